@@ -231,20 +231,47 @@ func (x *DevnetMetadata) GetNamespace() string {
 }
 
 type DevnetSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Plugin        string                 `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`                              // Network plugin name (e.g., "stable", "osmosis")
-	NetworkType   string                 `protobuf:"bytes,2,opt,name=network_type,json=networkType,proto3" json:"network_type,omitempty"` // "cosmos", "evm", "tempo"
-	Validators    int32                  `protobuf:"varint,3,opt,name=validators,proto3" json:"validators,omitempty"`
-	FullNodes     int32                  `protobuf:"varint,4,opt,name=full_nodes,json=fullNodes,proto3" json:"full_nodes,omitempty"`
-	Mode          string                 `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`                                   // "docker" or "local"
-	SdkVersion    string                 `protobuf:"bytes,6,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty"`     // Binary version
-	GenesisPath   string                 `protobuf:"bytes,7,opt,name=genesis_path,json=genesisPath,proto3" json:"genesis_path,omitempty"`  // Custom genesis file path
-	SnapshotUrl   string                 `protobuf:"bytes,8,opt,name=snapshot_url,json=snapshotUrl,proto3" json:"snapshot_url,omitempty"`  // Chain state snapshot URL
-	RpcUrl        string                 `protobuf:"bytes,9,opt,name=rpc_url,json=rpcUrl,proto3" json:"rpc_url,omitempty"`                 // RPC endpoint URL for genesis forking
-	ForkNetwork   string                 `protobuf:"bytes,10,opt,name=fork_network,json=forkNetwork,proto3" json:"fork_network,omitempty"` // Network to fork from (e.g., "mainnet", "testnet") - used to fetch plugin defaults
-	ChainId       string                 `protobuf:"bytes,11,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`             // Chain ID for the devnet (e.g., "mainnet-1", "mydevnet-1")
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Plugin           string                 `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`                              // Network plugin name (e.g., "stable", "osmosis")
+	NetworkType      string                 `protobuf:"bytes,2,opt,name=network_type,json=networkType,proto3" json:"network_type,omitempty"` // "cosmos", "evm", "tempo"
+	Validators       int32                  `protobuf:"varint,3,opt,name=validators,proto3" json:"validators,omitempty"`
+	FullNodes        int32                  `protobuf:"varint,4,opt,name=full_nodes,json=fullNodes,proto3" json:"full_nodes,omitempty"`
+	Mode             string                 `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`                                                                                                                            // "docker" or "local"
+	SdkVersion       string                 `protobuf:"bytes,6,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty"`                                                                                              // Binary version
+	GenesisPath      string                 `protobuf:"bytes,7,opt,name=genesis_path,json=genesisPath,proto3" json:"genesis_path,omitempty"`                                                                                           // Custom genesis file path
+	SnapshotUrl      string                 `protobuf:"bytes,8,opt,name=snapshot_url,json=snapshotUrl,proto3" json:"snapshot_url,omitempty"`                                                                                           // Chain state snapshot URL
+	RpcUrl           string                 `protobuf:"bytes,9,opt,name=rpc_url,json=rpcUrl,proto3" json:"rpc_url,omitempty"`                                                                                                          // RPC endpoint URL for genesis forking
+	ForkNetwork      string                 `protobuf:"bytes,10,opt,name=fork_network,json=forkNetwork,proto3" json:"fork_network,omitempty"`                                                                                          // Network to fork from (e.g., "mainnet", "testnet") - used to fetch plugin defaults
+	ChainId          string                 `protobuf:"bytes,11,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`                                                                                                      // Chain ID for the devnet (e.g., "mainnet-1", "mydevnet-1")
+	Image            string                 `protobuf:"bytes,12,opt,name=image,proto3" json:"image,omitempty"`                                                                                                                         // Docker image to run nodes with (docker mode), e.g. one produced by BuildService
+	Profile          string                 `protobuf:"bytes,13,opt,name=profile,proto3" json:"profile,omitempty"`                                                                                                                     // Provisioning profile tuning per-node resources (e.g., "laptop")
+	ForceBuild       bool                   `protobuf:"varint,14,opt,name=force_build,json=forceBuild,proto3" json:"force_build,omitempty"`                                                                                            // Compile the binary from source even if a release binary is published
+	Offline          bool                   `protobuf:"varint,15,opt,name=offline,proto3" json:"offline,omitempty"`                                                                                                                    // Provision only from local caches; fail fast if anything is missing
+	GenesisOverrides map[string]string      `protobuf:"bytes,16,rep,name=genesis_overrides,json=genesisOverrides,proto3" json:"genesis_overrides,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Genesis path (e.g., "app_state.gov.params.voting_period") to JSON-encoded value
+	FundedAccounts   []*FundedAccount       `protobuf:"bytes,17,rep,name=funded_accounts,json=fundedAccounts,proto3" json:"funded_accounts,omitempty"`                                                                                 // Accounts to fund in genesis alongside validator accounts
+	Accounts         int32                  `protobuf:"varint,18,opt,name=accounts,proto3" json:"accounts,omitempty"`                                                                                                                  // Number of deterministic test accounts to fund in genesis
+	Ttl              string                 `protobuf:"bytes,19,opt,name=ttl,proto3" json:"ttl,omitempty"`                                                                                                                             // Lifetime (e.g., "4h") after which the daemon stops the devnet; empty = forever
+	DeleteOnExpiry   bool                   `protobuf:"varint,20,opt,name=delete_on_expiry,json=deleteOnExpiry,proto3" json:"delete_on_expiry,omitempty"`                                                                              // Delete instead of stop the devnet when its TTL expires
+	IdleTimeout      string                 `protobuf:"bytes,21,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`                                                                                          // Stop the devnet after this long (e.g., "30m") without RPC traffic or transactions; empty = never
+	SkipDiskCheck    bool                   `protobuf:"varint,22,opt,name=skip_disk_check,json=skipDiskCheck,proto3" json:"skip_disk_check,omitempty"`                                                                                 // Provision even if the disk space preflight check fails
+	Ports            *PortLayout            `protobuf:"bytes,23,opt,name=ports,proto3" json:"ports,omitempty"`                                                                                                                         // Base ports and stride for the nodes; unset = defaults
+	BindAddress      string                 `protobuf:"bytes,24,opt,name=bind_address,json=bindAddress,proto3" json:"bind_address,omitempty"`                                                                                          // IP nodes listen on (e.g. "0.0.0.0", "::"); empty = per-node loopback subnet address
+	ValidatorKeys    []*ValidatorKeys       `protobuf:"bytes,25,rep,name=validator_keys,json=validatorKeys,proto3" json:"validator_keys,omitempty"`                                                                                    // Operator-provided keys for validator slots; other slots get generated keys
+	RemoteSigners    []*RemoteSigner        `protobuf:"bytes,26,rep,name=remote_signers,json=remoteSigners,proto3" json:"remote_signers,omitempty"`                                                                                    // Validator slots that sign through an external signer on priv_validator_laddr
+	Seed             string                 `protobuf:"bytes,27,opt,name=seed,proto3" json:"seed,omitempty"`                                                                                                                           // Derives all mnemonics and node keys, so devnets with the same seed are identical; empty = fixed keys
+	GenesisPrune     *GenesisPrune          `protobuf:"bytes,28,opt,name=genesis_prune,json=genesisPrune,proto3" json:"genesis_prune,omitempty"`                                                                                       // State to drop from a forked genesis to shrink it; unset = keep everything
+	NodeConfigs      []*NodeConfig          `protobuf:"bytes,29,rep,name=node_configs,json=nodeConfigs,proto3" json:"node_configs,omitempty"`                                                                                          // Per-node config.toml and app.toml snippets merged after the plugin's overrides
+	BlockTime        string                 `protobuf:"bytes,30,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`                                                                                                // Consensus timeout_commit of every node (e.g., "500ms"); empty = 1s
+	EpochDuration    string                 `protobuf:"bytes,31,opt,name=epoch_duration,json=epochDuration,proto3" json:"epoch_duration,omitempty"`                                                                                    // Duration of every epochs module epoch in genesis (e.g., "1m"); empty = keep genesis durations
+	Webhooks         []*Webhook             `protobuf:"bytes,32,rep,name=webhooks,proto3" json:"webhooks,omitempty"`                                                                                                                   // Webhooks notified of this devnet's events, in addition to those in devnetd.toml
+	Hooks            *Hooks                 `protobuf:"bytes,33,opt,name=hooks,proto3" json:"hooks,omitempty"`                                                                                                                         // User commands run between lifecycle phases; unset = none
+	Bootstrap        *Bootstrap             `protobuf:"bytes,34,opt,name=bootstrap,proto3" json:"bootstrap,omitempty"`                                                                                                                 // Contracts deployed once the devnet is first healthy; unset = none
+	Hosts            []*RemoteHost          `protobuf:"bytes,35,rep,name=hosts,proto3" json:"hosts,omitempty"`                                                                                                                         // Remote machines nodes run on over SSH, node i on hosts[i % len]; empty = the daemon's host
+	NetworkShaping   *NetworkShaping        `protobuf:"bytes,36,opt,name=network_shaping,json=networkShaping,proto3" json:"network_shaping,omitempty"`                                                                                 // Emulated network conditions between regions of docker-mode nodes; unset = none
+	VotingPower      *VotingPower           `protobuf:"bytes,37,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`                                                                                          // How stake is split between the validators injected into a forked genesis; unset = equal
+	AllowUnverified  bool                   `protobuf:"varint,38,opt,name=allow_unverified,json=allowUnverified,proto3" json:"allow_unverified,omitempty"`                                                                             // Use a release binary even if the release publishes no checksum for it; otherwise build from source
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *DevnetSpec) Reset() {
@@ -354,226 +381,221 @@ func (x *DevnetSpec) GetChainId() string {
 	return ""
 }
 
-type DevnetStatus struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Phase           string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
-	Nodes           int32                  `protobuf:"varint,2,opt,name=nodes,proto3" json:"nodes,omitempty"`
-	ReadyNodes      int32                  `protobuf:"varint,3,opt,name=ready_nodes,json=readyNodes,proto3" json:"ready_nodes,omitempty"`
-	CurrentHeight   int64                  `protobuf:"varint,4,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
-	SdkVersion      string                 `protobuf:"bytes,5,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty"`
-	LastHealthCheck *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_health_check,json=lastHealthCheck,proto3" json:"last_health_check,omitempty"`
-	Message         string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
-	Conditions      []*Condition           `protobuf:"bytes,8,rep,name=conditions,proto3" json:"conditions,omitempty"` // Detailed status conditions
-	Events          []*Event               `protobuf:"bytes,9,rep,name=events,proto3" json:"events,omitempty"`         // Recent events (last 10)
-	Subnet          uint32                 `protobuf:"varint,10,opt,name=subnet,proto3" json:"subnet,omitempty"`       // Allocated loopback subnet (1-254) for 127.0.X.0/24
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *DevnetSpec) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
 }
 
-func (x *DevnetStatus) Reset() {
-	*x = DevnetStatus{}
-	mi := &file_v1_devnet_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DevnetSpec) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
 }
 
-func (x *DevnetStatus) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DevnetSpec) GetForceBuild() bool {
+	if x != nil {
+		return x.ForceBuild
+	}
+	return false
 }
 
-func (*DevnetStatus) ProtoMessage() {}
-
-func (x *DevnetStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[3]
+func (x *DevnetSpec) GetOffline() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Offline
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use DevnetStatus.ProtoReflect.Descriptor instead.
-func (*DevnetStatus) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{3}
+func (x *DevnetSpec) GetGenesisOverrides() map[string]string {
+	if x != nil {
+		return x.GenesisOverrides
+	}
+	return nil
 }
 
-func (x *DevnetStatus) GetPhase() string {
+func (x *DevnetSpec) GetFundedAccounts() []*FundedAccount {
 	if x != nil {
-		return x.Phase
+		return x.FundedAccounts
 	}
-	return ""
+	return nil
 }
 
-func (x *DevnetStatus) GetNodes() int32 {
+func (x *DevnetSpec) GetAccounts() int32 {
 	if x != nil {
-		return x.Nodes
+		return x.Accounts
 	}
 	return 0
 }
 
-func (x *DevnetStatus) GetReadyNodes() int32 {
+func (x *DevnetSpec) GetTtl() string {
 	if x != nil {
-		return x.ReadyNodes
+		return x.Ttl
 	}
-	return 0
+	return ""
 }
 
-func (x *DevnetStatus) GetCurrentHeight() int64 {
+func (x *DevnetSpec) GetDeleteOnExpiry() bool {
 	if x != nil {
-		return x.CurrentHeight
+		return x.DeleteOnExpiry
 	}
-	return 0
+	return false
 }
 
-func (x *DevnetStatus) GetSdkVersion() string {
+func (x *DevnetSpec) GetIdleTimeout() string {
 	if x != nil {
-		return x.SdkVersion
+		return x.IdleTimeout
 	}
 	return ""
 }
 
-func (x *DevnetStatus) GetLastHealthCheck() *timestamppb.Timestamp {
+func (x *DevnetSpec) GetSkipDiskCheck() bool {
 	if x != nil {
-		return x.LastHealthCheck
+		return x.SkipDiskCheck
+	}
+	return false
+}
+
+func (x *DevnetSpec) GetPorts() *PortLayout {
+	if x != nil {
+		return x.Ports
 	}
 	return nil
 }
 
-func (x *DevnetStatus) GetMessage() string {
+func (x *DevnetSpec) GetBindAddress() string {
 	if x != nil {
-		return x.Message
+		return x.BindAddress
 	}
 	return ""
 }
 
-func (x *DevnetStatus) GetConditions() []*Condition {
+func (x *DevnetSpec) GetValidatorKeys() []*ValidatorKeys {
 	if x != nil {
-		return x.Conditions
+		return x.ValidatorKeys
 	}
 	return nil
 }
 
-func (x *DevnetStatus) GetEvents() []*Event {
+func (x *DevnetSpec) GetRemoteSigners() []*RemoteSigner {
 	if x != nil {
-		return x.Events
+		return x.RemoteSigners
 	}
 	return nil
 }
 
-func (x *DevnetStatus) GetSubnet() uint32 {
+func (x *DevnetSpec) GetSeed() string {
 	if x != nil {
-		return x.Subnet
+		return x.Seed
 	}
-	return 0
+	return ""
 }
 
-// Condition represents a status condition of a resource.
-type Condition struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Type               string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`     // Type of condition (Ready, Progressing, etc.)
-	Status             string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // True, False, Unknown
-	LastTransitionTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_transition_time,json=lastTransitionTime,proto3" json:"last_transition_time,omitempty"`
-	Reason             string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`   // CamelCase reason code
-	Message            string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"` // Human-readable message
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *DevnetSpec) GetGenesisPrune() *GenesisPrune {
+	if x != nil {
+		return x.GenesisPrune
+	}
+	return nil
 }
 
-func (x *Condition) Reset() {
-	*x = Condition{}
-	mi := &file_v1_devnet_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DevnetSpec) GetNodeConfigs() []*NodeConfig {
+	if x != nil {
+		return x.NodeConfigs
+	}
+	return nil
 }
 
-func (x *Condition) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DevnetSpec) GetBlockTime() string {
+	if x != nil {
+		return x.BlockTime
+	}
+	return ""
 }
 
-func (*Condition) ProtoMessage() {}
+func (x *DevnetSpec) GetEpochDuration() string {
+	if x != nil {
+		return x.EpochDuration
+	}
+	return ""
+}
 
-func (x *Condition) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[4]
+func (x *DevnetSpec) GetWebhooks() []*Webhook {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Webhooks
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
-func (*Condition) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{4}
+func (x *DevnetSpec) GetHooks() *Hooks {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
 }
 
-func (x *Condition) GetType() string {
+func (x *DevnetSpec) GetBootstrap() *Bootstrap {
 	if x != nil {
-		return x.Type
+		return x.Bootstrap
 	}
-	return ""
+	return nil
 }
 
-func (x *Condition) GetStatus() string {
+func (x *DevnetSpec) GetHosts() []*RemoteHost {
 	if x != nil {
-		return x.Status
+		return x.Hosts
 	}
-	return ""
+	return nil
 }
 
-func (x *Condition) GetLastTransitionTime() *timestamppb.Timestamp {
+func (x *DevnetSpec) GetNetworkShaping() *NetworkShaping {
 	if x != nil {
-		return x.LastTransitionTime
+		return x.NetworkShaping
 	}
 	return nil
 }
 
-func (x *Condition) GetReason() string {
+func (x *DevnetSpec) GetVotingPower() *VotingPower {
 	if x != nil {
-		return x.Reason
+		return x.VotingPower
 	}
-	return ""
+	return nil
 }
 
-func (x *Condition) GetMessage() string {
+func (x *DevnetSpec) GetAllowUnverified() bool {
 	if x != nil {
-		return x.Message
+		return x.AllowUnverified
 	}
-	return ""
+	return false
 }
 
-// Event represents a significant occurrence during resource lifecycle.
-type Event struct {
+// NetworkShaping labels nodes with regions and sets the network conditions
+// between them, applied with tc netem in each node's network namespace.
+type NetworkShaping struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`           // Normal, Warning
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`       // CamelCase reason code
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`     // Human-readable message
-	Component     string                 `protobuf:"bytes,5,opt,name=component,proto3" json:"component,omitempty"` // Source component (controller, runtime, etc.)
+	Regions       map[int32]string       `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Region of each node by index; unlabelled nodes are in "default"
+	Links         []*NetworkLink         `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`                                                                                // Conditions between two regions, in both directions
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`                                                                                // Image with tc the shaping runs in; empty = nicolaka/netshoot:latest
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
-	mi := &file_v1_devnet_proto_msgTypes[5]
+func (x *NetworkShaping) Reset() {
+	*x = NetworkShaping{}
+	mi := &file_v1_devnet_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event) String() string {
+func (x *NetworkShaping) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*NetworkShaping) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[5]
+func (x *NetworkShaping) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,72 +606,59 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use NetworkShaping.ProtoReflect.Descriptor instead.
+func (*NetworkShaping) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Event) GetTimestamp() *timestamppb.Timestamp {
+func (x *NetworkShaping) GetRegions() map[int32]string {
 	if x != nil {
-		return x.Timestamp
+		return x.Regions
 	}
 	return nil
 }
 
-func (x *Event) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *Event) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-func (x *Event) GetMessage() string {
+func (x *NetworkShaping) GetLinks() []*NetworkLink {
 	if x != nil {
-		return x.Message
+		return x.Links
 	}
-	return ""
+	return nil
 }
 
-func (x *Event) GetComponent() string {
+func (x *NetworkShaping) GetImage() string {
 	if x != nil {
-		return x.Component
+		return x.Image
 	}
 	return ""
 }
 
-// DevnetService request/response messages
-type CreateDevnetRequest struct {
+type NetworkLink struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Spec          *DevnetSpec            `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
-	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"` // Target namespace (defaults to "default")
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`       // Region
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`           // Region; the same as from shapes traffic within the region
+	Latency       string                 `protobuf:"bytes,3,opt,name=latency,proto3" json:"latency,omitempty"` // Delay added to each packet (e.g. "100ms")
+	Jitter        string                 `protobuf:"bytes,4,opt,name=jitter,proto3" json:"jitter,omitempty"`   // Variation of the latency (e.g. "20ms")
+	Loss          string                 `protobuf:"bytes,5,opt,name=loss,proto3" json:"loss,omitempty"`       // Percentage of packets dropped (e.g. "1%")
+	Rate          string                 `protobuf:"bytes,6,opt,name=rate,proto3" json:"rate,omitempty"`       // Bandwidth limit in tc units (e.g. "10mbit")
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDevnetRequest) Reset() {
-	*x = CreateDevnetRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[6]
+func (x *NetworkLink) Reset() {
+	*x = NetworkLink{}
+	mi := &file_v1_devnet_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDevnetRequest) String() string {
+func (x *NetworkLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDevnetRequest) ProtoMessage() {}
+func (*NetworkLink) ProtoMessage() {}
 
-func (x *CreateDevnetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[6]
+func (x *NetworkLink) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,61 +669,82 @@ func (x *CreateDevnetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDevnetRequest.ProtoReflect.Descriptor instead.
-func (*CreateDevnetRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use NetworkLink.ProtoReflect.Descriptor instead.
+func (*NetworkLink) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateDevnetRequest) GetName() string {
+func (x *NetworkLink) GetFrom() string {
 	if x != nil {
-		return x.Name
+		return x.From
 	}
 	return ""
 }
 
-func (x *CreateDevnetRequest) GetSpec() *DevnetSpec {
+func (x *NetworkLink) GetTo() string {
 	if x != nil {
-		return x.Spec
+		return x.To
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateDevnetRequest) GetLabels() map[string]string {
+func (x *NetworkLink) GetLatency() string {
 	if x != nil {
-		return x.Labels
+		return x.Latency
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateDevnetRequest) GetNamespace() string {
+func (x *NetworkLink) GetJitter() string {
 	if x != nil {
-		return x.Namespace
+		return x.Jitter
 	}
 	return ""
 }
 
-type CreateDevnetResponse struct {
+func (x *NetworkLink) GetLoss() string {
+	if x != nil {
+		return x.Loss
+	}
+	return ""
+}
+
+func (x *NetworkLink) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+// RemoteHost is a machine nodes run on, reached over SSH with the daemon
+// user's ssh configuration and agent.
+type RemoteHost struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Devnet        *Devnet                `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // Name in logs and errors
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`                               // IP address or DNS name the daemon and other nodes reach the host's nodes on
+	User          string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`                                     // SSH user; empty = the ssh client's default
+	Port          int32                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`                                    // SSH port; 0 = 22
+	IdentityFile  string                 `protobuf:"bytes,5,opt,name=identity_file,json=identityFile,proto3" json:"identity_file,omitempty"` // Private key to authenticate with; empty = the ssh client's keys and agent
+	Dir           string                 `protobuf:"bytes,6,opt,name=dir,proto3" json:"dir,omitempty"`                                       // Absolute directory binaries, homes and logs are uploaded to; empty = ~/.devnet-builder
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDevnetResponse) Reset() {
-	*x = CreateDevnetResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[7]
+func (x *RemoteHost) Reset() {
+	*x = RemoteHost{}
+	mi := &file_v1_devnet_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDevnetResponse) String() string {
+func (x *RemoteHost) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDevnetResponse) ProtoMessage() {}
+func (*RemoteHost) ProtoMessage() {}
 
-func (x *CreateDevnetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[7]
+func (x *RemoteHost) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,92 +755,78 @@ func (x *CreateDevnetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDevnetResponse.ProtoReflect.Descriptor instead.
-func (*CreateDevnetResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RemoteHost.ProtoReflect.Descriptor instead.
+func (*RemoteHost) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateDevnetResponse) GetDevnet() *Devnet {
+func (x *RemoteHost) GetName() string {
 	if x != nil {
-		return x.Devnet
+		return x.Name
 	}
-	return nil
-}
-
-type GetDevnetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace to look in (defaults to "default")
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *GetDevnetRequest) Reset() {
-	*x = GetDevnetRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetDevnetRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RemoteHost) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
 }
 
-func (*GetDevnetRequest) ProtoMessage() {}
-
-func (x *GetDevnetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[8]
+func (x *RemoteHost) GetUser() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.User
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetDevnetRequest.ProtoReflect.Descriptor instead.
-func (*GetDevnetRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{8}
+func (x *RemoteHost) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
 }
 
-func (x *GetDevnetRequest) GetName() string {
+func (x *RemoteHost) GetIdentityFile() string {
 	if x != nil {
-		return x.Name
+		return x.IdentityFile
 	}
 	return ""
 }
 
-func (x *GetDevnetRequest) GetNamespace() string {
+func (x *RemoteHost) GetDir() string {
 	if x != nil {
-		return x.Namespace
+		return x.Dir
 	}
 	return ""
 }
 
-type GetDevnetResponse struct {
+// Webhook receives JSON notifications of devnet events.
+type Hooks struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Devnet        *Devnet                `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	PostFork      []*Hook                `protobuf:"bytes,1,rep,name=post_fork,json=postFork,proto3" json:"post_fork,omitempty"`          // Run once genesis is forked, before the nodes are initialized
+	PreStart      []*Hook                `protobuf:"bytes,2,rep,name=pre_start,json=preStart,proto3" json:"pre_start,omitempty"`          // Run once the nodes are initialized, before they start
+	PostHealthy   []*Hook                `protobuf:"bytes,3,rep,name=post_healthy,json=postHealthy,proto3" json:"post_healthy,omitempty"` // Run once, the first time all nodes are healthy
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDevnetResponse) Reset() {
-	*x = GetDevnetResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[9]
+func (x *Hooks) Reset() {
+	*x = Hooks{}
+	mi := &file_v1_devnet_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDevnetResponse) String() string {
+func (x *Hooks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDevnetResponse) ProtoMessage() {}
+func (*Hooks) ProtoMessage() {}
 
-func (x *GetDevnetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[9]
+func (x *Hooks) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,41 +837,59 @@ func (x *GetDevnetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDevnetResponse.ProtoReflect.Descriptor instead.
-func (*GetDevnetResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Hooks.ProtoReflect.Descriptor instead.
+func (*Hooks) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetDevnetResponse) GetDevnet() *Devnet {
+func (x *Hooks) GetPostFork() []*Hook {
 	if x != nil {
-		return x.Devnet
+		return x.PostFork
 	}
 	return nil
 }
 
-type ListDevnetsRequest struct {
+func (x *Hooks) GetPreStart() []*Hook {
+	if x != nil {
+		return x.PreStart
+	}
+	return nil
+}
+
+func (x *Hooks) GetPostHealthy() []*Hook {
+	if x != nil {
+		return x.PostHealthy
+	}
+	return nil
+}
+
+type Hook struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LabelSelector string                 `protobuf:"bytes,1,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Filter by namespace, empty = all namespaces
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                            // Name in logs and events; empty = its position, e.g. "postFork[0]"
+	Command       string                 `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`                      // Shell command, run with sh -c
+	Script        string                 `protobuf:"bytes,3,opt,name=script,proto3" json:"script,omitempty"`                        // Absolute path of a script on the daemon's host, run instead of command
+	Image         string                 `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`                          // Run in a container of this image instead of on the host
+	Timeout       string                 `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`                      // Go duration bounding the run (e.g. "2m"); empty = 5m
+	OnFailure     string                 `protobuf:"bytes,6,opt,name=on_failure,json=onFailure,proto3" json:"on_failure,omitempty"` // "fail" or "ignore"; empty = fail
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDevnetsRequest) Reset() {
-	*x = ListDevnetsRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[10]
+func (x *Hook) Reset() {
+	*x = Hook{}
+	mi := &file_v1_devnet_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDevnetsRequest) String() string {
+func (x *Hook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDevnetsRequest) ProtoMessage() {}
+func (*Hook) ProtoMessage() {}
 
-func (x *ListDevnetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[10]
+func (x *Hook) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -866,47 +900,78 @@ func (x *ListDevnetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDevnetsRequest.ProtoReflect.Descriptor instead.
-func (*ListDevnetsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Hook.ProtoReflect.Descriptor instead.
+func (*Hook) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListDevnetsRequest) GetLabelSelector() string {
+func (x *Hook) GetName() string {
 	if x != nil {
-		return x.LabelSelector
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListDevnetsRequest) GetNamespace() string {
+func (x *Hook) GetCommand() string {
 	if x != nil {
-		return x.Namespace
+		return x.Command
 	}
 	return ""
 }
 
-type ListDevnetsResponse struct {
+func (x *Hook) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *Hook) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Hook) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
+func (x *Hook) GetOnFailure() string {
+	if x != nil {
+		return x.OnFailure
+	}
+	return ""
+}
+
+// Bootstrap lists contracts deployed once a devnet is first healthy. Their
+// addresses are written to bootstrap-output.json in its data directory.
+type Bootstrap struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Devnets       []*Devnet              `protobuf:"bytes,1,rep,name=devnets,proto3" json:"devnets,omitempty"`
+	GasPrice      string                 `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"` // Price of CosmWasm transactions per unit of gas (e.g. "0.025stake"); empty = no fees
+	Contracts     []*Contract            `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts,omitempty"`               // Deployed in order by the first validator
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDevnetsResponse) Reset() {
-	*x = ListDevnetsResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[11]
+func (x *Bootstrap) Reset() {
+	*x = Bootstrap{}
+	mi := &file_v1_devnet_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDevnetsResponse) String() string {
+func (x *Bootstrap) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDevnetsResponse) ProtoMessage() {}
+func (*Bootstrap) ProtoMessage() {}
 
-func (x *ListDevnetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[11]
+func (x *Bootstrap) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -917,41 +982,54 @@ func (x *ListDevnetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDevnetsResponse.ProtoReflect.Descriptor instead.
-func (*ListDevnetsResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use Bootstrap.ProtoReflect.Descriptor instead.
+func (*Bootstrap) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListDevnetsResponse) GetDevnets() []*Devnet {
+func (x *Bootstrap) GetGasPrice() string {
 	if x != nil {
-		return x.Devnets
+		return x.GasPrice
+	}
+	return ""
+}
+
+func (x *Bootstrap) GetContracts() []*Contract {
+	if x != nil {
+		return x.Contracts
 	}
 	return nil
 }
 
-type DeleteDevnetRequest struct {
+type Contract struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                      // Key of the contract in bootstrap-output.json
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                      // "wasm" or "evm"
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                  // Absolute path or http(s) URL of a .wasm file, hex bytecode or a JSON artifact
+	InitMsg       string                 `protobuf:"bytes,4,opt,name=init_msg,json=initMsg,proto3" json:"init_msg,omitempty"` // JSON instantiate message of a wasm contract; empty = {}
+	Args          string                 `protobuf:"bytes,5,opt,name=args,proto3" json:"args,omitempty"`                      // Hex ABI-encoded constructor arguments of an evm contract
+	Label         string                 `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`                    // Label of a wasm contract; empty = name
+	Admin         string                 `protobuf:"bytes,7,opt,name=admin,proto3" json:"admin,omitempty"`                    // Admin of a wasm contract; empty = none
+	Funds         string                 `protobuf:"bytes,8,opt,name=funds,proto3" json:"funds,omitempty"`                    // Sent with the instantiation: coins for wasm, wei for evm
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDevnetRequest) Reset() {
-	*x = DeleteDevnetRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[12]
+func (x *Contract) Reset() {
+	*x = Contract{}
+	mi := &file_v1_devnet_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDevnetRequest) String() string {
+func (x *Contract) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDevnetRequest) ProtoMessage() {}
+func (*Contract) ProtoMessage() {}
 
-func (x *DeleteDevnetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[12]
+func (x *Contract) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -962,47 +1040,92 @@ func (x *DeleteDevnetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDevnetRequest.ProtoReflect.Descriptor instead.
-func (*DeleteDevnetRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Contract.ProtoReflect.Descriptor instead.
+func (*Contract) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteDevnetRequest) GetName() string {
+func (x *Contract) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *DeleteDevnetRequest) GetNamespace() string {
+func (x *Contract) GetType() string {
 	if x != nil {
-		return x.Namespace
+		return x.Type
 	}
 	return ""
 }
 
-type DeleteDevnetResponse struct {
+func (x *Contract) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Contract) GetInitMsg() string {
+	if x != nil {
+		return x.InitMsg
+	}
+	return ""
+}
+
+func (x *Contract) GetArgs() string {
+	if x != nil {
+		return x.Args
+	}
+	return ""
+}
+
+func (x *Contract) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Contract) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *Contract) GetFunds() string {
+	if x != nil {
+		return x.Funds
+	}
+	return ""
+}
+
+type Webhook struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`           // http(s) endpoint the notifications are POSTed to
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`     // "slack", "discord" or "generic"; empty = generic
+	Events        []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`     // Event types to send (e.g. "node.crashed"); empty = all
+	Template      string                 `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"` // Go text/template for the message text; empty = the event's message
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDevnetResponse) Reset() {
-	*x = DeleteDevnetResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[13]
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_v1_devnet_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDevnetResponse) String() string {
+func (x *Webhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDevnetResponse) ProtoMessage() {}
+func (*Webhook) ProtoMessage() {}
 
-func (x *DeleteDevnetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[13]
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1013,41 +1136,65 @@ func (x *DeleteDevnetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDevnetResponse.ProtoReflect.Descriptor instead.
-func (*DeleteDevnetResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteDevnetResponse) GetDeleted() bool {
+func (x *Webhook) GetUrl() string {
 	if x != nil {
-		return x.Deleted
+		return x.Url
 	}
-	return false
+	return ""
 }
 
-type StartDevnetRequest struct {
+func (x *Webhook) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Webhook) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+// NodeConfig holds raw TOML merged into one node's config files at
+// provisioning time.
+type NodeConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                            // Node index, from 0: validators, then full nodes
+	ConfigToml    string                 `protobuf:"bytes,2,opt,name=config_toml,json=configToml,proto3" json:"config_toml,omitempty"` // TOML deep-merged into config.toml
+	AppToml       string                 `protobuf:"bytes,3,opt,name=app_toml,json=appToml,proto3" json:"app_toml,omitempty"`          // TOML deep-merged into app.toml
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartDevnetRequest) Reset() {
-	*x = StartDevnetRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[14]
+func (x *NodeConfig) Reset() {
+	*x = NodeConfig{}
+	mi := &file_v1_devnet_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartDevnetRequest) String() string {
+func (x *NodeConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartDevnetRequest) ProtoMessage() {}
+func (*NodeConfig) ProtoMessage() {}
 
-func (x *StartDevnetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[14]
+func (x *NodeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,47 +1205,57 @@ func (x *StartDevnetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartDevnetRequest.ProtoReflect.Descriptor instead.
-func (*StartDevnetRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use NodeConfig.ProtoReflect.Descriptor instead.
+func (*NodeConfig) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *StartDevnetRequest) GetName() string {
+func (x *NodeConfig) GetIndex() int32 {
 	if x != nil {
-		return x.Name
+		return x.Index
+	}
+	return 0
+}
+
+func (x *NodeConfig) GetConfigToml() string {
+	if x != nil {
+		return x.ConfigToml
 	}
 	return ""
 }
 
-func (x *StartDevnetRequest) GetNamespace() string {
+func (x *NodeConfig) GetAppToml() string {
 	if x != nil {
-		return x.Namespace
+		return x.AppToml
 	}
 	return ""
 }
 
-type StartDevnetResponse struct {
+// GenesisPrune selects state to drop from a forked genesis.
+type GenesisPrune struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Devnet        *Devnet                `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	IbcPackets    bool                   `protobuf:"varint,1,opt,name=ibc_packets,json=ibcPackets,proto3" json:"ibc_packets,omitempty"`         // Clear IBC packet commitments, receipts and acknowledgements
+	DustThreshold string                 `protobuf:"bytes,2,opt,name=dust_threshold,json=dustThreshold,proto3" json:"dust_threshold,omitempty"` // Remove plain accounts holding less than this coin (e.g. "1000uatom"); empty = keep all
+	WasmCode      bool                   `protobuf:"varint,3,opt,name=wasm_code,json=wasmCode,proto3" json:"wasm_code,omitempty"`               // Drop CosmWasm code blobs and the contracts instantiated from them
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartDevnetResponse) Reset() {
-	*x = StartDevnetResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[15]
+func (x *GenesisPrune) Reset() {
+	*x = GenesisPrune{}
+	mi := &file_v1_devnet_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartDevnetResponse) String() string {
+func (x *GenesisPrune) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartDevnetResponse) ProtoMessage() {}
+func (*GenesisPrune) ProtoMessage() {}
 
-func (x *StartDevnetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[15]
+func (x *GenesisPrune) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1109,92 +1266,57 @@ func (x *StartDevnetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartDevnetResponse.ProtoReflect.Descriptor instead.
-func (*StartDevnetResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GenesisPrune.ProtoReflect.Descriptor instead.
+func (*GenesisPrune) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *StartDevnetResponse) GetDevnet() *Devnet {
+func (x *GenesisPrune) GetIbcPackets() bool {
 	if x != nil {
-		return x.Devnet
+		return x.IbcPackets
 	}
-	return nil
+	return false
 }
 
-type StopDevnetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *StopDevnetRequest) Reset() {
-	*x = StopDevnetRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *StopDevnetRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*StopDevnetRequest) ProtoMessage() {}
-
-func (x *StopDevnetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[16]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StopDevnetRequest.ProtoReflect.Descriptor instead.
-func (*StopDevnetRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *StopDevnetRequest) GetName() string {
+func (x *GenesisPrune) GetDustThreshold() string {
 	if x != nil {
-		return x.Name
+		return x.DustThreshold
 	}
 	return ""
 }
 
-func (x *StopDevnetRequest) GetNamespace() string {
+func (x *GenesisPrune) GetWasmCode() bool {
 	if x != nil {
-		return x.Namespace
+		return x.WasmCode
 	}
-	return ""
+	return false
 }
 
-type StopDevnetResponse struct {
+// VotingPower splits the validators' stake in a forked genesis, so that
+// governance quorum and threshold behave as they do on the source chain.
+type VotingPower struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Devnet        *Devnet                `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	Profile       string                 `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`          // "equal", "proportional" (the source chain's largest validators, in order) or "custom"; empty = equal
+	Weights       []float64              `protobuf:"fixed64,2,rep,packed,name=weights,proto3" json:"weights,omitempty"` // custom: relative power of each validator, by index
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopDevnetResponse) Reset() {
-	*x = StopDevnetResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[17]
+func (x *VotingPower) Reset() {
+	*x = VotingPower{}
+	mi := &file_v1_devnet_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopDevnetResponse) String() string {
+func (x *VotingPower) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopDevnetResponse) ProtoMessage() {}
+func (*VotingPower) ProtoMessage() {}
 
-func (x *StopDevnetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[17]
+func (x *VotingPower) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,44 +1327,52 @@ func (x *StopDevnetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopDevnetResponse.ProtoReflect.Descriptor instead.
-func (*StopDevnetResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use VotingPower.ProtoReflect.Descriptor instead.
+func (*VotingPower) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *StopDevnetResponse) GetDevnet() *Devnet {
+func (x *VotingPower) GetProfile() string {
 	if x != nil {
-		return x.Devnet
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *VotingPower) GetWeights() []float64 {
+	if x != nil {
+		return x.Weights
 	}
 	return nil
 }
 
-type ApplyDevnetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Spec          *DevnetSpec            `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
-	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Annotations   map[string]string      `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Namespace     string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// RemoteSigner makes a validator sign through an external signer such as
+// tmkms instead of its own key file.
+type RemoteSigner struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Index           int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                                           // Validator index, from 0
+	Tmkms           bool                   `protobuf:"varint,2,opt,name=tmkms,proto3" json:"tmkms,omitempty"`                                           // Launch a tmkms softsign container with the validator's key; false = operator connects their own signer
+	Image           string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`                                            // tmkms image; empty = default
+	ProtocolVersion string                 `protobuf:"bytes,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"` // tmkms protocol version ("v0.34", "v0.38", ...); empty = v0.38
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ApplyDevnetRequest) Reset() {
-	*x = ApplyDevnetRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[18]
+func (x *RemoteSigner) Reset() {
+	*x = RemoteSigner{}
+	mi := &file_v1_devnet_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyDevnetRequest) String() string {
+func (x *RemoteSigner) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyDevnetRequest) ProtoMessage() {}
+func (*RemoteSigner) ProtoMessage() {}
 
-func (x *ApplyDevnetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[18]
+func (x *RemoteSigner) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1253,69 +1383,65 @@ func (x *ApplyDevnetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyDevnetRequest.ProtoReflect.Descriptor instead.
-func (*ApplyDevnetRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *ApplyDevnetRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use RemoteSigner.ProtoReflect.Descriptor instead.
+func (*RemoteSigner) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ApplyDevnetRequest) GetSpec() *DevnetSpec {
+func (x *RemoteSigner) GetIndex() int32 {
 	if x != nil {
-		return x.Spec
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-func (x *ApplyDevnetRequest) GetLabels() map[string]string {
+func (x *RemoteSigner) GetTmkms() bool {
 	if x != nil {
-		return x.Labels
+		return x.Tmkms
 	}
-	return nil
+	return false
 }
 
-func (x *ApplyDevnetRequest) GetAnnotations() map[string]string {
+func (x *RemoteSigner) GetImage() string {
 	if x != nil {
-		return x.Annotations
+		return x.Image
 	}
-	return nil
+	return ""
 }
 
-func (x *ApplyDevnetRequest) GetNamespace() string {
+func (x *RemoteSigner) GetProtocolVersion() string {
 	if x != nil {
-		return x.Namespace
+		return x.ProtocolVersion
 	}
 	return ""
 }
 
-type ApplyDevnetResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Devnet        *Devnet                `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
-	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // "created", "configured", "unchanged"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ValidatorKeys are the keys a validator slot reuses instead of generating
+// its own, e.g. those of staging infrastructure.
+type ValidatorKeys struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Index            int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                                                // Validator index, from 0
+	PrivValidatorKey string                 `protobuf:"bytes,2,opt,name=priv_validator_key,json=privValidatorKey,proto3" json:"priv_validator_key,omitempty"` // Contents of priv_validator_key.json (consensus key); empty = generate
+	NodeKey          string                 `protobuf:"bytes,3,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`                              // Contents of node_key.json (p2p identity); empty = generate
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ApplyDevnetResponse) Reset() {
-	*x = ApplyDevnetResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[19]
+func (x *ValidatorKeys) Reset() {
+	*x = ValidatorKeys{}
+	mi := &file_v1_devnet_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyDevnetResponse) String() string {
+func (x *ValidatorKeys) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyDevnetResponse) ProtoMessage() {}
+func (*ValidatorKeys) ProtoMessage() {}
 
-func (x *ApplyDevnetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[19]
+func (x *ValidatorKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1326,51 +1452,61 @@ func (x *ApplyDevnetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyDevnetResponse.ProtoReflect.Descriptor instead.
-func (*ApplyDevnetResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ValidatorKeys.ProtoReflect.Descriptor instead.
+func (*ValidatorKeys) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ApplyDevnetResponse) GetDevnet() *Devnet {
+func (x *ValidatorKeys) GetIndex() int32 {
 	if x != nil {
-		return x.Devnet
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-func (x *ApplyDevnetResponse) GetAction() string {
+func (x *ValidatorKeys) GetPrivValidatorKey() string {
 	if x != nil {
-		return x.Action
+		return x.PrivValidatorKey
 	}
 	return ""
 }
 
-type UpdateDevnetRequest struct {
+func (x *ValidatorKeys) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+// PortLayout assigns ports to a devnet's nodes: each service starts at its
+// base port and node i adds i*stride. Zero values use the defaults.
+type PortLayout struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Spec          *DevnetSpec            `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
-	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Annotations   map[string]string      `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Namespace     string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	P2P           int32                  `protobuf:"varint,1,opt,name=p2p,proto3" json:"p2p,omitempty"`                     // Base P2P port (default: 26656)
+	Rpc           int32                  `protobuf:"varint,2,opt,name=rpc,proto3" json:"rpc,omitempty"`                     // Base Tendermint RPC port (default: 26657)
+	Rest          int32                  `protobuf:"varint,3,opt,name=rest,proto3" json:"rest,omitempty"`                   // Base REST API port (default: 1317)
+	Grpc          int32                  `protobuf:"varint,4,opt,name=grpc,proto3" json:"grpc,omitempty"`                   // Base gRPC port (default: 9090)
+	EvmRpc        int32                  `protobuf:"varint,5,opt,name=evm_rpc,json=evmRpc,proto3" json:"evm_rpc,omitempty"` // Base EVM JSON-RPC port (default: 8545); WebSocket uses the next port
+	Stride        int32                  `protobuf:"varint,6,opt,name=stride,proto3" json:"stride,omitempty"`               // Distance between consecutive nodes' ports
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDevnetRequest) Reset() {
-	*x = UpdateDevnetRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[20]
+func (x *PortLayout) Reset() {
+	*x = PortLayout{}
+	mi := &file_v1_devnet_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDevnetRequest) String() string {
+func (x *PortLayout) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDevnetRequest) ProtoMessage() {}
+func (*PortLayout) ProtoMessage() {}
 
-func (x *UpdateDevnetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[20]
+func (x *PortLayout) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1381,68 +1517,78 @@ func (x *UpdateDevnetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDevnetRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDevnetRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use PortLayout.ProtoReflect.Descriptor instead.
+func (*PortLayout) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdateDevnetRequest) GetName() string {
+func (x *PortLayout) GetP2P() int32 {
 	if x != nil {
-		return x.Name
+		return x.P2P
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateDevnetRequest) GetSpec() *DevnetSpec {
+func (x *PortLayout) GetRpc() int32 {
 	if x != nil {
-		return x.Spec
+		return x.Rpc
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateDevnetRequest) GetLabels() map[string]string {
+func (x *PortLayout) GetRest() int32 {
 	if x != nil {
-		return x.Labels
+		return x.Rest
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateDevnetRequest) GetAnnotations() map[string]string {
+func (x *PortLayout) GetGrpc() int32 {
 	if x != nil {
-		return x.Annotations
+		return x.Grpc
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateDevnetRequest) GetNamespace() string {
+func (x *PortLayout) GetEvmRpc() int32 {
 	if x != nil {
-		return x.Namespace
+		return x.EvmRpc
 	}
-	return ""
+	return 0
 }
 
-type UpdateDevnetResponse struct {
+func (x *PortLayout) GetStride() int32 {
+	if x != nil {
+		return x.Stride
+	}
+	return 0
+}
+
+// FundedAccount is an account pre-funded in genesis.
+type FundedAccount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Devnet        *Devnet                `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // Bech32 address, or 0x address for EVM chains
+	Coins         string                 `protobuf:"bytes,2,opt,name=coins,proto3" json:"coins,omitempty"`     // Balance, e.g. "1000000ustake,500uatom"
+	Vesting       *VestingSchedule       `protobuf:"bytes,3,opt,name=vesting,proto3" json:"vesting,omitempty"` // Optional vesting for part of the balance
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDevnetResponse) Reset() {
-	*x = UpdateDevnetResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[21]
+func (x *FundedAccount) Reset() {
+	*x = FundedAccount{}
+	mi := &file_v1_devnet_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDevnetResponse) String() string {
+func (x *FundedAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDevnetResponse) ProtoMessage() {}
+func (*FundedAccount) ProtoMessage() {}
 
-func (x *UpdateDevnetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[21]
+func (x *FundedAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1453,41 +1599,58 @@ func (x *UpdateDevnetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDevnetResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDevnetResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use FundedAccount.ProtoReflect.Descriptor instead.
+func (*FundedAccount) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdateDevnetResponse) GetDevnet() *Devnet {
+func (x *FundedAccount) GetAddress() string {
 	if x != nil {
-		return x.Devnet
+		return x.Address
+	}
+	return ""
+}
+
+func (x *FundedAccount) GetCoins() string {
+	if x != nil {
+		return x.Coins
+	}
+	return ""
+}
+
+func (x *FundedAccount) GetVesting() *VestingSchedule {
+	if x != nil {
+		return x.Vesting
 	}
 	return nil
 }
 
-type StreamProvisionLogsRequest struct {
+// VestingSchedule locks part of a funded account's balance.
+type VestingSchedule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                            // "continuous" or "delayed"
+	Coins         string                 `protobuf:"bytes,2,opt,name=coins,proto3" json:"coins,omitempty"`                          // Vesting portion of the balance (default: all of it)
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // When continuous vesting begins
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // When vesting completes
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamProvisionLogsRequest) Reset() {
-	*x = StreamProvisionLogsRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[22]
+func (x *VestingSchedule) Reset() {
+	*x = VestingSchedule{}
+	mi := &file_v1_devnet_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamProvisionLogsRequest) String() string {
+func (x *VestingSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamProvisionLogsRequest) ProtoMessage() {}
+func (*VestingSchedule) ProtoMessage() {}
 
-func (x *StreamProvisionLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[22]
+func (x *VestingSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1498,58 +1661,73 @@ func (x *StreamProvisionLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamProvisionLogsRequest.ProtoReflect.Descriptor instead.
-func (*StreamProvisionLogsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use VestingSchedule.ProtoReflect.Descriptor instead.
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *StreamProvisionLogsRequest) GetNamespace() string {
+func (x *VestingSchedule) GetType() string {
 	if x != nil {
-		return x.Namespace
+		return x.Type
 	}
 	return ""
 }
 
-func (x *StreamProvisionLogsRequest) GetName() string {
+func (x *VestingSchedule) GetCoins() string {
 	if x != nil {
-		return x.Name
+		return x.Coins
 	}
 	return ""
 }
 
-type StreamProvisionLogsResponse struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Level     string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`     // "info", "warn", "error"
-	Message   string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // Log line
-	Phase     string                 `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`     // Current phase when log was emitted
-	// Progress fields for detailed step tracking
-	StepName        string  `protobuf:"bytes,5,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`                       // "Downloading snapshot", "Extracting...", etc.
-	StepStatus      string  `protobuf:"bytes,6,opt,name=step_status,json=stepStatus,proto3" json:"step_status,omitempty"`                 // "running", "completed", "failed"
-	ProgressCurrent int64   `protobuf:"varint,7,opt,name=progress_current,json=progressCurrent,proto3" json:"progress_current,omitempty"` // Bytes downloaded, etc. (0 if indeterminate)
-	ProgressTotal   int64   `protobuf:"varint,8,opt,name=progress_total,json=progressTotal,proto3" json:"progress_total,omitempty"`       // Total bytes (0 if unknown)
-	ProgressUnit    string  `protobuf:"bytes,9,opt,name=progress_unit,json=progressUnit,proto3" json:"progress_unit,omitempty"`           // "bytes", "files", "" for indeterminate
-	StepDetail      string  `protobuf:"bytes,10,opt,name=step_detail,json=stepDetail,proto3" json:"step_detail,omitempty"`                // "from cache", etc.
-	Speed           float64 `protobuf:"fixed64,11,opt,name=speed,proto3" json:"speed,omitempty"`                                          // bytes per second (for download progress)
-	unknownFields   protoimpl.UnknownFields
+func (x *VestingSchedule) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *VestingSchedule) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type DevnetStatus struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Phase           string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Nodes           int32                  `protobuf:"varint,2,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	ReadyNodes      int32                  `protobuf:"varint,3,opt,name=ready_nodes,json=readyNodes,proto3" json:"ready_nodes,omitempty"`
+	CurrentHeight   int64                  `protobuf:"varint,4,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	SdkVersion      string                 `protobuf:"bytes,5,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty"`
+	LastHealthCheck *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_health_check,json=lastHealthCheck,proto3" json:"last_health_check,omitempty"`
+	Message         string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Conditions      []*Condition           `protobuf:"bytes,8,rep,name=conditions,proto3" json:"conditions,omitempty"`                       // Detailed status conditions
+	Events          []*Event               `protobuf:"bytes,9,rep,name=events,proto3" json:"events,omitempty"`                               // Recent events (last 10)
+	Subnet          uint32                 `protobuf:"varint,10,opt,name=subnet,proto3" json:"subnet,omitempty"`                             // Allocated loopback subnet (1-254) for 127.0.X.0/24
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`       // When the TTL expires; unset without a TTL
+	Image           string                 `protobuf:"bytes,12,opt,name=image,proto3" json:"image,omitempty"`                                // Image docker-mode nodes run, as specified
+	ImageDigest     string                 `protobuf:"bytes,13,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"` // Digest the image was pinned to when provisioned
+	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *StreamProvisionLogsResponse) Reset() {
-	*x = StreamProvisionLogsResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[23]
+func (x *DevnetStatus) Reset() {
+	*x = DevnetStatus{}
+	mi := &file_v1_devnet_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamProvisionLogsResponse) String() string {
+func (x *DevnetStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamProvisionLogsResponse) ProtoMessage() {}
+func (*DevnetStatus) ProtoMessage() {}
 
-func (x *StreamProvisionLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[23]
+func (x *DevnetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1560,113 +1738,129 @@ func (x *StreamProvisionLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamProvisionLogsResponse.ProtoReflect.Descriptor instead.
-func (*StreamProvisionLogsResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use DevnetStatus.ProtoReflect.Descriptor instead.
+func (*DevnetStatus) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *StreamProvisionLogsResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *DevnetStatus) GetPhase() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Phase
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamProvisionLogsResponse) GetLevel() string {
+func (x *DevnetStatus) GetNodes() int32 {
 	if x != nil {
-		return x.Level
+		return x.Nodes
 	}
-	return ""
+	return 0
 }
 
-func (x *StreamProvisionLogsResponse) GetMessage() string {
+func (x *DevnetStatus) GetReadyNodes() int32 {
 	if x != nil {
-		return x.Message
+		return x.ReadyNodes
 	}
-	return ""
+	return 0
 }
 
-func (x *StreamProvisionLogsResponse) GetPhase() string {
+func (x *DevnetStatus) GetCurrentHeight() int64 {
 	if x != nil {
-		return x.Phase
+		return x.CurrentHeight
 	}
-	return ""
+	return 0
 }
 
-func (x *StreamProvisionLogsResponse) GetStepName() string {
+func (x *DevnetStatus) GetSdkVersion() string {
 	if x != nil {
-		return x.StepName
+		return x.SdkVersion
 	}
 	return ""
 }
 
-func (x *StreamProvisionLogsResponse) GetStepStatus() string {
+func (x *DevnetStatus) GetLastHealthCheck() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StepStatus
+		return x.LastHealthCheck
+	}
+	return nil
+}
+
+func (x *DevnetStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *StreamProvisionLogsResponse) GetProgressCurrent() int64 {
+func (x *DevnetStatus) GetConditions() []*Condition {
 	if x != nil {
-		return x.ProgressCurrent
+		return x.Conditions
 	}
-	return 0
+	return nil
 }
 
-func (x *StreamProvisionLogsResponse) GetProgressTotal() int64 {
+func (x *DevnetStatus) GetEvents() []*Event {
 	if x != nil {
-		return x.ProgressTotal
+		return x.Events
+	}
+	return nil
+}
+
+func (x *DevnetStatus) GetSubnet() uint32 {
+	if x != nil {
+		return x.Subnet
 	}
 	return 0
 }
 
-func (x *StreamProvisionLogsResponse) GetProgressUnit() string {
+func (x *DevnetStatus) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ProgressUnit
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-func (x *StreamProvisionLogsResponse) GetStepDetail() string {
+func (x *DevnetStatus) GetImage() string {
 	if x != nil {
-		return x.StepDetail
+		return x.Image
 	}
 	return ""
 }
 
-func (x *StreamProvisionLogsResponse) GetSpeed() float64 {
+func (x *DevnetStatus) GetImageDigest() string {
 	if x != nil {
-		return x.Speed
+		return x.ImageDigest
 	}
-	return 0
+	return ""
 }
 
-// Node represents a single blockchain node within a devnet.
-type Node struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *NodeMetadata          `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Spec          *NodeSpec              `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
-	Status        *NodeStatus            `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Condition represents a status condition of a resource.
+type Condition struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Type               string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`     // Type of condition (Ready, Progressing, etc.)
+	Status             string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // True, False, Unknown
+	LastTransitionTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_transition_time,json=lastTransitionTime,proto3" json:"last_transition_time,omitempty"`
+	Reason             string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`   // CamelCase reason code
+	Message            string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"` // Human-readable message
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *Node) Reset() {
-	*x = Node{}
-	mi := &file_v1_devnet_proto_msgTypes[24]
+func (x *Condition) Reset() {
+	*x = Condition{}
+	mi := &file_v1_devnet_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Node) String() string {
+func (x *Condition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Node) ProtoMessage() {}
+func (*Condition) ProtoMessage() {}
 
-func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[24]
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1677,60 +1871,73 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Node.ProtoReflect.Descriptor instead.
-func (*Node) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Node) GetMetadata() *NodeMetadata {
+func (x *Condition) GetType() string {
 	if x != nil {
-		return x.Metadata
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-func (x *Node) GetSpec() *NodeSpec {
+func (x *Condition) GetStatus() string {
 	if x != nil {
-		return x.Spec
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *Node) GetStatus() *NodeStatus {
+func (x *Condition) GetLastTransitionTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Status
+		return x.LastTransitionTime
 	}
 	return nil
 }
 
-type NodeMetadata struct {
+func (x *Condition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Condition) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Event represents a significant occurrence during resource lifecycle.
+type Event struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                   // UUID for global lookup
-	DevnetName    string                 `protobuf:"bytes,2,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"` // Parent devnet reference
-	Index         int32                  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`                            // Index within devnet (0-based)
-	Generation    int64                  `protobuf:"varint,4,opt,name=generation,proto3" json:"generation,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Namespace     string                 `protobuf:"bytes,7,opt,name=namespace,proto3" json:"namespace,omitempty"` // Parent devnet's namespace (defaults to "default")
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`           // Normal, Warning
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`       // CamelCase reason code
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`     // Human-readable message
+	Component     string                 `protobuf:"bytes,5,opt,name=component,proto3" json:"component,omitempty"` // Source component (controller, runtime, etc.)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeMetadata) Reset() {
-	*x = NodeMetadata{}
-	mi := &file_v1_devnet_proto_msgTypes[25]
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_v1_devnet_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeMetadata) String() string {
+func (x *Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeMetadata) ProtoMessage() {}
+func (*Event) ProtoMessage() {}
 
-func (x *NodeMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[25]
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1741,87 +1948,72 @@ func (x *NodeMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeMetadata.ProtoReflect.Descriptor instead.
-func (*NodeMetadata) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *NodeMetadata) GetId() string {
+func (x *Event) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.Timestamp
 	}
-	return ""
+	return nil
 }
 
-func (x *NodeMetadata) GetDevnetName() string {
+func (x *Event) GetType() string {
 	if x != nil {
-		return x.DevnetName
+		return x.Type
 	}
 	return ""
 }
 
-func (x *NodeMetadata) GetIndex() int32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-func (x *NodeMetadata) GetGeneration() int64 {
-	if x != nil {
-		return x.Generation
-	}
-	return 0
-}
-
-func (x *NodeMetadata) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Event) GetReason() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *NodeMetadata) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Event) GetMessage() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *NodeMetadata) GetNamespace() string {
+func (x *Event) GetComponent() string {
 	if x != nil {
-		return x.Namespace
+		return x.Component
 	}
 	return ""
 }
 
-type NodeSpec struct {
+// DevnetService request/response messages
+type CreateDevnetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"` // "validator" or "fullnode"
-	BinaryPath    string                 `protobuf:"bytes,2,opt,name=binary_path,json=binaryPath,proto3" json:"binary_path,omitempty"`
-	HomeDir       string                 `protobuf:"bytes,3,opt,name=home_dir,json=homeDir,proto3" json:"home_dir,omitempty"`
-	DesiredPhase  string                 `protobuf:"bytes,4,opt,name=desired_phase,json=desiredPhase,proto3" json:"desired_phase,omitempty"` // "Running" or "Stopped"
-	RestartPolicy NodeRestartPolicy      `protobuf:"varint,5,opt,name=restart_policy,json=restartPolicy,proto3,enum=devnetbuilder.v1.NodeRestartPolicy" json:"restart_policy,omitempty"`
-	Address       string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"` // Node IP address (e.g., "127.0.42.1") for loopback subnet aliasing
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Spec          *DevnetSpec            `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"` // Target namespace (defaults to "default")
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeSpec) Reset() {
-	*x = NodeSpec{}
-	mi := &file_v1_devnet_proto_msgTypes[26]
+func (x *CreateDevnetRequest) Reset() {
+	*x = CreateDevnetRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeSpec) String() string {
+func (x *CreateDevnetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeSpec) ProtoMessage() {}
+func (*CreateDevnetRequest) ProtoMessage() {}
 
-func (x *NodeSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[26]
+func (x *CreateDevnetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1832,84 +2024,61 @@ func (x *NodeSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeSpec.ProtoReflect.Descriptor instead.
-func (*NodeSpec) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *NodeSpec) GetRole() string {
-	if x != nil {
-		return x.Role
-	}
-	return ""
-}
-
-func (x *NodeSpec) GetBinaryPath() string {
-	if x != nil {
-		return x.BinaryPath
-	}
-	return ""
+// Deprecated: Use CreateDevnetRequest.ProtoReflect.Descriptor instead.
+func (*CreateDevnetRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *NodeSpec) GetHomeDir() string {
+func (x *CreateDevnetRequest) GetName() string {
 	if x != nil {
-		return x.HomeDir
+		return x.Name
 	}
 	return ""
 }
 
-func (x *NodeSpec) GetDesiredPhase() string {
+func (x *CreateDevnetRequest) GetSpec() *DevnetSpec {
 	if x != nil {
-		return x.DesiredPhase
+		return x.Spec
 	}
-	return ""
+	return nil
 }
 
-func (x *NodeSpec) GetRestartPolicy() NodeRestartPolicy {
+func (x *CreateDevnetRequest) GetLabels() map[string]string {
 	if x != nil {
-		return x.RestartPolicy
+		return x.Labels
 	}
-	return NodeRestartPolicy_NODE_RESTART_POLICY_UNSPECIFIED
+	return nil
 }
 
-func (x *NodeSpec) GetAddress() string {
+func (x *CreateDevnetRequest) GetNamespace() string {
 	if x != nil {
-		return x.Address
+		return x.Namespace
 	}
 	return ""
 }
 
-type NodeStatus struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Phase              string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"` // Pending, Starting, Running, Stopping, Stopped, Unhealthy
-	ContainerId        string                 `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Pid                int32                  `protobuf:"varint,3,opt,name=pid,proto3" json:"pid,omitempty"`
-	Health             *NodeHealth            `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`
-	BlockHeight        int64                  `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	PeerCount          int32                  `protobuf:"varint,6,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
-	CatchingUp         bool                   `protobuf:"varint,7,opt,name=catching_up,json=catchingUp,proto3" json:"catching_up,omitempty"`
-	RestartCount       int32                  `protobuf:"varint,8,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
-	Message            string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
-	ObservedGeneration int64                  `protobuf:"varint,10,opt,name=observed_generation,json=observedGeneration,proto3" json:"observed_generation,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type CreateDevnetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devnet        *Devnet                `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeStatus) Reset() {
-	*x = NodeStatus{}
-	mi := &file_v1_devnet_proto_msgTypes[27]
+func (x *CreateDevnetResponse) Reset() {
+	*x = CreateDevnetResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeStatus) String() string {
+func (x *CreateDevnetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeStatus) ProtoMessage() {}
+func (*CreateDevnetResponse) ProtoMessage() {}
 
-func (x *NodeStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[27]
+func (x *CreateDevnetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1920,106 +2089,92 @@ func (x *NodeStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeStatus.ProtoReflect.Descriptor instead.
-func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *NodeStatus) GetPhase() string {
-	if x != nil {
-		return x.Phase
-	}
-	return ""
+// Deprecated: Use CreateDevnetResponse.ProtoReflect.Descriptor instead.
+func (*CreateDevnetResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *NodeStatus) GetContainerId() string {
+func (x *CreateDevnetResponse) GetDevnet() *Devnet {
 	if x != nil {
-		return x.ContainerId
+		return x.Devnet
 	}
-	return ""
+	return nil
 }
 
-func (x *NodeStatus) GetPid() int32 {
-	if x != nil {
-		return x.Pid
-	}
-	return 0
+type GetDevnetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace to look in (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeStatus) GetHealth() *NodeHealth {
-	if x != nil {
-		return x.Health
-	}
-	return nil
+func (x *GetDevnetRequest) Reset() {
+	*x = GetDevnetRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeStatus) GetBlockHeight() int64 {
-	if x != nil {
-		return x.BlockHeight
-	}
-	return 0
+func (x *GetDevnetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *NodeStatus) GetPeerCount() int32 {
-	if x != nil {
-		return x.PeerCount
-	}
-	return 0
-}
+func (*GetDevnetRequest) ProtoMessage() {}
 
-func (x *NodeStatus) GetCatchingUp() bool {
+func (x *GetDevnetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[24]
 	if x != nil {
-		return x.CatchingUp
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *NodeStatus) GetRestartCount() int32 {
-	if x != nil {
-		return x.RestartCount
-	}
-	return 0
+// Deprecated: Use GetDevnetRequest.ProtoReflect.Descriptor instead.
+func (*GetDevnetRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *NodeStatus) GetMessage() string {
+func (x *GetDevnetRequest) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-func (x *NodeStatus) GetObservedGeneration() int64 {
+func (x *GetDevnetRequest) GetNamespace() string {
 	if x != nil {
-		return x.ObservedGeneration
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-type NodeHealth struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Status              string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // Unknown, Healthy, Unhealthy, Degraded
-	Message             string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	LastCheck           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_check,json=lastCheck,proto3" json:"last_check,omitempty"`
-	ConsecutiveFailures int32                  `protobuf:"varint,4,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type GetDevnetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devnet        *Devnet                `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeHealth) Reset() {
-	*x = NodeHealth{}
-	mi := &file_v1_devnet_proto_msgTypes[28]
+func (x *GetDevnetResponse) Reset() {
+	*x = GetDevnetResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeHealth) String() string {
+func (x *GetDevnetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeHealth) ProtoMessage() {}
+func (*GetDevnetResponse) ProtoMessage() {}
 
-func (x *NodeHealth) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[28]
+func (x *GetDevnetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2030,64 +2185,41 @@ func (x *NodeHealth) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeHealth.ProtoReflect.Descriptor instead.
-func (*NodeHealth) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *NodeHealth) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *NodeHealth) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use GetDevnetResponse.ProtoReflect.Descriptor instead.
+func (*GetDevnetResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *NodeHealth) GetLastCheck() *timestamppb.Timestamp {
+func (x *GetDevnetResponse) GetDevnet() *Devnet {
 	if x != nil {
-		return x.LastCheck
+		return x.Devnet
 	}
 	return nil
 }
 
-func (x *NodeHealth) GetConsecutiveFailures() int32 {
-	if x != nil {
-		return x.ConsecutiveFailures
-	}
-	return 0
-}
-
-// NodeService request/response messages
-type StartNodeRequest struct {
+type ListDevnetsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
-	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	LabelSelector string                 `protobuf:"bytes,1,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Filter by namespace, empty = all namespaces
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartNodeRequest) Reset() {
-	*x = StartNodeRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[29]
+func (x *ListDevnetsRequest) Reset() {
+	*x = ListDevnetsRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartNodeRequest) String() string {
+func (x *ListDevnetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartNodeRequest) ProtoMessage() {}
+func (*ListDevnetsRequest) ProtoMessage() {}
 
-func (x *StartNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[29]
+func (x *ListDevnetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2098,54 +2230,47 @@ func (x *StartNodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartNodeRequest.ProtoReflect.Descriptor instead.
-func (*StartNodeRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListDevnetsRequest.ProtoReflect.Descriptor instead.
+func (*ListDevnetsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *StartNodeRequest) GetDevnetName() string {
+func (x *ListDevnetsRequest) GetLabelSelector() string {
 	if x != nil {
-		return x.DevnetName
+		return x.LabelSelector
 	}
 	return ""
 }
 
-func (x *StartNodeRequest) GetIndex() int32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-func (x *StartNodeRequest) GetNamespace() string {
+func (x *ListDevnetsRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-type StartNodeResponse struct {
+type ListDevnetsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Node          *Node                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Devnets       []*Devnet              `protobuf:"bytes,1,rep,name=devnets,proto3" json:"devnets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartNodeResponse) Reset() {
-	*x = StartNodeResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[30]
+func (x *ListDevnetsResponse) Reset() {
+	*x = ListDevnetsResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartNodeResponse) String() string {
+func (x *ListDevnetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartNodeResponse) ProtoMessage() {}
+func (*ListDevnetsResponse) ProtoMessage() {}
 
-func (x *StartNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[30]
+func (x *ListDevnetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2156,43 +2281,41 @@ func (x *StartNodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartNodeResponse.ProtoReflect.Descriptor instead.
-func (*StartNodeResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ListDevnetsResponse.ProtoReflect.Descriptor instead.
+func (*ListDevnetsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *StartNodeResponse) GetNode() *Node {
+func (x *ListDevnetsResponse) GetDevnets() []*Devnet {
 	if x != nil {
-		return x.Node
+		return x.Devnets
 	}
 	return nil
 }
 
-type StopNodeRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DevnetName     string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
-	Index          int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	TimeoutSeconds int32                  `protobuf:"varint,3,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // Grace period, default 30
-	Namespace      string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`                                  // Namespace (defaults to "default")
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type DeleteDevnetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopNodeRequest) Reset() {
-	*x = StopNodeRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[31]
+func (x *DeleteDevnetRequest) Reset() {
+	*x = DeleteDevnetRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopNodeRequest) String() string {
+func (x *DeleteDevnetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopNodeRequest) ProtoMessage() {}
+func (*DeleteDevnetRequest) ProtoMessage() {}
 
-func (x *StopNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[31]
+func (x *DeleteDevnetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2203,61 +2326,47 @@ func (x *StopNodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopNodeRequest.ProtoReflect.Descriptor instead.
-func (*StopNodeRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use DeleteDevnetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDevnetRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *StopNodeRequest) GetDevnetName() string {
+func (x *DeleteDevnetRequest) GetName() string {
 	if x != nil {
-		return x.DevnetName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *StopNodeRequest) GetIndex() int32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-func (x *StopNodeRequest) GetTimeoutSeconds() int32 {
-	if x != nil {
-		return x.TimeoutSeconds
-	}
-	return 0
-}
-
-func (x *StopNodeRequest) GetNamespace() string {
+func (x *DeleteDevnetRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-type StopNodeResponse struct {
+type DeleteDevnetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Node          *Node                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopNodeResponse) Reset() {
-	*x = StopNodeResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[32]
+func (x *DeleteDevnetResponse) Reset() {
+	*x = DeleteDevnetResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopNodeResponse) String() string {
+func (x *DeleteDevnetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopNodeResponse) ProtoMessage() {}
+func (*DeleteDevnetResponse) ProtoMessage() {}
 
-func (x *StopNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[32]
+func (x *DeleteDevnetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2268,42 +2377,41 @@ func (x *StopNodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopNodeResponse.ProtoReflect.Descriptor instead.
-func (*StopNodeResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use DeleteDevnetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDevnetResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *StopNodeResponse) GetNode() *Node {
+func (x *DeleteDevnetResponse) GetDeleted() bool {
 	if x != nil {
-		return x.Node
+		return x.Deleted
 	}
-	return nil
+	return false
 }
 
-type RestartNodeRequest struct {
+type StartDevnetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
-	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestartNodeRequest) Reset() {
-	*x = RestartNodeRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[33]
+func (x *StartDevnetRequest) Reset() {
+	*x = StartDevnetRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestartNodeRequest) String() string {
+func (x *StartDevnetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestartNodeRequest) ProtoMessage() {}
+func (*StartDevnetRequest) ProtoMessage() {}
 
-func (x *RestartNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[33]
+func (x *StartDevnetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2314,54 +2422,47 @@ func (x *RestartNodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestartNodeRequest.ProtoReflect.Descriptor instead.
-func (*RestartNodeRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use StartDevnetRequest.ProtoReflect.Descriptor instead.
+func (*StartDevnetRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RestartNodeRequest) GetDevnetName() string {
+func (x *StartDevnetRequest) GetName() string {
 	if x != nil {
-		return x.DevnetName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RestartNodeRequest) GetIndex() int32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-func (x *RestartNodeRequest) GetNamespace() string {
+func (x *StartDevnetRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-type RestartNodeResponse struct {
+type StartDevnetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Node          *Node                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Devnet        *Devnet                `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestartNodeResponse) Reset() {
-	*x = RestartNodeResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[34]
+func (x *StartDevnetResponse) Reset() {
+	*x = StartDevnetResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestartNodeResponse) String() string {
+func (x *StartDevnetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestartNodeResponse) ProtoMessage() {}
+func (*StartDevnetResponse) ProtoMessage() {}
 
-func (x *RestartNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[34]
+func (x *StartDevnetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2372,42 +2473,42 @@ func (x *RestartNodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestartNodeResponse.ProtoReflect.Descriptor instead.
-func (*RestartNodeResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use StartDevnetResponse.ProtoReflect.Descriptor instead.
+func (*StartDevnetResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *RestartNodeResponse) GetNode() *Node {
+func (x *StartDevnetResponse) GetDevnet() *Devnet {
 	if x != nil {
-		return x.Node
+		return x.Devnet
 	}
 	return nil
 }
 
-type GetNodeRequest struct {
+type StopDevnetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
-	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`       // Optional reason, recorded in the status message
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNodeRequest) Reset() {
-	*x = GetNodeRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[35]
+func (x *StopDevnetRequest) Reset() {
+	*x = StopDevnetRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNodeRequest) String() string {
+func (x *StopDevnetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodeRequest) ProtoMessage() {}
+func (*StopDevnetRequest) ProtoMessage() {}
 
-func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[35]
+func (x *StopDevnetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2418,54 +2519,54 @@ func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodeRequest.ProtoReflect.Descriptor instead.
-func (*GetNodeRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use StopDevnetRequest.ProtoReflect.Descriptor instead.
+func (*StopDevnetRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetNodeRequest) GetDevnetName() string {
+func (x *StopDevnetRequest) GetName() string {
 	if x != nil {
-		return x.DevnetName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetNodeRequest) GetIndex() int32 {
+func (x *StopDevnetRequest) GetNamespace() string {
 	if x != nil {
-		return x.Index
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *GetNodeRequest) GetNamespace() string {
+func (x *StopDevnetRequest) GetReason() string {
 	if x != nil {
-		return x.Namespace
+		return x.Reason
 	}
 	return ""
 }
 
-type GetNodeResponse struct {
+type StopDevnetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Node          *Node                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Devnet        *Devnet                `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNodeResponse) Reset() {
-	*x = GetNodeResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[36]
+func (x *StopDevnetResponse) Reset() {
+	*x = StopDevnetResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNodeResponse) String() string {
+func (x *StopDevnetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodeResponse) ProtoMessage() {}
+func (*StopDevnetResponse) ProtoMessage() {}
 
-func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[36]
+func (x *StopDevnetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2476,41 +2577,44 @@ func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodeResponse.ProtoReflect.Descriptor instead.
-func (*GetNodeResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use StopDevnetResponse.ProtoReflect.Descriptor instead.
+func (*StopDevnetResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetNodeResponse) GetNode() *Node {
+func (x *StopDevnetResponse) GetDevnet() *Devnet {
 	if x != nil {
-		return x.Node
+		return x.Devnet
 	}
 	return nil
 }
 
-type ListNodesRequest struct {
+type ApplyDevnetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"` // Required: filter by devnet
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                     // Namespace (defaults to "default")
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Spec          *DevnetSpec            `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Namespace     string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodesRequest) Reset() {
-	*x = ListNodesRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[37]
+func (x *ApplyDevnetRequest) Reset() {
+	*x = ApplyDevnetRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodesRequest) String() string {
+func (x *ApplyDevnetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodesRequest) ProtoMessage() {}
+func (*ApplyDevnetRequest) ProtoMessage() {}
 
-func (x *ListNodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[37]
+func (x *ApplyDevnetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2521,47 +2625,69 @@ func (x *ListNodesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodesRequest.ProtoReflect.Descriptor instead.
-func (*ListNodesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ApplyDevnetRequest.ProtoReflect.Descriptor instead.
+func (*ApplyDevnetRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListNodesRequest) GetDevnetName() string {
+func (x *ApplyDevnetRequest) GetName() string {
 	if x != nil {
-		return x.DevnetName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListNodesRequest) GetNamespace() string {
+func (x *ApplyDevnetRequest) GetSpec() *DevnetSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ApplyDevnetRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ApplyDevnetRequest) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *ApplyDevnetRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-type ListNodesResponse struct {
+type ApplyDevnetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Nodes         []*Node                `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Devnet        *Devnet                `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // "created", "configured", "unchanged"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodesResponse) Reset() {
-	*x = ListNodesResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[38]
+func (x *ApplyDevnetResponse) Reset() {
+	*x = ApplyDevnetResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodesResponse) String() string {
+func (x *ApplyDevnetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodesResponse) ProtoMessage() {}
+func (*ApplyDevnetResponse) ProtoMessage() {}
 
-func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[38]
+func (x *ApplyDevnetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2572,42 +2698,51 @@ func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodesResponse.ProtoReflect.Descriptor instead.
-func (*ListNodesResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ApplyDevnetResponse.ProtoReflect.Descriptor instead.
+func (*ApplyDevnetResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ListNodesResponse) GetNodes() []*Node {
+func (x *ApplyDevnetResponse) GetDevnet() *Devnet {
 	if x != nil {
-		return x.Nodes
+		return x.Devnet
 	}
 	return nil
 }
 
-type GetNodeHealthRequest struct {
+func (x *ApplyDevnetResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type UpdateDevnetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
-	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Spec          *DevnetSpec            `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Namespace     string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNodeHealthRequest) Reset() {
-	*x = GetNodeHealthRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[39]
+func (x *UpdateDevnetRequest) Reset() {
+	*x = UpdateDevnetRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNodeHealthRequest) String() string {
+func (x *UpdateDevnetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodeHealthRequest) ProtoMessage() {}
+func (*UpdateDevnetRequest) ProtoMessage() {}
 
-func (x *GetNodeHealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[39]
+func (x *UpdateDevnetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2618,54 +2753,68 @@ func (x *GetNodeHealthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodeHealthRequest.ProtoReflect.Descriptor instead.
-func (*GetNodeHealthRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use UpdateDevnetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDevnetRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetNodeHealthRequest) GetDevnetName() string {
+func (x *UpdateDevnetRequest) GetName() string {
 	if x != nil {
-		return x.DevnetName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetNodeHealthRequest) GetIndex() int32 {
+func (x *UpdateDevnetRequest) GetSpec() *DevnetSpec {
 	if x != nil {
-		return x.Index
+		return x.Spec
 	}
-	return 0
+	return nil
 }
 
-func (x *GetNodeHealthRequest) GetNamespace() string {
+func (x *UpdateDevnetRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UpdateDevnetRequest) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *UpdateDevnetRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-type GetNodeHealthResponse struct {
+type UpdateDevnetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Health        *NodeHealth            `protobuf:"bytes,1,opt,name=health,proto3" json:"health,omitempty"`
+	Devnet        *Devnet                `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNodeHealthResponse) Reset() {
-	*x = GetNodeHealthResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[40]
+func (x *UpdateDevnetResponse) Reset() {
+	*x = UpdateDevnetResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNodeHealthResponse) String() string {
+func (x *UpdateDevnetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodeHealthResponse) ProtoMessage() {}
+func (*UpdateDevnetResponse) ProtoMessage() {}
 
-func (x *GetNodeHealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[40]
+func (x *UpdateDevnetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2676,45 +2825,41 @@ func (x *GetNodeHealthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodeHealthResponse.ProtoReflect.Descriptor instead.
-func (*GetNodeHealthResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use UpdateDevnetResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDevnetResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetNodeHealthResponse) GetHealth() *NodeHealth {
+func (x *UpdateDevnetResponse) GetDevnet() *Devnet {
 	if x != nil {
-		return x.Health
+		return x.Devnet
 	}
 	return nil
 }
 
-type StreamNodeLogsRequest struct {
+type StreamProvisionLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
-	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	Follow        bool                   `protobuf:"varint,3,opt,name=follow,proto3" json:"follow,omitempty"`
-	Since         string                 `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`         // RFC3339 timestamp
-	Tail          int32                  `protobuf:"varint,5,opt,name=tail,proto3" json:"tail,omitempty"`          // Number of lines from end
-	Namespace     string                 `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamNodeLogsRequest) Reset() {
-	*x = StreamNodeLogsRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[41]
+func (x *StreamProvisionLogsRequest) Reset() {
+	*x = StreamProvisionLogsRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamNodeLogsRequest) String() string {
+func (x *StreamProvisionLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamNodeLogsRequest) ProtoMessage() {}
+func (*StreamProvisionLogsRequest) ProtoMessage() {}
 
-func (x *StreamNodeLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[41]
+func (x *StreamProvisionLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2725,77 +2870,58 @@ func (x *StreamNodeLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamNodeLogsRequest.ProtoReflect.Descriptor instead.
-func (*StreamNodeLogsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use StreamProvisionLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamProvisionLogsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *StreamNodeLogsRequest) GetDevnetName() string {
+func (x *StreamProvisionLogsRequest) GetNamespace() string {
 	if x != nil {
-		return x.DevnetName
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *StreamNodeLogsRequest) GetIndex() int32 {
+func (x *StreamProvisionLogsRequest) GetName() string {
 	if x != nil {
-		return x.Index
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *StreamNodeLogsRequest) GetFollow() bool {
-	if x != nil {
-		return x.Follow
-	}
-	return false
+type StreamProvisionLogsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Level     string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`     // "info", "warn", "error"
+	Message   string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // Log line
+	Phase     string                 `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`     // Current phase when log was emitted
+	// Progress fields for detailed step tracking
+	StepName        string  `protobuf:"bytes,5,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`                       // "Downloading snapshot", "Extracting...", etc.
+	StepStatus      string  `protobuf:"bytes,6,opt,name=step_status,json=stepStatus,proto3" json:"step_status,omitempty"`                 // "running", "completed", "failed"
+	ProgressCurrent int64   `protobuf:"varint,7,opt,name=progress_current,json=progressCurrent,proto3" json:"progress_current,omitempty"` // Bytes downloaded, etc. (0 if indeterminate)
+	ProgressTotal   int64   `protobuf:"varint,8,opt,name=progress_total,json=progressTotal,proto3" json:"progress_total,omitempty"`       // Total bytes (0 if unknown)
+	ProgressUnit    string  `protobuf:"bytes,9,opt,name=progress_unit,json=progressUnit,proto3" json:"progress_unit,omitempty"`           // "bytes", "files", "" for indeterminate
+	StepDetail      string  `protobuf:"bytes,10,opt,name=step_detail,json=stepDetail,proto3" json:"step_detail,omitempty"`                // "from cache", etc.
+	Speed           float64 `protobuf:"fixed64,11,opt,name=speed,proto3" json:"speed,omitempty"`                                          // bytes per second (for download progress)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *StreamNodeLogsRequest) GetSince() string {
-	if x != nil {
-		return x.Since
-	}
-	return ""
+func (x *StreamProvisionLogsResponse) Reset() {
+	*x = StreamProvisionLogsResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamNodeLogsRequest) GetTail() int32 {
-	if x != nil {
-		return x.Tail
-	}
-	return 0
+func (x *StreamProvisionLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StreamNodeLogsRequest) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
-	}
-	return ""
-}
-
-type StreamNodeLogsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Stream        string                 `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"` // "stdout" or "stderr"
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *StreamNodeLogsResponse) Reset() {
-	*x = StreamNodeLogsResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[42]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *StreamNodeLogsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*StreamNodeLogsResponse) ProtoMessage() {}
+func (*StreamProvisionLogsResponse) ProtoMessage() {}
 
-func (x *StreamNodeLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[42]
+func (x *StreamProvisionLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2806,58 +2932,114 @@ func (x *StreamNodeLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamNodeLogsResponse.ProtoReflect.Descriptor instead.
-func (*StreamNodeLogsResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use StreamProvisionLogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamProvisionLogsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *StreamNodeLogsResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *StreamProvisionLogsResponse) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Timestamp
 	}
 	return nil
 }
 
-func (x *StreamNodeLogsResponse) GetStream() string {
+func (x *StreamProvisionLogsResponse) GetLevel() string {
 	if x != nil {
-		return x.Stream
+		return x.Level
 	}
 	return ""
 }
 
-func (x *StreamNodeLogsResponse) GetMessage() string {
+func (x *StreamProvisionLogsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type ExecInNodeRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DevnetName     string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
-	Index          int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	Command        []string               `protobuf:"bytes,3,rep,name=command,proto3" json:"command,omitempty"`
-	TimeoutSeconds int32                  `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
-	Namespace      string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *StreamProvisionLogsResponse) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
 }
 
-func (x *ExecInNodeRequest) Reset() {
-	*x = ExecInNodeRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[43]
+func (x *StreamProvisionLogsResponse) GetStepName() string {
+	if x != nil {
+		return x.StepName
+	}
+	return ""
+}
+
+func (x *StreamProvisionLogsResponse) GetStepStatus() string {
+	if x != nil {
+		return x.StepStatus
+	}
+	return ""
+}
+
+func (x *StreamProvisionLogsResponse) GetProgressCurrent() int64 {
+	if x != nil {
+		return x.ProgressCurrent
+	}
+	return 0
+}
+
+func (x *StreamProvisionLogsResponse) GetProgressTotal() int64 {
+	if x != nil {
+		return x.ProgressTotal
+	}
+	return 0
+}
+
+func (x *StreamProvisionLogsResponse) GetProgressUnit() string {
+	if x != nil {
+		return x.ProgressUnit
+	}
+	return ""
+}
+
+func (x *StreamProvisionLogsResponse) GetStepDetail() string {
+	if x != nil {
+		return x.StepDetail
+	}
+	return ""
+}
+
+func (x *StreamProvisionLogsResponse) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+// ExportFixturesRequest generates canonical fixtures from a running devnet.
+type ExportFixturesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                   // Namespace (defaults to "default")
+	Types         []string               `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`                           // bank, staking, gov (default: all)
+	NodeIndex     int32                  `protobuf:"varint,4,opt,name=node_index,json=nodeIndex,proto3" json:"node_index,omitempty"` // Node to query (default: 0)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportFixturesRequest) Reset() {
+	*x = ExportFixturesRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecInNodeRequest) String() string {
+func (x *ExportFixturesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecInNodeRequest) ProtoMessage() {}
+func (*ExportFixturesRequest) ProtoMessage() {}
 
-func (x *ExecInNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[43]
+func (x *ExportFixturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2868,70 +3050,63 @@ func (x *ExecInNodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecInNodeRequest.ProtoReflect.Descriptor instead.
-func (*ExecInNodeRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ExportFixturesRequest.ProtoReflect.Descriptor instead.
+func (*ExportFixturesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ExecInNodeRequest) GetDevnetName() string {
+func (x *ExportFixturesRequest) GetDevnetName() string {
 	if x != nil {
 		return x.DevnetName
 	}
 	return ""
 }
 
-func (x *ExecInNodeRequest) GetIndex() int32 {
+func (x *ExportFixturesRequest) GetNamespace() string {
 	if x != nil {
-		return x.Index
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *ExecInNodeRequest) GetCommand() []string {
+func (x *ExportFixturesRequest) GetTypes() []string {
 	if x != nil {
-		return x.Command
+		return x.Types
 	}
 	return nil
 }
 
-func (x *ExecInNodeRequest) GetTimeoutSeconds() int32 {
+func (x *ExportFixturesRequest) GetNodeIndex() int32 {
 	if x != nil {
-		return x.TimeoutSeconds
+		return x.NodeIndex
 	}
 	return 0
 }
 
-func (x *ExecInNodeRequest) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
-	}
-	return ""
-}
-
-type ExecInNodeResponse struct {
+// FixtureFile is a generated fixture file.
+type FixtureFile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExitCode      int32                  `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
-	Stdout        string                 `protobuf:"bytes,2,opt,name=stdout,proto3" json:"stdout,omitempty"`
-	Stderr        string                 `protobuf:"bytes,3,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Path relative to the output directory
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecInNodeResponse) Reset() {
-	*x = ExecInNodeResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[44]
+func (x *FixtureFile) Reset() {
+	*x = FixtureFile{}
+	mi := &file_v1_devnet_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecInNodeResponse) String() string {
+func (x *FixtureFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecInNodeResponse) ProtoMessage() {}
+func (*FixtureFile) ProtoMessage() {}
 
-func (x *ExecInNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[44]
+func (x *FixtureFile) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2942,58 +3117,49 @@ func (x *ExecInNodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecInNodeResponse.ProtoReflect.Descriptor instead.
-func (*ExecInNodeResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *ExecInNodeResponse) GetExitCode() int32 {
-	if x != nil {
-		return x.ExitCode
-	}
-	return 0
+// Deprecated: Use FixtureFile.ProtoReflect.Descriptor instead.
+func (*FixtureFile) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ExecInNodeResponse) GetStdout() string {
+func (x *FixtureFile) GetName() string {
 	if x != nil {
-		return x.Stdout
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ExecInNodeResponse) GetStderr() string {
+func (x *FixtureFile) GetContent() []byte {
 	if x != nil {
-		return x.Stderr
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-// PortMapping describes a single port binding between container and host.
-type PortMapping struct {
+type ExportFixturesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                         // Service name: "p2p", "rpc", "rest", "grpc"
-	ContainerPort int32                  `protobuf:"varint,2,opt,name=container_port,json=containerPort,proto3" json:"container_port,omitempty"` // Port inside container (e.g., 26656)
-	HostPort      int32                  `protobuf:"varint,3,opt,name=host_port,json=hostPort,proto3" json:"host_port,omitempty"`                // Port on host (e.g., 26756 for node index 1)
-	Protocol      string                 `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`                                 // "tcp" or "udp"
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Height        int64                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"` // Height all query fixtures are pinned to
+	Files         []*FixtureFile         `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PortMapping) Reset() {
-	*x = PortMapping{}
-	mi := &file_v1_devnet_proto_msgTypes[45]
+func (x *ExportFixturesResponse) Reset() {
+	*x = ExportFixturesResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PortMapping) String() string {
+func (x *ExportFixturesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PortMapping) ProtoMessage() {}
+func (*ExportFixturesResponse) ProtoMessage() {}
 
-func (x *PortMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[45]
+func (x *ExportFixturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3004,62 +3170,56 @@ func (x *PortMapping) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PortMapping.ProtoReflect.Descriptor instead.
-func (*PortMapping) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ExportFixturesResponse.ProtoReflect.Descriptor instead.
+func (*ExportFixturesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *PortMapping) GetName() string {
+func (x *ExportFixturesResponse) GetChainId() string {
 	if x != nil {
-		return x.Name
+		return x.ChainId
 	}
 	return ""
 }
 
-func (x *PortMapping) GetContainerPort() int32 {
-	if x != nil {
-		return x.ContainerPort
-	}
-	return 0
-}
-
-func (x *PortMapping) GetHostPort() int32 {
+func (x *ExportFixturesResponse) GetHeight() int64 {
 	if x != nil {
-		return x.HostPort
+		return x.Height
 	}
 	return 0
 }
 
-func (x *PortMapping) GetProtocol() string {
+func (x *ExportFixturesResponse) GetFiles() []*FixtureFile {
 	if x != nil {
-		return x.Protocol
+		return x.Files
 	}
-	return ""
+	return nil
 }
 
-type GetNodePortsRequest struct {
+// ExportKeysRequest exports a devnet's validator and test-account keys.
+type ExportKeysRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
-	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNodePortsRequest) Reset() {
-	*x = GetNodePortsRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[46]
+func (x *ExportKeysRequest) Reset() {
+	*x = ExportKeysRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNodePortsRequest) String() string {
+func (x *ExportKeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodePortsRequest) ProtoMessage() {}
+func (*ExportKeysRequest) ProtoMessage() {}
 
-func (x *GetNodePortsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[46]
+func (x *ExportKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3070,49 +3230,55 @@ func (x *GetNodePortsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodePortsRequest.ProtoReflect.Descriptor instead.
-func (*GetNodePortsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ExportKeysRequest.ProtoReflect.Descriptor instead.
+func (*ExportKeysRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetNodePortsRequest) GetDevnetName() string {
+func (x *ExportKeysRequest) GetDevnetName() string {
 	if x != nil {
 		return x.DevnetName
 	}
 	return ""
 }
 
-func (x *GetNodePortsRequest) GetIndex() int32 {
+func (x *ExportKeysRequest) GetNamespace() string {
 	if x != nil {
-		return x.Index
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-type GetNodePortsResponse struct {
+// AccountKey is a validator or test-account key.
+type AccountKey struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
-	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	Ports         []*PortMapping         `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // e.g., "validator0", "account1"
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"` // "validator" or "account"
+	Index         int32                  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`                         // Bech32 account address
+	EvmAddress    string                 `protobuf:"bytes,5,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"` // 0x address (EVM chains only)
+	Mnemonic      string                 `protobuf:"bytes,6,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	HdPath        string                 `protobuf:"bytes,7,opt,name=hd_path,json=hdPath,proto3" json:"hd_path,omitempty"`
+	PrivateKey    string                 `protobuf:"bytes,8,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"` // 0x-prefixed hex
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNodePortsResponse) Reset() {
-	*x = GetNodePortsResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[47]
+func (x *AccountKey) Reset() {
+	*x = AccountKey{}
+	mi := &file_v1_devnet_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNodePortsResponse) String() string {
+func (x *AccountKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodePortsResponse) ProtoMessage() {}
+func (*AccountKey) ProtoMessage() {}
 
-func (x *GetNodePortsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[47]
+func (x *AccountKey) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3123,57 +3289,92 @@ func (x *GetNodePortsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodePortsResponse.ProtoReflect.Descriptor instead.
-func (*GetNodePortsResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use AccountKey.ProtoReflect.Descriptor instead.
+func (*AccountKey) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetNodePortsResponse) GetDevnetName() string {
+func (x *AccountKey) GetName() string {
 	if x != nil {
-		return x.DevnetName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetNodePortsResponse) GetIndex() int32 {
+func (x *AccountKey) GetRole() string {
 	if x != nil {
-		return x.Index
+		return x.Role
 	}
-	return 0
+	return ""
 }
 
-func (x *GetNodePortsResponse) GetPorts() []*PortMapping {
+func (x *AccountKey) GetIndex() int32 {
 	if x != nil {
-		return x.Ports
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-// Upgrade represents a chain upgrade operation.
-type Upgrade struct {
+func (x *AccountKey) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AccountKey) GetEvmAddress() string {
+	if x != nil {
+		return x.EvmAddress
+	}
+	return ""
+}
+
+func (x *AccountKey) GetMnemonic() string {
+	if x != nil {
+		return x.Mnemonic
+	}
+	return ""
+}
+
+func (x *AccountKey) GetHdPath() string {
+	if x != nil {
+		return x.HdPath
+	}
+	return ""
+}
+
+func (x *AccountKey) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+type ExportKeysResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *UpgradeMetadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Spec          *UpgradeSpec           `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
-	Status        *UpgradeStatus         `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Evm           bool                   `protobuf:"varint,2,opt,name=evm,proto3" json:"evm,omitempty"` // Keys use Ethereum derivation
+	Keys          []*AccountKey          `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	EvmChainId    int64                  `protobuf:"varint,4,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty"` // EVM chain ID (0 for non-EVM chains)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Upgrade) Reset() {
-	*x = Upgrade{}
-	mi := &file_v1_devnet_proto_msgTypes[48]
+func (x *ExportKeysResponse) Reset() {
+	*x = ExportKeysResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Upgrade) String() string {
+func (x *ExportKeysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Upgrade) ProtoMessage() {}
+func (*ExportKeysResponse) ProtoMessage() {}
 
-func (x *Upgrade) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[48]
+func (x *ExportKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3184,58 +3385,66 @@ func (x *Upgrade) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Upgrade.ProtoReflect.Descriptor instead.
-func (*Upgrade) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ExportKeysResponse.ProtoReflect.Descriptor instead.
+func (*ExportKeysResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *Upgrade) GetMetadata() *UpgradeMetadata {
+func (x *ExportKeysResponse) GetChainId() string {
 	if x != nil {
-		return x.Metadata
+		return x.ChainId
 	}
-	return nil
+	return ""
 }
 
-func (x *Upgrade) GetSpec() *UpgradeSpec {
+func (x *ExportKeysResponse) GetEvm() bool {
 	if x != nil {
-		return x.Spec
+		return x.Evm
 	}
-	return nil
+	return false
 }
 
-func (x *Upgrade) GetStatus() *UpgradeStatus {
+func (x *ExportKeysResponse) GetKeys() []*AccountKey {
 	if x != nil {
-		return x.Status
+		return x.Keys
 	}
 	return nil
 }
 
-type UpgradeMetadata struct {
+func (x *ExportKeysResponse) GetEvmChainId() int64 {
+	if x != nil {
+		return x.EvmChainId
+	}
+	return 0
+}
+
+// DiffValidatorSetsRequest compares a devnet's validator sets at two heights.
+type DiffValidatorSetsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Unique upgrade name
-	Generation    int64                  `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Namespace     string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace for isolation (defaults to "default")
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	FromHeight    int64                  `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight      int64                  `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`    // 0 for the latest height
+	NodeIndex     int32                  `protobuf:"varint,5,opt,name=node_index,json=nodeIndex,proto3" json:"node_index,omitempty"` // Node to query (default: 0)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpgradeMetadata) Reset() {
-	*x = UpgradeMetadata{}
-	mi := &file_v1_devnet_proto_msgTypes[49]
+func (x *DiffValidatorSetsRequest) Reset() {
+	*x = DiffValidatorSetsRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpgradeMetadata) String() string {
+func (x *DiffValidatorSetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpgradeMetadata) ProtoMessage() {}
+func (*DiffValidatorSetsRequest) ProtoMessage() {}
 
-func (x *UpgradeMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[49]
+func (x *DiffValidatorSetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3246,75 +3455,74 @@ func (x *UpgradeMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpgradeMetadata.ProtoReflect.Descriptor instead.
-func (*UpgradeMetadata) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use DiffValidatorSetsRequest.ProtoReflect.Descriptor instead.
+func (*DiffValidatorSetsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *UpgradeMetadata) GetName() string {
+func (x *DiffValidatorSetsRequest) GetDevnetName() string {
 	if x != nil {
-		return x.Name
+		return x.DevnetName
 	}
 	return ""
 }
 
-func (x *UpgradeMetadata) GetGeneration() int64 {
+func (x *DiffValidatorSetsRequest) GetNamespace() string {
 	if x != nil {
-		return x.Generation
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *UpgradeMetadata) GetCreatedAt() *timestamppb.Timestamp {
+func (x *DiffValidatorSetsRequest) GetFromHeight() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.FromHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *UpgradeMetadata) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *DiffValidatorSetsRequest) GetToHeight() int64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ToHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *UpgradeMetadata) GetNamespace() string {
+func (x *DiffValidatorSetsRequest) GetNodeIndex() int32 {
 	if x != nil {
-		return x.Namespace
+		return x.NodeIndex
 	}
-	return ""
+	return 0
 }
 
-type UpgradeSpec struct {
-	state        protoimpl.MessageState `protogen:"open.v1"`
-	DevnetRef    string                 `protobuf:"bytes,1,opt,name=devnet_ref,json=devnetRef,proto3" json:"devnet_ref,omitempty"`           // Target devnet name
-	UpgradeName  string                 `protobuf:"bytes,2,opt,name=upgrade_name,json=upgradeName,proto3" json:"upgrade_name,omitempty"`     // On-chain upgrade name
-	TargetHeight int64                  `protobuf:"varint,3,opt,name=target_height,json=targetHeight,proto3" json:"target_height,omitempty"` // Block height for upgrade (0 = auto-calculate)
-	NewBinary    *BinarySource          `protobuf:"bytes,4,opt,name=new_binary,json=newBinary,proto3" json:"new_binary,omitempty"`           // Upgraded binary source
-	WithExport   bool                   `protobuf:"varint,5,opt,name=with_export,json=withExport,proto3" json:"with_export,omitempty"`       // Enable state export before/after
-	AutoVote     bool                   `protobuf:"varint,6,opt,name=auto_vote,json=autoVote,proto3" json:"auto_vote,omitempty"`             // Auto-vote yes with all validators
-	// Field 7 reserved for future use
-	Namespace     string `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace for the devnet reference (defaults to "default")
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ValidatorSetChange is how one validator differs between two heights.
+type ValidatorSetChange struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Kind             string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // added, removed, jailed, unjailed, power
+	ConsensusAddress string                 `protobuf:"bytes,2,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	OperatorAddress  string                 `protobuf:"bytes,3,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	Moniker          string                 `protobuf:"bytes,4,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	FromPower        int64                  `protobuf:"varint,5,opt,name=from_power,json=fromPower,proto3" json:"from_power,omitempty"`
+	ToPower          int64                  `protobuf:"varint,6,opt,name=to_power,json=toPower,proto3" json:"to_power,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *UpgradeSpec) Reset() {
-	*x = UpgradeSpec{}
-	mi := &file_v1_devnet_proto_msgTypes[50]
+func (x *ValidatorSetChange) Reset() {
+	*x = ValidatorSetChange{}
+	mi := &file_v1_devnet_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpgradeSpec) String() string {
+func (x *ValidatorSetChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpgradeSpec) ProtoMessage() {}
+func (*ValidatorSetChange) ProtoMessage() {}
 
-func (x *UpgradeSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[50]
+func (x *ValidatorSetChange) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3325,85 +3533,81 @@ func (x *UpgradeSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpgradeSpec.ProtoReflect.Descriptor instead.
-func (*UpgradeSpec) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use ValidatorSetChange.ProtoReflect.Descriptor instead.
+func (*ValidatorSetChange) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *UpgradeSpec) GetDevnetRef() string {
+func (x *ValidatorSetChange) GetKind() string {
 	if x != nil {
-		return x.DevnetRef
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *UpgradeSpec) GetUpgradeName() string {
+func (x *ValidatorSetChange) GetConsensusAddress() string {
 	if x != nil {
-		return x.UpgradeName
+		return x.ConsensusAddress
 	}
 	return ""
 }
 
-func (x *UpgradeSpec) GetTargetHeight() int64 {
-	if x != nil {
-		return x.TargetHeight
-	}
-	return 0
-}
-
-func (x *UpgradeSpec) GetNewBinary() *BinarySource {
+func (x *ValidatorSetChange) GetOperatorAddress() string {
 	if x != nil {
-		return x.NewBinary
+		return x.OperatorAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *UpgradeSpec) GetWithExport() bool {
+func (x *ValidatorSetChange) GetMoniker() string {
 	if x != nil {
-		return x.WithExport
+		return x.Moniker
 	}
-	return false
+	return ""
 }
 
-func (x *UpgradeSpec) GetAutoVote() bool {
+func (x *ValidatorSetChange) GetFromPower() int64 {
 	if x != nil {
-		return x.AutoVote
+		return x.FromPower
 	}
-	return false
+	return 0
 }
 
-func (x *UpgradeSpec) GetNamespace() string {
+func (x *ValidatorSetChange) GetToPower() int64 {
 	if x != nil {
-		return x.Namespace
+		return x.ToPower
 	}
-	return ""
+	return 0
 }
 
-type BinarySource struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`       // "cache", "url", "local"
-	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // Version identifier
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`         // URL for remote binary (if type=url)
-	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`       // Local path (if type=local)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DiffValidatorSetsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FromHeight     int64                  `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight       int64                  `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`    // Resolved height when latest was requested
+	FromCount      int32                  `protobuf:"varint,3,opt,name=from_count,json=fromCount,proto3" json:"from_count,omitempty"` // Active validators at from_height
+	ToCount        int32                  `protobuf:"varint,4,opt,name=to_count,json=toCount,proto3" json:"to_count,omitempty"`
+	FromTotalPower int64                  `protobuf:"varint,5,opt,name=from_total_power,json=fromTotalPower,proto3" json:"from_total_power,omitempty"`
+	ToTotalPower   int64                  `protobuf:"varint,6,opt,name=to_total_power,json=toTotalPower,proto3" json:"to_total_power,omitempty"`
+	Changes        []*ValidatorSetChange  `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *BinarySource) Reset() {
-	*x = BinarySource{}
-	mi := &file_v1_devnet_proto_msgTypes[51]
+func (x *DiffValidatorSetsResponse) Reset() {
+	*x = DiffValidatorSetsResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BinarySource) String() string {
+func (x *DiffValidatorSetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinarySource) ProtoMessage() {}
+func (*DiffValidatorSetsResponse) ProtoMessage() {}
 
-func (x *BinarySource) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[51]
+func (x *DiffValidatorSetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3414,69 +3618,86 @@ func (x *BinarySource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinarySource.ProtoReflect.Descriptor instead.
-func (*BinarySource) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use DiffValidatorSetsResponse.ProtoReflect.Descriptor instead.
+func (*DiffValidatorSetsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *BinarySource) GetType() string {
+func (x *DiffValidatorSetsResponse) GetFromHeight() int64 {
 	if x != nil {
-		return x.Type
+		return x.FromHeight
 	}
-	return ""
+	return 0
 }
 
-func (x *BinarySource) GetVersion() string {
+func (x *DiffValidatorSetsResponse) GetToHeight() int64 {
 	if x != nil {
-		return x.Version
+		return x.ToHeight
 	}
-	return ""
+	return 0
 }
 
-func (x *BinarySource) GetUrl() string {
+func (x *DiffValidatorSetsResponse) GetFromCount() int32 {
 	if x != nil {
-		return x.Url
+		return x.FromCount
 	}
-	return ""
+	return 0
 }
 
-func (x *BinarySource) GetPath() string {
+func (x *DiffValidatorSetsResponse) GetToCount() int32 {
 	if x != nil {
-		return x.Path
+		return x.ToCount
 	}
-	return ""
+	return 0
 }
 
-type UpgradeStatus struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Phase          string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`                              // Pending, Proposing, Voting, Waiting, Switching, Verifying, Completed, Failed
-	ProposalId     uint64                 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"` // Governance proposal ID
-	VotesReceived  int32                  `protobuf:"varint,3,opt,name=votes_received,json=votesReceived,proto3" json:"votes_received,omitempty"`
-	VotesRequired  int32                  `protobuf:"varint,4,opt,name=votes_required,json=votesRequired,proto3" json:"votes_required,omitempty"`
-	CurrentHeight  int64                  `protobuf:"varint,5,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
-	PreExportPath  string                 `protobuf:"bytes,6,opt,name=pre_export_path,json=preExportPath,proto3" json:"pre_export_path,omitempty"`    // Path to pre-upgrade state export
-	PostExportPath string                 `protobuf:"bytes,7,opt,name=post_export_path,json=postExportPath,proto3" json:"post_export_path,omitempty"` // Path to post-upgrade state export
-	Message        string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
-	Error          string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"` // Error details if phase is Failed
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *DiffValidatorSetsResponse) GetFromTotalPower() int64 {
+	if x != nil {
+		return x.FromTotalPower
+	}
+	return 0
 }
 
-func (x *UpgradeStatus) Reset() {
-	*x = UpgradeStatus{}
-	mi := &file_v1_devnet_proto_msgTypes[52]
+func (x *DiffValidatorSetsResponse) GetToTotalPower() int64 {
+	if x != nil {
+		return x.ToTotalPower
+	}
+	return 0
+}
+
+func (x *DiffValidatorSetsResponse) GetChanges() []*ValidatorSetChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// ListBlocksRequest lists a devnet's most recent blocks.
+type ListBlocksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                   // Namespace (defaults to "default")
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                          // Number of blocks (default: 20, max: 100)
+	NodeIndex     int32                  `protobuf:"varint,4,opt,name=node_index,json=nodeIndex,proto3" json:"node_index,omitempty"` // Node to query (default: 0)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlocksRequest) Reset() {
+	*x = ListBlocksRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpgradeStatus) String() string {
+func (x *ListBlocksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpgradeStatus) ProtoMessage() {}
+func (*ListBlocksRequest) ProtoMessage() {}
 
-func (x *UpgradeStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[52]
+func (x *ListBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3487,99 +3708,6914 @@ func (x *UpgradeStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpgradeStatus.ProtoReflect.Descriptor instead.
-func (*UpgradeStatus) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ListBlocksRequest.ProtoReflect.Descriptor instead.
+func (*ListBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *UpgradeStatus) GetPhase() string {
+func (x *ListBlocksRequest) GetDevnetName() string {
 	if x != nil {
-		return x.Phase
+		return x.DevnetName
 	}
 	return ""
 }
 
-func (x *UpgradeStatus) GetProposalId() uint64 {
+func (x *ListBlocksRequest) GetNamespace() string {
 	if x != nil {
-		return x.ProposalId
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *UpgradeStatus) GetVotesReceived() int32 {
+func (x *ListBlocksRequest) GetLimit() int32 {
 	if x != nil {
-		return x.VotesReceived
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *UpgradeStatus) GetVotesRequired() int32 {
+func (x *ListBlocksRequest) GetNodeIndex() int32 {
 	if x != nil {
-		return x.VotesRequired
+		return x.NodeIndex
+	}
+	return 0
+}
+
+// BlockSummary is a block header with its transaction count.
+type BlockSummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Height          int64                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Time            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Hash            string                 `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	ProposerAddress string                 `protobuf:"bytes,4,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"` // Hex consensus address
+	ProposerMoniker string                 `protobuf:"bytes,5,opt,name=proposer_moniker,json=proposerMoniker,proto3" json:"proposer_moniker,omitempty"` // Empty if unknown
+	NumTxs          int32                  `protobuf:"varint,6,opt,name=num_txs,json=numTxs,proto3" json:"num_txs,omitempty"`
+	IntervalMs      int64                  `protobuf:"varint,7,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"` // Time since the previous block (0 for block 1)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BlockSummary) Reset() {
+	*x = BlockSummary{}
+	mi := &file_v1_devnet_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockSummary) ProtoMessage() {}
+
+func (x *BlockSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockSummary.ProtoReflect.Descriptor instead.
+func (*BlockSummary) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BlockSummary) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BlockSummary) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *BlockSummary) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *BlockSummary) GetProposerAddress() string {
+	if x != nil {
+		return x.ProposerAddress
+	}
+	return ""
+}
+
+func (x *BlockSummary) GetProposerMoniker() string {
+	if x != nil {
+		return x.ProposerMoniker
+	}
+	return ""
+}
+
+func (x *BlockSummary) GetNumTxs() int32 {
+	if x != nil {
+		return x.NumTxs
+	}
+	return 0
+}
+
+func (x *BlockSummary) GetIntervalMs() int64 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+type ListBlocksResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Blocks           []*BlockSummary        `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`                                                  // Newest first
+	LatestBlockAgeMs int64                  `protobuf:"varint,2,opt,name=latest_block_age_ms,json=latestBlockAgeMs,proto3" json:"latest_block_age_ms,omitempty"` // Time since the latest block, by the daemon's clock
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListBlocksResponse) Reset() {
+	*x = ListBlocksResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlocksResponse) ProtoMessage() {}
+
+func (x *ListBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlocksResponse.ProtoReflect.Descriptor instead.
+func (*ListBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListBlocksResponse) GetBlocks() []*BlockSummary {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *ListBlocksResponse) GetLatestBlockAgeMs() int64 {
+	if x != nil {
+		return x.LatestBlockAgeMs
+	}
+	return 0
+}
+
+// GetBlockRequest fetches one block with its transactions.
+type GetBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                   // Namespace (defaults to "default")
+	Height        int64                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`                        // 0 for the latest block
+	NodeIndex     int32                  `protobuf:"varint,4,opt,name=node_index,json=nodeIndex,proto3" json:"node_index,omitempty"` // Node to query (default: 0)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockRequest) Reset() {
+	*x = GetBlockRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockRequest) ProtoMessage() {}
+
+func (x *GetBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetBlockRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *GetBlockRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetBlockRequest) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetBlockRequest) GetNodeIndex() int32 {
+	if x != nil {
+		return x.NodeIndex
+	}
+	return 0
+}
+
+// BlockTx is a transaction in a block, decoded by the network plugin.
+type BlockTx struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Code          uint32                 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"` // 0 = success
+	Log           string                 `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`
+	GasWanted     int64                  `protobuf:"varint,4,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	GasUsed       int64                  `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	Messages      []*TxTraceMessage      `protobuf:"bytes,6,rep,name=messages,proto3" json:"messages,omitempty"`
+	Memo          string                 `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	Fee           string                 `protobuf:"bytes,8,opt,name=fee,proto3" json:"fee,omitempty"`
+	DecodeError   string                 `protobuf:"bytes,9,opt,name=decode_error,json=decodeError,proto3" json:"decode_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockTx) Reset() {
+	*x = BlockTx{}
+	mi := &file_v1_devnet_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockTx) ProtoMessage() {}
+
+func (x *BlockTx) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockTx.ProtoReflect.Descriptor instead.
+func (*BlockTx) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BlockTx) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *BlockTx) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BlockTx) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+func (x *BlockTx) GetGasWanted() int64 {
+	if x != nil {
+		return x.GasWanted
+	}
+	return 0
+}
+
+func (x *BlockTx) GetGasUsed() int64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *BlockTx) GetMessages() []*TxTraceMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *BlockTx) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *BlockTx) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *BlockTx) GetDecodeError() string {
+	if x != nil {
+		return x.DecodeError
+	}
+	return ""
+}
+
+type GetBlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Block         *BlockSummary          `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Txs           []*BlockTx             `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockResponse) Reset() {
+	*x = GetBlockResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockResponse) ProtoMessage() {}
+
+func (x *GetBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetBlockResponse) GetBlock() *BlockSummary {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *GetBlockResponse) GetTxs() []*BlockTx {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+// ExtendDevnetRequest is the request for ExtendDevnet.
+type ExtendDevnetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	By            string                 `protobuf:"bytes,3,opt,name=by,proto3" json:"by,omitempty"` // Duration to add (e.g., "2h"), counted from now if already expired
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendDevnetRequest) Reset() {
+	*x = ExtendDevnetRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendDevnetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendDevnetRequest) ProtoMessage() {}
+
+func (x *ExtendDevnetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendDevnetRequest.ProtoReflect.Descriptor instead.
+func (*ExtendDevnetRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ExtendDevnetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExtendDevnetRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ExtendDevnetRequest) GetBy() string {
+	if x != nil {
+		return x.By
+	}
+	return ""
+}
+
+// ExtendDevnetResponse is the response for ExtendDevnet.
+type ExtendDevnetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devnet        *Devnet                `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendDevnetResponse) Reset() {
+	*x = ExtendDevnetResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendDevnetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendDevnetResponse) ProtoMessage() {}
+
+func (x *ExtendDevnetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendDevnetResponse.ProtoReflect.Descriptor instead.
+func (*ExtendDevnetResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ExtendDevnetResponse) GetDevnet() *Devnet {
+	if x != nil {
+		return x.Devnet
+	}
+	return nil
+}
+
+type SetBlockTimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	BlockTime     string                 `protobuf:"bytes,3,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"` // Consensus timeout_commit (e.g., "500ms"); empty = back to the 1s default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBlockTimeRequest) Reset() {
+	*x = SetBlockTimeRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBlockTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBlockTimeRequest) ProtoMessage() {}
+
+func (x *SetBlockTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBlockTimeRequest.ProtoReflect.Descriptor instead.
+func (*SetBlockTimeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetBlockTimeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetBlockTimeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SetBlockTimeRequest) GetBlockTime() string {
+	if x != nil {
+		return x.BlockTime
+	}
+	return ""
+}
+
+// SetBlockTimeResponse is the response for SetBlockTime.
+type SetBlockTimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devnet        *Devnet                `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	RestartNodes  []int32                `protobuf:"varint,2,rep,packed,name=restart_nodes,json=restartNodes,proto3" json:"restart_nodes,omitempty"` // Running nodes whose config changed; they need a restart to use it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBlockTimeResponse) Reset() {
+	*x = SetBlockTimeResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBlockTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBlockTimeResponse) ProtoMessage() {}
+
+func (x *SetBlockTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBlockTimeResponse.ProtoReflect.Descriptor instead.
+func (*SetBlockTimeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetBlockTimeResponse) GetDevnet() *Devnet {
+	if x != nil {
+		return x.Devnet
+	}
+	return nil
+}
+
+func (x *SetBlockTimeResponse) GetRestartNodes() []int32 {
+	if x != nil {
+		return x.RestartNodes
+	}
+	return nil
+}
+
+type WatchDevnetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace to watch (empty = all namespaces)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchDevnetsRequest) Reset() {
+	*x = WatchDevnetsRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDevnetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDevnetsRequest) ProtoMessage() {}
+
+func (x *WatchDevnetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDevnetsRequest.ProtoReflect.Descriptor instead.
+func (*WatchDevnetsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *WatchDevnetsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// WatchDevnetsResponse is a change to a devnet or node. Exactly one of devnet
+// and node is set.
+type WatchDevnetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "ADDED", "MODIFIED" or "DELETED"
+	Devnet        *Devnet                `protobuf:"bytes,2,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	Node          *Node                  `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchDevnetsResponse) Reset() {
+	*x = WatchDevnetsResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDevnetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDevnetsResponse) ProtoMessage() {}
+
+func (x *WatchDevnetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDevnetsResponse.ProtoReflect.Descriptor instead.
+func (*WatchDevnetsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *WatchDevnetsResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WatchDevnetsResponse) GetDevnet() *Devnet {
+	if x != nil {
+		return x.Devnet
+	}
+	return nil
+}
+
+func (x *WatchDevnetsResponse) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+// ListDevnetEventsRequest queries a devnet's event history, which the daemon
+// keeps across restarts up to a per-devnet retention limit.
+type ListDevnetEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Since         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`         // Only events at or after this time
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`           // Normal or Warning (empty = all)
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`        // Most recent events (default: 100, max: 1000)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevnetEventsRequest) Reset() {
+	*x = ListDevnetEventsRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevnetEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevnetEventsRequest) ProtoMessage() {}
+
+func (x *ListDevnetEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevnetEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListDevnetEventsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListDevnetEventsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListDevnetEventsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListDevnetEventsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListDevnetEventsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListDevnetEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDevnetEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevnetEventsResponse) Reset() {
+	*x = ListDevnetEventsResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevnetEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevnetEventsResponse) ProtoMessage() {}
+
+func (x *ListDevnetEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevnetEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListDevnetEventsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListDevnetEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// GetPeerMatrixRequest inspects the peer connectivity of a running devnet.
+type GetPeerMatrixRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPeerMatrixRequest) Reset() {
+	*x = GetPeerMatrixRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPeerMatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerMatrixRequest) ProtoMessage() {}
+
+func (x *GetPeerMatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerMatrixRequest.ProtoReflect.Descriptor instead.
+func (*GetPeerMatrixRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetPeerMatrixRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *GetPeerMatrixRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetPeerMatrixResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*PeerNode            `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"` // Ordered by node index
+	Warnings      []*PeerWarning         `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPeerMatrixResponse) Reset() {
+	*x = GetPeerMatrixResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPeerMatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerMatrixResponse) ProtoMessage() {}
+
+func (x *GetPeerMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerMatrixResponse.ProtoReflect.Descriptor instead.
+func (*GetPeerMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetPeerMatrixResponse) GetNodes() []*PeerNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetPeerMatrixResponse) GetWarnings() []*PeerWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// PeerNode is one node's view of its peers.
+type PeerNode struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Index           int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                              // e.g. "validator-0"
+	NodeId          string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`                            // CometBFT node ID
+	ListenAddr      string                 `protobuf:"bytes,4,opt,name=listen_addr,json=listenAddr,proto3" json:"listen_addr,omitempty"`                // P2P listen address
+	Error           string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`                                            // Set when the node could not be queried
+	Connected       []int32                `protobuf:"varint,6,rep,packed,name=connected,proto3" json:"connected,omitempty"`                            // Indexes of devnet nodes in its net_info
+	ExternalPeers   int32                  `protobuf:"varint,7,opt,name=external_peers,json=externalPeers,proto3" json:"external_peers,omitempty"`      // Peers that are not devnet nodes
+	PersistentPeers []string               `protobuf:"bytes,8,rep,name=persistent_peers,json=persistentPeers,proto3" json:"persistent_peers,omitempty"` // persistent_peers entries in config.toml
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PeerNode) Reset() {
+	*x = PeerNode{}
+	mi := &file_v1_devnet_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerNode) ProtoMessage() {}
+
+func (x *PeerNode) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerNode.ProtoReflect.Descriptor instead.
+func (*PeerNode) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PeerNode) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PeerNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PeerNode) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *PeerNode) GetListenAddr() string {
+	if x != nil {
+		return x.ListenAddr
+	}
+	return ""
+}
+
+func (x *PeerNode) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PeerNode) GetConnected() []int32 {
+	if x != nil {
+		return x.Connected
+	}
+	return nil
+}
+
+func (x *PeerNode) GetExternalPeers() int32 {
+	if x != nil {
+		return x.ExternalPeers
+	}
+	return 0
+}
+
+func (x *PeerNode) GetPersistentPeers() []string {
+	if x != nil {
+		return x.PersistentPeers
+	}
+	return nil
+}
+
+// PeerWarning is a connectivity problem found on a node.
+type PeerWarning struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeIndex     int32                  `protobuf:"varint,1,opt,name=node_index,json=nodeIndex,proto3" json:"node_index,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerWarning) Reset() {
+	*x = PeerWarning{}
+	mi := &file_v1_devnet_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerWarning) ProtoMessage() {}
+
+func (x *PeerWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerWarning.ProtoReflect.Descriptor instead.
+func (*PeerWarning) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PeerWarning) GetNodeIndex() int32 {
+	if x != nil {
+		return x.NodeIndex
+	}
+	return 0
+}
+
+func (x *PeerWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// DiagnoseConsensusRequest diagnoses the consensus state of a running devnet.
+type DiagnoseConsensusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnoseConsensusRequest) Reset() {
+	*x = DiagnoseConsensusRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnoseConsensusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnoseConsensusRequest) ProtoMessage() {}
+
+func (x *DiagnoseConsensusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnoseConsensusRequest.ProtoReflect.Descriptor instead.
+func (*DiagnoseConsensusRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DiagnoseConsensusRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *DiagnoseConsensusRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type DiagnoseConsensusResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ObservedBy      string                 `protobuf:"bytes,1,opt,name=observed_by,json=observedBy,proto3" json:"observed_by,omitempty"` // Node whose round state is reported
+	Height          int64                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`                          // Height consensus is working on
+	Round           int32                  `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Step            string                 `protobuf:"bytes,4,opt,name=step,proto3" json:"step,omitempty"` // e.g. "Prevote"
+	LatestBlockTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=latest_block_time,json=latestBlockTime,proto3" json:"latest_block_time,omitempty"`
+	Stalled         bool                   `protobuf:"varint,6,opt,name=stalled,proto3" json:"stalled,omitempty"`                                  // No block for longer than the stall threshold
+	CompareHeight   int64                  `protobuf:"varint,7,opt,name=compare_height,json=compareHeight,proto3" json:"compare_height,omitempty"` // Height the nodes' app hashes were compared at
+	Validators      []*ConsensusValidator  `protobuf:"bytes,8,rep,name=validators,proto3" json:"validators,omitempty"`
+	Nodes           []*ConsensusNode       `protobuf:"bytes,9,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Hypotheses      []*ConsensusHypothesis `protobuf:"bytes,10,rep,name=hypotheses,proto3" json:"hypotheses,omitempty"` // Most likely first
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DiagnoseConsensusResponse) Reset() {
+	*x = DiagnoseConsensusResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnoseConsensusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnoseConsensusResponse) ProtoMessage() {}
+
+func (x *DiagnoseConsensusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnoseConsensusResponse.ProtoReflect.Descriptor instead.
+func (*DiagnoseConsensusResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DiagnoseConsensusResponse) GetObservedBy() string {
+	if x != nil {
+		return x.ObservedBy
+	}
+	return ""
+}
+
+func (x *DiagnoseConsensusResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *DiagnoseConsensusResponse) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *DiagnoseConsensusResponse) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *DiagnoseConsensusResponse) GetLatestBlockTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LatestBlockTime
+	}
+	return nil
+}
+
+func (x *DiagnoseConsensusResponse) GetStalled() bool {
+	if x != nil {
+		return x.Stalled
+	}
+	return false
+}
+
+func (x *DiagnoseConsensusResponse) GetCompareHeight() int64 {
+	if x != nil {
+		return x.CompareHeight
+	}
+	return 0
+}
+
+func (x *DiagnoseConsensusResponse) GetValidators() []*ConsensusValidator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *DiagnoseConsensusResponse) GetNodes() []*ConsensusNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *DiagnoseConsensusResponse) GetHypotheses() []*ConsensusHypothesis {
+	if x != nil {
+		return x.Hypotheses
+	}
+	return nil
+}
+
+// ConsensusValidator is a validator's participation in the current round.
+type ConsensusValidator struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Address         string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // Consensus address (hex)
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`       // Devnet node name, if it is one of the devnet's nodes
+	VotingPower     int64                  `protobuf:"varint,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	Proposer        bool                   `protobuf:"varint,4,opt,name=proposer,proto3" json:"proposer,omitempty"`                                        // Proposer of the current round
+	Prevote         string                 `protobuf:"bytes,5,opt,name=prevote,proto3" json:"prevote,omitempty"`                                           // block, nil or missing
+	Precommit       string                 `protobuf:"bytes,6,opt,name=precommit,proto3" json:"precommit,omitempty"`                                       // block, nil or missing
+	SignedLastBlock bool                   `protobuf:"varint,7,opt,name=signed_last_block,json=signedLastBlock,proto3" json:"signed_last_block,omitempty"` // Precommit is in the commit of the latest block
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConsensusValidator) Reset() {
+	*x = ConsensusValidator{}
+	mi := &file_v1_devnet_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsensusValidator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusValidator) ProtoMessage() {}
+
+func (x *ConsensusValidator) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusValidator.ProtoReflect.Descriptor instead.
+func (*ConsensusValidator) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ConsensusValidator) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ConsensusValidator) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConsensusValidator) GetVotingPower() int64 {
+	if x != nil {
+		return x.VotingPower
+	}
+	return 0
+}
+
+func (x *ConsensusValidator) GetProposer() bool {
+	if x != nil {
+		return x.Proposer
+	}
+	return false
+}
+
+func (x *ConsensusValidator) GetPrevote() string {
+	if x != nil {
+		return x.Prevote
+	}
+	return ""
+}
+
+func (x *ConsensusValidator) GetPrecommit() string {
+	if x != nil {
+		return x.Precommit
+	}
+	return ""
+}
+
+func (x *ConsensusValidator) GetSignedLastBlock() bool {
+	if x != nil {
+		return x.SignedLastBlock
+	}
+	return false
+}
+
+// ConsensusNode is a node's view of the chain.
+type ConsensusNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Height        int64                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	CatchingUp    bool                   `protobuf:"varint,4,opt,name=catching_up,json=catchingUp,proto3" json:"catching_up,omitempty"`
+	AppHash       string                 `protobuf:"bytes,5,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"` // App hash at compare_height
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`                    // Set when the node could not be queried
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsensusNode) Reset() {
+	*x = ConsensusNode{}
+	mi := &file_v1_devnet_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsensusNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusNode) ProtoMessage() {}
+
+func (x *ConsensusNode) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusNode.ProtoReflect.Descriptor instead.
+func (*ConsensusNode) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ConsensusNode) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ConsensusNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConsensusNode) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ConsensusNode) GetCatchingUp() bool {
+	if x != nil {
+		return x.CatchingUp
+	}
+	return false
+}
+
+func (x *ConsensusNode) GetAppHash() string {
+	if x != nil {
+		return x.AppHash
+	}
+	return ""
+}
+
+func (x *ConsensusNode) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// CollectDebugBundleRequest collects the diagnostics of a devnet.
+type CollectDebugBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                // Namespace (defaults to "default")
+	LogLines      int32                  `protobuf:"varint,3,opt,name=log_lines,json=logLines,proto3" json:"log_lines,omitempty"` // Lines kept from the end of each node log (default: 500)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectDebugBundleRequest) Reset() {
+	*x = CollectDebugBundleRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectDebugBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectDebugBundleRequest) ProtoMessage() {}
+
+func (x *CollectDebugBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectDebugBundleRequest.ProtoReflect.Descriptor instead.
+func (*CollectDebugBundleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CollectDebugBundleRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *CollectDebugBundleRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CollectDebugBundleRequest) GetLogLines() int32 {
+	if x != nil {
+		return x.LogLines
+	}
+	return 0
+}
+
+// BundleFile is a file of a support bundle.
+type BundleFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Path within the bundle, e.g. "nodes/validator-0/node.log"
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleFile) Reset() {
+	*x = BundleFile{}
+	mi := &file_v1_devnet_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleFile) ProtoMessage() {}
+
+func (x *BundleFile) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleFile.ProtoReflect.Descriptor instead.
+func (*BundleFile) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BundleFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BundleFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type CollectDebugBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*BundleFile          `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectDebugBundleResponse) Reset() {
+	*x = CollectDebugBundleResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectDebugBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectDebugBundleResponse) ProtoMessage() {}
+
+func (x *CollectDebugBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectDebugBundleResponse.ProtoReflect.Descriptor instead.
+func (*CollectDebugBundleResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CollectDebugBundleResponse) GetFiles() []*BundleFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+// ExportGenesisRequest exports a devnet's state as a genesis file.
+type ExportGenesisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Height        int64                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`      // Height to export; waits for it if still ahead (0 = the height the node halts at)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGenesisRequest) Reset() {
+	*x = ExportGenesisRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGenesisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGenesisRequest) ProtoMessage() {}
+
+func (x *ExportGenesisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGenesisRequest.ProtoReflect.Descriptor instead.
+func (*ExportGenesisRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ExportGenesisRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *ExportGenesisRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ExportGenesisRequest) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type ExportGenesisResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genesis       []byte                 `protobuf:"bytes,1,opt,name=genesis,proto3" json:"genesis,omitempty"`
+	ChainId       string                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Height        int64                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`                             // Height the state was exported at
+	Node          string                 `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`                                  // Node whose state was exported
+	HaltedNodes   []string               `protobuf:"bytes,5,rep,name=halted_nodes,json=haltedNodes,proto3" json:"halted_nodes,omitempty"` // Nodes stopped for the export and restarted after it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGenesisResponse) Reset() {
+	*x = ExportGenesisResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGenesisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGenesisResponse) ProtoMessage() {}
+
+func (x *ExportGenesisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGenesisResponse.ProtoReflect.Descriptor instead.
+func (*ExportGenesisResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ExportGenesisResponse) GetGenesis() []byte {
+	if x != nil {
+		return x.Genesis
+	}
+	return nil
+}
+
+func (x *ExportGenesisResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ExportGenesisResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ExportGenesisResponse) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ExportGenesisResponse) GetHaltedNodes() []string {
+	if x != nil {
+		return x.HaltedNodes
+	}
+	return nil
+}
+
+// ExportComposeRequest exports a docker-mode devnet as a compose file.
+type ExportComposeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`               // Namespace (defaults to "default")
+	HomeRoot      string                 `protobuf:"bytes,3,opt,name=home_root,json=homeRoot,proto3" json:"home_root,omitempty"` // Directory to mount node homes from, as <home_root>/<service> (default: their paths on the daemon host)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportComposeRequest) Reset() {
+	*x = ExportComposeRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportComposeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportComposeRequest) ProtoMessage() {}
+
+func (x *ExportComposeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportComposeRequest.ProtoReflect.Descriptor instead.
+func (*ExportComposeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ExportComposeRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *ExportComposeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ExportComposeRequest) GetHomeRoot() string {
+	if x != nil {
+		return x.HomeRoot
+	}
+	return ""
+}
+
+type ExportComposeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`   // docker-compose.yaml
+	Services      []string               `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"` // Service names, in node order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportComposeResponse) Reset() {
+	*x = ExportComposeResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportComposeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportComposeResponse) ProtoMessage() {}
+
+func (x *ExportComposeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportComposeResponse.ProtoReflect.Descriptor instead.
+func (*ExportComposeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ExportComposeResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportComposeResponse) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// ShapeNetworkRequest applies or clears a devnet's network shaping.
+type ShapeNetworkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Clear         bool                   `protobuf:"varint,3,opt,name=clear,proto3" json:"clear,omitempty"`        // Remove the shaping instead of applying it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShapeNetworkRequest) Reset() {
+	*x = ShapeNetworkRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShapeNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShapeNetworkRequest) ProtoMessage() {}
+
+func (x *ShapeNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShapeNetworkRequest.ProtoReflect.Descriptor instead.
+func (*ShapeNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ShapeNetworkRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *ShapeNetworkRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ShapeNetworkRequest) GetClear() bool {
+	if x != nil {
+		return x.Clear
+	}
+	return false
+}
+
+type ShapeNetworkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*ShapedNode          `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"` // In node order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShapeNetworkResponse) Reset() {
+	*x = ShapeNetworkResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShapeNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShapeNetworkResponse) ProtoMessage() {}
+
+func (x *ShapeNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShapeNetworkResponse.ProtoReflect.Descriptor instead.
+func (*ShapeNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ShapeNetworkResponse) GetNodes() []*ShapedNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+// ShapedNode is the shaping applied to a node's outgoing traffic.
+type ShapedNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Links         []string               `protobuf:"bytes,3,rep,name=links,proto3" json:"links,omitempty"` // Shaped traffic by destination region, e.g. "eu-west (2 nodes): 100ms ±20ms, 1% loss"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShapedNode) Reset() {
+	*x = ShapedNode{}
+	mi := &file_v1_devnet_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShapedNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShapedNode) ProtoMessage() {}
+
+func (x *ShapedNode) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShapedNode.ProtoReflect.Descriptor instead.
+func (*ShapedNode) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ShapedNode) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ShapedNode) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ShapedNode) GetLinks() []string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+// CheckImageRequest checks whether a devnet's image tag has moved.
+type CheckImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckImageRequest) Reset() {
+	*x = CheckImageRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckImageRequest) ProtoMessage() {}
+
+func (x *CheckImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckImageRequest.ProtoReflect.Descriptor instead.
+func (*CheckImageRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CheckImageRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *CheckImageRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type CheckImageResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Image          string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`                                         // Image as specified, e.g. "stablelabs/stabled:latest"
+	PinnedDigest   string                 `protobuf:"bytes,2,opt,name=pinned_digest,json=pinnedDigest,proto3" json:"pinned_digest,omitempty"`       // Digest the devnet's nodes run
+	UpstreamDigest string                 `protobuf:"bytes,3,opt,name=upstream_digest,json=upstreamDigest,proto3" json:"upstream_digest,omitempty"` // Digest the tag resolves to in the registry
+	Moved          bool                   `protobuf:"varint,4,opt,name=moved,proto3" json:"moved,omitempty"`                                        // The tag no longer resolves to the pinned digest
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckImageResponse) Reset() {
+	*x = CheckImageResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckImageResponse) ProtoMessage() {}
+
+func (x *CheckImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckImageResponse.ProtoReflect.Descriptor instead.
+func (*CheckImageResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CheckImageResponse) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *CheckImageResponse) GetPinnedDigest() string {
+	if x != nil {
+		return x.PinnedDigest
+	}
+	return ""
+}
+
+func (x *CheckImageResponse) GetUpstreamDigest() string {
+	if x != nil {
+		return x.UpstreamDigest
+	}
+	return ""
+}
+
+func (x *CheckImageResponse) GetMoved() bool {
+	if x != nil {
+		return x.Moved
+	}
+	return false
+}
+
+// PruneNodesRequest prunes one node of a devnet, or all of them.
+type PruneNodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                // Namespace (defaults to "default")
+	Index         int32                  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`                       // Node to prune
+	AllNodes      bool                   `protobuf:"varint,4,opt,name=all_nodes,json=allNodes,proto3" json:"all_nodes,omitempty"` // Prune every node, one at a time, instead of index
+	Strategy      string                 `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`                  // Strategy passed to the binary's prune command (default: "everything")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PruneNodesRequest) Reset() {
+	*x = PruneNodesRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PruneNodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneNodesRequest) ProtoMessage() {}
+
+func (x *PruneNodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneNodesRequest.ProtoReflect.Descriptor instead.
+func (*PruneNodesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *PruneNodesRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *PruneNodesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PruneNodesRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PruneNodesRequest) GetAllNodes() bool {
+	if x != nil {
+		return x.AllNodes
+	}
+	return false
+}
+
+func (x *PruneNodesRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+type PruneNodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*PrunedNode          `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PruneNodesResponse) Reset() {
+	*x = PruneNodesResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PruneNodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneNodesResponse) ProtoMessage() {}
+
+func (x *PruneNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneNodesResponse.ProtoReflect.Descriptor instead.
+func (*PruneNodesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *PruneNodesResponse) GetNodes() []*PrunedNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+// PrunedNode reports the disk a pruned node's data directory used.
+type PrunedNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	BytesBefore   int64                  `protobuf:"varint,2,opt,name=bytes_before,json=bytesBefore,proto3" json:"bytes_before,omitempty"`
+	BytesAfter    int64                  `protobuf:"varint,3,opt,name=bytes_after,json=bytesAfter,proto3" json:"bytes_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrunedNode) Reset() {
+	*x = PrunedNode{}
+	mi := &file_v1_devnet_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrunedNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrunedNode) ProtoMessage() {}
+
+func (x *PrunedNode) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrunedNode.ProtoReflect.Descriptor instead.
+func (*PrunedNode) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *PrunedNode) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *PrunedNode) GetBytesBefore() int64 {
+	if x != nil {
+		return x.BytesBefore
+	}
+	return 0
+}
+
+func (x *PrunedNode) GetBytesAfter() int64 {
+	if x != nil {
+		return x.BytesAfter
+	}
+	return 0
+}
+
+// SearchLogsRequest searches the logs of a devnet for a pattern.
+type SearchLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Pattern       string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`     // RE2 regular expression
+	IgnoreCase    bool                   `protobuf:"varint,4,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`                              // Only logs at or after this time, by rotated file for local-mode nodes
+	Context       int32                  `protobuf:"varint,6,opt,name=context,proto3" json:"context,omitempty"`                         // Lines shown before and after each match (max: 100)
+	MaxMatches    int32                  `protobuf:"varint,7,opt,name=max_matches,json=maxMatches,proto3" json:"max_matches,omitempty"` // Matches returned (default: 1000, max: 10000)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLogsRequest) Reset() {
+	*x = SearchLogsRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLogsRequest) ProtoMessage() {}
+
+func (x *SearchLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLogsRequest.ProtoReflect.Descriptor instead.
+func (*SearchLogsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SearchLogsRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *SearchLogsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SearchLogsRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *SearchLogsRequest) GetIgnoreCase() bool {
+	if x != nil {
+		return x.IgnoreCase
+	}
+	return false
+}
+
+func (x *SearchLogsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *SearchLogsRequest) GetContext() int32 {
+	if x != nil {
+		return x.Context
+	}
+	return 0
+}
+
+func (x *SearchLogsRequest) GetMaxMatches() int32 {
+	if x != nil {
+		return x.MaxMatches
+	}
+	return 0
+}
+
+// LogMatch is a log line matching a search, with its context.
+type LogMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // Node name, or "daemon"
+	Line          string                 `protobuf:"bytes,2,opt,name=line,proto3" json:"line,omitempty"`
+	Before        []string               `protobuf:"bytes,3,rep,name=before,proto3" json:"before,omitempty"` // Context lines before the match, oldest first
+	After         []string               `protobuf:"bytes,4,rep,name=after,proto3" json:"after,omitempty"`   // Context lines after the match
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogMatch) Reset() {
+	*x = LogMatch{}
+	mi := &file_v1_devnet_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogMatch) ProtoMessage() {}
+
+func (x *LogMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogMatch.ProtoReflect.Descriptor instead.
+func (*LogMatch) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *LogMatch) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *LogMatch) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+func (x *LogMatch) GetBefore() []string {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *LogMatch) GetAfter() []string {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type SearchLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*LogMatch            `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`      // Grouped by source: nodes by index, then the daemon log
+	Truncated     bool                   `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"` // More lines matched than max_matches
+	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`        // Logs that could not be searched
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLogsResponse) Reset() {
+	*x = SearchLogsResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLogsResponse) ProtoMessage() {}
+
+func (x *SearchLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLogsResponse.ProtoReflect.Descriptor instead.
+func (*SearchLogsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SearchLogsResponse) GetMatches() []*LogMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *SearchLogsResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *SearchLogsResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// InjectDoubleSignRequest makes a validator of a running devnet double-sign.
+// The validator is tombstoned for good.
+type InjectDoubleSignRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName     string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Namespace      string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                                  // Namespace (defaults to "default")
+	ValidatorIndex int32                  `protobuf:"varint,3,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"` // Index of the validator node
+	Confirm        bool                   `protobuf:"varint,4,opt,name=confirm,proto3" json:"confirm,omitempty"`                                     // Must be set: the validator cannot be recovered
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InjectDoubleSignRequest) Reset() {
+	*x = InjectDoubleSignRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InjectDoubleSignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InjectDoubleSignRequest) ProtoMessage() {}
+
+func (x *InjectDoubleSignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InjectDoubleSignRequest.ProtoReflect.Descriptor instead.
+func (*InjectDoubleSignRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *InjectDoubleSignRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *InjectDoubleSignRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *InjectDoubleSignRequest) GetValidatorIndex() int32 {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return 0
+}
+
+func (x *InjectDoubleSignRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+type InjectDoubleSignResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Height           int64                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`                                            // Height of the double-signed block
+	ValidatorAddress string                 `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"` // Hex consensus address of the validator
+	EvidenceHash     string                 `protobuf:"bytes,3,opt,name=evidence_hash,json=evidenceHash,proto3" json:"evidence_hash,omitempty"`             // Hash of the submitted evidence
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InjectDoubleSignResponse) Reset() {
+	*x = InjectDoubleSignResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InjectDoubleSignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InjectDoubleSignResponse) ProtoMessage() {}
+
+func (x *InjectDoubleSignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InjectDoubleSignResponse.ProtoReflect.Descriptor instead.
+func (*InjectDoubleSignResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *InjectDoubleSignResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *InjectDoubleSignResponse) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *InjectDoubleSignResponse) GetEvidenceHash() string {
+	if x != nil {
+		return x.EvidenceHash
+	}
+	return ""
+}
+
+// ExportDevnetRequest exports a devnet as a portable archive.
+type ExportDevnetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Live          bool                   `protobuf:"varint,3,opt,name=live,proto3" json:"live,omitempty"`          // Export while nodes run; their chain data may be inconsistent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDevnetRequest) Reset() {
+	*x = ExportDevnetRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDevnetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDevnetRequest) ProtoMessage() {}
+
+func (x *ExportDevnetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDevnetRequest.ProtoReflect.Descriptor instead.
+func (*ExportDevnetRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ExportDevnetRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *ExportDevnetRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ExportDevnetRequest) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+// ArchiveChunk is a piece of a devnet archive.
+type ArchiveChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveChunk) Reset() {
+	*x = ArchiveChunk{}
+	mi := &file_v1_devnet_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveChunk) ProtoMessage() {}
+
+func (x *ArchiveChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveChunk.ProtoReflect.Descriptor instead.
+func (*ArchiveChunk) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ArchiveChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ImportDevnetRequest streams a devnet archive. The name and namespace are
+// read from the first message.
+type ImportDevnetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`           // Name of the new devnet (defaults to the archived name)
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportDevnetRequest) Reset() {
+	*x = ImportDevnetRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDevnetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDevnetRequest) ProtoMessage() {}
+
+func (x *ImportDevnetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDevnetRequest.ProtoReflect.Descriptor instead.
+func (*ImportDevnetRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ImportDevnetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportDevnetRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ImportDevnetRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportDevnetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devnet        *Devnet                `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	Warnings      []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"` // Problems to fix before starting the devnet, like missing binaries
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportDevnetResponse) Reset() {
+	*x = ImportDevnetResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDevnetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDevnetResponse) ProtoMessage() {}
+
+func (x *ImportDevnetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDevnetResponse.ProtoReflect.Descriptor instead.
+func (*ImportDevnetResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ImportDevnetResponse) GetDevnet() *Devnet {
+	if x != nil {
+		return x.Devnet
+	}
+	return nil
+}
+
+func (x *ImportDevnetResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// ConsensusHypothesis is a possible cause of a stall.
+type ConsensusHypothesis struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Confidence    string                 `protobuf:"bytes,2,opt,name=confidence,proto3" json:"confidence,omitempty"` // high, medium or low
+	Evidence      []string               `protobuf:"bytes,3,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	Fixes         []string               `protobuf:"bytes,4,rep,name=fixes,proto3" json:"fixes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsensusHypothesis) Reset() {
+	*x = ConsensusHypothesis{}
+	mi := &file_v1_devnet_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsensusHypothesis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusHypothesis) ProtoMessage() {}
+
+func (x *ConsensusHypothesis) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusHypothesis.ProtoReflect.Descriptor instead.
+func (*ConsensusHypothesis) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ConsensusHypothesis) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ConsensusHypothesis) GetConfidence() string {
+	if x != nil {
+		return x.Confidence
+	}
+	return ""
+}
+
+func (x *ConsensusHypothesis) GetEvidence() []string {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *ConsensusHypothesis) GetFixes() []string {
+	if x != nil {
+		return x.Fixes
+	}
+	return nil
+}
+
+// Node represents a single blockchain node within a devnet.
+type Node struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *NodeMetadata          `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *NodeSpec              `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *NodeStatus            `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	mi := &file_v1_devnet_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *Node) GetMetadata() *NodeMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Node) GetSpec() *NodeSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Node) GetStatus() *NodeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type NodeMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                   // UUID for global lookup
+	DevnetName    string                 `protobuf:"bytes,2,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"` // Parent devnet reference
+	Index         int32                  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`                            // Index within devnet (0-based)
+	Generation    int64                  `protobuf:"varint,4,opt,name=generation,proto3" json:"generation,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Namespace     string                 `protobuf:"bytes,7,opt,name=namespace,proto3" json:"namespace,omitempty"` // Parent devnet's namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeMetadata) Reset() {
+	*x = NodeMetadata{}
+	mi := &file_v1_devnet_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeMetadata) ProtoMessage() {}
+
+func (x *NodeMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeMetadata.ProtoReflect.Descriptor instead.
+func (*NodeMetadata) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *NodeMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NodeMetadata) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *NodeMetadata) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *NodeMetadata) GetGeneration() int64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *NodeMetadata) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NodeMetadata) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *NodeMetadata) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type NodeSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"` // "validator" or "fullnode"
+	BinaryPath    string                 `protobuf:"bytes,2,opt,name=binary_path,json=binaryPath,proto3" json:"binary_path,omitempty"`
+	HomeDir       string                 `protobuf:"bytes,3,opt,name=home_dir,json=homeDir,proto3" json:"home_dir,omitempty"`
+	DesiredPhase  string                 `protobuf:"bytes,4,opt,name=desired_phase,json=desiredPhase,proto3" json:"desired_phase,omitempty"` // "Running" or "Stopped"
+	RestartPolicy NodeRestartPolicy      `protobuf:"varint,5,opt,name=restart_policy,json=restartPolicy,proto3,enum=devnetbuilder.v1.NodeRestartPolicy" json:"restart_policy,omitempty"`
+	Address       string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`                            // Node IP address (e.g., "127.0.42.1") for loopback subnet aliasing
+	Ports         *NetworkPortConfig     `protobuf:"bytes,7,opt,name=ports,proto3" json:"ports,omitempty"`                                // Ports the node is reached on, from its devnet's port layout
+	BindAddress   string                 `protobuf:"bytes,8,opt,name=bind_address,json=bindAddress,proto3" json:"bind_address,omitempty"` // Address the node listens on when it differs from address (e.g. "::")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeSpec) Reset() {
+	*x = NodeSpec{}
+	mi := &file_v1_devnet_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeSpec) ProtoMessage() {}
+
+func (x *NodeSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeSpec.ProtoReflect.Descriptor instead.
+func (*NodeSpec) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *NodeSpec) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *NodeSpec) GetBinaryPath() string {
+	if x != nil {
+		return x.BinaryPath
+	}
+	return ""
+}
+
+func (x *NodeSpec) GetHomeDir() string {
+	if x != nil {
+		return x.HomeDir
+	}
+	return ""
+}
+
+func (x *NodeSpec) GetDesiredPhase() string {
+	if x != nil {
+		return x.DesiredPhase
+	}
+	return ""
+}
+
+func (x *NodeSpec) GetRestartPolicy() NodeRestartPolicy {
+	if x != nil {
+		return x.RestartPolicy
+	}
+	return NodeRestartPolicy_NODE_RESTART_POLICY_UNSPECIFIED
+}
+
+func (x *NodeSpec) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NodeSpec) GetPorts() *NetworkPortConfig {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *NodeSpec) GetBindAddress() string {
+	if x != nil {
+		return x.BindAddress
+	}
+	return ""
+}
+
+type NodeStatus struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Phase               string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"` // Pending, Starting, Running, Stopping, Stopped, Unhealthy
+	ContainerId         string                 `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Pid                 int32                  `protobuf:"varint,3,opt,name=pid,proto3" json:"pid,omitempty"`
+	Health              *NodeHealth            `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`
+	BlockHeight         int64                  `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	PeerCount           int32                  `protobuf:"varint,6,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
+	CatchingUp          bool                   `protobuf:"varint,7,opt,name=catching_up,json=catchingUp,proto3" json:"catching_up,omitempty"`
+	RestartCount        int32                  `protobuf:"varint,8,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	Message             string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	ObservedGeneration  int64                  `protobuf:"varint,10,opt,name=observed_generation,json=observedGeneration,proto3" json:"observed_generation,omitempty"`
+	Conditions          []*Condition           `protobuf:"bytes,11,rep,name=conditions,proto3" json:"conditions,omitempty"`                                                    // Synced, PeersHealthy, DiskPressure
+	LastBlockTime       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_block_time,json=lastBlockTime,proto3" json:"last_block_time,omitempty"`                       // When the node last produced a new block
+	Version             string                 `protobuf:"bytes,13,opt,name=version,proto3" json:"version,omitempty"`                                                          // Binary version the node runs, e.g. "v22.0.0"
+	BlocksPerMinute     float64                `protobuf:"fixed64,14,opt,name=blocks_per_minute,json=blocksPerMinute,proto3" json:"blocks_per_minute,omitempty"`               // Block rate over the last 10 minutes of health checks
+	AvgBlockTimeSeconds float64                `protobuf:"fixed64,15,opt,name=avg_block_time_seconds,json=avgBlockTimeSeconds,proto3" json:"avg_block_time_seconds,omitempty"` // Average time between blocks over the same window
+	HeightLag           int64                  `protobuf:"varint,16,opt,name=height_lag,json=heightLag,proto3" json:"height_lag,omitempty"`                                    // Blocks behind the highest node of the devnet
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *NodeStatus) Reset() {
+	*x = NodeStatus{}
+	mi := &file_v1_devnet_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeStatus) ProtoMessage() {}
+
+func (x *NodeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeStatus.ProtoReflect.Descriptor instead.
+func (*NodeStatus) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *NodeStatus) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *NodeStatus) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *NodeStatus) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *NodeStatus) GetHealth() *NodeHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *NodeStatus) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *NodeStatus) GetPeerCount() int32 {
+	if x != nil {
+		return x.PeerCount
+	}
+	return 0
+}
+
+func (x *NodeStatus) GetCatchingUp() bool {
+	if x != nil {
+		return x.CatchingUp
+	}
+	return false
+}
+
+func (x *NodeStatus) GetRestartCount() int32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *NodeStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NodeStatus) GetObservedGeneration() int64 {
+	if x != nil {
+		return x.ObservedGeneration
+	}
+	return 0
+}
+
+func (x *NodeStatus) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *NodeStatus) GetLastBlockTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastBlockTime
+	}
+	return nil
+}
+
+func (x *NodeStatus) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *NodeStatus) GetBlocksPerMinute() float64 {
+	if x != nil {
+		return x.BlocksPerMinute
+	}
+	return 0
+}
+
+func (x *NodeStatus) GetAvgBlockTimeSeconds() float64 {
+	if x != nil {
+		return x.AvgBlockTimeSeconds
+	}
+	return 0
+}
+
+func (x *NodeStatus) GetHeightLag() int64 {
+	if x != nil {
+		return x.HeightLag
+	}
+	return 0
+}
+
+type NodeHealth struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Status              string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // Unknown, Healthy, Unhealthy, Degraded
+	Message             string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	LastCheck           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_check,json=lastCheck,proto3" json:"last_check,omitempty"`
+	ConsecutiveFailures int32                  `protobuf:"varint,4,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *NodeHealth) Reset() {
+	*x = NodeHealth{}
+	mi := &file_v1_devnet_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeHealth) ProtoMessage() {}
+
+func (x *NodeHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeHealth.ProtoReflect.Descriptor instead.
+func (*NodeHealth) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *NodeHealth) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NodeHealth) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NodeHealth) GetLastCheck() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCheck
+	}
+	return nil
+}
+
+func (x *NodeHealth) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+// NodeService request/response messages
+type StartNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartNodeRequest) Reset() {
+	*x = StartNodeRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartNodeRequest) ProtoMessage() {}
+
+func (x *StartNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartNodeRequest.ProtoReflect.Descriptor instead.
+func (*StartNodeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *StartNodeRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *StartNodeRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *StartNodeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type StartNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          *Node                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartNodeResponse) Reset() {
+	*x = StartNodeResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartNodeResponse) ProtoMessage() {}
+
+func (x *StartNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartNodeResponse.ProtoReflect.Descriptor instead.
+func (*StartNodeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *StartNodeResponse) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type StopNodeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName     string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Index          int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	TimeoutSeconds int32                  `protobuf:"varint,3,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // Grace period, default 30
+	Namespace      string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`                                  // Namespace (defaults to "default")
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StopNodeRequest) Reset() {
+	*x = StopNodeRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopNodeRequest) ProtoMessage() {}
+
+func (x *StopNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopNodeRequest.ProtoReflect.Descriptor instead.
+func (*StopNodeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *StopNodeRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *StopNodeRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *StopNodeRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *StopNodeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type StopNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          *Node                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopNodeResponse) Reset() {
+	*x = StopNodeResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopNodeResponse) ProtoMessage() {}
+
+func (x *StopNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopNodeResponse.ProtoReflect.Descriptor instead.
+func (*StopNodeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *StopNodeResponse) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type RestartNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestartNodeRequest) Reset() {
+	*x = RestartNodeRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestartNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartNodeRequest) ProtoMessage() {}
+
+func (x *RestartNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartNodeRequest.ProtoReflect.Descriptor instead.
+func (*RestartNodeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RestartNodeRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *RestartNodeRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RestartNodeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type RestartNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          *Node                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestartNodeResponse) Reset() {
+	*x = RestartNodeResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestartNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartNodeResponse) ProtoMessage() {}
+
+func (x *RestartNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartNodeResponse.ProtoReflect.Descriptor instead.
+func (*RestartNodeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *RestartNodeResponse) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type GetNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeRequest) Reset() {
+	*x = GetNodeRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeRequest) ProtoMessage() {}
+
+func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetNodeRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *GetNodeRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GetNodeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          *Node                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeResponse) Reset() {
+	*x = GetNodeResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeResponse) ProtoMessage() {}
+
+func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetNodeResponse) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type ListNodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"` // Required: filter by devnet
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                     // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNodesRequest) Reset() {
+	*x = ListNodesRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodesRequest) ProtoMessage() {}
+
+func (x *ListNodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodesRequest.ProtoReflect.Descriptor instead.
+func (*ListNodesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListNodesRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *ListNodesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListNodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*Node                `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNodesResponse) Reset() {
+	*x = ListNodesResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodesResponse) ProtoMessage() {}
+
+func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodesResponse.ProtoReflect.Descriptor instead.
+func (*ListNodesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListNodesResponse) GetNodes() []*Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type GetNodeHealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeHealthRequest) Reset() {
+	*x = GetNodeHealthRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeHealthRequest) ProtoMessage() {}
+
+func (x *GetNodeHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeHealthRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetNodeHealthRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *GetNodeHealthRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GetNodeHealthRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetNodeHealthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Health        *NodeHealth            `protobuf:"bytes,1,opt,name=health,proto3" json:"health,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeHealthResponse) Reset() {
+	*x = GetNodeHealthResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeHealthResponse) ProtoMessage() {}
+
+func (x *GetNodeHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeHealthResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetNodeHealthResponse) GetHealth() *NodeHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+type StreamNodeLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Follow        bool                   `protobuf:"varint,3,opt,name=follow,proto3" json:"follow,omitempty"`
+	Since         string                 `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`         // RFC3339 timestamp
+	Tail          int32                  `protobuf:"varint,5,opt,name=tail,proto3" json:"tail,omitempty"`          // Number of lines from end
+	Namespace     string                 `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNodeLogsRequest) Reset() {
+	*x = StreamNodeLogsRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNodeLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNodeLogsRequest) ProtoMessage() {}
+
+func (x *StreamNodeLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNodeLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamNodeLogsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *StreamNodeLogsRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *StreamNodeLogsRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *StreamNodeLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *StreamNodeLogsRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *StreamNodeLogsRequest) GetTail() int32 {
+	if x != nil {
+		return x.Tail
+	}
+	return 0
+}
+
+func (x *StreamNodeLogsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type StreamNodeLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Stream        string                 `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"` // "stdout" or "stderr"
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNodeLogsResponse) Reset() {
+	*x = StreamNodeLogsResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNodeLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNodeLogsResponse) ProtoMessage() {}
+
+func (x *StreamNodeLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNodeLogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamNodeLogsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *StreamNodeLogsResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *StreamNodeLogsResponse) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *StreamNodeLogsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ExecInNodeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName     string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Index          int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Command        []string               `protobuf:"bytes,3,rep,name=command,proto3" json:"command,omitempty"`
+	TimeoutSeconds int32                  `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	Namespace      string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExecInNodeRequest) Reset() {
+	*x = ExecInNodeRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecInNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecInNodeRequest) ProtoMessage() {}
+
+func (x *ExecInNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecInNodeRequest.ProtoReflect.Descriptor instead.
+func (*ExecInNodeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ExecInNodeRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *ExecInNodeRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ExecInNodeRequest) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *ExecInNodeRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ExecInNodeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ExecInNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExitCode      int32                  `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Stdout        string                 `protobuf:"bytes,2,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr        string                 `protobuf:"bytes,3,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecInNodeResponse) Reset() {
+	*x = ExecInNodeResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecInNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecInNodeResponse) ProtoMessage() {}
+
+func (x *ExecInNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecInNodeResponse.ProtoReflect.Descriptor instead.
+func (*ExecInNodeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ExecInNodeResponse) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ExecInNodeResponse) GetStdout() string {
+	if x != nil {
+		return x.Stdout
+	}
+	return ""
+}
+
+func (x *ExecInNodeResponse) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+// ApplyNodeConfigRequest patches a node's config.toml and app.toml.
+type ApplyNodeConfigRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName          string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Index               int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Overrides           string                 `protobuf:"bytes,3,opt,name=overrides,proto3" json:"overrides,omitempty"`                                                     // TOML with [config] and [app] tables
+	NoRestartIfPossible bool                   `protobuf:"varint,4,opt,name=no_restart_if_possible,json=noRestartIfPossible,proto3" json:"no_restart_if_possible,omitempty"` // Reload in place if the node supports it
+	Namespace           string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                     // Namespace (defaults to "default")
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ApplyNodeConfigRequest) Reset() {
+	*x = ApplyNodeConfigRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyNodeConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyNodeConfigRequest) ProtoMessage() {}
+
+func (x *ApplyNodeConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyNodeConfigRequest.ProtoReflect.Descriptor instead.
+func (*ApplyNodeConfigRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ApplyNodeConfigRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *ApplyNodeConfigRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ApplyNodeConfigRequest) GetOverrides() string {
+	if x != nil {
+		return x.Overrides
+	}
+	return ""
+}
+
+func (x *ApplyNodeConfigRequest) GetNoRestartIfPossible() bool {
+	if x != nil {
+		return x.NoRestartIfPossible
+	}
+	return false
+}
+
+func (x *ApplyNodeConfigRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// ConfigChange is a changed config value.
+type ConfigChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`                         // "config.toml" or "app.toml"
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`                           // Dotted key, e.g. "rpc.max_open_connections"
+	OldValue      string                 `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` // Previous TOML literal
+	NewValue      string                 `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"` // New TOML literal
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigChange) Reset() {
+	*x = ConfigChange{}
+	mi := &file_v1_devnet_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigChange) ProtoMessage() {}
+
+func (x *ConfigChange) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigChange.ProtoReflect.Descriptor instead.
+func (*ConfigChange) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ConfigChange) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *ConfigChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConfigChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ConfigChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type ApplyNodeConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How the changes were applied: "unchanged", "reloaded", "restarted", or
+	// "deferred" when the node is not running.
+	Action        string          `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Changes       []*ConfigChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	Message       string          `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyNodeConfigResponse) Reset() {
+	*x = ApplyNodeConfigResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyNodeConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyNodeConfigResponse) ProtoMessage() {}
+
+func (x *ApplyNodeConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyNodeConfigResponse.ProtoReflect.Descriptor instead.
+func (*ApplyNodeConfigResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ApplyNodeConfigResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ApplyNodeConfigResponse) GetChanges() []*ConfigChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ApplyNodeConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetNodeConfigRequest asks for a node's effective config.toml and app.toml.
+type GetNodeConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeConfigRequest) Reset() {
+	*x = GetNodeConfigRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeConfigRequest) ProtoMessage() {}
+
+func (x *GetNodeConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeConfigRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetNodeConfigRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *GetNodeConfigRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GetNodeConfigRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// NodeConfigField is an effective config value and where it came from.
+type NodeConfigField struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	File  string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`   // "config.toml" or "app.toml"
+	Key   string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`     // Dotted key, e.g. "rpc.laddr"
+	Value string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"` // TOML literal
+	// Layer that set the value: "default" (node init), "spec" (devnet
+	// defaults and profile), "plugin", "runtime" (computed per node), "user"
+	// (apply-config), or "file" when edited outside devnet-builder.
+	Source        string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Detail        string `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"` // e.g. "profile laptop"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeConfigField) Reset() {
+	*x = NodeConfigField{}
+	mi := &file_v1_devnet_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeConfigField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeConfigField) ProtoMessage() {}
+
+func (x *NodeConfigField) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeConfigField.ProtoReflect.Descriptor instead.
+func (*NodeConfigField) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *NodeConfigField) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *NodeConfigField) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *NodeConfigField) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *NodeConfigField) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *NodeConfigField) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type GetNodeConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        []*NodeConfigField     `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"` // Sorted by file and key
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeConfigResponse) Reset() {
+	*x = GetNodeConfigResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeConfigResponse) ProtoMessage() {}
+
+func (x *GetNodeConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeConfigResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GetNodeConfigResponse) GetFields() []*NodeConfigField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// SyncNodeConfigRequest compares a node's config.toml and app.toml with the
+// values devnet-builder sets and optionally restores them.
+type SyncNodeConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Apply         bool                   `protobuf:"varint,4,opt,name=apply,proto3" json:"apply,omitempty"`        // Restore drifted values, restarting a running node; false = only report
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncNodeConfigRequest) Reset() {
+	*x = SyncNodeConfigRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncNodeConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncNodeConfigRequest) ProtoMessage() {}
+
+func (x *SyncNodeConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncNodeConfigRequest.ProtoReflect.Descriptor instead.
+func (*SyncNodeConfigRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *SyncNodeConfigRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *SyncNodeConfigRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SyncNodeConfigRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SyncNodeConfigRequest) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+// ConfigDrift is a config value that differs from what its layer sets.
+type ConfigDrift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`                                        // "config.toml" or "app.toml"
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`                                          // Dotted key, e.g. "mempool.size"
+	CurrentValue  string                 `protobuf:"bytes,3,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`    // TOML literal in the file
+	ExpectedValue string                 `protobuf:"bytes,4,opt,name=expected_value,json=expectedValue,proto3" json:"expected_value,omitempty"` // TOML literal the layer sets
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`                                    // Layer that sets the value, as in NodeConfigField
+	Detail        string                 `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"`                                    // e.g. "profile laptop"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigDrift) Reset() {
+	*x = ConfigDrift{}
+	mi := &file_v1_devnet_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigDrift) ProtoMessage() {}
+
+func (x *ConfigDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigDrift.ProtoReflect.Descriptor instead.
+func (*ConfigDrift) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ConfigDrift) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *ConfigDrift) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConfigDrift) GetCurrentValue() string {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return ""
+}
+
+func (x *ConfigDrift) GetExpectedValue() string {
+	if x != nil {
+		return x.ExpectedValue
+	}
+	return ""
+}
+
+func (x *ConfigDrift) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ConfigDrift) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type SyncNodeConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "unchanged" when nothing drifted, "drifted" when drift was only
+	// reported, "restarted", or "deferred" when restored on a stopped node.
+	Action        string         `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Drift         []*ConfigDrift `protobuf:"bytes,2,rep,name=drift,proto3" json:"drift,omitempty"` // Sorted by file and key
+	Message       string         `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncNodeConfigResponse) Reset() {
+	*x = SyncNodeConfigResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncNodeConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncNodeConfigResponse) ProtoMessage() {}
+
+func (x *SyncNodeConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncNodeConfigResponse.ProtoReflect.Descriptor instead.
+func (*SyncNodeConfigResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *SyncNodeConfigResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SyncNodeConfigResponse) GetDrift() []*ConfigDrift {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+func (x *SyncNodeConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// SetNodeBinaryRequest swaps the binary (or Docker image) of one node and
+// restarts it, leaving the rest of the devnet on its version.
+type SetNodeBinaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`                     // Namespace (defaults to "default")
+	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`                         // Version to run, e.g. "v23.0.0"
+	BinaryPath    string                 `protobuf:"bytes,5,opt,name=binary_path,json=binaryPath,proto3" json:"binary_path,omitempty"` // Local binary to run instead of building version (local mode)
+	Image         string                 `protobuf:"bytes,6,opt,name=image,proto3" json:"image,omitempty"`                             // Image to run instead of the devnet's image at version (docker mode)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNodeBinaryRequest) Reset() {
+	*x = SetNodeBinaryRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNodeBinaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNodeBinaryRequest) ProtoMessage() {}
+
+func (x *SetNodeBinaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNodeBinaryRequest.ProtoReflect.Descriptor instead.
+func (*SetNodeBinaryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *SetNodeBinaryRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *SetNodeBinaryRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SetNodeBinaryRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SetNodeBinaryRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SetNodeBinaryRequest) GetBinaryPath() string {
+	if x != nil {
+		return x.BinaryPath
+	}
+	return ""
+}
+
+func (x *SetNodeBinaryRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type SetNodeBinaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          *Node                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNodeBinaryResponse) Reset() {
+	*x = SetNodeBinaryResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNodeBinaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNodeBinaryResponse) ProtoMessage() {}
+
+func (x *SetNodeBinaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNodeBinaryResponse.ProtoReflect.Descriptor instead.
+func (*SetNodeBinaryResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *SetNodeBinaryResponse) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+// PortMapping describes a single port binding between container and host.
+type PortMapping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                         // Service name: "p2p", "rpc", "rest", "grpc"
+	ContainerPort int32                  `protobuf:"varint,2,opt,name=container_port,json=containerPort,proto3" json:"container_port,omitempty"` // Port inside container (e.g., 26656)
+	HostPort      int32                  `protobuf:"varint,3,opt,name=host_port,json=hostPort,proto3" json:"host_port,omitempty"`                // Port on host (e.g., 26756 for node index 1)
+	Protocol      string                 `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`                                 // "tcp" or "udp"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortMapping) Reset() {
+	*x = PortMapping{}
+	mi := &file_v1_devnet_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortMapping) ProtoMessage() {}
+
+func (x *PortMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortMapping.ProtoReflect.Descriptor instead.
+func (*PortMapping) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *PortMapping) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PortMapping) GetContainerPort() int32 {
+	if x != nil {
+		return x.ContainerPort
+	}
+	return 0
+}
+
+func (x *PortMapping) GetHostPort() int32 {
+	if x != nil {
+		return x.HostPort
+	}
+	return 0
+}
+
+func (x *PortMapping) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+type GetNodePortsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodePortsRequest) Reset() {
+	*x = GetNodePortsRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodePortsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodePortsRequest) ProtoMessage() {}
+
+func (x *GetNodePortsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodePortsRequest.ProtoReflect.Descriptor instead.
+func (*GetNodePortsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetNodePortsRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *GetNodePortsRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type GetNodePortsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"`
+	Index         int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Ports         []*PortMapping         `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodePortsResponse) Reset() {
+	*x = GetNodePortsResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodePortsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodePortsResponse) ProtoMessage() {}
+
+func (x *GetNodePortsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodePortsResponse.ProtoReflect.Descriptor instead.
+func (*GetNodePortsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetNodePortsResponse) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *GetNodePortsResponse) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GetNodePortsResponse) GetPorts() []*PortMapping {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+// Upgrade represents a chain upgrade operation.
+type Upgrade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *UpgradeMetadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *UpgradeSpec           `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *UpgradeStatus         `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Upgrade) Reset() {
+	*x = Upgrade{}
+	mi := &file_v1_devnet_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Upgrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Upgrade) ProtoMessage() {}
+
+func (x *Upgrade) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Upgrade.ProtoReflect.Descriptor instead.
+func (*Upgrade) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *Upgrade) GetMetadata() *UpgradeMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Upgrade) GetSpec() *UpgradeSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Upgrade) GetStatus() *UpgradeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type UpgradeMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Unique upgrade name
+	Generation    int64                  `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Namespace     string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace for isolation (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeMetadata) Reset() {
+	*x = UpgradeMetadata{}
+	mi := &file_v1_devnet_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeMetadata) ProtoMessage() {}
+
+func (x *UpgradeMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeMetadata.ProtoReflect.Descriptor instead.
+func (*UpgradeMetadata) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *UpgradeMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpgradeMetadata) GetGeneration() int64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *UpgradeMetadata) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UpgradeMetadata) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *UpgradeMetadata) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type UpgradeSpec struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	DevnetRef    string                 `protobuf:"bytes,1,opt,name=devnet_ref,json=devnetRef,proto3" json:"devnet_ref,omitempty"`           // Target devnet name
+	UpgradeName  string                 `protobuf:"bytes,2,opt,name=upgrade_name,json=upgradeName,proto3" json:"upgrade_name,omitempty"`     // On-chain upgrade name
+	TargetHeight int64                  `protobuf:"varint,3,opt,name=target_height,json=targetHeight,proto3" json:"target_height,omitempty"` // Block height for upgrade (0 = auto-calculate)
+	NewBinary    *BinarySource          `protobuf:"bytes,4,opt,name=new_binary,json=newBinary,proto3" json:"new_binary,omitempty"`           // Upgraded binary source
+	WithExport   bool                   `protobuf:"varint,5,opt,name=with_export,json=withExport,proto3" json:"with_export,omitempty"`       // Enable state export before/after
+	AutoVote     bool                   `protobuf:"varint,6,opt,name=auto_vote,json=autoVote,proto3" json:"auto_vote,omitempty"`             // Auto-vote yes with all validators
+	// Field 7 reserved for future use
+	Namespace     string `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace for the devnet reference (defaults to "default")
+	Mode          string `protobuf:"bytes,9,opt,name=mode,proto3" json:"mode,omitempty"`           // "" for a governance upgrade, "fork-test" to halt, export and re-provision with the new binary
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeSpec) Reset() {
+	*x = UpgradeSpec{}
+	mi := &file_v1_devnet_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeSpec) ProtoMessage() {}
+
+func (x *UpgradeSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeSpec.ProtoReflect.Descriptor instead.
+func (*UpgradeSpec) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *UpgradeSpec) GetDevnetRef() string {
+	if x != nil {
+		return x.DevnetRef
+	}
+	return ""
+}
+
+func (x *UpgradeSpec) GetUpgradeName() string {
+	if x != nil {
+		return x.UpgradeName
+	}
+	return ""
+}
+
+func (x *UpgradeSpec) GetTargetHeight() int64 {
+	if x != nil {
+		return x.TargetHeight
+	}
+	return 0
+}
+
+func (x *UpgradeSpec) GetNewBinary() *BinarySource {
+	if x != nil {
+		return x.NewBinary
+	}
+	return nil
+}
+
+func (x *UpgradeSpec) GetWithExport() bool {
+	if x != nil {
+		return x.WithExport
+	}
+	return false
+}
+
+func (x *UpgradeSpec) GetAutoVote() bool {
+	if x != nil {
+		return x.AutoVote
+	}
+	return false
+}
+
+func (x *UpgradeSpec) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpgradeSpec) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type BinarySource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`       // "cache", "url", "local"
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // Version identifier
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`         // URL for remote binary (if type=url)
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`       // Local path (if type=local)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BinarySource) Reset() {
+	*x = BinarySource{}
+	mi := &file_v1_devnet_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BinarySource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinarySource) ProtoMessage() {}
+
+func (x *BinarySource) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinarySource.ProtoReflect.Descriptor instead.
+func (*BinarySource) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *BinarySource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BinarySource) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BinarySource) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *BinarySource) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type UpgradeStatus struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Phase           string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`                              // Pending, Proposing, Voting, Waiting, Switching, Halting, Exporting, Forking, Verifying, Completed, Failed
+	ProposalId      uint64                 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"` // Governance proposal ID
+	VotesReceived   int32                  `protobuf:"varint,3,opt,name=votes_received,json=votesReceived,proto3" json:"votes_received,omitempty"`
+	VotesRequired   int32                  `protobuf:"varint,4,opt,name=votes_required,json=votesRequired,proto3" json:"votes_required,omitempty"`
+	CurrentHeight   int64                  `protobuf:"varint,5,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	PreExportPath   string                 `protobuf:"bytes,6,opt,name=pre_export_path,json=preExportPath,proto3" json:"pre_export_path,omitempty"`    // Path to pre-upgrade state export
+	PostExportPath  string                 `protobuf:"bytes,7,opt,name=post_export_path,json=postExportPath,proto3" json:"post_export_path,omitempty"` // Path to post-upgrade state export
+	Message         string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	Error           string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`                                                // Error details if phase is Failed
+	ForkDevnetRef   string                 `protobuf:"bytes,10,opt,name=fork_devnet_ref,json=forkDevnetRef,proto3" json:"fork_devnet_ref,omitempty"`        // Devnet provisioned from the export (fork-test mode)
+	ForkStartHeight int64                  `protobuf:"varint,11,opt,name=fork_start_height,json=forkStartHeight,proto3" json:"fork_start_height,omitempty"` // First height observed on the fork devnet
+	ForkHeight      int64                  `protobuf:"varint,12,opt,name=fork_height,json=forkHeight,proto3" json:"fork_height,omitempty"`                  // Latest height observed on the fork devnet
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpgradeStatus) Reset() {
+	*x = UpgradeStatus{}
+	mi := &file_v1_devnet_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeStatus) ProtoMessage() {}
+
+func (x *UpgradeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeStatus.ProtoReflect.Descriptor instead.
+func (*UpgradeStatus) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *UpgradeStatus) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *UpgradeStatus) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *UpgradeStatus) GetVotesReceived() int32 {
+	if x != nil {
+		return x.VotesReceived
+	}
+	return 0
+}
+
+func (x *UpgradeStatus) GetVotesRequired() int32 {
+	if x != nil {
+		return x.VotesRequired
+	}
+	return 0
+}
+
+func (x *UpgradeStatus) GetCurrentHeight() int64 {
+	if x != nil {
+		return x.CurrentHeight
+	}
+	return 0
+}
+
+func (x *UpgradeStatus) GetPreExportPath() string {
+	if x != nil {
+		return x.PreExportPath
+	}
+	return ""
+}
+
+func (x *UpgradeStatus) GetPostExportPath() string {
+	if x != nil {
+		return x.PostExportPath
+	}
+	return ""
+}
+
+func (x *UpgradeStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpgradeStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *UpgradeStatus) GetForkDevnetRef() string {
+	if x != nil {
+		return x.ForkDevnetRef
+	}
+	return ""
+}
+
+func (x *UpgradeStatus) GetForkStartHeight() int64 {
+	if x != nil {
+		return x.ForkStartHeight
+	}
+	return 0
+}
+
+func (x *UpgradeStatus) GetForkHeight() int64 {
+	if x != nil {
+		return x.ForkHeight
+	}
+	return 0
+}
+
+// UpgradeService request/response messages
+type CreateUpgradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Unique upgrade name
+	Spec          *UpgradeSpec           `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUpgradeRequest) Reset() {
+	*x = CreateUpgradeRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUpgradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUpgradeRequest) ProtoMessage() {}
+
+func (x *CreateUpgradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUpgradeRequest.ProtoReflect.Descriptor instead.
+func (*CreateUpgradeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *CreateUpgradeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateUpgradeRequest) GetSpec() *UpgradeSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *CreateUpgradeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type CreateUpgradeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Upgrade       *Upgrade               `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUpgradeResponse) Reset() {
+	*x = CreateUpgradeResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUpgradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUpgradeResponse) ProtoMessage() {}
+
+func (x *CreateUpgradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUpgradeResponse.ProtoReflect.Descriptor instead.
+func (*CreateUpgradeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *CreateUpgradeResponse) GetUpgrade() *Upgrade {
+	if x != nil {
+		return x.Upgrade
+	}
+	return nil
+}
+
+type GetUpgradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpgradeRequest) Reset() {
+	*x = GetUpgradeRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpgradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpgradeRequest) ProtoMessage() {}
+
+func (x *GetUpgradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpgradeRequest.ProtoReflect.Descriptor instead.
+func (*GetUpgradeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetUpgradeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetUpgradeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetUpgradeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Upgrade       *Upgrade               `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpgradeResponse) Reset() {
+	*x = GetUpgradeResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpgradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpgradeResponse) ProtoMessage() {}
+
+func (x *GetUpgradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpgradeResponse.ProtoReflect.Descriptor instead.
+func (*GetUpgradeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GetUpgradeResponse) GetUpgrade() *Upgrade {
+	if x != nil {
+		return x.Upgrade
+	}
+	return nil
+}
+
+type ListUpgradesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"` // Optional: filter by devnet
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                     // Namespace (defaults to "default", empty = all namespaces)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpgradesRequest) Reset() {
+	*x = ListUpgradesRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpgradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpgradesRequest) ProtoMessage() {}
+
+func (x *ListUpgradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpgradesRequest.ProtoReflect.Descriptor instead.
+func (*ListUpgradesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListUpgradesRequest) GetDevnetName() string {
+	if x != nil {
+		return x.DevnetName
+	}
+	return ""
+}
+
+func (x *ListUpgradesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListUpgradesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Upgrades      []*Upgrade             `protobuf:"bytes,1,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpgradesResponse) Reset() {
+	*x = ListUpgradesResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpgradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpgradesResponse) ProtoMessage() {}
+
+func (x *ListUpgradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpgradesResponse.ProtoReflect.Descriptor instead.
+func (*ListUpgradesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ListUpgradesResponse) GetUpgrades() []*Upgrade {
+	if x != nil {
+		return x.Upgrades
+	}
+	return nil
+}
+
+type DeleteUpgradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUpgradeRequest) Reset() {
+	*x = DeleteUpgradeRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUpgradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUpgradeRequest) ProtoMessage() {}
+
+func (x *DeleteUpgradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUpgradeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUpgradeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *DeleteUpgradeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteUpgradeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type DeleteUpgradeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUpgradeResponse) Reset() {
+	*x = DeleteUpgradeResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUpgradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUpgradeResponse) ProtoMessage() {}
+
+func (x *DeleteUpgradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUpgradeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUpgradeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *DeleteUpgradeResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type CancelUpgradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelUpgradeRequest) Reset() {
+	*x = CancelUpgradeRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelUpgradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelUpgradeRequest) ProtoMessage() {}
+
+func (x *CancelUpgradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelUpgradeRequest.ProtoReflect.Descriptor instead.
+func (*CancelUpgradeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *CancelUpgradeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CancelUpgradeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type CancelUpgradeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Upgrade       *Upgrade               `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelUpgradeResponse) Reset() {
+	*x = CancelUpgradeResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelUpgradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelUpgradeResponse) ProtoMessage() {}
+
+func (x *CancelUpgradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelUpgradeResponse.ProtoReflect.Descriptor instead.
+func (*CancelUpgradeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *CancelUpgradeResponse) GetUpgrade() *Upgrade {
+	if x != nil {
+		return x.Upgrade
+	}
+	return nil
+}
+
+type RetryUpgradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryUpgradeRequest) Reset() {
+	*x = RetryUpgradeRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryUpgradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryUpgradeRequest) ProtoMessage() {}
+
+func (x *RetryUpgradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryUpgradeRequest.ProtoReflect.Descriptor instead.
+func (*RetryUpgradeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *RetryUpgradeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RetryUpgradeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type RetryUpgradeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Upgrade       *Upgrade               `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryUpgradeResponse) Reset() {
+	*x = RetryUpgradeResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryUpgradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryUpgradeResponse) ProtoMessage() {}
+
+func (x *RetryUpgradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryUpgradeResponse.ProtoReflect.Descriptor instead.
+func (*RetryUpgradeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *RetryUpgradeResponse) GetUpgrade() *Upgrade {
+	if x != nil {
+		return x.Upgrade
+	}
+	return nil
+}
+
+type GetUpgradeReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpgradeReportRequest) Reset() {
+	*x = GetUpgradeReportRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpgradeReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpgradeReportRequest) ProtoMessage() {}
+
+func (x *GetUpgradeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpgradeReportRequest.ProtoReflect.Descriptor instead.
+func (*GetUpgradeReportRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *GetUpgradeReportRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetUpgradeReportRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// GetUpgradeReportResponse carries the rehearsal report written when an
+// upgrade finished.
+type GetUpgradeReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Json          []byte                 `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`         // Report as JSON
+	Markdown      string                 `protobuf:"bytes,2,opt,name=markdown,proto3" json:"markdown,omitempty"` // Report rendered as markdown
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`         // Path of the JSON report on the daemon host
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpgradeReportResponse) Reset() {
+	*x = GetUpgradeReportResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpgradeReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpgradeReportResponse) ProtoMessage() {}
+
+func (x *GetUpgradeReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpgradeReportResponse.ProtoReflect.Descriptor instead.
+func (*GetUpgradeReportResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetUpgradeReportResponse) GetJson() []byte {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+func (x *GetUpgradeReportResponse) GetMarkdown() string {
+	if x != nil {
+		return x.Markdown
+	}
+	return ""
+}
+
+func (x *GetUpgradeReportResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// ListNetworksRequest is the request message for ListNetworks.
+type ListNetworksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNetworksRequest) Reset() {
+	*x = ListNetworksRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworksRequest) ProtoMessage() {}
+
+func (x *ListNetworksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworksRequest.ProtoReflect.Descriptor instead.
+func (*ListNetworksRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{150}
+}
+
+// ListNetworksResponse is the response message for ListNetworks.
+type ListNetworksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Networks      []*NetworkSummary      `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNetworksResponse) Reset() {
+	*x = ListNetworksResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworksResponse) ProtoMessage() {}
+
+func (x *ListNetworksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworksResponse.ProtoReflect.Descriptor instead.
+func (*ListNetworksResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *ListNetworksResponse) GetNetworks() []*NetworkSummary {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+// NetworkSummary provides a brief overview of a network module.
+type NetworkSummary struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                               // Unique identifier (e.g., "stable", "cosmos")
+	DisplayName          string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`                              // Human-readable name
+	Version              string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`                                                         // Module version
+	BinaryName           string                 `protobuf:"bytes,4,opt,name=binary_name,json=binaryName,proto3" json:"binary_name,omitempty"`                                 // CLI binary name (e.g., "stabled")
+	AvailableNetworks    []string               `protobuf:"bytes,5,rep,name=available_networks,json=availableNetworks,proto3" json:"available_networks,omitempty"`            // Supported network types (e.g., ["mainnet", "testnet"])
+	DefaultBinaryVersion string                 `protobuf:"bytes,6,opt,name=default_binary_version,json=defaultBinaryVersion,proto3" json:"default_binary_version,omitempty"` // Default binary version
+	ProtocolVersion      int32                  `protobuf:"varint,7,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`                 // Plugin protocol version (0 for built-in modules)
+	Capabilities         []string               `protobuf:"bytes,8,rep,name=capabilities,proto3" json:"capabilities,omitempty"`                                               // Plugin capabilities (e.g., "gov-params", "evm")
+	CapabilitiesKnown    bool                   `protobuf:"varint,9,opt,name=capabilities_known,json=capabilitiesKnown,proto3" json:"capabilities_known,omitempty"`           // False for plugins that predate capability negotiation
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *NetworkSummary) Reset() {
+	*x = NetworkSummary{}
+	mi := &file_v1_devnet_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkSummary) ProtoMessage() {}
+
+func (x *NetworkSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkSummary.ProtoReflect.Descriptor instead.
+func (*NetworkSummary) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *NetworkSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkSummary) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *NetworkSummary) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *NetworkSummary) GetBinaryName() string {
+	if x != nil {
+		return x.BinaryName
+	}
+	return ""
+}
+
+func (x *NetworkSummary) GetAvailableNetworks() []string {
+	if x != nil {
+		return x.AvailableNetworks
+	}
+	return nil
+}
+
+func (x *NetworkSummary) GetDefaultBinaryVersion() string {
+	if x != nil {
+		return x.DefaultBinaryVersion
+	}
+	return ""
+}
+
+func (x *NetworkSummary) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *NetworkSummary) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *NetworkSummary) GetCapabilitiesKnown() bool {
+	if x != nil {
+		return x.CapabilitiesKnown
+	}
+	return false
+}
+
+// GetNetworkInfoRequest is the request message for GetNetworkInfo.
+type GetNetworkInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Network module name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNetworkInfoRequest) Reset() {
+	*x = GetNetworkInfoRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetworkInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworkInfoRequest) ProtoMessage() {}
+
+func (x *GetNetworkInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworkInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetNetworkInfoRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *GetNetworkInfoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetNetworkInfoResponse is the response message for GetNetworkInfo.
+type GetNetworkInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       *NetworkInfo           `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNetworkInfoResponse) Reset() {
+	*x = GetNetworkInfoResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetworkInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworkInfoResponse) ProtoMessage() {}
+
+func (x *GetNetworkInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworkInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetNetworkInfoResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *GetNetworkInfoResponse) GetNetwork() *NetworkInfo {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+// NetworkInfo provides detailed information about a network module.
+type NetworkInfo struct {
+	state                protoimpl.MessageState   `protogen:"open.v1"`
+	Name                 string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                      // Unique identifier
+	DisplayName          string                   `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`                                                     // Human-readable name
+	Version              string                   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`                                                                                // Module version
+	BinaryName           string                   `protobuf:"bytes,4,opt,name=binary_name,json=binaryName,proto3" json:"binary_name,omitempty"`                                                        // CLI binary name
+	DefaultBinaryVersion string                   `protobuf:"bytes,5,opt,name=default_binary_version,json=defaultBinaryVersion,proto3" json:"default_binary_version,omitempty"`                        // Default binary version
+	BinarySource         *NetworkBinarySource     `protobuf:"bytes,6,opt,name=binary_source,json=binarySource,proto3" json:"binary_source,omitempty"`                                                  // Binary source configuration
+	Bech32Prefix         string                   `protobuf:"bytes,7,opt,name=bech32_prefix,json=bech32Prefix,proto3" json:"bech32_prefix,omitempty"`                                                  // Address prefix (e.g., "stable", "cosmos")
+	BaseDenom            string                   `protobuf:"bytes,8,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`                                                           // Base token denom (e.g., "ustable", "uatom")
+	DefaultChainId       string                   `protobuf:"bytes,9,opt,name=default_chain_id,json=defaultChainId,proto3" json:"default_chain_id,omitempty"`                                          // Default chain ID for devnets
+	Endpoints            map[string]*EndpointInfo `protobuf:"bytes,10,rep,name=endpoints,proto3" json:"endpoints,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Network endpoints by network type
+	DockerImage          string                   `protobuf:"bytes,11,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`                                                    // Docker image name
+	DockerHomeDir        string                   `protobuf:"bytes,12,opt,name=docker_home_dir,json=dockerHomeDir,proto3" json:"docker_home_dir,omitempty"`                                            // Home directory inside Docker
+	DefaultPorts         *NetworkPortConfig       `protobuf:"bytes,13,opt,name=default_ports,json=defaultPorts,proto3" json:"default_ports,omitempty"`                                                 // Default port configuration
+	CallStats            []*PluginMethodStats     `protobuf:"bytes,14,rep,name=call_stats,json=callStats,proto3" json:"call_stats,omitempty"`                                                          // Plugin RPC call counts, if loaded from a plugin
+	ProtocolVersion      int32                    `protobuf:"varint,15,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`                                       // Plugin protocol version (0 for built-in modules)
+	Capabilities         []string                 `protobuf:"bytes,16,rep,name=capabilities,proto3" json:"capabilities,omitempty"`                                                                     // Plugin capabilities (e.g., "gov-params", "evm")
+	CapabilitiesKnown    bool                     `protobuf:"varint,17,opt,name=capabilities_known,json=capabilitiesKnown,proto3" json:"capabilities_known,omitempty"`                                 // False for plugins that predate capability negotiation
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *NetworkInfo) Reset() {
+	*x = NetworkInfo{}
+	mi := &file_v1_devnet_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInfo) ProtoMessage() {}
+
+func (x *NetworkInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInfo.ProtoReflect.Descriptor instead.
+func (*NetworkInfo) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *NetworkInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetBinaryName() string {
+	if x != nil {
+		return x.BinaryName
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetDefaultBinaryVersion() string {
+	if x != nil {
+		return x.DefaultBinaryVersion
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetBinarySource() *NetworkBinarySource {
+	if x != nil {
+		return x.BinarySource
+	}
+	return nil
+}
+
+func (x *NetworkInfo) GetBech32Prefix() string {
+	if x != nil {
+		return x.Bech32Prefix
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetDefaultChainId() string {
+	if x != nil {
+		return x.DefaultChainId
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetEndpoints() map[string]*EndpointInfo {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+func (x *NetworkInfo) GetDockerImage() string {
+	if x != nil {
+		return x.DockerImage
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetDockerHomeDir() string {
+	if x != nil {
+		return x.DockerHomeDir
 	}
-	return 0
+	return ""
 }
 
-func (x *UpgradeStatus) GetCurrentHeight() int64 {
+func (x *NetworkInfo) GetDefaultPorts() *NetworkPortConfig {
 	if x != nil {
-		return x.CurrentHeight
+		return x.DefaultPorts
 	}
-	return 0
+	return nil
 }
 
-func (x *UpgradeStatus) GetPreExportPath() string {
+func (x *NetworkInfo) GetCallStats() []*PluginMethodStats {
 	if x != nil {
-		return x.PreExportPath
+		return x.CallStats
 	}
-	return ""
+	return nil
 }
 
-func (x *UpgradeStatus) GetPostExportPath() string {
+func (x *NetworkInfo) GetProtocolVersion() int32 {
 	if x != nil {
-		return x.PostExportPath
+		return x.ProtocolVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *UpgradeStatus) GetMessage() string {
+func (x *NetworkInfo) GetCapabilities() []string {
 	if x != nil {
-		return x.Message
+		return x.Capabilities
 	}
-	return ""
+	return nil
 }
 
-func (x *UpgradeStatus) GetError() string {
+func (x *NetworkInfo) GetCapabilitiesKnown() bool {
 	if x != nil {
-		return x.Error
+		return x.CapabilitiesKnown
 	}
-	return ""
+	return false
 }
 
-// UpgradeService request/response messages
-type CreateUpgradeRequest struct {
+// PluginMethodStats counts calls to one plugin RPC delegation method since
+// the plugin was loaded.
+type PluginMethodStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Unique upgrade name
-	Spec          *UpgradeSpec           `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
-	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`                // RPC method (e.g., "GetBlockHeight")
+	Calls         uint64                 `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`                 // Total calls
+	Failures      uint64                 `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`           // Calls that failed or returned an error field
+	Unimplemented uint64                 `protobuf:"varint,4,opt,name=unimplemented,proto3" json:"unimplemented,omitempty"` // Calls the plugin does not implement
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUpgradeRequest) Reset() {
-	*x = CreateUpgradeRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[53]
+func (x *PluginMethodStats) Reset() {
+	*x = PluginMethodStats{}
+	mi := &file_v1_devnet_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUpgradeRequest) String() string {
+func (x *PluginMethodStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUpgradeRequest) ProtoMessage() {}
+func (*PluginMethodStats) ProtoMessage() {}
 
-func (x *CreateUpgradeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[53]
+func (x *PluginMethodStats) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3590,54 +10626,65 @@ func (x *CreateUpgradeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUpgradeRequest.ProtoReflect.Descriptor instead.
-func (*CreateUpgradeRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use PluginMethodStats.ProtoReflect.Descriptor instead.
+func (*PluginMethodStats) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *CreateUpgradeRequest) GetName() string {
+func (x *PluginMethodStats) GetMethod() string {
 	if x != nil {
-		return x.Name
+		return x.Method
 	}
 	return ""
 }
 
-func (x *CreateUpgradeRequest) GetSpec() *UpgradeSpec {
+func (x *PluginMethodStats) GetCalls() uint64 {
 	if x != nil {
-		return x.Spec
+		return x.Calls
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateUpgradeRequest) GetNamespace() string {
+func (x *PluginMethodStats) GetFailures() uint64 {
 	if x != nil {
-		return x.Namespace
+		return x.Failures
 	}
-	return ""
+	return 0
 }
 
-type CreateUpgradeResponse struct {
+func (x *PluginMethodStats) GetUnimplemented() uint64 {
+	if x != nil {
+		return x.Unimplemented
+	}
+	return 0
+}
+
+// NetworkBinarySource describes how to acquire the network binary.
+type NetworkBinarySource struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Upgrade       *Upgrade               `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                            // "github" or "local"
+	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`                          // GitHub owner (if type="github")
+	Repo          string                 `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`                            // GitHub repo (if type="github")
+	LocalPath     string                 `protobuf:"bytes,4,opt,name=local_path,json=localPath,proto3" json:"local_path,omitempty"` // Local path (if type="local")
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUpgradeResponse) Reset() {
-	*x = CreateUpgradeResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[54]
+func (x *NetworkBinarySource) Reset() {
+	*x = NetworkBinarySource{}
+	mi := &file_v1_devnet_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUpgradeResponse) String() string {
+func (x *NetworkBinarySource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUpgradeResponse) ProtoMessage() {}
+func (*NetworkBinarySource) ProtoMessage() {}
 
-func (x *CreateUpgradeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[54]
+func (x *NetworkBinarySource) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3648,92 +10695,63 @@ func (x *CreateUpgradeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUpgradeResponse.ProtoReflect.Descriptor instead.
-func (*CreateUpgradeResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use NetworkBinarySource.ProtoReflect.Descriptor instead.
+func (*NetworkBinarySource) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *CreateUpgradeResponse) GetUpgrade() *Upgrade {
+func (x *NetworkBinarySource) GetType() string {
 	if x != nil {
-		return x.Upgrade
+		return x.Type
 	}
-	return nil
-}
-
-type GetUpgradeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetUpgradeRequest) Reset() {
-	*x = GetUpgradeRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[55]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetUpgradeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GetUpgradeRequest) ProtoMessage() {}
-
-func (x *GetUpgradeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[55]
+func (x *NetworkBinarySource) GetOwner() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Owner
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetUpgradeRequest.ProtoReflect.Descriptor instead.
-func (*GetUpgradeRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{55}
+	return ""
 }
 
-func (x *GetUpgradeRequest) GetName() string {
+func (x *NetworkBinarySource) GetRepo() string {
 	if x != nil {
-		return x.Name
+		return x.Repo
 	}
 	return ""
 }
 
-func (x *GetUpgradeRequest) GetNamespace() string {
+func (x *NetworkBinarySource) GetLocalPath() string {
 	if x != nil {
-		return x.Namespace
+		return x.LocalPath
 	}
 	return ""
 }
 
-type GetUpgradeResponse struct {
+// EndpointInfo contains RPC and snapshot endpoints for a network type.
+type EndpointInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Upgrade       *Upgrade               `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	RpcEndpoint   string                 `protobuf:"bytes,1,opt,name=rpc_endpoint,json=rpcEndpoint,proto3" json:"rpc_endpoint,omitempty"` // RPC endpoint URL
+	SnapshotUrl   string                 `protobuf:"bytes,2,opt,name=snapshot_url,json=snapshotUrl,proto3" json:"snapshot_url,omitempty"` // Snapshot download URL
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpgradeResponse) Reset() {
-	*x = GetUpgradeResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[56]
+func (x *EndpointInfo) Reset() {
+	*x = EndpointInfo{}
+	mi := &file_v1_devnet_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpgradeResponse) String() string {
+func (x *EndpointInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpgradeResponse) ProtoMessage() {}
+func (*EndpointInfo) ProtoMessage() {}
 
-func (x *GetUpgradeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[56]
+func (x *EndpointInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3744,41 +10762,54 @@ func (x *GetUpgradeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpgradeResponse.ProtoReflect.Descriptor instead.
-func (*GetUpgradeResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use EndpointInfo.ProtoReflect.Descriptor instead.
+func (*EndpointInfo) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *GetUpgradeResponse) GetUpgrade() *Upgrade {
+func (x *EndpointInfo) GetRpcEndpoint() string {
 	if x != nil {
-		return x.Upgrade
+		return x.RpcEndpoint
 	}
-	return nil
+	return ""
 }
 
-type ListUpgradesRequest struct {
+func (x *EndpointInfo) GetSnapshotUrl() string {
+	if x != nil {
+		return x.SnapshotUrl
+	}
+	return ""
+}
+
+// NetworkPortConfig contains default port configuration for a network.
+type NetworkPortConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DevnetName    string                 `protobuf:"bytes,1,opt,name=devnet_name,json=devnetName,proto3" json:"devnet_name,omitempty"` // Optional: filter by devnet
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                     // Namespace (defaults to "default", empty = all namespaces)
+	Rpc           int32                  `protobuf:"varint,1,opt,name=rpc,proto3" json:"rpc,omitempty"`                              // Tendermint RPC port (default: 26657)
+	P2P           int32                  `protobuf:"varint,2,opt,name=p2p,proto3" json:"p2p,omitempty"`                              // P2P port (default: 26656)
+	Grpc          int32                  `protobuf:"varint,3,opt,name=grpc,proto3" json:"grpc,omitempty"`                            // gRPC port (default: 9090)
+	GrpcWeb       int32                  `protobuf:"varint,4,opt,name=grpc_web,json=grpcWeb,proto3" json:"grpc_web,omitempty"`       // gRPC-Web port (default: 9091)
+	Api           int32                  `protobuf:"varint,5,opt,name=api,proto3" json:"api,omitempty"`                              // REST API port (default: 1317)
+	EvmRpc        int32                  `protobuf:"varint,6,opt,name=evm_rpc,json=evmRpc,proto3" json:"evm_rpc,omitempty"`          // EVM JSON-RPC port (default: 8545)
+	EvmSocket     int32                  `protobuf:"varint,7,opt,name=evm_socket,json=evmSocket,proto3" json:"evm_socket,omitempty"` // EVM WebSocket port (default: 8546)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUpgradesRequest) Reset() {
-	*x = ListUpgradesRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[57]
+func (x *NetworkPortConfig) Reset() {
+	*x = NetworkPortConfig{}
+	mi := &file_v1_devnet_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUpgradesRequest) String() string {
+func (x *NetworkPortConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUpgradesRequest) ProtoMessage() {}
+func (*NetworkPortConfig) ProtoMessage() {}
 
-func (x *ListUpgradesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[57]
+func (x *NetworkPortConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3789,92 +10820,84 @@ func (x *ListUpgradesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUpgradesRequest.ProtoReflect.Descriptor instead.
-func (*ListUpgradesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use NetworkPortConfig.ProtoReflect.Descriptor instead.
+func (*NetworkPortConfig) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *ListUpgradesRequest) GetDevnetName() string {
+func (x *NetworkPortConfig) GetRpc() int32 {
 	if x != nil {
-		return x.DevnetName
+		return x.Rpc
 	}
-	return ""
+	return 0
 }
 
-func (x *ListUpgradesRequest) GetNamespace() string {
+func (x *NetworkPortConfig) GetP2P() int32 {
 	if x != nil {
-		return x.Namespace
+		return x.P2P
 	}
-	return ""
-}
-
-type ListUpgradesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Upgrades      []*Upgrade             `protobuf:"bytes,1,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *ListUpgradesResponse) Reset() {
-	*x = ListUpgradesResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[58]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *NetworkPortConfig) GetGrpc() int32 {
+	if x != nil {
+		return x.Grpc
+	}
+	return 0
 }
 
-func (x *ListUpgradesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NetworkPortConfig) GetGrpcWeb() int32 {
+	if x != nil {
+		return x.GrpcWeb
+	}
+	return 0
 }
 
-func (*ListUpgradesResponse) ProtoMessage() {}
-
-func (x *ListUpgradesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[58]
+func (x *NetworkPortConfig) GetApi() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Api
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListUpgradesResponse.ProtoReflect.Descriptor instead.
-func (*ListUpgradesResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{58}
+func (x *NetworkPortConfig) GetEvmRpc() int32 {
+	if x != nil {
+		return x.EvmRpc
+	}
+	return 0
 }
 
-func (x *ListUpgradesResponse) GetUpgrades() []*Upgrade {
+func (x *NetworkPortConfig) GetEvmSocket() int32 {
 	if x != nil {
-		return x.Upgrades
+		return x.EvmSocket
 	}
-	return nil
+	return 0
 }
 
-type DeleteUpgradeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ListBinaryVersionsRequest is the request for ListBinaryVersions.
+type ListBinaryVersionsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	NetworkName       string                 `protobuf:"bytes,1,opt,name=network_name,json=networkName,proto3" json:"network_name,omitempty"`                    // Required: network plugin name (e.g., "stable")
+	IncludePrerelease bool                   `protobuf:"varint,2,opt,name=include_prerelease,json=includePrerelease,proto3" json:"include_prerelease,omitempty"` // Optional: include prerelease versions (default: false)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *DeleteUpgradeRequest) Reset() {
-	*x = DeleteUpgradeRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[59]
+func (x *ListBinaryVersionsRequest) Reset() {
+	*x = ListBinaryVersionsRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUpgradeRequest) String() string {
+func (x *ListBinaryVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUpgradeRequest) ProtoMessage() {}
+func (*ListBinaryVersionsRequest) ProtoMessage() {}
 
-func (x *DeleteUpgradeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[59]
+func (x *ListBinaryVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3885,47 +10908,51 @@ func (x *DeleteUpgradeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUpgradeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUpgradeRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use ListBinaryVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListBinaryVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *DeleteUpgradeRequest) GetName() string {
+func (x *ListBinaryVersionsRequest) GetNetworkName() string {
 	if x != nil {
-		return x.Name
+		return x.NetworkName
 	}
 	return ""
 }
 
-func (x *DeleteUpgradeRequest) GetNamespace() string {
+func (x *ListBinaryVersionsRequest) GetIncludePrerelease() bool {
 	if x != nil {
-		return x.Namespace
+		return x.IncludePrerelease
 	}
-	return ""
+	return false
 }
 
-type DeleteUpgradeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ListBinaryVersionsResponse is the response for ListBinaryVersions.
+type ListBinaryVersionsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NetworkName    string                 `protobuf:"bytes,1,opt,name=network_name,json=networkName,proto3" json:"network_name,omitempty"`          // Network plugin name
+	Versions       []*BinaryVersionInfo   `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`                                   // Available versions, newest first
+	DefaultVersion string                 `protobuf:"bytes,3,opt,name=default_version,json=defaultVersion,proto3" json:"default_version,omitempty"` // Default version for this network
+	SourceType     string                 `protobuf:"bytes,4,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`             // Binary source type: "github", "local"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteUpgradeResponse) Reset() {
-	*x = DeleteUpgradeResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[60]
+func (x *ListBinaryVersionsResponse) Reset() {
+	*x = ListBinaryVersionsResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUpgradeResponse) String() string {
+func (x *ListBinaryVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUpgradeResponse) ProtoMessage() {}
+func (*ListBinaryVersionsResponse) ProtoMessage() {}
 
-func (x *DeleteUpgradeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[60]
+func (x *ListBinaryVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3936,41 +10963,66 @@ func (x *DeleteUpgradeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUpgradeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUpgradeResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use ListBinaryVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListBinaryVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *DeleteUpgradeResponse) GetDeleted() bool {
+func (x *ListBinaryVersionsResponse) GetNetworkName() string {
 	if x != nil {
-		return x.Deleted
+		return x.NetworkName
 	}
-	return false
+	return ""
 }
 
-type CancelUpgradeRequest struct {
+func (x *ListBinaryVersionsResponse) GetVersions() []*BinaryVersionInfo {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ListBinaryVersionsResponse) GetDefaultVersion() string {
+	if x != nil {
+		return x.DefaultVersion
+	}
+	return ""
+}
+
+func (x *ListBinaryVersionsResponse) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+// BinaryVersionInfo describes a single binary version.
+type BinaryVersionInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`                                    // Version tag (e.g., "v1.0.0")
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                  // Release name/title
+	Prerelease    bool                   `protobuf:"varint,3,opt,name=prerelease,proto3" json:"prerelease,omitempty"`                     // Whether this is a prerelease
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"` // Publication timestamp
+	HtmlUrl       string                 `protobuf:"bytes,5,opt,name=html_url,json=htmlUrl,proto3" json:"html_url,omitempty"`             // URL to the release page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelUpgradeRequest) Reset() {
-	*x = CancelUpgradeRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[61]
+func (x *BinaryVersionInfo) Reset() {
+	*x = BinaryVersionInfo{}
+	mi := &file_v1_devnet_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelUpgradeRequest) String() string {
+func (x *BinaryVersionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelUpgradeRequest) ProtoMessage() {}
+func (*BinaryVersionInfo) ProtoMessage() {}
 
-func (x *CancelUpgradeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[61]
+func (x *BinaryVersionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3981,92 +11033,73 @@ func (x *CancelUpgradeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelUpgradeRequest.ProtoReflect.Descriptor instead.
-func (*CancelUpgradeRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use BinaryVersionInfo.ProtoReflect.Descriptor instead.
+func (*BinaryVersionInfo) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *CancelUpgradeRequest) GetName() string {
+func (x *BinaryVersionInfo) GetTag() string {
 	if x != nil {
-		return x.Name
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *CancelUpgradeRequest) GetNamespace() string {
+func (x *BinaryVersionInfo) GetName() string {
 	if x != nil {
-		return x.Namespace
+		return x.Name
 	}
 	return ""
 }
 
-type CancelUpgradeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Upgrade       *Upgrade               `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CancelUpgradeResponse) Reset() {
-	*x = CancelUpgradeResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[62]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CancelUpgradeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CancelUpgradeResponse) ProtoMessage() {}
-
-func (x *CancelUpgradeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[62]
+func (x *BinaryVersionInfo) GetPrerelease() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Prerelease
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use CancelUpgradeResponse.ProtoReflect.Descriptor instead.
-func (*CancelUpgradeResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{62}
+func (x *BinaryVersionInfo) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
 }
 
-func (x *CancelUpgradeResponse) GetUpgrade() *Upgrade {
+func (x *BinaryVersionInfo) GetHtmlUrl() string {
 	if x != nil {
-		return x.Upgrade
+		return x.HtmlUrl
 	}
-	return nil
+	return ""
 }
 
-type RetryUpgradeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// InstallPluginRequest is the request for InstallPlugin.
+type InstallPluginRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Owner           string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`                                             // Required: GitHub repository owner
+	Repo            string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`                                               // Required: GitHub repository name
+	Version         string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`                                         // Optional: release tag (default: latest release)
+	PublicKey       []byte                 `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`                    // Optional: PEM Ed25519 key; requires a signed checksum manifest
+	AllowUnverified bool                   `protobuf:"varint,5,opt,name=allow_unverified,json=allowUnverified,proto3" json:"allow_unverified,omitempty"` // Optional: install even if the release publishes no checksums
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *RetryUpgradeRequest) Reset() {
-	*x = RetryUpgradeRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[63]
+func (x *InstallPluginRequest) Reset() {
+	*x = InstallPluginRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RetryUpgradeRequest) String() string {
+func (x *InstallPluginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RetryUpgradeRequest) ProtoMessage() {}
+func (*InstallPluginRequest) ProtoMessage() {}
 
-func (x *RetryUpgradeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[63]
+func (x *InstallPluginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4077,91 +11110,75 @@ func (x *RetryUpgradeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RetryUpgradeRequest.ProtoReflect.Descriptor instead.
-func (*RetryUpgradeRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use InstallPluginRequest.ProtoReflect.Descriptor instead.
+func (*InstallPluginRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *RetryUpgradeRequest) GetName() string {
+func (x *InstallPluginRequest) GetOwner() string {
 	if x != nil {
-		return x.Name
+		return x.Owner
 	}
 	return ""
 }
 
-func (x *RetryUpgradeRequest) GetNamespace() string {
+func (x *InstallPluginRequest) GetRepo() string {
 	if x != nil {
-		return x.Namespace
+		return x.Repo
 	}
 	return ""
 }
 
-type RetryUpgradeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Upgrade       *Upgrade               `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RetryUpgradeResponse) Reset() {
-	*x = RetryUpgradeResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[64]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RetryUpgradeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RetryUpgradeResponse) ProtoMessage() {}
-
-func (x *RetryUpgradeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[64]
+func (x *InstallPluginRequest) GetVersion() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Version
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RetryUpgradeResponse.ProtoReflect.Descriptor instead.
-func (*RetryUpgradeResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{64}
+	return ""
 }
 
-func (x *RetryUpgradeResponse) GetUpgrade() *Upgrade {
+func (x *InstallPluginRequest) GetPublicKey() []byte {
 	if x != nil {
-		return x.Upgrade
+		return x.PublicKey
 	}
 	return nil
 }
 
-// ListNetworksRequest is the request message for ListNetworks.
-type ListNetworksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *InstallPluginRequest) GetAllowUnverified() bool {
+	if x != nil {
+		return x.AllowUnverified
+	}
+	return false
 }
 
-func (x *ListNetworksRequest) Reset() {
-	*x = ListNetworksRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[65]
+// InstallPluginResponse is the response for InstallPlugin.
+type InstallPluginResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                     // Network name provided by the plugin
+	Version           string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                                               // Installed release tag
+	Path              string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`                                                     // Installed binary path on the daemon host
+	Sha256            string                 `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`                                                 // SHA-256 of the downloaded release asset
+	ChecksumVerified  bool                   `protobuf:"varint,5,opt,name=checksum_verified,json=checksumVerified,proto3" json:"checksum_verified,omitempty"`    // Asset matched the published checksum
+	SignatureVerified bool                   `protobuf:"varint,6,opt,name=signature_verified,json=signatureVerified,proto3" json:"signature_verified,omitempty"` // Checksum manifest signature was verified
+	Replaced          bool                   `protobuf:"varint,7,opt,name=replaced,proto3" json:"replaced,omitempty"`                                            // A loaded plugin of the same name was reloaded
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InstallPluginResponse) Reset() {
+	*x = InstallPluginResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNetworksRequest) String() string {
+func (x *InstallPluginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNetworksRequest) ProtoMessage() {}
+func (*InstallPluginResponse) ProtoMessage() {}
 
-func (x *ListNetworksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[65]
+func (x *InstallPluginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4172,84 +11189,91 @@ func (x *ListNetworksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNetworksRequest.ProtoReflect.Descriptor instead.
-func (*ListNetworksRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use InstallPluginResponse.ProtoReflect.Descriptor instead.
+func (*InstallPluginResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{164}
 }
 
-// ListNetworksResponse is the response message for ListNetworks.
-type ListNetworksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Networks      []*NetworkSummary      `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *InstallPluginResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *ListNetworksResponse) Reset() {
-	*x = ListNetworksResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[66]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *InstallPluginResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
 }
 
-func (x *ListNetworksResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InstallPluginResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
 }
 
-func (*ListNetworksResponse) ProtoMessage() {}
+func (x *InstallPluginResponse) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
 
-func (x *ListNetworksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[66]
+func (x *InstallPluginResponse) GetChecksumVerified() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ChecksumVerified
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ListNetworksResponse.ProtoReflect.Descriptor instead.
-func (*ListNetworksResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{66}
+func (x *InstallPluginResponse) GetSignatureVerified() bool {
+	if x != nil {
+		return x.SignatureVerified
+	}
+	return false
 }
 
-func (x *ListNetworksResponse) GetNetworks() []*NetworkSummary {
+func (x *InstallPluginResponse) GetReplaced() bool {
 	if x != nil {
-		return x.Networks
+		return x.Replaced
 	}
-	return nil
+	return false
 }
 
-// NetworkSummary provides a brief overview of a network module.
-type NetworkSummary struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                               // Unique identifier (e.g., "stable", "cosmos")
-	DisplayName          string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`                              // Human-readable name
-	Version              string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`                                                         // Module version
-	BinaryName           string                 `protobuf:"bytes,4,opt,name=binary_name,json=binaryName,proto3" json:"binary_name,omitempty"`                                 // CLI binary name (e.g., "stabled")
-	AvailableNetworks    []string               `protobuf:"bytes,5,rep,name=available_networks,json=availableNetworks,proto3" json:"available_networks,omitempty"`            // Supported network types (e.g., ["mainnet", "testnet"])
-	DefaultBinaryVersion string                 `protobuf:"bytes,6,opt,name=default_binary_version,json=defaultBinaryVersion,proto3" json:"default_binary_version,omitempty"` // Default binary version
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// BuildRequest is the request for Build.
+type BuildRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NetworkName     string                 `protobuf:"bytes,1,opt,name=network_name,json=networkName,proto3" json:"network_name,omitempty"`              // Required: network plugin name (e.g., "stable")
+	GitRef          string                 `protobuf:"bytes,2,opt,name=git_ref,json=gitRef,proto3" json:"git_ref,omitempty"`                             // Branch, tag, or commit (defaults to plugin default version)
+	NetworkType     string                 `protobuf:"bytes,3,opt,name=network_type,json=networkType,proto3" json:"network_type,omitempty"`              // Network type used to select build config (e.g., "mainnet")
+	Image           bool                   `protobuf:"varint,4,opt,name=image,proto3" json:"image,omitempty"`                                            // Build a Docker image instead of a bare binary
+	Tag             string                 `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`                                                 // Image tag (defaults to dvb/<network>:<ref>-<commit>)
+	NoCache         bool                   `protobuf:"varint,6,opt,name=no_cache,json=noCache,proto3" json:"no_cache,omitempty"`                         // Force rebuild even if a cached artifact exists
+	GitRepo         string                 `protobuf:"bytes,7,opt,name=git_repo,json=gitRepo,proto3" json:"git_repo,omitempty"`                          // Override the plugin's source repository
+	ForceBuild      bool                   `protobuf:"varint,8,opt,name=force_build,json=forceBuild,proto3" json:"force_build,omitempty"`                // Compile from source even if a release binary is published
+	AllowUnverified bool                   `protobuf:"varint,9,opt,name=allow_unverified,json=allowUnverified,proto3" json:"allow_unverified,omitempty"` // Use a release binary even if the release publishes no checksum for it
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *NetworkSummary) Reset() {
-	*x = NetworkSummary{}
-	mi := &file_v1_devnet_proto_msgTypes[67]
+func (x *BuildRequest) Reset() {
+	*x = BuildRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkSummary) String() string {
+func (x *BuildRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkSummary) ProtoMessage() {}
+func (*BuildRequest) ProtoMessage() {}
 
-func (x *NetworkSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[67]
+func (x *BuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4260,76 +11284,102 @@ func (x *NetworkSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkSummary.ProtoReflect.Descriptor instead.
-func (*NetworkSummary) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use BuildRequest.ProtoReflect.Descriptor instead.
+func (*BuildRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *NetworkSummary) GetName() string {
+func (x *BuildRequest) GetNetworkName() string {
 	if x != nil {
-		return x.Name
+		return x.NetworkName
 	}
 	return ""
 }
 
-func (x *NetworkSummary) GetDisplayName() string {
+func (x *BuildRequest) GetGitRef() string {
 	if x != nil {
-		return x.DisplayName
+		return x.GitRef
 	}
 	return ""
 }
 
-func (x *NetworkSummary) GetVersion() string {
+func (x *BuildRequest) GetNetworkType() string {
 	if x != nil {
-		return x.Version
+		return x.NetworkType
 	}
 	return ""
 }
 
-func (x *NetworkSummary) GetBinaryName() string {
+func (x *BuildRequest) GetImage() bool {
 	if x != nil {
-		return x.BinaryName
+		return x.Image
+	}
+	return false
+}
+
+func (x *BuildRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *NetworkSummary) GetAvailableNetworks() []string {
+func (x *BuildRequest) GetNoCache() bool {
 	if x != nil {
-		return x.AvailableNetworks
+		return x.NoCache
 	}
-	return nil
+	return false
 }
 
-func (x *NetworkSummary) GetDefaultBinaryVersion() string {
+func (x *BuildRequest) GetGitRepo() string {
 	if x != nil {
-		return x.DefaultBinaryVersion
+		return x.GitRepo
 	}
 	return ""
 }
 
-// GetNetworkInfoRequest is the request message for GetNetworkInfo.
-type GetNetworkInfoRequest struct {
+func (x *BuildRequest) GetForceBuild() bool {
+	if x != nil {
+		return x.ForceBuild
+	}
+	return false
+}
+
+func (x *BuildRequest) GetAllowUnverified() bool {
+	if x != nil {
+		return x.AllowUnverified
+	}
+	return false
+}
+
+// BuildResponse is the response for Build.
+type BuildResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Network module name
+	BinaryPath    string                 `protobuf:"bytes,1,opt,name=binary_path,json=binaryPath,proto3" json:"binary_path,omitempty"`     // Path to the built binary (binary builds)
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`                                 // Image reference (image builds)
+	GitCommit     string                 `protobuf:"bytes,3,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`        // Resolved commit hash
+	GitRef        string                 `protobuf:"bytes,4,opt,name=git_ref,json=gitRef,proto3" json:"git_ref,omitempty"`                 // Ref that was built
+	Cached        bool                   `protobuf:"varint,5,opt,name=cached,proto3" json:"cached,omitempty"`                              // True if an existing artifact was reused
+	FromRelease   bool                   `protobuf:"varint,6,opt,name=from_release,json=fromRelease,proto3" json:"from_release,omitempty"` // True if the binary was downloaded from a published release
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNetworkInfoRequest) Reset() {
-	*x = GetNetworkInfoRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[68]
+func (x *BuildResponse) Reset() {
+	*x = BuildResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNetworkInfoRequest) String() string {
+func (x *BuildResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNetworkInfoRequest) ProtoMessage() {}
+func (*BuildResponse) ProtoMessage() {}
 
-func (x *GetNetworkInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[68]
+func (x *BuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4340,41 +11390,75 @@ func (x *GetNetworkInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNetworkInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetNetworkInfoRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use BuildResponse.ProtoReflect.Descriptor instead.
+func (*BuildResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *GetNetworkInfoRequest) GetName() string {
+func (x *BuildResponse) GetBinaryPath() string {
 	if x != nil {
-		return x.Name
+		return x.BinaryPath
 	}
 	return ""
 }
 
-// GetNetworkInfoResponse is the response message for GetNetworkInfo.
-type GetNetworkInfoResponse struct {
+func (x *BuildResponse) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *BuildResponse) GetGitCommit() string {
+	if x != nil {
+		return x.GitCommit
+	}
+	return ""
+}
+
+func (x *BuildResponse) GetGitRef() string {
+	if x != nil {
+		return x.GitRef
+	}
+	return ""
+}
+
+func (x *BuildResponse) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *BuildResponse) GetFromRelease() bool {
+	if x != nil {
+		return x.FromRelease
+	}
+	return false
+}
+
+// PingRequest is the request for Ping.
+type PingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       *NetworkInfo           `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNetworkInfoResponse) Reset() {
-	*x = GetNetworkInfoResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[69]
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNetworkInfoResponse) String() string {
+func (x *PingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNetworkInfoResponse) ProtoMessage() {}
+func (*PingRequest) ProtoMessage() {}
 
-func (x *GetNetworkInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[69]
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4385,53 +11469,34 @@ func (x *GetNetworkInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNetworkInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetNetworkInfoResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{69}
-}
-
-func (x *GetNetworkInfoResponse) GetNetwork() *NetworkInfo {
-	if x != nil {
-		return x.Network
-	}
-	return nil
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{167}
 }
 
-// NetworkInfo provides detailed information about a network module.
-type NetworkInfo struct {
-	state                protoimpl.MessageState   `protogen:"open.v1"`
-	Name                 string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                      // Unique identifier
-	DisplayName          string                   `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`                                                     // Human-readable name
-	Version              string                   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`                                                                                // Module version
-	BinaryName           string                   `protobuf:"bytes,4,opt,name=binary_name,json=binaryName,proto3" json:"binary_name,omitempty"`                                                        // CLI binary name
-	DefaultBinaryVersion string                   `protobuf:"bytes,5,opt,name=default_binary_version,json=defaultBinaryVersion,proto3" json:"default_binary_version,omitempty"`                        // Default binary version
-	BinarySource         *NetworkBinarySource     `protobuf:"bytes,6,opt,name=binary_source,json=binarySource,proto3" json:"binary_source,omitempty"`                                                  // Binary source configuration
-	Bech32Prefix         string                   `protobuf:"bytes,7,opt,name=bech32_prefix,json=bech32Prefix,proto3" json:"bech32_prefix,omitempty"`                                                  // Address prefix (e.g., "stable", "cosmos")
-	BaseDenom            string                   `protobuf:"bytes,8,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`                                                           // Base token denom (e.g., "ustable", "uatom")
-	DefaultChainId       string                   `protobuf:"bytes,9,opt,name=default_chain_id,json=defaultChainId,proto3" json:"default_chain_id,omitempty"`                                          // Default chain ID for devnets
-	Endpoints            map[string]*EndpointInfo `protobuf:"bytes,10,rep,name=endpoints,proto3" json:"endpoints,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Network endpoints by network type
-	DockerImage          string                   `protobuf:"bytes,11,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`                                                    // Docker image name
-	DockerHomeDir        string                   `protobuf:"bytes,12,opt,name=docker_home_dir,json=dockerHomeDir,proto3" json:"docker_home_dir,omitempty"`                                            // Home directory inside Docker
-	DefaultPorts         *NetworkPortConfig       `protobuf:"bytes,13,opt,name=default_ports,json=defaultPorts,proto3" json:"default_ports,omitempty"`                                                 // Default port configuration
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// PingResponse is the response for Ping.
+type PingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerVersion string                 `protobuf:"bytes,1,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"` // Server version string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkInfo) Reset() {
-	*x = NetworkInfo{}
-	mi := &file_v1_devnet_proto_msgTypes[70]
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkInfo) String() string {
+func (x *PingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkInfo) ProtoMessage() {}
+func (*PingResponse) ProtoMessage() {}
 
-func (x *NetworkInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[70]
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4442,128 +11507,132 @@ func (x *NetworkInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkInfo.ProtoReflect.Descriptor instead.
-func (*NetworkInfo) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *NetworkInfo) GetName() string {
+func (x *PingResponse) GetServerVersion() string {
 	if x != nil {
-		return x.Name
+		return x.ServerVersion
 	}
 	return ""
 }
 
-func (x *NetworkInfo) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
+// WhoAmIRequest is the request for WhoAmI.
+type WhoAmIRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkInfo) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
+func (x *WhoAmIRequest) Reset() {
+	*x = WhoAmIRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkInfo) GetBinaryName() string {
-	if x != nil {
-		return x.BinaryName
-	}
-	return ""
+func (x *WhoAmIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *NetworkInfo) GetDefaultBinaryVersion() string {
+func (*WhoAmIRequest) ProtoMessage() {}
+
+func (x *WhoAmIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[169]
 	if x != nil {
-		return x.DefaultBinaryVersion
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *NetworkInfo) GetBinarySource() *NetworkBinarySource {
-	if x != nil {
-		return x.BinarySource
-	}
-	return nil
+// Deprecated: Use WhoAmIRequest.ProtoReflect.Descriptor instead.
+func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *NetworkInfo) GetBech32Prefix() string {
-	if x != nil {
-		return x.Bech32Prefix
-	}
-	return ""
+// WhoAmIResponse is the response for WhoAmI.
+type WhoAmIResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`             // User name from API key
+	Namespaces    []string               `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"` // Allowed namespaces (["*"] = all)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkInfo) GetBaseDenom() string {
-	if x != nil {
-		return x.BaseDenom
-	}
-	return ""
+func (x *WhoAmIResponse) Reset() {
+	*x = WhoAmIResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkInfo) GetDefaultChainId() string {
-	if x != nil {
-		return x.DefaultChainId
-	}
-	return ""
+func (x *WhoAmIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *NetworkInfo) GetEndpoints() map[string]*EndpointInfo {
+func (*WhoAmIResponse) ProtoMessage() {}
+
+func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[170]
 	if x != nil {
-		return x.Endpoints
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *NetworkInfo) GetDockerImage() string {
-	if x != nil {
-		return x.DockerImage
-	}
-	return ""
+// Deprecated: Use WhoAmIResponse.ProtoReflect.Descriptor instead.
+func (*WhoAmIResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *NetworkInfo) GetDockerHomeDir() string {
+func (x *WhoAmIResponse) GetName() string {
 	if x != nil {
-		return x.DockerHomeDir
+		return x.Name
 	}
 	return ""
 }
 
-func (x *NetworkInfo) GetDefaultPorts() *NetworkPortConfig {
+func (x *WhoAmIResponse) GetNamespaces() []string {
 	if x != nil {
-		return x.DefaultPorts
+		return x.Namespaces
 	}
 	return nil
 }
 
-// NetworkBinarySource describes how to acquire the network binary.
-type NetworkBinarySource struct {
+// HandOffCredentialsRequest is the request for HandOffCredentials.
+type HandOffCredentialsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                            // "github" or "local"
-	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`                          // GitHub owner (if type="github")
-	Repo          string                 `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`                            // GitHub repo (if type="github")
-	LocalPath     string                 `protobuf:"bytes,4,opt,name=local_path,json=localPath,proto3" json:"local_path,omitempty"` // Local path (if type="local")
+	SocketPath    string                 `protobuf:"bytes,1,opt,name=socket_path,json=socketPath,proto3" json:"socket_path,omitempty"` // Unix socket the client serves credentials on
+	Nonce         string                 `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`                             // One-time secret the daemon presents to the socket
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkBinarySource) Reset() {
-	*x = NetworkBinarySource{}
-	mi := &file_v1_devnet_proto_msgTypes[71]
+func (x *HandOffCredentialsRequest) Reset() {
+	*x = HandOffCredentialsRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkBinarySource) String() string {
+func (x *HandOffCredentialsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkBinarySource) ProtoMessage() {}
+func (*HandOffCredentialsRequest) ProtoMessage() {}
 
-func (x *NetworkBinarySource) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[71]
+func (x *HandOffCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4574,63 +11643,48 @@ func (x *NetworkBinarySource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkBinarySource.ProtoReflect.Descriptor instead.
-func (*NetworkBinarySource) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{71}
-}
-
-func (x *NetworkBinarySource) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *NetworkBinarySource) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
+// Deprecated: Use HandOffCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*HandOffCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *NetworkBinarySource) GetRepo() string {
+func (x *HandOffCredentialsRequest) GetSocketPath() string {
 	if x != nil {
-		return x.Repo
+		return x.SocketPath
 	}
 	return ""
 }
 
-func (x *NetworkBinarySource) GetLocalPath() string {
+func (x *HandOffCredentialsRequest) GetNonce() string {
 	if x != nil {
-		return x.LocalPath
+		return x.Nonce
 	}
 	return ""
 }
 
-// EndpointInfo contains RPC and snapshot endpoints for a network type.
-type EndpointInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RpcEndpoint   string                 `protobuf:"bytes,1,opt,name=rpc_endpoint,json=rpcEndpoint,proto3" json:"rpc_endpoint,omitempty"` // RPC endpoint URL
-	SnapshotUrl   string                 `protobuf:"bytes,2,opt,name=snapshot_url,json=snapshotUrl,proto3" json:"snapshot_url,omitempty"` // Snapshot download URL
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// HandOffCredentialsResponse is the response for HandOffCredentials.
+type HandOffCredentialsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GithubTokenSource string                 `protobuf:"bytes,1,opt,name=github_token_source,json=githubTokenSource,proto3" json:"github_token_source,omitempty"` // Source of the token now in use
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *EndpointInfo) Reset() {
-	*x = EndpointInfo{}
-	mi := &file_v1_devnet_proto_msgTypes[72]
+func (x *HandOffCredentialsResponse) Reset() {
+	*x = HandOffCredentialsResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EndpointInfo) String() string {
+func (x *HandOffCredentialsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EndpointInfo) ProtoMessage() {}
+func (*HandOffCredentialsResponse) ProtoMessage() {}
 
-func (x *EndpointInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[72]
+func (x *HandOffCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4641,54 +11695,40 @@ func (x *EndpointInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EndpointInfo.ProtoReflect.Descriptor instead.
-func (*EndpointInfo) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{72}
-}
-
-func (x *EndpointInfo) GetRpcEndpoint() string {
-	if x != nil {
-		return x.RpcEndpoint
-	}
-	return ""
+// Deprecated: Use HandOffCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*HandOffCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *EndpointInfo) GetSnapshotUrl() string {
+func (x *HandOffCredentialsResponse) GetGithubTokenSource() string {
 	if x != nil {
-		return x.SnapshotUrl
+		return x.GithubTokenSource
 	}
 	return ""
 }
 
-// NetworkPortConfig contains default port configuration for a network.
-type NetworkPortConfig struct {
+// GetCredentialStatusRequest is the request for GetCredentialStatus.
+type GetCredentialStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rpc           int32                  `protobuf:"varint,1,opt,name=rpc,proto3" json:"rpc,omitempty"`                              // Tendermint RPC port (default: 26657)
-	P2P           int32                  `protobuf:"varint,2,opt,name=p2p,proto3" json:"p2p,omitempty"`                              // P2P port (default: 26656)
-	Grpc          int32                  `protobuf:"varint,3,opt,name=grpc,proto3" json:"grpc,omitempty"`                            // gRPC port (default: 9090)
-	GrpcWeb       int32                  `protobuf:"varint,4,opt,name=grpc_web,json=grpcWeb,proto3" json:"grpc_web,omitempty"`       // gRPC-Web port (default: 9091)
-	Api           int32                  `protobuf:"varint,5,opt,name=api,proto3" json:"api,omitempty"`                              // REST API port (default: 1317)
-	EvmRpc        int32                  `protobuf:"varint,6,opt,name=evm_rpc,json=evmRpc,proto3" json:"evm_rpc,omitempty"`          // EVM JSON-RPC port (default: 8545)
-	EvmSocket     int32                  `protobuf:"varint,7,opt,name=evm_socket,json=evmSocket,proto3" json:"evm_socket,omitempty"` // EVM WebSocket port (default: 8546)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkPortConfig) Reset() {
-	*x = NetworkPortConfig{}
-	mi := &file_v1_devnet_proto_msgTypes[73]
+func (x *GetCredentialStatusRequest) Reset() {
+	*x = GetCredentialStatusRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkPortConfig) String() string {
+func (x *GetCredentialStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkPortConfig) ProtoMessage() {}
+func (*GetCredentialStatusRequest) ProtoMessage() {}
 
-func (x *NetworkPortConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[73]
+func (x *GetCredentialStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4699,84 +11739,86 @@ func (x *NetworkPortConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkPortConfig.ProtoReflect.Descriptor instead.
-func (*NetworkPortConfig) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use GetCredentialStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetCredentialStatusRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *NetworkPortConfig) GetRpc() int32 {
-	if x != nil {
-		return x.Rpc
-	}
-	return 0
+// GetCredentialStatusResponse is the response for GetCredentialStatus.
+type GetCredentialStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Source of the daemon's GitHub token: "keychain" (handed over by dvb),
+	// "config-file", "environment" or "none".
+	GithubTokenSource string `protobuf:"bytes,1,opt,name=github_token_source,json=githubTokenSource,proto3" json:"github_token_source,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *NetworkPortConfig) GetP2P() int32 {
-	if x != nil {
-		return x.P2P
-	}
-	return 0
+func (x *GetCredentialStatusResponse) Reset() {
+	*x = GetCredentialStatusResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkPortConfig) GetGrpc() int32 {
-	if x != nil {
-		return x.Grpc
-	}
-	return 0
+func (x *GetCredentialStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *NetworkPortConfig) GetGrpcWeb() int32 {
-	if x != nil {
-		return x.GrpcWeb
-	}
-	return 0
-}
+func (*GetCredentialStatusResponse) ProtoMessage() {}
 
-func (x *NetworkPortConfig) GetApi() int32 {
+func (x *GetCredentialStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[174]
 	if x != nil {
-		return x.Api
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *NetworkPortConfig) GetEvmRpc() int32 {
-	if x != nil {
-		return x.EvmRpc
-	}
-	return 0
+// Deprecated: Use GetCredentialStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetCredentialStatusResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *NetworkPortConfig) GetEvmSocket() int32 {
+func (x *GetCredentialStatusResponse) GetGithubTokenSource() string {
 	if x != nil {
-		return x.EvmSocket
+		return x.GithubTokenSource
 	}
-	return 0
+	return ""
 }
 
-// ListBinaryVersionsRequest is the request for ListBinaryVersions.
-type ListBinaryVersionsRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	NetworkName       string                 `protobuf:"bytes,1,opt,name=network_name,json=networkName,proto3" json:"network_name,omitempty"`                    // Required: network plugin name (e.g., "stable")
-	IncludePrerelease bool                   `protobuf:"varint,2,opt,name=include_prerelease,json=includePrerelease,proto3" json:"include_prerelease,omitempty"` // Optional: include prerelease versions (default: false)
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// Namespace is a namespace with its quota and usage.
+type Namespace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Quota         *NamespaceQuota        `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	DevnetCount   int32                  `protobuf:"varint,3,opt,name=devnet_count,json=devnetCount,proto3" json:"devnet_count,omitempty"` // Devnets in the namespace
+	NodeCount     int32                  `protobuf:"varint,4,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`       // Validators and full nodes across its devnets
+	Implicit      bool                   `protobuf:"varint,5,opt,name=implicit,proto3" json:"implicit,omitempty"`                          // True if the namespace only exists because it holds devnets
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBinaryVersionsRequest) Reset() {
-	*x = ListBinaryVersionsRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[74]
+func (x *Namespace) Reset() {
+	*x = Namespace{}
+	mi := &file_v1_devnet_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBinaryVersionsRequest) String() string {
+func (x *Namespace) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBinaryVersionsRequest) ProtoMessage() {}
+func (*Namespace) ProtoMessage() {}
 
-func (x *ListBinaryVersionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[74]
+func (x *Namespace) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4787,51 +11829,77 @@ func (x *ListBinaryVersionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBinaryVersionsRequest.ProtoReflect.Descriptor instead.
-func (*ListBinaryVersionsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use Namespace.ProtoReflect.Descriptor instead.
+func (*Namespace) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *ListBinaryVersionsRequest) GetNetworkName() string {
+func (x *Namespace) GetName() string {
 	if x != nil {
-		return x.NetworkName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListBinaryVersionsRequest) GetIncludePrerelease() bool {
+func (x *Namespace) GetQuota() *NamespaceQuota {
 	if x != nil {
-		return x.IncludePrerelease
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *Namespace) GetDevnetCount() int32 {
+	if x != nil {
+		return x.DevnetCount
+	}
+	return 0
+}
+
+func (x *Namespace) GetNodeCount() int32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *Namespace) GetImplicit() bool {
+	if x != nil {
+		return x.Implicit
 	}
 	return false
 }
 
-// ListBinaryVersionsResponse is the response for ListBinaryVersions.
-type ListBinaryVersionsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	NetworkName    string                 `protobuf:"bytes,1,opt,name=network_name,json=networkName,proto3" json:"network_name,omitempty"`          // Network plugin name
-	Versions       []*BinaryVersionInfo   `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`                                   // Available versions, newest first
-	DefaultVersion string                 `protobuf:"bytes,3,opt,name=default_version,json=defaultVersion,proto3" json:"default_version,omitempty"` // Default version for this network
-	SourceType     string                 `protobuf:"bytes,4,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`             // Binary source type: "github", "local"
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *Namespace) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// NamespaceQuota limits the resources of a namespace. Zero means unlimited.
+type NamespaceQuota struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxDevnets    int32                  `protobuf:"varint,1,opt,name=max_devnets,json=maxDevnets,proto3" json:"max_devnets,omitempty"`
+	MaxNodes      int32                  `protobuf:"varint,2,opt,name=max_nodes,json=maxNodes,proto3" json:"max_nodes,omitempty"` // Validators plus full nodes across all devnets
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBinaryVersionsResponse) Reset() {
-	*x = ListBinaryVersionsResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[75]
+func (x *NamespaceQuota) Reset() {
+	*x = NamespaceQuota{}
+	mi := &file_v1_devnet_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBinaryVersionsResponse) String() string {
+func (x *NamespaceQuota) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBinaryVersionsResponse) ProtoMessage() {}
+func (*NamespaceQuota) ProtoMessage() {}
 
-func (x *ListBinaryVersionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[75]
+func (x *NamespaceQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4842,66 +11910,49 @@ func (x *ListBinaryVersionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBinaryVersionsResponse.ProtoReflect.Descriptor instead.
-func (*ListBinaryVersionsResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{75}
-}
-
-func (x *ListBinaryVersionsResponse) GetNetworkName() string {
-	if x != nil {
-		return x.NetworkName
-	}
-	return ""
-}
-
-func (x *ListBinaryVersionsResponse) GetVersions() []*BinaryVersionInfo {
-	if x != nil {
-		return x.Versions
-	}
-	return nil
+// Deprecated: Use NamespaceQuota.ProtoReflect.Descriptor instead.
+func (*NamespaceQuota) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *ListBinaryVersionsResponse) GetDefaultVersion() string {
+func (x *NamespaceQuota) GetMaxDevnets() int32 {
 	if x != nil {
-		return x.DefaultVersion
+		return x.MaxDevnets
 	}
-	return ""
+	return 0
 }
 
-func (x *ListBinaryVersionsResponse) GetSourceType() string {
+func (x *NamespaceQuota) GetMaxNodes() int32 {
 	if x != nil {
-		return x.SourceType
+		return x.MaxNodes
 	}
-	return ""
+	return 0
 }
 
-// BinaryVersionInfo describes a single binary version.
-type BinaryVersionInfo struct {
+// CreateNamespaceRequest is the request for CreateNamespace.
+type CreateNamespaceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`                                    // Version tag (e.g., "v1.0.0")
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                  // Release name/title
-	Prerelease    bool                   `protobuf:"varint,3,opt,name=prerelease,proto3" json:"prerelease,omitempty"`                     // Whether this is a prerelease
-	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"` // Publication timestamp
-	HtmlUrl       string                 `protobuf:"bytes,5,opt,name=html_url,json=htmlUrl,proto3" json:"html_url,omitempty"`             // URL to the release page
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Quota         *NamespaceQuota        `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BinaryVersionInfo) Reset() {
-	*x = BinaryVersionInfo{}
-	mi := &file_v1_devnet_proto_msgTypes[76]
+func (x *CreateNamespaceRequest) Reset() {
+	*x = CreateNamespaceRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BinaryVersionInfo) String() string {
+func (x *CreateNamespaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinaryVersionInfo) ProtoMessage() {}
+func (*CreateNamespaceRequest) ProtoMessage() {}
 
-func (x *BinaryVersionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[76]
+func (x *CreateNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4912,68 +11963,92 @@ func (x *BinaryVersionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinaryVersionInfo.ProtoReflect.Descriptor instead.
-func (*BinaryVersionInfo) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use CreateNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*CreateNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *BinaryVersionInfo) GetTag() string {
+func (x *CreateNamespaceRequest) GetName() string {
 	if x != nil {
-		return x.Tag
+		return x.Name
 	}
 	return ""
 }
 
-func (x *BinaryVersionInfo) GetName() string {
+func (x *CreateNamespaceRequest) GetQuota() *NamespaceQuota {
 	if x != nil {
-		return x.Name
+		return x.Quota
 	}
-	return ""
+	return nil
 }
 
-func (x *BinaryVersionInfo) GetPrerelease() bool {
-	if x != nil {
-		return x.Prerelease
-	}
-	return false
+// CreateNamespaceResponse is the response for CreateNamespace.
+type CreateNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     *Namespace             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BinaryVersionInfo) GetPublishedAt() *timestamppb.Timestamp {
+func (x *CreateNamespaceResponse) Reset() {
+	*x = CreateNamespaceResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNamespaceResponse) ProtoMessage() {}
+
+func (x *CreateNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[178]
 	if x != nil {
-		return x.PublishedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *BinaryVersionInfo) GetHtmlUrl() string {
+// Deprecated: Use CreateNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*CreateNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *CreateNamespaceResponse) GetNamespace() *Namespace {
 	if x != nil {
-		return x.HtmlUrl
+		return x.Namespace
 	}
-	return ""
+	return nil
 }
 
-// PingRequest is the request for Ping.
-type PingRequest struct {
+// ListNamespacesRequest is the request for ListNamespaces.
+type ListNamespacesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PingRequest) Reset() {
-	*x = PingRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[77]
+func (x *ListNamespacesRequest) Reset() {
+	*x = ListNamespacesRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PingRequest) String() string {
+func (x *ListNamespacesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingRequest) ProtoMessage() {}
+func (*ListNamespacesRequest) ProtoMessage() {}
 
-func (x *PingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[77]
+func (x *ListNamespacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4984,34 +12059,34 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
-func (*PingRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use ListNamespacesRequest.ProtoReflect.Descriptor instead.
+func (*ListNamespacesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{179}
 }
 
-// PingResponse is the response for Ping.
-type PingResponse struct {
+// ListNamespacesResponse is the response for ListNamespaces.
+type ListNamespacesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerVersion string                 `protobuf:"bytes,1,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"` // Server version string
+	Namespaces    []*Namespace           `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PingResponse) Reset() {
-	*x = PingResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[78]
+func (x *ListNamespacesResponse) Reset() {
+	*x = ListNamespacesResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PingResponse) String() string {
+func (x *ListNamespacesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingResponse) ProtoMessage() {}
+func (*ListNamespacesResponse) ProtoMessage() {}
 
-func (x *PingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[78]
+func (x *ListNamespacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5022,40 +12097,41 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
-func (*PingResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use ListNamespacesResponse.ProtoReflect.Descriptor instead.
+func (*ListNamespacesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *PingResponse) GetServerVersion() string {
+func (x *ListNamespacesResponse) GetNamespaces() []*Namespace {
 	if x != nil {
-		return x.ServerVersion
+		return x.Namespaces
 	}
-	return ""
+	return nil
 }
 
-// WhoAmIRequest is the request for WhoAmI.
-type WhoAmIRequest struct {
+// DeleteNamespaceRequest is the request for DeleteNamespace.
+type DeleteNamespaceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WhoAmIRequest) Reset() {
-	*x = WhoAmIRequest{}
-	mi := &file_v1_devnet_proto_msgTypes[79]
+func (x *DeleteNamespaceRequest) Reset() {
+	*x = DeleteNamespaceRequest{}
+	mi := &file_v1_devnet_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WhoAmIRequest) String() string {
+func (x *DeleteNamespaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WhoAmIRequest) ProtoMessage() {}
+func (*DeleteNamespaceRequest) ProtoMessage() {}
 
-func (x *WhoAmIRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[79]
+func (x *DeleteNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5066,35 +12142,40 @@ func (x *WhoAmIRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WhoAmIRequest.ProtoReflect.Descriptor instead.
-func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use DeleteNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{181}
 }
 
-// WhoAmIResponse is the response for WhoAmI.
-type WhoAmIResponse struct {
+func (x *DeleteNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DeleteNamespaceResponse is the response for DeleteNamespace.
+type DeleteNamespaceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`             // User name from API key
-	Namespaces    []string               `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"` // Allowed namespaces (["*"] = all)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WhoAmIResponse) Reset() {
-	*x = WhoAmIResponse{}
-	mi := &file_v1_devnet_proto_msgTypes[80]
+func (x *DeleteNamespaceResponse) Reset() {
+	*x = DeleteNamespaceResponse{}
+	mi := &file_v1_devnet_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WhoAmIResponse) String() string {
+func (x *DeleteNamespaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WhoAmIResponse) ProtoMessage() {}
+func (*DeleteNamespaceResponse) ProtoMessage() {}
 
-func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_devnet_proto_msgTypes[80]
+func (x *DeleteNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_devnet_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5105,30 +12186,16 @@ func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WhoAmIResponse.ProtoReflect.Descriptor instead.
-func (*WhoAmIResponse) Descriptor() ([]byte, []int) {
-	return file_v1_devnet_proto_rawDescGZIP(), []int{80}
-}
-
-func (x *WhoAmIResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *WhoAmIResponse) GetNamespaces() []string {
-	if x != nil {
-		return x.Namespaces
-	}
-	return nil
+// Deprecated: Use DeleteNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_devnet_proto_rawDescGZIP(), []int{182}
 }
 
 var File_v1_devnet_proto protoreflect.FileDescriptor
 
 const file_v1_devnet_proto_rawDesc = "" +
 	"\n" +
-	"\x0fv1/devnet.proto\x12\x10devnetbuilder.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb0\x01\n" +
+	"\x0fv1/devnet.proto\x12\x10devnetbuilder.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14v1/transaction.proto\"\xb0\x01\n" +
 	"\x06Devnet\x12<\n" +
 	"\bmetadata\x18\x01 \x01(\v2 .devnetbuilder.v1.DevnetMetadataR\bmetadata\x120\n" +
 	"\x04spec\x18\x02 \x01(\v2\x1c.devnetbuilder.v1.DevnetSpecR\x04spec\x126\n" +
@@ -5150,7 +12217,7 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
 	"\x10AnnotationsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd8\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa9\r\n" +
 	"\n" +
 	"DevnetSpec\x12\x16\n" +
 	"\x06plugin\x18\x01 \x01(\tR\x06plugin\x12!\n" +
@@ -5168,7 +12235,131 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"\arpc_url\x18\t \x01(\tR\x06rpcUrl\x12!\n" +
 	"\ffork_network\x18\n" +
 	" \x01(\tR\vforkNetwork\x12\x19\n" +
-	"\bchain_id\x18\v \x01(\tR\achainId\"\x8b\x03\n" +
+	"\bchain_id\x18\v \x01(\tR\achainId\x12\x14\n" +
+	"\x05image\x18\f \x01(\tR\x05image\x12\x18\n" +
+	"\aprofile\x18\r \x01(\tR\aprofile\x12\x1f\n" +
+	"\vforce_build\x18\x0e \x01(\bR\n" +
+	"forceBuild\x12\x18\n" +
+	"\aoffline\x18\x0f \x01(\bR\aoffline\x12_\n" +
+	"\x11genesis_overrides\x18\x10 \x03(\v22.devnetbuilder.v1.DevnetSpec.GenesisOverridesEntryR\x10genesisOverrides\x12H\n" +
+	"\x0ffunded_accounts\x18\x11 \x03(\v2\x1f.devnetbuilder.v1.FundedAccountR\x0efundedAccounts\x12\x1a\n" +
+	"\baccounts\x18\x12 \x01(\x05R\baccounts\x12\x10\n" +
+	"\x03ttl\x18\x13 \x01(\tR\x03ttl\x12(\n" +
+	"\x10delete_on_expiry\x18\x14 \x01(\bR\x0edeleteOnExpiry\x12!\n" +
+	"\fidle_timeout\x18\x15 \x01(\tR\vidleTimeout\x12&\n" +
+	"\x0fskip_disk_check\x18\x16 \x01(\bR\rskipDiskCheck\x122\n" +
+	"\x05ports\x18\x17 \x01(\v2\x1c.devnetbuilder.v1.PortLayoutR\x05ports\x12!\n" +
+	"\fbind_address\x18\x18 \x01(\tR\vbindAddress\x12F\n" +
+	"\x0evalidator_keys\x18\x19 \x03(\v2\x1f.devnetbuilder.v1.ValidatorKeysR\rvalidatorKeys\x12E\n" +
+	"\x0eremote_signers\x18\x1a \x03(\v2\x1e.devnetbuilder.v1.RemoteSignerR\rremoteSigners\x12\x12\n" +
+	"\x04seed\x18\x1b \x01(\tR\x04seed\x12C\n" +
+	"\rgenesis_prune\x18\x1c \x01(\v2\x1e.devnetbuilder.v1.GenesisPruneR\fgenesisPrune\x12?\n" +
+	"\fnode_configs\x18\x1d \x03(\v2\x1c.devnetbuilder.v1.NodeConfigR\vnodeConfigs\x12\x1d\n" +
+	"\n" +
+	"block_time\x18\x1e \x01(\tR\tblockTime\x12%\n" +
+	"\x0eepoch_duration\x18\x1f \x01(\tR\repochDuration\x125\n" +
+	"\bwebhooks\x18  \x03(\v2\x19.devnetbuilder.v1.WebhookR\bwebhooks\x12-\n" +
+	"\x05hooks\x18! \x01(\v2\x17.devnetbuilder.v1.HooksR\x05hooks\x129\n" +
+	"\tbootstrap\x18\" \x01(\v2\x1b.devnetbuilder.v1.BootstrapR\tbootstrap\x122\n" +
+	"\x05hosts\x18# \x03(\v2\x1c.devnetbuilder.v1.RemoteHostR\x05hosts\x12I\n" +
+	"\x0fnetwork_shaping\x18$ \x01(\v2 .devnetbuilder.v1.NetworkShapingR\x0enetworkShaping\x12@\n" +
+	"\fvoting_power\x18% \x01(\v2\x1d.devnetbuilder.v1.VotingPowerR\vvotingPower\x12)\n" +
+	"\x10allow_unverified\x18& \x01(\bR\x0fallowUnverified\x1aC\n" +
+	"\x15GenesisOverridesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe0\x01\n" +
+	"\x0eNetworkShaping\x12G\n" +
+	"\aregions\x18\x01 \x03(\v2-.devnetbuilder.v1.NetworkShaping.RegionsEntryR\aregions\x123\n" +
+	"\x05links\x18\x02 \x03(\v2\x1d.devnetbuilder.v1.NetworkLinkR\x05links\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x1a:\n" +
+	"\fRegionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8b\x01\n" +
+	"\vNetworkLink\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x18\n" +
+	"\alatency\x18\x03 \x01(\tR\alatency\x12\x16\n" +
+	"\x06jitter\x18\x04 \x01(\tR\x06jitter\x12\x12\n" +
+	"\x04loss\x18\x05 \x01(\tR\x04loss\x12\x12\n" +
+	"\x04rate\x18\x06 \x01(\tR\x04rate\"\x99\x01\n" +
+	"\n" +
+	"RemoteHost\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\tR\x04user\x12\x12\n" +
+	"\x04port\x18\x04 \x01(\x05R\x04port\x12#\n" +
+	"\ridentity_file\x18\x05 \x01(\tR\fidentityFile\x12\x10\n" +
+	"\x03dir\x18\x06 \x01(\tR\x03dir\"\xac\x01\n" +
+	"\x05Hooks\x123\n" +
+	"\tpost_fork\x18\x01 \x03(\v2\x16.devnetbuilder.v1.HookR\bpostFork\x123\n" +
+	"\tpre_start\x18\x02 \x03(\v2\x16.devnetbuilder.v1.HookR\bpreStart\x129\n" +
+	"\fpost_healthy\x18\x03 \x03(\v2\x16.devnetbuilder.v1.HookR\vpostHealthy\"\x9b\x01\n" +
+	"\x04Hook\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acommand\x18\x02 \x01(\tR\acommand\x12\x16\n" +
+	"\x06script\x18\x03 \x01(\tR\x06script\x12\x14\n" +
+	"\x05image\x18\x04 \x01(\tR\x05image\x12\x18\n" +
+	"\atimeout\x18\x05 \x01(\tR\atimeout\x12\x1d\n" +
+	"\n" +
+	"on_failure\x18\x06 \x01(\tR\tonFailure\"b\n" +
+	"\tBootstrap\x12\x1b\n" +
+	"\tgas_price\x18\x01 \x01(\tR\bgasPrice\x128\n" +
+	"\tcontracts\x18\x02 \x03(\v2\x1a.devnetbuilder.v1.ContractR\tcontracts\"\xbb\x01\n" +
+	"\bContract\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x19\n" +
+	"\binit_msg\x18\x04 \x01(\tR\ainitMsg\x12\x12\n" +
+	"\x04args\x18\x05 \x01(\tR\x04args\x12\x14\n" +
+	"\x05label\x18\x06 \x01(\tR\x05label\x12\x14\n" +
+	"\x05admin\x18\a \x01(\tR\x05admin\x12\x14\n" +
+	"\x05funds\x18\b \x01(\tR\x05funds\"g\n" +
+	"\aWebhook\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x16\n" +
+	"\x06events\x18\x03 \x03(\tR\x06events\x12\x1a\n" +
+	"\btemplate\x18\x04 \x01(\tR\btemplate\"^\n" +
+	"\n" +
+	"NodeConfig\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1f\n" +
+	"\vconfig_toml\x18\x02 \x01(\tR\n" +
+	"configToml\x12\x19\n" +
+	"\bapp_toml\x18\x03 \x01(\tR\aappToml\"s\n" +
+	"\fGenesisPrune\x12\x1f\n" +
+	"\vibc_packets\x18\x01 \x01(\bR\n" +
+	"ibcPackets\x12%\n" +
+	"\x0edust_threshold\x18\x02 \x01(\tR\rdustThreshold\x12\x1b\n" +
+	"\twasm_code\x18\x03 \x01(\bR\bwasmCode\"A\n" +
+	"\vVotingPower\x12\x18\n" +
+	"\aprofile\x18\x01 \x01(\tR\aprofile\x12\x18\n" +
+	"\aweights\x18\x02 \x03(\x01R\aweights\"{\n" +
+	"\fRemoteSigner\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x14\n" +
+	"\x05tmkms\x18\x02 \x01(\bR\x05tmkms\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12)\n" +
+	"\x10protocol_version\x18\x04 \x01(\tR\x0fprotocolVersion\"n\n" +
+	"\rValidatorKeys\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12,\n" +
+	"\x12priv_validator_key\x18\x02 \x01(\tR\x10privValidatorKey\x12\x19\n" +
+	"\bnode_key\x18\x03 \x01(\tR\anodeKey\"\x89\x01\n" +
+	"\n" +
+	"PortLayout\x12\x10\n" +
+	"\x03p2p\x18\x01 \x01(\x05R\x03p2p\x12\x10\n" +
+	"\x03rpc\x18\x02 \x01(\x05R\x03rpc\x12\x12\n" +
+	"\x04rest\x18\x03 \x01(\x05R\x04rest\x12\x12\n" +
+	"\x04grpc\x18\x04 \x01(\x05R\x04grpc\x12\x17\n" +
+	"\aevm_rpc\x18\x05 \x01(\x05R\x06evmRpc\x12\x16\n" +
+	"\x06stride\x18\x06 \x01(\x05R\x06stride\"|\n" +
+	"\rFundedAccount\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05coins\x18\x02 \x01(\tR\x05coins\x12;\n" +
+	"\avesting\x18\x03 \x01(\v2!.devnetbuilder.v1.VestingScheduleR\avesting\"\xad\x01\n" +
+	"\x0fVestingSchedule\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05coins\x18\x02 \x01(\tR\x05coins\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\xff\x03\n" +
 	"\fDevnetStatus\x12\x14\n" +
 	"\x05phase\x18\x01 \x01(\tR\x05phase\x12\x14\n" +
 	"\x05nodes\x18\x02 \x01(\x05R\x05nodes\x12\x1f\n" +
@@ -5184,7 +12375,11 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"conditions\x12/\n" +
 	"\x06events\x18\t \x03(\v2\x17.devnetbuilder.v1.EventR\x06events\x12\x16\n" +
 	"\x06subnet\x18\n" +
-	" \x01(\rR\x06subnet\"\xb7\x01\n" +
+	" \x01(\rR\x06subnet\x129\n" +
+	"\n" +
+	"expires_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x14\n" +
+	"\x05image\x18\f \x01(\tR\x05image\x12!\n" +
+	"\fimage_digest\x18\r \x01(\tR\vimageDigest\"\xb7\x01\n" +
 	"\tCondition\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12L\n" +
@@ -5226,10 +12421,11 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"G\n" +
 	"\x13StartDevnetResponse\x120\n" +
-	"\x06devnet\x18\x01 \x01(\v2\x18.devnetbuilder.v1.DevnetR\x06devnet\"E\n" +
+	"\x06devnet\x18\x01 \x01(\v2\x18.devnetbuilder.v1.DevnetR\x06devnet\"]\n" +
 	"\x11StopDevnetRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"F\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"F\n" +
 	"\x12StopDevnetResponse\x120\n" +
 	"\x06devnet\x18\x01 \x01(\v2\x18.devnetbuilder.v1.DevnetR\x06devnet\"\x96\x03\n" +
 	"\x12ApplyDevnetRequest\x12\x12\n" +
@@ -5278,7 +12474,313 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"\vstep_detail\x18\n" +
 	" \x01(\tR\n" +
 	"stepDetail\x12\x14\n" +
-	"\x05speed\x18\v \x01(\x01R\x05speed\"\xa8\x01\n" +
+	"\x05speed\x18\v \x01(\x01R\x05speed\"\x8b\x01\n" +
+	"\x15ExportFixturesRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05types\x18\x03 \x03(\tR\x05types\x12\x1d\n" +
+	"\n" +
+	"node_index\x18\x04 \x01(\x05R\tnodeIndex\";\n" +
+	"\vFixtureFile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"\x80\x01\n" +
+	"\x16ExportFixturesResponse\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x03R\x06height\x123\n" +
+	"\x05files\x18\x03 \x03(\v2\x1d.devnetbuilder.v1.FixtureFileR\x05files\"R\n" +
+	"\x11ExportKeysRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\xdb\x01\n" +
+	"\n" +
+	"AccountKey\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x14\n" +
+	"\x05index\x18\x03 \x01(\x05R\x05index\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x1f\n" +
+	"\vevm_address\x18\x05 \x01(\tR\n" +
+	"evmAddress\x12\x1a\n" +
+	"\bmnemonic\x18\x06 \x01(\tR\bmnemonic\x12\x17\n" +
+	"\ahd_path\x18\a \x01(\tR\x06hdPath\x12\x1f\n" +
+	"\vprivate_key\x18\b \x01(\tR\n" +
+	"privateKey\"\x95\x01\n" +
+	"\x12ExportKeysResponse\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x10\n" +
+	"\x03evm\x18\x02 \x01(\bR\x03evm\x120\n" +
+	"\x04keys\x18\x03 \x03(\v2\x1c.devnetbuilder.v1.AccountKeyR\x04keys\x12 \n" +
+	"\fevm_chain_id\x18\x04 \x01(\x03R\n" +
+	"evmChainId\"\xb6\x01\n" +
+	"\x18DiffValidatorSetsRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1f\n" +
+	"\vfrom_height\x18\x03 \x01(\x03R\n" +
+	"fromHeight\x12\x1b\n" +
+	"\tto_height\x18\x04 \x01(\x03R\btoHeight\x12\x1d\n" +
+	"\n" +
+	"node_index\x18\x05 \x01(\x05R\tnodeIndex\"\xd4\x01\n" +
+	"\x12ValidatorSetChange\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12+\n" +
+	"\x11consensus_address\x18\x02 \x01(\tR\x10consensusAddress\x12)\n" +
+	"\x10operator_address\x18\x03 \x01(\tR\x0foperatorAddress\x12\x18\n" +
+	"\amoniker\x18\x04 \x01(\tR\amoniker\x12\x1d\n" +
+	"\n" +
+	"from_power\x18\x05 \x01(\x03R\tfromPower\x12\x19\n" +
+	"\bto_power\x18\x06 \x01(\x03R\atoPower\"\xa3\x02\n" +
+	"\x19DiffValidatorSetsResponse\x12\x1f\n" +
+	"\vfrom_height\x18\x01 \x01(\x03R\n" +
+	"fromHeight\x12\x1b\n" +
+	"\tto_height\x18\x02 \x01(\x03R\btoHeight\x12\x1d\n" +
+	"\n" +
+	"from_count\x18\x03 \x01(\x05R\tfromCount\x12\x19\n" +
+	"\bto_count\x18\x04 \x01(\x05R\atoCount\x12(\n" +
+	"\x10from_total_power\x18\x05 \x01(\x03R\x0efromTotalPower\x12$\n" +
+	"\x0eto_total_power\x18\x06 \x01(\x03R\ftoTotalPower\x12>\n" +
+	"\achanges\x18\a \x03(\v2$.devnetbuilder.v1.ValidatorSetChangeR\achanges\"\x87\x01\n" +
+	"\x11ListBlocksRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"node_index\x18\x04 \x01(\x05R\tnodeIndex\"\xfa\x01\n" +
+	"\fBlockSummary\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x03R\x06height\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12)\n" +
+	"\x10proposer_address\x18\x04 \x01(\tR\x0fproposerAddress\x12)\n" +
+	"\x10proposer_moniker\x18\x05 \x01(\tR\x0fproposerMoniker\x12\x17\n" +
+	"\anum_txs\x18\x06 \x01(\x05R\x06numTxs\x12\x1f\n" +
+	"\vinterval_ms\x18\a \x01(\x03R\n" +
+	"intervalMs\"{\n" +
+	"\x12ListBlocksResponse\x126\n" +
+	"\x06blocks\x18\x01 \x03(\v2\x1e.devnetbuilder.v1.BlockSummaryR\x06blocks\x12-\n" +
+	"\x13latest_block_age_ms\x18\x02 \x01(\x03R\x10latestBlockAgeMs\"\x87\x01\n" +
+	"\x0fGetBlockRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x03R\x06height\x12\x1d\n" +
+	"\n" +
+	"node_index\x18\x04 \x01(\x05R\tnodeIndex\"\x84\x02\n" +
+	"\aBlockTx\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\rR\x04code\x12\x10\n" +
+	"\x03log\x18\x03 \x01(\tR\x03log\x12\x1d\n" +
+	"\n" +
+	"gas_wanted\x18\x04 \x01(\x03R\tgasWanted\x12\x19\n" +
+	"\bgas_used\x18\x05 \x01(\x03R\agasUsed\x12<\n" +
+	"\bmessages\x18\x06 \x03(\v2 .devnetbuilder.v1.TxTraceMessageR\bmessages\x12\x12\n" +
+	"\x04memo\x18\a \x01(\tR\x04memo\x12\x10\n" +
+	"\x03fee\x18\b \x01(\tR\x03fee\x12!\n" +
+	"\fdecode_error\x18\t \x01(\tR\vdecodeError\"u\n" +
+	"\x10GetBlockResponse\x124\n" +
+	"\x05block\x18\x01 \x01(\v2\x1e.devnetbuilder.v1.BlockSummaryR\x05block\x12+\n" +
+	"\x03txs\x18\x02 \x03(\v2\x19.devnetbuilder.v1.BlockTxR\x03txs\"W\n" +
+	"\x13ExtendDevnetRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x0e\n" +
+	"\x02by\x18\x03 \x01(\tR\x02by\"H\n" +
+	"\x14ExtendDevnetResponse\x120\n" +
+	"\x06devnet\x18\x01 \x01(\v2\x18.devnetbuilder.v1.DevnetR\x06devnet\"f\n" +
+	"\x13SetBlockTimeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"block_time\x18\x03 \x01(\tR\tblockTime\"m\n" +
+	"\x14SetBlockTimeResponse\x120\n" +
+	"\x06devnet\x18\x01 \x01(\v2\x18.devnetbuilder.v1.DevnetR\x06devnet\x12#\n" +
+	"\rrestart_nodes\x18\x02 \x03(\x05R\frestartNodes\"3\n" +
+	"\x13WatchDevnetsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"\x88\x01\n" +
+	"\x14WatchDevnetsResponse\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x120\n" +
+	"\x06devnet\x18\x02 \x01(\v2\x18.devnetbuilder.v1.DevnetR\x06devnet\x12*\n" +
+	"\x04node\x18\x03 \x01(\v2\x16.devnetbuilder.v1.NodeR\x04node\"\xa7\x01\n" +
+	"\x17ListDevnetEventsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x120\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"K\n" +
+	"\x18ListDevnetEventsResponse\x12/\n" +
+	"\x06events\x18\x01 \x03(\v2\x17.devnetbuilder.v1.EventR\x06events\"U\n" +
+	"\x14GetPeerMatrixRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\x84\x01\n" +
+	"\x15GetPeerMatrixResponse\x120\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1a.devnetbuilder.v1.PeerNodeR\x05nodes\x129\n" +
+	"\bwarnings\x18\x02 \x03(\v2\x1d.devnetbuilder.v1.PeerWarningR\bwarnings\"\xf4\x01\n" +
+	"\bPeerNode\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12\x1f\n" +
+	"\vlisten_addr\x18\x04 \x01(\tR\n" +
+	"listenAddr\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1c\n" +
+	"\tconnected\x18\x06 \x03(\x05R\tconnected\x12%\n" +
+	"\x0eexternal_peers\x18\a \x01(\x05R\rexternalPeers\x12)\n" +
+	"\x10persistent_peers\x18\b \x03(\tR\x0fpersistentPeers\"F\n" +
+	"\vPeerWarning\x12\x1d\n" +
+	"\n" +
+	"node_index\x18\x01 \x01(\x05R\tnodeIndex\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"Y\n" +
+	"\x18DiagnoseConsensusRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\xcb\x03\n" +
+	"\x19DiagnoseConsensusResponse\x12\x1f\n" +
+	"\vobserved_by\x18\x01 \x01(\tR\n" +
+	"observedBy\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x03R\x06height\x12\x14\n" +
+	"\x05round\x18\x03 \x01(\x05R\x05round\x12\x12\n" +
+	"\x04step\x18\x04 \x01(\tR\x04step\x12F\n" +
+	"\x11latest_block_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0flatestBlockTime\x12\x18\n" +
+	"\astalled\x18\x06 \x01(\bR\astalled\x12%\n" +
+	"\x0ecompare_height\x18\a \x01(\x03R\rcompareHeight\x12D\n" +
+	"\n" +
+	"validators\x18\b \x03(\v2$.devnetbuilder.v1.ConsensusValidatorR\n" +
+	"validators\x125\n" +
+	"\x05nodes\x18\t \x03(\v2\x1f.devnetbuilder.v1.ConsensusNodeR\x05nodes\x12E\n" +
+	"\n" +
+	"hypotheses\x18\n" +
+	" \x03(\v2%.devnetbuilder.v1.ConsensusHypothesisR\n" +
+	"hypotheses\"\xe5\x01\n" +
+	"\x12ConsensusValidator\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fvoting_power\x18\x03 \x01(\x03R\vvotingPower\x12\x1a\n" +
+	"\bproposer\x18\x04 \x01(\bR\bproposer\x12\x18\n" +
+	"\aprevote\x18\x05 \x01(\tR\aprevote\x12\x1c\n" +
+	"\tprecommit\x18\x06 \x01(\tR\tprecommit\x12*\n" +
+	"\x11signed_last_block\x18\a \x01(\bR\x0fsignedLastBlock\"\xa3\x01\n" +
+	"\rConsensusNode\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x03R\x06height\x12\x1f\n" +
+	"\vcatching_up\x18\x04 \x01(\bR\n" +
+	"catchingUp\x12\x19\n" +
+	"\bapp_hash\x18\x05 \x01(\tR\aappHash\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"w\n" +
+	"\x19CollectDebugBundleRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1b\n" +
+	"\tlog_lines\x18\x03 \x01(\x05R\blogLines\":\n" +
+	"\n" +
+	"BundleFile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"P\n" +
+	"\x1aCollectDebugBundleResponse\x122\n" +
+	"\x05files\x18\x01 \x03(\v2\x1c.devnetbuilder.v1.BundleFileR\x05files\"m\n" +
+	"\x14ExportGenesisRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x03R\x06height\"\x9b\x01\n" +
+	"\x15ExportGenesisResponse\x12\x18\n" +
+	"\agenesis\x18\x01 \x01(\fR\agenesis\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x03R\x06height\x12\x12\n" +
+	"\x04node\x18\x04 \x01(\tR\x04node\x12!\n" +
+	"\fhalted_nodes\x18\x05 \x03(\tR\vhaltedNodes\"r\n" +
+	"\x14ExportComposeRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1b\n" +
+	"\thome_root\x18\x03 \x01(\tR\bhomeRoot\"M\n" +
+	"\x15ExportComposeResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12\x1a\n" +
+	"\bservices\x18\x02 \x03(\tR\bservices\"j\n" +
+	"\x13ShapeNetworkRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05clear\x18\x03 \x01(\bR\x05clear\"J\n" +
+	"\x14ShapeNetworkResponse\x122\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1c.devnetbuilder.v1.ShapedNodeR\x05nodes\"N\n" +
+	"\n" +
+	"ShapedNode\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x14\n" +
+	"\x05links\x18\x03 \x03(\tR\x05links\"R\n" +
+	"\x11CheckImageRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\x8e\x01\n" +
+	"\x12CheckImageResponse\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\tR\x05image\x12#\n" +
+	"\rpinned_digest\x18\x02 \x01(\tR\fpinnedDigest\x12'\n" +
+	"\x0fupstream_digest\x18\x03 \x01(\tR\x0eupstreamDigest\x12\x14\n" +
+	"\x05moved\x18\x04 \x01(\bR\x05moved\"\xa1\x01\n" +
+	"\x11PruneNodesRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05index\x18\x03 \x01(\x05R\x05index\x12\x1b\n" +
+	"\tall_nodes\x18\x04 \x01(\bR\ballNodes\x12\x1a\n" +
+	"\bstrategy\x18\x05 \x01(\tR\bstrategy\"H\n" +
+	"\x12PruneNodesResponse\x122\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1c.devnetbuilder.v1.PrunedNodeR\x05nodes\"d\n" +
+	"\n" +
+	"PrunedNode\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12!\n" +
+	"\fbytes_before\x18\x02 \x01(\x03R\vbytesBefore\x12\x1f\n" +
+	"\vbytes_after\x18\x03 \x01(\x03R\n" +
+	"bytesAfter\"\xfa\x01\n" +
+	"\x11SearchLogsRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\x12\x1f\n" +
+	"\vignore_case\x18\x04 \x01(\bR\n" +
+	"ignoreCase\x120\n" +
+	"\x05since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12\x18\n" +
+	"\acontext\x18\x06 \x01(\x05R\acontext\x12\x1f\n" +
+	"\vmax_matches\x18\a \x01(\x05R\n" +
+	"maxMatches\"d\n" +
+	"\bLogMatch\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\tR\x04line\x12\x16\n" +
+	"\x06before\x18\x03 \x03(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x04 \x03(\tR\x05after\"\x80\x01\n" +
+	"\x12SearchLogsResponse\x124\n" +
+	"\amatches\x18\x01 \x03(\v2\x1a.devnetbuilder.v1.LogMatchR\amatches\x12\x1c\n" +
+	"\ttruncated\x18\x02 \x01(\bR\ttruncated\x12\x16\n" +
+	"\x06errors\x18\x03 \x03(\tR\x06errors\"\x9b\x01\n" +
+	"\x17InjectDoubleSignRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12'\n" +
+	"\x0fvalidator_index\x18\x03 \x01(\x05R\x0evalidatorIndex\x12\x18\n" +
+	"\aconfirm\x18\x04 \x01(\bR\aconfirm\"\x84\x01\n" +
+	"\x18InjectDoubleSignResponse\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x03R\x06height\x12+\n" +
+	"\x11validator_address\x18\x02 \x01(\tR\x10validatorAddress\x12#\n" +
+	"\revidence_hash\x18\x03 \x01(\tR\fevidenceHash\"h\n" +
+	"\x13ExportDevnetRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04live\x18\x03 \x01(\bR\x04live\"\"\n" +
+	"\fArchiveChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"[\n" +
+	"\x13ImportDevnetRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"d\n" +
+	"\x14ImportDevnetResponse\x120\n" +
+	"\x06devnet\x18\x01 \x01(\v2\x18.devnetbuilder.v1.DevnetR\x06devnet\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"}\n" +
+	"\x13ConsensusHypothesis\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x02 \x01(\tR\n" +
+	"confidence\x12\x1a\n" +
+	"\bevidence\x18\x03 \x03(\tR\bevidence\x12\x14\n" +
+	"\x05fixes\x18\x04 \x03(\tR\x05fixes\"\xa8\x01\n" +
 	"\x04Node\x12:\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x1e.devnetbuilder.v1.NodeMetadataR\bmetadata\x12.\n" +
 	"\x04spec\x18\x02 \x01(\v2\x1a.devnetbuilder.v1.NodeSpecR\x04spec\x124\n" +
@@ -5295,7 +12797,7 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1c\n" +
-	"\tnamespace\x18\a \x01(\tR\tnamespace\"\xe5\x01\n" +
+	"\tnamespace\x18\a \x01(\tR\tnamespace\"\xc3\x02\n" +
 	"\bNodeSpec\x12\x12\n" +
 	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1f\n" +
 	"\vbinary_path\x18\x02 \x01(\tR\n" +
@@ -5303,7 +12805,9 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"\bhome_dir\x18\x03 \x01(\tR\ahomeDir\x12#\n" +
 	"\rdesired_phase\x18\x04 \x01(\tR\fdesiredPhase\x12J\n" +
 	"\x0erestart_policy\x18\x05 \x01(\x0e2#.devnetbuilder.v1.NodeRestartPolicyR\rrestartPolicy\x12\x18\n" +
-	"\aaddress\x18\x06 \x01(\tR\aaddress\"\xe0\x02\n" +
+	"\aaddress\x18\x06 \x01(\tR\aaddress\x129\n" +
+	"\x05ports\x18\a \x01(\v2#.devnetbuilder.v1.NetworkPortConfigR\x05ports\x12!\n" +
+	"\fbind_address\x18\b \x01(\tR\vbindAddress\"\xfb\x04\n" +
 	"\n" +
 	"NodeStatus\x12\x14\n" +
 	"\x05phase\x18\x01 \x01(\tR\x05phase\x12!\n" +
@@ -5318,7 +12822,16 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"\rrestart_count\x18\b \x01(\x05R\frestartCount\x12\x18\n" +
 	"\amessage\x18\t \x01(\tR\amessage\x12/\n" +
 	"\x13observed_generation\x18\n" +
-	" \x01(\x03R\x12observedGeneration\"\xac\x01\n" +
+	" \x01(\x03R\x12observedGeneration\x12;\n" +
+	"\n" +
+	"conditions\x18\v \x03(\v2\x1b.devnetbuilder.v1.ConditionR\n" +
+	"conditions\x12B\n" +
+	"\x0flast_block_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rlastBlockTime\x12\x18\n" +
+	"\aversion\x18\r \x01(\tR\aversion\x12*\n" +
+	"\x11blocks_per_minute\x18\x0e \x01(\x01R\x0fblocksPerMinute\x123\n" +
+	"\x16avg_block_time_seconds\x18\x0f \x01(\x01R\x13avgBlockTimeSeconds\x12\x1d\n" +
+	"\n" +
+	"height_lag\x18\x10 \x01(\x03R\theightLag\"\xac\x01\n" +
 	"\n" +
 	"NodeHealth\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
@@ -5390,7 +12903,64 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"\x12ExecInNodeResponse\x12\x1b\n" +
 	"\texit_code\x18\x01 \x01(\x05R\bexitCode\x12\x16\n" +
 	"\x06stdout\x18\x02 \x01(\tR\x06stdout\x12\x16\n" +
-	"\x06stderr\x18\x03 \x01(\tR\x06stderr\"\x81\x01\n" +
+	"\x06stderr\x18\x03 \x01(\tR\x06stderr\"\xc0\x01\n" +
+	"\x16ApplyNodeConfigRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x1c\n" +
+	"\toverrides\x18\x03 \x01(\tR\toverrides\x123\n" +
+	"\x16no_restart_if_possible\x18\x04 \x01(\bR\x13noRestartIfPossible\x12\x1c\n" +
+	"\tnamespace\x18\x05 \x01(\tR\tnamespace\"n\n" +
+	"\fConfigChange\x12\x12\n" +
+	"\x04file\x18\x01 \x01(\tR\x04file\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1b\n" +
+	"\told_value\x18\x03 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x04 \x01(\tR\bnewValue\"\x85\x01\n" +
+	"\x17ApplyNodeConfigResponse\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x128\n" +
+	"\achanges\x18\x02 \x03(\v2\x1e.devnetbuilder.v1.ConfigChangeR\achanges\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"k\n" +
+	"\x14GetNodeConfigRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\"}\n" +
+	"\x0fNodeConfigField\x12\x12\n" +
+	"\x04file\x18\x01 \x01(\tR\x04file\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x16\n" +
+	"\x06detail\x18\x05 \x01(\tR\x06detail\"R\n" +
+	"\x15GetNodeConfigResponse\x129\n" +
+	"\x06fields\x18\x01 \x03(\v2!.devnetbuilder.v1.NodeConfigFieldR\x06fields\"\x82\x01\n" +
+	"\x15SyncNodeConfigRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05apply\x18\x04 \x01(\bR\x05apply\"\xaf\x01\n" +
+	"\vConfigDrift\x12\x12\n" +
+	"\x04file\x18\x01 \x01(\tR\x04file\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12#\n" +
+	"\rcurrent_value\x18\x03 \x01(\tR\fcurrentValue\x12%\n" +
+	"\x0eexpected_value\x18\x04 \x01(\tR\rexpectedValue\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12\x16\n" +
+	"\x06detail\x18\x06 \x01(\tR\x06detail\"\x7f\n" +
+	"\x16SyncNodeConfigResponse\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x123\n" +
+	"\x05drift\x18\x02 \x03(\v2\x1d.devnetbuilder.v1.ConfigDriftR\x05drift\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xbc\x01\n" +
+	"\x14SetNodeBinaryRequest\x12\x1f\n" +
+	"\vdevnet_name\x18\x01 \x01(\tR\n" +
+	"devnetName\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\x12\x1f\n" +
+	"\vbinary_path\x18\x05 \x01(\tR\n" +
+	"binaryPath\x12\x14\n" +
+	"\x05image\x18\x06 \x01(\tR\x05image\"C\n" +
+	"\x15SetNodeBinaryResponse\x12*\n" +
+	"\x04node\x18\x01 \x01(\v2\x16.devnetbuilder.v1.NodeR\x04node\"\x81\x01\n" +
 	"\vPortMapping\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
 	"\x0econtainer_port\x18\x02 \x01(\x05R\rcontainerPort\x12\x1b\n" +
@@ -5418,7 +12988,7 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1c\n" +
-	"\tnamespace\x18\x05 \x01(\tR\tnamespace\"\x8f\x02\n" +
+	"\tnamespace\x18\x05 \x01(\tR\tnamespace\"\xa3\x02\n" +
 	"\vUpgradeSpec\x12\x1d\n" +
 	"\n" +
 	"devnet_ref\x18\x01 \x01(\tR\tdevnetRef\x12!\n" +
@@ -5429,12 +12999,13 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"\vwith_export\x18\x05 \x01(\bR\n" +
 	"withExport\x12\x1b\n" +
 	"\tauto_vote\x18\x06 \x01(\bR\bautoVote\x12\x1c\n" +
-	"\tnamespace\x18\b \x01(\tR\tnamespace\"b\n" +
+	"\tnamespace\x18\b \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04mode\x18\t \x01(\tR\x04mode\"b\n" +
 	"\fBinarySource\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x10\n" +
 	"\x03url\x18\x03 \x01(\tR\x03url\x12\x12\n" +
-	"\x04path\x18\x04 \x01(\tR\x04path\"\xbd\x02\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\"\xb2\x03\n" +
 	"\rUpgradeStatus\x12\x14\n" +
 	"\x05phase\x18\x01 \x01(\tR\x05phase\x12\x1f\n" +
 	"\vproposal_id\x18\x02 \x01(\x04R\n" +
@@ -5445,7 +13016,12 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"\x0fpre_export_path\x18\x06 \x01(\tR\rpreExportPath\x12(\n" +
 	"\x10post_export_path\x18\a \x01(\tR\x0epostExportPath\x12\x18\n" +
 	"\amessage\x18\b \x01(\tR\amessage\x12\x14\n" +
-	"\x05error\x18\t \x01(\tR\x05error\"{\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12&\n" +
+	"\x0ffork_devnet_ref\x18\n" +
+	" \x01(\tR\rforkDevnetRef\x12*\n" +
+	"\x11fork_start_height\x18\v \x01(\x03R\x0fforkStartHeight\x12\x1f\n" +
+	"\vfork_height\x18\f \x01(\x03R\n" +
+	"forkHeight\"{\n" +
 	"\x14CreateUpgradeRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x121\n" +
 	"\x04spec\x18\x02 \x01(\v2\x1d.devnetbuilder.v1.UpgradeSpecR\x04spec\x12\x1c\n" +
@@ -5477,10 +13053,17 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"K\n" +
 	"\x14RetryUpgradeResponse\x123\n" +
-	"\aupgrade\x18\x01 \x01(\v2\x19.devnetbuilder.v1.UpgradeR\aupgrade\"\x15\n" +
+	"\aupgrade\x18\x01 \x01(\v2\x19.devnetbuilder.v1.UpgradeR\aupgrade\"K\n" +
+	"\x17GetUpgradeReportRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"^\n" +
+	"\x18GetUpgradeReportResponse\x12\x12\n" +
+	"\x04json\x18\x01 \x01(\fR\x04json\x12\x1a\n" +
+	"\bmarkdown\x18\x02 \x01(\tR\bmarkdown\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\"\x15\n" +
 	"\x13ListNetworksRequest\"T\n" +
 	"\x14ListNetworksResponse\x12<\n" +
-	"\bnetworks\x18\x01 \x03(\v2 .devnetbuilder.v1.NetworkSummaryR\bnetworks\"\xe7\x01\n" +
+	"\bnetworks\x18\x01 \x03(\v2 .devnetbuilder.v1.NetworkSummaryR\bnetworks\"\xe5\x02\n" +
 	"\x0eNetworkSummary\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x18\n" +
@@ -5488,11 +13071,14 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"\vbinary_name\x18\x04 \x01(\tR\n" +
 	"binaryName\x12-\n" +
 	"\x12available_networks\x18\x05 \x03(\tR\x11availableNetworks\x124\n" +
-	"\x16default_binary_version\x18\x06 \x01(\tR\x14defaultBinaryVersion\"+\n" +
+	"\x16default_binary_version\x18\x06 \x01(\tR\x14defaultBinaryVersion\x12)\n" +
+	"\x10protocol_version\x18\a \x01(\x05R\x0fprotocolVersion\x12\"\n" +
+	"\fcapabilities\x18\b \x03(\tR\fcapabilities\x12-\n" +
+	"\x12capabilities_known\x18\t \x01(\bR\x11capabilitiesKnown\"+\n" +
 	"\x15GetNetworkInfoRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"Q\n" +
 	"\x16GetNetworkInfoResponse\x127\n" +
-	"\anetwork\x18\x01 \x01(\v2\x1d.devnetbuilder.v1.NetworkInfoR\anetwork\"\xae\x05\n" +
+	"\anetwork\x18\x01 \x01(\v2\x1d.devnetbuilder.v1.NetworkInfoR\anetwork\"\xf0\x06\n" +
 	"\vNetworkInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x18\n" +
@@ -5509,10 +13095,20 @@ const file_v1_devnet_proto_rawDesc = "" +
 	" \x03(\v2,.devnetbuilder.v1.NetworkInfo.EndpointsEntryR\tendpoints\x12!\n" +
 	"\fdocker_image\x18\v \x01(\tR\vdockerImage\x12&\n" +
 	"\x0fdocker_home_dir\x18\f \x01(\tR\rdockerHomeDir\x12H\n" +
-	"\rdefault_ports\x18\r \x01(\v2#.devnetbuilder.v1.NetworkPortConfigR\fdefaultPorts\x1a\\\n" +
+	"\rdefault_ports\x18\r \x01(\v2#.devnetbuilder.v1.NetworkPortConfigR\fdefaultPorts\x12B\n" +
+	"\n" +
+	"call_stats\x18\x0e \x03(\v2#.devnetbuilder.v1.PluginMethodStatsR\tcallStats\x12)\n" +
+	"\x10protocol_version\x18\x0f \x01(\x05R\x0fprotocolVersion\x12\"\n" +
+	"\fcapabilities\x18\x10 \x03(\tR\fcapabilities\x12-\n" +
+	"\x12capabilities_known\x18\x11 \x01(\bR\x11capabilitiesKnown\x1a\\\n" +
 	"\x0eEndpointsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x124\n" +
-	"\x05value\x18\x02 \x01(\v2\x1e.devnetbuilder.v1.EndpointInfoR\x05value:\x028\x01\"r\n" +
+	"\x05value\x18\x02 \x01(\v2\x1e.devnetbuilder.v1.EndpointInfoR\x05value:\x028\x01\"\x83\x01\n" +
+	"\x11PluginMethodStats\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x14\n" +
+	"\x05calls\x18\x02 \x01(\x04R\x05calls\x12\x1a\n" +
+	"\bfailures\x18\x03 \x01(\x04R\bfailures\x12$\n" +
+	"\runimplemented\x18\x04 \x01(\x04R\runimplemented\"r\n" +
 	"\x13NetworkBinarySource\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
 	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x12\n" +
@@ -5547,7 +13143,42 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"prerelease\x18\x03 \x01(\bR\n" +
 	"prerelease\x12=\n" +
 	"\fpublished_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12\x19\n" +
-	"\bhtml_url\x18\x05 \x01(\tR\ahtmlUrl\"\r\n" +
+	"\bhtml_url\x18\x05 \x01(\tR\ahtmlUrl\"\xa4\x01\n" +
+	"\x14InstallPluginRequest\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\fR\tpublicKey\x12)\n" +
+	"\x10allow_unverified\x18\x05 \x01(\bR\x0fallowUnverified\"\xe9\x01\n" +
+	"\x15InstallPluginResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x16\n" +
+	"\x06sha256\x18\x04 \x01(\tR\x06sha256\x12+\n" +
+	"\x11checksum_verified\x18\x05 \x01(\bR\x10checksumVerified\x12-\n" +
+	"\x12signature_verified\x18\x06 \x01(\bR\x11signatureVerified\x12\x1a\n" +
+	"\breplaced\x18\a \x01(\bR\breplaced\"\x97\x02\n" +
+	"\fBuildRequest\x12!\n" +
+	"\fnetwork_name\x18\x01 \x01(\tR\vnetworkName\x12\x17\n" +
+	"\agit_ref\x18\x02 \x01(\tR\x06gitRef\x12!\n" +
+	"\fnetwork_type\x18\x03 \x01(\tR\vnetworkType\x12\x14\n" +
+	"\x05image\x18\x04 \x01(\bR\x05image\x12\x10\n" +
+	"\x03tag\x18\x05 \x01(\tR\x03tag\x12\x19\n" +
+	"\bno_cache\x18\x06 \x01(\bR\anoCache\x12\x19\n" +
+	"\bgit_repo\x18\a \x01(\tR\agitRepo\x12\x1f\n" +
+	"\vforce_build\x18\b \x01(\bR\n" +
+	"forceBuild\x12)\n" +
+	"\x10allow_unverified\x18\t \x01(\bR\x0fallowUnverified\"\xb9\x01\n" +
+	"\rBuildResponse\x12\x1f\n" +
+	"\vbinary_path\x18\x01 \x01(\tR\n" +
+	"binaryPath\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x12\x1d\n" +
+	"\n" +
+	"git_commit\x18\x03 \x01(\tR\tgitCommit\x12\x17\n" +
+	"\agit_ref\x18\x04 \x01(\tR\x06gitRef\x12\x16\n" +
+	"\x06cached\x18\x05 \x01(\bR\x06cached\x12!\n" +
+	"\ffrom_release\x18\x06 \x01(\bR\vfromRelease\"\r\n" +
 	"\vPingRequest\"5\n" +
 	"\fPingResponse\x12%\n" +
 	"\x0eserver_version\x18\x01 \x01(\tR\rserverVersion\"\x0f\n" +
@@ -5556,12 +13187,47 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
 	"\n" +
 	"namespaces\x18\x02 \x03(\tR\n" +
-	"namespaces*\x9b\x01\n" +
+	"namespaces\"R\n" +
+	"\x19HandOffCredentialsRequest\x12\x1f\n" +
+	"\vsocket_path\x18\x01 \x01(\tR\n" +
+	"socketPath\x12\x14\n" +
+	"\x05nonce\x18\x02 \x01(\tR\x05nonce\"L\n" +
+	"\x1aHandOffCredentialsResponse\x12.\n" +
+	"\x13github_token_source\x18\x01 \x01(\tR\x11githubTokenSource\"\x1c\n" +
+	"\x1aGetCredentialStatusRequest\"M\n" +
+	"\x1bGetCredentialStatusResponse\x12.\n" +
+	"\x13github_token_source\x18\x01 \x01(\tR\x11githubTokenSource\"\xf0\x01\n" +
+	"\tNamespace\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x126\n" +
+	"\x05quota\x18\x02 \x01(\v2 .devnetbuilder.v1.NamespaceQuotaR\x05quota\x12!\n" +
+	"\fdevnet_count\x18\x03 \x01(\x05R\vdevnetCount\x12\x1d\n" +
+	"\n" +
+	"node_count\x18\x04 \x01(\x05R\tnodeCount\x12\x1a\n" +
+	"\bimplicit\x18\x05 \x01(\bR\bimplicit\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"N\n" +
+	"\x0eNamespaceQuota\x12\x1f\n" +
+	"\vmax_devnets\x18\x01 \x01(\x05R\n" +
+	"maxDevnets\x12\x1b\n" +
+	"\tmax_nodes\x18\x02 \x01(\x05R\bmaxNodes\"d\n" +
+	"\x16CreateNamespaceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x126\n" +
+	"\x05quota\x18\x02 \x01(\v2 .devnetbuilder.v1.NamespaceQuotaR\x05quota\"T\n" +
+	"\x17CreateNamespaceResponse\x129\n" +
+	"\tnamespace\x18\x01 \x01(\v2\x1b.devnetbuilder.v1.NamespaceR\tnamespace\"\x17\n" +
+	"\x15ListNamespacesRequest\"U\n" +
+	"\x16ListNamespacesResponse\x12;\n" +
+	"\n" +
+	"namespaces\x18\x01 \x03(\v2\x1b.devnetbuilder.v1.NamespaceR\n" +
+	"namespaces\",\n" +
+	"\x16DeleteNamespaceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x19\n" +
+	"\x17DeleteNamespaceResponse*\x9b\x01\n" +
 	"\x11NodeRestartPolicy\x12#\n" +
 	"\x1fNODE_RESTART_POLICY_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19NODE_RESTART_POLICY_NEVER\x10\x01\x12\"\n" +
 	"\x1eNODE_RESTART_POLICY_ON_FAILURE\x10\x02\x12\x1e\n" +
-	"\x1aNODE_RESTART_POLICY_ALWAYS\x10\x032\xe5\x06\n" +
+	"\x1aNODE_RESTART_POLICY_ALWAYS\x10\x032\xdb\x16\n" +
 	"\rDevnetService\x12]\n" +
 	"\fCreateDevnet\x12%.devnetbuilder.v1.CreateDevnetRequest\x1a&.devnetbuilder.v1.CreateDevnetResponse\x12T\n" +
 	"\tGetDevnet\x12\".devnetbuilder.v1.GetDevnetRequest\x1a#.devnetbuilder.v1.GetDevnetResponse\x12Z\n" +
@@ -5572,7 +13238,33 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"StopDevnet\x12#.devnetbuilder.v1.StopDevnetRequest\x1a$.devnetbuilder.v1.StopDevnetResponse\x12Z\n" +
 	"\vApplyDevnet\x12$.devnetbuilder.v1.ApplyDevnetRequest\x1a%.devnetbuilder.v1.ApplyDevnetResponse\x12]\n" +
 	"\fUpdateDevnet\x12%.devnetbuilder.v1.UpdateDevnetRequest\x1a&.devnetbuilder.v1.UpdateDevnetResponse\x12t\n" +
-	"\x13StreamProvisionLogs\x12,.devnetbuilder.v1.StreamProvisionLogsRequest\x1a-.devnetbuilder.v1.StreamProvisionLogsResponse0\x012\xb9\x06\n" +
+	"\x13StreamProvisionLogs\x12,.devnetbuilder.v1.StreamProvisionLogsRequest\x1a-.devnetbuilder.v1.StreamProvisionLogsResponse0\x01\x12c\n" +
+	"\x0eExportFixtures\x12'.devnetbuilder.v1.ExportFixturesRequest\x1a(.devnetbuilder.v1.ExportFixturesResponse\x12W\n" +
+	"\n" +
+	"ExportKeys\x12#.devnetbuilder.v1.ExportKeysRequest\x1a$.devnetbuilder.v1.ExportKeysResponse\x12l\n" +
+	"\x11DiffValidatorSets\x12*.devnetbuilder.v1.DiffValidatorSetsRequest\x1a+.devnetbuilder.v1.DiffValidatorSetsResponse\x12W\n" +
+	"\n" +
+	"ListBlocks\x12#.devnetbuilder.v1.ListBlocksRequest\x1a$.devnetbuilder.v1.ListBlocksResponse\x12Q\n" +
+	"\bGetBlock\x12!.devnetbuilder.v1.GetBlockRequest\x1a\".devnetbuilder.v1.GetBlockResponse\x12]\n" +
+	"\fExtendDevnet\x12%.devnetbuilder.v1.ExtendDevnetRequest\x1a&.devnetbuilder.v1.ExtendDevnetResponse\x12]\n" +
+	"\fSetBlockTime\x12%.devnetbuilder.v1.SetBlockTimeRequest\x1a&.devnetbuilder.v1.SetBlockTimeResponse\x12_\n" +
+	"\fWatchDevnets\x12%.devnetbuilder.v1.WatchDevnetsRequest\x1a&.devnetbuilder.v1.WatchDevnetsResponse0\x01\x12i\n" +
+	"\x10ListDevnetEvents\x12).devnetbuilder.v1.ListDevnetEventsRequest\x1a*.devnetbuilder.v1.ListDevnetEventsResponse\x12`\n" +
+	"\rGetPeerMatrix\x12&.devnetbuilder.v1.GetPeerMatrixRequest\x1a'.devnetbuilder.v1.GetPeerMatrixResponse\x12l\n" +
+	"\x11DiagnoseConsensus\x12*.devnetbuilder.v1.DiagnoseConsensusRequest\x1a+.devnetbuilder.v1.DiagnoseConsensusResponse\x12o\n" +
+	"\x12CollectDebugBundle\x12+.devnetbuilder.v1.CollectDebugBundleRequest\x1a,.devnetbuilder.v1.CollectDebugBundleResponse\x12`\n" +
+	"\rExportGenesis\x12&.devnetbuilder.v1.ExportGenesisRequest\x1a'.devnetbuilder.v1.ExportGenesisResponse\x12W\n" +
+	"\fExportDevnet\x12%.devnetbuilder.v1.ExportDevnetRequest\x1a\x1e.devnetbuilder.v1.ArchiveChunk0\x01\x12_\n" +
+	"\fImportDevnet\x12%.devnetbuilder.v1.ImportDevnetRequest\x1a&.devnetbuilder.v1.ImportDevnetResponse(\x01\x12`\n" +
+	"\rExportCompose\x12&.devnetbuilder.v1.ExportComposeRequest\x1a'.devnetbuilder.v1.ExportComposeResponse\x12]\n" +
+	"\fShapeNetwork\x12%.devnetbuilder.v1.ShapeNetworkRequest\x1a&.devnetbuilder.v1.ShapeNetworkResponse\x12W\n" +
+	"\n" +
+	"CheckImage\x12#.devnetbuilder.v1.CheckImageRequest\x1a$.devnetbuilder.v1.CheckImageResponse\x12W\n" +
+	"\n" +
+	"PruneNodes\x12#.devnetbuilder.v1.PruneNodesRequest\x1a$.devnetbuilder.v1.PruneNodesResponse\x12W\n" +
+	"\n" +
+	"SearchLogs\x12#.devnetbuilder.v1.SearchLogsRequest\x1a$.devnetbuilder.v1.SearchLogsResponse\x12i\n" +
+	"\x10InjectDoubleSign\x12).devnetbuilder.v1.InjectDoubleSignRequest\x1a*.devnetbuilder.v1.InjectDoubleSignResponse2\xca\t\n" +
 	"\vNodeService\x12T\n" +
 	"\tStartNode\x12\".devnetbuilder.v1.StartNodeRequest\x1a#.devnetbuilder.v1.StartNodeResponse\x12Q\n" +
 	"\bStopNode\x12!.devnetbuilder.v1.StopNodeRequest\x1a\".devnetbuilder.v1.StopNodeResponse\x12Z\n" +
@@ -5581,9 +13273,13 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"\tListNodes\x12\".devnetbuilder.v1.ListNodesRequest\x1a#.devnetbuilder.v1.ListNodesResponse\x12`\n" +
 	"\rGetNodeHealth\x12&.devnetbuilder.v1.GetNodeHealthRequest\x1a'.devnetbuilder.v1.GetNodeHealthResponse\x12e\n" +
 	"\x0eStreamNodeLogs\x12'.devnetbuilder.v1.StreamNodeLogsRequest\x1a(.devnetbuilder.v1.StreamNodeLogsResponse0\x01\x12]\n" +
-	"\fGetNodePorts\x12%.devnetbuilder.v1.GetNodePortsRequest\x1a&.devnetbuilder.v1.GetNodePortsResponse\x12W\n" +
+	"\fGetNodePorts\x12%.devnetbuilder.v1.GetNodePortsRequest\x1a&.devnetbuilder.v1.GetNodePortsResponse\x12`\n" +
+	"\rGetNodeConfig\x12&.devnetbuilder.v1.GetNodeConfigRequest\x1a'.devnetbuilder.v1.GetNodeConfigResponse\x12W\n" +
 	"\n" +
-	"ExecInNode\x12#.devnetbuilder.v1.ExecInNodeRequest\x1a$.devnetbuilder.v1.ExecInNodeResponse2\xcd\x04\n" +
+	"ExecInNode\x12#.devnetbuilder.v1.ExecInNodeRequest\x1a$.devnetbuilder.v1.ExecInNodeResponse\x12f\n" +
+	"\x0fApplyNodeConfig\x12(.devnetbuilder.v1.ApplyNodeConfigRequest\x1a).devnetbuilder.v1.ApplyNodeConfigResponse\x12c\n" +
+	"\x0eSyncNodeConfig\x12'.devnetbuilder.v1.SyncNodeConfigRequest\x1a(.devnetbuilder.v1.SyncNodeConfigResponse\x12`\n" +
+	"\rSetNodeBinary\x12&.devnetbuilder.v1.SetNodeBinaryRequest\x1a'.devnetbuilder.v1.SetNodeBinaryResponse2\xb8\x05\n" +
 	"\x0eUpgradeService\x12`\n" +
 	"\rCreateUpgrade\x12&.devnetbuilder.v1.CreateUpgradeRequest\x1a'.devnetbuilder.v1.CreateUpgradeResponse\x12W\n" +
 	"\n" +
@@ -5591,14 +13287,24 @@ const file_v1_devnet_proto_rawDesc = "" +
 	"\fListUpgrades\x12%.devnetbuilder.v1.ListUpgradesRequest\x1a&.devnetbuilder.v1.ListUpgradesResponse\x12`\n" +
 	"\rDeleteUpgrade\x12&.devnetbuilder.v1.DeleteUpgradeRequest\x1a'.devnetbuilder.v1.DeleteUpgradeResponse\x12`\n" +
 	"\rCancelUpgrade\x12&.devnetbuilder.v1.CancelUpgradeRequest\x1a'.devnetbuilder.v1.CancelUpgradeResponse\x12]\n" +
-	"\fRetryUpgrade\x12%.devnetbuilder.v1.RetryUpgradeRequest\x1a&.devnetbuilder.v1.RetryUpgradeResponse2\xc5\x02\n" +
+	"\fRetryUpgrade\x12%.devnetbuilder.v1.RetryUpgradeRequest\x1a&.devnetbuilder.v1.RetryUpgradeResponse\x12i\n" +
+	"\x10GetUpgradeReport\x12).devnetbuilder.v1.GetUpgradeReportRequest\x1a*.devnetbuilder.v1.GetUpgradeReportResponse2\xa7\x03\n" +
 	"\x0eNetworkService\x12]\n" +
 	"\fListNetworks\x12%.devnetbuilder.v1.ListNetworksRequest\x1a&.devnetbuilder.v1.ListNetworksResponse\x12c\n" +
 	"\x0eGetNetworkInfo\x12'.devnetbuilder.v1.GetNetworkInfoRequest\x1a(.devnetbuilder.v1.GetNetworkInfoResponse\x12o\n" +
-	"\x12ListBinaryVersions\x12+.devnetbuilder.v1.ListBinaryVersionsRequest\x1a,.devnetbuilder.v1.ListBinaryVersionsResponse2\xa1\x01\n" +
+	"\x12ListBinaryVersions\x12+.devnetbuilder.v1.ListBinaryVersionsRequest\x1a,.devnetbuilder.v1.ListBinaryVersionsResponse\x12`\n" +
+	"\rInstallPlugin\x12&.devnetbuilder.v1.InstallPluginRequest\x1a'.devnetbuilder.v1.InstallPluginResponse2X\n" +
+	"\fBuildService\x12H\n" +
+	"\x05Build\x12\x1e.devnetbuilder.v1.BuildRequest\x1a\x1f.devnetbuilder.v1.BuildResponse2\x86\x03\n" +
 	"\vAuthService\x12E\n" +
 	"\x04Ping\x12\x1d.devnetbuilder.v1.PingRequest\x1a\x1e.devnetbuilder.v1.PingResponse\x12K\n" +
-	"\x06WhoAmI\x12\x1f.devnetbuilder.v1.WhoAmIRequest\x1a .devnetbuilder.v1.WhoAmIResponseB\xcd\x01\n" +
+	"\x06WhoAmI\x12\x1f.devnetbuilder.v1.WhoAmIRequest\x1a .devnetbuilder.v1.WhoAmIResponse\x12o\n" +
+	"\x12HandOffCredentials\x12+.devnetbuilder.v1.HandOffCredentialsRequest\x1a,.devnetbuilder.v1.HandOffCredentialsResponse\x12r\n" +
+	"\x13GetCredentialStatus\x12,.devnetbuilder.v1.GetCredentialStatusRequest\x1a-.devnetbuilder.v1.GetCredentialStatusResponse2\xc7\x02\n" +
+	"\x10NamespaceService\x12f\n" +
+	"\x0fCreateNamespace\x12(.devnetbuilder.v1.CreateNamespaceRequest\x1a).devnetbuilder.v1.CreateNamespaceResponse\x12c\n" +
+	"\x0eListNamespaces\x12'.devnetbuilder.v1.ListNamespacesRequest\x1a(.devnetbuilder.v1.ListNamespacesResponse\x12f\n" +
+	"\x0fDeleteNamespace\x12(.devnetbuilder.v1.DeleteNamespaceRequest\x1a).devnetbuilder.v1.DeleteNamespaceResponseB\xcd\x01\n" +
 	"\x14com.devnetbuilder.v1B\vDevnetProtoP\x01ZGgithub.com/altuslabsxyz/devnet-builder/api/proto/gen/v1;devnetbuilderv1\xa2\x02\x03DXX\xaa\x02\x10Devnetbuilder.V1\xca\x02\x10Devnetbuilder\\V1\xe2\x02\x1cDevnetbuilder\\V1\\GPBMetadata\xea\x02\x11Devnetbuilder::V1b\x06proto3"
 
 var (
@@ -5614,228 +13320,461 @@ func file_v1_devnet_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_devnet_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_devnet_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
+var file_v1_devnet_proto_msgTypes = make([]protoimpl.MessageInfo, 193)
 var file_v1_devnet_proto_goTypes = []any{
 	(NodeRestartPolicy)(0),              // 0: devnetbuilder.v1.NodeRestartPolicy
 	(*Devnet)(nil),                      // 1: devnetbuilder.v1.Devnet
 	(*DevnetMetadata)(nil),              // 2: devnetbuilder.v1.DevnetMetadata
 	(*DevnetSpec)(nil),                  // 3: devnetbuilder.v1.DevnetSpec
-	(*DevnetStatus)(nil),                // 4: devnetbuilder.v1.DevnetStatus
-	(*Condition)(nil),                   // 5: devnetbuilder.v1.Condition
-	(*Event)(nil),                       // 6: devnetbuilder.v1.Event
-	(*CreateDevnetRequest)(nil),         // 7: devnetbuilder.v1.CreateDevnetRequest
-	(*CreateDevnetResponse)(nil),        // 8: devnetbuilder.v1.CreateDevnetResponse
-	(*GetDevnetRequest)(nil),            // 9: devnetbuilder.v1.GetDevnetRequest
-	(*GetDevnetResponse)(nil),           // 10: devnetbuilder.v1.GetDevnetResponse
-	(*ListDevnetsRequest)(nil),          // 11: devnetbuilder.v1.ListDevnetsRequest
-	(*ListDevnetsResponse)(nil),         // 12: devnetbuilder.v1.ListDevnetsResponse
-	(*DeleteDevnetRequest)(nil),         // 13: devnetbuilder.v1.DeleteDevnetRequest
-	(*DeleteDevnetResponse)(nil),        // 14: devnetbuilder.v1.DeleteDevnetResponse
-	(*StartDevnetRequest)(nil),          // 15: devnetbuilder.v1.StartDevnetRequest
-	(*StartDevnetResponse)(nil),         // 16: devnetbuilder.v1.StartDevnetResponse
-	(*StopDevnetRequest)(nil),           // 17: devnetbuilder.v1.StopDevnetRequest
-	(*StopDevnetResponse)(nil),          // 18: devnetbuilder.v1.StopDevnetResponse
-	(*ApplyDevnetRequest)(nil),          // 19: devnetbuilder.v1.ApplyDevnetRequest
-	(*ApplyDevnetResponse)(nil),         // 20: devnetbuilder.v1.ApplyDevnetResponse
-	(*UpdateDevnetRequest)(nil),         // 21: devnetbuilder.v1.UpdateDevnetRequest
-	(*UpdateDevnetResponse)(nil),        // 22: devnetbuilder.v1.UpdateDevnetResponse
-	(*StreamProvisionLogsRequest)(nil),  // 23: devnetbuilder.v1.StreamProvisionLogsRequest
-	(*StreamProvisionLogsResponse)(nil), // 24: devnetbuilder.v1.StreamProvisionLogsResponse
-	(*Node)(nil),                        // 25: devnetbuilder.v1.Node
-	(*NodeMetadata)(nil),                // 26: devnetbuilder.v1.NodeMetadata
-	(*NodeSpec)(nil),                    // 27: devnetbuilder.v1.NodeSpec
-	(*NodeStatus)(nil),                  // 28: devnetbuilder.v1.NodeStatus
-	(*NodeHealth)(nil),                  // 29: devnetbuilder.v1.NodeHealth
-	(*StartNodeRequest)(nil),            // 30: devnetbuilder.v1.StartNodeRequest
-	(*StartNodeResponse)(nil),           // 31: devnetbuilder.v1.StartNodeResponse
-	(*StopNodeRequest)(nil),             // 32: devnetbuilder.v1.StopNodeRequest
-	(*StopNodeResponse)(nil),            // 33: devnetbuilder.v1.StopNodeResponse
-	(*RestartNodeRequest)(nil),          // 34: devnetbuilder.v1.RestartNodeRequest
-	(*RestartNodeResponse)(nil),         // 35: devnetbuilder.v1.RestartNodeResponse
-	(*GetNodeRequest)(nil),              // 36: devnetbuilder.v1.GetNodeRequest
-	(*GetNodeResponse)(nil),             // 37: devnetbuilder.v1.GetNodeResponse
-	(*ListNodesRequest)(nil),            // 38: devnetbuilder.v1.ListNodesRequest
-	(*ListNodesResponse)(nil),           // 39: devnetbuilder.v1.ListNodesResponse
-	(*GetNodeHealthRequest)(nil),        // 40: devnetbuilder.v1.GetNodeHealthRequest
-	(*GetNodeHealthResponse)(nil),       // 41: devnetbuilder.v1.GetNodeHealthResponse
-	(*StreamNodeLogsRequest)(nil),       // 42: devnetbuilder.v1.StreamNodeLogsRequest
-	(*StreamNodeLogsResponse)(nil),      // 43: devnetbuilder.v1.StreamNodeLogsResponse
-	(*ExecInNodeRequest)(nil),           // 44: devnetbuilder.v1.ExecInNodeRequest
-	(*ExecInNodeResponse)(nil),          // 45: devnetbuilder.v1.ExecInNodeResponse
-	(*PortMapping)(nil),                 // 46: devnetbuilder.v1.PortMapping
-	(*GetNodePortsRequest)(nil),         // 47: devnetbuilder.v1.GetNodePortsRequest
-	(*GetNodePortsResponse)(nil),        // 48: devnetbuilder.v1.GetNodePortsResponse
-	(*Upgrade)(nil),                     // 49: devnetbuilder.v1.Upgrade
-	(*UpgradeMetadata)(nil),             // 50: devnetbuilder.v1.UpgradeMetadata
-	(*UpgradeSpec)(nil),                 // 51: devnetbuilder.v1.UpgradeSpec
-	(*BinarySource)(nil),                // 52: devnetbuilder.v1.BinarySource
-	(*UpgradeStatus)(nil),               // 53: devnetbuilder.v1.UpgradeStatus
-	(*CreateUpgradeRequest)(nil),        // 54: devnetbuilder.v1.CreateUpgradeRequest
-	(*CreateUpgradeResponse)(nil),       // 55: devnetbuilder.v1.CreateUpgradeResponse
-	(*GetUpgradeRequest)(nil),           // 56: devnetbuilder.v1.GetUpgradeRequest
-	(*GetUpgradeResponse)(nil),          // 57: devnetbuilder.v1.GetUpgradeResponse
-	(*ListUpgradesRequest)(nil),         // 58: devnetbuilder.v1.ListUpgradesRequest
-	(*ListUpgradesResponse)(nil),        // 59: devnetbuilder.v1.ListUpgradesResponse
-	(*DeleteUpgradeRequest)(nil),        // 60: devnetbuilder.v1.DeleteUpgradeRequest
-	(*DeleteUpgradeResponse)(nil),       // 61: devnetbuilder.v1.DeleteUpgradeResponse
-	(*CancelUpgradeRequest)(nil),        // 62: devnetbuilder.v1.CancelUpgradeRequest
-	(*CancelUpgradeResponse)(nil),       // 63: devnetbuilder.v1.CancelUpgradeResponse
-	(*RetryUpgradeRequest)(nil),         // 64: devnetbuilder.v1.RetryUpgradeRequest
-	(*RetryUpgradeResponse)(nil),        // 65: devnetbuilder.v1.RetryUpgradeResponse
-	(*ListNetworksRequest)(nil),         // 66: devnetbuilder.v1.ListNetworksRequest
-	(*ListNetworksResponse)(nil),        // 67: devnetbuilder.v1.ListNetworksResponse
-	(*NetworkSummary)(nil),              // 68: devnetbuilder.v1.NetworkSummary
-	(*GetNetworkInfoRequest)(nil),       // 69: devnetbuilder.v1.GetNetworkInfoRequest
-	(*GetNetworkInfoResponse)(nil),      // 70: devnetbuilder.v1.GetNetworkInfoResponse
-	(*NetworkInfo)(nil),                 // 71: devnetbuilder.v1.NetworkInfo
-	(*NetworkBinarySource)(nil),         // 72: devnetbuilder.v1.NetworkBinarySource
-	(*EndpointInfo)(nil),                // 73: devnetbuilder.v1.EndpointInfo
-	(*NetworkPortConfig)(nil),           // 74: devnetbuilder.v1.NetworkPortConfig
-	(*ListBinaryVersionsRequest)(nil),   // 75: devnetbuilder.v1.ListBinaryVersionsRequest
-	(*ListBinaryVersionsResponse)(nil),  // 76: devnetbuilder.v1.ListBinaryVersionsResponse
-	(*BinaryVersionInfo)(nil),           // 77: devnetbuilder.v1.BinaryVersionInfo
-	(*PingRequest)(nil),                 // 78: devnetbuilder.v1.PingRequest
-	(*PingResponse)(nil),                // 79: devnetbuilder.v1.PingResponse
-	(*WhoAmIRequest)(nil),               // 80: devnetbuilder.v1.WhoAmIRequest
-	(*WhoAmIResponse)(nil),              // 81: devnetbuilder.v1.WhoAmIResponse
-	nil,                                 // 82: devnetbuilder.v1.DevnetMetadata.LabelsEntry
-	nil,                                 // 83: devnetbuilder.v1.DevnetMetadata.AnnotationsEntry
-	nil,                                 // 84: devnetbuilder.v1.CreateDevnetRequest.LabelsEntry
-	nil,                                 // 85: devnetbuilder.v1.ApplyDevnetRequest.LabelsEntry
-	nil,                                 // 86: devnetbuilder.v1.ApplyDevnetRequest.AnnotationsEntry
-	nil,                                 // 87: devnetbuilder.v1.UpdateDevnetRequest.LabelsEntry
-	nil,                                 // 88: devnetbuilder.v1.UpdateDevnetRequest.AnnotationsEntry
-	nil,                                 // 89: devnetbuilder.v1.NetworkInfo.EndpointsEntry
-	(*timestamppb.Timestamp)(nil),       // 90: google.protobuf.Timestamp
+	(*NetworkShaping)(nil),              // 4: devnetbuilder.v1.NetworkShaping
+	(*NetworkLink)(nil),                 // 5: devnetbuilder.v1.NetworkLink
+	(*RemoteHost)(nil),                  // 6: devnetbuilder.v1.RemoteHost
+	(*Hooks)(nil),                       // 7: devnetbuilder.v1.Hooks
+	(*Hook)(nil),                        // 8: devnetbuilder.v1.Hook
+	(*Bootstrap)(nil),                   // 9: devnetbuilder.v1.Bootstrap
+	(*Contract)(nil),                    // 10: devnetbuilder.v1.Contract
+	(*Webhook)(nil),                     // 11: devnetbuilder.v1.Webhook
+	(*NodeConfig)(nil),                  // 12: devnetbuilder.v1.NodeConfig
+	(*GenesisPrune)(nil),                // 13: devnetbuilder.v1.GenesisPrune
+	(*VotingPower)(nil),                 // 14: devnetbuilder.v1.VotingPower
+	(*RemoteSigner)(nil),                // 15: devnetbuilder.v1.RemoteSigner
+	(*ValidatorKeys)(nil),               // 16: devnetbuilder.v1.ValidatorKeys
+	(*PortLayout)(nil),                  // 17: devnetbuilder.v1.PortLayout
+	(*FundedAccount)(nil),               // 18: devnetbuilder.v1.FundedAccount
+	(*VestingSchedule)(nil),             // 19: devnetbuilder.v1.VestingSchedule
+	(*DevnetStatus)(nil),                // 20: devnetbuilder.v1.DevnetStatus
+	(*Condition)(nil),                   // 21: devnetbuilder.v1.Condition
+	(*Event)(nil),                       // 22: devnetbuilder.v1.Event
+	(*CreateDevnetRequest)(nil),         // 23: devnetbuilder.v1.CreateDevnetRequest
+	(*CreateDevnetResponse)(nil),        // 24: devnetbuilder.v1.CreateDevnetResponse
+	(*GetDevnetRequest)(nil),            // 25: devnetbuilder.v1.GetDevnetRequest
+	(*GetDevnetResponse)(nil),           // 26: devnetbuilder.v1.GetDevnetResponse
+	(*ListDevnetsRequest)(nil),          // 27: devnetbuilder.v1.ListDevnetsRequest
+	(*ListDevnetsResponse)(nil),         // 28: devnetbuilder.v1.ListDevnetsResponse
+	(*DeleteDevnetRequest)(nil),         // 29: devnetbuilder.v1.DeleteDevnetRequest
+	(*DeleteDevnetResponse)(nil),        // 30: devnetbuilder.v1.DeleteDevnetResponse
+	(*StartDevnetRequest)(nil),          // 31: devnetbuilder.v1.StartDevnetRequest
+	(*StartDevnetResponse)(nil),         // 32: devnetbuilder.v1.StartDevnetResponse
+	(*StopDevnetRequest)(nil),           // 33: devnetbuilder.v1.StopDevnetRequest
+	(*StopDevnetResponse)(nil),          // 34: devnetbuilder.v1.StopDevnetResponse
+	(*ApplyDevnetRequest)(nil),          // 35: devnetbuilder.v1.ApplyDevnetRequest
+	(*ApplyDevnetResponse)(nil),         // 36: devnetbuilder.v1.ApplyDevnetResponse
+	(*UpdateDevnetRequest)(nil),         // 37: devnetbuilder.v1.UpdateDevnetRequest
+	(*UpdateDevnetResponse)(nil),        // 38: devnetbuilder.v1.UpdateDevnetResponse
+	(*StreamProvisionLogsRequest)(nil),  // 39: devnetbuilder.v1.StreamProvisionLogsRequest
+	(*StreamProvisionLogsResponse)(nil), // 40: devnetbuilder.v1.StreamProvisionLogsResponse
+	(*ExportFixturesRequest)(nil),       // 41: devnetbuilder.v1.ExportFixturesRequest
+	(*FixtureFile)(nil),                 // 42: devnetbuilder.v1.FixtureFile
+	(*ExportFixturesResponse)(nil),      // 43: devnetbuilder.v1.ExportFixturesResponse
+	(*ExportKeysRequest)(nil),           // 44: devnetbuilder.v1.ExportKeysRequest
+	(*AccountKey)(nil),                  // 45: devnetbuilder.v1.AccountKey
+	(*ExportKeysResponse)(nil),          // 46: devnetbuilder.v1.ExportKeysResponse
+	(*DiffValidatorSetsRequest)(nil),    // 47: devnetbuilder.v1.DiffValidatorSetsRequest
+	(*ValidatorSetChange)(nil),          // 48: devnetbuilder.v1.ValidatorSetChange
+	(*DiffValidatorSetsResponse)(nil),   // 49: devnetbuilder.v1.DiffValidatorSetsResponse
+	(*ListBlocksRequest)(nil),           // 50: devnetbuilder.v1.ListBlocksRequest
+	(*BlockSummary)(nil),                // 51: devnetbuilder.v1.BlockSummary
+	(*ListBlocksResponse)(nil),          // 52: devnetbuilder.v1.ListBlocksResponse
+	(*GetBlockRequest)(nil),             // 53: devnetbuilder.v1.GetBlockRequest
+	(*BlockTx)(nil),                     // 54: devnetbuilder.v1.BlockTx
+	(*GetBlockResponse)(nil),            // 55: devnetbuilder.v1.GetBlockResponse
+	(*ExtendDevnetRequest)(nil),         // 56: devnetbuilder.v1.ExtendDevnetRequest
+	(*ExtendDevnetResponse)(nil),        // 57: devnetbuilder.v1.ExtendDevnetResponse
+	(*SetBlockTimeRequest)(nil),         // 58: devnetbuilder.v1.SetBlockTimeRequest
+	(*SetBlockTimeResponse)(nil),        // 59: devnetbuilder.v1.SetBlockTimeResponse
+	(*WatchDevnetsRequest)(nil),         // 60: devnetbuilder.v1.WatchDevnetsRequest
+	(*WatchDevnetsResponse)(nil),        // 61: devnetbuilder.v1.WatchDevnetsResponse
+	(*ListDevnetEventsRequest)(nil),     // 62: devnetbuilder.v1.ListDevnetEventsRequest
+	(*ListDevnetEventsResponse)(nil),    // 63: devnetbuilder.v1.ListDevnetEventsResponse
+	(*GetPeerMatrixRequest)(nil),        // 64: devnetbuilder.v1.GetPeerMatrixRequest
+	(*GetPeerMatrixResponse)(nil),       // 65: devnetbuilder.v1.GetPeerMatrixResponse
+	(*PeerNode)(nil),                    // 66: devnetbuilder.v1.PeerNode
+	(*PeerWarning)(nil),                 // 67: devnetbuilder.v1.PeerWarning
+	(*DiagnoseConsensusRequest)(nil),    // 68: devnetbuilder.v1.DiagnoseConsensusRequest
+	(*DiagnoseConsensusResponse)(nil),   // 69: devnetbuilder.v1.DiagnoseConsensusResponse
+	(*ConsensusValidator)(nil),          // 70: devnetbuilder.v1.ConsensusValidator
+	(*ConsensusNode)(nil),               // 71: devnetbuilder.v1.ConsensusNode
+	(*CollectDebugBundleRequest)(nil),   // 72: devnetbuilder.v1.CollectDebugBundleRequest
+	(*BundleFile)(nil),                  // 73: devnetbuilder.v1.BundleFile
+	(*CollectDebugBundleResponse)(nil),  // 74: devnetbuilder.v1.CollectDebugBundleResponse
+	(*ExportGenesisRequest)(nil),        // 75: devnetbuilder.v1.ExportGenesisRequest
+	(*ExportGenesisResponse)(nil),       // 76: devnetbuilder.v1.ExportGenesisResponse
+	(*ExportComposeRequest)(nil),        // 77: devnetbuilder.v1.ExportComposeRequest
+	(*ExportComposeResponse)(nil),       // 78: devnetbuilder.v1.ExportComposeResponse
+	(*ShapeNetworkRequest)(nil),         // 79: devnetbuilder.v1.ShapeNetworkRequest
+	(*ShapeNetworkResponse)(nil),        // 80: devnetbuilder.v1.ShapeNetworkResponse
+	(*ShapedNode)(nil),                  // 81: devnetbuilder.v1.ShapedNode
+	(*CheckImageRequest)(nil),           // 82: devnetbuilder.v1.CheckImageRequest
+	(*CheckImageResponse)(nil),          // 83: devnetbuilder.v1.CheckImageResponse
+	(*PruneNodesRequest)(nil),           // 84: devnetbuilder.v1.PruneNodesRequest
+	(*PruneNodesResponse)(nil),          // 85: devnetbuilder.v1.PruneNodesResponse
+	(*PrunedNode)(nil),                  // 86: devnetbuilder.v1.PrunedNode
+	(*SearchLogsRequest)(nil),           // 87: devnetbuilder.v1.SearchLogsRequest
+	(*LogMatch)(nil),                    // 88: devnetbuilder.v1.LogMatch
+	(*SearchLogsResponse)(nil),          // 89: devnetbuilder.v1.SearchLogsResponse
+	(*InjectDoubleSignRequest)(nil),     // 90: devnetbuilder.v1.InjectDoubleSignRequest
+	(*InjectDoubleSignResponse)(nil),    // 91: devnetbuilder.v1.InjectDoubleSignResponse
+	(*ExportDevnetRequest)(nil),         // 92: devnetbuilder.v1.ExportDevnetRequest
+	(*ArchiveChunk)(nil),                // 93: devnetbuilder.v1.ArchiveChunk
+	(*ImportDevnetRequest)(nil),         // 94: devnetbuilder.v1.ImportDevnetRequest
+	(*ImportDevnetResponse)(nil),        // 95: devnetbuilder.v1.ImportDevnetResponse
+	(*ConsensusHypothesis)(nil),         // 96: devnetbuilder.v1.ConsensusHypothesis
+	(*Node)(nil),                        // 97: devnetbuilder.v1.Node
+	(*NodeMetadata)(nil),                // 98: devnetbuilder.v1.NodeMetadata
+	(*NodeSpec)(nil),                    // 99: devnetbuilder.v1.NodeSpec
+	(*NodeStatus)(nil),                  // 100: devnetbuilder.v1.NodeStatus
+	(*NodeHealth)(nil),                  // 101: devnetbuilder.v1.NodeHealth
+	(*StartNodeRequest)(nil),            // 102: devnetbuilder.v1.StartNodeRequest
+	(*StartNodeResponse)(nil),           // 103: devnetbuilder.v1.StartNodeResponse
+	(*StopNodeRequest)(nil),             // 104: devnetbuilder.v1.StopNodeRequest
+	(*StopNodeResponse)(nil),            // 105: devnetbuilder.v1.StopNodeResponse
+	(*RestartNodeRequest)(nil),          // 106: devnetbuilder.v1.RestartNodeRequest
+	(*RestartNodeResponse)(nil),         // 107: devnetbuilder.v1.RestartNodeResponse
+	(*GetNodeRequest)(nil),              // 108: devnetbuilder.v1.GetNodeRequest
+	(*GetNodeResponse)(nil),             // 109: devnetbuilder.v1.GetNodeResponse
+	(*ListNodesRequest)(nil),            // 110: devnetbuilder.v1.ListNodesRequest
+	(*ListNodesResponse)(nil),           // 111: devnetbuilder.v1.ListNodesResponse
+	(*GetNodeHealthRequest)(nil),        // 112: devnetbuilder.v1.GetNodeHealthRequest
+	(*GetNodeHealthResponse)(nil),       // 113: devnetbuilder.v1.GetNodeHealthResponse
+	(*StreamNodeLogsRequest)(nil),       // 114: devnetbuilder.v1.StreamNodeLogsRequest
+	(*StreamNodeLogsResponse)(nil),      // 115: devnetbuilder.v1.StreamNodeLogsResponse
+	(*ExecInNodeRequest)(nil),           // 116: devnetbuilder.v1.ExecInNodeRequest
+	(*ExecInNodeResponse)(nil),          // 117: devnetbuilder.v1.ExecInNodeResponse
+	(*ApplyNodeConfigRequest)(nil),      // 118: devnetbuilder.v1.ApplyNodeConfigRequest
+	(*ConfigChange)(nil),                // 119: devnetbuilder.v1.ConfigChange
+	(*ApplyNodeConfigResponse)(nil),     // 120: devnetbuilder.v1.ApplyNodeConfigResponse
+	(*GetNodeConfigRequest)(nil),        // 121: devnetbuilder.v1.GetNodeConfigRequest
+	(*NodeConfigField)(nil),             // 122: devnetbuilder.v1.NodeConfigField
+	(*GetNodeConfigResponse)(nil),       // 123: devnetbuilder.v1.GetNodeConfigResponse
+	(*SyncNodeConfigRequest)(nil),       // 124: devnetbuilder.v1.SyncNodeConfigRequest
+	(*ConfigDrift)(nil),                 // 125: devnetbuilder.v1.ConfigDrift
+	(*SyncNodeConfigResponse)(nil),      // 126: devnetbuilder.v1.SyncNodeConfigResponse
+	(*SetNodeBinaryRequest)(nil),        // 127: devnetbuilder.v1.SetNodeBinaryRequest
+	(*SetNodeBinaryResponse)(nil),       // 128: devnetbuilder.v1.SetNodeBinaryResponse
+	(*PortMapping)(nil),                 // 129: devnetbuilder.v1.PortMapping
+	(*GetNodePortsRequest)(nil),         // 130: devnetbuilder.v1.GetNodePortsRequest
+	(*GetNodePortsResponse)(nil),        // 131: devnetbuilder.v1.GetNodePortsResponse
+	(*Upgrade)(nil),                     // 132: devnetbuilder.v1.Upgrade
+	(*UpgradeMetadata)(nil),             // 133: devnetbuilder.v1.UpgradeMetadata
+	(*UpgradeSpec)(nil),                 // 134: devnetbuilder.v1.UpgradeSpec
+	(*BinarySource)(nil),                // 135: devnetbuilder.v1.BinarySource
+	(*UpgradeStatus)(nil),               // 136: devnetbuilder.v1.UpgradeStatus
+	(*CreateUpgradeRequest)(nil),        // 137: devnetbuilder.v1.CreateUpgradeRequest
+	(*CreateUpgradeResponse)(nil),       // 138: devnetbuilder.v1.CreateUpgradeResponse
+	(*GetUpgradeRequest)(nil),           // 139: devnetbuilder.v1.GetUpgradeRequest
+	(*GetUpgradeResponse)(nil),          // 140: devnetbuilder.v1.GetUpgradeResponse
+	(*ListUpgradesRequest)(nil),         // 141: devnetbuilder.v1.ListUpgradesRequest
+	(*ListUpgradesResponse)(nil),        // 142: devnetbuilder.v1.ListUpgradesResponse
+	(*DeleteUpgradeRequest)(nil),        // 143: devnetbuilder.v1.DeleteUpgradeRequest
+	(*DeleteUpgradeResponse)(nil),       // 144: devnetbuilder.v1.DeleteUpgradeResponse
+	(*CancelUpgradeRequest)(nil),        // 145: devnetbuilder.v1.CancelUpgradeRequest
+	(*CancelUpgradeResponse)(nil),       // 146: devnetbuilder.v1.CancelUpgradeResponse
+	(*RetryUpgradeRequest)(nil),         // 147: devnetbuilder.v1.RetryUpgradeRequest
+	(*RetryUpgradeResponse)(nil),        // 148: devnetbuilder.v1.RetryUpgradeResponse
+	(*GetUpgradeReportRequest)(nil),     // 149: devnetbuilder.v1.GetUpgradeReportRequest
+	(*GetUpgradeReportResponse)(nil),    // 150: devnetbuilder.v1.GetUpgradeReportResponse
+	(*ListNetworksRequest)(nil),         // 151: devnetbuilder.v1.ListNetworksRequest
+	(*ListNetworksResponse)(nil),        // 152: devnetbuilder.v1.ListNetworksResponse
+	(*NetworkSummary)(nil),              // 153: devnetbuilder.v1.NetworkSummary
+	(*GetNetworkInfoRequest)(nil),       // 154: devnetbuilder.v1.GetNetworkInfoRequest
+	(*GetNetworkInfoResponse)(nil),      // 155: devnetbuilder.v1.GetNetworkInfoResponse
+	(*NetworkInfo)(nil),                 // 156: devnetbuilder.v1.NetworkInfo
+	(*PluginMethodStats)(nil),           // 157: devnetbuilder.v1.PluginMethodStats
+	(*NetworkBinarySource)(nil),         // 158: devnetbuilder.v1.NetworkBinarySource
+	(*EndpointInfo)(nil),                // 159: devnetbuilder.v1.EndpointInfo
+	(*NetworkPortConfig)(nil),           // 160: devnetbuilder.v1.NetworkPortConfig
+	(*ListBinaryVersionsRequest)(nil),   // 161: devnetbuilder.v1.ListBinaryVersionsRequest
+	(*ListBinaryVersionsResponse)(nil),  // 162: devnetbuilder.v1.ListBinaryVersionsResponse
+	(*BinaryVersionInfo)(nil),           // 163: devnetbuilder.v1.BinaryVersionInfo
+	(*InstallPluginRequest)(nil),        // 164: devnetbuilder.v1.InstallPluginRequest
+	(*InstallPluginResponse)(nil),       // 165: devnetbuilder.v1.InstallPluginResponse
+	(*BuildRequest)(nil),                // 166: devnetbuilder.v1.BuildRequest
+	(*BuildResponse)(nil),               // 167: devnetbuilder.v1.BuildResponse
+	(*PingRequest)(nil),                 // 168: devnetbuilder.v1.PingRequest
+	(*PingResponse)(nil),                // 169: devnetbuilder.v1.PingResponse
+	(*WhoAmIRequest)(nil),               // 170: devnetbuilder.v1.WhoAmIRequest
+	(*WhoAmIResponse)(nil),              // 171: devnetbuilder.v1.WhoAmIResponse
+	(*HandOffCredentialsRequest)(nil),   // 172: devnetbuilder.v1.HandOffCredentialsRequest
+	(*HandOffCredentialsResponse)(nil),  // 173: devnetbuilder.v1.HandOffCredentialsResponse
+	(*GetCredentialStatusRequest)(nil),  // 174: devnetbuilder.v1.GetCredentialStatusRequest
+	(*GetCredentialStatusResponse)(nil), // 175: devnetbuilder.v1.GetCredentialStatusResponse
+	(*Namespace)(nil),                   // 176: devnetbuilder.v1.Namespace
+	(*NamespaceQuota)(nil),              // 177: devnetbuilder.v1.NamespaceQuota
+	(*CreateNamespaceRequest)(nil),      // 178: devnetbuilder.v1.CreateNamespaceRequest
+	(*CreateNamespaceResponse)(nil),     // 179: devnetbuilder.v1.CreateNamespaceResponse
+	(*ListNamespacesRequest)(nil),       // 180: devnetbuilder.v1.ListNamespacesRequest
+	(*ListNamespacesResponse)(nil),      // 181: devnetbuilder.v1.ListNamespacesResponse
+	(*DeleteNamespaceRequest)(nil),      // 182: devnetbuilder.v1.DeleteNamespaceRequest
+	(*DeleteNamespaceResponse)(nil),     // 183: devnetbuilder.v1.DeleteNamespaceResponse
+	nil,                                 // 184: devnetbuilder.v1.DevnetMetadata.LabelsEntry
+	nil,                                 // 185: devnetbuilder.v1.DevnetMetadata.AnnotationsEntry
+	nil,                                 // 186: devnetbuilder.v1.DevnetSpec.GenesisOverridesEntry
+	nil,                                 // 187: devnetbuilder.v1.NetworkShaping.RegionsEntry
+	nil,                                 // 188: devnetbuilder.v1.CreateDevnetRequest.LabelsEntry
+	nil,                                 // 189: devnetbuilder.v1.ApplyDevnetRequest.LabelsEntry
+	nil,                                 // 190: devnetbuilder.v1.ApplyDevnetRequest.AnnotationsEntry
+	nil,                                 // 191: devnetbuilder.v1.UpdateDevnetRequest.LabelsEntry
+	nil,                                 // 192: devnetbuilder.v1.UpdateDevnetRequest.AnnotationsEntry
+	nil,                                 // 193: devnetbuilder.v1.NetworkInfo.EndpointsEntry
+	(*timestamppb.Timestamp)(nil),       // 194: google.protobuf.Timestamp
+	(*TxTraceMessage)(nil),              // 195: devnetbuilder.v1.TxTraceMessage
 }
 var file_v1_devnet_proto_depIdxs = []int32{
-	2,  // 0: devnetbuilder.v1.Devnet.metadata:type_name -> devnetbuilder.v1.DevnetMetadata
-	3,  // 1: devnetbuilder.v1.Devnet.spec:type_name -> devnetbuilder.v1.DevnetSpec
-	4,  // 2: devnetbuilder.v1.Devnet.status:type_name -> devnetbuilder.v1.DevnetStatus
-	90, // 3: devnetbuilder.v1.DevnetMetadata.created_at:type_name -> google.protobuf.Timestamp
-	90, // 4: devnetbuilder.v1.DevnetMetadata.updated_at:type_name -> google.protobuf.Timestamp
-	82, // 5: devnetbuilder.v1.DevnetMetadata.labels:type_name -> devnetbuilder.v1.DevnetMetadata.LabelsEntry
-	83, // 6: devnetbuilder.v1.DevnetMetadata.annotations:type_name -> devnetbuilder.v1.DevnetMetadata.AnnotationsEntry
-	90, // 7: devnetbuilder.v1.DevnetStatus.last_health_check:type_name -> google.protobuf.Timestamp
-	5,  // 8: devnetbuilder.v1.DevnetStatus.conditions:type_name -> devnetbuilder.v1.Condition
-	6,  // 9: devnetbuilder.v1.DevnetStatus.events:type_name -> devnetbuilder.v1.Event
-	90, // 10: devnetbuilder.v1.Condition.last_transition_time:type_name -> google.protobuf.Timestamp
-	90, // 11: devnetbuilder.v1.Event.timestamp:type_name -> google.protobuf.Timestamp
-	3,  // 12: devnetbuilder.v1.CreateDevnetRequest.spec:type_name -> devnetbuilder.v1.DevnetSpec
-	84, // 13: devnetbuilder.v1.CreateDevnetRequest.labels:type_name -> devnetbuilder.v1.CreateDevnetRequest.LabelsEntry
-	1,  // 14: devnetbuilder.v1.CreateDevnetResponse.devnet:type_name -> devnetbuilder.v1.Devnet
-	1,  // 15: devnetbuilder.v1.GetDevnetResponse.devnet:type_name -> devnetbuilder.v1.Devnet
-	1,  // 16: devnetbuilder.v1.ListDevnetsResponse.devnets:type_name -> devnetbuilder.v1.Devnet
-	1,  // 17: devnetbuilder.v1.StartDevnetResponse.devnet:type_name -> devnetbuilder.v1.Devnet
-	1,  // 18: devnetbuilder.v1.StopDevnetResponse.devnet:type_name -> devnetbuilder.v1.Devnet
-	3,  // 19: devnetbuilder.v1.ApplyDevnetRequest.spec:type_name -> devnetbuilder.v1.DevnetSpec
-	85, // 20: devnetbuilder.v1.ApplyDevnetRequest.labels:type_name -> devnetbuilder.v1.ApplyDevnetRequest.LabelsEntry
-	86, // 21: devnetbuilder.v1.ApplyDevnetRequest.annotations:type_name -> devnetbuilder.v1.ApplyDevnetRequest.AnnotationsEntry
-	1,  // 22: devnetbuilder.v1.ApplyDevnetResponse.devnet:type_name -> devnetbuilder.v1.Devnet
-	3,  // 23: devnetbuilder.v1.UpdateDevnetRequest.spec:type_name -> devnetbuilder.v1.DevnetSpec
-	87, // 24: devnetbuilder.v1.UpdateDevnetRequest.labels:type_name -> devnetbuilder.v1.UpdateDevnetRequest.LabelsEntry
-	88, // 25: devnetbuilder.v1.UpdateDevnetRequest.annotations:type_name -> devnetbuilder.v1.UpdateDevnetRequest.AnnotationsEntry
-	1,  // 26: devnetbuilder.v1.UpdateDevnetResponse.devnet:type_name -> devnetbuilder.v1.Devnet
-	90, // 27: devnetbuilder.v1.StreamProvisionLogsResponse.timestamp:type_name -> google.protobuf.Timestamp
-	26, // 28: devnetbuilder.v1.Node.metadata:type_name -> devnetbuilder.v1.NodeMetadata
-	27, // 29: devnetbuilder.v1.Node.spec:type_name -> devnetbuilder.v1.NodeSpec
-	28, // 30: devnetbuilder.v1.Node.status:type_name -> devnetbuilder.v1.NodeStatus
-	90, // 31: devnetbuilder.v1.NodeMetadata.created_at:type_name -> google.protobuf.Timestamp
-	90, // 32: devnetbuilder.v1.NodeMetadata.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 33: devnetbuilder.v1.NodeSpec.restart_policy:type_name -> devnetbuilder.v1.NodeRestartPolicy
-	29, // 34: devnetbuilder.v1.NodeStatus.health:type_name -> devnetbuilder.v1.NodeHealth
-	90, // 35: devnetbuilder.v1.NodeHealth.last_check:type_name -> google.protobuf.Timestamp
-	25, // 36: devnetbuilder.v1.StartNodeResponse.node:type_name -> devnetbuilder.v1.Node
-	25, // 37: devnetbuilder.v1.StopNodeResponse.node:type_name -> devnetbuilder.v1.Node
-	25, // 38: devnetbuilder.v1.RestartNodeResponse.node:type_name -> devnetbuilder.v1.Node
-	25, // 39: devnetbuilder.v1.GetNodeResponse.node:type_name -> devnetbuilder.v1.Node
-	25, // 40: devnetbuilder.v1.ListNodesResponse.nodes:type_name -> devnetbuilder.v1.Node
-	29, // 41: devnetbuilder.v1.GetNodeHealthResponse.health:type_name -> devnetbuilder.v1.NodeHealth
-	90, // 42: devnetbuilder.v1.StreamNodeLogsResponse.timestamp:type_name -> google.protobuf.Timestamp
-	46, // 43: devnetbuilder.v1.GetNodePortsResponse.ports:type_name -> devnetbuilder.v1.PortMapping
-	50, // 44: devnetbuilder.v1.Upgrade.metadata:type_name -> devnetbuilder.v1.UpgradeMetadata
-	51, // 45: devnetbuilder.v1.Upgrade.spec:type_name -> devnetbuilder.v1.UpgradeSpec
-	53, // 46: devnetbuilder.v1.Upgrade.status:type_name -> devnetbuilder.v1.UpgradeStatus
-	90, // 47: devnetbuilder.v1.UpgradeMetadata.created_at:type_name -> google.protobuf.Timestamp
-	90, // 48: devnetbuilder.v1.UpgradeMetadata.updated_at:type_name -> google.protobuf.Timestamp
-	52, // 49: devnetbuilder.v1.UpgradeSpec.new_binary:type_name -> devnetbuilder.v1.BinarySource
-	51, // 50: devnetbuilder.v1.CreateUpgradeRequest.spec:type_name -> devnetbuilder.v1.UpgradeSpec
-	49, // 51: devnetbuilder.v1.CreateUpgradeResponse.upgrade:type_name -> devnetbuilder.v1.Upgrade
-	49, // 52: devnetbuilder.v1.GetUpgradeResponse.upgrade:type_name -> devnetbuilder.v1.Upgrade
-	49, // 53: devnetbuilder.v1.ListUpgradesResponse.upgrades:type_name -> devnetbuilder.v1.Upgrade
-	49, // 54: devnetbuilder.v1.CancelUpgradeResponse.upgrade:type_name -> devnetbuilder.v1.Upgrade
-	49, // 55: devnetbuilder.v1.RetryUpgradeResponse.upgrade:type_name -> devnetbuilder.v1.Upgrade
-	68, // 56: devnetbuilder.v1.ListNetworksResponse.networks:type_name -> devnetbuilder.v1.NetworkSummary
-	71, // 57: devnetbuilder.v1.GetNetworkInfoResponse.network:type_name -> devnetbuilder.v1.NetworkInfo
-	72, // 58: devnetbuilder.v1.NetworkInfo.binary_source:type_name -> devnetbuilder.v1.NetworkBinarySource
-	89, // 59: devnetbuilder.v1.NetworkInfo.endpoints:type_name -> devnetbuilder.v1.NetworkInfo.EndpointsEntry
-	74, // 60: devnetbuilder.v1.NetworkInfo.default_ports:type_name -> devnetbuilder.v1.NetworkPortConfig
-	77, // 61: devnetbuilder.v1.ListBinaryVersionsResponse.versions:type_name -> devnetbuilder.v1.BinaryVersionInfo
-	90, // 62: devnetbuilder.v1.BinaryVersionInfo.published_at:type_name -> google.protobuf.Timestamp
-	73, // 63: devnetbuilder.v1.NetworkInfo.EndpointsEntry.value:type_name -> devnetbuilder.v1.EndpointInfo
-	7,  // 64: devnetbuilder.v1.DevnetService.CreateDevnet:input_type -> devnetbuilder.v1.CreateDevnetRequest
-	9,  // 65: devnetbuilder.v1.DevnetService.GetDevnet:input_type -> devnetbuilder.v1.GetDevnetRequest
-	11, // 66: devnetbuilder.v1.DevnetService.ListDevnets:input_type -> devnetbuilder.v1.ListDevnetsRequest
-	13, // 67: devnetbuilder.v1.DevnetService.DeleteDevnet:input_type -> devnetbuilder.v1.DeleteDevnetRequest
-	15, // 68: devnetbuilder.v1.DevnetService.StartDevnet:input_type -> devnetbuilder.v1.StartDevnetRequest
-	17, // 69: devnetbuilder.v1.DevnetService.StopDevnet:input_type -> devnetbuilder.v1.StopDevnetRequest
-	19, // 70: devnetbuilder.v1.DevnetService.ApplyDevnet:input_type -> devnetbuilder.v1.ApplyDevnetRequest
-	21, // 71: devnetbuilder.v1.DevnetService.UpdateDevnet:input_type -> devnetbuilder.v1.UpdateDevnetRequest
-	23, // 72: devnetbuilder.v1.DevnetService.StreamProvisionLogs:input_type -> devnetbuilder.v1.StreamProvisionLogsRequest
-	30, // 73: devnetbuilder.v1.NodeService.StartNode:input_type -> devnetbuilder.v1.StartNodeRequest
-	32, // 74: devnetbuilder.v1.NodeService.StopNode:input_type -> devnetbuilder.v1.StopNodeRequest
-	34, // 75: devnetbuilder.v1.NodeService.RestartNode:input_type -> devnetbuilder.v1.RestartNodeRequest
-	36, // 76: devnetbuilder.v1.NodeService.GetNode:input_type -> devnetbuilder.v1.GetNodeRequest
-	38, // 77: devnetbuilder.v1.NodeService.ListNodes:input_type -> devnetbuilder.v1.ListNodesRequest
-	40, // 78: devnetbuilder.v1.NodeService.GetNodeHealth:input_type -> devnetbuilder.v1.GetNodeHealthRequest
-	42, // 79: devnetbuilder.v1.NodeService.StreamNodeLogs:input_type -> devnetbuilder.v1.StreamNodeLogsRequest
-	47, // 80: devnetbuilder.v1.NodeService.GetNodePorts:input_type -> devnetbuilder.v1.GetNodePortsRequest
-	44, // 81: devnetbuilder.v1.NodeService.ExecInNode:input_type -> devnetbuilder.v1.ExecInNodeRequest
-	54, // 82: devnetbuilder.v1.UpgradeService.CreateUpgrade:input_type -> devnetbuilder.v1.CreateUpgradeRequest
-	56, // 83: devnetbuilder.v1.UpgradeService.GetUpgrade:input_type -> devnetbuilder.v1.GetUpgradeRequest
-	58, // 84: devnetbuilder.v1.UpgradeService.ListUpgrades:input_type -> devnetbuilder.v1.ListUpgradesRequest
-	60, // 85: devnetbuilder.v1.UpgradeService.DeleteUpgrade:input_type -> devnetbuilder.v1.DeleteUpgradeRequest
-	62, // 86: devnetbuilder.v1.UpgradeService.CancelUpgrade:input_type -> devnetbuilder.v1.CancelUpgradeRequest
-	64, // 87: devnetbuilder.v1.UpgradeService.RetryUpgrade:input_type -> devnetbuilder.v1.RetryUpgradeRequest
-	66, // 88: devnetbuilder.v1.NetworkService.ListNetworks:input_type -> devnetbuilder.v1.ListNetworksRequest
-	69, // 89: devnetbuilder.v1.NetworkService.GetNetworkInfo:input_type -> devnetbuilder.v1.GetNetworkInfoRequest
-	75, // 90: devnetbuilder.v1.NetworkService.ListBinaryVersions:input_type -> devnetbuilder.v1.ListBinaryVersionsRequest
-	78, // 91: devnetbuilder.v1.AuthService.Ping:input_type -> devnetbuilder.v1.PingRequest
-	80, // 92: devnetbuilder.v1.AuthService.WhoAmI:input_type -> devnetbuilder.v1.WhoAmIRequest
-	8,  // 93: devnetbuilder.v1.DevnetService.CreateDevnet:output_type -> devnetbuilder.v1.CreateDevnetResponse
-	10, // 94: devnetbuilder.v1.DevnetService.GetDevnet:output_type -> devnetbuilder.v1.GetDevnetResponse
-	12, // 95: devnetbuilder.v1.DevnetService.ListDevnets:output_type -> devnetbuilder.v1.ListDevnetsResponse
-	14, // 96: devnetbuilder.v1.DevnetService.DeleteDevnet:output_type -> devnetbuilder.v1.DeleteDevnetResponse
-	16, // 97: devnetbuilder.v1.DevnetService.StartDevnet:output_type -> devnetbuilder.v1.StartDevnetResponse
-	18, // 98: devnetbuilder.v1.DevnetService.StopDevnet:output_type -> devnetbuilder.v1.StopDevnetResponse
-	20, // 99: devnetbuilder.v1.DevnetService.ApplyDevnet:output_type -> devnetbuilder.v1.ApplyDevnetResponse
-	22, // 100: devnetbuilder.v1.DevnetService.UpdateDevnet:output_type -> devnetbuilder.v1.UpdateDevnetResponse
-	24, // 101: devnetbuilder.v1.DevnetService.StreamProvisionLogs:output_type -> devnetbuilder.v1.StreamProvisionLogsResponse
-	31, // 102: devnetbuilder.v1.NodeService.StartNode:output_type -> devnetbuilder.v1.StartNodeResponse
-	33, // 103: devnetbuilder.v1.NodeService.StopNode:output_type -> devnetbuilder.v1.StopNodeResponse
-	35, // 104: devnetbuilder.v1.NodeService.RestartNode:output_type -> devnetbuilder.v1.RestartNodeResponse
-	37, // 105: devnetbuilder.v1.NodeService.GetNode:output_type -> devnetbuilder.v1.GetNodeResponse
-	39, // 106: devnetbuilder.v1.NodeService.ListNodes:output_type -> devnetbuilder.v1.ListNodesResponse
-	41, // 107: devnetbuilder.v1.NodeService.GetNodeHealth:output_type -> devnetbuilder.v1.GetNodeHealthResponse
-	43, // 108: devnetbuilder.v1.NodeService.StreamNodeLogs:output_type -> devnetbuilder.v1.StreamNodeLogsResponse
-	48, // 109: devnetbuilder.v1.NodeService.GetNodePorts:output_type -> devnetbuilder.v1.GetNodePortsResponse
-	45, // 110: devnetbuilder.v1.NodeService.ExecInNode:output_type -> devnetbuilder.v1.ExecInNodeResponse
-	55, // 111: devnetbuilder.v1.UpgradeService.CreateUpgrade:output_type -> devnetbuilder.v1.CreateUpgradeResponse
-	57, // 112: devnetbuilder.v1.UpgradeService.GetUpgrade:output_type -> devnetbuilder.v1.GetUpgradeResponse
-	59, // 113: devnetbuilder.v1.UpgradeService.ListUpgrades:output_type -> devnetbuilder.v1.ListUpgradesResponse
-	61, // 114: devnetbuilder.v1.UpgradeService.DeleteUpgrade:output_type -> devnetbuilder.v1.DeleteUpgradeResponse
-	63, // 115: devnetbuilder.v1.UpgradeService.CancelUpgrade:output_type -> devnetbuilder.v1.CancelUpgradeResponse
-	65, // 116: devnetbuilder.v1.UpgradeService.RetryUpgrade:output_type -> devnetbuilder.v1.RetryUpgradeResponse
-	67, // 117: devnetbuilder.v1.NetworkService.ListNetworks:output_type -> devnetbuilder.v1.ListNetworksResponse
-	70, // 118: devnetbuilder.v1.NetworkService.GetNetworkInfo:output_type -> devnetbuilder.v1.GetNetworkInfoResponse
-	76, // 119: devnetbuilder.v1.NetworkService.ListBinaryVersions:output_type -> devnetbuilder.v1.ListBinaryVersionsResponse
-	79, // 120: devnetbuilder.v1.AuthService.Ping:output_type -> devnetbuilder.v1.PingResponse
-	81, // 121: devnetbuilder.v1.AuthService.WhoAmI:output_type -> devnetbuilder.v1.WhoAmIResponse
-	93, // [93:122] is the sub-list for method output_type
-	64, // [64:93] is the sub-list for method input_type
-	64, // [64:64] is the sub-list for extension type_name
-	64, // [64:64] is the sub-list for extension extendee
-	0,  // [0:64] is the sub-list for field type_name
+	2,   // 0: devnetbuilder.v1.Devnet.metadata:type_name -> devnetbuilder.v1.DevnetMetadata
+	3,   // 1: devnetbuilder.v1.Devnet.spec:type_name -> devnetbuilder.v1.DevnetSpec
+	20,  // 2: devnetbuilder.v1.Devnet.status:type_name -> devnetbuilder.v1.DevnetStatus
+	194, // 3: devnetbuilder.v1.DevnetMetadata.created_at:type_name -> google.protobuf.Timestamp
+	194, // 4: devnetbuilder.v1.DevnetMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	184, // 5: devnetbuilder.v1.DevnetMetadata.labels:type_name -> devnetbuilder.v1.DevnetMetadata.LabelsEntry
+	185, // 6: devnetbuilder.v1.DevnetMetadata.annotations:type_name -> devnetbuilder.v1.DevnetMetadata.AnnotationsEntry
+	186, // 7: devnetbuilder.v1.DevnetSpec.genesis_overrides:type_name -> devnetbuilder.v1.DevnetSpec.GenesisOverridesEntry
+	18,  // 8: devnetbuilder.v1.DevnetSpec.funded_accounts:type_name -> devnetbuilder.v1.FundedAccount
+	17,  // 9: devnetbuilder.v1.DevnetSpec.ports:type_name -> devnetbuilder.v1.PortLayout
+	16,  // 10: devnetbuilder.v1.DevnetSpec.validator_keys:type_name -> devnetbuilder.v1.ValidatorKeys
+	15,  // 11: devnetbuilder.v1.DevnetSpec.remote_signers:type_name -> devnetbuilder.v1.RemoteSigner
+	13,  // 12: devnetbuilder.v1.DevnetSpec.genesis_prune:type_name -> devnetbuilder.v1.GenesisPrune
+	12,  // 13: devnetbuilder.v1.DevnetSpec.node_configs:type_name -> devnetbuilder.v1.NodeConfig
+	11,  // 14: devnetbuilder.v1.DevnetSpec.webhooks:type_name -> devnetbuilder.v1.Webhook
+	7,   // 15: devnetbuilder.v1.DevnetSpec.hooks:type_name -> devnetbuilder.v1.Hooks
+	9,   // 16: devnetbuilder.v1.DevnetSpec.bootstrap:type_name -> devnetbuilder.v1.Bootstrap
+	6,   // 17: devnetbuilder.v1.DevnetSpec.hosts:type_name -> devnetbuilder.v1.RemoteHost
+	4,   // 18: devnetbuilder.v1.DevnetSpec.network_shaping:type_name -> devnetbuilder.v1.NetworkShaping
+	14,  // 19: devnetbuilder.v1.DevnetSpec.voting_power:type_name -> devnetbuilder.v1.VotingPower
+	187, // 20: devnetbuilder.v1.NetworkShaping.regions:type_name -> devnetbuilder.v1.NetworkShaping.RegionsEntry
+	5,   // 21: devnetbuilder.v1.NetworkShaping.links:type_name -> devnetbuilder.v1.NetworkLink
+	8,   // 22: devnetbuilder.v1.Hooks.post_fork:type_name -> devnetbuilder.v1.Hook
+	8,   // 23: devnetbuilder.v1.Hooks.pre_start:type_name -> devnetbuilder.v1.Hook
+	8,   // 24: devnetbuilder.v1.Hooks.post_healthy:type_name -> devnetbuilder.v1.Hook
+	10,  // 25: devnetbuilder.v1.Bootstrap.contracts:type_name -> devnetbuilder.v1.Contract
+	19,  // 26: devnetbuilder.v1.FundedAccount.vesting:type_name -> devnetbuilder.v1.VestingSchedule
+	194, // 27: devnetbuilder.v1.VestingSchedule.start_time:type_name -> google.protobuf.Timestamp
+	194, // 28: devnetbuilder.v1.VestingSchedule.end_time:type_name -> google.protobuf.Timestamp
+	194, // 29: devnetbuilder.v1.DevnetStatus.last_health_check:type_name -> google.protobuf.Timestamp
+	21,  // 30: devnetbuilder.v1.DevnetStatus.conditions:type_name -> devnetbuilder.v1.Condition
+	22,  // 31: devnetbuilder.v1.DevnetStatus.events:type_name -> devnetbuilder.v1.Event
+	194, // 32: devnetbuilder.v1.DevnetStatus.expires_at:type_name -> google.protobuf.Timestamp
+	194, // 33: devnetbuilder.v1.Condition.last_transition_time:type_name -> google.protobuf.Timestamp
+	194, // 34: devnetbuilder.v1.Event.timestamp:type_name -> google.protobuf.Timestamp
+	3,   // 35: devnetbuilder.v1.CreateDevnetRequest.spec:type_name -> devnetbuilder.v1.DevnetSpec
+	188, // 36: devnetbuilder.v1.CreateDevnetRequest.labels:type_name -> devnetbuilder.v1.CreateDevnetRequest.LabelsEntry
+	1,   // 37: devnetbuilder.v1.CreateDevnetResponse.devnet:type_name -> devnetbuilder.v1.Devnet
+	1,   // 38: devnetbuilder.v1.GetDevnetResponse.devnet:type_name -> devnetbuilder.v1.Devnet
+	1,   // 39: devnetbuilder.v1.ListDevnetsResponse.devnets:type_name -> devnetbuilder.v1.Devnet
+	1,   // 40: devnetbuilder.v1.StartDevnetResponse.devnet:type_name -> devnetbuilder.v1.Devnet
+	1,   // 41: devnetbuilder.v1.StopDevnetResponse.devnet:type_name -> devnetbuilder.v1.Devnet
+	3,   // 42: devnetbuilder.v1.ApplyDevnetRequest.spec:type_name -> devnetbuilder.v1.DevnetSpec
+	189, // 43: devnetbuilder.v1.ApplyDevnetRequest.labels:type_name -> devnetbuilder.v1.ApplyDevnetRequest.LabelsEntry
+	190, // 44: devnetbuilder.v1.ApplyDevnetRequest.annotations:type_name -> devnetbuilder.v1.ApplyDevnetRequest.AnnotationsEntry
+	1,   // 45: devnetbuilder.v1.ApplyDevnetResponse.devnet:type_name -> devnetbuilder.v1.Devnet
+	3,   // 46: devnetbuilder.v1.UpdateDevnetRequest.spec:type_name -> devnetbuilder.v1.DevnetSpec
+	191, // 47: devnetbuilder.v1.UpdateDevnetRequest.labels:type_name -> devnetbuilder.v1.UpdateDevnetRequest.LabelsEntry
+	192, // 48: devnetbuilder.v1.UpdateDevnetRequest.annotations:type_name -> devnetbuilder.v1.UpdateDevnetRequest.AnnotationsEntry
+	1,   // 49: devnetbuilder.v1.UpdateDevnetResponse.devnet:type_name -> devnetbuilder.v1.Devnet
+	194, // 50: devnetbuilder.v1.StreamProvisionLogsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	42,  // 51: devnetbuilder.v1.ExportFixturesResponse.files:type_name -> devnetbuilder.v1.FixtureFile
+	45,  // 52: devnetbuilder.v1.ExportKeysResponse.keys:type_name -> devnetbuilder.v1.AccountKey
+	48,  // 53: devnetbuilder.v1.DiffValidatorSetsResponse.changes:type_name -> devnetbuilder.v1.ValidatorSetChange
+	194, // 54: devnetbuilder.v1.BlockSummary.time:type_name -> google.protobuf.Timestamp
+	51,  // 55: devnetbuilder.v1.ListBlocksResponse.blocks:type_name -> devnetbuilder.v1.BlockSummary
+	195, // 56: devnetbuilder.v1.BlockTx.messages:type_name -> devnetbuilder.v1.TxTraceMessage
+	51,  // 57: devnetbuilder.v1.GetBlockResponse.block:type_name -> devnetbuilder.v1.BlockSummary
+	54,  // 58: devnetbuilder.v1.GetBlockResponse.txs:type_name -> devnetbuilder.v1.BlockTx
+	1,   // 59: devnetbuilder.v1.ExtendDevnetResponse.devnet:type_name -> devnetbuilder.v1.Devnet
+	1,   // 60: devnetbuilder.v1.SetBlockTimeResponse.devnet:type_name -> devnetbuilder.v1.Devnet
+	1,   // 61: devnetbuilder.v1.WatchDevnetsResponse.devnet:type_name -> devnetbuilder.v1.Devnet
+	97,  // 62: devnetbuilder.v1.WatchDevnetsResponse.node:type_name -> devnetbuilder.v1.Node
+	194, // 63: devnetbuilder.v1.ListDevnetEventsRequest.since:type_name -> google.protobuf.Timestamp
+	22,  // 64: devnetbuilder.v1.ListDevnetEventsResponse.events:type_name -> devnetbuilder.v1.Event
+	66,  // 65: devnetbuilder.v1.GetPeerMatrixResponse.nodes:type_name -> devnetbuilder.v1.PeerNode
+	67,  // 66: devnetbuilder.v1.GetPeerMatrixResponse.warnings:type_name -> devnetbuilder.v1.PeerWarning
+	194, // 67: devnetbuilder.v1.DiagnoseConsensusResponse.latest_block_time:type_name -> google.protobuf.Timestamp
+	70,  // 68: devnetbuilder.v1.DiagnoseConsensusResponse.validators:type_name -> devnetbuilder.v1.ConsensusValidator
+	71,  // 69: devnetbuilder.v1.DiagnoseConsensusResponse.nodes:type_name -> devnetbuilder.v1.ConsensusNode
+	96,  // 70: devnetbuilder.v1.DiagnoseConsensusResponse.hypotheses:type_name -> devnetbuilder.v1.ConsensusHypothesis
+	73,  // 71: devnetbuilder.v1.CollectDebugBundleResponse.files:type_name -> devnetbuilder.v1.BundleFile
+	81,  // 72: devnetbuilder.v1.ShapeNetworkResponse.nodes:type_name -> devnetbuilder.v1.ShapedNode
+	86,  // 73: devnetbuilder.v1.PruneNodesResponse.nodes:type_name -> devnetbuilder.v1.PrunedNode
+	194, // 74: devnetbuilder.v1.SearchLogsRequest.since:type_name -> google.protobuf.Timestamp
+	88,  // 75: devnetbuilder.v1.SearchLogsResponse.matches:type_name -> devnetbuilder.v1.LogMatch
+	1,   // 76: devnetbuilder.v1.ImportDevnetResponse.devnet:type_name -> devnetbuilder.v1.Devnet
+	98,  // 77: devnetbuilder.v1.Node.metadata:type_name -> devnetbuilder.v1.NodeMetadata
+	99,  // 78: devnetbuilder.v1.Node.spec:type_name -> devnetbuilder.v1.NodeSpec
+	100, // 79: devnetbuilder.v1.Node.status:type_name -> devnetbuilder.v1.NodeStatus
+	194, // 80: devnetbuilder.v1.NodeMetadata.created_at:type_name -> google.protobuf.Timestamp
+	194, // 81: devnetbuilder.v1.NodeMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 82: devnetbuilder.v1.NodeSpec.restart_policy:type_name -> devnetbuilder.v1.NodeRestartPolicy
+	160, // 83: devnetbuilder.v1.NodeSpec.ports:type_name -> devnetbuilder.v1.NetworkPortConfig
+	101, // 84: devnetbuilder.v1.NodeStatus.health:type_name -> devnetbuilder.v1.NodeHealth
+	21,  // 85: devnetbuilder.v1.NodeStatus.conditions:type_name -> devnetbuilder.v1.Condition
+	194, // 86: devnetbuilder.v1.NodeStatus.last_block_time:type_name -> google.protobuf.Timestamp
+	194, // 87: devnetbuilder.v1.NodeHealth.last_check:type_name -> google.protobuf.Timestamp
+	97,  // 88: devnetbuilder.v1.StartNodeResponse.node:type_name -> devnetbuilder.v1.Node
+	97,  // 89: devnetbuilder.v1.StopNodeResponse.node:type_name -> devnetbuilder.v1.Node
+	97,  // 90: devnetbuilder.v1.RestartNodeResponse.node:type_name -> devnetbuilder.v1.Node
+	97,  // 91: devnetbuilder.v1.GetNodeResponse.node:type_name -> devnetbuilder.v1.Node
+	97,  // 92: devnetbuilder.v1.ListNodesResponse.nodes:type_name -> devnetbuilder.v1.Node
+	101, // 93: devnetbuilder.v1.GetNodeHealthResponse.health:type_name -> devnetbuilder.v1.NodeHealth
+	194, // 94: devnetbuilder.v1.StreamNodeLogsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	119, // 95: devnetbuilder.v1.ApplyNodeConfigResponse.changes:type_name -> devnetbuilder.v1.ConfigChange
+	122, // 96: devnetbuilder.v1.GetNodeConfigResponse.fields:type_name -> devnetbuilder.v1.NodeConfigField
+	125, // 97: devnetbuilder.v1.SyncNodeConfigResponse.drift:type_name -> devnetbuilder.v1.ConfigDrift
+	97,  // 98: devnetbuilder.v1.SetNodeBinaryResponse.node:type_name -> devnetbuilder.v1.Node
+	129, // 99: devnetbuilder.v1.GetNodePortsResponse.ports:type_name -> devnetbuilder.v1.PortMapping
+	133, // 100: devnetbuilder.v1.Upgrade.metadata:type_name -> devnetbuilder.v1.UpgradeMetadata
+	134, // 101: devnetbuilder.v1.Upgrade.spec:type_name -> devnetbuilder.v1.UpgradeSpec
+	136, // 102: devnetbuilder.v1.Upgrade.status:type_name -> devnetbuilder.v1.UpgradeStatus
+	194, // 103: devnetbuilder.v1.UpgradeMetadata.created_at:type_name -> google.protobuf.Timestamp
+	194, // 104: devnetbuilder.v1.UpgradeMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	135, // 105: devnetbuilder.v1.UpgradeSpec.new_binary:type_name -> devnetbuilder.v1.BinarySource
+	134, // 106: devnetbuilder.v1.CreateUpgradeRequest.spec:type_name -> devnetbuilder.v1.UpgradeSpec
+	132, // 107: devnetbuilder.v1.CreateUpgradeResponse.upgrade:type_name -> devnetbuilder.v1.Upgrade
+	132, // 108: devnetbuilder.v1.GetUpgradeResponse.upgrade:type_name -> devnetbuilder.v1.Upgrade
+	132, // 109: devnetbuilder.v1.ListUpgradesResponse.upgrades:type_name -> devnetbuilder.v1.Upgrade
+	132, // 110: devnetbuilder.v1.CancelUpgradeResponse.upgrade:type_name -> devnetbuilder.v1.Upgrade
+	132, // 111: devnetbuilder.v1.RetryUpgradeResponse.upgrade:type_name -> devnetbuilder.v1.Upgrade
+	153, // 112: devnetbuilder.v1.ListNetworksResponse.networks:type_name -> devnetbuilder.v1.NetworkSummary
+	156, // 113: devnetbuilder.v1.GetNetworkInfoResponse.network:type_name -> devnetbuilder.v1.NetworkInfo
+	158, // 114: devnetbuilder.v1.NetworkInfo.binary_source:type_name -> devnetbuilder.v1.NetworkBinarySource
+	193, // 115: devnetbuilder.v1.NetworkInfo.endpoints:type_name -> devnetbuilder.v1.NetworkInfo.EndpointsEntry
+	160, // 116: devnetbuilder.v1.NetworkInfo.default_ports:type_name -> devnetbuilder.v1.NetworkPortConfig
+	157, // 117: devnetbuilder.v1.NetworkInfo.call_stats:type_name -> devnetbuilder.v1.PluginMethodStats
+	163, // 118: devnetbuilder.v1.ListBinaryVersionsResponse.versions:type_name -> devnetbuilder.v1.BinaryVersionInfo
+	194, // 119: devnetbuilder.v1.BinaryVersionInfo.published_at:type_name -> google.protobuf.Timestamp
+	177, // 120: devnetbuilder.v1.Namespace.quota:type_name -> devnetbuilder.v1.NamespaceQuota
+	194, // 121: devnetbuilder.v1.Namespace.created_at:type_name -> google.protobuf.Timestamp
+	177, // 122: devnetbuilder.v1.CreateNamespaceRequest.quota:type_name -> devnetbuilder.v1.NamespaceQuota
+	176, // 123: devnetbuilder.v1.CreateNamespaceResponse.namespace:type_name -> devnetbuilder.v1.Namespace
+	176, // 124: devnetbuilder.v1.ListNamespacesResponse.namespaces:type_name -> devnetbuilder.v1.Namespace
+	159, // 125: devnetbuilder.v1.NetworkInfo.EndpointsEntry.value:type_name -> devnetbuilder.v1.EndpointInfo
+	23,  // 126: devnetbuilder.v1.DevnetService.CreateDevnet:input_type -> devnetbuilder.v1.CreateDevnetRequest
+	25,  // 127: devnetbuilder.v1.DevnetService.GetDevnet:input_type -> devnetbuilder.v1.GetDevnetRequest
+	27,  // 128: devnetbuilder.v1.DevnetService.ListDevnets:input_type -> devnetbuilder.v1.ListDevnetsRequest
+	29,  // 129: devnetbuilder.v1.DevnetService.DeleteDevnet:input_type -> devnetbuilder.v1.DeleteDevnetRequest
+	31,  // 130: devnetbuilder.v1.DevnetService.StartDevnet:input_type -> devnetbuilder.v1.StartDevnetRequest
+	33,  // 131: devnetbuilder.v1.DevnetService.StopDevnet:input_type -> devnetbuilder.v1.StopDevnetRequest
+	35,  // 132: devnetbuilder.v1.DevnetService.ApplyDevnet:input_type -> devnetbuilder.v1.ApplyDevnetRequest
+	37,  // 133: devnetbuilder.v1.DevnetService.UpdateDevnet:input_type -> devnetbuilder.v1.UpdateDevnetRequest
+	39,  // 134: devnetbuilder.v1.DevnetService.StreamProvisionLogs:input_type -> devnetbuilder.v1.StreamProvisionLogsRequest
+	41,  // 135: devnetbuilder.v1.DevnetService.ExportFixtures:input_type -> devnetbuilder.v1.ExportFixturesRequest
+	44,  // 136: devnetbuilder.v1.DevnetService.ExportKeys:input_type -> devnetbuilder.v1.ExportKeysRequest
+	47,  // 137: devnetbuilder.v1.DevnetService.DiffValidatorSets:input_type -> devnetbuilder.v1.DiffValidatorSetsRequest
+	50,  // 138: devnetbuilder.v1.DevnetService.ListBlocks:input_type -> devnetbuilder.v1.ListBlocksRequest
+	53,  // 139: devnetbuilder.v1.DevnetService.GetBlock:input_type -> devnetbuilder.v1.GetBlockRequest
+	56,  // 140: devnetbuilder.v1.DevnetService.ExtendDevnet:input_type -> devnetbuilder.v1.ExtendDevnetRequest
+	58,  // 141: devnetbuilder.v1.DevnetService.SetBlockTime:input_type -> devnetbuilder.v1.SetBlockTimeRequest
+	60,  // 142: devnetbuilder.v1.DevnetService.WatchDevnets:input_type -> devnetbuilder.v1.WatchDevnetsRequest
+	62,  // 143: devnetbuilder.v1.DevnetService.ListDevnetEvents:input_type -> devnetbuilder.v1.ListDevnetEventsRequest
+	64,  // 144: devnetbuilder.v1.DevnetService.GetPeerMatrix:input_type -> devnetbuilder.v1.GetPeerMatrixRequest
+	68,  // 145: devnetbuilder.v1.DevnetService.DiagnoseConsensus:input_type -> devnetbuilder.v1.DiagnoseConsensusRequest
+	72,  // 146: devnetbuilder.v1.DevnetService.CollectDebugBundle:input_type -> devnetbuilder.v1.CollectDebugBundleRequest
+	75,  // 147: devnetbuilder.v1.DevnetService.ExportGenesis:input_type -> devnetbuilder.v1.ExportGenesisRequest
+	92,  // 148: devnetbuilder.v1.DevnetService.ExportDevnet:input_type -> devnetbuilder.v1.ExportDevnetRequest
+	94,  // 149: devnetbuilder.v1.DevnetService.ImportDevnet:input_type -> devnetbuilder.v1.ImportDevnetRequest
+	77,  // 150: devnetbuilder.v1.DevnetService.ExportCompose:input_type -> devnetbuilder.v1.ExportComposeRequest
+	79,  // 151: devnetbuilder.v1.DevnetService.ShapeNetwork:input_type -> devnetbuilder.v1.ShapeNetworkRequest
+	82,  // 152: devnetbuilder.v1.DevnetService.CheckImage:input_type -> devnetbuilder.v1.CheckImageRequest
+	84,  // 153: devnetbuilder.v1.DevnetService.PruneNodes:input_type -> devnetbuilder.v1.PruneNodesRequest
+	87,  // 154: devnetbuilder.v1.DevnetService.SearchLogs:input_type -> devnetbuilder.v1.SearchLogsRequest
+	90,  // 155: devnetbuilder.v1.DevnetService.InjectDoubleSign:input_type -> devnetbuilder.v1.InjectDoubleSignRequest
+	102, // 156: devnetbuilder.v1.NodeService.StartNode:input_type -> devnetbuilder.v1.StartNodeRequest
+	104, // 157: devnetbuilder.v1.NodeService.StopNode:input_type -> devnetbuilder.v1.StopNodeRequest
+	106, // 158: devnetbuilder.v1.NodeService.RestartNode:input_type -> devnetbuilder.v1.RestartNodeRequest
+	108, // 159: devnetbuilder.v1.NodeService.GetNode:input_type -> devnetbuilder.v1.GetNodeRequest
+	110, // 160: devnetbuilder.v1.NodeService.ListNodes:input_type -> devnetbuilder.v1.ListNodesRequest
+	112, // 161: devnetbuilder.v1.NodeService.GetNodeHealth:input_type -> devnetbuilder.v1.GetNodeHealthRequest
+	114, // 162: devnetbuilder.v1.NodeService.StreamNodeLogs:input_type -> devnetbuilder.v1.StreamNodeLogsRequest
+	130, // 163: devnetbuilder.v1.NodeService.GetNodePorts:input_type -> devnetbuilder.v1.GetNodePortsRequest
+	121, // 164: devnetbuilder.v1.NodeService.GetNodeConfig:input_type -> devnetbuilder.v1.GetNodeConfigRequest
+	116, // 165: devnetbuilder.v1.NodeService.ExecInNode:input_type -> devnetbuilder.v1.ExecInNodeRequest
+	118, // 166: devnetbuilder.v1.NodeService.ApplyNodeConfig:input_type -> devnetbuilder.v1.ApplyNodeConfigRequest
+	124, // 167: devnetbuilder.v1.NodeService.SyncNodeConfig:input_type -> devnetbuilder.v1.SyncNodeConfigRequest
+	127, // 168: devnetbuilder.v1.NodeService.SetNodeBinary:input_type -> devnetbuilder.v1.SetNodeBinaryRequest
+	137, // 169: devnetbuilder.v1.UpgradeService.CreateUpgrade:input_type -> devnetbuilder.v1.CreateUpgradeRequest
+	139, // 170: devnetbuilder.v1.UpgradeService.GetUpgrade:input_type -> devnetbuilder.v1.GetUpgradeRequest
+	141, // 171: devnetbuilder.v1.UpgradeService.ListUpgrades:input_type -> devnetbuilder.v1.ListUpgradesRequest
+	143, // 172: devnetbuilder.v1.UpgradeService.DeleteUpgrade:input_type -> devnetbuilder.v1.DeleteUpgradeRequest
+	145, // 173: devnetbuilder.v1.UpgradeService.CancelUpgrade:input_type -> devnetbuilder.v1.CancelUpgradeRequest
+	147, // 174: devnetbuilder.v1.UpgradeService.RetryUpgrade:input_type -> devnetbuilder.v1.RetryUpgradeRequest
+	149, // 175: devnetbuilder.v1.UpgradeService.GetUpgradeReport:input_type -> devnetbuilder.v1.GetUpgradeReportRequest
+	151, // 176: devnetbuilder.v1.NetworkService.ListNetworks:input_type -> devnetbuilder.v1.ListNetworksRequest
+	154, // 177: devnetbuilder.v1.NetworkService.GetNetworkInfo:input_type -> devnetbuilder.v1.GetNetworkInfoRequest
+	161, // 178: devnetbuilder.v1.NetworkService.ListBinaryVersions:input_type -> devnetbuilder.v1.ListBinaryVersionsRequest
+	164, // 179: devnetbuilder.v1.NetworkService.InstallPlugin:input_type -> devnetbuilder.v1.InstallPluginRequest
+	166, // 180: devnetbuilder.v1.BuildService.Build:input_type -> devnetbuilder.v1.BuildRequest
+	168, // 181: devnetbuilder.v1.AuthService.Ping:input_type -> devnetbuilder.v1.PingRequest
+	170, // 182: devnetbuilder.v1.AuthService.WhoAmI:input_type -> devnetbuilder.v1.WhoAmIRequest
+	172, // 183: devnetbuilder.v1.AuthService.HandOffCredentials:input_type -> devnetbuilder.v1.HandOffCredentialsRequest
+	174, // 184: devnetbuilder.v1.AuthService.GetCredentialStatus:input_type -> devnetbuilder.v1.GetCredentialStatusRequest
+	178, // 185: devnetbuilder.v1.NamespaceService.CreateNamespace:input_type -> devnetbuilder.v1.CreateNamespaceRequest
+	180, // 186: devnetbuilder.v1.NamespaceService.ListNamespaces:input_type -> devnetbuilder.v1.ListNamespacesRequest
+	182, // 187: devnetbuilder.v1.NamespaceService.DeleteNamespace:input_type -> devnetbuilder.v1.DeleteNamespaceRequest
+	24,  // 188: devnetbuilder.v1.DevnetService.CreateDevnet:output_type -> devnetbuilder.v1.CreateDevnetResponse
+	26,  // 189: devnetbuilder.v1.DevnetService.GetDevnet:output_type -> devnetbuilder.v1.GetDevnetResponse
+	28,  // 190: devnetbuilder.v1.DevnetService.ListDevnets:output_type -> devnetbuilder.v1.ListDevnetsResponse
+	30,  // 191: devnetbuilder.v1.DevnetService.DeleteDevnet:output_type -> devnetbuilder.v1.DeleteDevnetResponse
+	32,  // 192: devnetbuilder.v1.DevnetService.StartDevnet:output_type -> devnetbuilder.v1.StartDevnetResponse
+	34,  // 193: devnetbuilder.v1.DevnetService.StopDevnet:output_type -> devnetbuilder.v1.StopDevnetResponse
+	36,  // 194: devnetbuilder.v1.DevnetService.ApplyDevnet:output_type -> devnetbuilder.v1.ApplyDevnetResponse
+	38,  // 195: devnetbuilder.v1.DevnetService.UpdateDevnet:output_type -> devnetbuilder.v1.UpdateDevnetResponse
+	40,  // 196: devnetbuilder.v1.DevnetService.StreamProvisionLogs:output_type -> devnetbuilder.v1.StreamProvisionLogsResponse
+	43,  // 197: devnetbuilder.v1.DevnetService.ExportFixtures:output_type -> devnetbuilder.v1.ExportFixturesResponse
+	46,  // 198: devnetbuilder.v1.DevnetService.ExportKeys:output_type -> devnetbuilder.v1.ExportKeysResponse
+	49,  // 199: devnetbuilder.v1.DevnetService.DiffValidatorSets:output_type -> devnetbuilder.v1.DiffValidatorSetsResponse
+	52,  // 200: devnetbuilder.v1.DevnetService.ListBlocks:output_type -> devnetbuilder.v1.ListBlocksResponse
+	55,  // 201: devnetbuilder.v1.DevnetService.GetBlock:output_type -> devnetbuilder.v1.GetBlockResponse
+	57,  // 202: devnetbuilder.v1.DevnetService.ExtendDevnet:output_type -> devnetbuilder.v1.ExtendDevnetResponse
+	59,  // 203: devnetbuilder.v1.DevnetService.SetBlockTime:output_type -> devnetbuilder.v1.SetBlockTimeResponse
+	61,  // 204: devnetbuilder.v1.DevnetService.WatchDevnets:output_type -> devnetbuilder.v1.WatchDevnetsResponse
+	63,  // 205: devnetbuilder.v1.DevnetService.ListDevnetEvents:output_type -> devnetbuilder.v1.ListDevnetEventsResponse
+	65,  // 206: devnetbuilder.v1.DevnetService.GetPeerMatrix:output_type -> devnetbuilder.v1.GetPeerMatrixResponse
+	69,  // 207: devnetbuilder.v1.DevnetService.DiagnoseConsensus:output_type -> devnetbuilder.v1.DiagnoseConsensusResponse
+	74,  // 208: devnetbuilder.v1.DevnetService.CollectDebugBundle:output_type -> devnetbuilder.v1.CollectDebugBundleResponse
+	76,  // 209: devnetbuilder.v1.DevnetService.ExportGenesis:output_type -> devnetbuilder.v1.ExportGenesisResponse
+	93,  // 210: devnetbuilder.v1.DevnetService.ExportDevnet:output_type -> devnetbuilder.v1.ArchiveChunk
+	95,  // 211: devnetbuilder.v1.DevnetService.ImportDevnet:output_type -> devnetbuilder.v1.ImportDevnetResponse
+	78,  // 212: devnetbuilder.v1.DevnetService.ExportCompose:output_type -> devnetbuilder.v1.ExportComposeResponse
+	80,  // 213: devnetbuilder.v1.DevnetService.ShapeNetwork:output_type -> devnetbuilder.v1.ShapeNetworkResponse
+	83,  // 214: devnetbuilder.v1.DevnetService.CheckImage:output_type -> devnetbuilder.v1.CheckImageResponse
+	85,  // 215: devnetbuilder.v1.DevnetService.PruneNodes:output_type -> devnetbuilder.v1.PruneNodesResponse
+	89,  // 216: devnetbuilder.v1.DevnetService.SearchLogs:output_type -> devnetbuilder.v1.SearchLogsResponse
+	91,  // 217: devnetbuilder.v1.DevnetService.InjectDoubleSign:output_type -> devnetbuilder.v1.InjectDoubleSignResponse
+	103, // 218: devnetbuilder.v1.NodeService.StartNode:output_type -> devnetbuilder.v1.StartNodeResponse
+	105, // 219: devnetbuilder.v1.NodeService.StopNode:output_type -> devnetbuilder.v1.StopNodeResponse
+	107, // 220: devnetbuilder.v1.NodeService.RestartNode:output_type -> devnetbuilder.v1.RestartNodeResponse
+	109, // 221: devnetbuilder.v1.NodeService.GetNode:output_type -> devnetbuilder.v1.GetNodeResponse
+	111, // 222: devnetbuilder.v1.NodeService.ListNodes:output_type -> devnetbuilder.v1.ListNodesResponse
+	113, // 223: devnetbuilder.v1.NodeService.GetNodeHealth:output_type -> devnetbuilder.v1.GetNodeHealthResponse
+	115, // 224: devnetbuilder.v1.NodeService.StreamNodeLogs:output_type -> devnetbuilder.v1.StreamNodeLogsResponse
+	131, // 225: devnetbuilder.v1.NodeService.GetNodePorts:output_type -> devnetbuilder.v1.GetNodePortsResponse
+	123, // 226: devnetbuilder.v1.NodeService.GetNodeConfig:output_type -> devnetbuilder.v1.GetNodeConfigResponse
+	117, // 227: devnetbuilder.v1.NodeService.ExecInNode:output_type -> devnetbuilder.v1.ExecInNodeResponse
+	120, // 228: devnetbuilder.v1.NodeService.ApplyNodeConfig:output_type -> devnetbuilder.v1.ApplyNodeConfigResponse
+	126, // 229: devnetbuilder.v1.NodeService.SyncNodeConfig:output_type -> devnetbuilder.v1.SyncNodeConfigResponse
+	128, // 230: devnetbuilder.v1.NodeService.SetNodeBinary:output_type -> devnetbuilder.v1.SetNodeBinaryResponse
+	138, // 231: devnetbuilder.v1.UpgradeService.CreateUpgrade:output_type -> devnetbuilder.v1.CreateUpgradeResponse
+	140, // 232: devnetbuilder.v1.UpgradeService.GetUpgrade:output_type -> devnetbuilder.v1.GetUpgradeResponse
+	142, // 233: devnetbuilder.v1.UpgradeService.ListUpgrades:output_type -> devnetbuilder.v1.ListUpgradesResponse
+	144, // 234: devnetbuilder.v1.UpgradeService.DeleteUpgrade:output_type -> devnetbuilder.v1.DeleteUpgradeResponse
+	146, // 235: devnetbuilder.v1.UpgradeService.CancelUpgrade:output_type -> devnetbuilder.v1.CancelUpgradeResponse
+	148, // 236: devnetbuilder.v1.UpgradeService.RetryUpgrade:output_type -> devnetbuilder.v1.RetryUpgradeResponse
+	150, // 237: devnetbuilder.v1.UpgradeService.GetUpgradeReport:output_type -> devnetbuilder.v1.GetUpgradeReportResponse
+	152, // 238: devnetbuilder.v1.NetworkService.ListNetworks:output_type -> devnetbuilder.v1.ListNetworksResponse
+	155, // 239: devnetbuilder.v1.NetworkService.GetNetworkInfo:output_type -> devnetbuilder.v1.GetNetworkInfoResponse
+	162, // 240: devnetbuilder.v1.NetworkService.ListBinaryVersions:output_type -> devnetbuilder.v1.ListBinaryVersionsResponse
+	165, // 241: devnetbuilder.v1.NetworkService.InstallPlugin:output_type -> devnetbuilder.v1.InstallPluginResponse
+	167, // 242: devnetbuilder.v1.BuildService.Build:output_type -> devnetbuilder.v1.BuildResponse
+	169, // 243: devnetbuilder.v1.AuthService.Ping:output_type -> devnetbuilder.v1.PingResponse
+	171, // 244: devnetbuilder.v1.AuthService.WhoAmI:output_type -> devnetbuilder.v1.WhoAmIResponse
+	173, // 245: devnetbuilder.v1.AuthService.HandOffCredentials:output_type -> devnetbuilder.v1.HandOffCredentialsResponse
+	175, // 246: devnetbuilder.v1.AuthService.GetCredentialStatus:output_type -> devnetbuilder.v1.GetCredentialStatusResponse
+	179, // 247: devnetbuilder.v1.NamespaceService.CreateNamespace:output_type -> devnetbuilder.v1.CreateNamespaceResponse
+	181, // 248: devnetbuilder.v1.NamespaceService.ListNamespaces:output_type -> devnetbuilder.v1.ListNamespacesResponse
+	183, // 249: devnetbuilder.v1.NamespaceService.DeleteNamespace:output_type -> devnetbuilder.v1.DeleteNamespaceResponse
+	188, // [188:250] is the sub-list for method output_type
+	126, // [126:188] is the sub-list for method input_type
+	126, // [126:126] is the sub-list for extension type_name
+	126, // [126:126] is the sub-list for extension extendee
+	0,   // [0:126] is the sub-list for field type_name
 }
 
 func init() { file_v1_devnet_proto_init() }
@@ -5843,15 +13782,16 @@ func file_v1_devnet_proto_init() {
 	if File_v1_devnet_proto != nil {
 		return
 	}
+	file_v1_transaction_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_devnet_proto_rawDesc), len(file_v1_devnet_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   89,
+			NumMessages:   193,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   7,
 		},
 		GoTypes:           file_v1_devnet_proto_goTypes,
 		DependencyIndexes: file_v1_devnet_proto_depIdxs,
@@ -31,6 +31,27 @@ const (
 	DevnetService_ApplyDevnet_FullMethodName         = "/devnetbuilder.v1.DevnetService/ApplyDevnet"
 	DevnetService_UpdateDevnet_FullMethodName        = "/devnetbuilder.v1.DevnetService/UpdateDevnet"
 	DevnetService_StreamProvisionLogs_FullMethodName = "/devnetbuilder.v1.DevnetService/StreamProvisionLogs"
+	DevnetService_ExportFixtures_FullMethodName      = "/devnetbuilder.v1.DevnetService/ExportFixtures"
+	DevnetService_ExportKeys_FullMethodName          = "/devnetbuilder.v1.DevnetService/ExportKeys"
+	DevnetService_DiffValidatorSets_FullMethodName   = "/devnetbuilder.v1.DevnetService/DiffValidatorSets"
+	DevnetService_ListBlocks_FullMethodName          = "/devnetbuilder.v1.DevnetService/ListBlocks"
+	DevnetService_GetBlock_FullMethodName            = "/devnetbuilder.v1.DevnetService/GetBlock"
+	DevnetService_ExtendDevnet_FullMethodName        = "/devnetbuilder.v1.DevnetService/ExtendDevnet"
+	DevnetService_SetBlockTime_FullMethodName        = "/devnetbuilder.v1.DevnetService/SetBlockTime"
+	DevnetService_WatchDevnets_FullMethodName        = "/devnetbuilder.v1.DevnetService/WatchDevnets"
+	DevnetService_ListDevnetEvents_FullMethodName    = "/devnetbuilder.v1.DevnetService/ListDevnetEvents"
+	DevnetService_GetPeerMatrix_FullMethodName       = "/devnetbuilder.v1.DevnetService/GetPeerMatrix"
+	DevnetService_DiagnoseConsensus_FullMethodName   = "/devnetbuilder.v1.DevnetService/DiagnoseConsensus"
+	DevnetService_CollectDebugBundle_FullMethodName  = "/devnetbuilder.v1.DevnetService/CollectDebugBundle"
+	DevnetService_ExportGenesis_FullMethodName       = "/devnetbuilder.v1.DevnetService/ExportGenesis"
+	DevnetService_ExportDevnet_FullMethodName        = "/devnetbuilder.v1.DevnetService/ExportDevnet"
+	DevnetService_ImportDevnet_FullMethodName        = "/devnetbuilder.v1.DevnetService/ImportDevnet"
+	DevnetService_ExportCompose_FullMethodName       = "/devnetbuilder.v1.DevnetService/ExportCompose"
+	DevnetService_ShapeNetwork_FullMethodName        = "/devnetbuilder.v1.DevnetService/ShapeNetwork"
+	DevnetService_CheckImage_FullMethodName          = "/devnetbuilder.v1.DevnetService/CheckImage"
+	DevnetService_PruneNodes_FullMethodName          = "/devnetbuilder.v1.DevnetService/PruneNodes"
+	DevnetService_SearchLogs_FullMethodName          = "/devnetbuilder.v1.DevnetService/SearchLogs"
+	DevnetService_InjectDoubleSign_FullMethodName    = "/devnetbuilder.v1.DevnetService/InjectDoubleSign"
 )
 
 // DevnetServiceClient is the client API for DevnetService service.
@@ -52,6 +73,59 @@ type DevnetServiceClient interface {
 	UpdateDevnet(ctx context.Context, in *UpdateDevnetRequest, opts ...grpc.CallOption) (*UpdateDevnetResponse, error)
 	// StreamProvisionLogs streams provisioning logs for a devnet
 	StreamProvisionLogs(ctx context.Context, in *StreamProvisionLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamProvisionLogsResponse], error)
+	// ExportFixtures generates signed transaction and query-response fixtures
+	ExportFixtures(ctx context.Context, in *ExportFixturesRequest, opts ...grpc.CallOption) (*ExportFixturesResponse, error)
+	// ExportKeys returns the deterministic validator and test-account keys
+	ExportKeys(ctx context.Context, in *ExportKeysRequest, opts ...grpc.CallOption) (*ExportKeysResponse, error)
+	// DiffValidatorSets compares the validator sets at two heights
+	DiffValidatorSets(ctx context.Context, in *DiffValidatorSetsRequest, opts ...grpc.CallOption) (*DiffValidatorSetsResponse, error)
+	// ListBlocks lists the most recent blocks
+	ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*ListBlocksResponse, error)
+	// GetBlock returns a block with its transactions decoded
+	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
+	// ExtendDevnet pushes back the TTL expiry of a devnet
+	ExtendDevnet(ctx context.Context, in *ExtendDevnetRequest, opts ...grpc.CallOption) (*ExtendDevnetResponse, error)
+	// SetBlockTime changes the block time of a devnet and writes it to every node's config
+	SetBlockTime(ctx context.Context, in *SetBlockTimeRequest, opts ...grpc.CallOption) (*SetBlockTimeResponse, error)
+	// WatchDevnets streams the current devnets and nodes, then their changes
+	WatchDevnets(ctx context.Context, in *WatchDevnetsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchDevnetsResponse], error)
+	// ListDevnetEvents returns the recorded event history of a devnet
+	ListDevnetEvents(ctx context.Context, in *ListDevnetEventsRequest, opts ...grpc.CallOption) (*ListDevnetEventsResponse, error)
+	// GetPeerMatrix returns which nodes are connected to which, and
+	// persistent_peers misconfigurations
+	GetPeerMatrix(ctx context.Context, in *GetPeerMatrixRequest, opts ...grpc.CallOption) (*GetPeerMatrixResponse, error)
+	// DiagnoseConsensus collects consensus state and ranks likely causes of a
+	// stalled chain
+	DiagnoseConsensus(ctx context.Context, in *DiagnoseConsensusRequest, opts ...grpc.CallOption) (*DiagnoseConsensusResponse, error)
+	// CollectDebugBundle returns the diagnostics of a devnet for a support
+	// bundle, with secrets redacted
+	CollectDebugBundle(ctx context.Context, in *CollectDebugBundleRequest, opts ...grpc.CallOption) (*CollectDebugBundleResponse, error)
+	// ExportGenesis exports a devnet's state at a height as a genesis file,
+	// halting the nodes it needs and restarting them afterwards
+	ExportGenesis(ctx context.Context, in *ExportGenesisRequest, opts ...grpc.CallOption) (*ExportGenesisResponse, error)
+	// ExportDevnet streams a tar.zst archive of a devnet: its resources and
+	// every node home, with binary references but not the binaries
+	ExportDevnet(ctx context.Context, in *ExportDevnetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveChunk], error)
+	// ImportDevnet creates a devnet from an archive streamed by ExportDevnet
+	ImportDevnet(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportDevnetRequest, ImportDevnetResponse], error)
+	// ExportCompose renders a docker-mode devnet's running containers as a
+	// standalone docker compose file
+	ExportCompose(ctx context.Context, in *ExportComposeRequest, opts ...grpc.CallOption) (*ExportComposeResponse, error)
+	// ShapeNetwork applies a docker-mode devnet's network shaping to its
+	// running nodes, or clears it
+	ShapeNetwork(ctx context.Context, in *ShapeNetworkRequest, opts ...grpc.CallOption) (*ShapeNetworkResponse, error)
+	// CheckImage compares a docker-mode devnet's pinned image digest with
+	// the digest its tag resolves to in the registry now
+	CheckImage(ctx context.Context, in *CheckImageRequest, opts ...grpc.CallOption) (*CheckImageResponse, error)
+	// PruneNodes prunes the application state of a devnet's nodes to reclaim
+	// disk, halting and restarting one node at a time
+	PruneNodes(ctx context.Context, in *PruneNodesRequest, opts ...grpc.CallOption) (*PruneNodesResponse, error)
+	// SearchLogs searches the logs of every node of a devnet, and the daemon
+	// log lines about it, in parallel
+	SearchLogs(ctx context.Context, in *SearchLogsRequest, opts ...grpc.CallOption) (*SearchLogsResponse, error)
+	// InjectDoubleSign makes a validator double-sign the latest block and
+	// submits the evidence, so the chain slashes and tombstones it
+	InjectDoubleSign(ctx context.Context, in *InjectDoubleSignRequest, opts ...grpc.CallOption) (*InjectDoubleSignResponse, error)
 }
 
 type devnetServiceClient struct {
@@ -161,6 +235,237 @@ func (c *devnetServiceClient) StreamProvisionLogs(ctx context.Context, in *Strea
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type DevnetService_StreamProvisionLogsClient = grpc.ServerStreamingClient[StreamProvisionLogsResponse]
 
+func (c *devnetServiceClient) ExportFixtures(ctx context.Context, in *ExportFixturesRequest, opts ...grpc.CallOption) (*ExportFixturesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportFixturesResponse)
+	err := c.cc.Invoke(ctx, DevnetService_ExportFixtures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) ExportKeys(ctx context.Context, in *ExportKeysRequest, opts ...grpc.CallOption) (*ExportKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportKeysResponse)
+	err := c.cc.Invoke(ctx, DevnetService_ExportKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) DiffValidatorSets(ctx context.Context, in *DiffValidatorSetsRequest, opts ...grpc.CallOption) (*DiffValidatorSetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffValidatorSetsResponse)
+	err := c.cc.Invoke(ctx, DevnetService_DiffValidatorSets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*ListBlocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlocksResponse)
+	err := c.cc.Invoke(ctx, DevnetService_ListBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlockResponse)
+	err := c.cc.Invoke(ctx, DevnetService_GetBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) ExtendDevnet(ctx context.Context, in *ExtendDevnetRequest, opts ...grpc.CallOption) (*ExtendDevnetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendDevnetResponse)
+	err := c.cc.Invoke(ctx, DevnetService_ExtendDevnet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) SetBlockTime(ctx context.Context, in *SetBlockTimeRequest, opts ...grpc.CallOption) (*SetBlockTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBlockTimeResponse)
+	err := c.cc.Invoke(ctx, DevnetService_SetBlockTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) WatchDevnets(ctx context.Context, in *WatchDevnetsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchDevnetsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DevnetService_ServiceDesc.Streams[1], DevnetService_WatchDevnets_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchDevnetsRequest, WatchDevnetsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DevnetService_WatchDevnetsClient = grpc.ServerStreamingClient[WatchDevnetsResponse]
+
+func (c *devnetServiceClient) ListDevnetEvents(ctx context.Context, in *ListDevnetEventsRequest, opts ...grpc.CallOption) (*ListDevnetEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDevnetEventsResponse)
+	err := c.cc.Invoke(ctx, DevnetService_ListDevnetEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) GetPeerMatrix(ctx context.Context, in *GetPeerMatrixRequest, opts ...grpc.CallOption) (*GetPeerMatrixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPeerMatrixResponse)
+	err := c.cc.Invoke(ctx, DevnetService_GetPeerMatrix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) DiagnoseConsensus(ctx context.Context, in *DiagnoseConsensusRequest, opts ...grpc.CallOption) (*DiagnoseConsensusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiagnoseConsensusResponse)
+	err := c.cc.Invoke(ctx, DevnetService_DiagnoseConsensus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) CollectDebugBundle(ctx context.Context, in *CollectDebugBundleRequest, opts ...grpc.CallOption) (*CollectDebugBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectDebugBundleResponse)
+	err := c.cc.Invoke(ctx, DevnetService_CollectDebugBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) ExportGenesis(ctx context.Context, in *ExportGenesisRequest, opts ...grpc.CallOption) (*ExportGenesisResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportGenesisResponse)
+	err := c.cc.Invoke(ctx, DevnetService_ExportGenesis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) ExportDevnet(ctx context.Context, in *ExportDevnetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DevnetService_ServiceDesc.Streams[2], DevnetService_ExportDevnet_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportDevnetRequest, ArchiveChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DevnetService_ExportDevnetClient = grpc.ServerStreamingClient[ArchiveChunk]
+
+func (c *devnetServiceClient) ImportDevnet(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportDevnetRequest, ImportDevnetResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DevnetService_ServiceDesc.Streams[3], DevnetService_ImportDevnet_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportDevnetRequest, ImportDevnetResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DevnetService_ImportDevnetClient = grpc.ClientStreamingClient[ImportDevnetRequest, ImportDevnetResponse]
+
+func (c *devnetServiceClient) ExportCompose(ctx context.Context, in *ExportComposeRequest, opts ...grpc.CallOption) (*ExportComposeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportComposeResponse)
+	err := c.cc.Invoke(ctx, DevnetService_ExportCompose_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) ShapeNetwork(ctx context.Context, in *ShapeNetworkRequest, opts ...grpc.CallOption) (*ShapeNetworkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShapeNetworkResponse)
+	err := c.cc.Invoke(ctx, DevnetService_ShapeNetwork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) CheckImage(ctx context.Context, in *CheckImageRequest, opts ...grpc.CallOption) (*CheckImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckImageResponse)
+	err := c.cc.Invoke(ctx, DevnetService_CheckImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) PruneNodes(ctx context.Context, in *PruneNodesRequest, opts ...grpc.CallOption) (*PruneNodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PruneNodesResponse)
+	err := c.cc.Invoke(ctx, DevnetService_PruneNodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) SearchLogs(ctx context.Context, in *SearchLogsRequest, opts ...grpc.CallOption) (*SearchLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchLogsResponse)
+	err := c.cc.Invoke(ctx, DevnetService_SearchLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devnetServiceClient) InjectDoubleSign(ctx context.Context, in *InjectDoubleSignRequest, opts ...grpc.CallOption) (*InjectDoubleSignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InjectDoubleSignResponse)
+	err := c.cc.Invoke(ctx, DevnetService_InjectDoubleSign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DevnetServiceServer is the server API for DevnetService service.
 // All implementations must embed UnimplementedDevnetServiceServer
 // for forward compatibility.
@@ -180,6 +485,59 @@ type DevnetServiceServer interface {
 	UpdateDevnet(context.Context, *UpdateDevnetRequest) (*UpdateDevnetResponse, error)
 	// StreamProvisionLogs streams provisioning logs for a devnet
 	StreamProvisionLogs(*StreamProvisionLogsRequest, grpc.ServerStreamingServer[StreamProvisionLogsResponse]) error
+	// ExportFixtures generates signed transaction and query-response fixtures
+	ExportFixtures(context.Context, *ExportFixturesRequest) (*ExportFixturesResponse, error)
+	// ExportKeys returns the deterministic validator and test-account keys
+	ExportKeys(context.Context, *ExportKeysRequest) (*ExportKeysResponse, error)
+	// DiffValidatorSets compares the validator sets at two heights
+	DiffValidatorSets(context.Context, *DiffValidatorSetsRequest) (*DiffValidatorSetsResponse, error)
+	// ListBlocks lists the most recent blocks
+	ListBlocks(context.Context, *ListBlocksRequest) (*ListBlocksResponse, error)
+	// GetBlock returns a block with its transactions decoded
+	GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error)
+	// ExtendDevnet pushes back the TTL expiry of a devnet
+	ExtendDevnet(context.Context, *ExtendDevnetRequest) (*ExtendDevnetResponse, error)
+	// SetBlockTime changes the block time of a devnet and writes it to every node's config
+	SetBlockTime(context.Context, *SetBlockTimeRequest) (*SetBlockTimeResponse, error)
+	// WatchDevnets streams the current devnets and nodes, then their changes
+	WatchDevnets(*WatchDevnetsRequest, grpc.ServerStreamingServer[WatchDevnetsResponse]) error
+	// ListDevnetEvents returns the recorded event history of a devnet
+	ListDevnetEvents(context.Context, *ListDevnetEventsRequest) (*ListDevnetEventsResponse, error)
+	// GetPeerMatrix returns which nodes are connected to which, and
+	// persistent_peers misconfigurations
+	GetPeerMatrix(context.Context, *GetPeerMatrixRequest) (*GetPeerMatrixResponse, error)
+	// DiagnoseConsensus collects consensus state and ranks likely causes of a
+	// stalled chain
+	DiagnoseConsensus(context.Context, *DiagnoseConsensusRequest) (*DiagnoseConsensusResponse, error)
+	// CollectDebugBundle returns the diagnostics of a devnet for a support
+	// bundle, with secrets redacted
+	CollectDebugBundle(context.Context, *CollectDebugBundleRequest) (*CollectDebugBundleResponse, error)
+	// ExportGenesis exports a devnet's state at a height as a genesis file,
+	// halting the nodes it needs and restarting them afterwards
+	ExportGenesis(context.Context, *ExportGenesisRequest) (*ExportGenesisResponse, error)
+	// ExportDevnet streams a tar.zst archive of a devnet: its resources and
+	// every node home, with binary references but not the binaries
+	ExportDevnet(*ExportDevnetRequest, grpc.ServerStreamingServer[ArchiveChunk]) error
+	// ImportDevnet creates a devnet from an archive streamed by ExportDevnet
+	ImportDevnet(grpc.ClientStreamingServer[ImportDevnetRequest, ImportDevnetResponse]) error
+	// ExportCompose renders a docker-mode devnet's running containers as a
+	// standalone docker compose file
+	ExportCompose(context.Context, *ExportComposeRequest) (*ExportComposeResponse, error)
+	// ShapeNetwork applies a docker-mode devnet's network shaping to its
+	// running nodes, or clears it
+	ShapeNetwork(context.Context, *ShapeNetworkRequest) (*ShapeNetworkResponse, error)
+	// CheckImage compares a docker-mode devnet's pinned image digest with
+	// the digest its tag resolves to in the registry now
+	CheckImage(context.Context, *CheckImageRequest) (*CheckImageResponse, error)
+	// PruneNodes prunes the application state of a devnet's nodes to reclaim
+	// disk, halting and restarting one node at a time
+	PruneNodes(context.Context, *PruneNodesRequest) (*PruneNodesResponse, error)
+	// SearchLogs searches the logs of every node of a devnet, and the daemon
+	// log lines about it, in parallel
+	SearchLogs(context.Context, *SearchLogsRequest) (*SearchLogsResponse, error)
+	// InjectDoubleSign makes a validator double-sign the latest block and
+	// submits the evidence, so the chain slashes and tombstones it
+	InjectDoubleSign(context.Context, *InjectDoubleSignRequest) (*InjectDoubleSignResponse, error)
 	mustEmbedUnimplementedDevnetServiceServer()
 }
 
@@ -217,6 +575,69 @@ func (UnimplementedDevnetServiceServer) UpdateDevnet(context.Context, *UpdateDev
 func (UnimplementedDevnetServiceServer) StreamProvisionLogs(*StreamProvisionLogsRequest, grpc.ServerStreamingServer[StreamProvisionLogsResponse]) error {
 	return status.Error(codes.Unimplemented, "method StreamProvisionLogs not implemented")
 }
+func (UnimplementedDevnetServiceServer) ExportFixtures(context.Context, *ExportFixturesRequest) (*ExportFixturesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportFixtures not implemented")
+}
+func (UnimplementedDevnetServiceServer) ExportKeys(context.Context, *ExportKeysRequest) (*ExportKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportKeys not implemented")
+}
+func (UnimplementedDevnetServiceServer) DiffValidatorSets(context.Context, *DiffValidatorSetsRequest) (*DiffValidatorSetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffValidatorSets not implemented")
+}
+func (UnimplementedDevnetServiceServer) ListBlocks(context.Context, *ListBlocksRequest) (*ListBlocksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBlocks not implemented")
+}
+func (UnimplementedDevnetServiceServer) GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (UnimplementedDevnetServiceServer) ExtendDevnet(context.Context, *ExtendDevnetRequest) (*ExtendDevnetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExtendDevnet not implemented")
+}
+func (UnimplementedDevnetServiceServer) SetBlockTime(context.Context, *SetBlockTimeRequest) (*SetBlockTimeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetBlockTime not implemented")
+}
+func (UnimplementedDevnetServiceServer) WatchDevnets(*WatchDevnetsRequest, grpc.ServerStreamingServer[WatchDevnetsResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchDevnets not implemented")
+}
+func (UnimplementedDevnetServiceServer) ListDevnetEvents(context.Context, *ListDevnetEventsRequest) (*ListDevnetEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDevnetEvents not implemented")
+}
+func (UnimplementedDevnetServiceServer) GetPeerMatrix(context.Context, *GetPeerMatrixRequest) (*GetPeerMatrixResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPeerMatrix not implemented")
+}
+func (UnimplementedDevnetServiceServer) DiagnoseConsensus(context.Context, *DiagnoseConsensusRequest) (*DiagnoseConsensusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiagnoseConsensus not implemented")
+}
+func (UnimplementedDevnetServiceServer) CollectDebugBundle(context.Context, *CollectDebugBundleRequest) (*CollectDebugBundleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CollectDebugBundle not implemented")
+}
+func (UnimplementedDevnetServiceServer) ExportGenesis(context.Context, *ExportGenesisRequest) (*ExportGenesisResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportGenesis not implemented")
+}
+func (UnimplementedDevnetServiceServer) ExportDevnet(*ExportDevnetRequest, grpc.ServerStreamingServer[ArchiveChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportDevnet not implemented")
+}
+func (UnimplementedDevnetServiceServer) ImportDevnet(grpc.ClientStreamingServer[ImportDevnetRequest, ImportDevnetResponse]) error {
+	return status.Error(codes.Unimplemented, "method ImportDevnet not implemented")
+}
+func (UnimplementedDevnetServiceServer) ExportCompose(context.Context, *ExportComposeRequest) (*ExportComposeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportCompose not implemented")
+}
+func (UnimplementedDevnetServiceServer) ShapeNetwork(context.Context, *ShapeNetworkRequest) (*ShapeNetworkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShapeNetwork not implemented")
+}
+func (UnimplementedDevnetServiceServer) CheckImage(context.Context, *CheckImageRequest) (*CheckImageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckImage not implemented")
+}
+func (UnimplementedDevnetServiceServer) PruneNodes(context.Context, *PruneNodesRequest) (*PruneNodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PruneNodes not implemented")
+}
+func (UnimplementedDevnetServiceServer) SearchLogs(context.Context, *SearchLogsRequest) (*SearchLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchLogs not implemented")
+}
+func (UnimplementedDevnetServiceServer) InjectDoubleSign(context.Context, *InjectDoubleSignRequest) (*InjectDoubleSignResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InjectDoubleSign not implemented")
+}
 func (UnimplementedDevnetServiceServer) mustEmbedUnimplementedDevnetServiceServer() {}
 func (UnimplementedDevnetServiceServer) testEmbeddedByValue()                       {}
 
@@ -227,172 +648,525 @@ type UnsafeDevnetServiceServer interface {
 	mustEmbedUnimplementedDevnetServiceServer()
 }
 
-func RegisterDevnetServiceServer(s grpc.ServiceRegistrar, srv DevnetServiceServer) {
-	// If the following call panics, it indicates UnimplementedDevnetServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func RegisterDevnetServiceServer(s grpc.ServiceRegistrar, srv DevnetServiceServer) {
+	// If the following call panics, it indicates UnimplementedDevnetServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DevnetService_ServiceDesc, srv)
+}
+
+func _DevnetService_CreateDevnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDevnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).CreateDevnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_CreateDevnet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).CreateDevnet(ctx, req.(*CreateDevnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_GetDevnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDevnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).GetDevnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_GetDevnet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).GetDevnet(ctx, req.(*GetDevnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_ListDevnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevnetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).ListDevnets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_ListDevnets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).ListDevnets(ctx, req.(*ListDevnetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_DeleteDevnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDevnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).DeleteDevnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_DeleteDevnet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).DeleteDevnet(ctx, req.(*DeleteDevnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_StartDevnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDevnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).StartDevnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_StartDevnet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).StartDevnet(ctx, req.(*StartDevnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_StopDevnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopDevnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).StopDevnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_StopDevnet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).StopDevnet(ctx, req.(*StopDevnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_ApplyDevnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyDevnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).ApplyDevnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_ApplyDevnet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).ApplyDevnet(ctx, req.(*ApplyDevnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_UpdateDevnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDevnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).UpdateDevnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_UpdateDevnet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).UpdateDevnet(ctx, req.(*UpdateDevnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_StreamProvisionLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamProvisionLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DevnetServiceServer).StreamProvisionLogs(m, &grpc.GenericServerStream[StreamProvisionLogsRequest, StreamProvisionLogsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DevnetService_StreamProvisionLogsServer = grpc.ServerStreamingServer[StreamProvisionLogsResponse]
+
+func _DevnetService_ExportFixtures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportFixturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).ExportFixtures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_ExportFixtures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).ExportFixtures(ctx, req.(*ExportFixturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_ExportKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).ExportKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_ExportKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).ExportKeys(ctx, req.(*ExportKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_DiffValidatorSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffValidatorSetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).DiffValidatorSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_DiffValidatorSets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).DiffValidatorSets(ctx, req.(*DiffValidatorSetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_ListBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).ListBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_ListBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).ListBlocks(ctx, req.(*ListBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_GetBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).GetBlock(ctx, req.(*GetBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_ExtendDevnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendDevnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).ExtendDevnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_ExtendDevnet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).ExtendDevnet(ctx, req.(*ExtendDevnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_SetBlockTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBlockTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).SetBlockTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_SetBlockTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).SetBlockTime(ctx, req.(*SetBlockTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_WatchDevnets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDevnetsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DevnetServiceServer).WatchDevnets(m, &grpc.GenericServerStream[WatchDevnetsRequest, WatchDevnetsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DevnetService_WatchDevnetsServer = grpc.ServerStreamingServer[WatchDevnetsResponse]
+
+func _DevnetService_ListDevnetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevnetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).ListDevnetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_ListDevnetEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).ListDevnetEvents(ctx, req.(*ListDevnetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DevnetService_GetPeerMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&DevnetService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).GetPeerMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_GetPeerMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).GetPeerMatrix(ctx, req.(*GetPeerMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _DevnetService_CreateDevnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDevnetRequest)
+func _DevnetService_DiagnoseConsensus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiagnoseConsensusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DevnetServiceServer).CreateDevnet(ctx, in)
+		return srv.(DevnetServiceServer).DiagnoseConsensus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DevnetService_CreateDevnet_FullMethodName,
+		FullMethod: DevnetService_DiagnoseConsensus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DevnetServiceServer).CreateDevnet(ctx, req.(*CreateDevnetRequest))
+		return srv.(DevnetServiceServer).DiagnoseConsensus(ctx, req.(*DiagnoseConsensusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DevnetService_GetDevnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDevnetRequest)
+func _DevnetService_CollectDebugBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectDebugBundleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DevnetServiceServer).GetDevnet(ctx, in)
+		return srv.(DevnetServiceServer).CollectDebugBundle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DevnetService_GetDevnet_FullMethodName,
+		FullMethod: DevnetService_CollectDebugBundle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DevnetServiceServer).GetDevnet(ctx, req.(*GetDevnetRequest))
+		return srv.(DevnetServiceServer).CollectDebugBundle(ctx, req.(*CollectDebugBundleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DevnetService_ListDevnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListDevnetsRequest)
+func _DevnetService_ExportGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGenesisRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DevnetServiceServer).ListDevnets(ctx, in)
+		return srv.(DevnetServiceServer).ExportGenesis(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DevnetService_ListDevnets_FullMethodName,
+		FullMethod: DevnetService_ExportGenesis_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DevnetServiceServer).ListDevnets(ctx, req.(*ListDevnetsRequest))
+		return srv.(DevnetServiceServer).ExportGenesis(ctx, req.(*ExportGenesisRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DevnetService_DeleteDevnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteDevnetRequest)
+func _DevnetService_ExportDevnet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDevnetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DevnetServiceServer).ExportDevnet(m, &grpc.GenericServerStream[ExportDevnetRequest, ArchiveChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DevnetService_ExportDevnetServer = grpc.ServerStreamingServer[ArchiveChunk]
+
+func _DevnetService_ImportDevnet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DevnetServiceServer).ImportDevnet(&grpc.GenericServerStream[ImportDevnetRequest, ImportDevnetResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DevnetService_ImportDevnetServer = grpc.ClientStreamingServer[ImportDevnetRequest, ImportDevnetResponse]
+
+func _DevnetService_ExportCompose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportComposeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DevnetServiceServer).DeleteDevnet(ctx, in)
+		return srv.(DevnetServiceServer).ExportCompose(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DevnetService_DeleteDevnet_FullMethodName,
+		FullMethod: DevnetService_ExportCompose_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DevnetServiceServer).DeleteDevnet(ctx, req.(*DeleteDevnetRequest))
+		return srv.(DevnetServiceServer).ExportCompose(ctx, req.(*ExportComposeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DevnetService_StartDevnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartDevnetRequest)
+func _DevnetService_ShapeNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShapeNetworkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DevnetServiceServer).StartDevnet(ctx, in)
+		return srv.(DevnetServiceServer).ShapeNetwork(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DevnetService_StartDevnet_FullMethodName,
+		FullMethod: DevnetService_ShapeNetwork_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DevnetServiceServer).StartDevnet(ctx, req.(*StartDevnetRequest))
+		return srv.(DevnetServiceServer).ShapeNetwork(ctx, req.(*ShapeNetworkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DevnetService_StopDevnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopDevnetRequest)
+func _DevnetService_CheckImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckImageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DevnetServiceServer).StopDevnet(ctx, in)
+		return srv.(DevnetServiceServer).CheckImage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DevnetService_StopDevnet_FullMethodName,
+		FullMethod: DevnetService_CheckImage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DevnetServiceServer).StopDevnet(ctx, req.(*StopDevnetRequest))
+		return srv.(DevnetServiceServer).CheckImage(ctx, req.(*CheckImageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DevnetService_ApplyDevnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplyDevnetRequest)
+func _DevnetService_PruneNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneNodesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DevnetServiceServer).ApplyDevnet(ctx, in)
+		return srv.(DevnetServiceServer).PruneNodes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DevnetService_ApplyDevnet_FullMethodName,
+		FullMethod: DevnetService_PruneNodes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DevnetServiceServer).ApplyDevnet(ctx, req.(*ApplyDevnetRequest))
+		return srv.(DevnetServiceServer).PruneNodes(ctx, req.(*PruneNodesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DevnetService_UpdateDevnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateDevnetRequest)
+func _DevnetService_SearchLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchLogsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DevnetServiceServer).UpdateDevnet(ctx, in)
+		return srv.(DevnetServiceServer).SearchLogs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DevnetService_UpdateDevnet_FullMethodName,
+		FullMethod: DevnetService_SearchLogs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DevnetServiceServer).UpdateDevnet(ctx, req.(*UpdateDevnetRequest))
+		return srv.(DevnetServiceServer).SearchLogs(ctx, req.(*SearchLogsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DevnetService_StreamProvisionLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamProvisionLogsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _DevnetService_InjectDoubleSign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InjectDoubleSignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(DevnetServiceServer).StreamProvisionLogs(m, &grpc.GenericServerStream[StreamProvisionLogsRequest, StreamProvisionLogsResponse]{ServerStream: stream})
+	if interceptor == nil {
+		return srv.(DevnetServiceServer).InjectDoubleSign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DevnetService_InjectDoubleSign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevnetServiceServer).InjectDoubleSign(ctx, req.(*InjectDoubleSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type DevnetService_StreamProvisionLogsServer = grpc.ServerStreamingServer[StreamProvisionLogsResponse]
-
 // DevnetService_ServiceDesc is the grpc.ServiceDesc for DevnetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -432,6 +1206,78 @@ var DevnetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDevnet",
 			Handler:    _DevnetService_UpdateDevnet_Handler,
 		},
+		{
+			MethodName: "ExportFixtures",
+			Handler:    _DevnetService_ExportFixtures_Handler,
+		},
+		{
+			MethodName: "ExportKeys",
+			Handler:    _DevnetService_ExportKeys_Handler,
+		},
+		{
+			MethodName: "DiffValidatorSets",
+			Handler:    _DevnetService_DiffValidatorSets_Handler,
+		},
+		{
+			MethodName: "ListBlocks",
+			Handler:    _DevnetService_ListBlocks_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _DevnetService_GetBlock_Handler,
+		},
+		{
+			MethodName: "ExtendDevnet",
+			Handler:    _DevnetService_ExtendDevnet_Handler,
+		},
+		{
+			MethodName: "SetBlockTime",
+			Handler:    _DevnetService_SetBlockTime_Handler,
+		},
+		{
+			MethodName: "ListDevnetEvents",
+			Handler:    _DevnetService_ListDevnetEvents_Handler,
+		},
+		{
+			MethodName: "GetPeerMatrix",
+			Handler:    _DevnetService_GetPeerMatrix_Handler,
+		},
+		{
+			MethodName: "DiagnoseConsensus",
+			Handler:    _DevnetService_DiagnoseConsensus_Handler,
+		},
+		{
+			MethodName: "CollectDebugBundle",
+			Handler:    _DevnetService_CollectDebugBundle_Handler,
+		},
+		{
+			MethodName: "ExportGenesis",
+			Handler:    _DevnetService_ExportGenesis_Handler,
+		},
+		{
+			MethodName: "ExportCompose",
+			Handler:    _DevnetService_ExportCompose_Handler,
+		},
+		{
+			MethodName: "ShapeNetwork",
+			Handler:    _DevnetService_ShapeNetwork_Handler,
+		},
+		{
+			MethodName: "CheckImage",
+			Handler:    _DevnetService_CheckImage_Handler,
+		},
+		{
+			MethodName: "PruneNodes",
+			Handler:    _DevnetService_PruneNodes_Handler,
+		},
+		{
+			MethodName: "SearchLogs",
+			Handler:    _DevnetService_SearchLogs_Handler,
+		},
+		{
+			MethodName: "InjectDoubleSign",
+			Handler:    _DevnetService_InjectDoubleSign_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -439,20 +1285,39 @@ var DevnetService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _DevnetService_StreamProvisionLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchDevnets",
+			Handler:       _DevnetService_WatchDevnets_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportDevnet",
+			Handler:       _DevnetService_ExportDevnet_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportDevnet",
+			Handler:       _DevnetService_ImportDevnet_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "v1/devnet.proto",
 }
 
 const (
-	NodeService_StartNode_FullMethodName      = "/devnetbuilder.v1.NodeService/StartNode"
-	NodeService_StopNode_FullMethodName       = "/devnetbuilder.v1.NodeService/StopNode"
-	NodeService_RestartNode_FullMethodName    = "/devnetbuilder.v1.NodeService/RestartNode"
-	NodeService_GetNode_FullMethodName        = "/devnetbuilder.v1.NodeService/GetNode"
-	NodeService_ListNodes_FullMethodName      = "/devnetbuilder.v1.NodeService/ListNodes"
-	NodeService_GetNodeHealth_FullMethodName  = "/devnetbuilder.v1.NodeService/GetNodeHealth"
-	NodeService_StreamNodeLogs_FullMethodName = "/devnetbuilder.v1.NodeService/StreamNodeLogs"
-	NodeService_GetNodePorts_FullMethodName   = "/devnetbuilder.v1.NodeService/GetNodePorts"
-	NodeService_ExecInNode_FullMethodName     = "/devnetbuilder.v1.NodeService/ExecInNode"
+	NodeService_StartNode_FullMethodName       = "/devnetbuilder.v1.NodeService/StartNode"
+	NodeService_StopNode_FullMethodName        = "/devnetbuilder.v1.NodeService/StopNode"
+	NodeService_RestartNode_FullMethodName     = "/devnetbuilder.v1.NodeService/RestartNode"
+	NodeService_GetNode_FullMethodName         = "/devnetbuilder.v1.NodeService/GetNode"
+	NodeService_ListNodes_FullMethodName       = "/devnetbuilder.v1.NodeService/ListNodes"
+	NodeService_GetNodeHealth_FullMethodName   = "/devnetbuilder.v1.NodeService/GetNodeHealth"
+	NodeService_StreamNodeLogs_FullMethodName  = "/devnetbuilder.v1.NodeService/StreamNodeLogs"
+	NodeService_GetNodePorts_FullMethodName    = "/devnetbuilder.v1.NodeService/GetNodePorts"
+	NodeService_GetNodeConfig_FullMethodName   = "/devnetbuilder.v1.NodeService/GetNodeConfig"
+	NodeService_ExecInNode_FullMethodName      = "/devnetbuilder.v1.NodeService/ExecInNode"
+	NodeService_ApplyNodeConfig_FullMethodName = "/devnetbuilder.v1.NodeService/ApplyNodeConfig"
+	NodeService_SyncNodeConfig_FullMethodName  = "/devnetbuilder.v1.NodeService/SyncNodeConfig"
+	NodeService_SetNodeBinary_FullMethodName   = "/devnetbuilder.v1.NodeService/SetNodeBinary"
 )
 
 // NodeServiceClient is the client API for NodeService service.
@@ -471,8 +1336,12 @@ type NodeServiceClient interface {
 	GetNodeHealth(ctx context.Context, in *GetNodeHealthRequest, opts ...grpc.CallOption) (*GetNodeHealthResponse, error)
 	StreamNodeLogs(ctx context.Context, in *StreamNodeLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamNodeLogsResponse], error)
 	GetNodePorts(ctx context.Context, in *GetNodePortsRequest, opts ...grpc.CallOption) (*GetNodePortsResponse, error)
+	GetNodeConfig(ctx context.Context, in *GetNodeConfigRequest, opts ...grpc.CallOption) (*GetNodeConfigResponse, error)
 	// Mutation
 	ExecInNode(ctx context.Context, in *ExecInNodeRequest, opts ...grpc.CallOption) (*ExecInNodeResponse, error)
+	ApplyNodeConfig(ctx context.Context, in *ApplyNodeConfigRequest, opts ...grpc.CallOption) (*ApplyNodeConfigResponse, error)
+	SyncNodeConfig(ctx context.Context, in *SyncNodeConfigRequest, opts ...grpc.CallOption) (*SyncNodeConfigResponse, error)
+	SetNodeBinary(ctx context.Context, in *SetNodeBinaryRequest, opts ...grpc.CallOption) (*SetNodeBinaryResponse, error)
 }
 
 type nodeServiceClient struct {
@@ -572,6 +1441,16 @@ func (c *nodeServiceClient) GetNodePorts(ctx context.Context, in *GetNodePortsRe
 	return out, nil
 }
 
+func (c *nodeServiceClient) GetNodeConfig(ctx context.Context, in *GetNodeConfigRequest, opts ...grpc.CallOption) (*GetNodeConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNodeConfigResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetNodeConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nodeServiceClient) ExecInNode(ctx context.Context, in *ExecInNodeRequest, opts ...grpc.CallOption) (*ExecInNodeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExecInNodeResponse)
@@ -582,6 +1461,36 @@ func (c *nodeServiceClient) ExecInNode(ctx context.Context, in *ExecInNodeReques
 	return out, nil
 }
 
+func (c *nodeServiceClient) ApplyNodeConfig(ctx context.Context, in *ApplyNodeConfigRequest, opts ...grpc.CallOption) (*ApplyNodeConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyNodeConfigResponse)
+	err := c.cc.Invoke(ctx, NodeService_ApplyNodeConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) SyncNodeConfig(ctx context.Context, in *SyncNodeConfigRequest, opts ...grpc.CallOption) (*SyncNodeConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncNodeConfigResponse)
+	err := c.cc.Invoke(ctx, NodeService_SyncNodeConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) SetNodeBinary(ctx context.Context, in *SetNodeBinaryRequest, opts ...grpc.CallOption) (*SetNodeBinaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNodeBinaryResponse)
+	err := c.cc.Invoke(ctx, NodeService_SetNodeBinary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServiceServer is the server API for NodeService service.
 // All implementations must embed UnimplementedNodeServiceServer
 // for forward compatibility.
@@ -598,8 +1507,12 @@ type NodeServiceServer interface {
 	GetNodeHealth(context.Context, *GetNodeHealthRequest) (*GetNodeHealthResponse, error)
 	StreamNodeLogs(*StreamNodeLogsRequest, grpc.ServerStreamingServer[StreamNodeLogsResponse]) error
 	GetNodePorts(context.Context, *GetNodePortsRequest) (*GetNodePortsResponse, error)
+	GetNodeConfig(context.Context, *GetNodeConfigRequest) (*GetNodeConfigResponse, error)
 	// Mutation
 	ExecInNode(context.Context, *ExecInNodeRequest) (*ExecInNodeResponse, error)
+	ApplyNodeConfig(context.Context, *ApplyNodeConfigRequest) (*ApplyNodeConfigResponse, error)
+	SyncNodeConfig(context.Context, *SyncNodeConfigRequest) (*SyncNodeConfigResponse, error)
+	SetNodeBinary(context.Context, *SetNodeBinaryRequest) (*SetNodeBinaryResponse, error)
 	mustEmbedUnimplementedNodeServiceServer()
 }
 
@@ -634,9 +1547,21 @@ func (UnimplementedNodeServiceServer) StreamNodeLogs(*StreamNodeLogsRequest, grp
 func (UnimplementedNodeServiceServer) GetNodePorts(context.Context, *GetNodePortsRequest) (*GetNodePortsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetNodePorts not implemented")
 }
+func (UnimplementedNodeServiceServer) GetNodeConfig(context.Context, *GetNodeConfigRequest) (*GetNodeConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNodeConfig not implemented")
+}
 func (UnimplementedNodeServiceServer) ExecInNode(context.Context, *ExecInNodeRequest) (*ExecInNodeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ExecInNode not implemented")
 }
+func (UnimplementedNodeServiceServer) ApplyNodeConfig(context.Context, *ApplyNodeConfigRequest) (*ApplyNodeConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyNodeConfig not implemented")
+}
+func (UnimplementedNodeServiceServer) SyncNodeConfig(context.Context, *SyncNodeConfigRequest) (*SyncNodeConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SyncNodeConfig not implemented")
+}
+func (UnimplementedNodeServiceServer) SetNodeBinary(context.Context, *SetNodeBinaryRequest) (*SetNodeBinaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetNodeBinary not implemented")
+}
 func (UnimplementedNodeServiceServer) mustEmbedUnimplementedNodeServiceServer() {}
 func (UnimplementedNodeServiceServer) testEmbeddedByValue()                     {}
 
@@ -795,6 +1720,24 @@ func _NodeService_GetNodePorts_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodeService_GetNodeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetNodeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetNodeConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetNodeConfig(ctx, req.(*GetNodeConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NodeService_ExecInNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecInNodeRequest)
 	if err := dec(in); err != nil {
@@ -813,6 +1756,60 @@ func _NodeService_ExecInNode_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodeService_ApplyNodeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyNodeConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).ApplyNodeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_ApplyNodeConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).ApplyNodeConfig(ctx, req.(*ApplyNodeConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_SyncNodeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncNodeConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).SyncNodeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_SyncNodeConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).SyncNodeConfig(ctx, req.(*SyncNodeConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_SetNodeBinary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNodeBinaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).SetNodeBinary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_SetNodeBinary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).SetNodeBinary(ctx, req.(*SetNodeBinaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NodeService_ServiceDesc is the grpc.ServiceDesc for NodeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -848,10 +1845,26 @@ var NodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNodePorts",
 			Handler:    _NodeService_GetNodePorts_Handler,
 		},
+		{
+			MethodName: "GetNodeConfig",
+			Handler:    _NodeService_GetNodeConfig_Handler,
+		},
 		{
 			MethodName: "ExecInNode",
 			Handler:    _NodeService_ExecInNode_Handler,
 		},
+		{
+			MethodName: "ApplyNodeConfig",
+			Handler:    _NodeService_ApplyNodeConfig_Handler,
+		},
+		{
+			MethodName: "SyncNodeConfig",
+			Handler:    _NodeService_SyncNodeConfig_Handler,
+		},
+		{
+			MethodName: "SetNodeBinary",
+			Handler:    _NodeService_SetNodeBinary_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -864,12 +1877,13 @@ var NodeService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	UpgradeService_CreateUpgrade_FullMethodName = "/devnetbuilder.v1.UpgradeService/CreateUpgrade"
-	UpgradeService_GetUpgrade_FullMethodName    = "/devnetbuilder.v1.UpgradeService/GetUpgrade"
-	UpgradeService_ListUpgrades_FullMethodName  = "/devnetbuilder.v1.UpgradeService/ListUpgrades"
-	UpgradeService_DeleteUpgrade_FullMethodName = "/devnetbuilder.v1.UpgradeService/DeleteUpgrade"
-	UpgradeService_CancelUpgrade_FullMethodName = "/devnetbuilder.v1.UpgradeService/CancelUpgrade"
-	UpgradeService_RetryUpgrade_FullMethodName  = "/devnetbuilder.v1.UpgradeService/RetryUpgrade"
+	UpgradeService_CreateUpgrade_FullMethodName    = "/devnetbuilder.v1.UpgradeService/CreateUpgrade"
+	UpgradeService_GetUpgrade_FullMethodName       = "/devnetbuilder.v1.UpgradeService/GetUpgrade"
+	UpgradeService_ListUpgrades_FullMethodName     = "/devnetbuilder.v1.UpgradeService/ListUpgrades"
+	UpgradeService_DeleteUpgrade_FullMethodName    = "/devnetbuilder.v1.UpgradeService/DeleteUpgrade"
+	UpgradeService_CancelUpgrade_FullMethodName    = "/devnetbuilder.v1.UpgradeService/CancelUpgrade"
+	UpgradeService_RetryUpgrade_FullMethodName     = "/devnetbuilder.v1.UpgradeService/RetryUpgrade"
+	UpgradeService_GetUpgradeReport_FullMethodName = "/devnetbuilder.v1.UpgradeService/GetUpgradeReport"
 )
 
 // UpgradeServiceClient is the client API for UpgradeService service.
@@ -886,6 +1900,8 @@ type UpgradeServiceClient interface {
 	// Actions
 	CancelUpgrade(ctx context.Context, in *CancelUpgradeRequest, opts ...grpc.CallOption) (*CancelUpgradeResponse, error)
 	RetryUpgrade(ctx context.Context, in *RetryUpgradeRequest, opts ...grpc.CallOption) (*RetryUpgradeResponse, error)
+	// Reports
+	GetUpgradeReport(ctx context.Context, in *GetUpgradeReportRequest, opts ...grpc.CallOption) (*GetUpgradeReportResponse, error)
 }
 
 type upgradeServiceClient struct {
@@ -956,6 +1972,16 @@ func (c *upgradeServiceClient) RetryUpgrade(ctx context.Context, in *RetryUpgrad
 	return out, nil
 }
 
+func (c *upgradeServiceClient) GetUpgradeReport(ctx context.Context, in *GetUpgradeReportRequest, opts ...grpc.CallOption) (*GetUpgradeReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUpgradeReportResponse)
+	err := c.cc.Invoke(ctx, UpgradeService_GetUpgradeReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UpgradeServiceServer is the server API for UpgradeService service.
 // All implementations must embed UnimplementedUpgradeServiceServer
 // for forward compatibility.
@@ -970,6 +1996,8 @@ type UpgradeServiceServer interface {
 	// Actions
 	CancelUpgrade(context.Context, *CancelUpgradeRequest) (*CancelUpgradeResponse, error)
 	RetryUpgrade(context.Context, *RetryUpgradeRequest) (*RetryUpgradeResponse, error)
+	// Reports
+	GetUpgradeReport(context.Context, *GetUpgradeReportRequest) (*GetUpgradeReportResponse, error)
 	mustEmbedUnimplementedUpgradeServiceServer()
 }
 
@@ -998,6 +2026,9 @@ func (UnimplementedUpgradeServiceServer) CancelUpgrade(context.Context, *CancelU
 func (UnimplementedUpgradeServiceServer) RetryUpgrade(context.Context, *RetryUpgradeRequest) (*RetryUpgradeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RetryUpgrade not implemented")
 }
+func (UnimplementedUpgradeServiceServer) GetUpgradeReport(context.Context, *GetUpgradeReportRequest) (*GetUpgradeReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUpgradeReport not implemented")
+}
 func (UnimplementedUpgradeServiceServer) mustEmbedUnimplementedUpgradeServiceServer() {}
 func (UnimplementedUpgradeServiceServer) testEmbeddedByValue()                        {}
 
@@ -1097,32 +2128,50 @@ func _UpgradeService_CancelUpgrade_Handler(srv interface{}, ctx context.Context,
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UpgradeServiceServer).CancelUpgrade(ctx, in)
+		return srv.(UpgradeServiceServer).CancelUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UpgradeService_CancelUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UpgradeServiceServer).CancelUpgrade(ctx, req.(*CancelUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UpgradeService_RetryUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UpgradeServiceServer).RetryUpgrade(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UpgradeService_CancelUpgrade_FullMethodName,
+		FullMethod: UpgradeService_RetryUpgrade_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UpgradeServiceServer).CancelUpgrade(ctx, req.(*CancelUpgradeRequest))
+		return srv.(UpgradeServiceServer).RetryUpgrade(ctx, req.(*RetryUpgradeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UpgradeService_RetryUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RetryUpgradeRequest)
+func _UpgradeService_GetUpgradeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpgradeReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UpgradeServiceServer).RetryUpgrade(ctx, in)
+		return srv.(UpgradeServiceServer).GetUpgradeReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UpgradeService_RetryUpgrade_FullMethodName,
+		FullMethod: UpgradeService_GetUpgradeReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UpgradeServiceServer).RetryUpgrade(ctx, req.(*RetryUpgradeRequest))
+		return srv.(UpgradeServiceServer).GetUpgradeReport(ctx, req.(*GetUpgradeReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1158,6 +2207,10 @@ var UpgradeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RetryUpgrade",
 			Handler:    _UpgradeService_RetryUpgrade_Handler,
 		},
+		{
+			MethodName: "GetUpgradeReport",
+			Handler:    _UpgradeService_GetUpgradeReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/devnet.proto",
@@ -1167,6 +2220,7 @@ const (
 	NetworkService_ListNetworks_FullMethodName       = "/devnetbuilder.v1.NetworkService/ListNetworks"
 	NetworkService_GetNetworkInfo_FullMethodName     = "/devnetbuilder.v1.NetworkService/GetNetworkInfo"
 	NetworkService_ListBinaryVersions_FullMethodName = "/devnetbuilder.v1.NetworkService/ListBinaryVersions"
+	NetworkService_InstallPlugin_FullMethodName      = "/devnetbuilder.v1.NetworkService/InstallPlugin"
 )
 
 // NetworkServiceClient is the client API for NetworkService service.
@@ -1182,6 +2236,9 @@ type NetworkServiceClient interface {
 	// ListBinaryVersions returns available binary versions for a network.
 	// This fetches releases from the network's binary source (e.g., GitHub).
 	ListBinaryVersions(ctx context.Context, in *ListBinaryVersionsRequest, opts ...grpc.CallOption) (*ListBinaryVersionsResponse, error)
+	// InstallPlugin downloads a plugin binary from a GitHub release, verifies
+	// it, installs it into the daemon's plugin directory and loads it.
+	InstallPlugin(ctx context.Context, in *InstallPluginRequest, opts ...grpc.CallOption) (*InstallPluginResponse, error)
 }
 
 type networkServiceClient struct {
@@ -1222,6 +2279,16 @@ func (c *networkServiceClient) ListBinaryVersions(ctx context.Context, in *ListB
 	return out, nil
 }
 
+func (c *networkServiceClient) InstallPlugin(ctx context.Context, in *InstallPluginRequest, opts ...grpc.CallOption) (*InstallPluginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstallPluginResponse)
+	err := c.cc.Invoke(ctx, NetworkService_InstallPlugin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NetworkServiceServer is the server API for NetworkService service.
 // All implementations must embed UnimplementedNetworkServiceServer
 // for forward compatibility.
@@ -1235,6 +2302,9 @@ type NetworkServiceServer interface {
 	// ListBinaryVersions returns available binary versions for a network.
 	// This fetches releases from the network's binary source (e.g., GitHub).
 	ListBinaryVersions(context.Context, *ListBinaryVersionsRequest) (*ListBinaryVersionsResponse, error)
+	// InstallPlugin downloads a plugin binary from a GitHub release, verifies
+	// it, installs it into the daemon's plugin directory and loads it.
+	InstallPlugin(context.Context, *InstallPluginRequest) (*InstallPluginResponse, error)
 	mustEmbedUnimplementedNetworkServiceServer()
 }
 
@@ -1254,6 +2324,9 @@ func (UnimplementedNetworkServiceServer) GetNetworkInfo(context.Context, *GetNet
 func (UnimplementedNetworkServiceServer) ListBinaryVersions(context.Context, *ListBinaryVersionsRequest) (*ListBinaryVersionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListBinaryVersions not implemented")
 }
+func (UnimplementedNetworkServiceServer) InstallPlugin(context.Context, *InstallPluginRequest) (*InstallPluginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InstallPlugin not implemented")
+}
 func (UnimplementedNetworkServiceServer) mustEmbedUnimplementedNetworkServiceServer() {}
 func (UnimplementedNetworkServiceServer) testEmbeddedByValue()                        {}
 
@@ -1329,6 +2402,24 @@ func _NetworkService_ListBinaryVersions_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NetworkService_InstallPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstallPluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).InstallPlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_InstallPlugin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).InstallPlugin(ctx, req.(*InstallPluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NetworkService_ServiceDesc is the grpc.ServiceDesc for NetworkService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1348,14 +2439,130 @@ var NetworkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBinaryVersions",
 			Handler:    _NetworkService_ListBinaryVersions_Handler,
 		},
+		{
+			MethodName: "InstallPlugin",
+			Handler:    _NetworkService_InstallPlugin_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/devnet.proto",
+}
+
+const (
+	BuildService_Build_FullMethodName = "/devnetbuilder.v1.BuildService/Build"
+)
+
+// BuildServiceClient is the client API for BuildService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BuildService builds network binaries and Docker images from git refs.
+type BuildServiceClient interface {
+	// Build compiles the network binary at a git ref, or builds a Docker image
+	// containing it when image is set.
+	Build(ctx context.Context, in *BuildRequest, opts ...grpc.CallOption) (*BuildResponse, error)
+}
+
+type buildServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBuildServiceClient(cc grpc.ClientConnInterface) BuildServiceClient {
+	return &buildServiceClient{cc}
+}
+
+func (c *buildServiceClient) Build(ctx context.Context, in *BuildRequest, opts ...grpc.CallOption) (*BuildResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuildResponse)
+	err := c.cc.Invoke(ctx, BuildService_Build_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BuildServiceServer is the server API for BuildService service.
+// All implementations must embed UnimplementedBuildServiceServer
+// for forward compatibility.
+//
+// BuildService builds network binaries and Docker images from git refs.
+type BuildServiceServer interface {
+	// Build compiles the network binary at a git ref, or builds a Docker image
+	// containing it when image is set.
+	Build(context.Context, *BuildRequest) (*BuildResponse, error)
+	mustEmbedUnimplementedBuildServiceServer()
+}
+
+// UnimplementedBuildServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBuildServiceServer struct{}
+
+func (UnimplementedBuildServiceServer) Build(context.Context, *BuildRequest) (*BuildResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Build not implemented")
+}
+func (UnimplementedBuildServiceServer) mustEmbedUnimplementedBuildServiceServer() {}
+func (UnimplementedBuildServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeBuildServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BuildServiceServer will
+// result in compilation errors.
+type UnsafeBuildServiceServer interface {
+	mustEmbedUnimplementedBuildServiceServer()
+}
+
+func RegisterBuildServiceServer(s grpc.ServiceRegistrar, srv BuildServiceServer) {
+	// If the following call panics, it indicates UnimplementedBuildServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BuildService_ServiceDesc, srv)
+}
+
+func _BuildService_Build_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BuildServiceServer).Build(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BuildService_Build_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BuildServiceServer).Build(ctx, req.(*BuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BuildService_ServiceDesc is the grpc.ServiceDesc for BuildService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BuildService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "devnetbuilder.v1.BuildService",
+	HandlerType: (*BuildServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Build",
+			Handler:    _BuildService_Build_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/devnet.proto",
 }
 
 const (
-	AuthService_Ping_FullMethodName   = "/devnetbuilder.v1.AuthService/Ping"
-	AuthService_WhoAmI_FullMethodName = "/devnetbuilder.v1.AuthService/WhoAmI"
+	AuthService_Ping_FullMethodName                = "/devnetbuilder.v1.AuthService/Ping"
+	AuthService_WhoAmI_FullMethodName              = "/devnetbuilder.v1.AuthService/WhoAmI"
+	AuthService_HandOffCredentials_FullMethodName  = "/devnetbuilder.v1.AuthService/HandOffCredentials"
+	AuthService_GetCredentialStatus_FullMethodName = "/devnetbuilder.v1.AuthService/GetCredentialStatus"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -1368,6 +2575,13 @@ type AuthServiceClient interface {
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
 	// WhoAmI returns information about the authenticated user.
 	WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIResponse, error)
+	// HandOffCredentials makes the daemon fetch credentials from a short-lived
+	// Unix socket the client listens on, so they never travel in an API
+	// request. Local connections only.
+	HandOffCredentials(ctx context.Context, in *HandOffCredentialsRequest, opts ...grpc.CallOption) (*HandOffCredentialsResponse, error)
+	// GetCredentialStatus reports where the daemon's credentials come from,
+	// without their values.
+	GetCredentialStatus(ctx context.Context, in *GetCredentialStatusRequest, opts ...grpc.CallOption) (*GetCredentialStatusResponse, error)
 }
 
 type authServiceClient struct {
@@ -1398,6 +2612,26 @@ func (c *authServiceClient) WhoAmI(ctx context.Context, in *WhoAmIRequest, opts
 	return out, nil
 }
 
+func (c *authServiceClient) HandOffCredentials(ctx context.Context, in *HandOffCredentialsRequest, opts ...grpc.CallOption) (*HandOffCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HandOffCredentialsResponse)
+	err := c.cc.Invoke(ctx, AuthService_HandOffCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetCredentialStatus(ctx context.Context, in *GetCredentialStatusRequest, opts ...grpc.CallOption) (*GetCredentialStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCredentialStatusResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetCredentialStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -1408,6 +2642,13 @@ type AuthServiceServer interface {
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
 	// WhoAmI returns information about the authenticated user.
 	WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIResponse, error)
+	// HandOffCredentials makes the daemon fetch credentials from a short-lived
+	// Unix socket the client listens on, so they never travel in an API
+	// request. Local connections only.
+	HandOffCredentials(context.Context, *HandOffCredentialsRequest) (*HandOffCredentialsResponse, error)
+	// GetCredentialStatus reports where the daemon's credentials come from,
+	// without their values.
+	GetCredentialStatus(context.Context, *GetCredentialStatusRequest) (*GetCredentialStatusResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -1424,6 +2665,12 @@ func (UnimplementedAuthServiceServer) Ping(context.Context, *PingRequest) (*Ping
 func (UnimplementedAuthServiceServer) WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method WhoAmI not implemented")
 }
+func (UnimplementedAuthServiceServer) HandOffCredentials(context.Context, *HandOffCredentialsRequest) (*HandOffCredentialsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method HandOffCredentials not implemented")
+}
+func (UnimplementedAuthServiceServer) GetCredentialStatus(context.Context, *GetCredentialStatusRequest) (*GetCredentialStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCredentialStatus not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -1481,6 +2728,42 @@ func _AuthService_WhoAmI_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_HandOffCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandOffCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).HandOffCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_HandOffCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).HandOffCredentials(ctx, req.(*HandOffCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetCredentialStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCredentialStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetCredentialStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetCredentialStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetCredentialStatus(ctx, req.(*GetCredentialStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1496,6 +2779,202 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WhoAmI",
 			Handler:    _AuthService_WhoAmI_Handler,
 		},
+		{
+			MethodName: "HandOffCredentials",
+			Handler:    _AuthService_HandOffCredentials_Handler,
+		},
+		{
+			MethodName: "GetCredentialStatus",
+			Handler:    _AuthService_GetCredentialStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/devnet.proto",
+}
+
+const (
+	NamespaceService_CreateNamespace_FullMethodName = "/devnetbuilder.v1.NamespaceService/CreateNamespace"
+	NamespaceService_ListNamespaces_FullMethodName  = "/devnetbuilder.v1.NamespaceService/ListNamespaces"
+	NamespaceService_DeleteNamespace_FullMethodName = "/devnetbuilder.v1.NamespaceService/DeleteNamespace"
+)
+
+// NamespaceServiceClient is the client API for NamespaceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NamespaceService manages namespaces and their quotas.
+type NamespaceServiceClient interface {
+	// CreateNamespace creates a namespace, optionally with a quota.
+	CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*CreateNamespaceResponse, error)
+	// ListNamespaces lists created namespaces and namespaces that hold devnets.
+	ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error)
+	// DeleteNamespace deletes an empty namespace.
+	DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error)
+}
+
+type namespaceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNamespaceServiceClient(cc grpc.ClientConnInterface) NamespaceServiceClient {
+	return &namespaceServiceClient{cc}
+}
+
+func (c *namespaceServiceClient) CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*CreateNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateNamespaceResponse)
+	err := c.cc.Invoke(ctx, NamespaceService_CreateNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNamespacesResponse)
+	err := c.cc.Invoke(ctx, NamespaceService_ListNamespaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteNamespaceResponse)
+	err := c.cc.Invoke(ctx, NamespaceService_DeleteNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NamespaceServiceServer is the server API for NamespaceService service.
+// All implementations must embed UnimplementedNamespaceServiceServer
+// for forward compatibility.
+//
+// NamespaceService manages namespaces and their quotas.
+type NamespaceServiceServer interface {
+	// CreateNamespace creates a namespace, optionally with a quota.
+	CreateNamespace(context.Context, *CreateNamespaceRequest) (*CreateNamespaceResponse, error)
+	// ListNamespaces lists created namespaces and namespaces that hold devnets.
+	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
+	// DeleteNamespace deletes an empty namespace.
+	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error)
+	mustEmbedUnimplementedNamespaceServiceServer()
+}
+
+// UnimplementedNamespaceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNamespaceServiceServer struct{}
+
+func (UnimplementedNamespaceServiceServer) CreateNamespace(context.Context, *CreateNamespaceRequest) (*CreateNamespaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateNamespace not implemented")
+}
+func (UnimplementedNamespaceServiceServer) ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNamespaces not implemented")
+}
+func (UnimplementedNamespaceServiceServer) DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
+func (UnimplementedNamespaceServiceServer) mustEmbedUnimplementedNamespaceServiceServer() {}
+func (UnimplementedNamespaceServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeNamespaceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NamespaceServiceServer will
+// result in compilation errors.
+type UnsafeNamespaceServiceServer interface {
+	mustEmbedUnimplementedNamespaceServiceServer()
+}
+
+func RegisterNamespaceServiceServer(s grpc.ServiceRegistrar, srv NamespaceServiceServer) {
+	// If the following call panics, it indicates UnimplementedNamespaceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NamespaceService_ServiceDesc, srv)
+}
+
+func _NamespaceService_CreateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).CreateNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NamespaceService_CreateNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).CreateNamespace(ctx, req.(*CreateNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_ListNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).ListNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NamespaceService_ListNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).ListNamespaces(ctx, req.(*ListNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).DeleteNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NamespaceService_DeleteNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NamespaceService_ServiceDesc is the grpc.ServiceDesc for NamespaceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NamespaceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "devnetbuilder.v1.NamespaceService",
+	HandlerType: (*NamespaceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateNamespace",
+			Handler:    _NamespaceService_CreateNamespace_Handler,
+		},
+		{
+			MethodName: "ListNamespaces",
+			Handler:    _NamespaceService_ListNamespaces_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _NamespaceService_DeleteNamespace_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/devnet.proto",
@@ -756,8 +756,9 @@ type SubmitGovProposalRequest struct {
 	ProposalType  string                 `protobuf:"bytes,2,opt,name=proposal_type,json=proposalType,proto3" json:"proposal_type,omitempty"` // upgrade, param_change, text
 	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Content       []byte                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"` // type-specific content
+	Content       []byte                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"` // type-specific content, or a submit-proposal JSON file
 	Proposer      string                 `protobuf:"bytes,6,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Deposit       string                 `protobuf:"bytes,7,opt,name=deposit,proto3" json:"deposit,omitempty"` // initial deposit (e.g., "10000000stake")
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -834,6 +835,1002 @@ func (x *SubmitGovProposalRequest) GetProposer() string {
 	return ""
 }
 
+func (x *SubmitGovProposalRequest) GetDeposit() string {
+	if x != nil {
+		return x.Deposit
+	}
+	return ""
+}
+
+// GetGovProposalRequest queries a governance proposal on a devnet.
+type GetGovProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devnet        string                 `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	ProposalId    uint64                 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"` // 0 = most recently submitted proposal
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`                      // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGovProposalRequest) Reset() {
+	*x = GetGovProposalRequest{}
+	mi := &file_v1_transaction_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGovProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGovProposalRequest) ProtoMessage() {}
+
+func (x *GetGovProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_transaction_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGovProposalRequest.ProtoReflect.Descriptor instead.
+func (*GetGovProposalRequest) Descriptor() ([]byte, []int) {
+	return file_v1_transaction_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetGovProposalRequest) GetDevnet() string {
+	if x != nil {
+		return x.Devnet
+	}
+	return ""
+}
+
+func (x *GetGovProposalRequest) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *GetGovProposalRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// GovProposal is the on-chain state of a governance proposal.
+type GovProposal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // e.g., PROPOSAL_STATUS_VOTING_PERIOD, PROPOSAL_STATUS_PASSED
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Summary       string                 `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	Proposer      string                 `protobuf:"bytes,5,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	VotingEndTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=voting_end_time,json=votingEndTime,proto3" json:"voting_end_time,omitempty"`
+	FailedReason  string                 `protobuf:"bytes,7,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
+	// Tally: live during the voting period, final afterwards
+	Yes           string `protobuf:"bytes,8,opt,name=yes,proto3" json:"yes,omitempty"`
+	No            string `protobuf:"bytes,9,opt,name=no,proto3" json:"no,omitempty"`
+	Abstain       string `protobuf:"bytes,10,opt,name=abstain,proto3" json:"abstain,omitempty"`
+	NoWithVeto    string `protobuf:"bytes,11,opt,name=no_with_veto,json=noWithVeto,proto3" json:"no_with_veto,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GovProposal) Reset() {
+	*x = GovProposal{}
+	mi := &file_v1_transaction_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GovProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GovProposal) ProtoMessage() {}
+
+func (x *GovProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_transaction_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GovProposal.ProtoReflect.Descriptor instead.
+func (*GovProposal) Descriptor() ([]byte, []int) {
+	return file_v1_transaction_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GovProposal) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GovProposal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GovProposal) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GovProposal) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *GovProposal) GetProposer() string {
+	if x != nil {
+		return x.Proposer
+	}
+	return ""
+}
+
+func (x *GovProposal) GetVotingEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VotingEndTime
+	}
+	return nil
+}
+
+func (x *GovProposal) GetFailedReason() string {
+	if x != nil {
+		return x.FailedReason
+	}
+	return ""
+}
+
+func (x *GovProposal) GetYes() string {
+	if x != nil {
+		return x.Yes
+	}
+	return ""
+}
+
+func (x *GovProposal) GetNo() string {
+	if x != nil {
+		return x.No
+	}
+	return ""
+}
+
+func (x *GovProposal) GetAbstain() string {
+	if x != nil {
+		return x.Abstain
+	}
+	return ""
+}
+
+func (x *GovProposal) GetNoWithVeto() string {
+	if x != nil {
+		return x.NoWithVeto
+	}
+	return ""
+}
+
+// GetGovProposalResponse contains the queried proposal.
+type GetGovProposalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposal      *GovProposal           `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGovProposalResponse) Reset() {
+	*x = GetGovProposalResponse{}
+	mi := &file_v1_transaction_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGovProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGovProposalResponse) ProtoMessage() {}
+
+func (x *GetGovProposalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_transaction_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGovProposalResponse.ProtoReflect.Descriptor instead.
+func (*GetGovProposalResponse) Descriptor() ([]byte, []int) {
+	return file_v1_transaction_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetGovProposalResponse) GetProposal() *GovProposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+// BuildParamChangeProposalRequest asks for a proposal that sets one param of
+// a module, keeping its other params.
+type BuildParamChangeProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devnet        string                 `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	Module        string                 `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`       // e.g., "staking"
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`             // e.g., "max_validators"
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`         // JSON for object and list params, plain text otherwise
+	Namespace     string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildParamChangeProposalRequest) Reset() {
+	*x = BuildParamChangeProposalRequest{}
+	mi := &file_v1_transaction_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildParamChangeProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildParamChangeProposalRequest) ProtoMessage() {}
+
+func (x *BuildParamChangeProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_transaction_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildParamChangeProposalRequest.ProtoReflect.Descriptor instead.
+func (*BuildParamChangeProposalRequest) Descriptor() ([]byte, []int) {
+	return file_v1_transaction_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BuildParamChangeProposalRequest) GetDevnet() string {
+	if x != nil {
+		return x.Devnet
+	}
+	return ""
+}
+
+func (x *BuildParamChangeProposalRequest) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *BuildParamChangeProposalRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BuildParamChangeProposalRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *BuildParamChangeProposalRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// BuildParamChangeProposalResponse contains the built proposal.
+type BuildParamChangeProposalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposal      []byte                 `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`                 // submit-proposal JSON file, for SubmitGovProposal
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` // JSON
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"` // JSON
+	Expedited     bool                   `protobuf:"varint,4,opt,name=expedited,proto3" json:"expedited,omitempty"`              // false if the chain has no expedited proposals
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildParamChangeProposalResponse) Reset() {
+	*x = BuildParamChangeProposalResponse{}
+	mi := &file_v1_transaction_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildParamChangeProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildParamChangeProposalResponse) ProtoMessage() {}
+
+func (x *BuildParamChangeProposalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_transaction_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildParamChangeProposalResponse.ProtoReflect.Descriptor instead.
+func (*BuildParamChangeProposalResponse) Descriptor() ([]byte, []int) {
+	return file_v1_transaction_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BuildParamChangeProposalResponse) GetProposal() []byte {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+func (x *BuildParamChangeProposalResponse) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *BuildParamChangeProposalResponse) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *BuildParamChangeProposalResponse) GetExpedited() bool {
+	if x != nil {
+		return x.Expedited
+	}
+	return false
+}
+
+type GetModuleParamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devnet        string                 `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	Module        string                 `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModuleParamsRequest) Reset() {
+	*x = GetModuleParamsRequest{}
+	mi := &file_v1_transaction_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModuleParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModuleParamsRequest) ProtoMessage() {}
+
+func (x *GetModuleParamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_transaction_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModuleParamsRequest.ProtoReflect.Descriptor instead.
+func (*GetModuleParamsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_transaction_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetModuleParamsRequest) GetDevnet() string {
+	if x != nil {
+		return x.Devnet
+	}
+	return ""
+}
+
+func (x *GetModuleParamsRequest) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *GetModuleParamsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// GetModuleParamsResponse contains a module's current params.
+type GetModuleParamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Params        []byte                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"` // JSON object
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModuleParamsResponse) Reset() {
+	*x = GetModuleParamsResponse{}
+	mi := &file_v1_transaction_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModuleParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModuleParamsResponse) ProtoMessage() {}
+
+func (x *GetModuleParamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_transaction_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModuleParamsResponse.ProtoReflect.Descriptor instead.
+func (*GetModuleParamsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_transaction_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetModuleParamsResponse) GetParams() []byte {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// TraceTransactionRequest looks up an on-chain transaction by hash.
+type TraceTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devnet        string                 `protobuf:"bytes,1,opt,name=devnet,proto3" json:"devnet,omitempty"`
+	Hash          string                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`                          // CometBFT tx hash, or 0x-prefixed Ethereum hash
+	EvmTrace      bool                   `protobuf:"varint,3,opt,name=evm_trace,json=evmTrace,proto3" json:"evm_trace,omitempty"` // Include the EVM call trace (debug_traceTransaction)
+	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`                // Namespace (defaults to "default")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TraceTransactionRequest) Reset() {
+	*x = TraceTransactionRequest{}
+	mi := &file_v1_transaction_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraceTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceTransactionRequest) ProtoMessage() {}
+
+func (x *TraceTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_transaction_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceTransactionRequest.ProtoReflect.Descriptor instead.
+func (*TraceTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_transaction_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TraceTransactionRequest) GetDevnet() string {
+	if x != nil {
+		return x.Devnet
+	}
+	return ""
+}
+
+func (x *TraceTransactionRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *TraceTransactionRequest) GetEvmTrace() bool {
+	if x != nil {
+		return x.EvmTrace
+	}
+	return false
+}
+
+func (x *TraceTransactionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// TraceTransactionResponse contains the inspected transaction.
+type TraceTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trace         *TxTrace               `protobuf:"bytes,1,opt,name=trace,proto3" json:"trace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TraceTransactionResponse) Reset() {
+	*x = TraceTransactionResponse{}
+	mi := &file_v1_transaction_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraceTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceTransactionResponse) ProtoMessage() {}
+
+func (x *TraceTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_transaction_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceTransactionResponse.ProtoReflect.Descriptor instead.
+func (*TraceTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_v1_transaction_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TraceTransactionResponse) GetTrace() *TxTrace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+// TxTrace is a decoded on-chain transaction with its result.
+type TxTrace struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Hash      string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height    int64                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Code      uint32                 `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"` // 0 = success
+	Codespace string                 `protobuf:"bytes,4,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Log       string                 `protobuf:"bytes,5,opt,name=log,proto3" json:"log,omitempty"`
+	GasWanted int64                  `protobuf:"varint,6,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	GasUsed   int64                  `protobuf:"varint,7,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// Decoded by the network plugin, or by the Cosmos SDK decoder if the
+	// plugin doesn't decode transactions
+	Messages      []*TxTraceMessage `protobuf:"bytes,8,rep,name=messages,proto3" json:"messages,omitempty"`
+	Memo          string            `protobuf:"bytes,9,opt,name=memo,proto3" json:"memo,omitempty"`
+	Fee           string            `protobuf:"bytes,10,opt,name=fee,proto3" json:"fee,omitempty"`
+	DecodeError   string            `protobuf:"bytes,11,opt,name=decode_error,json=decodeError,proto3" json:"decode_error,omitempty"`
+	Events        []*TxTraceEvent   `protobuf:"bytes,12,rep,name=events,proto3" json:"events,omitempty"`
+	Nodes         []*TxTraceNode    `protobuf:"bytes,13,rep,name=nodes,proto3" json:"nodes,omitempty"` // Lookup result on each running node
+	EvmTxHash     string            `protobuf:"bytes,14,opt,name=evm_tx_hash,json=evmTxHash,proto3" json:"evm_tx_hash,omitempty"`
+	EvmTrace      []byte            `protobuf:"bytes,15,opt,name=evm_trace,json=evmTrace,proto3" json:"evm_trace,omitempty"` // callTracer JSON
+	EvmTraceError string            `protobuf:"bytes,16,opt,name=evm_trace_error,json=evmTraceError,proto3" json:"evm_trace_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxTrace) Reset() {
+	*x = TxTrace{}
+	mi := &file_v1_transaction_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxTrace) ProtoMessage() {}
+
+func (x *TxTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_transaction_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxTrace.ProtoReflect.Descriptor instead.
+func (*TxTrace) Descriptor() ([]byte, []int) {
+	return file_v1_transaction_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TxTrace) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *TxTrace) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *TxTrace) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *TxTrace) GetCodespace() string {
+	if x != nil {
+		return x.Codespace
+	}
+	return ""
+}
+
+func (x *TxTrace) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+func (x *TxTrace) GetGasWanted() int64 {
+	if x != nil {
+		return x.GasWanted
+	}
+	return 0
+}
+
+func (x *TxTrace) GetGasUsed() int64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *TxTrace) GetMessages() []*TxTraceMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *TxTrace) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *TxTrace) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *TxTrace) GetDecodeError() string {
+	if x != nil {
+		return x.DecodeError
+	}
+	return ""
+}
+
+func (x *TxTrace) GetEvents() []*TxTraceEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *TxTrace) GetNodes() []*TxTraceNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *TxTrace) GetEvmTxHash() string {
+	if x != nil {
+		return x.EvmTxHash
+	}
+	return ""
+}
+
+func (x *TxTrace) GetEvmTrace() []byte {
+	if x != nil {
+		return x.EvmTrace
+	}
+	return nil
+}
+
+func (x *TxTrace) GetEvmTraceError() string {
+	if x != nil {
+		return x.EvmTraceError
+	}
+	return ""
+}
+
+type TxTraceMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TypeUrl       string                 `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	Json          []byte                 `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"` // Empty if the decoder doesn't know the type
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxTraceMessage) Reset() {
+	*x = TxTraceMessage{}
+	mi := &file_v1_transaction_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxTraceMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxTraceMessage) ProtoMessage() {}
+
+func (x *TxTraceMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_transaction_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxTraceMessage.ProtoReflect.Descriptor instead.
+func (*TxTraceMessage) Descriptor() ([]byte, []int) {
+	return file_v1_transaction_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TxTraceMessage) GetTypeUrl() string {
+	if x != nil {
+		return x.TypeUrl
+	}
+	return ""
+}
+
+func (x *TxTraceMessage) GetJson() []byte {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+type TxTraceEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Attributes    []*TxTraceAttribute    `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxTraceEvent) Reset() {
+	*x = TxTraceEvent{}
+	mi := &file_v1_transaction_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxTraceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxTraceEvent) ProtoMessage() {}
+
+func (x *TxTraceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_transaction_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxTraceEvent.ProtoReflect.Descriptor instead.
+func (*TxTraceEvent) Descriptor() ([]byte, []int) {
+	return file_v1_transaction_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TxTraceEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TxTraceEvent) GetAttributes() []*TxTraceAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type TxTraceAttribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxTraceAttribute) Reset() {
+	*x = TxTraceAttribute{}
+	mi := &file_v1_transaction_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxTraceAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxTraceAttribute) ProtoMessage() {}
+
+func (x *TxTraceAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_transaction_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxTraceAttribute.ProtoReflect.Descriptor instead.
+func (*TxTraceAttribute) Descriptor() ([]byte, []int) {
+	return file_v1_transaction_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TxTraceAttribute) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TxTraceAttribute) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type TxTraceNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Height        int64                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxTraceNode) Reset() {
+	*x = TxTraceNode{}
+	mi := &file_v1_transaction_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxTraceNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxTraceNode) ProtoMessage() {}
+
+func (x *TxTraceNode) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_transaction_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxTraceNode.ProtoReflect.Descriptor instead.
+func (*TxTraceNode) Descriptor() ([]byte, []int) {
+	return file_v1_transaction_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TxTraceNode) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TxTraceNode) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *TxTraceNode) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *TxTraceNode) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_v1_transaction_proto protoreflect.FileDescriptor
 
 const file_v1_transaction_proto_rawDesc = "" +
@@ -891,21 +1888,106 @@ const file_v1_transaction_proto_rawDesc = "" +
 	"proposalId\x12\x1f\n" +
 	"\vvote_option\x18\x03 \x01(\tR\n" +
 	"voteOption\x12\x14\n" +
-	"\x05voter\x18\x04 \x01(\tR\x05voter\"\xc5\x01\n" +
+	"\x05voter\x18\x04 \x01(\tR\x05voter\"\xdf\x01\n" +
 	"\x18SubmitGovProposalRequest\x12\x16\n" +
 	"\x06devnet\x18\x01 \x01(\tR\x06devnet\x12#\n" +
 	"\rproposal_type\x18\x02 \x01(\tR\fproposalType\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
 	"\acontent\x18\x05 \x01(\fR\acontent\x12\x1a\n" +
-	"\bproposer\x18\x06 \x01(\tR\bproposer2\x90\x05\n" +
+	"\bproposer\x18\x06 \x01(\tR\bproposer\x12\x18\n" +
+	"\adeposit\x18\a \x01(\tR\adeposit\"n\n" +
+	"\x15GetGovProposalRequest\x12\x16\n" +
+	"\x06devnet\x18\x01 \x01(\tR\x06devnet\x12\x1f\n" +
+	"\vproposal_id\x18\x02 \x01(\x04R\n" +
+	"proposalId\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\"\xc8\x02\n" +
+	"\vGovProposal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x12\x1a\n" +
+	"\bproposer\x18\x05 \x01(\tR\bproposer\x12B\n" +
+	"\x0fvoting_end_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rvotingEndTime\x12#\n" +
+	"\rfailed_reason\x18\a \x01(\tR\ffailedReason\x12\x10\n" +
+	"\x03yes\x18\b \x01(\tR\x03yes\x12\x0e\n" +
+	"\x02no\x18\t \x01(\tR\x02no\x12\x18\n" +
+	"\aabstain\x18\n" +
+	" \x01(\tR\aabstain\x12 \n" +
+	"\fno_with_veto\x18\v \x01(\tR\n" +
+	"noWithVeto\"S\n" +
+	"\x16GetGovProposalResponse\x129\n" +
+	"\bproposal\x18\x01 \x01(\v2\x1d.devnetbuilder.v1.GovProposalR\bproposal\"\x97\x01\n" +
+	"\x1fBuildParamChangeProposalRequest\x12\x16\n" +
+	"\x06devnet\x18\x01 \x01(\tR\x06devnet\x12\x16\n" +
+	"\x06module\x18\x02 \x01(\tR\x06module\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\x12\x1c\n" +
+	"\tnamespace\x18\x05 \x01(\tR\tnamespace\"\x96\x01\n" +
+	" BuildParamChangeProposalResponse\x12\x1a\n" +
+	"\bproposal\x18\x01 \x01(\fR\bproposal\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\x12\x1c\n" +
+	"\texpedited\x18\x04 \x01(\bR\texpedited\"f\n" +
+	"\x16GetModuleParamsRequest\x12\x16\n" +
+	"\x06devnet\x18\x01 \x01(\tR\x06devnet\x12\x16\n" +
+	"\x06module\x18\x02 \x01(\tR\x06module\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\"1\n" +
+	"\x17GetModuleParamsResponse\x12\x16\n" +
+	"\x06params\x18\x01 \x01(\fR\x06params\"\x80\x01\n" +
+	"\x17TraceTransactionRequest\x12\x16\n" +
+	"\x06devnet\x18\x01 \x01(\tR\x06devnet\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x1b\n" +
+	"\tevm_trace\x18\x03 \x01(\bR\bevmTrace\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\"K\n" +
+	"\x18TraceTransactionResponse\x12/\n" +
+	"\x05trace\x18\x01 \x01(\v2\x19.devnetbuilder.v1.TxTraceR\x05trace\"\x8c\x04\n" +
+	"\aTxTrace\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x03R\x06height\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\rR\x04code\x12\x1c\n" +
+	"\tcodespace\x18\x04 \x01(\tR\tcodespace\x12\x10\n" +
+	"\x03log\x18\x05 \x01(\tR\x03log\x12\x1d\n" +
+	"\n" +
+	"gas_wanted\x18\x06 \x01(\x03R\tgasWanted\x12\x19\n" +
+	"\bgas_used\x18\a \x01(\x03R\agasUsed\x12<\n" +
+	"\bmessages\x18\b \x03(\v2 .devnetbuilder.v1.TxTraceMessageR\bmessages\x12\x12\n" +
+	"\x04memo\x18\t \x01(\tR\x04memo\x12\x10\n" +
+	"\x03fee\x18\n" +
+	" \x01(\tR\x03fee\x12!\n" +
+	"\fdecode_error\x18\v \x01(\tR\vdecodeError\x126\n" +
+	"\x06events\x18\f \x03(\v2\x1e.devnetbuilder.v1.TxTraceEventR\x06events\x123\n" +
+	"\x05nodes\x18\r \x03(\v2\x1d.devnetbuilder.v1.TxTraceNodeR\x05nodes\x12\x1e\n" +
+	"\vevm_tx_hash\x18\x0e \x01(\tR\tevmTxHash\x12\x1b\n" +
+	"\tevm_trace\x18\x0f \x01(\fR\bevmTrace\x12&\n" +
+	"\x0fevm_trace_error\x18\x10 \x01(\tR\revmTraceError\"?\n" +
+	"\x0eTxTraceMessage\x12\x19\n" +
+	"\btype_url\x18\x01 \x01(\tR\atypeUrl\x12\x12\n" +
+	"\x04json\x18\x02 \x01(\fR\x04json\"f\n" +
+	"\fTxTraceEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12B\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\v2\".devnetbuilder.v1.TxTraceAttributeR\n" +
+	"attributes\":\n" +
+	"\x10TxTraceAttribute\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"g\n" +
+	"\vTxTraceNode\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x14\n" +
+	"\x05found\x18\x02 \x01(\bR\x05found\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x03R\x06height\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error2\xcc\b\n" +
 	"\x12TransactionService\x12l\n" +
 	"\x11SubmitTransaction\x12*.devnetbuilder.v1.SubmitTransactionRequest\x1a+.devnetbuilder.v1.SubmitTransactionResponse\x12c\n" +
 	"\x0eGetTransaction\x12'.devnetbuilder.v1.GetTransactionRequest\x1a(.devnetbuilder.v1.GetTransactionResponse\x12i\n" +
 	"\x10ListTransactions\x12).devnetbuilder.v1.ListTransactionsRequest\x1a*.devnetbuilder.v1.ListTransactionsResponse\x12l\n" +
 	"\x11CancelTransaction\x12*.devnetbuilder.v1.CancelTransactionRequest\x1a+.devnetbuilder.v1.CancelTransactionResponse\x12`\n" +
 	"\rSubmitGovVote\x12&.devnetbuilder.v1.SubmitGovVoteRequest\x1a'.devnetbuilder.v1.SubmitGovVoteResponse\x12l\n" +
-	"\x11SubmitGovProposal\x12*.devnetbuilder.v1.SubmitGovProposalRequest\x1a+.devnetbuilder.v1.SubmitGovProposalResponseB\xd2\x01\n" +
+	"\x11SubmitGovProposal\x12*.devnetbuilder.v1.SubmitGovProposalRequest\x1a+.devnetbuilder.v1.SubmitGovProposalResponse\x12c\n" +
+	"\x0eGetGovProposal\x12'.devnetbuilder.v1.GetGovProposalRequest\x1a(.devnetbuilder.v1.GetGovProposalResponse\x12\x81\x01\n" +
+	"\x18BuildParamChangeProposal\x121.devnetbuilder.v1.BuildParamChangeProposalRequest\x1a2.devnetbuilder.v1.BuildParamChangeProposalResponse\x12f\n" +
+	"\x0fGetModuleParams\x12(.devnetbuilder.v1.GetModuleParamsRequest\x1a).devnetbuilder.v1.GetModuleParamsResponse\x12i\n" +
+	"\x10TraceTransaction\x12).devnetbuilder.v1.TraceTransactionRequest\x1a*.devnetbuilder.v1.TraceTransactionResponseB\xd2\x01\n" +
 	"\x14com.devnetbuilder.v1B\x10TransactionProtoP\x01ZGgithub.com/altuslabsxyz/devnet-builder/api/proto/gen/v1;devnetbuilderv1\xa2\x02\x03DXX\xaa\x02\x10Devnetbuilder.V1\xca\x02\x10Devnetbuilder\\V1\xe2\x02\x1cDevnetbuilder\\V1\\GPBMetadata\xea\x02\x11Devnetbuilder::V1b\x06proto3"
 
 var (
@@ -920,49 +2002,78 @@ func file_v1_transaction_proto_rawDescGZIP() []byte {
 	return file_v1_transaction_proto_rawDescData
 }
 
-var file_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_v1_transaction_proto_goTypes = []any{
-	(*Transaction)(nil),               // 0: devnetbuilder.v1.Transaction
-	(*SubmitTransactionRequest)(nil),  // 1: devnetbuilder.v1.SubmitTransactionRequest
-	(*GetTransactionRequest)(nil),     // 2: devnetbuilder.v1.GetTransactionRequest
-	(*ListTransactionsRequest)(nil),   // 3: devnetbuilder.v1.ListTransactionsRequest
-	(*ListTransactionsResponse)(nil),  // 4: devnetbuilder.v1.ListTransactionsResponse
-	(*CancelTransactionRequest)(nil),  // 5: devnetbuilder.v1.CancelTransactionRequest
-	(*SubmitTransactionResponse)(nil), // 6: devnetbuilder.v1.SubmitTransactionResponse
-	(*GetTransactionResponse)(nil),    // 7: devnetbuilder.v1.GetTransactionResponse
-	(*CancelTransactionResponse)(nil), // 8: devnetbuilder.v1.CancelTransactionResponse
-	(*SubmitGovVoteResponse)(nil),     // 9: devnetbuilder.v1.SubmitGovVoteResponse
-	(*SubmitGovProposalResponse)(nil), // 10: devnetbuilder.v1.SubmitGovProposalResponse
-	(*SubmitGovVoteRequest)(nil),      // 11: devnetbuilder.v1.SubmitGovVoteRequest
-	(*SubmitGovProposalRequest)(nil),  // 12: devnetbuilder.v1.SubmitGovProposalRequest
-	(*timestamppb.Timestamp)(nil),     // 13: google.protobuf.Timestamp
+	(*Transaction)(nil),                      // 0: devnetbuilder.v1.Transaction
+	(*SubmitTransactionRequest)(nil),         // 1: devnetbuilder.v1.SubmitTransactionRequest
+	(*GetTransactionRequest)(nil),            // 2: devnetbuilder.v1.GetTransactionRequest
+	(*ListTransactionsRequest)(nil),          // 3: devnetbuilder.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),         // 4: devnetbuilder.v1.ListTransactionsResponse
+	(*CancelTransactionRequest)(nil),         // 5: devnetbuilder.v1.CancelTransactionRequest
+	(*SubmitTransactionResponse)(nil),        // 6: devnetbuilder.v1.SubmitTransactionResponse
+	(*GetTransactionResponse)(nil),           // 7: devnetbuilder.v1.GetTransactionResponse
+	(*CancelTransactionResponse)(nil),        // 8: devnetbuilder.v1.CancelTransactionResponse
+	(*SubmitGovVoteResponse)(nil),            // 9: devnetbuilder.v1.SubmitGovVoteResponse
+	(*SubmitGovProposalResponse)(nil),        // 10: devnetbuilder.v1.SubmitGovProposalResponse
+	(*SubmitGovVoteRequest)(nil),             // 11: devnetbuilder.v1.SubmitGovVoteRequest
+	(*SubmitGovProposalRequest)(nil),         // 12: devnetbuilder.v1.SubmitGovProposalRequest
+	(*GetGovProposalRequest)(nil),            // 13: devnetbuilder.v1.GetGovProposalRequest
+	(*GovProposal)(nil),                      // 14: devnetbuilder.v1.GovProposal
+	(*GetGovProposalResponse)(nil),           // 15: devnetbuilder.v1.GetGovProposalResponse
+	(*BuildParamChangeProposalRequest)(nil),  // 16: devnetbuilder.v1.BuildParamChangeProposalRequest
+	(*BuildParamChangeProposalResponse)(nil), // 17: devnetbuilder.v1.BuildParamChangeProposalResponse
+	(*GetModuleParamsRequest)(nil),           // 18: devnetbuilder.v1.GetModuleParamsRequest
+	(*GetModuleParamsResponse)(nil),          // 19: devnetbuilder.v1.GetModuleParamsResponse
+	(*TraceTransactionRequest)(nil),          // 20: devnetbuilder.v1.TraceTransactionRequest
+	(*TraceTransactionResponse)(nil),         // 21: devnetbuilder.v1.TraceTransactionResponse
+	(*TxTrace)(nil),                          // 22: devnetbuilder.v1.TxTrace
+	(*TxTraceMessage)(nil),                   // 23: devnetbuilder.v1.TxTraceMessage
+	(*TxTraceEvent)(nil),                     // 24: devnetbuilder.v1.TxTraceEvent
+	(*TxTraceAttribute)(nil),                 // 25: devnetbuilder.v1.TxTraceAttribute
+	(*TxTraceNode)(nil),                      // 26: devnetbuilder.v1.TxTraceNode
+	(*timestamppb.Timestamp)(nil),            // 27: google.protobuf.Timestamp
 }
 var file_v1_transaction_proto_depIdxs = []int32{
-	13, // 0: devnetbuilder.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
-	13, // 1: devnetbuilder.v1.Transaction.updated_at:type_name -> google.protobuf.Timestamp
+	27, // 0: devnetbuilder.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
+	27, // 1: devnetbuilder.v1.Transaction.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: devnetbuilder.v1.ListTransactionsResponse.transactions:type_name -> devnetbuilder.v1.Transaction
 	0,  // 3: devnetbuilder.v1.SubmitTransactionResponse.transaction:type_name -> devnetbuilder.v1.Transaction
 	0,  // 4: devnetbuilder.v1.GetTransactionResponse.transaction:type_name -> devnetbuilder.v1.Transaction
 	0,  // 5: devnetbuilder.v1.CancelTransactionResponse.transaction:type_name -> devnetbuilder.v1.Transaction
 	0,  // 6: devnetbuilder.v1.SubmitGovVoteResponse.transaction:type_name -> devnetbuilder.v1.Transaction
 	0,  // 7: devnetbuilder.v1.SubmitGovProposalResponse.transaction:type_name -> devnetbuilder.v1.Transaction
-	1,  // 8: devnetbuilder.v1.TransactionService.SubmitTransaction:input_type -> devnetbuilder.v1.SubmitTransactionRequest
-	2,  // 9: devnetbuilder.v1.TransactionService.GetTransaction:input_type -> devnetbuilder.v1.GetTransactionRequest
-	3,  // 10: devnetbuilder.v1.TransactionService.ListTransactions:input_type -> devnetbuilder.v1.ListTransactionsRequest
-	5,  // 11: devnetbuilder.v1.TransactionService.CancelTransaction:input_type -> devnetbuilder.v1.CancelTransactionRequest
-	11, // 12: devnetbuilder.v1.TransactionService.SubmitGovVote:input_type -> devnetbuilder.v1.SubmitGovVoteRequest
-	12, // 13: devnetbuilder.v1.TransactionService.SubmitGovProposal:input_type -> devnetbuilder.v1.SubmitGovProposalRequest
-	6,  // 14: devnetbuilder.v1.TransactionService.SubmitTransaction:output_type -> devnetbuilder.v1.SubmitTransactionResponse
-	7,  // 15: devnetbuilder.v1.TransactionService.GetTransaction:output_type -> devnetbuilder.v1.GetTransactionResponse
-	4,  // 16: devnetbuilder.v1.TransactionService.ListTransactions:output_type -> devnetbuilder.v1.ListTransactionsResponse
-	8,  // 17: devnetbuilder.v1.TransactionService.CancelTransaction:output_type -> devnetbuilder.v1.CancelTransactionResponse
-	9,  // 18: devnetbuilder.v1.TransactionService.SubmitGovVote:output_type -> devnetbuilder.v1.SubmitGovVoteResponse
-	10, // 19: devnetbuilder.v1.TransactionService.SubmitGovProposal:output_type -> devnetbuilder.v1.SubmitGovProposalResponse
-	14, // [14:20] is the sub-list for method output_type
-	8,  // [8:14] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	27, // 8: devnetbuilder.v1.GovProposal.voting_end_time:type_name -> google.protobuf.Timestamp
+	14, // 9: devnetbuilder.v1.GetGovProposalResponse.proposal:type_name -> devnetbuilder.v1.GovProposal
+	22, // 10: devnetbuilder.v1.TraceTransactionResponse.trace:type_name -> devnetbuilder.v1.TxTrace
+	23, // 11: devnetbuilder.v1.TxTrace.messages:type_name -> devnetbuilder.v1.TxTraceMessage
+	24, // 12: devnetbuilder.v1.TxTrace.events:type_name -> devnetbuilder.v1.TxTraceEvent
+	26, // 13: devnetbuilder.v1.TxTrace.nodes:type_name -> devnetbuilder.v1.TxTraceNode
+	25, // 14: devnetbuilder.v1.TxTraceEvent.attributes:type_name -> devnetbuilder.v1.TxTraceAttribute
+	1,  // 15: devnetbuilder.v1.TransactionService.SubmitTransaction:input_type -> devnetbuilder.v1.SubmitTransactionRequest
+	2,  // 16: devnetbuilder.v1.TransactionService.GetTransaction:input_type -> devnetbuilder.v1.GetTransactionRequest
+	3,  // 17: devnetbuilder.v1.TransactionService.ListTransactions:input_type -> devnetbuilder.v1.ListTransactionsRequest
+	5,  // 18: devnetbuilder.v1.TransactionService.CancelTransaction:input_type -> devnetbuilder.v1.CancelTransactionRequest
+	11, // 19: devnetbuilder.v1.TransactionService.SubmitGovVote:input_type -> devnetbuilder.v1.SubmitGovVoteRequest
+	12, // 20: devnetbuilder.v1.TransactionService.SubmitGovProposal:input_type -> devnetbuilder.v1.SubmitGovProposalRequest
+	13, // 21: devnetbuilder.v1.TransactionService.GetGovProposal:input_type -> devnetbuilder.v1.GetGovProposalRequest
+	16, // 22: devnetbuilder.v1.TransactionService.BuildParamChangeProposal:input_type -> devnetbuilder.v1.BuildParamChangeProposalRequest
+	18, // 23: devnetbuilder.v1.TransactionService.GetModuleParams:input_type -> devnetbuilder.v1.GetModuleParamsRequest
+	20, // 24: devnetbuilder.v1.TransactionService.TraceTransaction:input_type -> devnetbuilder.v1.TraceTransactionRequest
+	6,  // 25: devnetbuilder.v1.TransactionService.SubmitTransaction:output_type -> devnetbuilder.v1.SubmitTransactionResponse
+	7,  // 26: devnetbuilder.v1.TransactionService.GetTransaction:output_type -> devnetbuilder.v1.GetTransactionResponse
+	4,  // 27: devnetbuilder.v1.TransactionService.ListTransactions:output_type -> devnetbuilder.v1.ListTransactionsResponse
+	8,  // 28: devnetbuilder.v1.TransactionService.CancelTransaction:output_type -> devnetbuilder.v1.CancelTransactionResponse
+	9,  // 29: devnetbuilder.v1.TransactionService.SubmitGovVote:output_type -> devnetbuilder.v1.SubmitGovVoteResponse
+	10, // 30: devnetbuilder.v1.TransactionService.SubmitGovProposal:output_type -> devnetbuilder.v1.SubmitGovProposalResponse
+	15, // 31: devnetbuilder.v1.TransactionService.GetGovProposal:output_type -> devnetbuilder.v1.GetGovProposalResponse
+	17, // 32: devnetbuilder.v1.TransactionService.BuildParamChangeProposal:output_type -> devnetbuilder.v1.BuildParamChangeProposalResponse
+	19, // 33: devnetbuilder.v1.TransactionService.GetModuleParams:output_type -> devnetbuilder.v1.GetModuleParamsResponse
+	21, // 34: devnetbuilder.v1.TransactionService.TraceTransaction:output_type -> devnetbuilder.v1.TraceTransactionResponse
+	25, // [25:35] is the sub-list for method output_type
+	15, // [15:25] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_v1_transaction_proto_init() }
@@ -976,7 +2087,7 @@ func file_v1_transaction_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_transaction_proto_rawDesc), len(file_v1_transaction_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,12 +22,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TransactionService_SubmitTransaction_FullMethodName = "/devnetbuilder.v1.TransactionService/SubmitTransaction"
-	TransactionService_GetTransaction_FullMethodName    = "/devnetbuilder.v1.TransactionService/GetTransaction"
-	TransactionService_ListTransactions_FullMethodName  = "/devnetbuilder.v1.TransactionService/ListTransactions"
-	TransactionService_CancelTransaction_FullMethodName = "/devnetbuilder.v1.TransactionService/CancelTransaction"
-	TransactionService_SubmitGovVote_FullMethodName     = "/devnetbuilder.v1.TransactionService/SubmitGovVote"
-	TransactionService_SubmitGovProposal_FullMethodName = "/devnetbuilder.v1.TransactionService/SubmitGovProposal"
+	TransactionService_SubmitTransaction_FullMethodName        = "/devnetbuilder.v1.TransactionService/SubmitTransaction"
+	TransactionService_GetTransaction_FullMethodName           = "/devnetbuilder.v1.TransactionService/GetTransaction"
+	TransactionService_ListTransactions_FullMethodName         = "/devnetbuilder.v1.TransactionService/ListTransactions"
+	TransactionService_CancelTransaction_FullMethodName        = "/devnetbuilder.v1.TransactionService/CancelTransaction"
+	TransactionService_SubmitGovVote_FullMethodName            = "/devnetbuilder.v1.TransactionService/SubmitGovVote"
+	TransactionService_SubmitGovProposal_FullMethodName        = "/devnetbuilder.v1.TransactionService/SubmitGovProposal"
+	TransactionService_GetGovProposal_FullMethodName           = "/devnetbuilder.v1.TransactionService/GetGovProposal"
+	TransactionService_BuildParamChangeProposal_FullMethodName = "/devnetbuilder.v1.TransactionService/BuildParamChangeProposal"
+	TransactionService_GetModuleParams_FullMethodName          = "/devnetbuilder.v1.TransactionService/GetModuleParams"
+	TransactionService_TraceTransaction_FullMethodName         = "/devnetbuilder.v1.TransactionService/TraceTransaction"
 )
 
 // TransactionServiceClient is the client API for TransactionService service.
@@ -44,6 +48,11 @@ type TransactionServiceClient interface {
 	// Governance convenience methods
 	SubmitGovVote(ctx context.Context, in *SubmitGovVoteRequest, opts ...grpc.CallOption) (*SubmitGovVoteResponse, error)
 	SubmitGovProposal(ctx context.Context, in *SubmitGovProposalRequest, opts ...grpc.CallOption) (*SubmitGovProposalResponse, error)
+	GetGovProposal(ctx context.Context, in *GetGovProposalRequest, opts ...grpc.CallOption) (*GetGovProposalResponse, error)
+	BuildParamChangeProposal(ctx context.Context, in *BuildParamChangeProposalRequest, opts ...grpc.CallOption) (*BuildParamChangeProposalResponse, error)
+	GetModuleParams(ctx context.Context, in *GetModuleParamsRequest, opts ...grpc.CallOption) (*GetModuleParamsResponse, error)
+	// Inspection
+	TraceTransaction(ctx context.Context, in *TraceTransactionRequest, opts ...grpc.CallOption) (*TraceTransactionResponse, error)
 }
 
 type transactionServiceClient struct {
@@ -114,6 +123,46 @@ func (c *transactionServiceClient) SubmitGovProposal(ctx context.Context, in *Su
 	return out, nil
 }
 
+func (c *transactionServiceClient) GetGovProposal(ctx context.Context, in *GetGovProposalRequest, opts ...grpc.CallOption) (*GetGovProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGovProposalResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetGovProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) BuildParamChangeProposal(ctx context.Context, in *BuildParamChangeProposalRequest, opts ...grpc.CallOption) (*BuildParamChangeProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuildParamChangeProposalResponse)
+	err := c.cc.Invoke(ctx, TransactionService_BuildParamChangeProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetModuleParams(ctx context.Context, in *GetModuleParamsRequest, opts ...grpc.CallOption) (*GetModuleParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModuleParamsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetModuleParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) TraceTransaction(ctx context.Context, in *TraceTransactionRequest, opts ...grpc.CallOption) (*TraceTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TraceTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_TraceTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServiceServer is the server API for TransactionService service.
 // All implementations must embed UnimplementedTransactionServiceServer
 // for forward compatibility.
@@ -128,6 +177,11 @@ type TransactionServiceServer interface {
 	// Governance convenience methods
 	SubmitGovVote(context.Context, *SubmitGovVoteRequest) (*SubmitGovVoteResponse, error)
 	SubmitGovProposal(context.Context, *SubmitGovProposalRequest) (*SubmitGovProposalResponse, error)
+	GetGovProposal(context.Context, *GetGovProposalRequest) (*GetGovProposalResponse, error)
+	BuildParamChangeProposal(context.Context, *BuildParamChangeProposalRequest) (*BuildParamChangeProposalResponse, error)
+	GetModuleParams(context.Context, *GetModuleParamsRequest) (*GetModuleParamsResponse, error)
+	// Inspection
+	TraceTransaction(context.Context, *TraceTransactionRequest) (*TraceTransactionResponse, error)
 	mustEmbedUnimplementedTransactionServiceServer()
 }
 
@@ -156,6 +210,18 @@ func (UnimplementedTransactionServiceServer) SubmitGovVote(context.Context, *Sub
 func (UnimplementedTransactionServiceServer) SubmitGovProposal(context.Context, *SubmitGovProposalRequest) (*SubmitGovProposalResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SubmitGovProposal not implemented")
 }
+func (UnimplementedTransactionServiceServer) GetGovProposal(context.Context, *GetGovProposalRequest) (*GetGovProposalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGovProposal not implemented")
+}
+func (UnimplementedTransactionServiceServer) BuildParamChangeProposal(context.Context, *BuildParamChangeProposalRequest) (*BuildParamChangeProposalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BuildParamChangeProposal not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetModuleParams(context.Context, *GetModuleParamsRequest) (*GetModuleParamsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetModuleParams not implemented")
+}
+func (UnimplementedTransactionServiceServer) TraceTransaction(context.Context, *TraceTransactionRequest) (*TraceTransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TraceTransaction not implemented")
+}
 func (UnimplementedTransactionServiceServer) mustEmbedUnimplementedTransactionServiceServer() {}
 func (UnimplementedTransactionServiceServer) testEmbeddedByValue()                            {}
 
@@ -285,6 +351,78 @@ func _TransactionService_SubmitGovProposal_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_GetGovProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGovProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetGovProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetGovProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetGovProposal(ctx, req.(*GetGovProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_BuildParamChangeProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildParamChangeProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).BuildParamChangeProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_BuildParamChangeProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).BuildParamChangeProposal(ctx, req.(*BuildParamChangeProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetModuleParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModuleParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetModuleParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetModuleParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetModuleParams(ctx, req.(*GetModuleParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_TraceTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).TraceTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_TraceTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).TraceTransaction(ctx, req.(*TraceTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TransactionService_ServiceDesc is the grpc.ServiceDesc for TransactionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -316,6 +454,22 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitGovProposal",
 			Handler:    _TransactionService_SubmitGovProposal_Handler,
 		},
+		{
+			MethodName: "GetGovProposal",
+			Handler:    _TransactionService_GetGovProposal_Handler,
+		},
+		{
+			MethodName: "BuildParamChangeProposal",
+			Handler:    _TransactionService_BuildParamChangeProposal_Handler,
+		},
+		{
+			MethodName: "GetModuleParams",
+			Handler:    _TransactionService_GetModuleParams_Handler,
+		},
+		{
+			MethodName: "TraceTransaction",
+			Handler:    _TransactionService_TraceTransaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/transaction.proto",
@@ -14,6 +14,7 @@ import (
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/server"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/transport"
 	"github.com/altuslabsxyz/devnet-builder/internal/output"
 	"github.com/altuslabsxyz/devnet-builder/types/ctxconfig"
 	"github.com/spf13/cobra"
@@ -134,9 +135,7 @@ func runStart(cmd *cobra.Command, args []string) error {
 
 	// Start in background
 	daemonCmd := exec.Command(devnetdPath, daemonArgs...)
-	daemonCmd.SysProcAttr = &syscall.SysProcAttr{
-		Setpgid: true,
-	}
+	detachProcess(daemonCmd)
 
 	if err := daemonCmd.Start(); err != nil {
 		return fmt.Errorf("failed to start daemon: %w", err)
@@ -181,7 +180,7 @@ func runStop(cmd *cobra.Command, args []string) error {
 	}
 
 	// Remove stale socket file
-	_ = os.Remove(socketPath)
+	transport.Cleanup(socketPath)
 
 	output.Success("Daemon stopped")
 	return nil
@@ -241,7 +240,9 @@ func runDaemonStatus(cmd *cobra.Command, args []string) error {
 
 // isDaemonRunning checks if the daemon is running by testing the socket.
 func isDaemonRunning(socketPath string) bool {
-	conn, err := net.DialTimeout("unix", socketPath, time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	conn, err := transport.Dial(ctx, socketPath)
 	if err != nil {
 		return false
 	}
@@ -253,7 +254,10 @@ func isDaemonRunning(socketPath string) bool {
 func getDaemonPID(socketPath string) (int, error) {
 	// Try to get PID from daemon via gRPC
 	conn, err := grpc.NewClient(
-		"unix://"+socketPath,
+		"passthrough:///devnetd",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return transport.Dial(ctx, socketPath)
+		}),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
 	if err != nil {
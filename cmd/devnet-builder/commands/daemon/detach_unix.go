@@ -0,0 +1,16 @@
+//go:build !windows
+
+package daemon
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// detachProcess starts cmd in its own process group, so the daemon
+// outlives the terminal's Ctrl+C.
+func detachProcess(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid: true,
+	}
+}
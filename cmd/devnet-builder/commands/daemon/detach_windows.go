@@ -0,0 +1,18 @@
+//go:build windows
+
+package daemon
+
+import (
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
+
+// detachProcess starts cmd in its own process group without a console, so
+// the daemon outlives the terminal's Ctrl+C.
+func detachProcess(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		CreationFlags: windows.CREATE_NEW_PROCESS_GROUP | windows.DETACHED_PROCESS,
+	}
+}
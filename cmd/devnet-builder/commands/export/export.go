@@ -174,7 +174,7 @@ func runList(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	output.Bold(fmt.Sprintf("Exports (%d total, %s)", result.TotalCount, formatBytes(result.TotalSize)))
+	output.Bold("Exports (%d total, %s)", result.TotalCount, formatBytes(result.TotalSize))
 	fmt.Println("─────────────────────────────────────────────────────────────────────────────────────────")
 	fmt.Printf("%-35s  %-10s  %-20s  %-12s  %s\n", "DIRECTORY", "HEIGHT", "TIMESTAMP", "VERSION", "NETWORK")
 	fmt.Println("─────────────────────────────────────────────────────────────────────────────────────────")
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/config"
+	"github.com/altuslabsxyz/devnet-builder/internal/domain/credential"
+	infracred "github.com/altuslabsxyz/devnet-builder/internal/infrastructure/credential"
 	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/github"
 	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/interactive"
 	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
@@ -108,15 +110,21 @@ func RunInteractiveVersionSelectionWithMode(ctx context.Context, cmd *cobra.Comm
 	return selector.RunVersionSelectionFlow(ctx, networkName)
 }
 
-// ResolveGitHubToken resolves the GitHub token from environment or config.
-// Priority: environment variable > config file.
+// ResolveGitHubToken resolves the GitHub token from environment, keychain
+// or config.
+// Priority: environment variable > OS keychain > config file.
 func ResolveGitHubToken(fileCfg *config.FileConfig) (string, bool) {
 	// Priority 1: Environment variable
 	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
 		return token, true
 	}
 
-	// Priority 2: Config file
+	// Priority 2: OS keychain ('dvb auth github login')
+	if cred, err := infracred.NewKeychainStore().Get(credential.TypeGitHubToken); err == nil && cred.Value != "" {
+		return cred.Value, true
+	}
+
+	// Priority 3: Config file
 	if fileCfg != nil && fileCfg.GitHubToken != nil && *fileCfg.GitHubToken != "" {
 		return *fileCfg.GitHubToken, true
 	}
@@ -60,6 +60,8 @@ var (
 	upgradeNoInteractive bool
 	upgradeVersion       string
 	skipGovernance       bool
+	upgradeDryRun        bool
+	upgradePath          string
 
 	// Resume-related flags
 	upgradeResume       bool
@@ -83,6 +85,10 @@ This command automates the complete upgrade process:
   4. Switch to the new binary
   5. Verify chain resumes
 
+With --path, run several governance upgrades in order. Every target is
+resolved before the first proposal, and the chain must keep producing blocks
+after each upgrade before the next one starts.
+
 With --skip-gov flag, skip governance and directly replace the binary:
   1. Stop all nodes
   2. Replace the binary
@@ -109,6 +115,15 @@ Examples:
   # Force custom voting period (override chain/plugin parameters)
   devnet-builder upgrade --name v2.0.0-upgrade --image ghcr.io/stablelabs/stable:v2.0.0 --voting-period 30s --force-voting-period
 
+  # Show the upgrade plan (target, voting period, predicted height) without proposing
+  devnet-builder upgrade --dry-run --no-interactive --name v2.0.0-upgrade --version v2.0.0
+
+  # Upgrade through several versions in one run, exporting genesis around each step
+  devnet-builder upgrade --path v1.2.0,v1.3.0,v2.0.0 --with-export
+
+  # Upgrade path with explicit upgrade handler names
+  devnet-builder upgrade --path v1.3=v1.3.0,v2=v2.0.0
+
   # Upgrade and export state snapshots
   devnet-builder upgrade --name v2.0.0-upgrade --image ghcr.io/stablelabs/stable:v2.0.0 --with-export
 
@@ -154,6 +169,8 @@ Resume options (for interrupted upgrades):
 	cmd.Flags().IntVar(&heightBuffer, "height-buffer", DefaultHeightBuffer, "Blocks to add after voting period ends (0 = auto-calculate based on block time)")
 	cmd.Flags().BoolVar(&withExport, "with-export", false, "Export state before and after upgrade")
 	cmd.Flags().StringVar(&genesisDir, "genesis-dir", "", "Directory for genesis exports (default: <home>/devnet/genesis-snapshots)")
+	cmd.Flags().StringVar(&upgradePath, "path", "", "Comma-separated versions to upgrade through in order (entries may be name=version)")
+	cmd.Flags().BoolVar(&upgradeDryRun, "dry-run", false, "Print the upgrade plan and predicted upgrade height without building or proposing anything")
 
 	// Resume flags (for interrupted upgrades)
 	cmd.Flags().BoolVar(&upgradeResume, "resume", false, "Resume an interrupted upgrade from saved state")
@@ -281,6 +298,10 @@ func runUpgrade(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if upgradePath != "" {
+		return runUpgradePath(ctx, homeDir, svc, cleanMetadata, networkModule, resolvedMode, jsonMode)
+	}
+
 	// Track selected version and name
 	var selectedVersion string
 	var selectedName string
@@ -379,6 +400,7 @@ For more information, see: https://github.com/altuslabsxyz/devnet-builder/blob/m
 	// Mode-aware version resolution
 	var cachedBuildResult *dto.BuildOutput
 	var versionResolvedImage string
+	buildRequired := false
 
 	if selectedVersion != "" && upgradeImage == "" && upgradeBinary == "" {
 		if resolvedMode == UpgradeModeDocker && isStandardVersionTag(selectedVersion) {
@@ -386,6 +408,9 @@ For more information, see: https://github.com/altuslabsxyz/devnet-builder/blob/m
 			dockerImage := networkModule.DockerImage()
 			versionResolvedImage = fmt.Sprintf("%s:%s", dockerImage, selectedVersion)
 			logger.Info("Using docker image for version %s: %s", selectedVersion, versionResolvedImage)
+		} else if upgradeDryRun {
+			// Dry run: report the build instead of running it
+			buildRequired = true
 		} else {
 			// Local mode or custom ref: build local binary to cache using DI container
 			buildResult, err := buildBinaryForUpgrade(ctx, cleanMetadata.BlockchainNetwork, selectedVersion, cleanMetadata.NetworkName, homeDir, logger)
@@ -402,8 +427,8 @@ For more information, see: https://github.com/altuslabsxyz/devnet-builder/blob/m
 	}
 
 	// Get governance parameters (skip if --skip-gov is set)
-	var govParams *ports.GovParams
 	var vp time.Duration
+	var vpSource string
 
 	if skipGovernance {
 		// Skip governance mode - show warning
@@ -413,51 +438,11 @@ For more information, see: https://github.com/altuslabsxyz/devnet-builder/blob/m
 			logger.Warn("Chain state must be compatible with the new version.")
 			fmt.Println()
 		}
-	} else if forceVotingPeriod {
-		// User explicitly wants to override with CLI value
-		logger.Info("Using forced voting period from --voting-period flag...")
-		parsedVP, parseErr := time.ParseDuration(votingPeriod)
-		if parseErr != nil {
-			return fmt.Errorf("invalid voting period: %w", parseErr)
-		}
-		vp = parsedVP
-		logger.Info("Forced expedited voting period: %s", vp)
 	} else {
-		// Query from chain (plugin or REST)
-		logger.Info("Fetching governance parameters from chain...")
-		rpcHost := "localhost"
-		rpcPort := 26657
-		tempFactory := di.NewInfrastructureFactory(homeDir, logger).
-			WithNetworkModule(networkModule)
-		rpcClient := tempFactory.CreateRPCClient(rpcHost, rpcPort)
-
-		// Configure plugin delegation for governance parameter queries
-		// Type assert to check if network module supports governance parameter queries
-		if cosmosClient, ok := rpcClient.(*infrarpc.CosmosRPCClient); ok {
-			// Check if network module implements GetGovernanceParams (optional interface)
-			if pluginModule, ok := networkModule.(infrarpc.NetworkPluginModule); ok {
-				rpcClient = cosmosClient.WithPlugin(pluginModule, cleanMetadata.NetworkName)
-			}
-			// If plugin doesn't implement GetGovernanceParams, will fall back to REST API
-		}
-
-		var err error
-		govParams, err = rpcClient.GetGovParams(ctx)
+		vp, vpSource, err = resolveVotingPeriod(ctx, homeDir, networkModule, cleanMetadata.NetworkName, logger)
 		if err != nil {
-			logger.Debug("Failed to fetch gov params, using CLI flag value: %v", err)
-			// Fallback to CLI flag if chain query fails
-			parsedVP, parseErr := time.ParseDuration(votingPeriod)
-			if parseErr != nil {
-				return fmt.Errorf("invalid voting period: %w", parseErr)
-			}
-			govParams = &ports.GovParams{
-				ExpeditedVotingPeriod: parsedVP,
-			}
+			return err
 		}
-
-		// Use expedited voting period from chain
-		vp = govParams.ExpeditedVotingPeriod
-		logger.Info("Using expedited voting period: %s", vp)
 	}
 
 	// Binary resolution for local mode upgrades (--binary flag removed)
@@ -468,6 +453,9 @@ For more information, see: https://github.com/altuslabsxyz/devnet-builder/blob/m
 		if cachedBuildResult != nil {
 			// Binary was pre-built from custom ref (e.g., feat/gas-waiver) - use it directly
 			logger.Debug("Using pre-built binary from custom ref: %s", cachedBuildResult.BinaryPath)
+		} else if buildRequired {
+			// Dry run: the binary would be built from selectedVersion
+			logger.Debug("Binary would be built from %s", selectedVersion)
 		} else if customBinarySymlinkPath == "" {
 			// No binary selected yet - fall back to cache selection (for non-interactive mode or GitHub release flow)
 			// Priority 2: Interactive/Auto selection from cache (US1)
@@ -501,6 +489,26 @@ For more information, see: https://github.com/altuslabsxyz/devnet-builder/blob/m
 		targetImage = versionResolvedImage
 	}
 
+	if upgradeDryRun {
+		planInput := dto.UpgradePlanInput{
+			UpgradeName:        selectedName,
+			Mode:               types.ExecutionMode(resolvedMode),
+			TargetBinary:       targetBinary,
+			TargetImage:        targetImage,
+			TargetVersion:      selectedVersion,
+			BuildRequired:      buildRequired,
+			VotingPeriod:       vp,
+			VotingPeriodSource: vpSource,
+			HeightBuffer:       heightBuffer,
+			NumValidators:      cleanMetadata.NumValidators,
+			SkipGovernance:     skipGovernance,
+		}
+		if cachedBuildResult != nil {
+			planInput.TargetBinary = cachedBuildResult.BinaryPath
+		}
+		return runUpgradeDryRun(ctx, homeDir, networkModule, planInput, jsonMode)
+	}
+
 	// Print upgrade plan (non-JSON mode)
 	if !jsonMode {
 		if skipGovernance {
@@ -586,6 +594,60 @@ For more information, see: https://github.com/altuslabsxyz/devnet-builder/blob/m
 	return outputUpgradeText(result)
 }
 
+// resolveVotingPeriod returns the expedited voting period the upgrade
+// proposal will use and where it came from: "forced" (--force-voting-period),
+// "chain" (queried via the plugin or REST), or "fallback" (--voting-period
+// because the chain query failed).
+func resolveVotingPeriod(ctx context.Context, homeDir string, networkModule network.NetworkModule, networkName string, logger *output.Logger) (time.Duration, string, error) {
+	if forceVotingPeriod {
+		// User explicitly wants to override with CLI value
+		logger.Info("Using forced voting period from --voting-period flag...")
+		vp, err := time.ParseDuration(votingPeriod)
+		if err != nil {
+			return 0, "", fmt.Errorf("invalid voting period: %w", err)
+		}
+		logger.Info("Forced expedited voting period: %s", vp)
+		return vp, "forced", nil
+	}
+
+	// Query from chain (plugin or REST)
+	logger.Info("Fetching governance parameters from chain...")
+	rpcHost := "localhost"
+	rpcPort := 26657
+	tempFactory := di.NewInfrastructureFactory(homeDir, logger).
+		WithNetworkModule(networkModule)
+	rpcClient := tempFactory.CreateRPCClient(rpcHost, rpcPort)
+
+	// Configure plugin delegation for governance parameter queries
+	// Type assert to check if network module supports governance parameter queries
+	if cosmosClient, ok := rpcClient.(*infrarpc.CosmosRPCClient); ok {
+		// Check if network module implements GetGovernanceParams (optional interface)
+		if pluginModule, ok := networkModule.(infrarpc.NetworkPluginModule); ok {
+			rpcClient = cosmosClient.WithPlugin(pluginModule, networkName)
+		}
+		// If plugin doesn't implement GetGovernanceParams, will fall back to REST API
+	}
+
+	source := "chain"
+	govParams, err := rpcClient.GetGovParams(ctx)
+	if err != nil {
+		logger.Debug("Failed to fetch gov params, using CLI flag value: %v", err)
+		// Fallback to CLI flag if chain query fails
+		parsedVP, parseErr := time.ParseDuration(votingPeriod)
+		if parseErr != nil {
+			return 0, "", fmt.Errorf("invalid voting period: %w", parseErr)
+		}
+		govParams = &ports.GovParams{
+			ExpeditedVotingPeriod: parsedVP,
+		}
+		source = "fallback"
+	}
+
+	// Use expedited voting period from chain
+	logger.Info("Using expedited voting period: %s", govParams.ExpeditedVotingPeriod)
+	return govParams.ExpeditedVotingPeriod, source, nil
+}
+
 // selectBinaryForUpgrade orchestrates binary selection from cache for upgrade command.
 // This is simpler than selectBinaryForDeployment because upgrade doesn't build from source.
 func selectBinaryForUpgrade(
@@ -829,6 +891,167 @@ func printSkipGovPlan(mode, targetImage, targetBinary string, cached *dto.BuildO
 	fmt.Println()
 }
 
+// UpgradePlanJSON represents the JSON output of 'upgrade --dry-run'.
+type UpgradePlanJSON struct {
+	Status             string                `json:"status"`
+	UpgradeName        string                `json:"upgrade_name,omitempty"`
+	Mode               string                `json:"mode"`
+	SkipGovernance     bool                  `json:"skip_governance"`
+	TargetImage        string                `json:"target_image,omitempty"`
+	TargetBinary       string                `json:"target_binary,omitempty"`
+	TargetVersion      string                `json:"target_version,omitempty"`
+	BuildRequired      bool                  `json:"build_required"`
+	VotingPeriod       string                `json:"voting_period,omitempty"`
+	VotingPeriodSource string                `json:"voting_period_source,omitempty"`
+	CurrentHeight      int64                 `json:"current_height"`
+	BlockTime          string                `json:"block_time"`
+	BlockTimeMeasured  bool                  `json:"block_time_measured"`
+	VotingBlocks       int64                 `json:"voting_blocks,omitempty"`
+	HeightBuffer       int64                 `json:"height_buffer,omitempty"`
+	HeightBufferAuto   bool                  `json:"height_buffer_auto,omitempty"`
+	UpgradeHeight      int64                 `json:"upgrade_height,omitempty"`
+	EstimatedDuration  string                `json:"estimated_duration"`
+	Timeline           []UpgradePlanStepJSON `json:"timeline"`
+}
+
+// UpgradePlanStepJSON is one stage of the timeline in UpgradePlanJSON.
+type UpgradePlanStepJSON struct {
+	Stage  string `json:"stage"`
+	Height int64  `json:"height,omitempty"`
+	After  string `json:"after"`
+}
+
+// runUpgradeDryRun computes the upgrade plan and prints it without
+// proposing, voting or switching binaries.
+func runUpgradeDryRun(ctx context.Context, homeDir string, networkModule network.NetworkModule, input dto.UpgradePlanInput, jsonMode bool) error {
+	factory := di.NewInfrastructureFactory(homeDir, output.DefaultLogger).
+		WithNetworkModule(networkModule).
+		WithDockerMode(input.Mode == types.ExecutionModeDocker)
+
+	container, err := factory.WireContainer()
+	if err != nil {
+		return outputUpgradeError(fmt.Errorf("failed to initialize: %w", err))
+	}
+
+	plan, err := container.UpgradePlanUseCase().Execute(ctx, input)
+	if err != nil {
+		if jsonMode {
+			return outputUpgradeError(err)
+		}
+		return err
+	}
+
+	if jsonMode {
+		return outputUpgradePlanJSON(input, plan)
+	}
+	printUpgradeDryRun(input, plan)
+	return nil
+}
+
+func printUpgradeDryRun(input dto.UpgradePlanInput, plan *dto.UpgradePlanOutput) {
+	if input.SkipGovernance {
+		output.Bold("Binary Replacement Plan (--skip-gov, dry run)")
+	} else {
+		output.Bold("Upgrade Plan (dry run)")
+	}
+	fmt.Println("─────────────────────────────────────────────────────────")
+	if !input.SkipGovernance {
+		fmt.Printf("Upgrade Name:     %s\n", input.UpgradeName)
+	}
+	fmt.Printf("Execution Mode:   %s\n", input.Mode)
+	switch {
+	case input.TargetImage != "":
+		fmt.Printf("Target Image:     %s\n", input.TargetImage)
+	case input.BuildRequired:
+		fmt.Printf("Target Binary:    built from %s\n", input.TargetVersion)
+	case input.TargetBinary != "":
+		fmt.Printf("Target Binary:    %s\n", input.TargetBinary)
+	}
+	fmt.Printf("Validators:       %d\n", input.NumValidators)
+	fmt.Printf("Current Height:   %d\n", plan.CurrentHeight)
+	if plan.BlockTimeMeasured {
+		fmt.Printf("Block Time:       %s (measured)\n", plan.BlockTime)
+	} else {
+		fmt.Printf("Block Time:       %s (assumed)\n", plan.BlockTime)
+	}
+	if !input.SkipGovernance {
+		fmt.Printf("Voting Period:    %s (%s, %d blocks)\n", input.VotingPeriod, votingPeriodSourceText(input.VotingPeriodSource), plan.VotingBlocks)
+		if plan.HeightBufferAuto {
+			fmt.Printf("Height Buffer:    %d blocks (auto)\n", plan.HeightBuffer)
+		} else {
+			fmt.Printf("Height Buffer:    %d blocks (manual)\n", plan.HeightBuffer)
+		}
+		fmt.Printf("Upgrade Height:   %s\n", color.CyanString("%d", plan.UpgradeHeight))
+	}
+	fmt.Println()
+
+	output.Bold("Expected Timeline")
+	fmt.Println("─────────────────────────────────────────────────────────")
+	for _, step := range plan.Timeline {
+		height := "-"
+		if step.Height > 0 {
+			height = fmt.Sprintf("%d", step.Height)
+		}
+		fmt.Printf("  +%-8s  %-10s  %s\n", step.Offset.Round(time.Second), height, step.Stage)
+	}
+	fmt.Printf("\nEstimated Duration: ~%s\n", plan.EstimatedDuration.Round(time.Second))
+	fmt.Println()
+	output.Info("Dry run: no proposal was submitted. Run again without --dry-run to upgrade.")
+}
+
+// votingPeriodSourceText describes where the voting period came from.
+func votingPeriodSourceText(source string) string {
+	switch source {
+	case "forced":
+		return "from --voting-period, forced"
+	case "fallback":
+		return "from --voting-period, chain query failed"
+	default:
+		return "from chain"
+	}
+}
+
+func outputUpgradePlanJSON(input dto.UpgradePlanInput, plan *dto.UpgradePlanOutput) error {
+	jsonPlan := UpgradePlanJSON{
+		Status:            "dry_run",
+		UpgradeName:       input.UpgradeName,
+		Mode:              string(input.Mode),
+		SkipGovernance:    input.SkipGovernance,
+		TargetImage:       input.TargetImage,
+		TargetBinary:      input.TargetBinary,
+		TargetVersion:     input.TargetVersion,
+		BuildRequired:     input.BuildRequired,
+		CurrentHeight:     plan.CurrentHeight,
+		BlockTime:         plan.BlockTime.String(),
+		BlockTimeMeasured: plan.BlockTimeMeasured,
+		VotingBlocks:      plan.VotingBlocks,
+		HeightBuffer:      plan.HeightBuffer,
+		HeightBufferAuto:  plan.HeightBufferAuto,
+		UpgradeHeight:     plan.UpgradeHeight,
+		EstimatedDuration: plan.EstimatedDuration.String(),
+		Timeline:          make([]UpgradePlanStepJSON, 0, len(plan.Timeline)),
+	}
+	if !input.SkipGovernance {
+		jsonPlan.VotingPeriod = input.VotingPeriod.String()
+		jsonPlan.VotingPeriodSource = input.VotingPeriodSource
+	}
+	for _, step := range plan.Timeline {
+		jsonPlan.Timeline = append(jsonPlan.Timeline, UpgradePlanStepJSON{
+			Stage:  step.Stage,
+			Height: step.Height,
+			After:  step.Offset.String(),
+		})
+	}
+
+	data, err := json.MarshalIndent(jsonPlan, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(data))
+	return nil
+}
+
 var (
 	lastUpgradeStage   string
 	lastUpgradeStageMu sync.RWMutex
@@ -0,0 +1,346 @@
+package manage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/application"
+	"github.com/altuslabsxyz/devnet-builder/internal/application/dto"
+	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+	"github.com/altuslabsxyz/devnet-builder/internal/di"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	"github.com/altuslabsxyz/devnet-builder/internal/output"
+	"github.com/altuslabsxyz/devnet-builder/types"
+	"github.com/fatih/color"
+)
+
+// upgradePathVerifyBlocks is how many blocks the chain must produce after
+// each step of an upgrade path before the next step starts.
+const upgradePathVerifyBlocks = 3
+
+// upgradePathStep is one upgrade of an --path chain.
+type upgradePathStep struct {
+	Name    string // Upgrade handler name
+	Version string // Target version (tag or branch/commit)
+
+	// Resolved target, set before the first upgrade starts.
+	image  string
+	cached *dto.BuildOutput
+}
+
+// UpgradePathResultJSON represents the JSON output of 'upgrade --path'.
+type UpgradePathResultJSON struct {
+	Status     string              `json:"status"`
+	Steps      []UpgradeResultJSON `json:"steps"`
+	FailedStep int                 `json:"failed_step,omitempty"`
+	Error      string              `json:"error,omitempty"`
+	Duration   string              `json:"duration"`
+}
+
+// parseUpgradePath parses an --path value: a comma-separated list of
+// versions, each optionally prefixed with its upgrade handler name as
+// "name=version". Without a name, the handler name is derived from the
+// version the same way interactive mode does ("v1.3.0" → "v1.3.0-upgrade").
+func parseUpgradePath(s string) ([]upgradePathStep, error) {
+	var steps []upgradePathStep
+	for i, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			return nil, fmt.Errorf("invalid --path: entry %d is empty", i+1)
+		}
+
+		step := upgradePathStep{Version: entry}
+		if name, version, ok := strings.Cut(entry, "="); ok {
+			step.Name = strings.TrimSpace(name)
+			step.Version = strings.TrimSpace(version)
+			if step.Name == "" || step.Version == "" {
+				return nil, fmt.Errorf("invalid --path entry %q: expected name=version", entry)
+			}
+		} else {
+			step.Name = defaultUpgradeName(entry)
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// defaultUpgradeName derives an upgrade handler name from a version. For
+// branches only the last path element is used: "feat/gas-waiver" →
+// "gas-waiver-upgrade".
+func defaultUpgradeName(version string) string {
+	if i := strings.LastIndex(version, "/"); i >= 0 {
+		version = version[i+1:]
+	}
+	return version + "-upgrade"
+}
+
+// validateUpgradePathFlags rejects flags that select a single upgrade target
+// or resume a single upgrade, which do not apply to --path.
+func validateUpgradePathFlags() error {
+	conflicts := []struct {
+		flag string
+		set  bool
+	}{
+		{"--name", upgradeName != ""},
+		{"--image", upgradeImage != ""},
+		{"--version", upgradeVersion != ""},
+		{"--skip-gov", skipGovernance},
+		{"--dry-run", upgradeDryRun},
+		{"--resume", upgradeResume},
+		{"--resume-from", upgradeResumeFrom != ""},
+	}
+	for _, c := range conflicts {
+		if c.set {
+			return fmt.Errorf("%s cannot be used with --path", c.flag)
+		}
+	}
+	return nil
+}
+
+// runUpgradePath executes a chain of governance upgrades in order. Every
+// target is resolved (and built, if needed) before the first proposal, and
+// the chain must keep producing blocks after each step before the next one
+// starts. The first failing step stops the chain.
+func runUpgradePath(
+	ctx context.Context,
+	homeDir string,
+	svc *application.DevnetService,
+	metadata *ports.DevnetMetadata,
+	networkModule network.NetworkModule,
+	mode UpgradeExecutionMode,
+	jsonMode bool,
+) error {
+	logger := output.DefaultLogger
+	start := time.Now()
+
+	if err := validateUpgradePathFlags(); err != nil {
+		return err
+	}
+	steps, err := parseUpgradePath(upgradePath)
+	if err != nil {
+		return err
+	}
+
+	// Resolve every target up front so a bad version fails before the
+	// chain has been upgraded part way.
+	for i := range steps {
+		step := &steps[i]
+		if mode == UpgradeModeDocker && isStandardVersionTag(step.Version) {
+			step.image = fmt.Sprintf("%s:%s", networkModule.DockerImage(), step.Version)
+			logger.Info("Step %d/%d: using docker image %s", i+1, len(steps), step.image)
+			continue
+		}
+		buildResult, err := buildBinaryForUpgrade(ctx, metadata.BlockchainNetwork, step.Version, metadata.NetworkName, homeDir, logger)
+		if err != nil {
+			return fmt.Errorf("failed to pre-build binary for step %d/%d (%s): %w", i+1, len(steps), step.Version, err)
+		}
+		step.cached = buildResult
+		logger.Success("Step %d/%d: binary for %s pre-built and cached", i+1, len(steps), step.Version)
+	}
+
+	vp, _, err := resolveVotingPeriod(ctx, homeDir, networkModule, metadata.NetworkName, logger)
+	if err != nil {
+		return err
+	}
+
+	// A saved single upgrade must be finished or cleared first.
+	if _, err := checkForExistingUpgradeState(ctx, homeDir, logger, jsonMode); err != nil {
+		if jsonMode {
+			return outputUpgradeError(err)
+		}
+		return err
+	}
+
+	if !jsonMode {
+		printUpgradePathPlan(steps, string(mode), vp, metadata)
+	}
+
+	var results []UpgradeResultJSON
+	for i, step := range steps {
+		if !jsonMode {
+			fmt.Println()
+			output.Bold("Upgrade %d/%d: %s (%s)", i+1, len(steps), step.Name, step.Version)
+			fmt.Println("─────────────────────────────────────────────────────────")
+		}
+
+		result, err := executeUpgradePathStep(ctx, homeDir, networkModule, mode, step, vp)
+		if err == nil && result.Error != nil {
+			err = result.Error
+		}
+		if err == nil {
+			err = verifyUpgradePathStep(ctx, homeDir, networkModule, result.PostUpgradeHeight)
+		}
+		if err != nil {
+			err = fmt.Errorf("upgrade %d/%d (%s) failed: %w", i+1, len(steps), step.Name, err)
+			if jsonMode {
+				return outputUpgradePathJSON(results, i+1, err, time.Since(start))
+			}
+			if i+1 < len(steps) {
+				logger.Info("After fixing the devnet, continue with --path %s", remainingUpgradePath(steps[i+1:]))
+			}
+			return err
+		}
+
+		// Record the new version so the next step loads keys and binaries
+		// for the version actually running.
+		metadata.CurrentVersion = step.Version
+		metadata.ExecutionMode = types.ExecutionMode(mode)
+		if err := svc.SaveMetadata(ctx, metadata); err != nil {
+			logger.Warn("Failed to update metadata: %v", err)
+		}
+
+		results = append(results, UpgradeResultJSON{
+			Status:            "success",
+			UpgradeName:       step.Name,
+			ProposalID:        result.ProposalID,
+			UpgradeHeight:     result.UpgradeHeight,
+			PostUpgradeHeight: result.PostUpgradeHeight,
+			NewBinary:         result.NewBinary,
+			Duration:          result.Duration.String(),
+			PreGenesisPath:    result.PreGenesisPath,
+			PostGenesisPath:   result.PostGenesisPath,
+		})
+		if !jsonMode {
+			logger.Success("Upgrade %d/%d complete: %s at height %d", i+1, len(steps), step.Name, result.UpgradeHeight)
+		}
+	}
+
+	if jsonMode {
+		return outputUpgradePathJSON(results, 0, nil, time.Since(start))
+	}
+	printUpgradePathSummary(results, time.Since(start))
+	return nil
+}
+
+// executeUpgradePathStep runs one upgrade of a path with a fresh container.
+func executeUpgradePathStep(
+	ctx context.Context,
+	homeDir string,
+	networkModule network.NetworkModule,
+	mode UpgradeExecutionMode,
+	step upgradePathStep,
+	vp time.Duration,
+) (*dto.ExecuteUpgradeOutput, error) {
+	factory := di.NewInfrastructureFactory(homeDir, output.DefaultLogger).
+		WithNetworkModule(networkModule).
+		WithDockerMode(mode == UpgradeModeDocker)
+
+	container, err := factory.WireContainer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize: %w", err)
+	}
+
+	input := dto.ExecuteUpgradeInput{
+		HomeDir:       homeDir,
+		UpgradeName:   step.Name,
+		TargetImage:   step.image,
+		TargetVersion: step.Version,
+		VotingPeriod:  vp,
+		HeightBuffer:  heightBuffer,
+		WithExport:    withExport,
+		GenesisDir:    genesisDir,
+		Mode:          types.ExecutionMode(mode),
+	}
+	if step.cached != nil {
+		input.CachePath = step.cached.BinaryPath
+		input.CommitHash = step.cached.CommitHash
+		input.CacheRef = step.cached.CacheRef
+	}
+
+	return container.ResumableExecuteUpgradeUseCase().Execute(ctx, input, nil)
+}
+
+// verifyUpgradePathStep waits until the chain has produced
+// upgradePathVerifyBlocks blocks past the post-upgrade height, so the next
+// proposal is submitted to a chain that is making progress.
+func verifyUpgradePathStep(ctx context.Context, homeDir string, networkModule network.NetworkModule, postUpgradeHeight int64) error {
+	rpcClient := di.NewInfrastructureFactory(homeDir, output.DefaultLogger).
+		WithNetworkModule(networkModule).
+		CreateRPCClient("localhost", 26657)
+
+	target := postUpgradeHeight + upgradePathVerifyBlocks
+	if err := rpcClient.WaitForBlock(ctx, target); err != nil {
+		return fmt.Errorf("chain did not reach height %d after upgrade: %w", target, err)
+	}
+	return nil
+}
+
+// remainingUpgradePath formats steps back into an --path value.
+func remainingUpgradePath(steps []upgradePathStep) string {
+	entries := make([]string, len(steps))
+	for i, step := range steps {
+		entries[i] = step.Name + "=" + step.Version
+	}
+	return strings.Join(entries, ",")
+}
+
+func printUpgradePathPlan(steps []upgradePathStep, mode string, votingPeriod time.Duration, metadata *ports.DevnetMetadata) {
+	output.Bold("Upgrade Path Plan")
+	fmt.Println("─────────────────────────────────────────────────────────")
+	fmt.Printf("ExecutionMode:    %s\n", mode)
+	fmt.Printf("Current Version:  %s\n", metadata.CurrentVersion)
+	fmt.Printf("Voting Period:    %s\n", votingPeriod)
+	fmt.Printf("Validators:       %d\n", metadata.NumValidators)
+	if withExport {
+		fmt.Printf("Genesis Export:   before and after each upgrade\n")
+	}
+	fmt.Println()
+	for i, step := range steps {
+		target := step.image
+		if step.cached != nil {
+			target = step.cached.BinaryPath + " (cached)"
+		}
+		fmt.Printf("  %d. %-24s %s\n", i+1, step.Name, target)
+	}
+	fmt.Println()
+}
+
+func printUpgradePathSummary(results []UpgradeResultJSON, elapsed time.Duration) {
+	fmt.Println()
+	output.Success("Upgrade path completed successfully!")
+	fmt.Println()
+	output.Bold("Upgrade Path Summary")
+	fmt.Println("─────────────────────────────────────────────────────────")
+	for i, r := range results {
+		fmt.Printf("  %d. %-24s %s  height %d → %d, proposal %d\n",
+			i+1, r.UpgradeName, color.GreenString("SUCCESS"), r.UpgradeHeight, r.PostUpgradeHeight, r.ProposalID)
+		if r.PreGenesisPath != "" {
+			fmt.Printf("     Pre-Upgrade:  %s\n", r.PreGenesisPath)
+		}
+		if r.PostGenesisPath != "" {
+			fmt.Printf("     Post-Upgrade: %s\n", r.PostGenesisPath)
+		}
+	}
+	fmt.Printf("  Total Duration:   %s\n", elapsed.Round(time.Second))
+	fmt.Println("─────────────────────────────────────────────────────────")
+	fmt.Println()
+	output.Info("Use 'devnet-builder status' to verify chain health")
+	fmt.Println()
+}
+
+func outputUpgradePathJSON(results []UpgradeResultJSON, failedStep int, stepErr error, elapsed time.Duration) error {
+	jsonResult := UpgradePathResultJSON{
+		Status:     "success",
+		Steps:      results,
+		FailedStep: failedStep,
+		Duration:   elapsed.String(),
+	}
+	if jsonResult.Steps == nil {
+		jsonResult.Steps = []UpgradeResultJSON{}
+	}
+	if stepErr != nil {
+		jsonResult.Status = "failed"
+		jsonResult.Error = stepErr.Error()
+	}
+
+	data, err := json.MarshalIndent(jsonResult, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(data))
+	return stepErr
+}
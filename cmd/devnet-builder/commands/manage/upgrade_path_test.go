@@ -0,0 +1,76 @@
+package manage
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseUpgradePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []upgradePathStep
+		wantErr bool
+	}{
+		{
+			name:  "versions",
+			input: "v1.2.0,v1.3.0,v2.0.0",
+			want: []upgradePathStep{
+				{Name: "v1.2.0-upgrade", Version: "v1.2.0"},
+				{Name: "v1.3.0-upgrade", Version: "v1.3.0"},
+				{Name: "v2.0.0-upgrade", Version: "v2.0.0"},
+			},
+		},
+		{
+			name:  "named entries and spaces",
+			input: "v1.3=v1.3.0, v2 = v2.0.0",
+			want: []upgradePathStep{
+				{Name: "v1.3", Version: "v1.3.0"},
+				{Name: "v2", Version: "v2.0.0"},
+			},
+		},
+		{
+			name:  "branch",
+			input: "feat/gas-waiver",
+			want:  []upgradePathStep{{Name: "gas-waiver-upgrade", Version: "feat/gas-waiver"}},
+		},
+		{name: "empty entry", input: "v1.2.0,,v2.0.0", wantErr: true},
+		{name: "trailing comma", input: "v1.2.0,", wantErr: true},
+		{name: "missing version", input: "v2=", wantErr: true},
+		{name: "missing name", input: "=v2.0.0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseUpgradePath(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseUpgradePath(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseUpgradePath(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemainingUpgradePath(t *testing.T) {
+	steps, err := parseUpgradePath("v1.3.0,v2=v2.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := remainingUpgradePath(steps)
+	want := "v1.3.0-upgrade=v1.3.0,v2=v2.0.0"
+	if got != want {
+		t.Errorf("remainingUpgradePath() = %q, want %q", got, want)
+	}
+
+	// The formatted path parses back to the same steps.
+	again, err := parseUpgradePath(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(again, steps) {
+		t.Errorf("round trip = %+v, want %+v", again, steps)
+	}
+}
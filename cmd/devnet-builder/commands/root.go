@@ -34,6 +34,7 @@ var (
 	noColor    bool
 	verbose    bool
 	configPath string
+	logFormat  string
 )
 
 // DefaultHomeDir returns the default home directory for devnet data.
@@ -84,6 +85,8 @@ Examples:
 		"Enable verbose logging")
 	cmd.PersistentFlags().StringVar(&configPath, "config", "",
 		"Path to config.toml file")
+	cmd.PersistentFlags().StringVar(&logFormat, "log-format", "text",
+		"Output format for messages and progress: text or json (JSON events on stderr, for CI)")
 
 	// Define command groups for organized help output
 	cmd.AddGroup(&cobra.Group{ID: GroupMain, Title: "Main Commands:"})
@@ -140,6 +143,20 @@ func persistentPreRunE(cmd *cobra.Command, args []string) error {
 	output.DefaultLogger.SetVerbose(verbose)
 	output.DefaultLogger.SetJSONMode(jsonMode)
 
+	// JSON log format turns messages into events on stderr; the final result
+	// event (see main) carries any error, so cobra must not print it too.
+	format, err := output.ParseLogFormat(logFormat)
+	if err != nil {
+		return err
+	}
+	if format == output.LogFormatJSON {
+		if err := output.EnableJSONEvents(output.NewEventWriter(os.Stderr, cmd.CommandPath())); err != nil {
+			return err
+		}
+		cmd.Root().SilenceErrors = true
+		cmd.Root().SilenceUsage = true
+	}
+
 	types.SetHomeDir(homeDir)
 
 	// Store context
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/fatih/color"
 
 	"github.com/altuslabsxyz/devnet-builder/cmd/devnet-builder/commands"
 	"github.com/altuslabsxyz/devnet-builder/internal"
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
 	"github.com/altuslabsxyz/devnet-builder/internal/di"
 	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
 	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/version/migrations"
@@ -85,14 +87,25 @@ func main() {
 		os.Exit(1)
 	}
 
+	start := time.Now()
 	err := rootCmd.Execute()
 
 	// Always close plugins before exit (os.Exit skips defers)
 	globalLoader.Close()
 
+	// In JSON log format, the result event replaces the error message
+	if events := output.JSONEvents(); events != nil {
+		output.CloseJSONEvents()
+		events.EmitResult(err, time.Since(start))
+		if err != nil {
+			os.Exit(apierror.ExitCode(err))
+		}
+		return
+	}
+
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		os.Exit(apierror.ExitCode(err))
 	}
 }
 
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	binaryapp "github.com/altuslabsxyz/devnet-builder/internal/application/binary"
 	"github.com/altuslabsxyz/devnet-builder/internal/di"
 	"github.com/altuslabsxyz/devnet-builder/internal/output"
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
 	"github.com/altuslabsxyz/devnet-builder/types/ctxconfig"
 	"github.com/spf13/cobra"
 )
@@ -18,22 +20,36 @@ import (
 //
 // The command name is the binary name (e.g., "stabled", "gaiad").
 // All arguments after the command name are passed directly to the binary.
+// Subcommands the plugin declares for passthrough also get the devnet's
+// --home, --node and --chain-id unless the user passes them.
 //
 // Example:
 //
 //	devnet-builder stabled status
-//	-> Executes: <active-binary-path> status
+//	-> Executes: <active-binary-path> status --node tcp://localhost:26657
 //
-//	devnet-builder stabled tx bank send ...
-//	-> Executes: <active-binary-path> tx bank send ...
-func createBinaryPassthroughCommand(binaryName, pluginName string) *cobra.Command {
+//	devnet-builder stabled q bank balances <addr>
+//	-> Executes: <active-binary-path> q bank balances <addr> --home <node0 home> --node tcp://localhost:26657
+func createBinaryPassthroughCommand(entry network.PassthroughEntry) *cobra.Command {
+	var long strings.Builder
+	fmt.Fprintf(&long, "Pass through commands to the active %s binary for plugin %q.\n\n", entry.Binary, entry.Plugin)
+	long.WriteString("These subcommands run against node0 of the devnet, with flags filled in\nunless you pass them:\n")
+	for _, c := range entry.Commands {
+		name := strings.Join(append([]string{c.Name}, c.Aliases...), ", ")
+		flags := make([]string, len(c.Flags))
+		for i, f := range c.Flags {
+			flags[i] = "--" + string(f)
+		}
+		fmt.Fprintf(&long, "  %-28s %s\n", name, strings.Join(flags, " "))
+	}
+
 	cmd := &cobra.Command{
-		Use:                binaryName,
-		Short:              fmt.Sprintf("Execute %s binary commands", binaryName),
-		Long:               fmt.Sprintf("Pass through commands to the active %s binary for plugin %q", binaryName, pluginName),
+		Use:                entry.Binary,
+		Short:              fmt.Sprintf("Execute %s binary commands", entry.Binary),
+		Long:               long.String(),
 		DisableFlagParsing: true, // Pass all flags to the binary
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeBinaryPassthrough(cmd, pluginName, args)
+			return executeBinaryPassthrough(cmd, entry, args)
 		},
 	}
 
@@ -41,14 +57,14 @@ func createBinaryPassthroughCommand(binaryName, pluginName string) *cobra.Comman
 }
 
 // executeBinaryPassthrough executes a binary passthrough command.
-func executeBinaryPassthrough(cmd *cobra.Command, pluginName string, args []string) error {
+func executeBinaryPassthrough(cmd *cobra.Command, entry network.PassthroughEntry, args []string) error {
 	ctx := cmd.Context()
 	cfg := ctxconfig.FromContext(ctx)
 
 	// Initialize container lazily
 	container, err := InitContainer(AppConfig{
 		HomeDir:           cfg.HomeDir(),
-		BlockchainNetwork: pluginName,
+		BlockchainNetwork: entry.Plugin,
 		ExecutionMode:     "local",
 		Verbose:           cfg.Verbose(),
 		NoColor:           cfg.NoColor(),
@@ -58,12 +74,19 @@ func executeBinaryPassthrough(cmd *cobra.Command, pluginName string, args []stri
 		return fmt.Errorf("failed to initialize: %w", err)
 	}
 
+	// Point declared subcommands at the devnet
+	if len(args) > 0 {
+		if c, ok := entry.Command(args[0]); ok {
+			args = c.InjectFlags(args, passthroughTarget(ctx, container, cfg.HomeDir()))
+		}
+	}
+
 	// Get the passthrough use case from container
 	passthroughUC := container.PassthroughUseCase()
 
 	// Prepare execute request
 	req := binaryapp.ExecuteRequest{
-		PluginName:  pluginName,
+		PluginName:  entry.Plugin,
 		Args:        args,
 		WorkDir:     "",   // Use current directory
 		Interactive: true, // Enable TTY for interactive commands
@@ -83,13 +106,37 @@ func executeBinaryPassthrough(cmd *cobra.Command, pluginName string, args []stri
 	return nil
 }
 
+// passthroughTarget returns node0 of the devnet at homeDir as the target of
+// passthrough commands, or an empty target if there is no devnet.
+func passthroughTarget(ctx context.Context, container *di.Container, homeDir string) network.PassthroughTarget {
+	if !container.DevnetRepository().Exists(homeDir) {
+		return network.PassthroughTarget{}
+	}
+	node, err := container.NodeRepository().Load(ctx, homeDir, 0)
+	if err != nil {
+		output.DefaultLogger.Debug("Failed to load node0 for binary passthrough: %v", err)
+		return network.PassthroughTarget{}
+	}
+
+	target := network.PassthroughTarget{
+		Home:    node.HomeDir,
+		ChainID: node.ChainID,
+	}
+	if node.Ports.RPC > 0 {
+		target.Node = fmt.Sprintf("tcp://localhost:%d", node.Ports.RPC)
+	}
+	return target
+}
+
 // enhanceRootWithBinaryPassthrough adds dynamic binary passthrough commands to the root command.
-// This discovers available plugins, gets their binary names, and creates passthrough commands.
+// This discovers available plugins, registers their binaries and passthrough
+// subcommands, and creates a command for each binary.
 //
 // Flow:
 //  1. Discover available plugins
-//  2. For each plugin, get its binary name
-//  3. Create a dynamic command for that binary
+//  2. Register each plugin's binary in a network.PassthroughRegistry; plugins
+//     that do not declare passthrough commands get the Cosmos SDK defaults
+//  3. Create a dynamic command for each binary
 //  4. Add to root command
 //
 // This enables commands like:
@@ -112,32 +159,30 @@ func enhanceRootWithBinaryPassthrough(rootCmd *cobra.Command, _ *di.Container) e
 		return nil
 	}
 
-	// Create passthrough commands for each plugin
+	// Register each plugin's binary
+	registry := network.NewPassthroughRegistry()
 	for _, pluginName := range plugins {
-		// Load plugin to get binary name
 		pluginClient, err := loader.Load(pluginName)
 		if err != nil {
 			output.DefaultLogger.Debug("Failed to load plugin %q: %v", pluginName, err)
 			continue
 		}
-
-		binaryName := pluginClient.Module().BinaryName()
-		if binaryName == "" {
-			output.DefaultLogger.Debug("Plugin %q does not specify a binary name", pluginName)
-			continue
+		if err := registry.Register(pluginName, pluginClient.Module()); err != nil {
+			output.DefaultLogger.Debug("Skipping binary passthrough for plugin %q: %v", pluginName, err)
 		}
+	}
 
+	// Create passthrough commands for each binary
+	for _, entry := range registry.Entries() {
 		// Check if command already exists (avoid conflicts)
-		if hasCommand(rootCmd, binaryName) {
-			output.DefaultLogger.Debug("Skipping binary passthrough for %q - command already exists", binaryName)
+		if hasCommand(rootCmd, entry.Binary) {
+			output.DefaultLogger.Debug("Skipping binary passthrough for %q - command already exists", entry.Binary)
 			continue
 		}
 
-		// Create and add the passthrough command
-		passthroughCmd := createBinaryPassthroughCommand(binaryName, pluginName)
-		rootCmd.AddCommand(passthroughCmd)
+		rootCmd.AddCommand(createBinaryPassthroughCommand(entry))
 
-		output.DefaultLogger.Debug("Added binary passthrough command: %s (plugin: %s)", binaryName, pluginName)
+		output.DefaultLogger.Debug("Added binary passthrough command: %s (plugin: %s)", entry.Binary, entry.Plugin)
 	}
 
 	return nil
@@ -63,7 +63,8 @@ func generateDefaultConfigTOML() string {
 # Environment variables use DEVNETD_ prefix (e.g., DEVNETD_LOG_LEVEL)
 
 [server]
-# Unix socket path for gRPC communication
+# gRPC socket: a Unix socket path, a Windows named pipe (\\.\pipe\name),
+# or a loopback TCP address (tcp://127.0.0.1:port)
 socket = %q
 
 # Data directory for state, logs, and plugins
@@ -78,6 +79,21 @@ workers = %d
 # Run in foreground (vs daemonize)
 foreground = %v
 
+# Provision only from local caches (binaries, snapshots, genesis files,
+# docker images), failing fast if anything is missing. For air-gapped CI.
+offline = %v
+
+# Fail plugin RPC calls whose response carries an error message, instead of
+# leaving callers to check it. Helps catch broken plugins.
+strict_plugin_responses = %v
+
+# Events kept per devnet for 'dvb events' (older events are dropped)
+event_retention = %d
+
+# Stop running devnets' nodes (full nodes first, then validators) when the
+# daemon shuts down, instead of leaving them running to reconnect to
+stop_nodes_on_shutdown = %v
+
 [docker]
 # Enable Docker container runtime for nodes
 enabled = %v
@@ -85,9 +101,22 @@ enabled = %v
 # Default Docker image for nodes
 image = %q
 
+# Pull images through a registry mirror: images under "from" are pulled
+# from "to" instead, e.g. ghcr.io/org/chain:v1 from mirror.corp/ghcr/org/chain:v1
+# [[docker.mirrors]]
+# from = "ghcr.io"
+# to = "mirror.corp/ghcr"
+
+# Credentials of private registries and mirrors
+# [[docker.registries]]
+# host = "mirror.corp"
+# username = "ci"
+# password = "..."
+
 [github]
 # GitHub API token for higher rate limits and private repos
-# Can also be set via DEVNETD_GITHUB_TOKEN environment variable
+# Can also be set via DEVNETD_GITHUB_TOKEN environment variable; prefer
+# 'dvb auth github login', which keeps the token in the OS keychain
 token = ""
 
 [timeouts]
@@ -110,6 +139,21 @@ max_retries = %d
 # Delay between retries
 retry_delay = %q
 
+# HTTP address to share the snapshot cache on, e.g. "0.0.0.0:8090" (empty = disabled).
+# Teammates can set a devnet's snapshot URL to
+# http://<host>:8090/snapshots/<cache-key>/<file>; GET /snapshots lists the cache.
+serve_listen = %q
+
+# Credentials for s3:// and gs:// snapshot URLs. Each is exported as the
+# matching environment variable (AWS_REGION, AWS_ENDPOINT_URL_S3,
+# AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, GOOGLE_OAUTH_ACCESS_TOKEN) unless
+# it is already set. Without credentials, only public objects can be fetched.
+# s3_region = "us-east-1"
+# s3_endpoint = "http://minio.internal:9000"  # S3-compatible storage, path-style
+# s3_access_key_id = ""
+# s3_secret_access_key = ""
+# gcs_access_token = ""                       # gcloud auth print-access-token
+
 [network]
 # Port spacing between nodes (node 0: base, node 1: base + offset, etc.)
 port_offset = %d
@@ -119,12 +163,97 @@ base_rpc_port = %d   # CometBFT RPC
 base_p2p_port = %d   # P2P communication
 base_rest_port = %d  # REST API
 base_grpc_port = %d  # gRPC API
+
+# Docker runtime: when a node's host ports are already in use, "reallocate"
+# moves it to the next free block of ports (recorded in devnetd.db in the
+# data directory); "fail" fails the node, naming the process that owns the port.
+port_conflict = %q
+
+[api]
+# Enable gRPC server reflection (e.g., for grpcurl)
+reflection = %v
+
+# HTTP address of the REST/JSON gateway, e.g. "127.0.0.1:8080" (empty = disabled).
+# Serves POST /v1/<Service>/<Method> and GET /openapi.json. A non-loopback
+# address requires tls_cert and tls_key; remote calls need an API key when
+# authentication is enabled.
+gateway_listen = %q
+
+# Retry policies of provisioning steps that fail on transient errors. A step
+# is attempted up to max_attempts times, waiting initial_backoff after the
+# first failure and multiplying the wait by multiplier after each further
+# one, up to max_backoff.
+[retry.build]        # building or downloading the chain binary
+max_attempts = %d
+initial_backoff = %q
+max_backoff = %q
+multiplier = %.1f
+
+[retry.snapshot]     # forking genesis, including snapshot downloads
+max_attempts = %d
+initial_backoff = %q
+max_backoff = %q
+multiplier = %.1f
+
+[retry.node_start]   # starting a node process or container
+max_attempts = %d
+initial_backoff = %q
+max_backoff = %q
+multiplier = %.1f
+
+# Webhooks notified of devnet events: phase changes (devnet.running,
+# devnet.degraded, ...), node.crashed, upgrade.completed/failed and
+# devnet.ttl_expiring, sent ttl_warning before a TTL expires ("0s" = never).
+[notifications]
+ttl_warning = %q
+
+[notifications.retry]  # failed deliveries
+max_attempts = %d
+initial_backoff = %q
+max_backoff = %q
+multiplier = %.1f
+
+# [[notifications.webhooks]]
+# url = "https://hooks.slack.com/services/..."
+# format = "slack"                       # slack, discord or generic
+# events = ["devnet.*", "node.crashed"]  # empty = all events
+# template = "{{.Devnet}}: {{.Message}}" # Go text/template for the message
+
+# Prune the nodes of running devnets older than min_age every interval,
+# one node at a time, with the chain binary's prune command ("0s" = never)
+[pruning]
+interval = %q
+min_age = %q
+strategy = %q  # everything, default, ...
+
+# Rotation of process and service runtime node logs. Rotated files are
+# <node>.log.1, .2, ... (newest first), gzipped when compress is set
+[node_logs]
+max_size_mb = %d
+max_age = %q    # rotate every period, e.g. each UTC day ("0s" = size only)
+max_files = %d
+retention = %q  # remove rotated files older than this ("0s" = keep max_files)
+compress = %v
+
+# Serve every node's RPC, REST, EVM JSON-RPC and gRPC endpoints behind one
+# address, e.g. http://127.0.0.1:8080/my-devnet/node0/rpc/status
+# (empty = disabled). Basic auth and tls_cert/tls_key are required unless
+# listen is loopback; with a certificate the proxy serves HTTPS.
+[proxy]
+listen = %q
+# username = "ci"
+# password = "..."  # or DEVNETD_PROXY_PASSWORD
+log_requests = %v
 `,
 		cfg.Server.Socket,
 		cfg.Server.DataDir,
 		cfg.Server.LogLevel,
 		cfg.Server.Workers,
 		cfg.Server.Foreground,
+		cfg.Server.Offline,
+		cfg.Server.StrictPluginResponses,
+		cfg.Server.EventRetention,
+		cfg.Server.StopNodesOnShutdown,
 		cfg.Docker.Enabled,
 		cfg.Docker.Image,
 		cfg.Timeouts.Shutdown,
@@ -133,10 +262,41 @@ base_grpc_port = %d  # gRPC API
 		cfg.Snapshot.CacheTTL,
 		cfg.Snapshot.MaxRetries,
 		cfg.Snapshot.RetryDelay,
+		cfg.Snapshot.ServeListen,
 		cfg.Network.PortOffset,
 		cfg.Network.BaseRPCPort,
 		cfg.Network.BaseP2PPort,
 		cfg.Network.BaseRESTPort,
 		cfg.Network.BaseGRPCPort,
+		cfg.Network.PortConflict,
+		cfg.API.Reflection,
+		cfg.API.GatewayListen,
+		cfg.Retry.Build.MaxAttempts,
+		cfg.Retry.Build.InitialBackoff,
+		cfg.Retry.Build.MaxBackoff,
+		cfg.Retry.Build.Multiplier,
+		cfg.Retry.Snapshot.MaxAttempts,
+		cfg.Retry.Snapshot.InitialBackoff,
+		cfg.Retry.Snapshot.MaxBackoff,
+		cfg.Retry.Snapshot.Multiplier,
+		cfg.Retry.NodeStart.MaxAttempts,
+		cfg.Retry.NodeStart.InitialBackoff,
+		cfg.Retry.NodeStart.MaxBackoff,
+		cfg.Retry.NodeStart.Multiplier,
+		cfg.Notifications.TTLWarning,
+		cfg.Notifications.Retry.MaxAttempts,
+		cfg.Notifications.Retry.InitialBackoff,
+		cfg.Notifications.Retry.MaxBackoff,
+		cfg.Notifications.Retry.Multiplier,
+		cfg.Pruning.Interval,
+		cfg.Pruning.MinAge,
+		cfg.Pruning.Strategy,
+		cfg.NodeLogs.MaxSizeMB,
+		cfg.NodeLogs.MaxAge,
+		cfg.NodeLogs.MaxFiles,
+		cfg.NodeLogs.Retention,
+		cfg.NodeLogs.Compress,
+		cfg.Proxy.Listen,
+		cfg.Proxy.LogRequests,
 	)
 }
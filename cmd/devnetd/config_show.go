@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/config"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/notify"
 	"github.com/spf13/cobra"
 )
 
@@ -31,21 +32,30 @@ func newConfigShowCmd() *cobra.Command {
 			fmt.Printf("  log_level   = %q\n", cfg.Server.LogLevel)
 			fmt.Printf("  workers     = %d\n", cfg.Server.Workers)
 			fmt.Printf("  foreground  = %v\n", cfg.Server.Foreground)
+			fmt.Printf("  offline     = %v\n", cfg.Server.Offline)
+			fmt.Printf("  strict_plugin_responses = %v\n", cfg.Server.StrictPluginResponses)
+			fmt.Printf("  event_retention = %d\n", cfg.Server.EventRetention)
+			fmt.Printf("  stop_nodes_on_shutdown = %v\n", cfg.Server.StopNodesOnShutdown)
 			fmt.Println()
 			fmt.Println("[docker]")
 			fmt.Printf("  enabled     = %v\n", cfg.Docker.Enabled)
 			fmt.Printf("  image       = %q\n", cfg.Docker.Image)
+			for _, m := range cfg.Docker.Mirrors {
+				fmt.Println()
+				fmt.Println("[[docker.mirrors]]")
+				fmt.Printf("  from = %q\n", m.From)
+				fmt.Printf("  to   = %q\n", m.To)
+			}
+			for _, r := range cfg.Docker.Registries {
+				fmt.Println()
+				fmt.Println("[[docker.registries]]")
+				fmt.Printf("  host     = %q\n", r.Host)
+				fmt.Printf("  username = %q\n", r.Username)
+				fmt.Printf("  password = %q\n", maskSecret(r.Password))
+			}
 			fmt.Println()
 			fmt.Println("[github]")
-			token := cfg.GitHub.Token
-			if token != "" {
-				if len(token) > 4 {
-					token = token[:4] + "****"
-				} else {
-					token = "****"
-				}
-			}
-			fmt.Printf("  token       = %q\n", token)
+			fmt.Printf("  token       = %q\n", maskSecret(cfg.GitHub.Token))
 			fmt.Println()
 			fmt.Println("[timeouts]")
 			fmt.Printf("  shutdown          = %s\n", cfg.Timeouts.Shutdown)
@@ -56,6 +66,12 @@ func newConfigShowCmd() *cobra.Command {
 			fmt.Printf("  cache_ttl    = %s\n", cfg.Snapshot.CacheTTL)
 			fmt.Printf("  max_retries  = %d\n", cfg.Snapshot.MaxRetries)
 			fmt.Printf("  retry_delay  = %s\n", cfg.Snapshot.RetryDelay)
+			fmt.Printf("  serve_listen = %q\n", cfg.Snapshot.ServeListen)
+			fmt.Printf("  s3_region            = %q\n", cfg.Snapshot.S3Region)
+			fmt.Printf("  s3_endpoint          = %q\n", cfg.Snapshot.S3Endpoint)
+			fmt.Printf("  s3_access_key_id     = %q\n", cfg.Snapshot.S3AccessKeyID)
+			fmt.Printf("  s3_secret_access_key = %q\n", maskSecret(cfg.Snapshot.S3SecretAccessKey))
+			fmt.Printf("  gcs_access_token     = %q\n", maskSecret(cfg.Snapshot.GCSAccessToken))
 			fmt.Println()
 			fmt.Println("[network]")
 			fmt.Printf("  port_offset    = %d\n", cfg.Network.PortOffset)
@@ -63,6 +79,61 @@ func newConfigShowCmd() *cobra.Command {
 			fmt.Printf("  base_p2p_port  = %d\n", cfg.Network.BaseP2PPort)
 			fmt.Printf("  base_rest_port = %d\n", cfg.Network.BaseRESTPort)
 			fmt.Printf("  base_grpc_port = %d\n", cfg.Network.BaseGRPCPort)
+			fmt.Printf("  port_conflict  = %q\n", cfg.Network.PortConflict)
+			fmt.Println()
+			fmt.Println("[api]")
+			fmt.Printf("  reflection     = %v\n", cfg.API.Reflection)
+			fmt.Printf("  gateway_listen = %q\n", cfg.API.GatewayListen)
+			for _, p := range []struct {
+				name   string
+				policy config.RetryPolicyConfig
+			}{
+				{"build", cfg.Retry.Build},
+				{"snapshot", cfg.Retry.Snapshot},
+				{"node_start", cfg.Retry.NodeStart},
+			} {
+				fmt.Println()
+				fmt.Printf("[retry.%s]\n", p.name)
+				fmt.Printf("  max_attempts    = %d\n", p.policy.MaxAttempts)
+				fmt.Printf("  initial_backoff = %s\n", p.policy.InitialBackoff)
+				fmt.Printf("  max_backoff     = %s\n", p.policy.MaxBackoff)
+				fmt.Printf("  multiplier      = %v\n", p.policy.Multiplier)
+			}
+			fmt.Println()
+			fmt.Println("[notifications]")
+			fmt.Printf("  ttl_warning = %s\n", cfg.Notifications.TTLWarning)
+			fmt.Println()
+			fmt.Println("[notifications.retry]")
+			fmt.Printf("  max_attempts    = %d\n", cfg.Notifications.Retry.MaxAttempts)
+			fmt.Printf("  initial_backoff = %s\n", cfg.Notifications.Retry.InitialBackoff)
+			fmt.Printf("  max_backoff     = %s\n", cfg.Notifications.Retry.MaxBackoff)
+			fmt.Printf("  multiplier      = %v\n", cfg.Notifications.Retry.Multiplier)
+			for _, w := range cfg.Notifications.Webhooks {
+				fmt.Println()
+				fmt.Println("[[notifications.webhooks]]")
+				fmt.Printf("  url      = %q\n", notify.DisplayURL(w.URL))
+				fmt.Printf("  format   = %q\n", w.Format)
+				fmt.Printf("  events   = %q\n", w.Events)
+				fmt.Printf("  template = %q\n", w.Template)
+			}
+			fmt.Println()
+			fmt.Println("[pruning]")
+			fmt.Printf("  interval = %s\n", cfg.Pruning.Interval)
+			fmt.Printf("  min_age  = %s\n", cfg.Pruning.MinAge)
+			fmt.Printf("  strategy = %q\n", cfg.Pruning.Strategy)
+			fmt.Println()
+			fmt.Println("[node_logs]")
+			fmt.Printf("  max_size_mb = %d\n", cfg.NodeLogs.MaxSizeMB)
+			fmt.Printf("  max_age     = %s\n", cfg.NodeLogs.MaxAge)
+			fmt.Printf("  max_files   = %d\n", cfg.NodeLogs.MaxFiles)
+			fmt.Printf("  retention   = %s\n", cfg.NodeLogs.Retention)
+			fmt.Printf("  compress    = %v\n", cfg.NodeLogs.Compress)
+			fmt.Println()
+			fmt.Println("[proxy]")
+			fmt.Printf("  listen       = %q\n", cfg.Proxy.Listen)
+			fmt.Printf("  username     = %q\n", cfg.Proxy.Username)
+			fmt.Printf("  password     = %q\n", maskSecret(cfg.Proxy.Password))
+			fmt.Printf("  log_requests = %v\n", cfg.Proxy.LogRequests)
 
 			return nil
 		},
@@ -70,3 +141,14 @@ func newConfigShowCmd() *cobra.Command {
 
 	return cmd
 }
+
+// maskSecret hides all but the first four characters of a secret.
+func maskSecret(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	if len(secret) > 4 {
+		return secret[:4] + "****"
+	}
+	return "****"
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/config"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/server"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/snapshot"
 	"github.com/altuslabsxyz/devnet-builder/internal/version"
 	"github.com/spf13/cobra"
 )
@@ -26,6 +27,12 @@ var (
 	// Runtime flag
 	flagRuntimeMode string
 
+	// Offline flag
+	flagOffline bool
+
+	// Plugin flags
+	flagStrictPlugins bool
+
 	// Remote listener flags
 	flagListen  string
 	flagTLSCert string
@@ -46,7 +53,7 @@ func main() {
 	rootCmd.Flags().StringVar(&flagConfigPath, "config", "", "Config file path (default: ~/.devnet-builder/devnetd.toml)")
 
 	// Server flags
-	rootCmd.Flags().StringVar(&flagSocket, "socket", "", fmt.Sprintf("Unix socket path (default: %s)", defaults.Server.Socket))
+	rootCmd.Flags().StringVar(&flagSocket, "socket", "", fmt.Sprintf("Socket path, Windows named pipe, or tcp://127.0.0.1:port (default: %s)", defaults.Server.Socket))
 	rootCmd.Flags().StringVar(&flagDataDir, "data-dir", "", fmt.Sprintf("Data directory (default: %s)", defaults.Server.DataDir))
 	rootCmd.Flags().StringVar(&flagLogLevel, "log-level", "", fmt.Sprintf("Log level: debug, info, warn, error (default: %s)", defaults.Server.LogLevel))
 	rootCmd.Flags().IntVar(&flagWorkers, "workers", 0, fmt.Sprintf("Workers per controller (default: %d)", defaults.Server.Workers))
@@ -55,6 +62,12 @@ func main() {
 	// Runtime flag
 	rootCmd.Flags().StringVar(&flagRuntimeMode, "runtime", "", `Node runtime: "process" (default), "service" (launchd/systemd), "docker"`)
 
+	// Offline flag
+	rootCmd.Flags().BoolVar(&flagOffline, "offline", false, "Provision only from local caches (binaries, snapshots, genesis files, docker images)")
+
+	// Plugin flags
+	rootCmd.Flags().BoolVar(&flagStrictPlugins, "strict-plugins", false, "Fail plugin RPC calls whose response carries an error message")
+
 	// Docker flags
 	rootCmd.Flags().BoolVar(&flagDocker, "docker", false, "Enable Docker container runtime")
 	rootCmd.Flags().StringVar(&flagDockerImage, "docker-image", "", fmt.Sprintf("Default Docker image (default: %s)", defaults.Docker.Image))
@@ -68,6 +81,8 @@ func main() {
 	rootCmd.AddCommand(version.NewCmd("devnet-builder", "devnetd"))
 	rootCmd.AddCommand(newConfigCmd())
 	rootCmd.AddCommand(newKeysCmd())
+	rootCmd.AddCommand(newSnapshotCmd())
+	rootCmd.AddCommand(newServiceCmd())
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -98,29 +113,9 @@ func runDaemon(cmd *cobra.Command, args []string) error {
 	}
 
 	// Convert to server.Config
-	serverCfg := &server.Config{
-		SocketPath:         cfg.Server.Socket,
-		DataDir:            cfg.Server.DataDir,
-		Foreground:         cfg.Server.Foreground,
-		Workers:            cfg.Server.Workers,
-		LogLevel:           cfg.Server.LogLevel,
-		RuntimeMode:        cfg.Server.RuntimeMode,
-		EnableDocker:       cfg.Docker.Enabled,
-		DockerImage:        cfg.Docker.Image,
-		ShutdownTimeout:    cfg.Timeouts.Shutdown,
-		HealthCheckTimeout: cfg.Timeouts.HealthCheck,
-		GitHubToken:        cfg.GitHub.Token,
-		Listen:             cfg.Server.Listen,
-		TLSCert:            cfg.Server.TLSCert,
-		TLSKey:             cfg.Server.TLSKey,
-		AuthEnabled:        cfg.Auth.Enabled,
-		AuthKeysFile:       cfg.Auth.KeysFile,
-	}
-
-	// Set GitHub token in environment for github_factory.go to pick up
-	if cfg.GitHub.Token != "" {
-		os.Setenv("GITHUB_TOKEN", cfg.GitHub.Token)
-	}
+	serverCfg := server.ConfigFrom(cfg)
+
+	exportSnapshotCredentials(cfg)
 
 	srv, err := server.New(serverCfg)
 	if err != nil {
@@ -129,6 +124,24 @@ func runDaemon(cmd *cobra.Command, args []string) error {
 	return srv.Run(context.Background())
 }
 
+// exportSnapshotCredentials sets the environment variables the s3:// and
+// gs:// snapshot providers read from the [snapshot] config, leaving variables
+// already set in the environment alone.
+func exportSnapshotCredentials(cfg *config.Config) {
+	vars := map[string]string{
+		snapshot.EnvAWSRegion:          cfg.Snapshot.S3Region,
+		snapshot.EnvAWSEndpointURLS3:   cfg.Snapshot.S3Endpoint,
+		snapshot.EnvAWSAccessKeyID:     cfg.Snapshot.S3AccessKeyID,
+		snapshot.EnvAWSSecretAccessKey: cfg.Snapshot.S3SecretAccessKey,
+		snapshot.EnvGCSAccessToken:     cfg.Snapshot.GCSAccessToken,
+	}
+	for name, value := range vars {
+		if value != "" && os.Getenv(name) == "" {
+			os.Setenv(name, value)
+		}
+	}
+}
+
 // applyFlagOverrides applies CLI flags to config (highest priority).
 func applyFlagOverrides(cmd *cobra.Command, cfg *config.Config) {
 	if cmd.Flags().Changed("socket") {
@@ -149,6 +162,12 @@ func applyFlagOverrides(cmd *cobra.Command, cfg *config.Config) {
 	if cmd.Flags().Changed("runtime") {
 		cfg.Server.RuntimeMode = flagRuntimeMode
 	}
+	if cmd.Flags().Changed("offline") {
+		cfg.Server.Offline = flagOffline
+	}
+	if cmd.Flags().Changed("strict-plugins") {
+		cfg.Server.StrictPluginResponses = flagStrictPlugins
+	}
 	if cmd.Flags().Changed("docker") {
 		cfg.Docker.Enabled = flagDocker
 	}
@@ -0,0 +1,197 @@
+// cmd/devnetd/service.go
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/config"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/daemonsvc"
+	"github.com/spf13/cobra"
+)
+
+// serviceReadyTimeout is how long service install/start wait for the daemon
+// to accept connections.
+const serviceReadyTimeout = 15 * time.Second
+
+func newServiceCmd() *cobra.Command {
+	var (
+		dataDir    string
+		configPath string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "service",
+		Short: "Run devnetd as a background service",
+		Long: `Install and control devnetd as a per-user service, so it runs in the
+background and starts at login instead of in a terminal.
+
+On macOS this is a launchd agent (~/Library/LaunchAgents/com.altuslabs.devnet.devnetd.plist);
+on Linux a systemd user unit (~/.config/systemd/user/devnet-devnetd.service).
+Daemon logs are still written to <data-dir>/daemon.log; anything devnetd
+prints before logging starts goes to <data-dir>/devnetd.service.log.
+
+Examples:
+  # Install and start the service
+  devnetd service install
+
+  # Install with extra daemon flags
+  devnetd service install -- --docker --log-level debug
+
+  # Stop, start, and check the service
+  devnetd service stop
+  devnetd service start
+  devnetd service status`,
+	}
+
+	cmd.PersistentFlags().StringVar(&dataDir, "data-dir", "", fmt.Sprintf("Data directory (default: %s)", config.DefaultDataDir()))
+	cmd.PersistentFlags().StringVar(&configPath, "config", "", "Config file path passed to the daemon")
+
+	// newManager resolves the data directory and creates the service manager.
+	newManager := func() (*daemonsvc.Manager, string, error) {
+		dir := dataDir
+		if dir == "" {
+			dir = config.DefaultDataDir()
+		}
+		m, err := daemonsvc.New(dir)
+		return m, dir, err
+	}
+
+	// socketFor loads the daemon config to find the socket it will listen on.
+	socketFor := func(dir string) (string, error) {
+		cfg, err := config.NewLoader(dir, configPath).Load()
+		if err != nil {
+			return "", fmt.Errorf("failed to load config: %w", err)
+		}
+		return cfg.Server.Socket, nil
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "install [-- daemon flags]",
+			Short: "Install and start the devnetd service",
+			RunE: func(cmd *cobra.Command, args []string) error {
+				m, dir, err := newManager()
+				if err != nil {
+					return err
+				}
+				binary, err := os.Executable()
+				if err != nil {
+					return fmt.Errorf("failed to locate devnetd binary: %w", err)
+				}
+				if resolved, err := filepath.EvalSymlinks(binary); err == nil {
+					binary = resolved
+				}
+
+				daemonArgs := []string{"--data-dir", dir}
+				if configPath != "" {
+					abs, err := filepath.Abs(configPath)
+					if err != nil {
+						return fmt.Errorf("invalid --config path: %w", err)
+					}
+					daemonArgs = append(daemonArgs, "--config", abs)
+				}
+				daemonArgs = append(daemonArgs, args...)
+
+				if err := m.Install(cmd.Context(), binary, daemonArgs); err != nil {
+					return err
+				}
+				fmt.Printf("Installed %s\n", m.ID())
+				return startService(cmd, m, socketFor, dir)
+			},
+		},
+		&cobra.Command{
+			Use:   "uninstall",
+			Short: "Stop and remove the devnetd service",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				m, _, err := newManager()
+				if err != nil {
+					return err
+				}
+				if err := m.Uninstall(cmd.Context()); err != nil {
+					return err
+				}
+				fmt.Printf("Uninstalled %s\n", m.ID())
+				return nil
+			},
+		},
+		&cobra.Command{
+			Use:   "start",
+			Short: "Start the installed devnetd service",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				m, dir, err := newManager()
+				if err != nil {
+					return err
+				}
+				return startService(cmd, m, socketFor, dir)
+			},
+		},
+		&cobra.Command{
+			Use:   "stop",
+			Short: "Stop the devnetd service",
+			Long: `Stop the devnetd service. It stays installed and starts again at the next
+login; use "devnetd service uninstall" to remove it.`,
+			Args: cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				m, _, err := newManager()
+				if err != nil {
+					return err
+				}
+				if err := m.Stop(cmd.Context()); err != nil {
+					return err
+				}
+				fmt.Printf("Stopped %s\n", m.ID())
+				return nil
+			},
+		},
+		&cobra.Command{
+			Use:   "status",
+			Short: "Show whether the devnetd service is installed and running",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				m, _, err := newManager()
+				if err != nil {
+					return err
+				}
+				st, err := m.Status(cmd.Context())
+				if err != nil {
+					return err
+				}
+				fmt.Printf("Service:   %s\n", m.ID())
+				fmt.Printf("Installed: %v\n", st.Installed)
+				fmt.Printf("Running:   %v\n", st.Running)
+				if st.PID > 0 {
+					fmt.Printf("PID:       %d\n", st.PID)
+				}
+				fmt.Printf("Log:       %s\n", m.LogPath())
+				return nil
+			},
+		},
+	)
+
+	return cmd
+}
+
+// startService starts the service and waits for the daemon socket.
+func startService(cmd *cobra.Command, m *daemonsvc.Manager, socketFor func(string) (string, error), dataDir string) error {
+	if err := m.Start(cmd.Context()); err != nil {
+		if errors.Is(err, daemonsvc.ErrNotInstalled) {
+			return err
+		}
+		return fmt.Errorf("failed to start service: %w", err)
+	}
+	socket, err := socketFor(dataDir)
+	if err != nil {
+		return err
+	}
+	if err := daemonsvc.WaitReady(cmd.Context(), socket, serviceReadyTimeout); err != nil {
+		return fmt.Errorf("%w; check %s", err, m.LogPath())
+	}
+	fmt.Printf("devnetd is running (socket: %s)\n", socket)
+	return nil
+}
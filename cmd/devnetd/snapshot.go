@@ -0,0 +1,120 @@
+// cmd/devnetd/snapshot.go
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/config"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/snapshot"
+	"github.com/spf13/cobra"
+)
+
+func newSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Manage the snapshot cache",
+	}
+
+	cmd.AddCommand(newSnapshotServeCmd())
+
+	return cmd
+}
+
+func newSnapshotServeCmd() *cobra.Command {
+	var (
+		listen  string
+		dataDir string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Share the snapshot cache over HTTP",
+		Long: `Serve the local snapshot cache over HTTP so teammates can point a devnet's
+snapshot URL at it instead of downloading the snapshot again.
+
+Snapshot files are served with Range support, and GET /snapshots lists the
+cached snapshots as JSON. Expired snapshots are still served. The cache is
+served without authentication; only listen on networks you trust.
+
+To share the cache for as long as the daemon runs, set serve_listen in the
+[snapshot] section of devnetd.toml instead.
+
+Examples:
+  # Share the cache on port 8090
+  devnetd snapshot serve --listen 0.0.0.0:8090
+
+  # On a teammate's machine, list what is cached
+  curl http://alice-laptop:8090/snapshots`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, _, err := net.SplitHostPort(listen); err != nil {
+				return fmt.Errorf("invalid --listen address %q: %w", listen, err)
+			}
+
+			caches, err := snapshot.ListCaches(dataDir)
+			if err != nil {
+				return fmt.Errorf("failed to list snapshot cache: %w", err)
+			}
+			if len(caches) == 0 {
+				fmt.Printf("No cached snapshots in %s yet; serving them as they are downloaded.\n", dataDir)
+			} else {
+				fmt.Println("Sharing cached snapshots:")
+				for _, c := range caches {
+					fmt.Printf("  %-24s http://%s/snapshots/%s/%s (%s)\n",
+						c.CacheKey, listen, c.CacheKey, filepath.Base(c.FilePath), formatBytes(c.SizeBytes))
+				}
+			}
+
+			listener, err := net.Listen("tcp", listen)
+			if err != nil {
+				return fmt.Errorf("failed to listen on %s: %w", listen, err)
+			}
+			srv := &http.Server{
+				Handler:           snapshot.NewCacheHandler(dataDir),
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			go func() {
+				<-ctx.Done()
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				_ = srv.Shutdown(shutdownCtx)
+			}()
+
+			fmt.Printf("Listening on http://%s (Ctrl+C to stop)\n", listen)
+			if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&listen, "listen", "0.0.0.0:8090", "HTTP address to serve the cache on")
+	cmd.Flags().StringVar(&dataDir, "data-dir", config.DefaultDataDir(), "Data directory holding the snapshot cache")
+
+	return cmd
+}
+
+// formatBytes formats bytes as human-readable string.
+func formatBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(b)/float64(div), "KMGTPE"[exp])
+}
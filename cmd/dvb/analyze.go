@@ -0,0 +1,162 @@
+// cmd/dvb/analyze.go
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// valsetDiffOptions holds options for the analyze valset-diff command
+type valsetDiffOptions struct {
+	from      int64
+	to        int64
+	node      int
+	output    string
+	namespace string
+}
+
+func newAnalyzeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "analyze",
+		Short: "Analyze devnet chain state",
+		Long: `Analyze the chain state of a running devnet.
+
+Examples:
+  # Validator set changes between heights 100 and 250
+  dvb analyze valset-diff my-devnet --from 100 --to 250`,
+	}
+
+	cmd.AddCommand(
+		newAnalyzeValsetDiffCmd(),
+	)
+
+	return cmd
+}
+
+func newAnalyzeValsetDiffCmd() *cobra.Command {
+	opts := &valsetDiffOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "valset-diff [devnet]",
+		Short: "Diff the validator sets at two heights",
+		Long: `Show validators added to or removed from the active set, jailed or unjailed,
+and voting power changes between two heights.
+
+Useful after slashing tests, scale operations and upgrade rehearsals. The
+heights must still be available on the queried node: a pruning node only
+keeps recent state.
+
+Without --to, the set at --from is compared with the latest height.
+
+Examples:
+  # Changes since height 100
+  dvb analyze valset-diff my-devnet --from 100
+
+  # Changes between two heights, as JSON
+  dvb analyze valset-diff my-devnet --from 100 --to 250 -o json`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := validation.OutputFormat(opts.output, "json"); err != nil {
+				return err
+			}
+			return validation.NodeIndex("node", opts.node)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, opts.namespace)
+			if err != nil {
+				return err
+			}
+
+			resp, err := daemonClient.DiffValidatorSets(cmd.Context(), &v1.DiffValidatorSetsRequest{
+				DevnetName: devnetName,
+				Namespace:  ns,
+				FromHeight: opts.from,
+				ToHeight:   opts.to,
+				NodeIndex:  int32(opts.node),
+			})
+			if err != nil {
+				return err
+			}
+
+			if opts.output == "json" {
+				return printJSON(resp)
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+			printValsetDiff(os.Stdout, resp)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.from, "from", 0, "Height to compare from (required)")
+	_ = cmd.MarkFlagRequired("from")
+	cmd.Flags().Int64Var(&opts.to, "to", 0, "Height to compare to (default: latest)")
+	cmd.Flags().IntVar(&opts.node, "node", 0, "Index of the node to query")
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "", "Output format (json)")
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+
+	return cmd
+}
+
+// printValsetDiff prints a summary of both sets and a table of changes.
+func printValsetDiff(out io.Writer, resp *v1.DiffValidatorSetsResponse) {
+	fmt.Fprintf(out, "Validator set %d → %d\n", resp.FromHeight, resp.ToHeight)
+	fmt.Fprintf(out, "  Active:      %d → %d\n", resp.FromCount, resp.ToCount)
+	fmt.Fprintf(out, "  Total power: %d → %d\n\n", resp.FromTotalPower, resp.ToTotalPower)
+
+	if len(resp.Changes) == 0 {
+		fmt.Fprintln(out, "No validator changes.")
+		return
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CHANGE\tVALIDATOR\tADDRESS\tPOWER")
+	for _, c := range resp.Changes {
+		name := c.Moniker
+		if name == "" {
+			name = "-"
+		}
+		address := c.OperatorAddress
+		if address == "" {
+			address = c.ConsensusAddress
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", valsetChangeLabel(c.Kind), name, address, powerDelta(c.FromPower, c.ToPower))
+	}
+	w.Flush()
+}
+
+func valsetChangeLabel(kind string) string {
+	switch kind {
+	case "added", "unjailed":
+		return color.GreenString(kind)
+	case "removed", "jailed":
+		return color.RedString(kind)
+	default:
+		return kind
+	}
+}
+
+// powerDelta formats a voting power change, e.g. "100 → 150 (+50)".
+func powerDelta(from, to int64) string {
+	if from == to {
+		return fmt.Sprintf("%d", to)
+	}
+	return fmt.Sprintf("%d → %d (%+d)", from, to, to-from)
+}
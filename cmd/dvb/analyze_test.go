@@ -0,0 +1,66 @@
+// cmd/dvb/analyze_test.go
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+func TestPrintValsetDiff(t *testing.T) {
+	var buf bytes.Buffer
+	printValsetDiff(&buf, &v1.DiffValidatorSetsResponse{
+		FromHeight:     100,
+		ToHeight:       250,
+		FromCount:      4,
+		ToCount:        3,
+		FromTotalPower: 400,
+		ToTotalPower:   350,
+		Changes: []*v1.ValidatorSetChange{
+			{Kind: "jailed", Moniker: "validator-3", OperatorAddress: "stablevaloper1xyz", FromPower: 100},
+			{Kind: "power", ConsensusAddress: "ABCDEF", FromPower: 100, ToPower: 150},
+		},
+	})
+
+	out := buf.String()
+	for _, want := range []string{
+		"Validator set 100 → 250",
+		"Active:      4 → 3",
+		"validator-3",
+		"stablevaloper1xyz",
+		"100 → 0 (-100)",
+		"ABCDEF",
+		"100 → 150 (+50)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestPrintValsetDiff_NoChanges(t *testing.T) {
+	var buf bytes.Buffer
+	printValsetDiff(&buf, &v1.DiffValidatorSetsResponse{FromHeight: 1, ToHeight: 2})
+
+	if !strings.Contains(buf.String(), "No validator changes.") {
+		t.Errorf("unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestPowerDelta(t *testing.T) {
+	tests := []struct {
+		from, to int64
+		want     string
+	}{
+		{100, 100, "100"},
+		{0, 50, "0 → 50 (+50)"},
+		{100, 0, "100 → 0 (-100)"},
+	}
+	for _, tt := range tests {
+		if got := powerDelta(tt.from, tt.to); got != tt.want {
+			t.Errorf("powerDelta(%d, %d) = %q, want %q", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
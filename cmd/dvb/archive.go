@@ -0,0 +1,138 @@
+// cmd/dvb/archive.go
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// exportDevnetOptions holds options for exporting a devnet archive.
+type exportDevnetOptions struct {
+	namespace string
+	output    string
+	live      bool
+}
+
+// runExportDevnet writes a devnet archive to opts.output, or
+// <devnet>.tar.zst. The archive is written next to its destination and
+// renamed into place, so a failed export leaves no partial file.
+func runExportDevnet(cmd *cobra.Command, args []string, opts *exportDevnetOptions) error {
+	if err := requireDaemon(); err != nil {
+		return err
+	}
+
+	var explicitDevnet string
+	if len(args) > 0 {
+		explicitDevnet = args[0]
+	}
+
+	ns, devnetName, err := resolveWithSuggestions(explicitDevnet, opts.namespace)
+	if err != nil {
+		return err
+	}
+
+	printContextHeader(explicitDevnet, currentContext)
+
+	output := opts.output
+	if output == "" {
+		output = devnetName + ".tar.zst"
+	}
+	f, err := os.CreateTemp(filepath.Dir(output), "."+filepath.Base(output)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create archive: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	fmt.Printf("Exporting devnet %q...\n", devnetName)
+	err = daemonClient.ExportDevnet(cmd.Context(), &v1.ExportDevnetRequest{
+		DevnetName: devnetName,
+		Namespace:  ns,
+		Live:       opts.live,
+	}, f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	if err := os.Rename(f.Name(), output); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	size := int64(0)
+	if info, err := os.Stat(output); err == nil {
+		size = info.Size()
+	}
+	color.Green("✓ Wrote %s (%.1f MB)", output, float64(size)/(1<<20))
+	fmt.Println("  The archive holds node keys and keyrings; share it only with people who may use them.")
+	if opts.live {
+		color.Yellow("  Nodes were running during the export; their chain data may be inconsistent.")
+	}
+	return nil
+}
+
+func newImportCmd() *cobra.Command {
+	var (
+		name      string
+		namespace string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import <archive>",
+		Short: "Create a devnet from an exported archive",
+		Long: `Create a devnet from an archive written by 'dvb export', with the node
+homes, keyrings and chain data of the exported devnet.
+
+The devnet is imported stopped. Its nodes get addresses in a subnet of this
+daemon, and local nodes run a binary built at the node's version. Binaries
+are built after the import returns; wait for them with
+'dvb wait <devnet> --for condition=BinariesReady'. Nodes left without a
+binary are listed; give them one with 'dvb node set-binary' before starting
+the devnet.
+
+Examples:
+  # Import a devnet under its exported name
+  dvb import my-devnet.tar.zst
+
+  # Import a devnet from a bug report under another name
+  dvb import repro.tar.zst --name repro`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to open archive: %w", err)
+			}
+			defer f.Close()
+
+			fmt.Printf("Importing %s...\n", args[0])
+			resp, err := daemonClient.ImportDevnet(cmd.Context(), name, namespace, f)
+			if err != nil {
+				return err
+			}
+
+			devnet := resp.GetDevnet()
+			devnetName := devnet.GetMetadata().GetName()
+			color.Green("✓ Imported devnet %q (%d nodes)", devnetName, devnet.GetStatus().GetNodes())
+			for _, w := range resp.Warnings {
+				color.Yellow("  ! %s", w)
+			}
+			fmt.Printf("\nStart it with: dvb node start %s --all\n", devnetName)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Name of the imported devnet (defaults to the exported name)")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+
+	return cmd
+}
@@ -0,0 +1,318 @@
+// cmd/dvb/auth.go
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	goruntime "runtime"
+	"strings"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/client"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/credentials"
+	"github.com/altuslabsxyz/devnet-builder/internal/domain/credential"
+	infracred "github.com/altuslabsxyz/devnet-builder/internal/infrastructure/credential"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// newGitHubTokenStore returns the store 'dvb auth github' keeps the token
+// in. Tests replace it.
+var newGitHubTokenStore = func() credential.Store {
+	return infracred.NewKeychainStore()
+}
+
+func newAuthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auth",
+		Short: "Manage credentials",
+		Long:  `Manage the credentials dvb and devnetd use for external services.`,
+	}
+
+	cmd.AddCommand(newAuthGitHubCmd())
+
+	return cmd
+}
+
+func newAuthGitHubCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "github",
+		Short: "Manage the GitHub token",
+		Long: `Manage the GitHub token used for the GitHub API (release lookups, higher
+rate limits) and release downloads.
+
+The token is stored in the OS keychain (macOS Keychain, Secret Service on
+Linux, Windows Credential Manager), not in a config file. dvb hands it over
+to a local devnetd through a short-lived Unix socket, so it never appears in
+the daemon's environment, its config or an API request. The daemon keeps it
+in memory only; dvb hands it over again after the daemon restarts.`,
+	}
+
+	cmd.AddCommand(
+		newAuthGitHubLoginCmd(),
+		newAuthGitHubLogoutCmd(),
+		newAuthGitHubStatusCmd(),
+	)
+
+	return cmd
+}
+
+func newAuthGitHubLoginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Store a GitHub token in the OS keychain",
+		Long: `Store a GitHub token in the OS keychain and hand it over to the local
+daemon if it is running. The token is read from a prompt, or from stdin
+when stdin is not a terminal.`,
+		Example: `  # Paste the token at the prompt
+  dvb auth github login
+
+  # Read the token from stdin
+  gh auth token | dvb auth github login`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			token, err := readGitHubToken(cmd.InOrStdin(), cmd.ErrOrStderr())
+			if err != nil {
+				return err
+			}
+			if err := credential.ValidateGitHubToken(token); err != nil {
+				color.Yellow("Warning: this does not look like a GitHub token (expected ghp_*, github_pat_*, ...)")
+			}
+
+			store := newGitHubTokenStore()
+			if !store.IsAvailable() {
+				return fmt.Errorf("the OS keychain is not available; set GITHUB_TOKEN for devnetd instead")
+			}
+			if err := store.Set(credential.TypeGitHubToken, token); err != nil {
+				return fmt.Errorf("failed to store the token in the keychain: %w", err)
+			}
+			color.Green("✓ Stored the GitHub token in %s", keychainName())
+
+			return reportHandOff(cmd.Context(), cmd.OutOrStdout(), token)
+		},
+	}
+
+	return cmd
+}
+
+func newAuthGitHubLogoutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logout",
+		Short: "Remove the GitHub token from the OS keychain",
+		Long: `Remove the GitHub token from the OS keychain and from the local daemon's
+memory. The daemon falls back to its github.token setting or GITHUB_TOKEN,
+if any.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := newGitHubTokenStore().Delete(credential.TypeGitHubToken)
+			switch {
+			case errors.Is(err, credential.ErrCredentialNotFound):
+				fmt.Fprintln(cmd.OutOrStdout(), "No GitHub token is stored in the keychain")
+			case err != nil:
+				return fmt.Errorf("failed to remove the token from the keychain: %w", err)
+			default:
+				color.Green("✓ Removed the GitHub token from %s", keychainName())
+			}
+
+			return reportHandOff(cmd.Context(), cmd.OutOrStdout(), "")
+		},
+	}
+
+	return cmd
+}
+
+// githubAuthStatus is the JSON output of 'dvb auth github status'.
+type githubAuthStatus struct {
+	Keychain    bool   `json:"keychain"`
+	Token       string `json:"token,omitempty"`
+	Environment bool   `json:"environment"`
+	// Daemon is the source of the local daemon's token, or empty when the
+	// daemon is not running.
+	Daemon string `json:"daemon,omitempty"`
+}
+
+func newAuthGitHubStatusCmd() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show where the GitHub token comes from",
+		Long: `Show whether a GitHub token is stored in the OS keychain or set in
+GITHUB_TOKEN, and which token the local daemon uses. Only the token's
+prefix is shown.`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return validation.OutputFormat(output, "json")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var st githubAuthStatus
+			if cred, err := newGitHubTokenStore().Get(credential.TypeGitHubToken); err == nil {
+				st.Keychain = true
+				st.Token = maskToken(cred.Value)
+			}
+			st.Environment = os.Getenv("GITHUB_TOKEN") != ""
+			if daemonClient != nil && !daemonClient.IsRemote() {
+				source, err := daemonClient.GetCredentialStatus(cmd.Context())
+				if err != nil {
+					return err
+				}
+				st.Daemon = source
+			}
+
+			if output == "json" {
+				return printJSON(st)
+			}
+			printGitHubAuthStatus(cmd.OutOrStdout(), st)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json)")
+
+	return cmd
+}
+
+// printGitHubAuthStatus prints the sources of the GitHub token.
+func printGitHubAuthStatus(out io.Writer, st githubAuthStatus) {
+	keychain := "not set"
+	if st.Keychain {
+		keychain = fmt.Sprintf("%s (%s)", st.Token, keychainName())
+	}
+	env := "not set"
+	if st.Environment {
+		env = "set"
+	}
+	daemon := "not running"
+	switch credential.Source(st.Daemon) {
+	case "":
+	case credential.SourceKeychain:
+		daemon = "keychain token, handed over by dvb"
+	case credential.SourceConfigFile:
+		daemon = "github.token from the daemon config"
+	case credential.SourceEnvironment:
+		daemon = "GITHUB_TOKEN from the daemon's environment"
+	default:
+		daemon = "no token"
+	}
+
+	fmt.Fprintf(out, "Keychain:      %s\n", keychain)
+	fmt.Fprintf(out, "GITHUB_TOKEN:  %s\n", env)
+	fmt.Fprintf(out, "Daemon:        %s\n", daemon)
+}
+
+// reportHandOff hands token over to the local daemon, if running, and
+// reports the outcome.
+func reportHandOff(ctx context.Context, out io.Writer, token string) error {
+	if daemonClient == nil || daemonClient.IsRemote() {
+		return nil
+	}
+	source, err := handOffGitHubToken(ctx, daemonClient, token)
+	if err != nil {
+		return fmt.Errorf("failed to hand the token over to the daemon: %w", err)
+	}
+	switch {
+	case token != "":
+		fmt.Fprintln(out, "The daemon now uses this token")
+	case credential.Source(source) == credential.SourceNone:
+		fmt.Fprintln(out, "The daemon no longer has a GitHub token")
+	default:
+		fmt.Fprintf(out, "The daemon now uses its %s token\n", source)
+	}
+	return nil
+}
+
+// handOffGitHubToken hands token over to a local daemon through a
+// short-lived socket and returns the source of the token the daemon then
+// uses. An empty token clears the one handed over before.
+func handOffGitHubToken(ctx context.Context, c *client.Client, token string) (string, error) {
+	var source string
+	err := credentials.Offer(ctx, credentials.Handoff{GitHubToken: token}, func(socketPath, nonce string) error {
+		var err error
+		source, err = c.HandOffCredentials(ctx, socketPath, nonce)
+		return err
+	})
+	return source, err
+}
+
+// syncGitHubToken hands the keychain's GitHub token over to a local daemon
+// that does not have it yet, e.g. after the daemon restarted. Failures are
+// ignored: the token is optional.
+func syncGitHubToken(ctx context.Context, c *client.Client) {
+	if c == nil || c.IsRemote() {
+		return
+	}
+	source, err := c.GetCredentialStatus(ctx)
+	if err != nil || credential.Source(source) == credential.SourceKeychain {
+		return
+	}
+	cred, err := newGitHubTokenStore().Get(credential.TypeGitHubToken)
+	if err != nil {
+		return
+	}
+	_, _ = handOffGitHubToken(ctx, c, cred.Value)
+}
+
+// shouldSyncGitHubToken reports whether cmd may need the daemon to have the
+// GitHub token. Commands that never start the daemon skip the keychain
+// lookup, and 'dvb auth' hands the token over itself.
+func shouldSyncGitHubToken(cmd *cobra.Command) bool {
+	path := cmd.CommandPath()
+	for _, skip := range append(noAutostartCommands, "dvb auth") {
+		if path == skip || strings.HasPrefix(path, skip+" ") {
+			return false
+		}
+	}
+	return true
+}
+
+// readGitHubToken prompts for a token on a terminal, or reads the first line
+// of in otherwise.
+func readGitHubToken(in io.Reader, prompt io.Writer) (string, error) {
+	if f, ok := in.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		fmt.Fprint(prompt, "GitHub token: ")
+		b, err := term.ReadPassword(int(f.Fd()))
+		fmt.Fprintln(prompt)
+		if err != nil {
+			return "", fmt.Errorf("failed to read token: %w", err)
+		}
+		return validToken(string(b))
+	}
+
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read token: %w", err)
+	}
+	return validToken(line)
+}
+
+func validToken(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", fmt.Errorf("no token given")
+	}
+	return s, nil
+}
+
+// maskToken shows only a token's prefix.
+func maskToken(token string) string {
+	if len(token) <= 8 {
+		return "****"
+	}
+	return token[:4] + "****"
+}
+
+func keychainName() string {
+	switch goruntime.GOOS {
+	case "darwin":
+		return "the macOS Keychain"
+	case "windows":
+		return "Windows Credential Manager"
+	default:
+		return "the Secret Service keyring"
+	}
+}
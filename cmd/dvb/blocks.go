@@ -0,0 +1,341 @@
+// cmd/dvb/blocks.go
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// slowBlockFactor is how many times the median interval a block interval
+// (or the latest block's age) must exceed to be highlighted.
+const slowBlockFactor = 3
+
+func newBlocksCmd() *cobra.Command {
+	var (
+		namespace string
+		limit     int
+		node      int
+		output    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "blocks [devnet]",
+		Short: "List recent blocks",
+		Long: `List the most recent blocks of a running devnet with their proposer,
+transaction count and the time since the previous block.
+
+Block intervals well above the median are highlighted, and the age of the
+latest block shows whether the chain is still producing blocks. Useful during
+upgrade rehearsals to confirm liveness without an external explorer.`,
+		Example: `  # The last 20 blocks
+  dvb blocks my-devnet
+
+  # The last 50 blocks as seen by node 1, as JSON
+  dvb blocks my-devnet --limit 50 --node 1 -o json`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := validation.OutputFormat(output, "json"); err != nil {
+				return err
+			}
+			if err := validation.NonNegative("limit", limit); err != nil {
+				return err
+			}
+			return validation.NodeIndex("node", node)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			resp, err := daemonClient.ListBlocks(cmd.Context(), &v1.ListBlocksRequest{
+				DevnetName: devnetName,
+				Namespace:  ns,
+				Limit:      int32(limit),
+				NodeIndex:  int32(node),
+			})
+			if err != nil {
+				return err
+			}
+
+			if output == "json" {
+				return printJSON(resp)
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+			printBlocks(os.Stdout, resp)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().IntVar(&limit, "limit", 20, "Number of blocks to list (max 100)")
+	cmd.Flags().IntVar(&node, "node", 0, "Index of the node to query")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json)")
+
+	return cmd
+}
+
+func newBlockCmd() *cobra.Command {
+	var (
+		namespace string
+		devnet    string
+		node      int
+		output    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "block <height|latest>",
+		Short: "Show a block and its transactions",
+		Long: `Show a block of a running devnet with each transaction's result, gas usage
+and decoded messages.
+
+Messages are decoded by the devnet's network plugin, or by the Cosmos SDK
+decoder for plugins that don't decode transactions; unknown message types are
+shown by type only.`,
+		Example: `  # The latest block
+  dvb block latest
+
+  # Block 1200 of a specific devnet, as JSON
+  dvb block 1200 --devnet my-devnet -o json`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := validation.OutputFormat(output, "json"); err != nil {
+				return err
+			}
+			if _, err := parseBlockHeight(args[0]); err != nil {
+				return err
+			}
+			return validation.NodeIndex("node", node)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := parseBlockHeight(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(devnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			resp, err := daemonClient.GetBlock(cmd.Context(), &v1.GetBlockRequest{
+				DevnetName: devnetName,
+				Namespace:  ns,
+				Height:     height,
+				NodeIndex:  int32(node),
+			})
+			if err != nil {
+				return err
+			}
+
+			if output == "json" {
+				return printJSON(blockView(resp))
+			}
+
+			printContextHeader(devnet, currentContext)
+			printBlock(os.Stdout, resp)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVar(&devnet, "devnet", "", "Name of the devnet")
+	cmd.Flags().IntVar(&node, "node", 0, "Index of the node to query")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json)")
+
+	return cmd
+}
+
+// parseBlockHeight parses a block height argument: a positive number, or
+// "latest" for 0.
+func parseBlockHeight(arg string) (int64, error) {
+	if arg == "latest" {
+		return 0, nil
+	}
+	height, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || height <= 0 {
+		return 0, fmt.Errorf("invalid height %q: want a positive number or \"latest\"", arg)
+	}
+	return height, nil
+}
+
+// printBlocks prints a table of blocks and the age of the latest one,
+// highlighting intervals well above the median.
+func printBlocks(out io.Writer, resp *v1.ListBlocksResponse) {
+	if len(resp.Blocks) == 0 {
+		fmt.Fprintln(out, "No blocks yet.")
+		return
+	}
+
+	median := medianBlockInterval(resp.Blocks)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "HEIGHT\tTIME\tINTERVAL\tPROPOSER\tTXS")
+	for _, b := range resp.Blocks {
+		interval := "-"
+		if b.IntervalMs > 0 {
+			interval = formatBlockInterval(b.IntervalMs)
+			if median > 0 && b.IntervalMs > slowBlockFactor*median {
+				interval = color.YellowString(interval)
+			}
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\n", b.Height, b.Time.AsTime().Local().Format("15:04:05.000"),
+			interval, blockProposer(b), b.NumTxs)
+	}
+	w.Flush()
+
+	age := fmt.Sprintf("\nLatest block %d produced %s ago", resp.Blocks[0].Height, formatBlockInterval(resp.LatestBlockAgeMs))
+	if median > 0 {
+		age += fmt.Sprintf(" (median interval %s)", formatBlockInterval(median))
+	}
+	if median > 0 && resp.LatestBlockAgeMs > slowBlockFactor*median {
+		color.New(color.FgYellow).Fprintf(out, "%s — the chain may be halted\n", age)
+		return
+	}
+	fmt.Fprintln(out, age)
+}
+
+// printBlock prints a block header and its transactions.
+func printBlock(out io.Writer, resp *v1.GetBlockResponse) {
+	b := resp.Block
+	fmt.Fprintf(out, "Block %d\n", b.Height)
+	fmt.Fprintf(out, "Hash:      %s\n", b.Hash)
+	fmt.Fprintf(out, "Time:      %s", b.Time.AsTime().Local().Format(time.RFC3339Nano))
+	if b.IntervalMs > 0 {
+		fmt.Fprintf(out, " (+%s)", formatBlockInterval(b.IntervalMs))
+	}
+	fmt.Fprintf(out, "\nProposer:  %s\n", blockProposer(b))
+
+	fmt.Fprintf(out, "\nTransactions (%d):\n", len(resp.Txs))
+	for i, tx := range resp.Txs {
+		fmt.Fprintf(out, "  [%d] %s ", i, tx.Hash)
+		if tx.Code == 0 {
+			color.New(color.FgGreen).Fprintln(out, "✓")
+		} else {
+			color.New(color.FgRed).Fprintf(out, "✗ code %d\n", tx.Code)
+		}
+		fmt.Fprintf(out, "      Gas: %d used / %d wanted\n", tx.GasUsed, tx.GasWanted)
+		if tx.Fee != "" {
+			fmt.Fprintf(out, "      Fee: %s\n", tx.Fee)
+		}
+		if tx.Memo != "" {
+			fmt.Fprintf(out, "      Memo: %s\n", tx.Memo)
+		}
+		if tx.Code != 0 && tx.Log != "" {
+			fmt.Fprintf(out, "      Log: %s\n", tx.Log)
+		}
+		if tx.DecodeError != "" {
+			color.New(color.FgYellow).Fprintf(out, "      Could not decode transaction: %s\n", tx.DecodeError)
+		}
+		for _, m := range tx.Messages {
+			fmt.Fprintf(out, "      %s\n", m.TypeUrl)
+			if len(m.Json) > 0 {
+				fmt.Fprintf(out, "%s\n", indentJSON(m.Json, "        "))
+			}
+		}
+	}
+}
+
+// blockProposer formats a block's proposer as its moniker and address.
+func blockProposer(b *v1.BlockSummary) string {
+	if b.ProposerMoniker == "" {
+		return b.ProposerAddress
+	}
+	return fmt.Sprintf("%s (%s)", b.ProposerMoniker, b.ProposerAddress)
+}
+
+// medianBlockInterval returns the median of the known block intervals in
+// milliseconds, or 0 if there are none.
+func medianBlockInterval(blocks []*v1.BlockSummary) int64 {
+	var intervals []int64
+	for _, b := range blocks {
+		if b.IntervalMs > 0 {
+			intervals = append(intervals, b.IntervalMs)
+		}
+	}
+	if len(intervals) == 0 {
+		return 0
+	}
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i] < intervals[j] })
+	return intervals[len(intervals)/2]
+}
+
+// formatBlockInterval formats milliseconds with block-time precision, e.g.
+// "1.52s".
+func formatBlockInterval(ms int64) string {
+	d := time.Duration(ms) * time.Millisecond
+	if d >= time.Minute {
+		return d.Round(time.Second).String()
+	}
+	return d.Round(10 * time.Millisecond).String()
+}
+
+// blockJSON is the -o json form of a block, embedding decoded messages as
+// JSON rather than base64.
+type blockJSON struct {
+	Block *v1.BlockSummary `json:"block"`
+	Txs   []blockTxJSON    `json:"txs"`
+}
+
+type blockTxJSON struct {
+	Hash        string           `json:"hash"`
+	Code        uint32           `json:"code"`
+	Log         string           `json:"log,omitempty"`
+	GasWanted   int64            `json:"gasWanted"`
+	GasUsed     int64            `json:"gasUsed"`
+	Fee         string           `json:"fee,omitempty"`
+	Memo        string           `json:"memo,omitempty"`
+	Messages    []txTraceMsgJSON `json:"messages"`
+	DecodeError string           `json:"decodeError,omitempty"`
+}
+
+func blockView(resp *v1.GetBlockResponse) blockJSON {
+	view := blockJSON{Block: resp.Block, Txs: make([]blockTxJSON, 0, len(resp.Txs))}
+	for _, tx := range resp.Txs {
+		txView := blockTxJSON{
+			Hash:        tx.Hash,
+			Code:        tx.Code,
+			Log:         tx.Log,
+			GasWanted:   tx.GasWanted,
+			GasUsed:     tx.GasUsed,
+			Fee:         tx.Fee,
+			Memo:        tx.Memo,
+			Messages:    make([]txTraceMsgJSON, 0, len(tx.Messages)),
+			DecodeError: tx.DecodeError,
+		}
+		for _, m := range tx.Messages {
+			msg := txTraceMsgJSON{TypeURL: m.TypeUrl}
+			if json.Valid(m.Json) {
+				msg.Value = m.Json
+			}
+			txView.Messages = append(txView.Messages, msg)
+		}
+		view.Txs = append(view.Txs, txView)
+	}
+	return view
+}
@@ -0,0 +1,105 @@
+// cmd/dvb/blocks_test.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPrintBlocks(t *testing.T) {
+	now := time.Now()
+	var out bytes.Buffer
+	printBlocks(&out, &v1.ListBlocksResponse{
+		Blocks: []*v1.BlockSummary{
+			{Height: 12, Time: timestamppb.New(now), ProposerAddress: "AA", ProposerMoniker: "validator-0", NumTxs: 3, IntervalMs: 1000},
+			{Height: 11, Time: timestamppb.New(now), ProposerAddress: "BB", IntervalMs: 9000},
+			{Height: 10, Time: timestamppb.New(now), ProposerAddress: "AA", IntervalMs: 1000},
+			{Height: 9, Time: timestamppb.New(now), ProposerAddress: "BB"},
+		},
+		LatestBlockAgeMs: 2000,
+	})
+
+	for _, want := range []string{
+		"validator-0 (AA)",
+		"9s",
+		"Latest block 12 produced 2s ago (median interval 1s)",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+	if strings.Contains(out.String(), "halted") {
+		t.Errorf("2s is not over 3x the 1s median:\n%s", out.String())
+	}
+}
+
+func TestPrintBlocksHalted(t *testing.T) {
+	var out bytes.Buffer
+	printBlocks(&out, &v1.ListBlocksResponse{
+		Blocks:           []*v1.BlockSummary{{Height: 2, Time: timestamppb.Now(), IntervalMs: 1000}},
+		LatestBlockAgeMs: 60000,
+	})
+	if !strings.Contains(out.String(), "the chain may be halted") {
+		t.Errorf("expected halt warning:\n%s", out.String())
+	}
+}
+
+func TestPrintBlock(t *testing.T) {
+	var out bytes.Buffer
+	printBlock(&out, &v1.GetBlockResponse{
+		Block: &v1.BlockSummary{Height: 42, Hash: "ABCD", Time: timestamppb.Now(), ProposerAddress: "AA", IntervalMs: 1520},
+		Txs: []*v1.BlockTx{
+			{Hash: "T1", GasUsed: 81234, GasWanted: 200000, Fee: "5000stake", Messages: []*v1.TxTraceMessage{
+				{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Json: []byte(`{"from_address":"cosmos1a"}`)},
+			}},
+			{Hash: "T2", Code: 5, Log: "insufficient funds", DecodeError: "unknown tx format"},
+		},
+	})
+
+	for _, want := range []string{
+		"Block 42",
+		"(+1.52s)",
+		"Transactions (2):",
+		"Gas: 81234 used / 200000 wanted",
+		"/cosmos.bank.v1beta1.MsgSend",
+		`"from_address": "cosmos1a"`,
+		"✗ code 5",
+		"Log: insufficient funds",
+		"Could not decode transaction: unknown tx format",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestBlockView(t *testing.T) {
+	data, err := json.Marshal(blockView(&v1.GetBlockResponse{
+		Block: &v1.BlockSummary{Height: 42},
+		Txs: []*v1.BlockTx{{Hash: "T1", Messages: []*v1.TxTraceMessage{
+			{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Json: []byte(`{"amount":"1"}`)},
+		}}},
+	}))
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if !strings.Contains(string(data), `"value":{"amount":"1"}`) {
+		t.Errorf("JSON missing decoded message:\n%s", data)
+	}
+}
+
+func TestMedianBlockInterval(t *testing.T) {
+	blocks := []*v1.BlockSummary{{IntervalMs: 3000}, {IntervalMs: 1000}, {}, {IntervalMs: 2000}}
+	if got := medianBlockInterval(blocks); got != 2000 {
+		t.Errorf("medianBlockInterval = %d, want 2000", got)
+	}
+	if got := medianBlockInterval(nil); got != 0 {
+		t.Errorf("medianBlockInterval(nil) = %d, want 0", got)
+	}
+}
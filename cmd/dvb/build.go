@@ -0,0 +1,134 @@
+// cmd/dvb/build.go
+package main
+
+import (
+	"fmt"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// buildOptions holds options for the build command
+type buildOptions struct {
+	network         string
+	networkType     string
+	ref             string
+	repo            string
+	image           bool
+	tag             string
+	noCache         bool
+	forceBuild      bool
+	allowUnverified bool
+	output          string
+}
+
+func newBuildCmd() *cobra.Command {
+	opts := &buildOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "build",
+		Short: "Build a network binary or Docker image from a git ref",
+		Long: `Build a network binary or Docker image from a git branch, tag, or commit.
+
+By default the daemon builds the binary and stores it in its binary cache.
+When the ref names a published release with a prebuilt binary for this
+platform, the release asset is downloaded and checksum-verified instead of
+compiling from source. Use --force-build to always compile. Releases that
+publish no checksum are compiled from source too, unless --allow-unverified
+is given.
+
+With --image, the daemon generates a multi-stage Dockerfile from the network
+plugin's build configuration (tags, ldflags, env) and builds a local Docker
+image, so chains without a published image can run in docker mode.
+
+Images are tagged dvb/<network>:<ref>-<commit> unless --tag is given.
+An existing image with the same tag is reused unless --no-cache is set.
+
+Examples:
+  # Build a binary from a feature branch
+  dvb build --network stable --ref feat/my-branch
+
+  # Compile a release from source instead of downloading its binary
+  dvb build --network stable --ref v1.2.0 --force-build
+
+  # Build a Docker image from a feature branch
+  dvb build --network stable --ref feat/my-branch --image
+
+  # Build an image with a custom tag from a fork
+  dvb build --network cosmos --repo github.com/me/gaia --ref v19.0.0 --image --tag gaia:dev
+
+  # Use the image for a docker-mode devnet
+  dvb provision --name my-devnet --network stable --mode docker --image dvb/stable:feat-my-branch-abc123def456`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			if opts.tag != "" && !opts.image {
+				return fmt.Errorf("--tag requires --image")
+			}
+
+			resp, err := daemonClient.Build(cmd.Context(), &v1.BuildRequest{
+				NetworkName:     opts.network,
+				NetworkType:     opts.networkType,
+				GitRef:          opts.ref,
+				GitRepo:         opts.repo,
+				Image:           opts.image,
+				Tag:             opts.tag,
+				NoCache:         opts.noCache,
+				ForceBuild:      opts.forceBuild,
+				AllowUnverified: opts.allowUnverified,
+			})
+			if err != nil {
+				return err
+			}
+
+			if opts.output == "json" {
+				return printJSON(resp)
+			}
+
+			printBuildResult(resp)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.network, "network", "", "Network/plugin name (stable, cosmos, gaia, osmosis, evmos) - required")
+	_ = cmd.MarkFlagRequired("network")
+	_ = cmd.RegisterFlagCompletionFunc("network", completeNetworkNames)
+	cmd.Flags().StringVar(&opts.ref, "ref", "", "Git branch, tag, or commit to build (default: repository default branch)")
+	cmd.Flags().StringVar(&opts.repo, "repo", "", "Git repository to build from (default: plugin's source repository)")
+	cmd.Flags().StringVar(&opts.networkType, "network-type", "mainnet", "Network type used to select build configuration (mainnet or testnet)")
+	cmd.Flags().BoolVar(&opts.image, "image", false, "Build a Docker image instead of a binary")
+	cmd.Flags().StringVar(&opts.tag, "tag", "", "Image tag (requires --image, default: dvb/<network>:<ref>-<commit>)")
+	cmd.Flags().BoolVar(&opts.noCache, "no-cache", false, "Rebuild even if a cached binary or image exists")
+	cmd.Flags().BoolVar(&opts.forceBuild, "force-build", false, "Compile from source even if the ref matches a release with prebuilt binaries")
+	cmd.Flags().BoolVar(&opts.allowUnverified, "allow-unverified", false, "Use a release binary even if its release publishes no checksum, instead of compiling from source")
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "", "Output format (json)")
+
+	return cmd
+}
+
+func printBuildResult(resp *v1.BuildResponse) {
+	if resp.Image != "" {
+		if resp.Cached {
+			color.Green("✓ Image up to date: %s", resp.Image)
+		} else {
+			color.Green("✓ Image built: %s", resp.Image)
+		}
+	} else if resp.FromRelease {
+		color.Green("✓ Binary downloaded: %s", resp.BinaryPath)
+	} else {
+		color.Green("✓ Binary built: %s", resp.BinaryPath)
+	}
+	fmt.Printf("  Ref:    %s\n", resp.GitRef)
+	if resp.GitCommit != "" {
+		fmt.Printf("  Commit: %s\n", resp.GitCommit)
+	}
+
+	if resp.Image != "" {
+		fmt.Println()
+		fmt.Println("Run a devnet with this image:")
+		fmt.Printf("  dvb provision --mode docker --image %s ...\n", resp.Image)
+	}
+}
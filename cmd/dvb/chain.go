@@ -0,0 +1,272 @@
+// cmd/dvb/chain.go
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/dvbcontext"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
+	"github.com/spf13/cobra"
+)
+
+// chainTarget is the node a chain CLI invocation is pointed at.
+type chainTarget struct {
+	NodeURL string
+	ChainID string
+	Home    string
+}
+
+func newChainCmd() *cobra.Command {
+	var (
+		namespace string
+		nodeName  string
+		binary    string
+		timeout   int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "chain [devnet] -- <binary args...>",
+		Short: "Run the devnet's chain CLI against one of its nodes",
+		Long: `Run the chain binary of a devnet's plugin (stabled, gaiad, ...) with
+--node, --chain-id, --home and --keyring-backend pointing at a node, so
+endpoints don't have to be copied from 'dvb describe'.
+
+Flags are only added where the subcommand takes them (--node for query, tx
+and status; --chain-id for tx; --keyring-backend for tx and keys), and never
+when already given. Node 0 is used unless --node selects another.
+
+Local devnets run the binary on this machine with the node's home directory.
+Docker devnets run it inside the node's container.
+
+With context set (dvb use <devnet>), the devnet name is optional.
+
+Examples:
+  # Query a balance through validator-0
+  dvb chain my-devnet -- query bank balances stable1...
+
+  # Send tokens using the node's test keyring
+  dvb chain -- tx bank send validator stable1... 1000ustable --yes
+
+  # Check sync status of a full node
+  dvb chain my-devnet --node fullnode-0 -- status`,
+		Args:              cobra.ArbitraryArgs,
+		ValidArgsFunction: completeDevnetNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			dash := cmd.ArgsLenAtDash()
+			if dash == -1 || dash == len(args) {
+				return fmt.Errorf("no binary arguments specified after --")
+			}
+			if dash > 1 {
+				return fmt.Errorf("expected at most one devnet name before --, got %d arguments", dash)
+			}
+
+			var explicitDevnet string
+			if dash == 1 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+
+			ctx := cmd.Context()
+			devnet, err := daemonClient.GetDevnet(ctx, ns, devnetName)
+			if err != nil {
+				return err
+			}
+
+			index := 0
+			if nodeName != "" {
+				sel, err := dvbcontext.ResolveNodeName(ctx, daemonClient, ns, devnetName, nodeName)
+				if err != nil {
+					return err
+				}
+				index = sel.Index
+			}
+			node, err := daemonClient.GetNode(ctx, ns, devnetName, index)
+			if err != nil {
+				return err
+			}
+
+			network, err := daemonClient.GetNetworkInfo(ctx, devnet.GetSpec().GetPlugin())
+			if err != nil {
+				return fmt.Errorf("failed to get network info for plugin %q: %w", devnet.GetSpec().GetPlugin(), err)
+			}
+
+			chainID := devnet.GetSpec().GetChainId()
+			if chainID == "" {
+				chainID = network.GetDefaultChainId()
+			}
+
+			if devnet.GetSpec().GetMode() == "docker" {
+				if binary == "" {
+					binary = network.GetBinaryName()
+				}
+				target := chainTarget{
+					NodeURL: fmt.Sprintf("tcp://localhost:%d", dvbtypes.DefaultPortConfig().RPC),
+					ChainID: chainID,
+					Home:    network.GetDockerHomeDir(),
+				}
+				command := append([]string{binary}, chainCommandArgs(args[dash:], target)...)
+
+				result, err := daemonClient.ExecInNode(ctx, devnetName, index, command, timeout)
+				if err != nil {
+					return err
+				}
+				fmt.Print(result.Stdout)
+				fmt.Fprint(os.Stderr, result.Stderr)
+				if result.ExitCode != 0 {
+					os.Exit(result.ExitCode)
+				}
+				return nil
+			}
+
+			if daemonClient.IsRemote() {
+				return fmt.Errorf("devnet %q runs on the daemon host; use a docker devnet or run dvb on that host", devnetName)
+			}
+
+			if binary == "" {
+				binary = localChainBinary(node, network)
+			}
+			target := chainTarget{
+				NodeURL: "tcp://" + hostPort(nodeHost(node), nodePorts(node).RPC),
+				ChainID: chainID,
+				Home:    node.GetSpec().GetHomeDir(),
+			}
+
+			run := exec.CommandContext(ctx, binary, chainCommandArgs(args[dash:], target)...)
+			run.Stdin, run.Stdout, run.Stderr = os.Stdin, os.Stdout, os.Stderr
+			if err := run.Run(); err != nil {
+				var exitErr *exec.ExitError
+				if errors.As(err, &exitErr) {
+					os.Exit(exitErr.ExitCode())
+				}
+				return fmt.Errorf("failed to run %s: %w", binary, err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVar(&nodeName, "node", "", "Node to target, e.g. validator-1 (default: node 0)")
+	cmd.Flags().StringVar(&binary, "binary", "", "Chain binary to run (default: the plugin's binary)")
+	cmd.Flags().IntVar(&timeout, "timeout", 30, "Command timeout in seconds (docker devnets)")
+	_ = cmd.RegisterFlagCompletionFunc("node", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		var explicitDevnet string
+		if len(args) > 0 {
+			explicitDevnet = args[0]
+		}
+		ns, devnetName, err := dvbcontext.Resolve(explicitDevnet, namespace, currentContext)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return nodeCompletions(cmd, ns, devnetName), cobra.ShellCompDirectiveNoFileComp
+	})
+
+	return cmd
+}
+
+// localChainBinary returns the binary the node runs with if it is on this
+// machine, or the plugin's binary name to look up in PATH.
+func localChainBinary(node *v1.Node, network *v1.NetworkInfo) string {
+	if path := node.GetSpec().GetBinaryPath(); path != "" {
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+	}
+	return network.GetBinaryName()
+}
+
+// nodeHost returns the address a node listens on.
+func nodeHost(node *v1.Node) string {
+	if addr := node.GetSpec().GetAddress(); addr != "" {
+		return addr
+	}
+	return "127.0.0.1"
+}
+
+// nodePorts returns the ports a node is reached on. Daemons that predate
+// port layouts don't report them: nodes with a loopback address then listen
+// on the default ports, and others use the legacy offset of 100 per node.
+func nodePorts(node *v1.Node) dvbtypes.PortConfig {
+	ports := dvbtypes.DefaultPortConfig()
+	p := node.GetSpec().GetPorts()
+	if p == nil {
+		if node.GetSpec().GetAddress() == "" {
+			return ports.WithOffset(int(node.GetMetadata().GetIndex()) * 100)
+		}
+		return ports
+	}
+	ports.P2P = int(p.P2P)
+	ports.RPC = int(p.Rpc)
+	ports.API = int(p.Api)
+	ports.GRPC = int(p.Grpc)
+	ports.GRPCWeb = int(p.GrpcWeb)
+	ports.EVMRPC = int(p.EvmRpc)
+	ports.EVMWS = int(p.EvmSocket)
+	return ports
+}
+
+// hostPort joins a node host and port, bracketing IPv6 addresses.
+func hostPort(host string, port int) string {
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+// chainCommandArgs adds the flags pointing the chain CLI at target to args.
+// Flags are added only for subcommands that accept them, and never when the
+// user already passed them.
+func chainCommandArgs(args []string, target chainTarget) []string {
+	var subcommand string
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, "-") {
+			subcommand = arg
+			break
+		}
+	}
+
+	out := append([]string(nil), args...)
+	add := func(flag, value string) {
+		if value == "" || hasFlag(args, flag) {
+			return
+		}
+		out = append(out, flag, value)
+	}
+
+	switch subcommand {
+	case "query", "q", "status":
+		add("--node", target.NodeURL)
+	case "tx":
+		add("--node", target.NodeURL)
+		add("--chain-id", target.ChainID)
+		add("--keyring-backend", "test")
+	case "keys":
+		add("--keyring-backend", "test")
+	}
+	add("--home", target.Home)
+	return out
+}
+
+// hasFlag reports whether args contain flag, as "--flag value" or
+// "--flag=value".
+func hasFlag(args []string, flag string) bool {
+	for _, arg := range args {
+		if arg == flag || strings.HasPrefix(arg, flag+"=") {
+			return true
+		}
+	}
+	return false
+}
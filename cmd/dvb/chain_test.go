@@ -0,0 +1,62 @@
+// cmd/dvb/chain_test.go
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChainCommandArgs(t *testing.T) {
+	target := chainTarget{NodeURL: "tcp://127.0.42.1:26657", ChainID: "devnet-1", Home: "/data/node0"}
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "query gets node and home",
+			args: []string{"query", "bank", "balances", "stable1abc"},
+			want: []string{"query", "bank", "balances", "stable1abc", "--node", "tcp://127.0.42.1:26657", "--home", "/data/node0"},
+		},
+		{
+			name: "tx gets all flags",
+			args: []string{"tx", "bank", "send", "a", "b", "1ustable"},
+			want: []string{"tx", "bank", "send", "a", "b", "1ustable",
+				"--node", "tcp://127.0.42.1:26657", "--chain-id", "devnet-1", "--keyring-backend", "test", "--home", "/data/node0"},
+		},
+		{
+			name: "keys gets keyring backend",
+			args: []string{"keys", "list"},
+			want: []string{"keys", "list", "--keyring-backend", "test", "--home", "/data/node0"},
+		},
+		{
+			name: "other commands get only home",
+			args: []string{"version"},
+			want: []string{"version", "--home", "/data/node0"},
+		},
+		{
+			name: "user flags are kept",
+			args: []string{"tx", "bank", "send", "--chain-id=other-1", "--node", "tcp://x:1"},
+			want: []string{"tx", "bank", "send", "--chain-id=other-1", "--node", "tcp://x:1",
+				"--keyring-backend", "test", "--home", "/data/node0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := chainCommandArgs(tt.args, target)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("chainCommandArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChainCommandArgs_EmptyTargetValues(t *testing.T) {
+	got := chainCommandArgs([]string{"tx", "bank", "send"}, chainTarget{NodeURL: "tcp://localhost:26657"})
+	want := []string{"tx", "bank", "send", "--node", "tcp://localhost:26657", "--keyring-backend", "test"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("chainCommandArgs() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,574 @@
+// cmd/dvb/ci.go
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
+	"github.com/altuslabsxyz/devnet-builder/internal/client"
+	"github.com/altuslabsxyz/devnet-builder/internal/config"
+	daemonconfig "github.com/altuslabsxyz/devnet-builder/internal/daemon/config"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/server"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Exit codes of the ci commands.
+const (
+	ciExitFailed      = 1
+	ciExitUsage       = 2
+	ciExitProvision   = 3
+	ciExitTimeout     = 4
+	ciExitInterrupted = 130
+)
+
+const (
+	// ciStateFile is where 'dvb ci up' records the devnet for 'dvb ci down'.
+	ciStateFile = ".dvb-ci.json"
+	// ciEngineStartTimeout is how long an embedded engine may take to listen.
+	ciEngineStartTimeout = 30 * time.Second
+	// ciCleanupTimeout bounds the teardown after a failed 'dvb ci up'.
+	ciCleanupTimeout = 2 * time.Minute
+)
+
+// exitCodeError is an error that exits dvb with a specific code.
+type exitCodeError struct {
+	code int
+	err  error
+}
+
+func (e *exitCodeError) Error() string { return e.err.Error() }
+func (e *exitCodeError) Unwrap() error { return e.err }
+func (e *exitCodeError) ExitCode() int { return e.code }
+
+// withExitCode makes err exit dvb with code.
+func withExitCode(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &exitCodeError{code: code, err: err}
+}
+
+// exitCode returns the code dvb exits with for err: the code set by
+// withExitCode, else the exit status of its apierror code, else 1.
+func exitCode(err error) int {
+	return apierror.ExitCode(err)
+}
+
+// ciState is the state file written by 'dvb ci up'.
+type ciState struct {
+	Devnet    string `json:"devnet"`
+	Namespace string `json:"namespace"`
+	DataDir   string `json:"dataDir"`
+}
+
+// ciEndpoints is the machine-readable output of 'dvb ci up'.
+type ciEndpoints struct {
+	Devnet     string        `json:"devnet"`
+	Namespace  string        `json:"namespace"`
+	ChainID    string        `json:"chainId"`
+	EVMChainID int64         `json:"evmChainId,omitempty"`
+	Nodes      []ciNode      `json:"nodes"`
+	Keys       []exportedKey `json:"keys"`
+}
+
+// ciNode is a node and the endpoints it is reached on.
+type ciNode struct {
+	Index  int    `json:"index"`
+	Role   string `json:"role"`
+	RPC    string `json:"rpc"`
+	REST   string `json:"rest"`
+	GRPC   string `json:"grpc"`
+	P2P    string `json:"p2p"`
+	EVMRPC string `json:"evmRpc,omitempty"`
+	EVMWS  string `json:"evmWs,omitempty"`
+}
+
+// buildCIEndpoints assembles the endpoints and keys of a running devnet.
+func buildCIEndpoints(namespace, devnetName string, nodes []*v1.Node, keys *v1.ExportKeysResponse) ciEndpoints {
+	out := ciEndpoints{
+		Devnet:     devnetName,
+		Namespace:  namespace,
+		ChainID:    keys.GetChainId(),
+		EVMChainID: keys.GetEvmChainId(),
+		Nodes:      make([]ciNode, 0, len(nodes)),
+		Keys:       make([]exportedKey, 0, len(keys.GetKeys())),
+	}
+	for _, n := range nodes {
+		host := nodeHost(n)
+		ports := nodePorts(n)
+		node := ciNode{
+			Index: int(n.GetMetadata().GetIndex()),
+			Role:  n.GetSpec().GetRole(),
+			RPC:   "http://" + hostPort(host, ports.RPC),
+			REST:  "http://" + hostPort(host, ports.API),
+			GRPC:  hostPort(host, ports.GRPC),
+			P2P:   hostPort(host, ports.P2P),
+		}
+		if keys.GetEvm() {
+			node.EVMRPC = "http://" + hostPort(host, ports.EVMRPC)
+			node.EVMWS = "ws://" + hostPort(host, ports.EVMWS)
+		}
+		out.Nodes = append(out.Nodes, node)
+	}
+	for _, k := range keys.GetKeys() {
+		out.Keys = append(out.Keys, exportedKey{
+			Name:       k.Name,
+			Role:       k.Role,
+			Index:      k.Index,
+			Address:    k.Address,
+			EVMAddress: k.EvmAddress,
+			Mnemonic:   k.Mnemonic,
+			HDPath:     k.HdPath,
+			PrivateKey: k.PrivateKey,
+		})
+	}
+	return out
+}
+
+// ciEngine is the daemon a ci command talks to: the running devnetd, or a
+// server embedded in the command when there is none.
+type ciEngine struct {
+	client   *client.Client
+	embedded bool
+	cancel   context.CancelFunc
+	done     chan error
+}
+
+// startCIEngine connects to the daemon of dataDir, starting an embedded one
+// if it is not running. The embedded server logs to daemon.log only.
+func startCIEngine(ctx context.Context, dataDir string) (*ciEngine, error) {
+	cfg, err := daemonconfig.NewLoader(dataDir, "").Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load daemon config: %w", err)
+	}
+	if err := daemonconfig.Validate(cfg); err != nil {
+		return nil, err
+	}
+
+	if client.IsDaemonRunningAt(cfg.Server.Socket) {
+		c, err := client.NewWithSocket(cfg.Server.Socket)
+		if err != nil {
+			return nil, err
+		}
+		return &ciEngine{client: c}, nil
+	}
+
+	srv, err := server.New(ciServerConfig(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to start engine: %w", err)
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	e := &ciEngine{embedded: true, cancel: cancel, done: make(chan error, 1)}
+	go func() {
+		e.done <- srv.Run(runCtx)
+	}()
+
+	startCtx, startCancel := context.WithTimeout(ctx, ciEngineStartTimeout)
+	defer startCancel()
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for !client.IsDaemonRunningAt(cfg.Server.Socket) {
+		select {
+		case err := <-e.done:
+			cancel()
+			if err == nil {
+				err = errors.New("engine exited")
+			}
+			return nil, fmt.Errorf("failed to start engine: %w", err)
+		case <-startCtx.Done():
+			e.Close()
+			return nil, fmt.Errorf("engine did not start within %s", ciEngineStartTimeout)
+		case <-ticker.C:
+		}
+	}
+
+	c, err := client.NewWithSocket(cfg.Server.Socket)
+	if err != nil {
+		e.Close()
+		return nil, err
+	}
+	e.client = c
+	return e, nil
+}
+
+// ciServerConfig returns the config of an embedded engine. The engine stops
+// after each command, so it always detaches from the nodes: stopping them
+// on shutdown would stop the devnet 'dvb ci up' just started.
+func ciServerConfig(cfg *daemonconfig.Config) *server.Config {
+	serverCfg := server.ConfigFrom(cfg)
+	serverCfg.LogOutput = io.Discard
+	serverCfg.IgnoreSignals = true
+	serverCfg.StopNodesOnShutdown = false
+	return serverCfg
+}
+
+// Close disconnects from the engine and stops it if it is embedded. Nodes
+// of an embedded engine keep running and are picked up by the next engine.
+func (e *ciEngine) Close() error {
+	if e.client != nil {
+		e.client.Close()
+	}
+	if !e.embedded {
+		return nil
+	}
+	e.cancel()
+	return <-e.done
+}
+
+// ciTeardownClient is the subset of the daemon client used to tear down a
+// devnet.
+type ciTeardownClient interface {
+	StopDevnet(ctx context.Context, namespace, name string) (*v1.Devnet, error)
+	ListNodes(ctx context.Context, namespace, devnetName string) ([]*v1.Node, error)
+	DeleteDevnet(ctx context.Context, namespace, name string) error
+}
+
+// teardownCIDevnet stops the nodes of a devnet, waits for them to exit and
+// deletes the devnet. Deleting alone would leave the node processes running.
+// A devnet that no longer exists is already torn down.
+func teardownCIDevnet(ctx context.Context, c ciTeardownClient, namespace, devnetName string, pollInterval time.Duration) error {
+	if _, err := c.StopDevnet(ctx, namespace, devnetName); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		return fmt.Errorf("failed to stop devnet: %w", err)
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		nodes, err := c.ListNodes(ctx, namespace, devnetName)
+		if err != nil {
+			return fmt.Errorf("failed to list nodes: %w", err)
+		}
+		running := 0
+		for _, n := range nodes {
+			switch n.GetStatus().GetPhase() {
+			case types.NodePhaseStopped, types.NodePhaseCrashed, types.NodePhasePending:
+			default:
+				running++
+			}
+		}
+		if running == 0 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for %d nodes to stop", running)
+		case <-ticker.C:
+		}
+	}
+
+	if err := c.DeleteDevnet(ctx, namespace, devnetName); err != nil && status.Code(err) != codes.NotFound {
+		return fmt.Errorf("failed to delete devnet: %w", err)
+	}
+	return nil
+}
+
+// ciFailure gives err the exit code of why ctx ended, or code if it did
+// not: signals interrupt, the --timeout deadline times out.
+func ciFailure(sigCtx, ctx context.Context, timeout time.Duration, code int, err error) error {
+	switch {
+	case sigCtx.Err() != nil:
+		return withExitCode(ciExitInterrupted, errors.New("interrupted"))
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return withExitCode(ciExitTimeout, fmt.Errorf("timed out after %s: %w", timeout, err))
+	}
+	return withExitCode(code, err)
+}
+
+func writeCIState(path string, state ciState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+func readCIState(path string) (ciState, error) {
+	var state ciState
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return state, err
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return state, fmt.Errorf("invalid state file %s: %w", path, err)
+	}
+	return state, nil
+}
+
+func newCICmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ci",
+		Short: "Run ephemeral devnets in CI pipelines",
+		Long: `Bring a devnet up and down in a CI job, without prompts and without a
+running daemon: when devnetd is not running, the ci commands run the engine
+themselves for the length of the command.
+
+Progress goes to stderr; machine-readable output goes to stdout or --output.
+
+Exit codes:
+  0    Success
+  1    Error
+  2    Invalid usage or spec
+  3    Provisioning failed
+  4    Timed out
+  130  Interrupted`,
+	}
+	cmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return withExitCode(ciExitUsage, err)
+	})
+
+	cmd.AddCommand(newCIUpCmd(), newCIDownCmd())
+	return cmd
+}
+
+// ciUpOptions holds options for 'dvb ci up'.
+type ciUpOptions struct {
+	file          string
+	name          string
+	output        string
+	state         string
+	dataDir       string
+	timeout       time.Duration
+	keepOnFailure bool
+}
+
+func newCIUpCmd() *cobra.Command {
+	opts := &ciUpOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "up -f <spec.yaml>",
+		Short: "Provision a devnet and print its endpoints and keys",
+		Long: `Provision a devnet from a spec file and wait until it runs, then write its
+endpoints and keys as JSON to --output (stdout by default).
+
+If provisioning fails, times out or is interrupted, the devnet is torn down
+unless --keep-on-failure is set. The devnet is recorded in a state file for
+'dvb ci down'. Nodes keep running after the command exits.
+
+Examples:
+  # Bring up a devnet for an integration test job
+  dvb ci up -f devnet.yaml --output endpoints.json --timeout 10m
+
+  # Use an isolated data directory
+  dvb ci up -f devnet.yaml --data-dir "$RUNNER_TEMP/dvb" -o endpoints.json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCIUp(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.file, "file", "f", "", "Devnet spec file (required)")
+	cmd.Flags().StringVar(&opts.name, "name", "", "Devnet name (overrides the spec)")
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "", "File to write endpoints and keys to (default: stdout)")
+	cmd.Flags().StringVar(&opts.state, "state", ciStateFile, "State file for 'dvb ci down'")
+	cmd.Flags().StringVar(&opts.dataDir, "data-dir", "", "Daemon data directory (default: ~/.devnet-builder)")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", 15*time.Minute, "How long provisioning may take")
+	cmd.Flags().BoolVar(&opts.keepOnFailure, "keep-on-failure", false, "Keep the devnet when provisioning fails, for debugging")
+
+	return cmd
+}
+
+func runCIUp(parent context.Context, opts *ciUpOptions) error {
+	if opts.file == "" {
+		return withExitCode(ciExitUsage, errors.New("--file is required"))
+	}
+	devnets, err := config.NewYAMLLoader().LoadFile(opts.file)
+	if err != nil {
+		return withExitCode(ciExitUsage, fmt.Errorf("failed to load spec: %w", err))
+	}
+	if len(devnets) != 1 {
+		return withExitCode(ciExitUsage, fmt.Errorf("spec must define exactly one devnet, found %d", len(devnets)))
+	}
+	spec := devnets[0].ToProto()
+	name := spec.GetMetadata().GetName()
+	if opts.name != "" {
+		name = opts.name
+	}
+	if name == "" {
+		name = generateDevnetName("")
+	}
+	namespace := spec.GetMetadata().GetNamespace()
+
+	sigCtx, stopSignals := signal.NotifyContext(parent, os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+	ctx, cancel := context.WithTimeout(sigCtx, opts.timeout)
+	defer cancel()
+
+	engine, err := startCIEngine(ctx, opts.dataDir)
+	if err != nil {
+		return ciFailure(sigCtx, ctx, opts.timeout, ciExitFailed, err)
+	}
+	defer engine.Close()
+	c := engine.client
+
+	fmt.Fprintf(os.Stderr, "Provisioning devnet %q...\n", name)
+	devnet, err := c.CreateDevnet(ctx, namespace, name, spec.GetSpec(), spec.GetMetadata().GetLabels())
+	if err != nil {
+		return ciFailure(sigCtx, ctx, opts.timeout, ciExitProvision, fmt.Errorf("failed to create devnet: %w", err))
+	}
+	if ns := devnet.GetMetadata().GetNamespace(); ns != "" {
+		namespace = ns
+	}
+
+	dataDir := opts.dataDir
+	if dataDir == "" {
+		dataDir = daemonconfig.DefaultDataDir()
+	}
+	if abs, err := filepath.Abs(dataDir); err == nil {
+		dataDir = abs
+	}
+	if err := writeCIState(opts.state, ciState{Devnet: name, Namespace: namespace, DataDir: dataDir}); err != nil {
+		err = fmt.Errorf("failed to write state file: %w", err)
+		return cleanupCIUp(sigCtx, ctx, c, namespace, name, opts, ciFailure(sigCtx, ctx, opts.timeout, ciExitFailed, err))
+	}
+
+	if err := pollProvisionStatusWithClient(ctx, namespace, name, c, 2*time.Second); err != nil {
+		return cleanupCIUp(sigCtx, ctx, c, namespace, name, opts, ciFailure(sigCtx, ctx, opts.timeout, ciExitProvision, err))
+	}
+
+	nodes, err := c.ListNodes(ctx, namespace, name)
+	if err == nil {
+		var keys *v1.ExportKeysResponse
+		keys, err = c.ExportKeys(ctx, &v1.ExportKeysRequest{DevnetName: name, Namespace: namespace})
+		if err == nil {
+			err = writeCIEndpoints(opts.output, buildCIEndpoints(namespace, name, nodes, keys))
+		}
+	}
+	if err != nil {
+		return cleanupCIUp(sigCtx, ctx, c, namespace, name, opts, ciFailure(sigCtx, ctx, opts.timeout, ciExitFailed, err))
+	}
+
+	fmt.Fprintf(os.Stderr, "Devnet %q is running. Tear it down with: dvb ci down --state %s\n", name, opts.state)
+	return nil
+}
+
+// cleanupCIUp tears down the devnet of a failed 'dvb ci up' and returns
+// the failure.
+func cleanupCIUp(sigCtx, ctx context.Context, c *client.Client, namespace, name string, opts *ciUpOptions, failure error) error {
+	if opts.keepOnFailure {
+		fmt.Fprintf(os.Stderr, "Keeping devnet %q (--keep-on-failure)\n", name)
+		return failure
+	}
+
+	fmt.Fprintf(os.Stderr, "Tearing down devnet %q...\n", name)
+	cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), ciCleanupTimeout)
+	defer cancel()
+	if err := teardownCIDevnet(cleanupCtx, c, namespace, name, time.Second); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to tear down devnet %q: %v\n", name, err)
+		return failure
+	}
+	os.Remove(opts.state)
+	return failure
+}
+
+func writeCIEndpoints(path string, endpoints ciEndpoints) error {
+	data, err := json.MarshalIndent(endpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if path == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	// The output holds private keys
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+	return nil
+}
+
+// ciDownOptions holds options for 'dvb ci down'.
+type ciDownOptions struct {
+	namespace string
+	state     string
+	dataDir   string
+	timeout   time.Duration
+}
+
+func newCIDownCmd() *cobra.Command {
+	opts := &ciDownOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "down [devnet]",
+		Short: "Tear down a devnet brought up by 'dvb ci up'",
+		Long: `Stop the nodes of a devnet brought up by 'dvb ci up' and delete it. The
+devnet is read from the state file unless it is named. Tearing down a devnet
+that no longer exists succeeds, so the command is safe in cleanup steps that
+always run.
+
+Examples:
+  # Tear down the devnet of this job
+  dvb ci down
+
+  # Tear down a devnet by name
+  dvb ci down my-devnet --data-dir "$RUNNER_TEMP/dvb"`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCIDown(cmd.Context(), args, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVar(&opts.state, "state", ciStateFile, "State file written by 'dvb ci up'")
+	cmd.Flags().StringVar(&opts.dataDir, "data-dir", "", "Daemon data directory (default: from the state file)")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", 5*time.Minute, "How long the teardown may take")
+
+	return cmd
+}
+
+func runCIDown(parent context.Context, args []string, opts *ciDownOptions) error {
+	var state ciState
+	if len(args) > 0 {
+		state = ciState{Devnet: args[0], Namespace: opts.namespace}
+	} else {
+		var err error
+		state, err = readCIState(opts.state)
+		if errors.Is(err, os.ErrNotExist) {
+			return withExitCode(ciExitUsage, fmt.Errorf("no devnet named and no state file %s", opts.state))
+		}
+		if err != nil {
+			return withExitCode(ciExitUsage, err)
+		}
+	}
+	if opts.dataDir != "" {
+		state.DataDir = opts.dataDir
+	}
+
+	sigCtx, stopSignals := signal.NotifyContext(parent, os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+	ctx, cancel := context.WithTimeout(sigCtx, opts.timeout)
+	defer cancel()
+
+	engine, err := startCIEngine(ctx, state.DataDir)
+	if err != nil {
+		return ciFailure(sigCtx, ctx, opts.timeout, ciExitFailed, err)
+	}
+	defer engine.Close()
+
+	fmt.Fprintf(os.Stderr, "Tearing down devnet %q...\n", state.Devnet)
+	if err := teardownCIDevnet(ctx, engine.client, state.Namespace, state.Devnet, time.Second); err != nil {
+		return ciFailure(sigCtx, ctx, opts.timeout, ciExitFailed, err)
+	}
+	if len(args) == 0 {
+		os.Remove(opts.state)
+	}
+	fmt.Fprintf(os.Stderr, "Devnet %q torn down\n", state.Devnet)
+	return nil
+}
@@ -0,0 +1,149 @@
+// cmd/dvb/ci_test.go
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
+	daemonconfig "github.com/altuslabsxyz/devnet-builder/internal/daemon/config"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCIEndpoints(t *testing.T) {
+	nodes := []*v1.Node{
+		{
+			Metadata: &v1.NodeMetadata{Index: 0},
+			Spec: &v1.NodeSpec{
+				Role:    "validator",
+				Address: "10.77.0.2",
+				Ports:   &v1.NetworkPortConfig{P2P: 26656, Rpc: 26657, Api: 1317, Grpc: 9090, EvmRpc: 8545, EvmSocket: 8546},
+			},
+		},
+	}
+	keys := &v1.ExportKeysResponse{
+		ChainId:    "devnet-1",
+		Evm:        true,
+		EvmChainId: 262144,
+		Keys: []*v1.AccountKey{
+			{Name: "validator0", Role: "validator", Address: "cosmos1abc", EvmAddress: "0xabc", PrivateKey: "deadbeef"},
+		},
+	}
+
+	out := buildCIEndpoints("default", "ci", nodes, keys)
+
+	assert.Equal(t, "ci", out.Devnet)
+	assert.Equal(t, "devnet-1", out.ChainID)
+	assert.Equal(t, int64(262144), out.EVMChainID)
+	require.Len(t, out.Nodes, 1)
+	assert.Equal(t, ciNode{
+		Index:  0,
+		Role:   "validator",
+		RPC:    "http://10.77.0.2:26657",
+		REST:   "http://10.77.0.2:1317",
+		GRPC:   "10.77.0.2:9090",
+		P2P:    "10.77.0.2:26656",
+		EVMRPC: "http://10.77.0.2:8545",
+		EVMWS:  "ws://10.77.0.2:8546",
+	}, out.Nodes[0])
+	require.Len(t, out.Keys, 1)
+	assert.Equal(t, "deadbeef", out.Keys[0].PrivateKey)
+
+	keys.Evm = false
+	out = buildCIEndpoints("default", "ci", nodes, keys)
+	assert.Empty(t, out.Nodes[0].EVMRPC)
+}
+
+// fakeTeardownClient reports nodes stopped after stopAfter polls, and
+// fails to stop the devnet with stopErr if set.
+type fakeTeardownClient struct {
+	stopErr   error
+	stopAfter int
+	polls     int
+	deleted   bool
+}
+
+func (f *fakeTeardownClient) StopDevnet(ctx context.Context, namespace, name string) (*v1.Devnet, error) {
+	if f.stopErr != nil {
+		return nil, f.stopErr
+	}
+	return &v1.Devnet{}, nil
+}
+
+func (f *fakeTeardownClient) ListNodes(ctx context.Context, namespace, devnetName string) ([]*v1.Node, error) {
+	f.polls++
+	phase := types.NodePhaseStopping
+	if f.polls > f.stopAfter {
+		phase = types.NodePhaseStopped
+	}
+	return []*v1.Node{{Status: &v1.NodeStatus{Phase: phase}}}, nil
+}
+
+func (f *fakeTeardownClient) DeleteDevnet(ctx context.Context, namespace, name string) error {
+	f.deleted = true
+	return nil
+}
+
+func TestTeardownCIDevnet(t *testing.T) {
+	t.Run("waits for nodes before deleting", func(t *testing.T) {
+		c := &fakeTeardownClient{stopAfter: 2}
+		require.NoError(t, teardownCIDevnet(context.Background(), c, "default", "ci", time.Millisecond))
+		assert.Equal(t, 3, c.polls)
+		assert.True(t, c.deleted)
+	})
+
+	t.Run("missing devnet", func(t *testing.T) {
+		c := &fakeTeardownClient{stopErr: &apierror.Error{Code: apierror.NotFound, Message: `not found: devnet "ci" not found`}}
+		require.NoError(t, teardownCIDevnet(context.Background(), c, "default", "ci", time.Millisecond))
+		assert.False(t, c.deleted)
+	})
+
+	t.Run("other error mentioning not found", func(t *testing.T) {
+		c := &fakeTeardownClient{stopErr: &apierror.Error{Code: apierror.Internal, Message: "plugin not found"}}
+		require.Error(t, teardownCIDevnet(context.Background(), c, "default", "ci", time.Millisecond))
+		assert.False(t, c.deleted)
+	})
+
+	t.Run("nodes never stop", func(t *testing.T) {
+		c := &fakeTeardownClient{stopAfter: 1 << 30}
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		err := teardownCIDevnet(ctx, c, "default", "ci", time.Millisecond)
+		require.Error(t, err)
+		assert.False(t, c.deleted, "nodes still running must not be orphaned")
+	})
+}
+
+func TestCIServerConfig(t *testing.T) {
+	cfg := daemonconfig.DefaultConfig()
+	cfg.Server.StopNodesOnShutdown = true
+
+	serverCfg := ciServerConfig(cfg)
+	assert.False(t, serverCfg.StopNodesOnShutdown, "the embedded engine must leave the devnet running")
+	assert.True(t, serverCfg.IgnoreSignals)
+}
+
+func TestCIFailureExitCodes(t *testing.T) {
+	failure := errors.New("provisioning failed")
+
+	ctx := context.Background()
+	assert.Equal(t, ciExitProvision, exitCode(ciFailure(ctx, ctx, time.Minute, ciExitProvision, failure)))
+
+	timedOut, cancel := context.WithTimeout(ctx, 0)
+	defer cancel()
+	<-timedOut.Done()
+	assert.Equal(t, ciExitTimeout, exitCode(ciFailure(ctx, timedOut, time.Minute, ciExitProvision, failure)))
+
+	interrupted, cancelSig := context.WithCancel(ctx)
+	cancelSig()
+	assert.Equal(t, ciExitInterrupted, exitCode(ciFailure(interrupted, interrupted, time.Minute, ciExitProvision, failure)))
+
+	assert.Equal(t, 1, exitCode(failure))
+	assert.Equal(t, ciExitUsage, exitCode(fmt.Errorf("wrapped: %w", withExitCode(ciExitUsage, failure))))
+}
@@ -2,9 +2,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"time"
 
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/dvbcontext"
 	"github.com/spf13/cobra"
 )
 
@@ -55,3 +59,122 @@ PowerShell:
 
 	return cmd
 }
+
+// completionTimeout bounds the daemon calls made while completing, so a slow
+// daemon never hangs the shell.
+const completionTimeout = 2 * time.Second
+
+// fallbackNetworks are completed for --network when the daemon is not
+// running; they are the networks built into dvb.
+var fallbackNetworks = []string{"stable", "cosmos", "gaia", "osmosis", "evmos"}
+
+// completeDevnetNames completes the single [devnet] argument with the
+// devnets known to the daemon.
+func completeDevnetNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return devnetCompletions(cmd), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeNodeArgs completes the [devnet-name] [node-name] arguments of node
+// subcommands. The first argument may also be a node of the devnet selected
+// with 'dvb use'.
+func completeNodeArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	switch len(args) {
+	case 0:
+		comps := devnetCompletions(cmd)
+		if currentContext != nil {
+			comps = append(comps, nodeCompletions(cmd, currentContext.Namespace, currentContext.Devnet)...)
+		}
+		return comps, cobra.ShellCompDirectiveNoFileComp
+	case 1:
+		if isNodeName(args[0]) {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		ns, _ := cmd.Flags().GetString("namespace")
+		return nodeCompletions(cmd, ns, args[0]), cobra.ShellCompDirectiveNoFileComp
+	default:
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeNetworkNames completes network plugin names, for --network flags
+// and plugin arguments.
+func completeNetworkNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if daemonClient == nil {
+		return fallbackNetworks, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), completionTimeout)
+	defer cancel()
+
+	networks, err := daemonClient.ListNetworks(ctx)
+	if err != nil {
+		return fallbackNetworks, cobra.ShellCompDirectiveNoFileComp
+	}
+	return networkCompletionEntries(networks), cobra.ShellCompDirectiveNoFileComp
+}
+
+// devnetCompletions lists the devnets in the command's --namespace.
+func devnetCompletions(cmd *cobra.Command) []string {
+	if daemonClient == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), completionTimeout)
+	defer cancel()
+
+	ns, _ := cmd.Flags().GetString("namespace")
+	devnets, err := daemonClient.ListDevnets(ctx, ns)
+	if err != nil {
+		return nil
+	}
+	return devnetCompletionEntries(devnets)
+}
+
+// nodeCompletions lists the nodes of a devnet.
+func nodeCompletions(cmd *cobra.Command, namespace, devnet string) []string {
+	if daemonClient == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), completionTimeout)
+	defer cancel()
+
+	nodes, err := daemonClient.ListNodes(ctx, namespace, devnet)
+	if err != nil {
+		return nil
+	}
+	return nodeCompletionEntries(nodes)
+}
+
+// devnetCompletionEntries formats devnets as completions described by their
+// phase.
+func devnetCompletionEntries(devnets []*v1.Devnet) []string {
+	comps := make([]string, 0, len(devnets))
+	for _, d := range devnets {
+		comps = append(comps, fmt.Sprintf("%s\t%s", d.GetMetadata().GetName(), d.GetStatus().GetPhase()))
+	}
+	return comps
+}
+
+// nodeCompletionEntries formats nodes as completions described by their
+// index and phase.
+func nodeCompletionEntries(nodes []*v1.Node) []string {
+	comps := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		comps = append(comps, fmt.Sprintf("%s\tnode %d, %s", dvbcontext.NodeName(n), n.GetMetadata().GetIndex(), n.GetStatus().GetPhase()))
+	}
+	return comps
+}
+
+// networkCompletionEntries formats networks as completions described by
+// their display name.
+func networkCompletionEntries(networks []*v1.NetworkSummary) []string {
+	comps := make([]string, 0, len(networks))
+	for _, n := range networks {
+		comps = append(comps, fmt.Sprintf("%s\t%s", n.GetName(), n.GetDisplayName()))
+	}
+	return comps
+}
@@ -0,0 +1,61 @@
+// cmd/dvb/completion_test.go
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/dvbcontext"
+	"github.com/spf13/cobra"
+)
+
+func TestCompletionEntries(t *testing.T) {
+	devnets := []*v1.Devnet{
+		{Metadata: &v1.DevnetMetadata{Name: "alpha"}, Status: &v1.DevnetStatus{Phase: "Running"}},
+		{Metadata: &v1.DevnetMetadata{Name: "beta"}, Status: &v1.DevnetStatus{Phase: "Stopped"}},
+	}
+	if got, want := devnetCompletionEntries(devnets), []string{"alpha\tRunning", "beta\tStopped"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("devnetCompletionEntries() = %q, want %q", got, want)
+	}
+
+	nodes := []*v1.Node{
+		{Metadata: &v1.NodeMetadata{Index: 0}, Spec: &v1.NodeSpec{Role: "validator"}, Status: &v1.NodeStatus{Phase: "Running"}},
+		{Metadata: &v1.NodeMetadata{Index: 1}, Spec: &v1.NodeSpec{Role: "fullnode"}, Status: &v1.NodeStatus{Phase: "Stopped"}},
+	}
+	if got, want := nodeCompletionEntries(nodes), []string{"validator-0\tnode 0, Running", "fullnode-1\tnode 1, Stopped"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("nodeCompletionEntries() = %q, want %q", got, want)
+	}
+
+	networks := []*v1.NetworkSummary{{Name: "stable", DisplayName: "Stable"}}
+	if got, want := networkCompletionEntries(networks), []string{"stable\tStable"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("networkCompletionEntries() = %q, want %q", got, want)
+	}
+}
+
+func TestCompletion_WithoutDaemon(t *testing.T) {
+	oldClient, oldContext := daemonClient, currentContext
+	daemonClient, currentContext = nil, &dvbcontext.Context{Namespace: "default", Devnet: "alpha"}
+	t.Cleanup(func() { daemonClient, currentContext = oldClient, oldContext })
+
+	cmd := &cobra.Command{Use: "get"}
+	cmd.Flags().StringP("namespace", "n", "", "")
+
+	comps, directive := completeNodeArgs(cmd, nil, "")
+	if len(comps) != 0 || directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("completeNodeArgs() = %q, %v; want no completions", comps, directive)
+	}
+
+	comps, _ = completeNetworkNames(cmd, nil, "")
+	if !reflect.DeepEqual(comps, fallbackNetworks) {
+		t.Errorf("completeNetworkNames() = %q, want %q", comps, fallbackNetworks)
+	}
+}
+
+func TestCompleteNodeArgs_SecondArgIsNodeName(t *testing.T) {
+	cmd := &cobra.Command{Use: "get"}
+	comps, directive := completeNodeArgs(cmd, []string{"alpha", "validator-0"}, "")
+	if len(comps) != 0 || directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("completeNodeArgs() = %q, %v; want no completions", comps, directive)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/client"
+	"github.com/altuslabsxyz/devnet-builder/internal/dvbcontext"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
@@ -20,19 +21,34 @@ Configuration is stored in ~/.dvb/config.yaml and includes:
   - server:    Remote devnetd server address (optional)
   - api-key:   API key for authentication (required for remote)
   - namespace: Default namespace for commands
+  - autostart: Start devnetd in the background when it is not running
+
+Named contexts, like kubeconfig contexts, each hold a daemon endpoint, a
+namespace and a devnet. While one is in use, dvb talks to its daemon instead
+of the server above.
 
 Examples:
   dvb config set server devnetd.example.com:9000
   dvb config set api-key devnet_xxx
   dvb config set namespace team-a
   dvb config get server
-  dvb config list`,
+  dvb config list
+
+  # Named contexts
+  dvb config set-context staging --server devnetd.example.com:9000 --api-key devnet_xxx
+  dvb config use-context staging
+  dvb config get-contexts`,
 	}
 
 	cmd.AddCommand(
 		newConfigGetCmd(),
 		newConfigSetCmd(),
 		newConfigListCmd(),
+		newConfigGetContextsCmd(),
+		newConfigUseContextCmd(),
+		newConfigCurrentContextCmd(),
+		newConfigSetContextCmd(),
+		newConfigDeleteContextCmd(),
 	)
 
 	return cmd
@@ -48,11 +64,13 @@ Supported keys:
   server    - Remote devnetd server address (e.g., devnetd.example.com:9000)
   api-key   - API key for authentication
   namespace - Default namespace for commands
+  autostart - Start devnetd in the background when it is not running (true/false)
 
 Examples:
   dvb config set server devnetd.example.com:9000
   dvb config set api-key devnet_abc123...
-  dvb config set namespace team-a`,
+  dvb config set namespace team-a
+  dvb config set autostart true`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			key := args[0]
@@ -97,6 +115,7 @@ Supported keys:
   server    - Remote devnetd server address
   api-key   - API key for authentication (masked for security)
   namespace - Default namespace for commands
+  autostart - Start devnetd in the background when it is not running
 
 Examples:
   dvb config get server
@@ -107,7 +126,7 @@ Examples:
 			key := args[0]
 
 			// Validate key
-			validKeys := []string{"server", "api-key", "namespace"}
+			validKeys := []string{"server", "api-key", "namespace", "autostart"}
 			valid := false
 			for _, k := range validKeys {
 				if k == key {
@@ -184,6 +203,15 @@ API keys are masked for security.`,
 			}
 			fmt.Printf("  namespace: %s\n", namespace)
 
+			// Autostart
+			fmt.Printf("  autostart: %v\n", cfg.AutoStart)
+
+			// A named context in use overrides the server
+			if named, err := dvbcontext.CurrentNamed(); err == nil && named != nil {
+				fmt.Println()
+				fmt.Printf("Context %q is in use; see 'dvb config get-contexts'.\n", named.Name)
+			}
+
 			return nil
 		},
 	}
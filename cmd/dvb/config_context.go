@@ -0,0 +1,239 @@
+// cmd/dvb/config_context.go
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/dvbcontext"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newConfigGetContextsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-contexts",
+		Short: "List named contexts",
+		Long: `List the named contexts. The context in use is marked with *.
+
+API keys are not shown.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			contexts, err := dvbcontext.LoadContexts()
+			if err != nil {
+				return err
+			}
+			if len(contexts.Contexts) == 0 {
+				fmt.Println("No contexts defined. Create one with 'dvb config set-context <name>'.")
+				return nil
+			}
+			printContexts(os.Stdout, contexts)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// printContexts writes the named contexts as a table.
+func printContexts(out io.Writer, contexts *dvbcontext.Contexts) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CURRENT\tNAME\tSERVER\tNAMESPACE\tDEVNET")
+	for _, c := range contexts.Contexts {
+		current := ""
+		if c.Name == contexts.CurrentContext {
+			current = "*"
+		}
+		server := c.Server
+		if server == "" {
+			server = "(local)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", current, c.Name, server, orDash(c.Namespace), orDash(c.Devnet))
+	}
+	w.Flush()
+}
+
+// orDash returns s, or "-" if it is empty.
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func newConfigUseContextCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "use-context <name>",
+		Short: "Switch to a named context",
+		Long: `Switch to a named context. Later commands talk to the daemon of the
+context and default to its devnet and namespace.
+
+Use "-" to stop using named contexts and go back to the context set by
+'dvb use' and the server in ~/.dvb/config.yaml.
+
+Examples:
+  dvb config use-context staging
+  dvb config use-context -`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeContextNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if name == "-" {
+				name = ""
+			}
+
+			contexts, err := dvbcontext.LoadContexts()
+			if err != nil {
+				return err
+			}
+			if err := contexts.Use(name); err != nil {
+				return err
+			}
+			if err := contexts.Save(); err != nil {
+				return err
+			}
+
+			if name == "" {
+				color.Yellow("No named context in use")
+				return nil
+			}
+			color.Green("Switched to context %q", name)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newConfigCurrentContextCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-context",
+		Short: "Show the named context in use",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			named, err := dvbcontext.CurrentNamed()
+			if err != nil {
+				return err
+			}
+			if named == nil {
+				return fmt.Errorf("no named context in use")
+			}
+			fmt.Println(named.Name)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newConfigSetContextCmd() *cobra.Command {
+	var (
+		server    string
+		apiKey    string
+		namespace string
+		devnet    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "set-context <name>",
+		Short: "Create or update a named context",
+		Long: `Create a named context, or update the fields of an existing one that are
+given as flags. A context without a server talks to the local daemon.
+
+Examples:
+  # A context for a shared remote daemon
+  dvb config set-context staging --server devnetd.example.com:9000 --api-key devnet_xxx
+
+  # A local context defaulting to a devnet
+  dvb config set-context local --devnet my-devnet`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeContextNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			contexts, err := dvbcontext.LoadContexts()
+			if err != nil {
+				return err
+			}
+
+			ctx := dvbcontext.NamedContext{Name: args[0]}
+			existing := contexts.Get(args[0])
+			if existing != nil {
+				ctx = *existing
+			}
+			flags := cmd.Flags()
+			if flags.Changed("server") {
+				ctx.Server = server
+			}
+			if flags.Changed("api-key") {
+				ctx.APIKey = apiKey
+			}
+			if flags.Changed("namespace") {
+				ctx.Namespace = namespace
+			}
+			if flags.Changed("devnet") {
+				ctx.Devnet = devnet
+			}
+			contexts.Set(ctx)
+			if err := contexts.Save(); err != nil {
+				return err
+			}
+
+			if existing != nil {
+				color.Green("Updated context %q", ctx.Name)
+			} else {
+				color.Green("Created context %q", ctx.Name)
+				fmt.Printf("Switch to it with: dvb config use-context %s\n", ctx.Name)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&server, "server", "", "Remote devnetd server address (empty for the local daemon)")
+	cmd.Flags().StringVar(&apiKey, "api-key", "", "API key for the server")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Default namespace")
+	cmd.Flags().StringVar(&devnet, "devnet", "", "Default devnet")
+
+	return cmd
+}
+
+func newConfigDeleteContextCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "delete-context <name>",
+		Short:             "Delete a named context",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeContextNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			contexts, err := dvbcontext.LoadContexts()
+			if err != nil {
+				return err
+			}
+			if !contexts.Delete(args[0]) {
+				return fmt.Errorf("context %q not found", args[0])
+			}
+			if err := contexts.Save(); err != nil {
+				return err
+			}
+			color.Green("Deleted context %q", args[0])
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// completeContextNames completes the names of named contexts.
+func completeContextNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	contexts, err := dvbcontext.LoadContexts()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	names := make([]string, 0, len(contexts.Contexts))
+	for _, c := range contexts.Contexts {
+		names = append(names, c.Name)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
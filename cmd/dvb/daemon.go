@@ -18,9 +18,13 @@ func newDaemonCmd() *cobra.Command {
 		Long: `Manage the devnetd daemon.
 
 The daemon runs in the background and manages devnet lifecycle.
-Use these subcommands to check status, view logs, and manage plugins.
+Use these subcommands to start and stop it, check status, view logs, and
+manage plugins.
 
 Examples:
+  # Start the daemon in the background (installs a launchd/systemd service)
+  dvb daemon start
+
   # Check daemon status and connectivity
   dvb daemon status
 
@@ -35,6 +39,8 @@ Examples:
 	}
 
 	cmd.AddCommand(
+		newDaemonStartCmd(),
+		newDaemonStopCmd(),
 		newDaemonStatusCmd(),
 		newDaemonLogsCmd(),
 		newDaemonWhoAmICmd(),
@@ -101,7 +107,7 @@ func runDaemonStatusLocal(cmd *cobra.Command, socketPath string) error {
 		color.Yellow("○ Daemon is not running")
 		fmt.Println()
 		fmt.Println("Start the daemon with:")
-		fmt.Println("  devnetd &")
+		fmt.Println("  dvb daemon start")
 		fmt.Println()
 		fmt.Println("Or run in foreground:")
 		fmt.Println("  devnetd")
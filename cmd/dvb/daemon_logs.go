@@ -14,6 +14,8 @@ import (
 	"github.com/fatih/color"
 	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/cobra"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/redact"
 )
 
 // newDaemonLogsCmd creates the 'daemon logs' subcommand.
@@ -57,7 +59,7 @@ Examples:
 			if _, err := os.Stat(logPath); os.IsNotExist(err) {
 				fmt.Fprintf(os.Stderr, "Daemon log file not found: %s\n\n", logPath)
 				fmt.Fprintln(os.Stderr, "The daemon may not be running or hasn't written logs yet.")
-				fmt.Fprintln(os.Stderr, "To start the daemon, run: devnetd")
+				fmt.Fprintln(os.Stderr, "To start the daemon, run: dvb daemon start")
 				fmt.Fprintln(os.Stderr, "")
 				fmt.Fprintln(os.Stderr, "Tip: You can also check daemon status with: dvb daemon status")
 				return nil
@@ -246,6 +248,7 @@ func shouldShowLine(line string, level string) bool {
 
 // printDaemonLogLine formats and prints a daemon log line with colorization.
 func printDaemonLogLine(w io.Writer, line string) {
+	line = redact.String(line)
 	// Colorize based on level
 	if strings.Contains(line, "level=ERROR") || strings.Contains(line, "level=error") {
 		color.New(color.FgRed).Fprintln(w, line)
@@ -0,0 +1,210 @@
+// cmd/dvb/daemon_service.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/client"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/config"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/daemonsvc"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// daemonStartTimeout is how long 'dvb daemon start' waits for the socket.
+const daemonStartTimeout = 15 * time.Second
+
+// newDaemonStartCmd creates the 'daemon start' subcommand.
+func newDaemonStartCmd() *cobra.Command {
+	var devnetdPath string
+
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Start devnetd as a background service",
+		Long: `Start devnetd as a per-user background service (launchd on macOS, systemd
+on Linux), installing the service first if needed. Once installed, the
+daemon also starts at login.
+
+The devnetd binary is looked up on PATH, then next to dvb. To pass daemon
+flags, install the service with: devnetd service install -- <flags>
+
+Examples:
+  # Start the daemon
+  dvb daemon start
+
+  # Use a specific devnetd binary
+  dvb daemon start --devnetd ./build/devnetd`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			socket := client.DefaultSocketPath()
+			if client.IsDaemonRunning() {
+				color.Green("● Daemon is already running")
+				fmt.Printf("  Socket:  %s\n", socket)
+				return nil
+			}
+
+			m, err := daemonsvc.New(config.DefaultDataDir())
+			if err != nil {
+				return fmt.Errorf("%w\nRun the daemon in a terminal instead: devnetd", err)
+			}
+			st, err := m.Status(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if !st.Installed || devnetdPath != "" {
+				binary, err := findDevnetd(devnetdPath)
+				if err != nil {
+					return err
+				}
+				if err := m.Install(cmd.Context(), binary, []string{"--data-dir", config.DefaultDataDir()}); err != nil {
+					return err
+				}
+				fmt.Printf("Installed %s (%s)\n", m.ID(), binary)
+			}
+
+			if err := m.Start(cmd.Context()); err != nil {
+				return fmt.Errorf("failed to start service: %w", err)
+			}
+			if err := daemonsvc.WaitReady(cmd.Context(), socket, daemonStartTimeout); err != nil {
+				return fmt.Errorf("%w; check %s or run: dvb daemon logs", err, m.LogPath())
+			}
+			if c, err := client.NewWithSocket(socket); err == nil {
+				syncGitHubToken(cmd.Context(), c)
+				c.Close()
+			}
+
+			color.Green("● Daemon started")
+			fmt.Printf("  Socket:  %s\n", socket)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&devnetdPath, "devnetd", "", "Path to the devnetd binary (reinstalls the service)")
+
+	return cmd
+}
+
+// newDaemonStopCmd creates the 'daemon stop' subcommand.
+func newDaemonStopCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stop",
+		Short: "Stop the devnetd background service",
+		Long: `Stop the devnetd service started with 'dvb daemon start'. The service stays
+installed and starts again at the next login; remove it with:
+devnetd service uninstall`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := daemonsvc.New(config.DefaultDataDir())
+			if err != nil {
+				return err
+			}
+			if err := m.Stop(cmd.Context()); err != nil {
+				return err
+			}
+			color.Yellow("○ Daemon stopped")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// noAutostartCommands are the command paths, and their subcommands, that
+// never start the daemon even with autostart enabled: they either do not
+// need it or report on whether it is running.
+var noAutostartCommands = []string{
+	"dvb __complete",
+	"dvb __completeNoDesc",
+	"dvb completion",
+	"dvb config",
+	"dvb doctor",
+	"dvb explain",
+	"dvb help",
+	"dvb version",
+}
+
+// maybeAutostart starts the daemon when the client config enables
+// autostart and cmd needs the daemon. It returns an error when autostart is
+// off, so callers fall back to their usual "daemon not running" handling.
+func maybeAutostart(cmd *cobra.Command) (*client.Client, error) {
+	cfg, err := client.LoadConfig()
+	if err != nil || !cfg.AutoStart {
+		return nil, errDaemonNotRunning
+	}
+	path := cmd.CommandPath()
+	for _, skip := range noAutostartCommands {
+		if path == skip || strings.HasPrefix(path, skip+" ") {
+			return nil, errDaemonNotRunning
+		}
+	}
+	c, err := autostartDaemon(cmd.Context())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: failed to autostart devnetd: %v\n", err)
+		return nil, err
+	}
+	return c, nil
+}
+
+// autostartDaemon starts devnetd in the background for 'autostart: true'
+// and returns a client once its socket accepts connections. An installed
+// devnetd service is started through the service manager; otherwise devnetd
+// is spawned as a detached process.
+func autostartDaemon(ctx context.Context) (*client.Client, error) {
+	socket := client.DefaultSocketPath()
+	dataDir := config.DefaultDataDir()
+
+	m, err := daemonsvc.New(dataDir)
+	if err == nil {
+		if st, statusErr := m.Status(ctx); statusErr == nil && st.Installed {
+			fmt.Fprintf(os.Stderr, "devnetd is not running; starting %s\n", m.ID())
+			if err := m.Start(ctx); err != nil {
+				return nil, fmt.Errorf("failed to start service: %w", err)
+			}
+			return waitForDaemon(ctx, socket, m.LogPath())
+		}
+	}
+
+	binary, err := findDevnetd("")
+	if err != nil {
+		return nil, err
+	}
+	logPath := filepath.Join(dataDir, daemonsvc.LogFile)
+	pid, err := daemonsvc.Spawn(binary, []string{"--data-dir", dataDir, "--socket", socket}, logPath)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(os.Stderr, "devnetd is not running; started it in the background (pid %d)\n", pid)
+	return waitForDaemon(ctx, socket, logPath)
+}
+
+// waitForDaemon waits for the daemon socket and connects to it.
+func waitForDaemon(ctx context.Context, socket, logPath string) (*client.Client, error) {
+	if err := daemonsvc.WaitReady(ctx, socket, daemonStartTimeout); err != nil {
+		return nil, fmt.Errorf("%w; check %s", err, logPath)
+	}
+	return client.NewWithSocket(socket)
+}
+
+// findDevnetd returns the devnetd binary to install: the explicit path if
+// given, else devnetd on PATH, else a devnetd next to the running dvb.
+func findDevnetd(explicit string) (string, error) {
+	if explicit != "" {
+		return filepath.Abs(explicit)
+	}
+	if path, err := exec.LookPath("devnetd"); err == nil {
+		return filepath.Abs(path)
+	}
+	if self, err := os.Executable(); err == nil {
+		sibling := filepath.Join(filepath.Dir(self), "devnetd")
+		if _, err := os.Stat(sibling); err == nil {
+			return sibling, nil
+		}
+	}
+	return "", fmt.Errorf("devnetd not found on PATH or next to dvb; pass --devnetd <path>")
+}
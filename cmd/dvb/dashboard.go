@@ -0,0 +1,133 @@
+// cmd/dvb/dashboard.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/tui"
+	"github.com/altuslabsxyz/devnet-builder/internal/tui/views"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+)
+
+// dashboardReconnectDelay is how long the dashboard waits before
+// re-establishing a failed watch stream.
+const dashboardReconnectDelay = 2 * time.Second
+
+func newDashboardCmd() *cobra.Command {
+	var namespace string
+
+	cmd := &cobra.Command{
+		Use:   "dashboard",
+		Short: "Full-screen dashboard of devnets and nodes",
+		Long: `Show a live, full-screen dashboard of devnets, node health, block heights
+and recent events.
+
+The dashboard follows the daemon's watch stream, so changes appear as soon
+as the daemon records them.
+
+Keys:
+  ↑/↓, k/j   Select a devnet
+  s          Start the selected devnet
+  x          Stop the selected devnet
+  l          Show the selected devnet's logs in $PAGER
+  d          Describe the selected devnet in $PAGER
+  q          Quit
+
+Examples:
+  # Watch all devnets
+  dvb dashboard
+
+  # Watch the devnets of one namespace
+  dvb dashboard -n team-a`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+			if !tui.IsInteractive() {
+				return fmt.Errorf("dvb dashboard requires an interactive terminal; use 'dvb list' or 'dvb status' instead")
+			}
+			return runDashboard(cmd.Context(), namespace)
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace to show (default: all)")
+
+	return cmd
+}
+
+// runDashboard runs the dashboard TUI, feeding it from the watch stream in a
+// background goroutine.
+func runDashboard(ctx context.Context, namespace string) error {
+	model := views.NewDashboardModel(namespace, dashboardActions(ctx))
+	p := tea.NewProgram(model, tea.WithAltScreen())
+
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	defer cancelWatch()
+
+	go func() {
+		for {
+			p.Send(views.WatchResetMsg{})
+			err := daemonClient.WatchDevnets(watchCtx, namespace, func(event *v1.WatchDevnetsResponse) error {
+				p.Send(views.WatchEventMsg{Event: event})
+				return nil
+			})
+			if watchCtx.Err() != nil {
+				return
+			}
+			if err == nil {
+				err = fmt.Errorf("watch stream closed by daemon")
+			}
+			p.Send(views.WatchErrorMsg{Error: fmt.Errorf("%w (reconnecting)", err)})
+
+			select {
+			case <-watchCtx.Done():
+				return
+			case <-time.After(dashboardReconnectDelay):
+			}
+		}
+	}()
+
+	_, err := p.Run()
+	return err
+}
+
+// dashboardActions binds the dashboard keys to daemon calls and dvb
+// subcommands.
+func dashboardActions(ctx context.Context) views.DashboardActions {
+	return views.DashboardActions{
+		Start: func(namespace, name string) error {
+			_, err := daemonClient.StartDevnet(ctx, namespace, name)
+			return err
+		},
+		Stop: func(namespace, name string) error {
+			_, err := daemonClient.StopDevnet(ctx, namespace, name)
+			return err
+		},
+		Logs: func(namespace, name string) *exec.Cmd {
+			return pagedDvbCommand("node", "logs", name, "--tail", "500")
+		},
+		Describe: func(namespace, name string) *exec.Cmd {
+			return pagedDvbCommand("status", name, "--verbose", "--namespace", namespace)
+		},
+	}
+}
+
+// pagedDvbCommand returns a command running dvb with args, piped through
+// $PAGER (default: less -R), with this invocation's connection flags.
+func pagedDvbCommand(args ...string) *exec.Cmd {
+	self, err := os.Executable()
+	if err != nil {
+		self = "dvb"
+	}
+	dvbArgs := append(forwardedGlobalArgs(), args...)
+	// dvb and its arguments are passed as $0 and $@ so the shell never
+	// re-splits them.
+	return exec.Command("sh", append([]string{"-c", `"$0" "$@" 2>&1 | ${PAGER:-less -R}`, self}, dvbArgs...)...)
+}
@@ -0,0 +1,197 @@
+// cmd/dvb/debug.go
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newDebugCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Diagnose devnet problems",
+		Long:  `Collect diagnostics from a devnet's nodes to find out why it misbehaves.`,
+	}
+
+	cmd.AddCommand(
+		newDebugConsensusCmd(),
+		newDebugBundleCmd(),
+	)
+
+	return cmd
+}
+
+func newDebugConsensusCmd() *cobra.Command {
+	var (
+		namespace string
+		output    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "consensus [devnet]",
+		Short: "Diagnose a stalled chain",
+		Long: `Collect the consensus state of a running devnet and explain why it is not
+producing blocks.
+
+The daemon reads the round state (/dump_consensus_state) of the most advanced
+node, shows which validators prevoted and precommitted in the current round,
+and compares the nodes' app hashes. It then lists hypotheses, most likely
+first, with the evidence for each and suggested fixes. Common causes are too
+little voting power online (more than 2/3 is needed) and nodes that computed
+different app hashes.`,
+		Example: `  # Diagnose a stalled devnet
+  dvb debug consensus my-devnet
+
+  # As JSON
+  dvb debug consensus my-devnet -o json`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return validation.OutputFormat(output, "json")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			resp, err := daemonClient.DiagnoseConsensus(cmd.Context(), &v1.DiagnoseConsensusRequest{
+				DevnetName: devnetName,
+				Namespace:  ns,
+			})
+			if err != nil {
+				return err
+			}
+
+			if output == "json" {
+				return printJSON(resp)
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+			printConsensusDiagnosis(os.Stdout, resp, time.Now())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json)")
+
+	return cmd
+}
+
+// printConsensusDiagnosis prints the round state, validator participation,
+// node app hashes and ranked hypotheses of a consensus diagnosis.
+func printConsensusDiagnosis(out io.Writer, resp *v1.DiagnoseConsensusResponse, now time.Time) {
+	if resp.ObservedBy != "" {
+		fmt.Fprintf(out, "Consensus:  height %d, round %d, step %s (as seen by %s)\n", resp.Height, resp.Round, resp.Step, resp.ObservedBy)
+	}
+	if resp.LatestBlockTime != nil {
+		age := now.Sub(resp.LatestBlockTime.AsTime()).Round(time.Second)
+		state := color.GreenString("producing blocks")
+		if resp.Stalled {
+			state = color.RedString("STALLED")
+		}
+		fmt.Fprintf(out, "Last block: %s ago (%s)\n", age, state)
+	}
+
+	if len(resp.Validators) > 0 {
+		var total int64
+		for _, v := range resp.Validators {
+			total += v.VotingPower
+		}
+		fmt.Fprintln(out)
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "VALIDATOR\tPOWER\tPREVOTE\tPRECOMMIT\n")
+		for _, v := range resp.Validators {
+			name := v.Name
+			if name == "" {
+				name = shortAddress(v.Address)
+			}
+			if v.Proposer {
+				name += " (proposer)"
+			}
+			share := "-"
+			if total > 0 {
+				share = fmt.Sprintf("%.1f%%", float64(v.VotingPower)*100/float64(total))
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", name, share, v.Prevote, v.Precommit)
+		}
+		w.Flush()
+	}
+
+	if len(resp.Nodes) > 0 {
+		fmt.Fprintln(out)
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "NODE\tHEIGHT\tAPP HASH (at %d)\tSTATUS\n", resp.CompareHeight)
+		for _, n := range resp.Nodes {
+			height, appHash, state := fmt.Sprint(n.Height), shortAddress(n.AppHash), "ok"
+			switch {
+			case n.Error != "":
+				height, appHash, state = "-", "-", "unreachable"
+			case n.CatchingUp:
+				state = "catching up"
+			}
+			if appHash == "" {
+				appHash = "-"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", n.Name, height, appHash, state)
+		}
+		w.Flush()
+	}
+
+	fmt.Fprintln(out)
+	if len(resp.Hypotheses) == 0 {
+		if resp.Stalled {
+			fmt.Fprintln(out, "No likely cause found.")
+		} else {
+			color.New(color.FgGreen).Fprintln(out, "✓ Consensus is healthy")
+		}
+		return
+	}
+
+	fmt.Fprintln(out, "Hypotheses (most likely first):")
+	for i, h := range resp.Hypotheses {
+		confidence := h.Confidence
+		switch h.Confidence {
+		case "high":
+			confidence = color.RedString(h.Confidence)
+		case "medium":
+			confidence = color.YellowString(h.Confidence)
+		}
+		fmt.Fprintf(out, "\n  %d. %s [%s]\n", i+1, h.Title, confidence)
+		for _, e := range h.Evidence {
+			fmt.Fprintf(out, "     • %s\n", e)
+		}
+		if len(h.Fixes) > 0 {
+			fmt.Fprintln(out, "     Fix:")
+			for _, f := range h.Fixes {
+				fmt.Fprintf(out, "       → %s\n", f)
+			}
+		}
+	}
+}
+
+// shortAddress abbreviates a hex address or hash for tables.
+func shortAddress(s string) string {
+	if len(s) > 12 {
+		return s[:12] + "..."
+	}
+	return s
+}
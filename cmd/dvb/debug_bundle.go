@@ -0,0 +1,146 @@
+// cmd/dvb/debug_bundle.go
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	goruntime "runtime"
+	"strings"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
+	"github.com/altuslabsxyz/devnet-builder/internal/version"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newDebugBundleCmd() *cobra.Command {
+	var (
+		namespace string
+		output    string
+		logLines  int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "bundle [devnet]",
+		Short: "Create a support bundle to attach to bug reports",
+		Long: `Create a tar.gz with everything needed to investigate a devnet problem:
+
+  devnet.json, events.json       devnet spec, status and event history
+  nodes/<node>/node.json         node spec and status
+  nodes/<node>/node.log          the last --log-lines lines of the node's output
+  nodes/<node>/config.toml, app.toml
+  daemon.log                     the end of the daemon log
+  versions.txt, host.txt         devnetd and chain versions, host information
+  client.txt                     dvb version and client platform
+  errors.txt                     anything that could not be collected
+
+Values of keys that look like passwords, tokens, API keys, mnemonics or
+private keys, and credentials in URLs, are replaced with REDACTED. Review the
+bundle before sharing it.`,
+		Example: `  # Create dvb-bundle-my-devnet-<time>.tar.gz in the current directory
+  dvb debug bundle my-devnet
+
+  # Keep more node log lines and choose the file name
+  dvb debug bundle my-devnet --log-lines 5000 -o bug-1234.tar.gz`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return validation.AtLeast("log-lines", logLines, 1)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			files, err := daemonClient.CollectDebugBundle(cmd.Context(), &v1.CollectDebugBundleRequest{
+				DevnetName: devnetName,
+				Namespace:  ns,
+				LogLines:   int32(logLines),
+			})
+			if err != nil {
+				return err
+			}
+
+			now := time.Now()
+			prefix := fmt.Sprintf("dvb-bundle-%s-%s", devnetName, now.Format("20060102-150405"))
+			if output == "" {
+				output = prefix + ".tar.gz"
+			}
+			files = append(files, &v1.BundleFile{Name: "client.txt", Content: []byte(clientInfo())})
+
+			f, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+			if err != nil {
+				return fmt.Errorf("failed to create bundle: %w", err)
+			}
+			if err := writeBundle(f, prefix, files, now); err != nil {
+				f.Close()
+				os.Remove(output)
+				return fmt.Errorf("failed to write bundle: %w", err)
+			}
+			if err := f.Close(); err != nil {
+				return fmt.Errorf("failed to write bundle: %w", err)
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+			color.Green("✓ Wrote %s (%d files)", output, len(files))
+			fmt.Println("  Secrets were redacted; review the bundle before sharing it.")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file (default: dvb-bundle-<devnet>-<time>.tar.gz)")
+	cmd.Flags().IntVar(&logLines, "log-lines", 500, "Lines to keep from the end of each node log")
+
+	return cmd
+}
+
+// writeBundle writes files as a gzipped tar under the directory prefix.
+func writeBundle(w io.Writer, prefix string, files []*v1.BundleFile, modTime time.Time) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	for _, f := range files {
+		hdr := &tar.Header{
+			Name:    prefix + "/" + strings.TrimPrefix(f.Name, "/"),
+			Mode:    0644,
+			Size:    int64(len(f.Content)),
+			ModTime: modTime,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := tw.Write(f.Content); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// clientInfo describes the dvb build and the machine it runs on.
+func clientInfo() string {
+	var sb strings.Builder
+	sb.WriteString(version.NewInfo("devnet-builder", "dvb").String())
+	fmt.Fprintf(&sb, "\nos/arch:  %s/%s\n", goruntime.GOOS, goruntime.GOARCH)
+	if currentContext != nil {
+		fmt.Fprintf(&sb, "context:  %s\n", currentContext)
+	}
+	return sb.String()
+}
@@ -0,0 +1,96 @@
+// cmd/dvb/debug_test.go
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPrintConsensusDiagnosis(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	var out bytes.Buffer
+	printConsensusDiagnosis(&out, &v1.DiagnoseConsensusResponse{
+		ObservedBy:      "validator-0",
+		Height:          11,
+		Round:           2,
+		Step:            "Prevote",
+		LatestBlockTime: timestamppb.New(now.Add(-2 * time.Minute)),
+		Stalled:         true,
+		CompareHeight:   10,
+		Validators: []*v1.ConsensusValidator{
+			{Address: "AAAA", Name: "validator-0", VotingPower: 10, Prevote: "block", Precommit: "missing"},
+			{Address: "BBBBBBBBBBBBBBBBBBBB", VotingPower: 30, Proposer: true, Prevote: "missing", Precommit: "missing"},
+		},
+		Nodes: []*v1.ConsensusNode{
+			{Index: 0, Name: "validator-0", Height: 10, AppHash: "APPHASH"},
+			{Index: 1, Name: "validator-1", Error: "connection refused"},
+		},
+		Hypotheses: []*v1.ConsensusHypothesis{{
+			Title:      "Not enough voting power online",
+			Confidence: "high",
+			Evidence:   []string{"online voting power is 10 of 40 (25.0%)"},
+			Fixes:      []string{"Start validator-1: dvb node start alpha validator-1"},
+		}},
+	}, now)
+
+	for _, want := range []string{
+		"height 11, round 2, step Prevote (as seen by validator-0)",
+		"Last block: 2m0s ago (STALLED)",
+		"validator-0                 25.0%  block    missing",
+		"BBBBBBBBBBBB... (proposer)  75.0%",
+		"validator-1  -       -                 unreachable",
+		"1. Not enough voting power online [high]",
+		"• online voting power is 10 of 40 (25.0%)",
+		"→ Start validator-1: dvb node start alpha validator-1",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+
+	out.Reset()
+	printConsensusDiagnosis(&out, &v1.DiagnoseConsensusResponse{LatestBlockTime: timestamppb.New(now.Add(-time.Second))}, now)
+	if !strings.Contains(out.String(), "Consensus is healthy") {
+		t.Errorf("expected healthy message, got:\n%s", out.String())
+	}
+}
+
+func TestWriteBundle(t *testing.T) {
+	var buf bytes.Buffer
+	files := []*v1.BundleFile{
+		{Name: "devnet.json", Content: []byte(`{"metadata":{}}`)},
+		{Name: "nodes/validator-0/node.log", Content: []byte("committed block\n")},
+	}
+	if err := writeBundle(&buf, "dvb-bundle-alpha", files, time.Now()); err != nil {
+		t.Fatalf("writeBundle failed: %v", err)
+	}
+
+	gz, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatalf("not a gzip stream: %v", err)
+	}
+	tr := tar.NewReader(gz)
+	got := make(map[string]string)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("invalid tar: %v", err)
+		}
+		content, _ := io.ReadAll(tr)
+		got[hdr.Name] = string(content)
+	}
+	if got["dvb-bundle-alpha/nodes/validator-0/node.log"] != "committed block\n" || len(got) != 2 {
+		t.Errorf("unexpected bundle contents: %v", got)
+	}
+}
@@ -49,7 +49,8 @@ Examples:
 
   # Delete in standalone mode with custom data directory
   dvb delete my-devnet --data-dir /path/to/data`,
-		Args: cobra.MaximumNArgs(1),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// If -f is provided, delete from file
 			if filePath != "" {
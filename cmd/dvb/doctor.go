@@ -0,0 +1,457 @@
+// cmd/dvb/doctor.go
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/client"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/subnet"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/prereq"
+	"github.com/altuslabsxyz/devnet-builder/internal/paths"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// Doctor check statuses.
+const (
+	doctorPass = "pass"
+	doctorWarn = "warn"
+	doctorFail = "fail"
+)
+
+// minDockerMajor and minDockerMinor are the oldest Docker Engine release
+// devnets are tested with.
+const (
+	minDockerMajor = 20
+	minDockerMinor = 10
+)
+
+// doctorTimeout bounds each check that talks to another process.
+const doctorTimeout = 10 * time.Second
+
+// doctorCheck is the result of one doctor check.
+type doctorCheck struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Fix     string `json:"fix,omitempty"`
+}
+
+func newDoctorCmd() *cobra.Command {
+	var (
+		mode   string
+		strict bool
+		output string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check that this machine can run devnets",
+		Long: `Check the prerequisites for provisioning devnets and print a fix for
+each problem found:
+
+  - daemon connectivity
+  - Docker daemon availability and version (docker mode)
+  - required tools: curl and jq, and the optional zstd and lz4
+  - loopback address aliases for per-devnet subnets
+  - the open file limit
+  - whether the default node ports are free
+  - installed network plugins
+
+dvb doctor exits nonzero if any check fails, so it can gate CI jobs. With
+--strict, warnings fail too.`,
+		Example: `  # Check this machine for docker mode devnets
+  dvb doctor
+
+  # Check for local mode devnets, where Docker is not needed
+  dvb doctor --mode local
+
+  # Gate a CI job, failing on warnings as well
+  dvb doctor --strict -o json`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := validation.OneOf("mode", mode, "docker", "local"); err != nil {
+				return err
+			}
+			return validation.OutputFormat(output, "json")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			checks := runDoctorChecks(cmd.Context(), mode)
+			if output == "json" {
+				if err := printJSON(checks); err != nil {
+					return err
+				}
+			} else {
+				renderDoctor(cmd.OutOrStdout(), checks)
+			}
+
+			if n := doctorProblems(checks, strict); n > 0 {
+				cmd.SilenceUsage = true
+				return fmt.Errorf("dvb doctor found %d problem(s)", n)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&mode, "mode", "docker", "Execution mode to check for (docker or local)")
+	cmd.Flags().BoolVar(&strict, "strict", false, "Exit nonzero on warnings as well as failures")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json)")
+
+	return cmd
+}
+
+// runDoctorChecks runs every check, in the order they are printed.
+func runDoctorChecks(ctx context.Context, mode string) []doctorCheck {
+	checks := []doctorCheck{
+		checkDaemonConnection(ctx),
+		checkDocker(ctx, mode == "docker"),
+	}
+	checks = append(checks, checkTools()...)
+	checks = append(checks, checkLoopbackAlias())
+	if c, ok := checkOpenFiles(); ok {
+		checks = append(checks, c)
+	}
+	checks = append(checks,
+		checkPorts(defaultNodePorts()),
+		checkPlugins(ctx),
+	)
+	return checks
+}
+
+// doctorProblems counts the checks that make dvb doctor exit nonzero.
+func doctorProblems(checks []doctorCheck, strict bool) int {
+	n := 0
+	for _, c := range checks {
+		if c.Status == doctorFail || strict && c.Status == doctorWarn {
+			n++
+		}
+	}
+	return n
+}
+
+// renderDoctor prints one line per check, followed by its fix if it did not
+// pass.
+func renderDoctor(out io.Writer, checks []doctorCheck) {
+	for _, c := range checks {
+		switch c.Status {
+		case doctorPass:
+			color.New(color.FgGreen).Fprint(out, "✓")
+		case doctorWarn:
+			color.New(color.FgYellow).Fprint(out, "!")
+		default:
+			color.New(color.FgRed).Fprint(out, "✗")
+		}
+		fmt.Fprintf(out, " %-14s %s\n", c.Name, c.Message)
+		if c.Fix != "" && c.Status != doctorPass {
+			dimColor.Fprintf(out, "  %-14s %s\n", "", c.Fix)
+		}
+	}
+
+	var fails, warns int
+	for _, c := range checks {
+		switch c.Status {
+		case doctorFail:
+			fails++
+		case doctorWarn:
+			warns++
+		}
+	}
+	fmt.Fprintln(out)
+	switch {
+	case fails > 0:
+		color.New(color.FgRed).Fprintf(out, "%d check(s) failed, %d warning(s)\n", fails, warns)
+	case warns > 0:
+		color.New(color.FgYellow).Fprintf(out, "Ready, with %d warning(s)\n", warns)
+	default:
+		color.New(color.FgGreen).Fprintln(out, "Ready to provision devnets")
+	}
+}
+
+// checkDaemonConnection pings the daemon dvb is configured to use.
+func checkDaemonConnection(ctx context.Context) doctorCheck {
+	check := doctorCheck{Name: "daemon"}
+	if daemonClient == nil {
+		check.Status = doctorFail
+		check.Message = fmt.Sprintf("not running at %s", client.DefaultSocketPath())
+		check.Fix = "Start it with: dvb daemon start (see: dvb explain daemon-not-running)"
+		return check
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, doctorTimeout)
+	defer cancel()
+	resp, err := daemonClient.Ping(ctx)
+	if err != nil {
+		check.Status = doctorFail
+		check.Message = fmt.Sprintf("ping failed: %v", err)
+		check.Fix = "Check the daemon with: dvb daemon status"
+		return check
+	}
+
+	where := daemonClient.SocketPath()
+	if daemonClient.IsRemote() {
+		where = daemonClient.Server()
+	}
+	check.Status = doctorPass
+	check.Message = fmt.Sprintf("devnetd %s at %s", resp.ServerVersion, where)
+	return check
+}
+
+// checkDocker checks that the Docker daemon answers and is recent enough.
+// When Docker is not required, problems are warnings.
+func checkDocker(ctx context.Context, required bool) doctorCheck {
+	check := doctorCheck{Name: "docker"}
+	problem := doctorFail
+	if !required {
+		problem = doctorWarn
+	}
+
+	if _, err := exec.LookPath("docker"); err != nil {
+		check.Status = problem
+		check.Message = "not installed"
+		check.Fix = "Install Docker: https://docs.docker.com/get-docker/"
+		if required {
+			check.Fix += " (or use --mode local)"
+		}
+		return check
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, doctorTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, "docker", "version", "--format", "{{.Server.Version}}").CombinedOutput()
+	if err != nil {
+		check.Status = problem
+		if strings.Contains(strings.ToLower(string(out)), "permission denied") {
+			check.Message = "permission denied on the Docker socket"
+			check.Fix = "Add yourself to the docker group: sudo usermod -aG docker $USER (see: dvb explain docker-permission-denied)"
+		} else {
+			check.Message = "daemon is not running"
+			check.Fix = "Start Docker (see: dvb explain docker-not-running)"
+		}
+		return check
+	}
+
+	version := strings.TrimSpace(string(out))
+	if !dockerVersionAtLeast(version, minDockerMajor, minDockerMinor) {
+		check.Status = doctorWarn
+		check.Message = fmt.Sprintf("Docker %s is older than %d.%d", version, minDockerMajor, minDockerMinor)
+		check.Fix = "Upgrade Docker: https://docs.docker.com/engine/install/"
+		return check
+	}
+	check.Status = doctorPass
+	check.Message = fmt.Sprintf("Docker %s is running", version)
+	return check
+}
+
+// dockerVersionAtLeast reports whether a Docker version such as "27.1.1" or
+// "20.10.24+dfsg1" is at least major.minor. Unparseable versions pass.
+func dockerVersionAtLeast(version string, major, minor int) bool {
+	var gotMajor, gotMinor int
+	if _, err := fmt.Sscanf(version, "%d.%d", &gotMajor, &gotMinor); err != nil {
+		return true
+	}
+	return gotMajor > major || gotMajor == major && gotMinor >= minor
+}
+
+// checkTools checks the command line tools devnets use. zstd and gzip
+// snapshots are extracted natively, so only curl and jq are required.
+func checkTools() []doctorCheck {
+	results, _ := prereq.NewChecker().Check()
+	checks := make([]doctorCheck, 0, len(results)+1)
+	for _, r := range results {
+		checks = append(checks, toolCheck(r))
+	}
+
+	zstd := prereq.PrereqResult{Name: "zstd", Message: "zstd is available"}
+	if path, err := exec.LookPath("zstd"); err == nil {
+		zstd.Found = true
+		zstd.Path = path
+	} else {
+		zstd.Message = "zstd not found (only needed to create snapshots; extraction is built in)"
+		zstd.Suggestion = "Install zstd with your package manager"
+	}
+	return append(checks, toolCheck(zstd))
+}
+
+func toolCheck(r prereq.PrereqResult) doctorCheck {
+	check := doctorCheck{Name: r.Name, Message: r.Message}
+	switch {
+	case r.Found:
+		check.Status = doctorPass
+		if r.Path != "" {
+			check.Message = r.Path
+		}
+	case r.Required:
+		check.Status = doctorFail
+		check.Fix = r.Suggestion
+	default:
+		check.Status = doctorWarn
+		check.Fix = r.Suggestion
+	}
+	return check
+}
+
+// checkLoopbackAlias checks that a node address in a devnet subnet can be
+// bound. Each devnet gets its own 127.0.X.0/24 subnet; Linux routes all of
+// 127.0.0.0/8 to the loopback interface, macOS only 127.0.0.1.
+func checkLoopbackAlias() doctorCheck {
+	addr := subnet.NodeIP(1, 0)
+	check := doctorCheck{Name: "loopback"}
+	ln, err := net.Listen("tcp", net.JoinHostPort(addr, "0"))
+	if err != nil {
+		check.Status = doctorFail
+		check.Message = fmt.Sprintf("cannot bind %s: %v", addr, err)
+		if runtime.GOOS == "darwin" {
+			check.Fix = fmt.Sprintf("devnetd adds loopback aliases when it runs as root; otherwise add them, e.g.: sudo ifconfig lo0 alias %s up, or provision with --bind-address 127.0.0.1", addr)
+		} else {
+			check.Fix = "Check that the loopback interface is up: ip addr show lo"
+		}
+		return check
+	}
+	ln.Close()
+	check.Status = doctorPass
+	check.Message = fmt.Sprintf("node addresses such as %s can be bound", addr)
+	return check
+}
+
+// checkOpenFiles checks the open file limit that devnetd inherits when
+// started from this shell. It reports false where limits don't exist.
+func checkOpenFiles() (doctorCheck, bool) {
+	soft, hard, err := prereq.OpenFileLimit()
+	if errors.Is(err, prereq.ErrUlimitUnsupported) {
+		return doctorCheck{}, false
+	}
+	check := doctorCheck{Name: "open files"}
+	switch {
+	case err != nil:
+		check.Status = doctorWarn
+		check.Message = fmt.Sprintf("could not read the limit: %v", err)
+	case soft >= prereq.MinOpenFiles:
+		check.Status = doctorPass
+		check.Message = fmt.Sprintf("limit %d", soft)
+	case hard >= prereq.MinOpenFiles:
+		check.Status = doctorWarn
+		check.Message = fmt.Sprintf("limit %d is below the recommended %d", soft, prereq.MinOpenFiles)
+		check.Fix = fmt.Sprintf("Raise it before starting devnetd: ulimit -n %d", prereq.MinOpenFiles)
+	default:
+		check.Status = doctorWarn
+		check.Message = fmt.Sprintf("limit %d (hard limit %d) is below the recommended %d", soft, hard, prereq.MinOpenFiles)
+		if runtime.GOOS == "darwin" {
+			check.Fix = fmt.Sprintf("Raise the hard limit: sudo launchctl limit maxfiles %d unlimited", prereq.MinOpenFiles)
+		} else {
+			check.Fix = "Raise the hard limit for your user in /etc/security/limits.conf (nofile)"
+		}
+	}
+	return check, true
+}
+
+// defaultNodePorts returns the ports the first node of a devnet listens on.
+func defaultNodePorts() []int {
+	p := dvbtypes.DefaultPortConfig()
+	return []int{p.RPC, p.P2P, p.Proxy, p.GRPC, p.API, p.EVMRPC, p.EVMWS}
+}
+
+// checkPorts checks that ports are free on all interfaces. A process holding
+// one, often another devnet, conflicts with new devnets.
+func checkPorts(ports []int) doctorCheck {
+	var inUse []string
+	for _, port := range ports {
+		ln, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+		if err != nil {
+			inUse = append(inUse, strconv.Itoa(port))
+			continue
+		}
+		ln.Close()
+	}
+
+	check := doctorCheck{Name: "ports"}
+	if len(inUse) > 0 {
+		check.Status = doctorWarn
+		check.Message = fmt.Sprintf("in use: %s", strings.Join(inUse, ", "))
+		check.Fix = fmt.Sprintf("Find the process with: lsof -i :%s (see: dvb explain port-in-use)", inUse[0])
+		return check
+	}
+	check.Status = doctorPass
+	check.Message = fmt.Sprintf("default node ports are free (%d checked)", len(ports))
+	return check
+}
+
+// checkPlugins checks that at least one network plugin is installed. With a
+// daemon, it asks the daemon; otherwise it looks in the default plugin
+// directory.
+func checkPlugins(ctx context.Context) doctorCheck {
+	check := doctorCheck{Name: "plugins"}
+	dir := paths.PluginsPath(paths.DefaultHomeDir())
+
+	var names []string
+	if daemonClient != nil {
+		ctx, cancel := context.WithTimeout(ctx, doctorTimeout)
+		defer cancel()
+		networks, err := daemonClient.ListNetworks(ctx)
+		if err != nil {
+			check.Status = doctorWarn
+			check.Message = fmt.Sprintf("could not list plugins: %v", err)
+			check.Fix = "Check the daemon logs: dvb daemon logs"
+			return check
+		}
+		for _, n := range networks {
+			names = append(names, n.Name)
+		}
+	} else {
+		var err error
+		names, err = installedPlugins(dir)
+		if err != nil {
+			check.Status = doctorFail
+			check.Message = fmt.Sprintf("could not read %s: %v", dir, err)
+			return check
+		}
+	}
+
+	if len(names) == 0 {
+		check.Status = doctorFail
+		check.Message = "no network plugins installed"
+		check.Fix = fmt.Sprintf("Install a plugin into %s (see: dvb explain plugin-not-found)", dir)
+		return check
+	}
+	check.Status = doctorPass
+	check.Message = strings.Join(names, ", ")
+	return check
+}
+
+// installedPlugins returns the networks of the executable <network>-plugin
+// files in dir. A missing directory has no plugins.
+func installedPlugins(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, "-plugin") {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(dir, name))
+		if err != nil || info.Mode()&0111 == 0 {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(name, "-plugin"))
+	}
+	return names, nil
+}
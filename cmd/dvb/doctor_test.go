@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestDockerVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		version string
+		want    bool
+	}{
+		{"27.1.1", true},
+		{"20.10.24+dfsg1", true},
+		{"20.9.0", false},
+		{"19.03.12", false},
+		{"25.0-ce", true},
+		{"dev", true},
+	}
+	for _, tt := range tests {
+		if got := dockerVersionAtLeast(tt.version, 20, 10); got != tt.want {
+			t.Errorf("dockerVersionAtLeast(%q) = %v, want %v", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestDoctorProblems(t *testing.T) {
+	checks := []doctorCheck{
+		{Name: "daemon", Status: doctorPass},
+		{Name: "lz4", Status: doctorWarn},
+		{Name: "plugins", Status: doctorFail},
+	}
+	if got := doctorProblems(checks, false); got != 1 {
+		t.Errorf("doctorProblems() = %d, want 1", got)
+	}
+	if got := doctorProblems(checks, true); got != 2 {
+		t.Errorf("doctorProblems(strict) = %d, want 2", got)
+	}
+}
+
+func TestRenderDoctor(t *testing.T) {
+	var out bytes.Buffer
+	renderDoctor(&out, []doctorCheck{
+		{Name: "curl", Status: doctorPass, Message: "/usr/bin/curl", Fix: "unused"},
+		{Name: "docker", Status: doctorFail, Message: "daemon is not running", Fix: "Start Docker"},
+	})
+	got := out.String()
+	for _, want := range []string{"curl", "/usr/bin/curl", "daemon is not running", "Start Docker", "1 check(s) failed, 0 warning(s)"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "unused") {
+		t.Errorf("fix printed for a passing check:\n%s", got)
+	}
+}
+
+func TestCheckDocker_NotInstalled(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	if c := checkDocker(context.Background(), true); c.Status != doctorFail || c.Message != "not installed" {
+		t.Errorf("docker mode: %+v", c)
+	}
+	if c := checkDocker(context.Background(), false); c.Status != doctorWarn {
+		t.Errorf("local mode: expected a warning, got %+v", c)
+	}
+}
+
+func TestCheckPorts(t *testing.T) {
+	ln, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	port := ln.Addr().(*net.TCPAddr).Port
+
+	c := checkPorts([]int{port})
+	if c.Status != doctorWarn || !strings.Contains(c.Message, strconv.Itoa(port)) {
+		t.Errorf("expected port %d reported in use, got %+v", port, c)
+	}
+	if !strings.Contains(c.Fix, "lsof -i :"+strconv.Itoa(port)) {
+		t.Errorf("unexpected fix %q", c.Fix)
+	}
+}
+
+func TestInstalledPlugins(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]os.FileMode{
+		"stable-plugin":  0755,
+		"cosmos-plugin":  0644, // not executable
+		"README.md":      0644,
+		"osmosis-plugin": 0755,
+	}
+	for name, mode := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := installedPlugins(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"osmosis", "stable"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("installedPlugins() = %v, want %v", got, want)
+	}
+
+	got, err = installedPlugins(filepath.Join(dir, "missing"))
+	if err != nil || len(got) != 0 {
+		t.Errorf("missing directory: %v, %v", got, err)
+	}
+}
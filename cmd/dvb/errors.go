@@ -4,7 +4,7 @@ package main
 import "fmt"
 
 // errDaemonNotRunning is the standard error returned when daemon connection is required but unavailable.
-var errDaemonNotRunning = fmt.Errorf("daemon not running - start with: devnetd")
+var errDaemonNotRunning = fmt.Errorf("daemon not running - start with: dvb daemon start")
 
 // requireDaemon returns errDaemonNotRunning if the daemon client is not connected.
 // Usage: if err := requireDaemon(); err != nil { return err }
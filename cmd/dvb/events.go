@@ -0,0 +1,142 @@
+// cmd/dvb/events.go
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newEventsCmd() *cobra.Command {
+	var (
+		namespace string
+		since     time.Duration
+		eventType string
+		limit     int
+		output    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "events [devnet]",
+		Short: "Show the event history of a devnet",
+		Long: `Show the events recorded for a devnet, oldest first.
+
+'dvb status' shows only the most recent events; the daemon keeps a longer
+history per devnet (server.event_retention in devnetd.toml, default 1000) that
+survives daemon restarts and is removed with the devnet.`,
+		Example: `  # Events of the last hour
+  dvb events my-devnet --since 1h
+
+  # Only warnings, as JSON
+  dvb events my-devnet --type Warning -o json`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := validation.OutputFormat(output, "json"); err != nil {
+				return err
+			}
+			if since < 0 {
+				return fmt.Errorf("--since must not be negative")
+			}
+			if err := validation.NonNegative("limit", limit); err != nil {
+				return err
+			}
+			_, err := normalizeEventType(eventType)
+			return err
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			eventType, err := normalizeEventType(eventType)
+			if err != nil {
+				return err
+			}
+
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			req := &v1.ListDevnetEventsRequest{
+				Name:      devnetName,
+				Namespace: ns,
+				Type:      eventType,
+				Limit:     int32(limit),
+			}
+			if since > 0 {
+				req.Since = timestamppb.New(time.Now().Add(-since))
+			}
+			events, err := daemonClient.ListDevnetEvents(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			if output == "json" {
+				return printJSON(events)
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+			printEventHistory(os.Stdout, events)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().DurationVar(&since, "since", 0, "Only show events newer than this (e.g. 30m, 1h)")
+	cmd.Flags().StringVar(&eventType, "type", "", "Only show events of this type (Normal or Warning)")
+	cmd.Flags().IntVar(&limit, "limit", 100, "Maximum number of events, keeping the most recent (max 1000)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json)")
+
+	return cmd
+}
+
+// normalizeEventType returns the event type a --type value names: "",
+// "Normal" or "Warning", in any case.
+func normalizeEventType(eventType string) (string, error) {
+	switch strings.ToLower(eventType) {
+	case "":
+		return "", nil
+	case "normal":
+		return "Normal", nil
+	case "warning":
+		return "Warning", nil
+	}
+	return "", fmt.Errorf("invalid event type %q (must be Normal or Warning)", eventType)
+}
+
+// printEventHistory prints events as a table, oldest first.
+func printEventHistory(out io.Writer, events []*v1.Event) {
+	if len(events) == 0 {
+		fmt.Fprintln(out, "No events found.")
+		return
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tTYPE\tREASON\tCOMPONENT\tMESSAGE")
+	for _, e := range events {
+		eventType := e.Type
+		if e.Type == "Warning" {
+			eventType = color.YellowString(e.Type)
+		}
+		msg := strings.Join(strings.Fields(e.Message), " ")
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", e.Timestamp.AsTime().Local().Format("2006-01-02 15:04:05"),
+			eventType, e.Reason, e.Component, msg)
+	}
+	w.Flush()
+}
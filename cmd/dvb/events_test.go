@@ -0,0 +1,40 @@
+// cmd/dvb/events_test.go
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPrintEventHistory(t *testing.T) {
+	var out bytes.Buffer
+	printEventHistory(&out, nil)
+	if !strings.Contains(out.String(), "No events found.") {
+		t.Errorf("expected empty message, got:\n%s", out.String())
+	}
+
+	out.Reset()
+	ts := time.Date(2026, 3, 1, 12, 30, 0, 0, time.Local)
+	printEventHistory(&out, []*v1.Event{
+		{Timestamp: timestamppb.New(ts), Type: "Normal", Reason: "Provisioned", Component: "devnet-controller", Message: "all\n  nodes ready"},
+		{Timestamp: timestamppb.New(ts.Add(time.Minute)), Type: "Warning", Reason: "NodeCrashed", Component: "health-controller"},
+	})
+	for _, want := range []string{
+		"2026-03-01 12:30:00",
+		"Provisioned",
+		"all nodes ready",
+		"NodeCrashed",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+	if lines := strings.Split(strings.TrimSpace(out.String()), "\n"); len(lines) != 3 {
+		t.Errorf("expected header and 2 rows, got %d lines:\n%s", len(lines), out.String())
+	}
+}
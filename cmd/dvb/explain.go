@@ -0,0 +1,103 @@
+// cmd/dvb/explain.go
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/redact"
+	"github.com/altuslabsxyz/devnet-builder/internal/triage"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newExplainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain [error-code]",
+		Short: "Explain a known failure and how to fix it",
+		Long: `Explain a known failure and list the steps to fix it.
+
+When a command fails with a recognized error, dvb prints a hint with the
+error code. Pass that code to explain for the cause, remediation steps and
+links to the docs. Without a code, all known failures are listed.
+
+Examples:
+  # List all known failures
+  dvb explain
+
+  # Explain a failure
+  dvb explain port-in-use`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return printTriageEntries(cmd.OutOrStdout())
+			}
+			entry, ok := triage.Lookup(args[0])
+			if !ok {
+				return fmt.Errorf("unknown error code %q (run 'dvb explain' to list codes)", args[0])
+			}
+			printTriageEntry(cmd.OutOrStdout(), entry)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// printTriageEntries lists every known failure.
+func printTriageEntries(out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CODE\tDESCRIPTION")
+	for _, e := range triage.Entries() {
+		fmt.Fprintf(w, "%s\t%s\n", e.Code, e.Title)
+	}
+	return w.Flush()
+}
+
+// printTriageEntry prints a failure's cause, remediation steps and links.
+func printTriageEntry(out io.Writer, e *triage.Entry) {
+	bold := color.New(color.Bold)
+
+	bold.Fprintf(out, "%s: %s\n", e.Code, e.Title)
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, e.Cause)
+	fmt.Fprintln(out)
+	bold.Fprintln(out, "To fix:")
+	for i, step := range e.Steps {
+		fmt.Fprintf(out, "  %d. %s\n", i+1, step)
+	}
+	if len(e.Links) > 0 {
+		fmt.Fprintln(out)
+		bold.Fprintln(out, "See also:")
+		for _, link := range e.Links {
+			fmt.Fprintf(out, "  %s\n", link)
+		}
+	}
+}
+
+// printTriageHint prints a remediation hint after a failed command when the
+// error matches a known failure. It reports whether a hint was printed.
+func printTriageHint(out io.Writer, err error) bool {
+	entry := triage.Match(err.Error())
+	if entry == nil {
+		return false
+	}
+
+	fmt.Fprintln(out)
+	color.New(color.FgYellow).Fprintf(out, "Hint: %s\n", entry.Title)
+	for _, step := range entry.Steps {
+		fmt.Fprintf(out, "  - %s\n", step)
+	}
+	dimColor.Fprintf(out, "Run 'dvb explain %s' for details.\n", entry.Code)
+	return true
+}
+
+// exitWithError prints a failed command's error, plus a remediation hint if
+// it is a known failure, and exits.
+func exitWithError(err error) {
+	fmt.Fprintf(os.Stderr, "Error: %s\n", redact.String(err.Error()))
+	printTriageHint(os.Stderr, err)
+	os.Exit(exitCode(err))
+}
@@ -0,0 +1,54 @@
+// cmd/dvb/explain_test.go
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPrintTriageHint(t *testing.T) {
+	var out bytes.Buffer
+	err := errors.New("failed to start node 0: listen tcp :26657: bind: address already in use")
+	if !printTriageHint(&out, err) {
+		t.Fatal("printTriageHint() should print a hint for a port conflict")
+	}
+	if !strings.Contains(out.String(), "dvb explain port-in-use") {
+		t.Errorf("hint should point at dvb explain, got:\n%s", out.String())
+	}
+
+	out.Reset()
+	if printTriageHint(&out, errors.New("devnet \"foo\" not found")) {
+		t.Errorf("printTriageHint() should not print for unknown errors, got:\n%s", out.String())
+	}
+}
+
+func TestExplainCmd(t *testing.T) {
+	cmd := newExplainCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"docker-not-running"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("explain docker-not-running: %v", err)
+	}
+	if !strings.Contains(out.String(), "To fix:") || !strings.Contains(out.String(), "docker info") {
+		t.Errorf("unexpected explain output:\n%s", out.String())
+	}
+
+	out.Reset()
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("explain: %v", err)
+	}
+	if !strings.Contains(out.String(), "port-in-use") {
+		t.Errorf("explain without a code should list codes, got:\n%s", out.String())
+	}
+
+	cmd.SetArgs([]string{"no-such-code"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	if err := cmd.Execute(); err == nil {
+		t.Error("explain should fail for an unknown code")
+	}
+}
@@ -0,0 +1,264 @@
+// cmd/dvb/export.go
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// exportFixturesOptions holds options for the export fixtures command
+type exportFixturesOptions struct {
+	types     []string
+	outputDir string
+	node      int
+	namespace string
+}
+
+// exportComposeOptions holds options for the export compose command
+type exportComposeOptions struct {
+	output    string
+	homeRoot  string
+	namespace string
+}
+
+func newExportCmd() *cobra.Command {
+	opts := &exportDevnetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "export [devnet]",
+		Short: "Export a devnet archive or artifacts from a devnet",
+		Long: `Export a devnet as a portable tar.zst archive, or artifacts derived from a
+running devnet with the subcommands.
+
+The archive holds the devnet and node resources and every node home,
+including keyrings and chain data, so a provisioned devnet can be moved to
+another machine with 'dvb import' or attached to a bug report. Binaries are
+not included: nodes keep their binary path, image and version, and the
+importing daemon builds missing binaries at that version. Stop the devnet
+before exporting it, or pass --live to copy chain data that may change
+during the export.
+
+Examples:
+  # Export a stopped devnet to my-devnet.tar.zst
+  dvb export my-devnet
+
+  # Export the current devnet while it runs
+  dvb export -o repro.tar.zst --live
+
+  # Export signed transaction and query fixtures
+  dvb export fixtures my-devnet --types bank,staking,gov -o ./fixtures
+
+  # Export the node/peer/port topology graph
+  dvb export topology my-devnet -o topology.dot
+
+  # Export a docker-mode devnet as a docker compose file
+  dvb export compose my-devnet -o docker-compose.yaml`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExportDevnet(cmd, args, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "", "Archive to write (default <devnet>.tar.zst)")
+	cmd.Flags().BoolVar(&opts.live, "live", false, "Export while nodes run; their chain data may be inconsistent")
+
+	cmd.AddCommand(
+		newExportFixturesCmd(),
+		newExportTopologyCmd(),
+		newExportComposeCmd(),
+	)
+
+	return cmd
+}
+
+func newExportFixturesCmd() *cobra.Command {
+	opts := &exportFixturesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "fixtures [devnet]",
+		Short: "Export canonical signed transaction and query fixtures",
+		Long: `Export canonical signed transaction and query-response fixtures from a
+running devnet, for SDK and client library regression tests.
+
+Transactions are signed offline with SIGN_MODE_DIRECT against the devnet's
+chain ID by accounts derived from a well-known test mnemonic, so fixtures
+are reproducible without access to the devnet's keyring. Query responses are
+pinned to a single block height.
+
+Each type writes files under <output>/<type>/:
+  tx_<name>.json     Signed transaction (JSON, protobuf bytes, sign doc, hash)
+  query_<name>.json  REST query response at the pinned height
+
+A manifest.json records the chain ID, height, mnemonic and signer accounts.
+
+Examples:
+  # Export all fixture types for the current devnet
+  dvb export fixtures -o ./fixtures
+
+  # Export bank and gov fixtures for a specific devnet
+  dvb export fixtures my-devnet --types bank,gov -o ./fixtures`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return validation.NodeIndex("node", opts.node)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, opts.namespace)
+			if err != nil {
+				return err
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+
+			resp, err := daemonClient.ExportFixtures(cmd.Context(), &v1.ExportFixturesRequest{
+				DevnetName: devnetName,
+				Namespace:  ns,
+				Types:      opts.types,
+				NodeIndex:  int32(opts.node),
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := writeFixtureFiles(opts.outputDir, resp.Files); err != nil {
+				return err
+			}
+
+			color.Green("✓ Exported %d fixture files to %s", len(resp.Files), opts.outputDir)
+			fmt.Printf("  Chain ID: %s\n", resp.ChainId)
+			fmt.Printf("  Height:   %d\n", resp.Height)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&opts.types, "types", nil, "Fixture types to export: bank, staking, gov (default: all)")
+	cmd.Flags().StringVarP(&opts.outputDir, "output", "o", "./fixtures", "Output directory")
+	cmd.Flags().IntVar(&opts.node, "node", 0, "Index of the node to query")
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", "Namespace (defaults to context or server default)")
+
+	return cmd
+}
+
+func newExportComposeCmd() *cobra.Command {
+	opts := &exportComposeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "compose [devnet]",
+		Short: "Export a docker-mode devnet as a docker compose file",
+		Long: `Export the containers of a running docker-mode devnet as a standalone
+docker compose file, so the same topology runs without devnetd, e.g. on a
+CI host with only docker compose.
+
+Each node becomes a service named <role>-<index> with the image, command,
+environment, home directory mount, published ports and restart policy of
+its container. All services join one "devnet" network.
+
+Node homes hold the genesis, keys and chain data, and are mounted from
+their paths on the daemon host. To run the file elsewhere, copy each node
+home to <dir>/<service> and pass --home-root <dir>. The daemon must run
+with the docker runtime (devnetd --runtime docker).
+
+Examples:
+  # Print the compose file of the current devnet
+  dvb export compose
+
+  # Write it to a file and run it
+  dvb export compose my-devnet -o docker-compose.yaml
+  docker compose -f docker-compose.yaml up -d
+
+  # Mount node homes from ./homes/validator-0, ./homes/validator-1, ...
+  dvb export compose my-devnet --home-root ./homes -o docker-compose.yaml`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, opts.namespace)
+			if err != nil {
+				return err
+			}
+
+			resp, err := daemonClient.ExportCompose(cmd.Context(), &v1.ExportComposeRequest{
+				DevnetName: devnetName,
+				Namespace:  ns,
+				HomeRoot:   opts.homeRoot,
+			})
+			if err != nil {
+				return err
+			}
+
+			if opts.output == "" {
+				_, err := cmd.OutOrStdout().Write(resp.Content)
+				return err
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+			if dir := filepath.Dir(opts.output); dir != "." {
+				if err := os.MkdirAll(dir, 0o755); err != nil {
+					return fmt.Errorf("failed to create directory: %w", err)
+				}
+			}
+			if err := os.WriteFile(opts.output, resp.Content, 0o644); err != nil {
+				return fmt.Errorf("failed to write %s: %w", opts.output, err)
+			}
+			color.Green("✓ Wrote compose file with %d services to %s", len(resp.Services), opts.output)
+			fmt.Printf("  Services: %s\n", strings.Join(resp.Services, ", "))
+			fmt.Printf("  Run with: docker compose -f %s up -d\n", opts.output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "", "File to write the compose file to (default: print)")
+	cmd.Flags().StringVar(&opts.homeRoot, "home-root", "", "Directory to mount node homes from, as <dir>/<service> (default: their paths on the daemon host)")
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", "Namespace (defaults to context or server default)")
+
+	return cmd
+}
+
+// writeFixtureFiles writes fixture files under dir, rejecting names that
+// would escape it.
+func writeFixtureFiles(dir string, files []*v1.FixtureFile) error {
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("invalid output directory: %w", err)
+	}
+
+	for _, f := range files {
+		path := filepath.Join(root, filepath.FromSlash(f.Name))
+		if path != root && !strings.HasPrefix(path, root+string(filepath.Separator)) {
+			return fmt.Errorf("refusing to write fixture outside output directory: %s", f.Name)
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+		if err := os.WriteFile(path, f.Content, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// cmd/dvb/export_test.go
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+func TestWriteFixtureFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	err := writeFixtureFiles(dir, []*v1.FixtureFile{
+		{Name: "bank/tx_send.json", Content: []byte(`{"name":"send"}`)},
+		{Name: "manifest.json", Content: []byte(`{}`)},
+	})
+	if err != nil {
+		t.Fatalf("writeFixtureFiles failed: %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "bank", "tx_send.json"))
+	if err != nil {
+		t.Fatalf("fixture not written: %v", err)
+	}
+	if string(got) != `{"name":"send"}` {
+		t.Errorf("unexpected content: %s", got)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "manifest.json")); err != nil {
+		t.Errorf("manifest not written: %v", err)
+	}
+}
+
+func TestWriteFixtureFiles_RejectsEscapingPaths(t *testing.T) {
+	dir := t.TempDir()
+
+	err := writeFixtureFiles(filepath.Join(dir, "out"), []*v1.FixtureFile{
+		{Name: "../escape.json", Content: []byte(`{}`)},
+	})
+	if err == nil {
+		t.Fatal("expected error for path outside output directory")
+	}
+	if _, statErr := os.Stat(filepath.Join(dir, "escape.json")); !os.IsNotExist(statErr) {
+		t.Error("file outside output directory was written")
+	}
+}
@@ -0,0 +1,91 @@
+// cmd/dvb/extend.go
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newExtendCmd() *cobra.Command {
+	var (
+		namespace string
+		by        string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "extend [devnet]",
+		Short: "Extend the TTL of a devnet",
+		Long: `Extend the TTL of a devnet.
+
+A devnet provisioned with a TTL (spec.ttl or --ttl) is stopped by the daemon
+once it expires, or deleted if deleteOnExpiry is set. Extending pushes the
+expiry back; an already expired devnet is extended from now and can then be
+started again.
+
+Examples:
+  # Give the current context devnet two more hours
+  dvb extend --by 2h
+
+  # Extend a devnet in a specific namespace
+  dvb extend my-devnet -n ci --by 30m`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+
+			devnet, err := daemonClient.ExtendDevnet(cmd.Context(), ns, devnetName, by)
+			if err != nil {
+				return err
+			}
+
+			expiresAt := devnet.Status.GetExpiresAt()
+			color.Green("✓ Devnet %q extended", devnetName)
+			fmt.Printf("  Expires: %s (in %s)\n",
+				expiresAt.AsTime().Local().Format("2006-01-02 15:04"),
+				ttlRemaining(expiresAt, time.Now()))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVar(&by, "by", "", "Duration to extend the TTL by (e.g., 2h)")
+	_ = cmd.MarkFlagRequired("by")
+
+	return cmd
+}
+
+// ttlRemaining formats the time left until a devnet's TTL expires: "-" when
+// it has no TTL, "expired" once it has passed, and otherwise a duration
+// rounded to the minute, e.g. "3h25m".
+func ttlRemaining(expiresAt *timestamppb.Timestamp, now time.Time) string {
+	if expiresAt == nil {
+		return "-"
+	}
+	left := expiresAt.AsTime().Sub(now)
+	if left <= 0 {
+		return "expired"
+	}
+	if left < time.Minute {
+		return "<1m"
+	}
+	s := left.Truncate(time.Minute).String()
+	return s[:len(s)-2] // drop the trailing "0s"
+}
@@ -0,0 +1,34 @@
+// cmd/dvb/extend_test.go
+package main
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTTLRemaining(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		expiresAt *timestamppb.Timestamp
+		want      string
+	}{
+		{"no ttl", nil, "-"},
+		{"expired", timestamppb.New(now.Add(-time.Second)), "expired"},
+		{"now", timestamppb.New(now), "expired"},
+		{"seconds", timestamppb.New(now.Add(30 * time.Second)), "<1m"},
+		{"minutes", timestamppb.New(now.Add(25*time.Minute + 40*time.Second)), "25m"},
+		{"hours", timestamppb.New(now.Add(3*time.Hour + 25*time.Minute)), "3h25m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ttlRemaining(tt.expiresAt, now); got != tt.want {
+				t.Errorf("ttlRemaining() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
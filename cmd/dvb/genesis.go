@@ -4,15 +4,19 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
 	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/provisioner"
 	"github.com/altuslabsxyz/devnet-builder/internal/plugin/cosmos"
 	"github.com/altuslabsxyz/devnet-builder/internal/plugin/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/redact"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
@@ -30,6 +34,7 @@ type genesisForkOptions struct {
 	votingPeriod  time.Duration
 	unbondingTime time.Duration
 	noCache       bool
+	prune         types.GenesisPruneOptions
 }
 
 func newGenesisCmd() *cobra.Command {
@@ -39,7 +44,8 @@ func newGenesisCmd() *cobra.Command {
 		Long: `Manage genesis file operations for devnets.
 
 Subcommands allow you to fork genesis from various sources including
-mainnet RPC endpoints, snapshots, or local files.
+mainnet RPC endpoints, snapshots, or local files, and to export the state
+of a devnet as a genesis file.
 
 Examples:
   # Fork genesis from mainnet RPC
@@ -49,11 +55,15 @@ Examples:
   dvb genesis fork --network stable --local-path ./genesis.json --chain-id my-devnet-1
 
   # Fork genesis with custom parameters
-  dvb genesis fork --network cosmos --chain-id test-1 --voting-period 60s --unbonding-time 120s`,
+  dvb genesis fork --network cosmos --chain-id test-1 --voting-period 60s --unbonding-time 120s
+
+  # Export a devnet's state at height 1200
+  dvb genesis export my-devnet --height 1200 -o genesis.json`,
 	}
 
 	cmd.AddCommand(
 		newGenesisForkCmd(),
+		newGenesisExportCmd(),
 	)
 
 	return cmd
@@ -77,6 +87,12 @@ The forked genesis will have:
   - Modified governance voting period (default: 30s)
   - Modified staking unbonding time (default: 60s)
 
+Mainnet genesis files can be several GB. Pruning flags drop state a devnet
+rarely needs before patching:
+  --prune-ibc-packets  Clear IBC packet commitments, receipts and acknowledgements
+  --prune-dust COIN    Remove plain accounts holding less than COIN (no delegations)
+  --prune-wasm-code    Drop CosmWasm code and the contracts instantiated from it
+
 Examples:
   # Fork from mainnet RPC (uses plugin's default endpoint)
   dvb genesis fork --network stable --chain-id my-devnet-1
@@ -87,6 +103,9 @@ Examples:
   # Fork from a local genesis file
   dvb genesis fork --network stable --local-path ./mainnet-genesis.json --chain-id my-devnet-1 -o ./devnet-genesis.json
 
+  # Fork a smaller genesis without IBC packets and dust accounts
+  dvb genesis fork --network gaia --chain-id test-1 --prune-ibc-packets --prune-dust 1000uatom -o genesis.json
+
   # Fork from testnet instead of mainnet
   dvb genesis fork --network gaia --network-type testnet --chain-id test-local-1`,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -95,9 +114,10 @@ Examples:
 	}
 
 	// Required flags
-	cmd.Flags().StringVar(&opts.network, "network", "", "Network/plugin name (stable, cosmos, gaia) - required")
+	cmd.Flags().StringVar(&opts.network, "network", "", "Network/plugin name (stable, cosmos, gaia, osmosis, evmos) - required")
 	cmd.Flags().StringVar(&opts.chainID, "chain-id", "", "New chain ID for the forked genesis - required")
 	_ = cmd.MarkFlagRequired("network")
+	_ = cmd.RegisterFlagCompletionFunc("network", completeNetworkNames)
 	_ = cmd.MarkFlagRequired("chain-id")
 
 	// Source flags
@@ -114,6 +134,11 @@ Examples:
 	cmd.Flags().DurationVar(&opts.votingPeriod, "voting-period", 30*time.Second, "Governance voting period")
 	cmd.Flags().DurationVar(&opts.unbondingTime, "unbonding-time", 60*time.Second, "Staking unbonding time")
 
+	// Prune flags
+	cmd.Flags().BoolVar(&opts.prune.IBCPackets, "prune-ibc-packets", false, "Clear IBC packet commitments, receipts and acknowledgements")
+	cmd.Flags().StringVar(&opts.prune.DustThreshold, "prune-dust", "", "Remove plain accounts holding less than this coin (e.g., 1000uatom)")
+	cmd.Flags().BoolVar(&opts.prune.WasmCode, "prune-wasm-code", false, "Drop CosmWasm code and the contracts using it")
+
 	// Cache flags
 	cmd.Flags().BoolVar(&opts.noCache, "no-cache", false, "Skip cache for snapshots")
 
@@ -137,14 +162,14 @@ func runGenesisFork(ctx context.Context, opts *genesisForkOptions) error {
 	var logger *slog.Logger
 	if opts.output == "" {
 		// Quiet mode for stdout - only errors
-		logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		logger = slog.New(redact.NewHandler(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
 			Level: slog.LevelError,
-		}))
+		})))
 	} else {
 		// Verbose mode for file output
-		logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		logger = slog.New(redact.NewHandler(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
 			Level: slog.LevelInfo,
-		}))
+		})))
 	}
 
 	// Create plugin genesis based on network
@@ -163,6 +188,11 @@ func runGenesisFork(ctx context.Context, opts *genesisForkOptions) error {
 	// Determine source mode (priority: local > snapshot > rpc)
 	source := determineGenesisSource(opts)
 
+	// Check the prune options before fetching a possibly huge genesis
+	if _, err := provisioner.PruneTransforms(opts.prune); err != nil {
+		return err
+	}
+
 	// Validate snapshot mode requirements
 	if source.Mode == types.GenesisModeSnapshot && opts.binaryPath == "" {
 		return fmt.Errorf("--binary-path is required for snapshot mode")
@@ -178,17 +208,29 @@ func runGenesisFork(ctx context.Context, opts *genesisForkOptions) error {
 		fmt.Fprintf(os.Stderr, "\n")
 	}
 
-	// Fork genesis
-	result, err := forker.Fork(ctx, ports.ForkOptions{
+	// Fork genesis straight to the output file, or to a temporary file
+	// that is copied to stdout
+	outputPath := opts.output
+	if outputPath == "" {
+		tmp, err := os.CreateTemp(dataDir, "genesis-fork-*.json")
+		if err != nil {
+			return fmt.Errorf("failed to create temporary file: %w", err)
+		}
+		tmp.Close()
+		defer os.Remove(tmp.Name())
+		outputPath = tmp.Name()
+	}
+	result, err := forker.ForkToFile(ctx, ports.ForkOptions{
 		Source: source,
 		PatchOpts: types.GenesisPatchOptions{
 			ChainID:       opts.chainID,
 			VotingPeriod:  opts.votingPeriod,
 			UnbondingTime: opts.unbondingTime,
+			Prune:         opts.prune,
 		},
 		BinaryPath: opts.binaryPath,
 		NoCache:    opts.noCache,
-	}, ports.NilProgressReporter)
+	}, outputPath, ports.NilProgressReporter)
 	if err != nil {
 		return fmt.Errorf("failed to fork genesis: %w", err)
 	}
@@ -196,16 +238,15 @@ func runGenesisFork(ctx context.Context, opts *genesisForkOptions) error {
 	// Output result
 	if opts.output == "" {
 		// Write to stdout
-		_, err = os.Stdout.Write(result.Genesis)
+		genesis, err := os.Open(outputPath)
 		if err != nil {
+			return fmt.Errorf("failed to read forked genesis: %w", err)
+		}
+		defer genesis.Close()
+		if _, err := io.Copy(os.Stdout, genesis); err != nil {
 			return fmt.Errorf("failed to write to stdout: %w", err)
 		}
 	} else {
-		// Write to file
-		if err := os.WriteFile(opts.output, result.Genesis, 0644); err != nil {
-			return fmt.Errorf("failed to write output file: %w", err)
-		}
-
 		// Print success message
 		color.Green("Genesis forked successfully!")
 		fmt.Fprintf(os.Stderr, "\n")
@@ -219,6 +260,104 @@ func runGenesisFork(ctx context.Context, opts *genesisForkOptions) error {
 	return nil
 }
 
+func newGenesisExportCmd() *cobra.Command {
+	var (
+		namespace string
+		height    int64
+		output    string
+		timeout   time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export [devnet]",
+		Short: "Export a devnet's state as a genesis file",
+		Long: `Export the state of a devnet at a height as a genesis file, with the
+network's export command.
+
+The state comes from a full node: one already stopped is used as is, else
+the last running full node is halted for the export. A devnet without full
+nodes is halted as a whole and exports from node 0. Halted nodes are
+restarted afterwards, even if the export fails.
+
+With --height, the export waits for the devnet to reach the height if it is
+still ahead. The node must keep the state of the height, which the default
+pruning does for recent heights. Without --height the state is exported at
+the height the node halted at.
+
+Only local-mode devnets can be exported: the export runs the node's binary
+on its home directory.`,
+		Example: `  # Capture the state at height 1200, waiting for it if needed
+  dvb genesis export my-devnet --height 1200 -o genesis.json
+
+  # Export the current state to stdout
+  dvb genesis export my-devnet > genesis.json`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if height < 0 {
+				return fmt.Errorf("--height must not be negative")
+			}
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			if height > 0 {
+				fmt.Fprintf(os.Stderr, "Exporting %s at height %d...\n", devnetName, height)
+			} else {
+				fmt.Fprintf(os.Stderr, "Exporting %s...\n", devnetName)
+			}
+			resp, err := daemonClient.ExportGenesis(ctx, &v1.ExportGenesisRequest{
+				DevnetName: devnetName,
+				Namespace:  ns,
+				Height:     height,
+			})
+			if err != nil {
+				return err
+			}
+
+			if output == "" {
+				if _, err := os.Stdout.Write(resp.Genesis); err != nil {
+					return fmt.Errorf("failed to write to stdout: %w", err)
+				}
+			} else if err := os.WriteFile(output, resp.Genesis, 0644); err != nil {
+				return fmt.Errorf("failed to write output file: %w", err)
+			}
+
+			color.New(color.FgGreen).Fprintln(os.Stderr, "Genesis exported successfully!")
+			fmt.Fprintf(os.Stderr, "\n")
+			if output != "" {
+				fmt.Fprintf(os.Stderr, "  Output:    %s\n", output)
+			}
+			fmt.Fprintf(os.Stderr, "  Chain ID:  %s\n", resp.ChainId)
+			fmt.Fprintf(os.Stderr, "  Height:    %d\n", resp.Height)
+			fmt.Fprintf(os.Stderr, "  From node: %s\n", resp.Node)
+			if len(resp.HaltedNodes) > 0 {
+				fmt.Fprintf(os.Stderr, "  Restarted: %s\n", strings.Join(resp.HaltedNodes, ", "))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().Int64Var(&height, "height", 0, "Height to export (default: the height the node halts at)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file path (default: stdout)")
+	cmd.Flags().DurationVar(&timeout, "timeout", 10*time.Minute, "How long to wait for the height, the halt and the export")
+
+	return cmd
+}
+
 // getPluginGenesis returns the appropriate PluginGenesis for the given network
 func getPluginGenesis(network string) (types.PluginGenesis, error) {
 	switch network {
@@ -226,8 +365,12 @@ func getPluginGenesis(network string) (types.PluginGenesis, error) {
 		return cosmos.NewCosmosGenesis("stabled"), nil
 	case "cosmos", "gaia":
 		return cosmos.NewCosmosGenesis("gaiad"), nil
+	case "osmosis":
+		return cosmos.NewCosmosGenesis("osmosisd"), nil
+	case "evmos":
+		return cosmos.NewCosmosGenesis("evmosd"), nil
 	default:
-		return nil, fmt.Errorf("unknown network: %s (supported: stable, cosmos, gaia)", network)
+		return nil, fmt.Errorf("unknown network: %s (supported: stable, cosmos, gaia, osmosis, evmos)", network)
 	}
 }
 
@@ -42,7 +42,8 @@ Examples:
 
   # Get a devnet in a specific namespace
   dvb get staging/my-devnet`,
-		Args: cobra.MaximumNArgs(1),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := requireDaemon(); err != nil {
 				return err
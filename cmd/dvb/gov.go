@@ -0,0 +1,491 @@
+// cmd/dvb/gov.go
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newGovSubmitCmd() *cobra.Command {
+	var (
+		namespace string
+		devnet    string
+		file      string
+		deposit   string
+		proposer  string
+		voteAll   string
+		noWait    bool
+		timeout   time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "submit [devnet]",
+		Short: "Submit a proposal from a JSON file and follow it to the result",
+		Long: `Submit a governance proposal of any type from a JSON file, optionally vote on
+it from every validator, and follow it until it passes or fails.
+
+The file uses the format of '<chaind> tx gov submit-proposal' (as written by
+'<chaind> tx gov draft-proposal'): a list of messages with their "@type", plus
+title, summary, metadata, deposit and expedited. A file without messages
+submits a text proposal. Messages of the standard SDK modules (auth, bank,
+consensus, distribution, gov, mint, slashing, staking) are supported.
+
+The command exits non-zero if the proposal is rejected or fails.`,
+		Example: `  # Submit, vote yes from all validators and wait for the result
+  dvb gov submit -f proposal.json --deposit 10000000stake --vote-all yes
+
+  # Submit without voting or waiting for the result
+  dvb gov submit my-devnet -f proposal.json --no-wait`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if voteAll != "" {
+				option, err := normalizeVoteOption(voteAll)
+				if err != nil {
+					return err
+				}
+				voteAll = option
+			}
+
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read proposal file: %w", err)
+			}
+
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			explicitDevnet := devnet
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+
+			validators := 0
+			if voteAll != "" {
+				d, err := daemonClient.GetDevnet(cmd.Context(), ns, devnetName)
+				if err != nil {
+					return err
+				}
+				validators = int(d.GetSpec().GetValidators())
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			s := &govSubmitter{client: daemonClient, out: os.Stdout, pollInterval: 2 * time.Second}
+			return s.run(ctx, govSubmitOptions{
+				namespace:  ns,
+				devnet:     devnetName,
+				proposer:   proposer,
+				deposit:    deposit,
+				content:    content,
+				voteOption: voteAll,
+				validators: validators,
+				wait:       !noWait,
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVar(&devnet, "devnet", "", "Name of the devnet")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Path to the proposal JSON file (required)")
+	cmd.Flags().StringVar(&deposit, "deposit", "", "Initial deposit, overriding the file's (e.g., 10000000stake)")
+	cmd.Flags().StringVar(&proposer, "proposer", "validator:0", "Proposer (validator:N or account:name)")
+	cmd.Flags().StringVar(&voteAll, "vote-all", "", "Vote from every validator: yes, no, abstain, no_with_veto")
+	cmd.Flags().BoolVar(&noWait, "no-wait", false, "Return once the proposal (and votes) are on chain instead of waiting for the result")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Minute, "How long to wait for the proposal to finish")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func newGovSetParamCmd() *cobra.Command {
+	var (
+		namespace string
+		devnet    string
+		proposer  string
+		noWait    bool
+		timeout   time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "set-param <module> <key> <value>",
+		Short: "Change a module param through governance",
+		Long: `Change one param of a chain module through a governance proposal.
+
+The daemon reads the module's current params, replaces the one given, and
+builds a MsgUpdateParams proposal signed by the gov module. The proposal is
+expedited when the chain supports it, with the expedited minimum deposit.
+Every validator votes yes, and the command waits until the proposal passes and
+the new value is in effect.
+
+String params take the value as is; numbers and booleans must parse; list and
+object params (e.g., gov min_deposit) take JSON. Network plugins can override
+where a module's params live for chains that differ from the Cosmos SDK.`,
+		Example: `  # Raise the validator set size
+  dvb gov set-param staking max_validators 200
+
+  # Shorten the voting period
+  dvb gov set-param gov voting_period 60s
+
+  # Set a list param as JSON
+  dvb gov set-param gov min_deposit '[{"denom":"stake","amount":"1000"}]'`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			module, key, value := args[0], args[1], args[2]
+
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(devnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			printContextHeader(devnet, currentContext)
+
+			change, err := daemonClient.BuildParamChangeProposal(cmd.Context(), ns, devnetName, module, key, value)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Setting %s.%s: %s → %s\n", module, key, change.OldValue, change.NewValue)
+			if !change.Expedited {
+				color.Yellow("  Chain has no expedited proposals; using the regular voting period")
+			}
+
+			d, err := daemonClient.GetDevnet(cmd.Context(), ns, devnetName)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			opts := govSubmitOptions{
+				namespace:  ns,
+				devnet:     devnetName,
+				proposer:   proposer,
+				content:    change.Proposal,
+				voteOption: "yes",
+				validators: int(d.GetSpec().GetValidators()),
+				wait:       !noWait,
+			}
+			s := &govSubmitter{client: daemonClient, out: os.Stdout, pollInterval: 2 * time.Second}
+			if err := s.run(ctx, opts); err != nil {
+				return err
+			}
+			if noWait {
+				return nil
+			}
+			return s.waitForParam(ctx, daemonClient, opts, module, key, change.NewValue)
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVar(&devnet, "devnet", "", "Name of the devnet")
+	cmd.Flags().StringVar(&proposer, "proposer", "validator:0", "Proposer (validator:N or account:name)")
+	cmd.Flags().BoolVar(&noWait, "no-wait", false, "Return once the proposal and votes are on chain instead of waiting for the change")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Minute, "How long to wait for the change to apply")
+
+	return cmd
+}
+
+// normalizeVoteOption validates a vote option, accepting "veto" for
+// no_with_veto.
+func normalizeVoteOption(s string) (string, error) {
+	switch strings.ToLower(s) {
+	case "yes", "no", "abstain":
+		return strings.ToLower(s), nil
+	case "no_with_veto", "nowithveto", "veto":
+		return "no_with_veto", nil
+	default:
+		return "", fmt.Errorf("invalid vote option %q (must be yes, no, abstain or no_with_veto)", s)
+	}
+}
+
+// govClient is the subset of the daemon client used by gov submit.
+type govClient interface {
+	SubmitGovProposal(ctx context.Context, devnet, proposer, proposalType, title, description, deposit string, content []byte) (*v1.Transaction, error)
+	SubmitGovVote(ctx context.Context, devnet string, proposalID uint64, voter, option string) (*v1.Transaction, error)
+	GetTransaction(ctx context.Context, name string) (*v1.Transaction, error)
+	GetGovProposal(ctx context.Context, namespace, devnet string, proposalID uint64) (*v1.GovProposal, error)
+}
+
+type govSubmitOptions struct {
+	namespace  string
+	devnet     string
+	proposer   string
+	deposit    string
+	content    []byte
+	voteOption string // empty to not vote
+	validators int
+	wait       bool
+}
+
+// devnetRef returns the transaction devnet reference, qualified with the
+// namespace outside the default one.
+func (o govSubmitOptions) devnetRef() string {
+	if o.namespace != "" && o.namespace != "default" {
+		return o.namespace + "/" + o.devnet
+	}
+	return o.devnet
+}
+
+// govSubmitter submits a proposal, votes on it and follows it.
+type govSubmitter struct {
+	client       govClient
+	out          io.Writer
+	pollInterval time.Duration
+}
+
+func (s *govSubmitter) run(ctx context.Context, opts govSubmitOptions) error {
+	// The daemon does not return the proposal ID, so note the latest
+	// proposal before submitting and look for the next one afterwards.
+	before, err := s.latestProposalID(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.client.SubmitGovProposal(ctx, opts.devnetRef(), opts.proposer, "", "", "", opts.deposit, opts.content)
+	if err != nil {
+		return err
+	}
+	txName := tx.Name
+	tx, err = s.waitForTx(ctx, txName)
+	if err != nil {
+		return fmt.Errorf("proposal transaction %s: %w", txName, err)
+	}
+
+	proposal, err := s.client.GetGovProposal(ctx, opts.namespace, opts.devnet, 0)
+	if err != nil {
+		return fmt.Errorf("failed to find the submitted proposal: %w", err)
+	}
+	if proposal.Id <= before {
+		return fmt.Errorf("transaction %s was confirmed but no new proposal was found", tx.Name)
+	}
+
+	color.New(color.FgGreen).Fprintf(s.out, "✓ Proposal %d submitted: %s\n", proposal.Id, proposal.Title)
+	fmt.Fprintf(s.out, "  Tx:       %s (height %d)\n", tx.TxHash, tx.Height)
+	fmt.Fprintf(s.out, "  Status:   %s\n", proposalStatusText(proposal.Status))
+
+	if opts.voteOption != "" {
+		if proposal.Status == "PROPOSAL_STATUS_DEPOSIT_PERIOD" {
+			return fmt.Errorf("proposal %d is in its deposit period and cannot be voted on; submit it with a --deposit of at least the chain's minimum deposit", proposal.Id)
+		}
+		if err := s.voteAll(ctx, opts, proposal.Id); err != nil {
+			return err
+		}
+	}
+
+	if !opts.wait {
+		return nil
+	}
+	id := proposal.Id
+	proposal, err = s.waitForProposal(ctx, opts, id)
+	if err != nil {
+		return fmt.Errorf("proposal %d did not finish: %w", id, err)
+	}
+	return s.report(proposal)
+}
+
+func (s *govSubmitter) latestProposalID(ctx context.Context, opts govSubmitOptions) (uint64, error) {
+	p, err := s.client.GetGovProposal(ctx, opts.namespace, opts.devnet, 0)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return p.Id, nil
+}
+
+// voteAll votes from every validator, submitting all votes before waiting
+// for them so they land in the same few blocks.
+func (s *govSubmitter) voteAll(ctx context.Context, opts govSubmitOptions, proposalID uint64) error {
+	if opts.validators <= 0 {
+		return fmt.Errorf("devnet %s has no validators to vote with", opts.devnet)
+	}
+
+	names := make([]string, 0, opts.validators)
+	for i := 0; i < opts.validators; i++ {
+		tx, err := s.client.SubmitGovVote(ctx, opts.devnetRef(), proposalID, fmt.Sprintf("validator:%d", i), opts.voteOption)
+		if err != nil {
+			return fmt.Errorf("validator %d failed to vote: %w", i, err)
+		}
+		names = append(names, tx.Name)
+	}
+	for i, name := range names {
+		if _, err := s.waitForTx(ctx, name); err != nil {
+			return fmt.Errorf("vote from validator %d: %w", i, err)
+		}
+	}
+
+	color.New(color.FgGreen).Fprintf(s.out, "✓ Voted %s from %d validator(s)\n", opts.voteOption, opts.validators)
+	return nil
+}
+
+// waitForTx polls a transaction until it is confirmed, returning an error if
+// it fails.
+func (s *govSubmitter) waitForTx(ctx context.Context, name string) (*v1.Transaction, error) {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		tx, err := s.client.GetTransaction(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		switch tx.Phase {
+		case "Confirmed":
+			return tx, nil
+		case "Failed":
+			return tx, fmt.Errorf("failed: %s", tx.Error)
+		}
+
+		select {
+		case <-ctx.Done():
+			return tx, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForProposal polls a proposal until it reaches a final status,
+// printing status and tally changes.
+func (s *govSubmitter) waitForProposal(ctx context.Context, opts govSubmitOptions, id uint64) (*v1.GovProposal, error) {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	var last string
+	for {
+		p, err := s.client.GetGovProposal(ctx, opts.namespace, opts.devnet, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if line := fmt.Sprintf("%s: %s", proposalStatusText(p.Status), proposalTallyText(p)); line != last {
+			last = line
+			fmt.Fprintf(s.out, "  %s\n", line)
+		}
+		switch p.Status {
+		case "PROPOSAL_STATUS_PASSED", "PROPOSAL_STATUS_REJECTED", "PROPOSAL_STATUS_FAILED":
+			return p, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return p, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// report prints the result of a finished proposal and returns an error
+// unless it passed.
+func (s *govSubmitter) report(p *v1.GovProposal) error {
+	if p.Status == "PROPOSAL_STATUS_PASSED" {
+		color.New(color.FgGreen).Fprintf(s.out, "✓ Proposal %d passed\n", p.Id)
+		return nil
+	}
+
+	color.New(color.FgRed).Fprintf(s.out, "✗ Proposal %d %s\n", p.Id, strings.ToLower(proposalStatusText(p.Status)))
+	if p.FailedReason != "" {
+		fmt.Fprintf(s.out, "  Reason: %s\n", p.FailedReason)
+	}
+	return fmt.Errorf("proposal %d %s", p.Id, strings.ToLower(proposalStatusText(p.Status)))
+}
+
+// paramsGetter is the subset of the daemon client used to check a param.
+type paramsGetter interface {
+	GetModuleParams(ctx context.Context, namespace, devnet, module string) ([]byte, error)
+}
+
+// waitForParam polls a module's params until key has the wanted value. A
+// passed proposal's messages run when voting ends, so this rarely waits.
+func (s *govSubmitter) waitForParam(ctx context.Context, client paramsGetter, opts govSubmitOptions, module, key, want string) error {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		data, err := client.GetModuleParams(ctx, opts.namespace, opts.devnet, module)
+		if err != nil {
+			return err
+		}
+		var params map[string]json.RawMessage
+		if err := json.Unmarshal(data, &params); err != nil {
+			return fmt.Errorf("failed to decode %s params: %w", module, err)
+		}
+		got := params[key]
+		if paramValueMatches(got, json.RawMessage(want)) {
+			color.New(color.FgGreen).Fprintf(s.out, "✓ %s.%s is now %s\n", module, key, got)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s.%s is %s, not %s: %w", module, key, got, want, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// paramValueMatches compares param values as JSON, treating decimals that
+// differ only in formatting ("0.5" and "0.500000000000000000") as equal.
+func paramValueMatches(got, want json.RawMessage) bool {
+	var g, w bytes.Buffer
+	if json.Compact(&g, got) == nil && json.Compact(&w, want) == nil && g.String() == w.String() {
+		return true
+	}
+
+	number := func(v json.RawMessage) (*big.Rat, bool) {
+		var s string
+		if json.Unmarshal(v, &s) != nil {
+			s = string(v)
+		}
+		return new(big.Rat).SetString(s)
+	}
+	gr, ok1 := number(got)
+	wr, ok2 := number(want)
+	return ok1 && ok2 && gr.Cmp(wr) == 0
+}
+
+// proposalStatusText shortens a proposal status: "PROPOSAL_STATUS_PASSED"
+// becomes "Passed".
+func proposalStatusText(status string) string {
+	s := strings.ToLower(strings.TrimPrefix(status, "PROPOSAL_STATUS_"))
+	s = strings.ReplaceAll(s, "_", " ")
+	if s == "" {
+		return "Unknown"
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func proposalTallyText(p *v1.GovProposal) string {
+	orZero := func(s string) string {
+		if s == "" {
+			return "0"
+		}
+		return s
+	}
+	return fmt.Sprintf("yes %s, no %s, abstain %s, veto %s",
+		orZero(p.Yes), orZero(p.No), orZero(p.Abstain), orZero(p.NoWithVeto))
+}
@@ -0,0 +1,229 @@
+// cmd/dvb/gov_test.go
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+// fakeGovChain confirms every transaction and tracks one proposal. Proposal
+// 3 already exists; submitting creates proposal 4, which reaches finalStatus
+// once it has been polled a few times.
+type fakeGovChain struct {
+	initialStatus string
+	finalStatus   string
+	failVoter     string
+
+	submitted bool
+	votes     []string
+	polls     int
+	txs       map[string]*v1.Transaction
+}
+
+func (f *fakeGovChain) addTx(signer string) *v1.Transaction {
+	if f.txs == nil {
+		f.txs = map[string]*v1.Transaction{}
+	}
+	tx := &v1.Transaction{Name: fmt.Sprintf("tx-%d", len(f.txs)), Signer: signer, Phase: "Confirmed", TxHash: "ABC", Height: 42}
+	if signer == f.failVoter {
+		tx.Phase = "Failed"
+		tx.Error = "out of gas"
+	}
+	f.txs[tx.Name] = tx
+	return &v1.Transaction{Name: tx.Name, Phase: "Pending"}
+}
+
+func (f *fakeGovChain) SubmitGovProposal(ctx context.Context, devnet, proposer, proposalType, title, description, deposit string, content []byte) (*v1.Transaction, error) {
+	f.submitted = true
+	return f.addTx(proposer), nil
+}
+
+func (f *fakeGovChain) SubmitGovVote(ctx context.Context, devnet string, proposalID uint64, voter, option string) (*v1.Transaction, error) {
+	f.votes = append(f.votes, voter+"="+option)
+	return f.addTx(voter), nil
+}
+
+func (f *fakeGovChain) GetTransaction(ctx context.Context, name string) (*v1.Transaction, error) {
+	return f.txs[name], nil
+}
+
+func (f *fakeGovChain) GetGovProposal(ctx context.Context, namespace, devnet string, proposalID uint64) (*v1.GovProposal, error) {
+	if !f.submitted {
+		return &v1.GovProposal{Id: 3, Status: "PROPOSAL_STATUS_PASSED"}, nil
+	}
+	p := &v1.GovProposal{Id: 4, Title: "Fund the faucet", Status: f.initialStatus}
+	if proposalID == 4 {
+		f.polls++
+		if f.polls >= 3 {
+			p.Status = f.finalStatus
+			p.Yes = "300"
+		}
+	}
+	return p, nil
+}
+
+func newTestGovSubmitter(client govClient) (*govSubmitter, *bytes.Buffer) {
+	var out bytes.Buffer
+	return &govSubmitter{client: client, out: &out, pollInterval: time.Millisecond}, &out
+}
+
+func TestGovSubmitter_VoteAllAndPass(t *testing.T) {
+	chain := &fakeGovChain{initialStatus: "PROPOSAL_STATUS_VOTING_PERIOD", finalStatus: "PROPOSAL_STATUS_PASSED"}
+	s, out := newTestGovSubmitter(chain)
+
+	err := s.run(context.Background(), govSubmitOptions{
+		devnet: "mydevnet", proposer: "validator:0", voteOption: "yes", validators: 3, wait: true,
+	})
+	if err != nil {
+		t.Fatalf("run() error = %v\n%s", err, out.String())
+	}
+
+	want := []string{"validator:0=yes", "validator:1=yes", "validator:2=yes"}
+	if strings.Join(chain.votes, ",") != strings.Join(want, ",") {
+		t.Errorf("votes = %v, want %v", chain.votes, want)
+	}
+	for _, line := range []string{"Proposal 4 submitted", "Voted yes from 3 validator(s)", "Passed: yes 300", "Proposal 4 passed"} {
+		if !strings.Contains(out.String(), line) {
+			t.Errorf("output missing %q:\n%s", line, out.String())
+		}
+	}
+}
+
+func TestGovSubmitter_Rejected(t *testing.T) {
+	chain := &fakeGovChain{initialStatus: "PROPOSAL_STATUS_VOTING_PERIOD", finalStatus: "PROPOSAL_STATUS_REJECTED"}
+	s, _ := newTestGovSubmitter(chain)
+
+	err := s.run(context.Background(), govSubmitOptions{devnet: "mydevnet", proposer: "validator:0", wait: true})
+	if err == nil || !strings.Contains(err.Error(), "proposal 4 rejected") {
+		t.Errorf("run() error = %v, want proposal 4 rejected", err)
+	}
+	if len(chain.votes) != 0 {
+		t.Errorf("votes = %v, want none without --vote-all", chain.votes)
+	}
+}
+
+func TestGovSubmitter_FailedVote(t *testing.T) {
+	chain := &fakeGovChain{initialStatus: "PROPOSAL_STATUS_VOTING_PERIOD", failVoter: "validator:1"}
+	s, _ := newTestGovSubmitter(chain)
+
+	err := s.run(context.Background(), govSubmitOptions{
+		devnet: "mydevnet", proposer: "validator:0", voteOption: "no", validators: 2, wait: true,
+	})
+	if err == nil || !strings.Contains(err.Error(), "vote from validator 1: failed: out of gas") {
+		t.Errorf("run() error = %v, want validator 1 vote failure", err)
+	}
+}
+
+func TestGovSubmitter_DepositPeriod(t *testing.T) {
+	chain := &fakeGovChain{initialStatus: "PROPOSAL_STATUS_DEPOSIT_PERIOD"}
+	s, _ := newTestGovSubmitter(chain)
+
+	err := s.run(context.Background(), govSubmitOptions{
+		devnet: "mydevnet", proposer: "validator:0", voteOption: "yes", validators: 1,
+	})
+	if err == nil || !strings.Contains(err.Error(), "deposit period") {
+		t.Errorf("run() error = %v, want deposit period error", err)
+	}
+}
+
+// notFoundGovChain has no proposals until one is submitted, and then never
+// shows it.
+type notFoundGovChain struct{ fakeGovChain }
+
+func (f *notFoundGovChain) GetGovProposal(ctx context.Context, namespace, devnet string, proposalID uint64) (*v1.GovProposal, error) {
+	if !f.submitted {
+		return nil, errors.New("not found: devnet \"mydevnet\" has no proposals")
+	}
+	return &v1.GovProposal{Id: 0}, nil
+}
+
+func TestGovSubmitter_NoNewProposal(t *testing.T) {
+	s, _ := newTestGovSubmitter(&notFoundGovChain{})
+
+	err := s.run(context.Background(), govSubmitOptions{devnet: "mydevnet", proposer: "validator:0"})
+	if err == nil || !strings.Contains(err.Error(), "no new proposal was found") {
+		t.Errorf("run() error = %v, want no new proposal", err)
+	}
+}
+
+func TestNormalizeVoteOption(t *testing.T) {
+	tests := map[string]string{"YES": "yes", "abstain": "abstain", "veto": "no_with_veto", "no_with_veto": "no_with_veto"}
+	for in, want := range tests {
+		got, err := normalizeVoteOption(in)
+		if err != nil || got != want {
+			t.Errorf("normalizeVoteOption(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := normalizeVoteOption("maybe"); err == nil {
+		t.Error("normalizeVoteOption(maybe) should fail")
+	}
+}
+
+func TestProposalStatusText(t *testing.T) {
+	if got := proposalStatusText("PROPOSAL_STATUS_VOTING_PERIOD"); got != "Voting period" {
+		t.Errorf("proposalStatusText() = %q, want %q", got, "Voting period")
+	}
+}
+
+// fakeParams returns max_validators 100 until it has been polled twice.
+type fakeParams struct{ polls int }
+
+func (f *fakeParams) GetModuleParams(ctx context.Context, namespace, devnet, module string) ([]byte, error) {
+	f.polls++
+	if f.polls > 2 {
+		return []byte(`{"max_validators":200,"bond_denom":"stake"}`), nil
+	}
+	return []byte(`{"max_validators":100,"bond_denom":"stake"}`), nil
+}
+
+func TestGovSubmitter_WaitForParam(t *testing.T) {
+	s, out := newTestGovSubmitter(&fakeGovChain{})
+	params := &fakeParams{}
+
+	err := s.waitForParam(context.Background(), params, govSubmitOptions{devnet: "mydevnet"}, "staking", "max_validators", "200")
+	if err != nil {
+		t.Fatalf("waitForParam() error = %v", err)
+	}
+	if params.polls != 3 {
+		t.Errorf("polls = %d, want 3", params.polls)
+	}
+	if !strings.Contains(out.String(), "staking.max_validators is now 200") {
+		t.Errorf("output = %q", out.String())
+	}
+}
+
+func TestGovSubmitter_WaitForParamTimeout(t *testing.T) {
+	s, _ := newTestGovSubmitter(&fakeGovChain{})
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := s.waitForParam(ctx, &fakeParams{}, govSubmitOptions{devnet: "mydevnet"}, "staking", "bond_denom", `"uatom"`)
+	if err == nil || !strings.Contains(err.Error(), `staking.bond_denom is "stake", not "uatom"`) {
+		t.Errorf("waitForParam() error = %v", err)
+	}
+}
+
+func TestParamValueMatches(t *testing.T) {
+	tests := []struct {
+		got, want string
+		match     bool
+	}{
+		{`200`, `200`, true},
+		{`"0.500000000000000000"`, `"0.5"`, true},
+		{`[{"denom":"stake", "amount":"1"}]`, `[{"denom":"stake","amount":"1"}]`, true},
+		{`"60s"`, `"1m"`, false},
+		{`100`, `200`, false},
+	}
+	for _, tt := range tests {
+		if got := paramValueMatches([]byte(tt.got), []byte(tt.want)); got != tt.match {
+			t.Errorf("paramValueMatches(%s, %s) = %v, want %v", tt.got, tt.want, got, tt.match)
+		}
+	}
+}
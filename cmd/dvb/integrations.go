@@ -0,0 +1,294 @@
+// cmd/dvb/integrations.go
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// EVM tools supported by evm-config.
+const (
+	evmToolHardhat = "hardhat"
+	evmToolFoundry = "foundry"
+)
+
+// evmConfigFiles maps each EVM tool to the file evm-config writes for it.
+var evmConfigFiles = map[string]string{
+	evmToolHardhat: "hardhat.config.ts",
+	evmToolFoundry: "foundry.toml",
+}
+
+// evmConfigOptions holds options for the integrations evm-config command
+type evmConfigOptions struct {
+	tools     []string
+	outputDir string
+	force     bool
+	namespace string
+}
+
+// evmEndpoint is the JSON-RPC endpoint of a devnet node.
+type evmEndpoint struct {
+	Name string
+	URL  string
+}
+
+// evmConfig is everything the EVM tool configs are rendered from.
+type evmConfig struct {
+	Devnet    string
+	ChainID   int64
+	Endpoints []evmEndpoint
+	Keys      []*v1.AccountKey
+}
+
+func newIntegrationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "integrations",
+		Short: "Generate configuration for external tools",
+		Long: `Generate configuration that points external tools at a devnet.
+
+Examples:
+  # Print Hardhat and Foundry config for an EVM devnet
+  dvb integrations evm-config my-devnet`,
+	}
+
+	cmd.AddCommand(
+		newEVMConfigCmd(),
+	)
+
+	return cmd
+}
+
+func newEVMConfigCmd() *cobra.Command {
+	opts := &evmConfigOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "evm-config [devnet]",
+		Short: "Generate Hardhat and Foundry config for an EVM devnet",
+		Long: `Generate hardhat.config.ts and foundry.toml for a devnet of an EVM-enabled
+network, with the JSON-RPC URL of every node, the EVM chain ID and the
+private keys of the devnet's pre-funded accounts.
+
+The first network or RPC endpoint is named after the devnet and points at
+node 0; the others are named <devnet>-node<N>. Test accounts (see
+'dvb provision --accounts') come first, followed by the validator operators.
+
+Without --output the configs are printed. With --output they are written to
+the directory, refusing to overwrite existing files unless --force is set.
+
+Examples:
+  # Print both configs
+  dvb integrations evm-config my-devnet
+
+  # Write foundry.toml into the current directory
+  dvb integrations evm-config my-devnet --tool foundry -o .`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, tool := range opts.tools {
+				if _, ok := evmConfigFiles[tool]; !ok {
+					return fmt.Errorf("unsupported tool %q (use hardhat or foundry)", tool)
+				}
+			}
+
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, opts.namespace)
+			if err != nil {
+				return err
+			}
+
+			keys, err := daemonClient.ExportKeys(cmd.Context(), &v1.ExportKeysRequest{
+				DevnetName: devnetName,
+				Namespace:  ns,
+			})
+			if err != nil {
+				return err
+			}
+			if !keys.Evm || keys.EvmChainId == 0 {
+				return fmt.Errorf("devnet %q is not on an EVM-enabled network", devnetName)
+			}
+
+			nodes, err := daemonClient.ListNodes(cmd.Context(), ns, devnetName)
+			if err != nil {
+				return fmt.Errorf("failed to list nodes: %w", err)
+			}
+
+			if len(nodes) == 0 {
+				return fmt.Errorf("devnet %q has no nodes", devnetName)
+			}
+
+			cfg := evmConfig{
+				Devnet:    devnetName,
+				ChainID:   keys.EvmChainId,
+				Endpoints: evmEndpoints(devnetName, nodes),
+				Keys:      fundedKeysFirst(keys.Keys),
+			}
+
+			if opts.outputDir == "" {
+				for i, tool := range opts.tools {
+					if i > 0 {
+						fmt.Fprintln(cmd.OutOrStdout())
+					}
+					if err := renderEVMConfig(cmd.OutOrStdout(), tool, cfg); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+			for _, tool := range opts.tools {
+				path := filepath.Join(opts.outputDir, evmConfigFiles[tool])
+				if err := writeEVMConfig(path, tool, cfg, opts.force); err != nil {
+					return err
+				}
+				color.Green("✓ Wrote %s", path)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&opts.tools, "tool", []string{evmToolHardhat, evmToolFoundry}, "Tools to generate config for: hardhat, foundry")
+	cmd.Flags().StringVarP(&opts.outputDir, "output", "o", "", "Directory to write config files to (default: print)")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "Overwrite existing config files")
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", "Namespace (defaults to context or server default)")
+
+	return cmd
+}
+
+// evmEndpoints returns the JSON-RPC endpoint of every node, ordered by index.
+func evmEndpoints(devnet string, nodes []*v1.Node) []evmEndpoint {
+	sorted := append([]*v1.Node(nil), nodes...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Metadata.Index < sorted[j].Metadata.Index
+	})
+
+	endpoints := make([]evmEndpoint, 0, len(sorted))
+	for _, n := range sorted {
+		index := int(n.Metadata.Index)
+		name := devnet
+		if index > 0 {
+			name = fmt.Sprintf("%s-node%d", devnet, index)
+		}
+
+		host := "localhost"
+		if n.Spec != nil && n.Spec.Address != "" {
+			host = n.Spec.Address
+		}
+		url := "http://" + hostPort(host, nodePorts(n).EVMRPC)
+		endpoints = append(endpoints, evmEndpoint{Name: name, URL: url})
+	}
+	return endpoints
+}
+
+// fundedKeysFirst orders test accounts before validator operators, so the
+// default signer of each tool is a test account.
+func fundedKeysFirst(keys []*v1.AccountKey) []*v1.AccountKey {
+	ordered := append([]*v1.AccountKey(nil), keys...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Role != "validator" && ordered[j].Role == "validator"
+	})
+	return ordered
+}
+
+// writeEVMConfig renders a tool's config to path.
+func writeEVMConfig(path, tool string, cfg evmConfig, force bool) error {
+	if _, err := os.Stat(path); err == nil && !force {
+		return fmt.Errorf("%s already exists (use --force to overwrite)", path)
+	}
+
+	var b strings.Builder
+	if err := renderEVMConfig(&b, tool, cfg); err != nil {
+		return err
+	}
+	// The config contains private keys: keep it private to the user.
+	if err := os.WriteFile(path, []byte(b.String()), 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// renderEVMConfig renders the config of one tool.
+func renderEVMConfig(w io.Writer, tool string, cfg evmConfig) error {
+	switch tool {
+	case evmToolHardhat:
+		return renderHardhatConfig(w, cfg)
+	case evmToolFoundry:
+		return renderFoundryConfig(w, cfg)
+	default:
+		return fmt.Errorf("unsupported tool %q (use hardhat or foundry)", tool)
+	}
+}
+
+func renderHardhatConfig(w io.Writer, cfg evmConfig) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "// hardhat.config.ts for devnet %q, generated by dvb integrations evm-config.\n", cfg.Devnet)
+	b.WriteString("// The keys are deterministic devnet keys: never use them on a real network.\n")
+	b.WriteString("import type { HardhatUserConfig } from \"hardhat/config\";\n\n")
+
+	b.WriteString("const accounts = [\n")
+	for _, k := range cfg.Keys {
+		fmt.Fprintf(&b, "  %q, // %s %s\n", k.PrivateKey, k.Name, k.EvmAddress)
+	}
+	b.WriteString("];\n\n")
+
+	b.WriteString("const config: HardhatUserConfig = {\n")
+	b.WriteString("  solidity: \"0.8.24\",\n")
+	fmt.Fprintf(&b, "  defaultNetwork: %q,\n", cfg.Devnet)
+	b.WriteString("  networks: {\n")
+	for _, e := range cfg.Endpoints {
+		fmt.Fprintf(&b, "    %q: {\n", e.Name)
+		fmt.Fprintf(&b, "      url: %q,\n", e.URL)
+		fmt.Fprintf(&b, "      chainId: %d,\n", cfg.ChainID)
+		b.WriteString("      accounts,\n")
+		b.WriteString("    },\n")
+	}
+	b.WriteString("  },\n")
+	b.WriteString("};\n\n")
+	b.WriteString("export default config;\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func renderFoundryConfig(w io.Writer, cfg evmConfig) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# foundry.toml for devnet %q, generated by dvb integrations evm-config.\n", cfg.Devnet)
+	b.WriteString("# The keys are deterministic devnet keys: never use them on a real network.\n")
+	b.WriteString("#\n")
+	fmt.Fprintf(&b, "# forge script script/Deploy.s.sol --rpc-url %s --private-key $PRIVATE_KEY --broadcast\n", cfg.Devnet)
+	b.WriteString("#\n")
+	b.WriteString("# Pre-funded accounts:\n")
+	for _, k := range cfg.Keys {
+		fmt.Fprintf(&b, "#   %-12s %s %s\n", k.Name, k.EvmAddress, k.PrivateKey)
+	}
+	b.WriteString("\n")
+
+	b.WriteString("[profile.default]\n")
+	fmt.Fprintf(&b, "chain_id = %d\n", cfg.ChainID)
+	fmt.Fprintf(&b, "eth_rpc_url = %q\n", cfg.Endpoints[0].URL)
+	b.WriteString("\n")
+
+	b.WriteString("[rpc_endpoints]\n")
+	for _, e := range cfg.Endpoints {
+		fmt.Fprintf(&b, "%q = %q\n", e.Name, e.URL)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
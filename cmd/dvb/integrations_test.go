@@ -0,0 +1,120 @@
+// cmd/dvb/integrations_test.go
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+func testEVMConfig() evmConfig {
+	nodes := []*v1.Node{
+		{Metadata: &v1.NodeMetadata{Index: 1}, Spec: &v1.NodeSpec{Address: "127.0.42.2"}},
+		{Metadata: &v1.NodeMetadata{Index: 0}, Spec: &v1.NodeSpec{Address: "127.0.42.1"}},
+	}
+	keys := []*v1.AccountKey{
+		{Name: "validator0", Role: "validator", EvmAddress: "0xVAL", PrivateKey: "0x01"},
+		{Name: "account0", Role: "account", EvmAddress: "0xACC", PrivateKey: "0x02"},
+	}
+	return evmConfig{
+		Devnet:    "evm",
+		ChainID:   9000,
+		Endpoints: evmEndpoints("evm", nodes),
+		Keys:      fundedKeysFirst(keys),
+	}
+}
+
+func TestEVMEndpoints(t *testing.T) {
+	cfg := testEVMConfig()
+	if len(cfg.Endpoints) != 2 {
+		t.Fatalf("expected 2 endpoints, got %d", len(cfg.Endpoints))
+	}
+	if cfg.Endpoints[0] != (evmEndpoint{Name: "evm", URL: "http://127.0.42.1:8545"}) {
+		t.Errorf("unexpected first endpoint: %+v", cfg.Endpoints[0])
+	}
+	if cfg.Endpoints[1].Name != "evm-node1" {
+		t.Errorf("second endpoint name = %q, want evm-node1", cfg.Endpoints[1].Name)
+	}
+
+	legacy := evmEndpoints("evm", []*v1.Node{{Metadata: &v1.NodeMetadata{Index: 2}}})
+	if legacy[0].URL != "http://localhost:8745" {
+		t.Errorf("legacy URL = %q, want http://localhost:8745", legacy[0].URL)
+	}
+
+	// Nodes report their ports from the devnet's port layout
+	custom := evmEndpoints("evm", []*v1.Node{{
+		Metadata: &v1.NodeMetadata{Index: 1},
+		Spec:     &v1.NodeSpec{Address: "127.0.42.2", Ports: &v1.NetworkPortConfig{EvmRpc: 9555}},
+	}})
+	if custom[0].URL != "http://127.0.42.2:9555" {
+		t.Errorf("custom layout URL = %q, want http://127.0.42.2:9555", custom[0].URL)
+	}
+}
+
+func TestFundedKeysFirst(t *testing.T) {
+	cfg := testEVMConfig()
+	if cfg.Keys[0].Name != "account0" || cfg.Keys[1].Name != "validator0" {
+		t.Errorf("test accounts should come first, got %s, %s", cfg.Keys[0].Name, cfg.Keys[1].Name)
+	}
+}
+
+func TestRenderEVMConfig(t *testing.T) {
+	cfg := testEVMConfig()
+
+	var hardhat bytes.Buffer
+	if err := renderEVMConfig(&hardhat, evmToolHardhat, cfg); err != nil {
+		t.Fatalf("render hardhat: %v", err)
+	}
+	for _, want := range []string{
+		`"0x02", // account0 0xACC`,
+		`defaultNetwork: "evm"`,
+		`"evm-node1": {`,
+		`url: "http://127.0.42.2:8545"`,
+		`chainId: 9000`,
+	} {
+		if !strings.Contains(hardhat.String(), want) {
+			t.Errorf("hardhat config missing %q:\n%s", want, hardhat.String())
+		}
+	}
+
+	var foundry bytes.Buffer
+	if err := renderEVMConfig(&foundry, evmToolFoundry, cfg); err != nil {
+		t.Fatalf("render foundry: %v", err)
+	}
+	for _, want := range []string{
+		"chain_id = 9000\n",
+		`eth_rpc_url = "http://127.0.42.1:8545"`,
+		`"evm-node1" = "http://127.0.42.2:8545"`,
+		"account0",
+	} {
+		if !strings.Contains(foundry.String(), want) {
+			t.Errorf("foundry config missing %q:\n%s", want, foundry.String())
+		}
+	}
+
+	if err := renderEVMConfig(&foundry, "truffle", cfg); err == nil {
+		t.Error("renderEVMConfig should reject unknown tools")
+	}
+}
+
+func TestWriteEVMConfig_RefusesOverwrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), evmConfigFiles[evmToolFoundry])
+	if err := os.WriteFile(path, []byte("existing"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writeEVMConfig(path, evmToolFoundry, testEVMConfig(), false); err == nil {
+		t.Fatal("writeEVMConfig should refuse to overwrite without force")
+	}
+	if err := writeEVMConfig(path, evmToolFoundry, testEVMConfig(), true); err != nil {
+		t.Fatalf("writeEVMConfig with force: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), "[rpc_endpoints]") {
+		t.Errorf("file not overwritten:\n%s", data)
+	}
+}
@@ -15,9 +15,10 @@ var (
 )
 
 // IsNonInteractive returns true if interactive mode should be disabled.
-// Checks the --non-interactive flag, DVB_NON_INTERACTIVE=1 / CI=true env vars, or non-TTY.
+// Checks the --non-interactive flag, --log-format json, DVB_NON_INTERACTIVE=1 / CI=true env vars, or non-TTY.
 func IsNonInteractive() bool {
 	return flagNonInteractive ||
+		logEvents != nil ||
 		os.Getenv("DVB_NON_INTERACTIVE") == "1" ||
 		os.Getenv("CI") == "true" ||
 		!tui.IsInteractive()
@@ -0,0 +1,236 @@
+// cmd/dvb/keys.go
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// Key export formats.
+const (
+	keysFormatJSON = "json"
+	keysFormatEnv  = "env"
+	keysFormatCSV  = "csv"
+)
+
+// exportKeysOptions holds options for the keys export command
+type exportKeysOptions struct {
+	format    string
+	output    string
+	namespace string
+}
+
+func newKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Manage devnet keys",
+		Long: `Manage the validator and test-account keys of a devnet.
+
+Examples:
+  # Export keys as a dotenv file for Hardhat or Foundry
+  dvb keys export my-devnet --format env -o .env`,
+	}
+
+	cmd.AddCommand(
+		newKeysExportCmd(),
+	)
+
+	return cmd
+}
+
+func newKeysExportCmd() *cobra.Command {
+	opts := &exportKeysOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "export [devnet]",
+		Short: "Export validator and test-account keys",
+		Long: `Export the mnemonics, addresses and private keys of a devnet's validator
+operators and test accounts (see 'dvb provision --accounts').
+
+Keys are derived deterministically from each key's role and index, so the
+same keys are valid on every devnet of a network. They are for local
+testing only and must never hold real funds.
+
+Formats:
+  json  Array of keys with name, role, addresses, mnemonic and private key
+  env   Dotenv variables (VALIDATOR_0_MNEMONIC, ACCOUNT_0_PRIVATE_KEY, ...)
+        plus CHAIN_ID and PRIVATE_KEYS, a comma-separated list for Hardhat
+  csv   One row per key, for CI secret stores
+
+EVM chains include the 0x address of each key.
+
+Examples:
+  # Print keys for the current devnet as JSON
+  dvb keys export
+
+  # Write a dotenv file for Hardhat or Foundry
+  dvb keys export my-devnet --format env -o .env
+
+  # Export keys as CSV
+  dvb keys export my-devnet --format csv -o keys.csv`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateKeysFormat(opts.format); err != nil {
+				return err
+			}
+
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, opts.namespace)
+			if err != nil {
+				return err
+			}
+
+			resp, err := daemonClient.ExportKeys(cmd.Context(), &v1.ExportKeysRequest{
+				DevnetName: devnetName,
+				Namespace:  ns,
+			})
+			if err != nil {
+				return err
+			}
+
+			if opts.output == "" {
+				return writeKeys(cmd.OutOrStdout(), opts.format, resp)
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+
+			// Keys are secrets: keep the file private to the user.
+			f, err := os.OpenFile(opts.output, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+			if err != nil {
+				return fmt.Errorf("failed to create %s: %w", opts.output, err)
+			}
+			if err := writeKeys(f, opts.format, resp); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return fmt.Errorf("failed to write %s: %w", opts.output, err)
+			}
+
+			color.Green("✓ Exported %d keys to %s", len(resp.Keys), opts.output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.format, "format", keysFormatJSON, "Output format: json, env, csv")
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "", "Output file (default: stdout)")
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", "Namespace (defaults to context or server default)")
+
+	return cmd
+}
+
+// validateKeysFormat checks that format is a supported key export format.
+func validateKeysFormat(format string) error {
+	switch format {
+	case keysFormatJSON, keysFormatEnv, keysFormatCSV:
+		return nil
+	default:
+		return fmt.Errorf("unsupported format %q (use json, env or csv)", format)
+	}
+}
+
+// writeKeys writes exported keys to w in the given format.
+func writeKeys(w io.Writer, format string, resp *v1.ExportKeysResponse) error {
+	switch format {
+	case keysFormatJSON:
+		return writeKeysJSON(w, resp)
+	case keysFormatEnv:
+		return writeKeysEnv(w, resp)
+	case keysFormatCSV:
+		return writeKeysCSV(w, resp)
+	default:
+		return validateKeysFormat(format)
+	}
+}
+
+// exportedKey is the JSON form of an exported key.
+type exportedKey struct {
+	Name       string `json:"name"`
+	Role       string `json:"role"`
+	Index      int32  `json:"index"`
+	Address    string `json:"address"`
+	EVMAddress string `json:"evmAddress,omitempty"`
+	Mnemonic   string `json:"mnemonic"`
+	HDPath     string `json:"hdPath"`
+	PrivateKey string `json:"privateKey"`
+}
+
+func writeKeysJSON(w io.Writer, resp *v1.ExportKeysResponse) error {
+	out := struct {
+		ChainID string        `json:"chainId"`
+		EVM     bool          `json:"evm"`
+		Keys    []exportedKey `json:"keys"`
+	}{
+		ChainID: resp.ChainId,
+		EVM:     resp.Evm,
+		Keys:    make([]exportedKey, 0, len(resp.Keys)),
+	}
+	for _, k := range resp.Keys {
+		out.Keys = append(out.Keys, exportedKey{
+			Name:       k.Name,
+			Role:       k.Role,
+			Index:      k.Index,
+			Address:    k.Address,
+			EVMAddress: k.EvmAddress,
+			Mnemonic:   k.Mnemonic,
+			HDPath:     k.HdPath,
+			PrivateKey: k.PrivateKey,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+func writeKeysEnv(w io.Writer, resp *v1.ExportKeysResponse) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CHAIN_ID=%s\n", resp.ChainId)
+
+	privateKeys := make([]string, 0, len(resp.Keys))
+	for _, k := range resp.Keys {
+		prefix := fmt.Sprintf("%s_%d_", strings.ToUpper(k.Role), k.Index)
+		fmt.Fprintf(&b, "%sADDRESS=%s\n", prefix, k.Address)
+		if k.EvmAddress != "" {
+			fmt.Fprintf(&b, "%sEVM_ADDRESS=%s\n", prefix, k.EvmAddress)
+		}
+		fmt.Fprintf(&b, "%sMNEMONIC=\"%s\"\n", prefix, k.Mnemonic)
+		fmt.Fprintf(&b, "%sPRIVATE_KEY=%s\n", prefix, k.PrivateKey)
+		privateKeys = append(privateKeys, k.PrivateKey)
+	}
+	fmt.Fprintf(&b, "PRIVATE_KEYS=%s\n", strings.Join(privateKeys, ","))
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeKeysCSV(w io.Writer, resp *v1.ExportKeysResponse) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"name", "role", "address", "evm_address", "private_key", "mnemonic"}); err != nil {
+		return err
+	}
+	for _, k := range resp.Keys {
+		if err := cw.Write([]string{k.Name, k.Role, k.Address, k.EvmAddress, k.PrivateKey, k.Mnemonic}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,90 @@
+// cmd/dvb/keys_test.go
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+func testKeysResponse() *v1.ExportKeysResponse {
+	return &v1.ExportKeysResponse{
+		ChainId: "stable-1",
+		Evm:     true,
+		Keys: []*v1.AccountKey{
+			{Name: "validator0", Role: "validator", Index: 0, Address: "stable1val", EvmAddress: "0xVAL", Mnemonic: "word one", PrivateKey: "0x01"},
+			{Name: "account0", Role: "account", Index: 0, Address: "stable1acc", EvmAddress: "0xACC", Mnemonic: "word two", PrivateKey: "0x02"},
+		},
+	}
+}
+
+func TestWriteKeysJSON(t *testing.T) {
+	var out bytes.Buffer
+	if err := writeKeys(&out, keysFormatJSON, testKeysResponse()); err != nil {
+		t.Fatalf("writeKeys: %v", err)
+	}
+
+	var decoded struct {
+		ChainID string        `json:"chainId"`
+		Keys    []exportedKey `json:"keys"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &decoded); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if decoded.ChainID != "stable-1" || len(decoded.Keys) != 2 {
+		t.Fatalf("unexpected JSON output:\n%s", out.String())
+	}
+	if decoded.Keys[1].EVMAddress != "0xACC" {
+		t.Errorf("evmAddress = %q, want 0xACC", decoded.Keys[1].EVMAddress)
+	}
+}
+
+func TestWriteKeysEnv(t *testing.T) {
+	var out bytes.Buffer
+	if err := writeKeys(&out, keysFormatEnv, testKeysResponse()); err != nil {
+		t.Fatalf("writeKeys: %v", err)
+	}
+
+	for _, want := range []string{
+		"CHAIN_ID=stable-1\n",
+		"VALIDATOR_0_ADDRESS=stable1val\n",
+		"ACCOUNT_0_EVM_ADDRESS=0xACC\n",
+		"ACCOUNT_0_MNEMONIC=\"word two\"\n",
+		"PRIVATE_KEYS=0x01,0x02\n",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("env output missing %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestWriteKeysCSV(t *testing.T) {
+	var out bytes.Buffer
+	if err := writeKeys(&out, keysFormatCSV, testKeysResponse()); err != nil {
+		t.Fatalf("writeKeys: %v", err)
+	}
+
+	rows, err := csv.NewReader(&out).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected header and 2 rows, got %d", len(rows))
+	}
+	if rows[2][0] != "account0" || rows[2][5] != "word two" {
+		t.Errorf("unexpected row: %v", rows[2])
+	}
+}
+
+func TestValidateKeysFormat(t *testing.T) {
+	if err := validateKeysFormat("yaml"); err == nil {
+		t.Error("validateKeysFormat(yaml) should fail")
+	}
+	if err := validateKeysFormat(keysFormatEnv); err != nil {
+		t.Errorf("validateKeysFormat(env): %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+// cmd/dvb/logformat.go
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/output"
+	"github.com/altuslabsxyz/devnet-builder/internal/triage"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// flagLogFormat selects text or JSON events for human-oriented output.
+	flagLogFormat string
+
+	// logEvents receives JSON events when --log-format json is set.
+	logEvents *output.EventWriter
+
+	// commandStart is when this invocation started, for the result event.
+	commandStart = time.Now()
+)
+
+// setupLogFormat applies --log-format. In JSON format, everything printed to
+// stderr, color prints, spinners and progress become JSON events on stderr,
+// and cobra's own error and usage printing is silenced so the final result
+// event carries the error instead.
+func setupLogFormat(cmd *cobra.Command) error {
+	format, err := output.ParseLogFormat(flagLogFormat)
+	if err != nil {
+		return err
+	}
+	if format != output.LogFormatJSON {
+		return nil
+	}
+
+	if logEvents == nil {
+		events := output.NewEventWriter(os.Stderr, cmd.CommandPath())
+		if err := output.EnableJSONEvents(events); err != nil {
+			return err
+		}
+		logEvents = events
+	}
+	logEvents.SetCommand(cmd.CommandPath())
+	cmd.Root().SilenceErrors = true
+	cmd.Root().SilenceUsage = true
+	return nil
+}
+
+// emitCommandResult writes the final JSON event of the invocation, with a
+// warning event for the remediation hint of a known failure first.
+func emitCommandResult(err error) {
+	output.CloseJSONEvents()
+	if err != nil {
+		if entry := triage.Match(err.Error()); entry != nil {
+			logEvents.Emit(output.LevelWarn, "Hint: "+entry.Title+"; run 'dvb explain "+entry.Code+"' for details")
+		}
+	}
+	logEvents.EmitResult(err, time.Since(commandStart))
+}
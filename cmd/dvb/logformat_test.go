@@ -0,0 +1,48 @@
+// cmd/dvb/logformat_test.go
+package main
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestSetupLogFormat_TextAndInvalid(t *testing.T) {
+	defer func(old string) { flagLogFormat = old }(flagLogFormat)
+	cmd := &cobra.Command{Use: "dvb"}
+
+	flagLogFormat = "text"
+	if err := setupLogFormat(cmd); err != nil {
+		t.Fatalf("setupLogFormat(text) error = %v", err)
+	}
+	if logEvents != nil {
+		t.Error("text format should not enable JSON events")
+	}
+
+	flagLogFormat = "xml"
+	if err := setupLogFormat(cmd); err == nil {
+		t.Error("setupLogFormat(xml) should fail")
+	}
+}
+
+func TestForwardedGlobalArgs_LogFormat(t *testing.T) {
+	defer func(old string) { flagLogFormat = old }(flagLogFormat)
+
+	flagLogFormat = "text"
+	for _, arg := range forwardedGlobalArgs() {
+		if arg == "--log-format=text" {
+			t.Error("the default log format should not be forwarded")
+		}
+	}
+
+	flagLogFormat = "json"
+	found := false
+	for _, arg := range forwardedGlobalArgs() {
+		if arg == "--log-format=json" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("forwardedGlobalArgs() = %v, want --log-format=json", forwardedGlobalArgs())
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/altuslabsxyz/devnet-builder/internal/client"
 	"github.com/altuslabsxyz/devnet-builder/internal/dvbcontext"
+	"github.com/altuslabsxyz/devnet-builder/internal/redact"
 	"github.com/fatih/color"
 	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/cobra"
@@ -66,6 +67,7 @@ type logsOptions struct {
 	tail      int
 	dataDir   string
 	timestamp bool
+	since     time.Duration
 }
 
 func newNodeLogsCmd() *cobra.Command {
@@ -105,10 +107,18 @@ Examples:
   # Show last 100 lines
   dvb node logs --tail 100
 
+  # Show the logs of the last hour, including rotated log files
+  dvb node logs validator-0 --since 1h
+
   # Show logs with timestamps
   dvb node logs --timestamps`,
-		Args: cobra.RangeArgs(0, 2),
+		Args:              cobra.RangeArgs(0, 2),
+		ValidArgsFunction: completeNodeArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.since < 0 {
+				return fmt.Errorf("--since must not be negative")
+			}
+
 			var explicitDevnet, nodeArg string
 
 			if len(args) == 0 {
@@ -160,6 +170,7 @@ Examples:
 	cmd.Flags().IntVarP(&opts.tail, "tail", "n", 0, "Number of lines to show from the end (0 = all)")
 	cmd.Flags().StringVar(&opts.dataDir, "data-dir", "", "Base data directory (default: ~/.devnet-builder)")
 	cmd.Flags().BoolVarP(&opts.timestamp, "timestamps", "t", false, "Show timestamps")
+	cmd.Flags().DurationVar(&opts.since, "since", 0, "Only show logs newer than this (e.g. 30m, 1h), by rotated log file")
 
 	return cmd
 }
@@ -185,6 +196,9 @@ func runLogs(ctx context.Context, opts *logsOptions) error {
 	}
 
 	// Fall back to file-based logs (standalone mode or multi-node)
+	if opts.since > 0 {
+		return fmt.Errorf("--since needs the daemon and a single node")
+	}
 	devnetPath := filepath.Join(dataDir, "devnets", opts.devnet)
 	if _, err := os.Stat(devnetPath); os.IsNotExist(err) {
 		return fmt.Errorf("devnet '%s' not found", opts.devnet)
@@ -457,7 +471,7 @@ func printLogLine(w io.Writer, node, line string, showTimestamp bool) {
 		nodeColor := getNodeColor(node)
 		parts = append(parts, nodeColor("["+node+"]"))
 	}
-	parts = append(parts, line)
+	parts = append(parts, redact.String(line))
 	fmt.Fprintln(w, strings.Join(parts, " "))
 }
 
@@ -521,15 +535,20 @@ func streamLogsFromDaemonWithClient(ctx context.Context, opts *logsOptions, inde
 
 	nodeColor := getNodeColor(opts.node)
 
-	return c.StreamNodeLogs(ctx, opts.devnet, index, opts.follow, "", opts.tail,
+	var since string
+	if opts.since > 0 {
+		since = time.Now().Add(-opts.since).Format(time.RFC3339)
+	}
+
+	return c.StreamNodeLogs(ctx, opts.devnet, index, opts.follow, since, opts.tail,
 		func(entry *client.LogEntry) error {
 			if opts.timestamp && !entry.Timestamp.IsZero() {
 				fmt.Fprintf(w, "%s %s %s\n",
 					color.WhiteString(entry.Timestamp.Format(time.RFC3339)),
 					nodeColor("["+opts.node+"]"),
-					entry.Message)
+					redact.String(entry.Message))
 			} else {
-				fmt.Fprintf(w, "%s %s\n", nodeColor("["+opts.node+"]"), entry.Message)
+				fmt.Fprintf(w, "%s %s\n", nodeColor("["+opts.node+"]"), redact.String(entry.Message))
 			}
 			return nil
 		})
@@ -0,0 +1,152 @@
+// cmd/dvb/logs_grep.go
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Search the logs of a devnet",
+		Long: `Search the logs of a devnet's nodes and the daemon.
+
+To view the logs of a node, use 'dvb node logs'; for the daemon's own log,
+use 'dvb daemon logs'.`,
+	}
+
+	cmd.AddCommand(newLogsGrepCmd())
+
+	return cmd
+}
+
+func newLogsGrepCmd() *cobra.Command {
+	var (
+		namespace    string
+		since        time.Duration
+		contextLines int
+		ignoreCase   bool
+		maxMatches   int
+		output       string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "grep [devnet] <pattern>",
+		Short: "Search the logs of every node of a devnet",
+		Long: `Search the logs of every node of a devnet, and the daemon log lines that
+mention it, for a regular expression (RE2 syntax). The daemon searches the
+logs in parallel and returns the matches with the node they came from, so
+there is no need to read each node's log in turn.
+
+--since skips older logs. Docker mode node logs are filtered by line; the
+logs of local-mode nodes carry no date, so they are filtered by rotated log
+file.
+
+Secrets in the matching lines are redacted.`,
+		Example: `  # Find consensus failures of the last 30 minutes, with 3 lines of context
+  dvb logs grep my-devnet "consensus failure" --since 30m --context 3
+
+  # Case-insensitive search in the current devnet (see 'dvb use')
+  dvb logs grep -i "panic|fatal"`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeDevnetNames,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := validation.OutputFormat(output, "json"); err != nil {
+				return err
+			}
+			if since < 0 {
+				return fmt.Errorf("--since must not be negative")
+			}
+			if err := validation.NonNegative("context", contextLines); err != nil {
+				return err
+			}
+			return validation.NonNegative("max-matches", maxMatches)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			pattern := args[len(args)-1]
+			if len(args) == 2 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			req := &v1.SearchLogsRequest{
+				DevnetName: devnetName,
+				Namespace:  ns,
+				Pattern:    pattern,
+				IgnoreCase: ignoreCase,
+				Context:    int32(contextLines),
+				MaxMatches: int32(maxMatches),
+			}
+			if since > 0 {
+				req.Since = timestamppb.New(time.Now().Add(-since))
+			}
+			resp, err := daemonClient.SearchLogs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			if output == "json" {
+				return printJSON(resp)
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+			printLogMatches(os.Stdout, resp.Matches, contextLines > 0)
+			if resp.Truncated {
+				color.Yellow("Showing the first %d matches; narrow the search or raise --max-matches", len(resp.Matches))
+			}
+			for _, e := range resp.Errors {
+				color.Yellow("⚠ could not search %s", e)
+			}
+			if len(resp.Matches) == 0 {
+				fmt.Println("No matches")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().DurationVar(&since, "since", 0, "Only search logs newer than this (e.g. 30m, 1h)")
+	cmd.Flags().IntVarP(&contextLines, "context", "C", 0, "Lines to show before and after each match (max 100)")
+	cmd.Flags().BoolVarP(&ignoreCase, "ignore-case", "i", false, "Match case-insensitively")
+	cmd.Flags().IntVar(&maxMatches, "max-matches", 1000, "Maximum number of matches (max 10000)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json)")
+
+	return cmd
+}
+
+// printLogMatches prints matches like grep over several files: each line
+// prefixed with its source, "source:" for matches and "source-" for
+// context, with groups of context separated by "--".
+func printLogMatches(w io.Writer, matches []*v1.LogMatch, withContext bool) {
+	for i, m := range matches {
+		if withContext && i > 0 {
+			fmt.Fprintln(w, "--")
+		}
+		source := getNodeColor(m.Source)
+		for _, line := range m.Before {
+			fmt.Fprintf(w, "%s %s\n", source("%s-", m.Source), line)
+		}
+		fmt.Fprintf(w, "%s %s\n", source("%s:", m.Source), m.Line)
+		for _, line := range m.After {
+			fmt.Fprintf(w, "%s %s\n", source("%s-", m.Source), line)
+		}
+	}
+}
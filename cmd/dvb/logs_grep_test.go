@@ -0,0 +1,33 @@
+// cmd/dvb/logs_grep_test.go
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+func TestPrintLogMatches(t *testing.T) {
+	matches := []*v1.LogMatch{
+		{Source: "validator-0", Line: "ERR consensus failure", Before: []string{"INF committed"}, After: []string{"INF recovering"}},
+		{Source: "daemon", Line: "level=ERROR msg=\"node crashed\""},
+	}
+
+	var buf bytes.Buffer
+	printLogMatches(&buf, matches, true)
+	want := "validator-0- INF committed\n" +
+		"validator-0: ERR consensus failure\n" +
+		"validator-0- INF recovering\n" +
+		"--\n" +
+		"daemon: level=ERROR msg=\"node crashed\"\n"
+	if got := buf.String(); got != want {
+		t.Errorf("printLogMatches() =\n%s\nwant\n%s", got, want)
+	}
+
+	buf.Reset()
+	printLogMatches(&buf, matches[1:], false)
+	if got, want := buf.String(), "daemon: level=ERROR msg=\"node crashed\"\n"; got != want {
+		t.Errorf("printLogMatches() without context = %q, want %q", got, want)
+	}
+}
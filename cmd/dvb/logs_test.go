@@ -23,6 +23,7 @@ type mockNodeLogStreamer struct {
 	calledDevnet string
 	calledIndex  int
 	calledFollow bool
+	calledSince  string
 	calledTail   int
 }
 
@@ -30,6 +31,7 @@ func (m *mockNodeLogStreamer) StreamNodeLogs(ctx context.Context, devnetName str
 	m.calledDevnet = devnetName
 	m.calledIndex = index
 	m.calledFollow = follow
+	m.calledSince = since
 	m.calledTail = tail
 	if m.err != nil {
 		return m.err
@@ -203,6 +205,24 @@ func TestStreamLogsFromDaemonWithClient(t *testing.T) {
 		}
 	})
 
+	t.Run("passes since as a timestamp", func(t *testing.T) {
+		mock := &mockNodeLogStreamer{}
+		var buf bytes.Buffer
+		opts := &logsOptions{devnet: "test", node: "0", since: time.Hour}
+
+		if err := streamLogsFromDaemonWithClient(context.Background(), opts, 0, mock, &buf); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		since, err := time.Parse(time.RFC3339, mock.calledSince)
+		if err != nil {
+			t.Fatalf("since should be RFC3339, got %q", mock.calledSince)
+		}
+		if d := time.Until(since) + time.Hour; d < -time.Minute || d > time.Minute {
+			t.Errorf("since should be an hour ago, got %s", since)
+		}
+	})
+
 	t.Run("nil client returns error", func(t *testing.T) {
 		var buf bytes.Buffer
 		opts := &logsOptions{devnet: "test", node: "0"}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/client"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
 	"github.com/altuslabsxyz/devnet-builder/internal/dvbcontext"
 	"github.com/altuslabsxyz/devnet-builder/internal/output"
@@ -102,10 +103,23 @@ func main() {
 		Short: "Devnet Builder CLI",
 		Long:  `dvb is a CLI for managing blockchain development networks.`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			// Skip daemon connection for certain commands
+			if err := setupLogFormat(cmd); err != nil {
+				return err
+			}
+
+			// Fail fast on invalid input, before connecting to the daemon
+			if err := validation.Run(cmd, args); err != nil {
+				return err
+			}
+
+			// Skip daemon connection for certain commands. The ci commands
+			// connect themselves, running an embedded engine if needed.
 			if cmd.Name() == "daemon" || cmd.Parent() != nil && cmd.Parent().Name() == "daemon" {
 				return nil
 			}
+			if cmd.Parent() != nil && cmd.Parent().Name() == "ci" {
+				return nil
+			}
 
 			// Skip if standalone mode
 			if standalone {
@@ -115,8 +129,9 @@ func main() {
 			// Connection precedence:
 			// 1. --local flag -> use Unix socket
 			// 2. --server flag -> use specified remote
-			// 3. ~/.dvb/config.yaml server -> use configured remote
-			// 4. Default -> try local Unix socket
+			// 3. Named context in use -> use its server, or the Unix socket
+			// 4. ~/.dvb/config.yaml server -> use configured remote
+			// 5. Default -> try local Unix socket
 
 			var c *client.Client
 			var err error
@@ -124,6 +139,9 @@ func main() {
 			if flagLocal {
 				// Force local Unix socket connection
 				c, err = client.New()
+				if err != nil {
+					c, err = maybeAutostart(cmd)
+				}
 				if err == nil {
 					daemonClient = c
 				}
@@ -142,6 +160,27 @@ func main() {
 					return fmt.Errorf("failed to connect to remote server: %w", err)
 				}
 				daemonClient = c
+			} else if named, _ := dvbcontext.CurrentNamed(); named != nil {
+				// Use the daemon of the named context
+				if named.Server != "" {
+					apiKey := flagAPIKey
+					if apiKey == "" {
+						apiKey = named.APIKey
+					}
+					c, err = client.NewRemoteClient(named.Server, apiKey)
+					if err != nil {
+						return fmt.Errorf("failed to connect to server of context %q: %w", named.Name, err)
+					}
+					daemonClient = c
+				} else {
+					c, err = client.New()
+					if err != nil {
+						c, err = maybeAutostart(cmd)
+					}
+					if err == nil {
+						daemonClient = c
+					}
+				}
 			} else {
 				// Check config file for remote server
 				cfg, cfgErr := client.LoadConfig()
@@ -159,18 +198,27 @@ func main() {
 				} else {
 					// Default: try local Unix socket
 					c, err = client.New()
+					if err != nil {
+						c, err = maybeAutostart(cmd)
+					}
 					if err == nil {
 						daemonClient = c
 					}
 				}
 			}
 
+			if daemonClient != nil && shouldSyncGitHubToken(cmd) {
+				syncGitHubToken(cmd.Context(), daemonClient)
+			}
+
 			// Load context (ignore errors, context is optional)
 			currentContext, _ = dvbcontext.Load()
 
 			return nil
 		},
 		PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
+			recordCommand(cmd, os.Args[1:])
+
 			if daemonClient != nil {
 				return daemonClient.Close()
 			}
@@ -185,6 +233,7 @@ func main() {
 	rootCmd.PersistentFlags().BoolVar(&flagLocal, "local", false, "Force local Unix socket connection (ignore config)")
 	rootCmd.PersistentFlags().BoolVarP(&flagYes, "yes", "y", false, "Auto-confirm all prompts (skip confirmations)")
 	rootCmd.PersistentFlags().BoolVar(&flagNonInteractive, "non-interactive", false, "Disable all interactive UI elements (pickers, wizards)")
+	rootCmd.PersistentFlags().StringVar(&flagLogFormat, "log-format", "text", "Output format for messages and progress: text or json (JSON events on stderr, for CI)")
 
 	// Add commands
 	rootCmd.AddCommand(
@@ -200,16 +249,50 @@ func main() {
 		newTxCmd(),
 		newGovCmd(),
 		newGenesisCmd(),
+		newBuildCmd(),
+		newExportCmd(),
+		newImportCmd(),
+		newKeysCmd(),
+		newProjectCmd(),
+		newNamespaceCmd(),
+		newExtendCmd(),
+		newSetCmd(),
+		newRolloutCmd(),
+		newWaitCmd(),
+		newCICmd(),
+		newIntegrationsCmd(),
+		newAnalyzeCmd(),
+		newBlocksCmd(),
+		newBlockCmd(),
+		newEventsCmd(),
+		newLogsCmd(),
+		newNetCmd(),
+		newDebugCmd(),
+		newAuthCmd(),
 		newProvisionCmd(),
 		newConfigCmd(),
 		newCompletionCmd(),
+		newExplainCmd(),
+		newDoctorCmd(),
+		newRecordCmd(),
+		newReplayCmd(),
+		newChainCmd(),
+		newValidatorCmd(),
+		newDashboardCmd(),
 		newDeprecatedStartCmd(),
 		newDeprecatedStopCmd(),
 	)
 
-	if err := rootCmd.Execute(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+	err := rootCmd.Execute()
+	if logEvents != nil {
+		emitCommandResult(err)
+		if err != nil {
+			os.Exit(exitCode(err))
+		}
+		return
+	}
+	if err != nil {
+		exitWithError(err)
 	}
 }
 
@@ -291,16 +374,18 @@ func newListCmd() *cobra.Command {
 				return nil
 			}
 
+			now := time.Now()
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			fmt.Fprintln(w, "NAMESPACE\tNAME\tPHASE\tNODES\tREADY\tHEIGHT")
+			fmt.Fprintln(w, "NAMESPACE\tNAME\tPHASE\tNODES\tREADY\tHEIGHT\tTTL")
 			for _, d := range devnets {
-				fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\n",
+				fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%s\n",
 					d.Metadata.Namespace,
 					d.Metadata.Name,
 					d.Status.Phase,
 					d.Status.Nodes,
 					d.Status.ReadyNodes,
-					d.Status.CurrentHeight)
+					d.Status.CurrentHeight,
+					ttlRemaining(d.Status.ExpiresAt, now))
 			}
 			w.Flush()
 
@@ -362,6 +447,8 @@ func getBinaryNameFromPlugin(plugin string) string {
 		return "gaiad"
 	case "osmosis":
 		return "osmosisd"
+	case "evmos":
+		return "evmosd"
 	default:
 		return "gaiad"
 	}
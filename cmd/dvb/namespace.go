@@ -0,0 +1,173 @@
+// cmd/dvb/namespace.go
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newNamespaceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "namespace",
+		Aliases: []string{"ns"},
+		Short:   "Manage namespaces and their quotas",
+		Long: `Manage namespaces and their quotas.
+
+Namespaces exist implicitly as soon as a devnet is created in them. Creating a
+namespace explicitly reserves it and can put a quota on the number of devnets
+and the total number of nodes (validators plus full nodes) it may hold. The
+daemon rejects devnets that would exceed the quota.
+
+Examples:
+  # Create a namespace limited to 3 devnets and 12 nodes
+  dvb namespace create team-a --max-devnets 3 --max-nodes 12
+
+  # List namespaces with usage
+  dvb namespace list
+
+  # Delete an empty namespace
+  dvb namespace delete team-a`,
+	}
+
+	cmd.AddCommand(
+		newNamespaceCreateCmd(),
+		newNamespaceListCmd(),
+		newNamespaceDeleteCmd(),
+	)
+
+	return cmd
+}
+
+func newNamespaceCreateCmd() *cobra.Command {
+	var maxDevnets, maxNodes int
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a namespace with an optional quota",
+		Long: `Create a namespace with an optional quota. A namespace that already holds
+devnets can be created to put a quota on it. Limits of 0 are unlimited.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			ns, err := daemonClient.CreateNamespace(cmd.Context(), &v1.CreateNamespaceRequest{
+				Name: args[0],
+				Quota: &v1.NamespaceQuota{
+					MaxDevnets: int32(maxDevnets),
+					MaxNodes:   int32(maxNodes),
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			color.Green("✓ Namespace %q created", ns.Name)
+			fmt.Printf("  Devnets: %s\n", quotaUsage(ns.DevnetCount, ns.Quota.GetMaxDevnets()))
+			fmt.Printf("  Nodes:   %s\n", quotaUsage(ns.NodeCount, ns.Quota.GetMaxNodes()))
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&maxDevnets, "max-devnets", 0, "Maximum number of devnets (0 = unlimited)")
+	cmd.Flags().IntVar(&maxNodes, "max-nodes", 0, "Maximum number of nodes across all devnets (0 = unlimited)")
+
+	return cmd
+}
+
+func newNamespaceListCmd() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List namespaces with their quota and usage",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			namespaces, err := daemonClient.ListNamespaces(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			if output == "json" {
+				return printJSON(namespaces)
+			}
+
+			if len(namespaces) == 0 {
+				fmt.Println("No namespaces found")
+				return nil
+			}
+
+			printNamespaces(os.Stdout, namespaces)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format: json")
+
+	return cmd
+}
+
+func newNamespaceDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete an empty namespace",
+		Long: `Delete a namespace and its quota. The namespace must not hold any devnets;
+delete them first. The default namespace cannot be deleted.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			if err := daemonClient.DeleteNamespace(cmd.Context(), args[0]); err != nil {
+				return err
+			}
+
+			color.Green("✓ Namespace %q deleted", args[0])
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// printNamespaces prints namespaces as a table. Implicit namespaces, which
+// only exist because they hold devnets, are marked with "-" as created time.
+func printNamespaces(out io.Writer, namespaces []*v1.Namespace) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tDEVNETS\tNODES\tCREATED")
+	for _, ns := range namespaces {
+		created := "-"
+		if !ns.Implicit && ns.CreatedAt != nil {
+			created = ns.CreatedAt.AsTime().Local().Format("2006-01-02 15:04")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			ns.Name,
+			quotaUsage(ns.DevnetCount, ns.Quota.GetMaxDevnets()),
+			quotaUsage(ns.NodeCount, ns.Quota.GetMaxNodes()),
+			created)
+	}
+	w.Flush()
+}
+
+// quotaUsage formats usage against a limit, e.g. "2/5", or just the usage
+// when unlimited.
+func quotaUsage(used, limit int32) string {
+	if limit <= 0 {
+		return strconv.Itoa(int(used))
+	}
+	return fmt.Sprintf("%d/%d", used, limit)
+}
@@ -0,0 +1,45 @@
+// cmd/dvb/namespace_test.go
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+func TestPrintNamespaces(t *testing.T) {
+	var buf bytes.Buffer
+	printNamespaces(&buf, []*v1.Namespace{
+		{Name: "default", DevnetCount: 1, NodeCount: 4, Implicit: true},
+		{Name: "team-a", DevnetCount: 2, NodeCount: 6, Quota: &v1.NamespaceQuota{MaxDevnets: 3, MaxNodes: 12}},
+	})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and 2 rows:\n%s", buf.String())
+	}
+	if fields := strings.Fields(lines[1]); fields[1] != "1" || fields[2] != "4" || fields[3] != "-" {
+		t.Errorf("unexpected implicit row: %q", lines[1])
+	}
+	if fields := strings.Fields(lines[2]); fields[1] != "2/3" || fields[2] != "6/12" {
+		t.Errorf("unexpected quota row: %q", lines[2])
+	}
+}
+
+func TestQuotaUsage(t *testing.T) {
+	tests := []struct {
+		used, limit int32
+		want        string
+	}{
+		{0, 0, "0"},
+		{3, 0, "3"},
+		{2, 5, "2/5"},
+	}
+	for _, tt := range tests {
+		if got := quotaUsage(tt.used, tt.limit); got != tt.want {
+			t.Errorf("quotaUsage(%d, %d) = %q, want %q", tt.used, tt.limit, got, tt.want)
+		}
+	}
+}
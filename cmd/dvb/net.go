@@ -0,0 +1,268 @@
+// cmd/dvb/net.go
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newNetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "net",
+		Short: "Inspect and shape devnet networking",
+		Long:  `Inspect the P2P networking of a devnet's nodes and emulate network conditions between them.`,
+	}
+
+	cmd.AddCommand(newNetPeersCmd(), newNetShapeCmd())
+
+	return cmd
+}
+
+func newNetPeersCmd() *cobra.Command {
+	var (
+		namespace string
+		output    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "peers [devnet]",
+		Short: "Show which nodes are connected to which",
+		Long: `Show the peer connectivity matrix of a running devnet.
+
+Each row is a node's view of its peers (from its net_info); a ✗ marks a
+devnet node it is not connected to. Each node's persistent_peers in
+config.toml are checked against the other nodes' IDs and listen addresses,
+so stale node IDs, wrong ports and unreachable peers are reported. These are
+a frequent cause of devnets that stop producing blocks.`,
+		Example: `  # Show the connectivity matrix
+  dvb net peers my-devnet
+
+  # As JSON
+  dvb net peers my-devnet -o json`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return validation.OutputFormat(output, "json")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			resp, err := daemonClient.GetPeerMatrix(cmd.Context(), &v1.GetPeerMatrixRequest{
+				DevnetName: devnetName,
+				Namespace:  ns,
+			})
+			if err != nil {
+				return err
+			}
+
+			if output == "json" {
+				return printJSON(resp)
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+			printPeerMatrix(os.Stdout, resp)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json)")
+
+	return cmd
+}
+
+func newNetShapeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shape",
+		Short: "Emulate latency, loss and bandwidth limits between nodes",
+		Long: `Emulate wide-area networks between the nodes of a docker-mode devnet.
+
+Nodes are labelled with regions in spec.nodes[].region, and
+spec.networkShaping.links set the latency, jitter, packet loss and bandwidth
+between two regions. 'apply' shapes each node's outgoing traffic with tc
+netem inside its container's network namespace, replacing earlier shaping;
+'clear' removes it. Shaping is lost when a node's container is recreated,
+so apply it again after restarting nodes.`,
+	}
+
+	cmd.AddCommand(newNetShapeRunCmd(false), newNetShapeRunCmd(true))
+
+	return cmd
+}
+
+// newNetShapeRunCmd returns 'net shape apply', or 'net shape clear' when
+// remove is set.
+func newNetShapeRunCmd(remove bool) *cobra.Command {
+	var (
+		namespace string
+		output    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "apply [devnet]",
+		Short: "Apply the devnet's network shaping to its running nodes",
+		Example: `  # Apply spec.networkShaping
+  dvb net shape apply my-devnet`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return validation.OutputFormat(output, "json")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			resp, err := daemonClient.ShapeNetwork(cmd.Context(), &v1.ShapeNetworkRequest{
+				DevnetName: devnetName,
+				Namespace:  ns,
+				Clear:      remove,
+			})
+			if err != nil {
+				return err
+			}
+
+			if output == "json" {
+				return printJSON(resp)
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+			if remove {
+				color.Green("✓ Cleared network shaping of %d nodes", len(resp.Nodes))
+				return nil
+			}
+			printShapedNodes(os.Stdout, resp)
+			return nil
+		},
+	}
+	if remove {
+		cmd.Use = "clear [devnet]"
+		cmd.Short = "Remove the network shaping of the devnet's running nodes"
+		cmd.Example = `  # Restore unshaped networking
+  dvb net shape clear my-devnet`
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json)")
+
+	return cmd
+}
+
+// printShapedNodes prints each node's region and the shaping of its
+// traffic to other regions.
+func printShapedNodes(out io.Writer, resp *v1.ShapeNetworkResponse) {
+	nameWidth, regionWidth := len("NODE"), len("REGION")
+	for _, n := range resp.Nodes {
+		nameWidth = max(nameWidth, len(n.Node))
+		regionWidth = max(regionWidth, len(n.Region))
+	}
+
+	fmt.Fprintf(out, "%-*s  %-*s  %s\n", nameWidth, "NODE", regionWidth, "REGION", "SHAPED TRAFFIC")
+	for _, n := range resp.Nodes {
+		links := strings.Join(n.Links, "; ")
+		if links == "" {
+			links = "-"
+		}
+		fmt.Fprintf(out, "%-*s  %-*s  %s\n", nameWidth, n.Node, regionWidth, n.Region, links)
+	}
+	fmt.Fprintln(out)
+	color.New(color.FgGreen).Fprintf(out, "✓ Applied network shaping to %d nodes\n", len(resp.Nodes))
+}
+
+// printPeerMatrix prints the NxN connectivity matrix followed by the
+// problems found. Rows are nodes, columns are the node indexes they report
+// as peers.
+func printPeerMatrix(out io.Writer, resp *v1.GetPeerMatrixResponse) {
+	if len(resp.Nodes) == 0 {
+		fmt.Fprintln(out, "No nodes found.")
+		return
+	}
+
+	nameWidth := len("NODE")
+	cellWidth := 1
+	for _, n := range resp.Nodes {
+		nameWidth = max(nameWidth, len(n.Name))
+		cellWidth = max(cellWidth, len(strconv.Itoa(int(n.Index))))
+	}
+	pad := func(s string, width int) string {
+		return s + strings.Repeat(" ", max(0, width-len([]rune(s))))
+	}
+
+	fmt.Fprint(out, pad("NODE", nameWidth))
+	for _, n := range resp.Nodes {
+		fmt.Fprint(out, "  "+pad(strconv.Itoa(int(n.Index)), cellWidth))
+	}
+	fmt.Fprintln(out, "  EXTERNAL")
+
+	for _, n := range resp.Nodes {
+		connected := make(map[int32]bool, len(n.Connected))
+		for _, idx := range n.Connected {
+			connected[idx] = true
+		}
+		fmt.Fprint(out, pad(n.Name, nameWidth))
+		for _, peer := range resp.Nodes {
+			var cell string
+			switch {
+			case peer.Index == n.Index:
+				cell = pad("-", cellWidth)
+			case n.Error != "":
+				cell = color.YellowString(pad("?", cellWidth))
+			case connected[peer.Index]:
+				cell = color.GreenString(pad("✓", cellWidth))
+			default:
+				cell = color.RedString(pad("✗", cellWidth))
+			}
+			fmt.Fprint(out, "  "+cell)
+		}
+		if n.Error != "" {
+			fmt.Fprintln(out, "  "+color.YellowString("unreachable"))
+		} else {
+			fmt.Fprintf(out, "  %d\n", n.ExternalPeers)
+		}
+	}
+
+	fmt.Fprintln(out)
+	if len(resp.Warnings) == 0 {
+		color.New(color.FgGreen).Fprintln(out, "✓ All nodes are connected and persistent_peers are consistent")
+		return
+	}
+
+	names := make(map[int32]string, len(resp.Nodes))
+	for _, n := range resp.Nodes {
+		names[n.Index] = n.Name
+	}
+	color.New(color.FgYellow).Fprintf(out, "Warnings (%d):\n", len(resp.Warnings))
+	for _, w := range resp.Warnings {
+		fmt.Fprintf(out, "  ⚠ %s: %s\n", names[w.NodeIndex], w.Message)
+	}
+}
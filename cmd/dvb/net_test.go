@@ -0,0 +1,68 @@
+// cmd/dvb/net_test.go
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+func TestPrintPeerMatrix(t *testing.T) {
+	var out bytes.Buffer
+	printPeerMatrix(&out, &v1.GetPeerMatrixResponse{
+		Nodes: []*v1.PeerNode{
+			{Index: 0, Name: "validator-0", Connected: []int32{1}, ExternalPeers: 2},
+			{Index: 1, Name: "validator-1", Connected: []int32{0}},
+			{Index: 2, Name: "fullnode-2", Error: "connection refused"},
+		},
+		Warnings: []*v1.PeerWarning{
+			{NodeIndex: 1, Message: "not connected to persistent peer fullnode-2"},
+		},
+	})
+
+	lines := strings.Split(out.String(), "\n")
+	for i, want := range []string{
+		"NODE         0  1  2  EXTERNAL",
+		"validator-0  -  ✓  ✗  2",
+		"validator-1  ✓  -  ✗  0",
+		"fullnode-2   ?  ?  -  unreachable",
+	} {
+		if lines[i] != want {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want)
+		}
+	}
+	if !strings.Contains(out.String(), "⚠ validator-1: not connected to persistent peer fullnode-2") {
+		t.Errorf("output missing warning:\n%s", out.String())
+	}
+
+	out.Reset()
+	printPeerMatrix(&out, &v1.GetPeerMatrixResponse{Nodes: []*v1.PeerNode{{Index: 0, Name: "validator-0"}}})
+	if !strings.Contains(out.String(), "All nodes are connected") {
+		t.Errorf("expected all-clear message, got:\n%s", out.String())
+	}
+}
+
+func TestPrintShapedNodes(t *testing.T) {
+	var out bytes.Buffer
+	printShapedNodes(&out, &v1.ShapeNetworkResponse{
+		Nodes: []*v1.ShapedNode{
+			{Node: "validator-0", Region: "us-east", Links: []string{"eu-west (2 nodes): 100ms ±20ms, 1% loss"}},
+			{Node: "validator-2", Region: "eu-west", Links: []string{"eu-west (1 node): 10mbit", "us-east (2 nodes): 100ms ±20ms, 1% loss"}},
+			{Node: "fullnode-4", Region: "default"},
+		},
+	})
+
+	lines := strings.Split(out.String(), "\n")
+	for i, want := range []string{
+		"NODE         REGION   SHAPED TRAFFIC",
+		"validator-0  us-east  eu-west (2 nodes): 100ms ±20ms, 1% loss",
+		"validator-2  eu-west  eu-west (1 node): 10mbit; us-east (2 nodes): 100ms ±20ms, 1% loss",
+		"fullnode-4   default  -",
+	} {
+		if lines[i] != want {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want)
+		}
+	}
+}
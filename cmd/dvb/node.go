@@ -15,10 +15,12 @@ import (
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
 	"github.com/altuslabsxyz/devnet-builder/internal/client"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/provisioner"
 	"github.com/altuslabsxyz/devnet-builder/internal/dvbcontext"
 	"github.com/altuslabsxyz/devnet-builder/internal/plugin/cosmos"
 	"github.com/altuslabsxyz/devnet-builder/internal/plugin/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/redact"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
@@ -50,6 +52,16 @@ func resolveNodeArgs(args []string) (explicitDevnet, nodeNameArg string) {
 	}
 }
 
+// checkAllExcludesNode fails when --all is combined with a node name.
+func checkAllExcludesNode(cmd *cobra.Command, args []string) error {
+	if all, _ := cmd.Flags().GetBool("all"); all {
+		if _, nodeNameArg := resolveNodeArgs(args); nodeNameArg != "" {
+			return fmt.Errorf("cannot specify both --all and a node name")
+		}
+	}
+	return nil
+}
+
 // resolveNodeSelection resolves a node name argument (or picker selection) to a NodeSelection.
 // If nodeNameArg is empty and interactive, invokes the fuzzy picker.
 // If nodeNameArg is empty and non-interactive, returns an error listing available nodes.
@@ -118,6 +130,11 @@ Examples:
 		newNodeRestartCmd(),
 		newNodeExecCmd(),
 		newNodeInitCmd(),
+		newNodeApplyConfigCmd(),
+		newNodeConfigCmd(),
+		newNodeSyncConfigCmd(),
+		newNodeSetBinaryCmd(),
+		newNodePruneCmd(),
 	)
 
 	return cmd
@@ -161,7 +178,8 @@ Examples:
 
   # Wide output with additional details
   dvb node list --wide`,
-		Args: cobra.MaximumNArgs(1),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := requireDaemon(); err != nil {
 				return err
@@ -311,7 +329,7 @@ func printNodeTable(nodes []*v1.Node, wide bool) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 
 	if wide {
-		fmt.Fprintln(w, "NAME\tHEALTH\tROLE\tPHASE\tRUNTIME ID\tRPC ENDPOINT\tRESTARTS\tMESSAGE")
+		fmt.Fprintln(w, "NAME\tHEALTH\tROLE\tPHASE\tRUNTIME ID\tRPC ENDPOINT\tHEIGHT\tBLOCKS/MIN\tBLOCK TIME\tLAG\tRESTARTS\tMESSAGE")
 	} else {
 		fmt.Fprintln(w, "NAME\tHEALTH\tROLE\tPHASE\tRUNTIME ID\tRESTARTS")
 	}
@@ -337,14 +355,12 @@ func printNodeTable(nodes []*v1.Node, wide bool) {
 
 		if wide {
 			// Determine RPC endpoint: use node's Address if set (loopback subnet mode)
-			// or fall back to legacy port offset calculation
-			var rpcEndpoint string
+			// or fall back to localhost
+			host := "localhost"
 			if n.Spec.Address != "" {
-				rpcEndpoint = fmt.Sprintf("%s:26657", n.Spec.Address)
-			} else {
-				rpcPort := 26657 + int(n.Metadata.Index)*100
-				rpcEndpoint = fmt.Sprintf("localhost:%d", rpcPort)
+				host = n.Spec.Address
 			}
+			rpcEndpoint := hostPort(host, nodePorts(n).RPC)
 			message := n.Status.Message
 			if len(message) > 30 {
 				message = message[:27] + "..."
@@ -353,13 +369,19 @@ func printNodeTable(nodes []*v1.Node, wide bool) {
 				message = "-"
 			}
 
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\n",
+			height, blocksPerMin, blockTime, lag := formatBlockRate(n.Status)
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\n",
 				nodeName,
 				healthIcon,
 				n.Spec.Role,
 				n.Status.Phase,
 				runtimeID,
 				rpcEndpoint,
+				height,
+				blocksPerMin,
+				blockTime,
+				lag,
 				n.Status.RestartCount,
 				message,
 			)
@@ -377,6 +399,25 @@ func printNodeTable(nodes []*v1.Node, wide bool) {
 	w.Flush()
 }
 
+// formatBlockRate formats a node's height, block rate, average block time
+// and lag behind the devnet's highest node for the wide node table, with
+// "-" for what the health checks have not measured yet.
+func formatBlockRate(s *v1.NodeStatus) (height, blocksPerMin, blockTime, lag string) {
+	height, blocksPerMin, blockTime, lag = "-", "-", "-", "-"
+	if s.BlockHeight <= 0 {
+		return
+	}
+	height = fmt.Sprintf("%d", s.BlockHeight)
+	lag = fmt.Sprintf("%d", s.HeightLag)
+	if s.BlocksPerMinute > 0 {
+		blocksPerMin = fmt.Sprintf("%.1f", s.BlocksPerMinute)
+	}
+	if s.AvgBlockTimeSeconds > 0 {
+		blockTime = fmt.Sprintf("%.2fs", s.AvgBlockTimeSeconds)
+	}
+	return
+}
+
 // getNodeListSummary returns a summary string of node states
 func getNodeListSummary(nodes []*v1.Node) string {
 	running, stopped, other := 0, 0, 0
@@ -422,7 +463,10 @@ func getHealthIcon(phase string) string {
 }
 
 func newNodeGetCmd() *cobra.Command {
-	var namespace string
+	var (
+		namespace string
+		output    string
+	)
 
 	cmd := &cobra.Command{
 		Use:   "get [devnet-name] [node-name]",
@@ -442,8 +486,15 @@ Examples:
   dvb node get validator-0
 
   # Get node details (explicit devnet)
-  dvb node get my-devnet validator-0`,
-		Args: cobra.RangeArgs(0, 2),
+  dvb node get my-devnet validator-0
+
+  # Inspect node conditions from a script
+  dvb node get my-devnet validator-0 -o json | jq '.status.conditions'`,
+		Args:              cobra.RangeArgs(0, 2),
+		ValidArgsFunction: completeNodeArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return validation.OutputFormat(output, "json")
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := requireDaemon(); err != nil {
 				return err
@@ -456,7 +507,9 @@ Examples:
 				return err
 			}
 
-			printContextHeader(explicitDevnet, currentContext)
+			if output != "json" {
+				printContextHeader(explicitDevnet, currentContext)
+			}
 
 			sel, err := resolveNodeSelection(cmd.Context(), ns, devnetName, nodeNameArg)
 			if err != nil {
@@ -468,12 +521,17 @@ Examples:
 				return err
 			}
 
+			if output == "json" {
+				return printJSON(node)
+			}
+
 			printNodeStatus(node)
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json)")
 
 	return cmd
 }
@@ -513,7 +571,8 @@ Examples:
 
   # Check health of fullnode
   dvb node health my-devnet fullnode-0`,
-		Args: cobra.RangeArgs(0, 2),
+		Args:              cobra.RangeArgs(0, 2),
+		ValidArgsFunction: completeNodeArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := requireDaemon(); err != nil {
 				return err
@@ -576,7 +635,8 @@ Examples:
 
   # Show ports for fullnode
   dvb node ports my-devnet fullnode-0`,
-		Args: cobra.RangeArgs(0, 2),
+		Args:              cobra.RangeArgs(0, 2),
+		ValidArgsFunction: completeNodeArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := requireDaemon(); err != nil {
 				return err
@@ -625,6 +685,10 @@ Examples:
 					fmt.Printf("REST endpoint: http://localhost:%d\n", p.HostPort)
 				case "grpc":
 					fmt.Printf("gRPC endpoint: localhost:%d\n", p.HostPort)
+				case "evm-rpc":
+					fmt.Printf("EVM JSON-RPC:  http://localhost:%d\n", p.HostPort)
+				case "evm-ws":
+					fmt.Printf("EVM WebSocket: ws://localhost:%d\n", p.HostPort)
 				}
 			}
 
@@ -670,7 +734,9 @@ Examples:
 
   # Start node (explicit devnet)
   dvb node start my-devnet validator-0`,
-		Args: cobra.RangeArgs(0, 2),
+		Args:              cobra.RangeArgs(0, 2),
+		ValidArgsFunction: completeNodeArgs,
+		PreRunE:           checkAllExcludesNode,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := requireDaemon(); err != nil {
 				return err
@@ -686,9 +752,6 @@ Examples:
 			printContextHeader(explicitDevnet, currentContext)
 
 			if all {
-				if nodeNameArg != "" {
-					return fmt.Errorf("cannot specify both --all and a node name")
-				}
 				return startAllNodes(cmd.Context(), ns, devnetName, force, noWait, verbose)
 			}
 
@@ -792,7 +855,9 @@ Examples:
 
   # Stop node (explicit devnet)
   dvb node stop my-devnet validator-0`,
-		Args: cobra.RangeArgs(0, 2),
+		Args:              cobra.RangeArgs(0, 2),
+		ValidArgsFunction: completeNodeArgs,
+		PreRunE:           checkAllExcludesNode,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := requireDaemon(); err != nil {
 				return err
@@ -808,9 +873,6 @@ Examples:
 			printContextHeader(explicitDevnet, currentContext)
 
 			if all {
-				if nodeNameArg != "" {
-					return fmt.Errorf("cannot specify both --all and a node name")
-				}
 				return stopAllNodes(cmd.Context(), ns, devnetName)
 			}
 
@@ -845,6 +907,9 @@ func stopAllNodes(ctx context.Context, ns, devnetName string) error {
 
 	color.Green("✓ Devnet %q stopped", devnet.Metadata.Name)
 	fmt.Printf("  Phase: %s\n", devnet.Status.Phase)
+	if devnet.Status.Message != "" {
+		fmt.Printf("  Message: %s\n", devnet.Status.Message)
+	}
 	return nil
 }
 
@@ -880,7 +945,9 @@ Examples:
 
   # Restart node (explicit devnet)
   dvb node restart my-devnet validator-0`,
-		Args: cobra.RangeArgs(0, 2),
+		Args:              cobra.RangeArgs(0, 2),
+		ValidArgsFunction: completeNodeArgs,
+		PreRunE:           checkAllExcludesNode,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := requireDaemon(); err != nil {
 				return err
@@ -896,9 +963,6 @@ Examples:
 			printContextHeader(explicitDevnet, currentContext)
 
 			if all {
-				if nodeNameArg != "" {
-					return fmt.Errorf("cannot specify both --all and a node name")
-				}
 				return restartAllNodes(cmd.Context(), ns, devnetName, noWait, verbose)
 			}
 
@@ -991,7 +1055,8 @@ Examples:
 
   # Run a command with a longer timeout
   dvb node exec validator-0 --timeout 60 -- stabled query bank balances cosmos1...`,
-		Args: cobra.MinimumNArgs(1),
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: completeNodeArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := requireDaemon(); err != nil {
 				return err
@@ -1111,6 +1176,7 @@ Examples:
 
 	// Optional flags with defaults
 	cmd.Flags().StringVar(&opts.network, "network", "stable", "Network type (e.g., stable, cosmos)")
+	_ = cmd.RegisterFlagCompletionFunc("network", completeNetworkNames)
 	cmd.Flags().StringVar(&opts.dataDir, "data-dir", "", "Base directory for node data (default ~/.devnet-builder/nodes)")
 	cmd.Flags().StringVar(&opts.binaryPath, "binary-path", "", "Path to chain binary (uses network default if not specified)")
 	cmd.Flags().IntVar(&opts.numNodes, "num-nodes", 1, "Number of nodes to initialize")
@@ -1141,9 +1207,9 @@ func runNodeInit(ctx context.Context, opts *nodeInitOptions) error {
 	}
 
 	// Create logger
-	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+	logger := slog.New(redact.NewHandler(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
 		Level: slog.LevelInfo,
-	}))
+	})))
 
 	// Get plugin initializer based on network
 	pluginInit, err := getPluginInitializer(opts.network)
@@ -1224,8 +1290,12 @@ func getPluginInitializer(network string) (types.PluginInitializer, error) {
 		return cosmos.NewCosmosInitializer("stabled"), nil
 	case "cosmos", "gaia":
 		return cosmos.NewCosmosInitializer("gaiad"), nil
+	case "osmosis":
+		return cosmos.NewCosmosInitializer("osmosisd"), nil
+	case "evmos":
+		return cosmos.NewCosmosInitializer("evmosd"), nil
 	default:
-		return nil, fmt.Errorf("unknown network: %s (supported: stable, cosmos, gaia)", network)
+		return nil, fmt.Errorf("unknown network: %s (supported: stable, cosmos, gaia, osmosis, evmos)", network)
 	}
 }
 
@@ -1250,6 +1320,9 @@ func printNodeStatus(n *v1.Node) {
 	fmt.Printf("\nDevnet:     %s\n", n.Metadata.DevnetName)
 	fmt.Printf("Name:       %s\n", dvbcontext.NodeName(n))
 	fmt.Printf("Role:       %s\n", n.Spec.Role)
+	if n.Status.Version != "" {
+		fmt.Printf("Version:    %s\n", n.Status.Version)
+	}
 
 	// Show IP address if available
 	if n.Spec.Address != "" {
@@ -1280,30 +1353,40 @@ func printNodeStatus(n *v1.Node) {
 
 	if n.Status.BlockHeight > 0 {
 		fmt.Printf("Height:     %d\n", n.Status.BlockHeight)
+		_, blocksPerMin, blockTime, lag := formatBlockRate(n.Status)
+		fmt.Printf("Block rate: %s blocks/min, %s per block\n", blocksPerMin, blockTime)
+		fmt.Printf("Lag:        %s blocks\n", lag)
 	}
 
 	fmt.Printf("Restarts:   %d\n", n.Status.RestartCount)
 
+	if h := n.Status.Health; h != nil && h.Status != "" && h.Status != "Unknown" {
+		health := h.Status
+		if h.Message != "" {
+			health += " (" + h.Message + ")"
+		}
+		fmt.Printf("Health:     %s\n", health)
+	}
+
 	if n.Status.Message != "" {
 		fmt.Printf("Message:    %s\n", n.Status.Message)
 	}
 
-	// Show endpoints based on whether we have an IP address
-	fmt.Printf("\nEndpoints:\n")
+	if len(n.Status.Conditions) > 0 {
+		printConditions(n.Status.Conditions, true)
+	}
+
+	// Show endpoints on the node's IP address, or localhost without one
+	host := "localhost"
 	if n.Spec.Address != "" {
-		addr := n.Spec.Address
-		fmt.Printf("  RPC:      http://%s:26657\n", addr)
-		fmt.Printf("  REST:     http://%s:1317\n", addr)
-		fmt.Printf("  gRPC:     %s:9090\n", addr)
-		fmt.Printf("  P2P:      %s:26656\n", addr)
-	} else {
-		// Fallback to port-offset based display for legacy/docker mode
-		offset := int(n.Metadata.Index) * 100
-		fmt.Printf("  RPC:      http://localhost:%d\n", 26657+offset)
-		fmt.Printf("  REST:     http://localhost:%d\n", 1317+offset)
-		fmt.Printf("  gRPC:     localhost:%d\n", 9090+offset)
-		fmt.Printf("  P2P:      localhost:%d\n", 26656+offset)
+		host = n.Spec.Address
 	}
+	ports := nodePorts(n)
+	fmt.Printf("\nEndpoints:\n")
+	fmt.Printf("  RPC:      http://%s\n", hostPort(host, ports.RPC))
+	fmt.Printf("  REST:     http://%s\n", hostPort(host, ports.API))
+	fmt.Printf("  gRPC:     %s\n", hostPort(host, ports.GRPC))
+	fmt.Printf("  P2P:      %s\n", hostPort(host, ports.P2P))
 }
 
 // nodeServiceInfo holds service file information for a node.
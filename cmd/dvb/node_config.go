@@ -0,0 +1,357 @@
+// cmd/dvb/node_config.go
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newNodeApplyConfigCmd() *cobra.Command {
+	var (
+		namespace           string
+		file                string
+		noRestartIfPossible bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "apply-config [devnet-name] <node>",
+		Short: "Patch a node's config.toml/app.toml in place",
+		Long: `Patch a node's config.toml and app.toml in place.
+
+The overrides file is TOML with a [config] table for config.toml and an
+[app] table for app.toml. Every key must already exist in the node's config;
+comments and layout of the files are preserved.
+
+  [config]
+  log_level = "debug"
+
+  [config.rpc]
+  max_open_connections = 2000
+
+  [app]
+  minimum-gas-prices = "0stake"
+
+A running node is restarted to pick up the changes. With
+--no-restart-if-possible the node reloads the changes in place instead when
+its plugin supports reloading every changed key, and falls back to a restart
+otherwise. A stopped node picks up the changes when it next starts.
+
+The node can be given by name (validator-0) or index (0).
+
+Examples:
+  # Raise the log level of validator-0 without restarting it
+  dvb node apply-config validator-0 -f overrides.toml --no-restart-if-possible
+
+  # Patch node 1 of an explicit devnet
+  dvb node apply-config my-devnet 1 -f overrides.toml`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeNodeArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			overrides, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read overrides: %w", err)
+			}
+
+			explicitDevnet, nodeArg := "", args[0]
+			if len(args) == 2 {
+				explicitDevnet, nodeArg = args[0], args[1]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+
+			index, err := strconv.Atoi(nodeArg)
+			if err != nil {
+				sel, err := resolveNodeSelection(cmd.Context(), ns, devnetName, nodeArg)
+				if err != nil {
+					return fmt.Errorf("failed to resolve node: %w", err)
+				}
+				index = sel.Index
+			}
+
+			resp, err := daemonClient.ApplyNodeConfig(cmd.Context(), &v1.ApplyNodeConfigRequest{
+				DevnetName:          devnetName,
+				Index:               int32(index),
+				Overrides:           string(overrides),
+				NoRestartIfPossible: noRestartIfPossible,
+				Namespace:           ns,
+			})
+			if err != nil {
+				return err
+			}
+
+			printNodeConfigResult(os.Stdout, devnetName, index, resp)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "TOML overrides file (required)")
+	cmd.Flags().BoolVar(&noRestartIfPossible, "no-restart-if-possible", false, "Reload in place when the node supports it instead of restarting")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func newNodeConfigCmd() *cobra.Command {
+	var (
+		namespace  string
+		showSource bool
+		output     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "config [devnet-name] <node>",
+		Short: "Show a node's effective config.toml/app.toml",
+		Long: `Show a node's effective config.toml and app.toml values.
+
+With --show-source each value is attributed to the layer that set it:
+
+  default   written by the node's init command
+  spec      devnet defaults, the provisioning profile or the node's
+            configToml/appToml in the devnet spec
+  plugin    the network plugin's config overrides
+  runtime   computed per node: listen addresses, moniker and peers
+  user      set with 'dvb node apply-config'
+  file      edited outside devnet-builder
+
+The node can be given by name (validator-0) or index (0).
+
+Examples:
+  # Show where each value of node 0 came from
+  dvb node config 0 --show-source
+
+  # Find the mempool settings of validator-1
+  dvb node config validator-1 | grep mempool`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeNodeArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			explicitDevnet, nodeArg := "", args[0]
+			if len(args) == 2 {
+				explicitDevnet, nodeArg = args[0], args[1]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			if output != "json" {
+				printContextHeader(explicitDevnet, currentContext)
+			}
+
+			index, err := strconv.Atoi(nodeArg)
+			if err != nil {
+				sel, err := resolveNodeSelection(cmd.Context(), ns, devnetName, nodeArg)
+				if err != nil {
+					return fmt.Errorf("failed to resolve node: %w", err)
+				}
+				index = sel.Index
+			}
+
+			fields, err := daemonClient.GetNodeConfig(cmd.Context(), ns, devnetName, index)
+			if err != nil {
+				return err
+			}
+
+			if output == "json" {
+				return printJSON(fields)
+			}
+			printNodeConfigFields(os.Stdout, fields, showSource)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().BoolVar(&showSource, "show-source", false, "Show which layer set each value")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format: json")
+
+	return cmd
+}
+
+func newNodeSyncConfigCmd() *cobra.Command {
+	var (
+		namespace string
+		apply     bool
+		output    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "sync-config [devnet-name] <node>",
+		Short: "Detect and repair drift in a node's config.toml/app.toml",
+		Long: `Compare a node's config.toml and app.toml with the values devnet-builder
+sets and show the values that drifted, e.g. after manual experiments.
+
+The expected values are recomputed from the network plugin's overrides,
+devnet defaults, the provisioning profile, the node's configToml/appToml in
+the devnet spec and values set with 'dvb node apply-config', later layers
+taking precedence. Values computed at provisioning time, such as listen
+addresses and peers, and values no layer sets are not checked.
+
+With --apply the drifted values are restored and a running node is
+restarted to pick them up. A stopped node picks them up when it next starts.
+
+The node can be given by name (validator-0) or index (0).
+
+Examples:
+  # Show what changed on validator-0
+  dvb node sync-config validator-0
+
+  # Restore node 1 of an explicit devnet and restart it
+  dvb node sync-config my-devnet 1 --apply`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeNodeArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			explicitDevnet, nodeArg := "", args[0]
+			if len(args) == 2 {
+				explicitDevnet, nodeArg = args[0], args[1]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			if output != "json" {
+				printContextHeader(explicitDevnet, currentContext)
+			}
+
+			index, err := strconv.Atoi(nodeArg)
+			if err != nil {
+				sel, err := resolveNodeSelection(cmd.Context(), ns, devnetName, nodeArg)
+				if err != nil {
+					return fmt.Errorf("failed to resolve node: %w", err)
+				}
+				index = sel.Index
+			}
+
+			resp, err := daemonClient.SyncNodeConfig(cmd.Context(), &v1.SyncNodeConfigRequest{
+				DevnetName: devnetName,
+				Index:      int32(index),
+				Namespace:  ns,
+				Apply:      apply,
+			})
+			if err != nil {
+				return err
+			}
+
+			if output == "json" {
+				return printJSON(resp)
+			}
+			printNodeConfigSync(os.Stdout, devnetName, index, resp)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().BoolVar(&apply, "apply", false, "Restore drifted values and restart the node if it is running")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format: json")
+
+	return cmd
+}
+
+// printNodeConfigFields prints effective config values as a table,
+// optionally with their source.
+func printNodeConfigFields(out io.Writer, fields []*v1.NodeConfigField, showSource bool) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	if showSource {
+		fmt.Fprintln(w, "FILE\tKEY\tVALUE\tSOURCE\tDETAIL")
+	} else {
+		fmt.Fprintln(w, "FILE\tKEY\tVALUE")
+	}
+	for _, f := range fields {
+		if showSource {
+			detail := f.Detail
+			if detail == "" {
+				detail = "-"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", f.File, f.Key, f.Value, f.Source, detail)
+		} else {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", f.File, f.Key, f.Value)
+		}
+	}
+	w.Flush()
+}
+
+// printNodeConfigResult prints the outcome of apply-config and the changed keys.
+func printNodeConfigResult(out io.Writer, devnetName string, index int, resp *v1.ApplyNodeConfigResponse) {
+	if resp.Action == "unchanged" {
+		fmt.Fprintf(out, "Node %s/%d config unchanged: %s\n", devnetName, index, resp.Message)
+		return
+	}
+
+	fmt.Fprintln(out, color.GreenString("✓ Node %s/%d config applied (%s)", devnetName, index, configActionOutcome(resp.Action)))
+	fmt.Fprintf(out, "  %s\n\n", resp.Message)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "FILE\tKEY\tOLD\tNEW")
+	for _, c := range resp.Changes {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.File, c.Key, c.OldValue, c.NewValue)
+	}
+	w.Flush()
+}
+
+// configActionOutcome describes what applying a config change did to the
+// node, for the action reported by apply-config and sync-config.
+func configActionOutcome(action string) string {
+	switch action {
+	case "reloaded":
+		return "node reloaded in place"
+	case "restarted":
+		return "node restarted"
+	case "deferred":
+		return "takes effect on next start"
+	default:
+		return action
+	}
+}
+
+// printNodeConfigSync prints the drift sync-config found and what it did
+// about it.
+func printNodeConfigSync(out io.Writer, devnetName string, index int, resp *v1.SyncNodeConfigResponse) {
+	switch resp.Action {
+	case "unchanged":
+		fmt.Fprintf(out, "Node %s/%d config in sync: %s\n", devnetName, index, resp.Message)
+		return
+	case "drifted":
+		fmt.Fprintln(out, color.YellowString("! Node %s/%d config drifted", devnetName, index))
+		fmt.Fprintf(out, "  %s; run with --apply to restore them\n\n", resp.Message)
+	default:
+		fmt.Fprintln(out, color.GreenString("✓ Node %s/%d config restored (%s)", devnetName, index, configActionOutcome(resp.Action)))
+		fmt.Fprintf(out, "  %s\n\n", resp.Message)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "FILE\tKEY\tCURRENT\tEXPECTED\tSOURCE")
+	for _, d := range resp.Drift {
+		source := d.Source
+		if d.Detail != "" {
+			source += " (" + d.Detail + ")"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", d.File, d.Key, d.CurrentValue, d.ExpectedValue, source)
+	}
+	w.Flush()
+}
@@ -0,0 +1,97 @@
+// cmd/dvb/node_config_test.go
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+func TestPrintNodeConfigResult(t *testing.T) {
+	var buf bytes.Buffer
+	printNodeConfigResult(&buf, "my-devnet", 1, &v1.ApplyNodeConfigResponse{
+		Action:  "reloaded",
+		Message: "node reloaded its config without a restart",
+		Changes: []*v1.ConfigChange{
+			{File: "config.toml", Key: "log_level", OldValue: `"info"`, NewValue: `"debug"`},
+		},
+	})
+
+	out := buf.String()
+	for _, want := range []string{"my-devnet/1 config applied (node reloaded in place)", "FILE", "config.toml", "log_level", `"info"`, `"debug"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestPrintNodeConfigResult_Unchanged(t *testing.T) {
+	var buf bytes.Buffer
+	printNodeConfigResult(&buf, "my-devnet", 0, &v1.ApplyNodeConfigResponse{
+		Action:  "unchanged",
+		Message: "config already up to date",
+	})
+
+	if strings.Contains(buf.String(), "FILE") {
+		t.Errorf("unchanged result should not print a table:\n%s", buf.String())
+	}
+}
+
+func TestPrintNodeConfigFields(t *testing.T) {
+	fields := []*v1.NodeConfigField{
+		{File: "app.toml", Key: "pruning", Value: `"everything"`, Source: "spec", Detail: "profile laptop"},
+		{File: "config.toml", Key: "log_level", Value: `"info"`, Source: "default"},
+	}
+
+	var buf bytes.Buffer
+	printNodeConfigFields(&buf, fields, false)
+	if strings.Contains(buf.String(), "SOURCE") || strings.Contains(buf.String(), "profile laptop") {
+		t.Errorf("sources shown without --show-source:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	printNodeConfigFields(&buf, fields, true)
+	out := buf.String()
+	for _, want := range []string{"SOURCE", "profile laptop", "default", "-"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestPrintNodeConfigSync(t *testing.T) {
+	drift := []*v1.ConfigDrift{
+		{File: "config.toml", Key: "mempool.size", CurrentValue: "10", ExpectedValue: "1000", Source: "spec", Detail: "profile laptop"},
+	}
+
+	var buf bytes.Buffer
+	printNodeConfigSync(&buf, "my-devnet", 0, &v1.SyncNodeConfigResponse{
+		Action:  "drifted",
+		Message: "1 value(s) differ from the devnet spec",
+		Drift:   drift,
+	})
+	out := buf.String()
+	for _, want := range []string{"my-devnet/0 config drifted", "--apply", "CURRENT", "mempool.size", "10", "1000", "spec (profile laptop)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	buf.Reset()
+	printNodeConfigSync(&buf, "my-devnet", 0, &v1.SyncNodeConfigResponse{
+		Action:  "restarted",
+		Message: "node restarted with the restored config",
+		Drift:   drift,
+	})
+	if out := buf.String(); !strings.Contains(out, "config restored (node restarted)") || strings.Contains(out, "--apply") {
+		t.Errorf("unexpected restored output:\n%s", out)
+	}
+
+	buf.Reset()
+	printNodeConfigSync(&buf, "my-devnet", 0, &v1.SyncNodeConfigResponse{Action: "unchanged", Message: "config matches the devnet spec"})
+	if strings.Contains(buf.String(), "FILE") {
+		t.Errorf("in-sync result should not print a table:\n%s", buf.String())
+	}
+}
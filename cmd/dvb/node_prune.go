@@ -0,0 +1,122 @@
+// cmd/dvb/node_prune.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newNodePruneCmd() *cobra.Command {
+	var (
+		namespace string
+		strategy  string
+		timeout   time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "prune <devnet-name> [node]",
+		Short: "Prune node state to reclaim disk",
+		Long: `Prune the application state of a node with its chain binary's prune
+command, and report the disk reclaimed.
+
+The node is stopped for the prune and restarted afterwards. Without a node,
+every node of the devnet is pruned, one at a time, so the devnet keeps
+producing blocks. Nodes stopped on purpose are pruned and stay stopped.
+
+--strategy is passed to the prune command: "everything" (the default) keeps
+only the latest state, "default" keeps the chain's default number of recent
+states.
+
+devnetd can also prune long-lived devnets on a schedule; see the [pruning]
+section of devnetd.toml.
+
+The node can be given by name (validator-0) or index (0).
+
+Examples:
+  # Prune every node of a devnet
+  dvb node prune my-devnet
+
+  # Prune validator-1, keeping the chain's default recent states
+  dvb node prune my-devnet validator-1 --strategy default`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeNodeArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(args[0], namespace)
+			if err != nil {
+				return err
+			}
+
+			req := &v1.PruneNodesRequest{
+				DevnetName: devnetName,
+				Namespace:  ns,
+				AllNodes:   len(args) == 1,
+				Strategy:   strategy,
+			}
+			if len(args) == 2 {
+				index, err := strconv.Atoi(args[1])
+				if err != nil {
+					sel, err := resolveNodeSelection(cmd.Context(), ns, devnetName, args[1])
+					if err != nil {
+						return fmt.Errorf("failed to resolve node: %w", err)
+					}
+					index = sel.Index
+				}
+				req.Index = int32(index)
+			}
+
+			if req.AllNodes {
+				fmt.Printf("Pruning the nodes of %s, one at a time...\n", devnetName)
+			} else {
+				fmt.Printf("Pruning node %s of %s...\n", args[1], devnetName)
+			}
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+			nodes, err := daemonClient.PruneNodes(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			var reclaimed int64
+			for _, n := range nodes {
+				freed := n.BytesBefore - n.BytesAfter
+				reclaimed += freed
+				color.Green("✓ %s: %s → %s (reclaimed %s)", n.Node,
+					formatBytes(n.BytesBefore), formatBytes(n.BytesAfter), formatBytes(max(freed, 0)))
+			}
+			if len(nodes) > 1 {
+				fmt.Printf("Reclaimed %s in total\n", formatBytes(max(reclaimed, 0)))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVar(&strategy, "strategy", "", `Strategy passed to the prune command (default "everything")`)
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "How long to wait for pruning and the restarts")
+
+	return cmd
+}
+
+// formatBytes formats bytes as human-readable string.
+func formatBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(b)/float64(div), "KMGTPE"[exp])
+}
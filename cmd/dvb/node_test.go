@@ -296,3 +296,48 @@ func TestPrintNodeTable(t *testing.T) {
 		printNodeTable(nodes, true)
 	})
 }
+
+func TestFormatBlockRate(t *testing.T) {
+	height, blocksPerMin, blockTime, lag := formatBlockRate(&v1.NodeStatus{})
+	if height != "-" || blocksPerMin != "-" || blockTime != "-" || lag != "-" {
+		t.Errorf("unmeasured node = %q %q %q %q, want all -", height, blocksPerMin, blockTime, lag)
+	}
+
+	height, blocksPerMin, blockTime, lag = formatBlockRate(&v1.NodeStatus{
+		BlockHeight:         1200,
+		BlocksPerMinute:     11.96,
+		AvgBlockTimeSeconds: 5.0167,
+		HeightLag:           2,
+	})
+	if height != "1200" || blocksPerMin != "12.0" || blockTime != "5.02s" || lag != "2" {
+		t.Errorf("measured node = %q %q %q %q", height, blocksPerMin, blockTime, lag)
+	}
+}
+
+func TestCheckAllExcludesNode(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   []string
+		args    []string
+		wantErr bool
+	}{
+		{name: "all", flags: []string{"--all"}},
+		{name: "all with devnet", flags: []string{"--all"}, args: []string{"my-devnet"}},
+		{name: "node", args: []string{"my-devnet", "validator-0"}},
+		{name: "all with node", flags: []string{"--all"}, args: []string{"validator-0"}, wantErr: true},
+		{name: "all with devnet and node", flags: []string{"--all"}, args: []string{"my-devnet", "validator-0"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newNodeStartCmd()
+			if err := cmd.ParseFlags(tt.flags); err != nil {
+				t.Fatal(err)
+			}
+			err := cmd.PreRunE(cmd, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PreRunE() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -5,9 +5,14 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/plugininstall"
 )
 
 func newPluginsCmd() *cobra.Command {
@@ -19,11 +24,14 @@ func newPluginsCmd() *cobra.Command {
 Network plugins define the blockchain network configuration, including
 the binary to use, available versions, and supported network types.
 
-Plugins are discovered from ~/.devnet-builder/plugins/`,
+Plugins are discovered from ~/.devnet-builder/plugins/. Use
+'dvb plugins install' to install a plugin from GitHub releases.`,
 	}
 
 	cmd.AddCommand(
 		newPluginsListCmd(),
+		newPluginsStatsCmd(),
+		newPluginsInstallCmd(),
 	)
 
 	return cmd
@@ -38,6 +46,10 @@ func newPluginsListCmd() *cobra.Command {
 Network plugins are loaded from ~/.devnet-builder/plugins/ and define
 the networks that can be used when provisioning a devnet.
 
+PROTOCOL is the plugin protocol version and CAPABILITIES the optional
+features the plugin reported (e.g., gov-params, evm, state-sync). Plugins
+built before protocol v2 show "unknown" capabilities.
+
 Examples:
   # List all available plugins
   dvb daemon plugins list`,
@@ -64,7 +76,7 @@ func runPluginsList(ctx context.Context) error {
 	if len(networks) == 0 {
 		fmt.Println("No network plugins found.")
 		fmt.Println()
-		fmt.Println("Install plugins to ~/.devnet-builder/plugins/")
+		fmt.Println("Install a plugin with: dvb plugins install <owner>/<repo>[@version]")
 		return nil
 	}
 
@@ -72,21 +84,225 @@ func runPluginsList(ctx context.Context) error {
 	fmt.Println()
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "NAME\tDISPLAY NAME\tBINARY\tVERSION\tNETWORKS")
+	fmt.Fprintln(w, "NAME\tDISPLAY NAME\tBINARY\tVERSION\tNETWORKS\tPROTOCOL\tCAPABILITIES")
 	for _, n := range networks {
 		networksStr := "-"
 		if len(n.AvailableNetworks) > 0 {
 			networksStr = fmt.Sprintf("%v", n.AvailableNetworks)
 		}
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 			n.Name,
 			n.DisplayName,
 			n.BinaryName,
 			n.DefaultBinaryVersion,
 			networksStr,
+			formatPluginProtocol(n.ProtocolVersion),
+			formatCapabilities(n.Capabilities, n.CapabilitiesKnown, n.ProtocolVersion),
 		)
 	}
 	w.Flush()
 
 	return nil
 }
+
+// formatPluginProtocol renders a plugin protocol version; built-in modules
+// have none.
+func formatPluginProtocol(version int32) string {
+	if version == 0 {
+		return "built-in"
+	}
+	return fmt.Sprintf("v%d", version)
+}
+
+// formatCapabilities renders a plugin's capabilities for a table cell.
+func formatCapabilities(caps []string, known bool, protocol int32) string {
+	switch {
+	case protocol == 0:
+		return "-"
+	case !known:
+		return "unknown"
+	case len(caps) == 0:
+		return "none"
+	default:
+		return strings.Join(caps, ",")
+	}
+}
+
+func newPluginsStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats [plugin]",
+		Short: "Show plugin RPC call and failure counts",
+		Long: `Show how often the daemon called each plugin RPC method since the plugin
+was loaded, and how many calls failed. A failure is a gRPC error or a
+response with its error field set; unimplemented methods are counted
+separately because the daemon falls back to its built-in implementation.
+
+Start devnetd with --strict-plugins to turn response errors into hard
+failures instead of leaving callers to check them.
+
+Examples:
+  # Show stats for all plugins
+  dvb plugins stats
+
+  # Show stats for one plugin
+  dvb plugins stats stable`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeNetworkNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPluginsStats(cmd.Context(), args)
+		},
+	}
+
+	return cmd
+}
+
+// runPluginsStats prints RPC call stats for the named plugin, or all plugins.
+func runPluginsStats(ctx context.Context, args []string) error {
+	if err := requireDaemon(); err != nil {
+		return err
+	}
+
+	var names []string
+	if len(args) > 0 {
+		names = args
+	} else {
+		networks, err := daemonClient.ListNetworks(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list networks: %w", err)
+		}
+		for _, n := range networks {
+			names = append(names, n.Name)
+		}
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PLUGIN\tMETHOD\tCALLS\tFAILURES\tUNIMPLEMENTED\tFAILURE RATE")
+	rows := 0
+	for _, name := range names {
+		info, err := daemonClient.GetNetworkInfo(ctx, name)
+		if err != nil {
+			return fmt.Errorf("failed to get network %q: %w", name, err)
+		}
+		for _, s := range info.CallStats {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%s\n",
+				name, s.Method, s.Calls, s.Failures, s.Unimplemented,
+				failureRate(s.Calls, s.Failures))
+			rows++
+		}
+	}
+
+	if rows == 0 {
+		fmt.Println("No plugin calls recorded yet.")
+		return nil
+	}
+	w.Flush()
+
+	return nil
+}
+
+// failureRate formats failures as a percentage of calls.
+func failureRate(calls, failures uint64) string {
+	if calls == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", float64(failures)*100/float64(calls))
+}
+
+func newPluginsInstallCmd() *cobra.Command {
+	var (
+		publicKeyPath   string
+		allowUnverified bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "install <owner>/<repo>[@version]",
+		Short: "Install a network plugin from GitHub releases",
+		Long: `Install a network plugin from a GitHub release.
+
+The daemon downloads the plugin binary for its platform from the release
+(the latest release unless a version is given), verifies it against the
+release's checksum manifest (e.g., checksums.txt), installs it into its
+plugin directory (~/.devnet-builder/plugins/) and loads it. An installed
+plugin of the same name is replaced and reloaded; running devnets keep
+using the old plugin process until they are reprovisioned.
+
+Release assets must contain "-plugin" and the target OS and architecture
+in their name, e.g. osmosis-plugin_linux_amd64.tar.gz.
+
+With --public-key, the checksum manifest must also carry an Ed25519
+signature in <manifest>.sig (raw or base64), made with the matching
+private key.
+
+Examples:
+  # Install the latest release
+  dvb plugins install acme/osmosis-devnet-plugin
+
+  # Install a specific version and require a signed checksum manifest
+  dvb plugins install acme/osmosis-devnet-plugin@v1.2.0 --public-key acme.pub`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPluginsInstall(cmd.Context(), args[0], publicKeyPath, allowUnverified)
+		},
+	}
+
+	cmd.Flags().StringVar(&publicKeyPath, "public-key", "", "PEM Ed25519 public key the checksum manifest must be signed with")
+	cmd.Flags().BoolVar(&allowUnverified, "allow-unverified", false, "Install even if the release publishes no checksums")
+
+	return cmd
+}
+
+// runPluginsInstall asks the daemon to install and load a plugin release.
+func runPluginsInstall(ctx context.Context, ref, publicKeyPath string, allowUnverified bool) error {
+	owner, repo, version, err := plugininstall.ParseRef(ref)
+	if err != nil {
+		return err
+	}
+	if err := requireDaemon(); err != nil {
+		return err
+	}
+
+	req := &v1.InstallPluginRequest{
+		Owner:           owner,
+		Repo:            repo,
+		Version:         version,
+		AllowUnverified: allowUnverified,
+	}
+	if publicKeyPath != "" {
+		key, err := os.ReadFile(publicKeyPath)
+		if err != nil {
+			return fmt.Errorf("failed to read public key: %w", err)
+		}
+		req.PublicKey = key
+	}
+
+	fmt.Printf("Installing %s/%s", owner, repo)
+	if version != "" {
+		fmt.Printf(" %s", version)
+	}
+	fmt.Println("...")
+
+	resp, err := daemonClient.InstallPlugin(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to install plugin: %w", err)
+	}
+
+	switch {
+	case resp.SignatureVerified:
+		fmt.Printf("Verified: checksum and signature (sha256 %s)\n", resp.Sha256)
+	case resp.ChecksumVerified:
+		fmt.Printf("Verified: checksum (sha256 %s)\n", resp.Sha256)
+	default:
+		color.Yellow("Warning: release publishes no checksums; installed unverified (sha256 %s)", resp.Sha256)
+	}
+
+	action := "Installed"
+	if resp.Replaced {
+		action = "Updated"
+	}
+	color.Green("✓ %s plugin %s %s", action, resp.Name, resp.Version)
+	fmt.Printf("  Path: %s\n", resp.Path)
+	fmt.Println()
+	fmt.Printf("Use it with: dvb provision --network %s\n", resp.Name)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+// cmd/dvb/plugins_test.go
+package main
+
+import "testing"
+
+func TestFailureRate(t *testing.T) {
+	tests := []struct {
+		calls, failures uint64
+		want            string
+	}{
+		{0, 0, "-"},
+		{10, 0, "0.0%"},
+		{3, 1, "33.3%"},
+		{4, 4, "100.0%"},
+	}
+
+	for _, tt := range tests {
+		if got := failureRate(tt.calls, tt.failures); got != tt.want {
+			t.Errorf("failureRate(%d, %d) = %q, want %q", tt.calls, tt.failures, got, tt.want)
+		}
+	}
+}
+
+func TestFormatCapabilities(t *testing.T) {
+	tests := []struct {
+		caps     []string
+		known    bool
+		protocol int32
+		want     string
+	}{
+		{nil, false, 0, "-"},
+		{nil, false, 1, "unknown"},
+		{nil, true, 2, "none"},
+		{[]string{"evm", "gov-params"}, true, 2, "evm,gov-params"},
+	}
+
+	for _, tt := range tests {
+		if got := formatCapabilities(tt.caps, tt.known, tt.protocol); got != tt.want {
+			t.Errorf("formatCapabilities(%v, %v, %d) = %q, want %q", tt.caps, tt.known, tt.protocol, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+// cmd/dvb/project.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/config"
+	"github.com/altuslabsxyz/devnet-builder/internal/project"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// projectOptions holds options shared by the project commands
+type projectOptions struct {
+	file      string
+	namespace string
+}
+
+func newProjectCmd() *cobra.Command {
+	opts := &projectOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "project",
+		Short: "Manage a group of devnets as one unit",
+		Long: `Manage a multi-chain project: the devnets, relayers and sidecars listed in
+a project manifest (project.yaml), brought up and down together in
+dependency order.
+
+A project manifest looks like:
+
+  apiVersion: devnet.lagos/v1
+  kind: Project
+  metadata:
+    name: interchain
+  spec:
+    devnets:
+      - name: hub
+        file: hub.yaml           # devnet file, relative to project.yaml
+      - name: consumer
+        dependsOn: [hub]
+        spec:                    # or an inline devnet spec
+          network: stable
+          validators: 2
+    relayers:
+      - name: hub-consumer
+        image: informalsystems/hermes:1.10.0
+        chains: [hub, consumer]  # relayers depend on their chains
+        command: [hermes, start]
+    sidecars:
+      - name: indexer
+        image: example/indexer:latest
+        dependsOn: [consumer]
+        env:
+          LOG_LEVEL: debug
+
+Relayers and sidecars run as Docker containers on the host network, named
+dvb-<project>-<name>. They receive DVB_PROJECT, DVB_NAMESPACE and
+DVB_DEVNETS, and relayers DVB_CHAINS, in their environment.
+
+Examples:
+  # Bring up the project in ./project.yaml
+  dvb project up
+
+  # Show the state of every unit
+  dvb project status
+
+  # Tear the project down
+  dvb project down`,
+	}
+
+	cmd.PersistentFlags().StringVarP(&opts.file, "file", "f", config.DefaultProjectFile, "Project manifest")
+	cmd.PersistentFlags().StringVarP(&opts.namespace, "namespace", "n", "", "Namespace for devnets (defaults to the project's or server default)")
+
+	cmd.AddCommand(
+		newProjectUpCmd(opts),
+		newProjectDownCmd(opts),
+		newProjectStatusCmd(opts),
+	)
+
+	return cmd
+}
+
+func newProjectUpCmd(opts *projectOptions) *cobra.Command {
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "up",
+		Short: "Bring up every devnet, relayer and sidecar of a project",
+		Long: `Bring up every unit of a project in dependency order. Devnets are created,
+or updated if they exist, and must reach Running before their dependents
+start.
+
+If a unit fails, the units created by this run are removed again and the
+devnets it updated or containers it replaced are restored, in reverse order,
+so the project is either fully up or left as it was.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := loadProjectManager(opts)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			if err := m.Up(ctx); err != nil {
+				return err
+			}
+			color.Green("✓ Project is up")
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "How long to wait for the whole project")
+
+	return cmd
+}
+
+func newProjectDownCmd(opts *projectOptions) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "down",
+		Short: "Remove every devnet, relayer and sidecar of a project",
+		Long: `Remove every unit of a project in reverse dependency order. Removal keeps
+going after a failure and reports every error at the end.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := loadProjectManager(opts)
+			if err != nil {
+				return err
+			}
+
+			if !force && !ShouldSkipConfirm() {
+				fmt.Printf("This will delete every devnet, relayer and sidecar of project %s.\n", opts.file)
+				fmt.Print("\nAre you sure? [y/N] ")
+				var response string
+				if _, err := fmt.Scanln(&response); err != nil || (response != "y" && response != "Y") {
+					fmt.Println("Cancelled")
+					return nil
+				}
+			}
+
+			if err := m.Down(cmd.Context()); err != nil {
+				return err
+			}
+			color.Green("✓ Project is down")
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation")
+
+	return cmd
+}
+
+func newProjectStatusCmd(opts *projectOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show the state of every unit of a project",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := loadProjectManager(opts)
+			if err != nil {
+				return err
+			}
+
+			statuses, err := m.Status(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "KIND\tNAME\tSTATE")
+			for _, s := range statuses {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", s.Kind, s.Name, s.State)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+// loadProjectManager loads the project manifest and connects it to the
+// daemon and Docker.
+func loadProjectManager(opts *projectOptions) (*project.Manager, error) {
+	p, err := config.LoadProject(opts.file)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireDaemon(); err != nil {
+		return nil, err
+	}
+	return project.NewManager(p, opts.namespace, daemonProjectDevnets{}, project.NewDockerContainers(), os.Stderr), nil
+}
+
+// daemonProjectDevnets manages project devnets through the daemon.
+type daemonProjectDevnets struct{}
+
+func (daemonProjectDevnets) Apply(ctx context.Context, namespace, name string, spec *v1.DevnetSpec, labels map[string]string) (bool, error) {
+	exists, _, err := CheckDevnetExists(ctx, namespace, name)
+	if err != nil {
+		return false, err
+	}
+
+	if exists {
+		if _, err := daemonClient.ApplyDevnet(ctx, namespace, name, spec, labels, nil); err != nil {
+			return false, err
+		}
+	} else if _, err := daemonClient.CreateDevnet(ctx, namespace, name, spec, labels); err != nil {
+		return false, err
+	}
+
+	if err := pollProvisionStatus(ctx, namespace, name); err != nil {
+		return !exists, err
+	}
+	return !exists, nil
+}
+
+func (daemonProjectDevnets) Delete(ctx context.Context, namespace, name string) error {
+	err := daemonClient.DeleteDevnet(ctx, namespace, name)
+	if err != nil && strings.Contains(err.Error(), "not found") {
+		return nil
+	}
+	return err
+}
+
+func (daemonProjectDevnets) Spec(ctx context.Context, namespace, name string) (*v1.DevnetSpec, map[string]string, error) {
+	exists, devnet, err := CheckDevnetExists(ctx, namespace, name)
+	if err != nil || !exists {
+		return nil, nil, err
+	}
+	return devnet.Spec, devnet.GetMetadata().GetLabels(), nil
+}
+
+func (daemonProjectDevnets) Phase(ctx context.Context, namespace, name string) (string, error) {
+	exists, devnet, err := CheckDevnetExists(ctx, namespace, name)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return project.StateAbsent, nil
+	}
+	if devnet.Status == nil || devnet.Status.Phase == "" {
+		return "Pending", nil
+	}
+	return devnet.Status.Phase, nil
+}
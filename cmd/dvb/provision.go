@@ -5,28 +5,33 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
 	"github.com/altuslabsxyz/devnet-builder/internal/client"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
 	"github.com/altuslabsxyz/devnet-builder/internal/config"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/genesispatch"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/keys"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
 	"github.com/altuslabsxyz/devnet-builder/internal/dvbcontext"
 	"github.com/altuslabsxyz/devnet-builder/internal/output"
 	"github.com/altuslabsxyz/devnet-builder/internal/tui/views"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 	k8syaml "sigs.k8s.io/yaml"
 )
 
-// stepSpinner is a package-level spinner for non-byte progress steps in verbose mode
-var stepSpinner *output.StatusSpinner
-
 // ProvisionMode represents the mode of operation for the provision command
 type ProvisionMode int
 
@@ -41,20 +46,40 @@ const (
 
 // provisionOptions holds options for the provision command
 type provisionOptions struct {
-	name          string
-	namespace     string
-	network       string
-	networkType   string
-	validators    int
-	fullNodes     int
-	mode          string
-	binaryVersion string
-	file          string // YAML config file path
-	dryRun        bool   // Preview changes without applying
-	listPlugins   bool   // List available network plugins
-	noWait        bool   // Return immediately without waiting for provisioning
-	verbose       bool   // Stream detailed provisioner logs
-	quick         bool   // Quick mode with smart defaults
+	name             string
+	namespace        string
+	network          string
+	networkType      string
+	validators       int
+	fullNodes        int
+	accounts         int
+	mode             string
+	binaryVersion    string
+	image            string   // Docker image for docker mode
+	profile          string   // Resource profile (e.g., laptop)
+	forceBuild       bool     // Compile from source even if a release binary exists
+	allowUnverified  bool     // Use release binaries without a published checksum
+	offline          bool     // Use only local caches (no network access)
+	force            bool     // Provision even if the disk space preflight fails
+	genesisOverrides []string // Genesis overrides as path=value
+	ports            string   // Port layout as service=port pairs
+	bindAddress      string   // IP nodes listen on instead of their subnet addresses
+	seed             string   // Derives all keys, so devnets with the same seed are identical
+	pruneIBCPackets  bool     // Clear IBC packet state from a forked genesis
+	pruneDust        string   // Remove accounts below this coin from a forked genesis
+	pruneWasmCode    bool     // Drop CosmWasm code from a forked genesis
+	powerProfile     string   // Voting power split: equal, proportional or a weights file
+	ttl              string   // Stop the devnet after this duration (e.g., 4h)
+	deleteOnExpiry   bool     // Delete instead of stop when the TTL expires
+	idleTimeout      string   // Stop the devnet after this long without traffic
+	blockTime        string   // Consensus timeout_commit of every node (e.g., 500ms)
+	epochDuration    string   // Duration of every epochs module epoch in genesis
+	file             string   // YAML config file path
+	dryRun           bool     // Preview changes without applying
+	listPlugins      bool     // List available network plugins
+	noWait           bool     // Return immediately without waiting for provisioning
+	verbose          bool     // Stream detailed provisioner logs
+	quick            bool     // Quick mode with smart defaults
 }
 
 func newProvisionCmd() *cobra.Command {
@@ -87,9 +112,42 @@ Examples:
   # Provision with custom settings
   dvb provision --name my-devnet --network cosmos --validators 4
 
+  # Reduce per-node resource usage on a 16GB laptop
+  dvb provision --name my-devnet --network stable --validators 4 --profile laptop
+
+  # Provision in docker mode with an image built from a branch
+  dvb provision --name my-devnet --network stable --image dvb/stable:feat-my-branch-abc123def456
+
   # Provision from a YAML file
   dvb provision -f devnet.yaml
 
+  # Shorten the governance voting period in the genesis
+  dvb provision --name my-devnet --network stable --genesis-override app_state.gov.params.voting_period=30s
+
+  # Produce blocks twice a second and end Osmosis-style epochs every minute
+  dvb provision --name my-devnet --network osmosis --block-time 500ms --epoch-duration 1m
+
+  # Stop the devnet automatically after 4 hours ('dvb extend' adds time)
+  dvb provision --name my-devnet --network stable --ttl 4h
+
+  # Stop the devnet after 30 minutes without RPC traffic or transactions
+  dvb provision --name my-devnet --network stable --idle-timeout 30m
+
+  # Move the node ports out of the way of another chain on this host
+  dvb provision --name my-devnet --network stable --ports rpc=36657,rest=2317,grpc=10090,evm=9545,stride=10
+
+  # Listen on all IPv6 and IPv4 interfaces so other hosts can reach the nodes
+  dvb provision --name my-devnet --network stable --mode local --bind-address ::
+
+  # Create the same validator set, accounts and node IDs as a colleague
+  dvb provision -q --seed issue-1234
+
+  # Provision on a runner without internet access, using only local caches
+  dvb provision -f devnet.yaml --offline
+
+  # Provision even if the disk space preflight check fails
+  dvb provision -f devnet.yaml --force
+
   # Quick provision with smart defaults (auto-generated name, 1 validator)
   dvb provision -q
   dvb provision -q --name my-devnet
@@ -98,6 +156,9 @@ Examples:
   # Preview changes without applying (dry-run)
   dvb provision --name my-devnet --network stable --dry-run
   dvb provision -f devnet.yaml --dry-run`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return validateProvisionOptions(opts)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// List plugins mode
 			if opts.listPlugins {
@@ -134,14 +195,39 @@ Examples:
 	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "default", "Namespace")
 
 	// Network configuration
-	cmd.Flags().StringVar(&opts.network, "network", "stable", "Network plugin name (e.g., stable, cosmos)")
+	cmd.Flags().StringVar(&opts.network, "network", "stable", "Network plugin name (e.g., stable, cosmos, osmosis, evmos)")
+	_ = cmd.RegisterFlagCompletionFunc("network", completeNetworkNames)
 	cmd.Flags().StringVar(&opts.networkType, "network-type", "", "Network type for genesis fork (e.g., mainnet, testnet)")
 	cmd.Flags().StringVar(&opts.binaryVersion, "binary-version", "", "Binary version to use")
+	cmd.Flags().BoolVar(&opts.forceBuild, "force-build", false, "Compile the binary from source even if the version has a published release binary")
+	cmd.Flags().BoolVar(&opts.allowUnverified, "allow-unverified", false, "Use a release binary even if its release publishes no checksum, instead of compiling from source")
+	cmd.Flags().BoolVar(&opts.offline, "offline", false, "Use only cached binaries, snapshots, genesis files and docker images; fail if anything is missing")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "Provision even if the disk space preflight estimates the disk is too small")
+	cmd.Flags().StringArrayVar(&opts.genesisOverrides, "genesis-override", nil, "Override a genesis value as path=value (repeatable; value is JSON or a plain string)")
+	cmd.Flags().BoolVar(&opts.pruneIBCPackets, "prune-ibc-packets", false, "Clear IBC packet commitments, receipts and acknowledgements from the forked genesis")
+	cmd.Flags().StringVar(&opts.pruneDust, "prune-dust", "", "Remove plain accounts holding less than this coin from the forked genesis (e.g., 1000uatom)")
+	cmd.Flags().BoolVar(&opts.pruneWasmCode, "prune-wasm-code", false, "Drop CosmWasm code and the contracts using it from the forked genesis")
+	cmd.Flags().StringVar(&opts.powerProfile, "power-profile", "", "Voting power of the validators in a forked genesis: equal (default), proportional to the source chain's largest validators, or a JSON file with one weight per validator")
 
 	// Node configuration
 	cmd.Flags().IntVar(&opts.validators, "validators", 4, "Number of validators")
 	cmd.Flags().IntVar(&opts.fullNodes, "full-nodes", 0, "Number of full nodes")
+	cmd.Flags().IntVar(&opts.accounts, "accounts", 0, "Number of deterministic test accounts to fund in genesis (see 'dvb keys export')")
 	cmd.Flags().StringVar(&opts.mode, "mode", "docker", "Execution mode (docker or local)")
+	cmd.Flags().StringVar(&opts.profile, "profile", "", "Resource profile: laptop (pruning, no tx index, small mempool, API on node 0 only, GOMEMLIMIT)")
+	cmd.Flags().StringVar(&opts.image, "image", "", "Docker image for nodes in docker mode (e.g., one built with 'dvb build --image')")
+	cmd.Flags().StringVar(&opts.bindAddress, "bind-address", "", "IP address nodes listen on instead of their own loopback address (e.g., 0.0.0.0, ::, or an interface IP); in docker mode, the host address ports are published on")
+	cmd.Flags().StringVar(&opts.seed, "seed", "", "Derive all validator and account mnemonics, node keys, the chain ID and the genesis time from this seed, so devnets created with the same seed are identical")
+	cmd.Flags().StringVar(&opts.ports, "ports", "", "Port layout as base ports and stride (e.g., rpc=36657,rest=2317,grpc=10090,evm=9545,p2p=36656,stride=10)")
+
+	// Chain speed
+	cmd.Flags().StringVar(&opts.blockTime, "block-time", "", "Block time of the devnet, the consensus timeout_commit of every node (default 1s; e.g., 500ms)")
+	cmd.Flags().StringVar(&opts.epochDuration, "epoch-duration", "", "Set the duration of every epochs module epoch in genesis (e.g., 1m), so epoch-driven logic runs quickly")
+
+	// Lifetime
+	cmd.Flags().StringVar(&opts.ttl, "ttl", "", "Stop the devnet automatically after this duration (e.g., 4h)")
+	cmd.Flags().BoolVar(&opts.deleteOnExpiry, "delete-on-expiry", false, "Delete the devnet instead of stopping it when its TTL expires")
+	cmd.Flags().StringVar(&opts.idleTimeout, "idle-timeout", "", "Stop the devnet after this long without RPC traffic or transactions (e.g., 30m)")
 
 	// Quick mode
 	cmd.Flags().BoolVarP(&opts.quick, "quick", "q", false, "Quick provision with smart defaults (auto-generated name, 1 validator)")
@@ -158,6 +244,37 @@ Examples:
 	return cmd
 }
 
+// validateProvisionOptions checks the node counts, mode and parsed flags
+// of provision, before it talks to the daemon.
+func validateProvisionOptions(opts *provisionOptions) error {
+	if err := validation.AtLeast("validators", opts.validators, 1); err != nil {
+		return err
+	}
+	if err := validation.NonNegative("full-nodes", opts.fullNodes); err != nil {
+		return err
+	}
+	if err := validation.NonNegative("accounts", opts.accounts); err != nil {
+		return err
+	}
+	if err := validation.OneOf("mode", opts.mode, "docker", "local"); err != nil {
+		return err
+	}
+	// A spec file may set the TTL itself
+	if opts.file == "" && opts.deleteOnExpiry && opts.ttl == "" {
+		return fmt.Errorf("--delete-on-expiry requires --ttl")
+	}
+	if _, err := parseGenesisOverrides(opts.genesisOverrides); err != nil {
+		return err
+	}
+	if _, err := parsePortLayout(opts.ports); err != nil {
+		return err
+	}
+	if _, err := parsePowerProfile(opts.powerProfile); err != nil {
+		return err
+	}
+	return nil
+}
+
 // detectProvisionMode determines which mode to use based on flags
 func detectProvisionMode(opts *provisionOptions) ProvisionMode {
 	// Order: file > quick/flags > interactive
@@ -203,6 +320,19 @@ func runInteractiveMode(ctx context.Context, opts *provisionOptions) error {
 		Mode:        wizardOpts.Mode,
 		SdkVersion:  wizardOpts.BinaryVersion,
 		ForkNetwork: wizardOpts.ForkNetwork,
+
+		SkipDiskCheck: opts.force,
+		BindAddress:   opts.bindAddress,
+		Seed:          opts.seed,
+		GenesisPrune:  opts.genesisPrune(),
+		BlockTime:     opts.blockTime,
+		EpochDuration: opts.epochDuration,
+	}
+	if spec.Ports, err = parsePortLayout(opts.ports); err != nil {
+		return err
+	}
+	if spec.VotingPower, err = parsePowerProfile(opts.powerProfile); err != nil {
+		return err
 	}
 
 	namespace := "default"
@@ -224,7 +354,7 @@ func runFlagMode(ctx context.Context, opts *provisionOptions) error {
 	// Quick mode: apply smart defaults for unset values
 	if opts.quick {
 		if opts.name == "" {
-			opts.name = generateDevnetName()
+			opts.name = generateDevnetName(opts.seed)
 		}
 		// Quick mode defaults: 1 validator, local mode
 		// Only override if user didn't explicitly set them
@@ -244,26 +374,52 @@ func runFlagMode(ctx context.Context, opts *provisionOptions) error {
 		return fmt.Errorf("--network is required in flag mode")
 	}
 
-	// Validate options
-	if opts.validators < 1 {
-		return fmt.Errorf("--validators must be at least 1")
+	// Quick mode may have switched to local mode
+	if opts.image != "" && opts.mode != "docker" {
+		return fmt.Errorf("--image requires --mode docker")
 	}
-	if opts.fullNodes < 0 {
-		return fmt.Errorf("--full-nodes cannot be negative")
+
+	genesisOverrides, err := parseGenesisOverrides(opts.genesisOverrides)
+	if err != nil {
+		return err
 	}
-	if opts.mode != "docker" && opts.mode != "local" {
-		return fmt.Errorf("--mode must be 'docker' or 'local'")
+	portLayout, err := parsePortLayout(opts.ports)
+	if err != nil {
+		return err
+	}
+	votingPower, err := parsePowerProfile(opts.powerProfile)
+	if err != nil {
+		return err
 	}
 
 	// Build devnet spec
 	spec := &v1.DevnetSpec{
-		Plugin:      opts.network,
-		NetworkType: opts.networkType,
-		Validators:  int32(opts.validators),
-		FullNodes:   int32(opts.fullNodes),
-		Mode:        opts.mode,
-		SdkVersion:  opts.binaryVersion,
-		ForkNetwork: opts.networkType,
+		Plugin:          opts.network,
+		NetworkType:     opts.networkType,
+		Validators:      int32(opts.validators),
+		FullNodes:       int32(opts.fullNodes),
+		Accounts:        int32(opts.accounts),
+		Mode:            opts.mode,
+		SdkVersion:      opts.binaryVersion,
+		ForkNetwork:     opts.networkType,
+		Image:           opts.image,
+		Profile:         opts.profile,
+		ForceBuild:      opts.forceBuild,
+		AllowUnverified: opts.allowUnverified,
+		Offline:         opts.offline,
+		Ttl:             opts.ttl,
+		IdleTimeout:     opts.idleTimeout,
+
+		BlockTime:        opts.blockTime,
+		EpochDuration:    opts.epochDuration,
+		GenesisOverrides: genesisOverrides,
+		DeleteOnExpiry:   opts.deleteOnExpiry,
+		SkipDiskCheck:    opts.force,
+		Ports:            portLayout,
+		BindAddress:      opts.bindAddress,
+		Seed:             opts.seed,
+		GenesisPrune:     opts.genesisPrune(),
+		VotingPower:      votingPower,
 	}
 
 	namespace := opts.namespace
@@ -296,6 +452,58 @@ func runFileMode(ctx context.Context, opts *provisionOptions) error {
 
 	yamlDevnet := devnets[0]
 	proto := yamlDevnet.ToProto()
+	if opts.offline {
+		proto.Spec.Offline = true
+	}
+	if opts.force {
+		proto.Spec.SkipDiskCheck = true
+	}
+	if opts.ttl != "" {
+		proto.Spec.Ttl = opts.ttl
+	}
+	if opts.deleteOnExpiry {
+		proto.Spec.DeleteOnExpiry = true
+	}
+	if opts.idleTimeout != "" {
+		proto.Spec.IdleTimeout = opts.idleTimeout
+	}
+	if opts.blockTime != "" {
+		proto.Spec.BlockTime = opts.blockTime
+	}
+	if opts.epochDuration != "" {
+		proto.Spec.EpochDuration = opts.epochDuration
+	}
+	if opts.bindAddress != "" {
+		proto.Spec.BindAddress = opts.bindAddress
+	}
+	if opts.seed != "" {
+		proto.Spec.Seed = opts.seed
+	}
+	if prune := opts.genesisPrune(); prune != nil {
+		proto.Spec.GenesisPrune = prune
+	}
+	if opts.ports != "" {
+		if proto.Spec.Ports, err = parsePortLayout(opts.ports); err != nil {
+			return err
+		}
+	}
+	if opts.powerProfile != "" {
+		if proto.Spec.VotingPower, err = parsePowerProfile(opts.powerProfile); err != nil {
+			return err
+		}
+	}
+	if len(opts.genesisOverrides) > 0 {
+		overrides, err := parseGenesisOverrides(opts.genesisOverrides)
+		if err != nil {
+			return err
+		}
+		if proto.Spec.GenesisOverrides == nil {
+			proto.Spec.GenesisOverrides = make(map[string]string, len(overrides))
+		}
+		for path, value := range overrides {
+			proto.Spec.GenesisOverrides[path] = value
+		}
+	}
 
 	namespace := proto.Metadata.Namespace
 	if namespace == "" {
@@ -306,6 +514,99 @@ func runFileMode(ctx context.Context, opts *provisionOptions) error {
 	return executeUpsert(ctx, namespace, proto.Metadata.Name, proto.Spec, proto.Metadata.Labels, proto.Metadata.Annotations, opts.dryRun, true, opts.noWait, opts.verbose)
 }
 
+// parseGenesisOverrides parses --genesis-override flags of the form
+// path=value into JSON-encoded values. Values that are not valid JSON are
+// treated as plain strings, so "voting_period=30s" needs no extra quoting.
+func parseGenesisOverrides(flags []string) (map[string]string, error) {
+	if len(flags) == 0 {
+		return nil, nil
+	}
+	overrides := make(map[string]string, len(flags))
+	for _, flag := range flags {
+		path, value, ok := strings.Cut(flag, "=")
+		if !ok || path == "" {
+			return nil, fmt.Errorf("--genesis-override must be path=value, got %q", flag)
+		}
+		if !json.Valid([]byte(value)) {
+			encoded, _ := json.Marshal(value)
+			value = string(encoded)
+		}
+		overrides[path] = value
+	}
+	return overrides, nil
+}
+
+// genesisPrune returns the genesis pruning selected by the --prune-* flags,
+// or nil if none is.
+func (opts *provisionOptions) genesisPrune() *v1.GenesisPrune {
+	if !opts.pruneIBCPackets && opts.pruneDust == "" && !opts.pruneWasmCode {
+		return nil
+	}
+	return &v1.GenesisPrune{
+		IbcPackets:    opts.pruneIBCPackets,
+		DustThreshold: opts.pruneDust,
+		WasmCode:      opts.pruneWasmCode,
+	}
+}
+
+// parsePowerProfile parses a --power-profile flag: equal, proportional, or
+// the path of a JSON file holding an array of per-validator weights, which
+// selects the custom profile.
+func parsePowerProfile(flag string) (*v1.VotingPower, error) {
+	switch flag {
+	case "":
+		return nil, nil
+	case genesispatch.PowerEqual, genesispatch.PowerProportional:
+		return &v1.VotingPower{Profile: flag}, nil
+	}
+	data, err := os.ReadFile(flag)
+	if err != nil {
+		return nil, fmt.Errorf("--power-profile must be %s, %s or a weights file: %w", genesispatch.PowerEqual, genesispatch.PowerProportional, err)
+	}
+	var weights []float64
+	if err := json.Unmarshal(data, &weights); err != nil {
+		return nil, fmt.Errorf("--power-profile: %s must be a JSON array of weights: %w", flag, err)
+	}
+	return &v1.VotingPower{Profile: genesispatch.PowerCustom, Weights: weights}, nil
+}
+
+// parsePortLayout parses a --ports flag of comma-separated service=port
+// pairs. Services are p2p, rpc, rest, grpc, evm and stride; omitted ones
+// keep their defaults.
+func parsePortLayout(flag string) (*v1.PortLayout, error) {
+	if flag == "" {
+		return nil, nil
+	}
+	layout := &v1.PortLayout{}
+	for _, pair := range strings.Split(flag, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return nil, fmt.Errorf("--ports must be service=port pairs, got %q", pair)
+		}
+		port, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || port < 0 {
+			return nil, fmt.Errorf("--ports: invalid %s port %q", name, value)
+		}
+		switch name {
+		case "p2p":
+			layout.P2P = int32(port)
+		case "rpc":
+			layout.Rpc = int32(port)
+		case "rest":
+			layout.Rest = int32(port)
+		case "grpc":
+			layout.Grpc = int32(port)
+		case "evm":
+			layout.EvmRpc = int32(port)
+		case "stride":
+			layout.Stride = int32(port)
+		default:
+			return nil, fmt.Errorf("--ports: unknown service %q (use p2p, rpc, rest, grpc, evm or stride)", name)
+		}
+	}
+	return layout, nil
+}
+
 // CheckDevnetExists checks if a devnet exists via the daemon
 func CheckDevnetExists(ctx context.Context, namespace, name string) (bool, *v1.Devnet, error) {
 	if err := requireDaemon(); err != nil {
@@ -655,7 +956,12 @@ func runListPlugins(ctx context.Context) error {
 }
 
 // generateDevnetName generates a random short devnet name for quick mode.
-func generateDevnetName() string {
+// Seeded devnets take the suffix from their seed instead, so their names
+// match.
+func generateDevnetName(seed string) string {
+	if seed != "" {
+		return keys.ForSeed(seed).DevnetName()
+	}
 	b := make([]byte, 2)
 	if _, err := rand.Read(b); err != nil {
 		// Fallback to a fixed name if crypto/rand fails
@@ -716,7 +1022,8 @@ func formatProvisionYAML(w io.Writer, namespace, name string, spec *v1.DevnetSpe
 }
 
 // streamProvisionLogs streams detailed provisioner logs from the daemon.
-// It prints each log entry with a [provisioner] prefix until the stream ends.
+// It prints each log entry with a [provisioner] prefix and renders step
+// progress until the stream ends.
 func streamProvisionLogs(ctx context.Context, namespace, name string) error {
 	return streamProvisionLogsWithClient(ctx, namespace, name, daemonClient)
 }
@@ -732,13 +1039,21 @@ func streamProvisionLogsWithClient(ctx context.Context, namespace, name string,
 		return fmt.Errorf("daemon client not available")
 	}
 
+	renderer := newStepRenderer()
+	defer renderer.Clear()
+
 	return c.StreamProvisionLogs(ctx, namespace, name, func(entry *client.ProvisionLogEntry) error {
-		// Print log entry with provisioner prefix
-		printProvisionLog(entry)
+		printProvisionLog(renderer, entry)
 		return nil
 	})
 }
 
+// newStepRenderer returns a step progress renderer for stderr, drawing
+// progress bars only when stderr is a terminal.
+func newStepRenderer() *output.StepRenderer {
+	return output.NewStepRenderer(os.Stderr, term.IsTerminal(int(os.Stderr.Fd())))
+}
+
 // runProvisionTUI runs the provision command with the bubbletea TUI.
 // It creates a ProvisionModel, starts the tea.Program, and streams logs in a background goroutine.
 func runProvisionTUI(ctx context.Context, c *client.Client, namespace, devnetName, network string) error {
@@ -802,19 +1117,29 @@ func runProvisionTUI(ctx context.Context, c *client.Client, namespace, devnetNam
 	return pm.GetError()
 }
 
-// printProvisionLog prints a provision log entry to stderr with appropriate formatting.
-func printProvisionLog(entry *client.ProvisionLogEntry) {
+// printProvisionLog prints a provision log entry to stderr with appropriate
+// formatting. Step progress entries are drawn by renderer.
+func printProvisionLog(renderer *output.StepRenderer, entry *client.ProvisionLogEntry) {
 	if entry == nil {
 		return
 	}
 
 	// Handle progress updates (sub-steps)
 	if entry.StepName != "" {
-		printProgressStep(entry)
+		renderer.Render(output.StepUpdate{
+			Name:    entry.StepName,
+			Status:  entry.StepStatus,
+			Current: entry.ProgressCurrent,
+			Total:   entry.ProgressTotal,
+			Unit:    entry.ProgressUnit,
+			Detail:  entry.StepDetail,
+			Speed:   entry.Speed,
+		})
 		return
 	}
 
-	// Regular log entry (existing code)
+	// Regular log entry; clear any progress bar so the line isn't garbled.
+	renderer.Clear()
 	prefix := "[provisioner]"
 	switch entry.Level {
 	case "error":
@@ -826,97 +1151,6 @@ func printProvisionLog(entry *client.ProvisionLogEntry) {
 	}
 }
 
-// printProgressStep prints a progress sub-step with appropriate formatting.
-func printProgressStep(entry *client.ProvisionLogEntry) {
-	switch entry.StepStatus {
-	case "running":
-		if entry.ProgressTotal > 0 && entry.ProgressUnit == "bytes" {
-			// Byte-based progress: stop spinner if running, show progress bar
-			if stepSpinner != nil {
-				stepSpinner.Stop()
-				stepSpinner = nil
-			}
-
-			pct := float64(entry.ProgressCurrent) / float64(entry.ProgressTotal) * 100
-			currentMB := float64(entry.ProgressCurrent) / (1024 * 1024)
-			totalMB := float64(entry.ProgressTotal) / (1024 * 1024)
-			speedMB := entry.Speed / (1024 * 1024)
-
-			// Build progress bar (width: 30 chars)
-			barWidth := 30
-			filled := int(pct / 100 * float64(barWidth))
-			if filled < 0 {
-				filled = 0
-			} else if filled > barWidth {
-				filled = barWidth
-			}
-			bar := strings.Repeat("█", filled) + strings.Repeat("░", barWidth-filled)
-
-			// Calculate ETA
-			eta := ""
-			if entry.Speed > 0 {
-				remaining := float64(entry.ProgressTotal - entry.ProgressCurrent)
-				etaSecs := remaining / entry.Speed
-				if etaSecs < 60 {
-					eta = fmt.Sprintf("%.0fs", etaSecs)
-				} else if etaSecs < 3600 {
-					eta = fmt.Sprintf("%.1fm", etaSecs/60)
-				} else {
-					eta = fmt.Sprintf("%.1fh", etaSecs/3600)
-				}
-			}
-
-			fmt.Fprintf(os.Stderr, "\r  %s %5.1f%% | %.1f/%.1f MB | %.1f MB/s | ETA: %s    ",
-				color.CyanString(bar), pct, currentMB, totalMB, speedMB, eta)
-		} else {
-			// Non-byte progress: use spinner for animated feedback
-			msg := entry.StepName
-			if entry.StepDetail != "" {
-				msg = fmt.Sprintf("%s (%s)", entry.StepName, entry.StepDetail)
-			}
-
-			if stepSpinner == nil {
-				stepSpinner = output.NewStatusSpinner()
-				stepSpinner.Start(msg)
-			} else {
-				stepSpinner.Update(msg)
-			}
-		}
-	case "completed":
-		// Stop spinner and show completion
-		if stepSpinner != nil {
-			stepSpinner.Stop()
-			stepSpinner = nil
-		}
-		clearLine()
-		if entry.StepDetail != "" {
-			fmt.Fprintf(os.Stderr, "  %s %s (%s)\n",
-				color.GreenString("✓"),
-				entry.StepName,
-				entry.StepDetail)
-		} else {
-			fmt.Fprintf(os.Stderr, "  %s %s\n",
-				color.GreenString("✓"),
-				entry.StepName)
-		}
-	case "failed":
-		// Stop spinner and show failure
-		if stepSpinner != nil {
-			stepSpinner.Stop()
-			stepSpinner = nil
-		}
-		clearLine()
-		fmt.Fprintf(os.Stderr, "  %s %s\n",
-			color.RedString("✗"),
-			entry.StepName)
-	}
-}
-
-// clearLine clears the current terminal line for progress updates.
-func clearLine() {
-	fmt.Fprintf(os.Stderr, "\x1b[2K\r")
-}
-
 // devnetGetter is an interface for getting devnet status, used for testing.
 type devnetGetter interface {
 	GetDevnet(ctx context.Context, namespace, name string) (*v1.Devnet, error)
@@ -960,13 +1194,24 @@ func pollProvisionStatusWithClient(ctx context.Context, namespace, name string,
 				case "Running":
 					return nil
 				case "Degraded":
-					return fmt.Errorf("provisioning failed: %s", devnet.Status.Message)
+					return apierror.Errorf(apierror.FromConditionReason(degradedReason(devnet.Status)),
+						"provisioning failed: %s", devnet.Status.Message)
 				}
 			}
 		}
 	}
 }
 
+// degradedReason returns the reason of the Degraded condition, if set.
+func degradedReason(status *v1.DevnetStatus) string {
+	for _, c := range status.Conditions {
+		if c.Type == types.ConditionTypeDegraded && c.Status == types.ConditionTrue {
+			return c.Reason
+		}
+	}
+	return ""
+}
+
 // printEvent prints an event to stderr with appropriate formatting.
 // Normal events are printed with a checkmark, warnings with a warning indicator.
 func printEvent(event *v1.Event) {
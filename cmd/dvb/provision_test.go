@@ -13,7 +13,10 @@ import (
 	"time"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
 	"github.com/altuslabsxyz/devnet-builder/internal/client"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -122,6 +125,124 @@ func TestDetectProvisionMode(t *testing.T) {
 	}
 }
 
+func TestParseGenesisOverrides(t *testing.T) {
+	overrides, err := parseGenesisOverrides([]string{
+		"app_state.gov.params.voting_period=30s",
+		"app_state.staking.params.max_validators=4",
+		`app_state.staking.params={"bond_denom":"ustake"}`,
+	})
+	if err != nil {
+		t.Fatalf("parseGenesisOverrides() error = %v", err)
+	}
+	expected := map[string]string{
+		"app_state.gov.params.voting_period":      `"30s"`,
+		"app_state.staking.params.max_validators": `4`,
+		"app_state.staking.params":                `{"bond_denom":"ustake"}`,
+	}
+	for path, want := range expected {
+		if got := overrides[path]; got != want {
+			t.Errorf("override %s = %s, want %s", path, got, want)
+		}
+	}
+
+	if _, err := parseGenesisOverrides([]string{"no-equals-sign"}); err == nil {
+		t.Error("parseGenesisOverrides() should reject flags without '='")
+	}
+}
+
+func TestParsePortLayout(t *testing.T) {
+	layout, err := parsePortLayout("rpc=36657, rest=2317,grpc=10090,evm=9545,stride=10")
+	if err != nil {
+		t.Fatalf("parsePortLayout() error = %v", err)
+	}
+	want := &v1.PortLayout{Rpc: 36657, Rest: 2317, Grpc: 10090, EvmRpc: 9545, Stride: 10}
+	if !proto.Equal(layout, want) {
+		t.Errorf("parsePortLayout() = %v, want %v", layout, want)
+	}
+
+	if layout, err := parsePortLayout(""); layout != nil || err != nil {
+		t.Errorf("parsePortLayout(\"\") = %v, %v; want nil, nil", layout, err)
+	}
+	for _, bad := range []string{"rpc", "rpc=abc", "ws=8546", "rpc=-1"} {
+		if _, err := parsePortLayout(bad); err == nil {
+			t.Errorf("parsePortLayout(%q) should fail", bad)
+		}
+	}
+}
+
+func TestParsePowerProfile(t *testing.T) {
+	if power, err := parsePowerProfile(""); power != nil || err != nil {
+		t.Errorf("parsePowerProfile(\"\") = %v, %v; want nil, nil", power, err)
+	}
+	power, err := parsePowerProfile("proportional")
+	if err != nil || !proto.Equal(power, &v1.VotingPower{Profile: "proportional"}) {
+		t.Errorf("parsePowerProfile(proportional) = %v, %v", power, err)
+	}
+
+	dir := t.TempDir()
+	weights := dir + "/weights.json"
+	if err := os.WriteFile(weights, []byte("[40, 30, 20, 10]"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	power, err = parsePowerProfile(weights)
+	if err != nil {
+		t.Fatalf("parsePowerProfile(%s) error = %v", weights, err)
+	}
+	if want := (&v1.VotingPower{Profile: "custom", Weights: []float64{40, 30, 20, 10}}); !proto.Equal(power, want) {
+		t.Errorf("parsePowerProfile(%s) = %v, want %v", weights, power, want)
+	}
+
+	bad := dir + "/bad.json"
+	if err := os.WriteFile(bad, []byte(`{"node0": 40}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, flag := range []string{"whale", bad} {
+		if _, err := parsePowerProfile(flag); err == nil {
+			t.Errorf("parsePowerProfile(%q) should fail", flag)
+		}
+	}
+}
+
+func TestValidateProvisionOptions(t *testing.T) {
+	valid := func() *provisionOptions {
+		return &provisionOptions{validators: 4, mode: "docker"}
+	}
+	if err := validateProvisionOptions(valid()); err != nil {
+		t.Fatalf("validateProvisionOptions() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*provisionOptions)
+	}{
+		{"no validators", func(o *provisionOptions) { o.validators = 0 }},
+		{"negative full nodes", func(o *provisionOptions) { o.fullNodes = -1 }},
+		{"negative accounts", func(o *provisionOptions) { o.accounts = -1 }},
+		{"unknown mode", func(o *provisionOptions) { o.mode = "k8s" }},
+		{"delete on expiry without ttl", func(o *provisionOptions) { o.deleteOnExpiry = true }},
+		{"bad port layout", func(o *provisionOptions) { o.ports = "rpc" }},
+		{"bad power profile", func(o *provisionOptions) { o.powerProfile = "whale" }},
+		{"bad genesis override", func(o *provisionOptions) { o.genesisOverrides = []string{"novalue"} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := valid()
+			tt.modify(opts)
+			if err := validateProvisionOptions(opts); err == nil {
+				t.Error("validateProvisionOptions() should fail")
+			}
+		})
+	}
+
+	// A spec file may set the TTL for --delete-on-expiry
+	opts := valid()
+	opts.file = "devnet.yaml"
+	opts.deleteOnExpiry = true
+	if err := validateProvisionOptions(opts); err != nil {
+		t.Errorf("validateProvisionOptions() with a spec file error = %v", err)
+	}
+}
+
 func TestProvisionOptions_NoWaitFlag(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -363,6 +484,9 @@ func TestPollProvisionStatus_ReturnsErrorOnDegraded(t *testing.T) {
 		Status: &v1.DevnetStatus{
 			Phase:   "Degraded",
 			Message: "Binary build failed",
+			Conditions: []*v1.Condition{
+				{Type: types.ConditionTypeDegraded, Status: types.ConditionTrue, Reason: types.ReasonBuildFailed},
+			},
 		},
 	})
 
@@ -376,6 +500,9 @@ func TestPollProvisionStatus_ReturnsErrorOnDegraded(t *testing.T) {
 	if !strings.Contains(err.Error(), "provisioning failed") {
 		t.Errorf("pollProvisionStatus() error = %v, want to contain 'provisioning failed'", err)
 	}
+	if code := apierror.CodeOf(err); code != apierror.BuildFailed {
+		t.Errorf("pollProvisionStatus() error code = %q, want %q", code, apierror.BuildFailed)
+	}
 }
 
 // TestPollProvisionStatus_PrintsNewEvents tests that polling prints new events without duplicates
@@ -682,7 +809,7 @@ func TestPrintProvisionLog_FormatsLevels(t *testing.T) {
 			r, w, _ := os.Pipe()
 			os.Stderr = w
 
-			printProvisionLog(tt.entry)
+			printProvisionLog(newStepRenderer(), tt.entry)
 
 			w.Close()
 			os.Stderr = oldStderr
@@ -710,7 +837,7 @@ func TestPrintProvisionLog_NilEntry(t *testing.T) {
 	r, w, _ := os.Pipe()
 	os.Stderr = w
 
-	printProvisionLog(nil)
+	printProvisionLog(newStepRenderer(), nil)
 
 	w.Close()
 	os.Stderr = oldStderr
@@ -0,0 +1,208 @@
+// cmd/dvb/record.go
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/dvbrecord"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record",
+		Short: "Record the dvb commands that shape a devnet",
+		Long: `Record the dvb commands that shape a devnet into a replayable manifest.
+
+While a recording is active, every successful state-changing dvb command
+(provision, node start/stop, tx submit, upgrade create, ...) is appended to
+the manifest with its arguments as typed. Read-only commands such as list,
+status and logs are not recorded, and --api-key values are redacted. Files
+passed with --file are embedded so the manifest can be replayed elsewhere.
+
+Replay a manifest with 'dvb replay'.
+
+Examples:
+  # Record a manual test setup
+  dvb record start gov-setup
+  dvb provision -f devnet.yaml
+  dvb tx gov propose ...
+  dvb record stop
+
+  # Reproduce it
+  dvb replay gov-setup.dvb.yaml`,
+	}
+
+	cmd.AddCommand(
+		newRecordStartCmd(),
+		newRecordStopCmd(),
+		newRecordStatusCmd(),
+	)
+
+	return cmd
+}
+
+func newRecordStartCmd() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "start [name]",
+		Short: "Start recording dvb commands",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := "session"
+			if len(args) == 1 {
+				name = args[0]
+			}
+			if output == "" {
+				output = name + ".dvb.yaml"
+			}
+
+			var context string
+			if currentContext != nil {
+				context = currentContext.String()
+			}
+
+			if err := dvbrecord.Start(output, name, context, time.Now()); err != nil {
+				return err
+			}
+
+			path, _ := dvbrecord.Active()
+			color.Green("✓ Recording to %s", path)
+			fmt.Println("  Stop with 'dvb record stop'")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Manifest file (default: <name>.dvb.yaml)")
+
+	return cmd
+}
+
+func newRecordStopCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stop",
+		Short: "Stop recording and finalize the manifest",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, m, err := dvbrecord.Stop(time.Now())
+			if err != nil {
+				return err
+			}
+
+			color.Green("✓ Recorded %d command(s) to %s", len(m.Steps), path)
+			fmt.Printf("  Replay with 'dvb replay %s'\n", path)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newRecordStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the active recording",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := dvbrecord.Active()
+			if err != nil {
+				return err
+			}
+			if path == "" {
+				fmt.Println("Not recording")
+				return nil
+			}
+
+			m, err := dvbrecord.Load(path)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Recording %q to %s\n", m.Name, path)
+			fmt.Printf("  Started: %s\n", m.StartedAt.Local().Format("2006-01-02 15:04:05"))
+			fmt.Printf("  Steps:   %d\n", len(m.Steps))
+			for i, step := range m.Steps {
+				fmt.Printf("  %3d  dvb %s\n", i+1, strings.Join(step.Args, " "))
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// unrecordedCommands are the command paths, and their subcommands, that
+// never change devnet state and so are not recorded.
+var unrecordedCommands = []string{
+	"dvb __complete",
+	"dvb __completeNoDesc",
+	"dvb analyze",
+	"dvb auth",
+	"dvb completion",
+	"dvb config",
+	"dvb daemon",
+	"dvb dashboard",
+	"dvb debug",
+	"dvb doctor",
+	"dvb events",
+	"dvb explain",
+	"dvb export",
+	"dvb genesis",
+	"dvb get",
+	"dvb help",
+	"dvb integrations",
+	"dvb keys",
+	"dvb list",
+	"dvb logs",
+	"dvb namespace list",
+	"dvb net",
+	"dvb node config",
+	"dvb node get",
+	"dvb node health",
+	"dvb node list",
+	"dvb node ports",
+	"dvb plugins",
+	"dvb project status",
+	"dvb record",
+	"dvb replay",
+	"dvb status",
+	"dvb tx list",
+	"dvb tx status",
+	"dvb upgrade list",
+	"dvb upgrade status",
+	"dvb version",
+}
+
+// shouldRecord reports whether a command changes devnet state.
+func shouldRecord(cmd *cobra.Command) bool {
+	path := cmd.CommandPath()
+	for _, skip := range unrecordedCommands {
+		if path == skip || strings.HasPrefix(path, skip+" ") {
+			return false
+		}
+	}
+	return cmd.Runnable()
+}
+
+// recordCommand appends a successful command to the active recording.
+// Recording failures are reported but never fail the command itself.
+func recordCommand(cmd *cobra.Command, args []string) {
+	if !shouldRecord(cmd) {
+		return
+	}
+
+	step := dvbrecord.Step{Args: dvbrecord.Redact(args), At: time.Now()}
+	if flag := cmd.Flags().Lookup("file"); flag != nil && flag.Changed {
+		if content, err := os.ReadFile(flag.Value.String()); err == nil {
+			step.Files = map[string]string{flag.Value.String(): string(content)}
+		}
+	}
+
+	if err := dvbrecord.Record(step); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to record command: %v\n", err)
+	}
+}
@@ -0,0 +1,43 @@
+// cmd/dvb/record_test.go
+package main
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestShouldRecord(t *testing.T) {
+	run := func(cmd *cobra.Command, args []string) {}
+	root := &cobra.Command{Use: "dvb"}
+	node := &cobra.Command{Use: "node"}
+	nodeStart := &cobra.Command{Use: "start", Run: run}
+	nodeList := &cobra.Command{Use: "list", Run: run}
+	provision := &cobra.Command{Use: "provision", Run: run}
+	status := &cobra.Command{Use: "status", Run: run}
+	record := &cobra.Command{Use: "record"}
+	recordStop := &cobra.Command{Use: "stop", Run: run}
+	complete := &cobra.Command{Use: "__complete", Run: run}
+	node.AddCommand(nodeStart, nodeList)
+	record.AddCommand(recordStop)
+	root.AddCommand(node, provision, status, record, complete)
+
+	tests := []struct {
+		cmd  *cobra.Command
+		want bool
+	}{
+		{provision, true},
+		{nodeStart, true},
+		{nodeList, false},
+		{status, false},
+		{recordStop, false},
+		{complete, false},
+		// Group commands only print help
+		{node, false},
+	}
+	for _, tt := range tests {
+		if got := shouldRecord(tt.cmd); got != tt.want {
+			t.Errorf("shouldRecord(%q) = %v, want %v", tt.cmd.CommandPath(), got, tt.want)
+		}
+	}
+}
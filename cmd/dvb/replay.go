@@ -0,0 +1,168 @@
+// cmd/dvb/replay.go
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/dvbrecord"
+	"github.com/altuslabsxyz/devnet-builder/internal/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newReplayCmd() *cobra.Command {
+	var (
+		dryRun          bool
+		from            int
+		continueOnError bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "replay <manifest>",
+		Short: "Replay a recorded sequence of dvb commands",
+		Long: `Replay a manifest recorded with 'dvb record'.
+
+Each recorded command is run in order as a separate dvb invocation, with the
+global connection flags of this invocation (--server, --local, --yes, ...)
+added. If the recording started with a 'dvb use' context, it is selected
+first. Replay stops at the first failing command unless --continue-on-error
+is set; resume from that command with --from.
+
+Examples:
+  # Show what would run
+  dvb replay gov-setup.dvb.yaml --dry-run
+
+  # Replay against a remote daemon, confirming all prompts
+  dvb replay gov-setup.dvb.yaml --server devnetd.example.com:9000 --yes
+
+  # Resume from step 4
+  dvb replay gov-setup.dvb.yaml --from 4`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := dvbrecord.Load(args[0])
+			if err != nil {
+				return err
+			}
+			steps := replaySteps(m)
+			if len(steps) == 0 {
+				return fmt.Errorf("%s has no recorded commands", args[0])
+			}
+			if from < 1 || from > len(steps) {
+				return fmt.Errorf("--from must be between 1 and %d", len(steps))
+			}
+
+			fmt.Printf("Replaying %q: %d command(s)\n", m.Name, len(steps))
+
+			if dryRun {
+				printReplayPlan(os.Stdout, steps, from)
+				return nil
+			}
+
+			dir, err := os.MkdirTemp("", "dvb-replay-")
+			if err != nil {
+				return fmt.Errorf("failed to create temp dir: %w", err)
+			}
+			defer os.RemoveAll(dir)
+
+			self, err := os.Executable()
+			if err != nil {
+				return fmt.Errorf("failed to locate dvb: %w", err)
+			}
+			global := forwardedGlobalArgs()
+
+			failed := 0
+			for i, step := range steps {
+				if i+1 < from {
+					continue
+				}
+
+				stepArgs, err := step.ReplayArgs(dir)
+				if err != nil {
+					return err
+				}
+				fmt.Println()
+				dimColor.Printf("[%d/%d] dvb %s\n", i+1, len(steps), strings.Join(step.Args, " "))
+
+				run := exec.CommandContext(cmd.Context(), self, append(global, stepArgs...)...)
+				run.Stdin, run.Stdout, run.Stderr = os.Stdin, os.Stdout, os.Stderr
+				if err := run.Run(); err != nil {
+					failed++
+					if !continueOnError {
+						return fmt.Errorf("step %d failed: %w\nresume with 'dvb replay %s --from %d'", i+1, err, args[0], i+1)
+					}
+					color.Red("✗ step %d failed: %v", i+1, err)
+				}
+			}
+
+			fmt.Println()
+			if failed > 0 {
+				return fmt.Errorf("%d step(s) failed", failed)
+			}
+			color.Green("✓ Replayed %d command(s)", len(steps)-from+1)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the commands without running them")
+	cmd.Flags().IntVar(&from, "from", 1, "Step to start from (1-based)")
+	cmd.Flags().BoolVar(&continueOnError, "continue-on-error", false, "Keep going after a failing command")
+
+	return cmd
+}
+
+// replaySteps returns the manifest's steps, preceded by a 'use' step for the
+// recorded context.
+func replaySteps(m *dvbrecord.Manifest) []dvbrecord.Step {
+	if m.Context == "" {
+		return m.Steps
+	}
+	return append([]dvbrecord.Step{{Args: []string{"use", m.Context}}}, m.Steps...)
+}
+
+// forwardedGlobalArgs returns the global flags of this invocation to pass on
+// to dvb subprocesses, such as replayed commands. They go before the command
+// so they never end up after a "--".
+func forwardedGlobalArgs() []string {
+	var args []string
+	if flagServer != "" {
+		args = append(args, "--server="+flagServer)
+	}
+	if flagAPIKey != "" {
+		args = append(args, "--api-key="+flagAPIKey)
+	}
+	if flagLocal {
+		args = append(args, "--local")
+	}
+	if standalone {
+		args = append(args, "--standalone")
+	}
+	if flagYes {
+		args = append(args, "--yes")
+	}
+	if flagNonInteractive {
+		args = append(args, "--non-interactive")
+	}
+	if flagLogFormat != "" && flagLogFormat != string(output.LogFormatText) {
+		args = append(args, "--log-format="+flagLogFormat)
+	}
+	return args
+}
+
+// printReplayPlan prints the commands a replay would run, marking the ones
+// skipped by --from.
+func printReplayPlan(out io.Writer, steps []dvbrecord.Step, from int) {
+	for i, step := range steps {
+		marker := " "
+		if i+1 < from {
+			marker = "-"
+		}
+		fmt.Fprintf(out, "%s %3d  dvb %s\n", marker, i+1, strings.Join(step.Args, " "))
+		for path := range step.Files {
+			fmt.Fprintf(out, "         (embeds %s)\n", path)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// cmd/dvb/replay_test.go
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/dvbrecord"
+)
+
+func TestReplaySteps(t *testing.T) {
+	m := &dvbrecord.Manifest{
+		Context: "default/my-devnet",
+		Steps:   []dvbrecord.Step{{Args: []string{"node", "stop", "validator-1"}}},
+	}
+
+	steps := replaySteps(m)
+	if len(steps) != 2 || strings.Join(steps[0].Args, " ") != "use default/my-devnet" {
+		t.Errorf("unexpected steps: %+v", steps)
+	}
+
+	m.Context = ""
+	if steps := replaySteps(m); len(steps) != 1 {
+		t.Errorf("expected no use step without context, got %+v", steps)
+	}
+}
+
+func TestPrintReplayPlan(t *testing.T) {
+	steps := []dvbrecord.Step{
+		{Args: []string{"provision", "-f", "devnet.yaml"}, Files: map[string]string{"devnet.yaml": "x"}},
+		{Args: []string{"node", "stop", "validator-1"}},
+	}
+
+	var buf bytes.Buffer
+	printReplayPlan(&buf, steps, 2)
+
+	want := "-   1  dvb provision -f devnet.yaml\n" +
+		"         (embeds devnet.yaml)\n" +
+		"    2  dvb node stop validator-1\n"
+	if buf.String() != want {
+		t.Errorf("plan =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
@@ -0,0 +1,130 @@
+// cmd/dvb/rolling_restart.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/dvbcontext"
+)
+
+// rolloutClient is the subset of the daemon client used to restart nodes
+// and poll them until they are back.
+type rolloutClient interface {
+	RestartNode(ctx context.Context, namespace, devnetName string, index int) (*v1.Node, error)
+	GetNode(ctx context.Context, namespace, devnetName string, index int) (*v1.Node, error)
+	DiagnoseConsensus(ctx context.Context, req *v1.DiagnoseConsensusRequest) (*v1.DiagnoseConsensusResponse, error)
+}
+
+// rolloutOptions control a rolling restart.
+type rolloutOptions struct {
+	// maxUnavailable is how many nodes are restarted at once. Values below
+	// 1 mean 1.
+	maxUnavailable int
+	// nodeTimeout is how long each node gets to come back.
+	nodeTimeout  time.Duration
+	pollInterval time.Duration
+}
+
+// rollingRestart restarts the nodes at indexes in batches of
+// maxUnavailable, waiting for every node of a batch to be back before
+// restarting the next, so a devnet keeps the voting power it needs to
+// produce blocks.
+func rollingRestart(ctx context.Context, c rolloutClient, namespace, devnetName string, indexes []int, opts rolloutOptions, w io.Writer) error {
+	batchSize := max(opts.maxUnavailable, 1)
+	done := 0
+	for start := 0; start < len(indexes); start += batchSize {
+		batch := indexes[start:min(start+batchSize, len(indexes))]
+
+		restarted := make([]*v1.Node, 0, len(batch))
+		for _, index := range batch {
+			fmt.Fprintf(w, "  [%d/%d] restarting node %d...\n", done+len(restarted)+1, len(indexes), index)
+			node, err := c.RestartNode(ctx, namespace, devnetName, index)
+			if err != nil {
+				return fmt.Errorf("failed to restart node %d: %w", index, err)
+			}
+			restarted = append(restarted, node)
+		}
+
+		for i, index := range batch {
+			nodeCtx, cancel := context.WithTimeout(ctx, opts.nodeTimeout)
+			node, err := waitForNodeBack(nodeCtx, c, namespace, devnetName, index, restarted[i].GetStatus().GetBlockHeight(), opts.pollInterval)
+			cancel()
+			if err != nil {
+				return fmt.Errorf("node %d did not come back: %w", index, err)
+			}
+			done++
+			fmt.Fprintf(w, "  [%d/%d] %s back at height %d\n", done, len(indexes), dvbcontext.NodeName(node), node.Status.BlockHeight)
+		}
+	}
+	return nil
+}
+
+// waitForNodeBack polls a restarted node until it runs, has caught up and
+// reports a block past fromHeight, the height it had when it was restarted.
+// A validator must also have signed the latest block, so it is voting
+// again.
+func waitForNodeBack(ctx context.Context, c rolloutClient, namespace, devnetName string, index int, fromHeight int64, pollInterval time.Duration) (*v1.Node, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	waiting := "to start"
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("still waiting %s: %w", waiting, ctx.Err())
+		case <-ticker.C:
+			node, err := c.GetNode(ctx, namespace, devnetName, index)
+			if err != nil {
+				return nil, err
+			}
+			s := node.GetStatus()
+			switch {
+			case s.GetPhase() != "Running":
+				waiting = "to start"
+				continue
+			case s.GetCatchingUp() || s.GetBlockHeight() <= fromHeight:
+				waiting = "to sync"
+				continue
+			case !strings.EqualFold(node.GetSpec().GetRole(), "validator"):
+				return node, nil
+			}
+
+			waiting = "to vote"
+			report, err := c.DiagnoseConsensus(ctx, &v1.DiagnoseConsensusRequest{DevnetName: devnetName, Namespace: namespace})
+			if err != nil {
+				continue
+			}
+			name := dvbcontext.NodeName(node)
+			for _, v := range report.Validators {
+				if v.Name == name && v.SignedLastBlock {
+					return node, nil
+				}
+			}
+		}
+	}
+}
+
+// haltsChain reports whether taking down n validators at once leaves less
+// than the two thirds of the voting power the chain needs to produce
+// blocks, assuming the most powerful ones go down together.
+func haltsChain(validators []*v1.ConsensusValidator, n int) bool {
+	powers := make([]int64, 0, len(validators))
+	var total int64
+	for _, v := range validators {
+		powers = append(powers, v.VotingPower)
+		total += v.VotingPower
+	}
+	sort.Slice(powers, func(i, j int) bool { return powers[i] > powers[j] })
+
+	var down int64
+	for _, p := range powers[:min(n, len(powers))] {
+		down += p
+	}
+	return total > 0 && 3*(total-down) <= 2*total
+}
@@ -0,0 +1,179 @@
+// cmd/dvb/rolling_restart_test.go
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+// fakeRestarter restarts nodes instantly. A restarted node reports
+// pendingPolls non-running statuses, then runs one block higher. A
+// restarted validator signs blocks again from its second running poll.
+type fakeRestarter struct {
+	roles        map[int]string
+	heights      map[int]int64
+	pendingPolls int
+	maxDown      int
+	polls        map[int]int
+	restarted    []int
+}
+
+func newFakeRestarter(roles map[int]string, pendingPolls, maxDown int) *fakeRestarter {
+	heights := make(map[int]int64, len(roles))
+	for index := range roles {
+		heights[index] = 10
+	}
+	return &fakeRestarter{roles: roles, heights: heights, pendingPolls: pendingPolls, maxDown: maxDown, polls: map[int]int{}}
+}
+
+func (f *fakeRestarter) RestartNode(_ context.Context, _, _ string, index int) (*v1.Node, error) {
+	// At most maxDown nodes may be down or not yet voting at once
+	down := 0
+	for _, prev := range f.restarted {
+		if !f.back(prev) {
+			down++
+		}
+	}
+	if down >= f.maxDown {
+		return nil, errTooManyDown
+	}
+	f.restarted = append(f.restarted, index)
+	return f.node(index, "Pending"), nil
+}
+
+func (f *fakeRestarter) GetNode(_ context.Context, _, _ string, index int) (*v1.Node, error) {
+	f.polls[index]++
+	if f.polls[index] <= f.pendingPolls {
+		return f.node(index, "Starting"), nil
+	}
+	f.heights[index]++
+	return f.node(index, "Running"), nil
+}
+
+func (f *fakeRestarter) DiagnoseConsensus(_ context.Context, _ *v1.DiagnoseConsensusRequest) (*v1.DiagnoseConsensusResponse, error) {
+	resp := &v1.DiagnoseConsensusResponse{}
+	for index, role := range f.roles {
+		if role != "validator" {
+			continue
+		}
+		signed := f.back(index) || f.polls[index] == 0
+		resp.Validators = append(resp.Validators, &v1.ConsensusValidator{
+			Name:            fmt.Sprintf("validator-%d", index),
+			VotingPower:     10,
+			SignedLastBlock: signed,
+		})
+	}
+	return resp, nil
+}
+
+// back reports whether a restarted node is running and, for validators,
+// voting again.
+func (f *fakeRestarter) back(index int) bool {
+	if f.roles[index] == "validator" {
+		return f.polls[index] > f.pendingPolls+1
+	}
+	return f.polls[index] > f.pendingPolls
+}
+
+func (f *fakeRestarter) node(index int, phase string) *v1.Node {
+	return &v1.Node{
+		Metadata: &v1.NodeMetadata{Index: int32(index)},
+		Spec:     &v1.NodeSpec{Role: f.roles[index]},
+		Status:   &v1.NodeStatus{Phase: phase, BlockHeight: f.heights[index]},
+	}
+}
+
+var errTooManyDown = errors.New("restarted while too many nodes were down")
+
+func TestRollingRestart(t *testing.T) {
+	f := newFakeRestarter(map[int]string{0: "validator", 1: "validator", 2: "fullnode"}, 2, 1)
+
+	var out bytes.Buffer
+	opts := rolloutOptions{maxUnavailable: 1, nodeTimeout: time.Second, pollInterval: time.Millisecond}
+	if err := rollingRestart(context.Background(), f, "default", "test", []int{0, 1, 2}, opts, &out); err != nil {
+		t.Fatalf("rollingRestart() error = %v", err)
+	}
+	if len(f.restarted) != 3 {
+		t.Errorf("restarted %v, want all three nodes", f.restarted)
+	}
+	// Validators are back once they vote, a poll after they run
+	if f.polls[0] != 4 || f.polls[2] != 3 {
+		t.Errorf("polls = %v, want 4 for validators and 3 for the full node", f.polls)
+	}
+	if !strings.Contains(out.String(), "[3/3] fullnode-2 back at height 11") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestRollingRestart_MaxUnavailable(t *testing.T) {
+	f := newFakeRestarter(map[int]string{0: "validator", 1: "validator", 2: "validator", 3: "validator"}, 1, 2)
+
+	var out bytes.Buffer
+	opts := rolloutOptions{maxUnavailable: 2, nodeTimeout: time.Second, pollInterval: time.Millisecond}
+	if err := rollingRestart(context.Background(), f, "default", "test", []int{0, 1, 2, 3}, opts, &out); err != nil {
+		t.Fatalf("rollingRestart() error = %v", err)
+	}
+	if len(f.restarted) != 4 {
+		t.Errorf("restarted %v, want all four nodes", f.restarted)
+	}
+
+	// One more than the devnet tolerates fails the rollout
+	f = newFakeRestarter(map[int]string{0: "validator", 1: "validator", 2: "validator"}, 1, 2)
+	opts.maxUnavailable = 3
+	if err := rollingRestart(context.Background(), f, "default", "test", []int{0, 1, 2}, opts, &out); !errors.Is(err, errTooManyDown) {
+		t.Errorf("rollingRestart() error = %v, want %v", err, errTooManyDown)
+	}
+}
+
+func TestRollingRestart_Timeout(t *testing.T) {
+	// The node never leaves Starting
+	f := newFakeRestarter(map[int]string{0: "validator", 1: "validator"}, 1<<30, 1)
+
+	var out bytes.Buffer
+	opts := rolloutOptions{maxUnavailable: 1, nodeTimeout: 20 * time.Millisecond, pollInterval: time.Millisecond}
+	err := rollingRestart(context.Background(), f, "default", "test", []int{0, 1}, opts, &out)
+	if err == nil || !strings.Contains(err.Error(), "node 0 did not come back") || !strings.Contains(err.Error(), "to start") {
+		t.Errorf("rollingRestart() error = %v, want node 0 timing out while starting", err)
+	}
+	if len(f.restarted) != 1 {
+		t.Errorf("restarted %v after a timeout, want only node 0", f.restarted)
+	}
+}
+
+func TestHaltsChain(t *testing.T) {
+	validators := func(powers ...int64) []*v1.ConsensusValidator {
+		var out []*v1.ConsensusValidator
+		for _, p := range powers {
+			out = append(out, &v1.ConsensusValidator{VotingPower: p})
+		}
+		return out
+	}
+	tests := []struct {
+		name       string
+		validators []*v1.ConsensusValidator
+		n          int
+		want       bool
+	}{
+		{"one of four", validators(10, 10, 10, 10), 1, false},
+		{"two of four", validators(10, 10, 10, 10), 2, true},
+		{"one of three", validators(10, 10, 10), 1, true},
+		{"largest goes down", validators(30, 10, 10, 10, 10, 10, 10, 10), 1, false},
+		{"two largest go down", validators(50, 30, 10, 10, 10, 10), 2, true},
+		{"single validator", validators(10), 1, true},
+		{"no validators", nil, 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := haltsChain(tt.validators, tt.n); got != tt.want {
+				t.Errorf("haltsChain(%d) = %v, want %v", tt.n, got, tt.want)
+			}
+		})
+	}
+}
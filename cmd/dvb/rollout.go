@@ -0,0 +1,123 @@
+// cmd/dvb/rollout.go
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newRolloutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollout",
+		Short: "Roll changes through a devnet's nodes",
+	}
+
+	cmd.AddCommand(newRolloutRestartCmd())
+
+	return cmd
+}
+
+func newRolloutRestartCmd() *cobra.Command {
+	var (
+		namespace      string
+		maxUnavailable int
+		timeout        time.Duration
+		force          bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "restart [devnet]",
+		Short: "Restart a devnet's nodes without halting the chain",
+		Long: `Restart the running nodes of a devnet a few at a time.
+
+Stopping every validator at once halts the chain, and it can take a while to
+recover. A rollout restarts --max-unavailable nodes at a time (default 1)
+and waits for each of them to run again, catch up and, for validators, sign
+the latest block before moving on to the next ones. Stopped nodes are left
+alone.
+
+A --max-unavailable that takes down a third or more of the voting power
+would halt the chain, so it is refused unless --force is given.
+
+Examples:
+  # Restart the current context devnet one node at a time
+  dvb rollout restart
+
+  # Restart two nodes at a time
+  dvb rollout restart my-devnet --max-unavailable 2`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return validation.AtLeast("max-unavailable", maxUnavailable, 1)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+
+			nodes, err := daemonClient.ListNodes(cmd.Context(), ns, devnetName)
+			if err != nil {
+				return err
+			}
+			indexes := runningNodeIndexes(nodes)
+			if len(indexes) == 0 {
+				return fmt.Errorf("devnet %q has no running nodes to restart", devnetName)
+			}
+
+			report, err := daemonClient.DiagnoseConsensus(cmd.Context(), &v1.DiagnoseConsensusRequest{DevnetName: devnetName, Namespace: ns})
+			if err != nil {
+				return err
+			}
+			if haltsChain(report.Validators, maxUnavailable) {
+				if maxUnavailable > 1 && !force {
+					return fmt.Errorf("restarting %d validators at once would halt the chain; lower --max-unavailable or pass --force", maxUnavailable)
+				}
+				color.Yellow("The chain pauses while each validator restarts: it has too few validators to lose one")
+			}
+
+			fmt.Printf("Restarting %d node(s) of %q, %d at a time...\n", len(indexes), devnetName, maxUnavailable)
+			opts := rolloutOptions{maxUnavailable: maxUnavailable, nodeTimeout: timeout, pollInterval: 2 * time.Second}
+			if err := rollingRestart(cmd.Context(), daemonClient, ns, devnetName, indexes, opts, os.Stdout); err != nil {
+				return err
+			}
+			color.Green("✓ Rollout of %q complete", devnetName)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().IntVar(&maxUnavailable, "max-unavailable", 1, "How many nodes to restart at once")
+	cmd.Flags().DurationVar(&timeout, "timeout", 5*time.Minute, "How long to wait for each node to sync and vote after its restart")
+	cmd.Flags().BoolVar(&force, "force", false, "Restart even if --max-unavailable takes down enough voting power to halt the chain")
+
+	return cmd
+}
+
+// runningNodeIndexes returns the indexes of the running nodes, in order.
+func runningNodeIndexes(nodes []*v1.Node) []int {
+	var out []int
+	for _, n := range nodes {
+		if n.GetStatus().GetPhase() == "Running" {
+			out = append(out, int(n.GetMetadata().GetIndex()))
+		}
+	}
+	return out
+}
@@ -0,0 +1,101 @@
+// cmd/dvb/set.go
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Change settings of a running devnet",
+	}
+
+	cmd.AddCommand(newSetBlockTimeCmd())
+
+	return cmd
+}
+
+func newSetBlockTimeCmd() *cobra.Command {
+	var (
+		namespace string
+		noRestart bool
+		timeout   time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "block-time <duration> [devnet]",
+		Short: "Change the block time of a devnet",
+		Long: `Change the block time of a devnet.
+
+The block time is the consensus timeout_commit of every node. It is recorded
+in the devnet spec (spec.blockTime) and written to every node's config.toml.
+Running nodes are then restarted one at a time, each waiting for the last to
+be back, synced and voting, so the chain keeps the voting power it needs to
+make progress (see 'dvb rollout restart'). Stopped nodes use the new block time when they start.
+
+Examples:
+  # Run the current context devnet at two blocks per second
+  dvb set block-time 500ms
+
+  # Update the configs now and restart the nodes later
+  dvb set block-time 2s my-devnet --no-restart`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeDevnetNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 1 {
+				explicitDevnet = args[1]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+
+			resp, err := daemonClient.SetBlockTime(cmd.Context(), ns, devnetName, args[0])
+			if err != nil {
+				return err
+			}
+			color.Green("✓ Block time of %q set to %s", devnetName, args[0])
+
+			indexes := make([]int, 0, len(resp.RestartNodes))
+			for _, i := range resp.RestartNodes {
+				indexes = append(indexes, int(i))
+			}
+			switch {
+			case len(indexes) == 0:
+				fmt.Println("  No running node needs a restart")
+				return nil
+			case noRestart:
+				color.Yellow("  %d running node(s) use the new block time after a restart", len(indexes))
+				return nil
+			}
+
+			fmt.Printf("Restarting %d node(s) one at a time...\n", len(indexes))
+			opts := rolloutOptions{maxUnavailable: 1, nodeTimeout: timeout, pollInterval: 2 * time.Second}
+			if err := rollingRestart(cmd.Context(), daemonClient, ns, devnetName, indexes, opts, os.Stdout); err != nil {
+				return err
+			}
+			color.Green("✓ All nodes running with the new block time")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().BoolVar(&noRestart, "no-restart", false, "Update the node configs without restarting running nodes")
+	cmd.Flags().DurationVar(&timeout, "timeout", 5*time.Minute, "How long to wait for each node to come back after its restart")
+
+	return cmd
+}
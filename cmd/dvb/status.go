@@ -2,8 +2,10 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -52,7 +54,8 @@ Examples:
   # Set context first, then show status
   dvb use my-devnet
   dvb status`,
-		Args: cobra.MaximumNArgs(1),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var explicitDevnet string
 			if len(args) > 0 {
@@ -117,7 +120,7 @@ func printStatusDaemonNotRunning(ctx *dvbcontext.Context) error {
 	color.Yellow("Daemon: Not running")
 	fmt.Println()
 	fmt.Println("Start the daemon with:")
-	fmt.Println("  devnetd &")
+	fmt.Println("  dvb daemon start")
 	fmt.Println()
 	fmt.Println("Or run in foreground:")
 	fmt.Println("  devnetd")
@@ -258,6 +261,17 @@ func printVerboseStatus(cmd *cobra.Command, devnet *v1.Devnet, nodes []*v1.Node)
 	if devnet.Spec.FullNodes > 0 {
 		fmt.Printf("Full Nodes:   %d\n", devnet.Spec.FullNodes)
 	}
+	if layout := formatPortLayout(devnet.Spec.Ports); layout != "" {
+		fmt.Printf("Ports:        %s\n", layout)
+	}
+	if devnet.Spec.BindAddress != "" {
+		fmt.Printf("Bind Address: %s\n", devnet.Spec.BindAddress)
+	}
+	if devnet.Status.ImageDigest != "" {
+		fmt.Printf("Image:        %s\n", devnet.Status.Image)
+		fmt.Printf("Digest:       %s\n", devnet.Status.ImageDigest)
+		printImageDrift(cmd, devnet)
+	}
 
 	// Status section
 	fmt.Printf("\nStatus:\n")
@@ -274,20 +288,16 @@ func printVerboseStatus(cmd *cobra.Command, devnet *v1.Devnet, nodes []*v1.Node)
 	if devnet.Status.Message != "" {
 		fmt.Printf("  Message:      %s\n", devnet.Status.Message)
 	}
+	if groups := nodeVersionGroups(nodes); len(groups) > 1 {
+		fmt.Printf("  Versions:     %s\n", color.YellowString("mixed"))
+		for _, g := range groups {
+			fmt.Printf("    %-12s %s\n", g.version, strings.Join(g.nodes, ", "))
+		}
+	}
 
 	// Conditions section
 	if len(devnet.Status.Conditions) > 0 {
-		fmt.Printf("\nConditions:\n")
-		fmt.Printf("  %-20s %-8s %-25s %s\n", "TYPE", "STATUS", "REASON", "MESSAGE")
-		for _, c := range devnet.Status.Conditions {
-			status := c.Status
-			if c.Status == "True" {
-				status = color.GreenString("True")
-			} else if c.Status == "False" {
-				status = color.RedString("False")
-			}
-			fmt.Printf("  %-20s %-8s %-25s %s\n", c.Type, status, c.Reason, c.Message)
-		}
+		printConditions(devnet.Status.Conditions, false)
 	}
 
 	// Nodes section
@@ -298,13 +308,18 @@ func printVerboseStatus(cmd *cobra.Command, devnet *v1.Devnet, nodes []*v1.Node)
 	// Endpoints section
 	if len(nodes) > 0 && nodes[0].Spec != nil && nodes[0].Spec.Address != "" {
 		firstNodeAddr := nodes[0].Spec.Address
+		ports := nodePorts(nodes[0])
 		fmt.Printf("\nEndpoints:\n")
-		fmt.Printf("  RPC:  http://%s:26657\n", firstNodeAddr)
-		fmt.Printf("  REST: http://%s:1317\n", firstNodeAddr)
-		fmt.Printf("  gRPC: %s:9090\n", firstNodeAddr)
+		fmt.Printf("  RPC:  http://%s\n", hostPort(firstNodeAddr, ports.RPC))
+		fmt.Printf("  REST: http://%s\n", hostPort(firstNodeAddr, ports.API))
+		fmt.Printf("  gRPC: %s\n", hostPort(firstNodeAddr, ports.GRPC))
+		if servesEVM(nodes[0]) {
+			fmt.Printf("  EVM JSON-RPC:  http://%s\n", hostPort(firstNodeAddr, ports.EVMRPC))
+			fmt.Printf("  EVM WebSocket: ws://%s\n", hostPort(firstNodeAddr, ports.EVMWS))
+		}
 
 		fmt.Printf("\nConnect with CLI:\n")
-		fmt.Printf("  %s status --node tcp://%s:26657\n", getBinaryNameFromPlugin(devnet.Spec.Plugin), firstNodeAddr)
+		fmt.Printf("  %s status --node tcp://%s\n", getBinaryNameFromPlugin(devnet.Spec.Plugin), hostPort(firstNodeAddr, ports.RPC))
 	}
 
 	// Events section
@@ -321,6 +336,64 @@ func printVerboseStatus(cmd *cobra.Command, devnet *v1.Devnet, nodes []*v1.Node)
 	return nil
 }
 
+// imageCheckTimeout bounds the registry lookup of printImageDrift, so an
+// unreachable registry does not hold up the status.
+const imageCheckTimeout = 5 * time.Second
+
+// printImageDrift warns when the tag a devnet's image was pinned from now
+// points to another digest. Lookups that fail, e.g. offline or for images
+// that were never pushed, print nothing.
+func printImageDrift(cmd *cobra.Command, devnet *v1.Devnet) {
+	if daemonClient == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(cmd.Context(), imageCheckTimeout)
+	defer cancel()
+	resp, err := daemonClient.CheckImage(ctx, &v1.CheckImageRequest{
+		DevnetName: devnet.Metadata.Name,
+		Namespace:  devnet.Metadata.Namespace,
+	})
+	if err != nil || !resp.Moved {
+		return
+	}
+	color.Yellow("  ⚠ %s has moved upstream to %s; nodes keep running the pinned digest", resp.Image, resp.UpstreamDigest)
+}
+
+// versionGroup is the nodes running one binary version.
+type versionGroup struct {
+	version string
+	nodes   []string
+}
+
+// nodeVersionGroups groups the nodes by the version they run, sorted by
+// version. More than one group means the devnet runs mixed versions. Nodes
+// that do not report a version are left out.
+func nodeVersionGroups(nodes []*v1.Node) []versionGroup {
+	byVersion := make(map[string][]string)
+	for _, n := range nodes {
+		if v := n.GetStatus().GetVersion(); v != "" {
+			byVersion[v] = append(byVersion[v], dvbcontext.NodeName(n))
+		}
+	}
+	groups := make([]versionGroup, 0, len(byVersion))
+	for v, names := range byVersion {
+		groups = append(groups, versionGroup{version: v, nodes: names})
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].version < groups[j].version })
+	return groups
+}
+
+// servesEVM reports whether the daemon probes the node's EVM JSON-RPC,
+// which it does for the nodes of EVM networks that serve it.
+func servesEVM(node *v1.Node) bool {
+	for _, c := range node.GetStatus().GetConditions() {
+		if c.GetType() == "EVMReady" {
+			return true
+		}
+	}
+	return false
+}
+
 // printVerboseNodes prints detailed node table
 func printVerboseNodes(nodes []*v1.Node) {
 	hasAddresses := false
@@ -356,7 +429,7 @@ func printVerboseNodes(nodes []*v1.Node) {
 			}
 			rpc := "-"
 			if addr != "-" {
-				rpc = fmt.Sprintf("%s:26657", addr)
+				rpc = hostPort(addr, nodePorts(n).RPC)
 			}
 			fmt.Printf("  %-6d %-10s %-14s %-18s %-10d\n",
 				n.Metadata.Index,
@@ -434,9 +507,9 @@ func printTroubleshooting(cmd *cobra.Command, devnet *v1.Devnet) {
 		fmt.Println("    The network plugin is not registered with the daemon.")
 		fmt.Println()
 		fmt.Println("    To fix this:")
-		fmt.Printf("      1. Install the plugin: dvb daemon plugins install %s\n", devnet.Spec.Plugin)
-		fmt.Println("      2. Restart the daemon: pkill devnetd && devnetd &")
-		fmt.Println("      3. Delete and recreate the devnet")
+		fmt.Printf("      1. Install the plugin providing '%s': dvb plugins install <owner>/<repo>[@version]\n", devnet.Spec.Plugin)
+		fmt.Println("         (the daemon loads it immediately; no restart needed)")
+		fmt.Println("      2. Delete and recreate the devnet")
 		fmt.Println()
 		if len(registeredNetworks) > 0 {
 			names := make([]string, len(registeredNetworks))
@@ -556,7 +629,7 @@ func printNodesSummary(nodes []*v1.Node) {
 			}
 			rpc := "-"
 			if addr != "-" {
-				rpc = fmt.Sprintf("%s:26657", addr)
+				rpc = hostPort(addr, nodePorts(n).RPC)
 			}
 			fmt.Fprintf(w, "  %d\t%s\t%s\t%s\t%s\n",
 				n.Metadata.Index,
@@ -619,6 +692,46 @@ func formatPhase(phase string) string {
 	}
 }
 
+// printConditions prints a Conditions section, coloring each status by
+// whether it is good. withAge adds how long each condition has had its
+// status.
+func printConditions(conditions []*v1.Condition, withAge bool) {
+	fmt.Printf("\nConditions:\n")
+	if withAge {
+		fmt.Printf("  %-20s %-8s %-25s %-8s %s\n", "TYPE", "STATUS", "REASON", "SINCE", "MESSAGE")
+	} else {
+		fmt.Printf("  %-20s %-8s %-25s %s\n", "TYPE", "STATUS", "REASON", "MESSAGE")
+	}
+	for _, c := range conditions {
+		status := c.Status
+		good, bad := "True", "False"
+		if badWhenTrue[c.Type] {
+			good, bad = bad, good
+		}
+		switch c.Status {
+		case good:
+			status = color.GreenString("%-8s", c.Status)
+		case bad:
+			status = color.RedString("%-8s", c.Status)
+		}
+		if withAge {
+			since := "-"
+			if c.LastTransitionTime != nil && !c.LastTransitionTime.AsTime().IsZero() {
+				since = formatAge(time.Since(c.LastTransitionTime.AsTime()))
+			}
+			fmt.Printf("  %-20s %-8s %-25s %-8s %s\n", c.Type, status, c.Reason, since, c.Message)
+		} else {
+			fmt.Printf("  %-20s %-8s %-25s %s\n", c.Type, status, c.Reason, c.Message)
+		}
+	}
+}
+
+// badWhenTrue lists the condition types for which True is the bad state.
+var badWhenTrue = map[string]bool{
+	"Degraded":     true,
+	"DiskPressure": true,
+}
+
 // formatAge formats a duration into a human-readable age string
 func formatAge(d time.Duration) string {
 	if d < time.Minute {
@@ -642,3 +755,21 @@ func formatAge(d time.Duration) string {
 	}
 	return fmt.Sprintf("%dd", days)
 }
+
+// formatPortLayout renders a custom port layout in the --ports flag syntax,
+// or "" for the default layout.
+func formatPortLayout(p *v1.PortLayout) string {
+	var parts []string
+	for _, field := range []struct {
+		name string
+		port int32
+	}{
+		{"p2p", p.GetP2P()}, {"rpc", p.GetRpc()}, {"rest", p.GetRest()},
+		{"grpc", p.GetGrpc()}, {"evm", p.GetEvmRpc()}, {"stride", p.GetStride()},
+	} {
+		if field.port != 0 {
+			parts = append(parts, fmt.Sprintf("%s=%d", field.name, field.port))
+		}
+	}
+	return strings.Join(parts, ",")
+}
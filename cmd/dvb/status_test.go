@@ -0,0 +1,36 @@
+// cmd/dvb/status_test.go
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+func TestNodeVersionGroups(t *testing.T) {
+	node := func(role string, index int32, version string) *v1.Node {
+		return &v1.Node{
+			Metadata: &v1.NodeMetadata{Index: index},
+			Spec:     &v1.NodeSpec{Role: role},
+			Status:   &v1.NodeStatus{Version: version},
+		}
+	}
+	nodes := []*v1.Node{
+		node("validator", 0, "v22.0.0"),
+		node("validator", 1, "v23.0.0"),
+		node("validator", 2, "v22.0.0"),
+		node("fullnode", 3, ""),
+	}
+
+	want := []versionGroup{
+		{version: "v22.0.0", nodes: []string{"validator-0", "validator-2"}},
+		{version: "v23.0.0", nodes: []string{"validator-1"}},
+	}
+	if got := nodeVersionGroups(nodes); !reflect.DeepEqual(got, want) {
+		t.Errorf("nodeVersionGroups() = %+v, want %+v", got, want)
+	}
+	if got := nodeVersionGroups(nodes[:1]); len(got) != 1 {
+		t.Errorf("nodeVersionGroups() of one version = %+v", got)
+	}
+}
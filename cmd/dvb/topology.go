@@ -0,0 +1,351 @@
+// cmd/dvb/topology.go
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/config"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// Topology output formats.
+const (
+	topologyFormatDOT  = "dot"
+	topologyFormatJSON = "json"
+)
+
+// topologyEdgePeer is the kind of edge between two peered nodes. Edges from
+// project containers take the container kind.
+const topologyEdgePeer = "peer"
+
+// exportTopologyOptions holds options for the export topology command
+type exportTopologyOptions struct {
+	output    string
+	format    string
+	project   string
+	namespace string
+}
+
+// topology is the graph of a devnet's nodes, peer links, ports and the
+// project containers attached to it. It holds only what provisioning
+// decides, never runtime state, so the same devnet always renders the same
+// artifact.
+type topology struct {
+	Devnet     string              `json:"devnet"`
+	Namespace  string              `json:"namespace"`
+	ChainID    string              `json:"chainId,omitempty"`
+	Plugin     string              `json:"plugin,omitempty"`
+	Mode       string              `json:"mode,omitempty"`
+	Nodes      []topologyNode      `json:"nodes"`
+	Containers []topologyContainer `json:"containers,omitempty"`
+	Edges      []topologyEdge      `json:"edges"`
+}
+
+// topologyNode is a devnet node and the ports it listens on.
+type topologyNode struct {
+	ID    string         `json:"id"`
+	Index int            `json:"index"`
+	Role  string         `json:"role"`
+	Host  string         `json:"host"`
+	Ports []topologyPort `json:"ports"`
+}
+
+// topologyPort is a named port of a node.
+type topologyPort struct {
+	Name string `json:"name"`
+	Port int    `json:"port"`
+}
+
+// topologyContainer is a relayer or sidecar of a project.
+type topologyContainer struct {
+	ID    string `json:"id"`
+	Kind  string `json:"kind"`
+	Image string `json:"image"`
+}
+
+// topologyEdge links two graph vertices. Peer edges are undirected and
+// listed once per pair with the lower index as From.
+type topologyEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Kind string `json:"kind"`
+}
+
+func newExportTopologyCmd() *cobra.Command {
+	opts := &exportTopologyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "topology [devnet]",
+		Short: "Export the devnet topology as a DOT or JSON graph",
+		Long: `Export the node, peer and port topology of a devnet as a graph artifact,
+for rendering in docs or asserting in CI that provisioning produced the
+intended architecture.
+
+Every node is a vertex carrying its role, host and p2p/rpc/rest/grpc ports.
+Nodes are fully meshed through persistent peers, so each pair of nodes is
+joined by one peer edge. With --project, the relayers that connect the devnet
+and the sidecars that depend on it are added, linked to node 0.
+
+The output is deterministic: vertices and edges are sorted and no runtime
+state (phase, PIDs, heights) is included. The format is taken from the
+--output extension (.dot or .json) unless --format is set.
+
+Examples:
+  # Print the topology as DOT
+  dvb export topology my-devnet
+
+  # Write a JSON artifact for CI
+  dvb export topology my-devnet -o topology.json
+
+  # Render with Graphviz, including project sidecars
+  dvb export topology my-devnet --project project.yaml -o topology.dot
+  dot -Tsvg topology.dot > topology.svg`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := topologyFormat(opts.format, opts.output)
+			if err != nil {
+				return err
+			}
+
+			var project *config.YAMLProject
+			if opts.project != "" {
+				if project, err = config.LoadProject(opts.project); err != nil {
+					return err
+				}
+			}
+
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, opts.namespace)
+			if err != nil {
+				return err
+			}
+
+			devnet, err := daemonClient.GetDevnet(cmd.Context(), ns, devnetName)
+			if err != nil {
+				return err
+			}
+
+			nodes, err := daemonClient.ListNodes(cmd.Context(), ns, devnetName)
+			if err != nil {
+				return fmt.Errorf("failed to list nodes: %w", err)
+			}
+
+			topo := buildTopology(devnet, nodes, project)
+
+			if opts.output == "" {
+				return renderTopology(cmd.OutOrStdout(), format, topo)
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+			if err := writeTopology(opts.output, format, topo); err != nil {
+				return err
+			}
+			color.Green("✓ Wrote %s topology (%d nodes, %d edges) to %s", format, len(topo.Nodes), len(topo.Edges), opts.output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "", "File to write the topology to (default: print)")
+	cmd.Flags().StringVar(&opts.format, "format", "", "Output format: dot, json (default: from --output extension, else dot)")
+	cmd.Flags().StringVar(&opts.project, "project", "", "Project manifest whose relayers and sidecars to include")
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", "Namespace (defaults to context or server default)")
+
+	return cmd
+}
+
+// topologyFormat resolves the output format from the explicit flag or the
+// output file extension, defaulting to DOT.
+func topologyFormat(format, output string) (string, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(output)) {
+		case ".json":
+			format = topologyFormatJSON
+		case "", ".dot", ".gv":
+			format = topologyFormatDOT
+		default:
+			return "", fmt.Errorf("cannot infer format from %q (use --format dot or json)", output)
+		}
+	}
+	if format != topologyFormatDOT && format != topologyFormatJSON {
+		return "", fmt.Errorf("unsupported format %q (use dot or json)", format)
+	}
+	return format, nil
+}
+
+// buildTopology builds the topology of devnet from its nodes and, if
+// project is set, the relayers and sidecars attached to it.
+func buildTopology(devnet *v1.Devnet, nodes []*v1.Node, project *config.YAMLProject) *topology {
+	topo := &topology{
+		Devnet:    devnet.GetMetadata().GetName(),
+		Namespace: devnet.GetMetadata().GetNamespace(),
+		ChainID:   devnet.GetSpec().GetChainId(),
+		Plugin:    devnet.GetSpec().GetPlugin(),
+		Mode:      devnet.GetSpec().GetMode(),
+		Nodes:     []topologyNode{},
+		Edges:     []topologyEdge{},
+	}
+
+	sorted := append([]*v1.Node(nil), nodes...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].GetMetadata().GetIndex() < sorted[j].GetMetadata().GetIndex()
+	})
+
+	for _, n := range sorted {
+		topo.Nodes = append(topo.Nodes, topologyNodeFor(n))
+	}
+
+	for i := range topo.Nodes {
+		for j := i + 1; j < len(topo.Nodes); j++ {
+			topo.Edges = append(topo.Edges, topologyEdge{
+				From: topo.Nodes[i].ID,
+				To:   topo.Nodes[j].ID,
+				Kind: topologyEdgePeer,
+			})
+		}
+	}
+
+	if project != nil && len(topo.Nodes) > 0 {
+		addProjectContainers(topo, project)
+	}
+	return topo
+}
+
+// topologyNodeFor returns the vertex of a node.
+func topologyNodeFor(n *v1.Node) topologyNode {
+	index := int(n.GetMetadata().GetIndex())
+	ports := nodePorts(n)
+
+	return topologyNode{
+		ID:    fmt.Sprintf("node%d", index),
+		Index: index,
+		Role:  n.GetSpec().GetRole(),
+		Host:  nodeHost(n),
+		Ports: []topologyPort{
+			{Name: "p2p", Port: ports.P2P},
+			{Name: "rpc", Port: ports.RPC},
+			{Name: "rest", Port: ports.API},
+			{Name: "grpc", Port: ports.GRPC},
+		},
+	}
+}
+
+// addProjectContainers adds the project's relayers connecting the devnet and
+// sidecars depending on it, each linked to the first node.
+func addProjectContainers(topo *topology, project *config.YAMLProject) {
+	add := func(kind string, c config.YAMLProjectContainer) {
+		topo.Containers = append(topo.Containers, topologyContainer{ID: kind + ":" + c.Name, Kind: kind, Image: c.Image})
+	}
+
+	for _, r := range project.Spec.Relayers {
+		if slices.Contains(r.Chains, topo.Devnet) {
+			add(config.UnitRelayer, r)
+		}
+	}
+	for _, s := range project.Spec.Sidecars {
+		if slices.Contains(s.DependsOn, topo.Devnet) {
+			add(config.UnitSidecar, s)
+		}
+	}
+
+	sort.Slice(topo.Containers, func(i, j int) bool {
+		return topo.Containers[i].ID < topo.Containers[j].ID
+	})
+	for _, c := range topo.Containers {
+		topo.Edges = append(topo.Edges, topologyEdge{From: c.ID, To: topo.Nodes[0].ID, Kind: c.Kind})
+	}
+}
+
+// renderTopology writes topo to w in the given format.
+func renderTopology(w io.Writer, format string, topo *topology) error {
+	if format == topologyFormatJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(topo)
+	}
+	return renderTopologyDOT(w, topo)
+}
+
+// renderTopologyDOT writes topo as a Graphviz graph with the devnet nodes
+// in one cluster.
+func renderTopologyDOT(w io.Writer, topo *topology) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "graph %s {\n", dotQuote(topo.Devnet))
+	b.WriteString("  node [shape=record];\n")
+	fmt.Fprintf(&b, "  subgraph %s {\n", dotQuote("cluster_"+topo.Devnet))
+
+	label := topo.Devnet
+	if topo.ChainID != "" {
+		label += " (" + topo.ChainID + ")"
+	}
+	fmt.Fprintf(&b, "    label=%s;\n", dotQuote(label))
+
+	for _, n := range topo.Nodes {
+		ports := make([]string, len(n.Ports))
+		for i, p := range n.Ports {
+			ports[i] = fmt.Sprintf("%s %d", p.Name, p.Port)
+		}
+		record := fmt.Sprintf("{%s|%s|%s|%s}", n.ID, n.Role, n.Host, strings.Join(ports, "\\l")+"\\l")
+		fmt.Fprintf(&b, "    %s [label=%s];\n", dotQuote(n.ID), dotQuote(record))
+	}
+	b.WriteString("  }\n")
+
+	for _, c := range topo.Containers {
+		record := fmt.Sprintf("{%s|%s}", c.ID, c.Image)
+		fmt.Fprintf(&b, "  %s [shape=record, style=dashed, label=%s];\n", dotQuote(c.ID), dotQuote(record))
+	}
+
+	for _, e := range topo.Edges {
+		attrs := ""
+		if e.Kind != topologyEdgePeer {
+			attrs = fmt.Sprintf(" [style=dashed, label=%s]", dotQuote(e.Kind))
+		}
+		fmt.Fprintf(&b, "  %s -- %s%s;\n", dotQuote(e.From), dotQuote(e.To), attrs)
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// dotQuote quotes s as a DOT string ID.
+func dotQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+// writeTopology renders topo into the file at path.
+func writeTopology(path, format string, topo *topology) error {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	if err := renderTopology(f, format, topo); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,166 @@
+// cmd/dvb/topology_test.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/config"
+)
+
+func testTopologyDevnet() (*v1.Devnet, []*v1.Node) {
+	devnet := &v1.Devnet{
+		Metadata: &v1.DevnetMetadata{Name: "my-devnet", Namespace: "default"},
+		Spec:     &v1.DevnetSpec{Plugin: "stable", ChainId: "stable-1", Mode: "local"},
+	}
+	// Out of order, to check that output is sorted by index.
+	nodes := []*v1.Node{
+		{Metadata: &v1.NodeMetadata{Index: 2}, Spec: &v1.NodeSpec{Role: "fullnode", Address: "127.0.42.3"}},
+		{Metadata: &v1.NodeMetadata{Index: 0}, Spec: &v1.NodeSpec{Role: "validator", Address: "127.0.42.1"}},
+		{Metadata: &v1.NodeMetadata{Index: 1}, Spec: &v1.NodeSpec{Role: "validator", Address: "127.0.42.2"}},
+	}
+	return devnet, nodes
+}
+
+func TestBuildTopology(t *testing.T) {
+	devnet, nodes := testTopologyDevnet()
+	topo := buildTopology(devnet, nodes, nil)
+
+	if len(topo.Nodes) != 3 {
+		t.Fatalf("expected 3 nodes, got %d", len(topo.Nodes))
+	}
+	for i, n := range topo.Nodes {
+		if n.Index != i {
+			t.Errorf("node %d has index %d", i, n.Index)
+		}
+	}
+	if topo.Nodes[2].Role != "fullnode" || topo.Nodes[2].Host != "127.0.42.3" {
+		t.Errorf("unexpected node 2: %+v", topo.Nodes[2])
+	}
+	if topo.Nodes[1].Ports[1] != (topologyPort{Name: "rpc", Port: 26657}) {
+		t.Errorf("unexpected rpc port: %+v", topo.Nodes[1].Ports[1])
+	}
+
+	want := []topologyEdge{
+		{From: "node0", To: "node1", Kind: topologyEdgePeer},
+		{From: "node0", To: "node2", Kind: topologyEdgePeer},
+		{From: "node1", To: "node2", Kind: topologyEdgePeer},
+	}
+	if len(topo.Edges) != len(want) {
+		t.Fatalf("expected %d edges, got %v", len(want), topo.Edges)
+	}
+	for i := range want {
+		if topo.Edges[i] != want[i] {
+			t.Errorf("edge %d = %+v, want %+v", i, topo.Edges[i], want[i])
+		}
+	}
+}
+
+func TestBuildTopology_PortOffset(t *testing.T) {
+	topo := buildTopology(&v1.Devnet{}, []*v1.Node{
+		{Metadata: &v1.NodeMetadata{Index: 1}, Spec: &v1.NodeSpec{Role: "validator"}},
+	}, nil)
+
+	n := topo.Nodes[0]
+	if n.Host != "127.0.0.1" || n.Ports[0] != (topologyPort{Name: "p2p", Port: 26756}) {
+		t.Errorf("unexpected node: %+v", n)
+	}
+}
+
+func TestBuildTopology_ProjectContainers(t *testing.T) {
+	devnet, nodes := testTopologyDevnet()
+	project := &config.YAMLProject{Spec: config.YAMLProjectSpec{
+		Relayers: []config.YAMLProjectContainer{
+			{Name: "hermes", Image: "hermes:1.10", Chains: []string{"other", "my-devnet"}},
+			{Name: "unrelated", Image: "hermes:1.10", Chains: []string{"a", "b"}},
+		},
+		Sidecars: []config.YAMLProjectContainer{
+			{Name: "indexer", Image: "indexer:latest", DependsOn: []string{"my-devnet"}},
+			{Name: "explorer", Image: "explorer:latest"},
+		},
+	}}
+
+	topo := buildTopology(devnet, nodes, project)
+
+	if len(topo.Containers) != 2 {
+		t.Fatalf("expected 2 containers, got %v", topo.Containers)
+	}
+	if topo.Containers[0].ID != "relayer:hermes" || topo.Containers[1].ID != "sidecar:indexer" {
+		t.Errorf("unexpected containers: %v", topo.Containers)
+	}
+	last := topo.Edges[len(topo.Edges)-1]
+	if last != (topologyEdge{From: "sidecar:indexer", To: "node0", Kind: config.UnitSidecar}) {
+		t.Errorf("unexpected sidecar edge: %+v", last)
+	}
+}
+
+func TestRenderTopology(t *testing.T) {
+	devnet, nodes := testTopologyDevnet()
+	project := &config.YAMLProject{Spec: config.YAMLProjectSpec{
+		Sidecars: []config.YAMLProjectContainer{
+			{Name: "indexer", Image: "indexer:latest", DependsOn: []string{"my-devnet"}},
+		},
+	}}
+	topo := buildTopology(devnet, nodes, project)
+
+	var dot bytes.Buffer
+	if err := renderTopology(&dot, topologyFormatDOT, topo); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`graph "my-devnet" {`,
+		`label="my-devnet (stable-1)";`,
+		`"node0" [label="{node0|validator|127.0.42.1|p2p 26656\lrpc 26657\lrest 1317\lgrpc 9090\l}"];`,
+		`"node0" -- "node1";`,
+		`"sidecar:indexer" -- "node0" [style=dashed, label="sidecar"];`,
+	} {
+		if !strings.Contains(dot.String(), want) {
+			t.Errorf("DOT missing %q:\n%s", want, dot.String())
+		}
+	}
+
+	// Rendering is deterministic.
+	var again bytes.Buffer
+	if err := renderTopology(&again, topologyFormatDOT, buildTopology(devnet, nodes, project)); err != nil {
+		t.Fatal(err)
+	}
+	if again.String() != dot.String() {
+		t.Error("DOT output is not deterministic")
+	}
+
+	var out bytes.Buffer
+	if err := renderTopology(&out, topologyFormatJSON, topo); err != nil {
+		t.Fatal(err)
+	}
+	var decoded topology
+	if err := json.Unmarshal(out.Bytes(), &decoded); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if decoded.ChainID != "stable-1" || len(decoded.Nodes) != 3 || len(decoded.Edges) != 4 {
+		t.Errorf("unexpected JSON topology: %+v", decoded)
+	}
+}
+
+func TestTopologyFormat(t *testing.T) {
+	tests := []struct {
+		format, output string
+		want           string
+		wantErr        bool
+	}{
+		{"", "", topologyFormatDOT, false},
+		{"", "topology.dot", topologyFormatDOT, false},
+		{"", "out/topology.JSON", topologyFormatJSON, false},
+		{"json", "topology.txt", topologyFormatJSON, false},
+		{"", "topology.txt", "", true},
+		{"svg", "", "", true},
+	}
+	for _, tt := range tests {
+		got, err := topologyFormat(tt.format, tt.output)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("topologyFormat(%q, %q) = %q, %v", tt.format, tt.output, got, err)
+		}
+	}
+}
@@ -24,6 +24,7 @@ func newTxCmd() *cobra.Command {
 		newTxListCmd(),
 		newTxStatusCmd(),
 		newTxCancelCmd(),
+		newTxTraceCmd(),
 	)
 
 	return cmd
@@ -39,9 +40,10 @@ func newTxSubmitCmd() *cobra.Command {
 	)
 
 	cmd := &cobra.Command{
-		Use:   "submit [devnet]",
-		Short: "Submit a transaction",
-		Args:  cobra.MaximumNArgs(1),
+		Use:               "submit [devnet]",
+		Short:             "Submit a transaction",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := requireDaemon(); err != nil {
 				return err
@@ -107,10 +109,11 @@ func newTxListCmd() *cobra.Command {
 	)
 
 	cmd := &cobra.Command{
-		Use:     "list [devnet]",
-		Short:   "List transactions for a devnet",
-		Aliases: []string{"ls"},
-		Args:    cobra.MaximumNArgs(1),
+		Use:               "list [devnet]",
+		Short:             "List transactions for a devnet",
+		Aliases:           []string{"ls"},
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := requireDaemon(); err != nil {
 				return err
@@ -228,6 +231,8 @@ func newGovCmd() *cobra.Command {
 	cmd.AddCommand(
 		newGovVoteCmd(),
 		newGovProposeCmd(),
+		newGovSubmitCmd(),
+		newGovSetParamCmd(),
 	)
 
 	return cmd
@@ -243,9 +248,10 @@ func newGovVoteCmd() *cobra.Command {
 	)
 
 	cmd := &cobra.Command{
-		Use:   "vote [devnet]",
-		Short: "Submit a governance vote",
-		Args:  cobra.MaximumNArgs(1),
+		Use:               "vote [devnet]",
+		Short:             "Submit a governance vote",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := requireDaemon(); err != nil {
 				return err
@@ -306,13 +312,15 @@ func newGovProposeCmd() *cobra.Command {
 		proposalType string
 		title        string
 		description  string
+		deposit      string
 		contentFile  string
 	)
 
 	cmd := &cobra.Command{
-		Use:   "propose [devnet]",
-		Short: "Submit a governance proposal",
-		Args:  cobra.MaximumNArgs(1),
+		Use:               "propose [devnet]",
+		Short:             "Submit a governance proposal",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := requireDaemon(); err != nil {
 				return err
@@ -346,7 +354,7 @@ func newGovProposeCmd() *cobra.Command {
 				devnetRef = ns + "/" + devnetName
 			}
 
-			tx, err := daemonClient.SubmitGovProposal(cmd.Context(), devnetRef, proposer, proposalType, title, description, content)
+			tx, err := daemonClient.SubmitGovProposal(cmd.Context(), devnetRef, proposer, proposalType, title, description, deposit, content)
 			if err != nil {
 				return err
 			}
@@ -367,6 +375,7 @@ func newGovProposeCmd() *cobra.Command {
 	cmd.Flags().StringVar(&proposalType, "type", "text", "Proposal type")
 	cmd.Flags().StringVar(&title, "title", "", "Proposal title (required)")
 	cmd.Flags().StringVar(&description, "description", "", "Proposal description")
+	cmd.Flags().StringVar(&deposit, "deposit", "", "Initial deposit (e.g., 10000000stake)")
 	cmd.Flags().StringVar(&contentFile, "content", "", "Path to content JSON file")
 	cmd.MarkFlagRequired("proposer")
 	cmd.MarkFlagRequired("title")
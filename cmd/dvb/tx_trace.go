@@ -0,0 +1,248 @@
+// cmd/dvb/tx_trace.go
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// txHashPattern matches a CometBFT or Ethereum transaction hash.
+var txHashPattern = regexp.MustCompile(`^(0[xX])?[0-9a-fA-F]{64}$`)
+
+func newTxTraceCmd() *cobra.Command {
+	var (
+		namespace string
+		devnet    string
+		evm       bool
+		output    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "trace <txhash|name>",
+		Short: "Inspect an on-chain transaction",
+		Long: `Look a transaction up on every running node of a devnet and show its result,
+gas usage, decoded messages and events.
+
+Messages are decoded by the devnet's network plugin, or by the Cosmos SDK
+decoder for plugins that don't decode transactions; unknown message types are
+shown by type only. The transaction is given by hash (a 0x-prefixed Ethereum
+hash is accepted on EVM chains) or by the name of a transaction submitted
+through the daemon.
+
+With --evm, the EVM call trace (debug_traceTransaction with the call tracer)
+is included for EVM transactions. The node must enable the debug JSON-RPC
+namespace.`,
+		Example: `  # Trace a transaction by hash
+  dvb tx trace 9F2C4E...
+
+  # Trace a transaction submitted with dvb, as JSON
+  dvb tx trace tx-mydevnet-abc123 -o json
+
+  # Include the EVM call trace
+  dvb tx trace 0x5e1f... --evm`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return validation.OutputFormat(output, "json")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			hash := args[0]
+			explicitDevnet := devnet
+			if !txHashPattern.MatchString(hash) {
+				tx, err := daemonClient.GetTransaction(cmd.Context(), hash)
+				if err != nil {
+					return err
+				}
+				if tx.TxHash == "" {
+					return fmt.Errorf("transaction %s has no hash yet (phase %s)", tx.Name, tx.Phase)
+				}
+				hash = tx.TxHash
+				if explicitDevnet == "" {
+					ns, name, ok := strings.Cut(tx.DevnetRef, "/")
+					if !ok {
+						ns, name = "", tx.DevnetRef
+					}
+					if namespace == "" {
+						namespace = ns
+					}
+					explicitDevnet = name
+				}
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			trace, err := daemonClient.TraceTransaction(cmd.Context(), ns, devnetName, hash, evm)
+			if err != nil {
+				return err
+			}
+
+			if output == "json" {
+				return printJSON(txTraceView(trace))
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+			printTxTrace(os.Stdout, trace)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVar(&devnet, "devnet", "", "Name of the devnet")
+	cmd.Flags().BoolVar(&evm, "evm", false, "Include the EVM call trace (EVM chains)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json)")
+
+	return cmd
+}
+
+// printTxTrace prints the result, messages, events and node lookups of a
+// traced transaction.
+func printTxTrace(out io.Writer, t *v1.TxTrace) {
+	if t.Code == 0 {
+		color.New(color.FgGreen).Fprintf(out, "✓ Success")
+	} else {
+		color.New(color.FgRed).Fprintf(out, "✗ Failed (code %d", t.Code)
+		if t.Codespace != "" {
+			color.New(color.FgRed).Fprintf(out, ", %s", t.Codespace)
+		}
+		color.New(color.FgRed).Fprintf(out, ")")
+	}
+	fmt.Fprintf(out, "\nHash:     %s\n", t.Hash)
+	fmt.Fprintf(out, "Height:   %d\n", t.Height)
+	fmt.Fprintf(out, "Gas:      %d used / %d wanted\n", t.GasUsed, t.GasWanted)
+	if t.Fee != "" {
+		fmt.Fprintf(out, "Fee:      %s\n", t.Fee)
+	}
+	if t.Memo != "" {
+		fmt.Fprintf(out, "Memo:     %s\n", t.Memo)
+	}
+	if t.Code != 0 && t.Log != "" {
+		fmt.Fprintf(out, "Log:      %s\n", t.Log)
+	}
+	if t.EvmTxHash != "" {
+		fmt.Fprintf(out, "EVM Hash: %s\n", t.EvmTxHash)
+	}
+
+	fmt.Fprintf(out, "\nMessages (%d):\n", len(t.Messages))
+	if t.DecodeError != "" {
+		color.New(color.FgYellow).Fprintf(out, "  Could not decode transaction: %s\n", t.DecodeError)
+	}
+	for i, m := range t.Messages {
+		fmt.Fprintf(out, "  [%d] %s\n", i, m.TypeUrl)
+		if len(m.Json) > 0 {
+			fmt.Fprintf(out, "%s\n", indentJSON(m.Json, "      "))
+		}
+	}
+
+	fmt.Fprintf(out, "\nEvents (%d):\n", len(t.Events))
+	for _, e := range t.Events {
+		fmt.Fprintf(out, "  %s\n", e.Type)
+		for _, a := range e.Attributes {
+			fmt.Fprintf(out, "      %s: %s\n", a.Key, a.Value)
+		}
+	}
+
+	fmt.Fprintln(out, "\nNodes:")
+	for _, n := range t.Nodes {
+		switch {
+		case n.Error != "":
+			fmt.Fprintf(out, "  node %d: error: %s\n", n.Index, n.Error)
+		case n.Found:
+			fmt.Fprintf(out, "  node %d: found at height %d\n", n.Index, n.Height)
+		default:
+			fmt.Fprintf(out, "  node %d: not found (tx indexing may be disabled)\n", n.Index)
+		}
+	}
+
+	switch {
+	case len(t.EvmTrace) > 0:
+		fmt.Fprintf(out, "\nEVM call trace:\n%s\n", indentJSON(t.EvmTrace, "  "))
+	case t.EvmTraceError != "":
+		color.New(color.FgYellow).Fprintf(out, "\nEVM call trace unavailable: %s\n", t.EvmTraceError)
+	}
+}
+
+// indentJSON indents a JSON document with a prefix on every line, returning
+// it unchanged if it is not valid JSON.
+func indentJSON(data []byte, prefix string) string {
+	var v any
+	if json.Unmarshal(data, &v) != nil {
+		return prefix + string(data)
+	}
+	out, err := json.MarshalIndent(v, prefix, "  ")
+	if err != nil {
+		return prefix + string(data)
+	}
+	return prefix + string(out)
+}
+
+// txTraceJSON is the -o json form of a trace, embedding decoded messages and
+// the EVM trace as JSON rather than base64.
+type txTraceJSON struct {
+	Hash          string             `json:"hash"`
+	Height        int64              `json:"height"`
+	Code          uint32             `json:"code"`
+	Codespace     string             `json:"codespace,omitempty"`
+	Log           string             `json:"log,omitempty"`
+	GasWanted     int64              `json:"gasWanted"`
+	GasUsed       int64              `json:"gasUsed"`
+	Fee           string             `json:"fee,omitempty"`
+	Memo          string             `json:"memo,omitempty"`
+	Messages      []txTraceMsgJSON   `json:"messages"`
+	DecodeError   string             `json:"decodeError,omitempty"`
+	Events        []*v1.TxTraceEvent `json:"events"`
+	Nodes         []*v1.TxTraceNode  `json:"nodes"`
+	EVMTxHash     string             `json:"evmTxHash,omitempty"`
+	EVMTrace      json.RawMessage    `json:"evmTrace,omitempty"`
+	EVMTraceError string             `json:"evmTraceError,omitempty"`
+}
+
+type txTraceMsgJSON struct {
+	TypeURL string          `json:"typeUrl"`
+	Value   json.RawMessage `json:"value,omitempty"`
+}
+
+func txTraceView(t *v1.TxTrace) txTraceJSON {
+	view := txTraceJSON{
+		Hash:          t.Hash,
+		Height:        t.Height,
+		Code:          t.Code,
+		Codespace:     t.Codespace,
+		Log:           t.Log,
+		GasWanted:     t.GasWanted,
+		GasUsed:       t.GasUsed,
+		Fee:           t.Fee,
+		Memo:          t.Memo,
+		Messages:      make([]txTraceMsgJSON, 0, len(t.Messages)),
+		DecodeError:   t.DecodeError,
+		Events:        t.Events,
+		Nodes:         t.Nodes,
+		EVMTxHash:     t.EvmTxHash,
+		EVMTraceError: t.EvmTraceError,
+	}
+	for _, m := range t.Messages {
+		msg := txTraceMsgJSON{TypeURL: m.TypeUrl}
+		if json.Valid(m.Json) {
+			msg.Value = m.Json
+		}
+		view.Messages = append(view.Messages, msg)
+	}
+	if json.Valid(t.EvmTrace) {
+		view.EVMTrace = t.EvmTrace
+	}
+	return view
+}
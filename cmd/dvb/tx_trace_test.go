@@ -0,0 +1,91 @@
+// cmd/dvb/tx_trace_test.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+func testTxTrace() *v1.TxTrace {
+	return &v1.TxTrace{
+		Hash:      "9F2C",
+		Height:    42,
+		Code:      5,
+		Codespace: "sdk",
+		Log:       "insufficient funds",
+		GasWanted: 200000,
+		GasUsed:   81234,
+		Fee:       "5000stake",
+		Messages: []*v1.TxTraceMessage{
+			{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Json: []byte(`{"from_address":"cosmos1a"}`)},
+			{TypeUrl: "/mychain.fees.v1.MsgSetFee"},
+		},
+		Events: []*v1.TxTraceEvent{{Type: "tx", Attributes: []*v1.TxTraceAttribute{{Key: "fee", Value: "5000stake"}}}},
+		Nodes: []*v1.TxTraceNode{
+			{Index: 0, Found: true, Height: 42},
+			{Index: 1},
+			{Index: 2, Error: "connection refused"},
+		},
+		EvmTraceError: "transaction has no EVM execution",
+	}
+}
+
+func TestPrintTxTrace(t *testing.T) {
+	var out bytes.Buffer
+	printTxTrace(&out, testTxTrace())
+
+	for _, want := range []string{
+		"✗ Failed (code 5, sdk)",
+		"Gas:      81234 used / 200000 wanted",
+		"Log:      insufficient funds",
+		"[0] /cosmos.bank.v1beta1.MsgSend",
+		`"from_address": "cosmos1a"`,
+		"[1] /mychain.fees.v1.MsgSetFee",
+		"fee: 5000stake",
+		"node 0: found at height 42",
+		"node 1: not found",
+		"node 2: error: connection refused",
+		"EVM call trace unavailable: transaction has no EVM execution",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestTxTraceView(t *testing.T) {
+	trace := testTxTrace()
+	trace.EvmTrace = []byte(`{"type":"CALL"}`)
+
+	data, err := json.Marshal(txTraceView(trace))
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	for _, want := range []string{
+		`"value":{"from_address":"cosmos1a"}`,
+		`{"typeUrl":"/mychain.fees.v1.MsgSetFee"}`,
+		`"evmTrace":{"type":"CALL"}`,
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("JSON missing %s:\n%s", want, data)
+		}
+	}
+}
+
+func TestTxHashPattern(t *testing.T) {
+	hash := strings.Repeat("ab", 32)
+	for _, s := range []string{hash, strings.ToUpper(hash), "0x" + hash} {
+		if !txHashPattern.MatchString(s) {
+			t.Errorf("%s should be a hash", s)
+		}
+	}
+	for _, s := range []string{"tx-mydevnet-abc123", hash[:60]} {
+		if txHashPattern.MatchString(s) {
+			t.Errorf("%s should not be a hash", s)
+		}
+	}
+}
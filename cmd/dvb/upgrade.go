@@ -2,11 +2,15 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"text/tabwriter"
+	"time"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/cmd/validation"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
@@ -25,6 +29,8 @@ func newUpgradeCmd() *cobra.Command {
 		newUpgradeCancelCmd(),
 		newUpgradeRetryCmd(),
 		newUpgradeDeleteCmd(),
+		newUpgradeForkTestCmd(),
+		newUpgradeReportCmd(),
 	)
 
 	return cmd
@@ -60,8 +66,10 @@ func newUpgradeCreateCmd() *cobra.Command {
 				return err
 			}
 
-			if upgradeName == "" {
-				return fmt.Errorf("--upgrade-name is required")
+			// Without --upgrade-name the daemon names the upgrade after
+			// --version, for networks with a naming convention
+			if upgradeName == "" && version == "" {
+				return fmt.Errorf("--upgrade-name is required unless --version is set")
 			}
 
 			printContextHeader(devnet, currentContext)
@@ -104,7 +112,7 @@ func newUpgradeCreateCmd() *cobra.Command {
 
 	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
 	cmd.Flags().StringVar(&devnet, "devnet", "", "Name of the devnet to upgrade")
-	cmd.Flags().StringVar(&upgradeName, "upgrade-name", "", "Name for the on-chain upgrade proposal (required)")
+	cmd.Flags().StringVar(&upgradeName, "upgrade-name", "", "Name for the on-chain upgrade proposal (default: derived from --version by the network, e.g. v19.0.0 → v19)")
 	cmd.Flags().Int64Var(&targetHeight, "target-height", 0, "Target block height for upgrade (0 = auto-calculate)")
 	cmd.Flags().StringVar(&binaryType, "binary-type", "cache", "Binary source type (cache, path, docker)")
 	cmd.Flags().StringVar(&binaryPath, "binary-path", "", "Path to new binary (for path type)")
@@ -112,11 +120,185 @@ func newUpgradeCreateCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&autoVote, "auto-vote", true, "Automatically vote yes on the upgrade proposal")
 	cmd.Flags().BoolVar(&withExport, "with-export", false, "Export state before and after upgrade")
 
-	cmd.MarkFlagRequired("upgrade-name")
+	return cmd
+}
+
+func newUpgradeForkTestCmd() *cobra.Command {
+	var (
+		namespace   string
+		devnet      string
+		atHeight    int64
+		toVersion   string
+		binaryType  string
+		binaryPath  string
+		upgradeName string
+		noWait      bool
+		timeout     time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "fork-test [name]",
+		Short: "Test an upgrade on a fork of the devnet's state",
+		Long: `Halt the devnet at a height, export its state, and provision a new devnet
+from that export running the new binary. Reports whether the fork starts and
+produces blocks, answering "will the migration work" without touching the
+original devnet's data.
+
+The fork devnet is named <name>-fork and is left running for inspection.`,
+		Example: `  # Halt at height 500 and start a fork on v2.0.0
+  dvb upgrade fork-test --at-height 500 --to-version v2.0.0
+
+  # Use a locally built binary for the fork
+  dvb upgrade fork-test --at-height 500 --binary-type local --binary-path ./build/stabled`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if atHeight <= 0 {
+				return fmt.Errorf("--at-height must be greater than 0")
+			}
+			if toVersion == "" && binaryPath == "" {
+				return fmt.Errorf("--to-version or --binary-path is required")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(devnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			name := fmt.Sprintf("%s-fork-test-%d", devnetName, atHeight)
+			if len(args) > 0 {
+				name = args[0]
+			}
+			if upgradeName == "" {
+				upgradeName = toVersion
+			}
+			if upgradeName == "" {
+				upgradeName = "fork-test"
+			}
+
+			printContextHeader(devnet, currentContext)
+
+			spec := &v1.UpgradeSpec{
+				DevnetRef:    devnetName,
+				UpgradeName:  upgradeName,
+				TargetHeight: atHeight,
+				Mode:         "fork-test",
+				NewBinary: &v1.BinarySource{
+					Type:    binaryType,
+					Path:    binaryPath,
+					Version: toVersion,
+				},
+			}
+
+			upgrade, err := daemonClient.CreateUpgrade(cmd.Context(), ns, name, spec)
+			if err != nil {
+				return err
+			}
+
+			color.Green("✓ Fork test %q created", upgrade.Metadata.Name)
+			fmt.Printf("  Devnet:     %s\n", upgrade.Spec.DevnetRef)
+			fmt.Printf("  Halt at:    %d\n", upgrade.Spec.TargetHeight)
+			if toVersion != "" {
+				fmt.Printf("  To version: %s\n", toVersion)
+			}
+
+			if noWait {
+				fmt.Printf("\nCheck progress with: dvb upgrade status %s\n", upgrade.Metadata.Name)
+				return nil
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			result, err := waitForForkTest(ctx, ns, upgrade.Metadata.Name, daemonClient, 2*time.Second, os.Stdout)
+			if err != nil {
+				return fmt.Errorf("fork test did not finish: %w", err)
+			}
+			return reportForkTest(result)
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVar(&devnet, "devnet", "", "Name of the devnet to fork")
+	cmd.Flags().Int64Var(&atHeight, "at-height", 0, "Block height to halt and export at (required)")
+	cmd.Flags().StringVar(&toVersion, "to-version", "", "Version of the new binary to run on the fork")
+	cmd.Flags().StringVar(&binaryType, "binary-type", "cache", "Binary source type (cache, local)")
+	cmd.Flags().StringVar(&binaryPath, "binary-path", "", "Path to new binary (for local type)")
+	cmd.Flags().StringVar(&upgradeName, "upgrade-name", "", "On-chain upgrade name (defaults to --to-version)")
+	cmd.Flags().BoolVar(&noWait, "no-wait", false, "Return after creating the fork test instead of waiting for the result")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "How long to wait for the fork test to finish")
+
+	cmd.MarkFlagRequired("at-height")
 
 	return cmd
 }
 
+// upgradeGetter is the subset of the daemon client used to poll upgrades.
+type upgradeGetter interface {
+	GetUpgrade(ctx context.Context, namespace, name string) (*v1.Upgrade, error)
+}
+
+// waitForForkTest polls a fork-test upgrade until it completes or fails,
+// printing each new status message to w.
+func waitForForkTest(ctx context.Context, namespace, name string, client upgradeGetter, pollInterval time.Duration, w io.Writer) (*v1.Upgrade, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var lastMessage string
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+			upgrade, err := client.GetUpgrade(ctx, namespace, name)
+			if err != nil {
+				return nil, err
+			}
+			if upgrade.Status == nil {
+				continue
+			}
+
+			if msg := upgrade.Status.Phase + ": " + upgrade.Status.Message; msg != lastMessage {
+				lastMessage = msg
+				fmt.Fprintf(w, "  %s\n", msg)
+			}
+
+			switch upgrade.Status.Phase {
+			case "Completed", "Failed":
+				return upgrade, nil
+			}
+		}
+	}
+}
+
+// reportForkTest prints the outcome of a finished fork test and returns an
+// error if the fork did not start and produce blocks.
+func reportForkTest(u *v1.Upgrade) error {
+	fmt.Println()
+	if u.Status.PreExportPath != "" {
+		fmt.Printf("Export:      %s\n", u.Status.PreExportPath)
+	}
+	if u.Status.ForkDevnetRef != "" {
+		fmt.Printf("Fork devnet: %s\n", u.Status.ForkDevnetRef)
+	}
+	if u.Status.ForkStartHeight > 0 {
+		fmt.Printf("Heights:     %d -> %d\n", u.Status.ForkStartHeight, u.Status.ForkHeight)
+	}
+
+	if u.Status.Phase != "Completed" {
+		color.Red("✗ Fork test failed: %s", u.Status.Error)
+		return fmt.Errorf("fork test %s failed", u.Metadata.Name)
+	}
+
+	color.Green("✓ Fork started from the exported state and produced blocks")
+	return nil
+}
+
 func newUpgradeListCmd() *cobra.Command {
 	var namespace string
 
@@ -306,13 +488,62 @@ func newUpgradeDeleteCmd() *cobra.Command {
 	return cmd
 }
 
+func newUpgradeReportCmd() *cobra.Command {
+	var (
+		namespace string
+		format    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "report [name]",
+		Short: "Show the rehearsal report of a finished upgrade",
+		Long: `Show the rehearsal report written when an upgrade completed or failed:
+per-stage durations, proposal details, app hashes before and after the
+upgrade height, module version changes and warnings.
+
+The daemon keeps reports under <data-dir>/reports/upgrades/<namespace>/ as
+<name>.json and <name>.md.`,
+		Example: `  # Print the report as markdown
+  dvb upgrade report v25
+
+  # Machine-readable report for CI
+  dvb upgrade report v25 -o json > v25-report.json`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return validation.OutputFormat(format, "markdown", "json")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			report, err := daemonClient.GetUpgradeReport(cmd.Context(), namespace, args[0])
+			if err != nil {
+				return err
+			}
+
+			if format == "json" {
+				fmt.Println(string(report.Json))
+				return nil
+			}
+			fmt.Print(report.Markdown)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringVarP(&format, "output", "o", "markdown", "Output format (markdown, json)")
+
+	return cmd
+}
+
 func printUpgradeStatus(u *v1.Upgrade) {
 	// Phase with color
 	phase := u.Status.Phase
 	switch phase {
 	case "Completed":
 		color.Green("● %s", phase)
-	case "Pending", "Proposing", "Voting", "Waiting", "Switching", "Verifying":
+	case "Pending", "Proposing", "Voting", "Waiting", "Switching", "Halting", "Exporting", "Forking", "Verifying":
 		color.Yellow("◐ %s", phase)
 	case "Failed":
 		color.Red("✗ %s", phase)
@@ -359,4 +590,8 @@ func printUpgradeStatus(u *v1.Upgrade) {
 	if u.Status.PostExportPath != "" {
 		fmt.Printf("Post-export:  %s\n", u.Status.PostExportPath)
 	}
+
+	if u.Status.ForkDevnetRef != "" {
+		fmt.Printf("Fork devnet:  %s\n", u.Status.ForkDevnetRef)
+	}
 }
@@ -0,0 +1,88 @@
+// cmd/dvb/upgrade_test.go
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+// fakeUpgradeGetter returns each upgrade in sequence, repeating the last.
+type fakeUpgradeGetter struct {
+	upgrades []*v1.Upgrade
+	calls    int
+}
+
+func (f *fakeUpgradeGetter) GetUpgrade(ctx context.Context, namespace, name string) (*v1.Upgrade, error) {
+	i := f.calls
+	if i >= len(f.upgrades) {
+		i = len(f.upgrades) - 1
+	}
+	f.calls++
+	return f.upgrades[i], nil
+}
+
+func forkTestUpgrade(phase, message string) *v1.Upgrade {
+	return &v1.Upgrade{
+		Metadata: &v1.UpgradeMetadata{Name: "mydevnet-fork-test-500"},
+		Spec:     &v1.UpgradeSpec{DevnetRef: "mydevnet", TargetHeight: 500, Mode: "fork-test"},
+		Status:   &v1.UpgradeStatus{Phase: phase, Message: message},
+	}
+}
+
+func TestWaitForForkTest_ReturnsOnCompleted(t *testing.T) {
+	getter := &fakeUpgradeGetter{upgrades: []*v1.Upgrade{
+		forkTestUpgrade("Waiting", "Height 490/500"),
+		forkTestUpgrade("Exporting", "Waiting for nodes to stop before export"),
+		forkTestUpgrade("Exporting", "Waiting for nodes to stop before export"),
+		forkTestUpgrade("Completed", "Fork devnet produced blocks"),
+	}}
+
+	var out bytes.Buffer
+	result, err := waitForForkTest(context.Background(), "default", "mydevnet-fork-test-500", getter, time.Millisecond, &out)
+	if err != nil {
+		t.Fatalf("waitForForkTest() error = %v", err)
+	}
+	if result.Status.Phase != "Completed" {
+		t.Errorf("Phase = %q, want Completed", result.Status.Phase)
+	}
+
+	// Repeated status messages are printed once
+	if n := strings.Count(out.String(), "Waiting for nodes to stop"); n != 1 {
+		t.Errorf("expected status message printed once, got %d times:\n%s", n, out.String())
+	}
+}
+
+func TestWaitForForkTest_Timeout(t *testing.T) {
+	getter := &fakeUpgradeGetter{upgrades: []*v1.Upgrade{
+		forkTestUpgrade("Waiting", "Height 10/500"),
+	}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	var out bytes.Buffer
+	if _, err := waitForForkTest(ctx, "default", "mydevnet-fork-test-500", getter, time.Millisecond, &out); err == nil {
+		t.Error("waitForForkTest() should fail when the context expires")
+	}
+}
+
+func TestReportForkTest(t *testing.T) {
+	passed := forkTestUpgrade("Completed", "done")
+	passed.Status.ForkDevnetRef = "mydevnet-fork-test-500-fork"
+	passed.Status.ForkStartHeight = 501
+	passed.Status.ForkHeight = 505
+	if err := reportForkTest(passed); err != nil {
+		t.Errorf("reportForkTest() error = %v for a completed fork test", err)
+	}
+
+	failed := forkTestUpgrade("Failed", "Upgrade failed")
+	failed.Status.Error = "fork devnet crashed"
+	if err := reportForkTest(failed); err == nil {
+		t.Error("reportForkTest() should return an error for a failed fork test")
+	}
+}
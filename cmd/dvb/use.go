@@ -37,7 +37,8 @@ Examples:
 
   # Clear context
   dvb use -`,
-		Args: cobra.MaximumNArgs(1),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Case 1: dvb use - (clear context)
 			if len(args) == 1 && args[0] == "-" {
@@ -0,0 +1,565 @@
+// cmd/dvb/validator.go
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/dvbcontext"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newValidatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator",
+		Short: "Simulate validator faults",
+		Long: `Simulate validator faults on a running devnet, to rehearse slashing
+response runbooks and check evidence handling and monitoring alerts.`,
+	}
+
+	cmd.AddCommand(
+		newValidatorJailCmd(),
+		newValidatorUnjailCmd(),
+		newValidatorDoubleSignCmd(),
+	)
+
+	return cmd
+}
+
+func newValidatorJailCmd() *cobra.Command {
+	var (
+		namespace string
+		timeout   time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "jail [devnet] <index>",
+		Short: "Get a validator jailed for downtime",
+		Long: `Stop a validator's node until the chain jails it for downtime, then start
+it again.
+
+The slashing module jails a validator that signs fewer than
+min_signed_per_window of the last signed_blocks_window blocks, and slashes
+slash_fraction_downtime of its stake. The command reads those params, stops
+the node, waits for the validator to leave the active set and restarts the
+node, which then follows the chain as a jailed validator until it is
+unjailed.
+
+The chain needs two thirds of the voting power to keep producing blocks, so
+a validator holding a third or more of it cannot be jailed this way. Only
+running devnets are supported: a stopped devnet's state lives in its nodes'
+databases and is not patched directly.
+
+Examples:
+  # Jail validator 1 of my-devnet
+  dvb validator jail my-devnet 1
+
+  # Jail validator 2 of the current context devnet
+  dvb validator jail 2`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeDevnetNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			explicitDevnet, index, err := parseValidatorArgs(args)
+			if err != nil {
+				return err
+			}
+
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			f := &validatorFaults{client: daemonClient, out: os.Stdout, pollInterval: 2 * time.Second}
+			return f.jail(ctx, ns, devnetName, index)
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Minute, "How long to wait for the validator to be jailed")
+
+	return cmd
+}
+
+func newValidatorUnjailCmd() *cobra.Command {
+	var (
+		namespace string
+		timeout   time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "unjail [devnet] <index>",
+		Short: "Unjail a validator and wait for it to rejoin the active set",
+		Long: `Submit an unjail transaction from a jailed validator and wait for it to
+rejoin the active set.
+
+A validator jailed for downtime can only be unjailed once the slashing
+module's downtime_jail_duration has passed, and its node must be running so
+it signs blocks again.
+
+Examples:
+  # Unjail validator 1 of my-devnet
+  dvb validator unjail my-devnet 1`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeDevnetNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			explicitDevnet, index, err := parseValidatorArgs(args)
+			if err != nil {
+				return err
+			}
+
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			f := &validatorFaults{client: daemonClient, out: os.Stdout, pollInterval: 2 * time.Second}
+			return f.unjail(ctx, ns, devnetName, index)
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().DurationVar(&timeout, "timeout", 5*time.Minute, "How long to wait for the validator to rejoin the active set")
+
+	return cmd
+}
+
+// destructiveFlag must be passed to commands that do lasting harm to a
+// devnet.
+const destructiveFlag = "i-know-this-is-destructive"
+
+func newValidatorDoubleSignCmd() *cobra.Command {
+	var (
+		namespace   string
+		timeout     time.Duration
+		destructive bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "double-sign [devnet] <index>",
+		Short: "Make a validator double-sign and get it tombstoned",
+		Long: `Make a validator double-sign, to check evidence handling, tombstoning and
+monitoring alerts.
+
+The daemon signs two prevotes for conflicting forks of the latest block
+with a copy of the validator's consensus key, in a signing harness outside
+the node, and submits them to the chain as duplicate vote evidence. The
+command then waits for the validator to leave the active set.
+
+The chain slashes slash_fraction_double_sign of the validator's stake and
+tombstones it: it stays jailed for good and can never be unjailed, so the
+command refuses to run without --i-know-this-is-destructive. The validator's
+node keeps running and following the chain. Validators behind a remote
+signer or on a remote host are not supported, and a validator holding a
+third or more of the voting power would halt the chain.
+
+Examples:
+  # Tombstone validator 1 of my-devnet
+  dvb validator double-sign my-devnet 1 --i-know-this-is-destructive`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeDevnetNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			explicitDevnet, index, err := parseValidatorArgs(args)
+			if err != nil {
+				return err
+			}
+			if !destructive {
+				return fmt.Errorf("a double-signing validator is tombstoned and can never be unjailed; pass --%s to proceed", destructiveFlag)
+			}
+
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			f := &validatorFaults{client: daemonClient, out: os.Stdout, pollInterval: 2 * time.Second}
+			return f.doubleSign(ctx, ns, devnetName, index)
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().DurationVar(&timeout, "timeout", 5*time.Minute, "How long to wait for the validator to be tombstoned")
+	cmd.Flags().BoolVar(&destructive, destructiveFlag, false, "Confirm that the validator is tombstoned for good")
+
+	return cmd
+}
+
+// parseValidatorArgs parses the [devnet] <index> arguments of the validator
+// commands.
+func parseValidatorArgs(args []string) (explicitDevnet string, index int, err error) {
+	last := args[len(args)-1]
+	index, err = strconv.Atoi(last)
+	if err != nil || index < 0 {
+		return "", 0, fmt.Errorf("validator index must be a non-negative number, got %q", last)
+	}
+	if len(args) == 2 {
+		explicitDevnet = args[0]
+	}
+	return explicitDevnet, index, nil
+}
+
+// validatorClient is the subset of the daemon client used to simulate
+// validator faults.
+type validatorClient interface {
+	GetDevnet(ctx context.Context, namespace, name string) (*v1.Devnet, error)
+	GetNode(ctx context.Context, namespace, devnetName string, index int) (*v1.Node, error)
+	StartNode(ctx context.Context, namespace, devnetName string, index int) (*v1.Node, error)
+	StopNode(ctx context.Context, namespace, devnetName string, index int) (*v1.Node, error)
+	DiagnoseConsensus(ctx context.Context, req *v1.DiagnoseConsensusRequest) (*v1.DiagnoseConsensusResponse, error)
+	GetModuleParams(ctx context.Context, namespace, devnet, module string) ([]byte, error)
+	SubmitTransaction(ctx context.Context, devnet, txType, signer string, payload []byte) (*v1.Transaction, error)
+	GetTransaction(ctx context.Context, name string) (*v1.Transaction, error)
+	InjectDoubleSign(ctx context.Context, req *v1.InjectDoubleSignRequest) (*v1.InjectDoubleSignResponse, error)
+}
+
+// validatorFaults jails, unjails and tombstones a devnet's validators.
+type validatorFaults struct {
+	client       validatorClient
+	out          io.Writer
+	pollInterval time.Duration
+}
+
+// downtimeParams are the slashing params that decide when a validator is
+// jailed for downtime.
+type downtimeParams struct {
+	signedBlocksWindow   int64
+	minSignedPerWindow   float64
+	downtimeJailDuration time.Duration
+	slashFraction        string
+}
+
+// parseDowntimeParams decodes the downtime params from the slashing
+// module's params JSON, where numbers may be quoted.
+func parseDowntimeParams(data []byte) (downtimeParams, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return downtimeParams{}, fmt.Errorf("failed to decode slashing params: %w", err)
+	}
+	field := func(key string) string {
+		var s string
+		if json.Unmarshal(raw[key], &s) != nil {
+			s = string(raw[key])
+		}
+		return s
+	}
+
+	var p downtimeParams
+	var err error
+	if p.signedBlocksWindow, err = strconv.ParseInt(field("signed_blocks_window"), 10, 64); err != nil || p.signedBlocksWindow <= 0 {
+		return p, fmt.Errorf("invalid slashing signed_blocks_window %q", field("signed_blocks_window"))
+	}
+	minSigned, ok := new(big.Rat).SetString(field("min_signed_per_window"))
+	if !ok {
+		return p, fmt.Errorf("invalid slashing min_signed_per_window %q", field("min_signed_per_window"))
+	}
+	p.minSignedPerWindow, _ = minSigned.Float64()
+	// A missing duration only leaves out the unjail hint
+	p.downtimeJailDuration, _ = time.ParseDuration(field("downtime_jail_duration"))
+	p.slashFraction = formatFraction(field("slash_fraction_downtime"))
+	return p, nil
+}
+
+// formatFraction formats a decimal slashing fraction like "0.010000000000000000"
+// as "0.01", or returns "" if it is not a number.
+func formatFraction(value string) string {
+	fraction, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return ""
+	}
+	return strings.TrimRight(strings.TrimRight(fraction.FloatString(6), "0"), ".")
+}
+
+// doubleSignSlashFraction returns slash_fraction_double_sign from the
+// slashing module's params JSON, or "" if it is missing.
+func doubleSignSlashFraction(data []byte) string {
+	var params struct {
+		SlashFractionDoubleSign string `json:"slash_fraction_double_sign"`
+	}
+	if json.Unmarshal(data, &params) != nil {
+		return ""
+	}
+	return formatFraction(params.SlashFractionDoubleSign)
+}
+
+// missedBlocksToJail is how many blocks in a row a validator misses before
+// it is jailed: one more than the window allows.
+func (p downtimeParams) missedBlocksToJail() int64 {
+	minSigned := int64(math.Round(p.minSignedPerWindow * float64(p.signedBlocksWindow)))
+	return p.signedBlocksWindow - minSigned + 1
+}
+
+// jail stops a validator's node until the validator leaves the active set,
+// then starts it again.
+func (f *validatorFaults) jail(ctx context.Context, namespace, devnet string, index int) error {
+	node, report, err := f.validator(ctx, namespace, devnet, index)
+	if err != nil {
+		return err
+	}
+	name := dvbcontext.NodeName(node)
+	target := findConsensusValidator(report.Validators, name)
+	if target == nil {
+		return fmt.Errorf("%s is not in the active set; it may already be jailed (see 'dvb analyze valset-diff')", name)
+	}
+	if haltsWithout(report.Validators, target) {
+		return fmt.Errorf("%s holds %d of %d voting power; stopping it would halt the chain instead of jailing it",
+			name, target.VotingPower, totalVotingPower(report.Validators))
+	}
+
+	data, err := f.client.GetModuleParams(ctx, namespace, devnet, "slashing")
+	if err != nil {
+		return fmt.Errorf("failed to read slashing params: %w", err)
+	}
+	params, err := parseDowntimeParams(data)
+	if err != nil {
+		return err
+	}
+
+	if node.GetStatus().GetPhase() == "Running" {
+		if _, err := f.client.StopNode(ctx, namespace, devnet, index); err != nil {
+			return fmt.Errorf("failed to stop %s: %w", name, err)
+		}
+	}
+	fmt.Fprintf(f.out, "Stopped %s; the chain jails it after it misses %d of %d blocks...\n",
+		name, params.missedBlocksToJail(), params.signedBlocksWindow)
+
+	waitErr := f.waitForActiveSet(ctx, namespace, devnet, name, false)
+
+	// Start the node even if the wait failed, so it is not left down
+	if _, err := f.client.StartNode(context.WithoutCancel(ctx), namespace, devnet, index); err != nil {
+		if waitErr == nil {
+			return fmt.Errorf("%s was jailed but failed to start again: %w", name, err)
+		}
+		color.New(color.FgYellow).Fprintf(f.out, "⚠ failed to start %s again: %v\n", name, err)
+	}
+	if waitErr != nil {
+		return fmt.Errorf("%s was not jailed: %w", name, waitErr)
+	}
+
+	color.New(color.FgGreen).Fprintf(f.out, "✓ %s jailed for downtime and restarted\n", name)
+	if params.slashFraction != "" {
+		fmt.Fprintf(f.out, "  Slashed:   %s of its stake\n", params.slashFraction)
+	}
+	if params.downtimeJailDuration > 0 {
+		fmt.Fprintf(f.out, "  Unjail:    after %s, with 'dvb validator unjail %s %d'\n", params.downtimeJailDuration, devnet, index)
+	}
+	return nil
+}
+
+// unjail submits an unjail transaction from a jailed validator and waits
+// for it to rejoin the active set.
+func (f *validatorFaults) unjail(ctx context.Context, namespace, devnet string, index int) error {
+	node, report, err := f.validator(ctx, namespace, devnet, index)
+	if err != nil {
+		return err
+	}
+	name := dvbcontext.NodeName(node)
+	if findConsensusValidator(report.Validators, name) != nil {
+		return fmt.Errorf("%s is in the active set, not jailed", name)
+	}
+	if phase := node.GetStatus().GetPhase(); phase != "Running" {
+		return fmt.Errorf("%s is %s; start it with 'dvb node start %s %s' first, or it is jailed again for downtime", name, phase, devnet, name)
+	}
+
+	devnetRef := devnet
+	if namespace != "" && namespace != "default" {
+		devnetRef = namespace + "/" + devnet
+	}
+	tx, err := f.client.SubmitTransaction(ctx, devnetRef, "slashing/unjail", fmt.Sprintf("validator:%d", index), nil)
+	if err != nil {
+		return err
+	}
+	if tx, err = f.waitForTx(ctx, tx.Name); err != nil {
+		return fmt.Errorf("unjail transaction of %s: %w (a validator jailed for downtime can only be unjailed once the slashing downtime_jail_duration is over)", name, err)
+	}
+	fmt.Fprintf(f.out, "Unjail transaction %s confirmed at height %d\n", tx.TxHash, tx.Height)
+
+	if err := f.waitForActiveSet(ctx, namespace, devnet, name, true); err != nil {
+		return fmt.Errorf("%s did not rejoin the active set: %w", name, err)
+	}
+	color.New(color.FgGreen).Fprintf(f.out, "✓ %s unjailed and back in the active set\n", name)
+	return nil
+}
+
+// doubleSign has the daemon inject double-sign evidence against a validator
+// and waits for the validator to leave the active set.
+func (f *validatorFaults) doubleSign(ctx context.Context, namespace, devnet string, index int) error {
+	node, report, err := f.validator(ctx, namespace, devnet, index)
+	if err != nil {
+		return err
+	}
+	name := dvbcontext.NodeName(node)
+	target := findConsensusValidator(report.Validators, name)
+	if target == nil {
+		return fmt.Errorf("%s is not in the active set; it may already be jailed or tombstoned", name)
+	}
+	if haltsWithout(report.Validators, target) {
+		return fmt.Errorf("%s holds %d of %d voting power; tombstoning it would halt the chain",
+			name, target.VotingPower, totalVotingPower(report.Validators))
+	}
+
+	// The params only add the slashed fraction to the report
+	var slashFraction string
+	if data, err := f.client.GetModuleParams(ctx, namespace, devnet, "slashing"); err == nil {
+		slashFraction = doubleSignSlashFraction(data)
+	}
+
+	resp, err := f.client.InjectDoubleSign(ctx, &v1.InjectDoubleSignRequest{
+		DevnetName:     devnet,
+		Namespace:      namespace,
+		ValidatorIndex: int32(index),
+		Confirm:        true,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(f.out, "Submitted evidence %s of %s double-signing height %d; waiting for the chain to tombstone it...\n",
+		resp.EvidenceHash, name, resp.Height)
+
+	if err := f.waitForActiveSet(ctx, namespace, devnet, name, false); err != nil {
+		return fmt.Errorf("%s was not removed from the active set: %w (check for rejected evidence with 'dvb logs grep %s -i evidence')", name, err, devnet)
+	}
+
+	color.New(color.FgGreen).Fprintf(f.out, "✓ %s tombstoned for double-signing\n", name)
+	fmt.Fprintf(f.out, "  Address:   %s\n", resp.ValidatorAddress)
+	fmt.Fprintf(f.out, "  Height:    %d\n", resp.Height)
+	if slashFraction != "" {
+		fmt.Fprintf(f.out, "  Slashed:   %s of its stake\n", slashFraction)
+	}
+	fmt.Fprintf(f.out, "  Unjail:    never; the validator is jailed for good\n")
+	return nil
+}
+
+// validator returns a validator node of a running devnet and the current
+// consensus validators.
+func (f *validatorFaults) validator(ctx context.Context, namespace, devnet string, index int) (*v1.Node, *v1.DiagnoseConsensusResponse, error) {
+	d, err := f.client.GetDevnet(ctx, namespace, devnet)
+	if err != nil {
+		return nil, nil, err
+	}
+	if phase := d.GetStatus().GetPhase(); phase != "Running" && phase != "Degraded" {
+		return nil, nil, fmt.Errorf("devnet %q is %s; validator faults need a running chain", devnet, phase)
+	}
+
+	node, err := f.client.GetNode(ctx, namespace, devnet, index)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !strings.EqualFold(node.GetSpec().GetRole(), "validator") {
+		return nil, nil, fmt.Errorf("node %d of %q is a %s, not a validator", index, devnet, node.GetSpec().GetRole())
+	}
+
+	report, err := f.client.DiagnoseConsensus(ctx, &v1.DiagnoseConsensusRequest{DevnetName: devnet, Namespace: namespace})
+	if err != nil {
+		return nil, nil, err
+	}
+	return node, report, nil
+}
+
+// waitForActiveSet polls the consensus validators until the named one is in
+// the active set (active) or out of it (!active).
+func (f *validatorFaults) waitForActiveSet(ctx context.Context, namespace, devnet, name string, active bool) error {
+	ticker := time.NewTicker(f.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// The devnet may report errors while a validator is down
+		report, err := f.client.DiagnoseConsensus(ctx, &v1.DiagnoseConsensusRequest{DevnetName: devnet, Namespace: namespace})
+		if err == nil && (findConsensusValidator(report.Validators, name) != nil) == active {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForTx polls a transaction until it is confirmed, returning an error if
+// it fails.
+func (f *validatorFaults) waitForTx(ctx context.Context, name string) (*v1.Transaction, error) {
+	ticker := time.NewTicker(f.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		tx, err := f.client.GetTransaction(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		switch tx.Phase {
+		case "Confirmed":
+			return tx, nil
+		case "Failed":
+			return tx, fmt.Errorf("failed: %s", tx.Error)
+		}
+
+		select {
+		case <-ctx.Done():
+			return tx, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// findConsensusValidator returns the validator of the named devnet node, or
+// nil if it is not in the active set.
+func findConsensusValidator(validators []*v1.ConsensusValidator, name string) *v1.ConsensusValidator {
+	for _, v := range validators {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+func totalVotingPower(validators []*v1.ConsensusValidator) int64 {
+	var total int64
+	for _, v := range validators {
+		total += v.VotingPower
+	}
+	return total
+}
+
+// haltsWithout reports whether the chain keeps less than the two thirds of
+// the voting power it needs to produce blocks without the target.
+func haltsWithout(validators []*v1.ConsensusValidator, target *v1.ConsensusValidator) bool {
+	total := totalVotingPower(validators)
+	return total > 0 && 3*(total-target.VotingPower) <= 2*total
+}
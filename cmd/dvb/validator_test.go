@@ -0,0 +1,228 @@
+// cmd/dvb/validator_test.go
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+// fakeValidators runs a devnet of equal validators. A stopped validator
+// leaves the active set after jailAfterPolls consensus polls; a confirmed
+// unjail transaction brings it back. Injected double-sign evidence
+// tombstones a validator at once.
+type fakeValidators struct {
+	validators     int
+	phases         map[int]string
+	jailed         map[int]bool
+	jailAfterPolls int
+	downPolls      int
+	txPhase        string
+	submitted      []string
+	doubleSigned   []int32
+}
+
+func newFakeValidators(n int) *fakeValidators {
+	phases := make(map[int]string, n)
+	for i := 0; i < n; i++ {
+		phases[i] = "Running"
+	}
+	return &fakeValidators{validators: n, phases: phases, jailed: map[int]bool{}, jailAfterPolls: 2, txPhase: "Confirmed"}
+}
+
+func (f *fakeValidators) GetDevnet(_ context.Context, _, _ string) (*v1.Devnet, error) {
+	return &v1.Devnet{Status: &v1.DevnetStatus{Phase: "Running"}}, nil
+}
+
+func (f *fakeValidators) GetNode(_ context.Context, _, _ string, index int) (*v1.Node, error) {
+	return &v1.Node{
+		Metadata: &v1.NodeMetadata{Index: int32(index)},
+		Spec:     &v1.NodeSpec{Role: "validator"},
+		Status:   &v1.NodeStatus{Phase: f.phases[index]},
+	}, nil
+}
+
+func (f *fakeValidators) StartNode(_ context.Context, _, _ string, index int) (*v1.Node, error) {
+	f.phases[index] = "Running"
+	return f.GetNode(context.Background(), "", "", index)
+}
+
+func (f *fakeValidators) StopNode(_ context.Context, _, _ string, index int) (*v1.Node, error) {
+	f.phases[index] = "Stopped"
+	return f.GetNode(context.Background(), "", "", index)
+}
+
+func (f *fakeValidators) DiagnoseConsensus(_ context.Context, _ *v1.DiagnoseConsensusRequest) (*v1.DiagnoseConsensusResponse, error) {
+	resp := &v1.DiagnoseConsensusResponse{}
+	for i := 0; i < f.validators; i++ {
+		if f.phases[i] == "Stopped" {
+			if f.downPolls++; f.downPolls > f.jailAfterPolls {
+				f.jailed[i] = true
+			}
+		}
+		if f.jailed[i] {
+			continue
+		}
+		resp.Validators = append(resp.Validators, &v1.ConsensusValidator{Name: fmt.Sprintf("validator-%d", i), VotingPower: 10})
+	}
+	return resp, nil
+}
+
+func (f *fakeValidators) GetModuleParams(_ context.Context, _, _, _ string) ([]byte, error) {
+	return []byte(`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000"}`), nil
+}
+
+func (f *fakeValidators) SubmitTransaction(_ context.Context, _, txType, signer string, _ []byte) (*v1.Transaction, error) {
+	f.submitted = append(f.submitted, txType+" "+signer)
+	return &v1.Transaction{Name: "tx-1"}, nil
+}
+
+func (f *fakeValidators) GetTransaction(_ context.Context, name string) (*v1.Transaction, error) {
+	if f.txPhase == "Confirmed" {
+		for i := range f.jailed {
+			delete(f.jailed, i)
+		}
+	}
+	return &v1.Transaction{Name: name, Phase: f.txPhase, Error: "validator still jailed"}, nil
+}
+
+func (f *fakeValidators) InjectDoubleSign(_ context.Context, req *v1.InjectDoubleSignRequest) (*v1.InjectDoubleSignResponse, error) {
+	f.doubleSigned = append(f.doubleSigned, req.ValidatorIndex)
+	f.jailed[int(req.ValidatorIndex)] = true
+	return &v1.InjectDoubleSignResponse{Height: 42, ValidatorAddress: "A1B2", EvidenceHash: "E3F4"}, nil
+}
+
+func TestValidatorJailAndUnjail(t *testing.T) {
+	f := newFakeValidators(4)
+	var out bytes.Buffer
+	faults := &validatorFaults{client: f, out: &out, pollInterval: time.Millisecond}
+
+	if err := faults.jail(context.Background(), "default", "devnet", 1); err != nil {
+		t.Fatalf("jail() error = %v", err)
+	}
+	if !f.jailed[1] || f.phases[1] != "Running" {
+		t.Errorf("validator 1 jailed = %v, phase = %s; want jailed and running again", f.jailed[1], f.phases[1])
+	}
+	for _, want := range []string{"misses 51 of 100 blocks", "0.01 of its stake", "after 10m0s"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+
+	if err := faults.jail(context.Background(), "default", "devnet", 1); err == nil || !strings.Contains(err.Error(), "not in the active set") {
+		t.Errorf("jail() of a jailed validator error = %v", err)
+	}
+
+	if err := faults.unjail(context.Background(), "default", "devnet", 1); err != nil {
+		t.Fatalf("unjail() error = %v", err)
+	}
+	if len(f.submitted) != 1 || f.submitted[0] != "slashing/unjail validator:1" {
+		t.Errorf("submitted = %v", f.submitted)
+	}
+	if err := faults.unjail(context.Background(), "default", "devnet", 1); err == nil || !strings.Contains(err.Error(), "not jailed") {
+		t.Errorf("unjail() of an active validator error = %v", err)
+	}
+}
+
+func TestValidatorJailRefusesToHaltChain(t *testing.T) {
+	f := newFakeValidators(2)
+	faults := &validatorFaults{client: f, out: &bytes.Buffer{}, pollInterval: time.Millisecond}
+
+	err := faults.jail(context.Background(), "default", "devnet", 0)
+	if err == nil || !strings.Contains(err.Error(), "halt the chain") {
+		t.Fatalf("jail() error = %v, want halt refusal", err)
+	}
+	if f.phases[0] != "Running" {
+		t.Error("node should not be stopped")
+	}
+}
+
+func TestValidatorJailRestartsNodeOnTimeout(t *testing.T) {
+	f := newFakeValidators(4)
+	f.jailAfterPolls = 1 << 30
+	faults := &validatorFaults{client: f, out: &bytes.Buffer{}, pollInterval: time.Millisecond}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := faults.jail(ctx, "default", "devnet", 2); err == nil {
+		t.Fatal("jail() should fail when the validator is not jailed in time")
+	}
+	if f.phases[2] != "Running" {
+		t.Error("node should be started again after the timeout")
+	}
+}
+
+func TestValidatorUnjailFailure(t *testing.T) {
+	f := newFakeValidators(4)
+	f.jailed[3] = true
+	f.txPhase = "Failed"
+	faults := &validatorFaults{client: f, out: &bytes.Buffer{}, pollInterval: time.Millisecond}
+
+	err := faults.unjail(context.Background(), "default", "devnet", 3)
+	if err == nil || !strings.Contains(err.Error(), "validator still jailed") {
+		t.Fatalf("unjail() error = %v", err)
+	}
+}
+
+func TestParseValidatorArgs(t *testing.T) {
+	devnet, index, err := parseValidatorArgs([]string{"my-devnet", "2"})
+	if err != nil || devnet != "my-devnet" || index != 2 {
+		t.Errorf("parseValidatorArgs() = %q, %d, %v", devnet, index, err)
+	}
+	devnet, index, err = parseValidatorArgs([]string{"3"})
+	if err != nil || devnet != "" || index != 3 {
+		t.Errorf("parseValidatorArgs() = %q, %d, %v", devnet, index, err)
+	}
+	for _, bad := range []string{"validator-1", "-1"} {
+		if _, _, err := parseValidatorArgs([]string{"my-devnet", bad}); err == nil {
+			t.Errorf("parseValidatorArgs(%q) should fail", bad)
+		}
+	}
+}
+
+func TestValidatorDoubleSign(t *testing.T) {
+	f := newFakeValidators(4)
+	var out bytes.Buffer
+	faults := &validatorFaults{client: f, out: &out, pollInterval: time.Millisecond}
+
+	if err := faults.doubleSign(context.Background(), "default", "devnet", 2); err != nil {
+		t.Fatalf("doubleSign() error = %v", err)
+	}
+	if len(f.doubleSigned) != 1 || f.doubleSigned[0] != 2 {
+		t.Errorf("double-signed = %v, want [2]", f.doubleSigned)
+	}
+	for _, want := range []string{"evidence E3F4", "height 42", "0.05 of its stake", "never"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+
+	if err := faults.doubleSign(context.Background(), "default", "devnet", 2); err == nil || !strings.Contains(err.Error(), "not in the active set") {
+		t.Errorf("doubleSign() of a tombstoned validator error = %v", err)
+	}
+
+	halting := newFakeValidators(2)
+	faults.client = halting
+	if err := faults.doubleSign(context.Background(), "default", "devnet", 0); err == nil || !strings.Contains(err.Error(), "halt the chain") {
+		t.Errorf("doubleSign() error = %v, want halt refusal", err)
+	}
+	if len(halting.doubleSigned) != 0 {
+		t.Error("no evidence should be injected")
+	}
+}
+
+func TestValidatorDoubleSignNeedsDestructiveFlag(t *testing.T) {
+	cmd := newValidatorDoubleSignCmd()
+	cmd.SetArgs([]string{"devnet", "1"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--i-know-this-is-destructive") {
+		t.Errorf("Execute() error = %v, want destructive flag refusal", err)
+	}
+}
@@ -0,0 +1,243 @@
+// cmd/dvb/wait.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// waitClient is the subset of the daemon client used to poll devnet
+// milestones.
+type waitClient interface {
+	GetDevnet(ctx context.Context, namespace, name string) (*v1.Devnet, error)
+	ListNodes(ctx context.Context, namespace, devnetName string) ([]*v1.Node, error)
+	GetUpgrade(ctx context.Context, namespace, name string) (*v1.Upgrade, error)
+}
+
+// Kinds of wait conditions.
+const (
+	waitHeight         = "height"
+	waitCondition      = "condition"
+	waitUpgradeApplied = "upgrade-applied"
+)
+
+// waitFor is a milestone to wait for, parsed from --for <kind>=<value>.
+type waitFor struct {
+	kind  string
+	value string
+	// height is the parsed value of a height condition.
+	height int64
+}
+
+func (w waitFor) String() string {
+	return w.kind + "=" + w.value
+}
+
+// parseWaitFor parses a --for value: height=<n>, condition=<phase or
+// condition type> or upgrade-applied=<upgrade>.
+func parseWaitFor(s string) (waitFor, error) {
+	kind, value, ok := strings.Cut(s, "=")
+	if !ok || value == "" {
+		return waitFor{}, fmt.Errorf("invalid --for %q: expected <kind>=<value>", s)
+	}
+	w := waitFor{kind: kind, value: value}
+	switch kind {
+	case waitHeight:
+		height, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || height <= 0 {
+			return waitFor{}, fmt.Errorf("invalid --for %q: height must be a positive integer", s)
+		}
+		w.height = height
+	case waitCondition, waitUpgradeApplied:
+	default:
+		return waitFor{}, fmt.Errorf("invalid --for %q: kind must be height, condition or upgrade-applied", s)
+	}
+	return w, nil
+}
+
+// check reports whether the milestone is reached and describes the current
+// state. It returns an error once the milestone can no longer be reached.
+func (w waitFor) check(ctx context.Context, c waitClient, namespace, devnetName string) (bool, string, error) {
+	switch w.kind {
+	case waitHeight:
+		nodes, err := c.ListNodes(ctx, namespace, devnetName)
+		if err != nil {
+			return false, "", err
+		}
+		var height int64
+		for _, n := range nodes {
+			height = max(height, n.GetStatus().GetBlockHeight())
+		}
+		return height >= w.height, fmt.Sprintf("height %d", height), nil
+
+	case waitCondition:
+		devnet, err := c.GetDevnet(ctx, namespace, devnetName)
+		if err != nil {
+			return false, "", err
+		}
+		phase := devnet.GetStatus().GetPhase()
+		if strings.EqualFold(phase, w.value) {
+			return true, "phase " + phase, nil
+		}
+		for _, cond := range devnet.GetStatus().GetConditions() {
+			if strings.EqualFold(cond.Type, w.value) && cond.Status == "True" {
+				return true, fmt.Sprintf("condition %s is True", cond.Type), nil
+			}
+		}
+		return false, "phase " + phase, nil
+
+	case waitUpgradeApplied:
+		upgrade, err := c.GetUpgrade(ctx, namespace, w.value)
+		if err != nil {
+			return false, "", err
+		}
+		if ref := upgrade.GetSpec().GetDevnetRef(); ref != devnetName {
+			return false, "", fmt.Errorf("upgrade %q targets devnet %q, not %q", w.value, ref, devnetName)
+		}
+		s := upgrade.GetStatus()
+		switch s.GetPhase() {
+		case types.UpgradePhaseCompleted:
+			return true, "upgrade " + s.GetPhase(), nil
+		case types.UpgradePhaseFailed:
+			return false, "", fmt.Errorf("upgrade %q failed: %s", w.value, s.GetError())
+		}
+		return false, "upgrade " + s.GetPhase(), nil
+	}
+	return false, "", fmt.Errorf("unknown wait condition %q", w.kind)
+}
+
+// waitForAll polls until every milestone is reached, printing each one as
+// it is reached to out. On timeout the error names the milestones still
+// pending and their last state.
+func waitForAll(ctx context.Context, c waitClient, namespace, devnetName string, conds []waitFor, pollInterval time.Duration, out io.Writer) error {
+	pending := append([]waitFor(nil), conds...)
+	states := make(map[string]string)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		remaining := pending[:0]
+		for _, cond := range pending {
+			met, state, err := cond.check(ctx, c, namespace, devnetName)
+			if err != nil {
+				if ctx.Err() == nil {
+					return err
+				}
+				// Timed out mid-check; reported below
+				remaining = append(remaining, cond)
+				continue
+			}
+			states[cond.String()] = state
+			if met {
+				fmt.Fprintf(out, "  %s reached (%s)\n", cond, state)
+				continue
+			}
+			remaining = append(remaining, cond)
+		}
+		pending = remaining
+		if len(pending) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			waiting := make([]string, 0, len(pending))
+			for _, cond := range pending {
+				if state := states[cond.String()]; state != "" {
+					waiting = append(waiting, fmt.Sprintf("%s (at %s)", cond, state))
+				} else {
+					waiting = append(waiting, cond.String())
+				}
+			}
+			return apierror.Errorf(apierror.HealthTimeout, "timed out waiting for %s", strings.Join(waiting, ", "))
+		case <-ticker.C:
+		}
+	}
+}
+
+func newWaitCmd() *cobra.Command {
+	var (
+		namespace string
+		forFlags  []string
+		timeout   time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "wait [devnet] --for <kind>=<value>",
+		Short: "Wait for a devnet milestone",
+		Long: `Block until a devnet reaches a milestone, for CI scripts that would otherwise
+poll the RPC in a loop. Exits non-zero if --timeout passes first, or when
+the milestone can no longer be reached, like a failed upgrade.
+
+Milestones:
+  height=<n>               A node reports block height n or later
+  condition=<name>         The devnet is in phase <name> (Running, Stopped, ...)
+                           or its condition <name> is True
+  upgrade-applied=<name>   The upgrade <name> of the devnet completed
+
+--for may be repeated to wait for all of the milestones.
+
+Examples:
+  # Wait for block 12345
+  dvb wait my-devnet --for height=12345 --timeout 10m
+
+  # Wait for the devnet to run, then for the v2 upgrade
+  dvb wait --for condition=Running --for upgrade-applied=v2-upgrade`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeDevnetNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(forFlags) == 0 {
+				return fmt.Errorf("--for is required")
+			}
+			conds := make([]waitFor, 0, len(forFlags))
+			for _, f := range forFlags {
+				cond, err := parseWaitFor(f)
+				if err != nil {
+					return err
+				}
+				conds = append(conds, cond)
+			}
+
+			if err := requireDaemon(); err != nil {
+				return err
+			}
+
+			var explicitDevnet string
+			if len(args) > 0 {
+				explicitDevnet = args[0]
+			}
+
+			ns, devnetName, err := resolveWithSuggestions(explicitDevnet, namespace)
+			if err != nil {
+				return err
+			}
+
+			printContextHeader(explicitDevnet, currentContext)
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+			if err := waitForAll(ctx, daemonClient, ns, devnetName, conds, 2*time.Second, os.Stdout); err != nil {
+				return err
+			}
+			color.Green("✓ Devnet %q reached %s", devnetName, strings.Join(forFlags, ", "))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace (defaults to server default)")
+	cmd.Flags().StringArrayVar(&forFlags, "for", nil, "Milestone to wait for: height=<n>, condition=<name> or upgrade-applied=<name> (repeatable)")
+	cmd.Flags().DurationVar(&timeout, "timeout", 10*time.Minute, "How long to wait")
+
+	return cmd
+}
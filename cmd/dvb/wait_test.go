@@ -0,0 +1,107 @@
+// cmd/dvb/wait_test.go
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+// fakeWaitClient reports a devnet whose height grows by 10 per poll.
+type fakeWaitClient struct {
+	height  int64
+	phase   string
+	upgrade *v1.Upgrade
+}
+
+func (f *fakeWaitClient) GetDevnet(ctx context.Context, namespace, name string) (*v1.Devnet, error) {
+	return &v1.Devnet{Status: &v1.DevnetStatus{
+		Phase:      f.phase,
+		Conditions: []*v1.Condition{{Type: "Ready", Status: "True"}},
+	}}, nil
+}
+
+func (f *fakeWaitClient) ListNodes(ctx context.Context, namespace, devnetName string) ([]*v1.Node, error) {
+	f.height += 10
+	return []*v1.Node{
+		{Status: &v1.NodeStatus{BlockHeight: f.height - 1}},
+		{Status: &v1.NodeStatus{BlockHeight: f.height}},
+	}, nil
+}
+
+func (f *fakeWaitClient) GetUpgrade(ctx context.Context, namespace, name string) (*v1.Upgrade, error) {
+	return f.upgrade, nil
+}
+
+func mustParseWaitFor(t *testing.T, s string) waitFor {
+	t.Helper()
+	w, err := parseWaitFor(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+func TestParseWaitFor(t *testing.T) {
+	for _, valid := range []string{"height=12345", "condition=Running", "upgrade-applied=v2"} {
+		if _, err := parseWaitFor(valid); err != nil {
+			t.Errorf("parseWaitFor(%q): %v", valid, err)
+		}
+	}
+	for _, invalid := range []string{"height", "height=", "height=-1", "height=abc", "phase=Running"} {
+		if _, err := parseWaitFor(invalid); err == nil {
+			t.Errorf("parseWaitFor(%q) succeeded", invalid)
+		}
+	}
+}
+
+func TestWaitForAll(t *testing.T) {
+	c := &fakeWaitClient{phase: "Running", upgrade: &v1.Upgrade{
+		Spec:   &v1.UpgradeSpec{DevnetRef: "mydevnet"},
+		Status: &v1.UpgradeStatus{Phase: "Completed"},
+	}}
+	conds := []waitFor{
+		mustParseWaitFor(t, "height=35"),
+		mustParseWaitFor(t, "condition=running"),
+		mustParseWaitFor(t, "condition=Ready"),
+		mustParseWaitFor(t, "upgrade-applied=v2"),
+	}
+
+	var out bytes.Buffer
+	if err := waitForAll(context.Background(), c, "default", "mydevnet", conds, time.Millisecond, &out); err != nil {
+		t.Fatalf("waitForAll: %v", err)
+	}
+	if c.height != 40 {
+		t.Errorf("polled to height %d, want 40", c.height)
+	}
+	if !strings.Contains(out.String(), "height=35 reached (height 40)") {
+		t.Errorf("output:\n%s", out.String())
+	}
+}
+
+func TestWaitForAll_Timeout(t *testing.T) {
+	c := &fakeWaitClient{phase: "Provisioning"}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := waitForAll(ctx, c, "default", "mydevnet", []waitFor{mustParseWaitFor(t, "condition=Running")}, time.Millisecond, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "condition=Running (at phase Provisioning)") {
+		t.Errorf("err = %v, want a timeout naming the last phase", err)
+	}
+}
+
+func TestWaitForAll_UpgradeFailed(t *testing.T) {
+	c := &fakeWaitClient{upgrade: &v1.Upgrade{
+		Spec:   &v1.UpgradeSpec{DevnetRef: "mydevnet"},
+		Status: &v1.UpgradeStatus{Phase: "Failed", Error: "binary not found"},
+	}}
+
+	err := waitForAll(context.Background(), c, "default", "mydevnet", []waitFor{mustParseWaitFor(t, "upgrade-applied=v2")}, time.Millisecond, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "binary not found") {
+		t.Errorf("err = %v, want the upgrade failure", err)
+	}
+}
@@ -0,0 +1,289 @@
+// Package apierror defines the stable error codes shared by devnetd and dvb.
+//
+// devnetd attaches a code to every gRPC error as an ErrorInfo status detail
+// (domain "devnet-builder"). dvb keeps the code when it unwraps the status,
+// exits with the code's exit status and reports it as errorCode in JSON
+// result events, so scripts can branch on the class of a failure instead of
+// matching error messages.
+package apierror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain is the ErrorInfo domain of devnetd errors.
+const Domain = "devnet-builder"
+
+// Code is a stable error class. Codes are part of the API: never rename one.
+type Code string
+
+const (
+	// Internal is an unexpected failure, or one with no better code.
+	Internal Code = "Internal"
+	// InvalidArgument is a malformed request, flag or spec.
+	InvalidArgument Code = "InvalidArgument"
+	// NotFound is a devnet, node, upgrade or other resource that does not
+	// exist.
+	NotFound Code = "NotFound"
+	// AlreadyExists is a resource created under a name that is taken.
+	AlreadyExists Code = "AlreadyExists"
+	// FailedPrecondition is a request the resource's state does not allow,
+	// such as starting a running node.
+	FailedPrecondition Code = "FailedPrecondition"
+	// PluginMissing is a network plugin that is not installed or could not
+	// be loaded.
+	PluginMissing Code = "PluginMissing"
+	// BuildFailed is a failed binary or image build, or a binary that could
+	// not be resolved.
+	BuildFailed Code = "BuildFailed"
+	// SnapshotFailed is a snapshot that could not be downloaded, extracted
+	// or exported for a genesis fork.
+	SnapshotFailed Code = "SnapshotFailed"
+	// ProvisionFailed is any other failure to provision a devnet.
+	ProvisionFailed Code = "ProvisionFailed"
+	// HealthTimeout is a devnet or node that did not become healthy, or
+	// reach the awaited state, in time.
+	HealthTimeout Code = "HealthTimeout"
+	// Timeout is a request that did not complete in time.
+	Timeout Code = "Timeout"
+	// QuotaExceeded is a namespace quota that the request would exceed.
+	QuotaExceeded Code = "QuotaExceeded"
+	// Unauthenticated is a missing or invalid API key.
+	Unauthenticated Code = "Unauthenticated"
+	// PermissionDenied is an API key not allowed to make the request.
+	PermissionDenied Code = "PermissionDenied"
+	// Unavailable is a daemon that cannot be reached, or a daemon feature
+	// that is not configured.
+	Unavailable Code = "Unavailable"
+)
+
+// exitCodes are the dvb exit statuses of each code. Like the codes, they
+// are part of the API. 2, 3 and 4 match the usage, provisioning and timeout
+// statuses of 'dvb ci up'.
+var exitCodes = map[Code]int{
+	Internal:           1,
+	InvalidArgument:    2,
+	ProvisionFailed:    3,
+	Timeout:            4,
+	HealthTimeout:      5,
+	NotFound:           6,
+	AlreadyExists:      7,
+	FailedPrecondition: 8,
+	PluginMissing:      9,
+	BuildFailed:        10,
+	SnapshotFailed:     11,
+	QuotaExceeded:      12,
+	Unauthenticated:    13,
+	PermissionDenied:   14,
+	Unavailable:        15,
+}
+
+// grpcCodes are the gRPC status codes each code is sent with.
+var grpcCodes = map[Code]codes.Code{
+	Internal:           codes.Internal,
+	InvalidArgument:    codes.InvalidArgument,
+	NotFound:           codes.NotFound,
+	AlreadyExists:      codes.AlreadyExists,
+	FailedPrecondition: codes.FailedPrecondition,
+	PluginMissing:      codes.FailedPrecondition,
+	BuildFailed:        codes.Internal,
+	SnapshotFailed:     codes.Internal,
+	ProvisionFailed:    codes.Internal,
+	HealthTimeout:      codes.DeadlineExceeded,
+	Timeout:            codes.DeadlineExceeded,
+	QuotaExceeded:      codes.ResourceExhausted,
+	Unauthenticated:    codes.Unauthenticated,
+	PermissionDenied:   codes.PermissionDenied,
+	Unavailable:        codes.Unavailable,
+}
+
+// Codes returns every code, ordered by exit status.
+func Codes() []Code {
+	return []Code{
+		Internal, InvalidArgument, ProvisionFailed, Timeout, HealthTimeout,
+		NotFound, AlreadyExists, FailedPrecondition, PluginMissing,
+		BuildFailed, SnapshotFailed, QuotaExceeded, Unauthenticated,
+		PermissionDenied, Unavailable,
+	}
+}
+
+// ExitStatus returns the dvb exit status for the code.
+func (c Code) ExitStatus() int {
+	if s, ok := exitCodes[c]; ok {
+		return s
+	}
+	return 1
+}
+
+// GRPCCode returns the gRPC status code the code is sent with.
+func (c Code) GRPCCode() codes.Code {
+	if gc, ok := grpcCodes[c]; ok {
+		return gc
+	}
+	return codes.Internal
+}
+
+// Error is an error with a code.
+type Error struct {
+	Code    Code
+	Message string
+}
+
+// Errorf returns an error with code and a formatted message.
+func Errorf(code Code, format string, args ...any) error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *Error) Error() string { return e.Message }
+
+// GRPCStatus lets gRPC handlers return an *Error: the status has the code's
+// gRPC status code and carries the code as an ErrorInfo detail.
+func (e *Error) GRPCStatus() *status.Status {
+	return withInfo(status.New(e.Code.GRPCCode(), e.Message), e.Code)
+}
+
+// withInfo attaches code to st as an ErrorInfo detail.
+func withInfo(st *status.Status, code Code) *status.Status {
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: string(code), Domain: Domain})
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// FromStatus returns the code of a gRPC status: the code in its ErrorInfo
+// detail, or else the one closest to its status code.
+func FromStatus(st *status.Status) Code {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Domain == Domain && info.Reason != "" {
+			return Code(info.Reason)
+		}
+	}
+	return fromGRPCCode(st.Code())
+}
+
+func fromGRPCCode(c codes.Code) Code {
+	switch c {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return InvalidArgument
+	case codes.NotFound:
+		return NotFound
+	case codes.AlreadyExists:
+		return AlreadyExists
+	case codes.FailedPrecondition, codes.Aborted:
+		return FailedPrecondition
+	case codes.DeadlineExceeded:
+		return Timeout
+	case codes.ResourceExhausted:
+		return QuotaExceeded
+	case codes.Unauthenticated:
+		return Unauthenticated
+	case codes.PermissionDenied:
+		return PermissionDenied
+	case codes.Unavailable, codes.Unimplemented:
+		return Unavailable
+	default:
+		return Internal
+	}
+}
+
+// CodeOf returns the code of err, or "" if err is nil or has none.
+func CodeOf(err error) Code {
+	if err == nil {
+		return ""
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return Timeout
+	}
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		return FromStatus(st)
+	}
+	return ""
+}
+
+// ExitCoder is an error that chooses its own exit status.
+type ExitCoder interface {
+	ExitCode() int
+}
+
+// ExitCode returns the status dvb exits with for err: the status chosen by
+// an ExitCoder in its chain, else the status of its code, else 1.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var ec ExitCoder
+	if errors.As(err, &ec) {
+		return ec.ExitCode()
+	}
+	return CodeOf(err).ExitStatus()
+}
+
+// FromConditionReason returns the code of a devnet that failed with a
+// condition reason, such as the reason of its Degraded condition.
+func FromConditionReason(reason string) Code {
+	switch reason {
+	case types.ReasonPluginNotFound:
+		return PluginMissing
+	case types.ReasonBinaryNotFound, types.ReasonBuildFailed:
+		return BuildFailed
+	case types.ReasonSnapshotFailed:
+		return SnapshotFailed
+	case types.ReasonHealthCheckFailed, types.ReasonNodesCrashed, types.ReasonNodesNotReady:
+		return HealthTimeout
+	default:
+		return ProvisionFailed
+	}
+}
+
+// UnaryServerInterceptor gives every error a unary handler returns an
+// ErrorInfo detail with its code.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		return resp, withCode(err)
+	}
+}
+
+// StreamServerInterceptor gives every error a stream handler returns an
+// ErrorInfo detail with its code.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return withCode(handler(srv, ss))
+	}
+}
+
+// withCode converts err to a status carrying its code, keeping the status
+// code and message of a status error.
+func withCode(err error) error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return e.GRPCStatus().Err()
+	}
+	st := status.Convert(err)
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Domain == Domain {
+			return err
+		}
+	}
+	code := CodeOf(err)
+	if code == "" {
+		code = Internal
+	}
+	return withInfo(st, code).Err()
+}
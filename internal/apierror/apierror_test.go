@@ -0,0 +1,105 @@
+package apierror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCodes_ExitStatusesAreUnique(t *testing.T) {
+	seen := make(map[int]Code)
+	for _, c := range Codes() {
+		s, ok := exitCodes[c]
+		require.True(t, ok, "%s has no exit status", c)
+		if prev, dup := seen[s]; dup {
+			t.Errorf("%s and %s share exit status %d", prev, c, s)
+		}
+		seen[s] = c
+		_, ok = grpcCodes[c]
+		assert.True(t, ok, "%s has no gRPC code", c)
+	}
+	assert.Len(t, exitCodes, len(Codes()))
+}
+
+func TestError_GRPCStatus(t *testing.T) {
+	err := Errorf(BuildFailed, "build failed: %s", "exit status 2")
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Internal, st.Code())
+	assert.Equal(t, "build failed: exit status 2", st.Message())
+	assert.Equal(t, BuildFailed, FromStatus(st))
+}
+
+func TestCodeOf(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want Code
+	}{
+		{"nil", nil, ""},
+		{"plain", errors.New("boom"), ""},
+		{"coded", Errorf(PluginMissing, "no plugin"), PluginMissing},
+		{"wrapped", fmt.Errorf("provision: %w", Errorf(SnapshotFailed, "404")), SnapshotFailed},
+		{"status", status.Error(codes.AlreadyExists, "taken"), AlreadyExists},
+		{"deadline", fmt.Errorf("wait: %w", context.DeadlineExceeded), Timeout},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CodeOf(tt.err))
+		})
+	}
+}
+
+type exitCoder struct{ error }
+
+func (exitCoder) ExitCode() int { return 130 }
+
+func TestExitCode(t *testing.T) {
+	assert.Equal(t, 0, ExitCode(nil))
+	assert.Equal(t, 1, ExitCode(errors.New("boom")))
+	assert.Equal(t, 6, ExitCode(Errorf(NotFound, "devnet %q not found", "x")))
+	assert.Equal(t, 5, ExitCode(Errorf(HealthTimeout, "timed out")))
+	// An ExitCoder wins over the code it wraps
+	assert.Equal(t, 130, ExitCode(exitCoder{Errorf(NotFound, "gone")}))
+}
+
+func TestWithCode(t *testing.T) {
+	// Plain status errors get a code from their status code
+	err := withCode(status.Error(codes.ResourceExhausted, "quota exceeded"))
+	st := status.Convert(err)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	assert.Len(t, st.Details(), 1)
+	assert.Equal(t, QuotaExceeded, FromStatus(st))
+
+	// Plain errors are Internal
+	assert.Equal(t, Internal, FromStatus(status.Convert(withCode(errors.New("boom")))))
+
+	// Coded errors keep their code, even wrapped
+	err = withCode(fmt.Errorf("fixtures: %w", Errorf(PluginMissing, "network %q not found", "gaia")))
+	st = status.Convert(err)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Equal(t, PluginMissing, FromStatus(st))
+
+	// Errors that already carry a code are left alone
+	coded := Errorf(BuildFailed, "build failed").(*Error).GRPCStatus().Err()
+	assert.Equal(t, coded, withCode(coded))
+
+	assert.NoError(t, withCode(nil))
+}
+
+func TestFromConditionReason(t *testing.T) {
+	assert.Equal(t, PluginMissing, FromConditionReason(types.ReasonPluginNotFound))
+	assert.Equal(t, BuildFailed, FromConditionReason(types.ReasonBinaryNotFound))
+	assert.Equal(t, SnapshotFailed, FromConditionReason(types.ReasonSnapshotFailed))
+	assert.Equal(t, HealthTimeout, FromConditionReason(types.ReasonHealthCheckFailed))
+	assert.Equal(t, ProvisionFailed, FromConditionReason(types.ReasonContainerFailed))
+	assert.Equal(t, ProvisionFailed, FromConditionReason(""))
+}
@@ -0,0 +1,49 @@
+//go:build windows
+
+package devnet
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+// killProcess terminates the process and waits for it to exit. Windows has
+// no SIGTERM for processes outside our console group, so this is not
+// graceful.
+func killProcess(pid int, timeout time.Duration) error {
+	if err := forceKillProcess(pid); err != nil {
+		return err
+	}
+
+	h, err := windows.OpenProcess(windows.SYNCHRONIZE, false, uint32(pid))
+	if err != nil {
+		// Process is already gone
+		return nil
+	}
+	defer windows.CloseHandle(h)
+
+	event, err := windows.WaitForSingleObject(h, uint32(timeout.Milliseconds()))
+	if err != nil {
+		return fmt.Errorf("failed to wait for process %d: %w", pid, err)
+	}
+	if event != windows.WAIT_OBJECT_0 {
+		return fmt.Errorf("process %d did not exit within timeout", pid)
+	}
+	return nil
+}
+
+// forceKillProcess immediately terminates a process.
+func forceKillProcess(pid int) error {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		// Process might already be dead
+		return nil
+	}
+	if err := proc.Kill(); err != nil && err != os.ErrProcessDone {
+		return fmt.Errorf("failed to kill process %d: %w", pid, err)
+	}
+	return nil
+}
@@ -254,14 +254,9 @@ func (uc *ProvisionUseCase) Execute(ctx context.Context, input dto.ProvisionInpu
 }
 
 func (uc *ProvisionUseCase) downloadAndExtractSnapshot(ctx context.Context, input dto.ProvisionInput, snapshotURL string) error {
-	// Download snapshot
-	snapshotPath := fmt.Sprintf("%s/snapshot.tar.gz", input.HomeDir)
-	if err := uc.snapshotSvc.Download(ctx, snapshotURL, snapshotPath); err != nil {
-		return err
-	}
-
-	// Extract snapshot
-	return uc.snapshotSvc.Extract(ctx, snapshotPath, input.HomeDir)
+	// Stream the snapshot straight into the home directory without keeping the archive
+	_, err := uc.snapshotSvc.DownloadAndExtract(ctx, snapshotURL, "", input.HomeDir, true, nil)
+	return err
 }
 
 // extractChainID extracts the chain_id from genesis JSON.
@@ -3,7 +3,6 @@ package devnet
 import (
 	"context"
 	"fmt"
-	"syscall"
 	"time"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/application/dto"
@@ -280,42 +279,3 @@ func (uc *StopUseCase) Execute(ctx context.Context, input dto.StopInput) (*dto.S
 		Warnings:     warnings,
 	}, nil
 }
-
-// killProcess sends SIGTERM and waits for process to exit gracefully.
-func killProcess(pid int, timeout time.Duration) error {
-	// Send SIGTERM for graceful shutdown
-	if err := syscall.Kill(pid, syscall.SIGTERM); err != nil {
-		// Process might already be dead
-		if err == syscall.ESRCH {
-			return nil
-		}
-		return fmt.Errorf("failed to send SIGTERM: %w", err)
-	}
-
-	// Wait for process to exit
-	deadline := time.Now().Add(timeout)
-	for time.Now().Before(deadline) {
-		// Check if process is still running
-		if err := syscall.Kill(pid, 0); err != nil {
-			// Process is dead
-			if err == syscall.ESRCH {
-				return nil
-			}
-		}
-		time.Sleep(100 * time.Millisecond)
-	}
-
-	return fmt.Errorf("process %d did not exit within timeout", pid)
-}
-
-// forceKillProcess sends SIGKILL to immediately terminate a process.
-func forceKillProcess(pid int) error {
-	if err := syscall.Kill(pid, syscall.SIGKILL); err != nil {
-		// Process might already be dead
-		if err == syscall.ESRCH {
-			return nil
-		}
-		return fmt.Errorf("failed to send SIGKILL: %w", err)
-	}
-	return nil
-}
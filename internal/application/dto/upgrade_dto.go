@@ -26,6 +26,42 @@ type ProposeOutput struct {
 	VotingEndTime time.Time
 }
 
+// UpgradePlanInput contains the input for planning an upgrade without
+// executing it.
+type UpgradePlanInput struct {
+	UpgradeName        string
+	Mode               types.ExecutionMode
+	TargetBinary       string // Local binary path
+	TargetImage        string // Docker image
+	TargetVersion      string // Version string
+	BuildRequired      bool   // TargetVersion is built from source when the upgrade runs
+	VotingPeriod       time.Duration
+	VotingPeriodSource string // "chain", "forced" or "fallback"
+	HeightBuffer       int    // 0 for auto-calculate
+	NumValidators      int
+	SkipGovernance     bool
+}
+
+// UpgradePlanStep is one expected stage of an upgrade.
+type UpgradePlanStep struct {
+	Stage  string
+	Height int64         // Expected block height (0 if not tied to a height)
+	Offset time.Duration // Expected time after the upgrade starts
+}
+
+// UpgradePlanOutput contains the predicted course of an upgrade.
+type UpgradePlanOutput struct {
+	CurrentHeight     int64
+	BlockTime         time.Duration
+	BlockTimeMeasured bool // false if the default block time was assumed
+	VotingBlocks      int64
+	HeightBuffer      int64
+	HeightBufferAuto  bool
+	UpgradeHeight     int64 // 0 with --skip-gov
+	Timeline          []UpgradePlanStep
+	EstimatedDuration time.Duration
+}
+
 // VoteInput contains the input for voting on a proposal.
 type VoteInput struct {
 	HomeDir    string
@@ -171,6 +171,15 @@ type SnapshotFetcher interface {
 	//   - err: Any error that occurred
 	DownloadWithProgress(ctx context.Context, url, cacheKey string, noCache bool, progress ProgressReporter) (path string, fromCache bool, err error)
 
+	// DownloadAndExtract downloads a snapshot and extracts it into destPath in
+	// one pass, extracting while the download streams in. A valid cached
+	// snapshot is extracted instead of downloaded; otherwise the archive is
+	// cached under cacheKey as it streams, unless noCache is set.
+	// Returns:
+	//   - fromCache: True if the snapshot was served from cache
+	//   - err: Any error that occurred
+	DownloadAndExtract(ctx context.Context, url, cacheKey, destPath string, noCache bool, progress ProgressReporter) (fromCache bool, err error)
+
 	// Extract extracts a compressed snapshot.
 	Extract(ctx context.Context, archivePath, destPath string) error
 
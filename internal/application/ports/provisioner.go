@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/plugin/types"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
 )
 
 // =============================================================================
@@ -32,6 +33,16 @@ type GenesisForker interface {
 	Fork(ctx context.Context, opts ForkOptions, progress ProgressReporter) (*ForkResult, error)
 }
 
+// FileGenesisForker is implemented by genesis forkers that can write the
+// forked genesis straight to a file. Mainnet exports run to several GB, so
+// callers that only need the genesis on disk should prefer ForkToFile over
+// Fork.
+type FileGenesisForker interface {
+	// ForkToFile forks genesis like Fork, writing it to path. The returned
+	// ForkResult has no Genesis bytes.
+	ForkToFile(ctx context.Context, opts ForkOptions, path string, progress ProgressReporter) (*ForkResult, error)
+}
+
 // ForkOptions specifies options for forking genesis.
 type ForkOptions struct {
 	// Source specifies where to get genesis from (RPC, snapshot, or local file)
@@ -45,11 +56,15 @@ type ForkOptions struct {
 
 	// NoCache skips caching when true
 	NoCache bool
+
+	// Offline restricts genesis sources to local caches and files
+	Offline bool
 }
 
 // ForkResult contains the result of a genesis fork operation.
 type ForkResult struct {
-	// Genesis is the forked and patched genesis JSON bytes
+	// Genesis is the forked and patched genesis JSON bytes. It is empty
+	// when the genesis was written to a file by ForkToFile.
 	Genesis []byte
 
 	// SourceChainID is the original chain ID from the source genesis
@@ -157,6 +172,9 @@ type ProvisionOptions struct {
 	// DevnetName is the unique name for this devnet
 	DevnetName string
 
+	// Namespace is the devnet's namespace
+	Namespace string
+
 	// ChainID for the devnet
 	ChainID string
 
@@ -197,6 +215,176 @@ type ProvisionOptions struct {
 	// When set, nodes bind to 127.0.{Subnet}.{nodeIndex+1} instead of 0.0.0.0.
 	// This enables multiple devnets to coexist on the same host without port conflicts.
 	Subnet uint8
+
+	// PortLayout sets the ports each node listens on. The zero layout
+	// gives every node the default ports.
+	PortLayout dvbtypes.PortLayout
+
+	// BindAddress is the IP address every node listens on instead of its
+	// subnet address (e.g. "0.0.0.0" or "::"). Nodes are then reached on
+	// dvbtypes.DialHost(BindAddress), separated by PortLayout's stride.
+	BindAddress string
+
+	// HostAddresses are the addresses of the remote hosts nodes run on,
+	// node i on HostAddresses[i % len(HostAddresses)]. Remote nodes listen
+	// on all interfaces and are reached on their host's address, taking
+	// precedence over Subnet and BindAddress.
+	HostAddresses []string
+
+	// DockerNetwork runs the nodes in containers on the devnet's own docker
+	// network: they listen on all interfaces at the default ports and dial
+	// each other at their DNS names (see types.NodeSpec.DNSName in the
+	// daemon).
+	DockerNetwork bool
+
+	// Profile is an optional provisioning profile name (e.g., "laptop") that
+	// tunes per-node config for lower resource usage.
+	Profile string
+
+	// ForceBuild compiles the binary from source even if a published
+	// release binary matches BinaryVersion.
+	ForceBuild bool
+
+	// AllowUnverified uses a release binary even if its release publishes
+	// no checksum; otherwise the binary is built from source.
+	AllowUnverified bool
+
+	// Offline restricts provisioning to local caches: cached binaries,
+	// snapshots and genesis files. Anything missing fails fast.
+	Offline bool
+
+	// SkipDiskCheck continues provisioning when the disk space preflight
+	// estimates the disk is too small, logging a warning instead.
+	SkipDiskCheck bool
+
+	// GenesisOverrides maps dotted genesis paths to JSON-encoded values,
+	// merged into the final genesis after all plugin patches.
+	GenesisOverrides map[string]string
+
+	// FundedAccounts are added to the auth and bank genesis state before
+	// GenesisOverrides are applied.
+	FundedAccounts []FundedAccount
+
+	// ValidatorKeys are operator-provided keys for validator slots. The
+	// orchestrator installs them before init, so only the other slots get
+	// generated keys.
+	ValidatorKeys []ValidatorKeys
+
+	// RemoteSigners are the indexes of the validators that sign through a
+	// remote signer; their nodes listen for it on priv_validator_laddr.
+	RemoteSigners []int
+
+	// NodeConfigs are raw config.toml and app.toml snippets merged into
+	// individual nodes' config files after all other config changes.
+	NodeConfigs []NodeConfig
+
+	// BlockTime is every node's consensus timeout_commit (e.g., "500ms").
+	// Empty keeps the devnet default of 1s.
+	BlockTime string
+
+	// EpochDuration replaces the duration of every epochs module epoch in
+	// genesis. Zero keeps the genesis durations.
+	EpochDuration time.Duration
+
+	// Seed, when set, derives the operator and test-account keys and every
+	// node's consensus and p2p keys (see keys.ForSeed). Imported
+	// ValidatorKeys still take precedence.
+	Seed string
+
+	// NumAccounts is the number of deterministic test accounts to fund in
+	// genesis with the bond denom.
+	NumAccounts int
+
+	// PowerProfile splits the validators' stake in a forked genesis:
+	// "equal" (or empty), "proportional" to the source chain's largest
+	// bonded validators, or "custom" by PowerWeights. See genesispatch.
+	PowerProfile string
+
+	// PowerWeights is the relative power of each validator for the custom
+	// profile.
+	PowerWeights []float64
+
+	// Hooks are user commands the orchestrator runs between phases.
+	Hooks []Hook
+}
+
+// Hook is a user command run at a point of provisioning.
+type Hook struct {
+	// Phase is when the hook runs: "postFork", "preStart" or "postHealthy".
+	Phase string
+
+	// Name identifies the hook in logs and errors.
+	Name string
+
+	// Command is a shell command, run with sh -c. Ignored if Script is set.
+	Command string
+
+	// Script is the path of an executable script.
+	Script string
+
+	// Image runs the hook in a container of this image instead of on the
+	// host.
+	Image string
+
+	// Timeout bounds the hook's run.
+	Timeout time.Duration
+
+	// IgnoreFailure carries on when the hook fails instead of failing
+	// provisioning.
+	IgnoreFailure bool
+}
+
+// ValidatorKeys are the keys a validator slot reuses instead of generating
+// its own.
+type ValidatorKeys struct {
+	// Index is the validator's index, from 0.
+	Index int
+
+	// PrivValidatorKey is the contents of priv_validator_key.json. Empty
+	// means generate one.
+	PrivValidatorKey string
+
+	// NodeKey is the contents of node_key.json. Empty means generate one.
+	NodeKey string
+}
+
+// NodeConfig holds TOML snippets deep-merged into one node's config files.
+type NodeConfig struct {
+	// Index is the node's index, from 0: validators, then full nodes.
+	Index int
+
+	// ConfigToml is merged into config.toml. Empty leaves it as is.
+	ConfigToml string
+
+	// AppToml is merged into app.toml. Empty leaves it as is.
+	AppToml string
+}
+
+// FundedAccount is an account pre-funded in genesis.
+type FundedAccount struct {
+	// Address is a bech32 address, or a 0x address for EVM chains.
+	Address string
+
+	// Coins is the balance, e.g. "1000000ustake,500uatom".
+	Coins string
+
+	// Vesting optionally locks part of the balance.
+	Vesting *VestingSchedule
+}
+
+// VestingSchedule locks part of a funded account's balance.
+type VestingSchedule struct {
+	// Type is "continuous" or "delayed".
+	Type string
+
+	// Coins is the vesting portion of the balance. Empty means all of it.
+	Coins string
+
+	// StartTime is when continuous vesting begins.
+	StartTime time.Time
+
+	// EndTime is when vesting completes.
+	EndTime time.Time
 }
 
 // ProvisionResult contains the result of a full provisioning operation.
@@ -0,0 +1,102 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+)
+
+// calibrationBlocks is how many blocks pass between block time samples
+// while waiting for the upgrade height.
+const calibrationBlocks = 10
+
+// heightCalibrator re-samples the block time while the chain approaches the
+// upgrade height. The height was predicted from a single sample before the
+// proposal was submitted; if the chain has since sped up it reaches the
+// height before the voting period ends, the plan cannot be scheduled in the
+// past, and the chain never halts. The calibrator warns as soon as that is
+// predicted, and fails the wait once the proposal did not pass.
+type heightCalibrator struct {
+	rpcClient  ports.RPCClient
+	logger     ports.Logger
+	proposalID uint64
+
+	// votingEnd is when the proposal's voting period ends; zero once the
+	// proposal passed, or when it is unknown.
+	votingEnd time.Time
+
+	// sampledAt is the height of the last block time sample.
+	sampledAt int64
+	warned    bool
+}
+
+// newHeightCalibrator looks up the voting period end of a proposal. Without
+// a proposal, or when it cannot be read, the calibrator does nothing.
+func newHeightCalibrator(ctx context.Context, rpcClient ports.RPCClient, logger ports.Logger, proposalID uint64) *heightCalibrator {
+	c := &heightCalibrator{rpcClient: rpcClient, logger: logger, proposalID: proposalID}
+	if proposalID == 0 {
+		return c
+	}
+	proposal, err := rpcClient.GetProposal(ctx, proposalID)
+	if err != nil {
+		logger.Debug("Could not read proposal %d, not calibrating the upgrade height: %v", proposalID, err)
+		return c
+	}
+	if proposal.VotingEndTime.After(proposal.SubmitTime) {
+		c.votingEnd = proposal.VotingEndTime
+	}
+	return c
+}
+
+// check re-samples the block time every calibrationBlocks blocks during the
+// voting period, and reads the proposal's outcome once it ended. It returns
+// an error when the proposal did not pass, since the chain will not halt.
+func (c *heightCalibrator) check(ctx context.Context, currentHeight, targetHeight int64) error {
+	if c.votingEnd.IsZero() {
+		return nil
+	}
+
+	now := time.Now()
+	if !now.Before(c.votingEnd) {
+		proposal, err := c.rpcClient.GetProposal(ctx, c.proposalID)
+		if err != nil {
+			c.logger.Debug("Could not read proposal %d: %v", c.proposalID, err)
+			return nil
+		}
+		switch proposal.Status {
+		case ports.ProposalStatusPassed:
+			c.votingEnd = time.Time{}
+		case ports.ProposalStatusRejected, ports.ProposalStatusFailed:
+			return fmt.Errorf("proposal %d did not pass (%s), the chain will not halt at height %d; "+
+				"if the height was reached before voting ended, rerun with a larger --height-buffer",
+				c.proposalID, proposal.Status, targetHeight)
+		}
+		return nil
+	}
+
+	if currentHeight-c.sampledAt < calibrationBlocks {
+		return nil
+	}
+	c.sampledAt = currentHeight
+	blockTime, err := c.rpcClient.GetBlockTime(ctx, calibrationBlocks)
+	if err != nil || blockTime <= 0 {
+		return nil
+	}
+
+	reachedAt := now.Add(time.Duration(targetHeight-currentHeight) * blockTime)
+	c.logger.Debug("Calibrated block time %.2fs: height %d predicted at %s, voting ends at %s",
+		blockTime.Seconds(), targetHeight, reachedAt.Format(time.TimeOnly), c.votingEnd.Format(time.TimeOnly))
+	if c.warned || !reachedAt.Before(c.votingEnd) {
+		return nil
+	}
+	c.warned = true
+	short := int64(c.votingEnd.Sub(reachedAt)/blockTime) + 1
+	fmt.Fprint(c.logger.Writer(), "\n")
+	c.logger.Warn("Blocks now take %.2fs: height %d will be reached around %s, before voting ends at %s. "+
+		"The upgrade cannot be scheduled in the past, so the chain may not halt; "+
+		"it needs about %d more blocks of --height-buffer.",
+		blockTime.Seconds(), targetHeight, reachedAt.Format(time.TimeOnly), c.votingEnd.Format(time.TimeOnly), short)
+	return nil
+}
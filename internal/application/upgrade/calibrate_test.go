@@ -0,0 +1,121 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+)
+
+// fakeRPCClient serves a proposal and a block time; other methods are not
+// used by the calibrator.
+type fakeRPCClient struct {
+	ports.RPCClient
+	proposal  ports.Proposal
+	blockTime time.Duration
+	samples   int
+}
+
+func (f *fakeRPCClient) GetProposal(ctx context.Context, id uint64) (*ports.Proposal, error) {
+	p := f.proposal
+	return &p, nil
+}
+
+func (f *fakeRPCClient) GetBlockTime(ctx context.Context, sampleSize int) (time.Duration, error) {
+	f.samples++
+	return f.blockTime, nil
+}
+
+// warnLogger records warnings.
+type warnLogger struct {
+	warnings []string
+}
+
+func (l *warnLogger) Debug(format string, args ...interface{})   {}
+func (l *warnLogger) Info(format string, args ...interface{})    {}
+func (l *warnLogger) Error(format string, args ...interface{})   {}
+func (l *warnLogger) Success(format string, args ...interface{}) {}
+func (l *warnLogger) Print(format string, args ...interface{})   {}
+func (l *warnLogger) Println(format string, args ...interface{}) {}
+func (l *warnLogger) Writer() io.Writer                          { return io.Discard }
+func (l *warnLogger) ErrWriter() io.Writer                       { return io.Discard }
+func (l *warnLogger) IsVerbose() bool                            { return false }
+func (l *warnLogger) SetVerbose(verbose bool)                    {}
+func (l *warnLogger) Warn(format string, args ...interface{}) {
+	l.warnings = append(l.warnings, fmt.Sprintf(format, args...))
+}
+
+func TestHeightCalibratorWarnsWhenHeightComesEarly(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	rpc := &fakeRPCClient{
+		proposal:  ports.Proposal{Status: ports.ProposalStatusVoting, SubmitTime: now, VotingEndTime: now.Add(60 * time.Second)},
+		blockTime: 2 * time.Second,
+	}
+	logger := &warnLogger{}
+	c := newHeightCalibrator(ctx, rpc, logger, 1)
+
+	// 40 blocks of 2s take 80s, after the voting period ends
+	if err := c.check(ctx, 100, 140); err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if len(logger.warnings) != 0 {
+		t.Fatalf("unexpected warning: %v", logger.warnings)
+	}
+
+	// Only re-sampled after calibrationBlocks blocks
+	rpc.blockTime = 500 * time.Millisecond
+	if err := c.check(ctx, 105, 140); err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if rpc.samples != 1 {
+		t.Fatalf("sampled %d times, want 1", rpc.samples)
+	}
+
+	// 30 blocks of 0.5s take 15s, well before the voting period ends
+	if err := c.check(ctx, 110, 140); err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if len(logger.warnings) != 1 || !strings.Contains(logger.warnings[0], "before voting ends") {
+		t.Fatalf("warnings = %v, want one early height warning", logger.warnings)
+	}
+
+	// Warned once
+	if err := c.check(ctx, 120, 140); err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if len(logger.warnings) != 1 {
+		t.Fatalf("warned %d times, want 1", len(logger.warnings))
+	}
+}
+
+func TestHeightCalibratorFailsWhenProposalDidNotPass(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	rpc := &fakeRPCClient{
+		proposal: ports.Proposal{Status: ports.ProposalStatusVoting, SubmitTime: now.Add(-time.Minute), VotingEndTime: now.Add(-time.Second)},
+	}
+	c := newHeightCalibrator(ctx, rpc, &warnLogger{}, 1)
+
+	if err := c.check(ctx, 100, 140); err != nil {
+		t.Fatalf("check while tallying: %v", err)
+	}
+
+	rpc.proposal.Status = ports.ProposalStatusFailed
+	if err := c.check(ctx, 101, 140); err == nil {
+		t.Fatal("expected an error for a failed proposal")
+	}
+
+	rpc.proposal.Status = ports.ProposalStatusPassed
+	c = newHeightCalibrator(ctx, rpc, &warnLogger{}, 1)
+	if err := c.check(ctx, 100, 140); err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if !c.votingEnd.IsZero() {
+		t.Error("expected calibration to stop once the proposal passed")
+	}
+}
@@ -172,7 +172,7 @@ func (uc *ExecuteUpgradeUseCase) executeWithGov(ctx context.Context, input dto.E
 
 	// Step 3: Wait for upgrade height
 	uc.logger.Info("Step 3/5: Waiting for upgrade height %d...", proposeResult.UpgradeHeight)
-	if err := uc.waitForUpgradeHeight(ctx, proposeResult.UpgradeHeight); err != nil {
+	if err := uc.waitForUpgradeHeight(ctx, proposeResult.UpgradeHeight, proposeResult.ProposalID); err != nil {
 		output.Error = err
 		return output, err
 	}
@@ -269,7 +269,13 @@ func (uc *ExecuteUpgradeUseCase) updateCurrentVersion(ctx context.Context, homeD
 	return nil
 }
 
-func (uc *ExecuteUpgradeUseCase) waitForUpgradeHeight(ctx context.Context, targetHeight int64) error {
+// waitForUpgradeHeight waits until the chain reaches the upgrade height,
+// re-calibrating the block time during the proposal's voting period.
+func (uc *ExecuteUpgradeUseCase) waitForUpgradeHeight(ctx context.Context, targetHeight int64, proposalID uint64) error {
+	rpcCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	calibrator := newHeightCalibrator(rpcCtx, uc.rpcClient, uc.logger, proposalID)
+	cancel()
+
 	var (
 		lastHeight     int64
 		lastUpdateTime time.Time
@@ -296,7 +302,7 @@ func (uc *ExecuteUpgradeUseCase) waitForUpgradeHeight(ctx context.Context, targe
 		}
 
 		// Use timeout context for RPC call to prevent indefinite blocking
-		rpcCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		rpcCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
 		currentHeight, err := uc.rpcClient.GetBlockHeight(rpcCtx)
 		cancel()
 
@@ -317,6 +323,15 @@ func (uc *ExecuteUpgradeUseCase) waitForUpgradeHeight(ctx context.Context, targe
 			return nil
 		}
 
+		// Re-sample the block time and warn if the height comes too early
+		rpcCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+		err = calibrator.check(rpcCtx, currentHeight, targetHeight)
+		cancel()
+		if err != nil {
+			fmt.Fprint(uc.logger.Writer(), "\n")
+			return err
+		}
+
 		// Calculate progress metrics
 		remaining := targetHeight - currentHeight
 		progress := int(float64(currentHeight) / float64(targetHeight) * 100)
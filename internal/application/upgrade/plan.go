@@ -0,0 +1,117 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/application/dto"
+	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+)
+
+// defaultBlockTime is assumed when the block time cannot be estimated.
+const defaultBlockTime = 2 * time.Second
+
+// PlanUseCase predicts the course of an upgrade without submitting anything:
+// the upgrade height the proposal would use and when each stage is expected.
+type PlanUseCase struct {
+	rpcClient ports.RPCClient
+	logger    ports.Logger
+}
+
+// NewPlanUseCase creates a new PlanUseCase.
+func NewPlanUseCase(rpcClient ports.RPCClient, logger ports.Logger) *PlanUseCase {
+	return &PlanUseCase{
+		rpcClient: rpcClient,
+		logger:    logger,
+	}
+}
+
+// Execute computes the upgrade plan. It uses the same height calculation as
+// ProposeUseCase, so the predicted height matches what a real run proposes
+// (give or take the blocks produced in between).
+func (uc *PlanUseCase) Execute(ctx context.Context, input dto.UpgradePlanInput) (*dto.UpgradePlanOutput, error) {
+	currentHeight, err := uc.rpcClient.GetBlockHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current height: %w", err)
+	}
+
+	plan := &dto.UpgradePlanOutput{
+		CurrentHeight: currentHeight,
+		BlockTime:     defaultBlockTime,
+	}
+	if blockTime, err := uc.rpcClient.GetBlockTime(ctx, 5); err == nil && blockTime > 0 {
+		plan.BlockTime = blockTime
+		plan.BlockTimeMeasured = true
+	} else {
+		uc.logger.Debug("Could not estimate block time, assuming %s", defaultBlockTime)
+	}
+
+	if input.BuildRequired {
+		plan.Timeline = append(plan.Timeline, dto.UpgradePlanStep{
+			Stage: fmt.Sprintf("Build %s from source", input.TargetVersion),
+		})
+	}
+
+	if input.SkipGovernance {
+		plan.Timeline = append(plan.Timeline,
+			dto.UpgradePlanStep{Stage: "Stop all nodes", Height: currentHeight},
+			dto.UpgradePlanStep{Stage: "Replace binary", Height: currentHeight},
+			dto.UpgradePlanStep{Stage: "Restart all nodes", Height: currentHeight},
+			dto.UpgradePlanStep{Stage: "Chain resumes", Height: currentHeight + 1, Offset: plan.BlockTime},
+		)
+		plan.EstimatedDuration = plan.BlockTime
+		return plan, nil
+	}
+
+	plan.VotingBlocks = int64(input.VotingPeriod / plan.BlockTime)
+	plan.HeightBuffer = int64(input.HeightBuffer)
+	if plan.HeightBuffer == 0 {
+		plan.HeightBuffer = autoHeightBuffer(currentHeight, plan.BlockTime)
+		plan.HeightBufferAuto = true
+	}
+	plan.UpgradeHeight = currentHeight + plan.VotingBlocks + plan.HeightBuffer
+
+	haltAt := time.Duration(plan.VotingBlocks+plan.HeightBuffer) * plan.BlockTime
+	plan.Timeline = append(plan.Timeline,
+		dto.UpgradePlanStep{Stage: "Submit upgrade proposal", Height: currentHeight},
+		dto.UpgradePlanStep{Stage: fmt.Sprintf("Vote YES from %d validator(s)", input.NumValidators), Height: currentHeight},
+		dto.UpgradePlanStep{Stage: "Voting period ends", Height: currentHeight + plan.VotingBlocks, Offset: input.VotingPeriod},
+		dto.UpgradePlanStep{Stage: "Chain halts at upgrade height", Height: plan.UpgradeHeight, Offset: haltAt},
+		dto.UpgradePlanStep{Stage: "Switch binary and restart nodes", Height: plan.UpgradeHeight, Offset: haltAt},
+		dto.UpgradePlanStep{Stage: "Chain resumes", Height: plan.UpgradeHeight + 1, Offset: haltAt + plan.BlockTime},
+	)
+	plan.EstimatedDuration = haltAt + plan.BlockTime
+
+	uc.logger.Debug("Upgrade plan: current=%d + voting=%d + buffer=%d = %d",
+		currentHeight, plan.VotingBlocks, plan.HeightBuffer, plan.UpgradeHeight)
+	return plan, nil
+}
+
+// autoHeightBuffer calculates the height buffer based on block time.
+// This ensures we have enough time for the upgrade regardless of block speed.
+// Formula: buffer = time_needed / block_time
+// where time_needed is approximately 80 seconds (enough time for validators to prepare).
+func autoHeightBuffer(currentHeight int64, blockTime time.Duration) int64 {
+	const (
+		defaultBuffer    = 40               // Default buffer when chain height is too low
+		minBuffer        = 10               // Minimum buffer regardless of block time
+		maxBuffer        = 200              // Cap at a reasonable maximum
+		targetBufferTime = 80 * time.Second // Target time buffer (~80 seconds)
+	)
+
+	// If current height is less than 5, use default buffer
+	if currentHeight < 5 {
+		return defaultBuffer
+	}
+
+	// Goal: Have at least targetBufferTime seconds before upgrade
+	buffer := int64(targetBufferTime / blockTime)
+	if buffer < minBuffer {
+		return minBuffer
+	}
+	if buffer > maxBuffer {
+		return maxBuffer
+	}
+	return buffer
+}
@@ -271,7 +271,7 @@ func (uc *ResumableExecuteUpgradeUseCase) executeWithGovResumable(
 		output.ProposalID = state.ProposalID
 		output.UpgradeHeight = state.UpgradeHeight
 
-		if err := uc.executeUC.waitForUpgradeHeight(ctx, state.UpgradeHeight); err != nil {
+		if err := uc.executeUC.waitForUpgradeHeight(ctx, state.UpgradeHeight, state.ProposalID); err != nil {
 			if saveErr := uc.transitionAndSave(ctx, state, ports.ResumableStageFailed, err.Error()); saveErr != nil {
 				uc.logger.Warn("Failed to save failed state: %v", saveErr)
 			}
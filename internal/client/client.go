@@ -4,6 +4,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
 )
@@ -113,6 +114,17 @@ func (c *Client) StartDevnet(ctx context.Context, namespace, name string) (*v1.D
 	return c.grpc.StartDevnet(ctx, namespace, name)
 }
 
+// ExtendDevnet pushes back the TTL expiry of a devnet by the given duration.
+func (c *Client) ExtendDevnet(ctx context.Context, namespace, name, by string) (*v1.Devnet, error) {
+	return c.grpc.ExtendDevnet(ctx, namespace, name, by)
+}
+
+// SetBlockTime changes the block time of a devnet and writes it to every
+// node's config, returning the running nodes that need a restart.
+func (c *Client) SetBlockTime(ctx context.Context, namespace, name, blockTime string) (*v1.SetBlockTimeResponse, error) {
+	return c.grpc.SetBlockTime(ctx, namespace, name, blockTime)
+}
+
 // StopDevnet stops a running devnet.
 func (c *Client) StopDevnet(ctx context.Context, namespace, name string) (*v1.Devnet, error) {
 	return c.grpc.StopDevnet(ctx, namespace, name)
@@ -198,6 +210,11 @@ func (c *Client) RetryUpgrade(ctx context.Context, namespace, name string) (*v1.
 	return c.grpc.RetryUpgrade(ctx, namespace, name)
 }
 
+// GetUpgradeReport retrieves the rehearsal report of a finished upgrade.
+func (c *Client) GetUpgradeReport(ctx context.Context, namespace, name string) (*v1.GetUpgradeReportResponse, error) {
+	return c.grpc.GetUpgradeReport(ctx, namespace, name)
+}
+
 // SubmitTransaction submits a new transaction.
 func (c *Client) SubmitTransaction(ctx context.Context, devnet, txType, signer string, payload []byte) (*v1.Transaction, error) {
 	return c.grpc.SubmitTransaction(ctx, devnet, txType, signer, payload)
@@ -224,8 +241,29 @@ func (c *Client) SubmitGovVote(ctx context.Context, devnet string, proposalID ui
 }
 
 // SubmitGovProposal submits a governance proposal.
-func (c *Client) SubmitGovProposal(ctx context.Context, devnet, proposer, proposalType, title, description string, content []byte) (*v1.Transaction, error) {
-	return c.grpc.SubmitGovProposal(ctx, devnet, proposer, proposalType, title, description, content)
+func (c *Client) SubmitGovProposal(ctx context.Context, devnet, proposer, proposalType, title, description, deposit string, content []byte) (*v1.Transaction, error) {
+	return c.grpc.SubmitGovProposal(ctx, devnet, proposer, proposalType, title, description, deposit, content)
+}
+
+// GetGovProposal queries a governance proposal. A zero ID returns the most
+// recently submitted proposal.
+func (c *Client) GetGovProposal(ctx context.Context, namespace, devnet string, proposalID uint64) (*v1.GovProposal, error) {
+	return c.grpc.GetGovProposal(ctx, namespace, devnet, proposalID)
+}
+
+// BuildParamChangeProposal builds a proposal that sets one module param.
+func (c *Client) BuildParamChangeProposal(ctx context.Context, namespace, devnet, module, key, value string) (*v1.BuildParamChangeProposalResponse, error) {
+	return c.grpc.BuildParamChangeProposal(ctx, namespace, devnet, module, key, value)
+}
+
+// GetModuleParams returns a module's current params as a JSON object.
+func (c *Client) GetModuleParams(ctx context.Context, namespace, devnet, module string) ([]byte, error) {
+	return c.grpc.GetModuleParams(ctx, namespace, devnet, module)
+}
+
+// TraceTransaction looks up and decodes an on-chain transaction by hash.
+func (c *Client) TraceTransaction(ctx context.Context, namespace, devnet, hash string, evmTrace bool) (*v1.TxTrace, error) {
+	return c.grpc.TraceTransaction(ctx, namespace, devnet, hash, evmTrace)
 }
 
 // StreamNodeLogs streams logs from a node, calling the callback for each log entry.
@@ -239,6 +277,12 @@ func (c *Client) StreamProvisionLogs(ctx context.Context, namespace, name string
 	return c.grpc.StreamProvisionLogs(ctx, namespace, name, callback)
 }
 
+// WatchDevnets streams the current devnets and nodes in a namespace (empty
+// for all), then their changes, until ctx is cancelled.
+func (c *Client) WatchDevnets(ctx context.Context, namespace string, callback func(*v1.WatchDevnetsResponse) error) error {
+	return c.grpc.WatchDevnets(ctx, namespace, callback)
+}
+
 // ListNetworks returns all registered network modules from the daemon.
 func (c *Client) ListNetworks(ctx context.Context) ([]*v1.NetworkSummary, error) {
 	return c.grpc.ListNetworks(ctx)
@@ -254,6 +298,147 @@ func (c *Client) ListBinaryVersions(ctx context.Context, networkName string, inc
 	return c.grpc.ListBinaryVersions(ctx, networkName, includePrerelease)
 }
 
+// InstallPlugin installs a network plugin from a GitHub release and loads it.
+func (c *Client) InstallPlugin(ctx context.Context, req *v1.InstallPluginRequest) (*v1.InstallPluginResponse, error) {
+	return c.grpc.InstallPlugin(ctx, req)
+}
+
+// Build builds a network binary or Docker image from a git ref.
+func (c *Client) Build(ctx context.Context, req *v1.BuildRequest) (*v1.BuildResponse, error) {
+	return c.grpc.Build(ctx, req)
+}
+
+// ExportFixtures generates signed transaction and query-response fixtures from a devnet.
+func (c *Client) ExportFixtures(ctx context.Context, req *v1.ExportFixturesRequest) (*v1.ExportFixturesResponse, error) {
+	return c.grpc.ExportFixtures(ctx, req)
+}
+
+// ExportKeys returns the deterministic validator and test-account keys of a devnet.
+func (c *Client) ExportKeys(ctx context.Context, req *v1.ExportKeysRequest) (*v1.ExportKeysResponse, error) {
+	return c.grpc.ExportKeys(ctx, req)
+}
+
+// DiffValidatorSets compares a devnet's validator sets at two heights.
+func (c *Client) DiffValidatorSets(ctx context.Context, req *v1.DiffValidatorSetsRequest) (*v1.DiffValidatorSetsResponse, error) {
+	return c.grpc.DiffValidatorSets(ctx, req)
+}
+
+// ListBlocks lists a devnet's most recent blocks.
+func (c *Client) ListBlocks(ctx context.Context, req *v1.ListBlocksRequest) (*v1.ListBlocksResponse, error) {
+	return c.grpc.ListBlocks(ctx, req)
+}
+
+// ListDevnetEvents returns a devnet's recorded event history.
+func (c *Client) ListDevnetEvents(ctx context.Context, req *v1.ListDevnetEventsRequest) ([]*v1.Event, error) {
+	return c.grpc.ListDevnetEvents(ctx, req)
+}
+
+// GetPeerMatrix returns the peer connectivity of a devnet's nodes.
+func (c *Client) GetPeerMatrix(ctx context.Context, req *v1.GetPeerMatrixRequest) (*v1.GetPeerMatrixResponse, error) {
+	return c.grpc.GetPeerMatrix(ctx, req)
+}
+
+// ShapeNetwork applies or clears a docker-mode devnet's network shaping.
+func (c *Client) ShapeNetwork(ctx context.Context, req *v1.ShapeNetworkRequest) (*v1.ShapeNetworkResponse, error) {
+	return c.grpc.ShapeNetwork(ctx, req)
+}
+
+// CheckImage compares a docker-mode devnet's pinned image digest with the
+// digest its tag resolves to now.
+func (c *Client) CheckImage(ctx context.Context, req *v1.CheckImageRequest) (*v1.CheckImageResponse, error) {
+	return c.grpc.CheckImage(ctx, req)
+}
+
+// PruneNodes prunes the application state of one node of a devnet, or of
+// all of them one at a time.
+func (c *Client) PruneNodes(ctx context.Context, req *v1.PruneNodesRequest) ([]*v1.PrunedNode, error) {
+	return c.grpc.PruneNodes(ctx, req)
+}
+
+// SearchLogs searches the node logs of a devnet, and the daemon log lines
+// about it, for a pattern.
+func (c *Client) SearchLogs(ctx context.Context, req *v1.SearchLogsRequest) (*v1.SearchLogsResponse, error) {
+	return c.grpc.SearchLogs(ctx, req)
+}
+
+// InjectDoubleSign makes a validator of a devnet double-sign and submits
+// the evidence. The validator is tombstoned.
+func (c *Client) InjectDoubleSign(ctx context.Context, req *v1.InjectDoubleSignRequest) (*v1.InjectDoubleSignResponse, error) {
+	return c.grpc.InjectDoubleSign(ctx, req)
+}
+
+// DiagnoseConsensus returns the consensus state of a devnet and likely
+// causes of a stall.
+func (c *Client) DiagnoseConsensus(ctx context.Context, req *v1.DiagnoseConsensusRequest) (*v1.DiagnoseConsensusResponse, error) {
+	return c.grpc.DiagnoseConsensus(ctx, req)
+}
+
+// CollectDebugBundle returns the redacted diagnostic files of a devnet.
+func (c *Client) CollectDebugBundle(ctx context.Context, req *v1.CollectDebugBundleRequest) ([]*v1.BundleFile, error) {
+	return c.grpc.CollectDebugBundle(ctx, req)
+}
+
+// ExportGenesis exports a devnet's state at a height as a genesis file.
+func (c *Client) ExportGenesis(ctx context.Context, req *v1.ExportGenesisRequest) (*v1.ExportGenesisResponse, error) {
+	return c.grpc.ExportGenesis(ctx, req)
+}
+
+// ExportDevnet writes a portable tar.zst archive of a devnet to w.
+func (c *Client) ExportDevnet(ctx context.Context, req *v1.ExportDevnetRequest, w io.Writer) error {
+	return c.grpc.ExportDevnet(ctx, req, w)
+}
+
+// ImportDevnet creates a devnet named name in namespace from an archive
+// written by ExportDevnet. An empty name keeps the archived name.
+func (c *Client) ImportDevnet(ctx context.Context, name, namespace string, r io.Reader) (*v1.ImportDevnetResponse, error) {
+	return c.grpc.ImportDevnet(ctx, name, namespace, r)
+}
+
+// ExportCompose renders a docker-mode devnet as a docker compose file.
+func (c *Client) ExportCompose(ctx context.Context, req *v1.ExportComposeRequest) (*v1.ExportComposeResponse, error) {
+	return c.grpc.ExportCompose(ctx, req)
+}
+
+// GetBlock returns a block with its transactions decoded.
+func (c *Client) GetBlock(ctx context.Context, req *v1.GetBlockRequest) (*v1.GetBlockResponse, error) {
+	return c.grpc.GetBlock(ctx, req)
+}
+
+// CreateNamespace creates a namespace with an optional quota.
+func (c *Client) CreateNamespace(ctx context.Context, req *v1.CreateNamespaceRequest) (*v1.Namespace, error) {
+	return c.grpc.CreateNamespace(ctx, req)
+}
+
+// ListNamespaces lists namespaces with their quota and usage.
+func (c *Client) ListNamespaces(ctx context.Context) ([]*v1.Namespace, error) {
+	return c.grpc.ListNamespaces(ctx)
+}
+
+// DeleteNamespace deletes an empty namespace.
+func (c *Client) DeleteNamespace(ctx context.Context, name string) error {
+	return c.grpc.DeleteNamespace(ctx, name)
+}
+
+// ApplyNodeConfig patches a node's config files, reloading or restarting it.
+func (c *Client) ApplyNodeConfig(ctx context.Context, req *v1.ApplyNodeConfigRequest) (*v1.ApplyNodeConfigResponse, error) {
+	return c.grpc.ApplyNodeConfig(ctx, req)
+}
+
+// SyncNodeConfig reports a node's config drift and optionally restores it.
+func (c *Client) SyncNodeConfig(ctx context.Context, req *v1.SyncNodeConfigRequest) (*v1.SyncNodeConfigResponse, error) {
+	return c.grpc.SyncNodeConfig(ctx, req)
+}
+
+// SetNodeBinary swaps one node's binary or image and restarts it.
+func (c *Client) SetNodeBinary(ctx context.Context, req *v1.SetNodeBinaryRequest) (*v1.Node, error) {
+	return c.grpc.SetNodeBinary(ctx, req)
+}
+
+// GetNodeConfig returns a node's effective config with the source of each value.
+func (c *Client) GetNodeConfig(ctx context.Context, namespace, devnetName string, index int) ([]*v1.NodeConfigField, error) {
+	return c.grpc.GetNodeConfig(ctx, namespace, devnetName, index)
+}
+
 // Ping tests connectivity to the server.
 func (c *Client) Ping(ctx context.Context) (*PingResponse, error) {
 	return c.grpc.Ping(ctx)
@@ -263,3 +448,14 @@ func (c *Client) Ping(ctx context.Context) (*PingResponse, error) {
 func (c *Client) WhoAmI(ctx context.Context) (*WhoAmIResponse, error) {
 	return c.grpc.WhoAmI(ctx)
 }
+
+// HandOffCredentials asks the daemon to fetch credentials from the socket
+// the client listens on.
+func (c *Client) HandOffCredentials(ctx context.Context, socketPath, nonce string) (string, error) {
+	return c.grpc.HandOffCredentials(ctx, socketPath, nonce)
+}
+
+// GetCredentialStatus returns the source of the daemon's GitHub token.
+func (c *Client) GetCredentialStatus(ctx context.Context) (string, error) {
+	return c.grpc.GetCredentialStatus(ctx)
+}
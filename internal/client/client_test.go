@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -93,6 +94,18 @@ func TestWrapGRPCError_NonGRPCError(t *testing.T) {
 	assert.Equal(t, regularErr, wrapped)
 }
 
+func TestWrapGRPCError_KeepsCode(t *testing.T) {
+	// A code sent by the daemon survives the rewording
+	sent := apierror.Errorf(apierror.PluginMissing, "network %q not found", "gaia").(*apierror.Error)
+	wrapped := wrapGRPCError(sent.GRPCStatus().Err())
+	assert.Equal(t, apierror.PluginMissing, apierror.CodeOf(wrapped))
+	assert.Equal(t, `FailedPrecondition: network "gaia" not found`, wrapped.Error())
+
+	// Without one, the status code decides
+	wrapped = wrapGRPCError(status.Error(codes.NotFound, "devnet not found"))
+	assert.Equal(t, apierror.NotFound, apierror.CodeOf(wrapped))
+}
+
 func TestWrapGRPCError_NilError(t *testing.T) {
 	// Note: gRPC's status.FromError(nil) returns codes.OK with empty message
 	// This is expected behavior - nil error becomes "OK: " when wrapped
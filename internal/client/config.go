@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 
 	"gopkg.in/yaml.v3"
 )
@@ -21,6 +22,10 @@ type ClientConfig struct {
 
 	// Namespace is the default namespace for commands.
 	Namespace string `yaml:"namespace,omitempty"`
+
+	// AutoStart starts devnetd in the background when dvb cannot reach the
+	// local daemon. Off by default.
+	AutoStart bool `yaml:"autostart,omitempty"`
 }
 
 // configFilePath returns the path to the config file (~/.dvb/config.yaml).
@@ -82,7 +87,7 @@ func (c *ClientConfig) Save() error {
 }
 
 // Get retrieves a configuration value by key.
-// Supported keys: "server", "api-key", "namespace".
+// Supported keys: "server", "api-key", "namespace", "autostart".
 func (c *ClientConfig) Get(key string) string {
 	switch key {
 	case "server":
@@ -91,14 +96,19 @@ func (c *ClientConfig) Get(key string) string {
 		return c.APIKey
 	case "namespace":
 		return c.Namespace
+	case "autostart":
+		if c.AutoStart {
+			return "true"
+		}
+		return ""
 	default:
 		return ""
 	}
 }
 
 // Set sets a configuration value by key.
-// Supported keys: "server", "api-key", "namespace".
-// Returns an error for unknown keys.
+// Supported keys: "server", "api-key", "namespace", "autostart".
+// Returns an error for unknown keys or a non-boolean autostart value.
 func (c *ClientConfig) Set(key, value string) error {
 	switch key {
 	case "server":
@@ -107,8 +117,14 @@ func (c *ClientConfig) Set(key, value string) error {
 		c.APIKey = value
 	case "namespace":
 		c.Namespace = value
+	case "autostart":
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid autostart value %q: must be true or false", value)
+		}
+		c.AutoStart = enabled
 	default:
-		return fmt.Errorf("unknown config key: %s (supported: server, api-key, namespace)", key)
+		return fmt.Errorf("unknown config key: %s (supported: server, api-key, namespace, autostart)", key)
 	}
 	return nil
 }
@@ -113,6 +113,15 @@ func TestClientConfig_Set(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "new-namespace", cfg.Namespace)
 
+	err = cfg.Set("autostart", "true")
+	require.NoError(t, err)
+	assert.True(t, cfg.AutoStart)
+	assert.Equal(t, "true", cfg.Get("autostart"))
+
+	err = cfg.Set("autostart", "sometimes")
+	assert.Error(t, err)
+	assert.True(t, cfg.AutoStart)
+
 	// Test unknown key
 	err = cfg.Set("unknown", "value")
 	assert.Error(t, err)
@@ -2,16 +2,23 @@
 package client
 
 import (
-	"net"
+	"context"
 	"os"
 	"path/filepath"
 	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/transport"
 )
 
-// DefaultSocketPath returns the default daemon socket path.
+// DefaultSocketPath returns the default daemon socket address:
+// $DEVNETD_SOCKET if set, otherwise the socket (or, on Windows, named pipe)
+// of a daemon using ~/.devnet-builder.
 func DefaultSocketPath() string {
+	if socket := os.Getenv("DEVNETD_SOCKET"); socket != "" {
+		return socket
+	}
 	home, _ := os.UserHomeDir()
-	return filepath.Join(home, ".devnet-builder", "devnetd.sock")
+	return transport.DefaultAddress(filepath.Join(home, ".devnet-builder"))
 }
 
 // IsDaemonRunning checks if the daemon is accessible.
@@ -19,9 +26,11 @@ func IsDaemonRunning() bool {
 	return IsDaemonRunningAt(DefaultSocketPath())
 }
 
-// IsDaemonRunningAt checks if the daemon is accessible at the given socket path.
+// IsDaemonRunningAt checks if the daemon is accessible at the given socket address.
 func IsDaemonRunningAt(socketPath string) bool {
-	conn, err := net.DialTimeout("unix", socketPath, 500*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	conn, err := transport.Dial(ctx, socketPath)
 	if err != nil {
 		return false
 	}
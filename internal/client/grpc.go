@@ -6,9 +6,12 @@ import (
 	"crypto/tls"
 	"fmt"
 	"io"
+	"net"
 	"time"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/transport"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
@@ -17,7 +20,7 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// GRPCClient wraps the gRPC DevnetServiceClient, NodeServiceClient, UpgradeServiceClient, TransactionServiceClient, NetworkServiceClient, and AuthServiceClient.
+// GRPCClient wraps the gRPC DevnetServiceClient, NodeServiceClient, UpgradeServiceClient, TransactionServiceClient, NetworkServiceClient, BuildServiceClient, AuthServiceClient, and NamespaceServiceClient.
 type GRPCClient struct {
 	conn        *grpc.ClientConn
 	devnet      v1.DevnetServiceClient
@@ -25,14 +28,18 @@ type GRPCClient struct {
 	upgrade     v1.UpgradeServiceClient
 	transaction v1.TransactionServiceClient
 	network     v1.NetworkServiceClient
+	build       v1.BuildServiceClient
 	auth        v1.AuthServiceClient
+	namespace   v1.NamespaceServiceClient
 }
 
-// NewGRPCClient creates a new gRPC client connected to the daemon via Unix socket.
+// NewGRPCClient creates a new gRPC client connected to the daemon's local
+// socket: a Unix socket, a Windows named pipe or a loopback tcp:// address.
 func NewGRPCClient(socketPath string) (*GRPCClient, error) {
-	// Connect via Unix socket
-	target := "unix://" + socketPath
-	conn, err := grpc.NewClient(target,
+	conn, err := grpc.NewClient("passthrough:///devnetd",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return transport.Dial(ctx, socketPath)
+		}),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
 	if err != nil {
@@ -46,7 +53,9 @@ func NewGRPCClient(socketPath string) (*GRPCClient, error) {
 		upgrade:     v1.NewUpgradeServiceClient(conn),
 		transaction: v1.NewTransactionServiceClient(conn),
 		network:     v1.NewNetworkServiceClient(conn),
+		build:       v1.NewBuildServiceClient(conn),
 		auth:        v1.NewAuthServiceClient(conn),
+		namespace:   v1.NewNamespaceServiceClient(conn),
 	}, nil
 }
 
@@ -84,7 +93,9 @@ func NewRemoteGRPCClient(server, apiKey string) (*GRPCClient, error) {
 		upgrade:     v1.NewUpgradeServiceClient(conn),
 		transaction: v1.NewTransactionServiceClient(conn),
 		network:     v1.NewNetworkServiceClient(conn),
+		build:       v1.NewBuildServiceClient(conn),
 		auth:        v1.NewAuthServiceClient(conn),
+		namespace:   v1.NewNamespaceServiceClient(conn),
 	}, nil
 }
 
@@ -190,6 +201,33 @@ func (c *GRPCClient) StopDevnet(ctx context.Context, namespace, name string) (*v
 	return resp.Devnet, nil
 }
 
+// ExtendDevnet pushes back the TTL expiry of a devnet by the given duration.
+func (c *GRPCClient) ExtendDevnet(ctx context.Context, namespace, name, by string) (*v1.Devnet, error) {
+	resp, err := c.devnet.ExtendDevnet(ctx, &v1.ExtendDevnetRequest{
+		Namespace: namespace,
+		Name:      name,
+		By:        by,
+	})
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp.Devnet, nil
+}
+
+// SetBlockTime changes the block time of a devnet and writes it to every
+// node's config.
+func (c *GRPCClient) SetBlockTime(ctx context.Context, namespace, name, blockTime string) (*v1.SetBlockTimeResponse, error) {
+	resp, err := c.devnet.SetBlockTime(ctx, &v1.SetBlockTimeRequest{
+		Namespace: namespace,
+		Name:      name,
+		BlockTime: blockTime,
+	})
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
 // ApplyDevnet creates or updates a devnet.
 func (c *GRPCClient) ApplyDevnet(ctx context.Context, namespace, name string, spec *v1.DevnetSpec, labels, annotations map[string]string) (*v1.ApplyDevnetResponse, error) {
 	resp, err := c.devnet.ApplyDevnet(ctx, &v1.ApplyDevnetRequest{
@@ -456,6 +494,18 @@ func (c *GRPCClient) RetryUpgrade(ctx context.Context, namespace, name string) (
 	return resp.Upgrade, nil
 }
 
+// GetUpgradeReport retrieves the rehearsal report of a finished upgrade.
+func (c *GRPCClient) GetUpgradeReport(ctx context.Context, namespace, name string) (*v1.GetUpgradeReportResponse, error) {
+	resp, err := c.upgrade.GetUpgradeReport(ctx, &v1.GetUpgradeReportRequest{
+		Namespace: namespace,
+		Name:      name,
+	})
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
 // SubmitTransaction submits a new transaction.
 func (c *GRPCClient) SubmitTransaction(ctx context.Context, devnet, txType, signer string, payload []byte) (*v1.Transaction, error) {
 	resp, err := c.transaction.SubmitTransaction(ctx, &v1.SubmitTransactionRequest{
@@ -517,13 +567,14 @@ func (c *GRPCClient) SubmitGovVote(ctx context.Context, devnet string, proposalI
 }
 
 // SubmitGovProposal submits a governance proposal.
-func (c *GRPCClient) SubmitGovProposal(ctx context.Context, devnet, proposer, proposalType, title, description string, content []byte) (*v1.Transaction, error) {
+func (c *GRPCClient) SubmitGovProposal(ctx context.Context, devnet, proposer, proposalType, title, description, deposit string, content []byte) (*v1.Transaction, error) {
 	resp, err := c.transaction.SubmitGovProposal(ctx, &v1.SubmitGovProposalRequest{
 		Devnet:       devnet,
 		Proposer:     proposer,
 		ProposalType: proposalType,
 		Title:        title,
 		Description:  description,
+		Deposit:      deposit,
 		Content:      content,
 	})
 	if err != nil {
@@ -532,6 +583,62 @@ func (c *GRPCClient) SubmitGovProposal(ctx context.Context, devnet, proposer, pr
 	return resp.Transaction, nil
 }
 
+// GetGovProposal queries a governance proposal. A zero ID returns the most
+// recently submitted proposal.
+func (c *GRPCClient) GetGovProposal(ctx context.Context, namespace, devnet string, proposalID uint64) (*v1.GovProposal, error) {
+	resp, err := c.transaction.GetGovProposal(ctx, &v1.GetGovProposalRequest{
+		Namespace:  namespace,
+		Devnet:     devnet,
+		ProposalId: proposalID,
+	})
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp.Proposal, nil
+}
+
+// BuildParamChangeProposal builds a proposal that sets one module param.
+func (c *GRPCClient) BuildParamChangeProposal(ctx context.Context, namespace, devnet, module, key, value string) (*v1.BuildParamChangeProposalResponse, error) {
+	resp, err := c.transaction.BuildParamChangeProposal(ctx, &v1.BuildParamChangeProposalRequest{
+		Namespace: namespace,
+		Devnet:    devnet,
+		Module:    module,
+		Key:       key,
+		Value:     value,
+	})
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// GetModuleParams returns a module's current params as a JSON object.
+func (c *GRPCClient) GetModuleParams(ctx context.Context, namespace, devnet, module string) ([]byte, error) {
+	resp, err := c.transaction.GetModuleParams(ctx, &v1.GetModuleParamsRequest{
+		Namespace: namespace,
+		Devnet:    devnet,
+		Module:    module,
+	})
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp.Params, nil
+}
+
+// TraceTransaction looks up and decodes an on-chain transaction by hash.
+func (c *GRPCClient) TraceTransaction(ctx context.Context, namespace, devnet, hash string, evmTrace bool) (*v1.TxTrace, error) {
+	resp, err := c.transaction.TraceTransaction(ctx, &v1.TraceTransactionRequest{
+		Namespace: namespace,
+		Devnet:    devnet,
+		Hash:      hash,
+		EvmTrace:  evmTrace,
+	})
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp.Trace, nil
+}
+
 // ListNetworks returns all registered network modules.
 func (c *GRPCClient) ListNetworks(ctx context.Context) ([]*v1.NetworkSummary, error) {
 	resp, err := c.network.ListNetworks(ctx, &v1.ListNetworksRequest{})
@@ -564,6 +671,309 @@ func (c *GRPCClient) ListBinaryVersions(ctx context.Context, networkName string,
 	return resp, nil
 }
 
+// InstallPlugin installs a network plugin from a GitHub release.
+func (c *GRPCClient) InstallPlugin(ctx context.Context, req *v1.InstallPluginRequest) (*v1.InstallPluginResponse, error) {
+	resp, err := c.network.InstallPlugin(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// Build builds a network binary or Docker image from a git ref.
+func (c *GRPCClient) Build(ctx context.Context, req *v1.BuildRequest) (*v1.BuildResponse, error) {
+	resp, err := c.build.Build(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// ExportFixtures generates signed transaction and query-response fixtures from a devnet.
+func (c *GRPCClient) ExportFixtures(ctx context.Context, req *v1.ExportFixturesRequest) (*v1.ExportFixturesResponse, error) {
+	resp, err := c.devnet.ExportFixtures(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// ExportKeys returns the deterministic validator and test-account keys of a devnet.
+func (c *GRPCClient) ExportKeys(ctx context.Context, req *v1.ExportKeysRequest) (*v1.ExportKeysResponse, error) {
+	resp, err := c.devnet.ExportKeys(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// DiffValidatorSets compares a devnet's validator sets at two heights.
+func (c *GRPCClient) DiffValidatorSets(ctx context.Context, req *v1.DiffValidatorSetsRequest) (*v1.DiffValidatorSetsResponse, error) {
+	resp, err := c.devnet.DiffValidatorSets(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// ListBlocks lists a devnet's most recent blocks.
+func (c *GRPCClient) ListBlocks(ctx context.Context, req *v1.ListBlocksRequest) (*v1.ListBlocksResponse, error) {
+	resp, err := c.devnet.ListBlocks(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// ListDevnetEvents returns a devnet's recorded event history.
+func (c *GRPCClient) ListDevnetEvents(ctx context.Context, req *v1.ListDevnetEventsRequest) ([]*v1.Event, error) {
+	resp, err := c.devnet.ListDevnetEvents(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp.Events, nil
+}
+
+// GetPeerMatrix returns the peer connectivity of a devnet's nodes.
+func (c *GRPCClient) GetPeerMatrix(ctx context.Context, req *v1.GetPeerMatrixRequest) (*v1.GetPeerMatrixResponse, error) {
+	resp, err := c.devnet.GetPeerMatrix(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// ShapeNetwork applies or clears a docker-mode devnet's network shaping.
+func (c *GRPCClient) ShapeNetwork(ctx context.Context, req *v1.ShapeNetworkRequest) (*v1.ShapeNetworkResponse, error) {
+	resp, err := c.devnet.ShapeNetwork(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// CheckImage compares a docker-mode devnet's pinned image digest with the
+// digest its tag resolves to now.
+func (c *GRPCClient) CheckImage(ctx context.Context, req *v1.CheckImageRequest) (*v1.CheckImageResponse, error) {
+	resp, err := c.devnet.CheckImage(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// PruneNodes prunes the application state of one node of a devnet, or of
+// all of them one at a time.
+func (c *GRPCClient) PruneNodes(ctx context.Context, req *v1.PruneNodesRequest) ([]*v1.PrunedNode, error) {
+	resp, err := c.devnet.PruneNodes(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp.Nodes, nil
+}
+
+// SearchLogs searches the node logs of a devnet, and the daemon log lines
+// about it, for a pattern.
+func (c *GRPCClient) SearchLogs(ctx context.Context, req *v1.SearchLogsRequest) (*v1.SearchLogsResponse, error) {
+	resp, err := c.devnet.SearchLogs(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// InjectDoubleSign makes a validator of a devnet double-sign and submits
+// the evidence. The validator is tombstoned.
+func (c *GRPCClient) InjectDoubleSign(ctx context.Context, req *v1.InjectDoubleSignRequest) (*v1.InjectDoubleSignResponse, error) {
+	resp, err := c.devnet.InjectDoubleSign(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// DiagnoseConsensus returns the consensus state of a devnet and likely
+// causes of a stall.
+func (c *GRPCClient) DiagnoseConsensus(ctx context.Context, req *v1.DiagnoseConsensusRequest) (*v1.DiagnoseConsensusResponse, error) {
+	resp, err := c.devnet.DiagnoseConsensus(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// maxBundleSize is the largest debug bundle accepted from the daemon; node
+// logs easily exceed the default 4MB message limit.
+const maxBundleSize = 256 << 20
+
+// CollectDebugBundle returns the redacted diagnostic files of a devnet.
+func (c *GRPCClient) CollectDebugBundle(ctx context.Context, req *v1.CollectDebugBundleRequest) ([]*v1.BundleFile, error) {
+	resp, err := c.devnet.CollectDebugBundle(ctx, req, grpc.MaxCallRecvMsgSize(maxBundleSize))
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp.Files, nil
+}
+
+// maxGenesisSize is the largest exported genesis accepted from the daemon;
+// the state of forked networks runs to hundreds of megabytes.
+const maxGenesisSize = 1 << 30
+
+// ExportGenesis exports a devnet's state at a height as a genesis file.
+func (c *GRPCClient) ExportGenesis(ctx context.Context, req *v1.ExportGenesisRequest) (*v1.ExportGenesisResponse, error) {
+	resp, err := c.devnet.ExportGenesis(ctx, req, grpc.MaxCallRecvMsgSize(maxGenesisSize))
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// ExportCompose renders a docker-mode devnet as a docker compose file.
+func (c *GRPCClient) ExportCompose(ctx context.Context, req *v1.ExportComposeRequest) (*v1.ExportComposeResponse, error) {
+	resp, err := c.devnet.ExportCompose(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// archiveChunkSize is the most archive data sent in one import message.
+const archiveChunkSize = 1 << 20
+
+// ExportDevnet writes a portable tar.zst archive of a devnet to w.
+func (c *GRPCClient) ExportDevnet(ctx context.Context, req *v1.ExportDevnetRequest, w io.Writer) error {
+	stream, err := c.devnet.ExportDevnet(ctx, req)
+	if err != nil {
+		return wrapGRPCError(err)
+	}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return wrapGRPCError(err)
+		}
+		if _, err := w.Write(chunk.Data); err != nil {
+			return err
+		}
+	}
+}
+
+// ImportDevnet creates a devnet named name in namespace from an archive
+// written by ExportDevnet. An empty name keeps the archived name.
+func (c *GRPCClient) ImportDevnet(ctx context.Context, name, namespace string, r io.Reader) (*v1.ImportDevnetResponse, error) {
+	stream, err := c.devnet.ImportDevnet(ctx)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+
+	buf := make([]byte, archiveChunkSize)
+	first := true
+	for {
+		n, readErr := io.ReadFull(r, buf)
+		if n > 0 || first {
+			req := &v1.ImportDevnetRequest{Data: buf[:n]}
+			if first {
+				req.Name, req.Namespace = name, namespace
+				first = false
+			}
+			if err := stream.Send(req); err != nil {
+				// The daemon rejected the import; CloseAndRecv returns why
+				break
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return nil, readErr
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// GetBlock returns a block with its transactions decoded.
+func (c *GRPCClient) GetBlock(ctx context.Context, req *v1.GetBlockRequest) (*v1.GetBlockResponse, error) {
+	resp, err := c.devnet.GetBlock(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// CreateNamespace creates a namespace with an optional quota.
+func (c *GRPCClient) CreateNamespace(ctx context.Context, req *v1.CreateNamespaceRequest) (*v1.Namespace, error) {
+	resp, err := c.namespace.CreateNamespace(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp.Namespace, nil
+}
+
+// ListNamespaces lists namespaces with their quota and usage.
+func (c *GRPCClient) ListNamespaces(ctx context.Context) ([]*v1.Namespace, error) {
+	resp, err := c.namespace.ListNamespaces(ctx, &v1.ListNamespacesRequest{})
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp.Namespaces, nil
+}
+
+// DeleteNamespace deletes an empty namespace.
+func (c *GRPCClient) DeleteNamespace(ctx context.Context, name string) error {
+	_, err := c.namespace.DeleteNamespace(ctx, &v1.DeleteNamespaceRequest{Name: name})
+	if err != nil {
+		return wrapGRPCError(err)
+	}
+	return nil
+}
+
+// ApplyNodeConfig patches a node's config files, reloading or restarting it.
+func (c *GRPCClient) ApplyNodeConfig(ctx context.Context, req *v1.ApplyNodeConfigRequest) (*v1.ApplyNodeConfigResponse, error) {
+	resp, err := c.node.ApplyNodeConfig(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// SyncNodeConfig reports a node's config drift and optionally restores it.
+func (c *GRPCClient) SyncNodeConfig(ctx context.Context, req *v1.SyncNodeConfigRequest) (*v1.SyncNodeConfigResponse, error) {
+	resp, err := c.node.SyncNodeConfig(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp, nil
+}
+
+// SetNodeBinary swaps one node's binary or image and restarts it.
+func (c *GRPCClient) SetNodeBinary(ctx context.Context, req *v1.SetNodeBinaryRequest) (*v1.Node, error) {
+	resp, err := c.node.SetNodeBinary(ctx, req)
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp.Node, nil
+}
+
+// GetNodeConfig returns a node's effective config with the source of each value.
+func (c *GRPCClient) GetNodeConfig(ctx context.Context, namespace, devnetName string, index int) ([]*v1.NodeConfigField, error) {
+	resp, err := c.node.GetNodeConfig(ctx, &v1.GetNodeConfigRequest{
+		DevnetName: devnetName,
+		Index:      int32(index),
+		Namespace:  namespace,
+	})
+	if err != nil {
+		return nil, wrapGRPCError(err)
+	}
+	return resp.Fields, nil
+}
+
 // LogEntry represents a single log line from a node.
 type LogEntry struct {
 	Timestamp time.Time
@@ -678,6 +1088,30 @@ func (c *GRPCClient) StreamProvisionLogs(ctx context.Context, namespace, name st
 	}
 }
 
+// WatchDevnets streams devnet and node changes, calling the callback for each.
+func (c *GRPCClient) WatchDevnets(ctx context.Context, namespace string, callback func(*v1.WatchDevnetsResponse) error) error {
+	stream, err := c.devnet.WatchDevnets(ctx, &v1.WatchDevnetsRequest{Namespace: namespace})
+	if err != nil {
+		return wrapGRPCError(err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return wrapGRPCError(err)
+		}
+		if err := callback(resp); err != nil {
+			return err
+		}
+	}
+}
+
 // PingResponse contains the response from a Ping call.
 type PingResponse struct {
 	ServerVersion string
@@ -712,23 +1146,52 @@ func (c *GRPCClient) WhoAmI(ctx context.Context) (*WhoAmIResponse, error) {
 	}, nil
 }
 
-// wrapGRPCError converts gRPC errors to user-friendly messages.
+// HandOffCredentials asks the daemon to fetch credentials from the socket
+// the client listens on. It returns the source of the GitHub token the
+// daemon now uses.
+func (c *GRPCClient) HandOffCredentials(ctx context.Context, socketPath, nonce string) (string, error) {
+	resp, err := c.auth.HandOffCredentials(ctx, &v1.HandOffCredentialsRequest{
+		SocketPath: socketPath,
+		Nonce:      nonce,
+	})
+	if err != nil {
+		return "", wrapGRPCError(err)
+	}
+	return resp.GithubTokenSource, nil
+}
+
+// GetCredentialStatus returns the source of the daemon's GitHub token.
+func (c *GRPCClient) GetCredentialStatus(ctx context.Context) (string, error) {
+	resp, err := c.auth.GetCredentialStatus(ctx, &v1.GetCredentialStatusRequest{})
+	if err != nil {
+		return "", wrapGRPCError(err)
+	}
+	return resp.GithubTokenSource, nil
+}
+
+// wrapGRPCError converts gRPC errors to user-friendly messages. The result
+// is an *apierror.Error keeping the daemon's error code.
 func wrapGRPCError(err error) error {
 	st, ok := status.FromError(err)
 	if !ok {
 		return err
 	}
 
+	var msg string
 	switch st.Code() {
 	case codes.NotFound:
-		return fmt.Errorf("not found: %s", st.Message())
+		msg = fmt.Sprintf("not found: %s", st.Message())
 	case codes.AlreadyExists:
-		return fmt.Errorf("already exists: %s", st.Message())
+		msg = fmt.Sprintf("already exists: %s", st.Message())
 	case codes.InvalidArgument:
-		return fmt.Errorf("invalid argument: %s", st.Message())
+		msg = fmt.Sprintf("invalid argument: %s", st.Message())
 	case codes.Unavailable:
-		return fmt.Errorf("daemon unavailable: %s", st.Message())
+		msg = fmt.Sprintf("daemon unavailable: %s", st.Message())
+	case codes.ResourceExhausted:
+		// Quota errors already read "namespace ... quota exceeded: ...".
+		msg = st.Message()
 	default:
-		return fmt.Errorf("%s: %s", st.Code(), st.Message())
+		msg = fmt.Sprintf("%s: %s", st.Code(), st.Message())
 	}
+	return &apierror.Error{Code: apierror.FromStatus(st), Message: msg}
 }
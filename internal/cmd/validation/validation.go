@@ -0,0 +1,105 @@
+// internal/cmd/validation/validation.go
+
+// Package validation holds the argument and flag checks of the dvb
+// commands. Commands run them in PreRunE, and the root command runs them
+// with Run before connecting to the daemon, so invalid input fails before
+// any daemon round-trip. Checks may run twice and must therefore be pure:
+// they only look at the arguments and flags.
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Check validates the arguments or flags of a command.
+type Check func(cmd *cobra.Command, args []string) error
+
+// PreRunE returns a PreRunE running the checks in order and returning the
+// first error.
+func PreRunE(checks ...Check) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		for _, check := range checks {
+			if err := check(cmd, args); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Run runs the checks cobra would run only after the persistent pre-run
+// hooks: required flags, flag groups such as mutually exclusive flags, and
+// the PreRunE of cmd.
+func Run(cmd *cobra.Command, args []string) error {
+	if err := cmd.ValidateRequiredFlags(); err != nil {
+		return err
+	}
+	if err := cmd.ValidateFlagGroups(); err != nil {
+		return err
+	}
+	if cmd.PreRunE != nil {
+		return cmd.PreRunE(cmd, args)
+	}
+	return nil
+}
+
+// Func adapts a check that needs neither the command nor its arguments,
+// typically one closing over the command's option variables.
+func Func(check func() error) Check {
+	return func(*cobra.Command, []string) error {
+		return check()
+	}
+}
+
+// AtLeast fails when the value of flag is less than minimum.
+func AtLeast(flag string, value, minimum int) error {
+	if value < minimum {
+		return fmt.Errorf("--%s must be at least %d", flag, minimum)
+	}
+	return nil
+}
+
+// NonNegative fails when the value of flag is negative.
+func NonNegative(flag string, value int) error {
+	if value < 0 {
+		return fmt.Errorf("--%s cannot be negative", flag)
+	}
+	return nil
+}
+
+// NodeIndex fails when the value of flag is not a valid node index.
+func NodeIndex(flag string, index int) error {
+	if index < 0 {
+		return fmt.Errorf("--%s must be a node index (0 or greater), got %d", flag, index)
+	}
+	return nil
+}
+
+// OneOf fails when the value of flag is not one of allowed.
+func OneOf(flag, value string, allowed ...string) error {
+	if slices.Contains(allowed, value) {
+		return nil
+	}
+	return fmt.Errorf("invalid --%s %q (must be %s)", flag, value, orList(allowed))
+}
+
+// orList joins values as "a, b or c".
+func orList(values []string) string {
+	if len(values) < 2 {
+		return strings.Join(values, "")
+	}
+	return strings.Join(values[:len(values)-1], ", ") + " or " + values[len(values)-1]
+}
+
+// OutputFormat fails when an -o/--output value is set to a format other
+// than supported.
+func OutputFormat(value string, supported ...string) error {
+	if value == "" || slices.Contains(supported, value) {
+		return nil
+	}
+	return fmt.Errorf("unsupported output format %q (supported: %s)", value, strings.Join(supported, ", "))
+}
@@ -0,0 +1,70 @@
+// internal/cmd/validation/validation_test.go
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecks(t *testing.T) {
+	assert.NoError(t, AtLeast("validators", 1, 1))
+	assert.EqualError(t, AtLeast("validators", 0, 1), "--validators must be at least 1")
+
+	assert.NoError(t, NonNegative("full-nodes", 0))
+	assert.EqualError(t, NonNegative("full-nodes", -1), "--full-nodes cannot be negative")
+
+	assert.NoError(t, NodeIndex("node", 0))
+	assert.EqualError(t, NodeIndex("node", -1), "--node must be a node index (0 or greater), got -1")
+
+	assert.NoError(t, OneOf("mode", "local", "docker", "local"))
+	assert.EqualError(t, OneOf("mode", "k8s", "docker", "local"), `invalid --mode "k8s" (must be docker or local)`)
+	assert.EqualError(t, OneOf("kind", "x", "a", "b", "c"), `invalid --kind "x" (must be a, b or c)`)
+
+	assert.NoError(t, OutputFormat("", "json"))
+	assert.NoError(t, OutputFormat("json", "json"))
+	assert.EqualError(t, OutputFormat("yaml", "markdown", "json"), `unsupported output format "yaml" (supported: markdown, json)`)
+}
+
+func TestPreRunE(t *testing.T) {
+	var ran []string
+	check := func(name string, err error) Check {
+		return func(*cobra.Command, []string) error {
+			ran = append(ran, name)
+			return err
+		}
+	}
+
+	errBad := errors.New("bad")
+	preRun := PreRunE(check("a", nil), check("b", errBad), check("c", nil))
+	assert.ErrorIs(t, preRun(&cobra.Command{}, nil), errBad)
+	assert.Equal(t, []string{"a", "b"}, ran, "checks after a failure must not run")
+}
+
+func TestRun(t *testing.T) {
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{
+			Use:     "test",
+			PreRunE: PreRunE(Func(func() error { return errors.New("pre-run") })),
+			RunE:    func(*cobra.Command, []string) error { return nil },
+		}
+		cmd.Flags().Bool("all", false, "")
+		cmd.Flags().String("node", "", "")
+		cmd.MarkFlagsMutuallyExclusive("all", "node")
+		return cmd
+	}
+
+	// Flag groups are checked before PreRunE
+	cmd := newCmd()
+	require.NoError(t, cmd.ParseFlags([]string{"--all", "--node", "x"}))
+	err := Run(cmd, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "[all node] were all set")
+
+	cmd = newCmd()
+	require.NoError(t, cmd.ParseFlags([]string{"--all"}))
+	assert.EqualError(t, Run(cmd, nil), "pre-run")
+}
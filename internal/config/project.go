@@ -0,0 +1,271 @@
+// internal/config/project.go
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ProjectKind is the resource kind of a project manifest
+	ProjectKind = "Project"
+	// DefaultProjectFile is the project manifest looked up when no file is given
+	DefaultProjectFile = "project.yaml"
+)
+
+// Project unit kinds.
+const (
+	UnitDevnet  = "devnet"
+	UnitRelayer = "relayer"
+	UnitSidecar = "sidecar"
+)
+
+// YAMLProject is a project manifest: a group of devnets plus the relayers
+// and sidecars that connect and serve them, managed as one unit.
+type YAMLProject struct {
+	APIVersion string          `yaml:"apiVersion"`
+	Kind       string          `yaml:"kind"`
+	Metadata   YAMLMetadata    `yaml:"metadata"`
+	Spec       YAMLProjectSpec `yaml:"spec"`
+}
+
+// YAMLProjectSpec lists the units of a project
+type YAMLProjectSpec struct {
+	Devnets  []YAMLProjectDevnet    `yaml:"devnets"`
+	Relayers []YAMLProjectContainer `yaml:"relayers,omitempty"`
+	Sidecars []YAMLProjectContainer `yaml:"sidecars,omitempty"`
+}
+
+// YAMLProjectDevnet is a devnet of a project, defined inline or in a devnet
+// file relative to the project manifest.
+type YAMLProjectDevnet struct {
+	Name      string          `yaml:"name"`
+	File      string          `yaml:"file,omitempty"`
+	Spec      *YAMLDevnetSpec `yaml:"spec,omitempty"`
+	DependsOn []string        `yaml:"dependsOn,omitempty"`
+}
+
+// YAMLProjectContainer is a relayer or sidecar run as a container on the
+// host network, so it can reach the devnets' local endpoints.
+type YAMLProjectContainer struct {
+	Name    string            `yaml:"name"`
+	Image   string            `yaml:"image"`
+	Command []string          `yaml:"command,omitempty"`
+	Env     map[string]string `yaml:"env,omitempty"`
+	// Chains are the devnets a relayer connects. Relayers depend on them.
+	Chains    []string `yaml:"chains,omitempty"`
+	DependsOn []string `yaml:"dependsOn,omitempty"`
+}
+
+// ProjectUnit is a devnet, relayer or sidecar of a project with its
+// resolved dependencies.
+type ProjectUnit struct {
+	Kind      string
+	Name      string
+	DependsOn []string
+
+	// Devnet is set for devnet units.
+	Devnet *YAMLDevnetSpec
+	// Container is set for relayer and sidecar units.
+	Container *YAMLProjectContainer
+}
+
+// LoadProject loads a project manifest and the devnet files it references.
+func LoadProject(path string) (*YAMLProject, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read project: %w", err)
+	}
+
+	var project YAMLProject
+	if err := yaml.Unmarshal(data, &project); err != nil {
+		return nil, fmt.Errorf("failed to decode project %s: %w", path, err)
+	}
+
+	dir := filepath.Dir(path)
+	loader := NewYAMLLoader()
+	for i := range project.Spec.Devnets {
+		d := &project.Spec.Devnets[i]
+		if d.File == "" {
+			if d.Spec != nil {
+				if err := d.Spec.loadValidatorKeyFiles(dir); err != nil {
+					return nil, fmt.Errorf("spec.devnets[%d]: %w", i, err)
+				}
+			}
+			continue
+		}
+		if d.Spec != nil {
+			return nil, fmt.Errorf("spec.devnets[%d]: file and spec are mutually exclusive", i)
+		}
+		file := d.File
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(dir, file)
+		}
+		devnets, err := loader.LoadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("spec.devnets[%d]: %w", i, err)
+		}
+		if len(devnets) != 1 {
+			return nil, fmt.Errorf("spec.devnets[%d]: %s must contain exactly one devnet, found %d", i, d.File, len(devnets))
+		}
+		d.Spec = &devnets[0].Spec
+	}
+
+	if err := project.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid project %s: %w", path, err)
+	}
+	return &project, nil
+}
+
+// Validate checks the manifest, unit names and dependencies.
+func (p *YAMLProject) Validate() error {
+	var errs []string
+
+	if p.APIVersion != SupportedAPIVersion {
+		errs = append(errs, fmt.Sprintf("unsupported apiVersion %q, expected %q", p.APIVersion, SupportedAPIVersion))
+	}
+	if p.Kind != ProjectKind {
+		errs = append(errs, fmt.Sprintf("unsupported kind %q, expected %q", p.Kind, ProjectKind))
+	}
+	if p.Metadata.Name == "" {
+		errs = append(errs, "metadata.name is required")
+	}
+	if len(p.Spec.Devnets) == 0 {
+		errs = append(errs, "spec.devnets must list at least one devnet")
+	}
+
+	kinds := make(map[string]string)
+	addName := func(field, name, kind string) {
+		if name == "" {
+			errs = append(errs, fmt.Sprintf("%s.name is required", field))
+			return
+		}
+		if prev, ok := kinds[name]; ok {
+			errs = append(errs, fmt.Sprintf("%s: name %q is already used by a %s", field, name, prev))
+			return
+		}
+		kinds[name] = kind
+	}
+
+	for i, d := range p.Spec.Devnets {
+		field := fmt.Sprintf("spec.devnets[%d]", i)
+		addName(field, d.Name, UnitDevnet)
+		if d.Spec == nil {
+			errs = append(errs, fmt.Sprintf("%s: file or spec is required", field))
+		} else if err := d.Spec.Validate(); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", field, err))
+		}
+	}
+	for i, r := range p.Spec.Relayers {
+		field := fmt.Sprintf("spec.relayers[%d]", i)
+		addName(field, r.Name, UnitRelayer)
+		if r.Image == "" {
+			errs = append(errs, fmt.Sprintf("%s.image is required", field))
+		}
+		if len(r.Chains) != 2 {
+			errs = append(errs, fmt.Sprintf("%s.chains must list exactly two devnets", field))
+		}
+	}
+	for i, s := range p.Spec.Sidecars {
+		field := fmt.Sprintf("spec.sidecars[%d]", i)
+		addName(field, s.Name, UnitSidecar)
+		if s.Image == "" {
+			errs = append(errs, fmt.Sprintf("%s.image is required", field))
+		}
+	}
+
+	// Dependencies must name units of the project; relayer chains must name
+	// devnets.
+	for _, u := range p.units() {
+		for _, dep := range u.DependsOn {
+			if _, ok := kinds[dep]; !ok {
+				errs = append(errs, fmt.Sprintf("%s %q depends on unknown unit %q", u.Kind, u.Name, dep))
+			}
+		}
+	}
+	for _, r := range p.Spec.Relayers {
+		for _, chain := range r.Chains {
+			if kind, ok := kinds[chain]; ok && kind != UnitDevnet {
+				errs = append(errs, fmt.Sprintf("relayer %q chain %q is a %s, not a devnet", r.Name, chain, kind))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("validation errors: %s", strings.Join(errs, "; "))
+	}
+
+	if _, err := p.Units(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// units returns the project's units in declaration order.
+func (p *YAMLProject) units() []ProjectUnit {
+	var units []ProjectUnit
+	for _, d := range p.Spec.Devnets {
+		units = append(units, ProjectUnit{Kind: UnitDevnet, Name: d.Name, DependsOn: d.DependsOn, Devnet: d.Spec})
+	}
+	for i := range p.Spec.Relayers {
+		r := &p.Spec.Relayers[i]
+		deps := append(append([]string{}, r.Chains...), r.DependsOn...)
+		units = append(units, ProjectUnit{Kind: UnitRelayer, Name: r.Name, DependsOn: deps, Container: r})
+	}
+	for i := range p.Spec.Sidecars {
+		s := &p.Spec.Sidecars[i]
+		units = append(units, ProjectUnit{Kind: UnitSidecar, Name: s.Name, DependsOn: s.DependsOn, Container: s})
+	}
+	return units
+}
+
+// Units returns the project's units in dependency order: every unit comes
+// after the units it depends on. Independent units keep declaration order.
+func (p *YAMLProject) Units() ([]ProjectUnit, error) {
+	units := p.units()
+	pending := make(map[string]int, len(units))
+	dependents := make(map[string][]int)
+	for i, u := range units {
+		for _, dep := range u.DependsOn {
+			pending[u.Name]++
+			dependents[dep] = append(dependents[dep], i)
+		}
+	}
+
+	var ready []int
+	for i, u := range units {
+		if pending[u.Name] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	ordered := make([]ProjectUnit, 0, len(units))
+	for len(ready) > 0 {
+		sort.Ints(ready)
+		i := ready[0]
+		ready = ready[1:]
+		ordered = append(ordered, units[i])
+		for _, j := range dependents[units[i].Name] {
+			pending[units[j].Name]--
+			if pending[units[j].Name] == 0 {
+				ready = append(ready, j)
+			}
+		}
+	}
+
+	if len(ordered) != len(units) {
+		var cyclic []string
+		for _, u := range units {
+			if pending[u.Name] > 0 {
+				cyclic = append(cyclic, u.Name)
+			}
+		}
+		return nil, fmt.Errorf("dependency cycle between %s", strings.Join(cyclic, ", "))
+	}
+	return ordered, nil
+}
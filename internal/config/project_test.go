@@ -0,0 +1,108 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testProjectYAML = `apiVersion: devnet.lagos/v1
+kind: Project
+metadata:
+  name: interchain
+spec:
+  sidecars:
+    - name: indexer
+      image: example/indexer:latest
+      dependsOn: [consumer]
+  relayers:
+    - name: hub-consumer
+      image: informalsystems/hermes:1.10.0
+      chains: [hub, consumer]
+  devnets:
+    - name: consumer
+      dependsOn: [hub]
+      spec:
+        network: stable
+        validators: 1
+    - name: hub
+      file: hub.yaml
+`
+
+const testHubYAML = `apiVersion: devnet.lagos/v1
+kind: Devnet
+metadata:
+  name: hub
+spec:
+  network: cosmos
+  validators: 2
+`
+
+func writeTestProject(t *testing.T, project string) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "hub.yaml"), []byte(testHubYAML), 0644); err != nil {
+		t.Fatalf("failed to write hub.yaml: %v", err)
+	}
+	path := filepath.Join(dir, DefaultProjectFile)
+	if err := os.WriteFile(path, []byte(project), 0644); err != nil {
+		t.Fatalf("failed to write project: %v", err)
+	}
+	return path
+}
+
+func TestLoadProject(t *testing.T) {
+	project, err := LoadProject(writeTestProject(t, testProjectYAML))
+	if err != nil {
+		t.Fatalf("LoadProject failed: %v", err)
+	}
+
+	hub := project.Spec.Devnets[1]
+	if hub.Spec == nil || hub.Spec.Network != "cosmos" || hub.Spec.Validators != 2 {
+		t.Errorf("hub spec not loaded from file: %+v", hub.Spec)
+	}
+
+	units, err := project.Units()
+	if err != nil {
+		t.Fatalf("Units failed: %v", err)
+	}
+	var names []string
+	for _, u := range units {
+		names = append(names, u.Name)
+	}
+	if got, want := strings.Join(names, ","), "hub,consumer,hub-consumer,indexer"; got != want {
+		t.Errorf("unit order = %s, want %s", got, want)
+	}
+}
+
+func TestLoadProject_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		replace [2]string
+		wantErr string
+	}{
+		{"unknown dependency", [2]string{"dependsOn: [hub]", "dependsOn: [nope]"}, `unknown unit "nope"`},
+		{"duplicate name", [2]string{"name: indexer", "name: hub"}, `name "hub" is already used`},
+		{"relayer chain is not a devnet", [2]string{"chains: [hub, consumer]", "chains: [hub, indexer]"}, "is a sidecar, not a devnet"},
+		{"cycle", [2]string{"    - name: hub\n", "    - name: hub\n      dependsOn: [consumer]\n"}, "dependency cycle between consumer, hub"},
+		{"missing spec", [2]string{"      file: hub.yaml\n", ""}, "file or spec is required"},
+		{"wrong kind", [2]string{"kind: Project", "kind: Devnet"}, "unsupported kind"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := strings.Replace(testProjectYAML, tt.replace[0], tt.replace[1], 1)
+			if content == testProjectYAML {
+				t.Fatalf("replacement %q did not apply", tt.replace[0])
+			}
+			_, err := LoadProject(writeTestProject(t, content))
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
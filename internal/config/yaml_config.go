@@ -3,7 +3,11 @@ package config
 
 import (
 	"fmt"
+	"net"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
 )
 
 const (
@@ -35,6 +39,8 @@ type YAMLDevnetSpec struct {
 	NetworkType    string             `yaml:"networkType,omitempty"`
 	NetworkVersion string             `yaml:"networkVersion,omitempty"`
 	Mode           string             `yaml:"mode,omitempty"`
+	Image          string             `yaml:"image,omitempty"`   // Docker image for docker mode
+	Profile        string             `yaml:"profile,omitempty"` // Resource profile (e.g., "laptop")
 	Validators     int                `yaml:"validators,omitempty"`
 	FullNodes      int                `yaml:"fullNodes,omitempty"`
 	Accounts       int                `yaml:"accounts,omitempty"`
@@ -47,6 +53,243 @@ type YAMLDevnetSpec struct {
 	GenesisPath string `yaml:"genesisPath,omitempty"` // Path to local genesis file
 	SnapshotURL string `yaml:"snapshotURL,omitempty"` // URL to fetch snapshot from
 	RPCURL      string `yaml:"rpcURL,omitempty"`      // RPC endpoint URL for genesis forking
+
+	// GenesisPrune drops state from the forked genesis to shrink it.
+	GenesisPrune *YAMLGenesisPrune `yaml:"genesisPrune,omitempty"`
+
+	// VotingPower splits the stake of the validators injected into the
+	// forked genesis; unset gives them equal power.
+	VotingPower *YAMLVotingPower `yaml:"votingPower,omitempty"`
+
+	// GenesisOverrides maps genesis paths (e.g., "app_state.gov.params.voting_period")
+	// to values merged into the genesis after the plugin has patched it.
+	GenesisOverrides map[string]interface{} `yaml:"genesisOverrides,omitempty"`
+
+	// FundedAccounts are extra accounts funded in genesis alongside the
+	// validator accounts.
+	FundedAccounts []YAMLFundedAccount `yaml:"fundedAccounts,omitempty"`
+
+	// ValidatorKeys import existing keys for validator slots, e.g. those of
+	// staging infrastructure. Other slots get generated keys.
+	ValidatorKeys []YAMLValidatorKeys `yaml:"validatorKeys,omitempty"`
+
+	// RemoteSigners make validator slots sign through an external signer
+	// (tmkms, an HSM, ...) connected on priv_validator_laddr.
+	RemoteSigners []YAMLRemoteSigner `yaml:"remoteSigners,omitempty"`
+
+	// Seed derives all validator and account mnemonics and node keys, so
+	// devnets created from the same seed are identical.
+	Seed string `yaml:"seed,omitempty"`
+
+	// TTL (e.g., "4h") after which the daemon stops the devnet, or deletes
+	// it when DeleteOnExpiry is set.
+	TTL            string `yaml:"ttl,omitempty"`
+	DeleteOnExpiry bool   `yaml:"deleteOnExpiry,omitempty"`
+
+	// IdleTimeout (e.g., "30m") after which the daemon stops the devnet if
+	// it sees no RPC traffic or transactions.
+	IdleTimeout string `yaml:"idleTimeout,omitempty"`
+
+	// BlockTime (e.g., "500ms") is the consensus timeout_commit of every
+	// node; empty means 1s.
+	BlockTime string `yaml:"blockTime,omitempty"`
+
+	// EpochDuration (e.g., "1m") replaces the duration of every epochs
+	// module epoch in genesis.
+	EpochDuration string `yaml:"epochDuration,omitempty"`
+
+	// Ports sets the nodes' base ports and the stride between nodes.
+	Ports *YAMLPortLayout `yaml:"ports,omitempty"`
+
+	// BindAddress is the IP nodes listen on (e.g., "0.0.0.0" or "::")
+	// instead of their own loopback subnet addresses.
+	BindAddress string `yaml:"bindAddress,omitempty"`
+
+	// Webhooks are notified of the devnet's events (phase changes, crashed
+	// nodes, upgrades, expiring TTL), in addition to devnetd's webhooks.
+	Webhooks []YAMLWebhook `yaml:"webhooks,omitempty"`
+
+	// Hooks are commands run between lifecycle phases, with the devnet's
+	// chain ID, endpoints and node home directories in their environment.
+	Hooks *YAMLHooks `yaml:"hooks,omitempty"`
+
+	// Bootstrap deploys contracts once the devnet is first healthy and
+	// writes their addresses to bootstrap-output.json.
+	Bootstrap *YAMLBootstrap `yaml:"bootstrap,omitempty"`
+
+	// Hosts are remote machines nodes run on over SSH, node i on
+	// hosts[i % len(hosts)]. Requires mode local.
+	Hosts []YAMLRemoteHost `yaml:"hosts,omitempty"`
+
+	// NetworkShaping emulates latency, loss and bandwidth limits between
+	// the regions of spec.nodes, applied with 'dvb net shape apply'.
+	// Requires mode docker.
+	NetworkShaping *YAMLNetworkShaping `yaml:"networkShaping,omitempty"`
+}
+
+// YAMLNetworkShaping sets the network conditions between node regions.
+type YAMLNetworkShaping struct {
+	Links []YAMLNetworkLink `yaml:"links"`           // Conditions between two regions, in both directions
+	Image string            `yaml:"image,omitempty"` // Image with tc, default nicolaka/netshoot:latest
+}
+
+// YAMLNetworkLink is the emulated network between two regions.
+type YAMLNetworkLink struct {
+	From    string `yaml:"from"`              // Region, "default" for unlabelled nodes
+	To      string `yaml:"to"`                // Region, the same as from for traffic within it
+	Latency string `yaml:"latency,omitempty"` // Delay added to each packet (e.g., "100ms")
+	Jitter  string `yaml:"jitter,omitempty"`  // Variation of the latency (e.g., "20ms")
+	Loss    string `yaml:"loss,omitempty"`    // Percentage of packets dropped (e.g., "1%")
+	Rate    string `yaml:"rate,omitempty"`    // Bandwidth limit in tc units (e.g., "10mbit")
+}
+
+// YAMLRemoteHost is a machine nodes run on, reached over SSH.
+type YAMLRemoteHost struct {
+	Name         string `yaml:"name"`                   // Name in logs and errors
+	Address      string `yaml:"address"`                // IP address or DNS name the host's nodes are reached on
+	User         string `yaml:"user,omitempty"`         // SSH user, default the ssh client's
+	Port         int    `yaml:"port,omitempty"`         // SSH port, default 22
+	IdentityFile string `yaml:"identityFile,omitempty"` // Private key, default the ssh client's keys and agent
+	Dir          string `yaml:"dir,omitempty"`          // Absolute upload directory, default ~/.devnet-builder
+}
+
+// YAMLBootstrap lists contracts deployed once the devnet is first healthy.
+type YAMLBootstrap struct {
+	GasPrice  string         `yaml:"gasPrice,omitempty"` // Price of CosmWasm transactions per unit of gas (e.g., "0.025stake")
+	Contracts []YAMLContract `yaml:"contracts"`          // Deployed in order by the first validator
+}
+
+// YAMLContract is a CosmWasm contract or EVM bytecode deployed by bootstrap.
+type YAMLContract struct {
+	Name    string `yaml:"name"`              // Key in bootstrap-output.json
+	Type    string `yaml:"type"`              // "wasm" or "evm"
+	Source  string `yaml:"source"`            // Absolute path or http(s) URL
+	InitMsg string `yaml:"initMsg,omitempty"` // JSON instantiate message (wasm), default {}
+	Args    string `yaml:"args,omitempty"`    // Hex ABI-encoded constructor arguments (evm)
+	Label   string `yaml:"label,omitempty"`   // Contract label (wasm), default name
+	Admin   string `yaml:"admin,omitempty"`   // Admin address (wasm), default none
+	Funds   string `yaml:"funds,omitempty"`   // Coins (wasm) or wei (evm) sent with the instantiation
+}
+
+// YAMLHooks are the devnet's lifecycle hooks, run in order.
+type YAMLHooks struct {
+	PostFork    []YAMLHook `yaml:"postFork,omitempty"`    // After genesis is forked, before the nodes are initialized
+	PreStart    []YAMLHook `yaml:"preStart,omitempty"`    // After the nodes are initialized, before they start
+	PostHealthy []YAMLHook `yaml:"postHealthy,omitempty"` // Once, the first time all nodes are healthy
+}
+
+// YAMLHook is a command or script run at a point of the devnet's lifecycle.
+type YAMLHook struct {
+	Name      string `yaml:"name,omitempty"`      // Name in logs and events
+	Command   string `yaml:"command,omitempty"`   // Shell command, run with sh -c
+	Script    string `yaml:"script,omitempty"`    // Absolute path of a script on the daemon's host
+	Image     string `yaml:"image,omitempty"`     // Run in a container of this image instead of on the host
+	Timeout   string `yaml:"timeout,omitempty"`   // Go duration (e.g., "2m"), default 5m
+	OnFailure string `yaml:"onFailure,omitempty"` // "fail" (default) or "ignore"
+}
+
+// YAMLWebhook receives JSON notifications of the devnet's events.
+type YAMLWebhook struct {
+	URL      string   `yaml:"url"`                // http(s) endpoint
+	Format   string   `yaml:"format,omitempty"`   // "slack", "discord" or "generic" (default)
+	Events   []string `yaml:"events,omitempty"`   // Event types or patterns (e.g. "node.*"), empty = all
+	Template string   `yaml:"template,omitempty"` // Go text/template for the message text
+}
+
+// YAMLGenesisPrune selects state to drop from a forked genesis.
+type YAMLGenesisPrune struct {
+	IBCPackets    bool   `yaml:"ibcPackets,omitempty"`    // Clear IBC packet commitments, receipts and acknowledgements
+	DustThreshold string `yaml:"dustThreshold,omitempty"` // Remove plain accounts holding less than this coin (e.g., "1000uatom")
+	WasmCode      bool   `yaml:"wasmCode,omitempty"`      // Drop CosmWasm code and the contracts using it
+}
+
+// YAMLVotingPower splits the stake of a devnet's validators.
+type YAMLVotingPower struct {
+	Profile string    `yaml:"profile,omitempty"` // "equal" (default), "proportional" or "custom"
+	Weights []float64 `yaml:"weights,omitempty"` // custom: relative power of each validator, by index
+}
+
+// YAMLPortLayout assigns ports to nodes: each service starts at its base
+// port and node i adds i*stride. Omitted ports keep their defaults.
+type YAMLPortLayout struct {
+	P2P    int `yaml:"p2p,omitempty"`    // Base P2P port (default: 26656)
+	RPC    int `yaml:"rpc,omitempty"`    // Base Tendermint RPC port (default: 26657)
+	REST   int `yaml:"rest,omitempty"`   // Base REST API port (default: 1317)
+	GRPC   int `yaml:"grpc,omitempty"`   // Base gRPC port (default: 9090)
+	EVM    int `yaml:"evm,omitempty"`    // Base EVM JSON-RPC port (default: 8545)
+	Stride int `yaml:"stride,omitempty"` // Distance between consecutive nodes' ports
+}
+
+// YAMLFundedAccount is an account pre-funded in genesis
+type YAMLFundedAccount struct {
+	Address string       `yaml:"address"`           // Bech32 address, or 0x address for EVM chains
+	Coins   string       `yaml:"coins"`             // Balance, e.g. "1000000ustake,500uatom"
+	Vesting *YAMLVesting `yaml:"vesting,omitempty"` // Optional vesting for part of the balance
+}
+
+// YAMLVesting locks part of a funded account's balance
+type YAMLVesting struct {
+	Type  string    `yaml:"type"`            // "continuous" or "delayed"
+	Coins string    `yaml:"coins,omitempty"` // Vesting portion (default: the whole balance)
+	Start time.Time `yaml:"start,omitempty"` // When continuous vesting begins
+	End   time.Time `yaml:"end"`             // When vesting completes
+}
+
+// YAMLValidatorKeys are the keys a validator slot reuses instead of
+// generating its own. Each key is given inline or as a file path relative
+// to the devnet file; the loader reads files into the inline fields.
+type YAMLValidatorKeys struct {
+	Index                int    `yaml:"index"`                          // Validator index, from 0
+	PrivValidatorKey     string `yaml:"privValidatorKey,omitempty"`     // Contents of priv_validator_key.json
+	PrivValidatorKeyFile string `yaml:"privValidatorKeyFile,omitempty"` // Path to priv_validator_key.json
+	NodeKey              string `yaml:"nodeKey,omitempty"`              // Contents of node_key.json
+	NodeKeyFile          string `yaml:"nodeKeyFile,omitempty"`          // Path to node_key.json
+}
+
+// YAMLRemoteSigner makes a validator sign through an external signer. With
+// tmkms set the daemon launches a tmkms softsign container holding the
+// validator's key; otherwise the operator connects their own signer.
+type YAMLRemoteSigner struct {
+	Index           int    `yaml:"index"`                     // Validator index, from 0
+	TMKMS           bool   `yaml:"tmkms,omitempty"`           // Launch a tmkms softsign container
+	Image           string `yaml:"image,omitempty"`           // tmkms image (default tmkms:latest)
+	ProtocolVersion string `yaml:"protocolVersion,omitempty"` // tmkms protocol version (default v0.38)
+}
+
+// loadValidatorKeyFiles reads the validator key files of s, relative to
+// baseDir, into the inline fields.
+func (s *YAMLDevnetSpec) loadValidatorKeyFiles(baseDir string) error {
+	for i := range s.ValidatorKeys {
+		k := &s.ValidatorKeys[i]
+		field := fmt.Sprintf("spec.validatorKeys[%d]", i)
+		if err := readKeyFile(&k.PrivValidatorKey, &k.PrivValidatorKeyFile, baseDir); err != nil {
+			return fmt.Errorf("%s.privValidatorKeyFile: %w", field, err)
+		}
+		if err := readKeyFile(&k.NodeKey, &k.NodeKeyFile, baseDir); err != nil {
+			return fmt.Errorf("%s.nodeKeyFile: %w", field, err)
+		}
+	}
+	return nil
+}
+
+// readKeyFile reads *path, relative to baseDir, into *contents.
+func readKeyFile(contents, path *string, baseDir string) error {
+	if *path == "" {
+		return nil
+	}
+	if *contents != "" {
+		return fmt.Errorf("give either the key or its file, not both")
+	}
+	file := *path
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(baseDir, file)
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	*contents, *path = string(data), ""
+	return nil
 }
 
 // YAMLResources defines resource limits
@@ -56,11 +299,16 @@ type YAMLResources struct {
 	Storage string `yaml:"storage,omitempty"`
 }
 
-// YAMLNodeOverride allows per-node configuration
+// YAMLNodeOverride allows per-node configuration. ConfigToml and AppToml
+// are raw TOML deep-merged into the node's config files after the plugin's
+// config overrides, e.g. to change its mempool size or pruning.
 type YAMLNodeOverride struct {
-	Index     int            `yaml:"index"`
-	Role      string         `yaml:"role,omitempty"`
-	Resources *YAMLResources `yaml:"resources,omitempty"`
+	Index      int            `yaml:"index"`
+	Role       string         `yaml:"role,omitempty"`
+	Resources  *YAMLResources `yaml:"resources,omitempty"`
+	ConfigToml string         `yaml:"configToml,omitempty"` // Merged into config.toml
+	AppToml    string         `yaml:"appToml,omitempty"`    // Merged into app.toml
+	Region     string         `yaml:"region,omitempty"`     // Region label for networkShaping
 }
 
 // YAMLDaemonConfig configures daemon behavior
@@ -127,6 +375,10 @@ func (s *YAMLDevnetSpec) Validate() error {
 		errs = append(errs, fmt.Sprintf("spec.networkType must be 'mainnet' or 'testnet', got %q", s.NetworkType))
 	}
 
+	if s.BindAddress != "" && net.ParseIP(s.BindAddress) == nil {
+		errs = append(errs, fmt.Sprintf("spec.bindAddress must be an IPv4 or IPv6 address, got %q", s.BindAddress))
+	}
+
 	if len(errs) > 0 {
 		return fmt.Errorf("spec validation errors: %s", strings.Join(errs, "; "))
 	}
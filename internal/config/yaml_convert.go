@@ -1,7 +1,11 @@
 package config
 
 import (
+	"encoding/json"
+	"slices"
+
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ToProto converts YAMLDevnet to protobuf Devnet
@@ -30,12 +34,161 @@ func (d *YAMLDevnet) metadataToProto() *v1.DevnetMetadata {
 
 func (d *YAMLDevnet) specToProto() *v1.DevnetSpec {
 	spec := &v1.DevnetSpec{
-		Plugin:      d.Spec.Network,
-		NetworkType: d.Spec.NetworkType,
-		Validators:  int32(d.Spec.Validators),
-		FullNodes:   int32(d.Spec.FullNodes),
-		Mode:        d.Spec.Mode,
-		SdkVersion:  d.Spec.NetworkVersion,
+		Plugin:         d.Spec.Network,
+		NetworkType:    d.Spec.NetworkType,
+		Validators:     int32(d.Spec.Validators),
+		FullNodes:      int32(d.Spec.FullNodes),
+		Accounts:       int32(d.Spec.Accounts),
+		Mode:           d.Spec.Mode,
+		SdkVersion:     d.Spec.NetworkVersion,
+		Image:          d.Spec.Image,
+		Profile:        d.Spec.Profile,
+		Ttl:            d.Spec.TTL,
+		DeleteOnExpiry: d.Spec.DeleteOnExpiry,
+		IdleTimeout:    d.Spec.IdleTimeout,
+		BlockTime:      d.Spec.BlockTime,
+		EpochDuration:  d.Spec.EpochDuration,
+		BindAddress:    d.Spec.BindAddress,
+		Seed:           d.Spec.Seed,
+		ForkNetwork:    d.Spec.ForkNetwork,
+		GenesisPath:    d.Spec.GenesisPath,
+		SnapshotUrl:    d.Spec.SnapshotURL,
+		RpcUrl:         d.Spec.RPCURL,
+	}
+
+	if len(d.Spec.GenesisOverrides) > 0 {
+		spec.GenesisOverrides = make(map[string]string, len(d.Spec.GenesisOverrides))
+		for path, value := range d.Spec.GenesisOverrides {
+			// Values are validated by the YAML validator; anything that
+			// cannot be encoded is rejected server-side as invalid JSON
+			encoded, _ := json.Marshal(value)
+			spec.GenesisOverrides[path] = string(encoded)
+		}
+	}
+
+	for _, acc := range d.Spec.FundedAccounts {
+		spec.FundedAccounts = append(spec.FundedAccounts, acc.toProto())
+	}
+
+	for _, k := range d.Spec.ValidatorKeys {
+		spec.ValidatorKeys = append(spec.ValidatorKeys, &v1.ValidatorKeys{
+			Index:            int32(k.Index),
+			PrivValidatorKey: k.PrivValidatorKey,
+			NodeKey:          k.NodeKey,
+		})
+	}
+
+	for _, s := range d.Spec.RemoteSigners {
+		spec.RemoteSigners = append(spec.RemoteSigners, &v1.RemoteSigner{
+			Index:           int32(s.Index),
+			Tmkms:           s.TMKMS,
+			Image:           s.Image,
+			ProtocolVersion: s.ProtocolVersion,
+		})
+	}
+
+	for _, w := range d.Spec.Webhooks {
+		spec.Webhooks = append(spec.Webhooks, &v1.Webhook{
+			Url:      w.URL,
+			Format:   w.Format,
+			Events:   w.Events,
+			Template: w.Template,
+		})
+	}
+
+	if h := d.Spec.Hooks; h != nil {
+		spec.Hooks = &v1.Hooks{
+			PostFork:    yamlHooksToProto(h.PostFork),
+			PreStart:    yamlHooksToProto(h.PreStart),
+			PostHealthy: yamlHooksToProto(h.PostHealthy),
+		}
+	}
+
+	if b := d.Spec.Bootstrap; b != nil {
+		spec.Bootstrap = &v1.Bootstrap{GasPrice: b.GasPrice}
+		for _, c := range b.Contracts {
+			spec.Bootstrap.Contracts = append(spec.Bootstrap.Contracts, &v1.Contract{
+				Name:    c.Name,
+				Type:    c.Type,
+				Source:  c.Source,
+				InitMsg: c.InitMsg,
+				Args:    c.Args,
+				Label:   c.Label,
+				Admin:   c.Admin,
+				Funds:   c.Funds,
+			})
+		}
+	}
+
+	for _, h := range d.Spec.Hosts {
+		spec.Hosts = append(spec.Hosts, &v1.RemoteHost{
+			Name:         h.Name,
+			Address:      h.Address,
+			User:         h.User,
+			Port:         int32(h.Port),
+			IdentityFile: h.IdentityFile,
+			Dir:          h.Dir,
+		})
+	}
+
+	if ns := d.Spec.NetworkShaping; ns != nil {
+		spec.NetworkShaping = &v1.NetworkShaping{Image: ns.Image}
+		for _, l := range ns.Links {
+			spec.NetworkShaping.Links = append(spec.NetworkShaping.Links, &v1.NetworkLink{
+				From:    l.From,
+				To:      l.To,
+				Latency: l.Latency,
+				Jitter:  l.Jitter,
+				Loss:    l.Loss,
+				Rate:    l.Rate,
+			})
+		}
+	}
+	for _, n := range d.Spec.Nodes {
+		if n.Region == "" {
+			continue
+		}
+		if spec.NetworkShaping == nil {
+			spec.NetworkShaping = &v1.NetworkShaping{}
+		}
+		if spec.NetworkShaping.Regions == nil {
+			spec.NetworkShaping.Regions = make(map[int32]string)
+		}
+		spec.NetworkShaping.Regions[int32(n.Index)] = n.Region
+	}
+
+	for _, n := range d.Spec.Nodes {
+		if n.ConfigToml == "" && n.AppToml == "" {
+			continue
+		}
+		spec.NodeConfigs = append(spec.NodeConfigs, &v1.NodeConfig{
+			Index:      int32(n.Index),
+			ConfigToml: n.ConfigToml,
+			AppToml:    n.AppToml,
+		})
+	}
+
+	if p := d.Spec.GenesisPrune; p != nil {
+		spec.GenesisPrune = &v1.GenesisPrune{
+			IbcPackets:    p.IBCPackets,
+			DustThreshold: p.DustThreshold,
+			WasmCode:      p.WasmCode,
+		}
+	}
+
+	if p := d.Spec.VotingPower; p != nil {
+		spec.VotingPower = &v1.VotingPower{Profile: p.Profile, Weights: p.Weights}
+	}
+
+	if p := d.Spec.Ports; p != nil {
+		spec.Ports = &v1.PortLayout{
+			P2P:    int32(p.P2P),
+			Rpc:    int32(p.RPC),
+			Rest:   int32(p.REST),
+			Grpc:   int32(p.GRPC),
+			EvmRpc: int32(p.EVM),
+			Stride: int32(p.Stride),
+		}
 	}
 
 	// Apply defaults
@@ -75,7 +228,154 @@ func YAMLDevnetFromProto(pb *v1.Devnet) YAMLDevnet {
 			NetworkVersion: pb.Spec.SdkVersion,
 			Validators:     int(pb.Spec.Validators),
 			FullNodes:      int(pb.Spec.FullNodes),
+			Accounts:       int(pb.Spec.Accounts),
 			Mode:           pb.Spec.Mode,
+			Image:          pb.Spec.Image,
+			Profile:        pb.Spec.Profile,
+			TTL:            pb.Spec.Ttl,
+			DeleteOnExpiry: pb.Spec.DeleteOnExpiry,
+			IdleTimeout:    pb.Spec.IdleTimeout,
+			BlockTime:      pb.Spec.BlockTime,
+			EpochDuration:  pb.Spec.EpochDuration,
+			BindAddress:    pb.Spec.BindAddress,
+			Seed:           pb.Spec.Seed,
+			ForkNetwork:    pb.Spec.ForkNetwork,
+			GenesisPath:    pb.Spec.GenesisPath,
+			SnapshotURL:    pb.Spec.SnapshotUrl,
+			RPCURL:         pb.Spec.RpcUrl,
+		}
+
+		if len(pb.Spec.GenesisOverrides) > 0 {
+			yaml.Spec.GenesisOverrides = make(map[string]interface{}, len(pb.Spec.GenesisOverrides))
+			for path, encoded := range pb.Spec.GenesisOverrides {
+				var value interface{}
+				if err := json.Unmarshal([]byte(encoded), &value); err != nil {
+					value = encoded
+				}
+				yaml.Spec.GenesisOverrides[path] = value
+			}
+		}
+
+		for _, acc := range pb.Spec.FundedAccounts {
+			yaml.Spec.FundedAccounts = append(yaml.Spec.FundedAccounts, yamlFundedAccountFromProto(acc))
+		}
+
+		for _, k := range pb.Spec.ValidatorKeys {
+			yaml.Spec.ValidatorKeys = append(yaml.Spec.ValidatorKeys, YAMLValidatorKeys{
+				Index:            int(k.Index),
+				PrivValidatorKey: k.PrivValidatorKey,
+				NodeKey:          k.NodeKey,
+			})
+		}
+
+		for _, s := range pb.Spec.RemoteSigners {
+			yaml.Spec.RemoteSigners = append(yaml.Spec.RemoteSigners, YAMLRemoteSigner{
+				Index:           int(s.Index),
+				TMKMS:           s.Tmkms,
+				Image:           s.Image,
+				ProtocolVersion: s.ProtocolVersion,
+			})
+		}
+
+		for _, w := range pb.Spec.Webhooks {
+			yaml.Spec.Webhooks = append(yaml.Spec.Webhooks, YAMLWebhook{
+				URL:      w.Url,
+				Format:   w.Format,
+				Events:   w.Events,
+				Template: w.Template,
+			})
+		}
+
+		if h := pb.Spec.Hooks; h != nil {
+			yaml.Spec.Hooks = &YAMLHooks{
+				PostFork:    yamlHooksFromProto(h.PostFork),
+				PreStart:    yamlHooksFromProto(h.PreStart),
+				PostHealthy: yamlHooksFromProto(h.PostHealthy),
+			}
+		}
+
+		if b := pb.Spec.Bootstrap; b != nil {
+			yaml.Spec.Bootstrap = &YAMLBootstrap{GasPrice: b.GasPrice}
+			for _, c := range b.Contracts {
+				yaml.Spec.Bootstrap.Contracts = append(yaml.Spec.Bootstrap.Contracts, YAMLContract{
+					Name:    c.Name,
+					Type:    c.Type,
+					Source:  c.Source,
+					InitMsg: c.InitMsg,
+					Args:    c.Args,
+					Label:   c.Label,
+					Admin:   c.Admin,
+					Funds:   c.Funds,
+				})
+			}
+		}
+
+		for _, h := range pb.Spec.Hosts {
+			yaml.Spec.Hosts = append(yaml.Spec.Hosts, YAMLRemoteHost{
+				Name:         h.Name,
+				Address:      h.Address,
+				User:         h.User,
+				Port:         int(h.Port),
+				IdentityFile: h.IdentityFile,
+				Dir:          h.Dir,
+			})
+		}
+
+		for _, c := range pb.Spec.NodeConfigs {
+			yaml.Spec.Nodes = append(yaml.Spec.Nodes, YAMLNodeOverride{
+				Index:      int(c.Index),
+				ConfigToml: c.ConfigToml,
+				AppToml:    c.AppToml,
+			})
+		}
+
+		if ns := pb.Spec.NetworkShaping; ns != nil {
+			if len(ns.Links) > 0 || ns.Image != "" {
+				yaml.Spec.NetworkShaping = &YAMLNetworkShaping{Image: ns.Image}
+				for _, l := range ns.Links {
+					yaml.Spec.NetworkShaping.Links = append(yaml.Spec.NetworkShaping.Links, YAMLNetworkLink{
+						From:    l.From,
+						To:      l.To,
+						Latency: l.Latency,
+						Jitter:  l.Jitter,
+						Loss:    l.Loss,
+						Rate:    l.Rate,
+					})
+				}
+			}
+			// Region labels live on the node overrides
+			for index, region := range ns.Regions {
+				i := slices.IndexFunc(yaml.Spec.Nodes, func(n YAMLNodeOverride) bool { return n.Index == int(index) })
+				if i < 0 {
+					yaml.Spec.Nodes = append(yaml.Spec.Nodes, YAMLNodeOverride{Index: int(index)})
+					i = len(yaml.Spec.Nodes) - 1
+				}
+				yaml.Spec.Nodes[i].Region = region
+			}
+			slices.SortStableFunc(yaml.Spec.Nodes, func(a, b YAMLNodeOverride) int { return a.Index - b.Index })
+		}
+
+		if p := pb.Spec.GenesisPrune; p != nil {
+			yaml.Spec.GenesisPrune = &YAMLGenesisPrune{
+				IBCPackets:    p.IbcPackets,
+				DustThreshold: p.DustThreshold,
+				WasmCode:      p.WasmCode,
+			}
+		}
+
+		if p := pb.Spec.VotingPower; p != nil {
+			yaml.Spec.VotingPower = &YAMLVotingPower{Profile: p.Profile, Weights: p.Weights}
+		}
+
+		if p := pb.Spec.Ports; p != nil {
+			yaml.Spec.Ports = &YAMLPortLayout{
+				P2P:    int(p.P2P),
+				RPC:    int(p.Rpc),
+				REST:   int(p.Rest),
+				GRPC:   int(p.Grpc),
+				EVM:    int(p.EvmRpc),
+				Stride: int(p.Stride),
+			}
 		}
 	}
 
@@ -95,3 +395,63 @@ func (d *YAMLDevnet) ToCreateRequest() *v1.CreateDevnetRequest {
 		Labels:    d.Metadata.Labels,
 	}
 }
+
+func (a YAMLFundedAccount) toProto() *v1.FundedAccount {
+	pb := &v1.FundedAccount{Address: a.Address, Coins: a.Coins}
+	if v := a.Vesting; v != nil {
+		pb.Vesting = &v1.VestingSchedule{Type: v.Type, Coins: v.Coins}
+		if !v.Start.IsZero() {
+			pb.Vesting.StartTime = timestamppb.New(v.Start)
+		}
+		if !v.End.IsZero() {
+			pb.Vesting.EndTime = timestamppb.New(v.End)
+		}
+	}
+	return pb
+}
+
+func yamlFundedAccountFromProto(pb *v1.FundedAccount) YAMLFundedAccount {
+	acc := YAMLFundedAccount{Address: pb.Address, Coins: pb.Coins}
+	if v := pb.Vesting; v != nil {
+		acc.Vesting = &YAMLVesting{Type: v.Type, Coins: v.Coins}
+		if v.StartTime != nil {
+			acc.Vesting.Start = v.StartTime.AsTime()
+		}
+		if v.EndTime != nil {
+			acc.Vesting.End = v.EndTime.AsTime()
+		}
+	}
+	return acc
+}
+
+// yamlHooksToProto converts the hooks of one lifecycle phase.
+func yamlHooksToProto(hooks []YAMLHook) []*v1.Hook {
+	var out []*v1.Hook
+	for _, h := range hooks {
+		out = append(out, &v1.Hook{
+			Name:      h.Name,
+			Command:   h.Command,
+			Script:    h.Script,
+			Image:     h.Image,
+			Timeout:   h.Timeout,
+			OnFailure: h.OnFailure,
+		})
+	}
+	return out
+}
+
+// yamlHooksFromProto converts the hooks of one lifecycle phase.
+func yamlHooksFromProto(pbs []*v1.Hook) []YAMLHook {
+	var out []YAMLHook
+	for _, h := range pbs {
+		out = append(out, YAMLHook{
+			Name:      h.Name,
+			Command:   h.Command,
+			Script:    h.Script,
+			Image:     h.Image,
+			Timeout:   h.Timeout,
+			OnFailure: h.OnFailure,
+		})
+	}
+	return out
+}
@@ -1,9 +1,13 @@
 package config
 
 import (
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"gopkg.in/yaml.v3"
 )
 
 func TestYAMLDevnet_ToProto(t *testing.T) {
@@ -45,6 +49,425 @@ func TestYAMLDevnet_ToProto(t *testing.T) {
 	}
 }
 
+func TestYAMLDevnet_GenesisOverrides_RoundTrip(t *testing.T) {
+	yaml := YAMLDevnet{
+		Metadata: YAMLMetadata{Name: "test-devnet"},
+		Spec: YAMLDevnetSpec{
+			Network: "stable",
+			GenesisOverrides: map[string]interface{}{
+				"app_state.gov.params.voting_period": "30s",
+				"app_state.staking.params":           map[string]interface{}{"max_validators": 4},
+			},
+		},
+	}
+
+	proto := yaml.ToProto()
+
+	if got := proto.Spec.GenesisOverrides["app_state.gov.params.voting_period"]; got != `"30s"` {
+		t.Errorf("expected JSON-encoded voting_period, got %s", got)
+	}
+	if got := proto.Spec.GenesisOverrides["app_state.staking.params"]; got != `{"max_validators":4}` {
+		t.Errorf("expected JSON-encoded staking params, got %s", got)
+	}
+
+	back := YAMLDevnetFromProto(proto)
+	if got := back.Spec.GenesisOverrides["app_state.gov.params.voting_period"]; got != "30s" {
+		t.Errorf("expected voting_period 30s after round trip, got %v", got)
+	}
+}
+
+func TestYAMLDevnet_FundedAccounts_RoundTrip(t *testing.T) {
+	content := `
+apiVersion: devnet.lagos/v1
+kind: Devnet
+metadata:
+  name: funded
+spec:
+  network: stable
+  fundedAccounts:
+    - address: "0x0202020202020202020202020202020202020202"
+      coins: 1000astable
+    - address: "0x0303030303030303030303030303030303030303"
+      coins: 500astable
+      vesting:
+        type: continuous
+        start: 2026-01-01T00:00:00Z
+        end: 2027-01-01T00:00:00Z
+`
+	var devnet YAMLDevnet
+	if err := yaml.Unmarshal([]byte(content), &devnet); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	proto := devnet.ToProto()
+	if len(proto.Spec.FundedAccounts) != 2 {
+		t.Fatalf("expected 2 funded accounts, got %d", len(proto.Spec.FundedAccounts))
+	}
+	vesting := proto.Spec.FundedAccounts[1].Vesting
+	if vesting == nil || vesting.Type != "continuous" {
+		t.Fatalf("expected continuous vesting, got %v", vesting)
+	}
+	if got := vesting.EndTime.AsTime(); !got.Equal(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected vesting end 2027-01-01, got %v", got)
+	}
+
+	back := YAMLDevnetFromProto(proto)
+	if back.Spec.FundedAccounts[0].Coins != "1000astable" || back.Spec.FundedAccounts[0].Vesting != nil {
+		t.Errorf("unexpected first account after round trip: %+v", back.Spec.FundedAccounts[0])
+	}
+	if !back.Spec.FundedAccounts[1].Vesting.Start.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected vesting start 2026-01-01, got %v", back.Spec.FundedAccounts[1].Vesting.Start)
+	}
+}
+
+func TestYAMLDevnet_Ports_RoundTrip(t *testing.T) {
+	content := `
+apiVersion: devnet.lagos/v1
+kind: Devnet
+metadata:
+  name: ports
+spec:
+  network: stable
+  bindAddress: "::"
+  ports:
+    rpc: 36657
+    evm: 9545
+    stride: 10
+`
+	var devnet YAMLDevnet
+	if err := yaml.Unmarshal([]byte(content), &devnet); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	proto := devnet.ToProto()
+	if p := proto.Spec.Ports; p == nil || p.Rpc != 36657 || p.EvmRpc != 9545 || p.Stride != 10 || p.Rest != 0 {
+		t.Fatalf("unexpected port layout: %v", proto.Spec.Ports)
+	}
+	if proto.Spec.BindAddress != "::" {
+		t.Errorf("expected bind address ::, got %q", proto.Spec.BindAddress)
+	}
+
+	back := YAMLDevnetFromProto(proto)
+	if back.Spec.Ports == nil || *back.Spec.Ports != (YAMLPortLayout{RPC: 36657, EVM: 9545, Stride: 10}) {
+		t.Errorf("unexpected port layout after round trip: %+v", back.Spec.Ports)
+	}
+	if back.Spec.BindAddress != "::" {
+		t.Errorf("expected bind address :: after round trip, got %q", back.Spec.BindAddress)
+	}
+}
+
+func TestYAMLDevnet_NodeConfigs_RoundTrip(t *testing.T) {
+	content := `
+apiVersion: devnet.lagos/v1
+kind: Devnet
+metadata:
+  name: tuned
+spec:
+  network: stable
+  validators: 2
+  nodes:
+    - index: 0
+      role: validator
+    - index: 1
+      configToml: |
+        [mempool]
+        size = 10000
+      appToml: |
+        pruning = "everything"
+`
+	var devnet YAMLDevnet
+	if err := yaml.Unmarshal([]byte(content), &devnet); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	proto := devnet.ToProto()
+	if len(proto.Spec.NodeConfigs) != 1 {
+		t.Fatalf("expected only node 1 to carry config snippets, got %v", proto.Spec.NodeConfigs)
+	}
+	c := proto.Spec.NodeConfigs[0]
+	if c.Index != 1 || c.ConfigToml != "[mempool]\nsize = 10000\n" || c.AppToml != "pruning = \"everything\"\n" {
+		t.Errorf("unexpected node config: %v", c)
+	}
+
+	back := YAMLDevnetFromProto(proto)
+	if len(back.Spec.Nodes) != 1 || back.Spec.Nodes[0].Index != 1 || back.Spec.Nodes[0].ConfigToml != c.ConfigToml || back.Spec.Nodes[0].AppToml != c.AppToml {
+		t.Errorf("unexpected nodes after round trip: %+v", back.Spec.Nodes)
+	}
+}
+
+func TestYAMLDevnet_Webhooks_RoundTrip(t *testing.T) {
+	content := `
+apiVersion: devnet.lagos/v1
+kind: Devnet
+metadata:
+  name: watched
+spec:
+  network: stable
+  webhooks:
+    - url: https://hooks.slack.com/services/x
+      format: slack
+      events: ["node.crashed", "upgrade.*"]
+      template: "{{.Node}}: {{.Message}}"
+`
+	var devnet YAMLDevnet
+	if err := yaml.Unmarshal([]byte(content), &devnet); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	proto := devnet.ToProto()
+	if len(proto.Spec.Webhooks) != 1 {
+		t.Fatalf("expected one webhook, got %v", proto.Spec.Webhooks)
+	}
+	w := proto.Spec.Webhooks[0]
+	if w.Url != "https://hooks.slack.com/services/x" || w.Format != "slack" || len(w.Events) != 2 || w.Template != "{{.Node}}: {{.Message}}" {
+		t.Errorf("unexpected webhook: %v", w)
+	}
+
+	back := YAMLDevnetFromProto(proto)
+	if len(back.Spec.Webhooks) != 1 || !reflect.DeepEqual(back.Spec.Webhooks[0], devnet.Spec.Webhooks[0]) {
+		t.Errorf("unexpected webhooks after round trip: %+v", back.Spec.Webhooks)
+	}
+}
+
+func TestYAMLDevnet_Hooks_RoundTrip(t *testing.T) {
+	content := `
+apiVersion: devnet.lagos/v1
+kind: Devnet
+metadata:
+  name: hooked
+spec:
+  network: stable
+  validators: 1
+  hooks:
+    postFork:
+      - command: jq '.app_state.gov.params.voting_period = "30s"' $DEVNET_GENESIS > g && mv g $DEVNET_GENESIS
+    postHealthy:
+      - name: deploy
+        script: /opt/deploy.sh
+        image: node:20
+        timeout: 10m
+        onFailure: ignore
+`
+	var devnet YAMLDevnet
+	if err := yaml.Unmarshal([]byte(content), &devnet); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if result := NewYAMLValidator().Validate(&devnet); !result.Valid {
+		t.Fatalf("Validate: %s", result.Error())
+	}
+
+	proto := devnet.ToProto()
+	if h := proto.Spec.Hooks; h == nil || len(h.PostFork) != 1 || len(h.PreStart) != 0 || len(h.PostHealthy) != 1 {
+		t.Fatalf("unexpected hooks: %v", proto.Spec.Hooks)
+	}
+	if h := proto.Spec.Hooks.PostHealthy[0]; h.Name != "deploy" || h.Image != "node:20" || h.Timeout != "10m" || h.OnFailure != "ignore" {
+		t.Errorf("unexpected hook: %v", h)
+	}
+
+	back := YAMLDevnetFromProto(proto)
+	if !reflect.DeepEqual(back.Spec.Hooks, devnet.Spec.Hooks) {
+		t.Errorf("unexpected hooks after round trip: %+v", back.Spec.Hooks)
+	}
+}
+
+func TestYAMLDevnet_Bootstrap_RoundTrip(t *testing.T) {
+	content := `
+apiVersion: devnet.lagos/v1
+kind: Devnet
+metadata:
+  name: contracts
+spec:
+  network: stable
+  validators: 1
+  bootstrap:
+    gasPrice: 0.025stake
+    contracts:
+      - name: cw20
+        type: wasm
+        source: https://example.com/cw20_base.wasm
+        initMsg: '{"name":"Token","symbol":"TKN","decimals":6,"initial_balances":[]}'
+        admin: cosmos1admin
+      - name: token
+        type: evm
+        source: /contracts/Token.json
+        args: "0x0000000000000000000000000000000000000000000000000000000000000001"
+`
+	var devnet YAMLDevnet
+	if err := yaml.Unmarshal([]byte(content), &devnet); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if result := NewYAMLValidator().Validate(&devnet); !result.Valid {
+		t.Fatalf("Validate: %s", result.Error())
+	}
+
+	proto := devnet.ToProto()
+	if b := proto.Spec.Bootstrap; b == nil || b.GasPrice != "0.025stake" || len(b.Contracts) != 2 {
+		t.Fatalf("unexpected bootstrap: %v", proto.Spec.Bootstrap)
+	}
+	if c := proto.Spec.Bootstrap.Contracts[0]; c.Type != "wasm" || c.Admin != "cosmos1admin" || c.InitMsg == "" {
+		t.Errorf("unexpected contract: %v", c)
+	}
+
+	back := YAMLDevnetFromProto(proto)
+	if !reflect.DeepEqual(back.Spec.Bootstrap, devnet.Spec.Bootstrap) {
+		t.Errorf("unexpected bootstrap after round trip: %+v", back.Spec.Bootstrap)
+	}
+
+	devnet.Spec.Bootstrap.Contracts[1].Source = "Token.json"
+	if result := NewYAMLValidator().Validate(&devnet); result.Valid || !strings.Contains(result.Error(), "spec.bootstrap") {
+		t.Errorf("relative source accepted: %s", result.Error())
+	}
+}
+
+func TestYAMLDevnet_Hosts_RoundTrip(t *testing.T) {
+	content := `
+apiVersion: devnet.lagos/v1
+kind: Devnet
+metadata:
+  name: spread
+spec:
+  network: stable
+  mode: local
+  validators: 4
+  hosts:
+    - name: box-a
+      address: 10.0.0.11
+      user: dvb
+      identityFile: /home/me/.ssh/devnet
+    - name: box-b
+      address: box-b.lan
+      port: 2222
+      dir: /srv/devnet
+`
+	var devnet YAMLDevnet
+	if err := yaml.Unmarshal([]byte(content), &devnet); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if result := NewYAMLValidator().Validate(&devnet); !result.Valid {
+		t.Fatalf("Validate: %s", result.Error())
+	}
+
+	proto := devnet.ToProto()
+	if len(proto.Spec.Hosts) != 2 || proto.Spec.Hosts[1].Port != 2222 || proto.Spec.Hosts[0].IdentityFile != "/home/me/.ssh/devnet" {
+		t.Fatalf("unexpected hosts: %v", proto.Spec.Hosts)
+	}
+
+	back := YAMLDevnetFromProto(proto)
+	if !reflect.DeepEqual(back.Spec.Hosts, devnet.Spec.Hosts) {
+		t.Errorf("unexpected hosts after round trip: %+v", back.Spec.Hosts)
+	}
+
+	devnet.Spec.Mode = "docker"
+	devnet.Spec.Hosts[1].Name = "box-a"
+	result := NewYAMLValidator().Validate(&devnet)
+	if result.Valid || !strings.Contains(result.Error(), "duplicate") || !strings.Contains(result.Error(), "mode 'local'") {
+		t.Errorf("invalid hosts accepted: %s", result.Error())
+	}
+}
+
+func TestYAMLDevnet_NetworkShaping_RoundTrip(t *testing.T) {
+	content := `
+apiVersion: devnet.lagos/v1
+kind: Devnet
+metadata:
+  name: regions
+spec:
+  network: stable
+  mode: docker
+  validators: 4
+  networkShaping:
+    links:
+      - from: us-east
+        to: eu-west
+        latency: 100ms
+        jitter: 20ms
+        loss: 1%
+      - from: eu-west
+        to: eu-west
+        rate: 10mbit
+  nodes:
+    - index: 0
+      region: us-east
+      appToml: |
+        pruning = "everything"
+    - index: 1
+      region: us-east
+    - index: 2
+      region: eu-west
+    - index: 3
+      region: eu-west
+`
+	var devnet YAMLDevnet
+	if err := yaml.Unmarshal([]byte(content), &devnet); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if result := NewYAMLValidator().Validate(&devnet); !result.Valid {
+		t.Fatalf("Validate: %s", result.Error())
+	}
+
+	proto := devnet.ToProto()
+	ns := proto.Spec.NetworkShaping
+	if ns == nil || len(ns.Links) != 2 || ns.Regions[2] != "eu-west" || len(ns.Regions) != 4 {
+		t.Fatalf("unexpected network shaping: %v", ns)
+	}
+	if len(proto.Spec.NodeConfigs) != 1 {
+		t.Errorf("region-only nodes became node configs: %v", proto.Spec.NodeConfigs)
+	}
+
+	back := YAMLDevnetFromProto(proto)
+	if !reflect.DeepEqual(back.Spec.NetworkShaping, devnet.Spec.NetworkShaping) {
+		t.Errorf("unexpected network shaping after round trip: %+v", back.Spec.NetworkShaping)
+	}
+	if !reflect.DeepEqual(back.Spec.Nodes, devnet.Spec.Nodes) {
+		t.Errorf("unexpected nodes after round trip: %+v", back.Spec.Nodes)
+	}
+
+	devnet.Spec.Mode = "local"
+	devnet.Spec.NetworkShaping.Links[1].To = "mars"
+	result := NewYAMLValidator().Validate(&devnet)
+	if result.Valid || !strings.Contains(result.Error(), `unknown region "mars"`) || !strings.Contains(result.Error(), "mode 'docker'") {
+		t.Errorf("invalid network shaping accepted: %s", result.Error())
+	}
+}
+
+func TestYAMLDevnet_VotingPower_RoundTrip(t *testing.T) {
+	content := `
+apiVersion: devnet.lagos/v1
+kind: Devnet
+metadata:
+  name: power
+spec:
+  network: stable
+  validators: 3
+  votingPower:
+    profile: custom
+    weights: [50, 30, 20]
+`
+	var devnet YAMLDevnet
+	if err := yaml.Unmarshal([]byte(content), &devnet); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if result := NewYAMLValidator().Validate(&devnet); !result.Valid {
+		t.Fatalf("Validate() errors = %v", result.Errors)
+	}
+
+	proto := devnet.ToProto()
+	if p := proto.Spec.VotingPower; p == nil || p.Profile != "custom" || !reflect.DeepEqual(p.Weights, []float64{50, 30, 20}) {
+		t.Fatalf("unexpected voting power: %v", proto.Spec.VotingPower)
+	}
+
+	back := YAMLDevnetFromProto(proto)
+	if !reflect.DeepEqual(back.Spec.VotingPower, devnet.Spec.VotingPower) {
+		t.Errorf("unexpected voting power after round trip: %+v", back.Spec.VotingPower)
+	}
+
+	// One weight per validator
+	devnet.Spec.Validators = 4
+	if result := NewYAMLValidator().Validate(&devnet); result.Valid || result.Errors[0].Field != "spec.votingPower" {
+		t.Errorf("Validate() should reject 3 weights for 4 validators, got: %v", result.Errors)
+	}
+}
+
 func TestYAMLDevnet_FromProto(t *testing.T) {
 	proto := &v1.Devnet{
 		Metadata: &v1.DevnetMetadata{
@@ -26,11 +26,17 @@ func (l *YAMLLoader) LoadFile(path string) ([]YAMLDevnet, error) {
 	}
 	defer f.Close()
 
-	return l.LoadReader(f, path)
+	return l.load(f, path, filepath.Dir(path))
 }
 
-// LoadReader loads devnet definitions from a reader
+// LoadReader loads devnet definitions from a reader. Relative key file
+// paths are resolved against the working directory.
 func (l *YAMLLoader) LoadReader(r io.Reader, source string) ([]YAMLDevnet, error) {
+	return l.load(r, source, ".")
+}
+
+// load decodes devnet definitions, reading key files relative to baseDir.
+func (l *YAMLLoader) load(r io.Reader, source, baseDir string) ([]YAMLDevnet, error) {
 	decoder := yaml.NewDecoder(r)
 	var devnets []YAMLDevnet
 
@@ -45,6 +51,10 @@ func (l *YAMLLoader) LoadReader(r io.Reader, source string) ([]YAMLDevnet, error
 			return nil, fmt.Errorf("failed to decode YAML document %d in %s: %w", docIndex, source, err)
 		}
 
+		if err := devnet.Spec.loadValidatorKeyFiles(baseDir); err != nil {
+			return nil, fmt.Errorf("failed to load keys for document %d in %s: %w", docIndex, source, err)
+		}
+
 		// Validate each document
 		if err := devnet.Validate(); err != nil {
 			return nil, fmt.Errorf("validation failed for document %d in %s: %w", docIndex, source, err)
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -102,3 +103,56 @@ spec:
 		t.Error("LoadFile should fail for invalid YAML")
 	}
 }
+
+func TestYAMLLoader_LoadFile_ValidatorKeyFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	keyDir := filepath.Join(tmpDir, "keys")
+	if err := os.MkdirAll(keyDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	privKey := `{"priv_key":"from-file"}`
+	if err := os.WriteFile(filepath.Join(keyDir, "priv_validator_key.json"), []byte(privKey), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	yamlPath := filepath.Join(tmpDir, "devnet.yaml")
+	content := `apiVersion: devnet.lagos/v1
+kind: Devnet
+metadata:
+  name: rehearsal
+spec:
+  network: stable
+  validators: 2
+  validatorKeys:
+    - index: 1
+      privValidatorKeyFile: keys/priv_validator_key.json
+      nodeKey: '{"priv_key":"inline"}'
+`
+	if err := os.WriteFile(yamlPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	devnets, err := NewYAMLLoader().LoadFile(yamlPath)
+	if err != nil {
+		t.Fatalf("LoadFile failed: %v", err)
+	}
+	keys := devnets[0].Spec.ValidatorKeys
+	if len(keys) != 1 {
+		t.Fatalf("expected 1 validator keys entry, got %d", len(keys))
+	}
+	if keys[0].PrivValidatorKey != privKey || keys[0].PrivValidatorKeyFile != "" {
+		t.Errorf("priv validator key file not read relative to the devnet file: %+v", keys[0])
+	}
+	if keys[0].NodeKey != `{"priv_key":"inline"}` {
+		t.Errorf("inline node key changed: %q", keys[0].NodeKey)
+	}
+
+	// A missing key file fails the load
+	missing := strings.Replace(content, "keys/priv_validator_key.json", "keys/missing.json", 1)
+	if err := os.WriteFile(yamlPath, []byte(missing), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewYAMLLoader().LoadFile(yamlPath); err == nil || !strings.Contains(err.Error(), "spec.validatorKeys[0].privValidatorKeyFile") {
+		t.Errorf("expected missing key file error, got %v", err)
+	}
+}
@@ -2,8 +2,22 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/bootstrap"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/configpatch"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/genesispatch"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/hooks"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/netem"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/nodekeys"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/notify"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/signer"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
 )
 
 // ValidationError represents a single validation error
@@ -41,6 +55,7 @@ type YAMLValidator struct {
 	MaxValidators int
 	MinValidators int
 	MaxFullNodes  int
+	MaxAccounts   int
 	ValidModes    []string
 }
 
@@ -50,6 +65,7 @@ func NewYAMLValidator() *YAMLValidator {
 		MaxValidators: 4,
 		MinValidators: 1,
 		MaxFullNodes:  10,
+		MaxAccounts:   100,
 		ValidModes:    []string{"docker", "local"},
 	}
 }
@@ -122,6 +138,15 @@ func (v *YAMLValidator) Validate(devnet *YAMLDevnet) *ValidationResult {
 		})
 	}
 
+	// Validate spec.accounts
+	if devnet.Spec.Accounts < 0 || devnet.Spec.Accounts > v.MaxAccounts {
+		result.Valid = false
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "spec.accounts",
+			Message: fmt.Sprintf("must be between 0 and %d, got %d", v.MaxAccounts, devnet.Spec.Accounts),
+		})
+	}
+
 	// Validate spec.mode if provided
 	if devnet.Spec.Mode != "" {
 		validMode := false
@@ -151,9 +176,282 @@ func (v *YAMLValidator) Validate(devnet *YAMLDevnet) *ValidationResult {
 		}
 	}
 
+	// Validate spec.ttl if provided
+	if devnet.Spec.TTL != "" {
+		if d, err := time.ParseDuration(devnet.Spec.TTL); err != nil || d <= 0 {
+			result.Valid = false
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "spec.ttl",
+				Message: fmt.Sprintf("must be a positive duration (e.g., 4h), got %q", devnet.Spec.TTL),
+			})
+		}
+	}
+
+	// Validate spec.idleTimeout if provided
+	if devnet.Spec.IdleTimeout != "" {
+		if d, err := time.ParseDuration(devnet.Spec.IdleTimeout); err != nil || d <= 0 {
+			result.Valid = false
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "spec.idleTimeout",
+				Message: fmt.Sprintf("must be a positive duration (e.g., 30m), got %q", devnet.Spec.IdleTimeout),
+			})
+		}
+	}
+
+	// Validate spec.blockTime and spec.epochDuration if provided
+	for _, f := range []struct{ field, value, example string }{
+		{"spec.blockTime", devnet.Spec.BlockTime, "500ms"},
+		{"spec.epochDuration", devnet.Spec.EpochDuration, "1m"},
+	} {
+		if f.value == "" {
+			continue
+		}
+		if d, err := time.ParseDuration(f.value); err != nil || d <= 0 {
+			result.Valid = false
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   f.field,
+				Message: fmt.Sprintf("must be a positive duration (e.g., %s), got %q", f.example, f.value),
+			})
+		}
+	}
+
+	// Validate spec.genesisOverrides paths and values
+	for _, path := range sortedKeys(devnet.Spec.GenesisOverrides) {
+		if _, err := genesispatch.ParsePath(path); err != nil {
+			result.Valid = false
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   fmt.Sprintf("spec.genesisOverrides[%s]", path),
+				Message: fmt.Sprintf("invalid path: %v", err),
+			})
+			continue
+		}
+		if _, err := json.Marshal(devnet.Spec.GenesisOverrides[path]); err != nil {
+			result.Valid = false
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   fmt.Sprintf("spec.genesisOverrides[%s]", path),
+				Message: "value cannot be encoded as JSON",
+			})
+		}
+	}
+
+	// Validate spec.fundedAccounts addresses, coins and vesting
+	seen := make(map[string]bool)
+	for i, acc := range devnet.Spec.FundedAccounts {
+		field := fmt.Sprintf("spec.fundedAccounts[%d]", i)
+		account := genesispatch.Account{Address: acc.Address, Coins: acc.Coins}
+		if v := acc.Vesting; v != nil {
+			account.Vesting = &genesispatch.Vesting{Type: v.Type, Coins: v.Coins, Start: v.Start, End: v.End}
+		}
+		if err := genesispatch.ValidateAccounts([]genesispatch.Account{account}); err != nil {
+			result.Valid = false
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   field,
+				Message: err.Error(),
+			})
+			continue
+		}
+		if seen[strings.ToLower(acc.Address)] {
+			result.Valid = false
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   field,
+				Message: fmt.Sprintf("duplicate address %s", acc.Address),
+			})
+		}
+		seen[strings.ToLower(acc.Address)] = true
+	}
+
+	// Validate spec.genesisPrune.dustThreshold is a single coin
+	if prune := devnet.Spec.GenesisPrune; prune != nil && prune.DustThreshold != "" {
+		if _, err := genesispatch.TrimDustAccounts(prune.DustThreshold); err != nil {
+			result.Valid = false
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "spec.genesisPrune.dustThreshold",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	// Validate spec.votingPower profile and weights
+	if vp := devnet.Spec.VotingPower; vp != nil {
+		if err := genesispatch.ValidatePower(vp.Profile, vp.Weights, devnet.Spec.Validators); err != nil {
+			result.Valid = false
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "spec.votingPower",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	// Validate spec.validatorKeys slots and key files
+	keys := make([]nodekeys.Keys, 0, len(devnet.Spec.ValidatorKeys))
+	for _, k := range devnet.Spec.ValidatorKeys {
+		keys = append(keys, nodekeys.Keys{Index: k.Index, PrivValidatorKey: k.PrivValidatorKey, NodeKey: k.NodeKey})
+	}
+	if err := nodekeys.Validate(keys, devnet.Spec.Validators); err != nil {
+		result.Valid = false
+		for _, msg := range strings.Split(err.Error(), "\n") {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "spec.validatorKeys",
+				Message: msg,
+			})
+		}
+	}
+
+	// Validate spec.remoteSigners slots and protocol versions
+	signers := make([]types.RemoteSigner, 0, len(devnet.Spec.RemoteSigners))
+	for _, s := range devnet.Spec.RemoteSigners {
+		signers = append(signers, types.RemoteSigner{Index: s.Index, TMKMS: s.TMKMS, Image: s.Image, ProtocolVersion: s.ProtocolVersion})
+	}
+	if err := signer.Validate(signers, devnet.Spec.Validators); err != nil {
+		result.Valid = false
+		for _, msg := range strings.Split(err.Error(), "\n") {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "spec.remoteSigners",
+				Message: msg,
+			})
+		}
+	}
+
+	// Validate spec.nodes config snippets
+	var nodeConfigs []types.NodeConfig
+	for _, n := range devnet.Spec.Nodes {
+		if n.ConfigToml != "" || n.AppToml != "" {
+			nodeConfigs = append(nodeConfigs, types.NodeConfig{Index: n.Index, ConfigToml: n.ConfigToml, AppToml: n.AppToml})
+		}
+	}
+	if err := configpatch.ValidateNodeConfigs(nodeConfigs, devnet.Spec.Validators+devnet.Spec.FullNodes); err != nil {
+		result.Valid = false
+		for _, msg := range strings.Split(err.Error(), "\n") {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "spec.nodes",
+				Message: msg,
+			})
+		}
+	}
+
+	// Validate spec.webhooks
+	webhooks := make([]types.Webhook, 0, len(devnet.Spec.Webhooks))
+	for _, w := range devnet.Spec.Webhooks {
+		webhooks = append(webhooks, types.Webhook{URL: w.URL, Format: w.Format, Events: w.Events, Template: w.Template})
+	}
+	if err := notify.Validate(webhooks); err != nil {
+		result.Valid = false
+		for _, msg := range strings.Split(err.Error(), "\n") {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "spec.webhooks",
+				Message: msg,
+			})
+		}
+	}
+
+	// Validate spec.hooks
+	if h := devnet.Spec.Hooks; h != nil {
+		if err := hooks.Validate(types.Hooks{
+			PostFork:    yamlHooksToTypes(h.PostFork),
+			PreStart:    yamlHooksToTypes(h.PreStart),
+			PostHealthy: yamlHooksToTypes(h.PostHealthy),
+		}); err != nil {
+			result.Valid = false
+			for _, msg := range strings.Split(err.Error(), "\n") {
+				result.Errors = append(result.Errors, ValidationError{
+					Field:   "spec.hooks",
+					Message: msg,
+				})
+			}
+		}
+	}
+
+	// Validate spec.bootstrap
+	if b := devnet.Spec.Bootstrap; b != nil {
+		spec := types.Bootstrap{GasPrice: b.GasPrice}
+		for _, c := range b.Contracts {
+			spec.Contracts = append(spec.Contracts, types.Contract(c))
+		}
+		if err := bootstrap.Validate(spec); err != nil {
+			result.Valid = false
+			for _, msg := range strings.Split(err.Error(), "\n") {
+				result.Errors = append(result.Errors, ValidationError{
+					Field:   "spec.bootstrap",
+					Message: msg,
+				})
+			}
+		}
+	}
+
+	// Validate spec.hosts
+	if len(devnet.Spec.Hosts) > 0 {
+		hosts := make([]types.RemoteHost, 0, len(devnet.Spec.Hosts))
+		for _, h := range devnet.Spec.Hosts {
+			hosts = append(hosts, types.RemoteHost(h))
+		}
+		if err := runtime.ValidateHosts(hosts); err != nil {
+			result.Valid = false
+			for _, msg := range strings.Split(err.Error(), "\n") {
+				result.Errors = append(result.Errors, ValidationError{
+					Field:   "spec.hosts",
+					Message: msg,
+				})
+			}
+		}
+		if devnet.Spec.Mode == "docker" {
+			result.Valid = false
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "spec.hosts",
+				Message: "hosts require mode 'local'; docker nodes run on the daemon's host",
+			})
+		}
+		if len(devnet.Spec.RemoteSigners) > 0 {
+			result.Valid = false
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "spec.hosts",
+				Message: "hosts cannot be combined with remoteSigners",
+			})
+		}
+	}
+
+	// Validate spec.networkShaping and the nodes' regions
+	shaping := types.NetworkShaping{Regions: make(map[int]string)}
+	for _, n := range devnet.Spec.Nodes {
+		if n.Region != "" {
+			shaping.Regions[n.Index] = n.Region
+		}
+	}
+	if ns := devnet.Spec.NetworkShaping; ns != nil {
+		for _, l := range ns.Links {
+			shaping.Links = append(shaping.Links, types.NetworkLink(l))
+		}
+	}
+	if len(shaping.Regions) > 0 || len(shaping.Links) > 0 {
+		if err := netem.Validate(shaping, devnet.Spec.Validators+devnet.Spec.FullNodes); err != nil {
+			result.Valid = false
+			for _, msg := range strings.Split(err.Error(), "\n") {
+				result.Errors = append(result.Errors, ValidationError{
+					Field:   "spec.networkShaping",
+					Message: msg,
+				})
+			}
+		}
+		if len(shaping.Links) > 0 && devnet.Spec.Mode == "local" {
+			result.Valid = false
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "spec.networkShaping",
+				Message: "network shaping requires mode 'docker'",
+			})
+		}
+	}
+
 	return result
 }
 
+// yamlHooksToTypes converts the hooks of one lifecycle phase for validation.
+func yamlHooksToTypes(list []YAMLHook) []types.Hook {
+	out := make([]types.Hook, 0, len(list))
+	for _, h := range list {
+		out = append(out, types.Hook(h))
+	}
+	return out
+}
+
 // ValidateInvariants performs validation and returns a simple error
 func (v *YAMLValidator) ValidateInvariants(devnet *YAMLDevnet) error {
 	result := v.Validate(devnet)
@@ -199,3 +497,13 @@ func FormatValidationErrors(result *ValidationResult, filename string) string {
 
 	return sb.String()
 }
+
+// sortedKeys returns the keys of m in sorted order, for stable error output.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
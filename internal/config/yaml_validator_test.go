@@ -175,6 +175,63 @@ func TestYAMLValidator_Validate_InvalidMode(t *testing.T) {
 	}
 }
 
+func TestYAMLValidator_Validate_InvalidTTL(t *testing.T) {
+	v := NewYAMLValidator()
+	devnet := &YAMLDevnet{
+		APIVersion: SupportedAPIVersion,
+		Kind:       SupportedKind,
+		Metadata:   YAMLMetadata{Name: "test"},
+		Spec: YAMLDevnetSpec{
+			Network:    "stable",
+			Validators: 2,
+			TTL:        "four hours",
+		},
+	}
+
+	result := v.Validate(devnet)
+
+	if result.Valid {
+		t.Error("Validate() should fail for invalid ttl")
+	}
+	if len(result.Errors) != 1 || result.Errors[0].Field != "spec.ttl" {
+		t.Errorf("Validate() should contain a single spec.ttl error, got: %v", result.Errors)
+	}
+
+	devnet.Spec.TTL = "4h"
+	if result := v.Validate(devnet); !result.Valid {
+		t.Errorf("Validate() should accept ttl 4h, got: %v", result.Errors)
+	}
+}
+
+func TestYAMLValidator_Validate_BlockTime(t *testing.T) {
+	v := NewYAMLValidator()
+	devnet := &YAMLDevnet{
+		APIVersion: SupportedAPIVersion,
+		Kind:       SupportedKind,
+		Metadata:   YAMLMetadata{Name: "test"},
+		Spec: YAMLDevnetSpec{
+			Network:       "stable",
+			Validators:    2,
+			BlockTime:     "0s",
+			EpochDuration: "a minute",
+		},
+	}
+
+	result := v.Validate(devnet)
+	if result.Valid || len(result.Errors) != 2 {
+		t.Fatalf("Validate() should report blockTime and epochDuration, got: %v", result.Errors)
+	}
+	if result.Errors[0].Field != "spec.blockTime" || result.Errors[1].Field != "spec.epochDuration" {
+		t.Errorf("Validate() errors = %v", result.Errors)
+	}
+
+	devnet.Spec.BlockTime = "500ms"
+	devnet.Spec.EpochDuration = "1m"
+	if result := v.Validate(devnet); !result.Valid {
+		t.Errorf("Validate() should accept blockTime 500ms and epochDuration 1m, got: %v", result.Errors)
+	}
+}
+
 func TestYAMLValidator_Validate_InvalidAPIVersion(t *testing.T) {
 	v := NewYAMLValidator()
 	devnet := &YAMLDevnet{
@@ -292,6 +349,100 @@ func TestYAMLValidator_Validate_InvalidNetworkType(t *testing.T) {
 	}
 }
 
+func TestYAMLValidator_Validate_InvalidGenesisOverridePath(t *testing.T) {
+	v := NewYAMLValidator()
+	devnet := &YAMLDevnet{
+		APIVersion: SupportedAPIVersion,
+		Kind:       SupportedKind,
+		Metadata:   YAMLMetadata{Name: "test"},
+		Spec: YAMLDevnetSpec{
+			Network:    "stable",
+			Validators: 2,
+			GenesisOverrides: map[string]interface{}{
+				"app_state..gov": "30s",
+			},
+		},
+	}
+
+	result := v.Validate(devnet)
+
+	if result.Valid {
+		t.Error("Validate() should fail for invalid genesis override path")
+	}
+	if len(result.Errors) != 1 || !strings.HasPrefix(result.Errors[0].Field, "spec.genesisOverrides") {
+		t.Errorf("Validate() should contain error about genesisOverrides, got: %v", result.Errors)
+	}
+}
+
+func TestYAMLValidator_Validate_FundedAccounts(t *testing.T) {
+	v := NewYAMLValidator()
+	address := "0x0202020202020202020202020202020202020202"
+	devnet := &YAMLDevnet{
+		APIVersion: SupportedAPIVersion,
+		Kind:       SupportedKind,
+		Metadata:   YAMLMetadata{Name: "test"},
+		Spec: YAMLDevnetSpec{
+			Network:    "stable",
+			Validators: 2,
+			FundedAccounts: []YAMLFundedAccount{
+				{Address: address, Coins: "1000astable"},
+				{Address: "0x0303030303030303030303030303030303030303", Coins: "1000astable", Vesting: &YAMLVesting{Type: "weekly"}},
+				{Address: address, Coins: "5astable"},
+			},
+		},
+	}
+
+	result := v.Validate(devnet)
+
+	if result.Valid {
+		t.Error("Validate() should fail for invalid funded accounts")
+	}
+	if len(result.Errors) != 2 {
+		t.Fatalf("expected 2 errors, got: %v", result.Errors)
+	}
+	if result.Errors[0].Field != "spec.fundedAccounts[1]" || !strings.Contains(result.Errors[0].Message, "vesting type") {
+		t.Errorf("expected vesting type error on account 1, got: %v", result.Errors[0])
+	}
+	if result.Errors[1].Field != "spec.fundedAccounts[2]" || !strings.Contains(result.Errors[1].Message, "duplicate") {
+		t.Errorf("expected duplicate address error on account 2, got: %v", result.Errors[1])
+	}
+}
+
+func TestYAMLValidator_Validate_NodeConfigs(t *testing.T) {
+	v := NewYAMLValidator()
+	devnet := &YAMLDevnet{
+		APIVersion: SupportedAPIVersion,
+		Kind:       SupportedKind,
+		Metadata:   YAMLMetadata{Name: "test"},
+		Spec: YAMLDevnetSpec{
+			Network:    "stable",
+			Validators: 2,
+			FullNodes:  1,
+			Nodes: []YAMLNodeOverride{
+				{Index: 2, ConfigToml: "[mempool]\nsize = 10000"},
+				{Index: 1, AppToml: "pruning = "},
+				{Index: 3, AppToml: `pruning = "everything"`},
+				{Index: 5, Role: "validator"},
+			},
+		},
+	}
+
+	result := v.Validate(devnet)
+
+	if result.Valid {
+		t.Error("Validate() should fail for invalid node config snippets")
+	}
+	if len(result.Errors) != 2 {
+		t.Fatalf("expected 2 errors, got: %v", result.Errors)
+	}
+	if result.Errors[0].Field != "spec.nodes" || !strings.Contains(result.Errors[0].Message, "node 1: invalid app.toml") {
+		t.Errorf("expected app.toml error on node 1, got: %v", result.Errors[0])
+	}
+	if !strings.Contains(result.Errors[1].Message, "node 3: index out of range") {
+		t.Errorf("expected index error on node 3, got: %v", result.Errors[1])
+	}
+}
+
 func TestYAMLValidator_Validate_InvalidFullNodesCount(t *testing.T) {
 	v := NewYAMLValidator()
 	devnet := &YAMLDevnet{
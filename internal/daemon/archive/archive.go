@@ -0,0 +1,369 @@
+// internal/daemon/archive/archive.go
+
+// Package archive packs a provisioned devnet into a portable tar.zst
+// archive and unpacks it again: the devnet and node resources, and the home
+// directory of every node with its config, chain data and keyring. Binaries
+// are not included; nodes keep their binary path, image and version so the
+// importing daemon can resolve them on its machine.
+//
+// An archive holds, in this order:
+//
+//	manifest.json              format version, source devnet and daemon
+//	devnet.json                the devnet resource
+//	nodes/<index>/node.json    each node resource, followed by
+//	nodes/<index>/home/...     the node's home directory
+package archive
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/version"
+	"github.com/klauspost/compress/zstd"
+)
+
+// FormatVersion is the version of the archive layout. Archives of a newer
+// format are refused.
+const FormatVersion = 1
+
+const (
+	manifestName = "manifest.json"
+	devnetName   = "devnet.json"
+	nodesDir     = "nodes"
+)
+
+// Manifest describes an archive.
+type Manifest struct {
+	FormatVersion int       `json:"formatVersion"`
+	CreatedAt     time.Time `json:"createdAt"`
+	// CreatedBy is the version of the daemon that wrote the archive.
+	CreatedBy string `json:"createdBy"`
+	Devnet    string `json:"devnet"`
+	Namespace string `json:"namespace"`
+	ChainID   string `json:"chainId,omitempty"`
+	Nodes     int    `json:"nodes"`
+	// Live is set when nodes were running during the export, so their
+	// chain data may not be consistent.
+	Live bool `json:"live,omitempty"`
+}
+
+// Contents are the resources read from an archive.
+type Contents struct {
+	Manifest Manifest
+	Devnet   *types.Devnet
+	Nodes    []*types.Node
+}
+
+// Write writes an archive of devnet and its nodes to w. live records that
+// the nodes may have been running while their homes were read.
+func Write(w io.Writer, devnet *types.Devnet, nodes []*types.Node, live bool) error {
+	zw, err := zstd.NewWriter(w)
+	if err != nil {
+		return err
+	}
+	tw := tar.NewWriter(zw)
+	now := time.Now().UTC()
+
+	manifest := Manifest{
+		FormatVersion: FormatVersion,
+		CreatedAt:     now,
+		CreatedBy:     version.Version,
+		Devnet:        devnet.Metadata.Name,
+		Namespace:     devnet.Metadata.Namespace,
+		ChainID:       devnet.Spec.ChainID,
+		Nodes:         len(nodes),
+		Live:          live,
+	}
+	if err := writeJSON(tw, manifestName, manifest, now); err != nil {
+		return err
+	}
+	if err := writeJSON(tw, devnetName, devnet, now); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		dir := path.Join(nodesDir, strconv.Itoa(node.Spec.Index))
+		if err := writeJSON(tw, dir+"/node.json", node, now); err != nil {
+			return err
+		}
+		if node.Spec.HomeDir == "" {
+			continue
+		}
+		if err := writeDir(tw, node.Spec.HomeDir, dir+"/home"); err != nil {
+			return fmt.Errorf("failed to archive home of node %d: %w", node.Spec.Index, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// writeJSON adds v as the JSON file name.
+func writeJSON(tw *tar.Writer, name string, v any, modTime time.Time) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), ModTime: modTime}); err != nil {
+		return err
+	}
+	_, err = tw.Write(data)
+	return err
+}
+
+// writeDir adds the directories, regular files and symlinks under root,
+// with their paths under prefix. Sockets and other special files, like the
+// socket of a remote signer, are left out.
+func writeDir(tw *tar.Writer, root, prefix string) error {
+	return filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		mode := info.Mode()
+		if !mode.IsDir() && !mode.IsRegular() && mode&fs.ModeSymlink == 0 {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		var link string
+		if mode&fs.ModeSymlink != 0 {
+			if link, err = os.Readlink(p); err != nil {
+				return err
+			}
+		}
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		hdr.Name = path.Join(prefix, filepath.ToSlash(rel))
+		if mode.IsDir() {
+			hdr.Name += "/"
+		}
+		hdr.Uname, hdr.Gname = "", ""
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if !mode.IsRegular() {
+			return nil
+		}
+
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.CopyN(tw, f, hdr.Size)
+		return err
+	})
+}
+
+// HomeDirFunc returns the directory a node's home is unpacked into.
+type HomeDirFunc func(node *types.Node) (string, error)
+
+// Read reads an archive from r, unpacking each node's home into the
+// directory homeDir returns for it. The nodes are returned as archived;
+// callers update their home directories and anything else that differs on
+// this machine.
+func Read(r io.Reader, homeDir HomeDirFunc) (*Contents, error) {
+	zr, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	tr := tar.NewReader(zr)
+
+	c := &Contents{}
+	homes := make(map[string]string) // node index → home directory
+	for i := 0; ; i++ {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		switch {
+		case i == 0:
+			if hdr.Name != manifestName {
+				return nil, fmt.Errorf("not a devnet archive: %s is missing", manifestName)
+			}
+			if err := readJSON(tr, hdr, &c.Manifest); err != nil {
+				return nil, err
+			}
+			if c.Manifest.FormatVersion > FormatVersion {
+				return nil, fmt.Errorf("archive format %d is newer than the supported format %d; upgrade devnet-builder", c.Manifest.FormatVersion, FormatVersion)
+			}
+		case hdr.Name == devnetName:
+			c.Devnet = &types.Devnet{}
+			if err := readJSON(tr, hdr, c.Devnet); err != nil {
+				return nil, err
+			}
+		default:
+			index, rest, ok := splitNodePath(hdr.Name)
+			if !ok {
+				return nil, fmt.Errorf("unexpected archive entry %q", hdr.Name)
+			}
+			if rest == "node.json" {
+				node := &types.Node{}
+				if err := readJSON(tr, hdr, node); err != nil {
+					return nil, err
+				}
+				home, err := homeDir(node)
+				if err != nil {
+					return nil, err
+				}
+				homes[index] = home
+				c.Nodes = append(c.Nodes, node)
+				continue
+			}
+			home, ok := homes[index]
+			rel, isHome := strings.CutPrefix(rest, "home/")
+			if rest == "home" {
+				rel, isHome = "", true
+			}
+			if !ok || !isHome {
+				return nil, fmt.Errorf("unexpected archive entry %q", hdr.Name)
+			}
+			if err := extract(tr, hdr, home, rel); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if c.Devnet == nil {
+		return nil, fmt.Errorf("not a devnet archive: %s is missing", devnetName)
+	}
+	return c, nil
+}
+
+// splitNodePath splits "nodes/<index>/<rest>" into the index and rest.
+func splitNodePath(name string) (index, rest string, ok bool) {
+	after, ok := strings.CutPrefix(name, nodesDir+"/")
+	if !ok {
+		return "", "", false
+	}
+	index, rest, ok = strings.Cut(after, "/")
+	if _, err := strconv.Atoi(index); err != nil {
+		return "", "", false
+	}
+	return index, rest, ok
+}
+
+// readJSON decodes the archive entry hdr into v.
+func readJSON(tr *tar.Reader, hdr *tar.Header, v any) error {
+	if err := json.NewDecoder(tr).Decode(v); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", hdr.Name, err)
+	}
+	return nil
+}
+
+// extract writes the archive entry hdr to rel under root. Entries may not
+// leave root: files and directories are created through an os.Root, so
+// they cannot be written through a symlink pointing out of it, and symlinks
+// must point inside root.
+func extract(tr *tar.Reader, hdr *tar.Header, root, rel string) error {
+	rel = strings.TrimSuffix(rel, "/")
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return err
+	}
+	if rel == "" {
+		return nil
+	}
+	if !filepath.IsLocal(filepath.FromSlash(rel)) {
+		return fmt.Errorf("archive entry %q escapes the node home", hdr.Name)
+	}
+
+	r, err := os.OpenRoot(root)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	if err := mkdirAll(r, path.Dir(rel), 0755); err != nil {
+		return fmt.Errorf("archive entry %q: %w", hdr.Name, err)
+	}
+
+	mode := hdr.FileInfo().Mode()
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		return mkdirAll(r, rel, mode.Perm()|0700)
+	case tar.TypeSymlink:
+		if filepath.IsAbs(hdr.Linkname) || !filepath.IsLocal(filepath.Join(filepath.Dir(filepath.FromSlash(rel)), hdr.Linkname)) {
+			return fmt.Errorf("archive entry %q links outside the node home", hdr.Name)
+		}
+		// os.Root cannot create symlinks, so make sure the parents are
+		// real directories before creating it by path
+		if err := checkNoSymlinks(r, path.Dir(rel)); err != nil {
+			return fmt.Errorf("archive entry %q: %w", hdr.Name, err)
+		}
+		return os.Symlink(hdr.Linkname, filepath.Join(root, filepath.FromSlash(rel)))
+	case tar.TypeReg:
+		f, err := r.OpenFile(rel, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode.Perm())
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(f, tr); err != nil {
+			f.Close()
+			return err
+		}
+		return f.Close()
+	default:
+		return nil
+	}
+}
+
+// mkdirAll creates the slash-separated directory dir and its parents in r.
+func mkdirAll(r *os.Root, dir string, perm os.FileMode) error {
+	if dir == "." || dir == "" {
+		return nil
+	}
+	if err := mkdirAll(r, path.Dir(dir), 0755); err != nil {
+		return err
+	}
+	err := r.Mkdir(dir, perm)
+	if errors.Is(err, fs.ErrExist) {
+		info, statErr := r.Stat(dir)
+		if statErr != nil {
+			return statErr
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("%s is not a directory", dir)
+		}
+		return nil
+	}
+	return err
+}
+
+// checkNoSymlinks returns an error if any component of the slash-separated
+// directory dir in r is a symlink.
+func checkNoSymlinks(r *os.Root, dir string) error {
+	for ; dir != "." && dir != ""; dir = path.Dir(dir) {
+		info, err := r.Lstat(dir)
+		if err != nil {
+			return err
+		}
+		if info.Mode()&fs.ModeSymlink != 0 {
+			return fmt.Errorf("%s is a symlink", dir)
+		}
+	}
+	return nil
+}
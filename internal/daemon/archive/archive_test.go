@@ -0,0 +1,192 @@
+// internal/daemon/archive/archive_test.go
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/klauspost/compress/zstd"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	src := t.TempDir()
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "mydev", Namespace: types.DefaultNamespace},
+		Spec:     types.DevnetSpec{Plugin: "cosmos", ChainID: "mydev-1", Validators: 2},
+	}
+	var nodes []*types.Node
+	for i := 0; i < 2; i++ {
+		home := filepath.Join(src, "node"+strconv.Itoa(i))
+		writeFile(t, filepath.Join(home, "config", "config.toml"), "moniker = \"node"+strconv.Itoa(i)+"\"\n")
+		writeFile(t, filepath.Join(home, "keyring-test", "validator.info"), "key")
+		if err := os.Symlink("config/config.toml", filepath.Join(home, "link.toml")); err != nil {
+			t.Fatal(err)
+		}
+		nodes = append(nodes, &types.Node{
+			Metadata: types.ResourceMeta{Name: "mydev-node-" + strconv.Itoa(i)},
+			Spec:     types.NodeSpec{DevnetRef: "mydev", Index: i, Role: "validator", HomeDir: home},
+			Status:   types.NodeStatus{Version: "v1.0.0"},
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, devnet, nodes, false); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	dst := t.TempDir()
+	c, err := Read(&buf, func(n *types.Node) (string, error) {
+		return filepath.Join(dst, "home"+strconv.Itoa(n.Spec.Index)), nil
+	})
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+
+	if c.Manifest.FormatVersion != FormatVersion || c.Manifest.Devnet != "mydev" || c.Manifest.ChainID != "mydev-1" || c.Manifest.Nodes != 2 {
+		t.Errorf("manifest = %+v", c.Manifest)
+	}
+	if c.Devnet.Spec.ChainID != "mydev-1" {
+		t.Errorf("devnet chain ID = %q", c.Devnet.Spec.ChainID)
+	}
+	if len(c.Nodes) != 2 || c.Nodes[1].Status.Version != "v1.0.0" {
+		t.Fatalf("nodes = %+v", c.Nodes)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dst, "home1", "config", "config.toml"))
+	if err != nil || string(data) != "moniker = \"node1\"\n" {
+		t.Errorf("config.toml = %q, %v", data, err)
+	}
+	info, err := os.Stat(filepath.Join(dst, "home0", "keyring-test", "validator.info"))
+	if err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("keyring file = %v, %v; want mode 0600", info, err)
+	}
+	if link, err := os.Readlink(filepath.Join(dst, "home0", "link.toml")); err != nil || link != "config/config.toml" {
+		t.Errorf("symlink = %q, %v", link, err)
+	}
+}
+
+// rawArchive builds a tar.zst archive from name → content entries in order.
+func rawArchive(t *testing.T, entries ...string) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	zw, err := zstd.NewWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tw := tar.NewWriter(zw)
+	for i := 0; i < len(entries); i += 2 {
+		if err := tw.WriteHeader(&tar.Header{Name: entries[i], Mode: 0644, Size: int64(len(entries[i+1]))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(entries[i+1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tw.Close()
+	zw.Close()
+	return &buf
+}
+
+func TestRead_Invalid(t *testing.T) {
+	manifest := `{"formatVersion":1}`
+	node := `{"spec":{"index":0}}`
+	tests := []struct {
+		name    string
+		archive *bytes.Buffer
+		wantErr string
+	}{
+		{"no manifest", rawArchive(t, "devnet.json", "{}"), "manifest.json is missing"},
+		{"newer format", rawArchive(t, "manifest.json", `{"formatVersion":99}`), "newer than the supported"},
+		{"no devnet", rawArchive(t, "manifest.json", manifest), "devnet.json is missing"},
+		{"home before node", rawArchive(t, "manifest.json", manifest, "devnet.json", "{}", "nodes/0/home/config.toml", ""), "unexpected archive entry"},
+		{"escaping path", rawArchive(t, "manifest.json", manifest, "devnet.json", "{}", "nodes/0/node.json", node, "nodes/0/home/../../evil", "x"), "escapes the node home"},
+		{"not the home", rawArchive(t, "manifest.json", manifest, "devnet.json", "{}", "nodes/0/node.json", node, "nodes/0/homefoo/x", "x"), "unexpected archive entry"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := t.TempDir()
+			_, err := Read(tt.archive, func(*types.Node) (string, error) { return dst, nil })
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Read() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// symlinkArchive builds a node archive whose home holds a symlink to
+// linkname, followed by a file written under the symlink.
+func symlinkArchive(t *testing.T, linkname string) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	zw, err := zstd.NewWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tw := tar.NewWriter(zw)
+	files := []struct{ name, content string }{
+		{"manifest.json", `{"formatVersion":1}`},
+		{"devnet.json", "{}"},
+		{"nodes/0/node.json", `{"spec":{"index":0}}`},
+	}
+	for _, f := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.content))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(f.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: "nodes/0/home/link", Typeflag: tar.TypeSymlink, Linkname: linkname, Mode: 0777}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: "nodes/0/home/link/evil", Mode: 0644, Size: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write([]byte("x")); err != nil {
+		t.Fatal(err)
+	}
+	tw.Close()
+	zw.Close()
+	return &buf
+}
+
+func TestRead_Symlinks(t *testing.T) {
+	outside := t.TempDir()
+	for _, linkname := range []string{outside, "../outside", "config/../../outside"} {
+		dst := filepath.Join(t.TempDir(), "home")
+		_, err := Read(symlinkArchive(t, linkname), func(*types.Node) (string, error) { return dst, nil })
+		if err == nil || !strings.Contains(err.Error(), "links outside the node home") {
+			t.Errorf("Read() with link to %q error = %v", linkname, err)
+		}
+	}
+
+	// A symlink already in the home may not be written through either
+	dst := t.TempDir()
+	if err := os.Symlink(outside, filepath.Join(dst, "link")); err != nil {
+		t.Fatal(err)
+	}
+	_, err := Read(rawArchive(t, "manifest.json", `{"formatVersion":1}`, "devnet.json", "{}", "nodes/0/node.json", `{"spec":{"index":0}}`, "nodes/0/home/link/evil", "x"),
+		func(*types.Node) (string, error) { return dst, nil })
+	if err == nil {
+		t.Error("Read() should refuse to write through a symlink out of the home")
+	}
+	if _, err := os.Stat(filepath.Join(outside, "evil")); !os.IsNotExist(err) {
+		t.Errorf("file written outside the node home: %v", err)
+	}
+}
@@ -0,0 +1,252 @@
+// internal/daemon/blocks/blocks.go
+
+// Package blocks reads recent blocks from a devnet node for lightweight
+// inspection: proposers, transaction counts and block intervals, and a
+// block's transactions decoded. It confirms chain liveness during upgrade
+// rehearsals without an external explorer.
+package blocks
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/cometrpc"
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"github.com/altuslabsxyz/devnet-builder/pkg/network/cosmos"
+)
+
+// ErrNotFound is returned for a height the node does not have.
+var ErrNotFound = errors.New("block not found")
+
+// blockchainPageSize is the most block metas CometBFT's /blockchain returns
+// per call.
+const blockchainPageSize = 20
+
+// Summary is a block header with its transaction count.
+type Summary struct {
+	Height int64
+	Time   time.Time
+	Hash   string
+	// ProposerAddress is the hex consensus address of the proposer.
+	ProposerAddress string
+	NumTxs          int
+	// Interval is the time since the previous block; zero for the first
+	// block or when the previous block is unknown.
+	Interval time.Duration
+}
+
+// Tx is a transaction in a block with its execution result.
+type Tx struct {
+	// Hash is the uppercase hex CometBFT transaction hash.
+	Hash      string
+	Code      uint32
+	Log       string
+	GasWanted int64
+	GasUsed   int64
+
+	// Decoded is the decoded transaction, or nil if it could not be decoded.
+	Decoded     *network.DecodedTx
+	DecodeError string
+}
+
+// Block is a block with its transactions.
+type Block struct {
+	Summary
+	Txs []Tx
+}
+
+// Config configures a Reader.
+type Config struct {
+	// RPCEndpoint is the CometBFT RPC base URL (e.g., "http://127.0.0.1:26657").
+	RPCEndpoint string
+
+	// Decoder decodes transaction bytes. Defaults to cosmos.DecodeTx, which
+	// knows the standard SDK modules.
+	Decoder func(txBytes []byte) (*network.DecodedTx, error)
+
+	// HTTPClient is used for queries. Defaults to a client with a 10s timeout.
+	HTTPClient *http.Client
+}
+
+// Reader reads blocks from a node.
+type Reader struct {
+	config Config
+	client *http.Client
+}
+
+// NewReader creates a new Reader.
+func NewReader(cfg Config) *Reader {
+	if cfg.Decoder == nil {
+		cfg.Decoder = cosmos.DecodeTx
+	}
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Reader{config: cfg, client: httpClient}
+}
+
+type blockMeta struct {
+	BlockID struct {
+		Hash string `json:"hash"`
+	} `json:"block_id"`
+	Header struct {
+		Height          string    `json:"height"`
+		Time            time.Time `json:"time"`
+		ProposerAddress string    `json:"proposer_address"`
+	} `json:"header"`
+	NumTxs string `json:"num_txs"`
+}
+
+func (m *blockMeta) toSummary() Summary {
+	s := Summary{
+		Time:            m.Header.Time,
+		Hash:            m.BlockID.Hash,
+		ProposerAddress: m.Header.ProposerAddress,
+	}
+	s.Height, _ = strconv.ParseInt(m.Header.Height, 10, 64)
+	s.NumTxs, _ = strconv.Atoi(m.NumTxs)
+	return s
+}
+
+// List returns up to limit of the most recent blocks, newest first.
+func (r *Reader) List(ctx context.Context, limit int) ([]Summary, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	var latest struct {
+		LastHeight string `json:"last_height"`
+	}
+	if err := r.rpcGet(ctx, "/blockchain", &latest); err != nil {
+		return nil, fmt.Errorf("failed to query latest height: %w", err)
+	}
+	height, err := strconv.ParseInt(latest.LastHeight, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid latest height %q", latest.LastHeight)
+	}
+	return r.summaries(ctx, height, limit)
+}
+
+// summaries returns up to limit blocks ending at maxHeight, newest first,
+// reading one block further back to compute the oldest block's interval.
+func (r *Reader) summaries(ctx context.Context, maxHeight int64, limit int) ([]Summary, error) {
+	minHeight := max(maxHeight-int64(limit), 1)
+
+	var metas []blockMeta
+	for top := maxHeight; top >= minHeight; top -= blockchainPageSize {
+		bottom := max(top-blockchainPageSize+1, minHeight)
+		var page struct {
+			BlockMetas []blockMeta `json:"block_metas"`
+		}
+		path := fmt.Sprintf("/blockchain?minHeight=%d&maxHeight=%d", bottom, top)
+		if err := r.rpcGet(ctx, path, &page); err != nil {
+			return nil, fmt.Errorf("failed to query blocks %d-%d: %w", bottom, top, err)
+		}
+		metas = append(metas, page.BlockMetas...)
+	}
+
+	out := make([]Summary, 0, len(metas))
+	for i := range metas {
+		s := metas[i].toSummary()
+		if i+1 < len(metas) {
+			s.Interval = s.Time.Sub(metas[i+1].Header.Time)
+		}
+		out = append(out, s)
+	}
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+// Get returns the block at height with its transactions decoded. A zero
+// height means the latest block.
+func (r *Reader) Get(ctx context.Context, height int64) (*Block, error) {
+	path := "/block"
+	if height > 0 {
+		path += "?height=" + strconv.FormatInt(height, 10)
+	}
+	var raw struct {
+		BlockID struct {
+			Hash string `json:"hash"`
+		} `json:"block_id"`
+		Block struct {
+			Header struct {
+				Height string `json:"height"`
+			} `json:"header"`
+			Data struct {
+				Txs [][]byte `json:"txs"`
+			} `json:"data"`
+		} `json:"block"`
+	}
+	if err := r.rpcGet(ctx, path, &raw); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("%w: height %d", ErrNotFound, height)
+		}
+		return nil, fmt.Errorf("failed to query block: %w", err)
+	}
+	height, _ = strconv.ParseInt(raw.Block.Header.Height, 10, 64)
+
+	summaries, err := r.summaries(ctx, height, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(summaries) == 0 {
+		return nil, fmt.Errorf("%w: height %d", ErrNotFound, height)
+	}
+	block := &Block{Summary: summaries[0]}
+	if len(raw.Block.Data.Txs) == 0 {
+		return block, nil
+	}
+
+	var results struct {
+		TxsResults []struct {
+			Code      uint32 `json:"code"`
+			Log       string `json:"log"`
+			GasWanted string `json:"gas_wanted"`
+			GasUsed   string `json:"gas_used"`
+		} `json:"txs_results"`
+	}
+	if err := r.rpcGet(ctx, "/block_results?height="+strconv.FormatInt(height, 10), &results); err != nil {
+		return nil, fmt.Errorf("failed to query block results: %w", err)
+	}
+
+	for i, txBytes := range raw.Block.Data.Txs {
+		sum := sha256.Sum256(txBytes)
+		tx := Tx{Hash: strings.ToUpper(hex.EncodeToString(sum[:]))}
+		if i < len(results.TxsResults) {
+			res := results.TxsResults[i]
+			tx.Code = res.Code
+			tx.Log = res.Log
+			tx.GasWanted, _ = strconv.ParseInt(res.GasWanted, 10, 64)
+			tx.GasUsed, _ = strconv.ParseInt(res.GasUsed, 10, 64)
+		}
+		if decoded, err := r.config.Decoder(txBytes); err != nil {
+			tx.DecodeError = err.Error()
+		} else {
+			tx.Decoded = decoded
+		}
+		block.Txs = append(block.Txs, tx)
+	}
+	return block, nil
+}
+
+// rpcGet calls a CometBFT RPC endpoint of the node and decodes its result,
+// returning ErrNotFound for a height past the node's tip.
+func (r *Reader) rpcGet(ctx context.Context, path string, out any) error {
+	err := cometrpc.Get(ctx, r.client, r.config.RPCEndpoint, path, out)
+	// CometBFT reports heights past the tip as "must be less than or equal
+	// to the current blockchain height".
+	var rpcErr *cometrpc.Error
+	if errors.As(err, &rpcErr) && strings.Contains(rpcErr.Data, "must be less than or equal to") {
+		return ErrNotFound
+	}
+	return err
+}
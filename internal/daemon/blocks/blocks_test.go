@@ -0,0 +1,147 @@
+// internal/daemon/blocks/blocks_test.go
+package blocks
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// chainStart is the time of block 1; block h is produced at
+// chainStart + (h-1)*2s, except block 25, which took 9s.
+var chainStart = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func blockTime(h int64) time.Time {
+	t := chainStart.Add(time.Duration(h-1) * 2 * time.Second)
+	if h >= 25 {
+		t = t.Add(7 * time.Second)
+	}
+	return t
+}
+
+func blockMetaJSON(h int64) string {
+	return fmt.Sprintf(`{"block_id":{"hash":"HASH%d"},"header":{"height":"%d","time":"%s","proposer_address":"PROP%d"},"num_txs":"%d"}`,
+		h, h, blockTime(h).Format(time.RFC3339Nano), h%2, h%3)
+}
+
+// newFakeNode serves a chain of latest blocks over CometBFT RPC.
+func newFakeNode(t *testing.T, latest int64) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch r.URL.Path {
+		case "/blockchain":
+			minH, maxH := int64(1), latest
+			if v := q.Get("minHeight"); v != "" {
+				minH, _ = strconv.ParseInt(v, 10, 64)
+				maxH, _ = strconv.ParseInt(q.Get("maxHeight"), 10, 64)
+			}
+			if maxH-minH+1 > blockchainPageSize {
+				minH = maxH - blockchainPageSize + 1
+			}
+			var metas []string
+			for h := maxH; h >= minH; h-- {
+				metas = append(metas, blockMetaJSON(h))
+			}
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"last_height":"%d","block_metas":[%s]}}`, latest, strings.Join(metas, ","))
+		case "/block":
+			h := latest
+			if v := q.Get("height"); v != "" {
+				h, _ = strconv.ParseInt(v, 10, 64)
+			}
+			if h > latest {
+				fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"Internal error","data":"height %d must be less than or equal to the current blockchain height %d"}}`, h, latest)
+				return
+			}
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"block_id":{"hash":"HASH%d"},"block":{"header":{"height":"%d"},"data":{"txs":["dHgx","dHgy"]}}}}`, h, h)
+		case "/block_results":
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":-1,"result":{"txs_results":[
+				{"code":0,"gas_wanted":"200000","gas_used":"81234"},
+				{"code":5,"log":"insufficient funds","gas_wanted":"100000","gas_used":"40000"}]}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func decodeStub(txBytes []byte) (*network.DecodedTx, error) {
+	if string(txBytes) != "tx1" {
+		return nil, fmt.Errorf("unknown tx format")
+	}
+	return &network.DecodedTx{Messages: []network.DecodedMsg{{TypeURL: "/cosmos.bank.v1beta1.MsgSend"}}}, nil
+}
+
+func TestReader_List(t *testing.T) {
+	r := NewReader(Config{RPCEndpoint: newFakeNode(t, 30).URL, Decoder: decodeStub})
+
+	blocks, err := r.List(context.Background(), 25)
+	require.NoError(t, err)
+	require.Len(t, blocks, 25)
+	assert.Equal(t, int64(30), blocks[0].Height)
+	assert.Equal(t, int64(6), blocks[24].Height)
+	assert.Equal(t, "HASH30", blocks[0].Hash)
+	assert.Equal(t, "PROP0", blocks[0].ProposerAddress)
+	assert.Equal(t, 0, blocks[0].NumTxs)
+	assert.Equal(t, 2*time.Second, blocks[0].Interval)
+	assert.Equal(t, 9*time.Second, blocks[5].Interval, "block 25 was slow")
+	assert.Equal(t, 2*time.Second, blocks[24].Interval, "oldest block has an interval")
+}
+
+func TestReader_ListShortChain(t *testing.T) {
+	r := NewReader(Config{RPCEndpoint: newFakeNode(t, 3).URL})
+
+	blocks, err := r.List(context.Background(), 20)
+	require.NoError(t, err)
+	require.Len(t, blocks, 3)
+	assert.Equal(t, int64(1), blocks[2].Height)
+	assert.Zero(t, blocks[2].Interval, "first block has no interval")
+}
+
+func TestReader_Get(t *testing.T) {
+	r := NewReader(Config{RPCEndpoint: newFakeNode(t, 30).URL, Decoder: decodeStub})
+
+	block, err := r.Get(context.Background(), 25)
+	require.NoError(t, err)
+	assert.Equal(t, int64(25), block.Height)
+	assert.Equal(t, 9*time.Second, block.Interval)
+	require.Len(t, block.Txs, 2)
+
+	sum := sha256.Sum256([]byte("tx1"))
+	assert.Equal(t, strings.ToUpper(hex.EncodeToString(sum[:])), block.Txs[0].Hash)
+	assert.Equal(t, int64(81234), block.Txs[0].GasUsed)
+	require.NotNil(t, block.Txs[0].Decoded)
+	assert.Equal(t, "/cosmos.bank.v1beta1.MsgSend", block.Txs[0].Decoded.Messages[0].TypeURL)
+
+	assert.Equal(t, uint32(5), block.Txs[1].Code)
+	assert.Equal(t, "insufficient funds", block.Txs[1].Log)
+	assert.Nil(t, block.Txs[1].Decoded)
+	assert.Equal(t, "unknown tx format", block.Txs[1].DecodeError)
+}
+
+func TestReader_GetLatest(t *testing.T) {
+	r := NewReader(Config{RPCEndpoint: newFakeNode(t, 30).URL, Decoder: decodeStub})
+
+	block, err := r.Get(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), block.Height)
+}
+
+func TestReader_GetFutureHeight(t *testing.T) {
+	r := NewReader(Config{RPCEndpoint: newFakeNode(t, 30).URL})
+
+	_, err := r.Get(context.Background(), 31)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
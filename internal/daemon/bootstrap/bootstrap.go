@@ -0,0 +1,328 @@
+// internal/daemon/bootstrap/bootstrap.go
+
+// Package bootstrap deploys the contracts of a devnet's bootstrap spec once
+// the devnet is first healthy: CosmWasm contracts are stored and
+// instantiated, EVM bytecode is deployed with its constructor arguments.
+// Contracts are deployed in order by the devnet's first validator, whose
+// key is derived deterministically (see package keys).
+//
+// The deployed addresses are written to bootstrap-output.json in the
+// devnet's data directory, for tests and postHealthy hooks:
+//
+//	{
+//	  "chainId": "test-1",
+//	  "deployer": "cosmos1...",
+//	  "contracts": {
+//	    "cw20": {"type": "wasm", "codeId": 1, "address": "cosmos1...", "txHash": "..."},
+//	    "token": {"type": "evm", "address": "0x...", "txHash": "0x..."}
+//	  }
+//	}
+package bootstrap
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/keys"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// Contract types.
+const (
+	TypeWasm = "wasm"
+	TypeEVM  = "evm"
+)
+
+// OutputFile is the name of the file, in the devnet's data directory, the
+// deployed addresses are written to.
+const OutputFile = "bootstrap-output.json"
+
+// maxSourceSize bounds the size of a contract source.
+const maxSourceSize = 64 << 20
+
+// Output describes the contracts deployed on a devnet.
+type Output struct {
+	ChainID string `json:"chainId"`
+
+	// Deployer is the bech32 address of the account that deployed the
+	// contracts, and EVMDeployer its 0x address on EVM chains.
+	Deployer    string `json:"deployer"`
+	EVMDeployer string `json:"evmDeployer,omitempty"`
+
+	// Contracts are the deployed contracts by name.
+	Contracts map[string]Deployed `json:"contracts"`
+}
+
+// Deployed is a deployed contract.
+type Deployed struct {
+	Type    string `json:"type"`
+	Address string `json:"address"`
+	// CodeID is the code ID a CosmWasm contract was stored under.
+	CodeID uint64 `json:"codeId,omitempty"`
+	// TxHash is the hash of the instantiation or deployment transaction.
+	TxHash string `json:"txHash"`
+}
+
+// Validate reports contracts that cannot be deployed: without a unique
+// name, with an unknown type, without an absolute source path or http(s)
+// URL, or with parameters that do not apply to their type.
+func Validate(spec types.Bootstrap) error {
+	var errs []error
+	if spec.GasPrice != "" {
+		if _, err := sdk.ParseDecCoin(spec.GasPrice); err != nil {
+			errs = append(errs, fmt.Errorf("invalid gasPrice %q: want a price like 0.025stake", spec.GasPrice))
+		}
+	}
+
+	names := make(map[string]bool)
+	for i, c := range spec.Contracts {
+		name := c.Name
+		if name == "" {
+			errs = append(errs, fmt.Errorf("contract %d: name is required", i))
+			name = fmt.Sprintf("contracts[%d]", i)
+		} else if names[name] {
+			errs = append(errs, fmt.Errorf("contract %s: duplicate name", name))
+		}
+		names[name] = true
+
+		if c.Source == "" {
+			errs = append(errs, fmt.Errorf("contract %s: source is required", name))
+		} else if !isURL(c.Source) && !filepath.IsAbs(c.Source) {
+			errs = append(errs, fmt.Errorf("contract %s: source %q must be an absolute path or an http(s) URL", name, c.Source))
+		}
+
+		switch c.Type {
+		case TypeWasm:
+			if c.InitMsg != "" && !isJSONObject(c.InitMsg) {
+				errs = append(errs, fmt.Errorf("contract %s: initMsg must be a JSON object", name))
+			}
+			if c.Args != "" {
+				errs = append(errs, fmt.Errorf("contract %s: args only apply to evm contracts", name))
+			}
+			if c.Funds != "" {
+				if _, err := sdk.ParseCoinsNormalized(c.Funds); err != nil {
+					errs = append(errs, fmt.Errorf("contract %s: invalid funds %q: want coins like 100stake", name, c.Funds))
+				}
+			}
+		case TypeEVM:
+			if c.InitMsg != "" || c.Label != "" || c.Admin != "" {
+				errs = append(errs, fmt.Errorf("contract %s: initMsg, label and admin only apply to wasm contracts", name))
+			}
+			if _, err := decodeHex(c.Args); err != nil {
+				errs = append(errs, fmt.Errorf("contract %s: args must be hex ABI-encoded constructor arguments", name))
+			}
+			if _, err := parseWei(c.Funds); err != nil {
+				errs = append(errs, fmt.Errorf("contract %s: invalid funds %q: want a value in wei", name, c.Funds))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("contract %s: invalid type %q (must be %s or %s)", name, c.Type, TypeWasm, TypeEVM))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Config configures a Deployer.
+type Config struct {
+	ChainID string
+
+	// RESTEndpoint is the Cosmos SDK REST API CosmWasm contracts are
+	// deployed through.
+	RESTEndpoint string
+
+	// EVMEndpoint is the EVM JSON-RPC endpoint EVM contracts are deployed
+	// through. Empty on networks without an EVM.
+	EVMEndpoint string
+
+	// Key is the deployer's key.
+	Key *keys.Key
+
+	// HTTPClient is used for REST requests and source downloads. Nil means
+	// a client with a 30s timeout.
+	HTTPClient *http.Client
+
+	// PollInterval is how often a broadcast transaction is looked up until
+	// it is in a block. Zero means one second.
+	PollInterval time.Duration
+}
+
+// txTimeout bounds the wait for a broadcast transaction to be in a block.
+const txTimeout = 2 * time.Minute
+
+// Deployer deploys a devnet's bootstrap contracts.
+type Deployer struct {
+	config Config
+	client *http.Client
+	logger *slog.Logger
+}
+
+// NewDeployer creates a new Deployer.
+func NewDeployer(cfg Config) *Deployer {
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = time.Second
+	}
+	return &Deployer{config: cfg, client: client, logger: slog.Default()}
+}
+
+// SetLogger sets the logger.
+func (d *Deployer) SetLogger(logger *slog.Logger) {
+	d.logger = logger
+}
+
+// Deploy deploys the spec's contracts in order and returns their
+// addresses. It stops at the first contract that fails to deploy.
+func (d *Deployer) Deploy(ctx context.Context, spec types.Bootstrap) (*Output, error) {
+	if err := Validate(spec); err != nil {
+		return nil, err
+	}
+	if d.config.Key == nil {
+		return nil, fmt.Errorf("deployer key is required")
+	}
+
+	out := &Output{
+		ChainID:     d.config.ChainID,
+		Deployer:    d.config.Key.Address,
+		EVMDeployer: d.config.Key.EVMAddress,
+		Contracts:   make(map[string]Deployed, len(spec.Contracts)),
+	}
+
+	var evm *evmDeployer
+	defer func() {
+		if evm != nil {
+			evm.close()
+		}
+	}()
+
+	for _, c := range spec.Contracts {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		d.logger.Info("deploying contract", "contract", c.Name, "type", c.Type, "source", c.Source)
+
+		source, err := d.load(ctx, c.Source)
+		if err != nil {
+			return nil, fmt.Errorf("contract %s: %w", c.Name, err)
+		}
+
+		var deployed Deployed
+		switch c.Type {
+		case TypeWasm:
+			deployed, err = d.deployWasm(ctx, c, source, spec.GasPrice)
+		case TypeEVM:
+			if evm == nil {
+				evm, err = d.dialEVM(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("contract %s: %w", c.Name, err)
+				}
+			}
+			deployed, err = evm.deploy(ctx, c, source)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("contract %s: %w", c.Name, err)
+		}
+
+		d.logger.Info("contract deployed", "contract", c.Name, "address", deployed.Address, "tx", deployed.TxHash)
+		out.Contracts[c.Name] = deployed
+	}
+	return out, nil
+}
+
+// load reads a contract source from a path or URL.
+func (d *Deployer) load(ctx context.Context, source string) ([]byte, error) {
+	if !isURL(source) {
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read source: %w", err)
+		}
+		return data, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download source: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download source: HTTP %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSourceSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download source: %w", err)
+	}
+	if len(data) > maxSourceSize {
+		return nil, fmt.Errorf("source is larger than %d MiB", maxSourceSize>>20)
+	}
+	return data, nil
+}
+
+// WriteOutput writes out to path as indented JSON, replacing the file
+// atomically so readers never see a partial one.
+func WriteOutput(path string, out *Output) error {
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal bootstrap output: %w", err)
+	}
+	data = append(data, '\n')
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write bootstrap output: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to write bootstrap output: %w", err)
+	}
+	return nil
+}
+
+// isURL reports whether source is an http(s) URL.
+func isURL(source string) bool {
+	u, err := url.Parse(source)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// isJSONObject reports whether s is a JSON object.
+func isJSONObject(s string) bool {
+	var obj map[string]json.RawMessage
+	return json.Unmarshal([]byte(s), &obj) == nil && obj != nil
+}
+
+// decodeHex decodes hex with an optional 0x prefix.
+func decodeHex(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	return hex.DecodeString(s)
+}
+
+// parseWei parses a non-negative decimal value in wei. Empty is zero.
+func parseWei(s string) (*big.Int, error) {
+	if s == "" {
+		return new(big.Int), nil
+	}
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok || v.Sign() < 0 {
+		return nil, fmt.Errorf("invalid value %q", s)
+	}
+	return v, nil
+}
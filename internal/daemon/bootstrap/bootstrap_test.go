@@ -0,0 +1,332 @@
+// internal/daemon/bootstrap/bootstrap_test.go
+package bootstrap
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/keys"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(types.Bootstrap{
+		GasPrice: "0.025stake",
+		Contracts: []types.Contract{
+			{Name: "cw20", Type: TypeWasm, Source: "/contracts/cw20.wasm", InitMsg: `{"name":"Token"}`, Funds: "10stake"},
+			{Name: "token", Type: TypeEVM, Source: "https://example.com/Token.json", Args: "0x01", Funds: "1000"},
+		},
+	}))
+
+	err := Validate(types.Bootstrap{
+		GasPrice: "cheap",
+		Contracts: []types.Contract{
+			{Type: TypeWasm, Source: "/a.wasm"},
+			{Name: "a", Type: "move", Source: "contracts/a.wasm"},
+			{Name: "a", Type: TypeWasm, Source: "/a.wasm", InitMsg: "[]", Args: "00", Funds: "lots"},
+			{Name: "b", Type: TypeEVM, Source: "ftp://example.com/b.bin", Label: "b", Args: "zz", Funds: "-1"},
+		},
+	})
+	require.Error(t, err)
+	for _, want := range []string{
+		`invalid gasPrice "cheap"`,
+		"contract 0: name is required",
+		`contract a: source "contracts/a.wasm" must be an absolute path or an http(s) URL`,
+		`contract a: invalid type "move"`,
+		"contract a: duplicate name",
+		"contract a: initMsg must be a JSON object",
+		"contract a: args only apply to evm contracts",
+		`contract a: invalid funds "lots"`,
+		"contract b: initMsg, label and admin only apply to wasm contracts",
+		"contract b: args must be hex",
+		`contract b: invalid funds "-1"`,
+	} {
+		assert.Contains(t, err.Error(), want)
+	}
+}
+
+func TestEVMBytecode(t *testing.T) {
+	for name, source := range map[string]string{
+		"hex":      "0x6080604052\n",
+		"hardhat":  `{"contractName":"Token","bytecode":"0x6080604052"}`,
+		"foundry":  `{"bytecode":{"object":"0x6080604052","linkReferences":{}}}`,
+		"unprefix": "6080604052",
+	} {
+		code, err := evmBytecode([]byte(source))
+		require.NoError(t, err, name)
+		assert.Equal(t, []byte{0x60, 0x80, 0x60, 0x40, 0x52}, code, name)
+	}
+
+	_, err := evmBytecode([]byte(`{"abi":[]}`))
+	assert.Error(t, err)
+	_, err = evmBytecode([]byte("0x"))
+	assert.Error(t, err)
+}
+
+// fakeREST is the REST API of a chain that accepts CosmWasm transactions.
+type fakeREST struct {
+	t      *testing.T
+	pubKey *secp256k1.PubKey
+
+	mu       sync.Mutex
+	sequence uint64
+	msgs     []string
+	pending  map[string]int // hash -> lookups before it is in a block
+}
+
+func (f *fakeREST) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/cosmos/auth/v1beta1/accounts/"):
+		writeJSON(w, map[string]any{"account": map[string]string{
+			"account_number": "7",
+			"sequence":       strconv.FormatUint(f.sequence, 10),
+		}})
+	case r.URL.Path == "/cosmos/tx/v1beta1/simulate":
+		f.decode(r)
+		writeJSON(w, map[string]any{"gas_info": map[string]string{"gas_used": "100000"}})
+	case r.URL.Path == "/cosmos/tx/v1beta1/txs" && r.Method == http.MethodPost:
+		raw := f.decode(r)
+
+		// The signature covers the sign doc with the account's number
+		var authInfo txtypes.AuthInfo
+		require.NoError(f.t, authInfo.Unmarshal(raw.AuthInfoBytes))
+		assert.Equal(f.t, f.sequence, authInfo.SignerInfos[0].Sequence)
+		assert.Equal(f.t, uint64(130000), authInfo.Fee.GasLimit)
+		assert.Equal(f.t, "3250stake", authInfo.Fee.Amount.String())
+		signDoc, err := (&txtypes.SignDoc{
+			BodyBytes: raw.BodyBytes, AuthInfoBytes: raw.AuthInfoBytes, ChainId: "test-1", AccountNumber: 7,
+		}).Marshal()
+		require.NoError(f.t, err)
+		assert.True(f.t, f.pubKey.VerifySignature(signDoc, raw.Signatures[0]), "invalid signature")
+
+		var body txtypes.TxBody
+		require.NoError(f.t, body.Unmarshal(raw.BodyBytes))
+		hash := "TX" + strconv.Itoa(len(f.msgs))
+		f.msgs = append(f.msgs, body.Messages[0].TypeUrl)
+		f.pending[hash] = 1
+		f.sequence++
+		writeJSON(w, map[string]any{"tx_response": map[string]any{"txhash": hash, "code": 0}})
+	case strings.HasPrefix(r.URL.Path, "/cosmos/tx/v1beta1/txs/"):
+		hash := strings.TrimPrefix(r.URL.Path, "/cosmos/tx/v1beta1/txs/")
+		if f.pending[hash] > 0 {
+			f.pending[hash]--
+			http.Error(w, `{"code":5,"message":"tx not found"}`, http.StatusNotFound)
+			return
+		}
+		event := map[string]any{"type": "store_code", "attributes": []map[string]string{{"key": "code_id", "value": "3"}}}
+		if hash == "TX1" {
+			event = map[string]any{"type": "instantiate", "attributes": []map[string]string{
+				{"key": "code_id", "value": "3"}, {"key": "_contract_address", "value": "cosmos1contract"},
+			}}
+		}
+		writeJSON(w, map[string]any{"tx_response": map[string]any{"txhash": hash, "code": 0, "events": []any{event}}})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// decode decodes the transaction of a simulate or broadcast request.
+func (f *fakeREST) decode(r *http.Request) *txtypes.TxRaw {
+	var req struct {
+		TxBytes string `json:"tx_bytes"`
+	}
+	require.NoError(f.t, json.NewDecoder(r.Body).Decode(&req))
+	txBytes, err := base64.StdEncoding.DecodeString(req.TxBytes)
+	require.NoError(f.t, err)
+	var raw txtypes.TxRaw
+	require.NoError(f.t, raw.Unmarshal(txBytes))
+	return &raw
+}
+
+func TestDeployer_Wasm(t *testing.T) {
+	key, err := keys.Derive(keys.RoleValidator, 0, "cosmos", false)
+	require.NoError(t, err)
+	keyBytes, err := decodeHex(key.PrivateKey)
+	require.NoError(t, err)
+
+	rest := &fakeREST{t: t, pubKey: (&secp256k1.PrivKey{Key: keyBytes}).PubKey().(*secp256k1.PubKey), pending: map[string]int{}}
+	server := httptest.NewServer(rest)
+	defer server.Close()
+
+	source := filepath.Join(t.TempDir(), "cw20.wasm")
+	require.NoError(t, os.WriteFile(source, []byte("\x00asm\x01\x00\x00\x00"), 0644))
+
+	deployer := NewDeployer(Config{ChainID: "test-1", RESTEndpoint: server.URL, Key: key, PollInterval: time.Millisecond})
+	out, err := deployer.Deploy(context.Background(), types.Bootstrap{
+		GasPrice:  "0.025stake",
+		Contracts: []types.Contract{{Name: "cw20", Type: TypeWasm, Source: source, InitMsg: `{"name":"Token"}`}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{msgStoreCodeURL, msgInstantiateURL}, rest.msgs)
+	assert.Equal(t, key.Address, out.Deployer)
+	assert.Equal(t, Deployed{Type: TypeWasm, Address: "cosmos1contract", CodeID: 3, TxHash: "TX1"}, out.Contracts["cw20"])
+}
+
+func TestInstantiateMsg(t *testing.T) {
+	msg := instantiateMsg("cosmos1sender", "", 3, "cw20", []byte(`{}`), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	assert.Equal(t, msgInstantiateURL, msg.TypeUrl)
+
+	fields := map[protowire.Number][]byte{}
+	for b := msg.Value; len(b) > 0; {
+		num, typ, n := protowire.ConsumeTag(b)
+		require.GreaterOrEqual(t, n, 0)
+		b = b[n:]
+		if typ == protowire.VarintType {
+			v, n := protowire.ConsumeVarint(b)
+			fields[num] = []byte(strconv.FormatUint(v, 10))
+			b = b[n:]
+			continue
+		}
+		v, n := protowire.ConsumeBytes(b)
+		require.GreaterOrEqual(t, n, 0)
+		fields[num] = v
+		b = b[n:]
+	}
+	assert.Equal(t, map[protowire.Number][]byte{
+		1: []byte("cosmos1sender"),
+		3: []byte("3"),
+		4: []byte("cw20"),
+		5: []byte("{}"),
+		6: []byte("\n\x05stake\x12\x0210"),
+	}, fields)
+}
+
+func TestDeployer_WasmRejectsNonWasm(t *testing.T) {
+	key, err := keys.Derive(keys.RoleValidator, 0, "cosmos", false)
+	require.NoError(t, err)
+	source := filepath.Join(t.TempDir(), "cw20.wasm")
+	require.NoError(t, os.WriteFile(source, []byte("<html>"), 0644))
+
+	_, err = NewDeployer(Config{ChainID: "test-1", RESTEndpoint: "http://127.0.0.1:1", Key: key}).
+		Deploy(context.Background(), types.Bootstrap{Contracts: []types.Contract{{Name: "cw20", Type: TypeWasm, Source: source}}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "contract cw20: source is not a wasm module")
+}
+
+func TestDeployer_EVM(t *testing.T) {
+	key, err := keys.Derive(keys.RoleValidator, 0, "cosmos", true)
+	require.NoError(t, err)
+	from := common.HexToAddress(key.EVMAddress)
+
+	// The bytecode is downloaded
+	artifact := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"bytecode":"0x6080"}`)
+	}))
+	defer artifact.Close()
+
+	var sent *ethtypes.Transaction
+	var receiptLookups int
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result any
+		switch req.Method {
+		case "eth_chainId":
+			result = "0x2328"
+		case "eth_getTransactionCount":
+			result = "0x5"
+		case "eth_gasPrice":
+			result = "0x3b9aca00"
+		case "eth_estimateGas":
+			result = "0x186a0"
+		case "eth_sendRawTransaction":
+			var raw hexutil.Bytes
+			require.NoError(t, json.Unmarshal(req.Params[0], &raw))
+			sent = new(ethtypes.Transaction)
+			require.NoError(t, sent.UnmarshalBinary(raw))
+			result = sent.Hash()
+		case "eth_getTransactionReceipt":
+			if receiptLookups++; receiptLookups == 1 {
+				result = nil
+				break
+			}
+			result = map[string]any{
+				"transactionHash":   sent.Hash(),
+				"status":            "0x1",
+				"cumulativeGasUsed": "0x1",
+				"gasUsed":           "0x1",
+				"logsBloom":         hexutil.Bytes(make([]byte, 256)),
+				"logs":              []any{},
+				"contractAddress":   ethcrypto.CreateAddress(from, 5),
+			}
+		default:
+			t.Errorf("unexpected method %s", req.Method)
+		}
+		writeJSON(w, map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	defer rpc.Close()
+
+	deployer := NewDeployer(Config{ChainID: "test-1", EVMEndpoint: rpc.URL, Key: key, PollInterval: time.Millisecond})
+	out, err := deployer.Deploy(context.Background(), types.Bootstrap{Contracts: []types.Contract{
+		{Name: "token", Type: TypeEVM, Source: artifact.URL + "/Token.json", Args: "0x0102", Funds: "1000"},
+	}})
+	require.NoError(t, err)
+
+	require.NotNil(t, sent)
+	assert.Nil(t, sent.To(), "not a contract creation")
+	assert.Equal(t, []byte{0x60, 0x80, 0x01, 0x02}, sent.Data())
+	assert.Equal(t, int64(1000), sent.Value().Int64())
+	assert.Equal(t, uint64(5), sent.Nonce())
+	assert.Equal(t, uint64(130000), sent.Gas())
+	signer, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(sent.ChainId()), sent)
+	require.NoError(t, err)
+	assert.Equal(t, from, signer)
+
+	assert.Equal(t, key.EVMAddress, out.EVMDeployer)
+	assert.Equal(t, Deployed{
+		Type:    TypeEVM,
+		Address: ethcrypto.CreateAddress(from, 5).Hex(),
+		TxHash:  sent.Hash().Hex(),
+	}, out.Contracts["token"])
+}
+
+func TestWriteOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), OutputFile)
+	out := &Output{ChainID: "test-1", Deployer: "cosmos1deployer", Contracts: map[string]Deployed{
+		"cw20": {Type: TypeWasm, Address: "cosmos1contract", CodeID: 1, TxHash: "AB"},
+	}}
+	require.NoError(t, WriteOutput(path, out))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var got Output
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, *out, got)
+	assert.NoFileExists(t, path+".tmp")
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
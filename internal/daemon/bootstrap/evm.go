@@ -0,0 +1,179 @@
+// internal/daemon/bootstrap/evm.go
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// evmDeployer deploys EVM contracts through one JSON-RPC connection.
+type evmDeployer struct {
+	client       *ethclient.Client
+	chainID      *big.Int
+	key          *ecdsa.PrivateKey
+	from         common.Address
+	pollInterval time.Duration
+}
+
+// dialEVM connects to the devnet's EVM JSON-RPC endpoint.
+func (d *Deployer) dialEVM(ctx context.Context) (*evmDeployer, error) {
+	if d.config.EVMEndpoint == "" {
+		return nil, fmt.Errorf("devnet does not serve EVM JSON-RPC")
+	}
+	if d.config.Key.EVMAddress == "" {
+		return nil, fmt.Errorf("evm contracts need an EVM deployer key; this network uses Cosmos SDK keys")
+	}
+	keyBytes, err := decodeHex(d.config.Key.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deployer key: %w", err)
+	}
+	key, err := ethcrypto.ToECDSA(keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deployer key: %w", err)
+	}
+
+	client, err := ethclient.DialContext(ctx, d.config.EVMEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to EVM RPC: %w", err)
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to query EVM chain ID: %w", err)
+	}
+	return &evmDeployer{
+		client:       client,
+		chainID:      chainID,
+		key:          key,
+		from:         ethcrypto.PubkeyToAddress(key.PublicKey),
+		pollInterval: d.config.PollInterval,
+	}, nil
+}
+
+func (e *evmDeployer) close() {
+	e.client.Close()
+}
+
+// deploy deploys a contract's bytecode with its constructor arguments and
+// waits for its receipt.
+func (e *evmDeployer) deploy(ctx context.Context, c types.Contract, source []byte) (Deployed, error) {
+	code, err := evmBytecode(source)
+	if err != nil {
+		return Deployed{}, err
+	}
+	args, err := decodeHex(c.Args)
+	if err != nil {
+		return Deployed{}, fmt.Errorf("invalid args: %w", err)
+	}
+	value, err := parseWei(c.Funds)
+	if err != nil {
+		return Deployed{}, err
+	}
+	data := append(code, args...)
+
+	nonce, err := e.client.PendingNonceAt(ctx, e.from)
+	if err != nil {
+		return Deployed{}, fmt.Errorf("failed to query nonce: %w", err)
+	}
+	gasPrice, err := e.client.SuggestGasPrice(ctx)
+	if err != nil {
+		return Deployed{}, fmt.Errorf("failed to query gas price: %w", err)
+	}
+	gas, err := e.client.EstimateGas(ctx, ethereum.CallMsg{From: e.from, Value: value, Data: data})
+	if err != nil {
+		return Deployed{}, fmt.Errorf("failed to estimate gas: %w", err)
+	}
+	gas = uint64(float64(gas) * gasAdjustment)
+
+	tx, err := ethtypes.SignTx(ethtypes.NewContractCreation(nonce, value, gas, gasPrice, data),
+		ethtypes.LatestSignerForChainID(e.chainID), e.key)
+	if err != nil {
+		return Deployed{}, fmt.Errorf("failed to sign: %w", err)
+	}
+	if err := e.client.SendTransaction(ctx, tx); err != nil {
+		return Deployed{}, fmt.Errorf("failed to send transaction: %w", err)
+	}
+
+	receipt, err := e.waitForReceipt(ctx, tx.Hash())
+	if err != nil {
+		return Deployed{}, err
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return Deployed{}, fmt.Errorf("deployment %s reverted", tx.Hash().Hex())
+	}
+	return Deployed{Type: TypeEVM, Address: receipt.ContractAddress.Hex(), TxHash: tx.Hash().Hex()}, nil
+}
+
+// waitForReceipt polls for a transaction's receipt until it is in a block.
+func (e *evmDeployer) waitForReceipt(ctx context.Context, hash common.Hash) (*ethtypes.Receipt, error) {
+	ctx, cancel := context.WithTimeout(ctx, txTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(e.pollInterval)
+	defer ticker.Stop()
+	for {
+		receipt, err := e.client.TransactionReceipt(ctx, hash)
+		if err == nil {
+			return receipt, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return nil, fmt.Errorf("failed to query receipt of %s: %w", hash.Hex(), err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("transaction %s not in a block after %s", hash.Hex(), txTimeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// evmBytecode returns the creation bytecode in source: hex, or a Hardhat
+// or Foundry JSON artifact with a "bytecode" field.
+func evmBytecode(source []byte) ([]byte, error) {
+	source = bytes.TrimSpace(source)
+	text := string(source)
+
+	if bytes.HasPrefix(source, []byte("{")) {
+		var artifact struct {
+			Bytecode json.RawMessage `json:"bytecode"`
+		}
+		if err := json.Unmarshal(source, &artifact); err != nil {
+			return nil, fmt.Errorf("invalid JSON artifact: %w", err)
+		}
+		// Hardhat writes a string, Foundry an object with the hex in "object"
+		var foundry struct {
+			Object string `json:"object"`
+		}
+		switch {
+		case json.Unmarshal(artifact.Bytecode, &text) == nil:
+		case json.Unmarshal(artifact.Bytecode, &foundry) == nil:
+			text = foundry.Object
+		default:
+			return nil, fmt.Errorf("JSON artifact has no bytecode")
+		}
+	}
+
+	code, err := decodeHex(text)
+	if err != nil {
+		return nil, fmt.Errorf("source is not hex bytecode: %w", err)
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("source has no bytecode")
+	}
+	return code, nil
+}
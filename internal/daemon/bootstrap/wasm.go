@@ -0,0 +1,423 @@
+// internal/daemon/bootstrap/wasm.go
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// CosmWasm message type URLs. The messages are encoded by hand so
+// devnet-builder does not depend on wasmd.
+const (
+	msgStoreCodeURL   = "/cosmwasm.wasm.v1.MsgStoreCode"
+	msgInstantiateURL = "/cosmwasm.wasm.v1.MsgInstantiateContract"
+)
+
+// gasAdjustment scales simulated gas into the gas limit of a transaction.
+const gasAdjustment = 1.3
+
+// Magic numbers of wasm modules and of the gzip files wasmd also accepts.
+var (
+	wasmMagic = []byte("\x00asm")
+	gzipMagic = []byte{0x1f, 0x8b}
+)
+
+// errNotFound is returned by get for HTTP 404 responses.
+var errNotFound = errors.New("not found")
+
+// txEvent is an ABCI event of a transaction.
+type txEvent struct {
+	Type       string `json:"type"`
+	Attributes []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	} `json:"attributes"`
+}
+
+// txResponse is the result of a transaction in the REST API.
+type txResponse struct {
+	TxHash string    `json:"txhash"`
+	Code   uint32    `json:"code"`
+	RawLog string    `json:"raw_log"`
+	Events []txEvent `json:"events"`
+}
+
+// attribute returns the value of the first attribute key of an event of
+// the given type.
+func (r *txResponse) attribute(eventType, key string) string {
+	for _, ev := range r.Events {
+		if ev.Type != eventType {
+			continue
+		}
+		for _, attr := range ev.Attributes {
+			if attr.Key == key {
+				return attr.Value
+			}
+		}
+	}
+	return ""
+}
+
+// deployWasm stores a CosmWasm contract's code and instantiates it.
+func (d *Deployer) deployWasm(ctx context.Context, c types.Contract, code []byte, gasPrice string) (Deployed, error) {
+	if !bytes.HasPrefix(code, wasmMagic) && !bytes.HasPrefix(code, gzipMagic) {
+		return Deployed{}, fmt.Errorf("source is not a wasm module")
+	}
+	if d.config.Key.EVMAddress != "" {
+		return Deployed{}, fmt.Errorf("wasm contracts need a Cosmos SDK deployer key; this network uses EVM keys")
+	}
+	if d.config.RESTEndpoint == "" {
+		return Deployed{}, fmt.Errorf("REST endpoint is required")
+	}
+	sender := d.config.Key.Address
+
+	stored, err := d.submit(ctx, gasPrice, storeCodeMsg(sender, code))
+	if err != nil {
+		return Deployed{}, fmt.Errorf("failed to store code: %w", err)
+	}
+	codeID, err := strconv.ParseUint(stored.attribute("store_code", "code_id"), 10, 64)
+	if err != nil {
+		return Deployed{}, fmt.Errorf("store code transaction %s has no code ID", stored.TxHash)
+	}
+
+	initMsg := c.InitMsg
+	if initMsg == "" {
+		initMsg = "{}"
+	}
+	label := c.Label
+	if label == "" {
+		label = c.Name
+	}
+	funds, err := sdk.ParseCoinsNormalized(c.Funds)
+	if err != nil {
+		return Deployed{}, err
+	}
+
+	instantiated, err := d.submit(ctx, gasPrice, instantiateMsg(sender, c.Admin, codeID, label, []byte(initMsg), funds))
+	if err != nil {
+		return Deployed{}, fmt.Errorf("failed to instantiate code %d: %w", codeID, err)
+	}
+	address := instantiated.attribute("instantiate", "_contract_address")
+	if address == "" {
+		return Deployed{}, fmt.Errorf("instantiate transaction %s has no contract address", instantiated.TxHash)
+	}
+
+	return Deployed{Type: TypeWasm, Address: address, CodeID: codeID, TxHash: instantiated.TxHash}, nil
+}
+
+// storeCodeMsg encodes a MsgStoreCode.
+func storeCodeMsg(sender string, code []byte) *codectypes.Any {
+	var b []byte
+	b = appendString(b, 1, sender)
+	b = appendBytes(b, 2, code)
+	return &codectypes.Any{TypeUrl: msgStoreCodeURL, Value: b}
+}
+
+// instantiateMsg encodes a MsgInstantiateContract.
+func instantiateMsg(sender, admin string, codeID uint64, label string, msg []byte, funds sdk.Coins) *codectypes.Any {
+	var b []byte
+	b = appendString(b, 1, sender)
+	b = appendString(b, 2, admin)
+	if codeID != 0 {
+		b = protowire.AppendTag(b, 3, protowire.VarintType)
+		b = protowire.AppendVarint(b, codeID)
+	}
+	b = appendString(b, 4, label)
+	b = appendBytes(b, 5, msg)
+	for _, coin := range funds {
+		var cb []byte
+		cb = appendString(cb, 1, coin.Denom)
+		cb = appendString(cb, 2, coin.Amount.String())
+		b = protowire.AppendTag(b, 6, protowire.BytesType)
+		b = protowire.AppendBytes(b, cb)
+	}
+	return &codectypes.Any{TypeUrl: msgInstantiateURL, Value: b}
+}
+
+// appendString appends a string field, omitted when empty as proto3 does.
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	if s == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+// appendBytes appends a bytes field, omitted when empty as proto3 does.
+func appendBytes(b []byte, num protowire.Number, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+// submit signs msg with the deployer's key, with a gas limit from
+// simulating it, broadcasts it and waits for it to be in a block.
+func (d *Deployer) submit(ctx context.Context, gasPrice string, msg *codectypes.Any) (*txResponse, error) {
+	keyBytes, err := decodeHex(d.config.Key.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deployer key: %w", err)
+	}
+	privKey := &secp256k1.PrivKey{Key: keyBytes}
+	pubKey, err := codectypes.NewAnyWithValue(privKey.PubKey())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode public key: %w", err)
+	}
+
+	accountNumber, sequence, err := d.accountState(ctx, d.config.Key.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := (&txtypes.TxBody{Messages: []*codectypes.Any{msg}}).Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode tx body: %w", err)
+	}
+	authInfo := func(gasLimit uint64, fee sdk.Coins) ([]byte, error) {
+		return (&txtypes.AuthInfo{
+			SignerInfos: []*txtypes.SignerInfo{{
+				PublicKey: pubKey,
+				ModeInfo: &txtypes.ModeInfo{Sum: &txtypes.ModeInfo_Single_{
+					Single: &txtypes.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT},
+				}},
+				Sequence: sequence,
+			}},
+			Fee: &txtypes.Fee{Amount: fee, GasLimit: gasLimit},
+		}).Marshal()
+	}
+
+	// Signatures are not verified in simulation
+	simAuthInfo, err := authInfo(0, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode auth info: %w", err)
+	}
+	gasUsed, err := d.simulate(ctx, body, simAuthInfo)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit := uint64(math.Ceil(float64(gasUsed) * gasAdjustment))
+	fee, err := txFee(gasPrice, gasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	authInfoBytes, err := authInfo(gasLimit, fee)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode auth info: %w", err)
+	}
+	signDoc, err := (&txtypes.SignDoc{
+		BodyBytes:     body,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       d.config.ChainID,
+		AccountNumber: accountNumber,
+	}).Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode sign doc: %w", err)
+	}
+	signature, err := privKey.Sign(signDoc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign: %w", err)
+	}
+	txBytes, err := (&txtypes.TxRaw{
+		BodyBytes:     body,
+		AuthInfoBytes: authInfoBytes,
+		Signatures:    [][]byte{signature},
+	}).Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode tx: %w", err)
+	}
+
+	hash, err := d.broadcast(ctx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+	return d.waitForTx(ctx, hash)
+}
+
+// txFee returns the fee of a transaction at the gas price, rounded up.
+// An empty gas price means no fee.
+func txFee(gasPrice string, gasLimit uint64) (sdk.Coins, error) {
+	if gasPrice == "" {
+		return nil, nil
+	}
+	price, err := sdk.ParseDecCoin(gasPrice)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gas price %q: %w", gasPrice, err)
+	}
+	amount := price.Amount.MulInt64(int64(gasLimit)).Ceil().TruncateInt()
+	return sdk.NewCoins(sdk.NewCoin(price.Denom, amount)), nil
+}
+
+// simulate returns the gas a transaction uses.
+func (d *Deployer) simulate(ctx context.Context, body, authInfo []byte) (uint64, error) {
+	txBytes, err := (&txtypes.TxRaw{BodyBytes: body, AuthInfoBytes: authInfo, Signatures: [][]byte{{}}}).Marshal()
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode tx: %w", err)
+	}
+	var resp struct {
+		GasInfo struct {
+			GasUsed string `json:"gas_used"`
+		} `json:"gas_info"`
+	}
+	if err := d.post(ctx, "/cosmos/tx/v1beta1/simulate", map[string]string{
+		"tx_bytes": base64.StdEncoding.EncodeToString(txBytes),
+	}, &resp); err != nil {
+		return 0, fmt.Errorf("failed to simulate: %w", err)
+	}
+	gasUsed, err := strconv.ParseUint(resp.GasInfo.GasUsed, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid simulated gas %q", resp.GasInfo.GasUsed)
+	}
+	return gasUsed, nil
+}
+
+// broadcast broadcasts a signed transaction and returns its hash once it
+// passes CheckTx.
+func (d *Deployer) broadcast(ctx context.Context, txBytes []byte) (string, error) {
+	var resp struct {
+		TxResponse txResponse `json:"tx_response"`
+	}
+	if err := d.post(ctx, "/cosmos/tx/v1beta1/txs", map[string]string{
+		"tx_bytes": base64.StdEncoding.EncodeToString(txBytes),
+		"mode":     "BROADCAST_MODE_SYNC",
+	}, &resp); err != nil {
+		return "", fmt.Errorf("failed to broadcast: %w", err)
+	}
+	if resp.TxResponse.Code != 0 {
+		return "", fmt.Errorf("transaction rejected (code %d): %s", resp.TxResponse.Code, resp.TxResponse.RawLog)
+	}
+	return resp.TxResponse.TxHash, nil
+}
+
+// waitForTx polls for a transaction until it is in a block, and returns
+// its result.
+func (d *Deployer) waitForTx(ctx context.Context, hash string) (*txResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, txTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(d.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		var resp struct {
+			TxResponse txResponse `json:"tx_response"`
+		}
+		err := d.get(ctx, "/cosmos/tx/v1beta1/txs/"+hash, &resp)
+		switch {
+		case err == nil:
+			if resp.TxResponse.Code != 0 {
+				return nil, fmt.Errorf("transaction %s failed (code %d): %s", hash, resp.TxResponse.Code, resp.TxResponse.RawLog)
+			}
+			return &resp.TxResponse, nil
+		case !errors.Is(err, errNotFound):
+			return nil, fmt.Errorf("failed to query transaction %s: %w", hash, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("transaction %s not in a block after %s", hash, txTimeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// accountState returns the deployer's account number and sequence.
+func (d *Deployer) accountState(ctx context.Context, address string) (uint64, uint64, error) {
+	var resp struct {
+		Account struct {
+			AccountNumber string `json:"account_number"`
+			Sequence      string `json:"sequence"`
+			BaseAccount   *struct {
+				AccountNumber string `json:"account_number"`
+				Sequence      string `json:"sequence"`
+			} `json:"base_account"`
+		} `json:"account"`
+	}
+	if err := d.get(ctx, "/cosmos/auth/v1beta1/accounts/"+address, &resp); err != nil {
+		return 0, 0, fmt.Errorf("failed to query account %s: %w", address, err)
+	}
+
+	numStr, seqStr := resp.Account.AccountNumber, resp.Account.Sequence
+	if resp.Account.BaseAccount != nil {
+		numStr, seqStr = resp.Account.BaseAccount.AccountNumber, resp.Account.BaseAccount.Sequence
+	}
+	accountNumber, err := strconv.ParseUint(numStr, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid account number %q: %w", numStr, err)
+	}
+	sequence, err := strconv.ParseUint(seqStr, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid sequence %q: %w", seqStr, err)
+	}
+	return accountNumber, sequence, nil
+}
+
+// get performs a REST GET and decodes the response into out.
+func (d *Deployer) get(ctx context.Context, apiPath string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.restURL(apiPath), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	return d.do(req, apiPath, out)
+}
+
+// post performs a REST POST of body as JSON and decodes the response into
+// out.
+func (d *Deployer) post(ctx context.Context, apiPath string, body, out any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.restURL(apiPath), bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return d.do(req, apiPath, out)
+}
+
+// restURL returns the URL of a REST API path.
+func (d *Deployer) restURL(apiPath string) string {
+	return strings.TrimRight(d.config.RESTEndpoint, "/") + apiPath
+}
+
+// do sends a REST request and decodes the response into out.
+func (d *Deployer) do(req *http.Request, apiPath string, out any) error {
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request to %s failed: %w", apiPath, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+	// Some SDK versions answer lookups of unknown transactions with 400
+	if resp.StatusCode == http.StatusNotFound || strings.Contains(string(body), "tx not found") {
+		return errNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned HTTP %d: %s", apiPath, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return json.Unmarshal(body, out)
+}
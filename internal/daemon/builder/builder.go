@@ -3,6 +3,7 @@ package builder
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -13,6 +14,10 @@ import (
 	plugintypes "github.com/altuslabsxyz/devnet-builder/internal/plugin/types"
 )
 
+// ErrNotCached is returned by an offline build when no cached binary
+// matches the requested ref.
+var ErrNotCached = errors.New("binary not in cache")
+
 // PluginLoader loads plugin builders by name
 type PluginLoader interface {
 	GetBuilder(pluginName string) (plugintypes.PluginBuilder, error)
@@ -25,6 +30,11 @@ type DefaultBuilder struct {
 	pluginLoader PluginLoader
 	dataDir      string
 	logger       *slog.Logger
+
+	// releaseFinder enables downloading prebuilt release binaries instead of
+	// compiling from source. Nil disables the release fallback.
+	releaseFinder ReleaseFinder
+	downloader    *releaseDownloader
 }
 
 // NewDefaultBuilder creates a new DefaultBuilder
@@ -40,9 +50,16 @@ func NewDefaultBuilder(dataDir string, pluginLoader PluginLoader, logger *slog.L
 		pluginLoader: pluginLoader,
 		dataDir:      dataDir,
 		logger:       logger,
+		downloader:   newReleaseDownloader(),
 	}
 }
 
+// SetReleaseFinder enables downloading published release binaries when the
+// requested ref matches a release. BuildSpec.ForceBuild bypasses it.
+func (b *DefaultBuilder) SetReleaseFinder(finder ReleaseFinder) {
+	b.releaseFinder = finder
+}
+
 // Build builds a binary from source and returns the path to the built binary
 func (b *DefaultBuilder) Build(ctx context.Context, spec BuildSpec) (*BuildResult, error) {
 	b.logger.Info("starting build",
@@ -57,6 +74,36 @@ func (b *DefaultBuilder) Build(ctx context.Context, spec BuildSpec) (*BuildResul
 		return nil, fmt.Errorf("failed to get plugin builder for %q: %w", spec.PluginName, err)
 	}
 
+	if spec.Offline {
+		return b.buildOffline(spec, pluginBuilder)
+	}
+
+	// Prefer a published release binary when the ref names a release of the
+	// plugin's default repository. Custom repos are always built from source.
+	if b.releaseFinder != nil && !spec.ForceBuild && spec.GitRepo == "" && spec.GitRef != "" {
+		result, err := b.downloadRelease(ctx, spec, pluginBuilder)
+		if err == nil {
+			return result, nil
+		}
+		if errors.Is(err, ErrChecksumMismatch) {
+			return nil, err
+		}
+		switch {
+		case errors.Is(err, ErrNoReleaseAsset):
+			b.logger.Debug("no release asset for ref, building from source", "ref", spec.GitRef)
+		case errors.Is(err, ErrUnverifiedRelease):
+			b.logger.Warn("release has no checksum, building from source; allow unverified downloads to use it",
+				"ref", spec.GitRef,
+				"error", err,
+			)
+		default:
+			b.logger.Warn("release download failed, building from source",
+				"ref", spec.GitRef,
+				"error", err,
+			)
+		}
+	}
+
 	// Use default repo if not specified
 	gitRepo := spec.GitRepo
 	if gitRepo == "" {
@@ -187,6 +234,106 @@ func (b *DefaultBuilder) Build(ctx context.Context, spec BuildSpec) (*BuildResul
 	return result, nil
 }
 
+// downloadRelease fetches a prebuilt binary for spec.GitRef from the
+// plugin's published releases, verifies it, and stores it in the cache.
+func (b *DefaultBuilder) downloadRelease(ctx context.Context, spec BuildSpec, pluginBuilder plugintypes.PluginBuilder) (*BuildResult, error) {
+	asset, err := b.releaseFinder.FindReleaseAsset(ctx, spec.PluginName, spec.GitRef)
+	if err != nil {
+		return nil, err
+	}
+
+	// Key release downloads by asset URL so they never collide with source builds
+	cacheKey := b.cache.CacheKey(BuildSpec{GitRepo: asset.URL, PluginName: spec.PluginName}, asset.Version)
+	if !spec.NoCache {
+		if cachedResult, found := b.cache.Get(cacheKey); found {
+			b.logger.Info("cache hit", "cacheKey", cacheKey, "binaryPath", cachedResult.BinaryPath)
+			return cachedResult, nil
+		}
+	}
+
+	outputDir := b.cache.CachePath(cacheKey)
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+	success := false
+	defer func() {
+		if !success {
+			os.RemoveAll(outputDir)
+		}
+	}()
+
+	tempDir, err := os.MkdirTemp("", "dvb-release-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	b.logger.Info("downloading release binary",
+		"asset", asset.Name,
+		"version", asset.Version,
+		"verified", asset.ChecksumURL != "",
+	)
+	downloadPath := filepath.Join(tempDir, asset.Name)
+	sum, err := b.downloader.download(ctx, asset, downloadPath, spec.AllowUnverified)
+	if err != nil {
+		return nil, err
+	}
+
+	binaryPath := filepath.Join(outputDir, pluginBuilder.BinaryName())
+	if err := extractBinary(downloadPath, asset.Name, pluginBuilder.BinaryName(), binaryPath); err != nil {
+		return nil, fmt.Errorf("failed to extract %s: %w", asset.Name, err)
+	}
+
+	if err := pluginBuilder.ValidateBinary(ctx, binaryPath); err != nil {
+		return nil, fmt.Errorf("binary validation failed: %w", err)
+	}
+
+	result := &BuildResult{
+		BinaryPath:  binaryPath,
+		GitRef:      asset.Version,
+		BuiltAt:     time.Now(),
+		CacheKey:    cacheKey,
+		FromRelease: true,
+	}
+	if err := b.cache.Store(result); err != nil {
+		b.logger.Warn("failed to store result in cache", "error", err)
+	}
+
+	success = true
+	b.logger.Info("release binary downloaded",
+		"binaryPath", binaryPath,
+		"sha256", sum,
+	)
+	return result, nil
+}
+
+// buildOffline resolves spec against the local cache only. Refs cannot be
+// resolved to commits without network access, so entries are matched by the
+// ref (or commit prefix) they were built from.
+func (b *DefaultBuilder) buildOffline(spec BuildSpec, pluginBuilder plugintypes.PluginBuilder) (*BuildResult, error) {
+	if spec.GitRepo != "" {
+		return nil, fmt.Errorf("%w: custom repository %s cannot be resolved offline; provision with a local binary instead", ErrNotCached, spec.GitRepo)
+	}
+
+	binaryName := pluginBuilder.BinaryName()
+	result, found := b.cache.FindByRef(binaryName, spec.GitRef)
+	if !found {
+		ref := spec.GitRef
+		if ref == "" {
+			ref = "<ref>"
+		}
+		return nil, fmt.Errorf("%w: no cached %s for %s; run 'dvb build --network %s --ref %s' while online, or provision with a local binary",
+			ErrNotCached, binaryName, ref, spec.PluginName, ref)
+	}
+
+	b.logger.Info("offline: using cached binary",
+		"binaryPath", result.BinaryPath,
+		"ref", result.GitRef,
+		"commit", result.GitCommit,
+	)
+	return result, nil
+}
+
 // GetCached returns a cached build if available and valid
 // Note: Returns nil, false because we need to resolve the git ref to a commit
 // before we can check the cache. The cache key depends on the resolved commit.
@@ -3,10 +3,13 @@ package builder
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
 	"time"
+
+	plugintypes "github.com/altuslabsxyz/devnet-builder/internal/plugin/types"
 )
 
 func TestBuilderCacheHit(t *testing.T) {
@@ -145,6 +148,61 @@ func TestGetCachedReturnsNilWithoutResolvedCommit(t *testing.T) {
 	}
 }
 
+// stubPluginLoader returns a PluginBuilder that fails any real build.
+type stubPluginLoader struct {
+	binaryName string
+}
+
+func (l *stubPluginLoader) GetBuilder(string) (plugintypes.PluginBuilder, error) {
+	return &stubPluginBuilder{binaryName: l.binaryName}, nil
+}
+
+type stubPluginBuilder struct {
+	binaryName string
+}
+
+func (s *stubPluginBuilder) DefaultGitRepo() string                       { return "github.com/test/repo" }
+func (s *stubPluginBuilder) DefaultBuildFlags() map[string]string         { return nil }
+func (s *stubPluginBuilder) BinaryName() string                           { return s.binaryName }
+func (s *stubPluginBuilder) ValidateBinary(context.Context, string) error { return nil }
+func (s *stubPluginBuilder) BuildBinary(context.Context, plugintypes.BuildOptions) error {
+	return errors.New("unexpected build")
+}
+
+func TestBuildOfflineUsesCache(t *testing.T) {
+	tempDir := t.TempDir()
+	builder := NewDefaultBuilder(tempDir, &stubPluginLoader{binaryName: "stabled"}, nil)
+
+	binaryPath := filepath.Join(tempDir, "binaries", "key", "stabled")
+	if err := os.MkdirAll(filepath.Dir(binaryPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(binaryPath, []byte("fake"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := builder.cache.Store(&BuildResult{BinaryPath: binaryPath, GitRef: "v1.0.0", BuiltAt: time.Now(), CacheKey: "key"}); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := builder.Build(context.Background(), BuildSpec{PluginName: "stable", GitRef: "v1.0.0", Offline: true})
+	if err != nil {
+		t.Fatalf("Offline build failed: %v", err)
+	}
+	if result.BinaryPath != binaryPath {
+		t.Errorf("Expected cached binary %s, got %s", binaryPath, result.BinaryPath)
+	}
+
+	_, err = builder.Build(context.Background(), BuildSpec{PluginName: "stable", GitRef: "v2.0.0", Offline: true})
+	if !errors.Is(err, ErrNotCached) {
+		t.Errorf("Expected ErrNotCached for uncached ref, got %v", err)
+	}
+
+	_, err = builder.Build(context.Background(), BuildSpec{PluginName: "stable", GitRepo: "github.com/me/fork", GitRef: "v1.0.0", Offline: true})
+	if !errors.Is(err, ErrNotCached) {
+		t.Errorf("Expected ErrNotCached for custom repo, got %v", err)
+	}
+}
+
 func TestIsURL(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -161,6 +161,31 @@ func (c *BinaryCache) List() ([]*BuildResult, error) {
 	return results, nil
 }
 
+// FindByRef returns the most recently built cached binary with the given
+// file name whose ref or commit matches ref. An empty ref matches any entry.
+// Unlike Get, it does not need the resolved commit, so it works offline.
+func (c *BinaryCache) FindByRef(binaryName, ref string) (*BuildResult, bool) {
+	results, err := c.List()
+	if err != nil {
+		return nil, false
+	}
+
+	var best *BuildResult
+	for _, result := range results {
+		if filepath.Base(result.BinaryPath) != binaryName {
+			continue
+		}
+		if ref != "" && result.GitRef != ref && !(len(ref) >= 7 && strings.HasPrefix(result.GitCommit, ref)) {
+			continue
+		}
+		if best == nil || result.BuiltAt.After(best.BuiltAt) {
+			best = result
+		}
+	}
+
+	return best, best != nil
+}
+
 // FormatCacheKey returns a human-readable description of what a cache key represents
 func (c *BinaryCache) FormatCacheKey(result *BuildResult) string {
 	ref := result.GitRef
@@ -99,3 +99,37 @@ func TestCacheClean(t *testing.T) {
 		t.Error("Old cache entry should have been cleaned")
 	}
 }
+
+func TestCacheFindByRef(t *testing.T) {
+	cacheDir := t.TempDir()
+	cache := NewBinaryCache(cacheDir)
+
+	store := func(key, binary, ref, commit string, builtAt time.Time) {
+		path := filepath.Join(cacheDir, key, binary)
+		os.MkdirAll(filepath.Dir(path), 0755)
+		os.WriteFile(path, []byte("fake"), 0755)
+		cache.Store(&BuildResult{BinaryPath: path, GitRef: ref, GitCommit: commit, BuiltAt: builtAt, CacheKey: key})
+	}
+	now := time.Now()
+	store("old", "stabled", "v1.0.0", "aaaaaaa111", now.Add(-time.Hour))
+	store("new", "stabled", "v1.0.0", "bbbbbbb222", now)
+	store("other", "gaiad", "v1.0.0", "ccccccc333", now.Add(time.Hour))
+
+	result, found := cache.FindByRef("stabled", "v1.0.0")
+	if !found || result.CacheKey != "new" {
+		t.Fatalf("Expected newest stabled entry, got %v (found=%v)", result, found)
+	}
+
+	result, found = cache.FindByRef("stabled", "aaaaaaa")
+	if !found || result.CacheKey != "old" {
+		t.Errorf("Expected commit prefix match, got %v (found=%v)", result, found)
+	}
+
+	if _, found := cache.FindByRef("stabled", "v2.0.0"); found {
+		t.Error("Expected no match for uncached ref")
+	}
+
+	if _, found := cache.FindByRef("stabled", "aaa"); found {
+		t.Error("Expected short commit prefixes not to match")
+	}
+}
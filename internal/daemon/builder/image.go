@@ -0,0 +1,364 @@
+// internal/daemon/builder/image.go
+package builder
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sdknetwork "github.com/altuslabsxyz/devnet-builder/pkg/network"
+)
+
+const (
+	// defaultGoVersion is used for the builder stage when the source go.mod
+	// does not declare a go directive.
+	defaultGoVersion = "1.23"
+
+	// generatedDockerfileName is the Dockerfile written into the build context.
+	// A distinct name avoids clobbering a Dockerfile the repository ships.
+	generatedDockerfileName = "Dockerfile.dvb"
+
+	// maxImageTagLength is the maximum length of a Docker tag component.
+	maxImageTagLength = 128
+)
+
+// ImageBuildSpec specifies a Docker image to build from source
+type ImageBuildSpec struct {
+	NetworkName string                  // plugin name, used for the default repository name
+	GitRepo     string                  // repository URL
+	GitRef      string                  // branch, tag, or commit hash
+	BinaryName  string                  // binary to build and use as entrypoint (e.g., "stabled")
+	BuildConfig *sdknetwork.BuildConfig // plugin build configuration (tags, ldflags, env)
+	Tag         string                  // full image reference; generated when empty
+	NoCache     bool                    // rebuild even if the tag already exists locally
+}
+
+// ImageBuildResult contains the result of a successful image build
+type ImageBuildResult struct {
+	Image     string    // local image reference
+	GitCommit string    // resolved commit hash
+	GitRef    string    // original ref (branch/tag)
+	Cached    bool      // true if an existing local image was reused
+	BuiltAt   time.Time // when the build completed
+}
+
+// dockerImageCLI abstracts the docker CLI operations used by ImageBuilder.
+type dockerImageCLI interface {
+	// ImageExists reports whether an image with the given reference exists locally.
+	ImageExists(ctx context.Context, ref string) bool
+
+	// Build builds contextDir using dockerfile and tags the result.
+	Build(ctx context.Context, contextDir, dockerfile, tag string) error
+}
+
+// ImageBuilder builds Docker images for a network binary at a git ref.
+// It generates a multi-stage Dockerfile from the plugin's BuildConfig so that
+// chains without a published image can still run in docker mode.
+type ImageBuilder struct {
+	git    *GitOperations
+	docker dockerImageCLI
+	logger *slog.Logger
+}
+
+// NewImageBuilder creates a new ImageBuilder using the local docker CLI.
+func NewImageBuilder(logger *slog.Logger) *ImageBuilder {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ImageBuilder{
+		git:    &GitOperations{},
+		docker: &execDockerCLI{},
+		logger: logger,
+	}
+}
+
+// Build clones the repository at spec.GitRef, generates a Dockerfile and
+// builds a locally tagged image.
+func (b *ImageBuilder) Build(ctx context.Context, spec ImageBuildSpec) (*ImageBuildResult, error) {
+	if spec.BinaryName == "" {
+		return nil, fmt.Errorf("binary name is required")
+	}
+	if spec.GitRepo == "" {
+		return nil, fmt.Errorf("git repository is required")
+	}
+
+	repoURL := spec.GitRepo
+	if !isURL(repoURL) {
+		repoURL = "https://" + repoURL
+	}
+
+	b.logger.Info("starting image build",
+		"network", spec.NetworkName,
+		"repo", repoURL,
+		"ref", spec.GitRef,
+	)
+
+	tempDir, err := os.MkdirTemp("", "dvb-image-build-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	if err := b.git.Clone(ctx, CloneOptions{
+		Repo:    repoURL,
+		DestDir: tempDir,
+		Depth:   1,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to clone repository: %w", err)
+	}
+
+	gitRef := spec.GitRef
+	if gitRef == "" {
+		gitRef, err = b.git.GetRemoteDefaultBranch(ctx, tempDir)
+		if err != nil {
+			gitRef = "main"
+		}
+	}
+
+	resolvedCommit, err := b.git.Checkout(ctx, CheckoutOptions{
+		RepoDir: tempDir,
+		Ref:     gitRef,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to checkout ref %q: %w", gitRef, err)
+	}
+
+	tag := spec.Tag
+	if tag == "" {
+		tag = DefaultImageTag(spec.NetworkName, gitRef, resolvedCommit)
+	}
+
+	if !spec.NoCache && b.docker.ImageExists(ctx, tag) {
+		b.logger.Info("image already exists, skipping build", "image", tag)
+		return &ImageBuildResult{
+			Image:     tag,
+			GitCommit: resolvedCommit,
+			GitRef:    gitRef,
+			Cached:    true,
+			BuiltAt:   time.Now(),
+		}, nil
+	}
+
+	// go build -o needs a single package; wildcard patterns are not usable here
+	mainPkg := findMainPackage(tempDir, spec.BinaryName)
+	if strings.Contains(mainPkg, "...") {
+		mainPkg = "./cmd/" + spec.BinaryName
+	}
+
+	dockerfile := GenerateDockerfile(DockerfileOptions{
+		BinaryName:  spec.BinaryName,
+		MainPackage: mainPkg,
+		GoVersion:   goVersionFromModFile(filepath.Join(tempDir, "go.mod")),
+		BuildConfig: spec.BuildConfig,
+		GitRef:      gitRef,
+		GitCommit:   resolvedCommit,
+	})
+
+	dockerfilePath := filepath.Join(tempDir, generatedDockerfileName)
+	if err := os.WriteFile(dockerfilePath, []byte(dockerfile), 0644); err != nil {
+		return nil, fmt.Errorf("failed to write Dockerfile: %w", err)
+	}
+
+	b.logger.Info("building docker image (this may take a few minutes)", "image", tag)
+	if err := b.docker.Build(ctx, tempDir, dockerfilePath, tag); err != nil {
+		return nil, fmt.Errorf("docker build failed: %w", err)
+	}
+
+	b.logger.Info("image build completed", "image", tag, "commit", resolvedCommit)
+
+	return &ImageBuildResult{
+		Image:     tag,
+		GitCommit: resolvedCommit,
+		GitRef:    gitRef,
+		BuiltAt:   time.Now(),
+	}, nil
+}
+
+// DockerfileOptions configures GenerateDockerfile.
+type DockerfileOptions struct {
+	BinaryName  string                  // output binary and entrypoint
+	MainPackage string                  // go package to build (e.g., "./cmd/stabled")
+	GoVersion   string                  // golang image version for the builder stage
+	BuildConfig *sdknetwork.BuildConfig // plugin build configuration
+	GitRef      string                  // injected as the cosmos-sdk version
+	GitCommit   string                  // injected as the cosmos-sdk commit
+}
+
+// GenerateDockerfile renders a multi-stage Dockerfile that compiles the
+// binary with the plugin's build tags, ldflags and environment, and copies
+// it into a slim runtime image with the binary as entrypoint.
+func GenerateDockerfile(opts DockerfileOptions) string {
+	goVersion := opts.GoVersion
+	if goVersion == "" {
+		goVersion = defaultGoVersion
+	}
+	mainPkg := opts.MainPackage
+	if mainPkg == "" {
+		mainPkg = "./cmd/" + opts.BinaryName
+	}
+
+	cfg := opts.BuildConfig
+	if cfg == nil {
+		cfg = &sdknetwork.BuildConfig{}
+	}
+
+	// Environment: plugin env, with CGO enabled unless the plugin says otherwise
+	// (matches the local build path in the daemon wiring).
+	env := make(map[string]string, len(cfg.Env)+1)
+	for k, v := range cfg.Env {
+		env[k] = v
+	}
+	if _, ok := env["CGO_ENABLED"]; !ok {
+		env["CGO_ENABLED"] = "1"
+	}
+	envKeys := make([]string, 0, len(env))
+	for k := range env {
+		envKeys = append(envKeys, k)
+	}
+	sort.Strings(envKeys)
+
+	ldflags := append([]string{}, cfg.LDFlags...)
+	ldflags = append(ldflags,
+		"-w", "-s",
+		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.Name=%s", opts.BinaryName),
+		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.AppName=%s", opts.BinaryName),
+		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.Version=%s", opts.GitRef),
+		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.Commit=%s", opts.GitCommit),
+	)
+
+	buildArgs := []string{"go", "build", "-o", "/out/" + opts.BinaryName, "-ldflags", strings.Join(ldflags, " ")}
+	if len(cfg.Tags) > 0 {
+		buildArgs = append(buildArgs, "-tags", strings.Join(cfg.Tags, ","))
+	}
+	buildArgs = append(buildArgs, mainPkg)
+	// JSON exec form sidesteps shell quoting of ldflags
+	buildCmd, _ := json.Marshal(buildArgs)
+
+	var sb strings.Builder
+	sb.WriteString("# Generated by devnet-builder. Do not edit.\n")
+	fmt.Fprintf(&sb, "FROM golang:%s-bookworm AS builder\n", goVersion)
+	sb.WriteString("RUN apt-get update && apt-get install -y --no-install-recommends build-essential git ca-certificates && rm -rf /var/lib/apt/lists/*\n")
+	sb.WriteString("WORKDIR /src\n")
+	sb.WriteString("COPY go.mod go.sum* ./\n")
+	sb.WriteString("RUN go mod download\n")
+	sb.WriteString("COPY . .\n")
+	for _, k := range envKeys {
+		fmt.Fprintf(&sb, "ENV %s=%s\n", k, strconv.Quote(env[k]))
+	}
+	fmt.Fprintf(&sb, "RUN %s\n", buildCmd)
+	sb.WriteString("\n")
+	sb.WriteString("FROM debian:bookworm-slim\n")
+	sb.WriteString("RUN apt-get update && apt-get install -y --no-install-recommends ca-certificates curl jq && rm -rf /var/lib/apt/lists/*\n")
+	fmt.Fprintf(&sb, "COPY --from=builder /out/%s /usr/local/bin/%s\n", opts.BinaryName, opts.BinaryName)
+	fmt.Fprintf(&sb, "LABEL dvb.git.ref=%s dvb.git.commit=%s\n", strconv.Quote(opts.GitRef), strconv.Quote(opts.GitCommit))
+	fmt.Fprintf(&sb, "ENTRYPOINT [%s]\n", strconv.Quote(opts.BinaryName))
+
+	return sb.String()
+}
+
+// DefaultImageTag generates a local image reference for a build:
+// dvb/<network>:<sanitized-ref>-<short-commit>.
+func DefaultImageTag(networkName, gitRef, commit string) string {
+	repo := sanitizeImageComponent(strings.ToLower(networkName))
+	if repo == "" {
+		repo = "devnet"
+	}
+
+	shortCommit := commit
+	if len(shortCommit) > 12 {
+		shortCommit = shortCommit[:12]
+	}
+
+	tag := sanitizeImageComponent(gitRef)
+	if shortCommit != "" {
+		if tag != "" {
+			tag += "-"
+		}
+		tag += shortCommit
+	}
+	if tag == "" {
+		tag = "latest"
+	}
+	if len(tag) > maxImageTagLength {
+		tag = tag[len(tag)-maxImageTagLength:]
+	}
+	tag = strings.TrimLeft(tag, ".-")
+
+	return fmt.Sprintf("dvb/%s:%s", repo, tag)
+}
+
+// sanitizeImageComponent replaces characters not allowed in Docker tags
+// ([A-Za-z0-9_.-]) with '-' and trims leading separators.
+func sanitizeImageComponent(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '.', r == '-':
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('-')
+		}
+	}
+	return strings.TrimLeft(sb.String(), ".-")
+}
+
+// goVersionFromModFile reads the "go" directive from a go.mod file and
+// returns its major.minor component. Returns defaultGoVersion if absent.
+func goVersionFromModFile(path string) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return defaultGoVersion
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "go" {
+			parts := strings.SplitN(fields[1], ".", 3)
+			if len(parts) >= 2 {
+				return parts[0] + "." + parts[1]
+			}
+			return fields[1]
+		}
+	}
+	return defaultGoVersion
+}
+
+// execDockerCLI implements dockerImageCLI by shelling out to the docker CLI.
+type execDockerCLI struct{}
+
+func (d *execDockerCLI) ImageExists(ctx context.Context, ref string) bool {
+	cmd := exec.CommandContext(ctx, "docker", "image", "inspect", ref)
+	return cmd.Run() == nil
+}
+
+func (d *execDockerCLI) Build(ctx context.Context, contextDir, dockerfile, tag string) error {
+	cmd := exec.CommandContext(ctx, "docker", "build", "-f", dockerfile, "-t", tag, contextDir)
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%w: %s", err, lastLines(output.String(), 20))
+	}
+	return nil
+}
+
+// lastLines returns the last n lines of s.
+func lastLines(s string, n int) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
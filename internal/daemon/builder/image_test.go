@@ -0,0 +1,147 @@
+// internal/daemon/builder/image_test.go
+package builder
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdknetwork "github.com/altuslabsxyz/devnet-builder/pkg/network"
+)
+
+func TestGenerateDockerfile(t *testing.T) {
+	df := GenerateDockerfile(DockerfileOptions{
+		BinaryName:  "stabled",
+		MainPackage: "./cmd/stabled",
+		GoVersion:   "1.22",
+		BuildConfig: &sdknetwork.BuildConfig{
+			Tags:    []string{"netgo", "no_dynamic_precompiles"},
+			LDFlags: []string{"-X github.com/stablelabs/stable/app.EVMChainID=988"},
+			Env:     map[string]string{"CGO_ENABLED": "0", "GOARCH": "amd64"},
+		},
+		GitRef:    "feat/my-branch",
+		GitCommit: "abc123",
+	})
+
+	wantContains := []string{
+		"FROM golang:1.22-bookworm AS builder",
+		`ENV CGO_ENABLED="0"`,
+		`ENV GOARCH="amd64"`,
+		`"-tags","netgo,no_dynamic_precompiles"`,
+		"-X github.com/stablelabs/stable/app.EVMChainID=988",
+		"-X github.com/cosmos/cosmos-sdk/version.Version=feat/my-branch",
+		"-X github.com/cosmos/cosmos-sdk/version.Commit=abc123",
+		`"./cmd/stabled"]`,
+		"COPY --from=builder /out/stabled /usr/local/bin/stabled",
+		`ENTRYPOINT ["stabled"]`,
+	}
+	for _, want := range wantContains {
+		if !strings.Contains(df, want) {
+			t.Errorf("Dockerfile missing %q\n%s", want, df)
+		}
+	}
+
+	// Env keys are emitted in sorted order for reproducible builds
+	if strings.Index(df, "ENV CGO_ENABLED") > strings.Index(df, "ENV GOARCH") {
+		t.Error("expected ENV lines in sorted order")
+	}
+}
+
+func TestGenerateDockerfile_Defaults(t *testing.T) {
+	df := GenerateDockerfile(DockerfileOptions{BinaryName: "gaiad"})
+
+	if !strings.Contains(df, "FROM golang:"+defaultGoVersion+"-bookworm") {
+		t.Errorf("expected default go version, got:\n%s", df)
+	}
+	if !strings.Contains(df, `ENV CGO_ENABLED="1"`) {
+		t.Errorf("expected CGO enabled by default, got:\n%s", df)
+	}
+	if !strings.Contains(df, `"./cmd/gaiad"]`) {
+		t.Errorf("expected default main package, got:\n%s", df)
+	}
+	if strings.Contains(df, `"-tags"`) {
+		t.Errorf("expected no -tags without build tags, got:\n%s", df)
+	}
+}
+
+func TestDefaultImageTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		network string
+		ref     string
+		commit  string
+		want    string
+	}{
+		{
+			name:    "branch with slash",
+			network: "stable",
+			ref:     "feat/my-branch",
+			commit:  "0123456789abcdef0123",
+			want:    "dvb/stable:feat-my-branch-0123456789ab",
+		},
+		{
+			name:    "semver tag",
+			network: "cosmos",
+			ref:     "v1.2.3",
+			commit:  "deadbeef",
+			want:    "dvb/cosmos:v1.2.3-deadbeef",
+		},
+		{
+			name:    "uppercase network",
+			network: "Stable",
+			ref:     "main",
+			commit:  "abc",
+			want:    "dvb/stable:main-abc",
+		},
+		{
+			name:    "empty ref and commit",
+			network: "stable",
+			want:    "dvb/stable:latest",
+		},
+		{
+			name:    "leading separators trimmed",
+			network: "stable",
+			ref:     "-weird",
+			commit:  "abc",
+			want:    "dvb/stable:weird-abc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DefaultImageTag(tt.network, tt.ref, tt.commit)
+			if got != tt.want {
+				t.Errorf("DefaultImageTag() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultImageTag_TruncatesLongRefs(t *testing.T) {
+	got := DefaultImageTag("stable", strings.Repeat("a", 200), "abc")
+	tag := got[strings.Index(got, ":")+1:]
+	if len(tag) > maxImageTagLength {
+		t.Errorf("tag length = %d, want <= %d", len(tag), maxImageTagLength)
+	}
+	if !strings.HasSuffix(tag, "-abc") {
+		t.Errorf("expected commit suffix to be preserved, got %q", tag)
+	}
+}
+
+func TestGoVersionFromModFile(t *testing.T) {
+	dir := t.TempDir()
+
+	if got := goVersionFromModFile(filepath.Join(dir, "missing.mod")); got != defaultGoVersion {
+		t.Errorf("missing file: got %q, want %q", got, defaultGoVersion)
+	}
+
+	modPath := filepath.Join(dir, "go.mod")
+	content := "module example.com/chain\n\ngo 1.22.5\n\ntoolchain go1.22.7\n"
+	if err := os.WriteFile(modPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got := goVersionFromModFile(modPath); got != "1.22" {
+		t.Errorf("got %q, want %q", got, "1.22")
+	}
+}
@@ -0,0 +1,331 @@
+// internal/daemon/builder/release.go
+package builder
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/credentials"
+)
+
+// ErrNoReleaseAsset is returned by a ReleaseFinder when no prebuilt binary
+// is published for the requested version and platform.
+var ErrNoReleaseAsset = errors.New("no prebuilt release asset")
+
+// ErrChecksumMismatch is returned when a downloaded release asset does not
+// match its published checksum. The builder never falls back to a source
+// build on mismatch, since it may indicate a tampered release.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// ErrUnverifiedRelease is returned for a release asset without a published
+// checksum, unless unverified downloads are allowed. The builder falls back
+// to a source build.
+var ErrUnverifiedRelease = errors.New("release publishes no checksum")
+
+// ReleaseAsset describes a prebuilt binary attached to a release.
+type ReleaseAsset struct {
+	Version     string // release tag (e.g., "v1.2.0")
+	Name        string // asset filename
+	URL         string // download URL
+	ChecksumURL string // URL of a checksum file covering the asset (optional)
+}
+
+// ReleaseFinder locates prebuilt release binaries for a plugin.
+type ReleaseFinder interface {
+	// FindReleaseAsset returns the prebuilt binary asset for pluginName at
+	// version for the current platform, or ErrNoReleaseAsset if none exists.
+	FindReleaseAsset(ctx context.Context, pluginName, version string) (*ReleaseAsset, error)
+}
+
+// ReleaseFile is a file attached to a release, as listed by the hosting service.
+type ReleaseFile struct {
+	Name string
+	URL  string
+}
+
+// checksumFileNames are well-known names of release checksum manifests.
+var checksumFileNames = []string{
+	"checksums.txt",
+	"sha256sum.txt",
+	"sha256sums.txt",
+	"SHA256SUMS",
+	"SHA256SUMS.txt",
+}
+
+// SelectReleaseAsset picks the binary asset and its checksum file from a
+// release's files. If pattern is non-empty it is matched as a glob against
+// asset names; otherwise the asset name must contain binaryName, goos and
+// goarch. Returns nil if no binary asset matches.
+func SelectReleaseAsset(files []ReleaseFile, pattern, binaryName, goos, goarch string) (asset, checksum *ReleaseFile) {
+	for i := range files {
+		f := &files[i]
+		if isChecksumFile(f.Name) {
+			continue
+		}
+		if pattern != "" {
+			if ok, _ := path.Match(pattern, f.Name); !ok {
+				continue
+			}
+		} else {
+			lower := strings.ToLower(f.Name)
+			if !strings.Contains(lower, strings.ToLower(binaryName)) ||
+				!strings.Contains(lower, goos) ||
+				!strings.Contains(lower, goarch) {
+				continue
+			}
+		}
+		asset = f
+		break
+	}
+	if asset == nil {
+		return nil, nil
+	}
+
+	// Prefer a per-asset checksum file, then a release-wide manifest
+	for i := range files {
+		if files[i].Name == asset.Name+".sha256" {
+			return asset, &files[i]
+		}
+	}
+	for _, name := range checksumFileNames {
+		for i := range files {
+			if strings.EqualFold(files[i].Name, name) {
+				return asset, &files[i]
+			}
+		}
+	}
+	return asset, nil
+}
+
+// isChecksumFile reports whether name looks like a checksum or signature file.
+func isChecksumFile(name string) bool {
+	lower := strings.ToLower(name)
+	for _, suffix := range []string{".sha256", ".sha512", ".sig", ".asc", ".pem"} {
+		if strings.HasSuffix(lower, suffix) {
+			return true
+		}
+	}
+	for _, n := range checksumFileNames {
+		if strings.EqualFold(name, n) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseChecksum finds the SHA-256 hash for assetName in a checksum file.
+// Supports "<hash>  <name>" manifests and single-hash files.
+func ParseChecksum(content, assetName string) (string, bool) {
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	var single string
+	lines := 0
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		lines++
+		if len(fields) == 1 {
+			single = fields[0]
+			continue
+		}
+		// sha256sum binary mode prefixes names with '*'
+		if strings.TrimPrefix(fields[len(fields)-1], "*") == assetName {
+			return strings.ToLower(fields[0]), true
+		}
+	}
+	if lines == 1 && single != "" {
+		return strings.ToLower(single), true
+	}
+	return "", false
+}
+
+// releaseDownloader downloads and verifies release assets.
+type releaseDownloader struct {
+	httpClient *http.Client
+}
+
+func newReleaseDownloader() *releaseDownloader {
+	return &releaseDownloader{
+		httpClient: &http.Client{Timeout: 10 * time.Minute},
+	}
+}
+
+// fetch downloads url into w.
+func (d *releaseDownloader) fetch(ctx context.Context, url string, w io.Writer) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	credentials.AuthorizeGitHub(req)
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download %s: HTTP %d", url, resp.StatusCode)
+	}
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	return nil
+}
+
+// download fetches the asset to destPath and verifies its checksum. An
+// asset without a published checksum is only downloaded, unverified, if
+// allowUnverified is set. Returns the hex-encoded SHA-256 of the asset.
+func (d *releaseDownloader) download(ctx context.Context, asset *ReleaseAsset, destPath string, allowUnverified bool) (string, error) {
+	if asset.ChecksumURL == "" && !allowUnverified {
+		return "", fmt.Errorf("%w for %s", ErrUnverifiedRelease, asset.Name)
+	}
+
+	f, err := os.Create(destPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", destPath, err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if err := d.fetch(ctx, asset.URL, io.MultiWriter(f, h)); err != nil {
+		return "", err
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+
+	if asset.ChecksumURL == "" {
+		return sum, nil
+	}
+
+	var checksums strings.Builder
+	if err := d.fetch(ctx, asset.ChecksumURL, &checksums); err != nil {
+		return "", fmt.Errorf("failed to fetch checksums: %w", err)
+	}
+	expected, ok := ParseChecksum(checksums.String(), asset.Name)
+	if !ok {
+		return "", fmt.Errorf("checksum for %s not found in %s", asset.Name, asset.ChecksumURL)
+	}
+	if expected != sum {
+		return "", fmt.Errorf("%w for %s: expected %s, got %s", ErrChecksumMismatch, asset.Name, expected, sum)
+	}
+
+	return sum, nil
+}
+
+// errNotInArchive is returned by ExtractExecutable when no archive entry matches.
+var errNotInArchive = errors.New("not found in archive")
+
+// extractBinary writes binaryName from the downloaded asset at srcPath to
+// destPath. Archives (.tar.gz, .tgz, .zip) are searched for a file named
+// binaryName; any other asset is treated as the binary itself.
+func extractBinary(srcPath, assetName, binaryName, destPath string) error {
+	_, err := ExtractExecutable(srcPath, assetName, func(name string) bool { return name == binaryName }, destPath)
+	if errors.Is(err, errNotInArchive) {
+		return fmt.Errorf("binary %q not found in archive", binaryName)
+	}
+	return err
+}
+
+// ExtractExecutable writes an executable from the downloaded asset at
+// srcPath to destPath. Archives (.tar.gz, .tgz, .zip) are searched for the
+// first regular file whose base name satisfies match; any other asset is
+// treated as the binary itself. Returns the base name of the archive entry,
+// or assetName for a bare binary.
+func ExtractExecutable(srcPath, assetName string, match func(name string) bool, destPath string) (string, error) {
+	lower := strings.ToLower(assetName)
+	switch {
+	case strings.HasSuffix(lower, ".tar.gz") || strings.HasSuffix(lower, ".tgz"):
+		return extractFromTarGz(srcPath, match, destPath)
+	case strings.HasSuffix(lower, ".zip"):
+		return extractFromZip(srcPath, match, destPath)
+	default:
+		return assetName, copyExecutable(srcPath, destPath)
+	}
+}
+
+func extractFromTarGz(srcPath string, match func(string) bool, destPath string) (string, error) {
+	f, err := os.Open(srcPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return "", fmt.Errorf("failed to open gzip: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return "", errNotInArchive
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to read archive: %w", err)
+		}
+		if name := filepath.Base(hdr.Name); hdr.Typeflag == tar.TypeReg && match(name) {
+			return name, writeExecutable(tr, destPath)
+		}
+	}
+}
+
+func extractFromZip(srcPath string, match func(string) bool, destPath string) (string, error) {
+	zr, err := zip.OpenReader(srcPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open zip: %w", err)
+	}
+	defer zr.Close()
+
+	for _, f := range zr.File {
+		name := filepath.Base(f.Name)
+		if f.FileInfo().IsDir() || !match(name) {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return "", fmt.Errorf("failed to read archive: %w", err)
+		}
+		defer rc.Close()
+		return name, writeExecutable(rc, destPath)
+	}
+	return "", errNotInArchive
+}
+
+func copyExecutable(srcPath, destPath string) error {
+	f, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return writeExecutable(f, destPath)
+}
+
+func writeExecutable(r io.Reader, destPath string) error {
+	out, err := os.OpenFile(destPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", destPath, err)
+	}
+	if _, err := io.Copy(out, r); err != nil {
+		out.Close()
+		return fmt.Errorf("failed to write %s: %w", destPath, err)
+	}
+	return out.Close()
+}
@@ -0,0 +1,188 @@
+// internal/daemon/builder/release_test.go
+package builder
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSelectReleaseAsset(t *testing.T) {
+	files := []ReleaseFile{
+		{Name: "stabled-v1.2.0-darwin-arm64.tar.gz", URL: "u1"},
+		{Name: "stabled-v1.2.0-linux-amd64.tar.gz", URL: "u2"},
+		{Name: "stabled-v1.2.0-linux-amd64.tar.gz.sha256", URL: "u3"},
+		{Name: "checksums.txt", URL: "u4"},
+	}
+
+	asset, checksum := SelectReleaseAsset(files, "", "stabled", "linux", "amd64")
+	if asset == nil || asset.URL != "u2" {
+		t.Fatalf("expected linux-amd64 asset, got %+v", asset)
+	}
+	if checksum == nil || checksum.URL != "u3" {
+		t.Errorf("expected per-asset checksum to be preferred, got %+v", checksum)
+	}
+
+	asset, checksum = SelectReleaseAsset(files, "*-darwin-arm64.tar.gz", "stabled", "linux", "amd64")
+	if asset == nil || asset.URL != "u1" {
+		t.Fatalf("expected pattern to select darwin asset, got %+v", asset)
+	}
+	if checksum == nil || checksum.URL != "u4" {
+		t.Errorf("expected checksum manifest, got %+v", checksum)
+	}
+
+	if asset, _ := SelectReleaseAsset(files, "", "stabled", "windows", "amd64"); asset != nil {
+		t.Errorf("expected no asset for windows, got %+v", asset)
+	}
+}
+
+func TestParseChecksum(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		asset   string
+		want    string
+		wantOK  bool
+	}{
+		{
+			name:    "manifest",
+			content: "aaaa  other.tar.gz\nBBBB  app.tar.gz\n",
+			asset:   "app.tar.gz",
+			want:    "bbbb",
+			wantOK:  true,
+		},
+		{
+			name:    "binary mode",
+			content: "cccc *app.tar.gz\n",
+			asset:   "app.tar.gz",
+			want:    "cccc",
+			wantOK:  true,
+		},
+		{
+			name:    "single hash",
+			content: "dddd\n",
+			asset:   "app.tar.gz",
+			want:    "dddd",
+			wantOK:  true,
+		},
+		{
+			name:    "missing",
+			content: "aaaa  other.tar.gz\n",
+			asset:   "app.tar.gz",
+			wantOK:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseChecksum(tt.content, tt.asset)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("ParseChecksum() = %q, %v; want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestExtractBinaryFromTarGz(t *testing.T) {
+	dir := t.TempDir()
+	archive := filepath.Join(dir, "app.tar.gz")
+	content := []byte("#!/bin/sh\necho ok\n")
+
+	f, err := os.Create(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	if err := tw.WriteHeader(&tar.Header{Name: "release/bin/stabled", Mode: 0755, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	tw.Close()
+	gz.Close()
+	f.Close()
+
+	dest := filepath.Join(dir, "stabled")
+	if err := extractBinary(archive, "app.tar.gz", "stabled", dest); err != nil {
+		t.Fatalf("extractBinary() error = %v", err)
+	}
+
+	got, err := os.ReadFile(dest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(content) {
+		t.Errorf("extracted content = %q, want %q", got, content)
+	}
+	info, _ := os.Stat(dest)
+	if info.Mode().Perm()&0100 == 0 {
+		t.Errorf("extracted binary is not executable: %v", info.Mode())
+	}
+
+	if err := extractBinary(archive, "app.tar.gz", "missing", filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected error for binary not in archive")
+	}
+}
+
+func TestReleaseDownloaderVerifiesChecksum(t *testing.T) {
+	payload := []byte("binary-content")
+	sum := sha256.Sum256(payload)
+	goodSum := hex.EncodeToString(sum[:])
+
+	checksums := goodSum + "  stabled\n"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/stabled":
+			_, _ = w.Write(payload)
+		case "/checksums.txt":
+			_, _ = w.Write([]byte(checksums))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	d := newReleaseDownloader()
+	asset := &ReleaseAsset{
+		Version:     "v1.0.0",
+		Name:        "stabled",
+		URL:         srv.URL + "/stabled",
+		ChecksumURL: srv.URL + "/checksums.txt",
+	}
+
+	got, err := d.download(context.Background(), asset, filepath.Join(t.TempDir(), "stabled"), false)
+	if err != nil {
+		t.Fatalf("download() error = %v", err)
+	}
+	if got != goodSum {
+		t.Errorf("download() sum = %s, want %s", got, goodSum)
+	}
+
+	checksums = "0000000000000000000000000000000000000000000000000000000000000000  stabled\n"
+	_, err = d.download(context.Background(), asset, filepath.Join(t.TempDir(), "stabled"), false)
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("download() error = %v, want ErrChecksumMismatch", err)
+	}
+	asset.ChecksumURL = ""
+	dest := filepath.Join(t.TempDir(), "stabled")
+	_, err = d.download(context.Background(), asset, dest, false)
+	if !errors.Is(err, ErrUnverifiedRelease) {
+		t.Errorf("download() error = %v, want ErrUnverifiedRelease", err)
+	}
+	if _, err := os.Stat(dest); !os.IsNotExist(err) {
+		t.Error("an unverified asset should not be downloaded")
+	}
+	if got, err := d.download(context.Background(), asset, dest, true); err != nil || got != goodSum {
+		t.Errorf("download() with unverified allowed = %s, %v", got, err)
+	}
+}
@@ -286,7 +286,7 @@ func (s *BinaryBuilderService) executeBuild(ctx context.Context, sourceDir, outp
 	args = append(args, "-o", outputPath)
 
 	// Add main package (assume ./cmd/<binaryname> or .)
-	mainPkg := s.findMainPackage(sourceDir, filepath.Base(outputPath))
+	mainPkg := findMainPackage(sourceDir, filepath.Base(outputPath))
 	args = append(args, mainPkg)
 
 	// Create command
@@ -324,7 +324,7 @@ func (s *BinaryBuilderService) executeBuild(ctx context.Context, sourceDir, outp
 }
 
 // findMainPackage attempts to find the main package path for a binary.
-func (s *BinaryBuilderService) findMainPackage(sourceDir, binaryName string) string {
+func findMainPackage(sourceDir, binaryName string) string {
 	// Try common patterns
 	patterns := []string{
 		fmt.Sprintf("./cmd/%s", binaryName),
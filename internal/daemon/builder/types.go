@@ -14,16 +14,22 @@ type BuildSpec struct {
 	BuildFlags map[string]string // plugin-specific flags (ldflags, tags, etc.)
 	GoVersion  string            // optional Go version constraint
 	NoCache    bool              // skip cache and force rebuild
+	ForceBuild bool              // compile from source even if a release binary exists
+	// AllowUnverified downloads release binaries whose release publishes no
+	// checksum; without it they are built from source instead
+	AllowUnverified bool
+	Offline         bool // use only cached binaries; never download or clone
 }
 
 // BuildResult contains the result of a successful build
 type BuildResult struct {
-	BinaryPath string    // path to built binary
-	GitCommit  string    // resolved commit hash
-	GitRef     string    // original ref (branch/tag)
-	BuiltAt    time.Time // when the build completed
-	CacheKey   string    // for cache lookups
-	BuildLog   string    // build output (for debugging)
+	BinaryPath  string    // path to built binary
+	GitCommit   string    // resolved commit hash
+	GitRef      string    // original ref (branch/tag)
+	BuiltAt     time.Time // when the build completed
+	CacheKey    string    // for cache lookups
+	BuildLog    string    // build output (for debugging)
+	FromRelease bool      // downloaded from a published release instead of compiled
 }
 
 // BinaryBuilder builds binaries from git sources
@@ -0,0 +1,283 @@
+// internal/daemon/bundle/bundle.go
+
+// Package bundle collects the diagnostics of a devnet for a support bundle
+// to attach to bug reports: the devnet and node specs and statuses, the
+// event history, the tail of each node's log, node config files, the
+// daemon log, versions and host information. Secrets are redacted from
+// every file.
+package bundle
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/prereq"
+	"github.com/altuslabsxyz/devnet-builder/internal/redact"
+	"github.com/altuslabsxyz/devnet-builder/internal/version"
+)
+
+// DefaultLogLines is the number of lines kept from the end of each node log.
+const DefaultLogLines = 500
+
+// daemonLogLines is the number of lines kept from the end of daemon.log.
+const daemonLogLines = 2000
+
+// maxTailBytes bounds how much of the end of daemon.log is read.
+const maxTailBytes = 16 << 20
+
+// maxLogLine bounds a single log line, so a runaway line cannot exhaust
+// memory.
+const maxLogLine = 1024 * 1024
+
+// File is a file in the bundle.
+type File struct {
+	// Name is the path within the bundle, e.g. "nodes/validator-0/node.log".
+	Name    string
+	Content []byte
+}
+
+// LogSource opens the output of a node, starting lines from the end.
+type LogSource func(ctx context.Context, node *types.Node, lines int) (io.ReadCloser, error)
+
+// Config configures a Collector.
+type Config struct {
+	Store store.Store
+	// DataDir is the daemon data directory holding daemon.log.
+	DataDir string
+	// Logs reads node logs. Nil leaves node logs out of the bundle.
+	Logs LogSource
+	// LogLines is the number of lines kept from the end of each node log.
+	// Defaults to DefaultLogLines.
+	LogLines int
+}
+
+// Collector gathers the files of a support bundle.
+type Collector struct {
+	config Config
+}
+
+// NewCollector creates a new Collector.
+func NewCollector(cfg Config) *Collector {
+	if cfg.LogLines <= 0 {
+		cfg.LogLines = DefaultLogLines
+	}
+	return &Collector{config: cfg}
+}
+
+// Collect gathers the bundle files of a devnet. Only a failure to read the
+// devnet itself is an error; anything else that cannot be collected is
+// listed in errors.txt so the rest of the bundle is still useful.
+func (c *Collector) Collect(ctx context.Context, namespace, devnetName string) ([]File, error) {
+	devnet, err := c.config.Store.GetDevnet(ctx, namespace, devnetName)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &builder{}
+	b.addJSON("devnet.json", devnet)
+
+	if es, ok := c.config.Store.(store.EventStore); ok {
+		events, err := es.ListEvents(ctx, devnet.Metadata.Namespace, devnetName, store.ListEventsOptions{})
+		if err != nil {
+			b.fail("events", err)
+		} else {
+			b.addJSON("events.json", events)
+		}
+	}
+
+	nodes, err := c.config.Store.ListNodes(ctx, devnet.Metadata.Namespace, devnetName)
+	if err != nil {
+		b.fail("nodes", err)
+	}
+	for _, node := range nodes {
+		c.collectNode(ctx, b, node)
+	}
+
+	if c.config.DataDir != "" {
+		if tail, err := tailFile(filepath.Join(c.config.DataDir, "daemon.log"), daemonLogLines); err != nil {
+			b.fail("daemon.log", err)
+		} else {
+			b.add("daemon.log", tail)
+		}
+	}
+
+	b.add("versions.txt", []byte(versions(devnet)))
+	b.add("host.txt", []byte(c.hostInfo()))
+
+	if len(b.errors) > 0 {
+		b.add("errors.txt", []byte(strings.Join(b.errors, "\n")+"\n"))
+	}
+	return b.files, nil
+}
+
+// collectNode adds a node's spec and status, log tail and config files.
+func (c *Collector) collectNode(ctx context.Context, b *builder, node *types.Node) {
+	dir := fmt.Sprintf("nodes/%s-%d", strings.ToLower(node.Spec.Role), node.Spec.Index)
+	b.addJSON(dir+"/node.json", node)
+
+	if c.config.Logs != nil {
+		if logs, err := c.nodeLog(ctx, node); err != nil {
+			b.fail(dir+"/node.log", err)
+		} else {
+			b.add(dir+"/node.log", logs)
+		}
+	}
+
+	if node.Spec.HomeDir == "" {
+		return
+	}
+	for _, name := range []string{"config.toml", "app.toml"} {
+		content, err := os.ReadFile(filepath.Join(node.Spec.HomeDir, "config", name))
+		if err != nil {
+			b.fail(dir+"/"+name, err)
+			continue
+		}
+		b.add(dir+"/"+name, content)
+	}
+}
+
+// nodeLog returns the last lines of a node's log, without the stream
+// headers Docker prefixes to each line.
+func (c *Collector) nodeLog(ctx context.Context, node *types.Node) ([]byte, error) {
+	reader, err := c.config.Logs(ctx, node, c.config.LogLines)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), maxLogLine)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if len(line) >= 8 && (line[0] == 1 || line[0] == 2) {
+			line = line[8:]
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(lines) > c.config.LogLines {
+		lines = lines[len(lines)-c.config.LogLines:]
+	}
+	return []byte(strings.Join(lines, "\n") + "\n"), nil
+}
+
+// hostInfo describes the machine the daemon runs on.
+func (c *Collector) hostInfo() string {
+	var sb strings.Builder
+	hostname, _ := os.Hostname()
+	fmt.Fprintf(&sb, "collected: %s\n", time.Now().UTC().Format(time.RFC3339))
+	fmt.Fprintf(&sb, "hostname:  %s\n", hostname)
+	fmt.Fprintf(&sb, "os/arch:   %s/%s\n", goruntime.GOOS, goruntime.GOARCH)
+	fmt.Fprintf(&sb, "cpus:      %d\n", goruntime.NumCPU())
+	if c.config.DataDir != "" {
+		fmt.Fprintf(&sb, "data dir:  %s\n", c.config.DataDir)
+		if usage, err := prereq.StatDisk(c.config.DataDir); err == nil {
+			fmt.Fprintf(&sb, "disk free: %.1f GiB (%s)\n", float64(usage.Available)/(1<<30), usage.Path)
+		}
+	}
+	return sb.String()
+}
+
+// versions describes the daemon build and the devnet's chain binary.
+func versions(devnet *types.Devnet) string {
+	var sb strings.Builder
+	sb.WriteString(version.NewInfo("devnet-builder", "devnetd").String())
+	fmt.Fprintf(&sb, "\nplugin:         %s\n", devnet.Spec.Plugin)
+	fmt.Fprintf(&sb, "network type:   %s\n", devnet.Spec.NetworkType)
+	fmt.Fprintf(&sb, "mode:           %s\n", devnet.Spec.Mode)
+	if src := devnet.Spec.BinarySource; src.Type != "" {
+		fmt.Fprintf(&sb, "binary source:  %s %s\n", src.Type, firstNonEmpty(src.Version, src.Path, src.URL))
+	}
+	if devnet.Spec.Image != "" {
+		fmt.Fprintf(&sb, "image:          %s\n", devnet.Spec.Image)
+	}
+	if devnet.Status.ImageDigest != "" {
+		fmt.Fprintf(&sb, "image digest:   %s\n", devnet.Status.ImageDigest)
+	}
+	if devnet.Status.SDKVersion != "" {
+		fmt.Fprintf(&sb, "sdk version:    %s\n", devnet.Status.SDKVersion)
+	}
+	return sb.String()
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// builder accumulates bundle files and collection errors.
+type builder struct {
+	files  []File
+	errors []string
+}
+
+func (b *builder) add(name string, content []byte) {
+	b.files = append(b.files, File{Name: name, Content: redact.Bytes(content)})
+}
+
+func (b *builder) addJSON(name string, v any) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		b.fail(name, err)
+		return
+	}
+	b.add(name, append(data, '\n'))
+}
+
+func (b *builder) fail(what string, err error) {
+	b.errors = append(b.errors, fmt.Sprintf("%s: %v", what, err))
+}
+
+// tailFile returns the last n lines of a file, reading at most its last
+// maxTailBytes.
+func tailFile(path string, n int) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	partial := info.Size() > maxTailBytes
+	if partial {
+		if _, err := f.Seek(-maxTailBytes, io.SeekEnd); err != nil {
+			return nil, err
+		}
+	}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	if partial && len(lines) > 1 {
+		lines = lines[1:]
+	}
+	if len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return bytes.Join(lines, nil), nil
+}
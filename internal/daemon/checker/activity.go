@@ -0,0 +1,147 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// maxTxScanBlocks caps how many recent blocks SampleActivity inspects for
+// transactions; one /blockchain page.
+const maxTxScanBlocks = 20
+
+// RPCActivitySampler samples user activity on a node: transactions in new
+// blocks, pending mempool transactions, and client connections to the
+// node's RPC, REST, gRPC and EVM ports. Block production alone is not
+// activity.
+type RPCActivitySampler struct {
+	client  *http.Client
+	baseRPC int
+	logger  *slog.Logger
+
+	// connections counts client connections; overridden in tests.
+	connections func(host string, ports []int) int
+}
+
+// NewRPCActivitySampler creates a new activity sampler. It uses the same
+// Config as the RPCHealthChecker.
+func NewRPCActivitySampler(cfg Config) *RPCActivitySampler {
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 5 * time.Second
+	}
+	if cfg.BaseRPC == 0 {
+		cfg.BaseRPC = 26657
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &RPCActivitySampler{
+		client: &http.Client{
+			Timeout: cfg.Timeout,
+		},
+		baseRPC:     cfg.BaseRPC,
+		logger:      logger,
+		connections: countConnections,
+	}
+}
+
+// SampleActivity samples the node's activity. Transactions are counted in
+// blocks after sinceHeight, up to the latest maxTxScanBlocks blocks; pass 0
+// on the first sample to only record the height.
+func (s *RPCActivitySampler) SampleActivity(ctx context.Context, node *types.Node, sinceHeight int64) (*types.ActivitySample, error) {
+	host := node.Spec.Address
+	if host == "" {
+		host = "127.0.0.1"
+	}
+	rpcPort := s.baseRPC + node.Spec.Index
+	if !node.Spec.PortLayout.IsZero() {
+		rpcPort = node.Spec.Ports().RPC
+	}
+	base := "http://" + net.JoinHostPort(host, strconv.Itoa(rpcPort))
+
+	var statusResp CometBFTStatusResponse
+	if err := s.get(ctx, base+"/status", &statusResp); err != nil {
+		return nil, err
+	}
+	sample := &types.ActivitySample{Height: statusResp.Result.SyncInfo.LatestBlockHeight}
+
+	if sinceHeight > 0 && sample.Height > sinceHeight {
+		minHeight := sinceHeight + 1
+		if sample.Height-minHeight >= maxTxScanBlocks {
+			minHeight = sample.Height - maxTxScanBlocks + 1
+		}
+		var chainResp CometBFTBlockchainResponse
+		url := fmt.Sprintf("%s/blockchain?minHeight=%d&maxHeight=%d", base, minHeight, sample.Height)
+		if err := s.get(ctx, url, &chainResp); err != nil {
+			return nil, err
+		}
+		for _, meta := range chainResp.Result.BlockMetas {
+			sample.Txs += meta.NumTxs
+		}
+	}
+
+	var mempoolResp CometBFTUnconfirmedTxsResponse
+	if err := s.get(ctx, base+"/num_unconfirmed_txs", &mempoolResp); err != nil {
+		return nil, err
+	}
+	sample.PendingTxs = mempoolResp.Result.Total
+
+	ports := node.Spec.Ports()
+	sample.Connections = s.connections(host, []int{rpcPort, ports.API, ports.GRPC, ports.EVMRPC, ports.EVMWS})
+
+	s.logger.Debug("node activity sampled",
+		"node", fmt.Sprintf("%s/%d", node.Spec.DevnetRef, node.Spec.Index),
+		"height", sample.Height,
+		"txs", sample.Txs,
+		"pendingTxs", sample.PendingTxs,
+		"connections", sample.Connections)
+
+	return sample, nil
+}
+
+// get fetches a CometBFT RPC endpoint and decodes the JSON response.
+func (s *RPCActivitySampler) get(ctx context.Context, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("RPC request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("RPC returned status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// CometBFTBlockchainResponse is the response from /blockchain endpoint.
+type CometBFTBlockchainResponse struct {
+	Result struct {
+		LastHeight int64 `json:"last_height,string"`
+		BlockMetas []struct {
+			NumTxs int `json:"num_txs,string"`
+		} `json:"block_metas"`
+	} `json:"result"`
+}
+
+// CometBFTUnconfirmedTxsResponse is the response from /num_unconfirmed_txs
+// endpoint.
+type CometBFTUnconfirmedTxsResponse struct {
+	Result struct {
+		Total int `json:"total,string"`
+	} `json:"result"`
+}
@@ -0,0 +1,89 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+func newActivityTestSampler(t *testing.T, handler http.HandlerFunc) *RPCActivitySampler {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	port := strings.Split(server.URL, ":")[2]
+	var rpcPort int
+	_, _ = fmt.Sscanf(port, "%d", &rpcPort)
+
+	s := NewRPCActivitySampler(Config{BaseRPC: rpcPort})
+	s.connections = func(host string, ports []int) int { return 0 }
+	return s
+}
+
+func TestRPCActivitySampler_SampleActivity(t *testing.T) {
+	var blockchainQuery string
+	s := newActivityTestSampler(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/status":
+			w.Write([]byte(`{"result":{"sync_info":{"latest_block_height":"150"}}}`))
+		case "/blockchain":
+			blockchainQuery = r.URL.RawQuery
+			w.Write([]byte(`{"result":{"last_height":"150","block_metas":[{"num_txs":"2"},{"num_txs":"0"},{"num_txs":"1"}]}}`))
+		case "/num_unconfirmed_txs":
+			w.Write([]byte(`{"result":{"n_txs":"1","total":"4"}}`))
+		}
+	})
+
+	node := &types.Node{Spec: types.NodeSpec{DevnetRef: "test"}}
+	sample, err := s.SampleActivity(context.Background(), node, 100)
+	if err != nil {
+		t.Fatalf("SampleActivity failed: %v", err)
+	}
+
+	if sample.Height != 150 || sample.Txs != 3 || sample.PendingTxs != 4 || sample.Connections != 0 {
+		t.Errorf("unexpected sample: %+v", sample)
+	}
+	if !sample.Active() {
+		t.Error("expected sample to be active")
+	}
+	// Only the latest page of blocks is scanned
+	if blockchainQuery != "minHeight=131&maxHeight=150" {
+		t.Errorf("blockchain query = %q", blockchainQuery)
+	}
+}
+
+func TestRPCActivitySampler_FirstSample(t *testing.T) {
+	s := newActivityTestSampler(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/status":
+			w.Write([]byte(`{"result":{"sync_info":{"latest_block_height":"42"}}}`))
+		case "/num_unconfirmed_txs":
+			w.Write([]byte(`{"result":{"total":"0"}}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	sample, err := s.SampleActivity(context.Background(), &types.Node{}, 0)
+	if err != nil {
+		t.Fatalf("SampleActivity failed: %v", err)
+	}
+	if sample.Height != 42 || sample.Active() {
+		t.Errorf("unexpected sample: %+v", sample)
+	}
+}
+
+func TestRPCActivitySampler_RPCError(t *testing.T) {
+	s := newActivityTestSampler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if _, err := s.SampleActivity(context.Background(), &types.Node{}, 0); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,113 @@
+package checker
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// tcpStateEstablished is the ESTABLISHED state in /proc/net/tcp.
+const tcpStateEstablished = 0x01
+
+// tcpConn is a row of /proc/net/tcp or /proc/net/tcp6.
+type tcpConn struct {
+	local  netip.AddrPort
+	remote netip.AddrPort
+	state  uint8
+	inode  uint64
+}
+
+// parseProcNetTCP parses the contents of /proc/net/tcp or /proc/net/tcp6.
+func parseProcNetTCP(r io.Reader) ([]tcpConn, error) {
+	var conns []tcpConn
+	scanner := bufio.NewScanner(r)
+	first := true
+	for scanner.Scan() {
+		if first {
+			// Header line
+			first = false
+			continue
+		}
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 {
+			continue
+		}
+		local, err := parseHexAddrPort(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		remote, err := parseHexAddrPort(fields[2])
+		if err != nil {
+			return nil, err
+		}
+		state, err := strconv.ParseUint(fields[3], 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tcp state %q: %w", fields[3], err)
+		}
+		inode, err := strconv.ParseUint(fields[9], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid inode %q: %w", fields[9], err)
+		}
+		conns = append(conns, tcpConn{local: local, remote: remote, state: uint8(state), inode: inode})
+	}
+	return conns, scanner.Err()
+}
+
+// parseHexAddrPort parses an "ADDR:PORT" pair from /proc/net/tcp. The
+// address is hex encoded in host (little-endian) byte order, one 32-bit
+// word at a time.
+func parseHexAddrPort(s string) (netip.AddrPort, error) {
+	addrHex, portHex, ok := strings.Cut(s, ":")
+	if !ok {
+		return netip.AddrPort{}, fmt.Errorf("invalid address %q", s)
+	}
+	raw, err := hex.DecodeString(addrHex)
+	if err != nil || (len(raw) != 4 && len(raw) != 16) {
+		return netip.AddrPort{}, fmt.Errorf("invalid address %q", s)
+	}
+	for i := 0; i < len(raw); i += 4 {
+		raw[i], raw[i+1], raw[i+2], raw[i+3] = raw[i+3], raw[i+2], raw[i+1], raw[i]
+	}
+	port, err := strconv.ParseUint(portHex, 16, 16)
+	if err != nil {
+		return netip.AddrPort{}, fmt.Errorf("invalid port in %q", s)
+	}
+	addr, _ := netip.AddrFromSlice(raw)
+	return netip.AddrPortFrom(addr.Unmap(), uint16(port)), nil
+}
+
+// countServerConnections counts established connections accepted by host
+// on any of ports. Connections from the sockets in own, the daemon's own
+// health and activity probes, are not counted.
+func countServerConnections(conns []tcpConn, host netip.Addr, ports []int, own map[uint64]bool) int {
+	watched := make(map[uint16]bool, len(ports))
+	for _, p := range ports {
+		watched[uint16(p)] = true
+	}
+
+	ownEndpoints := make(map[netip.AddrPort]bool)
+	for _, c := range conns {
+		if own[c.inode] {
+			ownEndpoints[c.local] = true
+		}
+	}
+
+	count := 0
+	for _, c := range conns {
+		if c.state != tcpStateEstablished {
+			continue
+		}
+		if c.local.Addr() != host || !watched[c.local.Port()] {
+			continue
+		}
+		if ownEndpoints[c.remote] {
+			continue
+		}
+		count++
+	}
+	return count
+}
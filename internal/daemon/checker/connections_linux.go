@@ -0,0 +1,61 @@
+//go:build linux
+
+package checker
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// countConnections counts client connections to host on ports from
+// /proc/net/tcp and /proc/net/tcp6. It returns -1 if they cannot be read.
+func countConnections(host string, ports []int) int {
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return -1
+	}
+
+	var conns []tcpConn
+	read := false
+	for _, path := range []string{"/proc/net/tcp", "/proc/net/tcp6"} {
+		f, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		parsed, err := parseProcNetTCP(f)
+		f.Close()
+		if err != nil {
+			return -1
+		}
+		conns = append(conns, parsed...)
+		read = true
+	}
+	if !read {
+		return -1
+	}
+
+	return countServerConnections(conns, addr.Unmap(), ports, ownSocketInodes())
+}
+
+// ownSocketInodes returns the inodes of the sockets held by this process.
+func ownSocketInodes() map[uint64]bool {
+	inodes := make(map[uint64]bool)
+	fds, err := os.ReadDir("/proc/self/fd")
+	if err != nil {
+		return inodes
+	}
+	for _, fd := range fds {
+		link, err := os.Readlink(filepath.Join("/proc/self/fd", fd.Name()))
+		if err != nil || !strings.HasPrefix(link, "socket:[") {
+			continue
+		}
+		inode, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(link, "socket:["), "]"), 10, 64)
+		if err == nil {
+			inodes[inode] = true
+		}
+	}
+	return inodes
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+
+package checker
+
+// countConnections is only supported on Linux; elsewhere idle detection
+// relies on transactions alone.
+func countConnections(host string, ports []int) int {
+	return -1
+}
@@ -0,0 +1,71 @@
+package checker
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+const procNetTCP = `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 0100007F:6699 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 100 1 0000000000000000 100 0 0 10 0
+   1: 0100007F:6699 0100007F:D431 01 00000000:00000000 00:00000000 00000000     0        0 101 1 0000000000000000 20 4 30 10 -1
+   2: 0100007F:D431 0100007F:6699 01 00000000:00000000 00:00000000 00000000     0        0 102 1 0000000000000000 20 4 30 10 -1
+   3: 0100007F:6699 0100007F:D432 01 00000000:00000000 00:00000000 00000000     0        0 103 1 0000000000000000 20 4 30 10 -1
+   4: 0100007F:0515 0100007F:D433 01 00000000:00000000 00:00000000 00000000     0        0 104 1 0000000000000000 20 4 30 10 -1
+   5: 012A007F:6699 0100007F:D434 01 00000000:00000000 00:00000000 00000000     0        0 105 1 0000000000000000 20 4 30 10 -1
+   6: 0100007F:6699 0100007F:D435 06 00000000:00000000 00:00000000 00000000     0        0 0 1 0000000000000000 20 4 30 10 -1
+`
+
+func TestParseProcNetTCP(t *testing.T) {
+	conns, err := parseProcNetTCP(strings.NewReader(procNetTCP))
+	if err != nil {
+		t.Fatalf("parseProcNetTCP failed: %v", err)
+	}
+	if len(conns) != 7 {
+		t.Fatalf("expected 7 rows, got %d", len(conns))
+	}
+
+	c := conns[1]
+	if c.local != netip.MustParseAddrPort("127.0.0.1:26265") ||
+		c.remote != netip.MustParseAddrPort("127.0.0.1:54321") ||
+		c.state != tcpStateEstablished || c.inode != 101 {
+		t.Errorf("unexpected row: %+v", c)
+	}
+	if got := conns[5].local.Addr(); got != netip.MustParseAddr("127.0.42.1") {
+		t.Errorf("local addr = %v, want 127.0.42.1", got)
+	}
+}
+
+func TestParseHexAddrPort_IPv6(t *testing.T) {
+	// ::ffff:127.0.0.1 port 26657, as written to /proc/net/tcp6
+	got, err := parseHexAddrPort("0000000000000000FFFF00000100007F:6821")
+	if err != nil {
+		t.Fatalf("parseHexAddrPort failed: %v", err)
+	}
+	if want := netip.MustParseAddrPort("127.0.0.1:26657"); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, err := parseHexAddrPort("zz:0000"); err == nil {
+		t.Error("expected error for invalid address")
+	}
+}
+
+func TestCountServerConnections(t *testing.T) {
+	conns, err := parseProcNetTCP(strings.NewReader(procNetTCP))
+	if err != nil {
+		t.Fatalf("parseProcNetTCP failed: %v", err)
+	}
+	host := netip.MustParseAddr("127.0.0.1")
+
+	// Rows 1 and 3 are client connections to port 26265; row 4 is on an
+	// unwatched port, row 5 on another host, row 6 is closing.
+	if got := countServerConnections(conns, host, []int{26265}, nil); got != 2 {
+		t.Errorf("count = %d, want 2", got)
+	}
+
+	// Row 2 is our own client socket, so row 1 is not counted.
+	if got := countServerConnections(conns, host, []int{26265}, map[uint64]bool{102: true}); got != 1 {
+		t.Errorf("count excluding own = %d, want 1", got)
+	}
+}
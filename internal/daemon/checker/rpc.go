@@ -2,14 +2,18 @@
 package checker
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
 )
 
 // RPCHealthChecker implements HealthChecker using node RPC endpoints.
@@ -17,6 +21,7 @@ import (
 type RPCHealthChecker struct {
 	client  *http.Client
 	baseRPC int
+	evm     func(node *types.Node) bool
 	logger  *slog.Logger
 }
 
@@ -29,6 +34,10 @@ type Config struct {
 	// Each node's RPC port is calculated as BaseRPC + node.Spec.Index.
 	BaseRPC int
 
+	// EVM reports whether a node serves EVM JSON-RPC, which is then
+	// probed with eth_chainId and eth_blockNumber. Nil probes no node.
+	EVM func(node *types.Node) bool
+
 	// Logger for checker operations.
 	Logger *slog.Logger
 }
@@ -60,6 +69,7 @@ func NewRPCHealthChecker(cfg Config) *RPCHealthChecker {
 			Timeout: cfg.Timeout,
 		},
 		baseRPC: cfg.BaseRPC,
+		evm:     cfg.EVM,
 		logger:  logger,
 	}
 }
@@ -73,6 +83,9 @@ func (c *RPCHealthChecker) CheckHealth(ctx context.Context, node *types.Node) (*
 
 	// Calculate RPC port for this node
 	rpcPort := c.baseRPC + node.Spec.Index
+	if !node.Spec.PortLayout.IsZero() {
+		rpcPort = node.Spec.Ports().RPC
+	}
 	statusURL := fmt.Sprintf("http://127.0.0.1:%d/status", rpcPort)
 
 	c.logger.Debug("checking node health",
@@ -120,6 +133,16 @@ func (c *RPCHealthChecker) CheckHealth(ctx context.Context, node *types.Node) (*
 		result.PeerCount = peerCount
 	}
 
+	// Validators with a remote signer must be signing through it
+	if node.Spec.RemoteSigner != nil {
+		result.Signer = c.checkSigner(ctx, rpcPort, &statusResp)
+	}
+
+	// Nodes of networks with an EVM must serve it over JSON-RPC
+	if c.evm != nil && c.evm(node) {
+		result.EVM = c.checkEVM(ctx, node)
+	}
+
 	c.logger.Debug("node health check complete",
 		"node", result.NodeKey,
 		"healthy", result.Healthy,
@@ -130,6 +153,141 @@ func (c *RPCHealthChecker) CheckHealth(ctx context.Context, node *types.Node) (*
 	return result, nil
 }
 
+// signerCheckBlocks is how many recent blocks a validator with a remote
+// signer must have signed at least one of; a single missed precommit does
+// not mean the signer is gone.
+const signerCheckBlocks = 3
+
+// checkSigner checks that the validator of status signed one of the last
+// signerCheckBlocks commits, which it cannot do without its remote signer.
+func (c *RPCHealthChecker) checkSigner(ctx context.Context, rpcPort int, status *CometBFTStatusResponse) *types.SignerCheck {
+	height := status.Result.SyncInfo.LatestBlockHeight
+	address := status.Result.ValidatorInfo.Address
+	switch {
+	case status.Result.SyncInfo.CatchingUp || height < 2:
+		return &types.SignerCheck{Status: types.ConditionUnknown, Reason: types.ReasonWaitingForBlocks,
+			Message: fmt.Sprintf("Waiting for blocks to check signatures (height %d)", height)}
+	case status.Result.ValidatorInfo.VotingPower == "" || status.Result.ValidatorInfo.VotingPower == "0":
+		return &types.SignerCheck{Status: types.ConditionUnknown, Reason: types.ReasonNotInValidatorSet,
+			Message: fmt.Sprintf("Validator %s has no voting power", address)}
+	}
+
+	for h := height; h > height-signerCheckBlocks && h > 0; h-- {
+		signed, err := c.signedCommit(ctx, rpcPort, h, address)
+		if err != nil {
+			return &types.SignerCheck{Status: types.ConditionUnknown, Reason: types.ReasonSignerCheckFailed,
+				Message: err.Error()}
+		}
+		if signed {
+			return &types.SignerCheck{Status: types.ConditionTrue, Reason: types.ReasonSignerSigning,
+				Message: fmt.Sprintf("Validator %s signed block %d", address, h)}
+		}
+	}
+	return &types.SignerCheck{Status: types.ConditionFalse, Reason: types.ReasonSignerNotSigning,
+		Message: fmt.Sprintf("Validator %s signed none of the last %d blocks (up to %d); is its remote signer connected?",
+			address, signerCheckBlocks, height)}
+}
+
+// signedCommit reports whether the validator with the given address signed
+// the commit of a block.
+func (c *RPCHealthChecker) signedCommit(ctx context.Context, rpcPort int, height int64, address string) (bool, error) {
+	commitURL := fmt.Sprintf("http://127.0.0.1:%d/commit?height=%d", rpcPort, height)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, commitURL, nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("commit request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("commit returned status %d", resp.StatusCode)
+	}
+
+	var commitResp CometBFTCommitResponse
+	if err := json.NewDecoder(resp.Body).Decode(&commitResp); err != nil {
+		return false, fmt.Errorf("failed to parse commit response: %w", err)
+	}
+
+	for _, sig := range commitResp.Result.SignedHeader.Commit.Signatures {
+		if sig.BlockIDFlag == blockIDFlagCommit && strings.EqualFold(sig.ValidatorAddress, address) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkEVM probes the node's EVM JSON-RPC with eth_chainId and
+// eth_blockNumber. A chain ID in the Ethermint format fixes the EVM chain ID
+// the node must report.
+func (c *RPCHealthChecker) checkEVM(ctx context.Context, node *types.Node) *types.EVMCheck {
+	evmPort := dvbtypes.DefaultPortConfig().EVMRPC + node.Spec.Index
+	if !node.Spec.PortLayout.IsZero() {
+		evmPort = node.Spec.Ports().EVMRPC
+	}
+	rpcURL := fmt.Sprintf("http://127.0.0.1:%d", evmPort)
+
+	chainID, err := c.ethQuantity(ctx, rpcURL, "eth_chainId")
+	if err != nil {
+		return &types.EVMCheck{Status: types.ConditionFalse, Reason: types.ReasonEVMUnreachable,
+			Message: fmt.Sprintf("EVM JSON-RPC on port %d: %v", evmPort, err)}
+	}
+	if want, ok := dvbtypes.ChainID(node.Spec.ChainID).EVMChainID(); ok && chainID != want {
+		return &types.EVMCheck{Status: types.ConditionFalse, Reason: types.ReasonEVMChainIDMismatch, ChainID: chainID,
+			Message: fmt.Sprintf("EVM chain ID is %d, but chain ID %s implies %d", chainID, node.Spec.ChainID, want)}
+	}
+
+	blockNumber, err := c.ethQuantity(ctx, rpcURL, "eth_blockNumber")
+	if err != nil {
+		return &types.EVMCheck{Status: types.ConditionFalse, Reason: types.ReasonEVMUnreachable, ChainID: chainID,
+			Message: fmt.Sprintf("EVM JSON-RPC on port %d: %v", evmPort, err)}
+	}
+	return &types.EVMCheck{Status: types.ConditionTrue, Reason: types.ReasonEVMServing,
+		ChainID: chainID, BlockNumber: blockNumber,
+		Message: fmt.Sprintf("EVM chain %d is at block %d", chainID, blockNumber)}
+}
+
+// ethQuantity calls a parameterless JSON-RPC method that returns a
+// hex-encoded quantity, such as eth_chainId.
+func (c *RPCHealthChecker) ethQuantity(ctx context.Context, rpcURL, method string) (uint64, error) {
+	body, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 1, "method": method, "params": []any{}})
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rpcURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("%s request failed: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("%s returned status %d", method, resp.StatusCode)
+	}
+
+	var rpcResp JSONRPCResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return 0, fmt.Errorf("failed to parse %s response: %w", method, err)
+	}
+	if rpcResp.Error != nil {
+		return 0, fmt.Errorf("%s failed: %s", method, rpcResp.Error.Message)
+	}
+	quantity, err := strconv.ParseUint(strings.TrimPrefix(rpcResp.Result, "0x"), 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s returned %q: %w", method, rpcResp.Result, err)
+	}
+	return quantity, nil
+}
+
 // getPeerCount fetches the peer count from the node's net_info endpoint.
 func (c *RPCHealthChecker) getPeerCount(ctx context.Context, rpcPort int) (int, error) {
 	netInfoURL := fmt.Sprintf("http://127.0.0.1:%d/net_info", rpcPort)
@@ -180,6 +338,23 @@ type CometBFTStatusResponse struct {
 	} `json:"result"`
 }
 
+// blockIDFlagCommit is the block ID flag of a precommit for the block.
+const blockIDFlagCommit = 2
+
+// CometBFTCommitResponse is the response from /commit endpoint.
+type CometBFTCommitResponse struct {
+	Result struct {
+		SignedHeader struct {
+			Commit struct {
+				Signatures []struct {
+					BlockIDFlag      int    `json:"block_id_flag"`
+					ValidatorAddress string `json:"validator_address"`
+				} `json:"signatures"`
+			} `json:"commit"`
+		} `json:"signed_header"`
+	} `json:"result"`
+}
+
 // CometBFTNetInfoResponse is the response from /net_info endpoint.
 type CometBFTNetInfoResponse struct {
 	Result struct {
@@ -189,6 +364,15 @@ type CometBFTNetInfoResponse struct {
 	} `json:"result"`
 }
 
+// JSONRPCResponse is an EVM JSON-RPC response whose result is a string.
+type JSONRPCResponse struct {
+	Result string `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
 // Peer represents a connected peer.
 type Peer struct {
 	NodeInfo struct {
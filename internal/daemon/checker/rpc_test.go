@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
 )
 
 func TestRPCHealthChecker_CheckHealth_Success(t *testing.T) {
@@ -181,3 +182,140 @@ func TestDefaultConfig(t *testing.T) {
 		t.Errorf("Expected base RPC 26657, got %d", cfg.BaseRPC)
 	}
 }
+
+func TestRPCHealthChecker_CheckHealth_RemoteSigner(t *testing.T) {
+	const address = "AB12CD34"
+
+	tests := []struct {
+		name        string
+		votingPower string
+		signedAt    int64 // height whose commit the validator signed; 0 = none
+		wantStatus  string
+		wantReason  string
+	}{
+		{"signed latest block", "10", 100, types.ConditionTrue, types.ReasonSignerSigning},
+		{"missed one block", "10", 99, types.ConditionTrue, types.ReasonSignerSigning},
+		{"signer gone", "10", 0, types.ConditionFalse, types.ReasonSignerNotSigning},
+		{"not in validator set", "0", 0, types.ConditionUnknown, types.ReasonNotInValidatorSet},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/status":
+					resp := CometBFTStatusResponse{}
+					resp.Result.SyncInfo.LatestBlockHeight = 100
+					resp.Result.ValidatorInfo.Address = address
+					resp.Result.ValidatorInfo.VotingPower = tt.votingPower
+					json.NewEncoder(w).Encode(resp)
+				case "/net_info":
+					w.Write([]byte(`{"result":{"listening":true,"n_peers":"1","peers":[]}}`))
+				case "/commit":
+					signer := "EEEEEEEE"
+					if r.URL.Query().Get("height") == fmt.Sprint(tt.signedAt) {
+						signer = strings.ToLower(address)
+					}
+					fmt.Fprintf(w, `{"result":{"signed_header":{"commit":{"signatures":[{"block_id_flag":2,"validator_address":%q},{"block_id_flag":1,"validator_address":""}]}}}}`, signer)
+				}
+			}))
+			defer server.Close()
+
+			var rpcPort int
+			_, _ = fmt.Sscanf(strings.Split(server.URL, ":")[2], "%d", &rpcPort)
+			checker := NewRPCHealthChecker(Config{BaseRPC: rpcPort})
+
+			node := &types.Node{
+				Spec: types.NodeSpec{
+					DevnetRef:    "test",
+					Role:         "validator",
+					RemoteSigner: &types.RemoteSigner{TMKMS: true},
+				},
+			}
+			result, err := checker.CheckHealth(context.Background(), node)
+			if err != nil {
+				t.Fatalf("CheckHealth failed: %v", err)
+			}
+			if result.Signer == nil {
+				t.Fatal("expected a signer check")
+			}
+			if result.Signer.Status != tt.wantStatus || result.Signer.Reason != tt.wantReason {
+				t.Errorf("signer check = %s/%s (%s), want %s/%s",
+					result.Signer.Status, result.Signer.Reason, result.Signer.Message, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestRPCHealthChecker_CheckHealth_EVM(t *testing.T) {
+	tests := []struct {
+		name       string
+		chainID    string
+		evmChainID string // eth_chainId result; "" = JSON-RPC error
+		wantStatus string
+		wantReason string
+	}{
+		{"serving", "devnet_9002-1", "0x232a", types.ConditionTrue, types.ReasonEVMServing},
+		{"other chain ID format", "devnet-1", "0x1", types.ConditionTrue, types.ReasonEVMServing},
+		{"chain ID mismatch", "devnet_9002-1", "0x1", types.ConditionFalse, types.ReasonEVMChainIDMismatch},
+		{"json-rpc error", "devnet_9002-1", "", types.ConditionFalse, types.ReasonEVMUnreachable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// One server answers both CometBFT RPC and EVM JSON-RPC
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/status":
+					resp := CometBFTStatusResponse{}
+					resp.Result.SyncInfo.LatestBlockHeight = 100
+					json.NewEncoder(w).Encode(resp)
+				case "/net_info":
+					w.Write([]byte(`{"result":{"listening":true,"n_peers":"1","peers":[]}}`))
+				case "/":
+					var req struct {
+						Method string `json:"method"`
+					}
+					json.NewDecoder(r.Body).Decode(&req)
+					switch {
+					case tt.evmChainID == "":
+						w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"the method does not exist"}}`))
+					case req.Method == "eth_chainId":
+						fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":%q}`, tt.evmChainID)
+					case req.Method == "eth_blockNumber":
+						w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x64"}`))
+					}
+				}
+			}))
+			defer server.Close()
+
+			var port int
+			_, _ = fmt.Sscanf(strings.Split(server.URL, ":")[2], "%d", &port)
+			checker := NewRPCHealthChecker(Config{
+				EVM: func(node *types.Node) bool { return true },
+			})
+
+			node := &types.Node{
+				Spec: types.NodeSpec{
+					DevnetRef:  "test",
+					ChainID:    tt.chainID,
+					PortLayout: dvbtypes.PortLayout{RPC: port, EVMRPC: port},
+				},
+			}
+			result, err := checker.CheckHealth(context.Background(), node)
+			if err != nil {
+				t.Fatalf("CheckHealth failed: %v", err)
+			}
+			if result.EVM == nil {
+				t.Fatal("expected an EVM check")
+			}
+			if result.EVM.Status != tt.wantStatus || result.EVM.Reason != tt.wantReason {
+				t.Errorf("EVM check = %s/%s (%s), want %s/%s",
+					result.EVM.Status, result.EVM.Reason, result.EVM.Message, tt.wantStatus, tt.wantReason)
+			}
+			if tt.wantStatus == types.ConditionTrue && result.EVM.BlockNumber != 100 {
+				t.Errorf("EVM block number = %d, want 100", result.EVM.BlockNumber)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+// internal/daemon/clock/clock_test.go
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := NewFake(start)
+	if got := c.Now(); !got.Equal(start) {
+		t.Errorf("Now() = %v, want %v", got, start)
+	}
+
+	c.Advance(90 * time.Minute)
+	if got, want := c.Now(), start.Add(90*time.Minute); !got.Equal(want) {
+		t.Errorf("after Advance, Now() = %v, want %v", got, want)
+	}
+
+	c.Set(start)
+	if got := c.Now(); !got.Equal(start) {
+		t.Errorf("after Set, Now() = %v, want %v", got, start)
+	}
+}
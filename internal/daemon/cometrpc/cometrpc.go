@@ -0,0 +1,58 @@
+// internal/daemon/cometrpc/cometrpc.go
+
+// Package cometrpc calls the JSON-RPC endpoints CometBFT nodes serve over
+// HTTP GET, such as /status, /block and /tx_search.
+package cometrpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Error is an error returned by a CometBFT RPC endpoint.
+type Error struct {
+	Message string `json:"message"`
+	Data    string `json:"data"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Message, e.Data)
+}
+
+// Get calls the endpoint at path on the node serving RPC at base, such as
+// "/block?height=5" on "http://127.0.0.1:26657", and decodes its result
+// into out. Errors the endpoint returns are *Error.
+func Get(ctx context.Context, client *http.Client, base, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(base, "/")+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+	var rpcResp struct {
+		Result json.RawMessage `json:"result"`
+		Error  *Error          `json:"error"`
+	}
+	if err := json.Unmarshal(body, &rpcResp); err != nil {
+		return fmt.Errorf("HTTP %d: failed to decode response: %w", resp.StatusCode, err)
+	}
+	if rpcResp.Error != nil {
+		return rpcResp.Error
+	}
+	if err := json.Unmarshal(rpcResp.Result, out); err != nil {
+		return fmt.Errorf("failed to decode result: %w", err)
+	}
+	return nil
+}
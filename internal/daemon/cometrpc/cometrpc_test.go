@@ -0,0 +1,47 @@
+// internal/daemon/cometrpc/cometrpc_test.go
+package cometrpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/status":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":-1,"result":{"node_info":{"network":"devnet-1"}}}`))
+		case "/block":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"Internal error","data":"height 9 must be less than or equal to the current blockchain height 5"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("404 page not found"))
+		}
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+
+	var status struct {
+		NodeInfo struct {
+			Network string `json:"network"`
+		} `json:"node_info"`
+	}
+	require.NoError(t, Get(ctx, srv.Client(), srv.URL+"/", "/status", &status))
+	assert.Equal(t, "devnet-1", status.NodeInfo.Network)
+
+	err := Get(ctx, srv.Client(), srv.URL, "/block?height=9", &struct{}{})
+	var rpcErr *Error
+	require.ErrorAs(t, err, &rpcErr)
+	assert.Equal(t, "Internal error", rpcErr.Message)
+	assert.Contains(t, rpcErr.Data, "must be less than or equal to")
+
+	err = Get(ctx, srv.Client(), srv.URL, "/missing", &struct{}{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "HTTP 404")
+}
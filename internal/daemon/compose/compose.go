@@ -0,0 +1,153 @@
+// internal/daemon/compose/compose.go
+
+// Package compose renders the containers of a docker-mode devnet as a
+// standalone Docker Compose file, so the same topology can run with only
+// docker compose: one service per node with its image, command,
+// environment, home directory mount, published ports and restart policy,
+// all attached to one network under the DNS names the nodes' persistent
+// peers dial.
+package compose
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+)
+
+// NetworkName is the compose network every node service is attached to.
+const NetworkName = "devnet"
+
+// Options configures Render.
+type Options struct {
+	// Devnet is the name of the exported devnet; the compose project is
+	// named dvb-<devnet>.
+	Devnet string
+
+	// HomeRoot, if set, replaces the host directory of each node home mount
+	// with <HomeRoot>/<service>, for hosts the node homes are copied to.
+	// Otherwise the homes are mounted from their paths on the daemon host.
+	HomeRoot string
+}
+
+// File is a Docker Compose file.
+type File struct {
+	Name     string             `yaml:"name"`
+	Services map[string]Service `yaml:"services"`
+	Networks map[string]Network `yaml:"networks"`
+}
+
+// Service is a compose service running one node.
+type Service struct {
+	Image       string                    `yaml:"image"`
+	Command     []string                  `yaml:"command,omitempty"`
+	Environment map[string]string         `yaml:"environment,omitempty"`
+	Volumes     []string                  `yaml:"volumes,omitempty"`
+	Ports       []string                  `yaml:"ports,omitempty"`
+	Labels      map[string]string         `yaml:"labels,omitempty"`
+	Restart     string                    `yaml:"restart,omitempty"`
+	Tty         bool                      `yaml:"tty,omitempty"`
+	Networks    map[string]ServiceNetwork `yaml:"networks"`
+}
+
+// ServiceNetwork attaches a service to a network.
+type ServiceNetwork struct {
+	Aliases []string `yaml:"aliases,omitempty"`
+}
+
+// Network is a compose network.
+type Network struct {
+	Driver string `yaml:"driver"`
+}
+
+// ServiceName returns the compose service name of a node container:
+// <role>-<index>.
+func ServiceName(c runtime.ContainerSpec) string {
+	return fmt.Sprintf("%s-%d", c.Role, c.Index)
+}
+
+// Build builds the compose file of a devnet's containers.
+func Build(containers []runtime.ContainerSpec, opts Options) (*File, error) {
+	if len(containers) == 0 {
+		return nil, fmt.Errorf("devnet has no containers")
+	}
+
+	f := &File{
+		Name:     "dvb-" + opts.Devnet,
+		Services: make(map[string]Service, len(containers)),
+		Networks: map[string]Network{NetworkName: {Driver: "bridge"}},
+	}
+	for _, c := range containers {
+		name := ServiceName(c)
+		if _, ok := f.Services[name]; ok {
+			return nil, fmt.Errorf("duplicate service %q", name)
+		}
+
+		svc := Service{
+			Image:    c.Image,
+			Command:  c.Command,
+			Labels:   c.Labels,
+			Restart:  c.RestartPolicy,
+			Tty:      true,
+			Networks: map[string]ServiceNetwork{NetworkName: {Aliases: c.Aliases}},
+		}
+		if len(c.Env) > 0 {
+			svc.Environment = make(map[string]string, len(c.Env))
+			for _, kv := range c.Env {
+				k, v, _ := strings.Cut(kv, "=")
+				svc.Environment[k] = v
+			}
+		}
+		for _, m := range c.Mounts {
+			source := m.Source
+			if opts.HomeRoot != "" {
+				source = homeSource(opts.HomeRoot, name)
+			}
+			svc.Volumes = append(svc.Volumes, source+":"+m.Target)
+		}
+		for _, p := range c.Ports {
+			port := fmt.Sprintf("%d:%d", p.HostPort, p.ContainerPort)
+			if p.HostIP != "" {
+				port = p.HostIP + ":" + port
+			}
+			svc.Ports = append(svc.Ports, port)
+		}
+		f.Services[name] = svc
+	}
+	return f, nil
+}
+
+// Render renders a devnet's containers as compose YAML.
+func Render(containers []runtime.ContainerSpec, opts Options) ([]byte, error) {
+	f, err := Build(containers, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# Generated by 'dvb export compose' from devnet %s.\n", opts.Devnet)
+	fmt.Fprintf(&buf, "# Run with: docker compose -f <this file> up -d\n")
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(f); err != nil {
+		return nil, fmt.Errorf("failed to encode compose file: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode compose file: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// homeSource returns the bind mount source of a service's home under root.
+// Relative sources start with ./, without which compose takes them for
+// named volumes.
+func homeSource(root, service string) string {
+	source := strings.TrimSuffix(root, "/") + "/" + service
+	if !strings.HasPrefix(source, "/") && !strings.HasPrefix(source, ".") && !strings.HasPrefix(source, "~") {
+		source = "./" + source
+	}
+	return source
+}
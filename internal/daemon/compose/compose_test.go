@@ -0,0 +1,88 @@
+package compose
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+)
+
+func testContainers() []runtime.ContainerSpec {
+	return []runtime.ContainerSpec{
+		{
+			Name:    "dvb-mydevnet-validator-0",
+			Role:    "validator",
+			Index:   0,
+			Image:   "stablelabs/stabled:v1.2.0",
+			Command: []string{"start", "--home", "/root/.stabled"},
+			Env:     []string{"LOG_LEVEL=debug", "EMPTY="},
+			Mounts:  []runtime.BindMount{{Source: "/var/lib/devnetd/devnets/mydevnet/node0", Target: "/root/.stabled"}},
+			Ports: []runtime.PortBinding{
+				{HostPort: 26656, ContainerPort: 26656},
+				{HostIP: "127.0.0.1", HostPort: 26657, ContainerPort: 26657},
+			},
+			Labels:        map[string]string{"dvb.devnet": "mydevnet"},
+			RestartPolicy: "on-failure",
+			Aliases:       []string{"val0.mydevnet"},
+		},
+		{
+			Name:  "dvb-mydevnet-fullnode-1",
+			Role:  "fullnode",
+			Index: 1,
+			Image: "stablelabs/stabled:v1.2.0",
+		},
+	}
+}
+
+func TestRender(t *testing.T) {
+	out, err := Render(testContainers(), Options{Devnet: "mydevnet"})
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "# Generated by 'dvb export compose' from devnet mydevnet.")
+
+	var f File
+	require.NoError(t, yaml.Unmarshal(out, &f))
+	assert.Equal(t, "dvb-mydevnet", f.Name)
+	assert.Equal(t, map[string]Network{NetworkName: {Driver: "bridge"}}, f.Networks)
+	require.Len(t, f.Services, 2)
+
+	v := f.Services["validator-0"]
+	assert.Equal(t, "stablelabs/stabled:v1.2.0", v.Image)
+	assert.Equal(t, []string{"start", "--home", "/root/.stabled"}, v.Command)
+	assert.Equal(t, map[string]string{"LOG_LEVEL": "debug", "EMPTY": ""}, v.Environment)
+	assert.Equal(t, []string{"/var/lib/devnetd/devnets/mydevnet/node0:/root/.stabled"}, v.Volumes)
+	assert.Equal(t, []string{"26656:26656", "127.0.0.1:26657:26657"}, v.Ports)
+	assert.Equal(t, "on-failure", v.Restart)
+	assert.True(t, v.Tty)
+	assert.Equal(t, map[string]ServiceNetwork{NetworkName: {Aliases: []string{"val0.mydevnet"}}}, v.Networks)
+
+	full := f.Services["fullnode-1"]
+	assert.Empty(t, full.Volumes)
+	assert.Equal(t, map[string]ServiceNetwork{NetworkName: {}}, full.Networks)
+}
+
+func TestBuild_HomeRoot(t *testing.T) {
+	f, err := Build(testContainers(), Options{Devnet: "mydevnet", HomeRoot: "./homes"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"./homes/validator-0:/root/.stabled"}, f.Services["validator-0"].Volumes)
+
+	for root, want := range map[string]string{
+		"homes":    "./homes/validator-0",
+		"../homes": "../homes/validator-0",
+		"/srv/":    "/srv/validator-0",
+	} {
+		assert.Equal(t, want, homeSource(root, "validator-0"), root)
+	}
+}
+
+func TestBuild_Errors(t *testing.T) {
+	_, err := Build(nil, Options{Devnet: "mydevnet"})
+	assert.ErrorContains(t, err, "no containers")
+
+	containers := testContainers()
+	containers[1].Role, containers[1].Index = "validator", 0
+	_, err = Build(containers, Options{Devnet: "mydevnet"})
+	assert.ErrorContains(t, err, `duplicate service "validator-0"`)
+}
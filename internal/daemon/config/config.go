@@ -5,6 +5,12 @@ import (
 	"os"
 	"path/filepath"
 	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/notify"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/registries"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/retry"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/transport"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
 )
 
 // Config is the single source of truth for devnetd configuration.
@@ -17,6 +23,13 @@ type Config struct {
 	Timeouts TimeoutConfig  `toml:"timeouts"`
 	Snapshot SnapshotConfig `toml:"snapshot"`
 	Network  NetworkConfig  `toml:"network"`
+	API      APIConfig      `toml:"api"`
+	Retry    RetryConfig    `toml:"retry"`
+
+	Notifications NotificationsConfig `toml:"notifications"`
+	Pruning       PruningConfig       `toml:"pruning"`
+	NodeLogs      NodeLogsConfig      `toml:"node_logs"`
+	Proxy         ProxyConfig         `toml:"proxy"`
 }
 
 // ServerConfig holds core server settings.
@@ -30,6 +43,23 @@ type ServerConfig struct {
 	// RuntimeMode selects the node process runtime: "process" (default), "service", "docker".
 	RuntimeMode string `toml:"runtime_mode"`
 
+	// Offline forces provisioning to use only local caches (binaries,
+	// snapshots, genesis files, docker images). Useful for air-gapped CI.
+	Offline bool `toml:"offline"`
+
+	// StrictPluginResponses makes a populated Error field in a plugin RPC
+	// response fail the call instead of relying on the caller to check it.
+	StrictPluginResponses bool `toml:"strict_plugin_responses"`
+
+	// EventRetention is how many events the daemon keeps per devnet for
+	// 'dvb events'; older events are dropped as new ones are recorded.
+	EventRetention int `toml:"event_retention"`
+
+	// StopNodesOnShutdown stops running devnets' nodes, full nodes first,
+	// when the daemon shuts down, instead of leaving them running for the
+	// next daemon to reconnect to.
+	StopNodesOnShutdown bool `toml:"stop_nodes_on_shutdown"`
+
 	// Remote listener settings (optional - enables remote access)
 	Listen  string `toml:"listen"`   // TCP address (e.g., "0.0.0.0:9000"), empty = local only
 	TLSCert string `toml:"tls_cert"` // Path to TLS certificate file
@@ -42,10 +72,59 @@ type AuthConfig struct {
 	KeysFile string `toml:"keys_file"` // Path to API keys file
 }
 
+// APIConfig holds settings for external API access to devnetd.
+type APIConfig struct {
+	// Reflection enables gRPC server reflection, so tools like grpcurl can
+	// discover the devnetd services.
+	Reflection bool `toml:"reflection"`
+
+	// GatewayListen is the HTTP address of the REST/JSON gateway
+	// (e.g., "127.0.0.1:8080"), empty = disabled.
+	GatewayListen string `toml:"gateway_listen"`
+}
+
 // DockerConfig holds Docker runtime settings.
 type DockerConfig struct {
 	Enabled bool   `toml:"enabled"`
 	Image   string `toml:"image"`
+
+	// Mirrors rewrite image references to a registry mirror before they
+	// are pulled, e.g. ghcr.io/org/chain:v1 to mirror.corp/ghcr/org/chain:v1.
+	Mirrors []RegistryMirrorConfig `toml:"mirrors"`
+
+	// Registries hold the credentials of private registries and mirrors.
+	Registries []RegistryConfig `toml:"registries"`
+}
+
+// RegistryMirrorConfig holds a registry mirror.
+type RegistryMirrorConfig struct {
+	From string `toml:"from"` // Registry or repository prefix, e.g. "ghcr.io"
+	To   string `toml:"to"`   // Prefix it is replaced with, e.g. "mirror.corp/ghcr"
+}
+
+// RegistryConfig holds the credentials of a registry.
+type RegistryConfig struct {
+	Host     string `toml:"host"`
+	Username string `toml:"username"`
+	Password string `toml:"password"`
+}
+
+// MirrorList returns the mirrors as registries.Mirror.
+func (c DockerConfig) MirrorList() []registries.Mirror {
+	mirrors := make([]registries.Mirror, 0, len(c.Mirrors))
+	for _, m := range c.Mirrors {
+		mirrors = append(mirrors, registries.Mirror{From: m.From, To: m.To})
+	}
+	return mirrors
+}
+
+// CredentialList returns the registries as registries.Credentials.
+func (c DockerConfig) CredentialList() []registries.Credentials {
+	creds := make([]registries.Credentials, 0, len(c.Registries))
+	for _, r := range c.Registries {
+		creds = append(creds, registries.Credentials{Host: r.Host, Username: r.Username, Password: r.Password})
+	}
+	return creds
 }
 
 // GitHubConfig holds GitHub API settings.
@@ -65,6 +144,19 @@ type SnapshotConfig struct {
 	CacheTTL   time.Duration `toml:"cache_ttl"`
 	MaxRetries int           `toml:"max_retries"`
 	RetryDelay time.Duration `toml:"retry_delay"`
+
+	// ServeListen is the HTTP address the snapshot cache is shared on
+	// (e.g., "0.0.0.0:8090"), empty = disabled.
+	ServeListen string `toml:"serve_listen"`
+
+	// Credentials for s3:// and gs:// snapshot URLs. Each is exported as
+	// the matching AWS_* or GOOGLE_OAUTH_ACCESS_TOKEN environment variable
+	// unless that variable is already set.
+	S3Region          string `toml:"s3_region"`
+	S3Endpoint        string `toml:"s3_endpoint"`
+	S3AccessKeyID     string `toml:"s3_access_key_id"`
+	S3SecretAccessKey string `toml:"s3_secret_access_key"`
+	GCSAccessToken    string `toml:"gcs_access_token"`
 }
 
 // NetworkConfig holds network port settings.
@@ -74,6 +166,138 @@ type NetworkConfig struct {
 	BaseP2PPort  int `toml:"base_p2p_port"`
 	BaseRESTPort int `toml:"base_rest_port"`
 	BaseGRPCPort int `toml:"base_grpc_port"`
+
+	// PortConflict is what happens when a docker runtime node's host ports
+	// are already in use: "reallocate" moves it to the next free port block,
+	// "fail" fails the node with the process that owns the port.
+	PortConflict string `toml:"port_conflict"`
+}
+
+// RetryConfig holds the retry policies of the provisioning steps.
+type RetryConfig struct {
+	Build     RetryPolicyConfig `toml:"build"`
+	Snapshot  RetryPolicyConfig `toml:"snapshot"`
+	NodeStart RetryPolicyConfig `toml:"node_start"`
+}
+
+// RetryPolicyConfig holds the retry policy of a provisioning step.
+type RetryPolicyConfig struct {
+	MaxAttempts    int           `toml:"max_attempts"`
+	InitialBackoff time.Duration `toml:"initial_backoff"`
+	MaxBackoff     time.Duration `toml:"max_backoff"`
+	Multiplier     float64       `toml:"multiplier"`
+}
+
+// Policy returns the policy as a retry.Policy.
+func (c RetryPolicyConfig) Policy() retry.Policy {
+	return retry.Policy{
+		MaxAttempts:    c.MaxAttempts,
+		InitialBackoff: c.InitialBackoff,
+		MaxBackoff:     c.MaxBackoff,
+		Multiplier:     c.Multiplier,
+	}
+}
+
+// Policies returns the policies as retry.Policies.
+func (c RetryConfig) Policies() retry.Policies {
+	return retry.Policies{
+		Build:     c.Build.Policy(),
+		Snapshot:  c.Snapshot.Policy(),
+		NodeStart: c.NodeStart.Policy(),
+	}
+}
+
+// retryPolicyConfig converts a retry.Policy to its config.
+func retryPolicyConfig(p retry.Policy) RetryPolicyConfig {
+	return RetryPolicyConfig{
+		MaxAttempts:    p.MaxAttempts,
+		InitialBackoff: p.InitialBackoff,
+		MaxBackoff:     p.MaxBackoff,
+		Multiplier:     p.Multiplier,
+	}
+}
+
+// PruningConfig holds the scheduled pruning of long-lived devnets' nodes.
+type PruningConfig struct {
+	// Interval is how often the nodes of each running devnet are pruned,
+	// one at a time; zero disables scheduled pruning.
+	Interval time.Duration `toml:"interval"`
+
+	// MinAge is how long a devnet runs before it is pruned on schedule.
+	MinAge time.Duration `toml:"min_age"`
+
+	// Strategy is passed to the chain binary's prune command.
+	Strategy string `toml:"strategy"`
+}
+
+// NodeLogsConfig holds the rotation of the log files of process and
+// service runtime nodes.
+type NodeLogsConfig struct {
+	// MaxSizeMB is the size in megabytes a log file is rotated at.
+	MaxSizeMB int `toml:"max_size_mb"`
+
+	// MaxAge rotates a log file when it is written to in a later period,
+	// e.g. every UTC day for 24h; zero rotates on size only.
+	MaxAge time.Duration `toml:"max_age"`
+
+	// MaxFiles is how many rotated log files are kept per node.
+	MaxFiles int `toml:"max_files"`
+
+	// Retention removes rotated log files last written longer ago; zero
+	// keeps max_files of them.
+	Retention time.Duration `toml:"retention"`
+
+	// Compress gzips rotated log files.
+	Compress bool `toml:"compress"`
+}
+
+// ProxyConfig holds the RPC proxy that serves every node's RPC, REST, EVM
+// JSON-RPC and gRPC endpoints behind one address.
+type ProxyConfig struct {
+	// Listen is the HTTP address of the proxy (e.g., "127.0.0.1:8080"),
+	// empty = disabled. Like the gateway, the proxy serves HTTPS with the
+	// server's tls_cert and tls_key, which are required unless it only
+	// listens on loopback.
+	Listen string `toml:"listen"`
+
+	// Username and Password are required as HTTP basic auth; they must be
+	// set unless the proxy only listens on loopback.
+	Username string `toml:"username"`
+	Password string `toml:"password"`
+
+	// LogRequests logs every proxied request.
+	LogRequests bool `toml:"log_requests"`
+}
+
+// NotificationsConfig holds the webhooks notified of devnet events.
+type NotificationsConfig struct {
+	// TTLWarning is how long before a devnet's TTL expires the
+	// devnet.ttl_expiring event is sent; zero disables it.
+	TTLWarning time.Duration `toml:"ttl_warning"`
+
+	// Retry is the retry policy of failed deliveries.
+	Retry RetryPolicyConfig `toml:"retry"`
+
+	// Webhooks receive the events of every devnet. Devnets can add their
+	// own in their spec.
+	Webhooks []WebhookConfig `toml:"webhooks"`
+}
+
+// WebhookConfig holds a webhook notified of devnet events.
+type WebhookConfig struct {
+	URL      string   `toml:"url"`
+	Format   string   `toml:"format"`   // "slack", "discord" or "generic" (default)
+	Events   []string `toml:"events"`   // Event types or patterns (e.g. "node.*"), empty = all
+	Template string   `toml:"template"` // Go text/template for the message text
+}
+
+// WebhookList returns the webhooks as types.Webhook.
+func (c NotificationsConfig) WebhookList() []types.Webhook {
+	webhooks := make([]types.Webhook, 0, len(c.Webhooks))
+	for _, w := range c.Webhooks {
+		webhooks = append(webhooks, types.Webhook{URL: w.URL, Format: w.Format, Events: w.Events, Template: w.Template})
+	}
+	return webhooks
 }
 
 // DefaultDataDir returns the default data directory path.
@@ -85,14 +309,17 @@ func DefaultDataDir() string {
 // DefaultConfig returns configuration with sensible defaults.
 func DefaultConfig() *Config {
 	dataDir := DefaultDataDir()
+	policies := retry.DefaultPolicies()
 	return &Config{
 		Server: ServerConfig{
-			Socket:      filepath.Join(dataDir, "devnetd.sock"),
+			Socket:      transport.DefaultAddress(dataDir),
 			DataDir:     dataDir,
 			LogLevel:    "info",
 			Workers:     2,
 			Foreground:  true,
 			RuntimeMode: "process",
+
+			EventRetention: 1000,
 		},
 		Auth: AuthConfig{
 			Enabled:  true, // Auth enabled by default when Listen is set
@@ -121,6 +348,27 @@ func DefaultConfig() *Config {
 			BaseP2PPort:  26656,
 			BaseRESTPort: 1317,
 			BaseGRPCPort: 9090,
+			PortConflict: "reallocate",
+		},
+		Retry: RetryConfig{
+			Build:     retryPolicyConfig(policies.Build),
+			Snapshot:  retryPolicyConfig(policies.Snapshot),
+			NodeStart: retryPolicyConfig(policies.NodeStart),
+		},
+		Notifications: NotificationsConfig{
+			TTLWarning: notify.DefaultTTLWarning,
+			Retry:      retryPolicyConfig(notify.DefaultPolicy),
+		},
+		Pruning: PruningConfig{
+			MinAge:   24 * time.Hour,
+			Strategy: "everything",
+		},
+		NodeLogs: NodeLogsConfig{
+			MaxSizeMB: 100,
+			MaxAge:    24 * time.Hour,
+			MaxFiles:  5,
+			Retention: 7 * 24 * time.Hour,
+			Compress:  true,
 		},
 	}
 }
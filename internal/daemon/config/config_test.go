@@ -140,6 +140,354 @@ token = "file_token"
 	}
 }
 
+func TestLoaderOffline(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "devnetd.toml")
+	if err := os.WriteFile(configPath, []byte("[server]\noffline = true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if !cfg.Server.Offline {
+		t.Error("expected offline true from file")
+	}
+
+	// Env should override file
+	t.Setenv("DEVNETD_OFFLINE", "false")
+	cfg, err = NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.Server.Offline {
+		t.Error("expected offline false from env")
+	}
+}
+
+func TestLoaderStrictPluginResponses(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "devnetd.toml")
+	if err := os.WriteFile(configPath, []byte("[server]\nstrict_plugin_responses = true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if !cfg.Server.StrictPluginResponses {
+		t.Error("expected strict_plugin_responses true from file")
+	}
+
+	// Env should override file
+	t.Setenv("DEVNETD_STRICT_PLUGIN_RESPONSES", "false")
+	cfg, err = NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.Server.StrictPluginResponses {
+		t.Error("expected strict_plugin_responses false from env")
+	}
+}
+
+func TestLoaderStopNodesOnShutdown(t *testing.T) {
+	tmpDir := t.TempDir()
+	if cfg, err := NewLoader(tmpDir, "").Load(); err != nil || cfg.Server.StopNodesOnShutdown {
+		t.Fatalf("expected stop_nodes_on_shutdown false by default, got %v (err %v)", cfg.Server.StopNodesOnShutdown, err)
+	}
+
+	configPath := filepath.Join(tmpDir, "devnetd.toml")
+	if err := os.WriteFile(configPath, []byte("[server]\nstop_nodes_on_shutdown = true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if !cfg.Server.StopNodesOnShutdown {
+		t.Error("expected stop_nodes_on_shutdown true from file")
+	}
+
+	// Env should override file
+	t.Setenv("DEVNETD_STOP_NODES_ON_SHUTDOWN", "false")
+	cfg, err = NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.Server.StopNodesOnShutdown {
+		t.Error("expected stop_nodes_on_shutdown false from env")
+	}
+}
+
+func TestLoaderAPI(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "devnetd.toml")
+	content := "[api]\nreflection = true\ngateway_listen = \"127.0.0.1:8080\"\n"
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if !cfg.API.Reflection {
+		t.Error("expected reflection true from file")
+	}
+	if cfg.API.GatewayListen != "127.0.0.1:8080" {
+		t.Errorf("expected gateway_listen from file, got %q", cfg.API.GatewayListen)
+	}
+
+	// Env should override file
+	t.Setenv("DEVNETD_API_GATEWAY_LISTEN", "127.0.0.1:9080")
+	cfg, err = NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.API.GatewayListen != "127.0.0.1:9080" {
+		t.Errorf("expected gateway_listen from env, got %q", cfg.API.GatewayListen)
+	}
+}
+
+func TestLoaderSnapshotServeListen(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "devnetd.toml")
+	content := "[snapshot]\nserve_listen = \"0.0.0.0:8090\"\n"
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.Snapshot.ServeListen != "0.0.0.0:8090" {
+		t.Errorf("expected serve_listen from file, got %q", cfg.Snapshot.ServeListen)
+	}
+
+	// Env should override file
+	t.Setenv("DEVNETD_SNAPSHOT_SERVE_LISTEN", "127.0.0.1:8091")
+	cfg, err = NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.Snapshot.ServeListen != "127.0.0.1:8091" {
+		t.Errorf("expected serve_listen from env, got %q", cfg.Snapshot.ServeListen)
+	}
+}
+
+func TestLoaderRetryPolicies(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "devnetd.toml")
+	content := "[retry.snapshot]\nmax_attempts = 5\ninitial_backoff = \"1m\"\nmax_backoff = \"10m\"\n\n[retry.node_start]\nmultiplier = 1.5\n"
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	snapshot := cfg.Retry.Snapshot
+	if snapshot.MaxAttempts != 5 || snapshot.InitialBackoff != time.Minute || snapshot.MaxBackoff != 10*time.Minute {
+		t.Errorf("unexpected snapshot policy from file: %+v", snapshot)
+	}
+	if snapshot.Multiplier != DefaultConfig().Retry.Snapshot.Multiplier {
+		t.Errorf("expected default snapshot multiplier, got %v", snapshot.Multiplier)
+	}
+	if cfg.Retry.NodeStart.Multiplier != 1.5 {
+		t.Errorf("expected node_start multiplier from file, got %v", cfg.Retry.NodeStart.Multiplier)
+	}
+	if cfg.Retry.Build != DefaultConfig().Retry.Build {
+		t.Errorf("expected default build policy, got %+v", cfg.Retry.Build)
+	}
+}
+
+func TestLoaderNotifications(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "devnetd.toml")
+	content := `[notifications]
+ttl_warning = "1h"
+
+[notifications.retry]
+max_attempts = 2
+
+[[notifications.webhooks]]
+url = "https://hooks.slack.com/services/x"
+format = "slack"
+events = ["devnet.*"]
+
+[[notifications.webhooks]]
+url = "http://localhost:9000/hook"
+template = "{{.Message}}"
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.Notifications.TTLWarning != time.Hour {
+		t.Errorf("expected ttl_warning from file, got %s", cfg.Notifications.TTLWarning)
+	}
+	if retry := cfg.Notifications.Retry; retry.MaxAttempts != 2 || retry.InitialBackoff != DefaultConfig().Notifications.Retry.InitialBackoff {
+		t.Errorf("unexpected notifications retry policy: %+v", retry)
+	}
+	webhooks := cfg.Notifications.WebhookList()
+	if len(webhooks) != 2 || webhooks[0].Format != "slack" || webhooks[0].Events[0] != "devnet.*" || webhooks[1].Template != "{{.Message}}" {
+		t.Errorf("unexpected webhooks: %+v", webhooks)
+	}
+	if err := Validate(cfg); err != nil {
+		t.Errorf("Validate() error: %v", err)
+	}
+}
+
+func TestLoaderDockerRegistries(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "devnetd.toml")
+	content := `[docker]
+image = "ghcr.io/org/chain:v1"
+
+[[docker.mirrors]]
+from = "ghcr.io"
+to = "mirror.corp/ghcr"
+
+[[docker.mirrors]]
+from = "docker.io"
+to = "mirror.corp/hub"
+
+[[docker.registries]]
+host = "mirror.corp"
+username = "ci"
+password = "secret"
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	mirrors := cfg.Docker.MirrorList()
+	if len(mirrors) != 2 || mirrors[0].From != "ghcr.io" || mirrors[1].To != "mirror.corp/hub" {
+		t.Errorf("unexpected mirrors: %+v", mirrors)
+	}
+	creds := cfg.Docker.CredentialList()
+	if len(creds) != 1 || creds[0].Host != "mirror.corp" || creds[0].Password != "secret" {
+		t.Errorf("unexpected registries: %+v", creds)
+	}
+	if err := Validate(cfg); err != nil {
+		t.Errorf("Validate() error: %v", err)
+	}
+}
+
+func TestLoaderPruning(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "devnetd.toml")
+	content := `[pruning]
+interval = "12h"
+strategy = "default"
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.Pruning.Interval != 12*time.Hour || cfg.Pruning.Strategy != "default" {
+		t.Errorf("unexpected pruning from file: %+v", cfg.Pruning)
+	}
+	if cfg.Pruning.MinAge != DefaultConfig().Pruning.MinAge {
+		t.Errorf("expected default min_age, got %s", cfg.Pruning.MinAge)
+	}
+}
+
+func TestLoaderNodeLogs(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "devnetd.toml")
+	content := `[node_logs]
+max_size_mb = 20
+max_age = "1h"
+compress = false
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.NodeLogs.MaxSizeMB != 20 || cfg.NodeLogs.MaxAge != time.Hour || cfg.NodeLogs.Compress {
+		t.Errorf("unexpected node_logs from file: %+v", cfg.NodeLogs)
+	}
+	if cfg.NodeLogs.MaxFiles != DefaultConfig().NodeLogs.MaxFiles {
+		t.Errorf("expected default max_files, got %d", cfg.NodeLogs.MaxFiles)
+	}
+}
+
+func TestLoaderProxy(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "devnetd.toml")
+	content := `[proxy]
+listen = "0.0.0.0:8080"
+username = "ci"
+password = "from-file"
+log_requests = true
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(EnvProxyPassword, "from-env")
+
+	cfg, err := NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.Proxy.Listen != "0.0.0.0:8080" || cfg.Proxy.Username != "ci" || !cfg.Proxy.LogRequests {
+		t.Errorf("unexpected proxy from file: %+v", cfg.Proxy)
+	}
+	if cfg.Proxy.Password != "from-env" {
+		t.Errorf("expected password from %s, got %q", EnvProxyPassword, cfg.Proxy.Password)
+	}
+}
+
+func TestLoaderSnapshotStorageCredentials(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "devnetd.toml")
+	content := `[snapshot]
+s3_region = "eu-west-1"
+s3_endpoint = "http://minio:9000"
+s3_access_key_id = "AKIDEXAMPLE"
+s3_secret_access_key = "secret"
+gcs_access_token = "ya29.token"
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := NewLoader(tmpDir, configPath).Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.Snapshot.S3Region != "eu-west-1" || cfg.Snapshot.S3Endpoint != "http://minio:9000" {
+		t.Errorf("unexpected S3 location: region=%q endpoint=%q", cfg.Snapshot.S3Region, cfg.Snapshot.S3Endpoint)
+	}
+	if cfg.Snapshot.S3AccessKeyID != "AKIDEXAMPLE" || cfg.Snapshot.S3SecretAccessKey != "secret" {
+		t.Errorf("unexpected S3 credentials: %q/%q", cfg.Snapshot.S3AccessKeyID, cfg.Snapshot.S3SecretAccessKey)
+	}
+	if cfg.Snapshot.GCSAccessToken != "ya29.token" {
+		t.Errorf("expected gcs_access_token from file, got %q", cfg.Snapshot.GCSAccessToken)
+	}
+}
+
 func TestValidateConfig(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -165,6 +513,13 @@ func TestValidateConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid event retention",
+			modify: func(c *Config) {
+				c.Server.EventRetention = 0
+			},
+			wantErr: true,
+		},
 		{
 			name: "negative port offset",
 			modify: func(c *Config) {
@@ -172,6 +527,143 @@ func TestValidateConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid port conflict policy",
+			modify: func(c *Config) {
+				c.Network.PortConflict = "ignore"
+			},
+			wantErr: true,
+		},
+		{
+			name: "loopback gateway without TLS",
+			modify: func(c *Config) {
+				c.API.GatewayListen = "127.0.0.1:8080"
+			},
+			wantErr: false,
+		},
+		{
+			name: "remote gateway without TLS",
+			modify: func(c *Config) {
+				c.API.GatewayListen = "0.0.0.0:8080"
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid gateway address",
+			modify: func(c *Config) {
+				c.API.GatewayListen = "8080"
+			},
+			wantErr: true,
+		},
+		{
+			name: "snapshot sharing on all interfaces",
+			modify: func(c *Config) {
+				c.Snapshot.ServeListen = "0.0.0.0:8090"
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid snapshot serve address",
+			modify: func(c *Config) {
+				c.Snapshot.ServeListen = "8090"
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid s3 endpoint",
+			modify: func(c *Config) {
+				c.Snapshot.S3Endpoint = "minio:9000"
+			},
+			wantErr: true,
+		},
+		{
+			name: "s3 access key without secret",
+			modify: func(c *Config) {
+				c.Snapshot.S3AccessKeyID = "AKIDEXAMPLE"
+			},
+			wantErr: true,
+		},
+		{
+			name: "retry without attempts",
+			modify: func(c *Config) {
+				c.Retry.Build.MaxAttempts = 0
+			},
+			wantErr: true,
+		},
+		{
+			name: "retry max backoff below initial backoff",
+			modify: func(c *Config) {
+				c.Retry.NodeStart.MaxBackoff = time.Second
+				c.Retry.NodeStart.InitialBackoff = time.Minute
+			},
+			wantErr: true,
+		},
+		{
+			name: "webhook with unknown event",
+			modify: func(c *Config) {
+				c.Notifications.Webhooks = []WebhookConfig{{URL: "https://example.com", Events: []string{"node.exploded"}}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "registry mirror with url scheme",
+			modify: func(c *Config) {
+				c.Docker.Mirrors = []RegistryMirrorConfig{{From: "https://ghcr.io", To: "mirror.corp/ghcr"}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "pruning without strategy",
+			modify: func(c *Config) {
+				c.Pruning.Interval = time.Hour
+				c.Pruning.Strategy = ""
+			},
+			wantErr: true,
+		},
+		{
+			name: "node log size zero",
+			modify: func(c *Config) {
+				c.NodeLogs.MaxSizeMB = 0
+			},
+			wantErr: true,
+		},
+		{
+			name: "proxy on loopback without auth",
+			modify: func(c *Config) {
+				c.Proxy.Listen = "127.0.0.1:8080"
+			},
+			wantErr: false,
+		},
+		{
+			name: "proxy on all interfaces without auth",
+			modify: func(c *Config) {
+				c.Proxy.Listen = ":8080"
+			},
+			wantErr: true,
+		},
+		{
+			name: "proxy on all interfaces with auth but without TLS",
+			modify: func(c *Config) {
+				c.Proxy.Listen = ":8080"
+				c.Proxy.Username = "ci"
+				c.Proxy.Password = "s3cret"
+			},
+			wantErr: true,
+		},
+		{
+			name: "proxy password without username",
+			modify: func(c *Config) {
+				c.Proxy.Password = "s3cret"
+			},
+			wantErr: true,
+		},
+		{
+			name: "registry without password",
+			modify: func(c *Config) {
+				c.Docker.Registries = []RegistryConfig{{Host: "mirror.corp", Username: "ci"}}
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
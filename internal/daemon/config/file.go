@@ -11,6 +11,13 @@ type FileConfig struct {
 	Timeouts FileTimeoutConfig  `toml:"timeouts"`
 	Snapshot FileSnapshotConfig `toml:"snapshot"`
 	Network  FileNetworkConfig  `toml:"network"`
+	API      FileAPIConfig      `toml:"api"`
+	Retry    FileRetryConfig    `toml:"retry"`
+
+	Notifications FileNotificationsConfig `toml:"notifications"`
+	Pruning       FilePruningConfig       `toml:"pruning"`
+	NodeLogs      FileNodeLogsConfig      `toml:"node_logs"`
+	Proxy         FileProxyConfig         `toml:"proxy"`
 }
 
 // FileServerConfig is the TOML representation of ServerConfig.
@@ -22,6 +29,11 @@ type FileServerConfig struct {
 	Foreground *bool   `toml:"foreground"`
 
 	RuntimeMode *string `toml:"runtime_mode"`
+	Offline     *bool   `toml:"offline"`
+
+	StrictPluginResponses *bool `toml:"strict_plugin_responses"`
+	EventRetention        *int  `toml:"event_retention"`
+	StopNodesOnShutdown   *bool `toml:"stop_nodes_on_shutdown"`
 
 	// Remote listener settings
 	Listen  *string `toml:"listen"`
@@ -39,6 +51,10 @@ type FileAuthConfig struct {
 type FileDockerConfig struct {
 	Enabled *bool   `toml:"enabled"`
 	Image   *string `toml:"image"`
+
+	// Mirrors and Registries replace the default (empty) lists when set.
+	Mirrors    []RegistryMirrorConfig `toml:"mirrors"`
+	Registries []RegistryConfig       `toml:"registries"`
 }
 
 // FileGitHubConfig is the TOML representation of GitHubConfig.
@@ -57,18 +73,89 @@ type FileTimeoutConfig struct {
 // FileSnapshotConfig is the TOML representation of SnapshotConfig.
 // Uses strings for duration values since TOML cannot decode directly to time.Duration.
 type FileSnapshotConfig struct {
-	CacheTTL   *string `toml:"cache_ttl"`
-	MaxRetries *int    `toml:"max_retries"`
-	RetryDelay *string `toml:"retry_delay"`
+	CacheTTL    *string `toml:"cache_ttl"`
+	MaxRetries  *int    `toml:"max_retries"`
+	RetryDelay  *string `toml:"retry_delay"`
+	ServeListen *string `toml:"serve_listen"`
+
+	S3Region          *string `toml:"s3_region"`
+	S3Endpoint        *string `toml:"s3_endpoint"`
+	S3AccessKeyID     *string `toml:"s3_access_key_id"`
+	S3SecretAccessKey *string `toml:"s3_secret_access_key"`
+	GCSAccessToken    *string `toml:"gcs_access_token"`
 }
 
 // FileNetworkConfig is the TOML representation of NetworkConfig.
 type FileNetworkConfig struct {
-	PortOffset   *int `toml:"port_offset"`
-	BaseRPCPort  *int `toml:"base_rpc_port"`
-	BaseP2PPort  *int `toml:"base_p2p_port"`
-	BaseRESTPort *int `toml:"base_rest_port"`
-	BaseGRPCPort *int `toml:"base_grpc_port"`
+	PortOffset   *int    `toml:"port_offset"`
+	BaseRPCPort  *int    `toml:"base_rpc_port"`
+	BaseP2PPort  *int    `toml:"base_p2p_port"`
+	BaseRESTPort *int    `toml:"base_rest_port"`
+	BaseGRPCPort *int    `toml:"base_grpc_port"`
+	PortConflict *string `toml:"port_conflict"`
+}
+
+// FileAPIConfig is the TOML representation of APIConfig.
+type FileAPIConfig struct {
+	Reflection    *bool   `toml:"reflection"`
+	GatewayListen *string `toml:"gateway_listen"`
+}
+
+// FileRetryConfig is the TOML representation of RetryConfig.
+type FileRetryConfig struct {
+	Build     FileRetryPolicyConfig `toml:"build"`
+	Snapshot  FileRetryPolicyConfig `toml:"snapshot"`
+	NodeStart FileRetryPolicyConfig `toml:"node_start"`
+}
+
+// FileRetryPolicyConfig is the TOML representation of RetryPolicyConfig.
+// Uses strings for duration values since TOML cannot decode directly to time.Duration.
+type FileRetryPolicyConfig struct {
+	MaxAttempts    *int     `toml:"max_attempts"`
+	InitialBackoff *string  `toml:"initial_backoff"`
+	MaxBackoff     *string  `toml:"max_backoff"`
+	Multiplier     *float64 `toml:"multiplier"`
+}
+
+// FileNotificationsConfig is the TOML representation of NotificationsConfig.
+type FileNotificationsConfig struct {
+	TTLWarning *string               `toml:"ttl_warning"`
+	Retry      FileRetryPolicyConfig `toml:"retry"`
+
+	// Webhooks replace the default (empty) list when set.
+	Webhooks []WebhookConfig `toml:"webhooks"`
+}
+
+// FilePruningConfig is the TOML representation of PruningConfig.
+type FilePruningConfig struct {
+	Interval *string `toml:"interval"`
+	MinAge   *string `toml:"min_age"`
+	Strategy *string `toml:"strategy"`
+}
+
+// FileNodeLogsConfig is the TOML representation of NodeLogsConfig.
+type FileNodeLogsConfig struct {
+	MaxSizeMB *int    `toml:"max_size_mb"`
+	MaxAge    *string `toml:"max_age"`
+	MaxFiles  *int    `toml:"max_files"`
+	Retention *string `toml:"retention"`
+	Compress  *bool   `toml:"compress"`
+}
+
+// FileProxyConfig is the TOML representation of ProxyConfig.
+type FileProxyConfig struct {
+	Listen      *string `toml:"listen"`
+	Username    *string `toml:"username"`
+	Password    *string `toml:"password"`
+	LogRequests *bool   `toml:"log_requests"`
+}
+
+// isEmpty returns true if no policy values are set.
+func (f *FileRetryPolicyConfig) isEmpty() bool {
+	return f.MaxAttempts == nil &&
+		f.InitialBackoff == nil &&
+		f.MaxBackoff == nil &&
+		f.Multiplier == nil
 }
 
 // IsEmpty returns true if no configuration values are set.
@@ -79,10 +166,16 @@ func (f *FileConfig) IsEmpty() bool {
 		f.Server.Workers == nil &&
 		f.Server.Foreground == nil &&
 		f.Server.RuntimeMode == nil &&
+		f.Server.Offline == nil &&
+		f.Server.StrictPluginResponses == nil &&
+		f.Server.EventRetention == nil &&
+		f.Server.StopNodesOnShutdown == nil &&
 		f.Auth.Enabled == nil &&
 		f.Auth.KeysFile == nil &&
 		f.Docker.Enabled == nil &&
 		f.Docker.Image == nil &&
+		f.Docker.Mirrors == nil &&
+		f.Docker.Registries == nil &&
 		f.GitHub.Token == nil &&
 		f.Timeouts.Shutdown == nil &&
 		f.Timeouts.HealthCheck == nil &&
@@ -90,9 +183,36 @@ func (f *FileConfig) IsEmpty() bool {
 		f.Snapshot.CacheTTL == nil &&
 		f.Snapshot.MaxRetries == nil &&
 		f.Snapshot.RetryDelay == nil &&
+		f.Snapshot.ServeListen == nil &&
+		f.Snapshot.S3Region == nil &&
+		f.Snapshot.S3Endpoint == nil &&
+		f.Snapshot.S3AccessKeyID == nil &&
+		f.Snapshot.S3SecretAccessKey == nil &&
+		f.Snapshot.GCSAccessToken == nil &&
 		f.Network.PortOffset == nil &&
 		f.Network.BaseRPCPort == nil &&
 		f.Network.BaseP2PPort == nil &&
 		f.Network.BaseRESTPort == nil &&
-		f.Network.BaseGRPCPort == nil
+		f.Network.BaseGRPCPort == nil &&
+		f.Network.PortConflict == nil &&
+		f.API.Reflection == nil &&
+		f.API.GatewayListen == nil &&
+		f.Retry.Build.isEmpty() &&
+		f.Retry.Snapshot.isEmpty() &&
+		f.Retry.NodeStart.isEmpty() &&
+		f.Notifications.TTLWarning == nil &&
+		f.Notifications.Retry.isEmpty() &&
+		f.Notifications.Webhooks == nil &&
+		f.Pruning.Interval == nil &&
+		f.Pruning.MinAge == nil &&
+		f.Pruning.Strategy == nil &&
+		f.NodeLogs.MaxSizeMB == nil &&
+		f.NodeLogs.MaxAge == nil &&
+		f.NodeLogs.MaxFiles == nil &&
+		f.NodeLogs.Retention == nil &&
+		f.NodeLogs.Compress == nil &&
+		f.Proxy.Listen == nil &&
+		f.Proxy.Username == nil &&
+		f.Proxy.Password == nil &&
+		f.Proxy.LogRequests == nil
 }
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/pelletier/go-toml/v2"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/transport"
 )
 
 // ConfigFileName is the default config file name.
@@ -38,6 +40,29 @@ const (
 
 	// Runtime mode environment variable
 	EnvRuntimeMode = "DEVNETD_RUNTIME_MODE"
+
+	// Offline mode environment variable
+	EnvOffline = "DEVNETD_OFFLINE"
+
+	// Strict plugin responses environment variable
+	EnvStrictPluginResponses = "DEVNETD_STRICT_PLUGIN_RESPONSES"
+
+	// Stopping nodes on shutdown environment variable
+	EnvStopNodesOnShutdown = "DEVNETD_STOP_NODES_ON_SHUTDOWN"
+
+	// API environment variables
+	EnvAPIReflection    = "DEVNETD_API_REFLECTION"
+	EnvAPIGatewayListen = "DEVNETD_API_GATEWAY_LISTEN"
+
+	// Snapshot sharing environment variable
+	EnvSnapshotServeListen = "DEVNETD_SNAPSHOT_SERVE_LISTEN"
+
+	// RPC proxy environment variables
+	EnvProxyListen   = "DEVNETD_PROXY_LISTEN"
+	EnvProxyPassword = "DEVNETD_PROXY_PASSWORD" //nolint:gosec // This is an env var name, not a credential
+
+	// Host port conflict policy environment variable
+	EnvPortConflict = "DEVNETD_PORT_CONFLICT"
 )
 
 // Loader loads configuration from file, environment, and applies defaults.
@@ -65,7 +90,7 @@ func (l *Loader) Load() (*Config, error) {
 	// Override dataDir if provided
 	if l.dataDir != "" {
 		cfg.Server.DataDir = l.dataDir
-		cfg.Server.Socket = filepath.Join(l.dataDir, "devnetd.sock")
+		cfg.Server.Socket = transport.DefaultAddress(l.dataDir)
 	}
 
 	// Load from file
@@ -130,6 +155,18 @@ func mergeFileConfig(cfg *Config, file *FileConfig) {
 	if file.Server.RuntimeMode != nil {
 		cfg.Server.RuntimeMode = *file.Server.RuntimeMode
 	}
+	if file.Server.Offline != nil {
+		cfg.Server.Offline = *file.Server.Offline
+	}
+	if file.Server.StrictPluginResponses != nil {
+		cfg.Server.StrictPluginResponses = *file.Server.StrictPluginResponses
+	}
+	if file.Server.EventRetention != nil {
+		cfg.Server.EventRetention = *file.Server.EventRetention
+	}
+	if file.Server.StopNodesOnShutdown != nil {
+		cfg.Server.StopNodesOnShutdown = *file.Server.StopNodesOnShutdown
+	}
 	if file.Server.Listen != nil {
 		cfg.Server.Listen = *file.Server.Listen
 	}
@@ -155,6 +192,12 @@ func mergeFileConfig(cfg *Config, file *FileConfig) {
 	if file.Docker.Image != nil {
 		cfg.Docker.Image = *file.Docker.Image
 	}
+	if file.Docker.Mirrors != nil {
+		cfg.Docker.Mirrors = file.Docker.Mirrors
+	}
+	if file.Docker.Registries != nil {
+		cfg.Docker.Registries = file.Docker.Registries
+	}
 
 	// GitHub
 	if file.GitHub.Token != nil {
@@ -192,6 +235,24 @@ func mergeFileConfig(cfg *Config, file *FileConfig) {
 			cfg.Snapshot.RetryDelay = d
 		}
 	}
+	if file.Snapshot.ServeListen != nil {
+		cfg.Snapshot.ServeListen = *file.Snapshot.ServeListen
+	}
+	if file.Snapshot.S3Region != nil {
+		cfg.Snapshot.S3Region = *file.Snapshot.S3Region
+	}
+	if file.Snapshot.S3Endpoint != nil {
+		cfg.Snapshot.S3Endpoint = *file.Snapshot.S3Endpoint
+	}
+	if file.Snapshot.S3AccessKeyID != nil {
+		cfg.Snapshot.S3AccessKeyID = *file.Snapshot.S3AccessKeyID
+	}
+	if file.Snapshot.S3SecretAccessKey != nil {
+		cfg.Snapshot.S3SecretAccessKey = *file.Snapshot.S3SecretAccessKey
+	}
+	if file.Snapshot.GCSAccessToken != nil {
+		cfg.Snapshot.GCSAccessToken = *file.Snapshot.GCSAccessToken
+	}
 
 	// Network
 	if file.Network.PortOffset != nil {
@@ -209,6 +270,104 @@ func mergeFileConfig(cfg *Config, file *FileConfig) {
 	if file.Network.BaseGRPCPort != nil {
 		cfg.Network.BaseGRPCPort = *file.Network.BaseGRPCPort
 	}
+	if file.Network.PortConflict != nil {
+		cfg.Network.PortConflict = *file.Network.PortConflict
+	}
+
+	// Retry
+	mergeRetryPolicy(&cfg.Retry.Build, &file.Retry.Build)
+	mergeRetryPolicy(&cfg.Retry.Snapshot, &file.Retry.Snapshot)
+	mergeRetryPolicy(&cfg.Retry.NodeStart, &file.Retry.NodeStart)
+
+	// Notifications
+	if file.Notifications.TTLWarning != nil {
+		if d, err := time.ParseDuration(*file.Notifications.TTLWarning); err == nil {
+			cfg.Notifications.TTLWarning = d
+		}
+	}
+	mergeRetryPolicy(&cfg.Notifications.Retry, &file.Notifications.Retry)
+	if file.Notifications.Webhooks != nil {
+		cfg.Notifications.Webhooks = file.Notifications.Webhooks
+	}
+
+	// Pruning
+	if file.Pruning.Interval != nil {
+		if d, err := time.ParseDuration(*file.Pruning.Interval); err == nil {
+			cfg.Pruning.Interval = d
+		}
+	}
+	if file.Pruning.MinAge != nil {
+		if d, err := time.ParseDuration(*file.Pruning.MinAge); err == nil {
+			cfg.Pruning.MinAge = d
+		}
+	}
+	if file.Pruning.Strategy != nil {
+		cfg.Pruning.Strategy = *file.Pruning.Strategy
+	}
+
+	// Node logs
+	if file.NodeLogs.MaxSizeMB != nil {
+		cfg.NodeLogs.MaxSizeMB = *file.NodeLogs.MaxSizeMB
+	}
+	if file.NodeLogs.MaxAge != nil {
+		if d, err := time.ParseDuration(*file.NodeLogs.MaxAge); err == nil {
+			cfg.NodeLogs.MaxAge = d
+		}
+	}
+	if file.NodeLogs.MaxFiles != nil {
+		cfg.NodeLogs.MaxFiles = *file.NodeLogs.MaxFiles
+	}
+	if file.NodeLogs.Retention != nil {
+		if d, err := time.ParseDuration(*file.NodeLogs.Retention); err == nil {
+			cfg.NodeLogs.Retention = d
+		}
+	}
+	if file.NodeLogs.Compress != nil {
+		cfg.NodeLogs.Compress = *file.NodeLogs.Compress
+	}
+
+	// RPC proxy
+	if file.Proxy.Listen != nil {
+		cfg.Proxy.Listen = *file.Proxy.Listen
+	}
+	if file.Proxy.Username != nil {
+		cfg.Proxy.Username = *file.Proxy.Username
+	}
+	if file.Proxy.Password != nil {
+		cfg.Proxy.Password = *file.Proxy.Password
+	}
+	if file.Proxy.LogRequests != nil {
+		cfg.Proxy.LogRequests = *file.Proxy.LogRequests
+	}
+
+	// API
+	if file.API.Reflection != nil {
+		cfg.API.Reflection = *file.API.Reflection
+	}
+	if file.API.GatewayListen != nil {
+		cfg.API.GatewayListen = *file.API.GatewayListen
+	}
+}
+
+// mergeRetryPolicy overrides the values of a retry policy that are set in
+// the file.
+func mergeRetryPolicy(cfg *RetryPolicyConfig, file *FileRetryPolicyConfig) {
+	if file.MaxAttempts != nil {
+		cfg.MaxAttempts = *file.MaxAttempts
+	}
+	if file.InitialBackoff != nil {
+		if d, err := time.ParseDuration(*file.InitialBackoff); err == nil {
+			cfg.InitialBackoff = d
+		}
+	}
+	if file.MaxBackoff != nil {
+		if d, err := time.ParseDuration(*file.MaxBackoff); err == nil {
+			cfg.MaxBackoff = d
+		}
+	}
+	if file.Multiplier != nil {
+		cfg.Multiplier = *file.Multiplier
+	}
 }
 
 // applyEnvVars applies environment variable overrides to config.
@@ -266,6 +425,21 @@ func applyEnvVars(cfg *Config) {
 		cfg.Server.RuntimeMode = v
 	}
 
+	// Offline mode
+	if v := os.Getenv(EnvOffline); v != "" {
+		cfg.Server.Offline = v == "true" || v == "1"
+	}
+
+	// Strict plugin responses
+	if v := os.Getenv(EnvStrictPluginResponses); v != "" {
+		cfg.Server.StrictPluginResponses = v == "true" || v == "1"
+	}
+
+	// Stopping nodes on shutdown
+	if v := os.Getenv(EnvStopNodesOnShutdown); v != "" {
+		cfg.Server.StopNodesOnShutdown = v == "true" || v == "1"
+	}
+
 	// Authentication
 	if v := os.Getenv(EnvAuthEnabled); v != "" {
 		cfg.Auth.Enabled = v == "true" || v == "1"
@@ -273,4 +447,28 @@ func applyEnvVars(cfg *Config) {
 	if v := os.Getenv(EnvAuthKeysFile); v != "" {
 		cfg.Auth.KeysFile = v
 	}
+
+	// API
+	if v := os.Getenv(EnvAPIReflection); v != "" {
+		cfg.API.Reflection = v == "true" || v == "1"
+	}
+	if v := os.Getenv(EnvAPIGatewayListen); v != "" {
+		cfg.API.GatewayListen = v
+	}
+
+	// Snapshot sharing
+	if v := os.Getenv(EnvSnapshotServeListen); v != "" {
+		cfg.Snapshot.ServeListen = v
+	}
+
+	// RPC proxy
+	if v := os.Getenv(EnvProxyListen); v != "" {
+		cfg.Proxy.Listen = v
+	}
+	if v := os.Getenv(EnvProxyPassword); v != "" {
+		cfg.Proxy.Password = v
+	}
+	if v := os.Getenv(EnvPortConflict); v != "" {
+		cfg.Network.PortConflict = v
+	}
 }
@@ -3,8 +3,13 @@ package config
 
 import (
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"strings"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/notify"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/registries"
 )
 
 // ValidLogLevels are the allowed log level values.
@@ -41,6 +46,9 @@ func Validate(cfg *Config) error {
 	if cfg.Server.Workers < 1 {
 		errs = append(errs, "workers must be at least 1")
 	}
+	if cfg.Server.EventRetention < 1 {
+		errs = append(errs, "event_retention must be at least 1")
+	}
 
 	// Validate TLS settings: if Listen is set, TLS cert and key are required
 	if cfg.Server.Listen != "" {
@@ -64,6 +72,17 @@ func Validate(cfg *Config) error {
 		}
 	}
 
+	// Validate gateway: it reuses the TLS settings, which are required unless
+	// the gateway only listens on loopback
+	if cfg.API.GatewayListen != "" {
+		host, _, err := net.SplitHostPort(cfg.API.GatewayListen)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("invalid gateway_listen %q: %v", cfg.API.GatewayListen, err))
+		} else if !isLoopbackHost(host) && (cfg.Server.TLSCert == "" || cfg.Server.TLSKey == "") {
+			errs = append(errs, "tls_cert and tls_key are required when gateway_listen is not a loopback address")
+		}
+	}
+
 	// Validate timeouts
 	if cfg.Timeouts.Shutdown < 0 {
 		errs = append(errs, "shutdown timeout must be non-negative")
@@ -85,6 +104,19 @@ func Validate(cfg *Config) error {
 	if cfg.Snapshot.CacheTTL < 0 {
 		errs = append(errs, "cache_ttl must be non-negative")
 	}
+	if cfg.Snapshot.ServeListen != "" {
+		if _, _, err := net.SplitHostPort(cfg.Snapshot.ServeListen); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid serve_listen %q: %v", cfg.Snapshot.ServeListen, err))
+		}
+	}
+	if cfg.Snapshot.S3Endpoint != "" {
+		if u, err := url.Parse(cfg.Snapshot.S3Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Sprintf("invalid s3_endpoint %q: want an http(s) URL", cfg.Snapshot.S3Endpoint))
+		}
+	}
+	if (cfg.Snapshot.S3AccessKeyID == "") != (cfg.Snapshot.S3SecretAccessKey == "") {
+		errs = append(errs, "s3_access_key_id and s3_secret_access_key must be set together")
+	}
 
 	// Validate network ports
 	if cfg.Network.PortOffset < 0 {
@@ -102,6 +134,87 @@ func Validate(cfg *Config) error {
 	if cfg.Network.BaseGRPCPort < 1 || cfg.Network.BaseGRPCPort > 65535 {
 		errs = append(errs, "base_grpc_port must be between 1 and 65535")
 	}
+	if cfg.Network.PortConflict != "reallocate" && cfg.Network.PortConflict != "fail" {
+		errs = append(errs, fmt.Sprintf("invalid port_conflict %q (must be reallocate or fail)", cfg.Network.PortConflict))
+	}
+
+	// Validate retry policies
+	for _, p := range []struct {
+		name   string
+		policy RetryPolicyConfig
+	}{
+		{"build", cfg.Retry.Build},
+		{"snapshot", cfg.Retry.Snapshot},
+		{"node_start", cfg.Retry.NodeStart},
+	} {
+		if err := p.policy.Policy().Validate(); err != nil {
+			errs = append(errs, fmt.Sprintf("retry.%s: %v", p.name, err))
+		}
+	}
+
+	// Validate registry mirrors and credentials
+	if err := registries.Validate(cfg.Docker.MirrorList(), cfg.Docker.CredentialList()); err != nil {
+		for _, msg := range strings.Split(err.Error(), "\n") {
+			errs = append(errs, "docker."+msg)
+		}
+	}
+
+	// Validate notifications
+	if cfg.Notifications.TTLWarning < 0 {
+		errs = append(errs, "notifications.ttl_warning must be non-negative")
+	}
+	if err := cfg.Notifications.Retry.Policy().Validate(); err != nil {
+		errs = append(errs, fmt.Sprintf("notifications.retry: %v", err))
+	}
+	if err := notify.Validate(cfg.Notifications.WebhookList()); err != nil {
+		for _, msg := range strings.Split(err.Error(), "\n") {
+			errs = append(errs, "notifications."+msg)
+		}
+	}
+
+	// Validate pruning
+	if cfg.Pruning.Interval < 0 {
+		errs = append(errs, "pruning.interval must be non-negative")
+	}
+	if cfg.Pruning.MinAge < 0 {
+		errs = append(errs, "pruning.min_age must be non-negative")
+	}
+	if cfg.Pruning.Interval > 0 && cfg.Pruning.Strategy == "" {
+		errs = append(errs, "pruning.strategy is required when pruning.interval is set")
+	}
+
+	// Validate node logs
+	if cfg.NodeLogs.MaxSizeMB < 1 {
+		errs = append(errs, "node_logs.max_size_mb must be at least 1")
+	}
+	if cfg.NodeLogs.MaxAge < 0 {
+		errs = append(errs, "node_logs.max_age must be non-negative")
+	}
+	if cfg.NodeLogs.MaxFiles < 0 {
+		errs = append(errs, "node_logs.max_files must be non-negative")
+	}
+	if cfg.NodeLogs.Retention < 0 {
+		errs = append(errs, "node_logs.retention must be non-negative")
+	}
+
+	// Validate RPC proxy: like the gateway, it reuses the TLS settings, which
+	// are required along with basic auth unless it only listens on loopback
+	if cfg.Proxy.Listen != "" {
+		host, _, err := net.SplitHostPort(cfg.Proxy.Listen)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("invalid proxy.listen %q: %v", cfg.Proxy.Listen, err))
+		} else if !isLoopbackHost(host) {
+			if cfg.Server.TLSCert == "" || cfg.Server.TLSKey == "" {
+				errs = append(errs, "tls_cert and tls_key are required when proxy.listen is not a loopback address")
+			}
+			if cfg.Proxy.Username == "" {
+				errs = append(errs, "proxy.username and proxy.password are required when proxy.listen is not a loopback address")
+			}
+		}
+	}
+	if (cfg.Proxy.Username == "") != (cfg.Proxy.Password == "") {
+		errs = append(errs, "proxy.username and proxy.password must be set together")
+	}
 
 	if len(errs) > 0 {
 		return fmt.Errorf("config validation failed:\n  - %s", strings.Join(errs, "\n  - "))
@@ -109,3 +222,12 @@ func Validate(cfg *Config) error {
 
 	return nil
 }
+
+// isLoopbackHost reports whether host is "localhost" or a loopback IP.
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
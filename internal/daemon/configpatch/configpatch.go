@@ -0,0 +1,280 @@
+// internal/daemon/configpatch/configpatch.go
+
+// Package configpatch applies overrides to the config.toml and app.toml of
+// an initialized node home in place, preserving comments and layout.
+//
+// Overrides are a TOML document with a [config] table for config.toml and an
+// [app] table for app.toml:
+//
+//	[config]
+//	log_level = "debug"
+//
+//	[config.rpc]
+//	max_open_connections = 2000
+//
+//	[app]
+//	minimum-gas-prices = "0stake"
+package configpatch
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// Config files that can be patched, keyed by their override table.
+var files = map[string]string{
+	"config": "config.toml",
+	"app":    "app.toml",
+}
+
+// Change is a single override of a config value.
+type Change struct {
+	// File is the config file name, "config.toml" or "app.toml".
+	File string
+	// Key is the dotted key within the file, e.g. "rpc.max_open_connections".
+	Key string
+	// Value is the new value as a TOML literal.
+	Value string
+	// Old is the previous value as a TOML literal. Set by Apply.
+	Old string
+}
+
+// ID identifies the changed key as "<file>:<key>", e.g.
+// "config.toml:log_level".
+func (c Change) ID() string {
+	return c.File + ":" + c.Key
+}
+
+// Parse parses an overrides document into changes sorted by file and key.
+func Parse(data []byte) ([]Change, error) {
+	var doc map[string]any
+	if err := toml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid overrides: %w", err)
+	}
+
+	var changes []Change
+	for table, v := range doc {
+		file, ok := files[table]
+		if !ok {
+			return nil, fmt.Errorf("unknown overrides table %q (use [config] or [app])", table)
+		}
+		values, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("overrides %q must be a table", table)
+		}
+		flat, err := flatten(file, "", values, false)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, flat...)
+	}
+
+	if len(changes) == 0 {
+		return nil, fmt.Errorf("overrides contain no values")
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].ID() < changes[j].ID()
+	})
+	return changes, nil
+}
+
+// ParseFile parses a whole or partial config.toml or app.toml document into
+// its values, sorted by key. Values that cannot be patched, such as arrays
+// of tables, are skipped.
+func ParseFile(file string, data []byte) ([]Change, error) {
+	var doc map[string]any
+	if err := toml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", file, err)
+	}
+	changes, err := flatten(file, "", doc, true)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes, nil
+}
+
+// Read returns the current values of the config files under homeDir/config,
+// sorted by file and key. Missing files are skipped, but at least one must
+// exist.
+func Read(homeDir string) ([]Change, error) {
+	var values []Change
+	found := false
+	for _, file := range []string{"app.toml", "config.toml"} {
+		content, err := os.ReadFile(filepath.Join(homeDir, "config", file))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		found = true
+		parsed, err := ParseFile(file, content)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, parsed...)
+	}
+	if !found {
+		return nil, fmt.Errorf("no config.toml or app.toml in %s", filepath.Join(homeDir, "config"))
+	}
+	return values, nil
+}
+
+// flatten turns nested tables into dotted keys with TOML literal values.
+// Values of unsupported types are an error, or skipped if skipUnsupported.
+func flatten(file, prefix string, values map[string]any, skipUnsupported bool) ([]Change, error) {
+	var changes []Change
+	for k, v := range values {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]any); ok {
+			sub, err := flatten(file, key, nested, skipUnsupported)
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, sub...)
+			continue
+		}
+		literal, err := tomlLiteral(v)
+		if err != nil {
+			if skipUnsupported {
+				continue
+			}
+			return nil, fmt.Errorf("%s:%s: %w", file, key, err)
+		}
+		changes = append(changes, Change{File: file, Key: key, Value: literal})
+	}
+	return changes, nil
+}
+
+// tomlLiteral encodes a value as a TOML literal, using double-quoted strings
+// like the files generated by the node's init command.
+func tomlLiteral(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return strconv.Quote(v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case []any:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			literal, err := tomlLiteral(item)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, literal)
+		}
+		return "[" + strings.Join(parts, ", ") + "]", nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+// Apply writes changes to the config files under homeDir/config and returns
+// the changes that altered a value, with Old set. Every key must already
+// exist in its file; nothing is written if any key is missing.
+func Apply(homeDir string, changes []Change) ([]Change, error) {
+	byFile := make(map[string][]Change)
+	for _, c := range changes {
+		byFile[c.File] = append(byFile[c.File], c)
+	}
+
+	updated := make(map[string][]byte)
+	var applied []Change
+	for _, file := range sortedFiles(byFile) {
+		path := filepath.Join(homeDir, "config", file)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+
+		lines := strings.Split(string(content), "\n")
+		for _, c := range byFile[file] {
+			line, old, err := findKey(lines, c.Key)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", c.ID(), err)
+			}
+			if old == c.Value {
+				continue
+			}
+			indent := lines[line][:len(lines[line])-len(strings.TrimLeft(lines[line], " \t"))]
+			lines[line] = fmt.Sprintf("%s%s = %s", indent, lastKeyPart(c.Key), c.Value)
+			c.Old = old
+			applied = append(applied, c)
+		}
+		updated[path] = []byte(strings.Join(lines, "\n"))
+	}
+
+	for path, content := range updated {
+		if err := os.WriteFile(path, content, 0644); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", filepath.Base(path), err)
+		}
+	}
+	return applied, nil
+}
+
+var errKeyNotFound = errors.New("key not found")
+
+var sectionHeader = regexp.MustCompile(`^\s*\[\[?\s*([^\]]+?)\s*\]\]?\s*(#.*)?$`)
+
+// findKey returns the line index and current value of a dotted key.
+func findKey(lines []string, key string) (int, string, error) {
+	section := ""
+	name := key
+	if i := strings.LastIndex(key, "."); i >= 0 {
+		section, name = key[:i], key[i+1:]
+	}
+	keyLine := regexp.MustCompile(`^\s*` + regexp.QuoteMeta(name) + `\s*=\s*(.*)$`)
+
+	current := ""
+	for i, line := range lines {
+		if m := sectionHeader.FindStringSubmatch(line); m != nil {
+			current = m[1]
+			continue
+		}
+		if current != section {
+			continue
+		}
+		if m := keyLine.FindStringSubmatch(line); m != nil {
+			value := strings.TrimSpace(m[1])
+			if strings.HasPrefix(value, "[") && !strings.Contains(value, "]") {
+				return 0, "", fmt.Errorf("multi-line values are not supported")
+			}
+			return i, value, nil
+		}
+	}
+	return 0, "", errKeyNotFound
+}
+
+func lastKeyPart(key string) string {
+	if i := strings.LastIndex(key, "."); i >= 0 {
+		return key[i+1:]
+	}
+	return key
+}
+
+func sortedFiles(m map[string][]Change) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,146 @@
+// internal/daemon/configpatch/configpatch_test.go
+package configpatch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfigTOML = `# This is a TOML config file.
+proxy_app = "tcp://127.0.0.1:26658"
+log_level = "info"
+
+[rpc]
+# TCP or UNIX socket address for the RPC server to listen on
+laddr = "tcp://127.0.0.1:26657"
+max_open_connections = 900
+cors_allowed_origins = []
+`
+
+const testAppTOML = `minimum-gas-prices = ""
+
+[api]
+enable = false
+`
+
+func writeHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "config.toml"), []byte(testConfigTOML), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "app.toml"), []byte(testAppTOML), 0644))
+	return home
+}
+
+func TestParse(t *testing.T) {
+	changes, err := Parse([]byte(`
+[config]
+log_level = "debug"
+
+[config.rpc]
+max_open_connections = 2000
+cors_allowed_origins = ["*"]
+
+[app.api]
+enable = true
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []Change{
+		{File: "app.toml", Key: "api.enable", Value: "true"},
+		{File: "config.toml", Key: "log_level", Value: `"debug"`},
+		{File: "config.toml", Key: "rpc.cors_allowed_origins", Value: `["*"]`},
+		{File: "config.toml", Key: "rpc.max_open_connections", Value: "2000"},
+	}, changes)
+	assert.Equal(t, "config.toml:log_level", changes[1].ID())
+}
+
+func TestParse_Errors(t *testing.T) {
+	_, err := Parse([]byte(`[client]
+chain-id = "x"`))
+	assert.ErrorContains(t, err, "unknown overrides table")
+
+	_, err = Parse([]byte(`config = 1`))
+	assert.ErrorContains(t, err, "must be a table")
+
+	_, err = Parse([]byte(``))
+	assert.ErrorContains(t, err, "no values")
+}
+
+func TestApply(t *testing.T) {
+	home := writeHome(t)
+
+	applied, err := Apply(home, []Change{
+		{File: "config.toml", Key: "log_level", Value: `"info"`},
+		{File: "config.toml", Key: "rpc.max_open_connections", Value: "2000"},
+		{File: "app.toml", Key: "api.enable", Value: "true"},
+	})
+	require.NoError(t, err)
+
+	// log_level is unchanged, so only two changes are reported.
+	require.Len(t, applied, 2)
+	assert.Equal(t, "false", applied[0].Old)
+	assert.Equal(t, "900", applied[1].Old)
+
+	config, err := os.ReadFile(filepath.Join(home, "config", "config.toml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(config), "max_open_connections = 2000\n")
+	assert.Contains(t, string(config), "# TCP or UNIX socket address", "comments are preserved")
+
+	app, err := os.ReadFile(filepath.Join(home, "config", "app.toml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(app), "[api]\nenable = true\n")
+}
+
+func TestApply_MissingKeyWritesNothing(t *testing.T) {
+	home := writeHome(t)
+
+	_, err := Apply(home, []Change{
+		{File: "app.toml", Key: "api.enable", Value: "true"},
+		{File: "config.toml", Key: "rpc.no_such_key", Value: "1"},
+	})
+	assert.ErrorContains(t, err, "config.toml:rpc.no_such_key: key not found")
+
+	app, err := os.ReadFile(filepath.Join(home, "config", "app.toml"))
+	require.NoError(t, err)
+	assert.Equal(t, testAppTOML, string(app))
+}
+
+func TestApply_KeyInOtherSection(t *testing.T) {
+	home := writeHome(t)
+
+	// laddr only exists under [rpc], not at the top level.
+	_, err := Apply(home, []Change{{File: "config.toml", Key: "laddr", Value: `"x"`}})
+	assert.ErrorContains(t, err, "key not found")
+}
+
+func TestRead(t *testing.T) {
+	home := writeHome(t)
+
+	values, err := Read(home)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Change{
+		{File: "app.toml", Key: "api.enable", Value: "false"},
+		{File: "app.toml", Key: "minimum-gas-prices", Value: `""`},
+		{File: "config.toml", Key: "log_level", Value: `"info"`},
+		{File: "config.toml", Key: "proxy_app", Value: `"tcp://127.0.0.1:26658"`},
+		{File: "config.toml", Key: "rpc.cors_allowed_origins", Value: "[]"},
+		{File: "config.toml", Key: "rpc.laddr", Value: `"tcp://127.0.0.1:26657"`},
+		{File: "config.toml", Key: "rpc.max_open_connections", Value: "900"},
+	}, values)
+}
+
+func TestParseFile_SkipsUnsupported(t *testing.T) {
+	values, err := ParseFile("app.toml", []byte(`pruning = "nothing"
+
+[[telemetry.global-labels]]
+name = "x"
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []Change{{File: "app.toml", Key: "pruning", Value: `"nothing"`}}, values)
+}
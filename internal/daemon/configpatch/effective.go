@@ -0,0 +1,126 @@
+// internal/daemon/configpatch/effective.go
+package configpatch
+
+import (
+	"sort"
+	"strings"
+)
+
+// Sources of effective config values.
+const (
+	// SourceDefault is a value written by the node's init command.
+	SourceDefault = "default"
+	// SourceSpec is a setting of the devnet spec: devnet-builder's devnet
+	// defaults, the devnet's provisioning profile or a node's config
+	// snippets.
+	SourceSpec = "spec"
+	// SourcePlugin is a value from the network plugin's config overrides.
+	SourcePlugin = "plugin"
+	// SourceRuntime is a value computed per node, such as listen addresses
+	// and peers.
+	SourceRuntime = "runtime"
+	// SourceUser is a value set with apply-config.
+	SourceUser = "user"
+	// SourceFile is a value that differs from what its layer sets, i.e. it
+	// was edited outside devnet-builder.
+	SourceFile = "file"
+)
+
+// Layer is a set of values applied to a node's config files. Layers are
+// applied in order, later layers taking precedence.
+type Layer struct {
+	// Source is the layer's source, e.g. SourcePlugin.
+	Source string
+	// Detail describes the layer, e.g. "profile laptop".
+	Detail string
+	// Values maps change IDs ("<file>:<key>") to TOML literals. An empty
+	// literal matches any value, for values computed at provisioning time
+	// that cannot be recomputed.
+	Values map[string]string
+}
+
+// Field is an effective config value and where it came from.
+type Field struct {
+	File   string
+	Key    string
+	Value  string
+	Source string
+	Detail string
+}
+
+// Effective attributes each current value to the last layer that sets it to
+// that value. A value set by no layer is a node init default; one that
+// matches none of the layers setting it is reported as SourceFile.
+func Effective(current []Change, layers []Layer) []Field {
+	fields := make([]Field, 0, len(current))
+	for _, c := range current {
+		field := Field{File: c.File, Key: c.Key, Value: c.Value, Source: SourceDefault}
+		for i := len(layers) - 1; i >= 0; i-- {
+			want, ok := layers[i].Values[c.ID()]
+			if !ok {
+				continue
+			}
+			if want == "" || want == c.Value {
+				field.Source, field.Detail = layers[i].Source, layers[i].Detail
+				break
+			}
+			if field.Source == SourceDefault {
+				field.Source = SourceFile
+				field.Detail = layers[i].Source + " sets " + want
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// Drift is a value that differs from what the last layer setting it sets.
+type Drift struct {
+	File string
+	Key  string
+	// Current is the value in the file.
+	Current string
+	// Expected is the value the layer sets.
+	Expected string
+	Source   string
+	Detail   string
+}
+
+// Drifts compares current values with what the layers set, the last layer
+// setting a key taking precedence, and returns the differences sorted by
+// file and key. Values the layers compute at provisioning time cannot be
+// checked and are skipped, as are values no layer sets and keys missing
+// from the files: devnet-builder only sets most keys if the node's init
+// command wrote them.
+func Drifts(current []Change, layers []Layer) []Drift {
+	have := make(map[string]string, len(current))
+	for _, c := range current {
+		have[c.ID()] = c.Value
+	}
+
+	expected := make(map[string]Drift)
+	for _, layer := range layers {
+		for id, want := range layer.Values {
+			if want == "" {
+				delete(expected, id)
+				continue
+			}
+			file, key, _ := strings.Cut(id, ":")
+			expected[id] = Drift{File: file, Key: key, Expected: want, Source: layer.Source, Detail: layer.Detail}
+		}
+	}
+
+	var drifts []Drift
+	for id, d := range expected {
+		value, ok := have[id]
+		if !ok || value == d.Expected {
+			continue
+		}
+		d.Current = value
+		drifts = append(drifts, d)
+	}
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].File+":"+drifts[i].Key < drifts[j].File+":"+drifts[j].Key
+	})
+	return drifts
+}
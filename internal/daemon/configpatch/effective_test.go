@@ -0,0 +1,85 @@
+// internal/daemon/configpatch/effective_test.go
+package configpatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffective(t *testing.T) {
+	current := []Change{
+		{File: "app.toml", Key: "pruning", Value: `"everything"`},
+		{File: "config.toml", Key: "log_level", Value: `"debug"`},
+		{File: "config.toml", Key: "moniker", Value: `"validator-0"`},
+		{File: "config.toml", Key: "consensus.timeout_commit", Value: `"5s"`},
+		{File: "config.toml", Key: "consensus.timeout_propose", Value: `"3s"`},
+		{File: "config.toml", Key: "rpc.max_open_connections", Value: "900"},
+	}
+	layers := []Layer{
+		{Source: SourceSpec, Detail: "devnet default", Values: map[string]string{
+			"config.toml:consensus.timeout_commit": `"1s"`,
+		}},
+		{Source: SourceSpec, Detail: "profile laptop", Values: map[string]string{
+			"app.toml:pruning": `"everything"`,
+		}},
+		{Source: SourcePlugin, Values: map[string]string{
+			"config.toml:consensus.timeout_propose": `"3s"`,
+		}},
+		{Source: SourceSpec, Values: map[string]string{
+			"config.toml:consensus.timeout_propose": `"1s"`,
+		}},
+		{Source: SourceRuntime, Values: map[string]string{
+			"config.toml:moniker": "",
+		}},
+		{Source: SourcePlugin, Values: map[string]string{
+			"config.toml:log_level": `"info"`,
+		}},
+		{Source: SourceUser, Values: map[string]string{
+			"config.toml:log_level": `"debug"`,
+		}},
+	}
+
+	fields := Effective(current, layers)
+
+	assert.Equal(t, []Field{
+		{File: "app.toml", Key: "pruning", Value: `"everything"`, Source: SourceSpec, Detail: "profile laptop"},
+		{File: "config.toml", Key: "log_level", Value: `"debug"`, Source: SourceUser},
+		{File: "config.toml", Key: "moniker", Value: `"validator-0"`, Source: SourceRuntime},
+		{File: "config.toml", Key: "consensus.timeout_commit", Value: `"5s"`, Source: SourceFile, Detail: `spec sets "1s"`},
+		// An earlier layer's value is kept if a later layer did not apply.
+		{File: "config.toml", Key: "consensus.timeout_propose", Value: `"3s"`, Source: SourcePlugin},
+		{File: "config.toml", Key: "rpc.max_open_connections", Value: "900", Source: SourceDefault},
+	}, fields)
+}
+
+func TestDrifts(t *testing.T) {
+	current := []Change{
+		{File: "app.toml", Key: "pruning", Value: `"default"`},
+		{File: "config.toml", Key: "consensus.timeout_commit", Value: `"1s"`},
+		{File: "config.toml", Key: "log_level", Value: `"info"`},
+		{File: "config.toml", Key: "moniker", Value: `"edited"`},
+		{File: "config.toml", Key: "mempool.size", Value: "10"},
+	}
+	layers := []Layer{
+		{Source: SourcePlugin, Detail: "cosmos", Values: map[string]string{
+			"config.toml:consensus.timeout_commit": `"10s"`,
+			"config.toml:mempool.size":             "5000",
+		}},
+		{Source: SourceSpec, Detail: "devnet default", Values: map[string]string{
+			"config.toml:consensus.timeout_commit": `"1s"`,
+			"config.toml:p2p.allow_duplicate_ip":   "true",
+		}},
+		{Source: SourceRuntime, Values: map[string]string{
+			"config.toml:moniker": "",
+		}},
+		{Source: SourceUser, Detail: "apply-config", Values: map[string]string{
+			"app.toml:pruning": `"everything"`,
+		}},
+	}
+
+	assert.Equal(t, []Drift{
+		{File: "app.toml", Key: "pruning", Current: `"default"`, Expected: `"everything"`, Source: SourceUser, Detail: "apply-config"},
+		{File: "config.toml", Key: "mempool.size", Current: "10", Expected: "5000", Source: SourcePlugin, Detail: "cosmos"},
+	}, Drifts(current, layers))
+}
@@ -0,0 +1,124 @@
+// internal/daemon/configpatch/merge.go
+package configpatch
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// Merge deep-merges overrides, a whole or partial config.toml or app.toml
+// document, into content, the current contents of that file. Keys present in
+// content are changed in place, preserving comments and layout; missing keys
+// are added at the end of their table, and missing tables are appended.
+func Merge(file string, content, overrides []byte) ([]byte, error) {
+	changes, err := ParseFile(file, overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(string(content), "\n")
+	for _, c := range changes {
+		line, old, err := findKey(lines, c.Key)
+		switch {
+		case errors.Is(err, errKeyNotFound):
+			lines = insertKey(lines, c.Key, c.Value)
+		case err != nil:
+			return nil, fmt.Errorf("%s: %w", c.ID(), err)
+		case old != c.Value:
+			indent := lines[line][:len(lines[line])-len(strings.TrimLeft(lines[line], " \t"))]
+			lines[line] = fmt.Sprintf("%s%s = %s", indent, lastKeyPart(c.Key), c.Value)
+		}
+	}
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// MergeFile merges overrides into file under homeDir/config. See Merge.
+func MergeFile(homeDir, file string, overrides []byte) error {
+	path := filepath.Join(homeDir, "config", file)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	merged, err := Merge(file, content, overrides)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, merged, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", file, err)
+	}
+	return nil
+}
+
+// ValidateNodeConfigs checks that per-node config snippets name existing
+// nodes, each at most once, and are valid TOML that Merge can apply.
+func ValidateNodeConfigs(configs []types.NodeConfig, nodes int) error {
+	var errs []error
+	seen := make(map[int]bool)
+	for _, c := range configs {
+		if c.Index < 0 || c.Index >= nodes {
+			errs = append(errs, fmt.Errorf("node %d: index out of range (devnet has %d nodes)", c.Index, nodes))
+			continue
+		}
+		if seen[c.Index] {
+			errs = append(errs, fmt.Errorf("node %d: config given more than once", c.Index))
+			continue
+		}
+		seen[c.Index] = true
+
+		if _, err := ParseFile("config.toml", []byte(c.ConfigToml)); err != nil {
+			errs = append(errs, fmt.Errorf("node %d: %w", c.Index, err))
+		}
+		if _, err := ParseFile("app.toml", []byte(c.AppToml)); err != nil {
+			errs = append(errs, fmt.Errorf("node %d: %w", c.Index, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// insertKey adds a dotted key after the last value of its table, or after
+// the header of a table without values. A missing table is appended.
+func insertKey(lines []string, key, value string) []string {
+	section := ""
+	if i := strings.LastIndex(key, "."); i >= 0 {
+		section = key[:i]
+	}
+	entry := fmt.Sprintf("%s = %s", lastKeyPart(key), value)
+
+	// Keep the trailing newline at the end of the file.
+	end := len(lines)
+	if end > 0 && lines[end-1] == "" {
+		end--
+	}
+
+	at := -1
+	current := ""
+	for i, line := range lines[:end] {
+		if m := sectionHeader.FindStringSubmatch(line); m != nil {
+			if section == "" {
+				break
+			}
+			current = m[1]
+			if current == section {
+				at = i + 1
+			}
+			continue
+		}
+		trimmed := strings.TrimSpace(line)
+		if current == section && trimmed != "" && !strings.HasPrefix(trimmed, "#") {
+			at = i + 1
+		}
+	}
+
+	switch {
+	case at < 0 && section == "":
+		at = 0
+	case at < 0:
+		return append(lines[:end:end], append([]string{"", "[" + section + "]", entry}, lines[end:]...)...)
+	}
+	return append(lines[:at:at], append([]string{entry}, lines[at:]...)...)
+}
@@ -0,0 +1,119 @@
+// internal/daemon/configpatch/merge_test.go
+package configpatch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides string
+		want      string
+	}{
+		{
+			name:      "existing keys change in place",
+			overrides: "log_level = \"debug\"\n\n[rpc]\nmax_open_connections = 2000\n",
+			want: `# This is a TOML config file.
+proxy_app = "tcp://127.0.0.1:26658"
+log_level = "debug"
+
+[rpc]
+# TCP or UNIX socket address for the RPC server to listen on
+laddr = "tcp://127.0.0.1:26657"
+max_open_connections = 2000
+cors_allowed_origins = []
+`,
+		},
+		{
+			name:      "missing keys join their table",
+			overrides: "moniker = \"node0\"\n\n[rpc]\ntimeout_broadcast_tx_commit = \"5s\"\n",
+			want: `# This is a TOML config file.
+proxy_app = "tcp://127.0.0.1:26658"
+log_level = "info"
+moniker = "node0"
+
+[rpc]
+# TCP or UNIX socket address for the RPC server to listen on
+laddr = "tcp://127.0.0.1:26657"
+max_open_connections = 900
+cors_allowed_origins = []
+timeout_broadcast_tx_commit = "5s"
+`,
+		},
+		{
+			name:      "missing tables are appended",
+			overrides: "[mempool]\nsize = 10000\nrecheck = false\n\n[consensus.sub]\nx = [1, 2]\n",
+			want: testConfigTOML + `
+[consensus.sub]
+x = [1, 2]
+
+[mempool]
+recheck = false
+size = 10000
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Merge("config.toml", []byte(testConfigTOML), []byte(tt.overrides))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+
+			// The result parses and merging again changes nothing.
+			again, err := Merge("config.toml", got, []byte(tt.overrides))
+			require.NoError(t, err)
+			assert.Equal(t, string(got), string(again))
+		})
+	}
+}
+
+func TestMerge_Errors(t *testing.T) {
+	_, err := Merge("app.toml", []byte(testAppTOML), []byte("[api\nenable = true"))
+	assert.ErrorContains(t, err, "invalid app.toml")
+
+	multiline := "[p2p]\nseeds = [\n  \"a\",\n]\n"
+	_, err = Merge("config.toml", []byte(multiline), []byte("[p2p]\nseeds = [\"b\"]\n"))
+	assert.ErrorContains(t, err, "config.toml:p2p.seeds: multi-line values are not supported")
+}
+
+func TestMergeFile(t *testing.T) {
+	home := writeHome(t)
+
+	require.NoError(t, MergeFile(home, "app.toml", []byte("minimum-gas-prices = \"0stake\"\npruning = \"everything\"\n[api]\nenable = true\n")))
+
+	app, err := os.ReadFile(filepath.Join(home, "config", "app.toml"))
+	require.NoError(t, err)
+	assert.Equal(t, `minimum-gas-prices = "0stake"
+pruning = "everything"
+
+[api]
+enable = true
+`, string(app))
+
+	assert.Error(t, MergeFile(t.TempDir(), "app.toml", []byte("x = 1")))
+}
+
+func TestValidateNodeConfigs(t *testing.T) {
+	assert.NoError(t, ValidateNodeConfigs([]types.NodeConfig{
+		{Index: 0, ConfigToml: "[mempool]\nsize = 10000"},
+		{Index: 2, AppToml: `pruning = "everything"`},
+	}, 3))
+
+	err := ValidateNodeConfigs([]types.NodeConfig{
+		{Index: 3, AppToml: `pruning = "everything"`},
+		{Index: 0, ConfigToml: "[mempool"},
+		{Index: 1},
+		{Index: 1},
+	}, 3)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "node 3: index out of range (devnet has 3 nodes)")
+	assert.ErrorContains(t, err, "node 0: invalid config.toml")
+	assert.ErrorContains(t, err, "node 1: config given more than once")
+}
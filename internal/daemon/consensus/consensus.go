@@ -0,0 +1,377 @@
+// internal/daemon/consensus/consensus.go
+
+// Package consensus diagnoses stalled devnets. It collects each node's
+// status and the consensus round state (/dump_consensus_state), works out
+// which validators prevoted and precommitted in the current round, and
+// ranks likely causes of a stall, such as too little voting power online
+// or nodes that computed different app hashes, with suggested fixes.
+package consensus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/cometrpc"
+)
+
+// DefaultStallAfter is how old the latest block may be before the chain is
+// considered stalled.
+const DefaultStallAfter = 30 * time.Second
+
+// Confidence levels of a hypothesis.
+const (
+	ConfidenceHigh   = "high"
+	ConfidenceMedium = "medium"
+	ConfidenceLow    = "low"
+)
+
+// Vote states of a validator in the current round.
+const (
+	VoteBlock   = "block"   // voted for a block
+	VoteNil     = "nil"     // voted nil
+	VoteMissing = "missing" // no vote received
+)
+
+// Node is a devnet node to query.
+type Node struct {
+	Index int
+	// Name is the node's display name, e.g. "validator-0".
+	Name string
+	// RPCURL is the CometBFT RPC base URL.
+	RPCURL string
+}
+
+// NodeState is a node's view of the chain.
+type NodeState struct {
+	Node
+	Height          int64
+	LatestBlockTime time.Time
+	CatchingUp      bool
+	// ValidatorAddress is the node's consensus address; it is only a
+	// validator if that address is in the validator set.
+	ValidatorAddress string
+	// AppHash is the node's app hash at Report.CompareHeight.
+	AppHash string
+	// Error is set when the node's RPC could not be queried.
+	Error string
+}
+
+// Validator is a validator's participation in the current round.
+type Validator struct {
+	Address     string
+	Name        string
+	VotingPower int64
+	Proposer    bool
+	Prevote     string
+	Precommit   string
+	// SignedLastBlock reports whether the validator's precommit is in the
+	// commit of the latest block, i.e. it is voting.
+	SignedLastBlock bool
+}
+
+// Hypothesis is a possible cause of a stall.
+type Hypothesis struct {
+	Title      string
+	Confidence string
+	Evidence   []string
+	Fixes      []string
+
+	score int
+}
+
+// Report is the result of a diagnosis.
+type Report struct {
+	Nodes []NodeState
+	// ObservedBy is the name of the node whose round state is reported.
+	ObservedBy string
+	Height     int64
+	Round      int32
+	Step       string
+	Validators []Validator
+	// CompareHeight is the height at which the nodes' app hashes were
+	// compared: the lowest height all reachable nodes have.
+	CompareHeight   int64
+	LatestBlockTime time.Time
+	Stalled         bool
+	// Hypotheses are ordered from most to least likely.
+	Hypotheses []Hypothesis
+}
+
+// Config configures a Diagnoser.
+type Config struct {
+	// HTTPClient is used for queries. Defaults to a client with a 10s timeout.
+	HTTPClient *http.Client
+	// StallAfter is how old the latest block may be before the chain is
+	// considered stalled. Defaults to DefaultStallAfter.
+	StallAfter time.Duration
+}
+
+// Diagnoser collects consensus state from a devnet's nodes.
+type Diagnoser struct {
+	client     *http.Client
+	stallAfter time.Duration
+	now        func() time.Time
+}
+
+// NewDiagnoser creates a new Diagnoser.
+func NewDiagnoser(cfg Config) *Diagnoser {
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	stallAfter := cfg.StallAfter
+	if stallAfter <= 0 {
+		stallAfter = DefaultStallAfter
+	}
+	return &Diagnoser{client: httpClient, stallAfter: stallAfter, now: time.Now}
+}
+
+// Diagnose queries every node and reports the consensus state of the
+// devnet with hypotheses for why it is stalled. Suggested fixes refer to
+// the devnet by devnetName. Unreachable nodes are part of the report, not
+// errors.
+func (d *Diagnoser) Diagnose(ctx context.Context, devnetName string, nodes []Node) *Report {
+	report := &Report{Nodes: make([]NodeState, len(nodes))}
+
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		wg.Add(1)
+		go func(i int, node Node) {
+			defer wg.Done()
+			report.Nodes[i] = d.nodeStatus(ctx, node)
+		}(i, node)
+	}
+	wg.Wait()
+
+	// Read the round state from the most advanced reachable node, falling
+	// back to the others.
+	order := make([]int, 0, len(nodes))
+	for i, n := range report.Nodes {
+		if n.Error == "" {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return report.Nodes[order[a]].Height > report.Nodes[order[b]].Height
+	})
+	for _, i := range order {
+		if err := d.roundState(ctx, report, &report.Nodes[i]); err == nil {
+			break
+		}
+	}
+	if len(order) > 0 {
+		report.LatestBlockTime = report.Nodes[order[0]].LatestBlockTime
+		report.Stalled = d.now().Sub(report.LatestBlockTime) > d.stallAfter
+	}
+
+	d.compareAppHashes(ctx, report)
+	report.Hypotheses = hypothesize(report, devnetName, d.stallAfter, d.now())
+	return report
+}
+
+// nodeStatus queries a node's /status.
+func (d *Diagnoser) nodeStatus(ctx context.Context, node Node) NodeState {
+	state := NodeState{Node: node}
+	var status struct {
+		SyncInfo struct {
+			LatestBlockHeight jsonInt   `json:"latest_block_height"`
+			LatestBlockTime   time.Time `json:"latest_block_time"`
+			CatchingUp        bool      `json:"catching_up"`
+		} `json:"sync_info"`
+		ValidatorInfo struct {
+			Address string `json:"address"`
+		} `json:"validator_info"`
+	}
+	if err := cometrpc.Get(ctx, d.client, node.RPCURL, "/status", &status); err != nil {
+		state.Error = err.Error()
+		return state
+	}
+	state.Height = int64(status.SyncInfo.LatestBlockHeight)
+	state.LatestBlockTime = status.SyncInfo.LatestBlockTime
+	state.CatchingUp = status.SyncInfo.CatchingUp
+	state.ValidatorAddress = strings.ToUpper(status.ValidatorInfo.Address)
+	return state
+}
+
+// roundState reads the consensus round state from node into report.
+func (d *Diagnoser) roundState(ctx context.Context, report *Report, node *NodeState) error {
+	var dump struct {
+		RoundState struct {
+			Height     jsonInt `json:"height"`
+			Round      int32   `json:"round"`
+			Step       jsonInt `json:"step"`
+			Validators struct {
+				Validators []struct {
+					Address     string  `json:"address"`
+					VotingPower jsonInt `json:"voting_power"`
+				} `json:"validators"`
+				Proposer struct {
+					Address string `json:"address"`
+				} `json:"proposer"`
+			} `json:"validators"`
+			Votes []struct {
+				Round      int32    `json:"round"`
+				Prevotes   []string `json:"prevotes"`
+				Precommits []string `json:"precommits"`
+			} `json:"votes"`
+		} `json:"round_state"`
+	}
+	if err := cometrpc.Get(ctx, d.client, node.RPCURL, "/dump_consensus_state", &dump); err != nil {
+		return err
+	}
+	rs := dump.RoundState
+
+	report.ObservedBy = node.Name
+	report.Height = int64(rs.Height)
+	report.Round = rs.Round
+	report.Step = stepName(int(rs.Step))
+
+	names := make(map[string]string)
+	for _, n := range report.Nodes {
+		if n.ValidatorAddress != "" {
+			names[n.ValidatorAddress] = n.Name
+		}
+	}
+
+	var prevotes, precommits []string
+	for _, v := range rs.Votes {
+		if v.Round == rs.Round {
+			prevotes, precommits = v.Prevotes, v.Precommits
+		}
+	}
+
+	signed := d.lastCommitSigners(ctx, node.RPCURL)
+
+	report.Validators = nil
+	for i, v := range rs.Validators.Validators {
+		address := strings.ToUpper(v.Address)
+		report.Validators = append(report.Validators, Validator{
+			Address:         address,
+			Name:            names[address],
+			VotingPower:     int64(v.VotingPower),
+			Proposer:        strings.EqualFold(v.Address, rs.Validators.Proposer.Address),
+			Prevote:         voteAt(prevotes, i),
+			Precommit:       voteAt(precommits, i),
+			SignedLastBlock: signed[address],
+		})
+	}
+	return nil
+}
+
+// blockIDFlagCommit is the CometBFT block ID flag of a commit signature
+// for the block, as opposed to an absent or nil vote.
+const blockIDFlagCommit = 2
+
+// lastCommitSigners returns the uppercase consensus addresses of the
+// validators whose precommits are in the commit of the latest block. It
+// returns none if the commit cannot be read.
+func (d *Diagnoser) lastCommitSigners(ctx context.Context, rpcURL string) map[string]bool {
+	var commit struct {
+		SignedHeader struct {
+			Commit struct {
+				Signatures []struct {
+					BlockIDFlag      jsonInt `json:"block_id_flag"`
+					ValidatorAddress string  `json:"validator_address"`
+				} `json:"signatures"`
+			} `json:"commit"`
+		} `json:"signed_header"`
+	}
+	if err := cometrpc.Get(ctx, d.client, rpcURL, "/commit", &commit); err != nil {
+		return nil
+	}
+	signed := make(map[string]bool)
+	for _, sig := range commit.SignedHeader.Commit.Signatures {
+		if sig.BlockIDFlag == blockIDFlagCommit {
+			signed[strings.ToUpper(sig.ValidatorAddress)] = true
+		}
+	}
+	return signed
+}
+
+// compareAppHashes fetches every reachable node's app hash at the lowest
+// height they all have.
+func (d *Diagnoser) compareAppHashes(ctx context.Context, report *Report) {
+	for _, n := range report.Nodes {
+		if n.Error == "" && n.Height > 0 && (report.CompareHeight == 0 || n.Height < report.CompareHeight) {
+			report.CompareHeight = n.Height
+		}
+	}
+	if report.CompareHeight == 0 {
+		return
+	}
+
+	var wg sync.WaitGroup
+	for i := range report.Nodes {
+		n := &report.Nodes[i]
+		if n.Error != "" {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var block struct {
+				Block struct {
+					Header struct {
+						AppHash string `json:"app_hash"`
+					} `json:"header"`
+				} `json:"block"`
+			}
+			path := "/block?height=" + strconv.FormatInt(report.CompareHeight, 10)
+			if err := cometrpc.Get(ctx, d.client, n.RPCURL, path, &block); err == nil {
+				n.AppHash = strings.ToUpper(block.Block.Header.AppHash)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// voteAt returns the vote state of validator i from a round's votes, which
+// CometBFT renders as "nil-Vote" for a missing vote and otherwise as
+// "Vote{idx:addr height/round/type(Type) hash ...}", where hash is
+// 000000000000 for a nil vote.
+func voteAt(votes []string, i int) string {
+	if i >= len(votes) || !strings.HasPrefix(votes[i], "Vote{") {
+		return VoteMissing
+	}
+	fields := strings.Fields(strings.TrimPrefix(votes[i], "Vote{"))
+	if len(fields) < 3 {
+		return VoteMissing
+	}
+	if strings.Trim(fields[2], "0") == "" {
+		return VoteNil
+	}
+	return VoteBlock
+}
+
+// stepName returns the name of a CometBFT round step.
+func stepName(step int) string {
+	names := []string{"", "NewHeight", "NewRound", "Propose", "Prevote", "PrevoteWait", "Precommit", "PrecommitWait", "Commit"}
+	if step > 0 && step < len(names) {
+		return names[step]
+	}
+	return fmt.Sprintf("Step(%d)", step)
+}
+
+// jsonInt decodes integers that CometBFT renders either as numbers or as
+// strings.
+type jsonInt int64
+
+func (n *jsonInt) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*n = 0
+		return nil
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*n = jsonInt(v)
+	return nil
+}
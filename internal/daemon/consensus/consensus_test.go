@@ -0,0 +1,198 @@
+// internal/daemon/consensus/consensus_test.go
+package consensus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+// fakeNode is a node's answers to the RPC endpoints the Diagnoser uses.
+type fakeNode struct {
+	address    string
+	height     int64
+	blockTime  time.Time
+	appHash    string
+	prevotes   []string
+	precommits []string
+	round      int32
+	proposer   string
+	signers    []string
+}
+
+// validators is the validator set of the fake chain, 10 power each.
+var validators = []string{"AAAA", "BBBB", "CCCC", "DDDD"}
+
+func (f *fakeNode) serve(t *testing.T) string {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var result any
+		switch r.URL.Path {
+		case "/status":
+			result = map[string]any{
+				"sync_info": map[string]any{
+					"latest_block_height": fmt.Sprint(f.height),
+					"latest_block_time":   f.blockTime,
+					"catching_up":         false,
+				},
+				"validator_info": map[string]any{"address": f.address},
+			}
+		case "/dump_consensus_state":
+			var vals []map[string]any
+			for _, v := range validators {
+				vals = append(vals, map[string]any{"address": v, "voting_power": "10"})
+			}
+			result = map[string]any{"round_state": map[string]any{
+				"height":     fmt.Sprint(f.height + 1),
+				"round":      f.round,
+				"step":       4,
+				"validators": map[string]any{"validators": vals, "proposer": map[string]any{"address": f.proposer}},
+				"votes": []map[string]any{
+					{"round": f.round, "prevotes": f.prevotes, "precommits": f.precommits},
+				},
+			}}
+		case "/commit":
+			var sigs []map[string]any
+			for _, v := range validators {
+				flag := 1 // absent
+				for _, s := range f.signers {
+					if s == v {
+						flag = 2
+					}
+				}
+				sigs = append(sigs, map[string]any{"block_id_flag": flag, "validator_address": v})
+			}
+			result = map[string]any{"signed_header": map[string]any{"commit": map[string]any{"signatures": sigs}}}
+		case "/block":
+			result = map[string]any{"block": map[string]any{"header": map[string]any{"app_hash": f.appHash}}}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": -1, "result": result})
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func vote(i int, hash string) string {
+	return fmt.Sprintf("Vote{%d:%s 11/02/SIGNED_MSG_TYPE_PREVOTE(Prevote) %s 5F3A1B2C3D4E 000000000000 @ 2026-05-01T11:58:00Z}", i, validators[i], hash)
+}
+
+func newTestDiagnoser() *Diagnoser {
+	d := NewDiagnoser(Config{})
+	d.now = func() time.Time { return now }
+	return d
+}
+
+func downURL(t *testing.T) string {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+	return srv.URL
+}
+
+func titles(r *Report) []string {
+	var out []string
+	for _, h := range r.Hypotheses {
+		out = append(out, h.Confidence+": "+h.Title)
+	}
+	return out
+}
+
+func TestDiagnose_InsufficientVotingPower(t *testing.T) {
+	stalled := now.Add(-2 * time.Minute)
+	prevotes := []string{vote(0, "8B01023386C3"), vote(1, "8B01023386C3"), "nil-Vote", "nil-Vote"}
+	precommits := []string{"nil-Vote", "nil-Vote", "nil-Vote", "nil-Vote"}
+	node := func(i int) *fakeNode {
+		return &fakeNode{address: validators[i], height: 10, blockTime: stalled, appHash: "APPHASH", prevotes: prevotes, precommits: precommits, round: 2, proposer: "CCCC"}
+	}
+
+	r := newTestDiagnoser().Diagnose(context.Background(), "alpha", []Node{
+		{Index: 0, Name: "validator-0", RPCURL: node(0).serve(t)},
+		{Index: 1, Name: "validator-1", RPCURL: node(1).serve(t)},
+		{Index: 2, Name: "validator-2", RPCURL: downURL(t)},
+		{Index: 3, Name: "validator-3", RPCURL: downURL(t)},
+	})
+
+	assert.True(t, r.Stalled)
+	assert.Equal(t, int64(11), r.Height)
+	assert.Equal(t, int32(2), r.Round)
+	assert.Equal(t, "Prevote", r.Step)
+	assert.Equal(t, "validator-0", r.ObservedBy)
+	require.Len(t, r.Validators, 4)
+	assert.Equal(t, Validator{Address: "AAAA", Name: "validator-0", VotingPower: 10, Prevote: VoteBlock, Precommit: VoteMissing}, r.Validators[0])
+	assert.True(t, r.Validators[2].Proposer)
+	assert.Equal(t, VoteMissing, r.Validators[2].Prevote)
+
+	assert.Equal(t, []string{
+		"high: Not enough voting power online",
+		"low: The current proposer is offline",
+	}, titles(r))
+	assert.Contains(t, r.Hypotheses[0].Evidence[0], "online voting power is 20 of 40 (50.0%)")
+	assert.Contains(t, r.Hypotheses[0].Fixes, "Start validator-2: dvb node start alpha validator-2")
+}
+
+func TestDiagnose_AppHashMismatch(t *testing.T) {
+	stalled := now.Add(-time.Minute)
+	prevotes := []string{vote(0, "000000000000"), vote(1, "000000000000"), vote(2, "8B01023386C3"), vote(3, "000000000000")}
+	precommits := []string{"nil-Vote", "nil-Vote", "nil-Vote", "nil-Vote"}
+	var nodes []Node
+	for i, hash := range []string{"GOOD", "GOOD", "BAD", "GOOD"} {
+		f := &fakeNode{address: validators[i], height: 10, blockTime: stalled, appHash: hash, prevotes: prevotes, precommits: precommits, proposer: "CCCC"}
+		nodes = append(nodes, Node{Index: i, Name: fmt.Sprintf("validator-%d", i), RPCURL: f.serve(t)})
+	}
+
+	r := newTestDiagnoser().Diagnose(context.Background(), "alpha", nodes)
+
+	assert.Equal(t, []string{
+		"high: Nodes computed different app hashes",
+		"medium: Validators are rejecting the proposed block",
+	}, titles(r))
+	assert.Equal(t, []string{
+		"at height 10, validator-0, validator-1, validator-3: app hash GOOD",
+		"at height 10, validator-2: app hash BAD",
+	}, r.Hypotheses[0].Evidence)
+	assert.True(t, strings.HasSuffix(r.Hypotheses[0].Fixes[0], "dvb logs alpha validator-2"))
+}
+
+func TestDiagnose_Healthy(t *testing.T) {
+	votes := []string{vote(0, "8B01023386C3"), vote(1, "8B01023386C3"), vote(2, "8B01023386C3"), vote(3, "8B01023386C3")}
+	f := &fakeNode{address: "AAAA", height: 10, blockTime: now.Add(-2 * time.Second), appHash: "GOOD", prevotes: votes, precommits: votes, signers: []string{"AAAA", "BBBB", "DDDD"}}
+
+	r := newTestDiagnoser().Diagnose(context.Background(), "alpha", []Node{{Index: 0, Name: "validator-0", RPCURL: f.serve(t)}})
+
+	assert.False(t, r.Stalled)
+	assert.Empty(t, r.Hypotheses)
+
+	var signed []string
+	for _, v := range r.Validators {
+		if v.SignedLastBlock {
+			signed = append(signed, v.Address)
+		}
+	}
+	assert.Equal(t, []string{"AAAA", "BBBB", "DDDD"}, signed)
+}
+
+func TestDiagnose_Unreachable(t *testing.T) {
+	r := newTestDiagnoser().Diagnose(context.Background(), "alpha", []Node{{Index: 0, Name: "validator-0", RPCURL: downURL(t)}})
+
+	assert.Equal(t, []string{"high: No node answers on its RPC port"}, titles(r))
+}
+
+func TestVoteAt(t *testing.T) {
+	votes := []string{vote(0, "8B01023386C3"), vote(1, "000000000000"), "nil-Vote"}
+	assert.Equal(t, VoteBlock, voteAt(votes, 0))
+	assert.Equal(t, VoteNil, voteAt(votes, 1))
+	assert.Equal(t, VoteMissing, voteAt(votes, 2))
+	assert.Equal(t, VoteMissing, voteAt(votes, 3))
+}
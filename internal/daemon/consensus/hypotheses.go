@@ -0,0 +1,218 @@
+// internal/daemon/consensus/hypotheses.go
+package consensus
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// hypothesize ranks the likely causes of a stall, most likely first.
+func hypothesize(r *Report, devnetName string, stallAfter time.Duration, now time.Time) []Hypothesis {
+	var out []Hypothesis
+	add := func(score int, title string, evidence, fixes []string) {
+		out = append(out, Hypothesis{Title: title, Evidence: evidence, Fixes: fixes, score: score})
+	}
+
+	var reachable, unreachable []NodeState
+	for _, n := range r.Nodes {
+		if n.Error == "" {
+			reachable = append(reachable, n)
+		} else {
+			unreachable = append(unreachable, n)
+		}
+	}
+	if len(r.Nodes) > 0 && len(reachable) == 0 {
+		var evidence []string
+		for _, n := range unreachable {
+			evidence = append(evidence, fmt.Sprintf("%s: %s", n.Name, n.Error))
+		}
+		add(100, "No node answers on its RPC port", evidence, []string{
+			"Check that the devnet's nodes are running: dvb status " + devnetName,
+			"Check a node's logs for why it exited: dvb logs " + devnetName + " " + r.Nodes[0].Name,
+		})
+		return rank(out)
+	}
+
+	if h, ok := appHashMismatch(r, devnetName); ok {
+		add(95, "Nodes computed different app hashes", h.Evidence, h.Fixes)
+	}
+
+	var total, online, voted, nilPower int64
+	reachableAddr := make(map[string]bool)
+	for _, n := range reachable {
+		reachableAddr[n.ValidatorAddress] = true
+	}
+	for _, v := range r.Validators {
+		total += v.VotingPower
+		hasVoted := v.Prevote != VoteMissing || v.Precommit != VoteMissing
+		if hasVoted || reachableAddr[v.Address] {
+			online += v.VotingPower
+		}
+		if hasVoted {
+			voted += v.VotingPower
+		}
+		if v.Prevote == VoteNil {
+			nilPower += v.VotingPower
+		}
+	}
+
+	if r.Stalled && total > 0 {
+		if online*3 <= total*2 {
+			evidence := []string{fmt.Sprintf("online voting power is %d of %d (%s); more than 2/3 is needed to commit blocks",
+				online, total, percent(online, total))}
+			var fixes []string
+			for _, n := range unreachable {
+				evidence = append(evidence, fmt.Sprintf("%s is unreachable: %s", n.Name, n.Error))
+				fixes = append(fixes, fmt.Sprintf("Start %s: dvb node start %s %s", n.Name, devnetName, n.Name))
+			}
+			for _, v := range r.Validators {
+				if v.Name == "" && v.Prevote == VoteMissing && v.Precommit == VoteMissing {
+					evidence = append(evidence, fmt.Sprintf("validator %s (power %d, %s) has not voted", shortHash(v.Address), v.VotingPower, percent(v.VotingPower, total)))
+				}
+			}
+			if len(unreachable) > 0 {
+				fixes = append(fixes, fmt.Sprintf("Check why the nodes stopped: dvb logs %s %s", devnetName, unreachable[0].Name))
+			}
+			fixes = append(fixes, "Once enough validators are back, consensus resumes on its own")
+			add(90, "Not enough voting power online", evidence, fixes)
+		} else if voted*3 <= total*2 {
+			var evidence, fixes []string
+			for _, v := range r.Validators {
+				if v.Name != "" && v.Prevote == VoteMissing && v.Precommit == VoteMissing {
+					evidence = append(evidence, fmt.Sprintf("%s is running but none of its votes for round %d arrived", v.Name, r.Round))
+					if len(fixes) == 0 {
+						fixes = append(fixes, fmt.Sprintf("Check the node's logs: dvb logs %s %s", devnetName, v.Name))
+					}
+				}
+			}
+			evidence = append(evidence, fmt.Sprintf("votes with %s of the voting power were received", percent(voted, total)))
+			fixes = append(fixes,
+				"Check that the validators are connected to each other: dvb net peers "+devnetName,
+				"A validator whose priv_validator_key.json does not match the genesis validator set never votes",
+			)
+			add(70, "Validators are running but their votes are not arriving", evidence, fixes)
+		}
+
+		if nilPower*3 >= total {
+			add(60, "Validators are rejecting the proposed block", []string{
+				fmt.Sprintf("validators with %s of the voting power prevoted nil in round %d", percent(nilPower, total), r.Round),
+			}, []string{
+				"Look for \"prevote nil\" or \"invalid proposal\" in the logs: dvb logs " + devnetName + " " + firstName(r),
+				"Nodes running different binary versions disagree on block validity; make sure every node runs the same version",
+				"A proposal that arrives after timeout_propose is also prevoted nil; check block sizes and node load",
+			})
+		}
+
+		for _, v := range r.Validators {
+			if !v.Proposer || reachableAddr[v.Address] {
+				continue
+			}
+			name := v.Name
+			if name == "" {
+				name = "validator " + shortHash(v.Address)
+			}
+			add(40, "The current proposer is offline", []string{
+				fmt.Sprintf("the round %d proposer, %s, is not reachable; each round waits for timeout_propose before moving to the next proposer", r.Round, name),
+			}, []string{
+				"Start the proposer's node, or wait for the round to move on; check its state with: dvb status " + devnetName,
+			})
+		}
+	}
+
+	for _, n := range reachable {
+		if n.CatchingUp {
+			add(30, fmt.Sprintf("%s is still catching up", n.Name), []string{
+				fmt.Sprintf("%s is syncing at height %d", n.Name, n.Height),
+			}, []string{
+				"Wait for it to sync; if its height does not move, check its peers: dvb net peers " + devnetName,
+			})
+		}
+	}
+
+	if r.Stalled && len(out) == 0 {
+		evidence := []string{fmt.Sprintf("no block has been produced yet; consensus is at height %d, round %d, step %s", r.Height, r.Round, r.Step)}
+		if !r.LatestBlockTime.IsZero() {
+			evidence[0] = fmt.Sprintf("no block for %s (more than %s); consensus is at height %d, round %d, step %s",
+				now.Sub(r.LatestBlockTime).Round(time.Second), stallAfter, r.Height, r.Round, r.Step)
+		}
+		if r.Round > 0 {
+			evidence = append(evidence, fmt.Sprintf("%d rounds at this height failed to commit", r.Round))
+		}
+		add(10, "Consensus is not committing blocks for an unknown reason", evidence, []string{
+			"Check the logs of the nodes for errors: dvb logs " + devnetName + " " + firstName(r),
+			"Check peer connectivity: dvb net peers " + devnetName,
+		})
+	}
+
+	return rank(out)
+}
+
+// appHashMismatch reports nodes that disagree on the app hash at the
+// comparison height, naming the smaller group as the diverged nodes.
+func appHashMismatch(r *Report, devnetName string) (Hypothesis, bool) {
+	groups := make(map[string][]string)
+	var hashes []string
+	for _, n := range r.Nodes {
+		if n.AppHash == "" {
+			continue
+		}
+		if _, ok := groups[n.AppHash]; !ok {
+			hashes = append(hashes, n.AppHash)
+		}
+		groups[n.AppHash] = append(groups[n.AppHash], n.Name)
+	}
+	if len(groups) < 2 {
+		return Hypothesis{}, false
+	}
+	sort.SliceStable(hashes, func(i, j int) bool { return len(groups[hashes[i]]) > len(groups[hashes[j]]) })
+
+	var evidence []string
+	for _, hash := range hashes {
+		evidence = append(evidence, fmt.Sprintf("at height %d, %s: app hash %s", r.CompareHeight, strings.Join(groups[hash], ", "), shortHash(hash)))
+	}
+	diverged := groups[hashes[len(hashes)-1]][0]
+	return Hypothesis{
+		Evidence: evidence,
+		Fixes: []string{
+			"Look for \"wrong Block.Header.AppHash\" in the diverged node's logs: dvb logs " + devnetName + " " + diverged,
+			"Make sure every node runs the same binary version and genesis",
+			"Non-deterministic application code (map iteration, floats, local time) also diverges state; once fixed, redeploy the devnet",
+		},
+	}, true
+}
+
+// rank sorts hypotheses by score and sets their confidence.
+func rank(hypotheses []Hypothesis) []Hypothesis {
+	sort.SliceStable(hypotheses, func(i, j int) bool { return hypotheses[i].score > hypotheses[j].score })
+	for i := range hypotheses {
+		switch h := &hypotheses[i]; {
+		case h.score >= 80:
+			h.Confidence = ConfidenceHigh
+		case h.score >= 50:
+			h.Confidence = ConfidenceMedium
+		default:
+			h.Confidence = ConfidenceLow
+		}
+	}
+	return hypotheses
+}
+
+func percent(part, total int64) string {
+	return fmt.Sprintf("%.1f%%", float64(part)*100/float64(total))
+}
+
+func shortHash(hash string) string {
+	if len(hash) > 12 {
+		return hash[:12] + "..."
+	}
+	return hash
+}
+
+func firstName(r *Report) string {
+	if r.ObservedBy != "" {
+		return r.ObservedBy
+	}
+	return r.Nodes[0].Name
+}
@@ -257,6 +257,27 @@ func (c *DevnetController) reconcileProvisioning(ctx context.Context, devnet *ty
 		fmt.Sprintf("%d/%d nodes ready", devnet.Status.ReadyNodes, devnet.Status.Nodes),
 	)
 
+	// Bootstrap contracts and postHealthy hooks wait for the health
+	// controller to see all nodes healthy (see HookController)
+	if len(devnet.Spec.Bootstrap.Contracts) > 0 {
+		devnet.Status.Conditions = types.SetCondition(
+			devnet.Status.Conditions,
+			types.ConditionTypeBootstrapped,
+			types.ConditionUnknown,
+			types.ReasonBootstrapPending,
+			"Waiting for all nodes to be healthy",
+		)
+	}
+	if len(devnet.Spec.Hooks.PostHealthy) > 0 {
+		devnet.Status.Conditions = types.SetCondition(
+			devnet.Status.Conditions,
+			types.ConditionTypePostHealthyHooks,
+			types.ConditionUnknown,
+			types.ReasonHooksPending,
+			"Waiting for all nodes to be healthy",
+		)
+	}
+
 	// Add event
 	devnet.Status.Events = append(devnet.Status.Events, types.NewEvent(
 		types.EventTypeNormal,
@@ -306,6 +327,11 @@ func (c *DevnetController) classifyProvisioningError(err error) (reason, message
 	errStr := err.Error()
 
 	switch {
+	case strings.Contains(errStr, "plugin '") &&
+		(strings.Contains(errStr, "crashed during") || strings.Contains(errStr, "panicked during") || strings.Contains(errStr, "exited unexpectedly")):
+		return types.ReasonPluginCrashed, fmt.Sprintf("Network plugin failed: %v", err)
+	case strings.Contains(errStr, "plugin") && strings.Contains(errStr, "not found"):
+		return types.ReasonPluginNotFound, fmt.Sprintf("Network plugin not found: %v", err)
 	case strings.Contains(errStr, "image") && strings.Contains(errStr, "not found"):
 		return types.ReasonImageNotFound, fmt.Sprintf("Docker image not found: %v", err)
 	case strings.Contains(errStr, "credentials"):
@@ -314,6 +340,10 @@ func (c *DevnetController) classifyProvisioningError(err error) (reason, message
 		return types.ReasonModeNotSupported, fmt.Sprintf("Execution mode not supported: %v", err)
 	case strings.Contains(errStr, "binary") && strings.Contains(errStr, "not found"):
 		return types.ReasonBinaryNotFound, fmt.Sprintf("Binary not found: %v", err)
+	case strings.Contains(errStr, "snapshot"):
+		return types.ReasonSnapshotFailed, fmt.Sprintf("Snapshot failed: %v", err)
+	case strings.Contains(errStr, "build"):
+		return types.ReasonBuildFailed, fmt.Sprintf("Build failed: %v", err)
 	case strings.Contains(errStr, "container"):
 		return types.ReasonContainerFailed, fmt.Sprintf("Container operation failed: %v", err)
 	case strings.Contains(errStr, "network") || strings.Contains(errStr, "connection"):
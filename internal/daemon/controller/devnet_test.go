@@ -695,6 +695,31 @@ func TestDevnetController_ClassifyProvisioningError(t *testing.T) {
 			err:            fmt.Errorf("connection refused"),
 			expectedReason: types.ReasonNetworkError,
 		},
+		{
+			name:           "plugin crashed",
+			err:            fmt.Errorf("genesis patch failed: plugin 'cosmos' crashed during ModifyGenesis: panic: nil map"),
+			expectedReason: types.ReasonPluginCrashed,
+		},
+		{
+			name:           "plugin panicked",
+			err:            fmt.Errorf("plugin 'cosmos' panicked during GetGenesisModifications: network config missing"),
+			expectedReason: types.ReasonPluginCrashed,
+		},
+		{
+			name:           "plugin not found",
+			err:            fmt.Errorf("plugin 'gaia' not found in ~/.devnet-builder/plugins"),
+			expectedReason: types.ReasonPluginNotFound,
+		},
+		{
+			name:           "snapshot failed",
+			err:            fmt.Errorf("failed to download snapshot: 404"),
+			expectedReason: types.ReasonSnapshotFailed,
+		},
+		{
+			name:           "build failed",
+			err:            fmt.Errorf("failed to build gaiad v20.0.0: exit status 2"),
+			expectedReason: types.ReasonBuildFailed,
+		},
 		{
 			name:           "unknown error",
 			err:            fmt.Errorf("something went wrong"),
@@ -10,6 +10,7 @@ import (
 
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/prereq"
 )
 
 // HealthChecker abstracts health check operations for nodes.
@@ -29,6 +30,10 @@ type HealthControllerConfig struct {
 
 	// RestartPolicy defines auto-restart behavior.
 	RestartPolicy types.RestartPolicy
+
+	// MinFreeDisk is the free space, in bytes, below which a node's home
+	// directory is under disk pressure.
+	MinFreeDisk uint64
 }
 
 // DefaultHealthControllerConfig returns sensible defaults.
@@ -37,6 +42,7 @@ func DefaultHealthControllerConfig() HealthControllerConfig {
 		CheckInterval:  30 * time.Second,
 		StuckThreshold: 2 * time.Minute,
 		RestartPolicy:  types.DefaultRestartPolicy(),
+		MinFreeDisk:    1 << 30,
 	}
 }
 
@@ -54,17 +60,21 @@ type HealthController struct {
 	stopCh chan struct{}
 	// wg tracks running goroutines.
 	wg sync.WaitGroup
+
+	// statDisk measures free space for the DiskPressure condition.
+	statDisk func(path string) (prereq.DiskUsage, error)
 }
 
 // NewHealthController creates a new HealthController.
 func NewHealthController(s store.Store, checker HealthChecker, mgr *Manager, config HealthControllerConfig) *HealthController {
 	return &HealthController{
-		store:   s,
-		checker: checker,
-		manager: mgr,
-		config:  config,
-		logger:  slog.Default(),
-		stopCh:  make(chan struct{}),
+		store:    s,
+		checker:  checker,
+		manager:  mgr,
+		config:   config,
+		logger:   slog.Default(),
+		stopCh:   make(chan struct{}),
+		statDisk: prereq.StatDisk,
 	}
 }
 
@@ -105,7 +115,7 @@ func (c *HealthController) Reconcile(ctx context.Context, key string) error {
 	)
 
 	for _, node := range nodes {
-		result := c.checkNodeHealth(ctx, node)
+		result := c.checkNodeHealth(ctx, node, len(nodes)-1)
 		if result.Healthy {
 			healthyCount++
 		} else {
@@ -116,6 +126,8 @@ func (c *HealthController) Reconcile(ctx context.Context, key string) error {
 		}
 	}
 
+	c.updateHeightLag(ctx, nodes)
+
 	// Update devnet status
 	devnet.Status.ReadyNodes = healthyCount
 	devnet.Status.LastHealthCheck = time.Now()
@@ -132,13 +144,23 @@ func (c *HealthController) Reconcile(ctx context.Context, key string) error {
 			return err
 		}
 		c.logger.Error("failed to update devnet", "name", key, "error", err)
+		return nil
+	}
+
+	// Deploy bootstrap contracts and run postHealthy hooks the first time
+	// all nodes are healthy
+	if devnet.Status.Phase == types.PhaseRunning && healthyCount == len(nodes) &&
+		(BootstrapPending(devnet) || HooksPending(devnet)) &&
+		c.manager != nil && c.manager.HasController("hooks") {
+		c.manager.Enqueue("hooks", devnet.Metadata.FullName())
 	}
 
 	return nil
 }
 
-// checkNodeHealth checks a single node's health.
-func (c *HealthController) checkNodeHealth(ctx context.Context, node *types.Node) *types.HealthCheckResult {
+// checkNodeHealth checks a single node's health. expectedPeers is the
+// number of other nodes in its devnet.
+func (c *HealthController) checkNodeHealth(ctx context.Context, node *types.Node, expectedPeers int) *types.HealthCheckResult {
 	result := &types.HealthCheckResult{
 		NodeKey:   NodeKey(node.Spec.DevnetRef, node.Spec.Index),
 		CheckedAt: time.Now(),
@@ -175,6 +197,9 @@ func (c *HealthController) checkNodeHealth(ctx context.Context, node *types.Node
 			// Update failure count
 			node.Status.ConsecutiveFailures++
 			node.Status.LastHealthCheck = time.Now()
+			node.Status.Conditions = types.SetCondition(node.Status.Conditions, types.ConditionTypeSynced,
+				types.ConditionUnknown, types.ReasonHealthCheckFailed, err.Error())
+			c.updateDiskPressure(node)
 			if updateErr := c.store.UpdateNode(ctx, node); updateErr != nil {
 				c.logger.Error("failed to update node after health check error", "node", node.Metadata.Name, "error", updateErr)
 			}
@@ -185,7 +210,8 @@ func (c *HealthController) checkNodeHealth(ctx context.Context, node *types.Node
 	}
 
 	// Check for stuck chain
-	if c.isChainStuck(node) {
+	stuck := c.isChainStuck(node)
+	if stuck {
 		result.Healthy = false
 		result.Error = "chain stuck"
 		c.logger.Warn("chain appears stuck",
@@ -198,17 +224,44 @@ func (c *HealthController) checkNodeHealth(ctx context.Context, node *types.Node
 
 	// Update node health state
 	node.Status.LastHealthCheck = time.Now()
+	if result.Healthy && result.BlockHeight > node.Status.BlockHeight {
+		node.Status.BlockHeight = result.BlockHeight
+		node.Status.LastBlockTime = time.Now()
+	}
+	// Sample the height for the block rate, stuck or not
+	if c.checker != nil && result.BlockHeight > 0 {
+		node.Status.RecordHeight(result.BlockHeight, node.Status.LastHealthCheck)
+	}
+
+	// A validator whose remote signer is gone keeps following the chain
+	// but no longer signs
+	if s := result.Signer; result.Healthy && s != nil && s.Status == types.ConditionFalse {
+		result.Healthy = false
+		result.Error = s.Message
+	}
+	// A node of an EVM network must serve JSON-RPC for the right chain
+	if e := result.EVM; result.Healthy && e != nil && e.Status == types.ConditionFalse {
+		result.Healthy = false
+		result.Error = e.Message
+	}
 	if result.Healthy {
 		node.Status.ConsecutiveFailures = 0
-		if result.BlockHeight > node.Status.BlockHeight {
-			node.Status.BlockHeight = result.BlockHeight
-			node.Status.LastBlockTime = time.Now()
-		}
 	} else {
 		node.Status.ConsecutiveFailures++
 	}
 	node.Status.PeerCount = result.PeerCount
 	node.Status.CatchingUp = result.CatchingUp
+	if c.checker != nil {
+		c.updateNodeConditions(node, stuck, expectedPeers)
+		if s := result.Signer; s != nil {
+			node.Status.Conditions = types.SetCondition(node.Status.Conditions, types.ConditionTypeSignerConnected,
+				s.Status, s.Reason, s.Message)
+		}
+		if e := result.EVM; e != nil {
+			node.Status.Conditions = types.SetCondition(node.Status.Conditions, types.ConditionTypeEVMReady,
+				e.Status, e.Reason, e.Message)
+		}
+	}
 
 	if err := c.store.UpdateNode(ctx, node); err != nil {
 		c.logger.Warn("failed to update node health state",
@@ -219,6 +272,34 @@ func (c *HealthController) checkNodeHealth(ctx context.Context, node *types.Node
 	return result
 }
 
+// updateHeightLag records how far each running node is behind the highest
+// running node of its devnet. A validator that keeps lagging is slowing
+// consensus down.
+func (c *HealthController) updateHeightLag(ctx context.Context, nodes []*types.Node) {
+	var highest int64
+	for _, node := range nodes {
+		if node.Status.Phase == types.NodePhaseRunning && node.Status.BlockHeight > highest {
+			highest = node.Status.BlockHeight
+		}
+	}
+
+	for _, node := range nodes {
+		var lag int64
+		if node.Status.Phase == types.NodePhaseRunning && node.Status.BlockHeight > 0 {
+			lag = highest - node.Status.BlockHeight
+		}
+		if lag == node.Status.HeightLag {
+			continue
+		}
+		node.Status.HeightLag = lag
+		if err := c.store.UpdateNode(ctx, node); err != nil {
+			c.logger.Warn("failed to update node height lag",
+				"node", NodeKey(node.Spec.DevnetRef, node.Spec.Index),
+				"error", err)
+		}
+	}
+}
+
 // isChainStuck checks if a node's chain hasn't produced blocks recently.
 func (c *HealthController) isChainStuck(node *types.Node) bool {
 	// If we've never seen a block, can't determine if stuck
@@ -235,6 +316,64 @@ func (c *HealthController) isChainStuck(node *types.Node) bool {
 	return time.Since(node.Status.LastBlockTime) > c.config.StuckThreshold
 }
 
+// updateNodeConditions sets the Synced, PeersHealthy and DiskPressure
+// conditions from the node's latest health check.
+func (c *HealthController) updateNodeConditions(node *types.Node, stuck bool, expectedPeers int) {
+	status := &node.Status
+
+	switch {
+	case status.CatchingUp:
+		status.Conditions = types.SetCondition(status.Conditions, types.ConditionTypeSynced, types.ConditionFalse,
+			types.ReasonCatchingUp, fmt.Sprintf("Catching up at height %d", status.BlockHeight))
+	case stuck:
+		status.Conditions = types.SetCondition(status.Conditions, types.ConditionTypeSynced, types.ConditionFalse,
+			types.ReasonChainStuck, fmt.Sprintf("No new blocks since height %d at %s",
+				status.BlockHeight, status.LastBlockTime.Format(time.RFC3339)))
+	default:
+		status.Conditions = types.SetCondition(status.Conditions, types.ConditionTypeSynced, types.ConditionTrue,
+			types.ReasonSynced, fmt.Sprintf("At height %d", status.BlockHeight))
+	}
+
+	switch {
+	case expectedPeers <= 0:
+		status.Conditions = types.SetCondition(status.Conditions, types.ConditionTypePeersHealthy, types.ConditionTrue,
+			types.ReasonNoPeersExpected, "Single-node devnet")
+	case status.PeerCount == 0:
+		status.Conditions = types.SetCondition(status.Conditions, types.ConditionTypePeersHealthy, types.ConditionFalse,
+			types.ReasonNoPeers, fmt.Sprintf("No peers connected, expected %d", expectedPeers))
+	case status.PeerCount < expectedPeers:
+		status.Conditions = types.SetCondition(status.Conditions, types.ConditionTypePeersHealthy, types.ConditionFalse,
+			types.ReasonMissingPeers, fmt.Sprintf("%d/%d peers connected", status.PeerCount, expectedPeers))
+	default:
+		status.Conditions = types.SetCondition(status.Conditions, types.ConditionTypePeersHealthy, types.ConditionTrue,
+			types.ReasonPeersConnected, fmt.Sprintf("%d peers connected", status.PeerCount))
+	}
+
+	c.updateDiskPressure(node)
+}
+
+// updateDiskPressure sets the DiskPressure condition from the free space of
+// the node's home directory.
+func (c *HealthController) updateDiskPressure(node *types.Node) {
+	if node.Spec.HomeDir == "" || c.statDisk == nil {
+		return
+	}
+	usage, err := c.statDisk(node.Spec.HomeDir)
+	if err != nil {
+		node.Status.Conditions = types.SetCondition(node.Status.Conditions, types.ConditionTypeDiskPressure,
+			types.ConditionUnknown, types.ReasonDiskCheckFailed, err.Error())
+		return
+	}
+	message := fmt.Sprintf("%d MiB free on %s", usage.Available>>20, usage.Path)
+	if usage.Available < c.config.MinFreeDisk {
+		node.Status.Conditions = types.SetCondition(node.Status.Conditions, types.ConditionTypeDiskPressure,
+			types.ConditionTrue, types.ReasonLowDiskSpace, message)
+		return
+	}
+	node.Status.Conditions = types.SetCondition(node.Status.Conditions, types.ConditionTypeDiskPressure,
+		types.ConditionFalse, types.ReasonSufficientDiskSpace, message)
+}
+
 // handleCrashedNode handles a crashed node according to restart policy.
 func (c *HealthController) handleCrashedNode(ctx context.Context, node *types.Node) {
 	policy := c.config.RestartPolicy
@@ -3,11 +3,13 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/prereq"
 )
 
 // mockHealthChecker implements HealthChecker for testing.
@@ -546,3 +548,249 @@ type noopController struct{}
 func (m *noopController) Reconcile(ctx context.Context, key string) error {
 	return nil
 }
+
+func TestHealthController_NodeConditions(t *testing.T) {
+	ms := store.NewMemoryStore()
+	checker := newMockHealthChecker()
+	hc := NewHealthController(ms, checker, nil, DefaultHealthControllerConfig())
+	hc.statDisk = func(path string) (prereq.DiskUsage, error) {
+		if path == "/data/node2" {
+			return prereq.DiskUsage{Path: path, Available: 100 << 20}, nil
+		}
+		return prereq.DiskUsage{Path: path, Available: 50 << 30}, nil
+	}
+
+	ctx := context.Background()
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test-devnet"},
+		Status:   types.DevnetStatus{Phase: types.PhaseRunning, Nodes: 3},
+	}
+	if err := ms.CreateDevnet(ctx, devnet); err != nil {
+		t.Fatalf("CreateDevnet: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		node := &types.Node{
+			Metadata: types.ResourceMeta{Name: NodeKey("test-devnet", i)},
+			Spec: types.NodeSpec{
+				DevnetRef: "test-devnet",
+				Index:     i,
+				Desired:   types.NodePhaseRunning,
+				HomeDir:   fmt.Sprintf("/data/node%d", i),
+			},
+			Status: types.NodeStatus{
+				Phase:         types.NodePhaseRunning,
+				BlockHeight:   100,
+				LastBlockTime: time.Now(),
+			},
+		}
+		if err := ms.CreateNode(ctx, node); err != nil {
+			t.Fatalf("CreateNode %d: %v", i, err)
+		}
+	}
+	checker.SetResult(NodeKey("test-devnet", 0), &types.HealthCheckResult{Healthy: true, BlockHeight: 101, PeerCount: 2})
+	checker.SetResult(NodeKey("test-devnet", 1), &types.HealthCheckResult{Healthy: true, BlockHeight: 50, CatchingUp: true})
+	checker.SetResult(NodeKey("test-devnet", 2), &types.HealthCheckResult{Healthy: true, BlockHeight: 101, PeerCount: 1})
+
+	if err := hc.Reconcile(ctx, "test-devnet"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	want := []map[string]string{
+		{types.ConditionTypeSynced: types.ReasonSynced, types.ConditionTypePeersHealthy: types.ReasonPeersConnected, types.ConditionTypeDiskPressure: types.ReasonSufficientDiskSpace},
+		{types.ConditionTypeSynced: types.ReasonCatchingUp, types.ConditionTypePeersHealthy: types.ReasonNoPeers, types.ConditionTypeDiskPressure: types.ReasonSufficientDiskSpace},
+		{types.ConditionTypeSynced: types.ReasonSynced, types.ConditionTypePeersHealthy: types.ReasonMissingPeers, types.ConditionTypeDiskPressure: types.ReasonLowDiskSpace},
+	}
+	for i, reasons := range want {
+		node, err := ms.GetNode(ctx, "", "test-devnet", i)
+		if err != nil {
+			t.Fatalf("GetNode %d: %v", i, err)
+		}
+		for condType, reason := range reasons {
+			cond := types.GetCondition(node.Status.Conditions, condType)
+			if cond == nil {
+				t.Errorf("node %d: missing %s condition", i, condType)
+				continue
+			}
+			if cond.Reason != reason {
+				t.Errorf("node %d: %s reason = %q, want %q", i, condType, cond.Reason, reason)
+			}
+			if cond.LastTransitionTime.IsZero() {
+				t.Errorf("node %d: %s has no transition time", i, condType)
+			}
+		}
+	}
+
+	node, _ := ms.GetNode(ctx, "", "test-devnet", 2)
+	if !types.IsConditionTrue(node.Status.Conditions, types.ConditionTypeDiskPressure) {
+		t.Error("expected node 2 to be under disk pressure")
+	}
+	if !types.IsConditionFalse(node.Status.Conditions, types.ConditionTypePeersHealthy) {
+		t.Error("expected node 2 to have unhealthy peers")
+	}
+}
+
+func TestHealthController_SignerConnected(t *testing.T) {
+	ms := store.NewMemoryStore()
+	checker := newMockHealthChecker()
+	hc := NewHealthController(ms, checker, nil, DefaultHealthControllerConfig())
+
+	ctx := context.Background()
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test-devnet"},
+		Status:   types.DevnetStatus{Phase: types.PhaseRunning, Nodes: 1},
+	}
+	if err := ms.CreateDevnet(ctx, devnet); err != nil {
+		t.Fatalf("CreateDevnet: %v", err)
+	}
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: NodeKey("test-devnet", 0)},
+		Spec: types.NodeSpec{
+			DevnetRef:    "test-devnet",
+			Desired:      types.NodePhaseRunning,
+			RemoteSigner: &types.RemoteSigner{TMKMS: true},
+		},
+		Status: types.NodeStatus{
+			Phase:         types.NodePhaseRunning,
+			BlockHeight:   100,
+			LastBlockTime: time.Now(),
+		},
+	}
+	if err := ms.CreateNode(ctx, node); err != nil {
+		t.Fatalf("CreateNode: %v", err)
+	}
+	checker.SetResult(NodeKey("test-devnet", 0), &types.HealthCheckResult{
+		Healthy:     true,
+		BlockHeight: 101,
+		Signer: &types.SignerCheck{
+			Status:  types.ConditionFalse,
+			Reason:  types.ReasonSignerNotSigning,
+			Message: "signed none of the last 3 blocks",
+		},
+	})
+
+	if err := hc.Reconcile(ctx, "test-devnet"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	got, err := ms.GetNode(ctx, "", "test-devnet", 0)
+	if err != nil {
+		t.Fatalf("GetNode: %v", err)
+	}
+	cond := types.GetCondition(got.Status.Conditions, types.ConditionTypeSignerConnected)
+	if cond == nil || cond.Status != types.ConditionFalse || cond.Reason != types.ReasonSignerNotSigning {
+		t.Errorf("SignerConnected condition = %+v, want False/%s", cond, types.ReasonSignerNotSigning)
+	}
+	if got.Status.ConsecutiveFailures != 1 {
+		t.Errorf("ConsecutiveFailures = %d, want 1", got.Status.ConsecutiveFailures)
+	}
+	// The node still follows the chain, so it must not look stuck later
+	if got.Status.BlockHeight != 101 {
+		t.Errorf("BlockHeight = %d, want 101", got.Status.BlockHeight)
+	}
+}
+
+func TestHealthController_EVMReady(t *testing.T) {
+	ms := store.NewMemoryStore()
+	checker := newMockHealthChecker()
+	hc := NewHealthController(ms, checker, nil, DefaultHealthControllerConfig())
+
+	ctx := context.Background()
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test-devnet"},
+		Status:   types.DevnetStatus{Phase: types.PhaseRunning, Nodes: 1},
+	}
+	if err := ms.CreateDevnet(ctx, devnet); err != nil {
+		t.Fatalf("CreateDevnet: %v", err)
+	}
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: NodeKey("test-devnet", 0)},
+		Spec:     types.NodeSpec{DevnetRef: "test-devnet", Desired: types.NodePhaseRunning},
+		Status: types.NodeStatus{
+			Phase:         types.NodePhaseRunning,
+			BlockHeight:   100,
+			LastBlockTime: time.Now(),
+		},
+	}
+	if err := ms.CreateNode(ctx, node); err != nil {
+		t.Fatalf("CreateNode: %v", err)
+	}
+	checker.SetResult(NodeKey("test-devnet", 0), &types.HealthCheckResult{
+		Healthy:     true,
+		BlockHeight: 101,
+		EVM: &types.EVMCheck{
+			Status:  types.ConditionFalse,
+			Reason:  types.ReasonEVMUnreachable,
+			Message: "EVM JSON-RPC on port 8545: connection refused",
+		},
+	})
+
+	if err := hc.Reconcile(ctx, "test-devnet"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	got, err := ms.GetNode(ctx, "", "test-devnet", 0)
+	if err != nil {
+		t.Fatalf("GetNode: %v", err)
+	}
+	cond := types.GetCondition(got.Status.Conditions, types.ConditionTypeEVMReady)
+	if cond == nil || cond.Status != types.ConditionFalse || cond.Reason != types.ReasonEVMUnreachable {
+		t.Errorf("EVMReady condition = %+v, want False/%s", cond, types.ReasonEVMUnreachable)
+	}
+	if got.Status.ConsecutiveFailures != 1 {
+		t.Errorf("ConsecutiveFailures = %d, want 1", got.Status.ConsecutiveFailures)
+	}
+}
+
+func TestHealthController_BlockRateAndLag(t *testing.T) {
+	ctx := context.Background()
+	ms := store.NewMemoryStore()
+	checker := newMockHealthChecker()
+	hc := NewHealthController(ms, checker, nil, DefaultHealthControllerConfig())
+
+	if err := ms.CreateDevnet(ctx, &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test-devnet"},
+		Status:   types.DevnetStatus{Phase: types.PhaseRunning, Nodes: 2},
+	}); err != nil {
+		t.Fatalf("CreateDevnet: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		node := &types.Node{
+			Metadata: types.ResourceMeta{Name: NodeKey("test-devnet", i)},
+			Spec:     types.NodeSpec{DevnetRef: "test-devnet", Index: i, Role: "validator", Desired: types.NodePhaseRunning},
+			Status: types.NodeStatus{
+				Phase:         types.NodePhaseRunning,
+				LastBlockTime: time.Now(),
+				// A sample from a minute ago, 30 blocks back
+				HeightSamples: []types.HeightSample{{Height: 70, Time: time.Now().Add(-time.Minute)}},
+			},
+		}
+		if err := ms.CreateNode(ctx, node); err != nil {
+			t.Fatalf("CreateNode %d: %v", i, err)
+		}
+	}
+	checker.results[NodeKey("test-devnet", 0)] = &types.HealthCheckResult{Healthy: true, BlockHeight: 100}
+	checker.results[NodeKey("test-devnet", 1)] = &types.HealthCheckResult{Healthy: true, BlockHeight: 94}
+
+	if err := hc.Reconcile(ctx, "test-devnet"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	leader, _ := ms.GetNode(ctx, "", "test-devnet", 0)
+	if leader.Status.BlocksPerMinute < 29 || leader.Status.BlocksPerMinute > 31 {
+		t.Errorf("BlocksPerMinute = %f, want about 30", leader.Status.BlocksPerMinute)
+	}
+	if leader.Status.AvgBlockTime < 1900*time.Millisecond || leader.Status.AvgBlockTime > 2100*time.Millisecond {
+		t.Errorf("AvgBlockTime = %s, want about 2s", leader.Status.AvgBlockTime)
+	}
+	if leader.Status.HeightLag != 0 {
+		t.Errorf("leader HeightLag = %d, want 0", leader.Status.HeightLag)
+	}
+
+	lagging, _ := ms.GetNode(ctx, "", "test-devnet", 1)
+	if lagging.Status.HeightLag != 6 {
+		t.Errorf("lagging HeightLag = %d, want 6", lagging.Status.HeightLag)
+	}
+	if len(lagging.Status.HeightSamples) != 2 {
+		t.Errorf("HeightSamples = %+v, want 2 samples", lagging.Status.HeightSamples)
+	}
+}
@@ -0,0 +1,223 @@
+// internal/daemon/controller/hook.go
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/bootstrap"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/hooks"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// HooksPending reports whether the devnet's postHealthy hooks are waiting
+// for all its nodes to be healthy.
+func HooksPending(devnet *types.Devnet) bool {
+	cond := types.GetCondition(devnet.Status.Conditions, types.ConditionTypePostHealthyHooks)
+	return cond != nil && cond.Reason == types.ReasonHooksPending
+}
+
+// BootstrapPending reports whether the devnet's bootstrap contracts are
+// waiting for all its nodes to be healthy.
+func BootstrapPending(devnet *types.Devnet) bool {
+	cond := types.GetCondition(devnet.Status.Conditions, types.ConditionTypeBootstrapped)
+	return cond != nil && cond.Reason == types.ReasonBootstrapPending
+}
+
+// BootstrapConfigFunc returns the configuration deploying a devnet's
+// bootstrap contracts: its chain ID, endpoints and deployer key.
+type BootstrapConfigFunc func(devnet *types.Devnet, nodes []*types.Node) (bootstrap.Config, error)
+
+// HookController deploys a devnet's bootstrap contracts and then runs its
+// postHealthy hooks, once, the first time all its nodes are healthy after
+// provisioning. In daemon mode the orchestrator returns before the nodes
+// start, so the health controller enqueues devnets whose bootstrap or hooks
+// are pending here instead. The outcomes are recorded in the Bootstrapped
+// and PostHealthyHooks conditions: a failure does not stop a devnet that is
+// already running.
+type HookController struct {
+	store           store.Store
+	runner          *hooks.Runner
+	bootstrapConfig BootstrapConfigFunc
+	dataDir         string
+	logger          *slog.Logger
+
+	mu      sync.Mutex
+	running map[string]bool
+}
+
+// NewHookController creates a new HookController. dataDir is the directory
+// holding the devnets' data directories.
+func NewHookController(s store.Store, dataDir string) *HookController {
+	return &HookController{
+		store:   s,
+		runner:  hooks.NewRunner(slog.Default()),
+		dataDir: dataDir,
+		logger:  slog.Default(),
+		running: make(map[string]bool),
+	}
+}
+
+// SetBootstrapConfig sets how the controller configures the deployment of
+// bootstrap contracts. Without it, bootstrap contracts fail to deploy.
+func (c *HookController) SetBootstrapConfig(fn BootstrapConfigFunc) {
+	c.bootstrapConfig = fn
+}
+
+// SetLogger sets the logger for the controller.
+func (c *HookController) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+	c.runner = hooks.NewRunner(logger)
+}
+
+// Reconcile deploys the bootstrap contracts and runs the postHealthy hooks
+// of the devnet with the given key ("namespace/name") if they are pending
+// and the devnet is running. Hooks are skipped if the bootstrap fails.
+func (c *HookController) Reconcile(ctx context.Context, key string) error {
+	namespace, name := parseDevnetKey(key)
+	devnet, err := c.store.GetDevnet(ctx, namespace, name)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	bootstrapPending, hooksPending := BootstrapPending(devnet), HooksPending(devnet)
+	if (!bootstrapPending && !hooksPending) || devnet.Status.Phase != types.PhaseRunning {
+		return nil
+	}
+
+	// The health controller enqueues the devnet again while its hooks run
+	c.mu.Lock()
+	if c.running[key] {
+		c.mu.Unlock()
+		return nil
+	}
+	c.running[key] = true
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		delete(c.running, key)
+		c.mu.Unlock()
+	}()
+
+	nodes, err := c.store.ListNodes(ctx, namespace, name)
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	if bootstrapPending {
+		c.logger.Info("deploying bootstrap contracts", "devnet", key, "contracts", len(devnet.Spec.Bootstrap.Contracts))
+		bootstrapErr := c.bootstrap(ctx, devnet, nodes)
+		if ctx.Err() != nil {
+			// The daemon is stopping: leave the bootstrap pending to run again
+			return ctx.Err()
+		}
+		if bootstrapErr != nil {
+			c.logger.Warn("bootstrap failed", "devnet", key, "error", bootstrapErr)
+			if err := c.record(ctx, namespace, name, types.ConditionTypeBootstrapped, types.ConditionFalse,
+				types.ReasonBootstrapFailed, bootstrapErr.Error()); err != nil || !hooksPending {
+				return err
+			}
+			return c.record(ctx, namespace, name, types.ConditionTypePostHealthyHooks, types.ConditionFalse,
+				types.ReasonHookFailed, "postHealthy hooks skipped: bootstrap failed")
+		}
+		if err := c.record(ctx, namespace, name, types.ConditionTypeBootstrapped, types.ConditionTrue,
+			types.ReasonBootstrapSucceeded, "bootstrap contracts deployed"); err != nil {
+			return err
+		}
+	}
+	if !hooksPending {
+		return nil
+	}
+
+	c.logger.Info("running postHealthy hooks", "devnet", key, "hooks", len(devnet.Spec.Hooks.PostHealthy))
+	runErr := c.run(ctx, namespace, devnet, nodes)
+	if ctx.Err() != nil {
+		// The daemon is stopping: leave the hooks pending to run again
+		return ctx.Err()
+	}
+	if runErr != nil {
+		c.logger.Warn("postHealthy hooks failed", "devnet", key, "error", runErr)
+		return c.record(ctx, namespace, name, types.ConditionTypePostHealthyHooks, types.ConditionFalse,
+			types.ReasonHookFailed, runErr.Error())
+	}
+	return c.record(ctx, namespace, name, types.ConditionTypePostHealthyHooks, types.ConditionTrue,
+		types.ReasonHooksSucceeded, "postHealthy hooks completed")
+}
+
+// bootstrap deploys the devnet's bootstrap contracts and writes their
+// addresses to its data directory.
+func (c *HookController) bootstrap(ctx context.Context, devnet *types.Devnet, nodes []*types.Node) error {
+	if c.bootstrapConfig == nil {
+		return fmt.Errorf("bootstrap is not supported by this daemon")
+	}
+	cfg, err := c.bootstrapConfig(devnet, nodes)
+	if err != nil {
+		return err
+	}
+	deployer := bootstrap.NewDeployer(cfg)
+	deployer.SetLogger(c.logger)
+	out, err := deployer.Deploy(ctx, devnet.Spec.Bootstrap)
+	if err != nil {
+		return err
+	}
+	return bootstrap.WriteOutput(filepath.Join(c.dataDir, devnet.Metadata.Name, bootstrap.OutputFile), out)
+}
+
+// run runs the devnet's postHealthy hooks.
+func (c *HookController) run(ctx context.Context, namespace string, devnet *types.Devnet, nodes []*types.Node) error {
+	list, err := hooks.FromSpec(devnet.Spec.Hooks)
+	if err != nil {
+		return err
+	}
+
+	env := hooks.Devnet{
+		Namespace: namespace,
+		Name:      devnet.Metadata.Name,
+		ChainID:   devnet.Spec.ChainID,
+		Network:   devnet.Spec.Plugin,
+		DataDir:   filepath.Join(c.dataDir, devnet.Metadata.Name),
+		Nodes:     nodes,
+	}
+	if len(nodes) > 0 {
+		env.BinaryPath = nodes[0].Spec.BinaryPath
+		if env.ChainID == "" {
+			env.ChainID = nodes[0].Spec.ChainID
+		}
+	}
+	return c.runner.Run(ctx, hooks.PostHealthy, list, env)
+}
+
+// record sets a condition of the devnet, with an event, retrying updates
+// that conflict with the health controller's.
+func (c *HookController) record(ctx context.Context, namespace, name, condType, status, reason, message string) error {
+	eventType := types.EventTypeNormal
+	if status == types.ConditionFalse {
+		eventType = types.EventTypeWarning
+	}
+
+	var err error
+	for attempt := 0; attempt < 3; attempt++ {
+		var devnet *types.Devnet
+		devnet, err = c.store.GetDevnet(ctx, namespace, name)
+		if err != nil {
+			if store.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		devnet.Status.Conditions = types.SetCondition(devnet.Status.Conditions, condType, status, reason, message)
+		devnet.Status.Events = append(devnet.Status.Events, types.NewEvent(eventType, reason, message, "hook-controller"))
+		devnet.Metadata.UpdatedAt = time.Now()
+		if err = c.store.UpdateDevnet(ctx, devnet); !store.IsConflict(err) {
+			return err
+		}
+	}
+	return err
+}
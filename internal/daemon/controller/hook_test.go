@@ -0,0 +1,161 @@
+// internal/daemon/controller/hook_test.go
+package controller
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/bootstrap"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+func TestHookController_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	dataDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dataDir, "test"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test"},
+		Spec: types.DevnetSpec{ChainID: "test-1", Hooks: types.Hooks{PostHealthy: []types.Hook{
+			{Name: "seed", Command: `echo "$DEVNET_CHAIN_ID $DEVNET_NODES" >> seeded`},
+			{Name: "check", Command: "exit 1"},
+		}}},
+		Status: types.DevnetStatus{Phase: types.PhaseRunning},
+	}
+	devnet.Status.Conditions = types.SetCondition(nil, types.ConditionTypePostHealthyHooks,
+		types.ConditionUnknown, types.ReasonHooksPending, "Waiting for all nodes to be healthy")
+	if err := s.CreateDevnet(ctx, devnet); err != nil {
+		t.Fatalf("CreateDevnet: %v", err)
+	}
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: "test-validator-0"},
+		Spec:     types.NodeSpec{DevnetRef: "test", Role: "validator"},
+	}
+	if err := s.CreateNode(ctx, node); err != nil {
+		t.Fatalf("CreateNode: %v", err)
+	}
+
+	c := NewHookController(s, dataDir)
+	if err := c.Reconcile(ctx, "default/test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	seeded, err := os.ReadFile(filepath.Join(dataDir, "test", "seeded"))
+	if err != nil {
+		t.Fatalf("seed hook did not run: %v", err)
+	}
+	if string(seeded) != "test-1 1\n" {
+		t.Errorf("seed hook wrote %q", seeded)
+	}
+
+	// The failure is recorded on the devnet, which keeps running
+	got, err := s.GetDevnet(ctx, "default", "test")
+	if err != nil {
+		t.Fatalf("GetDevnet: %v", err)
+	}
+	cond := types.GetCondition(got.Status.Conditions, types.ConditionTypePostHealthyHooks)
+	if cond == nil || cond.Status != types.ConditionFalse || cond.Reason != types.ReasonHookFailed {
+		t.Fatalf("condition = %+v, want False/%s", cond, types.ReasonHookFailed)
+	}
+	if !strings.Contains(cond.Message, "hook check failed") {
+		t.Errorf("condition message = %q", cond.Message)
+	}
+	if !hasEventWithReason(got.Status.Events, types.ReasonHookFailed) {
+		t.Errorf("devnet has no %s event: %+v", types.ReasonHookFailed, got.Status.Events)
+	}
+	if got.Status.Phase != types.PhaseRunning {
+		t.Errorf("phase = %s, want %s", got.Status.Phase, types.PhaseRunning)
+	}
+
+	// Hooks run once
+	if err := c.Reconcile(ctx, "default/test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	seeded, _ = os.ReadFile(filepath.Join(dataDir, "test", "seeded"))
+	if strings.Count(string(seeded), "\n") != 1 {
+		t.Errorf("hooks ran again: %q", seeded)
+	}
+}
+
+func TestHookController_WaitsForRunning(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	dataDir := t.TempDir()
+
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test"},
+		Spec:     types.DevnetSpec{Hooks: types.Hooks{PostHealthy: []types.Hook{{Command: "touch ran"}}}},
+		Status:   types.DevnetStatus{Phase: types.PhaseDegraded},
+	}
+	devnet.Status.Conditions = types.SetCondition(nil, types.ConditionTypePostHealthyHooks,
+		types.ConditionUnknown, types.ReasonHooksPending, "")
+	if err := s.CreateDevnet(ctx, devnet); err != nil {
+		t.Fatalf("CreateDevnet: %v", err)
+	}
+
+	if err := NewHookController(s, dataDir).Reconcile(ctx, "default/test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	got, _ := s.GetDevnet(ctx, "default", "test")
+	if !HooksPending(got) {
+		t.Errorf("hooks of a degraded devnet are no longer pending: %+v", got.Status.Conditions)
+	}
+}
+
+func TestHookController_BootstrapFailureSkipsHooks(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	dataDir := t.TempDir()
+
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test"},
+		Spec: types.DevnetSpec{
+			Bootstrap: types.Bootstrap{Contracts: []types.Contract{
+				{Name: "cw20", Type: "wasm", Source: "/contracts/cw20.wasm"},
+			}},
+			Hooks: types.Hooks{PostHealthy: []types.Hook{{Command: "touch ran"}}},
+		},
+		Status: types.DevnetStatus{Phase: types.PhaseRunning},
+	}
+	devnet.Status.Conditions = types.SetCondition(nil, types.ConditionTypeBootstrapped,
+		types.ConditionUnknown, types.ReasonBootstrapPending, "")
+	devnet.Status.Conditions = types.SetCondition(devnet.Status.Conditions, types.ConditionTypePostHealthyHooks,
+		types.ConditionUnknown, types.ReasonHooksPending, "")
+	if err := s.CreateDevnet(ctx, devnet); err != nil {
+		t.Fatalf("CreateDevnet: %v", err)
+	}
+
+	c := NewHookController(s, dataDir)
+	c.SetBootstrapConfig(func(*types.Devnet, []*types.Node) (bootstrap.Config, error) {
+		return bootstrap.Config{}, errors.New("network \"test\" not found")
+	})
+	if err := c.Reconcile(ctx, "default/test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	got, err := s.GetDevnet(ctx, "default", "test")
+	if err != nil {
+		t.Fatalf("GetDevnet: %v", err)
+	}
+	cond := types.GetCondition(got.Status.Conditions, types.ConditionTypeBootstrapped)
+	if cond == nil || cond.Status != types.ConditionFalse || cond.Reason != types.ReasonBootstrapFailed {
+		t.Fatalf("bootstrap condition = %+v, want False/%s", cond, types.ReasonBootstrapFailed)
+	}
+	if BootstrapPending(got) || HooksPending(got) {
+		t.Errorf("bootstrap or hooks still pending: %+v", got.Status.Conditions)
+	}
+	if _, err := os.Stat(filepath.Join(dataDir, "test", "ran")); err == nil {
+		t.Error("hooks ran after a failed bootstrap")
+	}
+	if _, err := os.Stat(filepath.Join(dataDir, "test", bootstrap.OutputFile)); err == nil {
+		t.Error("failed bootstrap wrote its output")
+	}
+}
@@ -0,0 +1,202 @@
+// internal/daemon/controller/idle.go
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/clock"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// DefaultIdleCheckInterval is how often the IdleController samples devnet
+// activity.
+const DefaultIdleCheckInterval = time.Minute
+
+// ActivitySampler samples user activity on a node.
+type ActivitySampler interface {
+	// SampleActivity samples the node's activity, counting transactions in
+	// blocks after sinceHeight.
+	SampleActivity(ctx context.Context, node *types.Node, sinceHeight int64) (*types.ActivitySample, error)
+}
+
+// idleState tracks activity of a running devnet between sweeps.
+type idleState struct {
+	lastActive time.Time
+	// heights is the last sampled block height by node index.
+	heights map[int]int64
+}
+
+// IdleController stops running devnets with an idle timeout once they have
+// seen no RPC traffic or transactions for that long. Block production alone
+// does not count as activity. Like the TTLController it runs periodic sweeps
+// instead of reconciling by key.
+type IdleController struct {
+	store    store.Store
+	sampler  ActivitySampler
+	reaper   DevnetReaper
+	interval time.Duration
+	logger   *slog.Logger
+
+	clock clock.Clock
+
+	// state is only accessed from the sweep loop.
+	state map[string]*idleState
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewIdleController creates a new IdleController.
+func NewIdleController(s store.Store, sampler ActivitySampler, reaper DevnetReaper, interval time.Duration) *IdleController {
+	if interval <= 0 {
+		interval = DefaultIdleCheckInterval
+	}
+	return &IdleController{
+		store:    s,
+		sampler:  sampler,
+		reaper:   reaper,
+		interval: interval,
+		logger:   slog.Default(),
+		clock:    clock.System,
+		state:    make(map[string]*idleState),
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// SetLogger sets the logger for the controller.
+func (c *IdleController) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+}
+
+// SetClock sets the clock the controller tells the time by.
+func (c *IdleController) SetClock(clk clock.Clock) {
+	c.clock = clk
+}
+
+// Start starts the periodic activity sweep.
+func (c *IdleController) Start(ctx context.Context) {
+	c.wg.Add(1)
+	go c.loop(ctx)
+}
+
+// Stop stops the activity sweep.
+func (c *IdleController) Stop() {
+	close(c.stopCh)
+	c.wg.Wait()
+}
+
+func (c *IdleController) loop(ctx context.Context) {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	c.sweep(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.stopCh:
+			return
+		case <-ticker.C:
+			c.sweep(ctx)
+		}
+	}
+}
+
+// sweep samples every running devnet with an idle timeout and stops those
+// that have been idle for longer than it.
+func (c *IdleController) sweep(ctx context.Context) {
+	devnets, err := c.store.ListDevnets(ctx, "")
+	if err != nil {
+		c.logger.Error("failed to list devnets for idle sweep", "error", err)
+		return
+	}
+
+	now := c.clock.Now()
+	seen := make(map[string]bool)
+	for _, devnet := range devnets {
+		if devnet.Status.Phase != types.PhaseRunning && devnet.Status.Phase != types.PhaseDegraded {
+			continue
+		}
+		timeout, err := time.ParseDuration(devnet.Spec.IdleTimeout)
+		if err != nil || timeout <= 0 {
+			continue
+		}
+
+		namespace, name := devnet.Metadata.Namespace, devnet.Metadata.Name
+		if namespace == "" {
+			namespace = types.DefaultNamespace
+		}
+		key := namespace + "/" + name
+		seen[key] = true
+
+		state, ok := c.state[key]
+		if !ok {
+			// Start the idle clock when the devnet is first seen running.
+			state = &idleState{lastActive: now, heights: make(map[int]int64)}
+			c.state[key] = state
+		}
+
+		if c.sampleDevnet(ctx, namespace, name, state) {
+			state.lastActive = now
+			continue
+		}
+
+		idle := now.Sub(state.lastActive)
+		if idle < timeout {
+			continue
+		}
+
+		c.logger.Info("devnet idle, stopping",
+			"namespace", namespace, "name", name, "idleTimeout", timeout, "lastActive", state.lastActive)
+		if err := c.reaper.StopDevnet(ctx, namespace, name, fmt.Sprintf("idle for %s", timeout)); err != nil {
+			c.logger.Error("failed to stop idle devnet", "namespace", namespace, "name", name, "error", err)
+			continue
+		}
+		delete(c.state, key)
+	}
+
+	// Forget devnets that stopped, were deleted, or lost their idle timeout.
+	for key := range c.state {
+		if !seen[key] {
+			delete(c.state, key)
+		}
+	}
+}
+
+// sampleDevnet samples the devnet's running nodes and reports whether any
+// of them saw activity. Nodes that cannot be sampled count as active so a
+// flaky RPC never stops a devnet.
+func (c *IdleController) sampleDevnet(ctx context.Context, namespace, name string, state *idleState) bool {
+	nodes, err := c.store.ListNodes(ctx, namespace, name)
+	if err != nil {
+		c.logger.Warn("failed to list nodes for idle sweep", "namespace", namespace, "name", name, "error", err)
+		return true
+	}
+
+	active := false
+	for _, node := range nodes {
+		if node.Status.Phase != types.NodePhaseRunning {
+			continue
+		}
+		sample, err := c.sampler.SampleActivity(ctx, node, state.heights[node.Spec.Index])
+		if err != nil {
+			c.logger.Debug("failed to sample node activity",
+				"namespace", namespace, "name", name, "index", node.Spec.Index, "error", err)
+			active = true
+			continue
+		}
+		state.heights[node.Spec.Index] = sample.Height
+		if sample.Active() {
+			active = true
+		}
+	}
+	return active
+}
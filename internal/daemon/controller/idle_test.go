@@ -0,0 +1,123 @@
+// internal/daemon/controller/idle_test.go
+package controller
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/clock"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// fakeSampler returns canned activity samples by devnet name.
+type fakeSampler struct {
+	samples map[string]*types.ActivitySample
+	errs    map[string]error
+	since   map[string]int64
+}
+
+func (f *fakeSampler) SampleActivity(ctx context.Context, node *types.Node, sinceHeight int64) (*types.ActivitySample, error) {
+	f.since[node.Spec.DevnetRef] = sinceHeight
+	if err := f.errs[node.Spec.DevnetRef]; err != nil {
+		return nil, err
+	}
+	return f.samples[node.Spec.DevnetRef], nil
+}
+
+// stoppingReaper records the reasons devnets are stopped for.
+type stoppingReaper struct {
+	recordingReaper
+	reasons []string
+}
+
+func (r *stoppingReaper) StopDevnet(ctx context.Context, namespace, name, reason string) error {
+	r.reasons = append(r.reasons, reason)
+	return r.recordingReaper.StopDevnet(ctx, namespace, name, reason)
+}
+
+func TestIdleController_Sweep(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := store.NewMemoryStore()
+
+	running := types.DevnetStatus{Phase: types.PhaseRunning}
+	devnets := []*types.Devnet{
+		// No idle timeout
+		{Metadata: types.ResourceMeta{Name: "always-on"}, Status: running},
+		// Idle
+		{Metadata: types.ResourceMeta{Name: "idle"}, Spec: types.DevnetSpec{IdleTimeout: "30m"}, Status: running},
+		// Receiving transactions
+		{Metadata: types.ResourceMeta{Name: "busy"}, Spec: types.DevnetSpec{IdleTimeout: "30m"}, Status: running},
+		// RPC unreachable
+		{Metadata: types.ResourceMeta{Name: "flaky"}, Spec: types.DevnetSpec{IdleTimeout: "30m"}, Status: running},
+		// Already stopped
+		{Metadata: types.ResourceMeta{Name: "stopped"}, Spec: types.DevnetSpec{IdleTimeout: "30m"}, Status: types.DevnetStatus{Phase: types.PhaseStopped}},
+	}
+	for _, d := range devnets {
+		if err := s.CreateDevnet(ctx, d); err != nil {
+			t.Fatalf("CreateDevnet: %v", err)
+		}
+		node := &types.Node{
+			Metadata: types.ResourceMeta{Name: d.Metadata.Name + "-0"},
+			Spec:     types.NodeSpec{DevnetRef: d.Metadata.Name},
+			Status:   types.NodeStatus{Phase: types.NodePhaseRunning},
+		}
+		if err := s.CreateNode(ctx, node); err != nil {
+			t.Fatalf("CreateNode: %v", err)
+		}
+	}
+
+	sampler := &fakeSampler{
+		samples: map[string]*types.ActivitySample{
+			"always-on": {Height: 10},
+			"idle":      {Height: 10},
+			"busy":      {Height: 10, Txs: 1},
+		},
+		errs:  map[string]error{"flaky": errors.New("connection refused")},
+		since: map[string]int64{},
+	}
+	reaper := &stoppingReaper{}
+	c := NewIdleController(s, sampler, reaper, time.Minute)
+	clk := clock.NewFake(now)
+	c.SetClock(clk)
+
+	// The idle clock starts on the first sweep.
+	c.sweep(ctx)
+	if len(reaper.stopped) != 0 {
+		t.Fatalf("stopped on first sweep: %v", reaper.stopped)
+	}
+	if _, ok := sampler.since["always-on"]; ok {
+		t.Error("devnet without idle timeout was sampled")
+	}
+
+	clk.Advance(31 * time.Minute)
+	c.sweep(ctx)
+
+	if want := []string{"default/idle"}; !reflect.DeepEqual(reaper.stopped, want) {
+		t.Errorf("stopped = %v, want %v", reaper.stopped, want)
+	}
+	if want := []string{"idle for 30m0s"}; !reflect.DeepEqual(reaper.reasons, want) {
+		t.Errorf("reasons = %v, want %v", reaper.reasons, want)
+	}
+	// Transactions are counted since the previously sampled height.
+	if got := sampler.since["busy"]; got != 10 {
+		t.Errorf("sinceHeight = %d, want 10", got)
+	}
+	if _, ok := c.state["default/idle"]; ok {
+		t.Error("state of stopped devnet was kept")
+	}
+}
+
+func TestIdleController_StartStop(t *testing.T) {
+	c := NewIdleController(store.NewMemoryStore(), &fakeSampler{}, &recordingReaper{}, 0)
+	if c.interval != DefaultIdleCheckInterval {
+		t.Errorf("interval = %v, want default", c.interval)
+	}
+
+	c.Start(context.Background())
+	c.Stop()
+}
@@ -7,17 +7,29 @@ import (
 	"log/slog"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/retry"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
 )
 
+// SignerLauncher runs the remote signers the daemon launches next to
+// validators, such as tmkms softsign containers. Both methods do nothing
+// for nodes without a launched signer.
+type SignerLauncher interface {
+	Start(ctx context.Context, node *types.Node) error
+	Stop(ctx context.Context, node *types.Node) error
+}
+
 // NodeController reconciles Node resources.
 // It manages the lifecycle of individual nodes within devnets.
 type NodeController struct {
 	store   store.Store
 	runtime runtime.NodeRuntime
+	signers SignerLauncher
+	retry   retry.Policy
 	logger  *slog.Logger
 }
 
@@ -35,6 +47,18 @@ func (c *NodeController) SetLogger(logger *slog.Logger) {
 	c.logger = logger
 }
 
+// SetSignerLauncher sets the launcher of remote signers. Without one, nodes
+// whose signer the daemon should launch wait for an external signer.
+func (c *NodeController) SetSignerLauncher(l SignerLauncher) {
+	c.signers = l
+}
+
+// SetStartRetry sets the policy for retrying a node that fails to start.
+// Without one, a node that fails to start is Crashed at once.
+func (c *NodeController) SetStartRetry(p retry.Policy) {
+	c.retry = p
+}
+
 // ParseNodeKey parses a node key (format: "namespace/devnetName/index" or "devnetName/index") into its components.
 // If no namespace is provided, returns default namespace.
 func ParseNodeKey(key string) (namespace, devnetName string, index int, err error) {
@@ -162,7 +186,17 @@ func (c *NodeController) reconcileStarting(ctx context.Context, node *types.Node
 		opts := runtime.StartOptions{
 			RestartPolicy: runtime.DefaultRestartPolicy(),
 		}
-		if err := c.runtime.StartNode(ctx, node, opts); err != nil {
+		err := retry.Do(ctx, c.retry, func(ctx context.Context) error {
+			return c.runtime.StartNode(ctx, node, opts)
+		}, func(attempt int, err error, wait time.Duration) {
+			c.logger.Warn("failed to start node, retrying",
+				"devnet", node.Spec.DevnetRef,
+				"index", node.Spec.Index,
+				"attempt", attempt,
+				"wait", wait,
+				"error", err)
+		})
+		if err != nil {
 			c.logger.Error("failed to start node",
 				"devnet", node.Spec.DevnetRef,
 				"index", node.Spec.Index,
@@ -174,6 +208,23 @@ func (c *NodeController) reconcileStarting(ctx context.Context, node *types.Node
 			return c.store.UpdateNode(ctx, node)
 		}
 
+		// The signer starts after the node: it connects to the node's
+		// priv_validator_laddr, and may share the node's container network
+		if c.signers != nil {
+			if err := c.signers.Start(ctx, node); err != nil {
+				c.logger.Error("failed to start remote signer",
+					"devnet", node.Spec.DevnetRef,
+					"index", node.Spec.Index,
+					"error", err)
+				_ = c.runtime.StopNode(ctx, node.Metadata.Name, false)
+
+				node.Status.Phase = types.NodePhaseCrashed
+				node.Status.Message = "Failed to start remote signer: " + err.Error()
+
+				return c.store.UpdateNode(ctx, node)
+			}
+		}
+
 		// Get and persist PID for reconnection on daemon restart
 		nodeID := node.Metadata.Name
 		status, err := c.runtime.GetNodeStatus(ctx, nodeID)
@@ -253,6 +304,14 @@ func (c *NodeController) reconcileStopping(ctx context.Context, node *types.Node
 			// Continue anyway - the node may already be stopped
 		}
 	}
+	if c.signers != nil {
+		if err := c.signers.Stop(ctx, node); err != nil {
+			c.logger.Warn("failed to stop remote signer",
+				"devnet", node.Spec.DevnetRef,
+				"index", node.Spec.Index,
+				"error", err)
+		}
+	}
 
 	// Clear PID if set
 	node.Status.PID = 0
@@ -611,3 +611,95 @@ func TestNodeController_Reconcile_StoppingWithRuntime(t *testing.T) {
 		t.Errorf("Phase = %q, want %q", got.Status.Phase, types.NodePhaseStopped)
 	}
 }
+
+// mockSignerLauncher records the nodes whose signers were started and stopped.
+type mockSignerLauncher struct {
+	started, stopped []string
+	startErr         error
+}
+
+func (m *mockSignerLauncher) Start(ctx context.Context, node *types.Node) error {
+	m.started = append(m.started, node.Metadata.Name)
+	return m.startErr
+}
+
+func (m *mockSignerLauncher) Stop(ctx context.Context, node *types.Node) error {
+	m.stopped = append(m.stopped, node.Metadata.Name)
+	return nil
+}
+
+func TestNodeController_Reconcile_RemoteSigner(t *testing.T) {
+	ctx := context.Background()
+	ms := store.NewMemoryStore()
+
+	var stopped []string
+	mock := &mockNodeRuntime{
+		stopNodeFn: func(ctx context.Context, nodeID string, graceful bool) error {
+			stopped = append(stopped, nodeID)
+			return nil
+		},
+	}
+	signers := &mockSignerLauncher{}
+	nc := NewNodeController(ms, mock)
+	nc.SetSignerLauncher(signers)
+
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: "test-0"},
+		Spec: types.NodeSpec{
+			DevnetRef:    "test",
+			Index:        0,
+			Role:         "validator",
+			RemoteSigner: &types.RemoteSigner{Index: 0, TMKMS: true},
+		},
+		Status: types.NodeStatus{Phase: types.NodePhaseStarting},
+	}
+	if err := ms.CreateNode(ctx, node); err != nil {
+		t.Fatalf("CreateNode: %v", err)
+	}
+
+	// Starting the node starts its signer
+	if err := nc.Reconcile(ctx, "test/0"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	got, _ := ms.GetNode(ctx, "", "test", 0)
+	if got.Status.Phase != types.NodePhaseRunning {
+		t.Errorf("Phase = %q, want %q", got.Status.Phase, types.NodePhaseRunning)
+	}
+	if len(signers.started) != 1 || signers.started[0] != "test-0" {
+		t.Errorf("started signers = %v, want [test-0]", signers.started)
+	}
+
+	// Stopping the node stops its signer
+	got.Status.Phase = types.NodePhaseStopping
+	if err := ms.UpdateNode(ctx, got); err != nil {
+		t.Fatalf("UpdateNode: %v", err)
+	}
+	if err := nc.Reconcile(ctx, "test/0"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if len(signers.stopped) != 1 || signers.stopped[0] != "test-0" {
+		t.Errorf("stopped signers = %v, want [test-0]", signers.stopped)
+	}
+
+	// A signer that fails to start stops the node and marks it crashed
+	stopped = nil
+	signers.startErr = fmt.Errorf("image not found")
+	got, _ = ms.GetNode(ctx, "", "test", 0)
+	got.Status.Phase = types.NodePhaseStarting
+	if err := ms.UpdateNode(ctx, got); err != nil {
+		t.Fatalf("UpdateNode: %v", err)
+	}
+	if err := nc.Reconcile(ctx, "test/0"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	got, _ = ms.GetNode(ctx, "", "test", 0)
+	if got.Status.Phase != types.NodePhaseCrashed {
+		t.Errorf("Phase = %q, want %q", got.Status.Phase, types.NodePhaseCrashed)
+	}
+	if got.Status.Message != "Failed to start remote signer: image not found" {
+		t.Errorf("Message = %q", got.Status.Message)
+	}
+	if len(stopped) != 1 || stopped[0] != "test-0" {
+		t.Errorf("stopped nodes = %v, want [test-0]", stopped)
+	}
+}
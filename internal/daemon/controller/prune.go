@@ -0,0 +1,161 @@
+// internal/daemon/controller/prune.go
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/clock"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// DefaultPruneCheckInterval is how often the PruneController looks for
+// devnets due for pruning.
+const DefaultPruneCheckInterval = 10 * time.Minute
+
+// DevnetPruner prunes the nodes of a devnet one at a time, the same as the
+// corresponding API call.
+type DevnetPruner interface {
+	PruneDevnet(ctx context.Context, namespace, name string) error
+}
+
+// PruneController prunes the nodes of long-lived running devnets on a
+// schedule, so their disk use stays bounded. Like the IdleController it
+// runs periodic sweeps instead of reconciling by key.
+type PruneController struct {
+	store    store.Store
+	pruner   DevnetPruner
+	every    time.Duration
+	minAge   time.Duration
+	interval time.Duration
+	logger   *slog.Logger
+
+	clock clock.Clock
+
+	// lastPruned is when each devnet was last pruned, or first seen
+	// running. Only accessed from the sweep loop.
+	lastPruned map[string]time.Time
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewPruneController creates a PruneController that prunes each running
+// devnet older than minAge every period.
+func NewPruneController(s store.Store, pruner DevnetPruner, every, minAge time.Duration) *PruneController {
+	return &PruneController{
+		store:      s,
+		pruner:     pruner,
+		every:      every,
+		minAge:     minAge,
+		interval:   DefaultPruneCheckInterval,
+		logger:     slog.Default(),
+		clock:      clock.System,
+		lastPruned: make(map[string]time.Time),
+		stopCh:     make(chan struct{}),
+	}
+}
+
+// SetLogger sets the logger for the controller.
+func (c *PruneController) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+}
+
+// SetClock sets the clock the controller tells the time by.
+func (c *PruneController) SetClock(clk clock.Clock) {
+	c.clock = clk
+}
+
+// Start starts the periodic pruning sweep.
+func (c *PruneController) Start(ctx context.Context) {
+	c.wg.Add(1)
+	go c.loop(ctx)
+}
+
+// Stop stops the pruning sweep.
+func (c *PruneController) Stop() {
+	close(c.stopCh)
+	c.wg.Wait()
+}
+
+func (c *PruneController) loop(ctx context.Context) {
+	defer c.wg.Done()
+
+	// Pruning waits for each node to run again; Stop cancels the wait
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-c.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	c.sweep(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.stopCh:
+			return
+		case <-ticker.C:
+			c.sweep(ctx)
+		}
+	}
+}
+
+// sweep prunes the running devnets older than minAge that were not pruned
+// in the last period. The period of a devnet starts when it is first seen
+// running, so a daemon restart does not prune every devnet at once.
+func (c *PruneController) sweep(ctx context.Context) {
+	devnets, err := c.store.ListDevnets(ctx, "")
+	if err != nil {
+		c.logger.Error("failed to list devnets for pruning sweep", "error", err)
+		return
+	}
+
+	now := c.clock.Now()
+	seen := make(map[string]bool)
+	for _, devnet := range devnets {
+		if devnet.Status.Phase != types.PhaseRunning && devnet.Status.Phase != types.PhaseDegraded {
+			continue
+		}
+
+		namespace, name := devnet.Metadata.Namespace, devnet.Metadata.Name
+		if namespace == "" {
+			namespace = types.DefaultNamespace
+		}
+		key := namespace + "/" + name
+		seen[key] = true
+
+		last, ok := c.lastPruned[key]
+		if !ok {
+			c.lastPruned[key] = now
+			continue
+		}
+		if now.Sub(last) < c.every || now.Sub(devnet.Metadata.CreatedAt) < c.minAge {
+			continue
+		}
+
+		c.logger.Info("pruning devnet on schedule", "namespace", namespace, "name", name, "lastPruned", last)
+		c.lastPruned[key] = now
+		if err := c.pruner.PruneDevnet(ctx, namespace, name); err != nil {
+			c.logger.Error("failed to prune devnet", "namespace", namespace, "name", name, "error", err)
+		}
+	}
+
+	// Forget devnets that stopped or were deleted.
+	for key := range c.lastPruned {
+		if !seen[key] {
+			delete(c.lastPruned, key)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// internal/daemon/controller/prune_test.go
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/clock"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// recordingPruner records the devnets it prunes.
+type recordingPruner struct {
+	pruned []string
+}
+
+func (p *recordingPruner) PruneDevnet(ctx context.Context, namespace, name string) error {
+	p.pruned = append(p.pruned, namespace+"/"+name)
+	return nil
+}
+
+func TestPruneController_Sweep(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	s := store.NewMemoryStore()
+
+	running := types.DevnetStatus{Phase: types.PhaseRunning}
+	for _, d := range []*types.Devnet{
+		{Metadata: types.ResourceMeta{Name: "long-lived", CreatedAt: now.Add(-72 * time.Hour)}, Status: running},
+		{Metadata: types.ResourceMeta{Name: "young", CreatedAt: now.Add(-time.Hour)}, Status: running},
+		{Metadata: types.ResourceMeta{Name: "stopped", CreatedAt: now.Add(-72 * time.Hour)}, Status: types.DevnetStatus{Phase: types.PhaseStopped}},
+	} {
+		if err := s.CreateDevnet(ctx, d); err != nil {
+			t.Fatalf("CreateDevnet: %v", err)
+		}
+	}
+
+	pruner := &recordingPruner{}
+	c := NewPruneController(s, pruner, 6*time.Hour, 24*time.Hour)
+	clk := clock.NewFake(now)
+	c.SetClock(clk)
+
+	// Devnets first seen running start their period
+	c.sweep(ctx)
+	if len(pruner.pruned) != 0 {
+		t.Fatalf("pruned on first sight: %v", pruner.pruned)
+	}
+
+	clk.Advance(time.Hour)
+	c.sweep(ctx)
+	if len(pruner.pruned) != 0 {
+		t.Fatalf("pruned within the period: %v", pruner.pruned)
+	}
+
+	clk.Advance(6 * time.Hour)
+	c.sweep(ctx)
+	if want := []string{"default/long-lived"}; !reflect.DeepEqual(pruner.pruned, want) {
+		t.Errorf("pruned = %v, want %v", pruner.pruned, want)
+	}
+
+	// The period restarts after pruning
+	clk.Advance(time.Hour)
+	c.sweep(ctx)
+	if len(pruner.pruned) != 1 {
+		t.Errorf("pruned again within the period: %v", pruner.pruned)
+	}
+}
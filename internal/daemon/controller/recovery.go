@@ -0,0 +1,188 @@
+// internal/daemon/controller/recovery.go
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// DefaultMaxProvisionResumes is how many times the RecoveryController
+// resumes a devnet's provisioning after the daemon stopped in the middle of
+// it before it fails the devnet instead. Provisioning that keeps stopping
+// the daemon, e.g. by running the host out of memory, must not do so on
+// every restart.
+const DefaultMaxProvisionResumes = 2
+
+// ProvisionCheckpoint is how far a devnet's provisioning got, as recorded
+// by the provisioner in the devnet's data directory.
+type ProvisionCheckpoint struct {
+	// Phase is the provisioning phase in progress, or empty if provisioning
+	// had not started a phase or is not in progress.
+	Phase string
+
+	// Interruptions counts the earlier attempts, since provisioning last
+	// completed, that the daemon stopped in the middle of.
+	Interruptions int
+}
+
+// ProvisionRecovery reads the checkpoints of devnets' provisioning and
+// cleans up provisioning that will not be resumed.
+type ProvisionRecovery interface {
+	ProvisionCheckpoint(devnet *types.Devnet) ProvisionCheckpoint
+	CleanupProvisioning(ctx context.Context, devnet *types.Devnet) error
+}
+
+// Enqueuer adds keys to a controller's work queue, like Manager.Enqueue.
+type Enqueuer interface {
+	Enqueue(resourceType, key string)
+}
+
+// RecoveryController recovers the devnets whose provisioning the daemon
+// stopped in the middle of. Nothing reconciles those again on its own: they
+// would stay Provisioning forever. Unlike the other controllers it runs
+// once, at startup, before the controllers process their queues.
+type RecoveryController struct {
+	store      store.Store
+	recovery   ProvisionRecovery
+	queue      Enqueuer
+	maxResumes int
+	logger     *slog.Logger
+}
+
+// NewRecoveryController creates a new RecoveryController.
+func NewRecoveryController(s store.Store, r ProvisionRecovery, q Enqueuer, maxResumes int) *RecoveryController {
+	if maxResumes <= 0 {
+		maxResumes = DefaultMaxProvisionResumes
+	}
+	return &RecoveryController{
+		store:      s,
+		recovery:   r,
+		queue:      q,
+		maxResumes: maxResumes,
+		logger:     slog.Default(),
+	}
+}
+
+// SetLogger sets the logger for the controller.
+func (c *RecoveryController) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+}
+
+// Recover requeues Pending devnets and resumes Provisioning ones, or fails
+// and cleans them up if they were interrupted too often. Each decision on a
+// Provisioning devnet is recorded as an event.
+func (c *RecoveryController) Recover(ctx context.Context) {
+	devnets, err := c.store.ListDevnets(ctx, "")
+	if err != nil {
+		c.logger.Error("failed to list devnets for provisioning recovery", "error", err)
+		return
+	}
+
+	for _, devnet := range devnets {
+		switch devnet.Status.Phase {
+		case "", types.PhasePending:
+			c.logger.Info("requeueing pending devnet", "namespace", devnet.Metadata.Namespace, "name", devnet.Metadata.Name)
+			c.enqueue(devnet)
+		case types.PhaseProvisioning:
+			checkpoint := c.recovery.ProvisionCheckpoint(devnet)
+			if checkpoint.Interruptions >= c.maxResumes {
+				c.fail(ctx, devnet, checkpoint)
+			} else {
+				c.resume(ctx, devnet, checkpoint)
+			}
+		}
+	}
+}
+
+// resume records the decision to resume provisioning and requeues the
+// devnet. Provisioning resumes after the phases it completed.
+func (c *RecoveryController) resume(ctx context.Context, devnet *types.Devnet, checkpoint ProvisionCheckpoint) {
+	message := fmt.Sprintf("Resuming provisioning interrupted by a daemon restart %s (resume %d of %d)",
+		interruptedIn(checkpoint), checkpoint.Interruptions+1, c.maxResumes)
+	c.logger.Info("resuming interrupted provisioning",
+		"namespace", devnet.Metadata.Namespace,
+		"name", devnet.Metadata.Name,
+		"phase", checkpoint.Phase,
+		"interruptions", checkpoint.Interruptions)
+
+	devnet.Status.Message = message
+	devnet.Status.Events = append(devnet.Status.Events, types.NewEvent(
+		types.EventTypeNormal,
+		types.ReasonProvisioningResumed,
+		message,
+		"recovery-controller",
+	))
+	devnet.Metadata.UpdatedAt = time.Now()
+	if err := c.store.UpdateDevnet(ctx, devnet); err != nil {
+		c.logger.Error("failed to record provisioning resume", "name", devnet.Metadata.Name, "error", err)
+	}
+	c.enqueue(devnet)
+}
+
+// fail cleans up the devnet's incomplete provisioning and marks it
+// Degraded. Starting its nodes provisions it again from scratch.
+func (c *RecoveryController) fail(ctx context.Context, devnet *types.Devnet, checkpoint ProvisionCheckpoint) {
+	message := fmt.Sprintf("Provisioning was interrupted by a daemon restart %s %d times; removed its partial nodes and files",
+		interruptedIn(checkpoint), checkpoint.Interruptions+1)
+	c.logger.Warn("failing interrupted provisioning",
+		"namespace", devnet.Metadata.Namespace,
+		"name", devnet.Metadata.Name,
+		"phase", checkpoint.Phase,
+		"interruptions", checkpoint.Interruptions)
+
+	if err := c.recovery.CleanupProvisioning(ctx, devnet); err != nil {
+		c.logger.Error("failed to clean up interrupted provisioning", "name", devnet.Metadata.Name, "error", err)
+		message = fmt.Sprintf("Provisioning was interrupted by a daemon restart %s %d times; cleaning up failed: %v",
+			interruptedIn(checkpoint), checkpoint.Interruptions+1, err)
+	}
+
+	devnet.Status.Conditions = types.SetCondition(
+		devnet.Status.Conditions,
+		types.ConditionTypeProgressing,
+		types.ConditionFalse,
+		types.ReasonProvisioningInterrupted,
+		message,
+	)
+	devnet.Status.Conditions = types.SetCondition(
+		devnet.Status.Conditions,
+		types.ConditionTypeDegraded,
+		types.ConditionTrue,
+		types.ReasonProvisioningInterrupted,
+		message,
+	)
+	devnet.Status.Events = append(devnet.Status.Events, types.NewEvent(
+		types.EventTypeWarning,
+		types.ReasonProvisioningInterrupted,
+		message,
+		"recovery-controller",
+	))
+	devnet.Status.Phase = types.PhaseDegraded
+	devnet.Status.Message = "Provisioning failed: " + message
+	devnet.Status.Nodes = 0
+	devnet.Status.ReadyNodes = 0
+	devnet.Metadata.UpdatedAt = time.Now()
+	if err := c.store.UpdateDevnet(ctx, devnet); err != nil {
+		c.logger.Error("failed to record provisioning failure", "name", devnet.Metadata.Name, "error", err)
+	}
+}
+
+func (c *RecoveryController) enqueue(devnet *types.Devnet) {
+	namespace := devnet.Metadata.Namespace
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+	c.queue.Enqueue("devnets", namespace+"/"+devnet.Metadata.Name)
+}
+
+// interruptedIn describes where provisioning was interrupted.
+func interruptedIn(checkpoint ProvisionCheckpoint) string {
+	if checkpoint.Phase == "" {
+		return "before its first phase"
+	}
+	return "in the " + checkpoint.Phase + " phase"
+}
@@ -0,0 +1,115 @@
+// internal/daemon/controller/recovery_test.go
+package controller
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// fakeProvisionRecovery returns fixed checkpoints and records cleanups.
+type fakeProvisionRecovery struct {
+	checkpoints map[string]ProvisionCheckpoint
+	cleaned     []string
+}
+
+func (f *fakeProvisionRecovery) ProvisionCheckpoint(devnet *types.Devnet) ProvisionCheckpoint {
+	return f.checkpoints[devnet.Metadata.Name]
+}
+
+func (f *fakeProvisionRecovery) CleanupProvisioning(ctx context.Context, devnet *types.Devnet) error {
+	f.cleaned = append(f.cleaned, devnet.Metadata.Name)
+	return nil
+}
+
+// recordingEnqueuer records the keys it is asked to enqueue.
+type recordingEnqueuer struct {
+	keys []string
+}
+
+func (e *recordingEnqueuer) Enqueue(resourceType, key string) {
+	e.keys = append(e.keys, resourceType+":"+key)
+}
+
+func TestRecoveryController_Recover(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+
+	devnets := []*types.Devnet{
+		{Metadata: types.ResourceMeta{Name: "running"}, Status: types.DevnetStatus{Phase: types.PhaseRunning}},
+		{Metadata: types.ResourceMeta{Name: "queued"}, Status: types.DevnetStatus{Phase: types.PhasePending}},
+		{Metadata: types.ResourceMeta{Name: "interrupted"}, Status: types.DevnetStatus{Phase: types.PhaseProvisioning}},
+		{Metadata: types.ResourceMeta{Name: "crashloop"}, Status: types.DevnetStatus{Phase: types.PhaseProvisioning, Nodes: 4}},
+	}
+	for _, d := range devnets {
+		if err := s.CreateDevnet(ctx, d); err != nil {
+			t.Fatalf("CreateDevnet: %v", err)
+		}
+	}
+
+	recovery := &fakeProvisionRecovery{checkpoints: map[string]ProvisionCheckpoint{
+		"interrupted": {Phase: "Forking", Interruptions: 1},
+		"crashloop":   {Phase: "Forking", Interruptions: 2},
+	}}
+	queue := &recordingEnqueuer{}
+	NewRecoveryController(s, recovery, queue, 2).Recover(ctx)
+
+	sort.Strings(queue.keys)
+	if want := []string{"devnets:default/interrupted", "devnets:default/queued"}; !reflect.DeepEqual(queue.keys, want) {
+		t.Errorf("enqueued = %v, want %v", queue.keys, want)
+	}
+	if want := []string{"crashloop"}; !reflect.DeepEqual(recovery.cleaned, want) {
+		t.Errorf("cleaned = %v, want %v", recovery.cleaned, want)
+	}
+
+	// Resumed: still Provisioning, with the decision as an event
+	resumed, err := s.GetDevnet(ctx, "", "interrupted")
+	if err != nil {
+		t.Fatalf("GetDevnet: %v", err)
+	}
+	if resumed.Status.Phase != types.PhaseProvisioning {
+		t.Errorf("resumed phase = %s, want %s", resumed.Status.Phase, types.PhaseProvisioning)
+	}
+	if !hasEventWithReason(resumed.Status.Events, types.ReasonProvisioningResumed) {
+		t.Errorf("resumed devnet has no %s event: %+v", types.ReasonProvisioningResumed, resumed.Status.Events)
+	}
+
+	// Failed: Degraded, with the decision as an event
+	failed, err := s.GetDevnet(ctx, "", "crashloop")
+	if err != nil {
+		t.Fatalf("GetDevnet: %v", err)
+	}
+	if failed.Status.Phase != types.PhaseDegraded {
+		t.Errorf("failed phase = %s, want %s", failed.Status.Phase, types.PhaseDegraded)
+	}
+	if failed.Status.Nodes != 0 {
+		t.Errorf("failed nodes = %d, want 0", failed.Status.Nodes)
+	}
+	cond := types.GetCondition(failed.Status.Conditions, types.ConditionTypeDegraded)
+	if cond == nil || cond.Status != types.ConditionTrue || cond.Reason != types.ReasonProvisioningInterrupted {
+		t.Errorf("failed Degraded condition = %+v", cond)
+	}
+	if !hasEventWithReason(failed.Status.Events, types.ReasonProvisioningInterrupted) {
+		t.Errorf("failed devnet has no %s event: %+v", types.ReasonProvisioningInterrupted, failed.Status.Events)
+	}
+
+	// Other phases are left alone
+	running, err := s.GetDevnet(ctx, "", "running")
+	if err != nil {
+		t.Fatalf("GetDevnet: %v", err)
+	}
+	if running.Status.Phase != types.PhaseRunning || len(running.Status.Events) != 0 {
+		t.Errorf("running devnet changed: %+v", running.Status)
+	}
+}
+
+func TestNewRecoveryController_DefaultMaxResumes(t *testing.T) {
+	c := NewRecoveryController(store.NewMemoryStore(), &fakeProvisionRecovery{}, &recordingEnqueuer{}, 0)
+	if c.maxResumes != DefaultMaxProvisionResumes {
+		t.Errorf("maxResumes = %d, want default", c.maxResumes)
+	}
+}
@@ -0,0 +1,334 @@
+// internal/daemon/controller/shutdown.go
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// DefaultShutdownTimeout bounds how long stopping a devnet's nodes takes.
+const DefaultShutdownTimeout = 30 * time.Second
+
+// finalHeightTimeout bounds the status query for a node's final height.
+const finalHeightTimeout = 2 * time.Second
+
+// NodeShutdown is how one node stopped.
+type NodeShutdown struct {
+	Name  string
+	Index int
+	Role  string
+
+	// Height is the last block height the node reported.
+	Height int64
+
+	// SignedHeight is the height in the validator's priv_validator_state,
+	// the last height it signed at (validators with a local key only).
+	SignedHeight int64
+
+	// Killed is set when the node did not exit within the timeout and had
+	// to be killed with SIGKILL.
+	Killed bool
+
+	// Err is why the validator's signing state could not be flushed.
+	Err error
+}
+
+// ShutdownReport is how a devnet's nodes stopped, in the order they did.
+type ShutdownReport struct {
+	Nodes []NodeShutdown
+}
+
+// Killed returns the names of the nodes that had to be killed.
+func (r *ShutdownReport) Killed() []string {
+	var names []string
+	for _, n := range r.Nodes {
+		if n.Killed {
+			names = append(names, n.Name)
+		}
+	}
+	return names
+}
+
+// ShutdownController stops a devnet's nodes in order: full nodes first,
+// then validators, so no full node is left following a chain whose
+// validators are gone. Each validator's signing state is flushed to disk
+// once it exited, and each node's final height is persisted into its
+// status. Like the RecoveryController it is not a reconciler: callers run
+// it when a devnet stops or the daemon shuts down.
+type ShutdownController struct {
+	store   store.Store
+	runtime runtime.NodeRuntime
+	checker HealthChecker
+	signers SignerLauncher
+	timeout time.Duration
+	logger  *slog.Logger
+}
+
+// NewShutdownController creates a new ShutdownController. The checker,
+// which reads the nodes' final heights, may be nil. A timeout <= 0 means
+// DefaultShutdownTimeout.
+func NewShutdownController(s store.Store, r runtime.NodeRuntime, checker HealthChecker, timeout time.Duration) *ShutdownController {
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+	return &ShutdownController{
+		store:   s,
+		runtime: r,
+		checker: checker,
+		timeout: timeout,
+		logger:  slog.Default(),
+	}
+}
+
+// SetLogger sets the logger for the controller.
+func (c *ShutdownController) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+}
+
+// SetSignerLauncher sets the launcher whose remote signers are stopped
+// after their validators.
+func (c *ShutdownController) SetSignerLauncher(l SignerLauncher) {
+	c.signers = l
+}
+
+// Shutdown stops the devnet's nodes and records how they stopped as
+// events on the devnet, which the caller saves. Nodes that are already
+// stopped are left alone. Full nodes get half the timeout when there are
+// validators to stop after them; nodes still running at their deadline are
+// killed.
+func (c *ShutdownController) Shutdown(ctx context.Context, devnet *types.Devnet) (*ShutdownReport, error) {
+	nodes, err := c.store.ListNodes(ctx, devnet.Metadata.Namespace, devnet.Metadata.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	var fullNodes, validators []*types.Node
+	for _, node := range nodes {
+		node.Spec.Desired = types.NodePhaseStopped
+		if node.Status.Phase == types.NodePhaseStopped {
+			if err := c.store.UpdateNode(ctx, node); err != nil {
+				return nil, fmt.Errorf("failed to update node %d: %w", node.Spec.Index, err)
+			}
+			continue
+		}
+		// Mark the node Stopping first, so no controller starts it again
+		node.Status.Phase = types.NodePhaseStopping
+		node.Status.Message = "Stopping node"
+		if err := c.store.UpdateNode(ctx, node); err != nil {
+			return nil, fmt.Errorf("failed to update node %d: %w", node.Spec.Index, err)
+		}
+		if node.Spec.Role == "validator" {
+			validators = append(validators, node)
+		} else {
+			fullNodes = append(fullNodes, node)
+		}
+	}
+	byIndex := func(nodes []*types.Node) {
+		sort.Slice(nodes, func(i, j int) bool { return nodes[i].Spec.Index < nodes[j].Spec.Index })
+	}
+	byIndex(fullNodes)
+	byIndex(validators)
+
+	start := time.Now()
+	fullNodeDeadline := start.Add(c.timeout)
+	if len(validators) > 0 {
+		fullNodeDeadline = start.Add(c.timeout / 2)
+	}
+
+	report := &ShutdownReport{}
+	report.Nodes = append(report.Nodes, c.stopNodes(ctx, fullNodes, fullNodeDeadline)...)
+	report.Nodes = append(report.Nodes, c.stopNodes(ctx, validators, start.Add(c.timeout))...)
+
+	for _, n := range report.Nodes {
+		if n.Killed {
+			devnet.Status.Events = append(devnet.Status.Events, types.NewEvent(
+				types.EventTypeWarning,
+				types.ReasonNodeKilled,
+				fmt.Sprintf("Node %s did not exit within the shutdown timeout and was killed with SIGKILL", n.Name),
+				"shutdown-controller",
+			))
+		}
+		if n.Err != nil {
+			devnet.Status.Events = append(devnet.Status.Events, types.NewEvent(
+				types.EventTypeWarning,
+				types.ReasonValidatorStateNotFlushed,
+				fmt.Sprintf("Node %s: %v", n.Name, n.Err),
+				"shutdown-controller",
+			))
+		}
+	}
+	if len(report.Nodes) > 0 {
+		devnet.Status.Events = append(devnet.Status.Events, types.NewEvent(
+			types.EventTypeNormal,
+			types.ReasonNodesStopped,
+			fmt.Sprintf("Stopped %d full nodes, then %d validators, in %s",
+				len(fullNodes), len(validators), time.Since(start).Round(time.Millisecond)),
+			"shutdown-controller",
+		))
+	}
+	return report, nil
+}
+
+// stopNodes stops the nodes concurrently, each within the deadline.
+func (c *ShutdownController) stopNodes(ctx context.Context, nodes []*types.Node, deadline time.Time) []NodeShutdown {
+	results := make([]NodeShutdown, len(nodes))
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.stopNode(ctx, node, deadline)
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// stopNode stops one node and persists its final state.
+func (c *ShutdownController) stopNode(ctx context.Context, node *types.Node, deadline time.Time) NodeShutdown {
+	result := NodeShutdown{
+		Name:  node.Metadata.Name,
+		Index: node.Spec.Index,
+		Role:  node.Spec.Role,
+	}
+
+	// The final height, read while the node still serves RPC
+	if c.checker != nil {
+		checkCtx, cancel := context.WithTimeout(ctx, finalHeightTimeout)
+		if health, err := c.checker.CheckHealth(checkCtx, node); err == nil && health.BlockHeight > node.Status.BlockHeight {
+			node.Status.BlockHeight = health.BlockHeight
+		}
+		cancel()
+	}
+	result.Height = node.Status.BlockHeight
+
+	if c.runtime != nil {
+		killed, err := c.stopProcess(ctx, node.Metadata.Name, time.Until(deadline))
+		if err != nil {
+			c.logger.Warn("failed to stop node",
+				"devnet", node.Spec.DevnetRef,
+				"index", node.Spec.Index,
+				"error", err)
+			// Continue anyway - the node may already be stopped
+		}
+		result.Killed = killed
+	}
+	if c.signers != nil {
+		if err := c.signers.Stop(ctx, node); err != nil {
+			c.logger.Warn("failed to stop remote signer",
+				"devnet", node.Spec.DevnetRef,
+				"index", node.Spec.Index,
+				"error", err)
+		}
+	}
+
+	message := fmt.Sprintf("Node stopped at height %d", result.Height)
+	if node.Spec.Role == "validator" {
+		signed, err := flushValidatorState(node.Spec.HomeDir)
+		if err != nil {
+			result.Err = err
+		}
+		result.SignedHeight = signed
+		if signed > 0 {
+			message += fmt.Sprintf(", last signed at height %d", signed)
+		}
+	}
+	if result.Killed {
+		message += "; killed with SIGKILL after not exiting within the shutdown timeout"
+	}
+	if result.Err != nil {
+		message += fmt.Sprintf("; %v", result.Err)
+	}
+
+	c.logger.Info("stopped node",
+		"devnet", node.Spec.DevnetRef,
+		"index", node.Spec.Index,
+		"role", node.Spec.Role,
+		"height", result.Height,
+		"killed", result.Killed)
+
+	node.Status.PID = 0
+	node.Status.Phase = types.NodePhaseStopped
+	node.Status.Message = message
+	node.Metadata.UpdatedAt = time.Now()
+	if err := c.store.UpdateNode(ctx, node); err != nil {
+		c.logger.Error("failed to record stopped node", "name", node.Metadata.Name, "error", err)
+	}
+	return result
+}
+
+// stopProcess stops the node's process or container, within timeout if the
+// runtime can bound it.
+func (c *ShutdownController) stopProcess(ctx context.Context, nodeID string, timeout time.Duration) (bool, error) {
+	if timeout <= 0 {
+		// Past the deadline: no time left for a graceful stop
+		timeout = time.Millisecond
+	}
+	if stopper, ok := c.runtime.(runtime.TimedNodeStopper); ok {
+		return stopper.StopNodeWithin(ctx, nodeID, timeout)
+	}
+	return false, c.runtime.StopNode(ctx, nodeID, true)
+}
+
+// privValidatorState is the part of a validator's priv_validator_state.json
+// the shutdown reads.
+type privValidatorState struct {
+	Height string `json:"height"`
+}
+
+// flushValidatorState makes sure the validator's signing state survives a
+// host crash after the shutdown: the node wrote it, but not necessarily
+// to disk. It returns the height the validator last signed at, or 0 if the
+// node keeps no local signing state (e.g. it uses a remote signer).
+func flushValidatorState(homeDir string) (int64, error) {
+	if homeDir == "" {
+		return 0, nil
+	}
+	dataDir := filepath.Join(homeDir, "data")
+	path := filepath.Join(dataDir, "priv_validator_state.json")
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read priv_validator_state.json: %w", err)
+	}
+	var state privValidatorState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return 0, fmt.Errorf("priv_validator_state.json is corrupt: %w", err)
+	}
+	height, err := strconv.ParseInt(state.Height, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("priv_validator_state.json is corrupt: invalid height %q", state.Height)
+	}
+
+	if err := syncPath(path); err != nil {
+		return height, fmt.Errorf("failed to flush priv_validator_state.json: %w", err)
+	}
+	// Directories cannot be synced on every platform; the file is what counts
+	_ = syncPath(dataDir)
+	return height, nil
+}
+
+// syncPath flushes a file or directory to disk.
+func syncPath(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return f.Sync()
+}
@@ -0,0 +1,140 @@
+// internal/daemon/controller/shutdown_test.go
+package controller
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// timedStopRuntime is a runtime that records the order nodes are stopped
+// in and kills the nodes listed in kill.
+type timedStopRuntime struct {
+	mockNodeRuntime
+	kill map[string]bool
+
+	mu      sync.Mutex
+	stopped []string
+}
+
+func (r *timedStopRuntime) StopNodeWithin(ctx context.Context, nodeID string, timeout time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stopped = append(r.stopped, nodeID)
+	return r.kill[nodeID], nil
+}
+
+func TestShutdownController_Shutdown(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+
+	// validator-0 keeps its signing state locally, validator-2's is corrupt
+	homes := map[string]string{}
+	for name, state := range map[string]string{
+		"validator-0": `{"height": "41", "round": 0, "step": 3}`,
+		"validator-2": `{"height": `,
+	} {
+		home := t.TempDir()
+		if err := os.MkdirAll(filepath.Join(home, "data"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(home, "data", "priv_validator_state.json"), []byte(state), 0644); err != nil {
+			t.Fatal(err)
+		}
+		homes[name] = home
+	}
+
+	nodes := []*types.Node{
+		{Metadata: types.ResourceMeta{Name: "validator-0"}, Spec: types.NodeSpec{Index: 0, Role: "validator", HomeDir: homes["validator-0"]}, Status: types.NodeStatus{Phase: types.NodePhaseRunning, PID: 100}},
+		{Metadata: types.ResourceMeta{Name: "fullnode-1"}, Spec: types.NodeSpec{Index: 1, Role: "fullnode"}, Status: types.NodeStatus{Phase: types.NodePhaseRunning, BlockHeight: 10}},
+		{Metadata: types.ResourceMeta{Name: "validator-2"}, Spec: types.NodeSpec{Index: 2, Role: "validator", HomeDir: homes["validator-2"]}, Status: types.NodeStatus{Phase: types.NodePhaseRunning}},
+		{Metadata: types.ResourceMeta{Name: "fullnode-3"}, Spec: types.NodeSpec{Index: 3, Role: "fullnode"}, Status: types.NodeStatus{Phase: types.NodePhaseStopped}},
+	}
+	for _, node := range nodes {
+		node.Spec.DevnetRef = "test"
+		node.Spec.Desired = types.NodePhaseRunning
+		if err := s.CreateNode(ctx, node); err != nil {
+			t.Fatalf("CreateNode: %v", err)
+		}
+	}
+	devnet := &types.Devnet{Metadata: types.ResourceMeta{Name: "test"}}
+
+	rt := &timedStopRuntime{kill: map[string]bool{"validator-2": true}}
+	checker := newMockHealthChecker()
+	checker.results[NodeKey("test", 0)] = &types.HealthCheckResult{Healthy: true, BlockHeight: 42}
+
+	report, err := NewShutdownController(s, rt, checker, time.Second).Shutdown(ctx, devnet)
+	if err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+
+	// Full nodes first, then the validators together; stopped nodes are
+	// skipped
+	if len(rt.stopped) != 3 || rt.stopped[0] != "fullnode-1" {
+		t.Fatalf("stopped = %v, want fullnode-1 first, then the validators", rt.stopped)
+	}
+	validators := append([]string(nil), rt.stopped[1:]...)
+	sort.Strings(validators)
+	if want := []string{"validator-0", "validator-2"}; !reflect.DeepEqual(validators, want) {
+		t.Errorf("stopped validators = %v, want %v", validators, want)
+	}
+	if want := []string{"validator-2"}; !reflect.DeepEqual(report.Killed(), want) {
+		t.Errorf("killed = %v, want %v", report.Killed(), want)
+	}
+
+	// Final heights and signing state are persisted into the nodes' status
+	validator, err := s.GetNode(ctx, "", "test", 0)
+	if err != nil {
+		t.Fatalf("GetNode: %v", err)
+	}
+	if validator.Status.Phase != types.NodePhaseStopped || validator.Spec.Desired != types.NodePhaseStopped || validator.Status.PID != 0 {
+		t.Errorf("validator-0 not stopped: phase=%s desired=%s pid=%d", validator.Status.Phase, validator.Spec.Desired, validator.Status.PID)
+	}
+	if validator.Status.BlockHeight != 42 {
+		t.Errorf("validator-0 height = %d, want 42", validator.Status.BlockHeight)
+	}
+	if !strings.Contains(validator.Status.Message, "last signed at height 41") {
+		t.Errorf("validator-0 message = %q", validator.Status.Message)
+	}
+	if report.Nodes[1].SignedHeight != 41 {
+		t.Errorf("validator-0 signed height = %d, want 41", report.Nodes[1].SignedHeight)
+	}
+
+	killed, err := s.GetNode(ctx, "", "test", 2)
+	if err != nil {
+		t.Fatalf("GetNode: %v", err)
+	}
+	if !strings.Contains(killed.Status.Message, "SIGKILL") || !strings.Contains(killed.Status.Message, "corrupt") {
+		t.Errorf("validator-2 message = %q", killed.Status.Message)
+	}
+
+	stopped, err := s.GetNode(ctx, "", "test", 3)
+	if err != nil {
+		t.Fatalf("GetNode: %v", err)
+	}
+	if stopped.Spec.Desired != types.NodePhaseStopped {
+		t.Errorf("fullnode-3 desired = %s, want %s", stopped.Spec.Desired, types.NodePhaseStopped)
+	}
+
+	for _, reason := range []string{types.ReasonNodeKilled, types.ReasonValidatorStateNotFlushed, types.ReasonNodesStopped} {
+		if !hasEventWithReason(devnet.Status.Events, reason) {
+			t.Errorf("devnet has no %s event: %+v", reason, devnet.Status.Events)
+		}
+	}
+}
+
+func TestFlushValidatorState_NoLocalState(t *testing.T) {
+	height, err := flushValidatorState(t.TempDir())
+	if err != nil || height != 0 {
+		t.Errorf("flushValidatorState = %d, %v; want 0, nil", height, err)
+	}
+}
@@ -0,0 +1,133 @@
+// internal/daemon/controller/ttl.go
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/clock"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// DefaultTTLCheckInterval is how often the TTLController looks for expired
+// devnets.
+const DefaultTTLCheckInterval = time.Minute
+
+// DevnetReaper stops or deletes devnets, with the same cleanup as the
+// corresponding API calls. The stop reason is recorded in the devnet status.
+type DevnetReaper interface {
+	StopDevnet(ctx context.Context, namespace, name, reason string) error
+	DeleteDevnet(ctx context.Context, namespace, name string) error
+}
+
+// TTLController stops, or deletes, devnets whose TTL has expired so
+// forgotten devnets do not keep consuming host resources. Like the
+// HealthController it runs periodic sweeps instead of reconciling by key.
+type TTLController struct {
+	store    store.Store
+	reaper   DevnetReaper
+	interval time.Duration
+	logger   *slog.Logger
+
+	clock clock.Clock
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewTTLController creates a new TTLController.
+func NewTTLController(s store.Store, reaper DevnetReaper, interval time.Duration) *TTLController {
+	if interval <= 0 {
+		interval = DefaultTTLCheckInterval
+	}
+	return &TTLController{
+		store:    s,
+		reaper:   reaper,
+		interval: interval,
+		logger:   slog.Default(),
+		clock:    clock.System,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// SetLogger sets the logger for the controller.
+func (c *TTLController) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+}
+
+// SetClock sets the clock the controller tells the time by.
+func (c *TTLController) SetClock(clk clock.Clock) {
+	c.clock = clk
+}
+
+// Start starts the periodic expiry sweep.
+func (c *TTLController) Start(ctx context.Context) {
+	c.wg.Add(1)
+	go c.loop(ctx)
+}
+
+// Stop stops the expiry sweep.
+func (c *TTLController) Stop() {
+	close(c.stopCh)
+	c.wg.Wait()
+}
+
+func (c *TTLController) loop(ctx context.Context) {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	c.sweep(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.stopCh:
+			return
+		case <-ticker.C:
+			c.sweep(ctx)
+		}
+	}
+}
+
+// sweep stops every running expired devnet and deletes expired devnets
+// marked DeleteOnExpiry.
+func (c *TTLController) sweep(ctx context.Context) {
+	devnets, err := c.store.ListDevnets(ctx, "")
+	if err != nil {
+		c.logger.Error("failed to list devnets for TTL sweep", "error", err)
+		return
+	}
+
+	now := c.clock.Now()
+	for _, devnet := range devnets {
+		if !devnet.Status.Expired(now) {
+			continue
+		}
+
+		namespace, name := devnet.Metadata.Namespace, devnet.Metadata.Name
+		if namespace == "" {
+			namespace = types.DefaultNamespace
+		}
+
+		switch {
+		case devnet.Spec.DeleteOnExpiry:
+			c.logger.Info("devnet TTL expired, deleting",
+				"namespace", namespace, "name", name, "expiresAt", devnet.Status.ExpiresAt)
+			if err := c.reaper.DeleteDevnet(ctx, namespace, name); err != nil {
+				c.logger.Error("failed to delete expired devnet", "namespace", namespace, "name", name, "error", err)
+			}
+		case devnet.Status.Phase != types.PhaseStopped:
+			c.logger.Info("devnet TTL expired, stopping",
+				"namespace", namespace, "name", name, "expiresAt", devnet.Status.ExpiresAt)
+			if err := c.reaper.StopDevnet(ctx, namespace, name, "TTL expired"); err != nil {
+				c.logger.Error("failed to stop expired devnet", "namespace", namespace, "name", name, "error", err)
+			}
+		}
+	}
+}
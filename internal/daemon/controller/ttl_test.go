@@ -0,0 +1,81 @@
+// internal/daemon/controller/ttl_test.go
+package controller
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/clock"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// recordingReaper records the devnets it is asked to stop or delete.
+type recordingReaper struct {
+	stopped []string
+	deleted []string
+}
+
+func (r *recordingReaper) StopDevnet(ctx context.Context, namespace, name, reason string) error {
+	r.stopped = append(r.stopped, namespace+"/"+name)
+	return nil
+}
+
+func (r *recordingReaper) DeleteDevnet(ctx context.Context, namespace, name string) error {
+	r.deleted = append(r.deleted, namespace+"/"+name)
+	return nil
+}
+
+func TestTTLController_Sweep(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := store.NewMemoryStore()
+
+	devnets := []*types.Devnet{
+		// No TTL
+		{Metadata: types.ResourceMeta{Name: "forever"}, Status: types.DevnetStatus{Phase: types.PhaseRunning}},
+		// Not yet expired
+		{Metadata: types.ResourceMeta{Name: "fresh"}, Status: types.DevnetStatus{Phase: types.PhaseRunning, ExpiresAt: now.Add(time.Minute)}},
+		// Expired and running
+		{Metadata: types.ResourceMeta{Name: "expired"}, Status: types.DevnetStatus{Phase: types.PhaseRunning, ExpiresAt: now.Add(-time.Minute)}},
+		// Expired and already stopped
+		{Metadata: types.ResourceMeta{Name: "stopped"}, Status: types.DevnetStatus{Phase: types.PhaseStopped, ExpiresAt: now.Add(-time.Hour)}},
+		// Expired, delete on expiry
+		{
+			Metadata: types.ResourceMeta{Name: "ephemeral", Namespace: "ci"},
+			Spec:     types.DevnetSpec{DeleteOnExpiry: true},
+			Status:   types.DevnetStatus{Phase: types.PhaseStopped, ExpiresAt: now},
+		},
+	}
+	for _, d := range devnets {
+		if err := s.CreateDevnet(ctx, d); err != nil {
+			t.Fatalf("CreateDevnet: %v", err)
+		}
+	}
+
+	reaper := &recordingReaper{}
+	c := NewTTLController(s, reaper, time.Minute)
+	c.SetClock(clock.NewFake(now))
+	c.sweep(ctx)
+
+	sort.Strings(reaper.stopped)
+	if want := []string{"default/expired"}; !reflect.DeepEqual(reaper.stopped, want) {
+		t.Errorf("stopped = %v, want %v", reaper.stopped, want)
+	}
+	if want := []string{"ci/ephemeral"}; !reflect.DeepEqual(reaper.deleted, want) {
+		t.Errorf("deleted = %v, want %v", reaper.deleted, want)
+	}
+}
+
+func TestTTLController_StartStop(t *testing.T) {
+	c := NewTTLController(store.NewMemoryStore(), &recordingReaper{}, 0)
+	if c.interval != DefaultTTLCheckInterval {
+		t.Errorf("interval = %v, want default", c.interval)
+	}
+
+	c.Start(context.Background())
+	c.Stop()
+}
@@ -5,9 +5,11 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/upgradereport"
 )
 
 // UpgradeRuntime is the interface for chain upgrade operations.
@@ -31,20 +33,44 @@ type UpgradeRuntime interface {
 	// VerifyNodeVersion checks that a node is running the expected version.
 	VerifyNodeVersion(ctx context.Context, devnetName string, nodeIndex int, expectedVersion string) (bool, error)
 
-	// ExportState exports the chain state to a file.
-	ExportState(ctx context.Context, devnetName string, outputPath string) error
+	// ExportState exports the chain state at height to a file. Height 0
+	// exports at the exporting node's last known height.
+	ExportState(ctx context.Context, devnetName string, outputPath string, height int64) error
 
 	// GetValidatorCount returns the number of validators in the devnet.
 	GetValidatorCount(ctx context.Context, devnetName string) (int, error)
 }
 
+// UpgradeInspector is optionally implemented by an UpgradeRuntime to record
+// chain state for the upgrade's rehearsal report.
+type UpgradeInspector interface {
+	// GetAppHash returns the latest block height and its app hash.
+	GetAppHash(ctx context.Context, devnetName string) (height int64, appHash string, err error)
+
+	// GetModuleVersions returns the consensus version of each module.
+	GetModuleVersions(ctx context.Context, devnetName string) (map[string]uint64, error)
+}
+
+// upgradePollInterval is how often an in-progress upgrade is re-reconciled
+// while it waits on the chain (heights, votes, node restarts).
+const upgradePollInterval = 2 * time.Second
+
 // UpgradeController reconciles Upgrade resources.
 // It manages the lifecycle of chain upgrades, including governance proposals,
 // voting, binary switching, and verification.
 type UpgradeController struct {
 	store   store.Store
 	runtime UpgradeRuntime
+	manager *Manager
 	logger  *slog.Logger
+
+	// reportDir is where rehearsal reports are written when an upgrade
+	// finishes. Empty disables reports.
+	reportDir string
+
+	// dataDir is the daemon's data directory, holding a directory per
+	// devnet. Fork tests write their export there.
+	dataDir string
 }
 
 // NewUpgradeController creates a new UpgradeController.
@@ -61,6 +87,23 @@ func (c *UpgradeController) SetLogger(logger *slog.Logger) {
 	c.logger = logger
 }
 
+// SetManager sets the controller manager, used to requeue in-progress
+// upgrades and to enqueue devnets halted or created by fork tests.
+func (c *UpgradeController) SetManager(mgr *Manager) {
+	c.manager = mgr
+}
+
+// SetReportDir sets the directory rehearsal reports are written to.
+func (c *UpgradeController) SetReportDir(dir string) {
+	c.reportDir = dir
+}
+
+// SetDataDir sets the daemon's data directory, where fork tests write the
+// export of the devnet they fork. Without it they use the temp directory.
+func (c *UpgradeController) SetDataDir(dir string) {
+	c.dataDir = dir
+}
+
 // Reconcile processes a single upgrade by key (format: "namespace/name" or just "name").
 // It compares current phase with desired state and takes action to progress the upgrade.
 func (c *UpgradeController) Reconcile(ctx context.Context, key string) error {
@@ -83,17 +126,27 @@ func (c *UpgradeController) Reconcile(ctx context.Context, key string) error {
 	// Reconcile based on current phase
 	switch upgrade.Status.Phase {
 	case "", types.UpgradePhasePending:
-		return c.reconcilePending(ctx, upgrade)
+		err = c.reconcilePending(ctx, upgrade)
 	case types.UpgradePhaseProposing:
-		return c.reconcileProposing(ctx, upgrade)
+		err = c.reconcileProposing(ctx, upgrade)
 	case types.UpgradePhaseVoting:
-		return c.reconcileVoting(ctx, upgrade)
+		err = c.reconcileVoting(ctx, upgrade)
 	case types.UpgradePhaseWaiting:
-		return c.reconcileWaiting(ctx, upgrade)
+		err = c.reconcileWaiting(ctx, upgrade)
 	case types.UpgradePhaseSwitching:
-		return c.reconcileSwitching(ctx, upgrade)
+		err = c.reconcileSwitching(ctx, upgrade)
+	case types.UpgradePhaseHalting:
+		err = c.reconcileHalting(ctx, upgrade)
+	case types.UpgradePhaseExporting:
+		err = c.reconcileExporting(ctx, upgrade)
+	case types.UpgradePhaseForking:
+		err = c.reconcileForking(ctx, upgrade)
 	case types.UpgradePhaseVerifying:
-		return c.reconcileVerifying(ctx, upgrade)
+		if upgrade.Spec.Mode == types.UpgradeModeForkTest {
+			err = c.reconcileForkVerifying(ctx, upgrade)
+		} else {
+			err = c.reconcileVerifying(ctx, upgrade)
+		}
 	case types.UpgradePhaseCompleted, types.UpgradePhaseFailed:
 		// Terminal states, nothing to do
 		return nil
@@ -101,6 +154,26 @@ func (c *UpgradeController) Reconcile(ctx context.Context, key string) error {
 		c.logger.Warn("unknown upgrade phase", "key", key, "phase", upgrade.Status.Phase)
 		return nil
 	}
+	if err != nil {
+		return err
+	}
+
+	// Keep polling until the upgrade reaches a terminal phase. Without a
+	// store watcher nothing else would trigger the next reconcile.
+	if upgrade.Status.Phase != types.UpgradePhaseCompleted && upgrade.Status.Phase != types.UpgradePhaseFailed {
+		c.requeueAfter(key, upgradePollInterval)
+	}
+	return nil
+}
+
+// requeueAfter enqueues the upgrade again after delay.
+func (c *UpgradeController) requeueAfter(key string, delay time.Duration) {
+	if c.manager == nil {
+		return
+	}
+	time.AfterFunc(delay, func() {
+		c.manager.Enqueue("upgrades", key)
+	})
 }
 
 // reconcilePending handles upgrades in Pending phase.
@@ -111,6 +184,10 @@ func (c *UpgradeController) reconcilePending(ctx context.Context, upgrade *types
 		"devnet", upgrade.Spec.DevnetRef,
 		"upgradeName", upgrade.Spec.UpgradeName)
 
+	if upgrade.Spec.Mode == types.UpgradeModeForkTest {
+		return c.reconcileForkTestPending(ctx, upgrade)
+	}
+
 	// Calculate target height if not specified
 	if upgrade.Spec.TargetHeight == 0 && c.runtime != nil {
 		currentHeight, err := c.runtime.GetCurrentHeight(ctx, upgrade.Spec.DevnetRef)
@@ -134,13 +211,24 @@ func (c *UpgradeController) reconcilePending(ctx context.Context, upgrade *types
 		upgrade.Status.VotesRequired = validatorCount
 	}
 
+	// Record module versions to diff against after the upgrade
+	if inspector, ok := c.runtime.(UpgradeInspector); ok {
+		versions, err := inspector.GetModuleVersions(ctx, upgrade.Spec.DevnetRef)
+		if err != nil {
+			c.addWarning(upgrade, "pre-upgrade module versions unavailable: "+err.Error())
+		} else {
+			upgrade.Status.PreModuleVersions = versions
+		}
+	}
+
 	// Pre-upgrade export if requested
 	if upgrade.Spec.WithExport && c.runtime != nil {
 		exportPath := fmt.Sprintf("/tmp/%s-pre-upgrade-export.json", upgrade.Metadata.Name)
-		if err := c.runtime.ExportState(ctx, upgrade.Spec.DevnetRef, exportPath); err != nil {
+		if err := c.runtime.ExportState(ctx, upgrade.Spec.DevnetRef, exportPath, 0); err != nil {
 			c.logger.Warn("pre-upgrade export failed",
 				"name", upgrade.Metadata.Name,
 				"error", err)
+			c.addWarning(upgrade, "pre-upgrade export failed: "+err.Error())
 			// Non-fatal, continue with upgrade
 		} else {
 			upgrade.Status.PreExportPath = exportPath
@@ -151,7 +239,7 @@ func (c *UpgradeController) reconcilePending(ctx context.Context, upgrade *types
 	upgrade.Status.Phase = types.UpgradePhaseProposing
 	upgrade.Status.Message = "Creating governance proposal"
 
-	return c.store.UpdateUpgrade(ctx, upgrade)
+	return c.updateUpgrade(ctx, upgrade)
 }
 
 // reconcileProposing handles upgrades in Proposing phase.
@@ -182,7 +270,7 @@ func (c *UpgradeController) reconcileProposing(ctx context.Context, upgrade *typ
 	upgrade.Status.Phase = types.UpgradePhaseVoting
 	upgrade.Status.Message = fmt.Sprintf("Waiting for votes (proposal #%d)", upgrade.Status.ProposalID)
 
-	return c.store.UpdateUpgrade(ctx, upgrade)
+	return c.updateUpgrade(ctx, upgrade)
 }
 
 // reconcileVoting handles upgrades in Voting phase.
@@ -202,6 +290,7 @@ func (c *UpgradeController) reconcileVoting(ctx context.Context, upgrade *types.
 						"name", upgrade.Metadata.Name,
 						"validator", i,
 						"error", err)
+					c.addWarning(upgrade, fmt.Sprintf("auto-vote from validator %d failed: %s", i, err.Error()))
 					// Continue with other validators
 				}
 			}
@@ -227,7 +316,7 @@ func (c *UpgradeController) reconcileVoting(ctx context.Context, upgrade *types.
 		if !passed {
 			// Still waiting for votes
 			upgrade.Status.Message = fmt.Sprintf("Votes: %d/%d", votesReceived, votesRequired)
-			return c.store.UpdateUpgrade(ctx, upgrade)
+			return c.updateUpgrade(ctx, upgrade)
 		}
 	} else {
 		// No runtime - simulate voting complete
@@ -242,7 +331,7 @@ func (c *UpgradeController) reconcileVoting(ctx context.Context, upgrade *types.
 	upgrade.Status.Phase = types.UpgradePhaseWaiting
 	upgrade.Status.Message = fmt.Sprintf("Waiting for block height %d", upgrade.Spec.TargetHeight)
 
-	return c.store.UpdateUpgrade(ctx, upgrade)
+	return c.updateUpgrade(ctx, upgrade)
 }
 
 // reconcileWaiting handles upgrades in Waiting phase.
@@ -264,15 +353,34 @@ func (c *UpgradeController) reconcileWaiting(ctx context.Context, upgrade *types
 
 		upgrade.Status.CurrentHeight = currentHeight
 
+		// The last app hash seen before the halt is the pre-upgrade state
+		if inspector, ok := c.runtime.(UpgradeInspector); ok {
+			if height, appHash, err := inspector.GetAppHash(ctx, upgrade.Spec.DevnetRef); err == nil {
+				upgrade.Status.PreUpgradeHeight = height
+				upgrade.Status.PreUpgradeAppHash = appHash
+			}
+		}
+
 		if currentHeight < upgrade.Spec.TargetHeight {
 			// Still waiting
 			blocksRemaining := upgrade.Spec.TargetHeight - currentHeight
 			upgrade.Status.Message = fmt.Sprintf("Height %d/%d (%d blocks remaining)",
 				currentHeight, upgrade.Spec.TargetHeight, blocksRemaining)
-			return c.store.UpdateUpgrade(ctx, upgrade)
+			return c.updateUpgrade(ctx, upgrade)
 		}
 	}
 
+	if upgrade.Spec.Mode == types.UpgradeModeForkTest {
+		c.logger.Info("target height reached, halting devnet for fork test",
+			"name", upgrade.Metadata.Name,
+			"targetHeight", upgrade.Spec.TargetHeight)
+
+		upgrade.Status.Phase = types.UpgradePhaseHalting
+		upgrade.Status.Message = fmt.Sprintf("Halting devnet at height %d", upgrade.Spec.TargetHeight)
+
+		return c.updateUpgrade(ctx, upgrade)
+	}
+
 	// Target height reached - transition to Switching
 	c.logger.Info("target height reached, switching binaries",
 		"name", upgrade.Metadata.Name,
@@ -281,7 +389,7 @@ func (c *UpgradeController) reconcileWaiting(ctx context.Context, upgrade *types
 	upgrade.Status.Phase = types.UpgradePhaseSwitching
 	upgrade.Status.Message = "Switching node binaries"
 
-	return c.store.UpdateUpgrade(ctx, upgrade)
+	return c.updateUpgrade(ctx, upgrade)
 }
 
 // reconcileSwitching handles upgrades in Switching phase.
@@ -313,7 +421,7 @@ func (c *UpgradeController) reconcileSwitching(ctx context.Context, upgrade *typ
 	upgrade.Status.Phase = types.UpgradePhaseVerifying
 	upgrade.Status.Message = "Verifying upgrade success"
 
-	return c.store.UpdateUpgrade(ctx, upgrade)
+	return c.updateUpgrade(ctx, upgrade)
 }
 
 // reconcileVerifying handles upgrades in Verifying phase.
@@ -355,15 +463,18 @@ func (c *UpgradeController) reconcileVerifying(ctx context.Context, upgrade *typ
 		// Post-upgrade export if requested
 		if upgrade.Spec.WithExport {
 			exportPath := fmt.Sprintf("/tmp/%s-post-upgrade-export.json", upgrade.Metadata.Name)
-			if err := c.runtime.ExportState(ctx, upgrade.Spec.DevnetRef, exportPath); err != nil {
+			if err := c.runtime.ExportState(ctx, upgrade.Spec.DevnetRef, exportPath, 0); err != nil {
 				c.logger.Warn("post-upgrade export failed",
 					"name", upgrade.Metadata.Name,
 					"error", err)
+				c.addWarning(upgrade, "post-upgrade export failed: "+err.Error())
 				// Non-fatal, continue
 			} else {
 				upgrade.Status.PostExportPath = exportPath
 			}
 		}
+
+		c.recordPostUpgradeState(ctx, upgrade)
 	}
 
 	// All nodes verified - upgrade complete
@@ -375,7 +486,7 @@ func (c *UpgradeController) reconcileVerifying(ctx context.Context, upgrade *typ
 	upgrade.Status.Phase = types.UpgradePhaseCompleted
 	upgrade.Status.Message = "Upgrade completed successfully"
 
-	return c.store.UpdateUpgrade(ctx, upgrade)
+	return c.updateUpgrade(ctx, upgrade)
 }
 
 // setFailed transitions the upgrade to Failed phase with an error message.
@@ -388,8 +499,100 @@ func (c *UpgradeController) setFailed(ctx context.Context, upgrade *types.Upgrad
 	upgrade.Status.Message = "Upgrade failed"
 	upgrade.Status.Error = errMsg
 
+	return c.updateUpgrade(ctx, upgrade)
+}
+
+// recordPostUpgradeState records the app hash and module versions of the
+// upgraded chain for the rehearsal report.
+func (c *UpgradeController) recordPostUpgradeState(ctx context.Context, upgrade *types.Upgrade) {
+	inspector, ok := c.runtime.(UpgradeInspector)
+	if !ok {
+		return
+	}
+
+	height, appHash, err := inspector.GetAppHash(ctx, upgrade.Spec.DevnetRef)
+	if err != nil {
+		c.addWarning(upgrade, "post-upgrade app hash unavailable: "+err.Error())
+	} else {
+		upgrade.Status.PostUpgradeHeight = height
+		upgrade.Status.PostUpgradeAppHash = appHash
+	}
+
+	versions, err := inspector.GetModuleVersions(ctx, upgrade.Spec.DevnetRef)
+	if err != nil {
+		c.addWarning(upgrade, "post-upgrade module versions unavailable: "+err.Error())
+	} else {
+		upgrade.Status.PostModuleVersions = versions
+	}
+}
+
+// addWarning records a non-fatal problem for the rehearsal report.
+func (c *UpgradeController) addWarning(upgrade *types.Upgrade, warning string) {
+	upgrade.Status.Warnings = append(upgrade.Status.Warnings, warning)
+}
+
+// updateUpgrade saves the upgrade, first recording a phase change in its
+// phase history and writing the rehearsal report if it finished.
+func (c *UpgradeController) updateUpgrade(ctx context.Context, upgrade *types.Upgrade) error {
+	now := time.Now()
+	recordPhase(upgrade, now)
+
+	if c.reportDir != "" && isTerminalUpgradePhase(upgrade.Status.Phase) {
+		report := upgradereport.Build(upgrade, now)
+		path, err := upgradereport.Write(c.reportDir, report)
+		if err != nil {
+			c.logger.Warn("failed to write upgrade report",
+				"name", upgrade.Metadata.Name,
+				"error", err)
+		} else {
+			upgrade.Status.ReportPath = path
+			c.logger.Info("wrote upgrade report",
+				"name", upgrade.Metadata.Name,
+				"path", path)
+		}
+	}
+
 	return c.store.UpdateUpgrade(ctx, upgrade)
 }
 
+// recordPhase closes the current phase record and opens one for the new
+// phase if the phase changed. Terminal phases are closed immediately.
+func recordPhase(upgrade *types.Upgrade, now time.Time) {
+	history := upgrade.Status.PhaseHistory
+	phase := upgrade.Status.Phase
+	if phase == "" {
+		phase = types.UpgradePhasePending
+	}
+
+	if len(history) == 0 {
+		// The upgrade was Pending from creation until its first transition
+		started := upgrade.Metadata.CreatedAt
+		if started.IsZero() {
+			started = now
+		}
+		history = append(history, types.UpgradePhaseRecord{Phase: types.UpgradePhasePending, StartedAt: started})
+	}
+
+	last := &history[len(history)-1]
+	if last.Phase != phase {
+		if last.CompletedAt.IsZero() {
+			last.CompletedAt = now
+		}
+		history = append(history, types.UpgradePhaseRecord{Phase: phase, StartedAt: now})
+	}
+	if isTerminalUpgradePhase(phase) {
+		last = &history[len(history)-1]
+		if last.CompletedAt.IsZero() {
+			last.CompletedAt = now
+		}
+	}
+
+	upgrade.Status.PhaseHistory = history
+}
+
+func isTerminalUpgradePhase(phase string) bool {
+	return phase == types.UpgradePhaseCompleted || phase == types.UpgradePhaseFailed
+}
+
 // Ensure UpgradeController implements Controller interface
 var _ Controller = (*UpgradeController)(nil)
@@ -0,0 +1,237 @@
+// internal/daemon/controller/upgrade_forktest.go
+package controller
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// ForkOfLabel marks a devnet provisioned by a fork test with the name of
+// the devnet it was forked from.
+const ForkOfLabel = "devnet-builder/fork-of"
+
+// forkDevnetName returns the name of the devnet a fork test provisions.
+func forkDevnetName(upgrade *types.Upgrade) string {
+	return upgrade.Metadata.Name + "-fork"
+}
+
+// forkExportPath returns where a fork test writes the halted devnet's
+// state: the source devnet's data directory under dataDir, or a temp file
+// named after the upgrade's namespace when there is no data directory.
+func forkExportPath(dataDir string, upgrade *types.Upgrade) string {
+	if dataDir == "" {
+		return filepath.Join(os.TempDir(), fmt.Sprintf("%s-%s-fork-export.json", upgrade.Metadata.Namespace, upgrade.Metadata.Name))
+	}
+	return filepath.Join(dataDir, upgrade.Spec.DevnetRef, upgrade.Metadata.Name+"-fork-export.json")
+}
+
+// reconcileForkTestPending starts a fork test.
+// Fork tests skip governance and go straight to waiting for the halt height.
+func (c *UpgradeController) reconcileForkTestPending(ctx context.Context, upgrade *types.Upgrade) error {
+	if upgrade.Spec.TargetHeight <= 0 {
+		return c.setFailed(ctx, upgrade, "fork test requires a target height")
+	}
+
+	upgrade.Status.Phase = types.UpgradePhaseWaiting
+	upgrade.Status.Message = fmt.Sprintf("Waiting for block height %d", upgrade.Spec.TargetHeight)
+
+	return c.updateUpgrade(ctx, upgrade)
+}
+
+// reconcileHalting stops every node of the source devnet so its state can
+// be exported at the target height.
+func (c *UpgradeController) reconcileHalting(ctx context.Context, upgrade *types.Upgrade) error {
+	namespace := upgrade.Metadata.Namespace
+
+	nodes, err := c.store.ListNodes(ctx, namespace, upgrade.Spec.DevnetRef)
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	for _, node := range nodes {
+		if node.Spec.Desired == types.NodePhaseStopped {
+			continue
+		}
+		node.Spec.Desired = types.NodePhaseStopped
+		if node.Status.Phase == types.NodePhaseRunning {
+			node.Status.Phase = types.NodePhaseStopping
+			node.Status.Message = "Halting for fork test"
+		}
+		node.Metadata.UpdatedAt = time.Now()
+		if err := c.store.UpdateNode(ctx, node); err != nil {
+			return fmt.Errorf("failed to halt node %d: %w", node.Spec.Index, err)
+		}
+		if c.manager != nil {
+			c.manager.Enqueue("nodes", NodeKeyWithNamespace(namespace, node.Spec.DevnetRef, node.Spec.Index))
+		}
+	}
+
+	c.logger.Info("halted devnet for fork test",
+		"name", upgrade.Metadata.Name,
+		"devnet", upgrade.Spec.DevnetRef,
+		"nodes", len(nodes))
+
+	upgrade.Status.Phase = types.UpgradePhaseExporting
+	upgrade.Status.Message = "Waiting for nodes to stop before export"
+
+	return c.updateUpgrade(ctx, upgrade)
+}
+
+// reconcileExporting waits for the source devnet's nodes to stop, then
+// exports its state for the fork devnet's genesis.
+func (c *UpgradeController) reconcileExporting(ctx context.Context, upgrade *types.Upgrade) error {
+	nodes, err := c.store.ListNodes(ctx, upgrade.Metadata.Namespace, upgrade.Spec.DevnetRef)
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	for _, node := range nodes {
+		if node.Status.Phase != types.NodePhaseStopped && node.Status.Phase != types.NodePhaseCrashed {
+			upgrade.Status.Message = fmt.Sprintf("Waiting for node %d to stop (%s)", node.Spec.Index, node.Status.Phase)
+			return c.updateUpgrade(ctx, upgrade)
+		}
+	}
+
+	// The nodes stop some blocks past the target height, so export the
+	// state at the target height rather than where they stopped
+	exportPath := forkExportPath(c.dataDir, upgrade)
+	if c.runtime != nil {
+		if err := c.runtime.ExportState(ctx, upgrade.Spec.DevnetRef, exportPath, upgrade.Spec.TargetHeight); err != nil {
+			return c.setFailed(ctx, upgrade, "failed to export state: "+err.Error())
+		}
+	}
+
+	c.logger.Info("exported state for fork test",
+		"name", upgrade.Metadata.Name,
+		"height", upgrade.Spec.TargetHeight,
+		"path", exportPath)
+
+	upgrade.Status.PreExportPath = exportPath
+	upgrade.Status.Phase = types.UpgradePhaseForking
+	upgrade.Status.Message = "Provisioning fork devnet from export"
+
+	return c.updateUpgrade(ctx, upgrade)
+}
+
+// reconcileForking provisions a new devnet from the export, running the
+// new binary. The fork copies the source devnet's spec so only the binary
+// and genesis differ.
+func (c *UpgradeController) reconcileForking(ctx context.Context, upgrade *types.Upgrade) error {
+	namespace := upgrade.Metadata.Namespace
+
+	source, err := c.store.GetDevnet(ctx, namespace, upgrade.Spec.DevnetRef)
+	if err != nil {
+		return c.setFailed(ctx, upgrade, "failed to get devnet: "+err.Error())
+	}
+
+	forkName := forkDevnetName(upgrade)
+	now := time.Now()
+
+	spec := source.Spec
+	spec.GenesisPath = upgrade.Status.PreExportPath
+	spec.SnapshotURL = ""
+	spec.RPCURL = ""
+	spec.ForkNetwork = ""
+	spec.BinarySource = upgrade.Spec.NewBinary
+	if spec.ChainID == "" {
+		// Keep the source's chain ID rather than deriving one from the fork's name
+		spec.ChainID = source.Metadata.Name + "-1"
+	}
+
+	fork := &types.Devnet{
+		Metadata: types.ResourceMeta{
+			Name:       forkName,
+			Namespace:  namespace,
+			Generation: 1,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+			Labels:     map[string]string{ForkOfLabel: source.Metadata.Name},
+		},
+		Spec: spec,
+		Status: types.DevnetStatus{
+			Phase: types.PhasePending,
+		},
+	}
+
+	if err := c.store.CreateDevnet(ctx, fork); err != nil && !store.IsAlreadyExists(err) {
+		return c.setFailed(ctx, upgrade, "failed to create fork devnet: "+err.Error())
+	}
+	if c.manager != nil {
+		c.manager.Enqueue("devnets", fork.Metadata.FullName())
+	}
+
+	c.logger.Info("provisioning fork devnet",
+		"name", upgrade.Metadata.Name,
+		"fork", forkName,
+		"version", upgrade.Spec.NewBinary.Version)
+
+	upgrade.Status.ForkDevnetRef = forkName
+	upgrade.Status.Phase = types.UpgradePhaseVerifying
+	upgrade.Status.Message = fmt.Sprintf("Waiting for fork devnet %s to produce blocks", forkName)
+
+	return c.updateUpgrade(ctx, upgrade)
+}
+
+// reconcileForkVerifying waits for the fork devnet to start and produce
+// blocks past the first height it reports. Completion means the new binary
+// starts cleanly from the exported state.
+func (c *UpgradeController) reconcileForkVerifying(ctx context.Context, upgrade *types.Upgrade) error {
+	namespace := upgrade.Metadata.Namespace
+	forkName := upgrade.Status.ForkDevnetRef
+
+	fork, err := c.store.GetDevnet(ctx, namespace, forkName)
+	if err != nil {
+		return c.setFailed(ctx, upgrade, "failed to get fork devnet: "+err.Error())
+	}
+	if fork.Status.Phase == types.PhaseDegraded {
+		return c.setFailed(ctx, upgrade, fmt.Sprintf("fork devnet %s failed to start: %s", forkName, fork.Status.Message))
+	}
+
+	nodes, err := c.store.ListNodes(ctx, namespace, forkName)
+	if err != nil {
+		return fmt.Errorf("failed to list fork nodes: %w", err)
+	}
+
+	var height int64
+	for _, node := range nodes {
+		if node.Status.Phase == types.NodePhaseCrashed {
+			return c.setFailed(ctx, upgrade, fmt.Sprintf("fork devnet %s node %d crashed: %s", forkName, node.Spec.Index, node.Status.Message))
+		}
+		if node.Status.BlockHeight > height {
+			height = node.Status.BlockHeight
+		}
+	}
+
+	if height == 0 {
+		upgrade.Status.Message = fmt.Sprintf("Waiting for fork devnet %s to start (%s)", forkName, fork.Status.Phase)
+		return c.updateUpgrade(ctx, upgrade)
+	}
+
+	upgrade.Status.ForkHeight = height
+	if upgrade.Status.ForkStartHeight == 0 {
+		upgrade.Status.ForkStartHeight = height
+	}
+
+	if height <= upgrade.Status.ForkStartHeight {
+		upgrade.Status.Message = fmt.Sprintf("Fork devnet %s started at height %d, waiting for new blocks", forkName, height)
+		return c.updateUpgrade(ctx, upgrade)
+	}
+
+	c.logger.Info("fork test passed",
+		"name", upgrade.Metadata.Name,
+		"fork", forkName,
+		"startHeight", upgrade.Status.ForkStartHeight,
+		"height", height)
+
+	upgrade.Status.Phase = types.UpgradePhaseCompleted
+	upgrade.Status.Message = fmt.Sprintf("Fork devnet %s started from the height %d export and produced blocks (height %d)",
+		forkName, upgrade.Spec.TargetHeight, height)
+
+	return c.updateUpgrade(ctx, upgrade)
+}
@@ -0,0 +1,290 @@
+// internal/daemon/controller/upgrade_forktest_test.go
+package controller
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// setupForkTest creates a running devnet with two nodes and a fork-test
+// upgrade in the given phase.
+func setupForkTest(t *testing.T, phase string) (*store.MemoryStore, *UpgradeController) {
+	t.Helper()
+	ctx := context.Background()
+	ms := store.NewMemoryStore()
+
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "mydevnet"},
+		Spec: types.DevnetSpec{
+			Plugin:      "stable",
+			Validators:  2,
+			Mode:        "local",
+			ForkNetwork: "mainnet",
+			BinarySource: types.BinarySource{
+				Type:    "cache",
+				Version: "v1.0.0",
+			},
+		},
+		Status: types.DevnetStatus{Phase: types.PhaseRunning},
+	}
+	if err := ms.CreateDevnet(ctx, devnet); err != nil {
+		t.Fatalf("CreateDevnet: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		node := &types.Node{
+			Metadata: types.ResourceMeta{Name: NodeKey("mydevnet", i)},
+			Spec: types.NodeSpec{
+				DevnetRef: "mydevnet",
+				Index:     i,
+				Desired:   types.NodePhaseRunning,
+			},
+			Status: types.NodeStatus{Phase: types.NodePhaseRunning, BlockHeight: 100},
+		}
+		if err := ms.CreateNode(ctx, node); err != nil {
+			t.Fatalf("CreateNode: %v", err)
+		}
+	}
+
+	upgrade := &types.Upgrade{
+		Metadata: types.ResourceMeta{Name: "fork-test"},
+		Spec: types.UpgradeSpec{
+			DevnetRef:    "mydevnet",
+			UpgradeName:  "v2",
+			TargetHeight: 100,
+			Mode:         types.UpgradeModeForkTest,
+			NewBinary: types.BinarySource{
+				Type:    "cache",
+				Version: "v2.0.0",
+			},
+		},
+		Status: types.UpgradeStatus{Phase: phase},
+	}
+	if err := ms.CreateUpgrade(ctx, upgrade); err != nil {
+		t.Fatalf("CreateUpgrade: %v", err)
+	}
+
+	return ms, NewUpgradeController(ms, nil)
+}
+
+func TestUpgradeController_ForkTest_PendingSkipsGovernance(t *testing.T) {
+	ms, uc := setupForkTest(t, types.UpgradePhasePending)
+
+	if err := uc.Reconcile(context.Background(), "fork-test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	got, _ := ms.GetUpgrade(context.Background(), "", "fork-test")
+	if got.Status.Phase != types.UpgradePhaseWaiting {
+		t.Errorf("Phase = %q, want %q", got.Status.Phase, types.UpgradePhaseWaiting)
+	}
+}
+
+func TestUpgradeController_ForkTest_RequiresHeight(t *testing.T) {
+	ms, uc := setupForkTest(t, types.UpgradePhasePending)
+	upgrade, _ := ms.GetUpgrade(context.Background(), "", "fork-test")
+	upgrade.Spec.TargetHeight = 0
+	_ = ms.UpdateUpgrade(context.Background(), upgrade)
+
+	if err := uc.Reconcile(context.Background(), "fork-test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	got, _ := ms.GetUpgrade(context.Background(), "", "fork-test")
+	if got.Status.Phase != types.UpgradePhaseFailed {
+		t.Errorf("Phase = %q, want %q", got.Status.Phase, types.UpgradePhaseFailed)
+	}
+}
+
+func TestUpgradeController_ForkTest_WaitingToHalting(t *testing.T) {
+	ms, uc := setupForkTest(t, types.UpgradePhaseWaiting)
+
+	if err := uc.Reconcile(context.Background(), "fork-test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	got, _ := ms.GetUpgrade(context.Background(), "", "fork-test")
+	if got.Status.Phase != types.UpgradePhaseHalting {
+		t.Errorf("Phase = %q, want %q", got.Status.Phase, types.UpgradePhaseHalting)
+	}
+}
+
+func TestUpgradeController_ForkTest_HaltingStopsNodes(t *testing.T) {
+	ms, uc := setupForkTest(t, types.UpgradePhaseHalting)
+	ctx := context.Background()
+	rt := &mockUpgradeRuntime{}
+	uc.runtime = rt
+	dataDir := t.TempDir()
+	uc.SetDataDir(dataDir)
+
+	// The nodes halt past the target height of 100
+	nodes, _ := ms.ListNodes(ctx, "", "mydevnet")
+	for _, node := range nodes {
+		node.Status.BlockHeight = 103
+		_ = ms.UpdateNode(ctx, node)
+	}
+
+	if err := uc.Reconcile(ctx, "fork-test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	nodes, _ = ms.ListNodes(ctx, "", "mydevnet")
+	for _, node := range nodes {
+		if node.Spec.Desired != types.NodePhaseStopped {
+			t.Errorf("node %d Desired = %q, want %q", node.Spec.Index, node.Spec.Desired, types.NodePhaseStopped)
+		}
+		if node.Status.Phase != types.NodePhaseStopping {
+			t.Errorf("node %d Phase = %q, want %q", node.Spec.Index, node.Status.Phase, types.NodePhaseStopping)
+		}
+	}
+
+	// Export waits until every node has stopped
+	if err := uc.Reconcile(ctx, "fork-test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	got, _ := ms.GetUpgrade(ctx, "", "fork-test")
+	if got.Status.Phase != types.UpgradePhaseExporting {
+		t.Errorf("Phase = %q, want %q", got.Status.Phase, types.UpgradePhaseExporting)
+	}
+
+	for _, node := range nodes {
+		node.Status.Phase = types.NodePhaseStopped
+		_ = ms.UpdateNode(ctx, node)
+	}
+	if err := uc.Reconcile(ctx, "fork-test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	got, _ = ms.GetUpgrade(ctx, "", "fork-test")
+	if got.Status.Phase != types.UpgradePhaseForking {
+		t.Errorf("Phase = %q, want %q", got.Status.Phase, types.UpgradePhaseForking)
+	}
+	wantPath := filepath.Join(dataDir, "mydevnet", "fork-test-fork-export.json")
+	if got.Status.PreExportPath != wantPath {
+		t.Errorf("PreExportPath = %q, want %q", got.Status.PreExportPath, wantPath)
+	}
+	if len(rt.exports) != 1 || rt.exports[0] != (mockExport{path: wantPath, height: 100}) {
+		t.Errorf("exports = %+v, want one at the target height", rt.exports)
+	}
+}
+
+func TestUpgradeController_ForkTest_ForkingCreatesDevnet(t *testing.T) {
+	ms, uc := setupForkTest(t, types.UpgradePhaseForking)
+	ctx := context.Background()
+	upgrade, _ := ms.GetUpgrade(ctx, "", "fork-test")
+	upgrade.Status.PreExportPath = "/tmp/fork-test-fork-export.json"
+	_ = ms.UpdateUpgrade(ctx, upgrade)
+
+	if err := uc.Reconcile(ctx, "fork-test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	got, _ := ms.GetUpgrade(ctx, "", "fork-test")
+	if got.Status.Phase != types.UpgradePhaseVerifying {
+		t.Errorf("Phase = %q, want %q", got.Status.Phase, types.UpgradePhaseVerifying)
+	}
+	if got.Status.ForkDevnetRef != "fork-test-fork" {
+		t.Fatalf("ForkDevnetRef = %q, want %q", got.Status.ForkDevnetRef, "fork-test-fork")
+	}
+
+	fork, err := ms.GetDevnet(ctx, "", "fork-test-fork")
+	if err != nil {
+		t.Fatalf("GetDevnet(fork): %v", err)
+	}
+	if fork.Spec.GenesisPath != "/tmp/fork-test-fork-export.json" {
+		t.Errorf("GenesisPath = %q, want export path", fork.Spec.GenesisPath)
+	}
+	if fork.Spec.ForkNetwork != "" {
+		t.Errorf("ForkNetwork = %q, want empty", fork.Spec.ForkNetwork)
+	}
+	if fork.Spec.BinarySource.Version != "v2.0.0" {
+		t.Errorf("BinarySource.Version = %q, want v2.0.0", fork.Spec.BinarySource.Version)
+	}
+	if fork.Spec.ChainID != "mydevnet-1" {
+		t.Errorf("ChainID = %q, want mydevnet-1", fork.Spec.ChainID)
+	}
+	if fork.Metadata.Labels[ForkOfLabel] != "mydevnet" {
+		t.Errorf("fork-of label = %q, want mydevnet", fork.Metadata.Labels[ForkOfLabel])
+	}
+	if fork.Status.Phase != types.PhasePending {
+		t.Errorf("fork Phase = %q, want %q", fork.Status.Phase, types.PhasePending)
+	}
+}
+
+func TestUpgradeController_ForkTest_VerifyingWaitsForNewBlocks(t *testing.T) {
+	ms, uc := setupForkTest(t, types.UpgradePhaseForking)
+	ctx := context.Background()
+
+	// Forking -> Verifying
+	if err := uc.Reconcile(ctx, "fork-test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	// No fork nodes yet: still verifying
+	if err := uc.Reconcile(ctx, "fork-test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	got, _ := ms.GetUpgrade(ctx, "", "fork-test")
+	if got.Status.Phase != types.UpgradePhaseVerifying {
+		t.Fatalf("Phase = %q, want %q", got.Status.Phase, types.UpgradePhaseVerifying)
+	}
+
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: NodeKey("fork-test-fork", 0)},
+		Spec:     types.NodeSpec{DevnetRef: "fork-test-fork", Index: 0},
+		Status:   types.NodeStatus{Phase: types.NodePhaseRunning, BlockHeight: 101},
+	}
+	if err := ms.CreateNode(ctx, node); err != nil {
+		t.Fatalf("CreateNode: %v", err)
+	}
+
+	// First height seen is recorded, not yet proof of block production
+	if err := uc.Reconcile(ctx, "fork-test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	got, _ = ms.GetUpgrade(ctx, "", "fork-test")
+	if got.Status.Phase != types.UpgradePhaseVerifying || got.Status.ForkStartHeight != 101 {
+		t.Fatalf("Phase = %q, ForkStartHeight = %d; want Verifying, 101", got.Status.Phase, got.Status.ForkStartHeight)
+	}
+
+	node.Status.BlockHeight = 103
+	_ = ms.UpdateNode(ctx, node)
+	if err := uc.Reconcile(ctx, "fork-test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	got, _ = ms.GetUpgrade(ctx, "", "fork-test")
+	if got.Status.Phase != types.UpgradePhaseCompleted {
+		t.Errorf("Phase = %q, want %q", got.Status.Phase, types.UpgradePhaseCompleted)
+	}
+	if got.Status.ForkHeight != 103 {
+		t.Errorf("ForkHeight = %d, want 103", got.Status.ForkHeight)
+	}
+}
+
+func TestUpgradeController_ForkTest_VerifyingFailsOnCrash(t *testing.T) {
+	ms, uc := setupForkTest(t, types.UpgradePhaseForking)
+	ctx := context.Background()
+
+	if err := uc.Reconcile(ctx, "fork-test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: NodeKey("fork-test-fork", 0)},
+		Spec:     types.NodeSpec{DevnetRef: "fork-test-fork", Index: 0},
+		Status:   types.NodeStatus{Phase: types.NodePhaseCrashed, Message: "panic: store migration"},
+	}
+	if err := ms.CreateNode(ctx, node); err != nil {
+		t.Fatalf("CreateNode: %v", err)
+	}
+
+	if err := uc.Reconcile(ctx, "fork-test"); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	got, _ := ms.GetUpgrade(ctx, "", "fork-test")
+	if got.Status.Phase != types.UpgradePhaseFailed {
+		t.Errorf("Phase = %q, want %q", got.Status.Phase, types.UpgradePhaseFailed)
+	}
+}
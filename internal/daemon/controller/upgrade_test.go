@@ -3,12 +3,77 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/upgradereport"
 )
 
+// mockUpgradeRuntime is an UpgradeRuntime and UpgradeInspector for a chain
+// that halts at height 1000 and resumes once binaries are switched.
+type mockUpgradeRuntime struct {
+	switched bool
+	exports  []mockExport
+}
+
+// mockExport is a recorded ExportState call.
+type mockExport struct {
+	path   string
+	height int64
+}
+
+func (m *mockUpgradeRuntime) SubmitUpgradeProposal(ctx context.Context, devnetName, upgradeName string, targetHeight int64) (uint64, error) {
+	return 7, nil
+}
+
+func (m *mockUpgradeRuntime) GetProposalStatus(ctx context.Context, devnetName string, proposalID uint64) (int, int, bool, error) {
+	return 2, 2, true, nil
+}
+
+func (m *mockUpgradeRuntime) VoteOnProposal(ctx context.Context, devnetName string, proposalID uint64, validatorIndex int, voteYes bool) error {
+	return errors.New("vote rejected")
+}
+
+func (m *mockUpgradeRuntime) GetCurrentHeight(ctx context.Context, devnetName string) (int64, error) {
+	return 1000, nil
+}
+
+func (m *mockUpgradeRuntime) SwitchNodeBinary(ctx context.Context, devnetName string, nodeIndex int, newBinary types.BinarySource) error {
+	m.switched = true
+	return nil
+}
+
+func (m *mockUpgradeRuntime) VerifyNodeVersion(ctx context.Context, devnetName string, nodeIndex int, expectedVersion string) (bool, error) {
+	return true, nil
+}
+
+func (m *mockUpgradeRuntime) ExportState(ctx context.Context, devnetName string, outputPath string, height int64) error {
+	m.exports = append(m.exports, mockExport{path: outputPath, height: height})
+	return nil
+}
+
+func (m *mockUpgradeRuntime) GetValidatorCount(ctx context.Context, devnetName string) (int, error) {
+	return 2, nil
+}
+
+func (m *mockUpgradeRuntime) GetAppHash(ctx context.Context, devnetName string) (int64, string, error) {
+	if m.switched {
+		return 1003, "POST", nil
+	}
+	return 999, "PRE", nil
+}
+
+func (m *mockUpgradeRuntime) GetModuleVersions(ctx context.Context, devnetName string) (map[string]uint64, error) {
+	if m.switched {
+		return map[string]uint64{"bank": 4, "gov": 6, "feemarket": 1}, nil
+	}
+	return map[string]uint64{"bank": 4, "gov": 5, "crisis": 2}, nil
+}
+
 func TestUpgradeController_Reconcile_PendingToProposing(t *testing.T) {
 	ms := store.NewMemoryStore()
 	uc := NewUpgradeController(ms, nil)
@@ -425,3 +490,87 @@ func TestUpgradeController_Reconcile_WithExport(t *testing.T) {
 		t.Errorf("Phase = %q, want %q", got.Status.Phase, types.UpgradePhaseProposing)
 	}
 }
+
+func TestUpgradeController_Reconcile_WritesReport(t *testing.T) {
+	ms := store.NewMemoryStore()
+	uc := NewUpgradeController(ms, &mockUpgradeRuntime{})
+	reportDir := t.TempDir()
+	uc.SetReportDir(reportDir)
+
+	upgrade := &types.Upgrade{
+		Metadata: types.ResourceMeta{Name: "test-upgrade"},
+		Spec: types.UpgradeSpec{
+			DevnetRef:    "mydevnet",
+			UpgradeName:  "v2.0",
+			TargetHeight: 1000,
+			AutoVote:     true,
+			NewBinary:    types.BinarySource{Type: "cache", Version: "v2.0.0"},
+		},
+		Status: types.UpgradeStatus{Phase: types.UpgradePhasePending},
+	}
+	if err := ms.CreateUpgrade(context.Background(), upgrade); err != nil {
+		t.Fatalf("CreateUpgrade: %v", err)
+	}
+
+	for i := 0; i < 6; i++ {
+		if err := uc.Reconcile(context.Background(), "test-upgrade"); err != nil {
+			t.Fatalf("Reconcile step %d: %v", i+1, err)
+		}
+	}
+
+	got, _ := ms.GetUpgrade(context.Background(), "", "test-upgrade")
+	if got.Status.Phase != types.UpgradePhaseCompleted {
+		t.Fatalf("Phase = %q, want %q", got.Status.Phase, types.UpgradePhaseCompleted)
+	}
+
+	// Every phase is recorded and closed
+	wantPhases := []string{
+		types.UpgradePhasePending,
+		types.UpgradePhaseProposing,
+		types.UpgradePhaseVoting,
+		types.UpgradePhaseWaiting,
+		types.UpgradePhaseSwitching,
+		types.UpgradePhaseVerifying,
+		types.UpgradePhaseCompleted,
+	}
+	if len(got.Status.PhaseHistory) != len(wantPhases) {
+		t.Fatalf("PhaseHistory = %+v, want phases %v", got.Status.PhaseHistory, wantPhases)
+	}
+	for i, rec := range got.Status.PhaseHistory {
+		if rec.Phase != wantPhases[i] {
+			t.Errorf("PhaseHistory[%d].Phase = %q, want %q", i, rec.Phase, wantPhases[i])
+		}
+		if rec.CompletedAt.IsZero() {
+			t.Errorf("PhaseHistory[%d] (%s) not completed", i, rec.Phase)
+		}
+	}
+
+	if got.Status.PreUpgradeAppHash != "PRE" || got.Status.PostUpgradeAppHash != "POST" {
+		t.Errorf("app hashes = %q, %q", got.Status.PreUpgradeAppHash, got.Status.PostUpgradeAppHash)
+	}
+	if len(got.Status.Warnings) != 2 {
+		t.Errorf("Warnings = %v, want one per failed auto-vote", got.Status.Warnings)
+	}
+
+	jsonPath, markdownPath := upgradereport.Paths(reportDir, types.DefaultNamespace, "test-upgrade")
+	if got.Status.ReportPath != jsonPath {
+		t.Errorf("ReportPath = %q, want %q", got.Status.ReportPath, jsonPath)
+	}
+	if _, err := os.Stat(markdownPath); err != nil {
+		t.Errorf("markdown report not written: %v", err)
+	}
+
+	report, err := upgradereport.Read(reportDir, types.DefaultNamespace, "test-upgrade")
+	if err != nil {
+		t.Fatalf("Read report: %v", err)
+	}
+	if report.Proposal.ID != 7 || report.Phase != types.UpgradePhaseCompleted {
+		t.Errorf("report = %+v", report)
+	}
+	if len(report.Modules) != 3 {
+		t.Errorf("Modules = %+v, want crisis removed, feemarket added, gov bumped", report.Modules)
+	}
+	if filepath.Dir(jsonPath) != filepath.Join(reportDir, types.DefaultNamespace) {
+		t.Errorf("report path %s not under namespace directory", jsonPath)
+	}
+}
@@ -0,0 +1,99 @@
+// internal/daemon/credentials/credentials.go
+
+// Package credentials holds the GitHub token devnetd uses for the GitHub
+// API and release downloads. The token is kept in memory only and never
+// exported to the environment of the daemon or its children. It comes from,
+// in priority order: a dvb client that handed over the token stored in the
+// OS keychain (see Offer and Fetch), the github.token daemon setting, or the
+// GITHUB_TOKEN environment variable devnetd was started with.
+package credentials
+
+import (
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/domain/credential"
+)
+
+// Store holds the GitHub token of each source.
+type Store struct {
+	mu     sync.RWMutex
+	client string
+	config string
+	getenv func(string) string
+}
+
+// NewStore creates an empty Store.
+func NewStore() *Store {
+	return &Store{getenv: os.Getenv}
+}
+
+// Default is the store the daemon reads the GitHub token from.
+var Default = NewStore()
+
+// SetConfigGitHubToken sets the token from the daemon configuration.
+func (s *Store) SetConfigGitHubToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config = token
+}
+
+// SetClientGitHubToken sets the token handed over by a client from its
+// keychain. An empty token clears it, falling back to the other sources.
+func (s *Store) SetClientGitHubToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.client = token
+}
+
+// GitHubToken returns the GitHub token and where it came from, or
+// credential.SourceNone when there is none.
+func (s *Store) GitHubToken() (string, credential.Source) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	switch {
+	case s.client != "":
+		return s.client, credential.SourceKeychain
+	case s.config != "":
+		return s.config, credential.SourceConfigFile
+	}
+	if token := s.getenv("GITHUB_TOKEN"); token != "" {
+		return token, credential.SourceEnvironment
+	}
+	return "", credential.SourceNone
+}
+
+// GitHubToken returns the GitHub token of the Default store, or "" if there
+// is none.
+func GitHubToken() string {
+	token, _ := Default.GitHubToken()
+	return token
+}
+
+// gitHubHosts are the hosts the GitHub token is sent to: the website, the
+// API, and the storage release assets are served from. Hosts are matched
+// exactly, so look-alikes such as github.example.com never get the token.
+var gitHubHosts = map[string]bool{
+	"github.com":                    true,
+	"api.github.com":                true,
+	"objects.githubusercontent.com": true,
+}
+
+// IsGitHubHost reports whether host, without a port, is one of GitHub's own
+// hosts.
+func IsGitHubHost(host string) bool {
+	return gitHubHosts[strings.ToLower(host)]
+}
+
+// AuthorizeGitHub sets the GitHub token of the Default store on req if it
+// goes to one of GitHub's own hosts, and leaves other requests alone.
+func AuthorizeGitHub(req *http.Request) {
+	if !IsGitHubHost(req.URL.Hostname()) {
+		return
+	}
+	if token := GitHubToken(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+}
@@ -0,0 +1,93 @@
+// internal/daemon/credentials/credentials_test.go
+package credentials
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/domain/credential"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreGitHubToken(t *testing.T) {
+	env := map[string]string{}
+	s := NewStore()
+	s.getenv = func(key string) string { return env[key] }
+
+	token, source := s.GitHubToken()
+	assert.Empty(t, token)
+	assert.Equal(t, credential.SourceNone, source)
+
+	env["GITHUB_TOKEN"] = "env-token"
+	token, source = s.GitHubToken()
+	assert.Equal(t, "env-token", token)
+	assert.Equal(t, credential.SourceEnvironment, source)
+
+	s.SetConfigGitHubToken("config-token")
+	token, source = s.GitHubToken()
+	assert.Equal(t, "config-token", token)
+	assert.Equal(t, credential.SourceConfigFile, source)
+
+	s.SetClientGitHubToken("keychain-token")
+	token, source = s.GitHubToken()
+	assert.Equal(t, "keychain-token", token)
+	assert.Equal(t, credential.SourceKeychain, source)
+
+	s.SetClientGitHubToken("")
+	token, _ = s.GitHubToken()
+	assert.Equal(t, "config-token", token)
+}
+
+func TestHandoff(t *testing.T) {
+	ctx := context.Background()
+	var fetched Handoff
+	err := Offer(ctx, Handoff{GitHubToken: "ghp_secret"}, func(socketPath, nonce string) error {
+		var err error
+		fetched, err = Fetch(ctx, socketPath, nonce)
+		return err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ghp_secret", fetched.GitHubToken)
+}
+
+func TestHandoffWrongNonce(t *testing.T) {
+	ctx := context.Background()
+	err := Offer(ctx, Handoff{GitHubToken: "ghp_secret"}, func(socketPath, nonce string) error {
+		creds, err := Fetch(ctx, socketPath, "guess")
+		assert.Error(t, err)
+		assert.Empty(t, creds.GitHubToken)
+		return nil
+	})
+	assert.ErrorContains(t, err, "wrong nonce")
+}
+
+func TestHandoffRequestFails(t *testing.T) {
+	want := errors.New("daemon refused")
+	err := Offer(context.Background(), Handoff{}, func(string, string) error { return want })
+	assert.ErrorIs(t, err, want)
+}
+
+func TestAuthorizeGitHub(t *testing.T) {
+	defer func(s *Store) { Default = s }(Default)
+	Default = NewStore()
+	Default.SetConfigGitHubToken("secret")
+
+	for url, want := range map[string]string{
+		"https://github.com/org/repo/releases/download/v1/app.tar.gz": "Bearer secret",
+		"https://api.github.com/repos/org/repo/releases":              "Bearer secret",
+		"https://objects.githubusercontent.com/asset":                 "Bearer secret",
+		"https://GitHub.com:443/org/repo":                             "Bearer secret",
+		"https://github.attacker.example/asset":                       "",
+		"https://notgithub.com/asset":                                 "",
+		"https://github.com.attacker.example/asset":                   "",
+		"https://example.com/github/asset":                            "",
+	} {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		AuthorizeGitHub(req)
+		assert.Equal(t, want, req.Header.Get("Authorization"), url)
+	}
+}
@@ -0,0 +1,127 @@
+// internal/daemon/credentials/handoff.go
+package credentials
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// handoffTimeout bounds a credential handoff, from the client listening to
+// the daemon reading the credentials.
+const handoffTimeout = 10 * time.Second
+
+// Handoff is the set of credentials a client hands over to the daemon.
+type Handoff struct {
+	// GitHubToken is the client's GitHub token; empty to clear the one
+	// handed over before.
+	GitHubToken string `json:"github_token"`
+}
+
+// Offer hands credentials over to the daemon without sending them through
+// the daemon API, where requests may be logged or audited. It listens on a
+// Unix socket in a private temporary directory and calls request with the
+// socket path and a one-time nonce; request must ask the daemon to Fetch
+// from that socket. The socket accepts a single connection presenting the
+// nonce and is removed when Offer returns.
+func Offer(ctx context.Context, creds Handoff, request func(socketPath, nonce string) error) error {
+	dir, err := os.MkdirTemp("", "dvb-cred-")
+	if err != nil {
+		return fmt.Errorf("failed to create handoff directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	socketPath := filepath.Join(dir, "handoff.sock")
+	ln, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("failed to listen for handoff: %w", err)
+	}
+	defer ln.Close()
+
+	nonce, err := newNonce()
+	if err != nil {
+		return err
+	}
+
+	served := make(chan error, 1)
+	go func() {
+		served <- serveHandoff(ln, nonce, creds)
+	}()
+
+	if err := request(socketPath, nonce); err != nil {
+		return err
+	}
+
+	select {
+	case err := <-served:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(handoffTimeout):
+		return errors.New("timed out waiting for the daemon to fetch credentials")
+	}
+}
+
+// serveHandoff writes creds to the first connection on ln that presents
+// nonce.
+func serveHandoff(ln net.Listener, nonce string, creds Handoff) error {
+	conn, err := ln.Accept()
+	if err != nil {
+		return fmt.Errorf("failed to accept handoff connection: %w", err)
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(handoffTimeout))
+
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read handoff nonce: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(strings.TrimSpace(line)), []byte(nonce)) != 1 {
+		return errors.New("handoff connection presented the wrong nonce")
+	}
+	return json.NewEncoder(conn).Encode(creds)
+}
+
+// Fetch reads the credentials a client offers on socketPath, presenting
+// nonce.
+func Fetch(ctx context.Context, socketPath, nonce string) (Handoff, error) {
+	ctx, cancel := context.WithTimeout(ctx, handoffTimeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "unix", socketPath)
+	if err != nil {
+		return Handoff{}, fmt.Errorf("failed to connect to handoff socket: %w", err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	if _, err := fmt.Fprintln(conn, nonce); err != nil {
+		return Handoff{}, fmt.Errorf("failed to send handoff nonce: %w", err)
+	}
+	var creds Handoff
+	if err := json.NewDecoder(conn).Decode(&creds); err != nil {
+		return Handoff{}, fmt.Errorf("failed to read credentials: %w", err)
+	}
+	return creds, nil
+}
+
+func newNonce() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate handoff nonce: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
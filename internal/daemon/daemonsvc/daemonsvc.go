@@ -0,0 +1,192 @@
+// Package daemonsvc installs devnetd itself as a per-user service (a launchd
+// agent on macOS, a systemd user unit on Linux) so it runs in the background
+// and starts at login instead of living in a terminal. It reuses the service
+// backends the service runtime uses for nodes.
+package daemonsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/transport"
+)
+
+// ServiceName is the name devnetd is registered under with the service
+// backend, giving the IDs com.altuslabs.devnet.devnetd (launchd) and
+// devnet-devnetd.service (systemd).
+const ServiceName = "devnetd"
+
+// LogFile is the file, relative to the data directory, the service manager
+// writes devnetd's stdout and stderr to. Structured logs still go to
+// daemon.log; this catches startup failures before logging is set up.
+const LogFile = "devnetd.service.log"
+
+// ErrNotInstalled is returned when managing a service that is not installed.
+var ErrNotInstalled = errors.New("devnetd service is not installed (run: devnetd service install)")
+
+// passthroughEnv lists the environment variables copied into the service
+// definition. Service managers start with a minimal environment, so without
+// these devnetd cannot find git, go, or docker.
+var passthroughEnv = []string{"HOME", "PATH", "DOCKER_HOST"}
+
+// Status is the state of the devnetd service.
+type Status struct {
+	Installed bool
+	Running   bool
+	PID       int
+}
+
+// Manager installs and controls the devnetd service for one data directory.
+type Manager struct {
+	backend runtime.ServiceBackend
+	dataDir string
+}
+
+// New creates a Manager using the platform service backend.
+func New(dataDir string) (*Manager, error) {
+	backend, err := runtime.NewServiceBackend()
+	if err != nil {
+		return nil, err
+	}
+	return NewWithBackend(backend, dataDir), nil
+}
+
+// NewWithBackend creates a Manager using the given backend.
+func NewWithBackend(backend runtime.ServiceBackend, dataDir string) *Manager {
+	return &Manager{backend: backend, dataDir: dataDir}
+}
+
+// ID returns the platform service identifier.
+func (m *Manager) ID() string {
+	return m.backend.ServiceID(ServiceName)
+}
+
+// LogPath returns the path of the service output log.
+func (m *Manager) LogPath() string {
+	return filepath.Join(m.dataDir, LogFile)
+}
+
+// Definition returns the service definition that runs binary with args.
+func (m *Manager) Definition(binary string, args []string) *runtime.ServiceDefinition {
+	env := make(map[string]string)
+	for _, key := range passthroughEnv {
+		if value := os.Getenv(key); value != "" {
+			env[key] = value
+		}
+	}
+	return &runtime.ServiceDefinition{
+		ID:               m.ID(),
+		NodeID:           ServiceName,
+		Description:      "Devnet Builder daemon (devnetd)",
+		Command:          append([]string{binary}, args...),
+		WorkingDirectory: m.dataDir,
+		Environment:      env,
+		StdoutPath:       m.LogPath(),
+		StderrPath:       m.LogPath(),
+		RestartOnFailure: true,
+		GracePeriod:      30 * time.Second,
+		StartAtLogin:     true,
+	}
+}
+
+// Install writes the service definition and registers it with the service
+// manager. An existing installation is replaced, so reinstalling picks up a
+// new binary path or flags.
+func (m *Manager) Install(ctx context.Context, binary string, args []string) error {
+	if err := os.MkdirAll(m.dataDir, 0755); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+	installed, err := m.backend.IsInstalled(ctx, m.ID())
+	if err != nil {
+		return fmt.Errorf("failed to check service: %w", err)
+	}
+	if installed {
+		if err := m.backend.UninstallService(ctx, m.ID()); err != nil {
+			return fmt.Errorf("failed to remove existing service: %w", err)
+		}
+	}
+	if err := m.backend.InstallService(ctx, m.Definition(binary, args)); err != nil {
+		return fmt.Errorf("failed to install service: %w", err)
+	}
+	return nil
+}
+
+// Uninstall stops the service and removes its definition.
+func (m *Manager) Uninstall(ctx context.Context) error {
+	if err := m.requireInstalled(ctx); err != nil {
+		return err
+	}
+	return m.backend.UninstallService(ctx, m.ID())
+}
+
+// Start starts the installed service.
+func (m *Manager) Start(ctx context.Context) error {
+	if err := m.requireInstalled(ctx); err != nil {
+		return err
+	}
+	return m.backend.StartService(ctx, m.ID())
+}
+
+// Stop stops the running service. It stays installed and starts again at
+// the next login.
+func (m *Manager) Stop(ctx context.Context) error {
+	if err := m.requireInstalled(ctx); err != nil {
+		return err
+	}
+	return m.backend.StopService(ctx, m.ID(), false)
+}
+
+// Status reports whether the service is installed and running.
+func (m *Manager) Status(ctx context.Context) (*Status, error) {
+	installed, err := m.backend.IsInstalled(ctx, m.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check service: %w", err)
+	}
+	if !installed {
+		return &Status{}, nil
+	}
+	st, err := m.backend.GetServiceStatus(ctx, m.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query service: %w", err)
+	}
+	return &Status{Installed: true, Running: st.Running, PID: st.PID}, nil
+}
+
+func (m *Manager) requireInstalled(ctx context.Context) error {
+	installed, err := m.backend.IsInstalled(ctx, m.ID())
+	if err != nil {
+		return fmt.Errorf("failed to check service: %w", err)
+	}
+	if !installed {
+		return ErrNotInstalled
+	}
+	return nil
+}
+
+// WaitReady waits until the daemon accepts connections on address, polling
+// until timeout elapses.
+func WaitReady(ctx context.Context, address string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		dialCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+		conn, err := transport.Dial(dialCtx, address)
+		cancel()
+		if err == nil {
+			conn.Close()
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("devnetd did not start listening on %s within %s", address, timeout)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package daemonsvc
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBackend records service definitions and run state in memory.
+type fakeBackend struct {
+	defs    map[string]*runtime.ServiceDefinition
+	running map[string]bool
+}
+
+func newFakeBackend() *fakeBackend {
+	return &fakeBackend{
+		defs:    make(map[string]*runtime.ServiceDefinition),
+		running: make(map[string]bool),
+	}
+}
+
+func (f *fakeBackend) ServiceID(nodeID string) string { return "fake-" + nodeID }
+
+func (f *fakeBackend) InstallService(_ context.Context, def *runtime.ServiceDefinition) error {
+	f.defs[def.ID] = def
+	return nil
+}
+
+func (f *fakeBackend) UninstallService(_ context.Context, id string) error {
+	delete(f.defs, id)
+	delete(f.running, id)
+	return nil
+}
+
+func (f *fakeBackend) StartService(_ context.Context, id string) error {
+	f.running[id] = true
+	return nil
+}
+
+func (f *fakeBackend) StopService(_ context.Context, id string, _ bool) error {
+	f.running[id] = false
+	return nil
+}
+
+func (f *fakeBackend) RestartService(_ context.Context, id string) error {
+	f.running[id] = true
+	return nil
+}
+
+func (f *fakeBackend) GetServiceStatus(_ context.Context, id string) (*runtime.ServiceStatus, error) {
+	if f.running[id] {
+		return &runtime.ServiceStatus{Running: true, PID: 4242}, nil
+	}
+	return &runtime.ServiceStatus{}, nil
+}
+
+func (f *fakeBackend) IsInstalled(_ context.Context, id string) (bool, error) {
+	_, ok := f.defs[id]
+	return ok, nil
+}
+
+func TestManager_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	backend := newFakeBackend()
+	dataDir := t.TempDir()
+	m := NewWithBackend(backend, dataDir)
+
+	st, err := m.Status(ctx)
+	require.NoError(t, err)
+	assert.False(t, st.Installed)
+	assert.ErrorIs(t, m.Start(ctx), ErrNotInstalled)
+	assert.ErrorIs(t, m.Stop(ctx), ErrNotInstalled)
+
+	require.NoError(t, m.Install(ctx, "/usr/local/bin/devnetd", []string{"--data-dir", dataDir}))
+	def := backend.defs["fake-devnetd"]
+	require.NotNil(t, def)
+	assert.Equal(t, []string{"/usr/local/bin/devnetd", "--data-dir", dataDir}, def.Command)
+	assert.True(t, def.StartAtLogin)
+	assert.True(t, def.RestartOnFailure)
+	assert.Equal(t, filepath.Join(dataDir, LogFile), def.StdoutPath)
+
+	require.NoError(t, m.Start(ctx))
+	st, err = m.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &Status{Installed: true, Running: true, PID: 4242}, st)
+
+	// Reinstalling replaces the definition
+	require.NoError(t, m.Install(ctx, "/opt/devnetd", nil))
+	assert.Equal(t, []string{"/opt/devnetd"}, backend.defs["fake-devnetd"].Command)
+
+	require.NoError(t, m.Stop(ctx))
+	require.NoError(t, m.Uninstall(ctx))
+	st, err = m.Status(ctx)
+	require.NoError(t, err)
+	assert.False(t, st.Installed)
+}
+
+func TestWaitReady(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := transport.TCPPrefix + ln.Addr().String()
+	ln.Close()
+
+	ctx := context.Background()
+	err = WaitReady(ctx, address, 300*time.Millisecond)
+	require.Error(t, err)
+
+	ln, err = transport.Listen(address)
+	require.NoError(t, err)
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	require.NoError(t, WaitReady(ctx, address, 2*time.Second))
+}
@@ -0,0 +1,14 @@
+//go:build !windows
+
+package daemonsvc
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// detach starts cmd in its own session, so it survives the terminal
+// closing and the caller's Ctrl+C.
+func detach(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+}
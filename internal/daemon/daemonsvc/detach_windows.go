@@ -0,0 +1,18 @@
+//go:build windows
+
+package daemonsvc
+
+import (
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
+
+// detach starts cmd in its own process group without a console, so it
+// survives the terminal closing and the caller's Ctrl+C.
+func detach(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		CreationFlags: windows.CREATE_NEW_PROCESS_GROUP | windows.DETACHED_PROCESS,
+	}
+}
@@ -0,0 +1,28 @@
+//go:build !windows
+
+package daemonsvc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpawn_AppendsOutputToLog(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "logs", LogFile)
+	require.NoError(t, os.MkdirAll(filepath.Dir(logPath), 0755))
+	require.NoError(t, os.WriteFile(logPath, []byte("earlier\n"), 0644))
+
+	pid, err := Spawn("sh", []string{"-c", "echo started"}, logPath)
+	require.NoError(t, err)
+	assert.Positive(t, pid)
+
+	assert.Eventually(t, func() bool {
+		data, _ := os.ReadFile(logPath)
+		return string(data) == "earlier\nstarted\n"
+	}, 5*time.Second, 50*time.Millisecond)
+}
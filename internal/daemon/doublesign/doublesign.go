@@ -0,0 +1,182 @@
+// internal/daemon/doublesign/doublesign.go
+
+// Package doublesign is a signing harness that makes a devnet validator
+// double-sign, to check evidence handling, tombstoning and monitoring
+// alerts. It signs two prevotes for conflicting forks of a committed block
+// with a copy of the validator's consensus key, outside the node and its
+// double-signing protection, and submits the pair to the chain as duplicate
+// vote evidence. The chain then slashes the validator and tombstones it:
+// it is jailed for good and can never be unjailed.
+package doublesign
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// ErrNotInValidatorSet is returned when the validator is not in the active
+// set, so its votes are not evidence; it may already be jailed.
+var ErrNotInValidatorSet = errors.New("validator is not in the active set")
+
+// validatorsPageSize is the maximum page size of the /validators RPC.
+const validatorsPageSize = 100
+
+// Config configures an Injector.
+type Config struct {
+	// RPCEndpoint is the CometBFT RPC base URL of the node the evidence is
+	// submitted to (e.g., "http://127.0.0.1:26657").
+	RPCEndpoint string
+
+	// HTTPClient is used for RPC calls. Defaults to a client with a 30s
+	// timeout.
+	HTTPClient *http.Client
+}
+
+// Result is the evidence an Injector submitted.
+type Result struct {
+	// Height is the height of the block the validator double-signed.
+	Height int64
+	// ValidatorAddress is the hex consensus address of the validator.
+	ValidatorAddress string
+	// EvidenceHash is the hex hash of the submitted evidence.
+	EvidenceHash string
+}
+
+// Injector submits double-sign evidence against a devnet's validators.
+type Injector struct {
+	config Config
+	client *http.Client
+}
+
+// NewInjector creates a new Injector.
+func NewInjector(cfg Config) *Injector {
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Injector{config: cfg, client: httpClient}
+}
+
+// Inject makes the validator whose priv_validator_key.json is privValidatorKey
+// double-sign the latest block, and submits the evidence.
+func (i *Injector) Inject(ctx context.Context, privValidatorKey []byte) (*Result, error) {
+	privKey, err := ParseKey(privValidatorKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rpc, err := rpchttp.NewWithClient(i.config.RPCEndpoint, "/websocket", i.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RPC client: %w", err)
+	}
+	st, err := rpc.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query node status: %w", err)
+	}
+	height := st.SyncInfo.LatestBlockHeight
+	if height < 1 {
+		return nil, errors.New("the chain has not committed a block yet")
+	}
+
+	header, err := rpc.Header(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query block %d: %w", height, err)
+	}
+
+	valSet := &cmttypes.ValidatorSet{}
+	for page := 1; ; page++ {
+		perPage := validatorsPageSize
+		resp, err := rpc.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query validators at height %d: %w", height, err)
+		}
+		valSet.Validators = append(valSet.Validators, resp.Validators...)
+		if len(resp.Validators) == 0 || len(valSet.Validators) >= resp.Total {
+			break
+		}
+	}
+
+	ev, err := NewEvidence(st.NodeInfo.Network, privKey, height, header.Header.Time, valSet)
+	if err != nil {
+		return nil, err
+	}
+	res, err := rpc.BroadcastEvidence(ctx, ev)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit evidence: %w", err)
+	}
+
+	return &Result{
+		Height:           height,
+		ValidatorAddress: privKey.PubKey().Address().String(),
+		EvidenceHash:     fmt.Sprintf("%X", res.Hash),
+	}, nil
+}
+
+// ParseKey returns the private key of a priv_validator_key.json.
+func ParseKey(privValidatorKey []byte) (crypto.PrivKey, error) {
+	var key struct {
+		PrivKey crypto.PrivKey `json:"priv_key"`
+	}
+	if err := cmtjson.Unmarshal(privValidatorKey, &key); err != nil {
+		return nil, fmt.Errorf("invalid priv_validator_key.json: %w", err)
+	}
+	if key.PrivKey == nil {
+		return nil, errors.New("invalid priv_validator_key.json: no priv_key")
+	}
+	return key.PrivKey, nil
+}
+
+// NewEvidence signs two round 0 prevotes for different forks of the block at
+// height, whose header time is blockTime, and returns them as duplicate
+// vote evidence against the validator set at that height.
+func NewEvidence(chainID string, privKey crypto.PrivKey, height int64, blockTime time.Time, valSet *cmttypes.ValidatorSet) (*cmttypes.DuplicateVoteEvidence, error) {
+	address := privKey.PubKey().Address()
+	index, _ := valSet.GetByAddress(address)
+	if index < 0 {
+		return nil, fmt.Errorf("%w at height %d: %s", ErrNotInValidatorSet, height, address)
+	}
+
+	votes := make([]*cmttypes.Vote, 2)
+	for n := range votes {
+		vote := &cmttypes.Vote{
+			Type:             cmtproto.PrevoteType,
+			Height:           height,
+			Round:            0,
+			BlockID:          forkBlockID(),
+			Timestamp:        blockTime,
+			ValidatorAddress: address,
+			ValidatorIndex:   index,
+		}
+		sig, err := privKey.Sign(cmttypes.VoteSignBytes(chainID, vote.ToProto()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign vote: %w", err)
+		}
+		vote.Signature = sig
+		votes[n] = vote
+	}
+	return cmttypes.NewDuplicateVoteEvidence(votes[0], votes[1], blockTime, valSet)
+}
+
+// forkBlockID returns the ID of a made-up block, a fork no honest validator
+// votes for.
+func forkBlockID() cmttypes.BlockID {
+	hash := make([]byte, tmhash.Size)
+	partsHash := make([]byte, tmhash.Size)
+	_, _ = rand.Read(hash)
+	_, _ = rand.Read(partsHash)
+	return cmttypes.BlockID{
+		Hash:          hash,
+		PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: partsHash},
+	}
+}
@@ -0,0 +1,57 @@
+// internal/daemon/doublesign/doublesign_test.go
+package doublesign
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/evidence"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKey(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	data, err := cmtjson.Marshal(struct {
+		PubKey  crypto.PubKey  `json:"pub_key"`
+		PrivKey crypto.PrivKey `json:"priv_key"`
+	}{privKey.PubKey(), privKey})
+	require.NoError(t, err)
+
+	parsed, err := ParseKey(data)
+	require.NoError(t, err)
+	assert.True(t, parsed.Equals(privKey))
+
+	_, err = ParseKey([]byte(`{}`))
+	assert.Error(t, err)
+	_, err = ParseKey([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestNewEvidence(t *testing.T) {
+	const chainID = "devnet-1"
+	privKey := ed25519.GenPrivKey()
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{
+		cmttypes.NewValidator(privKey.PubKey(), 10),
+		cmttypes.NewValidator(ed25519.GenPrivKey().PubKey(), 10),
+	})
+	blockTime := time.Unix(1700000000, 0).UTC()
+
+	ev, err := NewEvidence(chainID, privKey, 42, blockTime, valSet)
+	require.NoError(t, err)
+	require.NoError(t, ev.ValidateBasic())
+	assert.NoError(t, evidence.VerifyDuplicateVote(ev, chainID, valSet))
+	assert.Equal(t, int64(42), ev.Height())
+	assert.Equal(t, int64(10), ev.ValidatorPower)
+	assert.NotEqual(t, ev.VoteA.BlockID.Key(), ev.VoteB.BlockID.Key())
+
+	// Votes signed for another chain are not evidence on this one
+	assert.Error(t, evidence.VerifyDuplicateVote(ev, "other-chain", valSet))
+
+	_, err = NewEvidence(chainID, ed25519.GenPrivKey(), 42, blockTime, valSet)
+	assert.ErrorIs(t, err, ErrNotInValidatorSet)
+}
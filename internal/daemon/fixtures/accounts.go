@@ -0,0 +1,62 @@
+// internal/daemon/fixtures/accounts.go
+package fixtures
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// Mnemonic is the well-known mnemonic fixture accounts are derived from.
+// It is public on purpose: fixtures must be reproducible by any client
+// library without access to the devnet's keyring.
+const Mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+// cosmosCoinType is the BIP-44 coin type used by Cosmos SDK chains.
+const cosmosCoinType = 118
+
+// Account is a deterministic signer used to produce fixtures.
+type Account struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	PubKey  string `json:"pubKey"` // base64-encoded compressed secp256k1 key
+	HDPath  string `json:"hdPath"`
+
+	privKey cryptotypes.PrivKey
+}
+
+// DeriveAccounts derives n accounts from Mnemonic at m/44'/118'/0'/0/i,
+// encoding addresses with the given bech32 prefix.
+func DeriveAccounts(bech32Prefix string, n int) ([]Account, error) {
+	if bech32Prefix == "" {
+		return nil, fmt.Errorf("bech32 prefix is required")
+	}
+
+	accounts := make([]Account, 0, n)
+	for i := 0; i < n; i++ {
+		path := hd.CreateHDPath(cosmosCoinType, 0, uint32(i)).String()
+		derived, err := hd.Secp256k1.Derive()(Mnemonic, "", path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive account %d: %w", i, err)
+		}
+		privKey := hd.Secp256k1.Generate()(derived)
+		pubKey := privKey.PubKey()
+
+		address, err := bech32.ConvertAndEncode(bech32Prefix, pubKey.Address())
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode address for account %d: %w", i, err)
+		}
+
+		accounts = append(accounts, Account{
+			Name:    fmt.Sprintf("fixture%d", i),
+			Address: address,
+			PubKey:  base64.StdEncoding.EncodeToString(pubKey.Bytes()),
+			HDPath:  path,
+			privKey: privKey,
+		})
+	}
+	return accounts, nil
+}
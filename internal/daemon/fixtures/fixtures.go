@@ -0,0 +1,593 @@
+// internal/daemon/fixtures/fixtures.go
+
+// Package fixtures generates canonical signed transaction and query-response
+// fixtures from a running devnet, for SDK and client library teams that need
+// regression vectors tied to a reproducible chain state.
+package fixtures
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"github.com/altuslabsxyz/devnet-builder/pkg/network/cosmos"
+)
+
+// Kind is a group of fixtures covering one SDK module.
+type Kind string
+
+// Supported fixture kinds.
+const (
+	KindBank    Kind = "bank"
+	KindStaking Kind = "staking"
+	KindGov     Kind = "gov"
+)
+
+// Kinds returns all supported fixture kinds.
+func Kinds() []Kind {
+	return []Kind{KindBank, KindStaking, KindGov}
+}
+
+// ParseKinds validates and de-duplicates kind names.
+// An empty list selects all kinds.
+func ParseKinds(names []string) ([]Kind, error) {
+	if len(names) == 0 {
+		return Kinds(), nil
+	}
+
+	seen := make(map[Kind]bool)
+	var kinds []Kind
+	for _, name := range names {
+		kind := Kind(strings.ToLower(strings.TrimSpace(name)))
+		if kind == "" || seen[kind] {
+			continue
+		}
+		switch kind {
+		case KindBank, KindStaking, KindGov:
+		default:
+			return nil, fmt.Errorf("unknown fixture type %q (valid: bank, staking, gov)", name)
+		}
+		seen[kind] = true
+		kinds = append(kinds, kind)
+	}
+	return kinds, nil
+}
+
+const (
+	// fixtureGasLimit is the gas limit set on every fixture transaction.
+	fixtureGasLimit = 200000
+
+	// fixtureAmount is the base-denom amount used by transfer and delegation fixtures.
+	fixtureAmount = 1000
+
+	// ManifestFile is the name of the manifest describing a fixture set.
+	ManifestFile = "manifest.json"
+)
+
+// Config configures fixture generation.
+type Config struct {
+	// RESTEndpoint is the node's REST API URL (e.g., "http://127.0.0.1:1317").
+	RESTEndpoint string
+
+	// ChainID is the devnet's chain ID. Queried from the node if empty.
+	ChainID string
+
+	// Bech32Prefix is the account address prefix (e.g., "cosmos").
+	Bech32Prefix string
+
+	// Denom is the fee and transfer denom. Defaults to the staking bond denom.
+	Denom string
+
+	// HTTPClient is used for REST queries. Defaults to a client with a 30s timeout.
+	HTTPClient *http.Client
+}
+
+// File is a generated fixture file, relative to the output directory.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// Result is the output of a fixture generation run.
+type Result struct {
+	ChainID string
+	Height  int64
+	Files   []File
+}
+
+// Manifest describes a generated fixture set.
+type Manifest struct {
+	ChainID  string    `json:"chainId"`
+	Height   int64     `json:"height"`
+	Mnemonic string    `json:"mnemonic"`
+	Accounts []Account `json:"accounts"`
+	Kinds    []Kind    `json:"kinds"`
+	Files    []string  `json:"files"`
+}
+
+// TxFixture is a canonical signed transaction.
+type TxFixture struct {
+	Name          string          `json:"name"`
+	Kind          Kind            `json:"kind"`
+	TxType        network.TxType  `json:"txType"`
+	ChainID       string          `json:"chainId"`
+	Signer        string          `json:"signer"`
+	AccountNumber uint64          `json:"accountNumber"`
+	Sequence      uint64          `json:"sequence"`
+	SignMode      string          `json:"signMode"`
+	Tx            json.RawMessage `json:"tx"`
+	SignDoc       string          `json:"signDoc"` // hex-encoded sign bytes
+	TxBytes       string          `json:"txBytes"` // base64-encoded protobuf tx
+	TxHash        string          `json:"txHash"`
+}
+
+// QueryFixture is a REST query response captured at a fixed height.
+type QueryFixture struct {
+	Name     string          `json:"name"`
+	Kind     Kind            `json:"kind"`
+	Path     string          `json:"path"`
+	Height   int64           `json:"height"`
+	Response json.RawMessage `json:"response"`
+}
+
+// txSpec describes a fixture transaction to build.
+type txSpec struct {
+	name    string
+	txType  network.TxType
+	signer  Account
+	payload any
+}
+
+// querySpec describes a fixture query to capture.
+type querySpec struct {
+	name string
+	path string
+}
+
+// errNotFound is returned by query for HTTP 404 responses.
+var errNotFound = errors.New("not found")
+
+// Generator produces fixtures from a running devnet node.
+type Generator struct {
+	config   Config
+	client   *http.Client
+	txConfig client.TxConfig
+	logger   *slog.Logger
+}
+
+// NewGenerator creates a new Generator.
+func NewGenerator(cfg Config) *Generator {
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Generator{
+		config:   cfg,
+		client:   httpClient,
+		txConfig: cosmos.NewTxConfig(),
+		logger:   slog.Default(),
+	}
+}
+
+// SetLogger sets the logger.
+func (g *Generator) SetLogger(logger *slog.Logger) {
+	g.logger = logger
+}
+
+// generation holds chain state shared by all fixtures of one run.
+type generation struct {
+	chainID  string
+	height   int64
+	denom    string
+	accounts []Account
+}
+
+// Generate produces fixtures for the given kinds, plus a manifest.
+// Queries are pinned to the latest height at the start of the run so all
+// query fixtures describe the same chain state.
+func (g *Generator) Generate(ctx context.Context, kinds []Kind) (*Result, error) {
+	if g.config.RESTEndpoint == "" {
+		return nil, fmt.Errorf("REST endpoint is required")
+	}
+	if err := cosmos.SetupSDKConfig(g.config.Bech32Prefix); err != nil {
+		return nil, err
+	}
+
+	gen, err := g.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	g.logger.Info("generating fixtures",
+		"chainID", gen.chainID,
+		"height", gen.height,
+		"kinds", kinds)
+
+	var files []File
+	for _, kind := range kinds {
+		kindFiles, err := g.generateKind(ctx, gen, kind)
+		if err != nil {
+			return nil, fmt.Errorf("%s fixtures: %w", kind, err)
+		}
+		files = append(files, kindFiles...)
+	}
+
+	manifest := Manifest{
+		ChainID:  gen.chainID,
+		Height:   gen.height,
+		Mnemonic: Mnemonic,
+		Accounts: gen.accounts,
+		Kinds:    kinds,
+	}
+	for _, f := range files {
+		manifest.Files = append(manifest.Files, f.Name)
+	}
+	content, err := marshalFixture(manifest)
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, File{Name: ManifestFile, Content: content})
+
+	return &Result{
+		ChainID: gen.chainID,
+		Height:  gen.height,
+		Files:   files,
+	}, nil
+}
+
+// prepare resolves the chain ID, pinned height, denom and signer accounts.
+func (g *Generator) prepare(ctx context.Context) (*generation, error) {
+	gen := &generation{
+		chainID: g.config.ChainID,
+		denom:   g.config.Denom,
+	}
+
+	if gen.chainID == "" {
+		var nodeInfo struct {
+			DefaultNodeInfo struct {
+				Network string `json:"network"`
+			} `json:"default_node_info"`
+		}
+		if err := g.queryJSON(ctx, "/cosmos/base/tendermint/v1beta1/node_info", 0, &nodeInfo); err != nil {
+			return nil, fmt.Errorf("failed to query chain ID: %w", err)
+		}
+		gen.chainID = nodeInfo.DefaultNodeInfo.Network
+	}
+
+	var block struct {
+		Block struct {
+			Header struct {
+				Height string `json:"height"`
+			} `json:"header"`
+		} `json:"block"`
+	}
+	if err := g.queryJSON(ctx, "/cosmos/base/tendermint/v1beta1/blocks/latest", 0, &block); err != nil {
+		return nil, fmt.Errorf("failed to query latest height: %w", err)
+	}
+	height, err := strconv.ParseInt(block.Block.Header.Height, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid latest height %q: %w", block.Block.Header.Height, err)
+	}
+	gen.height = height
+
+	if gen.denom == "" {
+		var params struct {
+			Params struct {
+				BondDenom string `json:"bond_denom"`
+			} `json:"params"`
+		}
+		if err := g.queryJSON(ctx, "/cosmos/staking/v1beta1/params", gen.height, &params); err != nil {
+			return nil, fmt.Errorf("failed to query bond denom: %w", err)
+		}
+		gen.denom = params.Params.BondDenom
+	}
+
+	accounts, err := DeriveAccounts(g.config.Bech32Prefix, 2)
+	if err != nil {
+		return nil, err
+	}
+	gen.accounts = accounts
+
+	return gen, nil
+}
+
+// generateKind produces the transaction and query fixtures for one kind.
+func (g *Generator) generateKind(ctx context.Context, gen *generation, kind Kind) ([]File, error) {
+	sender, recipient := gen.accounts[0], gen.accounts[1]
+	amount := fmt.Sprintf("%d%s", fixtureAmount, gen.denom)
+
+	var txs []txSpec
+	var queries []querySpec
+
+	switch kind {
+	case KindBank:
+		txs = []txSpec{{
+			name:    "send",
+			txType:  network.TxTypeBankSend,
+			signer:  sender,
+			payload: cosmos.BankSendPayload{ToAddress: recipient.Address, Amount: amount},
+		}}
+		queries = []querySpec{
+			{name: "balances", path: "/cosmos/bank/v1beta1/balances/" + sender.Address},
+			{name: "supply", path: "/cosmos/bank/v1beta1/supply"},
+			{name: "params", path: "/cosmos/bank/v1beta1/params"},
+		}
+
+	case KindStaking:
+		validator, err := g.firstValidator(ctx, gen.height)
+		if err != nil {
+			return nil, err
+		}
+		txs = []txSpec{{
+			name:    "delegate",
+			txType:  network.TxTypeStakingDelegate,
+			signer:  sender,
+			payload: cosmos.StakingDelegatePayload{ValidatorAddress: validator, Amount: amount},
+		}}
+		queries = []querySpec{
+			{name: "validators", path: "/cosmos/staking/v1beta1/validators"},
+			{name: "pool", path: "/cosmos/staking/v1beta1/pool"},
+			{name: "params", path: "/cosmos/staking/v1beta1/params"},
+		}
+
+	case KindGov:
+		txs = []txSpec{{
+			name:    "vote",
+			txType:  network.TxTypeGovVote,
+			signer:  sender,
+			payload: cosmos.GovVotePayload{ProposalID: 1, Option: "yes"},
+		}}
+		queries = []querySpec{
+			{name: "proposals", path: "/cosmos/gov/v1/proposals"},
+			{name: "params", path: "/cosmos/gov/v1/params/tallying"},
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown fixture type %q", kind)
+	}
+
+	var files []File
+	for _, spec := range txs {
+		fixture, err := g.buildTx(ctx, gen, kind, spec)
+		if err != nil {
+			return nil, fmt.Errorf("tx %s: %w", spec.name, err)
+		}
+		content, err := marshalFixture(fixture)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, File{Name: path.Join(string(kind), "tx_"+spec.name+".json"), Content: content})
+	}
+
+	for _, spec := range queries {
+		response, err := g.query(ctx, spec.path, gen.height)
+		if err != nil {
+			return nil, fmt.Errorf("query %s: %w", spec.name, err)
+		}
+		content, err := marshalFixture(QueryFixture{
+			Name:     spec.name,
+			Kind:     kind,
+			Path:     spec.path,
+			Height:   gen.height,
+			Response: response,
+		})
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, File{Name: path.Join(string(kind), "query_"+spec.name+".json"), Content: content})
+	}
+
+	return files, nil
+}
+
+// buildTx builds and signs a transaction with SIGN_MODE_DIRECT.
+func (g *Generator) buildTx(ctx context.Context, gen *generation, kind Kind, spec txSpec) (*TxFixture, error) {
+	payload, err := json.Marshal(spec.payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	msg, err := cosmos.BuildMessage(spec.txType, spec.signer.Address, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	accountNumber, sequence, err := g.accountState(ctx, spec.signer.Address, gen.height)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := g.txConfig.NewTxBuilder()
+	if err := builder.SetMsgs(msg); err != nil {
+		return nil, fmt.Errorf("failed to set messages: %w", err)
+	}
+	builder.SetGasLimit(fixtureGasLimit)
+	builder.SetFeeAmount(sdk.NewCoins())
+	builder.SetMemo(string(kind) + "/" + spec.name)
+
+	// Signer info is part of the signed auth info, so it is set before
+	// computing sign bytes and filled in with the signature afterwards.
+	pubKey := spec.signer.privKey.PubKey()
+	sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT}
+	sig := signing.SignatureV2{PubKey: pubKey, Data: sigData, Sequence: sequence}
+	if err := builder.SetSignatures(sig); err != nil {
+		return nil, fmt.Errorf("failed to set signer info: %w", err)
+	}
+
+	signBytes, err := authsigning.GetSignBytesAdapter(ctx,
+		g.txConfig.SignModeHandler(),
+		signing.SignMode_SIGN_MODE_DIRECT,
+		authsigning.SignerData{
+			Address:       spec.signer.Address,
+			ChainID:       gen.chainID,
+			AccountNumber: accountNumber,
+			Sequence:      sequence,
+			PubKey:        pubKey,
+		},
+		builder.GetTx(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sign bytes: %w", err)
+	}
+
+	signature, err := cosmos.SignBytes(spec.signer.privKey, signBytes)
+	if err != nil {
+		return nil, err
+	}
+	sigData.Signature = signature
+	if err := builder.SetSignatures(sig); err != nil {
+		return nil, fmt.Errorf("failed to set signature: %w", err)
+	}
+
+	txBytes, err := g.txConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode tx: %w", err)
+	}
+	txJSON, err := g.txConfig.TxJSONEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode tx JSON: %w", err)
+	}
+	hash := sha256.Sum256(txBytes)
+
+	return &TxFixture{
+		Name:          spec.name,
+		Kind:          kind,
+		TxType:        spec.txType,
+		ChainID:       gen.chainID,
+		Signer:        spec.signer.Address,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+		SignMode:      signing.SignMode_SIGN_MODE_DIRECT.String(),
+		Tx:            txJSON,
+		SignDoc:       hex.EncodeToString(signBytes),
+		TxBytes:       base64.StdEncoding.EncodeToString(txBytes),
+		TxHash:        strings.ToUpper(hex.EncodeToString(hash[:])),
+	}, nil
+}
+
+// accountState returns the on-chain account number and sequence for address.
+// Accounts that do not exist on chain yet sign with zero values.
+func (g *Generator) accountState(ctx context.Context, address string, height int64) (uint64, uint64, error) {
+	body, err := g.query(ctx, "/cosmos/auth/v1beta1/accounts/"+address, height)
+	if errors.Is(err, errNotFound) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to query account %s: %w", address, err)
+	}
+
+	var resp struct {
+		Account struct {
+			AccountNumber string `json:"account_number"`
+			Sequence      string `json:"sequence"`
+			BaseAccount   *struct {
+				AccountNumber string `json:"account_number"`
+				Sequence      string `json:"sequence"`
+			} `json:"base_account"`
+		} `json:"account"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse account %s: %w", address, err)
+	}
+
+	numStr, seqStr := resp.Account.AccountNumber, resp.Account.Sequence
+	if resp.Account.BaseAccount != nil {
+		numStr, seqStr = resp.Account.BaseAccount.AccountNumber, resp.Account.BaseAccount.Sequence
+	}
+	accountNumber, err := strconv.ParseUint(numStr, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid account number %q: %w", numStr, err)
+	}
+	sequence, err := strconv.ParseUint(seqStr, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid sequence %q: %w", seqStr, err)
+	}
+	return accountNumber, sequence, nil
+}
+
+// firstValidator returns the operator address of the first bonded validator.
+func (g *Generator) firstValidator(ctx context.Context, height int64) (string, error) {
+	var resp struct {
+		Validators []struct {
+			OperatorAddress string `json:"operator_address"`
+		} `json:"validators"`
+	}
+	if err := g.queryJSON(ctx, "/cosmos/staking/v1beta1/validators?status=BOND_STATUS_BONDED", height, &resp); err != nil {
+		return "", fmt.Errorf("failed to query validators: %w", err)
+	}
+	if len(resp.Validators) == 0 {
+		return "", fmt.Errorf("devnet has no bonded validators")
+	}
+	return resp.Validators[0].OperatorAddress, nil
+}
+
+// queryJSON queries the REST API and decodes the response into out.
+func (g *Generator) queryJSON(ctx context.Context, apiPath string, height int64, out any) error {
+	body, err := g.query(ctx, apiPath, height)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, out)
+}
+
+// query performs a REST GET. A non-zero height pins the query to that block.
+func (g *Generator) query(ctx context.Context, apiPath string, height int64) ([]byte, error) {
+	url := strings.TrimRight(g.config.RESTEndpoint, "/") + apiPath
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if height > 0 {
+		req.Header.Set("x-cosmos-block-height", strconv.FormatInt(height, 10))
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request to %s failed: %w", apiPath, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned HTTP %d: %s", apiPath, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// marshalFixture encodes v as indented JSON with a trailing newline so
+// fixture files diff cleanly across runs.
+func marshalFixture(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal fixture: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return nil, fmt.Errorf("failed to format fixture: %w", err)
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
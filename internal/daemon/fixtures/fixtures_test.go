@@ -0,0 +1,143 @@
+// internal/daemon/fixtures/fixtures_test.go
+package fixtures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKinds(t *testing.T) {
+	kinds, err := ParseKinds(nil)
+	require.NoError(t, err)
+	assert.Equal(t, Kinds(), kinds)
+
+	kinds, err = ParseKinds([]string{"gov", " Bank ", "gov"})
+	require.NoError(t, err)
+	assert.Equal(t, []Kind{KindGov, KindBank}, kinds)
+
+	_, err = ParseKinds([]string{"wasm"})
+	assert.Error(t, err)
+}
+
+func TestDeriveAccounts_Deterministic(t *testing.T) {
+	first, err := DeriveAccounts("cosmos", 2)
+	require.NoError(t, err)
+	second, err := DeriveAccounts("cosmos", 2)
+	require.NoError(t, err)
+
+	require.Len(t, first, 2)
+	assert.Equal(t, first[0].Address, second[0].Address)
+	assert.NotEqual(t, first[0].Address, first[1].Address)
+	assert.True(t, strings.HasPrefix(first[0].Address, "cosmos1"))
+	// Well-known address for the "abandon ... about" mnemonic at m/44'/118'/0'/0/0
+	assert.Equal(t, "cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4", first[0].Address)
+
+	_, err = DeriveAccounts("", 1)
+	assert.Error(t, err)
+}
+
+// newFakeREST serves the REST endpoints queried during fixture generation.
+func newFakeREST(t *testing.T) (*httptest.Server, *[]string) {
+	t.Helper()
+	var heights []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		heights = append(heights, r.Header.Get("x-cosmos-block-height"))
+		switch {
+		case r.URL.Path == "/cosmos/base/tendermint/v1beta1/node_info":
+			_, _ = w.Write([]byte(`{"default_node_info":{"network":"devnet-1"}}`))
+		case r.URL.Path == "/cosmos/base/tendermint/v1beta1/blocks/latest":
+			_, _ = w.Write([]byte(`{"block":{"header":{"height":"42"}}}`))
+		case r.URL.Path == "/cosmos/staking/v1beta1/params":
+			_, _ = w.Write([]byte(`{"params":{"bond_denom":"stake"}}`))
+		case r.URL.Path == "/cosmos/staking/v1beta1/validators":
+			_, _ = w.Write([]byte(`{"validators":[{"operator_address":"cosmosvaloper19rl4cm2hmr8afy4kldpxz3fka4jguq0a5m7df8"}]}`))
+		case strings.HasPrefix(r.URL.Path, "/cosmos/auth/v1beta1/accounts/"):
+			http.NotFound(w, r)
+		default:
+			_, _ = w.Write([]byte(`{"ok":true}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &heights
+}
+
+func TestGenerate(t *testing.T) {
+	srv, _ := newFakeREST(t)
+
+	g := NewGenerator(Config{RESTEndpoint: srv.URL, Bech32Prefix: "cosmos"})
+	result, err := g.Generate(context.Background(), Kinds())
+	require.NoError(t, err)
+	assert.Equal(t, "devnet-1", result.ChainID)
+	assert.Equal(t, int64(42), result.Height)
+	files := result.Files
+
+	byName := make(map[string][]byte)
+	for _, f := range files {
+		byName[f.Name] = f.Content
+	}
+	for _, name := range []string{
+		"bank/tx_send.json", "bank/query_balances.json",
+		"staking/tx_delegate.json", "staking/query_validators.json",
+		"gov/tx_vote.json", "gov/query_proposals.json",
+		ManifestFile,
+	} {
+		assert.Contains(t, byName, name)
+	}
+
+	var manifest Manifest
+	require.NoError(t, json.Unmarshal(byName[ManifestFile], &manifest))
+	assert.Equal(t, "devnet-1", manifest.ChainID)
+	assert.Equal(t, int64(42), manifest.Height)
+	assert.Len(t, manifest.Files, len(files)-1)
+
+	var tx TxFixture
+	require.NoError(t, json.Unmarshal(byName["bank/tx_send.json"], &tx))
+	assert.Equal(t, "devnet-1", tx.ChainID)
+	assert.Equal(t, uint64(0), tx.AccountNumber)
+	assert.Equal(t, "SIGN_MODE_DIRECT", tx.SignMode)
+	assert.NotEmpty(t, tx.TxBytes)
+	assert.Len(t, tx.TxHash, 64)
+	assert.Contains(t, string(tx.Tx), "1000")
+
+	var query QueryFixture
+	require.NoError(t, json.Unmarshal(byName["bank/query_supply.json"], &query))
+	assert.Equal(t, int64(42), query.Height)
+	assert.JSONEq(t, `{"ok":true}`, string(query.Response))
+}
+
+func TestGenerate_Reproducible(t *testing.T) {
+	srv, _ := newFakeREST(t)
+
+	g := NewGenerator(Config{RESTEndpoint: srv.URL, Bech32Prefix: "cosmos"})
+	first, err := g.Generate(context.Background(), []Kind{KindBank})
+	require.NoError(t, err)
+	second, err := g.Generate(context.Background(), []Kind{KindBank})
+	require.NoError(t, err)
+
+	require.Equal(t, len(first.Files), len(second.Files))
+	for i := range first.Files {
+		assert.Equal(t, string(first.Files[i].Content), string(second.Files[i].Content), first.Files[i].Name)
+	}
+}
+
+func TestGenerate_PinsQueryHeight(t *testing.T) {
+	srv, heights := newFakeREST(t)
+
+	g := NewGenerator(Config{RESTEndpoint: srv.URL, ChainID: "devnet-1", Denom: "stake", Bech32Prefix: "cosmos"})
+	_, err := g.Generate(context.Background(), []Kind{KindGov})
+	require.NoError(t, err)
+
+	// The first request discovers the height; every later one is pinned to it
+	require.NotEmpty(t, *heights)
+	assert.Equal(t, "", (*heights)[0])
+	for _, h := range (*heights)[1:] {
+		assert.Equal(t, "42", h)
+	}
+}
@@ -316,22 +316,16 @@ func (s *GenesisService) forkFromSnapshotInfra(ctx context.Context, module netwo
 	// Determine cache key
 	cacheKey := fmt.Sprintf("%s-%s", module.Name(), opts.NetworkType)
 
-	// Download snapshot
-	snapshotPath, fromCache, err := s.snapshotFetcher.DownloadWithCache(ctx, snapshotURL, cacheKey, opts.NoCache)
+	// Download and extract snapshot in one pass
+	fromCache, err := s.snapshotFetcher.DownloadAndExtract(ctx, snapshotURL, cacheKey, workDir, opts.NoCache, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to download snapshot: %w", err)
 	}
 
-	s.logger.Info("snapshot downloaded",
-		"path", snapshotPath,
+	s.logger.Info("snapshot downloaded and extracted",
 		"fromCache", fromCache,
 		"snapshotURL", snapshotURL)
 
-	// Extract snapshot
-	if err := s.snapshotFetcher.Extract(ctx, snapshotPath, workDir); err != nil {
-		return nil, fmt.Errorf("failed to extract snapshot: %w", err)
-	}
-
 	// Fetch RPC genesis for chain params
 	// The RPC genesis is required for the export command to read chain parameters
 	rpcURL := opts.RPCURL
@@ -0,0 +1,403 @@
+// internal/daemon/genesispatch/accounts.go
+package genesispatch
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// Vesting types supported by AddAccounts.
+const (
+	VestingContinuous = "continuous"
+	VestingDelayed    = "delayed"
+)
+
+// Account is a funded account to add to a Cosmos SDK genesis.
+type Account struct {
+	// Address is a bech32 address, or a 0x hex address for EVM chains that
+	// is re-encoded with the genesis' bech32 prefix.
+	Address string
+	// Coins is the account's balance, e.g. "1000000ustake,500uatom".
+	Coins string
+	// Vesting optionally locks part of the balance.
+	Vesting *Vesting
+}
+
+// Vesting is a vesting schedule for a funded account.
+type Vesting struct {
+	// Type is VestingContinuous or VestingDelayed.
+	Type string
+	// Coins is the vesting portion of the balance. Empty means all of it.
+	Coins string
+	// Start is when continuous vesting begins. Ignored for delayed vesting.
+	Start time.Time
+	// End is when vesting completes.
+	End time.Time
+}
+
+// Coin is a single denomination and amount.
+type Coin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+var coinPattern = regexp.MustCompile(`^([0-9]+)([a-zA-Z][a-zA-Z0-9/:._-]{1,127})$`)
+
+// ParseCoins parses a comma-separated coin list such as "100ustake,5uatom".
+// The result is sorted by denom, as the bank module requires.
+func ParseCoins(s string) ([]Coin, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, errors.New("coins are empty")
+	}
+
+	var coins []Coin
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ",") {
+		m := coinPattern.FindStringSubmatch(strings.TrimSpace(part))
+		if m == nil {
+			return nil, fmt.Errorf("invalid coin %q (want <amount><denom>, e.g. 1000ustake)", part)
+		}
+		amount, _ := new(big.Int).SetString(m[1], 10)
+		if amount.Sign() == 0 {
+			return nil, fmt.Errorf("coin %q has zero amount", part)
+		}
+		if seen[m[2]] {
+			return nil, fmt.Errorf("duplicate denom %q", m[2])
+		}
+		seen[m[2]] = true
+		coins = append(coins, Coin{Denom: m[2], Amount: amount.String()})
+	}
+	sort.Slice(coins, func(i, j int) bool { return coins[i].Denom < coins[j].Denom })
+	return coins, nil
+}
+
+// ValidateAccounts checks accounts without needing the genesis document.
+func ValidateAccounts(accounts []Account) error {
+	var errs []error
+	seen := make(map[string]bool)
+	for i, acc := range accounts {
+		if err := validateAccount(acc); err != nil {
+			errs = append(errs, fmt.Errorf("account %d (%s): %w", i, acc.Address, err))
+			continue
+		}
+		key := strings.ToLower(acc.Address)
+		if seen[key] {
+			errs = append(errs, fmt.Errorf("account %d (%s): duplicate address", i, acc.Address))
+		}
+		seen[key] = true
+	}
+	return errors.Join(errs...)
+}
+
+func validateAccount(acc Account) error {
+	if strings.HasPrefix(acc.Address, "0x") {
+		if b, err := hex.DecodeString(acc.Address[2:]); err != nil || len(b) != 20 {
+			return errors.New("invalid hex address")
+		}
+	} else if _, _, err := bech32.DecodeAndConvert(acc.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+
+	coins, err := ParseCoins(acc.Coins)
+	if err != nil {
+		return err
+	}
+
+	if acc.Vesting == nil {
+		return nil
+	}
+	switch acc.Vesting.Type {
+	case VestingContinuous:
+		if acc.Vesting.Start.IsZero() {
+			return errors.New("continuous vesting requires a start time")
+		}
+		if !acc.Vesting.End.After(acc.Vesting.Start) {
+			return errors.New("vesting end must be after start")
+		}
+	case VestingDelayed:
+		if acc.Vesting.End.IsZero() {
+			return errors.New("delayed vesting requires an end time")
+		}
+	default:
+		return fmt.Errorf("unknown vesting type %q (must be %q or %q)", acc.Vesting.Type, VestingContinuous, VestingDelayed)
+	}
+	if acc.Vesting.Coins != "" {
+		vesting, err := ParseCoins(acc.Vesting.Coins)
+		if err != nil {
+			return fmt.Errorf("vesting: %w", err)
+		}
+		if !coinsCover(coins, vesting) {
+			return fmt.Errorf("vesting coins %s exceed funded coins %s", acc.Vesting.Coins, acc.Coins)
+		}
+	}
+	return nil
+}
+
+// AddAccounts adds funded accounts to a Cosmos SDK genesis: an auth
+// account (base or vesting) and a bank balance per account, with the bank
+// supply raised to match. Accounts that already hold a balance, such as
+// validator accounts, have the coins added to it instead.
+func AddAccounts(genesis []byte, accounts []Account) ([]byte, error) {
+	if len(accounts) == 0 {
+		return genesis, nil
+	}
+	if err := ValidateAccounts(accounts); err != nil {
+		return nil, err
+	}
+
+	doc, err := decode(genesis)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse genesis: %w", err)
+	}
+	root, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("genesis is not an object")
+	}
+	appState, _ := root["app_state"].(map[string]interface{})
+	auth, _ := appState["auth"].(map[string]interface{})
+	bank, _ := appState["bank"].(map[string]interface{})
+	if auth == nil || bank == nil {
+		return nil, errors.New("genesis has no auth or bank module state")
+	}
+
+	authAccounts, _ := auth["accounts"].([]interface{})
+	balances, _ := bank["balances"].([]interface{})
+
+	hrp := addressPrefix(balances)
+	nextNumber := nextAccountNumber(authAccounts)
+	existing := make(map[string]bool, len(authAccounts))
+	for _, raw := range authAccounts {
+		if addr := accountAddress(raw); addr != "" {
+			existing[addr] = true
+		}
+	}
+
+	supply := coinMap(bank["supply"])
+	for _, acc := range accounts {
+		address, err := normalizeAddress(acc.Address, hrp)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", acc.Address, err)
+		}
+		coins, _ := ParseCoins(acc.Coins)
+
+		if existing[address] {
+			if acc.Vesting != nil {
+				return nil, fmt.Errorf("%s: cannot add vesting to an existing account", acc.Address)
+			}
+		} else {
+			authAccounts = append(authAccounts, authAccount(address, nextNumber, coins, acc.Vesting))
+			existing[address] = true
+			nextNumber++
+		}
+
+		balances = addBalance(balances, address, coins)
+		for _, c := range coins {
+			addAmount(supply, c.Denom, c.Amount)
+		}
+	}
+
+	auth["accounts"] = authAccounts
+	bank["balances"] = balances
+	bank["supply"] = coinList(supply)
+
+	out, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode genesis: %w", err)
+	}
+	return out, nil
+}
+
+// authAccount builds the auth module entry for a new account.
+func authAccount(address string, number uint64, coins []Coin, v *Vesting) map[string]interface{} {
+	base := map[string]interface{}{
+		"address":        address,
+		"pub_key":        nil,
+		"account_number": strconv.FormatUint(number, 10),
+		"sequence":       "0",
+	}
+	if v == nil {
+		base["@type"] = "/cosmos.auth.v1beta1.BaseAccount"
+		return base
+	}
+
+	vesting := coins
+	if v.Coins != "" {
+		vesting, _ = ParseCoins(v.Coins)
+	}
+	baseVesting := map[string]interface{}{
+		"base_account":      base,
+		"original_vesting":  coinsToJSON(vesting),
+		"delegated_free":    []interface{}{},
+		"delegated_vesting": []interface{}{},
+		"end_time":          strconv.FormatInt(v.End.Unix(), 10),
+	}
+	if v.Type == VestingContinuous {
+		return map[string]interface{}{
+			"@type":                "/cosmos.vesting.v1beta1.ContinuousVestingAccount",
+			"base_vesting_account": baseVesting,
+			"start_time":           strconv.FormatInt(v.Start.Unix(), 10),
+		}
+	}
+	return map[string]interface{}{
+		"@type":                "/cosmos.vesting.v1beta1.DelayedVestingAccount",
+		"base_vesting_account": baseVesting,
+	}
+}
+
+// addBalance adds coins to address's bank balance, creating it if needed.
+func addBalance(balances []interface{}, address string, coins []Coin) []interface{} {
+	for _, raw := range balances {
+		entry, ok := raw.(map[string]interface{})
+		if !ok || entry["address"] != address {
+			continue
+		}
+		held := coinMap(entry["coins"])
+		for _, c := range coins {
+			addAmount(held, c.Denom, c.Amount)
+		}
+		entry["coins"] = coinList(held)
+		return balances
+	}
+	return append(balances, map[string]interface{}{
+		"address": address,
+		"coins":   coinsToJSON(coins),
+	})
+}
+
+// normalizeAddress returns a bech32 address, converting 0x addresses with hrp.
+func normalizeAddress(address, hrp string) (string, error) {
+	if !strings.HasPrefix(address, "0x") {
+		return address, nil
+	}
+	if hrp == "" {
+		return "", errors.New("cannot convert a hex address: genesis has no bech32 accounts to take the prefix from")
+	}
+	b, err := hex.DecodeString(address[2:])
+	if err != nil {
+		return "", err
+	}
+	return bech32.ConvertAndEncode(hrp, b)
+}
+
+// addressPrefix returns the bech32 prefix used by the genesis' balances.
+func addressPrefix(balances []interface{}) string {
+	for _, raw := range balances {
+		entry, _ := raw.(map[string]interface{})
+		addr, _ := entry["address"].(string)
+		if hrp, _, err := bech32.DecodeAndConvert(addr); err == nil {
+			return hrp
+		}
+	}
+	return ""
+}
+
+// accountAddress extracts the address from a base or vesting auth account.
+func accountAddress(raw interface{}) string {
+	acc, _ := raw.(map[string]interface{})
+	if addr, ok := acc["address"].(string); ok {
+		return addr
+	}
+	if bva, ok := acc["base_vesting_account"].(map[string]interface{}); ok {
+		acc = bva
+	}
+	if base, ok := acc["base_account"].(map[string]interface{}); ok {
+		addr, _ := base["address"].(string)
+		return addr
+	}
+	return ""
+}
+
+// nextAccountNumber returns one past the highest account number in use.
+func nextAccountNumber(accounts []interface{}) uint64 {
+	var next uint64
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return
+		}
+		if s, ok := m["account_number"].(string); ok {
+			if n, err := strconv.ParseUint(s, 10, 64); err == nil && n+1 > next {
+				next = n + 1
+			}
+		}
+		for _, child := range m {
+			walk(child)
+		}
+	}
+	for _, acc := range accounts {
+		walk(acc)
+	}
+	return next
+}
+
+// coinMap decodes a JSON coin list into denom -> amount.
+func coinMap(v interface{}) map[string]*big.Int {
+	out := make(map[string]*big.Int)
+	list, _ := v.([]interface{})
+	for _, raw := range list {
+		c, _ := raw.(map[string]interface{})
+		denom, _ := c["denom"].(string)
+		amount := fmt.Sprint(c["amount"])
+		if n, ok := new(big.Int).SetString(amount, 10); ok && denom != "" {
+			out[denom] = n
+		}
+	}
+	return out
+}
+
+func addAmount(coins map[string]*big.Int, denom, amount string) {
+	n, _ := new(big.Int).SetString(amount, 10)
+	if cur, ok := coins[denom]; ok {
+		cur.Add(cur, n)
+		return
+	}
+	coins[denom] = n
+}
+
+// coinList encodes denom -> amount as a JSON coin list sorted by denom.
+func coinList(coins map[string]*big.Int) []interface{} {
+	denoms := make([]string, 0, len(coins))
+	for d := range coins {
+		denoms = append(denoms, d)
+	}
+	sort.Strings(denoms)
+	out := make([]interface{}, 0, len(denoms))
+	for _, d := range denoms {
+		out = append(out, map[string]interface{}{"denom": d, "amount": coins[d].String()})
+	}
+	return out
+}
+
+func coinsToJSON(coins []Coin) []interface{} {
+	out := make([]interface{}, 0, len(coins))
+	for _, c := range coins {
+		out = append(out, map[string]interface{}{"denom": c.Denom, "amount": c.Amount})
+	}
+	return out
+}
+
+// coinsCover reports whether have holds at least every coin in want.
+func coinsCover(have, want []Coin) bool {
+	held := make(map[string]*big.Int, len(have))
+	for _, c := range have {
+		held[c.Denom], _ = new(big.Int).SetString(c.Amount, 10)
+	}
+	for _, c := range want {
+		n, _ := new(big.Int).SetString(c.Amount, 10)
+		if h, ok := held[c.Denom]; !ok || h.Cmp(n) < 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,164 @@
+// internal/daemon/genesispatch/accounts_test.go
+package genesispatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAddress(t *testing.T, b byte) string {
+	t.Helper()
+	addr, err := bech32.ConvertAndEncode("cosmos", bytes.Repeat([]byte{b}, 20))
+	require.NoError(t, err)
+	return addr
+}
+
+func accountsGenesis(t *testing.T) []byte {
+	t.Helper()
+	validator := testAddress(t, 1)
+	doc := map[string]interface{}{
+		"app_state": map[string]interface{}{
+			"auth": map[string]interface{}{
+				"accounts": []interface{}{
+					map[string]interface{}{
+						"@type":          "/cosmos.auth.v1beta1.BaseAccount",
+						"address":        validator,
+						"account_number": "0",
+						"sequence":       "0",
+					},
+				},
+			},
+			"bank": map[string]interface{}{
+				"balances": []interface{}{
+					map[string]interface{}{
+						"address": validator,
+						"coins":   []interface{}{map[string]interface{}{"denom": "stake", "amount": "100"}},
+					},
+				},
+				"supply": []interface{}{map[string]interface{}{"denom": "stake", "amount": "100"}},
+			},
+		},
+	}
+	out, err := json.Marshal(doc)
+	require.NoError(t, err)
+	return out
+}
+
+func TestParseCoins(t *testing.T) {
+	coins, err := ParseCoins("500uatom, 1000ustake")
+	require.NoError(t, err)
+	assert.Equal(t, []Coin{{Denom: "uatom", Amount: "500"}, {Denom: "ustake", Amount: "1000"}}, coins)
+
+	for _, bad := range []string{"", "stake", "0stake", "10", "1stake,2stake", "10 stake"} {
+		_, err := ParseCoins(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestValidateAccounts(t *testing.T) {
+	addr := testAddress(t, 2)
+	start := time.Unix(1700000000, 0)
+
+	assert.NoError(t, ValidateAccounts([]Account{
+		{Address: addr, Coins: "10stake"},
+		{Address: "0x" + "ab" + "00000000000000000000000000000000000000", Coins: "1aevm"},
+	}))
+
+	cases := map[string]Account{
+		"bad address":      {Address: "nope", Coins: "10stake"},
+		"bad hex":          {Address: "0x1234", Coins: "10stake"},
+		"bad coins":        {Address: addr, Coins: "ten"},
+		"unknown vesting":  {Address: addr, Coins: "10stake", Vesting: &Vesting{Type: "linear", End: start}},
+		"continuous order": {Address: addr, Coins: "10stake", Vesting: &Vesting{Type: VestingContinuous, Start: start, End: start}},
+		"delayed no end":   {Address: addr, Coins: "10stake", Vesting: &Vesting{Type: VestingDelayed}},
+		"vesting exceeds":  {Address: addr, Coins: "10stake", Vesting: &Vesting{Type: VestingDelayed, Coins: "11stake", End: start}},
+	}
+	for name, acc := range cases {
+		assert.Error(t, ValidateAccounts([]Account{acc}), name)
+	}
+
+	assert.Error(t, ValidateAccounts([]Account{
+		{Address: addr, Coins: "10stake"},
+		{Address: addr, Coins: "5stake"},
+	}), "duplicate address")
+}
+
+func TestAddAccounts(t *testing.T) {
+	validator := testAddress(t, 1)
+	plain := testAddress(t, 2)
+	vesting := testAddress(t, 3)
+	start := time.Unix(1700000000, 0)
+	end := start.Add(24 * time.Hour)
+
+	out, err := AddAccounts(accountsGenesis(t), []Account{
+		{Address: plain, Coins: "1000stake,5uatom"},
+		{Address: vesting, Coins: "300stake", Vesting: &Vesting{Type: VestingContinuous, Coins: "200stake", Start: start, End: end}},
+		{Address: validator, Coins: "50stake"},
+	})
+	require.NoError(t, err)
+
+	var doc struct {
+		AppState struct {
+			Auth struct {
+				Accounts []map[string]interface{} `json:"accounts"`
+			} `json:"auth"`
+			Bank struct {
+				Balances []struct {
+					Address string `json:"address"`
+					Coins   []Coin `json:"coins"`
+				} `json:"balances"`
+				Supply []Coin `json:"supply"`
+			} `json:"bank"`
+		} `json:"app_state"`
+	}
+	require.NoError(t, json.Unmarshal(out, &doc))
+
+	// The validator already had an account, so only two are added
+	accounts := doc.AppState.Auth.Accounts
+	require.Len(t, accounts, 3)
+	assert.Equal(t, "/cosmos.auth.v1beta1.BaseAccount", accounts[1]["@type"])
+	assert.Equal(t, plain, accounts[1]["address"])
+	assert.Equal(t, "1", accounts[1]["account_number"])
+
+	assert.Equal(t, "/cosmos.vesting.v1beta1.ContinuousVestingAccount", accounts[2]["@type"])
+	assert.Equal(t, "1700000000", accounts[2]["start_time"])
+	bva := accounts[2]["base_vesting_account"].(map[string]interface{})
+	assert.Equal(t, "1700086400", bva["end_time"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"denom": "stake", "amount": "200"}}, bva["original_vesting"])
+	base := bva["base_account"].(map[string]interface{})
+	assert.Equal(t, vesting, base["address"])
+	assert.Equal(t, "2", base["account_number"])
+
+	balances := doc.AppState.Bank.Balances
+	require.Len(t, balances, 3)
+	assert.Equal(t, []Coin{{Denom: "stake", Amount: "150"}}, balances[0].Coins)
+	assert.Equal(t, []Coin{{Denom: "stake", Amount: "1000"}, {Denom: "uatom", Amount: "5"}}, balances[1].Coins)
+
+	assert.Equal(t, []Coin{{Denom: "stake", Amount: "1450"}, {Denom: "uatom", Amount: "5"}}, doc.AppState.Bank.Supply)
+}
+
+func TestAddAccounts_HexAddress(t *testing.T) {
+	out, err := AddAccounts(accountsGenesis(t), []Account{
+		{Address: "0x0202020202020202020202020202020202020202", Coins: "1stake"},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, string(out), testAddress(t, 2))
+}
+
+func TestAddAccounts_Errors(t *testing.T) {
+	_, err := AddAccounts([]byte(`{"app_state":{}}`), []Account{{Address: testAddress(t, 2), Coins: "1stake"}})
+	assert.Error(t, err, "missing auth and bank state")
+
+	_, err = AddAccounts(accountsGenesis(t), []Account{{
+		Address: testAddress(t, 1),
+		Coins:   "1stake",
+		Vesting: &Vesting{Type: VestingDelayed, End: time.Now()},
+	}})
+	assert.Error(t, err, "vesting on an existing account")
+}
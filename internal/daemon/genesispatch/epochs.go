@@ -0,0 +1,42 @@
+// internal/daemon/genesispatch/epochs.go
+package genesispatch
+
+import (
+	"strconv"
+	"time"
+)
+
+// SetEpochDurations sets the duration of every epoch of the epochs module
+// (Osmosis and the SDK's x/epochs) to d and returns how many it changed.
+// Epochs already counting restart at the genesis time, so a forked chain
+// does not catch up on all the epochs a shorter duration makes overdue, one
+// per block. Chains without the epochs module are left alone.
+func SetEpochDurations(genesis []byte, d time.Duration) ([]byte, int, error) {
+	duration := strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+
+	changed := 0
+	out, err := patchSections(genesis, []string{"app_state.epochs", "genesis_time"}, func(root map[string]interface{}) error {
+		epochs := object(root, "app_state", "epochs")
+		if epochs == nil {
+			return nil
+		}
+		genesisTime, _ := root["genesis_time"].(string)
+		list, _ := epochs["epochs"].([]interface{})
+		for _, raw := range list {
+			epoch, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			epoch["duration"] = duration
+			if started, _ := epoch["epoch_counting_started"].(bool); started && genesisTime != "" {
+				epoch["current_epoch_start_time"] = genesisTime
+			}
+			changed++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return out, changed, nil
+}
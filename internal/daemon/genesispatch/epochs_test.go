@@ -0,0 +1,58 @@
+// internal/daemon/genesispatch/epochs_test.go
+package genesispatch
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetEpochDurations(t *testing.T) {
+	genesis := []byte(`{
+  "genesis_time": "2026-01-02T03:04:05Z",
+  "chain_id": "osmosis-devnet-1",
+  "app_state": {
+    "epochs": {"epochs": [
+      {"identifier": "day", "duration": "86400s", "epoch_counting_started": true, "current_epoch_start_time": "2025-06-01T00:00:00Z"},
+      {"identifier": "week", "duration": "604800s", "epoch_counting_started": false, "current_epoch_start_time": "0001-01-01T00:00:00Z"}
+    ]},
+    "bank": {"balances": []}
+  }
+}`)
+
+	out, changed, err := SetEpochDurations(genesis, 90*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, 2, changed)
+
+	var doc struct {
+		ChainID  string `json:"chain_id"`
+		AppState struct {
+			Epochs struct {
+				Epochs []map[string]interface{} `json:"epochs"`
+			} `json:"epochs"`
+			Bank map[string]interface{} `json:"bank"`
+		} `json:"app_state"`
+	}
+	require.NoError(t, json.Unmarshal(out, &doc))
+	epochs := doc.AppState.Epochs.Epochs
+	require.Len(t, epochs, 2)
+	assert.Equal(t, "90s", epochs[0]["duration"])
+	assert.Equal(t, "2026-01-02T03:04:05Z", epochs[0]["current_epoch_start_time"], "running epochs restart at genesis")
+	assert.Equal(t, "90s", epochs[1]["duration"])
+	assert.Equal(t, "0001-01-01T00:00:00Z", epochs[1]["current_epoch_start_time"], "epochs not yet counting keep their start")
+	assert.Equal(t, "osmosis-devnet-1", doc.ChainID)
+	assert.Contains(t, doc.AppState.Bank, "balances")
+
+	out, _, err = SetEpochDurations(genesis, 500*time.Millisecond)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(out, &doc))
+	assert.Equal(t, "0.5s", doc.AppState.Epochs.Epochs[0]["duration"])
+
+	// Chains without the epochs module are left alone
+	_, changed, err = SetEpochDurations([]byte(`{"app_state": {"bank": {}}}`), time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 0, changed)
+}
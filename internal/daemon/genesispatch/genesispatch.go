@@ -0,0 +1,273 @@
+// Package genesispatch applies user-supplied overrides to genesis documents.
+//
+// Overrides map a dotted path into the genesis JSON, with optional array
+// indices (e.g. "app_state.gov.params.voting_period" or
+// "app_state.bank.balances[0].coins"), to a JSON-encoded value. Each value is
+// merged at its path: objects are merged key by key, anything else replaces
+// the existing value.
+package genesispatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Segment is one step of an override path: an object key or an array index.
+type Segment struct {
+	Key   string
+	Index int
+	// IsIndex reports whether the segment is an array index.
+	IsIndex bool
+}
+
+func (s Segment) String() string {
+	if s.IsIndex {
+		return fmt.Sprintf("[%d]", s.Index)
+	}
+	return s.Key
+}
+
+// ParsePath splits a dotted override path into segments.
+func ParsePath(path string) ([]Segment, error) {
+	if strings.TrimSpace(path) == "" {
+		return nil, errors.New("path is empty")
+	}
+
+	var segments []Segment
+	for _, part := range strings.Split(path, ".") {
+		key := part
+		var indices []int
+		if i := strings.IndexByte(part, '['); i >= 0 {
+			key = part[:i]
+			rest := part[i:]
+			for rest != "" {
+				end := strings.IndexByte(rest, ']')
+				if rest[0] != '[' || end < 0 {
+					return nil, fmt.Errorf("invalid index in %q", part)
+				}
+				n, err := strconv.Atoi(rest[1:end])
+				if err != nil || n < 0 {
+					return nil, fmt.Errorf("invalid index %q in %q", rest[1:end], part)
+				}
+				indices = append(indices, n)
+				rest = rest[end+1:]
+			}
+		}
+		if key == "" {
+			if len(indices) == 0 || len(segments) == 0 {
+				return nil, fmt.Errorf("empty segment in %q", path)
+			}
+		} else {
+			segments = append(segments, Segment{Key: key})
+		}
+		for _, n := range indices {
+			segments = append(segments, Segment{Index: n, IsIndex: true})
+		}
+	}
+	return segments, nil
+}
+
+// Validate checks that every override has a well-formed path and a valid
+// JSON value, without needing the genesis document.
+func Validate(overrides map[string]string) error {
+	var errs []error
+	for _, path := range sortedPaths(overrides) {
+		if _, err := ParsePath(path); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+		if !json.Valid([]byte(overrides[path])) {
+			errs = append(errs, fmt.Errorf("%s: value is not valid JSON", path))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Apply merges overrides into genesis in path order and returns the result.
+// Every path must already exist in genesis, so typos fail instead of adding
+// stray fields. Numbers and booleans written to string fields are converted
+// to strings, since the Cosmos SDK encodes most integers and durations as
+// JSON strings.
+func Apply(genesis []byte, overrides map[string]string) ([]byte, error) {
+	if len(overrides) == 0 {
+		return genesis, nil
+	}
+	if err := Validate(overrides); err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(overrides))
+	for path := range overrides {
+		segments, _ := ParsePath(path)
+		paths = append(paths, sectionOf(segments))
+	}
+
+	return patchSections(genesis, sectionPaths(paths), func(root map[string]interface{}) error {
+		var errs []error
+		for _, path := range sortedPaths(overrides) {
+			segments, _ := ParsePath(path)
+			value, err := decode([]byte(overrides[path]))
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", path, err))
+				continue
+			}
+			if _, err := set(root, segments, value, nil); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			}
+		}
+		return errors.Join(errs...)
+	})
+}
+
+// sectionOf returns the genesis section an override path lies in: its first
+// two object keys, such as "app_state.gov", so only that module is decoded.
+func sectionOf(segments []Segment) string {
+	keys := make([]string, 0, 2)
+	for _, seg := range segments {
+		if seg.IsIndex || len(keys) == 2 {
+			break
+		}
+		keys = append(keys, seg.Key)
+	}
+	return strings.Join(keys, ".")
+}
+
+// set writes value at segments within node and returns the updated node.
+// visited holds the segments already walked, for error messages.
+func set(node interface{}, segments []Segment, value interface{}, visited []Segment) (interface{}, error) {
+	if len(segments) == 0 {
+		return merge(node, value)
+	}
+
+	seg := segments[0]
+	visited = append(visited, seg)
+	switch current := node.(type) {
+	case map[string]interface{}:
+		if seg.IsIndex {
+			return nil, fmt.Errorf("%s is an object, not an array", joinPath(visited[:len(visited)-1]))
+		}
+		child, ok := current[seg.Key]
+		if !ok {
+			return nil, fmt.Errorf("%s does not exist in genesis", joinPath(visited))
+		}
+		updated, err := set(child, segments[1:], value, visited)
+		if err != nil {
+			return nil, err
+		}
+		current[seg.Key] = updated
+		return current, nil
+	case []interface{}:
+		if !seg.IsIndex {
+			return nil, fmt.Errorf("%s is an array, not an object", joinPath(visited[:len(visited)-1]))
+		}
+		if seg.Index >= len(current) {
+			return nil, fmt.Errorf("%s is out of range (length %d)", joinPath(visited), len(current))
+		}
+		updated, err := set(current[seg.Index], segments[1:], value, visited)
+		if err != nil {
+			return nil, err
+		}
+		current[seg.Index] = updated
+		return current, nil
+	default:
+		return nil, fmt.Errorf("%s is a scalar and has no field %s", joinPath(visited[:len(visited)-1]), seg)
+	}
+}
+
+// merge combines an override value with the existing value at its path.
+func merge(existing, value interface{}) (interface{}, error) {
+	switch current := existing.(type) {
+	case map[string]interface{}:
+		patch, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("cannot replace an object with %s", kind(value))
+		}
+		for k, v := range patch {
+			if old, ok := current[k]; ok {
+				merged, err := merge(old, v)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", k, err)
+				}
+				current[k] = merged
+			} else {
+				current[k] = v
+			}
+		}
+		return current, nil
+	case string:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case json.Number:
+			return v.String(), nil
+		case bool:
+			return strconv.FormatBool(v), nil
+		}
+	case nil:
+		return value, nil
+	}
+
+	if existing != nil && value != nil && kind(existing) != kind(value) {
+		return nil, fmt.Errorf("cannot replace %s with %s", kind(existing), kind(value))
+	}
+	return value, nil
+}
+
+// kind names the JSON type of a decoded value.
+func kind(v interface{}) string {
+	switch v.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	case nil:
+		return "null"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+// decode parses JSON preserving number precision.
+func decode(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func joinPath(segments []Segment) string {
+	var b strings.Builder
+	for i, s := range segments {
+		if i > 0 && !s.IsIndex {
+			b.WriteByte('.')
+		}
+		b.WriteString(s.String())
+	}
+	if b.Len() == 0 {
+		return "genesis"
+	}
+	return b.String()
+}
+
+func sortedPaths(overrides map[string]string) []string {
+	paths := make([]string, 0, len(overrides))
+	for path := range overrides {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -0,0 +1,93 @@
+// internal/daemon/genesispatch/genesispatch_test.go
+package genesispatch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGenesis = `{
+  "chain_id": "devnet-1",
+  "app_state": {
+    "gov": {"params": {"voting_period": "172800s", "quorum": "0.334", "burn_vote_veto": true}},
+    "staking": {"params": {"max_validators": 100, "bond_denom": "stake"}},
+    "bank": {"balances": [{"address": "a1", "coins": [{"denom": "stake", "amount": "10"}]}]}
+  }
+}`
+
+func TestParsePath(t *testing.T) {
+	segments, err := ParsePath("app_state.bank.balances[0].coins")
+	require.NoError(t, err)
+	assert.Equal(t, []Segment{
+		{Key: "app_state"},
+		{Key: "bank"},
+		{Key: "balances"},
+		{Index: 0, IsIndex: true},
+		{Key: "coins"},
+	}, segments)
+
+	for _, bad := range []string{"", "a..b", "[0]", "a[x]", "a[-1]", "a[0"} {
+		_, err := ParsePath(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestApply(t *testing.T) {
+	out, err := Apply([]byte(testGenesis), map[string]string{
+		"app_state.gov.params.voting_period":         `"30s"`,
+		"app_state.staking.params":                   `{"max_validators": 4}`,
+		"app_state.bank.balances[0].coins[0].amount": `1000000`,
+		"app_state.gov.params.burn_vote_veto":        `false`,
+	})
+	require.NoError(t, err)
+
+	var doc struct {
+		AppState struct {
+			Gov struct {
+				Params map[string]interface{} `json:"params"`
+			} `json:"gov"`
+			Staking struct {
+				Params map[string]interface{} `json:"params"`
+			} `json:"staking"`
+			Bank struct {
+				Balances []struct {
+					Coins []map[string]interface{} `json:"coins"`
+				} `json:"balances"`
+			} `json:"bank"`
+		} `json:"app_state"`
+	}
+	require.NoError(t, json.Unmarshal(out, &doc))
+
+	assert.Equal(t, "30s", doc.AppState.Gov.Params["voting_period"])
+	assert.Equal(t, "0.334", doc.AppState.Gov.Params["quorum"])
+	assert.Equal(t, false, doc.AppState.Gov.Params["burn_vote_veto"])
+	// Object values merge rather than replace
+	assert.Equal(t, float64(4), doc.AppState.Staking.Params["max_validators"])
+	assert.Equal(t, "stake", doc.AppState.Staking.Params["bond_denom"])
+	// Numbers written to string fields are converted
+	assert.Equal(t, "1000000", doc.AppState.Bank.Balances[0].Coins[0]["amount"])
+}
+
+func TestApply_Errors(t *testing.T) {
+	tests := map[string]string{
+		"app_state.gov.parms.voting_period":       `"30s"`,
+		"app_state.bank.balances[3].address":      `"a2"`,
+		"app_state.staking.params":                `5`,
+		"app_state.staking.params.bond_denom.x":   `"y"`,
+		"app_state.staking.params.max_validators": `"four"`,
+		"app_state.gov.params.quorum":             `{bad`,
+	}
+	for path, value := range tests {
+		_, err := Apply([]byte(testGenesis), map[string]string{path: value})
+		assert.Error(t, err, path)
+	}
+}
+
+func TestApply_NoOverrides(t *testing.T) {
+	out, err := Apply([]byte(testGenesis), nil)
+	require.NoError(t, err)
+	assert.Equal(t, testGenesis, string(out))
+}
@@ -0,0 +1,120 @@
+// internal/daemon/genesispatch/power.go
+package genesispatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"sort"
+)
+
+// Voting power profiles: how the stake of the validators injected into a
+// forked genesis is split between them.
+const (
+	// PowerEqual gives every validator the same stake.
+	PowerEqual = "equal"
+	// PowerProportional gives validator i the share of the source chain's
+	// i-th largest bonded validator among the largest ones, so quorum and
+	// threshold need as many validators as on the source chain.
+	PowerProportional = "proportional"
+	// PowerCustom splits the stake by user-supplied weights.
+	PowerCustom = "custom"
+)
+
+// bondedStatus is the staking status of validators in the active set.
+const bondedStatus = "BOND_STATUS_BONDED"
+
+// ValidatePower checks a voting power profile and its weights for a devnet
+// with the given number of validators. An empty profile means equal.
+func ValidatePower(profile string, weights []float64, validators int) error {
+	switch profile {
+	case "", PowerEqual, PowerProportional:
+		if len(weights) > 0 {
+			return fmt.Errorf("weights are only used by the %s profile", PowerCustom)
+		}
+	case PowerCustom:
+		if len(weights) != validators {
+			return fmt.Errorf("the %s profile needs one weight per validator: got %d for %d validators", PowerCustom, len(weights), validators)
+		}
+		for i, w := range weights {
+			if !(w > 0) || math.IsInf(w, 0) {
+				return fmt.Errorf("weight of validator %d must be a positive number, got %v", i, w)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown voting power profile %q (want %s, %s or %s)", profile, PowerEqual, PowerProportional, PowerCustom)
+	}
+	return nil
+}
+
+// BondedTokens returns the tokens of the bonded, unjailed validators of the
+// genesis read from r, largest first. Only app_state.staking.validators is
+// decoded.
+func BondedTokens(r io.Reader) ([]*big.Int, error) {
+	const path = "app_state.staking.validators"
+	raw, err := ReadSections(r, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse genesis: %w", err)
+	}
+
+	var validators []struct {
+		Status string `json:"status"`
+		Jailed bool   `json:"jailed"`
+		Tokens string `json:"tokens"`
+	}
+	if data, ok := raw[path]; ok {
+		if err := json.Unmarshal(data, &validators); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+	}
+
+	var tokens []*big.Int
+	for _, v := range validators {
+		if v.Status != bondedStatus || v.Jailed {
+			continue
+		}
+		n, ok := new(big.Int).SetString(v.Tokens, 10)
+		if !ok || n.Sign() <= 0 {
+			continue
+		}
+		tokens = append(tokens, n)
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].Cmp(tokens[j]) > 0 })
+	return tokens, nil
+}
+
+// FloatWeights converts relative weights to integers, keeping six decimal
+// places.
+func FloatWeights(weights []float64) []*big.Int {
+	out := make([]*big.Int, len(weights))
+	for i, w := range weights {
+		out[i], _ = big.NewFloat(w * 1e6).Int(nil)
+	}
+	return out
+}
+
+// SplitStake splits total between validators in proportion to weights.
+// Every validator gets at least minStake, so none drops out of the
+// validator set for lack of power; the total grows by what that adds.
+func SplitStake(total *big.Int, weights []*big.Int, minStake *big.Int) []*big.Int {
+	sum := new(big.Int)
+	for _, w := range weights {
+		sum.Add(sum, w)
+	}
+
+	stakes := make([]*big.Int, len(weights))
+	for i, w := range weights {
+		stake := new(big.Int)
+		if sum.Sign() > 0 {
+			stake.Mul(total, w)
+			stake.Quo(stake, sum)
+		}
+		if stake.Cmp(minStake) < 0 {
+			stake.Set(minStake)
+		}
+		stakes[i] = stake
+	}
+	return stakes
+}
@@ -0,0 +1,56 @@
+// internal/daemon/genesispatch/power_test.go
+package genesispatch
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePower(t *testing.T) {
+	assert.NoError(t, ValidatePower("", nil, 4))
+	assert.NoError(t, ValidatePower(PowerEqual, nil, 4))
+	assert.NoError(t, ValidatePower(PowerProportional, nil, 4))
+	assert.NoError(t, ValidatePower(PowerCustom, []float64{40, 30, 20, 10}, 4))
+
+	assert.Error(t, ValidatePower("whale", nil, 4))
+	assert.Error(t, ValidatePower(PowerEqual, []float64{1, 1}, 2), "weights need the custom profile")
+	assert.Error(t, ValidatePower(PowerCustom, []float64{40, 30}, 4), "one weight per validator")
+	assert.Error(t, ValidatePower(PowerCustom, []float64{1, 0}, 2), "weights are positive")
+}
+
+func TestBondedTokens(t *testing.T) {
+	genesis := pruneGenesis(t, map[string]interface{}{
+		"bank": map[string]interface{}{"balances": []interface{}{}},
+		"staking": map[string]interface{}{
+			"validators": []interface{}{
+				map[string]interface{}{"status": "BOND_STATUS_BONDED", "tokens": "300"},
+				map[string]interface{}{"status": "BOND_STATUS_UNBONDED", "tokens": "9000"},
+				map[string]interface{}{"status": "BOND_STATUS_BONDED", "tokens": "500"},
+				map[string]interface{}{"status": "BOND_STATUS_BONDED", "tokens": "700", "jailed": true},
+				map[string]interface{}{"status": "BOND_STATUS_BONDED", "tokens": "100"},
+			},
+			"delegations": []interface{}{},
+		},
+	})
+
+	tokens, err := BondedTokens(bytes.NewReader(genesis))
+	require.NoError(t, err)
+	assert.Equal(t, []*big.Int{big.NewInt(500), big.NewInt(300), big.NewInt(100)}, tokens)
+
+	tokens, err = BondedTokens(bytes.NewReader(pruneGenesis(t, map[string]interface{}{})))
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+}
+
+func TestSplitStake(t *testing.T) {
+	stakes := SplitStake(big.NewInt(1000), FloatWeights([]float64{50, 25, 12.5, 12.5}), big.NewInt(1))
+	assert.Equal(t, []*big.Int{big.NewInt(500), big.NewInt(250), big.NewInt(125), big.NewInt(125)}, stakes)
+
+	// Tiny shares are raised to the minimum
+	stakes = SplitStake(big.NewInt(1000), []*big.Int{big.NewInt(9999), big.NewInt(1)}, big.NewInt(10))
+	assert.Equal(t, []*big.Int{big.NewInt(999), big.NewInt(10)}, stakes)
+}
@@ -0,0 +1,260 @@
+// internal/daemon/genesispatch/prune.go
+package genesispatch
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Transform is a genesis rewrite that drops state a devnet does not need.
+// Transforms compose: Prune decodes the modules they use once and runs each
+// in turn.
+type Transform struct {
+	// Name identifies the transform in results and errors.
+	Name string
+	// Modules lists the app_state modules Apply reads or changes. Only these
+	// are decoded; the rest of the genesis is copied without decoding it.
+	Modules []string
+	// Apply rewrites the decoded genesis in place and returns how many
+	// entries it removed. Root holds only the sections the run decodes:
+	// within app_state, the modules of every transform in the run that
+	// exist in the genesis.
+	Apply func(root map[string]interface{}) (int, error)
+}
+
+// PruneResult reports what one transform removed.
+type PruneResult struct {
+	Name    string
+	Removed int
+}
+
+// Prune runs transforms over genesis in order and returns the result with
+// what each removed.
+func Prune(genesis []byte, transforms ...Transform) ([]byte, []PruneResult, error) {
+	if len(transforms) == 0 {
+		return genesis, nil, nil
+	}
+
+	results := make([]PruneResult, 0, len(transforms))
+	out, err := patchSections(genesis, prunePaths(transforms), runTransforms(transforms, &results))
+	if err != nil {
+		return nil, nil, err
+	}
+	return out, results, nil
+}
+
+// prunePaths returns the genesis sections transforms use.
+func prunePaths(transforms []Transform) []string {
+	var paths []string
+	for _, t := range transforms {
+		for _, m := range t.Modules {
+			paths = append(paths, "app_state."+m)
+		}
+	}
+	return sectionPaths(paths)
+}
+
+// runTransforms returns a patch that runs transforms in turn, appending
+// what each removed to results.
+func runTransforms(transforms []Transform, results *[]PruneResult) func(root map[string]interface{}) error {
+	return func(root map[string]interface{}) error {
+		for _, t := range transforms {
+			removed, err := t.Apply(root)
+			if err != nil {
+				return fmt.Errorf("%s: %w", t.Name, err)
+			}
+			*results = append(*results, PruneResult{Name: t.Name, Removed: removed})
+		}
+		return nil
+	}
+}
+
+// ClearIBCPackets removes the packet commitments, receipts and
+// acknowledgements of every IBC channel. Channels, connections, clients and
+// sequences stay, so the devnet's IBC state is consistent but carries no
+// in-flight or historical packets; relaying them needs the source chain's
+// counterparties anyway.
+func ClearIBCPackets() Transform {
+	return Transform{
+		Name:    "ibc-packets",
+		Modules: []string{"ibc"},
+		Apply: func(root map[string]interface{}) (int, error) {
+			channels := object(root, "app_state", "ibc", "channel_genesis")
+			if channels == nil {
+				return 0, nil
+			}
+			removed := 0
+			for _, key := range []string{"commitments", "receipts", "acknowledgements"} {
+				list, _ := channels[key].([]interface{})
+				removed += len(list)
+				channels[key] = []interface{}{}
+			}
+			return removed, nil
+		},
+	}
+}
+
+// TrimDustAccounts removes plain accounts whose balance is below threshold,
+// a single coin such as "1000uatom": base accounts holding less than
+// threshold of its denom and nothing else. Module, vesting and other
+// account types are kept, as are accounts that delegate, are unbonding or
+// redelegating, or belong to a CosmWasm contract. The bank supply is
+// lowered by the balances removed.
+func TrimDustAccounts(threshold string) (Transform, error) {
+	coins, err := ParseCoins(threshold)
+	if err != nil {
+		return Transform{}, fmt.Errorf("invalid dust threshold: %w", err)
+	}
+	if len(coins) != 1 {
+		return Transform{}, fmt.Errorf("dust threshold must be a single coin, got %q", threshold)
+	}
+	limit, _ := new(big.Int).SetString(coins[0].Amount, 10)
+	denom := coins[0].Denom
+
+	return Transform{
+		Name:    "dust-accounts",
+		Modules: []string{"auth", "bank", "staking", "wasm"},
+		Apply: func(root map[string]interface{}) (int, error) {
+			auth := object(root, "app_state", "auth")
+			bank := object(root, "app_state", "bank")
+			if auth == nil || bank == nil {
+				return 0, errors.New("genesis has no auth or bank module state")
+			}
+			keep := referencedAddresses(root)
+
+			dust := func(address string, held map[string]*big.Int) bool {
+				if keep[address] {
+					return false
+				}
+				for d, n := range held {
+					if d != denom && n.Sign() > 0 {
+						return false
+					}
+				}
+				n, ok := held[denom]
+				return !ok || n.Cmp(limit) < 0
+			}
+
+			balances, _ := bank["balances"].([]interface{})
+			held := make(map[string]map[string]*big.Int, len(balances))
+			for _, raw := range balances {
+				entry, _ := raw.(map[string]interface{})
+				if address, _ := entry["address"].(string); address != "" {
+					held[address] = coinMap(entry["coins"])
+				}
+			}
+
+			accounts, _ := auth["accounts"].([]interface{})
+			known := make(map[string]bool, len(accounts))
+			for _, raw := range accounts {
+				if address := accountAddress(raw); address != "" {
+					known[address] = true
+				}
+			}
+
+			// Only base accounts are candidates; a removed account takes its
+			// balance with it
+			removedAccounts := make(map[string]bool)
+			keptAccounts := accounts[:0]
+			for _, raw := range accounts {
+				acc, _ := raw.(map[string]interface{})
+				address, _ := acc["address"].(string)
+				if acc["@type"] == "/cosmos.auth.v1beta1.BaseAccount" && address != "" && dust(address, held[address]) {
+					removedAccounts[address] = true
+					continue
+				}
+				keptAccounts = append(keptAccounts, raw)
+			}
+			auth["accounts"] = keptAccounts
+
+			supply := coinMap(bank["supply"])
+			keptBalances := balances[:0]
+			for _, raw := range balances {
+				entry, _ := raw.(map[string]interface{})
+				address, _ := entry["address"].(string)
+				// Balances without an auth account are dropped by the same rule
+				if removedAccounts[address] || !known[address] && dust(address, held[address]) {
+					for d, n := range held[address] {
+						if cur, ok := supply[d]; ok {
+							cur.Sub(cur, n)
+						}
+					}
+					continue
+				}
+				keptBalances = append(keptBalances, raw)
+			}
+			bank["balances"] = keptBalances
+			if _, ok := bank["supply"]; ok {
+				for d, n := range supply {
+					if n.Sign() <= 0 {
+						delete(supply, d)
+					}
+				}
+				bank["supply"] = coinList(supply)
+			}
+
+			return len(removedAccounts), nil
+		},
+	}, nil
+}
+
+// DropWasmCode removes CosmWasm code blobs and, since a contract cannot be
+// imported without its code, the contracts instantiated from them. The code
+// and instance ID sequences are kept so new uploads do not reuse IDs.
+// Contract accounts and balances stay in the auth and bank modules.
+func DropWasmCode() Transform {
+	return Transform{
+		Name:    "wasm-code",
+		Modules: []string{"wasm"},
+		Apply: func(root map[string]interface{}) (int, error) {
+			wasm := object(root, "app_state", "wasm")
+			if wasm == nil {
+				return 0, nil
+			}
+			codes, _ := wasm["codes"].([]interface{})
+			contracts, _ := wasm["contracts"].([]interface{})
+			wasm["codes"] = []interface{}{}
+			wasm["contracts"] = []interface{}{}
+			return len(codes) + len(contracts), nil
+		},
+	}
+}
+
+// referencedAddresses returns the addresses genesis state outside auth and
+// bank depends on: delegators, unbonding and redelegating accounts, and
+// CosmWasm contracts.
+func referencedAddresses(root map[string]interface{}) map[string]bool {
+	out := make(map[string]bool)
+	collect := func(list interface{}, field string) {
+		entries, _ := list.([]interface{})
+		for _, raw := range entries {
+			entry, _ := raw.(map[string]interface{})
+			if address, _ := entry[field].(string); address != "" {
+				out[address] = true
+			}
+		}
+	}
+	if staking := object(root, "app_state", "staking"); staking != nil {
+		collect(staking["delegations"], "delegator_address")
+		collect(staking["unbonding_delegations"], "delegator_address")
+		collect(staking["redelegations"], "delegator_address")
+	}
+	if wasm := object(root, "app_state", "wasm"); wasm != nil {
+		collect(wasm["contracts"], "contract_address")
+	}
+	return out
+}
+
+// object walks keys down nested objects, returning nil if one is missing.
+func object(root map[string]interface{}, keys ...string) map[string]interface{} {
+	cur := root
+	for _, k := range keys {
+		next, ok := cur[k].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		cur = next
+	}
+	return cur
+}
@@ -0,0 +1,236 @@
+// internal/daemon/genesispatch/prune_test.go
+package genesispatch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func baseAccount(address string) map[string]interface{} {
+	return map[string]interface{}{
+		"@type":          "/cosmos.auth.v1beta1.BaseAccount",
+		"address":        address,
+		"account_number": "0",
+		"sequence":       "0",
+	}
+}
+
+func balance(address, denom, amount string) map[string]interface{} {
+	return map[string]interface{}{
+		"address": address,
+		"coins":   []interface{}{map[string]interface{}{"denom": denom, "amount": amount}},
+	}
+}
+
+func pruneGenesis(t *testing.T, appState map[string]interface{}) []byte {
+	t.Helper()
+	out, err := json.Marshal(map[string]interface{}{"chain_id": "source-1", "app_state": appState})
+	require.NoError(t, err)
+	return out
+}
+
+func appState(t *testing.T, genesis []byte) map[string]interface{} {
+	t.Helper()
+	var doc struct {
+		AppState map[string]interface{} `json:"app_state"`
+	}
+	require.NoError(t, json.Unmarshal(genesis, &doc))
+	return doc.AppState
+}
+
+func TestPrune_NoTransforms(t *testing.T) {
+	genesis := []byte(`{"chain_id":"source-1"}`)
+	out, results, err := Prune(genesis)
+	require.NoError(t, err)
+	assert.Equal(t, genesis, out)
+	assert.Empty(t, results)
+}
+
+func TestPrune_Composes(t *testing.T) {
+	genesis := pruneGenesis(t, map[string]interface{}{
+		"ibc": map[string]interface{}{
+			"channel_genesis": map[string]interface{}{
+				"commitments": []interface{}{map[string]interface{}{"sequence": "1"}},
+			},
+		},
+		"wasm": map[string]interface{}{
+			"codes": []interface{}{map[string]interface{}{"code_id": "1"}},
+		},
+	})
+
+	out, results, err := Prune(genesis, ClearIBCPackets(), DropWasmCode())
+	require.NoError(t, err)
+	assert.Equal(t, []PruneResult{{Name: "ibc-packets", Removed: 1}, {Name: "wasm-code", Removed: 1}}, results)
+	assert.JSONEq(t, `{
+		"chain_id": "source-1",
+		"app_state": {
+			"ibc": {"channel_genesis": {"commitments": [], "receipts": [], "acknowledgements": []}},
+			"wasm": {"codes": [], "contracts": []}
+		}
+	}`, string(out))
+
+	_, _, err = Prune([]byte(`[]`), ClearIBCPackets())
+	assert.ErrorContains(t, err, "genesis is not an object")
+}
+
+func TestClearIBCPackets(t *testing.T) {
+	channel := map[string]interface{}{"port_id": "transfer", "channel_id": "channel-0"}
+	genesis := pruneGenesis(t, map[string]interface{}{
+		"ibc": map[string]interface{}{
+			"channel_genesis": map[string]interface{}{
+				"channels":         []interface{}{channel},
+				"commitments":      []interface{}{map[string]interface{}{"sequence": "7"}, map[string]interface{}{"sequence": "8"}},
+				"receipts":         []interface{}{map[string]interface{}{"sequence": "3"}},
+				"acknowledgements": []interface{}{map[string]interface{}{"sequence": "2"}},
+				"send_sequences":   []interface{}{map[string]interface{}{"sequence": "9"}},
+			},
+		},
+	})
+
+	out, results, err := Prune(genesis, ClearIBCPackets())
+	require.NoError(t, err)
+	assert.Equal(t, 4, results[0].Removed)
+
+	channels := appState(t, out)["ibc"].(map[string]interface{})["channel_genesis"].(map[string]interface{})
+	assert.Empty(t, channels["commitments"])
+	assert.Empty(t, channels["receipts"])
+	assert.Empty(t, channels["acknowledgements"])
+	assert.Len(t, channels["channels"], 1, "channels are kept")
+	assert.Len(t, channels["send_sequences"], 1, "sequences are kept")
+
+	// Chains without IBC are left alone
+	out, results, err = Prune(pruneGenesis(t, map[string]interface{}{"bank": map[string]interface{}{}}), ClearIBCPackets())
+	require.NoError(t, err)
+	assert.Equal(t, 0, results[0].Removed)
+	assert.NotContains(t, appState(t, out), "ibc")
+}
+
+func TestTrimDustAccounts(t *testing.T) {
+	rich := testAddress(t, 1)
+	dust := testAddress(t, 2)
+	empty := testAddress(t, 3)
+	delegator := testAddress(t, 4)
+	multiDenom := testAddress(t, 5)
+	vesting := testAddress(t, 6)
+	contract := testAddress(t, 7)
+	orphan := testAddress(t, 8)
+
+	genesis := pruneGenesis(t, map[string]interface{}{
+		"auth": map[string]interface{}{
+			"accounts": []interface{}{
+				baseAccount(rich),
+				baseAccount(dust),
+				baseAccount(empty),
+				baseAccount(delegator),
+				baseAccount(multiDenom),
+				map[string]interface{}{
+					"@type": "/cosmos.vesting.v1beta1.DelayedVestingAccount",
+					"base_vesting_account": map[string]interface{}{
+						"base_account": map[string]interface{}{"address": vesting},
+					},
+				},
+				map[string]interface{}{
+					"@type":        "/cosmos.auth.v1beta1.ModuleAccount",
+					"base_account": map[string]interface{}{"address": testAddress(t, 9)},
+					"name":         "distribution",
+				},
+				baseAccount(contract),
+			},
+		},
+		"bank": map[string]interface{}{
+			"balances": []interface{}{
+				balance(rich, "uatom", "5000"),
+				balance(dust, "uatom", "10"),
+				balance(delegator, "uatom", "1"),
+				map[string]interface{}{
+					"address": multiDenom,
+					"coins": []interface{}{
+						map[string]interface{}{"denom": "ibc/ABC", "amount": "3"},
+						map[string]interface{}{"denom": "uatom", "amount": "1"},
+					},
+				},
+				balance(vesting, "uatom", "1"),
+				balance(contract, "uatom", "1"),
+				balance(orphan, "uatom", "20"),
+			},
+			"supply": []interface{}{
+				map[string]interface{}{"denom": "ibc/ABC", "amount": "3"},
+				map[string]interface{}{"denom": "uatom", "amount": "5034"},
+			},
+		},
+		"staking": map[string]interface{}{
+			"delegations": []interface{}{map[string]interface{}{"delegator_address": delegator}},
+		},
+		"wasm": map[string]interface{}{
+			"contracts": []interface{}{map[string]interface{}{"contract_address": contract}},
+		},
+	})
+
+	transform, err := TrimDustAccounts("1000uatom")
+	require.NoError(t, err)
+	out, results, err := Prune(genesis, transform)
+	require.NoError(t, err)
+	assert.Equal(t, 2, results[0].Removed, "the dust and empty base accounts")
+
+	state := appState(t, out)
+	var accounts []string
+	for _, raw := range state["auth"].(map[string]interface{})["accounts"].([]interface{}) {
+		accounts = append(accounts, accountAddress(raw))
+	}
+	assert.Equal(t, []string{rich, delegator, multiDenom, vesting, testAddress(t, 9), contract}, accounts)
+
+	bank := state["bank"].(map[string]interface{})
+	var balances []string
+	for _, raw := range bank["balances"].([]interface{}) {
+		balances = append(balances, raw.(map[string]interface{})["address"].(string))
+	}
+	assert.Equal(t, []string{rich, delegator, multiDenom, vesting, contract}, balances, "dust and orphan balances are removed")
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"denom": "ibc/ABC", "amount": "3"},
+		map[string]interface{}{"denom": "uatom", "amount": "5004"},
+	}, bank["supply"], "supply drops by the removed balances")
+}
+
+func TestTrimDustAccounts_InvalidThreshold(t *testing.T) {
+	for _, bad := range []string{"", "uatom", "0uatom", "10uatom,5ustake"} {
+		_, err := TrimDustAccounts(bad)
+		assert.Error(t, err, bad)
+	}
+
+	transform, err := TrimDustAccounts("10uatom")
+	require.NoError(t, err)
+	_, _, err = Prune(pruneGenesis(t, map[string]interface{}{}), transform)
+	assert.ErrorContains(t, err, "dust-accounts: genesis has no auth or bank module state")
+}
+
+func TestDropWasmCode(t *testing.T) {
+	genesis := pruneGenesis(t, map[string]interface{}{
+		"wasm": map[string]interface{}{
+			"params": map[string]interface{}{"instantiate_default_permission": "Everybody"},
+			"codes": []interface{}{
+				map[string]interface{}{"code_id": "1", "code_bytes": "AGFzbQ=="},
+				map[string]interface{}{"code_id": "2", "code_bytes": "AGFzbQ=="},
+			},
+			"contracts": []interface{}{map[string]interface{}{"contract_address": testAddress(t, 1)}},
+			"sequences": []interface{}{map[string]interface{}{"id_key": "BGxhc3RDb2RlSWQ=", "value": "3"}},
+		},
+	})
+
+	out, results, err := Prune(genesis, DropWasmCode())
+	require.NoError(t, err)
+	assert.Equal(t, 3, results[0].Removed)
+
+	wasm := appState(t, out)["wasm"].(map[string]interface{})
+	assert.Empty(t, wasm["codes"])
+	assert.Empty(t, wasm["contracts"])
+	assert.Len(t, wasm["sequences"], 1, "sequences are kept so code IDs are not reused")
+	assert.Contains(t, wasm, "params")
+
+	// Chains without CosmWasm are left alone
+	_, results, err = Prune(pruneGenesis(t, map[string]interface{}{}), DropWasmCode())
+	require.NoError(t, err)
+	assert.Equal(t, 0, results[0].Removed)
+}
@@ -0,0 +1,649 @@
+// internal/daemon/genesispatch/stream.go
+package genesispatch
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Mainnet genesis exports run to several GB, and decoding one into maps
+// takes many times its size in memory. The functions here work on the
+// encoded document instead: they decode only the sections a change touches,
+// such as "app_state.bank", and copy everything else as raw bytes, so
+// memory is bounded by those sections rather than the whole genesis.
+
+// ReadSections decodes the values at paths from the genesis read from r.
+// Paths are dotted object keys such as "chain_id" or "app_state.bank";
+// those that do not exist are left out of the result. Everything else is
+// skipped without being decoded.
+func ReadSections(r io.Reader, paths ...string) (map[string]json.RawMessage, error) {
+	s := newSectionStream(r, nil, paths)
+	s.found = make(map[string]json.RawMessage, len(paths))
+	if err := s.run(); err != nil {
+		return nil, err
+	}
+	return s.found, nil
+}
+
+// WriteSections copies the genesis read from r to w as compact JSON,
+// replacing the values at the paths in sections. A path missing from the
+// genesis is added to its parent object, which must exist.
+func WriteSections(w io.Writer, r io.Reader, sections map[string]json.RawMessage) error {
+	paths := make([]string, 0, len(sections))
+	for path := range sections {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	bw := bufio.NewWriterSize(w, 1<<20)
+	s := newSectionStream(r, bw, paths)
+	s.sections = sections
+	s.written = make(map[string]bool, len(sections))
+	if err := s.run(); err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if !s.written[path] {
+			return fmt.Errorf("%s: parent object does not exist in genesis", path)
+		}
+	}
+	return bw.Flush()
+}
+
+// patchSections decodes the sections of genesis at paths into a root object
+// holding only those sections, lets fn change them in place, and returns the
+// genesis with the sections re-encoded and everything else copied as is.
+// Sections missing from genesis are absent from root and not written back.
+func patchSections(genesis []byte, paths []string, fn func(root map[string]interface{}) error) ([]byte, error) {
+	var out bytes.Buffer
+	out.Grow(len(genesis))
+	open := func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(genesis)), nil }
+	if err := patchStream(&out, open, paths, fn); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// patchFile is patchSections for the genesis file at src, written to dst.
+// Neither is held in memory.
+func patchFile(dst, src string, paths []string, fn func(root map[string]interface{}) error) error {
+	open := func() (io.ReadCloser, error) { return os.Open(src) }
+	return WriteFile(dst, func(w io.Writer) error {
+		return patchStream(w, open, paths, fn)
+	})
+}
+
+// patchStream does the work of patchSections, reading the genesis twice
+// through open: once for the sections, skipping everything else, and once
+// to copy it to w. Sections fn adds to root are written too, to their parent
+// object.
+func patchStream(w io.Writer, open func() (io.ReadCloser, error), paths []string, fn func(root map[string]interface{}) error) error {
+	r, err := open()
+	if err != nil {
+		return err
+	}
+	raw, err := ReadSections(r, paths...)
+	r.Close()
+	if err != nil {
+		return fmt.Errorf("failed to parse genesis: %w", err)
+	}
+
+	root := make(map[string]interface{})
+	for path, data := range raw {
+		value, err := decode(data)
+		if err != nil {
+			return fmt.Errorf("failed to parse genesis: %s: %w", path, err)
+		}
+		insertSection(root, path, value)
+	}
+
+	if err := fn(root); err != nil {
+		return err
+	}
+
+	sections := make(map[string]json.RawMessage, len(paths))
+	for _, path := range paths {
+		value, ok := lookupSection(root, path)
+		if !ok {
+			continue
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to encode genesis: %s: %w", path, err)
+		}
+		sections[path] = data
+	}
+
+	if r, err = open(); err != nil {
+		return err
+	}
+	defer r.Close()
+	if err := WriteSections(w, r, sections); err != nil {
+		return fmt.Errorf("failed to encode genesis: %w", err)
+	}
+	return nil
+}
+
+// Rewrite is a set of changes RewriteFile makes to a genesis.
+type Rewrite struct {
+	// Transforms prune the genesis, in order.
+	Transforms []Transform
+	// Set replaces the values at dotted paths, after the transforms run.
+	// A path missing from the genesis is added to its parent object.
+	Set map[string]json.RawMessage
+	// Read lists dotted paths whose values in the source are returned.
+	Read []string
+}
+
+// RewriteResult reports what RewriteFile did.
+type RewriteResult struct {
+	// Pruned reports what each transform removed.
+	Pruned []PruneResult
+	// Values holds the source values at the Read paths that exist.
+	Values map[string]json.RawMessage
+}
+
+// RewriteFile writes the genesis file at src to dst with the changes in rw
+// made, reading src once for the sections they touch and once to copy it.
+// Neither file is held in memory.
+func RewriteFile(dst, src string, rw Rewrite) (*RewriteResult, error) {
+	paths := append(prunePaths(rw.Transforms), rw.Read...)
+	for path := range rw.Set {
+		paths = append(paths, path)
+	}
+
+	result := &RewriteResult{
+		Pruned: make([]PruneResult, 0, len(rw.Transforms)),
+		Values: make(map[string]json.RawMessage, len(rw.Read)),
+	}
+	prune := runTransforms(rw.Transforms, &result.Pruned)
+	err := patchFile(dst, src, sectionPaths(paths), func(root map[string]interface{}) error {
+		for _, path := range rw.Read {
+			value, ok := lookupSection(root, path)
+			if !ok {
+				continue
+			}
+			data, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Errorf("failed to encode genesis: %s: %w", path, err)
+			}
+			result.Values[path] = data
+		}
+		if err := prune(root); err != nil {
+			return err
+		}
+		for path, data := range rw.Set {
+			value, err := decode(data)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			insertSection(root, path, value)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// WriteFile creates the file at path and writes it with write, removing it
+// again if write fails.
+func WriteFile(path string, write func(w io.Writer) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(file); err != nil {
+		file.Close()
+		os.Remove(path)
+		return err
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(path)
+		return err
+	}
+	return nil
+}
+
+// insertSection stores value at the dotted path within root, creating the
+// objects above it.
+func insertSection(root map[string]interface{}, path string, value interface{}) {
+	keys := strings.Split(path, ".")
+	cur := root
+	for _, k := range keys[:len(keys)-1] {
+		next, ok := cur[k].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			cur[k] = next
+		}
+		cur = next
+	}
+	cur[keys[len(keys)-1]] = value
+}
+
+// lookupSection returns the value at the dotted path within root, reporting
+// whether there is one.
+func lookupSection(root map[string]interface{}, path string) (interface{}, bool) {
+	keys := strings.Split(path, ".")
+	parent := object(root, keys[:len(keys)-1]...)
+	if parent == nil {
+		return nil, false
+	}
+	value, ok := parent[keys[len(keys)-1]]
+	return value, ok
+}
+
+// sectionPaths reduces paths to those not nested in another, since a
+// section already covers everything below it.
+func sectionPaths(paths []string) []string {
+	sort.Strings(paths)
+	var out []string
+	for _, p := range paths {
+		if n := len(out); n > 0 && (out[n-1] == p || strings.HasPrefix(p, out[n-1]+".")) {
+			continue
+		}
+		out = append(out, p)
+	}
+	return out
+}
+
+// errSectionsFound stops a read once every wanted section has been found.
+var errSectionsFound = errors.New("all sections found")
+
+// sectionStream walks a JSON document byte by byte. Reading, it captures the
+// values at the wanted paths and skips the rest; writing, it copies the
+// document to w as compact JSON and substitutes the wanted values. Only the
+// keys of the objects leading to a wanted path are decoded: every other
+// value is scanned for its end and copied or skipped as raw bytes.
+type sectionStream struct {
+	r        *bufio.Reader
+	off      int64         // bytes consumed from r, for errors
+	w        *bufio.Writer // nil when reading
+	paths    []string
+	want     map[string]bool
+	prefixes map[string]bool
+
+	found    map[string]json.RawMessage // reading: values at wanted paths
+	sections map[string]json.RawMessage // writing: replacement values
+	written  map[string]bool            // writing: sections already written
+
+	closers []byte // composite: closing brackets of the open containers
+	scalar  []byte // scalar: the literal being read
+}
+
+func newSectionStream(r io.Reader, w *bufio.Writer, paths []string) *sectionStream {
+	s := &sectionStream{
+		r:        bufio.NewReaderSize(r, 1<<20),
+		w:        w,
+		paths:    paths,
+		want:     make(map[string]bool, len(paths)),
+		prefixes: make(map[string]bool),
+	}
+	for _, path := range paths {
+		s.want[path] = true
+		for i := strings.IndexByte(path, '.'); i >= 0; i = nextDot(path, i) {
+			s.prefixes[path[:i]] = true
+		}
+	}
+	return s
+}
+
+func nextDot(path string, i int) int {
+	j := strings.IndexByte(path[i+1:], '.')
+	if j < 0 {
+		return -1
+	}
+	return i + 1 + j
+}
+
+func (s *sectionStream) run() error {
+	c, err := s.next()
+	if err != nil {
+		return err
+	}
+	if c != '{' {
+		return errors.New("genesis is not an object")
+	}
+	s.writeByte('{')
+	if err := s.object(""); err != nil {
+		if err == errSectionsFound {
+			return nil
+		}
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	s.writeByte('}')
+	if _, err := s.peek(); err != io.EOF {
+		return errors.New("unexpected data after genesis object")
+	}
+	return nil
+}
+
+// object walks the members of an object whose opening brace has been read,
+// consuming its closing brace.
+func (s *sectionStream) object(prefix string) error {
+	first := true
+	member := func(key string) {
+		if !first {
+			s.writeByte(',')
+		}
+		first = false
+		s.writeByte('"')
+		s.w.WriteString(key)
+		s.writeByte('"')
+		s.writeByte(':')
+	}
+
+	c, err := s.peek()
+	if err != nil {
+		return err
+	}
+	if c == '}' {
+		s.next()
+	}
+	for c != '}' {
+		if err := s.expect('"'); err != nil {
+			return err
+		}
+		raw, key, err := s.key()
+		if err != nil {
+			return err
+		}
+		if err := s.expect(':'); err != nil {
+			return err
+		}
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		switch {
+		case s.want[path] && s.w == nil:
+			var value bytes.Buffer
+			if err := s.value(&value); err != nil {
+				return err
+			}
+			s.found[path] = value.Bytes()
+			if len(s.found) == len(s.want) {
+				return errSectionsFound
+			}
+		case s.want[path]:
+			if err := s.value(nil); err != nil {
+				return err
+			}
+			member(raw)
+			s.w.Write(s.sections[path])
+			s.written[path] = true
+		case s.prefixes[path]:
+			if s.w != nil {
+				member(raw)
+			}
+			if err := s.prefixValue(path); err != nil {
+				return err
+			}
+		case s.w == nil:
+			if err := s.value(nil); err != nil {
+				return err
+			}
+		default:
+			member(raw)
+			if err := s.value(s.w); err != nil {
+				return err
+			}
+		}
+
+		if c, err = s.next(); err != nil {
+			return err
+		}
+		if c != ',' && c != '}' {
+			return s.syntaxError("expected ',' or '}' after object member")
+		}
+	}
+
+	// Add replacements missing from this object
+	if s.w != nil {
+		for _, path := range s.paths {
+			parent, key := "", path
+			if i := strings.LastIndexByte(path, '.'); i >= 0 {
+				parent, key = path[:i], path[i+1:]
+			}
+			if parent != prefix || s.written[path] {
+				continue
+			}
+			member(key)
+			s.w.Write(s.sections[path])
+			s.written[path] = true
+		}
+	}
+	return nil
+}
+
+// prefixValue walks the value at path, which leads to wanted paths: an
+// object is walked member by member, anything else copied as is.
+func (s *sectionStream) prefixValue(path string) error {
+	c, err := s.peek()
+	if err != nil {
+		return err
+	}
+	if c != '{' {
+		return s.value(s.out())
+	}
+	s.next()
+	s.writeByte('{')
+	if err := s.object(path); err != nil {
+		return err
+	}
+	s.writeByte('}')
+	return nil
+}
+
+// value copies the next value to out as compact JSON, or skips it when out
+// is nil. The value is not decoded: objects, arrays and strings are scanned
+// for their end and copied in chunks of the read buffer.
+func (s *sectionStream) value(out io.Writer) error {
+	c, err := s.peek()
+	if err != nil {
+		return err
+	}
+	switch c {
+	case '{', '[', '"':
+		return s.composite(out)
+	default:
+		return s.literal(out)
+	}
+}
+
+// composite copies the object, array or string the reader is at, dropping
+// whitespace outside strings.
+func (s *sectionStream) composite(out io.Writer) error {
+	s.closers = s.closers[:0]
+	inString, escaped := false, false
+	for {
+		buf, err := s.r.Peek(max(s.r.Buffered(), 1))
+		if len(buf) == 0 {
+			return err
+		}
+		start := 0
+		for i, c := range buf {
+			if inString {
+				switch {
+				case escaped:
+					escaped = false
+				case c == '\\':
+					escaped = true
+				case c == '"':
+					inString = false
+					if len(s.closers) == 0 {
+						s.emit(out, buf[start:i+1], i+1)
+						return nil
+					}
+				case c < 0x20:
+					return s.syntaxErrorAt(i, "control character in string")
+				}
+				continue
+			}
+			switch c {
+			case '"':
+				inString = true
+			case '{':
+				s.closers = append(s.closers, '}')
+			case '[':
+				s.closers = append(s.closers, ']')
+			case '}', ']':
+				n := len(s.closers)
+				if n == 0 || s.closers[n-1] != c {
+					return s.syntaxErrorAt(i, fmt.Sprintf("unexpected %q", c))
+				}
+				s.closers = s.closers[:n-1]
+				if n == 1 {
+					s.emit(out, buf[start:i+1], i+1)
+					return nil
+				}
+			case ' ', '\t', '\n', '\r':
+				if out != nil && i > start {
+					out.Write(buf[start:i])
+				}
+				start = i + 1
+			}
+		}
+		s.emit(out, buf[start:], len(buf))
+	}
+}
+
+// literal copies the number, true, false or null the reader is at.
+func (s *sectionStream) literal(out io.Writer) error {
+	s.scalar = s.scalar[:0]
+	for {
+		c, err := s.r.ReadByte()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if c == ',' || c == '}' || c == ']' || c == ':' || isSpace(c) {
+			s.r.UnreadByte()
+			break
+		}
+		s.scalar = append(s.scalar, c)
+		s.off++
+	}
+	if !json.Valid(s.scalar) {
+		return s.syntaxError(fmt.Sprintf("invalid value %q", s.scalar))
+	}
+	if out != nil {
+		out.Write(s.scalar)
+	}
+	return nil
+}
+
+// emit writes chunk to out, if any, and consumes n bytes of the reader.
+func (s *sectionStream) emit(out io.Writer, chunk []byte, n int) {
+	if out != nil && len(chunk) > 0 {
+		out.Write(chunk)
+	}
+	s.r.Discard(n)
+	s.off += int64(n)
+}
+
+// key reads an object key whose opening quote has been consumed, returning
+// it as written and decoded.
+func (s *sectionStream) key() (raw, key string, err error) {
+	s.scalar = s.scalar[:0]
+	escaped := false
+	for {
+		c, err := s.r.ReadByte()
+		if err != nil {
+			return "", "", err
+		}
+		s.off++
+		if !escaped && c == '"' {
+			break
+		}
+		escaped = !escaped && c == '\\'
+		s.scalar = append(s.scalar, c)
+	}
+	raw = string(s.scalar)
+	if !strings.ContainsRune(raw, '\\') {
+		return raw, raw, nil
+	}
+	if err := json.Unmarshal([]byte(`"`+raw+`"`), &key); err != nil {
+		return "", "", s.syntaxError(fmt.Sprintf("invalid key %q", raw))
+	}
+	return raw, key, nil
+}
+
+// peek skips whitespace and returns the next byte without consuming it.
+func (s *sectionStream) peek() (byte, error) {
+	for {
+		c, err := s.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if !isSpace(c) {
+			s.r.UnreadByte()
+			return c, nil
+		}
+		s.off++
+	}
+}
+
+// next skips whitespace and consumes the next byte.
+func (s *sectionStream) next() (byte, error) {
+	c, err := s.peek()
+	if err != nil {
+		return 0, err
+	}
+	s.r.ReadByte()
+	s.off++
+	return c, nil
+}
+
+// expect consumes the next byte, failing unless it is want.
+func (s *sectionStream) expect(want byte) error {
+	c, err := s.next()
+	if err != nil {
+		return err
+	}
+	if c != want {
+		return s.syntaxError(fmt.Sprintf("expected %q, found %q", want, c))
+	}
+	return nil
+}
+
+func (s *sectionStream) syntaxError(msg string) error {
+	return s.syntaxErrorAt(0, msg)
+}
+
+// syntaxErrorAt reports a syntax error i bytes past the bytes consumed.
+func (s *sectionStream) syntaxErrorAt(i int, msg string) error {
+	return fmt.Errorf("invalid JSON at offset %d: %s", s.off+int64(i), msg)
+}
+
+// out returns the writer values are copied to, nil when reading.
+func (s *sectionStream) out() io.Writer {
+	if s.w == nil {
+		return nil
+	}
+	return s.w
+}
+
+func (s *sectionStream) writeByte(c byte) {
+	if s.w != nil {
+		s.w.WriteByte(c)
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
@@ -0,0 +1,216 @@
+// internal/daemon/genesispatch/stream_test.go
+package genesispatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadSections(t *testing.T) {
+	sections, err := ReadSections(strings.NewReader(testGenesis), "chain_id", "app_state.gov", "app_state.missing", "nope.x")
+	require.NoError(t, err)
+	assert.Len(t, sections, 2)
+	assert.JSONEq(t, `"devnet-1"`, string(sections["chain_id"]))
+	assert.JSONEq(t, `{"params": {"voting_period": "172800s", "quorum": "0.334", "burn_vote_veto": true}}`, string(sections["app_state.gov"]))
+
+	_, err = ReadSections(strings.NewReader(`[1, 2]`), "chain_id")
+	assert.ErrorContains(t, err, "genesis is not an object")
+	_, err = ReadSections(strings.NewReader(`{"chain_id": `), "app_state")
+	assert.Error(t, err)
+}
+
+func TestWriteSections(t *testing.T) {
+	genesis := `{"genesis_time": "2024-01-01T00:00:00Z", "chain_id": "a<b>", "initial_height": 12345678901234567890,
+		"app_state": {"gov": {"params": {}}, "bank": {"balances": [{"address": "a1"}, null, true]}}}`
+
+	var out bytes.Buffer
+	err := WriteSections(&out, strings.NewReader(genesis), map[string]json.RawMessage{
+		"chain_id":          json.RawMessage(`"devnet-1"`),
+		"app_state.gov":     json.RawMessage(`{"params":{"voting_period":"30s"}}`),
+		"app_state.crisis":  json.RawMessage(`{}`),
+		"consensus_params2": json.RawMessage(`null`),
+	})
+	require.NoError(t, err)
+
+	// Key order, escaping and number precision survive; missing sections
+	// are added to their parent
+	assert.Equal(t, `{"genesis_time":"2024-01-01T00:00:00Z","chain_id":"devnet-1","initial_height":12345678901234567890,`+
+		`"app_state":{"gov":{"params":{"voting_period":"30s"}},"bank":{"balances":[{"address":"a1"},null,true]},"crisis":{}},"consensus_params2":null}`,
+		out.String())
+
+	err = WriteSections(&out, strings.NewReader(genesis), map[string]json.RawMessage{
+		"consensus.params.block": json.RawMessage(`{}`),
+	})
+	assert.ErrorContains(t, err, "parent object does not exist")
+}
+
+func TestWriteSections_CopiesRawValues(t *testing.T) {
+	genesis := "{\n  \"chain_id\" : \"a\\\"b\",\n  \"app_state\": {\n    \"k\\u0065y\": [ 1.50, -2e3, \"x ] } \\\\\" , {\"a\" : null} ],\n    \"gov\": {}\n  }\n}\n"
+
+	var out bytes.Buffer
+	err := WriteSections(&out, strings.NewReader(genesis), map[string]json.RawMessage{"app_state.gov": json.RawMessage(`{"x":1}`)})
+	require.NoError(t, err)
+
+	// Whitespace outside strings goes; keys, strings and numbers are copied
+	// byte for byte
+	assert.Equal(t, `{"chain_id":"a\"b","app_state":{"k\u0065y":[1.50,-2e3,"x ] } \\",{"a":null}],"gov":{"x":1}}}`, out.String())
+
+	// Escaped keys are matched decoded
+	sections, err := ReadSections(strings.NewReader(genesis), "app_state.key")
+	require.NoError(t, err)
+	assert.Equal(t, `[1.50,-2e3,"x ] } \\",{"a":null}]`, string(sections["app_state.key"]))
+}
+
+func TestWriteSections_InvalidJSON(t *testing.T) {
+	for name, genesis := range map[string]string{
+		"truncated":          `{"chain_id":"a","app_state":{"bank":[1,2`,
+		"mismatched bracket": `{"chain_id":"a","app_state":{"bank":[1,2}}}`,
+		"missing colon":      `{"chain_id" "a"}`,
+		"missing comma":      `{"chain_id":"a" "app_state":{}}`,
+		"invalid literal":    `{"chain_id":tru}`,
+		"trailing data":      `{"chain_id":"a"} {}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out bytes.Buffer
+			err := WriteSections(&out, strings.NewReader(genesis), map[string]json.RawMessage{"app_state.gov": json.RawMessage(`{}`)})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRewriteFile(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "genesis.json")
+	dst := filepath.Join(dir, "rewritten.json")
+	require.NoError(t, os.WriteFile(src, []byte(`{"chain_id":"source-1","app_state":{"wasm":{"codes":[{"code_id":"1"}]},"bank":{"balances":[]}}}`), 0644))
+
+	result, err := RewriteFile(dst, src, Rewrite{
+		Transforms: []Transform{DropWasmCode()},
+		Set: map[string]json.RawMessage{
+			"chain_id":           json.RawMessage(`"devnet-1"`),
+			"app_state.wasm.foo": json.RawMessage(`1`),
+		},
+		Read: []string{"chain_id", "app_state.missing"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []PruneResult{{Name: "wasm-code", Removed: 1}}, result.Pruned)
+	assert.Equal(t, map[string]json.RawMessage{"chain_id": json.RawMessage(`"source-1"`)}, result.Values)
+	out, err := os.ReadFile(dst)
+	require.NoError(t, err)
+	assert.Equal(t, `{"chain_id":"devnet-1","app_state":{"wasm":{"codes":[],"contracts":[],"foo":1},"bank":{"balances":[]}}}`, string(out))
+
+	// A failed rewrite leaves no output behind
+	require.NoError(t, os.WriteFile(src, []byte(`[]`), 0644))
+	require.NoError(t, os.Remove(dst))
+	_, err = RewriteFile(dst, src, Rewrite{Transforms: []Transform{DropWasmCode()}})
+	assert.ErrorContains(t, err, "genesis is not an object")
+	assert.NoFileExists(t, dst)
+}
+
+func TestApply_KeepsUntouchedSections(t *testing.T) {
+	genesis := `{"chain_id":"devnet-1","app_state":{"wasm":{"codes":[{"code_bytes":"<AGFzbQ==>"}]},"gov":{"params":{"voting_period":"172800s"}}}}`
+
+	out, err := Apply([]byte(genesis), map[string]string{"app_state.gov.params.voting_period": `"30s"`})
+	require.NoError(t, err)
+	assert.Equal(t, `{"chain_id":"devnet-1","app_state":{"wasm":{"codes":[{"code_bytes":"<AGFzbQ==>"}]},"gov":{"params":{"voting_period":"30s"}}}}`, string(out))
+}
+
+// benchGenesis builds a genesis of roughly size bytes, most of it bank
+// balances as in mainnet exports.
+func benchGenesis(size int) []byte {
+	var b strings.Builder
+	b.WriteString(`{"chain_id":"bench-1","app_state":{"gov":{"params":{"voting_period":"172800s"}},"bank":{"balances":[`)
+	for i := 0; b.Len() < size; i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, `{"address":"cosmos1%038d","coins":[{"denom":"uatom","amount":"%d"}]}`, i, i*1000)
+	}
+	b.WriteString(`]}}}`)
+	return []byte(b.String())
+}
+
+// reportPeakHeap runs fn b.N times and reports the highest heap in use
+// while it ran, above what was in use before.
+func reportPeakHeap(b *testing.B, fn func() error) {
+	runtime.GC()
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	base := ms.HeapAlloc
+
+	var mu sync.Mutex
+	var peak uint64
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var ms runtime.MemStats
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(time.Millisecond):
+			}
+			runtime.ReadMemStats(&ms)
+			mu.Lock()
+			peak = max(peak, ms.HeapAlloc)
+			mu.Unlock()
+		}
+	}()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := fn(); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	close(done)
+	wg.Wait()
+
+	if peak > base {
+		b.ReportMetric(float64(peak-base)/(1<<20), "peak-heap-MB")
+	}
+}
+
+var overrideBenchmark = map[string]string{"app_state.gov.params.voting_period": `"30s"`}
+
+// BenchmarkApply_Decode decodes the whole genesis, as Apply used to.
+func BenchmarkApply_Decode(b *testing.B) {
+	genesis := benchGenesis(64 << 20)
+	b.SetBytes(int64(len(genesis)))
+	reportPeakHeap(b, func() error {
+		doc, err := decode(genesis)
+		if err != nil {
+			return err
+		}
+		segments, _ := ParsePath("app_state.gov.params.voting_period")
+		if _, err := set(doc, segments, "30s", nil); err != nil {
+			return err
+		}
+		_, err = json.Marshal(doc)
+		return err
+	})
+}
+
+// BenchmarkApply streams the genesis, decoding only app_state.gov.
+func BenchmarkApply(b *testing.B) {
+	genesis := benchGenesis(64 << 20)
+	b.SetBytes(int64(len(genesis)))
+	reportPeakHeap(b, func() error {
+		_, err := Apply(genesis, overrideBenchmark)
+		return err
+	})
+}
@@ -0,0 +1,222 @@
+// internal/daemon/gov/gov.go
+
+// Package gov queries a devnet's governance proposals through the Cosmos
+// SDK REST API, so clients can follow a proposal from submission until it
+// passes or fails.
+package gov
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned when the proposal does not exist, or when there
+// are no proposals at all.
+var ErrNotFound = errors.New("proposal not found")
+
+// Proposal statuses, as reported by x/gov v1.
+const (
+	StatusDepositPeriod = "PROPOSAL_STATUS_DEPOSIT_PERIOD"
+	StatusVotingPeriod  = "PROPOSAL_STATUS_VOTING_PERIOD"
+	StatusPassed        = "PROPOSAL_STATUS_PASSED"
+	StatusRejected      = "PROPOSAL_STATUS_REJECTED"
+	StatusFailed        = "PROPOSAL_STATUS_FAILED"
+)
+
+// Tally holds the vote counts of a proposal, as integer strings of voting
+// power.
+type Tally struct {
+	Yes        string
+	No         string
+	Abstain    string
+	NoWithVeto string
+}
+
+// Proposal is a governance proposal.
+type Proposal struct {
+	ID            uint64
+	Status        string
+	Title         string
+	Summary       string
+	Proposer      string
+	SubmitTime    time.Time
+	VotingEndTime time.Time
+	// FailedReason explains why a passed proposal's messages failed to
+	// execute.
+	FailedReason string
+	// Tally is the live tally during the voting period and the final tally
+	// afterwards.
+	Tally Tally
+}
+
+// Finished reports whether the proposal has reached a final status.
+func (p *Proposal) Finished() bool {
+	switch p.Status {
+	case StatusPassed, StatusRejected, StatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Config configures a Querier.
+type Config struct {
+	// RESTEndpoint is the Cosmos SDK REST API base URL (e.g.,
+	// "http://127.0.0.1:1317").
+	RESTEndpoint string
+
+	// HTTPClient is used for queries. Defaults to a client with a 30s timeout.
+	HTTPClient *http.Client
+}
+
+// Querier reads governance proposals from a node.
+type Querier struct {
+	config Config
+	client *http.Client
+}
+
+// NewQuerier creates a new Querier.
+func NewQuerier(cfg Config) *Querier {
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Querier{config: cfg, client: httpClient}
+}
+
+type proposalJSON struct {
+	ID               string    `json:"id"`
+	Status           string    `json:"status"`
+	Title            string    `json:"title"`
+	Summary          string    `json:"summary"`
+	Proposer         string    `json:"proposer"`
+	SubmitTime       time.Time `json:"submit_time"`
+	VotingEndTime    time.Time `json:"voting_end_time"`
+	FailedReason     string    `json:"failed_reason"`
+	FinalTallyResult tallyJSON `json:"final_tally_result"`
+}
+
+type tallyJSON struct {
+	YesCount        string `json:"yes_count"`
+	NoCount         string `json:"no_count"`
+	AbstainCount    string `json:"abstain_count"`
+	NoWithVetoCount string `json:"no_with_veto_count"`
+}
+
+func (t tallyJSON) toTally() Tally {
+	return Tally{Yes: t.YesCount, No: t.NoCount, Abstain: t.AbstainCount, NoWithVeto: t.NoWithVetoCount}
+}
+
+// Get returns the proposal with the given ID. A zero ID returns the most
+// recently submitted proposal.
+func (q *Querier) Get(ctx context.Context, id uint64) (*Proposal, error) {
+	var p proposalJSON
+	if id == 0 {
+		params := url.Values{}
+		params.Set("pagination.reverse", "true")
+		params.Set("pagination.limit", "1")
+
+		var resp struct {
+			Proposals []proposalJSON `json:"proposals"`
+		}
+		if err := q.getJSON(ctx, "/cosmos/gov/v1/proposals?"+params.Encode(), &resp); err != nil {
+			return nil, fmt.Errorf("failed to query proposals: %w", err)
+		}
+		if len(resp.Proposals) == 0 {
+			return nil, ErrNotFound
+		}
+		p = resp.Proposals[0]
+	} else {
+		var resp struct {
+			Proposal proposalJSON `json:"proposal"`
+		}
+		if err := q.getJSON(ctx, fmt.Sprintf("/cosmos/gov/v1/proposals/%d", id), &resp); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("failed to query proposal %d: %w", id, err)
+		}
+		p = resp.Proposal
+	}
+
+	proposal, err := p.toProposal()
+	if err != nil {
+		return nil, err
+	}
+
+	// The final tally is only filled in when voting ends.
+	if proposal.Status == StatusVotingPeriod {
+		var resp struct {
+			Tally tallyJSON `json:"tally"`
+		}
+		if err := q.getJSON(ctx, fmt.Sprintf("/cosmos/gov/v1/proposals/%d/tally", proposal.ID), &resp); err != nil {
+			return nil, fmt.Errorf("failed to query tally of proposal %d: %w", proposal.ID, err)
+		}
+		proposal.Tally = resp.Tally.toTally()
+	}
+	return proposal, nil
+}
+
+func (p proposalJSON) toProposal() (*Proposal, error) {
+	id, err := strconv.ParseUint(p.ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proposal id %q", p.ID)
+	}
+	return &Proposal{
+		ID:            id,
+		Status:        p.Status,
+		Title:         p.Title,
+		Summary:       p.Summary,
+		Proposer:      p.Proposer,
+		SubmitTime:    p.SubmitTime,
+		VotingEndTime: p.VotingEndTime,
+		FailedReason:  p.FailedReason,
+		Tally:         p.FinalTallyResult.toTally(),
+	}, nil
+}
+
+// grpcCodeNotFound is the gRPC NotFound code carried in REST error bodies.
+const grpcCodeNotFound = 5
+
+func (q *Querier) getJSON(ctx context.Context, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(q.config.RESTEndpoint, "/")+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := q.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.Message != "" {
+			if apiErr.Code == grpcCodeNotFound {
+				return ErrNotFound
+			}
+			return fmt.Errorf("HTTP %d: %s", resp.StatusCode, apiErr.Message)
+		}
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// internal/daemon/gov/gov_test.go
+package gov
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeNode serves two proposals: 1 passed, 2 in its voting period.
+func newFakeNode(t *testing.T, empty bool) *httptest.Server {
+	t.Helper()
+	const passed = `{"id":"1","status":"PROPOSAL_STATUS_PASSED","title":"Fund the faucet","summary":"s",
+		"voting_end_time":"2026-01-02T03:04:05Z",
+		"final_tally_result":{"yes_count":"300","no_count":"0","abstain_count":"0","no_with_veto_count":"0"}}`
+	const voting = `{"id":"2","status":"PROPOSAL_STATUS_VOTING_PERIOD","title":"Raise max validators",
+		"final_tally_result":{"yes_count":"0","no_count":"0","abstain_count":"0","no_with_veto_count":"0"}}`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cosmos/gov/v1/proposals":
+			assert.Equal(t, "true", r.URL.Query().Get("pagination.reverse"))
+			if empty {
+				fmt.Fprint(w, `{"proposals":[]}`)
+				return
+			}
+			fmt.Fprintf(w, `{"proposals":[%s]}`, voting)
+		case "/cosmos/gov/v1/proposals/1":
+			fmt.Fprintf(w, `{"proposal":%s}`, passed)
+		case "/cosmos/gov/v1/proposals/2":
+			fmt.Fprintf(w, `{"proposal":%s}`, voting)
+		case "/cosmos/gov/v1/proposals/2/tally":
+			fmt.Fprint(w, `{"tally":{"yes_count":"200","no_count":"0","abstain_count":"100","no_with_veto_count":"0"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":5,"message":"proposal 9 doesn't exist: key not found","details":[]}`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestQuerier_Get(t *testing.T) {
+	q := NewQuerier(Config{RESTEndpoint: newFakeNode(t, false).URL})
+
+	p, err := q.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), p.ID)
+	assert.Equal(t, StatusPassed, p.Status)
+	assert.True(t, p.Finished())
+	assert.Equal(t, "300", p.Tally.Yes, "final tally of a finished proposal")
+	assert.Equal(t, 2026, p.VotingEndTime.Year())
+}
+
+func TestQuerier_GetVotingUsesLiveTally(t *testing.T) {
+	q := NewQuerier(Config{RESTEndpoint: newFakeNode(t, false).URL})
+
+	p, err := q.Get(context.Background(), 2)
+	require.NoError(t, err)
+	assert.False(t, p.Finished())
+	assert.Equal(t, Tally{Yes: "200", No: "0", Abstain: "100", NoWithVeto: "0"}, p.Tally)
+}
+
+func TestQuerier_GetLatest(t *testing.T) {
+	q := NewQuerier(Config{RESTEndpoint: newFakeNode(t, false).URL})
+
+	p, err := q.Get(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), p.ID)
+	assert.Equal(t, "Raise max validators", p.Title)
+}
+
+func TestQuerier_GetNotFound(t *testing.T) {
+	q := NewQuerier(Config{RESTEndpoint: newFakeNode(t, true).URL})
+
+	_, err := q.Get(context.Background(), 9)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = q.Get(context.Background(), 0)
+	assert.ErrorIs(t, err, ErrNotFound, "no proposals at all")
+}
@@ -0,0 +1,200 @@
+// internal/daemon/gov/params.go
+package gov
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+)
+
+// DefaultParamsLayout returns the Cosmos SDK params layout of a module. x/gov
+// serves its params under v1; the other SDK modules use v1beta1.
+func DefaultParamsLayout(module string) network.ParamsLayout {
+	if module == "gov" {
+		return network.ParamsLayout{
+			QueryPath:  "/cosmos/gov/v1/params/params",
+			MsgTypeURL: "/cosmos.gov.v1.MsgUpdateParams",
+		}
+	}
+	return network.ParamsLayout{
+		QueryPath:  fmt.Sprintf("/cosmos/%s/v1beta1/params", module),
+		MsgTypeURL: fmt.Sprintf("/cosmos.%s.v1beta1.MsgUpdateParams", module),
+	}
+}
+
+// ParamChange is a proposal that sets one param of a module.
+type ParamChange struct {
+	OldValue json.RawMessage
+	NewValue json.RawMessage
+	// Expedited is false when the chain has no expedited proposals.
+	Expedited bool
+	// Proposal is a submit-proposal JSON file carrying the module's
+	// MsgUpdateParams.
+	Proposal []byte
+}
+
+// Params returns the current params of a module, by field.
+func (q *Querier) Params(ctx context.Context, layout network.ParamsLayout) (map[string]json.RawMessage, error) {
+	var resp map[string]json.RawMessage
+	if err := q.getJSON(ctx, layout.QueryPath, &resp); err != nil {
+		return nil, fmt.Errorf("failed to query params: %w", err)
+	}
+
+	field := layout.ResponseField
+	if field == "" {
+		field = "params"
+	}
+	raw, ok := resp[field]
+	if !ok {
+		return nil, fmt.Errorf("params response has no %q field", field)
+	}
+	var params map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return nil, fmt.Errorf("failed to decode params: %w", err)
+	}
+	return params, nil
+}
+
+// Authority returns the address of the gov module account, which signs
+// MsgUpdateParams when a proposal passes.
+func (q *Querier) Authority(ctx context.Context) (string, error) {
+	var resp struct {
+		Account struct {
+			BaseAccount struct {
+				Address string `json:"address"`
+			} `json:"base_account"`
+		} `json:"account"`
+	}
+	if err := q.getJSON(ctx, "/cosmos/auth/v1beta1/module_accounts/gov", &resp); err != nil {
+		return "", fmt.Errorf("failed to query gov module account: %w", err)
+	}
+	if resp.Account.BaseAccount.Address == "" {
+		return "", fmt.Errorf("gov module account has no address")
+	}
+	return resp.Account.BaseAccount.Address, nil
+}
+
+// BuildParamChange builds a proposal that sets key to value in a module's
+// params, keeping the other params at their current values. The proposal is
+// expedited, with the expedited minimum deposit, when the chain supports it,
+// so it enters voting at once and finishes quickly.
+func (q *Querier) BuildParamChange(ctx context.Context, module string, layout network.ParamsLayout, key, value string) (*ParamChange, error) {
+	params, err := q.Params(ctx, layout)
+	if err != nil {
+		return nil, err
+	}
+	old, ok := params[key]
+	if !ok {
+		keys := make([]string, 0, len(params))
+		for k := range params {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return nil, fmt.Errorf("%s has no param %q (params: %s)", module, key, strings.Join(keys, ", "))
+	}
+	newValue, err := encodeParamValue(old, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for %s.%s: %w", module, key, err)
+	}
+	params[key] = newValue
+
+	authority, err := q.Authority(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deposit, expedited, err := q.proposalDeposit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	field := layout.MsgField
+	if field == "" {
+		field = "params"
+	}
+	msg, err := json.Marshal(map[string]any{
+		"@type":     layout.MsgTypeURL,
+		"authority": authority,
+		field:       params,
+	})
+	if err != nil {
+		return nil, err
+	}
+	proposal, err := json.MarshalIndent(map[string]any{
+		"messages":  []json.RawMessage{msg},
+		"title":     fmt.Sprintf("Set %s %s", module, key),
+		"summary":   fmt.Sprintf("Set %s param %s from %s to %s.", module, key, old, newValue),
+		"deposit":   deposit,
+		"expedited": expedited,
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ParamChange{OldValue: old, NewValue: newValue, Expedited: expedited, Proposal: proposal}, nil
+}
+
+// proposalDeposit returns the deposit that puts a proposal straight into its
+// voting period: the expedited minimum deposit if the chain has expedited
+// proposals, otherwise the regular one.
+func (q *Querier) proposalDeposit(ctx context.Context) (string, bool, error) {
+	type coin struct {
+		Denom  string `json:"denom"`
+		Amount string `json:"amount"`
+	}
+	var resp struct {
+		Params struct {
+			MinDeposit          []coin `json:"min_deposit"`
+			ExpeditedMinDeposit []coin `json:"expedited_min_deposit"`
+		} `json:"params"`
+	}
+	if err := q.getJSON(ctx, "/cosmos/gov/v1/params/deposit", &resp); err != nil {
+		return "", false, fmt.Errorf("failed to query gov params: %w", err)
+	}
+
+	coins, expedited := resp.Params.ExpeditedMinDeposit, true
+	if len(coins) == 0 {
+		coins, expedited = resp.Params.MinDeposit, false
+	}
+	parts := make([]string, 0, len(coins))
+	for _, c := range coins {
+		parts = append(parts, c.Amount+c.Denom)
+	}
+	return strings.Join(parts, ","), expedited, nil
+}
+
+// encodeParamValue encodes a command-line value as JSON of the same kind as
+// the param's current value: strings are quoted, numbers and booleans must
+// parse, and objects and lists must be given as JSON.
+func encodeParamValue(old json.RawMessage, value string) (json.RawMessage, error) {
+	old = bytes.TrimSpace(old)
+	if len(old) == 0 {
+		return nil, fmt.Errorf("param has no current value")
+	}
+
+	switch c := old[0]; {
+	case c == '"':
+		return json.Marshal(value)
+	case c == 't' || c == 'f':
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", value)
+		}
+		return json.Marshal(b)
+	case c == '-' || (c >= '0' && c <= '9'):
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, fmt.Errorf("%q is not a number", value)
+		}
+		return json.RawMessage(value), nil
+	default:
+		if !json.Valid([]byte(value)) {
+			return nil, fmt.Errorf("%q is not valid JSON", value)
+		}
+		return json.RawMessage(value), nil
+	}
+}
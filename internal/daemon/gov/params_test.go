@@ -0,0 +1,148 @@
+// internal/daemon/gov/params_test.go
+package gov
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeParamsNode serves staking params, the gov module account and gov
+// deposit params, with or without expedited proposals.
+func newFakeParamsNode(t *testing.T, expedited bool) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cosmos/staking/v1beta1/params":
+			fmt.Fprint(w, `{"params":{"unbonding_time":"1814400s","max_validators":100,"bond_denom":"stake","min_commission_rate":"0.000000000000000000"}}`)
+		case "/cosmos/auth/v1beta1/module_accounts/gov":
+			fmt.Fprint(w, `{"account":{"@type":"/cosmos.auth.v1beta1.ModuleAccount","base_account":{"address":"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"},"name":"gov"}}`)
+		case "/cosmos/gov/v1/params/deposit":
+			if expedited {
+				fmt.Fprint(w, `{"params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"expedited_min_deposit":[{"denom":"stake","amount":"50000000"}]}}`)
+				return
+			}
+			fmt.Fprint(w, `{"params":{"min_deposit":[{"denom":"stake","amount":"10000000"}]}}`)
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+			fmt.Fprint(w, `{"code":12,"message":"Not Implemented","details":[]}`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestQuerier_BuildParamChange(t *testing.T) {
+	q := NewQuerier(Config{RESTEndpoint: newFakeParamsNode(t, true).URL})
+
+	change, err := q.BuildParamChange(context.Background(), "staking", DefaultParamsLayout("staking"), "max_validators", "200")
+	require.NoError(t, err)
+	assert.Equal(t, "100", string(change.OldValue))
+	assert.Equal(t, "200", string(change.NewValue))
+	assert.True(t, change.Expedited)
+
+	var proposal struct {
+		Messages  []map[string]json.RawMessage `json:"messages"`
+		Title     string                       `json:"title"`
+		Deposit   string                       `json:"deposit"`
+		Expedited bool                         `json:"expedited"`
+	}
+	require.NoError(t, json.Unmarshal(change.Proposal, &proposal))
+	assert.Equal(t, "Set staking max_validators", proposal.Title)
+	assert.Equal(t, "50000000stake", proposal.Deposit)
+	assert.True(t, proposal.Expedited)
+	require.Len(t, proposal.Messages, 1)
+
+	msg := proposal.Messages[0]
+	assert.JSONEq(t, `"/cosmos.staking.v1beta1.MsgUpdateParams"`, string(msg["@type"]))
+	assert.JSONEq(t, `"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"`, string(msg["authority"]))
+	assert.JSONEq(t, `{"unbonding_time":"1814400s","max_validators":200,"bond_denom":"stake","min_commission_rate":"0.000000000000000000"}`,
+		string(msg["params"]), "other params are kept")
+}
+
+func TestQuerier_BuildParamChangeWithoutExpedited(t *testing.T) {
+	q := NewQuerier(Config{RESTEndpoint: newFakeParamsNode(t, false).URL})
+
+	change, err := q.BuildParamChange(context.Background(), "staking", DefaultParamsLayout("staking"), "unbonding_time", "60s")
+	require.NoError(t, err)
+	assert.Equal(t, `"60s"`, string(change.NewValue))
+	assert.False(t, change.Expedited)
+	assert.Contains(t, string(change.Proposal), `"deposit": "10000000stake"`)
+}
+
+func TestQuerier_BuildParamChangeCustomLayout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/custom/fees/v2/config":
+			fmt.Fprint(w, `{"config":{"base_fee":"1000"}}`)
+		case "/cosmos/auth/v1beta1/module_accounts/gov":
+			fmt.Fprint(w, `{"account":{"base_account":{"address":"gov1authority"}}}`)
+		case "/cosmos/gov/v1/params/deposit":
+			fmt.Fprint(w, `{"params":{"expedited_min_deposit":[{"denom":"stake","amount":"5"}]}}`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	q := NewQuerier(Config{RESTEndpoint: srv.URL})
+
+	layout := network.ParamsLayout{
+		QueryPath:     "/custom/fees/v2/config",
+		ResponseField: "config",
+		MsgTypeURL:    "/custom.fees.v2.MsgUpdateConfig",
+		MsgField:      "config",
+	}
+	change, err := q.BuildParamChange(context.Background(), "fees", layout, "base_fee", "2000")
+	require.NoError(t, err)
+	var proposal struct {
+		Messages []json.RawMessage `json:"messages"`
+	}
+	require.NoError(t, json.Unmarshal(change.Proposal, &proposal))
+	require.Len(t, proposal.Messages, 1)
+	assert.JSONEq(t, `{"@type":"/custom.fees.v2.MsgUpdateConfig","authority":"gov1authority","config":{"base_fee":"2000"}}`,
+		string(proposal.Messages[0]))
+}
+
+func TestQuerier_BuildParamChangeErrors(t *testing.T) {
+	q := NewQuerier(Config{RESTEndpoint: newFakeParamsNode(t, true).URL})
+
+	_, err := q.BuildParamChange(context.Background(), "staking", DefaultParamsLayout("staking"), "max_validator", "200")
+	assert.ErrorContains(t, err, `staking has no param "max_validator" (params: bond_denom, max_validators, min_commission_rate, unbonding_time)`)
+
+	_, err = q.BuildParamChange(context.Background(), "staking", DefaultParamsLayout("staking"), "max_validators", "lots")
+	assert.ErrorContains(t, err, `"lots" is not a number`)
+
+	_, err = q.BuildParamChange(context.Background(), "mint", DefaultParamsLayout("mint"), "inflation_max", "0.2")
+	assert.ErrorContains(t, err, "failed to query params")
+}
+
+func TestEncodeParamValue(t *testing.T) {
+	tests := []struct {
+		old, value, want string
+	}{
+		{`"stake"`, "uatom", `"uatom"`},
+		{`true`, "false", `false`},
+		{`100`, "200", `200`},
+		{`[{"denom":"stake","amount":"1"}]`, `[{"denom":"stake","amount":"2"}]`, `[{"denom":"stake","amount":"2"}]`},
+	}
+	for _, tt := range tests {
+		got, err := encodeParamValue(json.RawMessage(tt.old), tt.value)
+		require.NoError(t, err, tt.value)
+		assert.Equal(t, tt.want, string(got))
+	}
+
+	_, err := encodeParamValue(json.RawMessage(`true`), "maybe")
+	assert.Error(t, err)
+	_, err = encodeParamValue(json.RawMessage(`[]`), "[1,")
+	assert.Error(t, err)
+}
+
+func TestDefaultParamsLayout(t *testing.T) {
+	assert.Equal(t, "/cosmos/gov/v1/params/params", DefaultParamsLayout("gov").QueryPath)
+	assert.Equal(t, "/cosmos.staking.v1beta1.MsgUpdateParams", DefaultParamsLayout("staking").MsgTypeURL)
+}
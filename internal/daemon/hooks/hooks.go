@@ -0,0 +1,192 @@
+// internal/daemon/hooks/hooks.go
+
+// Package hooks runs the lifecycle hooks of a devnet's spec: user commands
+// and scripts run between provisioning phases, on the host or in a
+// container, with the devnet's context in their environment:
+//
+//	DEVNET_HOOK             the phase: postFork, preStart or postHealthy
+//	DEVNET_NAME             the devnet's name
+//	DEVNET_NAMESPACE        the devnet's namespace
+//	DEVNET_CHAIN_ID         the chain ID
+//	DEVNET_NETWORK          the network plugin
+//	DEVNET_DATA_DIR         the devnet's data directory
+//	DEVNET_GENESIS          the devnet's genesis file
+//	DEVNET_BOOTSTRAP_OUTPUT the addresses of the devnet's bootstrap
+//	                        contracts, for postHealthy hooks (see package
+//	                        bootstrap)
+//	DEVNET_BINARY           the chain binary
+//	DEVNET_NODES            the number of nodes
+//	DEVNET_RPC, DEVNET_API, DEVNET_GRPC
+//	                        the first node's endpoints
+//	DEVNET_NODE_<i>_NAME, _ROLE, _HOME, _RPC, _API, _GRPC
+//	                        each node's name, role, home directory and
+//	                        endpoints
+//
+// Nodes exist from preStart on; postFork hooks see no nodes.
+package hooks
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/bootstrap"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// Hook phases.
+const (
+	PostFork    = "postFork"
+	PreStart    = "preStart"
+	PostHealthy = "postHealthy"
+)
+
+// Failure policies.
+const (
+	OnFailureFail   = "fail"
+	OnFailureIgnore = "ignore"
+)
+
+// DefaultTimeout bounds a hook's run unless its spec sets a timeout.
+const DefaultTimeout = 5 * time.Minute
+
+// phaseHooks are the hooks of one phase in a spec.
+type phaseHooks struct {
+	name  string
+	hooks []types.Hook
+}
+
+// phases returns the spec's hooks by phase, in the order the phases run.
+func phases(spec types.Hooks) []phaseHooks {
+	return []phaseHooks{
+		{PostFork, spec.PostFork},
+		{PreStart, spec.PreStart},
+		{PostHealthy, spec.PostHealthy},
+	}
+}
+
+// Validate reports hooks that cannot run: without exactly one of a command
+// and a script, with a relative script path, or with an invalid timeout or
+// failure policy.
+func Validate(spec types.Hooks) error {
+	var errs []error
+	for _, phase := range phases(spec) {
+		for i, h := range phase.hooks {
+			name := Name(phase.name, i, h.Name)
+			switch {
+			case h.Command == "" && h.Script == "":
+				errs = append(errs, fmt.Errorf("hook %s: needs a command or a script", name))
+			case h.Command != "" && h.Script != "":
+				errs = append(errs, fmt.Errorf("hook %s: has both a command and a script", name))
+			case h.Script != "" && !filepath.IsAbs(h.Script):
+				errs = append(errs, fmt.Errorf("hook %s: script %q must be an absolute path", name, h.Script))
+			}
+			if h.Timeout != "" {
+				if d, err := time.ParseDuration(h.Timeout); err != nil || d <= 0 {
+					errs = append(errs, fmt.Errorf("hook %s: invalid timeout %q: want a positive duration like 2m", name, h.Timeout))
+				}
+			}
+			if h.OnFailure != "" && h.OnFailure != OnFailureFail && h.OnFailure != OnFailureIgnore {
+				errs = append(errs, fmt.Errorf("hook %s: invalid onFailure %q (must be %s or %s)",
+					name, h.OnFailure, OnFailureFail, OnFailureIgnore))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// FromSpec returns the spec's hooks in the order they run, for the
+// orchestrator.
+func FromSpec(spec types.Hooks) ([]ports.Hook, error) {
+	if err := Validate(spec); err != nil {
+		return nil, err
+	}
+	var hooks []ports.Hook
+	for _, phase := range phases(spec) {
+		for i, h := range phase.hooks {
+			timeout := DefaultTimeout
+			if h.Timeout != "" {
+				timeout, _ = time.ParseDuration(h.Timeout)
+			}
+			hooks = append(hooks, ports.Hook{
+				Phase:         phase.name,
+				Name:          Name(phase.name, i, h.Name),
+				Command:       h.Command,
+				Script:        h.Script,
+				Image:         h.Image,
+				Timeout:       timeout,
+				IgnoreFailure: h.OnFailure == OnFailureIgnore,
+			})
+		}
+	}
+	return hooks, nil
+}
+
+// Name returns the name of the i-th hook of a phase: its own name, or its
+// position, e.g. "postFork[0]".
+func Name(phase string, i int, name string) string {
+	if name != "" {
+		return name
+	}
+	return fmt.Sprintf("%s[%d]", phase, i)
+}
+
+// Devnet is the devnet hooks run for, which their environment describes.
+type Devnet struct {
+	Namespace  string
+	Name       string
+	ChainID    string
+	Network    string
+	DataDir    string
+	BinaryPath string
+
+	// Nodes are the devnet's nodes, once they are initialized.
+	Nodes []*types.Node
+}
+
+// Env returns the environment variables describing the devnet to hooks of
+// the phase.
+func (d Devnet) Env(phase string) []string {
+	env := []string{
+		"DEVNET_HOOK=" + phase,
+		"DEVNET_NAME=" + d.Name,
+		"DEVNET_NAMESPACE=" + d.Namespace,
+		"DEVNET_CHAIN_ID=" + d.ChainID,
+		"DEVNET_NETWORK=" + d.Network,
+		"DEVNET_DATA_DIR=" + d.DataDir,
+		"DEVNET_GENESIS=" + filepath.Join(d.DataDir, "genesis.json"),
+		"DEVNET_BOOTSTRAP_OUTPUT=" + filepath.Join(d.DataDir, bootstrap.OutputFile),
+		"DEVNET_BINARY=" + d.BinaryPath,
+		"DEVNET_NODES=" + strconv.Itoa(len(d.Nodes)),
+	}
+
+	nodes := slices.Clone(d.Nodes)
+	slices.SortFunc(nodes, func(a, b *types.Node) int { return a.Spec.Index - b.Spec.Index })
+	for i, node := range nodes {
+		host := node.Spec.Address
+		if host == "" {
+			host = "127.0.0.1"
+		}
+		p := node.Spec.Ports()
+		rpc, api, grpc := p.RPCURL(host), p.APIURL(host), net.JoinHostPort(host, strconv.Itoa(p.GRPC))
+		if i == 0 {
+			env = append(env, "DEVNET_RPC="+rpc, "DEVNET_API="+api, "DEVNET_GRPC="+grpc)
+		}
+		prefix := fmt.Sprintf("DEVNET_NODE_%d_", node.Spec.Index)
+		env = append(env,
+			prefix+"NAME="+node.Metadata.Name,
+			prefix+"ROLE="+strings.ToLower(node.Spec.Role),
+			prefix+"HOME="+node.Spec.HomeDir,
+			prefix+"RPC="+rpc,
+			prefix+"API="+api,
+			prefix+"GRPC="+grpc,
+		)
+	}
+	return env
+}
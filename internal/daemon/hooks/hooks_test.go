@@ -0,0 +1,131 @@
+// internal/daemon/hooks/hooks_test.go
+package hooks
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(types.Hooks{
+		PostFork:    []types.Hook{{Command: "jq . $DEVNET_GENESIS"}},
+		PostHealthy: []types.Hook{{Name: "seed", Script: "/opt/seed.sh", Image: "alpine", Timeout: "2m", OnFailure: OnFailureIgnore}},
+	}))
+
+	err := Validate(types.Hooks{
+		PreStart: []types.Hook{
+			{},
+			{Name: "both", Command: "true", Script: "/bin/true"},
+			{Name: "relative", Script: "./seed.sh", Timeout: "soon", OnFailure: "retry"},
+		},
+	})
+	require.Error(t, err)
+	for _, want := range []string{
+		"hook preStart[0]: needs a command or a script",
+		"hook both: has both a command and a script",
+		`hook relative: script "./seed.sh" must be an absolute path`,
+		`hook relative: invalid timeout "soon"`,
+		`hook relative: invalid onFailure "retry"`,
+	} {
+		assert.Contains(t, err.Error(), want)
+	}
+}
+
+func TestFromSpec(t *testing.T) {
+	list, err := FromSpec(types.Hooks{
+		PostHealthy: []types.Hook{{Command: "b", Timeout: "30s", OnFailure: OnFailureIgnore}},
+		PostFork:    []types.Hook{{Name: "a", Command: "a"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []ports.Hook{
+		{Phase: PostFork, Name: "a", Command: "a", Timeout: DefaultTimeout},
+		{Phase: PostHealthy, Name: "postHealthy[0]", Command: "b", Timeout: 30 * time.Second, IgnoreFailure: true},
+	}, list)
+}
+
+func TestDevnet_Env(t *testing.T) {
+	layout := dvbtypes.PortLayout{RPC: 30000, Stride: 100}
+	env := Devnet{
+		Namespace: "team",
+		Name:      "test",
+		ChainID:   "test-1",
+		DataDir:   "/data/test",
+		Nodes: []*types.Node{
+			{Metadata: types.ResourceMeta{Name: "test-fullnode-1"}, Spec: types.NodeSpec{Index: 1, Role: "fullnode", HomeDir: "/data/test/nodes/1", Address: "127.0.5.2", PortLayout: layout}},
+			{Metadata: types.ResourceMeta{Name: "test-validator-0"}, Spec: types.NodeSpec{Index: 0, Role: "validator", HomeDir: "/data/test/nodes/0", PortLayout: layout}},
+		},
+	}.Env(PreStart)
+
+	for _, want := range []string{
+		"DEVNET_HOOK=preStart",
+		"DEVNET_NAMESPACE=team",
+		"DEVNET_CHAIN_ID=test-1",
+		"DEVNET_GENESIS=/data/test/genesis.json",
+		"DEVNET_BOOTSTRAP_OUTPUT=/data/test/bootstrap-output.json",
+		"DEVNET_NODES=2",
+		"DEVNET_RPC=http://127.0.0.1:30000",
+		"DEVNET_NODE_0_HOME=/data/test/nodes/0",
+		"DEVNET_NODE_1_ROLE=fullnode",
+		"DEVNET_NODE_1_RPC=http://127.0.5.2:30100",
+		"DEVNET_NODE_1_GRPC=127.0.5.2:9190",
+	} {
+		assert.Contains(t, env, want)
+	}
+}
+
+func TestRunner_Run(t *testing.T) {
+	dir := t.TempDir()
+	devnet := Devnet{Name: "test", ChainID: "test-1", DataDir: dir}
+	runner := NewRunner(nil)
+
+	list := []ports.Hook{
+		{Phase: PostFork, Name: "other-phase", Command: "touch other", Timeout: time.Minute},
+		{Phase: PreStart, Name: "env", Command: `echo "$DEVNET_HOOK $DEVNET_CHAIN_ID" > env`, Timeout: time.Minute},
+		{Phase: PreStart, Name: "ignored", Command: "exit 1", Timeout: time.Minute, IgnoreFailure: true},
+		{Phase: PreStart, Name: "fails", Command: "echo boom >&2; exit 2", Timeout: time.Minute},
+		{Phase: PreStart, Name: "after", Command: "touch after", Timeout: time.Minute},
+	}
+	err := runner.Run(context.Background(), PreStart, list, devnet)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "hook fails failed: exit status 2: boom")
+
+	env, err := os.ReadFile(filepath.Join(dir, "env"))
+	require.NoError(t, err)
+	assert.Equal(t, "preStart test-1\n", string(env))
+	assert.NoFileExists(t, filepath.Join(dir, "other"))
+	assert.NoFileExists(t, filepath.Join(dir, "after"), "hooks after a failure must not run")
+
+	// Each hook's output is kept
+	log, err := os.ReadFile(filepath.Join(dir, "hooks", "preStart-2.log"))
+	require.NoError(t, err)
+	assert.Equal(t, "boom\n", string(log))
+}
+
+func TestRunner_Timeout(t *testing.T) {
+	list := []ports.Hook{{Phase: PostHealthy, Name: "slow", Command: "sleep 10", Timeout: 100 * time.Millisecond}}
+	start := time.Now()
+	err := NewRunner(nil).Run(context.Background(), PostHealthy, list, Devnet{DataDir: t.TempDir()})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out after 100ms")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestDockerArgs(t *testing.T) {
+	args := dockerArgs(ports.Hook{Image: "alpine", Command: "echo hi"}, "/data/test", []string{"DEVNET_NAME=test"}, "hook-1")
+	assert.Equal(t, "run --rm --name hook-1 --network host -v /data/test:/data/test -w /data/test -e DEVNET_NAME=test alpine sh -c echo hi",
+		strings.Join(args, " "))
+
+	args = dockerArgs(ports.Hook{Image: "alpine", Script: "/opt/seed.sh"}, "", nil, "hook-2")
+	assert.Equal(t, "run --rm --name hook-2 --network host -v /opt/seed.sh:/opt/seed.sh:ro alpine /opt/seed.sh",
+		strings.Join(args, " "))
+}
@@ -0,0 +1,147 @@
+// internal/daemon/hooks/run.go
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+)
+
+// Runner runs hooks. Each hook's output is written to
+// <data dir>/hooks/<phase>-<n>.log.
+type Runner struct {
+	logger *slog.Logger
+}
+
+// NewRunner creates a Runner.
+func NewRunner(logger *slog.Logger) *Runner {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Runner{logger: logger}
+}
+
+// Run runs the hooks of the phase in order. A failed hook stops the phase
+// and its error is returned, unless the hook ignores failures.
+func (r *Runner) Run(ctx context.Context, phase string, hooks []ports.Hook, devnet Devnet) error {
+	n := 0
+	for _, h := range hooks {
+		if h.Phase != phase {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		logPath := filepath.Join(devnet.DataDir, "hooks", fmt.Sprintf("%s-%d.log", phase, n))
+		n++
+
+		r.logger.Info("running hook", "devnet", devnet.Name, "phase", phase, "hook", h.Name)
+		start := time.Now()
+		err := r.run(ctx, h, devnet, devnet.Env(phase), logPath)
+		if err == nil {
+			r.logger.Info("hook completed", "devnet", devnet.Name, "hook", h.Name, "duration", time.Since(start))
+			continue
+		}
+
+		err = fmt.Errorf("hook %s failed: %w", h.Name, err)
+		if h.IgnoreFailure {
+			r.logger.Warn("ignoring failed hook", "devnet", devnet.Name, "hook", h.Name, "error", err, "log", logPath)
+			continue
+		}
+		return err
+	}
+	return nil
+}
+
+// run runs one hook with its timeout, writing its output to logPath.
+func (r *Runner) run(ctx context.Context, h ports.Hook, devnet Devnet, env []string, logPath string) error {
+	ctx, cancel := context.WithTimeout(ctx, h.Timeout)
+	defer cancel()
+
+	var output bytes.Buffer
+	var out io.Writer = &output
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err == nil {
+		if f, err := os.Create(logPath); err == nil {
+			defer f.Close()
+			out = io.MultiWriter(&output, f)
+		}
+	}
+
+	var cmd *exec.Cmd
+	container := ""
+	if h.Image != "" {
+		container = fmt.Sprintf("devnet-hook-%s-%d", devnet.Name, time.Now().UnixNano())
+		cmd = exec.CommandContext(ctx, "docker", dockerArgs(h, devnet.DataDir, env, container)...)
+		cmd.Env = os.Environ()
+	} else {
+		if h.Script != "" {
+			cmd = exec.CommandContext(ctx, h.Script)
+		} else {
+			cmd = exec.CommandContext(ctx, "sh", "-c", h.Command)
+		}
+		cmd.Env = append(os.Environ(), env...)
+		killProcessGroup(cmd)
+		if info, err := os.Stat(devnet.DataDir); err == nil && info.IsDir() {
+			cmd.Dir = devnet.DataDir
+		}
+	}
+	cmd.Stdout = out
+	cmd.Stderr = out
+	cmd.WaitDelay = 5 * time.Second
+
+	err := cmd.Run()
+	if err == nil {
+		return nil
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("timed out after %s", h.Timeout)
+	}
+	if container != "" && ctx.Err() != nil {
+		// Killing the docker CLI leaves the container running
+		_ = exec.Command("docker", "rm", "-f", container).Run()
+	}
+	if tail := lastLines(output.String(), 10); tail != "" {
+		return fmt.Errorf("%w: %s", err, tail)
+	}
+	return err
+}
+
+// dockerArgs returns the docker arguments running the hook in a container
+// named container: on the host network, so node endpoints are reachable,
+// with the data directory mounted at the same path so paths in env stay
+// valid.
+func dockerArgs(h ports.Hook, dataDir string, env []string, container string) []string {
+	args := []string{"run", "--rm", "--name", container, "--network", "host"}
+	if dataDir != "" {
+		args = append(args, "-v", dataDir+":"+dataDir, "-w", dataDir)
+	}
+	for _, e := range env {
+		args = append(args, "-e", e)
+	}
+	if h.Script != "" {
+		args = append(args, "-v", h.Script+":"+h.Script+":ro", h.Image, h.Script)
+	} else {
+		args = append(args, h.Image, "sh", "-c", h.Command)
+	}
+	return args
+}
+
+// lastLines returns the last n lines of s.
+func lastLines(s string, n int) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
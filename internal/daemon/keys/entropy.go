@@ -0,0 +1,81 @@
+// internal/daemon/keys/entropy.go
+package keys
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/nodekeys"
+)
+
+// EntropySource supplies the entropy keys are derived from. Labels name
+// what the entropy is for, like "validator/0"; a source returns the same
+// entropy for the same label every time.
+type EntropySource interface {
+	Entropy(label string) [32]byte
+}
+
+// FixedSource returns the source of unseeded devnets: the SHA-256 of the
+// label, the same on every devnet.
+func FixedSource() EntropySource {
+	return fixedSource{}
+}
+
+type fixedSource struct{}
+
+func (fixedSource) Entropy(label string) [32]byte {
+	return sha256.Sum256([]byte("devnet-builder/" + label))
+}
+
+// SeededSource returns the source of a seeded devnet: the HMAC-SHA256 of
+// the label keyed by the seed.
+func SeededSource(seed string) EntropySource {
+	return seededSource{seed: []byte(seed)}
+}
+
+type seededSource struct {
+	seed []byte
+}
+
+func (s seededSource) Entropy(label string) [32]byte {
+	mac := hmac.New(sha256.New, s.seed)
+	mac.Write([]byte("devnet-builder/" + label))
+	var out [32]byte
+	copy(out[:], mac.Sum(nil))
+	return out
+}
+
+// NodeKeys derives the consensus key and p2p identity of a node. Node keys
+// are only derived for seeded devnets; others let the chain's init command
+// generate them.
+func (g *Generator) NodeKeys(role string, index int) (nodekeys.Keys, error) {
+	consensus := g.src.Entropy(fmt.Sprintf("consensus/%s/%d", role, index))
+	node := g.src.Entropy(fmt.Sprintf("node/%s/%d", role, index))
+	return nodekeys.Generate(index, consensus[:], node[:])
+}
+
+// Suffix returns a short hex string drawn from the source, for names that
+// must match between devnets of the same seed.
+func (g *Generator) Suffix() string {
+	entropy := g.src.Entropy("suffix")
+	return hex.EncodeToString(entropy[:2])
+}
+
+// DevnetName returns a devnet name drawn from the source, which seeded
+// devnets also take their default chain ID from.
+func (g *Generator) DevnetName() string {
+	return "devnet-" + g.Suffix()
+}
+
+// GenesisTime returns a genesis time drawn from the source, a second in
+// 2024, so the genesis files of devnets with the same seed match. It lies in
+// the past so the chain starts at once.
+func (g *Generator) GenesisTime() time.Time {
+	entropy := g.src.Entropy("genesis-time")
+	offset := binary.BigEndian.Uint32(entropy[:4]) % (366 * 24 * 60 * 60)
+	return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(offset) * time.Second)
+}
@@ -0,0 +1,172 @@
+// internal/daemon/keys/keys.go
+
+// Package keys derives the deterministic validator and test-account keys of
+// daemon-provisioned devnets.
+//
+// Every key is derived from a mnemonic that depends only on the key's role
+// and index, so validator0 and account0 have the same mnemonic and address
+// on every devnet of a network. Tests, scripts and CI secrets can rely on
+// them without reading the devnet's files.
+//
+// A seeded devnet replaces the fixed derivation with one keyed by its seed
+// (see EntropySource), and also derives its nodes' consensus and p2p keys,
+// so devnets created from the same seed are identical.
+package keys
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	bip39 "github.com/cosmos/go-bip39"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// Key roles.
+const (
+	RoleValidator = "validator"
+	RoleAccount   = "account"
+)
+
+// HD paths used for Cosmos SDK (secp256k1) and EVM (eth_secp256k1) keys.
+const (
+	CosmosHDPath   = "m/44'/118'/0'/0/0"
+	EthereumHDPath = "m/44'/60'/0'/0/0"
+)
+
+// Key is a derived account key.
+type Key struct {
+	Name  string `json:"name"`
+	Role  string `json:"role"`
+	Index int    `json:"index"`
+
+	// Address is the bech32 account address.
+	Address string `json:"address"`
+	// EVMAddress is the checksummed 0x address. Only set for EVM chains.
+	EVMAddress string `json:"evmAddress,omitempty"`
+
+	Mnemonic string `json:"mnemonic"`
+	HDPath   string `json:"hdPath"`
+	// PrivateKey is the 0x-prefixed hex private key.
+	PrivateKey string `json:"privateKey"`
+
+	// AddressBytes is the raw 20-byte account address.
+	AddressBytes []byte `json:"-"`
+}
+
+// Default derives the keys of unseeded devnets.
+var Default = NewGenerator(FixedSource())
+
+// Mnemonic returns the deterministic 24-word mnemonic for a role and index.
+func Mnemonic(role string, index int) (string, error) {
+	return Default.Mnemonic(role, index)
+}
+
+// Derive derives the key for a role and index. EVM chains use the Ethereum
+// HD path and address scheme; other chains use the Cosmos SDK ones.
+func Derive(role string, index int, bech32Prefix string, evm bool) (*Key, error) {
+	return Default.Derive(role, index, bech32Prefix, evm)
+}
+
+// DeriveAll derives the keys of a devnet's validators followed by its test
+// accounts.
+func DeriveAll(bech32Prefix string, validators, accounts int, evm bool) ([]*Key, error) {
+	return Default.DeriveAll(bech32Prefix, validators, accounts, evm)
+}
+
+// Generator derives keys from an entropy source.
+type Generator struct {
+	src EntropySource
+}
+
+// NewGenerator returns a Generator drawing on src.
+func NewGenerator(src EntropySource) *Generator {
+	return &Generator{src: src}
+}
+
+// ForSeed returns the Generator of a devnet with the given seed; the
+// Default one when seed is empty.
+func ForSeed(seed string) *Generator {
+	if seed == "" {
+		return Default
+	}
+	return NewGenerator(SeededSource(seed))
+}
+
+// Mnemonic returns the 24-word mnemonic for a role and index.
+func (g *Generator) Mnemonic(role string, index int) (string, error) {
+	entropy := g.src.Entropy(fmt.Sprintf("%s/%d", role, index))
+	return bip39.NewMnemonic(entropy[:])
+}
+
+// Derive derives the key for a role and index.
+func (g *Generator) Derive(role string, index int, bech32Prefix string, evm bool) (*Key, error) {
+	if bech32Prefix == "" {
+		return nil, fmt.Errorf("bech32 prefix is required")
+	}
+
+	mnemonic, err := g.Mnemonic(role, index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate mnemonic for %s%d: %w", role, index, err)
+	}
+
+	path := CosmosHDPath
+	if evm {
+		path = EthereumHDPath
+	}
+
+	seed := bip39.NewSeed(mnemonic, "")
+	master, chainCode := hd.ComputeMastersFromSeed(seed)
+	priv, err := hd.DerivePrivateKeyForPath(master, chainCode, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive %s%d: %w", role, index, err)
+	}
+
+	key := &Key{
+		Name:       fmt.Sprintf("%s%d", role, index),
+		Role:       role,
+		Index:      index,
+		Mnemonic:   mnemonic,
+		HDPath:     path,
+		PrivateKey: "0x" + hex.EncodeToString(priv),
+	}
+
+	if evm {
+		ecdsaKey, err := ethcrypto.ToECDSA(priv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid private key for %s%d: %w", role, index, err)
+		}
+		addr := ethcrypto.PubkeyToAddress(ecdsaKey.PublicKey)
+		key.AddressBytes = addr.Bytes()
+		key.EVMAddress = addr.Hex()
+	} else {
+		key.AddressBytes = (&secp256k1.PrivKey{Key: priv}).PubKey().Address().Bytes()
+	}
+
+	key.Address, err = bech32.ConvertAndEncode(bech32Prefix, key.AddressBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode address for %s%d: %w", role, index, err)
+	}
+	return key, nil
+}
+
+// DeriveAll derives the keys of a devnet's validators followed by its test
+// accounts.
+func (g *Generator) DeriveAll(bech32Prefix string, validators, accounts int, evm bool) ([]*Key, error) {
+	out := make([]*Key, 0, validators+accounts)
+	for _, group := range []struct {
+		role  string
+		count int
+	}{{RoleValidator, validators}, {RoleAccount, accounts}} {
+		for i := 0; i < group.count; i++ {
+			key, err := g.Derive(group.role, i, bech32Prefix, evm)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, key)
+		}
+	}
+	return out, nil
+}
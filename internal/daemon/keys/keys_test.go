@@ -0,0 +1,124 @@
+// internal/daemon/keys/keys_test.go
+package keys
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/nodekeys"
+	bip39 "github.com/cosmos/go-bip39"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMnemonic_Deterministic(t *testing.T) {
+	a, err := Mnemonic(RoleValidator, 0)
+	require.NoError(t, err)
+	b, err := Mnemonic(RoleValidator, 0)
+	require.NoError(t, err)
+	assert.Equal(t, a, b)
+	assert.True(t, bip39.IsMnemonicValid(a))
+	assert.Len(t, strings.Fields(a), 24)
+
+	other, err := Mnemonic(RoleAccount, 0)
+	require.NoError(t, err)
+	assert.NotEqual(t, a, other, "roles must not share mnemonics")
+}
+
+func TestDerive_Cosmos(t *testing.T) {
+	key, err := Derive(RoleAccount, 1, "cosmos", false)
+	require.NoError(t, err)
+
+	assert.Equal(t, "account1", key.Name)
+	assert.Equal(t, CosmosHDPath, key.HDPath)
+	assert.True(t, strings.HasPrefix(key.Address, "cosmos1"))
+	assert.Empty(t, key.EVMAddress)
+	assert.Len(t, key.PrivateKey, 66)
+	assert.Len(t, key.AddressBytes, 20)
+
+	again, err := Derive(RoleAccount, 1, "cosmos", false)
+	require.NoError(t, err)
+	assert.Equal(t, key.Address, again.Address)
+}
+
+func TestDerive_EVM(t *testing.T) {
+	key, err := Derive(RoleValidator, 0, "stable", true)
+	require.NoError(t, err)
+
+	assert.Equal(t, EthereumHDPath, key.HDPath)
+	assert.True(t, strings.HasPrefix(key.Address, "stable1"))
+	require.True(t, strings.HasPrefix(key.EVMAddress, "0x"))
+	assert.Equal(t, strings.ToLower(key.EVMAddress[2:]), hex.EncodeToString(key.AddressBytes))
+
+	cosmos, err := Derive(RoleValidator, 0, "stable", false)
+	require.NoError(t, err)
+	assert.NotEqual(t, cosmos.Address, key.Address, "EVM and Cosmos derivation must differ")
+}
+
+func TestDerive_RequiresPrefix(t *testing.T) {
+	_, err := Derive(RoleAccount, 0, "", false)
+	assert.Error(t, err)
+}
+
+func TestDeriveAll(t *testing.T) {
+	all, err := DeriveAll("cosmos", 2, 3, false)
+	require.NoError(t, err)
+	require.Len(t, all, 5)
+
+	names := make([]string, 0, len(all))
+	for _, k := range all {
+		names = append(names, k.Name)
+	}
+	assert.Equal(t, []string{"validator0", "validator1", "account0", "account1", "account2"}, names)
+}
+
+func TestFixedSource_KeepsKeys(t *testing.T) {
+	// Unseeded devnets keep the keys they had before seeds existed
+	entropy := sha256.Sum256([]byte("devnet-builder/validator/0"))
+	want, err := bip39.NewMnemonic(entropy[:])
+	require.NoError(t, err)
+
+	got, err := Mnemonic(RoleValidator, 0)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Same(t, Default, ForSeed(""))
+}
+
+func TestForSeed(t *testing.T) {
+	a, err := ForSeed("issue-1234").DeriveAll("cosmos", 2, 2, false)
+	require.NoError(t, err)
+	b, err := ForSeed("issue-1234").DeriveAll("cosmos", 2, 2, false)
+	require.NoError(t, err)
+	other, err := ForSeed("issue-5678").DeriveAll("cosmos", 2, 2, false)
+	require.NoError(t, err)
+	unseeded, err := DeriveAll("cosmos", 2, 2, false)
+	require.NoError(t, err)
+
+	for i := range a {
+		assert.Equal(t, a[i].Mnemonic, b[i].Mnemonic)
+		assert.Equal(t, a[i].Address, b[i].Address)
+		assert.NotEqual(t, a[i].Mnemonic, other[i].Mnemonic)
+		assert.NotEqual(t, a[i].Mnemonic, unseeded[i].Mnemonic)
+	}
+	assert.Equal(t, ForSeed("issue-1234").Suffix(), ForSeed("issue-1234").Suffix())
+	assert.Len(t, ForSeed("issue-1234").Suffix(), 4)
+}
+
+func TestNodeKeys(t *testing.T) {
+	gen := ForSeed("issue-1234")
+	a, err := gen.NodeKeys(RoleValidator, 0)
+	require.NoError(t, err)
+	again, err := ForSeed("issue-1234").NodeKeys(RoleValidator, 0)
+	require.NoError(t, err)
+	assert.Equal(t, a, again)
+
+	b, err := gen.NodeKeys(RoleValidator, 1)
+	require.NoError(t, err)
+	assert.NoError(t, nodekeys.Validate([]nodekeys.Keys{a, b}, 2))
+
+	fullnode, err := gen.NodeKeys("fullnode", 1)
+	require.NoError(t, err)
+	assert.NotEqual(t, b.NodeKey, fullnode.NodeKey)
+}
@@ -0,0 +1,177 @@
+// internal/daemon/logsearch/logsearch.go
+
+// Package logsearch searches the logs of a devnet's nodes and the daemon
+// log for a pattern, reading every log in parallel, like grep with context
+// lines. Matches keep the log they came from, so a problem can be traced
+// across nodes without reading each log in turn.
+package logsearch
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sync"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/redact"
+)
+
+// maxLogLine bounds a single log line, so a runaway line cannot exhaust
+// memory.
+const maxLogLine = 1024 * 1024
+
+// Source is a log to search.
+type Source struct {
+	// Name attributes the matches, e.g. "validator-0" or "daemon".
+	Name string
+	// Open opens the log from its start.
+	Open func(ctx context.Context) (io.ReadCloser, error)
+	// Filter, if set, skips the lines it returns false for, as if they
+	// were not in the log; e.g. daemon log lines about other devnets.
+	Filter func(line string) bool
+}
+
+// Options configures a search.
+type Options struct {
+	Pattern *regexp.Regexp
+	// Context is the number of lines kept before and after each match.
+	Context int
+	// MaxMatches bounds the matches returned over all sources.
+	MaxMatches int
+}
+
+// Match is a line matching the pattern, with its context. Lines are
+// redacted of secrets.
+type Match struct {
+	Source string
+	Line   string
+	Before []string
+	After  []string
+}
+
+// Result is the outcome of a search.
+type Result struct {
+	// Matches are grouped by source, in the order of the sources.
+	Matches []Match
+	// Truncated is set when more lines matched than MaxMatches.
+	Truncated bool
+	// Errors lists the sources that could not be searched.
+	Errors []string
+}
+
+// Search searches the sources in parallel. A source that cannot be read is
+// listed in Result.Errors; the matches found in it before the error are
+// kept.
+func Search(ctx context.Context, sources []Source, opts Options) Result {
+	type sourceResult struct {
+		matches   []Match
+		truncated bool
+		err       error
+	}
+	results := make([]sourceResult, len(sources))
+
+	var wg sync.WaitGroup
+	for i, src := range sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r, err := src.Open(ctx)
+			if err != nil {
+				results[i].err = err
+				return
+			}
+			defer r.Close()
+			results[i].matches, results[i].truncated, results[i].err = grep(ctx, r, src, opts)
+		}()
+	}
+	wg.Wait()
+
+	var res Result
+	for i, r := range results {
+		if r.err != nil {
+			res.Errors = append(res.Errors, fmt.Sprintf("%s: %v", sources[i].Name, r.err))
+		}
+		res.Truncated = res.Truncated || r.truncated
+		res.Matches = append(res.Matches, r.matches...)
+	}
+	if len(res.Matches) > opts.MaxMatches {
+		res.Matches = res.Matches[:opts.MaxMatches]
+		res.Truncated = true
+	}
+	return res
+}
+
+// grep returns the lines of r matching the pattern, up to MaxMatches, and
+// whether there were more.
+func grep(ctx context.Context, r io.Reader, src Source, opts Options) ([]Match, bool, error) {
+	var (
+		matches   []Match
+		before    []string
+		pending   []int // matches still collecting lines after them
+		truncated bool
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLogLine)
+	for n := 0; scanner.Scan(); n++ {
+		if n%1024 == 0 && ctx.Err() != nil {
+			return matches, truncated, ctx.Err()
+		}
+
+		line := scanner.Text()
+		// Docker logs prefix each line with a stream header
+		if len(line) >= 8 && (line[0] == 1 || line[0] == 2) {
+			line = line[8:]
+		}
+		if src.Filter != nil && !src.Filter(line) {
+			continue
+		}
+
+		if len(pending) > 0 {
+			redacted := redact.String(line)
+			kept := pending[:0]
+			for _, i := range pending {
+				matches[i].After = append(matches[i].After, redacted)
+				if len(matches[i].After) < opts.Context {
+					kept = append(kept, i)
+				}
+			}
+			pending = kept
+		}
+
+		if !truncated && opts.Pattern.MatchString(line) {
+			if len(matches) == opts.MaxMatches {
+				truncated = true
+			} else {
+				matches = append(matches, Match{Source: src.Name, Line: redact.String(line), Before: redactAll(before)})
+				if opts.Context > 0 {
+					pending = append(pending, len(matches)-1)
+				}
+			}
+		}
+		if truncated && len(pending) == 0 {
+			break
+		}
+
+		if opts.Context > 0 {
+			before = append(before, line)
+			if len(before) > opts.Context {
+				before = before[1:]
+			}
+		}
+	}
+	return matches, truncated, scanner.Err()
+}
+
+// redactAll returns a redacted copy of lines.
+func redactAll(lines []string) []string {
+	if len(lines) == 0 {
+		return nil
+	}
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		out[i] = redact.String(line)
+	}
+	return out
+}
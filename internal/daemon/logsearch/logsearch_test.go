@@ -0,0 +1,72 @@
+// internal/daemon/logsearch/logsearch_test.go
+package logsearch
+
+import (
+	"context"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stringSource is a source reading content.
+func stringSource(name, content string) Source {
+	return Source{
+		Name: name,
+		Open: func(ctx context.Context) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(content)), nil
+		},
+	}
+}
+
+func TestSearch(t *testing.T) {
+	ctx := context.Background()
+	validator0 := "1 INF committed\n2 ERR consensus failure\n3 INF recovering\n4 INF committed\n"
+	validator1 := "1 INF committed\n\x01\x00\x00\x00\x00\x00\x00\x182 ERR CONSENSUS FAILURE password=hunter2\n"
+
+	t.Run("attributes matches with context", func(t *testing.T) {
+		res := Search(ctx, []Source{stringSource("validator-0", validator0), stringSource("validator-1", validator1)}, Options{
+			Pattern:    regexp.MustCompile(`(?i)consensus failure`),
+			Context:    1,
+			MaxMatches: 10,
+		})
+		require.Len(t, res.Matches, 2)
+		assert.Equal(t, Match{
+			Source: "validator-0",
+			Line:   "2 ERR consensus failure",
+			Before: []string{"1 INF committed"},
+			After:  []string{"3 INF recovering"},
+		}, res.Matches[0])
+		assert.Equal(t, "validator-1", res.Matches[1].Source)
+		assert.NotContains(t, res.Matches[1].Line, "hunter2", "secrets are redacted")
+		assert.Empty(t, res.Matches[1].After)
+		assert.False(t, res.Truncated)
+	})
+
+	t.Run("truncates at max matches", func(t *testing.T) {
+		res := Search(ctx, []Source{stringSource("validator-0", validator0), stringSource("validator-1", validator1)}, Options{
+			Pattern:    regexp.MustCompile(`committed`),
+			MaxMatches: 2,
+		})
+		require.Len(t, res.Matches, 2)
+		assert.Equal(t, "validator-0", res.Matches[1].Source)
+		assert.True(t, res.Truncated)
+	})
+
+	t.Run("filters lines and reports failed sources", func(t *testing.T) {
+		daemon := stringSource("daemon", "level=ERROR msg=failed devnet=alpha\nlevel=ERROR msg=failed devnet=beta\n")
+		daemon.Filter = func(line string) bool { return strings.Contains(line, "alpha") }
+		broken := Source{
+			Name: "validator-2",
+			Open: func(ctx context.Context) (io.ReadCloser, error) { return nil, errors.New("node not found") },
+		}
+		res := Search(ctx, []Source{broken, daemon}, Options{Pattern: regexp.MustCompile(`failed`), MaxMatches: 10})
+		require.Len(t, res.Matches, 1)
+		assert.Contains(t, res.Matches[0].Line, "devnet=alpha")
+		assert.Equal(t, []string{"validator-2: node not found"}, res.Errors)
+	})
+}
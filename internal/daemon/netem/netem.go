@@ -0,0 +1,273 @@
+// internal/daemon/netem/netem.go
+
+// Package netem emulates wide-area networks between a devnet's nodes.
+// Nodes are labelled with regions, and the links between regions add
+// latency, jitter, packet loss and bandwidth limits with tc netem.
+//
+// Each node's outgoing traffic is shaped in its own network namespace: a
+// prio qdisc on DefaultDevice sends packets to the nodes of a linked region
+// through that link's netem qdisc and everything else through an unshaped
+// band. Since both ends of a link shape their own traffic, a 100ms latency
+// adds 200ms to each round trip.
+package netem
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// DefaultRegion is the region of nodes without a label.
+const DefaultRegion = "default"
+
+// DefaultImage is the image with tc that shaping runs in, sharing the
+// node's network namespace, unless the spec sets one.
+const DefaultImage = "nicolaka/netshoot:latest"
+
+// DefaultDevice is the interface shaped in each node's network namespace.
+const DefaultDevice = "eth0"
+
+// MaxRegions bounds the regions of a devnet: a prio qdisc has at most 16
+// bands, one of which carries unshaped traffic.
+const MaxRegions = 15
+
+var (
+	lossPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?%$`)
+	ratePattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?([kmgt]?bit|[kmgt]?bps)$`)
+)
+
+// Node is a node whose traffic is shaped.
+type Node struct {
+	Index int
+	Name  string
+
+	// IP is the address other nodes reach the node on.
+	IP string
+}
+
+// Rule shapes a node's traffic to the nodes of one region.
+type Rule struct {
+	Region string
+	Link   types.NetworkLink
+	IPs    []string
+}
+
+// RegionOf returns the region of the node at index.
+func RegionOf(spec types.NetworkShaping, index int) string {
+	if region := spec.Regions[index]; region != "" {
+		return region
+	}
+	return DefaultRegion
+}
+
+// Validate reports shaping that cannot be applied: region labels of nodes
+// that do not exist, too many regions, and links between unknown regions,
+// duplicated or with invalid conditions.
+func Validate(spec types.NetworkShaping, nodes int) error {
+	var errs []error
+
+	regions := map[string]bool{DefaultRegion: true}
+	indexes := make([]int, 0, len(spec.Regions))
+	for index := range spec.Regions {
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+	for _, index := range indexes {
+		region := spec.Regions[index]
+		if index < 0 || (nodes > 0 && index >= nodes) {
+			errs = append(errs, fmt.Errorf("region of node %d: devnet has %d nodes", index, nodes))
+		}
+		if region == "" || strings.ContainsAny(region, " \t\n") {
+			errs = append(errs, fmt.Errorf("region of node %d: %q is not a valid label", index, region))
+			continue
+		}
+		regions[region] = true
+	}
+	if len(regions) > MaxRegions {
+		errs = append(errs, fmt.Errorf("%d regions: at most %d are supported", len(regions), MaxRegions))
+	}
+
+	seen := make(map[[2]string]bool)
+	for i, link := range spec.Links {
+		name := fmt.Sprintf("link %d (%s-%s)", i, link.From, link.To)
+		for _, region := range []string{link.From, link.To} {
+			if !regions[region] {
+				errs = append(errs, fmt.Errorf("%s: unknown region %q", name, region))
+			}
+		}
+		if pair := linkKey(link.From, link.To); seen[pair] {
+			errs = append(errs, fmt.Errorf("%s: duplicate link between %s and %s", name, link.From, link.To))
+		} else {
+			seen[pair] = true
+		}
+		if err := validateLink(link); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validateLink reports invalid network conditions of a link.
+func validateLink(link types.NetworkLink) error {
+	if link.Latency == "" && link.Jitter == "" && link.Loss == "" && link.Rate == "" {
+		return fmt.Errorf("needs a latency, loss or rate")
+	}
+	for _, d := range []struct{ field, value string }{{"latency", link.Latency}, {"jitter", link.Jitter}} {
+		if d.value == "" {
+			continue
+		}
+		if v, err := time.ParseDuration(d.value); err != nil || v < 0 {
+			return fmt.Errorf("invalid %s %q: want a duration like 100ms", d.field, d.value)
+		}
+	}
+	if link.Jitter != "" && link.Latency == "" {
+		return fmt.Errorf("jitter needs a latency")
+	}
+	if link.Loss != "" {
+		if !lossPattern.MatchString(link.Loss) {
+			return fmt.Errorf("invalid loss %q: want a percentage like 1%%", link.Loss)
+		}
+		if v, _ := strconv.ParseFloat(strings.TrimSuffix(link.Loss, "%"), 64); v > 100 {
+			return fmt.Errorf("invalid loss %q: more than 100%%", link.Loss)
+		}
+	}
+	if link.Rate != "" && !ratePattern.MatchString(link.Rate) {
+		return fmt.Errorf("invalid rate %q: want a tc rate like 10mbit", link.Rate)
+	}
+	return nil
+}
+
+// linkKey identifies the link between two regions regardless of direction.
+func linkKey(a, b string) [2]string {
+	if a > b {
+		a, b = b, a
+	}
+	return [2]string{a, b}
+}
+
+// Plan returns the rules shaping each node's outgoing traffic, by node
+// name. Nodes without an IP are neither shaped nor shaped towards.
+func Plan(spec types.NetworkShaping, nodes []Node) map[string][]Rule {
+	links := make(map[[2]string]types.NetworkLink, len(spec.Links))
+	for _, link := range spec.Links {
+		links[linkKey(link.From, link.To)] = link
+	}
+
+	byRegion := make(map[string][]Node)
+	var regions []string
+	for _, n := range nodes {
+		if n.IP == "" {
+			continue
+		}
+		region := RegionOf(spec, n.Index)
+		if _, ok := byRegion[region]; !ok {
+			regions = append(regions, region)
+		}
+		byRegion[region] = append(byRegion[region], n)
+	}
+	slices.Sort(regions)
+
+	plan := make(map[string][]Rule)
+	for _, n := range nodes {
+		if n.IP == "" {
+			continue
+		}
+		from := RegionOf(spec, n.Index)
+		var rules []Rule
+		for _, to := range regions {
+			link, ok := links[linkKey(from, to)]
+			if !ok {
+				continue
+			}
+			var ips []string
+			for _, peer := range byRegion[to] {
+				if peer.Name != n.Name {
+					ips = append(ips, peer.IP)
+				}
+			}
+			if len(ips) > 0 {
+				rules = append(rules, Rule{Region: to, Link: link, IPs: ips})
+			}
+		}
+		plan[n.Name] = rules
+	}
+	return plan
+}
+
+// Script returns the shell script that replaces the shaping of a node's
+// outgoing traffic with rules. Without rules it only clears the shaping.
+func Script(rules []Rule) string {
+	var b strings.Builder
+	b.WriteString("set -e\n")
+	b.WriteString(ClearScript())
+	if len(rules) == 0 {
+		return b.String()
+	}
+
+	dev := DefaultDevice
+	priomap := strings.TrimSpace(strings.Repeat("0 ", 16))
+	fmt.Fprintf(&b, "tc qdisc add dev %s root handle 1: prio bands %d priomap %s\n", dev, len(rules)+1, priomap)
+	for i, rule := range rules {
+		band := i + 2
+		fmt.Fprintf(&b, "tc qdisc add dev %s parent 1:%x handle %x: netem %s\n", dev, band, band+0x10, NetemArgs(rule.Link))
+		for _, ip := range rule.IPs {
+			fmt.Fprintf(&b, "tc filter add dev %s parent 1: protocol ip prio 1 u32 match ip dst %s/32 flowid 1:%x\n", dev, ip, band)
+		}
+	}
+	return b.String()
+}
+
+// ClearScript returns the shell script that removes a node's shaping.
+func ClearScript() string {
+	return fmt.Sprintf("tc qdisc del dev %s root 2>/dev/null || true\n", DefaultDevice)
+}
+
+// NetemArgs returns the netem options of a link's conditions.
+func NetemArgs(link types.NetworkLink) string {
+	var args []string
+	if link.Latency != "" {
+		latency, _ := time.ParseDuration(link.Latency)
+		args = append(args, "delay", fmt.Sprintf("%dus", latency.Microseconds()))
+		if link.Jitter != "" {
+			jitter, _ := time.ParseDuration(link.Jitter)
+			args = append(args, fmt.Sprintf("%dus", jitter.Microseconds()))
+		}
+	}
+	if link.Loss != "" {
+		args = append(args, "loss", link.Loss)
+	}
+	if link.Rate != "" {
+		args = append(args, "rate", link.Rate)
+	}
+	return strings.Join(args, " ")
+}
+
+// Describe summarizes a rule, e.g. "eu-west (2 nodes): 100ms ±20ms, 1%
+// loss, 10mbit".
+func Describe(rule Rule) string {
+	var conds []string
+	if l := rule.Link; l.Latency != "" {
+		if l.Jitter != "" {
+			conds = append(conds, l.Latency+" ±"+l.Jitter)
+		} else {
+			conds = append(conds, l.Latency)
+		}
+	}
+	if rule.Link.Loss != "" {
+		conds = append(conds, rule.Link.Loss+" loss")
+	}
+	if rule.Link.Rate != "" {
+		conds = append(conds, rule.Link.Rate)
+	}
+	noun := "nodes"
+	if len(rule.IPs) == 1 {
+		noun = "node"
+	}
+	return fmt.Sprintf("%s (%d %s): %s", rule.Region, len(rule.IPs), noun, strings.Join(conds, ", "))
+}
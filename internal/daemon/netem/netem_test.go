@@ -0,0 +1,99 @@
+// internal/daemon/netem/netem_test.go
+package netem
+
+import (
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testShaping() types.NetworkShaping {
+	return types.NetworkShaping{
+		Regions: map[int]string{0: "us-east", 1: "us-east", 2: "eu-west", 3: "eu-west"},
+		Links: []types.NetworkLink{
+			{From: "us-east", To: "eu-west", Latency: "100ms", Jitter: "20ms", Loss: "1%"},
+			{From: "eu-west", To: "eu-west", Rate: "10mbit"},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(testShaping(), 4))
+	require.NoError(t, Validate(types.NetworkShaping{
+		Links: []types.NetworkLink{{From: DefaultRegion, To: DefaultRegion, Latency: "5ms"}},
+	}, 2))
+
+	err := Validate(types.NetworkShaping{
+		Regions: map[int]string{0: "us-east", 5: "eu-west", 1: "far away"},
+		Links: []types.NetworkLink{
+			{From: "us-east", To: "mars", Latency: "1s"},
+			{From: "us-east", To: "eu-west"},
+			{From: "eu-west", To: "us-east", Latency: "soon", Loss: "1"},
+			{From: "us-east", To: "us-east", Jitter: "5ms"},
+			{From: DefaultRegion, To: "us-east", Loss: "120%", Rate: "fast"},
+		},
+	}, 4)
+	require.Error(t, err)
+	for _, want := range []string{
+		"region of node 5: devnet has 4 nodes",
+		`region of node 1: "far away" is not a valid label`,
+		`link 0 (us-east-mars): unknown region "mars"`,
+		"link 1 (us-east-eu-west): needs a latency, loss or rate",
+		"link 2 (eu-west-us-east): duplicate link",
+		`link 2 (eu-west-us-east): invalid latency "soon"`,
+		"link 3 (us-east-us-east): jitter needs a latency",
+		`link 4 (default-us-east): invalid loss "120%": more than 100%`,
+	} {
+		assert.ErrorContains(t, err, want)
+	}
+
+	regions := make(map[int]string)
+	for i := 0; i < MaxRegions; i++ {
+		regions[i] = string(rune('a' + i))
+	}
+	assert.ErrorContains(t, Validate(types.NetworkShaping{Regions: regions}, MaxRegions), "at most 15")
+}
+
+func TestPlan(t *testing.T) {
+	nodes := []Node{
+		{Index: 0, Name: "node-0", IP: "172.17.0.2"},
+		{Index: 1, Name: "node-1", IP: "172.17.0.3"},
+		{Index: 2, Name: "node-2", IP: "172.17.0.4"},
+		{Index: 3, Name: "node-3", IP: "172.17.0.5"},
+		{Index: 4, Name: "node-4"},
+	}
+	plan := Plan(testShaping(), nodes)
+
+	require.Len(t, plan, 4)
+	us := plan["node-0"]
+	require.Len(t, us, 1)
+	assert.Equal(t, "eu-west", us[0].Region)
+	assert.Equal(t, []string{"172.17.0.4", "172.17.0.5"}, us[0].IPs)
+
+	eu := plan["node-2"]
+	require.Len(t, eu, 2)
+	assert.Equal(t, Rule{Region: "eu-west", Link: testShaping().Links[1], IPs: []string{"172.17.0.5"}}, eu[0])
+	assert.Equal(t, "us-east", eu[1].Region)
+	assert.Equal(t, "eu-west (1 node): 10mbit", Describe(eu[0]))
+	assert.Equal(t, "us-east (2 nodes): 100ms ±20ms, 1% loss", Describe(eu[1]))
+}
+
+func TestScript(t *testing.T) {
+	assert.Equal(t, "set -e\ntc qdisc del dev eth0 root 2>/dev/null || true\n", Script(nil))
+
+	script := Script(Plan(testShaping(), []Node{
+		{Index: 0, Name: "node-0", IP: "172.17.0.2"},
+		{Index: 2, Name: "node-2", IP: "172.17.0.4"},
+		{Index: 3, Name: "node-3", IP: "172.17.0.5"},
+	})["node-2"])
+	assert.Equal(t, `set -e
+tc qdisc del dev eth0 root 2>/dev/null || true
+tc qdisc add dev eth0 root handle 1: prio bands 3 priomap 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0
+tc qdisc add dev eth0 parent 1:2 handle 12: netem rate 10mbit
+tc filter add dev eth0 parent 1: protocol ip prio 1 u32 match ip dst 172.17.0.5/32 flowid 1:2
+tc qdisc add dev eth0 parent 1:3 handle 13: netem delay 100000us 20000us loss 1%
+tc filter add dev eth0 parent 1: protocol ip prio 1 u32 match ip dst 172.17.0.2/32 flowid 1:3
+`, script)
+}
@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/configpatch"
 	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
 )
 
@@ -383,23 +384,18 @@ func (s *NodeInitService) fixConfigPermissions(module network.NetworkModule, hom
 	return nil
 }
 
-// mergeTomlConfig merges override TOML into an existing config file.
-// This is a simple append-based approach - for full merge, consider using a TOML library.
+// mergeTomlConfig deep-merges override TOML into an existing config file.
 func (s *NodeInitService) mergeTomlConfig(configPath string, overrides []byte) error {
-	// Read existing config
 	existing, err := os.ReadFile(configPath)
 	if err != nil {
 		return fmt.Errorf("failed to read existing config: %w", err)
 	}
 
-	// For now, we'll append the overrides with a clear separator.
-	// A proper implementation would parse and merge TOML.
-	merged := make([]byte, 0, len(existing)+len(overrides)+50)
-	merged = append(merged, existing...)
-	merged = append(merged, []byte("\n# --- Module Config Overrides ---\n")...)
-	merged = append(merged, overrides...)
+	merged, err := configpatch.Merge(filepath.Base(configPath), existing, overrides)
+	if err != nil {
+		return err
+	}
 
-	// Write back
 	if err := os.WriteFile(configPath, merged, 0644); err != nil {
 		return fmt.Errorf("failed to write config: %w", err)
 	}
@@ -0,0 +1,248 @@
+// Package nodekeys validates and installs operator-provided node keys: the
+// CometBFT consensus key (priv_validator_key.json) and p2p identity
+// (node_key.json) of a validator slot. Reusing the keys of existing
+// infrastructure lets a fork rehearsal run with the same validator set and
+// node IDs as the network it forks.
+package nodekeys
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Key file names in a node's config directory.
+const (
+	PrivValidatorKeyFile = "priv_validator_key.json"
+	NodeKeyFile          = "node_key.json"
+)
+
+// Key types of CometBFT's amino JSON encoding.
+const (
+	typeEd25519PrivKey = "tendermint/PrivKeyEd25519"
+	typeEd25519PubKey  = "tendermint/PubKeyEd25519"
+)
+
+// Keys are the imported keys of one validator slot. An empty key is
+// generated by the chain's init command as usual.
+type Keys struct {
+	// Index is the validator's index, from 0.
+	Index int
+	// PrivValidatorKey is the contents of priv_validator_key.json.
+	PrivValidatorKey string
+	// NodeKey is the contents of node_key.json.
+	NodeKey string
+}
+
+type aminoKey struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// Validate checks the keys of a devnet with the given number of validators:
+// each entry names an existing slot at most once, holds at least one valid
+// key file, and no two slots share a consensus key or node key.
+func Validate(keys []Keys, validators int) error {
+	var errs []error
+	slots := make(map[int]bool)
+	consensusKeys := make(map[string]int)
+	nodeIDs := make(map[string]int)
+	for _, k := range keys {
+		if k.Index < 0 || k.Index >= validators {
+			errs = append(errs, fmt.Errorf("validator %d: index out of range (devnet has %d validators)", k.Index, validators))
+			continue
+		}
+		if slots[k.Index] {
+			errs = append(errs, fmt.Errorf("validator %d: keys given more than once", k.Index))
+			continue
+		}
+		slots[k.Index] = true
+
+		if k.PrivValidatorKey == "" && k.NodeKey == "" {
+			errs = append(errs, fmt.Errorf("validator %d: no keys given", k.Index))
+			continue
+		}
+		if k.PrivValidatorKey != "" {
+			pubKey, err := ConsensusPubKey(k.PrivValidatorKey)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("validator %d: %w", k.Index, err))
+			} else if prev, ok := consensusKeys[pubKey]; ok {
+				errs = append(errs, fmt.Errorf("validator %d: same consensus key as validator %d", k.Index, prev))
+			} else {
+				consensusKeys[pubKey] = k.Index
+			}
+		}
+		if k.NodeKey != "" {
+			id, err := NodeID(k.NodeKey)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("validator %d: %w", k.Index, err))
+			} else if prev, ok := nodeIDs[id]; ok {
+				errs = append(errs, fmt.Errorf("validator %d: same node key as validator %d", k.Index, prev))
+			} else {
+				nodeIDs[id] = k.Index
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ConsensusPubKey checks the contents of a priv_validator_key.json and
+// returns its base64 public key. For ed25519 keys it also checks that the
+// public key and address belong to the private key.
+func ConsensusPubKey(data string) (string, error) {
+	var key struct {
+		Address string   `json:"address"`
+		PubKey  aminoKey `json:"pub_key"`
+		PrivKey aminoKey `json:"priv_key"`
+	}
+	if err := json.Unmarshal([]byte(data), &key); err != nil {
+		return "", fmt.Errorf("invalid %s: %w", PrivValidatorKeyFile, err)
+	}
+	pub, err := decodeKey(key.PubKey, "pub_key")
+	if err != nil {
+		return "", fmt.Errorf("invalid %s: %w", PrivValidatorKeyFile, err)
+	}
+	priv, err := decodeKey(key.PrivKey, "priv_key")
+	if err != nil {
+		return "", fmt.Errorf("invalid %s: %w", PrivValidatorKeyFile, err)
+	}
+
+	if key.PrivKey.Type == typeEd25519PrivKey {
+		derived, err := ed25519PubKey(priv)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s: %w", PrivValidatorKeyFile, err)
+		}
+		if key.PubKey.Type != typeEd25519PubKey || !bytes.Equal(derived, pub) {
+			return "", fmt.Errorf("invalid %s: pub_key does not match priv_key", PrivValidatorKeyFile)
+		}
+		sum := sha256.Sum256(pub)
+		if key.Address != "" && !strings.EqualFold(key.Address, hex.EncodeToString(sum[:20])) {
+			return "", fmt.Errorf("invalid %s: address does not match pub_key", PrivValidatorKeyFile)
+		}
+	}
+	return key.PubKey.Value, nil
+}
+
+// NodeID checks the contents of a node_key.json and returns the node ID it
+// gives the node.
+func NodeID(data string) (string, error) {
+	var key struct {
+		PrivKey aminoKey `json:"priv_key"`
+	}
+	if err := json.Unmarshal([]byte(data), &key); err != nil {
+		return "", fmt.Errorf("invalid %s: %w", NodeKeyFile, err)
+	}
+	priv, err := decodeKey(key.PrivKey, "priv_key")
+	if err != nil {
+		return "", fmt.Errorf("invalid %s: %w", NodeKeyFile, err)
+	}
+	if key.PrivKey.Type != typeEd25519PrivKey {
+		return "", fmt.Errorf("invalid %s: unsupported key type %q", NodeKeyFile, key.PrivKey.Type)
+	}
+	pub, err := ed25519PubKey(priv)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s: %w", NodeKeyFile, err)
+	}
+	sum := sha256.Sum256(pub)
+	return hex.EncodeToString(sum[:20]), nil
+}
+
+// Generate renders the ed25519 keys with the given 32-byte seeds as
+// priv_validator_key.json and node_key.json, as the chain's init command
+// would write them.
+func Generate(index int, consensusSeed, nodeSeed []byte) (Keys, error) {
+	if len(consensusSeed) != ed25519.SeedSize || len(nodeSeed) != ed25519.SeedSize {
+		return Keys{}, fmt.Errorf("key seeds must be %d bytes", ed25519.SeedSize)
+	}
+
+	priv := ed25519.NewKeyFromSeed(consensusSeed)
+	pub := priv.Public().(ed25519.PublicKey)
+	sum := sha256.Sum256(pub)
+	privValidatorKey, err := json.MarshalIndent(struct {
+		Address string   `json:"address"`
+		PubKey  aminoKey `json:"pub_key"`
+		PrivKey aminoKey `json:"priv_key"`
+	}{
+		Address: strings.ToUpper(hex.EncodeToString(sum[:20])),
+		PubKey:  aminoKey{Type: typeEd25519PubKey, Value: base64.StdEncoding.EncodeToString(pub)},
+		PrivKey: aminoKey{Type: typeEd25519PrivKey, Value: base64.StdEncoding.EncodeToString(priv)},
+	}, "", "  ")
+	if err != nil {
+		return Keys{}, fmt.Errorf("failed to encode %s: %w", PrivValidatorKeyFile, err)
+	}
+
+	nodeKey, err := json.Marshal(struct {
+		PrivKey aminoKey `json:"priv_key"`
+	}{
+		PrivKey: aminoKey{Type: typeEd25519PrivKey, Value: base64.StdEncoding.EncodeToString(ed25519.NewKeyFromSeed(nodeSeed))},
+	})
+	if err != nil {
+		return Keys{}, fmt.Errorf("failed to encode %s: %w", NodeKeyFile, err)
+	}
+
+	return Keys{Index: index, PrivValidatorKey: string(privValidatorKey), NodeKey: string(nodeKey)}, nil
+}
+
+// Install writes k's keys into a node's config directory, replacing any
+// existing ones. Chain init commands keep key files that already exist, so
+// installing before init means init generates only the missing keys.
+func Install(configDir string, k Keys) error {
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	for name, data := range k.files() {
+		if err := os.WriteFile(filepath.Join(configDir, name), []byte(data), 0600); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Installed reports whether configDir holds k's keys.
+func Installed(configDir string, k Keys) bool {
+	for name, data := range k.files() {
+		existing, err := os.ReadFile(filepath.Join(configDir, name))
+		if err != nil || !bytes.Equal(existing, []byte(data)) {
+			return false
+		}
+	}
+	return true
+}
+
+// files maps the key file names of k to their contents.
+func (k Keys) files() map[string]string {
+	files := make(map[string]string, 2)
+	if k.PrivValidatorKey != "" {
+		files[PrivValidatorKeyFile] = k.PrivValidatorKey
+	}
+	if k.NodeKey != "" {
+		files[NodeKeyFile] = k.NodeKey
+	}
+	return files
+}
+
+func decodeKey(key aminoKey, field string) ([]byte, error) {
+	if key.Type == "" || key.Value == "" {
+		return nil, fmt.Errorf("%s is missing", field)
+	}
+	b, err := base64.StdEncoding.DecodeString(key.Value)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not base64: %w", field, err)
+	}
+	return b, nil
+}
+
+func ed25519PubKey(priv []byte) ([]byte, error) {
+	if len(priv) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("ed25519 priv_key must be %d bytes, got %d", ed25519.PrivateKeySize, len(priv))
+	}
+	return []byte(ed25519.PrivateKey(priv).Public().(ed25519.PublicKey)), nil
+}
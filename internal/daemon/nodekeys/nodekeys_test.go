@@ -0,0 +1,141 @@
+package nodekeys
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testKey returns a deterministic ed25519 key.
+func testKey(seed byte) ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed([]byte(strings.Repeat(string(rune('a'+seed)), ed25519.SeedSize)))
+}
+
+func privValidatorKeyJSON(priv ed25519.PrivateKey) string {
+	pub := priv.Public().(ed25519.PublicKey)
+	sum := sha256.Sum256(pub)
+	return fmt.Sprintf(`{"address":"%s","pub_key":{"type":"tendermint/PubKeyEd25519","value":"%s"},"priv_key":{"type":"tendermint/PrivKeyEd25519","value":"%s"}}`,
+		strings.ToUpper(hex.EncodeToString(sum[:20])),
+		base64.StdEncoding.EncodeToString(pub),
+		base64.StdEncoding.EncodeToString(priv))
+}
+
+func nodeKeyJSON(priv ed25519.PrivateKey) string {
+	return fmt.Sprintf(`{"priv_key":{"type":"tendermint/PrivKeyEd25519","value":"%s"}}`,
+		base64.StdEncoding.EncodeToString(priv))
+}
+
+func TestConsensusPubKey(t *testing.T) {
+	priv := testKey(0)
+	pubKey, err := ConsensusPubKey(privValidatorKeyJSON(priv))
+	require.NoError(t, err)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(priv.Public().(ed25519.PublicKey)), pubKey)
+
+	// pub_key of another key
+	other := testKey(1)
+	mismatched := strings.Replace(privValidatorKeyJSON(priv),
+		base64.StdEncoding.EncodeToString(priv.Public().(ed25519.PublicKey)),
+		base64.StdEncoding.EncodeToString(other.Public().(ed25519.PublicKey)), 1)
+	_, err = ConsensusPubKey(mismatched)
+	assert.ErrorContains(t, err, "pub_key does not match priv_key")
+
+	_, err = ConsensusPubKey(`{"address":"00","pub_key":` + strings.SplitN(privValidatorKeyJSON(priv), `"pub_key":`, 2)[1])
+	assert.ErrorContains(t, err, "address does not match pub_key")
+
+	_, err = ConsensusPubKey(`{"pub_key":{"type":"tendermint/PubKeyEd25519","value":"AAAA"}}`)
+	assert.ErrorContains(t, err, "priv_key is missing")
+
+	_, err = ConsensusPubKey(`not json`)
+	assert.ErrorContains(t, err, "invalid priv_validator_key.json")
+}
+
+func TestNodeID(t *testing.T) {
+	priv := testKey(2)
+	id, err := NodeID(nodeKeyJSON(priv))
+	require.NoError(t, err)
+	sum := sha256.Sum256(priv.Public().(ed25519.PublicKey))
+	assert.Equal(t, hex.EncodeToString(sum[:20]), id)
+
+	_, err = NodeID(`{"priv_key":{"type":"tendermint/PrivKeyEd25519","value":"AAAA"}}`)
+	assert.ErrorContains(t, err, "must be 64 bytes")
+}
+
+func TestGenerate(t *testing.T) {
+	consensus, node := testKey(3), testKey(4)
+	k, err := Generate(1, consensus.Seed(), node.Seed())
+	require.NoError(t, err)
+	assert.Equal(t, 1, k.Index)
+
+	pubKey, err := ConsensusPubKey(k.PrivValidatorKey)
+	require.NoError(t, err)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(consensus.Public().(ed25519.PublicKey)), pubKey)
+	assert.Equal(t, nodeKeyJSON(node), k.NodeKey)
+
+	_, err = Generate(0, []byte("short"), node.Seed())
+	assert.ErrorContains(t, err, "key seeds must be 32 bytes")
+}
+
+func TestValidate(t *testing.T) {
+	val0 := Keys{Index: 0, PrivValidatorKey: privValidatorKeyJSON(testKey(0)), NodeKey: nodeKeyJSON(testKey(10))}
+	val1 := Keys{Index: 1, PrivValidatorKey: privValidatorKeyJSON(testKey(1))}
+
+	tests := []struct {
+		name    string
+		keys    []Keys
+		wantErr string
+	}{
+		{name: "none", keys: nil},
+		{name: "some slots", keys: []Keys{val0, val1}},
+		{name: "out of range", keys: []Keys{{Index: 2, NodeKey: val0.NodeKey}}, wantErr: "validator 2: index out of range"},
+		{name: "slot twice", keys: []Keys{val0, {Index: 0, NodeKey: nodeKeyJSON(testKey(11))}}, wantErr: "validator 0: keys given more than once"},
+		{name: "empty", keys: []Keys{{Index: 1}}, wantErr: "validator 1: no keys given"},
+		{
+			name:    "shared consensus key",
+			keys:    []Keys{val0, {Index: 1, PrivValidatorKey: val0.PrivValidatorKey}},
+			wantErr: "validator 1: same consensus key as validator 0",
+		},
+		{
+			name:    "shared node key",
+			keys:    []Keys{val0, {Index: 1, NodeKey: val0.NodeKey}},
+			wantErr: "validator 1: same node key as validator 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.keys, 2)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestInstall(t *testing.T) {
+	configDir := filepath.Join(t.TempDir(), "config")
+	keys := Keys{Index: 0, PrivValidatorKey: privValidatorKeyJSON(testKey(0))}
+
+	assert.False(t, Installed(configDir, keys))
+	require.NoError(t, Install(configDir, keys))
+	assert.True(t, Installed(configDir, keys))
+
+	data, err := os.ReadFile(filepath.Join(configDir, PrivValidatorKeyFile))
+	require.NoError(t, err)
+	assert.Equal(t, keys.PrivValidatorKey, string(data))
+	_, err = os.Stat(filepath.Join(configDir, NodeKeyFile))
+	assert.True(t, os.IsNotExist(err), "node_key.json is left to init")
+
+	// A key regenerated over the imported one is detected
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, PrivValidatorKeyFile), []byte(privValidatorKeyJSON(testKey(1))), 0600))
+	assert.False(t, Installed(configDir, keys))
+}
@@ -0,0 +1,508 @@
+// internal/daemon/notify/notify.go
+
+// Package notify sends devnet events to webhooks: phase transitions such as
+// Provisioning→Running, crashed and recovered nodes, finished upgrades and
+// expiring TTLs. Webhooks come from devnetd.toml and from the devnets' own
+// specs; each receives a JSON payload in the Slack, Discord or generic
+// format, with optional text/template message text, and failed deliveries
+// are retried with backoff.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/clock"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/retry"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// Event types. Devnet phase transitions are "devnet." and the lowercase
+// phase the devnet entered.
+const (
+	EventDevnetProvisioning = "devnet.provisioning"
+	EventDevnetRunning      = "devnet.running"
+	EventDevnetDegraded     = "devnet.degraded"
+	EventDevnetStopped      = "devnet.stopped"
+	EventDevnetDeleted      = "devnet.deleted"
+	EventTTLExpiring        = "devnet.ttl_expiring"
+	EventNodeCrashed        = "node.crashed"
+	EventNodeRecovered      = "node.recovered"
+	EventUpgradeCompleted   = "upgrade.completed"
+	EventUpgradeFailed      = "upgrade.failed"
+)
+
+// EventTypes are all event types, which webhooks filter on.
+var EventTypes = []string{
+	EventDevnetProvisioning,
+	EventDevnetRunning,
+	EventDevnetDegraded,
+	EventDevnetStopped,
+	EventDevnetDeleted,
+	EventTTLExpiring,
+	EventNodeCrashed,
+	EventNodeRecovered,
+	EventUpgradeCompleted,
+	EventUpgradeFailed,
+}
+
+// DefaultTTLWarning is how long before a devnet's TTL expires the
+// devnet.ttl_expiring event is sent.
+const DefaultTTLWarning = 15 * time.Minute
+
+// DefaultPolicy is the retry policy of webhook deliveries unless
+// devnetd.toml sets another.
+var DefaultPolicy = retry.Policy{
+	MaxAttempts:    4,
+	InitialBackoff: time.Second,
+	MaxBackoff:     30 * time.Second,
+	Multiplier:     2,
+}
+
+// ttlCheckInterval is how often the Notifier looks for expiring devnets.
+const ttlCheckInterval = time.Minute
+
+// deliveryTimeout bounds one webhook request.
+const deliveryTimeout = 10 * time.Second
+
+// Event is a notification, the JSON payload of generic webhooks and the
+// data of message templates.
+type Event struct {
+	// Type is one of EventTypes.
+	Type string `json:"type"`
+
+	Namespace string `json:"namespace"`
+	Devnet    string `json:"devnet"`
+
+	// Node or Upgrade is the resource the event is about, if not the
+	// devnet itself.
+	Node    string `json:"node,omitempty"`
+	Upgrade string `json:"upgrade,omitempty"`
+
+	// Phase is the resource's new phase and PreviousPhase its phase before
+	// the transition.
+	Phase         string `json:"phase,omitempty"`
+	PreviousPhase string `json:"previousPhase,omitempty"`
+
+	// Message describes the event for people.
+	Message string `json:"message"`
+
+	// ExpiresAt is when the devnet's TTL expires (devnet.ttl_expiring).
+	ExpiresAt time.Time `json:"expiresAt,omitempty"`
+
+	Time time.Time `json:"time"`
+}
+
+// observed is the last phase seen of a resource, and its generation:
+// the store delivers changes concurrently, so older ones may arrive late.
+type observed struct {
+	phase      string
+	generation int64
+}
+
+// Notifier watches the store for devnet, node and upgrade changes and sends
+// the resulting events to the webhooks in devnetd.toml and to those of the
+// devnet concerned. Like the TTLController it runs alongside the
+// controllers rather than reconciling by key.
+type Notifier struct {
+	store      store.Store
+	webhooks   []types.Webhook
+	policy     retry.Policy
+	ttlWarning time.Duration
+	client     *http.Client
+	logger     *slog.Logger
+
+	clock clock.Clock
+
+	mu      sync.Mutex
+	phases  map[string]observed  // key: resource type and full name
+	ttlSent map[string]time.Time // key: devnet full name, value: warned expiry
+
+	cancel         context.CancelFunc // stops watching
+	cancelDelivery context.CancelFunc // abandons pending deliveries
+	deliveryCtx    context.Context
+	wg             sync.WaitGroup
+	delivery       sync.WaitGroup
+}
+
+// NewNotifier creates a Notifier sending to the given daemon-wide webhooks,
+// with the policy's retries.
+func NewNotifier(s store.Store, webhooks []types.Webhook, policy retry.Policy) *Notifier {
+	return &Notifier{
+		store:      s,
+		webhooks:   webhooks,
+		policy:     policy,
+		ttlWarning: DefaultTTLWarning,
+		client:     &http.Client{Timeout: deliveryTimeout},
+		logger:     slog.Default(),
+		clock:      clock.System,
+		phases:     make(map[string]observed),
+		ttlSent:    make(map[string]time.Time),
+	}
+}
+
+// SetLogger sets the logger for the notifier.
+func (n *Notifier) SetLogger(logger *slog.Logger) {
+	n.logger = logger
+}
+
+// SetClock sets the clock the notifier tells the time by.
+func (n *Notifier) SetClock(clk clock.Clock) {
+	n.clock = clk
+}
+
+// SetTTLWarning sets how long before a TTL expires devnet.ttl_expiring is
+// sent. Zero disables the event.
+func (n *Notifier) SetTTLWarning(d time.Duration) {
+	n.ttlWarning = d
+}
+
+// Start starts watching the store. The current phases are recorded
+// first, so a restarted daemon does not repeat old transitions.
+func (n *Notifier) Start(ctx context.Context) {
+	n.deliveryCtx, n.cancelDelivery = context.WithCancel(context.WithoutCancel(ctx))
+	ctx, n.cancel = context.WithCancel(ctx)
+
+	for _, resourceType := range []string{"devnets", "nodes", "upgrades"} {
+		n.wg.Add(1)
+		go func() {
+			defer n.wg.Done()
+			_ = n.store.Watch(ctx, resourceType, func(eventType string, resource interface{}) {
+				n.handle(ctx, eventType, resource)
+			})
+		}()
+	}
+	n.seed(ctx)
+
+	n.wg.Add(1)
+	go n.ttlLoop(ctx)
+}
+
+// Stop stops watching and gives pending deliveries one request timeout to
+// finish before abandoning them.
+func (n *Notifier) Stop() {
+	if n.cancel == nil {
+		return
+	}
+	n.cancel()
+	n.wg.Wait()
+
+	done := make(chan struct{})
+	go func() {
+		n.delivery.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(deliveryTimeout):
+		n.logger.Warn("abandoning pending webhook deliveries")
+	}
+	n.cancelDelivery()
+	<-done
+}
+
+// seed records the phases of the resources already in the store.
+func (n *Notifier) seed(ctx context.Context) {
+	devnets, err := n.store.ListDevnets(ctx, "")
+	if err != nil {
+		n.logger.Warn("failed to list devnets for notifications", "error", err)
+		return
+	}
+	for _, d := range devnets {
+		n.observe("devnets/"+d.Metadata.FullName(), d.Status.Phase, d.Metadata.Generation)
+		nodes, err := n.store.ListNodes(ctx, d.Metadata.Namespace, d.Metadata.Name)
+		if err != nil {
+			n.logger.Warn("failed to list nodes for notifications", "devnet", d.Metadata.FullName(), "error", err)
+			continue
+		}
+		for _, node := range nodes {
+			n.observe(nodeKey(node), node.Status.Phase, node.Metadata.Generation)
+		}
+	}
+	upgrades, err := n.store.ListUpgrades(ctx, "", "")
+	if err != nil {
+		n.logger.Warn("failed to list upgrades for notifications", "error", err)
+		return
+	}
+	for _, u := range upgrades {
+		n.observe("upgrades/"+u.Metadata.FullName(), u.Status.Phase, u.Metadata.Generation)
+	}
+}
+
+// observe records a resource's phase and returns its previous phase, and
+// whether this is a new transition: the resource was seen before, in
+// another phase, and the change is not older than the last one seen.
+func (n *Notifier) observe(key, phase string, generation int64) (string, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	prev, seen := n.phases[key]
+	if seen && generation < prev.generation {
+		return prev.phase, false
+	}
+	n.phases[key] = observed{phase: phase, generation: generation}
+	return prev.phase, seen && prev.phase != phase
+}
+
+// forget drops a deleted resource.
+func (n *Notifier) forget(key string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.phases, key)
+	delete(n.ttlSent, key)
+}
+
+// handle turns a store change into events.
+func (n *Notifier) handle(ctx context.Context, eventType string, resource interface{}) {
+	switch r := resource.(type) {
+	case *types.Devnet:
+		n.handleDevnet(eventType, r)
+	case *types.Node:
+		n.handleNode(ctx, eventType, r)
+	case *types.Upgrade:
+		n.handleUpgrade(ctx, eventType, r)
+	}
+}
+
+func (n *Notifier) handleDevnet(eventType string, devnet *types.Devnet) {
+	key := "devnets/" + devnet.Metadata.FullName()
+	if eventType == "DELETED" {
+		n.forget(key)
+		n.send(devnet, n.event(EventDevnetDeleted, devnet.Metadata, fmt.Sprintf("Devnet %s was deleted", devnet.Metadata.FullName())))
+		return
+	}
+
+	prev, changed := n.observe(key, devnet.Status.Phase, devnet.Metadata.Generation)
+	if eventType != "MODIFIED" || !changed {
+		return
+	}
+	eventType = devnetEventType(devnet.Status.Phase)
+	if eventType == "" {
+		return
+	}
+
+	msg := fmt.Sprintf("Devnet %s is %s (was %s)", devnet.Metadata.FullName(), devnet.Status.Phase, prev)
+	if devnet.Status.Message != "" {
+		msg += ": " + devnet.Status.Message
+	}
+	event := n.event(eventType, devnet.Metadata, msg)
+	event.Phase, event.PreviousPhase = devnet.Status.Phase, prev
+	n.send(devnet, event)
+}
+
+// devnetEventType returns the event type of a devnet entering phase, or ""
+// for phases without one.
+func devnetEventType(phase string) string {
+	switch phase {
+	case types.PhaseProvisioning, types.PhaseRunning, types.PhaseDegraded, types.PhaseStopped:
+		return "devnet." + strings.ToLower(phase)
+	}
+	return ""
+}
+
+func (n *Notifier) handleNode(ctx context.Context, eventType string, node *types.Node) {
+	key := nodeKey(node)
+	if eventType == "DELETED" {
+		n.forget(key)
+		return
+	}
+
+	prev, changed := n.observe(key, node.Status.Phase, node.Metadata.Generation)
+	if eventType != "MODIFIED" || !changed {
+		return
+	}
+
+	var msg string
+	switch {
+	case node.Status.Phase == types.NodePhaseCrashed:
+		eventType = EventNodeCrashed
+		msg = fmt.Sprintf("Node %s crashed", node.Metadata.Name)
+		if node.Status.Message != "" {
+			msg += ": " + node.Status.Message
+		}
+	case prev == types.NodePhaseCrashed && node.Status.Phase == types.NodePhaseRunning:
+		eventType = EventNodeRecovered
+		msg = fmt.Sprintf("Node %s recovered after %d restarts", node.Metadata.Name, node.Status.RestartCount)
+	default:
+		return
+	}
+
+	devnet, err := n.store.GetDevnet(ctx, node.Metadata.Namespace, node.Spec.DevnetRef)
+	if err != nil {
+		n.logger.Warn("failed to get devnet for notification", "node", node.Metadata.FullName(), "error", err)
+		return
+	}
+	event := n.event(eventType, devnet.Metadata, msg)
+	event.Node = node.Metadata.Name
+	event.Phase, event.PreviousPhase = node.Status.Phase, prev
+	n.send(devnet, event)
+}
+
+// nodeKey returns the key of a node's phase: node names are only unique
+// within their devnet.
+func nodeKey(node *types.Node) string {
+	namespace := node.Metadata.Namespace
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+	return fmt.Sprintf("nodes/%s/%s/%d", namespace, node.Spec.DevnetRef, node.Spec.Index)
+}
+
+func (n *Notifier) handleUpgrade(ctx context.Context, eventType string, upgrade *types.Upgrade) {
+	key := "upgrades/" + upgrade.Metadata.FullName()
+	if eventType == "DELETED" {
+		n.forget(key)
+		return
+	}
+
+	prev, changed := n.observe(key, upgrade.Status.Phase, upgrade.Metadata.Generation)
+	if eventType != "MODIFIED" || !changed {
+		return
+	}
+
+	var msg string
+	switch upgrade.Status.Phase {
+	case types.UpgradePhaseCompleted:
+		eventType = EventUpgradeCompleted
+		msg = fmt.Sprintf("Upgrade %s to %s completed", upgrade.Metadata.Name, upgrade.Spec.UpgradeName)
+		if upgrade.Status.PostUpgradeHeight > 0 {
+			msg += fmt.Sprintf(" at height %d", upgrade.Status.PostUpgradeHeight)
+		}
+	case types.UpgradePhaseFailed:
+		eventType = EventUpgradeFailed
+		msg = fmt.Sprintf("Upgrade %s to %s failed in phase %s", upgrade.Metadata.Name, upgrade.Spec.UpgradeName, prev)
+		if upgrade.Status.Error != "" {
+			msg += ": " + upgrade.Status.Error
+		}
+	default:
+		return
+	}
+
+	namespace := upgrade.Spec.NamespaceRef
+	if namespace == "" {
+		namespace = upgrade.Metadata.Namespace
+	}
+	devnet, err := n.store.GetDevnet(ctx, namespace, upgrade.Spec.DevnetRef)
+	if err != nil {
+		n.logger.Warn("failed to get devnet for notification", "upgrade", upgrade.Metadata.FullName(), "error", err)
+		return
+	}
+	event := n.event(eventType, devnet.Metadata, msg)
+	event.Upgrade = upgrade.Metadata.Name
+	event.Phase, event.PreviousPhase = upgrade.Status.Phase, prev
+	n.send(devnet, event)
+}
+
+func (n *Notifier) ttlLoop(ctx context.Context) {
+	defer n.wg.Done()
+
+	ticker := time.NewTicker(ttlCheckInterval)
+	defer ticker.Stop()
+
+	n.checkTTLs(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n.checkTTLs(ctx)
+		}
+	}
+}
+
+// checkTTLs sends devnet.ttl_expiring, once per expiry, for devnets whose
+// TTL expires within the warning.
+func (n *Notifier) checkTTLs(ctx context.Context) {
+	if n.ttlWarning <= 0 {
+		return
+	}
+	devnets, err := n.store.ListDevnets(ctx, "")
+	if err != nil {
+		n.logger.Warn("failed to list devnets for TTL notifications", "error", err)
+		return
+	}
+
+	now := n.clock.Now()
+	for _, devnet := range devnets {
+		expiresAt := devnet.Status.ExpiresAt
+		if expiresAt.IsZero() || devnet.Status.Expired(now) || expiresAt.Sub(now) > n.ttlWarning {
+			continue
+		}
+		if devnet.Status.Phase == types.PhaseStopped && !devnet.Spec.DeleteOnExpiry {
+			continue
+		}
+
+		key := "devnets/" + devnet.Metadata.FullName()
+		n.mu.Lock()
+		warned := n.ttlSent[key].Equal(expiresAt)
+		n.ttlSent[key] = expiresAt
+		n.mu.Unlock()
+		if warned {
+			continue
+		}
+
+		action := "stopped"
+		if devnet.Spec.DeleteOnExpiry {
+			action = "deleted"
+		}
+		event := n.event(EventTTLExpiring, devnet.Metadata, fmt.Sprintf("Devnet %s expires in %s and will be %s",
+			devnet.Metadata.FullName(), expiresAt.Sub(now).Round(time.Second), action))
+		event.ExpiresAt = expiresAt
+		n.send(devnet, event)
+	}
+}
+
+func (n *Notifier) event(eventType string, devnet types.ResourceMeta, msg string) Event {
+	namespace := devnet.Namespace
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+	return Event{
+		Type:      eventType,
+		Namespace: namespace,
+		Devnet:    devnet.Name,
+		Message:   msg,
+		Time:      n.clock.Now(),
+	}
+}
+
+// send delivers the event to every daemon-wide and devnet webhook that
+// subscribed to it, in the background.
+func (n *Notifier) send(devnet *types.Devnet, event Event) {
+	webhooks := append(append([]types.Webhook(nil), n.webhooks...), devnet.Spec.Webhooks...)
+	for _, w := range webhooks {
+		if !wants(w, event.Type) {
+			continue
+		}
+		n.delivery.Add(1)
+		go func() {
+			defer n.delivery.Done()
+			err := post(n.deliveryCtx, n.client, w, event, n.policy, func(attempt int, err error, wait time.Duration) {
+				n.logger.Debug("webhook delivery failed, retrying",
+					"event", event.Type, "url", DisplayURL(w.URL), "attempt", attempt, "wait", wait, "error", err)
+			})
+			if err != nil {
+				n.logger.Warn("failed to deliver webhook",
+					"event", event.Type, "devnet", event.Namespace+"/"+event.Devnet, "url", DisplayURL(w.URL), "error", err)
+			}
+		}()
+	}
+}
+
+// DisplayURL strips the path and query of a webhook URL for logs: Slack and
+// Discord webhook URLs carry their secret in the path.
+func DisplayURL(rawURL string) string {
+	if i := strings.Index(rawURL, "://"); i >= 0 {
+		if j := strings.Index(rawURL[i+3:], "/"); j >= 0 {
+			return rawURL[:i+3+j] + "/..."
+		}
+	}
+	return rawURL
+}
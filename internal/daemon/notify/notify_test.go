@@ -0,0 +1,216 @@
+// internal/daemon/notify/notify_test.go
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/clock"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/retry"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// receiver is a webhook endpoint that passes the payloads it receives on.
+func receiver(t *testing.T) (*httptest.Server, <-chan map[string]any) {
+	t.Helper()
+	payloads := make(chan map[string]any, 16)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Errorf("invalid payload %q: %v", body, err)
+		}
+		payloads <- payload
+	}))
+	t.Cleanup(srv.Close)
+	return srv, payloads
+}
+
+func next(t *testing.T, payloads <-chan map[string]any) map[string]any {
+	t.Helper()
+	select {
+	case p := <-payloads:
+		return p
+	case <-time.After(5 * time.Second):
+		t.Fatal("no webhook delivered")
+		return nil
+	}
+}
+
+func TestNotifier_Transitions(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+
+	global, globalPayloads := receiver(t)
+	own, ownPayloads := receiver(t)
+
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test"},
+		Spec: types.DevnetSpec{Webhooks: []types.Webhook{
+			{URL: own.URL, Format: FormatSlack, Events: []string{"node.*"}, Template: "{{.Node}} is {{.Phase}}"},
+		}},
+		Status: types.DevnetStatus{Phase: types.PhaseProvisioning},
+	}
+	require.NoError(t, s.CreateDevnet(ctx, devnet))
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: "validator-0"},
+		Spec:     types.NodeSpec{DevnetRef: "test", Index: 0},
+		Status:   types.NodeStatus{Phase: types.NodePhaseRunning},
+	}
+	require.NoError(t, s.CreateNode(ctx, node))
+
+	n := NewNotifier(s, []types.Webhook{{URL: global.URL, Events: []string{"devnet.running", "node.crashed"}}}, retry.Policy{})
+	n.Start(ctx)
+	defer n.Stop()
+
+	// Give the store watchers time to register
+	time.Sleep(100 * time.Millisecond)
+
+	// Provisioning→Running goes to the daemon-wide webhook only
+	devnet.Status.Phase = types.PhaseRunning
+	require.NoError(t, s.UpdateDevnet(ctx, devnet))
+	p := next(t, globalPayloads)
+	assert.Equal(t, EventDevnetRunning, p["type"])
+	assert.Equal(t, types.PhaseProvisioning, p["previousPhase"])
+	assert.Equal(t, "[default/test] Devnet default/test is Running (was Provisioning)", p["text"])
+
+	// A crash goes to both, in their formats
+	node.Status.Phase = types.NodePhaseCrashed
+	node.Status.Message = "exit code 2"
+	require.NoError(t, s.UpdateNode(ctx, node))
+	p = next(t, globalPayloads)
+	assert.Equal(t, EventNodeCrashed, p["type"])
+	assert.Equal(t, "validator-0", p["node"])
+	assert.Equal(t, "Node validator-0 crashed: exit code 2", p["message"])
+	assert.Equal(t, map[string]any{"text": "validator-0 is Crashed"}, next(t, ownPayloads))
+
+	// Changes without a transition are not sent. The memory store keeps no
+	// generations, so give each change time to arrive before the next.
+	node.Status.Message = "still down"
+	require.NoError(t, s.UpdateNode(ctx, node))
+	time.Sleep(50 * time.Millisecond)
+	node.Status.Phase = types.NodePhaseRunning
+	require.NoError(t, s.UpdateNode(ctx, node))
+	assert.Equal(t, map[string]any{"text": "validator-0 is Running"}, next(t, ownPayloads))
+	select {
+	case p := <-globalPayloads:
+		t.Errorf("unexpected delivery: %v", p)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestNotifier_Upgrade(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	srv, payloads := receiver(t)
+
+	require.NoError(t, s.CreateDevnet(ctx, &types.Devnet{Metadata: types.ResourceMeta{Name: "test"}}))
+	n := NewNotifier(s, []types.Webhook{{URL: srv.URL, Format: FormatDiscord}}, retry.Policy{})
+	n.Start(ctx)
+	defer n.Stop()
+
+	// Give the store watchers time to register
+	time.Sleep(100 * time.Millisecond)
+
+	upgrade := &types.Upgrade{
+		Metadata: types.ResourceMeta{Name: "v2"},
+		Spec:     types.UpgradeSpec{DevnetRef: "test", UpgradeName: "v2.0.0"},
+		Status:   types.UpgradeStatus{Phase: types.UpgradePhaseVoting},
+	}
+	require.NoError(t, s.CreateUpgrade(ctx, upgrade))
+	time.Sleep(50 * time.Millisecond)
+	upgrade.Status.Phase = types.UpgradePhaseFailed
+	upgrade.Status.Error = "proposal rejected"
+	require.NoError(t, s.UpdateUpgrade(ctx, upgrade))
+
+	assert.Equal(t, map[string]any{"content": "[default/test] Upgrade v2 to v2.0.0 failed in phase Voting: proposal rejected"}, next(t, payloads))
+}
+
+func TestNotifier_TTLExpiring(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	srv, payloads := receiver(t)
+
+	now := time.Now()
+	devnets := []*types.Devnet{
+		{Metadata: types.ResourceMeta{Name: "expiring"}, Status: types.DevnetStatus{Phase: types.PhaseRunning, ExpiresAt: now.Add(10 * time.Minute)}},
+		{Metadata: types.ResourceMeta{Name: "later"}, Status: types.DevnetStatus{Phase: types.PhaseRunning, ExpiresAt: now.Add(time.Hour)}},
+		{Metadata: types.ResourceMeta{Name: "stopped"}, Status: types.DevnetStatus{Phase: types.PhaseStopped, ExpiresAt: now.Add(time.Minute)}},
+	}
+	for _, d := range devnets {
+		require.NoError(t, s.CreateDevnet(ctx, d))
+	}
+
+	n := NewNotifier(s, []types.Webhook{{URL: srv.URL}}, retry.Policy{})
+	n.SetClock(clock.NewFake(now))
+	n.deliveryCtx, n.cancelDelivery = context.WithCancel(ctx)
+
+	// Sent once per expiry
+	n.checkTTLs(ctx)
+	n.checkTTLs(ctx)
+	n.delivery.Wait()
+
+	p := next(t, payloads)
+	assert.Equal(t, EventTTLExpiring, p["type"])
+	assert.Equal(t, "expiring", p["devnet"])
+	assert.Equal(t, "Devnet default/expiring expires in 10m0s and will be stopped", p["message"])
+	assert.Empty(t, payloads)
+}
+
+func TestPost_Retries(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch calls.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	policy := retry.Policy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+	event := Event{Type: EventDevnetStopped, Namespace: "default", Devnet: "test"}
+	require.NoError(t, post(context.Background(), srv.Client(), types.Webhook{URL: srv.URL}, event, policy, nil))
+	assert.Equal(t, int32(2), calls.Load())
+
+	// Client errors are not retried
+	calls.Store(0)
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer bad.Close()
+	require.Error(t, post(context.Background(), bad.Client(), types.Webhook{URL: bad.URL}, event, policy, nil))
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate([]types.Webhook{
+		{URL: "https://hooks.slack.com/services/x", Format: FormatSlack, Events: []string{"devnet.*", "node.crashed"}},
+		{URL: "http://localhost:8080/hook", Template: "{{.Devnet}}: {{.Message}}"},
+	}))
+
+	err := Validate([]types.Webhook{
+		{URL: "ftp://example.com"},
+		{URL: "https://example.com", Format: "teams", Events: []string{"node.exploded"}, Template: "{{.Devnet"},
+	})
+	require.Error(t, err)
+	for _, want := range []string{"webhook 0: invalid url", "webhook 1: unknown format", "webhook 1: unknown event", "webhook 1: invalid template"} {
+		assert.Contains(t, err.Error(), want)
+	}
+}
+
+func TestDisplayURL(t *testing.T) {
+	assert.Equal(t, "https://hooks.slack.com/...", DisplayURL("https://hooks.slack.com/services/T/B/secret"))
+	assert.Equal(t, "http://localhost:8080", DisplayURL("http://localhost:8080"))
+}
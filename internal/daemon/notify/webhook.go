@@ -0,0 +1,149 @@
+// internal/daemon/notify/webhook.go
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/retry"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// Webhook payload formats.
+const (
+	FormatGeneric = "generic"
+	FormatSlack   = "slack"
+	FormatDiscord = "discord"
+)
+
+// Formats are the supported webhook payload formats.
+var Formats = []string{FormatGeneric, FormatSlack, FormatDiscord}
+
+// Validate reports webhooks that cannot be used: bad URLs, unknown formats
+// or event types, and templates that do not parse.
+func Validate(webhooks []types.Webhook) error {
+	var errs []error
+	for i, w := range webhooks {
+		if u, err := url.Parse(w.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("webhook %d: invalid url %q: want an http(s) URL", i, w.URL))
+		}
+		if w.Format != "" && !slices.Contains(Formats, w.Format) {
+			errs = append(errs, fmt.Errorf("webhook %d: unknown format %q (must be one of: %s)",
+				i, w.Format, strings.Join(Formats, ", ")))
+		}
+		for _, pattern := range w.Events {
+			if !validEventPattern(pattern) {
+				errs = append(errs, fmt.Errorf("webhook %d: unknown event %q (must be one of: %s, or a pattern like devnet.*)",
+					i, pattern, strings.Join(EventTypes, ", ")))
+			}
+		}
+		if w.Template != "" {
+			if _, err := template.New("webhook").Parse(w.Template); err != nil {
+				errs = append(errs, fmt.Errorf("webhook %d: invalid template: %v", i, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validEventPattern reports whether pattern matches at least one event type.
+func validEventPattern(pattern string) bool {
+	for _, t := range EventTypes {
+		if ok, _ := path.Match(pattern, t); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// wants reports whether the webhook subscribed to the event type.
+func wants(w types.Webhook, eventType string) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, pattern := range w.Events {
+		if ok, _ := path.Match(pattern, eventType); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// text renders the message text of the event for the webhook.
+func text(w types.Webhook, event Event) (string, error) {
+	if w.Template == "" {
+		return fmt.Sprintf("[%s/%s] %s", event.Namespace, event.Devnet, event.Message), nil
+	}
+	tmpl, err := template.New("webhook").Parse(w.Template)
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, event); err != nil {
+		return "", fmt.Errorf("failed to render template: %w", err)
+	}
+	return b.String(), nil
+}
+
+// payload encodes the event in the webhook's format.
+func payload(w types.Webhook, event Event) ([]byte, error) {
+	msg, err := text(w, event)
+	if err != nil {
+		return nil, err
+	}
+	switch w.Format {
+	case FormatSlack:
+		return json.Marshal(map[string]string{"text": msg})
+	case FormatDiscord:
+		return json.Marshal(map[string]string{"content": msg})
+	default:
+		return json.Marshal(struct {
+			Event
+			Text string `json:"text"`
+		}{event, msg})
+	}
+}
+
+// post delivers the event to the webhook, retrying failed deliveries
+// according to the policy. Client errors other than 408 and 429 are not
+// retried: the same request would fail again.
+func post(ctx context.Context, client *http.Client, w types.Webhook, event Event, policy retry.Policy, onRetry func(attempt int, err error, wait time.Duration)) error {
+	body, err := payload(w, event)
+	if err != nil {
+		return err
+	}
+	return retry.Do(ctx, policy, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+		if err != nil {
+			return retry.Permanent(err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return nil
+		}
+		err = fmt.Errorf("webhook returned %s", resp.Status)
+		if resp.StatusCode < 500 && resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+			return retry.Permanent(err)
+		}
+		return err
+	}, onRetry)
+}
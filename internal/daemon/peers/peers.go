@@ -0,0 +1,324 @@
+// internal/daemon/peers/peers.go
+
+// Package peers inspects the peer connectivity of a devnet: which nodes are
+// connected to which, and whether each node's persistent_peers point at the
+// right node IDs and addresses. Misconfigured peers are a frequent cause of
+// stalled devnets.
+package peers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// Node is a devnet node to inspect.
+type Node struct {
+	Index int
+	// Name is the node's display name, e.g. "validator-0".
+	Name string
+	// RPCURL is the CometBFT RPC base URL.
+	RPCURL string
+	// HomeDir is the node home, for reading config/config.toml. Empty skips
+	// the persistent_peers checks for this node.
+	HomeDir string
+	// Address is the IP other nodes reach this node on, if it has its own
+	// loopback address. Empty skips the host check of peer entries.
+	Address string
+}
+
+// Peer is a connection reported by a node's net_info.
+type Peer struct {
+	ID       string
+	RemoteIP string
+	Outbound bool
+	// Index is the devnet node index of the peer, or -1 for a node outside
+	// the devnet.
+	Index int
+}
+
+// NodeState is what a node reports about itself and its peers.
+type NodeState struct {
+	Node
+	// ID is the CometBFT node ID.
+	ID string
+	// ListenAddr is the P2P listen address, e.g. "tcp://0.0.0.0:26656".
+	ListenAddr string
+	// Error is set when the node's RPC could not be queried.
+	Error string
+	Peers []Peer
+	// PersistentPeers are the entries of persistent_peers in config.toml.
+	PersistentPeers []string
+}
+
+// Warning is a connectivity problem found on a node.
+type Warning struct {
+	NodeIndex int
+	Message   string
+}
+
+// Report is the connectivity of a devnet.
+type Report struct {
+	Nodes []NodeState
+	// Connected[i][j] reports whether node i lists node j as a peer.
+	Connected [][]bool
+	Warnings  []Warning
+}
+
+// Config configures an Inspector.
+type Config struct {
+	// HTTPClient is used for queries. Defaults to a client with a 5s timeout.
+	HTTPClient *http.Client
+}
+
+// Inspector queries nodes for their peers.
+type Inspector struct {
+	client *http.Client
+}
+
+// NewInspector creates a new Inspector.
+func NewInspector(cfg Config) *Inspector {
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 5 * time.Second}
+	}
+	return &Inspector{client: httpClient}
+}
+
+// Inspect queries every node's status and net_info concurrently, reads
+// their persistent_peers, and reports the connectivity matrix and problems.
+// Unreachable nodes are reported as warnings, not errors.
+func (in *Inspector) Inspect(ctx context.Context, nodes []Node) *Report {
+	report := &Report{Nodes: make([]NodeState, len(nodes))}
+
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		wg.Add(1)
+		go func(i int, node Node) {
+			defer wg.Done()
+			report.Nodes[i] = in.inspectNode(ctx, node)
+		}(i, node)
+	}
+	wg.Wait()
+
+	byID := make(map[string]int, len(nodes))
+	for i, n := range report.Nodes {
+		if n.ID != "" {
+			byID[n.ID] = i
+		}
+	}
+
+	report.Connected = make([][]bool, len(nodes))
+	for i := range report.Nodes {
+		report.Connected[i] = make([]bool, len(nodes))
+		for p := range report.Nodes[i].Peers {
+			peer := &report.Nodes[i].Peers[p]
+			peer.Index = -1
+			if j, ok := byID[peer.ID]; ok {
+				peer.Index = report.Nodes[j].Index
+				report.Connected[i][j] = true
+			}
+		}
+	}
+
+	report.Warnings = check(report, byID)
+	return report
+}
+
+// check finds connectivity problems in a report.
+func check(report *Report, byID map[string]int) []Warning {
+	var warnings []Warning
+	warn := func(n *NodeState, format string, args ...any) {
+		warnings = append(warnings, Warning{NodeIndex: n.Index, Message: fmt.Sprintf(format, args...)})
+	}
+	multiNode := len(report.Nodes) > 1
+
+	for i := range report.Nodes {
+		n := &report.Nodes[i]
+		if n.Error != "" {
+			warn(n, "RPC unreachable: %s", n.Error)
+			continue
+		}
+		if multiNode && len(n.Peers) == 0 {
+			warn(n, "has no peers")
+		}
+		if multiNode && n.HomeDir != "" && len(n.PersistentPeers) == 0 {
+			warn(n, "persistent_peers is empty")
+		}
+
+		seen := make(map[string]bool)
+		for _, entry := range n.PersistentPeers {
+			id, host, port, err := parsePeer(entry)
+			if err != nil {
+				warn(n, "persistent_peers entry %q: %v", entry, err)
+				continue
+			}
+			if seen[id] {
+				warn(n, "persistent_peers lists %s more than once", shortID(id))
+			}
+			seen[id] = true
+			if id == n.ID {
+				warn(n, "persistent_peers lists the node itself (%s)", entry)
+				continue
+			}
+			j, ok := byID[id]
+			if !ok {
+				warn(n, "persistent_peers entry %s has unknown node ID %s (stale after a node was re-initialized?)", entry, shortID(id))
+				continue
+			}
+			target := &report.Nodes[j]
+			if listenPort := portOf(target.ListenAddr); listenPort != "" && port != listenPort {
+				warn(n, "persistent_peers entry for %s dials port %s but it listens on %s", target.Name, port, listenPort)
+			}
+			if target.Address != "" && net.ParseIP(host) != nil && host != target.Address {
+				warn(n, "persistent_peers entry for %s dials %s but it is at %s", target.Name, host, target.Address)
+			}
+			if !report.Connected[i][j] && !report.Connected[j][i] {
+				warn(n, "not connected to persistent peer %s; dialing %s is failing", target.Name, net.JoinHostPort(host, port))
+			}
+		}
+	}
+	return warnings
+}
+
+func (in *Inspector) inspectNode(ctx context.Context, node Node) NodeState {
+	state := NodeState{Node: node}
+
+	var status struct {
+		Result struct {
+			NodeInfo struct {
+				ID         string `json:"id"`
+				ListenAddr string `json:"listen_addr"`
+			} `json:"node_info"`
+		} `json:"result"`
+	}
+	if err := in.getJSON(ctx, node.RPCURL, "/status", &status); err != nil {
+		state.Error = err.Error()
+		return state
+	}
+	state.ID = status.Result.NodeInfo.ID
+	state.ListenAddr = status.Result.NodeInfo.ListenAddr
+
+	var netInfo struct {
+		Result struct {
+			Peers []struct {
+				NodeInfo struct {
+					ID string `json:"id"`
+				} `json:"node_info"`
+				IsOutbound bool   `json:"is_outbound"`
+				RemoteIP   string `json:"remote_ip"`
+			} `json:"peers"`
+		} `json:"result"`
+	}
+	if err := in.getJSON(ctx, node.RPCURL, "/net_info", &netInfo); err != nil {
+		state.Error = err.Error()
+		return state
+	}
+	for _, p := range netInfo.Result.Peers {
+		state.Peers = append(state.Peers, Peer{ID: p.NodeInfo.ID, RemoteIP: p.RemoteIP, Outbound: p.IsOutbound})
+	}
+
+	if node.HomeDir != "" {
+		peers, err := readPersistentPeers(node.HomeDir)
+		if err != nil {
+			state.Error = err.Error()
+			return state
+		}
+		state.PersistentPeers = peers
+	}
+	return state
+}
+
+// readPersistentPeers returns the persistent_peers entries of a node home.
+func readPersistentPeers(homeDir string) ([]string, error) {
+	data, err := os.ReadFile(filepath.Join(homeDir, "config", "config.toml"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config.toml: %w", err)
+	}
+	var cfg struct {
+		P2P struct {
+			PersistentPeers string `toml:"persistent_peers"`
+		} `toml:"p2p"`
+	}
+	if err := toml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid config.toml: %w", err)
+	}
+	var peers []string
+	for _, entry := range strings.Split(cfg.P2P.PersistentPeers, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			peers = append(peers, entry)
+		}
+	}
+	return peers, nil
+}
+
+// parsePeer splits a persistent_peers entry "id@host:port".
+func parsePeer(entry string) (id, host, port string, err error) {
+	id, addr, ok := strings.Cut(entry, "@")
+	if !ok || id == "" {
+		return "", "", "", fmt.Errorf("want <node-id>@<host>:<port>")
+	}
+	host, port, err = net.SplitHostPort(addr)
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid address: %v", err)
+	}
+	if _, err := strconv.Atoi(port); err != nil {
+		return "", "", "", fmt.Errorf("invalid port %q", port)
+	}
+	return id, host, port, nil
+}
+
+// portOf returns the port of a listen address such as "tcp://0.0.0.0:26656".
+func portOf(listenAddr string) string {
+	if u, err := url.Parse(listenAddr); err == nil && u.Host != "" {
+		return u.Port()
+	}
+	if _, port, err := net.SplitHostPort(listenAddr); err == nil {
+		return port
+	}
+	return ""
+}
+
+// shortID abbreviates a node ID for messages.
+func shortID(id string) string {
+	if len(id) > 12 {
+		return id[:12] + "..."
+	}
+	return id
+}
+
+func (in *Inspector) getJSON(ctx context.Context, base, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(base, "/")+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := in.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: HTTP %d", path, resp.StatusCode)
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	return nil
+}
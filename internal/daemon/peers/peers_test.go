@@ -0,0 +1,116 @@
+// internal/daemon/peers/peers_test.go
+package peers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeNode serves /status and /net_info for a node with the given ID,
+// P2P port and connected peer IDs.
+func newFakeNode(t *testing.T, id string, p2pPort int, peerIDs ...string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/status":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"node_info":{"id":%q,"listen_addr":"tcp://0.0.0.0:%d"}}}`, id, p2pPort)
+		case "/net_info":
+			var peers []string
+			for _, p := range peerIDs {
+				peers = append(peers, fmt.Sprintf(`{"node_info":{"id":%q},"is_outbound":true,"remote_ip":"127.0.0.1"}`, p))
+			}
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"n_peers":"%d","peers":[%s]}}`, len(peers), strings.Join(peers, ","))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// writeHome writes a node home whose config.toml has persistentPeers.
+func writeHome(t *testing.T, persistentPeers string) string {
+	t.Helper()
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+	config := fmt.Sprintf("moniker = \"node\"\n\n[p2p]\nladdr = \"tcp://0.0.0.0:26656\"\npersistent_peers = %q\n", persistentPeers)
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "config.toml"), []byte(config), 0644))
+	return home
+}
+
+func messages(report *Report, index int) []string {
+	var out []string
+	for _, w := range report.Warnings {
+		if w.NodeIndex == index {
+			out = append(out, w.Message)
+		}
+	}
+	return out
+}
+
+func TestInspect_Healthy(t *testing.T) {
+	n0 := newFakeNode(t, "id0", 26656, "id1")
+	n1 := newFakeNode(t, "id1", 36656, "id0")
+
+	report := NewInspector(Config{}).Inspect(context.Background(), []Node{
+		{Index: 0, Name: "validator-0", RPCURL: n0.URL, HomeDir: writeHome(t, "id1@127.0.0.1:36656")},
+		{Index: 1, Name: "validator-1", RPCURL: n1.URL, HomeDir: writeHome(t, "id0@127.0.0.1:26656")},
+	})
+
+	assert.Equal(t, [][]bool{{false, true}, {true, false}}, report.Connected)
+	assert.Empty(t, report.Warnings)
+	assert.Equal(t, "id0", report.Nodes[0].ID)
+	assert.Equal(t, []string{"id1@127.0.0.1:36656"}, report.Nodes[0].PersistentPeers)
+	assert.Equal(t, 1, report.Nodes[0].Peers[0].Index)
+}
+
+func TestInspect_Misconfigured(t *testing.T) {
+	n0 := newFakeNode(t, "id0", 26656, "id1", "external")
+	n1 := newFakeNode(t, "id1", 36656, "id0")
+	n2 := newFakeNode(t, "id2", 46656)
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	report := NewInspector(Config{}).Inspect(context.Background(), []Node{
+		{Index: 0, Name: "validator-0", RPCURL: n0.URL, HomeDir: writeHome(t, "id1@127.0.0.1:36656,id2@127.0.0.1:46656")},
+		{Index: 1, Name: "validator-1", RPCURL: n1.URL, HomeDir: writeHome(t, "id0@127.0.0.1:26666,stale@127.0.0.1:46656,id1@127.0.0.1:36656")},
+		{Index: 2, Name: "validator-2", RPCURL: n2.URL, HomeDir: writeHome(t, ""), Address: "127.0.42.3"},
+		{Index: 3, Name: "validator-3", RPCURL: down.URL},
+	})
+
+	assert.Equal(t, []bool{false, true, false, false}, report.Connected[0])
+	assert.Equal(t, -1, report.Nodes[0].Peers[1].Index)
+
+	assert.Equal(t, []string{
+		"persistent_peers entry for validator-2 dials 127.0.0.1 but it is at 127.0.42.3",
+		"not connected to persistent peer validator-2; dialing 127.0.0.1:46656 is failing",
+	}, messages(report, 0))
+	assert.Equal(t, []string{
+		"persistent_peers entry for validator-0 dials port 26666 but it listens on 26656",
+		"persistent_peers entry stale@127.0.0.1:46656 has unknown node ID stale (stale after a node was re-initialized?)",
+		"persistent_peers lists the node itself (id1@127.0.0.1:36656)",
+	}, messages(report, 1))
+	assert.Equal(t, []string{"has no peers", "persistent_peers is empty"}, messages(report, 2))
+	require.Len(t, messages(report, 3), 1)
+	assert.Contains(t, messages(report, 3)[0], "RPC unreachable")
+}
+
+func TestParsePeer(t *testing.T) {
+	id, host, port, err := parsePeer("abc@127.0.0.1:26656")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"abc", "127.0.0.1", "26656"}, []string{id, host, port})
+
+	for _, bad := range []string{"127.0.0.1:26656", "abc@127.0.0.1", "abc@host:port"} {
+		_, _, _, err := parsePeer(bad)
+		assert.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,370 @@
+// Package plugininstall installs network plugin binaries from GitHub releases.
+//
+// A release is expected to attach one plugin binary per platform, either as
+// a bare executable or inside a .tar.gz/.zip archive, whose name contains
+// "-plugin" and the target GOOS and GOARCH (e.g.,
+// "osmosis-plugin_linux_amd64.tar.gz"). The release must also publish a
+// checksum manifest such as checksums.txt. When a public key is supplied the
+// manifest must be signed: the signature is read from "<manifest>.sig" and
+// verified as an Ed25519 signature over the manifest bytes.
+package plugininstall
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/credentials"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/github"
+)
+
+// pluginSuffix is the file name suffix the plugin loader discovers.
+const pluginSuffix = "-plugin"
+
+// ErrNoChecksum is returned when a release publishes no checksum manifest
+// and the request does not allow unverified installs.
+var ErrNoChecksum = errors.New("release publishes no checksums")
+
+// ErrSignatureInvalid is returned when the checksum manifest signature is
+// missing or does not verify against the supplied public key.
+var ErrSignatureInvalid = errors.New("invalid checksum signature")
+
+// Request describes a plugin to install.
+type Request struct {
+	Owner   string // GitHub repository owner
+	Repo    string // GitHub repository name
+	Version string // release tag; empty selects the latest release
+
+	// PublicKey is a PEM-encoded Ed25519 public key. When set, the checksum
+	// manifest must carry a valid signature.
+	PublicKey []byte
+
+	// AllowUnverified installs the plugin even if the release publishes no
+	// checksum manifest.
+	AllowUnverified bool
+}
+
+// Result describes an installed plugin.
+type Result struct {
+	Name              string // network name (binary name without "-plugin")
+	Version           string // release tag that was installed
+	Path              string // installed binary path
+	SHA256            string // hex-encoded SHA-256 of the downloaded asset
+	ChecksumVerified  bool   // the asset matched the published checksum
+	SignatureVerified bool   // the checksum manifest signature was verified
+}
+
+// releaseSource fetches releases from a GitHub repository.
+type releaseSource interface {
+	FetchReleaseByTag(ctx context.Context, tag string) (*github.GitHubRelease, error)
+	FetchLatestRelease(ctx context.Context) (*github.GitHubRelease, error)
+}
+
+// Installer downloads plugin binaries into a plugin directory.
+type Installer struct {
+	pluginDir  string
+	newClient  func(owner, repo string) releaseSource
+	httpClient *http.Client
+	goos       string
+	goarch     string
+	logger     *slog.Logger
+}
+
+// New creates an Installer that installs into pluginDir for the host platform.
+func New(pluginDir string, logger *slog.Logger) *Installer {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Installer{
+		pluginDir: pluginDir,
+		newClient: func(owner, repo string) releaseSource {
+			return github.NewClient(
+				github.WithToken(credentials.GitHubToken()),
+				github.WithOwnerRepo(owner, repo),
+			)
+		},
+		httpClient: &http.Client{Timeout: 10 * time.Minute},
+		goos:       goruntime.GOOS,
+		goarch:     goruntime.GOARCH,
+		logger:     logger,
+	}
+}
+
+// PluginDir returns the directory plugins are installed into.
+func (i *Installer) PluginDir() string {
+	return i.pluginDir
+}
+
+// ParseRef parses a plugin reference of the form "owner/repo[@version]".
+func ParseRef(ref string) (owner, repo, version string, err error) {
+	name, version, _ := strings.Cut(ref, "@")
+	owner, repo, ok := strings.Cut(name, "/")
+	if !ok || owner == "" || repo == "" || strings.Contains(repo, "/") {
+		return "", "", "", fmt.Errorf("invalid plugin reference %q: expected <owner>/<repo>[@version]", ref)
+	}
+	if strings.Contains(ref, "@") && version == "" {
+		return "", "", "", fmt.Errorf("invalid plugin reference %q: empty version", ref)
+	}
+	return owner, repo, version, nil
+}
+
+// Install downloads, verifies and installs the plugin described by req.
+// The binary is written next to its final path and renamed into place, so
+// a running plugin process keeps its old binary until it is reloaded.
+func (i *Installer) Install(ctx context.Context, req Request) (*Result, error) {
+	if req.Owner == "" || req.Repo == "" {
+		return nil, fmt.Errorf("owner and repo are required")
+	}
+
+	var pubKey ed25519.PublicKey
+	if len(req.PublicKey) > 0 {
+		key, err := parsePublicKey(req.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		pubKey = key
+	}
+
+	release, err := i.fetchRelease(ctx, i.newClient(req.Owner, req.Repo), req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]builder.ReleaseFile, 0, len(release.Assets))
+	for _, a := range release.Assets {
+		files = append(files, builder.ReleaseFile{Name: a.Name, URL: a.BrowserDownloadURL})
+	}
+	asset, checksum := builder.SelectReleaseAsset(files, "", pluginSuffix, i.goos, i.goarch)
+	if asset == nil {
+		return nil, fmt.Errorf("release %s of %s/%s has no plugin binary for %s/%s",
+			release.TagName, req.Owner, req.Repo, i.goos, i.goarch)
+	}
+
+	result := &Result{Version: release.TagName}
+
+	var expected string
+	switch {
+	case checksum != nil:
+		manifest, err := i.fetchBytes(ctx, checksum.URL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch checksums: %w", err)
+		}
+		if pubKey != nil {
+			if err := i.verifySignature(ctx, files, checksum, manifest, pubKey); err != nil {
+				return nil, err
+			}
+			result.SignatureVerified = true
+		}
+		sum, ok := builder.ParseChecksum(string(manifest), asset.Name)
+		if !ok {
+			return nil, fmt.Errorf("checksum for %s not found in %s", asset.Name, checksum.Name)
+		}
+		expected = sum
+	case pubKey != nil:
+		return nil, fmt.Errorf("%w: release %s publishes no checksum manifest to verify", ErrSignatureInvalid, release.TagName)
+	case !req.AllowUnverified:
+		return nil, fmt.Errorf("%w: release %s of %s/%s cannot be verified (pass --allow-unverified to install anyway)",
+			ErrNoChecksum, release.TagName, req.Owner, req.Repo)
+	}
+
+	if err := os.MkdirAll(i.pluginDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create plugin directory: %w", err)
+	}
+
+	download, err := os.CreateTemp(i.pluginDir, ".download-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create download file: %w", err)
+	}
+	defer os.Remove(download.Name())
+
+	h := sha256.New()
+	err = i.fetch(ctx, asset.URL, io.MultiWriter(download, h))
+	if closeErr := download.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.SHA256 = hex.EncodeToString(h.Sum(nil))
+
+	if expected != "" {
+		if expected != result.SHA256 {
+			return nil, fmt.Errorf("%w for %s: expected %s, got %s", builder.ErrChecksumMismatch, asset.Name, expected, result.SHA256)
+		}
+		result.ChecksumVerified = true
+	}
+
+	staged := download.Name() + ".bin"
+	defer os.Remove(staged)
+	entry, err := builder.ExtractExecutable(download.Name(), asset.Name, func(name string) bool {
+		return strings.HasSuffix(name, pluginSuffix)
+	}, staged)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract plugin from %s: %w", asset.Name, err)
+	}
+
+	binaryName, err := pluginBinaryName(entry)
+	if err != nil {
+		return nil, err
+	}
+	result.Name = strings.TrimSuffix(binaryName, pluginSuffix)
+	result.Path = filepath.Join(i.pluginDir, binaryName)
+
+	if err := os.Rename(staged, result.Path); err != nil {
+		return nil, fmt.Errorf("failed to install %s: %w", result.Path, err)
+	}
+
+	i.logger.Info("installed plugin",
+		"plugin", result.Name,
+		"repo", req.Owner+"/"+req.Repo,
+		"version", result.Version,
+		"sha256", result.SHA256,
+		"signature_verified", result.SignatureVerified,
+	)
+	return result, nil
+}
+
+// fetchRelease looks up the release for version, trying with and without
+// a "v" prefix. An empty version selects the latest release.
+func (i *Installer) fetchRelease(ctx context.Context, client releaseSource, version string) (*github.GitHubRelease, error) {
+	if version == "" {
+		return client.FetchLatestRelease(ctx)
+	}
+
+	tags := []string{version}
+	if strings.HasPrefix(version, "v") {
+		tags = append(tags, strings.TrimPrefix(version, "v"))
+	} else {
+		tags = append(tags, "v"+version)
+	}
+
+	var err error
+	for _, tag := range tags {
+		var release *github.GitHubRelease
+		release, err = client.FetchReleaseByTag(ctx, tag)
+		if err == nil {
+			return release, nil
+		}
+		var notFound *github.NotFoundError
+		if !errors.As(err, &notFound) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+// verifySignature checks the "<manifest>.sig" release file against pubKey.
+func (i *Installer) verifySignature(ctx context.Context, files []builder.ReleaseFile, checksum *builder.ReleaseFile, manifest []byte, pubKey ed25519.PublicKey) error {
+	var sigFile *builder.ReleaseFile
+	for idx := range files {
+		if files[idx].Name == checksum.Name+".sig" {
+			sigFile = &files[idx]
+			break
+		}
+	}
+	if sigFile == nil {
+		return fmt.Errorf("%w: release has no %s.sig", ErrSignatureInvalid, checksum.Name)
+	}
+
+	raw, err := i.fetchBytes(ctx, sigFile.URL)
+	if err != nil {
+		return fmt.Errorf("failed to fetch signature: %w", err)
+	}
+	sig, err := decodeSignature(raw)
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(pubKey, manifest, sig) {
+		return fmt.Errorf("%w: %s does not match the public key", ErrSignatureInvalid, sigFile.Name)
+	}
+	return nil
+}
+
+// fetch downloads url into w.
+func (i *Installer) fetch(ctx context.Context, url string, w io.Writer) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	credentials.AuthorizeGitHub(req)
+
+	resp, err := i.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download %s: HTTP %d", url, resp.StatusCode)
+	}
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	return nil
+}
+
+// fetchBytes downloads a small release file into memory.
+func (i *Installer) fetchBytes(ctx context.Context, url string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := i.fetch(ctx, url, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// pluginBinaryName derives the installed file name from an asset or archive
+// entry name by dropping anything after "-plugin", so
+// "osmosis-plugin_linux_amd64" installs as "osmosis-plugin".
+func pluginBinaryName(name string) (string, error) {
+	idx := strings.LastIndex(name, pluginSuffix)
+	if idx <= 0 {
+		return "", fmt.Errorf("%q is not a plugin binary: name must contain %q", name, pluginSuffix)
+	}
+	return name[:idx+len(pluginSuffix)], nil
+}
+
+// parsePublicKey parses a PEM-encoded Ed25519 public key.
+func parsePublicKey(data []byte) (ed25519.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("public key is not PEM encoded")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+	edKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key is %T, want an Ed25519 key", key)
+	}
+	return edKey, nil
+}
+
+// decodeSignature accepts a raw or base64-encoded Ed25519 signature.
+func decodeSignature(raw []byte) ([]byte, error) {
+	if len(raw) == ed25519.SignatureSize {
+		return raw, nil
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(raw)))
+	if err != nil || len(sig) != ed25519.SignatureSize {
+		return nil, fmt.Errorf("%w: signature is not a raw or base64 Ed25519 signature", ErrSignatureInvalid)
+	}
+	return sig, nil
+}
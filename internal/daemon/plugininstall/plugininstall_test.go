@@ -0,0 +1,278 @@
+package plugininstall
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/github"
+)
+
+// fakeSource serves a fixed set of releases by tag.
+type fakeSource struct {
+	releases map[string]*github.GitHubRelease
+	latest   string
+}
+
+func (f *fakeSource) FetchReleaseByTag(_ context.Context, tag string) (*github.GitHubRelease, error) {
+	if r, ok := f.releases[tag]; ok {
+		return r, nil
+	}
+	return nil, &github.NotFoundError{Message: "release " + tag + " not found"}
+}
+
+func (f *fakeSource) FetchLatestRelease(ctx context.Context) (*github.GitHubRelease, error) {
+	return f.FetchReleaseByTag(ctx, f.latest)
+}
+
+// testRelease serves release files from an HTTP server.
+type testRelease struct {
+	files map[string][]byte
+	srv   *httptest.Server
+}
+
+func newTestRelease(t *testing.T, files map[string][]byte) *testRelease {
+	t.Helper()
+	r := &testRelease{files: files}
+	r.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		data, ok := r.files[filepath.Base(req.URL.Path)]
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(r.srv.Close)
+	return r
+}
+
+func (r *testRelease) installer(t *testing.T, tag string) *Installer {
+	t.Helper()
+	release := &github.GitHubRelease{TagName: tag}
+	for name := range r.files {
+		release.Assets = append(release.Assets, github.ReleaseAsset{
+			Name:               name,
+			BrowserDownloadURL: r.srv.URL + "/" + name,
+		})
+	}
+	i := New(t.TempDir(), nil)
+	i.goos, i.goarch = "linux", "amd64"
+	i.newClient = func(owner, repo string) releaseSource {
+		return &fakeSource{releases: map[string]*github.GitHubRelease{tag: release}, latest: tag}
+	}
+	return i
+}
+
+func checksumLine(data []byte, name string) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]) + "  " + name + "\n"
+}
+
+func tarGz(t *testing.T, name string, content []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	if err := tw.WriteHeader(&tar.Header{Name: "dist/" + name, Mode: 0755, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestParseRef(t *testing.T) {
+	tests := []struct {
+		ref                  string
+		owner, repo, version string
+		wantErr              bool
+	}{
+		{ref: "acme/osmosis-plugin", owner: "acme", repo: "osmosis-plugin"},
+		{ref: "acme/osmosis-plugin@v1.2.0", owner: "acme", repo: "osmosis-plugin", version: "v1.2.0"},
+		{ref: "osmosis", wantErr: true},
+		{ref: "acme/", wantErr: true},
+		{ref: "acme/a/b", wantErr: true},
+		{ref: "acme/osmosis@", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			owner, repo, version, err := ParseRef(tt.ref)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRef() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if owner != tt.owner || repo != tt.repo || version != tt.version {
+				t.Errorf("ParseRef() = %q, %q, %q; want %q, %q, %q", owner, repo, version, tt.owner, tt.repo, tt.version)
+			}
+		})
+	}
+}
+
+func TestInstallFromArchive(t *testing.T) {
+	binary := []byte("#!/bin/sh\necho osmosis\n")
+	archive := tarGz(t, "osmosis-plugin", binary)
+	const assetName = "osmosis-plugin_1.2.0_linux_amd64.tar.gz"
+	r := newTestRelease(t, map[string][]byte{
+		assetName: archive,
+		"osmosis-plugin_1.2.0_darwin_arm64.tar.gz": []byte("other platform"),
+		"checksums.txt": []byte(checksumLine(archive, assetName)),
+	})
+	i := r.installer(t, "v1.2.0")
+
+	// A version without the "v" prefix resolves to the tagged release
+	res, err := i.Install(context.Background(), Request{Owner: "acme", Repo: "osmosis", Version: "1.2.0"})
+	if err != nil {
+		t.Fatalf("Install() error = %v", err)
+	}
+	if res.Name != "osmosis" || res.Version != "v1.2.0" || !res.ChecksumVerified || res.SignatureVerified {
+		t.Errorf("Install() = %+v", res)
+	}
+	if want := filepath.Join(i.PluginDir(), "osmosis-plugin"); res.Path != want {
+		t.Errorf("Path = %s, want %s", res.Path, want)
+	}
+
+	got, err := os.ReadFile(res.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, binary) {
+		t.Errorf("installed binary = %q, want %q", got, binary)
+	}
+	info, _ := os.Stat(res.Path)
+	if info.Mode()&0111 == 0 {
+		t.Errorf("installed binary is not executable: %v", info.Mode())
+	}
+
+	entries, _ := os.ReadDir(i.PluginDir())
+	if len(entries) != 1 {
+		t.Errorf("plugin dir has %d entries, want only the plugin", len(entries))
+	}
+}
+
+func TestInstallRejectsChecksumMismatch(t *testing.T) {
+	const assetName = "osmosis-plugin_linux_amd64"
+	r := newTestRelease(t, map[string][]byte{
+		assetName:       []byte("tampered"),
+		"checksums.txt": []byte(checksumLine([]byte("original"), assetName)),
+	})
+	i := r.installer(t, "v1.0.0")
+
+	_, err := i.Install(context.Background(), Request{Owner: "acme", Repo: "osmosis"})
+	if !errors.Is(err, builder.ErrChecksumMismatch) {
+		t.Fatalf("Install() error = %v, want ErrChecksumMismatch", err)
+	}
+	if _, err := os.Stat(filepath.Join(i.PluginDir(), "osmosis-plugin")); !os.IsNotExist(err) {
+		t.Errorf("plugin was installed despite checksum mismatch")
+	}
+}
+
+func TestInstallRequiresChecksum(t *testing.T) {
+	const assetName = "osmosis-plugin_linux_amd64"
+	r := newTestRelease(t, map[string][]byte{assetName: []byte("binary")})
+	i := r.installer(t, "v1.0.0")
+
+	_, err := i.Install(context.Background(), Request{Owner: "acme", Repo: "osmosis"})
+	if !errors.Is(err, ErrNoChecksum) {
+		t.Fatalf("Install() error = %v, want ErrNoChecksum", err)
+	}
+
+	res, err := i.Install(context.Background(), Request{Owner: "acme", Repo: "osmosis", AllowUnverified: true})
+	if err != nil {
+		t.Fatalf("Install(AllowUnverified) error = %v", err)
+	}
+	if res.ChecksumVerified || res.Name != "osmosis" {
+		t.Errorf("Install(AllowUnverified) = %+v", res)
+	}
+}
+
+func TestInstallVerifiesSignature(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	const assetName = "osmosis-plugin_linux_amd64"
+	binary := []byte("binary")
+	manifest := []byte(checksumLine(binary, assetName))
+	r := newTestRelease(t, map[string][]byte{
+		assetName:           binary,
+		"checksums.txt":     manifest,
+		"checksums.txt.sig": ed25519.Sign(priv, manifest),
+	})
+	i := r.installer(t, "v1.0.0")
+
+	res, err := i.Install(context.Background(), Request{Owner: "acme", Repo: "osmosis", PublicKey: pubPEM})
+	if err != nil {
+		t.Fatalf("Install() error = %v", err)
+	}
+	if !res.SignatureVerified || !res.ChecksumVerified {
+		t.Errorf("Install() = %+v, want checksum and signature verified", res)
+	}
+
+	// A manifest signed by another key is rejected
+	_, otherPriv, _ := ed25519.GenerateKey(rand.Reader)
+	r.files["checksums.txt.sig"] = ed25519.Sign(otherPriv, manifest)
+	_, err = i.Install(context.Background(), Request{Owner: "acme", Repo: "osmosis", PublicKey: pubPEM})
+	if !errors.Is(err, ErrSignatureInvalid) {
+		t.Fatalf("Install() error = %v, want ErrSignatureInvalid", err)
+	}
+
+	delete(r.files, "checksums.txt.sig")
+	i = r.installer(t, "v1.0.0")
+	_, err = i.Install(context.Background(), Request{Owner: "acme", Repo: "osmosis", PublicKey: pubPEM})
+	if !errors.Is(err, ErrSignatureInvalid) {
+		t.Fatalf("Install() without signature error = %v, want ErrSignatureInvalid", err)
+	}
+}
+
+func TestInstallNoAssetForPlatform(t *testing.T) {
+	r := newTestRelease(t, map[string][]byte{"osmosis-plugin_darwin_arm64": []byte("binary")})
+	i := r.installer(t, "v1.0.0")
+
+	if _, err := i.Install(context.Background(), Request{Owner: "acme", Repo: "osmosis"}); err == nil {
+		t.Fatal("Install() succeeded without a linux/amd64 asset")
+	}
+}
+
+func TestPluginBinaryName(t *testing.T) {
+	tests := map[string]string{
+		"osmosis-plugin":                 "osmosis-plugin",
+		"osmosis-plugin_linux_amd64":     "osmosis-plugin",
+		"gaia-hub-plugin-v1-linux-amd64": "gaia-hub-plugin",
+	}
+	for in, want := range tests {
+		got, err := pluginBinaryName(in)
+		if err != nil || got != want {
+			t.Errorf("pluginBinaryName(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := pluginBinaryName("osmosisd"); err == nil {
+		t.Error("pluginBinaryName(osmosisd) succeeded")
+	}
+}
@@ -0,0 +1,356 @@
+// Package portalloc allocates host port blocks to devnet nodes whose ports
+// are published on the host, detecting ports already bound by other devnets
+// or unrelated processes.
+package portalloc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// BlockSize is the default spacing between port blocks: block n publishes
+// each base port at base + n*BlockSize.
+const BlockSize = 100
+
+// maxPort is the highest TCP port.
+const maxPort = 65535
+
+// PortConflictError is returned when a port a node needs is already bound.
+type PortConflictError struct {
+	// Port is the host port in use.
+	Port int
+
+	// Owner describes what holds the port: another devnet's node, a
+	// process, or "" if it could not be determined.
+	Owner string
+}
+
+// Error implements the error interface.
+func (e *PortConflictError) Error() string {
+	if e.Owner == "" {
+		return fmt.Sprintf("port %d is already in use", e.Port)
+	}
+	return fmt.Sprintf("port %d is already in use by %s", e.Port, e.Owner)
+}
+
+// Is implements errors.Is interface for comparing error types.
+func (e *PortConflictError) Is(target error) bool {
+	_, ok := target.(*PortConflictError)
+	return ok
+}
+
+// Allocator records which node owns each port block, persisted as JSON so
+// allocations survive daemon restarts.
+type Allocator struct {
+	path        string
+	backend     Backend
+	allocations map[int]string // block -> "namespace/devnetName/index"
+	mu          sync.RWMutex
+
+	// probe reports whether a port is free; replaced in tests.
+	probe func(port int) error
+}
+
+// Table is the name the allocations are saved under in a Backend.
+const Table = "ports"
+
+// Backend persists the allocation table transactionally. The daemon state
+// store implements it.
+type Backend interface {
+	LoadAllocations(ctx context.Context, table string) (map[string]string, error)
+	SaveAllocations(ctx context.Context, table string, allocations map[string]string) error
+}
+
+// persistedState represents the JSON structure for persistence.
+type persistedState struct {
+	Allocations map[string]string `json:"allocations"` // block (as string) -> "namespace/devnetName/index"
+}
+
+// LoadOrCreate loads an existing allocator from the given path, or creates
+// a new one if the file doesn't exist.
+func LoadOrCreate(path string) (*Allocator, error) {
+	a := &Allocator{
+		path:        path,
+		allocations: make(map[int]string),
+		probe:       probePort,
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create directory: %w", err)
+		}
+		if err := a.save(); err != nil {
+			return nil, fmt.Errorf("failed to initialize port allocator: %w", err)
+		}
+		return a, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read port allocator file: %w", err)
+	}
+
+	var state persistedState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse port allocator file: %w", err)
+	}
+	if err := a.load(state.Allocations); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Open loads the allocator from backend. The first time, when backend has no
+// table yet, allocations are imported from the JSON file at legacyPath if it
+// exists, and the file is renamed to legacyPath+".migrated".
+func Open(backend Backend, legacyPath string) (*Allocator, error) {
+	ctx := context.Background()
+	table, err := backend.LoadAllocations(ctx, Table)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil && legacyPath != "" {
+		if _, statErr := os.Stat(legacyPath); statErr == nil {
+			legacy, err := LoadOrCreate(legacyPath)
+			if err != nil {
+				return nil, err
+			}
+			table = legacy.table()
+		}
+	}
+
+	a := &Allocator{
+		backend:     backend,
+		allocations: make(map[int]string),
+		probe:       probePort,
+	}
+	if err := a.load(table); err != nil {
+		return nil, err
+	}
+	if err := a.save(); err != nil {
+		return nil, fmt.Errorf("failed to initialize port allocator: %w", err)
+	}
+	if table != nil && legacyPath != "" {
+		if err := os.Rename(legacyPath, legacyPath+".migrated"); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to retire migrated port allocator file: %w", err)
+		}
+	}
+	return a, nil
+}
+
+// load replaces the allocations with a persisted table.
+func (a *Allocator) load(table map[string]string) error {
+	for blockStr, key := range table {
+		block, err := strconv.Atoi(blockStr)
+		if err != nil {
+			return fmt.Errorf("invalid block key %q: %w", blockStr, err)
+		}
+		a.allocations[block] = key
+	}
+	return nil
+}
+
+// table returns the allocations in their persisted form.
+func (a *Allocator) table() map[string]string {
+	table := make(map[string]string, len(a.allocations))
+	for block, key := range a.allocations {
+		table[strconv.Itoa(block)] = key
+	}
+	return table
+}
+
+// nodeKey returns the canonical key for a node.
+func nodeKey(namespace, devnetName string, index int) string {
+	return fmt.Sprintf("%s/%s/%d", namespace, devnetName, index)
+}
+
+// Lookup returns the block allocated to a node, if any.
+func (a *Allocator) Lookup(namespace, devnetName string, index int) (int, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.lookup(nodeKey(namespace, devnetName, index))
+}
+
+func (a *Allocator) lookup(key string) (int, bool) {
+	for block, existing := range a.allocations {
+		if existing == key {
+			return block, true
+		}
+	}
+	return 0, false
+}
+
+// Allocate returns a port block for a node whose base ports are published
+// at base + block*stride, or base + block*BlockSize if stride is zero. The
+// node keeps its existing block, or gets the
+// block matching its index, if all of the block's ports are free. Otherwise,
+// with reallocate, it gets the first block that is neither allocated nor
+// bound; without it, Allocate returns a *PortConflictError naming the owner
+// of the first port in use.
+func (a *Allocator) Allocate(namespace, devnetName string, index int, basePorts []int, stride int, reallocate bool) (int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if stride <= 0 {
+		stride = BlockSize
+	}
+	key := nodeKey(namespace, devnetName, index)
+	preferred, ok := a.lookup(key)
+	if !ok {
+		preferred = index
+	}
+
+	maxBlock := a.maxBlock(basePorts, stride)
+	if preferred > maxBlock {
+		preferred = 0
+	}
+	conflict := a.check(key, preferred, basePorts, stride)
+	if conflict == nil {
+		return preferred, a.assign(key, preferred)
+	}
+	if conflict.Owner == "" {
+		conflict.Owner = ListenerOwner(conflict.Port)
+	}
+	if !reallocate {
+		return 0, conflict
+	}
+
+	for i := 1; i <= maxBlock; i++ {
+		block := (preferred + i) % (maxBlock + 1)
+		if a.check(key, block, basePorts, stride) == nil {
+			return block, a.assign(key, block)
+		}
+	}
+	return 0, fmt.Errorf("no free port block for %s: %w", key, conflict)
+}
+
+// maxBlock returns the highest block that keeps every base port in range.
+func (a *Allocator) maxBlock(basePorts []int, stride int) int {
+	highest := 0
+	for _, port := range basePorts {
+		highest = max(highest, port)
+	}
+	return (maxPort - highest) / stride
+}
+
+// check returns a *PortConflictError if block is allocated to another node
+// or any of its ports is bound. The owner of a bound port is left for the
+// caller to look up, since that is slow.
+func (a *Allocator) check(key string, block int, basePorts []int, stride int) *PortConflictError {
+	owner, taken := a.allocations[block]
+	for _, base := range basePorts {
+		port := base + block*stride
+		if taken && owner != key {
+			return &PortConflictError{Port: port, Owner: "devnet node " + owner}
+		}
+		if err := a.probe(port); err != nil {
+			return &PortConflictError{Port: port}
+		}
+	}
+	return nil
+}
+
+// assign records block as key's allocation, replacing any previous one.
+func (a *Allocator) assign(key string, block int) error {
+	previous, hadPrevious := a.lookup(key)
+	if hadPrevious && previous == block {
+		return nil
+	}
+	if hadPrevious {
+		delete(a.allocations, previous)
+	}
+	a.allocations[block] = key
+	if err := a.save(); err != nil {
+		delete(a.allocations, block)
+		if hadPrevious {
+			a.allocations[previous] = key
+		}
+		return fmt.Errorf("failed to persist allocation: %w", err)
+	}
+	return nil
+}
+
+// Release releases the port blocks of every node of the given devnet.
+func (a *Allocator) Release(namespace, devnetName string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	prefix := namespace + "/" + devnetName + "/"
+	released := make(map[int]string)
+	for block, key := range a.allocations {
+		if strings.HasPrefix(key, prefix) {
+			released[block] = key
+			delete(a.allocations, block)
+		}
+	}
+	if len(released) == 0 {
+		// Not found is not an error - idempotent release
+		return nil
+	}
+
+	if err := a.save(); err != nil {
+		// Restore on failure
+		for block, key := range released {
+			a.allocations[block] = key
+		}
+		return fmt.Errorf("failed to persist release: %w", err)
+	}
+	return nil
+}
+
+// ListAllocations returns a copy of all current allocations.
+func (a *Allocator) ListAllocations() map[int]string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	result := make(map[int]string, len(a.allocations))
+	for block, key := range a.allocations {
+		result[block] = key
+	}
+	return result
+}
+
+// save persists the current allocations to the backend, or to the JSON
+// file for a file-based allocator.
+func (a *Allocator) save() error {
+	if a.backend != nil {
+		return a.backend.SaveAllocations(context.Background(), Table, a.table())
+	}
+
+	state := persistedState{
+		Allocations: a.table(),
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	// Write atomically using temp file
+	tmpPath := a.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write temp file: %w", err)
+	}
+	if err := os.Rename(tmpPath, a.path); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to rename temp file: %w", err)
+	}
+	return nil
+}
+
+// probePort returns an error if port can't be bound on all interfaces, the
+// way Docker publishes container ports.
+func probePort(port int) error {
+	ln, err := net.Listen("tcp", net.JoinHostPort("", strconv.Itoa(port)))
+	if err != nil {
+		return err
+	}
+	return ln.Close()
+}
@@ -0,0 +1,153 @@
+package portalloc
+
+import (
+	"errors"
+	"net"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var basePorts = []int{26656, 26657, 1317, 9090}
+
+// newTestAllocator returns an allocator whose probe reports the given ports
+// as bound.
+func newTestAllocator(t *testing.T, bound ...int) *Allocator {
+	t.Helper()
+	a, err := LoadOrCreate(filepath.Join(t.TempDir(), "ports.json"))
+	require.NoError(t, err)
+	a.probe = func(port int) error {
+		for _, b := range bound {
+			if port == b {
+				return syscall.EADDRINUSE
+			}
+		}
+		return nil
+	}
+	return a
+}
+
+func TestAllocate_PrefersNodeIndex(t *testing.T) {
+	a := newTestAllocator(t)
+
+	block, err := a.Allocate("default", "alpha", 2, basePorts, 0, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, block)
+
+	// Allocation is idempotent
+	block, err = a.Allocate("default", "alpha", 2, basePorts, 0, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, block)
+}
+
+func TestAllocate_SkipsOtherDevnets(t *testing.T) {
+	a := newTestAllocator(t)
+
+	_, err := a.Allocate("default", "alpha", 0, basePorts, 0, true)
+	require.NoError(t, err)
+
+	block, err := a.Allocate("default", "beta", 0, basePorts, 0, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, block)
+
+	_, err = a.Allocate("default", "gamma", 0, basePorts, 0, false)
+	var conflict *PortConflictError
+	require.True(t, errors.As(err, &conflict))
+	assert.Equal(t, 26656, conflict.Port)
+	assert.Equal(t, "devnet node default/alpha/0", conflict.Owner)
+}
+
+func TestAllocate_SkipsBoundPorts(t *testing.T) {
+	// Something outside dvb holds the RPC port of block 0
+	a := newTestAllocator(t, 26657)
+
+	block, err := a.Allocate("default", "alpha", 0, basePorts, 0, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, block)
+
+	_, err = a.Allocate("default", "beta", 0, basePorts, 0, false)
+	require.ErrorIs(t, err, &PortConflictError{})
+	assert.Contains(t, err.Error(), "port 26657 is already in use")
+}
+
+func TestAllocate_Stride(t *testing.T) {
+	// With a stride of 10, block 1 publishes the RPC port at 26667
+	a := newTestAllocator(t, 26667)
+
+	block, err := a.Allocate("default", "alpha", 1, basePorts, 10, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, block)
+}
+
+func TestAllocate_PersistsAndReleases(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ports.json")
+	a, err := LoadOrCreate(path)
+	require.NoError(t, err)
+	a.probe = func(int) error { return nil }
+
+	_, err = a.Allocate("default", "alpha", 0, basePorts, 0, true)
+	require.NoError(t, err)
+	_, err = a.Allocate("default", "alpha", 1, basePorts, 0, true)
+	require.NoError(t, err)
+	_, err = a.Allocate("default", "beta", 0, basePorts, 0, true)
+	require.NoError(t, err)
+
+	reloaded, err := LoadOrCreate(path)
+	require.NoError(t, err)
+	block, ok := reloaded.Lookup("default", "beta", 0)
+	assert.True(t, ok)
+	assert.Equal(t, 2, block)
+
+	require.NoError(t, reloaded.Release("default", "alpha"))
+	assert.Equal(t, map[int]string{2: "default/beta/0"}, reloaded.ListAllocations())
+	require.NoError(t, reloaded.Release("default", "alpha"))
+}
+
+func TestAllocate_NoFreeBlock(t *testing.T) {
+	a := newTestAllocator(t)
+	a.probe = func(int) error { return syscall.EADDRINUSE }
+
+	_, err := a.Allocate("default", "alpha", 0, basePorts, 0, true)
+	require.Error(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), "no free port block for default/alpha/0"))
+}
+
+func TestListenerOwner(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	owner := ListenerOwner(ln.Addr().(*net.TCPAddr).Port)
+	if owner == "" {
+		t.Skip("listener owner lookup is unavailable here")
+	}
+	assert.True(t, strings.HasPrefix(owner, "pid "), owner)
+}
+
+func TestOpen_MigratesLegacyFile(t *testing.T) {
+	legacyPath := filepath.Join(t.TempDir(), "ports.json")
+	legacy, err := LoadOrCreate(legacyPath)
+	require.NoError(t, err)
+	legacy.probe = func(int) error { return nil }
+	_, err = legacy.Allocate("default", "alpha", 3, basePorts, 0, false)
+	require.NoError(t, err)
+
+	backend := store.NewMemoryStore()
+	a, err := Open(backend, legacyPath)
+	require.NoError(t, err)
+	block, ok := a.Lookup("default", "alpha", 3)
+	require.True(t, ok)
+	assert.Equal(t, 3, block)
+	assert.NoFileExists(t, legacyPath)
+	assert.FileExists(t, legacyPath+".migrated")
+
+	require.NoError(t, a.Release("default", "alpha"))
+	reopened, err := Open(backend, legacyPath)
+	require.NoError(t, err)
+	assert.Empty(t, reopened.ListAllocations())
+}
@@ -0,0 +1,63 @@
+package portalloc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"time"
+)
+
+// ownerTimeout bounds the lsof lookup.
+const ownerTimeout = 5 * time.Second
+
+// ListenerOwner describes the process listening on a TCP port, e.g.
+// "pid 4242 (nginx)", or returns "" if it can't be determined. Processes of
+// other users are usually only visible to root.
+func ListenerOwner(port int) string {
+	if pid, name, ok := procListener(port); ok {
+		return formatOwner(pid, name)
+	}
+	return lsofListener(port)
+}
+
+func formatOwner(pid int, name string) string {
+	if name == "" {
+		return fmt.Sprintf("pid %d", pid)
+	}
+	return fmt.Sprintf("pid %d (%s)", pid, name)
+}
+
+// lsofListener asks lsof for the listener on port. lsof's -F output has
+// one field per line, prefixed with its name: p for the pid, c for the
+// command.
+func lsofListener(port int) string {
+	ctx, cancel := context.WithTimeout(context.Background(), ownerTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, "lsof", "-nP", "-iTCP:"+strconv.Itoa(port), "-sTCP:LISTEN", "-Fpc").Output()
+	if err != nil {
+		return ""
+	}
+
+	var pid int
+	var name string
+	for _, line := range bytes.Split(out, []byte("\n")) {
+		if len(line) < 2 {
+			continue
+		}
+		switch line[0] {
+		case 'p':
+			if pid != 0 {
+				return formatOwner(pid, name)
+			}
+			pid, _ = strconv.Atoi(string(line[1:]))
+		case 'c':
+			name = string(line[1:])
+		}
+	}
+	if pid == 0 {
+		return ""
+	}
+	return formatOwner(pid, name)
+}
@@ -0,0 +1,81 @@
+package portalloc
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// tcpListen is the socket state of a listening socket in /proc/net/tcp.
+const tcpListen = "0A"
+
+// procListener finds the process listening on port through /proc: the
+// socket inode from /proc/net/tcp{,6}, then the process with a descriptor
+// for that socket.
+func procListener(port int) (int, string, bool) {
+	var inodes []string
+	for _, table := range []string{"/proc/net/tcp", "/proc/net/tcp6"} {
+		inodes = append(inodes, listenInodes(table, port)...)
+	}
+	if len(inodes) == 0 {
+		return 0, "", false
+	}
+
+	procs, err := filepath.Glob("/proc/[0-9]*")
+	if err != nil {
+		return 0, "", false
+	}
+	for _, proc := range procs {
+		pid, err := strconv.Atoi(filepath.Base(proc))
+		if err != nil {
+			continue
+		}
+		fds, err := os.ReadDir(filepath.Join(proc, "fd"))
+		if err != nil {
+			continue
+		}
+		for _, fd := range fds {
+			link, err := os.Readlink(filepath.Join(proc, "fd", fd.Name()))
+			if err != nil {
+				continue
+			}
+			for _, inode := range inodes {
+				if link == "socket:["+inode+"]" {
+					comm, _ := os.ReadFile(filepath.Join(proc, "comm"))
+					return pid, strings.TrimSpace(string(comm)), true
+				}
+			}
+		}
+	}
+	return 0, "", false
+}
+
+// listenInodes returns the inodes of the listening sockets on port in a
+// /proc/net/tcp table. Each row is
+// "sl local_address rem_address st ... inode", with addresses as hex
+// "IP:PORT".
+func listenInodes(table string, port int) []string {
+	f, err := os.Open(table)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	want := fmt.Sprintf(":%04X", port)
+	var inodes []string
+	scanner := bufio.NewScanner(f)
+	scanner.Scan() // header
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 {
+			continue
+		}
+		if strings.HasSuffix(fields[1], want) && fields[3] == tcpListen {
+			inodes = append(inodes, fields[9])
+		}
+	}
+	return inodes
+}
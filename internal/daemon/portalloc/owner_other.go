@@ -0,0 +1,9 @@
+//go:build !linux
+
+package portalloc
+
+// procListener is only implemented on Linux; elsewhere ListenerOwner falls
+// back to lsof.
+func procListener(port int) (int, string, bool) {
+	return 0, "", false
+}
@@ -10,10 +10,15 @@ import (
 	"time"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/controller"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/hooks"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/portalloc"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/subnet"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
 	plugintypes "github.com/altuslabsxyz/devnet-builder/internal/plugin/types"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
 )
 
 // Orchestrator defines the interface for provisioning orchestration.
@@ -75,6 +80,8 @@ type DevnetProvisioner struct {
 	subnetAllocator             *subnet.Allocator
 	onProgress                  ProgressCallback
 	stepProgressReporterFactory StepProgressReporterFactory
+	offline                     bool
+	imagePinner                 runtime.ImagePinner
 }
 
 // Config configures the DevnetProvisioner.
@@ -102,6 +109,10 @@ type Config struct {
 	// StepProgressReporterFactory creates progress reporters for streaming
 	// detailed sub-step progress to CLI clients. Optional.
 	StepProgressReporterFactory StepProgressReporterFactory
+
+	// Offline forces every devnet to provision from local caches only,
+	// regardless of its spec.
+	Offline bool
 }
 
 // NewDevnetProvisioner creates a new DevnetProvisioner.
@@ -119,6 +130,7 @@ func NewDevnetProvisioner(s store.Store, cfg Config) *DevnetProvisioner {
 		subnetAllocator:             cfg.SubnetAllocator,
 		onProgress:                  cfg.OnProgress,
 		stepProgressReporterFactory: cfg.StepProgressReporterFactory,
+		offline:                     cfg.Offline,
 	}
 }
 
@@ -138,9 +150,14 @@ func (p *DevnetProvisioner) SetStepProgressReporterFactory(factory StepProgressR
 	p.stepProgressReporterFactory = factory
 }
 
-// EraseDevnetDir removes the entire devnet data directory to ensure a clean state.
-// Called at the start of Provision to guarantee fresh provisioning, and also
-// during delete to clean up filesystem artifacts.
+// SetImagePinner sets the runtime that pulls docker-mode devnets' images
+// and resolves them to the digest their nodes start from.
+func (p *DevnetProvisioner) SetImagePinner(pinner runtime.ImagePinner) {
+	p.imagePinner = pinner
+}
+
+// EraseDevnetDir removes the entire devnet data directory.
+// Called during delete to clean up filesystem artifacts.
 // Handles: directory doesn't exist (no error), permission errors (returns error).
 func (p *DevnetProvisioner) EraseDevnetDir(devnetName string) error {
 	devnetDataDir := filepath.Join(p.dataDir, devnetName)
@@ -166,6 +183,57 @@ func (p *DevnetProvisioner) EraseDevnetDir(devnetName string) error {
 	return nil
 }
 
+// resetDevnetDir removes the contents of the devnet data directory except
+// the provisioning state and the genesis its fork marker checks, so that
+// provisioning resumes after the phases an earlier attempt completed.
+func (p *DevnetProvisioner) resetDevnetDir(devnetName string) error {
+	devnetDataDir := filepath.Join(p.dataDir, devnetName)
+
+	entries, err := os.ReadDir(devnetDataDir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read devnet directory %s: %w", devnetDataDir, err)
+	}
+
+	for _, entry := range entries {
+		switch entry.Name() {
+		case provisionStateFile, "genesis.json":
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(devnetDataDir, entry.Name())); err != nil {
+			return fmt.Errorf("failed to erase %s: %w", entry.Name(), err)
+		}
+	}
+
+	p.logger.Info("reset devnet directory",
+		"name", devnetName,
+		"path", devnetDataDir)
+
+	return nil
+}
+
+// ProvisionCheckpoint returns how far the devnet's provisioning got, as
+// recorded in its data directory. It implements controller.ProvisionRecovery.
+func (p *DevnetProvisioner) ProvisionCheckpoint(devnet *types.Devnet) controller.ProvisionCheckpoint {
+	state := loadProvisionState(filepath.Join(p.dataDir, devnet.Metadata.Name))
+	return controller.ProvisionCheckpoint{
+		Phase:         string(state.Phase),
+		Interruptions: state.Interruptions,
+	}
+}
+
+// CleanupProvisioning removes the Node resources and the data directory of
+// an incomplete provisioning, including its checkpoint and markers. It
+// implements controller.ProvisionRecovery.
+func (p *DevnetProvisioner) CleanupProvisioning(ctx context.Context, devnet *types.Devnet) error {
+	if err := p.Deprovision(ctx, devnet); err != nil {
+		return err
+	}
+	return p.EraseDevnetDir(devnet.Metadata.Name)
+}
+
 // Provision creates Node resources for all validators and fullnodes in the devnet.
 // When an OrchestratorFactory is configured, it first executes the full provisioning
 // flow (build, fork, init) before creating Node resources.
@@ -177,8 +245,8 @@ func (p *DevnetProvisioner) Provision(ctx context.Context, devnet *types.Devnet)
 		"hasOrchestratorFactory", p.orchestratorFactory != nil,
 		"hasSubnetAllocator", p.subnetAllocator != nil)
 
-	// Erase existing devnet directory to ensure clean state
-	if err := p.EraseDevnetDir(devnet.Metadata.Name); err != nil {
+	// Erase what an earlier attempt left, except what lets this one resume
+	if err := p.resetDevnetDir(devnet.Metadata.Name); err != nil {
 		return fmt.Errorf("failed to erase devnet directory: %w", err)
 	}
 
@@ -201,6 +269,32 @@ func (p *DevnetProvisioner) Provision(ctx context.Context, devnet *types.Devnet)
 			"devnet", devnet.Metadata.Name,
 			"subnet", allocated,
 			"subnetRange", fmt.Sprintf("127.0.%d.0/24", allocated))
+
+		// Local nodes listen on their subnet addresses, which macOS only
+		// answers on once they are aliased on lo0
+		if devnet.Spec.Mode != "docker" && devnet.Spec.BindAddress == "" && len(devnet.Spec.Hosts) == 0 {
+			if err := subnet.EnsureAliases(allocated, devnet.Spec.Validators+devnet.Spec.FullNodes); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Pull the docker image once, before the slow phases, so every node
+	// starts from the digest its tag points to now
+	var pinnedImage string
+	if devnet.Spec.Mode == "docker" && p.imagePinner != nil {
+		pinned, err := p.imagePinner.PinImage(ctx, devnet.Spec.Image, devnet.Spec.Offline || p.offline)
+		if err != nil {
+			return fmt.Errorf("failed to pin image: %w", err)
+		}
+		devnet.Status.Image = pinned.Image
+		devnet.Status.ImageDigest = pinned.Digest
+		pinnedImage = pinned.Ref
+
+		p.logger.Info("pinned devnet image",
+			"devnet", devnet.Metadata.Name,
+			"image", pinned.Image,
+			"digest", pinned.Digest)
 	}
 
 	// Track binary path from orchestration (may be built)
@@ -217,7 +311,7 @@ func (p *DevnetProvisioner) Provision(ctx context.Context, devnet *types.Devnet)
 
 	// Create Node resources in the store (existing behavior)
 	// Pass the built binary path and allocated subnet so nodes get correct addresses
-	return p.createNodeResources(ctx, devnet, builtBinaryPath, allocatedSubnet)
+	return p.createNodeResources(ctx, devnet, builtBinaryPath, pinnedImage, allocatedSubnet)
 }
 
 // provisionWithOrchestrator executes the full provisioning flow using an orchestrator.
@@ -278,6 +372,7 @@ func (p *DevnetProvisioner) provisionWithOrchestrator(ctx context.Context, devne
 
 	// In daemon mode, skip start phase - NodeController will handle starting
 	opts.SkipStart = true
+	opts.Offline = opts.Offline || p.offline
 
 	p.logger.Info("executing orchestrator provisioning flow",
 		"name", devnet.Metadata.Name,
@@ -300,14 +395,18 @@ func (p *DevnetProvisioner) provisionWithOrchestrator(ctx context.Context, devne
 // createNodeResources creates Node resources in the store.
 // This is the original resource-only provisioning behavior.
 // builtBinaryPath is the path to the binary built by orchestrator (empty if no orchestration).
+// pinnedImage is the image reference nodes start from (empty to use the spec's image).
 // allocatedSubnet is the subnet for IP address assignment (0 means no subnet allocation).
-func (p *DevnetProvisioner) createNodeResources(ctx context.Context, devnet *types.Devnet, builtBinaryPath string, allocatedSubnet uint8) error {
+func (p *DevnetProvisioner) createNodeResources(ctx context.Context, devnet *types.Devnet, builtBinaryPath, pinnedImage string, allocatedSubnet uint8) error {
 	totalNodes := devnet.Spec.Validators + devnet.Spec.FullNodes
 	devnetDataDir := filepath.Join(p.dataDir, devnet.Metadata.Name)
 
 	// Create validator nodes (indices 0 to Validators-1)
 	for i := 0; i < devnet.Spec.Validators; i++ {
 		node := p.createNodeSpec(devnet, i, "validator", devnetDataDir, builtBinaryPath, allocatedSubnet)
+		if pinnedImage != "" {
+			node.Spec.Image = pinnedImage
+		}
 		if err := p.createNodeIfNotExists(ctx, node); err != nil {
 			return fmt.Errorf("failed to create validator node %d: %w", i, err)
 		}
@@ -316,6 +415,9 @@ func (p *DevnetProvisioner) createNodeResources(ctx context.Context, devnet *typ
 	// Create fullnode nodes (indices Validators to totalNodes-1)
 	for i := devnet.Spec.Validators; i < totalNodes; i++ {
 		node := p.createNodeSpec(devnet, i, "fullnode", devnetDataDir, builtBinaryPath, allocatedSubnet)
+		if pinnedImage != "" {
+			node.Spec.Image = pinnedImage
+		}
 		if err := p.createNodeIfNotExists(ctx, node); err != nil {
 			return fmt.Errorf("failed to create fullnode %d: %w", i, err)
 		}
@@ -355,15 +457,30 @@ func (p *DevnetProvisioner) createNodeSpec(devnet *types.Devnet, index int, role
 		namespace = types.DefaultNamespace
 	}
 
-	// Calculate node IP address from subnet allocation
+	// Calculate node IP address from subnet allocation. Local nodes with a
+	// bind address are all reached on it instead, and remote nodes on their
+	// host's address.
 	var nodeAddress string
-	if allocatedSubnet > 0 {
+	bindAddress := devnet.Spec.BindAddress
+	host := devnet.Spec.HostFor(index)
+	if host != nil {
+		nodeAddress = host.Address
+		bindAddress = remoteBindAddress
+	} else if devnet.Spec.BindAddress != "" && devnet.Spec.Mode != "docker" {
+		nodeAddress = dvbtypes.DialHost(devnet.Spec.BindAddress)
+	} else if allocatedSubnet > 0 {
 		nodeAddress = subnet.NodeIP(allocatedSubnet, index)
 	}
 
 	// Generate moniker matching orchestrator's format: {devnetName}-{role}-{index}
 	moniker := fmt.Sprintf("%s-%s-%d", devnet.Metadata.Name, role, index)
 
+	// Profile-driven process environment (e.g., GOMEMLIMIT for laptop profile)
+	var env map[string]string
+	if profile, ok := types.LookupProfile(devnet.Spec.Profile); ok {
+		env = profile.Env()
+	}
+
 	return &types.Node{
 		Metadata: types.ResourceMeta{
 			Name:      fmt.Sprintf("%s-node-%d", devnet.Metadata.Name, index),
@@ -372,19 +489,26 @@ func (p *DevnetProvisioner) createNodeSpec(devnet *types.Devnet, index int, role
 			UpdatedAt: time.Now(),
 		},
 		Spec: types.NodeSpec{
-			DevnetRef:  devnet.Metadata.Name,
-			Index:      index,
-			Role:       role,
-			BinaryPath: binaryPath,
-			HomeDir:    filepath.Join(devnetDataDir, "nodes", moniker),
-			Address:    nodeAddress,
-			Desired:    types.NodePhaseRunning,
-			ChainID:    devnet.Spec.ChainID,
-			Network:    devnet.Spec.Plugin,
+			DevnetRef:    devnet.Metadata.Name,
+			Index:        index,
+			Role:         role,
+			BinaryPath:   binaryPath,
+			HomeDir:      filepath.Join(devnetDataDir, "nodes", moniker),
+			Image:        devnet.Spec.Image,
+			Address:      nodeAddress,
+			BindAddress:  bindAddress,
+			PortLayout:   nodePortLayout(devnet),
+			Desired:      types.NodePhaseRunning,
+			ChainID:      devnet.Spec.ChainID,
+			Network:      devnet.Spec.Plugin,
+			Env:          env,
+			RemoteSigner: remoteSigner(devnet, index, role),
+			Host:         host,
 		},
 		Status: types.NodeStatus{
 			Phase:   types.NodePhasePending,
 			Message: "Node created, awaiting start",
+			Version: devnet.Spec.BinarySource.Version,
 		},
 	}
 }
@@ -555,13 +679,64 @@ func devnetToProvisionOptions(devnet *types.Devnet, dataDir string, networkDefau
 	}
 
 	opts := ports.ProvisionOptions{
-		DevnetName:    devnet.Metadata.Name,
-		ChainID:       chainID,
-		Network:       devnet.Spec.Plugin,
-		NumValidators: devnet.Spec.Validators,
-		NumFullNodes:  devnet.Spec.FullNodes,
-		DataDir:       filepath.Join(dataDir, devnet.Metadata.Name),
-		Subnet:        allocatedSubnet,
+		DevnetName:      devnet.Metadata.Name,
+		Namespace:       devnet.Metadata.Namespace,
+		ChainID:         chainID,
+		Network:         devnet.Spec.Plugin,
+		NumValidators:   devnet.Spec.Validators,
+		NumFullNodes:    devnet.Spec.FullNodes,
+		NumAccounts:     devnet.Spec.Accounts,
+		DataDir:         filepath.Join(dataDir, devnet.Metadata.Name),
+		Subnet:          allocatedSubnet,
+		Profile:         devnet.Spec.Profile,
+		ForceBuild:      devnet.Spec.ForceBuild,
+		AllowUnverified: devnet.Spec.AllowUnverified,
+		Offline:         devnet.Spec.Offline,
+		SkipDiskCheck:   devnet.Spec.SkipDiskCheck,
+
+		GenesisOverrides: devnet.Spec.GenesisOverrides,
+		FundedAccounts:   fundedAccountsToOptions(devnet.Spec.FundedAccounts),
+		ValidatorKeys:    validatorKeysToOptions(devnet.Spec.ValidatorKeys),
+		RemoteSigners:    remoteSignerIndexes(devnet.Spec.RemoteSigners),
+		NodeConfigs:      nodeConfigsToOptions(devnet.Spec.NodeConfigs),
+		BlockTime:        devnet.Spec.BlockTime,
+		Seed:             devnet.Spec.Seed,
+		PowerProfile:     devnet.Spec.VotingPower.Profile,
+		PowerWeights:     devnet.Spec.VotingPower.Weights,
+		GenesisPatchOpts: plugintypes.GenesisPatchOptions{
+			Prune: plugintypes.GenesisPruneOptions{
+				IBCPackets:    devnet.Spec.GenesisPrune.IBCPackets,
+				DustThreshold: devnet.Spec.GenesisPrune.DustThreshold,
+				WasmCode:      devnet.Spec.GenesisPrune.WasmCode,
+			},
+		},
+	}
+
+	// Docker nodes listen on the default ports inside their containers and
+	// reach each other on the devnet's network; their layout and bind
+	// address only affect the ports published on the host
+	if devnet.Spec.Mode != "docker" {
+		opts.PortLayout = nodePortLayout(devnet)
+		opts.BindAddress = devnet.Spec.BindAddress
+		for _, host := range devnet.Spec.Hosts {
+			opts.HostAddresses = append(opts.HostAddresses, host.Address)
+		}
+	} else {
+		opts.DockerNetwork = true
+	}
+
+	hookList, err := hooks.FromSpec(devnet.Spec.Hooks)
+	if err != nil {
+		return ports.ProvisionOptions{}, fmt.Errorf("invalid hooks: %w", err)
+	}
+	opts.Hooks = hookList
+
+	if devnet.Spec.EpochDuration != "" {
+		d, err := time.ParseDuration(devnet.Spec.EpochDuration)
+		if err != nil {
+			return ports.ProvisionOptions{}, fmt.Errorf("invalid epoch duration %q: %w", devnet.Spec.EpochDuration, err)
+		}
+		opts.EpochDuration = d
 	}
 
 	// Map BinarySource to BinaryPath/BinaryVersion
@@ -594,6 +769,85 @@ func devnetToProvisionOptions(devnet *types.Devnet, dataDir string, networkDefau
 	return opts, nil
 }
 
+// nodePortLayout returns the devnet's port layout for its nodes. Nodes that
+// share an address (docker nodes publishing on the host, local nodes with a
+// bind address, or remote nodes on one host) need a stride, defaulting to
+// portalloc.BlockSize.
+func nodePortLayout(devnet *types.Devnet) dvbtypes.PortLayout {
+	layout := devnet.Spec.Ports.Layout()
+	if layout.Stride == 0 && (devnet.Spec.Mode == "docker" || devnet.Spec.BindAddress != "" || len(devnet.Spec.Hosts) > 0) {
+		layout.Stride = portalloc.BlockSize
+	}
+	return layout
+}
+
+// fundedAccountsToOptions maps the spec's funded accounts to ProvisionOptions.
+func fundedAccountsToOptions(accounts []types.FundedAccount) []ports.FundedAccount {
+	if len(accounts) == 0 {
+		return nil
+	}
+	out := make([]ports.FundedAccount, 0, len(accounts))
+	for _, acc := range accounts {
+		funded := ports.FundedAccount{Address: acc.Address, Coins: acc.Coins}
+		if acc.Vesting != nil {
+			funded.Vesting = &ports.VestingSchedule{
+				Type:      acc.Vesting.Type,
+				Coins:     acc.Vesting.Coins,
+				StartTime: acc.Vesting.StartTime,
+				EndTime:   acc.Vesting.EndTime,
+			}
+		}
+		out = append(out, funded)
+	}
+	return out
+}
+
+func validatorKeysToOptions(keys []types.ValidatorKeys) []ports.ValidatorKeys {
+	if len(keys) == 0 {
+		return nil
+	}
+	out := make([]ports.ValidatorKeys, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, ports.ValidatorKeys(k))
+	}
+	return out
+}
+
+func nodeConfigsToOptions(configs []types.NodeConfig) []ports.NodeConfig {
+	if len(configs) == 0 {
+		return nil
+	}
+	out := make([]ports.NodeConfig, 0, len(configs))
+	for _, c := range configs {
+		out = append(out, ports.NodeConfig(c))
+	}
+	return out
+}
+
+func remoteSignerIndexes(signers []types.RemoteSigner) []int {
+	if len(signers) == 0 {
+		return nil
+	}
+	out := make([]int, 0, len(signers))
+	for _, s := range signers {
+		out = append(out, s.Index)
+	}
+	return out
+}
+
+// remoteSigner returns the remote signer of a devnet's node, if any.
+func remoteSigner(devnet *types.Devnet, index int, role string) *types.RemoteSigner {
+	if role != "validator" {
+		return nil
+	}
+	for _, s := range devnet.Spec.RemoteSigners {
+		if s.Index == index {
+			return &s
+		}
+	}
+	return nil
+}
+
 // mapGenesisSource determines the genesis source from devnet spec.
 // Priority: GenesisPath (local) > SnapshotURL (snapshot/spec or default) > RPCURL (spec or default) > fresh genesis
 // networkDefaults provides plugin-defined URLs when not explicitly specified in the spec.
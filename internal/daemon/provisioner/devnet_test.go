@@ -3,10 +3,13 @@ package provisioner
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
 	plugintypes "github.com/altuslabsxyz/devnet-builder/internal/plugin/types"
@@ -61,6 +64,83 @@ func TestDevnetProvisioner_Provision(t *testing.T) {
 	}
 }
 
+// fakeImagePinner pins every image to a fixed digest.
+type fakeImagePinner struct {
+	offline []bool
+	err     error
+}
+
+func (f *fakeImagePinner) PinImage(ctx context.Context, image string, offline bool) (runtime.PinnedImage, error) {
+	f.offline = append(f.offline, offline)
+	if f.err != nil {
+		return runtime.PinnedImage{}, f.err
+	}
+	if image == "" {
+		image = "stablelabs/stabled:latest"
+	}
+	return runtime.PinnedImage{Image: image, Digest: "sha256:abc", Ref: "stablelabs/stabled@sha256:abc"}, nil
+}
+
+func (f *fakeImagePinner) UpstreamDigest(ctx context.Context, image string) (string, error) {
+	return "sha256:abc", nil
+}
+
+func TestDevnetProvisioner_ProvisionPinsImage(t *testing.T) {
+	s := store.NewMemoryStore()
+	p := NewDevnetProvisioner(s, Config{DataDir: t.TempDir(), Offline: true})
+	pinner := &fakeImagePinner{}
+	p.SetImagePinner(pinner)
+
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "pinned-devnet"},
+		Spec:     types.DevnetSpec{Plugin: "stable", Validators: 2, Mode: "docker"},
+	}
+	if err := p.Provision(context.Background(), devnet); err != nil {
+		t.Fatalf("Provision failed: %v", err)
+	}
+
+	if devnet.Status.Image != "stablelabs/stabled:latest" || devnet.Status.ImageDigest != "sha256:abc" {
+		t.Errorf("expected pinned image in status, got %q %q", devnet.Status.Image, devnet.Status.ImageDigest)
+	}
+	if len(pinner.offline) != 1 || !pinner.offline[0] {
+		t.Errorf("expected one offline pin, got %v", pinner.offline)
+	}
+	nodes, err := s.ListNodes(context.Background(), "", "pinned-devnet")
+	if err != nil {
+		t.Fatalf("ListNodes failed: %v", err)
+	}
+	for _, node := range nodes {
+		if node.Spec.Image != "stablelabs/stabled@sha256:abc" {
+			t.Errorf("node %d: expected pinned image, got %q", node.Spec.Index, node.Spec.Image)
+		}
+	}
+
+	// Local devnets run binaries, not images
+	local := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "local-devnet"},
+		Spec:     types.DevnetSpec{Plugin: "stable", Validators: 1, Mode: "local"},
+	}
+	if err := p.Provision(context.Background(), local); err != nil {
+		t.Fatalf("Provision failed: %v", err)
+	}
+	if len(pinner.offline) != 1 || local.Status.ImageDigest != "" {
+		t.Errorf("expected local devnet not to be pinned")
+	}
+
+	// A failed pin stops provisioning before any node is created
+	pinner.err = errors.New("manifest unknown")
+	failed := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "failed-devnet"},
+		Spec:     types.DevnetSpec{Plugin: "stable", Validators: 1, Mode: "docker", Image: "stablelabs/stabled:missing"},
+	}
+	if err := p.Provision(context.Background(), failed); err == nil || !strings.Contains(err.Error(), "manifest unknown") {
+		t.Errorf("expected pin error, got %v", err)
+	}
+	if nodes, _ := s.ListNodes(context.Background(), "", "failed-devnet"); len(nodes) != 0 {
+		t.Errorf("expected no nodes, got %d", len(nodes))
+	}
+}
+
 func TestDevnetProvisioner_ProvisionIdempotent(t *testing.T) {
 	s := store.NewMemoryStore()
 	p := NewDevnetProvisioner(s, Config{DataDir: "/tmp/devnet"})
@@ -90,6 +170,39 @@ func TestDevnetProvisioner_ProvisionIdempotent(t *testing.T) {
 	}
 }
 
+func TestDevnetProvisioner_ProvisionKeepsResumeState(t *testing.T) {
+	dataDir := t.TempDir()
+	devnetDir := filepath.Join(dataDir, "test-devnet")
+	for _, name := range []string{provisionStateFile, "genesis.json", "node0/config/config.toml"} {
+		path := filepath.Join(devnetDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	p := NewDevnetProvisioner(store.NewMemoryStore(), Config{DataDir: dataDir})
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test-devnet"},
+		Spec:     types.DevnetSpec{Plugin: "stable", Validators: 1, Mode: "docker"},
+	}
+	if err := p.Provision(context.Background(), devnet); err != nil {
+		t.Fatalf("Provision failed: %v", err)
+	}
+
+	// The markers and the genesis they check survive; earlier output does not
+	for _, name := range []string{provisionStateFile, "genesis.json"} {
+		if _, err := os.Stat(filepath.Join(devnetDir, name)); err != nil {
+			t.Errorf("expected %s to be kept: %v", name, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(devnetDir, "node0")); !os.IsNotExist(err) {
+		t.Errorf("expected node0 to be erased, got %v", err)
+	}
+}
+
 func TestDevnetProvisioner_Deprovision(t *testing.T) {
 	s := store.NewMemoryStore()
 	p := NewDevnetProvisioner(s, Config{DataDir: "/tmp/devnet"})
@@ -675,6 +788,98 @@ func TestDevnetToProvisionOptions_BasicConversion(t *testing.T) {
 	}
 }
 
+func TestDevnetToProvisionOptions_PortLayout(t *testing.T) {
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "ports"},
+		Spec: types.DevnetSpec{
+			Plugin:     "stable",
+			Validators: 2,
+			Mode:       "local",
+			Ports:      types.PortConfig{BaseRPC: 36657, Stride: 10},
+		},
+	}
+
+	opts, err := devnetToProvisionOptions(devnet, "/data", nil, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := opts.PortLayout.ForNode(1).RPC; got != 36667 {
+		t.Errorf("Expected node 1 RPC port 36667, got %d", got)
+	}
+
+	// Docker nodes keep the default ports inside their containers
+	devnet.Spec.Mode = "docker"
+	opts, err = devnetToProvisionOptions(devnet, "/data", nil, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !opts.PortLayout.IsZero() {
+		t.Errorf("Expected no port layout in docker mode, got %+v", opts.PortLayout)
+	}
+}
+
+func TestCreateNodeSpec_PortLayout(t *testing.T) {
+	p := NewDevnetProvisioner(store.NewMemoryStore(), Config{DataDir: "/tmp/devnet"})
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "ports"},
+		Spec: types.DevnetSpec{
+			Plugin: "stable",
+			Mode:   "docker",
+			Ports:  types.PortConfig{BaseRPC: 36657},
+		},
+	}
+
+	// Docker nodes get the default stride so their host ports don't collide
+	node := p.createNodeSpec(devnet, 2, "validator", "/tmp/devnet/ports", "", 0)
+	if got := node.Spec.Ports().RPC; got != 36857 {
+		t.Errorf("Expected docker node 2 RPC port 36857, got %d", got)
+	}
+
+	devnet.Spec.Mode = "local"
+	node = p.createNodeSpec(devnet, 2, "validator", "/tmp/devnet/ports", "", 42)
+	if got := node.Spec.Ports().RPC; got != 36657 {
+		t.Errorf("Expected local node 2 RPC port 36657, got %d", got)
+	}
+}
+
+func TestCreateNodeSpec_BindAddress(t *testing.T) {
+	p := NewDevnetProvisioner(store.NewMemoryStore(), Config{DataDir: "/tmp/devnet"})
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "bind"},
+		Spec: types.DevnetSpec{
+			Plugin:      "stable",
+			Mode:        "local",
+			Validators:  2,
+			BindAddress: "::",
+		},
+	}
+
+	// Nodes sharing the bind address are reached on loopback, a block apart
+	node := p.createNodeSpec(devnet, 1, "validator", "/tmp/devnet/bind", "", 42)
+	if node.Spec.Address != "::1" || node.Spec.BindAddress != "::" {
+		t.Errorf("Expected address ::1 bound on ::, got %q bound on %q", node.Spec.Address, node.Spec.BindAddress)
+	}
+	if got := node.Spec.Ports().RPC; got != 26757 {
+		t.Errorf("Expected node 1 RPC port 26757, got %d", got)
+	}
+
+	opts, err := devnetToProvisionOptions(devnet, "/data", nil, 42)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.BindAddress != "::" || opts.PortLayout.Stride != 100 {
+		t.Errorf("Expected bind address :: with stride 100, got %q with stride %d", opts.BindAddress, opts.PortLayout.Stride)
+	}
+
+	// Docker nodes keep their subnet address; the bind address only moves
+	// the published ports
+	devnet.Spec.Mode = "docker"
+	node = p.createNodeSpec(devnet, 1, "validator", "/tmp/devnet/bind", "", 42)
+	if node.Spec.Address != "127.0.42.2" {
+		t.Errorf("Expected docker node address 127.0.42.2, got %q", node.Spec.Address)
+	}
+}
+
 func TestDevnetToProvisionOptions_LocalBinarySource(t *testing.T) {
 	devnet := &types.Devnet{
 		Metadata: types.ResourceMeta{Name: "test"},
@@ -1026,3 +1231,23 @@ func TestDevnetToProvisionOptions_EmptyTypeWithoutVersion(t *testing.T) {
 		t.Fatalf("Expected SnapshotVersionRequiredError, got %T: %v", err, err)
 	}
 }
+
+func TestDevnetToProvisionOptions_Offline(t *testing.T) {
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "offline-test"},
+		Spec: types.DevnetSpec{
+			Plugin:     "stable",
+			Validators: 1,
+			Mode:       "local",
+			Offline:    true,
+		},
+	}
+
+	opts, err := devnetToProvisionOptions(devnet, "/data", nil, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !opts.Offline {
+		t.Error("Expected Offline to be propagated from spec")
+	}
+}
@@ -4,6 +4,7 @@ package provisioner
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -13,9 +14,26 @@ import (
 	"time"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/genesispatch"
 	"github.com/altuslabsxyz/devnet-builder/internal/plugin/types"
 )
 
+// ErrOfflineUnavailable is returned in offline mode when a genesis source
+// is not available from local caches.
+var ErrOfflineUnavailable = errors.New("not available offline")
+
+// cachedSnapshotFetcher is implemented by snapshot fetchers that can look up
+// a previously downloaded snapshot without touching the network.
+type cachedSnapshotFetcher interface {
+	CachedSnapshot(cacheKey string) (string, bool)
+}
+
+// snapshotSizer is implemented by snapshot fetchers that can report the size
+// of a remote snapshot without downloading it.
+type snapshotSizer interface {
+	SnapshotSize(ctx context.Context, url string) (int64, error)
+}
+
 // GenesisForkerConfig configures the genesis forker
 type GenesisForkerConfig struct {
 	DataDir            string
@@ -62,18 +80,46 @@ func reportStep(progress ports.ProgressReporter, name, status string, detail str
 
 // Fork forks genesis from the specified source
 func (f *GenesisForker) Fork(ctx context.Context, opts ports.ForkOptions, progress ports.ProgressReporter) (*ports.ForkResult, error) {
+	workDir, err := f.workDir("fork-result")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(workDir)
+
+	path := filepath.Join(workDir, "genesis.json")
+	result, err := f.ForkToFile(ctx, opts, path, progress)
+	if err != nil {
+		return nil, err
+	}
+	if result.Genesis, err = os.ReadFile(path); err != nil {
+		return nil, fmt.Errorf("failed to read forked genesis: %w", err)
+	}
+	return result, nil
+}
+
+// ForkToFile forks genesis from the specified source into the file at path.
+// Each step streams the genesis from one file to the next, so only a plugin
+// without file-based patching reads it into memory, and only while it is
+// small enough for the plugin's gRPC limit.
+func (f *GenesisForker) ForkToFile(ctx context.Context, opts ports.ForkOptions, path string, progress ports.ProgressReporter) (*ports.ForkResult, error) {
 	f.logger.Info("forking genesis",
 		"mode", opts.Source.Mode,
 		"networkType", opts.Source.NetworkType,
 	)
 
-	var genesis []byte
-	var err error
+	workDir, err := f.workDir("fork")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(workDir)
+
+	// genesis is the file holding the genesis as of the current step
+	var genesis string
 
 	switch opts.Source.Mode {
 	case types.GenesisModeRPC:
 		reportStep(progress, "Fetching genesis from RPC", "running", opts.Source.RPCURL)
-		genesis, err = f.forkFromRPC(ctx, opts)
+		genesis, err = f.forkFromRPC(ctx, opts, workDir)
 		if err != nil {
 			reportStep(progress, "Fetching genesis from RPC", "failed", err.Error())
 			return nil, fmt.Errorf("failed to fetch genesis: %w", err)
@@ -81,7 +127,7 @@ func (f *GenesisForker) Fork(ctx context.Context, opts ports.ForkOptions, progre
 		reportStep(progress, "Fetching genesis from RPC", "completed", "")
 	case types.GenesisModeSnapshot:
 		reportStep(progress, "Forking from snapshot", "running", opts.Source.SnapshotURL)
-		genesis, err = f.forkFromSnapshot(ctx, opts, progress)
+		genesis, err = f.forkFromSnapshot(ctx, opts, workDir, progress)
 		if err != nil {
 			reportStep(progress, "Forking from snapshot", "failed", err.Error())
 			return nil, fmt.Errorf("failed to fetch genesis: %w", err)
@@ -99,56 +145,27 @@ func (f *GenesisForker) Fork(ctx context.Context, opts ports.ForkOptions, progre
 		return nil, fmt.Errorf("unsupported genesis mode: %s", opts.Source.Mode)
 	}
 
-	// Extract original chain ID
-	sourceChainID, err := extractChainID(genesis)
-	if err != nil {
-		f.logger.Warn("failed to extract source chain ID", "error", err)
-		sourceChainID = ""
-	}
-
-	// Check if genesis is large (>1GB) and requires file-based patching
-	// gRPC has a ~2GB message size limit, so use file-based approach for safety
-	const largeGenesisThreshold = 1 << 30 // 1GB
-	if len(genesis) > largeGenesisThreshold {
-		f.logger.Info("large genesis detected, using file-based patching",
-			"size", len(genesis),
-			"threshold", largeGenesisThreshold)
-		return f.patchLargeGenesis(genesis, sourceChainID, opts)
-	}
-
-	// Validate the fetched genesis
+	// Prune, read the source chain ID and set the new one in a single
+	// rewrite, straight to path when there is no plugin to patch it next
+	reportStep(progress, "Applying genesis patches", "running", "")
+	rewritten := path
 	if f.config.PluginGenesis != nil {
-		if err := f.config.PluginGenesis.ValidateGenesis(genesis); err != nil {
-			return nil, fmt.Errorf("genesis validation failed: %w", err)
-		}
+		rewritten = filepath.Join(workDir, "genesis-patched.json")
 	}
-
-	// Apply patches
-	reportStep(progress, "Applying genesis patches", "running", "")
-	patched, err := f.applyPatches(genesis, opts.PatchOpts)
+	sourceChainID, err := f.rewrite(rewritten, genesis, opts.PatchOpts)
 	if err != nil {
 		reportStep(progress, "Applying genesis patches", "failed", err.Error())
 		return nil, fmt.Errorf("failed to apply patches: %w", err)
 	}
 
 	// Apply plugin-specific patches (voting period, unbonding time, inflation rate, etc.)
-	if f.config.PluginGenesis != nil {
-		patched, err = f.config.PluginGenesis.PatchGenesis(patched, opts.PatchOpts)
-		if err != nil {
-			reportStep(progress, "Applying genesis patches", "failed", err.Error())
-			return nil, fmt.Errorf("plugin patch failed: %w", err)
-		}
-	} else if opts.PatchOpts.VotingPeriod > 0 || opts.PatchOpts.UnbondingTime > 0 || opts.PatchOpts.InflationRate != "" {
-		f.logger.Warn("genesis patch options specify network parameters but no plugin is configured to apply them",
-			"votingPeriod", opts.PatchOpts.VotingPeriod,
-			"unbondingTime", opts.PatchOpts.UnbondingTime,
-			"inflationRate", opts.PatchOpts.InflationRate,
-		)
+	if err := f.patchPlugin(path, rewritten, opts.PatchOpts); err != nil {
+		reportStep(progress, "Applying genesis patches", "failed", err.Error())
+		return nil, err
 	}
 	reportStep(progress, "Applying genesis patches", "completed", "")
 
 	return &ports.ForkResult{
-		Genesis:       patched,
 		SourceChainID: sourceChainID,
 		NewChainID:    opts.PatchOpts.ChainID,
 		SourceMode:    opts.Source.Mode,
@@ -156,92 +173,247 @@ func (f *GenesisForker) Fork(ctx context.Context, opts ports.ForkOptions, progre
 	}, nil
 }
 
-// patchLargeGenesis handles patching for genesis files that exceed gRPC message size limits.
-// It writes genesis to a temporary file, uses file-based patching, and reads the result back.
-func (f *GenesisForker) patchLargeGenesis(genesis []byte, sourceChainID string, opts ports.ForkOptions) (*ports.ForkResult, error) {
-	// Check if plugin supports file-based patching
-	fileBasedPlugin, ok := f.config.PluginGenesis.(types.FileBasedPluginGenesis)
-	if !ok {
-		return nil, fmt.Errorf("genesis size (%d bytes) exceeds gRPC limit but plugin does not support file-based patching", len(genesis))
+// largeGenesisThreshold is the genesis size above which plugins patch it
+// file to file. gRPC has a ~2GB message size limit, so the in-memory
+// PatchGenesis call stays well below it.
+const largeGenesisThreshold = 1 << 30 // 1GB
+
+// patchPlugin writes the genesis file at src, patched by the plugin, to dst.
+// Without a plugin, src is dst and already final. A large genesis needs a
+// plugin with file-based patching.
+func (f *GenesisForker) patchPlugin(dst, src string, opts types.GenesisPatchOptions) error {
+	if f.config.PluginGenesis == nil {
+		if opts.VotingPeriod > 0 || opts.UnbondingTime > 0 || opts.InflationRate != "" {
+			f.logger.Warn("genesis patch options specify network parameters but no plugin is configured to apply them",
+				"votingPeriod", opts.VotingPeriod,
+				"unbondingTime", opts.UnbondingTime,
+				"inflationRate", opts.InflationRate,
+			)
+		}
+		return nil
 	}
 
-	// Create temporary directory for file-based operations
-	workDir := filepath.Join(f.config.DataDir, "genesis-work", fmt.Sprintf("patch-%d", time.Now().UnixNano()))
-	if err := os.MkdirAll(workDir, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create work dir for large genesis: %w", err)
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
 	}
-	defer os.RemoveAll(workDir)
+	if info.Size() > largeGenesisThreshold {
+		f.logger.Info("large genesis detected, using file-based patching",
+			"size", info.Size(),
+			"threshold", largeGenesisThreshold)
 
-	// Write genesis to input file
-	inputPath := filepath.Join(workDir, "genesis-input.json")
-	if err := os.WriteFile(inputPath, genesis, 0644); err != nil {
-		return nil, fmt.Errorf("failed to write genesis to temp file: %w", err)
+		fileBasedPlugin, ok := f.config.PluginGenesis.(types.FileBasedPluginGenesis)
+		if !ok {
+			return fmt.Errorf("genesis size (%d bytes) exceeds gRPC limit but plugin does not support file-based patching", info.Size())
+		}
+		outputSize, err := fileBasedPlugin.PatchGenesisFile(src, dst, opts)
+		if err != nil {
+			return fmt.Errorf("file-based plugin patch failed: %w", err)
+		}
+		f.logger.Info("file-based patching complete", "outputSize", outputSize)
+		return nil
 	}
 
-	// Apply generic patches first (chain_id, etc.) by reading and writing
-	patched, err := f.applyPatches(genesis, opts.PatchOpts)
+	genesis, err := os.ReadFile(src)
 	if err != nil {
-		return nil, fmt.Errorf("failed to apply patches: %w", err)
+		return err
 	}
-
-	// Write patched genesis for plugin processing
-	patchedInputPath := filepath.Join(workDir, "genesis-patched.json")
-	if err := os.WriteFile(patchedInputPath, patched, 0644); err != nil {
-		return nil, fmt.Errorf("failed to write patched genesis: %w", err)
+	if err := f.config.PluginGenesis.ValidateGenesis(genesis); err != nil {
+		return fmt.Errorf("genesis validation failed: %w", err)
 	}
-
-	// Use file-based plugin patching
-	outputPath := filepath.Join(workDir, "genesis-output.json")
-	f.logger.Info("applying file-based plugin patches",
-		"inputPath", patchedInputPath,
-		"outputPath", outputPath)
-
-	outputSize, err := fileBasedPlugin.PatchGenesisFile(patchedInputPath, outputPath, opts.PatchOpts)
+	patched, err := f.config.PluginGenesis.PatchGenesis(genesis, opts)
 	if err != nil {
-		return nil, fmt.Errorf("file-based plugin patch failed: %w", err)
+		return fmt.Errorf("plugin patch failed: %w", err)
 	}
+	return os.WriteFile(dst, patched, 0644)
+}
 
-	f.logger.Info("file-based patching complete", "outputSize", outputSize)
-
-	// Read the result back
-	result, err := os.ReadFile(outputPath)
+// workDir creates a fresh directory for a fork's intermediate files under
+// the data dir, or the system temp dir when there is none.
+func (f *GenesisForker) workDir(prefix string) (string, error) {
+	parent := os.TempDir()
+	if f.config.DataDir != "" {
+		parent = filepath.Join(f.config.DataDir, "genesis-work")
+		if err := os.MkdirAll(parent, 0755); err != nil {
+			return "", fmt.Errorf("failed to create work dir: %w", err)
+		}
+	}
+	dir, err := os.MkdirTemp(parent, prefix+"-")
 	if err != nil {
-		return nil, fmt.Errorf("failed to read patched genesis: %w", err)
+		return "", fmt.Errorf("failed to create work dir: %w", err)
 	}
+	return dir, nil
+}
 
-	return &ports.ForkResult{
-		Genesis:       result,
-		SourceChainID: sourceChainID,
-		NewChainID:    opts.PatchOpts.ChainID,
-		SourceMode:    opts.Source.Mode,
-		FetchedAt:     time.Now(),
-	}, nil
+// copyGenesis copies the genesis file at src to dst.
+func copyGenesis(dst, src string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	return genesispatch.WriteFile(dst, func(w io.Writer) error {
+		_, err := io.Copy(w, in)
+		return err
+	})
 }
 
-// forkFromRPC fetches genesis from an RPC endpoint
-func (f *GenesisForker) forkFromRPC(ctx context.Context, opts ports.ForkOptions) ([]byte, error) {
+// forkFromRPC fetches genesis from an RPC endpoint into workDir and returns
+// its path. Offline, it returns the path of the cached genesis.
+func (f *GenesisForker) forkFromRPC(ctx context.Context, opts ports.ForkOptions, workDir string) (string, error) {
 	// Determine RPC endpoint
 	rpcURL := opts.Source.RPCURL
 	if rpcURL == "" && f.config.PluginGenesis != nil {
 		rpcURL = f.config.PluginGenesis.GetRPCEndpoint(opts.Source.NetworkType)
 	}
+	if opts.Offline {
+		return f.cachedGenesis(opts)
+	}
 	if rpcURL == "" {
-		return nil, fmt.Errorf("no RPC URL specified")
+		return "", fmt.Errorf("no RPC URL specified")
 	}
 
-	// Use existing infrastructure if available
+	var genesis []byte
+	var err error
 	if f.config.GenesisFetcher != nil {
-		return f.config.GenesisFetcher.FetchFromRPC(ctx, rpcURL)
+		// Use existing infrastructure if available
+		genesis, err = f.config.GenesisFetcher.FetchFromRPC(ctx, rpcURL)
+	} else {
+		// Fallback: direct HTTP fetch
+		genesis, err = f.fetchGenesisHTTP(ctx, rpcURL+"/genesis")
+	}
+	if err != nil {
+		return "", err
+	}
+
+	f.storeCachedGenesis(opts, genesis)
+	path := filepath.Join(workDir, "genesis-source.json")
+	if err := os.WriteFile(path, genesis, 0644); err != nil {
+		return "", fmt.Errorf("failed to write fetched genesis: %w", err)
+	}
+	return path, nil
+}
+
+// cacheKey identifies cached snapshots and genesis files for a network
+// (format: "binary-networkType", e.g., "stabled-mainnet").
+func (f *GenesisForker) cacheKey(opts ports.ForkOptions) string {
+	if f.config.PluginGenesis == nil {
+		return opts.Source.NetworkType
+	}
+	return fmt.Sprintf("%s-%s", f.config.PluginGenesis.BinaryName(), opts.Source.NetworkType)
+}
+
+// genesisCachePath returns where the source genesis for opts is cached.
+// Placing a genesis file here pre-seeds it for offline provisioning.
+func (f *GenesisForker) genesisCachePath(opts ports.ForkOptions) string {
+	return filepath.Join(f.config.DataDir, "genesis-cache", f.cacheKey(opts), "genesis.json")
+}
+
+// cachedGenesis returns the path of the cached source genesis for offline
+// provisioning.
+func (f *GenesisForker) cachedGenesis(opts ports.ForkOptions) (string, error) {
+	path := f.genesisCachePath(opts)
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("%w: no cached genesis for %s; provision once while online, place a genesis file at %s, or use a local genesis file",
+				ErrOfflineUnavailable, f.cacheKey(opts), path)
+		}
+		return "", fmt.Errorf("failed to read cached genesis: %w", err)
+	}
+
+	f.logger.Info("offline: using cached genesis", "path", path)
+	return path, nil
+}
+
+// loadCachedGenesis reads the cached source genesis for offline provisioning.
+func (f *GenesisForker) loadCachedGenesis(opts ports.ForkOptions) ([]byte, error) {
+	path, err := f.cachedGenesis(opts)
+	if err != nil {
+		return nil, err
+	}
+	genesis, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cached genesis: %w", err)
+	}
+	return genesis, nil
+}
+
+// storeCachedGenesis caches a fetched source genesis so later offline
+// provisioning can use it. Failures are logged and otherwise ignored.
+func (f *GenesisForker) storeCachedGenesis(opts ports.ForkOptions, genesis []byte) {
+	if f.config.DataDir == "" || len(genesis) == 0 {
+		return
 	}
 
-	// Fallback: direct HTTP fetch
-	return f.fetchGenesisHTTP(ctx, rpcURL+"/genesis")
+	path := f.genesisCachePath(opts)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		f.logger.Warn("failed to create genesis cache dir", "error", err)
+		return
+	}
+	if err := os.WriteFile(path, genesis, 0644); err != nil {
+		f.logger.Warn("failed to cache genesis", "path", path, "error", err)
+	}
 }
 
-// forkFromSnapshot downloads snapshot and exports genesis
-func (f *GenesisForker) forkFromSnapshot(ctx context.Context, opts ports.ForkOptions, progress ports.ProgressReporter) ([]byte, error) {
+// EstimateDisk estimates the disk space a snapshot fork needs: the snapshot
+// download into the cache, unless it is already cached, and its extraction
+// into the work directory. Other genesis modes need no significant space.
+func (f *GenesisForker) EstimateDisk(ctx context.Context, opts ports.ForkOptions) ([]DiskRequirement, error) {
+	if opts.Source.Mode != types.GenesisModeSnapshot || f.config.SnapshotFetcher == nil {
+		return nil, nil
+	}
+
+	var size int64
+	cached := false
+	if fetcher, ok := f.config.SnapshotFetcher.(cachedSnapshotFetcher); ok {
+		if path, found := fetcher.CachedSnapshot(f.cacheKey(opts)); found {
+			if info, err := os.Stat(path); err == nil {
+				size, cached = info.Size(), true
+			}
+		}
+	}
+	if !cached {
+		if opts.Offline {
+			return nil, nil
+		}
+		snapshotURL := opts.Source.SnapshotURL
+		if snapshotURL == "" && f.config.PluginGenesis != nil {
+			snapshotURL = f.config.PluginGenesis.GetSnapshotURL(opts.Source.NetworkType)
+		}
+		sizer, ok := f.config.SnapshotFetcher.(snapshotSizer)
+		if snapshotURL == "" || !ok {
+			return nil, nil
+		}
+		var err error
+		if size, err = sizer.SnapshotSize(ctx, snapshotURL); err != nil {
+			return nil, fmt.Errorf("failed to get snapshot size: %w", err)
+		}
+		if size <= 0 {
+			return nil, nil
+		}
+	}
+
+	workDir := filepath.Join(f.config.DataDir, "genesis-work")
+	reqs := []DiskRequirement{{
+		Path:    workDir,
+		Bytes:   size * snapshotExtractionFactor,
+		Purpose: "snapshot extraction",
+	}}
+	if !cached {
+		reqs = append([]DiskRequirement{{
+			Path:    filepath.Join(f.config.DataDir, "snapshots"),
+			Bytes:   size,
+			Purpose: "snapshot download",
+		}}, reqs...)
+	}
+	return reqs, nil
+}
+
+// forkFromSnapshot downloads snapshot and exports genesis into workDir,
+// returning its path
+func (f *GenesisForker) forkFromSnapshot(ctx context.Context, opts ports.ForkOptions, workDir string, progress ports.ProgressReporter) (string, error) {
 	if opts.BinaryPath == "" {
-		return nil, fmt.Errorf("binary path required for snapshot export")
+		return "", fmt.Errorf("binary path required for snapshot export")
 	}
 
 	// Determine snapshot URL
@@ -250,64 +422,140 @@ func (f *GenesisForker) forkFromSnapshot(ctx context.Context, opts ports.ForkOpt
 		snapshotURL = f.config.PluginGenesis.GetSnapshotURL(opts.Source.NetworkType)
 	}
 	if snapshotURL == "" {
-		return nil, fmt.Errorf("no snapshot URL specified")
+		return "", fmt.Errorf("no snapshot URL specified")
 	}
 
 	// Use existing infrastructure for snapshot download/export
-	if f.config.SnapshotFetcher != nil && f.config.StateExportService != nil {
-		return f.forkFromSnapshotInfra(ctx, opts, snapshotURL, progress)
+	if f.config.SnapshotFetcher == nil || f.config.StateExportService == nil {
+		return "", fmt.Errorf("snapshot forking requires SnapshotFetcher and StateExportService")
 	}
 
-	return nil, fmt.Errorf("snapshot forking requires SnapshotFetcher and StateExportService")
+	genesis, err := f.forkFromSnapshotInfra(ctx, opts, snapshotURL, progress)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(workDir, "genesis-source.json")
+	if err := os.WriteFile(path, genesis, 0644); err != nil {
+		return "", fmt.Errorf("failed to write exported genesis: %w", err)
+	}
+	return path, nil
 }
 
 // forkFromSnapshotInfra uses existing infrastructure for snapshot-based forking
 func (f *GenesisForker) forkFromSnapshotInfra(ctx context.Context, opts ports.ForkOptions, snapshotURL string, progress ports.ProgressReporter) ([]byte, error) {
 	// Create work directory
-	workDir := filepath.Join(f.config.DataDir, "genesis-work", fmt.Sprintf("fork-%d", time.Now().UnixNano()))
-	if err := os.MkdirAll(workDir, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create work dir: %w", err)
+	workDir, err := f.workDir("snapshot")
+	if err != nil {
+		return nil, err
 	}
 	defer os.RemoveAll(workDir)
 
-	// Determine cache key
-	cacheKey := ""
-	if f.config.PluginGenesis != nil {
-		cacheKey = fmt.Sprintf("%s-%s",
-			f.config.PluginGenesis.BinaryName(),
-			opts.Source.NetworkType)
+	cacheKey := f.cacheKey(opts)
+
+	// Download and extract snapshot
+	fromCache, err := f.fetchSnapshot(ctx, opts, snapshotURL, cacheKey, workDir, progress)
+	if err != nil {
+		return nil, err
 	}
 
-	// Download snapshot
-	reportStep(progress, "Downloading snapshot", "running", snapshotURL)
-	snapshotPath, fromCache, err := f.config.SnapshotFetcher.DownloadWithCache(
-		ctx, snapshotURL, cacheKey, opts.NoCache)
+	// First, fetch RPC genesis for chain params
+	// The RPC genesis is required for the export command to read chain parameters
+	rpcGenesis, err := f.fetchSnapshotRPCGenesis(ctx, opts, progress)
 	if err != nil {
-		reportStep(progress, "Downloading snapshot", "failed", err.Error())
-		return nil, fmt.Errorf("failed to download snapshot: %w", err)
+		return nil, err
+	}
+
+	// Export genesis from snapshot
+	reportStep(progress, "Exporting state from snapshot", "running", "")
+	exportOpts := ports.StateExportOptions{
+		HomeDir:           workDir,
+		BinaryPath:        opts.BinaryPath,
+		RpcGenesis:        rpcGenesis,
+		CacheKey:          cacheKey,
+		SnapshotURL:       snapshotURL,
+		SnapshotFromCache: fromCache,
 	}
 
-	cacheDetail := ""
-	if fromCache {
-		cacheDetail = "from cache"
+	genesis, err := f.config.StateExportService.ExportFromSnapshot(ctx, exportOpts)
+	if err != nil {
+		reportStep(progress, "Exporting state from snapshot", "failed", err.Error())
+		return nil, fmt.Errorf("failed to export genesis from snapshot: %w", err)
+	}
+	reportStep(progress, "Exporting state from snapshot", "completed", "")
+
+	return genesis, nil
+}
+
+// fetchSnapshot downloads the snapshot and extracts it into workDir. Online,
+// extraction streams alongside the download; offline, the cached archive is
+// extracted.
+func (f *GenesisForker) fetchSnapshot(ctx context.Context, opts ports.ForkOptions, snapshotURL, cacheKey, workDir string, progress ports.ProgressReporter) (bool, error) {
+	reportStep(progress, "Downloading snapshot", "running", snapshotURL)
+	if !opts.Offline {
+		fromCache, err := f.config.SnapshotFetcher.DownloadAndExtract(ctx, snapshotURL, cacheKey, workDir, opts.NoCache, progress)
+		if err != nil {
+			reportStep(progress, "Downloading snapshot", "failed", err.Error())
+			return false, fmt.Errorf("failed to download snapshot: %w", err)
+		}
+		cacheDetail := ""
+		if fromCache {
+			cacheDetail = "from cache"
+		}
+		reportStep(progress, "Downloading snapshot", "completed", cacheDetail)
+		reportStep(progress, "Extracting snapshot", "completed", "while downloading")
+
+		f.logger.Info("snapshot downloaded and extracted",
+			"fromCache", fromCache,
+			"snapshotURL", snapshotURL)
+		return fromCache, nil
 	}
-	reportStep(progress, "Downloading snapshot", "completed", cacheDetail)
 
-	f.logger.Info("snapshot downloaded",
-		"path", snapshotPath,
-		"fromCache", fromCache,
-		"snapshotURL", snapshotURL)
+	snapshotPath, fromCache, err := f.cachedSnapshot(cacheKey)
+	if err != nil {
+		reportStep(progress, "Downloading snapshot", "failed", err.Error())
+		return false, fmt.Errorf("failed to download snapshot: %w", err)
+	}
+	reportStep(progress, "Downloading snapshot", "completed", "from cache")
 
-	// Extract snapshot
 	reportStep(progress, "Extracting snapshot", "running", "")
 	if err := f.config.SnapshotFetcher.Extract(ctx, snapshotPath, workDir); err != nil {
 		reportStep(progress, "Extracting snapshot", "failed", err.Error())
-		return nil, fmt.Errorf("failed to extract snapshot: %w", err)
+		return false, fmt.Errorf("failed to extract snapshot: %w", err)
 	}
 	reportStep(progress, "Extracting snapshot", "completed", "")
+	return fromCache, nil
+}
+
+// cachedSnapshot returns a previously downloaded snapshot for offline
+// provisioning, regardless of cache expiry.
+func (f *GenesisForker) cachedSnapshot(cacheKey string) (string, bool, error) {
+	cached, ok := f.config.SnapshotFetcher.(cachedSnapshotFetcher)
+	if !ok {
+		return "", false, fmt.Errorf("%w: snapshot fetcher does not support cache lookups", ErrOfflineUnavailable)
+	}
+	path, found := cached.CachedSnapshot(cacheKey)
+	if !found {
+		return "", false, fmt.Errorf("%w: no cached snapshot for %s; provision once while online or use a local genesis file",
+			ErrOfflineUnavailable, cacheKey)
+	}
+	f.logger.Info("offline: using cached snapshot", "path", path)
+	return path, true, nil
+}
+
+// fetchSnapshotRPCGenesis fetches the source chain's RPC genesis, which the
+// snapshot export needs for chain parameters. Offline, it uses the cache.
+func (f *GenesisForker) fetchSnapshotRPCGenesis(ctx context.Context, opts ports.ForkOptions, progress ports.ProgressReporter) ([]byte, error) {
+	if opts.Offline {
+		reportStep(progress, "Fetching RPC genesis", "running", "from cache")
+		rpcGenesis, err := f.loadCachedGenesis(opts)
+		if err != nil {
+			reportStep(progress, "Fetching RPC genesis", "failed", err.Error())
+			return nil, err
+		}
+		reportStep(progress, "Fetching RPC genesis", "completed", "from cache")
+		return rpcGenesis, nil
+	}
 
-	// First, fetch RPC genesis for chain params
-	// The RPC genesis is required for the export command to read chain parameters
 	rpcURL := opts.Source.RPCURL
 	if rpcURL == "" && f.config.PluginGenesis != nil {
 		rpcURL = f.config.PluginGenesis.GetRPCEndpoint(opts.Source.NetworkType)
@@ -337,46 +585,32 @@ func (f *GenesisForker) forkFromSnapshotInfra(ctx context.Context, opts ports.Fo
 	reportStep(progress, "Fetching RPC genesis", "completed", "")
 
 	f.logger.Debug("RPC genesis fetched successfully", "size", len(rpcGenesis))
+	f.storeCachedGenesis(opts, rpcGenesis)
 
-	// Export genesis from snapshot
-	reportStep(progress, "Exporting state from snapshot", "running", "")
-	exportOpts := ports.StateExportOptions{
-		HomeDir:           workDir,
-		BinaryPath:        opts.BinaryPath,
-		RpcGenesis:        rpcGenesis,
-		CacheKey:          cacheKey,
-		SnapshotURL:       snapshotURL,
-		SnapshotFromCache: fromCache,
-	}
-
-	genesis, err := f.config.StateExportService.ExportFromSnapshot(ctx, exportOpts)
-	if err != nil {
-		reportStep(progress, "Exporting state from snapshot", "failed", err.Error())
-		return nil, fmt.Errorf("failed to export genesis from snapshot: %w", err)
-	}
-	reportStep(progress, "Exporting state from snapshot", "completed", "")
-
-	return genesis, nil
+	return rpcGenesis, nil
 }
 
-// forkFromLocal reads genesis from a local file
-func (f *GenesisForker) forkFromLocal(ctx context.Context, opts ports.ForkOptions) ([]byte, error) {
+// forkFromLocal returns the path of a local genesis file
+func (f *GenesisForker) forkFromLocal(ctx context.Context, opts ports.ForkOptions) (string, error) {
 	if opts.Source.LocalPath == "" {
-		return nil, fmt.Errorf("local path required for local mode")
+		return "", fmt.Errorf("local path required for local mode")
 	}
 
 	// Validate path is absolute and clean to prevent path traversal
 	cleanPath := filepath.Clean(opts.Source.LocalPath)
 	if !filepath.IsAbs(cleanPath) {
-		return nil, fmt.Errorf("local path must be absolute: %s", opts.Source.LocalPath)
+		return "", fmt.Errorf("local path must be absolute: %s", opts.Source.LocalPath)
 	}
 
-	genesis, err := os.ReadFile(cleanPath)
+	info, err := os.Stat(cleanPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read local genesis: %w", err)
+		return "", fmt.Errorf("failed to read local genesis: %w", err)
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("failed to read local genesis: %s is a directory", cleanPath)
 	}
 
-	return genesis, nil
+	return cleanPath, nil
 }
 
 // httpClientTimeout is the timeout for HTTP requests to RPC endpoints
@@ -422,31 +656,81 @@ func (f *GenesisForker) fetchGenesisHTTP(ctx context.Context, url string) ([]byt
 	return body, nil
 }
 
-// applyPatches applies generic patches to genesis.
-// This only handles chain_id patching. Network-specific patches (voting period,
-// unbonding time, inflation rate) are handled by the plugin's PatchGenesis method.
-func (f *GenesisForker) applyPatches(genesis []byte, opts types.GenesisPatchOptions) ([]byte, error) {
-	if opts.ChainID == "" {
-		return genesis, nil
+// rewrite streams the genesis file at src to dst pruned as opts selects and
+// with the generic patches applied, returning the source chain ID. This only
+// handles chain_id patching. Network-specific patches (voting period,
+// unbonding time, inflation rate) are handled by the plugin.
+func (f *GenesisForker) rewrite(dst, src string, opts types.GenesisPatchOptions) (string, error) {
+	transforms, err := PruneTransforms(opts.Prune)
+	if err != nil {
+		return "", fmt.Errorf("failed to prune genesis: %w", err)
+	}
+	set, err := genericPatchSections(opts)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := genesispatch.RewriteFile(dst, src, genesispatch.Rewrite{
+		Transforms: transforms,
+		Set:        set,
+		Read:       []string{"chain_id"},
+	})
+	if err != nil {
+		return "", err
 	}
 
-	var gen map[string]interface{}
-	if err := json.Unmarshal(genesis, &gen); err != nil {
-		return nil, fmt.Errorf("failed to parse genesis: %w", err)
+	for _, r := range result.Pruned {
+		f.logger.Info("pruned genesis", "transform", r.Name, "removed", r.Removed)
+	}
+	if len(transforms) > 0 {
+		var before, after int64
+		if info, err := os.Stat(src); err == nil {
+			before = info.Size()
+		}
+		if info, err := os.Stat(dst); err == nil {
+			after = info.Size()
+		}
+		f.logger.Info("genesis pruning complete", "sizeBefore", before, "sizeAfter", after)
 	}
 
-	gen["chain_id"] = opts.ChainID
+	var sourceChainID string
+	if raw, ok := result.Values["chain_id"]; ok {
+		if err := json.Unmarshal(raw, &sourceChainID); err != nil {
+			f.logger.Warn("failed to extract source chain ID", "error", err)
+		}
+	}
+	return sourceChainID, nil
+}
 
-	return json.MarshalIndent(gen, "", "  ")
+// genericPatchSections returns the genesis sections rewrite replaces.
+func genericPatchSections(opts types.GenesisPatchOptions) (map[string]json.RawMessage, error) {
+	sections := make(map[string]json.RawMessage)
+	if opts.ChainID != "" {
+		chainID, err := json.Marshal(opts.ChainID)
+		if err != nil {
+			return nil, err
+		}
+		sections["chain_id"] = chainID
+	}
+	return sections, nil
 }
 
-// extractChainID extracts chain_id from genesis
-func extractChainID(genesis []byte) (string, error) {
-	var gen struct {
-		ChainID string `json:"chain_id"`
+// PruneTransforms returns the genesis transforms selected by opts, in the
+// order they run.
+func PruneTransforms(opts types.GenesisPruneOptions) ([]genesispatch.Transform, error) {
+	var transforms []genesispatch.Transform
+	if opts.IBCPackets {
+		transforms = append(transforms, genesispatch.ClearIBCPackets())
 	}
-	if err := json.Unmarshal(genesis, &gen); err != nil {
-		return "", err
+	if opts.DustThreshold != "" {
+		t, err := genesispatch.TrimDustAccounts(opts.DustThreshold)
+		if err != nil {
+			return nil, err
+		}
+		transforms = append(transforms, t)
+	}
+	if opts.WasmCode {
+		transforms = append(transforms, genesispatch.DropWasmCode())
 	}
-	return gen.ChainID, nil
+	return transforms, nil
 }
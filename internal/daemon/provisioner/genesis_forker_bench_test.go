@@ -0,0 +1,193 @@
+//go:build linux
+
+// internal/daemon/provisioner/genesis_forker_bench_test.go
+package provisioner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+	"github.com/altuslabsxyz/devnet-builder/internal/plugin/types"
+)
+
+// The fork benchmarks compare the streaming pipeline with the in-memory one
+// it replaced on a 64 MB genesis. Besides time, each reports the peak RSS of
+// a child process running a single fork, since the heap in use misses what
+// the runtime holds on to:
+//
+//	go test ./internal/daemon/provisioner -run '^$' -bench GenesisFork -benchtime 3x
+
+// benchForkOptions prunes the genesis and patches its chain ID.
+var benchForkOptions = types.GenesisPatchOptions{
+	ChainID: "devnet-1",
+	Prune:   types.GenesisPruneOptions{IBCPackets: true, WasmCode: true},
+}
+
+// benchForks are the pipelines the benchmarks compare, by name.
+var benchForks = map[string]func(dst, src string) error{
+	"streaming": forkStreaming,
+	"in-memory": forkInMemory,
+}
+
+// forkStreaming forks the genesis file at src to dst with ForkToFile.
+func forkStreaming(dst, src string) error {
+	forker := NewGenesisForker(GenesisForkerConfig{
+		DataDir: filepath.Dir(dst),
+		Logger:  slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	_, err := forker.ForkToFile(context.Background(), ports.ForkOptions{
+		Source: types.GenesisSource{
+			Mode:      types.GenesisModeLocal,
+			LocalPath: src,
+		},
+		PatchOpts: benchForkOptions,
+	}, dst, ports.NilProgressReporter)
+	return err
+}
+
+// forkInMemory forks the genesis file at src to dst as the pipeline did
+// before streaming: it reads the whole genesis, decodes it, prunes and
+// patches the decoded document and encodes it again.
+func forkInMemory(dst, src string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc map[string]interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return err
+	}
+	transforms, err := PruneTransforms(benchForkOptions.Prune)
+	if err != nil {
+		return err
+	}
+	for _, t := range transforms {
+		if _, err := t.Apply(doc); err != nil {
+			return err
+		}
+	}
+	doc["chain_id"] = benchForkOptions.ChainID
+	out, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dst, out, 0644)
+}
+
+func BenchmarkGenesisFork_Streaming(b *testing.B) {
+	benchmarkGenesisFork(b, "streaming")
+}
+
+func BenchmarkGenesisFork_InMemory(b *testing.B) {
+	benchmarkGenesisFork(b, "in-memory")
+}
+
+func benchmarkGenesisFork(b *testing.B, pipeline string) {
+	dir := b.TempDir()
+	src := filepath.Join(dir, "genesis.json")
+	dst := filepath.Join(dir, "forked.json")
+	genesis := benchForkGenesis(64 << 20)
+	if err := os.WriteFile(src, genesis, 0644); err != nil {
+		b.Fatal(err)
+	}
+	fork := benchForks[pipeline]
+
+	b.SetBytes(int64(len(genesis)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := fork(dst, src); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+
+	reportPeakRSS(b, pipeline, dst, src)
+}
+
+// Environment of the reportPeakRSS child process.
+const (
+	benchForkPipelineEnv = "DVB_BENCH_FORK_PIPELINE"
+	benchForkSrcEnv      = "DVB_BENCH_FORK_SRC"
+	benchForkDstEnv      = "DVB_BENCH_FORK_DST"
+)
+
+// reportPeakRSS runs one fork with pipeline in a child process and reports
+// the child's peak RSS.
+func reportPeakRSS(b *testing.B, pipeline, dst, src string) {
+	b.Helper()
+	cmd := exec.Command(os.Args[0], "-test.run=^TestGenesisForkChild$", "-test.v")
+	cmd.Env = append(os.Environ(),
+		benchForkPipelineEnv+"="+pipeline,
+		benchForkSrcEnv+"="+src,
+		benchForkDstEnv+"="+dst,
+	)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		b.Fatalf("fork child failed: %v\n%s", err, out)
+	}
+	m := peakRSSPattern.FindSubmatch(out)
+	if m == nil {
+		b.Fatalf("fork child did not report its peak RSS:\n%s", out)
+	}
+	kb, _ := strconv.ParseFloat(string(m[1]), 64)
+	b.ReportMetric(kb/1024, "peak-RSS-MB")
+}
+
+var peakRSSPattern = regexp.MustCompile(`peak RSS: (\d+) kB`)
+
+// TestGenesisForkChild is the child process of reportPeakRSS. Run as a test,
+// it is skipped.
+func TestGenesisForkChild(t *testing.T) {
+	pipeline := os.Getenv(benchForkPipelineEnv)
+	if pipeline == "" {
+		t.Skip("child process of the genesis fork benchmarks")
+	}
+	fork, ok := benchForks[pipeline]
+	if !ok {
+		t.Fatalf("unknown pipeline %q", pipeline)
+	}
+	if err := fork(os.Getenv(benchForkDstEnv), os.Getenv(benchForkSrcEnv)); err != nil {
+		t.Fatal(err)
+	}
+
+	// VmHWM, unlike the rusage of the child, does not include the RSS the
+	// benchmark process had when it forked the child
+	status, err := os.ReadFile("/proc/self/status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(status), "\n") {
+		if hwm, ok := strings.CutPrefix(line, "VmHWM:"); ok {
+			t.Logf("peak RSS: %s", strings.TrimSpace(hwm))
+		}
+	}
+}
+
+// benchForkGenesis returns a genesis of about size bytes, most of it bank
+// balances.
+func benchForkGenesis(size int) []byte {
+	var b strings.Builder
+	b.WriteString(`{"chain_id":"bench-1","app_state":{"ibc":{"channel_genesis":{"commitments":[{"sequence":"1"}]}},"bank":{"balances":[`)
+	for i := 0; b.Len() < size; i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, `{"address":"cosmos1%038d","coins":[{"denom":"uatom","amount":"%d"}]}`, i, i*1000)
+	}
+	b.WriteString(`]}}}`)
+	return []byte(b.String())
+}
@@ -3,6 +3,9 @@ package provisioner
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
@@ -274,6 +277,109 @@ func TestGenesisForkerApplyChainIDPatch(t *testing.T) {
 	}
 }
 
+func TestGenesisForkerPrune(t *testing.T) {
+	tempDir := t.TempDir()
+
+	testGenesis := []byte(`{
+		"chain_id": "original-chain",
+		"app_state": {
+			"ibc": {"channel_genesis": {"commitments": [{"sequence": "1"}]}},
+			"wasm": {"codes": [{"code_id": "1", "code_bytes": "AGFzbQ=="}]}
+		}
+	}`)
+
+	genesisPath := filepath.Join(tempDir, "genesis.json")
+	if err := os.WriteFile(genesisPath, testGenesis, 0644); err != nil {
+		t.Fatalf("Failed to write test genesis: %v", err)
+	}
+
+	forker := NewGenesisForker(GenesisForkerConfig{
+		DataDir:       tempDir,
+		PluginGenesis: &mockPluginGenesis{},
+	})
+
+	opts := ports.ForkOptions{
+		Source: types.GenesisSource{
+			Mode:      types.GenesisModeLocal,
+			LocalPath: genesisPath,
+		},
+		PatchOpts: types.GenesisPatchOptions{
+			ChainID: "new-devnet-chain",
+			Prune:   types.GenesisPruneOptions{IBCPackets: true, WasmCode: true},
+		},
+	}
+
+	result, err := forker.Fork(context.Background(), opts, ports.NilProgressReporter)
+	if err != nil {
+		t.Fatalf("Fork failed: %v", err)
+	}
+	if strings.Contains(string(result.Genesis), "AGFzbQ==") || strings.Contains(string(result.Genesis), `"sequence"`) {
+		t.Errorf("Expected IBC packets and wasm code to be pruned, got %s", result.Genesis)
+	}
+	if result.SourceChainID != "original-chain" {
+		t.Errorf("Expected source chain ID 'original-chain', got '%s'", result.SourceChainID)
+	}
+
+	// An invalid dust threshold fails the fork
+	opts.PatchOpts.Prune = types.GenesisPruneOptions{DustThreshold: "dust"}
+	if _, err := forker.Fork(context.Background(), opts, ports.NilProgressReporter); err == nil || !strings.Contains(err.Error(), "invalid dust threshold") {
+		t.Errorf("Expected invalid dust threshold error, got %v", err)
+	}
+}
+
+func TestGenesisForkerForkToFile(t *testing.T) {
+	tempDir := t.TempDir()
+
+	genesisPath := filepath.Join(tempDir, "genesis.json")
+	testGenesis := `{"chain_id":"original-chain","app_state":{"wasm":{"codes":[{"code_id":"1","code_bytes":"AGFzbQ=="}]}}}`
+	if err := os.WriteFile(genesisPath, []byte(testGenesis), 0644); err != nil {
+		t.Fatalf("Failed to write test genesis: %v", err)
+	}
+
+	forker := NewGenesisForker(GenesisForkerConfig{
+		DataDir:       tempDir,
+		PluginGenesis: &mockPluginGenesis{},
+	})
+
+	outputPath := filepath.Join(tempDir, "forked.json")
+	result, err := forker.ForkToFile(context.Background(), ports.ForkOptions{
+		Source: types.GenesisSource{
+			Mode:      types.GenesisModeLocal,
+			LocalPath: genesisPath,
+		},
+		PatchOpts: types.GenesisPatchOptions{
+			ChainID: "new-devnet-chain",
+			Prune:   types.GenesisPruneOptions{WasmCode: true},
+		},
+	}, outputPath, ports.NilProgressReporter)
+	if err != nil {
+		t.Fatalf("ForkToFile failed: %v", err)
+	}
+	if result.Genesis != nil {
+		t.Errorf("Expected no genesis bytes in the result, got %d", len(result.Genesis))
+	}
+	if result.SourceChainID != "original-chain" {
+		t.Errorf("Expected source chain ID 'original-chain', got '%s'", result.SourceChainID)
+	}
+
+	forked, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read forked genesis: %v", err)
+	}
+	if !strings.Contains(string(forked), `"new-devnet-chain"`) || strings.Contains(string(forked), "AGFzbQ==") {
+		t.Errorf("Expected patched and pruned genesis, got %s", forked)
+	}
+
+	// The source is left alone and no intermediate files are left behind
+	source, err := os.ReadFile(genesisPath)
+	if err != nil || string(source) != testGenesis {
+		t.Errorf("Expected the source genesis to be unchanged, got %s (%v)", source, err)
+	}
+	if entries, _ := os.ReadDir(filepath.Join(tempDir, "genesis-work")); len(entries) != 0 {
+		t.Errorf("Expected an empty work dir, got %d entries", len(entries))
+	}
+}
+
 func TestGenesisForkerUnsupportedMode(t *testing.T) {
 	tempDir := t.TempDir()
 
@@ -423,7 +529,7 @@ func TestGenesisForkerNilPluginWithPatchOptions(t *testing.T) {
 		t.Fatalf("Fork should succeed even without plugin, got: %v", err)
 	}
 
-	// Chain ID should still be patched (handled by applyPatches, not plugin)
+	// Chain ID should still be patched (handled by writePatchedGenesis, not plugin)
 	if result.NewChainID != "devnet-1" {
 		t.Errorf("Expected new chain ID 'devnet-1', got '%s'", result.NewChainID)
 	}
@@ -466,3 +572,49 @@ func TestGenesisForkerForkFromLocalRelativePathRejected(t *testing.T) {
 		t.Errorf("Expected 'must be absolute' error, got: %v", err)
 	}
 }
+
+func TestGenesisForkerOfflineUsesCachedGenesis(t *testing.T) {
+	tempDir := t.TempDir()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":{"genesis":{"chain_id":"source-1"}}}`))
+	}))
+
+	forker := NewGenesisForker(GenesisForkerConfig{
+		DataDir:       tempDir,
+		PluginGenesis: &mockPluginGenesis{},
+	})
+	opts := ports.ForkOptions{
+		Source: types.GenesisSource{
+			Mode:        types.GenesisModeRPC,
+			RPCURL:      srv.URL,
+			NetworkType: "mainnet",
+		},
+	}
+
+	ctx := context.Background()
+
+	// Offline before anything is cached fails fast
+	opts.Offline = true
+	_, err := forker.Fork(ctx, opts, ports.NilProgressReporter)
+	if !errors.Is(err, ErrOfflineUnavailable) {
+		t.Fatalf("Expected ErrOfflineUnavailable, got %v", err)
+	}
+
+	// An online fork caches the source genesis
+	opts.Offline = false
+	if _, err := forker.Fork(ctx, opts, ports.NilProgressReporter); err != nil {
+		t.Fatalf("Online fork failed: %v", err)
+	}
+
+	// Offline fork succeeds without the RPC endpoint
+	srv.Close()
+	opts.Offline = true
+	result, err := forker.Fork(ctx, opts, ports.NilProgressReporter)
+	if err != nil {
+		t.Fatalf("Offline fork failed: %v", err)
+	}
+	if result.SourceChainID != "source-1" {
+		t.Errorf("Expected source chain ID source-1, got %q", result.SourceChainID)
+	}
+}
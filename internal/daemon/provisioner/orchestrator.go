@@ -2,13 +2,17 @@
 package provisioner
 
 import (
+	"bytes"
 	"context"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"math/big"
+	"net"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -17,11 +21,19 @@ import (
 
 	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/configpatch"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/controller"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/genesispatch"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/hooks"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/keys"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/nodekeys"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/retry"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/signer"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/subnet"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
 	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/nodeconfig"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/prereq"
 	plugintypes "github.com/altuslabsxyz/devnet-builder/internal/plugin/types"
 	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
 )
@@ -120,8 +132,25 @@ type OrchestratorConfig struct {
 	PluginGenesis plugintypes.PluginGenesis
 
 	// Bech32Prefix is the address prefix for this network (e.g., "stable", "cosmos").
-	// Used to derive validator operator addresses from consensus keys.
+	// Used to encode validator operator and test account addresses.
 	Bech32Prefix string
+
+	// EVM reports whether the network uses Ethereum-style account keys.
+	// Selects how validator and test account keys are derived (see package keys).
+	EVM bool
+
+	// ConfigOverrides returns the network plugin's config.toml and app.toml
+	// overrides for a node (optional). They are merged into the node's
+	// config files before devnet-builder's own settings.
+	ConfigOverrides func(node *types.Node, numValidators int) (configToml, appToml []byte, err error)
+
+	// StatDisk measures free disk space for the preflight check (optional).
+	// Defaults to prereq.StatDisk.
+	StatDisk func(path string) (prereq.DiskUsage, error)
+
+	// Retry holds the retry policies of the building, forking and starting
+	// phases. The zero value attempts each once.
+	Retry retry.Policies
 }
 
 // =============================================================================
@@ -183,6 +212,17 @@ func (o *ProvisioningOrchestrator) SetStepProgressReporter(reporter ports.Progre
 	o.config.StepProgressReporter = reporter
 }
 
+// reportStep reports sub-step progress to the configured reporter, if any.
+func (o *ProvisioningOrchestrator) reportStep(step ports.StepProgress) {
+	o.mu.RLock()
+	reporter := o.config.StepProgressReporter
+	o.mu.RUnlock()
+
+	if reporter != nil {
+		reporter.ReportStep(step)
+	}
+}
+
 // setPhase updates the current phase and notifies the progress callback
 func (o *ProvisioningOrchestrator) setPhase(phase ProvisioningPhase, message string) {
 	o.mu.Lock()
@@ -200,6 +240,30 @@ func (o *ProvisioningOrchestrator) setPhase(phase ProvisioningPhase, message str
 	}
 }
 
+// checkpoint records the phase in progress, so that a daemon restarted in
+// the middle of it can tell how far provisioning got.
+func (o *ProvisioningOrchestrator) checkpoint(state *provisionState, dataDir string, phase ProvisioningPhase) {
+	state.Phase = phase
+	if err := state.save(dataDir); err != nil {
+		o.logger.Warn("failed to record provisioning checkpoint", "phase", phase, "error", err)
+	}
+}
+
+// finishCheckpoint clears the phase in progress once Execute returns, and
+// the interruptions once provisioning completes.
+func (o *ProvisioningOrchestrator) finishCheckpoint(state *provisionState, dataDir string) {
+	if state.Phase == "" && state.Interruptions == 0 {
+		return
+	}
+	state.Phase = ""
+	if o.GetError() == nil {
+		state.Interruptions = 0
+	}
+	if err := state.save(dataDir); err != nil {
+		o.logger.Warn("failed to record provisioning checkpoint", "error", err)
+	}
+}
+
 // setError records an error and transitions to Failed phase
 func (o *ProvisioningOrchestrator) setError(err error) {
 	o.mu.Lock()
@@ -233,6 +297,20 @@ func (o *ProvisioningOrchestrator) Execute(ctx context.Context, opts ports.Provi
 		return nil, o.lastErr
 	}
 
+	// Preflight (still Pending): fail fast before any download if the disk
+	// can't hold the snapshot, its extraction and the node directories
+	if err := o.checkDiskSpace(ctx, opts); err != nil {
+		o.setError(fmt.Errorf("preflight failed: %w", err))
+		return nil, o.lastErr
+	}
+
+	// Phases completed by an earlier attempt are skipped
+	state := loadProvisionState(opts.DataDir)
+	if state.Phase != "" {
+		state.Interruptions++
+	}
+	defer o.finishCheckpoint(state, opts.DataDir)
+
 	// Track the binary path (may be provided or built)
 	binaryPath := opts.BinaryPath
 
@@ -244,8 +322,9 @@ func (o *ProvisioningOrchestrator) Execute(ctx context.Context, opts ports.Provi
 		}
 
 		o.setPhase(PhaseBuilding, "Building binary from source")
+		o.checkpoint(state, opts.DataDir, PhaseBuilding)
 
-		buildResult, err := o.executeBuildPhase(ctx, opts)
+		buildResult, err := o.executeBuildPhase(ctx, opts, state)
 		if err != nil {
 			o.setError(fmt.Errorf("building phase failed: %w", err))
 			return nil, o.lastErr
@@ -267,13 +346,20 @@ func (o *ProvisioningOrchestrator) Execute(ctx context.Context, opts ports.Provi
 	}
 
 	o.setPhase(PhaseForking, "Forking genesis from source")
+	o.checkpoint(state, opts.DataDir, PhaseForking)
 
-	forkResult, err := o.executeForkPhase(ctx, opts, binaryPath)
+	forkResult, err := o.executeForkPhase(ctx, opts, binaryPath, state)
 	if err != nil {
 		o.setError(fmt.Errorf("forking phase failed: %w", err))
 		return nil, o.lastErr
 	}
 
+	// postFork hooks may edit the forked genesis before it is distributed
+	if err := o.runHooks(ctx, hooks.PostFork, opts, binaryPath, nil); err != nil {
+		o.setError(err)
+		return nil, o.lastErr
+	}
+
 	// Phase 3: Initializing
 	if err := ctx.Err(); err != nil {
 		o.setError(fmt.Errorf("context cancelled: %w", err))
@@ -281,6 +367,7 @@ func (o *ProvisioningOrchestrator) Execute(ctx context.Context, opts ports.Provi
 	}
 
 	o.setPhase(PhaseInitializing, "Initializing node directories")
+	o.checkpoint(state, opts.DataDir, PhaseInitializing)
 
 	nodes, err := o.executeInitPhase(ctx, opts, binaryPath, forkResult)
 	if err != nil {
@@ -288,6 +375,12 @@ func (o *ProvisioningOrchestrator) Execute(ctx context.Context, opts ports.Provi
 		return nil, o.lastErr
 	}
 
+	// In daemon mode the controller starts the nodes once this returns
+	if err := o.runHooks(ctx, hooks.PreStart, opts, binaryPath, nodes); err != nil {
+		o.setError(err)
+		return nil, o.lastErr
+	}
+
 	// Phase 4: Starting (skip if SkipStart is true - daemon mode)
 	if opts.SkipStart {
 		o.logger.Info("skipping start phase (SkipStart=true, daemon mode)")
@@ -299,6 +392,7 @@ func (o *ProvisioningOrchestrator) Execute(ctx context.Context, opts ports.Provi
 		}
 
 		o.setPhase(PhaseStarting, "Starting node processes")
+		o.checkpoint(state, opts.DataDir, PhaseStarting)
 
 		if err := o.executeStartPhase(ctx, nodes); err != nil {
 			o.setError(fmt.Errorf("starting phase failed: %w", err))
@@ -314,6 +408,7 @@ func (o *ProvisioningOrchestrator) Execute(ctx context.Context, opts ports.Provi
 		// Skip health checking if timeout is negative (explicit opt-out)
 		if opts.HealthCheckTimeout >= 0 {
 			o.setPhase(PhaseHealthChecking, "Verifying node health")
+			o.checkpoint(state, opts.DataDir, PhaseHealthChecking)
 
 			healthResult, err := o.executeHealthPhase(ctx, nodes, opts.HealthCheckTimeout)
 			if err != nil {
@@ -323,6 +418,10 @@ func (o *ProvisioningOrchestrator) Execute(ctx context.Context, opts ports.Provi
 
 			// Determine final phase based on health check result
 			if healthResult.AllHealthy {
+				if err := o.runHooks(ctx, hooks.PostHealthy, opts, binaryPath, nodes); err != nil {
+					o.setError(err)
+					return nil, o.lastErr
+				}
 				o.setPhase(PhaseRunning, "Devnet is operational")
 			} else {
 				o.setPhase(PhaseDegraded, fmt.Sprintf("Devnet running but degraded (%d/%d nodes healthy)", healthResult.HealthyCount, healthResult.TotalCount))
@@ -354,23 +453,78 @@ func (o *ProvisioningOrchestrator) Execute(ctx context.Context, opts ports.Provi
 	return result, nil
 }
 
-// executeBuildPhase handles the building phase
-func (o *ProvisioningOrchestrator) executeBuildPhase(ctx context.Context, opts ports.ProvisionOptions) (*builder.BuildResult, error) {
+// runHooks runs the phase's hooks, reporting them as a sub-step.
+func (o *ProvisioningOrchestrator) runHooks(ctx context.Context, phase string, opts ports.ProvisionOptions, binaryPath string, nodes []*types.Node) error {
+	if !hasHooks(opts.Hooks, phase) {
+		return nil
+	}
+
+	step := fmt.Sprintf("Running %s hooks", phase)
+	o.reportStep(ports.StepProgress{Name: step, Status: "running"})
+	err := hooks.NewRunner(o.logger).Run(ctx, phase, opts.Hooks, hooks.Devnet{
+		Namespace:  opts.Namespace,
+		Name:       opts.DevnetName,
+		ChainID:    opts.ChainID,
+		Network:    opts.Network,
+		DataDir:    opts.DataDir,
+		BinaryPath: binaryPath,
+		Nodes:      nodes,
+	})
+	if err != nil {
+		o.reportStep(ports.StepProgress{Name: step, Status: "failed", Detail: err.Error()})
+		return fmt.Errorf("%s hooks failed: %w", phase, err)
+	}
+	o.reportStep(ports.StepProgress{Name: step, Status: "completed"})
+	return nil
+}
+
+// hasHooks reports whether any of the hooks run in the phase.
+func hasHooks(list []ports.Hook, phase string) bool {
+	return slices.ContainsFunc(list, func(h ports.Hook) bool { return h.Phase == phase })
+}
+
+// executeBuildPhase handles the building phase. A build completed by an
+// earlier attempt is reused.
+func (o *ProvisioningOrchestrator) executeBuildPhase(ctx context.Context, opts ports.ProvisionOptions, state *provisionState) (*builder.BuildResult, error) {
 	o.logger.Info("starting build phase",
 		"version", opts.BinaryVersion,
 		"network", opts.Network,
 	)
 
 	spec := builder.BuildSpec{
-		GitRef:     opts.BinaryVersion,
-		PluginName: opts.Network,
+		GitRef:          opts.BinaryVersion,
+		PluginName:      opts.Network,
+		ForceBuild:      opts.ForceBuild,
+		Offline:         opts.Offline,
+		AllowUnverified: opts.AllowUnverified,
+	}
+
+	if binaryPath, ok := state.completedBuild(spec); ok {
+		o.logger.Info("build phase already completed, resuming", "binaryPath", binaryPath)
+		o.reportStep(ports.StepProgress{Name: "Building binary", Status: "completed", Detail: opts.BinaryVersion + " (from previous attempt)"})
+		return &builder.BuildResult{BinaryPath: binaryPath}, nil
 	}
 
-	result, err := o.config.BinaryBuilder.Build(ctx, spec)
+	o.reportStep(ports.StepProgress{Name: "Building binary", Status: "running", Detail: opts.BinaryVersion})
+
+	var result *builder.BuildResult
+	err := retry.Do(ctx, o.config.Retry.Build, func(ctx context.Context) error {
+		var err error
+		result, err = o.config.BinaryBuilder.Build(ctx, spec)
+		return err
+	}, o.onRetry("Building binary", o.config.Retry.Build))
 	if err != nil {
+		o.reportStep(ports.StepProgress{Name: "Building binary", Status: "failed", Detail: opts.BinaryVersion, Error: err.Error()})
 		return nil, fmt.Errorf("binary build failed: %w", err)
 	}
 
+	o.reportStep(ports.StepProgress{Name: "Building binary", Status: "completed", Detail: opts.BinaryVersion})
+
+	state.Build = &buildMarker{Key: stepKey(spec), BinaryPath: result.BinaryPath, CompletedAt: time.Now()}
+	if err := state.save(opts.DataDir); err != nil {
+		o.logger.Warn("failed to record completed build phase", "error", err)
+	}
+
 	o.logger.Info("build phase completed",
 		"binaryPath", result.BinaryPath,
 	)
@@ -378,27 +532,21 @@ func (o *ProvisioningOrchestrator) executeBuildPhase(ctx context.Context, opts p
 	return result, nil
 }
 
-// executeForkPhase handles the genesis forking phase
-func (o *ProvisioningOrchestrator) executeForkPhase(ctx context.Context, opts ports.ProvisionOptions, binaryPath string) (*ports.ForkResult, error) {
+// executeForkPhase handles the genesis forking phase. A fork completed by an
+// earlier attempt is reused while the genesis it wrote is unchanged.
+func (o *ProvisioningOrchestrator) executeForkPhase(ctx context.Context, opts ports.ProvisionOptions, binaryPath string, state *provisionState) (*ports.ForkResult, error) {
 	o.logger.Info("starting fork phase",
 		"mode", opts.GenesisSource.Mode,
 		"chainID", opts.ChainID,
 	)
 
-	forkOpts := ports.ForkOptions{
-		Source:     opts.GenesisSource,
-		BinaryPath: binaryPath,
-		PatchOpts:  opts.GenesisPatchOpts,
-	}
-
-	// Ensure chain ID is set in patch options
-	if forkOpts.PatchOpts.ChainID == "" {
-		forkOpts.PatchOpts.ChainID = opts.ChainID
-	}
+	forkOpts := forkOptions(opts, binaryPath)
+	genesisPath := filepath.Join(opts.DataDir, "genesis.json")
 
-	// Propagate binary version to patch options for genesis modification
-	if forkOpts.PatchOpts.BinaryVersion == "" {
-		forkOpts.PatchOpts.BinaryVersion = opts.BinaryVersion
+	if result, ok := state.completedFork(forkOpts, genesisPath); ok {
+		o.logger.Info("fork phase already completed, resuming", "genesisPath", genesisPath)
+		o.reportStep(ports.StepProgress{Name: "Forking genesis", Status: "completed", Detail: "from previous attempt"})
+		return result, nil
 	}
 
 	// Use configured progress reporter if available, otherwise no-op
@@ -407,18 +555,35 @@ func (o *ProvisioningOrchestrator) executeForkPhase(ctx context.Context, opts po
 		progress = ports.NilProgressReporter
 	}
 
-	result, err := o.config.GenesisForker.Fork(ctx, forkOpts, progress)
+	// Save genesis to data directory
+	if err := os.MkdirAll(opts.DataDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	var result *ports.ForkResult
+	err := retry.Do(ctx, o.config.Retry.Snapshot, func(ctx context.Context) error {
+		var err error
+		result, err = o.forkToFile(ctx, forkOpts, genesisPath, progress)
+		return err
+	}, o.onRetry("Forking genesis", o.config.Retry.Snapshot))
 	if err != nil {
 		return nil, fmt.Errorf("genesis fork failed: %w", err)
 	}
 
-	// Save genesis to data directory
-	genesisPath := filepath.Join(opts.DataDir, "genesis.json")
-	if err := os.MkdirAll(opts.DataDir, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create data directory: %w", err)
+	genesisSHA256, err := fileDigest(genesisPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read genesis file: %w", err)
 	}
-	if err := os.WriteFile(genesisPath, result.Genesis, 0644); err != nil {
-		return nil, fmt.Errorf("failed to write genesis file: %w", err)
+	state.Fork = &forkMarker{
+		Key:           stepKey(forkOpts),
+		GenesisSHA256: genesisSHA256,
+		SourceChainID: result.SourceChainID,
+		NewChainID:    result.NewChainID,
+		SourceMode:    string(result.SourceMode),
+		CompletedAt:   time.Now(),
+	}
+	if err := state.save(opts.DataDir); err != nil {
+		o.logger.Warn("failed to record completed fork phase", "error", err)
 	}
 
 	o.logger.Info("fork phase completed",
@@ -430,6 +595,24 @@ func (o *ProvisioningOrchestrator) executeForkPhase(ctx context.Context, opts po
 	return result, nil
 }
 
+// forkToFile forks genesis into the file at path, streaming it there when
+// the forker supports it.
+func (o *ProvisioningOrchestrator) forkToFile(ctx context.Context, opts ports.ForkOptions, path string, progress ports.ProgressReporter) (*ports.ForkResult, error) {
+	if forker, ok := o.config.GenesisForker.(ports.FileGenesisForker); ok {
+		return forker.ForkToFile(ctx, opts, path, progress)
+	}
+
+	result, err := o.config.GenesisForker.Fork(ctx, opts, progress)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, result.Genesis, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write genesis file: %w", err)
+	}
+	result.Genesis = nil
+	return result, nil
+}
+
 // executeInitPhase handles the node initialization phase
 func (o *ProvisioningOrchestrator) executeInitPhase(ctx context.Context, opts ports.ProvisionOptions, binaryPath string, forkResult *ports.ForkResult) ([]*types.Node, error) {
 	o.logger.Info("starting init phase",
@@ -440,6 +623,18 @@ func (o *ProvisioningOrchestrator) executeInitPhase(ctx context.Context, opts po
 	totalNodes := opts.NumValidators + opts.NumFullNodes
 	nodes := make([]*types.Node, 0, totalNodes)
 
+	// reportInit reports how many nodes are initialized so far.
+	reportInit := func(status, detail string) {
+		o.reportStep(ports.StepProgress{
+			Name:    "Initializing nodes",
+			Status:  status,
+			Current: int64(len(nodes)),
+			Total:   int64(totalNodes),
+			Unit:    "nodes",
+			Detail:  detail,
+		})
+	}
+
 	// Initialize validators
 	for i := 0; i < opts.NumValidators; i++ {
 		if err := ctx.Err(); err != nil {
@@ -451,8 +646,10 @@ func (o *ProvisioningOrchestrator) executeInitPhase(ctx context.Context, opts po
 			"total", opts.NumValidators,
 		)
 
+		reportInit("running", fmt.Sprintf("validator-%d", i))
 		node, err := o.initializeNode(ctx, opts, binaryPath, i, "validator")
 		if err != nil {
+			reportInit("failed", fmt.Sprintf("validator-%d", i))
 			return nil, fmt.Errorf("failed to initialize validator %d: %w", i, err)
 		}
 		nodes = append(nodes, node)
@@ -470,25 +667,29 @@ func (o *ProvisioningOrchestrator) executeInitPhase(ctx context.Context, opts po
 		)
 
 		nodeIndex := opts.NumValidators + i
+		reportInit("running", fmt.Sprintf("fullnode-%d", i))
 		node, err := o.initializeNode(ctx, opts, binaryPath, nodeIndex, "fullnode")
 		if err != nil {
+			reportInit("failed", fmt.Sprintf("fullnode-%d", i))
 			return nil, fmt.Errorf("failed to initialize fullnode %d: %w", i, err)
 		}
 		nodes = append(nodes, node)
 	}
+	reportInit("completed", "")
 
 	// Write forked genesis to all node config directories
 	// This is critical: the chain init command creates a placeholder genesis,
 	// but we need to overwrite it with the actual forked genesis from the fork phase.
-	if forkResult != nil && len(forkResult.Genesis) > 0 {
+	masterGenesisPath := filepath.Join(opts.DataDir, "genesis.json")
+	if info, err := os.Stat(masterGenesisPath); forkResult != nil && err == nil && info.Size() > 0 {
 		o.logger.Info("distributing forked genesis to nodes",
 			"nodeCount", len(nodes),
-			"genesisSize", len(forkResult.Genesis),
+			"genesisSize", info.Size(),
 		)
 
 		for _, node := range nodes {
 			genesisPath := filepath.Join(node.Spec.HomeDir, "config", "genesis.json")
-			if err := os.WriteFile(genesisPath, forkResult.Genesis, 0644); err != nil {
+			if err := copyGenesis(genesisPath, masterGenesisPath); err != nil {
 				return nil, fmt.Errorf("failed to write genesis to node %s: %w", node.Metadata.Name, err)
 			}
 			o.logger.Debug("genesis written to node",
@@ -502,11 +703,16 @@ func (o *ProvisioningOrchestrator) executeInitPhase(ctx context.Context, opts po
 	if o.config.PluginGenesis != nil && opts.NumValidators > 0 {
 		o.logger.Info("reading validator keys for genesis injection")
 
-		validators, err := o.readValidatorKeys(nodes)
+		validators, err := o.readValidatorKeys(nodes, keys.ForSeed(opts.Seed))
 		if err != nil {
 			return nil, fmt.Errorf("failed to read validator keys: %w", err)
 		}
 
+		// Split the stake by the devnet's voting power profile
+		if err := o.applyVotingPower(validators, filepath.Join(nodes[0].Spec.HomeDir, "config", "genesis.json"), opts); err != nil {
+			return nil, err
+		}
+
 		if len(validators) > 0 {
 			patchOpts := plugintypes.GenesisPatchOptions{
 				ChainID:    opts.ChainID,
@@ -537,19 +743,13 @@ func (o *ProvisioningOrchestrator) executeInitPhase(ctx context.Context, opts po
 				)
 
 				// Copy patched genesis to all nodes and master
-				patchedGenesis, err := os.ReadFile(outputPath)
-				if err != nil {
-					return nil, fmt.Errorf("failed to read patched genesis: %w", err)
-				}
-
 				for _, node := range nodes {
 					genesisPath := filepath.Join(node.Spec.HomeDir, "config", "genesis.json")
-					if err := os.WriteFile(genesisPath, patchedGenesis, 0644); err != nil {
+					if err := copyGenesis(genesisPath, outputPath); err != nil {
 						return nil, fmt.Errorf("failed to redistribute genesis to %s: %w", node.Metadata.Name, err)
 					}
 				}
-				masterGenesisPath := filepath.Join(opts.DataDir, "genesis.json")
-				if err := os.WriteFile(masterGenesisPath, patchedGenesis, 0644); err != nil {
+				if err := copyGenesis(masterGenesisPath, outputPath); err != nil {
 					return nil, fmt.Errorf("failed to update master genesis: %w", err)
 				}
 			} else {
@@ -575,7 +775,6 @@ func (o *ProvisioningOrchestrator) executeInitPhase(ctx context.Context, opts po
 						return nil, fmt.Errorf("failed to redistribute genesis to %s: %w", node.Metadata.Name, err)
 					}
 				}
-				masterGenesisPath := filepath.Join(opts.DataDir, "genesis.json")
 				if err := os.WriteFile(masterGenesisPath, rePatchedGenesis, 0644); err != nil {
 					return nil, fmt.Errorf("failed to update master genesis: %w", err)
 				}
@@ -583,11 +782,57 @@ func (o *ProvisioningOrchestrator) executeInitPhase(ctx context.Context, opts po
 		}
 	}
 
+	// Post-init: pin the genesis time of seeded devnets so their genesis
+	// files match
+	if err := o.applySeedGenesisTime(nodes, opts); err != nil {
+		return nil, fmt.Errorf("failed to set genesis time: %w", err)
+	}
+
+	// Post-init: fund the spec's extra accounts alongside the validator accounts
+	if err := o.applyFundedAccounts(nodes, opts); err != nil {
+		return nil, fmt.Errorf("failed to add funded accounts: %w", err)
+	}
+
+	// Post-init: apply user genesis overrides last so they win over plugin patches
+	if err := o.applyGenesisOverrides(nodes, opts); err != nil {
+		return nil, fmt.Errorf("failed to apply genesis overrides: %w", err)
+	}
+
+	// Post-init: shorten epochs so epoch-driven logic runs at devnet speed
+	if err := o.applyEpochDuration(nodes, opts); err != nil {
+		return nil, fmt.Errorf("failed to set epoch durations: %w", err)
+	}
+
+	// Post-init: merge the plugin's config overrides under devnet-builder's settings
+	if err := o.applyPluginConfig(nodes, opts.NumValidators); err != nil {
+		return nil, fmt.Errorf("failed to apply plugin config overrides: %w", err)
+	}
+
 	// Post-init: configure node networking (persistent peers, ports, P2P settings)
-	if err := o.configureNodeNetworking(ctx, nodes); err != nil {
+	if err := o.configureNodeNetworking(ctx, nodes, opts.DockerNetwork); err != nil {
 		return nil, fmt.Errorf("failed to configure node networking: %w", err)
 	}
 
+	// Post-init: override the default block time
+	if err := o.applyBlockTime(nodes, opts.BlockTime); err != nil {
+		return nil, fmt.Errorf("failed to set block time: %w", err)
+	}
+
+	// Post-init: make remote-signer validators listen for their signer
+	if err := o.configureRemoteSigners(nodes, opts); err != nil {
+		return nil, fmt.Errorf("failed to configure remote signers: %w", err)
+	}
+
+	// Post-init: apply resource profile tunings (pruning, indexer, mempool)
+	if err := o.applyProfile(nodes, opts.Profile); err != nil {
+		return nil, fmt.Errorf("failed to apply profile %q: %w", opts.Profile, err)
+	}
+
+	// Post-init: merge the spec's per-node config snippets last so they win
+	if err := o.applyNodeConfigs(nodes, opts.NodeConfigs); err != nil {
+		return nil, fmt.Errorf("failed to apply node configs: %w", err)
+	}
+
 	o.logger.Info("init phase completed",
 		"nodeCount", len(nodes),
 	)
@@ -595,6 +840,10 @@ func (o *ProvisioningOrchestrator) executeInitPhase(ctx context.Context, opts po
 	return nodes, nil
 }
 
+// remoteBindAddress is the address nodes on remote hosts and in containers
+// listen on, so they are reached on any of the host's addresses.
+const remoteBindAddress = "0.0.0.0"
+
 // initializeNode initializes a single node
 func (o *ProvisioningOrchestrator) initializeNode(ctx context.Context, opts ports.ProvisionOptions, binaryPath string, index int, role string) (*types.Node, error) {
 	moniker := fmt.Sprintf("%s-%s-%d", opts.DevnetName, role, index)
@@ -611,14 +860,54 @@ func (o *ProvisioningOrchestrator) initializeNode(ctx context.Context, opts port
 		return nil, fmt.Errorf("failed to create node directory: %w", err)
 	}
 
+	// Install operator-provided and seed-derived keys first: init keeps
+	// existing key files, so it generates keys only for the other nodes
+	configDir := filepath.Join(nodeDir, "config")
+	nodeKeys, imported := importedKeys(opts, role, index)
+	if imported {
+		o.logger.Info("using imported validator keys", "moniker", moniker)
+	}
+	if opts.Seed != "" {
+		derived, err := keys.ForSeed(opts.Seed).NodeKeys(role, index)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive node keys: %w", err)
+		}
+		if nodeKeys.PrivValidatorKey == "" {
+			nodeKeys.PrivValidatorKey = derived.PrivValidatorKey
+		}
+		if nodeKeys.NodeKey == "" {
+			nodeKeys.NodeKey = derived.NodeKey
+		}
+	}
+	install := imported || opts.Seed != ""
+	if install {
+		if err := nodekeys.Install(configDir, nodeKeys); err != nil {
+			return nil, fmt.Errorf("failed to install node keys: %w", err)
+		}
+	}
+
 	// Initialize node
 	if err := o.config.NodeInitializer.Initialize(ctx, nodeDir, moniker, opts.ChainID); err != nil {
 		return nil, fmt.Errorf("node initialization failed: %w", err)
 	}
 
-	// Compute node address from subnet allocation
+	// Some init commands regenerate keys regardless; put ours back
+	if install && !nodekeys.Installed(configDir, nodeKeys) {
+		o.logger.Debug("init replaced node keys, reinstalling", "moniker", moniker)
+		if err := nodekeys.Install(configDir, nodeKeys); err != nil {
+			return nil, fmt.Errorf("failed to install node keys: %w", err)
+		}
+	}
+
+	// Compute node address from the node's remote host or subnet allocation
 	var nodeAddress string
-	if opts.Subnet > 0 {
+	bindAddress := opts.BindAddress
+	if len(opts.HostAddresses) > 0 {
+		nodeAddress = opts.HostAddresses[index%len(opts.HostAddresses)]
+		bindAddress = remoteBindAddress
+	} else if opts.BindAddress != "" {
+		nodeAddress = dvbtypes.DialHost(opts.BindAddress)
+	} else if opts.Subnet > 0 {
 		nodeAddress = subnet.NodeIP(opts.Subnet, index)
 	}
 
@@ -628,15 +917,17 @@ func (o *ProvisioningOrchestrator) initializeNode(ctx context.Context, opts port
 			Name: moniker,
 		},
 		Spec: types.NodeSpec{
-			DevnetRef:  opts.DevnetName,
-			Index:      index,
-			Role:       role,
-			BinaryPath: binaryPath,
-			HomeDir:    nodeDir,
-			Address:    nodeAddress,
-			Desired:    types.NodePhaseRunning,
-			ChainID:    opts.ChainID,
-			Network:    opts.Network,
+			DevnetRef:   opts.DevnetName,
+			Index:       index,
+			Role:        role,
+			BinaryPath:  binaryPath,
+			HomeDir:     nodeDir,
+			Address:     nodeAddress,
+			BindAddress: bindAddress,
+			PortLayout:  opts.PortLayout,
+			Desired:     types.NodePhaseRunning,
+			ChainID:     opts.ChainID,
+			Network:     opts.Network,
 		},
 		Status: types.NodeStatus{
 			Phase: types.NodePhasePending,
@@ -646,6 +937,19 @@ func (o *ProvisioningOrchestrator) initializeNode(ctx context.Context, opts port
 	return node, nil
 }
 
+// importedKeys returns the operator-provided keys of a validator slot.
+func importedKeys(opts ports.ProvisionOptions, role string, index int) (nodekeys.Keys, bool) {
+	if role != "validator" {
+		return nodekeys.Keys{}, false
+	}
+	for _, k := range opts.ValidatorKeys {
+		if k.Index == index {
+			return nodekeys.Keys(k), true
+		}
+	}
+	return nodekeys.Keys{}, false
+}
+
 // executeStartPhase handles the node starting phase
 func (o *ProvisioningOrchestrator) executeStartPhase(ctx context.Context, nodes []*types.Node) error {
 	o.logger.Info("starting nodes",
@@ -666,7 +970,10 @@ func (o *ProvisioningOrchestrator) executeStartPhase(ctx context.Context, nodes
 			RestartPolicy: runtime.DefaultRestartPolicy(),
 		}
 
-		if err := o.config.NodeRuntime.StartNode(ctx, node, startOpts); err != nil {
+		err := retry.Do(ctx, o.config.Retry.NodeStart, func(ctx context.Context) error {
+			return o.config.NodeRuntime.StartNode(ctx, node, startOpts)
+		}, o.onRetry("Starting "+node.Metadata.Name, o.config.Retry.NodeStart))
+		if err != nil {
 			return fmt.Errorf("failed to start node %s: %w", node.Metadata.Name, err)
 		}
 	}
@@ -678,6 +985,26 @@ func (o *ProvisioningOrchestrator) executeStartPhase(ctx context.Context, nodes
 	return nil
 }
 
+// onRetry returns the retry callback of a step, which logs the failed
+// attempt and reports it as sub-step progress.
+func (o *ProvisioningOrchestrator) onRetry(step string, policy retry.Policy) func(attempt int, err error, wait time.Duration) {
+	return func(attempt int, err error, wait time.Duration) {
+		o.logger.Warn("provisioning step failed, retrying",
+			"step", step,
+			"attempt", attempt,
+			"maxAttempts", policy.MaxAttempts,
+			"wait", wait,
+			"error", err,
+		)
+		o.reportStep(ports.StepProgress{
+			Name:   step,
+			Status: "running",
+			Detail: fmt.Sprintf("attempt %d/%d failed, retrying in %s", attempt, policy.MaxAttempts, wait),
+			Error:  err.Error(),
+		})
+	}
+}
+
 // DefaultHealthCheckTimeout is the default duration to wait for nodes to become healthy.
 const DefaultHealthCheckTimeout = 2 * time.Minute
 
@@ -721,10 +1048,23 @@ func (o *ProvisioningOrchestrator) executeHealthPhase(ctx context.Context, nodes
 		"timeout", timeout,
 	)
 
-	deadline := time.Now().Add(timeout)
+	start := time.Now()
+	deadline := start.Add(timeout)
 	ticker := time.NewTicker(DefaultHealthCheckInterval)
 	defer ticker.Stop()
 
+	// reportHealth reports the health check as a countdown to the deadline.
+	reportHealth := func(status string, healthyCount int) {
+		o.reportStep(ports.StepProgress{
+			Name:    "Waiting for healthy nodes",
+			Status:  status,
+			Current: int64(time.Since(start).Seconds()),
+			Total:   int64(timeout.Seconds()),
+			Unit:    "seconds",
+			Detail:  fmt.Sprintf("%d/%d healthy", healthyCount, len(nodes)),
+		})
+	}
+
 	for {
 		// Check for context cancellation
 		if err := ctx.Err(); err != nil {
@@ -762,6 +1102,7 @@ func (o *ProvisioningOrchestrator) executeHealthPhase(ctx context.Context, nodes
 
 		// Check if all nodes are healthy
 		if healthyCount == len(nodes) {
+			reportHealth("completed", healthyCount)
 			o.logger.Info("all nodes healthy",
 				"healthyCount", healthyCount,
 				"totalCount", len(nodes),
@@ -776,6 +1117,7 @@ func (o *ProvisioningOrchestrator) executeHealthPhase(ctx context.Context, nodes
 
 		// Check for timeout
 		if time.Now().After(deadline) {
+			reportHealth("failed", healthyCount)
 			o.logger.Warn("health check timeout",
 				"healthyCount", healthyCount,
 				"totalCount", len(nodes),
@@ -790,6 +1132,7 @@ func (o *ProvisioningOrchestrator) executeHealthPhase(ctx context.Context, nodes
 		}
 
 		// Report progress
+		reportHealth("running", healthyCount)
 		o.setPhase(PhaseHealthChecking, fmt.Sprintf("Waiting for nodes to become healthy (%d/%d)", healthyCount, len(nodes)))
 
 		// Wait for next poll
@@ -807,8 +1150,9 @@ func (o *ProvisioningOrchestrator) executeHealthPhase(ctx context.Context, nodes
 // =============================================================================
 
 // readValidatorKeys reads consensus pubkeys from validator nodes' priv_validator_key.json
-// files and derives operator addresses using the configured bech32 prefix.
-func (o *ProvisioningOrchestrator) readValidatorKeys(nodes []*types.Node) ([]plugintypes.ValidatorInfo, error) {
+// files. Operator addresses come from the validators' deterministic keys,
+// derived by gen.
+func (o *ProvisioningOrchestrator) readValidatorKeys(nodes []*types.Node, gen *keys.Generator) ([]plugintypes.ValidatorInfo, error) {
 	var validators []plugintypes.ValidatorInfo
 	for _, node := range nodes {
 		if node.Spec.Role != "validator" {
@@ -822,8 +1166,7 @@ func (o *ProvisioningOrchestrator) readValidatorKeys(nodes []*types.Node) ([]plu
 		}
 
 		var keyFile struct {
-			Address string `json:"address"`
-			PubKey  struct {
+			PubKey struct {
 				Type  string `json:"type"`
 				Value string `json:"value"`
 			} `json:"pub_key"`
@@ -832,14 +1175,15 @@ func (o *ProvisioningOrchestrator) readValidatorKeys(nodes []*types.Node) ([]plu
 			return nil, fmt.Errorf("failed to parse validator key for %s: %w", node.Metadata.Name, err)
 		}
 
-		// Derive bech32 valoper address from hex consensus address
-		addressBytes, err := hex.DecodeString(keyFile.Address)
+		// The operator account is the validator's deterministic key, so its
+		// mnemonic can be exported with 'dvb keys export'
+		operatorKey, err := gen.Derive(keys.RoleValidator, len(validators), o.config.Bech32Prefix, o.config.EVM)
 		if err != nil {
-			return nil, fmt.Errorf("failed to decode address for %s: %w", node.Metadata.Name, err)
+			return nil, fmt.Errorf("failed to derive operator key for %s: %w", node.Metadata.Name, err)
 		}
 
 		valoperPrefix := o.config.Bech32Prefix + "valoper"
-		operatorAddress, err := bech32.ConvertAndEncode(valoperPrefix, addressBytes)
+		operatorAddress, err := bech32.ConvertAndEncode(valoperPrefix, operatorKey.AddressBytes)
 		if err != nil {
 			return nil, fmt.Errorf("failed to encode operator address for %s: %w", node.Metadata.Name, err)
 		}
@@ -848,7 +1192,7 @@ func (o *ProvisioningOrchestrator) readValidatorKeys(nodes []*types.Node) ([]plu
 			Moniker:         node.Metadata.Name,
 			ConsPubKey:      keyFile.PubKey.Value,
 			OperatorAddress: operatorAddress,
-			SelfDelegation:  "1000000000000000000000", // 1000 tokens (18 decimals)
+			SelfDelegation:  validatorSelfDelegation,
 		})
 
 		o.logger.Debug("extracted validator key",
@@ -859,8 +1203,67 @@ func (o *ProvisioningOrchestrator) readValidatorKeys(nodes []*types.Node) ([]plu
 	return validators, nil
 }
 
+// validatorSelfDelegation is the stake of each injected validator when all
+// have the same power: 1000 tokens at 18 decimals.
+const validatorSelfDelegation = "1000000000000000000000"
+
+// applyVotingPower splits the validators' stake by the devnet's voting
+// power profile. The total stays what equal validators would have, so only
+// the distribution changes. The proportional profile reads the source
+// chain's bonded validators from the forked genesis at genesisPath.
+func (o *ProvisioningOrchestrator) applyVotingPower(validators []plugintypes.ValidatorInfo, genesisPath string, opts ports.ProvisionOptions) error {
+	var weights []*big.Int
+	switch opts.PowerProfile {
+	case "", genesispatch.PowerEqual:
+		return nil
+	case genesispatch.PowerCustom:
+		if len(opts.PowerWeights) != len(validators) {
+			return fmt.Errorf("custom voting power has %d weights for %d validators", len(opts.PowerWeights), len(validators))
+		}
+		weights = genesispatch.FloatWeights(opts.PowerWeights)
+	case genesispatch.PowerProportional:
+		if opts.GenesisSource.Mode == plugintypes.GenesisModeFresh {
+			return fmt.Errorf("proportional voting power needs a forked genesis")
+		}
+		f, err := os.Open(genesisPath)
+		if err != nil {
+			return fmt.Errorf("failed to read forked genesis: %w", err)
+		}
+		tokens, err := genesispatch.BondedTokens(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read the source chain's validators: %w", err)
+		}
+		if len(tokens) == 0 {
+			return fmt.Errorf("proportional voting power: the forked genesis has no bonded validators")
+		}
+		if len(tokens) < len(validators) {
+			o.logger.Warn("source chain has fewer bonded validators than the devnet, giving the rest the smallest stake",
+				"bonded", len(tokens),
+				"validators", len(validators))
+		}
+		for i := range validators {
+			weights = append(weights, tokens[min(i, len(tokens)-1)])
+		}
+	default:
+		return fmt.Errorf("unknown voting power profile %q", opts.PowerProfile)
+	}
+
+	perValidator, _ := new(big.Int).SetString(validatorSelfDelegation, 10)
+	total := new(big.Int).Mul(perValidator, big.NewInt(int64(len(validators))))
+	minStake := new(big.Int).Quo(perValidator, big.NewInt(1000))
+	for i, stake := range genesispatch.SplitStake(total, weights, minStake) {
+		validators[i].SelfDelegation = stake.String()
+	}
+	o.logger.Info("split validator stake by voting power profile",
+		"profile", opts.PowerProfile,
+		"validators", len(validators))
+	return nil
+}
+
 // configureNodeNetworking configures persistent peers, P2P settings, and ports for all nodes.
-func (o *ProvisioningOrchestrator) configureNodeNetworking(ctx context.Context, nodes []*types.Node) error {
+// Nodes on a docker network listen on all interfaces and dial each other by DNS name.
+func (o *ProvisioningOrchestrator) configureNodeNetworking(ctx context.Context, nodes []*types.Node, dockerNetwork bool) error {
 	if len(nodes) == 0 {
 		return nil
 	}
@@ -881,7 +1284,7 @@ func (o *ProvisioningOrchestrator) configureNodeNetworking(ctx context.Context,
 
 	// Configure each node
 	for i, node := range nodes {
-		peers := buildPeersExcludingSelf(nodeIDs, nodes, i)
+		peers := buildPeersExcludingSelf(nodeIDs, nodes, i, dockerNetwork)
 
 		editor := nodeconfig.NewConfigEditor(node.Spec.HomeDir, nil)
 		if err := editor.SetPersistentPeers(peers); err != nil {
@@ -894,9 +1297,16 @@ func (o *ProvisioningOrchestrator) configureNodeNetworking(ctx context.Context,
 			return fmt.Errorf("failed to set consensus params for %s: %w", node.Metadata.Name, err)
 		}
 
-		// Configure ports based on node index
-		host := node.Spec.Address // empty string if no subnet (port-offset mode)
-		if err := editor.SetPortsWithHost(node.Spec.Index, host); err != nil {
+		// Configure ports from the devnet's port layout
+		// Listen on the bind address if set, else the node's subnet address
+		// (empty in port-offset mode); in a container, on all interfaces
+		host := node.Spec.BindAddress
+		if dockerNetwork {
+			host = remoteBindAddress
+		} else if host == "" {
+			host = node.Spec.Address
+		}
+		if err := editor.SetPortConfigWithHost(node.Spec.Ports(), host); err != nil {
 			return fmt.Errorf("failed to set ports for %s: %w", node.Metadata.Name, err)
 		}
 
@@ -916,10 +1326,303 @@ func (o *ProvisioningOrchestrator) configureNodeNetworking(ctx context.Context,
 	return nil
 }
 
+// testAccountBalance is the bond denom amount each test account is funded
+// with: 10000 tokens at 18 decimals.
+const testAccountBalance = "10000000000000000000000"
+
+// configureRemoteSigners sets priv_validator_laddr on the validators that
+// sign through a remote signer, so they sign with it instead of their key
+// file. The signer connects to the node, not the other way round.
+func (o *ProvisioningOrchestrator) configureRemoteSigners(nodes []*types.Node, opts ports.ProvisionOptions) error {
+	for _, node := range nodes {
+		if node.Spec.Role != "validator" || !slices.Contains(opts.RemoteSigners, node.Spec.Index) {
+			continue
+		}
+		laddr := signer.ListenAddress(node.Spec)
+		editor := nodeconfig.NewConfigEditor(node.Spec.HomeDir, nil)
+		if err := editor.SetPrivValidatorLaddr(laddr); err != nil {
+			return fmt.Errorf("failed to set priv_validator_laddr for %s: %w", node.Metadata.Name, err)
+		}
+		o.logger.Info("validator signs through a remote signer",
+			"node", node.Metadata.Name,
+			"laddr", laddr,
+		)
+	}
+	return nil
+}
+
+// applyFundedAccounts adds the deterministic test accounts and the spec's
+// funded accounts to the auth and bank genesis state, validates it with the
+// plugin, and redistributes it to all nodes.
+func (o *ProvisioningOrchestrator) applyFundedAccounts(nodes []*types.Node, opts ports.ProvisionOptions) error {
+	if (len(opts.FundedAccounts) == 0 && opts.NumAccounts == 0) || len(nodes) == 0 {
+		return nil
+	}
+
+	o.logger.Info("adding funded accounts to genesis",
+		"testAccounts", opts.NumAccounts,
+		"fundedAccounts", len(opts.FundedAccounts))
+
+	testKeys, err := keys.ForSeed(opts.Seed).DeriveAll(o.config.Bech32Prefix, 0, opts.NumAccounts, o.config.EVM)
+	if err != nil {
+		return fmt.Errorf("failed to derive test accounts: %w", err)
+	}
+
+	accounts := make([]genesispatch.Account, 0, len(testKeys)+len(opts.FundedAccounts))
+	for _, acc := range opts.FundedAccounts {
+		account := genesispatch.Account{Address: acc.Address, Coins: acc.Coins}
+		if acc.Vesting != nil {
+			account.Vesting = &genesispatch.Vesting{
+				Type:  acc.Vesting.Type,
+				Coins: acc.Vesting.Coins,
+				Start: acc.Vesting.StartTime,
+				End:   acc.Vesting.EndTime,
+			}
+		}
+		accounts = append(accounts, account)
+	}
+
+	return o.rewriteGenesis(nodes, opts.DataDir, "funded accounts", func(genesis []byte) ([]byte, error) {
+		if len(testKeys) > 0 {
+			denom, err := genesisBondDenom(genesis)
+			if err != nil {
+				return nil, err
+			}
+			funded := make([]genesispatch.Account, 0, len(testKeys)+len(accounts))
+			for _, key := range testKeys {
+				funded = append(funded, genesispatch.Account{Address: key.Address, Coins: testAccountBalance + denom})
+			}
+			accounts = append(funded, accounts...)
+		}
+		return genesispatch.AddAccounts(genesis, accounts)
+	})
+}
+
+// genesisBondDenom returns the staking bond denom from a genesis document.
+func genesisBondDenom(genesis []byte) (string, error) {
+	var doc struct {
+		AppState struct {
+			Staking struct {
+				Params struct {
+					BondDenom string `json:"bond_denom"`
+				} `json:"params"`
+			} `json:"staking"`
+		} `json:"app_state"`
+	}
+	if err := json.Unmarshal(genesis, &doc); err != nil {
+		return "", fmt.Errorf("failed to parse genesis: %w", err)
+	}
+	if doc.AppState.Staking.Params.BondDenom == "" {
+		return "", fmt.Errorf("genesis has no staking bond denom to fund test accounts with")
+	}
+	return doc.AppState.Staking.Params.BondDenom, nil
+}
+
+// applyGenesisOverrides merges the spec's genesis overrides into the final
+// genesis, validates it with the plugin, and redistributes it to all nodes.
+func (o *ProvisioningOrchestrator) applyGenesisOverrides(nodes []*types.Node, opts ports.ProvisionOptions) error {
+	if len(opts.GenesisOverrides) == 0 || len(nodes) == 0 {
+		return nil
+	}
+
+	o.logger.Info("applying genesis overrides", "count", len(opts.GenesisOverrides))
+
+	return o.rewriteGenesis(nodes, opts.DataDir, "overrides", func(genesis []byte) ([]byte, error) {
+		return genesispatch.Apply(genesis, opts.GenesisOverrides)
+	})
+}
+
+// applySeedGenesisTime replaces the genesis time, which init or the fork
+// source set, with the one the devnet's seed derives. Unseeded devnets keep
+// theirs.
+func (o *ProvisioningOrchestrator) applySeedGenesisTime(nodes []*types.Node, opts ports.ProvisionOptions) error {
+	if opts.Seed == "" || len(nodes) == 0 {
+		return nil
+	}
+
+	genesisTime := keys.ForSeed(opts.Seed).GenesisTime().Format(time.RFC3339)
+	o.logger.Info("setting seeded genesis time", "genesisTime", genesisTime)
+
+	return o.rewriteGenesis(nodes, opts.DataDir, "genesis time", func(genesis []byte) ([]byte, error) {
+		return genesispatch.Apply(genesis, map[string]string{"genesis_time": strconv.Quote(genesisTime)})
+	})
+}
+
+// applyEpochDuration sets the duration of every epochs module epoch in the
+// final genesis to the spec's epoch duration.
+func (o *ProvisioningOrchestrator) applyEpochDuration(nodes []*types.Node, opts ports.ProvisionOptions) error {
+	if opts.EpochDuration == 0 || len(nodes) == 0 {
+		return nil
+	}
+
+	return o.rewriteGenesis(nodes, opts.DataDir, "epoch durations", func(genesis []byte) ([]byte, error) {
+		patched, changed, err := genesispatch.SetEpochDurations(genesis, opts.EpochDuration)
+		if err != nil {
+			return nil, err
+		}
+		if changed == 0 {
+			o.logger.Warn("genesis has no epochs to shorten", "epochDuration", opts.EpochDuration)
+		} else {
+			o.logger.Info("set epoch durations", "epochs", changed, "epochDuration", opts.EpochDuration)
+		}
+		return patched, nil
+	})
+}
+
+// rewriteGenesis patches node 0's genesis, validates the result with the
+// plugin, and writes it to every node and the devnet's master genesis.
+// change names the patch in validation errors.
+func (o *ProvisioningOrchestrator) rewriteGenesis(nodes []*types.Node, dataDir, change string, patch func([]byte) ([]byte, error)) error {
+	sourceGenesisPath := filepath.Join(nodes[0].Spec.HomeDir, "config", "genesis.json")
+	genesis, err := os.ReadFile(sourceGenesisPath)
+	if err != nil {
+		return fmt.Errorf("failed to read genesis: %w", err)
+	}
+
+	patched, err := patch(genesis)
+	if err != nil {
+		return err
+	}
+
+	if o.config.PluginGenesis != nil {
+		if err := o.config.PluginGenesis.ValidateGenesis(patched); err != nil {
+			return fmt.Errorf("genesis validation failed after %s: %w", change, err)
+		}
+	}
+
+	for _, node := range nodes {
+		genesisPath := filepath.Join(node.Spec.HomeDir, "config", "genesis.json")
+		if err := os.WriteFile(genesisPath, patched, 0644); err != nil {
+			return fmt.Errorf("failed to write genesis to %s: %w", node.Metadata.Name, err)
+		}
+	}
+	masterGenesisPath := filepath.Join(dataDir, "genesis.json")
+	if err := os.WriteFile(masterGenesisPath, patched, 0644); err != nil {
+		return fmt.Errorf("failed to update master genesis: %w", err)
+	}
+	return nil
+}
+
+// applyProfile applies a provisioning profile's config tunings to all nodes.
+// An empty profile name is a no-op.
+func (o *ProvisioningOrchestrator) applyProfile(nodes []*types.Node, profileName string) error {
+	if profileName == "" {
+		return nil
+	}
+	profile, ok := types.LookupProfile(profileName)
+	if !ok {
+		return fmt.Errorf("unknown profile %q", profileName)
+	}
+
+	o.logger.Info("applying provisioning profile",
+		"profile", profile.Name,
+		"nodeCount", len(nodes),
+	)
+
+	for i, node := range nodes {
+		editor := nodeconfig.NewConfigEditor(node.Spec.HomeDir, nil)
+
+		if profile.Pruning != "" {
+			if err := editor.SetPruning(profile.Pruning); err != nil {
+				return fmt.Errorf("failed to set pruning for %s: %w", node.Metadata.Name, err)
+			}
+		}
+		if profile.TxIndexer != "" {
+			if err := editor.SetTxIndexer(profile.TxIndexer); err != nil {
+				return fmt.Errorf("failed to set tx indexer for %s: %w", node.Metadata.Name, err)
+			}
+		}
+		if err := editor.SetMempoolSize(profile.MempoolSize, profile.MempoolCacheSize); err != nil {
+			return fmt.Errorf("failed to set mempool size for %s: %w", node.Metadata.Name, err)
+		}
+		if profile.ServicesOnFirstNodeOnly && i > 0 {
+			if err := editor.DisableServices(); err != nil {
+				return fmt.Errorf("failed to disable services for %s: %w", node.Metadata.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// applyBlockTime sets timeout_commit on all nodes. An empty block time keeps
+// the default set with the other consensus params.
+func (o *ProvisioningOrchestrator) applyBlockTime(nodes []*types.Node, blockTime string) error {
+	if blockTime == "" {
+		return nil
+	}
+
+	o.logger.Info("setting block time", "blockTime", blockTime, "nodeCount", len(nodes))
+
+	for _, node := range nodes {
+		editor := nodeconfig.NewConfigEditor(node.Spec.HomeDir, nil)
+		if err := editor.SetBlockTime(blockTime); err != nil {
+			return fmt.Errorf("failed to set block time for %s: %w", node.Metadata.Name, err)
+		}
+	}
+	return nil
+}
+
+// applyPluginConfig merges the network plugin's config overrides into every
+// node's config.toml and app.toml.
+func (o *ProvisioningOrchestrator) applyPluginConfig(nodes []*types.Node, numValidators int) error {
+	if o.config.ConfigOverrides == nil {
+		return nil
+	}
+	for _, node := range nodes {
+		configToml, appToml, err := o.config.ConfigOverrides(node, numValidators)
+		if err != nil {
+			return fmt.Errorf("failed to get config overrides for %s: %w", node.Metadata.Name, err)
+		}
+		if err := mergeNodeConfig(node, configToml, appToml); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyNodeConfigs merges per-node config snippets into the config files of
+// the nodes they name.
+func (o *ProvisioningOrchestrator) applyNodeConfigs(nodes []*types.Node, configs []ports.NodeConfig) error {
+	for _, c := range configs {
+		i := slices.IndexFunc(nodes, func(n *types.Node) bool { return n.Spec.Index == c.Index })
+		if i < 0 {
+			return fmt.Errorf("node %d: no such node (devnet has %d nodes)", c.Index, len(nodes))
+		}
+		node := nodes[i]
+		if err := mergeNodeConfig(node, []byte(c.ConfigToml), []byte(c.AppToml)); err != nil {
+			return err
+		}
+		o.logger.Info("merged node config",
+			"node", node.Metadata.Name,
+			"configToml", c.ConfigToml != "",
+			"appToml", c.AppToml != "",
+		)
+	}
+	return nil
+}
+
+// mergeNodeConfig deep-merges TOML into a node's config.toml and app.toml.
+// Empty overrides leave the file untouched.
+func mergeNodeConfig(node *types.Node, configToml, appToml []byte) error {
+	for _, f := range []struct {
+		name      string
+		overrides []byte
+	}{{"config.toml", configToml}, {"app.toml", appToml}} {
+		if len(bytes.TrimSpace(f.overrides)) == 0 {
+			continue
+		}
+		if err := configpatch.MergeFile(node.Spec.HomeDir, f.name, f.overrides); err != nil {
+			return fmt.Errorf("failed to merge %s of %s: %w", f.name, node.Metadata.Name, err)
+		}
+	}
+	return nil
+}
+
 // buildPeersExcludingSelf builds a persistent_peers string excluding the node at excludeIndex.
-// Uses port-offset mode (127.0.0.1 with P2P port offset per node) when Address is not set,
-// or loopback subnet mode (unique IP with default P2P port) when Address is set.
-func buildPeersExcludingSelf(nodeIDs []string, nodes []*types.Node, excludeIndex int) string {
+// Uses the nodes' DNS names and the default P2P port on a docker network, port-offset mode
+// (127.0.0.1 with P2P port offset per node) when Address is not set, or loopback subnet
+// mode (unique IP with default P2P port) when Address is set.
+func buildPeersExcludingSelf(nodeIDs []string, nodes []*types.Node, excludeIndex int, dockerNetwork bool) string {
 	defaultP2P := dvbtypes.DefaultP2PPort
 	var peers []string
 	for i, nodeID := range nodeIDs {
@@ -928,9 +1631,12 @@ func buildPeersExcludingSelf(nodeIDs []string, nodes []*types.Node, excludeIndex
 		}
 
 		var peer string
-		if nodes[i].Spec.Address != "" {
-			// Loopback subnet mode: unique IP, standard port
-			peer = fmt.Sprintf("%s@%s:%d", nodeID, nodes[i].Spec.Address, defaultP2P)
+		if dockerNetwork {
+			// Docker network: the node's alias, port inside its container
+			peer = nodeID + "@" + net.JoinHostPort(nodes[i].Spec.DNSName(), strconv.Itoa(defaultP2P))
+		} else if nodes[i].Spec.Address != "" {
+			// Loopback subnet mode: unique IP, port from the layout
+			peer = nodeID + "@" + net.JoinHostPort(nodes[i].Spec.Address, strconv.Itoa(nodes[i].Spec.Ports().P2P))
 		} else {
 			// Port-offset mode: 127.0.0.1 with port = base + (index * 10000)
 			port := defaultP2P + (i * 10000)
@@ -2,6 +2,7 @@
 package provisioner
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -14,9 +15,12 @@ import (
 
 	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/keys"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
 	plugintypes "github.com/altuslabsxyz/devnet-builder/internal/plugin/types"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -104,6 +108,7 @@ func (m *mockNodeInitializer) Initialize(ctx context.Context, nodeDir, moniker,
 		// Create a minimal config.toml (needed by configureNodeNetworking)
 		configTOML := `proxy_app = "tcp://127.0.0.1:26658"
 pprof_laddr = "localhost:6060"
+priv_validator_laddr = ""
 persistent_peers = ""
 timeout_propose = "3s"
 timeout_prevote = "1s"
@@ -973,6 +978,79 @@ func TestOnProgress_ReceivesAllPhaseMessages(t *testing.T) {
 	assert.GreaterOrEqual(t, len(messages), 5)
 }
 
+func TestSetStepProgressReporter_ReportsBuildInitAndHealth(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	config := OrchestratorConfig{
+		BinaryBuilder: &mockBinaryBuilder{
+			buildResult: &builder.BuildResult{BinaryPath: "/path/to/binary"},
+		},
+		GenesisForker: &mockGenesisForker{
+			forkResult: &ports.ForkResult{
+				Genesis:    []byte(`{"chain_id": "test-chain"}`),
+				NewChainID: "test-chain",
+			},
+		},
+		NodeInitializer: &mockNodeInitializer{nodeIDResult: "node123"},
+		NodeRuntime:     &mockNodeRuntime{},
+		HealthChecker:   newMockHealthChecker(),
+		DataDir:         tmpDir,
+		Logger:          slog.Default(),
+	}
+
+	orch := NewProvisioningOrchestrator(config)
+
+	var steps []ports.StepProgress
+	orch.SetStepProgressReporter(ports.ProgressFunc(func(step ports.StepProgress) {
+		steps = append(steps, step)
+	}))
+
+	opts := ports.ProvisionOptions{
+		DevnetName:         "test-devnet",
+		ChainID:            "test-chain",
+		BinaryVersion:      "v1.2.0",
+		NumValidators:      2,
+		NumFullNodes:       1,
+		DataDir:            tmpDir,
+		HealthCheckTimeout: 10 * time.Second,
+	}
+
+	_, err := orch.Execute(context.Background(), opts)
+	require.NoError(t, err)
+
+	byName := make(map[string][]ports.StepProgress)
+	for _, step := range steps {
+		byName[step.Name] = append(byName[step.Name], step)
+	}
+
+	build := byName["Building binary"]
+	require.Len(t, build, 2)
+	assert.Equal(t, "running", build[0].Status)
+	assert.Equal(t, "completed", build[1].Status)
+	assert.Equal(t, "v1.2.0", build[1].Detail)
+
+	init := byName["Initializing nodes"]
+	require.Len(t, init, 4)
+	for i, step := range init[:3] {
+		assert.Equal(t, "running", step.Status)
+		assert.Equal(t, int64(i), step.Current)
+		assert.Equal(t, int64(3), step.Total)
+		assert.Equal(t, "nodes", step.Unit)
+	}
+	assert.Equal(t, "validator-0", init[0].Detail)
+	assert.Equal(t, "fullnode-0", init[2].Detail)
+	assert.Equal(t, "completed", init[3].Status)
+	assert.Equal(t, int64(3), init[3].Current)
+
+	health := byName["Waiting for healthy nodes"]
+	require.NotEmpty(t, health)
+	last := health[len(health)-1]
+	assert.Equal(t, "completed", last.Status)
+	assert.Equal(t, "seconds", last.Unit)
+	assert.Equal(t, int64(10), last.Total)
+	assert.Equal(t, "3/3 healthy", last.Detail)
+}
+
 func TestOnProgress_NoCallback_DoesNotPanic(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -1452,7 +1530,7 @@ func TestReadValidatorKeys(t *testing.T) {
 		Bech32Prefix: "cosmos",
 	})
 
-	validators, err := orch.readValidatorKeys(nodes)
+	validators, err := orch.readValidatorKeys(nodes, keys.Default)
 	require.NoError(t, err)
 
 	// Should only read validators, not fullnodes
@@ -1467,6 +1545,14 @@ func TestReadValidatorKeys(t *testing.T) {
 	// Check second validator
 	assert.Equal(t, "test-validator-1", validators[1].Moniker)
 	assert.Contains(t, validators[1].OperatorAddress, "cosmosvaloper")
+
+	// Operator accounts are the validators' deterministic keys
+	operatorKey, err := keys.Derive(keys.RoleValidator, 1, "cosmos", false)
+	require.NoError(t, err)
+	wantOperator, err := bech32.ConvertAndEncode("cosmosvaloper", operatorKey.AddressBytes)
+	require.NoError(t, err)
+	assert.Equal(t, wantOperator, validators[1].OperatorAddress)
+	assert.NotEqual(t, validators[0].OperatorAddress, validators[1].OperatorAddress)
 }
 
 func TestReadValidatorKeys_SkipsFullnodes(t *testing.T) {
@@ -1487,11 +1573,48 @@ func TestReadValidatorKeys_SkipsFullnodes(t *testing.T) {
 		Bech32Prefix: "cosmos",
 	})
 
-	validators, err := orch.readValidatorKeys(nodes)
+	validators, err := orch.readValidatorKeys(nodes, keys.Default)
 	require.NoError(t, err)
 	assert.Empty(t, validators)
 }
 
+func TestApplyVotingPower(t *testing.T) {
+	genesisPath := filepath.Join(t.TempDir(), "genesis.json")
+	genesis := `{"chain_id":"source-1","app_state":{"staking":{"validators":[
+		{"status":"BOND_STATUS_BONDED","tokens":"600"},
+		{"status":"BOND_STATUS_BONDED","tokens":"300"},
+		{"status":"BOND_STATUS_BONDED","tokens":"100"}]}}}`
+	require.NoError(t, os.WriteFile(genesisPath, []byte(genesis), 0644))
+
+	orch := NewProvisioningOrchestrator(OrchestratorConfig{
+		Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	stakes := func(opts ports.ProvisionOptions) []string {
+		t.Helper()
+		validators := make([]plugintypes.ValidatorInfo, 3)
+		for i := range validators {
+			validators[i].SelfDelegation = validatorSelfDelegation
+		}
+		require.NoError(t, orch.applyVotingPower(validators, genesisPath, opts))
+		var out []string
+		for _, v := range validators {
+			out = append(out, v.SelfDelegation)
+		}
+		return out
+	}
+
+	// 3000 tokens in total, split 60/30/10 like the source chain
+	assert.Equal(t, []string{"1800000000000000000000", "900000000000000000000", "300000000000000000000"},
+		stakes(ports.ProvisionOptions{PowerProfile: "proportional"}))
+	assert.Equal(t, []string{"1500000000000000000000", "1000000000000000000000", "500000000000000000000"},
+		stakes(ports.ProvisionOptions{PowerProfile: "custom", PowerWeights: []float64{3, 2, 1}}))
+	assert.Equal(t, []string{validatorSelfDelegation, validatorSelfDelegation, validatorSelfDelegation},
+		stakes(ports.ProvisionOptions{}))
+
+	fresh := ports.ProvisionOptions{PowerProfile: "proportional", GenesisSource: plugintypes.GenesisSource{Mode: plugintypes.GenesisModeFresh}}
+	assert.Error(t, orch.applyVotingPower(make([]plugintypes.ValidatorInfo, 3), genesisPath, fresh))
+}
+
 func TestBuildPeersExcludingSelf(t *testing.T) {
 	nodeIDs := []string{"aaa111", "bbb222", "ccc333"}
 
@@ -1503,13 +1626,13 @@ func TestBuildPeersExcludingSelf(t *testing.T) {
 		}
 
 		// Exclude node 0
-		peers := buildPeersExcludingSelf(nodeIDs, nodes, 0)
+		peers := buildPeersExcludingSelf(nodeIDs, nodes, 0, false)
 		assert.Contains(t, peers, "bbb222@127.0.0.1:36656")
 		assert.Contains(t, peers, "ccc333@127.0.0.1:46656")
 		assert.NotContains(t, peers, "aaa111")
 
 		// Exclude node 1
-		peers = buildPeersExcludingSelf(nodeIDs, nodes, 1)
+		peers = buildPeersExcludingSelf(nodeIDs, nodes, 1, false)
 		assert.Contains(t, peers, "aaa111@127.0.0.1:26656")
 		assert.Contains(t, peers, "ccc333@127.0.0.1:46656")
 		assert.NotContains(t, peers, "bbb222")
@@ -1522,17 +1645,40 @@ func TestBuildPeersExcludingSelf(t *testing.T) {
 			{Spec: types.NodeSpec{Index: 2, Address: "127.0.42.3"}},
 		}
 
-		peers := buildPeersExcludingSelf(nodeIDs, nodes, 0)
+		peers := buildPeersExcludingSelf(nodeIDs, nodes, 0, false)
 		assert.Contains(t, peers, "bbb222@127.0.42.2:26656")
 		assert.Contains(t, peers, "ccc333@127.0.42.3:26656")
 		assert.NotContains(t, peers, "aaa111")
 	})
 
+	t.Run("shared ipv6 bind address", func(t *testing.T) {
+		layout := dvbtypes.PortLayout{Stride: 100}
+		nodes := []*types.Node{
+			{Spec: types.NodeSpec{Index: 0, Address: "::1", PortLayout: layout}},
+			{Spec: types.NodeSpec{Index: 1, Address: "::1", PortLayout: layout}},
+			{Spec: types.NodeSpec{Index: 2, Address: "::1", PortLayout: layout}},
+		}
+
+		peers := buildPeersExcludingSelf(nodeIDs, nodes, 0, false)
+		assert.Equal(t, "bbb222@[::1]:26756,ccc333@[::1]:26856", peers)
+	})
+
+	t.Run("docker network mode (dns names)", func(t *testing.T) {
+		nodes := []*types.Node{
+			{Spec: types.NodeSpec{Index: 0, Role: "validator", DevnetRef: "mydevnet"}},
+			{Spec: types.NodeSpec{Index: 1, Role: "validator", DevnetRef: "mydevnet"}},
+			{Spec: types.NodeSpec{Index: 2, Role: "full", DevnetRef: "mydevnet"}},
+		}
+
+		peers := buildPeersExcludingSelf(nodeIDs, nodes, 0, true)
+		assert.Equal(t, "bbb222@val1.mydevnet:26656,ccc333@full2.mydevnet:26656", peers)
+	})
+
 	t.Run("single node returns empty", func(t *testing.T) {
 		nodes := []*types.Node{
 			{Spec: types.NodeSpec{Index: 0}},
 		}
-		peers := buildPeersExcludingSelf([]string{"aaa111"}, nodes, 0)
+		peers := buildPeersExcludingSelf([]string{"aaa111"}, nodes, 0, false)
 		assert.Empty(t, peers)
 	})
 }
@@ -1646,3 +1792,564 @@ func TestPostInitSkippedWhenNoPluginGenesis(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, mockGenesis, writtenGenesis)
 }
+
+func TestApplyProfile_Laptop(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configTOML := `[mempool]
+size = 5000
+cache_size = 10000
+
+[tx_index]
+indexer = "kv"
+`
+	appTOML := `pruning = "default"
+pruning-keep-recent = "0"
+
+[api]
+enable = true
+
+[grpc]
+enable = true
+
+[json-rpc]
+enable = true
+`
+
+	var nodes []*types.Node
+	for i := 0; i < 2; i++ {
+		homeDir := filepath.Join(tmpDir, fmt.Sprintf("node%d", i))
+		configDir := filepath.Join(homeDir, "config")
+		require.NoError(t, os.MkdirAll(configDir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(configDir, "config.toml"), []byte(configTOML), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(configDir, "app.toml"), []byte(appTOML), 0644))
+		nodes = append(nodes, &types.Node{
+			Metadata: types.ResourceMeta{Name: fmt.Sprintf("test-validator-%d", i)},
+			Spec:     types.NodeSpec{HomeDir: homeDir, Index: i},
+		})
+	}
+
+	orch := NewProvisioningOrchestrator(OrchestratorConfig{
+		Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+
+	require.NoError(t, orch.applyProfile(nodes, types.ProfileLaptop))
+
+	for i, node := range nodes {
+		config, err := os.ReadFile(filepath.Join(node.Spec.HomeDir, "config", "config.toml"))
+		require.NoError(t, err)
+		assert.Contains(t, string(config), "size = 1000\n")
+		assert.Contains(t, string(config), "cache_size = 2000\n")
+		assert.Contains(t, string(config), `indexer = "null"`)
+
+		app, err := os.ReadFile(filepath.Join(node.Spec.HomeDir, "config", "app.toml"))
+		require.NoError(t, err)
+		assert.Contains(t, string(app), `pruning = "everything"`)
+		assert.Contains(t, string(app), `pruning-keep-recent = "0"`)
+
+		if i == 0 {
+			assert.Contains(t, string(app), "[api]\nenable = true")
+		} else {
+			assert.Contains(t, string(app), "[api]\nenable = false")
+		}
+	}
+}
+
+func TestApplyProfile_EmptyIsNoop(t *testing.T) {
+	orch := NewProvisioningOrchestrator(OrchestratorConfig{
+		Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+
+	// Nodes without config files would fail if any edit were attempted
+	nodes := []*types.Node{{Spec: types.NodeSpec{HomeDir: t.TempDir()}}}
+	assert.NoError(t, orch.applyProfile(nodes, ""))
+	assert.Error(t, orch.applyProfile(nodes, "unknown"))
+}
+
+func TestApplyGenesisOverrides(t *testing.T) {
+	tmpDir := t.TempDir()
+	genesis := `{"app_state":{"gov":{"params":{"voting_period":"172800s"}}}}`
+
+	var nodes []*types.Node
+	for i := 0; i < 2; i++ {
+		homeDir := filepath.Join(tmpDir, fmt.Sprintf("node%d", i))
+		require.NoError(t, os.MkdirAll(filepath.Join(homeDir, "config"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(homeDir, "config", "genesis.json"), []byte(genesis), 0644))
+		nodes = append(nodes, &types.Node{
+			Metadata: types.ResourceMeta{Name: fmt.Sprintf("test-validator-%d", i)},
+			Spec:     types.NodeSpec{HomeDir: homeDir, Index: i},
+		})
+	}
+
+	plugin := &mockPluginGenesis{}
+	orch := NewProvisioningOrchestrator(OrchestratorConfig{
+		Logger:        slog.New(slog.NewTextHandler(io.Discard, nil)),
+		PluginGenesis: plugin,
+	})
+	opts := ports.ProvisionOptions{
+		DataDir:          tmpDir,
+		GenesisOverrides: map[string]string{"app_state.gov.params.voting_period": `"30s"`},
+	}
+
+	require.NoError(t, orch.applyGenesisOverrides(nodes, opts))
+	for _, path := range []string{
+		filepath.Join(nodes[0].Spec.HomeDir, "config", "genesis.json"),
+		filepath.Join(nodes[1].Spec.HomeDir, "config", "genesis.json"),
+		filepath.Join(tmpDir, "genesis.json"),
+	} {
+		written, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"app_state":{"gov":{"params":{"voting_period":"30s"}}}}`, string(written))
+	}
+
+	// Unknown paths and plugin validation failures are rejected
+	opts.GenesisOverrides = map[string]string{"app_state.gov.parms.voting_period": `"30s"`}
+	assert.Error(t, orch.applyGenesisOverrides(nodes, opts))
+
+	plugin.validateErr = fmt.Errorf("invalid genesis")
+	opts.GenesisOverrides = map[string]string{"app_state.gov.params.voting_period": `"10s"`}
+	assert.Error(t, orch.applyGenesisOverrides(nodes, opts))
+}
+
+func TestApplyFundedAccounts(t *testing.T) {
+	tmpDir := t.TempDir()
+	validator, err := bech32.ConvertAndEncode("cosmos", bytes.Repeat([]byte{1}, 20))
+	require.NoError(t, err)
+	funded, err := bech32.ConvertAndEncode("cosmos", bytes.Repeat([]byte{2}, 20))
+	require.NoError(t, err)
+	genesis := fmt.Sprintf(`{"app_state":{
+		"auth":{"accounts":[{"@type":"/cosmos.auth.v1beta1.BaseAccount","address":%q,"account_number":"0","sequence":"0"}]},
+		"bank":{"balances":[{"address":%q,"coins":[{"denom":"stake","amount":"100"}]}],"supply":[{"denom":"stake","amount":"100"}]}
+	}}`, validator, validator)
+
+	var nodes []*types.Node
+	for i := 0; i < 2; i++ {
+		homeDir := filepath.Join(tmpDir, fmt.Sprintf("node%d", i))
+		require.NoError(t, os.MkdirAll(filepath.Join(homeDir, "config"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(homeDir, "config", "genesis.json"), []byte(genesis), 0644))
+		nodes = append(nodes, &types.Node{
+			Metadata: types.ResourceMeta{Name: fmt.Sprintf("test-validator-%d", i)},
+			Spec:     types.NodeSpec{HomeDir: homeDir, Index: i},
+		})
+	}
+
+	orch := NewProvisioningOrchestrator(OrchestratorConfig{
+		Logger:        slog.New(slog.NewTextHandler(io.Discard, nil)),
+		PluginGenesis: &mockPluginGenesis{},
+	})
+	opts := ports.ProvisionOptions{
+		DataDir: tmpDir,
+		FundedAccounts: []ports.FundedAccount{{
+			Address: funded,
+			Coins:   "1000stake",
+			Vesting: &ports.VestingSchedule{Type: "delayed", EndTime: time.Unix(1700000000, 0)},
+		}},
+	}
+
+	require.NoError(t, orch.applyFundedAccounts(nodes, opts))
+	for _, path := range []string{
+		filepath.Join(nodes[1].Spec.HomeDir, "config", "genesis.json"),
+		filepath.Join(tmpDir, "genesis.json"),
+	} {
+		written, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Contains(t, string(written), funded)
+		assert.Contains(t, string(written), "/cosmos.vesting.v1beta1.DelayedVestingAccount")
+		assert.Contains(t, string(written), `"supply":[{"amount":"1100","denom":"stake"}]`)
+	}
+
+	opts.FundedAccounts = []ports.FundedAccount{{Address: "not-an-address", Coins: "1stake"}}
+	assert.Error(t, orch.applyFundedAccounts(nodes, opts))
+}
+
+func TestApplyFundedAccounts_TestAccounts(t *testing.T) {
+	tmpDir := t.TempDir()
+	homeDir := filepath.Join(tmpDir, "node0")
+	require.NoError(t, os.MkdirAll(filepath.Join(homeDir, "config"), 0755))
+	genesis := `{"app_state":{
+		"staking":{"params":{"bond_denom":"astable"}},
+		"auth":{"accounts":[]},
+		"bank":{"balances":[],"supply":[]}
+	}}`
+	require.NoError(t, os.WriteFile(filepath.Join(homeDir, "config", "genesis.json"), []byte(genesis), 0644))
+	nodes := []*types.Node{{
+		Metadata: types.ResourceMeta{Name: "test-validator-0"},
+		Spec:     types.NodeSpec{HomeDir: homeDir},
+	}}
+
+	orch := NewProvisioningOrchestrator(OrchestratorConfig{
+		Logger:       slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Bech32Prefix: "stable",
+		EVM:          true,
+	})
+	require.NoError(t, orch.applyFundedAccounts(nodes, ports.ProvisionOptions{DataDir: tmpDir, NumAccounts: 2}))
+
+	written, err := os.ReadFile(filepath.Join(tmpDir, "genesis.json"))
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		key, err := keys.Derive(keys.RoleAccount, i, "stable", true)
+		require.NoError(t, err)
+		assert.Contains(t, string(written), key.Address)
+	}
+	assert.Contains(t, string(written), `"supply":[{"amount":"20000000000000000000000","denom":"astable"}]`)
+}
+
+func TestExecute_ImportedValidatorKeys(t *testing.T) {
+	tmpDir := t.TempDir()
+	orch := NewProvisioningOrchestrator(OrchestratorConfig{
+		BinaryBuilder: &mockBinaryBuilder{},
+		GenesisForker: &mockGenesisForker{forkResult: &ports.ForkResult{
+			Genesis:    []byte(`{"chain_id": "test-chain"}`),
+			NewChainID: "test-chain",
+		}},
+		NodeInitializer: &mockNodeInitializer{nodeIDResult: "node123"},
+		NodeRuntime:     &mockNodeRuntime{},
+		DataDir:         tmpDir,
+		Logger:          slog.Default(),
+	})
+
+	imported := ports.ValidatorKeys{
+		Index:            1,
+		PrivValidatorKey: `{"address":"STAGING","pub_key":{"type":"tendermint/PubKeyEd25519","value":"c3RhZ2luZw=="}}`,
+		NodeKey:          `{"priv_key":{"type":"tendermint/PrivKeyEd25519","value":"c3RhZ2luZw=="}}`,
+	}
+	_, err := orch.Execute(context.Background(), ports.ProvisionOptions{
+		DevnetName:    "test-devnet",
+		ChainID:       "test-chain",
+		BinaryPath:    "/pre-built/binary",
+		NumValidators: 2,
+		DataDir:       tmpDir,
+		SkipStart:     true,
+		ValidatorKeys: []ports.ValidatorKeys{imported},
+	})
+	require.NoError(t, err)
+
+	// The mock init writes its own key over the imported one, which must
+	// be put back
+	configDir := filepath.Join(tmpDir, "nodes", "test-devnet-validator-1", "config")
+	privKey, err := os.ReadFile(filepath.Join(configDir, "priv_validator_key.json"))
+	require.NoError(t, err)
+	assert.Equal(t, imported.PrivValidatorKey, string(privKey))
+	nodeKey, err := os.ReadFile(filepath.Join(configDir, "node_key.json"))
+	require.NoError(t, err)
+	assert.Equal(t, imported.NodeKey, string(nodeKey))
+
+	// Other slots keep their generated keys
+	privKey, err = os.ReadFile(filepath.Join(tmpDir, "nodes", "test-devnet-validator-0", "config", "priv_validator_key.json"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(privKey), "STAGING")
+}
+
+func TestExecute_RemoteSigners(t *testing.T) {
+	tmpDir := t.TempDir()
+	orch := NewProvisioningOrchestrator(OrchestratorConfig{
+		BinaryBuilder: &mockBinaryBuilder{},
+		GenesisForker: &mockGenesisForker{forkResult: &ports.ForkResult{
+			Genesis:    []byte(`{"chain_id": "test-chain"}`),
+			NewChainID: "test-chain",
+		}},
+		NodeInitializer: &mockNodeInitializer{nodeIDResult: "node123"},
+		NodeRuntime:     &mockNodeRuntime{},
+		DataDir:         tmpDir,
+		Logger:          slog.Default(),
+	})
+
+	_, err := orch.Execute(context.Background(), ports.ProvisionOptions{
+		DevnetName:    "test-devnet",
+		ChainID:       "test-chain",
+		BinaryPath:    "/pre-built/binary",
+		NumValidators: 2,
+		DataDir:       tmpDir,
+		SkipStart:     true,
+		PortLayout:    dvbtypes.PortLayout{Stride: 10},
+		RemoteSigners: []int{1},
+	})
+	require.NoError(t, err)
+
+	// The remote-signer validator listens for its signer after its proxy
+	// port, in its own port block
+	config, err := os.ReadFile(filepath.Join(tmpDir, "nodes", "test-devnet-validator-1", "config", "config.toml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(config), `priv_validator_laddr = "tcp://0.0.0.0:26669"`)
+
+	// Other validators keep signing with their key file
+	config, err = os.ReadFile(filepath.Join(tmpDir, "nodes", "test-devnet-validator-0", "config", "config.toml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(config), `priv_validator_laddr = ""`)
+}
+
+func TestExecute_NodeConfigs(t *testing.T) {
+	tmpDir := t.TempDir()
+	orch := NewProvisioningOrchestrator(OrchestratorConfig{
+		BinaryBuilder: &mockBinaryBuilder{},
+		GenesisForker: &mockGenesisForker{forkResult: &ports.ForkResult{
+			Genesis:    []byte(`{"chain_id": "test-chain"}`),
+			NewChainID: "test-chain",
+		}},
+		NodeInitializer: &mockNodeInitializer{nodeIDResult: "node123"},
+		NodeRuntime:     &mockNodeRuntime{},
+		DataDir:         tmpDir,
+		Logger:          slog.Default(),
+		ConfigOverrides: func(node *types.Node, numValidators int) ([]byte, []byte, error) {
+			return []byte("[consensus]\ntimeout_commit = \"10s\"\n[mempool]\nsize = 7000\n"),
+				[]byte(`minimum-gas-prices = "0.1stake"`), nil
+		},
+	})
+
+	_, err := orch.Execute(context.Background(), ports.ProvisionOptions{
+		DevnetName:    "test-devnet",
+		ChainID:       "test-chain",
+		BinaryPath:    "/pre-built/binary",
+		NumValidators: 2,
+		DataDir:       tmpDir,
+		SkipStart:     true,
+		NodeConfigs: []ports.NodeConfig{{
+			Index:      1,
+			ConfigToml: "[consensus]\ntimeout_commit = \"5s\"\n",
+			AppToml:    "pruning = \"everything\"\n",
+		}},
+	})
+	require.NoError(t, err)
+
+	readConfig := func(moniker, file string) string {
+		data, err := os.ReadFile(filepath.Join(tmpDir, "nodes", moniker, "config", file))
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	// Plugin overrides land on every node, under devnet-builder's defaults
+	config := readConfig("test-devnet-validator-0", "config.toml")
+	assert.Contains(t, config, "size = 7000\n")
+	assert.Contains(t, config, `timeout_commit = "1s"`)
+	assert.Contains(t, readConfig("test-devnet-validator-0", "app.toml"), `minimum-gas-prices = "0.1stake"`)
+
+	// The node's own snippets win over everything
+	assert.Contains(t, readConfig("test-devnet-validator-1", "config.toml"), `timeout_commit = "5s"`)
+	assert.Contains(t, readConfig("test-devnet-validator-1", "app.toml"), `pruning = "everything"`)
+	assert.NotContains(t, readConfig("test-devnet-validator-0", "app.toml"), `pruning = "everything"`)
+}
+
+func TestExecute_BlockTimeAndEpochs(t *testing.T) {
+	tmpDir := t.TempDir()
+	orch := NewProvisioningOrchestrator(OrchestratorConfig{
+		BinaryBuilder: &mockBinaryBuilder{},
+		GenesisForker: &mockGenesisForker{forkResult: &ports.ForkResult{
+			Genesis:    []byte(`{"chain_id": "test-chain", "app_state": {"epochs": {"epochs": [{"identifier": "day", "duration": "86400s"}]}}}`),
+			NewChainID: "test-chain",
+		}},
+		NodeInitializer: &mockNodeInitializer{nodeIDResult: "node123"},
+		NodeRuntime:     &mockNodeRuntime{},
+		DataDir:         tmpDir,
+		Logger:          slog.Default(),
+	})
+
+	_, err := orch.Execute(context.Background(), ports.ProvisionOptions{
+		DevnetName:    "test-devnet",
+		ChainID:       "test-chain",
+		BinaryPath:    "/pre-built/binary",
+		NumValidators: 2,
+		DataDir:       tmpDir,
+		SkipStart:     true,
+		BlockTime:     "500ms",
+		EpochDuration: time.Minute,
+	})
+	require.NoError(t, err)
+
+	for _, moniker := range []string{"test-devnet-validator-0", "test-devnet-validator-1"} {
+		config, err := os.ReadFile(filepath.Join(tmpDir, "nodes", moniker, "config", "config.toml"))
+		require.NoError(t, err)
+		assert.Contains(t, string(config), `timeout_commit = "500ms"`)
+
+		genesis, err := os.ReadFile(filepath.Join(tmpDir, "nodes", moniker, "config", "genesis.json"))
+		require.NoError(t, err)
+		assert.Contains(t, string(genesis), `"duration":"60s"`)
+	}
+}
+
+func TestExecute_Seed(t *testing.T) {
+	provision := func(seed string) map[string]string {
+		tmpDir := t.TempDir()
+		// Like init, the fork stamps the genesis with the current time
+		genesis := fmt.Sprintf(`{"chain_id": "test-chain", "genesis_time": %q}`, time.Now().Format(time.RFC3339Nano))
+		orch := NewProvisioningOrchestrator(OrchestratorConfig{
+			BinaryBuilder: &mockBinaryBuilder{},
+			GenesisForker: &mockGenesisForker{forkResult: &ports.ForkResult{
+				Genesis:    []byte(genesis),
+				NewChainID: "test-chain",
+			}},
+			NodeInitializer: &mockNodeInitializer{nodeIDResult: "node123"},
+			NodeRuntime:     &mockNodeRuntime{},
+			DataDir:         tmpDir,
+			Logger:          slog.Default(),
+		})
+
+		_, err := orch.Execute(context.Background(), ports.ProvisionOptions{
+			DevnetName:    "test-devnet",
+			ChainID:       "test-chain",
+			BinaryPath:    "/pre-built/binary",
+			NumValidators: 2,
+			NumFullNodes:  1,
+			DataDir:       tmpDir,
+			SkipStart:     true,
+			Seed:          seed,
+		})
+		require.NoError(t, err)
+
+		files := make(map[string]string)
+		for _, moniker := range []string{"test-devnet-validator-0", "test-devnet-validator-1", "test-devnet-fullnode-2"} {
+			for _, name := range []string{"priv_validator_key.json", "node_key.json"} {
+				data, err := os.ReadFile(filepath.Join(tmpDir, "nodes", moniker, "config", name))
+				require.NoError(t, err)
+				files[moniker+"/"+name] = string(data)
+			}
+			data, err := os.ReadFile(filepath.Join(tmpDir, "nodes", moniker, "config", "genesis.json"))
+			require.NoError(t, err)
+			files[moniker+"/genesis.json"] = string(data)
+		}
+		data, err := os.ReadFile(filepath.Join(tmpDir, "genesis.json"))
+		require.NoError(t, err)
+		files["genesis.json"] = string(data)
+		return files
+	}
+
+	a := provision("issue-1234")
+	time.Sleep(time.Millisecond)
+	b := provision("issue-1234")
+	other := provision("issue-5678")
+
+	// The mock init writes its own keys; the seed's must be put back. The
+	// genesis files are byte-identical despite the different fork times.
+	assert.Equal(t, a, b)
+	assert.Contains(t, a["genesis.json"], keys.ForSeed("issue-1234").GenesisTime().Format(time.RFC3339))
+	for name, data := range a {
+		assert.NotEqual(t, other[name], data, name)
+	}
+	want, err := keys.ForSeed("issue-1234").NodeKeys(keys.RoleValidator, 1)
+	require.NoError(t, err)
+	assert.Equal(t, want.PrivValidatorKey, a["test-devnet-validator-1/priv_validator_key.json"])
+	assert.Equal(t, want.NodeKey, a["test-devnet-validator-1/node_key.json"])
+}
+
+func TestExecute_ForksToFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	sourcePath := filepath.Join(tmpDir, "source-genesis.json")
+	require.NoError(t, os.WriteFile(sourcePath, []byte(`{"chain_id": "source-1", "app_state": {}}`), 0644))
+
+	orch := NewProvisioningOrchestrator(OrchestratorConfig{
+		BinaryBuilder:   &mockBinaryBuilder{},
+		GenesisForker:   NewGenesisForker(GenesisForkerConfig{DataDir: tmpDir}),
+		NodeInitializer: &mockNodeInitializer{nodeIDResult: "node123"},
+		NodeRuntime:     &mockNodeRuntime{},
+		DataDir:         tmpDir,
+		Logger:          slog.Default(),
+	})
+
+	_, err := orch.Execute(context.Background(), ports.ProvisionOptions{
+		DevnetName:    "test-devnet",
+		ChainID:       "test-chain",
+		BinaryPath:    "/pre-built/binary",
+		NumValidators: 1,
+		NumFullNodes:  1,
+		DataDir:       tmpDir,
+		SkipStart:     true,
+		GenesisSource: plugintypes.GenesisSource{Mode: plugintypes.GenesisModeLocal, LocalPath: sourcePath},
+	})
+	require.NoError(t, err)
+
+	// The forked genesis is written to the data dir and copied to every node
+	master, err := os.ReadFile(filepath.Join(tmpDir, "genesis.json"))
+	require.NoError(t, err)
+	assert.Contains(t, string(master), `"test-chain"`)
+	for _, moniker := range []string{"test-devnet-validator-0", "test-devnet-fullnode-1"} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, "nodes", moniker, "config", "genesis.json"))
+		require.NoError(t, err)
+		assert.Equal(t, string(master), string(data), moniker)
+	}
+}
+
+func TestExecute_Hooks(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	config := OrchestratorConfig{
+		BinaryBuilder: &mockBinaryBuilder{
+			buildResult: &builder.BuildResult{BinaryPath: "/path/to/binary"},
+		},
+		GenesisForker: &mockGenesisForker{
+			forkResult: &ports.ForkResult{
+				Genesis:    []byte(`{"chain_id": "test-chain"}`),
+				NewChainID: "test-chain",
+			},
+		},
+		NodeInitializer: &mockNodeInitializer{nodeIDResult: "node123"},
+		NodeRuntime:     &mockNodeRuntime{},
+		HealthChecker:   newMockHealthChecker(),
+		DataDir:         tmpDir,
+		Logger:          slog.Default(),
+	}
+
+	opts := ports.ProvisionOptions{
+		DevnetName:         "test-devnet",
+		ChainID:            "test-chain",
+		NumValidators:      1,
+		DataDir:            tmpDir,
+		HealthCheckTimeout: 10 * time.Second,
+		Hooks: []ports.Hook{
+			{Phase: "postFork", Name: "edit-genesis", Command: `printf '{"chain_id": "test-chain", "hooked": true}' > "$DEVNET_GENESIS"`, Timeout: time.Minute},
+			{Phase: "preStart", Name: "record-home", Command: `echo "$DEVNET_CHAIN_ID $DEVNET_NODE_0_HOME" > prestart`, Timeout: time.Minute},
+			{Phase: "preStart", Name: "flaky", Command: "exit 1", Timeout: time.Minute, IgnoreFailure: true},
+			{Phase: "postHealthy", Name: "seed", Command: `echo "$DEVNET_RPC" > healthy`, Timeout: time.Minute},
+		},
+	}
+
+	_, err := NewProvisioningOrchestrator(config).Execute(context.Background(), opts)
+	require.NoError(t, err)
+
+	// postFork edits reach the nodes' genesis
+	nodeGenesis, err := os.ReadFile(filepath.Join(tmpDir, "nodes", "test-devnet-validator-0", "config", "genesis.json"))
+	require.NoError(t, err)
+	assert.Contains(t, string(nodeGenesis), `"hooked": true`)
+
+	prestart, err := os.ReadFile(filepath.Join(tmpDir, "prestart"))
+	require.NoError(t, err)
+	assert.Equal(t, "test-chain "+filepath.Join(tmpDir, "nodes", "test-devnet-validator-0")+"\n", string(prestart))
+
+	healthy, err := os.ReadFile(filepath.Join(tmpDir, "healthy"))
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:26657\n", string(healthy))
+}
+
+func TestExecute_HookFailure_FailsToFailed(t *testing.T) {
+	tmpDir := t.TempDir()
+	nodeRuntime := &mockNodeRuntime{}
+
+	config := OrchestratorConfig{
+		BinaryBuilder: &mockBinaryBuilder{
+			buildResult: &builder.BuildResult{BinaryPath: "/path/to/binary"},
+		},
+		GenesisForker: &mockGenesisForker{
+			forkResult: &ports.ForkResult{Genesis: []byte(`{}`), NewChainID: "test-chain"},
+		},
+		NodeInitializer: &mockNodeInitializer{nodeIDResult: "node123"},
+		NodeRuntime:     nodeRuntime,
+		DataDir:         tmpDir,
+		Logger:          slog.Default(),
+	}
+
+	opts := ports.ProvisionOptions{
+		DevnetName:    "test-devnet",
+		ChainID:       "test-chain",
+		NumValidators: 1,
+		DataDir:       tmpDir,
+		Hooks: []ports.Hook{
+			{Phase: "preStart", Name: "check", Command: "echo not ready; exit 3", Timeout: time.Minute},
+		},
+	}
+
+	orch := NewProvisioningOrchestrator(config)
+	_, err := orch.Execute(context.Background(), opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "preStart hooks failed: hook check failed")
+	assert.Contains(t, err.Error(), "not ready")
+	assert.Equal(t, PhaseFailed, orch.CurrentPhase())
+	assert.Empty(t, nodeRuntime.startCalls, "nodes must not start after a failed preStart hook")
+}
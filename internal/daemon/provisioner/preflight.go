@@ -0,0 +1,201 @@
+// internal/daemon/provisioner/preflight.go
+package provisioner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/prereq"
+)
+
+const (
+	// snapshotExtractionFactor is how much larger extracted chain state is
+	// than its compressed snapshot, at the upper end of the usual 2-3x.
+	snapshotExtractionFactor = 3
+
+	// nodeDataBytes is the disk space reserved per node for its config,
+	// genesis copy and the chain data a devnet accumulates.
+	nodeDataBytes = 512 << 20
+)
+
+// DiskRequirement is disk space a provisioning step needs on the filesystem
+// holding Path.
+type DiskRequirement struct {
+	// Path is where the data is written; it need not exist yet.
+	Path string
+
+	// Bytes is the estimated space needed.
+	Bytes int64
+
+	// Purpose describes the data, e.g. "snapshot download".
+	Purpose string
+}
+
+// forkDiskEstimator is implemented by genesis forkers that can estimate the
+// disk space a fork needs before it starts.
+type forkDiskEstimator interface {
+	EstimateDisk(ctx context.Context, opts ports.ForkOptions) ([]DiskRequirement, error)
+}
+
+// InsufficientDiskSpaceError is returned when a filesystem lacks the space
+// provisioning is estimated to need.
+type InsufficientDiskSpaceError struct {
+	// Path is the measured path on the filesystem.
+	Path string
+
+	// Required is the total estimated space needed on the filesystem.
+	Required uint64
+
+	// Available is the free space on the filesystem.
+	Available uint64
+
+	// Requirements are the estimates that make up Required.
+	Requirements []DiskRequirement
+}
+
+// Error implements the error interface.
+func (e *InsufficientDiskSpaceError) Error() string {
+	parts := make([]string, len(e.Requirements))
+	for i, req := range e.Requirements {
+		parts[i] = fmt.Sprintf("%s %s", req.Purpose, formatBytes(uint64(req.Bytes)))
+	}
+	return fmt.Sprintf("insufficient disk space on %s: need %s (%s), %s available; free up space or provision with --force",
+		e.Path, formatBytes(e.Required), strings.Join(parts, ", "), formatBytes(e.Available))
+}
+
+// Is implements errors.Is interface for comparing error types.
+func (e *InsufficientDiskSpaceError) Is(target error) bool {
+	_, ok := target.(*InsufficientDiskSpaceError)
+	return ok
+}
+
+// forkOptions returns the genesis fork options for a provisioning run.
+func forkOptions(opts ports.ProvisionOptions, binaryPath string) ports.ForkOptions {
+	forkOpts := ports.ForkOptions{
+		Source:     opts.GenesisSource,
+		BinaryPath: binaryPath,
+		PatchOpts:  opts.GenesisPatchOpts,
+		Offline:    opts.Offline,
+	}
+
+	// Ensure chain ID is set in patch options
+	if forkOpts.PatchOpts.ChainID == "" {
+		forkOpts.PatchOpts.ChainID = opts.ChainID
+	}
+
+	// Propagate binary version to patch options for genesis modification
+	if forkOpts.PatchOpts.BinaryVersion == "" {
+		forkOpts.PatchOpts.BinaryVersion = opts.BinaryVersion
+	}
+	return forkOpts
+}
+
+// diskRequirements estimates the disk space provisioning needs: the
+// snapshot download and extraction, if the forker reports them, and the
+// node directories.
+func (o *ProvisioningOrchestrator) diskRequirements(ctx context.Context, opts ports.ProvisionOptions) []DiskRequirement {
+	var reqs []DiskRequirement
+	if estimator, ok := o.config.GenesisForker.(forkDiskEstimator); ok {
+		forkReqs, err := estimator.EstimateDisk(ctx, forkOptions(opts, opts.BinaryPath))
+		if err != nil {
+			o.logger.Warn("could not estimate genesis fork disk usage", "error", err)
+		}
+		reqs = append(reqs, forkReqs...)
+	}
+
+	if nodes := opts.NumValidators + opts.NumFullNodes; nodes > 0 {
+		reqs = append(reqs, DiskRequirement{
+			Path:    opts.DataDir,
+			Bytes:   int64(nodes) * nodeDataBytes,
+			Purpose: fmt.Sprintf("data for %d nodes", nodes),
+		})
+	}
+	return reqs
+}
+
+// checkDiskSpace fails fast if a filesystem lacks the space provisioning is
+// estimated to need. Requirements on the same filesystem are summed. With
+// opts.SkipDiskCheck, a shortfall is logged instead. Filesystems that can't
+// be measured are skipped.
+func (o *ProvisioningOrchestrator) checkDiskSpace(ctx context.Context, opts ports.ProvisionOptions) error {
+	reqs := o.diskRequirements(ctx, opts)
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	statDisk := o.config.StatDisk
+	if statDisk == nil {
+		statDisk = prereq.StatDisk
+	}
+
+	o.reportStep(ports.StepProgress{Name: "Checking disk space", Status: "running"})
+
+	// Group requirements by filesystem, in first-seen order
+	type filesystem struct {
+		usage prereq.DiskUsage
+		reqs  []DiskRequirement
+	}
+	var order []uint64
+	byDevice := make(map[uint64]*filesystem)
+	for _, req := range reqs {
+		usage, err := statDisk(req.Path)
+		if err != nil {
+			if !errors.Is(err, prereq.ErrDiskSpaceUnsupported) {
+				o.logger.Warn("could not check disk space", "path", req.Path, "error", err)
+			}
+			continue
+		}
+		fs, ok := byDevice[usage.Device]
+		if !ok {
+			fs = &filesystem{usage: usage}
+			byDevice[usage.Device] = fs
+			order = append(order, usage.Device)
+		}
+		fs.reqs = append(fs.reqs, req)
+	}
+
+	for _, device := range order {
+		fs := byDevice[device]
+		var required uint64
+		for _, req := range fs.reqs {
+			required += uint64(req.Bytes)
+		}
+		if required <= fs.usage.Available {
+			continue
+		}
+
+		err := &InsufficientDiskSpaceError{
+			Path:         fs.usage.Path,
+			Required:     required,
+			Available:    fs.usage.Available,
+			Requirements: fs.reqs,
+		}
+		if opts.SkipDiskCheck {
+			o.logger.Warn("continuing despite insufficient disk space (--force)", "error", err)
+			o.reportStep(ports.StepProgress{Name: "Checking disk space", Status: "completed", Detail: "insufficient, continuing (--force)"})
+			return nil
+		}
+		o.reportStep(ports.StepProgress{Name: "Checking disk space", Status: "failed", Error: err.Error()})
+		return err
+	}
+
+	o.reportStep(ports.StepProgress{Name: "Checking disk space", Status: "completed"})
+	return nil
+}
+
+// formatBytes formats a byte count with a binary unit, e.g. "1.5 GB".
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,186 @@
+package provisioner
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/prereq"
+	plugintypes "github.com/altuslabsxyz/devnet-builder/internal/plugin/types"
+)
+
+// estimatingForker is a mockGenesisForker that reports disk requirements.
+type estimatingForker struct {
+	mockGenesisForker
+	reqs []DiskRequirement
+}
+
+func (m *estimatingForker) EstimateDisk(ctx context.Context, opts ports.ForkOptions) ([]DiskRequirement, error) {
+	return m.reqs, nil
+}
+
+// fakeStatDisk reports every path under a "/big" prefix on one filesystem
+// and everything else on another.
+func fakeStatDisk(small, big uint64) func(string) (prereq.DiskUsage, error) {
+	return func(path string) (prereq.DiskUsage, error) {
+		if strings.HasPrefix(path, "/big") {
+			return prereq.DiskUsage{Path: "/big", Device: 2, Available: big}, nil
+		}
+		return prereq.DiskUsage{Path: "/", Device: 1, Available: small}, nil
+	}
+}
+
+func newPreflightOrchestrator(forker ports.GenesisForker, statDisk func(string) (prereq.DiskUsage, error)) (*ProvisioningOrchestrator, *mockBinaryBuilder) {
+	mockBuilder := &mockBinaryBuilder{
+		buildResult: &builder.BuildResult{BinaryPath: "/path/to/binary"},
+	}
+	return NewProvisioningOrchestrator(OrchestratorConfig{
+		BinaryBuilder:   mockBuilder,
+		GenesisForker:   forker,
+		NodeInitializer: &mockNodeInitializer{nodeIDResult: "node123"},
+		NodeRuntime:     &mockNodeRuntime{},
+		Logger:          slog.Default(),
+		StatDisk:        statDisk,
+	}), mockBuilder
+}
+
+func TestExecute_InsufficientDiskSpace_FailsBeforeBuild(t *testing.T) {
+	forker := &estimatingForker{reqs: []DiskRequirement{
+		{Path: "/data/snapshots", Bytes: 10 << 30, Purpose: "snapshot download"},
+		{Path: "/data/genesis-work", Bytes: 30 << 30, Purpose: "snapshot extraction"},
+	}}
+	orch, mockBuilder := newPreflightOrchestrator(forker, fakeStatDisk(20<<30, 0))
+
+	_, err := orch.Execute(context.Background(), ports.ProvisionOptions{
+		DevnetName:    "test-devnet",
+		NumValidators: 4,
+		DataDir:       "/data/devnets/test-devnet",
+	})
+	require.Error(t, err)
+
+	var diskErr *InsufficientDiskSpaceError
+	require.True(t, errors.As(err, &diskErr))
+	assert.Equal(t, uint64(42<<30), diskErr.Required)
+	assert.Len(t, diskErr.Requirements, 3)
+	assert.Contains(t, err.Error(), "need 42.0 GB (snapshot download 10.0 GB, snapshot extraction 30.0 GB, data for 4 nodes 2.0 GB), 20.0 GB available")
+	assert.Contains(t, err.Error(), "--force")
+	assert.Equal(t, PhaseFailed, orch.CurrentPhase())
+	assert.False(t, mockBuilder.buildCalled, "build should not start after a failed preflight")
+	assert.False(t, forker.forkCalled, "fork should not start after a failed preflight")
+}
+
+func TestCheckDiskSpace_SumsPerFilesystem(t *testing.T) {
+	// 30 GB of extraction fits on /big; the small filesystem only holds the
+	// download and node data
+	forker := &estimatingForker{reqs: []DiskRequirement{
+		{Path: "/data/snapshots", Bytes: 10 << 30, Purpose: "snapshot download"},
+		{Path: "/big/genesis-work", Bytes: 30 << 30, Purpose: "snapshot extraction"},
+	}}
+	orch, _ := newPreflightOrchestrator(forker, fakeStatDisk(11<<30, 40<<30))
+
+	err := orch.checkDiskSpace(context.Background(), ports.ProvisionOptions{
+		NumValidators: 1,
+		DataDir:       "/data/devnets/test-devnet",
+	})
+	assert.NoError(t, err)
+}
+
+func TestCheckDiskSpace_SkipDiskCheck(t *testing.T) {
+	orch, _ := newPreflightOrchestrator(&mockGenesisForker{}, fakeStatDisk(1<<20, 0))
+
+	var steps []ports.StepProgress
+	orch.SetStepProgressReporter(ports.ProgressFunc(func(step ports.StepProgress) {
+		steps = append(steps, step)
+	}))
+
+	opts := ports.ProvisionOptions{NumValidators: 2, DataDir: "/data/devnets/test-devnet"}
+	require.Error(t, orch.checkDiskSpace(context.Background(), opts))
+
+	steps = nil
+	opts.SkipDiskCheck = true
+	require.NoError(t, orch.checkDiskSpace(context.Background(), opts))
+	require.NotEmpty(t, steps)
+	last := steps[len(steps)-1]
+	assert.Equal(t, "completed", last.Status)
+	assert.Contains(t, last.Detail, "--force")
+}
+
+func TestCheckDiskSpace_UnsupportedPlatformSkips(t *testing.T) {
+	orch, _ := newPreflightOrchestrator(&mockGenesisForker{}, func(string) (prereq.DiskUsage, error) {
+		return prereq.DiskUsage{}, prereq.ErrDiskSpaceUnsupported
+	})
+	err := orch.checkDiskSpace(context.Background(), ports.ProvisionOptions{NumValidators: 4, DataDir: "/data"})
+	assert.NoError(t, err)
+}
+
+// sizedSnapshotFetcher reports a remote snapshot size and an optional cached
+// snapshot path.
+type sizedSnapshotFetcher struct {
+	ports.SnapshotFetcher
+	size       int64
+	cachedPath string
+}
+
+func (f *sizedSnapshotFetcher) SnapshotSize(ctx context.Context, url string) (int64, error) {
+	return f.size, nil
+}
+
+func (f *sizedSnapshotFetcher) CachedSnapshot(cacheKey string) (string, bool) {
+	return f.cachedPath, f.cachedPath != ""
+}
+
+func TestGenesisForker_EstimateDisk(t *testing.T) {
+	dataDir := t.TempDir()
+	snapshotOpts := ports.ForkOptions{Source: plugintypes.GenesisSource{
+		Mode:        plugintypes.GenesisModeSnapshot,
+		SnapshotURL: "https://snapshots.example.com/mainnet.tar.zst",
+		NetworkType: "mainnet",
+	}}
+
+	t.Run("remote snapshot", func(t *testing.T) {
+		forker := NewGenesisForker(GenesisForkerConfig{
+			DataDir:         dataDir,
+			SnapshotFetcher: &sizedSnapshotFetcher{size: 1000},
+		})
+		reqs, err := forker.EstimateDisk(context.Background(), snapshotOpts)
+		require.NoError(t, err)
+		assert.Equal(t, []DiskRequirement{
+			{Path: filepath.Join(dataDir, "snapshots"), Bytes: 1000, Purpose: "snapshot download"},
+			{Path: filepath.Join(dataDir, "genesis-work"), Bytes: 3000, Purpose: "snapshot extraction"},
+		}, reqs)
+	})
+
+	t.Run("cached snapshot needs only extraction", func(t *testing.T) {
+		cached := filepath.Join(dataDir, "snapshot.tar.zst")
+		require.NoError(t, os.WriteFile(cached, make([]byte, 200), 0644))
+		forker := NewGenesisForker(GenesisForkerConfig{
+			DataDir:         dataDir,
+			SnapshotFetcher: &sizedSnapshotFetcher{size: 1000, cachedPath: cached},
+		})
+		reqs, err := forker.EstimateDisk(context.Background(), snapshotOpts)
+		require.NoError(t, err)
+		require.Len(t, reqs, 1)
+		assert.Equal(t, int64(600), reqs[0].Bytes)
+	})
+
+	t.Run("rpc mode needs nothing", func(t *testing.T) {
+		forker := NewGenesisForker(GenesisForkerConfig{
+			DataDir:         dataDir,
+			SnapshotFetcher: &sizedSnapshotFetcher{size: 1000},
+		})
+		reqs, err := forker.EstimateDisk(context.Background(), ports.ForkOptions{
+			Source: plugintypes.GenesisSource{Mode: plugintypes.GenesisModeRPC},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, reqs)
+	})
+}
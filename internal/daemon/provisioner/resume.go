@@ -0,0 +1,154 @@
+// internal/daemon/provisioner/resume.go
+package provisioner
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
+	plugintypes "github.com/altuslabsxyz/devnet-builder/internal/plugin/types"
+)
+
+// provisionStateFile records the provisioning phases a devnet completed, in
+// its data directory, so provisioning again after a failure or a daemon
+// restart resumes after them instead of starting over.
+const provisionStateFile = "provision-state.json"
+
+// provisionState holds a marker per completed phase, and a checkpoint of
+// the phase in progress. Initializing is not marked: it is local, quick and
+// rewrites every node directory anyway.
+type provisionState struct {
+	Build *buildMarker `json:"build,omitempty"`
+	Fork  *forkMarker  `json:"fork,omitempty"`
+
+	// Phase is the phase in progress. It is cleared when provisioning
+	// completes or fails, so a phase left set means the daemon stopped in
+	// the middle of it.
+	Phase ProvisioningPhase `json:"phase,omitempty"`
+
+	// Interruptions counts the attempts since provisioning last completed
+	// that the daemon stopped in the middle of.
+	Interruptions int `json:"interruptions,omitempty"`
+}
+
+// buildMarker records a completed building phase.
+type buildMarker struct {
+	// Key identifies the build spec; a marker for another spec is stale.
+	Key         string    `json:"key"`
+	BinaryPath  string    `json:"binaryPath"`
+	CompletedAt time.Time `json:"completedAt"`
+}
+
+// forkMarker records a completed forking phase.
+type forkMarker struct {
+	// Key identifies the fork options; a marker for others is stale.
+	Key string `json:"key"`
+	// GenesisSHA256 is the digest of the genesis the phase wrote. Later
+	// phases patch that file, after which the fork has to run again.
+	GenesisSHA256 string    `json:"genesisSha256"`
+	SourceChainID string    `json:"sourceChainId,omitempty"`
+	NewChainID    string    `json:"newChainId,omitempty"`
+	SourceMode    string    `json:"sourceMode,omitempty"`
+	CompletedAt   time.Time `json:"completedAt"`
+}
+
+// loadProvisionState reads the provisioning state of a data directory. A
+// missing or unreadable state is empty: the phases simply run again.
+func loadProvisionState(dataDir string) *provisionState {
+	data, err := os.ReadFile(filepath.Join(dataDir, provisionStateFile))
+	if err != nil {
+		return &provisionState{}
+	}
+	var state provisionState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return &provisionState{}
+	}
+	return &state
+}
+
+// save writes the state to the data directory.
+func (s *provisionState) save(dataDir string) error {
+	if err := os.MkdirAll(dataDir, 0755); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal provisioning state: %w", err)
+	}
+	path := filepath.Join(dataDir, provisionStateFile)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write provisioning state: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write provisioning state: %w", err)
+	}
+	return nil
+}
+
+// completedBuild returns the binary of a completed building phase for spec,
+// if it is still there.
+func (s *provisionState) completedBuild(spec builder.BuildSpec) (string, bool) {
+	if key := stepKey(spec); s.Build == nil || key == "" || s.Build.Key != key {
+		return "", false
+	}
+	if _, err := os.Stat(s.Build.BinaryPath); err != nil {
+		return "", false
+	}
+	return s.Build.BinaryPath, true
+}
+
+// completedFork returns the result of a completed forking phase for opts,
+// if the genesis it wrote to genesisPath is unchanged.
+func (s *provisionState) completedFork(opts ports.ForkOptions, genesisPath string) (*ports.ForkResult, bool) {
+	if key := stepKey(opts); s.Fork == nil || key == "" || s.Fork.Key != key {
+		return nil, false
+	}
+	sum, err := fileDigest(genesisPath)
+	if err != nil || sum != s.Fork.GenesisSHA256 {
+		return nil, false
+	}
+	return &ports.ForkResult{
+		SourceChainID: s.Fork.SourceChainID,
+		NewChainID:    s.Fork.NewChainID,
+		SourceMode:    plugintypes.GenesisMode(s.Fork.SourceMode),
+		FetchedAt:     s.Fork.CompletedAt,
+	}, true
+}
+
+// stepKey identifies the inputs of a phase, or is empty if they cannot be
+// encoded.
+func stepKey(inputs any) string {
+	data, err := json.Marshal(inputs)
+	if err != nil {
+		return ""
+	}
+	return digest(data)
+}
+
+// digest returns the hex SHA-256 digest of data.
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// fileDigest returns the hex SHA-256 digest of the file at path.
+func fileDigest(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,188 @@
+package provisioner
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/controller"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/retry"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyBinaryBuilder fails its first builds, as many as failures.
+type flakyBinaryBuilder struct {
+	mockBinaryBuilder
+	failures int
+	calls    int
+}
+
+func (m *flakyBinaryBuilder) Build(ctx context.Context, spec builder.BuildSpec) (*builder.BuildResult, error) {
+	m.calls++
+	if m.calls <= m.failures {
+		return nil, errors.New("connection reset by peer")
+	}
+	return m.buildResult, nil
+}
+
+// resumeTestConfig returns a config whose builder returns a binary that
+// exists, as a completed build marker requires.
+func resumeTestConfig(t *testing.T, dataDir string, init *mockNodeInitializer) (OrchestratorConfig, *mockBinaryBuilder, *mockGenesisForker) {
+	t.Helper()
+	binaryPath := filepath.Join(t.TempDir(), "testd")
+	require.NoError(t, os.WriteFile(binaryPath, []byte("binary"), 0755))
+
+	b := &mockBinaryBuilder{buildResult: &builder.BuildResult{BinaryPath: binaryPath}}
+	f := &mockGenesisForker{forkResult: &ports.ForkResult{
+		Genesis:       []byte(`{"chain_id": "test-chain"}`),
+		SourceChainID: "source-chain",
+		NewChainID:    "test-chain",
+	}}
+	return OrchestratorConfig{
+		BinaryBuilder:   b,
+		GenesisForker:   f,
+		NodeInitializer: init,
+		NodeRuntime:     &mockNodeRuntime{},
+		DataDir:         dataDir,
+		Logger:          slog.Default(),
+	}, b, f
+}
+
+func resumeTestOptions(dataDir string) ports.ProvisionOptions {
+	return ports.ProvisionOptions{
+		DevnetName:    "test-devnet",
+		ChainID:       "test-chain",
+		BinaryVersion: "v1.0.0",
+		NumValidators: 1,
+		DataDir:       dataDir,
+		SkipStart:     true,
+	}
+}
+
+func TestExecute_ResumesAfterCompletedPhases(t *testing.T) {
+	dataDir := t.TempDir()
+
+	// The first attempt fails after building and forking
+	config, _, _ := resumeTestConfig(t, dataDir, &mockNodeInitializer{initializeErr: errors.New("init failed")})
+	_, err := NewProvisioningOrchestrator(config).Execute(context.Background(), resumeTestOptions(dataDir))
+	require.Error(t, err)
+
+	state := loadProvisionState(dataDir)
+	require.NotNil(t, state.Build)
+	require.NotNil(t, state.Fork)
+
+	// The second attempt resumes at initializing
+	b := &mockBinaryBuilder{buildErr: errors.New("must not build again")}
+	f := &mockGenesisForker{forkErr: errors.New("must not fork again")}
+	config.BinaryBuilder = b
+	config.GenesisForker = f
+	config.NodeInitializer = &mockNodeInitializer{nodeIDResult: "node123"}
+
+	result, err := NewProvisioningOrchestrator(config).Execute(context.Background(), resumeTestOptions(dataDir))
+	require.NoError(t, err)
+	assert.False(t, b.buildCalled)
+	assert.False(t, f.forkCalled)
+	assert.Equal(t, state.Build.BinaryPath, result.BinaryPath)
+}
+
+func TestExecute_RerunsPhasesWithStaleMarkers(t *testing.T) {
+	dataDir := t.TempDir()
+
+	config, _, _ := resumeTestConfig(t, dataDir, &mockNodeInitializer{initializeErr: errors.New("init failed")})
+	_, err := NewProvisioningOrchestrator(config).Execute(context.Background(), resumeTestOptions(dataDir))
+	require.Error(t, err)
+
+	// Another version invalidates the build, and with it the fork
+	config, b, f := resumeTestConfig(t, dataDir, &mockNodeInitializer{initializeErr: errors.New("init failed")})
+	opts := resumeTestOptions(dataDir)
+	opts.BinaryVersion = "v2.0.0"
+	_, err = NewProvisioningOrchestrator(config).Execute(context.Background(), opts)
+	require.Error(t, err)
+	assert.True(t, b.buildCalled)
+	assert.True(t, f.forkCalled)
+
+	// A changed genesis invalidates the fork only
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "genesis.json"), []byte(`{"chain_id": "patched"}`), 0644))
+	b.buildCalled, f.forkCalled = false, false
+	_, err = NewProvisioningOrchestrator(config).Execute(context.Background(), opts)
+	require.Error(t, err)
+	assert.False(t, b.buildCalled)
+	assert.True(t, f.forkCalled)
+}
+
+func TestExecute_RetriesFailedBuild(t *testing.T) {
+	dataDir := t.TempDir()
+
+	config, mock, _ := resumeTestConfig(t, dataDir, &mockNodeInitializer{nodeIDResult: "node123"})
+	flaky := &flakyBinaryBuilder{mockBinaryBuilder: *mock, failures: 1}
+	config.BinaryBuilder = flaky
+	config.Retry.Build = retry.Policy{MaxAttempts: 2, InitialBackoff: time.Millisecond}
+
+	_, err := NewProvisioningOrchestrator(config).Execute(context.Background(), resumeTestOptions(dataDir))
+	require.NoError(t, err)
+	assert.Equal(t, 2, flaky.calls)
+
+	// Without a policy, a build is attempted once
+	flaky = &flakyBinaryBuilder{mockBinaryBuilder: *mock, failures: 1}
+	config.BinaryBuilder = flaky
+	config.Retry.Build = retry.Policy{}
+	opts := resumeTestOptions(t.TempDir())
+
+	_, err = NewProvisioningOrchestrator(config).Execute(context.Background(), opts)
+	require.Error(t, err)
+	assert.Equal(t, 1, flaky.calls)
+}
+
+func TestExecute_CountsInterruptedAttempts(t *testing.T) {
+	dataDir := t.TempDir()
+
+	// A previous daemon stopped in the middle of forking
+	require.NoError(t, (&provisionState{Phase: PhaseForking, Interruptions: 1}).save(dataDir))
+
+	p := NewDevnetProvisioner(store.NewMemoryStore(), Config{DataDir: filepath.Dir(dataDir)})
+	devnet := &types.Devnet{Metadata: types.ResourceMeta{Name: filepath.Base(dataDir)}}
+	assert.Equal(t, controller.ProvisionCheckpoint{Phase: "Forking", Interruptions: 1}, p.ProvisionCheckpoint(devnet))
+
+	// A failed attempt counts the interruption and clears the phase
+	config, _, _ := resumeTestConfig(t, dataDir, &mockNodeInitializer{initializeErr: errors.New("init failed")})
+	_, err := NewProvisioningOrchestrator(config).Execute(context.Background(), resumeTestOptions(dataDir))
+	require.Error(t, err)
+	assert.Equal(t, controller.ProvisionCheckpoint{Interruptions: 2}, p.ProvisionCheckpoint(devnet))
+
+	// A completed attempt resets the count
+	config.NodeInitializer = &mockNodeInitializer{nodeIDResult: "node123"}
+	_, err = NewProvisioningOrchestrator(config).Execute(context.Background(), resumeTestOptions(dataDir))
+	require.NoError(t, err)
+	assert.Equal(t, controller.ProvisionCheckpoint{}, p.ProvisionCheckpoint(devnet))
+}
+
+func TestDevnetProvisioner_CleanupProvisioning(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	s := store.NewMemoryStore()
+	p := NewDevnetProvisioner(s, Config{DataDir: dataDir})
+
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test-devnet"},
+		Spec:     types.DevnetSpec{Plugin: "stable", Validators: 2, Mode: "docker"},
+	}
+	require.NoError(t, p.Provision(ctx, devnet))
+	require.NoError(t, (&provisionState{Phase: PhaseInitializing}).save(filepath.Join(dataDir, "test-devnet")))
+
+	require.NoError(t, p.CleanupProvisioning(ctx, devnet))
+
+	nodes, err := s.ListNodes(ctx, "", "test-devnet")
+	require.NoError(t, err)
+	assert.Empty(t, nodes)
+	_, err = os.Stat(filepath.Join(dataDir, "test-devnet"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,175 @@
+// Package registries points docker image references at registry mirrors
+// and authenticates pulls from private registries, so docker-mode devnets
+// run in air-gapped or enterprise environments without editing the images
+// that plugins and devnet specs name.
+package registries
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/distribution/reference"
+	"github.com/docker/docker/api/types/registry"
+)
+
+// dockerHub is the domain of images named without a registry.
+const dockerHub = "docker.io"
+
+// Mirror rewrites the images under one prefix to another, e.g. From
+// "ghcr.io" and To "mirror.corp/ghcr" pulls ghcr.io/org/chain:v1 from
+// mirror.corp/ghcr/org/chain:v1.
+type Mirror struct {
+	// From is a registry, optionally followed by a repository path, e.g.
+	// "ghcr.io" or "docker.io/stablelabs". A path without a registry is on
+	// Docker Hub.
+	From string
+
+	// To replaces From in the references of the images under it.
+	To string
+}
+
+// Credentials authenticate pulls from one registry.
+type Credentials struct {
+	// Host is the registry, e.g. "mirror.corp" or "docker.io".
+	Host     string
+	Username string
+	Password string
+}
+
+// Resolver resolves the references images are pulled and run from. A nil
+// Resolver leaves references unchanged and pulls anonymously.
+type Resolver struct {
+	mirrors     []Mirror // By normalized From, longest first
+	credentials map[string]Credentials
+}
+
+// NewResolver returns a resolver for validated mirrors and credentials, or
+// nil when there are none.
+func NewResolver(mirrors []Mirror, credentials []Credentials) *Resolver {
+	if len(mirrors) == 0 && len(credentials) == 0 {
+		return nil
+	}
+	r := &Resolver{credentials: make(map[string]Credentials, len(credentials))}
+	for _, m := range mirrors {
+		r.mirrors = append(r.mirrors, Mirror{From: normalizePrefix(m.From), To: strings.TrimSuffix(m.To, "/")})
+	}
+	sort.SliceStable(r.mirrors, func(i, j int) bool { return len(r.mirrors[i].From) > len(r.mirrors[j].From) })
+	for _, c := range credentials {
+		r.credentials[normalizeHost(c.Host)] = c
+	}
+	return r
+}
+
+// Rewrite returns the reference image is pulled and run from: image under
+// the longest matching mirror prefix, or image itself.
+func (r *Resolver) Rewrite(image string) string {
+	if r == nil || len(r.mirrors) == 0 {
+		return image
+	}
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return image
+	}
+	full := named.String()
+	for _, m := range r.mirrors {
+		if rest, ok := strings.CutPrefix(full, m.From); ok && strings.HasPrefix(rest, "/") {
+			return m.To + rest
+		}
+	}
+	return image
+}
+
+// Auth returns the encoded credentials of the registry image is pulled
+// from, for the docker API, or "" to pull anonymously.
+func (r *Resolver) Auth(image string) (string, error) {
+	if r == nil || len(r.credentials) == 0 {
+		return "", nil
+	}
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", nil
+	}
+	c, ok := r.credentials[reference.Domain(named)]
+	if !ok {
+		return "", nil
+	}
+	server := c.Host
+	if normalizeHost(c.Host) == dockerHub {
+		server = "https://index.docker.io/v1/"
+	}
+	return registry.EncodeAuthConfig(registry.AuthConfig{
+		Username:      c.Username,
+		Password:      c.Password,
+		ServerAddress: server,
+	})
+}
+
+// Validate reports mirrors and credentials that cannot be used: missing or
+// invalid prefixes and hosts, duplicates, and credentials without a
+// username or password.
+func Validate(mirrors []Mirror, credentials []Credentials) error {
+	var errs []error
+	froms := make(map[string]bool)
+	for i, m := range mirrors {
+		if !validPrefix(m.From) {
+			errs = append(errs, fmt.Errorf("mirror %d: invalid from %q: want a registry or repository prefix like ghcr.io", i, m.From))
+		} else if from := normalizePrefix(m.From); froms[from] {
+			errs = append(errs, fmt.Errorf("mirror %d: duplicate from %q", i, m.From))
+		} else {
+			froms[from] = true
+		}
+		if !validPrefix(m.To) {
+			errs = append(errs, fmt.Errorf("mirror %d: invalid to %q: want a registry or repository prefix like mirror.corp/ghcr", i, m.To))
+		}
+	}
+
+	hosts := make(map[string]bool)
+	for i, c := range credentials {
+		if c.Host == "" || strings.Contains(c.Host, "/") {
+			errs = append(errs, fmt.Errorf("registry %d: invalid host %q: want a registry like mirror.corp", i, c.Host))
+		} else if host := normalizeHost(c.Host); hosts[host] {
+			errs = append(errs, fmt.Errorf("registry %d: duplicate host %q", i, c.Host))
+		} else {
+			hosts[host] = true
+		}
+		if c.Username == "" || c.Password == "" {
+			errs = append(errs, fmt.Errorf("registry %d (%s): username and password are required", i, c.Host))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validPrefix reports whether prefix is a registry or repository path that
+// images can be named under.
+func validPrefix(prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" || strings.Contains(prefix, "://") {
+		return false
+	}
+	named, err := reference.ParseNormalizedNamed(prefix + "/image")
+	return err == nil && reference.IsNameOnly(named)
+}
+
+// normalizePrefix qualifies a prefix without a registry with Docker Hub's.
+func normalizePrefix(prefix string) string {
+	prefix = strings.TrimSuffix(prefix, "/")
+	first, _, _ := strings.Cut(prefix, "/")
+	if first == "localhost" || strings.ContainsAny(first, ".:") {
+		if normalizeHost(first) == dockerHub {
+			return dockerHub + strings.TrimPrefix(prefix, first)
+		}
+		return prefix
+	}
+	return dockerHub + "/" + prefix
+}
+
+// normalizeHost returns the domain of Docker Hub's aliases as docker.io.
+func normalizeHost(host string) string {
+	switch host {
+	case "index.docker.io", "registry-1.docker.io":
+		return dockerHub
+	}
+	return host
+}
@@ -0,0 +1,106 @@
+package registries
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/docker/docker/api/types/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Rewrite(t *testing.T) {
+	r := NewResolver([]Mirror{
+		{From: "ghcr.io", To: "mirror.corp/ghcr"},
+		{From: "ghcr.io/cosmos", To: "mirror.corp/cosmos/"},
+		{From: "docker.io", To: "mirror.corp/hub"},
+		{From: "stablelabs", To: "mirror.corp/stable"},
+	}, nil)
+
+	tests := []struct {
+		image string
+		want  string
+	}{
+		{"ghcr.io/org/chain:v1", "mirror.corp/ghcr/org/chain:v1"},
+		{"ghcr.io/cosmos/gaia:v19", "mirror.corp/cosmos/gaia:v19"},
+		{"ghcr.io/cosmoshub/gaia:v19", "mirror.corp/ghcr/cosmoshub/gaia:v19"},
+		{"stablelabs/stabled:latest", "mirror.corp/stable/stabled:latest"},
+		{"docker.io/stablelabs/stabled", "mirror.corp/stable/stabled"},
+		{"nginx:1.27", "mirror.corp/hub/library/nginx:1.27"},
+		{"quay.io/org/chain:v1", "quay.io/org/chain:v1"},
+		{"mirror.corp/ghcr/org/chain:v1", "mirror.corp/ghcr/org/chain:v1"},
+		{"Not A Ref", "Not A Ref"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, r.Rewrite(tt.image), tt.image)
+	}
+
+	var none *Resolver
+	assert.Equal(t, "ghcr.io/org/chain:v1", none.Rewrite("ghcr.io/org/chain:v1"))
+	assert.Nil(t, NewResolver(nil, nil))
+}
+
+func TestResolver_Auth(t *testing.T) {
+	r := NewResolver(nil, []Credentials{
+		{Host: "mirror.corp", Username: "ci", Password: "secret"},
+		{Host: "index.docker.io", Username: "hub", Password: "token"},
+	})
+
+	decode := func(image string) registry.AuthConfig {
+		encoded, err := r.Auth(image)
+		require.NoError(t, err)
+		require.NotEmpty(t, encoded, image)
+		data, err := base64.URLEncoding.DecodeString(encoded)
+		require.NoError(t, err)
+		var auth registry.AuthConfig
+		require.NoError(t, json.Unmarshal(data, &auth))
+		return auth
+	}
+
+	auth := decode("mirror.corp/ghcr/org/chain:v1")
+	assert.Equal(t, "ci", auth.Username)
+	assert.Equal(t, "secret", auth.Password)
+	assert.Equal(t, "mirror.corp", auth.ServerAddress)
+
+	auth = decode("stablelabs/stabled:latest")
+	assert.Equal(t, "hub", auth.Username)
+	assert.Equal(t, "https://index.docker.io/v1/", auth.ServerAddress)
+
+	encoded, err := r.Auth("ghcr.io/org/chain:v1")
+	require.NoError(t, err)
+	assert.Empty(t, encoded)
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(
+		[]Mirror{{From: "ghcr.io", To: "mirror.corp/ghcr"}, {From: "stablelabs", To: "localhost:5000/stable"}},
+		[]Credentials{{Host: "mirror.corp", Username: "ci", Password: "secret"}},
+	))
+
+	err := Validate(
+		[]Mirror{
+			{From: "", To: "mirror.corp"},
+			{From: "https://ghcr.io", To: "mirror.corp"},
+			{From: "ghcr.io", To: "mirror.corp/ghcr:v1"},
+			{From: "ghcr.io/", To: "mirror.corp/other"},
+		},
+		[]Credentials{
+			{Host: "mirror.corp/ghcr", Username: "ci", Password: "secret"},
+			{Host: "docker.io", Username: "hub", Password: "token"},
+			{Host: "index.docker.io", Username: "hub"},
+		},
+	)
+	require.Error(t, err)
+	for _, want := range []string{
+		`mirror 0: invalid from ""`,
+		`mirror 1: invalid from "https://ghcr.io"`,
+		`mirror 2: invalid to "mirror.corp/ghcr:v1"`,
+		`mirror 3: duplicate from "ghcr.io/"`,
+		`registry 0: invalid host "mirror.corp/ghcr"`,
+		`registry 2: duplicate host "index.docker.io"`,
+		"registry 2 (index.docker.io): username and password are required",
+	} {
+		assert.Contains(t, err.Error(), want)
+	}
+}
@@ -0,0 +1,146 @@
+// Package retry retries provisioning steps that fail on transient errors,
+// such as a dropped snapshot download or a node port still held by a
+// process that is shutting down, with exponential backoff.
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Policy says how often a step is attempted and how long to wait between
+// attempts. The zero Policy attempts a step once.
+type Policy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// Values below 1 mean 1.
+	MaxAttempts int
+
+	// InitialBackoff is the wait before the second attempt.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the wait between attempts. Zero means no cap.
+	MaxBackoff time.Duration
+
+	// Multiplier grows the wait after each attempt. Values below 1 mean
+	// a constant wait.
+	Multiplier float64
+}
+
+// Backoff returns the wait after the given failed attempt, counting from 1.
+func (p Policy) Backoff(attempt int) time.Duration {
+	wait := float64(p.InitialBackoff)
+	for i := 1; i < attempt && p.Multiplier > 1; i++ {
+		wait *= p.Multiplier
+		if p.MaxBackoff > 0 && wait >= float64(p.MaxBackoff) {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+	return time.Duration(wait)
+}
+
+// Validate reports a policy that cannot be used.
+func (p Policy) Validate() error {
+	switch {
+	case p.MaxAttempts < 1:
+		return fmt.Errorf("max_attempts must be at least 1")
+	case p.InitialBackoff < 0 || p.MaxBackoff < 0:
+		return fmt.Errorf("backoff must not be negative")
+	case p.MaxBackoff > 0 && p.MaxBackoff < p.InitialBackoff:
+		return fmt.Errorf("max_backoff must not be less than initial_backoff")
+	case p.Multiplier != 0 && p.Multiplier < 1:
+		return fmt.Errorf("multiplier must be at least 1")
+	}
+	return nil
+}
+
+// Policies are the retry policies of the provisioning steps.
+type Policies struct {
+	// Build covers building or downloading the chain binary.
+	Build Policy
+
+	// Snapshot covers forking genesis, which downloads and exports the
+	// snapshot in snapshot mode.
+	Snapshot Policy
+
+	// NodeStart covers starting a node process or container.
+	NodeStart Policy
+}
+
+// DefaultPolicies returns the policies devnetd uses unless devnetd.toml
+// sets others.
+func DefaultPolicies() Policies {
+	return Policies{
+		Build: Policy{
+			MaxAttempts:    2,
+			InitialBackoff: 10 * time.Second,
+			MaxBackoff:     time.Minute,
+			Multiplier:     2,
+		},
+		Snapshot: Policy{
+			MaxAttempts:    3,
+			InitialBackoff: 30 * time.Second,
+			MaxBackoff:     5 * time.Minute,
+			Multiplier:     2,
+		},
+		NodeStart: Policy{
+			MaxAttempts:    3,
+			InitialBackoff: 2 * time.Second,
+			MaxBackoff:     30 * time.Second,
+			Multiplier:     2,
+		},
+	}
+}
+
+// permanentError marks an error that retrying cannot fix.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as not worth retrying: Do returns it at once.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err was marked with Permanent.
+func IsPermanent(err error) bool {
+	var p *permanentError
+	return errors.As(err, &p)
+}
+
+// Do runs fn until it succeeds, returns a permanent error, ctx is done or
+// the policy's attempts are used up, and returns fn's last error. onRetry,
+// if not nil, is called before each wait with the failed attempt, its
+// error and the wait.
+func Do(ctx context.Context, p Policy, fn func(ctx context.Context) error, onRetry func(attempt int, err error, wait time.Duration)) error {
+	attempts := max(p.MaxAttempts, 1)
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = fn(ctx)
+		if err == nil || IsPermanent(err) || ctx.Err() != nil || attempt >= attempts {
+			return err
+		}
+
+		wait := p.Backoff(attempt)
+		if onRetry != nil {
+			onRetry(attempt, err, wait)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy_Backoff(t *testing.T) {
+	p := Policy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second, Multiplier: 2}
+
+	assert.Equal(t, time.Second, p.Backoff(1))
+	assert.Equal(t, 2*time.Second, p.Backoff(2))
+	assert.Equal(t, 4*time.Second, p.Backoff(3))
+	assert.Equal(t, 5*time.Second, p.Backoff(4))
+	assert.Equal(t, 5*time.Second, p.Backoff(100))
+
+	// Without a multiplier the wait is constant
+	assert.Equal(t, time.Second, Policy{InitialBackoff: time.Second}.Backoff(3))
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	require.NoError(t, DefaultPolicies().Build.Validate())
+	require.NoError(t, DefaultPolicies().Snapshot.Validate())
+	require.NoError(t, DefaultPolicies().NodeStart.Validate())
+
+	assert.Error(t, Policy{}.Validate())
+	assert.Error(t, Policy{MaxAttempts: 1, InitialBackoff: -time.Second}.Validate())
+	assert.Error(t, Policy{MaxAttempts: 1, InitialBackoff: time.Minute, MaxBackoff: time.Second}.Validate())
+	assert.Error(t, Policy{MaxAttempts: 1, Multiplier: 0.5}.Validate())
+}
+
+func TestDo_RetriesUntilSuccess(t *testing.T) {
+	p := Policy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+	calls := 0
+	var retried []int
+
+	err := Do(context.Background(), p, func(ctx context.Context) error {
+		calls++
+		if calls < 3 {
+			return errors.New("connection reset")
+		}
+		return nil
+	}, func(attempt int, err error, wait time.Duration) {
+		retried = append(retried, attempt)
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []int{1, 2}, retried)
+}
+
+func TestDo_StopsAfterMaxAttempts(t *testing.T) {
+	p := Policy{MaxAttempts: 2, InitialBackoff: time.Millisecond}
+	calls := 0
+
+	err := Do(context.Background(), p, func(ctx context.Context) error {
+		calls++
+		return errors.New("connection reset")
+	}, nil)
+
+	assert.EqualError(t, err, "connection reset")
+	assert.Equal(t, 2, calls)
+}
+
+func TestDo_ZeroPolicyAttemptsOnce(t *testing.T) {
+	calls := 0
+	err := Do(context.Background(), Policy{}, func(ctx context.Context) error {
+		calls++
+		return errors.New("boom")
+	}, nil)
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestDo_StopsOnPermanentError(t *testing.T) {
+	p := Policy{MaxAttempts: 5, InitialBackoff: time.Millisecond}
+	calls := 0
+	cause := errors.New("plugin not found")
+
+	err := Do(context.Background(), p, func(ctx context.Context) error {
+		calls++
+		return Permanent(cause)
+	}, nil)
+
+	assert.ErrorIs(t, err, cause)
+	assert.True(t, IsPermanent(err))
+	assert.Equal(t, 1, calls)
+	assert.NoError(t, Permanent(nil))
+}
+
+func TestDo_StopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := Policy{MaxAttempts: 5, InitialBackoff: time.Hour}
+	calls := 0
+
+	err := Do(ctx, p, func(ctx context.Context) error {
+		calls++
+		return errors.New("connection reset")
+	}, func(attempt int, err error, wait time.Duration) {
+		cancel()
+	})
+
+	assert.EqualError(t, err, "connection reset")
+	assert.Equal(t, 1, calls)
+}
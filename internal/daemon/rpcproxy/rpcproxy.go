@@ -0,0 +1,225 @@
+// internal/daemon/rpcproxy/rpcproxy.go
+
+// Package rpcproxy serves the endpoints of every devnet node behind one
+// address, so tools are configured once instead of per node port:
+//
+//	/<devnet>/<node>/rpc/...              CometBFT RPC, including /websocket
+//	/<devnet>/<node>/rest/...             Cosmos REST API
+//	/<devnet>/<node>/evm-rpc/...          EVM JSON-RPC
+//	/<namespace>/<devnet>/<node>/<service>/...
+//
+// gRPC clients cannot add a path prefix, so gRPC requests (HTTP/2 with an
+// application/grpc content type) are routed by their "x-devnet-node"
+// metadata instead: "[<namespace>/]<devnet>/<node>".
+package rpcproxy
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"time"
+)
+
+// Services that can be proxied.
+const (
+	ServiceRPC    = "rpc"
+	ServiceREST   = "rest"
+	ServiceGRPC   = "grpc"
+	ServiceEVMRPC = "evm-rpc"
+)
+
+// NodeHeader routes gRPC requests to a node.
+const NodeHeader = "x-devnet-node"
+
+// defaultNamespace is the namespace of routes without one.
+const defaultNamespace = "default"
+
+// ErrNotFound is wrapped by Resolver errors for a devnet, node or service
+// that does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Resolver returns the host:port a node serves a service on.
+type Resolver interface {
+	Resolve(ctx context.Context, namespace, devnet, node, service string) (string, error)
+}
+
+// Config configures a Proxy.
+type Config struct {
+	Resolver Resolver
+	// Username and Password, if set, are required as HTTP basic auth.
+	Username string
+	Password string
+	// LogRequests logs every proxied request.
+	LogRequests bool
+	Logger      *slog.Logger
+}
+
+// Proxy is an http.Handler routing requests to devnet nodes.
+type Proxy struct {
+	config Config
+	http   *httputil.ReverseProxy
+	grpc   *httputil.ReverseProxy
+}
+
+// route is where a request goes.
+type route struct {
+	namespace, devnet, node, service string
+	// path is the request path on the node.
+	path string
+}
+
+type routeKey struct{}
+
+// New creates a Proxy.
+func New(cfg Config) *Proxy {
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+	p := &Proxy{config: cfg}
+
+	errorHandler := func(w http.ResponseWriter, r *http.Request, err error) {
+		cfg.Logger.Debug("proxied request failed", "path", r.URL.Path, "error", err)
+		http.Error(w, "node unreachable: "+err.Error(), http.StatusBadGateway)
+	}
+	p.http = &httputil.ReverseProxy{Rewrite: p.rewrite, ErrorHandler: errorHandler}
+
+	// gRPC needs HTTP/2 to the node, which serves it without TLS
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Protocols = new(http.Protocols)
+	transport.Protocols.SetUnencryptedHTTP2(true)
+	p.grpc = &httputil.ReverseProxy{Rewrite: p.rewrite, Transport: transport, ErrorHandler: errorHandler}
+	return p
+}
+
+// ServeHTTP routes a request to its node.
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if p.config.Username != "" && !p.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="devnetd"`)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	rt, ok := parseRoute(r)
+	if !ok {
+		http.Error(w, "want /[<namespace>/]<devnet>/<node>/{rpc,rest,evm-rpc}/..., or gRPC with "+NodeHeader+" metadata", http.StatusNotFound)
+		return
+	}
+
+	addr, err := p.config.Resolver.Resolve(r.Context(), rt.namespace, rt.devnet, rt.node, rt.service)
+	if err != nil {
+		code := http.StatusBadGateway
+		if errors.Is(err, ErrNotFound) {
+			code = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), code)
+		return
+	}
+
+	start := time.Now()
+	rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	ctx := context.WithValue(r.Context(), routeKey{}, proxyTarget{route: rt, addr: addr})
+	if rt.service == ServiceGRPC {
+		p.grpc.ServeHTTP(rec, r.WithContext(ctx))
+	} else {
+		p.http.ServeHTTP(rec, r.WithContext(ctx))
+	}
+
+	if p.config.LogRequests {
+		p.config.Logger.Info("proxied request",
+			"namespace", rt.namespace,
+			"devnet", rt.devnet,
+			"node", rt.node,
+			"service", rt.service,
+			"method", r.Method,
+			"path", rt.path,
+			"status", rec.status,
+			"duration", time.Since(start).Round(time.Millisecond),
+			"remote", r.RemoteAddr)
+	}
+}
+
+// proxyTarget is the route of a request and the address it resolved to.
+type proxyTarget struct {
+	route
+	addr string
+}
+
+// rewrite points the outgoing request at the node.
+func (p *Proxy) rewrite(pr *httputil.ProxyRequest) {
+	target := pr.In.Context().Value(routeKey{}).(proxyTarget)
+	pr.Out.URL.Scheme = "http"
+	pr.Out.URL.Host = target.addr
+	pr.Out.URL.Path = target.path
+	pr.Out.URL.RawPath = ""
+	pr.Out.Host = ""
+	pr.Out.Header.Del("Authorization")
+	pr.Out.Header.Del(NodeHeader)
+	pr.SetXForwarded()
+}
+
+// authorized checks the request's basic auth.
+func (p *Proxy) authorized(r *http.Request) bool {
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		return false
+	}
+	userOK := subtle.ConstantTimeCompare([]byte(username), []byte(p.config.Username)) == 1
+	passOK := subtle.ConstantTimeCompare([]byte(password), []byte(p.config.Password)) == 1
+	return userOK && passOK
+}
+
+// parseRoute returns where a request goes: by path, or by its NodeHeader
+// for gRPC.
+func parseRoute(r *http.Request) (route, bool) {
+	if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+		parts := strings.Split(r.Header.Get(NodeHeader), "/")
+		rt := route{namespace: defaultNamespace, service: ServiceGRPC, path: r.URL.Path}
+		switch len(parts) {
+		case 2:
+			rt.devnet, rt.node = parts[0], parts[1]
+		case 3:
+			rt.namespace, rt.devnet, rt.node = parts[0], parts[1], parts[2]
+		default:
+			return route{}, false
+		}
+		return rt, rt.namespace != "" && rt.devnet != "" && rt.node != ""
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	var rt route
+	switch {
+	case len(parts) >= 3 && isPathService(parts[2]):
+		rt = route{namespace: defaultNamespace, devnet: parts[0], node: parts[1], service: parts[2], path: "/" + strings.Join(parts[3:], "/")}
+	case len(parts) >= 4 && isPathService(parts[3]):
+		rt = route{namespace: parts[0], devnet: parts[1], node: parts[2], service: parts[3], path: "/" + strings.Join(parts[4:], "/")}
+	default:
+		return route{}, false
+	}
+	return rt, rt.namespace != "" && rt.devnet != "" && rt.node != ""
+}
+
+// isPathService reports whether a path segment names a service routed by
+// path.
+func isPathService(s string) bool {
+	return s == ServiceRPC || s == ServiceREST || s == ServiceEVMRPC
+}
+
+// statusRecorder records the status of a response. Unwrap lets the reverse
+// proxy flush and hijack the connection for websockets.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
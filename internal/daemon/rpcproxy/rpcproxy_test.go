@@ -0,0 +1,112 @@
+// internal/daemon/rpcproxy/rpcproxy_test.go
+package rpcproxy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeResolver serves every known node's services from one address.
+type fakeResolver struct {
+	addr  string
+	nodes map[string]bool // "<namespace>/<devnet>/<node>"
+}
+
+func (f *fakeResolver) Resolve(ctx context.Context, namespace, devnet, node, service string) (string, error) {
+	if !f.nodes[namespace+"/"+devnet+"/"+node] {
+		return "", fmt.Errorf("node %q of devnet %q: %w", node, devnet, ErrNotFound)
+	}
+	return f.addr, nil
+}
+
+func TestProxy(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s?%s auth=%q", r.URL.Path, r.URL.RawQuery, r.Header.Get("Authorization"))
+	}))
+	defer backend.Close()
+
+	resolver := &fakeResolver{
+		addr:  strings.TrimPrefix(backend.URL, "http://"),
+		nodes: map[string]bool{"default/alpha/node0": true, "team/beta/validator-1": true},
+	}
+
+	get := func(t *testing.T, proxy http.Handler, path string, auth bool) (int, string) {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if auth {
+			req.SetBasicAuth("ci", "s3cret")
+		}
+		rec := httptest.NewRecorder()
+		proxy.ServeHTTP(rec, req)
+		body, _ := io.ReadAll(rec.Body)
+		return rec.Code, string(body)
+	}
+
+	t.Run("routes by path", func(t *testing.T) {
+		proxy := New(Config{Resolver: resolver})
+
+		code, body := get(t, proxy, "/alpha/node0/rpc/status?height=5", false)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, `/status?height=5 auth=""`, body)
+
+		code, body = get(t, proxy, "/team/beta/validator-1/rest/cosmos/base/tendermint/v1beta1/blocks/latest", false)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Contains(t, body, "/cosmos/base/tendermint/v1beta1/blocks/latest?")
+
+		code, _ = get(t, proxy, "/alpha/node9/rpc/status", false)
+		assert.Equal(t, http.StatusNotFound, code)
+
+		code, _ = get(t, proxy, "/alpha/node0/status", false)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("requires basic auth when configured", func(t *testing.T) {
+		proxy := New(Config{Resolver: resolver, Username: "ci", Password: "s3cret"})
+
+		code, _ := get(t, proxy, "/alpha/node0/rpc/status", false)
+		assert.Equal(t, http.StatusUnauthorized, code)
+
+		code, body := get(t, proxy, "/alpha/node0/rpc/status", true)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Contains(t, body, `auth=""`, "credentials are not forwarded to the node")
+	})
+
+	t.Run("reports unreachable nodes", func(t *testing.T) {
+		proxy := New(Config{Resolver: &fakeResolver{addr: "127.0.0.1:1", nodes: resolver.nodes}})
+		code, _ := get(t, proxy, "/alpha/node0/rpc/status", false)
+		assert.Equal(t, http.StatusBadGateway, code)
+	})
+}
+
+func TestParseRoute(t *testing.T) {
+	grpcRequest := func(node string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/cosmos.bank.v1beta1.Query/Balance", nil)
+		req.ProtoMajor = 2
+		req.Header.Set("Content-Type", "application/grpc")
+		req.Header.Set(NodeHeader, node)
+		return req
+	}
+
+	rt, ok := parseRoute(grpcRequest("team/beta/node1"))
+	require.True(t, ok)
+	assert.Equal(t, route{namespace: "team", devnet: "beta", node: "node1", service: ServiceGRPC, path: "/cosmos.bank.v1beta1.Query/Balance"}, rt)
+
+	rt, ok = parseRoute(grpcRequest("alpha/node0"))
+	require.True(t, ok)
+	assert.Equal(t, "default", rt.namespace)
+
+	_, ok = parseRoute(grpcRequest(""))
+	assert.False(t, ok, "gRPC requests need the node header")
+
+	rt, ok = parseRoute(httptest.NewRequest(http.MethodPost, "/alpha/node0/evm-rpc", nil))
+	require.True(t, ok)
+	assert.Equal(t, route{namespace: "default", devnet: "alpha", node: "node0", service: ServiceEVMRPC, path: "/"}, rt)
+}
@@ -3,18 +3,26 @@ package runtime
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/portalloc"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/registries"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
 	dockertypes "github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	imagetypes "github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/mount"
 	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/registry"
 	"github.com/docker/docker/client"
 	"github.com/docker/go-connections/nat"
 	specs "github.com/opencontainers/image-spec/specs-go/v1"
@@ -39,6 +47,13 @@ type dockerClient interface {
 	ContainerRemove(ctx context.Context, containerID string, opts container.RemoveOptions) error
 	ContainerInspect(ctx context.Context, containerID string) (dockertypes.ContainerJSON, error)
 	ContainerLogs(ctx context.Context, containerID string, opts container.LogsOptions) (io.ReadCloser, error)
+	ContainerWait(ctx context.Context, containerID string, condition container.WaitCondition) (<-chan container.WaitResponse, <-chan error)
+	NetworkCreate(ctx context.Context, name string, options network.CreateOptions) (network.CreateResponse, error)
+	NetworkInspect(ctx context.Context, networkID string, options network.InspectOptions) (network.Inspect, error)
+	NetworkRemove(ctx context.Context, networkID string) error
+	ImagePull(ctx context.Context, refStr string, options imagetypes.PullOptions) (io.ReadCloser, error)
+	ImageInspect(ctx context.Context, imageID string, inspectOpts ...client.ImageInspectOption) (imagetypes.InspectResponse, error)
+	DistributionInspect(ctx context.Context, imageRef, encodedRegistryAuth string) (registry.DistributionInspect, error)
 	ContainerExecCreate(ctx context.Context, containerID string, config container.ExecOptions) (container.ExecCreateResponse, error)
 	ContainerExecAttach(ctx context.Context, execID string, config container.ExecStartOptions) (dockertypes.HijackedResponse, error)
 	ContainerExecInspect(ctx context.Context, execID string) (container.ExecInspect, error)
@@ -55,23 +70,71 @@ type containerState struct {
 	lastError     string
 	restartPolicy RestartPolicy
 
+	// spec is what the container was created with
+	spec ContainerSpec
+
 	// Supervision channels
 	stopCh    chan struct{}
 	stoppedCh chan struct{}
 }
 
+// devnet returns the name of the container's devnet.
+func (s *containerState) devnet() string {
+	if s.node == nil {
+		return ""
+	}
+	return s.node.Spec.DevnetRef
+}
+
 // DockerRuntime implements NodeRuntime using Docker containers.
 type DockerRuntime struct {
 	client        dockerClient
 	logger        *slog.Logger
 	pluginRuntime PluginRuntime
 	defaultImage  string
+	registries    *registries.Resolver
+
+	// Host port allocation; nil publishes node i at the default ports
+	// plus i*100
+	portAllocator   *portalloc.Allocator
+	reallocatePorts bool
 
 	// Container tracking
 	containers map[string]*containerState
 	mu         sync.RWMutex
 }
 
+// ContainerSpec describes the container a node was started in.
+type ContainerSpec struct {
+	Name          string
+	Role          string
+	Index         int
+	Image         string
+	Command       []string
+	Env           []string // KEY=value
+	Mounts        []BindMount
+	Ports         []PortBinding
+	Labels        map[string]string
+	RestartPolicy string
+
+	// Aliases are the container's DNS names on its devnet's network.
+	Aliases []string
+}
+
+// BindMount is a host directory mounted into a container.
+type BindMount struct {
+	Source string
+	Target string
+}
+
+// PortBinding publishes a container port on a host port. An empty HostIP
+// publishes on all host addresses.
+type PortBinding struct {
+	HostIP        string
+	HostPort      int
+	ContainerPort int
+}
+
 // DockerConfig configures the Docker runtime.
 type DockerConfig struct {
 	// DefaultImage is used when node spec doesn't specify an image.
@@ -82,6 +145,18 @@ type DockerConfig struct {
 
 	// PluginRuntime provides network-specific commands.
 	PluginRuntime PluginRuntime
+
+	// PortAllocator assigns each node a block of host ports, checking that
+	// they are free before the container starts. Optional.
+	PortAllocator *portalloc.Allocator
+
+	// ReallocatePorts moves a node whose host ports are in use to the next
+	// free block instead of failing to start it.
+	ReallocatePorts bool
+
+	// Registries rewrites the images nodes run to registry mirrors and
+	// authenticates pulls. Optional.
+	Registries *registries.Resolver
 }
 
 // NewDockerRuntime creates a new Docker runtime.
@@ -102,36 +177,113 @@ func NewDockerRuntime(cfg DockerConfig) (*DockerRuntime, error) {
 	}
 
 	return &DockerRuntime{
-		client:        cli,
-		logger:        logger,
-		pluginRuntime: cfg.PluginRuntime,
-		defaultImage:  defaultImage,
-		containers:    make(map[string]*containerState),
+		client:          cli,
+		logger:          logger,
+		pluginRuntime:   cfg.PluginRuntime,
+		defaultImage:    defaultImage,
+		registries:      cfg.Registries,
+		portAllocator:   cfg.PortAllocator,
+		reallocatePorts: cfg.ReallocatePorts,
+		containers:      make(map[string]*containerState),
 	}, nil
 }
 
+// createContainerError wraps a ContainerCreate failure. Creating a
+// container never pulls its image (PinImage pulls node images beforehand,
+// unless offline), so a missing image gets an actionable hint; this is what
+// offline provisioning relies on for cached images.
+func createContainerError(image string, err error) error {
+	var notFound interface{ NotFound() }
+	if errors.As(err, &notFound) {
+		return missingImageError(image, err)
+	}
+	return fmt.Errorf("failed to create container: %w", err)
+}
+
+// missingImageError reports an image that is not available locally.
+func missingImageError(image string, err error) error {
+	return fmt.Errorf("image %q is not available locally; run 'docker pull %s' (or 'docker load' on an offline host) first: %w", image, image, err)
+}
+
 // containerName generates a container name from the node spec.
 func containerName(node *types.Node) string {
 	return fmt.Sprintf("dvb-%s-node-%d", node.Spec.DevnetRef, node.Spec.Index)
 }
 
-// buildPortBindings creates port mappings for a node based on its index.
-// Each node gets a 100-port range offset to avoid conflicts.
-func (r *DockerRuntime) buildPortBindings(node *types.Node) (nat.PortMap, nat.PortSet) {
-	offset := node.Spec.Index * 100
+// NodeContainerName returns the name of the container StartNode runs node
+// in. Sidecars such as a node's remote signer join it by this name.
+func NodeContainerName(node *types.Node) string {
+	return fmt.Sprintf("dvb-%s-%s-%d", node.Spec.DevnetRef, node.Spec.Role, node.Spec.Index)
+}
+
+// DevnetNetworkName returns the name of the docker network a devnet's
+// containers are attached to.
+func DevnetNetworkName(devnet string) string {
+	return "dvb-" + devnet
+}
 
+// containerNode returns the node as seen from inside its container. The
+// node's bind address is where its ports are published on the host, so the
+// container itself listens on all interfaces.
+func containerNode(node *types.Node) *types.Node {
+	if node.Spec.BindAddress == "" {
+		return node
+	}
+	n := *node
+	n.Spec.BindAddress = ""
+	return &n
+}
+
+// hostPorts returns the host ports a node's container ports are published
+// on, from the node's port layout. Without a port allocator, each node gets
+// the block matching its index; with one, it gets a block whose ports are
+// free. Nodes whose layout has no stride are spaced portalloc.BlockSize
+// apart.
+func (r *DockerRuntime) hostPorts(node *types.Node) (dvbtypes.PortConfig, error) {
+	layout := node.Spec.PortLayout
+	if layout.Stride == 0 {
+		layout.Stride = portalloc.BlockSize
+	}
+	if r.portAllocator == nil {
+		return layout.ForNode(node.Spec.Index), nil
+	}
+
+	namespace := node.Spec.NamespaceRef
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+	base := layout.ForNode(0)
+	block, err := r.portAllocator.Allocate(namespace, node.Spec.DevnetRef, node.Spec.Index,
+		[]int{base.P2P, base.RPC, base.API, base.GRPC}, layout.Stride, r.reallocatePorts)
+	if err != nil {
+		return dvbtypes.PortConfig{}, fmt.Errorf("failed to allocate host ports: %w", err)
+	}
+	ports := layout.ForNode(block)
+	if block != node.Spec.Index {
+		r.logger.Info("node host ports moved to a free block",
+			"devnet", node.Spec.DevnetRef,
+			"index", node.Spec.Index,
+			"rpcPort", ports.RPC)
+	}
+	return ports, nil
+}
+
+// buildPortBindings creates port mappings for a node, publishing each
+// container port on the matching host port. An empty hostIP publishes on
+// all host addresses.
+func (r *DockerRuntime) buildPortBindings(hostIP string, hostPorts dvbtypes.PortConfig) (nat.PortMap, nat.PortSet) {
 	portBindings := nat.PortMap{
 		nat.Port(fmt.Sprintf("%d/tcp", P2PPort)): []nat.PortBinding{
-			{HostPort: fmt.Sprintf("%d", P2PPort+offset)},
+			{HostIP: hostIP, HostPort: fmt.Sprintf("%d", hostPorts.P2P)},
 		},
 		nat.Port(fmt.Sprintf("%d/tcp", RPCPort)): []nat.PortBinding{
-			{HostPort: fmt.Sprintf("%d", RPCPort+offset)},
+			{HostIP: hostIP, HostPort: fmt.Sprintf("%d", hostPorts.RPC)},
 		},
 		nat.Port(fmt.Sprintf("%d/tcp", RESTPort)): []nat.PortBinding{
-			{HostPort: fmt.Sprintf("%d", RESTPort+offset)},
+			{HostIP: hostIP, HostPort: fmt.Sprintf("%d", hostPorts.API)},
 		},
 		nat.Port(fmt.Sprintf("%d/tcp", GRPCPort)): []nat.PortBinding{
-			{HostPort: fmt.Sprintf("%d", GRPCPort+offset)},
+			{HostIP: hostIP, HostPort: fmt.Sprintf("%d", hostPorts.GRPC)},
 		},
 	}
 
@@ -155,29 +307,39 @@ func (r *DockerRuntime) StartContainer(ctx context.Context, node *types.Node) (s
 		"index", node.Spec.Index,
 		"role", node.Spec.Role)
 
-	// Determine image - use BinaryPath if it looks like a Docker image, otherwise default
+	// Determine image - explicit spec image wins, then BinaryPath if set, otherwise default
 	image := r.defaultImage
-	if node.Spec.BinaryPath != "" {
+	if node.Spec.Image != "" {
+		image = node.Spec.Image
+	} else if node.Spec.BinaryPath != "" {
 		// If BinaryPath contains "/" it might be an image reference
 		// Otherwise use the default image
 		image = node.Spec.BinaryPath
 	}
+	image = r.registries.Rewrite(image)
 
 	// Get command and environment from plugin runtime (if available)
 	cmd := []string{"start", "--home", "/root/.stabled"} // fallback
 	var env []string
 	containerHomePath := "/root/.stabled" // fallback
 	if r.pluginRuntime != nil {
-		cmd = r.pluginRuntime.StartCommand(node)
+		cmd = r.pluginRuntime.StartCommand(containerNode(node))
 		containerHomePath = r.pluginRuntime.ContainerHomePath()
 		// Convert env map to Docker format
 		for k, v := range r.pluginRuntime.StartEnv(node) {
 			env = append(env, fmt.Sprintf("%s=%s", k, v))
 		}
 	}
+	for k, v := range node.Spec.Env {
+		env = append(env, fmt.Sprintf("%s=%s", k, v))
+	}
 
 	// Build port bindings for network access
-	portBindings, exposedPorts := r.buildPortBindings(node)
+	hostPorts, err := r.hostPorts(node)
+	if err != nil {
+		return "", err
+	}
+	portBindings, exposedPorts := r.buildPortBindings(node.Spec.BindAddress, hostPorts)
 
 	// Build container config
 	containerConfig := &container.Config{
@@ -214,7 +376,7 @@ func (r *DockerRuntime) StartContainer(ctx context.Context, node *types.Node) (s
 	// Create container
 	resp, err := r.client.ContainerCreate(ctx, containerConfig, hostConfig, nil, nil, name)
 	if err != nil {
-		return "", fmt.Errorf("failed to create container: %w", err)
+		return "", createContainerError(image, err)
 	}
 
 	// Start container
@@ -298,21 +460,16 @@ func (r *DockerRuntime) StartNode(ctx context.Context, node *types.Node, opts St
 	}
 
 	// Build container name
-	containerName := fmt.Sprintf("dvb-%s-%s-%d", node.Spec.DevnetRef, node.Spec.Role, node.Spec.Index)
+	containerName := NodeContainerName(node)
 
-	// Determine image
-	image := r.defaultImage
-	if node.Spec.BinaryPath != "" && strings.Contains(node.Spec.BinaryPath, "/") && strings.Contains(node.Spec.BinaryPath, ":") {
-		// Looks like a Docker image reference
-		image = node.Spec.BinaryPath
-	}
+	image := r.nodeImage(node)
 
 	// Build command and environment from plugin
 	cmd := []string{"start", "--home", "/root/.stabled"} // fallback
 	var env []string
 	containerHomePath := "/root/.stabled" // fallback
 	if pluginRuntime != nil {
-		cmd = pluginRuntime.StartCommand(node)
+		cmd = pluginRuntime.StartCommand(containerNode(node))
 		containerHomePath = pluginRuntime.ContainerHomePath()
 		// Convert env map to Docker format
 		for k, v := range pluginRuntime.StartEnv(node) {
@@ -320,13 +477,20 @@ func (r *DockerRuntime) StartNode(ctx context.Context, node *types.Node, opts St
 		}
 	}
 
-	// Add any additional environment variables from opts
+	// Add node-level environment (e.g., from provisioning profile), then opts
+	for k, v := range node.Spec.Env {
+		env = append(env, fmt.Sprintf("%s=%s", k, v))
+	}
 	for k, v := range opts.Env {
 		env = append(env, fmt.Sprintf("%s=%s", k, v))
 	}
 
-	// Build port bindings based on node index
-	portBindings, exposedPorts := r.buildPortBindings(node)
+	// Build port bindings from the node's host port block
+	hostPorts, err := r.hostPorts(node)
+	if err != nil {
+		return err
+	}
+	portBindings, exposedPorts := r.buildPortBindings(node.Spec.BindAddress, hostPorts)
 
 	// Build container config
 	containerConfig := &container.Config{
@@ -343,8 +507,21 @@ func (r *DockerRuntime) StartNode(ctx context.Context, node *types.Node, opts St
 		},
 	}
 
+	// Attach the container to its devnet's network, where the other nodes
+	// reach it on its DNS name
+	networkName, err := r.ensureNetwork(ctx, node)
+	if err != nil {
+		return err
+	}
+	networkingConfig := &network.NetworkingConfig{
+		EndpointsConfig: map[string]*network.EndpointSettings{
+			networkName: {Aliases: []string{node.Spec.DNSName()}},
+		},
+	}
+
 	// Build host config with mounts, port bindings, and restart policy
 	hostConfig := &container.HostConfig{
+		NetworkMode:  container.NetworkMode(networkName),
 		PortBindings: portBindings,
 		RestartPolicy: container.RestartPolicy{
 			Name: container.RestartPolicyOnFailure,
@@ -368,9 +545,9 @@ func (r *DockerRuntime) StartNode(ctx context.Context, node *types.Node, opts St
 		"image", image,
 		"nodeID", nodeID)
 
-	resp, err := r.client.ContainerCreate(ctx, containerConfig, hostConfig, nil, nil, containerName)
+	resp, err := r.client.ContainerCreate(ctx, containerConfig, hostConfig, networkingConfig, nil, containerName)
 	if err != nil {
-		return fmt.Errorf("failed to create container: %w", err)
+		return createContainerError(image, err)
 	}
 
 	// Start container
@@ -387,6 +564,7 @@ func (r *DockerRuntime) StartNode(ctx context.Context, node *types.Node, opts St
 		node:          node,
 		startedAt:     time.Now(),
 		restartPolicy: opts.RestartPolicy,
+		spec:          newContainerSpec(containerName, node, containerConfig, hostConfig, networkingConfig),
 		stopCh:        make(chan struct{}),
 		stoppedCh:     make(chan struct{}),
 	}
@@ -399,6 +577,59 @@ func (r *DockerRuntime) StartNode(ctx context.Context, node *types.Node, opts St
 	return nil
 }
 
+// DevnetContainers returns the containers the devnet's running nodes were
+// started in, ordered by node index.
+func (r *DockerRuntime) DevnetContainers(namespace, devnet string) []ContainerSpec {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var specs []ContainerSpec
+	for _, state := range r.containers {
+		node := state.node
+		ns := node.Spec.NamespaceRef
+		if ns == "" {
+			ns = types.DefaultNamespace
+		}
+		if ns == namespace && node.Spec.DevnetRef == devnet && state.containerID != "" {
+			specs = append(specs, state.spec)
+		}
+	}
+	sort.Slice(specs, func(i, j int) bool { return specs[i].Index < specs[j].Index })
+	return specs
+}
+
+// newContainerSpec describes a node's container from the configuration it
+// is created with.
+func newContainerSpec(name string, node *types.Node, config *container.Config, hostConfig *container.HostConfig, networkingConfig *network.NetworkingConfig) ContainerSpec {
+	spec := ContainerSpec{
+		Name:          name,
+		Role:          node.Spec.Role,
+		Index:         node.Spec.Index,
+		Image:         config.Image,
+		Command:       config.Cmd,
+		Env:           config.Env,
+		Labels:        config.Labels,
+		RestartPolicy: string(hostConfig.RestartPolicy.Name),
+	}
+	for _, m := range hostConfig.Mounts {
+		spec.Mounts = append(spec.Mounts, BindMount{Source: m.Source, Target: m.Target})
+	}
+	for port, bindings := range hostConfig.PortBindings {
+		for _, b := range bindings {
+			hostPort, err := strconv.Atoi(b.HostPort)
+			if err != nil {
+				continue
+			}
+			spec.Ports = append(spec.Ports, PortBinding{HostIP: b.HostIP, HostPort: hostPort, ContainerPort: port.Int()})
+		}
+	}
+	sort.Slice(spec.Ports, func(i, j int) bool { return spec.Ports[i].ContainerPort < spec.Ports[j].ContainerPort })
+	if endpoint := networkingConfig.EndpointsConfig[string(hostConfig.NetworkMode)]; endpoint != nil {
+		spec.Aliases = endpoint.Aliases
+	}
+	return spec
+}
+
 // StopNode stops a node's container.
 func (r *DockerRuntime) StopNode(ctx context.Context, nodeID string, graceful bool) error {
 	r.mu.Lock()
@@ -439,9 +670,50 @@ func (r *DockerRuntime) StopNode(ctx context.Context, nodeID string, graceful bo
 		return fmt.Errorf("failed to remove container: %w", err)
 	}
 
+	r.releaseNetwork(ctx, state.devnet())
 	return nil
 }
 
+// StopNodeWithin stops a node's container, which Docker kills if it has
+// not exited within timeout. It reports whether the container was killed.
+func (r *DockerRuntime) StopNodeWithin(ctx context.Context, nodeID string, timeout time.Duration) (bool, error) {
+	r.mu.Lock()
+	state, exists := r.containers[nodeID]
+	if !exists {
+		r.mu.Unlock()
+		return false, fmt.Errorf("node %s not found", nodeID)
+	}
+	delete(r.containers, nodeID)
+	r.mu.Unlock()
+
+	// Signal supervision to stop
+	close(state.stopCh)
+
+	containerID := state.containerID
+	seconds := int((timeout + time.Second - 1) / time.Second)
+	r.logger.Info("stopping container",
+		"containerID", containerID[:min(12, len(containerID))],
+		"nodeID", nodeID,
+		"timeout", seconds)
+
+	killed := false
+	if err := r.client.ContainerStop(ctx, containerID, container.StopOptions{Timeout: &seconds}); err != nil {
+		r.logger.Warn("graceful stop failed, forcing removal",
+			"containerID", containerID[:min(12, len(containerID))],
+			"error", err)
+		killed = true
+	} else if info, err := r.client.ContainerInspect(ctx, containerID); err == nil && info.State != nil {
+		// Docker kills a container that outlives the timeout with SIGKILL
+		killed = info.State.ExitCode == 128+9
+	}
+
+	if err := r.client.ContainerRemove(ctx, containerID, container.RemoveOptions{Force: true}); err != nil {
+		return killed, fmt.Errorf("failed to remove container: %w", err)
+	}
+	r.releaseNetwork(ctx, state.devnet())
+	return killed, nil
+}
+
 // GetNodeStatus returns the current status of a node.
 func (r *DockerRuntime) GetNodeStatus(ctx context.Context, nodeID string) (*NodeStatus, error) {
 	r.mu.RLock()
@@ -546,7 +818,11 @@ func (r *DockerRuntime) Cleanup(ctx context.Context) error {
 	defer r.mu.Unlock()
 
 	var lastErr error
+	devnets := make(map[string]bool)
 	for nodeID, state := range r.containers {
+		if devnet := state.devnet(); devnet != "" {
+			devnets[devnet] = true
+		}
 		r.logger.Info("cleaning up container",
 			"nodeID", nodeID,
 			"containerID", state.containerID[:min(12, len(state.containerID))])
@@ -562,6 +838,10 @@ func (r *DockerRuntime) Cleanup(ctx context.Context) error {
 	// Clear the map
 	r.containers = make(map[string]*containerState)
 
+	for devnet := range devnets {
+		r.removeNetwork(ctx, devnet)
+	}
+
 	return lastErr
 }
 
@@ -663,5 +943,8 @@ func demuxDockerStream(reader io.Reader, stdout, stderr *strings.Builder) error
 	}
 }
 
-// Ensure DockerRuntime implements NodeRuntime.
+// Ensure DockerRuntime implements NodeRuntime, ContainerLister and
+// NetworkShaper.
 var _ NodeRuntime = (*DockerRuntime)(nil)
+var _ ContainerLister = (*DockerRuntime)(nil)
+var _ NetworkShaper = (*DockerRuntime)(nil)
@@ -0,0 +1,96 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+)
+
+// RunNodeCommand runs the binary of a stopped node in a short-lived
+// container of its image, with its home directory mounted where the node
+// container mounts it.
+func (r *DockerRuntime) RunNodeCommand(ctx context.Context, node *types.Node, args []string) (string, error) {
+	nodeID := node.Metadata.Name
+	if _, err := r.runningContainer(nodeID); err == nil {
+		return "", fmt.Errorf("node %s is running", nodeID)
+	}
+	if node.Spec.HomeDir == "" {
+		return "", fmt.Errorf("node %s has no home directory", nodeID)
+	}
+
+	containerHomePath := "/root/.stabled" // fallback
+	if r.pluginRuntime != nil {
+		containerHomePath = r.pluginRuntime.ContainerHomePath()
+	}
+	image := r.nodeImage(node)
+	exitCode, output, err := r.runOneOff(ctx, &container.Config{
+		Image:  image,
+		Cmd:    append(append([]string{}, args...), "--home", containerHomePath),
+		Labels: map[string]string{"dvb.command": nodeID},
+	}, &container.HostConfig{
+		Mounts: []mount.Mount{{Type: mount.TypeBind, Source: node.Spec.HomeDir, Target: containerHomePath}},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to run command of node %s: %w", nodeID, err)
+	}
+	if exitCode != 0 {
+		return output, fmt.Errorf("command of node %s exited with code %d: %s", nodeID, exitCode, output)
+	}
+	return output, nil
+}
+
+// nodeImage returns the image a node's container runs, through its
+// registry mirror if any.
+func (r *DockerRuntime) nodeImage(node *types.Node) string {
+	image := r.defaultImage
+	if node.Spec.Image != "" {
+		image = node.Spec.Image
+	} else if node.Spec.BinaryPath != "" && strings.Contains(node.Spec.BinaryPath, "/") && strings.Contains(node.Spec.BinaryPath, ":") {
+		// Looks like a Docker image reference
+		image = node.Spec.BinaryPath
+	}
+	return r.registries.Rewrite(image)
+}
+
+// runOneOff runs a container to completion and removes it, returning its
+// exit code and output. The output is its error output when it failed and
+// wrote any.
+func (r *DockerRuntime) runOneOff(ctx context.Context, config *container.Config, hostConfig *container.HostConfig) (int64, string, error) {
+	resp, err := r.client.ContainerCreate(ctx, config, hostConfig, nil, nil, "")
+	if err != nil {
+		return 0, "", createContainerError(config.Image, err)
+	}
+	defer func() {
+		_ = r.client.ContainerRemove(context.WithoutCancel(ctx), resp.ID, container.RemoveOptions{Force: true})
+	}()
+
+	if err := r.client.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
+		return 0, "", fmt.Errorf("failed to start container: %w", err)
+	}
+
+	respCh, errCh := r.client.ContainerWait(ctx, resp.ID, container.WaitConditionNotRunning)
+	var exitCode int64
+	select {
+	case wait := <-respCh:
+		exitCode = wait.StatusCode
+	case err := <-errCh:
+		return 0, "", fmt.Errorf("failed to wait for container: %w", err)
+	}
+
+	var stdout, stderr strings.Builder
+	if logs, err := r.client.ContainerLogs(ctx, resp.ID, container.LogsOptions{ShowStdout: true, ShowStderr: true}); err == nil {
+		_ = demuxDockerStream(logs, &stdout, &stderr)
+		logs.Close()
+	}
+	output := strings.TrimSpace(stdout.String())
+	if exitCode != 0 {
+		if errOutput := strings.TrimSpace(stderr.String()); errOutput != "" {
+			output = errOutput
+		}
+	}
+	return exitCode, output, nil
+}
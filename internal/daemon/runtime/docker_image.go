@@ -0,0 +1,112 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/distribution/reference"
+	imagetypes "github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/errdefs"
+	"github.com/docker/docker/pkg/jsonmessage"
+)
+
+// PinImage pulls a node image once, before any node starts, and resolves
+// it to the repository digest its tag points to now. Images are pulled
+// from their registry mirror, if any. A pull that fails falls back to the
+// local copy, since tags built or loaded locally have no registry to pull
+// from.
+func (r *DockerRuntime) PinImage(ctx context.Context, image string, offline bool) (PinnedImage, error) {
+	if image == "" {
+		image = r.defaultImage
+	}
+	ref := r.registries.Rewrite(image)
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return PinnedImage{}, fmt.Errorf("invalid image %q: %w", ref, err)
+	}
+
+	var pullErr error
+	if !offline {
+		pullErr = r.pullImage(ctx, ref)
+	}
+	info, err := r.client.ImageInspect(ctx, ref)
+	if err != nil {
+		if pullErr != nil {
+			return PinnedImage{}, pullErr
+		}
+		if errdefs.IsNotFound(err) {
+			return PinnedImage{}, missingImageError(ref, err)
+		}
+		return PinnedImage{}, fmt.Errorf("failed to inspect image %s: %w", ref, err)
+	}
+	if pullErr != nil {
+		r.logger.Warn("failed to pull image, pinning the local copy", "image", ref, "error", pullErr)
+	}
+
+	pinned := PinnedImage{Image: image, Digest: info.ID, Ref: info.ID}
+	for _, repoDigest := range info.RepoDigests {
+		digested, err := reference.ParseNormalizedNamed(repoDigest)
+		if err != nil || digested.Name() != named.Name() {
+			continue
+		}
+		if canonical, ok := digested.(reference.Canonical); ok {
+			pinned.Digest = canonical.Digest().String()
+			pinned.Ref = reference.FamiliarString(canonical)
+			break
+		}
+	}
+	r.logger.Info("pinned image", "image", ref, "digest", pinned.Digest)
+	return pinned, nil
+}
+
+// UpstreamDigest returns the digest image resolves to in its registry, or
+// its registry mirror, without pulling it.
+func (r *DockerRuntime) UpstreamDigest(ctx context.Context, image string) (string, error) {
+	if image == "" {
+		image = r.defaultImage
+	}
+	image = r.registries.Rewrite(image)
+	auth, err := r.registries.Auth(image)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode registry credentials: %w", err)
+	}
+	info, err := r.client.DistributionInspect(ctx, image, auth)
+	if err != nil {
+		return "", fmt.Errorf("failed to look up image %s in its registry: %w", image, err)
+	}
+	return info.Descriptor.Digest.String(), nil
+}
+
+// pullImage pulls an image, waiting for the pull to complete. Failures
+// after the pull started are only reported in its progress stream.
+func (r *DockerRuntime) pullImage(ctx context.Context, image string) error {
+	auth, err := r.registries.Auth(image)
+	if err != nil {
+		return fmt.Errorf("failed to encode registry credentials: %w", err)
+	}
+	r.logger.Info("pulling image", "image", image)
+	out, err := r.client.ImagePull(ctx, image, imagetypes.PullOptions{RegistryAuth: auth})
+	if err != nil {
+		return fmt.Errorf("failed to pull image %s: %w", image, err)
+	}
+	defer out.Close()
+
+	dec := json.NewDecoder(out)
+	for {
+		var msg jsonmessage.JSONMessage
+		if err := dec.Decode(&msg); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to pull image %s: %w", image, err)
+		}
+		if msg.Error != nil {
+			return fmt.Errorf("failed to pull image %s: %s", image, msg.Error.Message)
+		}
+		if msg.ErrorMessage != "" {
+			return fmt.Errorf("failed to pull image %s: %s", image, msg.ErrorMessage)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+// NodeIP returns the address of a node's container on its docker network.
+func (r *DockerRuntime) NodeIP(ctx context.Context, nodeID string) (string, error) {
+	containerID, err := r.runningContainer(nodeID)
+	if err != nil {
+		return "", err
+	}
+	info, err := r.client.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect container of node %s: %w", nodeID, err)
+	}
+	if settings := info.NetworkSettings; settings != nil {
+		if settings.IPAddress != "" {
+			return settings.IPAddress, nil
+		}
+		for _, endpoint := range settings.Networks {
+			if endpoint != nil && endpoint.IPAddress != "" {
+				return endpoint.IPAddress, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("container of node %s has no IP address", nodeID)
+}
+
+// RunInNodeNetwork runs script in a short-lived container of image that
+// shares the network namespace of a node's container, so changes to its
+// interfaces and qdiscs apply to the node without tools in the node image.
+func (r *DockerRuntime) RunInNodeNetwork(ctx context.Context, nodeID, image, script string) error {
+	containerID, err := r.runningContainer(nodeID)
+	if err != nil {
+		return err
+	}
+
+	image = r.registries.Rewrite(image)
+	exitCode, output, err := r.runOneOff(ctx, &container.Config{
+		Image:      image,
+		Entrypoint: []string{"sh", "-c", script},
+		Labels:     map[string]string{"dvb.netem": nodeID},
+	}, &container.HostConfig{
+		NetworkMode: container.NetworkMode("container:" + containerID),
+		CapAdd:      []string{"NET_ADMIN"},
+	})
+	if err != nil {
+		return fmt.Errorf("network container of node %s: %w", nodeID, err)
+	}
+	if exitCode != 0 {
+		// The script failed; its error output says why
+		return fmt.Errorf("network script of node %s exited with code %d: %s", nodeID, exitCode, output)
+	}
+	return nil
+}
+
+// runningContainer returns the container ID of a running node.
+func (r *DockerRuntime) runningContainer(nodeID string) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	state, ok := r.containers[nodeID]
+	if !ok || state.containerID == "" {
+		return "", fmt.Errorf("node %s is not running", nodeID)
+	}
+	return state.containerID, nil
+}
@@ -0,0 +1,69 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/errdefs"
+)
+
+// ensureNetwork creates the bridge network of a node's devnet unless it
+// exists, and returns its name. Each devnet gets its own network, with a
+// subnet docker allocates, so concurrent devnets neither collide nor see
+// each other. Callers hold r.mu.
+func (r *DockerRuntime) ensureNetwork(ctx context.Context, node *types.Node) (string, error) {
+	name := DevnetNetworkName(node.Spec.DevnetRef)
+	if _, err := r.client.NetworkInspect(ctx, name, network.InspectOptions{}); err == nil {
+		return name, nil
+	} else if !errdefs.IsNotFound(err) {
+		return "", fmt.Errorf("failed to inspect network %s: %w", name, err)
+	}
+
+	namespace := node.Spec.NamespaceRef
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+	r.logger.Info("creating devnet network", "network", name, "devnet", node.Spec.DevnetRef)
+	_, err := r.client.NetworkCreate(ctx, name, network.CreateOptions{
+		Driver: network.NetworkBridge,
+		Labels: map[string]string{
+			"dvb.devnet":    node.Spec.DevnetRef,
+			"dvb.namespace": namespace,
+		},
+	})
+	if err != nil && !errdefs.IsConflict(err) {
+		return "", fmt.Errorf("failed to create network %s: %w", name, err)
+	}
+	return name, nil
+}
+
+// releaseNetwork removes a devnet's network once none of its nodes'
+// containers are left.
+func (r *DockerRuntime) releaseNetwork(ctx context.Context, devnet string) {
+	if devnet == "" {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, state := range r.containers {
+		if state.devnet() == devnet {
+			return
+		}
+	}
+	r.removeNetwork(ctx, devnet)
+}
+
+// removeNetwork removes a devnet's network, logging failures: a network
+// left behind only costs its subnet. Callers hold r.mu.
+func (r *DockerRuntime) removeNetwork(ctx context.Context, devnet string) {
+	name := DevnetNetworkName(devnet)
+	if err := r.client.NetworkRemove(ctx, name); err != nil {
+		if !errdefs.IsNotFound(err) {
+			r.logger.Warn("failed to remove devnet network", "network", name, "error", err)
+		}
+		return
+	}
+	r.logger.Info("removed devnet network", "network", name, "devnet", devnet)
+}
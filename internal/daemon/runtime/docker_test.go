@@ -5,15 +5,25 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/portalloc"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/registries"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
 	dockertypes "github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	imagetypes "github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
 	"github.com/docker/go-connections/nat"
+	"github.com/opencontainers/go-digest"
 	specs "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,6 +38,18 @@ type mockDockerClient struct {
 	removeFn  func(ctx context.Context, containerID string, opts container.RemoveOptions) error
 	inspectFn func(ctx context.Context, containerID string) (dockertypes.ContainerJSON, error)
 	logsFn    func(ctx context.Context, containerID string, opts container.LogsOptions) (io.ReadCloser, error)
+	waitFn    func(ctx context.Context, containerID string) (container.WaitResponse, error)
+
+	networks              map[string]network.CreateOptions
+	networkCreateCalls    []string
+	networkRemoveCalls    []string
+	networkingConfigCalls []*network.NetworkingConfig
+
+	images    map[string]imagetypes.InspectResponse // by reference
+	upstream  map[string]string                     // registry digests by reference
+	pullFn    func(ref string) (io.ReadCloser, error)
+	pullCalls []string
+	pullAuth  []string
 
 	createCalls  []createCall
 	startCalls   []string
@@ -44,6 +66,7 @@ type createCall struct {
 
 func (m *mockDockerClient) ContainerCreate(ctx context.Context, config *container.Config, hostConfig *container.HostConfig, networkingConfig *network.NetworkingConfig, platform *specs.Platform, containerName string) (container.CreateResponse, error) {
 	m.createCalls = append(m.createCalls, createCall{config: config, hostConfig: hostConfig, name: containerName})
+	m.networkingConfigCalls = append(m.networkingConfigCalls, networkingConfig)
 	if m.createFn != nil {
 		return m.createFn(ctx, config, hostConfig, networkingConfig, platform, containerName)
 	}
@@ -100,7 +123,70 @@ func (m *mockDockerClient) ContainerLogs(ctx context.Context, containerID string
 	if m.logsFn != nil {
 		return m.logsFn(ctx, containerID, opts)
 	}
-	return io.NopCloser(nil), nil
+	return io.NopCloser(strings.NewReader("")), nil
+}
+
+func (m *mockDockerClient) ContainerWait(ctx context.Context, containerID string, condition container.WaitCondition) (<-chan container.WaitResponse, <-chan error) {
+	respCh := make(chan container.WaitResponse, 1)
+	errCh := make(chan error, 1)
+	if m.waitFn == nil {
+		respCh <- container.WaitResponse{}
+	} else if resp, err := m.waitFn(ctx, containerID); err != nil {
+		errCh <- err
+	} else {
+		respCh <- resp
+	}
+	return respCh, errCh
+}
+
+func (m *mockDockerClient) NetworkCreate(ctx context.Context, name string, options network.CreateOptions) (network.CreateResponse, error) {
+	m.networkCreateCalls = append(m.networkCreateCalls, name)
+	if m.networks == nil {
+		m.networks = make(map[string]network.CreateOptions)
+	}
+	m.networks[name] = options
+	return network.CreateResponse{ID: name}, nil
+}
+
+func (m *mockDockerClient) NetworkInspect(ctx context.Context, networkID string, options network.InspectOptions) (network.Inspect, error) {
+	if _, ok := m.networks[networkID]; !ok {
+		return network.Inspect{}, errdefs.NotFound(fmt.Errorf("network %s not found", networkID))
+	}
+	return network.Inspect{Name: networkID}, nil
+}
+
+func (m *mockDockerClient) NetworkRemove(ctx context.Context, networkID string) error {
+	m.networkRemoveCalls = append(m.networkRemoveCalls, networkID)
+	if _, ok := m.networks[networkID]; !ok {
+		return errdefs.NotFound(fmt.Errorf("network %s not found", networkID))
+	}
+	delete(m.networks, networkID)
+	return nil
+}
+
+func (m *mockDockerClient) ImagePull(ctx context.Context, refStr string, options imagetypes.PullOptions) (io.ReadCloser, error) {
+	m.pullCalls = append(m.pullCalls, refStr)
+	m.pullAuth = append(m.pullAuth, options.RegistryAuth)
+	if m.pullFn != nil {
+		return m.pullFn(refStr)
+	}
+	return io.NopCloser(strings.NewReader(`{"status":"Pull complete"}`)), nil
+}
+
+func (m *mockDockerClient) ImageInspect(ctx context.Context, imageID string, inspectOpts ...client.ImageInspectOption) (imagetypes.InspectResponse, error) {
+	info, ok := m.images[imageID]
+	if !ok {
+		return imagetypes.InspectResponse{}, errdefs.NotFound(fmt.Errorf("no such image: %s", imageID))
+	}
+	return info, nil
+}
+
+func (m *mockDockerClient) DistributionInspect(ctx context.Context, imageRef, encodedRegistryAuth string) (registry.DistributionInspect, error) {
+	d, ok := m.upstream[imageRef]
+	if !ok {
+		return registry.DistributionInspect{}, errdefs.NotFound(fmt.Errorf("manifest unknown: %s", imageRef))
+	}
+	return registry.DistributionInspect{Descriptor: specs.Descriptor{Digest: digest.Digest(d)}}, nil
 }
 
 func (m *mockDockerClient) ContainerExecCreate(ctx context.Context, containerID string, config container.ExecOptions) (container.ExecCreateResponse, error) {
@@ -203,6 +289,69 @@ func TestDockerRuntime_StartNode(t *testing.T) {
 	assert.Equal(t, "test-container-id", state.containerID)
 }
 
+func TestDockerRuntime_StartNode_UsesSpecImage(t *testing.T) {
+	mock := &mockDockerClient{}
+
+	rt := &DockerRuntime{
+		client:       mock,
+		logger:       testLogger(),
+		defaultImage: "stablelabs/stabled:latest",
+		containers:   make(map[string]*containerState),
+	}
+
+	node := &types.Node{
+		Metadata: types.ResourceMeta{
+			Name: "test-devnet-validator-0",
+		},
+		Spec: types.NodeSpec{
+			DevnetRef:  "test-devnet",
+			Index:      0,
+			Role:       "validator",
+			HomeDir:    "/tmp/node-home",
+			BinaryPath: "/usr/bin/stabled",
+			Image:      "dvb/stable:feat-x-abc123",
+		},
+	}
+
+	err := rt.StartNode(context.Background(), node, StartOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, mock.createCalls, 1)
+	assert.Equal(t, "dvb/stable:feat-x-abc123", mock.createCalls[0].config.Image)
+}
+
+func TestDockerRuntime_StartNode_MissingImage(t *testing.T) {
+	mock := &mockDockerClient{
+		createFn: func(ctx context.Context, config *container.Config, hostConfig *container.HostConfig, networkingConfig *network.NetworkingConfig, platform *specs.Platform, containerName string) (container.CreateResponse, error) {
+			return container.CreateResponse{}, errdefs.NotFound(fmt.Errorf("No such image: %s", config.Image))
+		},
+	}
+
+	rt := &DockerRuntime{
+		client:       mock,
+		logger:       testLogger(),
+		defaultImage: "stablelabs/stabled:latest",
+		containers:   make(map[string]*containerState),
+	}
+
+	node := &types.Node{
+		Metadata: types.ResourceMeta{
+			Name: "test-devnet-validator-0",
+		},
+		Spec: types.NodeSpec{
+			DevnetRef: "test-devnet",
+			Index:     0,
+			Role:      "validator",
+			Image:     "dvb/stable:uncached",
+		},
+	}
+
+	err := rt.StartNode(context.Background(), node, StartOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "docker pull dvb/stable:uncached")
+	assert.Empty(t, mock.startCalls)
+}
+
 func TestDockerRuntime_StopNode_Graceful(t *testing.T) {
 	mock := &mockDockerClient{}
 
@@ -236,6 +385,53 @@ func TestDockerRuntime_StopNode_Graceful(t *testing.T) {
 	assert.False(t, exists)
 }
 
+func TestDockerRuntime_StopNodeWithin(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		exitCode   int
+		wantKilled bool
+	}{
+		{name: "exited", exitCode: 0, wantKilled: false},
+		{name: "killed", exitCode: 137, wantKilled: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var stopTimeout *int
+			mock := &mockDockerClient{
+				stopFn: func(ctx context.Context, containerID string, opts container.StopOptions) error {
+					stopTimeout = opts.Timeout
+					return nil
+				},
+				inspectFn: func(ctx context.Context, containerID string) (dockertypes.ContainerJSON, error) {
+					return dockertypes.ContainerJSON{
+						ContainerJSONBase: &dockertypes.ContainerJSONBase{
+							State: &dockertypes.ContainerState{ExitCode: tc.exitCode},
+						},
+					}, nil
+				},
+			}
+			rt := &DockerRuntime{
+				client: mock,
+				logger: testLogger(),
+				containers: map[string]*containerState{
+					"test-node": {
+						containerID: "container-789",
+						nodeID:      "test-node",
+						stopCh:      make(chan struct{}),
+						stoppedCh:   make(chan struct{}),
+					},
+				},
+			}
+
+			killed, err := rt.StopNodeWithin(context.Background(), "test-node", 1500*time.Millisecond)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantKilled, killed)
+			require.NotNil(t, stopTimeout)
+			assert.Equal(t, 2, *stopTimeout)
+			assert.Equal(t, []string{"container-789"}, mock.removeCalls)
+		})
+	}
+}
+
 func TestDockerRuntime_StopNode_Force(t *testing.T) {
 	mock := &mockDockerClient{}
 
@@ -639,7 +835,9 @@ func TestDockerRuntime_PortMapping(t *testing.T) {
 				},
 			}
 
-			portBindings, exposedPorts := rt.buildPortBindings(node)
+			hostPorts, err := rt.hostPorts(node)
+			require.NoError(t, err)
+			portBindings, exposedPorts := rt.buildPortBindings("", hostPorts)
 
 			// Verify port bindings
 			for containerPort, expectedHostPort := range tt.expectedPorts {
@@ -716,6 +914,65 @@ func TestDockerRuntime_StartNode_WithPortBindings(t *testing.T) {
 	assert.Len(t, createCall.config.ExposedPorts, 4)
 }
 
+func TestDockerRuntime_StartNode_PortConflict(t *testing.T) {
+	alloc, err := portalloc.LoadOrCreate(filepath.Join(t.TempDir(), "ports.json"))
+	require.NoError(t, err)
+	basePorts := []int{P2PPort, RPCPort, RESTPort, GRPCPort}
+	otherBlock, err := alloc.Allocate("default", "other-devnet", 0, basePorts, 0, true)
+	require.NoError(t, err)
+
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: "test-devnet-validator-0"},
+		Spec: types.NodeSpec{
+			DevnetRef:  "test-devnet",
+			Index:      0,
+			Role:       "validator",
+			HomeDir:    "/tmp/node-home",
+			BinaryPath: "/usr/bin/stabled",
+		},
+	}
+
+	t.Run("fails with the owner", func(t *testing.T) {
+		mock := &mockDockerClient{}
+		rt := &DockerRuntime{
+			client:        mock,
+			logger:        testLogger(),
+			containers:    make(map[string]*containerState),
+			portAllocator: alloc,
+		}
+		node := *node
+		node.Spec.Index = otherBlock
+
+		err := rt.StartNode(context.Background(), &node, StartOptions{})
+		require.ErrorIs(t, err, &portalloc.PortConflictError{})
+		assert.Contains(t, err.Error(), "devnet node default/other-devnet/0")
+		assert.Empty(t, mock.createCalls)
+	})
+
+	t.Run("reallocates to a free block", func(t *testing.T) {
+		mock := &mockDockerClient{}
+		rt := &DockerRuntime{
+			client:          mock,
+			logger:          testLogger(),
+			containers:      make(map[string]*containerState),
+			portAllocator:   alloc,
+			reallocatePorts: true,
+		}
+		node := *node
+		node.Spec.Index = otherBlock
+
+		require.NoError(t, rt.StartNode(context.Background(), &node, StartOptions{}))
+		block, ok := alloc.Lookup("default", "test-devnet", otherBlock)
+		require.True(t, ok)
+		assert.NotEqual(t, otherBlock, block)
+
+		require.Len(t, mock.createCalls, 1)
+		bindings := mock.createCalls[0].hostConfig.PortBindings[nat.Port("26657/tcp")]
+		require.Len(t, bindings, 1)
+		assert.Equal(t, strconv.Itoa(RPCPort+block*portalloc.BlockSize), bindings[0].HostPort)
+	})
+}
+
 func TestPortConstants(t *testing.T) {
 	// Verify port constants match expected Cosmos SDK defaults
 	assert.Equal(t, 26656, P2PPort, "P2P port should be 26656")
@@ -738,7 +995,9 @@ func TestDockerRuntime_PortMapping_HighIndex(t *testing.T) {
 		},
 	}
 
-	portBindings, _ := rt.buildPortBindings(node)
+	hostPorts, err := rt.hostPorts(node)
+	require.NoError(t, err)
+	portBindings, _ := rt.buildPortBindings("", hostPorts)
 
 	// Verify offset calculation (10 * 100 = 1000)
 	p2pBinding := portBindings[nat.Port("26656/tcp")]
@@ -749,3 +1008,366 @@ func TestDockerRuntime_PortMapping_HighIndex(t *testing.T) {
 	require.Len(t, rpcBinding, 1)
 	assert.Equal(t, fmt.Sprintf("%d", 26657+1000), rpcBinding[0].HostPort)
 }
+
+func TestDockerRuntime_PortMapping_CustomLayout(t *testing.T) {
+	rt := &DockerRuntime{
+		logger: testLogger(),
+	}
+
+	node := &types.Node{
+		Spec: types.NodeSpec{
+			DevnetRef:  "test-devnet",
+			Index:      2,
+			Role:       "validator",
+			PortLayout: dvbtypes.PortLayout{RPC: 36657, API: 2317, Stride: 10},
+		},
+	}
+
+	hostPorts, err := rt.hostPorts(node)
+	require.NoError(t, err)
+	portBindings, _ := rt.buildPortBindings("", hostPorts)
+
+	// Container ports stay fixed; only the published host ports move
+	expected := map[string]string{
+		"26656/tcp": "26676",
+		"26657/tcp": "36677",
+		"1317/tcp":  "2337",
+		"9090/tcp":  "9110",
+	}
+	for containerPort, hostPort := range expected {
+		bindings := portBindings[nat.Port(containerPort)]
+		require.Len(t, bindings, 1)
+		assert.Equal(t, hostPort, bindings[0].HostPort, containerPort)
+	}
+}
+
+func TestDockerRuntime_PortMapping_BindAddress(t *testing.T) {
+	rt := &DockerRuntime{
+		logger: testLogger(),
+	}
+
+	node := &types.Node{
+		Spec: types.NodeSpec{
+			DevnetRef:   "test-devnet",
+			Index:       1,
+			Role:        "validator",
+			BindAddress: "192.168.1.20",
+		},
+	}
+
+	hostPorts, err := rt.hostPorts(node)
+	require.NoError(t, err)
+	portBindings, _ := rt.buildPortBindings(node.Spec.BindAddress, hostPorts)
+
+	rpcBinding := portBindings[nat.Port("26657/tcp")]
+	require.Len(t, rpcBinding, 1)
+	assert.Equal(t, "192.168.1.20", rpcBinding[0].HostIP)
+	assert.Equal(t, "26757", rpcBinding[0].HostPort)
+
+	// Inside the container the node listens on all interfaces
+	assert.Empty(t, containerNode(node).Spec.BindAddress)
+	assert.Equal(t, "192.168.1.20", node.Spec.BindAddress)
+}
+
+func TestDockerRuntime_DevnetContainers(t *testing.T) {
+	mock := &mockDockerClient{}
+	rt := &DockerRuntime{
+		client:       mock,
+		logger:       testLogger(),
+		defaultImage: "stablelabs/stabled:latest",
+		containers:   make(map[string]*containerState),
+	}
+
+	for _, node := range []*types.Node{
+		{
+			Metadata: types.ResourceMeta{Name: "test-devnet-fullnode-1"},
+			Spec:     types.NodeSpec{DevnetRef: "test-devnet", Index: 1, Role: "fullnode", HomeDir: "/data/node1"},
+		},
+		{
+			Metadata: types.ResourceMeta{Name: "test-devnet-validator-0"},
+			Spec: types.NodeSpec{DevnetRef: "test-devnet", Index: 0, Role: "validator", HomeDir: "/data/node0",
+				Env: map[string]string{"LOG_LEVEL": "debug"}},
+		},
+		{
+			Metadata: types.ResourceMeta{Name: "other-validator-0"},
+			Spec:     types.NodeSpec{DevnetRef: "other", Index: 0, Role: "validator"},
+		},
+	} {
+		require.NoError(t, rt.StartNode(context.Background(), node, StartOptions{}))
+	}
+
+	specs := rt.DevnetContainers(types.DefaultNamespace, "test-devnet")
+	require.Len(t, specs, 2)
+
+	v := specs[0]
+	assert.Equal(t, "dvb-test-devnet-validator-0", v.Name)
+	assert.Equal(t, "validator", v.Role)
+	assert.Equal(t, "stablelabs/stabled:latest", v.Image)
+	assert.Equal(t, []string{"start", "--home", "/root/.stabled"}, v.Command)
+	assert.Equal(t, []string{"LOG_LEVEL=debug"}, v.Env)
+	assert.Equal(t, []BindMount{{Source: "/data/node0", Target: "/root/.stabled"}}, v.Mounts)
+	assert.Equal(t, "on-failure", v.RestartPolicy)
+	assert.Equal(t, "test-devnet", v.Labels["dvb.devnet"])
+	assert.Equal(t, []PortBinding{
+		{HostPort: 1317, ContainerPort: RESTPort},
+		{HostPort: 9090, ContainerPort: GRPCPort},
+		{HostPort: 26656, ContainerPort: P2PPort},
+		{HostPort: 26657, ContainerPort: RPCPort},
+	}, v.Ports)
+
+	assert.Equal(t, 1, specs[1].Index)
+	assert.Empty(t, rt.DevnetContainers("other-namespace", "test-devnet"))
+
+	// Stopped nodes are not listed
+	require.NoError(t, rt.StopNode(context.Background(), "test-devnet-fullnode-1", false))
+	assert.Len(t, rt.DevnetContainers(types.DefaultNamespace, "test-devnet"), 1)
+}
+
+func TestDockerRuntime_RunInNodeNetwork(t *testing.T) {
+	mock := &mockDockerClient{
+		inspectFn: func(ctx context.Context, containerID string) (dockertypes.ContainerJSON, error) {
+			return dockertypes.ContainerJSON{NetworkSettings: &container.NetworkSettings{
+				Networks: map[string]*network.EndpointSettings{"bridge": {IPAddress: "172.17.0.2"}},
+			}}, nil
+		},
+	}
+	rt := &DockerRuntime{
+		client:       mock,
+		logger:       testLogger(),
+		defaultImage: "stablelabs/stabled:latest",
+		containers:   make(map[string]*containerState),
+	}
+
+	_, err := rt.NodeIP(context.Background(), "test-node-0")
+	assert.ErrorContains(t, err, "node test-node-0 is not running")
+
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: "test-node-0"},
+		Spec:     types.NodeSpec{DevnetRef: "test-devnet", Index: 0, Role: "validator"},
+	}
+	require.NoError(t, rt.StartNode(context.Background(), node, StartOptions{}))
+
+	ip, err := rt.NodeIP(context.Background(), "test-node-0")
+	require.NoError(t, err)
+	assert.Equal(t, "172.17.0.2", ip)
+
+	require.NoError(t, rt.RunInNodeNetwork(context.Background(), "test-node-0", "netshoot", "tc qdisc show"))
+	require.Len(t, mock.createCalls, 2)
+	helper := mock.createCalls[1]
+	assert.Equal(t, "netshoot", helper.config.Image)
+	assert.Equal(t, []string{"sh", "-c", "tc qdisc show"}, []string(helper.config.Entrypoint))
+	assert.Equal(t, container.NetworkMode("container:test-container-id"), helper.hostConfig.NetworkMode)
+	assert.Equal(t, []string{"NET_ADMIN"}, []string(helper.hostConfig.CapAdd))
+	assert.Len(t, mock.removeCalls, 1)
+
+	// A failing script reports its error output
+	mock.waitFn = func(ctx context.Context, containerID string) (container.WaitResponse, error) {
+		return container.WaitResponse{StatusCode: 2}, nil
+	}
+	mock.logsFn = func(ctx context.Context, containerID string, opts container.LogsOptions) (io.ReadCloser, error) {
+		msg := "Error: Cannot find device \"eth0\"\n"
+		frame := append([]byte{2, 0, 0, 0, 0, 0, 0, byte(len(msg))}, msg...)
+		return io.NopCloser(strings.NewReader(string(frame))), nil
+	}
+	err = rt.RunInNodeNetwork(context.Background(), "test-node-0", "netshoot", "tc qdisc show dev eth0")
+	assert.ErrorContains(t, err, `exited with code 2: Error: Cannot find device "eth0"`)
+	assert.Len(t, mock.removeCalls, 2)
+}
+
+func TestDockerRuntime_RunNodeCommand(t *testing.T) {
+	mock := &mockDockerClient{
+		logsFn: func(ctx context.Context, containerID string, opts container.LogsOptions) (io.ReadCloser, error) {
+			msg := "pruned 120 versions\n"
+			frame := append([]byte{1, 0, 0, 0, 0, 0, 0, byte(len(msg))}, msg...)
+			return io.NopCloser(strings.NewReader(string(frame))), nil
+		},
+	}
+	rt := &DockerRuntime{
+		client:       mock,
+		logger:       testLogger(),
+		defaultImage: "stablelabs/stabled:latest",
+		containers:   make(map[string]*containerState),
+	}
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: "test-node-0"},
+		Spec:     types.NodeSpec{DevnetRef: "test-devnet", HomeDir: "/data/test-devnet/node0", Image: "stablelabs/stabled@sha256:abc"},
+	}
+
+	output, err := rt.RunNodeCommand(context.Background(), node, []string{"prune", "everything"})
+	require.NoError(t, err)
+	assert.Equal(t, "pruned 120 versions", output)
+	require.Len(t, mock.createCalls, 1)
+	call := mock.createCalls[0]
+	assert.Equal(t, "stablelabs/stabled@sha256:abc", call.config.Image)
+	assert.Equal(t, []string{"prune", "everything", "--home", "/root/.stabled"}, []string(call.config.Cmd))
+	require.Len(t, call.hostConfig.Mounts, 1)
+	assert.Equal(t, "/data/test-devnet/node0", call.hostConfig.Mounts[0].Source)
+	assert.Equal(t, "/root/.stabled", call.hostConfig.Mounts[0].Target)
+	assert.Len(t, mock.removeCalls, 1)
+
+	// Running nodes hold their database open
+	require.NoError(t, rt.StartNode(context.Background(), node, StartOptions{}))
+	_, err = rt.RunNodeCommand(context.Background(), node, []string{"prune", "everything"})
+	assert.ErrorContains(t, err, "node test-node-0 is running")
+}
+
+func TestDockerRuntime_DevnetNetwork(t *testing.T) {
+	mock := &mockDockerClient{}
+	rt := &DockerRuntime{
+		client:       mock,
+		logger:       testLogger(),
+		defaultImage: "stablelabs/stabled:latest",
+		containers:   make(map[string]*containerState),
+	}
+	ctx := context.Background()
+
+	nodes := []*types.Node{
+		{
+			Metadata: types.ResourceMeta{Name: "mydevnet-validator-0"},
+			Spec:     types.NodeSpec{DevnetRef: "mydevnet", Index: 0, Role: "validator"},
+		},
+		{
+			Metadata: types.ResourceMeta{Name: "mydevnet-fullnode-1"},
+			Spec:     types.NodeSpec{DevnetRef: "mydevnet", Index: 1, Role: "fullnode"},
+		},
+		{
+			Metadata: types.ResourceMeta{Name: "other-validator-0"},
+			Spec:     types.NodeSpec{DevnetRef: "other", Index: 0, Role: "validator", NamespaceRef: "team"},
+		},
+	}
+	for _, node := range nodes {
+		require.NoError(t, rt.StartNode(ctx, node, StartOptions{}))
+	}
+
+	// One network per devnet, created once
+	assert.Equal(t, []string{"dvb-mydevnet", "dvb-other"}, mock.networkCreateCalls)
+	assert.Equal(t, "bridge", mock.networks["dvb-mydevnet"].Driver)
+	assert.Equal(t, "team", mock.networks["dvb-other"].Labels["dvb.namespace"])
+
+	// Containers join their devnet's network under their DNS names
+	assert.Equal(t, container.NetworkMode("dvb-mydevnet"), mock.createCalls[1].hostConfig.NetworkMode)
+	endpoint := mock.networkingConfigCalls[1].EndpointsConfig["dvb-mydevnet"]
+	require.NotNil(t, endpoint)
+	assert.Equal(t, []string{"full1.mydevnet"}, endpoint.Aliases)
+	assert.Equal(t, []string{"val0.mydevnet"}, rt.DevnetContainers(types.DefaultNamespace, "mydevnet")[0].Aliases)
+
+	// The network is removed with the devnet's last container
+	require.NoError(t, rt.StopNode(ctx, "mydevnet-validator-0", false))
+	assert.Empty(t, mock.networkRemoveCalls)
+	_, err := rt.StopNodeWithin(ctx, "mydevnet-fullnode-1", time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dvb-mydevnet"}, mock.networkRemoveCalls)
+
+	require.NoError(t, rt.Cleanup(ctx))
+	assert.Equal(t, []string{"dvb-mydevnet", "dvb-other"}, mock.networkRemoveCalls)
+	assert.Empty(t, mock.networks)
+}
+
+func TestDockerRuntime_PinImage(t *testing.T) {
+	repoDigest := "sha256:" + strings.Repeat("a", 64)
+	otherDigest := "sha256:" + strings.Repeat("b", 64)
+	pulled := imagetypes.InspectResponse{
+		ID:          "sha256:" + strings.Repeat("1", 64),
+		RepoDigests: []string{"mirror.io/stabled@" + otherDigest, "stablelabs/stabled@" + repoDigest},
+	}
+	local := imagetypes.InspectResponse{ID: "sha256:" + strings.Repeat("2", 64)}
+	newRuntime := func(mock *mockDockerClient) *DockerRuntime {
+		return &DockerRuntime{
+			client:       mock,
+			logger:       testLogger(),
+			defaultImage: "stablelabs/stabled:latest",
+			containers:   make(map[string]*containerState),
+		}
+	}
+	ctx := context.Background()
+
+	t.Run("pulls and pins the repository digest", func(t *testing.T) {
+		mock := &mockDockerClient{images: map[string]imagetypes.InspectResponse{"stablelabs/stabled:latest": pulled}}
+		pinned, err := newRuntime(mock).PinImage(ctx, "", false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"stablelabs/stabled:latest"}, mock.pullCalls)
+		assert.Equal(t, PinnedImage{
+			Image:  "stablelabs/stabled:latest",
+			Digest: repoDigest,
+			Ref:    "stablelabs/stabled@" + repoDigest,
+		}, pinned)
+	})
+
+	t.Run("offline pins the local copy without pulling", func(t *testing.T) {
+		mock := &mockDockerClient{images: map[string]imagetypes.InspectResponse{"stablelabs/stabled:v1": pulled}}
+		pinned, err := newRuntime(mock).PinImage(ctx, "stablelabs/stabled:v1", true)
+		require.NoError(t, err)
+		assert.Empty(t, mock.pullCalls)
+		assert.Equal(t, "stablelabs/stabled@"+repoDigest, pinned.Ref)
+
+		_, err = newRuntime(mock).PinImage(ctx, "stablelabs/stabled:v2", true)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not available locally")
+	})
+
+	t.Run("locally built image pins its ID", func(t *testing.T) {
+		mock := &mockDockerClient{
+			images: map[string]imagetypes.InspectResponse{"mychain:dev": local},
+			pullFn: func(ref string) (io.ReadCloser, error) {
+				return nil, errdefs.NotFound(fmt.Errorf("pull access denied for %s", ref))
+			},
+		}
+		pinned, err := newRuntime(mock).PinImage(ctx, "mychain:dev", false)
+		require.NoError(t, err)
+		assert.Equal(t, local.ID, pinned.Digest)
+		assert.Equal(t, local.ID, pinned.Ref)
+	})
+
+	t.Run("failed pull without a local copy", func(t *testing.T) {
+		mock := &mockDockerClient{pullFn: func(ref string) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(`{"status":"Pulling"}` + "\n" + `{"error":"manifest unknown"}`)), nil
+		}}
+		_, err := newRuntime(mock).PinImage(ctx, "stablelabs/stabled:missing", false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "manifest unknown")
+	})
+
+	t.Run("pulls through a registry mirror", func(t *testing.T) {
+		mirrored := imagetypes.InspectResponse{
+			ID:          pulled.ID,
+			RepoDigests: []string{"mirror.corp/hub/stablelabs/stabled@" + repoDigest},
+		}
+		mock := &mockDockerClient{images: map[string]imagetypes.InspectResponse{"mirror.corp/hub/stablelabs/stabled:latest": mirrored}}
+		rt := newRuntime(mock)
+		rt.registries = registries.NewResolver(
+			[]registries.Mirror{{From: "docker.io", To: "mirror.corp/hub"}},
+			[]registries.Credentials{{Host: "mirror.corp", Username: "ci", Password: "secret"}},
+		)
+		pinned, err := rt.PinImage(ctx, "", false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"mirror.corp/hub/stablelabs/stabled:latest"}, mock.pullCalls)
+		assert.NotEmpty(t, mock.pullAuth[0])
+		assert.Equal(t, PinnedImage{
+			Image:  "stablelabs/stabled:latest",
+			Digest: repoDigest,
+			Ref:    "mirror.corp/hub/stablelabs/stabled@" + repoDigest,
+		}, pinned)
+	})
+
+	t.Run("invalid reference", func(t *testing.T) {
+		_, err := newRuntime(&mockDockerClient{}).PinImage(ctx, "Not A Ref", false)
+		require.Error(t, err)
+	})
+}
+
+func TestDockerRuntime_UpstreamDigest(t *testing.T) {
+	upstreamDigest := "sha256:" + strings.Repeat("c", 64)
+	rt := &DockerRuntime{
+		client:       &mockDockerClient{upstream: map[string]string{"stablelabs/stabled:latest": upstreamDigest}},
+		logger:       testLogger(),
+		defaultImage: "stablelabs/stabled:latest",
+		containers:   make(map[string]*containerState),
+	}
+
+	got, err := rt.UpstreamDigest(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, upstreamDigest, got)
+
+	_, err = rt.UpstreamDigest(context.Background(), "mychain:dev")
+	assert.Error(t, err)
+}
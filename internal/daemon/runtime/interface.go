@@ -110,6 +110,87 @@ type PluginRuntime interface {
 	ContainerHomePath() string
 }
 
+// ConfigReloader is implemented by PluginRuntimes whose node binary reloads
+// part of its configuration on a signal, without restarting.
+type ConfigReloader interface {
+	// ReloadableConfigKeys returns the keys the node reloads, as
+	// "<file>:<dotted.key>" (e.g. "config.toml:log_level").
+	ReloadableConfigKeys() []string
+
+	// ReloadSignal returns the signal that makes the node reload them
+	ReloadSignal() syscall.Signal
+}
+
+// NodeConfigReloader is implemented by NodeRuntimes that can apply
+// configuration changes to a running node without restarting it.
+type NodeConfigReloader interface {
+	// ReloadNodeConfig makes a running node reload the changed keys. It
+	// returns false without touching the node if any key cannot be reloaded.
+	ReloadNodeConfig(ctx context.Context, nodeID string, keys []string) (bool, error)
+}
+
+// ContainerLister is implemented by NodeRuntimes that run nodes in
+// containers, to describe them for export.
+type ContainerLister interface {
+	// DevnetContainers returns the containers of a devnet's running nodes,
+	// ordered by node index.
+	DevnetContainers(namespace, devnet string) []ContainerSpec
+}
+
+// NetworkShaper is implemented by NodeRuntimes that run each node in its
+// own network namespace, to emulate network conditions between nodes.
+type NetworkShaper interface {
+	// NodeIP returns the address the other nodes reach a running node on.
+	NodeIP(ctx context.Context, nodeID string) (string, error)
+
+	// RunInNodeNetwork runs a shell script with the NET_ADMIN capability in
+	// a running node's network namespace, in a container of image.
+	RunInNodeNetwork(ctx context.Context, nodeID, image, script string) error
+}
+
+// ImagePinner is implemented by NodeRuntimes that run nodes from images,
+// so all of a devnet's nodes start from the same image content.
+type ImagePinner interface {
+	// PinImage pulls image, or the runtime's default image when empty,
+	// unless offline, and resolves it to the digest of its local copy.
+	PinImage(ctx context.Context, image string, offline bool) (PinnedImage, error)
+
+	// UpstreamDigest returns the digest image resolves to in its registry.
+	UpstreamDigest(ctx context.Context, image string) (string, error)
+}
+
+// PinnedImage is an image resolved to the content it names.
+type PinnedImage struct {
+	// Image is the image as requested, e.g. "stablelabs/stabled:latest".
+	Image string
+
+	// Digest identifies the image's content, e.g. "sha256:...": its
+	// repository digest, or its image ID when it was built or loaded
+	// locally rather than pulled.
+	Digest string
+
+	// Ref is the reference nodes start from, e.g.
+	// "stablelabs/stabled@sha256:...".
+	Ref string
+}
+
+// NodeCommandRunner is implemented by NodeRuntimes that run nodes from
+// images, where a stopped node's binary is only available in its image.
+type NodeCommandRunner interface {
+	// RunNodeCommand runs the binary of a stopped node with args, followed
+	// by --home and its home directory, and returns the command's output.
+	RunNodeCommand(ctx context.Context, node *types.Node, args []string) (string, error)
+}
+
+// TimedNodeStopper is implemented by NodeRuntimes that can bound how long
+// stopping a node takes.
+type TimedNodeStopper interface {
+	// StopNodeWithin stops a node gracefully, kills it with SIGKILL if it
+	// has not exited within timeout, and waits for it to exit. It reports
+	// whether the node had to be killed.
+	StopNodeWithin(ctx context.Context, nodeID string, timeout time.Duration) (killed bool, err error)
+}
+
 // PluginRuntimeProvider provides PluginRuntime instances for different networks.
 // This allows the runtime to obtain network-specific commands dynamically.
 type PluginRuntimeProvider interface {
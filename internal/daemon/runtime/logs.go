@@ -3,30 +3,44 @@ package runtime
 
 import (
 	"bufio"
+	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/clock"
 	"github.com/nxadm/tail"
 )
 
-// LogConfig configures log management
+// LogConfig configures log management.
+//
+// A node's log is written to <nodeID>.log and rotated to <nodeID>.log.1,
+// .2, ... (newest first), gzipped to .N.gz when Compress is set.
 type LogConfig struct {
-	MaxSize  int64 // max file size before rotation (bytes)
-	MaxFiles int   // max number of rotated files to keep
+	MaxSize   int64         // max file size before rotation (bytes)
+	MaxAge    time.Duration // rotate when a write falls in a later period than the last one, e.g. the next UTC day for 24h (0 = size only)
+	MaxFiles  int           // max number of rotated files to keep
+	Retention time.Duration // remove rotated files last written longer ago than this (0 = keep MaxFiles)
+	Compress  bool          // gzip rotated files
 }
 
 // DefaultLogConfig returns default log configuration
 func DefaultLogConfig() LogConfig {
 	return LogConfig{
-		MaxSize:  100 * 1024 * 1024, // 100MB
-		MaxFiles: 5,
+		MaxSize:   100 * 1024 * 1024, // 100MB
+		MaxAge:    24 * time.Hour,
+		MaxFiles:  5,
+		Retention: 7 * 24 * time.Hour,
+		Compress:  true,
 	}
 }
 
@@ -64,7 +78,7 @@ func (lm *LogManager) GetWriter(nodeID string, logPath string) (io.WriteCloser,
 	}
 
 	// Create new rotating writer
-	w, err := newRotatingWriter(logPath, lm.config.MaxSize, lm.config.MaxFiles)
+	w, err := newRotatingWriter(logPath, lm.config)
 	if err != nil {
 		return nil, err
 	}
@@ -75,36 +89,38 @@ func (lm *LogManager) GetWriter(nodeID string, logPath string) (io.WriteCloser,
 
 // GetReader returns a reader for a node's logs.
 // If opts.Follow is true, it uses nxadm/tail to follow the file for new content.
+//
+// The rotated files are read before the current one, oldest first. With
+// opts.Since, rotated files last written before it are skipped; lines are
+// not filtered within a file, since node logs carry no date.
 func (lm *LogManager) GetReader(ctx context.Context, logPath string, opts LogOptions) (io.ReadCloser, error) {
 	// If follow mode, use tail package
 	if opts.Follow {
-		return lm.followFile(ctx, logPath, opts.Lines)
+		return lm.followFile(ctx, logPath, opts)
 	}
 
 	// Non-follow mode: return static content
 	if opts.Lines > 0 {
-		return lm.tailFile(logPath, opts.Lines)
+		return lm.tailFile(logPath, opts.Lines, opts.Since)
 	}
 
-	f, err := os.Open(logPath)
-	if err != nil {
-		return nil, err
-	}
-
-	return f, nil
+	return lm.readFiles(logPath, opts.Since)
 }
 
 // followFile uses nxadm/tail to follow a log file for new content.
-func (lm *LogManager) followFile(ctx context.Context, logPath string, lines int) (io.ReadCloser, error) {
+func (lm *LogManager) followFile(ctx context.Context, logPath string, opts LogOptions) (io.ReadCloser, error) {
 	// If lines > 0, we need to first read the last N lines, then follow from end.
 	// The tail package doesn't support "last N lines" directly - Location is for byte offset.
 	var initialContent io.ReadCloser
-	if lines > 0 {
-		var err error
-		initialContent, err = lm.tailFile(logPath, lines)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read initial lines: %w", err)
-		}
+	var err error
+	switch {
+	case opts.Lines > 0:
+		initialContent, err = lm.tailFile(logPath, opts.Lines, opts.Since)
+	case !opts.Since.IsZero():
+		initialContent, err = lm.readFiles(logPath, opts.Since)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read initial lines: %w", err)
 	}
 
 	// Always start following from the end of the file
@@ -229,24 +245,25 @@ func (tr *tailReader) Close() error {
 	return err
 }
 
-// tailFile returns the last N lines of a file
-func (lm *LogManager) tailFile(logPath string, lines int) (io.ReadCloser, error) {
-	f, err := os.Open(logPath)
+// tailFile returns the last N lines of a log, reading rotated files back
+// from the current one until it has enough.
+func (lm *LogManager) tailFile(logPath string, lines int, since time.Time) (io.ReadCloser, error) {
+	paths, err := logFiles(logPath, since)
 	if err != nil {
 		return nil, err
 	}
 
-	// Read all lines (simple implementation)
 	var allLines []string
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		allLines = append(allLines, scanner.Text())
-	}
-	f.Close()
-
-	// Check for scanner errors
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("failed to read log file: %w", err)
+	for i := len(paths) - 1; i >= 0 && len(allLines) < lines; i-- {
+		fileLines, err := readLines(paths[i])
+		if err != nil {
+			// Rotated away since it was listed
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read log file: %w", err)
+		}
+		allLines = append(fileLines, allLines...)
 	}
 
 	// Get last N lines
@@ -266,6 +283,153 @@ func (lm *LogManager) tailFile(logPath string, lines int) (io.ReadCloser, error)
 	return io.NopCloser(strings.NewReader(content)), nil
 }
 
+// readLines reads the lines of a log file, gzipped or not.
+func readLines(path string) ([]string, error) {
+	r, err := openLogFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
+
+// readFiles returns the content of a log's files, oldest first.
+func (lm *LogManager) readFiles(logPath string, since time.Time) (io.ReadCloser, error) {
+	paths, err := logFiles(logPath, since)
+	if err != nil {
+		return nil, err
+	}
+
+	var files multiReadCloser
+	for _, path := range paths {
+		r, err := openLogFile(path)
+		if err != nil {
+			// Rotated away since it was listed
+			if os.IsNotExist(err) {
+				continue
+			}
+			files.Close()
+			return nil, err
+		}
+		files = append(files, r)
+	}
+	return &files, nil
+}
+
+// logFiles returns the files of a log oldest first: the rotated files last
+// written at or after since, then the current file if it exists.
+func logFiles(logPath string, since time.Time) ([]string, error) {
+	rotated := rotatedFiles(logPath)
+
+	var paths []string
+	for i := len(rotated) - 1; i >= 0; i-- {
+		if !since.IsZero() {
+			info, err := os.Stat(rotated[i].path)
+			if err != nil || info.ModTime().Before(since) {
+				continue
+			}
+		}
+		paths = append(paths, rotated[i].path)
+	}
+
+	if _, err := os.Stat(logPath); err != nil {
+		// Between a rotation and the next write there is no current file
+		if !os.IsNotExist(err) || len(rotated) == 0 {
+			return nil, err
+		}
+		return paths, nil
+	}
+	return append(paths, logPath), nil
+}
+
+// rotatedFile is a rotated file of a log; index 1 is the newest.
+type rotatedFile struct {
+	path  string
+	index int
+}
+
+// rotatedFiles returns the rotated files of a log, newest first.
+func rotatedFiles(logPath string) []rotatedFile {
+	matches, err := filepath.Glob(logPath + ".*")
+	if err != nil {
+		// Glob only returns error for malformed patterns, which shouldn't happen
+		return nil
+	}
+
+	var files []rotatedFile
+	for _, m := range matches {
+		suffix := strings.TrimSuffix(strings.TrimPrefix(m, logPath+"."), ".gz")
+		index, err := strconv.Atoi(suffix)
+		if err != nil || index < 1 {
+			continue
+		}
+		files = append(files, rotatedFile{path: m, index: index})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].index < files[j].index })
+	return files
+}
+
+// openLogFile opens a log file, decompressing it if it is gzipped.
+func openLogFile(path string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(path, ".gz") {
+		return f, nil
+	}
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to decompress %s: %w", filepath.Base(path), err)
+	}
+	return gzipFile{Reader: zr, file: f}, nil
+}
+
+// gzipFile closes both the gzip reader and its file.
+type gzipFile struct {
+	*gzip.Reader
+	file *os.File
+}
+
+func (g gzipFile) Close() error {
+	return errors.Join(g.Reader.Close(), g.file.Close())
+}
+
+// multiReadCloser reads its readers one after the other.
+type multiReadCloser []io.ReadCloser
+
+func (m *multiReadCloser) Read(p []byte) (int, error) {
+	for len(*m) > 0 {
+		n, err := (*m)[0].Read(p)
+		if err == io.EOF {
+			(*m)[0].Close()
+			*m = (*m)[1:]
+			err = nil
+		}
+		if n > 0 || err != nil {
+			return n, err
+		}
+	}
+	return 0, io.EOF
+}
+
+func (m *multiReadCloser) Close() error {
+	var errs []error
+	for _, r := range *m {
+		errs = append(errs, r.Close())
+	}
+	*m = nil
+	return errors.Join(errs...)
+}
+
 // Close closes a writer for a node
 func (lm *LogManager) Close(nodeID string) error {
 	lm.mu.Lock()
@@ -280,19 +444,21 @@ func (lm *LogManager) Close(nodeID string) error {
 
 // rotatingWriter writes to a file with rotation
 type rotatingWriter struct {
-	path     string
-	maxSize  int64
-	maxFiles int
-	file     *os.File
-	size     int64
-	mu       sync.Mutex
+	path      string
+	config    LogConfig
+	file      *os.File
+	size      int64
+	lastWrite time.Time
+	mu        sync.Mutex
+
+	clock clock.Clock
 }
 
-func newRotatingWriter(path string, maxSize int64, maxFiles int) (*rotatingWriter, error) {
+func newRotatingWriter(path string, config LogConfig) (*rotatingWriter, error) {
 	w := &rotatingWriter{
-		path:     path,
-		maxSize:  maxSize,
-		maxFiles: maxFiles,
+		path:   path,
+		config: config,
+		clock:  clock.System,
 	}
 
 	if err := w.openFile(); err != nil {
@@ -316,6 +482,7 @@ func (w *rotatingWriter) openFile() error {
 
 	w.file = f
 	w.size = info.Size()
+	w.lastWrite = info.ModTime()
 	return nil
 }
 
@@ -324,7 +491,8 @@ func (w *rotatingWriter) Write(p []byte) (n int, err error) {
 	defer w.mu.Unlock()
 
 	// Check if we need to rotate
-	if w.size+int64(len(p)) > w.maxSize {
+	now := w.clock.Now()
+	if w.size+int64(len(p)) > w.config.MaxSize || w.periodEnded(now) {
 		if err := w.rotate(); err != nil {
 			return 0, err
 		}
@@ -332,61 +500,106 @@ func (w *rotatingWriter) Write(p []byte) (n int, err error) {
 
 	n, err = w.file.Write(p)
 	w.size += int64(n)
+	w.lastWrite = now
 	return n, err
 }
 
+// periodEnded reports whether a write at now falls in a later MaxAge period
+// than the last write. Periods are aligned to the Unix epoch, so a file
+// reopened after a restart rotates at the same time.
+func (w *rotatingWriter) periodEnded(now time.Time) bool {
+	if w.config.MaxAge <= 0 || w.size == 0 {
+		return false
+	}
+	return now.Truncate(w.config.MaxAge).After(w.lastWrite.Truncate(w.config.MaxAge))
+}
+
 func (w *rotatingWriter) rotate() error {
 	// Close current file
 	w.file.Close()
 
-	// Rotate existing files (errors are non-critical - old files might not exist)
-	for i := w.maxFiles - 1; i >= 1; i-- {
-		oldPath := fmt.Sprintf("%s.%d", w.path, i)
-		newPath := fmt.Sprintf("%s.%d", w.path, i+1)
-		if err := os.Rename(oldPath, newPath); err != nil && !os.IsNotExist(err) {
-			// Log but continue - rotation of old files is best-effort
-			_ = err // acknowledge error but continue
+	// Shift rotated files up by one, oldest first, dropping those beyond
+	// maxFiles (errors are non-critical - rotation of old files is best-effort)
+	rotated := rotatedFiles(w.path)
+	for i := len(rotated) - 1; i >= 0; i-- {
+		f := rotated[i]
+		if f.index >= w.config.MaxFiles {
+			_ = os.Remove(f.path)
+			continue
+		}
+		newPath := fmt.Sprintf("%s.%d", w.path, f.index+1)
+		if strings.HasSuffix(f.path, ".gz") {
+			newPath += ".gz"
 		}
+		_ = os.Rename(f.path, newPath)
 	}
 
 	// Move current file to .1 - this is critical for rotation
-	if err := os.Rename(w.path, fmt.Sprintf("%s.1", w.path)); err != nil && !os.IsNotExist(err) {
+	rotatedPath := w.path + ".1"
+	if err := os.Rename(w.path, rotatedPath); err != nil && !os.IsNotExist(err) {
 		return fmt.Errorf("failed to rotate current log file: %w", err)
 	}
 
-	// Delete old files beyond maxFiles
+	// A file that fails to compress is kept as is
+	if w.config.Compress {
+		_ = compressFile(rotatedPath)
+	}
+
+	// Delete files beyond the retention
 	w.cleanOldFiles()
 
 	// Open new file
 	return w.openFile()
 }
 
-func (w *rotatingWriter) cleanOldFiles() {
-	pattern := w.path + ".*"
-	matches, err := filepath.Glob(pattern)
-	if err != nil || matches == nil {
-		// Glob only returns error for malformed patterns, which shouldn't happen
-		// If no matches, nothing to clean
-		return
+// compressFile gzips path to path.gz and removes path. The compressed file
+// keeps the modification time, which is when the log was last written.
+func compressFile(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	src, err := os.Open(path)
+	if err != nil {
+		return err
 	}
+	defer src.Close()
 
-	if len(matches) <= w.maxFiles {
-		return
+	gzPath := path + ".gz"
+	dst, err := os.OpenFile(gzPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(dst)
+	_, err = io.Copy(zw, src)
+	err = errors.Join(err, zw.Close(), dst.Close())
+	if err != nil {
+		_ = os.Remove(gzPath)
+		return err
 	}
 
-	// Sort by modification time
-	sort.Slice(matches, func(i, j int) bool {
-		iInfo, _ := os.Stat(matches[i])
-		jInfo, _ := os.Stat(matches[j])
-		if iInfo == nil || jInfo == nil {
-			return false
-		}
-		return iInfo.ModTime().After(jInfo.ModTime())
-	})
+	if err := os.Chtimes(gzPath, info.ModTime(), info.ModTime()); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// cleanOldFiles removes the rotated files beyond maxFiles or last written
+// longer ago than the retention (best-effort cleanup, ignore errors).
+func (w *rotatingWriter) cleanOldFiles() {
+	cutoff := time.Time{}
+	if w.config.Retention > 0 {
+		cutoff = w.clock.Now().Add(-w.config.Retention)
+	}
 
-	// Remove old files (best-effort cleanup, ignore errors)
-	for i := w.maxFiles; i < len(matches); i++ {
-		_ = os.Remove(matches[i])
+	for _, f := range rotatedFiles(w.path) {
+		if f.index > w.config.MaxFiles {
+			_ = os.Remove(f.path)
+			continue
+		}
+		if info, err := os.Stat(f.path); err == nil && info.ModTime().Before(cutoff) {
+			_ = os.Remove(f.path)
+		}
 	}
 }
 
@@ -3,10 +3,14 @@ package runtime
 
 import (
 	"context"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/clock"
 )
 
 func TestLogManagerWrite(t *testing.T) {
@@ -96,3 +100,115 @@ func TestLogManagerRead(t *testing.T) {
 		t.Errorf("Expected last 2 lines, got: %s", content)
 	}
 }
+
+func TestLogManagerTimeRotation(t *testing.T) {
+	tempDir := t.TempDir()
+
+	lm := NewLogManager(tempDir, LogConfig{
+		MaxSize:   1024,
+		MaxAge:    24 * time.Hour,
+		MaxFiles:  5,
+		Retention: 48 * time.Hour,
+		Compress:  true,
+	})
+
+	logPath := filepath.Join(tempDir, "test.log")
+	writer, err := lm.GetWriter("test-node", logPath)
+	if err != nil {
+		t.Fatalf("GetWriter failed: %v", err)
+	}
+	defer writer.Close()
+
+	clk := clock.NewFake(time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC))
+	w := writer.(*rotatingWriter)
+	w.clock = clk
+	w.lastWrite = clk.Now()
+
+	// Writes on the same day stay in the current file
+	writer.Write([]byte("day 1 morning\n"))
+	clk.Advance(6 * time.Hour)
+	writer.Write([]byte("day 1 evening\n"))
+	if files := rotatedFiles(logPath); len(files) != 0 {
+		t.Fatalf("rotated within the day: %v", files)
+	}
+
+	// The first write of the next day rotates and compresses the file
+	clk.Advance(12 * time.Hour)
+	writer.Write([]byte("day 2\n"))
+	files := rotatedFiles(logPath)
+	if len(files) != 1 || files[0].path != logPath+".1.gz" {
+		t.Fatalf("expected %s.1.gz, got %v", logPath, files)
+	}
+	lines, err := readLines(files[0].path)
+	if err != nil {
+		t.Fatalf("readLines failed: %v", err)
+	}
+	if len(lines) != 2 || lines[0] != "day 1 morning" {
+		t.Errorf("unexpected rotated lines: %v", lines)
+	}
+
+	// Rotated files past the retention are removed at the next rotation
+	if err := os.Chtimes(files[0].path, clk.Now().Add(-72*time.Hour), clk.Now().Add(-72*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	clk.Advance(24 * time.Hour)
+	writer.Write([]byte("day 3\n"))
+	files = rotatedFiles(logPath)
+	if len(files) != 1 || files[0].path != logPath+".1.gz" {
+		t.Errorf("expected only the day 2 file, got %v", files)
+	}
+}
+
+func TestLogManagerReadRotated(t *testing.T) {
+	tempDir := t.TempDir()
+
+	lm := NewLogManager(tempDir, LogConfig{MaxSize: 1024, MaxFiles: 5})
+	logPath := filepath.Join(tempDir, "test.log")
+
+	// Two rotated files, one of them compressed, and the current file
+	old := time.Now().Add(-2 * time.Hour)
+	recent := time.Now().Add(-10 * time.Minute)
+	for _, f := range []struct {
+		path    string
+		content string
+		mtime   time.Time
+	}{
+		{logPath + ".2", "line 1\nline 2\n", old},
+		{logPath + ".1", "line 3\n", recent},
+		{logPath, "line 4\n", time.Now()},
+	} {
+		if err := os.WriteFile(f.path, []byte(f.content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(f.path, f.mtime, f.mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := compressFile(logPath + ".2"); err != nil {
+		t.Fatalf("compressFile failed: %v", err)
+	}
+
+	read := func(opts LogOptions) string {
+		t.Helper()
+		reader, err := lm.GetReader(context.Background(), logPath, opts)
+		if err != nil {
+			t.Fatalf("GetReader failed: %v", err)
+		}
+		defer reader.Close()
+		content, err := io.ReadAll(reader)
+		if err != nil {
+			t.Fatalf("ReadAll failed: %v", err)
+		}
+		return string(content)
+	}
+
+	if got, want := read(LogOptions{}), "line 1\nline 2\nline 3\nline 4\n"; got != want {
+		t.Errorf("all lines = %q, want %q", got, want)
+	}
+	if got, want := read(LogOptions{Lines: 3}), "line 2\nline 3\nline 4\n"; got != want {
+		t.Errorf("last 3 lines = %q, want %q", got, want)
+	}
+	if got, want := read(LogOptions{Since: time.Now().Add(-time.Hour)}), "line 3\nline 4\n"; got != want {
+		t.Errorf("lines since an hour ago = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,22 @@
+package runtime
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessAlive(t *testing.T) {
+	assert.True(t, processAlive(os.Getpid()))
+
+	// A process that has exited and been reaped is gone
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	cmd := exec.Command(exe, "-test.run=^$")
+	configureProcess(cmd)
+	require.NoError(t, cmd.Run())
+	assert.False(t, processAlive(cmd.Process.Pid))
+}
@@ -0,0 +1,28 @@
+//go:build !windows
+
+package runtime
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// configureProcess prepares cmd so signalProcess can stop it gracefully.
+// Unix processes need nothing extra.
+func configureProcess(cmd *exec.Cmd) {}
+
+// signalProcess sends sig to the process with the given PID.
+func signalProcess(pid int, sig syscall.Signal) error {
+	return syscall.Kill(pid, sig)
+}
+
+// processAlive reports whether a process with the given PID exists, by
+// sending it signal 0.
+func processAlive(pid int) bool {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	return proc.Signal(syscall.Signal(0)) == nil
+}
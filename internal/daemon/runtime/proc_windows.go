@@ -0,0 +1,56 @@
+//go:build windows
+
+package runtime
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
+
+// stillActive is the exit code GetExitCodeProcess reports for a process
+// that has not exited.
+const stillActive = 259
+
+// configureProcess starts the process in its own process group, so
+// signalProcess can send it CTRL_BREAK without interrupting devnetd.
+func configureProcess(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: windows.CREATE_NEW_PROCESS_GROUP}
+}
+
+// signalProcess maps Unix signals onto what Windows offers: SIGTERM and
+// SIGINT send CTRL_BREAK to the process group, which Go and CometBFT
+// binaries handle like SIGINT, and SIGKILL terminates the process. Other
+// signals, such as SIGHUP config reloads, are not supported.
+func signalProcess(pid int, sig syscall.Signal) error {
+	switch sig {
+	case syscall.SIGTERM, syscall.SIGINT:
+		return windows.GenerateConsoleCtrlEvent(windows.CTRL_BREAK_EVENT, uint32(pid))
+	case syscall.SIGKILL:
+		proc, err := os.FindProcess(pid)
+		if err != nil {
+			return err
+		}
+		return proc.Kill()
+	default:
+		return fmt.Errorf("signal %v is not supported on Windows", sig)
+	}
+}
+
+// processAlive reports whether a process with the given PID is running.
+func processAlive(pid int) bool {
+	h, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, uint32(pid))
+	if err != nil {
+		return false
+	}
+	defer windows.CloseHandle(h)
+
+	var code uint32
+	if err := windows.GetExitCodeProcess(h, &code); err != nil {
+		return false
+	}
+	return code == stillActive
+}
@@ -0,0 +1,16 @@
+//go:build windows
+
+package runtime
+
+import (
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignalProcess_UnsupportedSignal(t *testing.T) {
+	err := signalProcess(os.Getpid(), syscall.SIGHUP)
+	assert.ErrorContains(t, err, "not supported on Windows")
+}
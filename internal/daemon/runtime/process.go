@@ -127,6 +127,9 @@ func (pr *ProcessRuntime) StartNode(ctx context.Context, node *types.Node, opts
 			env[k] = v
 		}
 	}
+	for k, v := range node.Spec.Env {
+		env[k] = v
+	}
 	for k, v := range opts.Env {
 		env[k] = v
 	}
@@ -139,6 +142,11 @@ func (pr *ProcessRuntime) StartNode(ctx context.Context, node *types.Node, opts
 		gracePeriod = pluginRuntime.GracePeriod()
 	}
 
+	var reloader ConfigReloader
+	if r, ok := pluginRuntime.(ConfigReloader); ok {
+		reloader = r
+	}
+
 	// Create supervisor
 	sup := newSupervisor(supervisorConfig{
 		command:     command,
@@ -149,6 +157,7 @@ func (pr *ProcessRuntime) StartNode(ctx context.Context, node *types.Node, opts
 		stopSignal:  stopSignal,
 		gracePeriod: gracePeriod,
 		logger:      pr.config.Logger,
+		reloader:    reloader,
 	})
 
 	pr.supervisors[nodeID] = sup
@@ -184,6 +193,27 @@ func (pr *ProcessRuntime) StopNode(ctx context.Context, nodeID string, graceful
 	return nil
 }
 
+// StopNodeWithin stops a node process, killing it if it has not exited
+// within timeout. It reports whether the process had to be killed.
+func (pr *ProcessRuntime) StopNodeWithin(ctx context.Context, nodeID string, timeout time.Duration) (bool, error) {
+	pr.mu.Lock()
+	sup, exists := pr.supervisors[nodeID]
+	if !exists {
+		pr.mu.Unlock()
+		return false, fmt.Errorf("node %s not found", nodeID)
+	}
+	delete(pr.supervisors, nodeID)
+	pr.mu.Unlock()
+
+	killed := sup.stopWithin(timeout)
+
+	// Close log writer
+	pr.logManager.Close(nodeID)
+
+	pr.config.Logger.Info("stopped node", "nodeID", nodeID, "killed", killed)
+	return killed, nil
+}
+
 // RestartNode restarts a node process
 func (pr *ProcessRuntime) RestartNode(ctx context.Context, nodeID string) error {
 	pr.mu.RLock()
@@ -205,6 +235,38 @@ func (pr *ProcessRuntime) RestartNode(ctx context.Context, nodeID string) error
 	return nil
 }
 
+// ReloadNodeConfig signals a running node to reload its configuration if its
+// plugin declares every key reloadable.
+func (pr *ProcessRuntime) ReloadNodeConfig(ctx context.Context, nodeID string, keys []string) (bool, error) {
+	pr.mu.RLock()
+	sup, exists := pr.supervisors[nodeID]
+	pr.mu.RUnlock()
+
+	if !exists {
+		return false, fmt.Errorf("node %s not found", nodeID)
+	}
+
+	reloader := sup.config.reloader
+	if reloader == nil {
+		return false, nil
+	}
+	reloadable := make(map[string]bool)
+	for _, key := range reloader.ReloadableConfigKeys() {
+		reloadable[key] = true
+	}
+	for _, key := range keys {
+		if !reloadable[key] {
+			return false, nil
+		}
+	}
+
+	if err := sup.signal(reloader.ReloadSignal()); err != nil {
+		return false, fmt.Errorf("failed to signal node %s: %w", nodeID, err)
+	}
+	pr.config.Logger.Info("reloaded node config", "nodeID", nodeID, "keys", keys)
+	return true, nil
+}
+
 // GetNodeStatus returns the current status of a node
 func (pr *ProcessRuntime) GetNodeStatus(ctx context.Context, nodeID string) (*NodeStatus, error) {
 	pr.mu.RLock()
@@ -271,21 +333,11 @@ func (pr *ProcessRuntime) ReconnectNode(ctx context.Context, node *types.Node, s
 		return true, nil
 	}
 
-	// Check if process is still alive using signal 0
-	proc, err := os.FindProcess(storedPID)
-	if err != nil {
-		pr.config.Logger.Debug("process not found",
-			"nodeID", nodeID,
-			"pid", storedPID,
-			"error", err)
-		return false, nil
-	}
-
-	if err := proc.Signal(syscall.Signal(0)); err != nil {
+	// Check if process is still alive
+	if !processAlive(storedPID) {
 		pr.config.Logger.Debug("process not running",
 			"nodeID", nodeID,
-			"pid", storedPID,
-			"error", err)
+			"pid", storedPID)
 		return false, nil
 	}
 
@@ -394,7 +446,8 @@ func (pr *ProcessRuntime) validateProcess(pid int, node *types.Node) bool {
 	return false
 }
 
-// validateProcessFallback uses ps command when /proc is not available (macOS)
+// validateProcessFallback uses ps command when /proc is not available (macOS).
+// Windows has no ps, so processes there are assumed to match.
 func (pr *ProcessRuntime) validateProcessFallback(pid int, node *types.Node) bool {
 	// Use ps to get command line
 	cmd := exec.Command("ps", "-o", "command=", "-p", fmt.Sprintf("%d", pid))
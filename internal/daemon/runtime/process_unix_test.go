@@ -0,0 +1,121 @@
+//go:build !windows
+
+// internal/daemon/runtime/process_unix_test.go
+package runtime
+
+import (
+	"context"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// reloadingPluginRuntime is a PluginRuntime whose node reloads log_level on
+// SIGCONT, which is harmless to the sleep process used in tests.
+type reloadingPluginRuntime struct{}
+
+func (reloadingPluginRuntime) StartCommand(node *types.Node) []string      { return nil }
+func (reloadingPluginRuntime) StartEnv(node *types.Node) map[string]string { return nil }
+func (reloadingPluginRuntime) StopSignal() syscall.Signal                  { return syscall.SIGTERM }
+func (reloadingPluginRuntime) GracePeriod() time.Duration                  { return time.Second }
+func (reloadingPluginRuntime) HealthEndpoint(node *types.Node) string      { return "" }
+func (reloadingPluginRuntime) ContainerHomePath() string                   { return "" }
+func (reloadingPluginRuntime) ReloadableConfigKeys() []string {
+	return []string{"config.toml:log_level"}
+}
+func (reloadingPluginRuntime) ReloadSignal() syscall.Signal { return syscall.SIGCONT }
+
+func TestProcessRuntimeReloadNodeConfig(t *testing.T) {
+	tempDir := t.TempDir()
+	pr := NewProcessRuntime(ProcessRuntimeConfig{DataDir: tempDir})
+	ctx := context.Background()
+
+	start := func(nodeID string, pluginRuntime PluginRuntime) {
+		node := &types.Node{
+			Metadata: types.ResourceMeta{Name: nodeID},
+			Spec:     types.NodeSpec{BinaryPath: "sleep", HomeDir: tempDir},
+		}
+		pr.SetCommandOverride(nodeID, []string{"sleep", "30"})
+		if err := pr.StartNode(ctx, node, StartOptions{
+			RestartPolicy: RestartPolicy{Policy: "never"},
+			PluginRuntime: pluginRuntime,
+		}); err != nil {
+			t.Fatalf("StartNode failed: %v", err)
+		}
+		t.Cleanup(func() { _ = pr.StopNode(ctx, nodeID, false) })
+	}
+
+	start("reload-node", reloadingPluginRuntime{})
+	start("plain-node", nil)
+	time.Sleep(100 * time.Millisecond)
+
+	reloaded, err := pr.ReloadNodeConfig(ctx, "reload-node", []string{"config.toml:log_level"})
+	if err != nil || !reloaded {
+		t.Fatalf("ReloadNodeConfig() = %v, %v; want true, nil", reloaded, err)
+	}
+
+	reloaded, err = pr.ReloadNodeConfig(ctx, "reload-node", []string{"config.toml:log_level", "app.toml:api.enable"})
+	if err != nil || reloaded {
+		t.Errorf("ReloadNodeConfig() with a non-reloadable key = %v, %v; want false, nil", reloaded, err)
+	}
+
+	reloaded, err = pr.ReloadNodeConfig(ctx, "plain-node", []string{"config.toml:log_level"})
+	if err != nil || reloaded {
+		t.Errorf("ReloadNodeConfig() without a reloader = %v, %v; want false, nil", reloaded, err)
+	}
+
+	status, _ := pr.GetNodeStatus(ctx, "reload-node")
+	if !status.Running {
+		t.Error("node should still be running after a reload")
+	}
+
+	if _, err := pr.ReloadNodeConfig(ctx, "missing", nil); err == nil {
+		t.Error("ReloadNodeConfig() should fail for an unknown node")
+	}
+}
+
+func TestProcessRuntimeStopNodeWithin(t *testing.T) {
+	tempDir := t.TempDir()
+	pr := NewProcessRuntime(ProcessRuntimeConfig{DataDir: tempDir})
+	ctx := context.Background()
+
+	start := func(nodeID string, command []string) {
+		node := &types.Node{
+			Metadata: types.ResourceMeta{Name: nodeID},
+			Spec:     types.NodeSpec{BinaryPath: command[0], HomeDir: tempDir},
+		}
+		pr.SetCommandOverride(nodeID, command)
+		if err := pr.StartNode(ctx, node, StartOptions{RestartPolicy: RestartPolicy{Policy: "never"}}); err != nil {
+			t.Fatalf("StartNode(%s) failed: %v", nodeID, err)
+		}
+	}
+	start("exits", []string{"sleep", "30"})
+	start("ignores-sigterm", []string{"sh", "-c", "trap '' TERM; while :; do sleep 1; done"})
+	time.Sleep(200 * time.Millisecond)
+
+	killed, err := pr.StopNodeWithin(ctx, "exits", 5*time.Second)
+	if err != nil {
+		t.Fatalf("StopNodeWithin(exits) failed: %v", err)
+	}
+	if killed {
+		t.Error("a process that exits on SIGTERM should not be killed")
+	}
+
+	began := time.Now()
+	killed, err = pr.StopNodeWithin(ctx, "ignores-sigterm", 300*time.Millisecond)
+	if err != nil {
+		t.Fatalf("StopNodeWithin(ignores-sigterm) failed: %v", err)
+	}
+	if !killed {
+		t.Error("a process that ignores SIGTERM should be killed")
+	}
+	if elapsed := time.Since(began); elapsed > 5*time.Second {
+		t.Errorf("stopping took %s, want about the timeout", elapsed)
+	}
+
+	if _, err := pr.StopNodeWithin(ctx, "exits", time.Second); err == nil {
+		t.Error("expected an error stopping a node that was already stopped")
+	}
+}
@@ -116,6 +116,9 @@ func (sr *ServiceRuntime) StartNode(ctx context.Context, node *types.Node, opts
 			env[k] = v
 		}
 	}
+	for k, v := range node.Spec.Env {
+		env[k] = v
+	}
 	for k, v := range opts.Env {
 		env[k] = v
 	}
@@ -69,6 +69,23 @@ type ServiceDefinition struct {
 
 	// GracePeriod is how long to wait after stop signal before SIGKILL.
 	GracePeriod time.Duration
+
+	// Description is the human-readable service description.
+	// Defaults to "Devnet node <NodeID>".
+	Description string
+
+	// StartAtLogin enables the service so the service manager starts it
+	// when the user logs in. Node services leave this off; devnetd itself
+	// sets it when installed as a service.
+	StartAtLogin bool
+}
+
+// description returns def.Description or the node default.
+func (def *ServiceDefinition) description() string {
+	if def.Description != "" {
+		return def.Description
+	}
+	return "Devnet node " + def.NodeID
 }
 
 // ServiceStatus represents the status returned by the service manager.
@@ -80,7 +97,8 @@ type ServiceStatus struct {
 }
 
 // NewServiceBackend creates a ServiceBackend for the current platform.
-// Implemented in service_launchd.go (darwin) and service_systemd.go (linux).
+// Implemented in service_launchd.go (darwin) and service_systemd.go (linux);
+// other platforms return an error from service_other.go.
 func NewServiceBackend() (ServiceBackend, error) {
 	return newPlatformServiceBackend()
 }
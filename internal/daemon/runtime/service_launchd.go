@@ -222,7 +222,11 @@ var plistTemplate = template.Must(template.New("plist").Parse(`<?xml version="1.
 	<key>StandardErrorPath</key>
 	<string>{{ .StderrPath }}</string>
 	<key>RunAtLoad</key>
+{{- if .StartAtLogin }}
+	<true/>
+{{- else }}
 	<false/>
+{{- end }}
 {{- if .RestartOnFailure }}
 	<key>KeepAlive</key>
 	<dict>
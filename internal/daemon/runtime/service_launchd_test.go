@@ -111,6 +111,29 @@ func TestRenderPlistDefaultGracePeriod(t *testing.T) {
 	}
 }
 
+func TestRenderPlistStartAtLogin(t *testing.T) {
+	b := &launchdBackend{plistDir: "/tmp/test"}
+
+	def := &ServiceDefinition{
+		ID:               "com.altuslabs.devnet.devnetd",
+		NodeID:           "devnetd",
+		Command:          []string{"/usr/local/bin/devnetd"},
+		WorkingDirectory: "/tmp",
+		StdoutPath:       "/dev/null",
+		StderrPath:       "/dev/null",
+		StartAtLogin:     true,
+	}
+
+	plist, err := b.renderPlist(def)
+	if err != nil {
+		t.Fatalf("renderPlist failed: %v", err)
+	}
+
+	if !strings.Contains(string(plist), "<key>RunAtLoad</key>\n\t<true/>") {
+		t.Error("expected RunAtLoad true when StartAtLogin is set")
+	}
+}
+
 func TestParseLaunchctlPrint(t *testing.T) {
 	tests := []struct {
 		name     string
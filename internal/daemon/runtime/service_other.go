@@ -0,0 +1,11 @@
+//go:build !linux && !darwin
+
+package runtime
+
+import "fmt"
+
+// newPlatformServiceBackend reports that the service runtime is unsupported:
+// it needs systemd (linux) or launchd (darwin). Use the process runtime.
+func newPlatformServiceBackend() (ServiceBackend, error) {
+	return nil, fmt.Errorf("the service runtime is not supported on this platform; use the process runtime")
+}
@@ -74,6 +74,12 @@ func (b *systemdBackend) InstallService(ctx context.Context, def *ServiceDefinit
 		return fmt.Errorf("failed to reload systemd: %w", err)
 	}
 
+	if def.StartAtLogin {
+		if err := b.runSystemctl(ctx, "enable", def.ID); err != nil {
+			return fmt.Errorf("failed to enable service: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -203,7 +209,7 @@ func parseSystemctlShow(output string) *ServiceStatus {
 }
 
 var unitTemplate = template.Must(template.New("unit").Parse(`[Unit]
-Description=Devnet node {{ .NodeID }}
+Description={{ .DescriptionText }}
 
 [Service]
 Type=simple
@@ -220,13 +226,19 @@ RestartSec=5
 {{- end }}
 TimeoutStopSec={{ .TimeoutStopSec }}
 KillSignal=SIGTERM
+{{- if .StartAtLogin }}
+
+[Install]
+WantedBy=default.target
+{{- end }}
 `))
 
 type unitData struct {
 	*ServiceDefinition
-	ExecStart      string
-	EnvLines       []string
-	TimeoutStopSec int
+	DescriptionText string
+	ExecStart       string
+	EnvLines        []string
+	TimeoutStopSec  int
 }
 
 // renderUnit generates a systemd unit file from a service definition.
@@ -247,6 +259,7 @@ func (b *systemdBackend) renderUnit(def *ServiceDefinition) ([]byte, error) {
 
 	data := unitData{
 		ServiceDefinition: def,
+		DescriptionText:   def.description(),
 		ExecStart:         execStart,
 		EnvLines:          envLines,
 		TimeoutStopSec:    timeout,
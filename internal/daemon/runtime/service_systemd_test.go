@@ -0,0 +1,73 @@
+//go:build linux
+
+package runtime
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderUnit(t *testing.T) {
+	b := &systemdBackend{unitDir: "/tmp/test"}
+
+	def := &ServiceDefinition{
+		ID:               "devnet-testnode.service",
+		NodeID:           "testnode",
+		Command:          []string{"/usr/bin/stabled", "start", "--home", "/data/node0"},
+		WorkingDirectory: "/data/node0",
+		StdoutPath:       "/var/log/testnode.log",
+		StderrPath:       "/var/log/testnode.err",
+		RestartOnFailure: true,
+		GracePeriod:      45 * time.Second,
+	}
+
+	unit, err := b.renderUnit(def)
+	if err != nil {
+		t.Fatalf("renderUnit failed: %v", err)
+	}
+
+	content := string(unit)
+	checks := []string{
+		"Description=Devnet node testnode",
+		"ExecStart=/usr/bin/stabled start --home /data/node0",
+		"StandardOutput=append:/var/log/testnode.log",
+		"Restart=on-failure",
+		"TimeoutStopSec=45",
+	}
+	for _, check := range checks {
+		if !strings.Contains(content, check) {
+			t.Errorf("unit missing expected content: %s", check)
+		}
+	}
+	if strings.Contains(content, "[Install]") {
+		t.Error("node units should not have an [Install] section")
+	}
+}
+
+func TestRenderUnitStartAtLogin(t *testing.T) {
+	b := &systemdBackend{unitDir: "/tmp/test"}
+
+	def := &ServiceDefinition{
+		ID:               "devnet-devnetd.service",
+		NodeID:           "devnetd",
+		Description:      "Devnet Builder daemon",
+		Command:          []string{"/usr/local/bin/devnetd"},
+		WorkingDirectory: "/tmp",
+		StdoutPath:       "/dev/null",
+		StderrPath:       "/dev/null",
+		StartAtLogin:     true,
+	}
+
+	unit, err := b.renderUnit(def)
+	if err != nil {
+		t.Fatalf("renderUnit failed: %v", err)
+	}
+
+	content := string(unit)
+	for _, check := range []string{"Description=Devnet Builder daemon", "[Install]", "WantedBy=default.target"} {
+		if !strings.Contains(content, check) {
+			t.Errorf("unit missing expected content: %s", check)
+		}
+	}
+}
@@ -0,0 +1,829 @@
+// internal/daemon/runtime/ssh.go
+package runtime
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"debug/elf"
+	"debug/macho"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// defaultRemoteDir is where node files are uploaded on hosts without a Dir,
+// relative to the SSH user's home.
+const defaultRemoteDir = ".devnet-builder"
+
+// sshGracePeriod is how long a remote node has to exit after SIGTERM when
+// its network does not say.
+const sshGracePeriod = 30 * time.Second
+
+// sshClient runs shell scripts on remote hosts.
+type sshClient interface {
+	// Run runs script on host with stdin, writing its output to stdout and
+	// stderr. A script that exits non-zero returns an error with an
+	// ExitCode() int method.
+	Run(ctx context.Context, host types.RemoteHost, script string, stdin io.Reader, stdout, stderr io.Writer) error
+
+	// Stream runs script on host and returns its output until it exits or
+	// the reader is closed, which ends it.
+	Stream(ctx context.Context, host types.RemoteHost, script string) (io.ReadCloser, error)
+}
+
+// SSHRuntimeConfig configures the SSH runtime.
+type SSHRuntimeConfig struct {
+	// Local runs the nodes without a remote host.
+	Local NodeRuntime
+
+	// PluginRuntimeProvider provides the start command and environment of
+	// a node's network.
+	PluginRuntimeProvider PluginRuntimeProvider
+
+	// Logger for runtime operations.
+	Logger *slog.Logger
+}
+
+// SSHRuntime runs nodes with a remote host (NodeSpec.Host) on that host
+// over ssh, and every other node on a local runtime, so one daemon drives
+// devnets spread across machines.
+//
+// A remote node's binary and home are uploaded to
+// <host dir>/<namespace>/<devnet>/<node>/ before it starts: the binary when
+// its checksum changed, the whole home on first start and its config
+// directory afterwards, so chain data stays on the host. The node runs as
+// a transient systemd user unit where the host has a user manager, and
+// under nohup otherwise; either way its PID is kept in node.pid and its
+// output in node.log next to the home. Remote nodes keep running when the
+// daemon exits and are adopted again on startup.
+type SSHRuntime struct {
+	local    NodeRuntime
+	provider PluginRuntimeProvider
+	logger   *slog.Logger
+	ssh      sshClient
+
+	nodes map[string]*remoteNode
+	mu    sync.Mutex
+}
+
+// remoteNode is a node on a remote host.
+type remoteNode struct {
+	node        *types.Node
+	host        types.RemoteHost
+	startedAt   time.Time
+	gracePeriod time.Duration
+}
+
+// NewSSHRuntime creates a new SSH runtime.
+func NewSSHRuntime(cfg SSHRuntimeConfig) *SSHRuntime {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SSHRuntime{
+		local:    cfg.Local,
+		provider: cfg.PluginRuntimeProvider,
+		logger:   logger,
+		ssh:      execSSH{},
+		nodes:    make(map[string]*remoteNode),
+	}
+}
+
+// Local returns the runtime of the nodes without a remote host.
+func (r *SSHRuntime) Local() NodeRuntime {
+	return r.local
+}
+
+// Adopt tracks the remote nodes among nodes, which may still be running
+// from an earlier daemon, so their status, logs and stop requests reach
+// their hosts. It returns the number of remote nodes.
+func (r *SSHRuntime) Adopt(nodes []*types.Node) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	adopted := 0
+	for _, node := range nodes {
+		if node.Spec.Host == nil {
+			continue
+		}
+		if _, ok := r.nodes[node.Metadata.Name]; !ok {
+			r.nodes[node.Metadata.Name] = &remoteNode{node: node, host: *node.Spec.Host, gracePeriod: sshGracePeriod}
+		}
+		adopted++
+	}
+	return adopted
+}
+
+// remote returns the remote node with nodeID, or nil if it runs locally.
+func (r *SSHRuntime) remote(nodeID string) *remoteNode {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.nodes[nodeID]
+}
+
+// StartNode uploads a remote node's binary and home to its host and starts
+// it there, stopping an instance left running. Local nodes start on the
+// local runtime.
+func (r *SSHRuntime) StartNode(ctx context.Context, node *types.Node, opts StartOptions) error {
+	if node.Spec.Host == nil {
+		return r.local.StartNode(ctx, node, opts)
+	}
+	host := *node.Spec.Host
+	nodeID := node.Metadata.Name
+
+	pluginRuntime := opts.PluginRuntime
+	if pluginRuntime == nil && r.provider != nil {
+		pluginRuntime = r.provider.GetPluginRuntime(node.Spec.Network)
+	}
+	gracePeriod := sshGracePeriod
+	if pluginRuntime != nil && pluginRuntime.GracePeriod() > 0 {
+		gracePeriod = pluginRuntime.GracePeriod()
+	}
+
+	r.logger.Info("preparing remote node", "nodeID", nodeID, "host", host.Name)
+	dir, err := r.upload(ctx, node)
+	if err != nil {
+		return err
+	}
+
+	// The node as seen on its host
+	remote := *node
+	remote.Spec.HomeDir = dir + "/home"
+	remote.Spec.BinaryPath = dir + "/bin/" + filepath.Base(node.Spec.BinaryPath)
+
+	command := []string{remote.Spec.BinaryPath, "start", "--home", remote.Spec.HomeDir}
+	env := make(map[string]string)
+	if pluginRuntime != nil {
+		command = append([]string{remote.Spec.BinaryPath}, pluginRuntime.StartCommand(&remote)...)
+		for k, v := range pluginRuntime.StartEnv(&remote) {
+			env[k] = v
+		}
+	}
+	for k, v := range node.Spec.Env {
+		env[k] = v
+	}
+	for k, v := range opts.Env {
+		env[k] = v
+	}
+
+	out, err := r.run(ctx, host, startScript(nodeDir(host, node), dir, unitName(node), command, env, gracePeriod), nil)
+	if err != nil {
+		return fmt.Errorf("failed to start node on host %s: %w", host.Name, err)
+	}
+	manager, pid := parseStartOutput(out)
+	if pid == 0 {
+		return fmt.Errorf("failed to start node on host %s: no process started (see %s/node.log)", host.Name, dir)
+	}
+
+	r.mu.Lock()
+	r.nodes[nodeID] = &remoteNode{node: node, host: host, startedAt: time.Now(), gracePeriod: gracePeriod}
+	r.mu.Unlock()
+
+	r.logger.Info("remote node started",
+		"nodeID", nodeID,
+		"host", host.Name,
+		"pid", pid,
+		"manager", manager)
+	return nil
+}
+
+// upload copies a node's binary and home to its host and returns the
+// node's directory there.
+func (r *SSHRuntime) upload(ctx context.Context, node *types.Node) (string, error) {
+	host := *node.Spec.Host
+	word := nodeDir(host, node)
+	binary := filepath.Base(node.Spec.BinaryPath)
+
+	out, err := r.run(ctx, host, prepareScript(word, binary), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to prepare host %s: %w", host.Name, err)
+	}
+	lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+	for len(lines) < 4 {
+		lines = append(lines, "")
+	}
+	dir, uname, initialized, remoteSum := lines[0], lines[1], lines[2] == "initialized", lines[3]
+	if !path.IsAbs(dir) {
+		return "", fmt.Errorf("failed to prepare host %s: unexpected output %q", host.Name, out)
+	}
+
+	sum, err := fileSHA256(node.Spec.BinaryPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read node binary: %w", err)
+	}
+	if sum != remoteSum {
+		if err := checkBinaryPlatform(node.Spec.BinaryPath, host.Name, uname); err != nil {
+			return "", err
+		}
+		f, err := os.Open(node.Spec.BinaryPath)
+		if err != nil {
+			return "", fmt.Errorf("failed to read node binary: %w", err)
+		}
+		defer f.Close()
+
+		r.logger.Info("uploading node binary", "host", host.Name, "binary", node.Spec.BinaryPath)
+		if _, err := r.run(ctx, host, uploadBinaryScript(word, binary), f); err != nil {
+			return "", fmt.Errorf("failed to upload binary to host %s: %w", host.Name, err)
+		}
+	}
+
+	// Chain data lives on the host once the node ran there; later starts
+	// only refresh the config, which the daemon may have changed
+	sub := "."
+	if initialized {
+		sub = "config"
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeTarGz(pw, node.Spec.HomeDir, sub))
+	}()
+	defer pr.Close()
+
+	r.logger.Info("uploading node home", "host", host.Name, "home", node.Spec.HomeDir, "files", sub)
+	if _, err := r.run(ctx, host, uploadHomeScript(word), pr); err != nil {
+		return "", fmt.Errorf("failed to upload home to host %s: %w", host.Name, err)
+	}
+	return dir, nil
+}
+
+// StopNode stops a node, on its host for remote nodes.
+func (r *SSHRuntime) StopNode(ctx context.Context, nodeID string, graceful bool) error {
+	rn := r.remote(nodeID)
+	if rn == nil {
+		return r.local.StopNode(ctx, nodeID, graceful)
+	}
+	timeout := rn.gracePeriod
+	if !graceful {
+		timeout = 0
+	}
+	_, err := r.stopRemote(ctx, rn, timeout)
+	return err
+}
+
+// StopNodeWithin stops a node gracefully, killing it if it has not exited
+// within timeout, and reports whether it had to be killed. Local nodes stop
+// within timeout only if the local runtime supports it.
+func (r *SSHRuntime) StopNodeWithin(ctx context.Context, nodeID string, timeout time.Duration) (bool, error) {
+	rn := r.remote(nodeID)
+	if rn == nil {
+		if stopper, ok := r.local.(TimedNodeStopper); ok {
+			return stopper.StopNodeWithin(ctx, nodeID, timeout)
+		}
+		return false, r.local.StopNode(ctx, nodeID, true)
+	}
+	if timeout < time.Second {
+		timeout = time.Second
+	}
+	return r.stopRemote(ctx, rn, timeout)
+}
+
+// stopRemote stops a remote node with SIGTERM, then SIGKILL after timeout;
+// a zero timeout kills it right away.
+func (r *SSHRuntime) stopRemote(ctx context.Context, rn *remoteNode, timeout time.Duration) (bool, error) {
+	out, err := r.run(ctx, rn.host, stopScript(nodeDir(rn.host, rn.node), timeout), nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to stop node on host %s: %w", rn.host.Name, err)
+	}
+	killed := strings.Contains(out, "killed")
+	r.logger.Info("remote node stopped", "nodeID", rn.node.Metadata.Name, "host", rn.host.Name, "killed", killed)
+	return killed, nil
+}
+
+// RestartNode restarts a node, on its host for remote nodes.
+func (r *SSHRuntime) RestartNode(ctx context.Context, nodeID string) error {
+	rn := r.remote(nodeID)
+	if rn == nil {
+		return r.local.RestartNode(ctx, nodeID)
+	}
+	if err := r.StopNode(ctx, nodeID, true); err != nil {
+		return err
+	}
+	return r.StartNode(ctx, rn.node, StartOptions{RestartPolicy: DefaultRestartPolicy()})
+}
+
+// GetNodeStatus returns a node's status, checking its process on its host
+// for remote nodes.
+func (r *SSHRuntime) GetNodeStatus(ctx context.Context, nodeID string) (*NodeStatus, error) {
+	rn := r.remote(nodeID)
+	if rn == nil {
+		return r.local.GetNodeStatus(ctx, nodeID)
+	}
+	out, err := r.run(ctx, rn.host, statusScript(nodeDir(rn.host, rn.node)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check node on host %s: %w", rn.host.Name, err)
+	}
+	pid, _ := strconv.Atoi(strings.TrimSpace(out))
+	return &NodeStatus{
+		Running:   pid > 0,
+		PID:       pid,
+		StartedAt: rn.startedAt,
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+// GetLogs returns a node's logs, streamed from its host for remote nodes.
+func (r *SSHRuntime) GetLogs(ctx context.Context, nodeID string, opts LogOptions) (io.ReadCloser, error) {
+	rn := r.remote(nodeID)
+	if rn == nil {
+		return r.local.GetLogs(ctx, nodeID, opts)
+	}
+	rc, err := r.ssh.Stream(ctx, rn.host, logsScript(nodeDir(rn.host, rn.node), opts))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read logs on host %s: %w", rn.host.Name, err)
+	}
+	return rc, nil
+}
+
+// ExecInNode runs a command in a node's home directory, on its host for
+// remote nodes.
+func (r *SSHRuntime) ExecInNode(ctx context.Context, nodeID string, command []string, timeout time.Duration) (*ExecResult, error) {
+	rn := r.remote(nodeID)
+	if rn == nil {
+		return r.local.ExecInNode(ctx, nodeID, command, timeout)
+	}
+	if len(command) == 0 {
+		return nil, fmt.Errorf("command is required")
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var stdout, stderr bytes.Buffer
+	err := r.ssh.Run(ctx, rn.host, execScript(nodeDir(rn.host, rn.node), command), nil, &stdout, &stderr)
+	result := &ExecResult{Stdout: stdout.String(), Stderr: stderr.String()}
+	var exitErr interface{ ExitCode() int }
+	if errors.As(err, &exitErr) && exitErr.ExitCode() != sshConnectionFailed {
+		result.ExitCode = exitErr.ExitCode()
+		return result, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to exec on host %s: %w", rn.host.Name, sshError(err, stderr.String()))
+	}
+	return result, nil
+}
+
+// ReloadNodeConfig makes a local node reload changed config keys if the
+// local runtime supports it. Remote nodes are restarted instead, which
+// uploads their changed config.
+func (r *SSHRuntime) ReloadNodeConfig(ctx context.Context, nodeID string, keys []string) (bool, error) {
+	if r.remote(nodeID) != nil {
+		return false, nil
+	}
+	if reloader, ok := r.local.(NodeConfigReloader); ok {
+		return reloader.ReloadNodeConfig(ctx, nodeID, keys)
+	}
+	return false, nil
+}
+
+// Cleanup stops the remote nodes and cleans up the local runtime.
+func (r *SSHRuntime) Cleanup(ctx context.Context) error {
+	r.mu.Lock()
+	nodes := make([]*remoteNode, 0, len(r.nodes))
+	for _, rn := range r.nodes {
+		nodes = append(nodes, rn)
+	}
+	r.nodes = make(map[string]*remoteNode)
+	r.mu.Unlock()
+
+	var errs []error
+	for _, rn := range nodes {
+		if _, err := r.stopRemote(ctx, rn, rn.gracePeriod); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := r.local.Cleanup(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// run runs script on host and returns its output, with ssh's error output
+// in the error.
+func (r *SSHRuntime) run(ctx context.Context, host types.RemoteHost, script string, stdin io.Reader) (string, error) {
+	var stdout, stderr bytes.Buffer
+	if err := r.ssh.Run(ctx, host, script, stdin, &stdout, &stderr); err != nil {
+		return "", sshError(err, stderr.String())
+	}
+	return stdout.String(), nil
+}
+
+// sshConnectionFailed is the exit status of ssh when it cannot connect.
+const sshConnectionFailed = 255
+
+// sshError adds the error output of a failed ssh command to err.
+func sshError(err error, stderr string) error {
+	stderr = strings.TrimSpace(stderr)
+	if stderr == "" {
+		return err
+	}
+	return fmt.Errorf("%w: %s", err, stderr)
+}
+
+// ValidateHosts reports remote hosts that cannot be reached: without a
+// unique name or an address, with an invalid SSH port, or with a relative
+// directory.
+func ValidateHosts(hosts []types.RemoteHost) error {
+	var errs []error
+	names := make(map[string]bool)
+	for i, h := range hosts {
+		name := h.Name
+		if name == "" {
+			errs = append(errs, fmt.Errorf("host %d: name is required", i))
+			name = fmt.Sprintf("hosts[%d]", i)
+		} else if names[name] {
+			errs = append(errs, fmt.Errorf("host %s: duplicate name", name))
+		}
+		names[name] = true
+
+		if h.Address == "" {
+			errs = append(errs, fmt.Errorf("host %s: address is required", name))
+		} else if strings.ContainsAny(h.Address, " @/") || strings.HasPrefix(h.Address, "-") {
+			errs = append(errs, fmt.Errorf("host %s: invalid address %q", name, h.Address))
+		}
+		if strings.ContainsAny(h.User, " @") || strings.HasPrefix(h.User, "-") {
+			errs = append(errs, fmt.Errorf("host %s: invalid user %q", name, h.User))
+		}
+		if h.Port < 0 || h.Port > 65535 {
+			errs = append(errs, fmt.Errorf("host %s: invalid port %d", name, h.Port))
+		}
+		if h.Dir != "" && !path.IsAbs(h.Dir) {
+			errs = append(errs, fmt.Errorf("host %s: dir %q must be absolute", name, h.Dir))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// nodeDir returns the shell word of a node's directory on its host.
+func nodeDir(host types.RemoteHost, node *types.Node) string {
+	base := `"$HOME"/` + defaultRemoteDir
+	if host.Dir != "" {
+		base = shellQuote(host.Dir)
+	}
+	namespace := node.Spec.NamespaceRef
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+	return base + "/" + shellQuote(path.Join(namespace, node.Spec.DevnetRef, node.Metadata.Name))
+}
+
+// unitName returns the systemd unit a remote node runs as.
+func unitName(node *types.Node) string {
+	namespace := node.Spec.NamespaceRef
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+	return "dvb-" + namespace + "-" + node.Metadata.Name
+}
+
+// prepareScript creates a node's directory and prints its absolute path,
+// the host's OS and architecture, whether the node's home was uploaded
+// before, and the checksum of its uploaded binary.
+func prepareScript(dir, binary string) string {
+	return fmt.Sprintf(`set -e
+mkdir -p %[1]s/home %[1]s/bin
+cd %[1]s
+pwd
+uname -sm
+if [ -d home/config ]; then echo initialized; else echo new; fi
+{ sha256sum bin/%[2]s 2>/dev/null || shasum -a 256 bin/%[2]s 2>/dev/null || true; } | cut -d' ' -f1
+`, dir, shellQuote(binary))
+}
+
+// uploadBinaryScript replaces a node's binary with stdin.
+func uploadBinaryScript(dir, binary string) string {
+	return fmt.Sprintf(`set -e
+cd %s/bin
+cat > %[2]s.tmp
+chmod 755 %[2]s.tmp
+mv %[2]s.tmp %[2]s
+`, dir, shellQuote(binary))
+}
+
+// uploadHomeScript unpacks the tar.gz on stdin into a node's home.
+func uploadHomeScript(dir string) string {
+	return fmt.Sprintf("set -e\ntar -xzf - -C %s/home\n", dir)
+}
+
+// stopSnippet stops the process in node.pid with SIGTERM, then SIGKILL
+// after timeout, printing "killed" if it had to be killed.
+func stopSnippet(timeout time.Duration) string {
+	signal := "TERM"
+	if timeout == 0 {
+		signal = "KILL"
+	}
+	return fmt.Sprintf(`if [ -f node.pid ]; then
+  pid=$(cat node.pid)
+  if [ -n "$pid" ] && kill -0 "$pid" 2>/dev/null; then
+    kill -%s "$pid" 2>/dev/null || true
+    i=0
+    while kill -0 "$pid" 2>/dev/null && [ "$i" -lt %d ]; do sleep 1; i=$((i+1)); done
+    if kill -0 "$pid" 2>/dev/null; then kill -KILL "$pid" 2>/dev/null || true; echo killed; fi
+  fi
+  rm -f node.pid
+fi
+`, signal, int(timeout.Seconds()))
+}
+
+// stopScript stops a remote node.
+func stopScript(dir string, timeout time.Duration) string {
+	return fmt.Sprintf("cd %s 2>/dev/null || exit 0\n", dir) + stopSnippet(timeout)
+}
+
+// startScript stops an instance of a node left running, then starts the
+// node as a transient systemd user unit or under nohup, appending its
+// output to node.log. It prints the process manager and the node's PID.
+func startScript(dir, absDir, unit string, command []string, env map[string]string, gracePeriod time.Duration) string {
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	var setenv, assign []string
+	for _, k := range keys {
+		setenv = append(setenv, shellQuote("--setenv="+k+"="+env[k]))
+		assign = append(assign, shellQuote(k+"="+env[k]))
+	}
+	quoted := make([]string, len(command))
+	for i, arg := range command {
+		quoted[i] = shellQuote(arg)
+	}
+	logFile := shellQuote(absDir + "/node.log")
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "set -e\ncd %s\n", dir)
+	b.WriteString(stopSnippet(gracePeriod))
+	fmt.Fprintf(&b, `if command -v systemd-run >/dev/null 2>&1 && systemctl --user show-environment >/dev/null 2>&1; then
+  systemctl --user reset-failed %[1]s >/dev/null 2>&1 || true
+  systemd-run --user --quiet --collect --unit=%[1]s %[2]s sh -c %[3]s %[4]s
+  systemctl --user show -p MainPID --value %[1]s > node.pid
+  echo systemd
+else
+  nohup env %[5]s %[4]s >>%[6]s 2>&1 </dev/null &
+  echo $! > node.pid
+  echo nohup
+fi
+cat node.pid
+`, shellQuote(unit), strings.Join(setenv, " "), shellQuote(`exec "$0" "$@" >>`+logFile+` 2>&1`),
+		strings.Join(quoted, " "), strings.Join(assign, " "), logFile)
+	return b.String()
+}
+
+// parseStartOutput returns the process manager and PID printed by
+// startScript.
+func parseStartOutput(out string) (manager string, pid int) {
+	lines := strings.Fields(out)
+	if len(lines) < 2 {
+		return "", 0
+	}
+	pid, _ = strconv.Atoi(lines[len(lines)-1])
+	return lines[len(lines)-2], pid
+}
+
+// statusScript prints the PID of a remote node if it runs.
+func statusScript(dir string) string {
+	return fmt.Sprintf(`cd %s 2>/dev/null || exit 0
+[ -f node.pid ] || exit 0
+pid=$(cat node.pid)
+if [ -n "$pid" ] && kill -0 "$pid" 2>/dev/null; then echo "$pid"; fi
+`, dir)
+}
+
+// logsScript prints a remote node's log.
+func logsScript(dir string, opts LogOptions) string {
+	lines := "+1"
+	if opts.Lines > 0 {
+		lines = strconv.Itoa(opts.Lines)
+	}
+	follow := ""
+	if opts.Follow {
+		follow = " -F"
+	}
+	return fmt.Sprintf("cd %s\nexec tail -n %s%s node.log\n", dir, lines, follow)
+}
+
+// execScript runs a command in a remote node's home.
+func execScript(dir string, command []string) string {
+	quoted := make([]string, len(command))
+	for i, arg := range command {
+		quoted[i] = shellQuote(arg)
+	}
+	return fmt.Sprintf("cd %s/home\nexec %s\n", dir, strings.Join(quoted, " "))
+}
+
+// shellQuote quotes s as a single POSIX shell word.
+func shellQuote(s string) string {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./:=@,+", r))
+	}) < 0 {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// fileSHA256 returns the hex SHA-256 of a file.
+func fileSHA256(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// checkBinaryPlatform reports a binary built for another platform than the
+// host's uname -sm output. Binaries of unknown format, such as scripts,
+// pass.
+func checkBinaryPlatform(binary, hostName, uname string) error {
+	goos, arch := binaryPlatform(binary)
+	if goos == "" {
+		return nil
+	}
+	hostOS, hostArch, _ := strings.Cut(strings.TrimSpace(uname), " ")
+	if !strings.EqualFold(goos, hostOS) || normalizeArch(arch) != normalizeArch(hostArch) {
+		return fmt.Errorf("binary %s is built for %s %s, but host %s runs %s; build the devnet's binary for the host's platform",
+			binary, goos, arch, hostName, strings.TrimSpace(uname))
+	}
+	return nil
+}
+
+// binaryPlatform returns the OS and architecture of an ELF or Mach-O
+// binary, or empty strings for other files.
+func binaryPlatform(binary string) (goos, arch string) {
+	if f, err := elf.Open(binary); err == nil {
+		defer f.Close()
+		switch f.Machine {
+		case elf.EM_X86_64:
+			return "Linux", "x86_64"
+		case elf.EM_AARCH64:
+			return "Linux", "aarch64"
+		default:
+			return "Linux", f.Machine.String()
+		}
+	}
+	if f, err := macho.Open(binary); err == nil {
+		defer f.Close()
+		switch f.Cpu {
+		case macho.CpuAmd64:
+			return "Darwin", "x86_64"
+		case macho.CpuArm64:
+			return "Darwin", "arm64"
+		default:
+			return "Darwin", f.Cpu.String()
+		}
+	}
+	return "", ""
+}
+
+// normalizeArch maps the names uname uses for one architecture to one.
+func normalizeArch(arch string) string {
+	switch arch {
+	case "arm64", "aarch64":
+		return "arm64"
+	case "x86_64", "amd64":
+		return "x86_64"
+	}
+	return arch
+}
+
+// writeTarGz writes the files under root/sub as a gzipped tar, with paths
+// relative to root.
+func writeTarGz(w io.Writer, root, sub string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	err := filepath.WalkDir(filepath.Join(root, sub), func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil || rel == "." {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			return nil
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, bufio.NewReader(f))
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to archive %s: %w", root, err)
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// execSSH runs scripts with the ssh client, so hosts are reached with the
+// daemon user's ssh configuration, known hosts and agent.
+type execSSH struct{}
+
+// sshArgs returns the ssh arguments that run script on host.
+func sshArgs(host types.RemoteHost, script string) []string {
+	args := []string{
+		"-o", "BatchMode=yes",
+		"-o", "ConnectTimeout=10",
+		"-o", "ServerAliveInterval=15",
+		"-o", "StrictHostKeyChecking=accept-new",
+	}
+	if host.Port != 0 {
+		args = append(args, "-p", strconv.Itoa(host.Port))
+	}
+	if host.IdentityFile != "" {
+		args = append(args, "-i", host.IdentityFile, "-o", "IdentitiesOnly=yes")
+	}
+	target := host.Address
+	if host.User != "" {
+		target = host.User + "@" + target
+	}
+	return append(args, target, "sh -c "+shellQuote(script))
+}
+
+func (execSSH) Run(ctx context.Context, host types.RemoteHost, script string, stdin io.Reader, stdout, stderr io.Writer) error {
+	cmd := exec.CommandContext(ctx, "ssh", sshArgs(host, script)...)
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	return cmd.Run()
+}
+
+func (execSSH) Stream(ctx context.Context, host types.RemoteHost, script string) (io.ReadCloser, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	cmd := exec.CommandContext(ctx, "ssh", sshArgs(host, script)...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		cancel()
+		return nil, err
+	}
+	return &sshStream{ReadCloser: stdout, cmd: cmd, cancel: cancel}, nil
+}
+
+// sshStream is the output of a running ssh command; closing it ends the
+// command.
+type sshStream struct {
+	io.ReadCloser
+	cmd    *exec.Cmd
+	cancel context.CancelFunc
+}
+
+func (s *sshStream) Close() error {
+	s.cancel()
+	_ = s.cmd.Wait()
+	return nil
+}
+
+// Ensure SSHRuntime implements NodeRuntime and the optional interfaces the
+// local runtimes do.
+var (
+	_ NodeRuntime        = (*SSHRuntime)(nil)
+	_ TimedNodeStopper   = (*SSHRuntime)(nil)
+	_ NodeConfigReloader = (*SSHRuntime)(nil)
+)
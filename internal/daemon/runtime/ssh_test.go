@@ -0,0 +1,126 @@
+package runtime
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShellQuote(t *testing.T) {
+	for in, want := range map[string]string{
+		"start":            "start",
+		"/usr/bin/stabled": "/usr/bin/stabled",
+		"--home=/root/.x":  "--home=/root/.x",
+		"":                 "''",
+		"a b":              "'a b'",
+		"it's":             `'it'\''s'`,
+		"$HOME":            "'$HOME'",
+		`{"key": "value"}`: `'{"key": "value"}'`,
+		"x; rm -rf /":      "'x; rm -rf /'",
+	} {
+		assert.Equal(t, want, shellQuote(in), in)
+	}
+}
+
+func TestValidateHosts(t *testing.T) {
+	assert.NoError(t, ValidateHosts(nil))
+	assert.NoError(t, ValidateHosts([]types.RemoteHost{
+		{Name: "a", Address: "10.0.0.1"},
+		{Name: "b", Address: "node-b.example.com", User: "ubuntu", Port: 2222, Dir: "/srv/devnets"},
+	}))
+
+	err := ValidateHosts([]types.RemoteHost{
+		{Address: "10.0.0.1"},
+		{Name: "a", Address: "10.0.0.2"},
+		{Name: "a", Address: "-oProxyCommand=x"},
+		{Name: "b", User: "root@x", Port: 70000, Dir: "devnets"},
+	})
+	require.Error(t, err)
+	for _, want := range []string{
+		"host 0: name is required",
+		"host a: duplicate name",
+		`host a: invalid address "-oProxyCommand=x"`,
+		"host b: address is required",
+		`host b: invalid user "root@x"`,
+		"host b: invalid port 70000",
+		`host b: dir "devnets" must be absolute`,
+	} {
+		assert.Contains(t, err.Error(), want)
+	}
+}
+
+func TestSSHArgs(t *testing.T) {
+	args := sshArgs(types.RemoteHost{Name: "a", Address: "10.0.0.1", User: "ubuntu", Port: 2222, IdentityFile: "/keys/id"}, "echo 'hi'")
+	assert.Equal(t, []string{
+		"-o", "BatchMode=yes",
+		"-o", "ConnectTimeout=10",
+		"-o", "ServerAliveInterval=15",
+		"-o", "StrictHostKeyChecking=accept-new",
+		"-p", "2222",
+		"-i", "/keys/id", "-o", "IdentitiesOnly=yes",
+		"ubuntu@10.0.0.1",
+		`sh -c 'echo '\''hi'\'''`,
+	}, args)
+}
+
+func TestNodeDir(t *testing.T) {
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: "mydevnet-node-0"},
+		Spec:     types.NodeSpec{DevnetRef: "mydevnet"},
+	}
+	assert.Equal(t, `"$HOME"/.devnet-builder/default/mydevnet/mydevnet-node-0`, nodeDir(types.RemoteHost{}, node))
+	assert.Equal(t, `'/srv/my devnets'/default/mydevnet/mydevnet-node-0`, nodeDir(types.RemoteHost{Dir: "/srv/my devnets"}, node))
+}
+
+func TestCheckBinaryPlatform(t *testing.T) {
+	self, err := os.Executable()
+	require.NoError(t, err)
+
+	goos, arch := binaryPlatform(self)
+	if goos == "" {
+		t.Skipf("test binary format not recognized on %s", goruntime.GOOS)
+	}
+	assert.NoError(t, checkBinaryPlatform(self, "a", goos+" "+arch))
+	assert.ErrorContains(t, checkBinaryPlatform(self, "a", "Plan9 mips"), "host a runs Plan9 mips")
+
+	script := filepath.Join(t.TempDir(), "node.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\n"), 0755))
+	assert.NoError(t, checkBinaryPlatform(script, "a", "Plan9 mips"))
+}
+
+func TestWriteTarGz(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "config"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "data"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "config", "config.toml"), []byte("moniker = \"a\"\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "data", "state.db"), []byte("state"), 0644))
+
+	names := func(sub string) []string {
+		var buf bytes.Buffer
+		require.NoError(t, writeTarGz(&buf, root, sub))
+		gz, err := gzip.NewReader(&buf)
+		require.NoError(t, err)
+		tr := tar.NewReader(gz)
+		var names []string
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				return names
+			}
+			require.NoError(t, err)
+			names = append(names, hdr.Name)
+		}
+	}
+
+	assert.Equal(t, []string{"config", "config/config.toml", "data", "data/state.db"}, names("."))
+	assert.Equal(t, []string{"config", "config/config.toml"}, names("config"))
+}
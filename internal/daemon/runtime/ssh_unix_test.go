@@ -0,0 +1,187 @@
+//go:build !windows
+
+// internal/daemon/runtime/ssh_unix_test.go
+package runtime
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// localSSH runs scripts with the local shell, as if on a host without a
+// systemd user manager.
+type localSSH struct {
+	env []string
+}
+
+func newLocalSSH(t *testing.T) *localSSH {
+	// systemctl fails, so nodes run under nohup
+	stubs := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(stubs, "systemctl"), []byte("#!/bin/sh\nexit 1\n"), 0755))
+	return &localSSH{env: append(os.Environ(), "PATH="+stubs+":"+os.Getenv("PATH"))}
+}
+
+func (l *localSSH) command(ctx context.Context, script string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, "sh", "-c", script)
+	cmd.Env = l.env
+	return cmd
+}
+
+func (l *localSSH) Run(ctx context.Context, host types.RemoteHost, script string, stdin io.Reader, stdout, stderr io.Writer) error {
+	cmd := l.command(ctx, script)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = stdin, stdout, stderr
+	return cmd.Run()
+}
+
+func (l *localSSH) Stream(ctx context.Context, host types.RemoteHost, script string) (io.ReadCloser, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	cmd := l.command(ctx, script)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		cancel()
+		return nil, err
+	}
+	return &sshStream{ReadCloser: stdout, cmd: cmd, cancel: cancel}, nil
+}
+
+// localRuntime records the nodes started on it.
+type localRuntime struct {
+	NodeRuntime
+	started []string
+	stopped []string
+}
+
+func (l *localRuntime) StartNode(_ context.Context, node *types.Node, _ StartOptions) error {
+	l.started = append(l.started, node.Metadata.Name)
+	return nil
+}
+
+func (l *localRuntime) StopNode(_ context.Context, nodeID string, _ bool) error {
+	l.stopped = append(l.stopped, nodeID)
+	return nil
+}
+
+func TestSSHRuntime_RemoteNode(t *testing.T) {
+	ctx := context.Background()
+	remoteDir := t.TempDir()
+
+	// A node binary that logs its arguments and runs until stopped
+	binary := filepath.Join(t.TempDir(), "stabled")
+	require.NoError(t, os.WriteFile(binary, []byte("#!/bin/sh\necho \"started $* NODE_ENV=$NODE_ENV\"\nexec sleep 60\n"), 0755))
+
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "config.toml"), []byte("v1"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "data", "state"), []byte("genesis"), 0644))
+
+	local := &localRuntime{}
+	rt := NewSSHRuntime(SSHRuntimeConfig{Local: local, Logger: testLogger()})
+	rt.ssh = newLocalSSH(t)
+
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: "mydevnet-node-0"},
+		Spec: types.NodeSpec{
+			DevnetRef:  "mydevnet",
+			BinaryPath: binary,
+			HomeDir:    home,
+			Env:        map[string]string{"NODE_ENV": "remote value"},
+			Host:       &types.RemoteHost{Name: "host-a", Address: "10.0.0.1", Dir: remoteDir},
+		},
+	}
+	nodeDir := filepath.Join(remoteDir, "default", "mydevnet", "mydevnet-node-0")
+
+	require.NoError(t, rt.StartNode(ctx, node, StartOptions{}))
+	t.Cleanup(func() { _ = rt.StopNode(ctx, node.Metadata.Name, false) })
+	assert.Empty(t, local.started)
+
+	// The binary and home were uploaded
+	uploaded, err := os.ReadFile(filepath.Join(nodeDir, "bin", "stabled"))
+	require.NoError(t, err)
+	assert.Contains(t, string(uploaded), "exec sleep 60")
+	got, err := os.ReadFile(filepath.Join(nodeDir, "home", "config", "config.toml"))
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(got))
+
+	status, err := rt.GetNodeStatus(ctx, node.Metadata.Name)
+	require.NoError(t, err)
+	assert.True(t, status.Running)
+	assert.Positive(t, status.PID)
+
+	// The node runs its uploaded binary on its uploaded home
+	require.Eventually(t, func() bool {
+		logs, err := os.ReadFile(filepath.Join(nodeDir, "node.log"))
+		return err == nil && strings.Contains(string(logs), "started start --home "+nodeDir+"/home NODE_ENV=remote value")
+	}, 5*time.Second, 50*time.Millisecond)
+
+	rc, err := rt.GetLogs(ctx, node.Metadata.Name, LogOptions{Lines: 10})
+	require.NoError(t, err)
+	logs, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+	assert.Contains(t, string(logs), "started start")
+
+	result, err := rt.ExecInNode(ctx, node.Metadata.Name, []string{"sh", "-c", "pwd; exit 3"}, 5*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.ExitCode)
+	assert.Equal(t, filepath.Join(nodeDir, "home"), strings.TrimSpace(result.Stdout))
+
+	killed, err := rt.StopNodeWithin(ctx, node.Metadata.Name, 5*time.Second)
+	require.NoError(t, err)
+	assert.False(t, killed)
+	status, err = rt.GetNodeStatus(ctx, node.Metadata.Name)
+	require.NoError(t, err)
+	assert.False(t, status.Running)
+
+	// Later starts refresh the config but keep the chain data on the host
+	require.NoError(t, os.WriteFile(filepath.Join(nodeDir, "home", "data", "state"), []byte("height 42"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "config.toml"), []byte("v2"), 0644))
+	require.NoError(t, rt.StartNode(ctx, node, StartOptions{}))
+
+	got, err = os.ReadFile(filepath.Join(nodeDir, "home", "config", "config.toml"))
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(got))
+	got, err = os.ReadFile(filepath.Join(nodeDir, "home", "data", "state"))
+	require.NoError(t, err)
+	assert.Equal(t, "height 42", string(got))
+
+	// A new runtime adopts the running node
+	adopted := NewSSHRuntime(SSHRuntimeConfig{Local: local, Logger: testLogger()})
+	adopted.ssh = rt.ssh
+	assert.Equal(t, 1, adopted.Adopt([]*types.Node{node, {Metadata: types.ResourceMeta{Name: "local-node"}}}))
+	status, err = adopted.GetNodeStatus(ctx, node.Metadata.Name)
+	require.NoError(t, err)
+	assert.True(t, status.Running)
+
+	require.NoError(t, adopted.StopNode(ctx, node.Metadata.Name, false))
+	status, err = rt.GetNodeStatus(ctx, node.Metadata.Name)
+	require.NoError(t, err)
+	assert.False(t, status.Running)
+}
+
+func TestSSHRuntime_LocalNode(t *testing.T) {
+	local := &localRuntime{}
+	rt := NewSSHRuntime(SSHRuntimeConfig{Local: local, Logger: testLogger()})
+
+	node := &types.Node{Metadata: types.ResourceMeta{Name: "mydevnet-node-0"}}
+	require.NoError(t, rt.StartNode(context.Background(), node, StartOptions{}))
+	require.NoError(t, rt.StopNode(context.Background(), node.Metadata.Name, true))
+
+	assert.Equal(t, []string{"mydevnet-node-0"}, local.started)
+	assert.Equal(t, []string{"mydevnet-node-0"}, local.stopped)
+	assert.Same(t, NodeRuntime(local), rt.Local())
+}
@@ -24,6 +24,9 @@ type supervisorConfig struct {
 	gracePeriod time.Duration
 	logger      *slog.Logger
 
+	// reloader is set when the node reloads config keys on a signal
+	reloader ConfigReloader
+
 	// detachOnShutdown indicates the supervisor should detach (not kill process)
 	// when stop() is called. Used for graceful devnetd shutdown where processes
 	// should persist as orphans.
@@ -145,6 +148,7 @@ func (s *supervisor) startAndWait(ctx context.Context) (int, error) {
 	// to avoid SIGKILL race condition (CommandContext sends SIGKILL on context cancellation)
 	cmd := exec.Command(s.config.command[0], s.config.command[1:]...)
 	cmd.Dir = s.config.workDir
+	configureProcess(cmd)
 
 	// Set environment
 	cmd.Env = os.Environ()
@@ -218,7 +222,7 @@ func (s *supervisor) stopProcess() {
 	s.mu.Unlock()
 
 	// Send graceful signal
-	_ = process.Signal(stopSignal)
+	_ = signalProcess(process.Pid, stopSignal)
 
 	// Start a goroutine to force kill after grace period if process hasn't exited
 	// The actual wait is handled by startAndWait(), this just ensures we escalate to SIGKILL
@@ -235,9 +239,65 @@ func (s *supervisor) stop() {
 	s.stopOnce.Do(func() {
 		close(s.stopCh)
 	})
+	// The run loop only sees stopCh between processes: signal the running
+	// one, or stop would wait for it to exit on its own
+	s.maybeStopProcess()
 	<-s.stoppedCh
 }
 
+// stopWithin stops the process with its stop signal and, if it has not
+// exited within timeout, with SIGKILL. It waits for the process to exit and
+// reports whether it had to be killed. Unlike stop, it also stops
+// reconnected processes, which are otherwise left running.
+func (s *supervisor) stopWithin(timeout time.Duration) bool {
+	s.mu.RLock()
+	pid, running := s.pid, s.running
+	stopSignal := s.config.stopSignal
+	s.mu.RUnlock()
+	if stopSignal == 0 {
+		stopSignal = syscall.SIGTERM
+	}
+
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	if !running || pid == 0 {
+		<-s.stoppedCh
+		return false
+	}
+
+	_ = signalProcess(pid, stopSignal)
+	if s.waitExited(pid, timeout) {
+		return false
+	}
+	_ = signalProcess(pid, syscall.SIGKILL)
+	s.waitExited(pid, killWait)
+	return true
+}
+
+// killWait is how long stopWithin waits for a process to exit after SIGKILL.
+const killWait = 5 * time.Second
+
+// waitExited waits up to timeout for the supervisor to stop and the process
+// to exit. A reconnected process is not a child of the daemon, so its
+// supervisor stops without waiting for it.
+func (s *supervisor) waitExited(pid int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		select {
+		case <-s.stoppedCh:
+			if !processAlive(pid) {
+				return true
+			}
+		default:
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
 // forceStop immediately kills the process with SIGKILL
 func (s *supervisor) forceStop() {
 	s.mu.Lock()
@@ -289,6 +349,18 @@ func (s *supervisor) status() NodeStatus {
 	}
 }
 
+// signal sends sig to the running process
+func (s *supervisor) signal(sig syscall.Signal) error {
+	s.mu.RLock()
+	pid, running := s.pid, s.running
+	s.mu.RUnlock()
+
+	if !running || pid == 0 {
+		return fmt.Errorf("process is not running")
+	}
+	return signalProcess(pid, sig)
+}
+
 // shouldRestart determines if the process should be restarted
 func (s *supervisor) shouldRestart(exitCode int) bool {
 	switch s.policy.Policy {
@@ -371,20 +443,13 @@ func (s *supervisor) runMonitor(ctx context.Context) {
 
 			if !detach && pid > 0 {
 				// Send SIGTERM to the process
-				if proc, err := os.FindProcess(pid); err == nil {
-					_ = proc.Signal(syscall.SIGTERM)
-				}
+				_ = signalProcess(pid, syscall.SIGTERM)
 			}
 			return
 		case <-ticker.C:
-			// Check if process is still alive using signal 0
-			proc, err := os.FindProcess(s.pid)
-			if err != nil {
-				s.setProcessDead("process not found: " + err.Error())
-				return
-			}
-			if err := proc.Signal(syscall.Signal(0)); err != nil {
-				s.setProcessDead("process exited: " + err.Error())
+			// Check if process is still alive
+			if !processAlive(s.pid) {
+				s.setProcessDead("process exited")
 				return
 			}
 		}
@@ -3,8 +3,24 @@ package ante
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/bootstrap"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/configpatch"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/genesispatch"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/hooks"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/netem"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/nodekeys"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/notify"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/portalloc"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/signer"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
 )
 
 const (
@@ -17,6 +33,10 @@ const (
 	// Limited to 10 to prevent excessive resource consumption on development
 	// machines while allowing meaningful network topology testing.
 	MaxFullNodes = 10
+
+	// MaxAccounts is the maximum number of deterministic test accounts
+	// funded in genesis.
+	MaxAccounts = 100
 )
 
 // SpecValidator validates business rules and constraints.
@@ -65,6 +85,15 @@ func (v *specValidator) ValidateDevnetSpec(ctx context.Context, spec *v1.DevnetS
 		})
 	}
 
+	// Accounts count
+	if spec.Accounts < 0 || spec.Accounts > MaxAccounts {
+		errs = append(errs, &ValidationError{
+			Field:   "spec.accounts",
+			Code:    CodeInvalidRange,
+			Message: "accounts must be between 0 and 100",
+		})
+	}
+
 	// NetworkType validation
 	if spec.NetworkType != "" && spec.NetworkType != "mainnet" && spec.NetworkType != "testnet" {
 		errs = append(errs, &ValidationError{
@@ -74,9 +103,347 @@ func (v *specValidator) ValidateDevnetSpec(ctx context.Context, spec *v1.DevnetS
 		})
 	}
 
+	// Profile validation
+	if spec.Profile != "" {
+		if _, ok := types.LookupProfile(spec.Profile); !ok {
+			errs = append(errs, &ValidationError{
+				Field:   "spec.profile",
+				Code:    CodeInvalidValue,
+				Message: fmt.Sprintf("unknown profile %q (available: %s)", spec.Profile, strings.Join(types.ProfileNames(), ", ")),
+			})
+		}
+	}
+
+	// TTL must be a positive duration
+	if spec.Ttl != "" {
+		if d, err := time.ParseDuration(spec.Ttl); err != nil || d <= 0 {
+			errs = append(errs, &ValidationError{
+				Field:   "spec.ttl",
+				Code:    CodeInvalidValue,
+				Message: fmt.Sprintf("ttl %q must be a positive duration, e.g. 4h", spec.Ttl),
+			})
+		}
+	}
+
+	// Idle timeout must be a positive duration
+	if spec.IdleTimeout != "" {
+		if d, err := time.ParseDuration(spec.IdleTimeout); err != nil || d <= 0 {
+			errs = append(errs, &ValidationError{
+				Field:   "spec.idle_timeout",
+				Code:    CodeInvalidValue,
+				Message: fmt.Sprintf("idle_timeout %q must be a positive duration, e.g. 30m", spec.IdleTimeout),
+			})
+		}
+	}
+
+	// Block time and epoch duration must be positive durations
+	if spec.BlockTime != "" {
+		if d, err := time.ParseDuration(spec.BlockTime); err != nil || d <= 0 {
+			errs = append(errs, &ValidationError{
+				Field:   "spec.block_time",
+				Code:    CodeInvalidValue,
+				Message: fmt.Sprintf("block_time %q must be a positive duration, e.g. 500ms", spec.BlockTime),
+			})
+		}
+	}
+	if spec.EpochDuration != "" {
+		if d, err := time.ParseDuration(spec.EpochDuration); err != nil || d <= 0 {
+			errs = append(errs, &ValidationError{
+				Field:   "spec.epoch_duration",
+				Code:    CodeInvalidValue,
+				Message: fmt.Sprintf("epoch_duration %q must be a positive duration, e.g. 1m", spec.EpochDuration),
+			})
+		}
+	}
+
+	// Port layout must keep every node's ports in range and distinct
+	if p := spec.Ports; p != nil {
+		layout := dvbtypes.PortLayout{
+			P2P:    int(p.P2P),
+			RPC:    int(p.Rpc),
+			API:    int(p.Rest),
+			GRPC:   int(p.Grpc),
+			EVMRPC: int(p.EvmRpc),
+			Stride: int(p.Stride),
+		}
+		if (spec.Mode == "docker" || spec.BindAddress != "" || len(spec.Hosts) > 0) && layout.Stride == 0 {
+			layout.Stride = portalloc.BlockSize
+		}
+		if err := layout.Validate(int(spec.Validators + spec.FullNodes)); err != nil {
+			errs = append(errs, &ValidationError{
+				Field:   "spec.ports",
+				Code:    CodeInvalidValue,
+				Message: err.Error(),
+			})
+		}
+	}
+
+	// Bind address must be a literal IP; nodes can't listen on a hostname
+	if spec.BindAddress != "" && net.ParseIP(spec.BindAddress) == nil {
+		errs = append(errs, &ValidationError{
+			Field:   "spec.bind_address",
+			Code:    CodeInvalidValue,
+			Message: fmt.Sprintf("invalid bind address %q: must be an IPv4 or IPv6 address", spec.BindAddress),
+		})
+	}
+
+	// Genesis overrides must have valid paths and JSON values
+	if err := genesispatch.Validate(spec.GenesisOverrides); err != nil {
+		errs = append(errs, &ValidationError{
+			Field:   "spec.genesis_overrides",
+			Code:    CodeInvalidValue,
+			Message: strings.ReplaceAll(err.Error(), "\n", "; "),
+		})
+	}
+
+	// Funded accounts must have valid addresses, coins and vesting
+	if err := genesispatch.ValidateAccounts(fundedAccountsFromProto(spec.FundedAccounts)); err != nil {
+		errs = append(errs, &ValidationError{
+			Field:   "spec.funded_accounts",
+			Code:    CodeInvalidValue,
+			Message: strings.ReplaceAll(err.Error(), "\n", "; "),
+		})
+	}
+
+	// A dust threshold must be a single coin
+	if prune := spec.GenesisPrune; prune != nil && prune.DustThreshold != "" {
+		if _, err := genesispatch.TrimDustAccounts(prune.DustThreshold); err != nil {
+			errs = append(errs, &ValidationError{
+				Field:   "spec.genesis_prune.dust_threshold",
+				Code:    CodeInvalidValue,
+				Message: err.Error(),
+			})
+		}
+	}
+
+	// A voting power profile must be known, with one weight per validator
+	// for custom
+	if vp := spec.VotingPower; vp != nil {
+		if err := genesispatch.ValidatePower(vp.Profile, vp.Weights, int(spec.Validators)); err != nil {
+			errs = append(errs, &ValidationError{
+				Field:   "spec.voting_power",
+				Code:    CodeInvalidValue,
+				Message: err.Error(),
+			})
+		}
+	}
+
+	// Imported validator keys must be valid key files for existing slots
+	if err := nodekeys.Validate(validatorKeysFromProto(spec.ValidatorKeys), int(spec.Validators)); err != nil {
+		errs = append(errs, &ValidationError{
+			Field:   "spec.validator_keys",
+			Code:    CodeInvalidValue,
+			Message: strings.ReplaceAll(err.Error(), "\n", "; "),
+		})
+	}
+
+	// Remote signers must name existing slots
+	if err := signer.Validate(remoteSignersFromProto(spec.RemoteSigners), int(spec.Validators)); err != nil {
+		errs = append(errs, &ValidationError{
+			Field:   "spec.remote_signers",
+			Code:    CodeInvalidValue,
+			Message: strings.ReplaceAll(err.Error(), "\n", "; "),
+		})
+	}
+
+	// Node config snippets must name existing nodes and parse as TOML
+	nodes := int(spec.Validators + spec.FullNodes)
+	if err := configpatch.ValidateNodeConfigs(nodeConfigsFromProto(spec.NodeConfigs), nodes); err != nil {
+		errs = append(errs, &ValidationError{
+			Field:   "spec.node_configs",
+			Code:    CodeInvalidValue,
+			Message: strings.ReplaceAll(err.Error(), "\n", "; "),
+		})
+	}
+
+	// Webhooks must be http(s) URLs with known formats, events and
+	// parseable templates
+	if err := notify.Validate(webhooksFromProto(spec.Webhooks)); err != nil {
+		errs = append(errs, &ValidationError{
+			Field:   "spec.webhooks",
+			Code:    CodeInvalidValue,
+			Message: strings.ReplaceAll(err.Error(), "\n", "; "),
+		})
+	}
+
+	// Hooks need one command or script, and valid timeouts and policies
+	if err := hooks.Validate(hooksFromProto(spec.Hooks)); err != nil {
+		errs = append(errs, &ValidationError{
+			Field:   "spec.hooks",
+			Code:    CodeInvalidValue,
+			Message: strings.ReplaceAll(err.Error(), "\n", "; "),
+		})
+	}
+
+	// Bootstrap contracts need unique names, sources and parameters of
+	// their type
+	if err := bootstrap.Validate(bootstrapFromProto(spec.Bootstrap)); err != nil {
+		errs = append(errs, &ValidationError{
+			Field:   "spec.bootstrap",
+			Code:    CodeInvalidValue,
+			Message: strings.ReplaceAll(err.Error(), "\n", "; "),
+		})
+	}
+
+	// Remote hosts need unique names and reachable addresses, and run
+	// nodes as processes, not containers
+	if len(spec.Hosts) > 0 {
+		if err := runtime.ValidateHosts(remoteHostsFromProto(spec.Hosts)); err != nil {
+			errs = append(errs, &ValidationError{
+				Field:   "spec.hosts",
+				Code:    CodeInvalidValue,
+				Message: strings.ReplaceAll(err.Error(), "\n", "; "),
+			})
+		}
+		if spec.Mode == "docker" {
+			errs = append(errs, &ValidationError{
+				Field:   "spec.hosts",
+				Code:    CodeInvalidValue,
+				Message: "hosts require mode 'local'; docker nodes run on the daemon's host",
+			})
+		}
+		if len(spec.RemoteSigners) > 0 {
+			errs = append(errs, &ValidationError{
+				Field:   "spec.hosts",
+				Code:    CodeInvalidValue,
+				Message: "hosts cannot be combined with remote_signers",
+			})
+		}
+	}
+
+	// Network shaping needs regions of existing nodes and valid links, and
+	// runs in the nodes' container network namespaces
+	if ns := spec.NetworkShaping; ns != nil {
+		if err := netem.Validate(networkShapingFromProto(ns), int(spec.Validators+spec.FullNodes)); err != nil {
+			errs = append(errs, &ValidationError{
+				Field:   "spec.network_shaping",
+				Code:    CodeInvalidValue,
+				Message: strings.ReplaceAll(err.Error(), "\n", "; "),
+			})
+		}
+		if len(ns.Links) > 0 && spec.Mode != "docker" {
+			errs = append(errs, &ValidationError{
+				Field:   "spec.network_shaping",
+				Code:    CodeInvalidValue,
+				Message: "network shaping requires mode 'docker'",
+			})
+		}
+	}
+
 	return toError(errs)
 }
 
+// networkShapingFromProto converts network shaping for validation.
+func networkShapingFromProto(pb *v1.NetworkShaping) types.NetworkShaping {
+	n := types.NetworkShaping{Regions: make(map[int]string, len(pb.Regions)), Image: pb.Image}
+	for index, region := range pb.Regions {
+		n.Regions[int(index)] = region
+	}
+	for _, l := range pb.Links {
+		n.Links = append(n.Links, types.NetworkLink{From: l.From, To: l.To, Latency: l.Latency, Jitter: l.Jitter, Loss: l.Loss, Rate: l.Rate})
+	}
+	return n
+}
+
+// remoteHostsFromProto converts remote hosts for validation.
+func remoteHostsFromProto(pbs []*v1.RemoteHost) []types.RemoteHost {
+	hosts := make([]types.RemoteHost, 0, len(pbs))
+	for _, pb := range pbs {
+		hosts = append(hosts, types.RemoteHost{Name: pb.Name, Address: pb.Address, User: pb.User, Port: int(pb.Port), IdentityFile: pb.IdentityFile, Dir: pb.Dir})
+	}
+	return hosts
+}
+
+// hooksFromProto converts lifecycle hooks for validation.
+func hooksFromProto(pb *v1.Hooks) types.Hooks {
+	list := func(pbs []*v1.Hook) []types.Hook {
+		hooks := make([]types.Hook, 0, len(pbs))
+		for _, pb := range pbs {
+			hooks = append(hooks, types.Hook{Name: pb.Name, Command: pb.Command, Script: pb.Script, Image: pb.Image, Timeout: pb.Timeout, OnFailure: pb.OnFailure})
+		}
+		return hooks
+	}
+	return types.Hooks{PostFork: list(pb.GetPostFork()), PreStart: list(pb.GetPreStart()), PostHealthy: list(pb.GetPostHealthy())}
+}
+
+// bootstrapFromProto converts bootstrap contracts for validation.
+func bootstrapFromProto(pb *v1.Bootstrap) types.Bootstrap {
+	contracts := make([]types.Contract, 0, len(pb.GetContracts()))
+	for _, c := range pb.GetContracts() {
+		contracts = append(contracts, types.Contract{Name: c.Name, Type: c.Type, Source: c.Source, InitMsg: c.InitMsg, Args: c.Args, Label: c.Label, Admin: c.Admin, Funds: c.Funds})
+	}
+	return types.Bootstrap{GasPrice: pb.GetGasPrice(), Contracts: contracts}
+}
+
+// webhooksFromProto converts webhooks for validation.
+func webhooksFromProto(pbs []*v1.Webhook) []types.Webhook {
+	webhooks := make([]types.Webhook, 0, len(pbs))
+	for _, pb := range pbs {
+		webhooks = append(webhooks, types.Webhook{URL: pb.Url, Format: pb.Format, Events: pb.Events, Template: pb.Template})
+	}
+	return webhooks
+}
+
+// nodeConfigsFromProto converts node config snippets for validation.
+func nodeConfigsFromProto(pbs []*v1.NodeConfig) []types.NodeConfig {
+	configs := make([]types.NodeConfig, 0, len(pbs))
+	for _, pb := range pbs {
+		configs = append(configs, types.NodeConfig{
+			Index:      int(pb.Index),
+			ConfigToml: pb.ConfigToml,
+			AppToml:    pb.AppToml,
+		})
+	}
+	return configs
+}
+
+// remoteSignersFromProto converts remote signers for signer validation.
+func remoteSignersFromProto(pbs []*v1.RemoteSigner) []types.RemoteSigner {
+	signers := make([]types.RemoteSigner, 0, len(pbs))
+	for _, pb := range pbs {
+		signers = append(signers, types.RemoteSigner{
+			Index:           int(pb.Index),
+			TMKMS:           pb.Tmkms,
+			Image:           pb.Image,
+			ProtocolVersion: pb.ProtocolVersion,
+		})
+	}
+	return signers
+}
+
+// validatorKeysFromProto converts imported validator keys for nodekeys
+// validation.
+func validatorKeysFromProto(pbs []*v1.ValidatorKeys) []nodekeys.Keys {
+	keys := make([]nodekeys.Keys, 0, len(pbs))
+	for _, pb := range pbs {
+		keys = append(keys, nodekeys.Keys{
+			Index:            int(pb.Index),
+			PrivValidatorKey: pb.PrivValidatorKey,
+			NodeKey:          pb.NodeKey,
+		})
+	}
+	return keys
+}
+
+// fundedAccountsFromProto converts funded accounts for genesispatch validation.
+func fundedAccountsFromProto(pbs []*v1.FundedAccount) []genesispatch.Account {
+	accounts := make([]genesispatch.Account, 0, len(pbs))
+	for _, pb := range pbs {
+		acc := genesispatch.Account{Address: pb.Address, Coins: pb.Coins}
+		if v := pb.Vesting; v != nil {
+			acc.Vesting = &genesispatch.Vesting{Type: v.Type, Coins: v.Coins}
+			if v.StartTime != nil {
+				acc.Vesting.Start = v.StartTime.AsTime()
+			}
+			if v.EndTime != nil {
+				acc.Vesting.End = v.EndTime.AsTime()
+			}
+		}
+		accounts = append(accounts, acc)
+	}
+	return accounts
+}
+
 func (v *specValidator) ValidateUpgradeSpec(ctx context.Context, spec *v1.UpgradeSpec) error {
 	if spec == nil {
 		return nil
@@ -92,5 +459,23 @@ func (v *specValidator) ValidateUpgradeSpec(ctx context.Context, spec *v1.Upgrad
 		})
 	}
 
+	switch spec.Mode {
+	case "":
+	case types.UpgradeModeForkTest:
+		if spec.TargetHeight <= 0 {
+			errs = append(errs, &ValidationError{
+				Field:   "spec.target_height",
+				Code:    CodeRequired,
+				Message: "target_height is required for fork-test upgrades",
+			})
+		}
+	default:
+		errs = append(errs, &ValidationError{
+			Field:   "spec.mode",
+			Code:    CodeInvalidValue,
+			Message: fmt.Sprintf("unknown upgrade mode %q (must be empty or %q)", spec.Mode, types.UpgradeModeForkTest),
+		})
+	}
+
 	return toError(errs)
 }
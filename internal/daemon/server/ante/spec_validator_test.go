@@ -46,6 +46,152 @@ func TestSpecValidator_DevnetSpec(t *testing.T) {
 			wantErr: true,
 			field:   "spec.full_nodes",
 		},
+		{
+			name:    "known profile",
+			spec:    &v1.DevnetSpec{Plugin: "stable", Mode: "docker", Validators: 4, Profile: "laptop"},
+			wantErr: false,
+		},
+		{
+			name:    "unknown profile",
+			spec:    &v1.DevnetSpec{Plugin: "stable", Mode: "docker", Profile: "potato"},
+			wantErr: true,
+			field:   "spec.profile",
+		},
+		{
+			name:    "valid genesis overrides",
+			spec:    &v1.DevnetSpec{Plugin: "stable", Mode: "docker", GenesisOverrides: map[string]string{"app_state.gov.params.voting_period": `"30s"`}},
+			wantErr: false,
+		},
+		{
+			name:    "invalid genesis override value",
+			spec:    &v1.DevnetSpec{Plugin: "stable", Mode: "docker", GenesisOverrides: map[string]string{"app_state.gov.params.voting_period": "30s"}},
+			wantErr: true,
+			field:   "spec.genesis_overrides",
+		},
+		{
+			name:    "valid ttl",
+			spec:    &v1.DevnetSpec{Plugin: "stable", Mode: "docker", Ttl: "4h"},
+			wantErr: false,
+		},
+		{
+			name:    "invalid ttl",
+			spec:    &v1.DevnetSpec{Plugin: "stable", Mode: "docker", Ttl: "-1h"},
+			wantErr: true,
+			field:   "spec.ttl",
+		},
+		{
+			name:    "invalid idle timeout",
+			spec:    &v1.DevnetSpec{Plugin: "stable", Mode: "docker", IdleTimeout: "0s"},
+			wantErr: true,
+			field:   "spec.idle_timeout",
+		},
+		{
+			name:    "valid port layout",
+			spec:    &v1.DevnetSpec{Plugin: "stable", Mode: "local", Validators: 4, Ports: &v1.PortLayout{Rpc: 36657, Rest: 2317, Stride: 10}},
+			wantErr: false,
+		},
+		{
+			name:    "port layout with clashing ports",
+			spec:    &v1.DevnetSpec{Plugin: "stable", Mode: "local", Validators: 1, Ports: &v1.PortLayout{Rpc: 1317}},
+			wantErr: true,
+			field:   "spec.ports",
+		},
+		{
+			name:    "docker port layout past the last port",
+			spec:    &v1.DevnetSpec{Plugin: "stable", Mode: "docker", Validators: 4, Ports: &v1.PortLayout{Rpc: 65400}},
+			wantErr: true,
+			field:   "spec.ports",
+		},
+		{
+			name:    "ipv6 bind address",
+			spec:    &v1.DevnetSpec{Plugin: "stable", Mode: "local", Validators: 2, BindAddress: "::"},
+			wantErr: false,
+		},
+		{
+			name:    "hostname bind address",
+			spec:    &v1.DevnetSpec{Plugin: "stable", Mode: "local", Validators: 2, BindAddress: "localhost"},
+			wantErr: true,
+			field:   "spec.bind_address",
+		},
+		{
+			name: "valid funded account",
+			spec: &v1.DevnetSpec{Plugin: "stable", Mode: "docker", FundedAccounts: []*v1.FundedAccount{
+				{Address: "0x0202020202020202020202020202020202020202", Coins: "1000astable"},
+			}},
+			wantErr: false,
+		},
+		{
+			name: "funded account with bad coins",
+			spec: &v1.DevnetSpec{Plugin: "stable", Mode: "docker", FundedAccounts: []*v1.FundedAccount{
+				{Address: "0x0202020202020202020202020202020202020202", Coins: "lots"},
+			}},
+			wantErr: true,
+			field:   "spec.funded_accounts",
+		},
+		{
+			name: "validator keys for a missing slot",
+			spec: &v1.DevnetSpec{Plugin: "stable", Mode: "docker", Validators: 2, ValidatorKeys: []*v1.ValidatorKeys{
+				{Index: 2, NodeKey: `{"priv_key":{"type":"tendermint/PrivKeyEd25519","value":""}}`},
+			}},
+			wantErr: true,
+			field:   "spec.validator_keys",
+		},
+		{
+			name: "remote signer with an unsupported protocol version",
+			spec: &v1.DevnetSpec{Plugin: "stable", Mode: "docker", Validators: 2, RemoteSigners: []*v1.RemoteSigner{
+				{Index: 1, Tmkms: true, ProtocolVersion: "v1.0"},
+			}},
+			wantErr: true,
+			field:   "spec.remote_signers",
+		},
+		{
+			name: "remote hosts",
+			spec: &v1.DevnetSpec{Plugin: "stable", Mode: "local", Validators: 2, Hosts: []*v1.RemoteHost{
+				{Name: "a", Address: "10.0.0.11", User: "dvb"},
+				{Name: "b", Address: "10.0.0.12", Port: 2222, Dir: "/srv/dvb"},
+			}},
+			wantErr: false,
+		},
+		{
+			name: "remote hosts with docker mode",
+			spec: &v1.DevnetSpec{Plugin: "stable", Mode: "docker", Hosts: []*v1.RemoteHost{
+				{Name: "a", Address: "10.0.0.11"},
+			}},
+			wantErr: true,
+			field:   "spec.hosts",
+		},
+		{
+			name: "remote host with a relative dir",
+			spec: &v1.DevnetSpec{Plugin: "stable", Mode: "local", Hosts: []*v1.RemoteHost{
+				{Name: "a", Address: "10.0.0.11", Dir: "dvb"},
+			}},
+			wantErr: true,
+			field:   "spec.hosts",
+		},
+		{
+			name: "network shaping between regions",
+			spec: &v1.DevnetSpec{Plugin: "stable", Mode: "docker", Validators: 4, NetworkShaping: &v1.NetworkShaping{
+				Regions: map[int32]string{0: "us-east", 1: "us-east", 2: "eu-west", 3: "eu-west"},
+				Links:   []*v1.NetworkLink{{From: "us-east", To: "eu-west", Latency: "100ms", Jitter: "20ms", Loss: "1%"}},
+			}},
+			wantErr: false,
+		},
+		{
+			name: "network shaping with an unknown region",
+			spec: &v1.DevnetSpec{Plugin: "stable", Mode: "docker", Validators: 2, NetworkShaping: &v1.NetworkShaping{
+				Links: []*v1.NetworkLink{{From: "default", To: "mars", Latency: "1s"}},
+			}},
+			wantErr: true,
+			field:   "spec.network_shaping",
+		},
+		{
+			name: "network shaping in local mode",
+			spec: &v1.DevnetSpec{Plugin: "stable", Mode: "local", Validators: 2, NetworkShaping: &v1.NetworkShaping{
+				Links: []*v1.NetworkLink{{From: "default", To: "default", Rate: "10mbit"}},
+			}},
+			wantErr: true,
+			field:   "spec.network_shaping",
+		},
 	}
 
 	for _, tt := range tests {
@@ -97,6 +243,21 @@ func TestSpecValidator_UpgradeSpec(t *testing.T) {
 			spec:    &v1.UpgradeSpec{DevnetRef: "my-devnet", UpgradeName: "v2", TargetHeight: -1},
 			wantErr: true,
 		},
+		{
+			name:    "fork test with height",
+			spec:    &v1.UpgradeSpec{DevnetRef: "my-devnet", UpgradeName: "v2", TargetHeight: 100, Mode: "fork-test"},
+			wantErr: false,
+		},
+		{
+			name:    "fork test without height",
+			spec:    &v1.UpgradeSpec{DevnetRef: "my-devnet", UpgradeName: "v2", Mode: "fork-test"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown mode",
+			spec:    &v1.UpgradeSpec{DevnetRef: "my-devnet", UpgradeName: "v2", TargetHeight: 100, Mode: "sideways"},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
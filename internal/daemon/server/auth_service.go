@@ -2,21 +2,29 @@ package server
 
 import (
 	"context"
-	"strings"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
 	"github.com/altuslabsxyz/devnet-builder/internal/auth"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/credentials"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/transport"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 // AuthService implements the AuthService gRPC service.
 type AuthService struct {
 	v1.UnimplementedAuthServiceServer
+	credentials *credentials.Store
+	isLocal     func(ctx context.Context) bool
 }
 
 // NewAuthService creates a new AuthService.
 func NewAuthService() *AuthService {
-	return &AuthService{}
+	return &AuthService{
+		credentials: credentials.Default,
+		isLocal:     IsLocalConnection,
+	}
 }
 
 // Ping tests connectivity to the server.
@@ -44,27 +52,41 @@ func (s *AuthService) WhoAmI(ctx context.Context, req *v1.WhoAmIRequest) (*v1.Wh
 	}, nil
 }
 
-// IsLocalConnection determines if the gRPC request came from a Unix socket.
+// HandOffCredentials fetches credentials from the socket the client listens
+// on. Only local clients may hand over credentials: the socket path is on
+// the client's machine, and remote users must not replace the token the
+// daemon uses for everyone.
+func (s *AuthService) HandOffCredentials(ctx context.Context, req *v1.HandOffCredentialsRequest) (*v1.HandOffCredentialsResponse, error) {
+	if !s.isLocal(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "credentials can only be handed over on the local socket")
+	}
+	if req.SocketPath == "" || req.Nonce == "" {
+		return nil, status.Error(codes.InvalidArgument, "socket_path and nonce are required")
+	}
+
+	creds, err := credentials.Fetch(ctx, req.SocketPath, req.Nonce)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to fetch credentials: %v", err)
+	}
+	s.credentials.SetClientGitHubToken(creds.GitHubToken)
+
+	_, source := s.credentials.GitHubToken()
+	return &v1.HandOffCredentialsResponse{GithubTokenSource: string(source)}, nil
+}
+
+// GetCredentialStatus reports where the daemon's GitHub token comes from.
+func (s *AuthService) GetCredentialStatus(ctx context.Context, req *v1.GetCredentialStatusRequest) (*v1.GetCredentialStatusResponse, error) {
+	_, source := s.credentials.GitHubToken()
+	return &v1.GetCredentialStatusResponse{GithubTokenSource: string(source)}, nil
+}
+
+// IsLocalConnection determines if the gRPC request came from the local
+// socket (Unix socket, named pipe or loopback TCP transport).
 // This is used by the auth interceptor to skip authentication for local connections.
 func IsLocalConnection(ctx context.Context) bool {
 	p, ok := peer.FromContext(ctx)
 	if !ok {
 		return false
 	}
-
-	// Unix socket addresses have the format "@" or a file path
-	// TCP addresses have the format "ip:port"
-	addr := p.Addr.String()
-
-	// Unix socket addresses start with @ or / or are empty network addresses
-	if strings.HasPrefix(addr, "@") || strings.HasPrefix(addr, "/") {
-		return true
-	}
-
-	// Check the network type
-	if p.Addr.Network() == "unix" {
-		return true
-	}
-
-	return false
+	return transport.IsLocal(p.Addr)
 }
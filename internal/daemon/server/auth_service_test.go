@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/credentials"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthServiceHandOffCredentials(t *testing.T) {
+	ctx := context.Background()
+	svc := &AuthService{
+		credentials: credentials.NewStore(),
+		isLocal:     func(context.Context) bool { return true },
+	}
+
+	err := credentials.Offer(ctx, credentials.Handoff{GitHubToken: "ghp_test"}, func(socketPath, nonce string) error {
+		resp, err := svc.HandOffCredentials(ctx, &v1.HandOffCredentialsRequest{SocketPath: socketPath, Nonce: nonce})
+		if err != nil {
+			return err
+		}
+		if resp.GithubTokenSource != "keychain" {
+			t.Errorf("GithubTokenSource = %q, want keychain", resp.GithubTokenSource)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("handoff failed: %v", err)
+	}
+	if token, _ := svc.credentials.GitHubToken(); token != "ghp_test" {
+		t.Errorf("token = %q, want ghp_test", token)
+	}
+
+	st, err := svc.GetCredentialStatus(ctx, &v1.GetCredentialStatusRequest{})
+	if err != nil {
+		t.Fatalf("GetCredentialStatus failed: %v", err)
+	}
+	if st.GithubTokenSource != "keychain" {
+		t.Errorf("GithubTokenSource = %q, want keychain", st.GithubTokenSource)
+	}
+}
+
+func TestAuthServiceHandOffCredentialsRemote(t *testing.T) {
+	svc := &AuthService{
+		credentials: credentials.NewStore(),
+		isLocal:     func(context.Context) bool { return false },
+	}
+
+	_, err := svc.HandOffCredentials(context.Background(), &v1.HandOffCredentialsRequest{SocketPath: "/tmp/x.sock", Nonce: "n"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("code = %v, want PermissionDenied", status.Code(err))
+	}
+}
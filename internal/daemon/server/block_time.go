@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/configpatch"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultBlockTime is the timeout_commit devnets get without a block time,
+// see nodeconfig.ConfigEditor.SetConsensusParams.
+const defaultBlockTime = "1s"
+
+// SetBlockTime records a devnet's block time in its spec and writes it to
+// every node's config.toml as consensus.timeout_commit. Nodes are not
+// restarted: the response lists the running nodes that need a restart to
+// pick it up, so the caller can roll through them without halting the
+// chain.
+func (s *DevnetService) SetBlockTime(ctx context.Context, req *v1.SetBlockTimeRequest) (*v1.SetBlockTimeResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if req.BlockTime != "" {
+		if d, err := time.ParseDuration(req.BlockTime); err != nil || d <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid block time %q: must be positive, e.g. 500ms", req.BlockTime)
+		}
+	}
+
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+
+	var devnet *types.Devnet
+	err := store.RetryOnConflict(ctx, func() error {
+		var err error
+		devnet, err = s.store.GetDevnet(ctx, namespace, req.Name)
+		if err != nil {
+			return err
+		}
+		if devnet.Spec.BlockTime == req.BlockTime {
+			return nil
+		}
+		devnet.Spec.BlockTime = req.BlockTime
+		return s.store.UpdateDevnet(ctx, devnet)
+	})
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", req.Name)
+		}
+		return nil, updateDevnetError(err)
+	}
+
+	nodes, err := s.store.ListNodes(ctx, namespace, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list nodes: %v", err)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Spec.Index < nodes[j].Spec.Index })
+
+	blockTime := req.BlockTime
+	if blockTime == "" {
+		blockTime = defaultBlockTime
+	}
+	change := configpatch.Change{File: "config.toml", Key: "consensus.timeout_commit", Value: strconv.Quote(blockTime)}
+
+	resp := &v1.SetBlockTimeResponse{Devnet: DevnetToProto(devnet)}
+	for _, node := range nodes {
+		if node.Spec.HomeDir == "" {
+			continue
+		}
+		applied, err := configpatch.Apply(node.Spec.HomeDir, []configpatch.Change{change})
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "node %s/%d: %v", req.Name, node.Spec.Index, err)
+		}
+		if len(applied) > 0 && node.Status.Phase == types.NodePhaseRunning {
+			resp.RestartNodes = append(resp.RestartNodes, int32(node.Spec.Index))
+		}
+	}
+
+	s.logger.Info("set block time",
+		"namespace", namespace,
+		"devnet", req.Name,
+		"blockTime", blockTime,
+		"restartNodes", len(resp.RestartNodes))
+
+	return resp, nil
+}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDevnetService_SetBlockTime(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+
+	if err := s.CreateDevnet(ctx, &types.Devnet{Metadata: types.ResourceMeta{Name: "fast"}}); err != nil {
+		t.Fatalf("CreateDevnet: %v", err)
+	}
+	homes := make([]string, 3)
+	for i, phase := range []string{types.NodePhaseRunning, types.NodePhaseRunning, types.NodePhaseStopped} {
+		homes[i] = t.TempDir()
+		if err := os.MkdirAll(filepath.Join(homes[i], "config"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		config := "[consensus]\ntimeout_commit = \"1s\"\n"
+		if err := os.WriteFile(filepath.Join(homes[i], "config", "config.toml"), []byte(config), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.CreateNode(ctx, &types.Node{
+			Metadata: types.ResourceMeta{Name: fmt.Sprintf("fast-%d", i)},
+			Spec:     types.NodeSpec{DevnetRef: "fast", Index: i, HomeDir: homes[i]},
+			Status:   types.NodeStatus{Phase: phase},
+		}); err != nil {
+			t.Fatalf("CreateNode: %v", err)
+		}
+	}
+
+	resp, err := svc.SetBlockTime(ctx, &v1.SetBlockTimeRequest{Name: "fast", BlockTime: "500ms"})
+	if err != nil {
+		t.Fatalf("SetBlockTime: %v", err)
+	}
+	if got := resp.Devnet.Spec.BlockTime; got != "500ms" {
+		t.Errorf("spec.block_time = %q, want 500ms", got)
+	}
+	if want := []int32{0, 1}; !slices.Equal(resp.RestartNodes, want) {
+		t.Errorf("RestartNodes = %v, want %v: the stopped node picks it up when it starts", resp.RestartNodes, want)
+	}
+	for _, home := range homes {
+		data, _ := os.ReadFile(filepath.Join(home, "config", "config.toml"))
+		if !strings.Contains(string(data), `timeout_commit = "500ms"`) {
+			t.Errorf("config.toml not updated:\n%s", data)
+		}
+	}
+
+	// Setting it again changes nothing, so no node needs a restart
+	resp, err = svc.SetBlockTime(ctx, &v1.SetBlockTimeRequest{Name: "fast", BlockTime: "500ms"})
+	if err != nil {
+		t.Fatalf("second SetBlockTime: %v", err)
+	}
+	if len(resp.RestartNodes) != 0 {
+		t.Errorf("RestartNodes = %v, want none", resp.RestartNodes)
+	}
+
+	// An empty block time goes back to the default
+	if _, err := svc.SetBlockTime(ctx, &v1.SetBlockTimeRequest{Name: "fast"}); err != nil {
+		t.Fatalf("SetBlockTime to default: %v", err)
+	}
+	data, _ := os.ReadFile(filepath.Join(homes[0], "config", "config.toml"))
+	if !strings.Contains(string(data), `timeout_commit = "1s"`) {
+		t.Errorf("config.toml not reset:\n%s", data)
+	}
+
+	for _, tt := range []struct {
+		req  *v1.SetBlockTimeRequest
+		code codes.Code
+	}{
+		{&v1.SetBlockTimeRequest{BlockTime: "1s"}, codes.InvalidArgument},
+		{&v1.SetBlockTimeRequest{Name: "fast", BlockTime: "fast"}, codes.InvalidArgument},
+		{&v1.SetBlockTimeRequest{Name: "fast", BlockTime: "0s"}, codes.InvalidArgument},
+		{&v1.SetBlockTimeRequest{Name: "missing", BlockTime: "1s"}, codes.NotFound},
+	} {
+		if _, err := svc.SetBlockTime(ctx, tt.req); status.Code(err) != tt.code {
+			t.Errorf("SetBlockTime(%v): expected %v, got %v", tt.req, tt.code, err)
+		}
+	}
+}
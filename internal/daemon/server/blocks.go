@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/blocks"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/valset"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultListBlocksLimit = 20
+	maxListBlocksLimit     = 100
+)
+
+// ListBlocks lists a running devnet's most recent blocks with their
+// proposers, transaction counts and block intervals.
+func (s *DevnetService) ListBlocks(ctx context.Context, req *v1.ListBlocksRequest) (*v1.ListBlocksResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+	limit := int(req.Limit)
+	switch {
+	case limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	case limit == 0:
+		limit = defaultListBlocksLimit
+	case limit > maxListBlocksLimit:
+		limit = maxListBlocksLimit
+	}
+
+	_, rpcURL, restURL, err := s.blockNode(ctx, req.GetNamespace(), req.DevnetName, req.NodeIndex)
+	if err != nil {
+		return nil, err
+	}
+	summaries, err := blocks.NewReader(blocks.Config{RPCEndpoint: rpcURL}).List(ctx, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "%v", err)
+	}
+
+	monikers := proposerMonikers(ctx, rpcURL, restURL)
+	resp := &v1.ListBlocksResponse{}
+	for _, b := range summaries {
+		resp.Blocks = append(resp.Blocks, blockSummaryToProto(b, monikers))
+	}
+	if len(summaries) > 0 {
+		resp.LatestBlockAgeMs = time.Since(summaries[0].Time).Milliseconds()
+	}
+	return resp, nil
+}
+
+// GetBlock returns one block of a running devnet with its transactions
+// decoded by the network plugin.
+func (s *DevnetService) GetBlock(ctx context.Context, req *v1.GetBlockRequest) (*v1.GetBlockResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must not be negative")
+	}
+
+	plugin, rpcURL, restURL, err := s.blockNode(ctx, req.GetNamespace(), req.DevnetName, req.NodeIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := blocks.NewReader(blocks.Config{RPCEndpoint: rpcURL, Decoder: txDecoder(plugin)})
+	block, err := reader.Get(ctx, req.Height)
+	if err != nil {
+		if errors.Is(err, blocks.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+		return nil, status.Errorf(codes.Unavailable, "%v", err)
+	}
+	return blockToProto(block, proposerMonikers(ctx, rpcURL, restURL)), nil
+}
+
+// blockNode returns the network plugin of a running devnet and the CometBFT
+// RPC and REST URLs of one of its nodes.
+func (s *DevnetService) blockNode(ctx context.Context, namespace, devnetName string, nodeIndex int32) (plugin, rpcURL, restURL string, err error) {
+	devnet, err := s.store.GetDevnet(ctx, namespace, devnetName)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return "", "", "", status.Errorf(codes.NotFound, "devnet %q not found", devnetName)
+		}
+		return "", "", "", status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+	if devnet.Status.Phase != types.PhaseRunning && devnet.Status.Phase != types.PhaseDegraded {
+		return "", "", "", status.Errorf(codes.FailedPrecondition, "devnet %q is %s; block queries require a running devnet%s",
+			devnetName, devnet.Status.Phase, resumeHint(devnetName, devnet.Status.Phase))
+	}
+
+	node, err := s.store.GetNode(ctx, devnet.Metadata.Namespace, devnetName, int(nodeIndex))
+	if err != nil {
+		if store.IsNotFound(err) {
+			return "", "", "", status.Errorf(codes.NotFound, "node %s/%d not found", devnetName, nodeIndex)
+		}
+		return "", "", "", status.Errorf(codes.Internal, "failed to get node: %v", err)
+	}
+
+	host := node.Spec.Address
+	if host == "" {
+		host = "127.0.0.1"
+	}
+	ports := node.Spec.Ports()
+	return devnet.Spec.Plugin, ports.RPCURL(host), ports.APIURL(host), nil
+}
+
+// proposerMonikers maps uppercase hex consensus addresses to validator
+// monikers. Monikers are cosmetic, so lookup failures yield none.
+func proposerMonikers(ctx context.Context, rpcURL, restURL string) map[string]string {
+	set, err := valset.NewQuerier(valset.Config{RPCEndpoint: rpcURL, RESTEndpoint: restURL}).Get(ctx, 0)
+	if err != nil {
+		return nil
+	}
+	monikers := make(map[string]string, len(set.Validators))
+	for _, v := range set.Validators {
+		if v.Moniker != "" {
+			monikers[strings.ToUpper(v.ConsensusAddress)] = v.Moniker
+		}
+	}
+	return monikers
+}
+
+func blockSummaryToProto(b blocks.Summary, monikers map[string]string) *v1.BlockSummary {
+	return &v1.BlockSummary{
+		Height:          b.Height,
+		Time:            timestamppb.New(b.Time),
+		Hash:            b.Hash,
+		ProposerAddress: b.ProposerAddress,
+		ProposerMoniker: monikers[strings.ToUpper(b.ProposerAddress)],
+		NumTxs:          int32(b.NumTxs),
+		IntervalMs:      b.Interval.Milliseconds(),
+	}
+}
+
+func blockToProto(b *blocks.Block, monikers map[string]string) *v1.GetBlockResponse {
+	resp := &v1.GetBlockResponse{Block: blockSummaryToProto(b.Summary, monikers)}
+	for _, tx := range b.Txs {
+		pb := &v1.BlockTx{
+			Hash:        tx.Hash,
+			Code:        tx.Code,
+			Log:         tx.Log,
+			GasWanted:   tx.GasWanted,
+			GasUsed:     tx.GasUsed,
+			DecodeError: tx.DecodeError,
+		}
+		if tx.Decoded != nil {
+			pb.Memo = tx.Decoded.Memo
+			pb.Fee = tx.Decoded.Fee
+			for _, m := range tx.Decoded.Messages {
+				pb.Messages = append(pb.Messages, &v1.TxTraceMessage{TypeUrl: m.TypeURL, Json: m.JSON})
+			}
+		}
+		resp.Txs = append(resp.Txs, pb)
+	}
+	return resp
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/blocks"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	pkgNetwork "github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDevnetService_BlocksValidation(t *testing.T) {
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+	ctx := context.Background()
+
+	if err := s.CreateDevnet(ctx, &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "stopped-devnet", Namespace: types.DefaultNamespace},
+		Spec:     types.DevnetSpec{Plugin: "stable", Validators: 1},
+		Status:   types.DevnetStatus{Phase: types.PhaseStopped},
+	}); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+
+	listTests := []struct {
+		name string
+		req  *v1.ListBlocksRequest
+		code codes.Code
+	}{
+		{"missing devnet name", &v1.ListBlocksRequest{}, codes.InvalidArgument},
+		{"negative limit", &v1.ListBlocksRequest{DevnetName: "stopped-devnet", Limit: -1}, codes.InvalidArgument},
+		{"devnet not found", &v1.ListBlocksRequest{DevnetName: "missing"}, codes.NotFound},
+		{"devnet not running", &v1.ListBlocksRequest{DevnetName: "stopped-devnet"}, codes.FailedPrecondition},
+	}
+	for _, tt := range listTests {
+		t.Run("list "+tt.name, func(t *testing.T) {
+			_, err := svc.ListBlocks(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Errorf("expected code %v, got %v (%v)", tt.code, status.Code(err), err)
+			}
+		})
+	}
+
+	getTests := []struct {
+		name string
+		req  *v1.GetBlockRequest
+		code codes.Code
+	}{
+		{"missing devnet name", &v1.GetBlockRequest{Height: 1}, codes.InvalidArgument},
+		{"negative height", &v1.GetBlockRequest{DevnetName: "stopped-devnet", Height: -1}, codes.InvalidArgument},
+		{"devnet not found", &v1.GetBlockRequest{DevnetName: "missing"}, codes.NotFound},
+		{"devnet not running", &v1.GetBlockRequest{DevnetName: "stopped-devnet"}, codes.FailedPrecondition},
+	}
+	for _, tt := range getTests {
+		t.Run("get "+tt.name, func(t *testing.T) {
+			_, err := svc.GetBlock(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Errorf("expected code %v, got %v (%v)", tt.code, status.Code(err), err)
+			}
+		})
+	}
+}
+
+func TestBlockToProto(t *testing.T) {
+	resp := blockToProto(&blocks.Block{
+		Summary: blocks.Summary{
+			Height:          42,
+			Time:            time.Unix(1700000000, 0),
+			ProposerAddress: "abcd",
+			NumTxs:          2,
+			Interval:        1500 * time.Millisecond,
+		},
+		Txs: []blocks.Tx{
+			{Hash: "AA", GasUsed: 81234, Decoded: &pkgNetwork.DecodedTx{
+				Messages: []pkgNetwork.DecodedMsg{{TypeURL: "/cosmos.bank.v1beta1.MsgSend"}},
+				Fee:      "5000stake",
+			}},
+			{Hash: "BB", Code: 5, DecodeError: "unknown tx format"},
+		},
+	}, map[string]string{"ABCD": "validator-0"})
+
+	if resp.Block.Height != 42 || resp.Block.IntervalMs != 1500 || resp.Block.ProposerMoniker != "validator-0" {
+		t.Errorf("unexpected block: %v", resp.Block)
+	}
+	if len(resp.Txs) != 2 {
+		t.Fatalf("expected 2 txs, got %d", len(resp.Txs))
+	}
+	if resp.Txs[0].Fee != "5000stake" || len(resp.Txs[0].Messages) != 1 {
+		t.Errorf("unexpected tx: %v", resp.Txs[0])
+	}
+	if resp.Txs[1].Code != 5 || resp.Txs[1].DecodeError != "unknown tx format" {
+		t.Errorf("unexpected tx: %v", resp.Txs[1])
+	}
+}
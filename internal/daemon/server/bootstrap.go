@@ -0,0 +1,54 @@
+package server
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/bootstrap"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/keys"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+)
+
+// bootstrapConfig configures the deployment of a devnet's bootstrap
+// contracts: through its first node's REST API and the first node serving
+// EVM JSON-RPC, by its first validator's operator account.
+func bootstrapConfig(devnet *types.Devnet, nodes []*types.Node) (bootstrap.Config, error) {
+	if len(nodes) == 0 {
+		return bootstrap.Config{}, fmt.Errorf("devnet has no nodes")
+	}
+	module, err := network.Get(devnet.Spec.Plugin)
+	if err != nil {
+		return bootstrap.Config{}, fmt.Errorf("network %q not found: %w", devnet.Spec.Plugin, err)
+	}
+	key, err := keys.ForSeed(devnet.Spec.Seed).Derive(keys.RoleValidator, 0, module.Bech32Prefix(), isEVMNetwork(module))
+	if err != nil {
+		return bootstrap.Config{}, fmt.Errorf("failed to derive deployer key: %w", err)
+	}
+
+	nodes = slices.Clone(nodes)
+	slices.SortFunc(nodes, func(a, b *types.Node) int { return a.Spec.Index - b.Spec.Index })
+	host := func(node *types.Node) string {
+		if node.Spec.Address == "" {
+			return "127.0.0.1"
+		}
+		return node.Spec.Address
+	}
+
+	first := nodes[0]
+	cfg := bootstrap.Config{
+		ChainID:      first.Spec.ChainID,
+		RESTEndpoint: first.Spec.Ports().APIURL(host(first)),
+		Key:          key,
+	}
+	if cfg.ChainID == "" {
+		cfg.ChainID = devnet.Spec.ChainID
+	}
+	for _, node := range nodes {
+		if servesEVM(devnet, node) {
+			cfg.EVMEndpoint = node.Spec.Ports().EVMRPCURL(host(node))
+			break
+		}
+	}
+	return cfg, nil
+}
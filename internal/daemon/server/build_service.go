@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ImageBuilder builds Docker images from a git ref.
+type ImageBuilder interface {
+	Build(ctx context.Context, spec builder.ImageBuildSpec) (*builder.ImageBuildResult, error)
+}
+
+// BuildService implements the gRPC BuildServiceServer.
+type BuildService struct {
+	v1.UnimplementedBuildServiceServer
+	binaryBuilder builder.BinaryBuilder
+	imageBuilder  ImageBuilder
+	logger        *slog.Logger
+}
+
+// NewBuildService creates a new BuildService.
+func NewBuildService(binaryBuilder builder.BinaryBuilder, imageBuilder ImageBuilder) *BuildService {
+	return &BuildService{
+		binaryBuilder: binaryBuilder,
+		imageBuilder:  imageBuilder,
+		logger:        slog.Default(),
+	}
+}
+
+// SetLogger sets the logger for the service.
+func (s *BuildService) SetLogger(logger *slog.Logger) {
+	s.logger = logger
+}
+
+// Build builds a network binary or Docker image from a git ref.
+func (s *BuildService) Build(ctx context.Context, req *v1.BuildRequest) (*v1.BuildResponse, error) {
+	if req.NetworkName == "" {
+		return nil, status.Error(codes.InvalidArgument, "network_name is required")
+	}
+
+	module, err := network.Get(req.NetworkName)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "network %q not found: %v", req.NetworkName, err)
+	}
+
+	if req.Image {
+		return s.buildImage(ctx, req, module)
+	}
+	return s.buildBinary(ctx, req)
+}
+
+// buildBinary builds the network binary through the plugin builder.
+func (s *BuildService) buildBinary(ctx context.Context, req *v1.BuildRequest) (*v1.BuildResponse, error) {
+	if s.binaryBuilder == nil {
+		return nil, status.Error(codes.Unavailable, "binary builder not configured")
+	}
+
+	result, err := s.binaryBuilder.Build(ctx, builder.BuildSpec{
+		GitRepo:         req.GitRepo,
+		GitRef:          req.GitRef,
+		PluginName:      req.NetworkName,
+		NoCache:         req.NoCache,
+		ForceBuild:      req.ForceBuild,
+		AllowUnverified: req.AllowUnverified,
+	})
+	if err != nil {
+		s.logger.Error("binary build failed", "network", req.NetworkName, "ref", req.GitRef, "error", err)
+		return nil, apierror.Errorf(apierror.BuildFailed, "build failed: %v", err)
+	}
+
+	return &v1.BuildResponse{
+		BinaryPath:  result.BinaryPath,
+		GitCommit:   result.GitCommit,
+		GitRef:      result.GitRef,
+		FromRelease: result.FromRelease,
+	}, nil
+}
+
+// buildImage builds a Docker image containing the network binary.
+func (s *BuildService) buildImage(ctx context.Context, req *v1.BuildRequest, module network.NetworkModule) (*v1.BuildResponse, error) {
+	if s.imageBuilder == nil {
+		return nil, status.Error(codes.Unavailable, "image builder not configured")
+	}
+
+	gitRepo, err := resolveGitRepo(req.GitRepo, module.BinarySource())
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	buildConfig, err := module.GetBuildConfig(req.NetworkType)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to get build config for network type %q: %v", req.NetworkType, err)
+	}
+
+	result, err := s.imageBuilder.Build(ctx, builder.ImageBuildSpec{
+		NetworkName: req.NetworkName,
+		GitRepo:     gitRepo,
+		GitRef:      req.GitRef,
+		BinaryName:  module.BinaryName(),
+		BuildConfig: buildConfig,
+		Tag:         req.Tag,
+		NoCache:     req.NoCache,
+	})
+	if err != nil {
+		s.logger.Error("image build failed", "network", req.NetworkName, "ref", req.GitRef, "error", err)
+		return nil, apierror.Errorf(apierror.BuildFailed, "image build failed: %v", err)
+	}
+
+	return &v1.BuildResponse{
+		Image:     result.Image,
+		GitCommit: result.GitCommit,
+		GitRef:    result.GitRef,
+		Cached:    result.Cached,
+	}, nil
+}
+
+// resolveGitRepo returns the explicit repository if set, otherwise the
+// GitHub repository from the network's binary source.
+func resolveGitRepo(explicit string, source network.BinarySource) (string, error) {
+	if explicit != "" {
+		return explicit, nil
+	}
+	if !source.IsGitHub() || source.Owner == "" || source.Repo == "" {
+		return "", fmt.Errorf("network has no GitHub source repository; specify one with --repo")
+	}
+	return fmt.Sprintf("github.com/%s/%s", source.Owner, source.Repo), nil
+}
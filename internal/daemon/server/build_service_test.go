@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBuildService_Build_MissingNetworkName(t *testing.T) {
+	svc := NewBuildService(nil, nil)
+
+	_, err := svc.Build(context.Background(), &v1.BuildRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestBuildService_Build_NetworkNotFound(t *testing.T) {
+	svc := NewBuildService(nil, nil)
+
+	_, err := svc.Build(context.Background(), &v1.BuildRequest{
+		NetworkName: "nonexistent-network",
+		Image:       true,
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("Expected NotFound, got %v", err)
+	}
+}
+
+func TestResolveGitRepo(t *testing.T) {
+	tests := []struct {
+		name     string
+		explicit string
+		source   network.BinarySource
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "explicit repo wins",
+			explicit: "github.com/me/fork",
+			source:   network.BinarySource{Type: network.BinarySourceGitHub, Owner: "org", Repo: "chain"},
+			want:     "github.com/me/fork",
+		},
+		{
+			name:   "github source",
+			source: network.BinarySource{Type: network.BinarySourceGitHub, Owner: "org", Repo: "chain"},
+			want:   "github.com/org/chain",
+		},
+		{
+			name:    "local source",
+			source:  network.BinarySource{Type: network.BinarySourceLocal, LocalPath: "/usr/bin/chaind"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveGitRepo(tt.explicit, tt.source)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveGitRepo() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"io"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/bundle"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/controller"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBundleLogLines bounds the node log lines of a debug bundle.
+const maxBundleLogLines = 100000
+
+// CollectDebugBundle collects a devnet's specs, statuses, events, node logs
+// and configs, daemon log, versions and host information, with secrets
+// redacted, for dvb to pack into a support bundle. It works in any phase,
+// since bundles matter most for devnets that failed.
+func (s *DevnetService) CollectDebugBundle(ctx context.Context, req *v1.CollectDebugBundleRequest) (*v1.CollectDebugBundleResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+	if req.LogLines < 0 || req.LogLines > maxBundleLogLines {
+		return nil, status.Errorf(codes.InvalidArgument, "log_lines must be between 0 and %d", maxBundleLogLines)
+	}
+
+	cfg := bundle.Config{
+		Store:    s.store,
+		DataDir:  s.dataDir,
+		LogLines: int(req.LogLines),
+	}
+	if s.runtime != nil {
+		cfg.Logs = func(ctx context.Context, node *types.Node, lines int) (io.ReadCloser, error) {
+			nodeID := node.Metadata.Name
+			if nodeID == "" {
+				nodeID = controller.NodeKey(node.Spec.DevnetRef, node.Spec.Index)
+			}
+			return s.runtime.GetLogs(ctx, nodeID, runtime.LogOptions{Lines: lines})
+		}
+	}
+
+	files, err := bundle.NewCollector(cfg).Collect(ctx, req.GetNamespace(), req.DevnetName)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", req.DevnetName)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to collect debug bundle: %v", err)
+	}
+
+	resp := &v1.CollectDebugBundleResponse{}
+	for _, f := range files {
+		resp.Files = append(resp.Files, &v1.BundleFile{Name: f.Name, Content: f.Content})
+	}
+	return resp, nil
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDevnetService_CollectDebugBundle(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+	svc.SetDebugSources(nil, t.TempDir())
+
+	_, err := svc.CollectDebugBundle(ctx, &v1.CollectDebugBundleRequest{DevnetName: "alpha"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+	_, err = svc.CollectDebugBundle(ctx, &v1.CollectDebugBundleRequest{DevnetName: "alpha", LogLines: -1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for negative log_lines, got %v", err)
+	}
+
+	devnet := &types.Devnet{Metadata: types.ResourceMeta{Name: "alpha"}}
+	devnet.Status.Phase = types.PhaseDegraded
+	if err := s.CreateDevnet(ctx, devnet); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+	files, err := svc.CollectDebugBundle(ctx, &v1.CollectDebugBundleRequest{DevnetName: "alpha"})
+	if err != nil {
+		t.Fatalf("CollectDebugBundle failed: %v", err)
+	}
+	names := make(map[string]bool)
+	for _, f := range files.Files {
+		names[f.Name] = true
+	}
+	for _, want := range []string{"devnet.json", "versions.txt", "host.txt", "errors.txt"} {
+		if !names[want] {
+			t.Errorf("bundle missing %s, got %v", want, names)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/compose"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportCompose renders the containers a docker-mode devnet's nodes run in
+// as a standalone docker compose file.
+func (s *DevnetService) ExportCompose(ctx context.Context, req *v1.ExportComposeRequest) (*v1.ExportComposeResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+
+	devnet, err := s.store.GetDevnet(ctx, req.GetNamespace(), req.DevnetName)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", req.DevnetName)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+
+	lister, ok := s.runtime.(runtime.ContainerLister)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition,
+			"compose export requires the docker runtime; start devnetd with --runtime docker")
+	}
+
+	namespace := devnet.Metadata.Namespace
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+	containers := lister.DevnetContainers(namespace, req.DevnetName)
+	if len(containers) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "devnet %q has no running containers%s",
+			req.DevnetName, resumeHint(req.DevnetName, devnet.Status.Phase))
+	}
+
+	content, err := compose.Render(containers, compose.Options{
+		Devnet:   req.DevnetName,
+		HomeRoot: req.HomeRoot,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to render compose file: %v", err)
+	}
+
+	services := make([]string, 0, len(containers))
+	for _, c := range containers {
+		services = append(services, compose.ServiceName(c))
+	}
+	return &v1.ExportComposeResponse{Content: content, Services: services}, nil
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// containerRuntime is a NodeRuntime that runs nodes in containers.
+type containerRuntime struct {
+	runtime.NodeRuntime
+	containers []runtime.ContainerSpec
+}
+
+func (r *containerRuntime) DevnetContainers(namespace, devnet string) []runtime.ContainerSpec {
+	if namespace != types.DefaultNamespace || devnet != "docker-devnet" {
+		return nil
+	}
+	return r.containers
+}
+
+func TestDevnetService_ExportCompose(t *testing.T) {
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+	ctx := context.Background()
+
+	for _, name := range []string{"docker-devnet", "stopped-devnet"} {
+		if err := s.CreateDevnet(ctx, &types.Devnet{
+			Metadata: types.ResourceMeta{Name: name, Namespace: types.DefaultNamespace},
+			Spec:     types.DevnetSpec{Plugin: "stable", Validators: 1, Mode: "docker"},
+			Status:   types.DevnetStatus{Phase: types.PhaseRunning},
+		}); err != nil {
+			t.Fatalf("CreateDevnet failed: %v", err)
+		}
+	}
+
+	// Without the docker runtime
+	svc.SetDebugSources(nil, t.TempDir())
+	_, err := svc.ExportCompose(ctx, &v1.ExportComposeRequest{DevnetName: "docker-devnet"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition without docker runtime, got %v", err)
+	}
+
+	svc.SetDebugSources(&containerRuntime{containers: []runtime.ContainerSpec{
+		{Name: "dvb-docker-devnet-validator-0", Role: "validator", Index: 0, Image: "stablelabs/stabled:latest",
+			Mounts: []runtime.BindMount{{Source: "/data/node0", Target: "/root/.stabled"}}},
+		{Name: "dvb-docker-devnet-validator-1", Role: "validator", Index: 1, Image: "stablelabs/stabled:latest"},
+	}}, t.TempDir())
+
+	tests := []struct {
+		name string
+		req  *v1.ExportComposeRequest
+		code codes.Code
+	}{
+		{name: "missing devnet name", req: &v1.ExportComposeRequest{}, code: codes.InvalidArgument},
+		{name: "devnet not found", req: &v1.ExportComposeRequest{DevnetName: "missing"}, code: codes.NotFound},
+		{name: "no containers", req: &v1.ExportComposeRequest{DevnetName: "stopped-devnet"}, code: codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.ExportCompose(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Errorf("expected code %v, got %v (%v)", tt.code, status.Code(err), err)
+			}
+		})
+	}
+
+	resp, err := svc.ExportCompose(ctx, &v1.ExportComposeRequest{DevnetName: "docker-devnet", HomeRoot: "./homes"})
+	if err != nil {
+		t.Fatalf("ExportCompose failed: %v", err)
+	}
+	if got := strings.Join(resp.Services, ","); got != "validator-0,validator-1" {
+		t.Errorf("unexpected services: %s", got)
+	}
+	content := string(resp.Content)
+	for _, want := range []string{"name: dvb-docker-devnet", "validator-1:", "./homes/validator-0:/root/.stabled"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("compose file missing %q:\n%s", want, content)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/consensus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DiagnoseConsensus collects the consensus state of a running devnet from
+// its nodes and ranks likely causes of a stall.
+func (s *DevnetService) DiagnoseConsensus(ctx context.Context, req *v1.DiagnoseConsensusRequest) (*v1.DiagnoseConsensusResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+
+	nodes, err := s.runningNodes(ctx, req.GetNamespace(), req.DevnetName, "consensus diagnostics")
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]consensus.Node, 0, len(nodes))
+	for _, node := range nodes {
+		targets = append(targets, consensus.Node{
+			Index:  node.Spec.Index,
+			Name:   nodeDisplayName(node),
+			RPCURL: nodeRPCURL(node),
+		})
+	}
+
+	report := consensus.NewDiagnoser(consensus.Config{}).Diagnose(ctx, req.DevnetName, targets)
+	return consensusReportToProto(report), nil
+}
+
+// consensusReportToProto converts a consensus report to its API form.
+func consensusReportToProto(r *consensus.Report) *v1.DiagnoseConsensusResponse {
+	resp := &v1.DiagnoseConsensusResponse{
+		ObservedBy:    r.ObservedBy,
+		Height:        r.Height,
+		Round:         r.Round,
+		Step:          r.Step,
+		Stalled:       r.Stalled,
+		CompareHeight: r.CompareHeight,
+	}
+	if !r.LatestBlockTime.IsZero() {
+		resp.LatestBlockTime = timestamppb.New(r.LatestBlockTime)
+	}
+	for _, v := range r.Validators {
+		resp.Validators = append(resp.Validators, &v1.ConsensusValidator{
+			Address:         v.Address,
+			Name:            v.Name,
+			VotingPower:     v.VotingPower,
+			Proposer:        v.Proposer,
+			Prevote:         v.Prevote,
+			Precommit:       v.Precommit,
+			SignedLastBlock: v.SignedLastBlock,
+		})
+	}
+	for _, n := range r.Nodes {
+		resp.Nodes = append(resp.Nodes, &v1.ConsensusNode{
+			Index:      int32(n.Index),
+			Name:       n.Name,
+			Height:     n.Height,
+			CatchingUp: n.CatchingUp,
+			AppHash:    n.AppHash,
+			Error:      n.Error,
+		})
+	}
+	for _, h := range r.Hypotheses {
+		resp.Hypotheses = append(resp.Hypotheses, &v1.ConsensusHypothesis{
+			Title:      h.Title,
+			Confidence: h.Confidence,
+			Evidence:   h.Evidence,
+			Fixes:      h.Fixes,
+		})
+	}
+	return resp
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/consensus"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDevnetService_DiagnoseConsensus_NotRunning(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+
+	_, err := svc.DiagnoseConsensus(ctx, &v1.DiagnoseConsensusRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument without a devnet name, got %v", err)
+	}
+	_, err = svc.DiagnoseConsensus(ctx, &v1.DiagnoseConsensusRequest{DevnetName: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+
+	devnet := &types.Devnet{Metadata: types.ResourceMeta{Name: "alpha"}}
+	devnet.Status.Phase = types.PhaseStopped
+	if err := s.CreateDevnet(ctx, devnet); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+	_, err = svc.DiagnoseConsensus(ctx, &v1.DiagnoseConsensusRequest{DevnetName: "alpha"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition for a stopped devnet, got %v", err)
+	}
+}
+
+func TestConsensusReportToProto(t *testing.T) {
+	blockTime := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	resp := consensusReportToProto(&consensus.Report{
+		ObservedBy:      "validator-0",
+		Height:          11,
+		Round:           1,
+		Step:            "Prevote",
+		LatestBlockTime: blockTime,
+		Stalled:         true,
+		Validators:      []consensus.Validator{{Address: "AAAA", Name: "validator-0", VotingPower: 10, Prevote: consensus.VoteNil, Precommit: consensus.VoteMissing}},
+		Nodes:           []consensus.NodeState{{Node: consensus.Node{Index: 0, Name: "validator-0"}, Height: 10, AppHash: "HASH"}},
+		Hypotheses:      []consensus.Hypothesis{{Title: "Nodes computed different app hashes", Confidence: consensus.ConfidenceHigh}},
+	})
+
+	if resp.Height != 11 || resp.Round != 1 || !resp.Stalled || !resp.LatestBlockTime.AsTime().Equal(blockTime) {
+		t.Errorf("unexpected round state: %v", resp)
+	}
+	if len(resp.Validators) != 1 || resp.Validators[0].Prevote != "nil" {
+		t.Errorf("unexpected validators: %v", resp.Validators)
+	}
+	if len(resp.Nodes) != 1 || resp.Nodes[0].AppHash != "HASH" {
+		t.Errorf("unexpected nodes: %v", resp.Nodes)
+	}
+	if len(resp.Hypotheses) != 1 || resp.Hypotheses[0].Confidence != "high" {
+		t.Errorf("unexpected hypotheses: %v", resp.Hypotheses)
+	}
+}
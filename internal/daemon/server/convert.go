@@ -1,10 +1,13 @@
 package server
 
 import (
+	"maps"
+	"slices"
 	"time"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -98,13 +101,61 @@ func specsEqual(a types.DevnetSpec, b *v1.DevnetSpec) bool {
 		a.NetworkType == b.NetworkType &&
 		a.Validators == int(b.Validators) &&
 		a.FullNodes == int(b.FullNodes) &&
+		a.Accounts == int(b.Accounts) &&
 		a.Mode == b.Mode &&
 		a.BinarySource.Version == b.SdkVersion &&
 		a.GenesisPath == b.GenesisPath &&
 		a.SnapshotURL == b.SnapshotUrl &&
 		a.RPCURL == b.RpcUrl &&
 		a.ForkNetwork == b.ForkNetwork &&
-		a.ChainID == b.ChainId
+		a.ChainID == b.ChainId &&
+		a.Image == b.Image &&
+		a.Profile == b.Profile &&
+		a.ForceBuild == b.ForceBuild &&
+		a.AllowUnverified == b.AllowUnverified &&
+		a.Offline == b.Offline &&
+		a.SkipDiskCheck == b.SkipDiskCheck &&
+		a.TTL == b.Ttl &&
+		a.DeleteOnExpiry == b.DeleteOnExpiry &&
+		a.IdleTimeout == b.IdleTimeout &&
+		a.BlockTime == b.BlockTime &&
+		a.EpochDuration == b.EpochDuration &&
+		a.Ports == portLayoutFromProto(b.Ports) &&
+		a.BindAddress == b.BindAddress &&
+		labelsEqual(a.GenesisOverrides, b.GenesisOverrides) &&
+		fundedAccountsEqual(a.FundedAccounts, fundedAccountsFromProto(b.FundedAccounts)) &&
+		slices.Equal(a.ValidatorKeys, validatorKeysFromProto(b.ValidatorKeys)) &&
+		slices.Equal(a.RemoteSigners, remoteSignersFromProto(b.RemoteSigners)) &&
+		slices.Equal(a.NodeConfigs, nodeConfigsFromProto(b.NodeConfigs)) &&
+		slices.EqualFunc(a.Webhooks, webhooksFromProto(b.Webhooks), webhookEqual) &&
+		hooksEqual(a.Hooks, hooksFromProto(b.Hooks)) &&
+		bootstrapEqual(a.Bootstrap, bootstrapFromProto(b.Bootstrap)) &&
+		slices.Equal(a.Hosts, remoteHostsFromProto(b.Hosts)) &&
+		networkShapingEqual(a.NetworkShaping, networkShapingFromProto(b.NetworkShaping)) &&
+		a.Seed == b.Seed &&
+		a.GenesisPrune == genesisPruneFromProto(b.GenesisPrune) &&
+		votingPowerEqual(a.VotingPower, votingPowerFromProto(b.VotingPower))
+}
+
+// fundedAccountsEqual compares two funded account lists for equality.
+func fundedAccountsEqual(a, b []types.FundedAccount) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Address != b[i].Address || a[i].Coins != b[i].Coins {
+			return false
+		}
+		va, vb := a[i].Vesting, b[i].Vesting
+		if (va == nil) != (vb == nil) {
+			return false
+		}
+		if va != nil && (va.Type != vb.Type || va.Coins != vb.Coins ||
+			!va.StartTime.Equal(vb.StartTime) || !va.EndTime.Equal(vb.EndTime)) {
+			return false
+		}
+	}
+	return true
 }
 
 // labelsEqual compares two label maps for equality.
@@ -157,18 +208,498 @@ func metadataFromProto(pb *v1.DevnetMetadata) types.ResourceMeta {
 
 func specToProto(s *types.DevnetSpec) *v1.DevnetSpec {
 	return &v1.DevnetSpec{
-		Plugin:      s.Plugin,
-		NetworkType: s.NetworkType,
-		Validators:  int32(s.Validators),
-		FullNodes:   int32(s.FullNodes),
-		Mode:        s.Mode,
-		SdkVersion:  s.BinarySource.Version,
-		GenesisPath: s.GenesisPath,
-		SnapshotUrl: s.SnapshotURL,
-		RpcUrl:      s.RPCURL,
-		ForkNetwork: s.ForkNetwork,
-		ChainId:     s.ChainID,
+		Plugin:          s.Plugin,
+		NetworkType:     s.NetworkType,
+		Validators:      int32(s.Validators),
+		FullNodes:       int32(s.FullNodes),
+		Accounts:        int32(s.Accounts),
+		Mode:            s.Mode,
+		SdkVersion:      s.BinarySource.Version,
+		GenesisPath:     s.GenesisPath,
+		SnapshotUrl:     s.SnapshotURL,
+		RpcUrl:          s.RPCURL,
+		ForkNetwork:     s.ForkNetwork,
+		ChainId:         s.ChainID,
+		Image:           s.Image,
+		Profile:         s.Profile,
+		ForceBuild:      s.ForceBuild,
+		AllowUnverified: s.AllowUnverified,
+		Offline:         s.Offline,
+		Ttl:             s.TTL,
+
+		DeleteOnExpiry:   s.DeleteOnExpiry,
+		IdleTimeout:      s.IdleTimeout,
+		BlockTime:        s.BlockTime,
+		EpochDuration:    s.EpochDuration,
+		SkipDiskCheck:    s.SkipDiskCheck,
+		GenesisOverrides: s.GenesisOverrides,
+		FundedAccounts:   fundedAccountsToProto(s.FundedAccounts),
+		ValidatorKeys:    validatorKeysToProto(s.ValidatorKeys),
+		RemoteSigners:    remoteSignersToProto(s.RemoteSigners),
+		NodeConfigs:      nodeConfigsToProto(s.NodeConfigs),
+		Webhooks:         webhooksToProto(s.Webhooks),
+		Hooks:            hooksToProto(s.Hooks),
+		Bootstrap:        bootstrapToProto(s.Bootstrap),
+		Hosts:            remoteHostsToProto(s.Hosts),
+		NetworkShaping:   networkShapingToProto(s.NetworkShaping),
+		Seed:             s.Seed,
+		GenesisPrune:     genesisPruneToProto(s.GenesisPrune),
+		VotingPower:      votingPowerToProto(s.VotingPower),
+		Ports:            portLayoutToProto(s.Ports),
+		BindAddress:      s.BindAddress,
+	}
+}
+
+func portLayoutToProto(p types.PortConfig) *v1.PortLayout {
+	if p == (types.PortConfig{}) {
+		return nil
+	}
+	return &v1.PortLayout{
+		P2P:    int32(p.BaseP2P),
+		Rpc:    int32(p.BaseRPC),
+		Rest:   int32(p.BaseAPI),
+		Grpc:   int32(p.BaseGRPC),
+		EvmRpc: int32(p.BaseEVMRPC),
+		Stride: int32(p.Stride),
+	}
+}
+
+func portLayoutFromProto(pb *v1.PortLayout) types.PortConfig {
+	if pb == nil {
+		return types.PortConfig{}
+	}
+	return types.PortConfig{
+		BaseP2P:    int(pb.P2P),
+		BaseRPC:    int(pb.Rpc),
+		BaseAPI:    int(pb.Rest),
+		BaseGRPC:   int(pb.Grpc),
+		BaseEVMRPC: int(pb.EvmRpc),
+		Stride:     int(pb.Stride),
+	}
+}
+
+func genesisPruneToProto(p types.GenesisPrune) *v1.GenesisPrune {
+	if p == (types.GenesisPrune{}) {
+		return nil
+	}
+	return &v1.GenesisPrune{
+		IbcPackets:    p.IBCPackets,
+		DustThreshold: p.DustThreshold,
+		WasmCode:      p.WasmCode,
+	}
+}
+
+func genesisPruneFromProto(pb *v1.GenesisPrune) types.GenesisPrune {
+	if pb == nil {
+		return types.GenesisPrune{}
+	}
+	return types.GenesisPrune{
+		IBCPackets:    pb.IbcPackets,
+		DustThreshold: pb.DustThreshold,
+		WasmCode:      pb.WasmCode,
+	}
+}
+
+func votingPowerToProto(p types.VotingPower) *v1.VotingPower {
+	if p.Profile == "" && len(p.Weights) == 0 {
+		return nil
+	}
+	return &v1.VotingPower{Profile: p.Profile, Weights: p.Weights}
+}
+
+func votingPowerFromProto(pb *v1.VotingPower) types.VotingPower {
+	if pb == nil {
+		return types.VotingPower{}
+	}
+	return types.VotingPower{Profile: pb.Profile, Weights: pb.Weights}
+}
+
+// votingPowerEqual compares two voting power splits for equality.
+func votingPowerEqual(a, b types.VotingPower) bool {
+	return a.Profile == b.Profile && slices.Equal(a.Weights, b.Weights)
+}
+
+// nodePortsToProto converts a node's ports to the proto port config.
+func nodePortsToProto(p dvbtypes.PortConfig) *v1.NetworkPortConfig {
+	return &v1.NetworkPortConfig{
+		Rpc:       int32(p.RPC),
+		P2P:       int32(p.P2P),
+		Grpc:      int32(p.GRPC),
+		GrpcWeb:   int32(p.GRPCWeb),
+		Api:       int32(p.API),
+		EvmRpc:    int32(p.EVMRPC),
+		EvmSocket: int32(p.EVMWS),
+	}
+}
+
+func fundedAccountsToProto(accounts []types.FundedAccount) []*v1.FundedAccount {
+	if len(accounts) == 0 {
+		return nil
+	}
+	out := make([]*v1.FundedAccount, 0, len(accounts))
+	for _, acc := range accounts {
+		pb := &v1.FundedAccount{Address: acc.Address, Coins: acc.Coins}
+		if v := acc.Vesting; v != nil {
+			pb.Vesting = &v1.VestingSchedule{Type: v.Type, Coins: v.Coins}
+			if !v.StartTime.IsZero() {
+				pb.Vesting.StartTime = timestamppb.New(v.StartTime)
+			}
+			if !v.EndTime.IsZero() {
+				pb.Vesting.EndTime = timestamppb.New(v.EndTime)
+			}
+		}
+		out = append(out, pb)
+	}
+	return out
+}
+
+func fundedAccountsFromProto(pbs []*v1.FundedAccount) []types.FundedAccount {
+	if len(pbs) == 0 {
+		return nil
 	}
+	out := make([]types.FundedAccount, 0, len(pbs))
+	for _, pb := range pbs {
+		acc := types.FundedAccount{Address: pb.Address, Coins: pb.Coins}
+		if v := pb.Vesting; v != nil {
+			acc.Vesting = &types.VestingSchedule{Type: v.Type, Coins: v.Coins}
+			if v.StartTime != nil {
+				acc.Vesting.StartTime = v.StartTime.AsTime()
+			}
+			if v.EndTime != nil {
+				acc.Vesting.EndTime = v.EndTime.AsTime()
+			}
+		}
+		out = append(out, acc)
+	}
+	return out
+}
+
+func validatorKeysToProto(keys []types.ValidatorKeys) []*v1.ValidatorKeys {
+	if len(keys) == 0 {
+		return nil
+	}
+	out := make([]*v1.ValidatorKeys, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, &v1.ValidatorKeys{
+			Index:            int32(k.Index),
+			PrivValidatorKey: k.PrivValidatorKey,
+			NodeKey:          k.NodeKey,
+		})
+	}
+	return out
+}
+
+func validatorKeysFromProto(pbs []*v1.ValidatorKeys) []types.ValidatorKeys {
+	if len(pbs) == 0 {
+		return nil
+	}
+	out := make([]types.ValidatorKeys, 0, len(pbs))
+	for _, pb := range pbs {
+		out = append(out, types.ValidatorKeys{
+			Index:            int(pb.Index),
+			PrivValidatorKey: pb.PrivValidatorKey,
+			NodeKey:          pb.NodeKey,
+		})
+	}
+	return out
+}
+
+func remoteSignersToProto(signers []types.RemoteSigner) []*v1.RemoteSigner {
+	if len(signers) == 0 {
+		return nil
+	}
+	out := make([]*v1.RemoteSigner, 0, len(signers))
+	for _, s := range signers {
+		out = append(out, &v1.RemoteSigner{
+			Index:           int32(s.Index),
+			Tmkms:           s.TMKMS,
+			Image:           s.Image,
+			ProtocolVersion: s.ProtocolVersion,
+		})
+	}
+	return out
+}
+
+func remoteSignersFromProto(pbs []*v1.RemoteSigner) []types.RemoteSigner {
+	if len(pbs) == 0 {
+		return nil
+	}
+	out := make([]types.RemoteSigner, 0, len(pbs))
+	for _, pb := range pbs {
+		out = append(out, types.RemoteSigner{
+			Index:           int(pb.Index),
+			TMKMS:           pb.Tmkms,
+			Image:           pb.Image,
+			ProtocolVersion: pb.ProtocolVersion,
+		})
+	}
+	return out
+}
+
+func remoteHostsToProto(hosts []types.RemoteHost) []*v1.RemoteHost {
+	if len(hosts) == 0 {
+		return nil
+	}
+	out := make([]*v1.RemoteHost, 0, len(hosts))
+	for _, h := range hosts {
+		out = append(out, &v1.RemoteHost{
+			Name:         h.Name,
+			Address:      h.Address,
+			User:         h.User,
+			Port:         int32(h.Port),
+			IdentityFile: h.IdentityFile,
+			Dir:          h.Dir,
+		})
+	}
+	return out
+}
+
+func remoteHostsFromProto(pbs []*v1.RemoteHost) []types.RemoteHost {
+	if len(pbs) == 0 {
+		return nil
+	}
+	out := make([]types.RemoteHost, 0, len(pbs))
+	for _, pb := range pbs {
+		out = append(out, types.RemoteHost{
+			Name:         pb.Name,
+			Address:      pb.Address,
+			User:         pb.User,
+			Port:         int(pb.Port),
+			IdentityFile: pb.IdentityFile,
+			Dir:          pb.Dir,
+		})
+	}
+	return out
+}
+
+func nodeConfigsToProto(configs []types.NodeConfig) []*v1.NodeConfig {
+	if len(configs) == 0 {
+		return nil
+	}
+	out := make([]*v1.NodeConfig, 0, len(configs))
+	for _, c := range configs {
+		out = append(out, &v1.NodeConfig{
+			Index:      int32(c.Index),
+			ConfigToml: c.ConfigToml,
+			AppToml:    c.AppToml,
+		})
+	}
+	return out
+}
+
+func nodeConfigsFromProto(pbs []*v1.NodeConfig) []types.NodeConfig {
+	if len(pbs) == 0 {
+		return nil
+	}
+	out := make([]types.NodeConfig, 0, len(pbs))
+	for _, pb := range pbs {
+		out = append(out, types.NodeConfig{
+			Index:      int(pb.Index),
+			ConfigToml: pb.ConfigToml,
+			AppToml:    pb.AppToml,
+		})
+	}
+	return out
+}
+
+func webhooksToProto(webhooks []types.Webhook) []*v1.Webhook {
+	if len(webhooks) == 0 {
+		return nil
+	}
+	out := make([]*v1.Webhook, 0, len(webhooks))
+	for _, w := range webhooks {
+		out = append(out, &v1.Webhook{
+			Url:      w.URL,
+			Format:   w.Format,
+			Events:   w.Events,
+			Template: w.Template,
+		})
+	}
+	return out
+}
+
+func webhooksFromProto(pbs []*v1.Webhook) []types.Webhook {
+	if len(pbs) == 0 {
+		return nil
+	}
+	out := make([]types.Webhook, 0, len(pbs))
+	for _, pb := range pbs {
+		out = append(out, types.Webhook{
+			URL:      pb.Url,
+			Format:   pb.Format,
+			Events:   pb.Events,
+			Template: pb.Template,
+		})
+	}
+	return out
+}
+
+// webhookEqual compares two webhooks for equality.
+func webhookEqual(a, b types.Webhook) bool {
+	return a.URL == b.URL && a.Format == b.Format && a.Template == b.Template && slices.Equal(a.Events, b.Events)
+}
+
+func hooksToProto(h types.Hooks) *v1.Hooks {
+	if len(h.PostFork) == 0 && len(h.PreStart) == 0 && len(h.PostHealthy) == 0 {
+		return nil
+	}
+	return &v1.Hooks{
+		PostFork:    hookListToProto(h.PostFork),
+		PreStart:    hookListToProto(h.PreStart),
+		PostHealthy: hookListToProto(h.PostHealthy),
+	}
+}
+
+func hookListToProto(hooks []types.Hook) []*v1.Hook {
+	if len(hooks) == 0 {
+		return nil
+	}
+	out := make([]*v1.Hook, 0, len(hooks))
+	for _, h := range hooks {
+		out = append(out, &v1.Hook{
+			Name:      h.Name,
+			Command:   h.Command,
+			Script:    h.Script,
+			Image:     h.Image,
+			Timeout:   h.Timeout,
+			OnFailure: h.OnFailure,
+		})
+	}
+	return out
+}
+
+func hooksFromProto(pb *v1.Hooks) types.Hooks {
+	if pb == nil {
+		return types.Hooks{}
+	}
+	return types.Hooks{
+		PostFork:    hookListFromProto(pb.PostFork),
+		PreStart:    hookListFromProto(pb.PreStart),
+		PostHealthy: hookListFromProto(pb.PostHealthy),
+	}
+}
+
+func hookListFromProto(pbs []*v1.Hook) []types.Hook {
+	if len(pbs) == 0 {
+		return nil
+	}
+	out := make([]types.Hook, 0, len(pbs))
+	for _, pb := range pbs {
+		out = append(out, types.Hook{
+			Name:      pb.Name,
+			Command:   pb.Command,
+			Script:    pb.Script,
+			Image:     pb.Image,
+			Timeout:   pb.Timeout,
+			OnFailure: pb.OnFailure,
+		})
+	}
+	return out
+}
+
+// hooksEqual compares two sets of lifecycle hooks for equality.
+func hooksEqual(a, b types.Hooks) bool {
+	return slices.Equal(a.PostFork, b.PostFork) &&
+		slices.Equal(a.PreStart, b.PreStart) &&
+		slices.Equal(a.PostHealthy, b.PostHealthy)
+}
+
+func bootstrapToProto(b types.Bootstrap) *v1.Bootstrap {
+	if b.GasPrice == "" && len(b.Contracts) == 0 {
+		return nil
+	}
+	pb := &v1.Bootstrap{GasPrice: b.GasPrice}
+	for _, c := range b.Contracts {
+		pb.Contracts = append(pb.Contracts, &v1.Contract{
+			Name:    c.Name,
+			Type:    c.Type,
+			Source:  c.Source,
+			InitMsg: c.InitMsg,
+			Args:    c.Args,
+			Label:   c.Label,
+			Admin:   c.Admin,
+			Funds:   c.Funds,
+		})
+	}
+	return pb
+}
+
+func bootstrapFromProto(pb *v1.Bootstrap) types.Bootstrap {
+	if pb == nil {
+		return types.Bootstrap{}
+	}
+	b := types.Bootstrap{GasPrice: pb.GasPrice}
+	for _, c := range pb.Contracts {
+		b.Contracts = append(b.Contracts, types.Contract{
+			Name:    c.Name,
+			Type:    c.Type,
+			Source:  c.Source,
+			InitMsg: c.InitMsg,
+			Args:    c.Args,
+			Label:   c.Label,
+			Admin:   c.Admin,
+			Funds:   c.Funds,
+		})
+	}
+	return b
+}
+
+// bootstrapEqual compares two bootstrap specs for equality.
+func bootstrapEqual(a, b types.Bootstrap) bool {
+	return a.GasPrice == b.GasPrice && slices.Equal(a.Contracts, b.Contracts)
+}
+
+func networkShapingToProto(n types.NetworkShaping) *v1.NetworkShaping {
+	if len(n.Regions) == 0 && len(n.Links) == 0 && n.Image == "" {
+		return nil
+	}
+	pb := &v1.NetworkShaping{Image: n.Image}
+	if len(n.Regions) > 0 {
+		pb.Regions = make(map[int32]string, len(n.Regions))
+		for index, region := range n.Regions {
+			pb.Regions[int32(index)] = region
+		}
+	}
+	for _, l := range n.Links {
+		pb.Links = append(pb.Links, &v1.NetworkLink{
+			From:    l.From,
+			To:      l.To,
+			Latency: l.Latency,
+			Jitter:  l.Jitter,
+			Loss:    l.Loss,
+			Rate:    l.Rate,
+		})
+	}
+	return pb
+}
+
+func networkShapingFromProto(pb *v1.NetworkShaping) types.NetworkShaping {
+	if pb == nil {
+		return types.NetworkShaping{}
+	}
+	n := types.NetworkShaping{Image: pb.Image}
+	if len(pb.Regions) > 0 {
+		n.Regions = make(map[int]string, len(pb.Regions))
+		for index, region := range pb.Regions {
+			n.Regions[int(index)] = region
+		}
+	}
+	for _, l := range pb.Links {
+		n.Links = append(n.Links, types.NetworkLink{
+			From:    l.From,
+			To:      l.To,
+			Latency: l.Latency,
+			Jitter:  l.Jitter,
+			Loss:    l.Loss,
+			Rate:    l.Rate,
+		})
+	}
+	return n
+}
+
+// networkShapingEqual compares two network shaping specs for equality.
+func networkShapingEqual(a, b types.NetworkShaping) bool {
+	return a.Image == b.Image && maps.Equal(a.Regions, b.Regions) && slices.Equal(a.Links, b.Links)
 }
 
 func specFromProto(pb *v1.DevnetSpec) types.DevnetSpec {
@@ -177,16 +708,44 @@ func specFromProto(pb *v1.DevnetSpec) types.DevnetSpec {
 	}
 
 	return types.DevnetSpec{
-		Plugin:      pb.Plugin,
-		NetworkType: pb.NetworkType,
-		Validators:  int(pb.Validators),
-		FullNodes:   int(pb.FullNodes),
-		Mode:        pb.Mode,
-		GenesisPath: pb.GenesisPath,
-		SnapshotURL: pb.SnapshotUrl,
-		RPCURL:      pb.RpcUrl,
-		ForkNetwork: pb.ForkNetwork,
-		ChainID:     pb.ChainId,
+		Plugin:          pb.Plugin,
+		NetworkType:     pb.NetworkType,
+		Validators:      int(pb.Validators),
+		FullNodes:       int(pb.FullNodes),
+		Accounts:        int(pb.Accounts),
+		Mode:            pb.Mode,
+		GenesisPath:     pb.GenesisPath,
+		SnapshotURL:     pb.SnapshotUrl,
+		RPCURL:          pb.RpcUrl,
+		ForkNetwork:     pb.ForkNetwork,
+		ChainID:         pb.ChainId,
+		Image:           pb.Image,
+		Profile:         pb.Profile,
+		ForceBuild:      pb.ForceBuild,
+		AllowUnverified: pb.AllowUnverified,
+		Offline:         pb.Offline,
+		TTL:             pb.Ttl,
+
+		DeleteOnExpiry:   pb.DeleteOnExpiry,
+		IdleTimeout:      pb.IdleTimeout,
+		BlockTime:        pb.BlockTime,
+		EpochDuration:    pb.EpochDuration,
+		SkipDiskCheck:    pb.SkipDiskCheck,
+		GenesisOverrides: pb.GenesisOverrides,
+		FundedAccounts:   fundedAccountsFromProto(pb.FundedAccounts),
+		ValidatorKeys:    validatorKeysFromProto(pb.ValidatorKeys),
+		RemoteSigners:    remoteSignersFromProto(pb.RemoteSigners),
+		NodeConfigs:      nodeConfigsFromProto(pb.NodeConfigs),
+		Webhooks:         webhooksFromProto(pb.Webhooks),
+		Hooks:            hooksFromProto(pb.Hooks),
+		Bootstrap:        bootstrapFromProto(pb.Bootstrap),
+		Hosts:            remoteHostsFromProto(pb.Hosts),
+		NetworkShaping:   networkShapingFromProto(pb.NetworkShaping),
+		Seed:             pb.Seed,
+		GenesisPrune:     genesisPruneFromProto(pb.GenesisPrune),
+		VotingPower:      votingPowerFromProto(pb.VotingPower),
+		Ports:            portLayoutFromProto(pb.Ports),
+		BindAddress:      pb.BindAddress,
 		BinarySource: types.BinarySource{
 			Version: pb.SdkVersion,
 		},
@@ -194,31 +753,15 @@ func specFromProto(pb *v1.DevnetSpec) types.DevnetSpec {
 }
 
 func statusToProto(s *types.DevnetStatus) *v1.DevnetStatus {
-	// Convert conditions
-	var conditions []*v1.Condition
-	for _, c := range s.Conditions {
-		conditions = append(conditions, &v1.Condition{
-			Type:               c.Type,
-			Status:             c.Status,
-			LastTransitionTime: timestamppb.New(c.LastTransitionTime),
-			Reason:             c.Reason,
-			Message:            c.Message,
-		})
-	}
+	conditions := conditionsToProto(s.Conditions)
 
 	// Convert events
 	var events []*v1.Event
 	for _, e := range s.Events {
-		events = append(events, &v1.Event{
-			Timestamp: timestamppb.New(e.Timestamp),
-			Type:      e.Type,
-			Reason:    e.Reason,
-			Message:   e.Message,
-			Component: e.Component,
-		})
+		events = append(events, eventToProto(e))
 	}
 
-	return &v1.DevnetStatus{
+	pb := &v1.DevnetStatus{
 		Phase:           s.Phase,
 		Nodes:           int32(s.Nodes),
 		ReadyNodes:      int32(s.ReadyNodes),
@@ -229,7 +772,13 @@ func statusToProto(s *types.DevnetStatus) *v1.DevnetStatus {
 		Conditions:      conditions,
 		Events:          events,
 		Subnet:          uint32(s.Subnet),
+		Image:           s.Image,
+		ImageDigest:     s.ImageDigest,
 	}
+	if !s.ExpiresAt.IsZero() {
+		pb.ExpiresAt = timestamppb.New(s.ExpiresAt)
+	}
+	return pb
 }
 
 func statusFromProto(pb *v1.DevnetStatus) types.DevnetStatus {
@@ -237,20 +786,7 @@ func statusFromProto(pb *v1.DevnetStatus) types.DevnetStatus {
 		return types.DevnetStatus{}
 	}
 
-	// Convert conditions
-	var conditions []types.Condition
-	for _, c := range pb.Conditions {
-		cond := types.Condition{
-			Type:    c.Type,
-			Status:  c.Status,
-			Reason:  c.Reason,
-			Message: c.Message,
-		}
-		if c.LastTransitionTime != nil {
-			cond.LastTransitionTime = c.LastTransitionTime.AsTime()
-		}
-		conditions = append(conditions, cond)
-	}
+	conditions := conditionsFromProto(pb.Conditions)
 
 	// Convert events
 	var events []types.Event
@@ -277,11 +813,16 @@ func statusFromProto(pb *v1.DevnetStatus) types.DevnetStatus {
 		Message:       pb.Message,
 		Conditions:    conditions,
 		Events:        events,
+		Image:         pb.Image,
+		ImageDigest:   pb.ImageDigest,
 	}
 
 	if pb.LastHealthCheck != nil {
 		s.LastHealthCheck = pb.LastHealthCheck.AsTime()
 	}
+	if pb.ExpiresAt != nil {
+		s.ExpiresAt = pb.ExpiresAt.AsTime()
+	}
 
 	return s
 }
@@ -384,6 +925,7 @@ func upgradeSpecToProto(s *types.UpgradeSpec) *v1.UpgradeSpec {
 		NewBinary:    binarySourceToProto(&s.NewBinary),
 		WithExport:   s.WithExport,
 		AutoVote:     s.AutoVote,
+		Mode:         s.Mode,
 	}
 }
 
@@ -399,6 +941,7 @@ func upgradeSpecFromProto(pb *v1.UpgradeSpec) types.UpgradeSpec {
 		NewBinary:    binarySourceFromProto(pb.NewBinary),
 		WithExport:   pb.WithExport,
 		AutoVote:     pb.AutoVote,
+		Mode:         pb.Mode,
 	}
 }
 
@@ -413,6 +956,10 @@ func upgradeStatusToProto(s *types.UpgradeStatus) *v1.UpgradeStatus {
 		PostExportPath: s.PostExportPath,
 		Message:        s.Message,
 		Error:          s.Error,
+
+		ForkDevnetRef:   s.ForkDevnetRef,
+		ForkStartHeight: s.ForkStartHeight,
+		ForkHeight:      s.ForkHeight,
 	}
 }
 
@@ -431,6 +978,10 @@ func upgradeStatusFromProto(pb *v1.UpgradeStatus) types.UpgradeStatus {
 		PostExportPath: pb.PostExportPath,
 		Message:        pb.Message,
 		Error:          pb.Error,
+
+		ForkDevnetRef:   pb.ForkDevnetRef,
+		ForkStartHeight: pb.ForkStartHeight,
+		ForkHeight:      pb.ForkHeight,
 	}
 }
 
@@ -459,3 +1010,36 @@ func binarySourceFromProto(pb *v1.BinarySource) types.BinarySource {
 		Path:    pb.Path,
 	}
 }
+
+// conditionsToProto converts domain Conditions to proto.
+func conditionsToProto(conditions []types.Condition) []*v1.Condition {
+	var result []*v1.Condition
+	for _, c := range conditions {
+		result = append(result, &v1.Condition{
+			Type:               c.Type,
+			Status:             c.Status,
+			LastTransitionTime: timestamppb.New(c.LastTransitionTime),
+			Reason:             c.Reason,
+			Message:            c.Message,
+		})
+	}
+	return result
+}
+
+// conditionsFromProto converts proto Conditions to domain.
+func conditionsFromProto(conditions []*v1.Condition) []types.Condition {
+	var result []types.Condition
+	for _, c := range conditions {
+		cond := types.Condition{
+			Type:    c.Type,
+			Status:  c.Status,
+			Reason:  c.Reason,
+			Message: c.Message,
+		}
+		if c.LastTransitionTime != nil {
+			cond.LastTransitionTime = c.LastTransitionTime.AsTime()
+		}
+		result = append(result, cond)
+	}
+	return result
+}
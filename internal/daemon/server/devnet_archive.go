@@ -0,0 +1,519 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/archive"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/subnet"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// archiveChunkSize is the most archive data sent in one message, well
+// under the default gRPC message limit.
+const archiveChunkSize = 1 << 20
+
+// SetBinaryBuilder sets the builder imports use to build the binaries of
+// nodes whose binary does not exist on this machine.
+func (s *DevnetService) SetBinaryBuilder(b builder.BinaryBuilder) {
+	s.binaryBuilder = b
+}
+
+// ExportDevnet streams a portable archive of a devnet: its resources and
+// every node home, including keyrings and chain data. Binaries are
+// referenced by path, image and version but not included. Running nodes
+// would change their chain data while it is read, so the devnet must be
+// stopped unless req.Live is set.
+func (s *DevnetService) ExportDevnet(req *v1.ExportDevnetRequest, stream grpc.ServerStreamingServer[v1.ArchiveChunk]) error {
+	if req.DevnetName == "" {
+		return status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+	ctx := stream.Context()
+
+	devnet, err := s.store.GetDevnet(ctx, namespace, req.DevnetName)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return status.Errorf(codes.NotFound, "devnet %q not found", req.DevnetName)
+		}
+		return status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+	nodes, err := s.store.ListNodes(ctx, namespace, req.DevnetName)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list nodes: %v", err)
+	}
+	if len(nodes) == 0 {
+		return status.Errorf(codes.FailedPrecondition, "devnet %q has no nodes to export; provision it first", req.DevnetName)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Spec.Index < nodes[j].Spec.Index })
+
+	if !req.Live {
+		var running []string
+		for _, node := range nodes {
+			if node.Status.Phase == types.NodePhaseRunning || node.Status.Phase == types.NodePhaseStarting {
+				running = append(running, node.Metadata.Name)
+			}
+		}
+		if len(running) > 0 {
+			return status.Errorf(codes.FailedPrecondition,
+				"devnet %q has running nodes (%s); stop it first or export it live", req.DevnetName, strings.Join(running, ", "))
+		}
+	}
+
+	s.logger.Info("exporting devnet", "namespace", namespace, "name", req.DevnetName, "nodes", len(nodes), "live", req.Live)
+
+	w := bufio.NewWriterSize(chunkWriter{stream: stream}, archiveChunkSize)
+	if err := archive.Write(w, devnet, nodes, req.Live); err != nil {
+		return status.Errorf(codes.Internal, "failed to export devnet: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "failed to export devnet: %v", err)
+	}
+	return nil
+}
+
+// chunkWriter sends what is written to it as archive chunks.
+type chunkWriter struct {
+	stream grpc.ServerStreamingServer[v1.ArchiveChunk]
+}
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	for sent := 0; sent < len(p); {
+		end := min(sent+archiveChunkSize, len(p))
+		if err := w.stream.Send(&v1.ArchiveChunk{Data: p[sent:end]}); err != nil {
+			return sent, err
+		}
+		sent = end
+	}
+	return len(p), nil
+}
+
+// ImportDevnet creates a devnet from an archive written by ExportDevnet,
+// under the name in the first message or the archived name. Node homes are
+// unpacked into this daemon's data directory and nodes get addresses in a
+// newly allocated subnet. Local nodes get binaries of their version,
+// resolved after the import returns; the devnet's BinariesReady condition
+// tracks them. The devnet is imported stopped; problems that keep it from
+// starting are returned as warnings.
+func (s *DevnetService) ImportDevnet(stream grpc.ClientStreamingServer[v1.ImportDevnetRequest, v1.ImportDevnetResponse]) error {
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "archive is empty")
+	}
+	if err != nil {
+		return err
+	}
+	if s.dataDir == "" {
+		return status.Error(codes.Unavailable, "data directory not configured")
+	}
+
+	namespace := first.GetNamespace()
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+	if first.Name != "" {
+		if err := validateImportName(first.Name); err != nil {
+			return err
+		}
+	}
+
+	// Homes are unpacked into a staging directory that becomes the devnet
+	// directory once the archive is complete. They are staged by index and
+	// renamed after their monikers once the devnet's name is known.
+	staging, err := os.MkdirTemp(s.dataDir, ".import-")
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to create staging directory: %v", err)
+	}
+	defer os.RemoveAll(staging)
+
+	staged := make(map[int]bool)
+	contents, err := archive.Read(&importReader{stream: stream, buf: first.Data}, func(node *types.Node) (string, error) {
+		if node.Spec.Index < 0 || staged[node.Spec.Index] {
+			return "", fmt.Errorf("invalid node %d in archive", node.Spec.Index)
+		}
+		staged[node.Spec.Index] = true
+		return stagedHome(staging, node), nil
+	})
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to read archive: %v", err)
+	}
+
+	name := first.Name
+	if name == "" {
+		name = contents.Devnet.Metadata.Name
+		if err := validateImportName(name); err != nil {
+			return err
+		}
+	}
+	for _, node := range contents.Nodes {
+		if node.Spec.DevnetRef != contents.Devnet.Metadata.Name {
+			return status.Errorf(codes.InvalidArgument, "node %d in archive belongs to devnet %q, not the archived %q",
+				node.Spec.Index, node.Spec.DevnetRef, contents.Devnet.Metadata.Name)
+		}
+		if moniker := nodeMoniker(name, node); !filepath.IsLocal(moniker) || filepath.Base(moniker) != moniker {
+			return status.Errorf(codes.InvalidArgument, "invalid node %d in archive", node.Spec.Index)
+		}
+	}
+	if _, err := s.store.GetDevnet(ctx, namespace, name); err == nil {
+		return status.Errorf(codes.AlreadyExists, "devnet %q already exists; import it under another name", name)
+	} else if !store.IsNotFound(err) {
+		return status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+	if err := checkNamespaceQuota(ctx, s.store, namespace, name, len(contents.Nodes)); err != nil {
+		return err
+	}
+
+	devnetDir := filepath.Join(s.dataDir, name)
+	if _, err := os.Stat(devnetDir); err == nil {
+		return status.Errorf(codes.FailedPrecondition, "data directory %s already exists", devnetDir)
+	}
+
+	s.logger.Info("importing devnet",
+		"namespace", namespace,
+		"name", name,
+		"from", contents.Manifest.Namespace+"/"+contents.Manifest.Devnet,
+		"nodes", len(contents.Nodes))
+
+	devnet, nodes := contents.Devnet, contents.Nodes
+	now := time.Now()
+	devnet.Metadata.Name = name
+	devnet.Metadata.Namespace = namespace
+	devnet.Metadata.CreatedAt = now
+	devnet.Metadata.UpdatedAt = now
+	devnet.Status.Phase = types.PhaseStopped
+	devnet.Status.Message = fmt.Sprintf("Imported from %s/%s", contents.Manifest.Namespace, contents.Manifest.Devnet)
+	devnet.Status.Nodes = len(nodes)
+	devnet.Status.ReadyNodes = 0
+	devnet.Status.Conditions = nil
+	if err := resetExpiry(devnet, now); err != nil {
+		return err
+	}
+
+	// Nodes listen on the addresses of the devnet's subnet, which is taken
+	// on this daemon by another devnet or not at all
+	var warnings []string
+	rewrites := make(map[string]string)
+	if s.subnetAllocator != nil {
+		allocated, err := s.subnetAllocator.Allocate(namespace, name)
+		if err != nil {
+			return status.Errorf(codes.ResourceExhausted, "failed to allocate subnet: %v", err)
+		}
+		devnet.Status.Subnet = allocated
+		if devnet.Spec.Mode != "docker" && devnet.Spec.BindAddress == "" {
+			if err := subnet.EnsureAliases(allocated, len(nodes)); err != nil {
+				warnings = append(warnings, err.Error())
+			}
+		}
+	}
+
+	var builds []*types.Node
+	for _, node := range nodes {
+		oldHome := node.Spec.HomeDir
+		node.Metadata.Name = fmt.Sprintf("%s-node-%d", name, node.Spec.Index)
+		node.Metadata.Namespace = namespace
+		node.Metadata.CreatedAt = now
+		node.Metadata.UpdatedAt = now
+		node.Spec.DevnetRef = name
+		if node.Spec.NamespaceRef != "" {
+			node.Spec.NamespaceRef = namespace
+		}
+		node.Spec.HomeDir = filepath.Join(devnetDir, "nodes", nodeMoniker(name, node))
+		node.Spec.Desired = types.NodePhaseStopped
+		if devnet.Status.Subnet > 0 && node.Spec.Address != "" && node.Spec.BindAddress == "" {
+			address := subnet.NodeIP(devnet.Status.Subnet, node.Spec.Index)
+			rewrites[node.Spec.Address] = address
+			node.Spec.Address = address
+		}
+		node.Status = types.NodeStatus{
+			Phase:            types.NodePhaseStopped,
+			Message:          "Imported, awaiting start",
+			BlockHeight:      node.Status.BlockHeight,
+			Version:          node.Status.Version,
+			ValidatorAddress: node.Status.ValidatorAddress,
+			ValidatorPubKey:  node.Status.ValidatorPubKey,
+		}
+		if oldHome != "" {
+			rewrites[oldHome] = node.Spec.HomeDir
+		}
+
+		if devnet.Spec.Mode != "docker" {
+			// The archived binary path is never used: it names a file on the
+			// exporting machine, and an archive could point it at any
+			// executable here
+			archived := node.Spec.BinaryPath
+			node.Spec.BinaryPath = ""
+			if node.Status.Version != "" && s.binaryBuilder != nil {
+				builds = append(builds, node)
+			} else {
+				warnings = append(warnings, missingBinaryWarning(name, node, archived))
+			}
+		}
+	}
+	if len(builds) > 0 {
+		devnet.Status.Conditions = types.SetCondition(devnet.Status.Conditions,
+			types.ConditionTypeBinariesReady, types.ConditionFalse, types.ReasonBuildingBinaries,
+			fmt.Sprintf("Building binaries for %d nodes", len(builds)))
+		warnings = append(warnings, fmt.Sprintf("binaries for %d nodes are built in the background; wait for them with 'dvb wait %s --for condition=%s'",
+			len(builds), name, types.ConditionTypeBinariesReady))
+	}
+
+	for _, node := range nodes {
+		home := filepath.Join(staging, "nodes", filepath.Base(node.Spec.HomeDir))
+		if err := os.Rename(stagedHome(staging, node), home); err != nil && !os.IsNotExist(err) {
+			s.releaseImportSubnet(namespace, name)
+			return status.Errorf(codes.Internal, "failed to move home of node %d: %v", node.Spec.Index, err)
+		}
+		if err := rewriteNodeConfig(home, rewrites); err != nil {
+			s.releaseImportSubnet(namespace, name)
+			return status.Errorf(codes.Internal, "failed to rewrite config of node %d: %v", node.Spec.Index, err)
+		}
+	}
+	if err := os.Rename(staging, devnetDir); err != nil {
+		s.releaseImportSubnet(namespace, name)
+		return status.Errorf(codes.Internal, "failed to move devnet directory: %v", err)
+	}
+
+	if err := s.createImported(ctx, devnet, nodes); err != nil {
+		os.RemoveAll(devnetDir)
+		s.releaseImportSubnet(namespace, name)
+		return err
+	}
+
+	if contents.Manifest.Live {
+		warnings = append(warnings, "the archive was exported while nodes were running; their chain data may be inconsistent")
+	}
+	err = stream.SendAndClose(&v1.ImportDevnetResponse{Devnet: DevnetToProto(devnet), Warnings: warnings})
+	if len(builds) > 0 {
+		// Builds can take minutes and outlive the import stream
+		s.importBuilds.Add(1)
+		go func() {
+			defer s.importBuilds.Done()
+			s.buildImportedBinaries(context.WithoutCancel(ctx), namespace, name, builds)
+		}()
+	}
+	return err
+}
+
+// importReader reads the archive data of an import stream.
+type importReader struct {
+	stream grpc.ClientStreamingServer[v1.ImportDevnetRequest, v1.ImportDevnetResponse]
+	buf    []byte
+}
+
+func (r *importReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = msg.Data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// validateImportName checks that name can name a devnet and its data
+// directory.
+func validateImportName(name string) error {
+	if !filepath.IsLocal(name) || filepath.Base(name) != name {
+		return status.Errorf(codes.InvalidArgument, "invalid devnet name %q", name)
+	}
+	return nil
+}
+
+// stagedHome returns where an import stages a node's home until the
+// devnet's name is known.
+func stagedHome(staging string, node *types.Node) string {
+	return filepath.Join(staging, "nodes", strconv.Itoa(node.Spec.Index))
+}
+
+// nodeMoniker returns the name of a node's home directory, in the
+// provisioner's {devnet}-{role}-{index} format.
+func nodeMoniker(devnetName string, node *types.Node) string {
+	return fmt.Sprintf("%s-%s-%d", devnetName, node.Spec.Role, node.Spec.Index)
+}
+
+// missingBinaryWarning returns the warning for an imported local node left
+// without a binary.
+func missingBinaryWarning(devnetName string, node *types.Node, archived string) string {
+	return fmt.Sprintf("node %d: no binary on this machine (the archived %q is not used); set one with 'dvb node set-binary %s %d --version <version> --binary-path <path>'",
+		node.Spec.Index, archived, devnetName, node.Spec.Index)
+}
+
+// buildImportedBinaries points the local nodes of an imported devnet at
+// binaries of their version from this daemon's build cache, building them
+// if needed, and reuses binaries across nodes. It runs after the import
+// returns and reports through the devnet's BinariesReady condition.
+func (s *DevnetService) buildImportedBinaries(ctx context.Context, namespace, devnetName string, nodes []*types.Node) {
+	devnet, err := s.store.GetDevnet(ctx, namespace, devnetName)
+	if err != nil {
+		s.logger.Warn("failed to build binaries of imported devnet", "devnet", devnetName, "error", err)
+		return
+	}
+
+	var failures []string
+	resolved := make(map[string]string) // plugin@version → built binary
+	for _, node := range nodes {
+		plugin := node.Spec.Network
+		if plugin == "" {
+			plugin = devnet.Spec.Plugin
+		}
+		version := node.Status.Version
+		key := plugin + "@" + version
+		path, ok := resolved[key]
+		if !ok {
+			spec := builder.BuildSpec{GitRef: version, PluginName: plugin}
+			result, cached := s.binaryBuilder.GetCached(ctx, spec)
+			if !cached {
+				if result, err = s.binaryBuilder.Build(ctx, spec); err != nil {
+					s.logger.Warn("failed to build binary of imported node", "devnet", devnetName, "index", node.Spec.Index, "plugin", plugin, "version", version, "error", err)
+					failures = append(failures, fmt.Sprintf("node %d: building %s %s failed: %v", node.Spec.Index, plugin, version, err))
+					continue
+				}
+			}
+			path = result.BinaryPath
+			resolved[key] = path
+		}
+
+		err := store.RetryOnConflict(ctx, func() error {
+			stored, err := s.store.GetNode(ctx, namespace, devnetName, node.Spec.Index)
+			if err != nil {
+				return err
+			}
+			// A binary set while this one built wins
+			if stored.Spec.BinaryPath != "" {
+				return nil
+			}
+			stored.Spec.BinaryPath = path
+			return s.store.UpdateNode(ctx, stored)
+		})
+		if err != nil {
+			s.logger.Warn("failed to set binary of imported node", "devnet", devnetName, "index", node.Spec.Index, "error", err)
+			failures = append(failures, fmt.Sprintf("node %d: failed to set binary: %v", node.Spec.Index, err))
+		}
+	}
+
+	condStatus, reason := types.ConditionTrue, types.ReasonBinariesBuilt
+	message := fmt.Sprintf("Binaries ready for %d nodes", len(nodes))
+	if len(failures) > 0 {
+		condStatus, reason = types.ConditionFalse, types.ReasonBuildFailed
+		message = strings.Join(failures, "; ") + fmt.Sprintf("; set binaries with 'dvb node set-binary %s <index> --version <version> --binary-path <path>'", devnetName)
+	}
+	err = store.RetryOnConflict(ctx, func() error {
+		devnet, err := s.store.GetDevnet(ctx, namespace, devnetName)
+		if err != nil {
+			return err
+		}
+		devnet.Status.Conditions = types.SetCondition(devnet.Status.Conditions, types.ConditionTypeBinariesReady, condStatus, reason, message)
+		return s.store.UpdateDevnet(ctx, devnet)
+	})
+	if err != nil {
+		s.logger.Warn("failed to report binaries of imported devnet", "devnet", devnetName, "error", err)
+	}
+}
+
+// importAddressPattern matches any of the addresses in rewrites, but not
+// addresses they are a prefix of (127.0.5.1 in 127.0.5.10).
+func importAddressPattern(rewrites map[string]string) *regexp.Regexp {
+	if len(rewrites) == 0 {
+		return nil
+	}
+	quoted := make([]string, 0, len(rewrites))
+	for old := range rewrites {
+		quoted = append(quoted, regexp.QuoteMeta(old))
+	}
+	// Longer matches first, so a home directory wins over its prefixes
+	sort.Slice(quoted, func(i, j int) bool { return len(quoted[i]) > len(quoted[j]) })
+	return regexp.MustCompile(`(?:` + strings.Join(quoted, "|") + `)\b`)
+}
+
+// rewriteNodeConfig replaces the old node addresses and home directories
+// in rewrites with the imported ones in the TOML files of a node's config
+// directory, in one pass so replacements are not replaced again.
+func rewriteNodeConfig(home string, rewrites map[string]string) error {
+	pattern := importAddressPattern(rewrites)
+	if pattern == nil {
+		return nil
+	}
+	configDir := filepath.Join(home, "config")
+	return filepath.WalkDir(configDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == configDir {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".toml" {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rewritten := pattern.ReplaceAllStringFunc(string(data), func(old string) string { return rewrites[old] })
+		if rewritten == string(data) {
+			return nil
+		}
+		return os.WriteFile(path, []byte(rewritten), info.Mode().Perm())
+	})
+}
+
+// createImported stores an imported devnet and its nodes, removing them
+// again if any fails.
+func (s *DevnetService) createImported(ctx context.Context, devnet *types.Devnet, nodes []*types.Node) error {
+	if err := s.store.CreateDevnet(ctx, devnet); err != nil {
+		if store.IsAlreadyExists(err) {
+			return status.Errorf(codes.AlreadyExists, "devnet %q already exists; import it under another name", devnet.Metadata.Name)
+		}
+		return status.Errorf(codes.Internal, "failed to create devnet: %v", err)
+	}
+	for _, node := range nodes {
+		if err := s.store.CreateNode(ctx, node); err != nil {
+			namespace, name := devnet.Metadata.Namespace, devnet.Metadata.Name
+			if err := s.store.DeleteNodesByDevnet(ctx, namespace, name); err != nil {
+				s.logger.Warn("failed to remove nodes of failed import", "devnet", name, "error", err)
+			}
+			if err := s.store.DeleteDevnet(ctx, namespace, name); err != nil {
+				s.logger.Warn("failed to remove failed import", "devnet", name, "error", err)
+			}
+			return status.Errorf(codes.Internal, "failed to create node %d: %v", node.Spec.Index, err)
+		}
+	}
+	return nil
+}
+
+// releaseImportSubnet releases the subnet allocated to a failed import.
+func (s *DevnetService) releaseImportSubnet(namespace, name string) {
+	if s.subnetAllocator == nil {
+		return
+	}
+	if err := s.subnetAllocator.Release(namespace, name); err != nil {
+		s.logger.Warn("failed to release subnet of failed import", "devnet", name, "error", err)
+	}
+}
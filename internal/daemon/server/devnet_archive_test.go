@@ -0,0 +1,272 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/archive"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/subnet"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockExportStream struct {
+	grpc.ServerStream
+	data bytes.Buffer
+}
+
+func (m *mockExportStream) Context() context.Context { return context.Background() }
+
+func (m *mockExportStream) Send(chunk *v1.ArchiveChunk) error {
+	m.data.Write(chunk.Data)
+	return nil
+}
+
+// mockImportStream sends an archive in small messages, the name and
+// namespace in the first.
+type mockImportStream struct {
+	grpc.ServerStream
+	msgs []*v1.ImportDevnetRequest
+	resp *v1.ImportDevnetResponse
+}
+
+func newMockImportStream(name string, data []byte) *mockImportStream {
+	m := &mockImportStream{}
+	for len(data) > 0 {
+		n := min(len(data), 4096)
+		m.msgs = append(m.msgs, &v1.ImportDevnetRequest{Data: data[:n]})
+		data = data[n:]
+	}
+	m.msgs[0].Name = name
+	return m
+}
+
+func (m *mockImportStream) Context() context.Context { return context.Background() }
+
+func (m *mockImportStream) Recv() (*v1.ImportDevnetRequest, error) {
+	if len(m.msgs) == 0 {
+		return nil, io.EOF
+	}
+	msg := m.msgs[0]
+	m.msgs = m.msgs[1:]
+	return msg, nil
+}
+
+func (m *mockImportStream) SendAndClose(resp *v1.ImportDevnetResponse) error {
+	m.resp = resp
+	return nil
+}
+
+// createArchivableDevnet creates a stopped local devnet of two validators
+// in subnet 7, with homes under dataDir.
+func createArchivableDevnet(t *testing.T, s store.Store, dataDir string) {
+	t.Helper()
+	ctx := context.Background()
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "src", Namespace: types.DefaultNamespace},
+		Spec:     types.DevnetSpec{Plugin: "cosmos", Mode: "local", Validators: 2},
+		Status:   types.DevnetStatus{Phase: types.PhaseStopped, Subnet: 7},
+	}
+	if err := s.CreateDevnet(ctx, devnet); err != nil {
+		t.Fatal(err)
+	}
+	// The archived binary exists here too, but must not be trusted
+	binary := filepath.Join(dataDir, "gaiad")
+	if err := os.WriteFile(binary, []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		home := filepath.Join(dataDir, "src", "nodes", nodeMoniker("src", &types.Node{Spec: types.NodeSpec{Role: "validator", Index: i}}))
+		if err := os.MkdirAll(filepath.Join(home, "config"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		config := "laddr = \"tcp://" + subnet.NodeIP(7, i) + ":26657\"\n" +
+			"persistent_peers = \"id0@127.0.7.1:26656,id1@127.0.7.2:26656\"\n" +
+			"priv_validator_laddr = \"unix://" + home + "/signer.sock\"\n"
+		if err := os.WriteFile(filepath.Join(home, "config", "config.toml"), []byte(config), 0644); err != nil {
+			t.Fatal(err)
+		}
+		node := &types.Node{
+			Metadata: types.ResourceMeta{Name: "src-node-" + strconv.Itoa(i), Namespace: types.DefaultNamespace},
+			Spec: types.NodeSpec{
+				DevnetRef:  "src",
+				Index:      i,
+				Role:       "validator",
+				Network:    "cosmos",
+				HomeDir:    home,
+				BinaryPath: binary,
+				Address:    subnet.NodeIP(7, i),
+			},
+			Status: types.NodeStatus{Phase: types.NodePhaseStopped, Version: "v22.0.0", BlockHeight: 120, PID: 42},
+		}
+		if err := s.CreateNode(ctx, node); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestDevnetService_ExportImportDevnet(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	s := store.NewMemoryStore()
+	alloc, err := subnet.LoadOrCreate(filepath.Join(t.TempDir(), "subnets.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewDevnetServiceWithAnte(s, nil, nil, alloc, nil)
+	svc.SetDebugSources(nil, dataDir)
+	b := &fakeBinaryBuilder{}
+	svc.SetBinaryBuilder(b)
+	createArchivableDevnet(t, s, dataDir)
+
+	export := &mockExportStream{}
+	if err := svc.ExportDevnet(&v1.ExportDevnetRequest{DevnetName: "src"}, export); err != nil {
+		t.Fatalf("ExportDevnet: %v", err)
+	}
+
+	stream := newMockImportStream("copy", export.data.Bytes())
+	if err := svc.ImportDevnet(stream); err != nil {
+		t.Fatalf("ImportDevnet: %v", err)
+	}
+	if stream.resp.Devnet.Metadata.Name != "copy" || stream.resp.Devnet.Status.Phase != types.PhaseStopped {
+		t.Errorf("imported devnet %q in phase %q", stream.resp.Devnet.Metadata.Name, stream.resp.Devnet.Status.Phase)
+	}
+	// Binaries are built after the import returns
+	if len(stream.resp.Warnings) != 1 || !strings.Contains(stream.resp.Warnings[0], "condition="+types.ConditionTypeBinariesReady) {
+		t.Errorf("warnings = %v", stream.resp.Warnings)
+	}
+	svc.importBuilds.Wait()
+
+	devnet, err := s.GetDevnet(ctx, types.DefaultNamespace, "copy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !types.IsConditionTrue(devnet.Status.Conditions, types.ConditionTypeBinariesReady) {
+		t.Errorf("conditions = %+v", devnet.Status.Conditions)
+	}
+	newSubnet := devnet.Status.Subnet
+	if newSubnet == 0 || newSubnet == 7 {
+		t.Fatalf("subnet = %d, want a new allocation", newSubnet)
+	}
+
+	node, err := s.GetNode(ctx, types.DefaultNamespace, "copy", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantHome := filepath.Join(dataDir, "copy", "nodes", "copy-validator-1")
+	if node.Metadata.Name != "copy-node-1" || node.Spec.HomeDir != wantHome || node.Spec.Address != subnet.NodeIP(newSubnet, 1) {
+		t.Errorf("node = %s at %s on %s", node.Metadata.Name, node.Spec.HomeDir, node.Spec.Address)
+	}
+	if node.Status.Phase != types.NodePhaseStopped || node.Status.PID != 0 || node.Status.Version != "v22.0.0" || node.Status.BlockHeight != 120 {
+		t.Errorf("status = %+v", node.Status)
+	}
+	// Both nodes share one build instead of the archived binary
+	if len(b.specs) != 1 || node.Spec.BinaryPath != "/builds/cosmos-v22.0.0" {
+		t.Errorf("builds = %+v, binary = %q", b.specs, node.Spec.BinaryPath)
+	}
+
+	config, err := os.ReadFile(filepath.Join(wantHome, "config", "config.toml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	peers := "id0@" + subnet.NodeIP(newSubnet, 0) + ":26656,id1@" + subnet.NodeIP(newSubnet, 1) + ":26656"
+	for _, want := range []string{"tcp://" + subnet.NodeIP(newSubnet, 1) + ":26657", peers, "unix://" + wantHome + "/signer.sock"} {
+		if !strings.Contains(string(config), want) {
+			t.Errorf("config.toml missing %q:\n%s", want, config)
+		}
+	}
+
+	// The name is now taken
+	again := newMockImportStream("copy", export.data.Bytes())
+	if err := svc.ImportDevnet(again); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("second import: expected AlreadyExists, got %v", err)
+	}
+}
+
+func TestDevnetService_ImportDevnet_DevnetMismatch(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+	svc.SetDebugSources(nil, dataDir)
+	createArchivableDevnet(t, s, dataDir)
+
+	devnet, err := s.GetDevnet(ctx, types.DefaultNamespace, "src")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodes, err := s.ListNodes(ctx, types.DefaultNamespace, "src")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A node of another devnet would be staged under one name and
+	// configured under another
+	nodes[1].Spec.DevnetRef = "other"
+	var data bytes.Buffer
+	if err := archive.Write(&data, devnet, nodes, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := svc.ImportDevnet(newMockImportStream("copy", data.Bytes())); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dataDir, "copy")); !os.IsNotExist(err) {
+		t.Errorf("import created the devnet directory: %v", err)
+	}
+}
+
+func TestDevnetService_ExportDevnet_Running(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+	createArchivableDevnet(t, s, dataDir)
+
+	node, err := s.GetNode(ctx, types.DefaultNamespace, "src", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.Status.Phase = types.NodePhaseRunning
+	if err := s.UpdateNode(ctx, node); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := svc.ExportDevnet(&v1.ExportDevnetRequest{DevnetName: "src"}, &mockExportStream{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition, got %v", err)
+	}
+	if err := svc.ExportDevnet(&v1.ExportDevnetRequest{DevnetName: "src", Live: true}, &mockExportStream{}); err != nil {
+		t.Errorf("live export: %v", err)
+	}
+}
+
+func TestDevnetService_ImportDevnet_Invalid(t *testing.T) {
+	svc := NewDevnetService(store.NewMemoryStore(), nil, nil)
+	svc.SetDebugSources(nil, t.TempDir())
+
+	tests := []struct {
+		name   string
+		stream *mockImportStream
+		code   codes.Code
+	}{
+		{"empty", &mockImportStream{}, codes.InvalidArgument},
+		{"not an archive", newMockImportStream("copy", []byte("not a tar.zst")), codes.InvalidArgument},
+		{"escaping name", newMockImportStream("../copy", []byte("x")), codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := svc.ImportDevnet(tt.stream); status.Code(err) != tt.code {
+				t.Errorf("expected %v, got %v", tt.code, err)
+			}
+		})
+	}
+}
@@ -2,15 +2,24 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"strings"
+	"sync"
 	"time"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/controller"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/keys"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/portalloc"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/server/ante"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/subnet"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -30,7 +39,17 @@ type DevnetService struct {
 	logger          *slog.Logger
 	ante            *ante.AnteHandler
 	subnetAllocator *subnet.Allocator
+	portAllocator   *portalloc.Allocator
 	dirEraser       DirEraser
+	runtime         runtime.NodeRuntime
+	dataDir         string
+	genesisExporter GenesisExporter
+	commandRunner   runtime.NodeCommandRunner
+	binaryBuilder   builder.BinaryBuilder
+	shutdown        *controller.ShutdownController
+
+	// importBuilds tracks binary builds of imports that outlive the import.
+	importBuilds sync.WaitGroup
 }
 
 // NewDevnetService creates a new DevnetService.
@@ -60,6 +79,25 @@ func (s *DevnetService) SetLogger(logger *slog.Logger) {
 	s.logger = logger
 }
 
+// SetPortAllocator sets the host port allocator whose blocks are released
+// when a devnet is deleted.
+func (s *DevnetService) SetPortAllocator(alloc *portalloc.Allocator) {
+	s.portAllocator = alloc
+}
+
+// SetDebugSources sets where debug bundles and log searches read node logs
+// and the daemon log from.
+func (s *DevnetService) SetDebugSources(rt runtime.NodeRuntime, dataDir string) {
+	s.runtime = rt
+	s.dataDir = dataDir
+}
+
+// SetShutdownController sets the controller that stops a devnet's nodes
+// when the devnet stops.
+func (s *DevnetService) SetShutdownController(c *controller.ShutdownController) {
+	s.shutdown = c
+}
+
 // CreateDevnet creates a new devnet.
 func (s *DevnetService) CreateDevnet(ctx context.Context, req *v1.CreateDevnetRequest) (*v1.CreateDevnetResponse, error) {
 	// Use ante handler if available
@@ -84,6 +122,14 @@ func (s *DevnetService) CreateDevnet(ctx context.Context, req *v1.CreateDevnetRe
 
 	// Convert to domain type
 	devnet := CreateRequestToDevnet(req)
+	if err := resetExpiry(devnet, devnet.Metadata.CreatedAt); err != nil {
+		return nil, err
+	}
+	defaultChainID(devnet)
+
+	if err := checkNamespaceQuota(ctx, s.store, namespace, req.Name, devnet.Spec.NodeCount()); err != nil {
+		return nil, err
+	}
 
 	// Store it
 	err := s.store.CreateDevnet(ctx, devnet)
@@ -103,6 +149,43 @@ func (s *DevnetService) CreateDevnet(ctx context.Context, req *v1.CreateDevnetRe
 	return &v1.CreateDevnetResponse{Devnet: DevnetToProto(devnet)}, nil
 }
 
+// defaultChainID gives a devnet without a chain ID one when the "<name>-1"
+// default does not fit. Seeded devnets take the name part from their seed,
+// so devnets of the same seed share a chain ID whatever they are called.
+// Devnets of networks whose default chain ID is in the Ethermint format,
+// <name>_<EVM chain ID>-<epoch>, get one in that format: Ethermint chains
+// take the EVM chain ID from the chain ID and reject any other.
+func defaultChainID(devnet *types.Devnet) {
+	if devnet.Spec.ChainID != "" {
+		return
+	}
+	name := devnet.Metadata.Name
+	if devnet.Spec.Seed != "" {
+		name = keys.ForSeed(devnet.Spec.Seed).DevnetName()
+		devnet.Spec.ChainID = name + "-1"
+	}
+
+	module, err := network.Get(devnet.Spec.Plugin)
+	if err != nil {
+		return
+	}
+	evmChainID, ok := dvbtypes.ChainID(module.DefaultChainID()).EVMChainID()
+	if !ok {
+		return
+	}
+	// The name part allows lowercase letters only
+	name = strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' {
+			return r
+		}
+		return -1
+	}, strings.ToLower(name))
+	if name == "" {
+		name = "devnet"
+	}
+	devnet.Spec.ChainID = fmt.Sprintf("%s_%d-1", name, evmChainID)
+}
+
 // GetDevnet retrieves a devnet by name.
 func (s *DevnetService) GetDevnet(ctx context.Context, req *v1.GetDevnetRequest) (*v1.GetDevnetResponse, error) {
 	if req.Name == "" {
@@ -178,8 +261,17 @@ func (s *DevnetService) DeleteDevnet(ctx context.Context, req *v1.DeleteDevnetRe
 		// Continue with devnet deletion even if upgrade deletion fails
 	}
 
-	// Release the allocated subnet for this devnet
+	// Release the allocated subnet for this devnet, removing any loopback
+	// aliases its local nodes needed
 	if s.subnetAllocator != nil {
+		if sub, ok := s.subnetAllocator.GetSubnet(namespace, req.Name); ok {
+			devnet, err := s.store.GetDevnet(ctx, namespace, req.Name)
+			if err == nil && devnet.Spec.Mode != "docker" && devnet.Spec.BindAddress == "" {
+				if err := subnet.RemoveAliases(sub, devnet.Spec.Validators+devnet.Spec.FullNodes); err != nil {
+					s.logger.Warn("failed to remove loopback aliases during delete", "devnet", req.Name, "error", err)
+				}
+			}
+		}
 		if err := s.subnetAllocator.Release(namespace, req.Name); err != nil {
 			s.logger.Warn("failed to release subnet during delete", "devnet", req.Name, "error", err)
 			// Continue with devnet deletion even if subnet release fails
@@ -188,6 +280,13 @@ func (s *DevnetService) DeleteDevnet(ctx context.Context, req *v1.DeleteDevnetRe
 		}
 	}
 
+	// Release the host port blocks of the devnet's nodes
+	if s.portAllocator != nil {
+		if err := s.portAllocator.Release(namespace, req.Name); err != nil {
+			s.logger.Warn("failed to release host ports during delete", "devnet", req.Name, "error", err)
+		}
+	}
+
 	// Erase devnet data directory from filesystem
 	if s.dirEraser != nil {
 		if err := s.dirEraser.EraseDevnetDir(req.Name); err != nil {
@@ -244,8 +343,17 @@ func (s *DevnetService) StartDevnet(ctx context.Context, req *v1.StartDevnetRequ
 		return nil, status.Errorf(codes.Internal, "failed to list nodes: %v", err)
 	}
 	if len(nodes) == 0 {
+		// Provisioning failed before it created the nodes: provision again,
+		// resuming after the phases the failed attempt completed
+		if devnet.Status.Phase == types.PhaseDegraded {
+			return s.retryProvisioning(ctx, devnet)
+		}
 		return nil, status.Errorf(codes.FailedPrecondition, "devnet %q has no nodes; use 'dvb provision' to create it first", req.Name)
 	}
+	if devnet.Status.Expired(time.Now()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "devnet %q TTL expired at %s; extend it with 'dvb extend' first",
+			req.Name, devnet.Status.ExpiresAt.Format(time.RFC3339))
+	}
 
 	// Set each non-running node to desired=Running, phase=Pending for NodeController.
 	// Note: this is not atomic across nodes+devnet. A partial failure may leave some
@@ -285,6 +393,47 @@ func (s *DevnetService) StartDevnet(ctx context.Context, req *v1.StartDevnetRequ
 	return &v1.StartDevnetResponse{Devnet: DevnetToProto(devnet)}, nil
 }
 
+// retryProvisioning moves a devnet whose provisioning failed back to
+// Pending, so the controller provisions it again.
+func (s *DevnetService) retryProvisioning(ctx context.Context, devnet *types.Devnet) (*v1.StartDevnetResponse, error) {
+	s.logger.Info("retrying provisioning", "namespace", devnet.Metadata.Namespace, "name", devnet.Metadata.Name)
+
+	devnet.Status.Conditions = types.SetCondition(
+		devnet.Status.Conditions,
+		types.ConditionTypeDegraded,
+		types.ConditionFalse,
+		types.ReasonProvisioning,
+		"Retrying provisioning",
+	)
+	devnet.Status.Phase = types.PhasePending
+	devnet.Status.Message = "Retrying provisioning"
+	devnet.Status.Events = append(devnet.Status.Events, types.NewEvent(
+		types.EventTypeNormal,
+		types.ReasonProvisioning,
+		"Retrying provisioning",
+		"devnet-service",
+	))
+	devnet.Metadata.UpdatedAt = time.Now()
+	if err := s.store.UpdateDevnet(ctx, devnet); err != nil {
+		return nil, updateDevnetError(err)
+	}
+
+	if s.manager != nil {
+		s.manager.Enqueue("devnets", devnet.Metadata.Namespace+"/"+devnet.Metadata.Name)
+	}
+	return &v1.StartDevnetResponse{Devnet: DevnetToProto(devnet)}, nil
+}
+
+// resumeHint tells how to start a stopped devnet again, e.g. after the
+// daemon stopped it for being idle. Devnet and node phases share the
+// "Stopped" name. Empty for other phases.
+func resumeHint(devnetName, phase string) string {
+	if phase != types.PhaseStopped {
+		return ""
+	}
+	return "; resume it with 'dvb node start " + devnetName + " --all'"
+}
+
 // StopDevnet stops a running devnet.
 func (s *DevnetService) StopDevnet(ctx context.Context, req *v1.StopDevnetRequest) (*v1.StopDevnetResponse, error) {
 	if req.Name == "" {
@@ -297,7 +446,7 @@ func (s *DevnetService) StopDevnet(ctx context.Context, req *v1.StopDevnetReques
 		namespace = types.DefaultNamespace
 	}
 
-	s.logger.Info("stopping devnet", "namespace", namespace, "name", req.Name)
+	s.logger.Info("stopping devnet", "namespace", namespace, "name", req.Name, "reason", req.Reason)
 
 	devnet, err := s.store.GetDevnet(ctx, namespace, req.Name)
 	if err != nil {
@@ -307,13 +456,31 @@ func (s *DevnetService) StopDevnet(ctx context.Context, req *v1.StopDevnetReques
 		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
 	}
 
+	// Stop the nodes in order. A cancelled request must not leave the
+	// devnet half stopped.
+	var killed []string
+	if s.shutdown != nil {
+		report, err := s.shutdown.Shutdown(context.WithoutCancel(ctx), devnet)
+		if err != nil {
+			s.logger.Error("failed to stop nodes", "name", req.Name, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to stop nodes: %v", err)
+		}
+		killed = report.Killed()
+	}
+
 	// Transition to Stopped
 	devnet.Status.Phase = types.PhaseStopped
 	devnet.Status.Message = "Devnet stopped"
+	if req.Reason != "" {
+		devnet.Status.Message += ": " + req.Reason
+	}
+	if len(killed) > 0 {
+		devnet.Status.Message += fmt.Sprintf(" (killed with SIGKILL after the shutdown timeout: %s)", strings.Join(killed, ", "))
+	}
 	devnet.Status.ReadyNodes = 0
 	devnet.Metadata.UpdatedAt = time.Now()
 
-	err = s.store.UpdateDevnet(ctx, devnet)
+	err = s.store.UpdateDevnet(context.WithoutCancel(ctx), devnet)
 	if err != nil {
 		s.logger.Error("failed to update devnet", "name", req.Name, "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to update devnet: %v", err)
@@ -356,6 +523,13 @@ func (s *DevnetService) ApplyDevnet(ctx context.Context, req *v1.ApplyDevnetRequ
 	if existing == nil {
 		// Create new devnet
 		devnet := ApplyRequestToDevnet(req)
+		if err := resetExpiry(devnet, devnet.Metadata.CreatedAt); err != nil {
+			return nil, err
+		}
+		defaultChainID(devnet)
+		if err := checkNamespaceQuota(ctx, s.store, namespace, req.Name, devnet.Spec.NodeCount()); err != nil {
+			return nil, err
+		}
 		err = s.store.CreateDevnet(ctx, devnet)
 		if err != nil {
 			s.logger.Error("failed to create devnet", "namespace", namespace, "name", req.Name, "error", err)
@@ -382,23 +556,25 @@ func (s *DevnetService) ApplyDevnet(ctx context.Context, req *v1.ApplyDevnetRequ
 		}, nil
 	}
 
-	// Update existing devnet
-	if req.Spec != nil {
-		existing.Spec = specFromProto(req.Spec)
-	}
-	if req.Labels != nil {
-		existing.Metadata.Labels = req.Labels
-	}
-	if req.Annotations != nil {
-		existing.Metadata.Annotations = req.Annotations
-	}
-	// Note: Generation is incremented by the store layer after conflict check passes.
-	// Do not increment here - that would cause the conflict check to always fail.
-
-	err = s.store.UpdateDevnet(ctx, existing)
+	// Update existing devnet. If a controller writes it between our read and
+	// write, re-read it and apply the request again.
+	err = store.RetryOnConflict(ctx, func() error {
+		if err := s.applyDevnetChanges(ctx, existing, req.Spec, req.Labels, req.Annotations); err != nil {
+			return err
+		}
+		err := s.store.UpdateDevnet(ctx, existing)
+		if store.IsConflict(err) {
+			fresh, getErr := s.store.GetDevnet(ctx, namespace, req.Name)
+			if getErr != nil {
+				return getErr
+			}
+			existing = fresh
+		}
+		return err
+	})
 	if err != nil {
 		s.logger.Error("failed to update devnet", "namespace", namespace, "name", req.Name, "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to update devnet: %v", err)
+		return nil, updateDevnetError(err)
 	}
 
 	if s.manager != nil {
@@ -430,30 +606,26 @@ func (s *DevnetService) UpdateDevnet(ctx context.Context, req *v1.UpdateDevnetRe
 
 	s.logger.Info("updating devnet", "namespace", namespace, "name", req.Name)
 
-	existing, err := s.store.GetDevnet(ctx, namespace, req.Name)
+	// Read, modify and write, starting over if a controller writes the
+	// devnet in between.
+	var existing *types.Devnet
+	err := store.RetryOnConflict(ctx, func() error {
+		var err error
+		existing, err = s.store.GetDevnet(ctx, namespace, req.Name)
+		if err != nil {
+			return err
+		}
+		if err := s.applyDevnetChanges(ctx, existing, req.Spec, req.Labels, req.Annotations); err != nil {
+			return err
+		}
+		return s.store.UpdateDevnet(ctx, existing)
+	})
 	if err != nil {
 		if store.IsNotFound(err) {
 			return nil, status.Errorf(codes.NotFound, "devnet %q not found in namespace %q", req.Name, namespace)
 		}
-		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
-	}
-
-	if req.Spec != nil {
-		existing.Spec = specFromProto(req.Spec)
-	}
-	if req.Labels != nil {
-		existing.Metadata.Labels = req.Labels
-	}
-	if req.Annotations != nil {
-		existing.Metadata.Annotations = req.Annotations
-	}
-	// Note: Generation is incremented by the store layer after conflict check passes.
-	// Do not increment here - that would cause the conflict check to always fail.
-
-	err = s.store.UpdateDevnet(ctx, existing)
-	if err != nil {
 		s.logger.Error("failed to update devnet", "namespace", namespace, "name", req.Name, "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to update devnet: %v", err)
+		return nil, updateDevnetError(err)
 	}
 
 	if s.manager != nil {
@@ -463,6 +635,50 @@ func (s *DevnetService) UpdateDevnet(ctx context.Context, req *v1.UpdateDevnetRe
 	return &v1.UpdateDevnetResponse{Devnet: DevnetToProto(existing)}, nil
 }
 
+// applyDevnetChanges applies a requested spec, labels and annotations to
+// devnet, checking the namespace quota when the node count grows and
+// restarting the TTL when it changes. Nil fields are left unchanged.
+//
+// Generation is not incremented here: the store increments it after its
+// optimistic concurrency check passes.
+func (s *DevnetService) applyDevnetChanges(ctx context.Context, devnet *types.Devnet, specProto *v1.DevnetSpec, labels, annotations map[string]string) error {
+	if specProto != nil {
+		spec := specFromProto(specProto)
+		if spec.NodeCount() > devnet.Spec.NodeCount() {
+			if err := checkNamespaceQuota(ctx, s.store, devnet.Metadata.Namespace, devnet.Metadata.Name, spec.NodeCount()); err != nil {
+				return err
+			}
+		}
+		ttlChanged := spec.TTL != devnet.Spec.TTL
+		devnet.Spec = spec
+		if ttlChanged {
+			if err := resetExpiry(devnet, time.Now()); err != nil {
+				return err
+			}
+		}
+	}
+	if labels != nil {
+		devnet.Metadata.Labels = labels
+	}
+	if annotations != nil {
+		devnet.Metadata.Annotations = annotations
+	}
+	return nil
+}
+
+// updateDevnetError converts a failed devnet update to a gRPC error. Status
+// errors from validation pass through; conflicts that outlast the retries
+// are reported as Aborted so clients can retry.
+func updateDevnetError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if store.IsConflict(err) {
+		return status.Errorf(codes.Aborted, "devnet is being modified concurrently, try again: %v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to update devnet: %v", err)
+}
+
 // parseLabelSelector parses a comma-separated label selector string into a map.
 // Format: "key1=value1,key2=value2"
 // Returns an empty map if the selector is empty.
@@ -4,14 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/controller"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/keys"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/server/ante"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/plugin/builtin"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -57,6 +61,41 @@ func TestDevnetService_Create(t *testing.T) {
 	}
 }
 
+func TestDevnetService_CreateDefaultChainID(t *testing.T) {
+	if err := builtin.Register(); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	svc := NewDevnetService(store.NewMemoryStore(), nil, nil)
+
+	seeded := keys.ForSeed("issue-1234").DevnetName()
+	tests := []struct {
+		name    string
+		plugin  string
+		chainID string
+		seed    string
+		want    string
+	}{
+		{"evm-Test-2", "evmos", "", "", "evmtest_9002-1"},
+		{"evm-explicit", "evmos", "mine_77-1", "", "mine_77-1"},
+		{"hub", "cosmos", "", "", ""},
+		{"alice", "cosmos", "", "issue-1234", seeded + "-1"},
+		{"bob", "cosmos", "", "issue-1234", seeded + "-1"},
+		{"seeded-explicit", "cosmos", "mine-1", "issue-1234", "mine-1"},
+	}
+	for _, tt := range tests {
+		resp, err := svc.CreateDevnet(context.Background(), &v1.CreateDevnetRequest{
+			Name: tt.name,
+			Spec: &v1.DevnetSpec{Plugin: tt.plugin, Validators: 1, ChainId: tt.chainID, Seed: tt.seed},
+		})
+		if err != nil {
+			t.Fatalf("CreateDevnet(%s): %v", tt.name, err)
+		}
+		if got := resp.Devnet.Spec.ChainId; got != tt.want {
+			t.Errorf("%s chain ID = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
 func TestDevnetService_CreateAlreadyExists(t *testing.T) {
 	s := store.NewMemoryStore()
 	svc := NewDevnetService(s, nil, nil)
@@ -357,6 +396,46 @@ func TestDevnetService_StartDevnet_NoNodes(t *testing.T) {
 	}
 }
 
+func TestDevnetService_StartDevnet_RetriesFailedProvisioning(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+
+	_, err := svc.CreateDevnet(ctx, &v1.CreateDevnetRequest{
+		Name: "failed-devnet",
+		Spec: &v1.DevnetSpec{
+			Plugin:     "stable",
+			Validators: 4,
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+
+	// Provisioning failed before any node was created
+	devnet, _ := s.GetDevnet(ctx, "", "failed-devnet")
+	devnet.Status.Phase = types.PhaseDegraded
+	devnet.Status.Conditions = types.SetCondition(devnet.Status.Conditions,
+		types.ConditionTypeDegraded, types.ConditionTrue, types.ReasonSnapshotFailed, "snapshot download failed")
+	s.UpdateDevnet(ctx, devnet)
+
+	resp, err := svc.StartDevnet(ctx, &v1.StartDevnetRequest{Name: "failed-devnet"})
+	if err != nil {
+		t.Fatalf("StartDevnet failed: %v", err)
+	}
+	if resp.Devnet.Status.Phase != types.PhasePending {
+		t.Errorf("expected phase %s, got %s", types.PhasePending, resp.Devnet.Status.Phase)
+	}
+
+	devnet, _ = s.GetDevnet(ctx, "", "failed-devnet")
+	if devnet.Status.Phase != types.PhasePending {
+		t.Errorf("expected stored phase %s, got %s", types.PhasePending, devnet.Status.Phase)
+	}
+	if types.IsConditionTrue(devnet.Status.Conditions, types.ConditionTypeDegraded) {
+		t.Error("expected Degraded condition to be cleared")
+	}
+}
+
 func TestDevnetService_StartDevnet_SkipsRunningNodes(t *testing.T) {
 	ctx := context.Background()
 	s := store.NewMemoryStore()
@@ -477,6 +556,79 @@ func TestDevnetService_StopDevnet(t *testing.T) {
 	}
 }
 
+func TestDevnetService_StopDevnetReason(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+
+	if err := s.CreateDevnet(ctx, &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "idle-devnet"},
+		Status:   types.DevnetStatus{Phase: types.PhaseRunning},
+	}); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+
+	resp, err := svc.StopDevnet(ctx, &v1.StopDevnetRequest{Name: "idle-devnet", Reason: "idle for 30m0s"})
+	if err != nil {
+		t.Fatalf("StopDevnet failed: %v", err)
+	}
+	if want := "Devnet stopped: idle for 30m0s"; resp.Devnet.Status.Message != want {
+		t.Errorf("message = %q, want %q", resp.Devnet.Status.Message, want)
+	}
+
+	// Operations on the stopped devnet tell how to resume it.
+	_, err = svc.DiffValidatorSets(ctx, &v1.DiffValidatorSetsRequest{DevnetName: "idle-devnet", FromHeight: 1})
+	if status.Code(err) != codes.FailedPrecondition || !strings.Contains(err.Error(), "dvb node start idle-devnet --all") {
+		t.Errorf("expected resume hint, got %v", err)
+	}
+}
+
+// killingRuntime is a NodeRuntime whose nodes never exit on their own.
+type killingRuntime struct {
+	runtime.NodeRuntime
+}
+
+func (killingRuntime) StopNodeWithin(_ context.Context, _ string, _ time.Duration) (bool, error) {
+	return true, nil
+}
+
+func TestDevnetService_StopDevnetStopsNodes(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+	svc.SetShutdownController(controller.NewShutdownController(s, killingRuntime{}, nil, time.Second))
+
+	if err := s.CreateDevnet(ctx, &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test-devnet"},
+		Status:   types.DevnetStatus{Phase: types.PhaseRunning, ReadyNodes: 1},
+	}); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+	if err := s.CreateNode(ctx, &types.Node{
+		Metadata: types.ResourceMeta{Name: "test-devnet-0"},
+		Spec:     types.NodeSpec{DevnetRef: "test-devnet", Role: "validator", Desired: types.NodePhaseRunning},
+		Status:   types.NodeStatus{Phase: types.NodePhaseRunning, PID: 100},
+	}); err != nil {
+		t.Fatalf("CreateNode failed: %v", err)
+	}
+
+	resp, err := svc.StopDevnet(ctx, &v1.StopDevnetRequest{Name: "test-devnet"})
+	if err != nil {
+		t.Fatalf("StopDevnet failed: %v", err)
+	}
+	if !strings.Contains(resp.Devnet.Status.Message, "killed with SIGKILL after the shutdown timeout: test-devnet-0") {
+		t.Errorf("message = %q, want the killed node", resp.Devnet.Status.Message)
+	}
+
+	node, err := s.GetNode(ctx, "", "test-devnet", 0)
+	if err != nil {
+		t.Fatalf("GetNode failed: %v", err)
+	}
+	if node.Status.Phase != types.NodePhaseStopped || node.Spec.Desired != types.NodePhaseStopped {
+		t.Errorf("node phase = %s, desired = %s; want both %s", node.Status.Phase, node.Spec.Desired, types.NodePhaseStopped)
+	}
+}
+
 func TestDevnetService_DeleteCascade(t *testing.T) {
 	ctx := context.Background()
 	s := store.NewMemoryStore()
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/doublesign"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/nodekeys"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InjectDoubleSign makes a validator double-sign the latest block with a
+// copy of its consensus key, outside its node, and submits the evidence to
+// a running node. The chain slashes the validator and tombstones it, which
+// cannot be undone, so the request must be confirmed.
+func (s *DevnetService) InjectDoubleSign(ctx context.Context, req *v1.InjectDoubleSignRequest) (*v1.InjectDoubleSignResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+	if !req.Confirm {
+		return nil, status.Error(codes.InvalidArgument, "confirm is required: a double-signing validator is tombstoned for good")
+	}
+
+	nodes, err := s.runningNodes(ctx, req.GetNamespace(), req.DevnetName, "double-sign injection")
+	if err != nil {
+		return nil, err
+	}
+	var validator, submitter *types.Node
+	for _, node := range nodes {
+		if node.Spec.Index == int(req.ValidatorIndex) {
+			validator = node
+		}
+		if submitter == nil && node.Status.Phase == types.NodePhaseRunning {
+			submitter = node
+		}
+	}
+	if validator == nil {
+		return nil, status.Errorf(codes.NotFound, "node %s/%d not found", req.DevnetName, req.ValidatorIndex)
+	}
+	name := nodeDisplayName(validator)
+	switch {
+	case validator.Spec.Role != "validator":
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is not a validator", name)
+	case validator.Spec.RemoteSigner != nil:
+		return nil, status.Errorf(codes.FailedPrecondition, "%s signs through a remote signer; its consensus key is not on the daemon host", name)
+	case validator.Spec.Host != nil:
+		return nil, status.Errorf(codes.FailedPrecondition, "%s runs on remote host %s; its consensus key is not on the daemon host",
+			name, validator.Spec.Host.Name)
+	case submitter == nil:
+		return nil, status.Errorf(codes.FailedPrecondition, "no node of devnet %q is running to submit the evidence to", req.DevnetName)
+	}
+
+	key, err := os.ReadFile(filepath.Join(validator.Spec.HomeDir, "config", nodekeys.PrivValidatorKeyFile))
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to read the consensus key of %s: %v", name, err)
+	}
+
+	injector := doublesign.NewInjector(doublesign.Config{RPCEndpoint: nodeRPCURL(submitter)})
+	result, err := injector.Inject(ctx, key)
+	if err != nil {
+		if errors.Is(err, doublesign.ErrNotInValidatorSet) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s: %v", name, err)
+		}
+		return nil, status.Errorf(codes.Unavailable, "failed to inject double-sign for %s: %v", name, err)
+	}
+
+	s.logger.Warn("injected double-sign evidence",
+		"namespace", validator.Metadata.Namespace,
+		"devnet", req.DevnetName,
+		"validator", name,
+		"height", result.Height,
+		"evidence", result.EvidenceHash)
+
+	return &v1.InjectDoubleSignResponse{
+		Height:           result.Height,
+		ValidatorAddress: result.ValidatorAddress,
+		EvidenceHash:     result.EvidenceHash,
+	}, nil
+}
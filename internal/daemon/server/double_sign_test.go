@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDevnetService_InjectDoubleSign(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+
+	if _, err := svc.InjectDoubleSign(ctx, &v1.InjectDoubleSignRequest{Confirm: true}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for missing name, got %v", err)
+	}
+	if _, err := svc.InjectDoubleSign(ctx, &v1.InjectDoubleSignRequest{DevnetName: "alpha"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument without confirm, got %v", err)
+	}
+	if _, err := svc.InjectDoubleSign(ctx, &v1.InjectDoubleSignRequest{DevnetName: "alpha", Confirm: true}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for missing devnet, got %v", err)
+	}
+
+	if err := s.CreateDevnet(ctx, &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "alpha", Namespace: types.DefaultNamespace},
+		Spec:     types.DevnetSpec{Validators: 2, FullNodes: 1},
+		Status:   types.DevnetStatus{Phase: types.PhaseRunning},
+	}); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+	signed := exportTestNode(0, "validator", types.NodePhaseRunning, 10)
+	signed.Metadata.Name = "alpha-0"
+	signed.Spec.RemoteSigner = &types.RemoteSigner{Index: 0, TMKMS: true}
+	keyless := exportTestNode(1, "validator", types.NodePhaseRunning, 10)
+	keyless.Metadata.Name = "alpha-1"
+	keyless.Spec.HomeDir = t.TempDir()
+	full := exportTestNode(2, "fullnode", types.NodePhaseRunning, 10)
+	full.Metadata.Name = "alpha-2"
+	for _, node := range []*types.Node{signed, keyless, full} {
+		if err := s.CreateNode(ctx, node); err != nil {
+			t.Fatalf("CreateNode failed: %v", err)
+		}
+	}
+
+	for _, tc := range []struct {
+		index int32
+		code  codes.Code
+		msg   string
+	}{
+		{index: 0, code: codes.FailedPrecondition, msg: "remote signer"},
+		{index: 1, code: codes.FailedPrecondition, msg: "consensus key"},
+		{index: 2, code: codes.FailedPrecondition, msg: "not a validator"},
+		{index: 7, code: codes.NotFound, msg: "not found"},
+	} {
+		_, err := svc.InjectDoubleSign(ctx, &v1.InjectDoubleSignRequest{DevnetName: "alpha", ValidatorIndex: tc.index, Confirm: true})
+		if status.Code(err) != tc.code || !strings.Contains(err.Error(), tc.msg) {
+			t.Errorf("InjectDoubleSign(%d) error = %v, want %s containing %q", tc.index, err, tc.code, tc.msg)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultListEventsLimit = 100
+	maxListEventsLimit     = store.DefaultEventRetention
+)
+
+// ListDevnetEvents returns a devnet's event history from the store, which
+// outlives daemon restarts and the recent events kept in its status.
+func (s *DevnetService) ListDevnetEvents(ctx context.Context, req *v1.ListDevnetEventsRequest) (*v1.ListDevnetEventsResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if req.Type != "" && req.Type != types.EventTypeNormal && req.Type != types.EventTypeWarning {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid event type %q (must be %s or %s)", req.Type, types.EventTypeNormal, types.EventTypeWarning)
+	}
+	limit := int(req.Limit)
+	switch {
+	case limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	case limit == 0:
+		limit = defaultListEventsLimit
+	case limit > maxListEventsLimit:
+		limit = maxListEventsLimit
+	}
+
+	devnet, err := s.store.GetDevnet(ctx, req.GetNamespace(), req.Name)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", req.Name)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+
+	opts := store.ListEventsOptions{Type: req.Type, Limit: limit}
+	if req.Since != nil {
+		opts.Since = req.Since.AsTime()
+	}
+
+	// Stores without an event history only have the recent status events.
+	var events []types.Event
+	if es, ok := s.store.(store.EventStore); ok {
+		events, err = es.ListEvents(ctx, devnet.Metadata.Namespace, devnet.Metadata.Name, opts)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list events: %v", err)
+		}
+	} else {
+		for _, e := range devnet.Status.Events {
+			if (opts.Since.IsZero() || !e.Timestamp.Before(opts.Since)) && (opts.Type == "" || e.Type == opts.Type) {
+				events = append(events, e)
+			}
+		}
+		if len(events) > limit {
+			events = events[len(events)-limit:]
+		}
+	}
+
+	resp := &v1.ListDevnetEventsResponse{}
+	for _, e := range events {
+		resp.Events = append(resp.Events, eventToProto(e))
+	}
+	return resp, nil
+}
+
+// eventToProto converts a domain Event to proto.
+func eventToProto(e types.Event) *v1.Event {
+	return &v1.Event{
+		Timestamp: timestamppb.New(e.Timestamp),
+		Type:      e.Type,
+		Reason:    e.Reason,
+		Message:   e.Message,
+		Component: e.Component,
+	}
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDevnetService_ListDevnetEvents(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+
+	if err := s.CreateDevnet(ctx, &types.Devnet{Metadata: types.ResourceMeta{Name: "alpha"}}); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+	base := time.Now().Add(-time.Hour)
+	for i := 0; i < 20; i++ {
+		devnet, err := s.GetDevnet(ctx, "", "alpha")
+		if err != nil {
+			t.Fatalf("GetDevnet failed: %v", err)
+		}
+		eventType := types.EventTypeNormal
+		if i%4 == 0 {
+			eventType = types.EventTypeWarning
+		}
+		devnet.Status.Events = append(devnet.Status.Events, types.Event{
+			Timestamp: base.Add(time.Duration(i) * time.Minute),
+			Type:      eventType,
+			Reason:    "Reason",
+		})
+		if err := s.UpdateDevnet(ctx, devnet); err != nil {
+			t.Fatalf("UpdateDevnet failed: %v", err)
+		}
+	}
+
+	resp, err := svc.ListDevnetEvents(ctx, &v1.ListDevnetEventsRequest{Name: "alpha"})
+	if err != nil {
+		t.Fatalf("ListDevnetEvents failed: %v", err)
+	}
+	if len(resp.Events) != 20 {
+		t.Errorf("expected the full history of 20 events, got %d", len(resp.Events))
+	}
+
+	resp, err = svc.ListDevnetEvents(ctx, &v1.ListDevnetEventsRequest{
+		Name:  "alpha",
+		Type:  types.EventTypeWarning,
+		Since: timestamppb.New(base.Add(5 * time.Minute)),
+	})
+	if err != nil {
+		t.Fatalf("ListDevnetEvents failed: %v", err)
+	}
+	if len(resp.Events) != 3 {
+		t.Errorf("expected 3 warnings since minute 5, got %d", len(resp.Events))
+	}
+
+	resp, err = svc.ListDevnetEvents(ctx, &v1.ListDevnetEventsRequest{Name: "alpha", Limit: 2})
+	if err != nil {
+		t.Fatalf("ListDevnetEvents failed: %v", err)
+	}
+	if len(resp.Events) != 2 || !resp.Events[1].Timestamp.AsTime().Equal(base.Add(19*time.Minute)) {
+		t.Errorf("expected the 2 most recent events, got %v", resp.Events)
+	}
+
+	for _, tc := range []struct {
+		req  *v1.ListDevnetEventsRequest
+		code codes.Code
+	}{
+		{&v1.ListDevnetEventsRequest{}, codes.InvalidArgument},
+		{&v1.ListDevnetEventsRequest{Name: "alpha", Type: "Error"}, codes.InvalidArgument},
+		{&v1.ListDevnetEventsRequest{Name: "alpha", Limit: -1}, codes.InvalidArgument},
+		{&v1.ListDevnetEventsRequest{Name: "missing"}, codes.NotFound},
+	} {
+		_, err := svc.ListDevnetEvents(ctx, tc.req)
+		if status.Code(err) != tc.code {
+			t.Errorf("ListDevnetEvents(%v): expected %v, got %v", tc.req, tc.code, err)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"context"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/fixtures"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportFixtures generates canonical signed transaction and query-response
+// fixtures against a running devnet's chain ID and state.
+func (s *DevnetService) ExportFixtures(ctx context.Context, req *v1.ExportFixturesRequest) (*v1.ExportFixturesResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+
+	kinds, err := fixtures.ParseKinds(req.Types)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	namespace := req.GetNamespace()
+	devnet, err := s.store.GetDevnet(ctx, namespace, req.DevnetName)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", req.DevnetName)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+	if devnet.Status.Phase != types.PhaseRunning && devnet.Status.Phase != types.PhaseDegraded {
+		return nil, status.Errorf(codes.FailedPrecondition, "devnet %q is %s; fixtures require a running devnet%s",
+			req.DevnetName, devnet.Status.Phase, resumeHint(req.DevnetName, devnet.Status.Phase))
+	}
+
+	module, err := network.Get(devnet.Spec.Plugin)
+	if err != nil {
+		return nil, apierror.Errorf(apierror.PluginMissing, "network %q not found: %v", devnet.Spec.Plugin, err)
+	}
+
+	node, err := s.store.GetNode(ctx, devnet.Metadata.Namespace, req.DevnetName, int(req.NodeIndex))
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "node %s/%d not found", req.DevnetName, req.NodeIndex)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get node: %v", err)
+	}
+
+	chainID := node.Spec.ChainID
+	if chainID == "" {
+		chainID = devnet.Spec.ChainID
+	}
+
+	host := node.Spec.Address
+	if host == "" {
+		host = "127.0.0.1"
+	}
+
+	generator := fixtures.NewGenerator(fixtures.Config{
+		RESTEndpoint: node.Spec.Ports().APIURL(host),
+		ChainID:      chainID,
+		Bech32Prefix: module.Bech32Prefix(),
+	})
+	generator.SetLogger(s.logger)
+
+	result, err := generator.Generate(ctx, kinds)
+	if err != nil {
+		s.logger.Error("fixture export failed", "devnet", req.DevnetName, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to export fixtures: %v", err)
+	}
+
+	resp := &v1.ExportFixturesResponse{
+		ChainId: result.ChainID,
+		Height:  result.Height,
+		Files:   make([]*v1.FixtureFile, 0, len(result.Files)),
+	}
+	for _, f := range result.Files {
+		resp.Files = append(resp.Files, &v1.FixtureFile{Name: f.Name, Content: f.Content})
+	}
+	return resp, nil
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDevnetService_ExportFixturesValidation(t *testing.T) {
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+	ctx := context.Background()
+
+	if err := s.CreateDevnet(ctx, &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "pending-devnet", Namespace: types.DefaultNamespace},
+		Spec:     types.DevnetSpec{Plugin: "stable", Validators: 1},
+		Status:   types.DevnetStatus{Phase: types.PhasePending},
+	}); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		req  *v1.ExportFixturesRequest
+		code codes.Code
+	}{
+		{
+			name: "missing devnet name",
+			req:  &v1.ExportFixturesRequest{},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "unknown type",
+			req:  &v1.ExportFixturesRequest{DevnetName: "pending-devnet", Types: []string{"wasm"}},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "devnet not found",
+			req:  &v1.ExportFixturesRequest{DevnetName: "missing"},
+			code: codes.NotFound,
+		},
+		{
+			name: "devnet not running",
+			req:  &v1.ExportFixturesRequest{DevnetName: "pending-devnet"},
+			code: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.ExportFixtures(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Errorf("expected code %v, got %v (%v)", tt.code, status.Code(err), err)
+			}
+		})
+	}
+}
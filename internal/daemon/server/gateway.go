@@ -0,0 +1,379 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// gatewayPathPrefix prefixes every gateway RPC route.
+const gatewayPathPrefix = "/v1/"
+
+// maxGatewayBodySize bounds the size of a gateway request body.
+const maxGatewayBodySize = 4 << 20
+
+// gatewayMethod is a unary RPC exposed by the gateway.
+type gatewayMethod struct {
+	fullMethod string // "/devnetbuilder.v1.DevnetService/ListDevnets"
+	input      protoreflect.MessageType
+	output     protoreflect.MessageType
+}
+
+// Gateway serves the unary devnetd RPCs as REST/JSON over HTTP, so CI
+// pipelines and dashboards can drive devnets without the dvb binary.
+//
+// Each RPC is exposed as POST /v1/<Service>/<Method> with the request message
+// as the JSON body, e.g. POST /v1/DevnetService/ListDevnets. Messages use the
+// canonical protobuf JSON mapping. An OpenAPI 3 document of all routes is
+// served at GET /openapi.json. Streaming RPCs are not exposed.
+type Gateway struct {
+	conn    grpc.ClientConnInterface
+	methods map[string]gatewayMethod // keyed by "<Service>/<Method>"
+	openapi []byte
+	logger  *slog.Logger
+}
+
+// NewGateway creates a gateway that forwards requests over conn for the
+// unary methods of services, typically grpc.Server.GetServiceInfo().
+func NewGateway(conn grpc.ClientConnInterface, services map[string]grpc.ServiceInfo) (*Gateway, error) {
+	g := &Gateway{
+		conn:    conn,
+		methods: make(map[string]gatewayMethod),
+		logger:  slog.Default(),
+	}
+
+	for serviceName, info := range services {
+		// Skip grpc.reflection.*, grpc.health.* and similar infrastructure.
+		if strings.HasPrefix(serviceName, "grpc.") {
+			continue
+		}
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+		if err != nil {
+			return nil, fmt.Errorf("service %s: %w", serviceName, err)
+		}
+		sd, ok := desc.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a service", serviceName)
+		}
+
+		for _, mi := range info.Methods {
+			if mi.IsClientStream || mi.IsServerStream {
+				continue
+			}
+			md := sd.Methods().ByName(protoreflect.Name(mi.Name))
+			if md == nil {
+				return nil, fmt.Errorf("method %s/%s not found in descriptor", serviceName, mi.Name)
+			}
+			input, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+			if err != nil {
+				return nil, fmt.Errorf("method %s/%s: %w", serviceName, mi.Name, err)
+			}
+			output, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+			if err != nil {
+				return nil, fmt.Errorf("method %s/%s: %w", serviceName, mi.Name, err)
+			}
+			g.methods[string(sd.Name())+"/"+mi.Name] = gatewayMethod{
+				fullMethod: "/" + serviceName + "/" + mi.Name,
+				input:      input,
+				output:     output,
+			}
+		}
+	}
+
+	doc, err := json.MarshalIndent(g.openAPIDocument(), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to build OpenAPI document: %w", err)
+	}
+	g.openapi = doc
+	return g, nil
+}
+
+// SetLogger sets the logger.
+func (g *Gateway) SetLogger(logger *slog.Logger) {
+	g.logger = logger
+}
+
+// ServeHTTP implements http.Handler.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/openapi.json" {
+		if r.Method != http.MethodGet {
+			writeGatewayError(w, http.StatusMethodNotAllowed, codes.Unimplemented, "use GET")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(g.openapi)
+		return
+	}
+
+	route, found := strings.CutPrefix(r.URL.Path, gatewayPathPrefix)
+	method, ok := g.methods[route]
+	if !found || !ok {
+		writeGatewayError(w, http.StatusNotFound, codes.NotFound, fmt.Sprintf("no RPC at %s", r.URL.Path))
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeGatewayError(w, http.StatusMethodNotAllowed, codes.Unimplemented, "use POST")
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxGatewayBodySize+1))
+	if err != nil {
+		writeGatewayError(w, http.StatusBadRequest, codes.InvalidArgument, fmt.Sprintf("failed to read body: %v", err))
+		return
+	}
+	if len(body) > maxGatewayBodySize {
+		writeGatewayError(w, http.StatusRequestEntityTooLarge, codes.InvalidArgument, "request body too large")
+		return
+	}
+
+	req := method.input.New().Interface()
+	if len(strings.TrimSpace(string(body))) > 0 {
+		if err := protojson.Unmarshal(body, req); err != nil {
+			writeGatewayError(w, http.StatusBadRequest, codes.InvalidArgument, fmt.Sprintf("invalid request body: %v", err))
+			return
+		}
+	}
+
+	// Forward the API key so remote gateway calls are authenticated like
+	// remote gRPC calls.
+	ctx := r.Context()
+	if authz := r.Header.Get("Authorization"); authz != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authz)
+	}
+
+	resp := method.output.New().Interface()
+	if err := g.conn.Invoke(ctx, method.fullMethod, req, resp); err != nil {
+		st := status.Convert(err)
+		writeGatewayError(w, httpStatusFromCode(st.Code()), st.Code(), st.Message())
+		return
+	}
+
+	data, err := protojson.Marshal(resp)
+	if err != nil {
+		g.logger.Error("failed to encode gateway response", "method", method.fullMethod, "error", err)
+		writeGatewayError(w, http.StatusInternalServerError, codes.Internal, "failed to encode response")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// gatewayError is the JSON body of a failed gateway request.
+type gatewayError struct {
+	Code    int    `json:"code"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+func writeGatewayError(w http.ResponseWriter, httpStatus int, code codes.Code, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(gatewayError{Code: int(code), Status: code.String(), Message: msg})
+}
+
+// httpStatusFromCode maps a gRPC status code to an HTTP status, following
+// google.rpc.Code.
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// openAPIDocument describes the gateway routes as an OpenAPI 3 document.
+func (g *Gateway) openAPIDocument() map[string]any {
+	names := make([]string, 0, len(g.methods))
+	for name := range g.methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	schemas := map[string]any{
+		"Error": map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"code":    map[string]any{"type": "integer"},
+				"status":  map[string]any{"type": "string"},
+				"message": map[string]any{"type": "string"},
+			},
+		},
+	}
+	paths := make(map[string]any, len(names))
+	for _, name := range names {
+		m := g.methods[name]
+		service, rpc, _ := strings.Cut(name, "/")
+		in := addOpenAPISchema(schemas, m.input.Descriptor())
+		out := addOpenAPISchema(schemas, m.output.Descriptor())
+
+		paths[gatewayPathPrefix+name] = map[string]any{
+			"post": map[string]any{
+				"operationId": service + "_" + rpc,
+				"tags":        []string{service},
+				"requestBody": map[string]any{
+					"required": true,
+					"content": map[string]any{
+						"application/json": map[string]any{"schema": in},
+					},
+				},
+				"responses": map[string]any{
+					"200": map[string]any{
+						"description": "OK",
+						"content": map[string]any{
+							"application/json": map[string]any{"schema": out},
+						},
+					},
+					"default": map[string]any{
+						"description": "Error",
+						"content": map[string]any{
+							"application/json": map[string]any{"schema": schemaRef("Error")},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "devnetd API",
+			"version": "v1",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"securitySchemes": map[string]any{
+				"bearerAuth": map[string]any{"type": "http", "scheme": "bearer"},
+			},
+			"schemas": schemas,
+		},
+		"security": []any{map[string]any{"bearerAuth": []string{}}},
+	}
+}
+
+func schemaRef(name string) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+// addOpenAPISchema adds the schema of md, and the messages it references, to
+// schemas and returns a reference to it.
+func addOpenAPISchema(schemas map[string]any, md protoreflect.MessageDescriptor) map[string]any {
+	if s := wellKnownSchema(md.FullName()); s != nil {
+		return s
+	}
+	name := string(md.FullName())
+	if _, ok := schemas[name]; ok {
+		return schemaRef(name)
+	}
+
+	properties := make(map[string]any)
+	schema := map[string]any{"type": "object", "properties": properties}
+	// Register before recursing so self-referencing messages terminate.
+	schemas[name] = schema
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		properties[fd.JSONName()] = fieldSchema(schemas, fd)
+	}
+	return schemaRef(name)
+}
+
+func fieldSchema(schemas map[string]any, fd protoreflect.FieldDescriptor) map[string]any {
+	if fd.IsMap() {
+		return map[string]any{
+			"type":                 "object",
+			"additionalProperties": singularFieldSchema(schemas, fd.MapValue()),
+		}
+	}
+	if fd.IsList() {
+		return map[string]any{"type": "array", "items": singularFieldSchema(schemas, fd)}
+	}
+	return singularFieldSchema(schemas, fd)
+}
+
+// singularFieldSchema returns the schema of one value of fd in the canonical
+// protobuf JSON mapping, where 64-bit integers are strings.
+func singularFieldSchema(schemas map[string]any, fd protoreflect.FieldDescriptor) map[string]any {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}
+	case protoreflect.StringKind:
+		return map[string]any{"type": "string"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		return map[string]any{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return addOpenAPISchema(schemas, fd.Message())
+	default:
+		return map[string]any{}
+	}
+}
+
+// wellKnownSchema returns the JSON schema of well-known types that have a
+// special JSON mapping, or nil.
+func wellKnownSchema(name protoreflect.FullName) map[string]any {
+	switch name {
+	case "google.protobuf.Timestamp":
+		return map[string]any{"type": "string", "format": "date-time"}
+	case "google.protobuf.Duration":
+		return map[string]any{"type": "string"}
+	case "google.protobuf.Struct":
+		return map[string]any{"type": "object"}
+	case "google.protobuf.Value":
+		return map[string]any{}
+	default:
+		return nil
+	}
+}
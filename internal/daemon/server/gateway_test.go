@@ -0,0 +1,224 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+)
+
+// newTestGateway serves a DevnetService and NodeService over an in-memory
+// gRPC connection behind a Gateway.
+func newTestGateway(t *testing.T, opts ...grpc.ServerOption) *httptest.Server {
+	t.Helper()
+
+	s := store.NewMemoryStore()
+	if err := s.CreateDevnet(context.Background(), &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "my-devnet", Namespace: types.DefaultNamespace},
+		Spec:     types.DevnetSpec{Plugin: "stable", Validators: 2},
+		Status:   types.DevnetStatus{Phase: types.PhaseRunning},
+	}); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+
+	grpcServer := grpc.NewServer(opts...)
+	v1.RegisterDevnetServiceServer(grpcServer, NewDevnetService(s, nil, nil))
+	v1.RegisterNodeServiceServer(grpcServer, NewNodeService(s, nil, nil))
+	reflection.Register(grpcServer)
+
+	lis := newPipeListener()
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///test",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	gateway, err := NewGateway(conn, grpcServer.GetServiceInfo())
+	if err != nil {
+		t.Fatalf("NewGateway failed: %v", err)
+	}
+	srv := httptest.NewServer(gateway)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func postJSON(t *testing.T, url, body string, header http.Header) (int, map[string]any) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("POST %s failed: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	data, _ := io.ReadAll(resp.Body)
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("invalid JSON response %q: %v", data, err)
+	}
+	return resp.StatusCode, out
+}
+
+func TestGateway_UnaryRPC(t *testing.T) {
+	srv := newTestGateway(t)
+
+	code, body := postJSON(t, srv.URL+"/v1/DevnetService/GetDevnet", `{"name":"my-devnet","namespace":"default"}`, nil)
+	if code != http.StatusOK {
+		t.Fatalf("status = %d, body = %v", code, body)
+	}
+	devnet, _ := body["devnet"].(map[string]any)
+	meta, _ := devnet["metadata"].(map[string]any)
+	if meta["name"] != "my-devnet" {
+		t.Errorf("unexpected response: %v", body)
+	}
+
+	// An empty body is an empty request message.
+	code, body = postJSON(t, srv.URL+"/v1/DevnetService/ListDevnets", "", nil)
+	if code != http.StatusOK {
+		t.Fatalf("status = %d, body = %v", code, body)
+	}
+	if devnets, _ := body["devnets"].([]any); len(devnets) != 1 {
+		t.Errorf("expected 1 devnet, got %v", body)
+	}
+}
+
+func TestGateway_Errors(t *testing.T) {
+	srv := newTestGateway(t)
+
+	tests := []struct {
+		name   string
+		path   string
+		body   string
+		status int
+		code   string
+	}{
+		{"grpc not found", "/v1/DevnetService/GetDevnet", `{"name":"missing"}`, http.StatusNotFound, "NotFound"},
+		{"grpc invalid argument", "/v1/DevnetService/GetDevnet", `{}`, http.StatusBadRequest, "InvalidArgument"},
+		{"malformed body", "/v1/DevnetService/GetDevnet", `{"nme":1}`, http.StatusBadRequest, "InvalidArgument"},
+		{"unknown method", "/v1/DevnetService/Nope", `{}`, http.StatusNotFound, "NotFound"},
+		{"streaming method", "/v1/NodeService/StreamNodeLogs", `{}`, http.StatusNotFound, "NotFound"},
+		{"reflection service", "/v1/ServerReflection/ServerReflectionInfo", `{}`, http.StatusNotFound, "NotFound"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, body := postJSON(t, srv.URL+tt.path, tt.body, nil)
+			if code != tt.status || body["status"] != tt.code {
+				t.Errorf("got %d %v, want %d %s", code, body, tt.status, tt.code)
+			}
+		})
+	}
+
+	resp, err := http.Get(srv.URL + "/v1/DevnetService/ListDevnets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("GET status = %d, want 405", resp.StatusCode)
+	}
+}
+
+func TestGateway_ForwardsAuthorization(t *testing.T) {
+	var got []string
+	srv := newTestGateway(t, grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		got = md.Get("authorization")
+		return handler(ctx, req)
+	}))
+
+	postJSON(t, srv.URL+"/v1/DevnetService/ListDevnets", `{}`, http.Header{"Authorization": {"Bearer devnet_abc"}})
+	if len(got) != 1 || got[0] != "Bearer devnet_abc" {
+		t.Errorf("authorization metadata = %v", got)
+	}
+}
+
+func TestGateway_OpenAPI(t *testing.T) {
+	srv := newTestGateway(t)
+
+	resp, err := http.Get(srv.URL + "/openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var doc struct {
+		OpenAPI    string                    `json:"openapi"`
+		Paths      map[string]map[string]any `json:"paths"`
+		Components struct {
+			Schemas map[string]map[string]any `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		t.Fatalf("invalid OpenAPI document: %v", err)
+	}
+
+	if doc.OpenAPI != "3.0.3" {
+		t.Errorf("openapi = %q", doc.OpenAPI)
+	}
+	if _, ok := doc.Paths["/v1/DevnetService/CreateDevnet"]["post"]; !ok {
+		t.Error("missing CreateDevnet route")
+	}
+	if _, ok := doc.Paths["/v1/NodeService/StreamNodeLogs"]; ok {
+		t.Error("streaming RPCs must not be documented")
+	}
+
+	spec, ok := doc.Components.Schemas["devnetbuilder.v1.DevnetSpec"]
+	if !ok {
+		t.Fatal("missing DevnetSpec schema")
+	}
+	props, _ := spec["properties"].(map[string]any)
+	validators, _ := props["validators"].(map[string]any)
+	if validators["type"] != "integer" {
+		t.Errorf("validators schema = %v", validators)
+	}
+	meta := doc.Components.Schemas["devnetbuilder.v1.DevnetMetadata"]
+	metaProps, _ := meta["properties"].(map[string]any)
+	createdAt, _ := metaProps["createdAt"].(map[string]any)
+	if createdAt["format"] != "date-time" {
+		t.Errorf("createdAt schema = %v", createdAt)
+	}
+}
+
+func TestHTTPStatusFromCode(t *testing.T) {
+	tests := map[codes.Code]int{
+		codes.OK:                 http.StatusOK,
+		codes.Unauthenticated:    http.StatusUnauthorized,
+		codes.PermissionDenied:   http.StatusForbidden,
+		codes.AlreadyExists:      http.StatusConflict,
+		codes.FailedPrecondition: http.StatusBadRequest,
+		codes.Unavailable:        http.StatusServiceUnavailable,
+		codes.Internal:           http.StatusInternalServerError,
+	}
+	for code, want := range tests {
+		if got := httpStatusFromCode(code); got != want {
+			t.Errorf("httpStatusFromCode(%v) = %d, want %d", code, got, want)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/controller"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/export"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportPollInterval is how often ExportGenesis checks on the nodes it
+// waits for.
+var exportPollInterval = time.Second
+
+// GenesisExporter runs a chain binary's export command and returns the
+// genesis it prints.
+type GenesisExporter interface {
+	Export(ctx context.Context, binaryPath string, args []string, height int64) ([]byte, error)
+}
+
+// SetGenesisExporter sets the exporter ExportGenesis runs export commands
+// with. The default runs them with export.ExportExecutor.
+func (s *DevnetService) SetGenesisExporter(e GenesisExporter) {
+	s.genesisExporter = e
+}
+
+// ExportGenesis exports a devnet's state as a genesis file. The state comes
+// from a full node, halted for the export unless it already is; devnets
+// without full nodes are halted as a whole and export from node 0. Halted
+// nodes are restarted afterwards, whether the export succeeded or not.
+func (s *DevnetService) ExportGenesis(ctx context.Context, req *v1.ExportGenesisRequest) (*v1.ExportGenesisResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must not be negative")
+	}
+
+	devnet, err := s.store.GetDevnet(ctx, req.GetNamespace(), req.DevnetName)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", req.DevnetName)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+	switch devnet.Status.Phase {
+	case types.PhaseRunning, types.PhaseDegraded, types.PhaseStopped:
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "devnet %q is %s; genesis export needs a running or stopped devnet",
+			req.DevnetName, devnet.Status.Phase)
+	}
+
+	module, err := network.Get(devnet.Spec.Plugin)
+	if err != nil {
+		return nil, apierror.Errorf(apierror.PluginMissing, "network %q not found: %v", devnet.Spec.Plugin, err)
+	}
+
+	namespace := devnet.Metadata.Namespace
+	nodes, err := s.store.ListNodes(ctx, namespace, req.DevnetName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list nodes: %v", err)
+	}
+	source, halt := exportPlan(nodes)
+	if source == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "devnet %q has no nodes", req.DevnetName)
+	}
+	if source.Spec.BinaryPath == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "node %s has no local binary to export with; genesis export supports local-mode devnets",
+			source.Metadata.Name)
+	}
+
+	// A height still ahead is waited for before halting
+	if req.Height > source.Status.BlockHeight {
+		if source.Status.Phase != types.NodePhaseRunning {
+			return nil, status.Errorf(codes.FailedPrecondition, "node %s is %s at height %d, below the requested height %d",
+				source.Metadata.Name, source.Status.Phase, source.Status.BlockHeight, req.Height)
+		}
+		s.logger.Info("waiting for export height", "devnet", req.DevnetName, "node", source.Metadata.Name, "height", req.Height)
+		if _, err := s.waitForNodes(ctx, []*types.Node{source}, func(n *types.Node) bool {
+			return n.Status.BlockHeight >= req.Height
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	halted, err := s.haltNodes(ctx, halt, "genesis export")
+	defer s.resumeNodes(context.WithoutCancel(ctx), halted, "genesis export")
+	if err != nil {
+		return nil, err
+	}
+	stopped, err := s.waitForNodes(ctx, append(halted, source), func(n *types.Node) bool {
+		return n.Status.Phase == types.NodePhaseStopped || n.Status.Phase == types.NodePhaseCrashed
+	})
+	if err != nil {
+		return nil, err
+	}
+	source = stopped[len(stopped)-1]
+
+	height := req.Height
+	if height == 0 {
+		height = source.Status.BlockHeight
+	}
+	if height <= 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "node %s has no known block height", source.Metadata.Name)
+	}
+
+	s.logger.Info("exporting genesis", "devnet", req.DevnetName, "node", source.Metadata.Name, "height", height)
+	exporter := s.genesisExporter
+	if exporter == nil {
+		exporter = export.NewExportExecutor()
+	}
+	args := append(module.ExportCommand(source.Spec.HomeDir), "--height", strconv.FormatInt(height, 10))
+	genesis, err := exporter.Export(ctx, source.Spec.BinaryPath, args, height)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export genesis: %v", err)
+	}
+	if v, ok := module.(network.StateExporter); ok {
+		if err := v.ValidateExportedGenesis(genesis); err != nil {
+			return nil, status.Errorf(codes.Internal, "exported genesis is invalid: %v", err)
+		}
+	}
+
+	var doc struct {
+		ChainID string `json:"chain_id"`
+	}
+	_ = json.Unmarshal(genesis, &doc) // The exporter checked the structure
+
+	resp := &v1.ExportGenesisResponse{
+		Genesis: genesis,
+		ChainId: doc.ChainID,
+		Height:  height,
+		Node:    source.Metadata.Name,
+	}
+	for _, n := range halted {
+		resp.HaltedNodes = append(resp.HaltedNodes, n.Metadata.Name)
+	}
+	return resp, nil
+}
+
+// exportPlan picks the node a genesis is exported from and the nodes to
+// halt for it: a full node already stopped, else a running full node alone,
+// else node 0 with every node not stopped on purpose.
+func exportPlan(nodes []*types.Node) (source *types.Node, halt []*types.Node) {
+	var fullNodes []*types.Node
+	for _, n := range nodes {
+		if n.Spec.Role == "fullnode" {
+			fullNodes = append(fullNodes, n)
+		}
+	}
+	for _, n := range fullNodes {
+		if n.Spec.Desired == types.NodePhaseStopped && n.Status.Phase == types.NodePhaseStopped {
+			return n, nil
+		}
+	}
+	for i := len(fullNodes) - 1; i >= 0; i-- {
+		if n := fullNodes[i]; n.Status.Phase == types.NodePhaseRunning {
+			return n, []*types.Node{n}
+		}
+	}
+
+	for _, n := range nodes {
+		if n.Spec.Index == 0 {
+			source = n
+		}
+		if n.Spec.Desired != types.NodePhaseStopped {
+			halt = append(halt, n)
+		}
+	}
+	return source, halt
+}
+
+// haltNodes stops nodes for an operation on their state, such as a genesis
+// export, and returns the ones it stopped.
+func (s *DevnetService) haltNodes(ctx context.Context, nodes []*types.Node, operation string) ([]*types.Node, error) {
+	var halted []*types.Node
+	for _, node := range nodes {
+		node.Spec.Desired = types.NodePhaseStopped
+		if node.Status.Phase == types.NodePhaseRunning {
+			node.Status.Phase = types.NodePhaseStopping
+			node.Status.Message = "Halting for " + operation
+		}
+		node.Metadata.UpdatedAt = time.Now()
+		if err := s.store.UpdateNode(ctx, node); err != nil {
+			return halted, status.Errorf(codes.Internal, "failed to halt node %s: %v", node.Metadata.Name, err)
+		}
+		halted = append(halted, node)
+		s.enqueueNode(node)
+	}
+	return halted, nil
+}
+
+// resumeNodes restarts the nodes halted for an operation.
+func (s *DevnetService) resumeNodes(ctx context.Context, nodes []*types.Node, operation string) {
+	for _, n := range nodes {
+		node, err := s.store.GetNode(ctx, n.Metadata.Namespace, n.Spec.DevnetRef, n.Spec.Index)
+		if err != nil {
+			s.logger.Error("failed to resume node", "node", n.Metadata.Name, "after", operation, "error", err)
+			continue
+		}
+		node.Spec.Desired = types.NodePhaseRunning
+		node.Status.Phase = types.NodePhasePending
+		node.Status.Message = "Resuming after " + operation
+		node.Metadata.UpdatedAt = time.Now()
+		if err := s.store.UpdateNode(ctx, node); err != nil {
+			s.logger.Error("failed to resume node", "node", n.Metadata.Name, "after", operation, "error", err)
+			continue
+		}
+		s.enqueueNode(node)
+	}
+}
+
+// waitForNodes polls nodes until done holds for each, returning their
+// latest state in the same order.
+func (s *DevnetService) waitForNodes(ctx context.Context, nodes []*types.Node, done func(*types.Node) bool) ([]*types.Node, error) {
+	ticker := time.NewTicker(exportPollInterval)
+	defer ticker.Stop()
+	for {
+		latest := make([]*types.Node, 0, len(nodes))
+		waiting := false
+		for _, n := range nodes {
+			node, err := s.store.GetNode(ctx, n.Metadata.Namespace, n.Spec.DevnetRef, n.Spec.Index)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get node %s: %v", n.Metadata.Name, err)
+			}
+			latest = append(latest, node)
+			waiting = waiting || !done(node)
+		}
+		if !waiting {
+			return latest, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *DevnetService) enqueueNode(node *types.Node) {
+	if s.manager != nil {
+		s.manager.Enqueue("nodes", controller.NodeKeyWithNamespace(node.Metadata.Namespace, node.Spec.DevnetRef, node.Spec.Index))
+	}
+}
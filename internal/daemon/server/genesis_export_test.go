@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeGenesisExporter records the export command it is asked to run.
+type fakeGenesisExporter struct {
+	binaryPath string
+	args       []string
+}
+
+func (f *fakeGenesisExporter) Export(ctx context.Context, binaryPath string, args []string, height int64) ([]byte, error) {
+	f.binaryPath, f.args = binaryPath, args
+	return []byte(`{"chain_id":"alpha-1","app_state":{"auth":{},"bank":{},"staking":{}}}`), nil
+}
+
+func exportTestNode(index int, role, phase string, height int64) *types.Node {
+	desired := types.NodePhaseRunning
+	if phase == types.NodePhaseStopped {
+		desired = types.NodePhaseStopped
+	}
+	return &types.Node{
+		Metadata: types.ResourceMeta{Name: "alpha-" + role, Namespace: types.DefaultNamespace},
+		Spec: types.NodeSpec{
+			DevnetRef:  "alpha",
+			Index:      index,
+			Role:       role,
+			BinaryPath: "/bin/stabled",
+			HomeDir:    "/data/alpha/" + role,
+			Desired:    desired,
+		},
+		Status: types.NodeStatus{Phase: phase, BlockHeight: height},
+	}
+}
+
+func TestExportPlan(t *testing.T) {
+	validator := exportTestNode(0, "validator", types.NodePhaseRunning, 10)
+	full := exportTestNode(1, "fullnode", types.NodePhaseRunning, 10)
+	halted := exportTestNode(2, "fullnode", types.NodePhaseStopped, 8)
+
+	source, halt := exportPlan([]*types.Node{validator, full, halted})
+	if source != halted || len(halt) != 0 {
+		t.Errorf("expected the halted full node alone, got %s and %d to halt", source.Metadata.Name, len(halt))
+	}
+
+	source, halt = exportPlan([]*types.Node{validator, full})
+	if source != full || len(halt) != 1 || halt[0] != full {
+		t.Errorf("expected to halt the running full node, got %s", source.Metadata.Name)
+	}
+
+	stopped := exportTestNode(1, "validator", types.NodePhaseStopped, 9)
+	source, halt = exportPlan([]*types.Node{validator, stopped})
+	if source != validator || len(halt) != 1 || halt[0] != validator {
+		t.Errorf("expected to halt the running validators and export node 0, got %s", source.Metadata.Name)
+	}
+}
+
+func TestDevnetService_ExportGenesis(t *testing.T) {
+	defer func(d time.Duration) { exportPollInterval = d }(exportPollInterval)
+	exportPollInterval = 10 * time.Millisecond
+
+	if _, err := network.Get("genesis-export-test"); err != nil {
+		network.Register(newMockModule("genesis-export-test", "exportd"))
+	}
+
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+	exporter := &fakeGenesisExporter{}
+	svc.SetGenesisExporter(exporter)
+
+	if _, err := svc.ExportGenesis(ctx, &v1.ExportGenesisRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for missing name, got %v", err)
+	}
+	if _, err := svc.ExportGenesis(ctx, &v1.ExportGenesisRequest{DevnetName: "alpha"}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for missing devnet, got %v", err)
+	}
+
+	if err := s.CreateDevnet(ctx, &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "alpha", Namespace: types.DefaultNamespace},
+		Spec:     types.DevnetSpec{Plugin: "genesis-export-test", Validators: 1, FullNodes: 1},
+		Status:   types.DevnetStatus{Phase: types.PhaseRunning},
+	}); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+	for _, n := range []*types.Node{
+		exportTestNode(0, "validator", types.NodePhaseRunning, 12),
+		exportTestNode(1, "fullnode", types.NodePhaseRunning, 12),
+	} {
+		if err := s.CreateNode(ctx, n); err != nil {
+			t.Fatalf("CreateNode failed: %v", err)
+		}
+	}
+
+	// Stand in for the node controller: stop the full node once halted
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(exportPollInterval):
+			}
+			node, err := s.GetNode(ctx, types.DefaultNamespace, "alpha", 1)
+			if err == nil && node.Status.Phase == types.NodePhaseStopping {
+				node.Status.Phase = types.NodePhaseStopped
+				_ = s.UpdateNode(ctx, node)
+			}
+		}
+	}()
+
+	resp, err := svc.ExportGenesis(ctx, &v1.ExportGenesisRequest{DevnetName: "alpha", Height: 10})
+	if err != nil {
+		t.Fatalf("ExportGenesis failed: %v", err)
+	}
+	if resp.ChainId != "alpha-1" || resp.Height != 10 || resp.Node != "alpha-fullnode" {
+		t.Errorf("unexpected response: chain %q, height %d, node %q", resp.ChainId, resp.Height, resp.Node)
+	}
+	if !slices.Equal(resp.HaltedNodes, []string{"alpha-fullnode"}) {
+		t.Errorf("HaltedNodes = %v, want the full node", resp.HaltedNodes)
+	}
+	if exporter.binaryPath != "/bin/stabled" || !slices.Equal(exporter.args, []string{"export", "--height", "10"}) {
+		t.Errorf("unexpected export command: %s %v", exporter.binaryPath, exporter.args)
+	}
+
+	// The full node is restarted; the validator was never touched
+	full, _ := s.GetNode(ctx, types.DefaultNamespace, "alpha", 1)
+	if full.Spec.Desired != types.NodePhaseRunning || full.Status.Phase != types.NodePhasePending {
+		t.Errorf("full node not resumed: desired %s, phase %s", full.Spec.Desired, full.Status.Phase)
+	}
+	validator, _ := s.GetNode(ctx, types.DefaultNamespace, "alpha", 0)
+	if validator.Status.Phase != types.NodePhaseRunning {
+		t.Errorf("validator phase = %s, want Running", validator.Status.Phase)
+	}
+
+	// A halted node cannot reach a height ahead of it
+	full.Spec.Desired, full.Status.Phase = types.NodePhaseStopped, types.NodePhaseStopped
+	if err := s.UpdateNode(ctx, full); err != nil {
+		t.Fatalf("UpdateNode failed: %v", err)
+	}
+	_, err = svc.ExportGenesis(ctx, &v1.ExportGenesisRequest{DevnetName: "alpha", Height: 100})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition for a height past a halted node, got %v", err)
+	}
+}
@@ -2,10 +2,10 @@ package server
 
 import (
 	"log/slog"
-	"os"
 	"path/filepath"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/credentials"
 	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/github"
 )
 
@@ -36,8 +36,7 @@ func (f *DefaultGitHubClientFactory) CreateClient(networkName, owner, repo strin
 	// Create network-specific cache directory
 	networkCacheDir := filepath.Join(f.cacheDir, networkName)
 
-	// Get token from environment (standard GITHUB_TOKEN)
-	token := os.Getenv("GITHUB_TOKEN")
+	token := credentials.GitHubToken()
 
 	f.logger.Debug("creating GitHub client",
 		"network", networkName,
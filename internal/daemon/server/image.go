@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CheckImage compares the digest a docker-mode devnet's image was pinned
+// to with the digest its tag resolves to in the registry now.
+func (s *DevnetService) CheckImage(ctx context.Context, req *v1.CheckImageRequest) (*v1.CheckImageResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+
+	devnet, err := s.store.GetDevnet(ctx, req.GetNamespace(), req.DevnetName)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", req.DevnetName)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+	if devnet.Status.ImageDigest == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "devnet %q has no pinned image", req.DevnetName)
+	}
+
+	pinner, ok := s.runtime.(runtime.ImagePinner)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition,
+			"checking images requires the docker runtime; start devnetd with --runtime docker")
+	}
+	upstream, err := pinner.UpstreamDigest(ctx, devnet.Status.Image)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "%v", err)
+	}
+
+	return &v1.CheckImageResponse{
+		Image:          devnet.Status.Image,
+		PinnedDigest:   devnet.Status.ImageDigest,
+		UpstreamDigest: upstream,
+		Moved:          upstream != devnet.Status.ImageDigest,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// imageRuntime is a NodeRuntime whose registry has moved some tags.
+type imageRuntime struct {
+	runtime.NodeRuntime
+	upstream map[string]string
+}
+
+func (r *imageRuntime) PinImage(ctx context.Context, image string, offline bool) (runtime.PinnedImage, error) {
+	return runtime.PinnedImage{}, errors.New("not implemented")
+}
+
+func (r *imageRuntime) UpstreamDigest(ctx context.Context, image string) (string, error) {
+	digest, ok := r.upstream[image]
+	if !ok {
+		return "", errors.New("manifest unknown")
+	}
+	return digest, nil
+}
+
+func TestDevnetService_CheckImage(t *testing.T) {
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+	ctx := context.Background()
+
+	devnets := map[string]types.DevnetStatus{
+		"current":  {Phase: types.PhaseRunning, Image: "stablelabs/stabled:v1", ImageDigest: "sha256:aaa"},
+		"moved":    {Phase: types.PhaseRunning, Image: "stablelabs/stabled:latest", ImageDigest: "sha256:aaa"},
+		"local":    {Phase: types.PhaseRunning, Image: "mychain:dev", ImageDigest: "sha256:111"},
+		"unpinned": {Phase: types.PhaseRunning},
+	}
+	for name, st := range devnets {
+		if err := s.CreateDevnet(ctx, &types.Devnet{
+			Metadata: types.ResourceMeta{Name: name, Namespace: types.DefaultNamespace},
+			Spec:     types.DevnetSpec{Plugin: "stable", Validators: 1, Mode: "docker"},
+			Status:   st,
+		}); err != nil {
+			t.Fatalf("CreateDevnet failed: %v", err)
+		}
+	}
+
+	// Without the docker runtime
+	svc.SetDebugSources(nil, t.TempDir())
+	_, err := svc.CheckImage(ctx, &v1.CheckImageRequest{DevnetName: "current"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition without docker runtime, got %v", err)
+	}
+
+	svc.SetDebugSources(&imageRuntime{upstream: map[string]string{
+		"stablelabs/stabled:v1":     "sha256:aaa",
+		"stablelabs/stabled:latest": "sha256:bbb",
+	}}, t.TempDir())
+
+	tests := []struct {
+		name  string
+		req   *v1.CheckImageRequest
+		code  codes.Code
+		moved bool
+	}{
+		{name: "missing devnet name", req: &v1.CheckImageRequest{}, code: codes.InvalidArgument},
+		{name: "devnet not found", req: &v1.CheckImageRequest{DevnetName: "missing"}, code: codes.NotFound},
+		{name: "no pinned image", req: &v1.CheckImageRequest{DevnetName: "unpinned"}, code: codes.FailedPrecondition},
+		{name: "not in a registry", req: &v1.CheckImageRequest{DevnetName: "local"}, code: codes.Unavailable},
+		{name: "tag unchanged", req: &v1.CheckImageRequest{DevnetName: "current"}, code: codes.OK},
+		{name: "tag moved", req: &v1.CheckImageRequest{DevnetName: "moved"}, code: codes.OK, moved: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := svc.CheckImage(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Fatalf("expected code %v, got %v (%v)", tt.code, status.Code(err), err)
+			}
+			if err == nil && resp.Moved != tt.moved {
+				t.Errorf("expected moved=%v, got %+v", tt.moved, resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/keys"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportKeys returns the deterministic keys of a devnet's validator operators
+// and test accounts. Keys are derived rather than read from node homes, so
+// they are available for devnets in any phase.
+func (s *DevnetService) ExportKeys(ctx context.Context, req *v1.ExportKeysRequest) (*v1.ExportKeysResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+
+	devnet, err := s.store.GetDevnet(ctx, req.GetNamespace(), req.DevnetName)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", req.DevnetName)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+
+	module, err := network.Get(devnet.Spec.Plugin)
+	if err != nil {
+		return nil, apierror.Errorf(apierror.PluginMissing, "network %q not found: %v", devnet.Spec.Plugin, err)
+	}
+
+	resp, err := devnetKeys(devnet, module.Bech32Prefix(), module.GenesisConfig().EVMChainID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to derive keys: %v", err)
+	}
+	return resp, nil
+}
+
+// devnetKeys derives the keys of a devnet's validators and test accounts,
+// from its seed if it has one.
+// A non-zero evmChainID selects Ethereum key derivation.
+func devnetKeys(devnet *types.Devnet, bech32Prefix string, evmChainID int64) (*v1.ExportKeysResponse, error) {
+	evm := evmChainID > 0
+	derived, err := keys.ForSeed(devnet.Spec.Seed).DeriveAll(bech32Prefix, devnet.Spec.Validators, devnet.Spec.Accounts, evm)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID := devnet.Spec.ChainID
+	if chainID == "" {
+		chainID = devnet.Metadata.Name + "-1"
+	}
+
+	resp := &v1.ExportKeysResponse{
+		ChainId:    chainID,
+		Evm:        evm,
+		EvmChainId: evmChainID,
+		Keys:       make([]*v1.AccountKey, 0, len(derived)),
+	}
+	for _, k := range derived {
+		resp.Keys = append(resp.Keys, &v1.AccountKey{
+			Name:       k.Name,
+			Role:       k.Role,
+			Index:      int32(k.Index),
+			Address:    k.Address,
+			EvmAddress: k.EVMAddress,
+			Mnemonic:   k.Mnemonic,
+			HdPath:     k.HDPath,
+			PrivateKey: k.PrivateKey,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDevnetService_ExportKeysValidation(t *testing.T) {
+	svc := NewDevnetService(store.NewMemoryStore(), nil, nil)
+	ctx := context.Background()
+
+	if _, err := svc.ExportKeys(ctx, &v1.ExportKeysRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for missing name, got %v", err)
+	}
+	if _, err := svc.ExportKeys(ctx, &v1.ExportKeysRequest{DevnetName: "missing"}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for missing devnet, got %v", err)
+	}
+}
+
+func TestDevnetKeys(t *testing.T) {
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "mydevnet"},
+		Spec:     types.DevnetSpec{Plugin: "stable", Validators: 2, Accounts: 3},
+	}
+
+	resp, err := devnetKeys(devnet, "stable", 9000)
+	if err != nil {
+		t.Fatalf("devnetKeys: %v", err)
+	}
+	if resp.ChainId != "mydevnet-1" {
+		t.Errorf("ChainId = %q, want mydevnet-1", resp.ChainId)
+	}
+	if !resp.Evm || resp.EvmChainId != 9000 {
+		t.Errorf("Evm = %v, EvmChainId = %d, want true, 9000", resp.Evm, resp.EvmChainId)
+	}
+	if len(resp.Keys) != 5 {
+		t.Fatalf("expected 5 keys, got %d", len(resp.Keys))
+	}
+	if resp.Keys[0].Name != "validator0" || resp.Keys[2].Name != "account0" {
+		t.Errorf("unexpected key order: %s, %s", resp.Keys[0].Name, resp.Keys[2].Name)
+	}
+	for _, k := range resp.Keys {
+		if !strings.HasPrefix(k.Address, "stable1") || !strings.HasPrefix(k.EvmAddress, "0x") || k.Mnemonic == "" || k.PrivateKey == "" {
+			t.Errorf("incomplete key: %+v", k)
+		}
+	}
+
+	again, _ := devnetKeys(devnet, "stable", 9000)
+	if again.Keys[3].Address != resp.Keys[3].Address {
+		t.Error("keys should be deterministic")
+	}
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/controller"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/logsearch"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultSearchMatches and maxSearchMatches bound the matches of a log
+	// search.
+	defaultSearchMatches = 1000
+	maxSearchMatches     = 10000
+
+	// maxSearchContext bounds the context lines of each match.
+	maxSearchContext = 100
+)
+
+// SearchLogs searches the logs of every node of a devnet, and the daemon
+// log lines that mention it, in parallel.
+func (s *DevnetService) SearchLogs(ctx context.Context, req *v1.SearchLogsRequest) (*v1.SearchLogsResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+	if req.Pattern == "" {
+		return nil, status.Error(codes.InvalidArgument, "pattern is required")
+	}
+	if req.Context < 0 || req.Context > maxSearchContext {
+		return nil, status.Errorf(codes.InvalidArgument, "context must be between 0 and %d", maxSearchContext)
+	}
+	if req.MaxMatches < 0 || req.MaxMatches > maxSearchMatches {
+		return nil, status.Errorf(codes.InvalidArgument, "max_matches must be between 0 and %d", maxSearchMatches)
+	}
+
+	expr := req.Pattern
+	if req.IgnoreCase {
+		expr = "(?i)" + expr
+	}
+	pattern, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pattern: %v", err)
+	}
+
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+	if _, err := s.store.GetDevnet(ctx, namespace, req.DevnetName); err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", req.DevnetName)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+	nodes, err := s.store.ListNodes(ctx, namespace, req.DevnetName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list nodes: %v", err)
+	}
+
+	var since time.Time
+	if req.Since != nil {
+		since = req.Since.AsTime()
+	}
+
+	var sources []logsearch.Source
+	if s.runtime != nil {
+		for _, node := range nodes {
+			nodeID := node.Metadata.Name
+			if nodeID == "" {
+				nodeID = controller.NodeKey(node.Spec.DevnetRef, node.Spec.Index)
+			}
+			sources = append(sources, logsearch.Source{
+				Name: nodeID,
+				Open: func(ctx context.Context) (io.ReadCloser, error) {
+					return s.runtime.GetLogs(ctx, nodeID, runtime.LogOptions{Since: since})
+				},
+			})
+		}
+	}
+	if s.dataDir != "" {
+		sources = append(sources, daemonLogSource(filepath.Join(s.dataDir, "daemon.log"), req.DevnetName, since))
+	}
+
+	maxMatches := int(req.MaxMatches)
+	if maxMatches == 0 {
+		maxMatches = defaultSearchMatches
+	}
+	res := logsearch.Search(ctx, sources, logsearch.Options{
+		Pattern:    pattern,
+		Context:    int(req.Context),
+		MaxMatches: maxMatches,
+	})
+
+	resp := &v1.SearchLogsResponse{Truncated: res.Truncated, Errors: res.Errors}
+	for _, m := range res.Matches {
+		resp.Matches = append(resp.Matches, &v1.LogMatch{Source: m.Source, Line: m.Line, Before: m.Before, After: m.After})
+	}
+	return resp, nil
+}
+
+// daemonLogSource searches the daemon log lines that mention a devnet and
+// were logged at or after since.
+func daemonLogSource(path, devnetName string, since time.Time) logsearch.Source {
+	return logsearch.Source{
+		Name: "daemon",
+		Open: func(ctx context.Context) (io.ReadCloser, error) {
+			return os.Open(path)
+		},
+		Filter: func(line string) bool {
+			if !strings.Contains(line, devnetName) {
+				return false
+			}
+			if since.IsZero() || !strings.HasPrefix(line, "time=") {
+				return true
+			}
+			stamp, _, _ := strings.Cut(strings.TrimPrefix(line, "time="), " ")
+			t, err := time.Parse(time.RFC3339, stamp)
+			return err != nil || !t.Before(since)
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDevnetService_SearchLogs(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+	dataDir := t.TempDir()
+	svc.SetDebugSources(nil, dataDir)
+
+	daemonLog := "time=2026-01-10T11:00:00.000Z level=ERROR msg=\"node crashed\" devnet=alpha\n" +
+		"time=2026-01-10T12:00:00.000Z level=ERROR msg=\"node crashed\" devnet=beta\n" +
+		"time=2026-01-10T13:00:00.000Z level=ERROR msg=\"node crashed\" devnet=alpha node=alpha-1\n"
+	if err := os.WriteFile(filepath.Join(dataDir, "daemon.log"), []byte(daemonLog), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name string
+		req  *v1.SearchLogsRequest
+		code codes.Code
+	}{
+		{"missing pattern", &v1.SearchLogsRequest{DevnetName: "alpha"}, codes.InvalidArgument},
+		{"invalid pattern", &v1.SearchLogsRequest{DevnetName: "alpha", Pattern: "("}, codes.InvalidArgument},
+		{"too much context", &v1.SearchLogsRequest{DevnetName: "alpha", Pattern: "x", Context: 101}, codes.InvalidArgument},
+		{"missing devnet", &v1.SearchLogsRequest{DevnetName: "alpha", Pattern: "x"}, codes.NotFound},
+	} {
+		if _, err := svc.SearchLogs(ctx, tc.req); status.Code(err) != tc.code {
+			t.Errorf("%s: expected %s, got %v", tc.name, tc.code, err)
+		}
+	}
+
+	if err := s.CreateDevnet(ctx, &types.Devnet{Metadata: types.ResourceMeta{Name: "alpha"}}); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+
+	// Only the daemon log lines about the devnet are searched
+	resp, err := svc.SearchLogs(ctx, &v1.SearchLogsRequest{DevnetName: "alpha", Pattern: "NODE CRASHED", IgnoreCase: true})
+	if err != nil {
+		t.Fatalf("SearchLogs failed: %v", err)
+	}
+	if len(resp.Matches) != 2 || resp.Matches[0].Source != "daemon" {
+		t.Fatalf("unexpected matches: %v", resp.Matches)
+	}
+
+	since := time.Date(2026, 1, 10, 12, 30, 0, 0, time.UTC)
+	resp, err = svc.SearchLogs(ctx, &v1.SearchLogsRequest{DevnetName: "alpha", Pattern: "crashed", Since: timestamppb.New(since)})
+	if err != nil {
+		t.Fatalf("SearchLogs failed: %v", err)
+	}
+	if len(resp.Matches) != 1 || resp.Matches[0].Line != "time=2026-01-10T13:00:00.000Z level=ERROR msg=\"node crashed\" devnet=alpha node=alpha-1" {
+		t.Errorf("unexpected matches since %s: %v", since, resp.Matches)
+	}
+}
@@ -0,0 +1,216 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/auth"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// namespaceNameRegex matches DNS-label namespace names.
+var namespaceNameRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// NamespaceService implements the gRPC NamespaceServiceServer.
+type NamespaceService struct {
+	v1.UnimplementedNamespaceServiceServer
+	store  store.Store
+	logger *slog.Logger
+}
+
+// NewNamespaceService creates a new NamespaceService.
+func NewNamespaceService(s store.Store) *NamespaceService {
+	return &NamespaceService{
+		store:  s,
+		logger: slog.Default(),
+	}
+}
+
+// SetLogger sets the logger for the service.
+func (s *NamespaceService) SetLogger(logger *slog.Logger) {
+	s.logger = logger
+}
+
+// CreateNamespace creates a namespace with an optional quota. A namespace
+// that already holds devnets can be created to put a quota on it.
+func (s *NamespaceService) CreateNamespace(ctx context.Context, req *v1.CreateNamespaceRequest) (*v1.CreateNamespaceResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if !namespaceNameRegex.MatchString(req.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace name %q: must be lowercase alphanumerics and '-', at most 63 characters", req.Name)
+	}
+	if req.Quota.GetMaxDevnets() < 0 || req.Quota.GetMaxNodes() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "quota limits must not be negative")
+	}
+	if !auth.HasNamespaceAccess(ctx, req.Name) {
+		return nil, status.Errorf(codes.PermissionDenied, "access denied to namespace %q", req.Name)
+	}
+
+	s.logger.Info("creating namespace", "name", req.Name)
+
+	ns := &types.Namespace{
+		Name: req.Name,
+		Quota: types.NamespaceQuota{
+			MaxDevnets: int(req.Quota.GetMaxDevnets()),
+			MaxNodes:   int(req.Quota.GetMaxNodes()),
+		},
+	}
+	if err := s.store.CreateNamespace(ctx, ns); err != nil {
+		if store.IsAlreadyExists(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "namespace %q already exists", req.Name)
+		}
+		s.logger.Error("failed to create namespace", "name", req.Name, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to create namespace: %v", err)
+	}
+
+	devnets, err := s.store.ListDevnets(ctx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list devnets: %v", err)
+	}
+
+	return &v1.CreateNamespaceResponse{Namespace: namespaceToProto(req.Name, ns, devnets)}, nil
+}
+
+// ListNamespaces lists created namespaces and namespaces holding devnets
+// that the caller can access, with their quota and usage.
+func (s *NamespaceService) ListNamespaces(ctx context.Context, req *v1.ListNamespacesRequest) (*v1.ListNamespacesResponse, error) {
+	names, err := s.store.ListNamespaces(ctx)
+	if err != nil {
+		s.logger.Error("failed to list namespaces", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list namespaces: %v", err)
+	}
+
+	devnets, err := s.store.ListDevnets(ctx, "")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list devnets: %v", err)
+	}
+	byNamespace := make(map[string][]*types.Devnet)
+	for _, d := range devnets {
+		ns := d.Metadata.Namespace
+		if ns == "" {
+			ns = types.DefaultNamespace
+		}
+		byNamespace[ns] = append(byNamespace[ns], d)
+	}
+
+	resp := &v1.ListNamespacesResponse{}
+	for _, name := range names {
+		if !auth.HasNamespaceAccess(ctx, name) {
+			continue
+		}
+		ns, err := s.store.GetNamespace(ctx, name)
+		if err != nil && !store.IsNotFound(err) {
+			return nil, status.Errorf(codes.Internal, "failed to get namespace: %v", err)
+		}
+		resp.Namespaces = append(resp.Namespaces, namespaceToProto(name, ns, byNamespace[name]))
+	}
+
+	return resp, nil
+}
+
+// DeleteNamespace deletes a created namespace. It must not hold devnets.
+func (s *NamespaceService) DeleteNamespace(ctx context.Context, req *v1.DeleteNamespaceRequest) (*v1.DeleteNamespaceResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if req.Name == types.DefaultNamespace {
+		return nil, status.Errorf(codes.FailedPrecondition, "namespace %q cannot be deleted", types.DefaultNamespace)
+	}
+	if !auth.HasNamespaceAccess(ctx, req.Name) {
+		return nil, status.Errorf(codes.PermissionDenied, "access denied to namespace %q", req.Name)
+	}
+
+	devnets, err := s.store.ListDevnets(ctx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list devnets: %v", err)
+	}
+	if len(devnets) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "namespace %q has %d devnet(s); delete them first", req.Name, len(devnets))
+	}
+
+	s.logger.Info("deleting namespace", "name", req.Name)
+
+	if err := s.store.DeleteNamespace(ctx, req.Name); err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "namespace %q not found", req.Name)
+		}
+		s.logger.Error("failed to delete namespace", "name", req.Name, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete namespace: %v", err)
+	}
+
+	return &v1.DeleteNamespaceResponse{}, nil
+}
+
+// checkNamespaceQuota returns a ResourceExhausted error if devnet name,
+// provisioning nodes nodes, does not fit in the quota of namespace. The
+// devnet's own current usage is not counted, so the check applies to both
+// creating and resizing a devnet.
+func checkNamespaceQuota(ctx context.Context, s store.Store, namespace, name string, nodes int) error {
+	ns, err := s.GetNamespace(ctx, namespace)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil
+		}
+		return status.Errorf(codes.Internal, "failed to get namespace: %v", err)
+	}
+
+	quota := ns.Quota
+	if quota.MaxDevnets == 0 && quota.MaxNodes == 0 {
+		return nil
+	}
+
+	devnets, err := s.ListDevnets(ctx, namespace)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list devnets: %v", err)
+	}
+
+	exists := false
+	count, used := 0, 0
+	for _, d := range devnets {
+		if d.Metadata.Name == name {
+			exists = true
+			continue
+		}
+		count++
+		used += d.Spec.NodeCount()
+	}
+
+	if !exists && quota.MaxDevnets > 0 && count >= quota.MaxDevnets {
+		return status.Errorf(codes.ResourceExhausted,
+			"namespace %q quota exceeded: %d of %d devnets in use", namespace, count, quota.MaxDevnets)
+	}
+	if quota.MaxNodes > 0 && used+nodes > quota.MaxNodes {
+		return status.Errorf(codes.ResourceExhausted,
+			"namespace %q quota exceeded: devnet %q needs %d nodes but only %d of %d are free",
+			namespace, name, nodes, max(quota.MaxNodes-used, 0), quota.MaxNodes)
+	}
+	return nil
+}
+
+// namespaceToProto converts a namespace and the devnets in it. ns is nil for
+// namespaces that only exist because they hold devnets.
+func namespaceToProto(name string, ns *types.Namespace, devnets []*types.Devnet) *v1.Namespace {
+	pb := &v1.Namespace{
+		Name:        name,
+		DevnetCount: int32(len(devnets)),
+		Implicit:    ns == nil,
+	}
+	for _, d := range devnets {
+		pb.NodeCount += int32(d.Spec.NodeCount())
+	}
+	if ns != nil {
+		pb.Quota = &v1.NamespaceQuota{
+			MaxDevnets: int32(ns.Quota.MaxDevnets),
+			MaxNodes:   int32(ns.Quota.MaxNodes),
+		}
+		pb.CreatedAt = timestamppb.New(ns.CreatedAt)
+	}
+	return pb
+}
@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNamespaceService_CreateListDelete(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	svc := NewNamespaceService(s)
+
+	resp, err := svc.CreateNamespace(ctx, &v1.CreateNamespaceRequest{
+		Name:  "team-a",
+		Quota: &v1.NamespaceQuota{MaxDevnets: 2, MaxNodes: 8},
+	})
+	if err != nil {
+		t.Fatalf("CreateNamespace failed: %v", err)
+	}
+	if resp.Namespace.Implicit || resp.Namespace.Quota.MaxNodes != 8 {
+		t.Errorf("unexpected namespace: %v", resp.Namespace)
+	}
+
+	_, err = svc.CreateNamespace(ctx, &v1.CreateNamespaceRequest{Name: "team-a"})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("expected AlreadyExists, got %v", err)
+	}
+
+	// A namespace holding devnets is listed even without a record.
+	if err := s.CreateDevnet(ctx, &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "d1", Namespace: "team-b"},
+		Spec:     types.DevnetSpec{Validators: 2, FullNodes: 1},
+	}); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+
+	list, err := svc.ListNamespaces(ctx, &v1.ListNamespacesRequest{})
+	if err != nil {
+		t.Fatalf("ListNamespaces failed: %v", err)
+	}
+	if len(list.Namespaces) != 2 {
+		t.Fatalf("expected 2 namespaces, got %v", list.Namespaces)
+	}
+	teamB := list.Namespaces[1]
+	if teamB.Name != "team-b" || !teamB.Implicit || teamB.DevnetCount != 1 || teamB.NodeCount != 3 {
+		t.Errorf("unexpected implicit namespace: %v", teamB)
+	}
+
+	// Namespaces holding devnets cannot be deleted.
+	_, err = svc.DeleteNamespace(ctx, &v1.DeleteNamespaceRequest{Name: "team-b"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition, got %v", err)
+	}
+
+	if _, err := svc.DeleteNamespace(ctx, &v1.DeleteNamespaceRequest{Name: "team-a"}); err != nil {
+		t.Fatalf("DeleteNamespace failed: %v", err)
+	}
+	_, err = svc.DeleteNamespace(ctx, &v1.DeleteNamespaceRequest{Name: "team-a"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+}
+
+func TestNamespaceService_CreateValidation(t *testing.T) {
+	svc := NewNamespaceService(store.NewMemoryStore())
+
+	tests := []*v1.CreateNamespaceRequest{
+		{},
+		{Name: "Team_A"},
+		{Name: "-team"},
+		{Name: "team", Quota: &v1.NamespaceQuota{MaxNodes: -1}},
+	}
+	for _, req := range tests {
+		_, err := svc.CreateNamespace(context.Background(), req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("CreateNamespace(%v): expected InvalidArgument, got %v", req, err)
+		}
+	}
+}
+
+func TestNamespaceService_DeleteDefault(t *testing.T) {
+	svc := NewNamespaceService(store.NewMemoryStore())
+
+	_, err := svc.DeleteNamespace(context.Background(), &v1.DeleteNamespaceRequest{Name: types.DefaultNamespace})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition, got %v", err)
+	}
+}
+
+func TestDevnetService_NamespaceQuota(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	if err := s.CreateNamespace(ctx, &types.Namespace{
+		Name:  "team-a",
+		Quota: types.NamespaceQuota{MaxDevnets: 2, MaxNodes: 5},
+	}); err != nil {
+		t.Fatalf("CreateNamespace failed: %v", err)
+	}
+	svc := NewDevnetService(s, nil, nil)
+
+	create := func(name string, validators int32) error {
+		_, err := svc.CreateDevnet(ctx, &v1.CreateDevnetRequest{
+			Name:      name,
+			Namespace: "team-a",
+			Spec:      &v1.DevnetSpec{Plugin: "stable", Validators: validators, Mode: "docker"},
+		})
+		return err
+	}
+
+	if err := create("d1", 3); err != nil {
+		t.Fatalf("first devnet: %v", err)
+	}
+
+	err := create("d2", 3)
+	if status.Code(err) != codes.ResourceExhausted || !strings.Contains(err.Error(), "only 2 of 5 are free") {
+		t.Errorf("expected node quota error, got %v", err)
+	}
+
+	if err := create("d2", 2); err != nil {
+		t.Fatalf("second devnet: %v", err)
+	}
+
+	err = create("d3", 0)
+	if status.Code(err) != codes.ResourceExhausted || !strings.Contains(err.Error(), "2 of 2 devnets in use") {
+		t.Errorf("expected devnet quota error, got %v", err)
+	}
+
+	// Growing a devnet past the node quota is rejected; its own nodes are
+	// not counted twice.
+	_, err = svc.UpdateDevnet(ctx, &v1.UpdateDevnetRequest{
+		Name:      "d1",
+		Namespace: "team-a",
+		Spec:      &v1.DevnetSpec{Plugin: "stable", Validators: 4, Mode: "docker"},
+	})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("expected ResourceExhausted on update, got %v", err)
+	}
+	_, err = svc.UpdateDevnet(ctx, &v1.UpdateDevnetRequest{
+		Name:      "d1",
+		Namespace: "team-a",
+		Spec:      &v1.DevnetSpec{Plugin: "stable", Validators: 3, Mode: "docker"},
+	})
+	if err != nil {
+		t.Errorf("update within quota failed: %v", err)
+	}
+
+	// Namespaces without a quota are unlimited.
+	if _, err := svc.CreateDevnet(ctx, &v1.CreateDevnetRequest{
+		Name: "big",
+		Spec: &v1.DevnetSpec{Plugin: "stable", Validators: 50, Mode: "docker"},
+	}); err != nil {
+		t.Errorf("default namespace create failed: %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/netem"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ShapeNetwork applies the network shaping of a docker-mode devnet's spec
+// to its running nodes, replacing any earlier shaping, or clears it.
+func (s *DevnetService) ShapeNetwork(ctx context.Context, req *v1.ShapeNetworkRequest) (*v1.ShapeNetworkResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+
+	devnet, err := s.store.GetDevnet(ctx, req.GetNamespace(), req.DevnetName)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", req.DevnetName)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+
+	shaper, ok := s.runtime.(runtime.NetworkShaper)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition,
+			"network shaping requires the docker runtime; start devnetd with --runtime docker")
+	}
+
+	spec := devnet.Spec.NetworkShaping
+	if !req.Clear {
+		if len(spec.Links) == 0 {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"devnet %q has no networkShaping links in its spec", req.DevnetName)
+		}
+		nodeCount := devnet.Spec.Validators + devnet.Spec.FullNodes
+		if err := netem.Validate(spec, nodeCount); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "invalid network shaping: %v", err)
+		}
+	}
+
+	nodes, err := s.store.ListNodes(ctx, devnet.Metadata.Namespace, req.DevnetName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list nodes: %v", err)
+	}
+	slices.SortFunc(nodes, func(a, b *types.Node) int { return a.Spec.Index - b.Spec.Index })
+
+	targets := make([]netem.Node, 0, len(nodes))
+	for _, node := range nodes {
+		ip, err := shaper.NodeIP(ctx, node.Metadata.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v%s", err,
+				resumeHint(req.DevnetName, devnet.Status.Phase))
+		}
+		targets = append(targets, netem.Node{Index: node.Spec.Index, Name: node.Metadata.Name, IP: ip})
+	}
+
+	image := spec.Image
+	if image == "" {
+		image = netem.DefaultImage
+	}
+	var plan map[string][]netem.Rule
+	if !req.Clear {
+		plan = netem.Plan(spec, targets)
+	}
+
+	resp := &v1.ShapeNetworkResponse{}
+	var errs []error
+	for _, target := range targets {
+		rules := plan[target.Name]
+		if err := shaper.RunInNodeNetwork(ctx, target.Name, image, netem.Script(rules)); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		shaped := &v1.ShapedNode{Node: target.Name, Region: netem.RegionOf(spec, target.Index)}
+		for _, rule := range rules {
+			shaped.Links = append(shaped.Links, netem.Describe(rule))
+		}
+		resp.Nodes = append(resp.Nodes, shaped)
+	}
+	if len(errs) > 0 {
+		return nil, status.Errorf(codes.Internal, "failed to shape network: %v", errors.Join(errs...))
+	}
+
+	s.logger.Info("network shaping updated", "devnet", req.DevnetName, "cleared", req.Clear, "nodes", len(resp.Nodes))
+	return resp, nil
+}
@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// shapingRuntime is a NodeRuntime that runs nodes in network namespaces.
+type shapingRuntime struct {
+	runtime.NodeRuntime
+	ips     map[string]string
+	scripts map[string]string
+	image   string
+}
+
+func (r *shapingRuntime) NodeIP(ctx context.Context, nodeID string) (string, error) {
+	if ip, ok := r.ips[nodeID]; ok {
+		return ip, nil
+	}
+	return "", fmt.Errorf("node %s is not running", nodeID)
+}
+
+func (r *shapingRuntime) RunInNodeNetwork(ctx context.Context, nodeID, image, script string) error {
+	r.image = image
+	r.scripts[nodeID] = script
+	return nil
+}
+
+func TestDevnetService_ShapeNetwork(t *testing.T) {
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+	ctx := context.Background()
+
+	shaping := types.NetworkShaping{
+		Regions: map[int]string{0: "us-east", 1: "eu-west"},
+		Links:   []types.NetworkLink{{From: "us-east", To: "eu-west", Latency: "100ms", Loss: "1%"}},
+	}
+	for _, d := range []struct {
+		name    string
+		shaping types.NetworkShaping
+	}{{"shaped", shaping}, {"unshaped", types.NetworkShaping{}}} {
+		if err := s.CreateDevnet(ctx, &types.Devnet{
+			Metadata: types.ResourceMeta{Name: d.name, Namespace: types.DefaultNamespace},
+			Spec:     types.DevnetSpec{Plugin: "stable", Validators: 2, Mode: "docker", NetworkShaping: d.shaping},
+			Status:   types.DevnetStatus{Phase: types.PhaseRunning},
+		}); err != nil {
+			t.Fatalf("CreateDevnet failed: %v", err)
+		}
+		for i := 0; i < 2; i++ {
+			if err := s.CreateNode(ctx, &types.Node{
+				Metadata: types.ResourceMeta{Name: fmt.Sprintf("%s-validator-%d", d.name, i), Namespace: types.DefaultNamespace},
+				Spec:     types.NodeSpec{DevnetRef: d.name, Index: i, Role: "validator"},
+			}); err != nil {
+				t.Fatalf("CreateNode failed: %v", err)
+			}
+		}
+	}
+
+	// Without the docker runtime
+	svc.SetDebugSources(nil, t.TempDir())
+	_, err := svc.ShapeNetwork(ctx, &v1.ShapeNetworkRequest{DevnetName: "shaped"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition without docker runtime, got %v", err)
+	}
+
+	rt := &shapingRuntime{
+		ips:     map[string]string{"shaped-validator-0": "172.17.0.2", "shaped-validator-1": "172.17.0.3"},
+		scripts: make(map[string]string),
+	}
+	svc.SetDebugSources(rt, t.TempDir())
+
+	tests := []struct {
+		name string
+		req  *v1.ShapeNetworkRequest
+		code codes.Code
+	}{
+		{name: "missing devnet name", req: &v1.ShapeNetworkRequest{}, code: codes.InvalidArgument},
+		{name: "devnet not found", req: &v1.ShapeNetworkRequest{DevnetName: "missing"}, code: codes.NotFound},
+		{name: "no links", req: &v1.ShapeNetworkRequest{DevnetName: "unshaped"}, code: codes.FailedPrecondition},
+		{name: "nodes not running", req: &v1.ShapeNetworkRequest{DevnetName: "unshaped", Clear: true}, code: codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.ShapeNetwork(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Errorf("expected code %v, got %v (%v)", tt.code, status.Code(err), err)
+			}
+		})
+	}
+
+	resp, err := svc.ShapeNetwork(ctx, &v1.ShapeNetworkRequest{DevnetName: "shaped"})
+	if err != nil {
+		t.Fatalf("ShapeNetwork failed: %v", err)
+	}
+	if len(resp.Nodes) != 2 || resp.Nodes[0].Region != "us-east" || resp.Nodes[1].Region != "eu-west" {
+		t.Fatalf("unexpected nodes: %v", resp.Nodes)
+	}
+	if got := strings.Join(resp.Nodes[0].Links, ";"); got != "eu-west (1 node): 100ms, 1% loss" {
+		t.Errorf("unexpected links: %s", got)
+	}
+	if rt.image != "nicolaka/netshoot:latest" {
+		t.Errorf("unexpected image: %s", rt.image)
+	}
+	if script := rt.scripts["shaped-validator-1"]; !strings.Contains(script, "netem delay 100000us loss 1%") ||
+		!strings.Contains(script, "match ip dst 172.17.0.2/32") {
+		t.Errorf("unexpected script:\n%s", script)
+	}
+
+	resp, err = svc.ShapeNetwork(ctx, &v1.ShapeNetworkRequest{DevnetName: "shaped", Clear: true})
+	if err != nil {
+		t.Fatalf("ShapeNetwork clear failed: %v", err)
+	}
+	if len(resp.Nodes) != 2 || len(resp.Nodes[0].Links) != 0 || strings.Contains(rt.scripts["shaped-validator-0"], "netem") {
+		t.Errorf("shaping not cleared: %v\n%s", resp.Nodes, rt.scripts["shaped-validator-0"])
+	}
+}
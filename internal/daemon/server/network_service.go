@@ -2,10 +2,15 @@ package server
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/plugininstall"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/github"
 	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	"github.com/altuslabsxyz/devnet-builder/pkg/network/plugin"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -15,9 +20,21 @@ import (
 type NetworkService struct {
 	v1.UnimplementedNetworkServiceServer
 	githubFactory GitHubClientFactory
+	installer     pluginInstaller
+	plugins       pluginLoader
 	logger        *slog.Logger
 }
 
+// pluginInstaller installs plugin binaries from GitHub releases.
+type pluginInstaller interface {
+	Install(ctx context.Context, req plugininstall.Request) (*plugininstall.Result, error)
+}
+
+// pluginLoader loads or reloads an installed plugin into the network registry.
+type pluginLoader interface {
+	LoadOrReload(name string) (bool, error)
+}
+
 // NewNetworkService creates a new NetworkService.
 func NewNetworkService(githubFactory GitHubClientFactory) *NetworkService {
 	return &NetworkService{
@@ -31,6 +48,13 @@ func (s *NetworkService) SetLogger(logger *slog.Logger) {
 	s.logger = logger
 }
 
+// SetPluginInstaller enables InstallPlugin. Installed plugins are loaded
+// through plugins.
+func (s *NetworkService) SetPluginInstaller(installer pluginInstaller, plugins pluginLoader) {
+	s.installer = installer
+	s.plugins = plugins
+}
+
 // ListNetworks returns all registered network modules.
 func (s *NetworkService) ListNetworks(ctx context.Context, req *v1.ListNetworksRequest) (*v1.ListNetworksResponse, error) {
 	modules := network.ListModules()
@@ -63,7 +87,7 @@ func (s *NetworkService) GetNetworkInfo(ctx context.Context, req *v1.GetNetworkI
 
 // moduleToSummary converts a NetworkModule to a NetworkSummary proto.
 func moduleToSummary(module network.NetworkModule) *v1.NetworkSummary {
-	return &v1.NetworkSummary{
+	summary := &v1.NetworkSummary{
 		Name:                 module.Name(),
 		DisplayName:          module.DisplayName(),
 		Version:              module.Version(),
@@ -71,6 +95,12 @@ func moduleToSummary(module network.NetworkModule) *v1.NetworkSummary {
 		AvailableNetworks:    module.AvailableNetworks(),
 		DefaultBinaryVersion: module.DefaultBinaryVersion(),
 	}
+	if caps, ok := pluginCapabilities(module); ok {
+		summary.ProtocolVersion = int32(caps.ProtocolVersion)
+		summary.Capabilities = capabilityNames(caps)
+		summary.CapabilitiesKnown = caps.Known()
+	}
+	return summary
 }
 
 // moduleToNetworkInfo converts a NetworkModule to a NetworkInfo proto.
@@ -105,7 +135,7 @@ func moduleToNetworkInfo(module network.NetworkModule) *v1.NetworkInfo {
 		EvmSocket: int32(defaultPorts.EVMWS),
 	}
 
-	return &v1.NetworkInfo{
+	info := &v1.NetworkInfo{
 		Name:                 module.Name(),
 		DisplayName:          module.DisplayName(),
 		Version:              module.Version(),
@@ -119,7 +149,56 @@ func moduleToNetworkInfo(module network.NetworkModule) *v1.NetworkInfo {
 		DockerImage:          module.DockerImage(),
 		DockerHomeDir:        module.DockerHomeDir(),
 		DefaultPorts:         pbPorts,
+		CallStats:            pluginCallStats(module),
+	}
+	if caps, ok := pluginCapabilities(module); ok {
+		info.ProtocolVersion = int32(caps.ProtocolVersion)
+		info.Capabilities = capabilityNames(caps)
+		info.CapabilitiesKnown = caps.Known()
 	}
+	return info
+}
+
+// pluginCapabilities returns the capabilities negotiated with the plugin a
+// module was loaded from, or false for built-in modules.
+func pluginCapabilities(module network.NetworkModule) (plugin.Capabilities, bool) {
+	adapter, ok := module.(*network.PluginAdapter)
+	if !ok {
+		return plugin.Capabilities{}, false
+	}
+	return plugin.ModuleCapabilities(adapter.Module())
+}
+
+func capabilityNames(caps plugin.Capabilities) []string {
+	names := make([]string, len(caps.Supported))
+	for i, c := range caps.Supported {
+		names[i] = string(c)
+	}
+	return names
+}
+
+// pluginCallStats returns the RPC call counts of a module loaded from a
+// plugin, or nil for built-in modules.
+func pluginCallStats(module network.NetworkModule) []*v1.PluginMethodStats {
+	adapter, ok := module.(*network.PluginAdapter)
+	if !ok {
+		return nil
+	}
+	client, ok := adapter.Module().(*plugin.GRPCClient)
+	if !ok {
+		return nil
+	}
+
+	var stats []*v1.PluginMethodStats
+	for _, m := range client.CallStats() {
+		stats = append(stats, &v1.PluginMethodStats{
+			Method:        m.Method,
+			Calls:         m.Calls,
+			Failures:      m.Failures,
+			Unimplemented: m.Unimplemented,
+		})
+	}
+	return stats
 }
 
 // ListBinaryVersions returns available binary versions for a network.
@@ -166,12 +245,22 @@ func (s *NetworkService) ListBinaryVersions(ctx context.Context, req *v1.ListBin
 
 	// Fetch releases (with cache fallback)
 	releases, fromCache, err := client.FetchReleasesWithCache(ctx)
-	if err != nil {
+	var stale *github.StaleDataWarning
+	switch {
+	case errors.As(err, &stale):
+		s.logger.Warn("GitHub unavailable, using cached releases",
+			"network", req.NetworkName,
+			"reason", stale.Message)
+	case err != nil:
 		s.logger.Error("failed to fetch releases",
 			"network", req.NetworkName,
 			"owner", binarySource.Owner,
 			"repo", binarySource.Repo,
 			"error", err)
+		var rateLimited *github.RateLimitError
+		if errors.As(err, &rateLimited) {
+			return nil, status.Errorf(codes.ResourceExhausted, "failed to fetch releases of %s/%s: %v", binarySource.Owner, binarySource.Repo, err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to fetch releases: %v", err)
 	}
 
@@ -209,3 +298,52 @@ func (s *NetworkService) ListBinaryVersions(ctx context.Context, req *v1.ListBin
 		SourceType:     sourceType,
 	}, nil
 }
+
+// InstallPlugin installs a plugin from a GitHub release and loads it, so it
+// is available to new devnets without restarting the daemon.
+func (s *NetworkService) InstallPlugin(ctx context.Context, req *v1.InstallPluginRequest) (*v1.InstallPluginResponse, error) {
+	if req.Owner == "" || req.Repo == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner and repo are required")
+	}
+	if s.installer == nil || s.plugins == nil {
+		return nil, status.Error(codes.Unimplemented, "plugin installation is not configured")
+	}
+
+	result, err := s.installer.Install(ctx, plugininstall.Request{
+		Owner:           req.Owner,
+		Repo:            req.Repo,
+		Version:         req.Version,
+		PublicKey:       req.PublicKey,
+		AllowUnverified: req.AllowUnverified,
+	})
+	if err != nil {
+		var notFound *github.NotFoundError
+		var rateLimited *github.RateLimitError
+		switch {
+		case errors.As(err, &notFound):
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		case errors.As(err, &rateLimited):
+			return nil, status.Errorf(codes.ResourceExhausted, "%v", err)
+		case errors.Is(err, builder.ErrChecksumMismatch),
+			errors.Is(err, plugininstall.ErrSignatureInvalid),
+			errors.Is(err, plugininstall.ErrNoChecksum):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to install plugin: %v", err)
+	}
+
+	replaced, err := s.plugins.LoadOrReload(result.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "plugin %s installed to %s but failed to load: %v", result.Name, result.Path, err)
+	}
+
+	return &v1.InstallPluginResponse{
+		Name:              result.Name,
+		Version:           result.Version,
+		Path:              result.Path,
+		Sha256:            result.SHA256,
+		ChecksumVerified:  result.ChecksumVerified,
+		SignatureVerified: result.SignatureVerified,
+		Replaced:          replaced,
+	}, nil
+}
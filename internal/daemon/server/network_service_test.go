@@ -3,12 +3,18 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
 	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/plugininstall"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/github"
 	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // mockGitHubClient is a mock implementation of ports.GitHubClient.
@@ -290,3 +296,112 @@ func TestNetworkService_ListBinaryVersions_OnlyPrereleases(t *testing.T) {
 		t.Errorf("Expected 2 versions with prereleases, got %d", len(resp.Versions))
 	}
 }
+
+// mockPluginInstaller is a mock implementation of pluginInstaller.
+type mockPluginInstaller struct {
+	result  *plugininstall.Result
+	err     error
+	lastReq plugininstall.Request
+}
+
+func (m *mockPluginInstaller) Install(ctx context.Context, req plugininstall.Request) (*plugininstall.Result, error) {
+	m.lastReq = req
+	return m.result, m.err
+}
+
+// mockPluginLoader is a mock implementation of pluginLoader.
+type mockPluginLoader struct {
+	replaced bool
+	err      error
+	loaded   []string
+}
+
+func (m *mockPluginLoader) LoadOrReload(name string) (bool, error) {
+	m.loaded = append(m.loaded, name)
+	return m.replaced, m.err
+}
+
+func TestNetworkService_InstallPlugin(t *testing.T) {
+	installer := &mockPluginInstaller{result: &plugininstall.Result{
+		Name:             "osmosis",
+		Version:          "v1.2.0",
+		Path:             "/plugins/osmosis-plugin",
+		SHA256:           "abc",
+		ChecksumVerified: true,
+	}}
+	loader := &mockPluginLoader{replaced: true}
+	svc := NewNetworkService(nil)
+	svc.SetPluginInstaller(installer, loader)
+
+	resp, err := svc.InstallPlugin(context.Background(), &v1.InstallPluginRequest{
+		Owner:   "acme",
+		Repo:    "osmosis",
+		Version: "v1.2.0",
+	})
+	if err != nil {
+		t.Fatalf("InstallPlugin() error = %v", err)
+	}
+	if resp.Name != "osmosis" || resp.Version != "v1.2.0" || !resp.ChecksumVerified || !resp.Replaced {
+		t.Errorf("InstallPlugin() = %+v", resp)
+	}
+	if installer.lastReq.Owner != "acme" || installer.lastReq.Repo != "osmosis" || installer.lastReq.Version != "v1.2.0" {
+		t.Errorf("installer request = %+v", installer.lastReq)
+	}
+	if len(loader.loaded) != 1 || loader.loaded[0] != "osmosis" {
+		t.Errorf("loaded plugins = %v, want [osmosis]", loader.loaded)
+	}
+}
+
+func TestNetworkService_InstallPlugin_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *v1.InstallPluginRequest
+		installer *mockPluginInstaller
+		loader    *mockPluginLoader
+		want      codes.Code
+	}{
+		{
+			name: "missing repo",
+			req:  &v1.InstallPluginRequest{Owner: "acme"},
+			want: codes.InvalidArgument,
+		},
+		{
+			name:      "release not found",
+			req:       &v1.InstallPluginRequest{Owner: "acme", Repo: "osmosis"},
+			installer: &mockPluginInstaller{err: &github.NotFoundError{Message: "not found"}},
+			want:      codes.NotFound,
+		},
+		{
+			name:      "checksum mismatch",
+			req:       &v1.InstallPluginRequest{Owner: "acme", Repo: "osmosis"},
+			installer: &mockPluginInstaller{err: fmt.Errorf("%w for asset", builder.ErrChecksumMismatch)},
+			want:      codes.FailedPrecondition,
+		},
+		{
+			name:      "load fails",
+			req:       &v1.InstallPluginRequest{Owner: "acme", Repo: "osmosis"},
+			installer: &mockPluginInstaller{result: &plugininstall.Result{Name: "osmosis"}},
+			loader:    &mockPluginLoader{err: errors.New("handshake failed")},
+			want:      codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			installer, loader := tt.installer, tt.loader
+			if installer == nil {
+				installer = &mockPluginInstaller{}
+			}
+			if loader == nil {
+				loader = &mockPluginLoader{}
+			}
+			svc := NewNetworkService(nil)
+			svc.SetPluginInstaller(installer, loader)
+
+			_, err := svc.InstallPlugin(context.Background(), tt.req)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("InstallPlugin() code = %v, want %v (err: %v)", got, tt.want, err)
+			}
+		})
+	}
+}
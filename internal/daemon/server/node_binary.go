@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetNodeBinary swaps the binary of one node, or its image in docker mode,
+// and restarts it, so a devnet can run mixed versions ahead of a
+// coordinated upgrade. Local nodes run the given binary or one built at the
+// version; docker nodes run the given image or the devnet's image tagged
+// with the version.
+func (s *NodeService) SetNodeBinary(ctx context.Context, req *v1.SetNodeBinaryRequest) (*v1.SetNodeBinaryResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+	if req.Version == "" {
+		return nil, status.Error(codes.InvalidArgument, "version is required")
+	}
+
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+
+	devnet, err := s.store.GetDevnet(ctx, namespace, req.DevnetName)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", req.DevnetName)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+	node, err := s.store.GetNode(ctx, namespace, req.DevnetName, int(req.Index))
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "node %s/%d not found", req.DevnetName, req.Index)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get node: %v", err)
+	}
+
+	if devnet.Spec.Mode == "docker" {
+		image := req.Image
+		if image == "" {
+			current := node.Spec.Image
+			if current == "" {
+				current = devnet.Spec.Image
+			}
+			if current == "" {
+				return nil, status.Errorf(codes.FailedPrecondition, "node %s/%d has no image to retag; specify one with image", req.DevnetName, req.Index)
+			}
+			image = imageWithTag(current, req.Version)
+		}
+		node.Spec.Image = image
+	} else {
+		binaryPath := req.BinaryPath
+		if binaryPath == "" {
+			if s.binaryBuilder == nil {
+				return nil, status.Error(codes.Unavailable, "binary builder not configured")
+			}
+			plugin := node.Spec.Network
+			if plugin == "" {
+				plugin = devnet.Spec.Plugin
+			}
+			result, err := s.binaryBuilder.Build(ctx, builder.BuildSpec{
+				GitRef:     req.Version,
+				PluginName: plugin,
+			})
+			if err != nil {
+				return nil, apierror.Errorf(apierror.BuildFailed, "failed to build %s %s: %v", plugin, req.Version, err)
+			}
+			binaryPath = result.BinaryPath
+		}
+		node.Spec.BinaryPath = binaryPath
+	}
+
+	s.logger.Info("setting node binary",
+		"namespace", namespace,
+		"devnet", req.DevnetName,
+		"index", req.Index,
+		"version", req.Version,
+		"from", node.Status.Version)
+
+	// The runtime refuses to start a node that still runs, so the old
+	// binary is stopped before the restart picks up the new one
+	running := node.Status.Phase == types.NodePhaseRunning
+	if running && s.runtime != nil {
+		if err := s.runtime.StopNode(ctx, node.Metadata.Name, true); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to stop node: %v", err)
+		}
+	}
+
+	node.Status.Version = req.Version
+	if err := s.store.UpdateNode(ctx, node); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update node: %v", err)
+	}
+
+	if !running {
+		return &v1.SetNodeBinaryResponse{Node: NodeToProto(node)}, nil
+	}
+	resp, err := s.RestartNode(ctx, &v1.RestartNodeRequest{
+		DevnetName: req.DevnetName,
+		Index:      req.Index,
+		Namespace:  namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SetNodeBinaryResponse{Node: resp.Node}, nil
+}
+
+// imageWithTag returns image with its tag or digest replaced by tag.
+func imageWithTag(image, tag string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image + ":" + tag
+}
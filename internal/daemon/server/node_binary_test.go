@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeBinaryBuilder "builds" binaries into /builds/<plugin>-<ref>.
+type fakeBinaryBuilder struct {
+	builder.BinaryBuilder
+	specs []builder.BuildSpec
+}
+
+func (b *fakeBinaryBuilder) Build(_ context.Context, spec builder.BuildSpec) (*builder.BuildResult, error) {
+	b.specs = append(b.specs, spec)
+	return &builder.BuildResult{BinaryPath: "/builds/" + spec.PluginName + "-" + spec.GitRef, BuiltAt: time.Now()}, nil
+}
+
+func (b *fakeBinaryBuilder) GetCached(context.Context, builder.BuildSpec) (*builder.BuildResult, bool) {
+	return nil, false
+}
+
+// stoppingRuntime is a NodeRuntime that records stopped nodes.
+type stoppingRuntime struct {
+	runtime.NodeRuntime
+	stopped []string
+}
+
+func (r *stoppingRuntime) StopNode(_ context.Context, nodeID string, _ bool) error {
+	r.stopped = append(r.stopped, nodeID)
+	return nil
+}
+
+func createBinaryNode(t *testing.T, s store.Store, mode, phase string) {
+	t.Helper()
+	ctx := context.Background()
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test-devnet", Namespace: types.DefaultNamespace},
+		Spec:     types.DevnetSpec{Plugin: "cosmos", Mode: mode, Image: "ghcr.io/cosmos/gaia:v22.0.0"},
+	}
+	if err := s.CreateDevnet(ctx, devnet); err != nil {
+		t.Fatalf("CreateDevnet: %v", err)
+	}
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: "test-devnet-node-1", Namespace: types.DefaultNamespace},
+		Spec: types.NodeSpec{
+			DevnetRef:  "test-devnet",
+			Index:      1,
+			Role:       "validator",
+			Network:    "cosmos",
+			BinaryPath: "/builds/cosmos-v22.0.0",
+			Image:      devnet.Spec.Image,
+		},
+		Status: types.NodeStatus{Phase: phase, Version: "v22.0.0"},
+	}
+	if err := s.CreateNode(ctx, node); err != nil {
+		t.Fatalf("CreateNode: %v", err)
+	}
+}
+
+func TestNodeService_SetNodeBinary(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	rt := &stoppingRuntime{}
+	b := &fakeBinaryBuilder{}
+	svc := NewNodeService(s, nil, rt)
+	svc.SetBinaryBuilder(b)
+	createBinaryNode(t, s, "local", types.NodePhaseRunning)
+
+	resp, err := svc.SetNodeBinary(ctx, &v1.SetNodeBinaryRequest{DevnetName: "test-devnet", Index: 1, Version: "v23.0.0"})
+	if err != nil {
+		t.Fatalf("SetNodeBinary: %v", err)
+	}
+	if len(b.specs) != 1 || b.specs[0].GitRef != "v23.0.0" || b.specs[0].PluginName != "cosmos" {
+		t.Errorf("builds = %+v, want cosmos at v23.0.0", b.specs)
+	}
+	if len(rt.stopped) != 1 {
+		t.Errorf("stopped %v, want the old binary stopped", rt.stopped)
+	}
+	if resp.Node.Spec.BinaryPath != "/builds/cosmos-v23.0.0" || resp.Node.Status.Version != "v23.0.0" {
+		t.Errorf("node runs %q at version %q", resp.Node.Spec.BinaryPath, resp.Node.Status.Version)
+	}
+	if resp.Node.Status.Phase != types.NodePhasePending {
+		t.Errorf("phase = %q, want a restart", resp.Node.Status.Phase)
+	}
+}
+
+func TestNodeService_SetNodeBinary_Docker(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	rt := &stoppingRuntime{}
+	svc := NewNodeService(s, nil, rt)
+	createBinaryNode(t, s, "docker", types.NodePhaseStopped)
+
+	if _, err := svc.SetNodeBinary(ctx, &v1.SetNodeBinaryRequest{DevnetName: "test-devnet", Index: 1, Version: "v23.0.0"}); err != nil {
+		t.Fatalf("SetNodeBinary: %v", err)
+	}
+	node, err := s.GetNode(ctx, types.DefaultNamespace, "test-devnet", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node.Spec.Image != "ghcr.io/cosmos/gaia:v23.0.0" || node.Status.Version != "v23.0.0" {
+		t.Errorf("node runs %q at version %q", node.Spec.Image, node.Status.Version)
+	}
+	// A stopped node picks up the image when it starts
+	if node.Status.Phase != types.NodePhaseStopped || len(rt.stopped) != 0 {
+		t.Errorf("phase = %q, stopped %v; want the stopped node left alone", node.Status.Phase, rt.stopped)
+	}
+}
+
+func TestNodeService_SetNodeBinary_Validation(t *testing.T) {
+	svc := NewNodeService(store.NewMemoryStore(), nil, nil)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  *v1.SetNodeBinaryRequest
+		code codes.Code
+	}{
+		{"missing devnet", &v1.SetNodeBinaryRequest{Version: "v1.0.0"}, codes.InvalidArgument},
+		{"missing version", &v1.SetNodeBinaryRequest{DevnetName: "test-devnet"}, codes.InvalidArgument},
+		{"missing devnet in store", &v1.SetNodeBinaryRequest{DevnetName: "test-devnet", Version: "v1.0.0"}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.SetNodeBinary(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Errorf("expected %v, got %v", tt.code, err)
+			}
+		})
+	}
+}
+
+func TestImageWithTag(t *testing.T) {
+	tests := []struct {
+		image string
+		want  string
+	}{
+		{"ghcr.io/cosmos/gaia:v22.0.0", "ghcr.io/cosmos/gaia:v23.0.0"},
+		{"osmolabs/osmosis", "osmolabs/osmosis:v23.0.0"},
+		{"localhost:5000/gaia", "localhost:5000/gaia:v23.0.0"},
+		{"localhost:5000/gaia:v22", "localhost:5000/gaia:v23.0.0"},
+		{"gaia@sha256:abcd", "gaia:v23.0.0"},
+	}
+	for _, tt := range tests {
+		if got := imageWithTag(tt.image, "v23.0.0"); got != tt.want {
+			t.Errorf("imageWithTag(%q) = %q, want %q", tt.image, got, tt.want)
+		}
+	}
+}
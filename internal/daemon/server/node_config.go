@@ -0,0 +1,448 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/configpatch"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/controller"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ways ApplyNodeConfig and SyncNodeConfig apply changes.
+const (
+	configActionUnchanged = "unchanged"
+	configActionReloaded  = "reloaded"
+	configActionRestarted = "restarted"
+	configActionDeferred  = "deferred"
+	configActionDrifted   = "drifted"
+)
+
+// ApplyNodeConfig patches a node's config.toml and app.toml in place. A
+// running node is reloaded without a restart when requested and its runtime
+// and plugin support reloading every changed key; otherwise it is restarted.
+func (s *NodeService) ApplyNodeConfig(ctx context.Context, req *v1.ApplyNodeConfigRequest) (*v1.ApplyNodeConfigResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+	changes, err := configpatch.Parse([]byte(req.Overrides))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+
+	node, err := s.store.GetNode(ctx, namespace, req.DevnetName, int(req.Index))
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "node %s/%d not found", req.DevnetName, req.Index)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get node: %v", err)
+	}
+	if node.Spec.HomeDir == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "node %s/%d has no home directory", req.DevnetName, req.Index)
+	}
+
+	applied, err := configpatch.Apply(node.Spec.HomeDir, changes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.recordConfigOverrides(ctx, node, changes); err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ApplyNodeConfigResponse{Changes: configChangesToProto(applied)}
+	switch {
+	case len(applied) == 0:
+		resp.Action = configActionUnchanged
+		resp.Message = "config already up to date"
+		return resp, nil
+	case node.Status.Phase != types.NodePhaseRunning:
+		resp.Action = configActionDeferred
+		resp.Message = fmt.Sprintf("node is %s; changes take effect when it starts", strings.ToLower(node.Status.Phase))
+		return resp, nil
+	}
+
+	s.logger.Info("applying node config",
+		"namespace", namespace,
+		"devnet", req.DevnetName,
+		"index", req.Index,
+		"changes", len(applied))
+
+	if req.NoRestartIfPossible {
+		if reloaded := s.reloadNodeConfig(ctx, node, applied); reloaded {
+			resp.Action = configActionReloaded
+			resp.Message = "node reloaded its config without a restart"
+			return resp, nil
+		}
+	}
+
+	if _, err := s.RestartNode(ctx, &v1.RestartNodeRequest{
+		DevnetName: req.DevnetName,
+		Index:      req.Index,
+		Namespace:  namespace,
+	}); err != nil {
+		return nil, err
+	}
+	resp.Action = configActionRestarted
+	resp.Message = "node restarted to apply the changes"
+	if req.NoRestartIfPossible {
+		resp.Message = "node cannot reload every changed key; restarted it instead"
+	}
+	return resp, nil
+}
+
+// recordConfigOverrides remembers the values set with ApplyNodeConfig on the
+// node, for GetNodeConfig.
+func (s *NodeService) recordConfigOverrides(ctx context.Context, node *types.Node, changes []configpatch.Change) error {
+	dirty := false
+	for _, c := range changes {
+		if node.Spec.ConfigOverrides[c.ID()] == c.Value {
+			continue
+		}
+		if node.Spec.ConfigOverrides == nil {
+			node.Spec.ConfigOverrides = make(map[string]string)
+		}
+		node.Spec.ConfigOverrides[c.ID()] = c.Value
+		dirty = true
+	}
+	if !dirty {
+		return nil
+	}
+	if err := s.store.UpdateNode(ctx, node); err != nil {
+		return status.Errorf(codes.Internal, "failed to update node: %v", err)
+	}
+	return nil
+}
+
+// reloadNodeConfig asks the runtime to reload the changed keys of a running
+// node, reporting whether it did.
+func (s *NodeService) reloadNodeConfig(ctx context.Context, node *types.Node, applied []configpatch.Change) bool {
+	reloader, ok := s.runtime.(runtime.NodeConfigReloader)
+	if !ok {
+		return false
+	}
+
+	keys := make([]string, 0, len(applied))
+	for _, c := range applied {
+		keys = append(keys, c.ID())
+	}
+
+	nodeID := node.Metadata.Name
+	if nodeID == "" {
+		nodeID = controller.NodeKey(node.Spec.DevnetRef, node.Spec.Index)
+	}
+
+	reloaded, err := reloader.ReloadNodeConfig(ctx, nodeID, keys)
+	if err != nil {
+		s.logger.Warn("config reload failed, falling back to restart", "nodeID", nodeID, "error", err)
+		return false
+	}
+	return reloaded
+}
+
+func configChangesToProto(changes []configpatch.Change) []*v1.ConfigChange {
+	out := make([]*v1.ConfigChange, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, &v1.ConfigChange{
+			File:     c.File,
+			Key:      c.Key,
+			OldValue: c.Old,
+			NewValue: c.Value,
+		})
+	}
+	return out
+}
+
+// GetNodeConfig returns a node's effective config.toml and app.toml values,
+// each attributed to the layer that set it.
+func (s *NodeService) GetNodeConfig(ctx context.Context, req *v1.GetNodeConfigRequest) (*v1.GetNodeConfigResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+
+	devnet, node, current, err := s.readNodeConfig(ctx, namespace, req.DevnetName, int(req.Index))
+	if err != nil {
+		return nil, err
+	}
+
+	fields := configpatch.Effective(current, s.configLayers(devnet, node))
+	resp := &v1.GetNodeConfigResponse{Fields: make([]*v1.NodeConfigField, 0, len(fields))}
+	for _, f := range fields {
+		resp.Fields = append(resp.Fields, &v1.NodeConfigField{
+			File:   f.File,
+			Key:    f.Key,
+			Value:  f.Value,
+			Source: f.Source,
+			Detail: f.Detail,
+		})
+	}
+	return resp, nil
+}
+
+// SyncNodeConfig compares a node's config files with the values its config
+// layers set and, when requested, restores the values that drifted. A
+// running node is restarted to pick them up.
+func (s *NodeService) SyncNodeConfig(ctx context.Context, req *v1.SyncNodeConfigRequest) (*v1.SyncNodeConfigResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+
+	devnet, node, current, err := s.readNodeConfig(ctx, namespace, req.DevnetName, int(req.Index))
+	if err != nil {
+		return nil, err
+	}
+
+	drifts := configpatch.Drifts(current, s.configLayers(devnet, node))
+	resp := &v1.SyncNodeConfigResponse{Drift: make([]*v1.ConfigDrift, 0, len(drifts))}
+	changes := make([]configpatch.Change, 0, len(drifts))
+	for _, d := range drifts {
+		resp.Drift = append(resp.Drift, &v1.ConfigDrift{
+			File:          d.File,
+			Key:           d.Key,
+			CurrentValue:  d.Current,
+			ExpectedValue: d.Expected,
+			Source:        d.Source,
+			Detail:        d.Detail,
+		})
+		changes = append(changes, configpatch.Change{File: d.File, Key: d.Key, Value: d.Expected})
+	}
+
+	switch {
+	case len(drifts) == 0:
+		resp.Action = configActionUnchanged
+		resp.Message = "config matches the devnet spec"
+		return resp, nil
+	case !req.Apply:
+		resp.Action = configActionDrifted
+		resp.Message = fmt.Sprintf("%d value(s) differ from the devnet spec", len(drifts))
+		return resp, nil
+	}
+
+	if _, err := configpatch.Apply(node.Spec.HomeDir, changes); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "node %s/%d: %v", req.DevnetName, req.Index, err)
+	}
+
+	s.logger.Info("restored drifted node config",
+		"namespace", namespace,
+		"devnet", req.DevnetName,
+		"index", req.Index,
+		"changes", len(changes))
+
+	if node.Status.Phase != types.NodePhaseRunning {
+		resp.Action = configActionDeferred
+		resp.Message = fmt.Sprintf("node is %s; restored values take effect when it starts", strings.ToLower(node.Status.Phase))
+		return resp, nil
+	}
+	if _, err := s.RestartNode(ctx, &v1.RestartNodeRequest{
+		DevnetName: req.DevnetName,
+		Index:      req.Index,
+		Namespace:  namespace,
+	}); err != nil {
+		return nil, err
+	}
+	resp.Action = configActionRestarted
+	resp.Message = "node restarted with the restored config"
+	return resp, nil
+}
+
+// readNodeConfig returns a node, its devnet and the node's current config
+// values.
+func (s *NodeService) readNodeConfig(ctx context.Context, namespace, devnetName string, index int) (*types.Devnet, *types.Node, []configpatch.Change, error) {
+	devnet, err := s.store.GetDevnet(ctx, namespace, devnetName)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, nil, nil, status.Errorf(codes.NotFound, "devnet %q not found", devnetName)
+		}
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+	node, err := s.store.GetNode(ctx, namespace, devnetName, index)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, nil, nil, status.Errorf(codes.NotFound, "node %s/%d not found", devnetName, index)
+		}
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to get node: %v", err)
+	}
+	if node.Spec.HomeDir == "" {
+		return nil, nil, nil, status.Errorf(codes.FailedPrecondition, "node %s/%d has no home directory", devnetName, index)
+	}
+
+	current, err := configpatch.Read(node.Spec.HomeDir)
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.FailedPrecondition, "node %s/%d: %v", devnetName, index, err)
+	}
+	return devnet, node, current, nil
+}
+
+// configLayers returns the layers that make up a node's config, in the order
+// provisioning and apply-config write them.
+func (s *NodeService) configLayers(devnet *types.Devnet, node *types.Node) []configpatch.Layer {
+	var layers []configpatch.Layer
+	if plugin := s.pluginConfigLayer(devnet, node); plugin != nil {
+		layers = append(layers, *plugin)
+	}
+
+	// Devnet-wide defaults written for every node, see
+	// ProvisioningOrchestrator.configureNodeNetworking.
+	defaults := map[string]string{
+		"config.toml:consensus.timeout_propose":   `"1s"`,
+		"config.toml:consensus.timeout_prevote":   `"500ms"`,
+		"config.toml:consensus.timeout_precommit": `"500ms"`,
+		"config.toml:consensus.timeout_commit":    `"1s"`,
+		"config.toml:p2p.addr_book_strict":        "false",
+		"config.toml:p2p.allow_duplicate_ip":      "true",
+	}
+	if node.Spec.Index == 0 {
+		defaults["app.toml:api.enable"] = "true"
+		defaults["app.toml:api.enabled-unsafe-cors"] = "true"
+		defaults["app.toml:grpc.enable"] = "true"
+		defaults["app.toml:json-rpc.enable"] = "true"
+	}
+	layers = append(layers, configpatch.Layer{Source: configpatch.SourceSpec, Detail: "devnet default", Values: defaults})
+
+	// Listen addresses and peers depend on the node's index, address and
+	// the other nodes' IDs.
+	host := node.Spec.BindAddress
+	if host == "" {
+		host = node.Spec.Address
+	}
+	if host == "" {
+		host = "0.0.0.0"
+	}
+	layers = append(layers, configpatch.Layer{
+		Source: configpatch.SourceRuntime,
+		Detail: fmt.Sprintf("node %d on %s", node.Spec.Index, host),
+		Values: map[string]string{
+			"config.toml:moniker":              "",
+			"config.toml:proxy_app":            "",
+			"config.toml:p2p.laddr":            "",
+			"config.toml:p2p.persistent_peers": "",
+			"config.toml:rpc.laddr":            "",
+			"config.toml:rpc.pprof_laddr":      "",
+			"app.toml:api.address":             "",
+			"app.toml:grpc.address":            "",
+			"app.toml:json-rpc.address":        "",
+			"app.toml:json-rpc.ws-address":     "",
+		},
+	})
+
+	if devnet.Spec.BlockTime != "" {
+		layers = append(layers, configpatch.Layer{
+			Source: configpatch.SourceSpec,
+			Detail: "block time",
+			Values: map[string]string{"config.toml:consensus.timeout_commit": strconv.Quote(devnet.Spec.BlockTime)},
+		})
+	}
+
+	if profile, ok := types.LookupProfile(devnet.Spec.Profile); ok {
+		values := make(map[string]string)
+		if profile.Pruning != "" {
+			values["app.toml:pruning"] = strconv.Quote(profile.Pruning)
+		}
+		if profile.TxIndexer != "" {
+			values["config.toml:tx_index.indexer"] = strconv.Quote(profile.TxIndexer)
+		}
+		if profile.MempoolSize > 0 {
+			values["config.toml:mempool.size"] = strconv.Itoa(profile.MempoolSize)
+		}
+		if profile.MempoolCacheSize > 0 {
+			values["config.toml:mempool.cache_size"] = strconv.Itoa(profile.MempoolCacheSize)
+		}
+		if profile.ServicesOnFirstNodeOnly && node.Spec.Index > 0 {
+			values["app.toml:api.enable"] = "false"
+			values["app.toml:grpc.enable"] = "false"
+			values["app.toml:json-rpc.enable"] = "false"
+		}
+		layers = append(layers, configpatch.Layer{Source: configpatch.SourceSpec, Detail: "profile " + profile.Name, Values: values})
+	}
+
+	for _, c := range devnet.Spec.NodeConfigs {
+		if c.Index != node.Spec.Index {
+			continue
+		}
+		values := make(map[string]string)
+		for file, data := range map[string]string{"config.toml": c.ConfigToml, "app.toml": c.AppToml} {
+			parsed, err := configpatch.ParseFile(file, []byte(data))
+			if err != nil {
+				continue
+			}
+			for _, v := range parsed {
+				values[v.ID()] = v.Value
+			}
+		}
+		layers = append(layers, configpatch.Layer{Source: configpatch.SourceSpec, Detail: fmt.Sprintf("spec.nodes[%d]", c.Index), Values: values})
+	}
+
+	if len(node.Spec.ConfigOverrides) > 0 {
+		layers = append(layers, configpatch.Layer{Source: configpatch.SourceUser, Detail: "apply-config", Values: node.Spec.ConfigOverrides})
+	}
+	return layers
+}
+
+// nodeConfigOptions returns the options a network plugin computes a node's
+// config overrides from.
+func nodeConfigOptions(node *types.Node, numValidators int) network.NodeConfigOptions {
+	return network.NodeConfigOptions{
+		ChainID:       node.Spec.ChainID,
+		Ports:         node.Spec.Ports(),
+		NumValidators: numValidators,
+		IsValidator:   node.Spec.Role == "validator",
+		Moniker:       node.Metadata.Name,
+	}
+}
+
+// pluginConfigLayer returns the network plugin's config overrides for the
+// node, or nil if the plugin is not loaded or has none.
+func (s *NodeService) pluginConfigLayer(devnet *types.Devnet, node *types.Node) *configpatch.Layer {
+	module, err := network.Get(devnet.Spec.Plugin)
+	if err != nil {
+		return nil
+	}
+
+	configToml, appToml, err := module.GetConfigOverrides(node.Spec.Index, nodeConfigOptions(node, devnet.Spec.Validators))
+	if err != nil {
+		s.logger.Warn("failed to get plugin config overrides", "plugin", devnet.Spec.Plugin, "error", err)
+		return nil
+	}
+
+	values := make(map[string]string)
+	for file, data := range map[string][]byte{"config.toml": configToml, "app.toml": appToml} {
+		if len(data) == 0 {
+			continue
+		}
+		parsed, err := configpatch.ParseFile(file, data)
+		if err != nil {
+			s.logger.Warn("invalid plugin config overrides", "plugin", devnet.Spec.Plugin, "file", file, "error", err)
+			continue
+		}
+		for _, c := range parsed {
+			values[c.ID()] = c.Value
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return &configpatch.Layer{Source: configpatch.SourcePlugin, Detail: devnet.Spec.Plugin, Values: values}
+}
@@ -0,0 +1,268 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// reloadingRuntime is a NodeRuntime that reloads config.toml:log_level.
+type reloadingRuntime struct {
+	runtime.NodeRuntime
+	reloaded []string
+}
+
+func (r *reloadingRuntime) ReloadNodeConfig(_ context.Context, _ string, keys []string) (bool, error) {
+	for _, k := range keys {
+		if k != "config.toml:log_level" {
+			return false, nil
+		}
+	}
+	r.reloaded = keys
+	return true, nil
+}
+
+func createConfigNode(t *testing.T, s store.Store, phase string) string {
+	t.Helper()
+	home := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(home, "config"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	config := "log_level = \"info\"\n\n[rpc]\nmax_open_connections = 900\n"
+	if err := os.WriteFile(filepath.Join(home, "config", "config.toml"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: "test-0"},
+		Spec:     types.NodeSpec{DevnetRef: "test-devnet", Index: 0, Role: "validator", HomeDir: home},
+		Status:   types.NodeStatus{Phase: phase},
+	}
+	if err := s.CreateNode(context.Background(), node); err != nil {
+		t.Fatalf("CreateNode: %v", err)
+	}
+	return home
+}
+
+func TestNodeService_ApplyNodeConfig_Validation(t *testing.T) {
+	svc := NewNodeService(store.NewMemoryStore(), nil, nil)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  *v1.ApplyNodeConfigRequest
+		code codes.Code
+	}{
+		{"missing devnet", &v1.ApplyNodeConfigRequest{Overrides: "[config]\nlog_level = \"debug\""}, codes.InvalidArgument},
+		{"bad overrides", &v1.ApplyNodeConfigRequest{DevnetName: "test-devnet", Overrides: "[client]\nx = 1"}, codes.InvalidArgument},
+		{"missing node", &v1.ApplyNodeConfigRequest{DevnetName: "test-devnet", Overrides: "[config]\nlog_level = \"debug\""}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.ApplyNodeConfig(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Errorf("expected %v, got %v", tt.code, err)
+			}
+		})
+	}
+}
+
+func TestNodeService_ApplyNodeConfig(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		phase      string
+		overrides  string
+		noRestart  bool
+		wantAction string
+		wantReload bool
+	}{
+		{"unchanged", types.NodePhaseRunning, "[config]\nlog_level = \"info\"", true, configActionUnchanged, false},
+		{"stopped node is deferred", types.NodePhaseStopped, "[config]\nlog_level = \"debug\"", true, configActionDeferred, false},
+		{"reloadable key is reloaded", types.NodePhaseRunning, "[config]\nlog_level = \"debug\"", true, configActionReloaded, true},
+		{"reload not requested", types.NodePhaseRunning, "[config]\nlog_level = \"debug\"", false, configActionRestarted, false},
+		{"non-reloadable key restarts", types.NodePhaseRunning, "[config.rpc]\nmax_open_connections = 2000", true, configActionRestarted, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := store.NewMemoryStore()
+			rt := &reloadingRuntime{}
+			svc := NewNodeService(s, nil, rt)
+			home := createConfigNode(t, s, tt.phase)
+
+			resp, err := svc.ApplyNodeConfig(ctx, &v1.ApplyNodeConfigRequest{
+				DevnetName:          "test-devnet",
+				Overrides:           tt.overrides,
+				NoRestartIfPossible: tt.noRestart,
+			})
+			if err != nil {
+				t.Fatalf("ApplyNodeConfig: %v", err)
+			}
+			if resp.Action != tt.wantAction {
+				t.Errorf("Action = %q, want %q (%s)", resp.Action, tt.wantAction, resp.Message)
+			}
+			if (rt.reloaded != nil) != tt.wantReload {
+				t.Errorf("reloaded = %v, want reload %v", rt.reloaded, tt.wantReload)
+			}
+
+			if tt.wantAction == configActionUnchanged {
+				return
+			}
+			if len(resp.Changes) != 1 || resp.Changes[0].OldValue == resp.Changes[0].NewValue {
+				t.Errorf("unexpected changes: %v", resp.Changes)
+			}
+			data, _ := os.ReadFile(filepath.Join(home, "config", "config.toml"))
+			if !strings.Contains(string(data), resp.Changes[0].NewValue) {
+				t.Errorf("config.toml not patched:\n%s", data)
+			}
+
+			node, _ := s.GetNode(ctx, "", "test-devnet", 0)
+			restarted := node.Status.RestartCount == 1
+			if restarted != (tt.wantAction == configActionRestarted) {
+				t.Errorf("RestartCount = %d for action %q", node.Status.RestartCount, resp.Action)
+			}
+		})
+	}
+}
+
+func TestNodeService_GetNodeConfig(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	if err := s.CreateDevnet(ctx, &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test-devnet"},
+		Spec: types.DevnetSpec{Plugin: "not-loaded", Profile: types.ProfileLaptop, NodeConfigs: []types.NodeConfig{
+			{Index: 0, ConfigToml: "[rpc]\nmax_open_connections = 900"},
+			{Index: 1, AppToml: `pruning = "nothing"`},
+		}},
+	}); err != nil {
+		t.Fatalf("CreateDevnet: %v", err)
+	}
+	home := createConfigNode(t, s, types.NodePhaseStopped)
+	app := "pruning = \"everything\"\n\n[api]\nenable = true\naddress = \"tcp://0.0.0.0:1317\"\n"
+	if err := os.WriteFile(filepath.Join(home, "config", "app.toml"), []byte(app), 0644); err != nil {
+		t.Fatal(err)
+	}
+	svc := NewNodeService(s, nil, nil)
+
+	if _, err := svc.ApplyNodeConfig(ctx, &v1.ApplyNodeConfigRequest{
+		DevnetName: "test-devnet",
+		Overrides:  "[config]\nlog_level = \"debug\"",
+	}); err != nil {
+		t.Fatalf("ApplyNodeConfig: %v", err)
+	}
+
+	resp, err := svc.GetNodeConfig(ctx, &v1.GetNodeConfigRequest{DevnetName: "test-devnet"})
+	if err != nil {
+		t.Fatalf("GetNodeConfig: %v", err)
+	}
+
+	got := make(map[string]string)
+	for _, f := range resp.Fields {
+		got[f.File+":"+f.Key] = f.Value + " " + f.Source + " " + f.Detail
+	}
+	want := map[string]string{
+		"app.toml:api.address":                 `"tcp://0.0.0.0:1317" runtime node 0 on 0.0.0.0`,
+		"app.toml:api.enable":                  "true spec devnet default",
+		"app.toml:pruning":                     `"everything" spec profile laptop`,
+		"config.toml:log_level":                `"debug" user apply-config`,
+		"config.toml:rpc.max_open_connections": "900 spec spec.nodes[0]",
+	}
+	for key, w := range want {
+		if got[key] != w {
+			t.Errorf("%s = %q, want %q", key, got[key], w)
+		}
+	}
+	if len(resp.Fields) != len(want) {
+		t.Errorf("expected %d fields, got %v", len(want), resp.Fields)
+	}
+
+	_, err = svc.GetNodeConfig(ctx, &v1.GetNodeConfigRequest{DevnetName: "test-devnet", Index: 3})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+}
+
+func TestNodeService_SyncNodeConfig(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		phase      string
+		apply      bool
+		wantAction string
+		wantValue  string
+	}{
+		{"drift is only reported", types.NodePhaseRunning, false, configActionDrifted, "max_open_connections = 900\n"},
+		{"stopped node is deferred", types.NodePhaseStopped, true, configActionDeferred, "max_open_connections = 2000\n"},
+		{"running node restarts", types.NodePhaseRunning, true, configActionRestarted, "max_open_connections = 2000\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := store.NewMemoryStore()
+			if err := s.CreateDevnet(ctx, &types.Devnet{
+				Metadata: types.ResourceMeta{Name: "test-devnet"},
+				Spec: types.DevnetSpec{Plugin: "not-loaded", NodeConfigs: []types.NodeConfig{
+					{Index: 0, ConfigToml: "log_level = \"info\"\n[rpc]\nmax_open_connections = 2000"},
+				}},
+			}); err != nil {
+				t.Fatalf("CreateDevnet: %v", err)
+			}
+			home := createConfigNode(t, s, tt.phase)
+			svc := NewNodeService(s, nil, &reloadingRuntime{})
+
+			resp, err := svc.SyncNodeConfig(ctx, &v1.SyncNodeConfigRequest{DevnetName: "test-devnet", Apply: tt.apply})
+			if err != nil {
+				t.Fatalf("SyncNodeConfig: %v", err)
+			}
+			if resp.Action != tt.wantAction {
+				t.Errorf("Action = %q, want %q (%s)", resp.Action, tt.wantAction, resp.Message)
+			}
+			want := &v1.ConfigDrift{
+				File: "config.toml", Key: "rpc.max_open_connections",
+				CurrentValue: "900", ExpectedValue: "2000",
+				Source: "spec", Detail: "spec.nodes[0]",
+			}
+			if len(resp.Drift) != 1 || !proto.Equal(resp.Drift[0], want) {
+				t.Errorf("Drift = %v, want [%v]", resp.Drift, want)
+			}
+
+			data, _ := os.ReadFile(filepath.Join(home, "config", "config.toml"))
+			if !strings.Contains(string(data), tt.wantValue) {
+				t.Errorf("config.toml lacks %q:\n%s", tt.wantValue, data)
+			}
+
+			node, _ := s.GetNode(ctx, "", "test-devnet", 0)
+			if restarted := node.Status.RestartCount == 1; restarted != (tt.wantAction == configActionRestarted) {
+				t.Errorf("RestartCount = %d for action %q", node.Status.RestartCount, resp.Action)
+			}
+
+			if tt.apply {
+				resp, err := svc.SyncNodeConfig(ctx, &v1.SyncNodeConfigRequest{DevnetName: "test-devnet"})
+				if err != nil {
+					t.Fatalf("second SyncNodeConfig: %v", err)
+				}
+				if resp.Action != configActionUnchanged || len(resp.Drift) != 0 {
+					t.Errorf("after sync: Action = %q, Drift = %v", resp.Action, resp.Drift)
+				}
+			}
+		})
+	}
+
+	_, err := NewNodeService(store.NewMemoryStore(), nil, nil).SyncNodeConfig(ctx, &v1.SyncNodeConfigRequest{DevnetName: "test-devnet"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for a missing devnet, got %v", err)
+	}
+}
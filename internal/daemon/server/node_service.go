@@ -3,17 +3,22 @@ package server
 import (
 	"bufio"
 	"context"
+	"fmt"
 	"io"
 	"log/slog"
 	"strings"
 	"time"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/controller"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/portalloc"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/server/ante"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,12 +28,14 @@ import (
 // NodeService implements the gRPC NodeServiceServer.
 type NodeService struct {
 	v1.UnimplementedNodeServiceServer
-	store       store.Store
-	manager     *controller.Manager
-	runtime     runtime.NodeRuntime
-	logger      *slog.Logger
-	ante        *ante.AnteHandler
-	shutdownCtx context.Context // Cancelled during server shutdown to terminate streaming RPCs
+	store         store.Store
+	manager       *controller.Manager
+	runtime       runtime.NodeRuntime
+	logger        *slog.Logger
+	ante          *ante.AnteHandler
+	shutdownCtx   context.Context // Cancelled during server shutdown to terminate streaming RPCs
+	portAlloc     *portalloc.Allocator
+	binaryBuilder builder.BinaryBuilder
 }
 
 // NewNodeService creates a new NodeService.
@@ -60,6 +67,17 @@ func (s *NodeService) SetLogger(logger *slog.Logger) {
 	s.logger = logger
 }
 
+// SetPortAllocator sets the host port allocator GetNodePorts reports the
+// allocated blocks from.
+func (s *NodeService) SetPortAllocator(alloc *portalloc.Allocator) {
+	s.portAlloc = alloc
+}
+
+// SetBinaryBuilder sets the builder SetNodeBinary builds node binaries with.
+func (s *NodeService) SetBinaryBuilder(b builder.BinaryBuilder) {
+	s.binaryBuilder = b
+}
+
 // mergeContexts creates a context that is cancelled when either the given context
 // or the server shutdown context is cancelled. This allows streaming RPCs to
 // terminate gracefully during server shutdown.
@@ -373,7 +391,8 @@ func (s *NodeService) ExecInNode(ctx context.Context, req *v1.ExecInNodeRequest)
 
 	// Check if node is running
 	if node.Status.Phase != types.NodePhaseRunning {
-		return nil, status.Errorf(codes.FailedPrecondition, "node is not running (current phase: %s)", node.Status.Phase)
+		return nil, status.Errorf(codes.FailedPrecondition, "node is not running (current phase: %s)%s",
+			node.Status.Phase, resumeHint(req.DevnetName, node.Status.Phase))
 	}
 
 	// Check if runtime is available
@@ -431,35 +450,58 @@ func (s *NodeService) GetNodePorts(ctx context.Context, req *v1.GetNodePortsRequ
 		return nil, status.Errorf(codes.Internal, "failed to get node: %v", err)
 	}
 
-	// Calculate port offset: each node gets 100 ports apart
-	offset := int32(node.Spec.Index * 100)
+	namespace := node.Spec.NamespaceRef
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+	devnet, err := s.store.GetDevnet(ctx, namespace, node.Spec.DevnetRef)
+	if err != nil {
+		devnet = nil
+	}
+	container, host, evm := s.nodePorts(devnet, node)
 
 	ports := []*v1.PortMapping{
 		{
 			Name:          "p2p",
-			ContainerPort: defaultP2PPort,
-			HostPort:      defaultP2PPort + offset,
+			ContainerPort: int32(container.P2P),
+			HostPort:      int32(host.P2P),
 			Protocol:      "tcp",
 		},
 		{
 			Name:          "rpc",
-			ContainerPort: defaultRPCPort,
-			HostPort:      defaultRPCPort + offset,
+			ContainerPort: int32(container.RPC),
+			HostPort:      int32(host.RPC),
 			Protocol:      "tcp",
 		},
 		{
 			Name:          "rest",
-			ContainerPort: defaultRESTPort,
-			HostPort:      defaultRESTPort + offset,
+			ContainerPort: int32(container.API),
+			HostPort:      int32(host.API),
 			Protocol:      "tcp",
 		},
 		{
 			Name:          "grpc",
-			ContainerPort: defaultGRPCPort,
-			HostPort:      defaultGRPCPort + offset,
+			ContainerPort: int32(container.GRPC),
+			HostPort:      int32(host.GRPC),
 			Protocol:      "tcp",
 		},
 	}
+	if evm {
+		ports = append(ports,
+			&v1.PortMapping{
+				Name:          "evm-rpc",
+				ContainerPort: int32(container.EVMRPC),
+				HostPort:      int32(host.EVMRPC),
+				Protocol:      "tcp",
+			},
+			&v1.PortMapping{
+				Name:          "evm-ws",
+				ContainerPort: int32(container.EVMWS),
+				HostPort:      int32(host.EVMWS),
+				Protocol:      "tcp",
+			},
+		)
+	}
 
 	return &v1.GetNodePortsResponse{
 		DevnetName: req.DevnetName,
@@ -468,6 +510,73 @@ func (s *NodeService) GetNodePorts(ctx context.Context, req *v1.GetNodePortsRequ
 	}, nil
 }
 
+// nodePorts returns the ports a node listens on and the host ports they
+// are reached on, and whether it serves EVM JSON-RPC. Process nodes listen
+// on their layout's ports directly. Docker nodes publish the fixed
+// container ports on a block of host ports: the node's index, unless the
+// allocator moved it to a free block. A nil devnet is taken as docker mode.
+func (s *NodeService) nodePorts(devnet *types.Devnet, node *types.Node) (container, host dvbtypes.PortConfig, evm bool) {
+	if devnet != nil && devnet.Spec.Mode != "docker" {
+		host = node.Spec.Ports()
+		return host, host, servesEVM(devnet, node)
+	}
+
+	namespace := node.Spec.NamespaceRef
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+	layout := node.Spec.PortLayout
+	if layout.Stride == 0 {
+		layout.Stride = portalloc.BlockSize
+	}
+	block := node.Spec.Index
+	if s.portAlloc != nil {
+		if allocated, ok := s.portAlloc.Lookup(namespace, node.Spec.DevnetRef, node.Spec.Index); ok {
+			block = allocated
+		}
+	}
+	container = dvbtypes.PortConfig{P2P: defaultP2PPort, RPC: defaultRPCPort, API: defaultRESTPort, GRPC: defaultGRPCPort}
+	return container, layout.ForNode(block), false
+}
+
+// evmNetwork reports whether the named network has an EVM.
+func evmNetwork(name string) bool {
+	module, err := network.Get(name)
+	return err == nil && isEVMNetwork(module)
+}
+
+// servesEVM reports whether a node serves EVM JSON-RPC on its host ports.
+// Docker nodes do not publish the EVM ports, and profiles that serve APIs
+// from the first node only disable JSON-RPC on the others.
+func servesEVM(devnet *types.Devnet, node *types.Node) bool {
+	if devnet.Spec.Mode == "docker" {
+		return false
+	}
+	if profile, ok := types.LookupProfile(devnet.Spec.Profile); ok && profile.ServicesOnFirstNodeOnly && node.Spec.Index > 0 {
+		return false
+	}
+	return evmNetwork(devnet.Spec.Plugin)
+}
+
+// evmHealthProbe returns the health checker's EVM hook, which selects the
+// nodes that serve EVM JSON-RPC.
+func evmHealthProbe(st store.Store) func(node *types.Node) bool {
+	return func(node *types.Node) bool {
+		if !evmNetwork(node.Spec.Network) {
+			return false
+		}
+		namespace := node.Spec.NamespaceRef
+		if namespace == "" {
+			namespace = types.DefaultNamespace
+		}
+		devnet, err := st.GetDevnet(context.Background(), namespace, node.Spec.DevnetRef)
+		if err != nil {
+			return false
+		}
+		return servesEVM(devnet, node)
+	}
+}
+
 // StreamNodeLogs streams logs from a node to the client.
 func (s *NodeService) StreamNodeLogs(req *v1.StreamNodeLogsRequest, stream grpc.ServerStreamingServer[v1.StreamNodeLogsResponse]) error {
 	if req.DevnetName == "" {
@@ -598,6 +707,26 @@ func NodeToProto(n *types.Node) *v1.Node {
 		return nil
 	}
 
+	nodeStatus := &v1.NodeStatus{
+		Phase:        n.Status.Phase,
+		Pid:          int32(n.Status.PID),
+		BlockHeight:  n.Status.BlockHeight,
+		PeerCount:    int32(n.Status.PeerCount),
+		CatchingUp:   n.Status.CatchingUp,
+		RestartCount: int32(n.Status.RestartCount),
+		Message:      n.Status.Message,
+		Version:      n.Status.Version,
+		Health:       nodeHealthToProto(&n.Status),
+		Conditions:   conditionsToProto(n.Status.Conditions),
+
+		BlocksPerMinute:     n.Status.BlocksPerMinute,
+		AvgBlockTimeSeconds: n.Status.AvgBlockTime.Seconds(),
+		HeightLag:           n.Status.HeightLag,
+	}
+	if !n.Status.LastBlockTime.IsZero() {
+		nodeStatus.LastBlockTime = timestamppb.New(n.Status.LastBlockTime)
+	}
+
 	return &v1.Node{
 		Metadata: &v1.NodeMetadata{
 			Id:         n.Metadata.Name,
@@ -614,19 +743,47 @@ func NodeToProto(n *types.Node) *v1.Node {
 			HomeDir:      n.Spec.HomeDir,
 			DesiredPhase: n.Spec.Desired,
 			Address:      n.Spec.Address,
+			Ports:        nodePortsToProto(n.Spec.Ports()),
+			BindAddress:  n.Spec.BindAddress,
 		},
-		Status: &v1.NodeStatus{
-			Phase:        n.Status.Phase,
-			Pid:          int32(n.Status.PID),
-			BlockHeight:  n.Status.BlockHeight,
-			PeerCount:    int32(n.Status.PeerCount),
-			CatchingUp:   n.Status.CatchingUp,
-			RestartCount: int32(n.Status.RestartCount),
-			Message:      n.Status.Message,
-		},
+		Status: nodeStatus,
 	}
 }
 
+// nodeHealthToProto summarizes a node's health checks and conditions:
+// Unhealthy when checks fail or it is not synced, Degraded when peers are
+// missing or its disk is under pressure.
+func nodeHealthToProto(s *types.NodeStatus) *v1.NodeHealth {
+	if s.LastHealthCheck.IsZero() {
+		return &v1.NodeHealth{Status: "Unknown"}
+	}
+	health := &v1.NodeHealth{
+		Status:              "Healthy",
+		LastCheck:           timestamppb.New(s.LastHealthCheck),
+		ConsecutiveFailures: int32(s.ConsecutiveFailures),
+	}
+	if s.ConsecutiveFailures > 0 {
+		health.Status = "Unhealthy"
+		health.Message = fmt.Sprintf("%d consecutive health check failures", s.ConsecutiveFailures)
+	}
+	for _, c := range s.Conditions {
+		switch {
+		case c.Type == types.ConditionTypeSynced && c.Status == types.ConditionFalse:
+			if health.Status != "Unhealthy" {
+				health.Status = "Unhealthy"
+				health.Message = c.Message
+			}
+		case c.Type == types.ConditionTypePeersHealthy && c.Status == types.ConditionFalse,
+			c.Type == types.ConditionTypeDiskPressure && c.Status == types.ConditionTrue:
+			if health.Status == "Healthy" {
+				health.Status = "Degraded"
+				health.Message = c.Message
+			}
+		}
+	}
+	return health
+}
+
 // NodeFromProto converts a proto Node to a domain Node.
 func NodeFromProto(pb *v1.Node) *types.Node {
 	if pb == nil {
@@ -655,6 +812,7 @@ func NodeFromProto(pb *v1.Node) *types.Node {
 		n.Spec.HomeDir = pb.Spec.HomeDir
 		n.Spec.Desired = pb.Spec.DesiredPhase
 		n.Spec.Address = pb.Spec.Address
+		n.Spec.BindAddress = pb.Spec.BindAddress
 	}
 
 	if pb.Status != nil {
@@ -665,6 +823,14 @@ func NodeFromProto(pb *v1.Node) *types.Node {
 		n.Status.CatchingUp = pb.Status.CatchingUp
 		n.Status.RestartCount = int(pb.Status.RestartCount)
 		n.Status.Message = pb.Status.Message
+		n.Status.Version = pb.Status.Version
+		n.Status.Conditions = conditionsFromProto(pb.Status.Conditions)
+		n.Status.BlocksPerMinute = pb.Status.BlocksPerMinute
+		n.Status.AvgBlockTime = time.Duration(pb.Status.AvgBlockTimeSeconds * float64(time.Second))
+		n.Status.HeightLag = pb.Status.HeightLag
+		if pb.Status.LastBlockTime != nil {
+			n.Status.LastBlockTime = pb.Status.LastBlockTime.AsTime()
+		}
 	}
 
 	return n
@@ -2,11 +2,15 @@ package server
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/plugin/builtin"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -466,6 +470,74 @@ func TestNodeToProto(t *testing.T) {
 	}
 }
 
+func TestNodeToProto_Conditions(t *testing.T) {
+	now := time.Now()
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: "test-node"},
+		Status: types.NodeStatus{
+			Phase:           types.NodePhaseRunning,
+			LastHealthCheck: now,
+			LastBlockTime:   now,
+			Conditions: []types.Condition{
+				{Type: types.ConditionTypeSynced, Status: types.ConditionTrue, Reason: types.ReasonSynced, LastTransitionTime: now},
+				{Type: types.ConditionTypePeersHealthy, Status: types.ConditionFalse, Reason: types.ReasonNoPeers, Message: "No peers connected, expected 3"},
+				{Type: types.ConditionTypeDiskPressure, Status: types.ConditionFalse, Reason: types.ReasonSufficientDiskSpace},
+			},
+		},
+	}
+
+	pb := NodeToProto(node)
+	if len(pb.Status.Conditions) != 3 {
+		t.Fatalf("expected 3 conditions, got %d", len(pb.Status.Conditions))
+	}
+	if c := pb.Status.Conditions[0]; c.Type != types.ConditionTypeSynced || !c.LastTransitionTime.AsTime().Equal(now) {
+		t.Errorf("unexpected Synced condition: %v", c)
+	}
+	if pb.Status.LastBlockTime == nil {
+		t.Error("expected LastBlockTime to be set")
+	}
+	if pb.Status.Health.Status != "Degraded" || pb.Status.Health.Message != "No peers connected, expected 3" {
+		t.Errorf("Health = %v, want Degraded from PeersHealthy", pb.Status.Health)
+	}
+
+	// Round trip
+	back := NodeFromProto(pb)
+	if len(back.Status.Conditions) != 3 || back.Status.Conditions[1].Reason != types.ReasonNoPeers {
+		t.Errorf("conditions lost in round trip: %v", back.Status.Conditions)
+	}
+
+	node.Status.ConsecutiveFailures = 2
+	if h := NodeToProto(node).Status.Health; h.Status != "Unhealthy" || h.ConsecutiveFailures != 2 {
+		t.Errorf("Health = %v, want Unhealthy with 2 failures", h)
+	}
+
+	node.Status.LastHealthCheck = time.Time{}
+	if h := NodeToProto(node).Status.Health; h.Status != "Unknown" {
+		t.Errorf("Health = %v, want Unknown before the first check", h)
+	}
+}
+
+func TestNodeToProto_BlockRate(t *testing.T) {
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: "test-node"},
+		Status: types.NodeStatus{
+			BlocksPerMinute: 10,
+			AvgBlockTime:    6 * time.Second,
+			HeightLag:       3,
+		},
+	}
+
+	pb := NodeToProto(node)
+	if pb.Status.BlocksPerMinute != 10 || pb.Status.AvgBlockTimeSeconds != 6 || pb.Status.HeightLag != 3 {
+		t.Errorf("block rate = %v/min, %vs, lag %d", pb.Status.BlocksPerMinute, pb.Status.AvgBlockTimeSeconds, pb.Status.HeightLag)
+	}
+
+	back := NodeFromProto(pb)
+	if back.Status.BlocksPerMinute != 10 || back.Status.AvgBlockTime != 6*time.Second || back.Status.HeightLag != 3 {
+		t.Errorf("block rate lost in round trip: %+v", back.Status)
+	}
+}
+
 func TestNodeToProto_Nil(t *testing.T) {
 	if NodeToProto(nil) != nil {
 		t.Error("NodeToProto(nil) should return nil")
@@ -549,6 +621,100 @@ func TestNodeService_GetNodePorts(t *testing.T) {
 	}
 }
 
+func TestNodeService_GetNodePorts_PortLayout(t *testing.T) {
+	s := store.NewMemoryStore()
+	svc := NewNodeService(s, nil, nil)
+	ctx := context.Background()
+
+	layout := dvbtypes.PortLayout{RPC: 36657, Stride: 10}
+	for _, mode := range []string{"local", "docker"} {
+		devnet := &types.Devnet{
+			Metadata: types.ResourceMeta{Name: mode + "-ports", Namespace: types.DefaultNamespace},
+			Spec:     types.DevnetSpec{Plugin: "stable", Mode: mode, Validators: 2},
+		}
+		if err := s.CreateDevnet(ctx, devnet); err != nil {
+			t.Fatalf("CreateDevnet: %v", err)
+		}
+		node := &types.Node{
+			Metadata: types.ResourceMeta{Name: mode + "-ports-1", Namespace: types.DefaultNamespace},
+			Spec:     types.NodeSpec{DevnetRef: mode + "-ports", Index: 1, Role: "validator", PortLayout: layout},
+		}
+		if err := s.CreateNode(ctx, node); err != nil {
+			t.Fatalf("CreateNode: %v", err)
+		}
+	}
+
+	rpcPort := func(devnet string) *v1.PortMapping {
+		resp, err := svc.GetNodePorts(ctx, &v1.GetNodePortsRequest{DevnetName: devnet, Index: 1})
+		if err != nil {
+			t.Fatalf("GetNodePorts failed: %v", err)
+		}
+		for _, p := range resp.Ports {
+			if p.Name == "rpc" {
+				return p
+			}
+		}
+		t.Fatal("RPC port not found in response")
+		return nil
+	}
+
+	// Local nodes listen on their layout's ports directly
+	if p := rpcPort("local-ports"); p.ContainerPort != 36667 || p.HostPort != 36667 {
+		t.Errorf("local RPC = %d -> %d, want 36667 -> 36667", p.ContainerPort, p.HostPort)
+	}
+	// Docker nodes publish the fixed container port on the layout's host port
+	if p := rpcPort("docker-ports"); p.ContainerPort != 26657 || p.HostPort != 36667 {
+		t.Errorf("docker RPC = %d -> %d, want 26657 -> 36667", p.ContainerPort, p.HostPort)
+	}
+}
+
+func TestNodeService_GetNodePorts_EVM(t *testing.T) {
+	if err := builtin.Register(); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	s := store.NewMemoryStore()
+	svc := NewNodeService(s, nil, nil)
+	ctx := context.Background()
+
+	for _, plugin := range []string{"evmos", "cosmos"} {
+		devnet := &types.Devnet{
+			Metadata: types.ResourceMeta{Name: plugin, Namespace: types.DefaultNamespace},
+			Spec:     types.DevnetSpec{Plugin: plugin, Mode: "local", Validators: 1},
+		}
+		if err := s.CreateDevnet(ctx, devnet); err != nil {
+			t.Fatalf("CreateDevnet: %v", err)
+		}
+		node := &types.Node{
+			Metadata: types.ResourceMeta{Name: plugin + "-0", Namespace: types.DefaultNamespace},
+			Spec:     types.NodeSpec{DevnetRef: plugin, Role: "validator", PortLayout: dvbtypes.PortLayout{EVMRPC: 18545}},
+		}
+		if err := s.CreateNode(ctx, node); err != nil {
+			t.Fatalf("CreateNode: %v", err)
+		}
+	}
+
+	evmPorts := func(devnet string) map[string]int32 {
+		resp, err := svc.GetNodePorts(ctx, &v1.GetNodePortsRequest{DevnetName: devnet})
+		if err != nil {
+			t.Fatalf("GetNodePorts failed: %v", err)
+		}
+		ports := make(map[string]int32)
+		for _, p := range resp.Ports {
+			if strings.HasPrefix(p.Name, "evm") {
+				ports[p.Name] = p.HostPort
+			}
+		}
+		return ports
+	}
+
+	if got := evmPorts("evmos"); got["evm-rpc"] != 18545 || got["evm-ws"] != 18546 {
+		t.Errorf("evmos EVM ports = %v, want evm-rpc 18545, evm-ws 18546", got)
+	}
+	if got := evmPorts("cosmos"); len(got) != 0 {
+		t.Errorf("cosmos EVM ports = %v, want none", got)
+	}
+}
+
 func TestNodeService_GetNodePorts_NotFound(t *testing.T) {
 	s := store.NewMemoryStore()
 	svc := NewNodeService(s, nil, nil)
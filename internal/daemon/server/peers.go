@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/peers"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPeerMatrix queries every node of a running devnet for its peers and
+// reports which nodes are connected to which, along with persistent_peers
+// misconfigurations.
+func (s *DevnetService) GetPeerMatrix(ctx context.Context, req *v1.GetPeerMatrixRequest) (*v1.GetPeerMatrixResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+
+	nodes, err := s.runningNodes(ctx, req.GetNamespace(), req.DevnetName, "peer inspection")
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]peers.Node, 0, len(nodes))
+	for _, node := range nodes {
+		targets = append(targets, peers.Node{
+			Index:   node.Spec.Index,
+			Name:    nodeDisplayName(node),
+			RPCURL:  nodeRPCURL(node),
+			HomeDir: node.Spec.HomeDir,
+			Address: node.Spec.Address,
+		})
+	}
+
+	report := peers.NewInspector(peers.Config{}).Inspect(ctx, targets)
+	return peerReportToProto(report), nil
+}
+
+// runningNodes returns the nodes of a running devnet ordered by index. what
+// names the operation for the error when the devnet is not running.
+func (s *DevnetService) runningNodes(ctx context.Context, namespace, devnetName, what string) ([]*types.Node, error) {
+	devnet, err := s.store.GetDevnet(ctx, namespace, devnetName)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", devnetName)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+	if devnet.Status.Phase != types.PhaseRunning && devnet.Status.Phase != types.PhaseDegraded {
+		return nil, status.Errorf(codes.FailedPrecondition, "devnet %q is %s; %s requires a running devnet%s",
+			devnetName, devnet.Status.Phase, what, resumeHint(devnetName, devnet.Status.Phase))
+	}
+
+	nodes, err := s.store.ListNodes(ctx, devnet.Metadata.Namespace, devnetName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list nodes: %v", err)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Spec.Index < nodes[j].Spec.Index })
+	return nodes, nil
+}
+
+// nodeDisplayName returns the name dvb shows for a node, e.g. "validator-0".
+func nodeDisplayName(node *types.Node) string {
+	return fmt.Sprintf("%s-%d", strings.ToLower(node.Spec.Role), node.Spec.Index)
+}
+
+// nodeRPCURL returns the CometBFT RPC URL of a node.
+func nodeRPCURL(node *types.Node) string {
+	host := node.Spec.Address
+	if host == "" {
+		host = "127.0.0.1"
+	}
+	return node.Spec.Ports().RPCURL(host)
+}
+
+// peerReportToProto converts a peers report to its API form.
+func peerReportToProto(report *peers.Report) *v1.GetPeerMatrixResponse {
+	resp := &v1.GetPeerMatrixResponse{}
+	for i, n := range report.Nodes {
+		node := &v1.PeerNode{
+			Index:           int32(n.Index),
+			Name:            n.Name,
+			NodeId:          n.ID,
+			ListenAddr:      n.ListenAddr,
+			Error:           n.Error,
+			PersistentPeers: n.PersistentPeers,
+		}
+		for j, connected := range report.Connected[i] {
+			if connected {
+				node.Connected = append(node.Connected, int32(report.Nodes[j].Index))
+			}
+		}
+		for _, p := range n.Peers {
+			if p.Index < 0 {
+				node.ExternalPeers++
+			}
+		}
+		resp.Nodes = append(resp.Nodes, node)
+	}
+	for _, w := range report.Warnings {
+		resp.Warnings = append(resp.Warnings, &v1.PeerWarning{NodeIndex: int32(w.NodeIndex), Message: w.Message})
+	}
+	return resp
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newPeerNode serves /status and /net_info for a node connected to peerID
+// and returns its RPC port.
+func newPeerNode(t *testing.T, id, peerID string) int {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/status":
+			fmt.Fprintf(w, `{"result":{"node_info":{"id":%q,"listen_addr":"tcp://0.0.0.0:26656"}}}`, id)
+		case "/net_info":
+			fmt.Fprintf(w, `{"result":{"peers":[{"node_info":{"id":%q}},{"node_info":{"id":"outsider"}}]}}`, peerID)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	u, _ := url.Parse(srv.URL)
+	port, _ := strconv.Atoi(u.Port())
+	return port
+}
+
+func TestDevnetService_GetPeerMatrix(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+
+	devnet := &types.Devnet{Metadata: types.ResourceMeta{Name: "alpha"}}
+	devnet.Status.Phase = types.PhaseProvisioning
+	if err := s.CreateDevnet(ctx, devnet); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+	_, err := svc.GetPeerMatrix(ctx, &v1.GetPeerMatrixRequest{DevnetName: "alpha"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for a devnet that is not running, got %v", err)
+	}
+
+	devnet, _ = s.GetDevnet(ctx, "", "alpha")
+	devnet.Status.Phase = types.PhaseRunning
+	if err := s.UpdateDevnet(ctx, devnet); err != nil {
+		t.Fatalf("UpdateDevnet failed: %v", err)
+	}
+	for i, ids := range [][2]string{{"id0", "id1"}, {"id1", "id0"}} {
+		node := &types.Node{
+			Metadata: types.ResourceMeta{Name: fmt.Sprintf("alpha-%d", i)},
+			Spec: types.NodeSpec{
+				DevnetRef:  "alpha",
+				Index:      i,
+				Role:       "validator",
+				PortLayout: dvbtypes.PortLayout{RPC: newPeerNode(t, ids[0], ids[1])},
+			},
+		}
+		if err := s.CreateNode(ctx, node); err != nil {
+			t.Fatalf("CreateNode failed: %v", err)
+		}
+	}
+
+	resp, err := svc.GetPeerMatrix(ctx, &v1.GetPeerMatrixRequest{DevnetName: "alpha"})
+	if err != nil {
+		t.Fatalf("GetPeerMatrix failed: %v", err)
+	}
+	if len(resp.Nodes) != 2 {
+		t.Fatalf("expected 2 nodes, got %d", len(resp.Nodes))
+	}
+	n1 := resp.Nodes[1]
+	if n1.Name != "validator-1" || n1.NodeId != "id1" {
+		t.Errorf("unexpected node 1: %s %s", n1.Name, n1.NodeId)
+	}
+	if len(n1.Connected) != 1 || n1.Connected[0] != 0 {
+		t.Errorf("expected node 1 connected to node 0, got %v", n1.Connected)
+	}
+	if n1.ExternalPeers != 1 {
+		t.Errorf("expected 1 external peer, got %d", n1.ExternalPeers)
+	}
+	if len(resp.Warnings) != 0 {
+		t.Errorf("expected no warnings, got %v", resp.Warnings)
+	}
+}
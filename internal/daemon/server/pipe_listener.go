@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"net"
+	"sync"
+)
+
+// pipeListener is an in-memory net.Listener. Each DialContext hands Accept
+// one end of a net.Pipe, so an in-process client such as the gateway can
+// reach the gRPC server without opening a socket. Its connections report a
+// memoryAddr, not net.Pipe's "pipe" address, which transport.IsLocal would
+// take for a local named pipe: gateway calls are remote calls.
+type pipeListener struct {
+	conns     chan net.Conn
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newPipeListener() *pipeListener {
+	return &pipeListener{
+		conns: make(chan net.Conn),
+		done:  make(chan struct{}),
+	}
+}
+
+// Accept waits for the next DialContext.
+func (l *pipeListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		return nil, net.ErrClosed
+	}
+}
+
+// Close stops Accept and DialContext. Connections already made stay open.
+func (l *pipeListener) Close() error {
+	l.closeOnce.Do(func() { close(l.done) })
+	return nil
+}
+
+// Addr returns a placeholder address.
+func (l *pipeListener) Addr() net.Addr {
+	return memoryAddr{}
+}
+
+// DialContext connects to the listener, waiting until Accept takes the
+// connection.
+func (l *pipeListener) DialContext(ctx context.Context) (net.Conn, error) {
+	server, client := net.Pipe()
+	select {
+	case l.conns <- memoryConn{server}:
+		return memoryConn{client}, nil
+	case <-l.done:
+		server.Close()
+		client.Close()
+		return nil, net.ErrClosed
+	case <-ctx.Done():
+		server.Close()
+		client.Close()
+		return nil, ctx.Err()
+	}
+}
+
+// memoryConn is a net.Pipe end that reports a memoryAddr.
+type memoryConn struct {
+	net.Conn
+}
+
+func (memoryConn) LocalAddr() net.Addr  { return memoryAddr{} }
+func (memoryConn) RemoteAddr() net.Addr { return memoryAddr{} }
+
+// memoryAddr is the address of a pipeListener and its connections.
+type memoryAddr struct{}
+
+func (memoryAddr) Network() string { return "memory" }
+func (memoryAddr) String() string  { return "memory" }
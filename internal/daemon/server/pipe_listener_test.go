@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/transport"
+)
+
+func TestPipeListener(t *testing.T) {
+	lis := newPipeListener()
+
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			close(accepted)
+			return
+		}
+		accepted <- conn
+	}()
+
+	client, err := lis.DialContext(context.Background())
+	if err != nil {
+		t.Fatalf("DialContext failed: %v", err)
+	}
+	defer client.Close()
+	server, ok := <-accepted
+	if !ok {
+		t.Fatal("Accept failed")
+	}
+	defer server.Close()
+
+	go func() { _, _ = client.Write([]byte("ping")) }()
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(server, buf); err != nil || string(buf) != "ping" {
+		t.Fatalf("read %q, %v", buf, err)
+	}
+
+	// Gateway calls must not pass for local connections
+	if transport.IsLocal(server.RemoteAddr()) {
+		t.Errorf("expected %s peer not to be local", server.RemoteAddr().Network())
+	}
+
+	if err := lis.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if _, err := lis.Accept(); !errors.Is(err, net.ErrClosed) {
+		t.Errorf("expected net.ErrClosed from Accept, got %v", err)
+	}
+	if _, err := lis.DialContext(context.Background()); !errors.Is(err, net.ErrClosed) {
+		t.Errorf("expected net.ErrClosed from DialContext, got %v", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	hclog "github.com/hashicorp/go-hclog"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	"github.com/altuslabsxyz/devnet-builder/internal/plugin/builtin"
 	"github.com/altuslabsxyz/devnet-builder/pkg/network/plugin"
 )
 
@@ -23,6 +24,15 @@ type PluginManagerConfig struct {
 	// Default directories (~/.devnet-builder/plugins, ./plugins) are always included.
 	PluginDirs []string
 
+	// StrictResponses turns populated Error fields in plugin RPC responses
+	// into errors naming the plugin and method.
+	StrictResponses bool
+
+	// WorkDir is the directory under which each plugin process gets a scoped
+	// working directory, HOME and TMPDIR. It does not confine the plugin.
+	// Empty leaves plugins in the daemon's environment.
+	WorkDir string
+
 	// Logger for logging plugin operations.
 	Logger *slog.Logger
 }
@@ -43,10 +53,29 @@ func NewPluginManager(config PluginManagerConfig) *PluginManager {
 	// Create loader with options
 	opts := []plugin.LoaderOption{
 		plugin.WithLogger(hcLogger),
+		plugin.WithStrictResponses(config.StrictResponses),
 	}
 	if len(config.PluginDirs) > 0 {
 		opts = append(opts, plugin.WithPluginDirs(config.PluginDirs...))
 	}
+	if config.WorkDir != "" {
+		opts = append(opts, plugin.WithWorkDir(config.WorkDir))
+	}
+	opts = append(opts, plugin.WithCrashHandler(func(crash *plugin.CrashError) {
+		if crash.Recovered {
+			logger.Error("plugin panicked",
+				"plugin", crash.PluginName,
+				"method", crash.Method,
+				"panic", crash.Reason)
+			return
+		}
+		logger.Error("plugin crashed",
+			"plugin", crash.PluginName,
+			"method", crash.Method,
+			"reason", crash.Reason,
+			"restart_in", crash.RestartIn,
+			"stderr", crash.Stderr)
+	}))
 
 	return &PluginManager{
 		loader: plugin.NewLoader(opts...),
@@ -113,6 +142,10 @@ func (pm *PluginManager) LoadAndRegister() (*LoadResult, error) {
 
 // loadAndRegisterPlugin loads a single plugin and registers it with the global registry.
 func (pm *PluginManager) loadAndRegisterPlugin(name string) error {
+	if builtin.Has(name) {
+		return fmt.Errorf("network %q is built in; remove or rename the plugin", name)
+	}
+
 	// Load the plugin
 	client, err := pm.loader.Load(name)
 	if err != nil {
@@ -143,31 +176,42 @@ func (pm *PluginManager) ListLoaded() []string {
 	return pm.loader.LoadedPlugins()
 }
 
-// Reload reloads a specific plugin.
+// Reload reloads a specific plugin and replaces its registry entry, so
+// callers see the module served by the new plugin process.
 func (pm *PluginManager) Reload(name string) error {
-	// First, try to reload the plugin in the loader
 	client, err := pm.loader.Reload(name)
 	if err != nil {
 		return fmt.Errorf("failed to reload plugin: %w", err)
 	}
 
-	// Get the module from the reloaded plugin
-	module := client.Module()
+	adapter := network.NewPluginAdapter(client.Module())
+	if err := network.Replace(adapter); err != nil {
+		return fmt.Errorf("failed to register plugin module: %w", err)
+	}
 
-	// Wrap with adapter
-	adapter := network.NewPluginAdapter(module)
+	return nil
+}
 
-	// Re-register with global registry
-	// Note: The registry doesn't support re-registration, so this may fail
-	// if the module was previously registered. In production, you'd want
-	// a mechanism to update existing registrations.
-	if err := network.MustRegister(adapter, false); err != nil {
-		pm.logger.Warn("plugin reloaded but registry update failed (already registered)",
-			"plugin", name,
-			"error", err)
+// LoadOrReload loads a newly installed plugin, or reloads it if it is
+// already loaded. It reports whether an existing plugin was replaced.
+// A network provided by a built-in module is never replaced by a plugin.
+func (pm *PluginManager) LoadOrReload(name string) (bool, error) {
+	if pm.loader.IsLoaded(name) {
+		if err := pm.Reload(name); err != nil {
+			return false, err
+		}
+		pm.logger.Info("plugin reloaded", "plugin", name)
+		return true, nil
 	}
 
-	return nil
+	if network.Has(name) {
+		return false, fmt.Errorf("network %q is provided by a built-in module", name)
+	}
+	if err := pm.loadAndRegisterPlugin(name); err != nil {
+		return false, err
+	}
+	pm.logger.Info("plugin loaded and registered", "plugin", name)
+	return false, nil
 }
 
 // DiscoverAvailable returns the names of available (but not necessarily loaded) plugins.
@@ -0,0 +1,202 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultPruneStrategy is the strategy nodes are pruned with unless another
+// is requested: keep only the latest application state.
+const DefaultPruneStrategy = "everything"
+
+// SetNodeCommandRunner sets what PruneNodes runs node binaries with. The
+// default runs them in the docker runtime for docker-mode devnets, and on
+// the daemon host otherwise.
+func (s *DevnetService) SetNodeCommandRunner(r runtime.NodeCommandRunner) {
+	s.commandRunner = r
+}
+
+// PruneNodes prunes the application state of one node, or of every node,
+// with its chain binary's prune command and reports the disk reclaimed.
+// Nodes are halted for it and restarted afterwards one at a time, so a
+// devnet keeps producing blocks while its nodes are pruned.
+func (s *DevnetService) PruneNodes(ctx context.Context, req *v1.PruneNodesRequest) (*v1.PruneNodesResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+	strategy := req.Strategy
+	if strategy == "" {
+		strategy = DefaultPruneStrategy
+	}
+
+	devnet, err := s.store.GetDevnet(ctx, req.GetNamespace(), req.DevnetName)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", req.DevnetName)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+	switch devnet.Status.Phase {
+	case types.PhaseRunning, types.PhaseDegraded, types.PhaseStopped:
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "devnet %q is %s; pruning needs a running or stopped devnet",
+			req.DevnetName, devnet.Status.Phase)
+	}
+
+	runner := s.commandRunner
+	if runner == nil {
+		if devnet.Spec.Mode == "docker" {
+			r, ok := s.runtime.(runtime.NodeCommandRunner)
+			if !ok {
+				return nil, status.Error(codes.FailedPrecondition,
+					"pruning docker-mode nodes requires the docker runtime; start devnetd with --runtime docker")
+			}
+			runner = r
+		} else {
+			runner = hostCommandRunner{}
+		}
+	}
+
+	namespace := devnet.Metadata.Namespace
+	nodes, err := s.store.ListNodes(ctx, namespace, req.DevnetName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list nodes: %v", err)
+	}
+	slices.SortFunc(nodes, func(a, b *types.Node) int { return a.Spec.Index - b.Spec.Index })
+	if !req.AllNodes {
+		i := slices.IndexFunc(nodes, func(n *types.Node) bool { return n.Spec.Index == int(req.Index) })
+		if i < 0 {
+			return nil, status.Errorf(codes.NotFound, "node %s/%d not found", req.DevnetName, req.Index)
+		}
+		nodes = nodes[i : i+1]
+	}
+	for _, node := range nodes {
+		if node.Spec.Host != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "node %s runs on remote host %s; pruning supports nodes on the daemon host",
+				node.Metadata.Name, node.Spec.Host.Name)
+		}
+	}
+
+	resp := &v1.PruneNodesResponse{}
+	for _, node := range nodes {
+		pruned, err := s.pruneNode(ctx, runner, node, strategy)
+		if err != nil {
+			return nil, err
+		}
+		resp.Nodes = append(resp.Nodes, pruned)
+	}
+	return resp, nil
+}
+
+// pruneNode halts a node unless it is stopped on purpose, prunes it, and
+// waits for it to run again.
+func (s *DevnetService) pruneNode(ctx context.Context, runner runtime.NodeCommandRunner, node *types.Node, strategy string) (*v1.PrunedNode, error) {
+	var halt []*types.Node
+	if node.Spec.Desired != types.NodePhaseStopped {
+		halt = append(halt, node)
+	}
+	halted, err := s.haltNodes(ctx, halt, "pruning")
+	if err != nil {
+		s.resumeNodes(context.WithoutCancel(ctx), halted, "pruning")
+		return nil, err
+	}
+	stopped, err := s.waitForNodes(ctx, []*types.Node{node}, func(n *types.Node) bool {
+		return n.Status.Phase == types.NodePhaseStopped || n.Status.Phase == types.NodePhaseCrashed
+	})
+	if err != nil {
+		s.resumeNodes(context.WithoutCancel(ctx), halted, "pruning")
+		return nil, err
+	}
+	node = stopped[0]
+
+	dataDir := filepath.Join(node.Spec.HomeDir, "data")
+	before, _ := dirSize(dataDir)
+	s.logger.Info("pruning node", "devnet", node.Spec.DevnetRef, "node", node.Metadata.Name, "strategy", strategy)
+	_, pruneErr := runner.RunNodeCommand(ctx, node, []string{"prune", strategy})
+	after, _ := dirSize(dataDir)
+
+	s.resumeNodes(context.WithoutCancel(ctx), halted, "pruning")
+	if pruneErr != nil {
+		return nil, status.Errorf(codes.Internal, "failed to prune node %s: %v", node.Metadata.Name, pruneErr)
+	}
+	s.logger.Info("pruned node", "devnet", node.Spec.DevnetRef, "node", node.Metadata.Name,
+		"bytesBefore", before, "bytesAfter", after)
+
+	if len(halted) > 0 {
+		restarted, err := s.waitForNodes(ctx, halted, func(n *types.Node) bool {
+			return n.Status.Phase == types.NodePhaseRunning || n.Status.Phase == types.NodePhaseCrashed
+		})
+		if err != nil {
+			return nil, err
+		}
+		if restarted[0].Status.Phase == types.NodePhaseCrashed {
+			return nil, status.Errorf(codes.Internal, "node %s crashed after pruning: %s",
+				node.Metadata.Name, restarted[0].Status.Message)
+		}
+	}
+	return &v1.PrunedNode{Node: node.Metadata.Name, BytesBefore: before, BytesAfter: after}, nil
+}
+
+// devnetPruner prunes devnets for the PruneController.
+type devnetPruner struct {
+	svc      *DevnetService
+	strategy string
+}
+
+func (p devnetPruner) PruneDevnet(ctx context.Context, namespace, name string) error {
+	_, err := p.svc.PruneNodes(ctx, &v1.PruneNodesRequest{
+		Namespace:  namespace,
+		DevnetName: name,
+		AllNodes:   true,
+		Strategy:   p.strategy,
+	})
+	return err
+}
+
+// hostCommandRunner runs the binaries of nodes on the daemon host.
+type hostCommandRunner struct{}
+
+// RunNodeCommand implements runtime.NodeCommandRunner.
+func (hostCommandRunner) RunNodeCommand(ctx context.Context, node *types.Node, args []string) (string, error) {
+	if node.Spec.BinaryPath == "" {
+		return "", fmt.Errorf("node %s has no binary", node.Metadata.Name)
+	}
+	args = append(append([]string{}, args...), "--home", node.Spec.HomeDir)
+	out, err := exec.CommandContext(ctx, node.Spec.BinaryPath, args...).CombinedOutput()
+	output := strings.TrimSpace(string(out))
+	if err != nil {
+		return output, fmt.Errorf("%s %s: %w: %s", filepath.Base(node.Spec.BinaryPath), strings.Join(args, " "), err, output)
+	}
+	return output, nil
+}
+
+// dirSize returns the total size of the regular files under dir.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakePruneRunner stands in for a prune command: it truncates the node's
+// application database, or fails.
+type fakePruneRunner struct {
+	nodes []string
+	args  []string
+	err   error
+}
+
+func (f *fakePruneRunner) RunNodeCommand(ctx context.Context, node *types.Node, args []string) (string, error) {
+	f.nodes, f.args = append(f.nodes, node.Metadata.Name), args
+	if f.err != nil {
+		return "", f.err
+	}
+	return "", os.WriteFile(filepath.Join(node.Spec.HomeDir, "data", "application.db"), make([]byte, 100), 0o644)
+}
+
+func TestDevnetService_PruneNodes(t *testing.T) {
+	defer func(d time.Duration) { exportPollInterval = d }(exportPollInterval)
+	exportPollInterval = 10 * time.Millisecond
+
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+	runner := &fakePruneRunner{}
+	svc.SetNodeCommandRunner(runner)
+
+	if _, err := svc.PruneNodes(ctx, &v1.PruneNodesRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for missing name, got %v", err)
+	}
+	if _, err := svc.PruneNodes(ctx, &v1.PruneNodesRequest{DevnetName: "alpha"}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for missing devnet, got %v", err)
+	}
+
+	if err := s.CreateDevnet(ctx, &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "alpha", Namespace: types.DefaultNamespace},
+		Spec:     types.DevnetSpec{Validators: 2},
+		Status:   types.DevnetStatus{Phase: types.PhaseRunning},
+	}); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+	root := t.TempDir()
+	for i, phase := range []string{types.NodePhaseRunning, types.NodePhaseStopped} {
+		node := exportTestNode(i, "validator", phase, 10)
+		node.Metadata.Name = "alpha-" + strconv.Itoa(i)
+		node.Spec.HomeDir = filepath.Join(root, node.Metadata.Name)
+		if err := os.MkdirAll(filepath.Join(node.Spec.HomeDir, "data"), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(node.Spec.HomeDir, "data", "application.db"), make([]byte, 1000), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.CreateNode(ctx, node); err != nil {
+			t.Fatalf("CreateNode failed: %v", err)
+		}
+	}
+
+	// Stand in for the node controller: stop halted nodes, start resumed ones
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(exportPollInterval):
+			}
+			nodes, _ := s.ListNodes(ctx, types.DefaultNamespace, "alpha")
+			for _, node := range nodes {
+				switch node.Status.Phase {
+				case types.NodePhaseStopping:
+					node.Status.Phase = types.NodePhaseStopped
+				case types.NodePhasePending:
+					node.Status.Phase = types.NodePhaseRunning
+				default:
+					continue
+				}
+				_ = s.UpdateNode(ctx, node)
+			}
+		}
+	}()
+
+	if _, err := svc.PruneNodes(ctx, &v1.PruneNodesRequest{DevnetName: "alpha", Index: 5}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for a missing node, got %v", err)
+	}
+
+	resp, err := svc.PruneNodes(ctx, &v1.PruneNodesRequest{DevnetName: "alpha", AllNodes: true})
+	if err != nil {
+		t.Fatalf("PruneNodes failed: %v", err)
+	}
+	if len(resp.Nodes) != 2 || resp.Nodes[0].Node != "alpha-0" || resp.Nodes[0].BytesBefore != 1000 || resp.Nodes[0].BytesAfter != 100 {
+		t.Errorf("unexpected response: %v", resp.Nodes)
+	}
+	if !slices.Equal(runner.nodes, []string{"alpha-0", "alpha-1"}) || !slices.Equal(runner.args, []string{"prune", DefaultPruneStrategy}) {
+		t.Errorf("unexpected prune commands: %v %v", runner.nodes, runner.args)
+	}
+
+	// The running node runs again; the node stopped on purpose stays stopped
+	running, _ := s.GetNode(ctx, types.DefaultNamespace, "alpha", 0)
+	if running.Spec.Desired != types.NodePhaseRunning || running.Status.Phase != types.NodePhaseRunning {
+		t.Errorf("node 0 not resumed: desired %s, phase %s", running.Spec.Desired, running.Status.Phase)
+	}
+	stopped, _ := s.GetNode(ctx, types.DefaultNamespace, "alpha", 1)
+	if stopped.Spec.Desired != types.NodePhaseStopped || stopped.Status.Phase != types.NodePhaseStopped {
+		t.Errorf("node 1 resumed: desired %s, phase %s", stopped.Spec.Desired, stopped.Status.Phase)
+	}
+
+	// A failed prune still resumes the node
+	runner.err = errors.New("prune: unknown strategy")
+	_, err = svc.PruneNodes(ctx, &v1.PruneNodesRequest{DevnetName: "alpha", Index: 0, Strategy: "sometimes"})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("expected Internal for a failed prune, got %v", err)
+	}
+	running, _ = s.GetNode(ctx, types.DefaultNamespace, "alpha", 0)
+	if running.Spec.Desired != types.NodePhaseRunning {
+		t.Errorf("node 0 not resumed after a failed prune: desired %s", running.Spec.Desired)
+	}
+}
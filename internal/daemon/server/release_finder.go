@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	goruntime "runtime"
+	"strings"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/credentials"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/github"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+)
+
+// releaseFetcher fetches a single release by tag from a GitHub repository.
+type releaseFetcher interface {
+	FetchReleaseByTag(ctx context.Context, tag string) (*github.GitHubRelease, error)
+}
+
+// GitHubReleaseFinder implements builder.ReleaseFinder using the GitHub
+// releases of each plugin's BinarySource.
+type GitHubReleaseFinder struct {
+	newClient func(owner, repo string) releaseFetcher
+	goos      string
+	goarch    string
+	logger    *slog.Logger
+}
+
+// NewGitHubReleaseFinder creates a GitHubReleaseFinder for the host platform.
+func NewGitHubReleaseFinder(logger *slog.Logger) *GitHubReleaseFinder {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GitHubReleaseFinder{
+		newClient: func(owner, repo string) releaseFetcher {
+			return github.NewClient(
+				github.WithToken(credentials.GitHubToken()),
+				github.WithOwnerRepo(owner, repo),
+			)
+		},
+		goos:   goruntime.GOOS,
+		goarch: goruntime.GOARCH,
+		logger: logger,
+	}
+}
+
+// FindReleaseAsset returns the prebuilt binary for pluginName at version.
+// Returns builder.ErrNoReleaseAsset if the plugin has no GitHub source, the
+// version is not a published release, or the release has no matching asset.
+func (f *GitHubReleaseFinder) FindReleaseAsset(ctx context.Context, pluginName, version string) (*builder.ReleaseAsset, error) {
+	module, err := network.Get(pluginName)
+	if err != nil {
+		return nil, fmt.Errorf("network %q not found: %w", pluginName, err)
+	}
+
+	source := module.BinarySource()
+	if !source.IsGitHub() || source.Owner == "" || source.Repo == "" {
+		return nil, builder.ErrNoReleaseAsset
+	}
+
+	client := f.newClient(source.Owner, source.Repo)
+	release, err := f.fetchRelease(ctx, client, version)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]builder.ReleaseFile, 0, len(release.Assets))
+	for _, a := range release.Assets {
+		files = append(files, builder.ReleaseFile{Name: a.Name, URL: a.BrowserDownloadURL})
+	}
+
+	asset, checksum := builder.SelectReleaseAsset(files, source.AssetName, module.BinaryName(), f.goos, f.goarch)
+	if asset == nil {
+		f.logger.Debug("release has no asset for platform",
+			"release", release.TagName,
+			"os", f.goos,
+			"arch", f.goarch,
+		)
+		return nil, builder.ErrNoReleaseAsset
+	}
+
+	result := &builder.ReleaseAsset{
+		Version: release.TagName,
+		Name:    asset.Name,
+		URL:     asset.URL,
+	}
+	if checksum != nil {
+		result.ChecksumURL = checksum.URL
+	}
+	return result, nil
+}
+
+// fetchRelease looks up the release for version, trying with and without
+// a "v" prefix. A missing release is reported as builder.ErrNoReleaseAsset.
+func (f *GitHubReleaseFinder) fetchRelease(ctx context.Context, client releaseFetcher, version string) (*github.GitHubRelease, error) {
+	tags := []string{version}
+	if strings.HasPrefix(version, "v") {
+		tags = append(tags, strings.TrimPrefix(version, "v"))
+	} else {
+		tags = append(tags, "v"+version)
+	}
+
+	for _, tag := range tags {
+		release, err := client.FetchReleaseByTag(ctx, tag)
+		if err == nil {
+			return release, nil
+		}
+		var notFound *github.NotFoundError
+		if !errors.As(err, &notFound) {
+			return nil, err
+		}
+	}
+	return nil, builder.ErrNoReleaseAsset
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/rpcproxy"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// proxyResolver resolves the nodes of the RPC proxy from the store, with
+// the host ports GetNodePorts reports.
+type proxyResolver struct {
+	nodes *NodeService
+}
+
+// Resolve returns the host:port a node serves a service on. The node is
+// named by index ("0"), "node0", display name ("validator-0") or resource
+// name ("my-devnet-0").
+func (r proxyResolver) Resolve(ctx context.Context, namespace, devnetName, nodeName, service string) (string, error) {
+	devnet, err := r.nodes.store.GetDevnet(ctx, namespace, devnetName)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return "", fmt.Errorf("devnet %q: %w", devnetName, rpcproxy.ErrNotFound)
+		}
+		return "", err
+	}
+	nodes, err := r.nodes.store.ListNodes(ctx, namespace, devnetName)
+	if err != nil {
+		return "", err
+	}
+
+	var node *types.Node
+	for _, n := range nodes {
+		if proxyNodeMatches(n, nodeName) {
+			node = n
+			break
+		}
+	}
+	if node == nil {
+		return "", fmt.Errorf("node %q of devnet %q: %w", nodeName, devnetName, rpcproxy.ErrNotFound)
+	}
+
+	_, ports, evm := r.nodes.nodePorts(devnet, node)
+	var port int
+	switch service {
+	case rpcproxy.ServiceRPC:
+		port = ports.RPC
+	case rpcproxy.ServiceREST:
+		port = ports.API
+	case rpcproxy.ServiceGRPC:
+		port = ports.GRPC
+	case rpcproxy.ServiceEVMRPC:
+		if !evm {
+			return "", fmt.Errorf("node %q of devnet %q does not serve EVM JSON-RPC: %w", nodeName, devnetName, rpcproxy.ErrNotFound)
+		}
+		port = ports.EVMRPC
+	default:
+		return "", fmt.Errorf("service %q: %w", service, rpcproxy.ErrNotFound)
+	}
+
+	host := node.Spec.Address
+	if host == "" {
+		host = "127.0.0.1"
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port)), nil
+}
+
+// proxyNodeMatches reports whether a node goes by name in proxy routes.
+func proxyNodeMatches(node *types.Node, name string) bool {
+	index := strconv.Itoa(node.Spec.Index)
+	return name == index ||
+		name == "node"+index ||
+		strings.EqualFold(name, nodeDisplayName(node)) ||
+		name == node.Metadata.Name
+}
@@ -0,0 +1,139 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/rpcproxy"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
+)
+
+func TestProxyResolver(t *testing.T) {
+	s := store.NewMemoryStore()
+	ctx := context.Background()
+
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "proxied", Namespace: types.DefaultNamespace},
+		Spec:     types.DevnetSpec{Plugin: "stable", Mode: "local", Validators: 2},
+	}
+	if err := s.CreateDevnet(ctx, devnet); err != nil {
+		t.Fatalf("CreateDevnet: %v", err)
+	}
+	layout := dvbtypes.PortLayout{RPC: 36657, Stride: 10}
+	for i := 0; i < 2; i++ {
+		node := &types.Node{
+			Metadata: types.ResourceMeta{Name: fmt.Sprintf("proxied-%d", i), Namespace: types.DefaultNamespace},
+			Spec:     types.NodeSpec{DevnetRef: "proxied", Index: i, Role: "validator", PortLayout: layout},
+		}
+		if err := s.CreateNode(ctx, node); err != nil {
+			t.Fatalf("CreateNode: %v", err)
+		}
+	}
+
+	r := proxyResolver{nodes: NewNodeService(s, nil, nil)}
+
+	for _, name := range []string{"1", "node1", "validator-1", "Validator-1", "proxied-1"} {
+		addr, err := r.Resolve(ctx, types.DefaultNamespace, "proxied", name, rpcproxy.ServiceRPC)
+		if err != nil {
+			t.Fatalf("Resolve(%q): %v", name, err)
+		}
+		if want := fmt.Sprintf("127.0.0.1:%d", layout.ForNode(1).RPC); addr != want {
+			t.Errorf("Resolve(%q) = %q, want %q", name, addr, want)
+		}
+	}
+
+	notFound := []struct {
+		devnet, node, service string
+	}{
+		{"missing", "node0", rpcproxy.ServiceRPC},
+		{"proxied", "node7", rpcproxy.ServiceRPC},
+		{"proxied", "node0", rpcproxy.ServiceEVMRPC},
+	}
+	for _, tt := range notFound {
+		_, err := r.Resolve(ctx, types.DefaultNamespace, tt.devnet, tt.node, tt.service)
+		if !errors.Is(err, rpcproxy.ErrNotFound) {
+			t.Errorf("Resolve(%s/%s/%s) error = %v, want ErrNotFound", tt.devnet, tt.node, tt.service, err)
+		}
+	}
+}
+
+func TestCreateProxyListener_TLS(t *testing.T) {
+	certFile, keyFile := writeTestCertificate(t)
+	srv := &Server{config: &Config{ProxyListen: "127.0.0.1:0", TLSCert: certFile, TLSKey: keyFile}}
+
+	listener, err := srv.createProxyListener()
+	if err != nil {
+		t.Fatalf("createProxyListener: %v", err)
+	}
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		_ = conn.(*tls.Conn).Handshake()
+		conn.Close()
+	}()
+
+	// gRPC clients negotiate HTTP/2 over TLS
+	conn, err := tls.Dial("tcp", listener.Addr().String(), &tls.Config{
+		InsecureSkipVerify: true,
+		NextProtos:         []string{"h2"},
+	})
+	if err != nil {
+		t.Fatalf("TLS dial: %v", err)
+	}
+	defer conn.Close()
+	if proto := conn.ConnectionState().NegotiatedProtocol; proto != "h2" {
+		t.Errorf("expected h2 to be negotiated, got %q", proto)
+	}
+}
+
+// writeTestCertificate writes a self-signed certificate for 127.0.0.1 and
+// its key, returning their paths.
+func writeTestCertificate(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalECPrivateKey: %v", err)
+	}
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
@@ -4,34 +4,55 @@ package server
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"sync"
 	"syscall"
 	"time"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
 	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
 	"github.com/altuslabsxyz/devnet-builder/internal/auth"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/checker"
+	daemonconfig "github.com/altuslabsxyz/devnet-builder/internal/daemon/config"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/controller"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/credentials"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/notify"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/plugininstall"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/portalloc"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/provisioner"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/registries"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/retry"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/rpcproxy"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/server/ante"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/signer"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/subnet"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/transport"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/upgrader"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/snapshot"
+	"github.com/altuslabsxyz/devnet-builder/internal/plugin/builtin"
+	"github.com/altuslabsxyz/devnet-builder/internal/redact"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
 )
 
 // Config holds server configuration.
 type Config struct {
-	// SocketPath is the Unix socket path.
+	// SocketPath is the local socket address: a Unix socket path, a Windows
+	// named pipe or a loopback tcp:// address. See package transport.
 	SocketPath string
 	// DataDir is the data directory.
 	DataDir string
@@ -43,10 +64,21 @@ type Config struct {
 	LogLevel string
 	// RuntimeMode selects the node process runtime: "process" (default), "service", "docker".
 	RuntimeMode string
+	// Offline forces provisioning to use only local caches.
+	Offline bool
+	// StrictPluginResponses turns Error fields in plugin RPC responses into errors.
+	StrictPluginResponses bool
+
+	// EventRetention is how many events are kept per devnet (0 = default).
+	EventRetention int
 	// EnableDocker enables Docker container runtime for nodes.
 	EnableDocker bool
 	// DockerImage is the default Docker image for nodes.
 	DockerImage string
+	// RegistryMirrors rewrite docker images to a registry mirror, and
+	// RegistryCredentials authenticate their pulls.
+	RegistryMirrors     []registries.Mirror
+	RegistryCredentials []registries.Credentials
 	// ShutdownTimeout is the graceful shutdown timeout.
 	ShutdownTimeout time.Duration
 	// HealthCheckTimeout is the RPC health check timeout.
@@ -68,6 +100,59 @@ type Config struct {
 	AuthEnabled bool
 	// AuthKeysFile is the path to the API keys file.
 	AuthKeysFile string
+
+	// API access settings
+	// Reflection enables gRPC server reflection.
+	Reflection bool
+	// GatewayListen is the HTTP address of the REST/JSON gateway.
+	// Empty disables the gateway.
+	GatewayListen string
+
+	// SnapshotServeListen is the HTTP address the snapshot cache is shared
+	// on. Empty disables sharing.
+	SnapshotServeListen string
+
+	// ProxyListen is the HTTP address the RPC proxy serves every node's
+	// endpoints on. Empty disables the proxy. ProxyUsername and
+	// ProxyPassword, if set, are required as basic auth; ProxyLogRequests
+	// logs every proxied request.
+	ProxyListen      string
+	ProxyUsername    string
+	ProxyPassword    string
+	ProxyLogRequests bool
+
+	// PortConflict is "fail" to fail docker runtime nodes whose host ports
+	// are in use instead of moving them to a free port block.
+	PortConflict string
+
+	// Retry holds the retry policies of the provisioning steps.
+	Retry retry.Policies
+
+	// StopNodesOnShutdown stops running devnets' nodes when the daemon
+	// shuts down instead of leaving them running to reconnect to.
+	StopNodesOnShutdown bool
+
+	// Webhooks are notified of every devnet's events, with WebhookRetry's
+	// retries. TTLWarning is how long before a TTL expires they are told.
+	Webhooks     []types.Webhook
+	WebhookRetry retry.Policy
+	TTLWarning   time.Duration
+
+	// PruneInterval is how often the nodes of running devnets older than
+	// PruneMinAge are pruned with PruneStrategy (0 = never).
+	PruneInterval time.Duration
+	PruneMinAge   time.Duration
+	PruneStrategy string
+
+	// NodeLogs is the rotation of process and service runtime node logs.
+	NodeLogs runtime.LogConfig
+
+	// LogOutput receives the log alongside daemon.log (nil = stdout).
+	LogOutput io.Writer
+	// IgnoreSignals leaves SIGINT and SIGTERM to the caller: Run then stops
+	// only when its context is cancelled. Used when the server is embedded
+	// in another command.
+	IgnoreSignals bool
 }
 
 // DefaultConfig returns default configuration.
@@ -75,7 +160,7 @@ func DefaultConfig() *Config {
 	home, _ := os.UserHomeDir()
 	dataDir := filepath.Join(home, ".devnet-builder")
 	return &Config{
-		SocketPath:         filepath.Join(dataDir, "devnetd.sock"),
+		SocketPath:         transport.DefaultAddress(dataDir),
 		DataDir:            dataDir,
 		Foreground:         false,
 		Workers:            2,
@@ -83,6 +168,59 @@ func DefaultConfig() *Config {
 		ShutdownTimeout:    30 * time.Second,
 		HealthCheckTimeout: 5 * time.Second,
 		GitHubToken:        "",
+		Retry:              retry.DefaultPolicies(),
+		WebhookRetry:       notify.DefaultPolicy,
+		TTLWarning:         notify.DefaultTTLWarning,
+	}
+}
+
+// ConfigFrom converts a loaded daemon config into a server config.
+func ConfigFrom(cfg *daemonconfig.Config) *Config {
+	return &Config{
+		SocketPath:            cfg.Server.Socket,
+		DataDir:               cfg.Server.DataDir,
+		Foreground:            cfg.Server.Foreground,
+		Workers:               cfg.Server.Workers,
+		LogLevel:              cfg.Server.LogLevel,
+		RuntimeMode:           cfg.Server.RuntimeMode,
+		Offline:               cfg.Server.Offline,
+		StrictPluginResponses: cfg.Server.StrictPluginResponses,
+		EventRetention:        cfg.Server.EventRetention,
+		EnableDocker:          cfg.Docker.Enabled,
+		DockerImage:           cfg.Docker.Image,
+		RegistryMirrors:       cfg.Docker.MirrorList(),
+		RegistryCredentials:   cfg.Docker.CredentialList(),
+		ShutdownTimeout:       cfg.Timeouts.Shutdown,
+		HealthCheckTimeout:    cfg.Timeouts.HealthCheck,
+		GitHubToken:           cfg.GitHub.Token,
+		Listen:                cfg.Server.Listen,
+		TLSCert:               cfg.Server.TLSCert,
+		TLSKey:                cfg.Server.TLSKey,
+		AuthEnabled:           cfg.Auth.Enabled,
+		AuthKeysFile:          cfg.Auth.KeysFile,
+		Reflection:            cfg.API.Reflection,
+		GatewayListen:         cfg.API.GatewayListen,
+		SnapshotServeListen:   cfg.Snapshot.ServeListen,
+		ProxyListen:           cfg.Proxy.Listen,
+		ProxyUsername:         cfg.Proxy.Username,
+		ProxyPassword:         cfg.Proxy.Password,
+		ProxyLogRequests:      cfg.Proxy.LogRequests,
+		PortConflict:          cfg.Network.PortConflict,
+		Retry:                 cfg.Retry.Policies(),
+		StopNodesOnShutdown:   cfg.Server.StopNodesOnShutdown,
+		Webhooks:              cfg.Notifications.WebhookList(),
+		WebhookRetry:          cfg.Notifications.Retry.Policy(),
+		TTLWarning:            cfg.Notifications.TTLWarning,
+		PruneInterval:         cfg.Pruning.Interval,
+		PruneMinAge:           cfg.Pruning.MinAge,
+		PruneStrategy:         cfg.Pruning.Strategy,
+		NodeLogs: runtime.LogConfig{
+			MaxSize:   int64(cfg.NodeLogs.MaxSizeMB) * 1024 * 1024,
+			MaxAge:    cfg.NodeLogs.MaxAge,
+			MaxFiles:  cfg.NodeLogs.MaxFiles,
+			Retention: cfg.NodeLogs.Retention,
+			Compress:  cfg.NodeLogs.Compress,
+		},
 	}
 }
 
@@ -92,12 +230,26 @@ type Server struct {
 	store           store.Store
 	manager         *controller.Manager
 	healthCtrl      *controller.HealthController
+	ttlCtrl         *controller.TTLController
+	idleCtrl        *controller.IdleController
+	pruneCtrl       *controller.PruneController
+	recoveryCtrl    *controller.RecoveryController
+	notifier        *notify.Notifier
+	devnetSvc       *DevnetService // Stops devnets on shutdown
 	pluginManager   *PluginManager
 	subnetAllocator *subnet.Allocator
 	nodeRuntime     runtime.NodeRuntime // Node runtime for process management
 	grpcServer      *grpc.Server
-	listener        net.Listener // Unix socket listener
-	tcpListener     net.Listener // TCP/TLS listener (optional)
+	listener        net.Listener     // Unix socket listener
+	tcpListener     net.Listener     // TCP/TLS listener (optional)
+	gatewayListener *pipeListener    // In-memory gRPC listener behind the gateway (optional)
+	gatewayConn     *grpc.ClientConn // Gateway connection to gatewayListener
+	gatewayHTTP     net.Listener     // Gateway HTTP(S) listener
+	gatewayServer   *http.Server     // REST/JSON gateway (optional)
+	snapshotHTTP    net.Listener     // Snapshot cache sharing listener
+	snapshotServer  *http.Server     // Snapshot cache sharing (optional)
+	proxyHTTP       net.Listener     // RPC proxy listener
+	proxyServer     *http.Server     // RPC proxy (optional)
 	logger          *slog.Logger
 	logFile         *os.File // Log file handle for cleanup
 
@@ -133,15 +285,30 @@ func New(config *Config) (*Server, error) {
 	}
 
 	// Write logs to both stdout and file
-	multiWriter := io.MultiWriter(os.Stdout, logFile)
-	logger := slog.New(slog.NewTextHandler(multiWriter, &slog.HandlerOptions{Level: level}))
+	logOutput := config.LogOutput
+	if logOutput == nil {
+		logOutput = os.Stdout
+	}
+	multiWriter := io.MultiWriter(logOutput, logFile)
+	logger := slog.New(redact.NewHandler(slog.NewTextHandler(multiWriter, &slog.HandlerOptions{Level: level})))
+
+	// The GitHub token stays in memory rather than in the environment, so
+	// plugins and node processes do not inherit it.
+	credentials.Default.SetConfigGitHubToken(config.GitHubToken)
+
+	// Register the built-in networks first: a plugin cannot replace them
+	if err := builtin.Register(); err != nil {
+		return nil, err
+	}
 
 	// Load network plugins from plugin directories
 	// Plugins are discovered from ~/.devnet-builder/plugins/ and registered
 	// with the global network registry so they can be queried via NetworkService
 	pluginMgr := NewPluginManager(PluginManagerConfig{
-		PluginDirs: []string{filepath.Join(config.DataDir, "plugins")},
-		Logger:     logger,
+		PluginDirs:      []string{filepath.Join(config.DataDir, "plugins")},
+		StrictResponses: config.StrictPluginResponses,
+		WorkDir:         filepath.Join(config.DataDir, "plugin-work"),
+		Logger:          logger,
 	})
 
 	result, err := pluginMgr.LoadAndRegister()
@@ -169,16 +336,26 @@ func New(config *Config) (*Server, error) {
 		pluginMgr.Close()
 		return nil, fmt.Errorf("failed to open state store: %w", err)
 	}
+	st.SetEventRetention(config.EventRetention)
 
-	// Initialize subnet allocator for loopback network aliasing
-	subnetAllocatorPath := filepath.Join(config.DataDir, "subnets.json")
-	subnetAlloc, err := subnet.LoadOrCreate(subnetAllocatorPath)
+	// Initialize subnet allocator for loopback network aliasing. Allocations
+	// live in the state store; subnets.json from older daemons is migrated.
+	subnetAlloc, err := subnet.Open(st, filepath.Join(config.DataDir, "subnets.json"))
 	if err != nil {
 		st.Close()
 		pluginMgr.Close()
 		return nil, fmt.Errorf("failed to initialize subnet allocator: %w", err)
 	}
-	logger.Info("subnet allocator initialized", "path", subnetAllocatorPath)
+	logger.Info("subnet allocator initialized", "allocations", len(subnetAlloc.ListAllocations()))
+
+	// Initialize host port allocator for docker runtime port bindings,
+	// migrating ports.json the same way
+	portAlloc, err := portalloc.Open(st, filepath.Join(config.DataDir, "ports.json"))
+	if err != nil {
+		st.Close()
+		pluginMgr.Close()
+		return nil, fmt.Errorf("failed to initialize port allocator: %w", err)
+	}
 
 	// Create controller manager
 	mgr := controller.NewManager()
@@ -186,6 +363,7 @@ func New(config *Config) (*Server, error) {
 
 	// Create orchestrator factory for full provisioning flow (build, fork, init)
 	orchFactory := NewOrchestratorFactory(config.DataDir, logger)
+	orchFactory.SetRetryPolicies(config.Retry)
 
 	// Create devnet provisioner with orchestrator factory and subnet allocator
 	// The factory enables full provisioning (build, fork, init) before creating Node resources
@@ -195,7 +373,11 @@ func New(config *Config) (*Server, error) {
 		Logger:              logger,
 		OrchestratorFactory: orchFactory,
 		SubnetAllocator:     subnetAlloc,
+		Offline:             config.Offline,
 	})
+	if config.Offline {
+		logger.Info("offline mode enabled: provisioning uses only local caches")
+	}
 
 	// Register controllers
 	devnetCtrl := controller.NewDevnetController(st, devnetProv)
@@ -203,6 +385,10 @@ func New(config *Config) (*Server, error) {
 	devnetCtrl.SetManager(mgr)
 	mgr.Register("devnets", devnetCtrl)
 
+	// Recover provisioning interrupted by the previous daemon stopping
+	recoveryCtrl := controller.NewRecoveryController(st, devnetProv, mgr, controller.DefaultMaxProvisionResumes)
+	recoveryCtrl.SetLogger(logger)
+
 	// Wire step progress reporter to broadcast provision logs to CLI clients
 	devnetProv.SetStepProgressReporterFactory(func(namespace, name string) ports.ProgressReporter {
 		return ports.ProgressFunc(func(step ports.StepProgress) {
@@ -236,17 +422,21 @@ func New(config *Config) (*Server, error) {
 	switch runtimeMode {
 	case "docker":
 		dockerRuntime, err := runtime.NewDockerRuntime(runtime.DockerConfig{
-			DefaultImage: config.DockerImage,
-			Logger:       logger,
+			DefaultImage:    config.DockerImage,
+			Logger:          logger,
+			PortAllocator:   portAlloc,
+			ReallocatePorts: config.PortConflict != "fail",
+			Registries:      registries.NewResolver(config.RegistryMirrors, config.RegistryCredentials),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create docker runtime: %w", err)
 		}
 		nodeRuntime = dockerRuntime
-		logger.Info("docker runtime enabled", "image", config.DockerImage)
+		logger.Info("docker runtime enabled", "image", config.DockerImage, "mirrors", len(config.RegistryMirrors))
 	case "service":
 		svcRuntime, err := runtime.NewServiceRuntime(runtime.ServiceRuntimeConfig{
 			DataDir:               config.DataDir,
+			LogConfig:             config.NodeLogs,
 			Logger:                logger,
 			PluginRuntimeProvider: orchFactory.AsPluginRuntimeProvider(),
 		})
@@ -258,20 +448,51 @@ func New(config *Config) (*Server, error) {
 	default: // "process"
 		nodeRuntime = runtime.NewProcessRuntime(runtime.ProcessRuntimeConfig{
 			DataDir:               config.DataDir,
+			LogConfig:             config.NodeLogs,
 			Logger:                logger,
 			PluginRuntimeProvider: orchFactory.AsPluginRuntimeProvider(),
 		})
 		logger.Info("process runtime enabled for local mode")
 	}
 
+	// Nodes of devnets spread across remote hosts run there over ssh
+	if runtimeMode != "docker" {
+		nodeRuntime = runtime.NewSSHRuntime(runtime.SSHRuntimeConfig{
+			Local:                 nodeRuntime,
+			PluginRuntimeProvider: orchFactory.AsPluginRuntimeProvider(),
+			Logger:                logger,
+		})
+	}
+
+	// Docker-mode devnets pull their image once and start every node from
+	// its digest
+	if pinner, ok := nodeRuntime.(runtime.ImagePinner); ok {
+		devnetProv.SetImagePinner(pinner)
+	}
+
 	nodeCtrl := controller.NewNodeController(st, nodeRuntime)
 	nodeCtrl.SetLogger(logger)
+	nodeCtrl.SetStartRetry(config.Retry.NodeStart)
+
+	// tmkms signers join their node's container in docker mode, and use
+	// the host network otherwise
+	signerConfig := signer.LauncherConfig{Logger: logger}
+	if runtimeMode == "docker" {
+		signerConfig.NodeContainer = runtime.NodeContainerName
+	}
+	signerLauncher, err := signer.NewLauncher(signerConfig)
+	if err != nil {
+		logger.Warn("tmkms signers unavailable", "error", err)
+	} else {
+		nodeCtrl.SetSignerLauncher(signerLauncher)
+	}
 	mgr.Register("nodes", nodeCtrl)
 
 	// Create health checker
 	healthChecker := checker.NewRPCHealthChecker(checker.Config{
 		Logger:  logger,
 		Timeout: config.HealthCheckTimeout,
+		EVM:     evmHealthProbe(st),
 	})
 
 	// Create and register health controller
@@ -280,6 +501,21 @@ func New(config *Config) (*Server, error) {
 	healthCtrl.SetLogger(logger)
 	mgr.Register("health", healthCtrl)
 
+	// Deploys devnets' bootstrap contracts and runs their postHealthy hooks
+	// once the health controller first sees all their nodes healthy
+	hookCtrl := controller.NewHookController(st, config.DataDir)
+	hookCtrl.SetLogger(logger)
+	hookCtrl.SetBootstrapConfig(bootstrapConfig)
+	mgr.Register("hooks", hookCtrl)
+
+	// Stops devnets' nodes in order when a devnet stops and, if configured,
+	// when the daemon shuts down
+	shutdownCtrl := controller.NewShutdownController(st, nodeRuntime, healthChecker, config.ShutdownTimeout)
+	shutdownCtrl.SetLogger(logger)
+	if signerLauncher != nil {
+		shutdownCtrl.SetSignerLauncher(signerLauncher)
+	}
+
 	// Create upgrade runtime
 	upgradeRuntime := upgrader.NewRuntime(st, upgrader.Config{
 		Logger: logger,
@@ -288,6 +524,9 @@ func New(config *Config) (*Server, error) {
 	// Create and register upgrade controller
 	upgradeCtrl := controller.NewUpgradeController(st, upgradeRuntime)
 	upgradeCtrl.SetLogger(logger)
+	upgradeCtrl.SetManager(mgr)
+	upgradeCtrl.SetReportDir(filepath.Join(config.DataDir, "reports", "upgrades"))
+	upgradeCtrl.SetDataDir(config.DataDir)
 	mgr.Register("upgrades", upgradeCtrl)
 
 	// Create and register transaction controller
@@ -296,9 +535,12 @@ func New(config *Config) (*Server, error) {
 	txCtrl.SetLogger(logger)
 	mgr.Register("transactions", txCtrl)
 
-	// Create gRPC server with optional auth interceptors for remote mode
+	// Create gRPC server. Every error it returns carries an apierror code;
+	// remote mode adds auth interceptors.
 	var grpcServer *grpc.Server
-	if config.Listen != "" && config.AuthEnabled {
+	// Gateway requests reach gRPC as remote calls, so they share the auth
+	// interceptors of the TCP listener.
+	if (config.Listen != "" || config.GatewayListen != "") && config.AuthEnabled {
 		// Load API key store for authentication.
 		// NOTE: Keys are loaded once at startup. After creating or revoking keys
 		// with `devnetd keys create/revoke`, the server must be restarted for
@@ -314,18 +556,22 @@ func New(config *Config) (*Server, error) {
 
 		// Create gRPC server with auth interceptors
 		grpcServer = grpc.NewServer(
-			grpc.ChainUnaryInterceptor(auth.NewAuthInterceptor(keyStore, IsLocalConnection)),
-			grpc.ChainStreamInterceptor(auth.NewStreamAuthInterceptor(keyStore, IsLocalConnection)),
+			grpc.ChainUnaryInterceptor(apierror.UnaryServerInterceptor(), auth.NewAuthInterceptor(keyStore, IsLocalConnection)),
+			grpc.ChainStreamInterceptor(apierror.StreamServerInterceptor(), auth.NewStreamAuthInterceptor(keyStore, IsLocalConnection)),
 		)
 		logger.Info("authentication enabled for remote connections")
 	} else {
-		grpcServer = grpc.NewServer()
+		grpcServer = grpc.NewServer(
+			grpc.ChainUnaryInterceptor(apierror.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(apierror.StreamServerInterceptor()),
+		)
 	}
 
 	// Create network service first (needed by ante handler)
 	githubFactory := NewDefaultGitHubClientFactory(config.DataDir, logger)
 	networkSvc := NewNetworkService(githubFactory)
 	networkSvc.SetLogger(logger)
+	networkSvc.SetPluginInstaller(plugininstall.New(filepath.Join(config.DataDir, "plugins"), logger), pluginMgr)
 
 	// Create ante handler for request validation
 	anteHandler := ante.New(st, networkSvc)
@@ -338,14 +584,65 @@ func New(config *Config) (*Server, error) {
 	// Register services
 	devnetSvc := NewDevnetServiceWithAnte(st, mgr, anteHandler, subnetAlloc, devnetProv)
 	devnetSvc.SetLogger(logger)
+	devnetSvc.SetPortAllocator(portAlloc)
+	devnetSvc.SetDebugSources(nodeRuntime, config.DataDir)
+	devnetSvc.SetShutdownController(shutdownCtrl)
 	v1.RegisterDevnetServiceServer(grpcServer, devnetSvc)
 
+	// Stop or delete devnets whose TTL has expired
+	ttlCtrl := controller.NewTTLController(st, devnetReaper{svc: devnetSvc}, controller.DefaultTTLCheckInterval)
+	ttlCtrl.SetLogger(logger)
+
+	// Stop devnets with an idle timeout once they see no traffic
+	activitySampler := checker.NewRPCActivitySampler(checker.Config{
+		Logger:  logger,
+		Timeout: config.HealthCheckTimeout,
+	})
+	idleCtrl := controller.NewIdleController(st, activitySampler, devnetReaper{svc: devnetSvc}, controller.DefaultIdleCheckInterval)
+	idleCtrl.SetLogger(logger)
+
+	// Prune long-lived devnets on schedule, if configured
+	var pruneCtrl *controller.PruneController
+	if config.PruneInterval > 0 {
+		pruneCtrl = controller.NewPruneController(st, devnetPruner{svc: devnetSvc, strategy: config.PruneStrategy},
+			config.PruneInterval, config.PruneMinAge)
+		pruneCtrl.SetLogger(logger)
+	}
+
+	// Send devnet events to the configured and the devnets' webhooks
+	notifier := notify.NewNotifier(st, config.Webhooks, config.WebhookRetry)
+	notifier.SetTTLWarning(config.TTLWarning)
+	notifier.SetLogger(logger)
+
 	nodeSvc := NewNodeServiceWithAnte(st, mgr, nodeRuntime, anteHandler, shutdownCtx)
 	nodeSvc.SetLogger(logger)
+	nodeSvc.SetPortAllocator(portAlloc)
 	v1.RegisterNodeServiceServer(grpcServer, nodeSvc)
 
+	// Serve every node's endpoints behind one address, if configured. gRPC
+	// is proxied over HTTP/2, with or without TLS.
+	var proxyServer *http.Server
+	if config.ProxyListen != "" {
+		protocols := new(http.Protocols)
+		protocols.SetHTTP1(true)
+		protocols.SetHTTP2(true)
+		protocols.SetUnencryptedHTTP2(true)
+		proxyServer = &http.Server{
+			Handler: rpcproxy.New(rpcproxy.Config{
+				Resolver:    proxyResolver{nodes: nodeSvc},
+				Username:    config.ProxyUsername,
+				Password:    config.ProxyPassword,
+				LogRequests: config.ProxyLogRequests,
+				Logger:      logger,
+			}),
+			Protocols:         protocols,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+	}
+
 	upgradeSvc := NewUpgradeServiceWithAnte(st, mgr, anteHandler)
 	upgradeSvc.SetLogger(logger)
+	upgradeSvc.SetReportDir(filepath.Join(config.DataDir, "reports", "upgrades"))
 	v1.RegisterUpgradeServiceServer(grpcServer, upgradeSvc)
 
 	txSvc := NewTransactionService(st, mgr)
@@ -354,19 +651,44 @@ func New(config *Config) (*Server, error) {
 
 	v1.RegisterNetworkServiceServer(grpcServer, networkSvc)
 
+	// Register build service for on-demand binary and image builds
+	binaryBuilder := builder.NewDefaultBuilder(config.DataDir, orchFactory, logger)
+	binaryBuilder.SetReleaseFinder(NewGitHubReleaseFinder(logger))
+	nodeSvc.SetBinaryBuilder(binaryBuilder)
+	devnetSvc.SetBinaryBuilder(binaryBuilder)
+	buildSvc := NewBuildService(binaryBuilder, builder.NewImageBuilder(logger))
+	buildSvc.SetLogger(logger)
+	v1.RegisterBuildServiceServer(grpcServer, buildSvc)
+
+	namespaceSvc := NewNamespaceService(st)
+	namespaceSvc.SetLogger(logger)
+	v1.RegisterNamespaceServiceServer(grpcServer, namespaceSvc)
+
 	// Register auth service for ping/whoami
 	authSvc := NewAuthService()
 	v1.RegisterAuthServiceServer(grpcServer, authSvc)
 
+	if config.Reflection {
+		reflection.Register(grpcServer)
+		logger.Info("gRPC reflection enabled")
+	}
+
 	return &Server{
 		config:          config,
 		store:           st,
 		manager:         mgr,
 		healthCtrl:      healthCtrl,
+		ttlCtrl:         ttlCtrl,
+		idleCtrl:        idleCtrl,
+		pruneCtrl:       pruneCtrl,
+		recoveryCtrl:    recoveryCtrl,
+		notifier:        notifier,
+		devnetSvc:       devnetSvc,
 		pluginManager:   pluginMgr,
 		subnetAllocator: subnetAlloc,
 		nodeRuntime:     nodeRuntime,
 		grpcServer:      grpcServer,
+		proxyServer:     proxyServer,
 		logger:          logger,
 		logFile:         logFile,
 		shutdownCtx:     shutdownCtx,
@@ -376,13 +698,11 @@ func New(config *Config) (*Server, error) {
 
 // Run starts the server and blocks until shutdown.
 func (s *Server) Run(ctx context.Context) error {
-	// Remove stale socket
-	os.Remove(s.config.SocketPath)
-
-	// Create Unix socket listener (always available for local access)
-	listener, err := net.Listen("unix", s.config.SocketPath)
+	// Create the local socket listener (always available for local access):
+	// a Unix socket, a Windows named pipe or a loopback TCP port
+	listener, err := transport.Listen(s.config.SocketPath)
 	if err != nil {
-		return fmt.Errorf("failed to listen on unix socket: %w", err)
+		return fmt.Errorf("failed to listen on %s socket: %w", transport.Network(s.config.SocketPath), err)
 	}
 	s.listener = listener
 
@@ -396,6 +716,56 @@ func (s *Server) Run(ctx context.Context) error {
 		s.tcpListener = tcpListener
 	}
 
+	// Create REST/JSON gateway if configured
+	if s.config.GatewayListen != "" {
+		if err := s.createGateway(); err != nil {
+			s.listener.Close()
+			if s.tcpListener != nil {
+				s.tcpListener.Close()
+			}
+			return fmt.Errorf("failed to create gateway: %w", err)
+		}
+	}
+
+	// Share the snapshot cache if configured
+	if s.config.SnapshotServeListen != "" {
+		snapshotListener, err := net.Listen("tcp", s.config.SnapshotServeListen)
+		if err != nil {
+			s.listener.Close()
+			if s.tcpListener != nil {
+				s.tcpListener.Close()
+			}
+			if s.gatewayHTTP != nil {
+				s.gatewayHTTP.Close()
+			}
+			return fmt.Errorf("failed to listen on %s for snapshot sharing: %w", s.config.SnapshotServeListen, err)
+		}
+		s.snapshotHTTP = snapshotListener
+		s.snapshotServer = &http.Server{
+			Handler:           snapshot.NewCacheHandler(s.config.DataDir),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+	}
+
+	// Listen for the RPC proxy if configured
+	if s.proxyServer != nil {
+		proxyListener, err := s.createProxyListener()
+		if err != nil {
+			s.listener.Close()
+			if s.tcpListener != nil {
+				s.tcpListener.Close()
+			}
+			if s.gatewayHTTP != nil {
+				s.gatewayHTTP.Close()
+			}
+			if s.snapshotHTTP != nil {
+				s.snapshotHTTP.Close()
+			}
+			return fmt.Errorf("failed to create RPC proxy listener: %w", err)
+		}
+		s.proxyHTTP = proxyListener
+	}
+
 	// Write PID file
 	pidPath := filepath.Join(s.config.DataDir, "devnetd.pid")
 	if err := os.WriteFile(pidPath, []byte(fmt.Sprintf("%d", os.Getpid())), 0644); err != nil {
@@ -412,6 +782,15 @@ func (s *Server) Run(ctx context.Context) error {
 	if s.config.Listen != "" {
 		logAttrs = append(logAttrs, "listen", s.config.Listen)
 	}
+	if s.config.GatewayListen != "" {
+		logAttrs = append(logAttrs, "gateway", s.config.GatewayListen)
+	}
+	if s.config.SnapshotServeListen != "" {
+		logAttrs = append(logAttrs, "snapshotServe", s.config.SnapshotServeListen)
+	}
+	if s.config.ProxyListen != "" {
+		logAttrs = append(logAttrs, "proxy", s.config.ProxyListen)
+	}
 	s.logger.Info("devnetd started", logAttrs...)
 
 	// Create cancellable context
@@ -424,18 +803,40 @@ func (s *Server) Run(ctx context.Context) error {
 		// Continue anyway - failed nodes will be restarted by controllers
 	}
 
+	// Watch for events before recovery changes any devnet. The notifier
+	// outlives ctx: Shutdown stops it once the nodes it reports on stopped.
+	s.notifier.Start(context.WithoutCancel(ctx))
+
+	// Resume, or fail and clean up, provisioning the previous session was
+	// in the middle of
+	s.recoveryCtrl.Recover(ctx)
+
 	// Start controller manager in background
 	go s.manager.Start(ctx, s.config.Workers)
 
 	// Start health controller's periodic health check loop
 	s.healthCtrl.Start(ctx)
 
+	// Start TTL controller's periodic expiry sweep
+	s.ttlCtrl.Start(ctx)
+
+	// Start idle controller's periodic activity sweep
+	s.idleCtrl.Start(ctx)
+
+	// Start scheduled pruning
+	if s.pruneCtrl != nil {
+		s.pruneCtrl.Start(ctx)
+	}
+
 	// Handle shutdown signals
 	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	if !s.config.IgnoreSignals {
+		signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+		defer signal.Stop(sigCh)
+	}
 
 	// Start gRPC server on Unix socket in background
-	errCh := make(chan error, 2) // Buffer for both listeners
+	errCh := make(chan error, 5) // Buffer for all listeners
 	go func() {
 		errCh <- s.grpcServer.Serve(listener)
 	}()
@@ -447,6 +848,36 @@ func (s *Server) Run(ctx context.Context) error {
 		}()
 	}
 
+	// Start the gateway and the in-memory gRPC listener behind it
+	if s.gatewayServer != nil {
+		go func() {
+			errCh <- s.grpcServer.Serve(s.gatewayListener)
+		}()
+		go func() {
+			if err := s.gatewayServer.Serve(s.gatewayHTTP); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				errCh <- fmt.Errorf("gateway: %w", err)
+			}
+		}()
+	}
+
+	// Start sharing the snapshot cache
+	if s.snapshotServer != nil {
+		go func() {
+			if err := s.snapshotServer.Serve(s.snapshotHTTP); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				errCh <- fmt.Errorf("snapshot server: %w", err)
+			}
+		}()
+	}
+
+	// Start the RPC proxy
+	if s.proxyServer != nil {
+		go func() {
+			if err := s.proxyServer.Serve(s.proxyHTTP); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				errCh <- fmt.Errorf("rpc proxy: %w", err)
+			}
+		}()
+	}
+
 	// Wait for shutdown
 	select {
 	case <-ctx.Done():
@@ -470,7 +901,8 @@ func (s *Server) Run(ctx context.Context) error {
 }
 
 // Shutdown gracefully shuts down the server.
-// Detaches from running processes so they continue as orphans.
+// Detaches from running processes so they continue as orphans, unless
+// StopNodesOnShutdown stops them first.
 func (s *Server) Shutdown() error {
 	s.logger.Info("shutting down")
 
@@ -479,6 +911,21 @@ func (s *Server) Shutdown() error {
 		s.healthCtrl.Stop()
 	}
 
+	// Stop TTL controller
+	if s.ttlCtrl != nil {
+		s.ttlCtrl.Stop()
+	}
+
+	// Stop idle controller
+	if s.idleCtrl != nil {
+		s.idleCtrl.Stop()
+	}
+
+	// Stop scheduled pruning
+	if s.pruneCtrl != nil {
+		s.pruneCtrl.Stop()
+	}
+
 	// Stop controller manager and wait for all workers to complete.
 	// This MUST happen before closing the store to prevent "database not open" errors.
 	// Use a timeout to prevent hanging if workers are blocked on external processes
@@ -493,8 +940,19 @@ func (s *Server) Shutdown() error {
 		}
 	}
 
-	// Handle runtime-specific shutdown behavior
-	switch rt := s.nodeRuntime.(type) {
+	// Stop the nodes before detaching from the rest
+	if s.config.StopNodesOnShutdown && s.devnetSvc != nil {
+		s.stopRunningDevnets()
+	}
+
+	// Stop notifications, after the events of the stopped nodes
+	if s.notifier != nil {
+		s.notifier.Stop()
+	}
+
+	// Handle runtime-specific shutdown behavior. Remote nodes keep running
+	// on their hosts.
+	switch rt := localRuntime(s.nodeRuntime).(type) {
 	case *runtime.ProcessRuntime:
 		// Detach from running processes - they will continue as orphans
 		// and can be reconnected on next startup
@@ -515,11 +973,38 @@ func (s *Server) Shutdown() error {
 		s.shutdownCancel()
 	}
 
+	// Stop accepting gateway requests before stopping gRPC
+	if s.gatewayServer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := s.gatewayServer.Shutdown(ctx); err != nil {
+			s.logger.Warn("gateway shutdown failed", "error", err)
+		}
+		cancel()
+	}
+	if s.snapshotServer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := s.snapshotServer.Shutdown(ctx); err != nil {
+			s.logger.Warn("snapshot server shutdown failed", "error", err)
+		}
+		cancel()
+	}
+	if s.proxyServer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := s.proxyServer.Shutdown(ctx); err != nil {
+			s.logger.Warn("rpc proxy shutdown failed", "error", err)
+		}
+		cancel()
+	}
+
 	// Graceful gRPC shutdown
 	if s.grpcServer != nil {
 		s.grpcServer.GracefulStop()
 	}
 
+	if s.gatewayConn != nil {
+		s.gatewayConn.Close()
+	}
+
 	// Close listeners
 	if s.listener != nil {
 		s.listener.Close()
@@ -544,22 +1029,73 @@ func (s *Server) Shutdown() error {
 	}
 
 	// Clean up socket
-	os.Remove(s.config.SocketPath)
+	transport.Cleanup(s.config.SocketPath)
 
 	s.logger.Info("devnetd stopped")
 	return nil
 }
 
+// stopRunningDevnets stops the nodes of every devnet that has any running,
+// the devnets in parallel so the whole shutdown stays within
+// ShutdownTimeout.
+func (s *Server) stopRunningDevnets() {
+	ctx := context.Background()
+	devnets, err := s.store.ListDevnets(ctx, "")
+	if err != nil {
+		s.logger.Warn("failed to list devnets to stop", "error", err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, devnet := range devnets {
+		switch devnet.Status.Phase {
+		case types.PhaseRunning, types.PhaseDegraded:
+		default:
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.logger.Info("stopping devnet nodes", "namespace", devnet.Metadata.Namespace, "name", devnet.Metadata.Name)
+			resp, err := s.devnetSvc.StopDevnet(ctx, &v1.StopDevnetRequest{
+				Namespace: devnet.Metadata.Namespace,
+				Name:      devnet.Metadata.Name,
+				Reason:    "daemon shutdown",
+			})
+			if err != nil {
+				s.logger.Warn("failed to stop devnet", "name", devnet.Metadata.Name, "error", err)
+				return
+			}
+			s.logger.Info("stopped devnet nodes", "name", devnet.Metadata.Name, "message", resp.Devnet.Status.Message)
+		}()
+	}
+	wg.Wait()
+}
+
 // reconnectExistingProcesses attempts to reconnect to orphaned node processes
 // from a previous daemon session. This is called at startup.
 func (s *Server) reconnectExistingProcesses(ctx context.Context) error {
+	// Remote nodes are checked on their hosts from now on
+	rt := s.nodeRuntime
+	if sshRuntime, ok := rt.(*runtime.SSHRuntime); ok {
+		allNodes, err := s.collectAllNodes(ctx)
+		if err != nil {
+			return err
+		}
+		if adopted := sshRuntime.Adopt(allNodes); adopted > 0 {
+			s.logger.Info("adopted remote nodes", "count", adopted)
+		}
+		rt = sshRuntime.Local()
+	}
+
 	// Determine reconnection strategy based on runtime type
-	switch rt := s.nodeRuntime.(type) {
+	switch rt := rt.(type) {
 	case *runtime.ProcessRuntime:
 		allNodes, err := s.collectAllNodes(ctx)
 		if err != nil {
 			return err
 		}
+		allNodes = localNodes(allNodes)
 		if len(allNodes) == 0 {
 			s.logger.Debug("no nodes to reconnect")
 			return nil
@@ -579,6 +1115,7 @@ func (s *Server) reconnectExistingProcesses(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		allNodes = localNodes(allNodes)
 		if len(allNodes) == 0 {
 			s.logger.Debug("no nodes to discover")
 			return nil
@@ -599,6 +1136,25 @@ func (s *Server) reconnectExistingProcesses(ctx context.Context) error {
 	}
 }
 
+// localRuntime returns the runtime of the nodes on the daemon's host.
+func localRuntime(rt runtime.NodeRuntime) runtime.NodeRuntime {
+	if sshRuntime, ok := rt.(*runtime.SSHRuntime); ok {
+		return sshRuntime.Local()
+	}
+	return rt
+}
+
+// localNodes returns the nodes that run on the daemon's host.
+func localNodes(nodes []*types.Node) []*types.Node {
+	var local []*types.Node
+	for _, node := range nodes {
+		if node.Spec.Host == nil {
+			local = append(local, node)
+		}
+	}
+	return local
+}
+
 // collectAllNodes gathers all nodes from all devnets in the store.
 func (s *Server) collectAllNodes(ctx context.Context) ([]*types.Node, error) {
 	devnets, err := s.store.ListDevnets(ctx, "")
@@ -626,15 +1182,9 @@ func (s *Server) collectAllNodes(ctx context.Context) ([]*types.Node, error) {
 // the server must be restarted. For production deployments requiring zero-downtime
 // rotation, consider using tls.Config.GetCertificate callback or a reverse proxy.
 func (s *Server) createTLSListener() (net.Listener, error) {
-	// Load TLS certificate and key
-	cert, err := tls.LoadX509KeyPair(s.config.TLSCert, s.config.TLSKey)
+	tlsConfig, err := s.tlsConfig()
 	if err != nil {
-		return nil, fmt.Errorf("failed to load TLS credentials: %w", err)
-	}
-
-	tlsConfig := &tls.Config{
-		Certificates: []tls.Certificate{cert},
-		MinVersion:   tls.VersionTLS12,
+		return nil, err
 	}
 
 	// Create TCP listener with TLS
@@ -646,3 +1196,89 @@ func (s *Server) createTLSListener() (net.Listener, error) {
 	s.logger.Info("TCP/TLS listener started", "address", s.config.Listen)
 	return listener, nil
 }
+
+// tlsConfig loads the configured TLS certificate and key.
+func (s *Server) tlsConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(s.config.TLSCert, s.config.TLSKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS credentials: %w", err)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// createProxyListener listens for the RPC proxy. Like the gateway, the
+// proxy serves HTTPS when a TLS certificate is configured, negotiating
+// HTTP/2 for gRPC.
+func (s *Server) createProxyListener() (net.Listener, error) {
+	var tlsConfig *tls.Config
+	if s.config.TLSCert != "" && s.config.TLSKey != "" {
+		var err error
+		if tlsConfig, err = s.tlsConfig(); err != nil {
+			return nil, err
+		}
+		tlsConfig.NextProtos = []string{"h2", "http/1.1"}
+	}
+
+	listener, err := net.Listen("tcp", s.config.ProxyListen)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", s.config.ProxyListen, err)
+	}
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+	return listener, nil
+}
+
+// createGateway creates the REST/JSON gateway. The gateway reaches the gRPC
+// server over an in-memory listener, and serves HTTPS when a TLS certificate
+// is configured.
+func (s *Server) createGateway() error {
+	s.gatewayListener = newPipeListener()
+	conn, err := grpc.NewClient("passthrough:///devnetd-gateway",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return s.gatewayListener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to connect gateway: %w", err)
+	}
+
+	gateway, err := NewGateway(conn, s.grpcServer.GetServiceInfo())
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	gateway.SetLogger(s.logger)
+
+	listener, err := net.Listen("tcp", s.config.GatewayListen)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to listen on %s: %w", s.config.GatewayListen, err)
+	}
+	scheme := "http"
+	if s.config.TLSCert != "" && s.config.TLSKey != "" {
+		tlsConfig, err := s.tlsConfig()
+		if err != nil {
+			conn.Close()
+			listener.Close()
+			return err
+		}
+		listener = tls.NewListener(listener, tlsConfig)
+		scheme = "https"
+	}
+
+	s.gatewayConn = conn
+	s.gatewayHTTP = listener
+	s.gatewayServer = &http.Server{
+		Handler:           gateway,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	s.logger.Info("REST/JSON gateway started",
+		"address", s.config.GatewayListen,
+		"openapi", fmt.Sprintf("%s://%s/openapi.json", scheme, s.config.GatewayListen))
+	return nil
+}
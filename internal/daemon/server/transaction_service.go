@@ -4,14 +4,18 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/controller"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/gov"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	pkgNetwork "github.com/altuslabsxyz/devnet-builder/pkg/network"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -198,24 +202,74 @@ func (s *TransactionService) SubmitGovVote(ctx context.Context, req *v1.SubmitGo
 	return &v1.SubmitGovVoteResponse{Transaction: resp.Transaction}, nil
 }
 
-// SubmitGovProposal submits a governance proposal transaction.
+// govProposalFile is a proposal file in the format of `<chaind> tx gov
+// submit-proposal`.
+type govProposalFile struct {
+	Messages  []json.RawMessage `json:"messages"`
+	Metadata  string            `json:"metadata"`
+	Deposit   string            `json:"deposit"`
+	Title     string            `json:"title"`
+	Summary   string            `json:"summary"`
+	Expedited bool              `json:"expedited"`
+}
+
+// parseGovProposalFile reports whether content is a proposal file rather
+// than type-specific content.
+func parseGovProposalFile(content []byte) (*govProposalFile, bool) {
+	if len(content) == 0 {
+		return nil, false
+	}
+	var f govProposalFile
+	if err := json.Unmarshal(content, &f); err != nil {
+		return nil, false
+	}
+	if len(f.Messages) == 0 && f.Title == "" {
+		return nil, false
+	}
+	return &f, true
+}
+
+// SubmitGovProposal submits a governance proposal transaction. The content
+// may be a submit-proposal JSON file, whose messages, deposit and text are
+// used unless the request sets them.
 func (s *TransactionService) SubmitGovProposal(ctx context.Context, req *v1.SubmitGovProposalRequest) (*v1.SubmitGovProposalResponse, error) {
 	if req.Devnet == "" {
 		return nil, status.Error(codes.InvalidArgument, "devnet is required")
 	}
-	if req.Title == "" {
-		return nil, status.Error(codes.InvalidArgument, "title is required")
-	}
 	if req.Proposer == "" {
 		return nil, status.Error(codes.InvalidArgument, "proposer is required")
 	}
 
-	payload, _ := json.Marshal(map[string]interface{}{
+	fields := map[string]interface{}{
 		"type":        req.ProposalType,
 		"title":       req.Title,
 		"description": req.Description,
-		"content":     req.Content,
-	})
+	}
+	deposit := req.Deposit
+	if file, ok := parseGovProposalFile(req.Content); ok {
+		if req.Title == "" {
+			fields["title"] = file.Title
+		}
+		if deposit == "" {
+			deposit = file.Deposit
+		}
+		fields["summary"] = file.Summary
+		fields["metadata"] = file.Metadata
+		fields["expedited"] = file.Expedited
+		if len(file.Messages) > 0 {
+			fields["messages"] = file.Messages
+		}
+	} else {
+		fields["content"] = req.Content
+	}
+	if fields["title"] == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if deposit != "" {
+		fields["deposit"] = deposit
+	}
+
+	payload, _ := json.Marshal(fields)
 
 	resp, err := s.SubmitTransaction(ctx, &v1.SubmitTransactionRequest{
 		Devnet:  req.Devnet,
@@ -229,6 +283,157 @@ func (s *TransactionService) SubmitGovProposal(ctx context.Context, req *v1.Subm
 	return &v1.SubmitGovProposalResponse{Transaction: resp.Transaction}, nil
 }
 
+// GetGovProposal queries a governance proposal through a running node of
+// the devnet.
+func (s *TransactionService) GetGovProposal(ctx context.Context, req *v1.GetGovProposalRequest) (*v1.GetGovProposalResponse, error) {
+	if req.Devnet == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet is required")
+	}
+
+	querier, _, err := s.govQuerier(ctx, req.GetNamespace(), req.Devnet, "proposal queries")
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := querier.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.Is(err, gov.ErrNotFound) {
+			if req.ProposalId == 0 {
+				return nil, status.Errorf(codes.NotFound, "devnet %q has no proposals", req.Devnet)
+			}
+			return nil, status.Errorf(codes.NotFound, "proposal %d not found", req.ProposalId)
+		}
+		return nil, status.Errorf(codes.Unavailable, "%v", err)
+	}
+
+	return &v1.GetGovProposalResponse{Proposal: govProposalToProto(p)}, nil
+}
+
+// BuildParamChangeProposal builds a proposal that sets one module param from
+// the devnet's current params, for SubmitGovProposal.
+func (s *TransactionService) BuildParamChangeProposal(ctx context.Context, req *v1.BuildParamChangeProposalRequest) (*v1.BuildParamChangeProposalResponse, error) {
+	if req.Devnet == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet is required")
+	}
+	if req.Module == "" || req.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "module and key are required")
+	}
+
+	querier, devnet, err := s.govQuerier(ctx, req.GetNamespace(), req.Devnet, "param changes")
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := querier.BuildParamChange(ctx, req.Module, paramsLayout(devnet.Spec.Plugin, req.Module), req.Key, req.Value)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return &v1.BuildParamChangeProposalResponse{
+		Proposal:  change.Proposal,
+		OldValue:  string(change.OldValue),
+		NewValue:  string(change.NewValue),
+		Expedited: change.Expedited,
+	}, nil
+}
+
+// GetModuleParams returns the current params of a module.
+func (s *TransactionService) GetModuleParams(ctx context.Context, req *v1.GetModuleParamsRequest) (*v1.GetModuleParamsResponse, error) {
+	if req.Devnet == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet is required")
+	}
+	if req.Module == "" {
+		return nil, status.Error(codes.InvalidArgument, "module is required")
+	}
+
+	querier, devnet, err := s.govQuerier(ctx, req.GetNamespace(), req.Devnet, "params queries")
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := querier.Params(ctx, paramsLayout(devnet.Spec.Plugin, req.Module))
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "%s: %v", req.Module, err)
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode params: %v", err)
+	}
+	return &v1.GetModuleParamsResponse{Params: data}, nil
+}
+
+// govQuerier returns a querier for the first running node of a running
+// devnet. What names the operation in the error for a stopped devnet.
+func (s *TransactionService) govQuerier(ctx context.Context, namespace, name, what string) (*gov.Querier, *types.Devnet, error) {
+	devnet, err := s.store.GetDevnet(ctx, namespace, name)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, nil, status.Errorf(codes.NotFound, "devnet %q not found", name)
+		}
+		return nil, nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+	if devnet.Status.Phase != types.PhaseRunning && devnet.Status.Phase != types.PhaseDegraded {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "devnet %q is %s; %s require a running devnet%s",
+			name, devnet.Status.Phase, what, resumeHint(name, devnet.Status.Phase))
+	}
+
+	nodes, err := s.store.ListNodes(ctx, devnet.Metadata.Namespace, name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to list nodes: %v", err)
+	}
+	var node *types.Node
+	for _, n := range nodes {
+		if n.Status.Phase == types.NodePhaseRunning {
+			node = n
+			break
+		}
+	}
+	if node == nil {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "devnet %q has no running nodes", name)
+	}
+
+	host := node.Spec.Address
+	if host == "" {
+		host = "127.0.0.1"
+	}
+	querier := gov.NewQuerier(gov.Config{
+		RESTEndpoint: node.Spec.Ports().APIURL(host),
+	})
+	return querier, devnet, nil
+}
+
+// paramsLayout returns the params layout of a chain module, as overridden
+// by the devnet's network plugin or else the Cosmos SDK default.
+func paramsLayout(pluginName, module string) pkgNetwork.ParamsLayout {
+	if m, err := network.Get(pluginName); err == nil {
+		if provider, ok := m.(pkgNetwork.ParamsLayoutProvider); ok {
+			if layout, ok := provider.ParamsLayout(module); ok {
+				return layout
+			}
+		}
+	}
+	return gov.DefaultParamsLayout(module)
+}
+
+func govProposalToProto(p *gov.Proposal) *v1.GovProposal {
+	pb := &v1.GovProposal{
+		Id:           p.ID,
+		Status:       p.Status,
+		Title:        p.Title,
+		Summary:      p.Summary,
+		Proposer:     p.Proposer,
+		FailedReason: p.FailedReason,
+		Yes:          p.Tally.Yes,
+		No:           p.Tally.No,
+		Abstain:      p.Tally.Abstain,
+		NoWithVeto:   p.Tally.NoWithVeto,
+	}
+	if !p.VotingEndTime.IsZero() {
+		pb.VotingEndTime = timestamppb.New(p.VotingEndTime)
+	}
+	return pb
+}
+
 // transactionToProto converts a Transaction to its proto representation.
 func transactionToProto(tx *types.Transaction) *v1.Transaction {
 	return &v1.Transaction{
@@ -3,12 +3,16 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestTransactionService_SubmitTransaction(t *testing.T) {
@@ -103,3 +107,108 @@ func TestTransactionService_SubmitGovVote(t *testing.T) {
 		t.Errorf("TxType = %q, want %q", resp.Transaction.TxType, "gov/vote")
 	}
 }
+
+func TestTransactionService_SubmitGovProposal_File(t *testing.T) {
+	ms := store.NewMemoryStore()
+	svc := NewTransactionService(ms, nil)
+
+	file := []byte(`{
+		"messages": [{"@type": "/cosmos.distribution.v1beta1.MsgCommunityPoolSpend", "recipient": "cosmos1r"}],
+		"deposit": "1000stake",
+		"title": "Fund the faucet",
+		"summary": "Spend from the community pool"
+	}`)
+	resp, err := svc.SubmitGovProposal(context.Background(), &v1.SubmitGovProposalRequest{
+		Devnet:   "mydevnet",
+		Proposer: "validator:0",
+		Content:  file,
+		Deposit:  "5000stake",
+	})
+	if err != nil {
+		t.Fatalf("SubmitGovProposal: %v", err)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(resp.Transaction.Payload, &payload); err != nil {
+		t.Fatalf("payload: %v", err)
+	}
+	if payload["title"] != "Fund the faucet" {
+		t.Errorf("title = %v, want the file's title", payload["title"])
+	}
+	if payload["deposit"] != "5000stake" {
+		t.Errorf("deposit = %v, want the request's deposit over the file's", payload["deposit"])
+	}
+	if msgs, _ := payload["messages"].([]interface{}); len(msgs) != 1 {
+		t.Errorf("messages = %v, want the file's message", payload["messages"])
+	}
+	if _, ok := payload["content"]; ok {
+		t.Error("a proposal file should not also be sent as content")
+	}
+}
+
+func TestTransactionService_SubmitGovProposal_RequiresTitle(t *testing.T) {
+	svc := NewTransactionService(store.NewMemoryStore(), nil)
+
+	_, err := svc.SubmitGovProposal(context.Background(), &v1.SubmitGovProposalRequest{
+		Devnet:   "mydevnet",
+		Proposer: "validator:0",
+		Content:  []byte(`{"messages": [{"@type": "/cosmos.bank.v1beta1.MsgSend"}]}`),
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestTransactionService_GetGovProposal_DevnetNotRunning(t *testing.T) {
+	ms := store.NewMemoryStore()
+	svc := NewTransactionService(ms, nil)
+
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "mydevnet", Namespace: types.DefaultNamespace},
+		Status:   types.DevnetStatus{Phase: types.PhaseStopped},
+	}
+	if err := ms.CreateDevnet(context.Background(), devnet); err != nil {
+		t.Fatalf("CreateDevnet: %v", err)
+	}
+
+	_, err := svc.GetGovProposal(context.Background(), &v1.GetGovProposalRequest{Devnet: "mydevnet"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("error = %v, want FailedPrecondition", err)
+	}
+
+	_, err = svc.GetGovProposal(context.Background(), &v1.GetGovProposalRequest{Devnet: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("error = %v, want NotFound", err)
+	}
+}
+
+func TestTransactionService_BuildParamChangeProposal_Validation(t *testing.T) {
+	ms := store.NewMemoryStore()
+	svc := NewTransactionService(ms, nil)
+
+	_, err := svc.BuildParamChangeProposal(context.Background(), &v1.BuildParamChangeProposalRequest{Devnet: "mydevnet", Module: "staking"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("error = %v, want InvalidArgument without a key", err)
+	}
+
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "mydevnet", Namespace: types.DefaultNamespace},
+		Status:   types.DevnetStatus{Phase: types.PhaseStopped},
+	}
+	if err := ms.CreateDevnet(context.Background(), devnet); err != nil {
+		t.Fatalf("CreateDevnet: %v", err)
+	}
+	_, err = svc.BuildParamChangeProposal(context.Background(), &v1.BuildParamChangeProposalRequest{
+		Devnet: "mydevnet", Module: "staking", Key: "max_validators", Value: "200",
+	})
+	if status.Code(err) != codes.FailedPrecondition || !strings.Contains(err.Error(), "param changes require a running devnet") {
+		t.Errorf("error = %v, want FailedPrecondition for a stopped devnet", err)
+	}
+}
+
+func TestParamsLayout_DefaultsForUnknownPlugin(t *testing.T) {
+	layout := paramsLayout("no-such-plugin", "staking")
+	if layout.QueryPath != "/cosmos/staking/v1beta1/params" {
+		t.Errorf("QueryPath = %q, want the SDK default", layout.QueryPath)
+	}
+}
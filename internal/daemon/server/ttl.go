@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// parseTTL parses a devnet TTL. Empty means no TTL.
+func parseTTL(ttl string) (time.Duration, error) {
+	if ttl == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(ttl)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ttl %q: %w", ttl, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid ttl %q: must be positive", ttl)
+	}
+	return d, nil
+}
+
+// resetExpiry sets the devnet's expiry to its TTL from now, or clears it if
+// the devnet has no TTL.
+func resetExpiry(devnet *types.Devnet, now time.Time) error {
+	ttl, err := parseTTL(devnet.Spec.TTL)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	devnet.Status.ExpiresAt = time.Time{}
+	if ttl > 0 {
+		devnet.Status.ExpiresAt = now.Add(ttl)
+	}
+	return nil
+}
+
+// ExtendDevnet pushes back the TTL expiry of a devnet. An expired devnet is
+// extended from now, and can then be started again.
+func (s *DevnetService) ExtendDevnet(ctx context.Context, req *v1.ExtendDevnetRequest) (*v1.ExtendDevnetResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	by, err := time.ParseDuration(req.By)
+	if err != nil || by <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid duration %q: must be positive, e.g. 2h", req.By)
+	}
+
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+
+	devnet, err := s.store.GetDevnet(ctx, namespace, req.Name)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", req.Name)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+	if devnet.Status.ExpiresAt.IsZero() {
+		return nil, status.Errorf(codes.FailedPrecondition, "devnet %q has no TTL", req.Name)
+	}
+
+	from := devnet.Status.ExpiresAt
+	if now := time.Now(); from.Before(now) {
+		from = now
+	}
+	devnet.Status.ExpiresAt = from.Add(by)
+
+	s.logger.Info("extending devnet", "namespace", namespace, "name", req.Name, "expiresAt", devnet.Status.ExpiresAt)
+
+	if err := s.store.UpdateDevnet(ctx, devnet); err != nil {
+		s.logger.Error("failed to update devnet", "name", req.Name, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to update devnet: %v", err)
+	}
+
+	return &v1.ExtendDevnetResponse{Devnet: DevnetToProto(devnet)}, nil
+}
+
+// devnetReaper stops and deletes expired devnets through the DevnetService,
+// so they get the same cleanup as API calls.
+type devnetReaper struct {
+	svc *DevnetService
+}
+
+func (r devnetReaper) StopDevnet(ctx context.Context, namespace, name, reason string) error {
+	_, err := r.svc.StopDevnet(ctx, &v1.StopDevnetRequest{Namespace: namespace, Name: name, Reason: reason})
+	return err
+}
+
+func (r devnetReaper) DeleteDevnet(ctx context.Context, namespace, name string) error {
+	_, err := r.svc.DeleteDevnet(ctx, &v1.DeleteDevnetRequest{Namespace: namespace, Name: name})
+	return err
+}
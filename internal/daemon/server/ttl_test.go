@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDevnetService_CreateSetsExpiry(t *testing.T) {
+	ctx := context.Background()
+	svc := NewDevnetService(store.NewMemoryStore(), nil, nil)
+
+	resp, err := svc.CreateDevnet(ctx, &v1.CreateDevnetRequest{
+		Name: "ephemeral",
+		Spec: &v1.DevnetSpec{Plugin: "stable", Validators: 1, Mode: "docker", Ttl: "4h"},
+	})
+	if err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+	expiresAt := resp.Devnet.Status.GetExpiresAt()
+	if expiresAt == nil {
+		t.Fatal("expected expires_at to be set")
+	}
+	if left := time.Until(expiresAt.AsTime()); left < 3*time.Hour || left > 4*time.Hour {
+		t.Errorf("expected expiry about 4h from now, got %v", left)
+	}
+
+	resp, err = svc.CreateDevnet(ctx, &v1.CreateDevnetRequest{
+		Name: "forever",
+		Spec: &v1.DevnetSpec{Plugin: "stable", Validators: 1, Mode: "docker"},
+	})
+	if err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+	if resp.Devnet.Status.ExpiresAt != nil {
+		t.Errorf("expected no expiry without ttl, got %v", resp.Devnet.Status.ExpiresAt)
+	}
+}
+
+func TestDevnetService_ExtendDevnet(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+
+	expired := time.Now().Add(-time.Hour)
+	for _, d := range []*types.Devnet{
+		{
+			Metadata: types.ResourceMeta{Name: "expired"},
+			Spec:     types.DevnetSpec{TTL: "1h"},
+			Status:   types.DevnetStatus{Phase: types.PhaseStopped, ExpiresAt: expired},
+		},
+		{Metadata: types.ResourceMeta{Name: "forever"}},
+	} {
+		if err := s.CreateDevnet(ctx, d); err != nil {
+			t.Fatalf("CreateDevnet failed: %v", err)
+		}
+	}
+
+	// An expired devnet cannot be started until it is extended.
+	_, err := svc.StartDevnet(ctx, &v1.StartDevnetRequest{Name: "expired"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition starting expired devnet, got %v", err)
+	}
+
+	resp, err := svc.ExtendDevnet(ctx, &v1.ExtendDevnetRequest{Name: "expired", By: "2h"})
+	if err != nil {
+		t.Fatalf("ExtendDevnet failed: %v", err)
+	}
+	// Extended from now, not from the past expiry.
+	if left := time.Until(resp.Devnet.Status.ExpiresAt.AsTime()); left < time.Hour+59*time.Minute {
+		t.Errorf("expected expiry about 2h from now, got %v", left)
+	}
+
+	tests := []struct {
+		req  *v1.ExtendDevnetRequest
+		code codes.Code
+	}{
+		{&v1.ExtendDevnetRequest{By: "2h"}, codes.InvalidArgument},
+		{&v1.ExtendDevnetRequest{Name: "expired", By: "soon"}, codes.InvalidArgument},
+		{&v1.ExtendDevnetRequest{Name: "expired", By: "-1h"}, codes.InvalidArgument},
+		{&v1.ExtendDevnetRequest{Name: "missing", By: "2h"}, codes.NotFound},
+		{&v1.ExtendDevnetRequest{Name: "forever", By: "2h"}, codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		_, err := svc.ExtendDevnet(ctx, tt.req)
+		if status.Code(err) != tt.code {
+			t.Errorf("ExtendDevnet(%v): expected %v, got %v", tt.req, tt.code, err)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/txtrace"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	pkgNetwork "github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"github.com/altuslabsxyz/devnet-builder/pkg/network/cosmos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TraceTransaction looks an on-chain transaction up on every running node of
+// a devnet and decodes it with the network plugin.
+func (s *TransactionService) TraceTransaction(ctx context.Context, req *v1.TraceTransactionRequest) (*v1.TraceTransactionResponse, error) {
+	if req.Devnet == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet is required")
+	}
+	if req.Hash == "" {
+		return nil, status.Error(codes.InvalidArgument, "hash is required")
+	}
+
+	devnet, err := s.store.GetDevnet(ctx, req.GetNamespace(), req.Devnet)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", req.Devnet)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+	if devnet.Status.Phase != types.PhaseRunning && devnet.Status.Phase != types.PhaseDegraded {
+		return nil, status.Errorf(codes.FailedPrecondition, "devnet %q is %s; tx traces require a running devnet%s",
+			req.Devnet, devnet.Status.Phase, resumeHint(req.Devnet, devnet.Status.Phase))
+	}
+
+	nodes, err := s.store.ListNodes(ctx, devnet.Metadata.Namespace, req.Devnet)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list nodes: %v", err)
+	}
+	cfg := txtrace.Config{Decoder: txDecoder(devnet.Spec.Plugin)}
+	for _, n := range nodes {
+		if n.Status.Phase != types.NodePhaseRunning {
+			continue
+		}
+		host := n.Spec.Address
+		if host == "" {
+			host = "127.0.0.1"
+		}
+		ports := n.Spec.Ports()
+		cfg.Nodes = append(cfg.Nodes, txtrace.Node{
+			Index:     n.Spec.Index,
+			RPCURL:    ports.RPCURL(host),
+			EVMRPCURL: ports.EVMRPCURL(host),
+		})
+	}
+	if len(cfg.Nodes) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "devnet %q has no running nodes", req.Devnet)
+	}
+
+	trace, err := txtrace.NewTracer(cfg).Trace(ctx, req.Hash, req.EvmTrace)
+	if err != nil {
+		if errors.Is(err, txtrace.ErrNotFound) {
+			for _, n := range trace.Nodes {
+				if n.Error != "" {
+					return nil, status.Errorf(codes.NotFound, "transaction %s not found (node %d: %s)", req.Hash, n.Index, n.Error)
+				}
+			}
+			return nil, status.Errorf(codes.NotFound, "transaction %s not found on any running node", req.Hash)
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &v1.TraceTransactionResponse{Trace: txTraceToProto(trace)}, nil
+}
+
+// txDecoder returns the devnet plugin's transaction decoder, falling back
+// to the Cosmos SDK decoder when the plugin has none or cannot decode.
+func txDecoder(pluginName string) func([]byte) (*pkgNetwork.DecodedTx, error) {
+	m, err := network.Get(pluginName)
+	if err != nil {
+		return cosmos.DecodeTx
+	}
+	decoder, ok := m.(pkgNetwork.TxDecoder)
+	if !ok {
+		return cosmos.DecodeTx
+	}
+	return func(txBytes []byte) (*pkgNetwork.DecodedTx, error) {
+		if tx, err := decoder.DecodeTx(txBytes); err == nil {
+			return tx, nil
+		}
+		return cosmos.DecodeTx(txBytes)
+	}
+}
+
+func txTraceToProto(t *txtrace.Trace) *v1.TxTrace {
+	pb := &v1.TxTrace{
+		Hash:          t.Hash,
+		Height:        t.Height,
+		Code:          t.Code,
+		Codespace:     t.Codespace,
+		Log:           t.Log,
+		GasWanted:     t.GasWanted,
+		GasUsed:       t.GasUsed,
+		DecodeError:   t.DecodeError,
+		EvmTxHash:     t.EVMTxHash,
+		EvmTrace:      t.EVMTrace,
+		EvmTraceError: t.EVMTraceError,
+	}
+	if t.Tx != nil {
+		pb.Memo = t.Tx.Memo
+		pb.Fee = t.Tx.Fee
+		for _, m := range t.Tx.Messages {
+			pb.Messages = append(pb.Messages, &v1.TxTraceMessage{TypeUrl: m.TypeURL, Json: m.JSON})
+		}
+	}
+	for _, e := range t.Events {
+		event := &v1.TxTraceEvent{Type: e.Type}
+		for _, a := range e.Attributes {
+			event.Attributes = append(event.Attributes, &v1.TxTraceAttribute{Key: a.Key, Value: a.Value})
+		}
+		pb.Events = append(pb.Events, event)
+	}
+	for _, n := range t.Nodes {
+		pb.Nodes = append(pb.Nodes, &v1.TxTraceNode{Index: int32(n.Index), Found: n.Found, Height: n.Height, Error: n.Error})
+	}
+	return pb
+}
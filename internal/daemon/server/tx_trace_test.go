@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/txtrace"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	pkgNetwork "github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTransactionService_TraceTransactionValidation(t *testing.T) {
+	s := store.NewMemoryStore()
+	svc := NewTransactionService(s, nil)
+	ctx := context.Background()
+
+	if err := s.CreateDevnet(ctx, &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "stopped-devnet", Namespace: types.DefaultNamespace},
+		Spec:     types.DevnetSpec{Plugin: "stable", Validators: 1},
+		Status:   types.DevnetStatus{Phase: types.PhaseStopped},
+	}); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		req  *v1.TraceTransactionRequest
+		code codes.Code
+	}{
+		{"missing devnet", &v1.TraceTransactionRequest{Hash: "ABC"}, codes.InvalidArgument},
+		{"missing hash", &v1.TraceTransactionRequest{Devnet: "stopped-devnet"}, codes.InvalidArgument},
+		{"devnet not found", &v1.TraceTransactionRequest{Devnet: "missing", Hash: "ABC"}, codes.NotFound},
+		{"devnet not running", &v1.TraceTransactionRequest{Devnet: "stopped-devnet", Hash: "ABC"}, codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.TraceTransaction(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Errorf("expected code %v, got %v (%v)", tt.code, status.Code(err), err)
+			}
+		})
+	}
+}
+
+func TestTxTraceToProto(t *testing.T) {
+	pb := txTraceToProto(&txtrace.Trace{
+		Hash:    "ABC",
+		Height:  42,
+		GasUsed: 81234,
+		Tx: &pkgNetwork.DecodedTx{
+			Messages: []pkgNetwork.DecodedMsg{{TypeURL: "/cosmos.bank.v1beta1.MsgSend", JSON: []byte(`{}`)}},
+			Fee:      "5000stake",
+		},
+		Events: []txtrace.Event{{Type: "transfer", Attributes: []txtrace.Attribute{{Key: "amount", Value: "100stake"}}}},
+		Nodes:  []txtrace.NodeResult{{Index: 0, Found: true, Height: 42}, {Index: 1, Error: "connection refused"}},
+	})
+
+	if pb.Height != 42 || pb.GasUsed != 81234 || pb.Fee != "5000stake" {
+		t.Errorf("unexpected trace: %v", pb)
+	}
+	if len(pb.Messages) != 1 || pb.Messages[0].TypeUrl != "/cosmos.bank.v1beta1.MsgSend" {
+		t.Errorf("unexpected messages: %v", pb.Messages)
+	}
+	if len(pb.Events) != 1 || pb.Events[0].Attributes[0].Value != "100stake" {
+		t.Errorf("unexpected events: %v", pb.Events)
+	}
+	if len(pb.Nodes) != 2 || !pb.Nodes[0].Found || pb.Nodes[1].Error != "connection refused" {
+		t.Errorf("unexpected nodes: %v", pb.Nodes)
+	}
+}
@@ -3,6 +3,8 @@ package server
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"log/slog"
 
 	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
@@ -10,6 +12,9 @@ import (
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/server/ante"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/upgradereport"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	pkgNetwork "github.com/altuslabsxyz/devnet-builder/pkg/network"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -21,6 +26,9 @@ type UpgradeService struct {
 	manager *controller.Manager
 	logger  *slog.Logger
 	ante    *ante.AnteHandler
+
+	// reportDir holds the rehearsal reports written by the upgrade controller.
+	reportDir string
 }
 
 // NewUpgradeService creates a new UpgradeService.
@@ -47,8 +55,19 @@ func (s *UpgradeService) SetLogger(logger *slog.Logger) {
 	s.logger = logger
 }
 
+// SetReportDir sets the directory upgrade reports are read from.
+func (s *UpgradeService) SetReportDir(dir string) {
+	s.reportDir = dir
+}
+
 // CreateUpgrade creates a new upgrade.
 func (s *UpgradeService) CreateUpgrade(ctx context.Context, req *v1.CreateUpgradeRequest) (*v1.CreateUpgradeResponse, error) {
+	// Without an upgrade name, name the upgrade after the target version
+	// the way the devnet's network does
+	if req.Spec != nil && req.Spec.UpgradeName == "" {
+		req.Spec.UpgradeName = s.conventionalUpgradeName(ctx, req.GetNamespace(), req.Spec)
+	}
+
 	// Use ante handler if available
 	if s.ante != nil {
 		if err := s.ante.ValidateCreateUpgrade(ctx, req); err != nil {
@@ -113,6 +132,33 @@ func (s *UpgradeService) CreateUpgrade(ctx context.Context, req *v1.CreateUpgrad
 	return &v1.CreateUpgradeResponse{Upgrade: UpgradeToProto(upgrade)}, nil
 }
 
+// conventionalUpgradeName returns the upgrade handler name the devnet's
+// network module derives from spec's target version, or "" if there is no
+// version, the devnet is unknown or the network has no naming convention.
+func (s *UpgradeService) conventionalUpgradeName(ctx context.Context, namespace string, spec *v1.UpgradeSpec) string {
+	version := spec.GetNewBinary().GetVersion()
+	if version == "" || spec.DevnetRef == "" {
+		return ""
+	}
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+	devnet, err := s.store.GetDevnet(ctx, namespace, spec.DevnetRef)
+	if err != nil {
+		return ""
+	}
+	module, err := network.Get(devnet.Spec.Plugin)
+	if err != nil {
+		return ""
+	}
+	namer, ok := module.(pkgNetwork.UpgradeNamer)
+	if !ok {
+		return ""
+	}
+	name, _ := namer.UpgradeName(version)
+	return name
+}
+
 // GetUpgrade retrieves an upgrade by name.
 func (s *UpgradeService) GetUpgrade(ctx context.Context, req *v1.GetUpgradeRequest) (*v1.GetUpgradeResponse, error) {
 	if req.Name == "" {
@@ -287,3 +333,46 @@ func (s *UpgradeService) RetryUpgrade(ctx context.Context, req *v1.RetryUpgradeR
 
 	return &v1.RetryUpgradeResponse{Upgrade: UpgradeToProto(upgrade)}, nil
 }
+
+// GetUpgradeReport returns the rehearsal report of a finished upgrade.
+func (s *UpgradeService) GetUpgradeReport(ctx context.Context, req *v1.GetUpgradeReportRequest) (*v1.GetUpgradeReportResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if s.reportDir == "" {
+		return nil, status.Error(codes.Unavailable, "upgrade reports are not enabled")
+	}
+
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = types.DefaultNamespace
+	}
+
+	report, err := upgradereport.Read(s.reportDir, namespace, req.Name)
+	if err != nil {
+		if errors.Is(err, upgradereport.ErrNotFound) {
+			// Reports are written when an upgrade reaches Completed or
+			// Failed, so tell in-progress upgrades apart from unknown ones.
+			if upgrade, getErr := s.store.GetUpgrade(ctx, namespace, req.Name); getErr == nil {
+				return nil, status.Errorf(codes.NotFound,
+					"no report for upgrade %q yet: it is in phase %q, reports are written when it completes or fails",
+					req.Name, upgrade.Status.Phase)
+			}
+			return nil, status.Errorf(codes.NotFound, "upgrade %q not found", req.Name)
+		}
+		s.logger.Error("failed to read upgrade report", "name", req.Name, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to read upgrade report: %v", err)
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode upgrade report: %v", err)
+	}
+	jsonPath, _ := upgradereport.Paths(s.reportDir, namespace, req.Name)
+
+	return &v1.GetUpgradeReportResponse{
+		Json:     data,
+		Markdown: report.Markdown(),
+		Path:     jsonPath,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/valset"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DiffValidatorSets compares a running devnet's validator sets at two heights
+// using the node's historical CometBFT and staking queries.
+func (s *DevnetService) DiffValidatorSets(ctx context.Context, req *v1.DiffValidatorSetsRequest) (*v1.DiffValidatorSetsResponse, error) {
+	if req.DevnetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "devnet_name is required")
+	}
+	if req.FromHeight <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "from_height must be positive")
+	}
+	if req.ToHeight < 0 || (req.ToHeight > 0 && req.ToHeight <= req.FromHeight) {
+		return nil, status.Error(codes.InvalidArgument, "to_height must be after from_height")
+	}
+
+	devnet, err := s.store.GetDevnet(ctx, req.GetNamespace(), req.DevnetName)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "devnet %q not found", req.DevnetName)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get devnet: %v", err)
+	}
+	if devnet.Status.Phase != types.PhaseRunning && devnet.Status.Phase != types.PhaseDegraded {
+		return nil, status.Errorf(codes.FailedPrecondition, "devnet %q is %s; validator set queries require a running devnet%s",
+			req.DevnetName, devnet.Status.Phase, resumeHint(req.DevnetName, devnet.Status.Phase))
+	}
+
+	node, err := s.store.GetNode(ctx, devnet.Metadata.Namespace, req.DevnetName, int(req.NodeIndex))
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "node %s/%d not found", req.DevnetName, req.NodeIndex)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get node: %v", err)
+	}
+
+	host := node.Spec.Address
+	if host == "" {
+		host = "127.0.0.1"
+	}
+	ports := node.Spec.Ports()
+	querier := valset.NewQuerier(valset.Config{
+		RPCEndpoint:  ports.RPCURL(host),
+		RESTEndpoint: ports.APIURL(host),
+	})
+
+	from, err := querier.Get(ctx, req.FromHeight)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "%v", err)
+	}
+	to, err := querier.Get(ctx, req.ToHeight)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "%v", err)
+	}
+	if to.Height <= from.Height {
+		return nil, status.Errorf(codes.InvalidArgument, "from_height %d is not before the latest height %d", from.Height, to.Height)
+	}
+
+	return valsetDiffToProto(from, to, valset.Diff(from, to)), nil
+}
+
+func valsetDiffToProto(from, to *valset.Set, changes []valset.Change) *v1.DiffValidatorSetsResponse {
+	resp := &v1.DiffValidatorSetsResponse{
+		FromHeight:     from.Height,
+		ToHeight:       to.Height,
+		FromCount:      int32(from.ActiveCount()),
+		ToCount:        int32(to.ActiveCount()),
+		FromTotalPower: from.TotalPower(),
+		ToTotalPower:   to.TotalPower(),
+		Changes:        make([]*v1.ValidatorSetChange, 0, len(changes)),
+	}
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, &v1.ValidatorSetChange{
+			Kind:             string(c.Kind),
+			ConsensusAddress: c.ConsensusAddress,
+			OperatorAddress:  c.OperatorAddress,
+			Moniker:          c.Moniker,
+			FromPower:        c.FromPower,
+			ToPower:          c.ToPower,
+		})
+	}
+	return resp
+}
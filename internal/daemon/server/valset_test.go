@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/valset"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDevnetService_DiffValidatorSetsValidation(t *testing.T) {
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+	ctx := context.Background()
+
+	if err := s.CreateDevnet(ctx, &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "pending-devnet", Namespace: types.DefaultNamespace},
+		Spec:     types.DevnetSpec{Plugin: "stable", Validators: 1},
+		Status:   types.DevnetStatus{Phase: types.PhasePending},
+	}); err != nil {
+		t.Fatalf("CreateDevnet failed: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		req  *v1.DiffValidatorSetsRequest
+		code codes.Code
+	}{
+		{"missing devnet name", &v1.DiffValidatorSetsRequest{FromHeight: 1}, codes.InvalidArgument},
+		{"missing from height", &v1.DiffValidatorSetsRequest{DevnetName: "pending-devnet"}, codes.InvalidArgument},
+		{"to before from", &v1.DiffValidatorSetsRequest{DevnetName: "pending-devnet", FromHeight: 10, ToHeight: 5}, codes.InvalidArgument},
+		{"devnet not found", &v1.DiffValidatorSetsRequest{DevnetName: "missing", FromHeight: 1}, codes.NotFound},
+		{"devnet not running", &v1.DiffValidatorSetsRequest{DevnetName: "pending-devnet", FromHeight: 1}, codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.DiffValidatorSets(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Errorf("expected code %v, got %v (%v)", tt.code, status.Code(err), err)
+			}
+		})
+	}
+}
+
+func TestValsetDiffToProto(t *testing.T) {
+	from := &valset.Set{Height: 10, Validators: []valset.Validator{
+		{ConsensusAddress: "AA", VotingPower: 100, Active: true},
+		{ConsensusAddress: "BB", VotingPower: 100, Active: true},
+	}}
+	to := &valset.Set{Height: 20, Validators: []valset.Validator{
+		{ConsensusAddress: "AA", VotingPower: 100, Active: true},
+	}}
+
+	resp := valsetDiffToProto(from, to, []valset.Change{
+		{Kind: valset.ChangeJailed, ConsensusAddress: "BB", Moniker: "validator-1", FromPower: 100},
+	})
+
+	if resp.FromHeight != 10 || resp.ToHeight != 20 {
+		t.Errorf("heights = %d..%d, want 10..20", resp.FromHeight, resp.ToHeight)
+	}
+	if resp.FromCount != 2 || resp.ToCount != 1 {
+		t.Errorf("counts = %d/%d, want 2/1", resp.FromCount, resp.ToCount)
+	}
+	if resp.FromTotalPower != 200 || resp.ToTotalPower != 100 {
+		t.Errorf("total power = %d/%d, want 200/100", resp.FromTotalPower, resp.ToTotalPower)
+	}
+	if len(resp.Changes) != 1 || resp.Changes[0].Kind != "jailed" || resp.Changes[0].Moniker != "validator-1" {
+		t.Errorf("unexpected changes: %v", resp.Changes)
+	}
+}
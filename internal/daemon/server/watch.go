@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchEventBuffer bounds the changes queued for a slow WatchDevnets client.
+const watchEventBuffer = 256
+
+// WatchDevnets streams the current devnets and their nodes as ADDED events,
+// then every change to them. Store watchers deliver changes concurrently, so
+// clients should order updates by metadata generation.
+func (s *DevnetService) WatchDevnets(req *v1.WatchDevnetsRequest, stream grpc.ServerStreamingServer[v1.WatchDevnetsResponse]) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	namespace := req.GetNamespace()
+	events := make(chan *v1.WatchDevnetsResponse, watchEventBuffer)
+	handler := func(eventType string, resource interface{}) {
+		event := watchEvent(eventType, resource, namespace)
+		if event == nil {
+			return
+		}
+		select {
+		case events <- event:
+		case <-ctx.Done():
+		}
+	}
+
+	// Watch before listing so no change between the two is missed.
+	go s.store.Watch(ctx, "devnets", handler)
+	go s.store.Watch(ctx, "nodes", handler)
+
+	devnets, err := s.store.ListDevnets(ctx, namespace)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list devnets: %v", err)
+	}
+	for _, d := range devnets {
+		if err := stream.Send(&v1.WatchDevnetsResponse{Type: "ADDED", Devnet: DevnetToProto(d)}); err != nil {
+			return err
+		}
+		nodes, err := s.store.ListNodes(ctx, d.Metadata.Namespace, d.Metadata.Name)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list nodes: %v", err)
+		}
+		for _, n := range nodes {
+			if err := stream.Send(&v1.WatchDevnetsResponse{Type: "ADDED", Node: NodeToProto(n)}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watchEvent converts a store change to a WatchDevnetsResponse, or returns
+// nil if it is outside namespace.
+func watchEvent(eventType string, resource interface{}, namespace string) *v1.WatchDevnetsResponse {
+	inNamespace := func(ns string) bool {
+		if ns == "" {
+			ns = types.DefaultNamespace
+		}
+		return namespace == "" || ns == namespace
+	}
+
+	switch r := resource.(type) {
+	case *types.Devnet:
+		if !inNamespace(r.Metadata.Namespace) {
+			return nil
+		}
+		return &v1.WatchDevnetsResponse{Type: eventType, Devnet: DevnetToProto(r)}
+	case *types.Node:
+		if !inNamespace(r.Metadata.Namespace) {
+			return nil
+		}
+		return &v1.WatchDevnetsResponse{Type: eventType, Node: NodeToProto(r)}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type mockWatchStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	mu        sync.Mutex
+	responses []*v1.WatchDevnetsResponse
+}
+
+func (m *mockWatchStream) Context() context.Context { return m.ctx }
+
+func (m *mockWatchStream) Send(resp *v1.WatchDevnetsResponse) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.responses = append(m.responses, resp)
+	return nil
+}
+
+func (m *mockWatchStream) received() []*v1.WatchDevnetsResponse {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*v1.WatchDevnetsResponse(nil), m.responses...)
+}
+
+func (m *mockWatchStream) SetHeader(metadata.MD) error  { return nil }
+func (m *mockWatchStream) SendHeader(metadata.MD) error { return nil }
+func (m *mockWatchStream) SetTrailer(metadata.MD)       {}
+func (m *mockWatchStream) SendMsg(interface{}) error    { return nil }
+func (m *mockWatchStream) RecvMsg(interface{}) error    { return nil }
+
+func TestDevnetService_WatchDevnets(t *testing.T) {
+	s := store.NewMemoryStore()
+	svc := NewDevnetService(s, nil, nil)
+	ctx := context.Background()
+
+	devnet := &types.Devnet{Metadata: types.ResourceMeta{Name: "alpha"}}
+	if err := s.CreateDevnet(ctx, devnet); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateNode(ctx, &types.Node{Spec: types.NodeSpec{DevnetRef: "alpha", Index: 0}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateDevnet(ctx, &types.Devnet{Metadata: types.ResourceMeta{Name: "other", Namespace: "team"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream := &mockWatchStream{ctx: watchCtx}
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.WatchDevnets(&v1.WatchDevnetsRequest{Namespace: types.DefaultNamespace}, stream)
+	}()
+
+	waitFor := func(n int) []*v1.WatchDevnetsResponse {
+		t.Helper()
+		deadline := time.Now().Add(2 * time.Second)
+		for time.Now().Before(deadline) {
+			if got := stream.received(); len(got) >= n {
+				return got
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("timed out waiting for %d events, got %d", n, len(stream.received()))
+		return nil
+	}
+
+	// Snapshot: the devnet in the watched namespace and its node.
+	got := waitFor(2)
+	if got[0].GetType() != "ADDED" || got[0].GetDevnet().GetMetadata().GetName() != "alpha" {
+		t.Errorf("first event = %v, want ADDED alpha", got[0])
+	}
+	if got[1].GetType() != "ADDED" || got[1].GetNode() == nil {
+		t.Errorf("second event = %v, want ADDED node", got[1])
+	}
+
+	// The store watchers register asynchronously, so keep changing the
+	// devnets until a change comes through.
+	devnet.Status.Phase = types.PhaseRunning
+	deadline := time.Now().Add(2 * time.Second)
+	for !hasWatchEvent(stream.received(), "MODIFIED", "alpha") {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for MODIFIED event")
+		}
+		if err := s.CreateDevnet(ctx, &types.Devnet{Metadata: types.ResourceMeta{Name: "skipped", Namespace: "team"}}); err != nil && !store.IsAlreadyExists(err) {
+			t.Fatal(err)
+		}
+		if err := s.UpdateDevnet(ctx, devnet); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("WatchDevnets() = %v, want context.Canceled", err)
+	}
+
+	// Changes outside the namespace are filtered out.
+	if hasWatchEvent(stream.received(), "ADDED", "skipped") {
+		t.Error("got event for devnet in another namespace")
+	}
+}
+
+func hasWatchEvent(events []*v1.WatchDevnetsResponse, eventType, devnet string) bool {
+	for _, e := range events {
+		if e.GetType() == eventType && e.GetDevnet().GetMetadata().GetName() == devnet {
+			return true
+		}
+	}
+	return false
+}
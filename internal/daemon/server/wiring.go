@@ -21,6 +21,7 @@ import (
 	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/builder"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/provisioner"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/retry"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/runtime"
 	daemontypes "github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
 	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/genesis"
@@ -426,6 +427,28 @@ func (a *moduleRuntimeAdapter) ContainerHomePath() string {
 	return a.module.DockerHomeDir()
 }
 
+// ReloadableConfigKeys returns the config keys the module's binary reloads
+// without restarting; none unless the module implements
+// sdknetwork.ConfigReloader.
+func (a *moduleRuntimeAdapter) ReloadableConfigKeys() []string {
+	reloader, ok := a.module.(sdknetwork.ConfigReloader)
+	if !ok {
+		return nil
+	}
+	keys, _ := reloader.ReloadableConfig()
+	return keys
+}
+
+func (a *moduleRuntimeAdapter) ReloadSignal() syscall.Signal {
+	if reloader, ok := a.module.(sdknetwork.ConfigReloader); ok {
+		if _, signal := reloader.ReloadableConfig(); signal != 0 {
+			return signal
+		}
+	}
+	// SIGHUP is the conventional reload signal
+	return syscall.SIGHUP
+}
+
 // readChainIDFromGenesis reads the chain_id from the genesis.json file in the node's home directory.
 // This is used as a fallback for existing nodes that were provisioned before ChainID was added to NodeSpec.
 // Returns empty string if the file cannot be read or parsed.
@@ -447,7 +470,10 @@ func readChainIDFromGenesis(homeDir string) string {
 }
 
 // Ensure interface compliance
-var _ runtime.PluginRuntime = (*moduleRuntimeAdapter)(nil)
+var (
+	_ runtime.PluginRuntime  = (*moduleRuntimeAdapter)(nil)
+	_ runtime.ConfigReloader = (*moduleRuntimeAdapter)(nil)
+)
 
 // =============================================================================
 // Node Initializer Adapter (ports.NodeInitializer)
@@ -724,6 +750,7 @@ func (a *nodeInitializerAdapter) GetTestMnemonic(validatorIndex int) string {
 // It uses the global network registry to obtain NetworkModules from loaded plugins.
 type OrchestratorFactory struct {
 	dataDir string
+	retry   retry.Policies
 	logger  *slog.Logger
 }
 
@@ -735,6 +762,12 @@ func NewOrchestratorFactory(dataDir string, logger *slog.Logger) *OrchestratorFa
 	}
 }
 
+// SetRetryPolicies sets the retry policies of the orchestrators' steps.
+// Without them, each step is attempted once.
+func (f *OrchestratorFactory) SetRetryPolicies(p retry.Policies) {
+	f.retry = p
+}
+
 // GetBuilder implements builder.PluginLoader interface.
 func (f *OrchestratorFactory) GetBuilder(pluginName string) (plugintypes.PluginBuilder, error) {
 	module, err := network.Get(pluginName)
@@ -791,6 +824,7 @@ func (f *OrchestratorFactory) CreateOrchestrator(networkName string) (provisione
 
 	// Create binary builder
 	binaryBuilder := builder.NewDefaultBuilder(f.dataDir, f, f.logger)
+	binaryBuilder.SetReleaseFinder(NewGitHubReleaseFinder(f.logger))
 
 	// Create infrastructure services for snapshot-based genesis forking
 	snapshotFetcher := snapshot.NewFetcherAdapter(f.dataDir, nil)
@@ -822,12 +856,22 @@ func (f *OrchestratorFactory) CreateOrchestrator(networkName string) (provisione
 		DataDir:       f.dataDir,
 		Logger:        f.logger,
 		PluginGenesis: genesisAdapter,
+		Retry:         f.retry,
 		Bech32Prefix:  module.Bech32Prefix(),
+		EVM:           isEVMNetwork(module),
+		ConfigOverrides: func(node *daemontypes.Node, numValidators int) ([]byte, []byte, error) {
+			return module.GetConfigOverrides(node.Spec.Index, nodeConfigOptions(node, numValidators))
+		},
 	}
 
 	return provisioner.NewProvisioningOrchestrator(config), nil
 }
 
+// isEVMNetwork reports whether a network uses Ethereum-style account keys.
+func isEVMNetwork(module network.NetworkModule) bool {
+	return module.GenesisConfig().EVMChainID > 0
+}
+
 // ListAvailableNetworks returns the names of all registered networks.
 func (f *OrchestratorFactory) ListAvailableNetworks() []string {
 	return network.List()
@@ -5,6 +5,7 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	"syscall"
 	"testing"
 
 	cosmoslog "cosmossdk.io/log"
@@ -555,6 +556,34 @@ func TestModuleRuntimeAdapter_StartCommand_GenesisFallback(t *testing.T) {
 	assert.Contains(t, cmd, "fallback-chain-123")
 }
 
+// reloadingMockModule is a mockNetworkModule whose binary reloads config.
+type reloadingMockModule struct {
+	*mockNetworkModule
+	keys   []string
+	signal syscall.Signal
+}
+
+func (m *reloadingMockModule) ReloadableConfig() ([]string, syscall.Signal) {
+	return m.keys, m.signal
+}
+
+func TestModuleRuntimeAdapter_ReloadableConfig(t *testing.T) {
+	adapter := &moduleRuntimeAdapter{module: newMockModule("test", "testd")}
+	assert.Empty(t, adapter.ReloadableConfigKeys())
+	assert.Equal(t, syscall.SIGHUP, adapter.ReloadSignal())
+
+	module := &reloadingMockModule{
+		mockNetworkModule: newMockModule("test", "testd"),
+		keys:              []string{"config.toml:log_level"},
+	}
+	adapter = &moduleRuntimeAdapter{module: module}
+	assert.Equal(t, []string{"config.toml:log_level"}, adapter.ReloadableConfigKeys())
+	assert.Equal(t, syscall.SIGHUP, adapter.ReloadSignal(), "a zero signal means SIGHUP")
+
+	module.signal = syscall.SIGINT
+	assert.Equal(t, syscall.SIGINT, adapter.ReloadSignal())
+}
+
 // =============================================================================
 // ensureOverwriteFlag Tests
 // =============================================================================
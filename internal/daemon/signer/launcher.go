@@ -0,0 +1,177 @@
+// internal/daemon/signer/launcher.go
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strconv"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// containerConfigDir is where a node's tmkms directory is mounted in its
+// signer container.
+const containerConfigDir = "/tmkms"
+
+// dockerClient abstracts the Docker API calls of the launcher for
+// testability.
+type dockerClient interface {
+	ContainerCreate(ctx context.Context, config *container.Config,
+		hostConfig *container.HostConfig, networkingConfig *network.NetworkingConfig,
+		platform *specs.Platform, containerName string) (container.CreateResponse, error)
+	ContainerStart(ctx context.Context, containerID string, opts container.StartOptions) error
+	ContainerRemove(ctx context.Context, containerID string, opts container.RemoveOptions) error
+	Close() error
+}
+
+// LauncherConfig configures a Launcher.
+type LauncherConfig struct {
+	// NodeContainer returns the container of a node run by the docker
+	// runtime; its signer joins the container's network namespace. Nil
+	// when nodes run on the host, whose signers use the host network.
+	NodeContainer func(node *types.Node) string
+
+	// Logger for launcher operations.
+	Logger *slog.Logger
+}
+
+// Launcher runs tmkms softsign containers for validators whose remote
+// signer is tmkms.
+type Launcher struct {
+	client        dockerClient
+	nodeContainer func(node *types.Node) string
+	logger        *slog.Logger
+}
+
+// NewLauncher creates a Launcher using the Docker daemon from the
+// environment.
+func NewLauncher(cfg LauncherConfig) (*Launcher, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create docker client: %w", err)
+	}
+	return newLauncher(cli, cfg), nil
+}
+
+func newLauncher(cli dockerClient, cfg LauncherConfig) *Launcher {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Launcher{
+		client:        cli,
+		nodeContainer: cfg.NodeContainer,
+		logger:        logger,
+	}
+}
+
+// ContainerName returns the name of a node's tmkms container.
+func ContainerName(node *types.Node) string {
+	return fmt.Sprintf("dvb-%s-%s-%d-tmkms", node.Spec.DevnetRef, node.Spec.Role, node.Spec.Index)
+}
+
+// Start (re)creates the tmkms container of node, after the node itself
+// started. It does nothing for nodes without a tmkms signer. The config is
+// rewritten on each start; the double-signing state is kept.
+func (l *Launcher) Start(ctx context.Context, node *types.Node) error {
+	rs := node.Spec.RemoteSigner
+	if rs == nil || !rs.TMKMS {
+		return nil
+	}
+	name := ContainerName(node)
+	if err := l.remove(ctx, name); err != nil {
+		return err
+	}
+
+	// A signer in the node's container reaches it on the node's ports
+	// inside the container; one on the host, on its published ports
+	spec := node.Spec
+	networkMode := container.NetworkMode("host")
+	if l.nodeContainer != nil {
+		networkMode = container.NetworkMode("container:" + l.nodeContainer(node))
+		spec.BindAddress = ""
+		spec.PortLayout = dvbtypes.PortLayout{}
+	}
+
+	dir := filepath.Join(node.Spec.HomeDir, ConfigDir)
+	if err := WriteConfig(dir, containerConfigDir, node, DialAddress(spec)); err != nil {
+		return fmt.Errorf("failed to write tmkms config: %w", err)
+	}
+
+	image := rs.Image
+	if image == "" {
+		image = DefaultImage
+	}
+	config := &container.Config{
+		Image:      image,
+		Entrypoint: []string{"tmkms"},
+		Cmd:        []string{"start", "-c", containerConfigDir + "/tmkms.toml"},
+		Labels: map[string]string{
+			"dvb.devnet": node.Spec.DevnetRef,
+			"dvb.node":   node.Metadata.Name,
+			"dvb.index":  strconv.Itoa(node.Spec.Index),
+			"dvb.role":   "signer",
+		},
+	}
+	hostConfig := &container.HostConfig{
+		NetworkMode: networkMode,
+		Mounts: []mount.Mount{{
+			Type:   mount.TypeBind,
+			Source: dir,
+			Target: containerConfigDir,
+		}},
+		RestartPolicy: container.RestartPolicy{
+			Name: container.RestartPolicyOnFailure,
+		},
+	}
+
+	l.logger.Info("starting tmkms signer",
+		"name", name,
+		"image", image,
+		"node", node.Metadata.Name)
+
+	resp, err := l.client.ContainerCreate(ctx, config, hostConfig, nil, nil, name)
+	if err != nil {
+		var notFound interface{ NotFound() }
+		if errors.As(err, &notFound) {
+			return fmt.Errorf("tmkms image %q is not available locally; build tmkms with the softsign feature and tag it %s, or set the signer's image: %w", image, image, err)
+		}
+		return fmt.Errorf("failed to create tmkms container: %w", err)
+	}
+	if err := l.client.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
+		_ = l.client.ContainerRemove(ctx, resp.ID, container.RemoveOptions{Force: true})
+		return fmt.Errorf("failed to start tmkms container: %w", err)
+	}
+	return nil
+}
+
+// Stop removes the tmkms container of node, if any.
+func (l *Launcher) Stop(ctx context.Context, node *types.Node) error {
+	if rs := node.Spec.RemoteSigner; rs == nil || !rs.TMKMS {
+		return nil
+	}
+	return l.remove(ctx, ContainerName(node))
+}
+
+// Close closes the Docker client.
+func (l *Launcher) Close() error {
+	return l.client.Close()
+}
+
+// remove force-removes a container, ignoring one that does not exist.
+func (l *Launcher) remove(ctx context.Context, name string) error {
+	err := l.client.ContainerRemove(ctx, name, container.RemoveOptions{Force: true})
+	if err != nil && !client.IsErrNotFound(err) {
+		return fmt.Errorf("failed to remove tmkms container %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+// Package signer runs validators with an external remote signer. The node
+// listens for the signer on priv_validator_laddr; the signer is either the
+// operator's own (an HSM behind tmkms, Horcrux, ...) or a tmkms softsign
+// container launched next to the node with the validator's consensus key.
+package signer
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/nodekeys"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
+)
+
+const (
+	// DefaultImage is the tmkms image launched for softsign signers. tmkms
+	// publishes no image; build one from the tmkms repository with the
+	// softsign feature and tag it, or set the signer's image.
+	DefaultImage = "tmkms:latest"
+
+	// DefaultProtocolVersion is the tmkms protocol version of CometBFT
+	// v0.38, used by Cosmos SDK v0.50 chains.
+	DefaultProtocolVersion = "v0.38"
+
+	// ConfigDir is the directory in a node's home holding its tmkms
+	// configuration, keys and double-signing state.
+	ConfigDir = "tmkms"
+)
+
+// ProtocolVersions are the protocol versions tmkms supports.
+var ProtocolVersions = []string{"legacy", "v0.33", "v0.34", "v0.38"}
+
+// Validate checks the remote signers of a devnet with the given number of
+// validators: each names an existing slot at most once, with a protocol
+// version tmkms supports.
+func Validate(signers []types.RemoteSigner, validators int) error {
+	var errs []error
+	slots := make(map[int]bool)
+	for _, s := range signers {
+		if s.Index < 0 || s.Index >= validators {
+			errs = append(errs, fmt.Errorf("validator %d: index out of range (devnet has %d validators)", s.Index, validators))
+			continue
+		}
+		if slots[s.Index] {
+			errs = append(errs, fmt.Errorf("validator %d: remote signer given more than once", s.Index))
+			continue
+		}
+		slots[s.Index] = true
+
+		if s.ProtocolVersion != "" && !slices.Contains(ProtocolVersions, s.ProtocolVersion) {
+			errs = append(errs, fmt.Errorf("validator %d: unsupported protocol version %q (supported: %s)",
+				s.Index, s.ProtocolVersion, strings.Join(ProtocolVersions, ", ")))
+		}
+		if s.Image != "" && !s.TMKMS {
+			errs = append(errs, fmt.Errorf("validator %d: image is only used with tmkms", s.Index))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ListenHost returns the host a node listens for its signer on: the node's
+// bind address, else its address, else all interfaces.
+func ListenHost(spec types.NodeSpec) string {
+	if spec.BindAddress != "" {
+		return spec.BindAddress
+	}
+	if spec.Address != "" {
+		return spec.Address
+	}
+	return "0.0.0.0"
+}
+
+// Port returns the port a node listens for its signer on: the one after
+// its ABCI proxy port, so it moves with the node's port block.
+func Port(spec types.NodeSpec) int {
+	return spec.Ports().Proxy + 1
+}
+
+// ListenAddress returns the node's priv_validator_laddr.
+func ListenAddress(spec types.NodeSpec) string {
+	return "tcp://" + net.JoinHostPort(ListenHost(spec), strconv.Itoa(Port(spec)))
+}
+
+// DialAddress returns the address a signer on the node's host connects to.
+func DialAddress(spec types.NodeSpec) string {
+	return "tcp://" + net.JoinHostPort(dvbtypes.DialHost(ListenHost(spec)), strconv.Itoa(Port(spec)))
+}
+
+// WriteConfig writes the tmkms softsign configuration of node into dir:
+// tmkms.toml, the consensus key taken from the node's
+// priv_validator_key.json and, unless present, the key tmkms identifies
+// itself with. Paths in tmkms.toml are under containerDir, where dir is
+// mounted in the tmkms container; dialAddr is where tmkms reaches the node.
+func WriteConfig(dir, containerDir string, node *types.Node, dialAddr string) error {
+	keyPath := filepath.Join(node.Spec.HomeDir, "config", nodekeys.PrivValidatorKeyFile)
+	data, err := os.ReadFile(keyPath)
+	if err != nil {
+		return fmt.Errorf("failed to read consensus key: %w", err)
+	}
+	consensusKey, err := softsignKey(data)
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range []string{"secrets", "state"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0700); err != nil {
+			return fmt.Errorf("failed to create tmkms directory: %w", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "secrets", "consensus.key"), []byte(consensusKey), 0600); err != nil {
+		return fmt.Errorf("failed to write consensus key: %w", err)
+	}
+	identityPath := filepath.Join(dir, "secrets", "kms-identity.key")
+	if _, err := os.Stat(identityPath); os.IsNotExist(err) {
+		seed := make([]byte, 32)
+		if _, err := rand.Read(seed); err != nil {
+			return fmt.Errorf("failed to generate tmkms identity key: %w", err)
+		}
+		if err := os.WriteFile(identityPath, []byte(base64.StdEncoding.EncodeToString(seed)), 0600); err != nil {
+			return fmt.Errorf("failed to write tmkms identity key: %w", err)
+		}
+	}
+
+	config := tomlConfig(chainID(node), containerDir, dialAddr, protocolVersion(node.Spec.RemoteSigner))
+	if err := os.WriteFile(filepath.Join(dir, "tmkms.toml"), []byte(config), 0600); err != nil {
+		return fmt.Errorf("failed to write tmkms.toml: %w", err)
+	}
+	return nil
+}
+
+// tomlConfig renders a tmkms.toml for one chain signed with a softsign key.
+func tomlConfig(chainID, dir, addr, protocol string) string {
+	q := strconv.Quote
+	return fmt.Sprintf(`# Generated by devnet-builder; rewritten whenever the signer starts.
+
+[[chain]]
+id = %s
+key_format = { type = "hex" }
+state_file = %s
+
+[[providers.softsign]]
+chain_ids = [%s]
+key_type = "consensus"
+path = %s
+
+[[validator]]
+chain_id = %s
+addr = %s
+secret_key = %s
+protocol_version = %s
+reconnect = true
+`,
+		q(chainID), q(dir+"/state/"+chainID+"-consensus.json"),
+		q(chainID), q(dir+"/secrets/consensus.key"),
+		q(chainID), q(addr), q(dir+"/secrets/kms-identity.key"), q(protocol))
+}
+
+// softsignKey converts a priv_validator_key.json into a tmkms softsign key:
+// the base64 ed25519 seed.
+func softsignKey(data []byte) (string, error) {
+	if _, err := nodekeys.ConsensusPubKey(string(data)); err != nil {
+		return "", err
+	}
+	var key struct {
+		PrivKey struct {
+			Type  string `json:"type"`
+			Value string `json:"value"`
+		} `json:"priv_key"`
+	}
+	if err := json.Unmarshal(data, &key); err != nil {
+		return "", fmt.Errorf("invalid %s: %w", nodekeys.PrivValidatorKeyFile, err)
+	}
+	if key.PrivKey.Type != "tendermint/PrivKeyEd25519" {
+		return "", fmt.Errorf("tmkms softsign needs an ed25519 consensus key, got %s", key.PrivKey.Type)
+	}
+	priv, err := base64.StdEncoding.DecodeString(key.PrivKey.Value)
+	if err != nil || len(priv) < 32 {
+		return "", fmt.Errorf("invalid %s: bad priv_key", nodekeys.PrivValidatorKeyFile)
+	}
+	return base64.StdEncoding.EncodeToString(priv[:32]), nil
+}
+
+// chainID returns the node's chain ID. Nodes of devnets without an explicit
+// chain ID run "<devnet>-1", as provisioned.
+func chainID(node *types.Node) string {
+	if node.Spec.ChainID != "" {
+		return node.Spec.ChainID
+	}
+	return node.Spec.DevnetRef + "-1"
+}
+
+func protocolVersion(s *types.RemoteSigner) string {
+	if s == nil || s.ProtocolVersion == "" {
+		return DefaultProtocolVersion
+	}
+	return s.ProtocolVersion
+}
@@ -0,0 +1,202 @@
+// internal/daemon/signer/signer_test.go
+package signer
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writePrivValidatorKey writes a priv_validator_key.json for seed into a
+// node home and returns the home.
+func writePrivValidatorKey(t *testing.T, seed byte) string {
+	t.Helper()
+	priv := ed25519.NewKeyFromSeed(bytes32(seed))
+	pub := priv.Public().(ed25519.PublicKey)
+	sum := sha256.Sum256(pub)
+	key := fmt.Sprintf(`{"address":%q,"pub_key":{"type":"tendermint/PubKeyEd25519","value":%q},"priv_key":{"type":"tendermint/PrivKeyEd25519","value":%q}}`,
+		hex.EncodeToString(sum[:20]), base64.StdEncoding.EncodeToString(pub), base64.StdEncoding.EncodeToString(priv))
+
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "priv_validator_key.json"), []byte(key), 0600))
+	return home
+}
+
+func bytes32(b byte) []byte {
+	out := make([]byte, 32)
+	for i := range out {
+		out[i] = b
+	}
+	return out
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate([]types.RemoteSigner{{Index: 0, TMKMS: true}, {Index: 1, ProtocolVersion: "v0.34"}}, 2))
+
+	err := Validate([]types.RemoteSigner{
+		{Index: 2},
+		{Index: 0},
+		{Index: 0},
+		{Index: 1, ProtocolVersion: "v1"},
+	}, 2)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "validator 2: index out of range")
+	assert.Contains(t, err.Error(), "validator 0: remote signer given more than once")
+	assert.Contains(t, err.Error(), `validator 1: unsupported protocol version "v1"`)
+
+	err = Validate([]types.RemoteSigner{{Index: 0, Image: "tmkms:dev"}}, 1)
+	assert.ErrorContains(t, err, "image is only used with tmkms")
+}
+
+func TestAddresses(t *testing.T) {
+	spec := types.NodeSpec{Index: 2, PortLayout: dvbtypes.PortLayout{Stride: 100}}
+	assert.Equal(t, "tcp://0.0.0.0:26859", ListenAddress(spec))
+	assert.Equal(t, "tcp://127.0.0.1:26859", DialAddress(spec))
+
+	spec = types.NodeSpec{Index: 1, Address: "127.0.42.2"}
+	assert.Equal(t, "tcp://127.0.42.2:26659", ListenAddress(spec))
+	assert.Equal(t, "tcp://127.0.42.2:26659", DialAddress(spec))
+
+	spec = types.NodeSpec{BindAddress: "::", Address: "::1"}
+	assert.Equal(t, "tcp://[::]:26659", ListenAddress(spec))
+	assert.Equal(t, "tcp://[::1]:26659", DialAddress(spec))
+}
+
+func TestWriteConfig(t *testing.T) {
+	home := writePrivValidatorKey(t, 7)
+	node := &types.Node{Spec: types.NodeSpec{
+		DevnetRef:    "rehearsal",
+		HomeDir:      home,
+		RemoteSigner: &types.RemoteSigner{TMKMS: true, ProtocolVersion: "v0.34"},
+	}}
+	dir := filepath.Join(home, ConfigDir)
+
+	require.NoError(t, WriteConfig(dir, "/tmkms", node, "tcp://127.0.0.1:26659"))
+
+	consensusKey, err := os.ReadFile(filepath.Join(dir, "secrets", "consensus.key"))
+	require.NoError(t, err)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(bytes32(7)), string(consensusKey))
+
+	config, err := os.ReadFile(filepath.Join(dir, "tmkms.toml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(config), `id = "rehearsal-1"`)
+	assert.Contains(t, string(config), `path = "/tmkms/secrets/consensus.key"`)
+	assert.Contains(t, string(config), `addr = "tcp://127.0.0.1:26659"`)
+	assert.Contains(t, string(config), `protocol_version = "v0.34"`)
+
+	// The identity key survives rewrites
+	identity, err := os.ReadFile(filepath.Join(dir, "secrets", "kms-identity.key"))
+	require.NoError(t, err)
+	require.NoError(t, WriteConfig(dir, "/tmkms", node, "tcp://127.0.0.1:26659"))
+	again, err := os.ReadFile(filepath.Join(dir, "secrets", "kms-identity.key"))
+	require.NoError(t, err)
+	assert.Equal(t, identity, again)
+}
+
+func TestWriteConfig_MissingKey(t *testing.T) {
+	node := &types.Node{Spec: types.NodeSpec{HomeDir: t.TempDir()}}
+	err := WriteConfig(filepath.Join(node.Spec.HomeDir, ConfigDir), "/tmkms", node, "tcp://127.0.0.1:26659")
+	assert.ErrorContains(t, err, "failed to read consensus key")
+}
+
+// fakeDocker records the containers the launcher creates and removes.
+type fakeDocker struct {
+	created    []string
+	config     *container.Config
+	hostConfig *container.HostConfig
+	removed    []string
+}
+
+func (f *fakeDocker) ContainerCreate(ctx context.Context, config *container.Config, hostConfig *container.HostConfig,
+	networkingConfig *network.NetworkingConfig, platform *specs.Platform, name string) (container.CreateResponse, error) {
+	f.created = append(f.created, name)
+	f.config, f.hostConfig = config, hostConfig
+	return container.CreateResponse{ID: "abc123"}, nil
+}
+
+func (f *fakeDocker) ContainerStart(ctx context.Context, id string, opts container.StartOptions) error {
+	return nil
+}
+
+func (f *fakeDocker) ContainerRemove(ctx context.Context, id string, opts container.RemoveOptions) error {
+	f.removed = append(f.removed, id)
+	return nil
+}
+
+func (f *fakeDocker) Close() error { return nil }
+
+func TestLauncher(t *testing.T) {
+	home := writePrivValidatorKey(t, 1)
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: "rehearsal-node-1"},
+		Spec: types.NodeSpec{
+			DevnetRef:    "rehearsal",
+			Index:        1,
+			Role:         "validator",
+			HomeDir:      home,
+			PortLayout:   dvbtypes.PortLayout{Stride: 100},
+			RemoteSigner: &types.RemoteSigner{Index: 1, TMKMS: true},
+		},
+	}
+
+	t.Run("host network", func(t *testing.T) {
+		docker := &fakeDocker{}
+		l := newLauncher(docker, LauncherConfig{})
+
+		require.NoError(t, l.Start(context.Background(), node))
+		assert.Equal(t, []string{"dvb-rehearsal-validator-1-tmkms"}, docker.created)
+		assert.Equal(t, DefaultImage, docker.config.Image)
+		assert.Equal(t, container.NetworkMode("host"), docker.hostConfig.NetworkMode)
+		require.Len(t, docker.hostConfig.Mounts, 1)
+		assert.Equal(t, filepath.Join(home, ConfigDir), docker.hostConfig.Mounts[0].Source)
+
+		config, err := os.ReadFile(filepath.Join(home, ConfigDir, "tmkms.toml"))
+		require.NoError(t, err)
+		assert.Contains(t, string(config), `addr = "tcp://127.0.0.1:26759"`)
+
+		require.NoError(t, l.Stop(context.Background(), node))
+		assert.Equal(t, []string{"dvb-rehearsal-validator-1-tmkms", "dvb-rehearsal-validator-1-tmkms"}, docker.removed)
+	})
+
+	t.Run("node container network", func(t *testing.T) {
+		docker := &fakeDocker{}
+		l := newLauncher(docker, LauncherConfig{
+			NodeContainer: func(n *types.Node) string { return "dvb-rehearsal-validator-1" },
+		})
+
+		require.NoError(t, l.Start(context.Background(), node))
+		assert.Equal(t, container.NetworkMode("container:dvb-rehearsal-validator-1"), docker.hostConfig.NetworkMode)
+
+		// Inside the node's container the node listens on the default ports
+		config, err := os.ReadFile(filepath.Join(home, ConfigDir, "tmkms.toml"))
+		require.NoError(t, err)
+		assert.Contains(t, string(config), `addr = "tcp://127.0.0.1:26659"`)
+	})
+
+	t.Run("external signer", func(t *testing.T) {
+		docker := &fakeDocker{}
+		l := newLauncher(docker, LauncherConfig{})
+		external := *node
+		external.Spec.RemoteSigner = &types.RemoteSigner{Index: 1}
+
+		require.NoError(t, l.Start(context.Background(), &external))
+		require.NoError(t, l.Stop(context.Background(), &external))
+		assert.Empty(t, docker.created)
+		assert.Empty(t, docker.removed)
+	})
+}
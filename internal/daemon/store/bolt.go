@@ -20,13 +20,20 @@ var (
 	bucketUpgrades     = []byte("upgrades")
 	bucketTransactions = []byte("transactions")
 	bucketMeta         = []byte("meta")
+	bucketNamespaces   = []byte("namespaces")
+	bucketAllocations  = []byte("allocations")
+	bucketEvents       = []byte("events")
 )
 
 // BoltStore implements Store using BoltDB.
 type BoltStore struct {
-	db       *bolt.DB
-	watchers map[string][]WatchHandler
-	mu       sync.RWMutex
+	db          *bolt.DB
+	watchers    map[string]map[int]WatchHandler
+	nextWatchID int
+	mu          sync.RWMutex
+
+	// eventRetention is how many events are kept per devnet (0 = default).
+	eventRetention int
 }
 
 // NewBoltStore creates a new BoltDB-backed store.
@@ -46,6 +53,9 @@ func NewBoltStore(path string) (*BoltStore, error) {
 			bucketUpgrades,
 			bucketTransactions,
 			bucketMeta,
+			bucketNamespaces,
+			bucketAllocations,
+			bucketEvents,
 		}
 		for _, bucket := range buckets {
 			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
@@ -61,7 +71,7 @@ func NewBoltStore(path string) (*BoltStore, error) {
 
 	return &BoltStore{
 		db:       db,
-		watchers: make(map[string][]WatchHandler),
+		watchers: make(map[string]map[int]WatchHandler),
 	}, nil
 }
 
@@ -73,20 +83,30 @@ func (s *BoltStore) Close() error {
 // notify sends events to registered watchers.
 func (s *BoltStore) notify(resourceType, eventType string, resource interface{}) {
 	s.mu.RLock()
-	handlers := s.watchers[resourceType]
-	s.mu.RUnlock()
+	defer s.mu.RUnlock()
 
-	for _, h := range handlers {
+	for _, h := range s.watchers[resourceType] {
 		go h(eventType, resource)
 	}
 }
 
-// Watch registers a handler for resource changes.
+// Watch registers a handler for resource changes until ctx is cancelled.
 func (s *BoltStore) Watch(ctx context.Context, resourceType string, handler WatchHandler) error {
 	s.mu.Lock()
-	s.watchers[resourceType] = append(s.watchers[resourceType], handler)
+	id := s.nextWatchID
+	s.nextWatchID++
+	if s.watchers[resourceType] == nil {
+		s.watchers[resourceType] = make(map[int]WatchHandler)
+	}
+	s.watchers[resourceType][id] = handler
 	s.mu.Unlock()
 
+	defer func() {
+		s.mu.Lock()
+		delete(s.watchers[resourceType], id)
+		s.mu.Unlock()
+	}()
+
 	// Send initial list as ADDED events
 	if resourceType == "devnets" {
 		devnets, err := s.ListDevnets(ctx, "") // empty namespace = all
@@ -0,0 +1,43 @@
+// internal/daemon/store/bolt_allocation.go
+package store
+
+import (
+	"context"
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// LoadAllocations returns the allocation table saved under table, or nil if
+// none has been saved yet.
+func (s *BoltStore) LoadAllocations(ctx context.Context, table string) (map[string]string, error) {
+	var allocations map[string]string
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(bucketAllocations).Get([]byte(table))
+		if data == nil {
+			return nil
+		}
+		return decode(data, &allocations)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s allocations: %w", table, err)
+	}
+	return allocations, nil
+}
+
+// SaveAllocations replaces the allocation table saved under table.
+func (s *BoltStore) SaveAllocations(ctx context.Context, table string, allocations map[string]string) error {
+	if allocations == nil {
+		allocations = map[string]string{}
+	}
+	data, err := encode(allocations)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s allocations: %w", table, err)
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(bucketAllocations).Put([]byte(table), data); err != nil {
+			return fmt.Errorf("failed to store %s allocations: %w", table, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,41 @@
+// internal/daemon/store/bolt_allocation_test.go
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoltStore_Allocations(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	store, err := NewBoltStore(dbPath)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	table, err := store.LoadAllocations(ctx, "subnets")
+	require.NoError(t, err)
+	assert.Nil(t, table, "unsaved tables are nil")
+
+	require.NoError(t, store.SaveAllocations(ctx, "subnets", map[string]string{"42": "default/alpha"}))
+	require.NoError(t, store.SaveAllocations(ctx, "ports", map[string]string{}))
+	require.NoError(t, store.Close())
+
+	// Tables survive reopening the database
+	store, err = NewBoltStore(dbPath)
+	require.NoError(t, err)
+	defer store.Close()
+
+	table, err = store.LoadAllocations(ctx, "subnets")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"42": "default/alpha"}, table)
+
+	table, err = store.LoadAllocations(ctx, "ports")
+	require.NoError(t, err)
+	assert.NotNil(t, table)
+	assert.Empty(t, table)
+}
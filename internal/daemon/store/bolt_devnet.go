@@ -36,6 +36,10 @@ func (s *BoltStore) CreateDevnet(ctx context.Context, devnet *Devnet) error {
 		devnet.Metadata.CreatedAt = now
 		devnet.Metadata.UpdatedAt = now
 
+		if err := s.recordEvents(tx, key, nil, devnet); err != nil {
+			return err
+		}
+
 		data, err := encode(devnet)
 		if err != nil {
 			return fmt.Errorf("failed to encode devnet: %w", err)
@@ -108,6 +112,10 @@ func (s *BoltStore) UpdateDevnet(ctx context.Context, devnet *Devnet) error {
 		devnet.Metadata.Generation++
 		devnet.Metadata.UpdatedAt = time.Now()
 
+		if err := s.recordEvents(tx, key, old.Status.Events, devnet); err != nil {
+			return err
+		}
+
 		data, err := encode(devnet)
 		if err != nil {
 			return fmt.Errorf("failed to encode devnet: %w", err)
@@ -144,6 +152,9 @@ func (s *BoltStore) DeleteDevnet(ctx context.Context, namespace, name string) er
 			return err
 		}
 
+		if err := deleteEvents(tx, key); err != nil {
+			return fmt.Errorf("failed to delete events: %w", err)
+		}
 		return b.Delete(key)
 	})
 	if err != nil {
@@ -189,16 +200,23 @@ func (s *BoltStore) ListDevnets(ctx context.Context, namespace string) ([]*Devne
 	return devnets, nil
 }
 
-// ListNamespaces returns a sorted list of all unique namespaces.
+// ListNamespaces returns a sorted list of all unique namespaces, both
+// created ones and those holding devnets.
 func (s *BoltStore) ListNamespaces(ctx context.Context) ([]string, error) {
 	namespaceSet := make(map[string]struct{})
 
 	err := s.db.View(func(tx *bolt.Tx) error {
 		b := tx.Bucket(bucketDevnets)
-		return b.ForEach(func(k, v []byte) error {
+		if err := b.ForEach(func(k, v []byte) error {
 			ns, _ := parseDevnetKey(k)
 			namespaceSet[ns] = struct{}{}
 			return nil
+		}); err != nil {
+			return err
+		}
+		return tx.Bucket(bucketNamespaces).ForEach(func(k, v []byte) error {
+			namespaceSet[string(k)] = struct{}{}
+			return nil
 		})
 	})
 	if err != nil {
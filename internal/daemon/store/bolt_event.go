@@ -0,0 +1,133 @@
+// internal/daemon/store/bolt_event.go
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+// SetEventRetention sets how many events are kept per devnet.
+func (s *BoltStore) SetEventRetention(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.eventRetention = n
+}
+
+func (s *BoltStore) eventRetentionLimit() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.eventRetention <= 0 {
+		return DefaultEventRetention
+	}
+	return s.eventRetention
+}
+
+// ListEvents returns the recorded events of a devnet, oldest first.
+func (s *BoltStore) ListEvents(ctx context.Context, namespace, devnetName string, opts ListEventsOptions) ([]types.Event, error) {
+	var events []types.Event
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketEvents).Bucket(devnetKey(namespace, devnetName))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var event types.Event
+			if err := decode(v, &event); err != nil {
+				return err
+			}
+			events = append(events, event)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	return filterEvents(events, opts), nil
+}
+
+// recordEvents appends the events added to devnet's status since previous
+// to the devnet's event history, drops history beyond the retention limit,
+// and trims the status to its most recent events.
+func (s *BoltStore) recordEvents(tx *bolt.Tx, key []byte, previous []types.Event, devnet *Devnet) error {
+	added := types.AddedEvents(previous, devnet.Status.Events)
+	devnet.Status.Events = types.RecentEvents(devnet.Status.Events)
+	if len(added) == 0 {
+		return nil
+	}
+
+	b, err := tx.Bucket(bucketEvents).CreateBucketIfNotExists(key)
+	if err != nil {
+		return fmt.Errorf("failed to create event bucket: %w", err)
+	}
+	for _, event := range added {
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		data, err := encode(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode event: %w", err)
+		}
+		if err := b.Put(eventKey(seq), data); err != nil {
+			return fmt.Errorf("failed to store event: %w", err)
+		}
+	}
+
+	// Keys are sequential, so the oldest events are first.
+	first, _ := b.Cursor().First()
+	excess := int(b.Sequence()-binary.BigEndian.Uint64(first)+1) - s.eventRetentionLimit()
+	if excess <= 0 {
+		return nil
+	}
+	var expired [][]byte
+	c := b.Cursor()
+	for k, _ := c.First(); k != nil && len(expired) < excess; k, _ = c.Next() {
+		expired = append(expired, append([]byte(nil), k...))
+	}
+	for _, k := range expired {
+		if err := b.Delete(k); err != nil {
+			return fmt.Errorf("failed to expire event: %w", err)
+		}
+	}
+	return nil
+}
+
+// deleteEvents removes the event history of the devnet stored under key.
+func deleteEvents(tx *bolt.Tx, key []byte) error {
+	b := tx.Bucket(bucketEvents)
+	if b.Bucket(key) == nil {
+		return nil
+	}
+	return b.DeleteBucket(key)
+}
+
+// eventKey encodes an event sequence number so keys sort chronologically.
+func eventKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
+
+// filterEvents applies opts to events, which are oldest first.
+func filterEvents(events []types.Event, opts ListEventsOptions) []types.Event {
+	result := make([]types.Event, 0, len(events))
+	for _, event := range events {
+		if !opts.Since.IsZero() && event.Timestamp.Before(opts.Since) {
+			continue
+		}
+		if opts.Type != "" && event.Type != opts.Type {
+			continue
+		}
+		result = append(result, event)
+	}
+	if opts.Limit > 0 && len(result) > opts.Limit {
+		result = result[len(result)-opts.Limit:]
+	}
+	return result
+}
@@ -0,0 +1,117 @@
+// internal/daemon/store/bolt_event_test.go
+package store
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoltStore_EventHistory(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	store, err := NewBoltStore(dbPath)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	devnet := &Devnet{Metadata: types.ResourceMeta{Name: "alpha"}}
+	devnet.Status.Events = []types.Event{types.NewEvent(types.EventTypeNormal, "Created", "created", "test")}
+	require.NoError(t, store.CreateDevnet(ctx, devnet))
+
+	// Append events over several updates, as the controller does
+	base := time.Now()
+	for i := 0; i < 15; i++ {
+		devnet, err = store.GetDevnet(ctx, "", "alpha")
+		require.NoError(t, err)
+		eventType := types.EventTypeNormal
+		if i%5 == 0 {
+			eventType = types.EventTypeWarning
+		}
+		devnet.Status.Events = append(devnet.Status.Events, types.Event{
+			Timestamp: base.Add(time.Duration(i) * time.Minute),
+			Type:      eventType,
+			Reason:    fmt.Sprintf("R%d", i),
+		})
+		require.NoError(t, store.UpdateDevnet(ctx, devnet))
+	}
+
+	devnet, err = store.GetDevnet(ctx, "", "alpha")
+	require.NoError(t, err)
+	assert.Len(t, devnet.Status.Events, types.MaxStatusEvents, "status keeps only recent events")
+	assert.Equal(t, "R14", devnet.Status.Events[types.MaxStatusEvents-1].Reason)
+
+	require.NoError(t, store.Close())
+
+	// History survives reopening the database
+	store, err = NewBoltStore(dbPath)
+	require.NoError(t, err)
+	defer store.Close()
+
+	events, err := store.ListEvents(ctx, "", "alpha", ListEventsOptions{})
+	require.NoError(t, err)
+	require.Len(t, events, 16)
+	assert.Equal(t, "Created", events[0].Reason)
+	assert.Equal(t, "R14", events[15].Reason)
+
+	events, err = store.ListEvents(ctx, "default", "alpha", ListEventsOptions{Type: types.EventTypeWarning})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, "R0", events[0].Reason)
+
+	events, err = store.ListEvents(ctx, "", "alpha", ListEventsOptions{Since: base.Add(12 * time.Minute)})
+	require.NoError(t, err)
+	assert.Len(t, events, 3)
+
+	events, err = store.ListEvents(ctx, "", "alpha", ListEventsOptions{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "R13", events[0].Reason)
+
+	// Retention drops the oldest events as new ones are recorded
+	store.SetEventRetention(5)
+	devnet, err = store.GetDevnet(ctx, "", "alpha")
+	require.NoError(t, err)
+	devnet.Status.Events = append(devnet.Status.Events, types.NewEvent(types.EventTypeNormal, "R15", "", "test"))
+	require.NoError(t, store.UpdateDevnet(ctx, devnet))
+
+	events, err = store.ListEvents(ctx, "", "alpha", ListEventsOptions{})
+	require.NoError(t, err)
+	require.Len(t, events, 5)
+	assert.Equal(t, "R11", events[0].Reason)
+
+	// Deleting the devnet deletes its history
+	require.NoError(t, store.DeleteDevnet(ctx, "", "alpha"))
+	events, err = store.ListEvents(ctx, "", "alpha", ListEventsOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestMemoryStore_EventHistory(t *testing.T) {
+	store := NewMemoryStore()
+	store.SetEventRetention(3)
+	ctx := context.Background()
+
+	devnet := &Devnet{Metadata: types.ResourceMeta{Name: "alpha"}}
+	require.NoError(t, store.CreateDevnet(ctx, devnet))
+	for i := 0; i < 5; i++ {
+		devnet, err := store.GetDevnet(ctx, "", "alpha")
+		require.NoError(t, err)
+		devnet.Status.Events = append(devnet.Status.Events, types.NewEvent(types.EventTypeNormal, fmt.Sprintf("R%d", i), "", "test"))
+		require.NoError(t, store.UpdateDevnet(ctx, devnet))
+	}
+
+	events, err := store.ListEvents(ctx, "", "alpha", ListEventsOptions{})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, "R2", events[0].Reason)
+
+	require.NoError(t, store.DeleteDevnet(ctx, "", "alpha"))
+	events, err = store.ListEvents(ctx, "", "alpha", ListEventsOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
@@ -0,0 +1,85 @@
+// internal/daemon/store/bolt_namespace.go
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// CreateNamespace creates a namespace record.
+func (s *BoltStore) CreateNamespace(ctx context.Context, ns *Namespace) error {
+	if ns == nil {
+		return fmt.Errorf("namespace cannot be nil")
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketNamespaces)
+		key := []byte(ns.Name)
+
+		if b.Get(key) != nil {
+			return &AlreadyExistsError{Resource: "namespace", Name: ns.Name}
+		}
+
+		ns.CreatedAt = time.Now()
+
+		data, err := encode(ns)
+		if err != nil {
+			return fmt.Errorf("failed to encode namespace: %w", err)
+		}
+
+		if err := b.Put(key, data); err != nil {
+			return fmt.Errorf("failed to store namespace: %w", err)
+		}
+
+		s.notify("namespaces", "ADDED", ns)
+		return nil
+	})
+}
+
+// GetNamespace retrieves a namespace record by name.
+func (s *BoltStore) GetNamespace(ctx context.Context, name string) (*Namespace, error) {
+	var ns Namespace
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(bucketNamespaces).Get([]byte(name))
+		if data == nil {
+			return &NotFoundError{Resource: "namespace", Name: name}
+		}
+		return decode(data, &ns)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ns, nil
+}
+
+// DeleteNamespace deletes a namespace record. Devnets in the namespace are
+// not affected.
+func (s *BoltStore) DeleteNamespace(ctx context.Context, name string) error {
+	var ns Namespace
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketNamespaces)
+		key := []byte(name)
+
+		data := b.Get(key)
+		if data == nil {
+			return &NotFoundError{Resource: "namespace", Name: name}
+		}
+		if err := decode(data, &ns); err != nil {
+			return err
+		}
+
+		return b.Delete(key)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.notify("namespaces", "DELETED", &ns)
+	return nil
+}
@@ -0,0 +1,59 @@
+// internal/daemon/store/bolt_namespace_test.go
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+func TestBoltStore_Namespace(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewBoltStore(filepath.Join(dir, "test.db"))
+	if err != nil {
+		t.Fatalf("NewBoltStore: %v", err)
+	}
+	defer s.Close()
+	ctx := context.Background()
+
+	ns := &types.Namespace{Name: "team-a", Quota: types.NamespaceQuota{MaxDevnets: 2, MaxNodes: 8}}
+	if err := s.CreateNamespace(ctx, ns); err != nil {
+		t.Fatalf("CreateNamespace: %v", err)
+	}
+	if err := s.CreateNamespace(ctx, &types.Namespace{Name: "team-a"}); !IsAlreadyExists(err) {
+		t.Errorf("expected AlreadyExists, got %v", err)
+	}
+
+	got, err := s.GetNamespace(ctx, "team-a")
+	if err != nil {
+		t.Fatalf("GetNamespace: %v", err)
+	}
+	if got.Quota != ns.Quota || got.CreatedAt.IsZero() {
+		t.Errorf("unexpected namespace: %+v", got)
+	}
+
+	// Created namespaces are listed alongside namespaces holding devnets.
+	if err := s.CreateDevnet(ctx, &types.Devnet{Metadata: types.ResourceMeta{Name: "d", Namespace: "team-b"}}); err != nil {
+		t.Fatalf("CreateDevnet: %v", err)
+	}
+	names, err := s.ListNamespaces(ctx)
+	if err != nil {
+		t.Fatalf("ListNamespaces: %v", err)
+	}
+	if want := []string{"team-a", "team-b"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("ListNamespaces = %v, want %v", names, want)
+	}
+
+	if err := s.DeleteNamespace(ctx, "team-a"); err != nil {
+		t.Fatalf("DeleteNamespace: %v", err)
+	}
+	if _, err := s.GetNamespace(ctx, "team-a"); !IsNotFound(err) {
+		t.Errorf("expected NotFound after delete, got %v", err)
+	}
+	if err := s.DeleteNamespace(ctx, "team-a"); !IsNotFound(err) {
+		t.Errorf("expected NotFound deleting twice, got %v", err)
+	}
+}
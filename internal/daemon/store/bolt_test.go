@@ -164,3 +164,67 @@ func TestBoltStore_Watch(t *testing.T) {
 		t.Fatal("timeout waiting for watch event")
 	}
 }
+
+func TestBoltStore_WatchStopsOnCancel(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewBoltStore(filepath.Join(dir, "test.db"))
+	require.NoError(t, err)
+	defer store.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- store.Watch(ctx, "nodes", func(string, interface{}) {})
+	}()
+
+	require.Eventually(t, func() bool {
+		store.mu.RLock()
+		defer store.mu.RUnlock()
+		return len(store.watchers["nodes"]) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	assert.Empty(t, store.watchers["nodes"])
+}
+
+func TestBoltStore_SpecUpdateRetriesStatusConflict(t *testing.T) {
+	store, err := NewBoltStore(filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	defer store.Close()
+
+	ctx := context.Background()
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "race"},
+		Spec:     types.DevnetSpec{Plugin: "stable", Validators: 1},
+	}
+	require.NoError(t, store.CreateDevnet(ctx, devnet))
+
+	attempts := 0
+	err = RetryOnConflict(ctx, func() error {
+		attempts++
+		current, err := store.GetDevnet(ctx, "", "race")
+		if err != nil {
+			return err
+		}
+		if attempts == 1 {
+			// A controller writes status between our read and write
+			racer, err := store.GetDevnet(ctx, "", "race")
+			require.NoError(t, err)
+			racer.Status.Phase = "Running"
+			require.NoError(t, store.UpdateDevnet(ctx, racer))
+		}
+		current.Spec.Validators = 3
+		return store.UpdateDevnet(ctx, current)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+
+	got, err := store.GetDevnet(ctx, "", "race")
+	require.NoError(t, err)
+	assert.Equal(t, 3, got.Spec.Validators)
+	assert.Equal(t, "Running", got.Status.Phase, "the concurrent status write is kept")
+}
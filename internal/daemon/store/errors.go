@@ -2,6 +2,7 @@
 package store
 
 import (
+	"context"
 	"errors"
 	"fmt"
 )
@@ -66,3 +67,24 @@ func IsAlreadyExists(err error) bool {
 	var alreadyExists *AlreadyExistsError
 	return errors.As(err, &alreadyExists)
 }
+
+// conflictRetries is how many times RetryOnConflict runs fn.
+const conflictRetries = 5
+
+// RetryOnConflict runs fn, which should re-read a resource, modify it and
+// update it, until it succeeds without a *ConflictError. Spec updates use it
+// so a controller writing status between the read and the write does not
+// fail the request; fn must re-apply its change to the fresh copy. The last
+// conflict is returned if every attempt conflicts.
+func RetryOnConflict(ctx context.Context, fn func() error) error {
+	var err error
+	for attempt := 0; attempt < conflictRetries; attempt++ {
+		if err = fn(); !IsConflict(err) {
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+	}
+	return err
+}
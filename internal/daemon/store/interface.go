@@ -3,6 +3,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
 )
@@ -13,6 +14,7 @@ type (
 	Node        = types.Node
 	Upgrade     = types.Upgrade
 	Transaction = types.Transaction
+	Namespace   = types.Namespace
 )
 
 // WatchHandler is called when a resource changes.
@@ -28,6 +30,16 @@ type ListTxOptions struct {
 	Limit int
 }
 
+// ListEventsOptions configures event history listing.
+type ListEventsOptions struct {
+	// Since limits results to events at or after this time.
+	Since time.Time
+	// Type filters by event type (Normal, Warning).
+	Type string
+	// Limit is the maximum number of results, keeping the most recent.
+	Limit int
+}
+
 // Store defines the interface for resource persistence.
 type Store interface {
 	// Devnet operations - namespace-scoped
@@ -36,7 +48,12 @@ type Store interface {
 	UpdateDevnet(ctx context.Context, devnet *Devnet) error
 	DeleteDevnet(ctx context.Context, namespace, name string) error
 	ListDevnets(ctx context.Context, namespace string) ([]*Devnet, error) // empty namespace = all
-	ListNamespaces(ctx context.Context) ([]string, error)                 // list all namespaces
+	ListNamespaces(ctx context.Context) ([]string, error)                 // created namespaces and those holding devnets
+
+	// Namespace operations - records of explicitly created namespaces
+	CreateNamespace(ctx context.Context, ns *Namespace) error
+	GetNamespace(ctx context.Context, name string) (*Namespace, error)
+	DeleteNamespace(ctx context.Context, name string) error
 
 	// Node operations - namespace-scoped
 	CreateNode(ctx context.Context, node *Node) error
@@ -66,3 +83,33 @@ type Store interface {
 	// Close closes the store.
 	Close() error
 }
+
+// AllocationStore persists the subnet and host port allocation tables, so
+// they are written in the same crash-safe database as the resources they
+// belong to. BoltStore and MemoryStore implement it.
+type AllocationStore interface {
+	// LoadAllocations returns the table saved under name, or nil if it
+	// has never been saved.
+	LoadAllocations(ctx context.Context, table string) (map[string]string, error)
+
+	// SaveAllocations replaces the table saved under name.
+	SaveAllocations(ctx context.Context, table string, allocations map[string]string) error
+}
+
+// DefaultEventRetention is how many events EventStore keeps per devnet.
+const DefaultEventRetention = 1000
+
+// EventStore keeps the event history of devnets. Events appended to a
+// devnet's status are recorded when the devnet is written, and the status
+// itself is trimmed to types.MaxStatusEvents. History survives daemon
+// restarts and is removed with the devnet. BoltStore and MemoryStore
+// implement it.
+type EventStore interface {
+	// ListEvents returns a devnet's recorded events, oldest first.
+	ListEvents(ctx context.Context, namespace, devnetName string, opts ListEventsOptions) ([]types.Event, error)
+
+	// SetEventRetention sets how many events are kept per devnet; older
+	// events are dropped as new ones are recorded. n <= 0 restores
+	// DefaultEventRetention.
+	SetEventRetention(n int)
+}
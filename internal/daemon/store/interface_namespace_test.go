@@ -40,6 +40,12 @@ func (m *mockNamespaceStore) ListNamespaces(ctx context.Context) ([]string, erro
 	return nil, nil
 }
 
+func (m *mockNamespaceStore) CreateNamespace(ctx context.Context, ns *Namespace) error { return nil }
+func (m *mockNamespaceStore) GetNamespace(ctx context.Context, name string) (*Namespace, error) {
+	return nil, nil
+}
+func (m *mockNamespaceStore) DeleteNamespace(ctx context.Context, name string) error { return nil }
+
 // Node operations - namespace-scoped
 func (m *mockNamespaceStore) CreateNode(ctx context.Context, node *Node) error {
 	return nil
@@ -73,6 +73,12 @@ func (m *mockStore) ListNamespaces(ctx context.Context) ([]string, error) {
 	return nil, nil
 }
 
+func (m *mockStore) CreateNamespace(ctx context.Context, ns *Namespace) error { return nil }
+func (m *mockStore) GetNamespace(ctx context.Context, name string) (*Namespace, error) {
+	return nil, nil
+}
+func (m *mockStore) DeleteNamespace(ctx context.Context, name string) error { return nil }
+
 func (m *mockStore) Watch(ctx context.Context, resourceType string, handler WatchHandler) error {
 	return nil
 }
@@ -91,6 +97,36 @@ func TestConflictError(t *testing.T) {
 	assert.True(t, IsConflict(err))
 }
 
+func TestRetryOnConflict(t *testing.T) {
+	ctx := context.Background()
+
+	attempts := 0
+	err := RetryOnConflict(ctx, func() error {
+		attempts++
+		if attempts < 3 {
+			return &ConflictError{Resource: "devnet", Name: "test"}
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+
+	// Other errors are returned immediately
+	attempts = 0
+	err = RetryOnConflict(ctx, func() error {
+		attempts++
+		return &NotFoundError{Resource: "devnet", Name: "test"}
+	})
+	assert.True(t, IsNotFound(err))
+	assert.Equal(t, 1, attempts)
+
+	// Persistent conflicts give up
+	err = RetryOnConflict(ctx, func() error {
+		return &ConflictError{Resource: "devnet", Name: "test"}
+	})
+	assert.True(t, IsConflict(err))
+}
+
 func TestAlreadyExistsError(t *testing.T) {
 	err := &AlreadyExistsError{Resource: "devnet", Name: "test"}
 	assert.Contains(t, err.Error(), "devnet")
@@ -16,7 +16,16 @@ type MemoryStore struct {
 	nodes        map[string]*types.Node        // key: "namespace/devnetName/index"
 	upgrades     map[string]*types.Upgrade     // key: "namespace/name"
 	transactions map[string]*types.Transaction // key: global unique name
+	namespaces   map[string]*types.Namespace   // key: name
+	allocations  map[string]map[string]string  // key: table
+	events       map[string][]types.Event      // key: "namespace/name"
 	mu           sync.RWMutex
+
+	eventRetention int
+
+	watchers    map[string]map[int]WatchHandler
+	nextWatchID int
+	watchMu     sync.Mutex
 }
 
 // NewMemoryStore creates a new in-memory store.
@@ -26,6 +35,10 @@ func NewMemoryStore() *MemoryStore {
 		nodes:        make(map[string]*types.Node),
 		upgrades:     make(map[string]*types.Upgrade),
 		transactions: make(map[string]*types.Transaction),
+		namespaces:   make(map[string]*types.Namespace),
+		allocations:  make(map[string]map[string]string),
+		events:       make(map[string][]types.Event),
+		watchers:     make(map[string]map[int]WatchHandler),
 	}
 }
 
@@ -66,9 +79,12 @@ func (m *MemoryStore) CreateDevnet(ctx context.Context, devnet *types.Devnet) er
 		return ErrAlreadyExists
 	}
 
+	m.recordEvents(key, nil, devnet)
+
 	// Deep copy to avoid mutation
 	copy := *devnet
 	m.devnets[key] = &copy
+	m.notify("devnets", "ADDED", copy)
 	return nil
 }
 
@@ -101,12 +117,16 @@ func (m *MemoryStore) UpdateDevnet(ctx context.Context, devnet *types.Devnet) er
 	devnet.Metadata.EnsureNamespace()
 
 	key := memoryDevnetKey(devnet.Metadata.Namespace, devnet.Metadata.Name)
-	if _, exists := m.devnets[key]; !exists {
+	old, exists := m.devnets[key]
+	if !exists {
 		return ErrNotFound
 	}
 
+	m.recordEvents(key, old.Status.Events, devnet)
+
 	copy := *devnet
 	m.devnets[key] = &copy
+	m.notify("devnets", "MODIFIED", copy)
 	return nil
 }
 
@@ -120,11 +140,14 @@ func (m *MemoryStore) DeleteDevnet(ctx context.Context, namespace, name string)
 	}
 
 	key := memoryDevnetKey(namespace, name)
-	if _, exists := m.devnets[key]; !exists {
+	devnet, exists := m.devnets[key]
+	if !exists {
 		return ErrNotFound
 	}
 
 	delete(m.devnets, key)
+	delete(m.events, key)
+	m.notify("devnets", "DELETED", *devnet)
 	return nil
 }
 
@@ -152,7 +175,8 @@ func (m *MemoryStore) ListDevnets(ctx context.Context, namespace string) ([]*typ
 	return result, nil
 }
 
-// ListNamespaces returns a sorted list of all unique namespaces.
+// ListNamespaces returns a sorted list of all unique namespaces, both
+// created ones and those holding devnets.
 func (m *MemoryStore) ListNamespaces(ctx context.Context) ([]string, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -165,6 +189,9 @@ func (m *MemoryStore) ListNamespaces(ctx context.Context) ([]string, error) {
 		}
 		namespaceSet[ns] = struct{}{}
 	}
+	for name := range m.namespaces {
+		namespaceSet[name] = struct{}{}
+	}
 
 	// Convert to sorted slice
 	namespaces := make([]string, 0, len(namespaceSet))
@@ -176,6 +203,47 @@ func (m *MemoryStore) ListNamespaces(ctx context.Context) ([]string, error) {
 	return namespaces, nil
 }
 
+// CreateNamespace creates a namespace record.
+func (m *MemoryStore) CreateNamespace(ctx context.Context, ns *types.Namespace) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.namespaces[ns.Name]; exists {
+		return ErrAlreadyExists
+	}
+
+	copy := *ns
+	m.namespaces[ns.Name] = &copy
+	return nil
+}
+
+// GetNamespace retrieves a namespace record by name.
+func (m *MemoryStore) GetNamespace(ctx context.Context, name string) (*types.Namespace, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ns, exists := m.namespaces[name]
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	copy := *ns
+	return &copy, nil
+}
+
+// DeleteNamespace deletes a namespace record.
+func (m *MemoryStore) DeleteNamespace(ctx context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.namespaces[name]; !exists {
+		return ErrNotFound
+	}
+
+	delete(m.namespaces, name)
+	return nil
+}
+
 // CreateNode creates a new node.
 func (m *MemoryStore) CreateNode(ctx context.Context, node *types.Node) error {
 	m.mu.Lock()
@@ -191,6 +259,7 @@ func (m *MemoryStore) CreateNode(ctx context.Context, node *types.Node) error {
 
 	copy := *node
 	m.nodes[key] = &copy
+	m.notify("nodes", "ADDED", copy)
 	return nil
 }
 
@@ -228,6 +297,7 @@ func (m *MemoryStore) UpdateNode(ctx context.Context, node *types.Node) error {
 
 	copy := *node
 	m.nodes[key] = &copy
+	m.notify("nodes", "MODIFIED", copy)
 	return nil
 }
 
@@ -241,11 +311,13 @@ func (m *MemoryStore) DeleteNode(ctx context.Context, namespace, devnetName stri
 	}
 
 	key := memoryNodeKey(namespace, devnetName, index)
-	if _, exists := m.nodes[key]; !exists {
+	node, exists := m.nodes[key]
+	if !exists {
 		return ErrNotFound
 	}
 
 	delete(m.nodes, key)
+	m.notify("nodes", "DELETED", *node)
 	return nil
 }
 
@@ -288,6 +360,7 @@ func (m *MemoryStore) DeleteNodesByDevnet(ctx context.Context, namespace, devnet
 		}
 		if ns == namespace && node.Spec.DevnetRef == devnetName {
 			delete(m.nodes, key)
+			m.notify("nodes", "DELETED", *node)
 		}
 	}
 	return nil
@@ -308,6 +381,7 @@ func (m *MemoryStore) CreateUpgrade(ctx context.Context, upgrade *types.Upgrade)
 
 	copy := *upgrade
 	m.upgrades[key] = &copy
+	m.notify("upgrades", "ADDED", copy)
 	return nil
 }
 
@@ -345,6 +419,7 @@ func (m *MemoryStore) UpdateUpgrade(ctx context.Context, upgrade *types.Upgrade)
 
 	copy := *upgrade
 	m.upgrades[key] = &copy
+	m.notify("upgrades", "MODIFIED", copy)
 	return nil
 }
 
@@ -358,11 +433,13 @@ func (m *MemoryStore) DeleteUpgrade(ctx context.Context, namespace, name string)
 	}
 
 	key := memoryUpgradeKey(namespace, name)
-	if _, exists := m.upgrades[key]; !exists {
+	upgrade, exists := m.upgrades[key]
+	if !exists {
 		return ErrNotFound
 	}
 
 	delete(m.upgrades, key)
+	m.notify("upgrades", "DELETED", *upgrade)
 	return nil
 }
 
@@ -412,6 +489,7 @@ func (m *MemoryStore) DeleteUpgradesByDevnet(ctx context.Context, namespace, dev
 		}
 		if ns == namespace && upgrade.Spec.DevnetRef == devnetName {
 			delete(m.upgrades, name)
+			m.notify("upgrades", "DELETED", *upgrade)
 		}
 	}
 	return nil
@@ -506,9 +584,41 @@ func (m *MemoryStore) ListTransactions(ctx context.Context, namespace, devnetNam
 	return result, nil
 }
 
-// Watch watches for resource changes (not implemented for memory store).
+// notify sends a copy of a changed resource to registered watchers.
+func (m *MemoryStore) notify(resourceType, eventType string, resource interface{}) {
+	m.watchMu.Lock()
+	defer m.watchMu.Unlock()
+
+	for _, h := range m.watchers[resourceType] {
+		switch r := resource.(type) {
+		case types.Devnet:
+			go h(eventType, &r)
+		case types.Node:
+			go h(eventType, &r)
+		case types.Upgrade:
+			go h(eventType, &r)
+		}
+	}
+}
+
+// Watch registers a handler for resource changes until ctx is cancelled.
+// Only devnet, node and upgrade changes are reported.
 func (m *MemoryStore) Watch(ctx context.Context, resourceType string, handler WatchHandler) error {
-	// Not implemented for memory store
+	m.watchMu.Lock()
+	id := m.nextWatchID
+	m.nextWatchID++
+	if m.watchers[resourceType] == nil {
+		m.watchers[resourceType] = make(map[int]WatchHandler)
+	}
+	m.watchers[resourceType][id] = handler
+	m.watchMu.Unlock()
+
+	defer func() {
+		m.watchMu.Lock()
+		delete(m.watchers[resourceType], id)
+		m.watchMu.Unlock()
+	}()
+
 	<-ctx.Done()
 	return ctx.Err()
 }
@@ -520,3 +630,68 @@ func (m *MemoryStore) Close() error {
 
 // Ensure MemoryStore implements Store.
 var _ Store = (*MemoryStore)(nil)
+
+// LoadAllocations returns a copy of the allocation table, or nil if it has
+// never been saved.
+func (s *MemoryStore) LoadAllocations(ctx context.Context, table string) (map[string]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	saved, ok := s.allocations[table]
+	if !ok {
+		return nil, nil
+	}
+	result := make(map[string]string, len(saved))
+	for k, v := range saved {
+		result[k] = v
+	}
+	return result, nil
+}
+
+// SaveAllocations replaces the allocation table with a copy of allocations.
+func (s *MemoryStore) SaveAllocations(ctx context.Context, table string, allocations map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	saved := make(map[string]string, len(allocations))
+	for k, v := range allocations {
+		saved[k] = v
+	}
+	s.allocations[table] = saved
+	return nil
+}
+
+// SetEventRetention sets how many events are kept per devnet.
+func (s *MemoryStore) SetEventRetention(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.eventRetention = n
+}
+
+// ListEvents returns the recorded events of a devnet, oldest first.
+func (s *MemoryStore) ListEvents(ctx context.Context, namespace, devnetName string, opts ListEventsOptions) ([]types.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return filterEvents(s.events[memoryDevnetKey(namespace, devnetName)], opts), nil
+}
+
+// recordEvents appends the events added to devnet's status since previous
+// to its history and trims the status. The caller holds s.mu.
+func (s *MemoryStore) recordEvents(key string, previous []types.Event, devnet *types.Devnet) {
+	added := types.AddedEvents(previous, devnet.Status.Events)
+	devnet.Status.Events = types.RecentEvents(devnet.Status.Events)
+	if len(added) == 0 {
+		return
+	}
+
+	retention := s.eventRetention
+	if retention <= 0 {
+		retention = DefaultEventRetention
+	}
+	events := append(s.events[key], added...)
+	if len(events) > retention {
+		events = append([]types.Event(nil), events[len(events)-retention:]...)
+	}
+	s.events[key] = events
+}
@@ -0,0 +1,105 @@
+package subnet
+
+import (
+	"fmt"
+	"net"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// aliasRequired reports whether loopback addresses other than 127.0.0.1
+// must be added to the loopback interface before they can be bound. Linux
+// routes all of 127.0.0.0/8 to lo; macOS only answers on 127.0.0.1.
+var aliasRequired = runtime.GOOS == "darwin"
+
+// canBind reports whether a TCP listener can be opened on ip.
+var canBind = func(ip string) bool {
+	ln, err := net.Listen("tcp", net.JoinHostPort(ip, "0"))
+	if err != nil {
+		return false
+	}
+	ln.Close()
+	return true
+}
+
+// ifconfig runs ifconfig with the given arguments.
+var ifconfig = func(args ...string) error {
+	out, err := exec.Command("ifconfig", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("ifconfig %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// AliasError reports loopback addresses that could not be aliased.
+type AliasError struct {
+	// Addresses are the node addresses that are still missing.
+	Addresses []string
+
+	// Err is the first ifconfig failure.
+	Err error
+}
+
+func (e *AliasError) Error() string {
+	var fix []string
+	for _, addr := range e.Addresses {
+		fix = append(fix, "sudo ifconfig lo0 alias "+addr+" up")
+	}
+	return fmt.Sprintf("cannot add loopback aliases for %s: %v (run: %s)",
+		strings.Join(e.Addresses, ", "), e.Err, strings.Join(fix, "; "))
+}
+
+func (e *AliasError) Unwrap() error {
+	return e.Err
+}
+
+// EnsureAliases makes the addresses of the first count nodes in subnet
+// bindable, adding loopback aliases where the platform needs them. Addresses
+// that can already be bound are left alone. Adding aliases needs root; if it
+// fails, the returned *AliasError lists the commands to run by hand.
+func EnsureAliases(subnet uint8, count int) error {
+	if !aliasRequired {
+		return nil
+	}
+	aliasErr := &AliasError{}
+	for i := 0; i < count; i++ {
+		addr := NodeIP(subnet, i)
+		if canBind(addr) {
+			continue
+		}
+		if err := ifconfig("lo0", "alias", addr, "up"); err != nil {
+			aliasErr.Addresses = append(aliasErr.Addresses, addr)
+			if aliasErr.Err == nil {
+				aliasErr.Err = err
+			}
+		}
+	}
+	if len(aliasErr.Addresses) > 0 {
+		return aliasErr
+	}
+	return nil
+}
+
+// RemoveAliases removes the loopback aliases EnsureAliases added for the
+// first count nodes in subnet. Addresses that were never aliased are
+// skipped; errors are returned joined by address.
+func RemoveAliases(subnet uint8, count int) error {
+	if !aliasRequired {
+		return nil
+	}
+	var failed []string
+	for i := 0; i < count; i++ {
+		addr := NodeIP(subnet, i)
+		if !canBind(addr) {
+			continue
+		}
+		if err := ifconfig("lo0", "-alias", addr); err != nil {
+			failed = append(failed, err.Error())
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to remove loopback aliases: %s", strings.Join(failed, "; "))
+	}
+	return nil
+}
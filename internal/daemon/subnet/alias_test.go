@@ -0,0 +1,69 @@
+package subnet
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLoopback simulates a loopback interface that only answers on the
+// aliased addresses, recording ifconfig calls.
+func fakeLoopback(t *testing.T, ifconfigErr error) (aliased map[string]bool, calls *[]string) {
+	t.Helper()
+	oldRequired, oldBind, oldIfconfig := aliasRequired, canBind, ifconfig
+	t.Cleanup(func() { aliasRequired, canBind, ifconfig = oldRequired, oldBind, oldIfconfig })
+
+	aliased = map[string]bool{}
+	calls = &[]string{}
+	aliasRequired = true
+	canBind = func(ip string) bool { return aliased[ip] }
+	ifconfig = func(args ...string) error {
+		*calls = append(*calls, strings.Join(args, " "))
+		if ifconfigErr != nil {
+			return ifconfigErr
+		}
+		switch args[1] {
+		case "alias":
+			aliased[args[2]] = true
+		case "-alias":
+			delete(aliased, args[2])
+		}
+		return nil
+	}
+	return aliased, calls
+}
+
+func TestEnsureAliases_AddsMissing(t *testing.T) {
+	aliased, calls := fakeLoopback(t, nil)
+	aliased["127.0.42.1"] = true
+
+	require.NoError(t, EnsureAliases(42, 3))
+	assert.Equal(t, []string{"lo0 alias 127.0.42.2 up", "lo0 alias 127.0.42.3 up"}, *calls)
+
+	require.NoError(t, RemoveAliases(42, 3))
+	assert.Empty(t, aliased)
+}
+
+func TestEnsureAliases_PermissionDenied(t *testing.T) {
+	denied := errors.New("permission denied")
+	fakeLoopback(t, denied)
+
+	err := EnsureAliases(7, 2)
+	var aliasErr *AliasError
+	require.True(t, errors.As(err, &aliasErr))
+	assert.Equal(t, []string{"127.0.7.1", "127.0.7.2"}, aliasErr.Addresses)
+	assert.ErrorIs(t, err, denied)
+	assert.Contains(t, err.Error(), "sudo ifconfig lo0 alias 127.0.7.2 up")
+}
+
+func TestEnsureAliases_NotRequired(t *testing.T) {
+	_, calls := fakeLoopback(t, nil)
+	aliasRequired = false
+
+	require.NoError(t, EnsureAliases(1, 4))
+	require.NoError(t, RemoveAliases(1, 4))
+	assert.Empty(t, *calls)
+}
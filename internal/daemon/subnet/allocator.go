@@ -2,6 +2,7 @@
 package subnet
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
@@ -20,10 +21,21 @@ const maxSubnet uint8 = 254
 // gets a unique subnet in the 127.0.X.0/24 range for loopback aliasing.
 type Allocator struct {
 	path        string
+	backend     Backend
 	allocations map[uint8]string // subnet -> "namespace/devnetName"
 	mu          sync.RWMutex
 }
 
+// Table is the name the allocations are saved under in a Backend.
+const Table = "subnets"
+
+// Backend persists the allocation table transactionally. The daemon state
+// store implements it.
+type Backend interface {
+	LoadAllocations(ctx context.Context, table string) (map[string]string, error)
+	SaveAllocations(ctx context.Context, table string, allocations map[string]string) error
+}
+
 // persistedState represents the JSON structure for persistence.
 type persistedState struct {
 	Allocations map[string]string `json:"allocations"` // subnet (as string) -> "namespace/devnetName"
@@ -59,17 +71,69 @@ func LoadOrCreate(path string) (*Allocator, error) {
 	if err := json.Unmarshal(data, &state); err != nil {
 		return nil, fmt.Errorf("failed to parse allocator file: %w", err)
 	}
+	if err := a.load(state.Allocations); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Open loads the allocator from backend. The first time, when backend has no
+// table yet, allocations are imported from the JSON file at legacyPath if it
+// exists, and the file is renamed to legacyPath+".migrated".
+func Open(backend Backend, legacyPath string) (*Allocator, error) {
+	ctx := context.Background()
+	table, err := backend.LoadAllocations(ctx, Table)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil && legacyPath != "" {
+		if _, statErr := os.Stat(legacyPath); statErr == nil {
+			legacy, err := LoadOrCreate(legacyPath)
+			if err != nil {
+				return nil, err
+			}
+			table = legacy.table()
+		}
+	}
 
-	// Convert string keys to uint8
-	for subnetStr, devnetKey := range state.Allocations {
+	a := &Allocator{
+		backend:     backend,
+		allocations: make(map[uint8]string),
+	}
+	if err := a.load(table); err != nil {
+		return nil, err
+	}
+	if err := a.save(); err != nil {
+		return nil, fmt.Errorf("failed to initialize allocator: %w", err)
+	}
+	if table != nil && legacyPath != "" {
+		if err := os.Rename(legacyPath, legacyPath+".migrated"); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to retire migrated allocator file: %w", err)
+		}
+	}
+	return a, nil
+}
+
+// load replaces the allocations with a persisted table.
+func (a *Allocator) load(table map[string]string) error {
+	for subnetStr, devnetKey := range table {
 		var subnet uint8
 		if _, err := fmt.Sscanf(subnetStr, "%d", &subnet); err != nil {
-			return nil, fmt.Errorf("invalid subnet key %q: %w", subnetStr, err)
+			return fmt.Errorf("invalid subnet key %q: %w", subnetStr, err)
 		}
 		a.allocations[subnet] = devnetKey
 	}
+	return nil
+}
 
-	return a, nil
+// table returns the allocations in their persisted form.
+func (a *Allocator) table() map[string]string {
+	table := make(map[string]string, len(a.allocations))
+	for subnet, key := range a.allocations {
+		table[fmt.Sprintf("%d", subnet)] = key
+	}
+	return table
 }
 
 // devnetKey returns the canonical key for a devnet.
@@ -174,14 +238,15 @@ func (a *Allocator) GetSubnet(namespace, devnetName string) (uint8, bool) {
 	return 0, false
 }
 
-// save persists the current allocations to the JSON file.
+// save persists the current allocations to the backend, or to the JSON
+// file for a file-based allocator.
 func (a *Allocator) save() error {
-	state := persistedState{
-		Allocations: make(map[string]string),
+	if a.backend != nil {
+		return a.backend.SaveAllocations(context.Background(), Table, a.table())
 	}
 
-	for subnet, key := range a.allocations {
-		state.Allocations[fmt.Sprintf("%d", subnet)] = key
+	state := persistedState{
+		Allocations: a.table(),
 	}
 
 	data, err := json.MarshalIndent(state, "", "  ")
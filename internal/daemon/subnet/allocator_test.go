@@ -1,12 +1,14 @@
 package subnet
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"path/filepath"
 	"sync"
 	"testing"
 
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -433,3 +435,39 @@ func TestLoadOrCreate_InvalidSubnetKey(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid subnet key")
 }
+
+func TestOpen_MigratesLegacyFile(t *testing.T) {
+	legacyPath := filepath.Join(t.TempDir(), "subnets.json")
+	legacy, err := LoadOrCreate(legacyPath)
+	require.NoError(t, err)
+	subnet, err := legacy.Allocate("default", "old-devnet")
+	require.NoError(t, err)
+
+	backend := store.NewMemoryStore()
+	a, err := Open(backend, legacyPath)
+	require.NoError(t, err)
+	got, ok := a.GetSubnet("default", "old-devnet")
+	require.True(t, ok)
+	assert.Equal(t, subnet, got)
+	assert.NoFileExists(t, legacyPath)
+	assert.FileExists(t, legacyPath+".migrated")
+
+	// Later allocations go to the backend only
+	_, err = a.Allocate("default", "new-devnet")
+	require.NoError(t, err)
+	reopened, err := Open(backend, legacyPath)
+	require.NoError(t, err)
+	assert.Len(t, reopened.ListAllocations(), 2)
+	assert.NoFileExists(t, legacyPath)
+}
+
+func TestOpen_NoLegacyFile(t *testing.T) {
+	backend := store.NewMemoryStore()
+	a, err := Open(backend, filepath.Join(t.TempDir(), "subnets.json"))
+	require.NoError(t, err)
+	assert.Empty(t, a.ListAllocations())
+
+	table, err := backend.LoadAllocations(context.Background(), Table)
+	require.NoError(t, err)
+	assert.NotNil(t, table, "an empty table is saved so the migration runs once")
+}
@@ -244,7 +244,7 @@ func TestGovTransactionHelpers(t *testing.T) {
 			},
 		})
 
-		tx, err := c.SubmitGovProposal(ctx, "gov-test-devnet", "validator:0", "params", "Increase MaxValidators", "Test description", content)
+		tx, err := c.SubmitGovProposal(ctx, "gov-test-devnet", "validator:0", "params", "Increase MaxValidators", "Test description", "", content)
 		require.NoError(t, err)
 		assert.Equal(t, "gov/proposal", tx.TxType)
 		assert.Equal(t, "validator:0", tx.Signer)
@@ -0,0 +1,23 @@
+//go:build !windows
+
+package transport
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// defaultNetwork is the transport DefaultAddress uses.
+const defaultNetwork = "unix"
+
+// errNoPipes is returned for named pipe addresses off Windows.
+var errNoPipes = errors.New("named pipes are only supported on Windows")
+
+func listenPipe(address string) (net.Listener, error) {
+	return nil, errNoPipes
+}
+
+func dialPipe(ctx context.Context, address string) (net.Conn, error) {
+	return nil, errNoPipes
+}
@@ -0,0 +1,15 @@
+//go:build !windows
+
+package transport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListen_PipeUnsupported(t *testing.T) {
+	_, err := Listen(`\\.\pipe\devnetd`)
+	assert.ErrorIs(t, err, errNoPipes)
+	assert.Equal(t, "/data/devnetd.sock", DefaultAddress("/data"))
+}
@@ -0,0 +1,25 @@
+//go:build windows
+
+package transport
+
+import (
+	"context"
+	"net"
+
+	"github.com/Microsoft/go-winio"
+)
+
+// defaultNetwork is the transport DefaultAddress uses.
+const defaultNetwork = "pipe"
+
+// pipeSecurity grants the pipe's owner, SYSTEM and administrators full
+// access, like the 0600-style permissions of the Unix socket.
+const pipeSecurity = "D:P(A;;GA;;;OW)(A;;GA;;;SY)(A;;GA;;;BA)"
+
+func listenPipe(address string) (net.Listener, error) {
+	return winio.ListenPipe(address, &winio.PipeConfig{SecurityDescriptor: pipeSecurity})
+}
+
+func dialPipe(ctx context.Context, address string) (net.Conn, error) {
+	return winio.DialPipeContext(ctx, address)
+}
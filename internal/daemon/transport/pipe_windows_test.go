@@ -0,0 +1,21 @@
+//go:build windows
+
+package transport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultAddress_Pipe(t *testing.T) {
+	assert.True(t, strings.HasPrefix(DefaultAddress(`C:\Users\me\.devnet-builder`), PipePrefix))
+}
+
+func TestListen_Pipe(t *testing.T) {
+	address := pipeName(t.TempDir())
+
+	peer := roundTrip(t, address)
+	assert.True(t, IsLocal(peer), "named pipe peers are local")
+}
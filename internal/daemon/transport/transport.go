@@ -0,0 +1,157 @@
+// Package transport connects dvb to the local devnetd. The daemon's socket
+// address is one of:
+//
+//   - a file path: a Unix domain socket (the default on Linux and macOS)
+//   - \\.\pipe\<name>: a Windows named pipe (the default on Windows)
+//   - tcp://127.0.0.1:<port>: a loopback TCP port, for setups where neither
+//     reaches the daemon, e.g. a Windows dvb talking to devnetd in WSL2
+//
+// Connections on any of these are local and trusted without an API key; the
+// TCP form only listens on loopback addresses for that reason.
+package transport
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// PipePrefix starts Windows named pipe addresses.
+	PipePrefix = `\\.\pipe\`
+
+	// TCPPrefix starts loopback TCP addresses.
+	TCPPrefix = "tcp://"
+)
+
+// Network returns the transport an address uses: "unix", "pipe" or "tcp".
+func Network(address string) string {
+	switch {
+	case strings.HasPrefix(strings.ToLower(address), strings.ToLower(PipePrefix)):
+		return "pipe"
+	case strings.HasPrefix(address, TCPPrefix):
+		return "tcp"
+	default:
+		return "unix"
+	}
+}
+
+// DefaultAddress returns the daemon's default socket address for a data
+// directory: devnetd.sock inside it, or on Windows a named pipe derived
+// from it, so daemons with different data directories don't collide.
+func DefaultAddress(dataDir string) string {
+	if defaultNetwork == "pipe" {
+		return pipeName(dataDir)
+	}
+	return filepath.Join(dataDir, "devnetd.sock")
+}
+
+// pipeName returns the named pipe for a data directory.
+func pipeName(dataDir string) string {
+	h := fnv.New32a()
+	h.Write([]byte(strings.ToLower(filepath.Clean(dataDir))))
+	return fmt.Sprintf(`%sdevnetd-%08x`, PipePrefix, h.Sum32())
+}
+
+// Listen listens on a daemon socket address, replacing a stale Unix socket
+// file left by a previous daemon.
+func Listen(address string) (net.Listener, error) {
+	switch Network(address) {
+	case "pipe":
+		return listenPipe(address)
+	case "tcp":
+		return listenLoopback(strings.TrimPrefix(address, TCPPrefix))
+	default:
+		os.Remove(address)
+		return net.Listen("unix", address)
+	}
+}
+
+// Dial connects to a daemon socket address.
+func Dial(ctx context.Context, address string) (net.Conn, error) {
+	switch Network(address) {
+	case "pipe":
+		return dialPipe(ctx, address)
+	case "tcp":
+		var d net.Dialer
+		return d.DialContext(ctx, "tcp", strings.TrimPrefix(address, TCPPrefix))
+	default:
+		var d net.Dialer
+		return d.DialContext(ctx, "unix", address)
+	}
+}
+
+// Cleanup removes what Listen leaves behind once the listener is closed:
+// the socket file for Unix sockets, nothing otherwise.
+func Cleanup(address string) {
+	if Network(address) == "unix" {
+		os.Remove(address)
+	}
+}
+
+// IsLocal reports whether a peer address belongs to a connection accepted
+// by a Listen listener.
+func IsLocal(addr net.Addr) bool {
+	if addr == nil {
+		return false
+	}
+	if _, ok := addr.(loopbackAddr); ok {
+		return true
+	}
+	switch addr.Network() {
+	case "unix", "pipe":
+		return true
+	}
+	// Unix socket peers are often unnamed ("@" or empty)
+	s := addr.String()
+	return strings.HasPrefix(s, "@") || strings.HasPrefix(s, "/")
+}
+
+// listenLoopback listens on a loopback TCP address. Connections it accepts
+// report a loopbackAddr peer, so IsLocal can tell them from connections on
+// the daemon's TLS listener.
+func listenLoopback(hostPort string) (net.Listener, error) {
+	host, _, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TCP socket address %q: %w", hostPort, err)
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return nil, fmt.Errorf("TCP socket address %q must be a loopback address", hostPort)
+	}
+	ln, err := net.Listen("tcp", hostPort)
+	if err != nil {
+		return nil, err
+	}
+	return loopbackListener{ln}, nil
+}
+
+// loopbackListener marks the connections it accepts as local.
+type loopbackListener struct {
+	net.Listener
+}
+
+func (l loopbackListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return loopbackConn{conn}, nil
+}
+
+// loopbackConn is a connection accepted by a loopbackListener.
+type loopbackConn struct {
+	net.Conn
+}
+
+func (c loopbackConn) RemoteAddr() net.Addr {
+	return loopbackAddr{c.Conn.RemoteAddr()}
+}
+
+// loopbackAddr is the peer address of a loopbackConn.
+type loopbackAddr struct {
+	net.Addr
+}
@@ -0,0 +1,95 @@
+package transport
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetwork(t *testing.T) {
+	assert.Equal(t, "unix", Network("/home/me/.devnet-builder/devnetd.sock"))
+	assert.Equal(t, "pipe", Network(`\\.\pipe\devnetd`))
+	assert.Equal(t, "pipe", Network(`\\.\PIPE\devnetd`))
+	assert.Equal(t, "tcp", Network("tcp://127.0.0.1:7777"))
+}
+
+func TestPipeName_PerDataDir(t *testing.T) {
+	a := pipeName(`C:\Users\me\.devnet-builder`)
+	assert.Regexp(t, `^\\\\\.\\pipe\\devnetd-[0-9a-f]{8}$`, a)
+	assert.Equal(t, a, pipeName(`c:\users\me\.devnet-builder`))
+	assert.NotEqual(t, a, pipeName(`D:\devnets`))
+}
+
+// roundTrip listens on address, dials it, and returns the peer address the
+// listener saw.
+func roundTrip(t *testing.T, address string) net.Addr {
+	t.Helper()
+	ln, err := Listen(address)
+	require.NoError(t, err)
+	defer ln.Close()
+
+	accepted := make(chan net.Addr, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			accepted <- nil
+			return
+		}
+		accepted <- conn.RemoteAddr()
+		conn.Close()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := Dial(ctx, address)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	select {
+	case addr := <-accepted:
+		require.NotNil(t, addr)
+		return addr
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection was not accepted")
+		return nil
+	}
+}
+
+func TestListen_Loopback(t *testing.T) {
+	// Find a free port
+	probe, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := TCPPrefix + probe.Addr().String()
+	probe.Close()
+
+	peer := roundTrip(t, address)
+	assert.True(t, IsLocal(peer), "loopback transport peers are local")
+
+	// A plain TCP peer, as seen on the TLS listener, is not
+	assert.False(t, IsLocal(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 5000}))
+}
+
+func TestListen_LoopbackOnly(t *testing.T) {
+	_, err := Listen("tcp://0.0.0.0:0")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must be a loopback address")
+}
+
+func TestListen_Unix(t *testing.T) {
+	address := filepath.Join(t.TempDir(), "devnetd.sock")
+
+	peer := roundTrip(t, address)
+	assert.True(t, IsLocal(peer))
+
+	// A stale socket file from a previous daemon is replaced
+	ln, err := Listen(address)
+	require.NoError(t, err)
+	ln.Close()
+	Cleanup(address)
+	assert.NoFileExists(t, address)
+}
@@ -0,0 +1,313 @@
+// internal/daemon/txtrace/txtrace.go
+
+// Package txtrace looks a transaction up on a devnet's nodes and decodes it
+// for inspection: messages, events and gas, and on EVM chains the EVM call
+// trace.
+package txtrace
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/cometrpc"
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"github.com/altuslabsxyz/devnet-builder/pkg/network/cosmos"
+)
+
+// ErrNotFound is returned when no node has the transaction.
+var ErrNotFound = errors.New("transaction not found")
+
+// Node is a devnet node to look the transaction up on.
+type Node struct {
+	Index int
+	// RPCURL is the CometBFT RPC URL (e.g., "http://127.0.0.1:26657").
+	RPCURL string
+	// EVMRPCURL is the EVM JSON-RPC URL, used for EVM call traces.
+	EVMRPCURL string
+}
+
+// NodeResult is the outcome of looking the transaction up on one node.
+type NodeResult struct {
+	Index  int
+	Found  bool
+	Height int64
+	// Error is set when the node could not be queried.
+	Error string
+}
+
+// Attribute is an event attribute.
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+// Event is an event emitted by the transaction.
+type Event struct {
+	Type       string
+	Attributes []Attribute
+}
+
+// Trace is an inspected transaction.
+type Trace struct {
+	Hash      string
+	Height    int64
+	Code      uint32
+	Codespace string
+	Log       string
+	GasWanted int64
+	GasUsed   int64
+
+	// Tx is the decoded transaction, or nil if it could not be decoded.
+	Tx          *network.DecodedTx
+	DecodeError string
+
+	Events []Event
+	Nodes  []NodeResult
+
+	// EVMTxHash is the Ethereum hash of an EVM transaction.
+	EVMTxHash string
+	// EVMTrace is the callTracer output of debug_traceTransaction, when
+	// requested.
+	EVMTrace      json.RawMessage
+	EVMTraceError string
+}
+
+// Config configures a Tracer.
+type Config struct {
+	Nodes []Node
+
+	// Decoder decodes transaction bytes. Defaults to cosmos.DecodeTx, which
+	// knows the standard SDK modules.
+	Decoder func(txBytes []byte) (*network.DecodedTx, error)
+
+	// HTTPClient is used for queries. Defaults to a client with a 10s timeout.
+	HTTPClient *http.Client
+}
+
+// Tracer looks up and decodes transactions.
+type Tracer struct {
+	config Config
+	client *http.Client
+}
+
+// NewTracer creates a new Tracer.
+func NewTracer(cfg Config) *Tracer {
+	if cfg.Decoder == nil {
+		cfg.Decoder = cosmos.DecodeTx
+	}
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Tracer{config: cfg, client: httpClient}
+}
+
+// Trace looks the transaction up on every node and decodes it from the
+// first node that has it. The hash is a CometBFT transaction hash, or a
+// 0x-prefixed Ethereum hash on EVM chains. With evmTrace, the EVM call trace
+// is included for EVM transactions. If no node has the transaction, Trace
+// returns ErrNotFound with a Trace holding only the per-node results.
+func (t *Tracer) Trace(ctx context.Context, hash string, evmTrace bool) (*Trace, error) {
+	ethHash := ""
+	if strings.HasPrefix(hash, "0x") || strings.HasPrefix(hash, "0X") {
+		ethHash = "0x" + strings.ToLower(hash[2:])
+	}
+	hexHash := strings.ToUpper(strings.TrimPrefix(strings.TrimPrefix(hash, "0x"), "0X"))
+	if b, err := hex.DecodeString(hexHash); err != nil || len(b) != 32 {
+		return nil, fmt.Errorf("invalid transaction hash %q: want 64 hex characters", hash)
+	}
+
+	var (
+		found     *rpcTx
+		foundNode Node
+		nodes     = make([]NodeResult, 0, len(t.config.Nodes))
+	)
+	for _, node := range t.config.Nodes {
+		res := NodeResult{Index: node.Index}
+		tx, err := t.lookup(ctx, node, hexHash, ethHash)
+		switch {
+		case errors.Is(err, ErrNotFound):
+		case err != nil:
+			res.Error = err.Error()
+		default:
+			res.Found = true
+			res.Height, _ = strconv.ParseInt(tx.Height, 10, 64)
+			if found == nil {
+				found, foundNode = tx, node
+			}
+		}
+		nodes = append(nodes, res)
+	}
+	if found == nil {
+		return &Trace{Hash: hexHash, Nodes: nodes}, ErrNotFound
+	}
+
+	trace := found.toTrace()
+	trace.Nodes = nodes
+	if tx, err := t.config.Decoder(found.Tx); err != nil {
+		trace.DecodeError = err.Error()
+	} else {
+		trace.Tx = tx
+	}
+
+	trace.EVMTxHash = evmTxHash(trace.Events)
+	if trace.EVMTxHash == "" {
+		trace.EVMTxHash = ethHash
+	}
+	if evmTrace {
+		switch {
+		case trace.EVMTxHash == "":
+			trace.EVMTraceError = "transaction has no EVM execution"
+		case foundNode.EVMRPCURL == "":
+			trace.EVMTraceError = "node has no EVM JSON-RPC endpoint"
+		default:
+			result, err := t.traceEVM(ctx, foundNode.EVMRPCURL, trace.EVMTxHash)
+			if err != nil {
+				trace.EVMTraceError = err.Error()
+			} else {
+				trace.EVMTrace = result
+			}
+		}
+	}
+	return trace, nil
+}
+
+// rpcTx is a transaction as returned by CometBFT's tx and tx_search
+// endpoints.
+type rpcTx struct {
+	Hash     string `json:"hash"`
+	Height   string `json:"height"`
+	TxResult struct {
+		Code      uint32 `json:"code"`
+		Codespace string `json:"codespace"`
+		Log       string `json:"log"`
+		GasWanted string `json:"gas_wanted"`
+		GasUsed   string `json:"gas_used"`
+		Events    []struct {
+			Type       string `json:"type"`
+			Attributes []struct {
+				Key   string `json:"key"`
+				Value string `json:"value"`
+			} `json:"attributes"`
+		} `json:"events"`
+	} `json:"tx_result"`
+	Tx []byte `json:"tx"`
+}
+
+func (r *rpcTx) toTrace() *Trace {
+	trace := &Trace{
+		Hash:      r.Hash,
+		Code:      r.TxResult.Code,
+		Codespace: r.TxResult.Codespace,
+		Log:       r.TxResult.Log,
+	}
+	trace.Height, _ = strconv.ParseInt(r.Height, 10, 64)
+	trace.GasWanted, _ = strconv.ParseInt(r.TxResult.GasWanted, 10, 64)
+	trace.GasUsed, _ = strconv.ParseInt(r.TxResult.GasUsed, 10, 64)
+	for _, e := range r.TxResult.Events {
+		event := Event{Type: e.Type}
+		for _, a := range e.Attributes {
+			event.Attributes = append(event.Attributes, Attribute{Key: a.Key, Value: a.Value})
+		}
+		trace.Events = append(trace.Events, event)
+	}
+	return trace
+}
+
+// lookup finds the transaction on a node by hash, and for an Ethereum hash
+// also by the ethereum_tx event the EVM module indexes.
+func (t *Tracer) lookup(ctx context.Context, node Node, hexHash, ethHash string) (*rpcTx, error) {
+	var tx rpcTx
+	err := t.rpcGet(ctx, node.RPCURL, "/tx?hash=0x"+hexHash, &tx)
+	if !errors.Is(err, ErrNotFound) || ethHash == "" {
+		if err != nil {
+			return nil, err
+		}
+		return &tx, nil
+	}
+
+	query := url.Values{}
+	query.Set("query", fmt.Sprintf(`"ethereum_tx.ethereumTxHash='%s'"`, ethHash))
+	var search struct {
+		Txs []rpcTx `json:"txs"`
+	}
+	if err := t.rpcGet(ctx, node.RPCURL, "/tx_search?"+query.Encode(), &search); err != nil {
+		return nil, err
+	}
+	if len(search.Txs) == 0 {
+		return nil, ErrNotFound
+	}
+	return &search.Txs[0], nil
+}
+
+// rpcGet calls a CometBFT RPC endpoint and decodes its result, returning
+// ErrNotFound for a transaction the node does not have.
+func (t *Tracer) rpcGet(ctx context.Context, rpcURL, path string, out any) error {
+	err := cometrpc.Get(ctx, t.client, rpcURL, path, out)
+	var rpcErr *cometrpc.Error
+	if errors.As(err, &rpcErr) && strings.Contains(rpcErr.Data, "not found") {
+		return ErrNotFound
+	}
+	return err
+}
+
+// evmTxHash returns the Ethereum hash the EVM module records for an EVM
+// transaction, or "" for other transactions.
+func evmTxHash(events []Event) string {
+	for _, e := range events {
+		if e.Type != "ethereum_tx" {
+			continue
+		}
+		for _, a := range e.Attributes {
+			if a.Key == "ethereumTxHash" {
+				return a.Value
+			}
+		}
+	}
+	return ""
+}
+
+// traceEVM runs debug_traceTransaction with the call tracer. The node must
+// enable the debug JSON-RPC namespace.
+func (t *Tracer) traceEVM(ctx context.Context, evmRPCURL, ethHash string) (json.RawMessage, error) {
+	payload, _ := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "debug_traceTransaction",
+		"params":  []any{ethHash, map[string]string{"tracer": "callTracer"}},
+	})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, evmRPCURL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("EVM JSON-RPC request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var rpcResp struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return nil, fmt.Errorf("HTTP %d: failed to decode EVM JSON-RPC response: %w", resp.StatusCode, err)
+	}
+	if rpcResp.Error != nil {
+		return nil, fmt.Errorf("debug_traceTransaction: %s", rpcResp.Error.Message)
+	}
+	return rpcResp.Result, nil
+}
@@ -0,0 +1,143 @@
+// internal/daemon/txtrace/txtrace_test.go
+package txtrace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	cosmosHash = "A1B2C3D4E5F60718293A4B5C6D7E8F90A1B2C3D4E5F60718293A4B5C6D7E8F90"
+	ethHash    = "0x1111111111111111111111111111111111111111111111111111111111111111"
+)
+
+// txResult is a CometBFT tx result for an EVM transaction.
+const txResult = `{"hash":"` + cosmosHash + `","height":"42","tx":"dHg=",
+	"tx_result":{"code":0,"log":"","gas_wanted":"200000","gas_used":"81234","events":[
+		{"type":"message","attributes":[{"key":"action","value":"/cosmos.evm.vm.v1.MsgEthereumTx"}]},
+		{"type":"ethereum_tx","attributes":[{"key":"ethereumTxHash","value":"` + ethHash + `"}]}]}}`
+
+// newFakeNode serves CometBFT tx lookups, knowing the transaction only if
+// hasTx, and an EVM JSON-RPC debug_traceTransaction.
+func newFakeNode(t *testing.T, hasTx bool) *httptest.Server {
+	t.Helper()
+	notFound := `{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"Internal error","data":"tx (` + cosmosHash + `) not found"}}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tx":
+			if !hasTx || r.URL.Query().Get("hash") != "0x"+cosmosHash {
+				fmt.Fprint(w, notFound)
+				return
+			}
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":%s}`, txResult)
+		case "/tx_search":
+			assert.Equal(t, `"ethereum_tx.ethereumTxHash='`+ethHash+`'"`, r.URL.Query().Get("query"))
+			if !hasTx {
+				fmt.Fprint(w, `{"jsonrpc":"2.0","id":-1,"result":{"txs":[],"total_count":"0"}}`)
+				return
+			}
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"txs":[%s],"total_count":"1"}}`, txResult)
+		case "/evm":
+			body, _ := io.ReadAll(r.Body)
+			assert.Contains(t, string(body), `"method":"debug_traceTransaction"`)
+			assert.Contains(t, string(body), `"callTracer"`)
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":{"type":"CALL","gasUsed":"0x5208"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func decodeStub(txBytes []byte) (*network.DecodedTx, error) {
+	if string(txBytes) != "tx" {
+		return nil, fmt.Errorf("unexpected tx bytes %q", txBytes)
+	}
+	return &network.DecodedTx{Messages: []network.DecodedMsg{{TypeURL: "/cosmos.evm.vm.v1.MsgEthereumTx"}}, GasLimit: 200000}, nil
+}
+
+func newTestTracer(t *testing.T, nodes ...*httptest.Server) *Tracer {
+	cfg := Config{Decoder: decodeStub}
+	for i, srv := range nodes {
+		cfg.Nodes = append(cfg.Nodes, Node{Index: i, RPCURL: srv.URL, EVMRPCURL: srv.URL + "/evm"})
+	}
+	return NewTracer(cfg)
+}
+
+func TestTracer_Trace(t *testing.T) {
+	tracer := newTestTracer(t, newFakeNode(t, false), newFakeNode(t, true))
+
+	trace, err := tracer.Trace(context.Background(), strings.ToLower(cosmosHash), false)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), trace.Height)
+	assert.Equal(t, int64(81234), trace.GasUsed)
+	assert.Equal(t, int64(200000), trace.GasWanted)
+	require.NotNil(t, trace.Tx)
+	assert.Equal(t, "/cosmos.evm.vm.v1.MsgEthereumTx", trace.Tx.Messages[0].TypeURL)
+	require.Len(t, trace.Events, 2)
+	assert.Equal(t, Attribute{Key: "action", Value: "/cosmos.evm.vm.v1.MsgEthereumTx"}, trace.Events[0].Attributes[0])
+	assert.Equal(t, ethHash, trace.EVMTxHash)
+	assert.Nil(t, trace.EVMTrace, "EVM trace only on request")
+
+	assert.Equal(t, []NodeResult{{Index: 0}, {Index: 1, Found: true, Height: 42}}, trace.Nodes)
+}
+
+func TestTracer_TraceByEthHashWithEVMTrace(t *testing.T) {
+	tracer := newTestTracer(t, newFakeNode(t, true))
+
+	trace, err := tracer.Trace(context.Background(), ethHash, true)
+	require.NoError(t, err)
+	assert.Equal(t, cosmosHash, trace.Hash)
+	assert.Empty(t, trace.EVMTraceError)
+	assert.JSONEq(t, `{"type":"CALL","gasUsed":"0x5208"}`, string(trace.EVMTrace))
+}
+
+func TestTracer_TraceNotFound(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(down.Close)
+	tracer := newTestTracer(t, newFakeNode(t, false), down)
+
+	trace, err := tracer.Trace(context.Background(), cosmosHash, false)
+	assert.ErrorIs(t, err, ErrNotFound)
+	require.Len(t, trace.Nodes, 2)
+	assert.False(t, trace.Nodes[0].Found)
+	assert.Empty(t, trace.Nodes[0].Error)
+	assert.NotEmpty(t, trace.Nodes[1].Error, "unreachable node is reported")
+}
+
+func TestTracer_TraceDecodeError(t *testing.T) {
+	tracer := NewTracer(Config{
+		Nodes:   []Node{{RPCURL: newFakeNode(t, true).URL}},
+		Decoder: func([]byte) (*network.DecodedTx, error) { return nil, fmt.Errorf("unknown tx format") },
+	})
+
+	trace, err := tracer.Trace(context.Background(), cosmosHash, true)
+	require.NoError(t, err)
+	assert.Nil(t, trace.Tx)
+	assert.Equal(t, "unknown tx format", trace.DecodeError)
+	assert.Equal(t, "node has no EVM JSON-RPC endpoint", trace.EVMTraceError)
+}
+
+func TestTracer_TraceInvalidHash(t *testing.T) {
+	_, err := newTestTracer(t).Trace(context.Background(), "xyz", false)
+	assert.ErrorContains(t, err, "invalid transaction hash")
+}
+
+func TestEVMTxHash(t *testing.T) {
+	var events []Event
+	require.NoError(t, json.Unmarshal([]byte(`[{"Type":"transfer"}]`), &events))
+	assert.Empty(t, evmTxHash(events))
+}
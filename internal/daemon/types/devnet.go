@@ -1,7 +1,11 @@
 // internal/daemon/types/devnet.go
 package types
 
-import "time"
+import (
+	"time"
+
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
+)
 
 // Phase constants for Devnet.
 const (
@@ -33,6 +37,10 @@ type DevnetSpec struct {
 	// FullNodes is the number of non-validator full nodes.
 	FullNodes int `json:"fullNodes,omitempty"`
 
+	// Accounts is the number of deterministic test accounts funded in
+	// genesis. See package keys.
+	Accounts int `json:"accounts,omitempty"`
+
 	// Mode is the execution mode ("docker" or "local").
 	Mode string `json:"mode"`
 
@@ -56,16 +64,413 @@ type DevnetSpec struct {
 	// For forking, should match the source network's chain ID.
 	ChainID string `json:"chainId,omitempty"`
 
-	// Ports configures port allocation for nodes.
+	// Image is the Docker image nodes run in docker mode.
+	// Empty means the runtime's default image.
+	Image string `json:"image,omitempty"`
+
+	// Profile is an optional provisioning profile (e.g., "laptop") that tunes
+	// per-node resource usage. See LookupProfile.
+	Profile string `json:"profile,omitempty"`
+
+	// ForceBuild compiles the binary from source even when the requested
+	// version matches a published release with prebuilt binaries.
+	ForceBuild bool `json:"forceBuild,omitempty"`
+
+	// AllowUnverified uses a release binary even if its release publishes
+	// no checksum; otherwise the binary is built from source.
+	AllowUnverified bool `json:"allowUnverified,omitempty"`
+
+	// Offline provisions only from local caches (binaries, snapshots,
+	// genesis files and docker images), failing fast if anything is missing.
+	Offline bool `json:"offline,omitempty"`
+
+	// SkipDiskCheck provisions even when the disk space preflight estimates
+	// the disk can't hold the snapshot and node data.
+	SkipDiskCheck bool `json:"skipDiskCheck,omitempty"`
+
+	// TTL is how long the devnet lives (a Go duration, e.g. "4h") before the
+	// daemon stops it. Empty means forever.
+	TTL string `json:"ttl,omitempty"`
+
+	// DeleteOnExpiry deletes the devnet instead of stopping it when its TTL
+	// expires.
+	DeleteOnExpiry bool `json:"deleteOnExpiry,omitempty"`
+
+	// IdleTimeout is how long the devnet may go without RPC traffic or
+	// transactions (a Go duration, e.g. "30m") before the daemon stops it.
+	// Block production alone does not count as activity. Empty means never.
+	IdleTimeout string `json:"idleTimeout,omitempty"`
+
+	// BlockTime is the consensus timeout_commit of every node (a Go
+	// duration, e.g. "500ms"), which bounds how fast blocks are produced.
+	// Empty means the default of 1s.
+	BlockTime string `json:"blockTime,omitempty"`
+
+	// EpochDuration overrides the duration of every epoch of the epochs
+	// module in genesis (a Go duration, e.g. "1m"), so epoch-driven logic
+	// like inflation and rewards runs quickly. Empty keeps the genesis
+	// durations.
+	EpochDuration string `json:"epochDuration,omitempty"`
+
+	// GenesisOverrides maps dotted genesis paths (e.g.,
+	// "app_state.gov.params.voting_period") to JSON-encoded values merged
+	// into genesis after the plugin's patches. See package genesispatch.
+	GenesisOverrides map[string]string `json:"genesisOverrides,omitempty"`
+
+	// FundedAccounts are extra accounts funded in genesis alongside the
+	// validator accounts.
+	FundedAccounts []FundedAccount `json:"fundedAccounts,omitempty"`
+
+	// ValidatorKeys are operator-provided keys for validator slots, e.g.
+	// those of staging infrastructure. Other slots get generated keys.
+	ValidatorKeys []ValidatorKeys `json:"validatorKeys,omitempty"`
+
+	// RemoteSigners switch validator slots to an external signer: the node
+	// listens for it on priv_validator_laddr instead of signing with its
+	// own key file.
+	RemoteSigners []RemoteSigner `json:"remoteSigners,omitempty"`
+
+	// NodeConfigs are raw config.toml and app.toml snippets merged into
+	// individual nodes' config files after the plugin's config overrides.
+	NodeConfigs []NodeConfig `json:"nodeConfigs,omitempty"`
+
+	// Seed derives the devnet's validator and account mnemonics, its
+	// nodes' consensus and p2p keys, its default chain ID and its genesis
+	// time, so devnets created from the same seed are identical. Empty uses
+	// the fixed keys of package keys and lets init generate node keys.
+	// Imported validator keys take precedence.
+	Seed string `json:"seed,omitempty"`
+
+	// GenesisPrune selects state to drop from a forked genesis to shrink
+	// it. The zero value keeps everything.
+	GenesisPrune GenesisPrune `json:"genesisPrune,omitempty"`
+
+	// Ports is the devnet's port layout: base ports and the stride between
+	// nodes. Zero values use the defaults.
 	Ports PortConfig `json:"ports,omitempty"`
 
+	// BindAddress is the IP address nodes listen on, e.g. "0.0.0.0", "::"
+	// or an interface address, instead of their own loopback subnet
+	// address. Nodes then share one address, so the port layout's stride
+	// defaults to portalloc.BlockSize. In docker mode it is the host address
+	// container ports are published on.
+	BindAddress string `json:"bindAddress,omitempty"`
+
+	// Hosts are remote machines the devnet's nodes run on over SSH, node i
+	// on Hosts[i % len(Hosts)], to spread a local-mode devnet across
+	// machines. Remote nodes listen on all interfaces and are reached on
+	// their host's address, so the port layout's stride defaults to
+	// portalloc.BlockSize. Empty runs every node on the daemon's host.
+	Hosts []RemoteHost `json:"hosts,omitempty"`
+
 	// Resources configures resource limits for Docker mode.
 	Resources ResourceLimits `json:"resources,omitempty"`
 
+	// Webhooks are notified of this devnet's events, in addition to the
+	// webhooks in devnetd.toml. See package notify.
+	Webhooks []Webhook `json:"webhooks,omitempty"`
+
+	// Hooks are user commands run between phases of the devnet's
+	// lifecycle, e.g. to seed contracts once it is healthy. See package
+	// hooks.
+	Hooks Hooks `json:"hooks,omitempty"`
+
+	// Bootstrap deploys contracts once the devnet is first healthy, before
+	// its postHealthy hooks. See package bootstrap.
+	Bootstrap Bootstrap `json:"bootstrap,omitempty"`
+
+	// NetworkShaping emulates latency, loss and bandwidth limits between
+	// regions of docker-mode nodes. It is applied with 'dvb net shape
+	// apply'. See package netem.
+	NetworkShaping NetworkShaping `json:"networkShaping,omitempty"`
+
+	// VotingPower splits the stake of the validators injected into a
+	// forked genesis. The zero value gives every validator the same stake.
+	VotingPower VotingPower `json:"votingPower,omitempty"`
+
 	// Options are plugin-specific configuration options.
 	Options map[string]string `json:"options,omitempty"`
 }
 
+// ValidatorKeys are the keys a validator slot reuses instead of generating
+// its own.
+type ValidatorKeys struct {
+	// Index is the validator's index, from 0.
+	Index int `json:"index"`
+
+	// PrivValidatorKey is the contents of priv_validator_key.json, the
+	// consensus key. Empty means generate one.
+	PrivValidatorKey string `json:"privValidatorKey,omitempty"`
+
+	// NodeKey is the contents of node_key.json, the p2p identity. Empty
+	// means generate one.
+	NodeKey string `json:"nodeKey,omitempty"`
+}
+
+// RemoteSigner makes a validator sign through an external signer such as
+// tmkms, connected on the node's priv_validator_laddr.
+type RemoteSigner struct {
+	// Index is the validator's index, from 0.
+	Index int `json:"index"`
+
+	// TMKMS launches a tmkms softsign container holding the validator's
+	// consensus key. Without it, the operator connects their own signer.
+	TMKMS bool `json:"tmkms,omitempty"`
+
+	// Image is the tmkms image. Empty means signer.DefaultImage.
+	Image string `json:"image,omitempty"`
+
+	// ProtocolVersion is the tmkms protocol version of the chain's
+	// CometBFT ("v0.34", "v0.38", ...). Empty means v0.38.
+	ProtocolVersion string `json:"protocolVersion,omitempty"`
+}
+
+// RemoteHost is a machine nodes run on, reached over SSH with the daemon
+// user's ssh client configuration and agent.
+type RemoteHost struct {
+	// Name identifies the host in logs and errors.
+	Name string `json:"name"`
+
+	// Address is the host's IP address or DNS name, which the daemon and
+	// the other nodes reach the host's nodes on.
+	Address string `json:"address"`
+
+	// User is the SSH user. Empty uses the ssh client's default.
+	User string `json:"user,omitempty"`
+
+	// Port is the SSH port. Zero means 22.
+	Port int `json:"port,omitempty"`
+
+	// IdentityFile is the private key to authenticate with. Empty uses the
+	// ssh client's keys and agent.
+	IdentityFile string `json:"identityFile,omitempty"`
+
+	// Dir is the absolute directory node binaries, homes and logs are
+	// uploaded to. Empty means ~/.devnet-builder.
+	Dir string `json:"dir,omitempty"`
+}
+
+// HostFor returns the remote host the node at index runs on, or nil if the
+// devnet runs on the daemon's host.
+func (s DevnetSpec) HostFor(index int) *RemoteHost {
+	if len(s.Hosts) == 0 {
+		return nil
+	}
+	host := s.Hosts[index%len(s.Hosts)]
+	return &host
+}
+
+// Webhook receives JSON notifications of devnet events, such as phase
+// transitions, crashed nodes, finished upgrades and expiring TTLs.
+type Webhook struct {
+	// URL is the http(s) endpoint the notifications are POSTed to.
+	URL string `json:"url"`
+
+	// Format is the payload format: "slack", "discord" or "generic" (the
+	// default), which posts the full event.
+	Format string `json:"format,omitempty"`
+
+	// Events are the event types to send, e.g. "node.crashed". Empty means
+	// all of them.
+	Events []string `json:"events,omitempty"`
+
+	// Template is a Go text/template for the message text, executed with
+	// the notify.Event. Empty means the event's own message.
+	Template string `json:"template,omitempty"`
+}
+
+// Hooks are user commands run at points of a devnet's lifecycle, with the
+// devnet's chain ID, endpoints and node home directories in their
+// environment. Each list runs in order.
+type Hooks struct {
+	// PostFork run once genesis is forked, before the nodes are
+	// initialized. They may edit the forked genesis.
+	PostFork []Hook `json:"postFork,omitempty"`
+
+	// PreStart run once the nodes are initialized, before they start.
+	PreStart []Hook `json:"preStart,omitempty"`
+
+	// PostHealthy run once, the first time all nodes are healthy after
+	// provisioning.
+	PostHealthy []Hook `json:"postHealthy,omitempty"`
+}
+
+// Hook is a user command run at a point of a devnet's lifecycle.
+type Hook struct {
+	// Name identifies the hook in logs and events. Empty means its
+	// position, e.g. "postFork[0]".
+	Name string `json:"name,omitempty"`
+
+	// Command is a shell command, run with sh -c.
+	Command string `json:"command,omitempty"`
+
+	// Script is the path of an executable script on the daemon's host,
+	// run instead of Command.
+	Script string `json:"script,omitempty"`
+
+	// Image runs the hook in a container of this image, on the host
+	// network with the devnet's data directory mounted, instead of on the
+	// host.
+	Image string `json:"image,omitempty"`
+
+	// Timeout bounds the hook's run (a Go duration, e.g. "2m"). Empty means
+	// hooks.DefaultTimeout.
+	Timeout string `json:"timeout,omitempty"`
+
+	// OnFailure is what a failed hook does: "fail" (the default) fails
+	// provisioning, "ignore" logs the failure and carries on.
+	OnFailure string `json:"onFailure,omitempty"`
+}
+
+// Bootstrap lists the contracts deployed on a devnet the first time it is
+// healthy. Their addresses are written to bootstrap-output.json in the
+// devnet's data directory.
+type Bootstrap struct {
+	// GasPrice is the price CosmWasm transactions pay per unit of gas,
+	// e.g. "0.025stake". Empty means no fees.
+	GasPrice string `json:"gasPrice,omitempty"`
+
+	// Contracts are deployed in order, by the devnet's first validator.
+	Contracts []Contract `json:"contracts,omitempty"`
+}
+
+// Contract is a CosmWasm contract or EVM bytecode deployed by Bootstrap.
+type Contract struct {
+	// Name identifies the contract in bootstrap-output.json.
+	Name string `json:"name"`
+
+	// Type is "wasm" or "evm".
+	Type string `json:"type"`
+
+	// Source is the absolute path or http(s) URL of the contract: a .wasm
+	// file, or EVM bytecode as hex or a Hardhat/Foundry JSON artifact.
+	Source string `json:"source"`
+
+	// InitMsg is the JSON instantiate message of a CosmWasm contract.
+	// Empty means {}.
+	InitMsg string `json:"initMsg,omitempty"`
+
+	// Args are the hex ABI-encoded constructor arguments of an EVM
+	// contract, appended to its bytecode.
+	Args string `json:"args,omitempty"`
+
+	// Label is the CosmWasm contract's label. Empty means Name.
+	Label string `json:"label,omitempty"`
+
+	// Admin is the address allowed to migrate a CosmWasm contract. Empty
+	// means none.
+	Admin string `json:"admin,omitempty"`
+
+	// Funds are sent with the instantiation: coins like "100stake" for a
+	// CosmWasm contract, a value in wei for an EVM one.
+	Funds string `json:"funds,omitempty"`
+}
+
+// NetworkShaping labels nodes with regions and sets the network
+// conditions between them.
+type NetworkShaping struct {
+	// Regions maps node indexes to region labels. Unlabelled nodes are in
+	// the "default" region.
+	Regions map[int]string `json:"regions,omitempty"`
+
+	// Links are the conditions of the traffic between two regions, in both
+	// directions. Pairs of regions without a link are not shaped.
+	Links []NetworkLink `json:"links,omitempty"`
+
+	// Image is the image with tc that shaping runs in, in each node's
+	// network namespace. Empty means netem.DefaultImage.
+	Image string `json:"image,omitempty"`
+}
+
+// NetworkLink is the emulated network between two regions, or within one
+// when From and To are the same.
+type NetworkLink struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+
+	// Latency is the delay added to each packet (a Go duration, e.g.
+	// "100ms"), so round trips take twice as long.
+	Latency string `json:"latency,omitempty"`
+
+	// Jitter varies Latency by up to this much (a Go duration).
+	Jitter string `json:"jitter,omitempty"`
+
+	// Loss is the percentage of packets dropped, e.g. "1%".
+	Loss string `json:"loss,omitempty"`
+
+	// Rate limits the bandwidth in tc units, e.g. "10mbit".
+	Rate string `json:"rate,omitempty"`
+}
+
+// NodeConfig holds TOML snippets deep-merged into a node's config files
+// when it is provisioned, after the plugin's overrides, devnet-builder's
+// defaults and the profile.
+type NodeConfig struct {
+	// Index is the node's index, from 0: validators, then full nodes.
+	Index int `json:"index"`
+
+	// ConfigToml is merged into config.toml, e.g. "[mempool]\nsize = 10000".
+	ConfigToml string `json:"configToml,omitempty"`
+
+	// AppToml is merged into app.toml, e.g. `pruning = "everything"`.
+	AppToml string `json:"appToml,omitempty"`
+}
+
+// VotingPower splits the stake of a devnet's validators. See
+// genesispatch.SplitStake.
+type VotingPower struct {
+	// Profile is "equal", "proportional" to the source chain's largest
+	// bonded validators, in order, or "custom". Empty means equal.
+	Profile string `json:"profile,omitempty"`
+
+	// Weights is the relative power of each validator, by index, for the
+	// custom profile.
+	Weights []float64 `json:"weights,omitempty"`
+}
+
+// GenesisPrune selects state to drop from a forked genesis. See the
+// transforms of package genesispatch.
+type GenesisPrune struct {
+	// IBCPackets clears IBC packet commitments, receipts and
+	// acknowledgements.
+	IBCPackets bool `json:"ibcPackets,omitempty"`
+
+	// DustThreshold removes plain accounts holding less than this coin,
+	// e.g. "1000uatom". Empty keeps all accounts.
+	DustThreshold string `json:"dustThreshold,omitempty"`
+
+	// WasmCode drops CosmWasm code blobs and the contracts instantiated
+	// from them.
+	WasmCode bool `json:"wasmCode,omitempty"`
+}
+
+// FundedAccount is an account pre-funded in genesis.
+type FundedAccount struct {
+	// Address is a bech32 address, or a 0x address for EVM chains.
+	Address string `json:"address"`
+
+	// Coins is the balance, e.g. "1000000ustake,500uatom".
+	Coins string `json:"coins"`
+
+	// Vesting optionally locks part of the balance.
+	Vesting *VestingSchedule `json:"vesting,omitempty"`
+}
+
+// VestingSchedule locks part of a funded account's balance.
+type VestingSchedule struct {
+	// Type is "continuous" or "delayed".
+	Type string `json:"type"`
+
+	// Coins is the vesting portion of the balance. Empty means all of it.
+	Coins string `json:"coins,omitempty"`
+
+	// StartTime is when continuous vesting begins.
+	StartTime time.Time `json:"startTime,omitempty"`
+
+	// EndTime is when vesting completes.
+	EndTime time.Time `json:"endTime"`
+}
+
 // DevnetStatus defines the observed state of a Devnet.
 type DevnetStatus struct {
 	// Phase is the current lifecycle phase.
@@ -96,11 +501,28 @@ type DevnetStatus struct {
 	// Conditions represent the current conditions of the devnet.
 	Conditions []Condition `json:"conditions,omitempty"`
 
-	// Events are recent significant occurrences (max 10).
+	// Events are recent significant occurrences (max MaxStatusEvents). The
+	// store keeps the full history; see store.EventStore.
 	Events []Event `json:"events,omitempty"`
 
 	// Message provides additional status information.
 	Message string `json:"message,omitempty"`
+
+	// ExpiresAt is when the TTL expires. Zero if the devnet has no TTL.
+	ExpiresAt time.Time `json:"expiresAt,omitempty"`
+
+	// Image is the image docker-mode nodes run, as specified or the
+	// runtime's default.
+	Image string `json:"image,omitempty"`
+
+	// ImageDigest is the digest Image resolved to when the devnet was
+	// provisioned, e.g. "sha256:..."; every node is started from it.
+	ImageDigest string `json:"imageDigest,omitempty"`
+}
+
+// Expired reports whether the devnet has a TTL that expired by now.
+func (s DevnetStatus) Expired(now time.Time) bool {
+	return !s.ExpiresAt.IsZero() && !now.Before(s.ExpiresAt)
 }
 
 // SDKVersionChange records an SDK version change from an upgrade.
@@ -146,6 +568,26 @@ type PortConfig struct {
 
 	// BaseAPI is the starting REST API port (default: 1317).
 	BaseAPI int `json:"baseApi,omitempty"`
+
+	// BaseEVMRPC is the starting EVM JSON-RPC port (default: 8545).
+	BaseEVMRPC int `json:"baseEvmRpc,omitempty"`
+
+	// Stride is the distance between consecutive nodes' ports. Zero means
+	// every node uses the base ports on its own loopback address; docker
+	// nodes publish their ports portalloc.BlockSize apart instead.
+	Stride int `json:"stride,omitempty"`
+}
+
+// Layout returns the port layout described by the config.
+func (p PortConfig) Layout() dvbtypes.PortLayout {
+	return dvbtypes.PortLayout{
+		P2P:    p.BaseP2P,
+		RPC:    p.BaseRPC,
+		API:    p.BaseAPI,
+		GRPC:   p.BaseGRPC,
+		EVMRPC: p.BaseEVMRPC,
+		Stride: p.Stride,
+	}
 }
 
 // ResourceLimits configures container resource limits.
@@ -156,3 +598,8 @@ type ResourceLimits struct {
 	// CPUs limit (e.g., "2.0").
 	CPUs string `json:"cpus,omitempty"`
 }
+
+// NodeCount returns the number of nodes the devnet spec provisions.
+func (s DevnetSpec) NodeCount() int {
+	return s.Validators + s.FullNodes
+}
@@ -4,6 +4,8 @@ package types
 import (
 	"sync"
 	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/redact"
 )
 
 // Event represents a significant occurrence during resource lifecycle.
@@ -15,17 +17,52 @@ type Event struct {
 	Component string    `json:"component"` // Source component
 }
 
-// NewEvent creates a new event with the current timestamp.
+// MaxStatusEvents is how many recent events a devnet's status keeps. Older
+// events stay in the daemon's event history.
+const MaxStatusEvents = 10
+
+// NewEvent creates a new event with the current timestamp. Secrets in
+// message are redacted.
 func NewEvent(eventType, reason, message, component string) Event {
 	return Event{
 		Timestamp: time.Now(),
 		Type:      eventType,
 		Reason:    reason,
-		Message:   message,
+		Message:   redact.String(message),
 		Component: component,
 	}
 }
 
+// AddedEvents returns the events appended to updated since previous, in
+// order. previous may have been trimmed to its most recent events, so
+// events are matched from its last one.
+func AddedEvents(previous, updated []Event) []Event {
+	if len(previous) == 0 {
+		return updated
+	}
+	last := previous[len(previous)-1]
+	for i := len(updated) - 1; i >= 0; i-- {
+		if updated[i].Timestamp.Equal(last.Timestamp) && updated[i].Reason == last.Reason && updated[i].Message == last.Message {
+			return updated[i+1:]
+		}
+	}
+	var added []Event
+	for _, e := range updated {
+		if e.Timestamp.After(last.Timestamp) {
+			added = append(added, e)
+		}
+	}
+	return added
+}
+
+// RecentEvents returns the last MaxStatusEvents events.
+func RecentEvents(events []Event) []Event {
+	if len(events) <= MaxStatusEvents {
+		return events
+	}
+	return events[len(events)-MaxStatusEvents:]
+}
+
 // EventRing is a fixed-size ring buffer for events.
 // It keeps the most recent N events.
 type EventRing struct {
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"fmt"
 	"testing"
+	"time"
 )
 
 func TestNewEvent(t *testing.T) {
@@ -24,6 +26,47 @@ func TestNewEvent(t *testing.T) {
 	}
 }
 
+func TestAddedEvents(t *testing.T) {
+	base := time.Now()
+	events := make([]Event, 15)
+	for i := range events {
+		events[i] = Event{Timestamp: base.Add(time.Duration(i) * time.Second), Type: EventTypeNormal, Reason: fmt.Sprintf("R%d", i)}
+	}
+
+	if got := AddedEvents(nil, events[:3]); len(got) != 3 {
+		t.Errorf("expected all 3 events added to an empty status, got %d", len(got))
+	}
+	if got := AddedEvents(events[:5], events[:5]); len(got) != 0 {
+		t.Errorf("expected no added events, got %d", len(got))
+	}
+	got := AddedEvents(RecentEvents(events[:12]), events)
+	if len(got) != 3 || got[0].Reason != "R12" {
+		t.Errorf("expected R12..R14 added, got %v", got)
+	}
+	// The last previous event was trimmed away: fall back to timestamps.
+	got = AddedEvents(events[:8], events[10:])
+	if len(got) != 5 || got[0].Reason != "R10" {
+		t.Errorf("expected R10..R14 added, got %v", got)
+	}
+}
+
+func TestRecentEvents(t *testing.T) {
+	events := make([]Event, MaxStatusEvents+5)
+	for i := range events {
+		events[i].Reason = fmt.Sprintf("R%d", i)
+	}
+	recent := RecentEvents(events)
+	if len(recent) != MaxStatusEvents {
+		t.Fatalf("expected %d events, got %d", MaxStatusEvents, len(recent))
+	}
+	if recent[0].Reason != "R5" {
+		t.Errorf("expected oldest kept event R5, got %s", recent[0].Reason)
+	}
+	if got := RecentEvents(events[:3]); len(got) != 3 {
+		t.Errorf("expected short list unchanged, got %d", len(got))
+	}
+}
+
 func TestEventRing(t *testing.T) {
 	ring := NewEventRing(3)
 
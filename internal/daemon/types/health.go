@@ -12,6 +12,33 @@ const (
 	ConditionTypeNodesCreated    = "NodesCreated"
 	ConditionTypeNodesRunning    = "NodesRunning"
 	ConditionTypeDegraded        = "Degraded"
+
+	// ConditionTypePostHealthyHooks is set on devnets with postHealthy
+	// hooks: Unknown until they run, then whether they succeeded.
+	ConditionTypePostHealthyHooks = "PostHealthyHooks"
+
+	// ConditionTypeBootstrapped is set on devnets with bootstrap
+	// contracts: Unknown until they are deployed, then whether they were.
+	ConditionTypeBootstrapped = "Bootstrapped"
+
+	// ConditionTypeBinariesReady is set on imported devnets whose node
+	// binaries are built after the import: False while they build, then
+	// whether every node got one.
+	ConditionTypeBinariesReady = "BinariesReady"
+)
+
+// Condition types for node status
+const (
+	ConditionTypeSynced       = "Synced"
+	ConditionTypePeersHealthy = "PeersHealthy"
+	ConditionTypeDiskPressure = "DiskPressure"
+
+	// ConditionTypeSignerConnected is set on validators with a remote
+	// signer.
+	ConditionTypeSignerConnected = "SignerConnected"
+
+	// ConditionTypeEVMReady is set on nodes of networks with an EVM.
+	ConditionTypeEVMReady = "EVMReady"
 )
 
 // Condition status values
@@ -35,6 +62,10 @@ const (
 	ReasonStartingNodes   = "StartingNodes"
 	ReasonWaitingForNodes = "WaitingForNodes"
 
+	// BinariesReady reasons
+	ReasonBuildingBinaries = "BuildingBinaries"
+	ReasonBinariesBuilt    = "BinariesBuilt"
+
 	// Ready reasons
 	ReasonAllNodesReady = "AllNodesReady"
 	ReasonNodesNotReady = "NodesNotReady"
@@ -43,9 +74,30 @@ const (
 	ReasonNodesCrashed      = "NodesCrashed"
 	ReasonHealthCheckFailed = "HealthCheckFailed"
 
+	// Node condition reasons
+	ReasonSynced              = "Synced"
+	ReasonCatchingUp          = "CatchingUp"
+	ReasonChainStuck          = "ChainStuck"
+	ReasonPeersConnected      = "PeersConnected"
+	ReasonNoPeersExpected     = "NoPeersExpected"
+	ReasonMissingPeers        = "MissingPeers"
+	ReasonNoPeers             = "NoPeers"
+	ReasonLowDiskSpace        = "LowDiskSpace"
+	ReasonSufficientDiskSpace = "SufficientDiskSpace"
+	ReasonDiskCheckFailed     = "DiskCheckFailed"
+	ReasonSignerSigning       = "SignerSigning"
+	ReasonSignerNotSigning    = "SignerNotSigning"
+	ReasonSignerCheckFailed   = "SignerCheckFailed"
+	ReasonNotInValidatorSet   = "NotInValidatorSet"
+	ReasonWaitingForBlocks    = "WaitingForBlocks"
+	ReasonEVMServing          = "EVMServing"
+	ReasonEVMUnreachable      = "EVMUnreachable"
+	ReasonEVMChainIDMismatch  = "EVMChainIDMismatch"
+
 	// Plugin reasons
 	ReasonPluginFound    = "PluginFound"
 	ReasonPluginNotFound = "PluginNotFound"
+	ReasonPluginCrashed  = "PluginCrashed"
 
 	// Error reasons
 	ReasonImageNotFound       = "ImageNotFound"
@@ -54,6 +106,8 @@ const (
 	ReasonBinaryNotFound      = "BinaryNotFound"
 	ReasonContainerFailed     = "ContainerFailed"
 	ReasonNetworkError        = "NetworkError"
+	ReasonBuildFailed         = "BuildFailed"
+	ReasonSnapshotFailed      = "SnapshotFailed"
 
 	// Provisioning step reasons (granular events)
 	ReasonBinaryBuilding   = "BinaryBuilding"
@@ -64,6 +118,25 @@ const (
 	ReasonNodesConfigured  = "NodesConfigured"
 	ReasonNodeReady        = "NodeReady"
 	ReasonProvisionFailed  = "ProvisioningFailed"
+
+	// Recovery reasons, for provisioning the daemon stopped in the middle of
+	ReasonProvisioningResumed     = "ProvisioningResumed"
+	ReasonProvisioningInterrupted = "ProvisioningInterrupted"
+
+	// Shutdown reasons, for stopping a devnet's nodes
+	ReasonNodesStopped             = "NodesStopped"
+	ReasonNodeKilled               = "NodeKilled"
+	ReasonValidatorStateNotFlushed = "ValidatorStateNotFlushed"
+
+	// Hook reasons, for a devnet's postHealthy hooks
+	ReasonHooksPending   = "HooksPending"
+	ReasonHooksSucceeded = "HooksSucceeded"
+	ReasonHookFailed     = "HookFailed"
+
+	// Bootstrap reasons, for a devnet's bootstrap contracts
+	ReasonBootstrapPending   = "BootstrapPending"
+	ReasonBootstrapSucceeded = "BootstrapSucceeded"
+	ReasonBootstrapFailed    = "BootstrapFailed"
 )
 
 // RestartPolicy defines how crashed nodes should be restarted.
@@ -116,10 +189,75 @@ type HealthCheckResult struct {
 	// Error is set if the health check failed.
 	Error string `json:"error,omitempty"`
 
+	// Signer is the remote signer check of validators that use one.
+	Signer *SignerCheck `json:"signer,omitempty"`
+
+	// EVM is the JSON-RPC check of nodes of networks with an EVM.
+	EVM *EVMCheck `json:"evm,omitempty"`
+
 	// CheckedAt is when the check was performed.
 	CheckedAt time.Time `json:"checkedAt"`
 }
 
+// SignerCheck is the result of checking that a validator's remote signer
+// is connected and signing blocks.
+type SignerCheck struct {
+	// Status is ConditionTrue when the validator signed a recent block,
+	// ConditionFalse when it did not, and ConditionUnknown when that
+	// cannot be told yet.
+	Status string `json:"status"`
+
+	// Reason is a CamelCase reason for the SignerConnected condition.
+	Reason string `json:"reason"`
+
+	// Message explains the result.
+	Message string `json:"message,omitempty"`
+}
+
+// EVMCheck is the result of probing a node's EVM JSON-RPC with eth_chainId
+// and eth_blockNumber.
+type EVMCheck struct {
+	// Status is ConditionTrue when JSON-RPC answers with the expected chain
+	// ID and ConditionFalse when it does not answer or reports another.
+	Status string `json:"status"`
+
+	// Reason is a CamelCase reason for the EVMReady condition.
+	Reason string `json:"reason"`
+
+	// Message explains the result.
+	Message string `json:"message,omitempty"`
+
+	// ChainID is the EVM chain ID the node reported.
+	ChainID uint64 `json:"chainId,omitempty"`
+
+	// BlockNumber is the latest EVM block number the node reported.
+	BlockNumber uint64 `json:"blockNumber,omitempty"`
+}
+
+// ActivitySample is a sample of user activity on a node, used for idle
+// detection. Block production alone is not activity.
+type ActivitySample struct {
+	// Height is the block height at sampling time.
+	Height int64 `json:"height"`
+
+	// Txs is the number of transactions in blocks after the height passed
+	// to the sampler, up to Height.
+	Txs int `json:"txs"`
+
+	// PendingTxs is the number of transactions in the mempool.
+	PendingTxs int `json:"pendingTxs"`
+
+	// Connections is the number of established client connections to the
+	// node's RPC, REST, gRPC and EVM ports, excluding the daemon's own.
+	// -1 if connections cannot be sampled on this platform.
+	Connections int `json:"connections"`
+}
+
+// Active reports whether the sample shows any user activity.
+func (s ActivitySample) Active() bool {
+	return s.Txs > 0 || s.PendingTxs > 0 || s.Connections > 0
+}
+
 // HealthState tracks health state for a node over time.
 type HealthState struct {
 	// LastBlockHeight is the block height from last check.
@@ -0,0 +1,27 @@
+// internal/daemon/types/namespace.go
+package types
+
+import "time"
+
+// Namespace is an explicitly created namespace. Namespaces also exist
+// implicitly while they hold devnets; those have no record and no quota.
+type Namespace struct {
+	// Name is the namespace name.
+	Name string `json:"name"`
+
+	// Quota limits the devnets and nodes in the namespace.
+	Quota NamespaceQuota `json:"quota"`
+
+	// CreatedAt is when the namespace was created.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// NamespaceQuota limits the resources of a namespace. Zero means unlimited.
+type NamespaceQuota struct {
+	// MaxDevnets is the maximum number of devnets.
+	MaxDevnets int `json:"maxDevnets,omitempty"`
+
+	// MaxNodes is the maximum number of validators and full nodes across
+	// all devnets.
+	MaxNodes int `json:"maxNodes,omitempty"`
+}
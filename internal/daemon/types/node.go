@@ -1,7 +1,12 @@
 // internal/daemon/types/node.go
 package types
 
-import "time"
+import (
+	"fmt"
+	"time"
+
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
+)
 
 // Node phase constants.
 const (
@@ -41,10 +46,24 @@ type NodeSpec struct {
 	// HomeDir is the node's data directory.
 	HomeDir string `json:"homeDir"`
 
+	// Image is the Docker image to run (docker runtime only).
+	// Copied from DevnetSpec at node creation time.
+	Image string `json:"image,omitempty"`
+
 	// Address is the node's IP address (e.g., "127.0.42.1").
 	// Used for loopback subnet aliasing where each node gets a unique IP.
 	Address string `json:"address,omitempty"`
 
+	// BindAddress is the address the node listens on when it differs from
+	// Address, e.g. "::" with Address "::1". In docker mode it is the host
+	// address the container's ports are published on.
+	// Copied from DevnetSpec at node creation time.
+	BindAddress string `json:"bindAddress,omitempty"`
+
+	// PortLayout is the devnet's port layout.
+	// Copied from DevnetSpec at node creation time.
+	PortLayout dvbtypes.PortLayout `json:"portLayout,omitempty"`
+
 	// Desired is the desired state: "Running" or "Stopped".
 	Desired string `json:"desired"`
 
@@ -55,6 +74,41 @@ type NodeSpec struct {
 	// Network is the network/plugin name (e.g., "cosmos", "stable").
 	// Used to lookup the appropriate PluginRuntime for this node.
 	Network string `json:"network,omitempty"`
+
+	// Env holds extra environment variables for the node process.
+	// Set from the devnet's provisioning profile at node creation time.
+	Env map[string]string `json:"env,omitempty"`
+
+	// RemoteSigner is set for validators that sign through an external
+	// signer on priv_validator_laddr.
+	// Copied from DevnetSpec at node creation time.
+	RemoteSigner *RemoteSigner `json:"remoteSigner,omitempty"`
+
+	// Host is the remote host the node runs on over SSH, nil for nodes on
+	// the daemon's host.
+	// Copied from DevnetSpec at node creation time.
+	Host *RemoteHost `json:"host,omitempty"`
+
+	// ConfigOverrides records the config values set with ApplyNodeConfig,
+	// keyed by "<file>:<key>" with TOML literal values, so the effective
+	// config can attribute them to the user.
+	ConfigOverrides map[string]string `json:"configOverrides,omitempty"`
+}
+
+// Ports returns the ports the node is reached on, from its port layout.
+func (s NodeSpec) Ports() dvbtypes.PortConfig {
+	return s.PortLayout.ForNode(s.Index)
+}
+
+// DNSName returns the name a docker-mode node is reached on by the other
+// containers on its devnet's network: "val<index>.<devnet>" for validators
+// and "full<index>.<devnet>" for full nodes.
+func (s NodeSpec) DNSName() string {
+	prefix := "full"
+	if s.Role == "validator" {
+		prefix = "val"
+	}
+	return fmt.Sprintf("%s%d.%s", prefix, s.Index, s.DevnetRef)
 }
 
 // NodeStatus defines the observed state of a Node.
@@ -77,6 +131,10 @@ type NodeStatus struct {
 	// RestartCount is how many times the node has been restarted.
 	RestartCount int `json:"restartCount"`
 
+	// Version is the binary version the node runs: the devnet's version
+	// unless another one was set for the node alone.
+	Version string `json:"version,omitempty"`
+
 	// ValidatorAddress is the validator's address (if validator).
 	ValidatorAddress string `json:"validatorAddress,omitempty"`
 
@@ -95,6 +153,64 @@ type NodeStatus struct {
 	// ConsecutiveFailures counts consecutive health check failures.
 	ConsecutiveFailures int `json:"consecutiveFailures"`
 
+	// HeightSamples are the block heights health checks saw over the last
+	// HeightSampleWindow, oldest first.
+	HeightSamples []HeightSample `json:"heightSamples,omitempty"`
+
+	// BlocksPerMinute is the node's block rate over HeightSamples.
+	BlocksPerMinute float64 `json:"blocksPerMinute,omitempty"`
+
+	// AvgBlockTime is the average time between blocks over HeightSamples.
+	AvgBlockTime time.Duration `json:"avgBlockTime,omitempty"`
+
+	// HeightLag is how many blocks the node is behind the highest node of
+	// its devnet.
+	HeightLag int64 `json:"heightLag,omitempty"`
+
 	// NextRestartTime is when the next restart attempt is allowed (backoff).
 	NextRestartTime time.Time `json:"nextRestartTime,omitempty"`
+
+	// Conditions explain the node's health: Synced, PeersHealthy,
+	// DiskPressure and, for remote-signer validators, SignerConnected, set
+	// by the health controller.
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// HeightSampleWindow is how far back a node's height samples go.
+const HeightSampleWindow = 10 * time.Minute
+
+// HeightSample is a node's block height at a point in time.
+type HeightSample struct {
+	Height int64     `json:"height"`
+	Time   time.Time `json:"time"`
+}
+
+// RecordHeight adds a height sample, drops the samples older than
+// HeightSampleWindow and recomputes the block rate from the rest. The rate
+// needs two samples; a node whose height did not change has a rate of 0.
+func (s *NodeStatus) RecordHeight(height int64, at time.Time) {
+	samples := append(s.HeightSamples, HeightSample{Height: height, Time: at})
+	cutoff := at.Add(-HeightSampleWindow)
+	for len(samples) > 0 && samples[0].Time.Before(cutoff) {
+		samples = samples[1:]
+	}
+	s.HeightSamples = samples
+
+	s.BlocksPerMinute = 0
+	s.AvgBlockTime = 0
+	first, last := samples[0], samples[len(samples)-1]
+	elapsed := last.Time.Sub(first.Time)
+	blocks := last.Height - first.Height
+	if blocks < 0 {
+		// The chain was reset: earlier samples belong to another chain
+		s.HeightSamples = samples[len(samples)-1:]
+		return
+	}
+	if elapsed <= 0 {
+		return
+	}
+	s.BlocksPerMinute = float64(blocks) / elapsed.Minutes()
+	if blocks > 0 {
+		s.AvgBlockTime = elapsed / time.Duration(blocks)
+	}
 }
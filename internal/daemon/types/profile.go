@@ -0,0 +1,75 @@
+// internal/daemon/types/profile.go
+package types
+
+import "sort"
+
+// Provisioning profile names.
+const (
+	// ProfileLaptop reduces per-node resource usage so multi-validator
+	// forks fit on memory-constrained machines (e.g., 16GB laptops).
+	ProfileLaptop = "laptop"
+)
+
+// Profile is a named set of per-node resource tunings applied at provisioning time.
+// Zero values leave the chain's defaults untouched.
+type Profile struct {
+	// Name is the profile name.
+	Name string
+
+	// Pruning is the app.toml pruning strategy (e.g., "everything").
+	Pruning string
+
+	// TxIndexer is the config.toml [tx_index] indexer (e.g., "null" to disable).
+	TxIndexer string
+
+	// MempoolSize is the config.toml [mempool] size (max number of txs).
+	MempoolSize int
+
+	// MempoolCacheSize is the config.toml [mempool] cache_size.
+	MempoolCacheSize int
+
+	// ServicesOnFirstNodeOnly disables API, gRPC and JSON-RPC on every node
+	// except node 0.
+	ServicesOnFirstNodeOnly bool
+
+	// GoMemLimit is the GOMEMLIMIT soft memory limit for node processes.
+	// A soft limit makes the Go GC work harder before the OS starts swapping.
+	GoMemLimit string
+}
+
+var profiles = map[string]Profile{
+	ProfileLaptop: {
+		Name:                    ProfileLaptop,
+		Pruning:                 "everything",
+		TxIndexer:               "null",
+		MempoolSize:             1000,
+		MempoolCacheSize:        2000,
+		ServicesOnFirstNodeOnly: true,
+		GoMemLimit:              "2GiB",
+	},
+}
+
+// LookupProfile returns the profile with the given name.
+func LookupProfile(name string) (Profile, bool) {
+	p, ok := profiles[name]
+	return p, ok
+}
+
+// ProfileNames returns the names of all known profiles, sorted.
+func ProfileNames() []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Env returns environment variables the profile sets for node processes.
+func (p Profile) Env() map[string]string {
+	env := make(map[string]string)
+	if p.GoMemLimit != "" {
+		env["GOMEMLIMIT"] = p.GoMemLimit
+	}
+	return env
+}
@@ -60,6 +60,47 @@ func TestDevnetStatus_SDKVersionHistory(t *testing.T) {
 	assert.Equal(t, "0.50.9", status.SDKVersionHistory[0].FromVersion)
 }
 
+func TestNodeStatus_RecordHeight(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var status NodeStatus
+
+	// One sample is not enough for a rate
+	status.RecordHeight(100, start)
+	assert.Zero(t, status.BlocksPerMinute)
+
+	// 60 blocks in 2 minutes
+	status.RecordHeight(130, start.Add(time.Minute))
+	status.RecordHeight(160, start.Add(2*time.Minute))
+	assert.InDelta(t, 30, status.BlocksPerMinute, 0.001)
+	assert.Equal(t, 2*time.Second, status.AvgBlockTime)
+
+	// Samples older than the window are dropped
+	status.RecordHeight(160, start.Add(HeightSampleWindow+90*time.Second))
+	require.Len(t, status.HeightSamples, 2)
+	assert.Equal(t, int64(160), status.HeightSamples[0].Height)
+	assert.Zero(t, status.BlocksPerMinute)
+	assert.Zero(t, status.AvgBlockTime)
+
+	// A reset chain starts over
+	status.RecordHeight(5, start.Add(HeightSampleWindow+2*time.Minute))
+	require.Len(t, status.HeightSamples, 1)
+	assert.Zero(t, status.BlocksPerMinute)
+}
+
+func TestNodeSpec_DNSName(t *testing.T) {
+	for _, tt := range []struct {
+		spec NodeSpec
+		want string
+	}{
+		{NodeSpec{DevnetRef: "mydevnet", Role: "validator", Index: 0}, "val0.mydevnet"},
+		{NodeSpec{DevnetRef: "mydevnet", Role: "fullnode", Index: 1}, "full1.mydevnet"},
+	} {
+		if got := tt.spec.DNSName(); got != tt.want {
+			t.Errorf("DNSName() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
 func TestResourceMetaNamespace(t *testing.T) {
 	meta := ResourceMeta{
 		Namespace: "production",
@@ -98,3 +139,19 @@ func TestResourceMetaEnsureNamespace(t *testing.T) {
 	meta2.EnsureNamespace()
 	assert.Equal(t, "custom", meta2.Namespace)
 }
+
+func TestLookupProfile(t *testing.T) {
+	p, ok := LookupProfile(ProfileLaptop)
+	require.True(t, ok)
+	assert.Equal(t, "everything", p.Pruning)
+	assert.Equal(t, "null", p.TxIndexer)
+	assert.True(t, p.ServicesOnFirstNodeOnly)
+	assert.Equal(t, map[string]string{"GOMEMLIMIT": p.GoMemLimit}, p.Env())
+
+	_, ok = LookupProfile("")
+	assert.False(t, ok)
+	_, ok = LookupProfile("unknown")
+	assert.False(t, ok)
+
+	assert.Contains(t, ProfileNames(), ProfileLaptop)
+}
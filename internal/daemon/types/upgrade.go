@@ -1,6 +1,8 @@
 // internal/daemon/types/upgrade.go
 package types
 
+import "time"
+
 // Upgrade phase constants.
 const (
 	UpgradePhasePending   = "Pending"
@@ -8,11 +10,19 @@ const (
 	UpgradePhaseVoting    = "Voting"
 	UpgradePhaseWaiting   = "Waiting"
 	UpgradePhaseSwitching = "Switching"
+	UpgradePhaseHalting   = "Halting"
+	UpgradePhaseExporting = "Exporting"
+	UpgradePhaseForking   = "Forking"
 	UpgradePhaseVerifying = "Verifying"
 	UpgradePhaseCompleted = "Completed"
 	UpgradePhaseFailed    = "Failed"
 )
 
+// UpgradeModeForkTest halts the devnet at the target height, exports its
+// state and provisions a new devnet from the export with the new binary,
+// instead of upgrading in place through governance.
+const UpgradeModeForkTest = "fork-test"
+
 // Upgrade represents a chain upgrade operation.
 type Upgrade struct {
 	Metadata ResourceMeta  `json:"metadata"`
@@ -44,6 +54,9 @@ type UpgradeSpec struct {
 
 	// AutoVote automatically votes yes with all validators.
 	AutoVote bool `json:"autoVote"`
+
+	// Mode is empty for a governance upgrade or UpgradeModeForkTest.
+	Mode string `json:"mode,omitempty"`
 }
 
 // UpgradeStatus defines the observed state of an Upgrade.
@@ -74,4 +87,45 @@ type UpgradeStatus struct {
 
 	// Error contains error details if phase is Failed.
 	Error string `json:"error,omitempty"`
+
+	// ForkDevnetRef is the devnet provisioned from the export (fork-test mode).
+	ForkDevnetRef string `json:"forkDevnetRef,omitempty"`
+
+	// ForkStartHeight is the first height observed on the fork devnet.
+	ForkStartHeight int64 `json:"forkStartHeight,omitempty"`
+
+	// ForkHeight is the latest height observed on the fork devnet.
+	ForkHeight int64 `json:"forkHeight,omitempty"`
+
+	// PhaseHistory records when the upgrade entered and left each phase.
+	PhaseHistory []UpgradePhaseRecord `json:"phaseHistory,omitempty"`
+
+	// PreUpgradeHeight and PreUpgradeAppHash are the last block observed
+	// before the chain halted for the upgrade.
+	PreUpgradeHeight  int64  `json:"preUpgradeHeight,omitempty"`
+	PreUpgradeAppHash string `json:"preUpgradeAppHash,omitempty"`
+
+	// PostUpgradeHeight and PostUpgradeAppHash are the block observed once
+	// every node was verified on the new version.
+	PostUpgradeHeight  int64  `json:"postUpgradeHeight,omitempty"`
+	PostUpgradeAppHash string `json:"postUpgradeAppHash,omitempty"`
+
+	// PreModuleVersions and PostModuleVersions are the module consensus
+	// versions before and after the upgrade.
+	PreModuleVersions  map[string]uint64 `json:"preModuleVersions,omitempty"`
+	PostModuleVersions map[string]uint64 `json:"postModuleVersions,omitempty"`
+
+	// Warnings lists non-fatal problems seen during the upgrade.
+	Warnings []string `json:"warnings,omitempty"`
+
+	// ReportPath is the JSON rehearsal report written when the upgrade
+	// finished.
+	ReportPath string `json:"reportPath,omitempty"`
+}
+
+// UpgradePhaseRecord records the time an upgrade spent in one phase.
+type UpgradePhaseRecord struct {
+	Phase       string    `json:"phase"`
+	StartedAt   time.Time `json:"startedAt"`
+	CompletedAt time.Time `json:"completedAt,omitempty"`
 }
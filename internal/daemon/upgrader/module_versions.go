@@ -0,0 +1,140 @@
+package upgrader
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// moduleVersionsQueryPath is the ABCI query path of the x/upgrade module
+// versions query.
+const moduleVersionsQueryPath = "/cosmos.upgrade.v1beta1.Query/ModuleVersions"
+
+// GetModuleVersions returns the consensus version of each module, as
+// reported by the x/upgrade module.
+func (r *Runtime) GetModuleVersions(ctx context.Context, devnetName string) (map[string]uint64, error) {
+	var versions map[string]uint64
+	err := r.queryRunningNode(ctx, devnetName, func(rpcPort int) error {
+		var err error
+		versions, err = r.getModuleVersions(ctx, rpcPort)
+		return err
+	})
+	return versions, err
+}
+
+// getModuleVersions runs the module versions query against a node through
+// CometBFT's abci_query endpoint, so no REST API is needed.
+func (r *Runtime) getModuleVersions(ctx context.Context, rpcPort int) (map[string]uint64, error) {
+	// An empty request (no module name) returns every module.
+	endpoint := fmt.Sprintf("http://127.0.0.1:%d/abci_query?path=%s", rpcPort, url.QueryEscape(`"`+moduleVersionsQueryPath+`"`))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("abci_query endpoint returned %d", resp.StatusCode)
+	}
+
+	var queryResp struct {
+		Result struct {
+			Response struct {
+				Code  uint32 `json:"code"`
+				Log   string `json:"log"`
+				Value []byte `json:"value"` // base64 in JSON
+			} `json:"response"`
+		} `json:"result"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&queryResp); err != nil {
+		return nil, err
+	}
+	if queryResp.Result.Response.Code != 0 {
+		return nil, fmt.Errorf("module versions query failed (code %d): %s", queryResp.Result.Response.Code, queryResp.Result.Response.Log)
+	}
+
+	return decodeModuleVersions(queryResp.Result.Response.Value)
+}
+
+// decodeModuleVersions decodes a QueryModuleVersionsResponse:
+//
+//	message QueryModuleVersionsResponse { repeated ModuleVersion module_versions = 1; }
+//	message ModuleVersion { string name = 1; uint64 version = 2; }
+func decodeModuleVersions(b []byte) (map[string]uint64, error) {
+	versions := make(map[string]uint64)
+	err := forEachField(b, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+		if num != 1 || typ != protowire.BytesType {
+			return nil
+		}
+
+		var (
+			name    string
+			version uint64
+		)
+		err := forEachField(value, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+			switch {
+			case num == 1 && typ == protowire.BytesType:
+				name = string(value)
+			case num == 2 && typ == protowire.VarintType:
+				version = varint
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if name != "" {
+			versions[name] = version
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode module versions: %w", err)
+	}
+	return versions, nil
+}
+
+// forEachField calls fn for each field of a protobuf message. Length
+// delimited fields pass their bytes in value, varints pass varint.
+func forEachField(b []byte, fn func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		var (
+			value  []byte
+			varint uint64
+		)
+		switch typ {
+		case protowire.BytesType:
+			value, n = protowire.ConsumeBytes(b)
+		case protowire.VarintType:
+			varint, n = protowire.ConsumeVarint(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		if err := fn(num, typ, value, varint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
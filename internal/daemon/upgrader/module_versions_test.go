@@ -0,0 +1,96 @@
+package upgrader
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// encodeModuleVersions encodes a QueryModuleVersionsResponse.
+func encodeModuleVersions(versions [][2]any) []byte {
+	var b []byte
+	for _, v := range versions {
+		var mv []byte
+		mv = protowire.AppendTag(mv, 1, protowire.BytesType)
+		mv = protowire.AppendString(mv, v[0].(string))
+		mv = protowire.AppendTag(mv, 2, protowire.VarintType)
+		mv = protowire.AppendVarint(mv, v[1].(uint64))
+
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendBytes(b, mv)
+	}
+	return b
+}
+
+func TestDecodeModuleVersions(t *testing.T) {
+	data := encodeModuleVersions([][2]any{{"bank", uint64(4)}, {"gov", uint64(5)}})
+	// Unknown fields are skipped
+	data = protowire.AppendTag(data, 2, protowire.VarintType)
+	data = protowire.AppendVarint(data, 7)
+
+	versions, err := decodeModuleVersions(data)
+	if err != nil {
+		t.Fatalf("decodeModuleVersions failed: %v", err)
+	}
+	if len(versions) != 2 || versions["bank"] != 4 || versions["gov"] != 5 {
+		t.Errorf("unexpected versions: %v", versions)
+	}
+
+	if _, err := decodeModuleVersions([]byte{0x0a, 0x05, 0x01}); err == nil {
+		t.Error("expected error for truncated message")
+	}
+}
+
+func TestRuntime_GetModuleVersions(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/abci_query" {
+			gotPath = r.URL.Query().Get("path")
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"result": map[string]interface{}{
+					"response": map[string]interface{}{
+						"code":  0,
+						"value": encodeModuleVersions([][2]any{{"upgrade", uint64(2)}}),
+					},
+				},
+			})
+		}
+	}))
+	defer server.Close()
+
+	port := strings.Split(server.URL, ":")[2]
+	var rpcPort int
+	fmt.Sscanf(port, "%d", &rpcPort)
+
+	s := store.NewMemoryStore()
+	s.CreateDevnet(context.Background(), &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test-devnet"},
+		Spec:     types.DevnetSpec{Validators: 1},
+	})
+	s.CreateNode(context.Background(), &types.Node{
+		Metadata: types.ResourceMeta{Name: "test-node-0"},
+		Spec:     types.NodeSpec{DevnetRef: "test-devnet", Index: 0},
+		Status:   types.NodeStatus{Phase: types.NodePhaseRunning},
+	})
+
+	runtime := NewRuntime(s, Config{BaseRPC: rpcPort})
+
+	versions, err := runtime.GetModuleVersions(context.Background(), "test-devnet")
+	if err != nil {
+		t.Fatalf("GetModuleVersions failed: %v", err)
+	}
+	if versions["upgrade"] != 2 {
+		t.Errorf("unexpected versions: %v", versions)
+	}
+	if gotPath != `"`+moduleVersionsQueryPath+`"` {
+		t.Errorf("query path = %s", gotPath)
+	}
+}
@@ -11,15 +11,17 @@ import (
 
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/store"
 	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/export"
 )
 
 // Runtime implements UpgradeRuntime for Cosmos SDK chains.
 // It uses RPC calls for chain queries and store operations for node management.
 type Runtime struct {
-	store   store.Store
-	baseRPC int
-	client  *http.Client
-	logger  *slog.Logger
+	store    store.Store
+	baseRPC  int
+	client   *http.Client
+	exporter *export.ExportExecutor
+	logger   *slog.Logger
 }
 
 // Config configures the upgrade runtime.
@@ -54,7 +56,8 @@ func NewRuntime(s store.Store, cfg Config) *Runtime {
 		client: &http.Client{
 			Timeout: cfg.Timeout,
 		},
-		logger: logger,
+		exporter: export.NewExportExecutor(),
+		logger:   logger,
 	}
 }
 
@@ -119,10 +122,36 @@ func (r *Runtime) VoteOnProposal(ctx context.Context, devnetName string, proposa
 
 // GetCurrentHeight returns the chain's current block height.
 func (r *Runtime) GetCurrentHeight(ctx context.Context, devnetName string) (int64, error) {
+	var height int64
+	err := r.queryRunningNode(ctx, devnetName, func(rpcPort int) error {
+		var err error
+		height, _, err = r.getNodeStatus(ctx, rpcPort)
+		return err
+	})
+	return height, err
+}
+
+// GetAppHash returns the chain's latest block height and its app hash.
+func (r *Runtime) GetAppHash(ctx context.Context, devnetName string) (int64, string, error) {
+	var (
+		height  int64
+		appHash string
+	)
+	err := r.queryRunningNode(ctx, devnetName, func(rpcPort int) error {
+		var err error
+		height, appHash, err = r.getNodeStatus(ctx, rpcPort)
+		return err
+	})
+	return height, appHash, err
+}
+
+// queryRunningNode calls query with the RPC port of each running node of the
+// devnet in turn, until one succeeds.
+func (r *Runtime) queryRunningNode(ctx context.Context, devnetName string, query func(rpcPort int) error) error {
 	// Get devnet to determine namespace
 	devnet, err := r.store.GetDevnet(ctx, "", devnetName)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get devnet: %w", err)
+		return fmt.Errorf("failed to get devnet: %w", err)
 	}
 
 	namespace := devnet.Metadata.Namespace
@@ -130,58 +159,61 @@ func (r *Runtime) GetCurrentHeight(ctx context.Context, devnetName string) (int6
 	// Get any running node for this devnet
 	nodes, err := r.store.ListNodes(ctx, namespace, devnetName)
 	if err != nil {
-		return 0, fmt.Errorf("failed to list nodes: %w", err)
+		return fmt.Errorf("failed to list nodes: %w", err)
 	}
 
 	for _, node := range nodes {
 		if node.Status.Phase == types.NodePhaseRunning {
 			rpcPort := r.baseRPC + node.Spec.Index
-			height, err := r.getNodeHeight(ctx, rpcPort)
-			if err != nil {
-				r.logger.Debug("failed to get height from node",
+			if !node.Spec.PortLayout.IsZero() {
+				rpcPort = node.Spec.Ports().RPC
+			}
+			if err := query(rpcPort); err != nil {
+				r.logger.Debug("failed to query node",
 					"nodeIndex", node.Spec.Index,
 					"error", err)
 				continue
 			}
-			return height, nil
+			return nil
 		}
 	}
 
-	return 0, fmt.Errorf("no running nodes found for devnet %s", devnetName)
+	return fmt.Errorf("no running nodes found for devnet %s", devnetName)
 }
 
-// getNodeHeight queries a node's current block height.
-func (r *Runtime) getNodeHeight(ctx context.Context, rpcPort int) (int64, error) {
+// getNodeStatus queries a node's latest block height and app hash.
+func (r *Runtime) getNodeStatus(ctx context.Context, rpcPort int) (int64, string, error) {
 	url := fmt.Sprintf("http://127.0.0.1:%d/status", rpcPort)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return 0, err
+		return 0, "", err
 	}
 
 	resp, err := r.client.Do(req)
 	if err != nil {
-		return 0, err
+		return 0, "", err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return 0, fmt.Errorf("status endpoint returned %d", resp.StatusCode)
+		return 0, "", fmt.Errorf("status endpoint returned %d", resp.StatusCode)
 	}
 
 	var statusResp struct {
 		Result struct {
 			SyncInfo struct {
-				LatestBlockHeight int64 `json:"latest_block_height,string"`
+				LatestBlockHeight int64  `json:"latest_block_height,string"`
+				LatestAppHash     string `json:"latest_app_hash"`
 			} `json:"sync_info"`
 		} `json:"result"`
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&statusResp); err != nil {
-		return 0, err
+		return 0, "", err
 	}
 
-	return statusResp.Result.SyncInfo.LatestBlockHeight, nil
+	return statusResp.Result.SyncInfo.LatestBlockHeight, statusResp.Result.SyncInfo.LatestAppHash, nil
 }
 
 // SwitchNodeBinary replaces the binary on a node and restarts it.
@@ -231,6 +263,9 @@ func (r *Runtime) SwitchNodeBinary(ctx context.Context, devnetName string, nodeI
 	node.Status.Phase = types.NodePhasePending
 	node.Status.Message = "Restarting with new binary for upgrade"
 	node.Status.RestartCount++
+	if newBinary.Version != "" {
+		node.Status.Version = newBinary.Version
+	}
 	node.Metadata.UpdatedAt = time.Now()
 
 	if err := r.store.UpdateNode(ctx, node); err != nil {
@@ -267,6 +302,9 @@ func (r *Runtime) VerifyNodeVersion(ctx context.Context, devnetName string, node
 
 	// Query the node's application version
 	rpcPort := r.baseRPC + nodeIndex
+	if !node.Spec.PortLayout.IsZero() {
+		rpcPort = node.Spec.Ports().RPC
+	}
 	version, err := r.getNodeVersion(ctx, rpcPort)
 	if err != nil {
 		return false, err
@@ -310,22 +348,39 @@ func (r *Runtime) getNodeVersion(ctx context.Context, rpcPort int) (string, erro
 	return abciResp.Result.Response.Version, nil
 }
 
-// ExportState exports the chain state to a file.
-func (r *Runtime) ExportState(ctx context.Context, devnetName string, outputPath string) error {
+// ExportState exports the chain state at height to a file, or at the node's
+// last known height if height is 0. The export runs the first validator's
+// binary against its home directory, so the node must be stopped first.
+func (r *Runtime) ExportState(ctx context.Context, devnetName string, outputPath string, height int64) error {
 	r.logger.Info("exporting chain state",
 		"devnet", devnetName,
+		"height", height,
 		"outputPath", outputPath)
 
-	// In a real implementation, this would:
-	// 1. Stop the nodes gracefully
-	// 2. Run the export command on one node
-	// 3. Copy the export to the output path
-	// 4. Restart the nodes
-	//
-	// For now, we simulate success
-	// The actual implementation would use the CLI tool or Docker exec
+	// Get devnet to determine namespace
+	devnet, err := r.store.GetDevnet(ctx, "", devnetName)
+	if err != nil {
+		return fmt.Errorf("failed to get devnet: %w", err)
+	}
 
-	return nil
+	node, err := r.store.GetNode(ctx, devnet.Metadata.Namespace, devnetName, 0)
+	if err != nil {
+		return fmt.Errorf("failed to get node: %w", err)
+	}
+	if node.Spec.BinaryPath == "" {
+		return fmt.Errorf("node %s has no local binary to export with", node.Metadata.Name)
+	}
+	if node.Status.BlockHeight <= 0 {
+		return fmt.Errorf("node %s has no known block height", node.Metadata.Name)
+	}
+	if height <= 0 {
+		height = node.Status.BlockHeight
+	} else if height > node.Status.BlockHeight {
+		return fmt.Errorf("node %s stopped at height %d, before the export height %d", node.Metadata.Name, node.Status.BlockHeight, height)
+	}
+
+	_, err = r.exporter.ExportAtHeight(ctx, node.Spec.BinaryPath, node.Spec.HomeDir, height, outputPath)
+	return err
 }
 
 // GetValidatorCount returns the number of validators in the devnet.
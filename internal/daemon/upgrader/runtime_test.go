@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -22,6 +24,7 @@ func TestRuntime_GetCurrentHeight(t *testing.T) {
 				"result": map[string]interface{}{
 					"sync_info": map[string]interface{}{
 						"latest_block_height": "12345",
+						"latest_app_hash":     "ABCDEF",
 					},
 				},
 			})
@@ -67,6 +70,15 @@ func TestRuntime_GetCurrentHeight(t *testing.T) {
 	if height != 12345 {
 		t.Errorf("Expected height 12345, got %d", height)
 	}
+
+	height, appHash, err := runtime.GetAppHash(context.Background(), "test-devnet")
+	if err != nil {
+		t.Fatalf("GetAppHash failed: %v", err)
+	}
+
+	if height != 12345 || appHash != "ABCDEF" {
+		t.Errorf("Expected app hash ABCDEF at 12345, got %s at %d", appHash, height)
+	}
 }
 
 func TestRuntime_GetValidatorCount(t *testing.T) {
@@ -242,3 +254,86 @@ func TestRuntime_VerifyNodeVersion(t *testing.T) {
 		t.Error("Expected version mismatch")
 	}
 }
+
+func TestRuntime_ExportState(t *testing.T) {
+	s := store.NewMemoryStore()
+	tmpDir := t.TempDir()
+
+	// Fake chain binary that prints its arguments into an exported genesis
+	binary := filepath.Join(tmpDir, "stabled")
+	script := `#!/bin/sh
+echo '{"chain_id":"test-1","app_state":{"auth":{},"bank":{},"staking":{}},"args":"'"$*"'"}'
+`
+	if err := os.WriteFile(binary, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test-devnet"},
+		Spec:     types.DevnetSpec{Validators: 1},
+	}
+	s.CreateDevnet(context.Background(), devnet)
+
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: "test-node-0"},
+		Spec: types.NodeSpec{
+			DevnetRef:  "test-devnet",
+			Index:      0,
+			BinaryPath: binary,
+			HomeDir:    filepath.Join(tmpDir, "node0"),
+		},
+		Status: types.NodeStatus{
+			Phase:       types.NodePhaseStopped,
+			BlockHeight: 150,
+		},
+	}
+	s.CreateNode(context.Background(), node)
+
+	runtime := NewRuntime(s, Config{})
+	outputPath := filepath.Join(tmpDir, "export.json")
+
+	if err := runtime.ExportState(context.Background(), "test-devnet", outputPath, 0); err != nil {
+		t.Fatalf("ExportState failed: %v", err)
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("export not written: %v", err)
+	}
+	if !strings.Contains(string(data), "--height 150") {
+		t.Errorf("expected export at the node's height, got %s", data)
+	}
+
+	if err := runtime.ExportState(context.Background(), "test-devnet", outputPath, 120); err != nil {
+		t.Fatalf("ExportState at height failed: %v", err)
+	}
+	if data, _ := os.ReadFile(outputPath); !strings.Contains(string(data), "--height 120") {
+		t.Errorf("expected export at height 120, got %s", data)
+	}
+	if err := runtime.ExportState(context.Background(), "test-devnet", outputPath, 200); err == nil {
+		t.Error("ExportState past the node's height should fail")
+	}
+}
+
+func TestRuntime_ExportState_NoBinary(t *testing.T) {
+	s := store.NewMemoryStore()
+
+	devnet := &types.Devnet{
+		Metadata: types.ResourceMeta{Name: "test-devnet"},
+		Spec:     types.DevnetSpec{Validators: 1},
+	}
+	s.CreateDevnet(context.Background(), devnet)
+
+	node := &types.Node{
+		Metadata: types.ResourceMeta{Name: "test-node-0"},
+		Spec:     types.NodeSpec{DevnetRef: "test-devnet", Index: 0},
+		Status:   types.NodeStatus{BlockHeight: 150},
+	}
+	s.CreateNode(context.Background(), node)
+
+	runtime := NewRuntime(s, Config{})
+
+	if err := runtime.ExportState(context.Background(), "test-devnet", filepath.Join(t.TempDir(), "export.json"), 0); err == nil {
+		t.Error("ExportState should fail without a local binary")
+	}
+}
@@ -0,0 +1,323 @@
+// internal/daemon/upgradereport/report.go
+
+// Package upgradereport builds the rehearsal report of a finished upgrade:
+// how long each stage took, the proposal, the app hashes around the upgrade
+// height, the module version changes and any warnings. Reports are written
+// as JSON for machines and markdown for people.
+package upgradereport
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+// ErrNotFound is returned by Read when no report exists for an upgrade.
+var ErrNotFound = errors.New("upgrade report not found")
+
+// Report is the rehearsal report of one upgrade.
+type Report struct {
+	Name        string    `json:"name"`
+	Namespace   string    `json:"namespace"`
+	Devnet      string    `json:"devnet"`
+	UpgradeName string    `json:"upgradeName"`
+	Mode        string    `json:"mode,omitempty"`
+	Phase       string    `json:"phase"`
+	Error       string    `json:"error,omitempty"`
+	StartedAt   time.Time `json:"startedAt"`
+	CompletedAt time.Time `json:"completedAt"`
+	DurationMs  int64     `json:"durationMs"`
+
+	Stages      []Stage           `json:"stages"`
+	Proposal    Proposal          `json:"proposal"`
+	AppHashes   AppHashes         `json:"appHashes"`
+	Modules     []ModuleChange    `json:"moduleVersionChanges"`
+	NewBinary   string            `json:"newBinary,omitempty"`
+	Exports     map[string]string `json:"exports,omitempty"`
+	Warnings    []string          `json:"warnings"`
+	GeneratedAt time.Time         `json:"generatedAt"`
+}
+
+// Stage is the time spent in one upgrade phase.
+type Stage struct {
+	Phase       string    `json:"phase"`
+	StartedAt   time.Time `json:"startedAt"`
+	CompletedAt time.Time `json:"completedAt"`
+	DurationMs  int64     `json:"durationMs"`
+}
+
+// Proposal describes the governance proposal of the upgrade.
+type Proposal struct {
+	ID            uint64 `json:"id,omitempty"`
+	TargetHeight  int64  `json:"targetHeight"`
+	VotesReceived int    `json:"votesReceived"`
+	VotesRequired int    `json:"votesRequired"`
+}
+
+// AppHashes are the app hashes observed around the upgrade height.
+type AppHashes struct {
+	PreHeight  int64  `json:"preHeight,omitempty"`
+	Pre        string `json:"pre,omitempty"`
+	PostHeight int64  `json:"postHeight,omitempty"`
+	Post       string `json:"post,omitempty"`
+}
+
+// Module version change kinds.
+const (
+	ModuleAdded   = "added"
+	ModuleRemoved = "removed"
+	ModuleBumped  = "bumped"
+)
+
+// ModuleChange is a module whose consensus version changed in the upgrade.
+type ModuleChange struct {
+	Module string `json:"module"`
+	Change string `json:"change"`
+	From   uint64 `json:"from,omitempty"`
+	To     uint64 `json:"to,omitempty"`
+}
+
+// Build creates the report of an upgrade. The upgrade is expected to be in
+// a terminal phase; now closes the last stage.
+func Build(u *types.Upgrade, now time.Time) *Report {
+	r := &Report{
+		Name:        u.Metadata.Name,
+		Namespace:   u.Metadata.Namespace,
+		Devnet:      u.Spec.DevnetRef,
+		UpgradeName: u.Spec.UpgradeName,
+		Mode:        u.Spec.Mode,
+		Phase:       u.Status.Phase,
+		Error:       u.Status.Error,
+		StartedAt:   u.Metadata.CreatedAt,
+		CompletedAt: now,
+		Proposal: Proposal{
+			ID:            u.Status.ProposalID,
+			TargetHeight:  u.Spec.TargetHeight,
+			VotesReceived: u.Status.VotesReceived,
+			VotesRequired: u.Status.VotesRequired,
+		},
+		AppHashes: AppHashes{
+			PreHeight:  u.Status.PreUpgradeHeight,
+			Pre:        u.Status.PreUpgradeAppHash,
+			PostHeight: u.Status.PostUpgradeHeight,
+			Post:       u.Status.PostUpgradeAppHash,
+		},
+		Modules:     DiffModuleVersions(u.Status.PreModuleVersions, u.Status.PostModuleVersions),
+		NewBinary:   binaryName(u.Spec.NewBinary),
+		Warnings:    append([]string{}, u.Status.Warnings...),
+		GeneratedAt: now,
+	}
+
+	for _, rec := range u.Status.PhaseHistory {
+		end := rec.CompletedAt
+		if end.IsZero() {
+			end = now
+		}
+		r.Stages = append(r.Stages, Stage{
+			Phase:       rec.Phase,
+			StartedAt:   rec.StartedAt,
+			CompletedAt: end,
+			DurationMs:  end.Sub(rec.StartedAt).Milliseconds(),
+		})
+	}
+	if len(r.Stages) > 0 && r.StartedAt.IsZero() {
+		r.StartedAt = r.Stages[0].StartedAt
+	}
+	if !r.StartedAt.IsZero() {
+		r.DurationMs = now.Sub(r.StartedAt).Milliseconds()
+	}
+
+	exports := map[string]string{}
+	if u.Status.PreExportPath != "" {
+		exports["pre"] = u.Status.PreExportPath
+	}
+	if u.Status.PostExportPath != "" {
+		exports["post"] = u.Status.PostExportPath
+	}
+	if len(exports) > 0 {
+		r.Exports = exports
+	}
+	return r
+}
+
+// DiffModuleVersions returns the modules added, removed or bumped between
+// two module version maps, sorted by module name. It returns nil when
+// either map is missing, since nothing can be compared.
+func DiffModuleVersions(pre, post map[string]uint64) []ModuleChange {
+	if pre == nil || post == nil {
+		return nil
+	}
+
+	var changes []ModuleChange
+	for module, to := range post {
+		from, ok := pre[module]
+		switch {
+		case !ok:
+			changes = append(changes, ModuleChange{Module: module, Change: ModuleAdded, To: to})
+		case from != to:
+			changes = append(changes, ModuleChange{Module: module, Change: ModuleBumped, From: from, To: to})
+		}
+	}
+	for module, from := range pre {
+		if _, ok := post[module]; !ok {
+			changes = append(changes, ModuleChange{Module: module, Change: ModuleRemoved, From: from})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Module < changes[j].Module })
+	return changes
+}
+
+func binaryName(b types.BinarySource) string {
+	switch {
+	case b.Version != "":
+		return b.Version
+	case b.Path != "":
+		return b.Path
+	default:
+		return b.URL
+	}
+}
+
+// Markdown renders the report for people.
+func (r *Report) Markdown() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Upgrade rehearsal: %s\n\n", r.Name)
+	fmt.Fprintf(&b, "| | |\n|---|---|\n")
+	fmt.Fprintf(&b, "| Devnet | %s/%s |\n", r.Namespace, r.Devnet)
+	fmt.Fprintf(&b, "| Upgrade name | %s |\n", r.UpgradeName)
+	if r.Mode != "" {
+		fmt.Fprintf(&b, "| Mode | %s |\n", r.Mode)
+	}
+	if r.NewBinary != "" {
+		fmt.Fprintf(&b, "| New binary | %s |\n", r.NewBinary)
+	}
+	fmt.Fprintf(&b, "| Result | %s |\n", r.Phase)
+	if r.Error != "" {
+		fmt.Fprintf(&b, "| Error | %s |\n", r.Error)
+	}
+	fmt.Fprintf(&b, "| Started | %s |\n", r.StartedAt.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "| Duration | %s |\n", formatMs(r.DurationMs))
+
+	b.WriteString("\n## Stages\n\n")
+	if len(r.Stages) == 0 {
+		b.WriteString("No stages were recorded.\n")
+	} else {
+		b.WriteString("| Stage | Started | Duration |\n|---|---|---|\n")
+		for _, s := range r.Stages {
+			fmt.Fprintf(&b, "| %s | %s | %s |\n", s.Phase, s.StartedAt.UTC().Format(time.RFC3339), formatMs(s.DurationMs))
+		}
+	}
+
+	b.WriteString("\n## Proposal\n\n")
+	if r.Proposal.ID == 0 {
+		b.WriteString("No governance proposal was submitted.\n")
+	} else {
+		fmt.Fprintf(&b, "- ID: %d\n", r.Proposal.ID)
+		fmt.Fprintf(&b, "- Upgrade height: %d\n", r.Proposal.TargetHeight)
+		fmt.Fprintf(&b, "- Votes: %d/%d\n", r.Proposal.VotesReceived, r.Proposal.VotesRequired)
+	}
+
+	b.WriteString("\n## App hashes\n\n")
+	fmt.Fprintf(&b, "- Before upgrade: %s\n", formatAppHash(r.AppHashes.PreHeight, r.AppHashes.Pre))
+	fmt.Fprintf(&b, "- After upgrade: %s\n", formatAppHash(r.AppHashes.PostHeight, r.AppHashes.Post))
+
+	b.WriteString("\n## Module versions\n\n")
+	if len(r.Modules) == 0 {
+		b.WriteString("No module version changes were recorded.\n")
+	} else {
+		b.WriteString("| Module | Change | Before | After |\n|---|---|---|---|\n")
+		for _, m := range r.Modules {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", m.Module, m.Change, formatVersion(m.From), formatVersion(m.To))
+		}
+	}
+
+	if len(r.Exports) > 0 {
+		b.WriteString("\n## State exports\n\n")
+		for _, kind := range []string{"pre", "post"} {
+			if path, ok := r.Exports[kind]; ok {
+				fmt.Fprintf(&b, "- %s-upgrade: %s\n", kind, path)
+			}
+		}
+	}
+
+	b.WriteString("\n## Warnings\n\n")
+	if len(r.Warnings) == 0 {
+		b.WriteString("None.\n")
+	} else {
+		for _, w := range r.Warnings {
+			fmt.Fprintf(&b, "- %s\n", w)
+		}
+	}
+	return b.String()
+}
+
+func formatMs(ms int64) string {
+	return (time.Duration(ms) * time.Millisecond).Round(time.Second).String()
+}
+
+func formatAppHash(height int64, hash string) string {
+	if hash == "" {
+		return "not recorded"
+	}
+	return fmt.Sprintf("`%s` at height %d", hash, height)
+}
+
+func formatVersion(v uint64) string {
+	if v == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d", v)
+}
+
+// Paths returns the JSON and markdown paths of an upgrade's report in dir.
+func Paths(dir, namespace, name string) (jsonPath, markdownPath string) {
+	base := filepath.Join(dir, namespace, name)
+	return base + ".json", base + ".md"
+}
+
+// Write writes the report as JSON and markdown under dir and returns the
+// JSON path.
+func Write(dir string, r *Report) (string, error) {
+	jsonPath, markdownPath := Paths(dir, r.Namespace, r.Name)
+	if err := os.MkdirAll(filepath.Dir(jsonPath), 0755); err != nil {
+		return "", fmt.Errorf("failed to create report directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode report: %w", err)
+	}
+	if err := os.WriteFile(jsonPath, append(data, '\n'), 0644); err != nil {
+		return "", fmt.Errorf("failed to write report: %w", err)
+	}
+	if err := os.WriteFile(markdownPath, []byte(r.Markdown()), 0644); err != nil {
+		return "", fmt.Errorf("failed to write report: %w", err)
+	}
+	return jsonPath, nil
+}
+
+// Read loads the report of an upgrade from dir.
+func Read(dir, namespace, name string) (*Report, error) {
+	jsonPath, _ := Paths(dir, namespace, name)
+	data, err := os.ReadFile(jsonPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to read report: %w", err)
+	}
+
+	var r Report
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("failed to decode report %s: %w", jsonPath, err)
+	}
+	return &r, nil
+}
@@ -0,0 +1,138 @@
+// internal/daemon/upgradereport/report_test.go
+package upgradereport
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/daemon/types"
+)
+
+func TestDiffModuleVersions(t *testing.T) {
+	pre := map[string]uint64{"bank": 4, "gov": 5, "crisis": 2}
+	post := map[string]uint64{"bank": 4, "gov": 6, "feemarket": 1}
+
+	got := DiffModuleVersions(pre, post)
+	want := []ModuleChange{
+		{Module: "crisis", Change: ModuleRemoved, From: 2},
+		{Module: "feemarket", Change: ModuleAdded, To: 1},
+		{Module: "gov", Change: ModuleBumped, From: 5, To: 6},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffModuleVersions() = %+v, want %+v", got, want)
+	}
+
+	if got := DiffModuleVersions(nil, post); got != nil {
+		t.Errorf("DiffModuleVersions(nil, post) = %+v, want nil", got)
+	}
+}
+
+func testUpgrade(start time.Time) *types.Upgrade {
+	return &types.Upgrade{
+		Metadata: types.ResourceMeta{Name: "v2", Namespace: "default", CreatedAt: start},
+		Spec: types.UpgradeSpec{
+			DevnetRef:    "mydevnet",
+			UpgradeName:  "v2.0",
+			TargetHeight: 120,
+			NewBinary:    types.BinarySource{Type: "cache", Version: "v2.0.0"},
+		},
+		Status: types.UpgradeStatus{
+			Phase:              types.UpgradePhaseCompleted,
+			ProposalID:         3,
+			VotesReceived:      4,
+			VotesRequired:      4,
+			PreUpgradeHeight:   119,
+			PreUpgradeAppHash:  "AAAA",
+			PostUpgradeHeight:  121,
+			PostUpgradeAppHash: "BBBB",
+			PreModuleVersions:  map[string]uint64{"gov": 5},
+			PostModuleVersions: map[string]uint64{"gov": 6},
+			Warnings:           []string{"pre-upgrade export failed: disk full"},
+			PhaseHistory: []types.UpgradePhaseRecord{
+				{Phase: types.UpgradePhasePending, StartedAt: start, CompletedAt: start.Add(time.Second)},
+				{Phase: types.UpgradePhaseVoting, StartedAt: start.Add(time.Second), CompletedAt: start.Add(31 * time.Second)},
+				{Phase: types.UpgradePhaseCompleted, StartedAt: start.Add(31 * time.Second)},
+			},
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	now := start.Add(40 * time.Second)
+
+	r := Build(testUpgrade(start), now)
+
+	if r.DurationMs != 40000 {
+		t.Errorf("DurationMs = %d, want 40000", r.DurationMs)
+	}
+	if len(r.Stages) != 3 {
+		t.Fatalf("Stages = %+v, want 3", r.Stages)
+	}
+	if r.Stages[1].DurationMs != 30000 {
+		t.Errorf("Voting duration = %d, want 30000", r.Stages[1].DurationMs)
+	}
+	// The open last stage is closed at now.
+	if !r.Stages[2].CompletedAt.Equal(now) {
+		t.Errorf("last stage CompletedAt = %v, want %v", r.Stages[2].CompletedAt, now)
+	}
+	if r.Proposal.ID != 3 || r.Proposal.TargetHeight != 120 {
+		t.Errorf("Proposal = %+v", r.Proposal)
+	}
+	if r.AppHashes.Pre != "AAAA" || r.AppHashes.Post != "BBBB" {
+		t.Errorf("AppHashes = %+v", r.AppHashes)
+	}
+	if len(r.Modules) != 1 || r.Modules[0].Change != ModuleBumped {
+		t.Errorf("Modules = %+v, want gov bumped", r.Modules)
+	}
+	if r.NewBinary != "v2.0.0" {
+		t.Errorf("NewBinary = %q, want v2.0.0", r.NewBinary)
+	}
+}
+
+func TestReport_Markdown(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	md := Build(testUpgrade(start), start.Add(40*time.Second)).Markdown()
+
+	for _, want := range []string{
+		"# Upgrade rehearsal: v2",
+		"| Voting | 2026-01-02T03:04:06Z | 30s |",
+		"- ID: 3",
+		"`AAAA` at height 119",
+		"| gov | bumped | 5 | 6 |",
+		"- pre-upgrade export failed: disk full",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("Markdown() missing %q:\n%s", want, md)
+		}
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	r := Build(testUpgrade(start), start.Add(40*time.Second))
+
+	path, err := Write(dir, r)
+	if err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if want, _ := Paths(dir, "default", "v2"); path != want {
+		t.Errorf("Write path = %q, want %q", path, want)
+	}
+
+	got, err := Read(dir, "default", "v2")
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if !reflect.DeepEqual(got, r) {
+		t.Errorf("Read() = %+v, want %+v", got, r)
+	}
+
+	if _, err := Read(dir, "default", "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Read(missing) error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,385 @@
+// internal/daemon/valset/valset.go
+
+// Package valset queries a devnet's historical validator sets and diffs them
+// between two heights, to review the effect of slashing tests, scale
+// operations, and upgrade rehearsals.
+package valset
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Validator is a validator as of one height.
+type Validator struct {
+	// ConsensusAddress is the hex CometBFT validator address.
+	ConsensusAddress string
+	// PubKey is the base64 consensus public key, used to join CometBFT and
+	// staking module data.
+	PubKey string
+	// OperatorAddress is the staking module operator address, if known.
+	OperatorAddress string
+	// Moniker is the validator's description moniker, if known.
+	Moniker string
+	// VotingPower is the CometBFT voting power; zero outside the active set.
+	VotingPower int64
+	// Active reports whether the validator is in the CometBFT validator set.
+	Active bool
+	// Jailed reports whether the staking module has the validator jailed.
+	Jailed bool
+}
+
+// Set is the validator set at a height, including staking validators that
+// are outside the active set.
+type Set struct {
+	Height     int64
+	Validators []Validator
+}
+
+// TotalPower returns the summed voting power of the active set.
+func (s *Set) TotalPower() int64 {
+	var total int64
+	for _, v := range s.Validators {
+		total += v.VotingPower
+	}
+	return total
+}
+
+// ActiveCount returns the number of validators in the active set.
+func (s *Set) ActiveCount() int {
+	n := 0
+	for _, v := range s.Validators {
+		if v.Active {
+			n++
+		}
+	}
+	return n
+}
+
+// Config configures a Querier.
+type Config struct {
+	// RPCEndpoint is the CometBFT RPC base URL (e.g., "http://127.0.0.1:26657").
+	RPCEndpoint string
+
+	// RESTEndpoint is the Cosmos SDK REST API base URL, used for monikers and
+	// jail status. Optional.
+	RESTEndpoint string
+
+	// HTTPClient is used for queries. Defaults to a client with a 30s timeout.
+	HTTPClient *http.Client
+}
+
+// Querier reads historical validator sets from a node.
+type Querier struct {
+	config Config
+	client *http.Client
+}
+
+// NewQuerier creates a new Querier.
+func NewQuerier(cfg Config) *Querier {
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Querier{config: cfg, client: httpClient}
+}
+
+// cometValidatorsPageSize is the maximum page size of the /validators RPC.
+const cometValidatorsPageSize = 100
+
+type cometValidatorsResponse struct {
+	Result struct {
+		BlockHeight string `json:"block_height"`
+		Validators  []struct {
+			Address string `json:"address"`
+			PubKey  struct {
+				Value string `json:"value"`
+			} `json:"pub_key"`
+			VotingPower string `json:"voting_power"`
+		} `json:"validators"`
+		Total string `json:"total"`
+	} `json:"result"`
+	Error *struct {
+		Message string `json:"message"`
+		Data    string `json:"data"`
+	} `json:"error"`
+}
+
+type stakingValidatorsResponse struct {
+	Validators []struct {
+		OperatorAddress string `json:"operator_address"`
+		ConsensusPubkey struct {
+			Key string `json:"key"`
+		} `json:"consensus_pubkey"`
+		Jailed      bool `json:"jailed"`
+		Description struct {
+			Moniker string `json:"moniker"`
+		} `json:"description"`
+	} `json:"validators"`
+	Pagination struct {
+		NextKey *string `json:"next_key"`
+	} `json:"pagination"`
+}
+
+// Get returns the validator set at height. A zero height means the latest
+// block.
+func (q *Querier) Get(ctx context.Context, height int64) (*Set, error) {
+	set, err := q.cometValidators(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	if q.config.RESTEndpoint == "" {
+		return set, nil
+	}
+	if err := q.addStakingValidators(ctx, set); err != nil {
+		return nil, fmt.Errorf("failed to query staking validators at height %d: %w", set.Height, err)
+	}
+	return set, nil
+}
+
+// cometValidators pages through the CometBFT validator set at height.
+func (q *Querier) cometValidators(ctx context.Context, height int64) (*Set, error) {
+	set := &Set{}
+	for page := 1; ; page++ {
+		params := url.Values{}
+		if height > 0 {
+			params.Set("height", strconv.FormatInt(height, 10))
+		}
+		params.Set("page", strconv.Itoa(page))
+		params.Set("per_page", strconv.Itoa(cometValidatorsPageSize))
+
+		var resp cometValidatorsResponse
+		if err := q.getJSON(ctx, q.config.RPCEndpoint, "/validators?"+params.Encode(), 0, &resp); err != nil {
+			return nil, fmt.Errorf("failed to query validators at height %d: %w", height, err)
+		}
+		if resp.Error != nil {
+			return nil, fmt.Errorf("failed to query validators at height %d: %s %s", height, resp.Error.Message, resp.Error.Data)
+		}
+
+		set.Height, _ = strconv.ParseInt(resp.Result.BlockHeight, 10, 64)
+		for _, v := range resp.Result.Validators {
+			power, err := strconv.ParseInt(v.VotingPower, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid voting power %q for %s", v.VotingPower, v.Address)
+			}
+			set.Validators = append(set.Validators, Validator{
+				ConsensusAddress: v.Address,
+				PubKey:           v.PubKey.Value,
+				VotingPower:      power,
+				Active:           true,
+			})
+		}
+
+		total, _ := strconv.Atoi(resp.Result.Total)
+		if len(resp.Result.Validators) == 0 || len(set.Validators) >= total {
+			break
+		}
+		// Pin later pages to the height of the first, in case it was latest.
+		height = set.Height
+	}
+	return set, nil
+}
+
+// addStakingValidators merges monikers and jail status from the staking
+// module at the set's height, adding staking validators outside the active set.
+func (q *Querier) addStakingValidators(ctx context.Context, set *Set) error {
+	byKey := make(map[string]int, len(set.Validators))
+	for i, v := range set.Validators {
+		byKey[v.PubKey] = i
+	}
+
+	nextKey := ""
+	for {
+		params := url.Values{}
+		params.Set("pagination.limit", "200")
+		if nextKey != "" {
+			params.Set("pagination.key", nextKey)
+		}
+
+		var resp stakingValidatorsResponse
+		if err := q.getJSON(ctx, q.config.RESTEndpoint, "/cosmos/staking/v1beta1/validators?"+params.Encode(), set.Height, &resp); err != nil {
+			return err
+		}
+
+		for _, sv := range resp.Validators {
+			i, ok := byKey[sv.ConsensusPubkey.Key]
+			if !ok {
+				set.Validators = append(set.Validators, Validator{
+					ConsensusAddress: consensusAddress(sv.ConsensusPubkey.Key),
+					PubKey:           sv.ConsensusPubkey.Key,
+				})
+				i = len(set.Validators) - 1
+				byKey[sv.ConsensusPubkey.Key] = i
+			}
+			set.Validators[i].OperatorAddress = sv.OperatorAddress
+			set.Validators[i].Moniker = sv.Description.Moniker
+			set.Validators[i].Jailed = sv.Jailed
+		}
+
+		if resp.Pagination.NextKey == nil || *resp.Pagination.NextKey == "" {
+			return nil
+		}
+		nextKey = *resp.Pagination.NextKey
+	}
+}
+
+// consensusAddress derives the CometBFT address of a base64 ed25519 public
+// key, the first 20 bytes of its SHA-256 hash. Other key types return "".
+func consensusAddress(pubKey string) string {
+	key, err := base64.StdEncoding.DecodeString(pubKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return ""
+	}
+	sum := sha256.Sum256(key)
+	return strings.ToUpper(hex.EncodeToString(sum[:20]))
+}
+
+// getJSON performs a GET against base+path and decodes the response into
+// out. A non-zero height pins a REST query to that block.
+func (q *Querier) getJSON(ctx context.Context, base, path string, height int64, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(base, "/")+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if height > 0 {
+		req.Header.Set("x-cosmos-block-height", strconv.FormatInt(height, 10))
+	}
+
+	resp, err := q.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+	// CometBFT reports RPC errors such as pruned heights in a JSON-RPC body
+	// with a non-200 status; let the caller decode those.
+	if resp.StatusCode != http.StatusOK && !json.Valid(body) {
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// ChangeKind classifies how a validator changed between two sets.
+type ChangeKind string
+
+// Change kinds, in the order Diff reports them.
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeJailed   ChangeKind = "jailed"
+	ChangeUnjailed ChangeKind = "unjailed"
+	ChangePower    ChangeKind = "power"
+)
+
+var kindOrder = map[ChangeKind]int{
+	ChangeAdded:    0,
+	ChangeRemoved:  1,
+	ChangeJailed:   2,
+	ChangeUnjailed: 3,
+	ChangePower:    4,
+}
+
+// Change is how one validator differs between two sets.
+type Change struct {
+	Kind             ChangeKind
+	ConsensusAddress string
+	OperatorAddress  string
+	Moniker          string
+	FromPower        int64
+	ToPower          int64
+}
+
+// Diff compares two validator sets. Each changed validator is reported once;
+// a jail status change takes precedence over leaving or joining the active
+// set, which takes precedence over a voting power change.
+func Diff(from, to *Set) []Change {
+	fromByKey := make(map[string]Validator, len(from.Validators))
+	for _, v := range from.Validators {
+		fromByKey[v.PubKey] = v
+	}
+	toByKey := make(map[string]Validator, len(to.Validators))
+	for _, v := range to.Validators {
+		toByKey[v.PubKey] = v
+	}
+
+	keys := make([]string, 0, len(fromByKey)+len(toByKey))
+	for k := range fromByKey {
+		keys = append(keys, k)
+	}
+	for k := range toByKey {
+		if _, ok := fromByKey[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+
+	var changes []Change
+	for _, k := range keys {
+		f, t := fromByKey[k], toByKey[k]
+
+		var kind ChangeKind
+		switch {
+		case t.Jailed && !f.Jailed:
+			kind = ChangeJailed
+		case f.Jailed && !t.Jailed:
+			kind = ChangeUnjailed
+		case t.Active && !f.Active:
+			kind = ChangeAdded
+		case f.Active && !t.Active:
+			kind = ChangeRemoved
+		case f.VotingPower != t.VotingPower:
+			kind = ChangePower
+		default:
+			continue
+		}
+
+		changes = append(changes, Change{
+			Kind:             kind,
+			ConsensusAddress: firstNonEmpty(t.ConsensusAddress, f.ConsensusAddress),
+			OperatorAddress:  firstNonEmpty(t.OperatorAddress, f.OperatorAddress),
+			Moniker:          firstNonEmpty(t.Moniker, f.Moniker),
+			FromPower:        f.VotingPower,
+			ToPower:          t.VotingPower,
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Kind != changes[j].Kind {
+			return kindOrder[changes[i].Kind] < kindOrder[changes[j].Kind]
+		}
+		di := changes[i].ToPower - changes[i].FromPower
+		dj := changes[j].ToPower - changes[j].FromPower
+		if di != dj {
+			return di > dj
+		}
+		return changes[i].ConsensusAddress < changes[j].ConsensusAddress
+	})
+	return changes
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,137 @@
+// internal/daemon/valset/valset_test.go
+package valset
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Base64 ed25519 public keys of the fake chain's validators.
+const (
+	keyA = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="
+	keyB = "AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE="
+	keyC = "AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI="
+)
+
+// newFakeNode serves CometBFT /validators and staking validators for heights
+// 10 and 20. Between them, validator B was jailed and A gained power.
+func newFakeNode(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/validators":
+			height := r.URL.Query().Get("height")
+			if height == "" {
+				height = "20"
+			}
+			switch height {
+			case "10":
+				fmt.Fprintf(w, `{"result":{"block_height":"10","validators":[
+					{"address":"AA","pub_key":{"value":%q},"voting_power":"100"},
+					{"address":"BB","pub_key":{"value":%q},"voting_power":"100"}],"total":"2"}}`, keyA, keyB)
+			case "20":
+				fmt.Fprintf(w, `{"result":{"block_height":"20","validators":[
+					{"address":"AA","pub_key":{"value":%q},"voting_power":"150"}],"total":"1"}}`, keyA)
+			default:
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprint(w, `{"error":{"message":"Internal error","data":"height 5 is not available"}}`)
+			}
+		case "/cosmos/staking/v1beta1/validators":
+			jailed := r.Header.Get("x-cosmos-block-height") == "20"
+			fmt.Fprintf(w, `{"validators":[
+				{"operator_address":"valoper1a","consensus_pubkey":{"key":%q},"jailed":false,"description":{"moniker":"validator-0"}},
+				{"operator_address":"valoper1b","consensus_pubkey":{"key":%q},"jailed":%t,"description":{"moniker":"validator-1"}},
+				{"operator_address":"valoper1c","consensus_pubkey":{"key":%q},"jailed":false,"description":{"moniker":"candidate"}}],
+				"pagination":{"next_key":null}}`, keyA, keyB, jailed, keyC)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestQuerier_Get(t *testing.T) {
+	srv := newFakeNode(t)
+	q := NewQuerier(Config{RPCEndpoint: srv.URL, RESTEndpoint: srv.URL})
+
+	set, err := q.Get(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), set.Height)
+	assert.Equal(t, 2, set.ActiveCount())
+	assert.Equal(t, int64(200), set.TotalPower())
+	require.Len(t, set.Validators, 3)
+	assert.Equal(t, "validator-0", set.Validators[0].Moniker)
+
+	candidate := set.Validators[2]
+	assert.False(t, candidate.Active)
+	assert.Equal(t, "candidate", candidate.Moniker)
+	assert.Len(t, candidate.ConsensusAddress, 40, "address derived from the ed25519 key")
+	assert.Equal(t, strings.ToUpper(candidate.ConsensusAddress), candidate.ConsensusAddress)
+}
+
+func TestQuerier_GetLatest(t *testing.T) {
+	srv := newFakeNode(t)
+	q := NewQuerier(Config{RPCEndpoint: srv.URL})
+
+	set, err := q.Get(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), set.Height)
+	require.Len(t, set.Validators, 1, "no staking data without a REST endpoint")
+}
+
+func TestQuerier_GetUnavailableHeight(t *testing.T) {
+	srv := newFakeNode(t)
+	q := NewQuerier(Config{RPCEndpoint: srv.URL})
+
+	_, err := q.Get(context.Background(), 5)
+	assert.ErrorContains(t, err, "height 5 is not available")
+}
+
+func TestDiff(t *testing.T) {
+	srv := newFakeNode(t)
+	q := NewQuerier(Config{RPCEndpoint: srv.URL, RESTEndpoint: srv.URL})
+	from, err := q.Get(context.Background(), 10)
+	require.NoError(t, err)
+	to, err := q.Get(context.Background(), 20)
+	require.NoError(t, err)
+
+	changes := Diff(from, to)
+	require.Len(t, changes, 2)
+
+	assert.Equal(t, ChangeJailed, changes[0].Kind)
+	assert.Equal(t, "validator-1", changes[0].Moniker)
+	assert.Equal(t, int64(100), changes[0].FromPower)
+	assert.Equal(t, int64(0), changes[0].ToPower)
+
+	assert.Equal(t, ChangePower, changes[1].Kind)
+	assert.Equal(t, "valoper1a", changes[1].OperatorAddress)
+	assert.Equal(t, int64(150), changes[1].ToPower)
+}
+
+func TestDiff_AddedAndRemoved(t *testing.T) {
+	from := &Set{Validators: []Validator{
+		{ConsensusAddress: "AA", PubKey: keyA, VotingPower: 10, Active: true},
+		{ConsensusAddress: "BB", PubKey: keyB, VotingPower: 10, Active: true},
+	}}
+	to := &Set{Validators: []Validator{
+		{ConsensusAddress: "AA", PubKey: keyA, VotingPower: 10, Active: true},
+		{ConsensusAddress: "CC", PubKey: keyC, VotingPower: 5, Active: true},
+	}}
+
+	changes := Diff(from, to)
+	require.Len(t, changes, 2)
+	assert.Equal(t, ChangeAdded, changes[0].Kind)
+	assert.Equal(t, "CC", changes[0].ConsensusAddress)
+	assert.Equal(t, ChangeRemoved, changes[1].Kind)
+	assert.Equal(t, "BB", changes[1].ConsensusAddress)
+
+	assert.Empty(t, Diff(from, from))
+}
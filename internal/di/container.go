@@ -83,6 +83,7 @@ type Container struct {
 	resetUC              *appdevnet.ResetUseCase
 	destroyUC            *appdevnet.DestroyUseCase
 	proposeUC            *upgrade.ProposeUseCase
+	upgradePlanUC        *upgrade.PlanUseCase
 	voteUC               *upgrade.VoteUseCase
 	switchUC             *upgrade.SwitchBinaryUseCase
 	executeUpgradeUC     *upgrade.ExecuteUpgradeUseCase
@@ -580,6 +581,20 @@ func (c *Container) ProposeUseCase() *upgrade.ProposeUseCase {
 	return c.proposeUC
 }
 
+// UpgradePlanUseCase returns the upgrade plan use case (lazy init).
+func (c *Container) UpgradePlanUseCase() *upgrade.PlanUseCase {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.upgradePlanUC == nil {
+		c.upgradePlanUC = upgrade.NewPlanUseCase(
+			c.rpcClient,
+			c.LoggerPort(),
+		)
+	}
+	return c.upgradePlanUC
+}
+
 // VoteUseCase returns the vote use case (lazy init).
 func (c *Container) VoteUseCase() *upgrade.VoteUseCase {
 	c.mu.Lock()
@@ -1,6 +1,7 @@
 // Package dvbcontext provides context management for dvb CLI.
 // It allows setting a default namespace/devnet so users don't need to
-// specify them on every command.
+// specify them on every command. Named contexts also select the daemon dvb
+// talks to; without one in use, the unnamed context file is used.
 package dvbcontext
 
 import (
@@ -32,9 +33,25 @@ func contextFilePath() (string, error) {
 	return filepath.Join(home, ".devnet-builder", contextFileName), nil
 }
 
-// Load reads the current context from file.
+// Load reads the current context: the devnet of the named context in use,
+// or else the unnamed context file.
 // Returns nil (not error) if no context is set.
 func Load() (*Context, error) {
+	named, err := CurrentNamed()
+	if err != nil {
+		return nil, err
+	}
+	if named != nil {
+		if named.Devnet == "" {
+			return nil, nil
+		}
+		namespace := named.Namespace
+		if namespace == "" {
+			namespace = "default"
+		}
+		return &Context{Namespace: namespace, Devnet: named.Devnet}, nil
+	}
+
 	path, err := contextFilePath()
 	if err != nil {
 		return nil, err
@@ -57,8 +74,19 @@ func Load() (*Context, error) {
 	return &Context{Namespace: ns, Devnet: devnet}, nil
 }
 
-// Save writes the context to file.
+// Save sets the current devnet, in the named context in use if there is
+// one.
 func Save(namespace, devnet string) error {
+	contexts, err := LoadContexts()
+	if err != nil {
+		return err
+	}
+	if named := contexts.Current(); named != nil {
+		named.Namespace = namespace
+		named.Devnet = devnet
+		return contexts.Save()
+	}
+
 	path, err := contextFilePath()
 	if err != nil {
 		return err
@@ -77,8 +105,19 @@ func Save(namespace, devnet string) error {
 	return nil
 }
 
-// Clear removes the context file.
+// Clear unsets the current devnet. A named context in use keeps its
+// server.
 func Clear() error {
+	contexts, err := LoadContexts()
+	if err != nil {
+		return err
+	}
+	if named := contexts.Current(); named != nil {
+		named.Namespace = ""
+		named.Devnet = ""
+		return contexts.Save()
+	}
+
 	path, err := contextFilePath()
 	if err != nil {
 		return err
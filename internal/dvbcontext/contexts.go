@@ -0,0 +1,145 @@
+package dvbcontext
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+const contextsFileName = "contexts.yaml"
+
+// NamedContext is a named context, like a kubeconfig context: a devnet, its
+// namespace and the daemon serving it.
+type NamedContext struct {
+	Name      string `yaml:"name"`
+	Namespace string `yaml:"namespace,omitempty"`
+	Devnet    string `yaml:"devnet,omitempty"`
+	// Server is the address of a remote devnetd. Empty means the local
+	// daemon.
+	Server string `yaml:"server,omitempty"`
+	// APIKey authenticates with Server.
+	APIKey string `yaml:"api-key,omitempty"`
+}
+
+// Contexts is the set of named contexts and the one in use, stored in
+// ~/.devnet-builder/contexts.yaml.
+type Contexts struct {
+	CurrentContext string         `yaml:"current-context,omitempty"`
+	Contexts       []NamedContext `yaml:"contexts,omitempty"`
+}
+
+// contextsFilePath returns the path to the named contexts file.
+func contextsFilePath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, ".devnet-builder", contextsFileName), nil
+}
+
+// LoadContexts reads the named contexts.
+// Returns an empty set (not error) if none are defined.
+func LoadContexts() (*Contexts, error) {
+	path, err := contextsFilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Contexts{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read contexts file: %w", err)
+	}
+
+	var c Contexts
+	if err := yaml.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("failed to parse contexts file: %w", err)
+	}
+	return &c, nil
+}
+
+// Save writes the named contexts. The file may hold API keys, so only the
+// owner can read it.
+func (c *Contexts) Save() error {
+	path, err := contextsFilePath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to marshal contexts: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write contexts file: %w", err)
+	}
+	return nil
+}
+
+// Get returns the named context, or nil if it is not defined.
+func (c *Contexts) Get(name string) *NamedContext {
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == name {
+			return &c.Contexts[i]
+		}
+	}
+	return nil
+}
+
+// Current returns the context in use, or nil if no named context is.
+func (c *Contexts) Current() *NamedContext {
+	if c.CurrentContext == "" {
+		return nil
+	}
+	return c.Get(c.CurrentContext)
+}
+
+// Set adds a context, replacing the context of the same name.
+func (c *Contexts) Set(ctx NamedContext) {
+	if existing := c.Get(ctx.Name); existing != nil {
+		*existing = ctx
+		return
+	}
+	c.Contexts = append(c.Contexts, ctx)
+}
+
+// Delete removes the named context, reporting whether it was defined.
+// Deleting the context in use leaves no named context in use.
+func (c *Contexts) Delete(name string) bool {
+	n := len(c.Contexts)
+	c.Contexts = slices.DeleteFunc(c.Contexts, func(ctx NamedContext) bool {
+		return ctx.Name == name
+	})
+	if c.CurrentContext == name {
+		c.CurrentContext = ""
+	}
+	return len(c.Contexts) != n
+}
+
+// Use makes the named context the one in use. An empty name goes back to
+// the unnamed context of 'dvb use'.
+func (c *Contexts) Use(name string) error {
+	if name != "" && c.Get(name) == nil {
+		return fmt.Errorf("context %q not found", name)
+	}
+	c.CurrentContext = name
+	return nil
+}
+
+// CurrentNamed returns the named context in use, or nil if none is.
+func CurrentNamed() (*NamedContext, error) {
+	c, err := LoadContexts()
+	if err != nil {
+		return nil, err
+	}
+	return c.Current(), nil
+}
@@ -0,0 +1,119 @@
+package dvbcontext
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestContexts(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	c, err := LoadContexts()
+	if err != nil {
+		t.Fatalf("LoadContexts() error = %v", err)
+	}
+	if c.Current() != nil {
+		t.Fatalf("Current() = %v, want nil with no contexts", c.Current())
+	}
+
+	c.Set(NamedContext{Name: "staging", Server: "devnetd.example.com:9000", APIKey: "devnet_abc"})
+	c.Set(NamedContext{Name: "local", Devnet: "my-devnet"})
+	c.Set(NamedContext{Name: "staging", Server: "devnetd.example.com:9001"})
+	if len(c.Contexts) != 2 {
+		t.Fatalf("Set() left %d contexts, want 2", len(c.Contexts))
+	}
+	if err := c.Use("missing"); err == nil {
+		t.Error("Use() of an undefined context should fail")
+	}
+	if err := c.Use("staging"); err != nil {
+		t.Fatalf("Use() error = %v", err)
+	}
+	if err := c.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	path, _ := contextsFilePath()
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("contexts file not written: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("contexts file mode = %o, want 600", perm)
+	}
+
+	named, err := CurrentNamed()
+	if err != nil {
+		t.Fatalf("CurrentNamed() error = %v", err)
+	}
+	if named == nil || named.Server != "devnetd.example.com:9001" {
+		t.Errorf("CurrentNamed() = %v, want staging on port 9001", named)
+	}
+
+	if !c.Delete("staging") {
+		t.Error("Delete() of a defined context = false")
+	}
+	if c.CurrentContext != "" {
+		t.Errorf("CurrentContext = %q after deleting it, want empty", c.CurrentContext)
+	}
+	if c.Delete("staging") {
+		t.Error("Delete() of an undefined context = true")
+	}
+}
+
+func TestLoadSaveClear_NamedContext(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("HOME", tmpDir)
+
+	// The unnamed context is ignored while a named context is in use
+	if err := Save("default", "unnamed-devnet"); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	c := &Contexts{}
+	c.Set(NamedContext{Name: "staging", Namespace: "team-a", Devnet: "shared"})
+	if err := c.Use("staging"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if ctx == nil || ctx.String() != "team-a/shared" {
+		t.Fatalf("Load() = %v, want team-a/shared", ctx)
+	}
+
+	// Save and Clear change the named context, not the unnamed one
+	if err := Save("team-a", "other"); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	c, _ = LoadContexts()
+	if got := c.Get("staging").Devnet; got != "other" {
+		t.Errorf("named context devnet = %q, want other", got)
+	}
+	if err := Clear(); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+	if ctx, _ := Load(); ctx != nil {
+		t.Errorf("Load() after Clear() = %v, want nil", ctx)
+	}
+	data, err := os.ReadFile(filepath.Join(tmpDir, ".devnet-builder", contextFileName))
+	if err != nil || string(data) != "default/unnamed-devnet\n" {
+		t.Errorf("unnamed context = %q, %v; want it untouched", data, err)
+	}
+
+	// Leaving named contexts restores the unnamed context
+	c, _ = LoadContexts()
+	if err := c.Use(""); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+	if ctx, _ := Load(); ctx == nil || ctx.Devnet != "unnamed-devnet" {
+		t.Errorf("Load() = %v, want the unnamed context", ctx)
+	}
+}
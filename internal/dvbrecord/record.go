@@ -0,0 +1,229 @@
+// Package dvbrecord records the dvb commands used to shape a devnet into a
+// replayable manifest, so a devnet's setup can be reconstructed and shared.
+//
+// While a recording is active, every successful state-changing dvb command
+// is appended to the manifest with its arguments as typed. Files passed with
+// --file are embedded so the manifest can be replayed on another machine.
+package dvbrecord
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestVersion is the version of the manifest format.
+const ManifestVersion = 1
+
+const activeFileName = "recording"
+
+// ErrNotRecording is returned by Stop when no recording is active.
+var ErrNotRecording = errors.New("no recording in progress")
+
+// Manifest is a recorded sequence of dvb commands.
+type Manifest struct {
+	Version int    `yaml:"version"`
+	Name    string `yaml:"name"`
+
+	// Context is the namespace/devnet selected with 'dvb use' when the
+	// recording started, so replay resolves devnets the same way.
+	Context string `yaml:"context,omitempty"`
+
+	StartedAt time.Time `yaml:"startedAt"`
+	StoppedAt time.Time `yaml:"stoppedAt,omitempty"`
+	Steps     []Step    `yaml:"steps"`
+}
+
+// Step is a single recorded dvb command.
+type Step struct {
+	// Args are the command line arguments after "dvb".
+	Args []string  `yaml:"args"`
+	At   time.Time `yaml:"at"`
+
+	// Files holds the contents of files passed with --file, by the path
+	// given on the command line.
+	Files map[string]string `yaml:"files,omitempty"`
+}
+
+// activeFilePath returns the path of the file pointing at the manifest being
+// recorded.
+func activeFilePath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, ".devnet-builder", activeFileName), nil
+}
+
+// Active returns the path of the manifest being recorded, or "" if no
+// recording is active.
+func Active() (string, error) {
+	path, err := activeFilePath()
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read recording state: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// Start begins recording to a new manifest at path.
+func Start(path, name, context string, now time.Time) error {
+	if active, err := Active(); err != nil {
+		return err
+	} else if active != "" {
+		return fmt.Errorf("already recording to %s; stop it first with 'dvb record stop'", active)
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q: %w", path, err)
+	}
+	if _, err := os.Stat(abs); err == nil {
+		return fmt.Errorf("%s already exists", abs)
+	}
+
+	m := &Manifest{Version: ManifestVersion, Name: name, Context: context, StartedAt: now}
+	if err := Save(abs, m); err != nil {
+		return err
+	}
+
+	state, err := activeFilePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(state), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(state, []byte(abs+"\n"), 0644); err != nil {
+		return fmt.Errorf("failed to write recording state: %w", err)
+	}
+	return nil
+}
+
+// Record appends a step to the active recording. It is a no-op if no
+// recording is active.
+func Record(step Step) error {
+	path, err := Active()
+	if err != nil || path == "" {
+		return err
+	}
+	m, err := Load(path)
+	if err != nil {
+		return err
+	}
+	m.Steps = append(m.Steps, step)
+	return Save(path, m)
+}
+
+// Stop ends the active recording and returns its path and manifest.
+func Stop(now time.Time) (string, *Manifest, error) {
+	path, err := Active()
+	if err != nil {
+		return "", nil, err
+	}
+	if path == "" {
+		return "", nil, ErrNotRecording
+	}
+
+	state, err := activeFilePath()
+	if err != nil {
+		return "", nil, err
+	}
+	if err := os.Remove(state); err != nil && !os.IsNotExist(err) {
+		return "", nil, fmt.Errorf("failed to clear recording state: %w", err)
+	}
+
+	m, err := Load(path)
+	if err != nil {
+		return "", nil, err
+	}
+	m.StoppedAt = now
+	if err := Save(path, m); err != nil {
+		return "", nil, err
+	}
+	return path, m, nil
+}
+
+// Load reads a manifest.
+func Load(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	var m Manifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w", path, err)
+	}
+	if m.Version != ManifestVersion {
+		return nil, fmt.Errorf("unsupported manifest version %d in %s", m.Version, path)
+	}
+	return &m, nil
+}
+
+// Save writes a manifest.
+func Save(path string, m *Manifest) error {
+	data, err := yaml.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
+
+// secretFlags are flags whose values are never recorded.
+var secretFlags = []string{"--api-key"}
+
+// Redact replaces the values of secret flags in args.
+func Redact(args []string) []string {
+	out := make([]string, len(args))
+	copy(out, args)
+	for i, arg := range out {
+		for _, flag := range secretFlags {
+			switch {
+			case arg == flag && i+1 < len(out):
+				out[i+1] = "REDACTED"
+			case strings.HasPrefix(arg, flag+"="):
+				out[i] = flag + "=REDACTED"
+			}
+		}
+	}
+	return out
+}
+
+// ReplayArgs returns the step's arguments with embedded files written under
+// dir and their paths replaced.
+func (s Step) ReplayArgs(dir string) ([]string, error) {
+	args := make([]string, len(s.Args))
+	copy(args, s.Args)
+
+	i := 0
+	for path, content := range s.Files {
+		i++
+		local := filepath.Join(dir, fmt.Sprintf("%d-%s", i, filepath.Base(path)))
+		if err := os.WriteFile(local, []byte(content), 0600); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		for j, arg := range args {
+			switch {
+			case arg == path && j > 0:
+				args[j] = local
+			case strings.HasSuffix(arg, "="+path):
+				args[j] = strings.TrimSuffix(arg, path) + local
+			}
+		}
+	}
+	return args, nil
+}
@@ -0,0 +1,112 @@
+package dvbrecord
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRecordLifecycle(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	path := filepath.Join(t.TempDir(), "session.yaml")
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// Nothing is recorded without an active recording.
+	if err := Record(Step{Args: []string{"provision"}}); err != nil {
+		t.Fatalf("Record without recording: %v", err)
+	}
+
+	if err := Start(path, "setup", "default/my-devnet", now); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if err := Start(path, "again", "", now); err == nil {
+		t.Error("expected error starting a second recording")
+	}
+
+	step := Step{
+		Args:  []string{"provision", "-f", "devnet.yaml"},
+		At:    now.Add(time.Minute),
+		Files: map[string]string{"devnet.yaml": "kind: Devnet\n"},
+	}
+	if err := Record(step); err != nil {
+		t.Fatalf("Record: %v", err)
+	}
+
+	active, err := Active()
+	if err != nil || active != path {
+		t.Errorf("Active() = %q, %v; want %q", active, err, path)
+	}
+
+	stopped, m, err := Stop(now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	if stopped != path || m.Name != "setup" || m.Context != "default/my-devnet" || !m.StoppedAt.Equal(now.Add(time.Hour)) {
+		t.Errorf("unexpected manifest: %+v", m)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(loaded.Steps) != 1 || !reflect.DeepEqual(loaded.Steps[0].Args, step.Args) || loaded.Steps[0].Files["devnet.yaml"] != "kind: Devnet\n" {
+		t.Errorf("unexpected steps: %+v", loaded.Steps)
+	}
+
+	if _, _, err := Stop(now); !errors.Is(err, ErrNotRecording) {
+		t.Errorf("expected ErrNotRecording, got %v", err)
+	}
+}
+
+func TestLoad_UnsupportedVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.yaml")
+	if err := os.WriteFile(path, []byte("version: 99\nname: x\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("expected error for unsupported version")
+	}
+}
+
+func TestRedact(t *testing.T) {
+	got := Redact([]string{"list", "--api-key", "secret", "--server=x", "--api-key=secret"})
+	want := []string{"list", "--api-key", "REDACTED", "--server=x", "--api-key=REDACTED"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Redact() = %v, want %v", got, want)
+	}
+}
+
+func TestStep_ReplayArgs(t *testing.T) {
+	dir := t.TempDir()
+	step := Step{
+		Args:  []string{"provision", "-f", "configs/devnet.yaml", "--name", "devnet.yaml"},
+		Files: map[string]string{"configs/devnet.yaml": "kind: Devnet\n"},
+	}
+
+	args, err := step.ReplayArgs(dir)
+	if err != nil {
+		t.Fatalf("ReplayArgs: %v", err)
+	}
+
+	local := filepath.Join(dir, "1-devnet.yaml")
+	want := []string{"provision", "-f", local, "--name", "devnet.yaml"}
+	if !reflect.DeepEqual(args, want) {
+		t.Errorf("ReplayArgs() = %v, want %v", args, want)
+	}
+	content, err := os.ReadFile(local)
+	if err != nil || string(content) != "kind: Devnet\n" {
+		t.Errorf("embedded file = %q, %v", content, err)
+	}
+
+	step.Args = []string{"provision", "--file=configs/devnet.yaml"}
+	args, err = step.ReplayArgs(dir)
+	if err != nil {
+		t.Fatalf("ReplayArgs: %v", err)
+	}
+	if want := []string{"provision", "--file=" + local}; !reflect.DeepEqual(args, want) {
+		t.Errorf("ReplayArgs() = %v, want %v", args, want)
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"io"
 	"os"
 	"os/exec"
-	"syscall"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
 )
@@ -136,11 +135,8 @@ func (e *PassthroughExecutor) ExecuteInteractive(ctx context.Context, cmd ports.
 	execCmd.Stdout = os.Stdout
 	execCmd.Stderr = os.Stderr
 
-	// Set process group for proper signal handling
-	// This ensures Ctrl+C is properly forwarded to the child process
-	execCmd.SysProcAttr = &syscall.SysProcAttr{
-		Setpgid: false, // Don't create a new process group (inherit parent's)
-	}
+	// The child stays in the parent's process group (the default), so
+	// Ctrl+C reaches it directly
 
 	// Start the process
 	if err := execCmd.Start(); err != nil {
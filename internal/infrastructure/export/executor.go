@@ -57,10 +57,6 @@ func (e *ExportExecutor) ExportAtHeight(
 		return "", fmt.Errorf("failed to create output directory: %w", err)
 	}
 
-	// Create command context with timeout
-	cmdCtx, cancel := context.WithTimeout(ctx, e.timeout)
-	defer cancel()
-
 	// Build export command
 	args := []string{
 		"export",
@@ -68,6 +64,26 @@ func (e *ExportExecutor) ExportAtHeight(
 		"--height", fmt.Sprintf("%d", height),
 	}
 
+	genesis, err := e.Export(ctx, binaryPath, args, height)
+	if err != nil {
+		return "", err
+	}
+
+	// Write genesis to file
+	if err := os.WriteFile(outputPath, genesis, 0644); err != nil {
+		return "", fmt.Errorf("failed to write genesis file: %w", err)
+	}
+
+	return outputPath, nil
+}
+
+// Export runs binaryPath with args, an export command for the given height,
+// and returns the genesis JSON it prints after checking its structure.
+func (e *ExportExecutor) Export(ctx context.Context, binaryPath string, args []string, height int64) ([]byte, error) {
+	// Create command context with timeout
+	cmdCtx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+
 	// Execute command and capture stdout
 	cmd := exec.CommandContext(cmdCtx, binaryPath, args...)
 	var stdout, stderr bytes.Buffer
@@ -75,7 +91,7 @@ func (e *ExportExecutor) ExportAtHeight(
 	cmd.Stderr = &stderr
 
 	if err := cmd.Run(); err != nil {
-		return "", &ExportError{
+		return nil, &ExportError{
 			Operation: "export",
 			Height:    height,
 			Message:   fmt.Sprintf("export command failed: %v\nStderr: %s", err, stderr.String()),
@@ -85,7 +101,7 @@ func (e *ExportExecutor) ExportAtHeight(
 	// Extract genesis JSON from stdout
 	genesis, err := extractGenesisJSON(stdout.Bytes())
 	if err != nil {
-		return "", &ExportError{
+		return nil, &ExportError{
 			Operation: "extract_json",
 			Height:    height,
 			Message:   fmt.Sprintf("failed to extract genesis JSON: %v", err),
@@ -94,19 +110,14 @@ func (e *ExportExecutor) ExportAtHeight(
 
 	// Validate genesis structure
 	if err := validateGenesisStructure(genesis); err != nil {
-		return "", &ExportError{
+		return nil, &ExportError{
 			Operation: "validate",
 			Height:    height,
 			Message:   fmt.Sprintf("invalid genesis structure: %v", err),
 		}
 	}
 
-	// Write genesis to file
-	if err := os.WriteFile(outputPath, genesis, 0644); err != nil {
-		return "", fmt.Errorf("failed to write genesis file: %w", err)
-	}
-
-	return outputPath, nil
+	return genesis, nil
 }
 
 // GetBinaryVersion queries the binary version.
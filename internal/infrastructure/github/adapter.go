@@ -3,6 +3,7 @@ package github
 
 import (
 	"context"
+	"errors"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
 )
@@ -45,10 +46,16 @@ func (a *Adapter) FetchReleases(ctx context.Context) ([]ports.GitHubRelease, *po
 	return convertReleases(releases), convertRateLimitInfo(rateLimit), nil
 }
 
-// FetchReleasesWithCache fetches releases with caching support.
+// FetchReleasesWithCache fetches releases with caching support. Cached
+// releases are returned along with a *StaleDataWarning when the API could
+// not be reached.
 func (a *Adapter) FetchReleasesWithCache(ctx context.Context) ([]ports.GitHubRelease, bool, error) {
 	releases, fromCache, err := a.client.FetchReleasesWithCache(ctx)
 	if err != nil {
+		var stale *StaleDataWarning
+		if errors.As(err, &stale) {
+			return convertReleases(releases), fromCache, err
+		}
 		return nil, fromCache, err
 	}
 
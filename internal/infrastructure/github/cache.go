@@ -1,6 +1,8 @@
 package github
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -219,3 +221,45 @@ func (m *CacheManager) IsContainerCacheExpired(cache *ContainerVersionCache) boo
 	}
 	return time.Now().After(cache.ExpiresAt)
 }
+
+// etagPath returns the cache file of the conditional request entry for key.
+func (m *CacheManager) etagPath(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(m.cacheDir, "etags", hex.EncodeToString(sum[:])+".json")
+}
+
+// loadETag loads the cached response for a conditional request. It
+// returns nil if there is none.
+func (m *CacheManager) loadETag(key string) (*etagEntry, error) {
+	data, err := os.ReadFile(m.etagPath(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read etag cache file: %w", err)
+	}
+
+	var entry etagEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to parse etag cache file: %w", err)
+	}
+	if entry.ETag == "" {
+		return nil, nil
+	}
+	return &entry, nil
+}
+
+// saveETag saves the response of a request with its ETag, for later
+// conditional requests.
+func (m *CacheManager) saveETag(key string, entry *etagEntry) error {
+	path := m.etagPath(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create etag cache directory: %w", err)
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal etag cache: %w", err)
+	}
+	return writeFileAtomic(path, data)
+}
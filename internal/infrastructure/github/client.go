@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -25,6 +24,17 @@ const (
 	DefaultPerPage = 100
 )
 
+// privateRepoMessage explains a 404 from a repository endpoint.
+const privateRepoMessage = `Repository not found. This usually means the repository is private and requires authentication.
+
+To set up GitHub authentication:
+  1. Create a Personal Access Token at https://github.com/settings/tokens
+     - For classic tokens: select 'repo' scope
+     - For fine-grained tokens: select 'Contents' read access
+  2. Configure the token using one of these methods:
+     - Run: dvb auth github login (or devnet-builder config set github-token <your-token>)
+     - Or set environment variable: export GITHUB_TOKEN=<your-token>`
+
 // RateLimitInfo contains GitHub API rate limit information.
 type RateLimitInfo struct {
 	Limit     int
@@ -39,6 +49,10 @@ type Client struct {
 	owner      string
 	repo       string
 	cache      *CacheManager
+
+	maxRateLimitWait time.Duration
+	sleep            func(ctx context.Context, d time.Duration) error
+	now              func() time.Time
 }
 
 // ClientOption is a function that configures a Client.
@@ -73,12 +87,23 @@ func WithHTTPClient(client *http.Client) ClientOption {
 	}
 }
 
+// WithMaxRateLimitWait sets the longest the client waits for a rate limit
+// to reset before failing. Zero fails immediately.
+func WithMaxRateLimitWait(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.maxRateLimitWait = d
+	}
+}
+
 // NewClient creates a new GitHub API client.
 func NewClient(opts ...ClientOption) *Client {
 	c := &Client{
-		httpClient: &http.Client{Timeout: 30 * time.Second},
-		owner:      DefaultOwner,
-		repo:       DefaultRepo,
+		httpClient:       &http.Client{Timeout: 30 * time.Second},
+		owner:            DefaultOwner,
+		repo:             DefaultRepo,
+		maxRateLimitWait: DefaultMaxRateLimitWait,
+		sleep:            sleepContext,
+		now:              time.Now,
 	}
 
 	for _, opt := range opts {
@@ -119,79 +144,55 @@ func (c *Client) FetchReleases(ctx context.Context) ([]GitHubRelease, *RateLimit
 
 // fetchPage fetches a single page of releases.
 func (c *Client) fetchPage(ctx context.Context, url string) ([]GitHubRelease, string, *RateLimitInfo, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	resp, err := c.get(ctx, url, privateRepoMessage)
 	if err != nil {
-		return nil, "", nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, "", nil, err
 	}
 
-	// Set headers
-	req.Header.Set("Accept", "application/vnd.github+json")
-	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
-	if c.token != "" {
-		req.Header.Set("Authorization", "Bearer "+c.token)
+	var releases []GitHubRelease
+	if err := json.Unmarshal(resp.Body, &releases); err != nil {
+		return nil, "", resp.RateLimit, fmt.Errorf("failed to parse releases: %w", err)
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, "", nil, fmt.Errorf("failed to fetch releases: %w", err)
-	}
-	defer resp.Body.Close()
+	// Parse Link header for pagination
+	nextURL := parseNextPageURL(resp.Link)
 
-	// Parse rate limit info
-	rateLimitInfo := parseRateLimitHeaders(resp)
+	return releases, nextURL, resp.RateLimit, nil
+}
 
-	// Check for rate limiting
-	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
-		return nil, "", rateLimitInfo, &RateLimitError{
-			Limit:     rateLimitInfo.Limit,
-			Remaining: rateLimitInfo.Remaining,
-			Reset:     rateLimitInfo.Reset,
-		}
-	}
+// FetchReleaseByTag fetches a single release, including its assets, by tag name.
+func (c *Client) FetchReleaseByTag(ctx context.Context, tag string) (*GitHubRelease, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/releases/tags/%s", GitHubAPIBaseURL, c.owner, c.repo, tag)
 
-	// Check for authentication errors
-	if resp.StatusCode == http.StatusUnauthorized {
-		return nil, "", rateLimitInfo, &AuthenticationError{
-			Message: "GitHub authentication failed. Check your token.",
-		}
+	resp, err := c.get(ctx, url, fmt.Sprintf("release %s not found in %s/%s", tag, c.owner, c.repo))
+	if err != nil {
+		return nil, err
 	}
 
-	// Check for 404 Not Found - often means private repo without proper token
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, "", rateLimitInfo, &NotFoundError{
-			Message: `Repository not found. This usually means the repository is private and requires authentication.
-
-To set up GitHub authentication:
-  1. Create a Personal Access Token at https://github.com/settings/tokens
-     - For classic tokens: select 'repo' scope
-     - For fine-grained tokens: select 'Contents' read access
-  2. Configure the token using one of these methods:
-     - Run: devnet-builder config set github-token <your-token>
-     - Or set environment variable: export GITHUB_TOKEN=<your-token>`,
-		}
+	var release GitHubRelease
+	if err := json.Unmarshal(resp.Body, &release); err != nil {
+		return nil, fmt.Errorf("failed to parse release: %w", err)
 	}
 
-	// Check for other errors
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, "", rateLimitInfo, fmt.Errorf("GitHub API error: %d - %s", resp.StatusCode, string(body))
-	}
+	return &release, nil
+}
 
-	// Parse response
-	body, err := io.ReadAll(resp.Body)
+// FetchLatestRelease fetches the most recent non-prerelease, non-draft
+// release, including its assets.
+func (c *Client) FetchLatestRelease(ctx context.Context) (*GitHubRelease, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/releases/latest", GitHubAPIBaseURL, c.owner, c.repo)
+
+	resp, err := c.get(ctx, url, fmt.Sprintf("no published release found in %s/%s", c.owner, c.repo))
 	if err != nil {
-		return nil, "", rateLimitInfo, fmt.Errorf("failed to read response: %w", err)
+		return nil, err
 	}
 
-	var releases []GitHubRelease
-	if err := json.Unmarshal(body, &releases); err != nil {
-		return nil, "", rateLimitInfo, fmt.Errorf("failed to parse releases: %w", err)
+	var release GitHubRelease
+	if err := json.Unmarshal(resp.Body, &release); err != nil {
+		return nil, fmt.Errorf("failed to parse release: %w", err)
 	}
 
-	// Parse Link header for pagination
-	nextURL := parseNextPageURL(resp.Header.Get("Link"))
-
-	return releases, nextURL, rateLimitInfo, nil
+	return &release, nil
 }
 
 // parseRateLimitHeaders extracts rate limit info from response headers.
@@ -276,76 +277,17 @@ func (c *Client) FetchContainerVersions(ctx context.Context, packageName string)
 	url := fmt.Sprintf("%s/orgs/%s/packages/container/%s/versions?per_page=%d&state=active",
 		GitHubAPIBaseURL, c.owner, packageName, DefaultPerPage)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Set headers
-	req.Header.Set("Accept", "application/vnd.github+json")
-	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
-	if c.token != "" {
-		req.Header.Set("Authorization", "Bearer "+c.token)
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to fetch container versions: %w", err)
-	}
-	defer resp.Body.Close()
-
-	// Parse rate limit info
-	rateLimitInfo := parseRateLimitHeaders(resp)
-
-	// Check for rate limiting
-	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
-		return nil, rateLimitInfo, &RateLimitError{
-			Limit:     rateLimitInfo.Limit,
-			Remaining: rateLimitInfo.Remaining,
-			Reset:     rateLimitInfo.Reset,
-		}
-	}
-
-	// Check for authentication errors
-	if resp.StatusCode == http.StatusUnauthorized {
-		return nil, rateLimitInfo, &AuthenticationError{
-			Message: "GitHub authentication failed. Check your token.",
-		}
-	}
-
-	// Check for 404 Not Found - often means private repo without proper token
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, rateLimitInfo, &NotFoundError{
-			Message: `Repository not found. This usually means the repository is private and requires authentication.
-
-To set up GitHub authentication:
-  1. Create a Personal Access Token at https://github.com/settings/tokens
-     - For classic tokens: select 'repo' scope
-     - For fine-grained tokens: select 'Contents' read access
-  2. Configure the token using one of these methods:
-     - Run: devnet-builder config set github-token <your-token>
-     - Or set environment variable: export GITHUB_TOKEN=<your-token>`,
-		}
-	}
-
-	// Check for other errors
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, rateLimitInfo, fmt.Errorf("GitHub API error: %d - %s", resp.StatusCode, string(body))
-	}
-
-	// Parse response
-	body, err := io.ReadAll(resp.Body)
+	resp, err := c.get(ctx, url, privateRepoMessage)
 	if err != nil {
-		return nil, rateLimitInfo, fmt.Errorf("failed to read response: %w", err)
+		return nil, nil, err
 	}
 
 	var versions []ContainerVersion
-	if err := json.Unmarshal(body, &versions); err != nil {
-		return nil, rateLimitInfo, fmt.Errorf("failed to parse container versions: %w", err)
+	if err := json.Unmarshal(resp.Body, &versions); err != nil {
+		return nil, resp.RateLimit, fmt.Errorf("failed to parse container versions: %w", err)
 	}
 
-	return versions, rateLimitInfo, nil
+	return versions, resp.RateLimit, nil
 }
 
 // GetImageVersions returns simplified version list for UI display.
@@ -0,0 +1,179 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rewriteTransport sends every request to a test server.
+type rewriteTransport struct {
+	target *url.URL
+}
+
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newTestClient returns a client that talks to handler and records its
+// sleeps instead of sleeping. Rate limits seen by earlier tests are
+// forgotten.
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...ClientOption) (*Client, *[]time.Duration) {
+	t.Helper()
+	rateLimits = &rateLimitTracker{limits: make(map[string]RateLimitInfo)}
+	etags = sync.Map{}
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	target, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	opts = append([]ClientOption{
+		WithHTTPClient(&http.Client{Transport: rewriteTransport{target: target}}),
+		WithOwnerRepo("owner", "repo"),
+	}, opts...)
+	c := NewClient(opts...)
+	var sleeps []time.Duration
+	c.sleep = func(ctx context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		return nil
+	}
+	return c, &sleeps
+}
+
+func TestClientETag(t *testing.T) {
+	var requests, notModified atomic.Int32
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"tag_name":"v1.0.0","assets":[{"name":"bin"}]}`))
+	})
+
+	for i := 0; i < 2; i++ {
+		release, err := c.FetchReleaseByTag(context.Background(), "v1.0.0")
+		require.NoError(t, err)
+		assert.Equal(t, "v1.0.0", release.TagName)
+		assert.Len(t, release.Assets, 1)
+	}
+	assert.EqualValues(t, 2, requests.Load())
+	assert.EqualValues(t, 1, notModified.Load())
+}
+
+func TestClientETagPersisted(t *testing.T) {
+	cache := NewCacheManager(t.TempDir(), time.Hour)
+	var notModified atomic.Int32
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`[{"tag_name":"v1.0.0"}]`))
+	}
+
+	c, _ := newTestClient(t, handler, WithCache(cache))
+	_, _, err := c.FetchReleases(context.Background())
+	require.NoError(t, err)
+
+	// A new process: the ETag comes from the cache directory.
+	c, _ = newTestClient(t, handler, WithCache(cache))
+	releases, _, err := c.FetchReleases(context.Background())
+	require.NoError(t, err)
+	require.Len(t, releases, 1)
+	assert.Equal(t, "v1.0.0", releases[0].TagName)
+	assert.EqualValues(t, 1, notModified.Load())
+}
+
+func TestClientPrimaryRateLimit(t *testing.T) {
+	reset := time.Now().Add(time.Hour)
+	var requests atomic.Int32
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("X-RateLimit-Limit", "60")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message":"API rate limit exceeded"}`))
+	}
+	c, sleeps := newTestClient(t, handler)
+
+	_, err := c.FetchReleaseByTag(context.Background(), "v1.0.0")
+	var rateLimited *RateLimitError
+	require.True(t, errors.As(err, &rateLimited), "got %v", err)
+	assert.Equal(t, 60, rateLimited.Limit)
+	assert.False(t, rateLimited.Authenticated)
+	assert.Contains(t, err.Error(), "rate limited until")
+	assert.Contains(t, err.Error(), "configure a GitHub token")
+	assert.Empty(t, *sleeps, "a reset an hour away is not waited for")
+
+	// The exhausted limit is remembered: the next request fails without
+	// reaching GitHub.
+	_, _, err = c.FetchReleases(context.Background())
+	assert.True(t, errors.As(err, &rateLimited))
+	assert.EqualValues(t, 1, requests.Load())
+}
+
+func TestClientSecondaryRateLimitBacksOff(t *testing.T) {
+	var requests atomic.Int32
+	c, sleeps := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"tag_name":"v1.0.0"}`))
+	}, WithToken("ghp_test"))
+
+	release, err := c.FetchReleaseByTag(context.Background(), "v1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0.0", release.TagName)
+	assert.Equal(t, []time.Duration{2 * time.Second}, *sleeps)
+}
+
+func TestClientRetriesServerErrors(t *testing.T) {
+	var requests atomic.Int32
+	c, sleeps := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"tag_name":"v1.0.0"}`))
+	})
+
+	_, err := c.FetchReleaseByTag(context.Background(), "v1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, *sleeps)
+}
+
+func TestClientForbiddenIsNotRateLimit(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message":"Resource not accessible by personal access token"}`))
+	}, WithToken("ghp_test"))
+
+	_, err := c.FetchReleaseByTag(context.Background(), "v1.0.0")
+	var authErr *AuthenticationError
+	require.True(t, errors.As(err, &authErr), "got %v", err)
+	assert.Contains(t, err.Error(), "Resource not accessible")
+}
@@ -64,10 +64,21 @@ type RateLimitError struct {
 	Limit     int
 	Remaining int
 	Reset     time.Time
+	// Authenticated is set when the request used a token, so configuring
+	// one would not help.
+	Authenticated bool
 }
 
 func (e *RateLimitError) Error() string {
-	return fmt.Sprintf("GitHub API rate limit exceeded. Reset at %s", e.Reset.Format(time.RFC1123))
+	msg := "GitHub API rate limit exceeded"
+	if !e.Reset.IsZero() {
+		msg = fmt.Sprintf("GitHub API rate limited until %s (in %s)",
+			e.Reset.Local().Format("15:04:05 MST"), time.Until(e.Reset).Round(time.Second))
+	}
+	if !e.Authenticated {
+		msg += "; configure a GitHub token for a higher limit: dvb auth github login, or set GITHUB_TOKEN"
+	}
+	return msg
 }
 
 // ShouldSilenceUsage returns true because rate limit errors
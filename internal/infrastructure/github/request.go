@@ -0,0 +1,263 @@
+package github
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxRateLimitWait is the longest the client waits for a rate
+	// limit to reset before failing with a RateLimitError.
+	DefaultMaxRateLimitWait = time.Minute
+
+	// maxAttempts bounds the attempts of a request that hits a rate limit
+	// or a server error.
+	maxAttempts = 3
+
+	// serverErrorBackoff is the wait before retrying a 5xx response; it
+	// doubles with each attempt.
+	serverErrorBackoff = time.Second
+)
+
+// rateLimits remembers the last rate limit seen for each token, shared by
+// all clients of the process, so a client created after the limit ran out
+// does not spend a request to find out.
+var rateLimits = &rateLimitTracker{limits: make(map[string]RateLimitInfo)}
+
+// rateLimitTracker records rate limits by token fingerprint.
+type rateLimitTracker struct {
+	mu     sync.Mutex
+	limits map[string]RateLimitInfo
+}
+
+func (t *rateLimitTracker) record(key string, info *RateLimitInfo) {
+	if info == nil || info.Limit == 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.limits[key] = *info
+}
+
+// exhausted returns the rate limit of key if it has no requests left until
+// a reset still in the future.
+func (t *rateLimitTracker) exhausted(key string, now time.Time) (RateLimitInfo, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	info, ok := t.limits[key]
+	if !ok || info.Remaining > 0 || !info.Reset.After(now) {
+		return RateLimitInfo{}, false
+	}
+	return info, true
+}
+
+// etagEntry is a cached response body with the ETag it was served with.
+type etagEntry struct {
+	ETag string          `json:"etag"`
+	Link string          `json:"link,omitempty"`
+	Body json.RawMessage `json:"body"`
+}
+
+// etags caches conditional request entries in memory for clients without
+// a CacheManager.
+var etags sync.Map
+
+// apiResponse is a successful GitHub API response.
+type apiResponse struct {
+	Body      []byte
+	Link      string
+	RateLimit *RateLimitInfo
+	// NotModified is set when the body came from the ETag cache.
+	NotModified bool
+}
+
+// get performs a GET request against the GitHub API. It sends the ETag of
+// a previous response so unchanged data costs no rate limit, waits for a
+// rate limit to reset or a server error to pass when that takes at most
+// the client's maxRateLimitWait, and maps error statuses to the package's
+// error types. notFound is the message of the NotFoundError for a 404.
+func (c *Client) get(ctx context.Context, url, notFound string) (*apiResponse, error) {
+	key := c.tokenKey()
+	cacheKey := key + " " + url
+	cached := c.loadETag(cacheKey)
+
+	for attempt := 1; ; attempt++ {
+		if info, ok := rateLimits.exhausted(key, c.now()); ok {
+			wait := info.Reset.Sub(c.now())
+			if wait > c.maxRateLimitWait || attempt > maxAttempts {
+				return nil, c.rateLimitError(&info)
+			}
+			if err := c.sleep(ctx, wait); err != nil {
+				return nil, err
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		req.Header.Set("Accept", "application/vnd.github+json")
+		req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+		if c.token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.token)
+		}
+		if cached != nil {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, &NetworkError{Message: fmt.Sprintf("failed to reach GitHub: %v", err), Cause: err}
+		}
+		body, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		info := parseRateLimitHeaders(resp)
+		rateLimits.record(key, info)
+
+		switch {
+		case resp.StatusCode == http.StatusNotModified && cached != nil:
+			return &apiResponse{Body: cached.Body, Link: cached.Link, RateLimit: info, NotModified: true}, nil
+
+		case resp.StatusCode == http.StatusOK:
+			if readErr != nil {
+				return nil, fmt.Errorf("failed to read response: %w", readErr)
+			}
+			link := resp.Header.Get("Link")
+			if etag := resp.Header.Get("ETag"); etag != "" && json.Valid(body) {
+				c.saveETag(cacheKey, &etagEntry{ETag: etag, Link: link, Body: body})
+			}
+			return &apiResponse{Body: body, Link: link, RateLimit: info}, nil
+
+		case isRateLimited(resp, info):
+			wait := retryAfter(resp, info, c.now())
+			if wait > c.maxRateLimitWait || attempt >= maxAttempts {
+				return nil, c.rateLimitError(info)
+			}
+			if err := c.sleep(ctx, wait); err != nil {
+				return nil, err
+			}
+
+		case resp.StatusCode >= http.StatusInternalServerError && attempt < maxAttempts:
+			if err := c.sleep(ctx, serverErrorBackoff<<(attempt-1)); err != nil {
+				return nil, err
+			}
+
+		case resp.StatusCode == http.StatusUnauthorized:
+			return nil, &AuthenticationError{
+				Message: "GitHub authentication failed. Check your token.",
+			}
+
+		case resp.StatusCode == http.StatusForbidden:
+			return nil, &AuthenticationError{
+				Message: fmt.Sprintf("GitHub denied access (403): %s. Check that the token can read this repository.", apiMessage(body)),
+			}
+
+		case resp.StatusCode == http.StatusNotFound:
+			return nil, &NotFoundError{Message: notFound}
+
+		default:
+			return nil, fmt.Errorf("GitHub API error: %d - %s", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// isRateLimited reports whether a response is a primary rate limit (no
+// requests left) or a secondary rate limit (429, or 403 with Retry-After).
+func isRateLimited(resp *http.Response, info *RateLimitInfo) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusForbidden:
+		return resp.Header.Get("Retry-After") != "" ||
+			resp.Header.Get("X-RateLimit-Remaining") != "" && info.Remaining == 0
+	}
+	return false
+}
+
+// retryAfter returns how long to wait before retrying a rate-limited
+// request: the Retry-After header, else the time until the limit resets,
+// else a minute as GitHub recommends for secondary limits.
+func retryAfter(resp *http.Response, info *RateLimitInfo, now time.Time) time.Duration {
+	if s := resp.Header.Get("Retry-After"); s != "" {
+		if secs, err := strconv.Atoi(s); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+	}
+	if info.Remaining == 0 && info.Reset.After(now) {
+		return info.Reset.Sub(now)
+	}
+	return time.Minute
+}
+
+// rateLimitError builds the error for an exhausted rate limit.
+func (c *Client) rateLimitError(info *RateLimitInfo) *RateLimitError {
+	return &RateLimitError{
+		Limit:         info.Limit,
+		Remaining:     info.Remaining,
+		Reset:         info.Reset,
+		Authenticated: c.token != "",
+	}
+}
+
+// apiMessage returns the "message" of a GitHub error body, or the body.
+func apiMessage(body []byte) string {
+	var e struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &e) == nil && e.Message != "" {
+		return e.Message
+	}
+	return string(body)
+}
+
+// tokenKey identifies the client's token without keeping it, so rate
+// limits and cached responses are not shared between tokens.
+func (c *Client) tokenKey() string {
+	if c.token == "" {
+		return "anonymous"
+	}
+	sum := sha256.Sum256([]byte(c.token))
+	return hex.EncodeToString(sum[:8])
+}
+
+func (c *Client) loadETag(key string) *etagEntry {
+	if c.cache != nil {
+		if entry, err := c.cache.loadETag(key); err == nil && entry != nil {
+			return entry
+		}
+		return nil
+	}
+	if v, ok := etags.Load(key); ok {
+		return v.(*etagEntry)
+	}
+	return nil
+}
+
+func (c *Client) saveETag(key string, entry *etagEntry) {
+	if c.cache != nil {
+		_ = c.cache.saveETag(key, entry) // Ignore save errors
+		return
+	}
+	etags.Store(key, entry)
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -10,6 +10,15 @@ type GitHubRelease struct {
 	Prerelease  bool      `json:"prerelease"`   // Is this a pre-release?
 	PublishedAt time.Time `json:"published_at"` // When was it published?
 	HTMLURL     string    `json:"html_url"`     // Link to release page
+
+	Assets []ReleaseAsset `json:"assets,omitempty"` // Files attached to the release
+}
+
+// ReleaseAsset represents a file attached to a GitHub release.
+type ReleaseAsset struct {
+	Name               string `json:"name"`                 // e.g., "gaiad-v19.0.0-linux-amd64"
+	Size               int64  `json:"size"`                 // Size in bytes
+	BrowserDownloadURL string `json:"browser_download_url"` // Public download URL
 }
 
 // VersionCache represents cached version data with metadata.
@@ -3,6 +3,7 @@ package network
 
 import (
 	"fmt"
+	"syscall"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
@@ -25,6 +26,11 @@ func NewPluginAdapter(module pkgNetwork.Module) *PluginAdapter {
 // Verify interface compliance at compile time.
 var _ NetworkModule = (*PluginAdapter)(nil)
 
+// Module returns the wrapped plugin module.
+func (a *PluginAdapter) Module() pkgNetwork.Module {
+	return a.module
+}
+
 // ============================================
 // NetworkIdentity
 // ============================================
@@ -56,6 +62,7 @@ func (a *PluginAdapter) BinarySource() BinarySource {
 		Owner:     src.Owner,
 		Repo:      src.Repo,
 		LocalPath: src.LocalPath,
+		AssetName: src.AssetName,
 		BuildTags: src.BuildTags,
 	}
 }
@@ -440,3 +447,59 @@ func (a *pluginStateExporterAdapter) SnapshotFormat(networkType string) Snapshot
 	format := a.module.SnapshotFormat(networkType)
 	return SnapshotFormat(format)
 }
+
+// ============================================
+// ParamsLayoutProvider (Optional Interface)
+// ============================================
+
+// ParamsLayout implements pkg/network.ParamsLayoutProvider. It reports false,
+// selecting the Cosmos SDK default, unless the plugin overrides the layout.
+func (a *PluginAdapter) ParamsLayout(module string) (pkgNetwork.ParamsLayout, bool) {
+	provider, ok := a.module.(pkgNetwork.ParamsLayoutProvider)
+	if !ok {
+		return pkgNetwork.ParamsLayout{}, false
+	}
+	return provider.ParamsLayout(module)
+}
+
+// ============================================
+// UpgradeNamer (Optional Interface)
+// ============================================
+
+// UpgradeName implements pkg/network.UpgradeNamer. It reports false unless
+// the module has an upgrade naming convention.
+func (a *PluginAdapter) UpgradeName(version string) (string, bool) {
+	namer, ok := a.module.(pkgNetwork.UpgradeNamer)
+	if !ok {
+		return "", false
+	}
+	return namer.UpgradeName(version)
+}
+
+// ============================================
+// ConfigReloader (Optional Interface)
+// ============================================
+
+// ReloadableConfig implements pkg/network.ConfigReloader. It reports no keys
+// unless the module's binary reloads config without restarting.
+func (a *PluginAdapter) ReloadableConfig() ([]string, syscall.Signal) {
+	reloader, ok := a.module.(pkgNetwork.ConfigReloader)
+	if !ok {
+		return nil, 0
+	}
+	return reloader.ReloadableConfig()
+}
+
+// ============================================
+// TxDecoder (Optional Interface)
+// ============================================
+
+// DecodeTx implements pkg/network.TxDecoder, failing if the plugin does not
+// decode transactions.
+func (a *PluginAdapter) DecodeTx(txBytes []byte) (*pkgNetwork.DecodedTx, error) {
+	decoder, ok := a.module.(pkgNetwork.TxDecoder)
+	if !ok {
+		return nil, fmt.Errorf("plugin does not decode transactions")
+	}
+	return decoder.DecodeTx(txBytes)
+}
@@ -25,6 +25,10 @@ type BinarySource struct {
 	// LocalPath is the path to a local binary (required if Type="local")
 	LocalPath string
 
+	// AssetName is a glob pattern matching the prebuilt release asset
+	// (e.g., "gaiad-*-linux-amd64"). Empty means match by binary name and platform.
+	AssetName string
+
 	// MaxRetries is the number of download retry attempts (default: 3)
 	MaxRetries int
 
@@ -60,6 +60,13 @@ func MustRegister(module NetworkModule, panicOnError bool) error {
 	return err
 }
 
+// Replace registers module in the global registry, replacing any module
+// already registered under the same name. It is used when a plugin is
+// reloaded after its binary changed.
+func Replace(module NetworkModule) error {
+	return globalRegistry.replace(module)
+}
+
 // Get retrieves a network module by name from the global registry.
 // Returns an error if the network is not registered.
 func Get(name string) (NetworkModule, error) {
@@ -130,6 +137,25 @@ func (r *registry) register(module NetworkModule) error {
 	return nil
 }
 
+func (r *registry) replace(module NetworkModule) error {
+	if module == nil {
+		return &ModuleValidationError{
+			ModuleName: "<nil>",
+			Reason:     "cannot register nil module",
+		}
+	}
+
+	if err := ValidateModuleCompatibility(module); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.modules[module.Name()] = module
+	return nil
+}
+
 func (r *registry) get(name string) (NetworkModule, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -2,9 +2,11 @@ package nodeconfig
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/output"
@@ -66,8 +68,12 @@ func (e *ConfigEditor) SetPorts(nodeIndex int) error {
 // If host is empty, it defaults to "0.0.0.0" for backward compatibility.
 // For loopback subnet mode, pass the node's assigned IP (e.g., "127.0.42.1").
 func (e *ConfigEditor) SetPortsWithHost(nodeIndex int, host string) error {
-	ports := GetPortConfigForNode(nodeIndex)
+	return e.SetPortConfigWithHost(types.PortConfigForNode(nodeIndex), host)
+}
 
+// SetPortConfigWithHost configures all port-related settings to the given
+// ports on a specific host IP, defaulting to "0.0.0.0" like SetPortsWithHost.
+func (e *ConfigEditor) SetPortConfigWithHost(ports types.PortConfig, host string) error {
 	// Default to 0.0.0.0 for backward compatibility
 	if host == "" {
 		host = "0.0.0.0"
@@ -87,12 +93,12 @@ func (e *ConfigEditor) SetPortsWithHost(nodeIndex int, host string) error {
 	}
 
 	// Proxy app - uses the node's IP for ABCI connections
-	if err := e.setConfigValue(configPath, "proxy_app", fmt.Sprintf("tcp://%s:%d", host, ports.Proxy)); err != nil {
+	if err := e.setConfigValue(configPath, "proxy_app", "tcp://"+hostPort(host, ports.Proxy)); err != nil {
 		return fmt.Errorf("failed to set proxy_app: %w", err)
 	}
 
 	// pprof - bind to node's IP
-	if err := e.setConfigValue(configPath, "pprof_laddr", fmt.Sprintf("%s:%d", host, ports.PProf)); err != nil {
+	if err := e.setConfigValue(configPath, "pprof_laddr", hostPort(host, ports.PProf)); err != nil {
 		return fmt.Errorf("failed to set pprof_laddr: %w", err)
 	}
 
@@ -192,6 +198,12 @@ func (e *ConfigEditor) SetConsensusParams() error {
 	return nil
 }
 
+// SetBlockTime sets timeout_commit in config.toml (e.g., "500ms"), the
+// pause after each block that sets the devnet's block time.
+func (e *ConfigEditor) SetBlockTime(blockTime string) error {
+	return e.setConfigValue(e.ConfigPath(), "timeout_commit", blockTime)
+}
+
 // SetP2PLocalDevnet configures P2P settings for local devnet (allows localhost connections).
 func (e *ConfigEditor) SetP2PLocalDevnet() error {
 	configPath := e.ConfigPath()
@@ -209,6 +221,35 @@ func (e *ConfigEditor) SetP2PLocalDevnet() error {
 	return nil
 }
 
+// SetPruning sets the pruning strategy in app.toml (e.g., "default", "nothing", "everything").
+func (e *ConfigEditor) SetPruning(strategy string) error {
+	return e.setConfigValue(e.AppConfigPath(), "pruning", strategy)
+}
+
+// SetTxIndexer sets the transaction indexer in config.toml ("kv" or "null" to disable).
+func (e *ConfigEditor) SetTxIndexer(indexer string) error {
+	return e.setSectionValue(e.ConfigPath(), "tx_index", "indexer", indexer)
+}
+
+// SetMempoolSize sets the mempool size and cache size in config.toml.
+// Zero values are left unchanged.
+func (e *ConfigEditor) SetMempoolSize(size, cacheSize int) error {
+	configPath := e.ConfigPath()
+
+	if size > 0 {
+		if err := e.setSectionInt(configPath, "mempool", "size", size); err != nil {
+			return err
+		}
+	}
+	if cacheSize > 0 {
+		if err := e.setSectionInt(configPath, "mempool", "cache_size", cacheSize); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // setP2PConfigBool sets a boolean value in the [p2p] section.
 func (e *ConfigEditor) setP2PConfigBool(filePath, key string, value bool) error {
 	content, err := os.ReadFile(filePath)
@@ -242,6 +283,13 @@ func (e *ConfigEditor) SetMoniker(moniker string) error {
 	return e.setConfigValue(e.ConfigPath(), "moniker", moniker)
 }
 
+// SetPrivValidatorLaddr sets the address the node listens on for a remote
+// signer in config.toml, e.g. "tcp://0.0.0.0:26659". While it is set, the
+// node signs through the signer instead of priv_validator_key.json.
+func (e *ConfigEditor) SetPrivValidatorLaddr(laddr string) error {
+	return e.setConfigValue(e.ConfigPath(), "priv_validator_laddr", laddr)
+}
+
 // setConfigValue sets a key=value in a TOML file (line-by-line replacement).
 // Only replaces exact key matches (not keys that start with the same prefix).
 func (e *ConfigEditor) setConfigValue(filePath, key, value string) error {
@@ -274,6 +322,12 @@ func (e *ConfigEditor) setP2PLaddr(filePath string, port int) error {
 	return e.setP2PLaddrWithHost(filePath, port, "0.0.0.0")
 }
 
+// hostPort joins a host and port into a listen address, bracketing IPv6
+// hosts (e.g. "[::]:26656").
+func hostPort(host string, port int) string {
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
 // setP2PLaddrWithHost sets the P2P laddr specifically in the [p2p] section with a specific host.
 func (e *ConfigEditor) setP2PLaddrWithHost(filePath string, port int, host string) error {
 	content, err := os.ReadFile(filePath)
@@ -293,7 +347,7 @@ func (e *ConfigEditor) setP2PLaddrWithHost(filePath string, port int, host strin
 			inP2PSection = false
 		}
 		if inP2PSection && strings.HasPrefix(trimmed, "laddr") {
-			lines[i] = fmt.Sprintf(`laddr = "tcp://%s:%d"`, host, port)
+			lines[i] = fmt.Sprintf(`laddr = "tcp://%s"`, hostPort(host, port))
 			break
 		}
 	}
@@ -328,7 +382,7 @@ func (e *ConfigEditor) setRPCLaddrWithHost(filePath string, port int, host strin
 			inRPCSection = false
 		}
 		if inRPCSection && strings.HasPrefix(trimmed, "laddr") {
-			lines[i] = fmt.Sprintf(`laddr = "tcp://%s:%d"`, host, port)
+			lines[i] = fmt.Sprintf(`laddr = "tcp://%s"`, hostPort(host, port))
 			break
 		}
 	}
@@ -345,7 +399,7 @@ func (e *ConfigEditor) setGRPCAddress(filePath string, port int) error {
 
 // setGRPCAddressWithHost sets the gRPC address in app.toml with a specific host.
 func (e *ConfigEditor) setGRPCAddressWithHost(filePath string, port int, host string) error {
-	return e.setSectionValue(filePath, "grpc", "address", fmt.Sprintf("%s:%d", host, port))
+	return e.setSectionValue(filePath, "grpc", "address", hostPort(host, port))
 }
 
 // setAPIAddress sets the API address in app.toml.
@@ -357,7 +411,7 @@ func (e *ConfigEditor) setAPIAddress(filePath string, port int) error {
 
 // setAPIAddressWithHost sets the API address in app.toml with a specific host.
 func (e *ConfigEditor) setAPIAddressWithHost(filePath string, port int, host string) error {
-	return e.setSectionValue(filePath, "api", "address", fmt.Sprintf("tcp://%s:%d", host, port))
+	return e.setSectionValue(filePath, "api", "address", "tcp://"+hostPort(host, port))
 }
 
 // setEVMRPCAddress sets the EVM JSON-RPC address in app.toml.
@@ -369,7 +423,7 @@ func (e *ConfigEditor) setEVMRPCAddress(filePath string, port int) error {
 
 // setEVMRPCAddressWithHost sets the EVM JSON-RPC address in app.toml with a specific host.
 func (e *ConfigEditor) setEVMRPCAddressWithHost(filePath string, port int, host string) error {
-	return e.setSectionValue(filePath, "json-rpc", "address", fmt.Sprintf("%s:%d", host, port))
+	return e.setSectionValue(filePath, "json-rpc", "address", hostPort(host, port))
 }
 
 // setEVMWSAddress sets the EVM WebSocket address in app.toml.
@@ -381,7 +435,7 @@ func (e *ConfigEditor) setEVMWSAddress(filePath string, port int) error {
 
 // setEVMWSAddressWithHost sets the EVM WebSocket address in app.toml with a specific host.
 func (e *ConfigEditor) setEVMWSAddressWithHost(filePath string, port int, host string) error {
-	return e.setSectionValue(filePath, "json-rpc", "ws-address", fmt.Sprintf("%s:%d", host, port))
+	return e.setSectionValue(filePath, "json-rpc", "ws-address", hostPort(host, port))
 }
 
 // setSectionValue sets a value within a specific TOML section.
@@ -442,6 +496,35 @@ func (e *ConfigEditor) setAppConfigBool(filePath, section, key string, value boo
 	return os.WriteFile(filePath, []byte(strings.Join(lines, "\n")), 0644)
 }
 
+// setSectionInt sets an integer value within a specific TOML section.
+func (e *ConfigEditor) setSectionInt(filePath, section, key string, value int) error {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(string(content), "\n")
+	inSection := false
+	sectionHeader := fmt.Sprintf("[%s]", section)
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == sectionHeader {
+			inSection = true
+			continue
+		}
+		if strings.HasPrefix(trimmed, "[") && trimmed != sectionHeader {
+			inSection = false
+		}
+		if inSection && (strings.HasPrefix(trimmed, key+" ") || strings.HasPrefix(trimmed, key+"=")) {
+			lines[i] = fmt.Sprintf(`%s = %d`, key, value)
+			break
+		}
+	}
+
+	return os.WriteFile(filePath, []byte(strings.Join(lines, "\n")), 0644)
+}
+
 // ConfigureNode applies all necessary configuration for a node.
 //
 // Deprecated: Use ConfigureNodeWithHost instead for loopback subnet support.
@@ -0,0 +1,23 @@
+//go:build !windows
+
+package persistence
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile takes an exclusive lock on f, failing immediately instead of
+// waiting when nonBlocking is set.
+func lockFile(f *os.File, nonBlocking bool) error {
+	how := syscall.LOCK_EX
+	if nonBlocking {
+		how |= syscall.LOCK_NB
+	}
+	return syscall.Flock(int(f.Fd()), how)
+}
+
+// unlockFile releases a lock taken with lockFile.
+func unlockFile(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+}
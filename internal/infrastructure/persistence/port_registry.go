@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"syscall"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/domain/ports"
 )
@@ -120,18 +119,18 @@ func (r *PortRegistryFile) WithLock(ctx context.Context, fn func() error) error
 
 	// Use a separate lock file to avoid race conditions with atomic rename
 	lockPath := r.registryPath + ".lock"
-	lockFile, err := os.OpenFile(lockPath, os.O_RDWR|os.O_CREATE, 0644)
+	lf, err := os.OpenFile(lockPath, os.O_RDWR|os.O_CREATE, 0644)
 	if err != nil {
 		return fmt.Errorf("failed to open lock file: %w", err)
 	}
-	defer lockFile.Close()
+	defer lf.Close()
 
 	// Acquire exclusive lock (blocks until available)
-	if err := syscall.Flock(int(lockFile.Fd()), syscall.LOCK_EX); err != nil {
+	if err := lockFile(lf, false); err != nil {
 		return fmt.Errorf("failed to acquire registry lock: %w", err)
 	}
 	defer func() {
-		_ = syscall.Flock(int(lockFile.Fd()), syscall.LOCK_UN)
+		_ = unlockFile(lf)
 	}()
 
 	// Execute function with lock held
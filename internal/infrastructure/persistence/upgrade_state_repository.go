@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"syscall"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
 )
@@ -220,7 +219,7 @@ func (m *FileUpgradeStateManager) AcquireLock(ctx context.Context) error {
 	}
 
 	// Try to acquire exclusive lock (non-blocking)
-	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+	if err := lockFile(f, true); err != nil {
 		_ = f.Close()
 		// Check if there's an existing state to provide better error message
 		state, loadErr := m.LoadState(ctx)
@@ -243,7 +242,7 @@ func (m *FileUpgradeStateManager) ReleaseLock(ctx context.Context) error {
 		return nil
 	}
 
-	if err := syscall.Flock(int(m.lockFile.Fd()), syscall.LOCK_UN); err != nil {
+	if err := unlockFile(m.lockFile); err != nil {
 		return fmt.Errorf("failed to release lock: %w", err)
 	}
 
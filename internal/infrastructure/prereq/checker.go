@@ -212,23 +212,14 @@ func (c *Checker) checkJq() {
 	c.results = append(c.results, result)
 }
 
-// checkDecompressor checks if zstd or lz4 is installed.
+// checkDecompressor checks if lz4 is installed. zstd, gzip and plain tar
+// snapshots are extracted natively; only lz4 snapshots need the command.
 func (c *Checker) checkDecompressor() {
 	result := PrereqResult{
-		Name:     "decompressor",
-		Required: true,
+		Name:     "lz4",
+		Required: false,
 	}
 
-	// Check for zstd first
-	if path, err := exec.LookPath("zstd"); err == nil {
-		result.Found = true
-		result.Path = path
-		result.Message = "zstd is available"
-		c.results = append(c.results, result)
-		return
-	}
-
-	// Check for lz4
 	if path, err := exec.LookPath("lz4"); err == nil {
 		result.Found = true
 		result.Path = path
@@ -238,8 +229,8 @@ func (c *Checker) checkDecompressor() {
 	}
 
 	result.Found = false
-	result.Message = "No snapshot decompressor found (zstd or lz4)"
-	result.Suggestion = "Install zstd or lz4 with your package manager"
+	result.Message = "lz4 not found (only needed for lz4 snapshots; zstd and gzip are built in)"
+	result.Suggestion = "Install lz4 with your package manager"
 	c.results = append(c.results, result)
 }
 
@@ -254,11 +245,15 @@ func GetDecompressor() (string, error) {
 	return "", fmt.Errorf("no decompressor found (zstd or lz4)")
 }
 
-// CheckDiskSpace checks if there's enough disk space available.
+// CheckDiskSpace checks if there's enough disk space available on the
+// filesystem holding path. It returns the available space in GB.
 func CheckDiskSpace(path string, requiredGB float64) (bool, float64, error) {
-	// This is a simplified implementation
-	// In production, you'd use syscall.Statfs or similar
-	return true, 100.0, nil // Placeholder
+	usage, err := StatDisk(path)
+	if err != nil {
+		return false, 0, err
+	}
+	availableGB := float64(usage.Available) / (1 << 30)
+	return availableGB >= requiredGB, availableGB, nil
 }
 
 // AllPassed returns true if all checks passed.
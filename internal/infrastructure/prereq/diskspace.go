@@ -0,0 +1,45 @@
+package prereq
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// ErrDiskSpaceUnsupported is returned by StatDisk on platforms where free
+// space can't be measured.
+var ErrDiskSpaceUnsupported = errors.New("disk space checks are not supported on this platform")
+
+// DiskUsage describes the filesystem holding a path.
+type DiskUsage struct {
+	// Path is the nearest existing ancestor of the requested path, which
+	// was measured.
+	Path string
+
+	// Device identifies the filesystem; paths with the same Device share
+	// free space.
+	Device uint64
+
+	// Available is the number of bytes available to unprivileged users.
+	Available uint64
+}
+
+// StatDisk returns the usage of the filesystem path is on, or would be
+// created on if it does not exist yet.
+func StatDisk(path string) (DiskUsage, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return DiskUsage{}, err
+	}
+	for {
+		if _, err := os.Stat(path); err == nil {
+			break
+		}
+		parent := filepath.Dir(path)
+		if parent == path {
+			break
+		}
+		path = parent
+	}
+	return statDisk(path)
+}
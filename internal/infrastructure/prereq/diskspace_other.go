@@ -0,0 +1,7 @@
+//go:build !linux && !darwin
+
+package prereq
+
+func statDisk(path string) (DiskUsage, error) {
+	return DiskUsage{}, ErrDiskSpaceUnsupported
+}
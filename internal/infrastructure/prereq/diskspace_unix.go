@@ -0,0 +1,24 @@
+//go:build linux || darwin
+
+package prereq
+
+import (
+	"fmt"
+	"syscall"
+)
+
+func statDisk(path string) (DiskUsage, error) {
+	var fs syscall.Statfs_t
+	if err := syscall.Statfs(path, &fs); err != nil {
+		return DiskUsage{}, fmt.Errorf("statfs %s: %w", path, err)
+	}
+	var st syscall.Stat_t
+	if err := syscall.Stat(path, &st); err != nil {
+		return DiskUsage{}, fmt.Errorf("stat %s: %w", path, err)
+	}
+	return DiskUsage{
+		Path:      path,
+		Device:    uint64(st.Dev),
+		Available: uint64(fs.Bavail) * uint64(fs.Bsize),
+	}, nil
+}
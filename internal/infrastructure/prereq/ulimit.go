@@ -0,0 +1,17 @@
+package prereq
+
+import "errors"
+
+// MinOpenFiles is the open file limit recommended for running a multi-node
+// devnet. Each node keeps its databases, WAL and peer connections open.
+const MinOpenFiles = 8192
+
+// ErrUlimitUnsupported is returned by OpenFileLimit on platforms without
+// resource limits.
+var ErrUlimitUnsupported = errors.New("resource limits are not supported on this platform")
+
+// OpenFileLimit returns the soft and hard limits on open files for the
+// current process. Processes started from the same shell inherit them.
+func OpenFileLimit() (soft, hard uint64, err error) {
+	return openFileLimit()
+}
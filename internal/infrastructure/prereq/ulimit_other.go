@@ -0,0 +1,7 @@
+//go:build !linux && !darwin
+
+package prereq
+
+func openFileLimit() (uint64, uint64, error) {
+	return 0, 0, ErrUlimitUnsupported
+}
@@ -0,0 +1,16 @@
+//go:build linux || darwin
+
+package prereq
+
+import (
+	"fmt"
+	"syscall"
+)
+
+func openFileLimit() (uint64, uint64, error) {
+	var rl syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rl); err != nil {
+		return 0, 0, fmt.Errorf("getrlimit: %w", err)
+	}
+	return uint64(rl.Cur), uint64(rl.Max), nil
+}
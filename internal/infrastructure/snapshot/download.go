@@ -3,12 +3,13 @@ package snapshot
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
@@ -24,6 +25,10 @@ const (
 
 	// DownloadTimeout is the maximum time allowed for a download.
 	DownloadTimeout = 30 * time.Minute
+
+	// progressInterval is how often download and extraction progress is
+	// reported.
+	progressInterval = 500 * time.Millisecond
 )
 
 // DownloadOptions configures the download behavior.
@@ -111,28 +116,11 @@ func Download(ctx context.Context, opts DownloadOptions) (*SnapshotCache, error)
 	return nil, fmt.Errorf("failed to download snapshot after %d attempts: %w", MaxRetries, lastErr)
 }
 
-// downloadFile performs the actual HTTP download.
+// downloadFile fetches a snapshot with the provider for its URL scheme.
 func downloadFile(ctx context.Context, url, destPath string, logger *output.Logger, progress ports.ProgressReporter) error {
-	// Create HTTP client with timeout
-	client := &http.Client{
-		Timeout: DownloadTimeout,
-	}
-
-	// Create request with context
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Start download
-	resp, err := client.Do(req)
+	provider, u, err := ProviderFor(url)
 	if err != nil {
-		return fmt.Errorf("failed to start download: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("download failed with status %d", resp.StatusCode)
+		return err
 	}
 
 	// Create destination file
@@ -143,49 +131,32 @@ func downloadFile(ctx context.Context, url, destPath string, logger *output.Logg
 	}
 	defer out.Close()
 
-	// Copy with progress
-	contentLength := resp.ContentLength
-	var downloaded int64
-	now := time.Now()
-
-	// Create progress reporter
-	progressReader := &progressReader{
-		reader:         resp.Body,
-		total:          contentLength,
-		downloaded:     &downloaded,
-		logger:         logger,
-		progress:       progress,
-		lastReport:     now,
-		reportInterval: 500 * time.Millisecond, // Update every 500ms for smooth progress
-		startTime:      now,
-		lastDownloaded: 0,
-		lastSpeedCheck: now,
-		currentSpeed:   0,
-	}
-
-	_, err = io.Copy(out, progressReader)
+	counter := &Progress{}
+	stopReporting := reportProgress("Downloading snapshot", counter, nil, progress, progressInterval)
+	err = provider.Fetch(ctx, u, out, counter)
+	stopReporting()
 
 	// Note: Terminal progress bar removed - progress reported via ProgressReporter
 
 	if err != nil {
 		os.Remove(tmpPath)
-		return fmt.Errorf("failed to write file: %w", err)
+		return err
 	}
 
 	// Close file before rename
 	out.Close()
 
-	// Validate downloaded file size matches Content-Length
+	// Validate downloaded file size matches the source size
 	// This prevents truncated downloads from being cached as valid
-	if contentLength > 0 {
+	if total := counter.Total(); total > 0 {
 		info, err := os.Stat(tmpPath)
 		if err != nil {
 			os.Remove(tmpPath)
 			return fmt.Errorf("failed to stat downloaded file: %w", err)
 		}
-		if info.Size() != contentLength {
+		if info.Size() != total {
 			os.Remove(tmpPath)
-			return fmt.Errorf("incomplete download: got %d bytes, expected %d bytes", info.Size(), contentLength)
+			return fmt.Errorf("incomplete download: got %d bytes, expected %d bytes", info.Size(), total)
 		}
 	}
 
@@ -198,55 +169,208 @@ func downloadFile(ctx context.Context, url, destPath string, logger *output.Logg
 	return nil
 }
 
-// progressReader wraps an io.Reader to report download progress.
-type progressReader struct {
-	reader         io.Reader
-	total          int64
-	downloaded     *int64
-	logger         *output.Logger
-	progress       ports.ProgressReporter // Optional progress reporter
-	lastReport     time.Time
-	reportInterval time.Duration
-	startTime      time.Time
-	lastDownloaded int64
-	lastSpeedCheck time.Time
-	currentSpeed   float64
+// DownloadAndExtract downloads a snapshot and extracts it into destDir in one
+// pass, piping the download into the extractor rather than extracting once
+// the download completes. With a cache key, the archive is written to the
+// cache as it streams; with NoCache or no cache key, it never touches the
+// disk. A valid cached snapshot is extracted instead of downloaded.
+//
+// A retry extracts over the partial result, rewriting every entry. It returns
+// the cache entry, nil if the archive was not cached, and whether it came
+// from the cache.
+func DownloadAndExtract(ctx context.Context, opts DownloadOptions, destDir string) (*SnapshotCache, bool, error) {
+	logger := opts.Logger
+	if logger == nil {
+		logger = output.DefaultLogger
+	}
+	useCache := !opts.NoCache && opts.CacheKey != ""
+
+	if useCache {
+		cache, err := GetValidCache(opts.HomeDir, opts.CacheKey)
+		if err != nil {
+			logger.Debug("Cache check failed: %v", err)
+		}
+		if cache != nil {
+			if _, err := os.Stat(cache.FilePath); err == nil {
+				logger.Debug("Extracting cached snapshot (expires in %s)", cache.TimeUntilExpiry().Round(time.Minute))
+				counter := &Progress{}
+				stopReporting := reportProgress("Extracting snapshot", counter, nil, opts.Progress, progressInterval)
+				err := ExtractFile(ctx, cache.FilePath, destDir, counter)
+				stopReporting()
+				if err != nil {
+					return nil, false, fmt.Errorf("extraction failed: %w", err)
+				}
+				return cache, true, nil
+			}
+		}
+	}
+
+	var cachePath string
+	if useCache {
+		_, extension := DetectDecompressor(opts.URL)
+		cachePath = SnapshotPath(opts.HomeDir, opts.CacheKey, extension)
+		if err := os.MkdirAll(filepath.Dir(cachePath), 0755); err != nil {
+			return nil, false, fmt.Errorf("failed to create download directory: %w", err)
+		}
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= MaxRetries; attempt++ {
+		if attempt > 1 {
+			logger.Warn("Retry attempt %d/%d...", attempt, MaxRetries)
+			select {
+			case <-ctx.Done():
+				return nil, false, ctx.Err()
+			case <-time.After(RetryDelay):
+			}
+		}
+
+		format, size, err := streamExtract(ctx, opts.URL, destDir, cachePath, opts.Progress)
+		if err == nil {
+			if cachePath == "" {
+				return nil, false, nil
+			}
+			cache := NewSnapshotCache(opts.CacheKey, cachePath, opts.URL, format, size)
+			if err := cache.Save(opts.HomeDir); err != nil {
+				logger.Warn("Failed to save cache metadata: %v", err)
+			}
+			return cache, false, nil
+		}
+
+		lastErr = err
+		logger.Warn("Download failed: %v", err)
+	}
+
+	return nil, false, fmt.Errorf("failed to download snapshot after %d attempts: %w", MaxRetries, lastErr)
 }
 
-func (pr *progressReader) Read(p []byte) (int, error) {
-	n, err := pr.reader.Read(p)
-	*pr.downloaded += int64(n)
-
-	// Report progress periodically
-	if time.Since(pr.lastReport) >= pr.reportInterval {
-		now := time.Now()
-
-		// Calculate speed (bytes per second)
-		timeSinceLastCheck := now.Sub(pr.lastSpeedCheck).Seconds()
-		if timeSinceLastCheck > 0 {
-			bytesDownloaded := *pr.downloaded - pr.lastDownloaded
-			pr.currentSpeed = float64(bytesDownloaded) / timeSinceLastCheck
-			pr.lastDownloaded = *pr.downloaded
-			pr.lastSpeedCheck = now
+// streamExtract pipes a snapshot download into the extractor, also writing
+// it to cachePath if set. It returns the detected compression format and the
+// number of bytes downloaded.
+func streamExtract(ctx context.Context, rawURL, destDir, cachePath string, progress ports.ProgressReporter) (string, int64, error) {
+	provider, u, err := ProviderFor(rawURL)
+	if err != nil {
+		return "", 0, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pr, pw := io.Pipe()
+	var sink io.Writer = pw
+	var out *os.File
+	tmpPath := cachePath + ".tmp"
+	if cachePath != "" {
+		out, err = os.Create(tmpPath)
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to create file: %w", err)
+		}
+		defer out.Close()
+		sink = io.MultiWriter(out, pw)
+	}
+	fail := func(err error) (string, int64, error) {
+		if out != nil {
+			out.Close()
+			os.Remove(tmpPath)
 		}
+		return "", 0, err
+	}
+
+	counter := &Progress{}
+	var files atomic.Int64
+	stopReporting := reportProgress("Downloading snapshot", counter, func() string {
+		return fmt.Sprintf("extracting, %d files", files.Load())
+	}, progress, progressInterval)
+
+	fetched := make(chan error, 1)
+	go func() {
+		err := provider.Stream(ctx, u, sink, counter)
+		pw.CloseWithError(err)
+		fetched <- err
+	}()
+
+	format, extractErr := extractStream(ctx, pr, destDir, &files)
+	if extractErr == nil {
+		// Drain the tar padding so the download, and the cached copy, completes
+		_, extractErr = io.Copy(io.Discard, pr)
+	}
+	if extractErr != nil {
+		cancel()
+		pr.CloseWithError(extractErr)
+	}
+	fetchErr := <-fetched
+	stopReporting()
+
+	// A failed extraction closes the pipe, which fails the fetch with the
+	// same error
+	if fetchErr != nil && !errors.Is(fetchErr, extractErr) {
+		return fail(fetchErr)
+	}
+	if extractErr != nil {
+		return fail(fmt.Errorf("extraction failed: %w", extractErr))
+	}
+	if total := counter.Total(); total > 0 && counter.Downloaded() != total {
+		return fail(fmt.Errorf("incomplete download: got %d bytes, expected %d bytes", counter.Downloaded(), total))
+	}
 
-		pr.lastReport = now
-
-		// Report step progress if progress reporter is provided
-		// Note: Terminal progress bar removed - CLI displays progress via daemon API
-		if pr.progress != nil {
-			pr.progress.ReportStep(ports.StepProgress{
-				Name:    "Downloading snapshot",
-				Status:  "running",
-				Current: *pr.downloaded,
-				Total:   pr.total,
-				Unit:    "bytes",
-				Speed:   pr.currentSpeed,
-			})
+	if out != nil {
+		if err := out.Close(); err != nil {
+			return fail(fmt.Errorf("failed to write cached snapshot: %w", err))
+		}
+		if err := os.Rename(tmpPath, cachePath); err != nil {
+			return fail(fmt.Errorf("failed to rename file: %w", err))
 		}
 	}
+	return format, counter.Downloaded(), nil
+}
+
+// reportProgress reports a step's byte progress and speed every interval
+// until the returned function is called. detail, if set, supplies the step
+// detail.
+func reportProgress(name string, counter *Progress, detail func() string, progress ports.ProgressReporter, interval time.Duration) (stop func()) {
+	if progress == nil {
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
 
-	return n, err
+		lastDownloaded, lastCheck := int64(0), time.Now()
+		for {
+			select {
+			case <-done:
+				return
+			case now := <-ticker.C:
+				// Calculate speed (bytes per second)
+				downloaded := counter.Downloaded()
+				var speed float64
+				if elapsed := now.Sub(lastCheck).Seconds(); elapsed > 0 {
+					speed = float64(downloaded-lastDownloaded) / elapsed
+				}
+				lastDownloaded, lastCheck = downloaded, now
+
+				step := ports.StepProgress{
+					Name:    name,
+					Status:  "running",
+					Current: downloaded,
+					Total:   counter.Total(),
+					Unit:    "bytes",
+					Speed:   speed,
+				}
+				if detail != nil {
+					step.Detail = detail()
+				}
+				progress.ReportStep(step)
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-finished
+	}
 }
 
 // DetectDecompressor determines the decompressor and extension from URL.
@@ -268,24 +392,9 @@ func DetectDecompressor(url string) (decompressor string, extension string) {
 
 // GetSnapshotSize returns the size of a remote snapshot without downloading it.
 func GetSnapshotSize(ctx context.Context, url string) (int64, error) {
-	client := &http.Client{
-		Timeout: 30 * time.Second,
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
-	if err != nil {
-		return 0, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := client.Do(req)
+	provider, u, err := ProviderFor(url)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get size: %w", err)
+		return 0, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return 0, fmt.Errorf("failed with status %d", resp.StatusCode)
-	}
-
-	return resp.ContentLength, nil
+	return provider.Size(ctx, u)
 }
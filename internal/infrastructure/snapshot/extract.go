@@ -0,0 +1,296 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression formats, as recorded in SnapshotCache.Decompressor.
+const (
+	CompressionZstd = "zstd"
+	CompressionLZ4  = "lz4"
+	CompressionGzip = "gzip"
+	CompressionNone = "none"
+)
+
+var (
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	lz4Magic  = []byte{0x04, 0x22, 0x4d, 0x18}
+	gzipMagic = []byte{0x1f, 0x8b}
+	tarMagic  = []byte("ustar")
+)
+
+// tarMagicOffset is the offset of the magic field in a tar header.
+const tarMagicOffset = 257
+
+// DetectCompression returns the compression format of a snapshot from its
+// first bytes, or "" if it is not recognized. A plain tar needs at least 262
+// bytes to be recognized.
+func DetectCompression(header []byte) string {
+	switch {
+	case bytes.HasPrefix(header, zstdMagic):
+		return CompressionZstd
+	case bytes.HasPrefix(header, lz4Magic):
+		return CompressionLZ4
+	case bytes.HasPrefix(header, gzipMagic):
+		return CompressionGzip
+	case len(header) >= tarMagicOffset+len(tarMagic) &&
+		bytes.Equal(header[tarMagicOffset:tarMagicOffset+len(tarMagic)], tarMagic):
+		return CompressionNone
+	}
+	return ""
+}
+
+// NewDecompressor wraps r in a reader that decompresses it, detecting the
+// format by magic bytes rather than the file name. It returns the detected
+// format. lz4 is decompressed by the lz4 command, which must be installed.
+func NewDecompressor(ctx context.Context, r io.Reader) (io.ReadCloser, string, error) {
+	br := bufio.NewReaderSize(r, 64<<10)
+	header, err := br.Peek(tarMagicOffset + len(tarMagic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, "", fmt.Errorf("failed to read snapshot header: %w", err)
+	}
+
+	format := DetectCompression(header)
+	switch format {
+	case CompressionZstd:
+		dec, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to start zstd decoder: %w", err)
+		}
+		return dec.IOReadCloser(), format, nil
+	case CompressionGzip:
+		dec, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to start gzip decoder: %w", err)
+		}
+		return dec, format, nil
+	case CompressionLZ4:
+		dec, err := newCommandReader(ctx, br, "lz4", "-d", "-c")
+		if err != nil {
+			return nil, "", fmt.Errorf("lz4 snapshots need the lz4 command: %w", err)
+		}
+		return dec, format, nil
+	case CompressionNone:
+		return io.NopCloser(br), format, nil
+	}
+	return nil, "", fmt.Errorf("unrecognized snapshot format (want a zstd, lz4 or gzip compressed tar, or a plain tar)")
+}
+
+// commandReader reads the output of a command fed from an io.Reader. Read
+// returns the command's error once its output is exhausted.
+type commandReader struct {
+	stdout   io.ReadCloser
+	cmd      *exec.Cmd
+	stderr   bytes.Buffer
+	waitOnce sync.Once
+	waitErr  error
+}
+
+func newCommandReader(ctx context.Context, stdin io.Reader, name string, args ...string) (*commandReader, error) {
+	r := &commandReader{cmd: exec.CommandContext(ctx, name, args...)}
+	r.cmd.Stdin = stdin
+	r.cmd.Stderr = &r.stderr
+	stdout, err := r.cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	r.stdout = stdout
+	if err := r.cmd.Start(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *commandReader) Read(p []byte) (int, error) {
+	n, err := r.stdout.Read(p)
+	if errors.Is(err, io.EOF) {
+		if werr := r.wait(); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
+
+// Close stops reading and waits for the command to exit.
+func (r *commandReader) Close() error {
+	r.stdout.Close()
+	return r.wait()
+}
+
+func (r *commandReader) wait() error {
+	r.waitOnce.Do(func() {
+		if err := r.cmd.Wait(); err != nil {
+			r.waitErr = fmt.Errorf("%s: %w: %s", r.cmd.Path, err, strings.TrimSpace(r.stderr.String()))
+		}
+	})
+	return r.waitErr
+}
+
+// ExtractStream decompresses and unpacks a snapshot tar from r into destDir.
+func ExtractStream(ctx context.Context, r io.Reader, destDir string) error {
+	_, err := extractStream(ctx, r, destDir, nil)
+	return err
+}
+
+// ExtractFile extracts a snapshot archive into destDir, counting the archive
+// bytes read into progress.
+func ExtractFile(ctx context.Context, archivePath, destDir string, progress *Progress) error {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if progress != nil {
+		if info, err := f.Stat(); err == nil {
+			progress.SetTotal(info.Size())
+		}
+		r = &countingReader{r: f, progress: progress}
+	}
+	_, err = extractStream(ctx, r, destDir, nil)
+	return err
+}
+
+// extractStream is ExtractStream, counting extracted entries into files if
+// it is non-nil. It returns the detected compression format.
+func extractStream(ctx context.Context, r io.Reader, destDir string, files *atomic.Int64) (string, error) {
+	dec, format, err := NewDecompressor(ctx, r)
+	if err != nil {
+		return "", err
+	}
+	err = extractTar(ctx, dec, destDir, files)
+	if cerr := dec.Close(); err == nil {
+		err = cerr
+	}
+	return format, err
+}
+
+// extractTar unpacks a tar stream into destDir. Entries that would land
+// outside destDir, including through links, are rejected.
+func extractTar(ctx context.Context, r io.Reader, destDir string, files *atomic.Int64) error {
+	root, err := filepath.Abs(destDir)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return fmt.Errorf("failed to create destination directory: %w", err)
+	}
+
+	tr := tar.NewReader(&contextReader{ctx: ctx, r: r})
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read tar: %w", err)
+		}
+
+		target, err := tarTarget(root, hdr.Name)
+		if err != nil {
+			return err
+		}
+		mode := os.FileMode(hdr.Mode).Perm()
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, mode|0700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := writeTarFile(tr, target, mode); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			linkTarget := hdr.Linkname
+			if !filepath.IsAbs(linkTarget) {
+				linkTarget = filepath.Join(filepath.Dir(target), linkTarget)
+			}
+			if !withinDir(root, linkTarget) {
+				return fmt.Errorf("tar entry %q links outside the destination", hdr.Name)
+			}
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			os.Remove(target)
+			if err := os.Symlink(hdr.Linkname, target); err != nil {
+				return err
+			}
+		case tar.TypeLink:
+			source, err := tarTarget(root, hdr.Linkname)
+			if err != nil {
+				return err
+			}
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			os.Remove(target)
+			if err := os.Link(source, target); err != nil {
+				return err
+			}
+		default:
+			// Devices, FIFOs and metadata-only entries are not part of chain data.
+			continue
+		}
+		if files != nil {
+			files.Add(1)
+		}
+	}
+}
+
+// tarTarget returns where a tar entry name lands under root.
+func tarTarget(root, name string) (string, error) {
+	target := filepath.Join(root, name)
+	if !withinDir(root, target) {
+		return "", fmt.Errorf("tar entry %q escapes the destination", name)
+	}
+	return target, nil
+}
+
+func withinDir(root, path string) bool {
+	path = filepath.Clean(path)
+	return path == root || strings.HasPrefix(path, root+string(filepath.Separator))
+}
+
+func writeTarFile(r io.Reader, target string, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, r); err != nil {
+		out.Close()
+		return fmt.Errorf("failed to write %s: %w", target, err)
+	}
+	return out.Close()
+}
+
+// countingReader counts bytes read into a Progress.
+type countingReader struct {
+	r        io.Reader
+	progress *Progress
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.progress.Add(int64(n))
+	return n, err
+}
@@ -5,9 +5,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
-	"strings"
 	"time"
 
 	"github.com/altuslabsxyz/devnet-builder/internal/application/ports"
@@ -94,6 +92,28 @@ func (f *FetcherAdapter) DownloadWithCache(ctx context.Context, url, cacheKey st
 	return cache.FilePath, false, nil
 }
 
+// CachedSnapshot returns the path of a previously downloaded snapshot for
+// cacheKey without touching the network. Expired entries are still returned,
+// since offline provisioning prefers a stale snapshot to none at all.
+func (f *FetcherAdapter) CachedSnapshot(cacheKey string) (string, bool) {
+	cache, err := LoadSnapshotCache(f.homeDir, cacheKey)
+	if err != nil || cache == nil {
+		return "", false
+	}
+	if _, err := os.Stat(cache.FilePath); err != nil {
+		return "", false
+	}
+	if cache.IsExpired() {
+		f.logger.Warn("Using expired cached snapshot (offline mode)")
+	}
+	return cache.FilePath, true
+}
+
+// SnapshotSize returns the size of a remote snapshot without downloading it.
+func (f *FetcherAdapter) SnapshotSize(ctx context.Context, url string) (int64, error) {
+	return GetSnapshotSize(ctx, url)
+}
+
 // DownloadWithProgress downloads a snapshot with caching support and progress reporting.
 // If a valid cached snapshot exists, returns the cached path without downloading.
 // The cache is stored in ~/.devnet-builder/snapshots/<cacheKey>/ with 30-minute expiration.
@@ -161,72 +181,40 @@ func (f *FetcherAdapter) DownloadWithProgress(ctx context.Context, url, cacheKey
 	return cache.FilePath, false, nil
 }
 
-// Extract extracts a compressed snapshot.
-// If extraction fails due to a corrupted archive, the cache is automatically cleared.
-func (f *FetcherAdapter) Extract(ctx context.Context, archivePath, destPath string) error {
-	// Detect decompressor from file extension
-	decompressor := detectDecompressorFromPath(archivePath)
-
-	// Get archive size for progress estimation
-	archiveInfo, err := os.Stat(archivePath)
-	if err != nil {
-		return &SnapshotError{
-			Operation: "extract",
-			Message:   fmt.Sprintf("failed to stat archive: %v", err),
-		}
-	}
-
-	// Ensure destination directory exists
-	if err := os.MkdirAll(destPath, 0755); err != nil {
-		return &SnapshotError{
-			Operation: "extract",
-			Message:   fmt.Sprintf("failed to create destination directory: %v", err),
-		}
-	}
-
-	f.logger.Info("Extracting snapshot (%.1f MB)...", float64(archiveInfo.Size())/(1024*1024))
-
-	var cmd *exec.Cmd
-
-	switch decompressor {
-	case "zstd":
-		// Use pv for progress if available, otherwise fallback
-		cmd = exec.CommandContext(ctx, "bash", "-c",
-			fmt.Sprintf("zstd -d -c %q | tar xf - -C %q", archivePath, destPath))
-	case "lz4":
-		cmd = exec.CommandContext(ctx, "bash", "-c",
-			fmt.Sprintf("lz4 -d -c %q | tar xf - -C %q", archivePath, destPath))
-	case "gzip":
-		cmd = exec.CommandContext(ctx, "tar", "xzf", archivePath, "-C", destPath)
-	case "none":
-		cmd = exec.CommandContext(ctx, "tar", "xf", archivePath, "-C", destPath)
-	default:
-		return &SnapshotError{
-			Operation: "extract",
-			Message:   fmt.Sprintf("unknown decompressor: %s", decompressor),
-		}
+// DownloadAndExtract downloads a snapshot and extracts it into destPath in
+// one pass, caching the archive under cacheKey as it streams (see the
+// package-level DownloadAndExtract). Progress is reported as a single
+// "Downloading snapshot" step whose detail counts extracted files.
+func (f *FetcherAdapter) DownloadAndExtract(ctx context.Context, url, cacheKey, destPath string, noCache bool, progress ports.ProgressReporter) (bool, error) {
+	opts := DownloadOptions{
+		URL:      url,
+		CacheKey: cacheKey,
+		HomeDir:  f.homeDir,
+		NoCache:  noCache,
+		Logger:   f.logger,
+		Progress: progress,
 	}
 
-	cmdOutput, err := cmd.CombinedOutput()
+	_, fromCache, err := DownloadAndExtract(ctx, opts, destPath)
 	if err != nil {
-		// Extraction failed - likely corrupted archive
-		// Clear the cache so the next attempt will re-download
-		cacheKey := extractCacheKeyFromPath(archivePath)
-		if cacheKey != "" {
-			f.logger.Warn("Extraction failed, clearing corrupted cache: %s", cacheKey)
-			if clearErr := ClearCache(f.homeDir, cacheKey); clearErr != nil {
-				f.logger.Debug("Failed to clear cache: %v", clearErr)
-			}
-		}
-
-		return &SnapshotError{
-			Operation: "extract",
-			Message:   fmt.Sprintf("extraction failed: %v\nOutput: %s", err, string(cmdOutput)),
+		return false, &SnapshotError{
+			Operation: "download",
+			Message:   err.Error(),
 		}
 	}
+	if fromCache {
+		f.logger.Info("Extracted cached snapshot")
+	} else {
+		f.logger.Success("Snapshot downloaded and extracted")
+	}
+	return fromCache, nil
+}
 
-	f.logger.Success("Extraction complete")
-	return nil
+// Extract extracts a compressed snapshot, detecting its compression from the
+// archive's magic bytes.
+// If extraction fails due to a corrupted archive, the cache is automatically cleared.
+func (f *FetcherAdapter) Extract(ctx context.Context, archivePath, destPath string) error {
+	return f.extract(ctx, archivePath, destPath, nil)
 }
 
 // ExtractWithProgress extracts a compressed snapshot with progress reporting.
@@ -240,8 +228,7 @@ func (f *FetcherAdapter) ExtractWithProgress(ctx context.Context, archivePath, d
 		})
 	}
 
-	// Delegate to the existing Extract method
-	err := f.Extract(ctx, archivePath, destPath)
+	err := f.extract(ctx, archivePath, destPath, progress)
 
 	if err != nil {
 		// Report failure via progress reporter
@@ -266,6 +253,43 @@ func (f *FetcherAdapter) ExtractWithProgress(ctx context.Context, archivePath, d
 	return nil
 }
 
+func (f *FetcherAdapter) extract(ctx context.Context, archivePath, destPath string, progress ports.ProgressReporter) error {
+	// Get archive size for progress estimation
+	archiveInfo, err := os.Stat(archivePath)
+	if err != nil {
+		return &SnapshotError{
+			Operation: "extract",
+			Message:   fmt.Sprintf("failed to stat archive: %v", err),
+		}
+	}
+
+	f.logger.Info("Extracting snapshot (%.1f MB)...", float64(archiveInfo.Size())/(1024*1024))
+
+	counter := &Progress{}
+	stopReporting := reportProgress("Extracting snapshot", counter, nil, progress, progressInterval)
+	err = ExtractFile(ctx, archivePath, destPath, counter)
+	stopReporting()
+	if err != nil {
+		// Extraction failed - likely corrupted archive
+		// Clear the cache so the next attempt will re-download
+		cacheKey := extractCacheKeyFromPath(archivePath)
+		if cacheKey != "" {
+			f.logger.Warn("Extraction failed, clearing corrupted cache: %s", cacheKey)
+			if clearErr := ClearCache(f.homeDir, cacheKey); clearErr != nil {
+				f.logger.Debug("Failed to clear cache: %v", clearErr)
+			}
+		}
+
+		return &SnapshotError{
+			Operation: "extract",
+			Message:   fmt.Sprintf("extraction failed: %v", err),
+		}
+	}
+
+	f.logger.Success("Extraction complete")
+	return nil
+}
+
 // extractCacheKeyFromPath extracts the cache key from a snapshot path.
 // Expected path format: ~/.devnet-builder/snapshots/{cache-key}/snapshot.tar.{ext}
 // cache-key format: "plugin-network" (e.g., "stable-mainnet", "ault-testnet")
@@ -297,24 +321,6 @@ func (f *FetcherAdapter) GetLatestSnapshotURL(ctx context.Context, cacheKey stri
 	return cache.SourceURL, nil
 }
 
-// detectDecompressorFromPath determines the decompressor from file path.
-func detectDecompressorFromPath(path string) string {
-	lower := strings.ToLower(path)
-	if strings.HasSuffix(lower, ".tar.zst") || strings.HasSuffix(lower, ".zst") {
-		return "zstd"
-	}
-	if strings.HasSuffix(lower, ".tar.lz4") || strings.HasSuffix(lower, ".lz4") {
-		return "lz4"
-	}
-	if strings.HasSuffix(lower, ".tar.gz") || strings.HasSuffix(lower, ".tgz") {
-		return "gzip"
-	}
-	if strings.HasSuffix(lower, ".tar") {
-		return "none"
-	}
-	return "zstd" // Default
-}
-
 // StandardSnapshotPath returns the standard snapshot path for a cache key.
 // cacheKey format: "plugin-network" (e.g., "stable-mainnet", "ault-testnet")
 func StandardSnapshotPath(homeDir, cacheKey, extension string) string {
@@ -0,0 +1,42 @@
+package snapshot
+
+import (
+	"context"
+	"net/url"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGCSObjectURL(t *testing.T) {
+	t.Setenv(EnvGCSEmulatorHost, "")
+	u, _ := url.Parse("gs://chain-snapshots/mainnet/latest.tar.lz4")
+
+	got, err := gcsObjectURL(u)
+	if err != nil || got != "https://storage.googleapis.com/chain-snapshots/mainnet/latest.tar.lz4" {
+		t.Errorf("gcsObjectURL = %q, %v", got, err)
+	}
+
+	t.Setenv(EnvGCSEmulatorHost, "localhost:4443")
+	got, err = gcsObjectURL(u)
+	if err != nil || got != "http://localhost:4443/chain-snapshots/mainnet/latest.tar.lz4" {
+		t.Errorf("gcsObjectURL with emulator = %q, %v", got, err)
+	}
+}
+
+func TestGCSProvider_AccessToken(t *testing.T) {
+	var auth atomic.Value
+	var ranged atomic.Int32
+	srv := newFakeObjectStore(t, "/chain-snapshots/latest.tar.lz4", []byte("state"), &auth, &ranged)
+
+	t.Setenv(EnvGCSEmulatorHost, srv.URL)
+	t.Setenv(EnvGCSAccessToken, "ya29.token")
+
+	u, _ := url.Parse("gs://chain-snapshots/latest.tar.lz4")
+	size, err := (&GCSProvider{}).Size(context.Background(), u)
+	if err != nil || size != 5 {
+		t.Fatalf("Size = %d, %v", size, err)
+	}
+	if a, _ := auth.Load().(string); a != "Bearer ya29.token" {
+		t.Errorf("unexpected Authorization %q", a)
+	}
+}
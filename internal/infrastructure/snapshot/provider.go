@@ -0,0 +1,287 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"sync/atomic"
+)
+
+// Provider fetches snapshots from one kind of source. The download pipeline
+// selects a provider by the snapshot URL's scheme.
+type Provider interface {
+	// Size returns the size of the snapshot in bytes, or -1 if unknown.
+	Size(ctx context.Context, u *url.URL) (int64, error)
+
+	// Fetch writes the snapshot to dst, reporting bytes written to
+	// progress. Parts may be written concurrently and out of order.
+	Fetch(ctx context.Context, u *url.URL, dst io.WriterAt, progress *Progress) error
+
+	// Stream writes the snapshot to w in order, reporting bytes written to
+	// progress. It is used to extract a snapshot while it downloads.
+	Stream(ctx context.Context, u *url.URL, w io.Writer, progress *Progress) error
+}
+
+// Progress counts the bytes a Provider has fetched. It is safe for
+// concurrent use.
+type Progress struct {
+	total      atomic.Int64
+	downloaded atomic.Int64
+}
+
+// SetTotal records the snapshot size; -1 or 0 means unknown.
+func (p *Progress) SetTotal(n int64) { p.total.Store(n) }
+
+// Add records n more bytes written.
+func (p *Progress) Add(n int64) { p.downloaded.Add(n) }
+
+// Total returns the snapshot size, or a value <= 0 if unknown.
+func (p *Progress) Total() int64 { return p.total.Load() }
+
+// Downloaded returns the number of bytes written so far.
+func (p *Progress) Downloaded() int64 { return p.downloaded.Load() }
+
+var (
+	providersMu sync.RWMutex
+	providers   = map[string]Provider{
+		"http":  &HTTPProvider{},
+		"https": &HTTPProvider{},
+		"file":  &FileProvider{},
+		"s3":    &S3Provider{},
+		"gs":    &GCSProvider{},
+	}
+)
+
+// RegisterProvider registers a provider for a URL scheme, replacing any
+// existing one.
+func RegisterProvider(scheme string, p Provider) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+	providers[scheme] = p
+}
+
+// ProviderFor parses a snapshot URL and returns the provider for its scheme.
+// A bare path is treated as a file:// URL.
+func ProviderFor(rawURL string) (Provider, *url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid snapshot URL %q: %w", rawURL, err)
+	}
+	if u.Scheme == "" {
+		u = &url.URL{Scheme: "file", Path: rawURL}
+	}
+
+	providersMu.RLock()
+	p, ok := providers[u.Scheme]
+	providersMu.RUnlock()
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported snapshot URL scheme %q (supported: http, https, file, s3, gs)", u.Scheme)
+	}
+	return p, u, nil
+}
+
+// countingWriter counts bytes written into a Progress.
+type countingWriter struct {
+	w        io.Writer
+	n        int64
+	progress *Progress
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	w.progress.Add(int64(n))
+	return n, err
+}
+
+// fetchRanges fetches [0, size) in parts of partSize with up to concurrency
+// parts in flight. fetchPart writes the inclusive byte range [start, end] to w.
+func fetchRanges(ctx context.Context, size, partSize int64, concurrency int, dst io.WriterAt, progress *Progress,
+	fetchPart func(ctx context.Context, start, end int64, w io.Writer) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+		sem      = make(chan struct{}, max(concurrency, 1))
+	)
+	fail := func(err error) {
+		errOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	for start := int64(0); start < size; start += partSize {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		end := min(start+partSize, size) - 1
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			w := &countingWriter{w: io.NewOffsetWriter(dst, start), progress: progress}
+			if err := fetchPart(ctx, start, end, w); err != nil {
+				fail(fmt.Errorf("bytes %d-%d: %w", start, end, err))
+				return
+			}
+			if w.n != end-start+1 {
+				fail(fmt.Errorf("bytes %d-%d: got %d bytes", start, end, w.n))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// getRange GETs an inclusive byte range with an authorizing function applied
+// to the request, and copies the body to w.
+func getRange(ctx context.Context, client *http.Client, rawURL string, start, end int64, authorize func(*http.Request) error, w io.Writer) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, end))
+	if authorize != nil {
+		if err := authorize(req); err != nil {
+			return err
+		}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusPartialContent {
+		return fmt.Errorf("ranged download failed with status %d", resp.StatusCode)
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// HTTPProvider fetches snapshots over HTTP(S) with a single GET.
+type HTTPProvider struct{}
+
+// Size implements Provider.
+func (p *HTTPProvider) Size(ctx context.Context, u *url.URL) (int64, error) {
+	return headSize(ctx, &http.Client{Timeout: sizeTimeout}, u.String(), nil)
+}
+
+// Fetch implements Provider.
+func (p *HTTPProvider) Fetch(ctx context.Context, u *url.URL, dst io.WriterAt, progress *Progress) error {
+	return p.Stream(ctx, u, io.NewOffsetWriter(dst, 0), progress)
+}
+
+// Stream implements Provider.
+func (p *HTTPProvider) Stream(ctx context.Context, u *url.URL, w io.Writer, progress *Progress) error {
+	return streamObject(ctx, u.String(), nil, w, progress)
+}
+
+// streamObject GETs a URL with an authorizing function applied to the
+// request, and copies the body to w.
+func streamObject(ctx context.Context, rawURL string, authorize func(*http.Request) error, w io.Writer, progress *Progress) error {
+	client := &http.Client{Timeout: DownloadTimeout}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if authorize != nil {
+		if err := authorize(req); err != nil {
+			return err
+		}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to start download: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download failed with status %d", resp.StatusCode)
+	}
+	progress.SetTotal(resp.ContentLength)
+	_, err = io.Copy(&countingWriter{w: w, progress: progress}, resp.Body)
+	return err
+}
+
+// headSize returns the Content-Length of a HEAD request.
+func headSize(ctx context.Context, client *http.Client, rawURL string, authorize func(*http.Request) error) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, rawURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	if authorize != nil {
+		if err := authorize(req); err != nil {
+			return 0, err
+		}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get size: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("failed with status %d", resp.StatusCode)
+	}
+	return resp.ContentLength, nil
+}
+
+// FileProvider copies snapshots from a local or mounted path.
+type FileProvider struct{}
+
+// Size implements Provider.
+func (p *FileProvider) Size(_ context.Context, u *url.URL) (int64, error) {
+	info, err := os.Stat(u.Path)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// Fetch implements Provider.
+func (p *FileProvider) Fetch(ctx context.Context, u *url.URL, dst io.WriterAt, progress *Progress) error {
+	return p.Stream(ctx, u, io.NewOffsetWriter(dst, 0), progress)
+}
+
+// Stream implements Provider.
+func (p *FileProvider) Stream(ctx context.Context, u *url.URL, w io.Writer, progress *Progress) error {
+	src, err := os.Open(u.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open snapshot: %w", err)
+	}
+	defer src.Close()
+	if info, err := src.Stat(); err == nil {
+		progress.SetTotal(info.Size())
+	}
+	_, err = io.Copy(&countingWriter{w: w, progress: progress}, &contextReader{ctx: ctx, r: src})
+	return err
+}
+
+// contextReader stops a copy when its context is done.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
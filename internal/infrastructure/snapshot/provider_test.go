@@ -0,0 +1,105 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProviderFor(t *testing.T) {
+	tests := []struct {
+		url     string
+		want    string
+		wantErr bool
+	}{
+		{"https://snapshots.example.com/mainnet.tar.lz4", "*snapshot.HTTPProvider", false},
+		{"http://alice-laptop:8090/snapshots/stable-mainnet/snapshot.tar.zst", "*snapshot.HTTPProvider", false},
+		{"file:///mnt/snapshots/mainnet.tar.zst", "*snapshot.FileProvider", false},
+		{"/mnt/snapshots/mainnet.tar.zst", "*snapshot.FileProvider", false},
+		{"s3://bucket/mainnet.tar.zst", "*snapshot.S3Provider", false},
+		{"gs://bucket/mainnet.tar.zst", "*snapshot.GCSProvider", false},
+		{"ftp://example.com/mainnet.tar.zst", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			p, _, err := ProviderFor(tt.url)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ProviderFor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := fmt.Sprintf("%T", p); err == nil && got != tt.want {
+				t.Errorf("ProviderFor() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDownload_FileURL(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "mainnet.tar.lz4")
+	if err := os.WriteFile(src, []byte("chain-state"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	homeDir := t.TempDir()
+
+	cache, err := Download(context.Background(), DownloadOptions{
+		URL:      "file://" + src,
+		CacheKey: "stable-mainnet",
+		HomeDir:  homeDir,
+	})
+	if err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	if cache.Decompressor != "lz4" || cache.SizeBytes != 11 {
+		t.Errorf("unexpected cache entry: %+v", cache)
+	}
+	got, _ := os.ReadFile(cache.FilePath)
+	if string(got) != "chain-state" {
+		t.Errorf("cached snapshot = %q", got)
+	}
+
+	size, err := GetSnapshotSize(context.Background(), src)
+	if err != nil || size != 11 {
+		t.Errorf("GetSnapshotSize = %d, %v", size, err)
+	}
+}
+
+func TestFetchRanges_PartFailure(t *testing.T) {
+	f, err := os.Create(filepath.Join(t.TempDir(), "snapshot"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	errPart := errors.New("connection reset")
+	err = fetchRanges(context.Background(), 100, 10, 4, f, &Progress{},
+		func(ctx context.Context, start, end int64, w io.Writer) error {
+			if start == 50 {
+				return errPart
+			}
+			_, err := w.Write(make([]byte, end-start+1))
+			return err
+		})
+	if !errors.Is(err, errPart) {
+		t.Errorf("expected the part error, got %v", err)
+	}
+}
+
+func TestFetchRanges_ShortPart(t *testing.T) {
+	f, err := os.Create(filepath.Join(t.TempDir(), "snapshot"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	err = fetchRanges(context.Background(), 20, 10, 2, f, &Progress{},
+		func(ctx context.Context, start, end int64, w io.Writer) error {
+			_, err := w.Write([]byte("short"))
+			return err
+		})
+	if err == nil {
+		t.Error("expected an error for a short part")
+	}
+}
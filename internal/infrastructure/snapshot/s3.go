@@ -0,0 +1,265 @@
+package snapshot
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultPartSize is the size of each part of a parallel download.
+	DefaultPartSize = 64 << 20
+
+	// DefaultPartConcurrency is the number of parts downloaded at once.
+	DefaultPartConcurrency = 8
+
+	// sizeTimeout bounds a snapshot size lookup.
+	sizeTimeout = 30 * time.Second
+
+	// emptyPayloadHash is the SHA256 of an empty request body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// S3 environment variables, following the AWS CLI and SDKs.
+const (
+	EnvAWSAccessKeyID     = "AWS_ACCESS_KEY_ID"
+	EnvAWSSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
+	EnvAWSSessionToken    = "AWS_SESSION_TOKEN"
+	EnvAWSRegion          = "AWS_REGION"
+	EnvAWSDefaultRegion   = "AWS_DEFAULT_REGION"
+	EnvAWSEndpointURLS3   = "AWS_ENDPOINT_URL_S3"
+	EnvAWSEndpointURL     = "AWS_ENDPOINT_URL"
+)
+
+// S3Provider fetches s3://bucket/key snapshots, downloading them in parallel
+// ranged parts. Credentials, region and endpoint come from the standard AWS
+// environment variables; without credentials, requests are unsigned, which
+// works for public buckets. A custom endpoint (e.g., MinIO) is addressed
+// path-style.
+type S3Provider struct {
+	// PartSize is the size of each part. Defaults to DefaultPartSize.
+	PartSize int64
+	// Concurrency is the number of parts downloaded at once. Defaults to
+	// DefaultPartConcurrency.
+	Concurrency int
+}
+
+// s3Config is the S3 configuration read from the environment.
+type s3Config struct {
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+	region          string
+	endpoint        string
+}
+
+func s3ConfigFromEnv() s3Config {
+	cfg := s3Config{
+		accessKeyID:     os.Getenv(EnvAWSAccessKeyID),
+		secretAccessKey: os.Getenv(EnvAWSSecretAccessKey),
+		sessionToken:    os.Getenv(EnvAWSSessionToken),
+		region:          firstEnv(EnvAWSRegion, EnvAWSDefaultRegion),
+		endpoint:        firstEnv(EnvAWSEndpointURLS3, EnvAWSEndpointURL),
+	}
+	if cfg.region == "" {
+		cfg.region = "us-east-1"
+	}
+	return cfg
+}
+
+func firstEnv(names ...string) string {
+	for _, name := range names {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// objectURL returns the HTTPS URL of an s3:// object.
+func (c s3Config) objectURL(u *url.URL) (string, error) {
+	bucket, key := u.Host, strings.TrimPrefix(u.Path, "/")
+	if bucket == "" || key == "" {
+		return "", fmt.Errorf("invalid S3 URL %q: want s3://<bucket>/<key>", u.String())
+	}
+	if c.endpoint != "" {
+		return strings.TrimRight(c.endpoint, "/") + "/" + bucket + "/" + awsURIEncode(key, false), nil
+	}
+	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", bucket, c.region, awsURIEncode(key, false)), nil
+}
+
+// authorize signs a request with SigV4, or leaves it unsigned without
+// credentials.
+func (c s3Config) authorize(req *http.Request) error {
+	if c.accessKeyID == "" || c.secretAccessKey == "" {
+		return nil
+	}
+	signS3Request(req, c.accessKeyID, c.secretAccessKey, c.sessionToken, c.region, time.Now())
+	return nil
+}
+
+// Size implements Provider.
+func (p *S3Provider) Size(ctx context.Context, u *url.URL) (int64, error) {
+	cfg := s3ConfigFromEnv()
+	objectURL, err := cfg.objectURL(u)
+	if err != nil {
+		return 0, err
+	}
+	return headSize(ctx, &http.Client{Timeout: sizeTimeout}, objectURL, cfg.authorize)
+}
+
+// Fetch implements Provider.
+func (p *S3Provider) Fetch(ctx context.Context, u *url.URL, dst io.WriterAt, progress *Progress) error {
+	cfg := s3ConfigFromEnv()
+	objectURL, err := cfg.objectURL(u)
+	if err != nil {
+		return err
+	}
+	return fetchParallel(ctx, objectURL, cfg.authorize, p.PartSize, p.Concurrency, dst, progress)
+}
+
+// Stream implements Provider. Parts can't be written in order in parallel,
+// so streamed objects are fetched with a single GET.
+func (p *S3Provider) Stream(ctx context.Context, u *url.URL, w io.Writer, progress *Progress) error {
+	cfg := s3ConfigFromEnv()
+	objectURL, err := cfg.objectURL(u)
+	if err != nil {
+		return err
+	}
+	return streamObject(ctx, objectURL, cfg.authorize, w, progress)
+}
+
+// fetchParallel downloads an object that supports Range requests in parallel
+// parts.
+func fetchParallel(ctx context.Context, objectURL string, authorize func(*http.Request) error,
+	partSize int64, concurrency int, dst io.WriterAt, progress *Progress) error {
+	if partSize <= 0 {
+		partSize = DefaultPartSize
+	}
+	if concurrency <= 0 {
+		concurrency = DefaultPartConcurrency
+	}
+
+	size, err := headSize(ctx, &http.Client{Timeout: sizeTimeout}, objectURL, authorize)
+	if err != nil {
+		return err
+	}
+	if size < 0 {
+		return fmt.Errorf("object size unknown")
+	}
+	progress.SetTotal(size)
+
+	client := &http.Client{Timeout: DownloadTimeout}
+	return fetchRanges(ctx, size, partSize, concurrency, dst, progress,
+		func(ctx context.Context, start, end int64, w io.Writer) error {
+			return getRange(ctx, client, objectURL, start, end, authorize, w)
+		})
+}
+
+// signS3Request adds AWS Signature Version 4 headers for S3 to a request
+// without a body.
+func signS3Request(req *http.Request, accessKeyID, secretAccessKey, sessionToken, region string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", emptyPayloadHash)
+	if sessionToken != "" {
+		req.Header.Set("x-amz-security-token", sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		lower := strings.ToLower(name)
+		if lower == "range" || strings.HasPrefix(lower, "x-amz-") {
+			headers[lower] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		emptyPayloadHash,
+	}, "\n")
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// canonicalQuery encodes query parameters sorted by name, as SigV4 requires.
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		values := append([]string(nil), query[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, awsURIEncode(k, true)+"="+awsURIEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// awsURIEncode percent-encodes everything but RFC 3986 unreserved
+// characters, and '/' unless encodeSlash.
+func awsURIEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
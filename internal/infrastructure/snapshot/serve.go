@@ -0,0 +1,129 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/paths"
+)
+
+// ServedSnapshot is an entry in the index of a shared snapshot cache.
+type ServedSnapshot struct {
+	CacheKey     string    `json:"cache_key"`
+	Path         string    `json:"path"` // URL path of the snapshot file
+	SizeBytes    int64     `json:"size_bytes"`
+	Decompressor string    `json:"decompressor"`
+	Checksum     string    `json:"checksum,omitempty"`
+	SourceURL    string    `json:"source_url"`
+	DownloadedAt time.Time `json:"downloaded_at"`
+	Expired      bool      `json:"expired"`
+}
+
+// ListCaches returns the cached snapshots under homeDir whose files still
+// exist, including expired ones, sorted by cache key.
+func ListCaches(homeDir string) ([]*SnapshotCache, error) {
+	entries, err := os.ReadDir(paths.SnapshotCachePath(homeDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var caches []*SnapshotCache
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		cache, err := LoadSnapshotCache(homeDir, e.Name())
+		if err != nil || cache == nil {
+			continue
+		}
+		if _, err := os.Stat(cache.FilePath); err != nil {
+			continue
+		}
+		caches = append(caches, cache)
+	}
+	sort.Slice(caches, func(i, j int) bool { return caches[i].CacheKey < caches[j].CacheKey })
+	return caches, nil
+}
+
+// servedPath returns the URL path a cached snapshot is served at. It keeps
+// the file extension, which Download uses to pick the decompressor.
+func servedPath(cache *SnapshotCache) string {
+	return path.Join("/snapshots", cache.CacheKey, filepath.Base(cache.FilePath))
+}
+
+// NewCacheHandler returns an HTTP handler that shares the snapshot cache
+// under homeDir, so teammates can point a devnet's SnapshotURL at it:
+//
+//	GET /snapshots                  JSON index of cached snapshots
+//	GET /snapshots/<key>/<file>     a snapshot file, with Range support
+//
+// Expired snapshots are still served; the expiry only controls when the
+// owner re-downloads.
+func NewCacheHandler(homeDir string) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /snapshots", func(w http.ResponseWriter, r *http.Request) {
+		caches, err := ListCaches(homeDir)
+		if err != nil {
+			http.Error(w, "failed to list snapshot cache", http.StatusInternalServerError)
+			return
+		}
+		index := make([]ServedSnapshot, 0, len(caches))
+		for _, c := range caches {
+			index = append(index, ServedSnapshot{
+				CacheKey:     c.CacheKey,
+				Path:         servedPath(c),
+				SizeBytes:    c.SizeBytes,
+				Decompressor: c.Decompressor,
+				Checksum:     c.Checksum,
+				SourceURL:    c.SourceURL,
+				DownloadedAt: c.DownloadedAt,
+				Expired:      c.IsExpired(),
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(index)
+	})
+
+	mux.HandleFunc("GET /snapshots/{key}/{file}", func(w http.ResponseWriter, r *http.Request) {
+		key, file := r.PathValue("key"), r.PathValue("file")
+		// Only files recorded in cache metadata are served, so a key or file
+		// name can't reach outside the cache.
+		if strings.HasPrefix(key, ".") {
+			http.NotFound(w, r)
+			return
+		}
+		cache, err := LoadSnapshotCache(homeDir, key)
+		if err != nil || cache == nil || filepath.Base(cache.FilePath) != file {
+			http.NotFound(w, r)
+			return
+		}
+
+		f, err := os.Open(cache.FilePath)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		defer f.Close()
+		info, err := f.Stat()
+		if err != nil {
+			http.Error(w, "failed to stat snapshot", http.StatusInternalServerError)
+			return
+		}
+		if cache.Checksum != "" {
+			w.Header().Set("ETag", `"`+cache.Checksum+`"`)
+		}
+		http.ServeContent(w, r, file, info.ModTime(), f)
+	})
+
+	return mux
+}
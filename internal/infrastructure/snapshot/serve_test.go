@@ -0,0 +1,110 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCache creates a cached snapshot with content under homeDir.
+func writeCache(t *testing.T, homeDir, cacheKey, content string, expiration time.Duration) *SnapshotCache {
+	t.Helper()
+	filePath := SnapshotPath(homeDir, cacheKey, ".tar.zst")
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cache := NewSnapshotCacheWithExpiration(cacheKey, filePath, "https://snapshots.example.com/"+cacheKey+".tar.zst",
+		"zstd", int64(len(content)), expiration)
+	if err := cache.Save(homeDir); err != nil {
+		t.Fatal(err)
+	}
+	return cache
+}
+
+func TestCacheHandler_Index(t *testing.T) {
+	homeDir := t.TempDir()
+	writeCache(t, homeDir, "stable-mainnet", "mainnet-state", time.Hour)
+	writeCache(t, homeDir, "ault-testnet", "testnet-state", -time.Minute)
+	// A cache whose file was removed is not listed.
+	gone := writeCache(t, homeDir, "gone-devnet", "x", time.Hour)
+	os.Remove(gone.FilePath)
+
+	srv := httptest.NewServer(NewCacheHandler(homeDir))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/snapshots")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var index []ServedSnapshot
+	if err := json.NewDecoder(resp.Body).Decode(&index); err != nil {
+		t.Fatalf("failed to decode index: %v", err)
+	}
+	if len(index) != 2 {
+		t.Fatalf("expected 2 snapshots, got %+v", index)
+	}
+	if index[0].CacheKey != "ault-testnet" || !index[0].Expired {
+		t.Errorf("unexpected first entry: %+v", index[0])
+	}
+	if index[1].Path != "/snapshots/stable-mainnet/snapshot.tar.zst" || index[1].SizeBytes != 13 {
+		t.Errorf("unexpected second entry: %+v", index[1])
+	}
+}
+
+func TestCacheHandler_ServeFile(t *testing.T) {
+	homeDir := t.TempDir()
+	writeCache(t, homeDir, "stable-mainnet", "mainnet-state", time.Hour)
+
+	srv := httptest.NewServer(NewCacheHandler(homeDir))
+	defer srv.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL+"/snapshots/stable-mainnet/snapshot.tar.zst", nil)
+	req.Header.Set("Range", "bytes=8-")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusPartialContent || string(body) != "state" {
+		t.Errorf("expected 206 with %q, got %d with %q", "state", resp.StatusCode, body)
+	}
+}
+
+func TestCacheHandler_NotFound(t *testing.T) {
+	homeDir := t.TempDir()
+	writeCache(t, homeDir, "stable-mainnet", "mainnet-state", time.Hour)
+	if err := os.WriteFile(filepath.Join(homeDir, "secret"), []byte("secret"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(NewCacheHandler(homeDir))
+	defer srv.Close()
+
+	for _, p := range []string{
+		"/snapshots/stable-mainnet/snapshot.meta.json",
+		"/snapshots/unknown/snapshot.tar.zst",
+		"/snapshots/../secret",
+		"/snapshots/..%2F/secret",
+	} {
+		resp, err := http.Get(srv.URL + p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusPartialContent {
+			t.Errorf("GET %s: expected an error status, got %d", p, resp.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+// internal/output/events.go
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
+	"github.com/altuslabsxyz/devnet-builder/internal/redact"
+	"github.com/fatih/color"
+)
+
+// LogFormat selects how human-oriented CLI output is written.
+type LogFormat string
+
+const (
+	// LogFormatText writes colored text for people.
+	LogFormatText LogFormat = "text"
+	// LogFormatJSON writes one JSON event per line for CI systems.
+	LogFormatJSON LogFormat = "json"
+)
+
+// ParseLogFormat parses a --log-format value.
+func ParseLogFormat(s string) (LogFormat, error) {
+	switch LogFormat(s) {
+	case LogFormatText, LogFormatJSON:
+		return LogFormat(s), nil
+	case "":
+		return LogFormatText, nil
+	default:
+		return "", fmt.Errorf("invalid log format %q (must be text or json)", s)
+	}
+}
+
+// Event levels.
+const (
+	LevelDebug = "debug"
+	LevelInfo  = "info"
+	LevelWarn  = "warn"
+	LevelError = "error"
+)
+
+// Event is a structured log event written in JSON log format.
+type Event struct {
+	Time    time.Time `json:"time"`
+	Level   string    `json:"level"`
+	Message string    `json:"msg"`
+	Command string    `json:"command,omitempty"`
+
+	// Result fields, set only on the final event of a command.
+	Status     string `json:"status,omitempty"` // "succeeded" or "failed"
+	Error      string `json:"error,omitempty"`
+	ErrorCode  string `json:"errorCode,omitempty"` // apierror code, if known
+	ExitCode   int    `json:"exitCode,omitempty"`
+	DurationMs int64  `json:"durationMs,omitempty"`
+}
+
+// EventWriter writes JSON events, one per line, tagged with the command
+// being run. It is safe for concurrent use.
+type EventWriter struct {
+	mu      sync.Mutex
+	out     io.Writer
+	command string
+	now     func() time.Time
+}
+
+// NewEventWriter creates an EventWriter writing to out. command is the
+// command path recorded on every event, e.g. "dvb provision".
+func NewEventWriter(out io.Writer, command string) *EventWriter {
+	return &EventWriter{out: out, command: command, now: time.Now}
+}
+
+// SetCommand changes the command recorded on events.
+func (w *EventWriter) SetCommand(command string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.command = command
+}
+
+// Emit writes an event. Blank messages are dropped.
+func (w *EventWriter) Emit(level, msg string) {
+	msg = strings.TrimSpace(msg)
+	if msg == "" {
+		return
+	}
+	w.write(Event{Level: level, Message: msg})
+}
+
+// EmitResult writes the final event of a command: whether it succeeded,
+// its error, error code and exit code if not, and how long it took.
+func (w *EventWriter) EmitResult(err error, elapsed time.Duration) {
+	e := Event{
+		Level:      LevelInfo,
+		Message:    "command succeeded",
+		Status:     "succeeded",
+		DurationMs: elapsed.Milliseconds(),
+	}
+	if err != nil {
+		e.Level = LevelError
+		e.Message = "command failed"
+		e.Status = "failed"
+		e.Error = err.Error()
+		e.ErrorCode = string(apierror.CodeOf(err))
+		e.ExitCode = apierror.ExitCode(err)
+	}
+	w.write(e)
+}
+
+func (w *EventWriter) write(e Event) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	e.Time = w.now().UTC()
+	e.Command = w.command
+	e.Message = redact.String(e.Message)
+	e.Error = redact.String(e.Error)
+	data, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	w.out.Write(append(data, '\n'))
+}
+
+// LineWriter returns a writer that turns each line written to it into an
+// event. Lines start at level unless they look like an error ("Error:",
+// "✗") or a warning ("Warning:", "⚠"). Color and cursor escape sequences
+// are removed.
+func (w *EventWriter) LineWriter(level string) io.Writer {
+	return &eventLineWriter{events: w, level: level}
+}
+
+type eventLineWriter struct {
+	mu     sync.Mutex
+	events *EventWriter
+	level  string
+	buf    bytes.Buffer
+}
+
+func (lw *eventLineWriter) Write(p []byte) (int, error) {
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+
+	lw.buf.Write(p)
+	for {
+		line, err := lw.buf.ReadString('\n')
+		if err != nil {
+			// Keep the partial line for the next write.
+			lw.buf.Reset()
+			lw.buf.WriteString(line)
+			break
+		}
+		lw.emit(line)
+	}
+	return len(p), nil
+}
+
+// flush emits a final line that was not terminated by a newline.
+func (lw *eventLineWriter) flush() {
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+
+	if lw.buf.Len() > 0 {
+		lw.emit(lw.buf.String())
+		lw.buf.Reset()
+	}
+}
+
+func (lw *eventLineWriter) emit(line string) {
+	msg := stripEscapes(line)
+	lw.events.Emit(lineLevel(msg, lw.level), msg)
+}
+
+// escapePattern matches ANSI color and cursor control sequences.
+var escapePattern = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]|\r`)
+
+func stripEscapes(s string) string {
+	return escapePattern.ReplaceAllString(s, "")
+}
+
+// lineLevel infers the level of a printed line from its prefix.
+func lineLevel(line, fallback string) string {
+	line = strings.TrimSpace(line)
+	switch {
+	case strings.HasPrefix(line, "Error"), strings.HasPrefix(line, "✗"):
+		return LevelError
+	case strings.HasPrefix(line, "Warning"), strings.HasPrefix(line, "⚠"):
+		return LevelWarn
+	default:
+		return fallback
+	}
+}
+
+var (
+	jsonEventsMu  sync.RWMutex
+	jsonEvents    *EventWriter
+	restoreStderr func()
+)
+
+// EnableJSONEvents switches human-oriented output to JSON events on w: the
+// default logger, fatih/color prints, status spinners and step progress.
+// os.Stderr is replaced by a pipe whose lines become events too, so w must
+// write to the original stderr (or elsewhere). Command data written to
+// stdout is left alone. Call CloseJSONEvents before exiting.
+func EnableJSONEvents(w *EventWriter) error {
+	r, pw, err := os.Pipe()
+	if err != nil {
+		return fmt.Errorf("failed to redirect stderr: %w", err)
+	}
+	orig := os.Stderr
+	os.Stderr = pw
+
+	lw := &eventLineWriter{events: w, level: LevelInfo}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = io.Copy(lw, r)
+		lw.flush()
+	}()
+
+	jsonEventsMu.Lock()
+	jsonEvents = w
+	restoreStderr = func() {
+		os.Stderr = orig
+		pw.Close()
+		<-done
+		r.Close()
+	}
+	jsonEventsMu.Unlock()
+
+	DefaultLogger.SetEvents(w)
+	color.NoColor = true
+	color.Output = w.LineWriter(LevelInfo)
+	color.Error = w.LineWriter(LevelError)
+	return nil
+}
+
+// CloseJSONEvents restores os.Stderr and waits until everything printed to
+// it has been emitted. It is a no-op in text format.
+func CloseJSONEvents() {
+	jsonEventsMu.Lock()
+	restore := restoreStderr
+	restoreStderr = nil
+	jsonEventsMu.Unlock()
+
+	if restore != nil {
+		restore()
+	}
+}
+
+// JSONEvents returns the EventWriter set by EnableJSONEvents, or nil when
+// output is text.
+func JSONEvents() *EventWriter {
+	jsonEventsMu.RLock()
+	defer jsonEventsMu.RUnlock()
+	return jsonEvents
+}
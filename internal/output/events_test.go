@@ -0,0 +1,179 @@
+// internal/output/events_test.go
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/apierror"
+	"github.com/fatih/color"
+)
+
+func decodeEvents(t *testing.T, buf *bytes.Buffer) []Event {
+	t.Helper()
+	var events []Event
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var e Event
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			t.Fatalf("invalid event %q: %v", line, err)
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+func TestParseLogFormat(t *testing.T) {
+	for in, want := range map[string]LogFormat{"": LogFormatText, "text": LogFormatText, "json": LogFormatJSON} {
+		got, err := ParseLogFormat(in)
+		if err != nil || got != want {
+			t.Errorf("ParseLogFormat(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseLogFormat("yaml"); err == nil {
+		t.Error("ParseLogFormat(yaml) should fail")
+	}
+}
+
+func TestEventWriter_EmitAndResult(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewEventWriter(&buf, "dvb provision")
+	w.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	w.Emit(LevelInfo, "  Building binary \n")
+	w.Emit(LevelInfo, "   ") // dropped
+	w.EmitResult(errors.New("boom"), 1500*time.Millisecond)
+
+	events := decodeEvents(t, &buf)
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2", len(events))
+	}
+	if e := events[0]; e.Level != LevelInfo || e.Message != "Building binary" || e.Command != "dvb provision" || e.Status != "" {
+		t.Errorf("unexpected event %+v", e)
+	}
+	if !events[0].Time.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("unexpected time %v", events[0].Time)
+	}
+	if e := events[1]; e.Level != LevelError || e.Status != "failed" || e.Error != "boom" || e.ErrorCode != "" || e.ExitCode != 1 || e.DurationMs != 1500 {
+		t.Errorf("unexpected result event %+v", e)
+	}
+}
+
+func TestEventWriter_ResultErrorCode(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewEventWriter(&buf, "dvb node start")
+
+	w.EmitResult(fmt.Errorf("start failed: %w", apierror.Errorf(apierror.NotFound, "devnet %q not found", "x")), 0)
+
+	events := decodeEvents(t, &buf)
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+	if e := events[0]; e.ErrorCode != "NotFound" || e.ExitCode != 6 {
+		t.Errorf("unexpected result event %+v", e)
+	}
+}
+
+func TestEventWriter_LineWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewEventWriter(&buf, "dvb")
+	lw := w.LineWriter(LevelInfo)
+
+	fmt.Fprint(lw, "\x1b[32m✓ Devnet created\x1b[0m\nWarning: low ")
+	fmt.Fprint(lw, "disk\n  ✗ Downloading snapshot\n\n")
+
+	events := decodeEvents(t, &buf)
+	want := []struct{ level, msg string }{
+		{LevelInfo, "✓ Devnet created"},
+		{LevelWarn, "Warning: low disk"},
+		{LevelError, "✗ Downloading snapshot"},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d: %+v", len(events), len(want), events)
+	}
+	for i, w := range want {
+		if events[i].Level != w.level || events[i].Message != w.msg {
+			t.Errorf("event %d = %s %q, want %s %q", i, events[i].Level, events[i].Message, w.level, w.msg)
+		}
+	}
+}
+
+func TestLogger_SetEvents(t *testing.T) {
+	var text, buf bytes.Buffer
+	l := &Logger{out: &text, errOut: &text}
+	l.SetEvents(NewEventWriter(&buf, "devnet-builder deploy"))
+
+	l.Info("Starting %d nodes", 4)
+	l.Success("Deployed")
+	l.Warn("slow")
+	l.Error("failed")
+	l.Debug("hidden unless verbose")
+	l.Progress(1, 2, 1)
+	l.StartSpinner("Processing...")
+	l.StopSpinner()
+
+	if text.Len() != 0 {
+		t.Errorf("text output should be empty in JSON format, got %q", text.String())
+	}
+	events := decodeEvents(t, &buf)
+	want := []struct{ level, msg string }{
+		{LevelInfo, "Starting 4 nodes"},
+		{LevelInfo, "Deployed"},
+		{LevelWarn, "slow"},
+		{LevelError, "failed"},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d: %+v", len(events), len(want), events)
+	}
+	for i, w := range want {
+		if events[i].Level != w.level || events[i].Message != w.msg || events[i].Command != "devnet-builder deploy" {
+			t.Errorf("event %d = %+v, want %s %q", i, events[i], w.level, w.msg)
+		}
+	}
+}
+
+func TestEnableJSONEvents_CapturesStderrAndColor(t *testing.T) {
+	oldOutput, oldError, oldNoColor := color.Output, color.Error, color.NoColor
+	defer func() {
+		color.Output, color.Error, color.NoColor = oldOutput, oldError, oldNoColor
+		DefaultLogger.SetEvents(nil)
+		jsonEventsMu.Lock()
+		jsonEvents = nil
+		jsonEventsMu.Unlock()
+	}()
+
+	var buf bytes.Buffer
+	if err := EnableJSONEvents(NewEventWriter(&buf, "dvb provision")); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Fprintf(os.Stderr, "Provisioning devnet via daemon...\n")
+	color.Green("Devnet %q created", "x")
+	fmt.Fprint(os.Stderr, "Warning: no trailing newline")
+	CloseJSONEvents()
+
+	if os.Stderr == nil {
+		t.Fatal("os.Stderr not restored")
+	}
+	events := decodeEvents(t, &buf)
+	msgs := make(map[string]string)
+	for _, e := range events {
+		msgs[e.Message] = e.Level
+	}
+	for msg, level := range map[string]string{
+		"Provisioning devnet via daemon...": LevelInfo,
+		`Devnet "x" created`:                LevelInfo,
+		"Warning: no trailing newline":      LevelWarn,
+	} {
+		if msgs[msg] != level {
+			t.Errorf("event %q has level %q, want %q (events: %+v)", msg, msgs[msg], level, events)
+		}
+	}
+}
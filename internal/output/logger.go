@@ -19,6 +19,7 @@ type Logger struct {
 	noColor  bool
 	verbose  bool
 	jsonMode bool
+	events   *EventWriter // JSON log format; nil for text
 
 	// Spinner state
 	spinnerMu      sync.Mutex
@@ -53,6 +54,14 @@ func (l *Logger) SetJSONMode(jsonMode bool) {
 	l.jsonMode = jsonMode
 }
 
+// SetEvents switches the logger to JSON log format, writing every message
+// as an event to w instead of printing text. Spinners and progress bars are
+// suppressed. A nil w restores text output.
+func (l *Logger) SetEvents(w *EventWriter) {
+	l.StopSpinner()
+	l.events = w
+}
+
 // SetAutoSpinner enables or disables automatic spinner after Success/Info logs.
 // When enabled, a spinner will be shown after each Success or Info log to indicate
 // ongoing work. The spinner is automatically cleared when the next log is printed.
@@ -69,6 +78,10 @@ func (l *Logger) SetAutoSpinner(enabled bool) {
 // Info prints an informational message in default color.
 // If autoSpinner is enabled, a spinner will be shown after the message.
 func (l *Logger) Info(format string, args ...interface{}) {
+	if l.events != nil {
+		l.events.Emit(LevelInfo, fmt.Sprintf(format, args...))
+		return
+	}
 	if l.jsonMode {
 		return
 	}
@@ -81,6 +94,10 @@ func (l *Logger) Info(format string, args ...interface{}) {
 
 // Warn prints a warning message in yellow.
 func (l *Logger) Warn(format string, args ...interface{}) {
+	if l.events != nil {
+		l.events.Emit(LevelWarn, fmt.Sprintf(format, args...))
+		return
+	}
 	if l.jsonMode {
 		return
 	}
@@ -91,6 +108,10 @@ func (l *Logger) Warn(format string, args ...interface{}) {
 
 // Error prints an error message in red.
 func (l *Logger) Error(format string, args ...interface{}) {
+	if l.events != nil {
+		l.events.Emit(LevelError, fmt.Sprintf(format, args...))
+		return
+	}
 	if l.jsonMode {
 		return
 	}
@@ -102,6 +123,10 @@ func (l *Logger) Error(format string, args ...interface{}) {
 // Success prints a success message in green with checkmark.
 // If autoSpinner is enabled, a spinner will be shown after the message.
 func (l *Logger) Success(format string, args ...interface{}) {
+	if l.events != nil {
+		l.events.Emit(LevelInfo, fmt.Sprintf(format, args...))
+		return
+	}
 	if l.jsonMode {
 		return
 	}
@@ -115,6 +140,12 @@ func (l *Logger) Success(format string, args ...interface{}) {
 
 // Debug prints a debug message if verbose mode is enabled.
 func (l *Logger) Debug(format string, args ...interface{}) {
+	if l.events != nil {
+		if l.verbose {
+			l.events.Emit(LevelDebug, fmt.Sprintf(format, args...))
+		}
+		return
+	}
 	if l.jsonMode || !l.verbose {
 		return
 	}
@@ -128,6 +159,10 @@ func (l *Logger) Debug(format string, args ...interface{}) {
 
 // Bold prints a message in bold.
 func (l *Logger) Bold(format string, args ...interface{}) {
+	if l.events != nil {
+		l.events.Emit(LevelInfo, fmt.Sprintf(format, args...))
+		return
+	}
 	if l.jsonMode {
 		return
 	}
@@ -138,6 +173,10 @@ func (l *Logger) Bold(format string, args ...interface{}) {
 
 // Cyan prints a message in cyan (for highlights).
 func (l *Logger) Cyan(format string, args ...interface{}) {
+	if l.events != nil {
+		l.events.Emit(LevelInfo, fmt.Sprintf(format, args...))
+		return
+	}
 	if l.jsonMode {
 		return
 	}
@@ -148,6 +187,10 @@ func (l *Logger) Cyan(format string, args ...interface{}) {
 
 // Print prints a plain message without newline.
 func (l *Logger) Print(format string, args ...interface{}) {
+	if l.events != nil {
+		l.events.Emit(LevelInfo, fmt.Sprintf(format, args...))
+		return
+	}
 	if l.jsonMode {
 		return
 	}
@@ -156,6 +199,10 @@ func (l *Logger) Print(format string, args ...interface{}) {
 
 // Println prints a plain message with newline.
 func (l *Logger) Println(format string, args ...interface{}) {
+	if l.events != nil {
+		l.events.Emit(LevelInfo, fmt.Sprintf(format, args...))
+		return
+	}
 	if l.jsonMode {
 		return
 	}
@@ -166,7 +213,7 @@ func (l *Logger) Println(format string, args ...interface{}) {
 // Progress prints a progress bar on the same line (uses carriage return).
 // downloaded and total are in bytes. speed is in bytes per second.
 func (l *Logger) Progress(downloaded, total int64, speed float64) {
-	if l.jsonMode {
+	if l.jsonMode || l.events != nil {
 		return
 	}
 
@@ -225,7 +272,7 @@ func (l *Logger) Progress(downloaded, total int64, speed float64) {
 
 // ProgressComplete finishes the progress bar and moves to a new line.
 func (l *Logger) ProgressComplete() {
-	if l.jsonMode {
+	if l.jsonMode || l.events != nil {
 		return
 	}
 	fmt.Fprintf(l.out, "\n")
@@ -237,7 +284,7 @@ var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "
 // StartSpinner starts an animated spinner with a message.
 // The spinner runs in a background goroutine until StopSpinner is called.
 func (l *Logger) StartSpinner(message string) {
-	if l.jsonMode {
+	if l.jsonMode || l.events != nil {
 		return
 	}
 
@@ -416,6 +463,10 @@ func (l *Logger) ErrWriter() io.Writer {
 // In default mode: prints node name, log path, and log contents.
 // In verbose mode: also prints command, work directory, and PID.
 func (l *Logger) PrintNodeError(info *NodeErrorInfo) {
+	if l.events != nil {
+		l.events.Emit(LevelError, nodeErrorMessage(info))
+		return
+	}
 	if l.jsonMode {
 		return
 	}
@@ -462,6 +513,10 @@ func (l *Logger) PrintNodeError(info *NodeErrorInfo) {
 // In default mode: prints command and stderr output.
 // In verbose mode: also prints work directory, stdout, and exit code.
 func (l *Logger) PrintCommandError(info *CommandErrorInfo) {
+	if l.events != nil {
+		l.events.Emit(LevelError, commandErrorMessage(info))
+		return
+	}
 	if l.jsonMode {
 		return
 	}
@@ -515,6 +570,28 @@ func (l *Logger) PrintCommandError(info *CommandErrorInfo) {
 	fmt.Fprintln(l.errOut)
 }
 
+// nodeErrorMessage formats a node failure as a single event message.
+func nodeErrorMessage(info *NodeErrorInfo) string {
+	msg := fmt.Sprintf("Node %s failed (log file: %s)", info.NodeName, info.LogPath)
+	if len(info.LogLines) > 0 {
+		msg += "\n" + strings.Join(info.LogLines, "\n")
+	}
+	return msg
+}
+
+// commandErrorMessage formats a command failure as a single event message.
+func commandErrorMessage(info *CommandErrorInfo) string {
+	cmdDisplay := info.Command
+	if len(info.Args) > 0 {
+		cmdDisplay = info.Command + " " + joinArgs(info.Args)
+	}
+	msg := fmt.Sprintf("Command failed with exit code %d: %s", info.ExitCode, cmdDisplay)
+	if info.Stderr != "" {
+		msg += "\n" + strings.TrimSpace(info.Stderr)
+	}
+	return msg
+}
+
 // joinArgs joins command arguments with spaces.
 func joinArgs(args []string) string {
 	result := ""
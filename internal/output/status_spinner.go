@@ -28,8 +28,17 @@ func NewStatusSpinner() *StatusSpinner {
 	return &StatusSpinner{out: os.Stderr}
 }
 
-// Start begins the spinner animation with the given message.
+// Start begins the spinner animation with the given message. In JSON log
+// format the message is emitted as an event instead.
 func (s *StatusSpinner) Start(message string) {
+	if events := JSONEvents(); events != nil {
+		s.mu.Lock()
+		s.message = message
+		s.mu.Unlock()
+		events.Emit(LevelInfo, message)
+		return
+	}
+
 	s.mu.Lock()
 	if s.running {
 		s.mu.Unlock()
@@ -57,8 +66,20 @@ func (s *StatusSpinner) Start(message string) {
 	}()
 }
 
-// Update changes the spinner message.
+// Update changes the spinner message. In JSON log format a changed message
+// is emitted as an event.
 func (s *StatusSpinner) Update(message string) {
+	if events := JSONEvents(); events != nil {
+		s.mu.Lock()
+		changed := message != s.message
+		s.message = message
+		s.mu.Unlock()
+		if changed {
+			events.Emit(LevelInfo, message)
+		}
+		return
+	}
+
 	s.mu.Lock()
 	s.message = message
 	s.mu.Unlock()
@@ -82,6 +103,9 @@ func (s *StatusSpinner) Stop() {
 
 // StopWithNewline stops the spinner and moves to a new line.
 func (s *StatusSpinner) StopWithNewline() {
+	if JSONEvents() != nil {
+		return
+	}
 	s.Stop()
 	fmt.Fprintf(s.out, "\n")
 }
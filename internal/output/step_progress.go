@@ -0,0 +1,204 @@
+// internal/output/step_progress.go
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// stepBarWidth is the width of a progress bar in characters.
+const stepBarWidth = 30
+
+// StepUpdate is a progress update for one sub-step of a long-running
+// operation, as streamed by the daemon while provisioning.
+type StepUpdate struct {
+	Name    string  // "Downloading snapshot", "Initializing nodes", ...
+	Status  string  // "running", "completed", "failed"
+	Current int64   // bytes, files, nodes or seconds done (0 if indeterminate)
+	Total   int64   // total bytes, files, nodes or seconds (0 if unknown)
+	Unit    string  // "bytes", "files", "nodes", "seconds", "" for indeterminate
+	Detail  string  // "from cache", "validator-1", ...
+	Speed   float64 // bytes per second (for downloads)
+}
+
+// StepRenderer renders step updates. On a terminal each running step is
+// drawn as a progress bar (or a spinner when its size is unknown) that is
+// redrawn in place. Otherwise it degrades to plain lines: one when a step
+// starts, one per 10% of progress (or per node), and one when it ends.
+type StepRenderer struct {
+	out     io.Writer
+	tty     bool
+	spinner *StatusSpinner
+	drawn   bool // a progress line is on screen (tty only)
+
+	// Plain mode state, to avoid printing a line per update.
+	step   string
+	bucket int64
+}
+
+// NewStepRenderer creates a StepRenderer writing to out. tty selects
+// in-place progress bars; pass false when out is not a terminal. In JSON log
+// format the plain lines are emitted as events instead.
+func NewStepRenderer(out io.Writer, tty bool) *StepRenderer {
+	if events := JSONEvents(); events != nil {
+		return &StepRenderer{out: events.LineWriter(LevelInfo)}
+	}
+	return &StepRenderer{out: out, tty: tty}
+}
+
+// Render draws a step update.
+func (r *StepRenderer) Render(u StepUpdate) {
+	switch u.Status {
+	case "running":
+		if r.tty {
+			r.renderRunning(u)
+		} else {
+			r.renderPlain(u)
+		}
+	case "completed":
+		r.Clear()
+		r.step = ""
+		fmt.Fprintf(r.out, "  %s %s\n", color.GreenString("✓"), withDetail(u.Name, u.Detail))
+	case "failed":
+		r.Clear()
+		r.step = ""
+		fmt.Fprintf(r.out, "  %s %s\n", color.RedString("✗"), withDetail(u.Name, u.Detail))
+	}
+}
+
+// Clear removes the progress line, if any, so other output can be printed.
+// The next running update draws it again.
+func (r *StepRenderer) Clear() {
+	if r.spinner != nil {
+		r.spinner.Stop()
+		r.spinner = nil
+	}
+	if r.drawn {
+		fmt.Fprint(r.out, "\x1b[2K\r")
+		r.drawn = false
+	}
+}
+
+func (r *StepRenderer) renderRunning(u StepUpdate) {
+	if u.Total <= 0 {
+		// Unknown size: animate a spinner instead of a bar.
+		if r.drawn {
+			fmt.Fprint(r.out, "\x1b[2K\r")
+			r.drawn = false
+		}
+		msg := withDetail(u.Name, u.Detail)
+		if r.spinner == nil {
+			r.spinner = &StatusSpinner{out: r.out}
+			r.spinner.Start(msg)
+		} else {
+			r.spinner.Update(msg)
+		}
+		return
+	}
+
+	if r.spinner != nil {
+		r.spinner.Stop()
+		r.spinner = nil
+	}
+	fmt.Fprintf(r.out, "\x1b[2K\r  %s %s %s", u.Name, color.CyanString(progressBar(u.Current, u.Total)), progressText(u))
+	r.drawn = true
+}
+
+func (r *StepRenderer) renderPlain(u StepUpdate) {
+	if u.Name != r.step {
+		r.step = u.Name
+		r.bucket = plainBucket(u)
+		if u.Total > 0 {
+			fmt.Fprintf(r.out, "  → %s: %s\n", u.Name, progressText(u))
+		} else {
+			fmt.Fprintf(r.out, "  → %s\n", withDetail(u.Name, u.Detail))
+		}
+		return
+	}
+
+	if u.Total <= 0 {
+		return
+	}
+	if bucket := plainBucket(u); bucket != r.bucket {
+		r.bucket = bucket
+		fmt.Fprintf(r.out, "    %s: %s\n", u.Name, progressText(u))
+	}
+}
+
+// plainBucket groups updates so plain output prints a line per node and
+// per 10% of anything else.
+func plainBucket(u StepUpdate) int64 {
+	if u.Total <= 0 {
+		return 0
+	}
+	if u.Unit == "nodes" {
+		return u.Current
+	}
+	return u.Current * 10 / u.Total
+}
+
+// progressBar returns a bar of stepBarWidth characters filled to current/total.
+func progressBar(current, total int64) string {
+	filled := 0
+	if total > 0 {
+		filled = int(current * stepBarWidth / total)
+	}
+	if filled < 0 {
+		filled = 0
+	} else if filled > stepBarWidth {
+		filled = stepBarWidth
+	}
+	return strings.Repeat("█", filled) + strings.Repeat("░", stepBarWidth-filled)
+}
+
+// progressText describes the progress of u in its unit.
+func progressText(u StepUpdate) string {
+	pct := float64(u.Current) / float64(u.Total) * 100
+
+	var text string
+	switch u.Unit {
+	case "bytes":
+		const mb = 1024 * 1024
+		text = fmt.Sprintf("%5.1f%% | %.1f/%.1f MB", pct, float64(u.Current)/mb, float64(u.Total)/mb)
+		if u.Speed > 0 {
+			text += fmt.Sprintf(" | %.1f MB/s | ETA: %s", u.Speed/mb, formatETA(float64(u.Total-u.Current)/u.Speed))
+		}
+	case "seconds":
+		remaining := u.Total - u.Current
+		if remaining < 0 {
+			remaining = 0
+		}
+		text = fmt.Sprintf("%ds left", remaining)
+	case "":
+		text = fmt.Sprintf("%d/%d", u.Current, u.Total)
+	default:
+		text = fmt.Sprintf("%d/%d %s", u.Current, u.Total, u.Unit)
+	}
+
+	if u.Detail != "" {
+		text += " (" + u.Detail + ")"
+	}
+	return text
+}
+
+// formatETA formats a duration in seconds as "42s", "3.5m" or "1.2h".
+func formatETA(secs float64) string {
+	switch {
+	case secs < 60:
+		return fmt.Sprintf("%.0fs", secs)
+	case secs < 3600:
+		return fmt.Sprintf("%.1fm", secs/60)
+	default:
+		return fmt.Sprintf("%.1fh", secs/3600)
+	}
+}
+
+func withDetail(name, detail string) string {
+	if detail == "" {
+		return name
+	}
+	return fmt.Sprintf("%s (%s)", name, detail)
+}
@@ -0,0 +1,104 @@
+// internal/output/step_progress_test.go
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestStepRenderer_PlainPrintsLinePerTenPercent(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewStepRenderer(&buf, false)
+
+	for i := int64(0); i <= 100; i++ {
+		r.Render(StepUpdate{Name: "Downloading snapshot", Status: "running", Current: i * 1024 * 1024, Total: 100 * 1024 * 1024, Unit: "bytes"})
+	}
+	r.Render(StepUpdate{Name: "Downloading snapshot", Status: "completed"})
+
+	out := buf.String()
+	if strings.Contains(out, "\r") || strings.Contains(out, "\x1b") {
+		t.Errorf("plain output should not contain control sequences, got %q", out)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	// Start line, one line per 10% (10..100) and the completion line.
+	if len(lines) != 12 {
+		t.Fatalf("got %d lines, want 12:\n%s", len(lines), out)
+	}
+	if !strings.Contains(lines[0], "Downloading snapshot:") || !strings.Contains(lines[0], "0.0/100.0 MB") {
+		t.Errorf("unexpected start line %q", lines[0])
+	}
+	if !strings.Contains(lines[5], " 50.0%") {
+		t.Errorf("unexpected progress line %q", lines[5])
+	}
+	if !strings.Contains(lines[11], "✓ Downloading snapshot") {
+		t.Errorf("unexpected completion line %q", lines[11])
+	}
+}
+
+func TestStepRenderer_PlainPrintsEachNode(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewStepRenderer(&buf, false)
+
+	for i := int64(0); i < 3; i++ {
+		r.Render(StepUpdate{Name: "Initializing nodes", Status: "running", Current: i, Total: 3, Unit: "nodes"})
+		// Repeated updates for the same node print nothing.
+		r.Render(StepUpdate{Name: "Initializing nodes", Status: "running", Current: i, Total: 3, Unit: "nodes"})
+	}
+
+	want := "  → Initializing nodes: 0/3 nodes\n" +
+		"    Initializing nodes: 1/3 nodes\n" +
+		"    Initializing nodes: 2/3 nodes\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestStepRenderer_TTYRedrawsInPlace(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewStepRenderer(&buf, true)
+
+	r.Render(StepUpdate{Name: "Waiting for healthy nodes", Status: "running", Current: 5, Total: 60, Unit: "seconds", Detail: "1/4 healthy"})
+	out := buf.String()
+	if !strings.HasPrefix(out, "\x1b[2K\r") {
+		t.Errorf("progress line should redraw in place, got %q", out)
+	}
+	if strings.Contains(out, "\n") {
+		t.Errorf("progress line should not end the line, got %q", out)
+	}
+	if !strings.Contains(out, "55s left (1/4 healthy)") {
+		t.Errorf("countdown missing, got %q", out)
+	}
+
+	buf.Reset()
+	r.Clear()
+	if buf.String() != "\x1b[2K\r" {
+		t.Errorf("Clear() wrote %q, want line erase", buf.String())
+	}
+	buf.Reset()
+	r.Clear()
+	if buf.Len() != 0 {
+		t.Errorf("second Clear() wrote %q, want nothing", buf.String())
+	}
+}
+
+func TestProgressText(t *testing.T) {
+	tests := []struct {
+		name string
+		u    StepUpdate
+		want string
+	}{
+		{"files", StepUpdate{Current: 3, Total: 10, Unit: "files"}, "3/10 files"},
+		{"no unit", StepUpdate{Current: 1, Total: 2}, "1/2"},
+		{"countdown past deadline", StepUpdate{Current: 70, Total: 60, Unit: "seconds"}, "0s left"},
+		{"detail", StepUpdate{Current: 1, Total: 4, Unit: "nodes", Detail: "validator-1"}, "1/4 nodes (validator-1)"},
+		{"bytes with speed", StepUpdate{Current: 1 << 20, Total: 2 << 20, Unit: "bytes", Speed: 1 << 20}, " 50.0% | 1.0/2.0 MB | 1.0 MB/s | ETA: 1s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := progressText(tt.u); got != tt.want {
+				t.Errorf("progressText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
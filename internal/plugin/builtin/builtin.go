@@ -0,0 +1,42 @@
+// Package builtin provides the network modules that ship with
+// devnet-builder: the Cosmos Hub (Gaia), Osmosis and Evmos. They are
+// selected with --network cosmos, osmosis or evmos and need no plugin
+// installation.
+package builtin
+
+import (
+	"fmt"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	pkgNetwork "github.com/altuslabsxyz/devnet-builder/pkg/network"
+)
+
+// Modules returns the built-in network modules.
+func Modules() []pkgNetwork.Module {
+	return []pkgNetwork.Module{NewGaia(), NewOsmosis(), NewEvmos()}
+}
+
+// Has reports whether name is the name of a built-in network.
+func Has(name string) bool {
+	for _, m := range Modules() {
+		if m.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Register adds the built-in modules to the global network registry.
+// Modules already registered are left in place, so calling it again is
+// harmless.
+func Register() error {
+	for _, m := range Modules() {
+		if network.Has(m.Name()) {
+			continue
+		}
+		if err := network.MustRegister(network.NewPluginAdapter(m), false); err != nil {
+			return fmt.Errorf("failed to register built-in network %q: %w", m.Name(), err)
+		}
+	}
+	return nil
+}
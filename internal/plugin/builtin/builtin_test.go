@@ -0,0 +1,259 @@
+// internal/plugin/builtin/builtin_test.go
+package builtin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/infrastructure/network"
+	pkgNetwork "github.com/altuslabsxyz/devnet-builder/pkg/network"
+)
+
+func TestRegister(t *testing.T) {
+	if err := Register(); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	// Registering again leaves the modules in place
+	if err := Register(); err != nil {
+		t.Fatalf("second Register() error = %v", err)
+	}
+
+	for _, name := range []string{"cosmos", "osmosis", "evmos"} {
+		if !Has(name) {
+			t.Errorf("Has(%q) = false", name)
+		}
+		m, err := network.Get(name)
+		if err != nil {
+			t.Fatalf("Get(%q) error = %v", name, err)
+		}
+		if _, ok := m.(pkgNetwork.UpgradeNamer); !ok {
+			t.Errorf("%s module does not name upgrades", name)
+		}
+	}
+	if Has("stable") {
+		t.Error(`Has("stable") = true`)
+	}
+}
+
+const testGenesis = `{
+  "chain_id": "cosmoshub-4",
+  "app_state": {
+    "gov": {"params": {"voting_period": "1209600s", "expedited_voting_period": "604800s", "max_deposit_period": "1209600s"}},
+    "staking": {"params": {"unbonding_time": "1814400s"}},
+    "txfees": {"basedenom": "stake"}
+  }
+}`
+
+func TestModifyGenesis(t *testing.T) {
+	tests := []struct {
+		module      pkgNetwork.Module
+		wantTxFees  string
+		wantChainID string
+	}{
+		{NewGaia(), "stake", "test-1"},
+		{NewOsmosis(), "uosmo", "test-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.module.Name(), func(t *testing.T) {
+			out, err := tt.module.ModifyGenesis([]byte(testGenesis), pkgNetwork.GenesisOptions{ChainID: tt.wantChainID})
+			if err != nil {
+				t.Fatalf("ModifyGenesis() error = %v", err)
+			}
+
+			var gen struct {
+				ChainID  string `json:"chain_id"`
+				AppState struct {
+					Gov struct {
+						Params map[string]string `json:"params"`
+					} `json:"gov"`
+					Staking struct {
+						Params map[string]string `json:"params"`
+					} `json:"staking"`
+					TxFees struct {
+						BaseDenom string `json:"basedenom"`
+					} `json:"txfees"`
+				} `json:"app_state"`
+			}
+			if err := json.Unmarshal(out, &gen); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if gen.ChainID != tt.wantChainID {
+				t.Errorf("chain_id = %q, want %q", gen.ChainID, tt.wantChainID)
+			}
+			gov := gen.AppState.Gov.Params
+			if gov["voting_period"] != "60000000000ns" || gov["expedited_voting_period"] != "30000000000ns" {
+				t.Errorf("gov params = %v", gov)
+			}
+			if got := gen.AppState.Staking.Params["unbonding_time"]; got != "120000000000ns" {
+				t.Errorf("unbonding_time = %q", got)
+			}
+			if gen.AppState.TxFees.BaseDenom != tt.wantTxFees {
+				t.Errorf("txfees.basedenom = %q, want %q", gen.AppState.TxFees.BaseDenom, tt.wantTxFees)
+			}
+		})
+	}
+}
+
+func TestUpgradeName(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+		ok      bool
+	}{
+		{"v19.0.0", "v19", true},
+		{"28.0.0", "v28", true},
+		{"v25.0.0-rc1", "v25", true},
+		{"v15.2.0", "v15.2.0", true},
+		{"v21.0.1", "v21.0.1", true},
+		{"main", "", false},
+		{"v19", "", false},
+		{"feat/x", "", false},
+	}
+	g := NewGaia()
+	for _, tt := range tests {
+		got, ok := g.UpgradeName(tt.version)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("UpgradeName(%q) = %q, %v; want %q, %v", tt.version, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestGetConfigOverrides(t *testing.T) {
+	configToml, appToml, err := NewOsmosis().GetConfigOverrides(0, pkgNetwork.NodeConfigOptions{})
+	if err != nil {
+		t.Fatalf("GetConfigOverrides() error = %v", err)
+	}
+	if configToml != nil {
+		t.Errorf("config.toml overrides = %q, want none", configToml)
+	}
+	app := string(appToml)
+	if !strings.Contains(app, `minimum-gas-prices = "0.0025uosmo"`) {
+		t.Errorf("app.toml overrides lack the gas price:\n%s", app)
+	}
+	if strings.Contains(app, "json-rpc") || strings.Contains(app, "evm") {
+		t.Errorf("app.toml overrides configure EVM:\n%s", app)
+	}
+}
+
+func TestEvmos(t *testing.T) {
+	e := NewEvmos()
+	if ports := e.DefaultPorts(); ports.EVMRPC != 8545 || ports.EVMSocket != 8546 {
+		t.Errorf("EVM ports = %d/%d, want 8545/8546", ports.EVMRPC, ports.EVMSocket)
+	}
+	if got := e.GenesisConfig().EVMChainID; got != 9002 {
+		t.Errorf("EVMChainID = %d, want 9002", got)
+	}
+	if got, ok := e.UpgradeName("v20.0.0-rc2"); got != "v20.0.0" || !ok {
+		t.Errorf("UpgradeName(v20.0.0-rc2) = %q, %v", got, ok)
+	}
+
+	_, appToml, err := e.GetConfigOverrides(3, pkgNetwork.NodeConfigOptions{})
+	if err != nil {
+		t.Fatalf("GetConfigOverrides() error = %v", err)
+	}
+	if app := string(appToml); !strings.Contains(app, "[json-rpc]\nenable = true") {
+		t.Errorf("app.toml overrides do not enable JSON-RPC:\n%s", app)
+	}
+}
+
+const testEVMGenesis = `{
+  "chain_id": "evmos_9001-2",
+  "app_state": {
+    "gov": {"params": {"voting_period": "1209600s", "max_deposit_period": "1209600s"}},
+    "evm": {"params": {"evm_denom": "stake", "chain_config": {"chain_id": "9001", "homestead_block": "0"}}}
+  }
+}`
+
+func TestEvmosModifyGenesis(t *testing.T) {
+	e := NewEvmos()
+	out, err := e.ModifyGenesis([]byte(testEVMGenesis), pkgNetwork.GenesisOptions{ChainID: "devnet_4242-1"})
+	if err != nil {
+		t.Fatalf("ModifyGenesis() error = %v", err)
+	}
+	var gen struct {
+		ChainID  string `json:"chain_id"`
+		AppState struct {
+			EVM struct {
+				Params struct {
+					EVMDenom    string            `json:"evm_denom"`
+					ChainConfig map[string]string `json:"chain_config"`
+				} `json:"params"`
+			} `json:"evm"`
+		} `json:"app_state"`
+	}
+	if err := json.Unmarshal(out, &gen); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	params := gen.AppState.EVM.Params
+	if gen.ChainID != "devnet_4242-1" || params.EVMDenom != "aevmos" || params.ChainConfig["chain_id"] != "4242" {
+		t.Errorf("genesis = %s, want chain devnet_4242-1, evm_denom aevmos, EVM chain ID 4242", out)
+	}
+
+	if _, err := e.ModifyGenesis([]byte(testEVMGenesis), pkgNetwork.GenesisOptions{ChainID: "devnet-1"}); err == nil {
+		t.Error("ModifyGenesis() with a chain ID without an EVM chain ID succeeded")
+	}
+}
+
+func TestGetGovernanceParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		routes  map[string]string
+		wantExp time.Duration
+	}{
+		{
+			name: "params",
+			routes: map[string]string{
+				"/cosmos/gov/v1/params/voting": `{"voting_params":null,"params":{"voting_period":"172800s","expedited_voting_period":"86400s",
+					"min_deposit":[{"denom":"ibc/27394FB","amount":"1"},{"denom":"uatom","amount":"250000000"}],
+					"expedited_min_deposit":[{"denom":"uatom","amount":"500000000"}]}}`,
+			},
+			wantExp: 24 * time.Hour,
+		},
+		{
+			name: "legacy",
+			routes: map[string]string{
+				"/cosmos/gov/v1/params/voting":  `{"voting_params":{"voting_period":"172800s"}}`,
+				"/cosmos/gov/v1/params/deposit": `{"deposit_params":{"min_deposit":[{"denom":"uatom","amount":"250000000"}]}}`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, ok := tt.routes[r.URL.Path]
+				if !ok {
+					http.NotFound(w, r)
+					return
+				}
+				_, _ = w.Write([]byte(body))
+			}))
+			defer srv.Close()
+
+			resp, err := NewGaia().GetGovernanceParams(srv.URL+"/", "mainnet")
+			if err != nil {
+				t.Fatalf("GetGovernanceParams() error = %v", err)
+			}
+			if time.Duration(resp.VotingPeriodNs) != 48*time.Hour {
+				t.Errorf("voting period = %v", time.Duration(resp.VotingPeriodNs))
+			}
+			if time.Duration(resp.ExpeditedVotingPeriodNs) != tt.wantExp {
+				t.Errorf("expedited voting period = %v, want %v", time.Duration(resp.ExpeditedVotingPeriodNs), tt.wantExp)
+			}
+			if resp.MinDeposit != "250000000" {
+				t.Errorf("min deposit = %q", resp.MinDeposit)
+			}
+		})
+	}
+
+	t.Run("unreachable", func(t *testing.T) {
+		srv := httptest.NewServer(http.NotFoundHandler())
+		defer srv.Close()
+		if _, err := NewGaia().GetGovernanceParams(srv.URL, "mainnet"); err == nil {
+			t.Error("GetGovernanceParams() error = nil, want the HTTP failure")
+		}
+	})
+}
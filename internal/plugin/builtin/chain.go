@@ -0,0 +1,287 @@
+// internal/plugin/builtin/chain.go
+package builtin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+)
+
+// moduleVersion is the version reported by the built-in modules.
+const moduleVersion = "1.0.0"
+
+// chainSpec describes a Cosmos SDK chain without EVM support.
+type chainSpec struct {
+	name        string
+	displayName string
+
+	binaryName     string
+	owner          string
+	repo           string
+	defaultVersion string
+
+	bech32Prefix string
+	baseDenom    string
+	displayDenom string
+
+	// minGasPrice is written to app.toml as minimum-gas-prices.
+	minGasPrice string
+
+	dockerImage   string
+	dockerHomeDir string
+	nodeHome      string
+
+	devnetChainID  string
+	chainIDPattern string
+
+	// snapshotURLs and rpcEndpoints are keyed by network type.
+	snapshotURLs map[string]string
+	rpcEndpoints map[string]string
+}
+
+// chain implements network.Module for a chainSpec. Gaia, Osmosis and Evmos
+// embed it and add what is specific to them.
+type chain struct {
+	spec   chainSpec
+	client *http.Client
+}
+
+func newChain(spec chainSpec) chain {
+	return chain{
+		spec:   spec,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Identity methods
+
+func (c *chain) Name() string        { return c.spec.name }
+func (c *chain) DisplayName() string { return c.spec.displayName }
+func (c *chain) Version() string     { return moduleVersion }
+
+// Binary methods
+
+func (c *chain) BinaryName() string { return c.spec.binaryName }
+
+func (c *chain) BinarySource() network.BinarySource {
+	return network.BinarySource{
+		Type:      "github",
+		Owner:     c.spec.owner,
+		Repo:      c.spec.repo,
+		AssetName: c.spec.binaryName + "-*-linux-amd64",
+	}
+}
+
+func (c *chain) DefaultBinaryVersion() string { return c.spec.defaultVersion }
+
+func (c *chain) GetBuildConfig(networkType string) (*network.BuildConfig, error) {
+	return &network.BuildConfig{}, nil
+}
+
+// Chain configuration methods
+
+func (c *chain) DefaultChainID() string { return c.spec.devnetChainID }
+func (c *chain) Bech32Prefix() string   { return c.spec.bech32Prefix }
+func (c *chain) BaseDenom() string      { return c.spec.baseDenom }
+
+func (c *chain) GenesisConfig() network.GenesisConfig {
+	return network.GenesisConfig{
+		ChainIDPattern:    c.spec.chainIDPattern,
+		BaseDenom:         c.spec.baseDenom,
+		DenomExponent:     6,
+		DisplayDenom:      c.spec.displayDenom,
+		BondDenom:         c.spec.baseDenom,
+		MinSelfDelegation: "1",
+		UnbondingTime:     120 * time.Second,
+		MaxValidators:     100,
+		MinDeposit:        "10000000" + c.spec.baseDenom,
+		VotingPeriod:      60 * time.Second,
+		MaxDepositPeriod:  120 * time.Second,
+		CommunityTax:      "0.020000000000000000",
+	}
+}
+
+func (c *chain) DefaultPorts() network.PortConfig {
+	return network.PortConfig{
+		RPC:     26657,
+		P2P:     26656,
+		GRPC:    9090,
+		GRPCWeb: 9091,
+		API:     1317,
+	}
+}
+
+// Docker methods
+
+func (c *chain) DockerImage() string                  { return c.spec.dockerImage }
+func (c *chain) DockerImageTag(version string) string { return version }
+func (c *chain) DockerHomeDir() string                { return c.spec.dockerHomeDir }
+
+// Path methods
+
+func (c *chain) DefaultNodeHome() string { return c.spec.nodeHome }
+func (c *chain) PIDFileName() string     { return c.spec.binaryName + ".pid" }
+func (c *chain) LogFileName() string     { return c.spec.binaryName + ".log" }
+func (c *chain) ProcessPattern() string  { return c.spec.binaryName + ".*start" }
+
+// Command methods
+
+func (c *chain) InitCommand(homeDir, chainID, moniker string) []string {
+	return []string{"init", moniker, "--chain-id", chainID, "--home", homeDir}
+}
+
+func (c *chain) StartCommand(homeDir string, networkMode string) []string {
+	return []string{"start", "--home", homeDir}
+}
+
+func (c *chain) ExportCommand(homeDir string) []string {
+	return []string{"export", "--home", homeDir}
+}
+
+// Devnet methods
+
+// ModifyGenesis sets the chain ID and shortens the governance and staking
+// periods to the values of GenesisConfig. The expedited voting period, on
+// chains that have one, is set to half the voting period: it must be the
+// shorter of the two.
+func (c *chain) ModifyGenesis(genesis []byte, opts network.GenesisOptions) ([]byte, error) {
+	var gen map[string]any
+	if err := json.Unmarshal(genesis, &gen); err != nil {
+		return nil, fmt.Errorf("failed to parse genesis: %w", err)
+	}
+	if err := c.patchGenesis(gen, opts); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(gen, "", "  ")
+}
+
+// patchGenesis applies the modifications of ModifyGenesis to a parsed
+// genesis.
+func (c *chain) patchGenesis(gen map[string]any, opts network.GenesisOptions) error {
+	if opts.ChainID != "" {
+		gen["chain_id"] = opts.ChainID
+	}
+
+	appState, ok := gen["app_state"].(map[string]any)
+	if !ok {
+		return fmt.Errorf("invalid app_state format")
+	}
+
+	cfg := c.GenesisConfig()
+	if params := moduleParams(appState, "gov"); params != nil {
+		params["voting_period"] = formatDuration(cfg.VotingPeriod)
+		params["max_deposit_period"] = formatDuration(cfg.MaxDepositPeriod)
+		if _, ok := params["expedited_voting_period"]; ok {
+			params["expedited_voting_period"] = formatDuration(cfg.VotingPeriod / 2)
+		}
+	}
+	if params := moduleParams(appState, "staking"); params != nil {
+		params["unbonding_time"] = formatDuration(cfg.UnbondingTime)
+	}
+	return nil
+}
+
+// GenerateDevnet is not supported: the daemon generates devnets itself.
+func (c *chain) GenerateDevnet(ctx context.Context, config network.GeneratorConfig, genesisFile string) error {
+	return fmt.Errorf("GenerateDevnet is not supported by the built-in %s module", c.spec.name)
+}
+
+func (c *chain) DefaultGeneratorConfig() network.GeneratorConfig {
+	return network.GeneratorConfig{
+		NumValidators:    4,
+		NumAccounts:      10,
+		AccountBalance:   "100000000000" + c.spec.baseDenom,
+		ValidatorBalance: "1000000000000" + c.spec.baseDenom,
+		ValidatorStake:   "100000000" + c.spec.baseDenom,
+		OutputDir:        "./devnet",
+		ChainID:          c.spec.devnetChainID,
+	}
+}
+
+// GetCodec returns no codec: the chains use only Cosmos SDK types.
+func (c *chain) GetCodec() ([]byte, error) { return nil, nil }
+
+func (c *chain) Validate() error {
+	switch {
+	case c.spec.name == "":
+		return fmt.Errorf("network name is required")
+	case c.spec.binaryName == "":
+		return fmt.Errorf("binary name is required")
+	case c.spec.baseDenom == "":
+		return fmt.Errorf("base denom is required")
+	}
+	return nil
+}
+
+// Network methods
+
+func (c *chain) SnapshotURL(networkType string) string { return c.spec.snapshotURLs[networkType] }
+func (c *chain) RPCEndpoint(networkType string) string { return c.spec.rpcEndpoints[networkType] }
+func (c *chain) AvailableNetworks() []string           { return []string{"mainnet", "testnet"} }
+
+// GetConfigOverrides sets the minimum gas price and serves the REST API and
+// gRPC. The chains have no EVM, so no JSON-RPC settings are written.
+func (c *chain) GetConfigOverrides(nodeIndex int, opts network.NodeConfigOptions) ([]byte, []byte, error) {
+	appToml := fmt.Sprintf(`minimum-gas-prices = %q
+
+[api]
+enable = true
+
+[grpc]
+enable = true
+`, c.spec.minGasPrice)
+	return nil, []byte(appToml), nil
+}
+
+// UpgradeName implements network.UpgradeNamer. Gaia and Osmosis register
+// the upgrade handler of a major release under its major version
+// ("v19.0.0" → "v19"); the rare minor or patch release that carries a
+// handler uses its full version ("v15.2.0" → "v15.2.0"). Release candidates
+// share the name of their release.
+func (c *chain) UpgradeName(version string) (string, bool) {
+	parts, ok := releaseVersion(version)
+	if !ok {
+		return "", false
+	}
+	if parts[1] == "0" && parts[2] == "0" {
+		return "v" + parts[0], true
+	}
+	return "v" + strings.Join(parts, "."), true
+}
+
+// releaseVersion splits a release version such as "v19.0.0" or
+// "v25.0.0-rc1" into its major, minor and patch numbers.
+func releaseVersion(version string) ([]string, bool) {
+	v, _, _ := strings.Cut(strings.TrimPrefix(version, "v"), "-")
+	parts := strings.Split(v, ".")
+	if len(parts) != 3 {
+		return nil, false
+	}
+	for _, p := range parts {
+		if _, err := strconv.ParseUint(p, 10, 32); err != nil {
+			return nil, false
+		}
+	}
+	return parts, true
+}
+
+// moduleParams returns the params of a module in app_state, or nil.
+func moduleParams(appState map[string]any, module string) map[string]any {
+	state, ok := appState[module].(map[string]any)
+	if !ok {
+		return nil
+	}
+	params, _ := state["params"].(map[string]any)
+	return params
+}
+
+// formatDuration formats d as a Cosmos SDK duration string.
+func formatDuration(d time.Duration) string {
+	return fmt.Sprintf("%dns", d.Nanoseconds())
+}
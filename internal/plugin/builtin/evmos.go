@@ -0,0 +1,134 @@
+// internal/plugin/builtin/evmos.go
+package builtin
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+	dvbtypes "github.com/altuslabsxyz/devnet-builder/types"
+)
+
+// evmosEVMChainID is the EVM chain ID of Evmos devnets, the one the Evmos
+// local node scripts use.
+const evmosEVMChainID = 9002
+
+// Evmos is the built-in module for Evmos and other chains built on the
+// Ethermint EVM module, selected with --network evmos.
+type Evmos struct {
+	chain
+}
+
+// Ensure Evmos implements network.Module and its optional interfaces
+var (
+	_ network.Module       = (*Evmos)(nil)
+	_ network.UpgradeNamer = (*Evmos)(nil)
+)
+
+// NewEvmos returns the Evmos module.
+func NewEvmos() *Evmos {
+	return &Evmos{chain: newChain(chainSpec{
+		name:           "evmos",
+		displayName:    "Evmos",
+		binaryName:     "evmosd",
+		owner:          "evmos",
+		repo:           "evmos",
+		defaultVersion: "v20.0.0",
+		bech32Prefix:   "evmos",
+		baseDenom:      "aevmos",
+		displayDenom:   "EVMOS",
+		minGasPrice:    "0aevmos",
+		dockerImage:    "tharsishq/evmos",
+		dockerHomeDir:  "/root/.evmosd",
+		nodeHome:       "/root/.evmosd",
+		devnetChainID:  fmt.Sprintf("evmos_%d-1", evmosEVMChainID),
+		chainIDPattern: "evmos_{evmid}-1",
+		snapshotURLs: map[string]string{
+			"mainnet": "https://snapshots.cosmos.directory/evmos_9001-2/latest.tar.lz4",
+			"testnet": "https://snapshots.cosmos.directory/evmos_9000-4/latest.tar.lz4",
+		},
+		rpcEndpoints: map[string]string{
+			"mainnet": "https://evmos-rpc.polkachu.com",
+			"testnet": "https://evmos-testnet-rpc.polkachu.com",
+		},
+	})}
+}
+
+// GenesisConfig adds the EVM chain ID to the common config. The EVM
+// denom has 18 decimals, like ether.
+func (e *Evmos) GenesisConfig() network.GenesisConfig {
+	cfg := e.chain.GenesisConfig()
+	cfg.EVMChainID = evmosEVMChainID
+	cfg.DenomExponent = 18
+	cfg.MinDeposit = "10000000000000000000" + e.spec.baseDenom
+	return cfg
+}
+
+// DefaultPorts adds the EVM JSON-RPC and WebSocket ports.
+func (e *Evmos) DefaultPorts() network.PortConfig {
+	ports := e.chain.DefaultPorts()
+	ports.EVMRPC = 8545
+	ports.EVMSocket = 8546
+	return ports
+}
+
+// ModifyGenesis applies the common modifications and points the EVM module
+// at the chain: Ethermint takes the EVM chain ID from the chain ID, which
+// must therefore be in the <name>_<EVM chain ID>-<epoch> format, and
+// versions whose chain config carries the EVM chain ID get it patched to
+// match.
+func (e *Evmos) ModifyGenesis(genesis []byte, opts network.GenesisOptions) ([]byte, error) {
+	var gen map[string]any
+	if err := json.Unmarshal(genesis, &gen); err != nil {
+		return nil, fmt.Errorf("failed to parse genesis: %w", err)
+	}
+	if err := e.patchGenesis(gen, opts); err != nil {
+		return nil, err
+	}
+
+	chainID, _ := gen["chain_id"].(string)
+	evmChainID, ok := dvbtypes.ChainID(chainID).EVMChainID()
+	if !ok {
+		return nil, fmt.Errorf("chain ID %q is not in the <name>_<EVM chain ID>-<epoch> format EVM chains need", chainID)
+	}
+
+	appState := gen["app_state"].(map[string]any)
+	if params := moduleParams(appState, "evm"); params != nil {
+		params["evm_denom"] = e.spec.baseDenom
+		if chainConfig, ok := params["chain_config"].(map[string]any); ok {
+			if _, ok := chainConfig["chain_id"]; ok {
+				chainConfig["chain_id"] = fmt.Sprint(evmChainID)
+			}
+		}
+	}
+	return json.MarshalIndent(gen, "", "  ")
+}
+
+// evmAPIs are the JSON-RPC namespaces devnet nodes serve.
+var evmAPIs = []string{"eth", "net", "web3", "txpool", "debug"}
+
+// GetConfigOverrides adds JSON-RPC to the common overrides, on every node.
+// The daemon sets the listen addresses from the node's ports.
+func (e *Evmos) GetConfigOverrides(nodeIndex int, opts network.NodeConfigOptions) ([]byte, []byte, error) {
+	configToml, appToml, err := e.chain.GetConfigOverrides(nodeIndex, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	appToml = append(appToml, fmt.Sprintf(`
+[json-rpc]
+enable = true
+api = %q
+`, strings.Join(evmAPIs, ","))...)
+	return configToml, appToml, nil
+}
+
+// UpgradeName implements network.UpgradeNamer. Evmos registers upgrade
+// handlers under the full release version ("v20.0.0" → "v20.0.0").
+func (e *Evmos) UpgradeName(version string) (string, bool) {
+	parts, ok := releaseVersion(version)
+	if !ok {
+		return "", false
+	}
+	return "v" + strings.Join(parts, "."), true
+}
@@ -0,0 +1,45 @@
+// internal/plugin/builtin/gaia.go
+package builtin
+
+import "github.com/altuslabsxyz/devnet-builder/pkg/network"
+
+// Gaia is the built-in module for the Cosmos Hub, selected with
+// --network cosmos.
+type Gaia struct {
+	chain
+}
+
+// Ensure Gaia implements network.Module and its optional interfaces
+var (
+	_ network.Module       = (*Gaia)(nil)
+	_ network.UpgradeNamer = (*Gaia)(nil)
+)
+
+// NewGaia returns the Cosmos Hub module.
+func NewGaia() *Gaia {
+	return &Gaia{chain: newChain(chainSpec{
+		name:           "cosmos",
+		displayName:    "Cosmos Hub",
+		binaryName:     "gaiad",
+		owner:          "cosmos",
+		repo:           "gaia",
+		defaultVersion: "v22.0.0",
+		bech32Prefix:   "cosmos",
+		baseDenom:      "uatom",
+		displayDenom:   "ATOM",
+		minGasPrice:    "0.005uatom",
+		dockerImage:    "ghcr.io/cosmos/gaia",
+		dockerHomeDir:  "/home/gaia",
+		nodeHome:       "/root/.gaia",
+		devnetChainID:  "cosmosdevnet-1",
+		chainIDPattern: "cosmosdevnet-{num}",
+		snapshotURLs: map[string]string{
+			"mainnet": "https://snapshots.cosmos.directory/cosmoshub-4/latest.tar.lz4",
+			"testnet": "https://snapshots.cosmos.directory/theta-testnet-001/latest.tar.lz4",
+		},
+		rpcEndpoints: map[string]string{
+			"mainnet": "https://cosmos-rpc.polkachu.com",
+			"testnet": "https://rpc.sentry-01.theta-testnet.polypore.xyz",
+		},
+	})}
+}
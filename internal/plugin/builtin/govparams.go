@@ -0,0 +1,112 @@
+// internal/plugin/builtin/govparams.go
+package builtin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network/plugin"
+)
+
+// govCoin is a coin in a gov params REST response.
+type govCoin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+// govParams holds the gov params fields of the voting and deposit params
+// REST responses.
+type govParams struct {
+	VotingPeriod          string    `json:"voting_period"`
+	ExpeditedVotingPeriod string    `json:"expedited_voting_period"`
+	MinDeposit            []govCoin `json:"min_deposit"`
+	ExpeditedMinDeposit   []govCoin `json:"expedited_min_deposit"`
+}
+
+// GetGovernanceParams queries the governance params of a running chain
+// through its REST API at restEndpoint. Chains on Cosmos SDK v0.47 or later
+// return all params from the voting params query; older chains need the
+// deposit params query as well. Deposits are reported in the base denom.
+func (c *chain) GetGovernanceParams(restEndpoint, networkType string) (*plugin.GovernanceParamsResponse, error) {
+	ctx := context.Background()
+	base := strings.TrimRight(restEndpoint, "/")
+
+	var voting struct {
+		Params       govParams `json:"params"`
+		VotingParams govParams `json:"voting_params"`
+	}
+	if err := c.getJSON(ctx, base+"/cosmos/gov/v1/params/voting", &voting); err != nil {
+		return nil, err
+	}
+
+	params := voting.Params
+	if params.VotingPeriod == "" {
+		var deposit struct {
+			DepositParams govParams `json:"deposit_params"`
+		}
+		if err := c.getJSON(ctx, base+"/cosmos/gov/v1/params/deposit", &deposit); err != nil {
+			return nil, err
+		}
+		params = voting.VotingParams
+		params.MinDeposit = deposit.DepositParams.MinDeposit
+		params.ExpeditedMinDeposit = deposit.DepositParams.ExpeditedMinDeposit
+	}
+
+	votingPeriod, err := time.ParseDuration(params.VotingPeriod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse voting_period %q: %w", params.VotingPeriod, err)
+	}
+	resp := &plugin.GovernanceParamsResponse{
+		VotingPeriodNs:      votingPeriod.Nanoseconds(),
+		MinDeposit:          c.baseAmount(params.MinDeposit),
+		ExpeditedMinDeposit: c.baseAmount(params.ExpeditedMinDeposit),
+	}
+	if params.ExpeditedVotingPeriod != "" {
+		expedited, err := time.ParseDuration(params.ExpeditedVotingPeriod)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse expedited_voting_period %q: %w", params.ExpeditedVotingPeriod, err)
+		}
+		resp.ExpeditedVotingPeriodNs = expedited.Nanoseconds()
+	}
+	return resp, nil
+}
+
+// baseAmount returns the amount of the base denom in coins, or "".
+func (c *chain) baseAmount(coins []govCoin) string {
+	for _, coin := range coins {
+		if coin.Denom == c.spec.baseDenom {
+			return coin.Amount
+		}
+	}
+	return ""
+}
+
+// getJSON fetches url and decodes the JSON response into v.
+func (c *chain) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to query %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", url, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("query %s failed with status %d: %s", url, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", url, err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// internal/plugin/builtin/osmosis.go
+package builtin
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+)
+
+// Osmosis is the built-in module for Osmosis, selected with
+// --network osmosis.
+type Osmosis struct {
+	chain
+}
+
+// Ensure Osmosis implements network.Module and its optional interfaces
+var (
+	_ network.Module       = (*Osmosis)(nil)
+	_ network.UpgradeNamer = (*Osmosis)(nil)
+)
+
+// NewOsmosis returns the Osmosis module.
+func NewOsmosis() *Osmosis {
+	return &Osmosis{chain: newChain(chainSpec{
+		name:           "osmosis",
+		displayName:    "Osmosis",
+		binaryName:     "osmosisd",
+		owner:          "osmosis-labs",
+		repo:           "osmosis",
+		defaultVersion: "v28.0.0",
+		bech32Prefix:   "osmo",
+		baseDenom:      "uosmo",
+		displayDenom:   "OSMO",
+		minGasPrice:    "0.0025uosmo",
+		dockerImage:    "osmolabs/osmosis",
+		dockerHomeDir:  "/osmosis/.osmosisd",
+		nodeHome:       "/root/.osmosisd",
+		devnetChainID:  "osmosis-devnet-1",
+		chainIDPattern: "osmosis-devnet-{num}",
+		snapshotURLs: map[string]string{
+			"mainnet": "https://snapshots.cosmos.directory/osmosis-1/latest.tar.lz4",
+			"testnet": "https://snapshots.cosmos.directory/osmo-test-5/latest.tar.lz4",
+		},
+		rpcEndpoints: map[string]string{
+			"mainnet": "https://rpc.osmosis.zone",
+			"testnet": "https://rpc.testnet.osmosis.zone",
+		},
+	})}
+}
+
+// ModifyGenesis applies the common modifications and makes the base denom
+// the fee token: Osmosis charges fees in txfees.basedenom, which a freshly
+// initialized genesis leaves at the SDK default.
+func (o *Osmosis) ModifyGenesis(genesis []byte, opts network.GenesisOptions) ([]byte, error) {
+	var gen map[string]any
+	if err := json.Unmarshal(genesis, &gen); err != nil {
+		return nil, fmt.Errorf("failed to parse genesis: %w", err)
+	}
+	if err := o.patchGenesis(gen, opts); err != nil {
+		return nil, err
+	}
+
+	appState := gen["app_state"].(map[string]any)
+	if txfees, ok := appState["txfees"].(map[string]any); ok {
+		txfees["basedenom"] = o.spec.baseDenom
+	}
+	return json.MarshalIndent(gen, "", "  ")
+}
@@ -3,6 +3,7 @@ package cosmos
 
 import (
 	"fmt"
+	"net"
 	"syscall"
 	"time"
 
@@ -31,9 +32,12 @@ func (r *CosmosRuntime) StartCommand(node *types.Node) []string {
 	// The actual host path is mounted to this container path
 	homeDir := r.ContainerHomePath()
 
-	// Determine bind address: use node's assigned IP (loopback subnet) or 0.0.0.0 (docker/legacy)
+	// Determine bind address: the devnet's bind address, the node's assigned
+	// IP (loopback subnet) or 0.0.0.0 (docker/legacy)
 	bindAddr := "0.0.0.0"
-	if node.Spec.Address != "" {
+	if node.Spec.BindAddress != "" {
+		bindAddr = node.Spec.BindAddress
+	} else if node.Spec.Address != "" {
 		bindAddr = node.Spec.Address
 	}
 
@@ -42,10 +46,10 @@ func (r *CosmosRuntime) StartCommand(node *types.Node) []string {
 		"--home", homeDir,
 		// Enable API for health checks
 		"--api.enable=true",
-		fmt.Sprintf("--api.address=tcp://%s:1317", bindAddr),
+		"--api.address=tcp://" + net.JoinHostPort(bindAddr, "1317"),
 		// Enable gRPC
 		"--grpc.enable=true",
-		fmt.Sprintf("--grpc.address=%s:9090", bindAddr),
+		"--grpc.address=" + net.JoinHostPort(bindAddr, "9090"),
 		// Logging
 		"--log_format=json",
 	}
@@ -80,7 +84,7 @@ func (r *CosmosRuntime) HealthEndpoint(node *types.Node) string {
 	// Use node's Address if set (loopback subnet mode), otherwise fall back to localhost with offset
 	if node.Spec.Address != "" {
 		// Loopback subnet mode: use node's unique IP with standard RPC port
-		return fmt.Sprintf("http://%s:26657/status", node.Spec.Address)
+		return "http://" + net.JoinHostPort(node.Spec.Address, "26657") + "/status"
 	}
 
 	// Legacy mode: Calculate RPC port based on node index
@@ -51,6 +51,22 @@ type GenesisPatchOptions struct {
 	// NOTE: Validator injection is handled by the provisioner/generator layer,
 	// not by PatchGenesis. This field is passed through for reference.
 	Validators []ValidatorInfo // validator entries (injected by provisioner, not PatchGenesis)
+	// Prune selects state to drop from the forked genesis before patching.
+	// NOTE: Pruning is applied by the genesis forker, not by PatchGenesis.
+	Prune GenesisPruneOptions
+}
+
+// GenesisPruneOptions selects state to drop from a forked genesis to shrink
+// it. Everything is kept by default.
+type GenesisPruneOptions struct {
+	IBCPackets    bool   // clear IBC packet commitments, receipts and acknowledgements
+	DustThreshold string // remove plain accounts holding less than this coin (e.g., "1000uatom")
+	WasmCode      bool   // drop CosmWasm code blobs and the contracts using them
+}
+
+// Enabled reports whether any pruning is selected.
+func (o GenesisPruneOptions) Enabled() bool {
+	return o.IBCPackets || o.DustThreshold != "" || o.WasmCode
 }
 
 // DefaultDevnetPatchOptions returns patch options suitable for local devnets
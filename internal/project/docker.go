@@ -0,0 +1,123 @@
+// internal/project/docker.go
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+)
+
+// DockerContainers runs containers with the docker CLI.
+type DockerContainers struct{}
+
+// NewDockerContainers creates a docker container runner.
+func NewDockerContainers() *DockerContainers {
+	return &DockerContainers{}
+}
+
+// Run starts c on the host network, replacing any container with its name.
+func (d *DockerContainers) Run(ctx context.Context, c Container) error {
+	if err := d.Remove(ctx, c.Name); err != nil {
+		return err
+	}
+	if _, err := docker(ctx, dockerRunArgs(c)...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Remove force-removes a container.
+func (d *DockerContainers) Remove(ctx context.Context, name string) error {
+	_, err := docker(ctx, "rm", "-f", name)
+	if err != nil && isNoSuchContainer(err) {
+		return nil
+	}
+	return err
+}
+
+// State returns the docker state of a container (e.g. "running", "exited").
+func (d *DockerContainers) State(ctx context.Context, name string) (string, error) {
+	out, err := docker(ctx, "inspect", "-f", "{{.State.Status}}", name)
+	if err != nil {
+		if isNoSuchContainer(err) {
+			return StateAbsent, nil
+		}
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// Inspect returns the image, command, environment and labels a container was
+// started with.
+func (d *DockerContainers) Inspect(ctx context.Context, name string) (*Container, error) {
+	out, err := docker(ctx, "inspect", "-f", "{{json .Config}}", name)
+	if err != nil {
+		if isNoSuchContainer(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return parseContainerConfig(name, []byte(out))
+}
+
+// parseContainerConfig parses the .Config of docker inspect output.
+func parseContainerConfig(name string, data []byte) (*Container, error) {
+	var cfg struct {
+		Image  string
+		Cmd    []string
+		Env    []string
+		Labels map[string]string
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse docker inspect output: %w", err)
+	}
+
+	c := &Container{Name: name, Image: cfg.Image, Command: cfg.Cmd, Labels: cfg.Labels}
+	if len(cfg.Env) > 0 {
+		c.Env = make(map[string]string, len(cfg.Env))
+		for _, kv := range cfg.Env {
+			k, v, _ := strings.Cut(kv, "=")
+			c.Env[k] = v
+		}
+	}
+	return c, nil
+}
+
+// dockerRunArgs builds the docker run arguments for c. Env and labels are
+// sorted so the command line is stable.
+func dockerRunArgs(c Container) []string {
+	args := []string{"run", "-d", "--name", c.Name, "--network", "host"}
+	for _, k := range sortedKeys(c.Labels) {
+		args = append(args, "--label", k+"="+c.Labels[k])
+	}
+	for _, k := range sortedKeys(c.Env) {
+		args = append(args, "-e", k+"="+c.Env[k])
+	}
+	args = append(args, c.Image)
+	return append(args, c.Command...)
+}
+
+func docker(ctx context.Context, args ...string) (string, error) {
+	out, err := exec.CommandContext(ctx, "docker", args...).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("docker %s failed: %w: %s", args[0], err, strings.TrimSpace(string(out)))
+	}
+	return string(out), nil
+}
+
+func isNoSuchContainer(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "no such container") || strings.Contains(msg, "no such object")
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,39 @@
+// internal/project/docker_test.go
+package project
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDockerRunArgs(t *testing.T) {
+	args := dockerRunArgs(Container{
+		Name:    "dvb-ibc-relayer",
+		Image:   "hermes",
+		Command: []string{"start"},
+		Env:     map[string]string{"B": "2", "A": "1"},
+		Labels:  map[string]string{LabelProject: "ibc"},
+	})
+	assert.Equal(t, []string{
+		"run", "-d", "--name", "dvb-ibc-relayer", "--network", "host",
+		"--label", LabelProject + "=ibc",
+		"-e", "A=1", "-e", "B=2",
+		"hermes", "start",
+	}, args)
+}
+
+func TestParseContainerConfig(t *testing.T) {
+	c, err := parseContainerConfig("dvb-ibc-relayer", []byte(`{"Image":"hermes","Cmd":["start"],"Env":["A=1","B=x=y"],"Labels":{"devnet-builder.project":"ibc"}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &Container{
+		Name:    "dvb-ibc-relayer",
+		Image:   "hermes",
+		Command: []string{"start"},
+		Env:     map[string]string{"A": "1", "B": "x=y"},
+		Labels:  map[string]string{LabelProject: "ibc"},
+	}, c)
+
+	_, err = parseContainerConfig("x", []byte("not json"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,306 @@
+// internal/project/project.go
+
+// Package project brings a project's devnets, relayers and sidecars up and
+// down as one unit, in dependency order.
+package project
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"sort"
+	"strings"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/config"
+	"google.golang.org/protobuf/proto"
+)
+
+// LabelProject is the label set on a project's devnets and containers.
+const LabelProject = "devnet-builder.project"
+
+// StateAbsent is the state of a unit that does not exist.
+const StateAbsent = "Absent"
+
+// Devnets manages the devnets of a project.
+type Devnets interface {
+	// Apply creates or updates a devnet and waits until it is running. It
+	// reports whether the devnet was created, also when it then fails to
+	// come up.
+	Apply(ctx context.Context, namespace, name string, spec *v1.DevnetSpec, labels map[string]string) (bool, error)
+	// Delete deletes a devnet. Deleting a missing devnet is not an error.
+	Delete(ctx context.Context, namespace, name string) error
+	// Spec returns the spec and labels of a devnet, or a nil spec if it
+	// does not exist.
+	Spec(ctx context.Context, namespace, name string) (*v1.DevnetSpec, map[string]string, error)
+	// Phase returns the phase of a devnet, or StateAbsent.
+	Phase(ctx context.Context, namespace, name string) (string, error)
+}
+
+// Container is a relayer or sidecar container.
+type Container struct {
+	Name    string
+	Image   string
+	Command []string
+	Env     map[string]string
+	Labels  map[string]string
+}
+
+// Containers runs the relayers and sidecars of a project.
+type Containers interface {
+	// Run starts a container, replacing any container with the same name.
+	Run(ctx context.Context, c Container) error
+	// Remove removes a container. Removing a missing container is not an error.
+	Remove(ctx context.Context, name string) error
+	// Inspect returns the configuration of a container, or nil if it does
+	// not exist.
+	Inspect(ctx context.Context, name string) (*Container, error)
+	// State returns the state of a container, or StateAbsent.
+	State(ctx context.Context, name string) (string, error)
+}
+
+// UnitStatus is the state of a project unit.
+type UnitStatus struct {
+	Kind  string
+	Name  string
+	State string
+}
+
+// Manager brings a project up and down.
+type Manager struct {
+	project    *config.YAMLProject
+	namespace  string
+	devnets    Devnets
+	containers Containers
+	out        io.Writer
+}
+
+// NewManager creates a manager for project. Devnets are created in the
+// project's namespace, or in namespace if the project does not set one.
+func NewManager(project *config.YAMLProject, namespace string, devnets Devnets, containers Containers, out io.Writer) *Manager {
+	if project.Metadata.Namespace != "" {
+		namespace = project.Metadata.Namespace
+	}
+	if out == nil {
+		out = io.Discard
+	}
+	return &Manager{
+		project:    project,
+		namespace:  namespace,
+		devnets:    devnets,
+		containers: containers,
+		out:        out,
+	}
+}
+
+// change is a unit Up created or changed. Spec and labels hold the devnet
+// it updated, container the container it replaced; all are nil when Up
+// created the unit.
+type change struct {
+	unit      config.ProjectUnit
+	spec      *v1.DevnetSpec
+	labels    map[string]string
+	container *Container
+}
+
+// Up brings every unit up in dependency order. If a unit fails, the units
+// created by this call are removed again and the units it changed are
+// restored, in reverse order, so the project is either fully up or left as
+// it was.
+func (m *Manager) Up(ctx context.Context) error {
+	units, err := m.project.Units()
+	if err != nil {
+		return err
+	}
+
+	var changes []change
+	for _, u := range units {
+		fmt.Fprintf(m.out, "Starting %s %s...\n", u.Kind, u.Name)
+		c, err := m.up(ctx, u)
+		if c != nil {
+			changes = append(changes, *c)
+		}
+		if err != nil {
+			err = fmt.Errorf("%s %q failed: %w", u.Kind, u.Name, err)
+			if len(changes) > 0 {
+				fmt.Fprintf(m.out, "Rolling back %d unit(s)...\n", len(changes))
+				// Roll back even if ctx timed out.
+				if rbErr := m.undo(context.WithoutCancel(ctx), changes); rbErr != nil {
+					err = fmt.Errorf("%w (rollback: %v)", err, rbErr)
+				}
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Down removes every unit in reverse dependency order. It keeps going after
+// a failure and returns all errors.
+func (m *Manager) Down(ctx context.Context) error {
+	units, err := m.project.Units()
+	if err != nil {
+		return err
+	}
+	return m.down(ctx, units)
+}
+
+// Status returns the state of every unit in dependency order.
+func (m *Manager) Status(ctx context.Context) ([]UnitStatus, error) {
+	units, err := m.project.Units()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]UnitStatus, 0, len(units))
+	for _, u := range units {
+		var state string
+		if u.Kind == config.UnitDevnet {
+			state, err = m.devnets.Phase(ctx, m.namespace, u.Name)
+		} else {
+			state, err = m.containers.State(ctx, m.containerName(u.Name))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: %w", u.Kind, u.Name, err)
+		}
+		statuses = append(statuses, UnitStatus{Kind: u.Kind, Name: u.Name, State: state})
+	}
+	return statuses, nil
+}
+
+// down removes units in reverse order.
+func (m *Manager) down(ctx context.Context, units []config.ProjectUnit) error {
+	var errs []error
+	for i := len(units) - 1; i >= 0; i-- {
+		if err := m.remove(ctx, units[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// undo reverts changes in reverse order: created units are removed, changed
+// ones get their previous spec or container back.
+func (m *Manager) undo(ctx context.Context, changes []change) error {
+	var errs []error
+	for i := len(changes) - 1; i >= 0; i-- {
+		c := changes[i]
+		var err error
+		switch {
+		case c.spec != nil:
+			fmt.Fprintf(m.out, "Restoring %s %s...\n", c.unit.Kind, c.unit.Name)
+			_, err = m.devnets.Apply(ctx, m.namespace, c.unit.Name, c.spec, c.labels)
+		case c.container != nil:
+			fmt.Fprintf(m.out, "Restoring %s %s...\n", c.unit.Kind, c.unit.Name)
+			err = m.containers.Run(ctx, *c.container)
+		default:
+			err = m.remove(ctx, c.unit)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %q: %w", c.unit.Kind, c.unit.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// remove removes a single unit.
+func (m *Manager) remove(ctx context.Context, u config.ProjectUnit) error {
+	fmt.Fprintf(m.out, "Removing %s %s...\n", u.Kind, u.Name)
+	var err error
+	if u.Kind == config.UnitDevnet {
+		err = m.devnets.Delete(ctx, m.namespace, u.Name)
+	} else {
+		err = m.containers.Remove(ctx, m.containerName(u.Name))
+	}
+	if err != nil {
+		return fmt.Errorf("%s %q: %w", u.Kind, u.Name, err)
+	}
+	return nil
+}
+
+// up brings a single unit up. It returns the change it made, also when the
+// unit then failed to come up, or nil if it created or changed nothing.
+func (m *Manager) up(ctx context.Context, u config.ProjectUnit) (*change, error) {
+	if u.Kind == config.UnitDevnet {
+		return m.upDevnet(ctx, u)
+	}
+
+	c := m.container(u)
+	prev, err := m.containers.Inspect(ctx, c.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = m.containers.Run(ctx, c)
+	if err != nil && prev == nil {
+		// A container that failed to start may not have been created.
+		if state, stateErr := m.containers.State(ctx, c.Name); stateErr == nil && state == StateAbsent {
+			return nil, err
+		}
+	}
+	// Run replaces an existing container, so it changed even if it had the
+	// same configuration.
+	return &change{unit: u, container: prev}, err
+}
+
+// upDevnet creates or updates a devnet unit.
+func (m *Manager) upDevnet(ctx context.Context, u config.ProjectUnit) (*change, error) {
+	devnet := config.YAMLDevnet{Metadata: config.YAMLMetadata{Name: u.Name}, Spec: *u.Devnet}
+	spec, labels := devnet.ToProto().Spec, m.labels()
+
+	prevSpec, prevLabels, err := m.devnets.Spec(ctx, m.namespace, u.Name)
+	if err != nil {
+		return nil, err
+	}
+	created, err := m.devnets.Apply(ctx, m.namespace, u.Name, spec, labels)
+	switch {
+	case created:
+		return &change{unit: u}, err
+	case prevSpec != nil && (!proto.Equal(prevSpec, spec) || !maps.Equal(prevLabels, labels)):
+		return &change{unit: u, spec: prevSpec, labels: prevLabels}, err
+	}
+	return nil, err
+}
+
+// container builds the container of a relayer or sidecar unit. Besides its
+// own environment, it gets the project's name, namespace and devnets, and a
+// relayer the devnets it connects.
+func (m *Manager) container(u config.ProjectUnit) Container {
+	env := map[string]string{
+		"DVB_PROJECT":   m.project.Metadata.Name,
+		"DVB_NAMESPACE": m.namespace,
+		"DVB_DEVNETS":   strings.Join(m.devnetNames(), ","),
+	}
+	if u.Kind == config.UnitRelayer {
+		env["DVB_CHAINS"] = strings.Join(u.Container.Chains, ",")
+	}
+	for k, v := range u.Container.Env {
+		env[k] = v
+	}
+
+	return Container{
+		Name:    m.containerName(u.Name),
+		Image:   u.Container.Image,
+		Command: u.Container.Command,
+		Env:     env,
+		Labels:  m.labels(),
+	}
+}
+
+func (m *Manager) devnetNames() []string {
+	names := make([]string, 0, len(m.project.Spec.Devnets))
+	for _, d := range m.project.Spec.Devnets {
+		names = append(names, d.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (m *Manager) containerName(unit string) string {
+	return fmt.Sprintf("dvb-%s-%s", m.project.Metadata.Name, unit)
+}
+
+func (m *Manager) labels() map[string]string {
+	return map[string]string{LabelProject: m.project.Metadata.Name}
+}
@@ -0,0 +1,203 @@
+// internal/project/project_test.go
+package project
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBackend implements Devnets and Containers, recording calls in order.
+// The unit named failOn fails once, without being created.
+type fakeBackend struct {
+	calls      []string
+	specs      map[string]*v1.DevnetSpec
+	containers map[string]*Container
+	failOn     string
+	states     map[string]string
+	lastSpec   map[string]*v1.DevnetSpec
+	lastEnv    map[string]map[string]string
+}
+
+func (f *fakeBackend) fail(name string) bool {
+	if name != f.failOn {
+		return false
+	}
+	f.failOn = ""
+	return true
+}
+
+func (f *fakeBackend) Apply(_ context.Context, namespace, name string, spec *v1.DevnetSpec, labels map[string]string) (bool, error) {
+	f.calls = append(f.calls, "apply "+namespace+"/"+name)
+	if f.fail(name) {
+		return false, errors.New("boom")
+	}
+	if f.lastSpec == nil {
+		f.lastSpec = make(map[string]*v1.DevnetSpec)
+	}
+	f.lastSpec[name] = spec
+	return f.specs[name] == nil, nil
+}
+
+func (f *fakeBackend) Spec(_ context.Context, _, name string) (*v1.DevnetSpec, map[string]string, error) {
+	if spec := f.specs[name]; spec != nil {
+		return spec, map[string]string{LabelProject: "ibc"}, nil
+	}
+	return nil, nil, nil
+}
+
+func (f *fakeBackend) Delete(_ context.Context, namespace, name string) error {
+	f.calls = append(f.calls, "delete "+namespace+"/"+name)
+	return nil
+}
+
+func (f *fakeBackend) Phase(_ context.Context, _, name string) (string, error) {
+	if s, ok := f.states[name]; ok {
+		return s, nil
+	}
+	return StateAbsent, nil
+}
+
+func (f *fakeBackend) Run(_ context.Context, c Container) error {
+	f.calls = append(f.calls, "run "+c.Name)
+	if f.fail(strings.TrimPrefix(c.Name, "dvb-ibc-")) {
+		return errors.New("boom")
+	}
+	if f.lastEnv == nil {
+		f.lastEnv = make(map[string]map[string]string)
+	}
+	f.lastEnv[c.Name] = c.Env
+	return nil
+}
+
+func (f *fakeBackend) Inspect(_ context.Context, name string) (*Container, error) {
+	return f.containers[name], nil
+}
+
+func (f *fakeBackend) Remove(_ context.Context, name string) error {
+	f.calls = append(f.calls, "remove "+name)
+	return nil
+}
+
+func (f *fakeBackend) State(_ context.Context, name string) (string, error) {
+	if s, ok := f.states[name]; ok {
+		return s, nil
+	}
+	return StateAbsent, nil
+}
+
+// testDevnetSpec is the spec Up applies for the devnets of testProject.
+func testDevnetSpec(t *testing.T, name string) *v1.DevnetSpec {
+	t.Helper()
+	for _, d := range testProject().Spec.Devnets {
+		if d.Name == name {
+			devnet := config.YAMLDevnet{Metadata: config.YAMLMetadata{Name: name}, Spec: *d.Spec}
+			return devnet.ToProto().Spec
+		}
+	}
+	t.Fatalf("no devnet %q", name)
+	return nil
+}
+
+func testProject() *config.YAMLProject {
+	spec := &config.YAMLDevnetSpec{Network: "stable", Validators: 1}
+	return &config.YAMLProject{
+		APIVersion: config.SupportedAPIVersion,
+		Kind:       config.ProjectKind,
+		Metadata:   config.YAMLMetadata{Name: "ibc"},
+		Spec: config.YAMLProjectSpec{
+			Devnets: []config.YAMLProjectDevnet{
+				{Name: "a", Spec: spec},
+				{Name: "b", Spec: spec, DependsOn: []string{"a"}},
+			},
+			Relayers: []config.YAMLProjectContainer{
+				{Name: "relayer", Image: "hermes", Chains: []string{"a", "b"}, Env: map[string]string{"RUST_LOG": "info"}},
+			},
+		},
+	}
+}
+
+func TestManager_Up(t *testing.T) {
+	backend := &fakeBackend{}
+	m := NewManager(testProject(), "default", backend, backend, nil)
+
+	require.NoError(t, m.Up(context.Background()))
+	assert.Equal(t, []string{"apply default/a", "apply default/b", "run dvb-ibc-relayer"}, backend.calls)
+
+	env := backend.lastEnv["dvb-ibc-relayer"]
+	assert.Equal(t, "a,b", env["DVB_CHAINS"])
+	assert.Equal(t, "ibc", env["DVB_PROJECT"])
+	assert.Equal(t, "info", env["RUST_LOG"])
+}
+
+func TestManager_UpRollsBackCreatedUnits(t *testing.T) {
+	// a already exists unchanged and the relayer never got created, so only
+	// b is removed.
+	backend := &fakeBackend{specs: map[string]*v1.DevnetSpec{"a": testDevnetSpec(t, "a")}, failOn: "relayer"}
+	m := NewManager(testProject(), "default", backend, backend, nil)
+
+	err := m.Up(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `relayer "relayer" failed`)
+	assert.Equal(t, []string{
+		"apply default/a",
+		"apply default/b",
+		"run dvb-ibc-relayer",
+		"delete default/b",
+	}, backend.calls)
+}
+
+func TestManager_UpRestoresChangedUnits(t *testing.T) {
+	// a is updated and the relayer replaced, so both get their previous
+	// spec and container back.
+	oldSpec := testDevnetSpec(t, "a")
+	oldSpec.Validators = 3
+	oldRelayer := &Container{Name: "dvb-ibc-relayer", Image: "hermes:old", Env: map[string]string{"RUST_LOG": "debug"}}
+	backend := &fakeBackend{
+		specs:      map[string]*v1.DevnetSpec{"a": oldSpec},
+		containers: map[string]*Container{"dvb-ibc-relayer": oldRelayer},
+		failOn:     "relayer",
+	}
+	m := NewManager(testProject(), "default", backend, backend, nil)
+
+	require.Error(t, m.Up(context.Background()))
+	assert.Equal(t, []string{
+		"apply default/a",
+		"apply default/b",
+		"run dvb-ibc-relayer",
+		"run dvb-ibc-relayer",
+		"delete default/b",
+		"apply default/a",
+	}, backend.calls)
+	assert.Same(t, oldSpec, backend.lastSpec["a"])
+	assert.Equal(t, oldRelayer.Env, backend.lastEnv["dvb-ibc-relayer"])
+}
+
+func TestManager_DownReversesOrder(t *testing.T) {
+	project := testProject()
+	project.Metadata.Namespace = "team"
+	backend := &fakeBackend{}
+	m := NewManager(project, "default", backend, backend, nil)
+
+	require.NoError(t, m.Down(context.Background()))
+	assert.Equal(t, []string{"remove dvb-ibc-relayer", "delete team/b", "delete team/a"}, backend.calls)
+}
+
+func TestManager_Status(t *testing.T) {
+	backend := &fakeBackend{states: map[string]string{"a": "Running", "dvb-ibc-relayer": "running"}}
+	m := NewManager(testProject(), "default", backend, backend, nil)
+
+	statuses, err := m.Status(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []UnitStatus{
+		{Kind: config.UnitDevnet, Name: "a", State: "Running"},
+		{Kind: config.UnitDevnet, Name: "b", State: StateAbsent},
+		{Kind: config.UnitRelayer, Name: "relayer", State: "running"},
+	}, statuses)
+}
@@ -0,0 +1,221 @@
+// Package redact masks secrets in text before it leaves the process: daemon
+// logs, CLI output, event messages and debug bundles. It recognizes BIP39
+// mnemonics, base64 ed25519 private keys, PEM-armored private keys, GitHub
+// tokens, credentials embedded in URLs, and values of keys that name a
+// secret (password, token, api_key, mnemonic, priv_key, ...).
+package redact
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	bip39 "github.com/cosmos/go-bip39"
+)
+
+// Placeholder replaces every masked secret.
+const Placeholder = "REDACTED"
+
+// minMnemonicWords is the shortest run of BIP39 words masked as a mnemonic.
+// BIP39 mnemonics have 12 to 24 words; prose rarely strings together 12
+// words that are all in the list.
+const minMnemonicWords = 12
+
+var (
+	// assignment matches a key followed by its value, in TOML
+	// ("key = value"), JSON ("key": value), YAML, env and logfmt
+	// (key=value) syntax. Only values of keys that name a secret are
+	// masked; see isSecretKey.
+	assignment = regexp.MustCompile(`("?([\w.-]+)"?\s*[:=]\s*)("(?:[^"\\]|\\.)*"|'[^']*'|[^\s,{}\[\]"']+)`)
+
+	// urlCredentials matches the user info of a URL.
+	urlCredentials = regexp.MustCompile(`(://)[^/\s:@]+:[^/\s@]+@`)
+
+	// githubToken matches GitHub personal access, OAuth, app and refresh
+	// tokens.
+	githubToken = regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,255}|github_pat_[A-Za-z0-9_]{22,255})\b`)
+
+	// ed25519Key matches the base64-encoded 64-byte ed25519 private key of
+	// a "priv_key" object, as in CometBFT's priv_validator_key.json and
+	// node_key.json. Bare base64 of that length is left alone, since
+	// signatures look the same.
+	ed25519Key = regexp.MustCompile(`(?is)((?:priv[_a-z]*key"\s*:\s*\{|PrivKeyEd25519")[^{}]*?"value"\s*:\s*")[A-Za-z0-9+/]{86}==`)
+
+	// pemPrivateKey matches a PEM-armored private key, such as the output
+	// of "keys export".
+	pemPrivateKey = regexp.MustCompile(`(?s)-----BEGIN [A-Z0-9 ]*PRIVATE KEY-----.*?-----END [A-Z0-9 ]*PRIVATE KEY-----`)
+
+	// word matches a candidate mnemonic word.
+	word = regexp.MustCompile(`[a-z]+`)
+)
+
+// String returns s with secrets replaced by Placeholder.
+func String(s string) string {
+	if s == "" {
+		return s
+	}
+	s = pemPrivateKey.ReplaceAllString(s, Placeholder)
+	s = ed25519Key.ReplaceAllString(s, "${1}"+Placeholder)
+	s = githubToken.ReplaceAllString(s, Placeholder)
+	s = redactMnemonics(s)
+	s = redactAssignments(s)
+	return urlCredentials.ReplaceAllString(s, "${1}"+Placeholder+"@")
+}
+
+// Bytes returns b with secrets replaced by Placeholder.
+func Bytes(b []byte) []byte {
+	return []byte(String(string(b)))
+}
+
+// redactAssignments masks the values of assignments to keys that name a
+// secret, keeping their quoting. The value of any other assignment is
+// searched too, so "password=x" inside a quoted log message is found.
+func redactAssignments(s string) string {
+	var sb strings.Builder
+	last := 0
+	for pos := 0; pos < len(s); {
+		m := assignment.FindStringSubmatchIndex(s[pos:])
+		if m == nil {
+			break
+		}
+		valueStart, valueEnd := pos+m[6], pos+m[7]
+		key, value := s[pos+m[4]:pos+m[5]], s[valueStart:valueEnd]
+		if !isSecretKey(key) || value == `""` || value == "''" {
+			pos = valueStart
+			continue
+		}
+
+		sb.WriteString(s[last:valueStart])
+		switch {
+		case strings.HasPrefix(value, `"`):
+			sb.WriteString(`"` + Placeholder + `"`)
+		case strings.HasPrefix(value, "'"):
+			sb.WriteString("'" + Placeholder + "'")
+		default:
+			sb.WriteString(Placeholder)
+		}
+		last, pos = valueEnd, valueEnd
+	}
+
+	if last == 0 {
+		return s
+	}
+	sb.WriteString(s[last:])
+	return sb.String()
+}
+
+// redactMnemonics masks runs of at least minMnemonicWords BIP39 words
+// separated only by whitespace.
+func redactMnemonics(s string) string {
+	matches := word.FindAllStringIndex(s, -1)
+	if len(matches) < minMnemonicWords {
+		return s
+	}
+
+	var sb strings.Builder
+	last := 0
+	flush := func(run [][]int) {
+		if len(run) < minMnemonicWords {
+			return
+		}
+		start, end := run[0][0], run[len(run)-1][1]
+		sb.WriteString(s[last:start])
+		sb.WriteString(Placeholder)
+		last = end
+	}
+
+	var run [][]int
+	for _, m := range matches {
+		isWord := isBIP39Word(s, m)
+		if isWord && len(run) > 0 && strings.TrimSpace(s[run[len(run)-1][1]:m[0]]) == "" {
+			run = append(run, m)
+			continue
+		}
+		flush(run)
+		run = nil
+		if isWord {
+			run = [][]int{m}
+		}
+	}
+	flush(run)
+
+	if last == 0 {
+		return s
+	}
+	sb.WriteString(s[last:])
+	return sb.String()
+}
+
+// isBIP39Word reports whether the match m of s is a whole BIP39 word, not
+// part of a longer identifier.
+func isBIP39Word(s string, m []int) bool {
+	if m[0] > 0 && isIdentByte(s[m[0]-1]) || m[1] < len(s) && isIdentByte(s[m[1]]) {
+		return false
+	}
+	_, ok := bip39.ReverseWordMap[s[m[0]:m[1]]]
+	return ok
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'A' && c <= 'Z'
+}
+
+// secretKeyWords are the last words of keys that name a secret, e.g.
+// "password", "github_token", "apiKey" or "node.priv-key".
+var secretKeyWords = [][]string{
+	{"password"}, {"passphrase"}, {"passwd"}, {"secret"}, {"token"},
+	{"mnemonic"}, {"apikey"}, {"privkey"},
+	{"api", "key"}, {"private", "key"}, {"priv", "key"}, {"seed", "phrase"},
+}
+
+// isSecretKey reports whether a key names a secret: it is one
+// of secretKeyWords or ends in one, split on '_', '-', '.' and camel case.
+// Keys that merely contain a word, such as "max_tokens" or "secretsDir",
+// do not.
+func isSecretKey(key string) bool {
+	words := keyWords(key)
+	for _, suffix := range secretKeyWords {
+		if len(words) < len(suffix) {
+			continue
+		}
+		tail := words[len(words)-len(suffix):]
+		match := true
+		for i := range suffix {
+			if tail[i] != suffix[i] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// keyWords splits a key into lowercase words.
+func keyWords(key string) []string {
+	var words []string
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+	}
+	prev := rune(0)
+	for _, r := range key {
+		switch {
+		case r == '_' || r == '-' || r == '.':
+			flush()
+		case unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev)):
+			flush()
+			word.WriteRune(unicode.ToLower(r))
+		default:
+			word.WriteRune(unicode.ToLower(r))
+		}
+		prev = r
+	}
+	flush()
+	return words
+}
@@ -0,0 +1,90 @@
+package redact
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// Handler is a slog.Handler that redacts the message and the string, error
+// and other non-scalar attribute values of records before passing them on.
+type Handler struct {
+	next slog.Handler
+}
+
+// NewHandler wraps next so that everything it logs is redacted.
+func NewHandler(next slog.Handler) *Handler {
+	return &Handler{next: next}
+}
+
+// Enabled implements slog.Handler.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	out := slog.NewRecord(r.Time, r.Level, String(r.Message), r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(redactAttr(a))
+		return true
+	})
+	return h.next.Handle(ctx, out)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = redactAttr(a)
+	}
+	return &Handler{next: h.next.WithAttrs(redacted)}
+}
+
+// WithGroup implements slog.Handler.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{next: h.next.WithGroup(name)}
+}
+
+// redactAttr redacts an attribute's value. Strings, errors and other
+// values that are not numbers, booleans, times or durations are logged as
+// their redacted text; under a key that names a secret they are masked
+// whole.
+func redactAttr(a slog.Attr) slog.Attr {
+	v := a.Value.Resolve()
+	switch v.Kind() {
+	case slog.KindString:
+		return redactText(a.Key, v.String())
+	case slog.KindGroup:
+		group := v.Group()
+		redacted := make([]any, len(group))
+		for i, ga := range group {
+			redacted[i] = redactAttr(ga)
+		}
+		return slog.Group(a.Key, redacted...)
+	case slog.KindAny:
+		switch x := v.Any().(type) {
+		case nil:
+		case error:
+			return redactText(a.Key, x.Error())
+		case fmt.Stringer:
+			return redactText(a.Key, x.String())
+		case []byte:
+			return redactText(a.Key, string(x))
+		default:
+			// %+v names struct fields, so a Password field reads as an
+			// assignment
+			return redactText(a.Key, fmt.Sprintf("%+v", x))
+		}
+	}
+	return slog.Attr{Key: a.Key, Value: v}
+}
+
+// redactText returns a string attribute holding text redacted, or masked
+// whole if key names a secret.
+func redactText(key, text string) slog.Attr {
+	if text != "" && isSecretKey(key) {
+		return slog.String(key, Placeholder)
+	}
+	return slog.String(key, String(text))
+}
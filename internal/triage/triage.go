@@ -0,0 +1,270 @@
+// Package triage maps common devnet failures to remediation steps.
+//
+// Each Entry has a stable code (e.g., "port-in-use") that users can look up
+// with `dvb explain <code>`, and a set of patterns matched against error
+// output so the CLI can suggest a fix right after a command fails.
+package triage
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// docsBase is where the troubleshooting guide is published.
+const docsBase = "https://github.com/altuslabsxyz/devnet-builder/blob/main/docs/troubleshooting.md"
+
+// Entry is a known failure and how to fix it.
+type Entry struct {
+	// Code is the stable identifier used by `dvb explain`.
+	Code string
+	// Title is a one-line summary of the failure.
+	Title string
+	// Cause explains why the failure happens.
+	Cause string
+	// Steps are remediation steps, in the order to try them.
+	Steps []string
+	// Links point to further documentation.
+	Links []string
+
+	patterns []*regexp.Regexp
+}
+
+// Matches reports whether text looks like this failure.
+func (e *Entry) Matches(text string) bool {
+	for _, p := range e.patterns {
+		if p.MatchString(text) {
+			return true
+		}
+	}
+	return false
+}
+
+func patterns(exprs ...string) []*regexp.Regexp {
+	out := make([]*regexp.Regexp, 0, len(exprs))
+	for _, expr := range exprs {
+		out = append(out, regexp.MustCompile("(?i)"+expr))
+	}
+	return out
+}
+
+// entries is the knowledge base. Match returns the first matching entry, so
+// more specific entries come before general ones.
+var entries = []*Entry{
+	{
+		Code:  "docker-permission-denied",
+		Title: "Permission denied on the Docker socket",
+		Cause: "The current user is not allowed to talk to the Docker daemon.",
+		Steps: []string{
+			"Add your user to the docker group: sudo usermod -aG docker $USER",
+			"Log out and back in (or run: newgrp docker)",
+			"Verify with: docker run hello-world",
+		},
+		Links:    []string{docsBase + "#docker-not-running"},
+		patterns: patterns(`permission denied.*docker(\.sock| daemon socket)`),
+	},
+	{
+		Code:  "docker-not-running",
+		Title: "Docker is not running",
+		Cause: "Docker mode needs a running Docker daemon, and none answered on its socket.",
+		Steps: []string{
+			"Linux: sudo systemctl start docker",
+			"macOS: open -a Docker and wait for it to finish starting",
+			"Verify with: docker info",
+			"Or provision without Docker: dvb provision --mode local",
+		},
+		Links: []string{docsBase + "#docker-not-running"},
+		patterns: patterns(
+			`cannot connect to the docker daemon`,
+			`is the docker daemon running`,
+			`docker: command not found`,
+			`docker.*executable file not found`,
+		),
+	},
+	{
+		Code:  "port-in-use",
+		Title: "A node port is already in use",
+		Cause: "Another process, often a previous devnet or a local node, is bound to a port the devnet needs.",
+		Steps: []string{
+			"Find the process: lsof -i :26657 (use the port from the error)",
+			"Check for another devnet: dvb list",
+			"Delete it if you no longer need it: dvb delete <devnet>",
+			"Or kill the process holding the port",
+		},
+		Links: []string{docsBase + "#port-conflicts", docsBase + "#port-configuration"},
+		patterns: patterns(
+			`address already in use`,
+			`port is already allocated`,
+			`bind: .*in use`,
+		),
+	},
+	{
+		Code:  "daemon-not-running",
+		Title: "The devnetd daemon is not reachable",
+		Cause: "dvb talks to devnetd over a Unix socket (or a remote address), and nothing is listening there.",
+		Steps: []string{
+			"Start the daemon in the background: dvb daemon start (or run devnetd in a terminal)",
+			"To have dvb start it automatically: dvb config set autostart true",
+			"Check it is up: dvb daemon status",
+			"If using a remote server, check --server and your ~/.dvb/config.yaml",
+		},
+		Links: []string{docsBase + "#daemon-connection-issues"},
+		patterns: patterns(
+			`daemon not running`,
+			`devnetd\.sock.*(connection refused|no such file)`,
+		),
+	},
+	{
+		Code:  "plugin-handshake",
+		Title: "Plugin handshake failed",
+		Cause: "The network plugin binary started but did not speak the protocol devnetd expects. It is usually built against a different devnet-builder version, or it crashed on startup.",
+		Steps: []string{
+			"Rebuild the plugin against the installed devnet-builder version",
+			"Run the plugin binary directly to see whether it crashes on startup",
+			"List installed plugins: dvb daemon plugins list",
+		},
+		Links: []string{"https://github.com/altuslabsxyz/devnet-builder/blob/main/docs/plugins.md"},
+		patterns: patterns(
+			`incompatible api version with plugin`,
+			`unrecognized remote plugin message`,
+			`plugin exited before we could connect`,
+			`timeout while waiting for plugin to start`,
+			`plugin.*handshake`,
+		),
+	},
+	{
+		Code:  "plugin-not-found",
+		Title: "Network plugin not found",
+		Cause: "No installed plugin provides the requested network.",
+		Steps: []string{
+			"List installed plugins: dvb daemon plugins list",
+			"Check the spelling of --network (or spec.network in YAML)",
+			"Install the plugin from its GitHub releases: dvb plugins install <owner>/<repo>[@version]",
+		},
+		Links:    []string{"https://github.com/altuslabsxyz/devnet-builder/blob/main/docs/plugins.md"},
+		patterns: patterns(`plugin .*not found`, `unknown (network|plugin)`),
+	},
+	{
+		Code:  "genesis-override-path",
+		Title: "Genesis override targets a path that does not exist",
+		Cause: "Genesis overrides may only change existing fields, so typos in a path are caught instead of adding an ignored field.",
+		Steps: []string{
+			"Inspect the devnet's genesis.json in its data directory under ~/.devnet-builder",
+			"Fix the path in --genesis-override or spec.genesisOverrides",
+			"Array elements are addressed by index, e.g. app_state.bank.balances[0]",
+		},
+		patterns: patterns(
+			`does not exist in genesis`,
+			`is a scalar and has no field`,
+			`is an (array, not an object|object, not an array)`,
+		),
+	},
+	{
+		Code:  "genesis-supply-mismatch",
+		Title: "Genesis bank supply does not match balances",
+		Cause: "The bank module requires the total supply to equal the sum of all balances. Editing balances by hand (or with overrides) without updating supply breaks this.",
+		Steps: []string{
+			"Fund accounts with spec.fundedAccounts instead of editing balances; supply is updated for you",
+			"If overriding balances, also override app_state.bank.supply to the new total",
+		},
+		patterns: patterns(`genesis supply is incorrect`, `supply.*(mismatch|does not match)`),
+	},
+	{
+		Code:  "genesis-invalid-account",
+		Title: "Genesis contains an invalid or duplicate account",
+		Cause: "An account address does not decode with the chain's bech32 prefix, or the same address appears twice.",
+		Steps: []string{
+			"Use addresses with the chain's bech32 prefix, or 0x addresses on EVM chains",
+			"Remove duplicate entries from spec.fundedAccounts",
+		},
+		patterns: patterns(
+			`duplicate (account|address|genesis account)`,
+			`invalid address`,
+			`decoding bech32 failed`,
+			`account .*already exists`,
+		),
+	},
+	{
+		Code:  "genesis-invalid-coins",
+		Title: "Genesis contains invalid coins",
+		Cause: "A coin amount or denom is malformed, zero, negative, or not sorted by denom.",
+		Steps: []string{
+			"Write coins as <amount><denom>, e.g. 1000000ustake,500uatom",
+			"Amounts must be positive integers; each denom may appear once",
+		},
+		patterns: patterns(`invalid coins?`, `coins? .*not sorted`, `zero amount`),
+	},
+	{
+		Code:  "genesis-validation",
+		Title: "Genesis failed validation",
+		Cause: "The plugin rejected the final genesis. The message after \"genesis validation failed\" names the module and field.",
+		Steps: []string{
+			"Read the module named in the error (e.g. gov, staking, bank)",
+			"Review any genesis overrides or funded accounts that touch that module",
+			"Reprovision without overrides to confirm the base genesis is valid",
+		},
+		Links:    []string{docsBase + "#genesis-export-failed"},
+		patterns: patterns(`genesis validation failed`, `invalid genesis`, `failed to validate genesis`),
+	},
+	{
+		Code:  "snapshot-version-required",
+		Title: "Snapshot mode needs an explicit binary version",
+		Cause: "State from a snapshot only loads with a binary matching its schema, so the version cannot be guessed.",
+		Steps: []string{
+			"Pass the chain's binary version: dvb provision --binary-version <version>",
+		},
+		Links:    []string{docsBase + "#snapshot-download-failed"},
+		patterns: patterns(`snapshot mode requires explicit binary version`),
+	},
+	{
+		Code:  "offline-cache-miss",
+		Title: "Required artifact is not cached for offline mode",
+		Cause: "Offline mode only uses local caches, and a binary, snapshot, genesis or image is missing from them.",
+		Steps: []string{
+			"Provision once online with the same spec to fill the caches",
+			"Or pull/load the missing Docker image, or pass a local binary",
+		},
+		patterns: patterns(`not available offline`, `binary not in cache`, `not available locally`),
+	},
+	{
+		Code:  "disk-full",
+		Title: "Out of disk space",
+		Cause: "Snapshots, exports and node data can use many gigabytes.",
+		Steps: []string{
+			"Check free space: df -h ~/.devnet-builder",
+			"Delete devnets you no longer need: dvb delete <devnet>",
+			"Clear cached snapshots and exports under ~/.devnet-builder",
+		},
+		Links:    []string{docsBase + "#insufficient-disk-space"},
+		patterns: patterns(`no space left on device`),
+	},
+}
+
+// Match returns the first entry whose patterns match text, or nil.
+func Match(text string) *Entry {
+	for _, e := range entries {
+		if e.Matches(text) {
+			return e
+		}
+	}
+	return nil
+}
+
+// Lookup returns the entry with the given code.
+func Lookup(code string) (*Entry, bool) {
+	code = strings.ToLower(strings.TrimSpace(code))
+	for _, e := range entries {
+		if e.Code == code {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// Entries returns all entries sorted by code.
+func Entries() []*Entry {
+	out := make([]*Entry, len(entries))
+	copy(out, entries)
+	sort.Slice(out, func(i, j int) bool { return out[i].Code < out[j].Code })
+	return out
+}
@@ -0,0 +1,62 @@
+package triage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		output string
+		code   string
+	}{
+		{"Cannot connect to the Docker daemon at unix:///var/run/docker.sock. Is the docker daemon running?", "docker-not-running"},
+		{"permission denied while trying to connect to the Docker daemon socket at unix:///var/run/docker.sock", "docker-permission-denied"},
+		{"listen tcp 0.0.0.0:26657: bind: address already in use", "port-in-use"},
+		{"Bind for 0.0.0.0:8545 failed: port is already allocated", "port-in-use"},
+		{"daemon not running - start with: devnetd", "daemon-not-running"},
+		{"failed to load plugin: Incompatible API version with plugin. Plugin version: 1, Client versions: [2]", "plugin-handshake"},
+		{"failed to apply genesis overrides: app_state.gov.parms does not exist in genesis", "genesis-override-path"},
+		{"genesis validation failed after overrides: genesis supply is incorrect, expected 100stake, got 90stake", "genesis-supply-mismatch"},
+		{"account 0 (cosmos1xyz): invalid address: decoding bech32 failed", "genesis-invalid-account"},
+		{"genesis validation failed: gov: voting period must be positive", "genesis-validation"},
+		{"write /data/node0/data/blockstore.db: no space left on device", "disk-full"},
+	}
+
+	for _, tt := range tests {
+		entry := Match(tt.output)
+		if assert.NotNil(t, entry, tt.output) {
+			assert.Equal(t, tt.code, entry.Code, tt.output)
+		}
+	}
+
+	assert.Nil(t, Match("devnet \"foo\" not found in namespace default"))
+}
+
+func TestLookup(t *testing.T) {
+	entry, ok := Lookup(" Port-In-Use ")
+	require.True(t, ok)
+	assert.Equal(t, "port-in-use", entry.Code)
+
+	_, ok = Lookup("no-such-code")
+	assert.False(t, ok)
+}
+
+func TestEntries(t *testing.T) {
+	all := Entries()
+	require.NotEmpty(t, all)
+
+	seen := make(map[string]bool)
+	for i, e := range all {
+		assert.False(t, seen[e.Code], "duplicate code %s", e.Code)
+		seen[e.Code] = true
+		assert.NotEmpty(t, e.Title, e.Code)
+		assert.NotEmpty(t, e.Steps, e.Code)
+		assert.NotEmpty(t, e.patterns, e.Code)
+		if i > 0 {
+			assert.Less(t, all[i-1].Code, e.Code)
+		}
+	}
+}
@@ -0,0 +1,391 @@
+// internal/tui/views/dashboard.go
+package views
+
+import (
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+	"github.com/altuslabsxyz/devnet-builder/internal/tui"
+)
+
+// Message types for dashboard view
+
+// WatchResetMsg is sent when the watch stream (re)connects, before the
+// snapshot of current devnets and nodes.
+type WatchResetMsg struct{}
+
+// WatchEventMsg carries a devnet or node change from the watch stream.
+type WatchEventMsg struct {
+	Event *v1.WatchDevnetsResponse
+}
+
+// WatchErrorMsg is sent when the watch stream fails.
+type WatchErrorMsg struct {
+	Error error
+}
+
+// actionDoneMsg reports the result of a key-bound action.
+type actionDoneMsg struct {
+	message string
+	err     error
+}
+
+// DashboardActions are the operations bound to dashboard keys. Logs and
+// Describe return a command run in the foreground with the dashboard
+// suspended.
+type DashboardActions struct {
+	Start    func(namespace, name string) error
+	Stop     func(namespace, name string) error
+	Logs     func(namespace, name string) *exec.Cmd
+	Describe func(namespace, name string) *exec.Cmd
+}
+
+// dashboardEventLimit is the number of recent events shown.
+const dashboardEventLimit = 8
+
+// DashboardModel is the TUI model for the dashboard command
+type DashboardModel struct {
+	Namespace string
+
+	actions DashboardActions
+	devnets map[string]*v1.Devnet
+	nodes   map[string]map[int32]*v1.Node // by devnet key, then index
+	cursor  int
+	message string
+	err     error
+	live    bool
+	width   int
+	height  int
+}
+
+// NewDashboardModel creates a new dashboard TUI model
+func NewDashboardModel(namespace string, actions DashboardActions) DashboardModel {
+	return DashboardModel{
+		Namespace: namespace,
+		actions:   actions,
+		devnets:   make(map[string]*v1.Devnet),
+		nodes:     make(map[string]map[int32]*v1.Node),
+		width:     80,
+		height:    24,
+	}
+}
+
+// Init implements tea.Model
+func (m DashboardModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+
+	case WatchResetMsg:
+		m.devnets = make(map[string]*v1.Devnet)
+		m.nodes = make(map[string]map[int32]*v1.Node)
+		m.live = true
+		m.err = nil
+
+	case WatchEventMsg:
+		m.applyEvent(msg.Event)
+
+	case WatchErrorMsg:
+		m.live = false
+		m.err = msg.Error
+
+	case actionDoneMsg:
+		m.message = msg.message
+		m.err = msg.err
+	}
+
+	return m, nil
+}
+
+func (m DashboardModel) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c", "esc":
+		return m, tea.Quit
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+		return m, nil
+	case "down", "j":
+		if m.cursor < len(m.devnets)-1 {
+			m.cursor++
+		}
+		return m, nil
+	}
+
+	devnet := m.Selected()
+	if devnet == nil {
+		return m, nil
+	}
+	ns, name := devnet.GetMetadata().GetNamespace(), devnet.GetMetadata().GetName()
+
+	switch msg.String() {
+	case "s":
+		if m.actions.Start == nil {
+			return m, nil
+		}
+		m.message = fmt.Sprintf("Starting %s...", name)
+		return m, func() tea.Msg {
+			if err := m.actions.Start(ns, name); err != nil {
+				return actionDoneMsg{err: fmt.Errorf("start %s: %w", name, err)}
+			}
+			return actionDoneMsg{message: fmt.Sprintf("Started %s", name)}
+		}
+	case "x":
+		if m.actions.Stop == nil {
+			return m, nil
+		}
+		m.message = fmt.Sprintf("Stopping %s...", name)
+		return m, func() tea.Msg {
+			if err := m.actions.Stop(ns, name); err != nil {
+				return actionDoneMsg{err: fmt.Errorf("stop %s: %w", name, err)}
+			}
+			return actionDoneMsg{message: fmt.Sprintf("Stopped %s", name)}
+		}
+	case "l":
+		if m.actions.Logs == nil {
+			return m, nil
+		}
+		return m, tea.ExecProcess(m.actions.Logs(ns, name), execDone("logs"))
+	case "d":
+		if m.actions.Describe == nil {
+			return m, nil
+		}
+		return m, tea.ExecProcess(m.actions.Describe(ns, name), execDone("describe"))
+	}
+	return m, nil
+}
+
+// execDone reports a failed foreground command.
+func execDone(what string) tea.ExecCallback {
+	return func(err error) tea.Msg {
+		if err != nil {
+			return actionDoneMsg{err: fmt.Errorf("%s: %w", what, err)}
+		}
+		return actionDoneMsg{}
+	}
+}
+
+// applyEvent applies a watch event. Events may arrive out of order, so a
+// modification older than the stored resource is ignored.
+func (m *DashboardModel) applyEvent(event *v1.WatchDevnetsResponse) {
+	if d := event.GetDevnet(); d != nil {
+		key := devnetKey(d.GetMetadata().GetNamespace(), d.GetMetadata().GetName())
+		if event.GetType() == "DELETED" {
+			delete(m.devnets, key)
+			delete(m.nodes, key)
+			m.clampCursor()
+			return
+		}
+		if old, ok := m.devnets[key]; ok && old.GetMetadata().GetGeneration() > d.GetMetadata().GetGeneration() {
+			return
+		}
+		m.devnets[key] = d
+		return
+	}
+
+	if n := event.GetNode(); n != nil {
+		key := devnetKey(n.GetMetadata().GetNamespace(), n.GetMetadata().GetDevnetName())
+		index := n.GetMetadata().GetIndex()
+		if event.GetType() == "DELETED" {
+			delete(m.nodes[key], index)
+			return
+		}
+		if m.nodes[key] == nil {
+			m.nodes[key] = make(map[int32]*v1.Node)
+		}
+		if old, ok := m.nodes[key][index]; ok && old.GetMetadata().GetGeneration() > n.GetMetadata().GetGeneration() {
+			return
+		}
+		m.nodes[key][index] = n
+	}
+}
+
+func (m *DashboardModel) clampCursor() {
+	if m.cursor >= len(m.devnets) {
+		m.cursor = len(m.devnets) - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+}
+
+func devnetKey(namespace, name string) string {
+	if namespace == "" {
+		namespace = "default"
+	}
+	return namespace + "/" + name
+}
+
+// sortedKeys returns the devnet keys in display order.
+func (m DashboardModel) sortedKeys() []string {
+	keys := make([]string, 0, len(m.devnets))
+	for k := range m.devnets {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Selected returns the devnet under the cursor, or nil if there are none.
+func (m DashboardModel) Selected() *v1.Devnet {
+	keys := m.sortedKeys()
+	if m.cursor < 0 || m.cursor >= len(keys) {
+		return nil
+	}
+	return m.devnets[keys[m.cursor]]
+}
+
+// Nodes returns the nodes of a devnet ordered by index.
+func (m DashboardModel) Nodes(devnet *v1.Devnet) []*v1.Node {
+	byIndex := m.nodes[devnetKey(devnet.GetMetadata().GetNamespace(), devnet.GetMetadata().GetName())]
+	nodes := make([]*v1.Node, 0, len(byIndex))
+	for _, n := range byIndex {
+		nodes = append(nodes, n)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].GetMetadata().GetIndex() < nodes[j].GetMetadata().GetIndex()
+	})
+	return nodes
+}
+
+// View implements tea.Model
+func (m DashboardModel) View() string {
+	var b strings.Builder
+
+	scope := m.Namespace
+	if scope == "" {
+		scope = "all namespaces"
+	}
+	state := tui.MutedStyle.Render("○ connecting")
+	if m.live {
+		state = tui.SuccessStyle.Render("live")
+	}
+	b.WriteString(tui.TitleStyle("dvb dashboard").String())
+	b.WriteString(tui.MutedStyle.Render(fmt.Sprintf("  %s  ", scope)))
+	b.WriteString(state)
+	b.WriteString("\n\n")
+
+	b.WriteString(m.devnetsView())
+
+	if devnet := m.Selected(); devnet != nil {
+		b.WriteString("\n")
+		b.WriteString(m.nodesView(devnet))
+		b.WriteString("\n")
+		b.WriteString(eventsView(devnet))
+	}
+
+	b.WriteString("\n")
+	if m.err != nil {
+		b.WriteString(tui.ErrorStyle.Render(m.err.Error()))
+		b.WriteString("\n")
+	} else if m.message != "" {
+		b.WriteString(m.message)
+		b.WriteString("\n")
+	}
+	b.WriteString(tui.MutedStyle.Render("↑/↓ select  s start  x stop  l logs  d describe  q quit"))
+
+	return b.String()
+}
+
+func (m DashboardModel) devnetsView() string {
+	keys := m.sortedKeys()
+	if len(keys) == 0 {
+		return tui.MutedStyle.Render("No devnets") + "\n"
+	}
+
+	var b strings.Builder
+	b.WriteString(tui.BoldStyle.Render(fmt.Sprintf("  %-30s %-12s %-7s %-10s %s", "DEVNET", "PHASE", "NODES", "HEIGHT", "PLUGIN")))
+	b.WriteString("\n")
+	for i, key := range keys {
+		d := m.devnets[key]
+		name := d.GetMetadata().GetName()
+		if m.Namespace == "" {
+			name = key
+		}
+		cursor := "  "
+		if i == m.cursor {
+			cursor = "> "
+		}
+		line := fmt.Sprintf("%-30s %-12s %-7s %-10d %s",
+			name,
+			d.GetStatus().GetPhase(),
+			fmt.Sprintf("%d/%d", d.GetStatus().GetReadyNodes(), d.GetStatus().GetNodes()),
+			d.GetStatus().GetCurrentHeight(),
+			d.GetSpec().GetPlugin(),
+		)
+		if i == m.cursor {
+			line = tui.BoldStyle.Render(line)
+		}
+		b.WriteString(cursor + line + "\n")
+	}
+	return b.String()
+}
+
+func (m DashboardModel) nodesView(devnet *v1.Devnet) string {
+	var b strings.Builder
+	b.WriteString(tui.BoldStyle.Render(fmt.Sprintf("Nodes (%s)", devnet.GetMetadata().GetName())))
+	b.WriteString("\n")
+
+	nodes := m.Nodes(devnet)
+	if len(nodes) == 0 {
+		b.WriteString(tui.MutedStyle.Render("  No nodes"))
+		b.WriteString("\n")
+		return b.String()
+	}
+
+	b.WriteString(tui.MutedStyle.Render(fmt.Sprintf("  %-14s %-10s %-10s %-10s %s", "NAME", "PHASE", "HEALTH", "HEIGHT", "PEERS")))
+	b.WriteString("\n")
+	for _, n := range nodes {
+		health := n.GetStatus().GetHealth().GetStatus()
+		if health == "" {
+			health = "Unknown"
+		}
+		b.WriteString(fmt.Sprintf("  %-14s %-10s %-10s %-10d %d\n",
+			fmt.Sprintf("%s-%d", n.GetSpec().GetRole(), n.GetMetadata().GetIndex()),
+			n.GetStatus().GetPhase(),
+			health,
+			n.GetStatus().GetBlockHeight(),
+			n.GetStatus().GetPeerCount(),
+		))
+	}
+	return b.String()
+}
+
+func eventsView(devnet *v1.Devnet) string {
+	var b strings.Builder
+	b.WriteString(tui.BoldStyle.Render("Recent events"))
+	b.WriteString("\n")
+
+	events := devnet.GetStatus().GetEvents()
+	if len(events) == 0 {
+		b.WriteString(tui.MutedStyle.Render("  No events"))
+		b.WriteString("\n")
+		return b.String()
+	}
+	if len(events) > dashboardEventLimit {
+		events = events[len(events)-dashboardEventLimit:]
+	}
+	for _, e := range events {
+		line := fmt.Sprintf("  %s  %-20s %s", e.GetTimestamp().AsTime().Local().Format("15:04:05"), e.GetReason(), e.GetMessage())
+		if e.GetType() == "Warning" {
+			line = tui.WarningStyle.Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,112 @@
+// internal/tui/views/dashboard_test.go
+package views
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/altuslabsxyz/devnet-builder/api/proto/gen/v1"
+)
+
+func devnetEvent(eventType, name, phase string, generation int64) WatchEventMsg {
+	return WatchEventMsg{Event: &v1.WatchDevnetsResponse{
+		Type: eventType,
+		Devnet: &v1.Devnet{
+			Metadata: &v1.DevnetMetadata{Name: name, Namespace: "default", Generation: generation},
+			Spec:     &v1.DevnetSpec{Plugin: "stable"},
+			Status:   &v1.DevnetStatus{Phase: phase},
+		},
+	}}
+}
+
+func nodeEvent(eventType, devnet string, index int32, height int64, generation int64) WatchEventMsg {
+	return WatchEventMsg{Event: &v1.WatchDevnetsResponse{
+		Type: eventType,
+		Node: &v1.Node{
+			Metadata: &v1.NodeMetadata{DevnetName: devnet, Namespace: "default", Index: index, Generation: generation},
+			Spec:     &v1.NodeSpec{Role: "validator"},
+			Status:   &v1.NodeStatus{Phase: "Running", BlockHeight: height},
+		},
+	}}
+}
+
+func update(m DashboardModel, msgs ...tea.Msg) DashboardModel {
+	for _, msg := range msgs {
+		next, _ := m.Update(msg)
+		m = next.(DashboardModel)
+	}
+	return m
+}
+
+func TestDashboardModel_AppliesWatchEvents(t *testing.T) {
+	m := update(NewDashboardModel("default", DashboardActions{}),
+		WatchResetMsg{},
+		devnetEvent("ADDED", "beta", "Stopped", 1),
+		devnetEvent("ADDED", "alpha", "Running", 1),
+		nodeEvent("ADDED", "alpha", 0, 100, 1),
+	)
+
+	require.NotNil(t, m.Selected())
+	assert.Equal(t, "alpha", m.Selected().GetMetadata().GetName(), "devnets are sorted by name")
+
+	view := m.View()
+	assert.Contains(t, view, "alpha")
+	assert.Contains(t, view, "beta")
+	assert.Contains(t, view, "validator-0")
+	assert.Contains(t, view, "100")
+
+	// Out-of-order updates are ignored.
+	m = update(m, nodeEvent("MODIFIED", "alpha", 0, 120, 3), nodeEvent("MODIFIED", "alpha", 0, 110, 2))
+	assert.Equal(t, int64(120), m.Nodes(m.Selected())[0].GetStatus().GetBlockHeight())
+
+	m = update(m, devnetEvent("DELETED", "alpha", "Running", 2))
+	assert.Equal(t, "beta", m.Selected().GetMetadata().GetName())
+	assert.NotContains(t, m.View(), "validator-0")
+}
+
+func TestDashboardModel_Navigation(t *testing.T) {
+	m := update(NewDashboardModel("default", DashboardActions{}),
+		devnetEvent("ADDED", "alpha", "Running", 1),
+		devnetEvent("ADDED", "beta", "Running", 1),
+	)
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, "beta", m.Selected().GetMetadata().GetName(), "cursor stops at the last devnet")
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")})
+	assert.Equal(t, "alpha", m.Selected().GetMetadata().GetName())
+}
+
+func TestDashboardModel_StartAction(t *testing.T) {
+	var started string
+	m := update(NewDashboardModel("default", DashboardActions{
+		Start: func(namespace, name string) error {
+			started = namespace + "/" + name
+			return nil
+		},
+		Stop: func(namespace, name string) error {
+			return errors.New("boom")
+		},
+	}), devnetEvent("ADDED", "alpha", "Stopped", 1))
+
+	next, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	require.NotNil(t, cmd)
+	m = update(next.(DashboardModel), cmd())
+	assert.Equal(t, "default/alpha", started)
+	assert.Contains(t, m.View(), "Started alpha")
+
+	next, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	require.NotNil(t, cmd)
+	m = update(next.(DashboardModel), cmd())
+	assert.Contains(t, m.View(), "stop alpha: boom")
+}
+
+func TestDashboardModel_WatchError(t *testing.T) {
+	m := update(NewDashboardModel("", DashboardActions{}), WatchErrorMsg{Error: errors.New("connection lost")})
+	assert.Contains(t, m.View(), "connection lost")
+	assert.Contains(t, m.View(), "No devnets")
+}
@@ -9,6 +9,10 @@ import (
 	"testing"
 
 	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -320,6 +324,90 @@ func TestBuildMessage_GovVote_AllOptions(t *testing.T) {
 	}
 }
 
+func TestBuildMessage_GovProposal(t *testing.T) {
+	// The format written by `<chaind> tx gov draft-proposal`
+	payload := []byte(`{
+		"messages": [{
+			"@type": "/cosmos.distribution.v1beta1.MsgCommunityPoolSpend",
+			"authority": "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+			"recipient": "cosmos1recipient",
+			"amount": [{"denom": "stake", "amount": "1000"}]
+		}],
+		"metadata": "ipfs://proposal",
+		"deposit": "10000000stake",
+		"title": "Fund the faucet",
+		"summary": "Spend from the community pool",
+		"expedited": true
+	}`)
+
+	msg, err := BuildMessage(network.TxTypeGovProposal, "cosmos1proposer", payload)
+	require.NoError(t, err)
+
+	submit, ok := msg.(*govtypes.MsgSubmitProposal)
+	require.True(t, ok, "got %T", msg)
+	require.Equal(t, "cosmos1proposer", submit.Proposer)
+	require.Equal(t, "Fund the faucet", submit.Title)
+	require.Equal(t, "Spend from the community pool", submit.Summary)
+	require.Equal(t, "ipfs://proposal", submit.Metadata)
+	require.True(t, submit.Expedited)
+	require.Equal(t, "10000000stake", sdk.Coins(submit.InitialDeposit).String())
+	require.Len(t, submit.Messages, 1)
+	require.Equal(t, "/cosmos.distribution.v1beta1.MsgCommunityPoolSpend", submit.Messages[0].TypeUrl)
+}
+
+func TestBuildMessage_GovProposal_Text(t *testing.T) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"title":       "Signal",
+		"description": "A text proposal",
+	})
+	require.NoError(t, err)
+
+	msg, err := BuildMessage(network.TxTypeGovProposal, "cosmos1proposer", payload)
+	require.NoError(t, err)
+
+	submit := msg.(*govtypes.MsgSubmitProposal)
+	require.Empty(t, submit.Messages)
+	require.Equal(t, "A text proposal", submit.Summary, "description is used as the summary")
+}
+
+func TestBuildMessage_GovProposal_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		wantErr string
+	}{
+		{"missing title", `{"summary": "no title"}`, "title is required"},
+		{"bad deposit", `{"title": "t", "deposit": "lots"}`, "failed to parse deposit"},
+		{"unknown message", `{"title": "t", "messages": [{"@type": "/mychain.custom.v1.MsgDoThing"}]}`, "failed to decode proposal message 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := BuildMessage(network.TxTypeGovProposal, "cosmos1proposer", []byte(tt.payload))
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestBuildMessage_SlashingUnjail(t *testing.T) {
+	addr := make([]byte, 20)
+	addr[19] = 7
+	sender, err := bech32.ConvertAndEncode("cosmos", addr)
+	require.NoError(t, err)
+	valoper, err := bech32.ConvertAndEncode("cosmosvaloper", addr)
+	require.NoError(t, err)
+
+	msg, err := BuildMessage(network.TxTypeSlashingUnjail, sender, nil)
+	require.NoError(t, err)
+	require.Equal(t, valoper, msg.(*slashingtypes.MsgUnjail).ValidatorAddr, "defaults to the sender's operator address")
+
+	msg, err = BuildMessage(network.TxTypeSlashingUnjail, "cosmos1signer", []byte(`{"validator_address": "cosmosvaloper1other"}`))
+	require.NoError(t, err)
+	require.Equal(t, "cosmosvaloper1other", msg.(*slashingtypes.MsgUnjail).ValidatorAddr)
+
+	_, err = BuildMessage(network.TxTypeSlashingUnjail, "not-bech32", nil)
+	require.ErrorContains(t, err, "failed to derive validator address")
+}
+
 func TestParseGasPrice(t *testing.T) {
 	tests := []struct {
 		name        string
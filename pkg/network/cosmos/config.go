@@ -13,7 +13,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -24,20 +28,8 @@ var sdkConfigMu sync.Mutex
 // It sets up an interface registry with standard types and module interfaces,
 // creates a proto codec, and returns a properly configured TxConfig.
 func NewTxConfig() client.TxConfig {
-	// Create interface registry
-	interfaceRegistry := codectypes.NewInterfaceRegistry()
-
-	// Register standard types
-	std.RegisterInterfaces(interfaceRegistry)
-
-	// Register module interfaces
-	authtypes.RegisterInterfaces(interfaceRegistry)
-	banktypes.RegisterInterfaces(interfaceRegistry)
-	govtypes.RegisterInterfaces(interfaceRegistry)
-	stakingtypes.RegisterInterfaces(interfaceRegistry)
-
 	// Create proto codec with the registry
-	protoCodec := codec.NewProtoCodec(interfaceRegistry)
+	protoCodec := codec.NewProtoCodec(newInterfaceRegistry())
 
 	// Create TxConfig with default sign modes using the new options-based API
 	txConfig, err := tx.NewTxConfigWithOptions(protoCodec, tx.ConfigOptions{
@@ -51,6 +43,33 @@ func NewTxConfig() client.TxConfig {
 	return txConfig
 }
 
+// newInterfaceRegistry creates an interface registry with the standard types
+// and the messages of the SDK modules most chains include. Proposal messages
+// can only be decoded from JSON if their type is registered here.
+func newInterfaceRegistry() codectypes.InterfaceRegistry {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+
+	// Register standard types
+	std.RegisterInterfaces(interfaceRegistry)
+
+	// Register module interfaces
+	authtypes.RegisterInterfaces(interfaceRegistry)
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	consensustypes.RegisterInterfaces(interfaceRegistry)
+	distrtypes.RegisterInterfaces(interfaceRegistry)
+	govtypes.RegisterInterfaces(interfaceRegistry)
+	minttypes.RegisterInterfaces(interfaceRegistry)
+	slashingtypes.RegisterInterfaces(interfaceRegistry)
+	stakingtypes.RegisterInterfaces(interfaceRegistry)
+
+	return interfaceRegistry
+}
+
+// msgCodec decodes proposal messages from JSON.
+var msgCodec = sync.OnceValue(func() codec.Codec {
+	return codec.NewProtoCodec(newInterfaceRegistry())
+})
+
 // SetupSDKConfig configures the Cosmos SDK with the given bech32 prefix.
 // It sets up prefixes for account addresses, validator addresses, and consensus node addresses.
 // This function is thread-safe and returns an error if the prefix is empty.
@@ -0,0 +1,50 @@
+// pkg/network/cosmos/decode.go
+package cosmos
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+)
+
+// DecodeTx decodes a Cosmos SDK transaction. Messages of the SDK modules in
+// the interface registry are decoded to JSON; other messages keep only their
+// type URL, so chain-specific transactions still show what they contain.
+func DecodeTx(txBytes []byte) (*network.DecodedTx, error) {
+	var raw txtypes.TxRaw
+	if err := raw.Unmarshal(txBytes); err != nil {
+		return nil, fmt.Errorf("failed to decode tx: %w", err)
+	}
+	var body txtypes.TxBody
+	if err := body.Unmarshal(raw.BodyBytes); err != nil {
+		return nil, fmt.Errorf("failed to decode tx body: %w", err)
+	}
+	var authInfo txtypes.AuthInfo
+	if err := authInfo.Unmarshal(raw.AuthInfoBytes); err != nil {
+		return nil, fmt.Errorf("failed to decode tx auth info: %w", err)
+	}
+
+	cdc := msgCodec()
+	decoded := &network.DecodedTx{
+		Messages: make([]network.DecodedMsg, 0, len(body.Messages)),
+		Memo:     body.Memo,
+	}
+	for _, anyMsg := range body.Messages {
+		msg := network.DecodedMsg{TypeURL: anyMsg.TypeUrl}
+		var sdkMsg sdk.Msg
+		if err := cdc.UnpackAny(anyMsg, &sdkMsg); err == nil {
+			if data, err := cdc.MarshalInterfaceJSON(sdkMsg); err == nil {
+				msg.JSON = data
+			}
+		}
+		decoded.Messages = append(decoded.Messages, msg)
+	}
+	if authInfo.Fee != nil {
+		decoded.Fee = authInfo.Fee.Amount.String()
+		decoded.GasLimit = authInfo.Fee.GasLimit
+	}
+	return decoded, nil
+}
@@ -0,0 +1,56 @@
+// pkg/network/cosmos/decode_test.go
+package cosmos
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeTx(t *testing.T) {
+	send, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+		FromAddress: "cosmos1from",
+		ToAddress:   "cosmos1to",
+		Amount:      sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(100))),
+	})
+	require.NoError(t, err)
+	custom := &codectypes.Any{TypeUrl: "/mychain.fees.v1.MsgSetFee", Value: []byte{0x0a, 0x01, 0x31}}
+
+	body := txtypes.TxBody{Messages: []*codectypes.Any{send, custom}, Memo: "hello"}
+	bodyBytes, err := body.Marshal()
+	require.NoError(t, err)
+	authInfo := txtypes.AuthInfo{Fee: &txtypes.Fee{
+		Amount:   sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(5000))),
+		GasLimit: 200000,
+	}}
+	authInfoBytes, err := authInfo.Marshal()
+	require.NoError(t, err)
+	raw := txtypes.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, Signatures: [][]byte{{1}}}
+	txBytes, err := raw.Marshal()
+	require.NoError(t, err)
+
+	decoded, err := DecodeTx(txBytes)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", decoded.Memo)
+	assert.Equal(t, "5000stake", decoded.Fee)
+	assert.Equal(t, uint64(200000), decoded.GasLimit)
+	require.Len(t, decoded.Messages, 2)
+
+	assert.Equal(t, "/cosmos.bank.v1beta1.MsgSend", decoded.Messages[0].TypeURL)
+	assert.JSONEq(t, `{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"cosmos1from","to_address":"cosmos1to","amount":[{"denom":"stake","amount":"100"}]}`,
+		string(decoded.Messages[0].JSON))
+
+	assert.Equal(t, "/mychain.fees.v1.MsgSetFee", decoded.Messages[1].TypeURL)
+	assert.Empty(t, decoded.Messages[1].JSON, "unknown messages keep only their type")
+}
+
+func TestDecodeTx_Invalid(t *testing.T) {
+	_, err := DecodeTx([]byte{0xff, 0xff})
+	assert.Error(t, err)
+}
@@ -9,8 +9,10 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/altuslabsxyz/devnet-builder/pkg/network"
@@ -24,6 +26,27 @@ type GovVotePayload struct {
 	Option string `json:"option"`
 }
 
+// GovProposalPayload contains the fields for a governance proposal
+// transaction. It accepts the proposal file format of `<chaind> tx gov
+// submit-proposal`, so an existing proposal.json can be submitted as is.
+type GovProposalPayload struct {
+	// Messages are the proposal's messages as JSON, each with an "@type".
+	// A proposal without messages is a text proposal.
+	Messages []json.RawMessage `json:"messages,omitempty"`
+	// Metadata is optional proposal metadata (often an IPFS link).
+	Metadata string `json:"metadata,omitempty"`
+	// Deposit is the initial deposit (e.g., "10000000stake").
+	Deposit string `json:"deposit,omitempty"`
+	// Title is the proposal title.
+	Title string `json:"title"`
+	// Summary describes the proposal.
+	Summary string `json:"summary,omitempty"`
+	// Description is accepted as the summary when Summary is empty.
+	Description string `json:"description,omitempty"`
+	// Expedited requests an expedited proposal.
+	Expedited bool `json:"expedited,omitempty"`
+}
+
 // BankSendPayload contains the fields for a bank send transaction.
 type BankSendPayload struct {
 	// ToAddress is the recipient's bech32 address.
@@ -40,16 +63,27 @@ type StakingDelegatePayload struct {
 	Amount string `json:"amount"`
 }
 
+// SlashingUnjailPayload contains the fields for a slashing unjail transaction.
+type SlashingUnjailPayload struct {
+	// ValidatorAddress is the jailed validator's bech32 operator address.
+	// Defaults to the operator address of the sender's account.
+	ValidatorAddress string `json:"validator_address,omitempty"`
+}
+
 // BuildMessage creates an SDK message from the given transaction type and payload.
 // It returns the appropriate message type based on TxType.
 func BuildMessage(txType network.TxType, sender string, payload json.RawMessage) (sdk.Msg, error) {
 	switch txType {
 	case network.TxTypeGovVote:
 		return buildGovVoteMsg(sender, payload)
+	case network.TxTypeGovProposal:
+		return buildGovProposalMsg(sender, payload)
 	case network.TxTypeBankSend:
 		return buildBankSendMsg(sender, payload)
 	case network.TxTypeStakingDelegate:
 		return buildStakingDelegateMsg(sender, payload)
+	case network.TxTypeSlashingUnjail:
+		return buildSlashingUnjailMsg(sender, payload)
 	default:
 		return nil, fmt.Errorf("unsupported transaction type: %s", txType)
 	}
@@ -77,6 +111,42 @@ func buildGovVoteMsg(voter string, payload json.RawMessage) (sdk.Msg, error) {
 	return msg, nil
 }
 
+// buildGovProposalMsg creates a governance proposal submission message.
+func buildGovProposalMsg(proposer string, payload json.RawMessage) (sdk.Msg, error) {
+	var p GovProposalPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal gov proposal payload: %w", err)
+	}
+
+	if p.Title == "" {
+		return nil, fmt.Errorf("title is required")
+	}
+	summary := p.Summary
+	if summary == "" {
+		summary = p.Description
+	}
+
+	var deposit sdk.Coins
+	if p.Deposit != "" {
+		coins, err := sdk.ParseCoinsNormalized(p.Deposit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse deposit: %w", err)
+		}
+		deposit = coins
+	}
+
+	msgs := make([]sdk.Msg, 0, len(p.Messages))
+	for i, raw := range p.Messages {
+		var msg sdk.Msg
+		if err := msgCodec().UnmarshalInterfaceJSON(raw, &msg); err != nil {
+			return nil, fmt.Errorf("failed to decode proposal message %d: %w", i+1, err)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return govtypes.NewMsgSubmitProposal(msgs, deposit, proposer, p.Metadata, p.Title, summary, p.Expedited)
+}
+
 // buildBankSendMsg creates a bank send message.
 func buildBankSendMsg(sender string, payload json.RawMessage) (sdk.Msg, error) {
 	var p BankSendPayload
@@ -127,6 +197,30 @@ func buildStakingDelegateMsg(delegator string, payload json.RawMessage) (sdk.Msg
 	return msg, nil
 }
 
+// buildSlashingUnjailMsg creates a slashing unjail message.
+func buildSlashingUnjailMsg(sender string, payload json.RawMessage) (sdk.Msg, error) {
+	var p SlashingUnjailPayload
+	if len(payload) > 0 {
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal slashing unjail payload: %w", err)
+		}
+	}
+
+	if p.ValidatorAddress == "" {
+		// A validator's operator address is its account address with the
+		// valoper prefix
+		hrp, addr, err := bech32.DecodeAndConvert(sender)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive validator address from sender: %w", err)
+		}
+		if p.ValidatorAddress, err = bech32.ConvertAndEncode(hrp+"valoper", addr); err != nil {
+			return nil, fmt.Errorf("failed to derive validator address from sender: %w", err)
+		}
+	}
+
+	return &slashingtypes.MsgUnjail{ValidatorAddr: p.ValidatorAddress}, nil
+}
+
 // parseVoteOption converts a string vote option to the governance VoteOption type.
 func parseVoteOption(opt string) (govtypes.VoteOption, error) {
 	switch strings.ToLower(opt) {
@@ -289,6 +289,7 @@ func (b *TxBuilder) SupportedTxTypes() []network.TxType {
 		network.TxTypeBankSend,
 		network.TxTypeStakingDelegate,
 		network.TxTypeStakingUnbond,
+		network.TxTypeSlashingUnjail,
 	}
 
 	// Add governance types based on features
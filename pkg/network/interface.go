@@ -24,6 +24,7 @@ package network
 
 import (
 	"context"
+	"syscall"
 	"time"
 )
 
@@ -461,3 +462,55 @@ type FileBasedGenesisModifier interface {
 	//   - error: Any error that occurred during modification
 	ModifyGenesisFile(inputPath, outputPath string, opts GenesisOptions) (outputSize int64, err error)
 }
+
+// ParamsLayout describes where a module's params are queried and how a
+// governance proposal updates them.
+type ParamsLayout struct {
+	// QueryPath is the REST path of the params query
+	// (e.g., "/cosmos/staking/v1beta1/params").
+	QueryPath string `json:"query_path"`
+
+	// ResponseField is the field of the query response holding the params.
+	// Defaults to "params".
+	ResponseField string `json:"response_field,omitempty"`
+
+	// MsgTypeURL is the type URL of the module's MsgUpdateParams
+	// (e.g., "/cosmos.staking.v1beta1.MsgUpdateParams").
+	MsgTypeURL string `json:"msg_type_url"`
+
+	// MsgField is the field of MsgUpdateParams holding the params.
+	// Defaults to "params".
+	MsgField string `json:"msg_field,omitempty"`
+}
+
+// ParamsLayoutProvider is an optional interface for network modules whose
+// chains lay out module params differently from the Cosmos SDK defaults,
+// such as custom modules or modules on another API version. It is used to
+// build param change proposals (dvb gov set-param).
+type ParamsLayoutProvider interface {
+	// ParamsLayout returns the params layout of the named module, or false
+	// to use the Cosmos SDK default for it.
+	ParamsLayout(module string) (ParamsLayout, bool)
+}
+
+// UpgradeNamer is an optional interface for network modules whose chains
+// name their upgrade handlers after the release that ships them. It lets
+// an upgrade be created from the target version alone (dvb upgrade create
+// --version).
+type UpgradeNamer interface {
+	// UpgradeName returns the name of the upgrade handler registered by
+	// the given release version, or false if the version does not follow
+	// the chain's convention.
+	UpgradeName(version string) (string, bool)
+}
+
+// ConfigReloader is an optional interface for network modules whose node
+// binaries reload part of their configuration on a signal, without
+// restarting. It lets dvb node config set --no-restart-if-possible apply
+// changes to those keys to a running node.
+type ConfigReloader interface {
+	// ReloadableConfig returns the keys the node reloads, as
+	// "<file>:<dotted.key>" (e.g. "config.toml:log_level"), and the signal
+	// that makes it reload them. A zero signal means SIGHUP.
+	ReloadableConfig() (keys []string, signal syscall.Signal)
+}
@@ -0,0 +1,213 @@
+package network
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// PassthroughFlag is a flag devnet-builder fills in for a passthrough
+// command from the devnet it runs against.
+type PassthroughFlag string
+
+const (
+	// PassthroughHome injects --home with the node's home directory.
+	PassthroughHome PassthroughFlag = "home"
+	// PassthroughNode injects --node with the node's CometBFT RPC address.
+	PassthroughNode PassthroughFlag = "node"
+	// PassthroughChainID injects --chain-id with the devnet's chain ID.
+	PassthroughChainID PassthroughFlag = "chain-id"
+)
+
+// PassthroughCommand declares a subcommand of a network's binary that
+// devnet-builder passes through, as in "devnet-builder stabled q bank
+// balances <addr>", with flags pointing it at the running devnet.
+type PassthroughCommand struct {
+	// Name is the binary's subcommand (e.g., "query").
+	Name string
+
+	// Aliases are other names of the subcommand (e.g., "q").
+	Aliases []string
+
+	// Flags are injected unless the user passes them.
+	Flags []PassthroughFlag
+}
+
+// PassthroughProvider is an optional interface for network modules whose
+// binaries need passthrough commands other than the Cosmos SDK defaults.
+// Modules that do not implement it get DefaultPassthroughCommands.
+type PassthroughProvider interface {
+	// PassthroughCommands returns the subcommands to pass through. An empty
+	// result uses the defaults.
+	PassthroughCommands() []PassthroughCommand
+}
+
+// DefaultPassthroughCommands returns the passthrough commands of a standard
+// Cosmos SDK binary.
+func DefaultPassthroughCommands() []PassthroughCommand {
+	return []PassthroughCommand{
+		{Name: "query", Aliases: []string{"q"}, Flags: []PassthroughFlag{PassthroughHome, PassthroughNode}},
+		{Name: "tx", Flags: []PassthroughFlag{PassthroughHome, PassthroughNode, PassthroughChainID}},
+		{Name: "keys", Flags: []PassthroughFlag{PassthroughHome}},
+		{Name: "status", Flags: []PassthroughFlag{PassthroughNode}},
+		{Name: "comet", Aliases: []string{"cometbft", "tendermint"}, Flags: []PassthroughFlag{PassthroughHome}},
+	}
+}
+
+// PassthroughCommandsFor returns the passthrough commands of module: its
+// own if it implements PassthroughProvider, the defaults otherwise.
+func PassthroughCommandsFor(module Module) []PassthroughCommand {
+	if provider, ok := module.(PassthroughProvider); ok {
+		if cmds := provider.PassthroughCommands(); len(cmds) > 0 {
+			return cmds
+		}
+	}
+	return DefaultPassthroughCommands()
+}
+
+// PassthroughTarget is the devnet node a passthrough command runs against.
+// Empty fields are not injected.
+type PassthroughTarget struct {
+	Home    string // node home directory
+	Node    string // CometBFT RPC address (e.g., "tcp://localhost:26657")
+	ChainID string
+}
+
+// value returns the target's value for flag.
+func (t PassthroughTarget) value(flag PassthroughFlag) string {
+	switch flag {
+	case PassthroughHome:
+		return t.Home
+	case PassthroughNode:
+		return t.Node
+	case PassthroughChainID:
+		return t.ChainID
+	default:
+		return ""
+	}
+}
+
+// Matches reports whether name is the command's name or one of its aliases.
+func (c PassthroughCommand) Matches(name string) bool {
+	if name == c.Name {
+		return true
+	}
+	for _, alias := range c.Aliases {
+		if name == alias {
+			return true
+		}
+	}
+	return false
+}
+
+// InjectFlags returns args with the command's flags for target added.
+// Flags the user already passed, as --flag value or --flag=value, are left
+// alone. Injected flags go before a "--" terminator, if any.
+func (c PassthroughCommand) InjectFlags(args []string, target PassthroughTarget) []string {
+	end := len(args)
+	for i, arg := range args {
+		if arg == "--" {
+			end = i
+			break
+		}
+	}
+
+	var injected []string
+	for _, flag := range c.Flags {
+		value := target.value(flag)
+		if value == "" || hasFlag(args[:end], string(flag)) {
+			continue
+		}
+		injected = append(injected, "--"+string(flag), value)
+	}
+	if len(injected) == 0 {
+		return args
+	}
+
+	out := make([]string, 0, len(args)+len(injected))
+	out = append(out, args[:end]...)
+	out = append(out, injected...)
+	return append(out, args[end:]...)
+}
+
+// hasFlag reports whether args set the named flag.
+func hasFlag(args []string, name string) bool {
+	for _, arg := range args {
+		if arg == "--"+name || strings.HasPrefix(arg, "--"+name+"=") {
+			return true
+		}
+	}
+	return false
+}
+
+// PassthroughEntry is a binary registered for passthrough.
+type PassthroughEntry struct {
+	Plugin   string // plugin the binary belongs to
+	Binary   string // binary name (e.g., "stabled")
+	Commands []PassthroughCommand
+}
+
+// Command returns the entry's command named name or aliased to it.
+func (e PassthroughEntry) Command(name string) (PassthroughCommand, bool) {
+	for _, c := range e.Commands {
+		if c.Matches(name) {
+			return c, true
+		}
+	}
+	return PassthroughCommand{}, false
+}
+
+// PassthroughRegistry maps binary names to their plugins' passthrough
+// commands. It is safe for concurrent use.
+type PassthroughRegistry struct {
+	mu      sync.RWMutex
+	entries map[string]PassthroughEntry
+}
+
+// NewPassthroughRegistry creates an empty passthrough registry.
+func NewPassthroughRegistry() *PassthroughRegistry {
+	return &PassthroughRegistry{entries: make(map[string]PassthroughEntry)}
+}
+
+// Register adds the binary of the module loaded as plugin. Every plugin
+// gets passthrough this way; modules customize it by implementing
+// PassthroughProvider.
+func (r *PassthroughRegistry) Register(plugin string, module Module) error {
+	binary := module.BinaryName()
+	if binary == "" {
+		return fmt.Errorf("plugin %q does not specify a binary name", plugin)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.entries[binary]; ok && existing.Plugin != plugin {
+		return fmt.Errorf("binary %q is already registered by plugin %q", binary, existing.Plugin)
+	}
+	r.entries[binary] = PassthroughEntry{
+		Plugin:   plugin,
+		Binary:   binary,
+		Commands: PassthroughCommandsFor(module),
+	}
+	return nil
+}
+
+// Lookup returns the entry for binary.
+func (r *PassthroughRegistry) Lookup(binary string) (PassthroughEntry, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.entries[binary]
+	return e, ok
+}
+
+// Entries returns every registered binary, sorted by name.
+func (r *PassthroughRegistry) Entries() []PassthroughEntry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]PassthroughEntry, 0, len(r.entries))
+	for _, e := range r.entries {
+		out = append(out, e)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Binary < out[j].Binary })
+	return out
+}
@@ -0,0 +1,90 @@
+package network
+
+import (
+	"reflect"
+	"testing"
+)
+
+// passthroughModule is a Module that declares its own passthrough commands.
+type passthroughModule struct {
+	Module
+	binary string
+	cmds   []PassthroughCommand
+}
+
+func (m passthroughModule) BinaryName() string { return m.binary }
+
+func (m passthroughModule) PassthroughCommands() []PassthroughCommand { return m.cmds }
+
+// TestPassthroughCommand_InjectFlags tests flag injection for a passthrough command.
+func TestPassthroughCommand_InjectFlags(t *testing.T) {
+	cmd := PassthroughCommand{Name: "tx", Flags: []PassthroughFlag{PassthroughHome, PassthroughNode, PassthroughChainID}}
+	target := PassthroughTarget{Home: "/devnet/node0", Node: "tcp://localhost:26657"}
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "injects set target fields",
+			args: []string{"tx", "bank", "send"},
+			want: []string{"tx", "bank", "send", "--home", "/devnet/node0", "--node", "tcp://localhost:26657"},
+		},
+		{
+			name: "keeps user flags",
+			args: []string{"tx", "--home=/other", "--node", "tcp://remote:26657"},
+			want: []string{"tx", "--home=/other", "--node", "tcp://remote:26657"},
+		},
+		{
+			name: "injects before terminator",
+			args: []string{"tx", "--", "--home"},
+			want: []string{"tx", "--home", "/devnet/node0", "--node", "tcp://localhost:26657", "--", "--home"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cmd.InjectFlags(tt.args, target); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InjectFlags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPassthroughRegistry tests registering plugin binaries for passthrough.
+func TestPassthroughRegistry(t *testing.T) {
+	r := NewPassthroughRegistry()
+
+	if err := r.Register("stable", passthroughModule{binary: "stabled"}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	custom := []PassthroughCommand{{Name: "query", Aliases: []string{"q"}, Flags: []PassthroughFlag{PassthroughNode}}}
+	if err := r.Register("ault", passthroughModule{binary: "aultd", cmds: custom}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	// Modules without their own commands get the defaults
+	entry, ok := r.Lookup("stabled")
+	if !ok || entry.Plugin != "stable" || !reflect.DeepEqual(entry.Commands, DefaultPassthroughCommands()) {
+		t.Errorf("Lookup(stabled) = %+v, %v", entry, ok)
+	}
+	entry, _ = r.Lookup("aultd")
+	if c, ok := entry.Command("q"); !ok || c.Name != "query" {
+		t.Errorf("Command(q) = %+v, %v", c, ok)
+	}
+	if _, ok := entry.Command("tx"); ok {
+		t.Error("expected no tx command for a plugin that declares only query")
+	}
+
+	if got := r.Entries(); len(got) != 2 || got[0].Binary != "aultd" || got[1].Binary != "stabled" {
+		t.Errorf("Entries() = %+v", got)
+	}
+
+	if err := r.Register("other", passthroughModule{binary: "stabled"}); err == nil {
+		t.Error("expected an error registering a binary of another plugin")
+	}
+	if err := r.Register("nobinary", passthroughModule{}); err == nil {
+		t.Error("expected an error registering a plugin without a binary")
+	}
+}
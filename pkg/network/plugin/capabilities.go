@@ -0,0 +1,219 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+)
+
+// ProtocolVersion is the plugin protocol version of this package. It is
+// bumped when methods are added to the NetworkModule service, so the host
+// can tell which methods a plugin was built with.
+//
+// Version 1 plugins predate capability negotiation. Version 2 plugins
+// report their capabilities through GetCapabilities.
+//
+// The go-plugin Handshake.ProtocolVersion stays at 1: changing it would
+// make the host refuse every plugin built before the change.
+const ProtocolVersion = 2
+
+// Capability is an optional feature a plugin may support.
+type Capability string
+
+const (
+	// CapabilityGovParams: the plugin queries governance params
+	// (GetGovernanceParams).
+	CapabilityGovParams Capability = "gov-params"
+	// CapabilityRPC: the plugin implements the RPC delegation methods
+	// (RPCProvider).
+	CapabilityRPC Capability = "rpc"
+	// CapabilityEVM: the chain runs an EVM, with an EVM chain ID or JSON-RPC
+	// port.
+	CapabilityEVM Capability = "evm"
+	// CapabilityStateSync: devnets can start from mainnet or testnet state,
+	// through snapshots or a network.StateExporter.
+	CapabilityStateSync Capability = "state-sync"
+	// CapabilityGenesisFile: the plugin modifies genesis files in place
+	// (network.FileBasedGenesisModifier).
+	CapabilityGenesisFile Capability = "genesis-file"
+	// CapabilityParamsLayout: network.ParamsLayoutProvider.
+	CapabilityParamsLayout Capability = "params-layout"
+	// CapabilityPassthrough: network.PassthroughProvider.
+	CapabilityPassthrough Capability = "passthrough"
+	// CapabilityConfigReload: network.ConfigReloader.
+	CapabilityConfigReload Capability = "config-reload"
+	// CapabilityTxDecoder: network.TxDecoder.
+	CapabilityTxDecoder Capability = "tx-decoder"
+	// CapabilityTxBuilder: network.TxBuilderFactory.
+	CapabilityTxBuilder Capability = "tx-builder"
+	// CapabilityWASM: the plugin is a WebAssembly module run inside the
+	// host. It implements only the methods in wasm/guest.Module.
+	CapabilityWASM Capability = "wasm"
+)
+
+// CapabilityProvider is an optional interface for modules that declare
+// capabilities the server cannot infer from the interfaces they implement,
+// such as CapabilityEVM for a chain without an EVM chain ID.
+type CapabilityProvider interface {
+	PluginCapabilities() []Capability
+}
+
+// Capabilities is the outcome of capability negotiation with a plugin.
+type Capabilities struct {
+	// ProtocolVersion is the plugin's protocol version; 1 for plugins
+	// that do not report one.
+	ProtocolVersion int
+
+	// Supported lists the plugin's capabilities, sorted. It is empty for
+	// version 1 plugins, whose capabilities are unknown.
+	Supported []Capability
+}
+
+// Known reports whether the plugin reported its capabilities.
+func (c Capabilities) Known() bool {
+	return c.ProtocolVersion >= 2
+}
+
+// Supports reports whether the plugin supports capability. It is true for
+// every capability of a plugin whose capabilities are unknown: the host
+// calls the method and falls back if the plugin returns Unimplemented, as
+// it did before negotiation.
+func (c Capabilities) Supports(capability Capability) bool {
+	if !c.Known() {
+		return true
+	}
+	for _, s := range c.Supported {
+		if s == capability {
+			return true
+		}
+	}
+	return false
+}
+
+// String lists the capabilities, e.g. "evm,gov-params,rpc".
+func (c Capabilities) String() string {
+	if !c.Known() {
+		return "unknown"
+	}
+	names := make([]string, len(c.Supported))
+	for i, s := range c.Supported {
+		names[i] = string(s)
+	}
+	return strings.Join(names, ",")
+}
+
+// moduleCapabilities infers the capabilities of module from the optional
+// interfaces it implements and its configuration, adding those it declares.
+func moduleCapabilities(module network.Module) []Capability {
+	set := make(map[Capability]bool)
+	if _, ok := module.(govParamsProvider); ok {
+		set[CapabilityGovParams] = true
+	}
+	if _, ok := module.(RPCProvider); ok {
+		set[CapabilityRPC] = true
+	}
+	if module.GenesisConfig().EVMChainID != 0 || module.DefaultPorts().EVMRPC != 0 {
+		set[CapabilityEVM] = true
+	}
+	if _, ok := module.(network.StateExporter); ok {
+		set[CapabilityStateSync] = true
+	}
+	for _, n := range module.AvailableNetworks() {
+		if module.SnapshotURL(n) != "" {
+			set[CapabilityStateSync] = true
+		}
+	}
+	if _, ok := module.(network.FileBasedGenesisModifier); ok {
+		set[CapabilityGenesisFile] = true
+	}
+	if _, ok := module.(network.ParamsLayoutProvider); ok {
+		set[CapabilityParamsLayout] = true
+	}
+	if _, ok := module.(network.PassthroughProvider); ok {
+		set[CapabilityPassthrough] = true
+	}
+	if _, ok := module.(network.ConfigReloader); ok {
+		set[CapabilityConfigReload] = true
+	}
+	if _, ok := module.(network.TxDecoder); ok {
+		set[CapabilityTxDecoder] = true
+	}
+	if _, ok := module.(network.TxBuilderFactory); ok {
+		set[CapabilityTxBuilder] = true
+	}
+	if provider, ok := module.(CapabilityProvider); ok {
+		for _, c := range provider.PluginCapabilities() {
+			set[c] = true
+		}
+	}
+
+	caps := make([]Capability, 0, len(set))
+	for c := range set {
+		caps = append(caps, c)
+	}
+	sort.Slice(caps, func(i, j int) bool { return caps[i] < caps[j] })
+	return caps
+}
+
+// govParamsProvider is the optional governance params query of a module.
+type govParamsProvider interface {
+	GetGovernanceParams(rpcEndpoint, networkType string) (*GovernanceParamsResponse, error)
+}
+
+// GetCapabilities reports ProtocolVersion and the module's capabilities.
+func (s *GRPCServer) GetCapabilities(ctx context.Context, req *Empty) (*CapabilitiesResponse, error) {
+	caps := moduleCapabilities(s.impl)
+	names := make([]string, len(caps))
+	for i, c := range caps {
+		names[i] = string(c)
+	}
+	return &CapabilitiesResponse{ProtocolVersion: ProtocolVersion, Capabilities: names}, nil
+}
+
+// Capabilities negotiates capabilities with the plugin on first use and
+// returns the result. Plugins that do not implement GetCapabilities, or
+// cannot be reached, are treated as protocol version 1.
+func (c *GRPCClient) Capabilities() Capabilities {
+	c.capsOnce.Do(func() {
+		c.caps = Capabilities{ProtocolVersion: 1}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		resp, err := c.client.GetCapabilities(ctx, &Empty{})
+		if err != nil || resp.ProtocolVersion < 2 {
+			return
+		}
+		c.caps.ProtocolVersion = int(resp.ProtocolVersion)
+		for _, name := range resp.Capabilities {
+			c.caps.Supported = append(c.caps.Supported, Capability(name))
+		}
+		sort.Slice(c.caps.Supported, func(i, j int) bool { return c.caps.Supported[i] < c.caps.Supported[j] })
+	})
+	return c.caps
+}
+
+// unsupported returns the Unimplemented error for a call to method, which
+// needs capability, if the plugin reported that it lacks capability.
+// Callers already fall back on Unimplemented; the message says why.
+func (c *GRPCClient) unsupported(method string, capability Capability) error {
+	if c.Capabilities().Supports(capability) {
+		return nil
+	}
+	name := "plugin"
+	if c.pluginName != "" {
+		name += " " + c.pluginName
+	}
+	err := status.Error(codes.Unimplemented,
+		fmt.Sprintf("%s does not support %s (protocol version %d): method %s not implemented", name, capability, c.caps.ProtocolVersion, method))
+	if c.stats != nil {
+		c.stats.record(method, err, "")
+	}
+	return err
+}
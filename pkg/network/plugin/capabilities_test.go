@@ -0,0 +1,135 @@
+package plugin
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// capsModule overrides the Module methods capability inference reads.
+type capsModule struct {
+	network.Module
+	evmChainID int64
+	snapshot   string
+}
+
+func (m *capsModule) GenesisConfig() network.GenesisConfig {
+	return network.GenesisConfig{EVMChainID: m.evmChainID}
+}
+func (m *capsModule) DefaultPorts() network.PortConfig { return network.PortConfig{RPC: 26657} }
+func (m *capsModule) AvailableNetworks() []string      { return []string{"mainnet"} }
+func (m *capsModule) SnapshotURL(string) string        { return m.snapshot }
+
+// govCapsModule adds governance params and a declared capability.
+type govCapsModule struct{ capsModule }
+
+func (m *govCapsModule) GetGovernanceParams(rpcEndpoint, networkType string) (*GovernanceParamsResponse, error) {
+	return &GovernanceParamsResponse{}, nil
+}
+func (m *govCapsModule) PluginCapabilities() []Capability { return []Capability{"custom"} }
+
+func TestGRPCServer_GetCapabilities(t *testing.T) {
+	tests := []struct {
+		name   string
+		module network.Module
+		want   []string
+	}{
+		{"none", &capsModule{}, []string{}},
+		{"evm and state sync", &capsModule{evmChainID: 988, snapshot: "https://example.com/s.tar.lz4"}, []string{"evm", "state-sync"}},
+		{"interfaces and declared", &govCapsModule{}, []string{"custom", "gov-params"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := NewGRPCServer(tt.module).GetCapabilities(context.Background(), &Empty{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.ProtocolVersion != ProtocolVersion {
+				t.Errorf("protocol_version: got %d, want %d", resp.ProtocolVersion, ProtocolVersion)
+			}
+			if !reflect.DeepEqual(resp.Capabilities, tt.want) {
+				t.Errorf("capabilities: got %v, want %v", resp.Capabilities, tt.want)
+			}
+		})
+	}
+}
+
+func TestGRPCClient_Capabilities_Negotiated(t *testing.T) {
+	calls := 0
+	mockClient := &mockNetworkModuleClient{
+		capabilitiesFn: func(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CapabilitiesResponse, error) {
+			calls++
+			return &CapabilitiesResponse{ProtocolVersion: 2, Capabilities: []string{"rpc", "evm"}}, nil
+		},
+		getGovernanceParamsFn: func(ctx context.Context, in *GovernanceParamsRequest, opts ...grpc.CallOption) (*GovernanceParamsResponse, error) {
+			t.Fatal("GetGovernanceParams called on a plugin without gov-params")
+			return nil, nil
+		},
+	}
+	client := &GRPCClient{client: mockClient, stats: NewCallStats()}
+	client.SetStrict("stable", false)
+
+	caps := client.Capabilities()
+	if !caps.Known() || caps.ProtocolVersion != 2 {
+		t.Fatalf("unexpected capabilities: %+v", caps)
+	}
+	if got := caps.String(); got != "evm,rpc" {
+		t.Errorf("String: got %q, want evm,rpc", got)
+	}
+	if !caps.Supports(CapabilityRPC) || caps.Supports(CapabilityGovParams) {
+		t.Errorf("Supports: got rpc=%v gov-params=%v", caps.Supports(CapabilityRPC), caps.Supports(CapabilityGovParams))
+	}
+
+	// Unsupported methods fail without a call, with Unimplemented so
+	// callers fall back, and a message naming what is missing
+	_, err := client.GetGovernanceParams("http://localhost:1317", "devnet")
+	if status.Code(err) != codes.Unimplemented {
+		t.Fatalf("expected Unimplemented, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "plugin stable does not support gov-params (protocol version 2)") {
+		t.Errorf("unexpected message: %v", err)
+	}
+	if _, ok := client.ParamsLayout("staking"); ok {
+		t.Error("ParamsLayout: expected no layout")
+	}
+
+	client.Capabilities()
+	if calls != 1 {
+		t.Errorf("GetCapabilities called %d times, want 1", calls)
+	}
+	stats := client.CallStats()
+	if len(stats) != 1 || stats[0].Method != "GetGovernanceParams" || stats[0].Unimplemented != 1 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestGRPCClient_Capabilities_Legacy(t *testing.T) {
+	called := false
+	mockClient := &mockNetworkModuleClient{
+		getGovernanceParamsFn: func(ctx context.Context, in *GovernanceParamsRequest, opts ...grpc.CallOption) (*GovernanceParamsResponse, error) {
+			called = true
+			return &GovernanceParamsResponse{MinDeposit: "1"}, nil
+		},
+	}
+	client := &GRPCClient{client: mockClient}
+
+	caps := client.Capabilities()
+	if caps.Known() || caps.ProtocolVersion != 1 || caps.String() != "unknown" {
+		t.Fatalf("unexpected capabilities: %+v", caps)
+	}
+
+	// Capabilities of version 1 plugins are unknown, so methods are called
+	if _, err := client.GetGovernanceParams("http://localhost:1317", "devnet"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Error("GetGovernanceParams was not called on a version 1 plugin")
+	}
+}
@@ -3,6 +3,8 @@ package plugin
 import (
 	"context"
 	"errors"
+	"sync"
+	"syscall"
 	"time"
 
 	"google.golang.org/grpc"
@@ -14,12 +16,28 @@ import (
 // This allows the host to use plugins as if they were native implementations.
 type GRPCClient struct {
 	client NetworkModuleClient
+
+	// Strict mode and call stats for RPC delegation methods; see strict.go.
+	pluginName string
+	strict     bool
+	stats      *CallStats
+
+	// Negotiated on first use; see capabilities.go.
+	capsOnce sync.Once
+	caps     Capabilities
 }
 
 // NewGRPCClient creates a new GRPCClient from a gRPC connection.
 func NewGRPCClient(conn *grpc.ClientConn) *GRPCClient {
+	return newGRPCClient(conn)
+}
+
+// newGRPCClient creates a GRPCClient over any client connection, such as a
+// plugin supervisor.
+func newGRPCClient(cc grpc.ClientConnInterface) *GRPCClient {
 	return &GRPCClient{
-		client: NewNetworkModuleClient(conn),
+		client: NewNetworkModuleClient(cc),
+		stats:  NewCallStats(),
 	}
 }
 
@@ -452,6 +470,10 @@ func (c *GRPCClient) ModifyGenesisFile(inputPath, outputPath string, opts networ
 // GetGovernanceParams retrieves governance parameters from the plugin.
 // This allows each network plugin to implement chain-specific parameter query logic.
 func (c *GRPCClient) GetGovernanceParams(rpcEndpoint, networkType string) (*GovernanceParamsResponse, error) {
+	if err := c.unsupported("GetGovernanceParams", CapabilityGovParams); err != nil {
+		return nil, err
+	}
+
 	// Use 5-second timeout for governance parameter queries
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -460,21 +482,93 @@ func (c *GRPCClient) GetGovernanceParams(rpcEndpoint, networkType string) (*Gove
 		RpcEndpoint: rpcEndpoint,
 		NetworkType: networkType,
 	})
-	if err != nil {
+	if err := c.checkResponse("GetGovernanceParams", err, resp.GetError()); err != nil {
 		return nil, err
 	}
 
 	return resp, nil
 }
 
+// ParamsLayout implements network.ParamsLayoutProvider. Plugins that do not
+// implement GetParamsLayout use the default layout.
+func (c *GRPCClient) ParamsLayout(module string) (network.ParamsLayout, bool) {
+	if !c.Capabilities().Supports(CapabilityParamsLayout) {
+		return network.ParamsLayout{}, false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetParamsLayout(ctx, &ParamsLayoutRequest{Module: module})
+	if err != nil || !resp.Found {
+		return network.ParamsLayout{}, false
+	}
+	return network.ParamsLayout{
+		QueryPath:     resp.QueryPath,
+		ResponseField: resp.ResponseField,
+		MsgTypeURL:    resp.MsgTypeUrl,
+		MsgField:      resp.MsgField,
+	}, true
+}
+
+// ReloadableConfig implements network.ConfigReloader. Plugins that do not
+// implement GetReloadableConfig reload no keys, so their nodes are
+// restarted on every config change.
+func (c *GRPCClient) ReloadableConfig() ([]string, syscall.Signal) {
+	if !c.Capabilities().Supports(CapabilityConfigReload) {
+		return nil, 0
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetReloadableConfig(ctx, &Empty{})
+	if err != nil {
+		return nil, 0
+	}
+	return resp.Keys, syscall.Signal(resp.Signal)
+}
+
+// PassthroughCommands implements network.PassthroughProvider. Plugins that
+// do not implement GetPassthroughCommands get the defaults.
+func (c *GRPCClient) PassthroughCommands() []network.PassthroughCommand {
+	if !c.Capabilities().Supports(CapabilityPassthrough) {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetPassthroughCommands(ctx, &Empty{})
+	if err != nil {
+		return nil
+	}
+	cmds := make([]network.PassthroughCommand, 0, len(resp.Commands))
+	for _, pc := range resp.Commands {
+		flags := make([]network.PassthroughFlag, len(pc.Flags))
+		for i, f := range pc.Flags {
+			flags[i] = network.PassthroughFlag(f)
+		}
+		cmds = append(cmds, network.PassthroughCommand{
+			Name:    pc.Name,
+			Aliases: pc.Aliases,
+			Flags:   flags,
+		})
+	}
+	return cmds
+}
+
 // RPC Operations - All blockchain RPC operations delegated to plugins
 
 // GetBlockHeight retrieves the current block height from the plugin.
 func (c *GRPCClient) GetBlockHeight(ctx context.Context, rpcEndpoint string) (*BlockHeightResponse, error) {
+	if err := c.unsupported("GetBlockHeight", CapabilityRPC); err != nil {
+		return nil, err
+	}
 	resp, err := c.client.GetBlockHeight(ctx, &BlockHeightRequest{
 		RpcEndpoint: rpcEndpoint,
 	})
-	if err != nil {
+	if err := c.checkResponse("GetBlockHeight", err, resp.GetError()); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -482,11 +576,14 @@ func (c *GRPCClient) GetBlockHeight(ctx context.Context, rpcEndpoint string) (*B
 
 // GetBlockTime retrieves the average block time from the plugin.
 func (c *GRPCClient) GetBlockTime(ctx context.Context, rpcEndpoint string, sampleSize int) (*BlockTimeResponse, error) {
+	if err := c.unsupported("GetBlockTime", CapabilityRPC); err != nil {
+		return nil, err
+	}
 	resp, err := c.client.GetBlockTime(ctx, &BlockTimeRequest{
 		RpcEndpoint: rpcEndpoint,
 		SampleSize:  int32(sampleSize),
 	})
-	if err != nil {
+	if err := c.checkResponse("GetBlockTime", err, resp.GetError()); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -494,10 +591,13 @@ func (c *GRPCClient) GetBlockTime(ctx context.Context, rpcEndpoint string, sampl
 
 // IsChainRunning checks if the chain is responding via the plugin.
 func (c *GRPCClient) IsChainRunning(ctx context.Context, rpcEndpoint string) (*ChainStatusResponse, error) {
+	if err := c.unsupported("IsChainRunning", CapabilityRPC); err != nil {
+		return nil, err
+	}
 	resp, err := c.client.IsChainRunning(ctx, &ChainStatusRequest{
 		RpcEndpoint: rpcEndpoint,
 	})
-	if err != nil {
+	if err := c.checkResponse("IsChainRunning", err, resp.GetError()); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -505,12 +605,15 @@ func (c *GRPCClient) IsChainRunning(ctx context.Context, rpcEndpoint string) (*C
 
 // WaitForBlock waits until the chain reaches the specified height via the plugin.
 func (c *GRPCClient) WaitForBlock(ctx context.Context, rpcEndpoint string, targetHeight int64, timeoutMs int64) (*WaitForBlockResponse, error) {
+	if err := c.unsupported("WaitForBlock", CapabilityRPC); err != nil {
+		return nil, err
+	}
 	resp, err := c.client.WaitForBlock(ctx, &WaitForBlockRequest{
 		RpcEndpoint:  rpcEndpoint,
 		TargetHeight: targetHeight,
 		TimeoutMs:    timeoutMs,
 	})
-	if err != nil {
+	if err := c.checkResponse("WaitForBlock", err, resp.GetError()); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -518,11 +621,14 @@ func (c *GRPCClient) WaitForBlock(ctx context.Context, rpcEndpoint string, targe
 
 // GetProposal retrieves a governance proposal by ID via the plugin.
 func (c *GRPCClient) GetProposal(ctx context.Context, rpcEndpoint string, proposalID uint64) (*ProposalResponse, error) {
+	if err := c.unsupported("GetProposal", CapabilityRPC); err != nil {
+		return nil, err
+	}
 	resp, err := c.client.GetProposal(ctx, &ProposalRequest{
 		RpcEndpoint: rpcEndpoint,
 		ProposalId:  proposalID,
 	})
-	if err != nil {
+	if err := c.checkResponse("GetProposal", err, resp.GetError()); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -530,10 +636,13 @@ func (c *GRPCClient) GetProposal(ctx context.Context, rpcEndpoint string, propos
 
 // GetUpgradePlan retrieves the current upgrade plan via the plugin.
 func (c *GRPCClient) GetUpgradePlan(ctx context.Context, rpcEndpoint string) (*UpgradePlanResponse, error) {
+	if err := c.unsupported("GetUpgradePlan", CapabilityRPC); err != nil {
+		return nil, err
+	}
 	resp, err := c.client.GetUpgradePlan(ctx, &UpgradePlanRequest{
 		RpcEndpoint: rpcEndpoint,
 	})
-	if err != nil {
+	if err := c.checkResponse("GetUpgradePlan", err, resp.GetError()); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -541,15 +650,43 @@ func (c *GRPCClient) GetUpgradePlan(ctx context.Context, rpcEndpoint string) (*U
 
 // GetAppVersion retrieves the application version via the plugin.
 func (c *GRPCClient) GetAppVersion(ctx context.Context, rpcEndpoint string) (*AppVersionResponse, error) {
+	if err := c.unsupported("GetAppVersion", CapabilityRPC); err != nil {
+		return nil, err
+	}
 	resp, err := c.client.GetAppVersion(ctx, &AppVersionRequest{
 		RpcEndpoint: rpcEndpoint,
 	})
-	if err != nil {
+	if err := c.checkResponse("GetAppVersion", err, resp.GetError()); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
+// DecodeTx implements network.TxDecoder. It fails for plugins that do not
+// implement DecodeTx, so callers can fall back to a default decoder.
+func (c *GRPCClient) DecodeTx(txBytes []byte) (*network.DecodedTx, error) {
+	if err := c.unsupported("DecodeTx", CapabilityTxDecoder); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.DecodeTx(ctx, &DecodeTxRequest{TxBytes: txBytes})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, errors.New(resp.Error)
+	}
+
+	tx := &network.DecodedTx{Memo: resp.Memo, Fee: resp.Fee, GasLimit: resp.GasLimit}
+	for _, msg := range resp.Messages {
+		tx.Messages = append(tx.Messages, network.DecodedMsg{TypeURL: msg.TypeUrl, JSON: msg.Json})
+	}
+	return tx, nil
+}
+
 // TxBuilder Operations
 
 // Ensure GRPCClient implements TxBuilderFactory
@@ -566,6 +703,9 @@ func (c *GRPCClient) CreateTxBuilder(ctx context.Context, cfg *network.TxBuilder
 	if cfg == nil {
 		return nil, errors.New("config is required")
 	}
+	if err := c.unsupported("CreateTxBuilder", CapabilityTxBuilder); err != nil {
+		return nil, err
+	}
 
 	req := &CreateTxBuilderRequest{
 		RpcEndpoint: cfg.RPCEndpoint,
@@ -2,9 +2,11 @@ package plugin
 
 import (
 	"context"
+	"syscall"
 	"testing"
 	"time"
 
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,6 +16,46 @@ import (
 type mockNetworkModuleClient struct {
 	NetworkModuleClient
 	getGovernanceParamsFn func(ctx context.Context, in *GovernanceParamsRequest, opts ...grpc.CallOption) (*GovernanceParamsResponse, error)
+	getParamsLayoutFn     func(ctx context.Context, in *ParamsLayoutRequest, opts ...grpc.CallOption) (*ParamsLayoutResponse, error)
+	decodeTxFn            func(ctx context.Context, in *DecodeTxRequest, opts ...grpc.CallOption) (*DecodeTxResponse, error)
+	passthroughFn         func(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PassthroughCommandsResponse, error)
+	capabilitiesFn        func(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CapabilitiesResponse, error)
+	reloadableConfigFn    func(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ReloadableConfigResponse, error)
+}
+
+func (m *mockNetworkModuleClient) GetReloadableConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ReloadableConfigResponse, error) {
+	if m.reloadableConfigFn != nil {
+		return m.reloadableConfigFn(ctx, in, opts...)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetReloadableConfig not implemented")
+}
+
+func (m *mockNetworkModuleClient) GetCapabilities(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CapabilitiesResponse, error) {
+	if m.capabilitiesFn != nil {
+		return m.capabilitiesFn(ctx, in, opts...)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
+
+func (m *mockNetworkModuleClient) GetPassthroughCommands(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PassthroughCommandsResponse, error) {
+	if m.passthroughFn != nil {
+		return m.passthroughFn(ctx, in, opts...)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetPassthroughCommands not implemented")
+}
+
+func (m *mockNetworkModuleClient) DecodeTx(ctx context.Context, in *DecodeTxRequest, opts ...grpc.CallOption) (*DecodeTxResponse, error) {
+	if m.decodeTxFn != nil {
+		return m.decodeTxFn(ctx, in, opts...)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeTx not implemented")
+}
+
+func (m *mockNetworkModuleClient) GetParamsLayout(ctx context.Context, in *ParamsLayoutRequest, opts ...grpc.CallOption) (*ParamsLayoutResponse, error) {
+	if m.getParamsLayoutFn != nil {
+		return m.getParamsLayoutFn(ctx, in, opts...)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetParamsLayout not implemented")
 }
 
 func (m *mockNetworkModuleClient) GetGovernanceParams(ctx context.Context, in *GovernanceParamsRequest, opts ...grpc.CallOption) (*GovernanceParamsResponse, error) {
@@ -212,3 +254,129 @@ func TestGRPCClient_TxBuilderFactory(t *testing.T) {
 	client := &GRPCClient{}
 	_ = client // Use to prevent unused variable warning
 }
+
+// TestGRPCClient_ParamsLayout tests a plugin that overrides a module's params layout.
+func TestGRPCClient_ParamsLayout(t *testing.T) {
+	mockClient := &mockNetworkModuleClient{
+		getParamsLayoutFn: func(ctx context.Context, in *ParamsLayoutRequest, opts ...grpc.CallOption) (*ParamsLayoutResponse, error) {
+			if in.Module != "feemarket" {
+				return &ParamsLayoutResponse{}, nil
+			}
+			return &ParamsLayoutResponse{
+				Found:      true,
+				QueryPath:  "/cosmos/evm/feemarket/v1/params",
+				MsgTypeUrl: "/cosmos.evm.feemarket.v1.MsgUpdateParams",
+			}, nil
+		},
+	}
+	client := &GRPCClient{client: mockClient}
+
+	layout, ok := client.ParamsLayout("feemarket")
+	if !ok {
+		t.Fatal("expected a layout for feemarket")
+	}
+	if layout.QueryPath != "/cosmos/evm/feemarket/v1/params" || layout.MsgTypeURL != "/cosmos.evm.feemarket.v1.MsgUpdateParams" {
+		t.Errorf("unexpected layout: %+v", layout)
+	}
+
+	if _, ok := client.ParamsLayout("staking"); ok {
+		t.Error("expected the default layout for staking")
+	}
+}
+
+// TestGRPCClient_ParamsLayout_Unimplemented tests that older plugins use the default layout.
+func TestGRPCClient_ParamsLayout_Unimplemented(t *testing.T) {
+	client := &GRPCClient{client: &mockNetworkModuleClient{}}
+
+	if _, ok := client.ParamsLayout("staking"); ok {
+		t.Error("expected the default layout from a plugin without GetParamsLayout")
+	}
+}
+
+// TestGRPCClient_PassthroughCommands tests a plugin that declares its own passthrough commands.
+func TestGRPCClient_PassthroughCommands(t *testing.T) {
+	mockClient := &mockNetworkModuleClient{
+		passthroughFn: func(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PassthroughCommandsResponse, error) {
+			return &PassthroughCommandsResponse{Commands: []*PassthroughCommand{
+				{Name: "query", Aliases: []string{"q"}, Flags: []string{"node"}},
+			}}, nil
+		},
+	}
+	client := &GRPCClient{client: mockClient}
+
+	cmds := network.PassthroughCommandsFor(client)
+	if len(cmds) != 1 || cmds[0].Name != "query" || cmds[0].Aliases[0] != "q" || cmds[0].Flags[0] != network.PassthroughNode {
+		t.Errorf("unexpected passthrough commands: %+v", cmds)
+	}
+}
+
+// TestGRPCClient_PassthroughCommands_Unimplemented tests that older plugins get the default commands.
+func TestGRPCClient_PassthroughCommands_Unimplemented(t *testing.T) {
+	client := &GRPCClient{client: &mockNetworkModuleClient{}}
+
+	cmds := network.PassthroughCommandsFor(client)
+	if len(cmds) != len(network.DefaultPassthroughCommands()) {
+		t.Errorf("expected the default passthrough commands, got %+v", cmds)
+	}
+}
+
+// TestGRPCClient_ReloadableConfig tests a plugin whose binary reloads config keys.
+func TestGRPCClient_ReloadableConfig(t *testing.T) {
+	mockClient := &mockNetworkModuleClient{
+		reloadableConfigFn: func(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ReloadableConfigResponse, error) {
+			return &ReloadableConfigResponse{Keys: []string{"config.toml:log_level"}, Signal: int32(syscall.SIGINT)}, nil
+		},
+	}
+	client := &GRPCClient{client: mockClient}
+
+	keys, signal := client.ReloadableConfig()
+	if len(keys) != 1 || keys[0] != "config.toml:log_level" || signal != syscall.SIGINT {
+		t.Errorf("unexpected reloadable config: %v %v", keys, signal)
+	}
+}
+
+// TestGRPCClient_ReloadableConfig_Unimplemented tests that older plugins reload no keys.
+func TestGRPCClient_ReloadableConfig_Unimplemented(t *testing.T) {
+	client := &GRPCClient{client: &mockNetworkModuleClient{}}
+
+	if keys, _ := client.ReloadableConfig(); len(keys) != 0 {
+		t.Errorf("expected no reloadable keys, got %v", keys)
+	}
+}
+
+// TestGRPCClient_DecodeTx tests decoding a transaction through the plugin.
+func TestGRPCClient_DecodeTx(t *testing.T) {
+	mockClient := &mockNetworkModuleClient{
+		decodeTxFn: func(ctx context.Context, in *DecodeTxRequest, opts ...grpc.CallOption) (*DecodeTxResponse, error) {
+			if string(in.TxBytes) != "tx" {
+				t.Errorf("unexpected tx bytes: %q", in.TxBytes)
+			}
+			return &DecodeTxResponse{
+				Messages: []*DecodedMsg{{TypeUrl: "/mychain.fees.v1.MsgSetFee", Json: []byte(`{"fee":"1"}`)}},
+				Fee:      "5000stake",
+				GasLimit: 200000,
+			}, nil
+		},
+	}
+	client := &GRPCClient{client: mockClient}
+
+	tx, err := client.DecodeTx([]byte("tx"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tx.Messages) != 1 || tx.Messages[0].TypeURL != "/mychain.fees.v1.MsgSetFee" || string(tx.Messages[0].JSON) != `{"fee":"1"}` {
+		t.Errorf("unexpected messages: %+v", tx.Messages)
+	}
+	if tx.Fee != "5000stake" || tx.GasLimit != 200000 {
+		t.Errorf("unexpected fee: %s, gas %d", tx.Fee, tx.GasLimit)
+	}
+}
+
+// TestGRPCClient_DecodeTx_Unimplemented tests that older plugins report an error.
+func TestGRPCClient_DecodeTx_Unimplemented(t *testing.T) {
+	client := &GRPCClient{client: &mockNetworkModuleClient{}}
+
+	if _, err := client.DecodeTx([]byte("tx")); status.Code(err) != codes.Unimplemented {
+		t.Errorf("expected Unimplemented, got %v", err)
+	}
+}
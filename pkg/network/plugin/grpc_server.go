@@ -369,10 +369,6 @@ func (s *GRPCServer) ModifyGenesisFile(ctx context.Context, req *ModifyGenesisFi
 func (s *GRPCServer) GetGovernanceParams(ctx context.Context, req *GovernanceParamsRequest) (*GovernanceParamsResponse, error) {
 	// Check if plugin implements GetGovernanceParams
 	// This is a type assertion to see if the underlying network.Module supports this method
-	type govParamsProvider interface {
-		GetGovernanceParams(rpcEndpoint, networkType string) (*GovernanceParamsResponse, error)
-	}
-
 	if gpp, ok := s.impl.(govParamsProvider); ok {
 		return gpp.GetGovernanceParams(req.RpcEndpoint, req.NetworkType)
 	}
@@ -382,6 +378,59 @@ func (s *GRPCServer) GetGovernanceParams(ctx context.Context, req *GovernancePar
 	return nil, status.Errorf(codes.Unimplemented, "method GetGovernanceParams not implemented")
 }
 
+// GetParamsLayout returns a module's params layout if the plugin implements
+// network.ParamsLayoutProvider.
+func (s *GRPCServer) GetParamsLayout(ctx context.Context, req *ParamsLayoutRequest) (*ParamsLayoutResponse, error) {
+	provider, ok := s.impl.(network.ParamsLayoutProvider)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "method GetParamsLayout not implemented")
+	}
+	layout, found := provider.ParamsLayout(req.Module)
+	if !found {
+		return &ParamsLayoutResponse{}, nil
+	}
+	return &ParamsLayoutResponse{
+		Found:         true,
+		QueryPath:     layout.QueryPath,
+		ResponseField: layout.ResponseField,
+		MsgTypeUrl:    layout.MsgTypeURL,
+		MsgField:      layout.MsgField,
+	}, nil
+}
+
+// GetPassthroughCommands returns the plugin's passthrough commands if it
+// implements network.PassthroughProvider.
+func (s *GRPCServer) GetPassthroughCommands(ctx context.Context, req *Empty) (*PassthroughCommandsResponse, error) {
+	provider, ok := s.impl.(network.PassthroughProvider)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "method GetPassthroughCommands not implemented")
+	}
+	resp := &PassthroughCommandsResponse{}
+	for _, c := range provider.PassthroughCommands() {
+		flags := make([]string, len(c.Flags))
+		for i, f := range c.Flags {
+			flags[i] = string(f)
+		}
+		resp.Commands = append(resp.Commands, &PassthroughCommand{
+			Name:    c.Name,
+			Aliases: c.Aliases,
+			Flags:   flags,
+		})
+	}
+	return resp, nil
+}
+
+// GetReloadableConfig returns the config keys the plugin's binary reloads if
+// it implements network.ConfigReloader.
+func (s *GRPCServer) GetReloadableConfig(ctx context.Context, req *Empty) (*ReloadableConfigResponse, error) {
+	reloader, ok := s.impl.(network.ConfigReloader)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "method GetReloadableConfig not implemented")
+	}
+	keys, signal := reloader.ReloadableConfig()
+	return &ReloadableConfigResponse{Keys: keys, Signal: int32(signal)}, nil
+}
+
 // RPC Operations - All blockchain RPC operations delegated to plugins.
 // These methods use type assertions to check if the plugin implements the optional interface,
 // returning Unimplemented error for backward compatibility with older plugins.
@@ -453,6 +502,24 @@ func (s *GRPCServer) GetAppVersion(ctx context.Context, req *AppVersionRequest)
 	return nil, status.Errorf(codes.Unimplemented, "method GetAppVersion not implemented")
 }
 
+// DecodeTx decodes a transaction if the plugin implements network.TxDecoder.
+func (s *GRPCServer) DecodeTx(ctx context.Context, req *DecodeTxRequest) (*DecodeTxResponse, error) {
+	decoder, ok := s.impl.(network.TxDecoder)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "method DecodeTx not implemented")
+	}
+	tx, err := decoder.DecodeTx(req.TxBytes)
+	if err != nil {
+		return &DecodeTxResponse{Error: err.Error()}, nil
+	}
+
+	resp := &DecodeTxResponse{Memo: tx.Memo, Fee: tx.Fee, GasLimit: tx.GasLimit}
+	for _, msg := range tx.Messages {
+		resp.Messages = append(resp.Messages, &DecodedMsg{TypeUrl: msg.TypeURL, Json: msg.JSON})
+	}
+	return resp, nil
+}
+
 // TxBuilder Operations
 
 // CreateTxBuilder creates a new TxBuilder instance.
@@ -15,6 +15,7 @@ import (
 
 	"github.com/altuslabsxyz/devnet-builder/internal/paths"
 	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"github.com/altuslabsxyz/devnet-builder/pkg/network/plugin/wasm"
 )
 
 // =============================================================================
@@ -123,7 +124,8 @@ func (c VersionConstraint) CheckVersion(version string) error {
 
 // PluginClient represents a loaded plugin client.
 type PluginClient struct {
-	client *hcplugin.Client
+	sup    *supervisor  // process plugins
+	wasm   *wasm.Module // WebAssembly plugins
 	module network.Module
 	name   string
 }
@@ -138,10 +140,44 @@ func (p *PluginClient) Name() string {
 	return p.name
 }
 
+// CallStats returns per-method call and failure counts for the plugin's RPC
+// delegation methods, or nil if the module does not collect them.
+func (p *PluginClient) CallStats() []MethodStats {
+	if gc, ok := p.module.(*GRPCClient); ok {
+		return gc.CallStats()
+	}
+	return nil
+}
+
+// Capabilities returns the protocol version and capabilities negotiated
+// with the plugin.
+func (p *PluginClient) Capabilities() Capabilities {
+	if caps, ok := ModuleCapabilities(p.module); ok {
+		return caps
+	}
+	return Capabilities{ProtocolVersion: ProtocolVersion}
+}
+
+// ModuleCapabilities returns the capabilities of a module loaded from a
+// plugin, or false if module was not loaded by a Loader.
+func ModuleCapabilities(module network.Module) (Capabilities, bool) {
+	switch m := module.(type) {
+	case *GRPCClient:
+		return m.Capabilities(), true
+	case *wasm.Module:
+		return Capabilities{ProtocolVersion: ProtocolVersion, Supported: []Capability{CapabilityWASM}}, true
+	default:
+		return Capabilities{}, false
+	}
+}
+
 // Close cleanly shuts down the plugin.
 func (p *PluginClient) Close() {
-	if p.client != nil {
-		p.client.Kill()
+	if p.sup != nil {
+		p.sup.close()
+	}
+	if p.wasm != nil {
+		p.wasm.Close()
 	}
 }
 
@@ -152,6 +188,9 @@ type Loader struct {
 	logger            hclog.Logger
 	plugins           map[string]*PluginClient
 	versionConstraint VersionConstraint
+	strict            bool
+	workDir           string
+	onCrash           func(*CrashError)
 }
 
 // LoaderOption is a functional option for configuring a Loader.
@@ -171,6 +210,34 @@ func WithVersionConstraint(constraint VersionConstraint) LoaderOption {
 	}
 }
 
+// WithStrictResponses makes loaded plugins return a *ResponseError when an
+// RPC delegation response has its Error field populated, instead of leaving
+// the caller to check the field.
+func WithStrictResponses(strict bool) LoaderOption {
+	return func(l *Loader) {
+		l.strict = strict
+	}
+}
+
+// WithWorkDir gives each plugin a scoped working directory: its own
+// subdirectory of dir, with HOME and TMPDIR pointing into it. This only
+// changes where relative, home and temp paths resolve. The plugin still
+// runs as the daemon's user and can access anything the daemon can.
+func WithWorkDir(dir string) LoaderOption {
+	return func(l *Loader) {
+		l.workDir = dir
+	}
+}
+
+// WithCrashHandler sets a function called whenever a loaded plugin crashes
+// or panics while serving a call, in place of the loader's own crash log.
+// Crashed plugins are restarted with backoff on their next call.
+func WithCrashHandler(fn func(*CrashError)) LoaderOption {
+	return func(l *Loader) {
+		l.onCrash = fn
+	}
+}
+
 // WithPluginDirs adds additional plugin directories.
 func WithPluginDirs(dirs ...string) LoaderOption {
 	return func(l *Loader) {
@@ -251,25 +318,8 @@ func (l *Loader) Discover() ([]string, error) {
 				continue
 			}
 
-			name := entry.Name()
-			// Plugin binaries should be named <network>-plugin
-			if !strings.HasSuffix(name, "-plugin") {
-				continue
-			}
-
-			// Extract network name
-			networkName := strings.TrimSuffix(name, "-plugin")
-			if seen[networkName] {
-				continue
-			}
-
-			// Check if executable
-			path := filepath.Join(dir, name)
-			info, err := os.Stat(path)
-			if err != nil {
-				continue
-			}
-			if info.Mode()&0111 == 0 {
+			networkName, _, ok := pluginFile(dir, entry.Name())
+			if !ok || seen[networkName] {
 				continue
 			}
 
@@ -305,29 +355,15 @@ func (l *Loader) DiscoverWithInfo() ([]PluginInfo, error) {
 				continue
 			}
 
-			name := entry.Name()
-			if !strings.HasSuffix(name, "-plugin") {
-				continue
-			}
-
-			networkName := strings.TrimSuffix(name, "-plugin")
-			if seen[networkName] {
-				continue
-			}
-
-			path := filepath.Join(dir, name)
-			info, err := os.Stat(path)
-			if err != nil {
-				continue
-			}
-			if info.Mode()&0111 == 0 {
+			networkName, info, ok := pluginFile(dir, entry.Name())
+			if !ok || seen[networkName] {
 				continue
 			}
 
 			seen[networkName] = true
 			plugins = append(plugins, PluginInfo{
 				Name:    networkName,
-				Path:    path,
+				Path:    filepath.Join(dir, entry.Name()),
 				Size:    info.Size(),
 				ModTime: info.ModTime().Unix(),
 			})
@@ -358,62 +394,48 @@ func (l *Loader) loadLocked(name string) (*PluginClient, error) {
 		return nil, &PluginError{Op: "find", PluginName: name, Err: err}
 	}
 
-	// Create the plugin client
-	client := hcplugin.NewClient(&hcplugin.ClientConfig{
-		HandshakeConfig: Handshake,
-		Plugins: map[string]hcplugin.Plugin{
-			"network": &NetworkModulePlugin{},
-		},
-		Cmd:              exec.Command(pluginPath),
-		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
-		Logger:           l.logger,
-	})
-
-	// Connect via RPC
-	rpcClient, err := client.Client()
-	if err != nil {
-		client.Kill()
-		return nil, &PluginError{Op: "connect", PluginName: name, Err: err}
+	if strings.HasSuffix(pluginPath, wasmSuffix) {
+		return l.loadWASMLocked(name, pluginPath)
 	}
 
-	// Request the plugin
-	raw, err := rpcClient.Dispense("network")
-	if err != nil {
-		client.Kill()
-		return nil, &PluginError{Op: "dispense", PluginName: name, Err: err}
+	// Start the plugin under supervision
+	sup := newSupervisor(name, l.processStarter(name, pluginPath), l.logger, l.onCrash)
+	if err := sup.launch(); err != nil {
+		return nil, err
 	}
 
-	module, ok := raw.(network.Module)
-	if !ok {
-		client.Kill()
-		return nil, &PluginError{
-			Op:         "type-assertion",
-			PluginName: name,
-			Err:        fmt.Errorf("does not implement network.Module"),
-		}
-	}
+	gc := newGRPCClient(sup)
+	gc.SetStrict(name, l.strict)
+	var module network.Module = gc
 
 	// Validate version compatibility
 	version := module.Version()
 	if err := l.versionConstraint.CheckVersion(version); err != nil {
-		client.Kill()
+		sup.close()
 		return nil, &PluginError{Op: "version-check", PluginName: name, Err: err}
 	}
 
 	// Validate the module
 	if err := module.Validate(); err != nil {
-		client.Kill()
+		sup.close()
 		return nil, &PluginError{Op: "validate", PluginName: name, Err: err}
 	}
 
 	pc := &PluginClient{
-		client: client,
+		sup:    sup,
 		module: module,
 		name:   name,
 	}
 
+	caps := pc.Capabilities()
+	if caps.ProtocolVersion > ProtocolVersion {
+		l.logger.Warn("plugin uses a newer protocol; methods it added are unavailable",
+			"name", name, "plugin_protocol", caps.ProtocolVersion, "host_protocol", ProtocolVersion)
+	}
+
 	l.plugins[name] = pc
-	l.logger.Info("plugin loaded successfully", "name", name, "version", version)
+	l.logger.Info("plugin loaded successfully", "name", name, "version", version,
+		"protocol", caps.ProtocolVersion, "capabilities", caps.String())
 	return pc, nil
 }
 
@@ -578,6 +600,21 @@ func (l *Loader) ValidatePlugin(name string) error {
 		return &PluginError{Op: "validate-find", PluginName: name, Err: ErrPluginNotFound}
 	}
 
+	if strings.HasSuffix(pluginPath, wasmSuffix) {
+		module, err := l.openWASM(pluginPath)
+		if err != nil {
+			return &PluginError{Op: "validate-load", PluginName: name, Err: err}
+		}
+		defer module.Close()
+		if err := l.versionConstraint.CheckVersion(module.Version()); err != nil {
+			return &PluginError{Op: "validate-version", PluginName: name, Err: err}
+		}
+		if err := module.Validate(); err != nil {
+			return &PluginError{Op: "validate-module", PluginName: name, Err: err}
+		}
+		return nil
+	}
+
 	// Create a temporary client for validation
 	client := hcplugin.NewClient(&hcplugin.ClientConfig{
 		HandshakeConfig: Handshake,
@@ -642,6 +679,15 @@ func (l *Loader) GetPluginVersion(name string) (string, error) {
 		return "", &PluginError{Op: "get-version", PluginName: name, Err: ErrPluginNotFound}
 	}
 
+	if strings.HasSuffix(pluginPath, wasmSuffix) {
+		module, err := l.openWASM(pluginPath)
+		if err != nil {
+			return "", &PluginError{Op: "get-version-load", PluginName: name, Err: err}
+		}
+		defer module.Close()
+		return module.Version(), nil
+	}
+
 	client := hcplugin.NewClient(&hcplugin.ClientConfig{
 		HandshakeConfig: Handshake,
 		Plugins: map[string]hcplugin.Plugin{
@@ -711,14 +757,38 @@ func (l *Loader) Reload(name string) (*PluginClient, error) {
 // Internal Methods
 // =============================================================================
 
+// pluginFile reports whether the file name in dir is a plugin: an
+// executable named <network>-plugin, or a WebAssembly module named
+// <network>-plugin.wasm. It returns the network name and file info.
+func pluginFile(dir, name string) (string, os.FileInfo, bool) {
+	networkName, isWASM := strings.CutSuffix(name, "-plugin"+wasmSuffix)
+	if !isWASM {
+		var ok bool
+		if networkName, ok = strings.CutSuffix(name, "-plugin"); !ok {
+			return "", nil, false
+		}
+	}
+
+	info, err := os.Stat(filepath.Join(dir, name))
+	if err != nil || info.IsDir() {
+		return "", nil, false
+	}
+	// Plugin binaries must be executable; WebAssembly modules need not be
+	if !isWASM && info.Mode()&0111 == 0 {
+		return "", nil, false
+	}
+	return networkName, info, true
+}
+
 // findPluginLocked finds the plugin binary path (caller must hold lock).
+// In each directory, a process plugin takes precedence over a WebAssembly
+// plugin of the same name.
 func (l *Loader) findPluginLocked(name string) (string, error) {
-	binaryName := name + "-plugin"
-
 	for _, dir := range l.pluginDirs {
-		path := filepath.Join(dir, binaryName)
-		if info, err := os.Stat(path); err == nil && info.Mode()&0111 != 0 {
-			return path, nil
+		for _, file := range []string{name + "-plugin", name + "-plugin" + wasmSuffix} {
+			if _, _, ok := pluginFile(dir, file); ok {
+				return filepath.Join(dir, file), nil
+			}
 		}
 	}
 
@@ -1597,6 +1597,352 @@ func (x *GovernanceParamsResponse) GetError() string {
 	return ""
 }
 
+// ParamsLayoutRequest requests the params layout of a chain module.
+type ParamsLayoutRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// module is the module name (e.g., "staking").
+	Module        string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParamsLayoutRequest) Reset() {
+	*x = ParamsLayoutRequest{}
+	mi := &file_network_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParamsLayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParamsLayoutRequest) ProtoMessage() {}
+
+func (x *ParamsLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParamsLayoutRequest.ProtoReflect.Descriptor instead.
+func (*ParamsLayoutRequest) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ParamsLayoutRequest) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+// ParamsLayoutResponse describes a module's params layout.
+// found is false when the plugin uses the Cosmos SDK default for the module.
+type ParamsLayoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Found         bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	QueryPath     string                 `protobuf:"bytes,2,opt,name=query_path,json=queryPath,proto3" json:"query_path,omitempty"`
+	ResponseField string                 `protobuf:"bytes,3,opt,name=response_field,json=responseField,proto3" json:"response_field,omitempty"`
+	MsgTypeUrl    string                 `protobuf:"bytes,4,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	MsgField      string                 `protobuf:"bytes,5,opt,name=msg_field,json=msgField,proto3" json:"msg_field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParamsLayoutResponse) Reset() {
+	*x = ParamsLayoutResponse{}
+	mi := &file_network_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParamsLayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParamsLayoutResponse) ProtoMessage() {}
+
+func (x *ParamsLayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParamsLayoutResponse.ProtoReflect.Descriptor instead.
+func (*ParamsLayoutResponse) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ParamsLayoutResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *ParamsLayoutResponse) GetQueryPath() string {
+	if x != nil {
+		return x.QueryPath
+	}
+	return ""
+}
+
+func (x *ParamsLayoutResponse) GetResponseField() string {
+	if x != nil {
+		return x.ResponseField
+	}
+	return ""
+}
+
+func (x *ParamsLayoutResponse) GetMsgTypeUrl() string {
+	if x != nil {
+		return x.MsgTypeUrl
+	}
+	return ""
+}
+
+func (x *ParamsLayoutResponse) GetMsgField() string {
+	if x != nil {
+		return x.MsgField
+	}
+	return ""
+}
+
+// CapabilitiesResponse is the plugin's side of capability negotiation.
+type CapabilitiesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// protocol_version is the plugin protocol version the plugin was built with.
+	ProtocolVersion int32 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// capabilities are the optional features the plugin supports
+	// (e.g., "gov-params", "evm", "state-sync").
+	Capabilities  []string `protobuf:"bytes,2,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapabilitiesResponse) Reset() {
+	*x = CapabilitiesResponse{}
+	mi := &file_network_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapabilitiesResponse) ProtoMessage() {}
+
+func (x *CapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*CapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CapabilitiesResponse) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *CapabilitiesResponse) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+// PassthroughCommand declares a binary subcommand devnet-builder passes through.
+type PassthroughCommand struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the subcommand (e.g., "query").
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// aliases are other names of the subcommand (e.g., "q").
+	Aliases []string `protobuf:"bytes,2,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// flags are injected unless passed: "home", "node" or "chain-id".
+	Flags         []string `protobuf:"bytes,3,rep,name=flags,proto3" json:"flags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PassthroughCommand) Reset() {
+	*x = PassthroughCommand{}
+	mi := &file_network_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PassthroughCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassthroughCommand) ProtoMessage() {}
+
+func (x *PassthroughCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassthroughCommand.ProtoReflect.Descriptor instead.
+func (*PassthroughCommand) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PassthroughCommand) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PassthroughCommand) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *PassthroughCommand) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+// PassthroughCommandsResponse lists a plugin's passthrough commands.
+// An empty list uses the Cosmos SDK defaults.
+type PassthroughCommandsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Commands      []*PassthroughCommand  `protobuf:"bytes,1,rep,name=commands,proto3" json:"commands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PassthroughCommandsResponse) Reset() {
+	*x = PassthroughCommandsResponse{}
+	mi := &file_network_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PassthroughCommandsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassthroughCommandsResponse) ProtoMessage() {}
+
+func (x *PassthroughCommandsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassthroughCommandsResponse.ProtoReflect.Descriptor instead.
+func (*PassthroughCommandsResponse) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PassthroughCommandsResponse) GetCommands() []*PassthroughCommand {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+// ReloadableConfigResponse lists the config keys a node reloads in place.
+type ReloadableConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// keys are "<file>:<dotted.key>" (e.g., "config.toml:log_level").
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	// signal is the signal number that makes the node reload them;
+	// 0 means SIGHUP.
+	Signal        int32 `protobuf:"varint,2,opt,name=signal,proto3" json:"signal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadableConfigResponse) Reset() {
+	*x = ReloadableConfigResponse{}
+	mi := &file_network_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadableConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadableConfigResponse) ProtoMessage() {}
+
+func (x *ReloadableConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadableConfigResponse.ProtoReflect.Descriptor instead.
+func (*ReloadableConfigResponse) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReloadableConfigResponse) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *ReloadableConfigResponse) GetSignal() int32 {
+	if x != nil {
+		return x.Signal
+	}
+	return 0
+}
+
 // BlockHeightRequest requests current block height from a network plugin.
 type BlockHeightRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1607,7 +1953,7 @@ type BlockHeightRequest struct {
 
 func (x *BlockHeightRequest) Reset() {
 	*x = BlockHeightRequest{}
-	mi := &file_network_proto_msgTypes[23]
+	mi := &file_network_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1619,7 +1965,7 @@ func (x *BlockHeightRequest) String() string {
 func (*BlockHeightRequest) ProtoMessage() {}
 
 func (x *BlockHeightRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[23]
+	mi := &file_network_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1632,7 +1978,7 @@ func (x *BlockHeightRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockHeightRequest.ProtoReflect.Descriptor instead.
 func (*BlockHeightRequest) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{23}
+	return file_network_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *BlockHeightRequest) GetRpcEndpoint() string {
@@ -1653,7 +1999,7 @@ type BlockHeightResponse struct {
 
 func (x *BlockHeightResponse) Reset() {
 	*x = BlockHeightResponse{}
-	mi := &file_network_proto_msgTypes[24]
+	mi := &file_network_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1665,7 +2011,7 @@ func (x *BlockHeightResponse) String() string {
 func (*BlockHeightResponse) ProtoMessage() {}
 
 func (x *BlockHeightResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[24]
+	mi := &file_network_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1678,7 +2024,7 @@ func (x *BlockHeightResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockHeightResponse.ProtoReflect.Descriptor instead.
 func (*BlockHeightResponse) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{24}
+	return file_network_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *BlockHeightResponse) GetHeight() int64 {
@@ -1706,7 +2052,7 @@ type BlockTimeRequest struct {
 
 func (x *BlockTimeRequest) Reset() {
 	*x = BlockTimeRequest{}
-	mi := &file_network_proto_msgTypes[25]
+	mi := &file_network_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1718,7 +2064,7 @@ func (x *BlockTimeRequest) String() string {
 func (*BlockTimeRequest) ProtoMessage() {}
 
 func (x *BlockTimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[25]
+	mi := &file_network_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1731,7 +2077,7 @@ func (x *BlockTimeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockTimeRequest.ProtoReflect.Descriptor instead.
 func (*BlockTimeRequest) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{25}
+	return file_network_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *BlockTimeRequest) GetRpcEndpoint() string {
@@ -1759,7 +2105,7 @@ type BlockTimeResponse struct {
 
 func (x *BlockTimeResponse) Reset() {
 	*x = BlockTimeResponse{}
-	mi := &file_network_proto_msgTypes[26]
+	mi := &file_network_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1771,7 +2117,7 @@ func (x *BlockTimeResponse) String() string {
 func (*BlockTimeResponse) ProtoMessage() {}
 
 func (x *BlockTimeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[26]
+	mi := &file_network_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1784,7 +2130,7 @@ func (x *BlockTimeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockTimeResponse.ProtoReflect.Descriptor instead.
 func (*BlockTimeResponse) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{26}
+	return file_network_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *BlockTimeResponse) GetBlockTimeNs() int64 {
@@ -1811,7 +2157,7 @@ type ChainStatusRequest struct {
 
 func (x *ChainStatusRequest) Reset() {
 	*x = ChainStatusRequest{}
-	mi := &file_network_proto_msgTypes[27]
+	mi := &file_network_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1823,7 +2169,7 @@ func (x *ChainStatusRequest) String() string {
 func (*ChainStatusRequest) ProtoMessage() {}
 
 func (x *ChainStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[27]
+	mi := &file_network_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1836,7 +2182,7 @@ func (x *ChainStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChainStatusRequest.ProtoReflect.Descriptor instead.
 func (*ChainStatusRequest) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{27}
+	return file_network_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ChainStatusRequest) GetRpcEndpoint() string {
@@ -1857,7 +2203,7 @@ type ChainStatusResponse struct {
 
 func (x *ChainStatusResponse) Reset() {
 	*x = ChainStatusResponse{}
-	mi := &file_network_proto_msgTypes[28]
+	mi := &file_network_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1869,7 +2215,7 @@ func (x *ChainStatusResponse) String() string {
 func (*ChainStatusResponse) ProtoMessage() {}
 
 func (x *ChainStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[28]
+	mi := &file_network_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1882,7 +2228,7 @@ func (x *ChainStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChainStatusResponse.ProtoReflect.Descriptor instead.
 func (*ChainStatusResponse) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{28}
+	return file_network_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ChainStatusResponse) GetIsRunning() bool {
@@ -1911,7 +2257,7 @@ type WaitForBlockRequest struct {
 
 func (x *WaitForBlockRequest) Reset() {
 	*x = WaitForBlockRequest{}
-	mi := &file_network_proto_msgTypes[29]
+	mi := &file_network_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1923,7 +2269,7 @@ func (x *WaitForBlockRequest) String() string {
 func (*WaitForBlockRequest) ProtoMessage() {}
 
 func (x *WaitForBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[29]
+	mi := &file_network_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1936,7 +2282,7 @@ func (x *WaitForBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WaitForBlockRequest.ProtoReflect.Descriptor instead.
 func (*WaitForBlockRequest) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{29}
+	return file_network_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *WaitForBlockRequest) GetRpcEndpoint() string {
@@ -1972,7 +2318,7 @@ type WaitForBlockResponse struct {
 
 func (x *WaitForBlockResponse) Reset() {
 	*x = WaitForBlockResponse{}
-	mi := &file_network_proto_msgTypes[30]
+	mi := &file_network_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1984,7 +2330,7 @@ func (x *WaitForBlockResponse) String() string {
 func (*WaitForBlockResponse) ProtoMessage() {}
 
 func (x *WaitForBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[30]
+	mi := &file_network_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1997,7 +2343,7 @@ func (x *WaitForBlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WaitForBlockResponse.ProtoReflect.Descriptor instead.
 func (*WaitForBlockResponse) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{30}
+	return file_network_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *WaitForBlockResponse) GetCurrentHeight() int64 {
@@ -2032,7 +2378,7 @@ type ProposalRequest struct {
 
 func (x *ProposalRequest) Reset() {
 	*x = ProposalRequest{}
-	mi := &file_network_proto_msgTypes[31]
+	mi := &file_network_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2044,7 +2390,7 @@ func (x *ProposalRequest) String() string {
 func (*ProposalRequest) ProtoMessage() {}
 
 func (x *ProposalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[31]
+	mi := &file_network_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2057,7 +2403,7 @@ func (x *ProposalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProposalRequest.ProtoReflect.Descriptor instead.
 func (*ProposalRequest) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{31}
+	return file_network_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ProposalRequest) GetRpcEndpoint() string {
@@ -2095,7 +2441,7 @@ type ProposalResponse struct {
 
 func (x *ProposalResponse) Reset() {
 	*x = ProposalResponse{}
-	mi := &file_network_proto_msgTypes[32]
+	mi := &file_network_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2107,7 +2453,7 @@ func (x *ProposalResponse) String() string {
 func (*ProposalResponse) ProtoMessage() {}
 
 func (x *ProposalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[32]
+	mi := &file_network_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2120,7 +2466,7 @@ func (x *ProposalResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProposalResponse.ProtoReflect.Descriptor instead.
 func (*ProposalResponse) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{32}
+	return file_network_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ProposalResponse) GetId() uint64 {
@@ -2217,7 +2563,7 @@ type UpgradePlanRequest struct {
 
 func (x *UpgradePlanRequest) Reset() {
 	*x = UpgradePlanRequest{}
-	mi := &file_network_proto_msgTypes[33]
+	mi := &file_network_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2229,7 +2575,7 @@ func (x *UpgradePlanRequest) String() string {
 func (*UpgradePlanRequest) ProtoMessage() {}
 
 func (x *UpgradePlanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[33]
+	mi := &file_network_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2242,7 +2588,7 @@ func (x *UpgradePlanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpgradePlanRequest.ProtoReflect.Descriptor instead.
 func (*UpgradePlanRequest) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{33}
+	return file_network_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *UpgradePlanRequest) GetRpcEndpoint() string {
@@ -2267,7 +2613,7 @@ type UpgradePlanResponse struct {
 
 func (x *UpgradePlanResponse) Reset() {
 	*x = UpgradePlanResponse{}
-	mi := &file_network_proto_msgTypes[34]
+	mi := &file_network_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2279,7 +2625,7 @@ func (x *UpgradePlanResponse) String() string {
 func (*UpgradePlanResponse) ProtoMessage() {}
 
 func (x *UpgradePlanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[34]
+	mi := &file_network_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2292,7 +2638,7 @@ func (x *UpgradePlanResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpgradePlanResponse.ProtoReflect.Descriptor instead.
 func (*UpgradePlanResponse) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{34}
+	return file_network_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *UpgradePlanResponse) GetName() string {
@@ -2347,7 +2693,7 @@ type AppVersionRequest struct {
 
 func (x *AppVersionRequest) Reset() {
 	*x = AppVersionRequest{}
-	mi := &file_network_proto_msgTypes[35]
+	mi := &file_network_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2359,7 +2705,7 @@ func (x *AppVersionRequest) String() string {
 func (*AppVersionRequest) ProtoMessage() {}
 
 func (x *AppVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[35]
+	mi := &file_network_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2372,7 +2718,7 @@ func (x *AppVersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppVersionRequest.ProtoReflect.Descriptor instead.
 func (*AppVersionRequest) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{35}
+	return file_network_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *AppVersionRequest) GetRpcEndpoint() string {
@@ -2393,7 +2739,7 @@ type AppVersionResponse struct {
 
 func (x *AppVersionResponse) Reset() {
 	*x = AppVersionResponse{}
-	mi := &file_network_proto_msgTypes[36]
+	mi := &file_network_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2405,7 +2751,7 @@ func (x *AppVersionResponse) String() string {
 func (*AppVersionResponse) ProtoMessage() {}
 
 func (x *AppVersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[36]
+	mi := &file_network_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2418,7 +2764,7 @@ func (x *AppVersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppVersionResponse.ProtoReflect.Descriptor instead.
 func (*AppVersionResponse) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{36}
+	return file_network_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *AppVersionResponse) GetVersion() string {
@@ -2446,7 +2792,7 @@ type SDKVersion struct {
 
 func (x *SDKVersion) Reset() {
 	*x = SDKVersion{}
-	mi := &file_network_proto_msgTypes[37]
+	mi := &file_network_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2458,7 +2804,7 @@ func (x *SDKVersion) String() string {
 func (*SDKVersion) ProtoMessage() {}
 
 func (x *SDKVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[37]
+	mi := &file_network_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2471,7 +2817,7 @@ func (x *SDKVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SDKVersion.ProtoReflect.Descriptor instead.
 func (*SDKVersion) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{37}
+	return file_network_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *SDKVersion) GetFramework() string {
@@ -2506,7 +2852,7 @@ type CreateTxBuilderRequest struct {
 
 func (x *CreateTxBuilderRequest) Reset() {
 	*x = CreateTxBuilderRequest{}
-	mi := &file_network_proto_msgTypes[38]
+	mi := &file_network_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2518,7 +2864,7 @@ func (x *CreateTxBuilderRequest) String() string {
 func (*CreateTxBuilderRequest) ProtoMessage() {}
 
 func (x *CreateTxBuilderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[38]
+	mi := &file_network_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2531,7 +2877,7 @@ func (x *CreateTxBuilderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTxBuilderRequest.ProtoReflect.Descriptor instead.
 func (*CreateTxBuilderRequest) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{38}
+	return file_network_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *CreateTxBuilderRequest) GetRpcEndpoint() string {
@@ -2565,7 +2911,7 @@ type CreateTxBuilderResponse struct {
 
 func (x *CreateTxBuilderResponse) Reset() {
 	*x = CreateTxBuilderResponse{}
-	mi := &file_network_proto_msgTypes[39]
+	mi := &file_network_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2577,7 +2923,7 @@ func (x *CreateTxBuilderResponse) String() string {
 func (*CreateTxBuilderResponse) ProtoMessage() {}
 
 func (x *CreateTxBuilderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[39]
+	mi := &file_network_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2590,7 +2936,7 @@ func (x *CreateTxBuilderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTxBuilderResponse.ProtoReflect.Descriptor instead.
 func (*CreateTxBuilderResponse) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{39}
+	return file_network_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *CreateTxBuilderResponse) GetBuilderId() string {
@@ -2623,7 +2969,7 @@ type BuildTxRequest struct {
 
 func (x *BuildTxRequest) Reset() {
 	*x = BuildTxRequest{}
-	mi := &file_network_proto_msgTypes[40]
+	mi := &file_network_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2635,7 +2981,7 @@ func (x *BuildTxRequest) String() string {
 func (*BuildTxRequest) ProtoMessage() {}
 
 func (x *BuildTxRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[40]
+	mi := &file_network_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2648,7 +2994,7 @@ func (x *BuildTxRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildTxRequest.ProtoReflect.Descriptor instead.
 func (*BuildTxRequest) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{40}
+	return file_network_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *BuildTxRequest) GetBuilderId() string {
@@ -2720,7 +3066,7 @@ type BuildTxResponse struct {
 
 func (x *BuildTxResponse) Reset() {
 	*x = BuildTxResponse{}
-	mi := &file_network_proto_msgTypes[41]
+	mi := &file_network_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2732,7 +3078,7 @@ func (x *BuildTxResponse) String() string {
 func (*BuildTxResponse) ProtoMessage() {}
 
 func (x *BuildTxResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[41]
+	mi := &file_network_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2745,7 +3091,7 @@ func (x *BuildTxResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildTxResponse.ProtoReflect.Descriptor instead.
 func (*BuildTxResponse) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{41}
+	return file_network_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *BuildTxResponse) GetTxBytes() []byte {
@@ -2794,7 +3140,7 @@ type SigningKeyProto struct {
 
 func (x *SigningKeyProto) Reset() {
 	*x = SigningKeyProto{}
-	mi := &file_network_proto_msgTypes[42]
+	mi := &file_network_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2806,7 +3152,7 @@ func (x *SigningKeyProto) String() string {
 func (*SigningKeyProto) ProtoMessage() {}
 
 func (x *SigningKeyProto) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[42]
+	mi := &file_network_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2819,7 +3165,7 @@ func (x *SigningKeyProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SigningKeyProto.ProtoReflect.Descriptor instead.
 func (*SigningKeyProto) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{42}
+	return file_network_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *SigningKeyProto) GetAddress() string {
@@ -2857,7 +3203,7 @@ type SignTxRequest struct {
 
 func (x *SignTxRequest) Reset() {
 	*x = SignTxRequest{}
-	mi := &file_network_proto_msgTypes[43]
+	mi := &file_network_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2869,7 +3215,7 @@ func (x *SignTxRequest) String() string {
 func (*SignTxRequest) ProtoMessage() {}
 
 func (x *SignTxRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[43]
+	mi := &file_network_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2882,7 +3228,7 @@ func (x *SignTxRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignTxRequest.ProtoReflect.Descriptor instead.
 func (*SignTxRequest) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{43}
+	return file_network_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *SignTxRequest) GetBuilderId() string {
@@ -2939,7 +3285,7 @@ type SignTxResponse struct {
 
 func (x *SignTxResponse) Reset() {
 	*x = SignTxResponse{}
-	mi := &file_network_proto_msgTypes[44]
+	mi := &file_network_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2951,7 +3297,7 @@ func (x *SignTxResponse) String() string {
 func (*SignTxResponse) ProtoMessage() {}
 
 func (x *SignTxResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[44]
+	mi := &file_network_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2964,7 +3310,7 @@ func (x *SignTxResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignTxResponse.ProtoReflect.Descriptor instead.
 func (*SignTxResponse) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{44}
+	return file_network_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *SignTxResponse) GetTxBytes() []byte {
@@ -3005,7 +3351,7 @@ type BroadcastTxRequest struct {
 
 func (x *BroadcastTxRequest) Reset() {
 	*x = BroadcastTxRequest{}
-	mi := &file_network_proto_msgTypes[45]
+	mi := &file_network_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3017,7 +3363,7 @@ func (x *BroadcastTxRequest) String() string {
 func (*BroadcastTxRequest) ProtoMessage() {}
 
 func (x *BroadcastTxRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[45]
+	mi := &file_network_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3030,7 +3376,7 @@ func (x *BroadcastTxRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BroadcastTxRequest.ProtoReflect.Descriptor instead.
 func (*BroadcastTxRequest) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{45}
+	return file_network_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *BroadcastTxRequest) GetBuilderId() string {
@@ -3060,7 +3406,7 @@ type BroadcastTxResponse struct {
 
 func (x *BroadcastTxResponse) Reset() {
 	*x = BroadcastTxResponse{}
-	mi := &file_network_proto_msgTypes[46]
+	mi := &file_network_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3072,7 +3418,7 @@ func (x *BroadcastTxResponse) String() string {
 func (*BroadcastTxResponse) ProtoMessage() {}
 
 func (x *BroadcastTxResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[46]
+	mi := &file_network_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3085,7 +3431,7 @@ func (x *BroadcastTxResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BroadcastTxResponse.ProtoReflect.Descriptor instead.
 func (*BroadcastTxResponse) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{46}
+	return file_network_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *BroadcastTxResponse) GetTxHash() string {
@@ -3132,7 +3478,7 @@ type DestroyTxBuilderRequest struct {
 
 func (x *DestroyTxBuilderRequest) Reset() {
 	*x = DestroyTxBuilderRequest{}
-	mi := &file_network_proto_msgTypes[47]
+	mi := &file_network_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3144,7 +3490,7 @@ func (x *DestroyTxBuilderRequest) String() string {
 func (*DestroyTxBuilderRequest) ProtoMessage() {}
 
 func (x *DestroyTxBuilderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[47]
+	mi := &file_network_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3157,7 +3503,7 @@ func (x *DestroyTxBuilderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DestroyTxBuilderRequest.ProtoReflect.Descriptor instead.
 func (*DestroyTxBuilderRequest) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{47}
+	return file_network_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *DestroyTxBuilderRequest) GetBuilderId() string {
@@ -3176,7 +3522,7 @@ type DestroyTxBuilderResponse struct {
 
 func (x *DestroyTxBuilderResponse) Reset() {
 	*x = DestroyTxBuilderResponse{}
-	mi := &file_network_proto_msgTypes[48]
+	mi := &file_network_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3188,7 +3534,7 @@ func (x *DestroyTxBuilderResponse) String() string {
 func (*DestroyTxBuilderResponse) ProtoMessage() {}
 
 func (x *DestroyTxBuilderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[48]
+	mi := &file_network_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3201,7 +3547,7 @@ func (x *DestroyTxBuilderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DestroyTxBuilderResponse.ProtoReflect.Descriptor instead.
 func (*DestroyTxBuilderResponse) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{48}
+	return file_network_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *DestroyTxBuilderResponse) GetError() string {
@@ -3211,6 +3557,180 @@ func (x *DestroyTxBuilderResponse) GetError() string {
 	return ""
 }
 
+type DecodeTxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TxBytes       []byte                 `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeTxRequest) Reset() {
+	*x = DecodeTxRequest{}
+	mi := &file_network_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeTxRequest) ProtoMessage() {}
+
+func (x *DecodeTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeTxRequest.ProtoReflect.Descriptor instead.
+func (*DecodeTxRequest) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DecodeTxRequest) GetTxBytes() []byte {
+	if x != nil {
+		return x.TxBytes
+	}
+	return nil
+}
+
+// DecodedMsg is a decoded transaction message. json is empty when the
+// plugin does not know the message type.
+type DecodedMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TypeUrl       string                 `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	Json          []byte                 `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodedMsg) Reset() {
+	*x = DecodedMsg{}
+	mi := &file_network_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodedMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedMsg) ProtoMessage() {}
+
+func (x *DecodedMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedMsg.ProtoReflect.Descriptor instead.
+func (*DecodedMsg) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DecodedMsg) GetTypeUrl() string {
+	if x != nil {
+		return x.TypeUrl
+	}
+	return ""
+}
+
+func (x *DecodedMsg) GetJson() []byte {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+type DecodeTxResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*DecodedMsg          `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	Memo          string                 `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	Fee           string                 `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	GasLimit      uint64                 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeTxResponse) Reset() {
+	*x = DecodeTxResponse{}
+	mi := &file_network_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeTxResponse) ProtoMessage() {}
+
+func (x *DecodeTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeTxResponse.ProtoReflect.Descriptor instead.
+func (*DecodeTxResponse) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DecodeTxResponse) GetMessages() []*DecodedMsg {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *DecodeTxResponse) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *DecodeTxResponse) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *DecodeTxResponse) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *DecodeTxResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_network_proto protoreflect.FileDescriptor
 
 const file_network_proto_rawDesc = "" +
@@ -3354,7 +3874,29 @@ const file_network_proto_rawDesc = "" +
 	"\vmin_deposit\x18\x03 \x01(\tR\n" +
 	"minDeposit\x122\n" +
 	"\x15expedited_min_deposit\x18\x04 \x01(\tR\x13expeditedMinDeposit\x12\x14\n" +
-	"\x05error\x18\x05 \x01(\tR\x05error\"7\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"-\n" +
+	"\x13ParamsLayoutRequest\x12\x16\n" +
+	"\x06module\x18\x01 \x01(\tR\x06module\"\xb1\x01\n" +
+	"\x14ParamsLayoutResponse\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12\x1d\n" +
+	"\n" +
+	"query_path\x18\x02 \x01(\tR\tqueryPath\x12%\n" +
+	"\x0eresponse_field\x18\x03 \x01(\tR\rresponseField\x12 \n" +
+	"\fmsg_type_url\x18\x04 \x01(\tR\n" +
+	"msgTypeUrl\x12\x1b\n" +
+	"\tmsg_field\x18\x05 \x01(\tR\bmsgField\"e\n" +
+	"\x14CapabilitiesResponse\x12)\n" +
+	"\x10protocol_version\x18\x01 \x01(\x05R\x0fprotocolVersion\x12\"\n" +
+	"\fcapabilities\x18\x02 \x03(\tR\fcapabilities\"X\n" +
+	"\x12PassthroughCommand\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaliases\x18\x02 \x03(\tR\aaliases\x12\x14\n" +
+	"\x05flags\x18\x03 \x03(\tR\x05flags\"V\n" +
+	"\x1bPassthroughCommandsResponse\x127\n" +
+	"\bcommands\x18\x01 \x03(\v2\x1b.network.PassthroughCommandR\bcommands\"F\n" +
+	"\x18ReloadableConfigResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\x12\x16\n" +
+	"\x06signal\x18\x02 \x01(\x05R\x06signal\"7\n" +
 	"\x12BlockHeightRequest\x12!\n" +
 	"\frpc_endpoint\x18\x01 \x01(\tR\vrpcEndpoint\"C\n" +
 	"\x13BlockHeightResponse\x12\x16\n" +
@@ -3476,8 +4018,21 @@ const file_network_proto_rawDesc = "" +
 	"\n" +
 	"builder_id\x18\x01 \x01(\tR\tbuilderId\"0\n" +
 	"\x18DestroyTxBuilderResponse\x12\x14\n" +
-	"\x05error\x18\x01 \x01(\tR\x05error2\xdd\x17\n" +
-	"\rNetworkModule\x12/\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\",\n" +
+	"\x0fDecodeTxRequest\x12\x19\n" +
+	"\btx_bytes\x18\x01 \x01(\fR\atxBytes\";\n" +
+	"\n" +
+	"DecodedMsg\x12\x19\n" +
+	"\btype_url\x18\x01 \x01(\tR\atypeUrl\x12\x12\n" +
+	"\x04json\x18\x02 \x01(\fR\x04json\"\x9c\x01\n" +
+	"\x10DecodeTxResponse\x12/\n" +
+	"\bmessages\x18\x01 \x03(\v2\x13.network.DecodedMsgR\bmessages\x12\x12\n" +
+	"\x04memo\x18\x02 \x01(\tR\x04memo\x12\x10\n" +
+	"\x03fee\x18\x03 \x01(\tR\x03fee\x12\x1b\n" +
+	"\tgas_limit\x18\x04 \x01(\x04R\bgasLimit\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error2\xca\x1a\n" +
+	"\rNetworkModule\x12@\n" +
+	"\x0fGetCapabilities\x12\x0e.network.Empty\x1a\x1d.network.CapabilitiesResponse\x12/\n" +
 	"\x04Name\x12\x0e.network.Empty\x1a\x17.network.StringResponse\x126\n" +
 	"\vDisplayName\x12\x0e.network.Empty\x1a\x17.network.StringResponse\x122\n" +
 	"\aVersion\x12\x0e.network.Empty\x1a\x17.network.StringResponse\x125\n" +
@@ -3511,7 +4066,10 @@ const file_network_proto_rawDesc = "" +
 	"\vRPCEndpoint\x12\x16.network.StringRequest\x1a\x17.network.StringResponse\x12@\n" +
 	"\x11AvailableNetworks\x12\x0e.network.Empty\x1a\x1b.network.StringListResponse\x12R\n" +
 	"\x12GetConfigOverrides\x12\x1a.network.NodeConfigRequest\x1a .network.ConfigOverridesResponse\x12Z\n" +
-	"\x13GetGovernanceParams\x12 .network.GovernanceParamsRequest\x1a!.network.GovernanceParamsResponse\x12K\n" +
+	"\x13GetGovernanceParams\x12 .network.GovernanceParamsRequest\x1a!.network.GovernanceParamsResponse\x12N\n" +
+	"\x0fGetParamsLayout\x12\x1c.network.ParamsLayoutRequest\x1a\x1d.network.ParamsLayoutResponse\x12N\n" +
+	"\x16GetPassthroughCommands\x12\x0e.network.Empty\x1a$.network.PassthroughCommandsResponse\x12H\n" +
+	"\x13GetReloadableConfig\x12\x0e.network.Empty\x1a!.network.ReloadableConfigResponse\x12K\n" +
 	"\x0eGetBlockHeight\x12\x1b.network.BlockHeightRequest\x1a\x1c.network.BlockHeightResponse\x12E\n" +
 	"\fGetBlockTime\x12\x19.network.BlockTimeRequest\x1a\x1a.network.BlockTimeResponse\x12K\n" +
 	"\x0eIsChainRunning\x12\x1b.network.ChainStatusRequest\x1a\x1c.network.ChainStatusResponse\x12K\n" +
@@ -3523,7 +4081,8 @@ const file_network_proto_rawDesc = "" +
 	"\aBuildTx\x12\x17.network.BuildTxRequest\x1a\x18.network.BuildTxResponse\x129\n" +
 	"\x06SignTx\x12\x16.network.SignTxRequest\x1a\x17.network.SignTxResponse\x12H\n" +
 	"\vBroadcastTx\x12\x1b.network.BroadcastTxRequest\x1a\x1c.network.BroadcastTxResponse\x12W\n" +
-	"\x10DestroyTxBuilder\x12 .network.DestroyTxBuilderRequest\x1a!.network.DestroyTxBuilderResponseB;Z9github.com/altuslabsxyz/devnet-builder/pkg/network/pluginb\x06proto3"
+	"\x10DestroyTxBuilder\x12 .network.DestroyTxBuilderRequest\x1a!.network.DestroyTxBuilderResponse\x12?\n" +
+	"\bDecodeTx\x12\x18.network.DecodeTxRequest\x1a\x19.network.DecodeTxResponseB;Z9github.com/altuslabsxyz/devnet-builder/pkg/network/pluginb\x06proto3"
 
 var (
 	file_network_proto_rawDescOnce sync.Once
@@ -3537,161 +4096,182 @@ func file_network_proto_rawDescGZIP() []byte {
 	return file_network_proto_rawDescData
 }
 
-var file_network_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_network_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_network_proto_goTypes = []any{
-	(*Empty)(nil),                     // 0: network.Empty
-	(*StringRequest)(nil),             // 1: network.StringRequest
-	(*StringResponse)(nil),            // 2: network.StringResponse
-	(*StringListResponse)(nil),        // 3: network.StringListResponse
-	(*BytesResponse)(nil),             // 4: network.BytesResponse
-	(*ErrorResponse)(nil),             // 5: network.ErrorResponse
-	(*BinarySourceResponse)(nil),      // 6: network.BinarySourceResponse
-	(*PortConfigResponse)(nil),        // 7: network.PortConfigResponse
-	(*GenesisConfigResponse)(nil),     // 8: network.GenesisConfigResponse
-	(*GeneratorConfigResponse)(nil),   // 9: network.GeneratorConfigResponse
-	(*InitCommandRequest)(nil),        // 10: network.InitCommandRequest
-	(*StartCommandRequest)(nil),       // 11: network.StartCommandRequest
-	(*ValidatorInfo)(nil),             // 12: network.ValidatorInfo
-	(*ModifyGenesisRequest)(nil),      // 13: network.ModifyGenesisRequest
-	(*GenerateDevnetRequest)(nil),     // 14: network.GenerateDevnetRequest
-	(*NodeConfigRequest)(nil),         // 15: network.NodeConfigRequest
-	(*ConfigOverridesResponse)(nil),   // 16: network.ConfigOverridesResponse
-	(*ModifyGenesisFileRequest)(nil),  // 17: network.ModifyGenesisFileRequest
-	(*ModifyGenesisFileResponse)(nil), // 18: network.ModifyGenesisFileResponse
-	(*BuildConfigRequest)(nil),        // 19: network.BuildConfigRequest
-	(*BuildConfigResponse)(nil),       // 20: network.BuildConfigResponse
-	(*GovernanceParamsRequest)(nil),   // 21: network.GovernanceParamsRequest
-	(*GovernanceParamsResponse)(nil),  // 22: network.GovernanceParamsResponse
-	(*BlockHeightRequest)(nil),        // 23: network.BlockHeightRequest
-	(*BlockHeightResponse)(nil),       // 24: network.BlockHeightResponse
-	(*BlockTimeRequest)(nil),          // 25: network.BlockTimeRequest
-	(*BlockTimeResponse)(nil),         // 26: network.BlockTimeResponse
-	(*ChainStatusRequest)(nil),        // 27: network.ChainStatusRequest
-	(*ChainStatusResponse)(nil),       // 28: network.ChainStatusResponse
-	(*WaitForBlockRequest)(nil),       // 29: network.WaitForBlockRequest
-	(*WaitForBlockResponse)(nil),      // 30: network.WaitForBlockResponse
-	(*ProposalRequest)(nil),           // 31: network.ProposalRequest
-	(*ProposalResponse)(nil),          // 32: network.ProposalResponse
-	(*UpgradePlanRequest)(nil),        // 33: network.UpgradePlanRequest
-	(*UpgradePlanResponse)(nil),       // 34: network.UpgradePlanResponse
-	(*AppVersionRequest)(nil),         // 35: network.AppVersionRequest
-	(*AppVersionResponse)(nil),        // 36: network.AppVersionResponse
-	(*SDKVersion)(nil),                // 37: network.SDKVersion
-	(*CreateTxBuilderRequest)(nil),    // 38: network.CreateTxBuilderRequest
-	(*CreateTxBuilderResponse)(nil),   // 39: network.CreateTxBuilderResponse
-	(*BuildTxRequest)(nil),            // 40: network.BuildTxRequest
-	(*BuildTxResponse)(nil),           // 41: network.BuildTxResponse
-	(*SigningKeyProto)(nil),           // 42: network.SigningKeyProto
-	(*SignTxRequest)(nil),             // 43: network.SignTxRequest
-	(*SignTxResponse)(nil),            // 44: network.SignTxResponse
-	(*BroadcastTxRequest)(nil),        // 45: network.BroadcastTxRequest
-	(*BroadcastTxResponse)(nil),       // 46: network.BroadcastTxResponse
-	(*DestroyTxBuilderRequest)(nil),   // 47: network.DestroyTxBuilderRequest
-	(*DestroyTxBuilderResponse)(nil),  // 48: network.DestroyTxBuilderResponse
-	nil,                               // 49: network.BuildConfigResponse.EnvEntry
+	(*Empty)(nil),                       // 0: network.Empty
+	(*StringRequest)(nil),               // 1: network.StringRequest
+	(*StringResponse)(nil),              // 2: network.StringResponse
+	(*StringListResponse)(nil),          // 3: network.StringListResponse
+	(*BytesResponse)(nil),               // 4: network.BytesResponse
+	(*ErrorResponse)(nil),               // 5: network.ErrorResponse
+	(*BinarySourceResponse)(nil),        // 6: network.BinarySourceResponse
+	(*PortConfigResponse)(nil),          // 7: network.PortConfigResponse
+	(*GenesisConfigResponse)(nil),       // 8: network.GenesisConfigResponse
+	(*GeneratorConfigResponse)(nil),     // 9: network.GeneratorConfigResponse
+	(*InitCommandRequest)(nil),          // 10: network.InitCommandRequest
+	(*StartCommandRequest)(nil),         // 11: network.StartCommandRequest
+	(*ValidatorInfo)(nil),               // 12: network.ValidatorInfo
+	(*ModifyGenesisRequest)(nil),        // 13: network.ModifyGenesisRequest
+	(*GenerateDevnetRequest)(nil),       // 14: network.GenerateDevnetRequest
+	(*NodeConfigRequest)(nil),           // 15: network.NodeConfigRequest
+	(*ConfigOverridesResponse)(nil),     // 16: network.ConfigOverridesResponse
+	(*ModifyGenesisFileRequest)(nil),    // 17: network.ModifyGenesisFileRequest
+	(*ModifyGenesisFileResponse)(nil),   // 18: network.ModifyGenesisFileResponse
+	(*BuildConfigRequest)(nil),          // 19: network.BuildConfigRequest
+	(*BuildConfigResponse)(nil),         // 20: network.BuildConfigResponse
+	(*GovernanceParamsRequest)(nil),     // 21: network.GovernanceParamsRequest
+	(*GovernanceParamsResponse)(nil),    // 22: network.GovernanceParamsResponse
+	(*ParamsLayoutRequest)(nil),         // 23: network.ParamsLayoutRequest
+	(*ParamsLayoutResponse)(nil),        // 24: network.ParamsLayoutResponse
+	(*CapabilitiesResponse)(nil),        // 25: network.CapabilitiesResponse
+	(*PassthroughCommand)(nil),          // 26: network.PassthroughCommand
+	(*PassthroughCommandsResponse)(nil), // 27: network.PassthroughCommandsResponse
+	(*ReloadableConfigResponse)(nil),    // 28: network.ReloadableConfigResponse
+	(*BlockHeightRequest)(nil),          // 29: network.BlockHeightRequest
+	(*BlockHeightResponse)(nil),         // 30: network.BlockHeightResponse
+	(*BlockTimeRequest)(nil),            // 31: network.BlockTimeRequest
+	(*BlockTimeResponse)(nil),           // 32: network.BlockTimeResponse
+	(*ChainStatusRequest)(nil),          // 33: network.ChainStatusRequest
+	(*ChainStatusResponse)(nil),         // 34: network.ChainStatusResponse
+	(*WaitForBlockRequest)(nil),         // 35: network.WaitForBlockRequest
+	(*WaitForBlockResponse)(nil),        // 36: network.WaitForBlockResponse
+	(*ProposalRequest)(nil),             // 37: network.ProposalRequest
+	(*ProposalResponse)(nil),            // 38: network.ProposalResponse
+	(*UpgradePlanRequest)(nil),          // 39: network.UpgradePlanRequest
+	(*UpgradePlanResponse)(nil),         // 40: network.UpgradePlanResponse
+	(*AppVersionRequest)(nil),           // 41: network.AppVersionRequest
+	(*AppVersionResponse)(nil),          // 42: network.AppVersionResponse
+	(*SDKVersion)(nil),                  // 43: network.SDKVersion
+	(*CreateTxBuilderRequest)(nil),      // 44: network.CreateTxBuilderRequest
+	(*CreateTxBuilderResponse)(nil),     // 45: network.CreateTxBuilderResponse
+	(*BuildTxRequest)(nil),              // 46: network.BuildTxRequest
+	(*BuildTxResponse)(nil),             // 47: network.BuildTxResponse
+	(*SigningKeyProto)(nil),             // 48: network.SigningKeyProto
+	(*SignTxRequest)(nil),               // 49: network.SignTxRequest
+	(*SignTxResponse)(nil),              // 50: network.SignTxResponse
+	(*BroadcastTxRequest)(nil),          // 51: network.BroadcastTxRequest
+	(*BroadcastTxResponse)(nil),         // 52: network.BroadcastTxResponse
+	(*DestroyTxBuilderRequest)(nil),     // 53: network.DestroyTxBuilderRequest
+	(*DestroyTxBuilderResponse)(nil),    // 54: network.DestroyTxBuilderResponse
+	(*DecodeTxRequest)(nil),             // 55: network.DecodeTxRequest
+	(*DecodedMsg)(nil),                  // 56: network.DecodedMsg
+	(*DecodeTxResponse)(nil),            // 57: network.DecodeTxResponse
+	nil,                                 // 58: network.BuildConfigResponse.EnvEntry
 }
 var file_network_proto_depIdxs = []int32{
 	12, // 0: network.ModifyGenesisRequest.validators:type_name -> network.ValidatorInfo
 	7,  // 1: network.NodeConfigRequest.ports:type_name -> network.PortConfigResponse
 	12, // 2: network.ModifyGenesisFileRequest.validators:type_name -> network.ValidatorInfo
-	49, // 3: network.BuildConfigResponse.env:type_name -> network.BuildConfigResponse.EnvEntry
-	37, // 4: network.CreateTxBuilderRequest.sdk_version:type_name -> network.SDKVersion
-	42, // 5: network.SignTxRequest.key:type_name -> network.SigningKeyProto
-	0,  // 6: network.NetworkModule.Name:input_type -> network.Empty
-	0,  // 7: network.NetworkModule.DisplayName:input_type -> network.Empty
-	0,  // 8: network.NetworkModule.Version:input_type -> network.Empty
-	0,  // 9: network.NetworkModule.BinaryName:input_type -> network.Empty
-	0,  // 10: network.NetworkModule.BinarySource:input_type -> network.Empty
-	0,  // 11: network.NetworkModule.DefaultBinaryVersion:input_type -> network.Empty
-	19, // 12: network.NetworkModule.GetBuildConfig:input_type -> network.BuildConfigRequest
-	0,  // 13: network.NetworkModule.DefaultChainID:input_type -> network.Empty
-	0,  // 14: network.NetworkModule.Bech32Prefix:input_type -> network.Empty
-	0,  // 15: network.NetworkModule.BaseDenom:input_type -> network.Empty
-	0,  // 16: network.NetworkModule.GenesisConfig:input_type -> network.Empty
-	0,  // 17: network.NetworkModule.DefaultPorts:input_type -> network.Empty
-	0,  // 18: network.NetworkModule.DefaultGeneratorConfig:input_type -> network.Empty
-	0,  // 19: network.NetworkModule.DockerImage:input_type -> network.Empty
-	1,  // 20: network.NetworkModule.DockerImageTag:input_type -> network.StringRequest
-	0,  // 21: network.NetworkModule.DockerHomeDir:input_type -> network.Empty
-	10, // 22: network.NetworkModule.InitCommand:input_type -> network.InitCommandRequest
-	11, // 23: network.NetworkModule.StartCommand:input_type -> network.StartCommandRequest
-	1,  // 24: network.NetworkModule.ExportCommand:input_type -> network.StringRequest
-	0,  // 25: network.NetworkModule.DefaultNodeHome:input_type -> network.Empty
-	0,  // 26: network.NetworkModule.PIDFileName:input_type -> network.Empty
-	0,  // 27: network.NetworkModule.LogFileName:input_type -> network.Empty
-	0,  // 28: network.NetworkModule.ProcessPattern:input_type -> network.Empty
-	13, // 29: network.NetworkModule.ModifyGenesis:input_type -> network.ModifyGenesisRequest
-	17, // 30: network.NetworkModule.ModifyGenesisFile:input_type -> network.ModifyGenesisFileRequest
-	14, // 31: network.NetworkModule.GenerateDevnet:input_type -> network.GenerateDevnetRequest
-	0,  // 32: network.NetworkModule.GetCodec:input_type -> network.Empty
-	0,  // 33: network.NetworkModule.Validate:input_type -> network.Empty
-	1,  // 34: network.NetworkModule.SnapshotURL:input_type -> network.StringRequest
-	1,  // 35: network.NetworkModule.RPCEndpoint:input_type -> network.StringRequest
-	0,  // 36: network.NetworkModule.AvailableNetworks:input_type -> network.Empty
-	15, // 37: network.NetworkModule.GetConfigOverrides:input_type -> network.NodeConfigRequest
-	21, // 38: network.NetworkModule.GetGovernanceParams:input_type -> network.GovernanceParamsRequest
-	23, // 39: network.NetworkModule.GetBlockHeight:input_type -> network.BlockHeightRequest
-	25, // 40: network.NetworkModule.GetBlockTime:input_type -> network.BlockTimeRequest
-	27, // 41: network.NetworkModule.IsChainRunning:input_type -> network.ChainStatusRequest
-	29, // 42: network.NetworkModule.WaitForBlock:input_type -> network.WaitForBlockRequest
-	31, // 43: network.NetworkModule.GetProposal:input_type -> network.ProposalRequest
-	33, // 44: network.NetworkModule.GetUpgradePlan:input_type -> network.UpgradePlanRequest
-	35, // 45: network.NetworkModule.GetAppVersion:input_type -> network.AppVersionRequest
-	38, // 46: network.NetworkModule.CreateTxBuilder:input_type -> network.CreateTxBuilderRequest
-	40, // 47: network.NetworkModule.BuildTx:input_type -> network.BuildTxRequest
-	43, // 48: network.NetworkModule.SignTx:input_type -> network.SignTxRequest
-	45, // 49: network.NetworkModule.BroadcastTx:input_type -> network.BroadcastTxRequest
-	47, // 50: network.NetworkModule.DestroyTxBuilder:input_type -> network.DestroyTxBuilderRequest
-	2,  // 51: network.NetworkModule.Name:output_type -> network.StringResponse
-	2,  // 52: network.NetworkModule.DisplayName:output_type -> network.StringResponse
-	2,  // 53: network.NetworkModule.Version:output_type -> network.StringResponse
-	2,  // 54: network.NetworkModule.BinaryName:output_type -> network.StringResponse
-	6,  // 55: network.NetworkModule.BinarySource:output_type -> network.BinarySourceResponse
-	2,  // 56: network.NetworkModule.DefaultBinaryVersion:output_type -> network.StringResponse
-	20, // 57: network.NetworkModule.GetBuildConfig:output_type -> network.BuildConfigResponse
-	2,  // 58: network.NetworkModule.DefaultChainID:output_type -> network.StringResponse
-	2,  // 59: network.NetworkModule.Bech32Prefix:output_type -> network.StringResponse
-	2,  // 60: network.NetworkModule.BaseDenom:output_type -> network.StringResponse
-	8,  // 61: network.NetworkModule.GenesisConfig:output_type -> network.GenesisConfigResponse
-	7,  // 62: network.NetworkModule.DefaultPorts:output_type -> network.PortConfigResponse
-	9,  // 63: network.NetworkModule.DefaultGeneratorConfig:output_type -> network.GeneratorConfigResponse
-	2,  // 64: network.NetworkModule.DockerImage:output_type -> network.StringResponse
-	2,  // 65: network.NetworkModule.DockerImageTag:output_type -> network.StringResponse
-	2,  // 66: network.NetworkModule.DockerHomeDir:output_type -> network.StringResponse
-	3,  // 67: network.NetworkModule.InitCommand:output_type -> network.StringListResponse
-	3,  // 68: network.NetworkModule.StartCommand:output_type -> network.StringListResponse
-	3,  // 69: network.NetworkModule.ExportCommand:output_type -> network.StringListResponse
-	2,  // 70: network.NetworkModule.DefaultNodeHome:output_type -> network.StringResponse
-	2,  // 71: network.NetworkModule.PIDFileName:output_type -> network.StringResponse
-	2,  // 72: network.NetworkModule.LogFileName:output_type -> network.StringResponse
-	2,  // 73: network.NetworkModule.ProcessPattern:output_type -> network.StringResponse
-	4,  // 74: network.NetworkModule.ModifyGenesis:output_type -> network.BytesResponse
-	18, // 75: network.NetworkModule.ModifyGenesisFile:output_type -> network.ModifyGenesisFileResponse
-	5,  // 76: network.NetworkModule.GenerateDevnet:output_type -> network.ErrorResponse
-	4,  // 77: network.NetworkModule.GetCodec:output_type -> network.BytesResponse
-	5,  // 78: network.NetworkModule.Validate:output_type -> network.ErrorResponse
-	2,  // 79: network.NetworkModule.SnapshotURL:output_type -> network.StringResponse
-	2,  // 80: network.NetworkModule.RPCEndpoint:output_type -> network.StringResponse
-	3,  // 81: network.NetworkModule.AvailableNetworks:output_type -> network.StringListResponse
-	16, // 82: network.NetworkModule.GetConfigOverrides:output_type -> network.ConfigOverridesResponse
-	22, // 83: network.NetworkModule.GetGovernanceParams:output_type -> network.GovernanceParamsResponse
-	24, // 84: network.NetworkModule.GetBlockHeight:output_type -> network.BlockHeightResponse
-	26, // 85: network.NetworkModule.GetBlockTime:output_type -> network.BlockTimeResponse
-	28, // 86: network.NetworkModule.IsChainRunning:output_type -> network.ChainStatusResponse
-	30, // 87: network.NetworkModule.WaitForBlock:output_type -> network.WaitForBlockResponse
-	32, // 88: network.NetworkModule.GetProposal:output_type -> network.ProposalResponse
-	34, // 89: network.NetworkModule.GetUpgradePlan:output_type -> network.UpgradePlanResponse
-	36, // 90: network.NetworkModule.GetAppVersion:output_type -> network.AppVersionResponse
-	39, // 91: network.NetworkModule.CreateTxBuilder:output_type -> network.CreateTxBuilderResponse
-	41, // 92: network.NetworkModule.BuildTx:output_type -> network.BuildTxResponse
-	44, // 93: network.NetworkModule.SignTx:output_type -> network.SignTxResponse
-	46, // 94: network.NetworkModule.BroadcastTx:output_type -> network.BroadcastTxResponse
-	48, // 95: network.NetworkModule.DestroyTxBuilder:output_type -> network.DestroyTxBuilderResponse
-	51, // [51:96] is the sub-list for method output_type
-	6,  // [6:51] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	58, // 3: network.BuildConfigResponse.env:type_name -> network.BuildConfigResponse.EnvEntry
+	26, // 4: network.PassthroughCommandsResponse.commands:type_name -> network.PassthroughCommand
+	43, // 5: network.CreateTxBuilderRequest.sdk_version:type_name -> network.SDKVersion
+	48, // 6: network.SignTxRequest.key:type_name -> network.SigningKeyProto
+	56, // 7: network.DecodeTxResponse.messages:type_name -> network.DecodedMsg
+	0,  // 8: network.NetworkModule.GetCapabilities:input_type -> network.Empty
+	0,  // 9: network.NetworkModule.Name:input_type -> network.Empty
+	0,  // 10: network.NetworkModule.DisplayName:input_type -> network.Empty
+	0,  // 11: network.NetworkModule.Version:input_type -> network.Empty
+	0,  // 12: network.NetworkModule.BinaryName:input_type -> network.Empty
+	0,  // 13: network.NetworkModule.BinarySource:input_type -> network.Empty
+	0,  // 14: network.NetworkModule.DefaultBinaryVersion:input_type -> network.Empty
+	19, // 15: network.NetworkModule.GetBuildConfig:input_type -> network.BuildConfigRequest
+	0,  // 16: network.NetworkModule.DefaultChainID:input_type -> network.Empty
+	0,  // 17: network.NetworkModule.Bech32Prefix:input_type -> network.Empty
+	0,  // 18: network.NetworkModule.BaseDenom:input_type -> network.Empty
+	0,  // 19: network.NetworkModule.GenesisConfig:input_type -> network.Empty
+	0,  // 20: network.NetworkModule.DefaultPorts:input_type -> network.Empty
+	0,  // 21: network.NetworkModule.DefaultGeneratorConfig:input_type -> network.Empty
+	0,  // 22: network.NetworkModule.DockerImage:input_type -> network.Empty
+	1,  // 23: network.NetworkModule.DockerImageTag:input_type -> network.StringRequest
+	0,  // 24: network.NetworkModule.DockerHomeDir:input_type -> network.Empty
+	10, // 25: network.NetworkModule.InitCommand:input_type -> network.InitCommandRequest
+	11, // 26: network.NetworkModule.StartCommand:input_type -> network.StartCommandRequest
+	1,  // 27: network.NetworkModule.ExportCommand:input_type -> network.StringRequest
+	0,  // 28: network.NetworkModule.DefaultNodeHome:input_type -> network.Empty
+	0,  // 29: network.NetworkModule.PIDFileName:input_type -> network.Empty
+	0,  // 30: network.NetworkModule.LogFileName:input_type -> network.Empty
+	0,  // 31: network.NetworkModule.ProcessPattern:input_type -> network.Empty
+	13, // 32: network.NetworkModule.ModifyGenesis:input_type -> network.ModifyGenesisRequest
+	17, // 33: network.NetworkModule.ModifyGenesisFile:input_type -> network.ModifyGenesisFileRequest
+	14, // 34: network.NetworkModule.GenerateDevnet:input_type -> network.GenerateDevnetRequest
+	0,  // 35: network.NetworkModule.GetCodec:input_type -> network.Empty
+	0,  // 36: network.NetworkModule.Validate:input_type -> network.Empty
+	1,  // 37: network.NetworkModule.SnapshotURL:input_type -> network.StringRequest
+	1,  // 38: network.NetworkModule.RPCEndpoint:input_type -> network.StringRequest
+	0,  // 39: network.NetworkModule.AvailableNetworks:input_type -> network.Empty
+	15, // 40: network.NetworkModule.GetConfigOverrides:input_type -> network.NodeConfigRequest
+	21, // 41: network.NetworkModule.GetGovernanceParams:input_type -> network.GovernanceParamsRequest
+	23, // 42: network.NetworkModule.GetParamsLayout:input_type -> network.ParamsLayoutRequest
+	0,  // 43: network.NetworkModule.GetPassthroughCommands:input_type -> network.Empty
+	0,  // 44: network.NetworkModule.GetReloadableConfig:input_type -> network.Empty
+	29, // 45: network.NetworkModule.GetBlockHeight:input_type -> network.BlockHeightRequest
+	31, // 46: network.NetworkModule.GetBlockTime:input_type -> network.BlockTimeRequest
+	33, // 47: network.NetworkModule.IsChainRunning:input_type -> network.ChainStatusRequest
+	35, // 48: network.NetworkModule.WaitForBlock:input_type -> network.WaitForBlockRequest
+	37, // 49: network.NetworkModule.GetProposal:input_type -> network.ProposalRequest
+	39, // 50: network.NetworkModule.GetUpgradePlan:input_type -> network.UpgradePlanRequest
+	41, // 51: network.NetworkModule.GetAppVersion:input_type -> network.AppVersionRequest
+	44, // 52: network.NetworkModule.CreateTxBuilder:input_type -> network.CreateTxBuilderRequest
+	46, // 53: network.NetworkModule.BuildTx:input_type -> network.BuildTxRequest
+	49, // 54: network.NetworkModule.SignTx:input_type -> network.SignTxRequest
+	51, // 55: network.NetworkModule.BroadcastTx:input_type -> network.BroadcastTxRequest
+	53, // 56: network.NetworkModule.DestroyTxBuilder:input_type -> network.DestroyTxBuilderRequest
+	55, // 57: network.NetworkModule.DecodeTx:input_type -> network.DecodeTxRequest
+	25, // 58: network.NetworkModule.GetCapabilities:output_type -> network.CapabilitiesResponse
+	2,  // 59: network.NetworkModule.Name:output_type -> network.StringResponse
+	2,  // 60: network.NetworkModule.DisplayName:output_type -> network.StringResponse
+	2,  // 61: network.NetworkModule.Version:output_type -> network.StringResponse
+	2,  // 62: network.NetworkModule.BinaryName:output_type -> network.StringResponse
+	6,  // 63: network.NetworkModule.BinarySource:output_type -> network.BinarySourceResponse
+	2,  // 64: network.NetworkModule.DefaultBinaryVersion:output_type -> network.StringResponse
+	20, // 65: network.NetworkModule.GetBuildConfig:output_type -> network.BuildConfigResponse
+	2,  // 66: network.NetworkModule.DefaultChainID:output_type -> network.StringResponse
+	2,  // 67: network.NetworkModule.Bech32Prefix:output_type -> network.StringResponse
+	2,  // 68: network.NetworkModule.BaseDenom:output_type -> network.StringResponse
+	8,  // 69: network.NetworkModule.GenesisConfig:output_type -> network.GenesisConfigResponse
+	7,  // 70: network.NetworkModule.DefaultPorts:output_type -> network.PortConfigResponse
+	9,  // 71: network.NetworkModule.DefaultGeneratorConfig:output_type -> network.GeneratorConfigResponse
+	2,  // 72: network.NetworkModule.DockerImage:output_type -> network.StringResponse
+	2,  // 73: network.NetworkModule.DockerImageTag:output_type -> network.StringResponse
+	2,  // 74: network.NetworkModule.DockerHomeDir:output_type -> network.StringResponse
+	3,  // 75: network.NetworkModule.InitCommand:output_type -> network.StringListResponse
+	3,  // 76: network.NetworkModule.StartCommand:output_type -> network.StringListResponse
+	3,  // 77: network.NetworkModule.ExportCommand:output_type -> network.StringListResponse
+	2,  // 78: network.NetworkModule.DefaultNodeHome:output_type -> network.StringResponse
+	2,  // 79: network.NetworkModule.PIDFileName:output_type -> network.StringResponse
+	2,  // 80: network.NetworkModule.LogFileName:output_type -> network.StringResponse
+	2,  // 81: network.NetworkModule.ProcessPattern:output_type -> network.StringResponse
+	4,  // 82: network.NetworkModule.ModifyGenesis:output_type -> network.BytesResponse
+	18, // 83: network.NetworkModule.ModifyGenesisFile:output_type -> network.ModifyGenesisFileResponse
+	5,  // 84: network.NetworkModule.GenerateDevnet:output_type -> network.ErrorResponse
+	4,  // 85: network.NetworkModule.GetCodec:output_type -> network.BytesResponse
+	5,  // 86: network.NetworkModule.Validate:output_type -> network.ErrorResponse
+	2,  // 87: network.NetworkModule.SnapshotURL:output_type -> network.StringResponse
+	2,  // 88: network.NetworkModule.RPCEndpoint:output_type -> network.StringResponse
+	3,  // 89: network.NetworkModule.AvailableNetworks:output_type -> network.StringListResponse
+	16, // 90: network.NetworkModule.GetConfigOverrides:output_type -> network.ConfigOverridesResponse
+	22, // 91: network.NetworkModule.GetGovernanceParams:output_type -> network.GovernanceParamsResponse
+	24, // 92: network.NetworkModule.GetParamsLayout:output_type -> network.ParamsLayoutResponse
+	27, // 93: network.NetworkModule.GetPassthroughCommands:output_type -> network.PassthroughCommandsResponse
+	28, // 94: network.NetworkModule.GetReloadableConfig:output_type -> network.ReloadableConfigResponse
+	30, // 95: network.NetworkModule.GetBlockHeight:output_type -> network.BlockHeightResponse
+	32, // 96: network.NetworkModule.GetBlockTime:output_type -> network.BlockTimeResponse
+	34, // 97: network.NetworkModule.IsChainRunning:output_type -> network.ChainStatusResponse
+	36, // 98: network.NetworkModule.WaitForBlock:output_type -> network.WaitForBlockResponse
+	38, // 99: network.NetworkModule.GetProposal:output_type -> network.ProposalResponse
+	40, // 100: network.NetworkModule.GetUpgradePlan:output_type -> network.UpgradePlanResponse
+	42, // 101: network.NetworkModule.GetAppVersion:output_type -> network.AppVersionResponse
+	45, // 102: network.NetworkModule.CreateTxBuilder:output_type -> network.CreateTxBuilderResponse
+	47, // 103: network.NetworkModule.BuildTx:output_type -> network.BuildTxResponse
+	50, // 104: network.NetworkModule.SignTx:output_type -> network.SignTxResponse
+	52, // 105: network.NetworkModule.BroadcastTx:output_type -> network.BroadcastTxResponse
+	54, // 106: network.NetworkModule.DestroyTxBuilder:output_type -> network.DestroyTxBuilderResponse
+	57, // 107: network.NetworkModule.DecodeTx:output_type -> network.DecodeTxResponse
+	58, // [58:108] is the sub-list for method output_type
+	8,  // [8:58] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_network_proto_init() }
@@ -3705,7 +4285,7 @@ func file_network_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_network_proto_rawDesc), len(file_network_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   50,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
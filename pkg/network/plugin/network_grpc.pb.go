@@ -19,6 +19,7 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
+	NetworkModule_GetCapabilities_FullMethodName        = "/network.NetworkModule/GetCapabilities"
 	NetworkModule_Name_FullMethodName                   = "/network.NetworkModule/Name"
 	NetworkModule_DisplayName_FullMethodName            = "/network.NetworkModule/DisplayName"
 	NetworkModule_Version_FullMethodName                = "/network.NetworkModule/Version"
@@ -52,6 +53,9 @@ const (
 	NetworkModule_AvailableNetworks_FullMethodName      = "/network.NetworkModule/AvailableNetworks"
 	NetworkModule_GetConfigOverrides_FullMethodName     = "/network.NetworkModule/GetConfigOverrides"
 	NetworkModule_GetGovernanceParams_FullMethodName    = "/network.NetworkModule/GetGovernanceParams"
+	NetworkModule_GetParamsLayout_FullMethodName        = "/network.NetworkModule/GetParamsLayout"
+	NetworkModule_GetPassthroughCommands_FullMethodName = "/network.NetworkModule/GetPassthroughCommands"
+	NetworkModule_GetReloadableConfig_FullMethodName    = "/network.NetworkModule/GetReloadableConfig"
 	NetworkModule_GetBlockHeight_FullMethodName         = "/network.NetworkModule/GetBlockHeight"
 	NetworkModule_GetBlockTime_FullMethodName           = "/network.NetworkModule/GetBlockTime"
 	NetworkModule_IsChainRunning_FullMethodName         = "/network.NetworkModule/IsChainRunning"
@@ -64,6 +68,7 @@ const (
 	NetworkModule_SignTx_FullMethodName                 = "/network.NetworkModule/SignTx"
 	NetworkModule_BroadcastTx_FullMethodName            = "/network.NetworkModule/BroadcastTx"
 	NetworkModule_DestroyTxBuilder_FullMethodName       = "/network.NetworkModule/DestroyTxBuilder"
+	NetworkModule_DecodeTx_FullMethodName               = "/network.NetworkModule/DecodeTx"
 )
 
 // NetworkModuleClient is the client API for NetworkModule service.
@@ -72,6 +77,11 @@ const (
 //
 // NetworkModule service definition
 type NetworkModuleClient interface {
+	// Capabilities
+	// GetCapabilities reports the plugin protocol version and the optional
+	// methods the plugin implements. Plugins that predate it return
+	// Unimplemented and are treated as protocol version 1.
+	GetCapabilities(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CapabilitiesResponse, error)
 	// Identity
 	Name(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StringResponse, error)
 	DisplayName(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StringResponse, error)
@@ -121,6 +131,18 @@ type NetworkModuleClient interface {
 	// Plugins implement chain-specific logic to query voting periods, deposit amounts,
 	// and other governance settings needed for upgrade workflows.
 	GetGovernanceParams(ctx context.Context, in *GovernanceParamsRequest, opts ...grpc.CallOption) (*GovernanceParamsResponse, error)
+	// GetParamsLayout returns where a module's params are queried and how
+	// MsgUpdateParams carries them, for chains that differ from the Cosmos
+	// SDK defaults.
+	GetParamsLayout(ctx context.Context, in *ParamsLayoutRequest, opts ...grpc.CallOption) (*ParamsLayoutResponse, error)
+	// Binary Passthrough
+	// GetPassthroughCommands returns the binary subcommands devnet-builder
+	// passes through, for binaries that differ from the Cosmos SDK defaults.
+	GetPassthroughCommands(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PassthroughCommandsResponse, error)
+	// Node Configuration
+	// GetReloadableConfig returns the config keys the plugin's binary reloads
+	// without restarting, and the signal that makes it reload them.
+	GetReloadableConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ReloadableConfigResponse, error)
 	// RPC Operations
 	// All blockchain RPC operations are delegated to plugins to allow chain-specific implementations.
 	// Each plugin implements these methods using their chain's specific RPC/REST endpoints.
@@ -137,6 +159,8 @@ type NetworkModuleClient interface {
 	SignTx(ctx context.Context, in *SignTxRequest, opts ...grpc.CallOption) (*SignTxResponse, error)
 	BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error)
 	DestroyTxBuilder(ctx context.Context, in *DestroyTxBuilderRequest, opts ...grpc.CallOption) (*DestroyTxBuilderResponse, error)
+	// DecodeTx decodes raw transaction bytes for tx inspection.
+	DecodeTx(ctx context.Context, in *DecodeTxRequest, opts ...grpc.CallOption) (*DecodeTxResponse, error)
 }
 
 type networkModuleClient struct {
@@ -147,6 +171,16 @@ func NewNetworkModuleClient(cc grpc.ClientConnInterface) NetworkModuleClient {
 	return &networkModuleClient{cc}
 }
 
+func (c *networkModuleClient) GetCapabilities(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CapabilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CapabilitiesResponse)
+	err := c.cc.Invoke(ctx, NetworkModule_GetCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *networkModuleClient) Name(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StringResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StringResponse)
@@ -477,6 +511,36 @@ func (c *networkModuleClient) GetGovernanceParams(ctx context.Context, in *Gover
 	return out, nil
 }
 
+func (c *networkModuleClient) GetParamsLayout(ctx context.Context, in *ParamsLayoutRequest, opts ...grpc.CallOption) (*ParamsLayoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParamsLayoutResponse)
+	err := c.cc.Invoke(ctx, NetworkModule_GetParamsLayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkModuleClient) GetPassthroughCommands(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PassthroughCommandsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PassthroughCommandsResponse)
+	err := c.cc.Invoke(ctx, NetworkModule_GetPassthroughCommands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkModuleClient) GetReloadableConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ReloadableConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadableConfigResponse)
+	err := c.cc.Invoke(ctx, NetworkModule_GetReloadableConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *networkModuleClient) GetBlockHeight(ctx context.Context, in *BlockHeightRequest, opts ...grpc.CallOption) (*BlockHeightResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BlockHeightResponse)
@@ -597,12 +661,27 @@ func (c *networkModuleClient) DestroyTxBuilder(ctx context.Context, in *DestroyT
 	return out, nil
 }
 
+func (c *networkModuleClient) DecodeTx(ctx context.Context, in *DecodeTxRequest, opts ...grpc.CallOption) (*DecodeTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecodeTxResponse)
+	err := c.cc.Invoke(ctx, NetworkModule_DecodeTx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NetworkModuleServer is the server API for NetworkModule service.
 // All implementations must embed UnimplementedNetworkModuleServer
 // for forward compatibility.
 //
 // NetworkModule service definition
 type NetworkModuleServer interface {
+	// Capabilities
+	// GetCapabilities reports the plugin protocol version and the optional
+	// methods the plugin implements. Plugins that predate it return
+	// Unimplemented and are treated as protocol version 1.
+	GetCapabilities(context.Context, *Empty) (*CapabilitiesResponse, error)
 	// Identity
 	Name(context.Context, *Empty) (*StringResponse, error)
 	DisplayName(context.Context, *Empty) (*StringResponse, error)
@@ -652,6 +731,18 @@ type NetworkModuleServer interface {
 	// Plugins implement chain-specific logic to query voting periods, deposit amounts,
 	// and other governance settings needed for upgrade workflows.
 	GetGovernanceParams(context.Context, *GovernanceParamsRequest) (*GovernanceParamsResponse, error)
+	// GetParamsLayout returns where a module's params are queried and how
+	// MsgUpdateParams carries them, for chains that differ from the Cosmos
+	// SDK defaults.
+	GetParamsLayout(context.Context, *ParamsLayoutRequest) (*ParamsLayoutResponse, error)
+	// Binary Passthrough
+	// GetPassthroughCommands returns the binary subcommands devnet-builder
+	// passes through, for binaries that differ from the Cosmos SDK defaults.
+	GetPassthroughCommands(context.Context, *Empty) (*PassthroughCommandsResponse, error)
+	// Node Configuration
+	// GetReloadableConfig returns the config keys the plugin's binary reloads
+	// without restarting, and the signal that makes it reload them.
+	GetReloadableConfig(context.Context, *Empty) (*ReloadableConfigResponse, error)
 	// RPC Operations
 	// All blockchain RPC operations are delegated to plugins to allow chain-specific implementations.
 	// Each plugin implements these methods using their chain's specific RPC/REST endpoints.
@@ -668,6 +759,8 @@ type NetworkModuleServer interface {
 	SignTx(context.Context, *SignTxRequest) (*SignTxResponse, error)
 	BroadcastTx(context.Context, *BroadcastTxRequest) (*BroadcastTxResponse, error)
 	DestroyTxBuilder(context.Context, *DestroyTxBuilderRequest) (*DestroyTxBuilderResponse, error)
+	// DecodeTx decodes raw transaction bytes for tx inspection.
+	DecodeTx(context.Context, *DecodeTxRequest) (*DecodeTxResponse, error)
 	mustEmbedUnimplementedNetworkModuleServer()
 }
 
@@ -678,6 +771,9 @@ type NetworkModuleServer interface {
 // pointer dereference when methods are called.
 type UnimplementedNetworkModuleServer struct{}
 
+func (UnimplementedNetworkModuleServer) GetCapabilities(context.Context, *Empty) (*CapabilitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCapabilities not implemented")
+}
 func (UnimplementedNetworkModuleServer) Name(context.Context, *Empty) (*StringResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Name not implemented")
 }
@@ -777,6 +873,15 @@ func (UnimplementedNetworkModuleServer) GetConfigOverrides(context.Context, *Nod
 func (UnimplementedNetworkModuleServer) GetGovernanceParams(context.Context, *GovernanceParamsRequest) (*GovernanceParamsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetGovernanceParams not implemented")
 }
+func (UnimplementedNetworkModuleServer) GetParamsLayout(context.Context, *ParamsLayoutRequest) (*ParamsLayoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetParamsLayout not implemented")
+}
+func (UnimplementedNetworkModuleServer) GetPassthroughCommands(context.Context, *Empty) (*PassthroughCommandsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPassthroughCommands not implemented")
+}
+func (UnimplementedNetworkModuleServer) GetReloadableConfig(context.Context, *Empty) (*ReloadableConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReloadableConfig not implemented")
+}
 func (UnimplementedNetworkModuleServer) GetBlockHeight(context.Context, *BlockHeightRequest) (*BlockHeightResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetBlockHeight not implemented")
 }
@@ -813,6 +918,9 @@ func (UnimplementedNetworkModuleServer) BroadcastTx(context.Context, *BroadcastT
 func (UnimplementedNetworkModuleServer) DestroyTxBuilder(context.Context, *DestroyTxBuilderRequest) (*DestroyTxBuilderResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DestroyTxBuilder not implemented")
 }
+func (UnimplementedNetworkModuleServer) DecodeTx(context.Context, *DecodeTxRequest) (*DecodeTxResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DecodeTx not implemented")
+}
 func (UnimplementedNetworkModuleServer) mustEmbedUnimplementedNetworkModuleServer() {}
 func (UnimplementedNetworkModuleServer) testEmbeddedByValue()                       {}
 
@@ -834,6 +942,24 @@ func RegisterNetworkModuleServer(s grpc.ServiceRegistrar, srv NetworkModuleServe
 	s.RegisterService(&NetworkModule_ServiceDesc, srv)
 }
 
+func _NetworkModule_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkModuleServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkModule_GetCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkModuleServer).GetCapabilities(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NetworkModule_Name_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -1428,6 +1554,60 @@ func _NetworkModule_GetGovernanceParams_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NetworkModule_GetParamsLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkModuleServer).GetParamsLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkModule_GetParamsLayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkModuleServer).GetParamsLayout(ctx, req.(*ParamsLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkModule_GetPassthroughCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkModuleServer).GetPassthroughCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkModule_GetPassthroughCommands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkModuleServer).GetPassthroughCommands(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkModule_GetReloadableConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkModuleServer).GetReloadableConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkModule_GetReloadableConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkModuleServer).GetReloadableConfig(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NetworkModule_GetBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlockHeightRequest)
 	if err := dec(in); err != nil {
@@ -1644,6 +1824,24 @@ func _NetworkModule_DestroyTxBuilder_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NetworkModule_DecodeTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkModuleServer).DecodeTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkModule_DecodeTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkModuleServer).DecodeTx(ctx, req.(*DecodeTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NetworkModule_ServiceDesc is the grpc.ServiceDesc for NetworkModule service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1651,6 +1849,10 @@ var NetworkModule_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "network.NetworkModule",
 	HandlerType: (*NetworkModuleServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _NetworkModule_GetCapabilities_Handler,
+		},
 		{
 			MethodName: "Name",
 			Handler:    _NetworkModule_Name_Handler,
@@ -1783,6 +1985,18 @@ var NetworkModule_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGovernanceParams",
 			Handler:    _NetworkModule_GetGovernanceParams_Handler,
 		},
+		{
+			MethodName: "GetParamsLayout",
+			Handler:    _NetworkModule_GetParamsLayout_Handler,
+		},
+		{
+			MethodName: "GetPassthroughCommands",
+			Handler:    _NetworkModule_GetPassthroughCommands_Handler,
+		},
+		{
+			MethodName: "GetReloadableConfig",
+			Handler:    _NetworkModule_GetReloadableConfig_Handler,
+		},
 		{
 			MethodName: "GetBlockHeight",
 			Handler:    _NetworkModule_GetBlockHeight_Handler,
@@ -1831,6 +2045,10 @@ var NetworkModule_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyTxBuilder",
 			Handler:    _NetworkModule_DestroyTxBuilder_Handler,
 		},
+		{
+			MethodName: "DecodeTx",
+			Handler:    _NetworkModule_DecodeTx_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "network.proto",
@@ -0,0 +1,510 @@
+package plugintest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"github.com/altuslabsxyz/devnet-builder/pkg/network/plugin"
+)
+
+// rpcTimeout bounds each RPC delegation call against unreachableEndpoint.
+// A call must return within it, plus rpcGrace for the module to notice.
+const (
+	rpcTimeout = 2 * time.Second
+	rpcGrace   = time.Second
+)
+
+var moduleName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+func checkIdentity(m network.Module) error {
+	var p problems
+	if name := m.Name(); !moduleName.MatchString(name) {
+		p.addf("Name %q must be lowercase letters, digits, '-' and '_'", name)
+	}
+	if m.DisplayName() == "" {
+		p.addf("DisplayName is empty")
+	}
+	if m.Version() == "" {
+		p.addf("Version is empty")
+	}
+	return p.err()
+}
+
+func checkBinary(m network.Module) error {
+	var p problems
+	if name := m.BinaryName(); name == "" {
+		p.addf("BinaryName is empty")
+	} else if strings.ContainsAny(name, `/\ `) {
+		p.addf("BinaryName %q must be a file name, not a path", name)
+	}
+	if m.DefaultBinaryVersion() == "" {
+		p.addf("DefaultBinaryVersion is empty")
+	}
+
+	src := m.BinarySource()
+	switch {
+	case src.IsGitHub():
+		if src.Owner == "" || src.Repo == "" {
+			p.addf("BinarySource of type github needs Owner and Repo, got %q/%q", src.Owner, src.Repo)
+		}
+	case src.Type == "local":
+		if src.LocalPath == "" {
+			p.addf("BinarySource of type local needs LocalPath")
+		}
+	case src.Type == "docker":
+	default:
+		p.addf("BinarySource type %q is not github, local or docker", src.Type)
+	}
+	return p.err()
+}
+
+func checkBuildConfig(m network.Module) error {
+	var p problems
+	for _, n := range m.AvailableNetworks() {
+		cfg, err := m.GetBuildConfig(n)
+		if err != nil {
+			// Modules may not support building for every network
+			continue
+		}
+		if err := cfg.Validate(); err != nil {
+			p.addf("GetBuildConfig(%q): %v", n, err)
+		}
+	}
+	return p.err()
+}
+
+func checkChain(m network.Module) error {
+	var p problems
+	if m.DefaultChainID() == "" {
+		p.addf("DefaultChainID is empty")
+	}
+	if m.Bech32Prefix() == "" {
+		p.addf("Bech32Prefix is empty")
+	}
+	if m.BaseDenom() == "" {
+		p.addf("BaseDenom is empty")
+	}
+
+	cfg := m.GenesisConfig()
+	if cfg.BaseDenom != "" && cfg.BaseDenom != m.BaseDenom() {
+		p.addf("GenesisConfig().BaseDenom %q differs from BaseDenom %q", cfg.BaseDenom, m.BaseDenom())
+	}
+	for name, d := range map[string]time.Duration{
+		"UnbondingTime":    cfg.UnbondingTime,
+		"VotingPeriod":     cfg.VotingPeriod,
+		"MaxDepositPeriod": cfg.MaxDepositPeriod,
+	} {
+		if d < 0 {
+			p.addf("GenesisConfig().%s is negative: %s", name, d)
+		}
+	}
+	return p.err()
+}
+
+func checkPorts(m network.Module) error {
+	var p problems
+	ports := m.DefaultPorts()
+	named := []struct {
+		name     string
+		port     int
+		required bool
+	}{
+		{"RPC", ports.RPC, true},
+		{"P2P", ports.P2P, true},
+		{"GRPC", ports.GRPC, true},
+		{"API", ports.API, true},
+		{"GRPCWeb", ports.GRPCWeb, false},
+		{"EVMRPC", ports.EVMRPC, false},
+		{"EVMSocket", ports.EVMSocket, false},
+	}
+
+	used := make(map[int]string)
+	for _, np := range named {
+		if np.port == 0 {
+			if np.required {
+				p.addf("DefaultPorts().%s is not set", np.name)
+			}
+			continue
+		}
+		if np.port < 1 || np.port > 65535 {
+			p.addf("DefaultPorts().%s %d is out of range", np.name, np.port)
+			continue
+		}
+		if other, ok := used[np.port]; ok {
+			p.addf("DefaultPorts().%s and %s are both %d", other, np.name, np.port)
+		}
+		used[np.port] = np.name
+	}
+	return p.err()
+}
+
+func checkDocker(m network.Module) error {
+	var p problems
+	if m.DockerImage() != "" && m.DockerImageTag(goldenVersion) == "" {
+		p.addf("DockerImageTag(%q) is empty", goldenVersion)
+	}
+	if home := m.DockerHomeDir(); home != "" && !path.IsAbs(home) {
+		p.addf("DockerHomeDir %q is not an absolute path", home)
+	}
+	return p.err()
+}
+
+func checkPaths(m network.Module) error {
+	var p problems
+	if m.DefaultNodeHome() == "" {
+		p.addf("DefaultNodeHome is empty")
+	}
+	for method, name := range map[string]string{
+		"PIDFileName": m.PIDFileName(),
+		"LogFileName": m.LogFileName(),
+	} {
+		if name == "" || strings.ContainsAny(name, `/\`) {
+			p.addf("%s %q must be a file name", method, name)
+		}
+	}
+	if pattern := m.ProcessPattern(); pattern == "" {
+		p.addf("ProcessPattern is empty")
+	} else if _, err := regexp.Compile(pattern); err != nil {
+		p.addf("ProcessPattern %q is not a valid pattern: %v", pattern, err)
+	}
+	return p.err()
+}
+
+func checkCommands(m network.Module) error {
+	var p problems
+	binary := m.BinaryName()
+	args := func(method string, got []string, want ...string) {
+		if len(got) == 0 {
+			p.addf("%s returns no arguments", method)
+			return
+		}
+		if got[0] == binary {
+			p.addf("%s must return the binary's arguments, not start with the binary %q", method, binary)
+		}
+		for _, w := range want {
+			if !containsArg(got, w) {
+				p.addf("%s %q does not pass %q", method, got, w)
+			}
+		}
+	}
+
+	args("InitCommand", m.InitCommand(goldenHome, goldenChainID, goldenMoniker), goldenHome, goldenChainID, goldenMoniker)
+	args("ExportCommand", m.ExportCommand(goldenHome), goldenHome)
+	for _, n := range append([]string{""}, m.AvailableNetworks()...) {
+		args(fmt.Sprintf("StartCommand(%q)", n), m.StartCommand(goldenHome, n), goldenHome)
+	}
+	return p.err()
+}
+
+// containsArg reports whether args pass value, either alone or as
+// --flag=value.
+func containsArg(args []string, value string) bool {
+	for _, a := range args {
+		if a == value || strings.HasSuffix(a, "="+value) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkGenesis(m network.Module) error {
+	var p problems
+	input := GoldenGenesis()
+	out, err := m.ModifyGenesis(input, network.GenesisOptions{ChainID: goldenChainID, NumValidators: 1})
+	if err != nil {
+		return fmt.Errorf("ModifyGenesis of the golden genesis: %w", err)
+	}
+	if string(input) != goldenGenesis {
+		p.addf("ModifyGenesis modified its input")
+	}
+	if err := checkPatchedGenesis(out); err != nil {
+		p.addf("ModifyGenesis: %v", err)
+	}
+
+	if _, err := m.ModifyGenesis([]byte(`{"chain_id": `), network.GenesisOptions{ChainID: goldenChainID}); err == nil {
+		p.addf("ModifyGenesis of malformed JSON returned no error")
+	}
+	return p.err()
+}
+
+// checkPatchedGenesis checks a genesis ModifyGenesis returned for the
+// golden genesis: it is a JSON object with the golden chain ID and every
+// module of the golden app_state.
+func checkPatchedGenesis(genesis []byte) error {
+	var doc struct {
+		ChainID  string                     `json:"chain_id"`
+		AppState map[string]json.RawMessage `json:"app_state"`
+	}
+	if err := json.Unmarshal(genesis, &doc); err != nil {
+		return fmt.Errorf("result is not a genesis object: %w", err)
+	}
+
+	var p problems
+	if doc.ChainID != goldenChainID {
+		p.addf("chain_id is %q, want %q", doc.ChainID, goldenChainID)
+	}
+	var golden struct {
+		AppState map[string]json.RawMessage `json:"app_state"`
+	}
+	_ = json.Unmarshal([]byte(goldenGenesis), &golden)
+	for module := range golden.AppState {
+		if _, ok := doc.AppState[module]; !ok {
+			p.addf("app_state.%s was dropped", module)
+		}
+	}
+	return p.err()
+}
+
+func checkGenesisFile(m network.Module) error {
+	modifier, ok := m.(network.FileBasedGenesisModifier)
+	if !ok {
+		return nil
+	}
+
+	dir, err := os.MkdirTemp("", "plugintest-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "genesis.json")
+	output := filepath.Join(dir, "genesis.out.json")
+	if err := os.WriteFile(input, GoldenGenesis(), 0o644); err != nil {
+		return err
+	}
+
+	size, err := modifier.ModifyGenesisFile(input, output, network.GenesisOptions{ChainID: goldenChainID, NumValidators: 1})
+	if status.Code(err) == codes.Unimplemented {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("ModifyGenesisFile of the golden genesis: %w", err)
+	}
+
+	var p problems
+	genesis, err := os.ReadFile(output)
+	if err != nil {
+		return fmt.Errorf("ModifyGenesisFile did not write its output: %w", err)
+	}
+	if size != int64(len(genesis)) {
+		p.addf("ModifyGenesisFile reports %d bytes, but wrote %d", size, len(genesis))
+	}
+	if err := checkPatchedGenesis(genesis); err != nil {
+		p.addf("ModifyGenesisFile: %v", err)
+	}
+	return p.err()
+}
+
+func checkGenerator(m network.Module) error {
+	var p problems
+	cfg := m.DefaultGeneratorConfig()
+	if cfg.NumValidators < 1 {
+		p.addf("DefaultGeneratorConfig().NumValidators is %d, want at least 1", cfg.NumValidators)
+	}
+	if cfg.NumAccounts < 0 {
+		p.addf("DefaultGeneratorConfig().NumAccounts is negative: %d", cfg.NumAccounts)
+	}
+	return p.err()
+}
+
+func checkCodec(m network.Module) error {
+	if _, err := m.GetCodec(); err != nil {
+		return fmt.Errorf("GetCodec: %w", err)
+	}
+	return nil
+}
+
+func checkValidate(m network.Module) error {
+	if err := m.Validate(); err != nil {
+		return fmt.Errorf("Validate: %w", err)
+	}
+	return nil
+}
+
+func checkNetworks(m network.Module) error {
+	var p problems
+	networks := m.AvailableNetworks()
+	seen := make(map[string]bool)
+	for _, n := range networks {
+		if n == "" || seen[n] {
+			p.addf("AvailableNetworks %q has an empty or duplicate network", networks)
+		}
+		seen[n] = true
+
+		for method, raw := range map[string]string{
+			"SnapshotURL": m.SnapshotURL(n),
+			"RPCEndpoint": m.RPCEndpoint(n),
+		} {
+			if raw == "" {
+				continue
+			}
+			if u, err := url.Parse(raw); err != nil || u.Scheme == "" || u.Host == "" {
+				p.addf("%s(%q) %q is not an absolute URL", method, n, raw)
+			}
+		}
+	}
+
+	if u := m.SnapshotURL(unknownNetwork); u != "" {
+		p.addf("SnapshotURL(%q) = %q, want empty for an unknown network", unknownNetwork, u)
+	}
+	if u := m.RPCEndpoint(unknownNetwork); u != "" {
+		p.addf("RPCEndpoint(%q) = %q, want empty for an unknown network", unknownNetwork, u)
+	}
+	return p.err()
+}
+
+func checkConfigOverrides(m network.Module) error {
+	var p problems
+	for _, index := range []int{0, 4} {
+		opts := goldenNodeOptions(m, index)
+		configToml, appToml, err := m.GetConfigOverrides(index, opts)
+		if err != nil {
+			p.addf("GetConfigOverrides(%d): %v", index, err)
+			continue
+		}
+		for file, data := range map[string][]byte{"config.toml": configToml, "app.toml": appToml} {
+			var doc map[string]interface{}
+			if err := toml.Unmarshal(data, &doc); err != nil {
+				p.addf("GetConfigOverrides(%d) %s is not valid TOML: %v", index, file, err)
+			}
+		}
+	}
+	return p.err()
+}
+
+// govParamsProvider is the governance query the plugin server delegates to.
+type govParamsProvider interface {
+	GetGovernanceParams(rpcEndpoint, networkType string) (*plugin.GovernanceParamsResponse, error)
+}
+
+// checkRPCDelegation calls the RPC methods of a module that implements
+// plugin.RPCProvider against an endpoint that refuses connections. Each
+// must return promptly with an error, as a Go error or in the response's
+// Error field, or a response; none may hang or return neither.
+func checkRPCDelegation(m network.Module) error {
+	var p problems
+	call := func(method string, fn func(ctx context.Context) (interface{ GetError() string }, error)) {
+		ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+		defer cancel()
+
+		type result struct {
+			resp interface{ GetError() string }
+			err  error
+		}
+		done := make(chan result, 1)
+		go func() {
+			resp, err := fn(ctx)
+			done <- result{resp, err}
+		}()
+
+		select {
+		case r := <-done:
+			if status.Code(r.err) == codes.Unimplemented {
+				return
+			}
+			if r.err == nil && isNil(r.resp) {
+				p.addf("%s returned neither a response nor an error", method)
+			}
+		case <-time.After(rpcTimeout + rpcGrace):
+			p.addf("%s did not return within %s of its context deadline", method, rpcGrace)
+		}
+	}
+
+	if rpc, ok := m.(plugin.RPCProvider); ok {
+		call("GetBlockHeight", func(ctx context.Context) (interface{ GetError() string }, error) {
+			return rpc.GetBlockHeight(ctx, unreachableEndpoint)
+		})
+		call("GetBlockTime", func(ctx context.Context) (interface{ GetError() string }, error) {
+			return rpc.GetBlockTime(ctx, unreachableEndpoint, 10)
+		})
+		call("IsChainRunning", func(ctx context.Context) (interface{ GetError() string }, error) {
+			return rpc.IsChainRunning(ctx, unreachableEndpoint)
+		})
+		call("WaitForBlock", func(ctx context.Context) (interface{ GetError() string }, error) {
+			return rpc.WaitForBlock(ctx, unreachableEndpoint, 1<<40, rpcTimeout.Milliseconds())
+		})
+		call("GetProposal", func(ctx context.Context) (interface{ GetError() string }, error) {
+			return rpc.GetProposal(ctx, unreachableEndpoint, 1)
+		})
+		call("GetUpgradePlan", func(ctx context.Context) (interface{ GetError() string }, error) {
+			return rpc.GetUpgradePlan(ctx, unreachableEndpoint)
+		})
+		call("GetAppVersion", func(ctx context.Context) (interface{ GetError() string }, error) {
+			return rpc.GetAppVersion(ctx, unreachableEndpoint)
+		})
+	}
+	if gov, ok := m.(govParamsProvider); ok {
+		call("GetGovernanceParams", func(context.Context) (interface{ GetError() string }, error) {
+			return gov.GetGovernanceParams(unreachableEndpoint, "devnet")
+		})
+	}
+	return p.err()
+}
+
+// isNil reports whether v is nil or holds a nil pointer.
+func isNil(v interface{ GetError() string }) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
+
+func checkParamsLayout(m network.Module) error {
+	provider, ok := m.(network.ParamsLayoutProvider)
+	if !ok {
+		return nil
+	}
+
+	var p problems
+	for _, module := range []string{"auth", "bank", "staking", "gov", "distribution", "slashing", unknownModule} {
+		layout, ok := provider.ParamsLayout(module)
+		if !ok {
+			continue
+		}
+		if !strings.HasPrefix(layout.QueryPath, "/") {
+			p.addf("ParamsLayout(%q).QueryPath %q must be an absolute REST path", module, layout.QueryPath)
+		}
+		if !strings.HasPrefix(layout.MsgTypeURL, "/") {
+			p.addf("ParamsLayout(%q).MsgTypeURL %q must be a type URL starting with '/'", module, layout.MsgTypeURL)
+		}
+	}
+	return p.err()
+}
+
+func checkPassthrough(m network.Module) error {
+	var p problems
+	names := make(map[string]bool)
+	for _, c := range network.PassthroughCommandsFor(m) {
+		if c.Name == "" {
+			p.addf("passthrough command with no name")
+		}
+		for _, name := range append([]string{c.Name}, c.Aliases...) {
+			if names[name] {
+				p.addf("passthrough command name %q is used twice", name)
+			}
+			names[name] = true
+		}
+		for _, f := range c.Flags {
+			if !slices.Contains([]network.PassthroughFlag{network.PassthroughHome, network.PassthroughNode, network.PassthroughChainID}, f) {
+				p.addf("passthrough command %q injects unknown flag %q", c.Name, f)
+			}
+		}
+	}
+	return p.err()
+}
@@ -0,0 +1,232 @@
+// Package plugintest provides a conformance suite for network.Module
+// implementations, so plugin authors can validate a module without running
+// the devnet-builder daemon or the chain binary:
+//
+//	func TestConformance(t *testing.T) {
+//		plugintest.RunConformance(t, &MyNetwork{})
+//	}
+//
+// The suite calls every Module method with golden inputs and checks the
+// results the way devnet-builder uses them, once on the module itself and
+// once through the gRPC plugin protocol. GenerateDevnet is not called, as it
+// runs the chain binary.
+package plugintest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"runtime/debug"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"github.com/altuslabsxyz/devnet-builder/pkg/network/plugin"
+)
+
+// RunConformance runs the conformance checks against module as subtests of
+// t, first calling the module directly and then through a gRPC plugin
+// client, and checks that both give the same results.
+func RunConformance(t *testing.T, module network.Module) {
+	t.Helper()
+
+	t.Run("direct", func(t *testing.T) {
+		runChecks(t, module)
+	})
+	t.Run("grpc", func(t *testing.T) {
+		client := serveGRPC(t, module)
+		runChecks(t, client)
+		t.Run("round-trip", func(t *testing.T) {
+			if err := checkRoundTrip(module, client); err != nil {
+				t.Error(err)
+			}
+		})
+	})
+}
+
+// check is a conformance check. It returns every problem it finds.
+type check struct {
+	name string
+	run  func(m network.Module) error
+}
+
+// checks are run in order against each transport.
+var checks = []check{
+	{"identity", checkIdentity},
+	{"binary", checkBinary},
+	{"build-config", checkBuildConfig},
+	{"chain", checkChain},
+	{"ports", checkPorts},
+	{"docker", checkDocker},
+	{"paths", checkPaths},
+	{"commands", checkCommands},
+	{"genesis", checkGenesis},
+	{"genesis-file", checkGenesisFile},
+	{"generator", checkGenerator},
+	{"codec", checkCodec},
+	{"validate", checkValidate},
+	{"networks", checkNetworks},
+	{"config-overrides", checkConfigOverrides},
+	{"rpc-delegation", checkRPCDelegation},
+	{"params-layout", checkParamsLayout},
+	{"passthrough", checkPassthrough},
+}
+
+func runChecks(t *testing.T, m network.Module) {
+	for _, c := range checks {
+		t.Run(c.name, func(t *testing.T) {
+			if err := runCheck(c, m); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// runCheck runs c against m, reporting a panic as a problem.
+func runCheck(c check, m network.Module) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panicked: %v\n%s", r, debug.Stack())
+		}
+	}()
+	return c.run(m)
+}
+
+// problems collects what a check finds wrong.
+type problems []error
+
+func (p *problems) addf(format string, args ...interface{}) {
+	*p = append(*p, fmt.Errorf(format, args...))
+}
+
+func (p problems) err() error {
+	return errors.Join(p...)
+}
+
+// serveGRPC serves module over an in-memory gRPC connection, as the plugin
+// host would over go-plugin, and returns a client for it.
+func serveGRPC(t *testing.T, module network.Module) *plugin.GRPCClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.UnaryInterceptor(recoverUnary))
+	plugin.RegisterNetworkModuleServer(server, plugin.NewGRPCServer(module))
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///plugintest",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to connect to plugin server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return plugin.NewGRPCClient(conn)
+}
+
+// recoverUnary turns a panic in the module into an Internal error, so one
+// failing check does not take down the test binary.
+func recoverUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = status.Errorf(codes.Internal, "%s panicked: %v", info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// checkRoundTrip compares what module and client return for the same
+// calls, which catches values the plugin protocol does not carry, such as
+// sub-second durations.
+func checkRoundTrip(module, client network.Module) error {
+	var p problems
+	compare := func(method string, direct, remote interface{}) {
+		if !sameValue(direct, remote) {
+			p.addf("%s: module returns %#v, but %#v through gRPC", method, direct, remote)
+		}
+	}
+
+	compare("Name", module.Name(), client.Name())
+	compare("DisplayName", module.DisplayName(), client.DisplayName())
+	compare("Version", module.Version(), client.Version())
+	compare("BinaryName", module.BinaryName(), client.BinaryName())
+	compare("BinarySource", module.BinarySource(), client.BinarySource())
+	compare("DefaultBinaryVersion", module.DefaultBinaryVersion(), client.DefaultBinaryVersion())
+	compare("DefaultChainID", module.DefaultChainID(), client.DefaultChainID())
+	compare("Bech32Prefix", module.Bech32Prefix(), client.Bech32Prefix())
+	compare("BaseDenom", module.BaseDenom(), client.BaseDenom())
+	compare("GenesisConfig", module.GenesisConfig(), client.GenesisConfig())
+	compare("DefaultPorts", module.DefaultPorts(), client.DefaultPorts())
+	compare("DefaultGeneratorConfig", module.DefaultGeneratorConfig(), client.DefaultGeneratorConfig())
+	compare("DockerImage", module.DockerImage(), client.DockerImage())
+	compare("DockerImageTag", module.DockerImageTag(goldenVersion), client.DockerImageTag(goldenVersion))
+	compare("DockerHomeDir", module.DockerHomeDir(), client.DockerHomeDir())
+	compare("DefaultNodeHome", module.DefaultNodeHome(), client.DefaultNodeHome())
+	compare("PIDFileName", module.PIDFileName(), client.PIDFileName())
+	compare("LogFileName", module.LogFileName(), client.LogFileName())
+	compare("ProcessPattern", module.ProcessPattern(), client.ProcessPattern())
+	compare("InitCommand", module.InitCommand(goldenHome, goldenChainID, goldenMoniker), client.InitCommand(goldenHome, goldenChainID, goldenMoniker))
+	compare("ExportCommand", module.ExportCommand(goldenHome), client.ExportCommand(goldenHome))
+	compare("AvailableNetworks", module.AvailableNetworks(), client.AvailableNetworks())
+	for _, n := range module.AvailableNetworks() {
+		compare(fmt.Sprintf("StartCommand(%q)", n), module.StartCommand(goldenHome, n), client.StartCommand(goldenHome, n))
+		compare(fmt.Sprintf("SnapshotURL(%q)", n), module.SnapshotURL(n), client.SnapshotURL(n))
+		compare(fmt.Sprintf("RPCEndpoint(%q)", n), module.RPCEndpoint(n), client.RPCEndpoint(n))
+	}
+	compare("PassthroughCommands", network.PassthroughCommandsFor(module), network.PassthroughCommandsFor(client))
+	return p.err()
+}
+
+// sameValue is reflect.DeepEqual, except that nil and empty slices and maps
+// are the same, as protobuf does not tell them apart.
+func sameValue(a, b interface{}) bool {
+	return deepEqual(reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+func deepEqual(a, b reflect.Value) bool {
+	if a.Kind() != b.Kind() || a.Type() != b.Type() {
+		return false
+	}
+	switch a.Kind() {
+	case reflect.Slice:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !deepEqual(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for _, k := range a.MapKeys() {
+			bv := b.MapIndex(k)
+			if !bv.IsValid() || !deepEqual(a.MapIndex(k), bv) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if !deepEqual(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a.Interface(), b.Interface())
+	}
+}
@@ -0,0 +1,205 @@
+package plugintest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"github.com/altuslabsxyz/devnet-builder/pkg/network/plugin"
+)
+
+// fakeModule is a well-behaved module; tests break it one field at a time.
+type fakeModule struct {
+	name          string
+	ports         network.PortConfig
+	votingPeriod  time.Duration
+	keepInput     bool // ModifyGenesis returns the genesis unchanged
+	startWithHome bool
+	hangRPC       bool // GetBlockHeight ignores its context
+}
+
+func newFakeModule() *fakeModule {
+	return &fakeModule{
+		name:          "fake",
+		ports:         network.PortConfig{RPC: 26657, P2P: 26656, GRPC: 9090, GRPCWeb: 9091, API: 1317},
+		votingPeriod:  30 * time.Second,
+		startWithHome: true,
+	}
+}
+
+var (
+	_ network.Module     = (*fakeModule)(nil)
+	_ plugin.RPCProvider = (*fakeModule)(nil)
+)
+
+func (m *fakeModule) Name() string                 { return m.name }
+func (m *fakeModule) DisplayName() string          { return "Fake" }
+func (m *fakeModule) Version() string              { return "1.0.0" }
+func (m *fakeModule) BinaryName() string           { return "faked" }
+func (m *fakeModule) DefaultBinaryVersion() string { return "v1.0.0" }
+func (m *fakeModule) BinarySource() network.BinarySource {
+	return network.BinarySource{Type: "github", Owner: "example", Repo: "fake"}
+}
+func (m *fakeModule) GetBuildConfig(networkType string) (*network.BuildConfig, error) {
+	return &network.BuildConfig{Tags: []string{"netgo"}}, nil
+}
+func (m *fakeModule) DefaultChainID() string { return "fake-1" }
+func (m *fakeModule) Bech32Prefix() string   { return "fake" }
+func (m *fakeModule) BaseDenom() string      { return "ufake" }
+func (m *fakeModule) GenesisConfig() network.GenesisConfig {
+	return network.GenesisConfig{BaseDenom: "ufake", BondDenom: "ufake", VotingPeriod: m.votingPeriod}
+}
+func (m *fakeModule) DefaultPorts() network.PortConfig { return m.ports }
+func (m *fakeModule) DockerImage() string              { return "example/fake" }
+func (m *fakeModule) DockerImageTag(version string) string {
+	return version
+}
+func (m *fakeModule) DockerHomeDir() string   { return "/root/.fake" }
+func (m *fakeModule) DefaultNodeHome() string { return "/root/.fake" }
+func (m *fakeModule) PIDFileName() string     { return "faked.pid" }
+func (m *fakeModule) LogFileName() string     { return "faked.log" }
+func (m *fakeModule) ProcessPattern() string  { return `faked.*start` }
+func (m *fakeModule) InitCommand(homeDir, chainID, moniker string) []string {
+	return []string{"init", moniker, "--chain-id", chainID, "--home", homeDir}
+}
+func (m *fakeModule) StartCommand(homeDir string, networkMode string) []string {
+	if !m.startWithHome {
+		return []string{"start"}
+	}
+	return []string{"start", "--home=" + homeDir}
+}
+func (m *fakeModule) ExportCommand(homeDir string) []string {
+	return []string{"export", "--home", homeDir}
+}
+func (m *fakeModule) ModifyGenesis(genesis []byte, opts network.GenesisOptions) ([]byte, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(genesis, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse genesis: %w", err)
+	}
+	if m.keepInput {
+		return genesis, nil
+	}
+	doc["chain_id"] = opts.ChainID
+	return json.Marshal(doc)
+}
+func (m *fakeModule) GenerateDevnet(ctx context.Context, config network.GeneratorConfig, genesisFile string) error {
+	return errors.New("not implemented")
+}
+func (m *fakeModule) DefaultGeneratorConfig() network.GeneratorConfig {
+	return network.GeneratorConfig{NumValidators: 4, NumAccounts: 10, ChainID: "fake-1"}
+}
+func (m *fakeModule) GetCodec() ([]byte, error) { return nil, nil }
+func (m *fakeModule) Validate() error           { return nil }
+func (m *fakeModule) SnapshotURL(networkType string) string {
+	if networkType == "mainnet" {
+		return "https://snapshots.example.com/fake.tar.lz4"
+	}
+	return ""
+}
+func (m *fakeModule) RPCEndpoint(networkType string) string {
+	if networkType == "mainnet" {
+		return "https://rpc.example.com"
+	}
+	return ""
+}
+func (m *fakeModule) AvailableNetworks() []string { return []string{"mainnet"} }
+func (m *fakeModule) GetConfigOverrides(nodeIndex int, opts network.NodeConfigOptions) ([]byte, []byte, error) {
+	config := fmt.Sprintf("moniker = %q\n\n[p2p]\npersistent_peers = %q\n", opts.Moniker, opts.PersistentPeers)
+	app := fmt.Sprintf("[api]\nenable = true\naddress = \"tcp://0.0.0.0:%d\"\n", opts.Ports.API)
+	return []byte(config), []byte(app), nil
+}
+
+// rpcError is the fake's answer to every RPC call.
+func (m *fakeModule) rpcError(endpoint string) string {
+	return "connection refused: " + endpoint
+}
+
+func (m *fakeModule) GetBlockHeight(ctx context.Context, rpcEndpoint string) (*plugin.BlockHeightResponse, error) {
+	if m.hangRPC {
+		// Ignores ctx, as a plugin using http.Get would
+		time.Sleep(rpcTimeout + rpcGrace + time.Second)
+	}
+	return &plugin.BlockHeightResponse{Error: m.rpcError(rpcEndpoint)}, nil
+}
+func (m *fakeModule) GetBlockTime(ctx context.Context, rpcEndpoint string, sampleSize int) (*plugin.BlockTimeResponse, error) {
+	return &plugin.BlockTimeResponse{Error: m.rpcError(rpcEndpoint)}, nil
+}
+func (m *fakeModule) IsChainRunning(ctx context.Context, rpcEndpoint string) (*plugin.ChainStatusResponse, error) {
+	return &plugin.ChainStatusResponse{Error: m.rpcError(rpcEndpoint)}, nil
+}
+func (m *fakeModule) WaitForBlock(ctx context.Context, rpcEndpoint string, targetHeight int64, timeoutMs int64) (*plugin.WaitForBlockResponse, error) {
+	return &plugin.WaitForBlockResponse{Error: m.rpcError(rpcEndpoint)}, nil
+}
+func (m *fakeModule) GetProposal(ctx context.Context, rpcEndpoint string, proposalID uint64) (*plugin.ProposalResponse, error) {
+	return nil, errors.New(m.rpcError(rpcEndpoint))
+}
+func (m *fakeModule) GetUpgradePlan(ctx context.Context, rpcEndpoint string) (*plugin.UpgradePlanResponse, error) {
+	return &plugin.UpgradePlanResponse{Error: m.rpcError(rpcEndpoint)}, nil
+}
+func (m *fakeModule) GetAppVersion(ctx context.Context, rpcEndpoint string) (*plugin.AppVersionResponse, error) {
+	return &plugin.AppVersionResponse{Error: m.rpcError(rpcEndpoint)}, nil
+}
+
+func TestRunConformance(t *testing.T) {
+	RunConformance(t, newFakeModule())
+}
+
+func TestChecks_Failures(t *testing.T) {
+	tests := []struct {
+		name   string
+		check  string
+		breaks func(m *fakeModule)
+		want   string
+	}{
+		{"bad name", "identity", func(m *fakeModule) { m.name = "Fake Net" }, "must be lowercase"},
+		{"port clash", "ports", func(m *fakeModule) { m.ports.API = m.ports.RPC }, "are both 26657"},
+		{"missing port", "ports", func(m *fakeModule) { m.ports.P2P = 0 }, "P2P is not set"},
+		{"start without home", "commands", func(m *fakeModule) { m.startWithHome = false }, "does not pass"},
+		{"chain id not patched", "genesis", func(m *fakeModule) { m.keepInput = true }, `chain_id is "golden-1"`},
+		{"hanging rpc", "rpc-delegation", func(m *fakeModule) { m.hangRPC = true }, "did not return"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newFakeModule()
+			tt.breaks(m)
+			err := runCheck(findCheck(t, tt.check), m)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("check %s: got %v, want error containing %q", tt.check, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckRoundTrip_SubSecondDuration(t *testing.T) {
+	m := newFakeModule()
+	m.votingPeriod = 1500 * time.Millisecond
+
+	err := checkRoundTrip(m, serveGRPC(t, m))
+	if err == nil || !strings.Contains(err.Error(), "GenesisConfig") {
+		t.Errorf("got %v, want a GenesisConfig mismatch", err)
+	}
+}
+
+func TestRunCheck_Panic(t *testing.T) {
+	err := runCheck(check{"panics", func(network.Module) error { panic("boom") }}, newFakeModule())
+	if err == nil || !strings.Contains(err.Error(), "panicked: boom") {
+		t.Errorf("got %v, want a panic error", err)
+	}
+}
+
+func findCheck(t *testing.T, name string) check {
+	t.Helper()
+	for _, c := range checks {
+		if c.name == name {
+			return c
+		}
+	}
+	t.Fatalf("no check named %q", name)
+	return check{}
+}
@@ -0,0 +1,99 @@
+package plugintest
+
+import (
+	"fmt"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+)
+
+// Golden inputs the conformance checks call the module with.
+const (
+	goldenChainID = "conformance-1"
+	goldenHome    = "/tmp/plugintest/node0"
+	goldenMoniker = "node0"
+	goldenVersion = "v1.0.0"
+
+	// unreachableEndpoint refuses connections, for RPC error paths.
+	unreachableEndpoint = "http://127.0.0.1:1"
+
+	// unknownNetwork is a network type no module defines.
+	unknownNetwork = "plugintest-unknown"
+
+	// unknownModule is a Cosmos SDK module name no chain has.
+	unknownModule = "plugintest-unknown"
+)
+
+// goldenGenesis is a minimal Cosmos SDK genesis with the modules
+// devnet-builder patches.
+const goldenGenesis = `{
+  "genesis_time": "2024-01-01T00:00:00Z",
+  "chain_id": "golden-1",
+  "initial_height": "1",
+  "consensus": {
+    "params": {
+      "block": {"max_bytes": "22020096", "max_gas": "-1"},
+      "evidence": {"max_age_num_blocks": "100000", "max_age_duration": "172800000000000", "max_bytes": "1048576"},
+      "validator": {"pub_key_types": ["ed25519"]}
+    }
+  },
+  "app_state": {
+    "auth": {
+      "params": {"max_memo_characters": "256", "tx_sig_limit": "7", "tx_size_cost_per_byte": "10"},
+      "accounts": []
+    },
+    "bank": {
+      "params": {"send_enabled": [], "default_send_enabled": true},
+      "balances": [],
+      "supply": [],
+      "denom_metadata": []
+    },
+    "staking": {
+      "params": {"unbonding_time": "1814400s", "max_validators": 100, "max_entries": 7, "historical_entries": 10000, "bond_denom": "stake", "min_commission_rate": "0.000000000000000000"},
+      "validators": [],
+      "delegations": []
+    },
+    "gov": {
+      "params": {
+        "min_deposit": [{"denom": "stake", "amount": "10000000"}],
+        "max_deposit_period": "172800s",
+        "voting_period": "172800s",
+        "expedited_voting_period": "86400s",
+        "quorum": "0.334000000000000000"
+      }
+    },
+    "distribution": {
+      "params": {"community_tax": "0.020000000000000000", "withdraw_addr_enabled": true}
+    },
+    "slashing": {
+      "params": {"signed_blocks_window": "100", "min_signed_per_window": "0.500000000000000000"}
+    }
+  }
+}`
+
+// GoldenGenesis returns the genesis document the conformance checks pass
+// to ModifyGenesis, for plugin authors who test their genesis patching
+// further.
+func GoldenGenesis() []byte {
+	return []byte(goldenGenesis)
+}
+
+// goldenNodeOptions returns the options GetConfigOverrides is called with
+// for the node at index in a devnet of four validators; higher indexes
+// are full nodes.
+func goldenNodeOptions(module network.Module, index int) network.NodeConfigOptions {
+	ports := module.DefaultPorts()
+	offset := index * 100
+	for _, p := range []*int{&ports.RPC, &ports.P2P, &ports.GRPC, &ports.GRPCWeb, &ports.API, &ports.EVMRPC, &ports.EVMSocket} {
+		if *p != 0 {
+			*p += offset
+		}
+	}
+	return network.NodeConfigOptions{
+		ChainID:         goldenChainID,
+		Ports:           ports,
+		PersistentPeers: "3f2a5c1d9e8b7a6f5e4d3c2b1a0f9e8d7c6b5a49@127.0.0.1:26656",
+		NumValidators:   4,
+		IsValidator:     index < 4,
+		Moniker:         fmt.Sprintf("node%d", index),
+	}
+}
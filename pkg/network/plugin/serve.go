@@ -27,9 +27,14 @@ package plugin
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"runtime/debug"
 
 	hcplugin "github.com/hashicorp/go-plugin"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/altuslabsxyz/devnet-builder/pkg/network"
 )
@@ -65,12 +70,26 @@ func Serve(module network.Module) {
 			opts = append(opts,
 				grpc.MaxRecvMsgSize(maxGRPCMessageSize),
 				grpc.MaxSendMsgSize(maxGRPCMessageSize),
+				grpc.ChainUnaryInterceptor(recoverPanics),
 			)
 			return grpc.NewServer(opts...)
 		},
 	})
 }
 
+// recoverPanics turns a panic in an RPC handler into an error the host
+// reports as a plugin crash, so one bad call does not kill the plugin
+// process. The stack goes to stderr, which the host keeps for diagnostics.
+func recoverPanics(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Fprintf(os.Stderr, "panic: %v\n\n%s", r, debug.Stack())
+			err = status.Errorf(codes.Internal, panicPrefix+"%s: %v", methodName(info.FullMethod), r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
 // NetworkModulePlugin is the plugin.GRPCPlugin implementation for network modules.
 type NetworkModulePlugin struct {
 	hcplugin.Plugin
@@ -0,0 +1,123 @@
+package plugin
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResponseError is returned in strict mode when a plugin reports a failure
+// through the Error field of an RPC response instead of a gRPC status.
+type ResponseError struct {
+	PluginName string // Name of the plugin
+	Method     string // RPC method (e.g., "GetBlockHeight")
+	Message    string // Content of the response Error field
+}
+
+func (e *ResponseError) Error() string {
+	if e.PluginName != "" {
+		return fmt.Sprintf("plugin %s: %s: %s", e.PluginName, e.Method, e.Message)
+	}
+	return fmt.Sprintf("plugin: %s: %s", e.Method, e.Message)
+}
+
+// MethodStats counts calls to a single plugin RPC method.
+type MethodStats struct {
+	Method string
+
+	// Calls is the total number of calls.
+	Calls uint64
+	// Failures counts calls that failed with a gRPC error or returned a
+	// populated Error field.
+	Failures uint64
+	// Unimplemented counts calls the plugin does not implement. These are
+	// not failures; callers fall back to their built-in implementation.
+	Unimplemented uint64
+}
+
+// FailureRate returns the fraction of calls that failed.
+func (s MethodStats) FailureRate() float64 {
+	if s.Calls == 0 {
+		return 0
+	}
+	return float64(s.Failures) / float64(s.Calls)
+}
+
+// CallStats collects per-method call and failure counts for a plugin.
+// It is safe for concurrent use.
+type CallStats struct {
+	mu      sync.Mutex
+	methods map[string]*MethodStats
+}
+
+// NewCallStats creates an empty CallStats.
+func NewCallStats() *CallStats {
+	return &CallStats{methods: make(map[string]*MethodStats)}
+}
+
+// record counts one call to method with its outcome.
+func (s *CallStats) record(method string, err error, respErr string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.methods[method]
+	if !ok {
+		m = &MethodStats{Method: method}
+		s.methods[method] = m
+	}
+	m.Calls++
+	switch {
+	case status.Code(err) == codes.Unimplemented:
+		m.Unimplemented++
+	case err != nil || respErr != "":
+		m.Failures++
+	}
+}
+
+// Snapshot returns the current counts, sorted by method name.
+func (s *CallStats) Snapshot() []MethodStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := make([]MethodStats, 0, len(s.methods))
+	for _, m := range s.methods {
+		stats = append(stats, *m)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Method < stats[j].Method })
+	return stats
+}
+
+// SetStrict enables or disables strict mode. In strict mode a populated
+// Error field in an RPC delegation response is returned as a *ResponseError
+// naming the plugin and method, so callers cannot silently ignore it.
+func (c *GRPCClient) SetStrict(pluginName string, strict bool) {
+	c.pluginName = pluginName
+	c.strict = strict
+}
+
+// CallStats returns per-method call and failure counts for this plugin.
+func (c *GRPCClient) CallStats() []MethodStats {
+	if c.stats == nil {
+		return nil
+	}
+	return c.stats.Snapshot()
+}
+
+// checkResponse records the outcome of an RPC delegation call and returns
+// the error the caller should see: the gRPC error if any, and in strict mode
+// a *ResponseError for a populated response Error field.
+func (c *GRPCClient) checkResponse(method string, err error, respErr string) error {
+	if c.stats != nil {
+		c.stats.record(method, err, respErr)
+	}
+	if err != nil {
+		return err
+	}
+	if c.strict && respErr != "" {
+		return &ResponseError{PluginName: c.pluginName, Method: method, Message: respErr}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGRPCClient_StrictMode tests that strict mode turns response Error
+// fields into typed errors while the default mode leaves them to the caller.
+func TestGRPCClient_StrictMode(t *testing.T) {
+	mockClient := &mockNetworkModuleClient{
+		getGovernanceParamsFn: func(ctx context.Context, in *GovernanceParamsRequest, opts ...grpc.CallOption) (*GovernanceParamsResponse, error) {
+			return &GovernanceParamsResponse{Error: "unsupported network type"}, nil
+		},
+	}
+
+	client := &GRPCClient{client: mockClient, stats: NewCallStats()}
+
+	// Default mode: the error stays in the response.
+	resp, err := client.GetGovernanceParams("http://localhost:26657", "devnet")
+	if err != nil {
+		t.Fatalf("unexpected error in default mode: %v", err)
+	}
+	if resp.Error != "unsupported network type" {
+		t.Errorf("unexpected error field: %q", resp.Error)
+	}
+
+	// Strict mode: the error is returned with plugin and method context.
+	client.SetStrict("stable", true)
+	resp, err = client.GetGovernanceParams("http://localhost:26657", "devnet")
+	if resp != nil {
+		t.Errorf("expected nil response in strict mode, got %v", resp)
+	}
+	var respErr *ResponseError
+	if !errors.As(err, &respErr) {
+		t.Fatalf("expected *ResponseError, got %T: %v", err, err)
+	}
+	if respErr.PluginName != "stable" || respErr.Method != "GetGovernanceParams" {
+		t.Errorf("unexpected error context: %+v", respErr)
+	}
+	if want := "plugin stable: GetGovernanceParams: unsupported network type"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+// TestGRPCClient_CallStats tests call and failure counting.
+func TestGRPCClient_CallStats(t *testing.T) {
+	var next error
+	var nextErrField string
+	mockClient := &mockNetworkModuleClient{
+		getGovernanceParamsFn: func(ctx context.Context, in *GovernanceParamsRequest, opts ...grpc.CallOption) (*GovernanceParamsResponse, error) {
+			if next != nil {
+				return nil, next
+			}
+			return &GovernanceParamsResponse{Error: nextErrField}, nil
+		},
+	}
+	client := &GRPCClient{client: mockClient, stats: NewCallStats()}
+
+	calls := []struct {
+		err      error
+		errField string
+	}{
+		{nil, ""},
+		{nil, "boom"},
+		{status.Error(codes.Unavailable, "plugin crashed"), ""},
+		{status.Error(codes.Unimplemented, "not implemented"), ""},
+	}
+	for _, c := range calls {
+		next, nextErrField = c.err, c.errField
+		_, _ = client.GetGovernanceParams("http://localhost:26657", "devnet")
+	}
+
+	stats := client.CallStats()
+	if len(stats) != 1 {
+		t.Fatalf("expected stats for one method, got %v", stats)
+	}
+	got := stats[0]
+	if got.Method != "GetGovernanceParams" || got.Calls != 4 || got.Failures != 2 || got.Unimplemented != 1 {
+		t.Errorf("unexpected stats: %+v", got)
+	}
+	if got.FailureRate() != 0.5 {
+		t.Errorf("FailureRate() = %v, want 0.5", got.FailureRate())
+	}
+}
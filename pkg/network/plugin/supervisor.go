@@ -0,0 +1,460 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	hcplugin "github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Every loaded plugin runs under a supervisor that sits between the
+// generated gRPC client and the plugin process. When the process dies
+// during a call, the call fails with a *CrashError naming the plugin and
+// method, and the process is restarted on the next call once its restart
+// backoff has elapsed. Plugins built with this package also recover panics
+// in RPC handlers; those calls fail with a *CrashError too, but the process
+// keeps running.
+
+const (
+	// restartBackoffInitial is the delay before restarting a crashed plugin.
+	restartBackoffInitial = time.Second
+
+	// restartBackoffMax caps the restart delay of a crash-looping plugin.
+	restartBackoffMax = 30 * time.Second
+
+	// stableRunTime is how long a plugin must run before a crash resets its
+	// restart backoff.
+	stableRunTime = time.Minute
+
+	// exitDetectTimeout bounds how long a failed call waits for the plugin
+	// process exit to be observed.
+	exitDetectTimeout = 500 * time.Millisecond
+
+	// stderrTailSize is how much of a plugin's stderr is kept for crash reports.
+	stderrTailSize = 8 * 1024
+
+	// panicPrefix starts the status message of a panic recovered by the
+	// plugin's gRPC server.
+	panicPrefix = "plugin panic in "
+)
+
+// ErrPluginClosed is returned by calls to a plugin that has been closed.
+var ErrPluginClosed = errors.New("plugin closed")
+
+// CrashError reports a plugin process that crashed, or a panic the plugin
+// recovered, while serving an RPC.
+type CrashError struct {
+	PluginName string        // Name of the plugin
+	Method     string        // RPC method; empty if the process exited between calls
+	Reason     string        // Panic message or last stderr line
+	Stderr     string        // Tail of the plugin's stderr
+	Recovered  bool          // The plugin recovered the panic and is still running
+	RestartIn  time.Duration // Delay before the plugin is restarted
+}
+
+func (e *CrashError) Error() string {
+	var b strings.Builder
+	switch {
+	case e.Recovered:
+		fmt.Fprintf(&b, "plugin '%s' panicked during %s", e.PluginName, e.Method)
+	case e.Method != "":
+		fmt.Fprintf(&b, "plugin '%s' crashed during %s", e.PluginName, e.Method)
+	default:
+		fmt.Fprintf(&b, "plugin '%s' exited unexpectedly", e.PluginName)
+	}
+	if e.Reason != "" {
+		fmt.Fprintf(&b, ": %s", e.Reason)
+	}
+	return b.String()
+}
+
+// pluginProcess is a running plugin process and its gRPC connection.
+type pluginProcess interface {
+	Conn() grpc.ClientConnInterface
+	Exited() bool
+	Kill()
+}
+
+// supervisor implements grpc.ClientConnInterface on top of a plugin process
+// that it restarts after crashes.
+type supervisor struct {
+	name    string
+	start   func(stderr io.Writer) (pluginProcess, error)
+	onCrash func(*CrashError)
+	logger  hclog.Logger
+	now     func() time.Time
+	sleep   func(ctx context.Context, d time.Duration) error
+
+	mu        sync.Mutex
+	proc      pluginProcess
+	stderr    *tailBuffer
+	startedAt time.Time
+	backoff   time.Duration
+	restartAt time.Time
+	lastCrash *CrashError
+	closed    bool
+}
+
+var _ grpc.ClientConnInterface = (*supervisor)(nil)
+
+func newSupervisor(name string, start func(stderr io.Writer) (pluginProcess, error), logger hclog.Logger, onCrash func(*CrashError)) *supervisor {
+	return &supervisor{
+		name:    name,
+		start:   start,
+		onCrash: onCrash,
+		logger:  logger,
+		now:     time.Now,
+		sleep:   sleepContext,
+	}
+}
+
+// launch starts the first plugin process.
+func (s *supervisor) launch() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.startLocked()
+}
+
+func (s *supervisor) startLocked() error {
+	stderr := newTailBuffer(stderrTailSize)
+	proc, err := s.start(stderr)
+	if err != nil {
+		return err
+	}
+	s.proc = proc
+	s.stderr = stderr
+	s.startedAt = s.now()
+	return nil
+}
+
+// close kills the plugin process and fails all later calls.
+func (s *supervisor) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	if s.proc != nil {
+		s.proc.Kill()
+		s.proc = nil
+	}
+}
+
+// Invoke performs a unary RPC on the plugin, restarting it first if it
+// crashed earlier.
+func (s *supervisor) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	proc, err := s.running(ctx, method)
+	if err != nil {
+		return err
+	}
+	if err := proc.Conn().Invoke(ctx, method, args, reply, opts...); err != nil {
+		return s.checkCrash(proc, method, err)
+	}
+	return nil
+}
+
+// NewStream opens a stream on the plugin, restarting it first if it
+// crashed earlier.
+func (s *supervisor) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	proc, err := s.running(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := proc.Conn().NewStream(ctx, desc, method, opts...)
+	if err != nil {
+		return nil, s.checkCrash(proc, method, err)
+	}
+	return stream, nil
+}
+
+// running returns a live plugin process. If the process exited, it is
+// restarted once the restart backoff has elapsed, waiting for it unless
+// ctx is done first.
+func (s *supervisor) running(ctx context.Context, method string) (pluginProcess, error) {
+	for {
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			return nil, &PluginError{Op: methodName(method), PluginName: s.name, Err: ErrPluginClosed}
+		}
+		if s.proc != nil && !s.proc.Exited() {
+			proc := s.proc
+			s.mu.Unlock()
+			return proc, nil
+		}
+
+		var crash *CrashError
+		if s.proc != nil {
+			crash = s.crashedLocked("")
+		}
+
+		wait := s.restartAt.Sub(s.now())
+		if wait <= 0 {
+			err := s.startLocked()
+			if err != nil {
+				s.bumpBackoffLocked()
+			}
+			proc, backoff := s.proc, s.backoff
+			s.mu.Unlock()
+
+			s.report(crash)
+			if err != nil {
+				s.logger.Error("plugin restart failed", "name", s.name, "error", err, "retry_in", backoff)
+				return nil, &PluginError{Op: "restart", PluginName: s.name, Err: err}
+			}
+			s.logger.Info("plugin restarted", "name", s.name)
+			return proc, nil
+		}
+		s.mu.Unlock()
+
+		s.report(crash)
+		if err := s.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// checkCrash turns a failed call into a *CrashError if the plugin panicked
+// or its process exited. Other errors are returned unchanged.
+func (s *supervisor) checkCrash(proc pluginProcess, method string, err error) error {
+	st, _ := status.FromError(err)
+
+	if st.Code() == codes.Internal && strings.HasPrefix(st.Message(), panicPrefix) {
+		_, reason, _ := strings.Cut(strings.TrimPrefix(st.Message(), panicPrefix), ": ")
+		crash := &CrashError{
+			PluginName: s.name,
+			Method:     methodName(method),
+			Reason:     reason,
+			Recovered:  true,
+		}
+		s.report(crash)
+		return crash
+	}
+
+	if st.Code() != codes.Unavailable || !waitExited(proc, exitDetectTimeout) {
+		return err
+	}
+
+	s.mu.Lock()
+	if s.proc != proc {
+		if s.closed || s.lastCrash == nil {
+			s.mu.Unlock()
+			return err
+		}
+		// A concurrent call already recorded this crash
+		crash := *s.lastCrash
+		s.mu.Unlock()
+		crash.Method = methodName(method)
+		return &crash
+	}
+	crash := s.crashedLocked(methodName(method))
+	s.mu.Unlock()
+
+	s.report(crash)
+	return crash
+}
+
+// crashedLocked records that the current process exited and schedules its
+// restart.
+func (s *supervisor) crashedLocked(method string) *CrashError {
+	stable := s.now().Sub(s.startedAt) >= stableRunTime
+	if stable {
+		s.backoff = 0
+	}
+	s.bumpBackoffLocked()
+
+	s.proc.Kill()
+	s.proc = nil
+
+	stderr := s.stderr.String()
+	s.lastCrash = &CrashError{
+		PluginName: s.name,
+		Method:     method,
+		Reason:     crashReason(stderr),
+		Stderr:     stderr,
+		RestartIn:  s.backoff,
+	}
+	return s.lastCrash
+}
+
+// bumpBackoffLocked doubles the restart backoff and schedules the next restart.
+func (s *supervisor) bumpBackoffLocked() {
+	switch {
+	case s.backoff == 0:
+		s.backoff = restartBackoffInitial
+	case s.backoff < restartBackoffMax:
+		s.backoff = min(s.backoff*2, restartBackoffMax)
+	}
+	s.restartAt = s.now().Add(s.backoff)
+}
+
+func (s *supervisor) report(crash *CrashError) {
+	if crash == nil {
+		return
+	}
+	if s.onCrash != nil {
+		s.onCrash(crash)
+		return
+	}
+	if crash.Recovered {
+		s.logger.Error("plugin panicked", "name", s.name, "method", crash.Method, "panic", crash.Reason)
+	} else {
+		s.logger.Error("plugin crashed", "name", s.name, "method", crash.Method,
+			"reason", crash.Reason, "restart_in", crash.RestartIn, "stderr", crash.Stderr)
+	}
+}
+
+// waitExited reports whether proc exits within timeout. A call fails as
+// soon as the connection drops, slightly before the process exit is
+// observed.
+func waitExited(proc pluginProcess, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		if proc.Exited() {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// methodName returns the short method name of a full gRPC method, e.g.
+// "ModifyGenesis" for "/plugin.NetworkModule/ModifyGenesis".
+func methodName(fullMethod string) string {
+	return path.Base(fullMethod)
+}
+
+// crashReason picks the most useful line from a crashed plugin's stderr:
+// the panic or fatal error message if there is one, else the last line
+// that is not a structured log entry.
+func crashReason(stderr string) string {
+	var last string
+	for _, line := range strings.Split(stderr, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "panic: ") || strings.HasPrefix(line, "fatal error: ") {
+			return line
+		}
+		if line != "" && !strings.HasPrefix(line, `{"@`) {
+			last = line
+		}
+	}
+	return last
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// tailBuffer keeps the last bytes written to it. It is safe for concurrent use.
+type tailBuffer struct {
+	mu   sync.Mutex
+	size int
+	buf  []byte
+}
+
+func newTailBuffer(size int) *tailBuffer {
+	return &tailBuffer{size: size}
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.buf = append(b.buf, p...)
+	if over := len(b.buf) - b.size; over > 0 {
+		b.buf = append(b.buf[:0], b.buf[over:]...)
+	}
+	return len(p), nil
+}
+
+func (b *tailBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return string(b.buf)
+}
+
+// hcProcess is a plugin process started by go-plugin.
+type hcProcess struct {
+	client *hcplugin.Client
+	conn   *grpc.ClientConn
+}
+
+func (p *hcProcess) Conn() grpc.ClientConnInterface { return p.conn }
+func (p *hcProcess) Exited() bool                   { return p.client.Exited() }
+func (p *hcProcess) Kill()                          { p.client.Kill() }
+
+// processStarter returns a function that starts the plugin binary at
+// pluginPath. With a work directory configured, the process gets a scoped
+// working directory, a subdirectory with HOME and TMPDIR pointing into it,
+// so files a plugin writes to relative or home paths stay out of the
+// daemon's directories.
+func (l *Loader) processStarter(name, pluginPath string) func(stderr io.Writer) (pluginProcess, error) {
+	return func(stderr io.Writer) (pluginProcess, error) {
+		cmd := exec.Command(pluginPath)
+		if l.workDir != "" {
+			dir := filepath.Join(l.workDir, name)
+			if err := os.MkdirAll(filepath.Join(dir, "tmp"), 0700); err != nil {
+				return nil, &PluginError{Op: "workdir", PluginName: name, Err: err}
+			}
+			cmd.Dir = dir
+			cmd.Env = scopedEnv(os.Environ(), dir)
+		}
+
+		client := hcplugin.NewClient(&hcplugin.ClientConfig{
+			HandshakeConfig: Handshake,
+			Plugins: map[string]hcplugin.Plugin{
+				"network": &NetworkModulePlugin{},
+			},
+			Cmd:              cmd,
+			AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
+			Logger:           l.logger,
+			Stderr:           stderr,
+		})
+
+		rpcClient, err := client.Client()
+		if err != nil {
+			client.Kill()
+			return nil, &PluginError{Op: "connect", PluginName: name, Err: err}
+		}
+		grpcClient, ok := rpcClient.(*hcplugin.GRPCClient)
+		if !ok {
+			client.Kill()
+			return nil, &PluginError{Op: "dispense", PluginName: name, Err: fmt.Errorf("plugin does not speak gRPC")}
+		}
+
+		return &hcProcess{client: client, conn: grpcClient.Conn}, nil
+	}
+}
+
+// scopedEnv returns env with HOME and TMPDIR pointing into dir.
+func scopedEnv(env []string, dir string) []string {
+	scoped := make([]string, 0, len(env)+2)
+	for _, kv := range env {
+		if strings.HasPrefix(kv, "HOME=") || strings.HasPrefix(kv, "TMPDIR=") {
+			continue
+		}
+		scoped = append(scoped, kv)
+	}
+	return append(scoped, "HOME="+dir, "TMPDIR="+filepath.Join(dir, "tmp"))
+}
@@ -0,0 +1,274 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeProcess is a plugin process whose calls are answered by invoke.
+type fakeProcess struct {
+	invoke func(method string) error
+	exited atomic.Bool
+	killed atomic.Bool
+}
+
+func (p *fakeProcess) Conn() grpc.ClientConnInterface { return p }
+func (p *fakeProcess) Exited() bool                   { return p.exited.Load() }
+func (p *fakeProcess) Kill()                          { p.killed.Store(true) }
+
+func (p *fakeProcess) Invoke(_ context.Context, method string, _, _ any, _ ...grpc.CallOption) error {
+	return p.invoke(method)
+}
+
+func (p *fakeProcess) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not implemented")
+}
+
+// supervisorHarness starts fake processes and controls the supervisor's clock.
+type supervisorHarness struct {
+	sup     *supervisor
+	procs   []*fakeProcess
+	crashes []*CrashError
+	now     time.Time
+	slept   []time.Duration
+
+	// next configures each new process; the stderr writer receives its output.
+	next     func(p *fakeProcess, stderr io.Writer)
+	startErr error
+}
+
+func newSupervisorHarness(t *testing.T) *supervisorHarness {
+	t.Helper()
+	h := &supervisorHarness{now: time.Unix(1_700_000_000, 0)}
+	h.next = func(p *fakeProcess, _ io.Writer) {
+		p.invoke = func(string) error { return nil }
+	}
+	start := func(stderr io.Writer) (pluginProcess, error) {
+		if h.startErr != nil {
+			return nil, h.startErr
+		}
+		p := &fakeProcess{}
+		h.next(p, stderr)
+		h.procs = append(h.procs, p)
+		return p, nil
+	}
+	h.sup = newSupervisor("cosmos", start, hclog.NewNullLogger(), func(c *CrashError) {
+		h.crashes = append(h.crashes, c)
+	})
+	h.sup.now = func() time.Time { return h.now }
+	h.sup.sleep = func(_ context.Context, d time.Duration) error {
+		h.slept = append(h.slept, d)
+		h.now = h.now.Add(d)
+		return nil
+	}
+	if err := h.sup.launch(); err != nil {
+		t.Fatalf("launch() error = %v", err)
+	}
+	return h
+}
+
+// crashOn makes the running process and all later ones write a panic to
+// stderr and exit when method is called.
+func (h *supervisorHarness) crashOn(method string) {
+	h.next = func(p *fakeProcess, stderr io.Writer) {
+		p.invoke = func(m string) error {
+			if strings.HasSuffix(m, method) {
+				_, _ = io.WriteString(stderr, "starting\npanic: runtime error: index out of range [3] with length 3\n\ngoroutine 7 [running]:\nmain.patch()\n")
+				p.exited.Store(true)
+				return status.Error(codes.Unavailable, "error reading from server: EOF")
+			}
+			return nil
+		}
+	}
+	h.next(h.procs[len(h.procs)-1], h.sup.stderr)
+}
+
+func (h *supervisorHarness) call(method string) error {
+	return h.sup.Invoke(context.Background(), "/plugin.NetworkModule/"+method, &Empty{}, &Empty{})
+}
+
+func TestSupervisor_CrashAttribution(t *testing.T) {
+	h := newSupervisorHarness(t)
+	h.crashOn("ModifyGenesis")
+
+	err := h.call("ModifyGenesis")
+	var crash *CrashError
+	if !errors.As(err, &crash) {
+		t.Fatalf("call error = %v, want *CrashError", err)
+	}
+	if want := "plugin 'cosmos' crashed during ModifyGenesis: panic: runtime error: index out of range [3] with length 3"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+	if crash.Recovered || crash.RestartIn != restartBackoffInitial {
+		t.Errorf("crash = %+v", crash)
+	}
+	if !strings.Contains(crash.Stderr, "goroutine 7") {
+		t.Errorf("Stderr = %q, want the stack trace", crash.Stderr)
+	}
+	if !h.procs[0].killed.Load() {
+		t.Error("crashed process was not cleaned up")
+	}
+	if len(h.crashes) != 1 || h.crashes[0] != crash {
+		t.Errorf("crash handler got %v, want the returned crash", h.crashes)
+	}
+
+	// The next call waits out the backoff and runs on a new process
+	if err := h.call("Name"); err != nil {
+		t.Fatalf("call after crash error = %v", err)
+	}
+	if len(h.procs) != 2 {
+		t.Fatalf("started %d processes, want 2", len(h.procs))
+	}
+	if len(h.slept) != 1 || h.slept[0] != restartBackoffInitial {
+		t.Errorf("slept %v, want [%v]", h.slept, restartBackoffInitial)
+	}
+}
+
+func TestSupervisor_Backoff(t *testing.T) {
+	h := newSupervisorHarness(t)
+	h.crashOn("ModifyGenesis")
+	h.procs[0].exited.Store(true)
+
+	// Each crash in quick succession doubles the delay, up to the cap
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second}
+	for i, w := range want {
+		if i == 0 {
+			// The first process died between calls
+			if err := h.call("Name"); err != nil {
+				t.Fatalf("call error = %v", err)
+			}
+			if h.crashes[0].Method != "" || h.crashes[0].RestartIn != w {
+				t.Errorf("crash = %+v", h.crashes[0])
+			}
+			continue
+		}
+		var crash *CrashError
+		if err := h.call("ModifyGenesis"); !errors.As(err, &crash) {
+			t.Fatalf("call error = %v, want *CrashError", err)
+		}
+		if crash.RestartIn != w {
+			t.Errorf("crash %d RestartIn = %v, want %v", i, crash.RestartIn, w)
+		}
+		if err := h.call("Name"); err != nil {
+			t.Fatalf("restart error = %v", err)
+		}
+	}
+
+	// A crash after a stable run starts over
+	h.now = h.now.Add(stableRunTime)
+	var crash *CrashError
+	if err := h.call("ModifyGenesis"); !errors.As(err, &crash) {
+		t.Fatalf("call error = %v, want *CrashError", err)
+	}
+	if crash.RestartIn != restartBackoffInitial {
+		t.Errorf("RestartIn after stable run = %v, want %v", crash.RestartIn, restartBackoffInitial)
+	}
+}
+
+func TestSupervisor_RestartFailure(t *testing.T) {
+	h := newSupervisorHarness(t)
+	h.procs[0].exited.Store(true)
+	h.startErr = errors.New("exec format error")
+
+	err := h.call("Name")
+	var pe *PluginError
+	if !errors.As(err, &pe) || pe.Op != "restart" {
+		t.Fatalf("call error = %v, want restart PluginError", err)
+	}
+
+	// The failed restart pushes the next attempt out further
+	h.startErr = nil
+	h.slept = nil
+	if err := h.call("Name"); err != nil {
+		t.Fatalf("call error = %v", err)
+	}
+	if len(h.slept) != 1 || h.slept[0] != 2*time.Second {
+		t.Errorf("slept %v, want [2s]", h.slept)
+	}
+}
+
+func TestSupervisor_RecoveredPanic(t *testing.T) {
+	h := newSupervisorHarness(t)
+	h.procs[0].invoke = func(string) error {
+		return status.Error(codes.Internal, panicPrefix+"ModifyGenesis: assignment to entry in nil map")
+	}
+
+	err := h.call("ModifyGenesis")
+	var crash *CrashError
+	if !errors.As(err, &crash) || !crash.Recovered {
+		t.Fatalf("call error = %v, want recovered *CrashError", err)
+	}
+	if want := "plugin 'cosmos' panicked during ModifyGenesis: assignment to entry in nil map"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+	if len(h.procs) != 1 || h.procs[0].killed.Load() {
+		t.Error("recovered panic restarted the plugin")
+	}
+}
+
+func TestSupervisor_PassesThroughErrors(t *testing.T) {
+	h := newSupervisorHarness(t)
+	want := status.Error(codes.Unavailable, "node unreachable")
+	h.procs[0].invoke = func(string) error { return want }
+
+	if err := h.call("GetGovernanceParams"); err != want {
+		t.Errorf("call error = %v, want %v", err, want)
+	}
+	if len(h.crashes) != 0 {
+		t.Errorf("crash reported for a live plugin: %v", h.crashes)
+	}
+}
+
+func TestSupervisor_Closed(t *testing.T) {
+	h := newSupervisorHarness(t)
+	h.sup.close()
+
+	if err := h.call("Name"); !errors.Is(err, ErrPluginClosed) {
+		t.Errorf("call error = %v, want ErrPluginClosed", err)
+	}
+	if !h.procs[0].killed.Load() {
+		t.Error("close did not kill the process")
+	}
+}
+
+func TestCrashReason(t *testing.T) {
+	tests := map[string]string{
+		"starting\npanic: boom\n\ngoroutine 1 [running]:\n":             "panic: boom",
+		"fatal error: concurrent map writes\n\ngoroutine 9:\n":          "fatal error: concurrent map writes",
+		"loading config\nsegmentation fault\n":                          "segmentation fault",
+		"oom\n{\"@level\":\"debug\",\"@message\":\"plugin address\"}\n": "oom",
+		"": "",
+	}
+	for in, want := range tests {
+		if got := crashReason(in); got != want {
+			t.Errorf("crashReason(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestTailBuffer(t *testing.T) {
+	b := newTailBuffer(8)
+	_, _ = b.Write([]byte("0123456789"))
+	_, _ = b.Write([]byte("ab"))
+	if got := b.String(); got != "456789ab" {
+		t.Errorf("String() = %q, want %q", got, "456789ab")
+	}
+}
+
+func TestScopedEnv(t *testing.T) {
+	env := scopedEnv([]string{"PATH=/bin", "HOME=/root", "TMPDIR=/tmp"}, "/data/plugin-work/cosmos")
+	want := []string{"PATH=/bin", "HOME=/data/plugin-work/cosmos", "TMPDIR=/data/plugin-work/cosmos/tmp"}
+	if strings.Join(env, " ") != strings.Join(want, " ") {
+		t.Errorf("scopedEnv() = %v, want %v", env, want)
+	}
+}
@@ -0,0 +1,46 @@
+package plugin
+
+import (
+	"context"
+
+	hclog "github.com/hashicorp/go-hclog"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network/plugin/wasm"
+)
+
+// wasmSuffix is the file extension of WebAssembly plugins. A WebAssembly
+// plugin for network foo is named foo-plugin.wasm.
+const wasmSuffix = ".wasm"
+
+// openWASM loads the WebAssembly plugin at path, logging its stderr.
+func (l *Loader) openWASM(path string) (*wasm.Module, error) {
+	stderr := l.logger.StandardWriter(&hclog.StandardLoggerOptions{InferLevels: true})
+	return wasm.Load(context.Background(), path, stderr)
+}
+
+// loadWASMLocked loads a WebAssembly plugin (caller must hold lock).
+func (l *Loader) loadWASMLocked(name, pluginPath string) (*PluginClient, error) {
+	module, err := l.openWASM(pluginPath)
+	if err != nil {
+		return nil, &PluginError{Op: "load-wasm", PluginName: name, Err: err}
+	}
+
+	version := module.Version()
+	if err := l.versionConstraint.CheckVersion(version); err != nil {
+		module.Close()
+		return nil, &PluginError{Op: "version-check", PluginName: name, Err: err}
+	}
+	if err := module.Validate(); err != nil {
+		module.Close()
+		return nil, &PluginError{Op: "validate", PluginName: name, Err: err}
+	}
+
+	pc := &PluginClient{
+		wasm:   module,
+		module: module,
+		name:   name,
+	}
+	l.plugins[name] = pc
+	l.logger.Info("plugin loaded successfully", "name", name, "version", version, "runtime", "wasm")
+	return pc, nil
+}
@@ -0,0 +1,320 @@
+// Package guest is the SDK for network plugins compiled to WebAssembly.
+//
+// A WebAssembly plugin is a single .wasm file that runs on every OS and
+// architecture devnet-builder supports, so it needs no per-platform builds.
+// It implements Module, the part of network.Module that computes values:
+// metadata, genesis patching, node commands and config overrides. Behaviors
+// that talk to a running chain (governance queries, transaction building,
+// devnet generation) need a process plugin; see pkg/network/plugin.
+//
+// Build a plugin with Go 1.24 or later:
+//
+//	package main
+//
+//	import "github.com/altuslabsxyz/devnet-builder/pkg/network/plugin/wasm/guest"
+//
+//	func init() {
+//	    guest.Serve(&MyNetwork{})
+//	}
+//
+//	func main() {}
+//
+//	GOOS=wasip1 GOARCH=wasm go build -buildmode=c-shared -o mynetwork-plugin.wasm .
+//
+// and install mynetwork-plugin.wasm in a plugin directory.
+package guest
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+)
+
+// ABIVersion is the version of the host/guest calling convention. The host
+// refuses modules reporting a different version.
+const ABIVersion = 1
+
+// Module is the subset of network.Module that WebAssembly plugins
+// implement. Any network.Module implementation satisfies it.
+type Module interface {
+	// Identity
+	Name() string
+	DisplayName() string
+	Version() string
+
+	// Binary
+	BinaryName() string
+	BinarySource() network.BinarySource
+	DefaultBinaryVersion() string
+	GetBuildConfig(networkType string) (*network.BuildConfig, error)
+
+	// Chain configuration
+	DefaultChainID() string
+	Bech32Prefix() string
+	BaseDenom() string
+	GenesisConfig() network.GenesisConfig
+	DefaultPorts() network.PortConfig
+
+	// Docker
+	DockerImage() string
+	DockerImageTag(version string) string
+	DockerHomeDir() string
+
+	// Paths
+	DefaultNodeHome() string
+	PIDFileName() string
+	LogFileName() string
+	ProcessPattern() string
+
+	// Commands
+	InitCommand(homeDir, chainID, moniker string) []string
+	StartCommand(homeDir string, networkMode string) []string
+	ExportCommand(homeDir string) []string
+
+	// Genesis and node configuration
+	ModifyGenesis(genesis []byte, opts network.GenesisOptions) ([]byte, error)
+	DefaultGeneratorConfig() network.GeneratorConfig
+	GetConfigOverrides(nodeIndex int, opts network.NodeConfigOptions) (configToml []byte, appToml []byte, err error)
+
+	// Networks
+	SnapshotURL(networkType string) string
+	RPCEndpoint(networkType string) string
+	AvailableNetworks() []string
+
+	Validate() error
+}
+
+// Methods callable through the module's dvb_call export.
+const (
+	MethodMetadata           = "Metadata"
+	MethodGetBuildConfig     = "GetBuildConfig"
+	MethodDockerImageTag     = "DockerImageTag"
+	MethodInitCommand        = "InitCommand"
+	MethodStartCommand       = "StartCommand"
+	MethodExportCommand      = "ExportCommand"
+	MethodModifyGenesis      = "ModifyGenesis"
+	MethodGetConfigOverrides = "GetConfigOverrides"
+	MethodValidate           = "Validate"
+)
+
+// Metadata holds the values of a module that do not depend on arguments.
+// The host fetches it once when loading the module.
+type Metadata struct {
+	ABIVersion             int                     `json:"abi_version"`
+	Name                   string                  `json:"name"`
+	DisplayName            string                  `json:"display_name"`
+	Version                string                  `json:"version"`
+	BinaryName             string                  `json:"binary_name"`
+	BinarySource           network.BinarySource    `json:"binary_source"`
+	DefaultBinaryVersion   string                  `json:"default_binary_version"`
+	DefaultChainID         string                  `json:"default_chain_id"`
+	Bech32Prefix           string                  `json:"bech32_prefix"`
+	BaseDenom              string                  `json:"base_denom"`
+	GenesisConfig          network.GenesisConfig   `json:"genesis_config"`
+	DefaultPorts           network.PortConfig      `json:"default_ports"`
+	DockerImage            string                  `json:"docker_image"`
+	DockerHomeDir          string                  `json:"docker_home_dir"`
+	DefaultNodeHome        string                  `json:"default_node_home"`
+	PIDFileName            string                  `json:"pid_file_name"`
+	LogFileName            string                  `json:"log_file_name"`
+	ProcessPattern         string                  `json:"process_pattern"`
+	DefaultGeneratorConfig network.GeneratorConfig `json:"default_generator_config"`
+	AvailableNetworks      []string                `json:"available_networks"`
+	SnapshotURLs           map[string]string       `json:"snapshot_urls,omitempty"`
+	RPCEndpoints           map[string]string       `json:"rpc_endpoints,omitempty"`
+}
+
+// NewMetadata collects the metadata of m.
+func NewMetadata(m Module) Metadata {
+	md := Metadata{
+		ABIVersion:             ABIVersion,
+		Name:                   m.Name(),
+		DisplayName:            m.DisplayName(),
+		Version:                m.Version(),
+		BinaryName:             m.BinaryName(),
+		BinarySource:           m.BinarySource(),
+		DefaultBinaryVersion:   m.DefaultBinaryVersion(),
+		DefaultChainID:         m.DefaultChainID(),
+		Bech32Prefix:           m.Bech32Prefix(),
+		BaseDenom:              m.BaseDenom(),
+		GenesisConfig:          m.GenesisConfig(),
+		DefaultPorts:           m.DefaultPorts(),
+		DockerImage:            m.DockerImage(),
+		DockerHomeDir:          m.DockerHomeDir(),
+		DefaultNodeHome:        m.DefaultNodeHome(),
+		PIDFileName:            m.PIDFileName(),
+		LogFileName:            m.LogFileName(),
+		ProcessPattern:         m.ProcessPattern(),
+		DefaultGeneratorConfig: m.DefaultGeneratorConfig(),
+		AvailableNetworks:      m.AvailableNetworks(),
+		SnapshotURLs:           make(map[string]string),
+		RPCEndpoints:           make(map[string]string),
+	}
+	for _, n := range md.AvailableNetworks {
+		if url := m.SnapshotURL(n); url != "" {
+			md.SnapshotURLs[n] = url
+		}
+		if endpoint := m.RPCEndpoint(n); endpoint != "" {
+			md.RPCEndpoints[n] = endpoint
+		}
+	}
+	return md
+}
+
+// Response is the envelope of every dvb_call result.
+type Response struct {
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+
+	// Panic is set when the method panicked; Error holds the panic value.
+	Panic bool `json:"panic,omitempty"`
+}
+
+// BuildConfigRequest is the request for GetBuildConfig.
+type BuildConfigRequest struct {
+	NetworkType string `json:"network_type"`
+}
+
+// DockerImageTagRequest is the request for DockerImageTag.
+type DockerImageTagRequest struct {
+	Version string `json:"version"`
+}
+
+// InitCommandRequest is the request for InitCommand.
+type InitCommandRequest struct {
+	HomeDir string `json:"home_dir"`
+	ChainID string `json:"chain_id"`
+	Moniker string `json:"moniker"`
+}
+
+// StartCommandRequest is the request for StartCommand.
+type StartCommandRequest struct {
+	HomeDir     string `json:"home_dir"`
+	NetworkMode string `json:"network_mode"`
+}
+
+// ExportCommandRequest is the request for ExportCommand.
+type ExportCommandRequest struct {
+	HomeDir string `json:"home_dir"`
+}
+
+// ModifyGenesisRequest is the request for ModifyGenesis.
+type ModifyGenesisRequest struct {
+	Genesis []byte                 `json:"genesis"`
+	Options network.GenesisOptions `json:"options"`
+}
+
+// ConfigOverridesRequest is the request for GetConfigOverrides.
+type ConfigOverridesRequest struct {
+	NodeIndex int                       `json:"node_index"`
+	Options   network.NodeConfigOptions `json:"options"`
+}
+
+// ConfigOverridesResponse is the result of GetConfigOverrides.
+type ConfigOverridesResponse struct {
+	ConfigToml []byte `json:"config_toml,omitempty"`
+	AppToml    []byte `json:"app_toml,omitempty"`
+}
+
+// Dispatch calls method on m with a JSON request and returns the JSON
+// Response. Serve routes dvb_call here; it is exported so modules can be
+// tested natively.
+func Dispatch(m Module, method string, req []byte) []byte {
+	var resp Response
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				resp = Response{Error: fmt.Sprint(r), Panic: true}
+			}
+		}()
+		result, err := dispatch(m, method, req)
+		if err != nil {
+			resp.Error = err.Error()
+			return
+		}
+		resp.Result, err = json.Marshal(result)
+		if err != nil {
+			resp.Error = fmt.Sprintf("encode %s result: %v", method, err)
+		}
+	}()
+
+	out, err := json.Marshal(resp)
+	if err != nil {
+		out, _ = json.Marshal(Response{Error: err.Error()})
+	}
+	return out
+}
+
+func dispatch(m Module, method string, req []byte) (any, error) {
+	switch method {
+	case MethodMetadata:
+		return NewMetadata(m), nil
+
+	case MethodGetBuildConfig:
+		var r BuildConfigRequest
+		if err := decode(method, req, &r); err != nil {
+			return nil, err
+		}
+		return m.GetBuildConfig(r.NetworkType)
+
+	case MethodDockerImageTag:
+		var r DockerImageTagRequest
+		if err := decode(method, req, &r); err != nil {
+			return nil, err
+		}
+		return m.DockerImageTag(r.Version), nil
+
+	case MethodInitCommand:
+		var r InitCommandRequest
+		if err := decode(method, req, &r); err != nil {
+			return nil, err
+		}
+		return m.InitCommand(r.HomeDir, r.ChainID, r.Moniker), nil
+
+	case MethodStartCommand:
+		var r StartCommandRequest
+		if err := decode(method, req, &r); err != nil {
+			return nil, err
+		}
+		return m.StartCommand(r.HomeDir, r.NetworkMode), nil
+
+	case MethodExportCommand:
+		var r ExportCommandRequest
+		if err := decode(method, req, &r); err != nil {
+			return nil, err
+		}
+		return m.ExportCommand(r.HomeDir), nil
+
+	case MethodModifyGenesis:
+		var r ModifyGenesisRequest
+		if err := decode(method, req, &r); err != nil {
+			return nil, err
+		}
+		return m.ModifyGenesis(r.Genesis, r.Options)
+
+	case MethodGetConfigOverrides:
+		var r ConfigOverridesRequest
+		if err := decode(method, req, &r); err != nil {
+			return nil, err
+		}
+		configToml, appToml, err := m.GetConfigOverrides(r.NodeIndex, r.Options)
+		if err != nil {
+			return nil, err
+		}
+		return ConfigOverridesResponse{ConfigToml: configToml, AppToml: appToml}, nil
+
+	case MethodValidate:
+		return nil, m.Validate()
+
+	default:
+		return nil, fmt.Errorf("unknown method %q", method)
+	}
+}
+
+func decode(method string, req []byte, v any) error {
+	if err := json.Unmarshal(req, v); err != nil {
+		return fmt.Errorf("decode %s request: %w", method, err)
+	}
+	return nil
+}
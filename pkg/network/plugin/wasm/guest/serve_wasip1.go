@@ -0,0 +1,66 @@
+//go:build wasip1
+
+package guest
+
+import "unsafe"
+
+// The host calls a module through four exports:
+//
+//	dvb_abi_version() -> u32             ABIVersion
+//	dvb_alloc(size u32) -> ptr u32       allocate a buffer for the host to fill
+//	dvb_free(ptr u32)                    release a buffer from dvb_alloc or dvb_call
+//	dvb_call(method, method_len, req, req_len u32) -> u64
+//
+// dvb_call takes a method name and a JSON request in buffers from dvb_alloc
+// and returns a JSON Response, packed as ptr<<32 | len.
+
+var (
+	served Module
+
+	// buffers keeps memory shared with the host reachable until dvb_free.
+	buffers = make(map[uint32][]byte)
+)
+
+// Serve makes m the module answering host calls. Call it from an init
+// function: the host does not run main.
+func Serve(m Module) {
+	served = m
+}
+
+//go:wasmexport dvb_abi_version
+func abiVersion() uint32 {
+	return ABIVersion
+}
+
+//go:wasmexport dvb_alloc
+func alloc(size uint32) uint32 {
+	return pin(make([]byte, size))
+}
+
+//go:wasmexport dvb_free
+func free(ptr uint32) {
+	delete(buffers, ptr)
+}
+
+//go:wasmexport dvb_call
+func call(methodPtr, methodLen, reqPtr, reqLen uint32) uint64 {
+	method := string(buffers[methodPtr][:methodLen])
+	req := buffers[reqPtr][:reqLen]
+
+	var resp []byte
+	if served == nil {
+		resp = []byte(`{"error":"guest.Serve was not called"}`)
+	} else {
+		resp = Dispatch(served, method, req)
+	}
+	return uint64(pin(resp))<<32 | uint64(len(resp))
+}
+
+func pin(buf []byte) uint32 {
+	if len(buf) == 0 {
+		buf = make([]byte, 1)[:0]
+	}
+	ptr := uint32(uintptr(unsafe.Pointer(unsafe.SliceData(buf[:cap(buf)]))))
+	buffers[ptr] = buf
+	return ptr
+}
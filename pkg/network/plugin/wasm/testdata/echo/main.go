@@ -0,0 +1,93 @@
+// Command echo is a WebAssembly plugin used by the wasm package tests.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"github.com/altuslabsxyz/devnet-builder/pkg/network/plugin/wasm/guest"
+)
+
+type echo struct{}
+
+func (echo) Name() string        { return "echo" }
+func (echo) DisplayName() string { return "Echo Network" }
+func (echo) Version() string     { return "1.2.0" }
+func (echo) BinaryName() string  { return "echod" }
+func (echo) BinarySource() network.BinarySource {
+	return network.BinarySource{Type: "github", Owner: "acme", Repo: "echo"}
+}
+func (echo) DefaultBinaryVersion() string         { return "v0.5.0" }
+func (echo) DefaultChainID() string               { return "echo-1" }
+func (echo) Bech32Prefix() string                 { return "echo" }
+func (echo) BaseDenom() string                    { return "uecho" }
+func (echo) GenesisConfig() network.GenesisConfig { return network.GenesisConfig{BaseDenom: "uecho"} }
+func (echo) DefaultPorts() network.PortConfig     { return network.PortConfig{RPC: 26657, P2P: 26656} }
+func (echo) DockerImage() string                  { return "acme/echo" }
+func (echo) DockerImageTag(version string) string { return "v" + version }
+func (echo) DockerHomeDir() string                { return "/home/echo" }
+func (echo) DefaultNodeHome() string              { return "/root/.echo" }
+func (echo) PIDFileName() string                  { return "echod.pid" }
+func (echo) LogFileName() string                  { return "echod.log" }
+func (echo) ProcessPattern() string               { return "echod.*start" }
+func (echo) AvailableNetworks() []string          { return []string{"mainnet", "testnet"} }
+func (echo) Validate() error                      { return nil }
+
+func (echo) GetBuildConfig(networkType string) (*network.BuildConfig, error) {
+	if networkType == "devnet" {
+		return nil, errors.New("devnet builds are not supported")
+	}
+	return &network.BuildConfig{Tags: []string{"netgo"}}, nil
+}
+
+func (echo) InitCommand(homeDir, chainID, moniker string) []string {
+	return []string{"init", moniker, "--chain-id", chainID, "--home", homeDir}
+}
+
+func (echo) StartCommand(homeDir string, networkMode string) []string {
+	return []string{"start", "--home", homeDir}
+}
+
+func (echo) ExportCommand(homeDir string) []string {
+	return []string{"export", "--home", homeDir}
+}
+
+func (echo) ModifyGenesis(genesis []byte, opts network.GenesisOptions) ([]byte, error) {
+	switch opts.ChainID {
+	case "panic":
+		var m map[string]int
+		m["boom"] = 1
+	case "exit":
+		fmt.Fprintln(os.Stderr, "fatal error: out of memory")
+		os.Exit(2)
+	}
+	return bytes.ReplaceAll(genesis, []byte("CHAIN_ID"), []byte(opts.ChainID)), nil
+}
+
+func (echo) DefaultGeneratorConfig() network.GeneratorConfig {
+	return network.GeneratorConfig{NumValidators: 4}
+}
+
+func (echo) GetConfigOverrides(nodeIndex int, opts network.NodeConfigOptions) ([]byte, []byte, error) {
+	return []byte(fmt.Sprintf("moniker = %q\n", opts.Moniker)), nil, nil
+}
+
+func (echo) SnapshotURL(networkType string) string {
+	if networkType == "mainnet" {
+		return "https://snapshots.example.com/echo.tar.lz4"
+	}
+	return ""
+}
+
+func (echo) RPCEndpoint(networkType string) string {
+	return "https://rpc." + networkType + ".example.com"
+}
+
+func init() {
+	guest.Serve(echo{})
+}
+
+func main() {}
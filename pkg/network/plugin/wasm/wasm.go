@@ -0,0 +1,340 @@
+// Package wasm runs network plugins compiled to WebAssembly inside the host
+// process, using the wazero runtime. Plugins are written with package guest.
+//
+// A WebAssembly plugin gets no filesystem, network or environment access:
+// everything it needs arrives in call arguments. Its stderr goes to the
+// writer passed to Load.
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+	"github.com/altuslabsxyz/devnet-builder/pkg/network/plugin/wasm/guest"
+)
+
+// ErrUnsupported is returned by network.Module methods that WebAssembly
+// plugins cannot implement.
+var ErrUnsupported = errors.New("not supported by WebAssembly plugins; use a process plugin")
+
+// Module is a network.Module served by a WebAssembly plugin. Calls are
+// serialized: a module instance runs one call at a time.
+//
+// A call that traps or exits the module fails with an error naming the
+// plugin and method, and the next call runs on a fresh instance.
+type Module struct {
+	name     string
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	config   wazero.ModuleConfig
+	md       guest.Metadata
+
+	mu   sync.Mutex
+	inst api.Module
+}
+
+// Ensure Module implements network.Module
+var _ network.Module = (*Module)(nil)
+
+// Load compiles and instantiates the WebAssembly plugin at path. The
+// plugin's stderr is written to stderr, if not nil.
+func Load(ctx context.Context, path string, stderr io.Writer) (*Module, error) {
+	code, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r := wazero.NewRuntime(ctx)
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, r); err != nil {
+		r.Close(ctx)
+		return nil, fmt.Errorf("instantiate WASI: %w", err)
+	}
+
+	compiled, err := r.CompileModule(ctx, code)
+	if err != nil {
+		r.Close(ctx)
+		return nil, fmt.Errorf("compile %s: %w", path, err)
+	}
+	for _, export := range []string{"dvb_abi_version", "dvb_alloc", "dvb_free", "dvb_call"} {
+		if _, ok := compiled.ExportedFunctions()[export]; !ok {
+			r.Close(ctx)
+			return nil, fmt.Errorf("%s is not a devnet-builder plugin: missing export %s", path, export)
+		}
+	}
+
+	if stderr == nil {
+		stderr = io.Discard
+	}
+	m := &Module{
+		name:     strings.TrimSuffix(filepath.Base(path), ".wasm"),
+		runtime:  r,
+		compiled: compiled,
+		config: wazero.NewModuleConfig().
+			WithName("").
+			WithStartFunctions("_initialize").
+			WithStderr(stderr).
+			WithSysWalltime().
+			WithSysNanotime(),
+	}
+
+	if err := m.instantiate(ctx); err != nil {
+		r.Close(ctx)
+		return nil, err
+	}
+	if err := m.call(guest.MethodMetadata, struct{}{}, &m.md); err != nil {
+		r.Close(ctx)
+		return nil, fmt.Errorf("read plugin metadata: %w", err)
+	}
+	if m.md.ABIVersion != guest.ABIVersion {
+		r.Close(ctx)
+		return nil, fmt.Errorf("plugin ABI version %d, host supports %d", m.md.ABIVersion, guest.ABIVersion)
+	}
+	m.name = m.md.Name
+	return m, nil
+}
+
+// Close releases the module and its runtime.
+func (m *Module) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.inst = nil
+	return m.runtime.Close(context.Background())
+}
+
+func (m *Module) instantiate(ctx context.Context) error {
+	inst, err := m.runtime.InstantiateModule(ctx, m.compiled, m.config)
+	if err != nil {
+		return fmt.Errorf("instantiate plugin: %w", err)
+	}
+	version, err := inst.ExportedFunction("dvb_abi_version").Call(ctx)
+	if err != nil {
+		inst.Close(ctx)
+		return fmt.Errorf("read plugin ABI version: %w", err)
+	}
+	if version[0] != guest.ABIVersion {
+		inst.Close(ctx)
+		return fmt.Errorf("plugin ABI version %d, host supports %d", version[0], guest.ABIVersion)
+	}
+	m.inst = inst
+	return nil
+}
+
+// call invokes method with req encoded as JSON and decodes the result into
+// result, if not nil.
+func (m *Module) call(method string, req, result any) error {
+	reqJSON, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("encode %s request: %w", method, err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ctx := context.Background()
+	if m.inst == nil {
+		if err := m.instantiate(ctx); err != nil {
+			return fmt.Errorf("plugin '%s' %s: %w", m.name, method, err)
+		}
+	}
+
+	out, err := invoke(ctx, m.inst, method, reqJSON)
+	if err != nil {
+		// The instance may be left in any state; start over on the next call
+		m.inst.Close(ctx)
+		m.inst = nil
+		return fmt.Errorf("plugin '%s' crashed during %s: %w", m.name, method, err)
+	}
+
+	var resp guest.Response
+	if err := json.Unmarshal(out, &resp); err != nil {
+		return fmt.Errorf("plugin '%s' %s: decode response: %w", m.name, method, err)
+	}
+	if resp.Panic {
+		return fmt.Errorf("plugin '%s' panicked during %s: %s", m.name, method, resp.Error)
+	}
+	if resp.Error != "" {
+		return errors.New(resp.Error)
+	}
+	if result != nil && len(resp.Result) > 0 {
+		if err := json.Unmarshal(resp.Result, result); err != nil {
+			return fmt.Errorf("plugin '%s' %s: decode result: %w", m.name, method, err)
+		}
+	}
+	return nil
+}
+
+// invoke runs dvb_call on inst and returns a copy of the response.
+func invoke(ctx context.Context, inst api.Module, method string, req []byte) ([]byte, error) {
+	alloc := inst.ExportedFunction("dvb_alloc")
+	free := inst.ExportedFunction("dvb_free")
+
+	write := func(data []byte) (uint32, error) {
+		res, err := alloc.Call(ctx, uint64(len(data)))
+		if err != nil {
+			return 0, err
+		}
+		ptr := uint32(res[0])
+		if !inst.Memory().Write(ptr, data) {
+			return 0, fmt.Errorf("write %d bytes at %#x: out of range", len(data), ptr)
+		}
+		return ptr, nil
+	}
+
+	methodPtr, err := write([]byte(method))
+	if err != nil {
+		return nil, err
+	}
+	reqPtr, err := write(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := inst.ExportedFunction("dvb_call").Call(ctx,
+		uint64(methodPtr), uint64(len(method)), uint64(reqPtr), uint64(len(req)))
+	if err != nil {
+		return nil, err
+	}
+	respPtr, respLen := uint32(res[0]>>32), uint32(res[0])
+
+	view, ok := inst.Memory().Read(respPtr, respLen)
+	if !ok {
+		return nil, fmt.Errorf("read %d bytes at %#x: out of range", respLen, respPtr)
+	}
+	out := append([]byte(nil), view...)
+
+	for _, ptr := range []uint32{methodPtr, reqPtr, respPtr} {
+		if _, err := free.Call(ctx, uint64(ptr)); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// Identity methods
+
+func (m *Module) Name() string        { return m.md.Name }
+func (m *Module) DisplayName() string { return m.md.DisplayName }
+func (m *Module) Version() string     { return m.md.Version }
+
+// Binary methods
+
+func (m *Module) BinaryName() string                   { return m.md.BinaryName }
+func (m *Module) BinarySource() network.BinarySource   { return m.md.BinarySource }
+func (m *Module) DefaultBinaryVersion() string         { return m.md.DefaultBinaryVersion }
+func (m *Module) DefaultChainID() string               { return m.md.DefaultChainID }
+func (m *Module) Bech32Prefix() string                 { return m.md.Bech32Prefix }
+func (m *Module) BaseDenom() string                    { return m.md.BaseDenom }
+func (m *Module) GenesisConfig() network.GenesisConfig { return m.md.GenesisConfig }
+func (m *Module) DefaultPorts() network.PortConfig     { return m.md.DefaultPorts }
+
+func (m *Module) GetBuildConfig(networkType string) (*network.BuildConfig, error) {
+	var cfg *network.BuildConfig
+	if err := m.call(guest.MethodGetBuildConfig, guest.BuildConfigRequest{NetworkType: networkType}, &cfg); err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		cfg = &network.BuildConfig{}
+	}
+	return cfg, nil
+}
+
+// Docker methods
+
+func (m *Module) DockerImage() string   { return m.md.DockerImage }
+func (m *Module) DockerHomeDir() string { return m.md.DockerHomeDir }
+
+func (m *Module) DockerImageTag(version string) string {
+	var tag string
+	if err := m.call(guest.MethodDockerImageTag, guest.DockerImageTagRequest{Version: version}, &tag); err != nil {
+		return ""
+	}
+	return tag
+}
+
+// Path methods
+
+func (m *Module) DefaultNodeHome() string { return m.md.DefaultNodeHome }
+func (m *Module) PIDFileName() string     { return m.md.PIDFileName }
+func (m *Module) LogFileName() string     { return m.md.LogFileName }
+func (m *Module) ProcessPattern() string  { return m.md.ProcessPattern }
+
+// Command methods
+
+func (m *Module) InitCommand(homeDir, chainID, moniker string) []string {
+	var args []string
+	if err := m.call(guest.MethodInitCommand, guest.InitCommandRequest{HomeDir: homeDir, ChainID: chainID, Moniker: moniker}, &args); err != nil {
+		return nil
+	}
+	return args
+}
+
+func (m *Module) StartCommand(homeDir string, networkMode string) []string {
+	var args []string
+	if err := m.call(guest.MethodStartCommand, guest.StartCommandRequest{HomeDir: homeDir, NetworkMode: networkMode}, &args); err != nil {
+		return nil
+	}
+	return args
+}
+
+func (m *Module) ExportCommand(homeDir string) []string {
+	var args []string
+	if err := m.call(guest.MethodExportCommand, guest.ExportCommandRequest{HomeDir: homeDir}, &args); err != nil {
+		return nil
+	}
+	return args
+}
+
+// Genesis methods
+
+func (m *Module) ModifyGenesis(genesis []byte, opts network.GenesisOptions) ([]byte, error) {
+	var out []byte
+	if err := m.call(guest.MethodModifyGenesis, guest.ModifyGenesisRequest{Genesis: genesis, Options: opts}, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GenerateDevnet is not supported: it needs the chain binary's codec.
+func (m *Module) GenerateDevnet(ctx context.Context, config network.GeneratorConfig, genesisFile string) error {
+	return fmt.Errorf("GenerateDevnet: %w", ErrUnsupported)
+}
+
+func (m *Module) DefaultGeneratorConfig() network.GeneratorConfig {
+	return m.md.DefaultGeneratorConfig
+}
+
+// GetCodec is not supported: it needs the chain binary's codec.
+func (m *Module) GetCodec() ([]byte, error) {
+	return nil, fmt.Errorf("GetCodec: %w", ErrUnsupported)
+}
+
+func (m *Module) Validate() error {
+	return m.call(guest.MethodValidate, struct{}{}, nil)
+}
+
+// Network methods
+
+func (m *Module) SnapshotURL(networkType string) string { return m.md.SnapshotURLs[networkType] }
+func (m *Module) RPCEndpoint(networkType string) string { return m.md.RPCEndpoints[networkType] }
+func (m *Module) AvailableNetworks() []string           { return m.md.AvailableNetworks }
+
+func (m *Module) GetConfigOverrides(nodeIndex int, opts network.NodeConfigOptions) ([]byte, []byte, error) {
+	var resp guest.ConfigOverridesResponse
+	if err := m.call(guest.MethodGetConfigOverrides, guest.ConfigOverridesRequest{NodeIndex: nodeIndex, Options: opts}, &resp); err != nil {
+		return nil, nil, err
+	}
+	return resp.ConfigToml, resp.AppToml, nil
+}
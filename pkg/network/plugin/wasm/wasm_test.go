@@ -0,0 +1,159 @@
+package wasm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/altuslabsxyz/devnet-builder/pkg/network"
+)
+
+var (
+	buildOnce sync.Once
+	echoPath  string
+	buildErr  error
+)
+
+// buildEcho compiles testdata/echo to WebAssembly once per test run.
+func buildEcho(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go toolchain not available")
+	}
+	buildOnce.Do(func() {
+		dir, err := os.MkdirTemp("", "wasm-plugin")
+		if err != nil {
+			buildErr = err
+			return
+		}
+		echoPath = filepath.Join(dir, "echo-plugin.wasm")
+		cmd := exec.Command("go", "build", "-buildmode=c-shared", "-o", echoPath, "./testdata/echo")
+		cmd.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			buildErr = errors.New(string(out))
+		}
+	})
+	if buildErr != nil {
+		t.Fatalf("build echo plugin: %v", buildErr)
+	}
+	return echoPath
+}
+
+func loadEcho(t *testing.T) (*Module, *bytes.Buffer) {
+	t.Helper()
+	var stderr bytes.Buffer
+	m, err := Load(context.Background(), buildEcho(t), &stderr)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	t.Cleanup(func() { m.Close() })
+	return m, &stderr
+}
+
+func TestLoad_Metadata(t *testing.T) {
+	m, _ := loadEcho(t)
+
+	if m.Name() != "echo" || m.Version() != "1.2.0" || m.BinaryName() != "echod" || m.Bech32Prefix() != "echo" {
+		t.Errorf("identity = %s %s %s %s", m.Name(), m.Version(), m.BinaryName(), m.Bech32Prefix())
+	}
+	if src := m.BinarySource(); !src.IsGitHub() || src.Repo != "echo" {
+		t.Errorf("BinarySource() = %+v", src)
+	}
+	if m.DefaultPorts().RPC != 26657 || m.DefaultGeneratorConfig().NumValidators != 4 {
+		t.Errorf("DefaultPorts() = %+v, DefaultGeneratorConfig() = %+v", m.DefaultPorts(), m.DefaultGeneratorConfig())
+	}
+	if got := m.SnapshotURL("mainnet"); got != "https://snapshots.example.com/echo.tar.lz4" {
+		t.Errorf("SnapshotURL(mainnet) = %q", got)
+	}
+	if got := m.SnapshotURL("testnet"); got != "" {
+		t.Errorf("SnapshotURL(testnet) = %q, want empty", got)
+	}
+	if got := m.RPCEndpoint("testnet"); got != "https://rpc.testnet.example.com" {
+		t.Errorf("RPCEndpoint(testnet) = %q", got)
+	}
+	if err := m.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestModule_Calls(t *testing.T) {
+	m, _ := loadEcho(t)
+
+	if got := strings.Join(m.InitCommand("/data", "echo-7", "node0"), " "); got != "init node0 --chain-id echo-7 --home /data" {
+		t.Errorf("InitCommand() = %q", got)
+	}
+	if got := m.DockerImageTag("1.0.0"); got != "v1.0.0" {
+		t.Errorf("DockerImageTag() = %q", got)
+	}
+
+	genesis, err := m.ModifyGenesis([]byte(`{"chain_id":"CHAIN_ID"}`), network.GenesisOptions{ChainID: "echo-7"})
+	if err != nil || string(genesis) != `{"chain_id":"echo-7"}` {
+		t.Errorf("ModifyGenesis() = %s, %v", genesis, err)
+	}
+
+	configToml, appToml, err := m.GetConfigOverrides(0, network.NodeConfigOptions{Moniker: "node0"})
+	if err != nil || string(configToml) != "moniker = \"node0\"\n" || appToml != nil {
+		t.Errorf("GetConfigOverrides() = %q, %q, %v", configToml, appToml, err)
+	}
+
+	cfg, err := m.GetBuildConfig("mainnet")
+	if err != nil || len(cfg.Tags) != 1 {
+		t.Errorf("GetBuildConfig(mainnet) = %+v, %v", cfg, err)
+	}
+	if _, err := m.GetBuildConfig("devnet"); err == nil || err.Error() != "devnet builds are not supported" {
+		t.Errorf("GetBuildConfig(devnet) error = %v", err)
+	}
+
+	if err := m.GenerateDevnet(context.Background(), network.GeneratorConfig{}, ""); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("GenerateDevnet() error = %v, want ErrUnsupported", err)
+	}
+}
+
+func TestModule_Panic(t *testing.T) {
+	m, _ := loadEcho(t)
+
+	_, err := m.ModifyGenesis([]byte(`{}`), network.GenesisOptions{ChainID: "panic"})
+	if err == nil || !strings.HasPrefix(err.Error(), "plugin 'echo' panicked during ModifyGenesis: assignment to entry in nil map") {
+		t.Fatalf("ModifyGenesis() error = %v", err)
+	}
+
+	// The module keeps serving calls
+	if _, err := m.ModifyGenesis([]byte(`{}`), network.GenesisOptions{ChainID: "echo-1"}); err != nil {
+		t.Errorf("ModifyGenesis() after panic error = %v", err)
+	}
+}
+
+func TestModule_Crash(t *testing.T) {
+	m, stderr := loadEcho(t)
+
+	_, err := m.ModifyGenesis([]byte(`{}`), network.GenesisOptions{ChainID: "exit"})
+	if err == nil || !strings.HasPrefix(err.Error(), "plugin 'echo' crashed during ModifyGenesis") {
+		t.Fatalf("ModifyGenesis() error = %v", err)
+	}
+	if !strings.Contains(stderr.String(), "fatal error: out of memory") {
+		t.Errorf("stderr = %q, want the plugin's output", stderr.String())
+	}
+
+	// The next call runs on a fresh instance
+	genesis, err := m.ModifyGenesis([]byte(`CHAIN_ID`), network.GenesisOptions{ChainID: "echo-2"})
+	if err != nil || string(genesis) != "echo-2" {
+		t.Errorf("ModifyGenesis() after crash = %s, %v", genesis, err)
+	}
+}
+
+func TestLoad_NotAPlugin(t *testing.T) {
+	// The smallest valid module: magic number and version only
+	path := filepath.Join(t.TempDir(), "empty-plugin.wasm")
+	if err := os.WriteFile(path, []byte("\x00asm\x01\x00\x00\x00"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(context.Background(), path, nil); err == nil || !strings.Contains(err.Error(), "missing export") {
+		t.Errorf("Load() error = %v, want missing export", err)
+	}
+}
@@ -0,0 +1,49 @@
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestLoader_DiscoverWASM(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]os.FileMode{
+		"osmosis-plugin.wasm": 0644, // WebAssembly plugins need not be executable
+		"gaia-plugin":         0755,
+		"gaia-plugin.wasm":    0644, // shadowed by the process plugin
+		"stable-plugin":       0644, // not executable
+		"notes.wasm":          0644,
+	}
+	for name, mode := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	l := NewLoaderWithDirs(dir)
+	names, err := l.Discover()
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	sort.Strings(names)
+	if got := strings.Join(names, ","); got != "gaia,osmosis" {
+		t.Errorf("Discover() = %v, want [gaia osmosis]", names)
+	}
+
+	tests := map[string]string{
+		"gaia":    "gaia-plugin",
+		"osmosis": "osmosis-plugin.wasm",
+	}
+	for name, want := range tests {
+		path, err := l.findPluginLocked(name)
+		if err != nil || filepath.Base(path) != want {
+			t.Errorf("findPluginLocked(%s) = %s, %v; want %s", name, path, err, want)
+		}
+	}
+	if _, err := l.findPluginLocked("stable"); err == nil {
+		t.Error("findPluginLocked(stable) found a non-executable plugin")
+	}
+}
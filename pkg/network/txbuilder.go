@@ -38,6 +38,7 @@ const (
 	TxTypeGovVote         TxType = "gov/vote"
 	TxTypeStakingDelegate TxType = "staking/delegate"
 	TxTypeStakingUnbond   TxType = "staking/unbond"
+	TxTypeSlashingUnjail  TxType = "slashing/unjail"
 	TxTypeBankSend        TxType = "bank/send"
 	TxTypeWasmExecute     TxType = "wasm/execute"
 	TxTypeWasmInstantiate TxType = "wasm/instantiate"
@@ -170,3 +171,35 @@ const (
 type TxBuilderFactory interface {
 	CreateTxBuilder(ctx context.Context, cfg *TxBuilderConfig) (TxBuilder, error)
 }
+
+// DecodedTx is a transaction decoded for inspection.
+type DecodedTx struct {
+	// Messages are the transaction's messages, in order.
+	Messages []DecodedMsg `json:"messages"`
+
+	// Memo is the transaction memo.
+	Memo string `json:"memo,omitempty"`
+
+	// Fee is the fee paid, as a coin string (e.g., "5000stake").
+	Fee string `json:"fee,omitempty"`
+
+	// GasLimit is the gas limit set by the signer.
+	GasLimit uint64 `json:"gasLimit"`
+}
+
+// DecodedMsg is a decoded transaction message.
+type DecodedMsg struct {
+	// TypeURL is the message type (e.g., "/cosmos.bank.v1beta1.MsgSend").
+	TypeURL string `json:"typeUrl"`
+
+	// JSON is the message as JSON, or empty if the decoder does not know
+	// the message type.
+	JSON json.RawMessage `json:"json,omitempty"`
+}
+
+// TxDecoder is implemented by modules that decode their chain's
+// transactions, so tx inspection can show chain-specific messages. Without
+// it, only messages of the standard Cosmos SDK modules are decoded.
+type TxDecoder interface {
+	DecodeTx(txBytes []byte) (*DecodedTx, error)
+}
@@ -0,0 +1,92 @@
+package unit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/devnet-builder/internal/application/dto"
+	"github.com/altuslabsxyz/devnet-builder/internal/application/upgrade"
+	"github.com/altuslabsxyz/devnet-builder/internal/output"
+	"github.com/altuslabsxyz/devnet-builder/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPlanUseCase_PredictsUpgradeHeight tests the predicted height and timeline.
+func TestPlanUseCase_PredictsUpgradeHeight(t *testing.T) {
+	tests := []struct {
+		name          string
+		currentHeight int64
+		heightBuffer  int
+		wantBuffer    int64
+		wantAuto      bool
+	}{
+		{
+			// 1s blocks: auto buffer is 80s / 1s = 80 blocks
+			name:          "auto buffer",
+			currentHeight: 100,
+			wantBuffer:    80,
+			wantAuto:      true,
+		},
+		{
+			name:          "auto buffer on young chain",
+			currentHeight: 3,
+			wantBuffer:    40,
+			wantAuto:      true,
+		},
+		{
+			name:          "manual buffer",
+			currentHeight: 100,
+			heightBuffer:  15,
+			wantBuffer:    15,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := upgrade.NewPlanUseCase(&MockRPCClient{BlockHeight: tt.currentHeight}, output.DefaultLogger)
+
+			plan, err := uc.Execute(context.Background(), dto.UpgradePlanInput{
+				UpgradeName:   "v2-upgrade",
+				Mode:          types.ExecutionModeDocker,
+				VotingPeriod:  60 * time.Second,
+				HeightBuffer:  tt.heightBuffer,
+				NumValidators: 4,
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.currentHeight, plan.CurrentHeight)
+			assert.Equal(t, time.Second, plan.BlockTime)
+			assert.True(t, plan.BlockTimeMeasured)
+			assert.Equal(t, int64(60), plan.VotingBlocks)
+			assert.Equal(t, tt.wantBuffer, plan.HeightBuffer)
+			assert.Equal(t, tt.wantAuto, plan.HeightBufferAuto)
+			assert.Equal(t, tt.currentHeight+60+tt.wantBuffer, plan.UpgradeHeight)
+
+			last := plan.Timeline[len(plan.Timeline)-1]
+			assert.Equal(t, "Chain resumes", last.Stage)
+			assert.Equal(t, plan.UpgradeHeight+1, last.Height)
+			assert.Equal(t, time.Duration(60+tt.wantBuffer+1)*time.Second, plan.EstimatedDuration)
+		})
+	}
+}
+
+// TestPlanUseCase_SkipGovernance tests that --skip-gov plans have no upgrade height.
+func TestPlanUseCase_SkipGovernance(t *testing.T) {
+	uc := upgrade.NewPlanUseCase(&MockRPCClient{BlockHeight: 100}, output.DefaultLogger)
+
+	plan, err := uc.Execute(context.Background(), dto.UpgradePlanInput{
+		Mode:           types.ExecutionModeLocal,
+		TargetVersion:  "feat/new-module",
+		BuildRequired:  true,
+		SkipGovernance: true,
+	})
+	require.NoError(t, err)
+
+	assert.Zero(t, plan.UpgradeHeight)
+	assert.Zero(t, plan.VotingBlocks)
+	require.NotEmpty(t, plan.Timeline)
+	assert.Equal(t, "Build feat/new-module from source", plan.Timeline[0].Stage)
+	assert.Equal(t, int64(101), plan.Timeline[len(plan.Timeline)-1].Height)
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	"fmt"
+	"net"
+)
+
 // Port default values - Single Source of Truth for all port constants.
 // These constants are used throughout the codebase for node configuration.
 const (
@@ -111,12 +116,106 @@ func (p PortConfig) WithOffset(offset int) PortConfig {
 	}
 }
 
+// PortLayout describes how ports are assigned to a devnet's nodes: each
+// service starts at its base port, and node i adds i*Stride to all of them.
+// Zero bases use the defaults. A zero Stride gives every node the same
+// ports, which is what loopback subnet mode wants since each node has its
+// own IP address.
+type PortLayout struct {
+	// P2P is the base peer-to-peer port (default: 26656)
+	P2P int `json:"p2p,omitempty"`
+
+	// RPC is the base Tendermint/CometBFT RPC port (default: 26657)
+	RPC int `json:"rpc,omitempty"`
+
+	// API is the base REST API port (default: 1317)
+	API int `json:"api,omitempty"`
+
+	// GRPC is the base gRPC port (default: 9090); gRPC-Web uses the next port
+	GRPC int `json:"grpc,omitempty"`
+
+	// EVMRPC is the base EVM JSON-RPC port (default: 8545); the EVM
+	// WebSocket uses the next port
+	EVMRPC int `json:"evm_rpc,omitempty"`
+
+	// Stride is the distance between consecutive nodes' ports
+	Stride int `json:"stride,omitempty"`
+}
+
+// IsZero reports whether the layout is the default one.
+func (l PortLayout) IsZero() bool {
+	return l == PortLayout{}
+}
+
+// ForNode returns the ports of the node at the given index.
+func (l PortLayout) ForNode(index int) PortConfig {
+	p := DefaultPortConfig()
+	if l.P2P != 0 {
+		p.P2P = l.P2P
+	}
+	if l.RPC != 0 {
+		p.RPC = l.RPC
+	}
+	if l.API != 0 {
+		p.API = l.API
+	}
+	if l.GRPC != 0 {
+		p.GRPC = l.GRPC
+		p.GRPCWeb = l.GRPC + 1
+	}
+	if l.EVMRPC != 0 {
+		p.EVMRPC = l.EVMRPC
+		p.EVMWS = l.EVMRPC + 1
+	}
+	return p.WithOffset(index * l.Stride)
+}
+
+// Validate checks that the layout's ports are in range for the given number
+// of nodes and that no two services of a node share a port.
+func (l PortLayout) Validate(nodes int) error {
+	if l.Stride < 0 {
+		return fmt.Errorf("port stride must not be negative, got %d", l.Stride)
+	}
+	type service struct {
+		name string
+		port int
+	}
+	for _, base := range []service{{"p2p", l.P2P}, {"rpc", l.RPC}, {"rest", l.API}, {"grpc", l.GRPC}, {"evm", l.EVMRPC}} {
+		if base.port < 0 || base.port > 65534 {
+			return fmt.Errorf("%s base port %d is out of range", base.name, base.port)
+		}
+	}
+
+	first := l.ForNode(0)
+	seen := make(map[int]string)
+	for _, svc := range []service{
+		{"p2p", first.P2P}, {"rpc", first.RPC}, {"proxy", first.Proxy},
+		{"rest", first.API}, {"grpc", first.GRPC}, {"grpc-web", first.GRPCWeb},
+		{"evm", first.EVMRPC}, {"evm-ws", first.EVMWS}, {"pprof", first.PProf},
+	} {
+		if other, ok := seen[svc.port]; ok {
+			return fmt.Errorf("%s and %s ports both use %d", other, svc.name, svc.port)
+		}
+		seen[svc.port] = svc.name
+	}
+
+	if nodes > 1 {
+		last := l.ForNode(nodes - 1)
+		for _, port := range last.AllPorts() {
+			if port > 65535 {
+				return fmt.Errorf("port layout needs port %d for node %d, above 65535", port, nodes-1)
+			}
+		}
+	}
+	return nil
+}
+
 // RPCURL returns the full RPC URL for this port configuration.
 func (p PortConfig) RPCURL(host string) string {
 	if host == "" {
 		host = "localhost"
 	}
-	return "http://" + host + ":" + itoa(p.RPC)
+	return "http://" + net.JoinHostPort(host, itoa(p.RPC))
 }
 
 // EVMRPCURL returns the full EVM JSON-RPC URL for this port configuration.
@@ -124,7 +223,7 @@ func (p PortConfig) EVMRPCURL(host string) string {
 	if host == "" {
 		host = "localhost"
 	}
-	return "http://" + host + ":" + itoa(p.EVMRPC)
+	return "http://" + net.JoinHostPort(host, itoa(p.EVMRPC))
 }
 
 // APIURL returns the full REST API URL for this port configuration.
@@ -132,7 +231,21 @@ func (p PortConfig) APIURL(host string) string {
 	if host == "" {
 		host = "localhost"
 	}
-	return "http://" + host + ":" + itoa(p.API)
+	return "http://" + net.JoinHostPort(host, itoa(p.API))
+}
+
+// DialHost returns the host clients use to reach a node listening on
+// bindAddress: the loopback address for the unspecified addresses
+// ("0.0.0.0" and "::"), otherwise bindAddress itself.
+func DialHost(bindAddress string) string {
+	switch ip := net.ParseIP(bindAddress); {
+	case ip == nil || !ip.IsUnspecified():
+		return bindAddress
+	case ip.To4() != nil:
+		return "127.0.0.1"
+	default:
+		return "::1"
+	}
 }
 
 // AllPorts returns a slice of all configured ports.
@@ -103,6 +103,14 @@ func TestPortConfig_WithOffset_Negative(t *testing.T) {
 	require.Equal(t, 26656-5000, adjusted.P2P)
 }
 
+func TestDialHost(t *testing.T) {
+	require.Equal(t, "127.0.0.1", DialHost("0.0.0.0"))
+	require.Equal(t, "::1", DialHost("::"))
+	require.Equal(t, "fd00::5", DialHost("fd00::5"))
+	require.Equal(t, "192.168.1.20", DialHost("192.168.1.20"))
+	require.Equal(t, "", DialHost(""))
+}
+
 func TestPortConfig_RPCURL(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -124,6 +132,11 @@ func TestPortConfig_RPCURL(t *testing.T) {
 			host:    "node.example.com",
 			wantURL: "http://node.example.com:26657",
 		},
+		{
+			name:    "ipv6 host is bracketed",
+			host:    "::1",
+			wantURL: "http://[::1]:26657",
+		},
 	}
 
 	cfg := DefaultPortConfig()
@@ -257,3 +270,31 @@ func TestPortConstants(t *testing.T) {
 	require.Equal(t, 6060, DefaultPProfPort)
 	require.Equal(t, 8080, DefaultRosettaPort)
 }
+
+func TestPortLayout_ForNode(t *testing.T) {
+	require.Equal(t, DefaultPortConfig(), PortLayout{}.ForNode(3))
+
+	layout := PortLayout{RPC: 36657, API: 2317, GRPC: 10090, EVMRPC: 9545, Stride: 10}
+	node0 := layout.ForNode(0)
+	require.Equal(t, 36657, node0.RPC)
+	require.Equal(t, DefaultP2PPort, node0.P2P)
+	require.Equal(t, 10091, node0.GRPCWeb)
+	require.Equal(t, 9546, node0.EVMWS)
+
+	node2 := layout.ForNode(2)
+	require.Equal(t, 36677, node2.RPC)
+	require.Equal(t, 2337, node2.API)
+	require.Equal(t, 10110, node2.GRPC)
+	require.Equal(t, 9565, node2.EVMRPC)
+}
+
+func TestPortLayout_Validate(t *testing.T) {
+	require.NoError(t, PortLayout{}.Validate(4))
+	require.NoError(t, PortLayout{RPC: 36657, Stride: 100}.Validate(4))
+
+	require.ErrorContains(t, PortLayout{Stride: -1}.Validate(1), "must not be negative")
+	require.ErrorContains(t, PortLayout{RPC: 70000}.Validate(1), "rpc base port 70000 is out of range")
+	require.ErrorContains(t, PortLayout{RPC: DefaultAPIPort}.Validate(1), "rpc and rest ports both use 1317")
+	require.ErrorContains(t, PortLayout{GRPC: DefaultEVMRPCPort - 1}.Validate(1), "grpc-web and evm ports both use 8545")
+	require.ErrorContains(t, PortLayout{Stride: 20000}.Validate(3), "for node 2, above 65535")
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -49,6 +50,24 @@ func (c ChainID) IsValid() bool {
 	return chainIDPattern.MatchString(string(c))
 }
 
+// ethermintChainIDPattern matches the Ethermint chain ID format
+// <name>_<EVM chain ID>-<epoch> used by EVM chains (evmos_9001-2).
+var ethermintChainIDPattern = regexp.MustCompile(`^[a-z]+_([1-9]\d*)-[1-9]\d*$`)
+
+// EVMChainID returns the EVM chain ID carried by a chain ID in the Ethermint
+// format, and false for chain IDs in any other format.
+func (c ChainID) EVMChainID() (uint64, bool) {
+	m := ethermintChainIDPattern.FindStringSubmatch(string(c))
+	if m == nil {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(m[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
 // NetworkSource represents the source network for snapshots.
 type NetworkSource string
 